@@ -0,0 +1,349 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux || windows || freebsd
+
+package accelerators
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"math"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+	"github.com/prometheus/common/model"
+
+	info "github.com/google/cadvisor/info/v1"
+	"github.com/google/cadvisor/stats"
+
+	"k8s.io/klog/v2"
+)
+
+var (
+	dcgmExporterURL = flag.String("dcgm_exporter_url", "", "URL of the metrics of a DCGM exporter, e.g. http://localhost:9400/metrics, to read the stats of the NVIDIA GPUs and MIG instances of containers from. Empty disables NVIDIA GPU stats")
+	dcgmInterval    = flag.Duration("dcgm_interval", 10*time.Second, "Interval between reads of the metrics of the DCGM exporter")
+)
+
+const (
+	// Major number of the device files of NVIDIA GPUs, /dev/nvidia<minor>.
+	nvidiaMajor = 195
+	// Minor numbers from which the device files are not GPUs, 254 being
+	// /dev/nvidia-modeset and 255 /dev/nvidiactl.
+	nvidiaMaxMinor = 253
+
+	// Labels of the metrics of the DCGM exporter.
+	deviceLabel             = "device"
+	gpuLabel                = "gpu"
+	uuidLabel               = "UUID"
+	modelNameLabel          = "modelName"
+	gpuInstanceLabel        = "GPU_I_ID"
+	gpuInstanceProfileLabel = "GPU_I_PROFILE"
+
+	// Megabytes, of the framebuffer metrics.
+	mib = 1 << 20
+)
+
+// device is the stats of a GPU, or of a MIG instance of a GPU.
+type device struct {
+	minor int
+	// Index of the GPU, by which CDI names it.
+	index int
+	stats info.AcceleratorStats
+}
+
+type dcgmManager struct {
+	url    string
+	client *http.Client
+
+	mu      sync.RWMutex
+	devices []device
+
+	stop chan struct{}
+}
+
+func newDcgmManager(url string, client *http.Client) *dcgmManager {
+	return &dcgmManager{
+		url:    url,
+		client: client,
+		stop:   make(chan struct{}),
+	}
+}
+
+func (m *dcgmManager) run(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if err := m.update(); err != nil {
+			klog.V(2).Infof("Failed to read the metrics of the DCGM exporter: %v", err)
+		}
+		select {
+		case <-m.stop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// update reads the stats of the devices from the DCGM exporter.
+func (m *dcgmManager) update() error {
+	response, err := m.client.Get(m.url)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %s", response.Status)
+	}
+	parser := expfmt.NewTextParser(model.UTF8Validation)
+	families, err := parser.TextToMetricFamilies(response.Body)
+	if err != nil {
+		return err
+	}
+	devices := parseDcgmMetrics(families)
+	m.mu.Lock()
+	m.devices = devices
+	m.mu.Unlock()
+	return nil
+}
+
+// parseDcgmMetrics returns the devices of the metrics of the DCGM exporter,
+// the GPUs and the MIG instances of the GPUs in MIG mode.
+func parseDcgmMetrics(families map[string]*dto.MetricFamily) []device {
+	type key struct{ device, gpuInstance string }
+	devices := map[key]*device{}
+	for name, family := range families {
+		for _, metric := range family.GetMetric() {
+			labels := map[string]string{}
+			for _, label := range metric.GetLabel() {
+				labels[label.GetName()] = label.GetValue()
+			}
+			k := key{labels[deviceLabel], labels[gpuInstanceLabel]}
+			if !strings.HasPrefix(k.device, "nvidia") {
+				continue
+			}
+			minor, err := strconv.Atoi(strings.TrimPrefix(k.device, "nvidia"))
+			if err != nil {
+				continue
+			}
+			d, ok := devices[k]
+			if !ok {
+				index, err := strconv.Atoi(labels[gpuLabel])
+				if err != nil {
+					index = -1
+				}
+				d = &device{minor: minor, index: index, stats: info.AcceleratorStats{
+					Make:               "nvidia",
+					Model:              labels[modelNameLabel],
+					ID:                 labels[uuidLabel],
+					GPUInstance:        k.gpuInstance,
+					GPUInstanceProfile: labels[gpuInstanceProfileLabel],
+				}}
+				devices[k] = d
+			}
+			value := metric.GetGauge().GetValue()
+			if metric.Counter != nil {
+				value = metric.GetCounter().GetValue()
+			}
+			switch name {
+			case "DCGM_FI_DEV_GPU_UTIL":
+				// Only reported for whole GPUs, the graphics engine
+				// activity is used for MIG instances.
+				if d.stats.GPUInstance == "" {
+					d.stats.DutyCycle = uint64(value)
+				}
+			case "DCGM_FI_PROF_GR_ENGINE_ACTIVE":
+				if d.stats.GPUInstance != "" {
+					d.stats.DutyCycle = uint64(math.Round(value * 100))
+				}
+			case "DCGM_FI_PROF_SM_OCCUPANCY":
+				d.stats.SMOccupancy = uint64(math.Round(value * 100))
+			case "DCGM_FI_DEV_FB_USED":
+				d.stats.MemoryUsed = uint64(value) * mib
+				d.stats.MemoryTotal += uint64(value) * mib
+			case "DCGM_FI_DEV_FB_FREE", "DCGM_FI_DEV_FB_RESERVED":
+				d.stats.MemoryTotal += uint64(value) * mib
+			case "DCGM_FI_DEV_XID_ERRORS":
+				d.stats.XIDError = uint64(value)
+			}
+		}
+	}
+	result := make([]device, 0, len(devices))
+	for k, d := range devices {
+		// XID errors are reported for the GPU of MIG instances.
+		if gpu, ok := devices[key{k.device, ""}]; ok && k.gpuInstance != "" {
+			d.stats.XIDError = gpu.stats.XIDError
+		}
+		result = append(result, *d)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].minor != result[j].minor {
+			return result[i].minor < result[j].minor
+		}
+		a, _ := strconv.Atoi(result[i].stats.GPUInstance)
+		b, _ := strconv.Atoi(result[j].stats.GPUInstance)
+		return a < b
+	})
+	return result
+}
+
+func (m *dcgmManager) Destroy() {
+	close(m.stop)
+}
+
+// GetCollector returns a collector of the stats of the GPUs the devices
+// cgroup allows the container to use.
+func (m *dcgmManager) GetCollector(devicesCgroup string) (stats.Collector, error) {
+	return m.GetContainerCollector(&Container{DevicesCgroup: devicesCgroup})
+}
+
+// GetContainerCollector returns a collector of the stats of the GPUs, and of
+// the MIG instances of GPUs in MIG mode, the devices cgroup allows the
+// container to use or its CDI annotations name. The devices of cgroup v2 are
+// not listed, so the GPUs of containers with cgroup v2 are only found from
+// CDI annotations.
+func (m *dcgmManager) GetContainerCollector(container *Container) (stats.Collector, error) {
+	collector := &dcgmCollector{manager: m, cdiDevices: cdiDevices(container.Labels)}
+	allowed, err := allowedDevices(container.DevicesCgroup)
+	if err != nil {
+		if len(collector.cdiDevices) == 0 {
+			return &stats.NoopCollector{}, err
+		}
+		klog.V(4).Infof("Finding the GPUs of the container from its CDI annotations only: %v", err)
+	}
+	collector.minors = allowed[nvidiaMajor]
+	if capsMajor, instances, err := readMIGCapabilities(); err != nil {
+		klog.V(4).Infof("Cannot read the MIG capabilities of the NVIDIA driver, containers are given the stats of all the instances of their GPUs: %v", err)
+	} else {
+		collector.instances = map[gpuInstance]struct{}{}
+		for minor := range allowed[capsMajor] {
+			if instance, ok := instances[minor]; ok {
+				collector.instances[instance] = struct{}{}
+			}
+		}
+	}
+	if len(collector.minors) == 0 && len(collector.instances) == 0 && len(collector.cdiDevices) == 0 {
+		return &stats.NoopCollector{}, nil
+	}
+	return collector, nil
+}
+
+// allowedDevices returns the minor numbers, by major number, of the
+// character devices the devices cgroup v1 allows explicitly. Cgroups
+// allowing all devices, as that of the root and of privileged containers,
+// are not given the stats of all GPUs.
+func allowedDevices(devicesCgroup string) (map[int]map[int]struct{}, error) {
+	f, err := os.Open(filepath.Join(devicesCgroup, "devices.list"))
+	if err != nil {
+		return nil, fmt.Errorf("cannot read the devices allowed for the container: %v", err)
+	}
+	defer f.Close()
+	devices := map[int]map[int]struct{}{}
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		// e.g. "c 195:0 rwm".
+		fields := strings.Fields(scanner.Text())
+		if len(fields) != 3 || fields[0] != "c" {
+			continue
+		}
+		majorField, minorField, ok := strings.Cut(fields[1], ":")
+		if !ok {
+			continue
+		}
+		major, err := strconv.Atoi(majorField)
+		if err != nil {
+			continue
+		}
+		minor, err := strconv.Atoi(minorField)
+		if err != nil || (major == nvidiaMajor && minor > nvidiaMaxMinor) {
+			continue
+		}
+		if devices[major] == nil {
+			devices[major] = map[int]struct{}{}
+		}
+		devices[major][minor] = struct{}{}
+	}
+	return devices, scanner.Err()
+}
+
+type dcgmCollector struct {
+	stats.NoopDestroy
+	manager *dcgmManager
+	// Minors of the GPUs the devices cgroup allows.
+	minors map[int]struct{}
+	// MIG instances whose capability devices the devices cgroup allows, nil
+	// if the capabilities of the driver are unknown.
+	instances map[gpuInstance]struct{}
+	// Names of the GPUs in the CDI annotations of the container.
+	cdiDevices map[string]struct{}
+}
+
+// UpdateStats sets the stats of the GPUs of the container, as of the last
+// read of the DCGM exporter. A container using a GPU in MIG mode is given the
+// stats of the MIG instances it can access, rather than of the whole GPU.
+func (c *dcgmCollector) UpdateStats(stats *info.ContainerStats) error {
+	c.manager.mu.RLock()
+	defer c.manager.mu.RUnlock()
+	migMode := map[int]bool{}
+	for _, d := range c.manager.devices {
+		if d.stats.GPUInstance != "" {
+			migMode[d.minor] = true
+		}
+	}
+	stats.Accelerators = nil
+	for _, d := range c.manager.devices {
+		if c.uses(d, migMode[d.minor]) {
+			stats.Accelerators = append(stats.Accelerators, d.stats)
+		}
+	}
+	return nil
+}
+
+// uses returns whether the container uses the device. MIG instances are
+// found from the capability devices allowed, and failing that from the GPU.
+func (c *dcgmCollector) uses(d device, migMode bool) bool {
+	_, allowed := c.minors[d.minor]
+	named := c.cdiNames(d)
+	if !migMode {
+		return allowed || named
+	}
+	if d.stats.GPUInstance == "" {
+		return false
+	}
+	if c.instances != nil && !named {
+		_, ok := c.instances[gpuInstance{minor: d.minor, id: d.stats.GPUInstance}]
+		return ok
+	}
+	return allowed || named
+}
+
+// cdiNames returns whether the CDI annotations of the container name the
+// GPU of the device.
+func (c *dcgmCollector) cdiNames(d device) bool {
+	for _, name := range []string{cdiAll, strconv.Itoa(d.index), d.stats.ID} {
+		if _, ok := c.cdiDevices[name]; ok {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,239 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package accelerators
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	info "github.com/google/cadvisor/info/v1"
+)
+
+const (
+	a100 = "GPU-5d5ba0d6-d33d-2b2c-524d-9e3d8d2b8a77"
+	v100 = "GPU-a3b50e4f-5c1e-9a02-2b6e-01dfa4c011b4"
+)
+
+func newTestManager(t *testing.T) *dcgmManager {
+	metrics, err := os.ReadFile("testdata/dcgm_metrics")
+	require.NoError(t, err)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(metrics)
+	}))
+	t.Cleanup(server.Close)
+	m := newDcgmManager(server.URL, server.Client())
+	require.NoError(t, m.update())
+	return m
+}
+
+func writeDevicesList(t *testing.T, content string) string {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "devices.list"), []byte(content), 0644))
+	return dir
+}
+
+func TestParseDcgmMetrics(t *testing.T) {
+	m := newTestManager(t)
+	assert.Equal(t, []device{
+		{minor: 0, stats: info.AcceleratorStats{Make: "nvidia", Model: "NVIDIA A100-SXM4-40GB", ID: a100}},
+		{minor: 0, stats: info.AcceleratorStats{
+			Make:               "nvidia",
+			Model:              "NVIDIA A100-SXM4-40GB",
+			ID:                 a100,
+			GPUInstance:        "1",
+			GPUInstanceProfile: "3g.20gb",
+			MemoryTotal:        19968 * mib,
+			MemoryUsed:         10240 * mib,
+			DutyCycle:          73,
+			SMOccupancy:        41,
+		}},
+		{minor: 0, stats: info.AcceleratorStats{
+			Make:               "nvidia",
+			Model:              "NVIDIA A100-SXM4-40GB",
+			ID:                 a100,
+			GPUInstance:        "7",
+			GPUInstanceProfile: "1g.5gb",
+			MemoryTotal:        4864 * mib,
+			MemoryUsed:         512 * mib,
+			DutyCycle:          5,
+			SMOccupancy:        2,
+		}},
+		{minor: 1, index: 1, stats: info.AcceleratorStats{
+			Make:        "nvidia",
+			Model:       "Tesla V100-SXM2-16GB",
+			ID:          v100,
+			MemoryTotal: 16160 * mib,
+			MemoryUsed:  10000 * mib,
+			DutyCycle:   87,
+			SMOccupancy: 50,
+			XIDError:    48,
+		}},
+	}, m.devices)
+}
+
+// mockMIGCapabilities mocks the capabilities of the NVIDIA driver of a GPU
+// 0 with the GPU instances 1 and 7, each with a compute instance 0, or
+// without capabilities if none.
+func mockMIGCapabilities(t *testing.T, none bool) {
+	dir := t.TempDir()
+	oldDevices, oldCapabilities := procDevicesPath, nvidiaCapabilitiesPath
+	procDevicesPath = filepath.Join(dir, "devices")
+	nvidiaCapabilitiesPath = filepath.Join(dir, "capabilities")
+	t.Cleanup(func() { procDevicesPath, nvidiaCapabilitiesPath = oldDevices, oldCapabilities })
+	if none {
+		return
+	}
+	files := map[string]string{
+		"devices":                              "Character devices:\n  1 mem\n195 nvidia\n511 nvidia-caps\n\nBlock devices:\n  8 sd\n",
+		"capabilities/mig/config":              "DeviceFileMinor: 1\nDeviceFileMode: 256\n",
+		"capabilities/gpu0/mig/gi1/access":     "DeviceFileMinor: 12\nDeviceFileMode: 292\n",
+		"capabilities/gpu0/mig/gi1/ci0/access": "DeviceFileMinor: 13\nDeviceFileMode: 292\n",
+		"capabilities/gpu0/mig/gi7/access":     "DeviceFileMinor: 66\nDeviceFileMode: 292\n",
+		"capabilities/gpu0/mig/gi7/ci0/access": "DeviceFileMinor: 67\nDeviceFileMode: 292\n",
+	}
+	for name, content := range files {
+		require.NoError(t, os.MkdirAll(filepath.Dir(filepath.Join(dir, name)), 0755))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0644))
+	}
+}
+
+func TestReadMIGCapabilities(t *testing.T) {
+	mockMIGCapabilities(t, false)
+	major, instances, err := readMIGCapabilities()
+	require.NoError(t, err)
+	assert.Equal(t, 511, major)
+	assert.Equal(t, map[int]gpuInstance{
+		12: {minor: 0, id: "1"},
+		13: {minor: 0, id: "1"},
+		66: {minor: 0, id: "7"},
+		67: {minor: 0, id: "7"},
+	}, instances)
+
+	mockMIGCapabilities(t, true)
+	_, _, err = readMIGCapabilities()
+	assert.Error(t, err)
+}
+
+func TestCdiDevices(t *testing.T) {
+	assert.Equal(t, map[string]struct{}{"0": {}, v100: {}}, cdiDevices(map[string]string{
+		"cdi.k8s.io/nvidia-device-plugin": "nvidia.com/gpu=0, nvidia.com/gpu=" + v100,
+		"cdi.k8s.io/other":                "vendor.com/net=eth1",
+		"nvidia.com/gpu":                  "1",
+	}))
+	assert.Empty(t, cdiDevices(nil))
+}
+
+func TestDcgmCollector(t *testing.T) {
+	m := newTestManager(t)
+	mockMIGCapabilities(t, true)
+
+	collector, err := m.GetCollector(writeDevicesList(t, "c 195:255 rwm\nc 195:1 rw\nc 1:3 rwm\n"))
+	require.NoError(t, err)
+	var stats info.ContainerStats
+	require.NoError(t, collector.UpdateStats(&stats))
+	require.Len(t, stats.Accelerators, 1)
+	assert.Equal(t, v100, stats.Accelerators[0].ID)
+
+	// The GPU is in MIG mode, its instances are reported.
+	collector, err = m.GetCollector(writeDevicesList(t, "c 195:0 rw\n"))
+	require.NoError(t, err)
+	stats = info.ContainerStats{}
+	require.NoError(t, collector.UpdateStats(&stats))
+	require.Len(t, stats.Accelerators, 2)
+	assert.Equal(t, "1", stats.Accelerators[0].GPUInstance)
+	assert.Equal(t, "7", stats.Accelerators[1].GPUInstance)
+
+	// Containers allowed all devices are not given all GPUs.
+	collector, err = m.GetCollector(writeDevicesList(t, "a *:* rwm\n"))
+	require.NoError(t, err)
+	stats = info.ContainerStats{}
+	require.NoError(t, collector.UpdateStats(&stats))
+	assert.Empty(t, stats.Accelerators)
+
+	// The devices of cgroup v2 are not listed.
+	_, err = m.GetCollector(t.TempDir())
+	assert.Error(t, err)
+}
+
+func TestDcgmCollectorMIGInstances(t *testing.T) {
+	m := newTestManager(t)
+	mockMIGCapabilities(t, false)
+
+	// The compute instance of the GPU instance 7 is allowed.
+	collector, err := m.GetContainerCollector(&Container{DevicesCgroup: writeDevicesList(t, "c 195:0 rw\nc 195:255 rw\nc 511:67 r\n")})
+	require.NoError(t, err)
+	var stats info.ContainerStats
+	require.NoError(t, collector.UpdateStats(&stats))
+	require.Len(t, stats.Accelerators, 1)
+	assert.Equal(t, "7", stats.Accelerators[0].GPUInstance)
+	assert.Equal(t, uint64(512*mib), stats.Accelerators[0].MemoryUsed)
+
+	// The GPU without the capabilities of any of its instances.
+	collector, err = m.GetContainerCollector(&Container{DevicesCgroup: writeDevicesList(t, "c 195:0 rw\n")})
+	require.NoError(t, err)
+	stats = info.ContainerStats{}
+	require.NoError(t, collector.UpdateStats(&stats))
+	assert.Empty(t, stats.Accelerators)
+}
+
+func TestDcgmCollectorCDI(t *testing.T) {
+	m := newTestManager(t)
+	mockMIGCapabilities(t, false)
+
+	// Cgroup v2, without devices.list.
+	collector, err := m.GetContainerCollector(&Container{DevicesCgroup: t.TempDir(), Labels: map[string]string{
+		"cdi.k8s.io/nvidia-device-plugin": "nvidia.com/gpu=1",
+	}})
+	require.NoError(t, err)
+	var stats info.ContainerStats
+	require.NoError(t, collector.UpdateStats(&stats))
+	require.Len(t, stats.Accelerators, 1)
+	assert.Equal(t, v100, stats.Accelerators[0].ID)
+
+	// The GPU in MIG mode named by its UUID.
+	collector, err = m.GetContainerCollector(&Container{DevicesCgroup: t.TempDir(), Labels: map[string]string{
+		"cdi.k8s.io/nvidia-device-plugin": "nvidia.com/gpu=" + a100,
+	}})
+	require.NoError(t, err)
+	stats = info.ContainerStats{}
+	require.NoError(t, collector.UpdateStats(&stats))
+	require.Len(t, stats.Accelerators, 2)
+	assert.Equal(t, "1", stats.Accelerators[0].GPUInstance)
+	assert.Equal(t, "7", stats.Accelerators[1].GPUInstance)
+
+	collector, err = m.GetContainerCollector(&Container{DevicesCgroup: t.TempDir(), Labels: map[string]string{
+		"cdi.k8s.io/nvidia-device-plugin": "nvidia.com/gpu=all",
+	}})
+	require.NoError(t, err)
+	stats = info.ContainerStats{}
+	require.NoError(t, collector.UpdateStats(&stats))
+	assert.Len(t, stats.Accelerators, 3)
+}
+
+func TestDcgmManagerUpdateError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "unavailable", http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+	assert.Error(t, newDcgmManager(server.URL, server.Client()).update())
+}
@@ -0,0 +1,299 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux || windows || freebsd
+
+// Package accelerators reads the stats of the accelerators used by
+// containers: of the NVIDIA GPUs, and of their MIG instances, from a DCGM
+// exporter, and of the accelerators of other vendors from plugins.
+package accelerators
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"net/http"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/cadvisor/accelerators/pluginapi"
+	info "github.com/google/cadvisor/info/v1"
+	"github.com/google/cadvisor/stats"
+
+	"k8s.io/klog/v2"
+)
+
+var (
+	pluginDir     = flag.String("accelerator_plugin_dir", "", "Directory in which out-of-tree accelerator plugins serve their gRPC API on *.sock unix sockets. Empty disables them")
+	pluginTimeout = flag.Duration("accelerator_plugin_timeout", 2*time.Second, "Timeout of accelerator plugin requests")
+)
+
+// rescanInterval is how often the plugin directory is checked for plugins
+// that started or stopped.
+const rescanInterval = 10 * time.Second
+
+// Manager is a stats.Manager whose collectors are given the name and labels
+// of their container.
+type Manager interface {
+	stats.Manager
+
+	// GetContainerCollector returns a collector of the stats of the
+	// accelerators the container uses.
+	GetContainerCollector(container *Container) (stats.Collector, error)
+}
+
+// NoopManager is the Manager used when accelerator stats are not collected.
+type NoopManager struct {
+	stats.NoopManager
+}
+
+func (m *NoopManager) GetContainerCollector(*Container) (stats.Collector, error) {
+	return &stats.NoopCollector{}, nil
+}
+
+// NewManager returns a manager merging the stats of the GPUs read from the
+// DCGM exporter of dcgm_exporter_url, of the registered plugins and of the
+// plugins of accelerator_plugin_dir, or a no-op manager if there are none.
+func NewManager() Manager {
+	var managers []Manager
+	if *dcgmExporterURL != "" {
+		m := newDcgmManager(*dcgmExporterURL, &http.Client{Timeout: *dcgmInterval})
+		go m.run(*dcgmInterval)
+		managers = append(managers, m)
+	}
+	if m := newPluginManager(newPluginCollectors(), *pluginDir, pluginapi.NewClient); m != nil {
+		managers = append(managers, m)
+	}
+	switch len(managers) {
+	case 0:
+		return &NoopManager{}
+	case 1:
+		return managers[0]
+	}
+	return multiManager(managers)
+}
+
+// multiManager merges the stats of several managers.
+type multiManager []Manager
+
+func (m multiManager) Destroy() {
+	for _, manager := range m {
+		manager.Destroy()
+	}
+}
+
+func (m multiManager) GetCollector(devicesCgroup string) (stats.Collector, error) {
+	return m.GetContainerCollector(&Container{DevicesCgroup: devicesCgroup})
+}
+
+func (m multiManager) GetContainerCollector(container *Container) (stats.Collector, error) {
+	var collectors multiCollector
+	var errs []error
+	for _, manager := range m {
+		collector, err := manager.GetContainerCollector(container)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if _, ok := collector.(*stats.NoopCollector); !ok {
+			collectors = append(collectors, collector)
+		}
+	}
+	if len(collectors) == 0 {
+		return &stats.NoopCollector{}, errors.Join(errs...)
+	}
+	return collectors, nil
+}
+
+// multiCollector merges the accelerator stats of several collectors.
+type multiCollector []stats.Collector
+
+func (c multiCollector) UpdateStats(stats *info.ContainerStats) error {
+	var accelerators []info.AcceleratorStats
+	var errs []error
+	for _, collector := range c {
+		stats.Accelerators = nil
+		if err := collector.UpdateStats(stats); err != nil {
+			errs = append(errs, err)
+		}
+		accelerators = append(accelerators, stats.Accelerators...)
+	}
+	stats.Accelerators = accelerators
+	return errors.Join(errs...)
+}
+
+func (c multiCollector) Destroy() {
+	for _, collector := range c {
+		collector.Destroy()
+	}
+}
+
+// socketPlugin is an accelerator plugin serving on a socket of the plugin
+// directory.
+type socketPlugin struct {
+	name   string
+	client pluginapi.Client
+}
+
+func (p *socketPlugin) GetStats(container *Container) ([]info.AcceleratorStats, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), *pluginTimeout)
+	defer cancel()
+	return p.client.GetStats(ctx, container)
+}
+
+func (p *socketPlugin) Destroy() {
+	_ = p.client.Close()
+}
+
+// pluginManager collects the stats of the in-process plugins and of the
+// plugins of the plugin directory.
+type pluginManager struct {
+	collectors map[string]AcceleratorCollector
+
+	dir      string
+	lock     sync.Mutex
+	sockets  map[string]*socketPlugin // keyed by socket
+	lastScan time.Time
+	// newClient is replaced in tests.
+	newClient func(socket string) (pluginapi.Client, error)
+}
+
+// newPluginManager returns a manager of the collectors and of the plugins
+// of dir, or nil if there are no collectors and dir is empty.
+func newPluginManager(collectors map[string]AcceleratorCollector, dir string, newClient func(socket string) (pluginapi.Client, error)) *pluginManager {
+	if len(collectors) == 0 && dir == "" {
+		return nil
+	}
+	return &pluginManager{
+		collectors: collectors,
+		dir:        dir,
+		sockets:    map[string]*socketPlugin{},
+		newClient:  newClient,
+	}
+}
+
+// scan connects to plugins that appeared in the plugin directory and drops
+// those whose socket is gone. Plugins that fail to identify themselves are
+// retried on the next scan. Must be called with the lock held.
+func (m *pluginManager) scan() {
+	m.lastScan = time.Now()
+	sockets, err := filepath.Glob(filepath.Join(m.dir, "*.sock"))
+	if err != nil {
+		klog.Warningf("Failed to list accelerator plugins in %q: %v", m.dir, err)
+		return
+	}
+	found := make(map[string]bool, len(sockets))
+	for _, socket := range sockets {
+		found[socket] = true
+		if _, ok := m.sockets[socket]; ok {
+			continue
+		}
+		client, err := m.newClient(socket)
+		if err != nil {
+			klog.Warningf("Failed to create client for accelerator plugin %q: %v", socket, err)
+			continue
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), *pluginTimeout)
+		pluginInfo, err := client.GetPluginInfo(ctx)
+		cancel()
+		if err != nil {
+			klog.V(4).Infof("Accelerator plugin %q not ready: %v", socket, err)
+			_ = client.Close()
+			continue
+		}
+		klog.Infof("Registered accelerator plugin %s %s at %q", pluginInfo.Name, pluginInfo.Version, socket)
+		m.sockets[socket] = &socketPlugin{name: pluginInfo.Name, client: client}
+	}
+	for socket, p := range m.sockets {
+		if !found[socket] {
+			klog.Infof("Accelerator plugin %s at %q is gone", p.name, socket)
+			p.Destroy()
+			delete(m.sockets, socket)
+		}
+	}
+}
+
+type namedCollector struct {
+	name string
+	AcceleratorCollector
+}
+
+// currentCollectors returns the in-process collectors in the order of their
+// names then the plugins of the directory in the order of their sockets,
+// rescanning the directory if it is due.
+func (m *pluginManager) currentCollectors() []namedCollector {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if m.dir != "" && time.Since(m.lastScan) >= rescanInterval {
+		m.scan()
+	}
+	collectors := make([]namedCollector, 0, len(m.collectors)+len(m.sockets))
+	for name, collector := range m.collectors {
+		collectors = append(collectors, namedCollector{name, collector})
+	}
+	sort.Slice(collectors, func(i, j int) bool { return collectors[i].name < collectors[j].name })
+	sockets := make([]string, 0, len(m.sockets))
+	for socket := range m.sockets {
+		sockets = append(sockets, socket)
+	}
+	sort.Strings(sockets)
+	for _, socket := range sockets {
+		collectors = append(collectors, namedCollector{m.sockets[socket].name, m.sockets[socket]})
+	}
+	return collectors
+}
+
+func (m *pluginManager) Destroy() {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	for _, collector := range m.collectors {
+		collector.Destroy()
+	}
+	for socket, p := range m.sockets {
+		p.Destroy()
+		delete(m.sockets, socket)
+	}
+}
+
+func (m *pluginManager) GetCollector(devicesCgroup string) (stats.Collector, error) {
+	return m.GetContainerCollector(&Container{DevicesCgroup: devicesCgroup})
+}
+
+func (m *pluginManager) GetContainerCollector(container *Container) (stats.Collector, error) {
+	return &pluginCollector{manager: m, container: container}, nil
+}
+
+type pluginCollector struct {
+	stats.NoopDestroy
+	manager   *pluginManager
+	container *Container
+}
+
+// UpdateStats sets the stats of the accelerators of the container from all
+// the plugins. Plugins failing are skipped, so as not to lose the stats of
+// the others.
+func (c *pluginCollector) UpdateStats(stats *info.ContainerStats) error {
+	stats.Accelerators = nil
+	for _, collector := range c.manager.currentCollectors() {
+		accelerators, err := collector.GetStats(c.container)
+		if err != nil {
+			klog.V(4).Infof("Accelerator plugin %s failed to get the stats of %q: %v", collector.name, c.container.Name, err)
+			continue
+		}
+		stats.Accelerators = append(stats.Accelerators, accelerators...)
+	}
+	return nil
+}
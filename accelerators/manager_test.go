@@ -0,0 +1,132 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package accelerators
+
+import (
+	"context"
+	"errors"
+	"net"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/google/cadvisor/accelerators/pluginapi"
+	info "github.com/google/cadvisor/info/v1"
+	"github.com/google/cadvisor/stats"
+)
+
+// fakeCollector reports a TPU for the containers labeled with it.
+type fakeCollector struct {
+	err       error
+	destroyed bool
+}
+
+func (c *fakeCollector) GetStats(container *Container) ([]info.AcceleratorStats, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	if container.Labels["tpu"] == "" {
+		return nil, nil
+	}
+	return []info.AcceleratorStats{{Make: "tpu", ID: container.Labels["tpu"], DutyCycle: 10}}, nil
+}
+
+func (c *fakeCollector) Destroy() {
+	c.destroyed = true
+}
+
+type fakePlugin struct {
+	collector AcceleratorCollector
+	err       error
+}
+
+func (p *fakePlugin) NewCollector() (AcceleratorCollector, error) {
+	return p.collector, p.err
+}
+
+// fakeServer is an accelerator plugin reporting an NPU for every container.
+type fakeServer struct{}
+
+func (fakeServer) GetPluginInfo(ctx context.Context) (*pluginapi.PluginInfo, error) {
+	return &pluginapi.PluginInfo{Name: "npu", Version: "1.0"}, nil
+}
+
+func (fakeServer) GetStats(ctx context.Context, container *pluginapi.Container) ([]info.AcceleratorStats, error) {
+	return []info.AcceleratorStats{{Make: "npu", ID: "npu-" + container.Name}}, nil
+}
+
+func TestRegisterPlugin(t *testing.T) {
+	defer func() { plugins = map[string]AcceleratorPlugin{} }()
+	collector := &fakeCollector{}
+	require.NoError(t, RegisterPlugin("tpu", &fakePlugin{collector: collector}))
+	require.NoError(t, RegisterPlugin("fpga", &fakePlugin{err: errors.New("no FPGA")}))
+	assert.Error(t, RegisterPlugin("tpu", &fakePlugin{}))
+
+	assert.Equal(t, map[string]AcceleratorCollector{"tpu": collector}, newPluginCollectors())
+}
+
+func TestPluginManager(t *testing.T) {
+	dir := t.TempDir()
+	listener, err := net.Listen("unix", filepath.Join(dir, "npu.sock"))
+	require.NoError(t, err)
+	server := pluginapi.NewServer(fakeServer{})
+	go func() { _ = server.Serve(listener) }()
+	defer server.Stop()
+
+	tpu, failing := &fakeCollector{}, &fakeCollector{err: errors.New("failed")}
+	m := newPluginManager(map[string]AcceleratorCollector{"tpu": tpu, "failing": failing}, dir, pluginapi.NewClient)
+	require.NotNil(t, m)
+
+	collector, err := m.GetContainerCollector(&Container{Name: "/a", Labels: map[string]string{"tpu": "tpu-1"}})
+	require.NoError(t, err)
+	stats := info.ContainerStats{}
+	require.NoError(t, collector.UpdateStats(&stats))
+	assert.Equal(t, []info.AcceleratorStats{
+		{Make: "tpu", ID: "tpu-1", DutyCycle: 10},
+		{Make: "npu", ID: "npu-/a"},
+	}, stats.Accelerators)
+
+	m.Destroy()
+	assert.True(t, tpu.destroyed)
+	assert.Empty(t, m.sockets)
+
+	assert.Nil(t, newPluginManager(nil, "", pluginapi.NewClient))
+}
+
+func TestMultiManager(t *testing.T) {
+	dcgm := newTestManager(t)
+	mockMIGCapabilities(t, true)
+	m := multiManager{dcgm, newPluginManager(map[string]AcceleratorCollector{"tpu": &fakeCollector{}}, "", nil)}
+
+	collector, err := m.GetContainerCollector(&Container{
+		DevicesCgroup: writeDevicesList(t, "c 195:1 rw\n"),
+		Labels:        map[string]string{"tpu": "tpu-1"},
+	})
+	require.NoError(t, err)
+	containerStats := info.ContainerStats{}
+	require.NoError(t, collector.UpdateStats(&containerStats))
+	require.Len(t, containerStats.Accelerators, 2)
+	assert.Equal(t, v100, containerStats.Accelerators[0].ID)
+	assert.Equal(t, "tpu-1", containerStats.Accelerators[1].ID)
+
+	// Collectors of the managers failing are left out.
+	collector, err = multiManager{dcgm}.GetContainerCollector(&Container{DevicesCgroup: t.TempDir()})
+	assert.Error(t, err)
+	assert.IsType(t, &stats.NoopCollector{}, collector)
+}
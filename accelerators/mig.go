@@ -0,0 +1,156 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux || windows || freebsd
+
+package accelerators
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"k8s.io/klog/v2"
+)
+
+var (
+	procDevicesPath        = "/proc/devices"
+	nvidiaCapabilitiesPath = "/proc/driver/nvidia/capabilities"
+)
+
+const (
+	// Name of the character devices of the capabilities of the NVIDIA driver
+	// in /proc/devices, /dev/nvidia-caps/nvidia-cap<minor>.
+	nvidiaCapsDevice = "nvidia-caps"
+
+	// Prefix of the CDI annotations of containers, and kind of the NVIDIA
+	// GPUs in CDI device names, e.g. nvidia.com/gpu=0.
+	cdiAnnotationPrefix = "cdi.k8s.io/"
+	cdiGPUKind          = "nvidia.com/gpu"
+	// CDI device name of all the GPUs.
+	cdiAll = "all"
+)
+
+// gpuInstance is a MIG GPU instance of the GPU of a minor number.
+type gpuInstance struct {
+	minor int
+	id    string
+}
+
+// readMIGCapabilities returns the major number of the capability devices of
+// the NVIDIA driver, and the MIG GPU instances given access to by the minor
+// numbers of the devices. Access to a compute instance gives access to its
+// GPU instance, the unit the DCGM exporter reports stats of.
+func readMIGCapabilities() (int, map[int]gpuInstance, error) {
+	major, err := characterDeviceMajor(nvidiaCapsDevice)
+	if err != nil {
+		return 0, nil, err
+	}
+	instances := map[int]gpuInstance{}
+	// e.g. gpu0/mig/gi1/access and gpu0/mig/gi1/ci0/access.
+	for _, pattern := range []string{"gpu*/mig/gi*/access", "gpu*/mig/gi*/ci*/access"} {
+		files, err := filepath.Glob(filepath.Join(nvidiaCapabilitiesPath, pattern))
+		if err != nil {
+			return 0, nil, err
+		}
+		for _, file := range files {
+			relative, err := filepath.Rel(nvidiaCapabilitiesPath, file)
+			if err != nil {
+				return 0, nil, err
+			}
+			parts := strings.Split(relative, string(filepath.Separator))
+			gpu, err := strconv.Atoi(strings.TrimPrefix(parts[0], "gpu"))
+			if err != nil {
+				continue
+			}
+			minor, err := readDeviceFileMinor(file)
+			if err != nil {
+				klog.V(4).Infof("Cannot read the capability of %q: %v", file, err)
+				continue
+			}
+			instances[minor] = gpuInstance{minor: gpu, id: strings.TrimPrefix(parts[2], "gi")}
+		}
+	}
+	return major, instances, nil
+}
+
+// characterDeviceMajor returns the major number of the character devices of
+// the name in /proc/devices.
+func characterDeviceMajor(name string) (int, error) {
+	f, err := os.Open(procDevicesPath)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+	character := false
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case line == "Character devices:":
+			character = true
+		case line == "Block devices:":
+			character = false
+		case character:
+			// e.g. "511 nvidia-caps".
+			fields := strings.Fields(line)
+			if len(fields) == 2 && fields[1] == name {
+				return strconv.Atoi(fields[0])
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return 0, err
+	}
+	return 0, fmt.Errorf("no %s character devices", name)
+}
+
+// readDeviceFileMinor returns the minor number of the device of a capability
+// of the NVIDIA driver, from its "DeviceFileMinor: <minor>" line.
+func readDeviceFileMinor(file string) (int, error) {
+	content, err := os.ReadFile(file)
+	if err != nil {
+		return 0, err
+	}
+	for _, line := range strings.Split(string(content), "\n") {
+		if value, ok := strings.CutPrefix(line, "DeviceFileMinor:"); ok {
+			return strconv.Atoi(strings.TrimSpace(value))
+		}
+	}
+	return 0, fmt.Errorf("no DeviceFileMinor")
+}
+
+// cdiDevices returns the names of the NVIDIA GPUs in the CDI annotations of
+// the labels of a container, e.g. "0", "all" or the UUID of a GPU from
+// cdi.k8s.io/<name>=nvidia.com/gpu=0,nvidia.com/gpu=GPU-<uuid>. MIG devices
+// are named by UUIDs the DCGM exporter does not report, so are not matched.
+func cdiDevices(labels map[string]string) map[string]struct{} {
+	devices := map[string]struct{}{}
+	for key, value := range labels {
+		if !strings.HasPrefix(key, cdiAnnotationPrefix) {
+			continue
+		}
+		for _, device := range strings.Split(value, ",") {
+			kind, name, ok := strings.Cut(strings.TrimSpace(device), "=")
+			if !ok || kind != cdiGPUKind {
+				continue
+			}
+			devices[name] = struct{}{}
+		}
+	}
+	return devices
+}
@@ -0,0 +1,89 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package accelerators
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/google/cadvisor/accelerators/pluginapi"
+	info "github.com/google/cadvisor/info/v1"
+
+	"k8s.io/klog/v2"
+)
+
+// Container is a container whose accelerators are collected.
+type Container = pluginapi.Container
+
+// AcceleratorCollector is implemented by vendors to supply the stats of their
+// accelerators, e.g. TPUs, NPUs or FPGAs, used by containers. The stats are
+// merged with those of the other vendors into the stats of the containers.
+type AcceleratorCollector interface {
+	// GetStats returns the stats of the accelerators of the vendor the
+	// container uses, with their Make set to the vendor, none if it uses
+	// none. It is called at every update of the stats of the container.
+	GetStats(container *Container) ([]info.AcceleratorStats, error)
+
+	// Destroy releases the resources of the collector when cAdvisor stops.
+	Destroy()
+}
+
+// AcceleratorPlugin creates the AcceleratorCollector of a vendor.
+type AcceleratorPlugin interface {
+	// NewCollector returns the collector, or an error if the accelerators
+	// of the vendor are not available on the machine.
+	NewCollector() (AcceleratorCollector, error)
+}
+
+// All registered accelerator plugins.
+var pluginsLock sync.Mutex
+var plugins = make(map[string]AcceleratorPlugin)
+
+// RegisterPlugin registers the in-process plugin of a vendor, typically from
+// the init function of its package.
+func RegisterPlugin(name string, plugin AcceleratorPlugin) error {
+	pluginsLock.Lock()
+	defer pluginsLock.Unlock()
+	if _, found := plugins[name]; found {
+		return fmt.Errorf("AcceleratorPlugin %q was registered twice", name)
+	}
+	klog.V(4).Infof("Registered AcceleratorPlugin %q", name)
+	plugins[name] = plugin
+	return nil
+}
+
+// newPluginCollectors returns the collectors of the registered plugins, by
+// name. Plugins whose accelerators are not available are skipped.
+func newPluginCollectors() map[string]AcceleratorCollector {
+	pluginsLock.Lock()
+	defer pluginsLock.Unlock()
+	names := make([]string, 0, len(plugins))
+	for name := range plugins {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	collectors := map[string]AcceleratorCollector{}
+	for _, name := range names {
+		collector, err := plugins[name].NewCollector()
+		if err != nil {
+			klog.V(4).Infof("Accelerator stats of plugin %q will not be available: %v", name, err)
+			continue
+		}
+		klog.V(1).Infof("Collecting accelerator stats of plugin %q", name)
+		collectors[name] = collector
+	}
+	return collectors
+}
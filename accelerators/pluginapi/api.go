@@ -0,0 +1,133 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package pluginapi is the API between cAdvisor and out-of-tree accelerator
+// plugins defined in plugin.proto, with a client for cAdvisor and a server
+// for plugins written in Go. Messages are encoded by hand to keep plugins
+// free of generated code.
+package pluginapi
+
+import "github.com/google/cadvisor/utils/grpcwire"
+
+// ServiceName is the full name of the AcceleratorPlugin service.
+const ServiceName = "cadvisor.accelerator.v1alpha1.AcceleratorPlugin"
+
+// PluginInfo identifies a plugin.
+type PluginInfo struct {
+	Name    string
+	Version string
+}
+
+// Container is a container whose accelerators are asked for.
+type Container struct {
+	// The cgroup name, e.g. "/kubepods/pod1234/abcd".
+	Name string
+	// Path of the devices cgroup of the container, whose devices.list lists
+	// the devices the container may use on cgroup v1.
+	DevicesCgroup string
+	Labels        map[string]string
+}
+
+// message is implemented by all messages, which plugins and cAdvisor both
+// encode and decode.
+type message interface {
+	grpcwire.Marshaler
+	grpcwire.Unmarshaler
+}
+
+type getPluginInfoRequest struct{}
+
+func (m *getPluginInfoRequest) Marshal() []byte { return nil }
+
+func (m *getPluginInfoRequest) Unmarshal(b []byte) error {
+	_, err := grpcwire.ParseFields(b)
+	return err
+}
+
+type getPluginInfoResponse struct {
+	info PluginInfo
+}
+
+func (m *getPluginInfoResponse) Marshal() []byte {
+	b := grpcwire.AppendString(nil, 1, m.info.Name)
+	return grpcwire.AppendString(b, 2, m.info.Version)
+}
+
+func (m *getPluginInfoResponse) Unmarshal(b []byte) error {
+	fields, err := grpcwire.ParseFields(b)
+	if err != nil {
+		return err
+	}
+	for _, f := range fields {
+		switch f.Num {
+		case 1:
+			m.info.Name = string(f.Bytes)
+		case 2:
+			m.info.Version = string(f.Bytes)
+		}
+	}
+	return nil
+}
+
+type getStatsRequest struct {
+	container Container
+}
+
+func (m *getStatsRequest) Marshal() []byte {
+	b := grpcwire.AppendString(nil, 1, m.container.Name)
+	b = grpcwire.AppendString(b, 2, m.container.DevicesCgroup)
+	return grpcwire.AppendMap(b, 3, m.container.Labels)
+}
+
+func (m *getStatsRequest) Unmarshal(b []byte) error {
+	fields, err := grpcwire.ParseFields(b)
+	if err != nil {
+		return err
+	}
+	m.container.Labels = map[string]string{}
+	for _, f := range fields {
+		switch f.Num {
+		case 1:
+			m.container.Name = string(f.Bytes)
+		case 2:
+			m.container.DevicesCgroup = string(f.Bytes)
+		case 3:
+			if err := grpcwire.ParseMapEntry(f.Bytes, m.container.Labels); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+type getStatsResponse struct {
+	statsJSON []byte
+}
+
+func (m *getStatsResponse) Marshal() []byte {
+	return grpcwire.AppendBytes(nil, 1, m.statsJSON)
+}
+
+func (m *getStatsResponse) Unmarshal(b []byte) error {
+	fields, err := grpcwire.ParseFields(b)
+	if err != nil {
+		return err
+	}
+	for _, f := range fields {
+		if f.Num == 1 {
+			m.statsJSON = append([]byte(nil), f.Bytes...)
+		}
+	}
+	return nil
+}
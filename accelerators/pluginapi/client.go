@@ -0,0 +1,85 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pluginapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	info "github.com/google/cadvisor/info/v1"
+	"github.com/google/cadvisor/utils/grpcwire"
+)
+
+const maxMsgSize = 16 * 1024 * 1024 // 16MB
+
+const methodPrefix = "/" + ServiceName + "/"
+
+// Client talks to an accelerator plugin.
+type Client interface {
+	GetPluginInfo(ctx context.Context) (*PluginInfo, error)
+	GetStats(ctx context.Context, container *Container) ([]info.AcceleratorStats, error)
+	Close() error
+}
+
+type client struct {
+	conn *grpc.ClientConn
+}
+
+// NewClient returns a Client for the plugin listening on the unix socket.
+// The connection is established lazily.
+func NewClient(socket string) (Client, error) {
+	conn, err := grpc.NewClient("unix://"+socket,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultCallOptions(
+			grpc.MaxCallRecvMsgSize(maxMsgSize),
+			grpc.ForceCodec(grpcwire.Codec{}),
+		),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create plugin client for %q: %v", socket, err)
+	}
+	return &client{conn: conn}, nil
+}
+
+func (c *client) GetPluginInfo(ctx context.Context) (*PluginInfo, error) {
+	resp := &getPluginInfoResponse{}
+	if err := c.conn.Invoke(ctx, methodPrefix+"GetPluginInfo", &getPluginInfoRequest{}, resp); err != nil {
+		return nil, err
+	}
+	return &resp.info, nil
+}
+
+func (c *client) GetStats(ctx context.Context, container *Container) ([]info.AcceleratorStats, error) {
+	resp := &getStatsResponse{}
+	if err := c.conn.Invoke(ctx, methodPrefix+"GetStats", &getStatsRequest{container: *container}, resp); err != nil {
+		return nil, err
+	}
+	if len(resp.statsJSON) == 0 {
+		return nil, nil
+	}
+	var stats []info.AcceleratorStats
+	if err := json.Unmarshal(resp.statsJSON, &stats); err != nil {
+		return nil, fmt.Errorf("failed to decode accelerator stats of %q: %v", container.Name, err)
+	}
+	return stats, nil
+}
+
+func (c *client) Close() error {
+	return c.conn.Close()
+}
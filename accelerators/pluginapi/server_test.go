@@ -0,0 +1,83 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pluginapi
+
+import (
+	"context"
+	"net"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	info "github.com/google/cadvisor/info/v1"
+)
+
+type fakeServer struct{}
+
+func (fakeServer) GetPluginInfo(ctx context.Context) (*PluginInfo, error) {
+	return &PluginInfo{Name: "fake-npu", Version: "1.0"}, nil
+}
+
+func (fakeServer) GetStats(ctx context.Context, container *Container) ([]info.AcceleratorStats, error) {
+	switch {
+	case container.Name == "/unknown":
+		return nil, status.Errorf(codes.NotFound, "no container %q", container.Name)
+	case container.Labels["npu"] == "" || container.DevicesCgroup == "":
+		return nil, nil
+	}
+	return []info.AcceleratorStats{{
+		Make:        "fake",
+		ID:          container.Labels["npu"],
+		MemoryTotal: 1 << 30,
+		DutyCycle:   40,
+	}}, nil
+}
+
+func TestClientServer(t *testing.T) {
+	socket := filepath.Join(t.TempDir(), "fake.sock")
+	listener, err := net.Listen("unix", socket)
+	require.NoError(t, err)
+	server := NewServer(fakeServer{})
+	go func() { _ = server.Serve(listener) }()
+	defer server.Stop()
+
+	c, err := NewClient(socket)
+	require.NoError(t, err)
+	defer c.Close()
+	ctx := context.Background()
+
+	pluginInfo, err := c.GetPluginInfo(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, &PluginInfo{Name: "fake-npu", Version: "1.0"}, pluginInfo)
+
+	stats, err := c.GetStats(ctx, &Container{
+		Name:          "/kubepods/abc",
+		DevicesCgroup: "/sys/fs/cgroup/devices/kubepods/abc",
+		Labels:        map[string]string{"npu": "npu-0", "app": "web"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []info.AcceleratorStats{{Make: "fake", ID: "npu-0", MemoryTotal: 1 << 30, DutyCycle: 40}}, stats)
+
+	stats, err = c.GetStats(ctx, &Container{Name: "/kubepods/def"})
+	assert.NoError(t, err)
+	assert.Empty(t, stats)
+
+	_, err = c.GetStats(ctx, &Container{Name: "/unknown"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
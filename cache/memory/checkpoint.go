@@ -0,0 +1,176 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memory
+
+import (
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	info "github.com/google/cadvisor/info/v1"
+)
+
+// checkpointVersion is bumped on incompatible changes of the checkpoint format.
+const checkpointVersion = 1
+
+// checkpoint is the gzip-compressed JSON document a cache is saved as.
+type checkpoint struct {
+	Version    int                   `json:"version"`
+	Timestamp  time.Time             `json:"timestamp"`
+	Containers []checkpointContainer `json:"containers"`
+}
+
+type checkpointContainer struct {
+	Reference info.ContainerReference `json:"reference"`
+	// Stats of all tiers, in timestamp order.
+	Stats []*info.ContainerStats `json:"stats"`
+}
+
+// WriteCheckpoint saves the stats of all containers to w, so that a later
+// instance can pick up where this one left off with ReadCheckpoint.
+func (c *InMemoryCache) WriteCheckpoint(w io.Writer) error {
+	cp := checkpoint{
+		Version:   checkpointVersion,
+		Timestamp: time.Now(),
+	}
+	c.containerCacheMap.m.Range(func(_, value any) bool {
+		cstore := value.(*containerCache)
+		stats, _ := cstore.RecentStats(time.Time{}, time.Time{}, -1)
+		if len(stats) > 0 {
+			cp.Containers = append(cp.Containers, checkpointContainer{
+				Reference: cstore.ref,
+				Stats:     stats,
+			})
+		}
+		return true
+	})
+	sort.Slice(cp.Containers, func(i, j int) bool {
+		return cp.Containers[i].Reference.Name < cp.Containers[j].Reference.Name
+	})
+
+	gz := gzip.NewWriter(w)
+	if err := json.NewEncoder(gz).Encode(&cp); err != nil {
+		return err
+	}
+	return gz.Close()
+}
+
+// ReadCheckpoint restores the stats saved by WriteCheckpoint into the cache,
+// and returns the number of containers restored. Stats older than the cache
+// keeps them for are dropped. Restored stats are not pushed to the storage
+// drivers, they got them the first time around.
+func (c *InMemoryCache) ReadCheckpoint(r io.Reader) (int, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return 0, fmt.Errorf("invalid checkpoint: %v", err)
+	}
+	defer gz.Close()
+	var cp checkpoint
+	if err := json.NewDecoder(gz).Decode(&cp); err != nil {
+		return 0, fmt.Errorf("invalid checkpoint: %v", err)
+	}
+	if cp.Version != checkpointVersion {
+		return 0, fmt.Errorf("unsupported checkpoint version %d, want %d", cp.Version, checkpointVersion)
+	}
+
+	cutoff := time.Now().Add(-c.retention())
+	restored := 0
+	for _, container := range cp.Containers {
+		stats := container.Stats
+		sort.SliceStable(stats, func(i, j int) bool {
+			return stats[i].Timestamp.Before(stats[j].Timestamp)
+		})
+		first := sort.Search(len(stats), func(i int) bool {
+			return !stats[i].Timestamp.Before(cutoff)
+		})
+		if first == len(stats) {
+			continue
+		}
+		name := container.Reference.Name
+		cstore, ok := c.containerCacheMap.Load(name)
+		if !ok {
+			newStore := newContainerStore(container.Reference, c.maxAge, int(c.maxStats.Load()), c.tiers)
+			cstore, _ = c.containerCacheMap.LoadOrStore(name, newStore)
+		}
+		for _, stat := range stats[first:] {
+			if err := cstore.AddStats(stat); err != nil {
+				return restored, err
+			}
+		}
+		restored++
+	}
+	return restored, nil
+}
+
+// WriteCheckpointFile saves a checkpoint to path. The checkpoint is written
+// to a temporary file first, so a crash never leaves a truncated one behind.
+func (c *InMemoryCache) WriteCheckpointFile(path string) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".tmp*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if err := c.WriteCheckpoint(tmp); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write checkpoint %q: %v", path, err)
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// ReadCheckpointFile restores the checkpoint at path, if there is one.
+func (c *InMemoryCache) ReadCheckpointFile(path string) (int, error) {
+	f, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+	n, err := c.ReadCheckpoint(f)
+	if err != nil {
+		return n, fmt.Errorf("failed to read checkpoint %q: %v", path, err)
+	}
+	return n, nil
+}
+
+// ContainerNames returns the names of all containers in the cache.
+func (c *InMemoryCache) ContainerNames() []string {
+	var names []string
+	c.containerCacheMap.m.Range(func(key, _ any) bool {
+		names = append(names, key.(string))
+		return true
+	})
+	sort.Strings(names)
+	return names
+}
+
+// retention returns how long the cache keeps stats for, the longest of
+// maxAge and the retention of the tiers.
+func (c *InMemoryCache) retention() time.Duration {
+	retention := c.maxAge
+	for _, tier := range c.tiers {
+		retention = max(retention, tier.Retention)
+	}
+	return retention
+}
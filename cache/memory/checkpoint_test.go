@@ -0,0 +1,145 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memory
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	info "github.com/google/cadvisor/info/v1"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func statAt(ts time.Time, i int) *info.ContainerStats {
+	return &info.ContainerStats{
+		Timestamp: ts,
+		Cpu: info.CpuStats{
+			LoadAverage: int32(i),
+		},
+	}
+}
+
+func TestCheckpointRoundTrip(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	original := New(time.Minute, nil)
+	other := info.ContainerInfo{
+		ContainerReference: info.ContainerReference{Name: "/other", Aliases: []string{"alias"}},
+	}
+	for i := 0; i < 3; i++ {
+		require.NoError(t, original.AddStats(&cInfo, statAt(now.Add(time.Duration(i-3)*time.Second), i)))
+	}
+	require.NoError(t, original.AddStats(&other, statAt(now, 7)))
+
+	var buf bytes.Buffer
+	require.NoError(t, original.WriteCheckpoint(&buf))
+
+	restored := New(time.Minute, nil)
+	n, err := restored.ReadCheckpoint(&buf)
+	require.NoError(t, err)
+	assert.Equal(t, 2, n)
+	assert.Equal(t, []string{containerName, "/other"}, restored.ContainerNames())
+
+	stats := getRecentStats(t, restored, -1)
+	require.Len(t, stats, 3)
+	for i, stat := range stats {
+		assert.Equal(t, int32(i), stat.Cpu.LoadAverage)
+		assert.True(t, now.Add(time.Duration(i-3)*time.Second).Equal(stat.Timestamp))
+	}
+	otherStats, err := restored.RecentStats("/other", zero, zero, -1)
+	require.NoError(t, err)
+	require.Len(t, otherStats, 1)
+	assert.Equal(t, int32(7), otherStats[0].Cpu.LoadAverage)
+	assert.Equal(t, other.ContainerReference, containerRef(t, restored, "/other"))
+}
+
+func TestCheckpointDropsExpiredStats(t *testing.T) {
+	now := time.Now()
+	original := New(time.Hour, nil)
+	require.NoError(t, original.AddStats(&cInfo, statAt(now.Add(-2*time.Minute), 0)))
+	require.NoError(t, original.AddStats(&cInfo, statAt(now, 1)))
+	expired := info.ContainerInfo{ContainerReference: info.ContainerReference{Name: "/expired"}}
+	require.NoError(t, original.AddStats(&expired, statAt(now.Add(-2*time.Minute), 2)))
+
+	var buf bytes.Buffer
+	require.NoError(t, original.WriteCheckpoint(&buf))
+
+	restored := New(time.Minute, nil)
+	n, err := restored.ReadCheckpoint(&buf)
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+	stats := getRecentStats(t, restored, -1)
+	require.Len(t, stats, 1)
+	assert.Equal(t, int32(1), stats[0].Cpu.LoadAverage)
+	assert.Equal(t, []string{containerName}, restored.ContainerNames())
+}
+
+func TestCheckpointTiers(t *testing.T) {
+	now := time.Now().Truncate(time.Minute)
+	tiers := []Tier{{Resolution: time.Minute, Retention: time.Hour}}
+	original := NewTiered(10*time.Second, -1, tiers, nil)
+	for i := 0; i < 30; i++ {
+		require.NoError(t, original.AddStats(&cInfo, statAt(now.Add(time.Duration(i-30)*10*time.Second), i)))
+	}
+	want := getRecentStats(t, original, -1)
+
+	var buf bytes.Buffer
+	require.NoError(t, original.WriteCheckpoint(&buf))
+	restored := NewTiered(10*time.Second, -1, tiers, nil)
+	_, err := restored.ReadCheckpoint(&buf)
+	require.NoError(t, err)
+
+	got := getRecentStats(t, restored, -1)
+	require.Len(t, got, len(want))
+	for i := range want {
+		assert.True(t, want[i].Timestamp.Equal(got[i].Timestamp))
+	}
+}
+
+func TestReadCheckpointInvalid(t *testing.T) {
+	_, err := New(time.Minute, nil).ReadCheckpoint(bytes.NewBufferString("not a checkpoint"))
+	assert.Error(t, err)
+}
+
+func TestCheckpointFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "checkpoint")
+	cache := New(time.Minute, nil)
+
+	// A missing checkpoint is not an error, there is just nothing to restore.
+	n, err := cache.ReadCheckpointFile(path)
+	require.NoError(t, err)
+	assert.Zero(t, n)
+
+	require.NoError(t, cache.AddStats(&cInfo, statAt(time.Now(), 1)))
+	require.NoError(t, cache.WriteCheckpointFile(path))
+	entries, err := os.ReadDir(filepath.Dir(path))
+	require.NoError(t, err)
+	assert.Len(t, entries, 1, "temporary file left behind")
+
+	restored := New(time.Minute, nil)
+	n, err = restored.ReadCheckpointFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+}
+
+func containerRef(t *testing.T, c *InMemoryCache, name string) info.ContainerReference {
+	cstore, ok := c.containerCacheMap.Load(name)
+	require.True(t, ok)
+	return cstore.ref
+}
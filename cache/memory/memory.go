@@ -17,6 +17,7 @@ package memory
 import (
 	"errors"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	info "github.com/google/cadvisor/info/v1"
@@ -62,12 +63,17 @@ func (c *containerCacheMap) Delete(name string) {
 }
 
 // TODO(vmarmol): See about refactoring this class, we have an unnecessary redirection of containerCache and InMemoryCache.
-// containerCache is used to store per-container information
+// containerCache is used to store per-container information. The stats are
+// copied into the slots of the rings, which are reused as stats are evicted,
+// so readers get either copies or, under the lock, the stats in the slots.
 type containerCache struct {
 	ref         info.ContainerReference
-	recentStats *utils.TimedStore
+	recentStats *utils.TimedRing[info.ContainerStats]
 	maxAge      time.Duration
 	lock        sync.RWMutex
+
+	// Downsampled stats older than maxAge, from the finest to the coarsest.
+	tiers []*tierStore
 }
 
 func (c *containerCache) AddStats(stats *info.ContainerStats) error {
@@ -75,43 +81,122 @@ func (c *containerCache) AddStats(stats *info.ContainerStats) error {
 	defer c.lock.Unlock()
 
 	// Add the stat to storage.
-	c.recentStats.Add(stats.Timestamp, stats)
+	c.recentStats.Add(stats.Timestamp, *stats)
+	for _, tier := range c.tiers {
+		tier.add(stats)
+	}
 	return nil
 }
 
 func (c *containerCache) RecentStats(start, end time.Time, maxStats int) ([]*info.ContainerStats, error) {
 	c.lock.RLock()
 	defer c.lock.RUnlock()
-	result := c.recentStats.InTimeRange(start, end, maxStats)
-	converted := make([]*info.ContainerStats, len(result))
-	for i, el := range result {
-		converted[i] = el.(*info.ContainerStats)
+	return copyStats(c.appendRefs(nil, start, end, maxStats)), nil
+}
+
+func (c *containerCache) ViewRecentStats(dst []*info.ContainerStats, start, end time.Time, maxStats int, view func([]*info.ContainerStats)) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	view(c.appendRefs(dst, start, end, maxStats))
+}
+
+// appendRefs appends the stats in the specified time period, in the slots of
+// the rings, to dst. They are only valid while the lock is held.
+func (c *containerCache) appendRefs(dst []*info.ContainerStats, start, end time.Time, maxStats int) []*info.ContainerStats {
+	if len(c.tiers) == 0 {
+		return c.recentStats.AppendRefsInTimeRange(dst, start, end, maxStats)
+	}
+	return c.appendTieredStats(dst, start, end, maxStats)
+}
+
+// copyStats returns copies of stats, all in a single allocation.
+func copyStats(stats []*info.ContainerStats) []*info.ContainerStats {
+	values := make([]info.ContainerStats, len(stats))
+	ret := make([]*info.ContainerStats, len(stats))
+	for i, s := range stats {
+		values[i] = *s
+		ret[i] = &values[i]
+	}
+	return ret
+}
+
+// appendTieredStats appends the stats in the specified time period from all
+// tiers, in timestamp order. Each tier only contributes stats older than the
+// ones of the finer tiers, so the result gets coarser the further back it goes.
+func (c *containerCache) appendTieredStats(dst []*info.ContainerStats, start, end time.Time, maxStats int) []*info.ContainerStats {
+	// Find which part of the time period each tier serves, from the oldest.
+	stores := make([]*utils.TimedRing[info.ContainerStats], 0, len(c.tiers)+1)
+	ends := make([]time.Time, 0, len(c.tiers)+1)
+	stores = append(stores, c.recentStats)
+	ends = append(ends, end)
+	boundary, ok := c.recentStats.OldestTimestamp()
+	for _, tier := range c.tiers {
+		tierEnd := end
+		if ok && (end.IsZero() || !end.Before(boundary)) {
+			tierEnd = boundary.Add(-time.Nanosecond)
+		}
+		stores = append(stores, tier.stats)
+		ends = append(ends, tierEnd)
+		if tierOldest, tierOk := tier.stats.OldestTimestamp(); tierOk && (!ok || tierOldest.Before(boundary)) {
+			boundary, ok = tierOldest, true
+		}
 	}
-	return converted, nil
+
+	// Take the latest maxStats stats, starting from the finest tier.
+	limits := make([]int, len(stores))
+	remaining := maxStats
+	for i, store := range stores {
+		limits[i] = store.CountInTimeRange(start, ends[i], remaining)
+		if remaining != -1 {
+			remaining -= limits[i]
+		}
+	}
+	for i := len(stores) - 1; i >= 0; i-- {
+		if limits[i] > 0 {
+			dst = stores[i].AppendRefsInTimeRange(dst, start, ends[i], limits[i])
+		}
+	}
+	return dst
 }
 
-func newContainerStore(ref info.ContainerReference, maxAge time.Duration) *containerCache {
-	return &containerCache{
-		ref:         ref,
-		recentStats: utils.NewTimedStore(maxAge, -1),
-		maxAge:      maxAge,
+// newContainerStore returns the store of a container. With a positive
+// maxStats, the slots of its recent stats are all allocated up front.
+func newContainerStore(ref info.ContainerReference, maxAge time.Duration, maxStats int, tiers []Tier) *containerCache {
+	cache := &containerCache{
+		ref:    ref,
+		maxAge: maxAge,
+	}
+	if maxStats > 0 {
+		cache.recentStats = utils.NewFixedTimedRing[info.ContainerStats](maxAge, maxStats)
+	} else {
+		cache.recentStats = utils.NewTimedRing[info.ContainerStats](maxAge, -1)
+	}
+	for _, tier := range tiers {
+		cache.tiers = append(cache.tiers, newTierStore(tier))
 	}
+	return cache
 }
 
 type InMemoryCache struct {
 	containerCacheMap containerCacheMap
 	maxAge            time.Duration
+	maxStats          atomic.Int64
+	tiers             []Tier
+	backendLock       sync.RWMutex // protects backend
 	backend           []storage.StorageDriver
+	watchLock         sync.Mutex // protects watches
+	watches           map[*statsWatch]struct{}
 }
 
 func (c *InMemoryCache) AddStats(cInfo *info.ContainerInfo, stats *info.ContainerStats) error {
 	name := cInfo.ContainerReference.Name
 	cstore, ok := c.containerCacheMap.Load(name)
 	if !ok {
-		newStore := newContainerStore(cInfo.ContainerReference, c.maxAge)
+		newStore := newContainerStore(cInfo.ContainerReference, c.maxAge, int(c.maxStats.Load()), c.tiers)
 		cstore, _ = c.containerCacheMap.LoadOrStore(name, newStore)
 	}
 
+	c.backendLock.RLock()
 	for _, backend := range c.backend {
 		// TODO(monnand): To deal with long delay write operations, we
 		// may want to start a pool of goroutines to do write
@@ -120,7 +205,35 @@ func (c *InMemoryCache) AddStats(cInfo *info.ContainerInfo, stats *info.Containe
 			klog.Error(err)
 		}
 	}
-	return cstore.AddStats(stats)
+	c.backendLock.RUnlock()
+	if err := cstore.AddStats(stats); err != nil {
+		return err
+	}
+	c.notifyWatches(name, stats)
+	return nil
+}
+
+// AddEvent pushes a container event to the storage drivers that store events.
+func (c *InMemoryCache) AddEvent(event *info.Event) {
+	c.backendLock.RLock()
+	defer c.backendLock.RUnlock()
+	for _, backend := range c.backend {
+		if storer, ok := backend.(storage.EventStorer); ok {
+			if err := storer.AddEvent(event); err != nil {
+				klog.Error(err)
+			}
+		}
+	}
+}
+
+// SetBackend replaces the storage drivers stats are pushed to, and returns
+// the previous ones for the caller to close.
+func (c *InMemoryCache) SetBackend(backend []storage.StorageDriver) []storage.StorageDriver {
+	c.backendLock.Lock()
+	defer c.backendLock.Unlock()
+	previous := c.backend
+	c.backend = backend
+	return previous
 }
 
 func (c *InMemoryCache) RecentStats(name string, start, end time.Time, maxStats int) ([]*info.ContainerStats, error) {
@@ -131,6 +244,68 @@ func (c *InMemoryCache) RecentStats(name string, start, end time.Time, maxStats
 	return cstore.RecentStats(start, end, maxStats)
 }
 
+// ViewRecentStats calls view with the stats RecentStats would return, without
+// copying them: they must not be modified nor kept after view returns, and
+// new stats of the container wait for view to return. They are appended to
+// dst, so that it does not allocate if dst has enough room, which makes it
+// suitable for callers that look at recent stats repeatedly.
+func (c *InMemoryCache) ViewRecentStats(dst []*info.ContainerStats, name string, start, end time.Time, maxStats int, view func(stats []*info.ContainerStats)) error {
+	cstore, ok := c.containerCacheMap.Load(name)
+	if !ok {
+		return ErrDataNotFound
+	}
+	cstore.ViewRecentStats(dst, start, end, maxStats, view)
+	return nil
+}
+
+// SetMaxStats changes how many recent stats are kept for every container,
+// e.g. when the housekeeping interval changes. A maxStats value of -1 means
+// no limit.
+func (c *InMemoryCache) SetMaxStats(maxStats int) {
+	c.maxStats.Store(int64(maxStats))
+	c.containerCacheMap.m.Range(func(_, value any) bool {
+		cstore := value.(*containerCache)
+		cstore.lock.Lock()
+		defer cstore.lock.Unlock()
+		cstore.recentStats.SetMaxItems(maxStats)
+		return true
+	})
+}
+
+// CacheSize returns the number of containers in the cache and the number of
+// stats they hold, including downsampled stats.
+func (c *InMemoryCache) CacheSize() (containers, stats int) {
+	c.containerCacheMap.m.Range(func(_, value any) bool {
+		cstore := value.(*containerCache)
+		cstore.lock.RLock()
+		defer cstore.lock.RUnlock()
+		containers++
+		stats += cstore.recentStats.Size()
+		for _, tier := range cstore.tiers {
+			stats += tier.stats.Size()
+		}
+		return true
+	})
+	return containers, stats
+}
+
+// TrimStats drops all but the latest maxStats stats of every container, e.g.
+// to release memory under pressure. Downsampled stats are dropped entirely.
+// The slots of the dropped stats are kept, only what they refer to is
+// released.
+func (c *InMemoryCache) TrimStats(maxStats int) {
+	c.containerCacheMap.m.Range(func(_, value any) bool {
+		cstore := value.(*containerCache)
+		cstore.lock.Lock()
+		defer cstore.lock.Unlock()
+		cstore.recentStats.KeepLatest(maxStats)
+		for _, tier := range cstore.tiers {
+			tier.stats.KeepLatest(0)
+		}
+		return true
+	})
+}
+
 func (c *InMemoryCache) Close() error {
 	c.containerCacheMap = containerCacheMap{}
 	return nil
@@ -145,8 +320,26 @@ func New(
 	maxAge time.Duration,
 	backend []storage.StorageDriver,
 ) *InMemoryCache {
-	return &InMemoryCache{
+	return NewTiered(maxAge, -1, nil, backend)
+}
+
+// NewTiered returns an InMemoryCache that keeps all stats for maxAge, but at
+// most maxStats of them per container, and downsampled stats for the
+// retention of each of the tiers. A positive maxStats, e.g. the number of
+// housekeepings in maxAge, allocates the slots of the recent stats of a
+// container up front; stats collected faster than that keep less than maxAge.
+// A maxStats value of -1 means no limit.
+func NewTiered(
+	maxAge time.Duration,
+	maxStats int,
+	tiers []Tier,
+	backend []storage.StorageDriver,
+) *InMemoryCache {
+	c := &InMemoryCache{
 		maxAge:  maxAge,
+		tiers:   tiers,
 		backend: backend,
 	}
+	c.maxStats.Store(int64(maxStats))
+	return c
 }
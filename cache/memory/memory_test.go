@@ -19,6 +19,7 @@ import (
 	"time"
 
 	info "github.com/google/cadvisor/info/v1"
+	"github.com/google/cadvisor/storage"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -30,6 +31,9 @@ var (
 	cInfo = info.ContainerInfo{
 		ContainerReference: info.ContainerReference{Name: containerName},
 	}
+	container2 = info.ContainerInfo{
+		ContainerReference: info.ContainerReference{Name: "/container2"},
+	}
 	zero time.Time
 )
 
@@ -100,3 +104,120 @@ func TestRecentStatsGetAllStats(t *testing.T) {
 
 	assert.Len(t, getRecentStats(t, memoryCache, -1), 10)
 }
+
+func TestRecentStatsAreCopies(t *testing.T) {
+	memoryCache := makeWithStats(t, 2)
+	added := makeStat(2)
+	require.NoError(t, memoryCache.AddStats(&cInfo, added))
+	added.Cpu.LoadAverage = 10
+
+	stats := getRecentStats(t, memoryCache, 1)
+	assert.Equal(t, []*info.ContainerStats{makeStat(2)}, stats)
+	stats[0].Cpu.LoadAverage = 20
+	assert.Equal(t, []*info.ContainerStats{makeStat(2)}, getRecentStats(t, memoryCache, 1))
+}
+
+func TestViewRecentStats(t *testing.T) {
+	memoryCache := makeWithStats(t, 10)
+
+	var buf [2]*info.ContainerStats
+	var viewed []*info.ContainerStats
+	err := memoryCache.ViewRecentStats(buf[:0], containerName, zero, zero, 2, func(stats []*info.ContainerStats) {
+		viewed = stats
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []*info.ContainerStats{makeStat(8), makeStat(9)}, viewed)
+	assert.Same(t, &buf[0], &viewed[0])
+
+	allocs := testing.AllocsPerRun(100, func() {
+		_ = memoryCache.ViewRecentStats(buf[:0], containerName, zero, zero, 2, func(stats []*info.ContainerStats) {})
+	})
+	assert.Zero(t, allocs)
+
+	err = memoryCache.ViewRecentStats(buf[:0], "/unknown", zero, zero, 2, func([]*info.ContainerStats) {})
+	assert.Equal(t, ErrDataNotFound, err)
+}
+
+func TestFixedSizeRecentStats(t *testing.T) {
+	memoryCache := NewTiered(60*time.Second, 3, nil, nil)
+	require.NoError(t, memoryCache.AddStats(&cInfo, makeStat(0)))
+	i := 1
+	allocs := testing.AllocsPerRun(10, func() {
+		_ = memoryCache.AddStats(&cInfo, makeStat(i))
+		i++
+	})
+	// Only makeStat allocates, the stats are copied into the slots of the ring.
+	assert.Equal(t, 1.0, allocs)
+	assert.Equal(t, []int{9, 10, 11}, timestamps(getRecentStats(t, memoryCache, -1)))
+
+	memoryCache.SetMaxStats(2)
+	assert.Equal(t, []int{10, 11}, timestamps(getRecentStats(t, memoryCache, -1)))
+	require.NoError(t, memoryCache.AddStats(&container2, makeStat(0)))
+	require.NoError(t, memoryCache.AddStats(&container2, makeStat(1)))
+	require.NoError(t, memoryCache.AddStats(&container2, makeStat(2)))
+	stats, err := memoryCache.RecentStats(container2.Name, zero, zero, -1)
+	require.NoError(t, err)
+	assert.Equal(t, []int{1, 2}, timestamps(stats))
+}
+
+// countingDriver counts the stats pushed to it.
+type countingDriver struct {
+	stats int
+}
+
+func (d *countingDriver) AddStats(*info.ContainerInfo, *info.ContainerStats) error {
+	d.stats++
+	return nil
+}
+
+func (d *countingDriver) Close() error {
+	return nil
+}
+
+func TestSetBackend(t *testing.T) {
+	first, second := &countingDriver{}, &countingDriver{}
+	memoryCache := New(60*time.Second, []storage.StorageDriver{first})
+	require.NoError(t, memoryCache.AddStats(&cInfo, makeStat(0)))
+
+	previous := memoryCache.SetBackend([]storage.StorageDriver{second})
+	assert.Equal(t, []storage.StorageDriver{first}, previous)
+	require.NoError(t, memoryCache.AddStats(&cInfo, makeStat(1)))
+
+	assert.Equal(t, 1, first.stats)
+	assert.Equal(t, 1, second.stats)
+	assert.Len(t, getRecentStats(t, memoryCache, -1), 2)
+}
+
+type eventDriver struct {
+	countingDriver
+	events int
+}
+
+func (d *eventDriver) AddEvent(*info.Event) error {
+	d.events++
+	return nil
+}
+
+func TestAddEvent(t *testing.T) {
+	stats, events := &countingDriver{}, &eventDriver{}
+	memoryCache := New(60*time.Second, []storage.StorageDriver{stats, events})
+	memoryCache.AddEvent(&info.Event{ContainerName: containerName, EventType: info.EventOom})
+	assert.Equal(t, 1, events.events)
+	assert.Zero(t, stats.stats)
+}
+
+func TestCacheSize(t *testing.T) {
+	memoryCache := makeWithStats(t, 10)
+	require.NoError(t, memoryCache.AddStats(&info.ContainerInfo{ContainerReference: info.ContainerReference{Name: "/other"}}, makeStat(0)))
+
+	containers, stats := memoryCache.CacheSize()
+	assert.Equal(t, 2, containers)
+	assert.Equal(t, 11, stats)
+}
+
+func TestTrimStats(t *testing.T) {
+	memoryCache := makeWithStats(t, 10)
+
+	memoryCache.TrimStats(3)
+	assert.Equal(t, []*info.ContainerStats{makeStat(7), makeStat(8), makeStat(9)}, getRecentStats(t, memoryCache, -1))
+}
@@ -0,0 +1,133 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memory
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	info "github.com/google/cadvisor/info/v1"
+	"github.com/google/cadvisor/utils"
+)
+
+// Tier is a downsampled retention level of the in-memory cache: it keeps one
+// stats sample per Resolution for Retention.
+type Tier struct {
+	Resolution time.Duration
+	Retention  time.Duration
+}
+
+// ParseTiers parses a comma-separated list of resolution:retention pairs,
+// e.g. "10s:10m,1m:2h,10m:24h", ordered from the finest to the coarsest
+// resolution.
+func ParseTiers(value string) ([]Tier, error) {
+	var tiers []Tier
+	for _, spec := range strings.Split(value, ",") {
+		spec = strings.TrimSpace(spec)
+		if spec == "" {
+			continue
+		}
+		resolution, retention, ok := strings.Cut(spec, ":")
+		if !ok {
+			return nil, fmt.Errorf("invalid storage tier %q, expected resolution:retention", spec)
+		}
+		var tier Tier
+		var err error
+		if tier.Resolution, err = time.ParseDuration(resolution); err != nil {
+			return nil, fmt.Errorf("invalid resolution of storage tier %q: %v", spec, err)
+		}
+		if tier.Retention, err = time.ParseDuration(retention); err != nil {
+			return nil, fmt.Errorf("invalid retention of storage tier %q: %v", spec, err)
+		}
+		if tier.Resolution <= 0 || tier.Retention < tier.Resolution {
+			return nil, fmt.Errorf("invalid storage tier %q, resolution must be positive and not exceed retention", spec)
+		}
+		if len(tiers) > 0 && tier.Resolution <= tiers[len(tiers)-1].Resolution {
+			return nil, fmt.Errorf("invalid storage tier %q, resolutions must be increasing", spec)
+		}
+		tiers = append(tiers, tier)
+	}
+	return tiers, nil
+}
+
+// tierStore holds the downsampled stats of a container for one tier. Each
+// interval of the tier's resolution is represented by the latest stats
+// collected in it, which keeps cumulative counters exact, so rates can still
+// be derived from consecutive samples, with the memory and process gauges
+// raised to their peak over the interval.
+type tierStore struct {
+	Tier
+	stats *utils.TimedRing[info.ContainerStats]
+
+	// Stats of the interval that is still being collected, if any.
+	pending         info.ContainerStats
+	hasPending      bool
+	pendingInterval time.Time
+}
+
+// newTierStore returns the store of a tier. Its ring grows up to the number
+// of intervals in the retention as stats come in, rather than allocating them
+// all up front, as long retentions at fine resolutions take many slots.
+func newTierStore(tier Tier) *tierStore {
+	return &tierStore{
+		Tier:  tier,
+		stats: utils.NewTimedRing[info.ContainerStats](tier.Retention, int(tier.Retention/tier.Resolution)+1),
+	}
+}
+
+// add records stats in the tier once the interval they belong to is over.
+// Stats older than the interval being collected are ignored.
+func (t *tierStore) add(stats *info.ContainerStats) {
+	interval := stats.Timestamp.Truncate(t.Resolution)
+	switch {
+	case !t.hasPending || interval.After(t.pendingInterval):
+		if t.hasPending {
+			t.stats.Add(t.pending.Timestamp, t.pending)
+		}
+		t.pending = *stats
+		t.hasPending = true
+		t.pendingInterval = interval
+	case interval.Equal(t.pendingInterval) && !stats.Timestamp.Before(t.pending.Timestamp):
+		var peaks [numGauges]uint64
+		for i, gauge := range gaugesOf(&t.pending) {
+			peaks[i] = *gauge
+		}
+		t.pending = *stats
+		for i, gauge := range gaugesOf(&t.pending) {
+			*gauge = max(*gauge, peaks[i])
+		}
+	}
+}
+
+// numGauges is the number of gauges returned by gaugesOf.
+const numGauges = 11
+
+// gaugesOf returns the gauges of stats that are aggregated by their peak.
+func gaugesOf(stats *info.ContainerStats) [numGauges]*uint64 {
+	return [numGauges]*uint64{
+		&stats.Memory.Usage,
+		&stats.Memory.Cache,
+		&stats.Memory.RSS,
+		&stats.Memory.Swap,
+		&stats.Memory.MappedFile,
+		&stats.Memory.WorkingSet,
+		&stats.Memory.KernelUsage,
+		&stats.Processes.ProcessCount,
+		&stats.Processes.FdCount,
+		&stats.Processes.SocketCount,
+		&stats.Processes.ThreadsCurrent,
+	}
+}
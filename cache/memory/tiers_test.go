@@ -0,0 +1,112 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memory
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	info "github.com/google/cadvisor/info/v1"
+)
+
+func TestParseTiers(t *testing.T) {
+	tiers, err := ParseTiers("10s:10m, 1m:2h,10m:24h")
+	require.NoError(t, err)
+	assert.Equal(t, []Tier{
+		{Resolution: 10 * time.Second, Retention: 10 * time.Minute},
+		{Resolution: time.Minute, Retention: 2 * time.Hour},
+		{Resolution: 10 * time.Minute, Retention: 24 * time.Hour},
+	}, tiers)
+
+	tiers, err = ParseTiers("")
+	assert.NoError(t, err)
+	assert.Empty(t, tiers)
+
+	for _, value := range []string{"10s", "x:10m", "10s:x", "0s:1m", "1m:10s", "1m:1h,10s:10m"} {
+		_, err := ParseTiers(value)
+		assert.Error(t, err, value)
+	}
+}
+
+func timestamps(stats []*info.ContainerStats) []int {
+	seconds := make([]int, len(stats))
+	for i, s := range stats {
+		seconds[i] = int(s.Timestamp.Sub(zero) / time.Second)
+	}
+	return seconds
+}
+
+func TestTieredRecentStats(t *testing.T) {
+	memoryCache := NewTiered(5*time.Second, -1, []Tier{
+		{Resolution: 10 * time.Second, Retention: 2 * time.Minute},
+		{Resolution: time.Minute, Retention: 10 * time.Minute},
+	}, nil)
+	for i := 0; i < 300; i++ {
+		require.NoError(t, memoryCache.AddStats(&cInfo, makeStat(i)))
+	}
+
+	// Older stats come from coarser tiers, each interval being represented by its latest stats.
+	expected := []int{59, 119, 179, 189, 199, 209, 219, 229, 239, 249, 259, 269, 279, 289, 295, 296, 297, 298, 299}
+	assert.Equal(t, expected, timestamps(getRecentStats(t, memoryCache, -1)))
+	assert.Equal(t, expected[len(expected)-7:], timestamps(getRecentStats(t, memoryCache, 7)))
+
+	stats, err := memoryCache.RecentStats(containerName, makeStat(100).Timestamp, makeStat(200).Timestamp, -1)
+	require.NoError(t, err)
+	assert.Equal(t, []int{119, 179, 189, 199}, timestamps(stats))
+
+	// The oldest raw stats are not duplicated by the tier ending at them.
+	stats, err = memoryCache.RecentStats(containerName, zero, makeStat(295).Timestamp, -1)
+	require.NoError(t, err)
+	assert.Equal(t, expected[:len(expected)-4], timestamps(stats))
+
+	buf := make([]*info.ContainerStats, 0, 3)
+	require.NoError(t, memoryCache.ViewRecentStats(buf, containerName, zero, zero, 3, func(viewed []*info.ContainerStats) {
+		stats = viewed
+	}))
+	assert.Equal(t, []int{297, 298, 299}, timestamps(stats))
+	assert.Same(t, &buf[:1][0], &stats[0])
+}
+
+func TestTierIgnoresOutOfOrderStats(t *testing.T) {
+	tier := newTierStore(Tier{Resolution: 10 * time.Second, Retention: time.Minute})
+	for _, i := range []int{1, 5, 3, 12, 8, 25} {
+		tier.add(makeStat(i))
+	}
+	assert.Equal(t, []int{5, 12}, timestamps(tier.stats.AppendRefsInTimeRange(nil, zero, zero, -1)))
+	assert.Equal(t, 25, int(tier.pending.Timestamp.Sub(zero)/time.Second))
+}
+
+func TestTierKeepsPeaksOfGauges(t *testing.T) {
+	tier := newTierStore(Tier{Resolution: 10 * time.Second, Retention: time.Minute})
+	for i, usage := range []uint64{10, 50, 20, 30} {
+		stats := makeStat(i)
+		stats.Cpu.Usage.Total = uint64(i)
+		stats.Memory.Usage = usage
+		stats.Processes.ProcessCount = 4 - uint64(i)
+		tier.add(stats)
+	}
+	tier.add(makeStat(10))
+
+	stats := tier.stats.InTimeRange(zero, zero, -1)
+	require.Len(t, stats, 1)
+	assert.Equal(t, 3, int(stats[0].Timestamp.Sub(zero)/time.Second))
+	// Counters are the latest ones, gauges the largest.
+	assert.Equal(t, uint64(3), stats[0].Cpu.Usage.Total)
+	assert.Equal(t, uint64(50), stats[0].Memory.Usage)
+	assert.Equal(t, uint64(4), stats[0].Processes.ProcessCount)
+}
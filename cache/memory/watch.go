@@ -0,0 +1,89 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memory
+
+import (
+	"strings"
+
+	info "github.com/google/cadvisor/info/v1"
+
+	"k8s.io/klog/v2"
+)
+
+// statsWatchBuffer is the number of stats a watch holds before dropping new
+// ones, so that a slow watcher never holds up housekeeping.
+const statsWatchBuffer = 100
+
+type statsWatch struct {
+	name          string
+	subcontainers bool
+	updates       chan *info.ContainerStatsUpdate
+}
+
+// matches returns whether the watch is for the stats of the named container.
+func (w *statsWatch) matches(name string) bool {
+	if name == w.name {
+		return true
+	}
+	if !w.subcontainers {
+		return false
+	}
+	return w.name == "/" || strings.HasPrefix(name, w.name+"/")
+}
+
+// WatchStats returns a channel receiving the stats added for the named
+// container, and for its subcontainers too if subcontainers is set, from now
+// on. The returned function ends the watch and closes the channel.
+func (c *InMemoryCache) WatchStats(name string, subcontainers bool) (<-chan *info.ContainerStatsUpdate, func()) {
+	w := &statsWatch{
+		name:          name,
+		subcontainers: subcontainers,
+		updates:       make(chan *info.ContainerStatsUpdate, statsWatchBuffer),
+	}
+
+	c.watchLock.Lock()
+	defer c.watchLock.Unlock()
+	if c.watches == nil {
+		c.watches = make(map[*statsWatch]struct{})
+	}
+	c.watches[w] = struct{}{}
+
+	stop := func() {
+		c.watchLock.Lock()
+		defer c.watchLock.Unlock()
+		if _, ok := c.watches[w]; ok {
+			delete(c.watches, w)
+			close(w.updates)
+		}
+	}
+	return w.updates, stop
+}
+
+// notifyWatches sends the stats added for the named container to the watches
+// for them.
+func (c *InMemoryCache) notifyWatches(name string, stats *info.ContainerStats) {
+	c.watchLock.Lock()
+	defer c.watchLock.Unlock()
+	for w := range c.watches {
+		if !w.matches(name) {
+			continue
+		}
+		select {
+		case w.updates <- &info.ContainerStatsUpdate{Name: name, Stats: stats}:
+		default:
+			klog.V(4).Infof("Dropping stats of %q for a watch of %q that is not keeping up", name, w.name)
+		}
+	}
+}
@@ -0,0 +1,78 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memory
+
+import (
+	"testing"
+	"time"
+
+	info "github.com/google/cadvisor/info/v1"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func addStats(t *testing.T, c *InMemoryCache, name string, i int) {
+	require.NoError(t, c.AddStats(&info.ContainerInfo{ContainerReference: info.ContainerReference{Name: name}}, makeStat(i)))
+}
+
+func receivedNames(updates <-chan *info.ContainerStatsUpdate) []string {
+	names := []string{}
+	for {
+		select {
+		case update := <-updates:
+			names = append(names, update.Name)
+		default:
+			return names
+		}
+	}
+}
+
+func TestWatchStats(t *testing.T) {
+	memoryCache := New(60*time.Second, nil)
+
+	updates, stop := memoryCache.WatchStats(containerName, false)
+	subcontainerUpdates, stopSubcontainers := memoryCache.WatchStats(containerName, true)
+	rootUpdates, stopRoot := memoryCache.WatchStats("/", true)
+	defer stopSubcontainers()
+	defer stopRoot()
+
+	addStats(t, memoryCache, containerName, 1)
+	addStats(t, memoryCache, containerName+"/sub", 2)
+	addStats(t, memoryCache, containerName+"-other", 3)
+
+	assert.Equal(t, []string{containerName}, receivedNames(updates))
+	assert.Equal(t, []string{containerName, containerName + "/sub"}, receivedNames(subcontainerUpdates))
+	assert.Equal(t, []string{containerName, containerName + "/sub", containerName + "-other"}, receivedNames(rootUpdates))
+
+	// Stopping closes the channel, and is fine to repeat.
+	stop()
+	stop()
+	_, ok := <-updates
+	assert.False(t, ok)
+	addStats(t, memoryCache, containerName, 4)
+	assert.Equal(t, []string{containerName}, receivedNames(subcontainerUpdates))
+}
+
+func TestWatchStatsDropsWhenFull(t *testing.T) {
+	memoryCache := New(60*time.Second, nil)
+	updates, stop := memoryCache.WatchStats(containerName, false)
+	defer stop()
+
+	for i := 0; i < statsWatchBuffer+10; i++ {
+		addStats(t, memoryCache, containerName, i)
+	}
+	assert.Len(t, receivedNames(updates), statsWatchBuffer)
+}
@@ -26,12 +26,11 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"path"
 	"strings"
 
 	v1 "github.com/google/cadvisor/info/v1"
-
-	"k8s.io/klog/v2"
 )
 
 // Client represents the base URL for a cAdvisor client.
@@ -45,6 +44,12 @@ func NewClient(url string) (*Client, error) {
 	return newClient(url, http.DefaultClient)
 }
 
+// NewClientWithHTTPClient returns a new v1.3 client with the specified base
+// URL that sends its requests with httpClient, e.g. to set a timeout.
+func NewClientWithHTTPClient(url string, httpClient *http.Client) (*Client, error) {
+	return newClient(url, httpClient)
+}
+
 func newClient(url string, client *http.Client) (*Client, error) {
 	if !strings.HasSuffix(url, "/") {
 		url += "/"
@@ -180,22 +185,22 @@ func (c *Client) httpGetJSONData(data, postData interface{}, url, infoName strin
 		resp, err = c.httpClient.Get(url)
 	}
 	if err != nil {
-		return fmt.Errorf("unable to get %q from %q: %v", infoName, url, err)
+		return fmt.Errorf("unable to get %q from %q: %v", infoName, redactURL(url), err)
 	}
 	if resp == nil {
-		return fmt.Errorf("received empty response for %q from %q", infoName, url)
+		return fmt.Errorf("received empty response for %q from %q", infoName, redactURL(url))
 	}
 	defer resp.Body.Close()
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
-		err = fmt.Errorf("unable to read all %q from %q: %v", infoName, url, err)
+		err = fmt.Errorf("unable to read all %q from %q: %v", infoName, redactURL(url), err)
 		return err
 	}
 	if resp.StatusCode != 200 {
-		return fmt.Errorf("request %q failed with error: %q", url, strings.TrimSpace(string(body)))
+		return fmt.Errorf("request %q failed with error: %q", redactURL(url), strings.TrimSpace(string(body)))
 	}
 	if err = json.Unmarshal(body, data); err != nil {
-		err = fmt.Errorf("unable to unmarshal %q (Body: %q) from %q with error: %v", infoName, string(body), url, err)
+		err = fmt.Errorf("unable to unmarshal %q (Body: %q) from %q with error: %v", infoName, string(body), redactURL(url), err)
 		return err
 	}
 	return nil
@@ -210,22 +215,33 @@ func (c *Client) getEventStreamingData(url string, einfo chan *v1.Event) error {
 	if err != nil {
 		return err
 	}
+	defer resp.Body.Close()
 	if resp.StatusCode != http.StatusOK {
 		return fmt.Errorf("status code is not OK: %v (%s)", resp.StatusCode, resp.Status)
 	}
 
 	dec := json.NewDecoder(resp.Body)
-	m := &v1.Event{}
 	for {
+		m := &v1.Event{}
 		err := dec.Decode(m)
 		if err != nil {
 			if err == io.EOF {
 				break
 			}
-			// if called without &stream=true will not be able to parse event and will trigger fatal
-			klog.Fatalf("Received error %v", err)
+			// if called without &stream=true the response is a JSON array, not a stream of events
+			return fmt.Errorf("unable to decode event from %q: %v", redactURL(url), err)
 		}
 		einfo <- m
 	}
 	return nil
 }
+
+// redactURL replaces the password of rawURL, if any, so that it can be put in
+// errors.
+func redactURL(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return rawURL
+	}
+	return u.Redacted()
+}
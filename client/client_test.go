@@ -138,6 +138,20 @@ func TestRequestFails(t *testing.T) {
 	}
 }
 
+func TestRequestFailsRedactsPassword(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "not found", http.StatusNotFound)
+	}))
+	defer ts.Close()
+	client, err := NewClient(strings.Replace(ts.URL, "://", "://admin:secret@", 1))
+	assert.NoError(t, err)
+
+	_, err = client.ContainerInfo("/missing", &info.ContainerInfoRequest{NumStats: 1})
+	assert.Error(t, err)
+	assert.NotContains(t, err.Error(), "secret")
+	assert.Contains(t, err.Error(), "admin:xxxxx@")
+}
+
 func TestGetSubcontainersInfo(t *testing.T) {
 	query := &info.ContainerInfoRequest{
 		NumStats: 3,
@@ -32,17 +32,25 @@ import (
 
 // Client represents the base URL for a cAdvisor client.
 type Client struct {
-	baseURL string
+	baseURL    string
+	httpClient *http.Client
 }
 
 // NewClient returns a new client with the specified base URL.
 func NewClient(url string) (*Client, error) {
+	return NewClientWithHTTPClient(url, http.DefaultClient)
+}
+
+// NewClientWithHTTPClient returns a new client with the specified base URL
+// that sends its requests with httpClient, e.g. to set a timeout.
+func NewClientWithHTTPClient(url string, httpClient *http.Client) (*Client, error) {
 	if !strings.HasSuffix(url, "/") {
 		url += "/"
 	}
 
 	return &Client{
-		baseURL: fmt.Sprintf("%sapi/v2.1/", url),
+		baseURL:    fmt.Sprintf("%sapi/v2.1/", url),
+		httpClient: httpClient,
 	}, nil
 }
 
@@ -136,9 +144,9 @@ func (c *Client) httpGetResponse(postData interface{}, urlPath, infoName string)
 		if marshalErr != nil {
 			return nil, fmt.Errorf("unable to marshal data: %v", marshalErr)
 		}
-		resp, err = http.Post(urlPath, "application/json", bytes.NewBuffer(data))
+		resp, err = c.httpClient.Post(urlPath, "application/json", bytes.NewBuffer(data))
 	} else {
-		resp, err = http.Get(urlPath)
+		resp, err = c.httpClient.Get(urlPath)
 	}
 	if err != nil {
 		return nil, fmt.Errorf("unable to post %q to %q: %v", infoName, urlPath, err)
@@ -15,6 +15,7 @@
 package main
 
 import (
+	"context"
 	"crypto/tls"
 	"flag"
 	"fmt"
@@ -25,11 +26,16 @@ import (
 	"runtime"
 	"strings"
 	"syscall"
+	"time"
 
+	"github.com/google/cadvisor/cache/memory"
+	"github.com/google/cadvisor/cmd/internal/admin"
+	"github.com/google/cadvisor/cmd/internal/cli"
 	cadvisorhttp "github.com/google/cadvisor/cmd/internal/http"
 	"github.com/google/cadvisor/container"
 	"github.com/google/cadvisor/manager"
 	"github.com/google/cadvisor/metrics"
+	"github.com/google/cadvisor/metrics/selfmetrics"
 	"github.com/google/cadvisor/utils/sysfs"
 	"github.com/google/cadvisor/version"
 
@@ -40,15 +46,12 @@ import (
 	_ "github.com/google/cadvisor/utils/cloudinfo/aws"
 	_ "github.com/google/cadvisor/utils/cloudinfo/azure"
 	_ "github.com/google/cadvisor/utils/cloudinfo/gce"
+	_ "github.com/google/cadvisor/utils/cloudinfo/openstack"
 
-	// Register resctrl plugin
-	_ "github.com/google/cadvisor/resctrl/intel/install"
-
+	auth "github.com/abbot/go-http-auth"
 	"k8s.io/klog/v2"
 )
 
-var argIP = flag.String("listen_ip", "", "IP to listen on, defaults to all IPs")
-var argPort = flag.Int("port", 8080, "port to listen")
 var maxProcs = flag.Int("max_procs", 0, "max number of CPUs that can be used simultaneously. Less than 1 for default (number of cores).")
 
 var versionFlag = flag.Bool("version", false, "print cAdvisor version and exit")
@@ -58,8 +61,22 @@ var httpAuthRealm = flag.String("http_auth_realm", "localhost", "HTTP auth realm
 var httpDigestFile = flag.String("http_digest_file", "", "HTTP digest file for the web UI")
 var httpDigestRealm = flag.String("http_digest_realm", "localhost", "HTTP digest file for the web UI")
 
+var uiAuthTokenFile = flag.String("ui_auth_token_file", "", "File holding a static token to log in to the web UI with. The web UI requires no login if neither this nor -ui_auth_oidc_issuer is set")
+var uiAuthOIDCIssuer = flag.String("ui_auth_oidc_issuer", "", "URL of the OpenID Connect issuer to log in to the web UI with")
+var uiAuthOIDCClientID = flag.String("ui_auth_oidc_client_id", "", "OpenID Connect client ID of cAdvisor")
+var uiAuthOIDCClientSecretFile = flag.String("ui_auth_oidc_client_secret_file", "", "File holding the OpenID Connect client secret of cAdvisor")
+var uiAuthOIDCRedirectURL = flag.String("ui_auth_oidc_redirect_url", "", "Absolute URL of /login/callback of cAdvisor registered with the OpenID Connect issuer, e.g. https://cadvisor.example.com/login/callback")
+var uiAuthOIDCAllowedUsers = flag.String("ui_auth_oidc_allowed_users", "", "Comma-separated e-mail addresses or subjects of the users allowed to log in with OpenID Connect. Empty allows every user of the issuer")
+var uiAuthSessionTTL = flag.Duration("ui_auth_session_ttl", 12*time.Hour, "How long a web UI login lasts")
+var uiAuthAPI = flag.Bool("ui_auth_api", true, "Also require the web UI login, or the static token as a bearer token, for the /api/ endpoints the web UI fetches its data from")
+
+var adminAuthFile = flag.String("admin_auth_file", "", "HTTP auth file for the admin endpoints under /admin/. The admin endpoints are disabled if empty.")
+var adminAuthRealm = flag.String("admin_auth_realm", "localhost", "HTTP auth realm for the admin endpoints")
+
 var prometheusEndpoint = flag.String("prometheus_endpoint", "/metrics", "Endpoint to expose Prometheus metrics on")
 
+var httpResponseCacheTTL = flag.Duration("http_response_cache_ttl", 0, "How long to serve identical API and Prometheus requests from the same response, e.g. about the housekeeping interval. 0 disables the response cache")
+
 var enableProfiling = flag.Bool("profiling", false, "Enable profiling via web interface host:port/debug/pprof/")
 
 var collectorCert = flag.String("collector_cert", "", "Collector's certificate, exposed to endpoints for certificate based authentication.")
@@ -90,9 +107,13 @@ var (
 		container.ProcessMetrics:                 struct{}{},
 		container.HugetlbUsageMetrics:            struct{}{},
 		container.ReferencedMemoryMetrics:        struct{}{},
+		container.DaxMemoryMetrics:               struct{}{},
 		container.CPUTopologyMetrics:             struct{}{},
 		container.ResctrlMetrics:                 struct{}{},
 		container.CPUSetMetrics:                  struct{}{},
+		container.ImageStorageMetrics:            struct{}{},
+		container.NetworkFsMetrics:               struct{}{},
+		container.TopProcessMetrics:              struct{}{},
 	}
 
 	// Metrics to be enabled.  Used only if non-empty.
@@ -106,11 +127,32 @@ func init() {
 }
 
 func main() {
+	// "cadvisor client top", or "cadvisor top" for short, queries a running
+	// cAdvisor instead of starting one.
+	if len(os.Args) > 1 && (os.Args[1] == "client" || cli.IsCommand(os.Args[1])) {
+		args := os.Args[1:]
+		if args[0] == "client" {
+			args = args[1:]
+		}
+		os.Exit(cli.Run(args, os.Stdout, os.Stderr))
+	}
+
 	klog.InitFlags(nil)
 	defer klog.Flush()
 	// Default logging verbosity to V(2)
 	_ = flag.Set("v", "2")
 	flag.Parse()
+	if *configFile != "" {
+		if err := loadConfigFile(flag.CommandLine, *configFile); err != nil {
+			klog.Fatalf("Failed to load the config file: %v", err)
+		}
+		// Flags given on the command line override the config file.
+		_ = flag.CommandLine.Parse(os.Args[1:])
+	}
+	logger, err := setUpLogging()
+	if err != nil {
+		klog.Fatalf("Failed to set up logging: %v", err)
+	}
 
 	if *versionFlag {
 		fmt.Printf("cAdvisor version %s (%s)\n", version.Info["version"], version.Info["revision"])
@@ -118,7 +160,7 @@ func main() {
 	}
 
 	var includedMetrics container.MetricSet
-	if len(enableMetrics) > 0 {
+	if enableMetrics.Len() > 0 {
 		includedMetrics = enableMetrics
 	} else {
 		includedMetrics = container.AllMetrics.Difference(ignoreMetrics)
@@ -126,10 +168,16 @@ func main() {
 	klog.V(1).Infof("enabled metrics: %s", includedMetrics.String())
 	setMaxProcs()
 
+	shutdownTracing, err := startTracing()
+	if err != nil {
+		klog.Fatalf("Failed to set up tracing: %v", err)
+	}
+
 	memoryStorage, err := NewMemoryStorage()
 	if err != nil {
 		klog.Fatalf("Failed to initialize storage driver: %s", err)
 	}
+	selfmetrics.SetMemoryCache(memoryStorage)
 
 	sysFs := sysfs.NewRealSysFs()
 
@@ -149,12 +197,37 @@ func main() {
 		mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
 	}
 
+	var responseCache *cadvisorhttp.ResponseCache
+	if *httpResponseCacheTTL > 0 {
+		responseCache = cadvisorhttp.NewResponseCache(*httpResponseCacheTTL)
+	}
+
+	uiAuth, err := createUIAuth()
+	if err != nil {
+		klog.Fatalf("Failed to set up the web UI login: %v", err)
+	}
+
 	// Register all HTTP handlers.
-	err = cadvisorhttp.RegisterHandlers(mux, resourceManager, *httpAuthFile, *httpAuthRealm, *httpDigestFile, *httpDigestRealm, *urlBasePrefix)
+	err = cadvisorhttp.RegisterHandlers(mux, resourceManager, *httpAuthFile, *httpAuthRealm, *httpDigestFile, *httpDigestRealm, *urlBasePrefix, responseCache, uiAuth)
 	if err != nil {
 		klog.Fatalf("Failed to register HTTP handlers: %v", err)
 	}
 
+	var reload func() error
+	var configReloader *reloader
+	if *reloadConfigFile != "" || *perfEvents != "" {
+		configReloader = newReloader(*reloadConfigFile, resourceManager, memoryStorage, includedMetrics)
+		reload = configReloader.reload
+	}
+
+	if *adminAuthFile != "" {
+		klog.V(1).Infof("Using admin auth file %s", *adminAuthFile)
+		authenticator := auth.NewBasicAuthenticator(*adminAuthRealm, auth.HtpasswdFileProvider(*adminAuthFile))
+		if err := admin.RegisterHandlers(mux, authenticator, includedMetrics, reload, logger); err != nil {
+			klog.Fatalf("Failed to register admin handlers: %v", err)
+		}
+	}
+
 	containerLabelFunc := metrics.DefaultContainerLabels
 	if !*storeContainerLabels {
 		whitelistedLabels := strings.Split(*whitelistedContainerLabels, ",")
@@ -166,23 +239,43 @@ func main() {
 	}
 
 	// Register Prometheus collector to gather information about containers, Go runtime, processes, and machine
-	cadvisorhttp.RegisterPrometheusHandler(mux, resourceManager, *prometheusEndpoint, containerLabelFunc, includedMetrics)
+	cadvisorhttp.RegisterPrometheusHandler(mux, resourceManager, *prometheusEndpoint, containerLabelFunc, includedMetrics, responseCache)
+
+	if err := registerFederation(mux, resourceManager, containerLabelFunc, includedMetrics, uiAuth); err != nil {
+		klog.Fatalf("Failed to set up federation: %v", err)
+	}
 
 	// Start the manager.
 	if err := resourceManager.Start(); err != nil {
 		klog.Fatalf("Failed to start manager: %v", err)
 	}
 
+	if err := startEventExport(resourceManager, memoryStorage); err != nil {
+		klog.Fatalf("Failed to push events to the storage drivers: %v", err)
+	}
+	if err := startEventWebhooks(resourceManager); err != nil {
+		klog.Fatalf("Failed to start event webhooks: %v", err)
+	}
+
 	// Install signal handler.
-	installSignalHandler(resourceManager)
+	installSignalHandler(resourceManager, memoryStorage, shutdownTracing)
+	startCheckpointing(memoryStorage)
+	if configReloader != nil {
+		installReloadHandler(configReloader)
+	}
 
 	klog.V(1).Infof("Starting cAdvisor version: %s-%s on port %d", version.Info["version"], version.Info["revision"], *argPort)
 
-	rootMux := http.NewServeMux()
-	rootMux.Handle(*urlBasePrefix+"/", http.StripPrefix(*urlBasePrefix, mux))
-
-	addr := fmt.Sprintf("%s:%d", *argIP, *argPort)
-	klog.Fatal(http.ListenAndServe(addr, rootMux))
+	tlsConfig, err := serverTLSConfig(nil)
+	if err != nil {
+		klog.Fatalf("Failed to set up TLS: %v", err)
+	}
+	endpoints, err := listen(mux)
+	if err != nil {
+		klog.Fatalf("Failed to listen: %v", err)
+	}
+	notifySystemd(resourceManager, nil)
+	klog.Fatal(serve(endpoints, tlsConfig))
 }
 
 func setMaxProcs() {
@@ -203,21 +296,68 @@ func setMaxProcs() {
 	}
 }
 
-func installSignalHandler(containerManager manager.Manager) {
+func installSignalHandler(containerManager manager.Manager, memoryStorage *memory.InMemoryCache, shutdownTracing func(context.Context) error) {
 	c := make(chan os.Signal, 1)
 	signal.Notify(c, os.Interrupt, syscall.SIGTERM)
 
 	// Block until a signal is received.
 	go func() {
 		sig := <-c
+		notifySystemdStopping()
 		if err := containerManager.Stop(); err != nil {
 			klog.Errorf("Failed to stop container manager: %v", err)
 		}
+		writeCheckpoint(memoryStorage)
+		ctx, cancel := context.WithTimeout(context.Background(), *otlpTracesTimeout)
+		if err := shutdownTracing(ctx); err != nil {
+			klog.Errorf("Failed to flush traces: %v", err)
+		}
+		cancel()
 		klog.Infof("Exiting given signal: %v", sig)
 		os.Exit(0)
 	}()
 }
 
+// createUIAuth returns the login of the web UI configured by the flags, or nil
+// if the web UI requires no login.
+func createUIAuth() (*cadvisorhttp.SessionAuth, error) {
+	if *uiAuthTokenFile == "" && *uiAuthOIDCIssuer == "" {
+		return nil, nil
+	}
+	if *httpAuthFile != "" || *httpDigestFile != "" {
+		return nil, fmt.Errorf("the web UI login cannot be used with -http_auth_file or -http_digest_file")
+	}
+	config := cadvisorhttp.SessionAuthConfig{
+		OIDCIssuer:      *uiAuthOIDCIssuer,
+		OIDCClientID:    *uiAuthOIDCClientID,
+		OIDCRedirectURL: *uiAuthOIDCRedirectURL,
+		SessionTTL:      *uiAuthSessionTTL,
+		ProtectAPI:      *uiAuthAPI,
+		URLBasePrefix:   *urlBasePrefix,
+	}
+	if *uiAuthOIDCAllowedUsers != "" {
+		config.AllowedUsers = strings.Split(*uiAuthOIDCAllowedUsers, ",")
+	}
+	if *uiAuthTokenFile != "" {
+		token, err := os.ReadFile(*uiAuthTokenFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read the token file: %w", err)
+		}
+		config.Token = strings.TrimSpace(string(token))
+		if config.Token == "" {
+			return nil, fmt.Errorf("the token file %q is empty", *uiAuthTokenFile)
+		}
+	}
+	if *uiAuthOIDCClientSecretFile != "" {
+		secret, err := os.ReadFile(*uiAuthOIDCClientSecretFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read the client secret file: %w", err)
+		}
+		config.OIDCClientSecret = strings.TrimSpace(string(secret))
+	}
+	return cadvisorhttp.NewSessionAuth(config)
+}
+
 func createCollectorHTTPClient(collectorCert, collectorKey string) http.Client {
 	//Enable accessing insecure endpoints. We should be able to access metrics from any endpoint
 	tlsConfig := &tls.Config{
@@ -73,7 +73,7 @@ func TestEnableAndIgnoreMetrics(t *testing.T) {
 		for _, sets := range []container.MetricSet{enableMetrics, ignoreMetrics} {
 			assert.NoError(t, sets.Set(test.value))
 
-			assert.Equal(t, len(test.expected), len(sets))
+			assert.Equal(t, len(test.expected), sets.Len())
 			for _, expected := range test.expected {
 				assert.True(t, sets.Has(expected), "Missing %s", expected)
 			}
@@ -108,11 +108,16 @@ func TestToIncludedMetrics(t *testing.T) {
 			container.HugetlbUsageMetrics:            struct{}{},
 			container.PerfMetrics:                    struct{}{},
 			container.ReferencedMemoryMetrics:        struct{}{},
+			container.DaxMemoryMetrics:               struct{}{},
 			container.CPUTopologyMetrics:             struct{}{},
 			container.ResctrlMetrics:                 struct{}{},
 			container.CPUSetMetrics:                  struct{}{},
 			container.OOMMetrics:                     struct{}{},
 			container.PressureMetrics:                struct{}{},
+			container.ImageStorageMetrics:            struct{}{},
+			container.NetworkFsMetrics:               struct{}{},
+			container.AcceleratorUsageMetrics:        struct{}{},
+			container.TopProcessMetrics:              struct{}{},
 		},
 		container.AllMetrics,
 		{},
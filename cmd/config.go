@@ -0,0 +1,194 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+var configFile = flag.String("config", "", "YAML or JSON file setting flags, by name or in nested sections whose keys are joined with underscores. Flags given on the command line override the file")
+
+// configValue is the value of a flag in the config file.
+type configValue struct {
+	value string
+	line  int
+}
+
+// loadConfigFile sets the flags of fs from the config file at path. Keys are
+// flag names, or sections whose nested keys are joined to the section name
+// with an underscore, so that
+//
+//	storage:
+//	  driver: influxdb
+//	  duration: 5m
+//
+// sets storage_driver and storage_duration. Lists are joined with commas and
+// mappings of flags that take key=value pairs are written as such.
+func loadConfigFile(fs *flag.FlagSet, path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	var root yaml.Node
+	if err := yaml.Unmarshal(data, &root); err != nil {
+		return fmt.Errorf("%s: %v", path, err)
+	}
+	if len(root.Content) == 0 {
+		return nil
+	}
+	doc := root.Content[0]
+	if doc.Kind != yaml.MappingNode {
+		return fmt.Errorf("%s:%d: expected a mapping of flag names to values", path, doc.Line)
+	}
+	values := map[string]configValue{}
+	if err := collectConfigValues(fs, doc, "", path, values); err != nil {
+		return err
+	}
+
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		v := values[name]
+		if err := fs.Set(name, v.value); err != nil {
+			return fmt.Errorf("%s:%d: invalid value %q for %s: %v", path, v.line, v.value, name, err)
+		}
+	}
+	return nil
+}
+
+func collectConfigValues(fs *flag.FlagSet, node *yaml.Node, prefix, path string, values map[string]configValue) error {
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		key, value := node.Content[i], node.Content[i+1]
+		name := key.Value
+		if prefix != "" {
+			name = prefix + "_" + name
+		}
+		if name == "config" {
+			return fmt.Errorf("%s:%d: config cannot be set in the config file", path, key.Line)
+		}
+		isFlag := fs.Lookup(name) != nil
+		if value.Kind == yaml.MappingNode && !isFlag {
+			if !hasFlagWithPrefix(fs, name+"_") {
+				return unknownConfigKey(fs, name, path, key.Line)
+			}
+			if err := collectConfigValues(fs, value, name, path, values); err != nil {
+				return err
+			}
+			continue
+		}
+		if !isFlag {
+			return unknownConfigKey(fs, name, path, key.Line)
+		}
+		s, err := configString(value)
+		if err != nil {
+			return fmt.Errorf("%s:%d: invalid value for %s: %v", path, value.Line, name, err)
+		}
+		if previous, ok := values[name]; ok {
+			return fmt.Errorf("%s:%d: %s is already set at line %d", path, key.Line, name, previous.line)
+		}
+		values[name] = configValue{value: s, line: key.Line}
+	}
+	return nil
+}
+
+// configString returns the flag value of node: scalars as they are, lists
+// joined with commas and mappings as comma-separated key=value pairs.
+func configString(node *yaml.Node) (string, error) {
+	switch node.Kind {
+	case yaml.ScalarNode:
+		if node.Tag == "!!null" {
+			return "", nil
+		}
+		return node.Value, nil
+	case yaml.SequenceNode:
+		items := make([]string, 0, len(node.Content))
+		for _, item := range node.Content {
+			if item.Kind != yaml.ScalarNode {
+				return "", fmt.Errorf("list items must be scalars")
+			}
+			items = append(items, item.Value)
+		}
+		return strings.Join(items, ","), nil
+	case yaml.MappingNode:
+		pairs := make([]string, 0, len(node.Content)/2)
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			k, v := node.Content[i], node.Content[i+1]
+			if v.Kind != yaml.ScalarNode {
+				return "", fmt.Errorf("values of %s must be scalars", k.Value)
+			}
+			pairs = append(pairs, k.Value+"="+v.Value)
+		}
+		return strings.Join(pairs, ","), nil
+	default:
+		return "", fmt.Errorf("unsupported value")
+	}
+}
+
+func hasFlagWithPrefix(fs *flag.FlagSet, prefix string) bool {
+	found := false
+	fs.VisitAll(func(f *flag.Flag) {
+		found = found || strings.HasPrefix(f.Name, prefix)
+	})
+	return found
+}
+
+func unknownConfigKey(fs *flag.FlagSet, name, path string, line int) error {
+	if suggestion := closestFlag(fs, name); suggestion != "" {
+		return fmt.Errorf("%s:%d: unknown flag %q, did you mean %q?", path, line, name, suggestion)
+	}
+	return fmt.Errorf("%s:%d: unknown flag %q", path, line, name)
+}
+
+// closestFlag returns the flag name closest to name, if it is close enough to
+// be a likely typo.
+func closestFlag(fs *flag.FlagSet, name string) string {
+	best, bestDistance := "", len(name)/3+1
+	fs.VisitAll(func(f *flag.Flag) {
+		if d := editDistance(name, f.Name); d < bestDistance {
+			best, bestDistance = f.Name, d
+		}
+	})
+	return best
+}
+
+// editDistance returns the Levenshtein distance between a and b.
+func editDistance(a, b string) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(b)]
+}
@@ -0,0 +1,107 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testFlags struct {
+	fs              *flag.FlagSet
+	storageDriver   *string
+	storageDuration *time.Duration
+	dockerOnly      *bool
+	enableMetrics   *string
+	groupIntervals  *string
+}
+
+func newTestFlags() testFlags {
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	return testFlags{
+		fs:              fs,
+		storageDriver:   fs.String("storage_driver", "", ""),
+		storageDuration: fs.Duration("storage_duration", 2*time.Minute, ""),
+		dockerOnly:      fs.Bool("docker_only", false, ""),
+		enableMetrics:   fs.String("enable_metrics", "", ""),
+		groupIntervals:  fs.String("metric_group_intervals", "", ""),
+	}
+}
+
+func writeConfig(t *testing.T, content string) string {
+	path := filepath.Join(t.TempDir(), "cadvisor.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+	return path
+}
+
+func TestLoadConfigFile(t *testing.T) {
+	flags := newTestFlags()
+	path := writeConfig(t, `
+docker_only: true
+storage:
+  driver: influxdb
+  duration: 5m
+enable_metrics: [cpu, memory]
+metric_group_intervals:
+  disk: 2m
+  tcp: 30s
+`)
+	require.NoError(t, loadConfigFile(flags.fs, path))
+	assert.True(t, *flags.dockerOnly)
+	assert.Equal(t, "influxdb", *flags.storageDriver)
+	assert.Equal(t, 5*time.Minute, *flags.storageDuration)
+	assert.Equal(t, "cpu,memory", *flags.enableMetrics)
+	assert.Equal(t, "disk=2m,tcp=30s", *flags.groupIntervals)
+}
+
+func TestLoadConfigFileJSON(t *testing.T) {
+	flags := newTestFlags()
+	path := writeConfig(t, `{"storage_driver": "stdout", "docker_only": true}`)
+	require.NoError(t, loadConfigFile(flags.fs, path))
+	assert.Equal(t, "stdout", *flags.storageDriver)
+	assert.True(t, *flags.dockerOnly)
+}
+
+func TestLoadConfigFileErrors(t *testing.T) {
+	for content, msg := range map[string]string{
+		"- docker_only\n": "1: expected a mapping",
+		"docker_only: true\nstorage_drivr: stdout\n": "2: unknown flag \"storage_drivr\", did you mean \"storage_driver\"?",
+		"bogus:\n  key: 1\n":                         "1: unknown flag \"bogus\"",
+		"storage:\n  duration: soon\n":               "2: invalid value \"soon\" for storage_duration",
+		"storage_driver: a\nstorage:\n  driver: b\n": "3: storage_driver is already set at line 1",
+		"enable_metrics: [[cpu]]\n":                  "invalid value for enable_metrics: list items must be scalars",
+		"config: other.yaml\n":                       "config cannot be set in the config file",
+		"docker_only: [\n":                           "cadvisor.yaml",
+	} {
+		err := loadConfigFile(newTestFlags().fs, writeConfig(t, content))
+		assert.ErrorContains(t, err, msg, content)
+	}
+}
+
+func TestLoadConfigFileCommandLineOverrides(t *testing.T) {
+	flags := newTestFlags()
+	args := []string{"--storage_driver=stdout"}
+	require.NoError(t, flags.fs.Parse(args))
+	require.NoError(t, loadConfigFile(flags.fs, writeConfig(t, "storage_driver: influxdb\ndocker_only: true\n")))
+	require.NoError(t, flags.fs.Parse(args))
+	assert.Equal(t, "stdout", *flags.storageDriver)
+	assert.True(t, *flags.dockerOnly)
+}
@@ -0,0 +1,65 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"flag"
+	"os"
+	"time"
+
+	"k8s.io/klog/v2"
+
+	"github.com/google/cadvisor/cmd/internal/federation"
+	cadvisorhttp "github.com/google/cadvisor/cmd/internal/http"
+	httpmux "github.com/google/cadvisor/cmd/internal/http/mux"
+	"github.com/google/cadvisor/container"
+	"github.com/google/cadvisor/manager"
+	"github.com/google/cadvisor/metrics"
+)
+
+var (
+	federationPeers    = flag.String("federation_peers", "", "Comma-separated remote cAdvisors whose machines, containers and metrics to merge with those of this one under /federation/, as name=URL or URL, then named after the host, e.g. node-b=http://10.0.0.2:8080. The URL may hold the user and password of HTTP basic auth. Empty disables federation")
+	federationInstance = flag.String("federation_instance", "", "Name of this cAdvisor in the federated view, the hostname by default")
+	federationTimeout  = flag.Duration("federation_timeout", 5*time.Second, "Timeout of the requests to the federation_peers")
+)
+
+// registerFederation registers the handlers of the merged view of this
+// cAdvisor and of the peers set by the flags, if any. The login of uiAuth, if
+// not nil, protects them as it protects the API.
+func registerFederation(mux httpmux.Mux, resourceManager manager.Manager, f metrics.ContainerLabelsFunc, includedMetrics container.MetricSet, uiAuth *cadvisorhttp.SessionAuth) error {
+	if *federationPeers == "" {
+		return nil
+	}
+	peers, err := federation.ParsePeers(*federationPeers)
+	if err != nil {
+		return err
+	}
+	name := *federationInstance
+	if name == "" {
+		if name, err = os.Hostname(); err != nil {
+			return err
+		}
+	}
+	fed, err := federation.New(name, resourceManager, cadvisorhttp.NewPrometheusRegistry(resourceManager, f, includedMetrics), peers, *prometheusEndpoint, *federationTimeout)
+	if err != nil {
+		return err
+	}
+	if *uiAuthAPI {
+		mux = uiAuth.Mux(mux)
+	}
+	fed.RegisterHandlers(mux)
+	klog.V(1).Infof("Federating %d peers as instance %q", len(peers), name)
+	return nil
+}
@@ -0,0 +1,163 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package admin provides authenticated handlers under /admin/ that change
+// cAdvisor's behaviour at runtime or profile it.
+package admin
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	httpmux "github.com/google/cadvisor/cmd/internal/http/mux"
+	"github.com/google/cadvisor/cmd/internal/logging"
+	"github.com/google/cadvisor/container"
+
+	auth "github.com/abbot/go-http-auth"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// MetricsPath lists and toggles the enabled metric groups.
+	MetricsPath = "/admin/metrics"
+	// ReloadPath reloads the runtime configuration.
+	ReloadPath = "/admin/reload"
+)
+
+// MetricsStatus is the response body of MetricsPath.
+type MetricsStatus struct {
+	Enabled  []string `json:"enabled"`
+	Disabled []string `json:"disabled"`
+}
+
+// RegisterHandlers registers the admin handlers on mux. Every handler is
+// wrapped by authenticator, which must not be nil.
+//
+// includedMetrics is the set shared with the manager and the Prometheus
+// collector; toggling a group updates it in place. ReloadPath is only
+// registered if reload is not nil, and LogPath if logger is not nil.
+// ProfilePath serves profiles of cAdvisor.
+func RegisterHandlers(mux httpmux.Mux, authenticator *auth.BasicAuth, includedMetrics container.MetricSet, reload func() error, logger *logging.Logger) error {
+	if authenticator == nil {
+		return fmt.Errorf("admin handlers require an authenticator")
+	}
+	mux.HandleFunc(MetricsPath, wrap(authenticator, metricsHandler(includedMetrics)))
+	mux.HandleFunc(ProfilePath, wrap(authenticator, profileHandler))
+	if reload != nil {
+		mux.HandleFunc(ReloadPath, wrap(authenticator, reloadHandler(reload)))
+	}
+	if logger != nil {
+		mux.HandleFunc(LogPath, wrap(authenticator, logHandler(logger)))
+	}
+	return nil
+}
+
+func wrap(authenticator *auth.BasicAuth, h http.HandlerFunc) http.HandlerFunc {
+	return authenticator.Wrap(func(w http.ResponseWriter, r *auth.AuthenticatedRequest) {
+		klog.V(2).Infof("Admin request %s %s by %q", r.Method, r.URL.Path, r.Username)
+		h(w, &r.Request)
+	})
+}
+
+// metricsHandler serves the current metric groups on GET and, on POST,
+// applies the comma-separated "enable" and "disable" query parameters.
+func metricsHandler(includedMetrics container.MetricSet) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+		case http.MethodPost:
+			enable, err := parseMetricKinds(r.URL.Query().Get("enable"))
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			disable, err := parseMetricKinds(r.URL.Query().Get("disable"))
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			for _, kind := range enable {
+				includedMetrics.Enable(kind)
+			}
+			for _, kind := range disable {
+				includedMetrics.Disable(kind)
+			}
+			klog.Infof("Enabled metrics changed to: %s", includedMetrics.String())
+		default:
+			http.Error(w, fmt.Sprintf("method %s not allowed", r.Method), http.StatusMethodNotAllowed)
+			return
+		}
+		writeResult(w, metricsStatus(includedMetrics))
+	}
+}
+
+// reloadHandler reloads the runtime configuration on POST.
+func reloadHandler(reload func() error) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, fmt.Sprintf("method %s not allowed", r.Method), http.StatusMethodNotAllowed)
+			return
+		}
+		if err := reload(); err != nil {
+			klog.Errorf("Failed to reload the configuration: %v", err)
+			http.Error(w, fmt.Sprintf("failed to reload the configuration: %v", err), http.StatusInternalServerError)
+			return
+		}
+		klog.Infof("Reloaded the configuration")
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func parseMetricKinds(value string) ([]container.MetricKind, error) {
+	if value == "" {
+		return nil, nil
+	}
+	var kinds []container.MetricKind
+	for _, name := range strings.Split(value, ",") {
+		kind := container.MetricKind(strings.TrimSpace(name))
+		if !container.AllMetrics.Has(kind) {
+			return nil, fmt.Errorf("unsupported metric %q specified", kind)
+		}
+		kinds = append(kinds, kind)
+	}
+	return kinds, nil
+}
+
+func metricsStatus(includedMetrics container.MetricSet) MetricsStatus {
+	enabled := includedMetrics.Copy()
+	status := MetricsStatus{Enabled: []string{}, Disabled: []string{}}
+	for kind := range container.AllMetrics.Copy() {
+		if enabled.Has(kind) {
+			status.Enabled = append(status.Enabled, kind.String())
+		} else {
+			status.Disabled = append(status.Disabled, kind.String())
+		}
+	}
+	sort.Strings(status.Enabled)
+	sort.Strings(status.Disabled)
+	return status
+}
+
+func writeResult(w http.ResponseWriter, res interface{}) {
+	out, err := json.Marshal(res)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed to marshall response %+v with error: %s", res, err), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(out)
+}
@@ -0,0 +1,88 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/cadvisor/container"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMetricsHandlerToggle(t *testing.T) {
+	includedMetrics := container.MetricSet{
+		container.CpuUsageMetrics:  struct{}{},
+		container.DiskUsageMetrics: struct{}{},
+	}
+	h := metricsHandler(includedMetrics)
+
+	w := httptest.NewRecorder()
+	h(w, httptest.NewRequest(http.MethodPost, MetricsPath+"?enable=perf_event,tcp&disable=disk", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var status MetricsStatus
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &status))
+	assert.Equal(t, []string{"cpu", "perf_event", "tcp"}, status.Enabled)
+	assert.True(t, includedMetrics.Has(container.PerfMetrics))
+	assert.False(t, includedMetrics.Has(container.DiskUsageMetrics))
+}
+
+func TestMetricsHandlerUnknownMetric(t *testing.T) {
+	includedMetrics := container.MetricSet{container.CpuUsageMetrics: struct{}{}}
+	h := metricsHandler(includedMetrics)
+
+	w := httptest.NewRecorder()
+	h(w, httptest.NewRequest(http.MethodPost, MetricsPath+"?enable=cpu,bogus", nil))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Equal(t, "cpu", includedMetrics.String())
+}
+
+func TestMetricsHandlerMethod(t *testing.T) {
+	w := httptest.NewRecorder()
+	metricsHandler(container.MetricSet{})(w, httptest.NewRequest(http.MethodDelete, MetricsPath, nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+}
+
+func TestReloadHandler(t *testing.T) {
+	reloads := 0
+	h := reloadHandler(func() error {
+		reloads++
+		return nil
+	})
+
+	w := httptest.NewRecorder()
+	h(w, httptest.NewRequest(http.MethodPost, ReloadPath, nil))
+	assert.Equal(t, http.StatusNoContent, w.Code)
+	assert.Equal(t, 1, reloads)
+
+	w = httptest.NewRecorder()
+	h(w, httptest.NewRequest(http.MethodGet, ReloadPath, nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+	assert.Equal(t, 1, reloads)
+}
+
+func TestReloadHandlerError(t *testing.T) {
+	h := reloadHandler(func() error { return errors.New("bad config") })
+
+	w := httptest.NewRecorder()
+	h(w, httptest.NewRequest(http.MethodPost, ReloadPath, nil))
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+	assert.Contains(t, w.Body.String(), "bad config")
+}
@@ -0,0 +1,73 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"k8s.io/klog/v2"
+
+	"github.com/google/cadvisor/cmd/internal/logging"
+)
+
+// LogPath shows and changes the log levels.
+const LogPath = "/admin/log"
+
+// LogStatus is the response body of LogPath.
+type LogStatus struct {
+	Format string `json:"format"`
+	logging.Levels
+}
+
+// logHandler serves the log levels on GET and, on POST, applies the "v" and
+// "modules" query parameters, the latter replacing the verbosities of all
+// packages with comma-separated package=verbosity pairs.
+func logHandler(logger *logging.Logger) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+		case http.MethodPost:
+			levels := logger.Levels()
+			query := r.URL.Query()
+			if query.Has("v") {
+				v, err := strconv.Atoi(query.Get("v"))
+				if err != nil {
+					http.Error(w, fmt.Sprintf("invalid verbosity %q", query.Get("v")), http.StatusBadRequest)
+					return
+				}
+				levels.V = v
+			}
+			if query.Has("modules") {
+				modules, err := logging.ParseModules(query.Get("modules"))
+				if err != nil {
+					http.Error(w, err.Error(), http.StatusBadRequest)
+					return
+				}
+				levels.Modules = modules
+			}
+			if err := logger.SetLevels(levels); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			klog.Infof("Log verbosity changed to %d, packages: %q", levels.V, logging.FormatModules(levels.Modules))
+		default:
+			http.Error(w, fmt.Sprintf("method %s not allowed", r.Method), http.StatusMethodNotAllowed)
+			return
+		}
+		writeResult(w, LogStatus{Format: logger.Format(), Levels: logger.Levels()})
+	}
+}
@@ -0,0 +1,59 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/klog/v2"
+
+	"github.com/google/cadvisor/cmd/internal/logging"
+)
+
+func TestLogHandler(t *testing.T) {
+	defer klog.CaptureState().Restore()
+	logger, err := logging.Setup(logging.FormatKlog, nil, logging.Levels{V: 2})
+	require.NoError(t, err)
+	h := logHandler(logger)
+
+	w := httptest.NewRecorder()
+	h(w, httptest.NewRequest(http.MethodGet, LogPath, nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"format":"klog","v":2,"modules":{}}`, w.Body.String())
+
+	w = httptest.NewRecorder()
+	h(w, httptest.NewRequest(http.MethodPost, LogPath+"?v=4", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+	var status LogStatus
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &status))
+	assert.Equal(t, 4, status.V)
+	assert.True(t, klog.V(4).Enabled())
+
+	for _, url := range []string{LogPath + "?v=x", LogPath + "?v=-1", LogPath + "?modules=manager", LogPath + "?modules=manager=4"} {
+		w = httptest.NewRecorder()
+		h(w, httptest.NewRequest(http.MethodPost, url, nil))
+		assert.Equal(t, http.StatusBadRequest, w.Code, url)
+	}
+	assert.Equal(t, logging.Levels{V: 4, Modules: map[string]int{}}, logger.Levels())
+
+	w = httptest.NewRecorder()
+	h(w, httptest.NewRequest(http.MethodPut, LogPath, nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+}
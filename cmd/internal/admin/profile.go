@@ -0,0 +1,100 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"k8s.io/klog/v2"
+
+	"github.com/google/cadvisor/utils/profiling"
+)
+
+const (
+	// ProfilePath captures a profile of cAdvisor, named by the rest of the
+	// path, e.g. /admin/profile/cpu?seconds=30.
+	ProfilePath = "/admin/profile/"
+
+	defaultProfileDuration = 30 * time.Second
+	maxProfileDuration     = 10 * time.Minute
+)
+
+// profileHandler serves the profile named by the path on GET as a file to
+// download. The "seconds" query parameter sets the duration of the cpu and
+// trace profiles, and "debug" the format of the others.
+func profileHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, fmt.Sprintf("method %s not allowed", r.Method), http.StatusMethodNotAllowed)
+		return
+	}
+	name := strings.TrimPrefix(r.URL.Path, ProfilePath)
+	if name != profiling.CPU && name != profiling.Trace && !slices.Contains(profiling.Snapshots, name) {
+		http.Error(w, fmt.Sprintf("unknown profile %q, expected one of %s", name, strings.Join(append([]string{profiling.CPU, profiling.Trace}, profiling.Snapshots...), ", ")), http.StatusNotFound)
+		return
+	}
+	duration := defaultProfileDuration
+	if value := r.URL.Query().Get("seconds"); value != "" {
+		seconds, err := strconv.ParseFloat(value, 64)
+		if err != nil || seconds <= 0 || seconds > maxProfileDuration.Seconds() {
+			http.Error(w, fmt.Sprintf("invalid seconds %q, expected a duration of at most %v", value, maxProfileDuration), http.StatusBadRequest)
+			return
+		}
+		duration = time.Duration(seconds * float64(time.Second))
+	}
+	debug := 0
+	if value := r.URL.Query().Get("debug"); value != "" {
+		var err error
+		if debug, err = strconv.Atoi(value); err != nil {
+			http.Error(w, fmt.Sprintf("invalid debug %q", value), http.StatusBadRequest)
+			return
+		}
+	}
+
+	// Buffer the profile so that a failure is reported with a status.
+	var buf bytes.Buffer
+	start := time.Now()
+	err := profiling.Capture(r.Context(), &buf, name, duration, debug)
+	if errors.Is(err, profiling.ErrActive) {
+		http.Error(w, err.Error(), http.StatusConflict)
+		return
+	}
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed to capture the %s profile: %v", name, err), http.StatusInternalServerError)
+		return
+	}
+	klog.Infof("Captured the %s profile in %v", name, time.Since(start).Round(time.Millisecond))
+
+	ext := "pprof"
+	switch {
+	case name == profiling.Trace:
+		ext = "trace"
+	case debug > 0 && name != profiling.CPU:
+		ext = "txt"
+	}
+	if ext == "txt" {
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	} else {
+		w.Header().Set("Content-Type", "application/octet-stream")
+	}
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"cadvisor-%s-%s.%s\"", name, start.UTC().Format("20060102T150405Z"), ext))
+	_, _ = w.Write(buf.Bytes())
+}
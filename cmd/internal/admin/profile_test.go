@@ -0,0 +1,57 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProfileHandler(t *testing.T) {
+	w := httptest.NewRecorder()
+	profileHandler(w, httptest.NewRequest(http.MethodGet, ProfilePath+"cpu?seconds=0.1", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "application/octet-stream", w.Header().Get("Content-Type"))
+	assert.Regexp(t, `^attachment; filename="cadvisor-cpu-\d{8}T\d{6}Z\.pprof"$`, w.Header().Get("Content-Disposition"))
+	assert.NotZero(t, w.Body.Len())
+
+	w = httptest.NewRecorder()
+	profileHandler(w, httptest.NewRequest(http.MethodGet, ProfilePath+"goroutine?debug=1", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "text/plain; charset=utf-8", w.Header().Get("Content-Type"))
+	assert.Regexp(t, `\.txt"$`, w.Header().Get("Content-Disposition"))
+	assert.Contains(t, w.Body.String(), "TestProfileHandler")
+}
+
+func TestProfileHandlerErrors(t *testing.T) {
+	for _, tc := range []struct {
+		method, url string
+		code        int
+	}{
+		{http.MethodPost, ProfilePath + "heap", http.StatusMethodNotAllowed},
+		{http.MethodGet, ProfilePath + "bogus", http.StatusNotFound},
+		{http.MethodGet, ProfilePath, http.StatusNotFound},
+		{http.MethodGet, ProfilePath + "cpu?seconds=-1", http.StatusBadRequest},
+		{http.MethodGet, ProfilePath + "cpu?seconds=3600", http.StatusBadRequest},
+		{http.MethodGet, ProfilePath + "heap?debug=x", http.StatusBadRequest},
+	} {
+		w := httptest.NewRecorder()
+		profileHandler(w, httptest.NewRequest(tc.method, tc.url, nil))
+		assert.Equal(t, tc.code, w.Code, "%s %s", tc.method, tc.url)
+	}
+}
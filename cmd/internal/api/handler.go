@@ -160,6 +160,40 @@ func streamResults(eventChannel *events.EventChannel, w http.ResponseWriter, r *
 	}
 }
 
+// streamStats writes the stats updates as server-sent events, one JSON
+// info.ContainerStatsUpdate per event, until the client goes away.
+func streamStats(updates <-chan *info.ContainerStatsUpdate, w http.ResponseWriter, r *http.Request) error {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		return errors.New("could not access http.Flusher")
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	for {
+		select {
+		case <-r.Context().Done():
+			return nil
+		case update, ok := <-updates:
+			if !ok {
+				return nil
+			}
+			out, err := json.Marshal(update)
+			if err != nil {
+				klog.Errorf("error encoding stats of %q for result stream: %v", update.Name, err)
+				continue
+			}
+			if _, err := fmt.Fprintf(w, "data: %s\n\n", out); err != nil {
+				return nil
+			}
+			flusher.Flush()
+		}
+	}
+}
+
 func getContainerInfoRequest(body io.ReadCloser) (*info.ContainerInfoRequest, error) {
 	query := info.DefaultContainerInfoRequest()
 	decoder := json.NewDecoder(body)
@@ -175,7 +209,7 @@ func getContainerInfoRequest(body io.ReadCloser) (*info.ContainerInfoRequest, er
 // with any twice defined arguments being assigned the first value.
 // If the value type for the argument is wrong the field will be assumed to be
 // unassigned
-// bools: stream, subcontainers, oom_events, creation_events, deletion_events
+// bools: stream, subcontainers, oom_events, creation_events, deletion_events, health_events
 // ints: max_events, start_time (unix timestamp), end_time (unix timestamp)
 // example r.URL: http://localhost:8080/api/v1.3/events?oom_events=true&stream=true
 func getEventRequest(r *http.Request) (*events.Request, bool, error) {
@@ -197,10 +231,18 @@ func getEventRequest(r *http.Request) (*events.Request, bool, error) {
 		}
 	}
 	eventTypes := map[string]info.EventType{
-		"oom_events":      info.EventOom,
-		"oom_kill_events": info.EventOomKill,
-		"creation_events": info.EventContainerCreation,
-		"deletion_events": info.EventContainerDeletion,
+		"oom_events":             info.EventOom,
+		"oom_kill_events":        info.EventOomKill,
+		"creation_events":        info.EventContainerCreation,
+		"deletion_events":        info.EventContainerDeletion,
+		"health_events":          info.EventHealthStatus,
+		"load_shedding_events":   info.EventLoadShedding,
+		"cpu_throttling_events":  info.EventCpuThrottling,
+		"memory_pressure_events": info.EventMemoryPressure,
+		"process_events":         info.EventProcess,
+		"machine_changed_events": info.EventMachineChanged,
+		"inode_usage_events":     info.EventInodeUsage,
+		"accelerator_xid_events": info.EventAcceleratorXID,
 	}
 	allEventTypes := false
 	if val, ok := urlMap["all_events"]; ok {
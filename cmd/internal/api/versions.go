@@ -19,8 +19,11 @@ import (
 	"net/http"
 	"path"
 	"strconv"
+	"strings"
 	"time"
 
+	"github.com/google/cadvisor/container"
+	"github.com/google/cadvisor/events"
 	info "github.com/google/cadvisor/info/v1"
 	v2 "github.com/google/cadvisor/info/v2"
 	"github.com/google/cadvisor/manager"
@@ -38,6 +41,7 @@ const (
 	statsAPI         = "stats"
 	specAPI          = "spec"
 	eventsAPI        = "events"
+	streamAPI        = "stream"
 	storageAPI       = "storage"
 	attributesAPI    = "attributes"
 	versionAPI       = "version"
@@ -262,13 +266,15 @@ func (api *version1_3) Version() string {
 }
 
 func (api *version1_3) SupportedRequestTypes() []string {
-	return append(api.baseVersion.SupportedRequestTypes(), eventsAPI)
+	return append(api.baseVersion.SupportedRequestTypes(), eventsAPI, streamAPI)
 }
 
 func (api *version1_3) HandleRequest(requestType string, request []string, m manager.Manager, w http.ResponseWriter, r *http.Request) error {
 	switch requestType {
 	case eventsAPI:
 		return handleEventRequest(request, m, w, r)
+	case streamAPI:
+		return handleStreamRequest(request, m, w, r)
 	default:
 		return api.baseVersion.HandleRequest(requestType, request, m, w, r)
 	}
@@ -296,6 +302,20 @@ func handleEventRequest(request []string, m manager.Manager, w http.ResponseWrit
 
 }
 
+// handleStreamRequest streams the stats of a container, and of its
+// subcontainers with subcontainers=true, as server-sent events.
+func handleStreamRequest(request []string, m manager.Manager, w http.ResponseWriter, r *http.Request) error {
+	containerName := path.Join("/", getContainerName(request))
+	subcontainers, _ := strconv.ParseBool(r.URL.Query().Get("subcontainers"))
+	klog.V(4).Infof("Api - Stream(%s, subcontainers=%t)", containerName, subcontainers)
+	updates, stop, err := m.WatchStats(containerName, subcontainers)
+	if err != nil {
+		return err
+	}
+	defer stop()
+	return streamStats(updates, w, r)
+}
+
 // API v2.0
 
 type version2_0 struct {
@@ -521,11 +541,64 @@ func (api *version2_1) HandleRequest(requestType string, request []string, m man
 			}
 		}
 		return writeResult(contStats, w)
+	case eventsAPI:
+		return handleEventRequestV2_1(request, m, w, r)
 	default:
 		return api.baseVersion.HandleRequest(requestType, request, m, w, r)
 	}
 }
 
+// handleEventRequestV2_1 handles events requests like v1.3, and can also
+// select events by container label, from more container subtrees, and
+// page through past events with a cursor.
+func handleEventRequestV2_1(request []string, m manager.Manager, w http.ResponseWriter, r *http.Request) error {
+	query, stream, err := getEventRequest(r)
+	if err != nil {
+		return err
+	}
+	query.ContainerName = path.Join("/", getContainerName(request))
+	params := r.URL.Query()
+	if containers := params.Get("containers"); containers != "" {
+		for _, name := range strings.Split(containers, ",") {
+			if name = strings.TrimSpace(name); name != "" {
+				query.ContainerNames = append(query.ContainerNames, path.Join("/", name))
+			}
+		}
+	}
+	if query.LabelSelector, err = container.ParseLabelSelector(params.Get("label_selector")); err != nil {
+		return fmt.Errorf("failed to parse 'label_selector' option: %v", err)
+	}
+	paginated := params.Has("cursor")
+	if paginated {
+		if stream {
+			return fmt.Errorf("'cursor' option cannot be used with 'stream'")
+		}
+		if query.Cursor, err = events.ParseCursor(params.Get("cursor")); err != nil {
+			return err
+		}
+	}
+	klog.V(4).Infof("Api - Events(%v)", query)
+	if stream {
+		eventChannel, err := m.WatchForEvents(query)
+		if err != nil {
+			return err
+		}
+		return streamResults(eventChannel, w, r, m)
+	}
+	pastEvents, err := m.GetPastEvents(query)
+	if err != nil {
+		return err
+	}
+	if !paginated {
+		return writeResult(pastEvents, w)
+	}
+	page := v2.EventPage{Events: pastEvents}
+	if query.MaxEventsReturned > 0 && len(pastEvents) == query.MaxEventsReturned {
+		page.NextCursor = events.NextCursor(query, pastEvents).String()
+	}
+	return writeResult(page, w)
+}
+
 // GetRequestOptions returns the metrics request options from a HTTP request.
 func GetRequestOptions(r *http.Request) (v2.RequestOptions, error) {
 	supportedTypes := map[string]bool{
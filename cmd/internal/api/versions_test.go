@@ -17,8 +17,10 @@ package api
 import (
 	"io"
 	"net/http"
+	"net/http/httptest"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/google/cadvisor/events"
 	info "github.com/google/cadvisor/info/v1"
@@ -79,3 +81,18 @@ func TestGetEventRequestDoubleArgument(t *testing.T) {
 	assert.True(t, stream)
 	assert.Nil(t, err)
 }
+
+func TestStreamStats(t *testing.T) {
+	updates := make(chan *info.ContainerStatsUpdate, 2)
+	updates <- &info.ContainerStatsUpdate{Name: "/a", Stats: &info.ContainerStats{Timestamp: time.Unix(1, 0).UTC()}}
+	updates <- &info.ContainerStatsUpdate{Name: "/a/b", Stats: &info.ContainerStats{Timestamp: time.Unix(2, 0).UTC()}}
+	close(updates)
+
+	w := httptest.NewRecorder()
+	assert.NoError(t, streamStats(updates, w, makeHTTPRequest("http://localhost:8080/api/v1.3/stream/a?subcontainers=true", t)))
+	assert.Equal(t, "text/event-stream", w.Header().Get("Content-Type"))
+	body := w.Body.String()
+	assert.Contains(t, body, `data: {"name":"/a","stats":{"timestamp":"1970-01-01T00:00:01Z"`)
+	assert.Contains(t, body, `data: {"name":"/a/b","stats":{"timestamp":"1970-01-01T00:00:02Z"`)
+	assert.Regexp(t, "^(data: [^\\n]+\\n\\n){2}$", body)
+}
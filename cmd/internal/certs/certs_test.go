@@ -0,0 +1,93 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package certs
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writePair writes a new self-signed certificate and its key to the files,
+// dated at modTime, and returns it.
+func writePair(t *testing.T, certFile, keyFile string, modTime time.Time) *tls.Certificate {
+	cert, err := SelfSigned([]string{"localhost"}, time.Hour)
+	require.NoError(t, err)
+	key, err := x509.MarshalPKCS8PrivateKey(cert.PrivateKey)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Certificate[0]}), 0o600))
+	require.NoError(t, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: key}), 0o600))
+	require.NoError(t, os.Chtimes(certFile, modTime, modTime))
+	require.NoError(t, os.Chtimes(keyFile, modTime, modTime))
+	return cert
+}
+
+func current(t *testing.T, r *Reloader) *tls.Certificate {
+	cert, err := r.GetCertificate(nil)
+	require.NoError(t, err)
+	return cert
+}
+
+func TestReloader(t *testing.T) {
+	dir := t.TempDir()
+	certFile, keyFile := filepath.Join(dir, "tls.crt"), filepath.Join(dir, "tls.key")
+	_, err := NewReloader(certFile, keyFile)
+	assert.Error(t, err)
+
+	modTime := time.Now().Add(-time.Hour)
+	first := writePair(t, certFile, keyFile, modTime)
+	r, err := NewReloader(certFile, keyFile)
+	require.NoError(t, err)
+	assert.Equal(t, first.Certificate, current(t, r).Certificate)
+
+	reloaded, err := r.Reload()
+	require.NoError(t, err)
+	assert.False(t, reloaded, "the files did not change")
+
+	modTime = modTime.Add(time.Minute)
+	second := writePair(t, certFile, keyFile, modTime)
+	reloaded, err = r.Reload()
+	require.NoError(t, err)
+	assert.True(t, reloaded)
+	assert.Equal(t, second.Certificate, current(t, r).Certificate)
+
+	// A certificate not matching the key is refused, and the previous one
+	// kept.
+	otherDir := t.TempDir()
+	writePair(t, filepath.Join(otherDir, "tls.crt"), filepath.Join(otherDir, "tls.key"), modTime.Add(time.Minute))
+	require.NoError(t, os.Rename(filepath.Join(otherDir, "tls.crt"), certFile))
+	_, err = r.Reload()
+	assert.ErrorContains(t, err, "failed to load the certificate")
+	assert.Equal(t, second.Certificate, current(t, r).Certificate)
+}
+
+func TestSelfSigned(t *testing.T) {
+	cert, err := SelfSigned([]string{"node-a", "127.0.0.1", "::1"}, 24*time.Hour)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"node-a"}, cert.Leaf.DNSNames)
+	require.Len(t, cert.Leaf.IPAddresses, 2)
+	assert.True(t, cert.Leaf.IPAddresses[1].Equal(net.IPv6loopback))
+	assert.NoError(t, cert.Leaf.VerifyHostname("127.0.0.1"))
+	assert.WithinDuration(t, time.Now().Add(24*time.Hour), cert.Leaf.NotAfter, time.Minute)
+	assert.Len(t, Fingerprint(cert), 64)
+}
@@ -0,0 +1,279 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/google/cadvisor/info/v1"
+	v2 "github.com/google/cadvisor/info/v2"
+)
+
+func testStats(at time.Time, cores float64, workingSet, rx, tx uint64) *v2.ContainerStats {
+	return &v2.ContainerStats{
+		Timestamp: at,
+		Cpu:       &v1.CpuStats{Usage: v1.CpuUsage{Total: 3 * uint64(time.Second)}},
+		CpuInst:   &v2.CpuInstStats{Usage: v2.CpuInstUsage{Total: uint64(cores * 1e9)}},
+		Memory:    &v1.MemoryStats{WorkingSet: workingSet, Usage: workingSet},
+		Network: &v2.NetworkStats{Interfaces: []v1.InterfaceStats{
+			{Name: "eth0", RxBytes: rx, TxBytes: tx},
+		}},
+	}
+}
+
+func testInfo(aliases []string, limit uint64, cores float64, workingSet uint64) v2.ContainerInfo {
+	start := time.Date(2026, 10, 14, 12, 0, 0, 0, time.UTC)
+	return v2.ContainerInfo{
+		Spec: v2.ContainerSpec{
+			Aliases:   aliases,
+			HasCpu:    true,
+			HasMemory: true,
+			Memory:    v2.MemorySpec{Limit: limit},
+		},
+		Stats: []*v2.ContainerStats{
+			testStats(start, cores, workingSet, 1000, 1000),
+			testStats(start.Add(time.Second), cores, workingSet, 3048, 1512),
+		},
+	}
+}
+
+// testServer serves stats and events like cAdvisor and records the
+// requests it receives.
+func testServer(t *testing.T, requests *[]*http.Request) *httptest.Server {
+	infos := map[string]v2.ContainerInfo{
+		"/":           testInfo(nil, 0, 1.5, 4<<30),
+		"/docker/abc": testInfo([]string{"web"}, 1<<30, 1.0, 512<<20),
+		"/docker/def": testInfo([]string{"db"}, unlimited, 0.25, 1<<30),
+	}
+	events := []*v1.Event{{
+		ContainerName: "/docker/abc",
+		Timestamp:     time.Date(2026, 10, 14, 12, 0, 0, 0, time.UTC),
+		EventType:     v1.EventOomKill,
+		EventData:     v1.EventData{OomKill: &v1.OomKillEventData{Pid: 1234, ProcessName: "java"}},
+	}}
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*requests = append(*requests, r)
+		switch {
+		case r.URL.Path == "/api/v2.1/stats":
+			json.NewEncoder(w).Encode(infos)
+		case r.URL.Path == "/api/v2.1/stats/web":
+			json.NewEncoder(w).Encode(map[string]v2.ContainerInfo{"/docker/abc": infos["/docker/abc"]})
+		case strings.HasPrefix(r.URL.Path, "/api/v1.3/events/"):
+			if r.URL.Query().Get("stream") != "true" {
+				json.NewEncoder(w).Encode(events)
+				return
+			}
+			for _, event := range events {
+				json.NewEncoder(w).Encode(event)
+			}
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func run(args ...string) (code int, stdout, stderr string) {
+	var out, errOut bytes.Buffer
+	code = Run(args, &out, &errOut)
+	return code, out.String(), errOut.String()
+}
+
+func TestRunUsage(t *testing.T) {
+	code, _, stderr := run()
+	assert.Equal(t, 2, code)
+	assert.Contains(t, stderr, "Usage: cadvisor client <command>")
+	assert.Contains(t, stderr, "  top ")
+
+	code, _, _ = run("help")
+	assert.Equal(t, 0, code)
+
+	code, _, stderr = run("ps")
+	assert.Equal(t, 2, code)
+	assert.Contains(t, stderr, `Unknown command "ps"`)
+
+	code, _, stderr = run("top", "-h")
+	assert.Equal(t, 0, code)
+	assert.Contains(t, stderr, "Usage: cadvisor client top [flags] [container]")
+	assert.Contains(t, stderr, "-sort")
+
+	code, _, stderr = run("top", "-sort", "disk")
+	assert.Equal(t, 2, code)
+	assert.Contains(t, stderr, `unknown -sort "disk"`)
+
+	code, _, stderr = run("stats")
+	assert.Equal(t, 2, code)
+	assert.Contains(t, stderr, "stats takes one container")
+
+	assert.True(t, IsCommand("events"))
+	assert.False(t, IsCommand("client"))
+}
+
+func TestRunTop(t *testing.T) {
+	var requests []*http.Request
+	s := testServer(t, &requests)
+
+	code, stdout, stderr := run("top", "-url", s.URL, "-sort", "memory", "-n", "2")
+	require.Equal(t, 0, code, stderr)
+	assert.Equal(t, `CONTAINER  CPU   MEMORY  LIMIT  NET RX    NET TX
+/          1.50  4.0GiB  -      2.0KiB/s  512B/s
+db         0.25  1.0GiB  -      2.0KiB/s  512B/s
+`, stdout)
+	require.Len(t, requests, 1)
+	assert.Equal(t, "/api/v2.1/stats", requests[0].URL.Path)
+	assert.Equal(t, "2", requests[0].URL.Query().Get("count"))
+	assert.Equal(t, "true", requests[0].URL.Query().Get("recursive"))
+}
+
+func TestTopRows(t *testing.T) {
+	infos := map[string]v2.ContainerInfo{
+		"/a": testInfo(nil, 0, 1, 1<<20),
+		"/b": testInfo(nil, 0, 2, 1<<20),
+		"/c": testInfo(nil, 0, 2, 1<<10),
+		"/d": {Spec: v2.ContainerSpec{}},
+	}
+	names := func(rows []*topRow) []string {
+		var names []string
+		for _, row := range rows {
+			names = append(names, row.name)
+		}
+		return names
+	}
+	assert.Equal(t, []string{"/b", "/c", "/a"}, names(topRows(infos, topSortOrders["cpu"])))
+	assert.Equal(t, []string{"/a", "/b", "/c"}, names(topRows(infos, topSortOrders["memory"])))
+	assert.Equal(t, []string{"/a", "/b", "/c"}, names(topRows(infos, topSortOrders["name"])))
+
+	infos = map[string]v2.ContainerInfo{"/a": testInfo(nil, 0, 1, 1<<20)}
+	infos["/a"].Stats[1].Network.Interfaces[0].RxBytes = 0
+	rows := topRows(infos, topSortOrders["cpu"])
+	require.Len(t, rows, 1)
+	assert.Equal(t, -1.0, rows[0].rx, "counters that went backwards have no rate")
+}
+
+func TestRunStats(t *testing.T) {
+	var requests []*http.Request
+	s := testServer(t, &requests)
+	token := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(token, []byte("secret\n"), 0o600))
+
+	code, stdout, stderr := run("stats", "-type", "docker", "-token_file", token, "-url", s.URL, "web")
+	require.Equal(t, 0, code, stderr)
+	require.Len(t, requests, 1)
+	assert.Equal(t, "/api/v2.1/stats/web", requests[0].URL.Path)
+	assert.Equal(t, "docker", requests[0].URL.Query().Get("type"))
+	assert.Equal(t, "Bearer secret", requests[0].Header.Get("Authorization"))
+	assert.Contains(t, stdout, "Container  /docker/abc\nAliases    web\n")
+
+	code, _, stderr = run("stats", "-type", "mesos", "-url", s.URL, "web")
+	assert.Equal(t, 2, code)
+	assert.Contains(t, stderr, "-type")
+}
+
+func TestWriteStats(t *testing.T) {
+	info := testInfo([]string{"web", "abc"}, 1<<30, 1.5, 512<<20)
+	info.Spec.Image = "nginx:1.27"
+	info.Spec.CreationTime = time.Date(2026, 10, 14, 11, 0, 0, 0, time.UTC)
+	info.Spec.Cpu.MaxLimit = 2000
+	now := time.Date(2026, 10, 14, 12, 0, 30, 0, time.UTC)
+
+	var out bytes.Buffer
+	require.NoError(t, writeStats(&out, "/docker/abc", info, now))
+	assert.Equal(t, `Container  /docker/abc
+Aliases    web, abc
+Image      nginx:1.27
+Created    2026-10-14T11:00:00Z (1h0m30s ago)
+Collected  2026-10-14T12:00:01Z
+CPU        1.50 cores, 3s total, limit 2.00 cores
+Memory     512.0MiB working set, 512.0MiB usage, 0B cache, limit 1.0GiB
+Network    eth0: 3.0KiB received, 1.5KiB sent
+           2.0KiB/s in, 512B/s out
+`, out.String())
+}
+
+func TestRunEvents(t *testing.T) {
+	var requests []*http.Request
+	s := testServer(t, &requests)
+
+	code, stdout, stderr := run("events", "-url", s.URL, "-type", "oom_kill,creation", "-since", "1h", "-n", "10", "/docker")
+	require.Equal(t, 0, code, stderr)
+	lines := strings.Split(strings.TrimSuffix(stdout, "\n"), "\n")
+	require.Len(t, lines, 2)
+	assert.Regexp(t, `^TIME +TYPE +CONTAINER +DETAILS$`, lines[0])
+	assert.Regexp(t, `^2026-10-14 \d\d:00:00  oomKill +/docker/abc +pid=1234 process_name=java$`, lines[1])
+	require.Len(t, requests, 1)
+	assert.Equal(t, "/api/v1.3/events/docker", requests[0].URL.Path)
+	query := requests[0].URL.Query()
+	assert.Equal(t, "true", query.Get("oom_kill_events"))
+	assert.Equal(t, "true", query.Get("creation_events"))
+	assert.Empty(t, query.Get("all_events"))
+	assert.Equal(t, "10", query.Get("max_events"))
+	assert.NotEmpty(t, query.Get("start_time"))
+
+	// The test server closes the stream after the past events.
+	code, stdout, stderr = run("events", "-url", s.URL, "-follow")
+	assert.Equal(t, 1, code)
+	assert.Contains(t, stdout, "pid=1234")
+	assert.Contains(t, stderr, "cAdvisor closed the event stream")
+	assert.Equal(t, "true", requests[1].URL.Query().Get("stream"))
+	assert.Equal(t, "true", requests[1].URL.Query().Get("all_events"))
+}
+
+func TestEventsQuery(t *testing.T) {
+	query, err := eventsQuery("", false)
+	require.NoError(t, err)
+	assert.Equal(t, "all_events=true&subcontainers=false", query.Encode())
+
+	query, err = eventsQuery("oom, deletion", true)
+	require.NoError(t, err)
+	assert.Equal(t, "deletion_events=true&oom_events=true&subcontainers=true", query.Encode())
+
+	_, err = eventsQuery("oom,restart", true)
+	assert.ErrorContains(t, err, `unknown event type "restart"`)
+}
+
+func TestEventDetails(t *testing.T) {
+	assert.Equal(t, "", eventDetails(&v1.Event{EventType: v1.EventContainerCreation}))
+	assert.Equal(t, "pid=1 process_name=\"my app\" count=3", eventDetails(&v1.Event{
+		EventData: v1.EventData{OomKill: &v1.OomKillEventData{Pid: 1, ProcessName: "my app"}},
+		Count:     3,
+	}))
+}
+
+func TestFormatBytes(t *testing.T) {
+	for b, want := range map[float64]string{
+		0:       "0B",
+		1023:    "1023B",
+		1536:    "1.5KiB",
+		3 << 30: "3.0GiB",
+		5 << 50: "5120.0TiB",
+	} {
+		assert.Equal(t, want, formatBytes(b))
+	}
+	assert.Equal(t, "-", formatLimit(0))
+	assert.Equal(t, "-", formatLimit(1<<63-4096))
+	assert.Equal(t, "1.0GiB", formatLimit(1<<30))
+}
@@ -0,0 +1,171 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/url"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	v1 "github.com/google/cadvisor/info/v1"
+)
+
+// eventTypes are the event types of -type, whose events the API returns
+// with <type>_events=true.
+var eventTypes = []string{
+	"accelerator_xid",
+	"cpu_throttling",
+	"creation",
+	"deletion",
+	"health",
+	"inode_usage",
+	"load_shedding",
+	"machine_changed",
+	"memory_pressure",
+	"oom",
+	"oom_kill",
+	"process",
+}
+
+func setUpEvents(fs *flag.FlagSet) runFunc {
+	follow := fs.Bool("follow", false, "Print new events as they occur until interrupted, instead of the past ones")
+	types := fs.String("type", "", "Comma-separated types of the events to print, of "+strings.Join(eventTypes, ", ")+". Empty prints all events")
+	since := fs.Duration("since", 0, "Only print the past events of this last duration, e.g. 1h. 0 prints all the events cAdvisor stored")
+	count := fs.Int("n", 100, "Maximum number of past events to print")
+	subcontainers := fs.Bool("subcontainers", true, "Also print the events of the subcontainers of the container")
+	return func(c *connection, args []string, out io.Writer) error {
+		if len(args) > 1 {
+			return usageError("events takes at most one container")
+		}
+		container := "/"
+		if len(args) == 1 {
+			container = args[0]
+		}
+		query, err := eventsQuery(*types, *subcontainers)
+		if err != nil {
+			return err
+		}
+		// The v1 client appends the query to the path of the container.
+		request := func(query url.Values) string {
+			return path.Join("/", container) + "?" + query.Encode()
+		}
+
+		fmt.Fprint(out, formatEventLine("TIME", "TYPE", "CONTAINER", "DETAILS"))
+		if !*follow {
+			query.Set("max_events", strconv.Itoa(*count))
+			if *since > 0 {
+				query.Set("start_time", time.Now().Add(-*since).Format(time.RFC3339))
+			}
+			events, err := c.v1.EventStaticInfo(request(query))
+			if err != nil {
+				return err
+			}
+			for _, event := range events {
+				fmt.Fprint(out, formatEvent(event))
+			}
+			return nil
+		}
+
+		query.Set("stream", "true")
+		events := make(chan *v1.Event)
+		errs := make(chan error, 1)
+		go func() {
+			errs <- c.stream.EventStreamingInfo(request(query), events)
+		}()
+		for {
+			select {
+			case event := <-events:
+				fmt.Fprint(out, formatEvent(event))
+			case err := <-errs:
+				if err == nil {
+					err = errors.New("cAdvisor closed the event stream")
+				}
+				return err
+			}
+		}
+	}
+}
+
+// eventsQuery returns the query of the events API selecting the events of
+// the comma-separated types, or all of them.
+func eventsQuery(types string, subcontainers bool) (url.Values, error) {
+	query := url.Values{"subcontainers": {strconv.FormatBool(subcontainers)}}
+	if types == "" {
+		query.Set("all_events", "true")
+		return query, nil
+	}
+	for _, t := range strings.Split(types, ",") {
+		t = strings.TrimSpace(t)
+		i := sort.SearchStrings(eventTypes, t)
+		if i == len(eventTypes) || eventTypes[i] != t {
+			return nil, usageError(fmt.Sprintf("unknown event type %q in -type", t))
+		}
+		query.Set(t+"_events", "true")
+	}
+	return query, nil
+}
+
+func formatEventLine(timestamp, eventType, container, details string) string {
+	return strings.TrimRight(fmt.Sprintf("%-19s  %-17s  %-40s  %s", timestamp, eventType, container, details), " ") + "\n"
+}
+
+func formatEvent(event *v1.Event) string {
+	return formatEventLine(event.Timestamp.Local().Format(time.DateTime), string(event.EventType), event.ContainerName, eventDetails(event))
+}
+
+// eventDetails returns the data of an event as key=value pairs, e.g.
+// "pid=1234 process_name=java" for an OOM kill.
+func eventDetails(event *v1.Event) string {
+	var details []string
+	data, err := json.Marshal(event.EventData)
+	if err == nil {
+		var kinds map[string]map[string]any
+		if err := json.Unmarshal(data, &kinds); err == nil {
+			for _, fields := range kinds {
+				for key, value := range fields {
+					details = append(details, key+"="+formatDetail(value))
+				}
+			}
+		}
+	}
+	sort.Strings(details)
+	if event.Count > 1 {
+		details = append(details, fmt.Sprintf("count=%d", event.Count))
+	}
+	return strings.Join(details, " ")
+}
+
+func formatDetail(value any) string {
+	switch v := value.(type) {
+	case string:
+		if strings.ContainsAny(v, " \t\"=") || v == "" {
+			return strconv.Quote(v)
+		}
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		data, _ := json.Marshal(v)
+		return string(data)
+	}
+}
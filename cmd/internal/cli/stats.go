@@ -0,0 +1,136 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	v2 "github.com/google/cadvisor/info/v2"
+)
+
+func setUpStats(fs *flag.FlagSet) runFunc {
+	idType := fs.String("type", v2.TypeName, "How the container is given: name, its cAdvisor name, or docker, its Docker name or ID")
+	asJSON := fs.Bool("json", false, "Print the spec and latest stats of the container as JSON, as returned by the v2.1 API")
+	return func(c *connection, args []string, out io.Writer) error {
+		if len(args) != 1 {
+			return usageError("stats takes one container")
+		}
+		if *idType != v2.TypeName && *idType != v2.TypeDocker {
+			return usageError(fmt.Sprintf("unknown -type %q", *idType))
+		}
+		infos, err := c.v2.Stats(args[0], &v2.RequestOptions{IdType: *idType, Count: 2})
+		if err != nil {
+			return err
+		}
+		if len(infos) != 1 {
+			return fmt.Errorf("expected one container named %q, got %d", args[0], len(infos))
+		}
+		for name, info := range infos {
+			if len(info.Stats) == 0 {
+				return fmt.Errorf("no stats of %s yet", name)
+			}
+			if *asJSON {
+				info.Stats = info.Stats[len(info.Stats)-1:]
+				encoder := json.NewEncoder(out)
+				encoder.SetIndent("", "  ")
+				return encoder.Encode(map[string]v2.ContainerInfo{name: info})
+			}
+			return writeStats(out, name, info, time.Now())
+		}
+		return nil
+	}
+}
+
+// writeStats writes the spec and latest stats of a container as a list of
+// properties.
+func writeStats(out io.Writer, name string, info v2.ContainerInfo, now time.Time) error {
+	spec := info.Spec
+	last := info.Stats[len(info.Stats)-1]
+	var prev *v2.ContainerStats
+	if len(info.Stats) > 1 {
+		prev = info.Stats[len(info.Stats)-2]
+	}
+
+	w := tabwriter.NewWriter(out, 0, 8, 2, ' ', 0)
+	property := func(key, format string, a ...any) {
+		fmt.Fprintf(w, "%s\t%s\n", key, fmt.Sprintf(format, a...))
+	}
+	property("Container", "%s", name)
+	if len(spec.Aliases) > 0 {
+		property("Aliases", "%s", strings.Join(spec.Aliases, ", "))
+	}
+	if spec.Image != "" {
+		property("Image", "%s", spec.Image)
+	}
+	if !spec.CreationTime.IsZero() {
+		property("Created", "%s (%s ago)", spec.CreationTime.Format(time.RFC3339), now.Sub(spec.CreationTime).Truncate(time.Second))
+	}
+	property("Collected", "%s", last.Timestamp.Format(time.RFC3339))
+
+	if spec.HasCpu && last.Cpu != nil {
+		cpu := fmt.Sprintf("%s total", time.Duration(last.Cpu.Usage.Total).Truncate(time.Millisecond))
+		if last.CpuInst != nil {
+			cpu = fmt.Sprintf("%.2f cores, %s", float64(last.CpuInst.Usage.Total)/1e9, cpu)
+		}
+		if spec.Cpu.MaxLimit > 0 {
+			cpu += fmt.Sprintf(", limit %.2f cores", float64(spec.Cpu.MaxLimit)/1000)
+		}
+		if last.Cpu.CFS.ThrottledPeriods > 0 {
+			cpu += fmt.Sprintf(", throttled %d of %d periods", last.Cpu.CFS.ThrottledPeriods, last.Cpu.CFS.Periods)
+		}
+		property("CPU", "%s", cpu)
+	}
+	if spec.HasMemory && last.Memory != nil {
+		memory := fmt.Sprintf("%s working set, %s usage, %s cache", formatBytes(float64(last.Memory.WorkingSet)), formatBytes(float64(last.Memory.Usage)), formatBytes(float64(last.Memory.Cache)))
+		if limit := formatLimit(spec.Memory.Limit); limit != "-" {
+			memory += ", limit " + limit
+		}
+		property("Memory", "%s", memory)
+	}
+	if last.Network != nil {
+		interfaces := last.Network.Interfaces
+		sort.Slice(interfaces, func(i, j int) bool { return interfaces[i].Name < interfaces[j].Name })
+		for i, iface := range interfaces {
+			key := ""
+			if i == 0 {
+				key = "Network"
+			}
+			network := fmt.Sprintf("%s: %s received, %s sent", iface.Name, formatBytes(float64(iface.RxBytes)), formatBytes(float64(iface.TxBytes)))
+			if iface.RxDropped+iface.TxDropped > 0 {
+				network += fmt.Sprintf(", %d dropped", iface.RxDropped+iface.TxDropped)
+			}
+			property(key, "%s", network)
+		}
+		if prev != nil {
+			if rx, tx := networkRates(prev, last); rx >= 0 {
+				property("", "%s in, %s out", formatRate(rx), formatRate(tx))
+			}
+		}
+	}
+	if last.Filesystem != nil && last.Filesystem.TotalUsageBytes != nil {
+		property("Filesystem", "%s used", formatBytes(float64(*last.Filesystem.TotalUsageBytes)))
+	}
+	if last.Processes != nil {
+		property("Processes", "%d processes, %d threads, %d file descriptors", last.Processes.ProcessCount, last.Processes.ThreadsCurrent, last.Processes.FdCount)
+	}
+	return w.Flush()
+}
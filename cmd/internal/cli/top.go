@@ -0,0 +1,169 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"sort"
+	"text/tabwriter"
+	"time"
+
+	v2 "github.com/google/cadvisor/info/v2"
+)
+
+// clearScreen moves the cursor to the top left corner of the terminal and
+// clears it, to refresh the list of containers in place.
+const clearScreen = "\x1b[H\x1b[2J"
+
+// topRow is a container listed by top.
+type topRow struct {
+	name string
+	// cpu is the number of cores used.
+	cpu float64
+	// memory is the working set, and limit the memory limit, in bytes.
+	memory, limit uint64
+	// rx and tx are the bytes received and sent per second, negative if
+	// unknown.
+	rx, tx float64
+}
+
+var topSortOrders = map[string]func(a, b *topRow) bool{
+	"cpu":    func(a, b *topRow) bool { return a.cpu > b.cpu },
+	"memory": func(a, b *topRow) bool { return a.memory > b.memory },
+	"name":   func(a, b *topRow) bool { return false },
+}
+
+func setUpTop(fs *flag.FlagSet) runFunc {
+	count := fs.Int("n", 20, "Number of containers to list, 0 for all")
+	sortBy := fs.String("sort", "cpu", "Column to sort the containers by, from the largest: cpu, memory or name")
+	watch := fs.Duration("watch", 0, "Interval to refresh the list at until interrupted, e.g. 2s. 0 lists the containers once")
+	return func(c *connection, args []string, out io.Writer) error {
+		if len(args) > 1 {
+			return usageError("top takes at most one container")
+		}
+		root := "/"
+		if len(args) == 1 {
+			root = args[0]
+		}
+		less, ok := topSortOrders[*sortBy]
+		if !ok {
+			return usageError(fmt.Sprintf("unknown -sort %q", *sortBy))
+		}
+		for {
+			// Two samples give the CPU usage and network rates.
+			infos, err := c.v2.Stats(root, &v2.RequestOptions{IdType: v2.TypeName, Count: 2, Recursive: true})
+			if err != nil {
+				return err
+			}
+			rows := topRows(infos, less)
+			if *count > 0 && len(rows) > *count {
+				rows = rows[:*count]
+			}
+			if *watch > 0 {
+				fmt.Fprintf(out, "%s%s at %s, %d containers\n\n", clearScreen, c.url, time.Now().Format(time.TimeOnly), len(infos))
+			}
+			if err := writeTop(out, rows); err != nil {
+				return err
+			}
+			if *watch <= 0 {
+				return nil
+			}
+			time.Sleep(*watch)
+		}
+	}
+}
+
+// topRows returns the rows of the containers of infos, ordered by less and
+// then by name.
+func topRows(infos map[string]v2.ContainerInfo, less func(a, b *topRow) bool) []*topRow {
+	rows := make([]*topRow, 0, len(infos))
+	for name, info := range infos {
+		if len(info.Stats) == 0 {
+			continue
+		}
+		row := &topRow{name: displayName(name, info.Spec), limit: info.Spec.Memory.Limit, rx: -1, tx: -1}
+		last := info.Stats[len(info.Stats)-1]
+		if last.CpuInst != nil {
+			row.cpu = float64(last.CpuInst.Usage.Total) / 1e9
+		}
+		if last.Memory != nil {
+			row.memory = last.Memory.WorkingSet
+		}
+		if len(info.Stats) > 1 {
+			row.rx, row.tx = networkRates(info.Stats[len(info.Stats)-2], last)
+		}
+		rows = append(rows, row)
+	}
+	sort.Slice(rows, func(i, j int) bool {
+		if less(rows[i], rows[j]) {
+			return true
+		}
+		if less(rows[j], rows[i]) {
+			return false
+		}
+		return rows[i].name < rows[j].name
+	})
+	return rows
+}
+
+// displayName returns the first alias of a container, e.g. its Docker name,
+// or its name.
+func displayName(name string, spec v2.ContainerSpec) string {
+	if len(spec.Aliases) > 0 {
+		return spec.Aliases[0]
+	}
+	return name
+}
+
+// networkRates returns the bytes received and sent per second by all the
+// interfaces of a container between two samples, or -1 if unknown.
+func networkRates(prev, cur *v2.ContainerStats) (rx, tx float64) {
+	seconds := cur.Timestamp.Sub(prev.Timestamp).Seconds()
+	if prev.Network == nil || cur.Network == nil || seconds <= 0 {
+		return -1, -1
+	}
+	var prevRx, prevTx, curRx, curTx uint64
+	for _, i := range prev.Network.Interfaces {
+		prevRx += i.RxBytes
+		prevTx += i.TxBytes
+	}
+	for _, i := range cur.Network.Interfaces {
+		curRx += i.RxBytes
+		curTx += i.TxBytes
+	}
+	if curRx < prevRx || curTx < prevTx {
+		// An interface was removed or its counters reset.
+		return -1, -1
+	}
+	return float64(curRx-prevRx) / seconds, float64(curTx-prevTx) / seconds
+}
+
+func formatRate(bytesPerSecond float64) string {
+	if bytesPerSecond < 0 {
+		return "-"
+	}
+	return formatBytes(bytesPerSecond) + "/s"
+}
+
+func writeTop(out io.Writer, rows []*topRow) error {
+	w := tabwriter.NewWriter(out, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "CONTAINER\tCPU\tMEMORY\tLIMIT\tNET RX\tNET TX")
+	for _, row := range rows {
+		fmt.Fprintf(w, "%s\t%.2f\t%s\t%s\t%s\t%s\n", row.name, row.cpu, formatBytes(float64(row.memory)), formatLimit(row.limit), formatRate(row.rx), formatRate(row.tx))
+	}
+	return w.Flush()
+}
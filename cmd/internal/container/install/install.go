@@ -14,21 +14,3 @@
 
 // The install package registers all included container providers when imported
 package install
-
-import (
-	// Register all included container providers.
-	_ "github.com/google/cadvisor/container/containerd/install"
-	_ "github.com/google/cadvisor/container/crio/install"
-	_ "github.com/google/cadvisor/container/docker/install"
-	_ "github.com/google/cadvisor/container/podman/install"
-	_ "github.com/google/cadvisor/container/systemd/install"
-
-	// Register all filesystem plugins.
-	_ "github.com/google/cadvisor/fs/btrfs/install"
-	_ "github.com/google/cadvisor/fs/devicemapper/install"
-	_ "github.com/google/cadvisor/fs/nfs/install"
-	_ "github.com/google/cadvisor/fs/overlay/install"
-	_ "github.com/google/cadvisor/fs/tmpfs/install"
-	_ "github.com/google/cadvisor/fs/vfs/install"
-	_ "github.com/google/cadvisor/fs/zfs/install"
-)
@@ -0,0 +1,325 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package federation serves a merged view of this cAdvisor and of remote
+// cAdvisors: their machines, their containers and their Prometheus metrics
+// labelled by instance.
+package federation
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+	"github.com/prometheus/common/model"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/google/cadvisor/client"
+	info "github.com/google/cadvisor/info/v1"
+	"github.com/google/cadvisor/manager"
+)
+
+// InstanceLabel is the label naming the instance of the federated metrics.
+const InstanceLabel = "instance"
+
+// Peer is a remote cAdvisor.
+type Peer struct {
+	// Name of the instance in the merged view.
+	Name string
+	// Base URL of the cAdvisor, e.g. http://10.0.0.2:8080, possibly with the
+	// user and password of HTTP basic auth. Only the URL without them is
+	// used in requests and errors, the credentials are sent in the
+	// Authorization header.
+	URL string
+}
+
+// ParsePeers parses a comma-separated list of peers, as name=URL or URL, in
+// which case they are named after the host of the URL.
+func ParsePeers(peers string) ([]Peer, error) {
+	var parsed []Peer
+	for _, p := range strings.Split(peers, ",") {
+		p = strings.TrimSpace(p)
+		if p == "" {
+			continue
+		}
+		name, rawURL, ok := strings.Cut(p, "=")
+		if !ok {
+			rawURL = p
+		}
+		u, err := url.Parse(rawURL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return nil, fmt.Errorf("invalid URL of peer %q, it must be an http or https URL", p)
+		}
+		if !ok {
+			name = u.Host
+		}
+		parsed = append(parsed, Peer{Name: name, URL: rawURL})
+	}
+	return parsed, nil
+}
+
+// instance is a cAdvisor of the merged view.
+type instance interface {
+	machineInfo() (*info.MachineInfo, error)
+	// containers returns the info of the named container, and of all its
+	// subcontainers if recursive.
+	containers(name string, query *info.ContainerInfoRequest, recursive bool) ([]info.ContainerInfo, error)
+	metrics() ([]*dto.MetricFamily, error)
+}
+
+// localInstance is this cAdvisor.
+type localInstance struct {
+	manager  manager.Manager
+	gatherer prometheus.Gatherer
+}
+
+func (l *localInstance) machineInfo() (*info.MachineInfo, error) {
+	return l.manager.GetMachineInfo()
+}
+
+func (l *localInstance) containers(name string, query *info.ContainerInfoRequest, recursive bool) ([]info.ContainerInfo, error) {
+	if !recursive {
+		cont, err := l.manager.GetContainerInfo(name, query)
+		if err != nil {
+			return nil, err
+		}
+		return []info.ContainerInfo{*cont}, nil
+	}
+	conts, err := l.manager.SubcontainersInfo(name, query)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]info.ContainerInfo, 0, len(conts))
+	for _, cont := range conts {
+		ret = append(ret, *cont)
+	}
+	return ret, nil
+}
+
+func (l *localInstance) metrics() ([]*dto.MetricFamily, error) {
+	return l.gatherer.Gather()
+}
+
+// remoteInstance is a peer.
+type remoteInstance struct {
+	client     *client.Client
+	metricsURL string
+	httpClient *http.Client
+}
+
+func newRemoteInstance(peer Peer, metricsPath string, timeout time.Duration) (*remoteInstance, error) {
+	u, err := url.Parse(peer.URL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid URL of peer %q: %v", peer.Name, err)
+	}
+	httpClient := &http.Client{Timeout: timeout}
+	if u.User != nil {
+		password, _ := u.User.Password()
+		httpClient.Transport = &basicAuthTransport{username: u.User.Username(), password: password, base: http.DefaultTransport}
+		u.User = nil
+	}
+	baseURL := u.String()
+	c, err := client.NewClientWithHTTPClient(baseURL, httpClient)
+	if err != nil {
+		return nil, err
+	}
+	return &remoteInstance{
+		client:     c,
+		metricsURL: strings.TrimSuffix(baseURL, "/") + metricsPath,
+		httpClient: httpClient,
+	}, nil
+}
+
+// basicAuthTransport sets the HTTP basic auth credentials of a peer on its
+// requests, keeping them out of the URLs.
+type basicAuthTransport struct {
+	username string
+	password string
+	base     http.RoundTripper
+}
+
+func (t *basicAuthTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	req.SetBasicAuth(t.username, t.password)
+	return t.base.RoundTrip(req)
+}
+
+func (r *remoteInstance) machineInfo() (*info.MachineInfo, error) {
+	return r.client.MachineInfo()
+}
+
+func (r *remoteInstance) containers(name string, query *info.ContainerInfoRequest, recursive bool) ([]info.ContainerInfo, error) {
+	if recursive {
+		return r.client.SubcontainersInfo(name, query)
+	}
+	cont, err := r.client.ContainerInfo(name, query)
+	if err != nil {
+		return nil, err
+	}
+	return []info.ContainerInfo{*cont}, nil
+}
+
+func (r *remoteInstance) metrics() ([]*dto.MetricFamily, error) {
+	resp, err := r.httpClient.Get(r.metricsURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the metrics: %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s returned %s", r.metricsURL, resp.Status)
+	}
+	parser := expfmt.NewTextParser(model.UTF8Validation)
+	families, err := parser.TextToMetricFamilies(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the metrics of %s: %v", r.metricsURL, err)
+	}
+	ret := make([]*dto.MetricFamily, 0, len(families))
+	for _, family := range families {
+		ret = append(ret, family)
+	}
+	return ret, nil
+}
+
+// Federation merges the views of this cAdvisor and of its peers.
+type Federation struct {
+	// Names of the instances, this one first, then the peers in order.
+	names     []string
+	instances map[string]instance
+}
+
+// New returns the Federation of this cAdvisor, named name, whose containers
+// are those of the manager and whose metrics are those of the gatherer, with
+// the peers, whose metrics are served at metricsPath. Requests to the peers
+// time out after timeout.
+func New(name string, m manager.Manager, gatherer prometheus.Gatherer, peers []Peer, metricsPath string, timeout time.Duration) (*Federation, error) {
+	f := &Federation{
+		names:     []string{name},
+		instances: map[string]instance{name: &localInstance{manager: m, gatherer: gatherer}},
+	}
+	for _, peer := range peers {
+		if _, ok := f.instances[peer.Name]; ok {
+			return nil, fmt.Errorf("instance %q is named twice", peer.Name)
+		}
+		remote, err := newRemoteInstance(peer, metricsPath, timeout)
+		if err != nil {
+			return nil, err
+		}
+		f.names = append(f.names, peer.Name)
+		f.instances[peer.Name] = remote
+	}
+	return f, nil
+}
+
+// View is the merged view of the instances.
+type View[T any] struct {
+	// What each instance returned, by instance.
+	Instances map[string]T `json:"instances"`
+	// The errors of the instances that failed, by instance.
+	Errors map[string]string `json:"errors,omitempty"`
+}
+
+// gather calls get for all the instances concurrently.
+func gather[T any](f *Federation, get func(instance) (T, error)) View[T] {
+	view := View[T]{Instances: map[string]T{}}
+	var lock sync.Mutex
+	var wg sync.WaitGroup
+	for name, inst := range f.instances {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			ret, err := get(inst)
+			lock.Lock()
+			defer lock.Unlock()
+			if err != nil {
+				if view.Errors == nil {
+					view.Errors = map[string]string{}
+				}
+				view.Errors[name] = err.Error()
+				return
+			}
+			view.Instances[name] = ret
+		}()
+	}
+	wg.Wait()
+	return view
+}
+
+// MachineInfo returns the machines of the instances.
+func (f *Federation) MachineInfo() View[*info.MachineInfo] {
+	return gather(f, instance.machineInfo)
+}
+
+// Containers returns the info of the named container of the instances, and
+// of all its subcontainers if recursive.
+func (f *Federation) Containers(name string, query *info.ContainerInfoRequest, recursive bool) View[[]info.ContainerInfo] {
+	return gather(f, func(inst instance) ([]info.ContainerInfo, error) {
+		return inst.containers(name, query, recursive)
+	})
+}
+
+// Gather implements prometheus.Gatherer, returning the metrics of all the
+// instances labelled with their instance, and whether each instance
+// responded.
+func (f *Federation) Gather() ([]*dto.MetricFamily, error) {
+	view := gather(f, instance.metrics)
+	up := &dto.MetricFamily{
+		Name: proto.String("cadvisor_federation_instance_up"),
+		Help: proto.String("Whether the instance of the federated metrics responded (1) or not (0)."),
+		Type: dto.MetricType_GAUGE.Enum(),
+	}
+	gatherers := prometheus.Gatherers{}
+	for _, name := range f.names {
+		value := 0.0
+		if families, ok := view.Instances[name]; ok {
+			value = 1
+			addInstanceLabel(families, name)
+			gatherers = append(gatherers, prometheus.GathererFunc(func() ([]*dto.MetricFamily, error) {
+				return families, nil
+			}))
+		}
+		up.Metric = append(up.Metric, &dto.Metric{
+			Label: []*dto.LabelPair{{Name: proto.String(InstanceLabel), Value: proto.String(name)}},
+			Gauge: &dto.Gauge{Value: &value},
+		})
+	}
+	gatherers = append(gatherers, prometheus.GathererFunc(func() ([]*dto.MetricFamily, error) {
+		return []*dto.MetricFamily{up}, nil
+	}))
+	return gatherers.Gather()
+}
+
+// addInstanceLabel sets the instance label of all the metrics, replacing the
+// one they may have.
+func addInstanceLabel(families []*dto.MetricFamily, name string) {
+	for _, family := range families {
+		for _, metric := range family.Metric {
+			labels := metric.Label[:0]
+			for _, label := range metric.Label {
+				if label.GetName() != InstanceLabel {
+					labels = append(labels, label)
+				}
+			}
+			labels = append(labels, &dto.LabelPair{Name: proto.String(InstanceLabel), Value: proto.String(name)})
+			sort.Slice(labels, func(i, j int) bool { return labels[i].GetName() < labels[j].GetName() })
+			metric.Label = labels
+		}
+	}
+}
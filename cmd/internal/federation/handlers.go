@@ -0,0 +1,73 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package federation
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+
+	httpmux "github.com/google/cadvisor/cmd/internal/http/mux"
+	info "github.com/google/cadvisor/info/v1"
+)
+
+const (
+	// MachinePath serves the machines of the instances.
+	MachinePath = "/federation/machine"
+	// ContainersPath serves the named container of the instances, e.g.
+	// /federation/containers/docker, with the "count" latest stats, 1 by
+	// default, and all its subcontainers too if "recursive" is true.
+	ContainersPath = "/federation/containers/"
+	// MetricsPath serves the Prometheus metrics of the instances.
+	MetricsPath = "/federation/metrics"
+)
+
+// RegisterHandlers registers the handlers of the merged view.
+func (f *Federation) RegisterHandlers(mux httpmux.Mux) {
+	mux.HandleFunc(MachinePath, func(w http.ResponseWriter, r *http.Request) {
+		writeResult(w, f.MachineInfo())
+	})
+	mux.HandleFunc(ContainersPath, f.serveContainers)
+	mux.Handle(MetricsPath, promhttp.HandlerFor(f, promhttp.HandlerOpts{ErrorHandling: promhttp.ContinueOnError}))
+}
+
+func (f *Federation) serveContainers(w http.ResponseWriter, r *http.Request) {
+	name := "/" + strings.TrimPrefix(r.URL.Path, ContainersPath)
+	query := &info.ContainerInfoRequest{NumStats: 1}
+	if count := r.URL.Query().Get("count"); count != "" {
+		n, err := strconv.Atoi(count)
+		if err != nil || n < -1 {
+			http.Error(w, fmt.Sprintf("invalid 'count' option %q", count), http.StatusBadRequest)
+			return
+		}
+		query.NumStats = n
+	}
+	recursive := r.URL.Query().Get("recursive") == "true"
+	writeResult(w, f.Containers(name, query, recursive))
+}
+
+func writeResult(w http.ResponseWriter, res interface{}) {
+	out, err := json.Marshal(res)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed to marshall response %+v with error: %s", res, err), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(out)
+}
@@ -0,0 +1,220 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	httpmux "github.com/google/cadvisor/cmd/internal/http/mux"
+
+	"k8s.io/utils/clock"
+)
+
+// ResponseCache serves identical GET requests arriving within a short time
+// of each other from one response, so that several scrapers or probes
+// hitting the same endpoints do not each walk the container hierarchy.
+// Requests arriving while the response is being computed wait for it rather
+// than computing it again. Only successful responses are cached, and
+// streaming requests are never.
+type ResponseCache struct {
+	ttl   time.Duration
+	clock clock.Clock
+
+	lock    sync.Mutex
+	entries map[string]*cachedResponse
+}
+
+type cachedResponse struct {
+	// done is closed once the response is recorded.
+	done chan struct{}
+	// The fields below are set under the lock of the cache before done is closed.
+	recorded  bool
+	cacheable bool
+	expires   time.Time
+	status    int
+	header    http.Header
+	body      []byte
+}
+
+// NewResponseCache returns a cache keeping responses for ttl.
+func NewResponseCache(ttl time.Duration) *ResponseCache {
+	return newResponseCache(ttl, clock.RealClock{})
+}
+
+func newResponseCache(ttl time.Duration, clock clock.Clock) *ResponseCache {
+	return &ResponseCache{
+		ttl:     ttl,
+		clock:   clock,
+		entries: make(map[string]*cachedResponse),
+	}
+}
+
+// Mux returns a mux registering the handlers on mux behind the cache. A nil
+// cache returns mux itself.
+func (c *ResponseCache) Mux(mux httpmux.Mux) httpmux.Mux {
+	if c == nil {
+		return mux
+	}
+	return &cachingMux{Mux: mux, cache: c}
+}
+
+type cachingMux struct {
+	httpmux.Mux
+	cache *ResponseCache
+}
+
+func (m *cachingMux) Handle(pattern string, handler http.Handler) {
+	m.Mux.Handle(pattern, m.cache.Wrap(handler))
+}
+
+func (m *cachingMux) HandleFunc(pattern string, handler func(http.ResponseWriter, *http.Request)) {
+	m.Handle(pattern, http.HandlerFunc(handler))
+}
+
+// Wrap returns handler behind the cache. A nil cache returns handler itself.
+func (c *ResponseCache) Wrap(handler http.Handler) http.Handler {
+	if c == nil {
+		return handler
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || streaming(r) {
+			handler.ServeHTTP(w, r)
+			return
+		}
+		key := responseCacheKey(r)
+		entry, owner := c.lookup(key)
+		if owner {
+			c.record(key, entry, handler, r)
+		} else {
+			select {
+			case <-entry.done:
+			case <-r.Context().Done():
+				return
+			}
+		}
+		if !owner && !entry.cacheable {
+			handler.ServeHTTP(w, r)
+			return
+		}
+		entry.write(w)
+	})
+}
+
+// streaming returns whether r asks for a response streamed until the client
+// goes away, e.g. events with ?stream=true or the server-sent events of the
+// stream API, which need to be flushed as they are written.
+func streaming(r *http.Request) bool {
+	if r.URL.Query().Has("stream") || strings.Contains(r.Header.Get("Accept"), "text/event-stream") {
+		return true
+	}
+	// /api/<version>/stream/<container>
+	elements := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	for i := 0; i+2 < len(elements); i++ {
+		if elements[i] == "api" && elements[i+2] == "stream" {
+			return true
+		}
+	}
+	return false
+}
+
+// lookup returns the entry of key, and whether the caller is to record it.
+func (c *ResponseCache) lookup(key string) (*cachedResponse, bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	now := c.clock.Now()
+	if entry, ok := c.entries[key]; ok && (!entry.recorded || now.Before(entry.expires)) {
+		return entry, false
+	}
+	for k, entry := range c.entries {
+		if entry.recorded && !now.Before(entry.expires) {
+			delete(c.entries, k)
+		}
+	}
+	entry := &cachedResponse{done: make(chan struct{})}
+	c.entries[key] = entry
+	return entry, true
+}
+
+// record serves r into entry.
+func (c *ResponseCache) record(key string, entry *cachedResponse, handler http.Handler, r *http.Request) {
+	recorder := &responseRecorder{header: make(http.Header)}
+	completed := false
+	defer func() {
+		c.lock.Lock()
+		defer c.lock.Unlock()
+		entry.recorded = true
+		entry.status = recorder.statusCode()
+		entry.header = recorder.header
+		entry.body = recorder.body.Bytes()
+		// A handler that panicked may have left a partial response.
+		entry.cacheable = completed && entry.status == http.StatusOK
+		entry.expires = c.clock.Now().Add(c.ttl)
+		if !entry.cacheable && c.entries[key] == entry {
+			delete(c.entries, key)
+		}
+		close(entry.done)
+	}()
+	handler.ServeHTTP(recorder, r)
+	completed = true
+}
+
+func (e *cachedResponse) write(w http.ResponseWriter) {
+	header := w.Header()
+	for k, v := range e.header {
+		header[k] = v
+	}
+	w.WriteHeader(e.status)
+	w.Write(e.body)
+}
+
+// responseCacheKey identifies the responses that are the same for all
+// requests. The response of the metrics endpoint depends on the accepted
+// format and encoding.
+func responseCacheKey(r *http.Request) string {
+	return r.URL.Path + "?" + r.URL.Query().Encode() + "\x00" + r.Header.Get("Accept") + "\x00" + r.Header.Get("Accept-Encoding")
+}
+
+// responseRecorder is an http.ResponseWriter keeping the response in memory.
+type responseRecorder struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func (r *responseRecorder) Header() http.Header {
+	return r.header
+}
+
+func (r *responseRecorder) WriteHeader(status int) {
+	if r.status == 0 {
+		r.status = status
+	}
+}
+
+func (r *responseRecorder) Write(b []byte) (int, error) {
+	r.WriteHeader(http.StatusOK)
+	return r.body.Write(b)
+}
+
+func (r *responseRecorder) statusCode() int {
+	if r.status == 0 {
+		return http.StatusOK
+	}
+	return r.status
+}
@@ -0,0 +1,187 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bufio"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/clock"
+	clocktesting "k8s.io/utils/clock/testing"
+)
+
+// countingHandler answers with the number of requests it served.
+type countingHandler struct {
+	calls  atomic.Int32
+	status int
+}
+
+func (h *countingHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	n := h.calls.Add(1)
+	w.Header().Set("Content-Type", "text/plain")
+	if h.status != 0 {
+		w.WriteHeader(h.status)
+	}
+	w.Write([]byte{byte('0' + n)})
+}
+
+func get(handler http.Handler, target string, header ...string) *httptest.ResponseRecorder {
+	r := httptest.NewRequest(http.MethodGet, target, nil)
+	for i := 0; i+1 < len(header); i += 2 {
+		r.Header.Set(header[i], header[i+1])
+	}
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, r)
+	return w
+}
+
+func TestResponseCache(t *testing.T) {
+	fakeClock := clocktesting.NewFakeClock(time.Now())
+	h := &countingHandler{}
+	handler := newResponseCache(time.Second, fakeClock).Wrap(h)
+
+	first := get(handler, "/api/v1.3/containers?a=1&b=2")
+	assert.Equal(t, "1", first.Body.String())
+	assert.Equal(t, "text/plain", first.Header().Get("Content-Type"))
+	// The order of the parameters does not matter.
+	assert.Equal(t, "1", get(handler, "/api/v1.3/containers?b=2&a=1").Body.String())
+	assert.Equal(t, "2", get(handler, "/api/v1.3/containers?a=2").Body.String())
+	assert.Equal(t, "3", get(handler, "/api/v1.3/containers?a=1&b=2", "Accept-Encoding", "gzip").Body.String())
+
+	fakeClock.Step(time.Second)
+	assert.Equal(t, "4", get(handler, "/api/v1.3/containers?a=1&b=2").Body.String())
+}
+
+func TestResponseCacheSkipsFailuresAndStreams(t *testing.T) {
+	h := &countingHandler{status: http.StatusInternalServerError}
+	handler := NewResponseCache(time.Minute).Wrap(h)
+	assert.Equal(t, http.StatusInternalServerError, get(handler, "/api/v1.3/machine").Code)
+	assert.Equal(t, "2", get(handler, "/api/v1.3/machine").Body.String())
+
+	h = &countingHandler{}
+	handler = NewResponseCache(time.Minute).Wrap(h)
+	get(handler, "/api/v1.3/events?stream=true")
+	get(handler, "/api/v1.3/events?stream=true")
+	assert.Equal(t, int32(2), h.calls.Load())
+
+	r := httptest.NewRequest(http.MethodPost, "/api/v1.3/machine", nil)
+	handler.ServeHTTP(httptest.NewRecorder(), r)
+	handler.ServeHTTP(httptest.NewRecorder(), r)
+	assert.Equal(t, int32(4), h.calls.Load())
+}
+
+func TestResponseCacheCoalescesConcurrentRequests(t *testing.T) {
+	release := make(chan struct{})
+	var calls atomic.Int32
+	handler := newResponseCache(time.Minute, clock.RealClock{}).Wrap(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		<-release
+		w.Write([]byte("done"))
+	}))
+
+	var wg sync.WaitGroup
+	bodies := make([]string, 5)
+	for i := range bodies {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			bodies[i] = get(handler, "/metrics").Body.String()
+		}()
+	}
+	// Let the requests queue up behind the first one.
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+	assert.Equal(t, int32(1), calls.Load())
+	for _, body := range bodies {
+		assert.Equal(t, "done", body)
+	}
+}
+
+func TestNilResponseCache(t *testing.T) {
+	var cache *ResponseCache
+	h := &countingHandler{}
+	handler := cache.Wrap(h)
+	get(handler, "/metrics")
+	get(handler, "/metrics")
+	assert.Equal(t, int32(2), h.calls.Load())
+
+	mux := http.NewServeMux()
+	assert.Same(t, mux, cache.Mux(mux))
+}
+
+func TestResponseCacheStreams(t *testing.T) {
+	// Like the stream API, the handler needs a Flusher and only returns once
+	// the client goes away.
+	mux := http.NewServeMux()
+	NewResponseCache(time.Minute).Mux(mux).HandleFunc("/api/", func(w http.ResponseWriter, r *http.Request) {
+		flusher, ok := w.(http.Flusher)
+		if !ok {
+			http.Error(w, "could not access http.Flusher", http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.Write([]byte("data: {}\n\n"))
+		flusher.Flush()
+		<-r.Context().Done()
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	for _, tc := range []struct {
+		path   string
+		accept string
+	}{
+		{"/api/v1.3/stream/docker?subcontainers=true", ""},
+		{"/api/v1.3/stream", ""},
+		{"/api/v2.1/stats/docker", "text/event-stream"},
+	} {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		r, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL+tc.path, nil)
+		require.NoError(t, err)
+		if tc.accept != "" {
+			r.Header.Set("Accept", tc.accept)
+		}
+		resp, err := http.DefaultClient.Do(r)
+		require.NoError(t, err, tc.path)
+		assert.Equal(t, http.StatusOK, resp.StatusCode, tc.path)
+		line, err := bufio.NewReader(resp.Body).ReadString('\n')
+		assert.NoError(t, err, tc.path)
+		assert.Equal(t, "data: {}\n", line, tc.path)
+		resp.Body.Close()
+		cancel()
+	}
+}
+
+func TestStreaming(t *testing.T) {
+	for target, want := range map[string]bool{
+		"/api/v1.3/events?stream=true": true,
+		"/api/v1.3/stream/docker":      true,
+		"/cadvisor/api/v1.3/stream":    true,
+		"/api/v1.3/containers/docker":  false,
+		"/api/v1.3/containers/stream":  false,
+		"/metrics":                     false,
+	} {
+		assert.Equal(t, want, streaming(httptest.NewRequest(http.MethodGet, target, nil)), target)
+	}
+}
@@ -24,8 +24,10 @@ import (
 	"github.com/google/cadvisor/cmd/internal/pages"
 	"github.com/google/cadvisor/cmd/internal/pages/static"
 	"github.com/google/cadvisor/container"
+	v2 "github.com/google/cadvisor/info/v2"
 	"github.com/google/cadvisor/manager"
 	"github.com/google/cadvisor/metrics"
+	"github.com/google/cadvisor/metrics/selfmetrics"
 	"github.com/google/cadvisor/validate"
 
 	auth "github.com/abbot/go-http-auth"
@@ -37,14 +39,28 @@ import (
 	"k8s.io/utils/clock"
 )
 
-func RegisterHandlers(mux httpmux.Mux, containerManager manager.Manager, httpAuthFile, httpAuthRealm, httpDigestFile, httpDigestRealm string, urlBasePrefix string) error {
+// RegisterHandlers registers the health, validation, API and UI handlers on
+// mux. The API is served behind responseCache, if not nil. The UI, and the
+// API if configured, are served behind the login of uiAuth, if not nil.
+func RegisterHandlers(mux httpmux.Mux, containerManager manager.Manager, httpAuthFile, httpAuthRealm, httpDigestFile, httpDigestRealm string, urlBasePrefix string, responseCache *ResponseCache, uiAuth *SessionAuth) error {
 	// Basic health handler.
 	if err := healthz.RegisterHandler(mux); err != nil {
 		return fmt.Errorf("failed to register healthz handler: %s", err)
 	}
 
+	// The login is checked before the response cache, so that cached
+	// responses aren't served without it.
+	uiMux := uiAuth.Mux(mux)
+	apiMux := mux
+	if uiAuth != nil {
+		uiAuth.RegisterHandlers(mux)
+		if uiAuth.config.ProtectAPI {
+			apiMux = uiMux
+		}
+	}
+
 	// Validation/Debug handler.
-	mux.HandleFunc(validate.ValidatePage, func(w http.ResponseWriter, r *http.Request) {
+	uiMux.HandleFunc(validate.ValidatePage, func(w http.ResponseWriter, r *http.Request) {
 		err := validate.HandleRequest(w, containerManager)
 		if err != nil {
 			http.Error(w, err.Error(), http.StatusInternalServerError)
@@ -52,7 +68,7 @@ func RegisterHandlers(mux httpmux.Mux, containerManager manager.Manager, httpAut
 	})
 
 	// Register API handler.
-	if err := api.RegisterHandlers(mux, containerManager); err != nil {
+	if err := api.RegisterHandlers(responseCache.Mux(apiMux), containerManager); err != nil {
 		return fmt.Errorf("failed to register API handlers: %s", err)
 	}
 
@@ -85,8 +101,8 @@ func RegisterHandlers(mux httpmux.Mux, containerManager manager.Manager, httpAut
 
 	// Change handler based on authenticator initialization
 	if !authenticated {
-		mux.HandleFunc(static.StaticResource, staticHandlerNoAuth)
-		if err := pages.RegisterHandlersBasic(mux, containerManager, nil, urlBasePrefix); err != nil {
+		uiMux.HandleFunc(static.StaticResource, staticHandlerNoAuth)
+		if err := pages.RegisterHandlersBasic(uiMux, containerManager, nil, urlBasePrefix); err != nil {
 			return fmt.Errorf("failed to register pages handlers: %s", err)
 		}
 	}
@@ -95,14 +111,13 @@ func RegisterHandlers(mux httpmux.Mux, containerManager manager.Manager, httpAut
 }
 
 // RegisterPrometheusHandler creates a new PrometheusCollector and configures
-// the provided HTTP mux to handle the given Prometheus endpoint.
+// the provided HTTP mux to handle the given Prometheus endpoint, behind
+// responseCache if not nil.
 func RegisterPrometheusHandler(mux httpmux.Mux, resourceManager manager.Manager, prometheusEndpoint string,
-	f metrics.ContainerLabelsFunc, includedMetrics container.MetricSet) {
-	goCollector := collectors.NewGoCollector()
-	processCollector := collectors.NewProcessCollector(collectors.ProcessCollectorOpts{})
-	machineCollector := metrics.NewPrometheusMachineCollector(resourceManager, includedMetrics)
+	f metrics.ContainerLabelsFunc, includedMetrics container.MetricSet, responseCache *ResponseCache) {
+	collectors := newPrometheusCollectors(resourceManager, includedMetrics)
 
-	mux.Handle(prometheusEndpoint, http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+	mux.Handle(prometheusEndpoint, responseCache.Wrap(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
 		opts, err := api.GetRequestOptions(req)
 		if err != nil {
 			http.Error(w, "No metrics gathered, last error:\n\n"+err.Error(), http.StatusInternalServerError)
@@ -111,15 +126,38 @@ func RegisterPrometheusHandler(mux httpmux.Mux, resourceManager manager.Manager,
 		opts.Count = 1        // we only want the latest datapoint
 		opts.Recursive = true // get all child containers
 
-		r := prometheus.NewRegistry()
-		r.MustRegister(
-			metrics.NewPrometheusCollector(resourceManager, f, includedMetrics, clock.RealClock{}, opts),
-			machineCollector,
-			goCollector,
-			processCollector,
-		)
+		r := collectors.registry(metrics.NewPrometheusCollector(resourceManager, f, includedMetrics, clock.RealClock{}, opts))
 		promhttp.HandlerFor(r, promhttp.HandlerOpts{ErrorHandling: promhttp.ContinueOnError}).ServeHTTP(w, req)
-	}))
+	})))
+}
+
+// NewPrometheusRegistry returns a registry of the metrics served by the
+// Prometheus endpoint by default, with the latest stats of all containers.
+func NewPrometheusRegistry(resourceManager manager.Manager, f metrics.ContainerLabelsFunc, includedMetrics container.MetricSet) *prometheus.Registry {
+	opts := v2.RequestOptions{IdType: v2.TypeName, Count: 1, Recursive: true}
+	return newPrometheusCollectors(resourceManager, includedMetrics).registry(metrics.NewPrometheusCollector(resourceManager, f, includedMetrics, clock.RealClock{}, opts))
+}
+
+// prometheusCollectors are the collectors of the Prometheus endpoint, but
+// the one of the containers, which depends on the request options.
+type prometheusCollectors []prometheus.Collector
+
+func newPrometheusCollectors(resourceManager manager.Manager, includedMetrics container.MetricSet) prometheusCollectors {
+	return prometheusCollectors{
+		metrics.NewPrometheusMachineCollector(resourceManager, includedMetrics),
+		selfmetrics.NewCollector(),
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+	}
+}
+
+// registry returns a registry of the collectors and of the collector of the
+// containers.
+func (c prometheusCollectors) registry(containerCollector prometheus.Collector) *prometheus.Registry {
+	r := prometheus.NewRegistry()
+	r.MustRegister(containerCollector)
+	r.MustRegister(c...)
+	return r
 }
 
 func staticHandlerNoAuth(w http.ResponseWriter, r *http.Request) {
@@ -0,0 +1,490 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	httpmux "github.com/google/cadvisor/cmd/internal/http/mux"
+
+	"golang.org/x/oauth2"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/clock"
+)
+
+const (
+	// LoginPath serves the login page of the web UI, or starts the login at
+	// the OIDC issuer.
+	LoginPath = "/login"
+	// CallbackPath is where the OIDC issuer redirects to after the login.
+	CallbackPath = "/login/callback"
+	// LogoutPath ends the session.
+	LogoutPath = "/logout"
+
+	sessionCookie = "cadvisor_session"
+	loginCookie   = "cadvisor_login"
+
+	// How long a login at the OIDC issuer may take.
+	loginTimeout = 10 * time.Minute
+)
+
+// SessionAuthConfig configures the login of the web UI, with either a static
+// token or an OIDC issuer.
+type SessionAuthConfig struct {
+	// Token logs in to the web UI. Empty to log in with OIDC.
+	Token string
+
+	// The OIDC issuer URL and the client registered with it.
+	OIDCIssuer       string
+	OIDCClientID     string
+	OIDCClientSecret string
+	// The absolute URL of CallbackPath, as registered with the issuer.
+	OIDCRedirectURL string
+	// The e-mail addresses or subjects of the users allowed to log in with
+	// OIDC. Empty allows all the users of the issuer.
+	AllowedUsers []string
+
+	// How long a login lasts.
+	SessionTTL time.Duration
+	// Whether the API also requires the login, as the web UI fetches its
+	// data from it.
+	ProtectAPI bool
+	// The prefix of the paths of cAdvisor behind a reverse proxy.
+	URLBasePrefix string
+}
+
+// SessionAuth protects the web UI with a login keeping a session in a signed
+// cookie. The sessions are signed with a key generated at start, so they end
+// when cAdvisor restarts.
+type SessionAuth struct {
+	config       SessionAuthConfig
+	clock        clock.Clock
+	key          []byte
+	allowedUsers map[string]bool
+	// oauth2 is nil when logging in with the static token.
+	oauth2 *oauth2.Config
+}
+
+// A session of a logged in user, kept in sessionCookie.
+type session struct {
+	User    string `json:"user"`
+	Expires int64  `json:"expires"`
+}
+
+// A login in progress at the OIDC issuer, kept in loginCookie.
+type pendingLogin struct {
+	State   string `json:"state"`
+	Nonce   string `json:"nonce"`
+	Next    string `json:"next"`
+	Expires int64  `json:"expires"`
+}
+
+// NewSessionAuth returns the login configured by config. With OIDC, the
+// endpoints of the issuer are discovered from it.
+func NewSessionAuth(config SessionAuthConfig) (*SessionAuth, error) {
+	return newSessionAuth(context.Background(), config, clock.RealClock{})
+}
+
+func newSessionAuth(ctx context.Context, config SessionAuthConfig, clock clock.Clock) (*SessionAuth, error) {
+	if (config.Token == "") == (config.OIDCIssuer == "") {
+		return nil, fmt.Errorf("exactly one of a token or an OIDC issuer is required")
+	}
+	if config.SessionTTL <= 0 {
+		return nil, fmt.Errorf("invalid session TTL %v", config.SessionTTL)
+	}
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		return nil, fmt.Errorf("failed to generate the session key: %w", err)
+	}
+	a := &SessionAuth{
+		config:       config,
+		clock:        clock,
+		key:          key,
+		allowedUsers: make(map[string]bool, len(config.AllowedUsers)),
+	}
+	for _, user := range config.AllowedUsers {
+		if user = strings.TrimSpace(user); user != "" {
+			a.allowedUsers[user] = true
+		}
+	}
+	if config.OIDCIssuer != "" {
+		if config.OIDCClientID == "" || config.OIDCRedirectURL == "" {
+			return nil, fmt.Errorf("an OIDC client ID and redirect URL are required")
+		}
+		endpoint, err := discoverOIDC(ctx, config.OIDCIssuer)
+		if err != nil {
+			return nil, err
+		}
+		a.oauth2 = &oauth2.Config{
+			ClientID:     config.OIDCClientID,
+			ClientSecret: config.OIDCClientSecret,
+			Endpoint:     endpoint,
+			RedirectURL:  config.OIDCRedirectURL,
+			Scopes:       []string{"openid", "email"},
+		}
+	}
+	return a, nil
+}
+
+// discoverOIDC returns the endpoints of issuer from its discovery document.
+func discoverOIDC(ctx context.Context, issuer string) (oauth2.Endpoint, error) {
+	discoveryURL := strings.TrimSuffix(issuer, "/") + "/.well-known/openid-configuration"
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, discoveryURL, nil)
+	if err != nil {
+		return oauth2.Endpoint{}, err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return oauth2.Endpoint{}, fmt.Errorf("failed to get the discovery document of %q: %w", issuer, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return oauth2.Endpoint{}, fmt.Errorf("failed to get the discovery document of %q: %s", issuer, resp.Status)
+	}
+	var doc struct {
+		Issuer                string `json:"issuer"`
+		AuthorizationEndpoint string `json:"authorization_endpoint"`
+		TokenEndpoint         string `json:"token_endpoint"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&doc); err != nil {
+		return oauth2.Endpoint{}, fmt.Errorf("failed to decode the discovery document of %q: %w", issuer, err)
+	}
+	if doc.Issuer != issuer {
+		return oauth2.Endpoint{}, fmt.Errorf("the discovery document of %q is for issuer %q", issuer, doc.Issuer)
+	}
+	return oauth2.Endpoint{AuthURL: doc.AuthorizationEndpoint, TokenURL: doc.TokenEndpoint}, nil
+}
+
+// Mux returns a mux registering the handlers on mux behind the login. A nil
+// login returns mux itself.
+func (a *SessionAuth) Mux(mux httpmux.Mux) httpmux.Mux {
+	if a == nil {
+		return mux
+	}
+	return &sessionMux{Mux: mux, auth: a}
+}
+
+type sessionMux struct {
+	httpmux.Mux
+	auth *SessionAuth
+}
+
+func (m *sessionMux) Handle(pattern string, handler http.Handler) {
+	m.Mux.Handle(pattern, m.auth.Wrap(handler))
+}
+
+func (m *sessionMux) HandleFunc(pattern string, handler func(http.ResponseWriter, *http.Request)) {
+	m.Handle(pattern, http.HandlerFunc(handler))
+}
+
+// Wrap returns handler behind the login. Pages redirect to the login page
+// without a session, while the API and the AJAX requests of the web UI are
+// refused. A nil login returns handler itself.
+func (a *SessionAuth) Wrap(handler http.Handler) http.Handler {
+	if a == nil {
+		return handler
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if a.authenticated(r) {
+			handler.ServeHTTP(w, r)
+			return
+		}
+		if r.Method != http.MethodGet || strings.HasPrefix(r.URL.Path, "/api/") || r.Header.Get("X-Requested-With") == "XMLHttpRequest" {
+			http.Error(w, "login required", http.StatusUnauthorized)
+			return
+		}
+		next := a.config.URLBasePrefix + r.URL.RequestURI()
+		http.Redirect(w, r, a.config.URLBasePrefix+LoginPath+"?next="+url.QueryEscape(next), http.StatusFound)
+	})
+}
+
+// authenticated returns whether r has a session, or the static token as a
+// bearer token for API clients.
+func (a *SessionAuth) authenticated(r *http.Request) bool {
+	if a.config.Token != "" {
+		if token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer "); ok && a.validToken(token) {
+			return true
+		}
+	}
+	cookie, err := r.Cookie(sessionCookie)
+	if err != nil {
+		return false
+	}
+	var s session
+	return a.decode(sessionCookie, cookie.Value, &s) && s.User != "" && a.clock.Now().Unix() < s.Expires
+}
+
+func (a *SessionAuth) validToken(token string) bool {
+	return subtle.ConstantTimeCompare([]byte(token), []byte(a.config.Token)) == 1
+}
+
+// RegisterHandlers registers the login and logout handlers on mux, which must
+// not be behind the login.
+func (a *SessionAuth) RegisterHandlers(mux httpmux.Mux) {
+	mux.HandleFunc(LoginPath, a.serveLogin)
+	if a.oauth2 != nil {
+		mux.HandleFunc(CallbackPath, a.serveCallback)
+	}
+	mux.HandleFunc(LogoutPath, a.serveLogout)
+}
+
+var loginTemplate = template.Must(template.New("login").Parse(`<html>
+  <head>
+    <title>cAdvisor - Login</title>
+  </head>
+  <body>
+    <h1>cAdvisor</h1>
+    {{if .Error}}<p>{{.Error}}</p>{{end}}
+    <form method="POST">
+      <input type="hidden" name="next" value="{{.Next}}">
+      <input type="password" name="token" placeholder="Token" autofocus>
+      <input type="submit" value="Log in">
+    </form>
+  </body>
+</html>
+`))
+
+func (a *SessionAuth) serveLogin(w http.ResponseWriter, r *http.Request) {
+	next := a.nextURL(r.FormValue("next"))
+	if a.oauth2 != nil {
+		a.startOIDCLogin(w, r, next)
+		return
+	}
+
+	data := struct {
+		Next  string
+		Error string
+	}{Next: next}
+	if r.Method == http.MethodPost {
+		if a.validToken(r.PostFormValue("token")) {
+			a.startSession(w, r, "token")
+			http.Redirect(w, r, next, http.StatusSeeOther)
+			return
+		}
+		data.Error = "Invalid token."
+		w.WriteHeader(http.StatusUnauthorized)
+	}
+	if err := loginTemplate.Execute(w, data); err != nil {
+		klog.Errorf("Failed to apply template: %s", err)
+	}
+}
+
+// startOIDCLogin redirects to the issuer, keeping the state of the login in
+// a cookie until the issuer redirects back.
+func (a *SessionAuth) startOIDCLogin(w http.ResponseWriter, r *http.Request, next string) {
+	login := pendingLogin{
+		State:   randomString(),
+		Nonce:   randomString(),
+		Next:    next,
+		Expires: a.clock.Now().Add(loginTimeout).Unix(),
+	}
+	a.setCookie(w, r, loginCookie, a.encode(loginCookie, login), loginTimeout)
+	http.Redirect(w, r, a.oauth2.AuthCodeURL(login.State, oauth2.SetAuthURLParam("nonce", login.Nonce)), http.StatusFound)
+}
+
+func (a *SessionAuth) serveCallback(w http.ResponseWriter, r *http.Request) {
+	var login pendingLogin
+	cookie, err := r.Cookie(loginCookie)
+	if err != nil || !a.decode(loginCookie, cookie.Value, &login) || a.clock.Now().Unix() >= login.Expires || r.FormValue("state") != login.State {
+		http.Error(w, "invalid or expired login, please log in again", http.StatusBadRequest)
+		return
+	}
+	a.setCookie(w, r, loginCookie, "", -1)
+	if loginErr := r.FormValue("error"); loginErr != "" {
+		http.Error(w, fmt.Sprintf("login failed: %s", loginErr), http.StatusUnauthorized)
+		return
+	}
+
+	token, err := a.oauth2.Exchange(r.Context(), r.FormValue("code"))
+	if err != nil {
+		klog.Warningf("Failed to exchange the OIDC authorization code: %v", err)
+		http.Error(w, "login failed", http.StatusUnauthorized)
+		return
+	}
+	rawIDToken, _ := token.Extra("id_token").(string)
+	user, err := a.verifyIDToken(rawIDToken, login.Nonce)
+	if err != nil {
+		klog.Warningf("Invalid OIDC ID token: %v", err)
+		http.Error(w, "login failed", http.StatusUnauthorized)
+		return
+	}
+	if len(a.allowedUsers) > 0 && !a.allowedUsers[user] {
+		http.Error(w, fmt.Sprintf("user %q is not allowed", user), http.StatusForbidden)
+		return
+	}
+	a.startSession(w, r, user)
+	http.Redirect(w, r, login.Next, http.StatusSeeOther)
+}
+
+// The claims of an ID token checked by cAdvisor.
+type idTokenClaims struct {
+	Issuer   string   `json:"iss"`
+	Subject  string   `json:"sub"`
+	Audience audience `json:"aud"`
+	Expiry   int64    `json:"exp"`
+	Nonce    string   `json:"nonce"`
+	Email    string   `json:"email"`
+}
+
+// The audience of an ID token is either a string or an array of strings.
+type audience []string
+
+func (a *audience) UnmarshalJSON(b []byte) error {
+	var single string
+	if err := json.Unmarshal(b, &single); err == nil {
+		*a = audience{single}
+		return nil
+	}
+	return json.Unmarshal(b, (*[]string)(a))
+}
+
+// verifyIDToken checks the claims of the ID token returned with the access
+// token and returns the user it identifies, by e-mail address if known. The
+// ID token comes directly from the token endpoint of the issuer, so its
+// signature isn't checked (OpenID Connect Core 1.0, section 3.1.3.7).
+func (a *SessionAuth) verifyIDToken(rawIDToken, nonce string) (string, error) {
+	parts := strings.Split(rawIDToken, ".")
+	if len(parts) != 3 {
+		return "", fmt.Errorf("malformed ID token")
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return "", fmt.Errorf("malformed ID token: %w", err)
+	}
+	var claims idTokenClaims
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return "", fmt.Errorf("malformed ID token: %w", err)
+	}
+	if claims.Issuer != a.config.OIDCIssuer {
+		return "", fmt.Errorf("unexpected issuer %q", claims.Issuer)
+	}
+	found := false
+	for _, aud := range claims.Audience {
+		found = found || aud == a.config.OIDCClientID
+	}
+	if !found {
+		return "", fmt.Errorf("ID token not issued for client %q", a.config.OIDCClientID)
+	}
+	if a.clock.Now().Unix() >= claims.Expiry {
+		return "", fmt.Errorf("expired ID token")
+	}
+	if claims.Nonce != nonce {
+		return "", fmt.Errorf("unexpected nonce")
+	}
+	if claims.Email != "" {
+		return claims.Email, nil
+	}
+	if claims.Subject == "" {
+		return "", fmt.Errorf("ID token without subject")
+	}
+	return claims.Subject, nil
+}
+
+func (a *SessionAuth) serveLogout(w http.ResponseWriter, r *http.Request) {
+	a.setCookie(w, r, sessionCookie, "", -1)
+	http.Redirect(w, r, a.config.URLBasePrefix+LoginPath, http.StatusSeeOther)
+}
+
+func (a *SessionAuth) startSession(w http.ResponseWriter, r *http.Request, user string) {
+	s := session{
+		User:    user,
+		Expires: a.clock.Now().Add(a.config.SessionTTL).Unix(),
+	}
+	a.setCookie(w, r, sessionCookie, a.encode(sessionCookie, s), a.config.SessionTTL)
+	klog.V(2).Infof("Web UI login of %q", user)
+}
+
+// nextURL returns where to redirect after the login. Only paths of cAdvisor
+// are allowed, not to redirect to other sites.
+func (a *SessionAuth) nextURL(next string) string {
+	if !strings.HasPrefix(next, "/") || strings.HasPrefix(next, "//") || strings.HasPrefix(next, "/\\") {
+		return a.config.URLBasePrefix + "/"
+	}
+	return next
+}
+
+// setCookie sets the cookie name for all the paths of cAdvisor. A negative
+// ttl deletes it.
+func (a *SessionAuth) setCookie(w http.ResponseWriter, r *http.Request, name, value string, ttl time.Duration) {
+	maxAge := int(ttl.Seconds())
+	if ttl < 0 {
+		maxAge = -1
+	}
+	http.SetCookie(w, &http.Cookie{
+		Name:     name,
+		Value:    value,
+		Path:     a.config.URLBasePrefix + "/",
+		MaxAge:   maxAge,
+		HttpOnly: true,
+		Secure:   r.TLS != nil || r.Header.Get("X-Forwarded-Proto") == "https",
+		SameSite: http.SameSiteLaxMode,
+	})
+}
+
+// encode returns v as JSON signed with the session key for the cookie
+// purpose, so that the value of one cookie isn't valid as another.
+func (a *SessionAuth) encode(purpose string, v interface{}) string {
+	b, err := json.Marshal(v)
+	if err != nil {
+		// Only fixed structs are encoded.
+		panic(err)
+	}
+	payload := base64.RawURLEncoding.EncodeToString(b)
+	return payload + "." + base64.RawURLEncoding.EncodeToString(a.sign(purpose, payload))
+}
+
+// decode decodes into v a value encoded by encode for purpose, and returns
+// whether its signature is valid.
+func (a *SessionAuth) decode(purpose, value string, v interface{}) bool {
+	payload, signature, ok := strings.Cut(value, ".")
+	if !ok {
+		return false
+	}
+	mac, err := base64.RawURLEncoding.DecodeString(signature)
+	if err != nil || !hmac.Equal(mac, a.sign(purpose, payload)) {
+		return false
+	}
+	b, err := base64.RawURLEncoding.DecodeString(payload)
+	if err != nil {
+		return false
+	}
+	return json.Unmarshal(b, v) == nil
+}
+
+func (a *SessionAuth) sign(purpose, payload string) []byte {
+	mac := hmac.New(sha256.New, a.key)
+	mac.Write([]byte(purpose + "|" + payload))
+	return mac.Sum(nil)
+}
+
+func randomString() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b)
+}
@@ -0,0 +1,270 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	clocktesting "k8s.io/utils/clock/testing"
+)
+
+func newTestMux(auth *SessionAuth) *http.ServeMux {
+	mux := http.NewServeMux()
+	auth.RegisterHandlers(mux)
+	auth.Mux(mux).Handle("/", &countingHandler{})
+	return mux
+}
+
+func serve(handler http.Handler, r *http.Request, cookies ...*http.Cookie) *httptest.ResponseRecorder {
+	for _, c := range cookies {
+		r.AddCookie(c)
+	}
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, r)
+	return w
+}
+
+func getCookie(w *httptest.ResponseRecorder, name string) *http.Cookie {
+	for _, c := range w.Result().Cookies() {
+		if c.Name == name {
+			return c
+		}
+	}
+	return nil
+}
+
+func TestSessionAuthToken(t *testing.T) {
+	fakeClock := clocktesting.NewFakeClock(time.Now())
+	auth, err := newSessionAuth(context.Background(), SessionAuthConfig{Token: "secret", SessionTTL: time.Hour, URLBasePrefix: "/cadvisor"}, fakeClock)
+	require.NoError(t, err)
+	mux := newTestMux(auth)
+
+	// Pages redirect to the login page, the API is refused.
+	w := serve(mux, httptest.NewRequest(http.MethodGet, "/containers/docker?a=b", nil))
+	assert.Equal(t, http.StatusFound, w.Code)
+	assert.Equal(t, "/cadvisor/login?next="+url.QueryEscape("/cadvisor/containers/docker?a=b"), w.Header().Get("Location"))
+	w = serve(mux, httptest.NewRequest(http.MethodGet, "/api/v1.3/containers/", nil))
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+
+	// A wrong token is refused.
+	form := url.Values{"token": {"wrong"}, "next": {"/cadvisor/containers/"}}
+	r := httptest.NewRequest(http.MethodPost, "/login", strings.NewReader(form.Encode()))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	w = serve(mux, r)
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+	assert.Nil(t, getCookie(w, sessionCookie))
+
+	// The token starts a session.
+	form.Set("token", "secret")
+	r = httptest.NewRequest(http.MethodPost, "/login", strings.NewReader(form.Encode()))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	w = serve(mux, r)
+	assert.Equal(t, http.StatusSeeOther, w.Code)
+	assert.Equal(t, "/cadvisor/containers/", w.Header().Get("Location"))
+	cookie := getCookie(w, sessionCookie)
+	require.NotNil(t, cookie)
+	assert.Equal(t, "/cadvisor/", cookie.Path)
+	assert.True(t, cookie.HttpOnly)
+
+	w = serve(mux, httptest.NewRequest(http.MethodGet, "/api/v1.3/containers/", nil), cookie)
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	// A tampered session is refused.
+	tampered := *cookie
+	tampered.Value = strings.Replace(tampered.Value, ".", "x.", 1)
+	w = serve(mux, httptest.NewRequest(http.MethodGet, "/api/v1.3/containers/", nil), &tampered)
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+
+	// The session expires.
+	fakeClock.Step(time.Hour)
+	w = serve(mux, httptest.NewRequest(http.MethodGet, "/api/v1.3/containers/", nil), cookie)
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+
+	// API clients can use the token as a bearer token.
+	r = httptest.NewRequest(http.MethodGet, "/api/v1.3/containers/", nil)
+	r.Header.Set("Authorization", "Bearer secret")
+	w = serve(mux, r)
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestSessionAuthNextURL(t *testing.T) {
+	auth := &SessionAuth{config: SessionAuthConfig{URLBasePrefix: "/cadvisor"}}
+	for next, expected := range map[string]string{
+		"/cadvisor/docker/":   "/cadvisor/docker/",
+		"":                    "/cadvisor/",
+		"https://example.com": "/cadvisor/",
+		"//example.com":       "/cadvisor/",
+		"/\\example.com":      "/cadvisor/",
+	} {
+		assert.Equal(t, expected, auth.nextURL(next), next)
+	}
+}
+
+func TestNilSessionAuth(t *testing.T) {
+	var auth *SessionAuth
+	h := &countingHandler{}
+	w := get(auth.Wrap(h), "/containers/")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.EqualValues(t, 1, h.calls.Load())
+}
+
+func TestSessionAuthConfig(t *testing.T) {
+	_, err := NewSessionAuth(SessionAuthConfig{SessionTTL: time.Hour})
+	assert.Error(t, err)
+	_, err = NewSessionAuth(SessionAuthConfig{Token: "secret", OIDCIssuer: "https://example.com", SessionTTL: time.Hour})
+	assert.Error(t, err)
+	_, err = NewSessionAuth(SessionAuthConfig{Token: "secret"})
+	assert.Error(t, err)
+}
+
+// fakeIssuer is an OIDC issuer returning an ID token with the claims of
+// the test.
+type fakeIssuer struct {
+	*httptest.Server
+	claims map[string]interface{}
+}
+
+func newFakeIssuer(t *testing.T) *fakeIssuer {
+	issuer := &fakeIssuer{}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]string{
+			"issuer":                 issuer.URL,
+			"authorization_endpoint": issuer.URL + "/authorize",
+			"token_endpoint":         issuer.URL + "/token",
+		})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "code", r.FormValue("code"))
+		payload, _ := json.Marshal(issuer.claims)
+		idToken := "e30." + base64.RawURLEncoding.EncodeToString(payload) + ".c2ln"
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"access_token": "access",
+			"token_type":   "Bearer",
+			"id_token":     idToken,
+		})
+	})
+	issuer.Server = httptest.NewServer(mux)
+	t.Cleanup(issuer.Close)
+	return issuer
+}
+
+func TestSessionAuthOIDC(t *testing.T) {
+	issuer := newFakeIssuer(t)
+	fakeClock := clocktesting.NewFakeClock(time.Now())
+	auth, err := newSessionAuth(context.Background(), SessionAuthConfig{
+		OIDCIssuer:      issuer.URL,
+		OIDCClientID:    "cadvisor",
+		OIDCRedirectURL: "https://cadvisor.example.com/login/callback",
+		AllowedUsers:    []string{"alice@example.com"},
+		SessionTTL:      time.Hour,
+		ProtectAPI:      true,
+	}, fakeClock)
+	require.NoError(t, err)
+	mux := newTestMux(auth)
+
+	login := func() (string, []*http.Cookie) {
+		w := serve(mux, httptest.NewRequest(http.MethodGet, "/login?next=/docker/", nil))
+		require.Equal(t, http.StatusFound, w.Code)
+		location, err := url.Parse(w.Header().Get("Location"))
+		require.NoError(t, err)
+		assert.Equal(t, issuer.URL+"/authorize", location.Scheme+"://"+location.Host+location.Path)
+		assert.Equal(t, "cadvisor", location.Query().Get("client_id"))
+		issuer.claims["nonce"] = location.Query().Get("nonce")
+		return location.Query().Get("state"), w.Result().Cookies()
+	}
+	callback := func(state string, cookies []*http.Cookie) *httptest.ResponseRecorder {
+		return serve(mux, httptest.NewRequest(http.MethodGet, "/login/callback?code=code&state="+state, nil), cookies...)
+	}
+
+	issuer.claims = map[string]interface{}{
+		"iss":   issuer.URL,
+		"sub":   "1234",
+		"aud":   []string{"cadvisor"},
+		"exp":   fakeClock.Now().Add(time.Minute).Unix(),
+		"email": "alice@example.com",
+	}
+	state, cookies := login()
+	w := callback(state, cookies)
+	assert.Equal(t, http.StatusSeeOther, w.Code)
+	assert.Equal(t, "/docker/", w.Header().Get("Location"))
+	cookie := getCookie(w, sessionCookie)
+	require.NotNil(t, cookie)
+	w = serve(mux, httptest.NewRequest(http.MethodGet, "/api/v1.3/containers/", nil), cookie)
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	// The state must match the login.
+	_, cookies = login()
+	w = callback("other", cookies)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	// Only the allowed users can log in.
+	issuer.claims["email"] = "bob@example.com"
+	state, cookies = login()
+	w = callback(state, cookies)
+	assert.Equal(t, http.StatusForbidden, w.Code)
+	issuer.claims["email"] = "alice@example.com"
+
+	// The ID token must be for cAdvisor.
+	issuer.claims["aud"] = "other"
+	state, cookies = login()
+	w = callback(state, cookies)
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+	issuer.claims["aud"] = "cadvisor"
+
+	// The ID token must not be expired.
+	issuer.claims["exp"] = fakeClock.Now().Unix()
+	state, cookies = login()
+	w = callback(state, cookies)
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+}
+
+func TestSessionAuthLoginCookieIsNotASession(t *testing.T) {
+	issuer := newFakeIssuer(t)
+	auth, err := newSessionAuth(context.Background(), SessionAuthConfig{
+		OIDCIssuer:      issuer.URL,
+		OIDCClientID:    "cadvisor",
+		OIDCRedirectURL: "https://cadvisor.example.com/login/callback",
+		SessionTTL:      time.Hour,
+		ProtectAPI:      true,
+	}, clocktesting.NewFakeClock(time.Now()))
+	require.NoError(t, err)
+	mux := newTestMux(auth)
+
+	// Anyone gets a signed login cookie by starting a login.
+	w := serve(mux, httptest.NewRequest(http.MethodGet, "/login", nil))
+	require.Equal(t, http.StatusFound, w.Code)
+	login := getCookie(w, loginCookie)
+	require.NotNil(t, login)
+
+	w = serve(mux, httptest.NewRequest(http.MethodGet, "/api/v1.3/containers/", nil), &http.Cookie{Name: sessionCookie, Value: login.Value})
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+
+	// Neither is a session without a user.
+	empty := auth.encode(sessionCookie, session{Expires: time.Now().Add(time.Hour).Unix()})
+	w = serve(mux, httptest.NewRequest(http.MethodGet, "/api/v1.3/containers/", nil), &http.Cookie{Name: sessionCookie, Value: empty})
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+}
@@ -0,0 +1,321 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package logging writes the klog output of cAdvisor through log/slog, as
+// JSON or as logfmt text, and sets the verbosity of some packages apart from
+// the others, at startup and at runtime.
+package logging
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"maps"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-logr/logr"
+	"k8s.io/klog/v2"
+)
+
+// Formats of the logs.
+const (
+	// FormatKlog leaves the logs to klog, so that its flags such as
+	// -log_dir keep applying.
+	FormatKlog = "klog"
+	// FormatText writes the logs as logfmt lines.
+	FormatText = "text"
+	// FormatJSON writes the logs as JSON objects, one per line.
+	FormatJSON = "json"
+)
+
+// LevelFatal is the level of the records of klog.Fatal and klog.Exit.
+const LevelFatal = slog.LevelError + 4
+
+const cadvisorPackage = "github.com/google/cadvisor/"
+
+// Levels are the klog verbosities the logs are written at.
+type Levels struct {
+	// V is the verbosity of the packages not in Modules.
+	V int `json:"v"`
+	// Modules maps package paths to their verbosity, which also applies to
+	// the packages below them. The paths of cAdvisor's packages are relative
+	// to github.com/google/cadvisor, e.g. "manager" or "container/docker".
+	Modules map[string]int `json:"modules"`
+}
+
+// verbosity returns the verbosity of the package pkg, set by its longest
+// matching module.
+func (l *Levels) verbosity(pkg string) int {
+	rel := strings.TrimPrefix(pkg, cadvisorPackage)
+	v, matched := l.V, -1
+	for module, level := range l.Modules {
+		if len(module) > matched && (underPath(pkg, module) || underPath(rel, module)) {
+			v, matched = level, len(module)
+		}
+	}
+	return v
+}
+
+func (l *Levels) max() int {
+	v := l.V
+	for _, level := range l.Modules {
+		v = max(v, level)
+	}
+	return v
+}
+
+func underPath(pkg, module string) bool {
+	return pkg == module || strings.HasPrefix(pkg, module+"/")
+}
+
+// ParseModules parses comma-separated package=verbosity pairs, e.g.
+// "manager=4,container/docker=5".
+func ParseModules(value string) (map[string]int, error) {
+	modules := map[string]int{}
+	for _, pair := range strings.Split(value, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		module, level, ok := strings.Cut(pair, "=")
+		module = strings.Trim(strings.TrimSpace(module), "/")
+		if !ok || module == "" {
+			return nil, fmt.Errorf("invalid module level %q, expected package=verbosity", pair)
+		}
+		v, err := strconv.Atoi(strings.TrimSpace(level))
+		if err != nil || v < 0 {
+			return nil, fmt.Errorf("invalid verbosity %q of %q", level, module)
+		}
+		modules[module] = v
+	}
+	return modules, nil
+}
+
+// FormatModules is the inverse of ParseModules, sorted by package.
+func FormatModules(modules map[string]int) string {
+	pairs := make([]string, 0, len(modules))
+	for module, v := range modules {
+		pairs = append(pairs, fmt.Sprintf("%s=%d", module, v))
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ",")
+}
+
+// Logger holds the format and the levels of the logs.
+type Logger struct {
+	format  string
+	handler slog.Handler
+	levels  atomic.Pointer[Levels]
+	// Serializes SetLevels.
+	mu sync.Mutex
+}
+
+// Setup routes the klog output, and the output of the standard log package,
+// to w in format, at levels. With FormatKlog, w is unused and klog keeps
+// writing the logs as set by its flags, and levels cannot have Modules.
+func Setup(format string, w io.Writer, levels Levels) (*Logger, error) {
+	l := &Logger{format: format}
+	opts := &slog.HandlerOptions{
+		// klog filters the records by verbosity.
+		Level: slog.LevelInfo,
+		ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+			if len(groups) == 0 && a.Key == slog.LevelKey && a.Value.Any() == LevelFatal {
+				a.Value = slog.StringValue("FATAL")
+			}
+			return a
+		},
+	}
+	switch format {
+	case FormatKlog:
+	case FormatText:
+		l.handler = slog.NewTextHandler(w, opts)
+	case FormatJSON:
+		l.handler = slog.NewJSONHandler(w, opts)
+	default:
+		return nil, fmt.Errorf("unknown log format %q, expected %s, %s or %s", format, FormatKlog, FormatText, FormatJSON)
+	}
+	if err := l.SetLevels(levels); err != nil {
+		return nil, err
+	}
+	if l.handler != nil {
+		klog.SetLogger(logr.New(&sink{logger: l}))
+		slog.SetDefault(slog.New(l.handler))
+	}
+	return l, nil
+}
+
+// Format returns the format of the logs.
+func (l *Logger) Format() string {
+	return l.format
+}
+
+// Levels returns the current levels.
+func (l *Logger) Levels() Levels {
+	levels := *l.levels.Load()
+	levels.Modules = maps.Clone(levels.Modules)
+	return levels
+}
+
+// SetLevels changes the levels of the logs written from now on.
+func (l *Logger) SetLevels(levels Levels) error {
+	if levels.V < 0 {
+		return fmt.Errorf("invalid verbosity %d", levels.V)
+	}
+	for module, v := range levels.Modules {
+		if v < 0 {
+			return fmt.Errorf("invalid verbosity %d of %q", v, module)
+		}
+	}
+	if len(levels.Modules) > 0 && l.handler == nil {
+		return fmt.Errorf("the verbosity of packages requires the %s or %s log format, use -vmodule with %s", FormatText, FormatJSON, FormatKlog)
+	}
+	levels.Modules = maps.Clone(levels.Modules)
+	if levels.Modules == nil {
+		levels.Modules = map[string]int{}
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	// klog lets the records up to the highest verbosity through, the sink
+	// drops those above the verbosity of their package.
+	l.levels.Store(&levels)
+	return setKlogVerbosity(levels.max())
+}
+
+// setKlogVerbosity sets the -v flag of klog, which is global whichever
+// klog.Level is set.
+func setKlogVerbosity(v int) error {
+	var level klog.Level
+	return level.Set(strconv.Itoa(v))
+}
+
+// sink writes the records of klog to the handler of logger.
+type sink struct {
+	logger *Logger
+	name   string
+	values []any
+}
+
+var _ logr.LogSink = &sink{}
+
+func (s *sink) Init(logr.RuntimeInfo) {}
+
+func (s *sink) Enabled(level int) bool {
+	return level <= s.logger.levels.Load().max()
+}
+
+func (s *sink) Info(level int, msg string, keysAndValues ...any) {
+	s.log(level, nil, msg, keysAndValues)
+}
+
+func (s *sink) Error(err error, msg string, keysAndValues ...any) {
+	s.log(0, err, msg, keysAndValues)
+}
+
+func (s *sink) WithValues(keysAndValues ...any) logr.LogSink {
+	c := *s
+	c.values = append(append([]any{}, s.values...), keysAndValues...)
+	return &c
+}
+
+func (s *sink) WithName(name string) logr.LogSink {
+	c := *s
+	if c.name != "" {
+		name = c.name + "/" + name
+	}
+	c.name = name
+	return &c
+}
+
+func (s *sink) log(v int, err error, msg string, keysAndValues []any) {
+	caller, level := callerOf()
+	if err != nil && level < slog.LevelError {
+		level = slog.LevelError
+	}
+	if level == slog.LevelInfo && v > s.logger.levels.Load().verbosity(packageOf(caller.Function)) {
+		return
+	}
+
+	r := slog.NewRecord(time.Now(), level, msg, 0)
+	if caller.File != "" {
+		r.AddAttrs(slog.String(slog.SourceKey, fmt.Sprintf("%s:%d", shortPath(caller.File), caller.Line)))
+	}
+	if s.name != "" {
+		r.AddAttrs(slog.String("logger", s.name))
+	}
+	if v > 0 {
+		r.AddAttrs(slog.Int("v", v))
+	}
+	if err != nil {
+		r.AddAttrs(slog.Any("err", err))
+	}
+	r.Add(s.values...)
+	r.Add(keysAndValues...)
+	_ = s.logger.handler.Handle(context.Background(), r)
+}
+
+// callerOf returns the frame that called klog, and the level of the klog
+// function called, since klog hands warnings and fatal errors to the sink as
+// info and errors.
+func callerOf() (runtime.Frame, slog.Level) {
+	var pcs [32]uintptr
+	frames := runtime.CallersFrames(pcs[:runtime.Callers(3, pcs[:])])
+	level := slog.LevelInfo
+	for {
+		frame, more := frames.Next()
+		pkg := packageOf(frame.Function)
+		switch pkg {
+		case "k8s.io/klog/v2":
+			name := strings.TrimPrefix(frame.Function, pkg+".")
+			switch {
+			case strings.HasPrefix(name, "Fatal"), strings.HasPrefix(name, "Exit"):
+				level = LevelFatal
+			case strings.HasPrefix(name, "Error"):
+				level = max(level, slog.LevelError)
+			case strings.HasPrefix(name, "Warning"):
+				level = max(level, slog.LevelWarn)
+			}
+		case "github.com/go-logr/logr", "log", "github.com/google/cadvisor/cmd/internal/logging":
+		default:
+			return frame, level
+		}
+		if !more {
+			return frame, level
+		}
+	}
+}
+
+// packageOf returns the package of the function fn, as named by
+// runtime.Frame.
+func packageOf(fn string) string {
+	slash := strings.LastIndex(fn, "/")
+	if dot := strings.Index(fn[slash+1:], "."); dot >= 0 {
+		return fn[:slash+1+dot]
+	}
+	return fn
+}
+
+// shortPath returns the file with its directory, e.g. docker/handler.go,
+// since many packages have files of the same name.
+func shortPath(file string) string {
+	return filepath.Join(filepath.Base(filepath.Dir(file)), filepath.Base(file))
+}
@@ -0,0 +1,116 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logging_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"log"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/klog/v2"
+
+	"github.com/google/cadvisor/cmd/internal/logging"
+)
+
+func readRecords(t *testing.T, buf *bytes.Buffer) []map[string]any {
+	var records []map[string]any
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		if line == "" {
+			continue
+		}
+		var record map[string]any
+		require.NoError(t, json.Unmarshal([]byte(line), &record), line)
+		delete(record, "time")
+		if source, ok := record["source"]; ok {
+			assert.Regexp(t, `^logging/logging_test\.go:\d+$`, source)
+			delete(record, "source")
+		}
+		records = append(records, record)
+	}
+	buf.Reset()
+	return records
+}
+
+func TestJSON(t *testing.T) {
+	state := klog.CaptureState()
+	defer state.Restore()
+	defer slog.SetDefault(slog.Default())
+
+	var buf bytes.Buffer
+	logger, err := logging.Setup(logging.FormatJSON, &buf, logging.Levels{V: 1, Modules: map[string]int{"cmd/internal/logging_test": 3}})
+	require.NoError(t, err)
+
+	klog.Infof("started %d", 1)
+	klog.V(3).Info("deep")
+	klog.V(4).Info("deeper")
+	klog.Warningf("slow")
+	klog.ErrorS(errors.New("boom"), "failed", "container", "/docker/abc")
+	log.Print("from log")
+	records := readRecords(t, &buf)
+	require.Len(t, records, 5)
+	assert.Equal(t, map[string]any{"level": "INFO", "msg": "started 1"}, records[0])
+	assert.Equal(t, map[string]any{"level": "INFO", "msg": "deep", "v": float64(3)}, records[1])
+	assert.Equal(t, "WARN", records[2]["level"])
+	assert.Equal(t, map[string]any{"level": "ERROR", "msg": "failed", "err": "boom", "container": "/docker/abc"}, records[3])
+	assert.Equal(t, map[string]any{"level": "INFO", "msg": "from log"}, records[4])
+
+	// Lower the verbosity of the package at runtime.
+	require.NoError(t, logger.SetLevels(logging.Levels{V: 1}))
+	klog.V(1).Info("shallow")
+	klog.V(3).Info("deep")
+	records = readRecords(t, &buf)
+	require.Len(t, records, 1)
+	assert.Equal(t, "shallow", records[0]["msg"])
+	assert.Equal(t, logging.Levels{V: 1, Modules: map[string]int{}}, logger.Levels())
+}
+
+func TestText(t *testing.T) {
+	state := klog.CaptureState()
+	defer state.Restore()
+	defer slog.SetDefault(slog.Default())
+
+	var buf bytes.Buffer
+	_, err := logging.Setup(logging.FormatText, &buf, logging.Levels{})
+	require.NoError(t, err)
+	klog.Errorf("failed to read %q", "cpu.stat")
+	assert.Regexp(t, `^time=\S+ level=ERROR msg="failed to read \\"cpu.stat\\"" source=logging/logging_test.go:\d+\n$`, buf.String())
+}
+
+func TestSetupErrors(t *testing.T) {
+	_, err := logging.Setup("xml", nil, logging.Levels{})
+	assert.Error(t, err)
+	_, err = logging.Setup(logging.FormatKlog, nil, logging.Levels{Modules: map[string]int{"manager": 4}})
+	assert.Error(t, err)
+	_, err = logging.Setup(logging.FormatKlog, nil, logging.Levels{V: -1})
+	assert.Error(t, err)
+}
+
+func TestParseModules(t *testing.T) {
+	modules, err := logging.ParseModules("manager=4, container/docker/=5,,")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int{"manager": 4, "container/docker": 5}, modules)
+	assert.Equal(t, "container/docker=5,manager=4", logging.FormatModules(modules))
+
+	for _, value := range []string{"manager", "=4", "manager=x", "manager=-1"} {
+		_, err := logging.ParseModules(value)
+		assert.Error(t, err, value)
+	}
+}
@@ -0,0 +1,315 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package otlp
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// Exporter POSTs spans to an OTLP/HTTP receiver, encoded as JSON.
+type Exporter struct {
+	endpoint string
+	headers  map[string]string
+	client   *http.Client
+}
+
+var _ sdktrace.SpanExporter = &Exporter{}
+
+// NewExporter returns an Exporter POSTing the spans to endpoint, the URL of
+// the traces of the receiver, e.g. http://localhost:4318/v1/traces.
+func NewExporter(endpoint string, headers map[string]string, timeout time.Duration) *Exporter {
+	return &Exporter{
+		endpoint: endpoint,
+		headers:  headers,
+		client:   &http.Client{Timeout: timeout},
+	}
+}
+
+// ExportSpans POSTs spans in a single request.
+func (e *Exporter) ExportSpans(ctx context.Context, spans []sdktrace.ReadOnlySpan) error {
+	if len(spans) == 0 {
+		return nil
+	}
+	body, err := json.Marshal(newExportRequest(spans))
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, e.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for name, value := range e.headers {
+		req.Header.Set(name, value)
+	}
+	resp, err := e.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("%s responded with %s: %s", e.endpoint, resp.Status, bytes.TrimSpace(msg))
+	}
+	_, _ = io.Copy(io.Discard, resp.Body)
+	return nil
+}
+
+// Shutdown closes the idle connections to the receiver.
+func (e *Exporter) Shutdown(context.Context) error {
+	e.client.CloseIdleConnections()
+	return nil
+}
+
+// The types below are the JSON encoding of ExportTraceServiceRequest of
+// OTLP, see https://opentelemetry.io/docs/specs/otlp/#json-protobuf-encoding.
+
+type exportRequest struct {
+	ResourceSpans []*resourceSpans `json:"resourceSpans"`
+}
+
+type resourceSpans struct {
+	Resource   resourceJSON  `json:"resource"`
+	ScopeSpans []*scopeSpans `json:"scopeSpans"`
+	SchemaURL  string        `json:"schemaUrl,omitempty"`
+}
+
+type resourceJSON struct {
+	Attributes []keyValue `json:"attributes,omitempty"`
+}
+
+type scopeSpans struct {
+	Scope     scopeJSON  `json:"scope"`
+	Spans     []spanJSON `json:"spans"`
+	SchemaURL string     `json:"schemaUrl,omitempty"`
+}
+
+type scopeJSON struct {
+	Name    string `json:"name"`
+	Version string `json:"version,omitempty"`
+}
+
+type spanJSON struct {
+	TraceID                string      `json:"traceId"`
+	SpanID                 string      `json:"spanId"`
+	TraceState             string      `json:"traceState,omitempty"`
+	ParentSpanID           string      `json:"parentSpanId,omitempty"`
+	Name                   string      `json:"name"`
+	Kind                   int         `json:"kind"`
+	StartTimeUnixNano      string      `json:"startTimeUnixNano"`
+	EndTimeUnixNano        string      `json:"endTimeUnixNano"`
+	Attributes             []keyValue  `json:"attributes,omitempty"`
+	DroppedAttributesCount int         `json:"droppedAttributesCount,omitempty"`
+	Events                 []eventJSON `json:"events,omitempty"`
+	DroppedEventsCount     int         `json:"droppedEventsCount,omitempty"`
+	Links                  []linkJSON  `json:"links,omitempty"`
+	DroppedLinksCount      int         `json:"droppedLinksCount,omitempty"`
+	Status                 statusJSON  `json:"status"`
+}
+
+type eventJSON struct {
+	TimeUnixNano string     `json:"timeUnixNano"`
+	Name         string     `json:"name"`
+	Attributes   []keyValue `json:"attributes,omitempty"`
+}
+
+type linkJSON struct {
+	TraceID    string     `json:"traceId"`
+	SpanID     string     `json:"spanId"`
+	TraceState string     `json:"traceState,omitempty"`
+	Attributes []keyValue `json:"attributes,omitempty"`
+}
+
+type statusJSON struct {
+	Code    int    `json:"code,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+type keyValue struct {
+	Key   string   `json:"key"`
+	Value anyValue `json:"value"`
+}
+
+type anyValue struct {
+	StringValue *string     `json:"stringValue,omitempty"`
+	BoolValue   *bool       `json:"boolValue,omitempty"`
+	IntValue    *string     `json:"intValue,omitempty"`
+	DoubleValue *float64    `json:"doubleValue,omitempty"`
+	ArrayValue  *arrayValue `json:"arrayValue,omitempty"`
+}
+
+type arrayValue struct {
+	Values []anyValue `json:"values"`
+}
+
+// OTLP status codes, which are ordered differently from codes.Code.
+const (
+	statusOk    = 1
+	statusError = 2
+)
+
+// newExportRequest groups spans by resource and instrumentation scope.
+func newExportRequest(spans []sdktrace.ReadOnlySpan) *exportRequest {
+	request := &exportRequest{}
+	byResource := map[attribute.Distinct]*resourceSpans{}
+	type scopeKey struct {
+		resource attribute.Distinct
+		scope    instrumentation.Scope
+	}
+	byScope := map[scopeKey]*scopeSpans{}
+	for _, span := range spans {
+		res := span.Resource()
+		if res == nil {
+			res = resource.Empty()
+		}
+		rs, ok := byResource[res.Equivalent()]
+		if !ok {
+			rs = &resourceSpans{
+				Resource:  resourceJSON{Attributes: keyValues(res.Attributes())},
+				SchemaURL: res.SchemaURL(),
+			}
+			byResource[res.Equivalent()] = rs
+			request.ResourceSpans = append(request.ResourceSpans, rs)
+		}
+		scope := span.InstrumentationScope()
+		key := scopeKey{resource: res.Equivalent(), scope: instrumentation.Scope{Name: scope.Name, Version: scope.Version, SchemaURL: scope.SchemaURL}}
+		ss, ok := byScope[key]
+		if !ok {
+			ss = &scopeSpans{
+				Scope:     scopeJSON{Name: scope.Name, Version: scope.Version},
+				SchemaURL: scope.SchemaURL,
+			}
+			byScope[key] = ss
+			rs.ScopeSpans = append(rs.ScopeSpans, ss)
+		}
+		ss.Spans = append(ss.Spans, newSpanJSON(span))
+	}
+	return request
+}
+
+func newSpanJSON(span sdktrace.ReadOnlySpan) spanJSON {
+	sc := span.SpanContext()
+	s := spanJSON{
+		TraceID:                sc.TraceID().String(),
+		SpanID:                 sc.SpanID().String(),
+		TraceState:             sc.TraceState().String(),
+		Name:                   span.Name(),
+		Kind:                   int(span.SpanKind()),
+		StartTimeUnixNano:      unixNano(span.StartTime()),
+		EndTimeUnixNano:        unixNano(span.EndTime()),
+		Attributes:             keyValues(span.Attributes()),
+		DroppedAttributesCount: span.DroppedAttributes(),
+		DroppedEventsCount:     span.DroppedEvents(),
+		DroppedLinksCount:      span.DroppedLinks(),
+	}
+	if parent := span.Parent(); parent.IsValid() {
+		s.ParentSpanID = parent.SpanID().String()
+	}
+	for _, event := range span.Events() {
+		s.Events = append(s.Events, eventJSON{
+			TimeUnixNano: unixNano(event.Time),
+			Name:         event.Name,
+			Attributes:   keyValues(event.Attributes),
+		})
+	}
+	for _, link := range span.Links() {
+		s.Links = append(s.Links, linkJSON{
+			TraceID:    link.SpanContext.TraceID().String(),
+			SpanID:     link.SpanContext.SpanID().String(),
+			TraceState: link.SpanContext.TraceState().String(),
+			Attributes: keyValues(link.Attributes),
+		})
+	}
+	switch status := span.Status(); status.Code {
+	case codes.Ok:
+		s.Status.Code = statusOk
+	case codes.Error:
+		s.Status = statusJSON{Code: statusError, Message: status.Description}
+	}
+	return s
+}
+
+func unixNano(t time.Time) string {
+	return strconv.FormatInt(t.UnixNano(), 10)
+}
+
+func keyValues(attrs []attribute.KeyValue) []keyValue {
+	if len(attrs) == 0 {
+		return nil
+	}
+	kvs := make([]keyValue, 0, len(attrs))
+	for _, attr := range attrs {
+		kvs = append(kvs, keyValue{Key: string(attr.Key), Value: newAnyValue(attr.Value)})
+	}
+	return kvs
+}
+
+func newAnyValue(v attribute.Value) anyValue {
+	switch v.Type() {
+	case attribute.BOOL:
+		b := v.AsBool()
+		return anyValue{BoolValue: &b}
+	case attribute.INT64:
+		return intValue(v.AsInt64())
+	case attribute.FLOAT64:
+		f := v.AsFloat64()
+		return anyValue{DoubleValue: &f}
+	case attribute.BOOLSLICE:
+		values := []anyValue{}
+		for _, b := range v.AsBoolSlice() {
+			values = append(values, anyValue{BoolValue: &b})
+		}
+		return anyValue{ArrayValue: &arrayValue{Values: values}}
+	case attribute.INT64SLICE:
+		values := []anyValue{}
+		for _, i := range v.AsInt64Slice() {
+			values = append(values, intValue(i))
+		}
+		return anyValue{ArrayValue: &arrayValue{Values: values}}
+	case attribute.FLOAT64SLICE:
+		values := []anyValue{}
+		for _, f := range v.AsFloat64Slice() {
+			values = append(values, anyValue{DoubleValue: &f})
+		}
+		return anyValue{ArrayValue: &arrayValue{Values: values}}
+	case attribute.STRINGSLICE:
+		values := []anyValue{}
+		for _, s := range v.AsStringSlice() {
+			values = append(values, anyValue{StringValue: &s})
+		}
+		return anyValue{ArrayValue: &arrayValue{Values: values}}
+	default:
+		s := v.Emit()
+		return anyValue{StringValue: &s}
+	}
+}
+
+func intValue(i int64) anyValue {
+	s := strconv.FormatInt(i, 10)
+	return anyValue{IntValue: &s}
+}
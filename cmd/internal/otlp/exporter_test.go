@@ -0,0 +1,121 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package otlp
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestExportSpans(t *testing.T) {
+	var requests []map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		assert.Equal(t, "Bearer secret", r.Header.Get("Authorization"))
+		var request map[string]any
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+		requests = append(requests, request)
+	}))
+	defer server.Close()
+
+	exporter := NewExporter(server.URL, map[string]string{"Authorization": "Bearer secret"}, time.Second)
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithSyncer(exporter),
+		sdktrace.WithResource(resource.NewSchemaless(attribute.String("service.name", "cadvisor"))),
+	)
+	tracer := provider.Tracer("github.com/google/cadvisor/manager")
+
+	ctx, parent := tracer.Start(context.Background(), "Housekeeping")
+	_, child := tracer.Start(ctx, "GetStats")
+	child.SetAttributes(
+		attribute.String("cadvisor.container.name", "/docker/abc"),
+		attribute.Int64("count", 3),
+		attribute.Bool("root", false),
+		attribute.StringSlice("paths", []string{"/a", "/b"}),
+	)
+	child.SetStatus(codes.Error, "no such file")
+	child.End()
+	parent.End()
+	require.NoError(t, provider.Shutdown(context.Background()))
+
+	require.Len(t, requests, 2)
+	resourceSpans := requests[0]["resourceSpans"].([]any)[0].(map[string]any)
+	assert.Equal(t, []any{map[string]any{"key": "service.name", "value": map[string]any{"stringValue": "cadvisor"}}}, resourceSpans["resource"].(map[string]any)["attributes"])
+	scopeSpans := resourceSpans["scopeSpans"].([]any)[0].(map[string]any)
+	assert.Equal(t, map[string]any{"name": "github.com/google/cadvisor/manager"}, scopeSpans["scope"])
+
+	span := scopeSpans["spans"].([]any)[0].(map[string]any)
+	assert.Equal(t, "GetStats", span["name"])
+	assert.Equal(t, child.SpanContext().TraceID().String(), span["traceId"])
+	assert.Equal(t, child.SpanContext().SpanID().String(), span["spanId"])
+	assert.Equal(t, parent.SpanContext().SpanID().String(), span["parentSpanId"])
+	assert.Equal(t, float64(1), span["kind"])
+	assert.NotEmpty(t, span["startTimeUnixNano"])
+	assert.Equal(t, map[string]any{"code": float64(2), "message": "no such file"}, span["status"])
+	assert.Equal(t, []any{
+		map[string]any{"key": "cadvisor.container.name", "value": map[string]any{"stringValue": "/docker/abc"}},
+		map[string]any{"key": "count", "value": map[string]any{"intValue": "3"}},
+		map[string]any{"key": "root", "value": map[string]any{"boolValue": false}},
+		map[string]any{"key": "paths", "value": map[string]any{"arrayValue": map[string]any{"values": []any{
+			map[string]any{"stringValue": "/a"},
+			map[string]any{"stringValue": "/b"},
+		}}}},
+	}, span["attributes"])
+
+	span = requests[1]["resourceSpans"].([]any)[0].(map[string]any)["scopeSpans"].([]any)[0].(map[string]any)["spans"].([]any)[0].(map[string]any)
+	assert.Equal(t, "Housekeeping", span["name"])
+	assert.NotContains(t, span, "parentSpanId")
+	assert.Equal(t, map[string]any{}, span["status"])
+}
+
+func TestExportSpansError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "over quota", http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	_, span := provider.Tracer("test").Start(context.Background(), "Housekeeping")
+	span.End()
+
+	err := NewExporter(server.URL, nil, time.Second).ExportSpans(context.Background(), recorder.Ended())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "429 Too Many Requests: over quota")
+}
+
+func TestSetupInvalidConfig(t *testing.T) {
+	for _, config := range []Config{
+		{Endpoint: "localhost:4318", SampleRatio: 1},
+		{Endpoint: "grpc://localhost:4317", SampleRatio: 1},
+		{Endpoint: "http://localhost:4318/v1/traces", SampleRatio: 2},
+	} {
+		_, err := Setup(config)
+		assert.Error(t, err, "%+v", config)
+	}
+}
@@ -0,0 +1,100 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package otlp exports the OpenTelemetry traces of cAdvisor with OTLP over
+// HTTP.
+package otlp
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"os"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.40.0"
+	"k8s.io/klog/v2"
+
+	"github.com/google/cadvisor/version"
+)
+
+// Config configures the export of the traces.
+type Config struct {
+	// Endpoint is the URL the spans are POSTed to, e.g.
+	// http://localhost:4318/v1/traces.
+	Endpoint string
+	// Headers are set on every request, e.g. for authentication.
+	Headers map[string]string
+	// Timeout of each request.
+	Timeout time.Duration
+	// SampleRatio is the fraction of the traces started by cAdvisor that are
+	// exported. Requests carrying a W3C traceparent header are sampled as
+	// decided by the caller.
+	SampleRatio float64
+}
+
+// Setup sets the global tracer provider to one exporting the spans as set by
+// config. The returned function flushes the spans not exported yet and stops
+// the export.
+func Setup(config Config) (func(context.Context) error, error) {
+	endpoint, err := url.Parse(config.Endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("invalid OTLP endpoint %q: %v", config.Endpoint, err)
+	}
+	if endpoint.Scheme != "http" && endpoint.Scheme != "https" {
+		return nil, fmt.Errorf("invalid OTLP endpoint %q: the scheme must be http or https", config.Endpoint)
+	}
+	if config.SampleRatio < 0 || config.SampleRatio > 1 {
+		return nil, fmt.Errorf("invalid sample ratio %v: must be between 0 and 1", config.SampleRatio)
+	}
+	res, err := newResource()
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe the resource: %v", err)
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(NewExporter(config.Endpoint, config.Headers, config.Timeout)),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(config.SampleRatio))),
+	)
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	otel.SetErrorHandler(otel.ErrorHandlerFunc(func(err error) {
+		klog.Warningf("Failed to export traces: %v", err)
+	}))
+	return provider.Shutdown, nil
+}
+
+// newResource describes this cAdvisor. OTEL_SERVICE_NAME and
+// OTEL_RESOURCE_ATTRIBUTES override the attributes.
+func newResource() (*resource.Resource, error) {
+	attrs := []attribute.KeyValue{
+		semconv.ServiceName("cadvisor"),
+		semconv.ServiceVersion(version.Info["version"]),
+	}
+	if hostname, err := os.Hostname(); err == nil {
+		attrs = append(attrs, semconv.HostName(hostname))
+	}
+	return resource.New(context.Background(),
+		resource.WithSchemaURL(semconv.SchemaURL),
+		resource.WithAttributes(attrs...),
+		resource.WithTelemetrySDK(),
+		resource.WithFromEnv(),
+	)
+}
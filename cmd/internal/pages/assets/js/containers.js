@@ -36,7 +36,8 @@ function humanizeMetric(num) {
 
 // Draw a table.
 function drawTable(
-    seriesTitles, titleTypes, data, elementId, numPages, sortIndex) {
+    seriesTitles, titleTypes, data, elementId, numPages, sortIndex,
+    sortAscending) {
   var dataTable = new google.visualization.DataTable();
   for (var i = 0; i < seriesTitles.length; i++) {
     dataTable.addColumn(titleTypes[i], seriesTitles[i]);
@@ -58,7 +59,7 @@ function drawTable(
     pageSize: numPages,
     allowHtml: true,
     sortColumn: sortIndex,
-    sortAscending: false,
+    sortAscending: !!sortAscending,
     cssClassNames: cssClassNames
   };
   window.charts[elementId].draw(dataTable, opts);
@@ -105,7 +106,9 @@ function drawLineChart(seriesTitles, data, elementId, unit) {
   if (!(elementId in window.charts)) {
     window.charts[elementId] =
         new google.visualization.LineChart(document.getElementById(elementId));
+    addExportButtons(elementId);
   }
+  window.chartData[elementId] = {titles: seriesTitles, data: data};
 
   // TODO(vmarmol): Look into changing the view window to get a smoother
   // animation.
@@ -133,6 +136,76 @@ function drawLineChart(seriesTitles, data, elementId, unit) {
   window.charts[elementId].draw(dataTable, opts);
 }
 
+// Add the buttons exporting the samples of a chart.
+function addExportButtons(elementId) {
+  var buttons = $('<div>').addClass('chart-export');
+  ['CSV', 'JSON'].forEach(function(format) {
+    buttons.append($('<button>')
+                       .attr('type', 'button')
+                       .addClass('btn btn-default btn-xs')
+                       .text(format)
+                       .click(exportChart.bind(null, elementId, format)));
+  });
+  $('#' + elementId).before(buttons);
+}
+
+// Quote a CSV field if it needs to.
+function csvField(value) {
+  var field = String(value);
+  if (/[",\n]/.test(field)) {
+    return '"' + field.replace(/"/g, '""') + '"';
+  }
+  return field;
+}
+
+// Download the samples of a chart, as drawn last, in the specified format.
+function exportChart(elementId, format) {
+  var chart = window.chartData[elementId];
+  if (!chart) {
+    return;
+  }
+
+  var content, type;
+  if (format == 'CSV') {
+    var lines = [chart.titles.map(csvField).join(',')];
+    chart.data.forEach(function(row) {
+      lines.push(row.map(function(value, i) {
+                      if (value === null || value === undefined) {
+                        return '';
+                      }
+                      return i == 0 ? value.toISOString() : csvField(value);
+                    })
+                     .join(','));
+    });
+    content = lines.join('\n') + '\n';
+    type = 'text/csv';
+  } else {
+    var samples = chart.data.map(function(row) {
+      var sample = {};
+      row.forEach(function(value, i) {
+        if (value === null || value === undefined) {
+          return;
+        }
+        sample[chart.titles[i]] = i == 0 ? value.toISOString() : value;
+      });
+      return sample;
+    });
+    content = JSON.stringify(samples, null, 2);
+    type = 'application/json';
+  }
+
+  var name =
+      window.cadvisor.containerName.replace(/^\/+/, '').replace(/\//g, '_');
+  var link = document.createElement('a');
+  link.href = URL.createObjectURL(new Blob([content], {type: type}));
+  link.download =
+      (name || 'root') + '-' + elementId + '.' + format.toLowerCase();
+  document.body.appendChild(link);
+  link.click();
+  document.body.removeChild(link);
+  URL.revokeObjectURL(link.href);
+}
+
 // Gets the length of the interval in nanoseconds.
 function getInterval(current, previous) {
   var cur = new Date(current);
@@ -160,6 +233,12 @@ function hasResourceForAll(containerInfos, resource) {
   return true;
 }
 
+// Checks if the page has the specified element, the template leaves out the
+// charts of stats the container does not have.
+function hasElement(elementId) {
+  return document.getElementById(elementId) !== null;
+}
+
 // Draw a set of gauges. Data is comprised of an array of arrays with two
 // elements:
 // a string label and a numeric value for the gauge.
@@ -196,12 +275,15 @@ function getProcessInfo(rootDir, containerName, callback) {
       .fail(function(jqhxr, textStatus, error) { callback([]); });
 }
 
+// Number of samples of container history the graphs show.
+var numStats = 60;
+
 // Get the container stats for the specified container.
 function getStats(rootDir, containerName, callback) {
   // Request 60s of container history and no samples.
   var request = JSON.stringify({
     // Update main.statsRequestedByUI while updating "num_stats" here.
-    'num_stats': 60,
+    'num_stats': numStats,
     'num_samples': 0
   });
 
@@ -305,6 +387,58 @@ function drawCpuUsageBreakdown(elementId, machineInfo, containerInfo) {
   drawLineChart(titles, data, elementId, 'Cores');
 }
 
+// Draw the graph for the share of CFS periods in which the CPU was throttled.
+function drawCpuThrottling(elementId, machineInfo, containerInfo) {
+  if (containerInfo.spec.has_cpu && !hasResource(containerInfo, 'cpu')) {
+    return;
+  }
+
+  var titles = ['Time', 'Throttled'];
+  var data = [];
+  for (var i = 1; i < containerInfo.stats.length; i++) {
+    var cur = containerInfo.stats[i];
+    var prev = containerInfo.stats[i - 1];
+    var periods = cur.cpu.cfs.periods - prev.cpu.cfs.periods;
+
+    var elements = [];
+    elements.push(cur.timestamp);
+    if (periods > 0) {
+      elements.push(
+          (cur.cpu.cfs.throttled_periods - prev.cpu.cfs.throttled_periods) *
+          100 / periods);
+    } else {
+      elements.push(0);
+    }
+    data.push(elements);
+  }
+  drawLineChart(titles, data, elementId, 'Percent of periods');
+}
+
+// Draw the graph for the pressure stall information of the specified
+// resource.
+function drawPressure(elementId, containerInfo, resource) {
+  if (!hasResource(containerInfo, resource)) {
+    return;
+  }
+
+  var titles = ['Time', 'Some', 'Full'];
+  var data = [];
+  for (var i = 1; i < containerInfo.stats.length; i++) {
+    var cur = containerInfo.stats[i][resource].psi;
+    var prev = containerInfo.stats[i - 1][resource].psi;
+    var intervalNs =
+        getInterval(containerInfo.stats[i].timestamp,
+                    containerInfo.stats[i - 1].timestamp);
+
+    var elements = [];
+    elements.push(containerInfo.stats[i].timestamp);
+    elements.push((cur.some.total - prev.some.total) * 100 / intervalNs);
+    elements.push((cur.full.total - prev.full.total) * 100 / intervalNs);
+    data.push(elements);
+  }
+  drawLineChart(titles, data, elementId, 'Percent of time stalled');
+}
+
 // Return chart titles and data from an array of subcontainerInfos, using the
 // passed dataFn to return the individual data points.
 function getSubcontainerChartData(subcontainerInfos, dataFn) {
@@ -456,6 +590,186 @@ function drawMemoryUsage(elementId, machineInfo, containerInfo) {
   drawLineChart(titles, data, elementId, 'Megabytes');
 }
 
+// Draw the graph for what the memory is used for.
+function drawMemoryComposition(elementId, machineInfo, containerInfo) {
+  if (containerInfo.spec.has_memory && !hasResource(containerInfo, 'memory')) {
+    return;
+  }
+
+  var titles = ['Time', 'Anonymous', 'Page Cache', 'Kernel', 'Swap'];
+  var data = [];
+  for (var i = 0; i < containerInfo.stats.length; i++) {
+    var cur = containerInfo.stats[i];
+
+    var elements = [];
+    elements.push(cur.timestamp);
+    elements.push(cur.memory.rss / oneMegabyte);
+    elements.push(cur.memory.cache / oneMegabyte);
+    elements.push(cur.memory.kernel / oneMegabyte);
+    elements.push(cur.memory.swap / oneMegabyte);
+    data.push(elements);
+  }
+  drawLineChart(titles, data, elementId, 'Megabytes');
+}
+
+// Draw the graph for page faults.
+function drawMemoryPageFaults(elementId, machineInfo, containerInfo) {
+  if (containerInfo.spec.has_memory && !hasResource(containerInfo, 'memory')) {
+    return;
+  }
+
+  var titles = ['Time', 'Faults', 'Major Faults'];
+  var data = [];
+  for (var i = 1; i < containerInfo.stats.length; i++) {
+    var cur = containerInfo.stats[i].memory.container_data;
+    var prev = containerInfo.stats[i - 1].memory.container_data;
+    var intervalInSec =
+        getInterval(containerInfo.stats[i].timestamp,
+                    containerInfo.stats[i - 1].timestamp) /
+        1000000000;
+
+    var elements = [];
+    elements.push(containerInfo.stats[i].timestamp);
+    elements.push((cur.pgfault - prev.pgfault) / intervalInSec);
+    elements.push((cur.pgmajfault - prev.pgmajfault) / intervalInSec);
+    data.push(elements);
+  }
+  drawLineChart(titles, data, elementId, 'Faults per second');
+}
+
+// Get the name of an accelerator, or of its MIG instance.
+function getAcceleratorName(accelerator) {
+  var name = accelerator.make + ' ' + accelerator.model + ' ' + accelerator.id;
+  if (accelerator.gpu_instance) {
+    name += ' ' + accelerator.gpu_instance;
+    if (accelerator.gpu_instance_profile) {
+      name += ' (' + accelerator.gpu_instance_profile + ')';
+    }
+  }
+  return name;
+}
+
+// Draw the graph for a value of each accelerator of the latest stats.
+function drawAcceleratorValues(elementId, containerInfo, unit, valueFn) {
+  if (!hasResource(containerInfo, 'accelerators')) {
+    return;
+  }
+
+  var last = containerInfo.stats[containerInfo.stats.length - 1];
+  var names = (last.accelerators || []).map(getAcceleratorName);
+  var titles = ['Time'].concat(names);
+  var data = [];
+  for (var i = 0; i < containerInfo.stats.length; i++) {
+    var cur = containerInfo.stats[i];
+    var values = {};
+    (cur.accelerators || []).forEach(function(accelerator) {
+      values[getAcceleratorName(accelerator)] = valueFn(accelerator);
+    });
+
+    var elements = [cur.timestamp];
+    names.forEach(function(name) {
+      elements.push(name in values ? values[name] : null);
+    });
+    data.push(elements);
+  }
+  drawLineChart(titles, data, elementId, unit);
+}
+
+// Get the name of a perf event series, the uncore events being measured per
+// PMU and socket.
+function getPerfEventName(event) {
+  if (event.pmu !== undefined) {
+    return event.name + ' (' + event.pmu + ', socket ' + event.socket + ')';
+  }
+  return event.name;
+}
+
+// Sum the values of the perf events with the same name, measured on different
+// CPUs.
+function sumPerfEvents(events) {
+  var sums = {};
+  (events || []).forEach(function(event) {
+    var name = getPerfEventName(event);
+    sums[name] = (sums[name] || 0) + event.value;
+  });
+  return sums;
+}
+
+// Draw the graph for the rate of the perf events of the specified field of the
+// stats.
+function drawPerfEvents(elementId, containerInfo, field) {
+  var stats = containerInfo.stats;
+  if (stats.length < 2 || !stats[stats.length - 1][field]) {
+    return;
+  }
+
+  var names = Object.keys(sumPerfEvents(stats[stats.length - 1][field]));
+  names.sort();
+  var titles = ['Time'].concat(names);
+  var data = [];
+  for (var i = 1; i < stats.length; i++) {
+    var cur = sumPerfEvents(stats[i][field]);
+    var prev = sumPerfEvents(stats[i - 1][field]);
+    var intervalInSec =
+        getInterval(stats[i].timestamp, stats[i - 1].timestamp) / 1000000000;
+
+    var elements = [stats[i].timestamp];
+    names.forEach(function(name) {
+      if (name in cur && name in prev) {
+        elements.push((cur[name] - prev[name]) / intervalInSec);
+      } else {
+        elements.push(null);
+      }
+    });
+    data.push(elements);
+  }
+  drawLineChart(titles, data, elementId, 'Events per second');
+}
+
+// Draw the table of the perf event counters of the latest stats, with the
+// lowest scaling ratio of each event.
+function drawPerfEventTable(elementId, containerInfo) {
+  var stats = containerInfo.stats;
+  if (stats.length == 0) {
+    return;
+  }
+
+  var last = stats[stats.length - 1];
+  var rows = {};
+  [['Core', last.perf_stats], ['Uncore', last.perf_uncore_stats]].forEach(
+      function(kind) {
+        (kind[1] || []).forEach(function(event) {
+          var name = getPerfEventName(event);
+          var row = rows[name];
+          if (!row) {
+            row = rows[name] = {
+              kind: kind[0],
+              value: 0,
+              scalingRatio: event.scaling_ratio,
+              unreliable: false
+            };
+          }
+          row.value += event.value;
+          row.scalingRatio = Math.min(row.scalingRatio, event.scaling_ratio);
+          row.unreliable = row.unreliable || !!event.unreliable;
+        });
+      });
+
+  var titles = ['Event', 'Type', 'Count', 'Scaling Ratio', 'Unreliable'];
+  var titleTypes = ['string', 'string', 'number', 'number', 'string'];
+  var data = [];
+  for (var name in rows) {
+    var row = rows[name];
+    data.push([
+      {v: name, f: $('<div>').text(name).html()}, row.kind,
+      {v: row.value, f: row.value.toLocaleString()},
+      {v: row.scalingRatio, f: row.scalingRatio.toFixed(3)},
+      row.unreliable ? 'Yes' : 'No'
+    ]);
+  }
+  drawTable(titles, titleTypes, data, elementId, 25, 0, true);
+}
+
 // Get the index of the interface with the specified name.
 function getNetworkInterfaceIndex(interfaceName, interfaces) {
   for (var i = 0; i < interfaces.length; i++) {
@@ -731,14 +1045,21 @@ function drawCharts(machineInfo, containerInfo, subcontainers) {
     // steps.push(function() {
     // 	drawCpuLoad("cpu-load-chart", machineInfo, containerInfo);
     // });
-    steps.push(function() {
-      drawCpuPerCoreUsage(
-          'cpu-per-core-usage-chart', machineInfo, containerInfo);
-    });
+    if (hasElement('cpu-per-core-usage-chart')) {
+      steps.push(function() {
+        drawCpuPerCoreUsage(
+            'cpu-per-core-usage-chart', machineInfo, containerInfo);
+      });
+    }
     steps.push(function() {
       drawCpuUsageBreakdown(
           'cpu-usage-breakdown-chart', machineInfo, containerInfo);
     });
+    if (hasElement('cpu-throttling-chart')) {
+      steps.push(function() {
+        drawCpuThrottling('cpu-throttling-chart', machineInfo, containerInfo);
+      });
+    }
   }
 
   // Memory.
@@ -746,6 +1067,61 @@ function drawCharts(machineInfo, containerInfo, subcontainers) {
     steps.push(function() {
       drawMemoryUsage('memory-usage-chart', machineInfo, containerInfo);
     });
+    steps.push(function() {
+      drawMemoryComposition(
+          'memory-composition-chart', machineInfo, containerInfo);
+    });
+    steps.push(function() {
+      drawMemoryPageFaults(
+          'memory-page-faults-chart', machineInfo, containerInfo);
+    });
+  }
+
+  // Pressure.
+  if (hasElement('cpu-pressure-chart')) {
+    steps.push(function() {
+      drawPressure('cpu-pressure-chart', containerInfo, 'cpu');
+    });
+    steps.push(function() {
+      drawPressure('memory-pressure-chart', containerInfo, 'memory');
+    });
+    steps.push(function() {
+      drawPressure('io-pressure-chart', containerInfo, 'diskio');
+    });
+  }
+
+  // Accelerators.
+  if (hasElement('accelerator-duty-cycle-chart')) {
+    steps.push(function() {
+      drawAcceleratorValues(
+          'accelerator-duty-cycle-chart', containerInfo, 'Percent',
+          function(accelerator) { return accelerator.duty_cycle; });
+    });
+    steps.push(function() {
+      drawAcceleratorValues(
+          'accelerator-memory-chart', containerInfo, 'Megabytes',
+          function(accelerator) {
+            return accelerator.memory_used / oneMegabyte;
+          });
+    });
+  }
+
+  // Perf events.
+  if (hasElement('perf-events-table')) {
+    steps.push(function() {
+      drawPerfEventTable('perf-events-table', containerInfo);
+    });
+  }
+  if (hasElement('perf-core-events-chart')) {
+    steps.push(function() {
+      drawPerfEvents('perf-core-events-chart', containerInfo, 'perf_stats');
+    });
+  }
+  if (hasElement('perf-uncore-events-chart')) {
+    steps.push(function() {
+      drawPerfEvents(
+          'perf-uncore-events-chart', containerInfo, 'perf_uncore_stats');
+    });
   }
 
   // Network.
@@ -776,6 +1152,11 @@ function drawCharts(machineInfo, containerInfo, subcontainers) {
   }
 
   // Subcontainers.
+  if (hasElement('subcontainer-table')) {
+    steps.push(function() {
+      drawSubcontainerTable('subcontainer-table', containerInfo, subcontainers);
+    });
+  }
   var subcontainerInfos = filterSubcontainers(containerInfo, subcontainers);
   if (subcontainerInfos.length > 0) {
     if (hasResourceForAll(subcontainerInfos, 'cpu')) {
@@ -800,6 +1181,123 @@ function drawCharts(machineInfo, containerInfo, subcontainers) {
   stepExecute(steps);
 }
 
+// Checks if the container matches all the search terms, in its name, aliases,
+// image or labels.
+function matchesSearch(containerInfo, terms) {
+  var fields = [containerInfo.name];
+  if (containerInfo.aliases) {
+    fields = fields.concat(containerInfo.aliases);
+  }
+  if (containerInfo.spec.image) {
+    fields.push(containerInfo.spec.image);
+  }
+  for (var label in containerInfo.spec.labels) {
+    fields.push(label + '=' + containerInfo.spec.labels[label]);
+  }
+  var text = fields.join('\n').toLowerCase();
+  for (var i = 0; i < terms.length; i++) {
+    if (text.indexOf(terms[i]) === -1) {
+      return false;
+    }
+  }
+  return true;
+}
+
+// Draw the table of subcontainers. Without a search, the immediate
+// subcontainers are listed, a search matches all the containers below this
+// one.
+function drawSubcontainerTable(elementId, containerInfo, subcontainers) {
+  window.cadvisor.subcontainers = {
+    containerInfo: containerInfo,
+    subcontainers: subcontainers
+  };
+
+  var terms = $('#subcontainer-search').val().toLowerCase().split(/\s+/)
+      .filter(function(term) { return term.length > 0; });
+  var containerInfos = filterSubcontainers(containerInfo, subcontainers);
+  if (terms.length > 0) {
+    containerInfos = (subcontainers || []).filter(function(subcontainer) {
+      return subcontainer.name !== containerInfo.name &&
+          matchesSearch(subcontainer, terms);
+    });
+  }
+
+  var titles = ['Name', 'Image', 'Labels', 'CPU', 'Memory'];
+  var titleTypes = ['string', 'string', 'string', 'number', 'number'];
+  var data = [];
+  for (var i = 0; i < containerInfos.length; i++) {
+    var subcontainer = containerInfos[i];
+    var name = subcontainer.name;
+    if (subcontainer.aliases && subcontainer.aliases.length > 0) {
+      name = subcontainer.aliases[0];
+    }
+    var link = $('<a>')
+                   .attr('href',
+                         window.cadvisor.rootDir + 'containers' +
+                             subcontainer.name)
+                   .text(name);
+    var labels = [];
+    for (var label in subcontainer.spec.labels) {
+      labels.push(label + '=' + subcontainer.spec.labels[label]);
+    }
+    labels.sort();
+
+    var cpu = 0;
+    var memory = 0;
+    var stats = subcontainer.stats;
+    if (stats.length > 1 && stats[0].cpu) {
+      var cur = stats[stats.length - 1];
+      var prev = stats[stats.length - 2];
+      cpu = (cur.cpu.usage.total - prev.cpu.usage.total) /
+          getInterval(cur.timestamp, prev.timestamp);
+    }
+    if (stats.length > 0 && stats[0].memory) {
+      memory = stats[stats.length - 1].memory.usage;
+    }
+
+    var elements = [];
+    elements.push({v: name, f: $('<div>').append(link).html()});
+    var image = subcontainer.spec.image || '';
+    elements.push({v: image, f: $('<div>').text(image).html()});
+    elements.push({
+      v: labels.join(', '),
+      f: $('<div>').text(labels.join('\n')).html().replace(/\n/g, '<br>')
+    });
+    elements.push({v: cpu, f: cpu.toFixed(3)});
+    elements.push({v: memory, f: humanizeIEC(memory)});
+    data.push(elements);
+  }
+
+  // Keep the sorting the user chose across the refreshes.
+  if (!window.cadvisor.subcontainerSort) {
+    window.cadvisor.subcontainerSort = {column: 3, ascending: false};
+  }
+  var sort = window.cadvisor.subcontainerSort;
+  var created = !(elementId in window.charts);
+  drawTable(
+      titles, titleTypes, data, elementId, 25, sort.column, sort.ascending);
+  if (created) {
+    google.visualization.events.addListener(
+        window.charts[elementId], 'sort', function(event) {
+          window.cadvisor.subcontainerSort = {
+            column: event.column,
+            ascending: event.ascending
+          };
+        });
+  }
+  $('#subcontainer-list').hide();
+}
+
+// Redraw the table of subcontainers with the current search.
+function searchSubcontainers() {
+  if (!window.cadvisor || !window.cadvisor.subcontainers) {
+    return;
+  }
+  drawSubcontainerTable(
+      'subcontainer-table', window.cadvisor.subcontainers.containerInfo,
+      window.cadvisor.subcontainers.subcontainers);
+}
+
 // Return an slice of subcontainers sorted by CPU, with at most 'count' entries.
 function sliceByCpu(subcontainerInfos, count) {
   subcontainerInfos.sort(function(a, b) {
@@ -938,10 +1436,82 @@ function refreshStats() {
             startCustomMetrics('custom-metrics-chart', containerInfo);
           }
         }
+        window.cadvisor.containerInfo = containerInfo;
+        window.cadvisor.subcontainers = subcontainers;
         drawCharts(machineInfo, containerInfo, subcontainers);
       });
 }
 
+// Add a streamed sample to the stats of its container, and redraw the charts.
+function addStats(update) {
+  if (!window.cadvisor.containerInfo) {
+    return;
+  }
+  var containerInfos = [window.cadvisor.containerInfo].concat(
+      window.cadvisor.subcontainers || []);
+  containerInfos.forEach(function(containerInfo) {
+    if (containerInfo.name != update.name) {
+      return;
+    }
+    var stats = containerInfo.stats;
+    if (stats.length > 0 &&
+        new Date(update.stats.timestamp) <=
+            new Date(stats[stats.length - 1].timestamp)) {
+      return;
+    }
+    stats.push(update.stats);
+    if (stats.length > numStats) {
+      stats.splice(0, stats.length - numStats);
+    }
+  });
+  scheduleDrawCharts();
+}
+
+// Redraw the charts shortly, once for all the samples streamed meanwhile.
+function scheduleDrawCharts() {
+  if (window.cadvisor.drawPending) {
+    return;
+  }
+  window.cadvisor.drawPending = true;
+  setTimeout(function() {
+    window.cadvisor.drawPending = false;
+    drawCharts(
+        window.cadvisor.machineInfo, window.cadvisor.containerInfo,
+        window.cadvisor.subcontainers);
+  }, 100);
+}
+
+// Stream the new samples of the container and its subcontainers as
+// housekeeping collects them, falling back to polling the stats every second
+// if the stream is not available.
+function startStatsStream(rootDir, containerName) {
+  if (!window.EventSource) {
+    startPolling();
+    return;
+  }
+  var source = new EventSource(
+      rootDir + 'api/v1.3/stream' + containerName + '?subcontainers=true');
+  source.onmessage = function(event) { addStats(JSON.parse(event.data)); };
+  source.onerror = function() {
+    // The browser reconnects by itself, unless the stream was refused.
+    if (source.readyState == EventSource.CLOSED) {
+      startPolling();
+    }
+  };
+
+  // Refresh the whole stats once in a while to pick up new subcontainers.
+  setInterval(function() { refreshStats(); }, 60000);
+}
+
+// Get the stats every 1s.
+function startPolling() {
+  if (window.cadvisor.polling) {
+    return;
+  }
+  window.cadvisor.polling = true;
+  setInterval(function() { refreshStats(); }, 1000);
+}
+
 function addAllLabels(containerInfo, metricsInfo) {
   if (metricsInfo.length == 0) {
     return;
@@ -1083,6 +1653,7 @@ function startPage(containerName, hasCpu, hasMemory, rootDir, isRoot) {
   }
 
   window.charts = {};
+  window.chartData = {};
   window.cadvisor = {};
   window.cadvisor.firstRun = true;
   window.cadvisor.rootDir = rootDir;
@@ -1102,9 +1673,136 @@ function startPage(containerName, hasCpu, hasMemory, rootDir, isRoot) {
     });
   }, 60000);
 
-  // Get machine info, then get the stats every 1s.
+  // Get machine info and the stats, then stream the new samples.
   getMachineInfo(rootDir, function(machineInfo) {
     window.cadvisor.machineInfo = machineInfo;
-    setInterval(function() { refreshStats(); }, 1000);
+    refreshStats();
+    startStatsStream(rootDir, containerName);
   });
 }
+
+// The maximum number of events shown on the events page.
+var maxEvents = 1000;
+
+// Returns the types of events selected on the events page.
+function getSelectedEventTypes() {
+  return $('.events-type:checked').map(function() {
+    return $(this).val();
+  }).get();
+}
+
+// Draw the selected events on a timeline, one series per event type.
+function drawEventsTimeline(elementId, events, eventTypes) {
+  var dataTable = new google.visualization.DataTable();
+  dataTable.addColumn('datetime', 'Time');
+  var ticks = [];
+  for (var i = 0; i < eventTypes.length; i++) {
+    dataTable.addColumn('number', eventTypes[i]);
+    dataTable.addColumn({type: 'string', role: 'tooltip'});
+    ticks.push({v: i, f: eventTypes[i]});
+  }
+  for (var i = 0; i < events.length; i++) {
+    var index = eventTypes.indexOf(events[i].event_type);
+    var row = [new Date(events[i].timestamp)];
+    for (var j = 0; j < eventTypes.length; j++) {
+      if (j == index) {
+        row.push(j, events[i].event_type + ': ' + events[i].container_name);
+      } else {
+        row.push(null, null);
+      }
+    }
+    dataTable.addRow(row);
+  }
+
+  if (!(elementId in window.charts)) {
+    window.charts[elementId] = new google.visualization.ScatterChart(
+        document.getElementById(elementId));
+  }
+  var opts = {
+    height: Math.max(200, 40 * eventTypes.length),
+    legend: {position: 'none'},
+    pointSize: 6,
+    vAxis: {ticks: ticks, viewWindow: {min: -1, max: eventTypes.length}},
+    hAxis: {format: 'MMM d HH:mm:ss'}
+  };
+  window.charts[elementId].draw(dataTable, opts);
+}
+
+// Draw the selected events in a table, the most recent first.
+function drawEventsTable(elementId, events) {
+  var titles = ['Time', 'Type', 'Container', 'Count', 'Details'];
+  var titleTypes = ['datetime', 'string', 'string', 'number', 'string'];
+  var data = [];
+  for (var i = 0; i < events.length; i++) {
+    var details = '';
+    if (events[i].event_data && !$.isEmptyObject(events[i].event_data)) {
+      details = JSON.stringify(events[i].event_data);
+    }
+    data.push([
+      new Date(events[i].timestamp),
+      events[i].event_type,
+      {
+        v: events[i].container_name,
+        f: $('<div>').text(events[i].container_name).html()
+      },
+      events[i].count || 1,
+      {v: details, f: $('<div>').text(details).html()}
+    ]);
+  }
+  drawTable(titles, titleTypes, data, elementId, 25, 0, false);
+}
+
+// Draw the events of the last fetch matching the selected event types.
+function drawEvents() {
+  var eventTypes = getSelectedEventTypes();
+  var events = (window.cadvisor.events || []).filter(function(event) {
+    return eventTypes.indexOf(event.event_type) != -1;
+  });
+  drawEventsTimeline('events-timeline', events, eventTypes);
+  drawEventsTable('events-table', events);
+}
+
+// Fetch the events of the selected time range from the events API.
+function refreshEvents() {
+  var startTime =
+      new Date(new Date().getTime() - $('#events-range').val() * 1000);
+  var params = {
+    all_events: true,
+    subcontainers: $('#events-subcontainers').is(':checked'),
+    max_events: maxEvents,
+    start_time: startTime.toISOString()
+  };
+  $.getJSON(
+       window.cadvisor.rootDir + 'api/v1.3/events' +
+           window.cadvisor.containerName,
+       params)
+      .done(function(events) {
+        window.cadvisor.events = events || [];
+        drawEvents();
+      })
+      .fail(function(jqhxr, textStatus, error) {
+        $('#events-table').text('Failed to get the events: ' + error);
+      });
+}
+
+// Executed when the events page finishes loading.
+function startEventsPage(containerName, rootDir) {
+  window.charts = {};
+  window.cadvisor = {};
+  window.cadvisor.rootDir = rootDir;
+  window.cadvisor.containerName = containerName;
+
+  $('.events-type').change(drawEvents);
+  $('#events-subcontainers, #events-range').change(refreshEvents);
+  $('#events-container').change(function() {
+    var subtree = $(this).val();
+    if (subtree.charAt(0) != '/') {
+      subtree = '/' + subtree;
+    }
+    window.location = rootDir + 'events' + subtree;
+  });
+
+  // Refresh the events every 10s.
+  refreshEvents();
+  setInterval(refreshEvents, 10000);
+}
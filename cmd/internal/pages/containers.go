@@ -219,30 +219,64 @@ func serveContainersPage(m manager.Manager, w http.ResponseWriter, u *url.URL) {
 		})
 	}
 
-	data := &pageData{
-		DisplayName:            displayName,
+	data := newContainerPageData(cont, machineInfo, rootDir)
+	data.DisplayName = displayName
+	data.ParentContainers = parentContainers
+	data.Subcontainers = subcontainerLinks
+	data.IsRoot = cont.Name == "/"
+	data.SubcontainersAvailable = len(subcontainerLinks) > 0
+	err = pageTemplate.Execute(w, data)
+	if err != nil {
+		klog.Errorf("Failed to apply template: %s", err)
+	}
+
+	klog.V(5).Infof("Request took %s", time.Since(start))
+}
+
+// newContainerPageData returns the page data graphing the resources of the
+// container.
+func newContainerPageData(cont *info.ContainerInfo, machineInfo *info.MachineInfo, rootDir string) *pageData {
+	last := lastStats(cont.Stats)
+	return &pageData{
 		ContainerName:          escapeContainerName(cont.Name),
-		ParentContainers:       parentContainers,
-		Subcontainers:          subcontainerLinks,
 		Spec:                   cont.Spec,
 		Stats:                  cont.Stats,
 		MachineInfo:            machineInfo,
-		IsRoot:                 cont.Name == "/",
 		ResourcesAvailable:     cont.Spec.HasCpu || cont.Spec.HasMemory || cont.Spec.HasNetwork || cont.Spec.HasFilesystem,
 		CpuAvailable:           cont.Spec.HasCpu,
+		PerCpuAvailable:        len(last.Cpu.Usage.PerCpu) > 0,
+		ThrottlingAvailable:    cont.Spec.Cpu.Quota != 0,
 		MemoryAvailable:        cont.Spec.HasMemory,
+		PressureAvailable:      hasPressure(cont.Stats),
+		AcceleratorsAvailable:  len(last.Accelerators) > 0,
+		PerfCoreAvailable:      len(last.PerfStats) > 0,
+		PerfUncoreAvailable:    len(last.PerfUncoreStats) > 0,
 		NetworkAvailable:       cont.Spec.HasNetwork,
 		FsAvailable:            cont.Spec.HasFilesystem,
 		CustomMetricsAvailable: cont.Spec.HasCustomMetrics,
-		SubcontainersAvailable: len(subcontainerLinks) > 0,
 		Root:                   rootDir,
 	}
-	err = pageTemplate.Execute(w, data)
-	if err != nil {
-		klog.Errorf("Failed to apply template: %s", err)
+}
+
+// hasPressure returns whether the stats include pressure stall information,
+// which is only reported on cgroup v2 hosts with PSI enabled.
+func hasPressure(stats []*info.ContainerStats) bool {
+	for _, s := range stats {
+		for _, psi := range []info.PSIStats{s.Cpu.PSI, s.Memory.PSI, s.DiskIo.PSI} {
+			if psi.Some.Total != 0 || psi.Full.Total != 0 {
+				return true
+			}
+		}
 	}
+	return false
+}
 
-	klog.V(5).Infof("Request took %s", time.Since(start))
+// lastStats returns the latest stats, empty if there are none.
+func lastStats(stats []*info.ContainerStats) *info.ContainerStats {
+	if len(stats) == 0 {
+		return &info.ContainerStats{}
+	}
+	return stats[len(stats)-1]
 }
 
 // Build a relative path to the root of the container page.
@@ -0,0 +1,116 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pages
+
+import (
+	"fmt"
+	"html/template"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	info "github.com/google/cadvisor/info/v1"
+	"github.com/google/cadvisor/manager"
+
+	"k8s.io/klog/v2"
+)
+
+const EventsPage = "/events/"
+
+var eventsTemplate *template.Template
+
+// The event types which can be filtered on the events page.
+var eventTypes = []info.EventType{
+	info.EventContainerCreation,
+	info.EventContainerDeletion,
+	info.EventOom,
+	info.EventOomKill,
+	info.EventHealthStatus,
+	info.EventProcess,
+	info.EventCpuThrottling,
+	info.EventMemoryPressure,
+	info.EventInodeUsage,
+	info.EventAcceleratorXID,
+	info.EventLoadShedding,
+	info.EventMachineChanged,
+}
+
+type eventsPageData struct {
+	DisplayName      string
+	ContainerName    string
+	ParentContainers []link
+	Root             string
+	EventTypes       []info.EventType
+}
+
+func init() {
+	eventsHTMLTemplate, _ := Asset("cmd/internal/pages/assets/html/events.html")
+	eventsTemplate = template.New("eventsTemplate")
+	_, err := eventsTemplate.Parse(string(eventsHTMLTemplate))
+	if err != nil {
+		klog.Fatalf("Failed to parse template: %s", err)
+	}
+}
+
+func serveEventsPage(m manager.Manager, w http.ResponseWriter, u *url.URL) {
+	start := time.Now()
+
+	// The container subtree is the path after the handler.
+	containerName := u.Path[len(EventsPage)-1:]
+
+	// Only the reference of the container is needed, the events are fetched
+	// by the page from the events API.
+	cont, err := m.GetContainerInfo(containerName, &info.ContainerInfoRequest{NumStats: 1})
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed to get container %q with error: %v", containerName, err), http.StatusNotFound)
+		return
+	}
+
+	rootDir := getRootDir(containerName)
+
+	// Make a list of the parent containers and the links to their events.
+	pathParts := strings.Split(cont.Name, "/")
+	parentContainers := make([]link, 0, len(pathParts))
+	parentContainers = append(parentContainers, link{
+		Text: "root",
+		Link: path.Join(rootDir, EventsPage),
+	})
+	for i := 1; i < len(pathParts); i++ {
+		// Skip empty parts.
+		if pathParts[i] == "" {
+			continue
+		}
+		parentContainers = append(parentContainers, link{
+			Text: pathParts[i],
+			Link: path.Join(rootDir, EventsPage, path.Join(pathParts[1:i+1]...)),
+		})
+	}
+
+	data := &eventsPageData{
+		DisplayName:      getContainerDisplayName(cont.ContainerReference),
+		ContainerName:    escapeContainerName(cont.Name),
+		ParentContainers: parentContainers,
+		Root:             rootDir,
+		EventTypes:       eventTypes,
+	}
+	err = eventsTemplate.Execute(w, data)
+	if err != nil {
+		klog.Errorf("Failed to apply template: %s", err)
+	}
+
+	klog.V(5).Infof("Request took %s", time.Since(start))
+}
@@ -55,7 +55,13 @@ type pageData struct {
 	IsRoot                 bool
 	ResourcesAvailable     bool
 	CpuAvailable           bool
+	PerCpuAvailable        bool
+	ThrottlingAvailable    bool
 	MemoryAvailable        bool
+	PressureAvailable      bool
+	AcceleratorsAvailable  bool
+	PerfCoreAvailable      bool
+	PerfUncoreAvailable    bool
 	NetworkAvailable       bool
 	FsAvailable            bool
 	CustomMetricsAvailable bool
@@ -111,6 +117,30 @@ func podmanHandler(containerManager manager.Manager) auth.AuthenticatedHandlerFu
 	}
 }
 
+func podsHandlerNoAuth(containerManager manager.Manager) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		servePodsPage(containerManager, w, r.URL)
+	}
+}
+
+func podsHandler(containerManager manager.Manager) auth.AuthenticatedHandlerFunc {
+	return func(w http.ResponseWriter, r *auth.AuthenticatedRequest) {
+		servePodsPage(containerManager, w, r.URL)
+	}
+}
+
+func eventsHandlerNoAuth(containerManager manager.Manager) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		serveEventsPage(containerManager, w, r.URL)
+	}
+}
+
+func eventsHandler(containerManager manager.Manager) auth.AuthenticatedHandlerFunc {
+	return func(w http.ResponseWriter, r *auth.AuthenticatedRequest) {
+		serveEventsPage(containerManager, w, r.URL)
+	}
+}
+
 // Register http handlers
 func RegisterHandlersDigest(mux httpmux.Mux, containerManager manager.Manager, authenticator *auth.DigestAuth, urlBasePrefix string) error {
 	// Register the handler for the containers page.
@@ -118,10 +148,14 @@ func RegisterHandlersDigest(mux httpmux.Mux, containerManager manager.Manager, a
 		mux.HandleFunc(ContainersPage, authenticator.Wrap(containerHandler(containerManager)))
 		mux.HandleFunc(DockerPage, authenticator.Wrap(dockerHandler(containerManager)))
 		mux.HandleFunc(PodmanPage, authenticator.Wrap(podmanHandler(containerManager)))
+		mux.HandleFunc(PodsPage, authenticator.Wrap(podsHandler(containerManager)))
+		mux.HandleFunc(EventsPage, authenticator.Wrap(eventsHandler(containerManager)))
 	} else {
 		mux.HandleFunc(ContainersPage, containerHandlerNoAuth(containerManager))
 		mux.HandleFunc(DockerPage, dockerHandlerNoAuth(containerManager))
 		mux.HandleFunc(PodmanPage, podmanHandlerNoAuth(containerManager))
+		mux.HandleFunc(PodsPage, podsHandlerNoAuth(containerManager))
+		mux.HandleFunc(EventsPage, eventsHandlerNoAuth(containerManager))
 	}
 
 	if ContainersPage[len(ContainersPage)-1] == '/' {
@@ -132,6 +166,14 @@ func RegisterHandlersDigest(mux httpmux.Mux, containerManager manager.Manager, a
 		redirectHandler := http.RedirectHandler(urlBasePrefix+DockerPage, http.StatusMovedPermanently)
 		mux.Handle(DockerPage[0:len(DockerPage)-1], redirectHandler)
 	}
+	if PodsPage[len(PodsPage)-1] == '/' {
+		redirectHandler := http.RedirectHandler(urlBasePrefix+PodsPage, http.StatusMovedPermanently)
+		mux.Handle(PodsPage[0:len(PodsPage)-1], redirectHandler)
+	}
+	if EventsPage[len(EventsPage)-1] == '/' {
+		redirectHandler := http.RedirectHandler(urlBasePrefix+EventsPage, http.StatusMovedPermanently)
+		mux.Handle(EventsPage[0:len(EventsPage)-1], redirectHandler)
+	}
 	if PodmanPage[len(PodmanPage)-1] == '/' {
 		redirectHandler := http.RedirectHandler(urlBasePrefix+PodmanPage, http.StatusMovedPermanently)
 		mux.Handle(PodmanPage[0:len(PodmanPage)-1], redirectHandler)
@@ -146,10 +188,14 @@ func RegisterHandlersBasic(mux httpmux.Mux, containerManager manager.Manager, au
 		mux.HandleFunc(ContainersPage, authenticator.Wrap(containerHandler(containerManager)))
 		mux.HandleFunc(DockerPage, authenticator.Wrap(dockerHandler(containerManager)))
 		mux.HandleFunc(PodmanPage, authenticator.Wrap(podmanHandler(containerManager)))
+		mux.HandleFunc(PodsPage, authenticator.Wrap(podsHandler(containerManager)))
+		mux.HandleFunc(EventsPage, authenticator.Wrap(eventsHandler(containerManager)))
 	} else {
 		mux.HandleFunc(ContainersPage, containerHandlerNoAuth(containerManager))
 		mux.HandleFunc(DockerPage, dockerHandlerNoAuth(containerManager))
 		mux.HandleFunc(PodmanPage, podmanHandlerNoAuth(containerManager))
+		mux.HandleFunc(PodsPage, podsHandlerNoAuth(containerManager))
+		mux.HandleFunc(EventsPage, eventsHandlerNoAuth(containerManager))
 	}
 
 	if ContainersPage[len(ContainersPage)-1] == '/' {
@@ -160,6 +206,14 @@ func RegisterHandlersBasic(mux httpmux.Mux, containerManager manager.Manager, au
 		redirectHandler := http.RedirectHandler(urlBasePrefix+DockerPage, http.StatusMovedPermanently)
 		mux.Handle(DockerPage[0:len(DockerPage)-1], redirectHandler)
 	}
+	if PodsPage[len(PodsPage)-1] == '/' {
+		redirectHandler := http.RedirectHandler(urlBasePrefix+PodsPage, http.StatusMovedPermanently)
+		mux.Handle(PodsPage[0:len(PodsPage)-1], redirectHandler)
+	}
+	if EventsPage[len(EventsPage)-1] == '/' {
+		redirectHandler := http.RedirectHandler(urlBasePrefix+EventsPage, http.StatusMovedPermanently)
+		mux.Handle(EventsPage[0:len(EventsPage)-1], redirectHandler)
+	}
 
 	return nil
 }
@@ -0,0 +1,197 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pages
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	info "github.com/google/cadvisor/info/v1"
+	"github.com/google/cadvisor/manager"
+	"github.com/google/cadvisor/utils/kubelet"
+
+	"k8s.io/klog/v2"
+)
+
+const PodsPage = "/pods/"
+
+// A Kubernetes pod, grouping the containers below its cgroup.
+type pod struct {
+	cgroup     string
+	namespace  string
+	name       string
+	containers []*info.ContainerInfo
+}
+
+// getPodCgroup returns the cgroup of the pod the named container is in, or ""
+// if it is not in a pod.
+func getPodCgroup(containerName string) string {
+	podCgroup, _, _ := kubelet.PodCgroup(containerName)
+	return podCgroup
+}
+
+// getQOSClass returns the Kubernetes QoS class of the pod from its cgroup.
+func getQOSClass(podCgroup string) string {
+	switch {
+	case strings.Contains(podCgroup, "besteffort"):
+		return "besteffort"
+	case strings.Contains(podCgroup, "burstable"):
+		return "burstable"
+	default:
+		return "guaranteed"
+	}
+}
+
+// groupPods groups the containers in pods by their pod cgroup, sorted by
+// namespace and name. The pod names come from the labels of their containers.
+func groupPods(conts []*info.ContainerInfo) []*pod {
+	pods := map[string]*pod{}
+	for _, cont := range conts {
+		cgroup := getPodCgroup(cont.Name)
+		if cgroup == "" || cgroup == cont.Name {
+			continue
+		}
+		p, ok := pods[cgroup]
+		if !ok {
+			p = &pod{cgroup: cgroup}
+			pods[cgroup] = p
+		}
+		if name := cont.Spec.Labels[kubelet.PodNameLabel]; name != "" {
+			p.name = name
+			p.namespace = cont.Spec.Labels[kubelet.PodNamespaceLabel]
+		}
+		p.containers = append(p.containers, cont)
+	}
+
+	sorted := make([]*pod, 0, len(pods))
+	for _, p := range pods {
+		sort.Slice(p.containers, func(i, j int) bool {
+			return p.containers[i].Name < p.containers[j].Name
+		})
+		sorted = append(sorted, p)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		a, b := sorted[i], sorted[j]
+		if a.namespace != b.namespace {
+			return a.namespace < b.namespace
+		}
+		if a.name != b.name {
+			return a.name < b.name
+		}
+		return a.cgroup < b.cgroup
+	})
+	return sorted
+}
+
+// displayName returns the namespace and name of the pod, or its cgroup if no
+// container of the pod carries them.
+func (p *pod) displayName() string {
+	if p.name == "" {
+		return path.Base(p.cgroup)
+	}
+	return p.namespace + "/" + p.name
+}
+
+// getPodContainerDisplayName returns the name of the container within its
+// pod, or its display name if it has none.
+func getPodContainerDisplayName(cont *info.ContainerInfo) string {
+	if name := cont.Spec.Labels[kubelet.ContainerNameLabel]; name != "" {
+		return fmt.Sprintf("%s (%s)", name, cont.Name)
+	}
+	return getContainerDisplayName(cont.ContainerReference)
+}
+
+func servePodsPage(m manager.Manager, w http.ResponseWriter, u *url.URL) {
+	start := time.Now()
+
+	// The pod cgroup is the path after the handler.
+	podName := u.Path[len(PodsPage)-1:]
+	rootDir := getRootDir(podName)
+
+	conts, err := m.SubcontainersInfo(podName, &info.ContainerInfoRequest{NumStats: 0})
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed to get containers of %q with error: %v", podName, err), http.StatusNotFound)
+		return
+	}
+	pods := groupPods(conts)
+
+	podsText := "Kubernetes Pods"
+	parentContainers := []link{{
+		Text: podsText,
+		Link: path.Join(rootDir, PodsPage),
+	}}
+	var data *pageData
+	if podName == "/" {
+		subcontainers := make([]link, 0, len(pods))
+		for _, p := range pods {
+			subcontainers = append(subcontainers, link{
+				Text: fmt.Sprintf("%s (%s, %d containers)", p.displayName(), getQOSClass(p.cgroup), len(p.containers)),
+				Link: path.Join(rootDir, PodsPage, p.cgroup),
+			})
+		}
+		data = &pageData{
+			DisplayName:      podsText,
+			ParentContainers: parentContainers,
+			Subcontainers:    subcontainers,
+			Root:             rootDir,
+		}
+	} else {
+		if len(pods) != 1 || pods[0].cgroup != podName {
+			http.Error(w, fmt.Sprintf("%q is not the cgroup of a pod", podName), http.StatusNotFound)
+			return
+		}
+		p := pods[0]
+
+		// The pod cgroup holds the usage of all the containers of the pod.
+		cont, err := m.GetContainerInfo(podName, &info.ContainerInfoRequest{NumStats: 60})
+		if err != nil {
+			http.Error(w, fmt.Sprintf("failed to get pod %q with error: %v", podName, err), http.StatusNotFound)
+			return
+		}
+		machineInfo, err := m.GetMachineInfo()
+		if err != nil {
+			http.Error(w, fmt.Sprintf("failed to get machine info: %v", err), http.StatusInternalServerError)
+			return
+		}
+
+		subcontainers := make([]link, 0, len(p.containers))
+		for _, c := range p.containers {
+			subcontainers = append(subcontainers, link{
+				Text: getPodContainerDisplayName(c),
+				Link: path.Join(rootDir, ContainersPage, c.Name),
+			})
+		}
+		data = newContainerPageData(cont, machineInfo, rootDir)
+		data.DisplayName = fmt.Sprintf("Pod %s (%s)", p.displayName(), getQOSClass(p.cgroup))
+		data.ParentContainers = append(parentContainers, link{
+			Text: p.displayName(),
+			Link: path.Join(rootDir, PodsPage, p.cgroup),
+		})
+		data.Subcontainers = subcontainers
+		data.SubcontainersAvailable = len(subcontainers) > 0
+	}
+
+	err = pageTemplate.Execute(w, data)
+	if err != nil {
+		klog.Errorf("Failed to apply template: %s", err)
+	}
+
+	klog.V(5).Infof("Request took %s", time.Since(start))
+}
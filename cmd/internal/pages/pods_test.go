@@ -0,0 +1,66 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pages
+
+import (
+	"testing"
+
+	info "github.com/google/cadvisor/info/v1"
+	"github.com/google/cadvisor/utils/kubelet"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetPodCgroup(t *testing.T) {
+	for name, want := range map[string]string{
+		"/kubepods/burstable/pod0e5d1a5b-6f3c-4c1e-9a36-2b2b3d5d8a01/abcdef":                                                                   "/kubepods/burstable/pod0e5d1a5b-6f3c-4c1e-9a36-2b2b3d5d8a01",
+		"/kubepods/pod0e5d1a5b-6f3c-4c1e-9a36-2b2b3d5d8a01":                                                                                    "/kubepods/pod0e5d1a5b-6f3c-4c1e-9a36-2b2b3d5d8a01",
+		"/kubepods.slice/kubepods-besteffort.slice/kubepods-besteffort-pod0e5d1a5b_6f3c_4c1e_9a36_2b2b3d5d8a01.slice/cri-containerd-abc.scope": "/kubepods.slice/kubepods-besteffort.slice/kubepods-besteffort-pod0e5d1a5b_6f3c_4c1e_9a36_2b2b3d5d8a01.slice",
+		"/kubepods/burstable": "",
+		"/docker/abcdef":      "",
+	} {
+		assert.Equal(t, want, getPodCgroup(name), name)
+	}
+}
+
+func TestGroupPods(t *testing.T) {
+	const (
+		web = "/kubepods/burstable/pod0e5d1a5b-6f3c-4c1e-9a36-2b2b3d5d8a01"
+		db  = "/kubepods/pod11111111-2222-3333-4444-555555555555"
+	)
+	container := func(name string, labels map[string]string) *info.ContainerInfo {
+		return &info.ContainerInfo{
+			ContainerReference: info.ContainerReference{Name: name},
+			Spec:               info.ContainerSpec{Labels: labels},
+		}
+	}
+	pods := groupPods([]*info.ContainerInfo{
+		container("/", nil),
+		container(web, nil),
+		container(web+"/b", map[string]string{kubelet.PodNameLabel: "web", kubelet.PodNamespaceLabel: "prod", kubelet.ContainerNameLabel: "nginx"}),
+		container(web+"/a", nil),
+		container(db+"/c", map[string]string{kubelet.PodNameLabel: "db", kubelet.PodNamespaceLabel: "prod"}),
+		container("/docker/d", nil),
+	})
+
+	assert.Len(t, pods, 2)
+	assert.Equal(t, "prod/db", pods[0].displayName())
+	assert.Equal(t, "guaranteed", getQOSClass(pods[0].cgroup))
+	assert.Equal(t, "prod/web", pods[1].displayName())
+	assert.Equal(t, "burstable", getQOSClass(pods[1].cgroup))
+	assert.Equal(t, web, pods[1].cgroup)
+	assert.Equal(t, web+"/a", pods[1].containers[0].Name)
+	assert.Equal(t, "nginx ("+web+"/b)", getPodContainerDisplayName(pods[1].containers[1]))
+}
@@ -0,0 +1,39 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build windows || freebsd
+
+package pages
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"runtime"
+
+	"github.com/google/cadvisor/manager"
+)
+
+const (
+	DockerPage = "/docker/"
+	PodmanPage = "/podman/"
+)
+
+func serveDockerPage(m manager.Manager, w http.ResponseWriter, u *url.URL) {
+	http.Error(w, fmt.Sprintf("Docker containers are not supported on %s", runtime.GOOS), http.StatusNotFound)
+}
+
+func servePodmanPage(m manager.Manager, w http.ResponseWriter, u *url.URL) {
+	http.Error(w, fmt.Sprintf("Podman containers are not supported on %s", runtime.GOOS), http.StatusNotFound)
+}
@@ -16,13 +16,13 @@
 // Code generated by go-bindata. DO NOT EDIT.
 // sources:
 // cmd/internal/pages/assets/js/bootstrap-4.0.0-beta.2.min.js (50.564kB)
-// cmd/internal/pages/assets/js/containers.js (34.605kB)
+// cmd/internal/pages/assets/js/containers.js (57.432kB)
 // cmd/internal/pages/assets/js/jquery-3.5.1.min.js (89.475kB)
 // cmd/internal/pages/assets/js/loader.js (65.121kB)
 // cmd/internal/pages/assets/js/popper.min.js (19.188kB)
 // cmd/internal/pages/assets/styles/bootstrap-4.0.0-beta.2.min.css (127.343kB)
 // cmd/internal/pages/assets/styles/bootstrap-theme-3.1.1.min.css (13.186kB)
-// cmd/internal/pages/assets/styles/containers.css (132.925kB)
+// cmd/internal/pages/assets/styles/containers.css (133.164kB)
 
 package static
 
@@ -110,7 +110,7 @@ func cmdInternalPagesAssetsJsBootstrap400Beta2MinJs() (*asset, error) {
 	return a, nil
 }
 
-var _cmdInternalPagesAssetsJsContainersJs = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x7d\x69\x73\x1b\xb9\x11\xe8\xe7\xa7\x5f\xd1\xeb\x6c\x76\xc8\x88\x1c\x52\xde\xe3\xd5\x52\xa6\xab\x64\xd9\xde\x28\xf1\x55\x92\x9c\x54\x4a\x56\xa9\xa0\x19\x90\x84\x3d\x1c\x4c\x00\x8c\x28\xae\x57\xff\xfd\x15\xae\x01\x30\x07\x49\x69\xbd\x9b\xe4\x25\xfe\x60\x91\x33\x8d\x46\xa3\xd1\xe8\x0b\x0d\x70\x34\x82\x63\x5a\xac\x19\x99\x2f\x04\x3c\x1e\x1f\x7c\x07\x3f\x51\x3a\xcf\x30\x9c\xe4\x49\x0c\x47\x59\x06\xa7\xf2\x15\x87\x53\xcc\x31\xbb\xc1\x69\xbc\x37\x1a\xed\x8d\x46\xf0\x8a\x24\x38\xe7\x38\x85\x32\x4f\x31\x03\xb1\xc0\x70\x54\xa0\x64\x81\xed\x9b\x01\xfc\x0d\x33\x4e\x68\x0e\x8f\xe3\x31\xf4\x24\xc0\x23\xf3\xea\x51\xff\x50\xa2\x58\xd3\x12\x96\x68\x0d\x39\x15\x50\x72\x0c\x62\x41\x38\xcc\x48\x86\x01\xdf\x26\xb8\x10\x40\x72\x48\xe8\xb2\xc8\x08\xca\x13\x0c\x2b\x22\x16\xaa\x1f\x83\x45\x52\x02\xff\x30\x38\xe8\xb5\x40\x24\x07\x04\x09\x2d\xd6\x40\x67\x3e\x20\x20\x61\x88\x96\xff\x16\x42\x14\x93\xd1\x68\xb5\x5a\xc5\x48\x11\x1c\x53\x36\x1f\x65\x1a\x94\x8f\x5e\x9d\x1c\xbf\x78\x73\xf6\x62\xf8\x38\x1e\x9b\x46\xef\xf3\x0c\x73\x0e\x0c\xff\xb3\x24\x0c\xa7\x70\xbd\x06\x54\x14\x19\x49\xd0\x75\x86\x21\x43\x2b\xa0\x0c\xd0\x9c\x61\x9c\x82\xa0\x92\xe8\x15\x23\x82\xe4\xf3\x01\x70\x3a\x13\x2b\xc4\xb0\x44\x93\x12\x2e\x18\xb9\x2e\x45\xc0\x33\x4b\x22\xe1\x01\x00\xcd\x01\xe5\xf0\xe8\xe8\x0c\x4e\xce\x1e\xc1\xb3\xa3\xb3\x93\xb3\x81\x44\xf2\xf7\x93\xf3\x3f\xbf\x7d\x7f\x0e\x7f\x3f\x3a\x3d\x3d\x7a\x73\x7e\xf2\xe2\x0c\xde\x9e\xc2\xf1\xdb\x37\xcf\x4f\xce\x4f\xde\xbe\x39\x83\xb7\x2f\xe1\xe8\xcd\x3f\xe0\xaf\x27\x6f\x9e\x0f\x00\x13\xb1\xc0\x0c\xf0\x6d\xc1\xe4\x08\x28\x03\x22\xb9\xa9\x27\x11\xce\x30\x0e\x48\x98\x51\x4d\x12\x2f\x70\x42\x66\x24\x81\x0c\xe5\xf3\x12\xcd\x31\xcc\xe9\x0d\x66\x39\xc9\xe7\x50\x60\xb6\x24\x5c\xce\x2a\x07\x94\xa7\x12\x4d\x46\x96\x44\x20\xa1\x1e\x35\xc6\x15\xef\xed\xcd\x95\x3c\xc5\xc9\x02\x31\xc1\xe3\x8c\xa2\xb4\x17\x25\x25\x63\x38\x17\xd1\x00\x3e\x17\x28\xf9\x84\xe6\x98\x4f\xe0\x22\x4a\x28\xc3\x0a\x2e\x1a\x40\x34\x47\xe5\x1c\xcb\x0f\x29\x9e\xa1\x32\x53\xcf\x66\x94\x2d\x91\xfa\x54\x12\xf9\xbf\x90\x53\x10\x5d\xde\xf5\x0f\xf7\xf6\x66\x65\x9e\x48\x2a\x60\x51\x2e\x51\x4e\x7e\xc6\xbd\xbc\x5c\x0e\x80\x93\x9f\xf1\x00\xca\x9c\x08\xde\x87\xcf\x7b\x00\x37\x88\xa9\xaf\x87\x7b\xa0\x86\xdc\x93\x5f\x60\xaa\x41\xe2\x82\x16\xbd\xfe\xa1\xf9\x92\xe1\x7c\x2e\x16\xf0\xcd\x37\x90\x97\x4b\x78\x3a\x55\xc8\xf4\xcb\xb0\x81\xc6\x0c\x0a\x6c\x64\xc0\xf6\x00\xee\xf6\x00\x18\x16\x25\xcb\xe1\x42\x11\x23\x9b\x5c\x1e\xee\xdd\xed\x49\xc6\xbd\xa4\x59\x46\x57\x92\xab\x92\x61\x27\x2f\x8e\x21\x47\x4b\xf9\x35\xa1\xf9\x0d\xce\xe5\x58\x9a\x83\x3a\x79\x71\x2c\xc7\xe5\x86\xc2\xb0\xa4\x25\x1c\xf3\xc1\xf8\xf1\x77\x03\xb8\x88\xce\xc9\x33\xc9\xa5\x9f\xf4\x9f\xd7\xfa\xcf\x5f\xf5\x9f\x67\xd1\x65\xff\xd0\xd1\xc7\xb0\xb8\x18\x5f\xc6\x82\xbe\x24\xb7\x38\xed\x3d\xee\xc3\x3e\x44\x10\xc1\xbe\x7a\x73\xa0\x88\x6e\xd0\xfc\x1a\x0b\x46\x92\x16\xb2\x9b\x74\x6b\xd0\x5d\x48\x1f\x8f\x15\xe9\x9a\x72\x4d\xb8\xa6\x5b\x93\xbd\x16\x98\xdf\x9f\x74\x49\xfb\x73\x86\x56\x80\x40\xc9\x4c\xec\x28\x4c\x19\x5a\x9d\xcb\x67\x3d\x35\x85\x1c\x33\x82\xf9\x39\x11\x19\xe6\x03\x10\xf2\xef\xf9\xba\x90\x9f\x53\x24\xd0\x00\x70\x86\x97\x38\x17\x27\xe9\x40\xce\xf6\x3b\x29\xba\x72\x9d\x33\x71\x92\xa7\xf8\xd6\x0d\x4e\x42\x2b\xb4\x30\x85\x1c\xaf\xc0\x2c\x83\x1b\xc2\x4b\x94\x91\x9f\xd5\x82\x89\x9f\x5b\xa0\x5e\xbf\x12\x47\xd9\x98\xc0\x14\xc6\x87\x40\xe0\x49\x40\x8f\x11\xc8\x43\x20\xfb\xfb\x56\xe4\xaa\x7e\x62\x94\xa6\xc7\x34\x2b\x97\x79\xcf\x51\x7d\x41\x2e\x07\x01\x8a\x0b\xa2\x79\x27\x45\x33\x68\x7a\x4a\x57\xbc\x27\x9f\xa8\xd7\x64\x06\xbd\xaf\x7a\xd5\x58\x95\x52\x23\x79\x4a\x57\x66\x1d\x57\x12\x1f\x3c\xbd\xa8\x1a\x5c\xc2\x54\xbd\x56\x8b\xa2\x6b\xf4\x7a\xe4\x29\x4d\x4a\xd9\x28\x9e\x63\xf1\x42\xb7\x7f\xb6\x3e\x49\x5d\xe7\x7d\x43\xb0\x61\x6c\xc2\xf9\x71\x86\x38\x7f\x83\x96\x98\xc3\xd4\xd0\x11\x2d\x30\x4a\x31\x3b\xa5\xab\x68\x02\x51\x34\xd0\x0f\xd5\x5c\x9b\x67\xea\xf3\x90\xd1\x95\x7d\x49\xd3\xf4\xbc\xf5\xbd\xec\xed\xd0\xf4\x46\x0b\xe1\x3a\x41\x99\xc0\x2c\x47\x52\xb7\x9f\xd2\xd5\x99\x58\x67\x78\x02\x82\x95\x58\x63\x2c\xd0\x1c\x4f\x20\xc2\xb9\xd2\x4a\xee\xd9\x19\xf9\x19\x4f\x9c\xb4\x18\x54\x19\x5d\xfd\x59\x2c\x33\x1f\x81\x14\x23\x3d\x85\x13\x27\x52\xee\xd5\x11\x4f\x70\x9e\x92\x7c\x3e\x81\x19\xca\xb8\x69\x14\xf0\x63\x12\x7e\xb5\x23\xe9\x9a\xa5\x58\x0a\x7f\xaf\x92\x83\x81\x1a\x6e\xbf\xb6\x60\x32\x92\x63\x50\x4d\x6b\xab\xe6\x15\xc9\xf1\xb1\x7c\xde\x0b\x17\x4d\x63\xa1\x48\xb5\xe7\x56\xc6\x92\xe4\x30\x85\x93\x7c\x46\x72\x22\xd6\x96\xd1\x4b\x74\x0b\x53\x18\xfa\x8f\xdb\x96\x83\xc4\xdd\xb6\x0c\x94\x1f\x93\xdf\x60\x26\x94\x66\x9a\x11\xc6\x05\x24\x8a\x97\xd2\x28\x23\x78\x8e\x04\x8e\x15\xa8\x94\x6d\x89\xe6\x82\x5c\xc2\x57\x53\xc8\xcb\x2c\xb3\x58\xf4\x9a\xb8\x20\x97\x17\xe3\x4b\xb3\x6e\x65\xbb\x9e\x7b\xaa\x64\xd1\x48\xa3\xea\xf5\x25\xc9\x53\x39\xa4\x81\x1c\x81\xee\xa0\xa2\xfb\x23\x4c\xe1\xe0\x10\x3e\x1a\xba\x2f\xc8\x65\x45\xfa\x47\x47\xba\x1e\xff\x0d\xca\x60\x5a\x75\xff\xf1\xf2\xd0\xbc\x93\xd4\xca\x77\x4f\x64\x27\xae\x09\x18\x36\xde\xa0\xcc\x42\xde\xd5\x5a\x3c\x95\x14\x05\x2d\x14\x8b\x1b\x2d\xee\xec\xea\x92\xfe\x05\x86\x94\xe6\x91\x80\x15\xca\x85\x64\x1c\x5f\xd0\x15\xa0\x7c\x2d\x9b\x95\x98\x83\x72\x85\xc4\x02\xe5\x30\x06\x4e\x21\x41\x85\xe2\xb7\x24\x46\x41\x00\x92\x13\x80\x44\xac\xf1\x1d\xe9\xe9\xe0\x68\x89\x41\x90\x25\x1e\x68\x84\x07\xe3\x3f\x5a\x1f\x6d\xce\x50\xb1\x80\x6b\x9c\xd1\x55\x0d\x13\x99\xc1\x0a\x43\x82\xf2\xd8\x09\xce\xdf\x95\x20\xc3\x54\x81\x0d\xa1\x27\x87\x34\xd4\x9c\x19\xc1\xc1\xd8\xaa\x2e\x07\xf9\x04\xc6\x96\x05\x7e\xf3\xf1\xa1\x37\xe8\xa3\x34\x55\x5d\xa7\x58\xc9\x9e\x14\x6f\x3a\x03\x8c\x92\x85\x95\x20\x94\x6b\x88\x1c\x27\x98\x73\xc4\xd6\x5a\x0e\x7f\x85\xaa\x6f\x53\xdb\x51\x8a\x04\x96\x5c\x8a\x6a\x3a\xdb\x88\x5d\xb0\x1e\x0e\x1e\x6e\x1e\xa2\xbc\x5c\x5e\x63\x56\xef\x65\x17\xcb\xa0\x19\x76\xcc\x30\x12\x58\x71\x45\xea\x01\xc5\x9a\x70\xb4\xbf\x97\x09\x71\x2a\xe8\x3e\x66\x64\x34\x82\xf3\xb7\xcf\xdf\xf6\x6e\x96\x88\x2d\x69\xd6\x9f\xc0\x2b\x4a\x3f\x01\xc9\x05\x95\x8a\x2e\x9f\x5b\x07\xe7\x86\xe0\x95\xa1\x4f\x2e\x86\x39\x16\x80\x80\x2f\x29\x95\x7e\xb5\x46\x84\x72\xb2\xac\xc6\xdc\xb0\x18\x49\xc9\x6e\x94\x25\x9e\x40\x64\x75\xa7\xb1\x0c\x0b\x2c\x03\xab\x09\x7c\x3b\x1e\xeb\x07\x19\x9e\xe3\x3c\x9d\xc0\xe7\x82\x72\x25\x85\x13\x88\x72\x9a\xe3\xe8\x6e\x60\xd4\x4a\x52\xf2\x73\xc4\xe6\x58\x4c\x20\x4a\x90\xc0\x73\xca\xd6\x06\xdb\xcd\xd1\x2d\xe1\x93\x6a\xb1\x2b\x0f\x60\xa2\x14\xef\xc0\x2a\x19\x82\x57\x5a\xfe\x27\xa1\x16\x99\xb8\x95\x31\x08\x15\x43\x8d\x2e\xf3\xd2\x23\xef\x9a\x0a\x41\x97\x91\x53\x23\x87\x9a\x29\x27\x7a\x6d\xaf\x16\x34\xc3\x4a\x98\x8c\xa4\xc1\x02\x71\xa7\x10\xd4\x32\x1f\x80\x60\x6b\xc9\xdc\x04\xe7\x02\x33\x20\x2a\xec\x93\x30\xc6\xe4\x54\x2b\x1a\xa6\x53\x5f\xa3\x49\x3e\xc7\x6a\xd8\xb1\x1b\x5a\xac\x75\xdc\x41\x7c\x00\x7f\x92\xc0\x87\x9b\x40\x95\x02\x1d\xc7\x3f\x3a\x50\x25\x1d\x0f\x33\x96\x3f\x61\xa1\x87\x66\x82\x06\xa3\xde\x88\x1c\x94\xd4\xc6\x24\x87\x1c\xe5\x94\xe3\x84\xe6\x29\xf7\x2c\xe9\x1c\x8b\x13\x03\xd4\x33\x71\xd1\x00\x0a\x86\x6f\x08\x2d\xbd\x90\x25\x29\x99\x6f\x91\x0c\x64\xdf\x9a\x4f\xd9\xc0\x7f\x5f\x21\xb0\x6b\x76\xc9\x61\xf8\x14\x72\x1e\x3b\xc7\x59\x22\x91\xcb\xe5\x9c\x2c\x71\xaf\x0f\x43\x85\xc4\x3d\xe8\xc3\x9f\x94\x3b\x3e\x1e\x8f\xed\x20\x8f\x17\x38\xf9\xc4\xe5\x84\x78\x81\x22\x4e\x81\x0b\x24\x38\x90\x3c\xc9\xca\x14\xd7\xde\x31\xcc\x69\xc9\x12\xdf\xe5\x5e\x20\x7e\x6a\x9e\xf6\x54\xd3\x41\x05\xa5\x07\x6c\x08\x54\xef\x62\xfd\xbf\x61\xeb\x53\x18\xcb\x78\xcc\x7b\x73\x31\xbe\xbc\xb0\xad\x2f\x9b\x84\xa2\x2c\x93\x91\x89\x40\x24\xc7\x4c\xd2\x08\x05\xa3\x37\x24\xc5\x29\x64\x84\x8b\x07\x11\xfd\x92\xb2\xa3\x2c\xeb\x55\x68\x4f\xf2\x19\x6d\x8c\x41\x4a\x6d\x08\x61\xc7\x30\x9d\x4e\x9d\x55\x32\x43\x55\x0e\x9d\x55\xbf\x6d\x8e\x4f\x2b\xaa\x40\xd5\x2b\x85\xeb\xb3\x36\x6c\xa2\x42\x81\x8a\x44\xe7\x17\xd4\x09\xb0\x0e\x41\xf5\x46\xfa\xa7\x35\x97\x90\x63\x21\x05\x5c\x85\xe8\x3c\x96\x12\x87\x80\x70\x95\xac\x61\x84\xe3\x54\xbe\x44\x39\x20\xc6\x90\x4a\xc6\xa8\x0f\xdc\x64\x70\x56\x54\x62\x32\xeb\x8a\x4f\xe4\x17\x04\x5c\x30\xa9\x74\x33\x74\x8d\x33\x65\x58\x90\x74\x98\xb1\x0c\x2f\xb5\x37\x60\xb3\x13\xaa\xcf\x9a\x23\xfa\x93\xa2\xa3\xe7\x79\x9c\x9a\x32\x3d\x48\x43\x65\x99\xf3\x05\x99\x89\xde\x45\xf4\x4a\x76\x22\x83\xc9\xbf\x49\xcc\x2a\x98\x6c\xd8\xb5\x82\x16\x65\x26\xbf\x28\xc7\x40\x8e\xcf\xc4\x8d\xce\xe4\xc3\xb4\xdd\x26\xa9\xc1\x9e\x53\x67\xf0\x0d\x31\xf7\xb2\x9e\xc6\x92\xa8\xac\x8a\x35\x26\xd6\x62\x1c\x58\x8b\xc1\x70\xfa\x92\xd1\xe5\x04\x7e\x74\x0f\xce\xa9\x07\xb0\xc6\x32\xcc\xd0\x30\xff\xf7\x7b\xff\x99\x04\xb3\xad\x96\x24\xa7\xec\x9c\x24\x9f\xf8\x04\x0c\x50\x65\xd5\x26\xf0\x39\x2d\x99\xf9\xf8\xa3\x8c\xcd\x31\xe2\x2a\x04\x89\x64\x5c\x80\x58\x74\xe7\x87\x4b\x4a\x69\x56\x86\xbb\xd3\x6c\xab\x09\xdb\xd5\x64\x6b\x3b\x50\x29\xdf\x81\xe5\x8b\xaf\x7a\xb5\xeb\x88\x92\x85\x8c\x55\x48\x3e\xa3\xa1\x82\x7d\xad\xdf\xc8\x75\xd0\x63\x94\x8a\xe7\x84\x0d\x20\x41\x59\x76\x8d\x92\x4f\x5a\x4a\xbe\x96\x54\xfc\xe5\xec\xed\x1b\x0b\x00\xfb\x10\xa1\x82\x8c\x6e\x0e\xe2\xf1\xc8\xa0\x8e\x06\x60\xd1\x6a\x8f\x08\x3e\x57\x68\x8c\x8b\x04\x77\x01\x5d\x05\x6f\x21\xe7\x1d\xa3\xd2\x8f\xac\x91\x63\x57\xab\x8c\xdc\x76\xa7\xee\x71\x3c\x1e\x15\x3c\x82\xfd\x10\x41\xdf\x4c\x41\x9c\xd2\x1c\xf7\x76\x20\xda\xc2\xcf\x10\xc9\x1c\xfc\xc7\x7f\x2e\x6e\xd9\x00\x04\xbe\x15\x67\x02\x89\x92\x0f\x00\x33\x46\x59\x80\xe3\xe2\xb2\x31\x6c\x65\xbf\x2d\x3d\xc6\x3c\xd4\xf2\x8b\x38\x75\x10\x21\x7b\x64\x4f\x7c\x47\xc6\x8c\x46\x70\x8a\xff\x59\x62\x2e\xe0\x87\x31\x97\xba\xc6\x75\xbb\x20\x5c\x50\xb6\x56\x2b\x2d\xa7\xd2\xe7\x28\xa4\x97\x5c\x65\x9f\x74\xb3\x29\x48\xbe\xc6\x5a\x01\x91\xd9\xba\x57\x05\x94\xef\x0b\xe9\x90\xc3\x12\x91\x5c\x9b\x1a\xd3\x13\x4e\x9f\xad\xdf\x9f\xc0\x6a\x41\x32\x0c\xa5\x04\x92\xaa\xeb\x51\x5e\x2e\xaf\x14\xd8\x23\x58\x60\x66\x82\xcd\xa8\x7a\x1a\x4d\xe0\x07\xb3\xe0\xf4\x43\x4d\x4e\x34\x81\xb1\x5c\x41\x5a\x3d\x7c\x1d\xaf\x16\x38\xef\x59\xf7\xec\xeb\xb8\xa0\x5c\xb4\x4a\xa4\xb3\x69\x8d\xb9\x1f\xd8\xb1\xf5\x07\x5b\x11\x1d\x8c\x78\x79\xbd\x13\xae\x0e\x89\x72\x6d\x4f\x31\x2f\x06\x10\xa0\x93\x8f\xfc\x08\xb4\x12\x99\x10\xe4\x62\x7c\xd9\xd2\xd0\x45\xdb\xe0\x49\xd7\x73\xab\x32\x75\xe0\x28\x85\xea\xf8\xdd\x7b\x28\x39\x6a\x98\x85\xe3\xa2\x3c\xa7\x02\x65\xef\xe5\x3b\xdf\x3a\x2c\x9d\x3a\x18\x68\xe1\x74\x26\xdb\x78\x16\x05\x4e\xe2\x05\xe2\x57\x49\x51\x4a\x7f\xe3\xab\x16\x97\x25\x4a\x8a\x32\xea\x87\x76\x3c\xc8\x54\x29\x27\x5c\xaa\xef\x8b\xe8\x5c\x47\x75\x91\xa2\x27\xba\x3c\x0c\xcd\xc8\xc5\x65\x67\x78\xd7\xf0\x80\x02\x93\xef\x1c\x43\xdf\x21\x22\x26\x79\xe0\xf9\x85\xc1\x6b\x18\xc2\x81\x07\x62\x5d\xd4\x37\x92\xd4\x9a\x37\x1a\xcb\x70\x94\x0b\xb4\x2c\xb4\x4f\xea\xbe\x6b\x79\xd5\x18\xac\x2d\xaf\x86\x02\xd5\xa3\xb8\x28\xf9\x22\xc4\xd4\x6f\x83\x50\x20\x49\x51\xc6\x7a\x22\x85\xe4\x93\xf5\x48\x6b\x8f\x65\xa8\xef\x68\x36\xd8\x54\x3c\xae\x30\x59\xbc\x2e\x98\x0d\x52\x55\xa2\x2b\x49\x15\x1d\x53\x86\x79\xb4\x4d\xd0\x32\x8a\xd2\xa6\x9c\xbd\xa2\x28\xdd\x45\xc2\x3a\xc4\xe2\xe8\x06\x33\x34\xc7\xbf\x87\x60\x7c\xc9\x49\xb3\x73\x26\x79\x72\x85\xf4\x18\x54\x1e\x66\x3c\xfe\x72\xd3\x72\x5a\xe6\x2a\xa1\x0a\x62\xc1\x30\x4a\x37\xcf\x50\x81\xd9\x30\xa1\x0c\x6f\xd2\x09\xef\x30\x93\x53\xfd\xaf\xd0\x0a\x26\xd9\x84\xb4\x0c\x28\x8a\x4d\x9a\x89\x55\xae\x65\x5d\x3c\x9a\x22\x60\x22\x02\x8f\xde\x58\x1a\x14\x89\x84\x07\x52\xa0\x51\x69\xfe\x2b\xf1\x56\xbb\x22\xa4\x9a\x82\xff\x4f\x54\x90\x8a\xb4\x02\xf5\x51\x60\x26\xe7\xe8\x4a\x7d\x83\xe9\x54\x6d\x4c\xce\x48\x8e\x53\xdf\x1a\xb9\xc9\xa9\x12\xba\x0f\x5e\x18\x41\xce\x77\xac\x73\xbe\x1d\x13\x14\xa4\x7e\x43\xcc\x15\x69\xb0\x71\x44\x17\x1f\x2f\x9b\xba\xb1\x0e\xd1\x87\x91\x87\xae\xa1\x30\xef\x7e\x5f\xb5\xa9\x67\xe2\x9a\x61\xf4\x29\xa5\xab\xbc\xb9\x2a\xd5\x72\x7c\x66\xdf\x77\xae\xcb\x20\xa6\xed\x08\xb4\x37\xaf\xd3\x00\xf4\x61\x56\xfc\x3d\x57\xd9\xd3\xe8\xaf\x98\xe5\xf8\x3e\xe6\xbc\x46\xe6\xf6\x35\xd5\xd2\xa0\x6d\x6d\xb5\x82\xfd\x07\x98\xf9\x92\x63\xd6\x94\x64\xf9\xb4\xd5\xc8\x77\x2c\x96\x1a\x52\xbe\xe6\x02\x2f\x9b\x68\xf5\xf3\xdf\xc9\x7b\x38\xd5\xb9\x13\x1d\x0f\x1b\x11\x52\x11\xbf\x14\x91\x19\xa3\xcb\x20\x3f\xe2\xfb\xbe\x26\x99\x54\x72\x93\x84\x96\xd8\x0a\xc4\x39\xd6\x8d\x5f\xaa\xfd\x2c\x9b\x9a\x51\x19\xc6\x94\xdc\x90\xb4\x44\x99\x46\x5e\x50\x22\x59\x14\x46\x54\x1e\x7e\x35\x90\xe7\x48\xa0\x5e\x4b\xaf\xba\x87\x6e\x5f\x65\x07\x51\xb7\xfb\xd6\x75\xe4\x6d\x82\xee\x1b\xa8\x46\x83\x0b\x72\x19\xe7\x32\x94\x6d\xd1\xb0\x66\x57\xad\xb5\x4d\xb8\xac\x1a\x1b\x6d\xc6\x58\x75\xb6\xf4\xf6\xde\x7c\xeb\xb5\x01\xde\x2c\x34\xd3\x48\x45\xc0\x39\x66\x2a\xc7\x03\xbc\x40\x8c\x63\x33\xd3\x3a\xff\x65\x17\x08\x20\x21\x27\x0f\xdf\xc2\xcf\x98\x51\x27\x1d\x6a\x02\x01\x09\x87\x4f\x43\x91\xfd\x83\x81\x9c\xfb\x6b\x0c\xa5\x94\x06\xc4\xf5\xb6\xa6\xd9\x7b\x62\x74\x15\x7b\x74\xfb\x8b\x35\x58\x97\xd5\xe8\x9a\x33\x34\xa3\xec\x05\x4a\x16\x2e\xb8\xf3\xad\x65\xb8\xf8\xd4\xae\xa9\x1f\x9d\x85\x40\x17\x64\xff\xe0\xd2\xec\x67\xbe\xcc\xe5\x02\xd5\x8a\xa5\x02\xec\x58\x71\x8d\xe4\xa3\x2f\x27\x13\xf3\x77\x50\xad\xd9\x89\x5e\x88\xaa\xc9\x46\x9f\xd0\x1f\xeb\x16\xdf\xd0\x5f\x2b\x0d\x1f\xb1\xc1\x33\x67\x82\xbe\x6a\x26\x88\x5b\x16\xd8\x56\x73\x93\xd8\xe5\xa9\xb5\xf4\xae\x2b\xd7\xb0\xd5\x85\xe5\x15\xc7\x3d\x9b\xf2\x60\x13\xe0\x68\xad\x2b\xdb\xdd\x03\xb5\xc3\x3d\x2b\x28\xa1\x4a\xad\x06\x1c\x5b\xe5\xea\x9e\x3c\xc4\xdb\x68\x4c\xf7\x12\x2f\x29\x5b\xb7\xce\xf8\x6b\xf5\xea\xb7\x9f\x74\x4d\xc2\xbf\x64\xde\xcd\xb4\xc9\x59\xd3\x54\xe8\x19\x82\x11\xd0\x1c\xbf\xc6\x73\x74\xbd\x16\xf8\xcb\xcc\x8d\xc5\x66\xe7\x27\x9c\x20\x95\x04\x57\x33\x44\x65\xa4\x98\x65\xd5\x66\x44\xeb\xd4\xbc\xd5\x40\x9b\xa3\xb4\x16\x6f\x70\xb3\xef\xd4\xed\x80\x55\xde\x92\x44\x60\x88\xd5\xf6\xcd\x22\x35\x3e\xaa\xad\x1e\xd8\xee\x76\x6e\xe8\xec\xe9\x14\x1e\xfb\x2b\x73\x83\x1f\xb7\x91\xe4\xc7\x9e\x83\xc7\xd0\xca\x12\xb8\xfb\x1a\xfd\x52\xfe\xa1\x5f\x7f\x43\x61\x49\xb2\x8c\xa8\x70\x47\x97\x4e\xa0\x4f\x7a\x23\xa5\xc0\x2c\xc1\xb9\x40\x73\xac\x77\xc7\x2b\x96\x56\x56\xe6\x35\x12\x8b\x98\xd1\x32\x4f\x7b\xbd\x5e\x35\xa2\xc0\x65\x83\x51\x7b\x64\x65\xf6\x2b\x8d\xba\x52\xd3\x63\xf1\x3f\x55\x2f\x2a\x63\xe6\x4d\xe5\xc1\x78\xec\xc7\x43\x66\xaf\x48\x19\xa6\x8b\xe8\xf8\xdd\xfb\x68\x50\x41\x5f\x86\x75\x68\x7a\x35\xed\x2a\x12\x1a\xda\xab\x56\x3a\x43\xa2\x54\x3e\x82\xa0\xc1\xe6\x05\x27\x3f\x9b\xd4\xb1\xec\x44\x15\xd7\x36\x05\x43\x62\x35\xab\x59\x41\xb8\x21\xeb\x06\x4f\x03\x0e\x69\xc8\xab\x04\x15\x28\x21\x62\xed\xf8\x60\xb1\x6f\x00\x0e\xa2\xe3\x70\xc8\xfe\x54\xb5\xa8\x17\x85\x3c\x9c\x93\x90\xbb\x5a\xf9\x46\x03\x1f\x6d\x8d\xc7\x79\xb9\xfc\xc9\x2e\x45\xd3\xd8\xf8\x75\x7b\x2e\xec\x9f\x91\x0c\x5b\xdf\xfe\x73\xe8\x2a\xfa\xfb\xa7\x01\x64\x9b\x33\x1a\x38\xb6\x21\x78\x15\x73\x19\xaf\xb8\xca\x2a\x5b\x36\xcc\x32\x4a\x59\x4f\xed\xa6\x18\x06\xa8\x71\xc7\x63\x29\xad\xea\x69\xc5\xfd\xc3\xc0\x49\x93\x23\xb3\x05\x07\x28\xbd\x21\x9c\xb2\x78\xc6\x15\xee\xb8\x72\xa6\x14\x82\x14\xdf\x10\xb5\xc3\xed\xfc\x42\xb3\x41\xe1\xa9\x57\x53\x3a\xa1\xcb\xf9\x29\x4b\x31\xb3\x3e\xa1\x06\xb8\x70\x1c\xdd\x97\xbd\xc7\xca\xb5\xbc\x54\x0e\xfe\xcb\x33\xf8\x43\x04\xfb\xd0\xab\x9e\xc3\x3e\x1c\xf4\x07\xde\x70\x2f\xeb\xd5\x6f\xaf\x94\x04\xa9\xd2\x28\x55\x53\x24\x83\x19\xc7\x36\x4b\x55\x4a\x78\x91\xa1\xb5\xae\x8f\xff\x3e\xb6\x8d\xa3\x97\x0e\x32\xc5\x02\x91\x8c\x47\xc0\xb1\xb6\x01\x5c\x90\x2c\x53\xd5\x62\x7a\x07\x8d\xe9\xfd\x48\x35\xb7\xd2\x78\xb8\x5e\xb8\x5b\x2e\x4b\x74\x7b\x55\xe9\x6e\x7f\xa8\xdf\xbb\x15\x12\xc8\x91\x2e\x92\xbb\xf2\xb7\x8b\x1d\xb3\x9c\xd0\xf1\x8c\x24\xb8\x37\x1e\xf8\xc0\xbe\xbb\x6a\x0c\x67\xe7\x3e\xb4\x32\x87\x6a\x4b\xd7\xd9\x5c\xa5\x7c\x1e\x7f\xa7\x04\xe5\xf1\x77\x87\xf6\xf5\x4f\xa4\xfe\x3a\xb0\xd3\x6d\xfe\xcb\xbd\x6d\xe4\x56\x3d\xb5\x35\x69\xb2\x83\x43\xd3\xb9\xfb\x31\x80\xe8\xcf\x54\xdc\x23\x94\xfc\x62\x59\x93\x2f\x9d\xfb\xee\x76\xa8\xb6\x35\x59\x51\xf6\x89\xe4\xf3\x2b\x8e\x45\x6b\xc3\xce\x84\xc4\x9e\x09\x30\xcd\x8e\xb7\x9e\x2d\xa5\x6a\x07\xc0\xb7\x98\x14\x67\xb5\xae\x76\xd4\xfc\x1d\x82\xe2\x9b\x1e\xf8\xe6\x9b\x3d\x9b\x86\xd9\x02\xf9\x24\xe8\xbd\x92\x9d\x1a\x49\x3b\x98\x3a\xcb\x86\xf7\x76\xef\x55\xf9\x16\x8c\xce\xd5\x31\x97\x6b\xc4\xe2\x2f\xe5\x08\x2e\xa8\xd0\x6b\xac\xa6\xe8\x3b\xa6\xd2\x53\xfa\xc1\x50\x2d\x3a\xa5\x49\xb7\x21\x6c\xd8\x8f\x56\x54\x09\xcd\xd2\x0a\x93\x8f\x77\xe8\x88\x96\xb0\x5f\xf7\xa2\x3f\x58\xd6\x0c\x17\x54\x0c\xed\xd2\x8d\x57\x24\x15\x8b\x9e\x1b\xe1\x3e\x44\x7f\x8c\xfa\x8d\x36\xb2\xa3\x7a\x23\xaf\xf3\xb0\x95\x86\x1b\x0a\x7c\x2b\xa2\x6a\xc3\x58\x7e\xf3\x53\xdb\xfe\x59\x96\xfa\xb8\xf5\xe1\x8d\x91\xda\xa8\xf0\xe1\x02\x1e\xc0\xbe\x87\x2d\x82\x9e\x04\xf6\x59\x20\x69\xea\x47\xda\x35\xdd\x35\x7f\x57\x0f\x5e\xbc\x55\xa6\x6d\xa1\x5f\xd0\x37\x43\xfe\x79\x34\x57\xd0\x90\xa3\x25\x0e\x33\x6f\x6f\xb0\x90\x02\x72\x62\x5b\xa9\x92\xfe\x5e\x85\x44\x6f\xb1\x57\x5f\x8d\x09\x6a\x53\x82\x0e\xa6\xab\xf6\xcb\x41\xd8\xcc\x19\x4c\xa7\x10\x74\xd5\xa8\xfa\x22\xad\x49\x97\xe1\xc1\x86\xf8\x3a\xd7\x23\x02\x71\x3b\x62\xb7\xa0\x58\x56\x0b\xdd\xcc\x98\xd5\x51\x9d\x07\x6e\xb0\xd9\x4e\xba\x36\xd9\xcc\xfb\x4d\x1b\x6d\x72\xf6\xdc\x64\xa9\x39\xb4\x6a\x81\x04\xb3\x01\x53\x35\xe0\x80\x8e\x5a\xc9\x61\xc5\xe6\x7a\xc3\xce\x19\xae\x04\xb4\xee\xdc\x19\xca\xe3\x0a\xd5\xa0\x56\xcc\xd8\x84\x70\xc9\xe8\x60\x9a\x35\x0d\x5e\x81\x7b\x42\x73\x4e\x33\x1c\x67\x74\xee\xfa\x8f\xde\x9b\xdd\x53\x0a\x33\x92\xa7\x6e\x08\x8f\xa2\x01\xd4\xe4\x30\x7a\x24\x1d\xc8\xa8\xaa\x1a\x09\xf6\xfe\x0c\x59\x41\x36\x68\xab\xc1\x37\x02\x22\x3f\x9f\xda\xcf\xff\x9e\xd5\x0f\x27\xf9\x19\x4e\xee\x15\xf9\x9a\x9d\x6e\x5b\x21\xfb\x25\x9d\x8b\x70\x6b\xa3\x29\x10\x17\xa1\x10\x5c\xc6\xe2\xf6\x4a\x31\x17\x86\x4e\x33\x2a\x72\xef\xd1\xd6\xdf\x30\x0c\xb8\xf2\x65\x48\x64\xbf\x82\x44\xb6\x23\x89\x5f\x60\x1f\x47\x69\x2d\x28\x30\x03\x5d\xaa\xbd\x31\xd7\x68\xd5\x94\xaa\x89\xe3\xad\x5a\xf0\x85\x7a\xf5\x3f\x35\xf8\xdf\xad\x06\xb5\x02\xfc\x9f\xea\xfb\x6d\x54\x9f\x5e\x7e\x0f\xd4\x7d\xba\xf1\x6f\xaf\xfc\x1e\x4e\x24\xdb\x95\xc8\x2f\xa0\xfe\xb4\xba\x6a\xd5\x7f\x5e\xb6\xc9\x4b\xf1\xe8\x68\x45\x9f\x0e\xac\xf9\x81\x2f\x49\x86\xcf\x14\x94\xce\x50\x6c\x2a\x8a\x6b\x0a\x73\x8b\x0a\xb2\xe2\xab\x76\x5e\xda\x53\x7f\x2d\x0b\x12\x67\x30\x95\xc1\xc9\x93\x94\xdc\x3c\x8d\x3a\x0f\x5a\x6f\x4f\x10\x6e\x4f\x0f\x7e\x81\xe4\xa0\x4d\x8e\x19\x6e\x3f\x7f\xfb\xda\xc9\x9e\xb7\xce\x1e\x90\x37\xd4\x62\xcc\x63\x1b\xd9\x99\x92\x7b\x13\xd2\x79\x64\xbb\x90\x4e\x37\x90\xf1\x9b\x05\x0e\x63\xb9\xda\xe9\x7e\x37\xc2\xb6\x30\xce\x07\x72\xb9\x68\x17\xca\xf9\x81\x9c\x23\xa4\x1f\x19\x19\xbe\xab\xe5\xbe\x4e\x96\x68\x8e\x79\x8f\xa8\x3f\xce\x82\xea\xef\x32\xf2\xc9\xcb\x2c\x83\x5f\x7e\x01\xfd\xc4\x1d\xbe\xa9\x9f\xbd\xb1\x4b\x24\x38\xf7\x05\x53\xf8\x5c\x9d\x6a\xf0\x54\xfa\x29\x56\xc7\xdf\x74\xe2\x3a\x3a\x47\x73\xe5\xdb\x9e\x3c\x57\xc7\x49\x08\x13\x25\xca\xe0\x8c\xfc\xac\xd4\xbe\x3a\xe8\x21\xc9\x0d\x4b\x27\xdc\xe9\x7c\x85\x51\x17\x9e\x4b\xf8\xb6\x4f\xd5\x51\x4d\xfb\xa9\x42\x53\x1d\x10\xb7\xbb\x0f\x3b\xe5\xd2\x02\x66\x34\xa4\xbb\x45\x7f\x2b\x8a\xd1\xbc\xfe\x88\x49\x3e\xc0\xd4\xe0\x93\x01\xa7\x7c\x72\x25\x21\xa5\xf1\xe6\x45\x46\x44\x2f\x9a\x44\x95\x9d\x2c\x28\x57\x4f\x13\xdc\x1b\x1e\x0c\xe0\x60\x43\xdd\x5c\x0b\xce\xee\x72\x0e\xd5\x53\x17\x25\x1f\x9b\x94\x18\xff\x46\xb5\x72\xae\xcd\x81\x5f\xe9\xa0\x3a\xd4\x35\x29\x0a\xec\x22\x84\x96\x5a\xc8\x95\x3e\x78\x9b\x47\xa1\x8d\xd0\x43\xfe\x48\x49\xae\x7a\x6f\xb5\x23\xaa\x27\x0d\x32\x80\x0e\x18\x37\x2e\x92\xc6\xbc\xbc\xe6\x82\xf5\xc6\x03\x78\xfc\x5d\x3b\x78\x35\x8a\xcf\x37\x13\x8f\x27\x37\x5a\x36\xaf\xf4\x75\x28\xb3\x49\x90\x5c\x69\x07\xeb\xdb\xb2\x0e\x25\x58\xfe\xe1\x42\x07\x9f\xa8\xb3\x4c\xa9\x39\x29\xd8\x4a\x50\x48\x87\x69\xa0\x48\x48\x63\x41\x5f\xd1\x04\x65\xf8\x4c\xc9\x7b\xaf\xea\x71\x8b\x21\xd3\xc7\xaa\x44\xe7\x1d\x1d\x51\x4a\x93\x4f\x98\x0d\x75\xb7\xd1\x00\xbe\x1d\xfb\x77\x74\x1c\x36\x74\x89\x39\x90\x23\xd5\x09\x3f\xa5\x54\x0c\xa0\x3a\x7d\x52\xb8\xb3\x3a\x4e\xc9\x78\x0f\xdb\xf4\x8a\xc9\x9f\x69\x94\x43\x41\x8b\xa8\xaf\x15\x67\xf4\x86\x42\xf5\x02\x66\xb4\xd4\xf6\xb5\xa9\x8b\x42\xad\xb3\xa7\x3d\x58\x53\x75\xf8\x4e\x6b\x9b\x77\xe6\xef\x99\x40\x4c\x80\x75\x35\x8f\xdf\xbd\x87\x3f\xaa\x4b\x53\x5e\xbc\xd6\x1f\x4e\xcf\xce\xec\x8d\x17\x75\x05\xa5\x8f\xf4\x44\xa6\xc8\x9a\xe4\x73\x87\x86\x2e\x97\x28\x4f\x55\x3f\x67\xa7\xd1\x1e\x40\x87\xfa\xd2\x88\x37\xe8\xab\xcd\xda\xcc\xfb\xa4\x31\xb5\xb5\xda\xa0\x17\x7d\xc2\x7c\x85\xf8\x9d\x75\x13\xf4\x7c\x76\x14\x44\x9b\x14\xb0\x9d\x02\x37\x32\x03\x61\xba\xdb\xb1\x5e\xda\x68\xd8\xa6\x6c\xec\xa2\x66\xc3\x35\xe3\xe1\x90\x8b\x46\x15\x44\xee\x00\x57\x90\x74\x27\x30\xc4\x70\x2e\xae\x76\x84\xe6\x52\xbe\xae\xa4\xd3\xde\xbe\xbc\xad\x2e\x9e\x40\xbd\x1b\xbd\xd9\x7f\x95\x14\x65\x55\xa6\xb2\x09\xc8\xbb\xc1\x47\x2b\xd5\x07\xf6\xb7\xc4\xcb\xed\xfd\x2d\xf1\x72\xc7\xfe\x9a\x1d\x31\xce\x1b\x2a\xb4\x09\xd2\xbf\x2f\xfd\x81\x8a\x76\x03\xd8\xd0\x4b\xa0\xad\x37\x8c\xa1\x39\xa3\xa2\x6c\xaf\xa9\xad\x0f\x43\xab\x85\xee\xd9\xaf\xc1\x27\xcb\xdd\x04\x90\x33\xaf\x4a\x23\x5c\xa2\x26\x1c\x98\x33\x5a\x16\x30\xad\xf3\x48\x3f\xbf\x2a\x90\x2e\x01\xb0\xbe\x32\xd7\x61\x09\x43\x2b\xdb\x32\x23\xf9\x27\x40\x1c\x88\x00\x19\x5e\xf1\x6a\xdf\xd8\x9d\x31\x8b\x1b\xfd\xbd\x92\x8d\xa6\x10\x3d\x41\xb0\x60\x78\x36\x7d\xa4\x2e\x91\x72\x67\xe6\x5c\xdb\x91\x3a\x28\xa7\xbb\xda\x87\xe8\xd1\xd3\x28\xd8\x93\xd0\x6f\x3c\x6b\xfd\xed\x58\x7b\xc4\x4f\x46\xe8\x69\x54\x2f\x96\x74\x82\xa6\xdb\x29\xe1\x72\x14\xdd\xdd\xa7\x62\x7f\xab\x69\x0c\xed\xd2\x00\x1e\x7f\xdf\x30\x8d\x7e\xae\xab\x11\xe9\xe5\x34\x0d\x02\x3d\xa5\x1e\xea\x91\xde\x0e\xd9\xae\x8e\xe0\xc5\xf8\xdd\xe6\xac\x0c\x2c\x51\x01\x74\x06\x3a\x86\x51\xdb\x2b\x20\x68\x23\x28\xda\x16\x08\x39\xa4\xf7\x0e\x35\x3b\x02\xc8\x1d\x23\xd0\xdf\x2e\xd2\xc4\x59\x8c\x8a\x02\xe7\xa9\x73\xf8\x1c\x85\x9e\x24\x02\xa8\x8b\x5f\x32\xc4\x79\x2f\x62\x74\x05\x09\xcd\x86\x7c\x39\x3c\x78\xdc\x00\xd3\xe8\x24\x96\xc5\x77\x4f\x2b\x8f\xa5\xaa\x0a\x21\xaa\x1a\x44\x4a\xf1\x44\x85\x75\x5e\x70\xd9\xef\xfb\xc7\x04\x6a\xf1\xa5\x57\xdc\xe5\x28\xf4\x88\xaa\x36\x1a\xaf\xbd\xb6\xf2\xcb\x30\x45\xf9\xdc\x59\xe7\x07\x8d\xd8\x8c\xf6\xc7\x0d\x83\xed\x24\x48\x3e\xd4\x60\xb5\x11\x85\xc3\xf5\xa2\xe3\x40\x4c\x9a\x54\x7c\xdb\x1c\x8a\xd7\xd8\xe2\xbc\x57\x54\x5f\xdd\x0d\x00\x10\xd5\xa8\x8c\x26\xf5\x99\xb0\x46\x25\xf2\x7a\x8d\x26\xfe\x00\x2a\x08\x95\x27\x8e\x26\x40\xf4\x93\x3b\x2b\xce\xd2\xb3\x8d\x54\xe9\x90\x3d\xa2\x1f\xe3\x65\x21\xd6\xbd\x8a\x57\x38\x73\xfb\xae\x3b\x24\x80\xac\xc2\x79\x71\x5b\xe0\x44\xf0\xe0\x54\x44\x92\x51\x5e\x32\xcc\xd5\xed\x30\x28\xcb\x62\x38\x9a\x09\x6c\x8e\xcc\xe1\x5b\x9c\x94\x4a\x03\x49\x35\xf5\x97\x33\x60\x65\x2e\xcd\x14\x10\x2e\xf1\xcd\xc9\x0d\xce\x95\xb2\x67\x34\x83\x6b\x94\x7c\x82\x6b\x3c\xa3\x4c\x1f\x4b\x27\x79\x49\xf2\xb9\xba\x05\xf3\x5c\x5d\x3a\x6a\xb5\x99\x5e\xbc\x1c\x10\x5f\xe7\xc9\x82\xd1\x9c\x96\x3c\x5b\xfb\xda\x0e\x17\x2f\x54\xcf\xb8\x27\x3f\xf3\xea\x14\xfa\x1b\xaa\x5e\x72\x39\x30\x5a\xc4\x55\x1e\x1d\x17\x5b\x53\x0f\x2e\x51\x8f\x14\x0e\x55\x33\xa9\xc7\x87\x81\x08\x9b\xae\x57\xaf\xa6\xba\x97\x58\xdf\x65\xa1\xe4\x49\x3e\xe8\x55\xb7\x4b\x9c\x25\x0b\x9c\x96\x19\x36\xf7\x55\xdd\x0a\xf5\x5e\xe2\xe0\xfa\x62\x17\x5a\x8a\xa0\xc0\xbf\x65\x4c\x87\x70\x37\x80\x71\xed\x1e\xb8\x2c\x73\xb7\xf2\x70\x30\x7c\x2f\x5a\xaa\xe8\x15\x40\xaf\xbb\x06\xa9\x76\xd4\xdb\xe5\x00\x55\xe7\xae\xe8\x76\x4b\x81\xed\x2f\xbf\xb4\x95\x8a\x34\x6b\x2d\x35\xbf\x94\xc5\x6c\x39\xd7\xd0\x28\x35\x8e\x94\x99\x1b\xda\xdb\x47\x37\x94\x52\x1d\x7a\x1e\x97\x9d\xc4\xe3\x77\xef\xe3\xad\xa4\xef\x4e\x59\x78\x82\x3d\x4a\x8a\x72\xa8\xd2\x63\x43\x4d\xa4\xbd\x2b\x75\x47\x22\xdd\xc5\x57\xec\x63\x8e\xe6\x28\xeb\x4f\xe0\x14\x0f\xf5\x7d\x85\xea\x54\xc4\x2b\x8a\x52\x40\x6a\x91\xa9\xab\x5c\xb9\x40\xea\xc2\xc1\x46\xa9\xb6\x41\xb6\x69\x04\xa3\x11\xfc\x1f\xff\x74\xf4\x23\x49\x7d\x46\x51\xaa\xc9\x7e\xb4\x03\xd9\xa3\x51\x45\xf9\x4e\xbc\x0a\x4e\xf6\x7a\x0a\x5f\x31\xce\x1e\x0d\x7e\x28\xef\x76\xa2\xa0\x76\x8a\xb1\x4e\x83\xee\xba\x3a\x05\x79\x5f\x22\xac\x94\xe9\x32\x97\x2d\x82\x76\xdf\x55\xe0\xd7\x12\x9a\xfa\xbe\x87\xb1\xca\x52\x69\x76\x04\xb7\x90\x69\xf7\xd3\x76\xa6\x33\xa8\x2e\xb1\x3b\xa0\x43\xb5\x3d\xfc\x5b\x4c\x6a\xb8\x8d\x5b\xf5\xa7\x37\x64\x1e\xca\x1a\x57\xf8\xba\x85\x3b\x4d\xf7\xb2\x83\xe2\xed\xf6\xb6\x46\x55\x5d\x71\x95\x5c\xd0\xa5\xb9\xd1\x97\x6f\x51\x61\x0a\xf6\x6a\xa9\x61\x77\x9b\xb9\x39\x16\xba\x0b\xd3\x83\xbf\x34\xea\x1e\x4f\x95\x7b\xab\xbf\x08\x6f\x26\xf1\x30\x54\x1d\x1a\x9a\x5c\xb6\xce\xfd\x53\x2b\xb4\x8b\x04\xbd\x42\xd5\xdb\xa1\xc1\x51\xcd\x6d\xcd\x78\xf9\x5d\x1c\x7a\x28\xee\x5a\x67\xda\x3f\xc7\x53\x5d\x3d\xd3\x38\xc6\x03\x53\x19\x68\x89\xf0\x1c\x12\xaf\x57\xdd\x86\x66\xb3\xda\xaa\xef\x38\x68\x19\x6c\xd8\xcf\xa0\xf7\x80\xe3\x69\xdb\x26\xd5\x04\x2a\xba\xba\xfb\x98\x96\xd6\x03\xfe\x83\xd5\xb7\x7e\x0f\x43\x03\x37\x4c\x24\x60\xd4\x8f\x6f\x50\xd6\xf3\x38\xb8\xe9\x04\xde\x5e\x38\x4f\x6d\xd8\x03\x55\x15\xc0\xab\xe2\xed\x67\xeb\xe3\xa2\x6c\x3d\xf1\xea\x51\xdf\x6f\x9c\x68\xbc\xdb\xbb\x27\xff\xea\x85\xd1\x0f\x66\xa1\xd1\xc0\x0f\xe1\xe2\x86\x53\x6d\x21\x23\xbb\xfa\xd8\xca\x4b\xdd\xc3\x43\xd8\x69\x58\xda\xe2\x73\x86\x07\xa7\x51\xae\xfb\xaa\x9f\x8e\xe6\x2a\x59\xa1\x6f\xe1\x3f\x7e\xf7\x7e\xa0\xab\x30\x91\x80\x25\xe5\x02\x22\xcd\x15\xc0\xb9\x60\x24\x4c\x53\x6c\x14\x02\xd5\x4c\x4f\x4a\x73\x39\xc9\x0e\xdd\xd4\xa1\x01\x5c\xfb\xcb\x0a\xc5\xe6\xe2\x13\x1e\x4b\xaf\xf4\x29\x5c\x07\x0f\x1a\x15\x97\xba\xc6\x06\xe0\x0e\x70\xc6\x71\x1b\x8a\x27\xdb\x50\x84\x18\x6a\x2f\x13\xba\x2c\x10\xc3\xcf\xd6\x52\x47\x6a\x6a\x3d\xde\xfb\xf7\xa6\xb7\x8c\xd4\x1e\x73\x50\xdb\xd6\x4b\x92\x77\x2a\x17\xcb\xb2\xaa\xc6\x57\x31\x29\xe8\xfb\x21\x33\xaa\x05\xb2\x7d\x52\xd5\xa5\x81\x5d\xf3\xda\x2d\x90\x5f\x66\x6a\x4d\xa1\x7d\x30\xbb\xa1\x83\xb5\xe3\x04\x1b\x44\x4f\x76\x40\xf4\x9f\x39\xcd\x12\xc2\x50\x47\x04\x65\x70\x8d\xb8\xfe\x3d\x0b\xd3\x07\xa3\x59\x86\x59\xbd\x52\x3a\x1c\x0e\x2f\xaf\x8f\x94\xb9\x7b\xe6\x55\xc6\x95\xd7\x47\xba\xa8\xf9\xa9\x7a\xa3\xaf\x06\x08\x0f\xb9\x2a\x8e\x79\x7c\x77\x6d\x9e\x74\xb6\x19\xfa\x8d\x82\x37\xe3\x43\xef\x5c\xb9\x15\x62\x9b\x91\x94\xc1\xaf\x9d\x40\xfb\x3d\x38\x75\xac\xb3\x89\x9d\xb7\x30\x98\x1b\x9f\x78\xc0\x7a\xaf\x1c\xa6\x28\xcf\xca\xa5\xbf\xb3\xaf\x85\xc4\x7b\xe8\x37\x34\xb9\xcb\xc6\xf9\x7d\xf9\xb8\x2a\x8b\xd3\x28\xf7\x75\xea\xb3\xfd\x1c\xa8\xeb\xc4\x82\xed\x72\x66\x38\x38\xaa\x9f\x14\xe5\xc4\xf6\x35\x6a\x23\xd2\x48\x96\xd7\xdf\xc4\xeb\x77\x4b\x93\xc6\x74\xa8\x3b\x5c\xe9\x0c\xc8\x72\x89\x53\x82\x44\x38\x0b\x7c\x60\xee\x77\x95\x31\xac\x76\xdc\xaa\x59\xf3\xe6\xe6\xde\xbe\x97\x3b\xff\x5d\x73\x91\x03\xbd\xf6\xcb\x2f\x66\xd9\x6c\x00\x0a\xee\x84\x75\x2d\xbe\x6a\xe9\xcf\xfd\x96\xc8\x65\x50\xdf\xe4\x83\x56\x3f\x8a\xa0\x32\x76\x9b\xfa\x6d\xca\x4a\x43\x10\xa1\x89\xfa\x22\x98\x1d\xb9\x9c\x2e\x6b\x97\x63\xa8\x87\x87\xee\x46\xc3\x2e\x7f\x57\x0f\xe2\x01\x34\xd5\x7d\xde\x4e\xba\xbe\x6a\xbf\xf7\x29\x80\xac\x96\x70\xe3\xd0\x7d\xfb\x02\x3d\x6c\x41\xa2\x15\x66\xe3\x0e\x93\x9a\x3e\xde\xa0\x90\xc3\x7a\x04\x5e\xd5\xd2\xf6\x5a\xce\x6e\x48\xd7\xd0\xc6\xa0\x1c\x67\xfa\xb0\x64\xed\xbc\x8d\xc9\xc8\xda\xaf\x5e\xae\x9b\x17\x28\x77\xa9\xfd\xaa\x56\x77\x02\x51\xbf\x0d\xfc\xba\x82\x0d\x29\x51\x43\xdb\x5a\xd1\x0b\xb5\xc3\x27\x36\x43\x59\xed\x2d\xe5\x78\x65\xd5\xa4\x64\xce\x8c\x61\xbe\xd0\x77\x7f\x56\x46\x45\xdf\x95\xcb\x0d\xb8\x2e\x86\xae\x86\x0d\x29\xa3\x45\xed\x36\x29\xb5\x1d\x65\xf9\x57\x41\xda\xf4\x76\xd7\x61\xc8\x8d\x67\x1d\xab\x22\xeb\xce\x05\xed\x6f\x1c\xd5\xd6\x71\xdb\xd9\xb3\xd6\xe2\xe6\x8d\xc8\xdb\x9b\xec\x90\x57\xee\x98\xa4\x4a\x43\xec\x32\x89\x51\x74\xe8\xdf\x5d\x97\x65\xde\x59\x24\x7b\xd4\xd0\xcd\x44\xb8\x65\xa6\x36\x0b\xea\xd3\xd0\x5d\x82\x79\xcf\x91\x37\xf6\xcc\x02\x79\xeb\x38\xf9\xd7\x8a\xd0\x9e\x98\xaa\xef\xcf\xc8\x55\x90\x91\xfa\x06\x93\x5d\x29\x42\xb0\x5e\xc4\x68\xa6\x4a\x66\x0a\x86\x39\xce\xf5\x2f\x61\x75\xc0\x3b\x9c\xa8\x1d\xe5\x06\xf4\x4b\x9c\x97\x44\xe0\xe5\xae\xed\x04\xba\xd6\x9b\x38\x03\x18\x1e\x6c\x6d\x93\x64\x24\x91\xeb\xc5\x2e\x9d\x58\x36\x56\x97\xfc\xd4\xaa\xe3\xfb\x5b\x51\xb5\xe9\x8b\x6a\x8b\xda\x53\x6e\xbb\xcf\xcd\xb8\xba\x07\xca\x1a\x7f\xa5\x2a\xb4\xcf\xa5\xae\x16\x6e\xdf\x8d\x08\x55\x4a\xe5\x5b\x79\x59\xb1\x96\x22\x5a\xef\xad\x24\xba\xba\x8e\xd8\x0c\xfb\xd7\xe5\xaa\x9a\xb7\xe6\x76\x7a\x17\xfa\x9f\x54\x4e\x8d\x0d\x41\xc2\xb8\x38\x2d\xf3\x30\xc1\xd5\x05\x05\x53\x7b\xb5\xbb\x07\x7c\xaf\x8c\xa3\xfd\xd7\xba\xd3\xdf\x90\x86\xc8\x21\xd0\x79\x84\x5d\xb2\xa3\xfa\xdf\xdd\x6e\x14\xd6\x32\xc6\x01\x79\x56\xb8\xa2\x86\x8d\xac\x27\xf2\x1e\xd2\x73\x7b\xe2\x33\x20\x20\xcc\x30\xee\x94\x52\xec\xa0\xe4\x2e\xcc\x8c\xdd\x77\x57\xcd\x4f\xbd\xf8\xee\x05\x4a\xd3\xa3\x2c\x53\x57\xe0\x37\x9c\xdc\x46\xf6\x54\xfd\xf2\x86\x7b\xb8\xbd\x86\x5a\x47\x2a\xb2\xc1\x59\xa1\xce\x94\xb4\x70\x32\xe4\x62\x60\x0b\x82\x45\x03\x24\x6f\x52\x64\x0f\x3f\xdb\x30\x6b\xea\x83\x5c\x04\xed\x2f\xbd\x0a\x63\xff\xda\xd4\x8a\xbc\xf6\x4b\x40\xf4\x7b\x63\x40\x1c\x70\x68\x21\x2c\x98\xe2\xe3\xdf\xd4\x4f\x37\x55\x7d\x5f\x38\x0c\xd5\xad\x21\x6a\x35\x2a\x60\x98\x9a\x1f\x54\x30\x2f\xec\xd0\xf5\x2f\x1f\x54\x43\xb6\x88\xeb\xca\x40\x83\x4d\xa5\x55\x0e\xe5\x4f\x1a\xdb\xeb\x52\x08\x9a\x0f\xa5\xcd\x75\x34\xf4\xe3\x05\x49\xb1\x9f\x23\xbc\x73\xaa\xc0\x9a\x68\x6f\xd0\xfb\x10\x5d\xa9\x5e\x78\x47\xd1\x44\x63\xc9\x6f\x32\x92\xf7\x37\x93\xf7\x36\x94\x0f\x37\x95\xbe\xe1\x53\x0c\xf7\xcd\x9e\x63\xc9\x40\x4f\xce\x56\xd3\x67\x8d\x9f\x81\xf6\x38\x2e\x67\xce\x89\x40\x38\x71\x81\x68\x78\xbf\xc2\xa1\xff\x59\xca\x7a\x4d\x72\x0e\x1b\xaa\xc2\xff\x3d\x2f\x7f\xcd\xcf\xb1\xd0\x1a\x49\x1f\xca\xf3\x64\xc3\x1d\x37\xf7\x56\x48\xc3\x24\x3a\x89\x7c\x87\x08\xab\x96\xcd\xfe\x3e\xf1\x83\xb2\x2d\xcd\xec\xaf\xaa\x4d\x21\xe8\xdf\x9d\x44\xef\x38\x85\xee\x07\x46\x3e\x2b\x2c\x1b\xaa\xb0\xc8\xc9\xb1\x94\xe1\x5f\x1d\x1d\xa9\xbe\xb6\x47\x46\x66\xb2\x75\xc5\x82\x3b\x0e\xd9\xe4\x77\xb5\x61\x63\xa0\xa6\xd2\x2f\x33\xec\xdb\xc6\x71\x7d\x63\x52\x30\x6e\x73\x53\x92\x9f\xb7\xda\x3a\x01\xea\x38\xd9\x85\xba\x98\x51\xe1\xa8\xc2\x84\x96\xe0\x2b\x48\x5c\x29\x56\xe2\xb4\x2e\x89\xc6\x48\x6c\x1a\xf5\x1b\x7b\x81\x67\x73\xe4\x76\x9e\xa3\xe8\xd0\x9f\xf6\x9d\x47\x51\x93\x8e\xa6\xf5\xf5\xaa\x10\x3b\x2e\x8a\xbb\xbf\xa9\xf2\x5a\x29\xcf\xb3\x35\xc6\xe8\x68\xd7\xa8\x23\x0c\x2c\xac\x67\x91\xda\xd6\x20\xba\x0d\x06\xd7\x29\x39\x35\xb8\xc0\x86\x79\x97\x67\xed\xb0\xea\x6b\x98\x1e\x66\x26\xf5\xee\xd6\xcd\x39\xbe\xf5\x0b\x01\x01\x14\x13\x20\xc9\x10\xe7\xd3\x0f\x91\x0d\x1f\x3f\x44\x4f\xe1\x89\xb6\x62\xd5\xbb\x6b\x91\xc3\xb5\xc8\x87\xf6\x67\x80\x6b\x97\x7f\xd8\xa6\x43\x41\xe7\xf3\x0c\x7f\x88\x40\xac\x0b\x2c\xdb\x29\x34\x1f\x22\x20\x69\xf5\xad\x66\x1a\x2d\x91\x96\xc0\xfd\x80\xc2\x0f\x91\xaa\x71\x34\x88\x03\x2a\x01\x31\x82\x86\x0b\xc4\x0b\x5a\x94\xc5\xf4\x43\x24\x4d\xfa\x87\xa8\x4e\x9b\x82\xc2\xb7\x05\xca\x53\x2c\x89\x50\xda\xfd\x43\xe4\x2a\x80\x5d\xc7\xa0\xd5\x8f\x26\xb6\x6e\x91\x7d\xa4\x35\xbd\xf6\x21\x7a\xfa\x64\xa4\x14\x17\x68\x04\x96\x6d\x09\x62\x38\x78\x3b\xd2\x2c\xe8\xe8\xbc\xcc\xb6\x77\x6d\xdc\x82\x0f\x51\x63\xde\x86\xd2\xe4\x7e\x88\x40\x5a\xe0\xe9\x87\x48\x7f\x6b\xe5\x86\x42\x91\xe1\xf4\x7a\xdd\x35\x29\x52\x79\x2b\x39\x18\x95\x99\xfc\x5f\x2d\x96\x56\x9a\xa5\x04\x55\x44\xbb\x1f\x1a\x94\xca\xbf\x0b\x65\x80\xcc\x0f\xf3\x0d\xe2\x7e\xed\x16\xda\x30\x13\xa0\x9b\x1b\x65\xdf\x52\x0a\xe9\x97\x40\xd6\x54\x68\xa8\x99\xbe\xc4\x8f\xfe\xa0\xa2\x30\xfa\x65\xf4\x6f\xf3\xeb\x3f\x61\x21\xe0\x4e\xca\xf8\xbf\x24\xea\xf8\x6d\xb4\xab\x7e\xf3\x3e\xd7\x27\x20\x43\x38\xf5\xf3\xe4\xbe\x1a\x6e\x5e\x4c\xd0\x12\xa8\xdc\x2f\xac\xe9\x4c\x4f\xe8\xb1\x1d\xd3\xcc\xe8\x2a\xdf\xe5\xdd\xa9\x41\xd3\x17\xde\x35\x70\x3d\xdc\x0b\x9d\xe2\xc6\xa1\x2d\x50\x6e\xb5\xe7\xd1\x98\xe4\x7f\x97\x93\x13\xb2\x46\x73\x25\x64\xd3\x45\x80\xee\xb2\x11\xdb\x99\xfb\xbb\x73\x87\xc2\xe7\x87\xf1\x59\xa7\xee\xad\xf1\x71\xdc\xd8\x3b\x0e\x8d\x05\x9d\xc8\x18\x88\x5c\xab\x92\x61\xdb\x51\x18\x68\xe8\xdf\xd1\xb3\xdd\x5c\x54\xf0\x97\x7e\xbc\x11\x9e\x49\x51\x4d\x5a\x02\x0d\x3d\xf3\x15\x6c\xe5\xb5\x3c\x0e\x7b\x0c\x91\x8d\x5b\x11\x75\x5e\x04\xee\x80\x76\xba\xbb\xa0\x45\xed\x0f\xfc\xf5\x11\x28\x76\x5b\x73\xae\x0a\x50\x52\x58\x2d\xb0\x4b\x20\xc2\x8c\xe4\x84\x2f\x30\x57\x3f\xf5\xa3\xca\xc4\x43\x27\xf3\x1d\x9a\x7b\x9b\x04\xba\xa3\x05\xe2\xc7\x45\xa9\xfe\xbe\x36\x35\x0c\x95\x8e\xf7\xcf\x37\x8d\x46\xf0\x5c\xfd\xbe\xf2\x0c\x8b\x64\xa1\xe5\x52\xff\xce\xb1\xfe\xd9\xe5\x05\xba\xc1\xea\xe7\x96\xdd\x0f\x50\xba\x1d\x8a\x63\xf7\x6b\x16\xaf\x83\x92\x81\x8e\xa4\x7f\x78\x90\xbd\xb6\xec\x3a\xb7\x00\xbc\xc4\xa1\x4d\x54\x74\x24\x3d\x61\x6a\x07\xd9\x06\x14\x6a\xd5\x69\xa8\x65\x0f\xdb\x36\x27\xba\x14\x41\x17\x19\xb5\xc8\xa0\x5a\x18\x3b\xf8\xc4\x07\xe3\x60\x37\xca\x1c\x8a\x0a\x2e\x1a\x45\x42\x4f\xb7\xaa\xc6\x37\x01\x12\xe0\x1b\xcc\xd6\xf0\xc3\x58\x6d\x59\xed\xf8\x4b\x7e\x95\x39\x6d\x1c\x25\x86\xfb\x1c\x3e\x76\xfb\xed\xdc\xbb\xa7\xa5\x51\x52\xf6\x05\x88\xba\x37\x59\x55\xd1\xe3\x00\x7e\x18\xeb\x73\xe0\xee\x4c\x83\xff\xab\x8c\x03\xb9\xca\x94\x3f\xe4\x25\xef\x35\x4f\x0f\x2c\x4b\x5b\x7f\xab\xd1\x15\x78\xba\xb7\xdd\x21\x90\x9f\xdf\xaf\xe5\xf3\xbb\xb9\x57\x0f\x82\xe5\x68\xaa\x43\xed\xda\xbf\xf9\x7f\x01\x00\x00\xff\xff\x09\xd4\x28\x41\x2d\x87\x00\x00")
+var _cmdInternalPagesAssetsJsContainersJs = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xec\xbd\x6b\x77\x1b\xb9\x91\x30\xfc\xf9\xd5\xaf\x28\x7b\xb3\x69\x32\xa6\x9a\x94\x67\x92\xf7\x84\x32\xbd\xc7\x23\xdb\x13\x6d\x7c\x7b\x2c\x39\x39\x39\xb2\x1e\x1d\xa8\x1b\x24\xdb\x6e\x36\x3a\x0d\xb4\x28\xc5\xa3\xff\xfe\x9c\xc2\x1d\x7d\x21\x29\x8d\x66\xb2\xd9\x5d\x7f\xb0\x24\x34\x50\x28\x14\x0a\x85\x42\xa1\xaa\x30\x1e\xc3\x11\x2b\x6f\xaa\x6c\xb1\x14\xf0\x74\x72\xf0\x3d\xfc\xc8\xd8\x22\xa7\x70\x5c\x24\x31\xbc\xc8\x73\xf8\x88\x9f\x38\x7c\xa4\x9c\x56\x57\x34\x8d\xf7\xc6\xe3\xbd\xf1\x18\xde\x64\x09\x2d\x38\x4d\xa1\x2e\x52\x5a\x81\x58\x52\x78\x51\x92\x64\x49\xcd\x97\x11\xfc\x85\x56\x3c\x63\x05\x3c\x8d\x27\x30\xc0\x0a\x8f\xf5\xa7\xc7\xc3\x43\x04\x71\xc3\x6a\x58\x91\x1b\x28\x98\x80\x9a\x53\x10\xcb\x8c\xc3\x3c\xcb\x29\xd0\xeb\x84\x96\x02\xb2\x02\x12\xb6\x2a\xf3\x8c\x14\x09\x85\x75\x26\x96\xb2\x1f\x0d\x05\x31\x81\xbf\x69\x18\xec\x52\x90\xac\x00\x02\x09\x2b\x6f\x80\xcd\xfd\x8a\x40\x84\x46\x1a\xff\x2d\x85\x28\xa7\xe3\xf1\x7a\xbd\x8e\x89\x44\x38\x66\xd5\x62\x9c\xab\xaa\x7c\xfc\xe6\xf8\xe8\xd5\xbb\x93\x57\xfb\x4f\xe3\x89\x6e\xf4\xa9\xc8\x29\xe7\x50\xd1\xbf\xd7\x59\x45\x53\xb8\xbc\x01\x52\x96\x79\x96\x90\xcb\x9c\x42\x4e\xd6\xc0\x2a\x20\x8b\x8a\xd2\x14\x04\x43\xa4\xd7\x55\x26\xb2\x62\x31\x02\xce\xe6\x62\x4d\x2a\x8a\x60\xd2\x8c\x8b\x2a\xbb\xac\x45\x40\x33\x83\x62\xc6\x83\x0a\xac\x00\x52\xc0\xe3\x17\x27\x70\x7c\xf2\x18\x7e\x78\x71\x72\x7c\x32\x42\x20\x7f\x3d\x3e\xfd\xd3\xfb\x4f\xa7\xf0\xd7\x17\x1f\x3f\xbe\x78\x77\x7a\xfc\xea\x04\xde\x7f\x84\xa3\xf7\xef\x5e\x1e\x9f\x1e\xbf\x7f\x77\x02\xef\x5f\xc3\x8b\x77\x7f\x83\x3f\x1f\xbf\x7b\x39\x02\x9a\x89\x25\xad\x80\x5e\x97\x15\x8e\x80\x55\x90\x21\x35\xd5\x24\xc2\x09\xa5\x01\x0a\x73\xa6\x50\xe2\x25\x4d\xb2\x79\x96\x40\x4e\x8a\x45\x4d\x16\x14\x16\xec\x8a\x56\x45\x56\x2c\xa0\xa4\xd5\x2a\xe3\x38\xab\x1c\x48\x91\x22\x98\x3c\x5b\x65\x82\x08\x59\xd4\x1a\x57\xbc\xb7\xb7\x90\xfc\x14\x27\x4b\x52\x09\x1e\xe7\x8c\xa4\x83\x28\xa9\xab\x8a\x16\x22\x1a\xc1\xb7\x92\x24\x5f\xc9\x82\xf2\x29\x9c\x45\x09\xab\xa8\xac\x17\x8d\x20\x5a\x90\x7a\x41\xf1\x97\x94\xce\x49\x9d\xcb\xb2\x39\xab\x56\x44\xfe\x56\x67\xf8\xbf\xc0\x29\x88\xce\x6f\x87\x87\x7b\x7b\xf3\xba\x48\x10\x0b\x58\xd6\x2b\x52\x64\xff\xa0\x83\xa2\x5e\x8d\x80\x67\xff\xa0\x23\xa8\x8b\x4c\xf0\x21\x7c\xdb\x03\xb8\x22\x95\xfc\xf3\x70\x0f\xe4\x90\x07\xf8\x07\xcc\x54\x95\xb8\x64\xe5\x60\x78\xa8\xff\xc8\x69\xb1\x10\x4b\xf8\xed\x6f\xa1\xa8\x57\xf0\x7c\x26\x81\x1d\x42\xbb\x81\x82\x0c\xb2\xda\x58\x57\xdb\x03\xb8\xdd\x03\xa8\xa8\xa8\xab\x02\xce\x24\x32\xd8\xe4\xfc\x70\xef\x76\x0f\x09\xf7\x9a\xe5\x39\x5b\x23\x55\x91\x60\xc7\xaf\x8e\xa0\x20\x2b\xfc\x33\x61\xc5\x15\x2d\x70\x2c\xed\x41\x1d\xbf\x3a\xc2\x71\xb9\xa1\x54\x14\x71\x09\xc7\x7c\x30\x79\xfa\xfd\x08\xce\xa2\xd3\xec\x07\xa4\xd2\x8f\xea\xc7\x5b\xf5\xe3\xcf\xea\xc7\x0f\xd1\xf9\xf0\xd0\xe1\x57\x51\x71\x36\x39\x8f\x05\x7b\x9d\x5d\xd3\x74\xf0\x74\x08\x4f\x20\x82\x08\x9e\xc8\x2f\x07\x12\xe9\x16\xce\x6f\xa9\xa8\xb2\xa4\x03\xed\x36\xde\xaa\xea\x2e\xa8\x4f\x26\x12\x75\x85\xb9\x42\x5c\xe1\xad\xd0\xbe\x11\x94\xdf\x1d\x75\xc4\xfd\x65\x45\xd6\x40\x40\xf2\x4c\xec\x30\x4c\x2b\xb2\x3e\xc5\xb2\x81\x9c\x42\x4e\xab\x8c\xf2\xd3\x4c\xe4\x94\x8f\x40\xe0\xcf\xd3\x9b\x12\x7f\x4f\x89\x20\x23\xa0\x39\x5d\xd1\x42\x1c\xa7\x23\x9c\xed\x0f\xc8\xba\xb8\xce\x2b\x71\x5c\xa4\xf4\x7a\xa4\x60\xb0\x4a\xbc\xe0\x09\x2d\xd2\xac\x58\xb8\xf1\x22\x00\xd9\x13\xcc\xa0\xa0\x6b\xd0\x2b\xe3\x2a\xe3\x35\xc9\xb3\x7f\xc8\x35\x14\xbf\x34\x95\x06\x43\xcb\xa1\xd8\x38\x83\x19\x4c\x0e\x21\x83\x67\x01\x8a\x9a\x47\x0f\x21\x7b\xf2\xc4\x70\xa1\xed\x27\x26\x69\x7a\xc4\xf2\x7a\x55\x0c\xdc\x40\xce\xb2\xf3\x51\x00\xe2\x2c\x3b\x1f\x1a\x6e\x0d\x9a\x7e\x64\x6b\x3e\xc0\x12\xf9\x39\x9b\xc3\xe0\xd1\xc0\x0e\x5f\xca\xb9\xac\x48\xd9\x5a\x2f\x6d\xbb\x08\x82\xd2\x33\xdb\xe0\x1c\x66\xf2\x33\xfe\xeb\x1d\xbd\x1a\x79\xca\x92\x1a\x1b\xc5\x0b\x2a\x5e\xa9\xf6\x3f\xdc\x1c\xa7\xae\xf3\xa1\x46\x58\x13\x36\xe1\xfc\x28\x27\x9c\xbf\x23\x2b\xca\x61\xa6\xf1\x88\x96\x94\xa4\xb4\xfa\xc8\xd6\xd1\x14\xa2\x48\x4d\x8d\x12\x19\xba\x4c\xfe\xbe\x5f\xb1\xb5\xf9\xc8\xd2\xf4\xb4\xf3\x3b\xf6\x76\xa8\x7b\x63\xa5\x70\x9d\x90\x5c\xd0\xaa\x20\x28\xee\x3f\xb2\xf5\x89\xb8\xc9\xe9\x14\x44\x55\x53\x05\xb1\x24\x0b\x3a\x85\x88\x16\x52\x50\xb9\xb2\x93\xec\x1f\x74\xea\x18\x48\x83\xca\xd9\xfa\x4f\x62\x95\xfb\x00\x90\x95\xd4\x14\x4e\x37\x72\xd9\x14\x1e\x3d\x0a\x0a\x54\x9d\x80\x32\xd3\xf0\x4f\x33\xa6\xbe\xf9\x8a\x71\x65\x0c\x2c\x47\x8c\xe4\xc0\x87\x8d\xd5\x94\x67\x05\x05\xd9\xb4\xb1\xa4\xde\x64\x05\x3d\xc2\xf2\x41\xb8\xa2\x5a\xab\x08\x65\xa2\x5b\x23\xab\xac\x80\x19\x1c\x17\xf3\xac\xc8\xc4\x8d\x21\xf9\x8a\x5c\xc3\x0c\xf6\xfd\xe2\xae\x85\x81\xb0\xbb\x16\x84\x54\x72\x8a\x2b\x5a\x09\x29\xb6\xe6\x59\xc5\x05\x24\x92\xaa\x20\x18\x10\x78\x49\x04\x8d\x65\x55\xe4\x72\x04\x73\x96\x9d\xc3\xa3\x19\x14\x75\x9e\x1b\x28\x6a\x75\x9c\x65\xe7\x67\x93\x73\xbd\x82\xb1\xdd\xc0\x95\x4a\xae\xd4\x7c\x29\x7b\x7d\x9d\x15\x29\x0e\x69\x84\x23\x50\x1d\x58\xbc\xbf\xc0\x0c\x0e\x0e\xe1\x8b\xc6\xfb\x2c\x3b\xb7\xa8\x7f\x71\xa8\xab\xf1\x5f\x91\x1c\x66\xb6\xfb\x2f\xe7\x87\xfa\x1b\x62\x8b\xdf\x9e\x61\x27\xae\x09\x68\x32\x5e\x91\xdc\xd4\xbc\x6d\xb4\x78\x8e\x18\x05\x2d\xc8\x75\x57\x8b\x5b\xb3\xce\x50\xf9\xa0\x90\xb2\x22\x12\xb0\x26\x85\x40\xc2\xf1\x25\x5b\x03\x29\x6e\xb0\x59\x4d\x39\x48\x3d\x49\x2c\x49\x01\x13\xe0\x0c\x12\x52\x4a\x7a\x23\x32\xb2\x06\x10\x9c\x00\x22\x62\x05\xef\x85\x9a\x0e\x4e\x56\x14\x44\xb6\xa2\x23\x05\xf0\x60\xf2\xef\x46\x81\x5b\x54\xa4\x5c\xc2\x25\xcd\xd9\xba\x01\x29\x9b\xc3\x9a\x42\x42\x8a\xd8\x31\xce\x5f\x25\x23\xc3\x4c\x56\xdb\x87\x01\x0e\x69\x5f\x51\x66\x0c\x07\x13\x23\xc4\x5c\xcd\x67\x30\x31\x24\xf0\x9b\x4f\x0e\xbd\x41\xbf\x48\x53\xd9\x75\x4a\x25\xef\x21\x7b\xb3\x39\x50\x92\x2c\x0d\x07\x91\x42\xd5\x28\x68\x42\x39\x27\xd5\x8d\xe2\xc3\x9f\x21\xf4\xbb\x04\x78\x94\x12\x41\x91\x4a\x51\x43\x7a\x6b\xb6\x0b\xd6\xc3\xc1\xfd\x37\x8a\xa8\xa8\x57\x97\xb4\x8a\xee\xb1\x47\x28\x82\x1d\x55\x94\x08\x2a\xa9\x82\x72\x40\x92\x26\x1c\xed\xaf\xb5\x99\x38\x11\xb4\xe3\x86\x02\x40\xd2\xf4\xd5\x75\xc9\x2a\xf1\x43\x2d\x04\x2b\xb8\x57\xc3\x0c\xdf\xc7\x08\xe7\x2d\x40\x0a\xbe\xc9\x8d\x96\x4f\xa1\x2d\xf5\xa6\xf2\xff\x5b\x43\xa7\xd3\xf7\x2f\xdf\x0f\xae\x56\xa4\x5a\xb1\x7c\x38\x85\x37\x8c\x7d\x85\xac\x10\x0c\xa5\x69\xb1\x30\x2a\xd6\x55\x46\xd7\xba\x4b\x10\x0c\x16\x54\x00\x01\xbe\x62\x0c\x35\x7b\x05\x88\x14\xd9\xca\x12\xb6\xb5\x41\x25\x75\x75\x25\x37\xfe\x29\x44\x46\x40\xeb\x8d\x68\x49\xf1\x68\x37\x85\xef\x26\x13\x55\x90\xd3\x05\x2d\xd2\x29\x7c\x2b\x19\x97\xac\x3e\x85\xa8\x60\x05\x8d\x6e\x47\x5a\x76\x25\x35\x3f\x25\xd5\x82\x8a\x29\x44\x09\x11\x74\xc1\xaa\x1b\x0d\xed\xea\xc5\x75\xc6\xa7\x56\xa2\x48\x3a\x4c\xa5\x74\x1f\xe9\x22\x1c\x8b\x5a\x64\xd3\x50\x54\x4d\xdd\xf2\x1b\x85\xd2\xa7\x81\x97\xfe\xe8\xa1\x77\xc9\x84\x60\xab\xc8\xc9\xaa\x43\x45\x94\x63\x25\x40\xd6\x4b\x96\x53\x49\x77\x3d\x21\xb0\x24\xdc\x49\x1d\x29\x4b\x46\x20\xaa\x1b\x24\x6e\x42\x0b\x41\x2b\xc8\xe4\xc1\x13\xeb\xe8\x7d\xcd\x8a\x0d\x98\xcd\x7c\xb1\x89\x74\x8e\xe5\xb0\x63\x37\xb4\x58\x09\xd2\x83\xf8\x00\x7e\x87\x95\x0f\x37\x55\x45\x90\x30\x89\xff\xe8\xaa\x4a\xb1\x73\xbf\x1d\xd9\x48\xaa\x4b\xc5\xba\x78\xec\x63\x95\x30\x8c\xc4\xc9\xaa\xcc\x29\x47\xe1\x45\x5a\x1b\xf6\x06\xb6\xb7\x3b\xb3\x01\x3b\x83\xdf\x0c\xa2\x67\x69\x76\xf5\x3c\x1a\x4a\xb9\x91\x13\xce\x07\x91\x04\xb9\xaf\xfa\x8c\xe4\x62\x39\x8b\x8e\x4e\xfe\x82\x3a\xfb\x7f\x9e\xbc\x7f\x17\x9d\xc7\x73\x56\xbd\x22\xc9\x72\x60\x7a\x1d\xa8\xd3\x9c\xa1\xa6\x86\x1f\x93\xb2\xa4\x45\x3a\xc0\x4e\x54\xd1\xf3\x68\x68\x99\xa5\xf1\x2f\x26\x42\x54\x83\x48\xdc\x94\xf2\xa4\xa8\xea\x6f\xaa\x6e\xd1\xbd\x14\x05\x5c\x8a\x62\x5f\x1f\x2e\xe5\xef\xd7\x7c\x43\x53\x41\xaf\x85\xc1\xb8\xb7\x52\x92\x67\xc9\xd7\x81\x22\x82\x94\x3c\xf1\x65\x56\xa4\x03\xd4\x22\x02\x9d\x47\xc3\xd1\x6a\xac\xfc\xff\x37\x83\xe8\xdf\xf0\xcc\xe2\x28\x1f\x5f\xd2\x39\xab\xe8\x40\x13\xc6\xce\xf3\xff\xa9\x99\xa0\x40\xe0\xe8\xe4\x2f\x30\xcf\x68\x9e\x22\x7b\x66\x02\x0a\x4a\x53\x0e\x82\x79\xf3\x9a\xf0\xab\xd7\x58\x63\x20\x19\xdd\xcd\xa5\x6a\x36\x83\x13\x51\x65\xc5\x42\x7f\x35\xfb\xe3\xf8\xec\xf1\xe8\x73\x71\x3e\x8e\x05\xe5\x62\x20\xab\x5a\x71\xac\x8f\x5c\xd1\x63\x44\x55\x7e\x8a\x2b\x5a\xe6\x24\xa1\x83\xf1\xe3\xf1\x62\x04\xd1\xe3\xc7\x91\x3c\x80\x3d\x8e\x1a\x27\x60\x59\xdb\x6a\x8f\x6c\x5d\xa0\x29\xa0\x87\x35\x47\x40\xb8\xdc\x3d\x0a\xc8\x09\x17\x23\xb3\x20\xb5\x69\x82\xa6\x9a\x82\xde\x50\x3d\xa2\x0f\xda\x94\xb6\x23\x97\xe0\x61\xb6\x49\x8a\xdb\xc3\x8e\xfc\x18\x8e\x3c\x3c\x76\xb0\x42\xd0\x42\x8c\x00\xf9\xcf\xb4\x52\x1d\xa2\xa4\x90\xec\x6f\x9a\x63\x7d\xd4\x95\x71\xfd\x9c\xa9\xe5\xa7\x76\x8a\x78\x45\xca\x81\x99\xa7\x61\xfc\x85\x65\xc5\x20\x1a\x45\x43\xad\xe3\xa9\xaa\x52\xa1\x68\xad\x9f\x8a\xad\x9d\x06\x27\x81\xc7\x65\xcd\x97\x58\x2e\xa1\xda\x8a\x5a\xcc\x65\xae\x76\xf3\x9f\x56\x0b\x6b\x0a\xb3\x99\xd2\x7a\xe1\xa7\x9f\xc0\x95\xa0\x61\x67\x9e\x15\x34\xed\x07\xe1\x98\x23\x3a\xec\xa9\x72\xbb\xb7\xb1\x61\x86\x54\x9b\xc0\x7f\xa8\x7e\x63\xc1\x8e\x4f\xde\x6b\x0e\x1d\xc2\xb4\xc9\xcc\xdd\x9d\xdc\xf6\x2c\x4e\x47\x58\xa3\xa4\xeb\x9f\x7a\x12\x61\xa6\x29\xa8\xea\x7d\x2e\x14\x17\x7f\x2e\xf4\x60\x70\x8a\x61\x06\x11\x8a\x81\x71\xc2\xaf\x14\x77\x03\xcd\x39\xf5\x66\xd8\x70\xf2\xcc\x9f\xb7\x60\x2a\x82\x39\x73\x4d\x70\xbb\xbe\x35\x23\xc2\xf9\x6b\x4d\x76\xd7\x1c\xde\x73\xd6\x1c\x2b\x37\xa7\x45\x21\x13\xf0\xe7\x59\x76\x8e\x7a\xcd\x96\xb9\x91\xa5\xf6\xe4\x60\xe7\x46\x4f\xac\x02\xdb\x47\x77\xdc\x1e\x62\x2e\x61\x65\xf3\x9b\x81\xa6\xe1\x08\x94\xd4\x7c\x3a\x0c\x27\x40\x9b\x5e\x91\x26\xe3\x2f\x9c\x15\x51\xb0\x24\x0b\xdc\xd5\x8d\x6a\x68\xd6\x38\x49\xaf\x32\xce\xaa\x18\xbb\x24\x59\x41\x2b\x3c\xfe\x3a\xb9\xf5\x7f\x3f\x8f\x9f\x8c\x47\x10\x45\x43\x57\xf6\x79\x2c\x85\xd9\x85\xda\xc9\xf4\xe2\xfd\x8a\x67\x30\xa3\x49\x26\x52\xcd\xd5\xca\xe4\x20\x22\xaa\x26\xd6\x8a\x97\x15\x9d\xc3\x0c\x3e\x7d\x7c\xa3\x6b\xbd\xbf\xfc\x42\x13\xf1\xe9\xe3\x9b\x01\xea\xaa\x3f\xe4\xec\x72\x70\xa6\xc7\x7f\x3e\x82\x6f\x42\x6a\x67\xf8\xff\xed\xd0\x41\x49\x8d\x88\x34\xc3\x19\xc8\xc1\xfd\xf4\x13\x44\x15\x63\x42\xf1\xe7\x7e\xb0\x65\x60\x49\x8c\x25\x5a\x3a\x0a\xf6\x86\xad\x69\x75\x44\xb8\x39\x58\x18\xec\x2f\x59\x7a\xa3\x77\xda\xa3\x65\x96\xa7\x03\xec\xd2\xf5\xad\xf6\xb1\x8e\x26\x15\x5d\xb1\x2b\xda\x68\x82\x03\xad\xe8\x15\xfb\xea\x0d\xd4\x12\xc2\x6e\x5b\x3f\x52\xa1\x34\x2f\x6d\x55\xd5\x47\xbc\xac\x10\xb4\xc2\x13\x69\x56\x40\x41\x0a\xc6\x69\xc2\x8a\x94\x7b\x92\x7d\x41\xc5\xb1\xae\x34\xd0\x86\xe3\x11\x94\x15\xbd\xca\x58\xed\xd9\x74\x93\xba\xf2\x4f\xe5\xba\xa6\x9d\x3f\x6c\xe0\x7f\xb7\x00\x8c\x3e\xbe\xe2\xb0\xff\x1c\x0a\x1e\xbb\x2d\x0b\x81\xe0\x91\xe1\x34\x5b\xd1\xc1\x10\xf6\x25\x10\x57\x30\x84\xdf\x49\x7b\xe5\x64\x32\x31\x83\x3c\x5a\xd2\xe4\x2b\x87\x4c\x8d\xcd\x6d\x57\x5c\x10\xc1\x21\x2b\x92\xbc\x4e\x69\xe3\x5b\x45\x39\xab\xab\xc4\xb7\x49\x2e\x09\xff\xa8\x4b\x07\xb2\xe9\xc8\xd6\x52\x03\x36\x0b\x0b\xbf\xc5\xea\x7f\x4d\xd6\xe7\x30\x41\x83\xb5\xf7\xe5\x6c\x72\x7e\x66\x5a\x9f\xb7\x11\x25\x79\x0e\x76\x65\x20\x8e\x50\x56\xec\x2a\x4b\x69\x0a\x79\xc6\xc5\xbd\x90\x7e\xcd\xaa\x17\x79\x3e\xb0\x60\x8f\x8b\x39\x6b\x8d\x01\xa5\x57\x58\xc3\x8c\x01\x65\xd7\xa4\xa1\x72\xcc\x49\xce\xad\x51\xbd\xcb\xf8\xd3\x09\x2a\x38\xee\xca\x4d\xdd\x27\x6d\xd8\x44\x1a\x46\x2d\x8a\x4e\x64\x36\x11\x30\x46\x11\xfb\x45\x54\x35\x6d\xd3\x15\xe9\x85\xa6\x3e\x77\xe2\xb0\xc4\xd3\x0b\x76\x24\x8b\x05\x5d\x95\x39\x11\xb8\x2e\xc8\x15\xe5\xc0\x6a\x69\x16\x41\x60\xea\x00\x80\x2b\x45\xf1\x0f\x56\xb7\x38\x43\xca\x28\x97\x77\x67\x4b\x72\xd5\x98\x07\x23\x96\x1a\x6a\xbc\xc6\x77\xfb\x69\x18\x1e\xe9\xfd\xa4\x61\xed\xe3\x54\x20\x36\xf2\x6a\x86\xc7\xb8\x90\x08\x64\x5c\x5e\xd2\x55\x19\xa7\x29\x7e\x24\x05\x90\xaa\x22\xf2\x12\x4e\xfe\xc2\xf5\xcd\xdd\x9a\x21\x24\xdd\x09\x9f\xe2\x1f\x04\x94\xdc\x87\x9c\x5c\xd2\x5c\xda\x0c\x08\x5a\x45\x69\x95\x25\x7a\x1f\x33\xb7\x52\xb2\xcf\x86\x8d\xf1\x47\x89\x87\xaf\xee\x29\xcc\xd4\x68\x35\x96\x75\xc1\x97\xd9\x5c\x0c\xce\xa2\x37\xd8\x09\x9e\x13\xfe\x82\x90\xa3\x73\xbb\xf4\x3d\x93\x45\xc9\xca\x5a\xce\x06\xf6\x29\xcf\x8d\xfa\xbe\xc0\x59\x73\x60\xd6\x6d\x6e\x90\x83\x3d\x65\xce\x96\xa3\x91\xb9\x93\x61\x44\x9f\xdf\x33\x75\xd2\xfa\x16\x9c\xd3\x0f\xcc\x39\xbd\xa2\xe9\xeb\x8a\xad\xa6\xf0\x47\x57\x70\xca\xbc\x0a\x37\x14\x6d\xc9\xaa\xce\xff\xff\x7b\xbf\xec\x94\xb9\x56\xab\xac\x60\xd5\x69\x96\x7c\xe5\x53\xd0\x95\xac\x2d\x61\x0a\xdf\xd2\xba\xd2\xbf\xfe\x11\xef\x64\x28\xe1\xd2\xce\x1c\xa1\x9e\x44\xaa\xe8\xd6\xb7\x89\x6b\xb5\x7a\x6f\x8b\x45\x46\x4e\xd8\xae\xd6\x18\xad\x42\x99\x23\xef\xc8\xd0\xc5\xdf\x51\x94\x55\x90\x24\xcb\xac\xa0\x90\x15\x73\x16\xee\x1b\x6f\xd5\x17\x5c\xde\x03\xdc\x34\x5f\x66\xd5\x08\x12\x92\xe7\x97\x24\xf9\xaa\xb8\xe4\x37\x88\x05\xaa\x20\xa6\x02\x6e\xa2\xa4\xcc\xc6\x57\x07\xf1\x64\xac\x41\x47\x23\xb0\x8a\x98\x34\x76\xc1\x37\x0b\x46\x15\x1c\xc2\x6d\x80\x57\xc9\x3b\xd0\xf9\x50\xb1\x84\x72\xde\x40\xc7\xd7\x4a\x76\xc7\xee\x69\x3c\x19\x97\x1c\x37\xfb\x00\x80\x51\x7f\xe3\x94\x15\x74\xb0\x03\xd2\xa6\xfe\x9c\x64\xb9\xab\xff\xe5\xef\xcb\xeb\x6a\x04\xa8\xed\x9e\x08\x22\x6a\x3e\x02\x5a\x55\xac\x0a\x60\x9c\x9d\x07\xc3\x7e\x27\x0d\x87\x52\x5a\xb9\x03\x9d\x45\x0e\x96\x19\x17\xac\xba\x51\x8b\x19\xed\xba\x5c\x9a\x7b\xe3\x3d\xa9\xb7\xd5\xab\x13\x29\xe2\x66\xf0\x87\xc9\x61\x30\xbb\x0e\x82\x12\x82\x8d\x6b\x6a\x9a\xba\x1a\x21\xb5\x25\xc0\x1d\xe9\x3c\x1e\xc3\x47\xfa\xf7\x9a\x72\x01\x7f\x98\xf4\x20\x8e\x0b\xb7\x60\x66\x70\xb1\xbd\xc4\x54\xcd\x5a\x7a\xac\xbd\x7a\xf8\x54\xa6\x44\x20\x97\x66\x85\xda\x90\x75\x4f\x34\xfd\xe1\xe6\xd3\x31\xac\x97\x59\x4e\xa1\xc6\x4a\x28\x09\x1f\x17\xf5\xea\x42\x56\x7b\x0c\x4b\x5a\xe9\x6b\x89\xc8\x96\x46\x53\x4b\xac\x91\xf7\x49\x21\x15\x4d\x61\xa2\x2d\x0a\x92\x79\xd6\x4b\x5a\x0c\xf4\x0c\xc3\x6f\xe2\x92\x71\xd1\xc9\xe6\x76\xb0\x6d\x86\x1a\x99\x11\x0e\x47\x5b\x01\x1d\x8c\x79\x7d\xb9\x13\xac\x1e\x36\x75\x6d\x3f\x52\x5e\x8e\x20\x00\x87\x45\xfe\x41\xc6\xf2\x61\x58\xe5\x6c\x72\xde\xd1\xd0\xdd\xce\x80\xc7\xb2\x2f\x8d\x1c\x96\x0c\x29\x59\xeb\xe8\xc3\x27\xa8\x39\x69\xed\x35\x47\x65\x7d\xca\x04\xc9\x3f\xe1\x37\x7f\xcb\x59\x39\x19\x33\x52\x2c\xea\xd4\x1b\xad\x85\x95\x34\x89\x97\x84\x5f\x24\x65\x8d\xba\xd9\xa3\x0e\xf5\x2e\x4a\xca\x3a\x1a\x6e\x30\x36\xa8\xd3\x18\x5a\x0f\xa2\x53\x75\x0b\x10\x49\x7c\xa2\xf3\xc3\x70\x6f\x3a\x3b\xef\xbd\x0e\x68\x69\x8b\x81\x7a\xe4\x94\x68\x5f\x79\xcc\xce\x0f\xed\x57\xad\x43\x07\x9f\x61\x1f\x0e\xbc\x2a\x46\x9d\x7f\x87\xa8\x36\x34\xf7\x18\xaf\x2f\xb8\x20\xab\x52\xe9\xef\xee\x6f\xc5\xaf\x0a\x82\x51\x10\xec\x50\xc0\x16\x29\xf3\x46\x00\x69\xd8\x55\x43\x56\x49\xca\x3a\x56\x13\x29\x90\x4e\x46\x7b\x6f\x14\xe3\xd5\x90\xc3\x59\x43\x93\xc7\x76\x09\xc9\xc0\x75\x97\x1f\xc1\xd5\xa6\xe8\xbb\xd4\x8c\x8e\x58\x45\x79\xb4\x8d\xd1\xf0\x7c\xd7\xe6\xb3\x37\xe8\x23\xb3\x03\x87\xf5\xb0\xc5\x8b\x2b\x5a\x91\x05\xfd\x35\x18\xe3\x21\x27\xcd\xcc\x19\xd2\xe4\x82\xa8\x31\xc8\x7b\xbb\xc9\xe4\xe1\xa6\xe5\x63\x5d\xc8\xab\x78\x10\xcb\x8a\x92\x74\xf3\x0c\x95\xb4\xda\x4f\x58\x45\x37\xc9\x84\x0f\xb4\xc2\xa9\xfe\x67\x48\x05\x6d\xf2\x27\x8a\x07\x24\xc6\xfa\x5a\xb2\xb2\xfa\x6a\x93\x3d\xce\xfb\xae\xce\x3d\x7c\x63\xdc\x50\x10\x08\x0f\xb8\x40\x81\x52\xf4\x97\xec\x2d\x5d\x6c\x32\x3b\x05\xff\x4d\x44\x90\x3c\x95\x06\xe2\xa3\xa4\x15\xce\xd1\x85\xfc\x0b\xfa\xcc\x6a\xbe\x51\xed\xf6\x67\x2e\x8c\xc0\x47\x60\xa2\x7c\x04\x7a\x26\x28\x70\x15\x08\x21\xef\x39\x8b\xdf\xa6\x11\x9d\x7d\x39\x6f\xcb\xc6\x66\x8d\x21\x8c\x3d\x70\x2d\x81\x79\xfb\xeb\x8a\x4d\x35\x13\x97\x15\x25\x5f\xd1\x4a\xd6\x5e\x95\x72\x39\xfe\x60\xbe\xf7\xae\xcb\xe0\xfc\xdf\x63\x94\xd8\xbc\x4e\x83\xaa\xf7\xdb\xc5\x3f\x71\x79\xdb\x1e\xfd\x99\x56\x05\xbd\xcb\x76\xde\x40\x73\xfb\x9a\xea\x68\xd0\xb5\xb6\x3a\xab\xfd\x0b\x6c\xf3\x35\xa7\x55\x9b\x93\xb1\xb4\x73\x93\xef\x59\x2c\x0d\xa0\xfc\x86\x0b\xba\x6a\x83\x55\xe5\xff\x3c\xed\x01\xff\xe2\x4b\x52\x51\x60\x73\x38\x7a\x7d\x82\x9b\x55\xc6\x52\x69\xbf\x5b\x2f\xb3\x44\x79\x4a\xe3\x62\x59\x4b\xf3\x53\xc5\x84\xc8\x69\x87\xb2\x71\xaa\x3e\xa1\x21\xff\xbf\xf4\x32\x39\x35\x43\xf8\x17\x59\x21\x66\x3e\x66\x60\x18\x2a\x99\xf3\xd8\x94\x7a\xfc\xe4\x15\xff\xdc\xe5\x81\xb3\x62\x7a\x78\xee\x2c\xa8\xbb\x6c\x0c\x88\x85\xe5\x92\x8b\x1e\x34\x5b\x15\x86\xf0\x3b\x0f\xd8\xc1\x64\x02\x63\x33\x70\xb3\x33\xf8\x57\x64\x4d\x44\x26\x0f\xbd\x7d\x7c\xa0\x55\x42\x0b\x69\xa3\xd4\x68\x6c\x5d\x44\xd2\x35\xbe\xae\x28\x70\x41\xf2\x5c\x1a\x6d\x2a\x65\x02\x33\x37\x14\xd6\xd0\x80\x50\x3a\xec\xde\x88\xdc\x07\x0d\xc5\x5f\x43\x0d\xa6\x6f\x1b\xbf\x37\x2d\x91\x96\x1d\x7a\xb7\x55\x72\xc2\xd4\xcf\xd7\x75\xfe\x4f\xd8\x4a\xdc\x35\x43\x5c\xf2\xec\x0e\xcb\xa6\xaf\xa1\xbf\xcd\x58\x46\x0b\xb6\x9b\x4e\x3c\xbc\x1d\xa8\xf3\x36\xb8\x1f\x91\xfb\xee\x55\x5b\xd0\xe8\xdf\xbe\x38\x5b\x35\x0e\xa8\xae\x44\xdf\x2f\x6d\xdf\xbc\x24\xa4\x79\x9d\xe7\x21\x24\x57\xb2\x01\xd2\xc3\xae\x3a\x1c\xb1\x5a\x49\x34\x75\x4b\xef\xa3\xe4\x5d\x6d\x24\x56\x70\x94\x19\x9c\x08\x02\xf3\x8a\xad\x82\x4b\x03\xdf\x76\xa3\x2f\x8e\x6a\xae\xdd\x98\x10\x5a\x49\x38\xa7\xaa\xf1\xeb\x02\x04\xb3\xd7\x30\xf2\x36\x31\xcd\xae\xb2\xb4\x26\xb9\x02\x5e\xb2\x0c\xa9\x14\xda\x05\x3d\xf8\x47\xc6\xff\x63\xd0\xd1\xab\xea\xa1\xff\xac\xbd\xc3\xfa\x32\x1e\xfb\x4d\xe0\x5d\xab\xcb\x3f\x60\xb5\x1a\x20\x3b\x15\x68\xdf\x3d\xec\xf5\x22\xee\x6c\x13\xae\xe5\x96\x63\xb1\x3e\x6c\xf5\xb6\xf4\x7c\x8d\xfd\xd3\xd7\x86\xfa\x7a\x1b\xd4\x8d\xa4\x1d\xb7\xa0\x95\xbc\xf8\x00\x5e\x92\x8a\x53\x3d\xd3\xea\x52\xc8\xac\x10\x20\x02\x27\x8f\x5e\xc3\x3f\x68\xc5\x1c\x77\xc8\x09\x04\x22\x1c\x3c\x55\x2b\x7b\x72\x30\xc2\xb9\xbf\xa4\x50\x23\x37\x10\xae\xdc\xb8\xb5\xaf\x2d\xba\x50\x78\x78\xfb\x0b\x38\xd8\x38\xed\xe8\xda\x33\xd4\xf2\xc0\xf0\x4f\x7b\xe1\xfa\x93\x5e\xe2\x6d\xef\x07\x53\xe9\x2c\x7b\x72\x70\xae\xfd\xb7\x5f\x17\xb8\x58\x95\x62\x6c\x2b\xf6\xac\xc1\xd6\x45\xa3\xcf\x27\x53\xfd\x73\x64\x57\xb1\xf2\x2f\x1d\xc9\x26\x1b\x6d\x1a\xfe\x58\xb7\xd8\x36\xfc\xb5\xd2\xb2\x71\xb4\x68\xd6\xbd\xb5\xe9\xcb\xe0\x8e\x05\xb6\x55\x0f\xb4\xee\x59\xea\x94\xb1\xeb\xca\xd5\x64\x75\x66\x65\x4b\x71\x6f\x23\xbb\xf7\x11\xc6\xe1\x0a\xf7\x36\x34\x3a\xbf\xbf\x50\xc8\xda\x01\xc7\x46\xdc\xba\x92\xfb\x1c\x13\x5a\xd3\xbd\xa2\x2b\xbc\xcb\xe8\x9a\xf1\xb7\xf2\xd3\x2f\x3f\xe9\x0a\x85\x7f\xca\xbc\xeb\x69\xc3\x59\x53\x58\xa8\x19\x82\x31\xb0\x82\xbe\xa5\x0b\x72\x79\x23\xe8\xc3\xcc\x8d\x81\x66\xe6\x27\x9c\x20\x79\x33\x2c\x67\x88\x5d\xd1\x8a\xe4\xb9\x55\xf8\x3a\xa7\xe6\xbd\xaa\xb4\xd9\xca\xd8\x71\x4c\xdb\xac\xb0\xf5\x6b\x7d\xf6\x2c\x83\x00\x34\xb2\x6a\x7f\x33\x40\xb5\x8d\xc5\x44\x4b\x6c\x3f\x0f\x6e\xe8\xec\xf9\x0c\x9e\xfa\x2b\x73\x83\xba\xb8\x11\xe5\xa7\xde\xf1\xab\x22\x6b\x83\xe0\xee\x6b\xf4\xa1\xec\x1b\x7e\xbc\x11\x83\x55\x96\xe7\x99\x34\xd7\xa9\x50\x11\xf2\x55\x79\x17\x94\x4a\x6d\x22\x0b\x2a\x1b\x39\x92\xda\x5d\xe6\x2d\x11\xcb\xb8\x62\x75\x91\x0e\x06\x03\x3b\xa2\x40\x89\x83\x71\xb7\x65\x50\x6b\x7c\xde\xc1\xd0\xc2\x7f\x2e\x3f\xd8\xcd\xcc\xf5\x8b\xe5\xfe\x81\x4c\x4d\xbc\xda\x98\xce\xa2\xa3\x0f\x9f\xa2\x91\xad\x7d\x1e\x46\xe0\xa9\xd5\xb4\x2b\x4b\xa8\xda\x5e\x74\xd6\x09\x11\xb5\xd4\x11\x04\x0b\x6e\xf4\x31\x90\x36\xf6\xfc\x67\x57\x32\xf0\xb6\x03\xaa\x5e\xcd\xb2\x86\x1b\xb2\x6a\xf0\x3c\xa0\x90\xaa\x79\x91\x90\x92\x24\x99\xb8\xf1\x1d\x68\x15\xf4\x0d\x95\x03\xeb\x6e\x38\x64\x7f\xaa\x3a\xc4\x8b\x04\x1e\xce\x49\x48\x5d\x25\x7c\xa3\x91\x0f\xb6\x41\xe3\xa2\x5e\xfd\x68\x96\xa2\x6e\xac\xf5\xba\x3d\x67\xb6\xc6\xf0\x79\x63\x9b\xfa\x16\xaa\x8a\xbe\xaf\x54\x50\xb3\x4b\x19\x0d\x14\xdb\xb0\xba\xb5\x88\xa8\x3a\xc2\xde\x8a\x1a\x32\xcc\x73\xc6\xaa\x81\x74\x31\xd0\x04\x90\xe3\x8e\x27\xc8\xad\xb2\xd4\x52\xff\x30\x50\xd2\x38\xcc\x5a\x4e\x9b\x73\x2e\x61\xc7\x56\x99\x92\x00\x52\x7a\x95\x49\x6f\x36\xa7\x17\xea\x6b\x76\x4f\xbc\x6a\xa7\x71\x95\xdb\x80\x55\x29\xad\x8c\x4e\xa8\x2a\x9c\x39\x8a\xa2\x13\x25\x8f\xa5\x6a\x79\x2e\x15\xfc\xd7\x27\x20\xdd\xf1\x07\xb6\x1c\x9e\xc0\xc1\x70\xe4\x0d\xf7\xbc\x19\xed\xf7\x46\x72\x10\x76\x59\x58\x57\x08\x47\x36\x83\x55\x9a\xf1\x32\x27\x37\x2a\x59\xc0\xef\x63\xd3\x38\x7a\xed\x6a\xa6\x54\x90\x2c\xe7\x11\x70\xaa\xf6\x00\x2e\xb2\x3c\x97\xee\x12\x3c\xb0\x50\xe0\xdc\xe2\xe6\xe1\x7a\xe1\x6e\xb9\xac\xc8\xf5\x85\x95\xdd\xfe\x50\x7f\xef\x56\x48\xc0\x47\xf0\xdc\x6b\xe3\x18\x61\xd1\x60\x3a\x9e\x67\x09\x1d\x4c\x46\x7e\xe5\xc3\x30\x58\x70\xa3\x73\x96\xdc\x0e\x11\x41\x6f\xcf\x95\xc2\xe7\xe9\xf7\x92\x51\x9e\x7e\x7f\x68\x3e\xff\x98\x35\x3f\x07\xfb\x74\x97\xfe\x72\xe7\x3d\x72\xab\x9c\xda\x6a\xcd\xdc\x41\xa1\xe9\xbd\xbd\x1f\x41\xf4\x27\x26\xee\x70\x94\x7c\x30\x9b\xe6\x43\xdf\xdd\xf6\x2b\x54\xdb\x9a\xac\x59\xf5\x35\x2b\x16\x17\x9c\x8a\xce\x86\xbd\x26\x8a\x3d\x7d\xc0\xd4\x6e\x60\x6a\xb6\xa4\xa8\x1d\x01\xdf\xb2\xa5\xb8\x5d\xeb\x62\x47\xc9\xdf\xc3\x28\xfe\xd6\x03\xbf\xfd\xad\x71\xd6\xde\x56\xf3\x59\xd0\xbb\xe5\x9d\x06\x4a\x3b\x6c\x75\x86\x0c\x9f\x8c\x07\x91\xb2\x6a\xb2\x45\x45\x39\x87\x4b\x52\xc5\x0f\xa5\x08\x2e\x99\x50\x6b\xac\x21\xe8\x7b\xa6\xd2\x13\xfa\xc1\x50\x0d\x38\x29\x49\xb7\x01\x6c\xed\x1f\x9d\xa0\x12\x96\xa7\x16\x92\x0f\x77\xdf\x21\x6d\x82\xac\x0c\x69\xf6\x97\x4c\xec\x9b\xa5\x1b\xaf\xb3\x54\x2c\x07\x6e\x84\x4f\x20\xfa\xf7\x68\xd8\x6a\x83\x1d\x35\x1b\x79\x9d\x87\xad\x54\xbd\x7d\x74\xa2\xb3\x81\x65\x2a\x8e\xcc\xb3\x4a\xfa\x89\x3d\x9a\xe3\x56\x99\x2c\xc6\xf2\xa2\xdd\xaf\x17\xd0\x00\x9e\x78\xd0\x22\x18\x60\x65\x9f\x04\x88\xd3\x30\x52\xaa\xe9\xae\x16\xbd\xe6\xe1\xa5\xfb\x70\xb9\x5e\x92\x60\xe5\x65\x5c\xd9\x62\xe6\xac\xea\x3c\x5a\x1e\xb1\x95\x89\xdc\xfc\x57\x10\xd0\x2f\x0a\x56\xdc\xac\x58\xcd\xf1\x0f\xcc\xd3\x00\x47\x24\x59\x52\xef\xae\x16\x0d\xee\x6b\x52\xfe\x37\x92\xde\x15\xe7\x77\x93\xdd\x09\x92\xe4\x6e\x4d\xbe\x4a\xe2\xdd\xad\x0d\x5f\x93\xf2\x6e\x7b\xc3\x43\x33\xbb\x74\xe5\x97\xb1\xa2\xbc\xdb\x6e\x42\x16\xf4\xb5\xfc\xfc\xaf\xc0\xdb\x0a\x53\xfc\xed\x2d\xf9\xc2\x2a\xd0\x7f\xff\xea\x37\x46\x96\x8d\xcc\xd7\x0b\xec\xf9\x0e\x37\x47\xdb\x00\x98\xa3\xf2\x71\x71\x42\x93\x5f\xff\x12\xc9\x73\x9b\xd1\x91\x42\x32\x58\xe8\xd7\xb8\x59\x2a\x17\x92\x5b\x8d\xa9\x43\xff\xe9\x9b\x21\x25\x4d\x36\x01\x58\x91\x2f\x0d\x18\xa6\xa4\x0f\xcc\x03\x2c\x47\xc5\x8a\x50\xd2\x0a\x54\x2c\x58\xd4\x72\xf8\x97\x81\x70\x3a\xd4\x24\x49\x68\x4e\x2b\x22\x58\x35\x02\x26\x8f\x5c\x99\xe0\xf0\xf6\xf8\x47\xc8\x0a\x2e\x48\x11\x5c\xd5\x2e\xa8\x78\xe1\x1a\xa0\x4b\xf2\xc0\x03\xe0\x6c\x66\xb2\x83\x99\x0f\x3c\x5e\xa1\xd9\xc6\x24\x96\x0a\x3e\xb0\x94\xe6\x9d\x5f\xb2\xd4\xa8\x8d\x7e\xe9\xa2\xac\x2f\x0c\x6a\x66\x99\xc8\xfe\x9e\xcc\x3a\x60\xf8\xb5\xdd\xb1\xad\xaf\xc6\x45\x59\x31\x3c\x0b\xba\xc3\x9b\x83\x3c\xd8\x04\xda\x34\xc4\x71\x0c\xa3\xce\xcb\x07\x84\xb4\x41\x3e\x12\x1d\xaf\x63\x92\xa3\x78\x3d\x99\x8b\xf4\x9c\x08\xca\x85\xf6\xdd\x0b\x65\xa8\x37\x2b\x32\x38\x87\x6f\xb8\x48\x97\x29\x25\x54\x6f\xe6\x76\x6e\xdb\x6d\x7a\xe4\x61\xc3\x37\xca\xca\x9c\x70\xf1\xf3\xb4\xe4\x42\x27\xa1\x1a\x20\xa8\xd8\xef\x18\x63\x37\xcf\xce\x87\x32\x20\xb8\xcd\x89\x4e\x2f\x6e\x5e\x33\xa2\x70\x4b\x88\x90\xf1\x9f\x7c\xf8\xeb\xeb\x1a\x5e\x16\xa2\x5a\xe5\xd7\xd2\xa1\xca\x52\x4c\x74\x8d\xb0\x75\x77\xd6\x5a\x65\x0a\x26\xc2\x3b\xdb\xb2\x28\xcf\x61\x66\x26\x3b\x28\x77\xe1\xc4\x7b\xbb\x5e\xf3\x49\x02\xb6\x91\xc3\xe2\x3e\x5f\x19\xfc\x06\x26\xeb\x10\x37\xf1\xcf\xfc\x0c\xcb\xcf\x61\x0a\xde\xd5\xdf\xed\x83\xc9\x40\x99\x12\xab\x57\xe8\xa1\x68\x9c\x03\xbd\xa2\x85\xd0\x49\x4d\x54\x20\x61\x5d\x48\x67\x65\xf9\x81\xc3\x25\xc5\xb3\xe0\x8a\x12\x5e\x57\x34\xc5\x36\x08\xec\xc3\xdb\x4f\xd2\x08\xcd\x59\xf2\x95\x8a\x46\x74\x12\xad\xe6\xaf\xb0\xb1\x9c\x02\x09\xc6\xad\x2e\xf9\x67\x5c\xae\x6a\x78\xd4\xe5\x01\xab\x65\x84\xaa\xa5\x64\x8e\x11\x39\xae\xe5\x13\x88\x46\xba\x67\x70\x5f\x74\x81\x15\x3c\x9e\xc8\x71\xe0\x0c\x31\x4e\xea\x95\x1c\xab\x9e\x0e\x2d\x58\x1c\x41\xb8\xcb\x12\x2a\x13\xbc\x14\x32\xea\xc4\x52\x01\xa5\x4d\x36\x9f\xd3\x8a\x16\x42\x46\x6b\x7e\xf8\xe4\x4b\x22\x5e\xaf\x2c\x11\xb8\x1a\xb2\xe7\x6a\xcf\xeb\x95\x63\x7e\xfd\xb5\x97\xe1\x3d\xf2\x05\x5b\x4a\x0f\x9d\x15\xe7\x60\x0f\x9a\xb3\x66\x30\xf0\xfe\xfa\xe9\x27\xf4\xe6\x32\x14\xb3\xc1\xf6\xb7\x7e\x1a\x44\xac\xbe\xc5\xd7\x49\xda\x43\x3a\x68\xd6\x74\x74\xd2\xf9\x41\x54\x31\xc2\xeb\x12\xd9\x3e\xa5\x7a\x65\xb5\x04\xe4\x91\x50\xc7\x78\x75\x48\x9a\xc3\xc0\x39\x5e\xcb\xd5\x67\xf0\x14\xc7\xfe\x48\xc9\xa2\x96\xc8\x3d\x93\xe0\xcf\x37\x88\x73\x23\x8c\x55\x60\x7a\xfc\x95\xde\xf0\x41\x38\xcb\x5b\x40\x4b\x0a\x2b\xb9\xc1\x59\x25\x06\x0f\x27\xa5\x03\x8f\xf8\xcd\xbe\xf0\x1d\x08\x67\x16\xc3\xb6\x6f\x7c\x57\xf5\x60\x50\x77\x53\x8f\x3b\x14\x62\xe8\x55\x77\xb7\xab\xb9\x6d\x70\x77\x91\xce\xc8\x22\x46\x26\x23\x75\x30\x37\xab\xfe\xd3\xbf\xe8\xed\x56\x69\xf5\x6a\x52\xda\xac\xfa\xa3\x4f\x91\x6d\x79\x2e\x6e\xf6\xf9\x78\xe0\x0d\x20\x52\x93\xd7\xa9\x04\xdb\xb5\x2d\x03\x93\xdb\xcb\x19\x12\x56\xe3\x80\x78\x97\xda\x35\xb2\xf2\x11\x41\xe5\x6c\x2d\xbf\x24\x04\xfd\x7f\x41\x86\xfb\x5a\x05\x4e\xc9\x9a\x9e\x35\xaf\x02\x9c\xfb\xd6\xfd\xfd\x17\x7c\x3b\xf0\xbf\x4b\x3b\xeb\x59\xb3\x66\xcd\x55\x6c\xed\x04\xf5\xd9\x99\x74\x93\x88\x46\xb2\x71\x8c\x74\x52\x81\x94\xe7\x98\x66\xf6\x53\x91\x34\x3f\xaa\x6d\x54\xd7\x71\x59\xab\x9a\xbe\x05\x5f\xb3\x22\x88\xff\x90\x05\x67\x07\xe7\xbb\xed\x08\xee\xd6\x6b\x97\x7d\xc1\xd5\xae\x64\x82\x42\x1c\xa0\x62\x5f\xff\xbb\x54\x82\x83\xfc\x32\x36\x9d\x4c\xd0\xc6\xc6\x95\xbb\x7f\x88\xfb\x54\xfe\x8f\xd1\x93\x8d\x8f\x72\xbb\x99\xc2\xa4\x59\xae\xd9\xe6\x23\x72\xcd\xd4\x6c\xe6\xaa\xec\x42\xb2\x52\xb3\x41\x5d\x54\x34\xcf\x90\x73\xa6\x2a\x9b\x42\xf0\xfd\xd6\x1f\x8b\x9f\x29\x08\x7d\xb9\x24\x0e\x78\x8e\x69\xec\x80\x7e\x1d\x1f\x1f\x63\x50\x5e\x65\xc5\xa0\xf9\x6d\xd4\x85\xeb\xb0\x09\xcd\x21\x0b\xb3\x66\x01\x6e\x49\x8f\x14\x10\x57\xea\x00\xdc\x06\xbe\x60\xed\xfd\x42\x4e\xb2\xbc\x02\xd2\x79\xcb\x8e\x70\xc5\xe2\x2f\x27\x7a\x25\x4a\x3c\xb1\xe0\x93\x05\xef\x6c\x33\x2e\x95\xaf\x84\xa6\x22\x8e\xb1\xb2\xfb\xcd\xe6\x70\xf4\x7e\xd3\x5f\xb7\x98\x78\x8c\x30\x45\x76\xf1\x77\xa2\x6e\xc6\x73\x92\xee\x4c\x8f\xf8\xdb\xd5\x54\x2b\x5d\xf3\xa9\x9f\x28\x4e\x9a\xbd\xf1\xc3\x30\x5e\x8a\x55\x3e\x18\xde\x8e\x24\x55\x91\xe7\x46\x5e\x5b\x3b\xd9\x12\x80\xfd\x4b\xa6\xb2\x49\x48\x4e\x4d\xce\xa1\xdb\x66\xa3\x70\x86\xe7\xed\x42\x9b\x20\xfa\x3b\xd7\xb8\x31\xaf\xff\x01\xd1\xdf\x28\x8f\x60\x0a\xd1\x3b\xa6\x72\x0a\x9e\x07\xa2\x5b\x89\x3d\xb1\x4b\x72\xe8\xa7\xbf\x1f\xc1\x64\x24\x73\x83\xb4\x54\x79\x75\x9f\xec\x27\xc0\x99\x13\x3f\xc1\xbd\x53\xc4\x90\x62\xa1\x96\xfe\x8e\x0a\xbc\x64\x39\x36\xad\x64\x42\xe0\x81\x05\xa2\xc2\xac\xed\x9f\x7a\x0e\xbb\x8e\x87\xae\x4e\x5f\xae\x14\x57\xc3\x78\x9f\xa2\x7c\x0e\xba\x6a\x65\x49\xc9\x3a\x6d\x07\xfb\x07\x1b\x34\xd3\x42\x8d\x08\xc4\xf5\xb8\xba\x06\x69\x89\x6d\x6c\x3b\x7a\xcc\x32\xf7\xf7\x3d\x83\x2c\x4d\x27\x7d\x81\x96\xfa\xfb\xa6\x60\x4b\x9c\x3d\x37\x59\x72\x0e\xcd\xd5\x5a\x16\xcc\x06\xa6\x2a\x3e\x08\xb7\xb7\x66\x8a\x1e\x4b\xe6\x66\xc3\xde\x19\xb6\xd2\xa5\xe9\x20\xa1\x31\x8f\x2d\xa8\x51\x23\xf9\x4f\xbb\x86\xd3\x47\x82\x69\x56\x38\x78\x49\x71\x13\x56\x70\x96\xd3\x38\x67\x0b\xd7\x7f\xf4\x49\x47\xd0\x32\x98\x67\x45\xea\x86\xf0\x38\x1a\x41\x83\x0f\xa3\xc7\x90\x15\x10\xb9\x8d\xc0\xa7\x86\x46\x2b\xf0\xa8\xdc\x7a\x69\xae\x19\x04\x7f\xff\x68\x7e\xff\xaf\x19\x01\xaf\x55\xea\x3b\x78\x8f\xed\xa2\x3d\xdf\xf3\x8a\x27\x0c\x6f\x6b\x33\xc4\x59\xc8\x04\xe7\xb1\xb8\xbe\x90\xc4\x85\x7d\xdb\x54\xa1\x7b\x87\xb6\xbe\xf5\x7b\xbb\xcd\xf9\xce\x28\x56\x3f\x03\xc5\x6a\x47\x14\x1f\x40\x95\x97\x52\x6b\xb3\x26\xdf\x96\x85\x32\xd9\x0a\xef\x94\x82\xaf\xe4\xa7\xff\x15\x83\xff\xb3\xc5\xa0\x12\x80\xff\x2b\xfa\x7e\x19\xd1\xa7\x96\xdf\x3d\x65\x9f\x6a\xfc\xcb\x0b\xbf\xfb\x23\x59\xed\x8a\xe4\x43\x58\x32\x14\x96\x5d\xf2\xcf\xf3\xd8\xf4\xdc\x24\x95\xc7\x8f\x32\xf0\x36\xf4\x40\x74\x91\x3c\x91\xb5\x94\x97\xdf\xa6\xc4\x28\x6d\x66\xee\x10\x41\x86\x7d\x55\xba\xdf\x4e\xf7\xd9\x8e\x05\x49\xf3\x20\x09\x76\xef\xfd\xcb\x56\x27\xdb\xed\x2e\xb6\x0f\xe0\x60\xdb\x48\x43\xf5\xf2\xfd\x5b\xc7\x7b\x7b\x3f\xcb\xf7\x56\xb1\x31\x8f\x8d\x77\x94\xce\xe5\xa6\xdd\xa2\x3c\xb4\x9d\x5b\x94\x6a\x80\x87\x41\x53\x39\xf4\x87\x6a\x3c\x17\xe4\x46\xd8\xe5\x0a\xe5\x57\xb2\x03\xf6\xdc\xa1\x7c\x67\x28\x87\xc8\x30\xd2\x3c\x7c\xdb\xf0\x1f\x3d\x5e\x11\x74\x58\xcd\xe4\x0f\xb7\x83\xaa\xbf\xc1\x4b\xb0\xab\x4a\xb6\x99\xac\x1a\x69\xdc\xad\x41\xaa\x21\xd2\x3f\x52\xe9\x13\xa5\x9c\xbf\xa3\x53\xb2\x90\xba\xed\xf1\x4b\xfc\xff\x2f\x59\x25\x6a\x92\x03\xbe\x16\x23\xed\x04\x15\x55\x7e\xbf\x61\xf8\xe1\x0e\x06\x81\x0d\xa6\x01\x0b\xc6\x3e\x2f\x63\x3c\xf8\x77\xba\x65\x0c\x88\xd1\xe2\xee\x0e\xf9\x2d\x31\x26\x8b\x66\x51\x85\x74\x80\x99\x86\x87\x07\x4e\x2c\xb9\xc0\x9a\xb8\x79\xf3\x32\xcf\xc4\x20\x9a\x46\x76\x9f\x2c\x19\x97\xa5\x09\x1d\xec\x1f\x8c\xe0\x60\x43\xee\x94\x0e\x98\xfd\x21\x91\xb2\xa7\x3e\x4c\xbe\xb4\x31\xd1\xfa\x8d\x6c\xe5\x54\x9b\x03\x07\x14\xe4\x70\x75\x5c\xa7\xac\x76\x16\xd6\x46\x29\x34\x6c\x3f\xbb\xd2\xdc\x23\xd4\x90\x55\x7e\xea\xa9\xcd\x63\x1d\xd6\x91\x3d\xa9\x2a\x23\xe8\xa9\xe3\xc6\x95\xa5\x31\xaf\x2f\xb9\xa8\xd0\x9b\xfb\xe9\xf7\xc3\xcd\x5b\x13\x5a\x5a\x5c\xdb\x2b\xc5\x9b\x17\xea\x7d\xb5\xf9\x34\x70\x50\xec\xae\x36\xbc\xf5\x6e\x1e\x52\x3f\x19\xaf\xab\xaf\x12\x26\xa7\x3a\xb3\x6e\x27\x42\x21\x1e\xba\x81\x44\x21\x6d\x9b\x89\x76\xdb\xc8\xb6\xd9\x75\xa2\x14\x2f\x29\xab\x7d\xd5\x6d\x34\x82\xef\x26\xde\xa3\x5f\xc3\xc3\x96\x2c\xd1\x99\x1e\x51\x9c\xf0\x8f\x8c\x89\x11\xe8\x6c\x79\xa8\xf9\xd8\x24\x90\x4e\xc8\x78\x85\x5d\x72\x45\xfb\xa0\x2a\x90\xfb\x82\x95\xc6\xa2\x16\xbd\x63\x60\x3f\xc0\x1c\xe3\x4f\xa2\x0e\x4d\xb2\x29\x75\xf6\x94\x06\xab\x33\xcf\x7c\x50\xd2\xe6\x83\xfe\x79\x22\x48\x25\xc0\xa8\x9a\x18\x1f\xfa\xef\xf8\xcb\xdb\x57\x6f\xd5\x2f\x1f\x4f\x4e\xcc\x7b\x59\x4d\x01\xa5\x72\x45\x46\x3a\xd1\x56\x56\x2c\x1c\x18\xb6\x5a\x91\x22\x95\xfd\x9c\x7c\x8c\xf6\x00\x7a\xc4\x97\x02\xbc\x93\x29\xb3\xe7\xab\xf9\xcd\x66\x47\x6c\xb5\xda\x20\x17\x7d\xc4\x7c\x81\xf8\xbd\x51\x13\xd4\x7c\xf6\x24\xc5\xd2\x97\x1c\x66\x0a\xdc\xc8\x74\x0d\xdd\xdd\x8e\x39\xb3\xb4\x84\x6d\xf3\xc6\x2e\x62\x36\x5c\x33\x1e\x0c\x5c\x34\x32\x29\xce\x0e\xf5\xca\x2c\xdd\xa9\x1a\xa9\x68\x21\x2e\x76\xac\xcd\x91\xbf\x2e\x50\x69\xef\x5e\xde\x46\x16\x4f\xa1\xd9\x8d\x0a\x98\xc3\x88\x42\x1b\xea\xb9\xa9\x92\xf7\x24\x60\x70\x3f\x77\xd7\xfe\x56\x74\xb5\xbd\xbf\x15\x5d\xed\xd8\x5f\xbb\xa3\x8a\xf3\x96\x08\x6d\x57\x19\xde\x15\xff\x40\x44\xbb\x01\x6c\xe8\x25\x90\xd6\x1b\xc6\xd0\x9e\x51\x51\xf3\x5d\x6a\x56\x4a\x2c\xf4\xcf\x7e\xa3\x7e\xb2\xda\x8d\x01\x79\xe5\x45\x3a\x86\x4b\x54\x1f\x07\x16\x15\xab\x4b\x98\x35\x69\xa4\xca\x2f\x4a\xa2\xc2\xe8\x8c\xae\xcc\xa9\x76\x98\x58\x9b\x96\x32\xff\x3f\xe1\x90\x09\xc0\xe3\x15\xb7\xb1\x57\x2e\xcf\x68\xdc\xea\xef\x8d\x7a\x34\x20\x7a\x46\x60\x59\xd1\xf9\x4c\x3e\x9b\xe2\xe5\x4d\x75\x6d\xc7\xf8\x45\x77\x85\xaf\xa7\x3c\x8f\x02\xbf\x7e\xf5\xc5\xdb\xad\xbf\x9b\x28\x8d\xf8\xd9\x98\x3c\x8f\x0e\x3b\x5d\x97\x90\xd1\x54\x3b\xc9\x5c\x0e\xa3\xdb\xbb\xa4\xdd\xd9\xba\x35\x86\xfb\x92\xba\xf8\x68\x6c\x8d\xbe\xad\xab\x75\xd2\x2b\x58\x1a\x1c\xf4\xa4\x78\x68\x9e\xf4\x76\xb0\x76\xf5\x1c\x5e\xb4\xde\xad\xf3\x25\xc2\x8a\x94\xc0\xe6\xa0\xce\x30\xea\xb6\x4b\xb0\xd6\xa1\x68\xdb\x41\xc8\x01\xbd\xf3\x51\xb3\xe7\x00\xb9\xe3\x09\xf4\x97\x3b\x69\xd2\xdc\xbc\xbc\x64\xd9\xce\x61\xb8\xd7\xf3\x8a\x12\xde\x0a\x26\x2c\xdf\xe7\xab\xfd\x83\xa7\xad\x6a\xee\x21\xa7\xe5\xf7\xf6\x0e\xd0\x45\x56\x66\x32\xa2\x12\xb9\x78\x2a\x8f\x75\xde\xe1\x52\xbe\x8c\xe4\x59\x9e\x82\xf3\xa5\xe7\xa6\xd2\xf9\x12\x95\x0d\xd6\xb9\xf4\xda\xe2\x1f\xfb\x29\x29\x16\x6e\x77\xbe\xd7\x88\xf5\x68\xff\xb8\x61\xb0\xbd\x08\x45\x43\x53\xad\x31\xa2\x70\xb8\xde\xe9\xb8\xf7\xb1\x2d\x85\xc5\x77\xed\xa1\x78\x8d\x0d\xcc\x3b\x9d\xea\x3d\xe7\x80\xa8\x81\x65\x34\x6d\xce\x84\xd9\x54\x22\xaf\xd7\x68\xea\x0f\xc0\xd6\x90\x76\xe2\x68\x0a\x99\x2a\xb9\x35\xec\xdc\xf1\xec\x15\x5d\x95\xe2\x66\x60\x69\x45\x73\x17\xbb\xb4\x83\x01\xc8\x08\x9c\x57\xd7\x25\x4d\x04\x0f\x32\x0b\x25\x39\xe3\x75\x45\x39\x08\x26\xb3\x47\xc7\xf0\x62\x2e\xa8\x4e\x9b\x4a\xaf\x69\x52\x4b\x09\x84\x62\xea\x3f\x4f\xa0\xaa\x0b\xdc\xa6\x20\xe3\x08\x6f\x91\x5d\xd1\x42\x0a\xfb\x8a\xe5\x80\x79\xa7\x41\xbd\xcf\x25\xcb\xb2\xa2\xce\x8a\x85\x7c\x56\xfb\x54\xbe\x62\x6e\xa4\x99\x5a\xbc\x1c\x08\xbf\x29\x92\x65\xc5\x0a\x56\xf3\xfc\xc6\x97\x76\xb4\x7c\x25\x7b\xa6\x03\xfc\x9d\xdb\x7c\xe4\xef\x98\xfc\xc8\x71\x60\xac\x8c\xad\x1d\x9d\x96\xbb\x78\xcb\x68\x43\x3d\x91\x30\xa4\xcb\xa7\x1a\x1f\x85\x4c\xc4\xd6\x39\x87\x96\x52\x70\x21\x48\xf5\x48\x82\xe4\x27\x2c\x18\xd8\x67\x0b\x4e\x92\x25\x4d\xeb\x9c\xea\x37\x2e\xaf\x85\xfc\x8e\x30\xb8\x7a\x08\x85\xd5\x22\x48\x92\xd3\x31\xa6\x43\xb8\x1d\xc1\x24\xdc\x0c\x70\xef\xb4\x8f\xec\x71\xd0\x74\x2f\x3b\x32\xd1\xc8\x0a\x83\xfe\x50\x9a\x46\xba\x6f\xdf\xfb\x88\x96\x5e\xe2\x8a\x2d\x49\x2a\x7e\xfa\x09\x76\xca\x57\xa0\xe8\x25\x77\xcc\x8e\xdc\x40\xad\x74\x1d\x91\xdc\xe6\xf6\xcd\x73\xe6\xfd\xc3\x08\xdc\x88\xcd\x24\x1e\x7d\xf8\x14\x6f\x45\x7d\x77\xcc\xc2\x2c\xe6\x98\x7f\x67\x5f\x9a\xc7\xf6\x15\x92\xe6\xf1\xf5\x1d\x91\x74\xef\x58\x56\x5f\x0a\xb2\x20\xf8\x8e\xe5\x47\xba\xaf\x5e\x3b\x46\xd4\x01\x53\x59\x03\x91\x8b\x4c\xbe\x0d\xcf\x05\x91\xaf\x13\xb7\xd2\x9d\x68\x60\x9b\x46\x30\x1e\xc3\xff\xe7\x67\xc8\x7e\x8c\xd8\xe7\x8c\xa4\x0a\xed\xc7\x3b\xa0\x3d\x1e\x5b\xcc\x91\xa2\xde\x4b\x29\x92\x14\x26\xe1\x73\x40\x0d\xef\x25\x98\xcd\xf4\x85\xce\x9c\xd0\xc1\x36\xd1\xdf\xcb\x0e\xc8\x3b\xaa\xdf\xee\x3e\xdb\x8d\x4c\xb8\x7b\x0d\x5c\x14\x0a\x36\x93\xee\xdd\x19\xa0\x8b\x8c\xc2\xa6\x14\xbd\x37\x09\xbd\xac\xa4\xdd\x20\xef\x4c\x2f\xb3\xa0\x54\xb4\x5e\x7c\x97\x04\x25\xdb\x09\xed\xa7\x1e\xd0\x11\x79\xf7\x5d\x51\xbb\x76\xe6\x07\xd3\xfa\xb3\xaa\x7b\x4f\xdc\xe7\x5f\x0e\x07\x2f\xe6\xb1\x03\x05\x14\xe5\xfb\x2a\x62\xf2\xae\x28\x98\xc9\x32\x69\x35\xe3\xbd\x6e\x4e\x33\xc9\x3b\x9b\x7c\xb6\x7d\x00\x06\x72\x27\x9c\xd6\xfe\xa2\x12\x95\xdd\x95\x48\xae\x0f\x43\x91\x6d\xdd\x98\x60\xce\xfb\xf7\x94\xb1\xed\xbd\xa4\x19\xff\x9a\xb1\xa8\x93\xe2\x5e\x24\x0e\xef\xa2\xba\x17\x81\xb3\x9f\xd6\xe2\x66\x3f\xb9\x49\xf2\x7b\xd0\xbf\x1d\xf0\xe5\x73\xd0\xc6\x5e\xda\x03\xd2\x09\x28\x23\xdf\xe1\xb4\x27\xf4\xc8\x78\xa4\x79\xa5\x31\xf6\x70\x21\x7b\x38\x84\xdb\x80\x2a\x0f\x3b\x12\xb3\x34\x7b\x46\xe1\xe2\x90\x77\x18\x47\xe8\xe1\xdb\x1e\x93\x4e\x11\x20\xe3\xf1\x5b\xd9\xcd\x9a\x8e\xaa\x8d\x45\xe7\x22\x43\xba\x38\x00\x9d\xa4\xf7\xd5\xe7\x7d\xe9\x82\x7e\xa7\x65\x17\x3a\x90\x77\x00\xdb\x2c\x16\x7a\xd0\x91\xdb\xa9\x06\x73\x77\x51\xe0\x82\x25\xfa\xa0\xb5\x27\xcb\xf9\x91\x47\xbb\xa1\x58\x17\x6d\xb0\xf7\x43\xd2\x67\xaf\x5e\xd0\x3d\x18\xfb\xce\xed\xdd\x02\x40\xbb\xdd\x6c\xd9\x20\x8d\xd3\xca\xce\xf8\x07\x2e\x9c\xc6\xcd\x68\x5f\xb2\xfb\x2f\xb1\x3f\x85\xbe\x52\xb6\x3f\xe5\xf5\x70\xdf\xdd\xc8\x65\x68\xda\x42\x9d\xb6\x0d\xa7\x07\xe3\xed\x87\xda\x06\x56\xcd\xd3\x41\xcd\x05\x5b\x81\xba\x08\xe7\x5b\xce\x09\xb2\xee\xc5\x4a\xd5\xdd\x6d\xe6\x16\x54\xa8\x2e\x74\x0f\x3e\xef\x35\xcd\x0a\xf6\x82\x6b\xe3\xb3\xab\x9d\xa2\x4d\xe3\xe4\xae\xc4\xdc\x3f\xa9\x0f\xf6\xa1\xa0\xd4\x58\xf9\x75\x5f\xc3\xe8\x63\x7f\xbf\x8b\xed\x22\xd0\x4f\x38\xd9\x29\x04\xfd\x03\xe7\xdd\xa5\xa0\x0f\x5e\x0b\xc2\x0e\x80\x5b\x4e\xb9\x4d\xa9\xa3\x42\x14\x1b\x29\x31\x61\x86\x06\x57\x11\xe6\xf4\xe4\x83\xad\x80\x71\xbc\x7d\x49\x8b\x03\xc7\x3d\x45\x97\xbb\xa6\x7a\xdd\x7e\x0e\x90\x06\x4b\x95\x29\x4d\x46\x47\x28\x4b\xd8\xbf\x99\xf3\x53\x40\x2e\x5d\x6f\x5f\x06\x3e\x45\x43\x8c\x18\x18\x78\x93\xbc\x29\x9b\x6d\xf7\xe9\x2c\x80\x1e\xe8\xf1\x41\x7d\x99\x08\xed\x87\x9b\xa3\xb2\xee\x1a\xb1\x8f\xfd\xb0\xe3\x48\x72\x47\xfa\x35\xf3\x7c\xdc\x9b\x84\x46\x17\xbd\x07\x15\x37\x64\x88\x0d\x09\xd9\xd7\xc7\x56\x5a\xaa\x1e\xee\x43\x4e\x4d\xd2\x0e\xdb\x53\xe7\xcb\xac\x89\xcb\x88\x4b\x44\xb2\xa4\xdc\xda\xa3\x38\x25\x55\xb2\x04\x41\xab\x15\x97\x0f\xcf\x67\x82\xeb\x90\x16\x92\x67\x84\x53\x3e\x42\x70\xd2\x15\x00\x58\xa5\x9e\x2e\xf5\x2f\x30\x34\xc0\x13\x09\xa7\xb9\xd4\x24\xd8\xc6\x43\xfc\x2a\x5e\xdd\xaf\x17\xdb\x18\x9b\xb6\x40\xd7\x48\x98\x99\xb6\x10\xd4\x2f\x26\x0a\xb5\xbb\x8d\xaf\xa1\x74\x6c\x13\x72\x4c\x21\xe0\xce\x2c\x24\xae\x6e\xeb\x56\x42\x52\x03\xa9\xd6\xd1\x44\x7e\xe3\x5d\xf0\x55\xab\x27\x10\xcd\x82\x37\x03\x9b\x4d\xcf\xe4\x8f\xf3\x40\xe4\xa1\xb1\xd9\x8d\xde\xbd\xde\xde\x7e\xfc\xba\xeb\xe6\x44\x4e\x47\x5f\xfc\x0b\x82\x56\xb9\x1f\xdf\xcf\x07\xb2\xe6\x59\x76\x3e\x94\xaf\x13\xef\x1f\xdc\xf3\x7d\xe0\x76\xf4\x66\x20\x7c\x63\xf8\x6b\x26\x96\xac\x16\x40\x34\x23\xaa\xd8\xfe\x6c\xb5\xa2\x69\x46\x84\x8a\xc6\xf6\x5b\x00\xa9\xa8\x7c\xa8\x19\x7d\x60\x4c\xa3\x16\x53\x7b\xf5\x2f\x69\xce\x10\x05\x65\xd6\x66\x45\xd3\xdc\xda\xde\x9c\xfa\xc3\xbb\x3b\xec\xae\xcd\xdd\x3f\x44\x76\xe6\x5c\xaa\x1d\x9c\x69\x03\xec\x5e\x33\x21\x3b\x9f\x86\x7f\xaa\x97\x67\x2d\x03\x54\x2b\xae\x65\x5b\x20\x6c\x14\x29\x8c\x24\x0b\xf9\x41\x7b\x71\x8d\x3f\xf3\x27\x63\xf7\x04\xaa\xdc\x29\x9d\x30\x45\xc8\xde\x91\x11\xff\xf4\xb6\x40\xa3\x8b\xa9\xfc\x73\x0f\xb5\xe9\xfa\x0c\xe9\x6f\xb4\xad\x1e\x06\x21\x61\x4d\xd4\x69\x63\x08\x7e\xa5\x16\xc7\xfa\x1f\xa5\xc4\x91\xb9\x1d\xda\x82\xc8\x65\x35\xc4\x7f\xa1\x78\xf3\x61\x18\xe9\xd6\xd2\xa8\x1a\x2e\x8f\xa8\x07\x4a\x27\x47\x14\x21\xf8\x8b\x7c\x98\x99\x6b\x6f\xa3\x48\x1e\x85\xe5\x66\xf7\xd0\x1e\x8e\x77\x4b\x99\xd2\xeb\xd4\xe8\x8f\xb9\x19\xdb\x1c\xfa\xcc\xeb\xe0\xde\x16\xa1\x9d\xed\x34\xf8\xa4\xc5\xb4\x7c\xbf\xbd\xa3\xbc\x83\x2d\xa0\xb3\x0b\x5d\xff\x6c\x72\xee\xdb\x8b\x55\x0a\xe5\xe2\xab\xbe\x54\x24\xcd\x5b\x4e\xfd\x2f\x26\x42\x54\x83\x08\xfd\x15\xa2\xee\xcc\x57\x9b\xd4\xff\xd0\xab\x21\x74\x62\xe8\xf8\xd7\xa2\x4c\x37\x4a\x2e\x7a\xd4\xd1\x56\x6d\x09\xe0\x3c\x9e\xda\x7b\x50\x00\xbd\x63\x0b\x02\xb3\x79\x77\x6c\x42\x7d\x6d\xfd\x3d\xc8\x50\x56\x43\x31\x09\x22\x2c\x8a\x78\xab\xa4\x7d\x69\xfd\x84\xa3\x61\x99\x09\x91\x0f\x3b\x34\x11\xf2\x1d\x31\xf2\xcf\xe1\xc0\xbe\xf0\x8f\xae\xb1\xde\x05\x50\xcb\x1f\xa1\x3b\x48\xbe\x2b\xa4\xe3\xac\x27\x99\x3a\xe8\x51\xdc\xed\xa9\x03\x6f\x16\xef\xfa\xb4\xc2\x6d\xdf\xa8\x27\xc1\xa8\x43\x13\x3d\x38\xd2\xf6\x8c\x3b\x48\xac\x79\xf7\x67\x1a\xfb\xe2\x9a\xf5\x25\x35\xae\x2b\x1b\xd9\xec\x31\xa9\xd2\x12\x67\x1d\xdc\xa4\xbe\xfc\xf4\x13\x44\xd1\x46\x27\xd7\xce\x40\x6a\xf9\xa5\xd9\x5f\xaf\x5b\x98\x66\x4f\xe7\x16\xec\x39\x83\x35\x21\x07\x75\x51\x8f\x32\xbd\xc4\x15\x2d\x73\x92\xd0\xc1\xf8\x73\x31\x5e\x8c\x20\x7a\x76\x59\x19\x09\xd2\xef\xe8\x86\xae\x7a\xd8\x8d\xef\x8d\xf7\xdd\xb0\xbf\xbe\x9a\xa4\x96\x33\x9c\x9e\xec\xdb\xdd\x12\x02\xff\x99\xd2\x52\xa9\xf2\xac\xb2\x19\x71\xe5\xeb\x80\xc9\x92\x71\x0a\x24\xa9\x18\xe7\xb2\xb4\xa2\xf3\x8a\xf2\x25\xb5\x87\xfc\x47\x9b\xf4\x97\x13\x56\x59\xb7\xb2\x6d\xf5\x50\xd5\x49\x58\x5e\xaf\x8a\x29\x7c\x37\x02\xc2\x13\x2a\x6f\x3e\x75\xea\x83\xdb\xe0\xd8\xae\xea\x6f\x03\x69\xb5\x0d\xed\x23\x3d\x83\x47\x4e\x37\x93\x0f\xff\xf9\x01\x07\xf6\x05\x0b\xa5\xc4\xe9\x29\xdf\x39\x76\x1d\x71\x8a\xd5\x00\xf4\x1f\x76\x08\x56\x59\xd1\x88\x18\x92\x74\x3e\xf9\xaf\x0d\xc9\x24\x4d\xdf\x64\x5c\xd0\x82\x56\xed\x18\x3e\x89\xef\x99\x45\xe0\x1c\xb7\x73\x26\xad\x38\x9b\xb2\x67\xec\x32\x03\x7b\x61\xc6\x46\x35\x1d\x12\x96\x19\x5b\x50\xc3\x9b\x25\x55\xc9\x16\xec\x75\x26\xaa\x70\xd6\x97\x96\xf6\x89\x2a\x79\x34\x8c\x97\x59\x2a\x8f\x1f\xe6\x21\xac\x74\xb3\xfe\xef\x72\x00\x24\x75\x55\xa9\x0c\x5f\xa8\x67\xf9\xfe\x71\xb2\x20\x54\x2d\xbd\x2c\x78\x0d\xaa\xc8\x5c\x15\x1b\x75\xf2\x6e\x37\xb3\xee\x93\x80\x1e\x78\xb7\xb5\x6a\x63\x2f\x71\x87\x8a\xbf\xe5\xb0\x10\x37\x35\xe4\xe0\x31\x31\x52\x28\x9b\x41\x9b\x84\xc8\x3a\x34\x85\xcb\x1b\xf4\x40\xd0\x09\x6f\x88\x80\x15\xe3\x02\x22\x65\xdd\x00\x5a\x88\x2a\x0b\xdd\x0e\x37\x1a\x73\x64\x33\x45\xa9\xd6\x57\xb5\xef\xbb\x7b\x9a\x11\x5c\xfa\xa7\x48\x12\xeb\xc7\xac\x39\xee\x93\xf0\x1c\x2e\x83\x82\x96\x56\xae\x62\x66\x6d\xfe\xa1\x0e\x10\xcf\xb6\x81\x38\xe8\x7c\x7b\x51\x7f\xc4\x9b\x61\x52\xd1\x1f\x6e\x54\xa6\x3d\xc4\xd6\x3b\xb5\x86\x19\xc5\x5a\x23\x35\xa9\xff\x6d\x72\x95\x3e\x23\xa1\x21\x99\x3d\x5c\x49\x22\x05\x7d\xdf\x67\x46\xcd\xfe\xd0\x35\xa9\x08\xaa\x77\x5e\xfb\x0d\x4b\x0f\x33\xb5\x0a\xb1\x70\x76\x9b\x2a\xca\x4e\x13\xac\x01\x3d\xdb\x01\xd0\xbf\xe6\x34\x63\x0d\x8d\x5d\x26\x58\x05\x97\x84\xab\xcc\x7c\xba\x8f\x8a\xe5\x39\xad\x9a\x99\x4f\xc2\xe1\xf0\xfa\xf2\x85\x3c\x41\xff\xe0\x24\x1f\x96\xa9\xe3\xea\x73\xf9\x25\xf6\x13\x87\xf9\x14\xf3\xe8\xee\xda\x3c\xeb\x6d\xb3\xef\x37\x0a\xbe\x4c\x0e\xbd\xb7\xd6\x0c\x13\x1b\x0f\x63\x94\xe1\x66\x02\xcd\xdf\x3e\x15\x5b\x39\xf5\x1a\xef\x5c\xe9\x57\xfc\x79\xc7\x11\x5e\x1f\x2d\x30\x17\xa2\x17\xa9\xa7\x98\xc4\x2b\x6c\x1f\x28\xda\x49\xa9\xb0\xd8\x8c\x57\x83\x7c\xa2\x54\xe8\xee\xb7\x91\x5c\x27\xa6\xda\x2e\xef\x68\x05\xcf\xd7\x25\x65\x3d\x35\x7d\x8d\xbb\x90\xd4\x9c\xe5\xf5\x37\xf5\xfa\xdd\xd2\xa4\x35\x1d\xb8\x0f\x23\xfd\xad\x05\x2d\x68\x2f\x2d\xbc\x49\x5e\xa7\x2a\x59\xa6\xa8\xb2\xc4\xce\x9a\x37\x37\x77\x36\xe7\xb8\xfd\xb8\x61\xcc\x6c\x18\x6d\xcc\x11\xab\xbf\x92\xf3\xf5\x9c\xc1\xc4\xb5\x78\xb4\x61\x0f\xd7\x47\x18\x6b\x7a\xf1\xab\xbe\xd3\x09\x11\x55\x2c\xea\xa6\x7e\xdb\xbc\xd2\x61\x4b\x6a\x81\x3e\x6b\x9d\xe8\xcf\xfb\x0c\x20\x5e\x72\xac\xae\x7b\x2b\x35\x88\x7b\xe0\xd4\x34\xab\xf4\xe2\xf5\xa8\xfb\x2d\xff\xa0\xa6\x5d\xc2\xb3\xdd\x16\x68\xff\x7b\x92\xad\x77\x3d\x1b\xf2\x78\x83\x40\x0e\xe3\x0b\xb9\xcd\x8d\x31\xe8\xc8\xc5\x84\x8a\xa8\xb9\xee\xe6\x34\x57\x0f\x08\x35\xde\xa0\xd0\x1e\xd6\x7b\x6d\xdf\x75\x5e\x92\xc2\xb9\xea\xdb\xdc\x1b\x53\x8c\x25\xed\xa8\x7e\x69\xeb\x86\x98\xc8\xa1\x6d\xcd\xd0\x01\x8d\x64\x52\xc6\xe3\xd8\xda\xc8\x0b\xba\x36\x62\x12\xcc\x51\x0d\x43\x0c\xb9\xd3\xa8\x65\x48\x34\xe5\xba\xba\xec\x00\xec\xb0\x21\xad\x58\x89\x7e\x8d\xcd\xf0\x12\x43\x3f\x5b\xd3\xb8\xab\xf7\xe5\xe8\xdf\x98\x5c\xda\x26\x4d\xe9\x5d\xd0\xcd\x14\x87\xde\x3a\xee\xa8\xd9\x9d\xac\x64\x23\xf0\xee\x26\x3b\xf8\x89\xf7\x4c\x92\x95\x10\xbb\x4c\x62\x14\x99\x99\x7b\x91\xa6\xa0\x5e\xd1\xb6\x58\xeb\xe7\x77\xdc\x4c\x84\x21\x30\xd2\xf9\xbf\x39\x0d\xc3\x3b\xa4\xb4\xde\x38\xf2\x96\xe9\x36\xe0\x37\x98\xed\x0e\xd0\x64\x40\x6b\xc6\x5b\xe0\x2a\xc8\xb3\x6e\x53\xaa\xb6\xa4\x56\x4c\x1e\x8f\xa2\xb2\xa2\x9c\x16\x42\x1e\x8b\x7b\xea\x3b\x98\x7d\xd6\xd9\x7e\xf0\x2b\x5a\xd4\x99\xa0\xab\x5d\xdb\x09\x72\xa9\x82\x32\x46\x78\x9b\xb5\xad\x4d\x92\x67\x09\xae\x17\xb3\x74\x62\x6c\x2c\x93\xa0\x36\xb2\xdd\x0c\xb7\x82\xea\x92\x17\xf6\x1c\xed\x09\xb7\xdd\xe7\x66\x62\xdf\x46\x36\x9b\xbf\x14\x15\x4a\xe7\xc2\x76\x3d\xd1\x05\xa1\x48\xb1\xba\x95\xe7\x80\xd3\x61\x95\xf1\xbe\x22\xd2\xb8\x2b\xc8\xf6\x7b\x0f\xe1\x16\xe3\x9e\x2e\xdd\xaa\x5d\xb8\xdc\x03\x4d\xc8\xf3\xac\xe2\xe2\x63\x5d\x6c\x36\x97\x98\x5a\x30\x53\x16\xa9\xc3\x3d\xaf\xf2\x9d\x9c\x9b\xcc\xbf\xce\xc8\xbd\x16\x37\x44\x0e\x80\xf2\x07\xd8\xcd\x97\x1b\x1a\x59\x41\x77\x77\x4e\x0b\xd0\x33\xcc\x15\xb5\xf6\xc8\xe8\x01\x7a\xee\xf6\xb1\x0a\x10\x08\x9d\x99\x76\xf2\x5e\xea\xc1\xe4\xb6\x37\x17\x56\xd0\xba\x29\xe5\x0e\xf7\x76\x31\xa0\x35\x6f\x24\x78\xc3\x9f\xe6\xae\x31\x39\xbe\xc3\x86\x5a\xa4\x72\xaf\x00\x2e\x2a\x4a\x56\x34\x05\x4e\x56\x65\x6e\x5f\x6b\xd3\xab\x56\x3d\xe6\xe1\x5d\x30\x92\x22\x85\xca\x19\xd1\x24\xb9\x7c\x45\x9d\xa4\xa9\x5a\x8d\xb5\xcc\x76\xd3\x6f\x17\x8b\x3b\x36\xfa\xae\x9c\x09\x6d\xb5\x74\x23\x20\x9b\x05\x7c\x17\x03\x97\xbe\xb4\x6d\x29\xe2\x1d\x7a\x6e\x27\xb6\x6d\x46\xd4\x97\xb8\xa0\x46\x1f\x17\x1d\x19\x3a\x9b\x37\x82\x5b\x32\x34\xf7\xde\xc4\x58\x76\xb0\x49\x3c\x74\xa7\xaa\xae\xbb\xd5\x81\x67\xb3\x60\x15\xd8\xfa\x7d\x17\x35\xae\xe9\x26\xdc\x55\x3b\xa9\x54\xfb\x1d\x0f\x7b\x91\x2e\xea\xd5\x89\x8b\x16\x76\x20\x74\x0e\x99\xc9\x08\x1a\xa8\xd8\x06\x4d\xbb\x09\xd7\x11\x71\x2f\xdd\x42\xe8\xb2\xf0\x2a\xe6\x04\xbe\x64\x95\xc8\x6f\x46\xc0\x8a\x84\xda\xa8\x71\xfd\x42\x41\x99\x53\xee\xd6\xc0\x8a\x92\x62\xbd\xcc\x72\x7f\x8f\xea\xea\xcc\x72\x75\x93\xbd\x94\xa7\xb0\x32\xd2\x6f\x4c\x49\xd4\xd1\x00\x66\xda\x51\xa5\x37\xb0\xaf\xf3\xde\x23\x84\xe0\x39\xc1\x78\x62\xa2\x57\xe2\x04\xf2\x63\xe3\xc2\x1a\xed\x26\xb5\x94\x1e\x31\xd2\xcf\xec\xea\x37\x23\x24\x79\xdd\x81\x42\x53\x9d\x35\x1d\xc1\x50\xb6\x64\x38\x61\x3e\x40\x20\xd2\x4d\x66\xc9\x6a\x4e\xbf\x52\x5a\xe2\x30\x13\xb4\x50\x25\x42\x1e\x3a\x56\x23\x1c\xb3\x4c\xda\x2c\x63\x42\x05\x83\x92\xa9\xbf\x9d\x1c\xa3\x57\xb4\xba\xd1\xe9\xcf\x10\x9a\xf6\x41\x53\xf3\x0e\x19\x87\x82\x09\x20\x57\x24\xcb\xc9\x65\x38\xf9\x82\x54\x4a\xbd\x50\x83\x18\x58\x6d\x22\xd0\x1e\xda\x62\x4e\xba\x8a\x9f\xc8\xe3\x8a\x73\x4b\x25\x95\xf8\xa0\x70\x1b\xf4\xe6\x88\xd1\x51\x79\x2a\x37\x8f\x07\xc5\xcc\xa1\x77\xcd\x4f\xca\x6c\x7c\x75\x10\x7f\x37\x56\xe3\x08\x3c\xb7\xde\xe9\x27\x40\xfe\x23\x20\xe6\x0c\x19\x4c\xb9\x9d\xab\x7e\x62\x56\xac\x28\xd7\x61\xfa\xad\xbb\x1e\x27\xcd\xff\xf3\xe4\xfd\xbb\xb8\x24\x15\xd7\x79\xd1\xe5\x7b\xeb\x43\x74\x87\x0e\x60\x49\xf7\x6e\x1f\x92\x17\x3a\x78\xba\xa4\x70\x59\xb1\x35\xa7\x15\x54\x38\x13\x85\x9c\xc2\xcb\x1b\x9c\x73\x9a\xcf\x47\x50\x17\x39\xd5\xd7\x82\x7a\x66\xd6\x84\x43\x45\xe7\x18\x44\x11\x3b\xb1\xa2\x7a\xab\x28\x49\x6f\x10\x3b\x99\x14\xd9\xa3\x54\x7c\xf4\xe6\xfd\xc9\xab\x97\x81\x98\x69\x11\xfe\xd6\xba\x30\x35\xd4\xd5\xf5\x92\xe5\x4e\x69\x95\x09\x36\x81\x80\x14\x0a\x92\xb7\xb2\xe4\x2b\xd4\xa5\x62\xe4\xa6\x93\x32\xf7\x6e\xda\x7d\x12\x34\x8f\xce\xb8\x40\xfe\x30\x99\x78\x4b\xc4\x24\xa6\xf6\xd9\xf5\xa0\x95\x94\xc1\x8e\xa1\x57\xfe\x68\xd6\xdf\x4d\xf6\xe8\xca\xa1\xdc\xd9\x79\x00\x07\x16\x7f\x7f\xf3\x7f\x91\xe7\xca\x99\x68\xb0\xc1\xff\xdb\xa2\xef\x15\x6e\xcf\xe6\xa6\x6c\xac\xd8\xe0\xa4\x94\xd9\x2d\x3b\x74\xc0\x50\xff\x0b\x4e\xb1\xe1\xd2\xc8\x8a\x36\x46\x0d\x85\x03\x66\x7e\x95\xb3\xa0\xbd\xef\xf1\xe2\x1d\x8f\x1d\x7a\xdd\x4f\x7a\xab\xef\xfa\xe8\xeb\x2a\x87\x67\x5b\x53\x4d\xd2\xf1\x2f\x24\xf7\x47\x7a\xe6\x20\x9c\x9b\xda\xf2\x1c\x21\x2b\x7b\x33\xd9\xed\x8f\x13\x02\x6e\x1e\x63\x54\xb5\x19\xda\x13\xfc\x4f\xca\xa4\x75\x59\x0b\xc1\x8a\x7d\x14\x32\x0e\x07\x77\xbd\xea\x74\x62\x3f\x97\xc3\xbf\x85\xd5\x51\x20\x5d\xe4\xca\xd7\xac\x3b\x7d\x43\xeb\xb0\xb2\xe9\x78\x7f\xf7\x03\xfe\x9d\x8f\xf8\xf7\x3f\xe4\xfb\x47\x76\x49\x70\xff\xc0\xee\x48\x32\x52\x93\x33\xdc\x01\x9c\x73\x0f\x19\x0e\x87\x87\xc1\xcc\x39\x16\x08\x27\x2e\x60\x0d\x4f\x35\x50\xff\x0c\x66\x83\x36\x3a\x87\xad\x43\xce\xad\xe7\x58\xeb\xaf\xf9\x05\x15\xea\x2c\xa5\xd2\x03\x7b\xbc\xe1\x12\xdf\x7b\x2b\xa4\xa5\x80\x38\x8e\xfc\x40\xb2\xca\x2e\x9b\x27\x4f\x32\x5f\xcb\xde\xd2\x0c\x5f\xe3\x99\x9c\x23\xeb\x06\xfd\xbb\x9c\xf8\x3d\xf9\xf0\x7d\x93\xae\x4f\x0a\x43\x06\x6b\xd0\x75\x7c\x8c\x3c\xfc\xb3\xed\xba\xb2\xaf\xed\x36\x5d\x3d\xd9\x2a\x77\x82\x4b\xcc\xdc\xa6\xb7\x75\x08\xd1\xb5\x7c\xff\xe8\x6d\x14\x57\xaf\x47\x04\xe3\x3e\x1f\xb6\x6e\xdc\xb6\x4e\x00\x76\x7c\x8e\xcf\xaf\xcc\x14\x0c\x6b\xe0\xec\x30\x1b\x07\x57\x6e\x92\x94\x34\x6d\x72\xa2\xde\x24\x36\x8d\xda\x3e\xda\xd7\x1e\xb9\x99\xe7\x28\x3a\xf4\xa7\x7d\xe7\x51\x34\xb8\xa3\x6d\x37\xd8\xfe\xf2\xce\xdd\xb7\x2a\xaf\x55\xff\x99\xb0\xa7\x5d\x2b\xa3\x51\xb0\xc3\x7a\x3b\x52\xd7\x1a\x24\xd7\xc1\xe0\x7a\x39\xa7\x51\x2f\xd8\xc3\xcc\xca\xed\xdc\x17\xb7\x41\xba\xdf\x36\xa9\xe2\x6b\xae\x4e\x65\x00\x82\x1f\x94\x89\x44\x80\x24\x27\x9c\xcf\x3e\x47\xc6\xf0\xfd\x39\x7a\x0e\xcf\xd4\x2e\x66\xbf\x5d\x8a\x02\x2e\x45\xb1\x9f\x52\x19\x26\x1f\x35\x9e\xf2\x36\x4d\xf7\x05\x5b\x2c\x72\xfa\x39\x02\x71\x53\x52\x6c\x27\xc1\x7c\x8e\x20\x4b\xed\x5f\x8d\xad\xd1\x20\x69\x10\x7c\x12\x60\xf8\x39\x92\x4e\x5f\x1a\x70\x80\x25\x90\x2a\x23\xfb\x4b\xc2\x4b\x56\xd6\xe5\xec\x73\x84\x5b\xfa\xe7\xa8\x89\x9b\xac\x45\xaf\x4b\x52\xa4\x14\x91\x90\xd2\xfd\x73\xf4\x3c\x6a\x77\x0c\x4a\xfc\x28\x64\x9b\x3b\xb2\x0f\xb4\x21\xd7\x3e\x47\xcf\x9f\x8d\xa5\xe0\x02\x05\xc0\x90\x2d\x21\x15\x0d\xbe\x8e\x15\x09\x7a\x3a\xaf\xf3\xed\x5d\x6b\xb5\xe0\x73\xd4\x9a\xb7\x7d\xdc\x72\x3f\x47\x80\x3b\xf0\xec\x73\xa4\xfe\xea\xa4\x86\x04\x91\xd3\xf4\xf2\xa6\x6f\x52\x50\x78\x4b\x3e\x18\xd7\x39\xfe\x2f\x17\x4b\x27\xce\xc8\x41\x16\x69\xbb\xd8\xa5\xf0\xef\x03\x19\x00\xf3\x2f\x28\x34\xe0\xe1\x30\x0c\x4e\x09\xef\x30\x54\x73\x2d\xec\x3b\x92\x32\xf9\xc9\x98\x1a\x22\x34\x94\x4c\xdd\x27\xd4\x91\xcc\xb7\x84\x27\x64\xbd\xa9\xc5\x0b\x2a\xf0\x54\x37\x68\x1e\x28\x9f\xc6\x93\x31\x29\x4b\x2d\x5f\xc6\xad\x53\xa5\xdd\xb2\x52\x56\x50\x77\x4a\x90\x07\x42\xf8\x66\xbb\x51\x05\x18\xa3\x61\xea\xcf\x49\xe6\x9d\x2a\xbe\xfc\x7d\x79\x2d\x03\x16\xae\x85\x4a\x5a\x3a\x52\x6f\x2e\x04\x30\xd0\x38\x67\x2c\x96\x61\x4a\xa2\x9d\x84\xf1\xff\x90\x53\xc7\x2f\x23\x5d\xd5\x97\x4f\x85\xca\xc5\x1c\xd6\xab\xb1\xd4\x17\xc3\xed\x27\x12\x3a\x0e\x2a\x77\x3b\xd6\xf4\x5e\xac\xa8\xb1\x1d\x29\x1b\x50\xc6\x82\x5b\x96\x9d\x1a\xb4\x75\xe1\x5d\x0f\xae\x61\x8e\xe8\x8e\x98\x16\xfc\xc7\x7d\x8d\x46\xbb\x2d\xf4\x29\x39\x21\x69\x14\x55\x42\x32\x9d\x05\xe0\xce\x5b\x67\x3b\xa5\xf7\x58\xc6\x6a\x1c\xeb\x54\xa1\x05\x8a\xf0\x94\x8e\x73\x18\x44\xa9\x76\x78\xdf\x07\x9d\x10\x21\xaa\xec\x52\x26\x2f\x33\x1d\x35\x1f\xd2\xcb\x6b\xc7\x4e\x67\xb6\xbe\x07\xac\xe9\x6d\x2e\x9b\x74\x1c\x34\xd4\xcc\xdb\xba\xee\x21\xd2\xb0\xc7\x10\xd8\xa4\x13\x50\x8f\x9b\xba\x5f\x69\xa7\x57\x14\x3a\xc4\xfe\xc8\x5f\x1f\x81\x60\x37\xd9\xef\x64\x08\x6c\x0a\xeb\x25\x75\x57\x9f\x30\xcf\x8a\x8c\x2f\x29\x87\x9c\x91\x54\x26\xac\x6b\x58\x78\xf0\xc6\xae\x21\xb8\x97\x84\x1f\xa1\x17\xff\x92\xf0\xb7\xda\xfb\xd2\xca\x78\x3f\xd3\x2a\x3a\x6e\xb0\x22\x12\x30\xa7\x22\x59\x2a\xbe\xcc\xe6\xb0\xa6\x90\xca\xe2\x25\xb9\xa2\x40\x8a\x1b\x9b\xfa\x2b\xf6\x7c\x2b\x8e\xca\xda\xbc\x49\xf3\x36\x70\x76\xec\x71\x57\x08\x53\xea\xfb\xa5\x2f\xd5\x7a\xe8\xf4\x5e\xe8\x2b\xf7\xef\x42\x8d\x05\xa3\x2f\xbe\x69\x66\x46\xdf\x55\x29\x14\xb7\xb3\x50\xfc\x1e\xee\xed\xed\x2e\x21\xfa\xd0\x68\x1c\x19\xec\x8a\xd9\x41\x59\x3e\x98\x04\x0e\x36\x3a\x6f\x2b\x64\xc5\x9c\x55\x2b\xf5\xae\x00\x11\x8a\x0f\xf4\x3d\x9b\x32\x4b\x2a\x73\xe0\x1f\x26\xd2\xc6\x88\xef\x54\xba\x0c\xba\xbd\xbb\xbd\xdd\x67\x5b\xd9\xce\xe1\x2e\xf9\xd1\x9d\x0b\x61\x9f\x69\x70\x4f\x87\x18\xfd\x5c\xa4\xee\x8c\x96\x75\xfe\x77\xb6\x54\x97\x76\x51\xdf\x6b\x48\xda\x4a\x5a\x5a\xe3\xaa\x0c\xe7\x2d\x8c\x81\xb9\x71\x27\xa1\x09\xfc\xd6\xdd\x8a\xb8\xb1\x58\xe4\xbd\x3b\x93\xfe\x93\x92\xef\xc0\xd0\x70\x58\x68\x1f\x89\xad\x91\x7a\x87\x9b\x06\x33\x23\x4a\xcc\xa0\x51\x7d\x45\xae\xb3\x55\xbd\x02\x15\x6c\x29\x9f\x8f\x55\x8f\xd7\xf2\x25\x5b\x17\xc6\xf3\x42\x97\x29\x07\x0c\xe5\x64\x71\xad\x1f\xb9\x9d\x49\x5b\xee\xa1\xe7\xb6\xa9\x8c\xf0\x42\xc6\x7b\x7a\xf0\xf4\x4e\xd4\x09\xb2\xeb\x4c\xaf\x12\x0d\x21\x14\xcd\x29\xfa\x18\xfe\x9b\x41\x14\x9b\x9c\x43\x37\x25\x9d\x26\x98\x19\x80\xa6\x91\x7a\x99\xbf\xc5\x5d\xb6\x95\x58\x66\xdc\xcb\x8b\x70\x3b\x44\x25\x76\xd0\x4e\x71\x6c\x11\xd5\x18\xe2\xc2\x02\xbc\xd5\xcc\xb3\x82\xe2\x3d\x20\xd5\xef\xb6\x43\x49\x2b\x55\x49\x0e\xb6\x11\x88\xad\xc8\x73\xaa\xdb\xf9\x5a\xa6\x82\xab\x7f\xca\x01\xba\x73\x3f\xca\xdd\x53\xfd\x6c\x29\xb2\x56\x67\x50\xce\x4b\x53\x49\x8d\xc4\xb6\x91\x29\x66\x65\x5c\xcc\x20\x4a\x89\xa0\xc2\xbc\x37\x85\x3f\xbd\xa7\xaf\x31\x91\xc2\xa6\x38\x5a\x87\x58\x97\x21\xba\xb3\x3b\x1b\xb5\xeb\xda\x62\xb4\xfd\x61\x6f\x8b\x6f\x72\xee\xbc\xc0\x5f\x3c\xa1\x4d\x21\x12\x8c\xe5\x22\x2b\x23\x13\x2c\x26\xb1\xf5\x82\xea\x64\x70\x59\xd0\xc9\xed\x70\x53\x72\x65\x45\xec\x7e\x87\x32\x65\x21\xf2\x46\x6c\x32\x06\xa8\x86\xa8\xaf\xca\xdf\x2e\x10\x5f\x2f\x34\x50\xbd\xa8\x7a\x66\xaf\xc3\x5d\x75\x77\x01\x7e\xde\xff\xa6\x49\x07\x89\x83\xa7\x4c\x70\x67\xfd\xa2\xde\xeb\xc4\x3c\xdc\xb6\x5c\x3d\x7a\x2a\xc9\xf1\x65\x04\xae\x53\x87\xa3\xcb\xc8\xec\xbe\x5a\x21\x70\x51\xf8\x7a\x63\xeb\x8d\x6c\x0b\x5b\x19\x9a\xbb\x1e\xc9\x6e\x4f\xe8\x47\xb6\xc6\x47\x7a\x5d\x0c\x9f\xd4\x0a\x36\x85\xb6\x35\x05\x5f\x33\x80\x6c\x13\xef\x9f\x24\x44\x08\x5a\x29\x8d\xcb\xe9\x6a\x2c\xa9\xb1\x31\x2e\x69\xed\x85\xf8\xc3\xcd\x71\xea\x90\x18\x06\x59\x27\x58\x29\x5c\x32\x83\x25\xcd\x16\x4b\x31\xd5\xd1\x12\xe4\x7a\xf0\x74\x32\x19\xc1\xf7\x13\xf8\x5d\x7b\x96\x74\xec\x65\x4e\x17\x14\x9f\x5c\xfe\x66\x92\x34\x4e\x21\x2a\x58\x41\x23\xfd\x34\x6d\xc9\xb2\x42\xe0\xab\x1a\x53\xf8\x83\x2a\xb9\x7a\x71\x9d\xf1\x29\x7c\x93\xec\x3c\x55\x5c\x3d\x82\xab\x8c\xae\xff\x2a\x89\x30\x85\x6f\xab\xac\x98\x02\xbe\x85\xb3\x22\xd7\xd3\x76\xd7\xb7\x1a\xf6\x52\x43\x52\xdb\xfe\x14\xa2\xb7\x6f\xdf\x42\x0a\x7f\xfa\xd3\x74\xb5\x9a\x72\x1e\xe9\xbb\xc9\x7e\xea\xca\x3b\xff\x81\x9d\xc2\x91\xa4\xc6\x76\x31\x28\x6f\x32\x85\x6a\x81\xdf\x65\xec\x4e\x45\x13\x5a\x08\x75\x53\xd0\x2d\xff\x9a\x29\x28\x14\x34\x27\xf0\xba\x9e\xc7\xb3\xef\x2a\x9b\x07\x39\xfc\x47\x96\x5f\x52\x41\xb2\x9c\xf7\x66\x17\xf0\x05\xdf\xe6\xfc\x02\xbb\x3d\xa9\xbc\xb3\x2c\x49\x15\x62\xda\xa3\xd6\x1e\x45\x1a\x4b\x54\x76\x82\xba\xf2\x6f\xe2\x8c\xbf\x42\x8b\xff\xfb\xcb\x2f\x34\x11\x9d\x15\x3d\x17\x1a\x07\x5d\xde\xa6\x2b\xdc\xb3\xf9\x4d\x77\xbb\xee\x17\x02\xcc\x2b\xcf\x1b\x85\x96\x71\xd5\xe8\x92\x2d\xe6\x9b\x97\xa5\x68\xda\x2b\x66\x46\xb6\x52\x47\xa0\x72\xaf\x6c\xd2\xf1\xca\x46\xe8\xb4\xb1\x91\x11\x4b\xe8\x7b\x75\xe0\xbf\x21\xad\xc9\xd3\x19\x6e\xad\xbf\xd9\x80\xeb\x07\x79\x19\x5a\xda\x00\xda\xab\xc6\xe8\x0c\xe6\xed\x7e\x6e\x0e\x54\x32\xef\x86\xf5\x2b\x09\xd6\x96\x52\x95\x3a\x97\x8f\xe7\xd6\xea\x04\x42\x68\x15\xf0\xd5\xa4\x43\xbf\x2e\xd6\x6b\xd9\x40\xf4\x97\x9e\x7c\x23\x41\x98\xae\x56\x9c\xfa\xb6\x46\x7f\x5b\x44\xa7\x35\x1d\x68\x65\xe3\x96\x1b\xfa\x4f\x64\x54\x36\x5d\x10\x75\xea\x41\x8d\xc6\x72\x56\xa2\x46\x82\x49\xf5\xa7\x25\xfd\x6b\x49\xde\x36\xed\x2d\x8d\xb1\x47\xa8\x48\x81\x07\xe8\x8a\xad\xfc\xaa\x2f\x3e\x1c\xb7\xfd\x89\x5b\x94\x97\xca\x35\x0e\x04\x66\xcd\x05\x64\x7f\x91\xca\x24\xd6\x19\x0c\x61\x5f\x5a\x60\x35\xd6\xb2\x63\x93\xc5\xc6\x7f\x17\x0b\x21\x97\xa4\x22\x2b\xb7\x13\x91\x3c\xbf\x50\xcd\xa6\xf2\x04\xd9\x99\x4a\xc7\x83\x1d\x7c\x89\x86\x71\xc6\x07\x91\x53\x87\x55\xeb\x15\xb9\xb6\x30\xad\xd2\x3e\x72\x87\x06\xf9\x96\xcb\xd4\x8d\x31\x16\xec\xf8\xe4\xbd\x79\x80\xcb\xec\x25\xce\xe0\xbb\x07\x1b\x1d\xa5\x03\xcf\x22\xd5\x6f\x23\x8b\xc8\x6e\xf9\x05\x15\x65\x7a\xac\xc5\xfe\x1e\xd2\x0d\xd4\x2e\x00\x9f\xdf\x43\x1f\x58\x33\xcd\xce\xb5\xf5\xce\xa6\xe6\xc0\x59\x21\xcc\xa9\x6a\x5e\xcb\x20\x59\x8e\x2c\xc8\x70\xc5\x7a\x9c\xa7\x15\x34\x09\xa7\xed\x5b\xdb\x36\xff\x78\x87\xa5\xad\x56\x20\x35\xb0\x2e\x5b\x90\x9e\xa3\x30\xd3\x53\xb7\x2d\x66\x9b\xc9\xe5\x81\xec\x29\x8d\xb3\x5c\x34\x44\x7c\x8a\x05\x1d\xb8\x19\x92\xf4\xe9\x63\xfa\x11\x34\xd7\x99\x6e\x1f\xac\xe5\x26\x88\xc4\xbd\xf4\x65\xea\xb7\x8e\x8d\x3a\xae\x4d\x54\x94\xc2\xac\x7d\x7a\xb4\x71\x6a\x58\x41\x12\xf1\x85\x18\x4c\xa4\x20\x8c\xc6\x51\x10\x8d\xa6\x41\x44\x63\x9d\x97\x06\xff\xf6\xf7\x67\x4d\xb5\x9c\x25\x44\x5b\x8f\xbc\xb5\x64\x97\x90\xdf\xf2\x76\xd8\xe5\x7b\xa6\xaa\x1a\xf7\xaf\x89\x1f\x75\xe5\xf3\xba\x6f\x8a\x09\x3e\x2b\xa7\x2c\xe5\x95\xf5\xff\x06\x00\xe0\x1f\xdf\x29\x58\xe0\x00\x00")
 
 func cmdInternalPagesAssetsJsContainersJsBytes() ([]byte, error) {
 	return bindataRead(
@@ -126,7 +126,7 @@ func cmdInternalPagesAssetsJsContainersJs() (*asset, error) {
 	}
 
 	info := bindataFileInfo{name: "cmd/internal/pages/assets/js/containers.js", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xa9, 0x8b, 0x82, 0xb8, 0xe0, 0x68, 0x92, 0x21, 0x9, 0xc6, 0xea, 0xfa, 0x2d, 0x53, 0xd5, 0xf3, 0x57, 0x2, 0xe9, 0xae, 0x9b, 0x54, 0x52, 0xc9, 0xa4, 0xf3, 0x48, 0x7b, 0x72, 0x98, 0xd5, 0x8c}}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xd7, 0xc9, 0xe, 0x8c, 0xf6, 0x51, 0xa5, 0x20, 0x63, 0x3d, 0xaf, 0xf7, 0xec, 0x14, 0x4e, 0x47, 0x20, 0xfe, 0x6f, 0x9b, 0xb1, 0xe, 0xe2, 0xce, 0x25, 0xcb, 0x6f, 0x84, 0x9f, 0xae, 0x2a, 0x18}}
 	return a, nil
 }
 
@@ -230,7 +230,7 @@ func cmdInternalPagesAssetsStylesBootstrapTheme311MinCss() (*asset, error) {
 	return a, nil
 }
 
-var _cmdInternalPagesAssetsStylesContainersCss = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\xb9\xd7\x8e\xe3\x68\x9a\x28\x78\xdf\x4f\x91\x3b\x83\x05\xce\x39\xec\x6c\x7a\x57\x85\xbd\xa0\x11\x45\x27\x7a\x7f\xb3\xa0\x27\x25\x7a\x27\x92\x85\x79\xf7\x85\x22\x22\x23\xb3\x4c\x57\x75\xcf\x6c\x20\x95\x11\xfc\xf9\x7f\xde\x7f\xfa\xdb\x3f\xca\xbe\x2f\x9b\xfc\xeb\x56\xcf\x6b\xdc\xd4\x67\xbc\xd4\x7d\xf7\x75\xe9\xfb\x26\x89\xa7\x5f\x8a\xbe\x5b\xbe\xce\xf5\x99\xff\x04\x43\xd0\xff\xfd\x5f\x7f\x7a\xf9\xcb\x9f\xbe\xfd\x9a\xef\x43\x3f\x2d\x5f\xeb\xf7\x4b\x7f\xff\xff\x01\x55\x16\x2f\xf1\xff\x08\x4f\xb5\xb4\xcd\xd7\xb4\xcf\xf2\x5f\xda\x78\x2a\xeb\xee\xeb\x54\x97\xd5\xf2\xd3\x3f\xe0\xbc\xfd\x73\x51\xbf\x43\xbe\x78\x69\xe2\xee\xed\xed\xbb\xb6\x9e\xf9\x1b\x92\xa4\x6f\xb2\xbf\x40\xd2\x3f\xbe\x26\xeb\xb2\xf4\xdd\x2f\x43\x9c\x65\x75\x57\xfe\x84\x0c\xfb\x5f\xc0\x2c\x53\x1d\x77\x65\x93\xff\x32\xf4\x73\xfd\x7a\xf3\x53\x9c\xcc\x7d\xb3\x2e\xf9\xcf\xef\xcc\x43\x3f\x2f\xfd\xf0\x13\xf4\x17\x68\xd2\x78\x78\x7f\x8e\x93\x26\xff\xe5\x59\x67\x4b\xf5\x66\xe1\x9f\xbf\x71\x02\xfd\xfc\xae\x92\x9f\xa0\x9f\x93\x7e\xca\xf2\xe9\xf3\x8f\xaf\x69\xdf\x34\xf1\x30\xe7\x3f\x7d\xfb\xe3\x2f\x68\xcd\x6d\xdc\x34\x5f\xb3\x3a\x6e\xfa\xf2\x83\x14\x0e\x41\x7f\x29\x69\x52\x97\xbf\x06\xa2\xfe\x05\xa0\x1f\x69\xfd\xb9\x6b\xfc\x88\xff\xf7\xba\x4c\xe2\xf4\x51\x4e\xfd\xda\x65\x2f\x71\xfb\xe9\xa7\xff\x4c\xe1\x8c\x2e\x8a\x6f\xca\x80\x87\xfd\xcb\xdc\x37\x75\xf6\xe5\x3f\xd1\x18\x27\x49\xec\x53\x71\xd4\xbf\xc5\xe3\xd7\xe4\x5f\x67\xf3\x6b\x52\xfe\xf2\x7b\xbe\xb2\x2c\xfb\xf9\xf7\xfc\xbf\xf9\xc0\xcf\x4d\x5e\x2c\x7f\xe9\x0a\xbf\x62\x67\xa9\x97\xbf\x8a\xcd\x1f\x39\x7a\xbb\xfe\x07\x4c\xe5\x50\x9e\x15\xf9\xcf\x1f\x4f\x10\x04\xfd\x9c\xae\xd3\xdc\x4f\x3f\x0d\x7d\xdd\x2d\xf9\xf4\xa3\xb6\xbe\x73\x3f\xe5\x4d\xbc\xd4\x5b\xfe\xf3\x0f\x59\x07\x19\x96\x9f\x7f\x1b\x56\x3f\x6f\xf9\xb4\xd4\x69\xdc\x7c\x8d\x9b\xba\xec\x7e\x6a\xeb\x2c\x6b\xfe\x1d\x2f\xfc\x9a\xf6\xdd\x92\x77\xcb\xbf\x2e\xe8\x07\xc0\x1f\x88\x5a\x14\xc5\xa7\x34\xd8\xb0\xff\x8a\xd9\xae\x9f\xda\xb8\xf9\xb9\xdf\xf2\xa9\x68\xfa\xe7\x4f\xf1\xba\xf4\xff\xb6\x35\xbe\xa6\x4d\x3f\xff\xbb\x36\x79\x07\xfa\x81\xdd\x9f\x96\x29\xee\xe6\x21\x9e\xf2\x6e\xf9\xb2\x4e\xcd\xff\x7a\xbb\xf0\xff\x26\xfd\xfe\x8f\xb2\x2e\xfe\xf7\x97\xae\xff\x3a\xe5\x43\x1e\x2f\x5f\xe6\x74\xea\x9b\xe6\x4b\x9a\xbf\x19\xaa\x7a\x97\x04\xc6\x7f\x34\xd4\x6f\x52\x0e\x0c\x0d\xfb\x9b\xc7\xbd\xac\xf9\x91\x4b\xf0\x7f\x2f\x0c\x3e\xf4\xfb\xa5\x2e\xa6\xb8\xfd\x37\x84\xfd\x35\xdc\x8f\xd9\xe5\x1b\xe7\xe4\xdb\xc3\xef\xc2\x36\x69\xe2\xf4\xf1\x5f\xff\x48\xab\x78\x5a\xe6\xaf\x75\xd7\xd4\x5d\xfe\x35\x69\xfa\xf4\xf1\xcb\xef\xfd\x31\xab\xe7\xa1\x89\x8f\x9f\xbe\xb6\xfd\xf9\x79\xb7\xdf\x3f\xcf\x7f\x04\xff\xaf\xff\xf3\xe5\x55\x1c\xbe\xfc\x11\xea\xbf\xff\x9f\x9f\x8a\x7a\x9a\x97\xaf\x69\x55\x37\x19\xf0\x4f\xef\xfd\xf2\x6b\xc4\x9f\x6c\xb6\x79\xb7\xfe\x68\xd3\x37\xe7\xfb\x9e\x95\xdf\xd2\x54\x9a\x7e\xf9\x4f\x82\x20\x3e\xfe\x4b\xd3\xf4\xdb\x85\x79\x39\x9a\xfc\xa7\x37\xe9\xbf\x1d\x7d\xd8\x6a\xd8\xbf\x85\x67\x96\x17\xf1\xda\xbc\x47\xdc\x87\xf7\x7e\x81\xd1\x61\xff\xc2\x4c\x75\xdc\xfc\x7d\x8e\xbb\xf9\xeb\x9c\x4f\x75\xf1\xbd\x3a\xf4\xeb\xf2\xe2\xf1\xa7\xae\xef\xf2\x1f\x03\xe1\x0b\xf4\x07\xfe\xf2\xd2\x5e\x96\xef\x3f\x21\x10\x04\x41\xbf\x12\xeb\x5b\x25\xfc\x51\xba\x2c\xcb\xde\x5c\x15\x04\xe7\xb9\xf9\x47\x39\x2f\xf1\x52\xa7\xff\x48\xfb\x16\xcc\xb3\x7a\xe9\x27\xf0\x1d\xe6\x6b\x52\xfe\x63\xe8\xca\xff\xfd\xe5\xdd\x81\xbf\xee\x5f\x96\x7e\xf8\xf2\xca\x7d\xdf\x4b\xd7\x3f\xcf\x44\x4d\x3d\x2f\x1f\xba\x79\x13\xe1\x43\x30\x64\xd8\xff\x58\x34\xe8\xe7\x25\xdf\x97\xaf\x59\x9e\xf6\xd3\x9b\x5b\xbe\xbf\xfe\x27\x29\xe9\xf7\x12\x7e\xed\xd7\x25\x9f\x5e\xee\xf3\xf7\x3f\x7a\x5b\x77\xdd\xfb\xdb\x5f\xfe\xb9\xd9\x3e\x84\x89\xe3\xf8\xb7\x64\x97\x7e\xf8\x73\x9a\xbf\xfc\xa6\xaa\x7f\x77\x81\x97\xbd\xbe\x89\xf8\x87\x38\xbe\x73\xf6\x0d\xc7\x97\xaf\xf0\x67\x68\x7d\x20\x82\xbe\xbc\x8e\xbe\x21\x7a\x79\x0e\x36\xec\xbf\x0d\x89\x3f\xc6\xfa\x56\xac\x5e\x18\xff\xec\xfa\xb4\xfc\xf1\xf9\x77\x01\x3f\xd1\x7c\x24\xa7\xb7\x9c\xfb\xdf\xc0\xf8\x9d\xb1\x1f\xf1\xfc\xf3\xf8\xfd\x4b\xa1\xfe\x35\xd0\xbf\x66\xe7\x0d\xe5\x6f\xe4\xfb\xe9\xa7\xa9\xef\x97\x3f\x02\xfc\xe5\x2d\xab\x7c\xe4\x42\xe8\x4f\x2e\xfe\xa8\xc2\x7f\x15\xe4\x07\xa6\xfe\x55\x90\x8f\xc6\xf3\x57\x00\xef\x89\xe6\xcf\xa0\xb2\xa9\x1f\xb2\xfe\xf9\x87\x60\x7f\x78\xbf\x9e\x5f\x8d\x6d\xf6\x63\xbd\xae\xdb\xb8\x7c\x8f\xe5\xff\xab\x6e\x5f\xf3\x43\xdc\x2d\x3f\xf7\x43\x9c\xd6\xcb\xf1\xd3\x3f\xd0\x9f\xdf\x92\xfb\x0f\xcf\x45\xdd\x2c\xf9\xf4\x53\xdc\x0c\x55\xfc\xbf\x3e\xce\xff\x1f\x14\xfa\xdf\x7f\x4a\xf0\xcf\x35\xfb\x87\x11\xff\xa7\xa0\x9f\x1a\xfe\xf7\x41\x3f\x34\xfd\xef\x03\x7e\x2a\xfb\x23\xcf\xa0\x28\xfa\x83\xc6\x7e\x9d\x83\x68\x9a\xfe\xfe\xee\xcf\xc2\xec\x1b\xc5\x3f\x2b\x81\x7f\x68\xc1\xef\xf9\xf8\x95\x5a\x7e\x60\xe4\x33\x5b\xfd\xfa\xfc\x0f\xcd\x53\xbd\x5a\xb0\xff\x86\x6d\xfe\x39\xdc\xef\xf2\xf4\x37\x85\xa4\xc5\x97\xff\x24\xe8\xfc\xe3\x3f\x32\x2e\xfe\x82\xb5\x38\x7d\xb5\x18\x7f\x48\xbd\x1f\xf2\xee\x0f\x3a\xce\x24\x49\x7e\x9c\x4f\x3e\xab\x6c\xd2\x2f\x4b\xdf\xbe\x15\xbe\x3f\xa4\x54\xf4\xe9\x3a\xff\xb7\x5c\xf4\xcf\x20\xff\x89\x22\xfa\x29\xee\xca\x3f\x2e\x80\xdf\x52\xc0\x77\x03\xbe\xb5\x0b\x5f\xa0\x7f\xb5\x9a\x7d\x3a\xe8\x8f\xcd\xe9\x7b\xfd\x21\x5f\x05\xe9\x7b\x0b\xf1\xa7\xdd\xc3\xfb\xaf\x8f\xa6\xf2\xbd\x83\xf8\xde\x05\x7f\x45\x29\xea\xad\x28\xfe\x8b\x3c\x7d\x9b\x85\xdf\x57\x08\x7f\xa8\xc5\x5f\x5f\xf9\x6f\x98\xe1\x5f\x40\xf0\xdb\x22\xfd\xb1\xd1\xf8\xe3\x8a\xfe\x89\xef\xe5\x32\x7f\x4e\xf1\x75\xe3\x2f\xaa\xc6\x07\xc1\x6f\x33\xe7\x7f\x0b\xdb\xef\xd8\x7f\xc7\xf6\x2d\xe9\x7c\x2b\x7d\xdf\x46\xef\xa2\x28\xfe\x9a\xd0\x1f\x5e\xa8\xf2\xf4\xf1\xaf\x3a\xf4\x6f\xa9\x66\x59\xf6\x2b\xaa\xf5\x92\xb7\xbf\xfc\xd0\x60\xfe\x75\xf3\xfc\x4f\x7a\x4e\xe8\x57\xfd\x33\x99\xb7\x6f\x81\x81\xbc\x4d\x55\x55\xbd\xe4\x5f\xe7\x21\x4e\x5f\x20\xcf\x29\x1e\x7e\xc7\xc2\x6f\x9f\x5f\xad\xc4\xb7\x18\x7b\x97\x81\xcc\xdb\x6f\x14\x3e\xfc\x02\x79\xdb\x56\xfc\xa8\x84\xae\x7f\xd3\x4d\xd2\xef\x5f\x7e\x8b\xf0\xef\xbf\xb9\x58\xa7\x7d\xf7\xbb\x4b\xbf\xa6\x08\x23\xbf\xc7\x1f\xa7\x69\xde\xfc\x73\xb8\x0f\xc6\xa0\xdf\x00\xbe\x49\xf4\x6d\x0a\xff\x77\x94\xfd\x7b\x2c\x7f\x58\xfc\xde\xde\xbc\xb1\xf6\xf7\x7f\x03\xe0\x37\x0c\xa5\x69\xfa\x4f\xb2\xfd\x5f\xe0\x79\xa9\xf2\x97\xff\x69\x2f\xf2\x86\xa9\xaa\xcb\xaa\xf9\x5d\x0a\x7a\x7f\xf5\x2a\x66\x7f\xb4\x41\x22\x72\xba\xa0\x7e\x53\xd8\x7f\x7d\xf8\xee\xab\x59\xbf\x2c\xf9\xef\x87\xc7\xef\x93\xc3\xd7\xf7\x12\xf4\xea\xfb\x3f\x8f\x96\x7e\x78\x3d\xff\x81\x31\x3f\xfc\xec\xf7\x8c\xbe\x69\xe3\x07\x3e\xdf\x53\xf2\x4f\x9f\xc9\xf9\x73\x29\x41\x0c\xfb\xfb\x8e\x8b\xf8\x93\xed\xc4\xab\x41\xfe\xe3\xf1\xec\xdb\xae\x82\xf8\x23\xf6\x7e\xdb\x88\xff\x05\xcf\x7f\x78\xfd\x4d\x90\x37\x06\xdf\x98\x78\xe7\xe7\x47\x6a\xfd\xfb\x16\x76\xce\x9b\x3c\x5d\xfe\xd0\xc1\x7e\x4b\xf0\x2f\x21\x7e\xa3\xbc\xff\x49\x09\xc4\x5f\xe1\xfb\x05\xfa\xbd\x6e\xde\xe2\xe4\x97\xef\x2d\xe0\xcf\x59\x3d\xe5\xe9\x9b\xf2\x9b\x65\xfa\xf9\xbb\xc4\xdf\x4b\xfc\x9f\x59\xe8\x63\xa4\x7e\x37\xcd\xdb\xd1\xbf\x68\x8e\x77\x3e\x3e\x6a\xc4\x0f\xd6\xfe\x01\xdd\xef\xba\xa1\x37\xc0\xb6\xcb\xdb\xbe\xab\xd3\xaf\x55\xdd\x2d\xbf\xfc\x76\xa0\x5f\xbb\x2c\x9f\x7e\xb7\x78\xf9\x35\xe0\x9c\x0f\xf1\x14\x2f\xfd\xf4\xa3\x1a\x7e\xb5\xb7\xfc\x1e\x02\x6f\x0c\xbe\xe6\xe0\xbf\xfd\xed\x1f\x2f\x1b\x7c\x6d\xe2\x24\x6f\xbe\xfc\xf2\xb7\x2f\x5f\xbe\x7c\xf9\xdd\x72\xf3\x6f\xff\xf5\xb7\x7f\xac\x5d\xfd\xeb\x5b\x1f\x44\xa8\xb7\x9f\x9f\xbf\x03\xbe\x07\x66\xbd\xc4\x4d\x9d\xbe\x41\xbe\xb7\x93\x5f\xd3\x61\xfd\xa7\xf8\x7f\xc0\x07\xbd\xfd\xbc\x01\xd6\xdd\xef\x40\x7f\x43\xf4\xbf\xfe\xf6\x8f\x29\x7e\x7e\x7d\x49\x30\xff\x88\xbc\x88\xdb\xba\x39\x7e\xfa\xf2\x1f\x5c\xbf\x4e\x75\x3e\x7d\xd1\xf2\xe7\x7f\xbc\x93\xf9\xb1\x6c\x7d\x19\xa6\xfc\xeb\xab\x72\xbd\x93\x9b\xfb\xe6\x63\xab\x57\x2f\x4d\xfe\x6b\x8a\xc2\xdb\xcf\xdb\xbd\x21\x2e\x5f\x03\x5f\x9c\xe5\xd3\x97\x0a\xfe\xb8\xf6\xec\xa7\xec\x0d\xd5\x4f\x5f\x92\x29\x8f\x1f\x5f\x5f\x07\x6f\xd7\xdf\xbe\xcf\xf8\x3a\xf5\xcf\x3f\x64\x30\x7d\x67\xf0\x3f\xfe\xfe\xe5\x3f\xda\xbe\xeb\xdf\xf8\xfa\x8f\x1f\xb5\xf9\x32\xdd\x97\xb7\x76\xf2\xed\xf0\x07\x3f\xfa\xf2\xe6\x5e\xef\xc7\xbf\x49\x26\x5f\x96\x7e\x78\x7f\xf1\xb1\x6e\xfa\xf2\x89\xe0\xc7\x2e\xe6\x0b\xfa\x9b\xd3\x77\x87\xfd\xdd\xf1\x2b\x61\xfe\xee\xf0\x23\xb1\xbe\x9f\xff\xd7\xdf\xfe\x31\xaf\xc9\xab\xf6\xc4\xf5\xab\x57\xf9\xd8\x16\x7e\xad\xbb\x61\x5d\x3e\x04\xff\x15\x65\xec\x1b\xb6\xf7\x84\xf7\x05\x83\xde\xd1\xfc\x67\xd3\x97\xfd\x37\x4d\x35\x7d\xbc\xbc\x85\xcb\xfb\xd5\x8f\x1c\xfb\x05\x79\xdb\x9f\xfe\x8e\x41\xe4\xf3\xf4\xf7\x89\xfa\x7b\x0e\xf9\xdd\x8d\x77\x0d\x7f\xb0\xfe\xbb\xb7\x9f\x09\xe2\xdb\xf6\xf9\xb7\x17\xde\x67\xf8\x57\x4a\xfb\x8f\x2c\x5e\xe2\x9f\xde\x9e\xc1\xa1\x2b\x7f\x4e\xe2\x39\x27\xb0\xbf\xd7\x1e\xab\x5b\x4f\x48\xb9\x96\x3d\xc3\x30\x8c\x66\xbb\xd5\xc5\x2d\x19\x86\x25\xdd\xd7\x63\xcd\x31\x12\xc3\x30\x1c\x8b\x98\x03\xca\x30\x8c\x62\xfb\x0d\x6f\xc2\xec\xe9\x3e\x32\x4e\xba\x34\x82\x79\x86\xbb\xee\x84\x88\xea\x48\xeb\xcd\x64\x98\x5c\x1b\x33\x17\xcd\xc2\x07\x98\xfa\xc6\x02\xa2\x91\x49\xfb\xe6\x72\x7f\xce\x51\x36\x97\x26\x53\x4b\xc4\x53\x3a\x58\x36\xea\x2b\xce\x66\xae\xd7\xcb\x85\x95\x99\x3d\xf4\x25\xae\x09\x5d\xc1\xba\xa6\xb6\x10\x5c\xca\xc5\x55\x6c\x19\x91\xb0\x91\xe9\xd7\x6b\xc6\xd6\x7d\x15\xd7\xb0\x97\xea\x3a\x56\xa0\x4a\x0e\x17\xc1\xb4\x91\x39\x4a\xc2\xe0\x4a\xe1\xf8\xd9\x81\x37\x1c\x3f\x9f\x00\x73\xb1\xa4\xf6\x91\xc7\x75\x5c\x32\xb8\xdb\xbb\x94\xbe\x94\xc5\x1d\xa2\xa5\xfd\xd6\xd2\x25\x23\x79\xb6\xce\x5c\x24\x96\x6f\x0f\x4a\xee\xd8\xd4\x65\x18\x83\xe1\xf3\xa0\x02\x32\x95\x01\x41\xe6\xba\x25\x4f\x86\x63\x44\xde\x53\x6b\xd3\xde\x01\x11\x2c\xc9\xc1\x94\x9b\x94\x91\xe4\x0b\x83\x71\x92\x8d\x3f\x67\xf6\x21\x48\xcc\xc1\xe1\xee\xc1\x9a\xcc\xc1\x96\xcc\x2c\xe8\x67\xc4\xf4\x4c\xc3\x30\x4c\x22\x36\x94\x59\x4b\xcc\x58\x42\x0c\x69\x58\xb2\x51\xba\x0c\xa2\xb4\x0e\xca\xa6\x0c\xa2\x48\xe9\x68\x96\xcc\xdd\xbc\x30\xad\x5d\x89\x32\x23\x33\xeb\x93\x2d\xbd\x50\xb0\x55\x46\x65\x9e\x25\x57\x4e\x17\xa6\x56\x4b\x87\x99\xca\x2b\xe3\x27\xe1\x61\x3d\x15\x66\x33\x5d\x26\xeb\xf1\xf0\xc1\xd0\x4c\xc8\x70\x4c\xeb\x4a\xf3\xc9\x30\xa5\xce\x48\x8c\xb9\x63\xce\x69\xde\x4a\x87\xd1\xcb\x9b\xa6\x80\x98\xd2\x14\xa9\xe4\x63\x36\x73\x61\x9e\xb7\xd5\x3b\xa3\x4c\x85\xee\x37\x77\x95\x03\xf3\xda\x15\x07\xa5\xf3\xb5\x94\xab\x5c\xa2\x0a\x5c\x68\xa5\xc3\xe5\x1a\xca\x38\xa7\xa4\x0d\x3e\xdf\x2f\xd0\xbd\x63\xa0\x53\xe7\x19\x86\xb9\x6e\x9d\xf5\x2c\x30\xcc\xc0\xf9\x0e\x5f\x9d\x0a\x6f\x93\x2e\xd1\x41\x68\x8f\x55\x20\x06\xd9\x4d\xba\x63\x15\x95\x81\xc0\x26\x3d\x39\x96\x61\x4c\x67\x06\x37\xa4\x01\x1b\x3c\x0b\xf9\xf4\x96\xb2\xb3\xe8\x83\x31\xd1\x24\x8c\x36\xf8\xcc\xf5\x5e\x00\x1e\x74\x22\xcf\xb6\xf4\xcb\xc9\xcc\xb7\x56\xcd\x9d\x3b\x65\xb2\x44\xde\x7b\xbc\x64\xb0\x50\xca\x95\xb3\x4a\xda\x5e\xdd\x27\xd0\x13\xc3\x66\x00\x3c\x8d\x2a\x01\x38\xb5\xd8\x12\xca\x64\x49\x00\x41\xa7\x27\x23\x5e\x63\x53\x6e\x97\xa7\xa2\xf3\x60\x20\x54\x49\x17\xaf\x6e\x4f\x76\xc7\xd3\xe2\x6e\x3e\x89\xe2\x87\x01\xec\x98\x07\x82\x21\x36\x40\xb9\x04\xcd\xde\x73\x16\xc2\x69\xf7\xa5\x16\x73\x39\x59\xdb\xd1\x16\x77\x07\xe1\x22\xa5\xad\x87\x55\xea\x50\x9c\xea\x2e\xe2\x09\xcd\x45\x1a\xca\x9b\xdc\x5c\x3d\x40\xc6\x99\x42\x71\x49\x97\xf9\x29\x92\x2b\x63\x2a\x2a\xdf\xdc\xed\xac\x64\x99\x62\xbb\x2d\x77\xb6\x57\x1f\x26\x63\x46\xa7\x79\xe4\x45\xca\x30\xb2\x0e\x02\x21\xcd\x28\xcf\x0b\x73\x46\xf9\x03\x7b\x32\xcc\xa6\x97\x61\xa0\xd4\xae\x00\x9d\x57\x8e\x61\x18\x4b\xe1\x58\x65\x62\x59\xf6\x79\xb2\x82\x72\x32\xfc\x70\xb2\x62\x72\x32\xc2\x53\xae\xd8\x87\xc5\xf0\x9c\xc3\x18\x2c\xcb\xb1\xab\xcd\x88\xcc\x58\x5d\xe5\x88\xbd\x7a\x0e\xa3\x2f\xe5\x65\x49\x18\xfe\x3a\x32\x56\xbb\x5e\xcc\xe5\x76\xd9\x75\x06\xed\x30\x56\x4e\xcb\x7c\x79\x0a\xcf\xa8\xbc\xca\x24\x77\x55\x8f\x2b\x67\x5a\xec\xc1\x94\x4e\x68\x5f\x88\x9a\x15\xea\xfb\x52\xd6\x67\x59\x06\x6d\x88\x5d\xa4\x56\x90\x34\x59\x61\x06\x8e\x1f\x6a\xd6\xb2\xa4\x39\x78\x39\x21\x37\xba\xf2\xf8\xf0\x60\x49\x02\xa5\x25\xad\x4c\xfc\xee\xed\x0c\x30\xf0\xc9\xc1\x31\x47\xbd\x1d\xca\x76\x49\xf7\x46\xba\x1e\xae\x69\xb8\x4c\xe7\x31\x6b\x5c\x16\xd7\x7e\xba\xf6\xa2\x61\x65\xbc\x69\x04\x21\xe7\xf7\xed\x66\x5d\x27\x33\x37\x42\x0e\xe9\xc7\xc2\xb2\x81\x0a\xce\x18\x96\xae\x7b\xfc\x5e\x6a\x97\x9d\x6f\xe3\x1b\xe2\x0b\xe9\x5d\xca\x84\x50\x6a\xc2\x6b\x9d\x88\x42\x6b\x34\x3e\xef\xf9\xf7\xd3\xbb\x8b\x5e\x89\x66\x95\x9b\x3c\x65\xbc\x22\x9f\xe5\x83\xe9\x57\xe6\x02\x3c\xa5\xa0\x37\xcd\x67\x7b\x70\x0f\x99\x15\xfd\xcb\x1e\x9a\x44\xd9\x90\x9c\x0e\x5d\xa6\x5e\xf2\x9e\x2d\x5f\xf1\x22\x27\x1f\x8a\x33\x5e\x00\x48\x0e\x7a\x1b\x48\x2d\x2c\xbc\x8f\xfb\x43\xa8\xae\x13\x97\xac\x92\x85\x55\x5d\xed\xe5\xf2\x13\x73\x02\x5c\x93\x64\x86\x94\x1f\xab\xa5\x73\xf5\xa5\x79\xf0\xb2\xcc\x41\xcd\x64\x6b\x8b\xa4\xc6\x96\x33\xf0\xa4\x7d\xb9\xb6\xa9\xc3\x25\xd3\xe3\x8a\xca\xe8\x6a\x4a\x73\xcf\xcd\x92\x9c\x5b\x0b\xce\x18\x8f\x0b\x60\x03\x54\xc7\xe3\x6a\xea\xea\xb3\x2f\x61\x8f\xa7\xdc\xd7\x96\x3d\xc8\xa6\x6d\xdf\xbd\xc6\x00\xdc\xcb\x10\x51\x87\x39\xb4\xe5\xd8\x88\xda\x18\x91\x2e\xa7\x8c\x92\x3e\xd0\xb1\xed\x23\x65\xd6\x30\x9b\x8b\xe5\xc3\xe5\xb4\xe0\xb6\x0e\xb4\x76\x8f\xf8\x83\x30\x97\x9e\x0e\xcc\xb6\x6d\xba\xb8\xc8\x7a\x15\x68\xa0\xb5\xec\xb2\x72\xc7\x85\x86\xe6\x02\xa8\xdd\x7a\x16\xe8\x3a\x22\x34\xa0\xec\xf9\xd4\xaa\x12\xe1\x77\xa8\xaa\x15\xb6\x99\xae\xc1\x1c\x0d\x7b\x8d\x5e\x6d\x20\x72\x94\x50\x80\xf0\x3c\x3a\x04\xf3\x09\x9f\x52\xd2\xfa\x79\xc8\xe3\xd5\xe6\x79\xe5\x48\xd7\x03\x25\x44\xa2\x72\x19\x5c\xa2\xf1\x62\xaf\x18\x82\x6e\x6c\x2c\x2f\x85\x5d\x10\x0e\xda\x91\x83\xed\xc9\x33\x81\x29\x68\x22\x7a\x78\x44\xde\x0c\xf3\xf7\xd1\xec\x5c\x61\xdc\xbc\xd1\x82\xbd\x95\x78\x20\xd3\x8c\xdb\x19\xac\x18\xee\x8d\xb6\x02\x42\x07\x02\x10\xe1\x80\xf6\xa6\xcd\x7d\x2c\x58\x23\x77\xb5\x15\xcd\x0d\xc9\xe2\x7a\x0d\xa1\x4a\x98\xc3\x23\x2a\x84\xd0\x5c\xa2\xc3\x2b\x8d\x49\xbd\xae\xa1\xe3\xcb\xc9\xbc\x75\xb3\x23\x86\x02\xb0\x2f\x88\x8a\x2e\x37\x17\xd2\xce\xb1\x8d\xe7\x68\x10\xda\x2e\x8f\xf6\xd3\xbc\xe8\xfb\x24\x96\x14\xdf\x45\xdd\x65\xd2\xa5\x14\xd3\xce\x6e\x5e\x98\x03\x02\xd5\x44\xf4\xe3\x11\x6e\xa7\x80\x58\x34\x7a\x20\x90\x89\x8c\xc3\x15\x3e\x48\x2b\x59\x08\x7a\xda\xb2\x80\xcb\x4c\x19\x9a\xbb\xc2\x25\x12\x67\x42\x33\xb3\x25\xb4\x69\xb0\xd6\xde\x27\x10\x34\x30\x88\x3b\xe9\x84\xc0\x82\x13\x66\x07\x1f\x9b\xab\x13\x19\x6a\x59\xa0\xb7\xd0\x8a\x01\x0b\xc0\xb4\xc1\x2b\x68\x89\x67\xfa\xd4\x98\x12\x79\xb0\xcd\x7c\x73\xaa\x3b\x75\x3b\xcc\x10\xa8\xa6\xf6\xda\x62\x50\xc1\x5d\xb0\xb8\xb4\x0e\xa4\x26\x1e\xa9\xd5\xf7\xb4\x20\xf7\x47\x8e\xc9\x2b\x21\x46\xc0\x0d\xce\xd9\x14\x88\xc6\x7d\x13\xaf\xca\xac\x69\x78\x83\x5e\x67\x2a\xf4\xe5\xe1\xb6\xac\x8f\x5d\x71\x04\x5c\xc5\x86\x33\xb2\xa6\xb6\x8d\x09\x28\x71\x9c\xec\x76\xde\x0e\xee\x4c\x27\xad\x9e\x8f\xfc\xd0\xf5\xcc\x4f\x63\x1c\xaf\xb6\xa5\xa5\x10\xf0\xd0\xaa\xd8\x13\xba\x9b\xa7\xd4\x67\xac\xee\x78\x12\x37\xdd\xa9\xb2\x51\xa4\x35\x5b\x3e\xb7\x42\x08\x78\x5d\xe3\xd3\x5e\x12\x4c\x97\xce\x0f\xf4\x79\x26\x10\xd4\xf7\x90\xdd\xbc\xc2\x79\xc2\xcb\x33\xd1\x52\x2b\x32\x2a\x48\x32\xa5\x16\xd2\x22\x68\x21\xe8\x92\x7b\x3f\x3a\x5b\x29\x3c\x27\x59\xfa\x9d\x34\xce\x99\x5b\x8f\x98\x64\x92\x45\x2f\xa2\x8a\xae\x03\x24\x29\x12\x43\x4c\x9f\xe9\xa5\x42\x7d\x1d\x35\xc9\x42\x94\xa1\x2c\xdd\x91\x44\xe4\x28\x82\x70\x2a\xed\xa6\x0f\xbb\xd2\xf1\x84\x97\x75\x57\x24\xaf\x52\x1c\x3d\x35\x2b\x6f\x6d\x9c\x57\x2f\x43\x86\x34\x7b\xe6\x24\x71\xae\x0c\x08\x51\xe4\xf3\xea\xe1\xd6\xb4\xae\x8b\x02\x43\xa0\x7f\x6f\xc9\x35\x41\x15\x34\x4a\x32\x27\x3b\x79\x54\x28\xe2\x8d\x74\x6c\x22\xb9\x52\x4d\xf6\x3c\xd1\xcd\x2c\x9c\x27\x0d\xdf\xeb\x34\x3d\xef\x5d\x9c\x27\x03\xa5\x9e\x0f\x3d\xdd\x82\x2e\xca\x7d\x8f\x24\x37\x0b\x37\x66\xfc\xd9\x76\x4b\xf8\x4c\xd2\x7b\xb2\x0b\x5b\xb9\xa5\x0b\x19\xf2\x3d\x60\xf8\x01\x71\xae\x0f\xde\x98\x43\x80\x19\x68\xa2\xc3\x72\x60\x98\xb0\x1a\x3d\x11\x40\x42\x49\xbf\x18\x1a\x80\x05\x8f\x1c\x38\x37\xe2\x00\x11\x03\x50\xc1\x2a\x2f\x24\x9f\x72\x31\x21\x64\x44\x96\xc9\x5d\x76\x0b\x2f\x71\x99\xdc\xf8\xf8\x79\x2b\x58\xf9\x71\x19\xcc\x14\x66\x43\x48\x58\x9f\xe1\xc6\xf3\x98\x67\x96\x4f\x99\xf7\x1e\x45\x3a\x68\xdb\x93\x2d\x8e\x2b\xd8\xdc\x6f\xf4\xde\xa9\xf2\x64\x09\x69\x2f\x75\x96\x17\x5f\xc1\xfe\x79\x72\xd1\x4d\x5c\x42\xeb\xde\x27\x06\xa7\x51\x66\xd1\x02\x2d\xe0\x9e\xc3\xa9\x86\x52\xad\xb3\x62\x22\xd5\x98\x88\x3c\xa2\xd6\x2a\xf0\xc2\x32\x07\xe5\xae\x8c\xea\x3a\x58\x8b\xa0\x41\x69\xe0\xa0\xaa\x16\xcf\xb8\xd0\x91\x11\x89\xe8\x54\x08\x0c\x77\xf5\x41\x22\xc8\x88\xa4\x5d\xd4\x4d\xd7\x61\x76\xdb\xfd\x4e\xca\x2b\x56\xaa\xf5\xb8\x4a\x02\x56\x81\x95\x40\x45\xa9\x9d\xb1\xb7\x07\x97\x23\x32\x7e\x73\xc2\x9d\x47\x64\x0c\xbd\x71\x38\x72\xc4\x37\xfc\x7c\xb4\x8d\xed\x67\x57\x7d\xc5\xb3\xa3\x5e\xad\xa2\x25\x52\x52\xed\xba\x94\xf2\x1d\x2f\x7a\x88\x4f\xac\x8a\x4a\xbb\x21\x25\x10\x0f\x6f\xa5\xd9\x30\xdb\xf5\x02\x36\x03\x82\x6b\xa2\x65\x1c\x95\xbc\x0a\x0e\xe4\x03\x7d\x8f\xd4\x6e\x72\x45\xd7\x4b\x4f\x3d\xd6\x38\xf3\x86\x00\xd1\x35\x88\x2c\x5c\x83\x88\xa5\xc8\xb0\xba\xe0\x7a\x7b\x36\x86\x5b\x2f\xf8\xc3\x6a\xed\xd5\x36\x4e\xc7\x8b\x2e\xf0\x42\xcc\x27\xdd\xec\x88\x0d\xe1\x8d\x7c\xb8\x9d\xa2\xd9\xbc\xdf\xfa\x0b\xb7\x05\x37\x35\xed\x17\x88\x6e\x95\x63\x75\xf8\xdc\xc2\xf1\x16\x55\xae\xb8\x2e\x1e\x5b\xf1\x90\xc0\xe6\xa4\xe8\xd9\x4b\x24\xef\x06\xc5\x3a\xe4\x8e\xb8\xc9\x57\xd1\x4d\x25\xca\x29\x17\xe6\xd0\x47\x6b\xcc\x56\x61\xc9\x5f\xcc\x13\x45\x12\x80\xd8\x1e\x77\x0e\x93\xfc\x42\x45\xcc\x59\xe0\xb0\x59\x35\xc2\x55\xac\x22\x2a\x67\xea\x0d\xf2\x49\xa1\xd6\x55\x77\xcf\x50\xf5\x2e\x64\xee\x34\x3c\xf2\x6b\xf7\x84\x3a\x54\x8d\x6f\xeb\xd1\x6c\xb0\x89\x35\x95\xed\x15\xf2\x98\x67\xe7\x71\x6f\x14\x1e\x1a\xfd\xc6\x3c\x56\xce\x3b\x6d\x6f\x8c\x1c\xf9\x40\x88\x95\x16\x46\x3c\xdc\xbd\xaa\xf1\x2d\x8f\x44\xc6\x00\xf6\x16\xbf\x38\xfa\xa0\xf1\x80\x39\x55\x8e\x7e\xda\xea\xa7\x35\xdd\x5b\xac\xc9\xf4\x80\xf6\x28\x3a\x68\x16\x64\x99\x72\x7b\x2a\x14\x32\x5f\x24\x82\x70\xa6\x64\x3c\x51\x3f\x01\x36\x7b\x82\x47\x33\x0b\xcc\x6a\x63\xb4\xc9\xda\xf6\x7b\xee\x26\x01\x02\x2b\x68\x67\x57\x79\x3b\xc1\xf7\x19\x81\x5e\xd9\x81\x39\xbd\x4a\xcb\xe1\xe4\x54\xe3\x19\x01\x95\x4a\x1b\x0d\x24\x1f\x60\x30\x48\x17\x1f\x8c\x91\x69\x21\xc9\x48\x5e\x26\x7f\xa3\x61\x34\x07\x6f\x7a\xb9\x43\x9e\x4e\x93\x5b\xa3\x1f\xd4\x62\x2f\x98\x87\xac\xb4\x15\xa0\x70\xb2\xa8\x6b\xb9\x20\xc6\xb5\x95\x92\x75\x02\xdb\x62\x41\x30\x0b\xcd\x8c\x3d\x22\x8c\x9c\xf0\x48\x16\x8c\x04\x12\x58\xc6\xec\x4a\xcf\xa8\x02\x80\x1b\x29\xc9\x89\x27\x21\x77\x0b\x97\xf4\x8e\x6f\x5a\x8c\xdd\x90\x61\x5b\xda\x2e\x87\x53\xa1\x1f\xf9\x59\x66\xf1\xf4\xd2\xa1\x44\x44\xe5\x80\x66\x12\x8a\xa8\x3f\xe9\x93\x3f\x33\x20\x2a\xc8\x02\xe6\xf4\x45\xca\xed\xcd\xd6\x27\x1a\x9e\x38\xe4\x9e\xc4\x0c\xdd\x27\xe8\x46\x48\xc7\xbd\xb3\xe0\x5c\x74\x73\x60\x01\x69\x80\x3c\xc5\x7b\x88\x06\xa2\x16\xd2\x41\x37\x72\x99\xc2\xa3\x3b\x90\xdb\xdc\x03\xd0\xbb\x48\xc9\xf3\xce\xc5\x40\x23\xb3\x23\x54\xec\x50\xc7\x38\xa2\x18\x86\xad\x20\x81\x3b\x93\x02\x72\x63\xcf\x20\xf2\x71\xa0\x01\x58\xc8\x14\x4d\x76\x77\x18\x7d\x6e\x22\x0f\x49\x5b\x56\x23\x87\x8f\xda\x04\x44\x4f\x77\x58\x2c\x3a\x9e\xc6\xdc\x7a\xf3\x11\x70\x8a\x8a\x42\x25\xbd\x94\x9a\x3a\x07\x72\xf2\x99\x84\x72\xd0\x29\xc0\x61\x7c\x6a\xde\x09\x83\xf7\x40\x6d\x90\x22\x4f\xd0\x6d\x5f\x9f\xc9\x82\x60\x2d\x6a\x1d\x98\x9c\x4c\x30\x20\x26\x08\x69\x65\x28\xb7\xe2\x37\x54\x80\x89\x3b\x5a\x6f\xa0\x4a\xc6\x60\xde\x8b\x40\x09\x8e\x05\xe9\x00\xb3\x1f\x30\x49\xce\x63\xe1\x8d\x2d\x4f\x4a\x8b\x78\x80\xd2\xb9\x30\xcf\xc1\xc1\x01\x21\xac\xa8\xa0\x47\x71\xb3\x68\xf7\x6e\xd5\xba\x76\xc7\xa1\x42\xdc\x67\xed\x3a\xa1\x47\x74\x23\x1c\x2c\xce\x8f\x61\x37\x8c\xa3\xc3\x7d\x10\xed\xa6\x94\xd8\x3a\x14\x44\x6b\x00\xda\x0c\xdf\x38\x43\x8a\xd9\xf0\x9a\x02\xc0\xa9\x6b\x07\x0a\x55\xb7\x91\x68\x37\x7d\x4e\xef\xfb\x0e\x50\x67\xf7\xa0\xa9\x38\xa1\xa6\x94\x4c\x8a\x1c\xc0\x48\x72\x2a\x76\x12\x2a\x5a\xfb\x4e\x99\x06\x6e\x52\xb4\x48\x84\x54\xde\x41\x17\x10\x35\xd6\x8c\x02\xb7\xe7\x46\x63\xce\x09\x40\xa0\x2c\x45\xd4\x6b\xce\xbb\x86\x8f\x45\xb4\x18\x1e\x64\x0c\x8a\x01\x09\x8e\x1e\xf0\xdb\xeb\x5c\x4e\x2f\x55\x94\x3e\x19\x46\xad\x6f\x0c\xc3\x61\x77\x26\xa8\x1b\x03\x0d\x5f\xef\xc4\xec\xd2\x68\x96\x59\xe4\x6c\x69\xda\xd2\xdd\xb8\x33\xeb\x6b\x52\x64\x4a\xe6\xd2\x5c\x4c\xcf\xc2\x90\xf5\xcc\x32\x84\x4d\x60\xb4\x43\x53\x5f\xb2\xa4\xcb\xc5\x96\xd5\x3b\x3a\xd4\x96\x9e\x84\x4d\xbc\xd4\x41\xd4\xac\xe6\x92\xa7\xfd\x7d\x8e\x90\x87\x9f\x22\x3a\x74\x44\x0b\x46\x5a\x1b\x84\x5a\x0f\x7d\x6e\x93\xa1\x6d\xae\x18\x09\x37\x3e\xa5\xc5\xf0\xc4\xb4\x15\x64\x29\x22\x27\x35\xe4\x1d\x62\x1f\x25\xcb\xde\xa5\x0d\xe5\x09\x0f\x10\x4d\x5a\x90\x7a\x81\xe4\x31\x7e\xc3\x9f\x00\xfa\xb0\xdb\x14\x3f\xc9\x8e\xba\xa9\x14\x56\xb0\x69\x93\x15\x13\x6f\xbe\xc9\xc4\x48\x5c\x89\x89\x0c\xc1\x3f\x5d\xa6\x60\x24\x73\x5e\x69\x28\xbf\x5a\x8d\x2c\x62\xdc\x34\x28\xa1\xf0\xed\x92\xeb\xc1\x0e\x40\xc5\x49\x02\x3f\x3a\xb9\x2d\x85\x52\xe0\x1d\x88\x36\xc8\x0a\xa4\xf1\x75\xd2\xa7\x16\x0e\x19\x0f\x02\x05\xb0\xbb\xbc\x81\x98\x1a\x17\xb6\x51\x87\xdf\x77\x90\xde\x75\xef\x50\x3d\xe4\xc8\x35\x84\x58\x1c\xd1\x82\x0a\xf3\x62\x6a\xfc\x44\xe4\x10\x9d\x1b\xac\x69\x23\xcb\x64\x3f\xa6\x43\x38\x41\x50\xb7\x7a\x2e\xe0\xd1\x23\x35\x5f\x58\x58\xc6\xd5\x1a\x10\xd3\xb9\xea\x09\x15\xdc\x73\x56\x20\x7f\x45\x8f\xc8\xdd\x82\xc9\x83\x1c\xc1\xf2\x04\xff\xc9\x5c\x86\x82\x04\xc0\xf2\x26\xf2\x30\xdc\xc7\xc9\x23\xa5\x32\x18\x25\x4f\xb8\x19\x6c\x1f\x62\x93\x8a\xc0\xf5\x77\x59\x4b\x85\xad\x17\x7a\x03\xc1\x30\x05\xb3\xa9\x49\x1b\x10\xe8\x9c\x31\x27\x3b\x59\x4a\xee\x6e\x1c\x33\x07\xdb\xf3\xcc\xf3\x26\x76\x67\xe7\x0a\xda\x49\xa1\x5b\xd5\xdd\x1f\x2b\x39\x2e\x05\x10\xc9\xca\x10\x4b\xf8\x53\x73\xef\xe2\x33\x7d\xc3\xc7\xca\x33\x8a\xd2\x03\x4e\x74\x8e\x07\x80\xe0\x23\x7d\x8c\x40\x36\xc3\x23\x3d\x48\x3c\x27\x9a\x6f\xa8\xd0\xb3\xa3\xc0\xd6\xa1\xe6\x65\x59\x37\x3f\x10\xa8\x36\x80\x58\xb2\x6c\x3f\x79\xba\x32\xa7\x76\x27\x81\x07\x4e\x01\xb2\xdb\xd9\x2c\x48\xa4\x0d\xbb\x33\xa6\xb1\x62\x11\xff\x9c\x55\xee\x79\xdc\x36\x45\x4e\xd0\x5d\x1b\xe9\xc0\x96\x73\x2e\x7e\x48\x14\xff\x6e\x17\xd5\xbc\x51\x14\x00\xd3\x16\x85\xd9\x06\x28\xd8\x0f\xbc\x94\xd8\xb3\xf2\x1d\x6a\x5e\x37\x7f\x4a\xe0\x1d\x7b\x86\x31\xeb\x3d\x27\xea\x9b\x5d\x58\x76\x8c\x91\x0d\x24\x73\x10\x4c\x25\x77\x83\xf4\x06\x3a\xf0\x70\xf5\x93\xa5\xcd\x02\x0b\xd2\x74\x88\x93\x94\x13\x00\x7b\x01\xe5\x1b\x68\xb8\x9a\x94\xca\xd1\x5a\xaf\x09\x20\x48\x0c\xd9\x3d\x6c\xe5\x5e\x40\xb9\x05\x90\x21\xf5\x0d\xe1\x85\x31\x8f\x79\x53\x1d\x17\xd7\xaf\xd5\x33\x05\xa6\x72\x4b\xd5\x26\xdd\x21\x20\x9d\x94\x71\xf5\x13\x19\x83\xb2\x11\x8e\xa1\xf3\x61\x97\xcc\x05\xa2\xb7\x84\xa6\x88\xea\xb9\x67\xb1\xbf\x91\x35\xbd\x24\x35\x56\xfa\x17\x8c\xe2\xe7\x59\xa4\x3e\x14\xcb\xa5\xce\x80\xac\xf9\xa6\x2f\x9d\x57\x9c\x8d\x37\x2e\xd9\x76\xad\x2b\x7f\x0b\xa6\xbe\x55\xef\xb1\xb7\x31\xac\x13\xc0\x59\x40\x9f\xa3\xc8\x4f\x56\xba\x05\x6b\x10\x14\xc0\xb8\x5b\xa8\x76\xcc\xeb\x23\xb9\x31\xdb\xdb\xdc\xc9\x30\x1c\x83\xe9\x16\xa4\x6f\x20\x8c\xa2\x14\x76\x3b\xb5\xb3\xf1\x80\x0d\xf2\xcb\x51\x99\xc7\x2d\x20\x0b\x3f\xe1\x13\xc7\x30\x39\x25\xbe\x82\x9b\x2e\x3a\xd8\xa1\xf8\xc8\x32\x6e\xa0\x02\x35\xb2\xed\x81\x04\xb6\x66\x83\xe8\x30\x64\xa7\x50\xdc\x1b\x7f\x4c\xa5\x63\xb9\x58\x09\xb9\xd7\x0c\xc4\x3a\x4e\xa0\x3a\x19\x16\x6e\x24\x39\x12\xcd\x48\x31\xbd\xf4\x31\x4d\xf4\x1a\xb8\x32\x65\xf0\xd0\x45\x92\x17\xb5\xa1\xa7\x5c\x0f\x0e\xac\x27\x8c\xd3\xdf\xc0\x9a\x6a\x3d\x95\xb0\xb0\x67\x0c\x38\xf7\x6f\x31\xb6\xf8\xce\x8e\x8d\x9b\xb1\x1f\x80\x23\xeb\xdd\x8c\x24\x13\x01\x74\x86\xc0\xed\x51\xba\x20\xf8\x12\xb7\xbd\x92\x98\x4e\xe9\x41\xa8\x26\x60\x58\xaa\x67\x53\x06\x9d\xba\xba\x11\x9b\x17\x57\x99\xdf\x68\x1a\x1f\x6a\x89\xb4\x3f\x3f\x6c\x6d\xcf\xb4\x61\x43\xb8\x1e\x34\xcf\xd4\x60\x63\x90\x90\x1e\x76\x9e\x26\xd7\xaa\x8f\xaf\xd8\xac\x70\x04\x40\x26\x2d\xec\x47\x75\xa2\xb1\x3a\x9b\x04\x23\x88\xa2\x46\x7b\x7d\x42\xb9\x7e\xae\x52\x4e\x47\x64\xcd\x16\x37\x8d\x2d\x0d\x8d\xbd\xc6\x5a\xc1\x3e\xdf\x4d\x7e\x7b\xfa\x2a\x3c\x62\x39\x05\x26\x33\xb8\x92\x2f\x38\xf3\xb1\xa9\x55\x14\xfb\xa8\x7c\xa4\x04\x1d\x2f\xaa\x7d\x97\x06\x9c\x62\xf0\x07\x61\x0f\xbc\xd6\x11\x18\x96\x17\x41\xf6\xc8\x44\x47\x5f\xc8\x4a\xa0\x59\x3d\xe4\x40\x79\xff\xf0\xa1\xa7\x0f\x57\xd6\xd6\x15\x45\xd1\x83\xe2\x0d\x4f\x83\x38\x07\xfb\x58\x07\x7b\xfb\x42\x1b\x2b\x42\x66\xeb\x2a\x09\x14\xf0\x18\x4b\xad\xd9\x57\x1a\xf5\xb4\x24\xd8\xb6\x6e\xbb\x85\x53\xa0\x83\x99\x77\x93\x1b\x0d\x28\x2a\x3c\x63\xf3\x98\xfb\xce\xe7\x71\xb3\x5a\xcd\x0a\x7d\xc1\x49\x41\x23\x0d\x88\x11\x5d\x00\x30\xc2\x71\xdc\xb0\x1f\x43\xbc\x22\x11\x6c\x05\x6d\xe4\x8f\x48\xbb\x99\xdc\xfa\x98\x8d\x4a\x17\x7d\x82\x98\x1d\xd9\x85\x35\xc7\x25\x71\xe2\x74\x5d\x55\x81\xcd\x51\x48\x20\x01\x7f\xa8\x0d\x1d\x4c\x03\x53\xbe\x87\x6e\xcd\xbb\x70\x80\xde\x5b\x24\xcf\x28\x00\x1f\x77\x79\x31\x46\x38\x5e\x7d\xb5\x69\x67\x08\xe8\xfa\x9b\xc4\x4c\xad\x7c\xa4\x86\x48\xd6\xb8\xde\x8e\xc4\xd0\x46\x57\x0b\x00\xa6\xcb\xd8\x00\xeb\x18\x80\xf4\x30\x6b\x8b\x56\x85\x1c\x19\x1a\x4f\xec\x0d\x29\x27\xaa\x67\x5d\x8e\x9a\x35\x1a\x5a\xb4\x19\x55\x0c\x8f\x33\x3c\x20\x64\x36\xd1\x27\x61\x70\x0a\x9e\xce\xa3\x47\x10\xd2\x5d\x3a\x1e\xe7\x93\x01\xc2\xab\xf5\xc0\x6f\x35\xb9\x03\xd3\x74\x92\x5d\x96\x0c\x00\x98\x0b\x51\x2b\xaf\x17\xec\x26\x16\xf3\x67\xae\x52\x29\x14\xed\xce\x29\x13\x7c\xb8\x83\x31\xca\xe0\x77\xea\xc8\x65\x61\x84\xf3\xad\xbe\xfb\x51\xcc\xfb\xcc\x2b\xd9\x90\x03\x91\xf7\x50\x6e\x68\x1e\x80\x49\x97\xd6\xee\xb0\x18\xa3\xa3\x70\x51\xe0\x74\x1e\xe8\x80\x11\x55\x01\x14\xdf\x83\xc0\x04\x6f\x83\x62\x83\x48\x70\x3f\xd7\xec\xac\x6e\x9d\x83\x23\xeb\x58\x63\x8b\xea\xb5\x67\xdc\x78\x63\x5c\xf6\x1a\x0b\xc9\x3e\x85\xf9\x7e\x48\xe5\xfa\x82\x66\x10\x20\xba\xee\xe2\x0d\x99\xdf\xc8\xb7\x05\xbb\xe2\xd2\x4e\x7d\xa0\xb3\xc1\x4e\x3e\x67\x42\xbf\xf7\x47\xda\xc8\xe0\x13\xad\xa8\xe0\x3e\x12\xeb\x34\x11\xeb\x74\x52\xa0\xea\x4f\x02\xee\xf9\x42\xc5\x20\x98\x68\x62\x41\x4c\x9e\x74\xe2\x89\xe7\x82\x14\x62\xf5\xb0\x64\x9c\xf4\x1b\xcb\x15\xae\x90\x88\xf7\x38\xf5\xc9\xa3\x60\x8e\x9e\xdf\xc8\x54\xd8\x3a\x03\x62\x86\x04\x2c\x27\x08\xf1\x8a\x6f\xfb\x31\xf0\xfe\xe4\xc1\xbe\xdb\x20\x0f\xe8\xba\xf5\x06\xcb\x5f\x49\x34\x20\x0f\x58\x6f\x9c\x81\x58\x28\x00\xe7\x78\xe5\x98\x27\x35\x0e\xce\xf2\x0a\x02\x1c\x26\x9e\xdf\x62\xab\x8f\xb9\x69\x05\xb7\x00\x04\x83\xe2\x56\x52\xb1\x60\xf9\x70\x3a\x07\x87\x90\xae\x81\xe5\x5a\x97\x1b\xe3\x31\x0f\xf6\x11\x89\x0e\x0c\x80\x37\x3b\x9a\x51\x77\x6d\xa0\x4d\x4f\x1d\x37\x3e\x33\x8a\xa2\x4c\x74\x55\x7a\xd6\xf8\x9e\xf3\x18\x7b\xbe\x4b\x67\x08\x41\x6d\x4d\xbb\x23\x4e\xb0\x8e\x0b\x1b\x24\xbf\x3f\xa9\x74\x73\x32\xcb\x9c\x27\xa5\xc9\x57\xfb\x52\x49\x21\xa7\x30\x92\xc6\xfb\x70\x47\x52\xfb\xad\xf5\xe1\x26\x01\x8d\x80\x3c\xb9\x4d\x3d\xd2\xb2\x13\x19\x8f\xaa\xbe\xd9\xbd\x92\x46\xfb\xb1\xca\xf7\x1d\x02\x25\x83\x20\x1d\xc2\xd9\xe1\x75\x1d\x20\x2f\xf0\x2a\x33\xbd\x8e\xc7\xe0\x0a\x6c\xd1\xdd\x58\x8b\x93\xd0\x2b\x8e\x06\x05\x82\x63\x47\xa6\xf3\x25\xa6\x2b\x4d\x75\x8d\xdd\x87\x9c\x3e\x45\x8c\xdb\x1e\x1f\x0e\xca\xba\xa7\x4c\x81\x0e\x2e\x50\x20\xaf\x6c\x30\xbd\xbd\x95\x6f\x64\x18\xc3\x87\xca\x1e\x78\x38\x09\xf5\x9e\x8c\x19\xdf\xb7\xac\x59\x4a\x17\x5c\x77\x06\x88\xc8\xa6\x71\x5c\xe7\x6c\x5b\x8e\x26\xae\xbd\x71\xeb\xce\x63\xa9\xad\x92\x2d\x24\xeb\xc3\x54\x92\xad\x90\xca\x31\xd7\x52\x2d\x11\x79\x33\x44\xe9\x1c\x8a\x55\x83\x53\x04\x92\x01\x4f\x2a\x0d\xd4\xe1\x41\x85\xbd\x77\x20\x9a\x26\x8f\xe1\xf5\x22\x44\x86\x03\x80\xfb\xe3\x78\xcb\x67\xb4\xd8\x53\x12\xed\xdb\x8f\x61\x5f\x35\x6b\x0d\x05\x8a\x0d\x3e\x7b\x88\x51\xe0\x26\xd8\xf5\x84\x2a\xf0\x60\xda\x96\xdc\xdb\x09\xd1\x45\xe1\x64\x2f\x9f\xec\xf0\x12\x9c\x90\xbb\x40\xad\x01\x0e\x04\xcd\xed\xc2\x31\xfa\x42\x2f\x34\xd9\x9d\x3c\x91\x69\x1e\xea\x40\xb4\x59\xb9\x48\x01\x62\x54\x54\x16\x4f\xa9\xf8\xcc\x59\x52\xeb\xd0\x5b\x57\xf3\x38\x70\xf5\x6d\x99\x77\x11\xcd\x49\x11\xcd\x2e\x2e\xfb\x41\x27\x4b\xe7\xec\x7d\xef\x52\x59\x49\x9b\xf0\x72\xc7\x72\xc1\xf3\x32\x17\x5d\xae\xa9\x6f\xb8\x5b\x8b\xb5\x7b\x75\xb2\x46\x69\x02\xce\x7b\x32\x91\x24\xd3\x15\xac\xa8\xd7\xd8\x81\x3d\x1f\x0d\xb6\xe1\xa2\x05\x65\x30\x89\x2e\x38\x88\x5d\xd9\xc8\xcf\x5b\x25\x4a\x37\x1b\xa2\xf5\x50\xbd\xb0\x7a\x30\x02\x28\xe0\xca\xaa\xe7\x05\xf0\xee\xae\x81\x7c\x79\xd8\x33\x54\x9d\x81\xb0\xdb\x6e\xad\x38\x6c\xf1\x23\x6e\xa7\xa7\x8b\x31\xed\xf4\xfd\x55\x87\x2e\xea\x0d\x6c\xd2\x25\xf0\xbb\xb1\x5d\x8c\x48\xcf\xbb\x29\xa2\x90\x44\x3d\xe6\x46\xc9\x31\xb3\xc3\x5a\x0a\x6e\x47\x82\x18\xa6\x78\x5c\x67\xaf\x9c\xcb\xa1\x50\x30\x7b\x64\x4e\xa2\xa5\x82\x9a\xce\xde\x43\x8b\xbb\xdc\x5f\x31\x0f\xa0\xe9\xe2\xcc\xa5\x7d\x77\x43\x5e\xf7\x9a\xc1\x9d\x7d\x15\x09\x49\x3d\x67\xcf\xf7\xba\xb0\x4c\x8e\x0b\x2f\x36\xb2\x4e\xa5\x4a\x3b\xd4\x70\xc9\xc1\xdc\xbc\x03\xc1\x83\x1a\x9f\xfc\x53\x2e\x62\x83\x79\xbe\x17\x7d\x1b\x12\x4b\x83\xe9\x1c\x2c\xe0\xd2\x69\xec\x81\xc0\xf3\xb3\x76\x18\x8f\xc8\x10\xeb\xca\x0a\x1a\xb6\xd7\xd9\xab\xe9\xb4\xb1\xb8\x53\x9b\x92\x20\x8e\x8e\x44\xf3\x7e\x73\x2e\x4f\xc1\x5d\x1e\x44\x77\x50\xa4\x12\x6e\x3d\x83\x1e\xd2\x47\x09\xe0\x2e\xcd\xec\x5c\x76\xfd\xde\x13\xb7\x4e\x3e\x4c\x6e\x5c\x13\x1a\xc4\x14\x24\x5b\x69\x32\x5f\x09\x00\xcb\x6f\xa2\xbd\x87\xcb\x99\xa2\x7a\x68\x01\x6c\x9c\x3b\x47\x5e\xcd\x71\xdb\x0e\x53\xbc\x6c\x09\x62\xf2\xbb\x29\x39\xf3\x64\x13\x73\x73\x94\x1a\x75\xbf\x52\xe3\xbb\xfd\x79\x49\x49\xef\x86\x8e\xea\x88\xba\xf2\xca\xab\x9f\xcc\x82\x57\x4b\x12\xc0\x54\x21\xee\xf5\x1e\xf9\x9e\x6c\x07\x7b\x1a\x08\xb7\x68\x3b\x28\x74\xa4\xbb\x0d\x6c\xe8\x60\x7c\xfd\x13\x1f\xd3\x09\xda\xa0\x0b\x10\xf3\x06\x7a\xca\x0c\x5f\x67\xb4\x29\x88\xc1\x55\xaa\xbe\xdf\x00\x72\xed\x26\x0d\xce\x8a\xa0\x3b\x1f\xb4\xe1\x3c\x8b\xe7\x3d\x9e\xcb\x8e\x78\x5c\x0a\x89\xfc\xcc\x41\x52\x9e\xcd\x41\x81\xaa\x13\xf9\x8c\x29\x1e\xb4\x47\xd8\x8a\xda\xe8\xe1\xbd\xf5\x25\xb6\x6b\x5d\x2c\xe1\x46\xd0\xc1\x99\x4f\x2f\x2f\x36\x10\xc2\xef\xc4\xe5\x66\x54\xab\xf1\x34\x2a\xca\xa8\x5a\xb9\x8d\x52\x67\xcc\x6e\x10\xd1\x41\x40\x03\x9d\xf7\xaa\xeb\x78\x6d\x6d\xe8\x6d\x48\xa7\x26\x55\xa7\x22\x21\x83\x98\x0c\x6c\x32\xc0\xdd\x9d\x82\x1f\x5a\xe1\xcc\x2d\xec\x8f\xb0\x3f\xd2\x5e\x36\x2c\xc1\x98\xa9\xd3\x93\x32\xd8\x27\x18\x81\x40\xff\x9a\x2b\x0a\x6a\xc2\x1e\x20\xe8\xed\x54\x74\x4d\xbb\x41\x5f\xb2\x31\xb9\x9e\x73\x22\xa0\xf4\xab\x9f\x99\x93\xeb\x2e\xa9\x75\xaa\xa2\xe7\x3a\x13\xed\xa0\xb8\xcf\x09\x13\xb6\xcf\x3a\x55\xc9\x07\x07\xf2\x87\xa7\xac\x2c\x7a\x17\x5b\x1a\x9d\x89\x75\x7a\x7d\x5e\x35\xb0\x4a\x3d\xa1\x89\xdc\xe6\x06\x14\x1e\xe5\xec\x1c\x22\x54\xa1\x4d\x04\x31\x22\x1f\x69\x3b\x1c\xf1\x3c\xb4\x2d\x3e\xc6\xed\xf0\xfa\x1c\xf1\xfc\x80\x35\x24\x47\x1d\x6d\xda\xb2\x64\x4a\x49\x91\xc1\xe5\x47\x65\x0f\x4e\x24\x3d\xec\x48\x4e\xd5\x25\x9d\xc1\x11\x8f\xa7\x90\xa0\x54\x4e\xaa\x6f\x77\xa9\x7e\xb9\x8a\x52\x4f\x4a\xcc\x9b\xf1\x3d\x4d\xfa\x51\xb9\xf7\xad\x5e\x87\xc4\xb5\xd6\xac\xf9\xc6\xb5\x32\x91\x1b\x5d\x0e\x60\xf9\x19\xad\x23\xb9\x92\x23\x87\x2b\x8a\x61\xd9\x7c\xa9\x03\x8c\xb1\xb5\xe5\x7b\xcf\xf9\x60\x6f\x9e\x10\xf9\x6e\xf3\x4a\xa4\xac\x99\x36\x80\xb1\xe7\xf2\x18\xb7\xf8\x1a\xb7\x4f\xe8\x6a\xa2\xe2\x5e\x4e\x8a\xfd\xd8\x4e\xca\x50\xbd\x58\xcc\x0e\x87\x10\x63\x52\xf5\xe2\x29\xca\xf6\x7a\xf6\xc6\xc5\x1b\x37\x21\x1c\xda\xb4\x93\xc7\xb0\x93\x8f\xb0\x15\x19\x4c\xbc\x08\xf8\x11\x49\x8f\x20\x24\xc9\x09\x64\xe0\x47\x74\xb5\x6e\xce\x65\x1f\x86\x3a\x6c\xe5\x7b\x8f\x68\x55\xf8\xa0\x1e\x6e\x7c\xaa\xc2\x3e\xfb\x71\xd8\x2a\x1c\xd8\xd6\x41\x1b\xb5\xd1\x3e\x26\x2d\x05\xa4\x89\x3a\xf9\xf0\x9a\xa1\xab\xa4\xae\xc4\xa6\xee\x69\xb2\x20\x8c\x81\x73\x9f\x71\xe8\x06\x78\x1e\x2c\xfc\x03\x03\xe8\x5d\x67\x0f\x78\xf1\xb3\x31\xa6\xe8\x8b\xf5\x08\x57\x9f\xe7\xc0\x45\x31\x31\xf5\x4c\x51\x8e\x0f\x5d\x07\x82\xb5\x7b\x78\xe8\x2f\xca\x77\x0c\x30\xee\x18\x62\xdc\x31\x40\x7b\xfd\x46\x90\x27\x72\xf6\x27\x7d\x53\x5f\xfa\x2f\x12\xf6\x19\x5e\x8d\x8d\xef\xe2\xc9\x25\xe4\x3a\xb9\x8a\x6c\x1a\x44\x18\x76\xee\x54\x20\xec\xa9\x1f\x9d\x81\xb8\x47\xdd\xcd\xec\x6e\xcf\xd3\x70\xd0\x0c\x15\xcf\xaa\xb8\x68\x77\xf7\x96\xd4\xe4\xd1\x49\x07\xa2\xe1\x0c\x48\x32\x40\xfe\x31\x3b\x09\x6f\x49\x17\x22\xc5\xdb\xc6\x1a\x70\x73\x2d\x8f\x6b\x3f\xaa\x1c\xb6\x75\x27\xf7\x9c\x55\x5a\x1c\x69\x80\xf1\x49\x34\x27\x2b\x2c\xba\x56\x58\xfe\xca\xa9\xd7\xb7\xcf\x23\x7b\xfb\x3b\x6a\xad\xc6\x9f\xe4\x37\xd9\xda\xfd\x56\x88\x32\x65\x76\xfb\x2d\xed\x64\xce\x7c\xc8\x76\x70\x6d\x29\x88\xa5\xd2\x59\xf4\xc6\x15\x1e\x8b\x86\x0b\x11\xcd\x89\xe0\x3c\xd8\x81\x9b\x0c\x0c\x1d\x41\x0c\x05\xa8\xdf\x31\xa0\xab\x5c\x15\x60\x4f\xf2\xb3\x3f\xb0\xcb\x4e\x3f\x67\xe9\x5e\xf3\x60\xbd\x3f\x75\x0b\x45\xe9\xb5\x98\x88\x74\x1f\x35\xb9\x4e\xc9\x9c\x26\xa8\xbe\x6b\x84\x2a\x57\x1e\x09\x4b\x18\x11\x52\xf5\xb9\xc8\xf6\xb1\xc8\x3e\x29\x51\x9a\x93\x49\x08\x1b\xbe\x8f\x0c\xde\x89\xc8\x22\x5c\x57\x10\xa5\x71\xd4\x9a\x34\x79\xc2\x00\xa3\x7e\xbc\xd2\xde\x2e\x39\x0f\x5c\xb7\xdd\x46\xb7\xb0\x19\x6e\xcd\x19\x6e\xa9\xa0\xa5\x0d\x07\x00\x84\x66\x48\x5a\xee\x80\x0a\xb4\xbe\x15\xbb\xa2\xd4\x7d\x79\x17\x19\x87\xae\xc0\xf3\xa3\x26\x5c\x1c\x9a\x56\x9a\x82\x67\x28\xa3\x02\x38\xe1\x65\x03\xc1\x4a\x1e\x38\x50\x74\x38\x50\x34\xd7\xf2\x79\xd5\xc3\x1c\x41\xfc\x93\x06\x4d\x8d\x06\x0b\x94\x66\x9e\x3a\x5f\x42\xaf\x08\xd1\xab\xd9\xb8\xa1\xaf\xd9\x71\x5d\xed\xc7\xa0\xdb\x2e\x2e\x3b\x0f\x5c\x75\xde\xda\xe6\xc6\xe0\x9f\x29\xff\x50\x67\xa1\x9c\x85\x03\x80\x83\x64\xc7\x08\x4f\x4e\x10\xd0\x81\x32\xa6\xd2\xcd\x57\x3f\x56\xa0\x28\x9a\x2e\x36\x6b\x1c\xec\x67\xbf\xae\xc8\x39\x0a\x0a\xd7\xcc\x85\x17\x45\xcc\x98\xc9\x7b\xb9\x7d\xd1\xe1\xad\xd5\x45\x48\xd1\x45\xd7\x34\xba\xbb\x71\xc1\x9f\x17\x58\xcb\x02\x07\xa1\x8b\x04\x41\x67\x5c\x18\x80\xb0\xbd\xf2\x15\x9b\x5e\x8f\x5b\xb4\x69\xb1\xdf\x0e\x71\xdc\x0e\x84\xdf\x0c\xaf\xbf\xc1\x7b\x4d\x44\xc8\x3e\x4f\x8a\x7e\x8e\x84\x7e\xce\x24\x42\x87\xe8\x4e\x19\x37\x8b\xdc\xda\xe0\x1c\x1a\xdf\x53\xe0\x86\x2c\x79\xc0\xbc\x3f\xdf\x7d\xcb\x6c\x2c\x8d\x8c\xba\x20\x58\x68\x56\xa4\x31\x47\xd8\xf3\x57\x0c\x59\x8f\xe8\xd5\x9d\x8c\xf1\x63\x90\xa8\x95\x40\x1b\x75\xc8\xa7\x85\xcc\xb7\x81\xcc\xd1\x81\xc8\xd1\x81\x2c\x82\x70\x12\x4d\x44\xe7\x5a\x77\xec\x6a\x0c\xb8\x9d\xb7\x45\x3a\xa1\xe5\x76\x42\x8b\xe1\x40\x8d\xdc\x65\xe8\x81\xef\xfe\xb4\x90\x3b\x15\x5e\xd9\x1a\xdb\x44\x79\xd6\xaa\x95\x2e\x52\xba\x33\x40\x63\xb8\x4b\x3b\x04\x94\x3c\xc6\x00\xf9\x67\x4c\x7a\x42\xd4\xb2\x0f\x18\xdb\x40\x71\x7f\xd5\x17\xea\xe5\x4b\x78\x01\x0e\x54\xf3\xb0\x1f\xc3\xed\x2e\x51\xe8\xab\x6e\xbf\x62\x5f\xb4\x5c\x4f\xb4\xb4\xe5\x2e\x9d\x2e\x11\x38\x5b\xe7\x26\x0b\x1d\x4c\x4b\xb6\x6d\x0f\x5c\x77\x4a\x48\x64\xd1\x0c\xcd\x3a\x0f\x2c\x4c\x88\x65\xc5\xa1\x37\xdd\x89\x4e\xc9\xdb\x5d\x3a\x6e\x77\xf9\xa0\xdb\x3d\xf3\x85\x3a\x74\xc6\x4c\x75\xa0\x4d\x3d\x6f\xa7\x74\xde\xf6\x9b\xfd\x18\x6f\xb7\x6a\x2a\x40\xe8\xaa\xca\x97\xc7\x79\x3b\x6f\xc8\x8a\xc2\xe3\x2b\xf7\xa6\x9d\x9a\x86\xd5\x23\xfa\x88\xb0\x58\x2c\x49\xad\x84\xf5\xb5\x6a\xe2\x57\xfd\xd7\x7a\x13\x60\x36\x92\x14\x62\xe6\xa3\x76\xf1\x66\x23\xd7\x61\xcc\x33\xb1\xf8\x84\x2e\x93\x31\xbc\x32\x9f\x17\x4f\x31\x14\x18\x80\x5c\x04\x21\x29\xc9\x6e\x77\xe4\xfc\x49\x85\xf7\x29\x3f\x01\xef\xc8\x02\x0b\xc9\x3a\x0b\xd9\xb6\xb4\x23\x36\xaa\xb1\x23\x35\x21\xe3\x48\x80\x8f\x7e\x51\x3d\xff\x0e\x65\x8c\xf8\xbc\x80\xee\xf2\xde\xa0\x30\x17\x55\xb5\x21\x5a\xee\x9f\xf5\x81\x05\xdc\x5b\x8d\x43\xd6\x67\x27\x52\x56\x96\xdd\x67\x77\x53\xf7\x9a\x78\xfa\x90\x72\x61\x50\x5c\x7f\x07\x29\x33\xc5\x6f\x86\x4c\xba\x30\x9c\x74\x17\x9f\x05\x55\x77\xdf\x06\x28\x8e\xe0\x64\x8c\xe1\x38\x42\x43\x76\xa7\x74\x3e\xe7\x76\xde\x6c\xd2\x09\x48\x4d\x86\x7b\xd5\xd0\x3a\x7c\xc8\x34\x23\x3e\xc5\xcf\xd9\x96\xef\xeb\x7b\x9e\x3c\x29\x83\x27\xcc\x1b\x73\xb3\xdd\x4e\x8e\x1b\x57\xd9\x7a\x06\x60\xc0\x4f\xba\x03\xe7\x76\x32\x7d\xb9\x30\x92\x9a\xf7\x82\x62\xc7\xa6\x5a\x7a\xdf\x77\x03\x66\x33\xec\x37\x90\xb1\x18\x97\xeb\xd2\x9e\x61\xc0\xcf\xb9\x49\xb2\x21\x9c\x73\x90\xfb\xf3\x66\x7a\x82\x6c\x4b\x0f\x88\x01\xa5\xe1\x73\xfe\x81\x2a\xc9\xc9\x8d\x30\x63\xae\xb8\x69\x4a\x65\xfd\x59\x67\x55\xfb\x62\xe9\x52\xc7\x70\xaa\x4d\x80\xee\x7e\x73\x44\x88\x29\x98\xef\x7b\xa2\x4b\xad\x6a\x51\x03\xe9\x15\x4c\x33\x1c\x17\x57\x92\x85\xb5\x25\x8f\x5d\xc1\x6f\x73\x00\xef\xbe\x7a\x1b\xc8\x34\x99\xfe\x2e\x9c\xf1\x53\xa5\xee\xe8\x37\x5d\xf1\x23\xa7\xbc\x54\x58\x1b\x64\xc9\x44\x9f\xfb\xaa\x8b\x92\x21\xee\x53\x62\x5d\x9e\xf1\x71\x3d\x92\x4b\x1e\xb0\xee\xd8\x87\x0a\x2a\x58\x1e\x74\x59\x2e\x19\xd3\xb1\x0f\x54\x9e\x79\xf0\xb8\xa5\xec\xdb\x2b\x45\xd4\x05\x56\xe3\x2f\x4f\x4e\x92\xfb\x28\xb9\x00\x2c\xf8\xb9\x3f\x13\x98\x20\x3c\x94\x0b\x53\x33\xe9\x85\x4c\xdb\xa7\x01\x4a\xdf\x73\x30\x46\xe8\x43\xdf\x32\x2c\xa7\x38\x63\xe1\x10\x60\xf0\x92\x11\xfd\x94\x51\x29\x4f\xeb\x91\xd8\x2c\xc3\xd6\x68\x94\xdf\xac\xa1\x67\x0e\x89\xfd\xd4\x1d\x31\xab\xfc\x13\x75\x09\x31\x80\x18\x89\x21\xee\x8f\x14\x5c\x18\xbe\xfd\xcc\x11\x17\x36\x8c\x2e\xee\xc5\x61\x98\x0a\x72\x01\x15\xf5\x4b\xf6\xbb\xac\x2c\x7f\xd5\x13\x8a\xd9\xd5\x7e\xa8\x02\xcd\x61\x56\x8b\x31\xca\x77\xd5\x31\xc6\x03\x63\x6e\xc2\x6a\x97\xd1\x6a\xf7\x4c\xfa\x29\x27\x53\xaa\x30\x56\x38\x2c\xc3\xd7\x90\x94\x9d\xee\x53\x7d\x98\xfc\x87\x7e\x2e\x5c\x68\x8d\x7a\xc5\x99\x1c\xa6\x98\xd7\xaa\xfa\xe6\x97\x12\xf3\xbc\x47\x7d\xcf\xf5\xac\xa4\x1d\xc1\x91\xba\x2f\xd5\xbe\xf3\xc7\x48\xd6\xa0\x19\x07\xc3\x5f\x98\x32\xbe\xbb\xa8\xec\x72\x3e\x79\x7a\xf1\x47\xff\xce\xc8\x62\x33\x30\x25\xbb\xe7\x2a\x9a\x36\x0c\x83\x7f\xe7\x9d\x69\x6d\x4b\xd6\x18\x8e\xd1\xef\x54\xc6\x5f\x9e\xcc\x8f\x72\xb5\x8f\xb0\xae\x19\xa6\xbe\xf0\x00\xc4\xe4\xd1\xb7\xbe\x81\x31\xf1\x61\xdf\xa1\xd2\x64\xb0\xa1\x8e\xda\xf8\x22\x97\xcc\xf9\x69\x0b\x4e\x34\x6e\xfa\xb5\xe4\x18\x59\x58\xed\xd5\xb9\x97\xec\x0f\xef\xe4\x35\x97\x2b\x4a\x94\x58\x46\xd4\x0e\x8f\x11\xd6\x6f\x7e\xcf\x9a\x38\xe8\x11\x89\x13\x26\x85\xc9\x94\xf7\xbe\x93\xed\xe8\x37\x36\x7a\xb5\x7a\xf7\x67\xde\xcd\x94\x6c\x72\x8c\x73\xd9\x6f\x10\x13\x31\xec\xe7\xec\xa3\x59\x91\x2a\xc4\xaf\xb4\xd3\x43\xb4\xe1\x18\x8a\xe4\x3c\x18\xf1\x33\xee\x60\x06\x32\x78\xfa\x76\x61\x74\x35\x6b\x1f\x51\xcf\xac\xec\xf6\x83\x6f\xa1\x16\x8f\xe9\xa5\x30\x3b\x17\x59\xfd\xee\x57\x31\xd1\x2d\x78\xda\x32\x2c\xab\xa9\xbf\xf1\x39\xc9\x45\xec\x41\x23\x73\x63\xeb\x19\x93\xb7\xb3\x20\x83\x7e\xed\x73\x97\xfb\x44\xae\xf8\xbe\x7a\x27\xc4\x84\x6c\x5f\xf5\xf1\x95\x14\x43\xb2\x30\xf9\x1f\x72\x0d\x6f\xb7\x35\x9d\xf6\xcc\x83\x61\x39\x4b\x76\x1f\x8b\xaa\xa0\xbf\x8d\xeb\x31\x69\xdb\x07\xc7\xf0\x17\x58\xe3\x13\x8b\xbd\x71\xdb\xf7\x78\xb9\x4e\x5b\x02\xdf\x33\xd1\x76\x8d\x92\x31\x5d\x68\x99\x08\xc0\x79\xc8\xe2\x0b\x47\xf6\x89\x63\x7d\x10\xb9\xfe\x70\x1d\x86\xb9\xab\x18\x78\x4d\xa7\x71\x6c\x79\x8c\x07\xbf\xc7\xab\xec\x16\x20\xc5\x54\xc4\xcc\x5c\xb4\x43\x7e\x10\x79\x3b\x10\xc5\xc0\x00\x4c\xf7\xa9\x23\x96\x83\x23\x80\xce\x27\x3a\x53\xc5\x27\x27\x9d\xba\xf0\xdc\x44\xa3\xbf\xbd\xd1\xb2\x3e\x69\xd5\xe3\x30\xc5\x35\x9d\xc5\xe3\xcd\x60\x6e\x16\x4a\x2d\xce\x70\xce\xd8\xfa\x56\xe7\xbe\xe7\x94\xd7\xb4\x03\x91\xcb\x01\xb7\x27\xc6\x70\x4c\x6d\xcb\x3c\x0a\x62\x8f\xcd\xaf\xcd\x26\x66\x8a\x3a\xad\x1b\xaa\x61\xbe\xcd\x76\x6a\x8c\xd0\x6b\x96\xe7\xb2\x78\x16\xcc\xe5\xd2\x6c\x77\x0c\x90\xd2\xbc\xe0\x98\x5d\x0a\x05\x9f\xe1\xf1\xcb\xb7\x98\xe3\xb8\xab\x78\xdf\x9f\x74\x6a\x30\x99\x1d\xc9\x3d\x1b\x72\xcc\x65\xc7\xf2\x62\xc9\xb3\x0c\xb7\xbd\x66\x61\x94\xa7\x50\x7c\xb7\x63\x2a\x0e\x99\x4a\x42\xd4\xb8\x68\x67\x8a\x40\xcc\x83\x65\x7c\x12\x19\xd3\xc5\xd0\x34\xa2\x71\x95\xa6\x67\x4f\xf6\x7b\xdd\x00\x24\xe4\x76\xbf\x93\x27\x69\x18\xfc\x93\xd2\x21\xe6\xc1\x98\xc7\x6d\x53\xb7\x02\xcc\xf3\x43\xba\x4b\xc8\x43\xb8\x5d\x8a\xcf\x9d\xb4\xa4\x65\x54\x9f\x07\x8d\x07\xe3\xb0\xce\x1e\x10\xf3\xe0\xcd\x5a\x5a\x35\xb4\x20\x19\x30\x6e\x07\x25\x31\xb9\x96\xd9\x3e\x77\x18\x37\x7e\x23\xc8\x49\xa0\x81\x27\xfd\xc4\xf8\x9a\xc1\xd8\x5b\x2e\x4e\xf8\x42\xe0\x50\xb3\xa7\x3e\xc4\x0c\x25\xfc\xe9\x63\x22\x7f\xe4\xfc\xb1\x6d\xa0\xd8\xb6\x8e\xd6\xc5\xa6\xcf\x84\x8a\x67\x8f\x4e\x3c\x6f\x80\x48\x10\x74\x62\x71\x2d\xe7\xa3\x90\xf3\x7d\x47\x7e\xd5\x51\x04\xa7\x71\x72\xeb\xf4\xb3\xc6\x63\xc6\xe6\x7b\x2f\xb2\x83\x24\x41\x27\xa7\x87\xaf\xe6\xb1\x32\x31\xe4\x02\xfc\xb7\xb0\x6a\xb5\x7b\x88\x82\x18\x76\xbe\xf5\x83\x10\xf3\xe0\xa4\xe3\xd6\xe9\x45\xc7\x93\xa0\x75\xb1\x2e\x89\xc9\xad\x5c\xf2\x89\xbf\xd7\x54\x0f\x2d\x50\xe8\x46\x81\x11\x19\x44\x31\x63\x73\x0f\x99\x0b\x1f\x86\xa1\x4a\x26\x7a\xdd\xe7\xd7\x7d\x71\xfa\xce\x8f\x26\x86\xb0\xe1\xd8\x07\x01\x10\x01\xf7\xe2\x87\xb9\x58\xae\x27\x18\xc5\x69\xd2\x9b\x0d\x69\x42\xcf\x06\xdc\xf7\x1d\xcf\x43\xa9\xc7\xa9\xeb\xce\x29\x42\x6c\x59\xbb\x28\x0c\x27\xa8\x13\x9d\x6d\x05\xc0\x01\xa0\xfa\xa4\xfc\x52\x48\xf9\xef\xbb\x9b\xe7\xdb\x9e\xdf\xc0\x78\x16\x32\x96\x71\x36\xf8\xcc\x8d\xef\x37\x5b\xaa\xa5\x59\x2e\x39\x86\x32\x60\xa0\x81\xf4\x01\xd6\x07\x4f\x19\xe0\x7c\x7c\xce\x3c\xb7\xc1\xc0\x06\xe7\x0d\xf4\x9a\x8f\x97\xec\x3e\x66\xd0\x93\x10\x43\x7a\xa8\x3a\x32\x49\xc8\xec\x36\x5f\x19\xb3\x6e\xdc\xde\xbb\x5a\x8f\xe8\x1a\x34\xf8\x61\xcd\xeb\x84\xd6\x9a\x35\x6a\xf6\xe8\x99\xa3\xe7\x55\x61\xcb\x59\xb2\xf8\x68\x8e\x15\x7e\xae\x0b\xbd\x55\x29\x5f\xa6\xf7\xb5\x1b\xe2\x68\xd3\xc8\x2e\x26\x4d\x62\xb0\xfc\x11\x46\x10\x3a\x26\x8f\xc7\xa0\xfa\xbc\xc1\x04\x24\xb1\x7f\xc6\x24\xa3\x66\xd3\x02\x93\xf7\x81\xc2\xae\xa9\x19\x8c\x5a\x2e\xbe\x46\x43\x79\x76\xc4\x57\x0f\xdf\xe6\x46\xed\x70\x91\x93\x28\xcf\x41\xa9\x07\x65\xa0\x0d\x0d\x5b\xdc\x87\x9d\xa8\x51\xa2\xc5\x32\x17\x6f\x82\xde\x62\x91\xb8\xcf\xc9\xeb\x73\xdd\xfb\xbc\x1b\x8e\x34\xac\x61\xf6\x22\x0a\xfb\x1c\x3e\x64\xce\x8f\x4f\xc4\x3f\xf4\xfa\x0c\x84\xb6\x95\xb5\xd7\x6c\x79\xbc\x9a\xc3\xb3\xc3\x96\x68\x8c\xda\xa8\x4d\xdb\x49\x8e\x27\x36\x9e\xf0\x42\xe1\xc4\x1d\xca\xae\x3b\x16\x71\xb9\x9a\x06\x0a\xec\xa3\xe8\xa4\x36\x6b\xa0\x36\x93\xe2\x97\xdc\xfc\xfd\xfb\xb2\x97\x0e\xc1\x8d\xb8\x22\xa0\x0f\x6b\xe6\x15\x09\x3c\xc1\x7a\x44\x8f\xa8\x8d\xdf\x1b\xf6\xd7\x67\x4c\x2e\xfb\xcd\xb9\xa9\x55\x4c\xc9\xaf\x7e\xf9\x35\x73\x80\x28\xcd\x80\x28\x0d\x66\x10\x6d\xc4\xfa\x3d\x3c\x9e\xcf\x83\x0b\x71\xdd\x7e\x4c\xb7\xf3\xd6\xc8\xb6\xbb\x68\xb6\x3b\x28\xf6\x83\x32\xb4\xb3\x90\xa0\xd1\xd6\xf8\x10\x30\x16\x06\x84\xb4\xa2\x40\xe9\x22\x80\x69\xd4\x21\x50\x93\x28\x89\xb6\x51\x86\x60\xc4\x87\x65\x0d\x54\x8d\x10\xe2\x59\x5d\x35\x19\xfd\x61\xbf\xc9\xd8\x91\x0a\x51\xe3\xba\xc1\x59\x5e\x70\x18\xa9\xd6\x21\xa2\x9d\xe9\xa6\x1d\xe9\xa4\x1c\xf3\xa4\x38\x52\x6d\x34\xc7\xc5\xdc\x48\x65\x18\xe3\x76\x9d\x56\x62\x25\x57\xf2\x35\x5c\xe4\x68\x43\xfa\x57\x74\x57\x7b\x5a\x78\x44\x7e\xd4\x46\x48\xd6\x44\x88\xd7\x44\x6d\xd2\xd3\xc1\x24\x90\x47\xca\x97\x98\x9e\x75\x1e\x9c\xa1\x19\xba\x90\x39\x84\xb4\x27\x84\x01\xd3\x09\x54\xeb\x16\xa0\x28\x43\x13\x4f\x14\xd3\xf5\xcf\xde\x8f\x91\x2f\x9d\x4d\x1d\x00\x05\x6b\x77\x57\xd0\xd8\xa0\xcf\x84\x3e\x9d\x94\x93\x2b\x67\xb5\xc6\x7a\xe5\xb2\xdf\xec\x4a\x41\x9d\x68\xd2\xb2\x4d\xac\x87\x21\xf1\x61\x3a\x57\x1a\xc2\x6f\x75\x95\x2a\xe6\xd6\x3d\xda\x7e\x56\xb8\x67\xaf\x70\xaf\x79\x7f\x77\x8c\xfd\x0e\x18\x70\xbe\x6d\x04\x00\x2f\xb1\xdf\x2c\xb1\x8f\x2e\x24\xf0\x94\x68\xc3\x5b\xc9\x68\x0b\x34\x8d\x46\x25\xa4\xbc\x01\x97\x4f\xbd\xf0\x4f\x56\xec\xb1\x69\xf1\x46\x7c\x77\xbd\x66\x88\x5d\x81\x75\xbd\xa3\xe3\xf4\xea\x4a\x91\x28\x8d\x66\x45\x42\x54\x73\x7c\xdd\x1f\x85\xa1\xa4\x45\x50\x9f\x79\x62\x43\x83\x6a\x43\x83\xe2\xe2\xba\xc3\x8c\xcb\xbe\x2e\x67\x8a\x68\x11\x05\x16\x24\x41\xf7\x90\x6e\x33\x77\xef\x2a\x1d\x92\x68\x94\xe4\x67\x2e\x7d\x32\xd6\xaa\x2b\xb8\x3f\x02\xa9\x27\x44\xb1\xd7\xcc\x44\xe2\xed\x56\x38\x17\xb5\x98\x27\x68\x7e\xef\x49\x9d\x7a\x42\x1a\x4e\x11\x45\x87\xe3\xc8\xcc\x9d\xfb\xc3\x7d\xb6\x2e\xe4\xe1\xbe\xdf\x0c\xb6\x3f\x28\xf6\xc8\x16\x35\x05\xb7\x31\x52\xcd\x7a\x57\x00\xc5\x25\x13\xa3\x83\xf7\x37\x84\x5c\xda\x61\x59\xd1\x5b\x1d\xb3\x3c\xc1\x7c\xce\x9e\x97\x9a\x0a\x56\xf0\x54\xc9\x33\x84\xd8\xaa\xc4\x92\x12\x57\x5b\x52\x7e\xcc\xbe\x2a\x1c\xc8\xad\xee\x7b\x65\xae\x9a\x7d\xa5\x11\xed\xee\x42\xf0\x92\x78\x0b\x69\x21\xb3\x03\x1f\xed\xc7\x3e\x3b\x9e\xbb\x6a\x37\xdd\x2b\xe0\x8f\x90\x3e\x8f\x73\x72\xb5\x90\x65\x8c\x1f\x74\x4a\x26\x7a\x34\xdd\x04\x6f\x0d\x82\xc9\x5b\x48\x8c\x0a\xb5\xe9\x02\x96\x60\x7a\x17\x19\xf3\xdd\xe9\x70\xc5\x0b\x82\x11\x99\xc6\x27\x10\x76\xe4\xe0\x8d\x65\x70\xe2\x04\x1c\x6e\xf7\x27\xaa\x41\xc5\x8e\x23\x28\x81\x50\xf7\x3e\x16\x3d\xd8\x09\x3c\x8d\x4c\xcf\x1b\xcf\xcc\x70\x74\xf1\x44\x8b\xa0\xd3\xd9\x79\xf6\xc6\x73\x22\x18\x09\x14\x21\xf4\xac\x9e\x7d\xeb\x0c\x67\x4f\xae\x4f\xa5\x27\x25\x28\x81\x1b\x1a\x44\x9d\xde\x57\x36\x1e\x44\x89\x4e\xfe\x56\xe7\xca\x5c\x85\x35\x9a\x98\xa7\x11\x93\x6b\x41\x86\xf5\x05\xad\xdf\xbe\x6b\x80\x8b\x6b\x4b\x41\x95\xe6\xa6\xe7\xed\x34\x3c\x2d\x71\x3b\x67\x20\x0e\x7f\x44\x66\x44\xe6\xec\xc7\x70\x50\x79\xe3\x35\xbe\xd7\x0e\xd6\x78\x93\x47\xf3\xe5\x4c\x33\x99\x6d\xe7\x05\x08\xe3\xab\xc9\xb7\x59\x71\xdb\x5a\x27\x9e\x47\x8f\x94\xeb\x32\xdd\x3e\x6b\x6b\xe9\x8b\x01\x79\x47\x45\x07\x7f\xba\x1b\x45\x48\xdc\xcd\x18\xbd\x28\x50\x9b\x03\x51\x6b\xa9\x94\x90\xb4\xb5\x65\xbd\xa1\x4c\x4a\xb7\x24\x80\xd2\xf9\x12\xd9\x33\x49\xcb\x93\x07\xa9\xaf\x93\x37\x51\x9b\x71\x96\x1b\x11\xc8\x0a\xc9\x84\xd3\x25\x35\xd0\xeb\x31\x4e\xd3\x95\x0c\x42\x35\x18\x07\x65\x48\xa6\x3b\xbd\xac\xbe\xda\x74\x37\xa8\x0c\xb8\xef\xfd\xb5\x58\x1d\x9a\x80\xd2\x00\x99\xa8\x68\x6d\xe5\x13\x0c\xd0\xf1\xa4\x78\x91\x3b\xd1\x41\x4f\xbb\x7b\xe8\x0b\x6b\x02\xe7\x33\x90\xe9\x20\xa8\xf7\xe9\xed\xde\x13\x1a\x24\x1d\x55\x13\xb5\xd1\x1c\xa8\x14\xa0\x1e\x40\xe0\x35\x4f\xe4\xe6\x08\x3b\x70\xbb\x5b\xed\x32\xc4\x77\x28\xb5\x57\x97\x80\xfd\x0e\x05\x8b\x22\xf5\x55\xfc\x6e\x34\x8c\xf8\x51\xcb\x18\x06\xca\x02\xbf\xeb\x9a\x65\xdf\xe7\x1a\x7c\x72\x13\x04\x3c\x46\x8f\xc0\xc3\x19\x1d\x3c\x45\xa7\x22\xf0\xac\x6d\x59\x0b\x82\xee\xdc\x9e\x67\x7d\x55\x77\x57\x42\xcb\x47\x14\xc7\xeb\x78\xa5\x9d\xd4\x15\xac\xc0\xae\x4b\x2a\x59\xe0\x52\x17\x9d\x81\xc2\xdb\x23\xc3\xc4\x7c\x71\xce\xf3\x70\x2f\x38\xa2\x6d\xba\x70\xb9\xa4\xdf\x6a\xb3\xe9\x6f\xba\xd0\xb4\x79\x91\xc3\x84\xbd\x6b\xc8\xcd\xf7\x84\x3c\x5b\x74\xed\x8a\x68\x75\x38\xd4\xb3\x82\x72\x24\x9a\xe6\x7a\x77\x56\x07\x2d\x20\x1d\x04\x3d\x50\xa6\x19\xa0\x4c\x73\xae\xbc\x35\xfb\x6a\xfc\x94\xf0\x4c\x73\xa7\x39\x61\x30\x9d\x5f\x0d\x1f\x40\x97\xc4\x55\x7d\x42\x64\x43\xe3\x0e\x61\x18\xd5\xca\x47\x7a\xfa\x66\xd5\x7c\xce\x1a\xa2\x08\xd3\x45\x00\xe2\x53\x0e\x5e\xce\xc8\x3a\xdd\xca\x40\x49\xb9\x71\x91\xe8\xca\xde\x35\xb8\x79\xe5\xf6\x2d\x59\x0c\x98\x25\xf1\xfa\x58\xb8\x8b\x15\x4f\x7e\xe4\x45\xb9\x07\x13\x74\x7c\xad\xb0\xc2\xb9\xc7\x9b\x0b\x6b\x36\x15\x01\x60\x3e\x27\xc2\x7e\x4b\xe7\xb3\x75\x8f\x02\x4c\x8f\xae\x1f\xf5\x7b\x6b\xb7\x17\xd5\xf8\xa8\xe1\x12\x57\x4e\x42\x32\x4d\x28\x49\xb7\x54\x27\xdb\xa6\x30\xd5\x58\xf9\x44\xc5\xd3\x71\xc2\x39\x50\x7d\x53\x25\xed\x13\xa2\xb7\x18\xd8\x56\x43\x2b\x53\x81\xf5\x2b\x81\xe1\xca\xe9\x48\x48\x10\x6e\xa6\x1a\xd3\x85\x61\x9f\xb2\x7d\xa5\xc9\x4d\xbc\x57\x10\x51\x68\xce\x9d\x32\xab\x30\x68\x87\x91\x18\xd3\xa2\xc0\x1d\xc3\x60\xb9\xe3\xd3\x7f\x38\x25\x6e\xa3\x0e\xa7\x7d\x10\xc8\x51\xf6\x69\xdf\xf8\xd8\x7e\xac\x4a\x42\xb7\x87\x6e\xf7\x63\xdd\xb6\xb1\x16\x82\x22\x05\x10\x3b\x86\xa5\xba\x88\xc6\xa4\x11\x58\x7c\x2a\x5f\xad\x4b\xb4\x6c\x5b\xd7\x55\xcf\x72\x88\x6f\x56\xf1\xea\x11\xb7\x0d\x14\x5a\x0f\xce\xd1\x52\x0d\x34\xe5\x18\x08\xe2\x76\x11\x01\xd5\x34\xfa\xb0\xf9\xd6\x37\x97\xa2\x02\x06\x00\x95\x77\x1d\x02\x0f\x89\xe5\x2f\xf3\xab\xdd\xd2\x96\x6d\xf3\x03\x00\x14\xbb\xdb\xc3\x05\x6e\x77\x89\x5c\x11\x43\x68\x2d\x24\xed\x26\x8b\xf5\x73\xaf\x1b\x9a\x19\x49\xee\x97\xf0\x3e\x15\xbc\xdc\xe5\x30\xe4\x15\x41\x5e\x14\xb3\x60\xf0\xda\xf8\xf2\xf9\x33\x5a\xd6\xa8\xc7\xcc\x72\xfe\x96\x83\x78\x0e\x29\x0c\x04\xed\x72\x43\xb4\xf2\x65\x1c\xd4\x9a\x0d\x8a\xb4\x68\x96\x8b\xc5\x98\x0e\x7e\xd1\xf2\x09\x2c\xb2\x90\x4a\x0d\x91\x3f\x7b\xca\xad\x20\xf3\x28\xaf\x25\x48\x22\x3e\x42\x2d\xca\xb1\x36\x42\x82\xc1\xda\x9d\x04\xa0\x79\xdd\x5e\x7d\x7c\xdd\x24\x21\x3c\x6d\x6b\xa0\xee\xbd\x64\xe9\x17\xf7\x72\xfb\xf4\xd1\x03\x6f\xcf\x2c\x06\x28\x60\x18\x4c\xf9\xd5\x54\xd5\x48\xa2\x1d\x70\x36\x0f\xf7\x88\x8a\x74\x60\x9b\x88\x20\xe8\x70\x38\x12\x6d\xd7\x2b\xcb\x5a\x3f\xb7\xa9\x6b\xe1\xc4\xb1\x43\x67\x2a\x18\xd1\x8e\x24\x7d\xeb\x8c\xfe\x15\xcf\x55\x3a\x35\x8b\x30\x2d\x21\x46\x03\x52\x3d\x9f\x8f\x67\xc3\xd9\xdc\xe5\x5b\x6a\x97\x06\x15\x00\x41\x3a\x07\x81\x18\x55\x71\x4b\xe9\x06\xcd\x71\x1b\x71\xdb\xd0\x21\x36\x1f\x37\x7e\x06\xe4\xc0\x21\xb2\x03\x8f\xd1\x62\x69\x13\x1d\x32\x9b\xc7\xd8\xf8\x13\xcd\x67\xf9\xe6\x93\xcb\x75\xf2\x12\x87\x4f\xc0\x91\xd4\x07\x82\x8a\xbd\x40\xae\xc3\x76\xe7\x1e\x83\x7e\x9f\x08\x0e\x07\x49\x65\x27\xd6\x52\xe3\x08\x22\xba\x7e\x9b\x85\x2f\x37\x07\xa2\xc1\x74\x03\x01\x1c\xbc\x0b\xfd\x8c\xc9\x36\x1c\xfb\xa2\x98\x4c\x49\xd1\x67\xfc\xd0\x5a\x3b\xf6\xaa\x21\x1b\x99\x9d\x82\x79\xae\xde\xa5\x7a\x44\x79\x4e\xd1\x08\xa8\x9e\x54\x5b\x0b\xc6\xf5\x89\x20\xd8\xbc\x6d\xb4\xea\xc3\x35\xae\xdb\xce\x03\x47\x42\x9a\xa6\x2a\x8d\xd2\x7b\xd5\x34\x9d\x8f\xbd\x00\xcb\x0b\x22\x48\x62\xd9\x75\x9b\x2c\xf7\xaa\x40\x7e\x09\xf9\x67\xb4\xf9\x13\x3e\x2b\xc5\xad\x9e\x49\x02\xd8\xda\xbc\x28\x68\x61\x5c\x32\x49\x69\x63\xbf\x1b\x4e\xf8\x89\x92\x48\x3a\xda\xd2\x69\x47\x2a\x22\x9e\x15\x46\x6b\xf0\x1d\x1b\x6c\x68\xec\xd4\xe6\xe5\x3b\x2d\x3b\x5e\xb8\xcf\x58\x63\x4c\xbe\x82\x80\x79\x03\x19\xd3\xbd\x2a\x75\x48\xe8\xe8\x46\x32\x85\xd5\x0d\x44\x7a\xa8\xd2\xba\x23\xc0\x8b\xd7\x60\x97\x87\x0b\x02\x9b\x3e\x28\x63\x8f\x57\x1b\x28\x0b\x23\x1d\xd8\xdc\xc3\x85\xb4\x7b\x4c\x53\xcb\x30\x39\x63\xda\x5b\xd0\xb2\xbc\xfa\x72\xac\x74\xd5\x96\xcd\x2f\xcc\xf6\x8d\x0e\x7f\xbc\xdc\xbb\x00\x37\xd2\x80\x6a\x5b\xbe\x48\xbb\x92\x76\x32\x7f\x02\x90\x61\x87\x6d\xd5\x35\xd6\x83\xb3\xe4\x84\x3c\x71\xef\x81\x2e\x57\xaf\xaf\x4a\x7c\x59\x51\xb5\x42\x4e\x7f\x22\x7a\xe5\x00\xc0\x8d\xe8\x3a\xb4\x41\xd2\x4e\x56\xaa\xab\x54\x4b\xb5\x02\x50\x6a\x0b\xd6\x3d\xa2\x45\x1e\x66\xd2\xb7\x6f\xfe\xce\x28\x33\xbd\x2d\x06\x08\x36\xd3\xd3\xc2\x6d\x01\xae\xcc\x02\xa7\x69\x9a\xb8\xc3\xc0\x1a\x09\xfb\x25\x35\x3a\xd9\xed\x34\x6a\xe4\x2e\x43\x3c\xf9\x49\x12\x04\xb9\xd7\x0c\x89\xc9\x55\x42\x38\x65\x09\x98\x17\x63\xd4\xc7\x42\x75\x09\x27\xf9\xbc\xdd\x31\x5a\x49\x73\xec\x60\x0f\x96\x47\xb5\x07\x44\x01\xb6\xf5\xde\xf7\x32\xcc\x40\x80\xbd\x1f\xbc\x9c\xda\x52\xaa\x07\x11\xa9\x02\x4d\x1f\xf4\x3d\xce\xf6\xba\xbe\x75\xfa\x39\x57\x52\x41\x72\x95\x15\x23\x34\xd8\xf0\xd1\xd5\xb9\x48\x4f\x0a\xf4\x84\x2a\x34\xb9\xf9\x12\x3a\x4c\x28\x34\xb1\x4b\x83\x09\x7c\x0f\x01\x43\x81\xc9\xdb\x7e\xb3\x2f\x08\x00\xad\xc7\x8d\xee\x82\x8a\x4f\x01\xa6\x94\xde\xa5\xe2\x1e\x10\x1c\x78\x02\x3c\xc4\x2f\x5a\x67\x3e\x78\x19\xbd\x03\x57\x7c\x6e\x2c\x4f\xcb\xbd\x2d\x29\xe9\x6d\x81\xf5\x99\x14\xe8\xe9\xda\x8f\xca\x5d\x14\xd1\x19\x52\x4e\xdd\xf1\xca\x4a\x98\xe8\x14\x04\x75\x1e\xc1\xca\xe0\xb4\xec\x7b\x8b\x18\x17\x47\xb4\x90\xcc\x61\x46\xee\x0a\x80\xc4\xbb\x3c\xbc\x70\x97\x08\x9d\xec\x1a\x72\x7a\xd6\xde\xa4\x82\x15\x30\x2c\xdb\x3a\x5c\xa2\x8b\xa7\x55\x66\x2a\xf2\xfc\x09\x85\x62\x85\xeb\x0a\x7a\x0a\x11\x2f\xd3\xc4\x26\x88\x8f\x5d\x72\xe2\xd0\x67\x34\x76\xd2\x8a\xa2\xc8\x5d\x58\xc8\x87\xae\x6c\x1e\xb5\x4d\x83\xfa\xf0\xea\xe5\xcf\x37\x3a\xc6\x47\xcf\x79\x61\xa2\x60\x04\xd1\xc1\xcb\xf2\xcc\x19\xb3\xba\xc9\x8f\x3c\xd3\x34\x0d\xde\x41\xd1\xb9\xd5\xf3\x72\xa4\xdd\x25\x05\x01\xb7\xba\x54\x92\xe0\x9f\x02\x4c\x6d\x46\xe7\xdc\x4f\x2a\x4e\xce\xc4\xe1\xda\xeb\x00\xe7\xc3\xe2\x03\x74\xbc\xb6\xf6\x8e\x0d\x5e\xa8\xca\x31\x9a\xad\x78\x6d\x55\xfc\x0d\x45\x08\xe8\x5d\x1e\xae\xac\x15\x63\x13\xd7\x6d\x20\x3c\x9f\xba\x73\x48\xa2\x11\xc8\x38\x5d\x4d\x62\x97\x73\xab\xdb\xc8\x5e\xde\xb1\xfd\x2a\x56\x10\x31\x1c\xe1\x19\x5c\x0d\x03\x1c\xe4\x07\xd5\x5c\x94\xa7\xdd\xb9\x63\xb3\x6e\x00\xea\x47\xee\x1a\x28\x02\x29\xb7\x89\x06\x9f\x56\x16\xb7\xec\xe5\xa2\x80\x9f\x31\xc4\x55\x7d\x2e\xf2\x24\x7e\xda\xc6\xe5\x48\x96\x03\xdb\x3a\xb5\xe1\xc6\xf0\xee\x2a\x76\x3b\xdc\xc9\x03\x92\x8c\x0a\x73\x76\x26\x8b\xa2\xdb\x30\x54\xa2\x01\x0a\x52\x26\x9a\xd0\x64\x96\xd2\x6b\x66\x59\x9a\x65\x1b\xe3\xb2\xf7\xc8\xc3\x16\x7d\x80\x22\x02\x06\x7b\xd1\x90\x8b\xcf\xf8\x49\x37\x81\xdc\x14\x02\xa0\x5f\xb3\x64\x63\xc3\x77\x34\xeb\x5f\xf5\x75\x8d\x9c\x9e\xb3\x91\xa8\xc5\xfb\x9b\x61\x28\xbc\x17\xb7\x77\x61\x71\xa4\xf8\x1e\x81\xd4\xce\xe9\xaf\x49\x10\x92\x7a\xae\xf7\x24\x29\x3d\xce\xf2\x35\x82\x33\xb6\x6b\xb1\x9e\x32\x2e\x19\x8d\x5b\x81\xba\x33\x88\x23\xb5\xf2\x91\xa1\xe4\x32\x69\x19\xc7\xf4\x8f\xf0\x63\x26\xd5\x2d\x84\x5a\xac\x61\x77\x69\xdf\x4f\x04\x0a\x09\xa1\x44\x3b\x99\xa0\xb4\xcb\x4d\x05\x0b\x20\x07\x68\x6f\x84\x7d\x08\x5b\xfb\x88\x97\xa2\x97\x5c\x4f\xb3\x7b\x52\x93\x59\x62\xf1\x14\x10\xaf\xbc\xce\x81\xea\xd9\x70\xe8\xfd\xd4\x5a\xc2\x46\xe0\xc5\x61\x72\x8e\x12\xd6\x9b\xf0\x5e\x2f\x18\x9c\x37\x77\x3d\xe9\xc0\xe7\x45\x68\x62\xb8\x7e\x86\x06\xb6\xf8\x67\x78\x0a\x98\x30\xa1\xd3\x51\x23\x08\x41\x57\x0e\x9e\xca\x21\x7c\xdd\x0a\x14\x00\x70\xc5\x79\x20\x8d\x50\x6a\xf2\xb8\x14\x45\x91\x21\xb4\x52\xcf\xd2\x09\xdc\xe2\x44\x3f\xa1\x9c\xa7\x75\x56\xfa\xac\x47\x9c\x09\xa7\x44\xb6\x22\x51\x1b\x55\xe6\x2c\x71\x37\x1a\x8c\xf0\xc5\x87\xdd\x31\xab\x28\x71\x19\x3d\xb5\x19\xf7\x64\xc5\x42\x20\x90\x10\x92\x9c\x9e\x77\xed\xcc\x45\xa3\x34\xa1\xcd\x23\x41\x4a\x32\x92\x10\x51\x5c\x83\x5f\x80\x4c\x74\x20\x48\xbf\xdc\x4f\xc6\xe7\xf8\xef\x7e\x0c\x4c\x31\x14\x5c\xf7\x31\x11\xb8\x67\xa9\xdd\x13\xe4\x99\xa3\x28\x81\x9a\x14\xc6\x5f\xc2\x44\x3b\x9e\xdb\x78\x40\x15\x8f\xa7\x48\x98\xc0\x30\x9c\xbb\x0d\xad\x8b\x12\xcb\x38\x17\xd0\x78\x9a\x4f\x70\xa4\xf3\x8b\x02\x77\x3c\x84\xfa\xda\x3d\x44\x8c\x1b\x1b\x4a\xf2\xb7\x1d\xa7\xc9\xc5\xb6\xcc\x75\x64\xc5\xe7\xb4\xb1\x1d\x56\x45\x46\xa2\xe5\xba\xbb\x40\x09\xec\xe0\xc9\xcb\x96\x6f\xfa\x5d\xda\x21\x7f\x13\x76\x65\x9e\xd4\x69\x02\x57\x7d\xeb\x4d\x4d\x50\xec\x07\x00\xd0\x38\x82\x09\xdc\xab\x27\x74\x16\x94\x44\x2e\xf5\xe5\xf2\x7d\x37\x7b\xbb\x37\x00\xb6\x3a\x12\xa1\xd4\x1c\xeb\x1f\x34\x45\x91\x97\xfd\x62\xb6\xd2\x50\xf5\x4b\xd6\x8b\xe4\xb4\x05\xb1\x74\x3c\x04\x0b\xf0\x1f\x83\x4c\xed\x00\x82\x42\x61\x5a\xba\xb0\x15\xae\x57\x91\xe6\xcd\xde\x4f\x75\x81\xb3\xa1\x65\x52\x76\x9d\xed\x2f\x97\x4f\x9d\x97\x14\xdc\xe6\x40\x61\x86\xa6\xab\xfa\xea\xd2\x66\x81\xb8\x33\x75\x58\x03\x37\xe7\xb2\x6b\x3b\x7a\x9e\xf0\xa8\x99\x85\x02\xe2\xad\xc4\xdd\x6e\x3c\x4d\x83\xa2\xeb\xca\x2c\x91\x0c\x70\x0c\x14\x9a\x02\x2b\xcf\x52\x40\x48\x14\x0f\x1f\x75\xd8\x30\x29\xf7\x6d\x9c\xe3\x25\x2c\xe5\xd9\x6c\xb7\x28\xf5\x9a\x01\xc1\x4a\x5e\xf9\x7c\x8d\x2a\x9f\xf3\x5a\x7c\x39\x21\x0c\x42\xa1\xbe\x40\x57\x74\x4b\xbc\x4e\x4b\x45\x11\x92\xec\x7a\xf4\x88\x95\x58\x32\xca\x4f\x4e\xb7\xf7\x6e\xbc\x74\xbc\x2d\x44\xfb\x5f\xe1\xc5\xd0\xf4\x2e\xd9\x17\x74\xb9\xc6\xde\xf5\x8e\xf5\x07\xda\x19\xa8\x36\xf9\xb1\x88\x5d\x79\x24\x1f\xbc\xa6\x30\xba\x93\xb8\x19\x0d\x01\x27\x2b\x11\xf7\xb3\xcc\x00\x2c\x7f\xe5\x9f\x01\x0f\x16\x45\xd6\x6b\xec\xd8\x70\xdd\xbd\x83\xae\xd7\x0e\xa2\x9a\x8b\xc4\xdc\x9f\xdf\xf6\x23\x8c\x4a\x93\x39\x40\x03\x77\x2a\xbf\xc3\x7a\x95\x90\xe4\xe9\xc4\xbc\x79\x70\x90\xb8\x4d\xf7\x65\xc5\x81\xeb\x14\xfa\x42\x45\xf8\xcd\x40\x20\x00\xd6\xdd\xb1\xcb\x16\xd9\x6e\xc3\xce\xa8\xb2\x45\xd3\xcd\x89\x13\xb2\xe0\xac\x9e\x7a\x72\x65\xc9\x7f\xcc\xd3\x3c\xb3\x91\x03\x99\x0f\x63\xdc\xe2\x6e\x5c\xe4\xbe\x82\x87\xbe\xc0\xa6\x3b\xa7\x48\xf5\xed\xae\xed\x04\xbc\x43\x15\xda\x3b\xcf\x54\x83\xe8\x0c\xa1\xbd\x54\x98\x77\x5c\xb5\xc7\xf9\x2e\x6d\x2a\x9c\x0f\x90\x0b\xa4\xc6\xd5\x3f\x91\xa8\x14\x58\xbe\x5f\xd4\xf7\xfa\xc9\x00\x9a\xcf\x96\x00\xd5\x6b\x81\x65\xbb\xab\xaa\x69\xcf\x60\x99\x33\x4b\x1f\xc8\xb7\x9d\x20\x3a\x90\xf9\xb6\xa0\xd9\x33\x15\xd9\x3a\x9c\xe5\x7a\xce\xea\x76\x18\x9e\xeb\x80\x1a\x57\x25\x68\x69\x7d\x84\x90\x40\x01\x8c\x9a\xe7\xc2\xb7\x9d\xd5\x75\xe7\x8a\x8b\x4c\x58\xdd\x10\xe7\xdd\x80\x64\x53\x22\x47\xcc\xa4\x74\x95\xe9\x0b\x82\xbd\x06\xa2\x58\xe3\x4a\x6b\x82\x41\x92\x6c\xd7\xc6\x29\xc4\x9d\x72\x05\x22\xde\x01\x6d\x48\x10\x70\x8b\x75\xb0\x27\x01\x2a\x11\xd9\x32\xbd\xee\x2b\xec\x2b\x4f\xc7\x1b\x37\x87\x88\xf6\x5d\x5f\xa0\xb5\x81\xe2\x3b\x94\x37\x10\x96\x8b\xd5\x2d\x8e\xc3\x96\xad\xde\xf6\x64\xb2\xfd\x1a\x3b\x20\xd8\x70\x20\x5a\x3d\x29\xf0\xc5\x3d\x20\x8e\xb5\x3b\xb6\x08\xfa\x1a\xcb\x50\x10\x1f\x81\x6e\x88\x5d\x6f\x35\xdd\x5c\xdf\xa6\x05\x87\x28\x84\x3a\xed\x6b\xf9\x99\x03\x8d\xa3\x9f\x89\x6d\xc2\x66\x4f\xad\xcb\x63\xf1\xc6\x15\x49\x12\x58\x1d\x9f\x48\xde\xa1\xcf\xc6\x4e\x26\xb3\xd0\x85\xb4\x28\x10\x3c\x05\xb2\xf8\x65\xc2\x1c\x1d\xda\xc2\x1f\xb4\xb0\x95\xeb\xe2\x21\xb0\xb6\xdf\xc8\xb9\xeb\x09\xaf\x97\xd1\xd5\x7a\x40\xa2\x33\x0c\xd5\x8c\x2e\x53\x94\x8b\xec\x23\xe3\x53\x61\x5b\x08\x95\x2b\x47\x4f\x15\xca\xc2\x11\x8a\x00\xa2\x35\x3b\xd3\x83\x6d\x45\xd5\xee\x5a\xf5\xb1\x7e\x2f\x89\xe0\x38\x83\xa9\x81\x06\xc5\x19\x30\xb0\x80\x71\x3c\x77\xbd\xc8\x0a\x9a\x3d\x0d\x44\x13\x65\x9b\x3a\x6c\xe5\x75\xf3\x83\xd3\x3e\x00\x70\x9c\xef\xfd\x40\xac\x23\x4c\xe0\xd1\x99\x34\x57\xee\x5a\x8e\x13\x9d\xa9\xc2\xc7\xec\x5a\x6c\x0a\x89\x82\xa0\x31\x24\x21\x62\x85\x51\xfe\x9a\x59\xd3\xeb\x33\x6f\x0e\x6b\xc8\xaf\x75\xbf\x6d\x64\xea\x23\x00\xe5\x7a\x9a\x83\x81\xba\x83\xaa\x5e\x9c\x78\x0d\x9e\xe7\xfe\x78\x4c\xde\x04\x03\xc9\x03\x2f\x23\x76\xa7\x7b\x52\xef\x48\x72\x3d\x81\x0b\xb2\x65\x71\xae\xde\x57\x1b\x06\x29\xb3\xe3\xa9\x68\xe6\x1f\xf8\xd5\xea\x2c\xaa\xa8\x4c\xac\x40\xc9\x6c\x26\x75\x9e\xef\x29\x3a\x37\x6a\xa9\x0a\xe7\xce\x19\x8e\x79\x52\x5e\x3e\x6f\xdc\x6b\x04\x01\xc1\x9d\xf0\xb3\xd8\xaf\xa9\xf1\xd6\x39\xcd\x51\xf9\x68\xa2\x75\xb7\x76\x87\x38\xe1\xdb\x77\x09\x37\xb6\xc8\xbb\x81\x2c\x40\xbf\x00\x89\x40\x82\xae\xd1\x89\xb2\x29\x08\x9e\x6d\xe6\x54\xd9\x43\x4d\xbc\x78\x2a\x36\x94\xec\xb6\x69\x4a\xf2\xb8\xc5\x27\x8a\x2a\x46\x3c\x0f\x1e\x89\xd6\x9e\xe1\x76\xf5\x54\xbf\x1b\x62\x93\xcf\xc6\x7d\x5f\x36\x6f\x29\xdc\x6a\xb1\x51\xe4\x80\x13\x6d\x84\xd3\xd9\x56\xb1\x69\x5c\x87\xd9\xef\xd0\xa9\x81\xc9\x6c\x05\xf2\x4e\x3e\x21\x20\x5c\xff\x3f\xaa\xae\x63\xcd\x55\xa4\x59\x3e\x10\x8b\xc2\x43\x2d\x25\x21\xe1\x8d\xf0\xb0\xc3\x7b\xef\x79\xfa\xfb\xf5\xf9\xe7\xf4\xcc\x5d\xb7\x3e\x75\x43\x55\x66\x46\x64\x46\x46\xcf\x58\x36\x7e\xaa\x33\x5c\x77\xbd\xe7\x3b\x9c\xa6\x65\x5b\xfe\xf6\x88\x34\xaf\xee\x08\xe0\xae\xe5\xfa\x88\xe9\x48\x90\xe8\xdc\x01\xc0\xb9\x66\xc8\xbb\xb9\x40\x41\x4a\xdf\x81\xe8\xaf\xcb\xca\xff\xd1\x17\x73\x0f\x01\x32\xd1\xe6\xad\x64\xf8\xb6\xcc\x21\x43\x00\x03\xe9\x77\xc0\x5d\x1f\xdc\x7b\x1c\x1f\x29\x71\x08\xa2\xf7\xfb\xef\xe2\x2b\xe5\x91\x08\x7a\xbd\x33\x20\x5f\x69\x6c\xe7\x4f\x7c\x9d\x23\x0c\xd2\x6c\xe2\xd3\x48\x3d\x71\xa2\x25\xf6\x12\x9a\xb5\x4e\xe1\x56\x03\x21\x5f\x0b\x0b\x3c\xcc\x6a\xac\xcf\x35\x54\xe2\x65\xc0\x7a\x5d\xb7\x17\xe4\xa4\x6d\x56\xde\xa7\x6a\xcb\x6e\xb4\xe1\x44\x8c\xae\x72\x3d\x4d\x63\xe4\x62\xe7\x26\x1c\x64\xb0\x32\x7b\xca\x20\x60\xa7\x6a\x86\x61\x18\x74\x58\x76\xb8\xc7\xb8\x54\x39\xbd\x74\x7d\x9b\xa7\x5e\xa5\xdf\x08\xf8\xeb\x35\x9d\x65\x7a\xd7\xac\x57\xa0\x7c\xe1\xe4\xef\x63\xfe\x33\xf6\xf8\xdf\x99\x3c\xf6\x1f\x0c\x94\x22\x4c\x34\xb0\xf5\xf7\x63\xfa\xed\x84\xa5\xee\xb3\x4f\x19\x14\x2a\x76\xcc\xf4\x18\xb6\xdf\x6c\xd1\x1b\xfd\xbd\xb6\x8b\x36\x22\x20\xef\xe0\xce\x90\x02\x44\x88\x7c\x27\x26\xcc\x9b\x8e\x41\x7e\x15\xca\xb6\xf0\xd1\xea\x76\x9b\xac\xda\x0e\xaa\x0d\xf1\x0b\x52\xa3\x3c\xfa\x33\x39\x64\x84\xf9\xf5\x51\x7e\x7d\x91\xec\x2e\xcb\x15\xb9\xc7\x71\x4c\x21\x49\xaf\xdc\x5f\x42\xbf\x6f\x8c\xc8\x18\xc2\x42\x7d\x65\xbe\x11\xbc\x5b\xb1\x10\xd9\xd5\xb0\xd7\xf8\xb0\x89\x1f\xab\x55\x40\x23\xc3\x5e\x16\xbb\x37\x94\x64\xb3\xdf\x83\xd5\xb9\x08\x4b\x16\xf7\xaa\xd1\x9c\xe3\x4b\xf4\xf9\x3b\x4f\x62\xc5\x15\x22\x07\x40\x61\xee\x2b\x06\x4e\x91\x9b\x4e\x97\xcc\x17\x0c\x30\x1f\xbf\x91\x20\x6d\xf1\x46\xa6\x29\xbc\x33\x0c\xe6\x3e\x35\x42\x8e\xa8\x92\x5e\x42\xd9\xc4\x00\x42\xd7\xe1\x60\x48\x95\xa9\x1a\x26\xe5\x71\xcd\x88\xd5\x8c\x4a\x84\xaf\xdb\xea\x76\xfb\x57\x21\x8d\xf6\x7f\xfb\x18\xc7\xf0\x79\x46\x2c\xd1\x3d\x83\x85\x88\xd7\x63\x70\x14\x9b\x42\x59\xdd\x46\x21\x13\xb0\xe5\x0f\x97\x20\x56\xfa\xa6\xa0\x25\x35\x57\x37\x4e\x59\x3f\xd2\x5f\xc1\x11\x24\x57\x6e\xc3\xcb\xf1\x84\xbd\x9b\x8d\x93\xdb\x20\xb6\x0d\x90\x0f\xf8\xe0\x91\xcb\xc5\xb3\xfc\xb7\x5f\x83\x1c\x38\x82\xef\xfb\x4c\x71\x4d\x65\x53\x25\xc3\x94\xc8\xb6\xc9\xd5\x30\xbe\x46\xe8\xd1\xac\xc1\x61\x3c\x8c\x62\x5b\x8e\x6c\x09\x65\xe8\x28\x28\x47\x04\x58\x12\x79\xa5\x38\x93\x0e\xa1\x70\x22\x79\x4f\xb1\xf9\x57\xc5\x24\xdb\xe9\xc6\x69\x22\x25\xa6\x70\x0b\x55\xaf\x08\x83\x78\x02\xf6\x89\x63\xf9\xe1\x8e\x74\xd6\xc5\x5a\x87\x6c\x7f\x94\x7f\xe5\x7c\xa1\xe7\x93\xfb\xa2\x1a\x93\x6e\xf8\x36\xd3\x5b\xae\x11\x10\xf1\x3f\x67\x32\x08\x36\x1f\x72\xb2\x56\x8b\x0c\x3d\x5f\x98\xaf\x72\x03\xbd\xf5\x4a\x5b\x4d\x0f\x96\xfc\xa6\xcf\x5f\x6d\x81\xfe\x64\x4e\x92\xce\x37\xa1\x3e\x9b\xfd\xae\x7f\x80\xeb\xff\x6a\xb0\x1b\x09\xde\xa0\x29\x57\x33\x5e\xc9\x6e\xe8\xc4\x3d\x2c\x06\x8b\x34\xcc\x53\xc3\x53\x92\x42\xde\x8c\x4b\xa7\xc6\x47\xb2\xdc\x6e\xb9\x9b\x02\x2f\xc5\x1f\xa8\x76\xaa\xa9\x90\x1b\x27\xf7\x76\x08\x50\x9f\x03\x5a\x66\x86\x79\x87\xbc\xdd\xd8\x76\x9e\x13\xda\x6c\xe1\x2b\x91\x32\x31\x34\x91\x7e\x64\x52\xe6\xc6\x91\xb9\x6c\x73\x5d\x78\x95\x81\x70\xbd\x1c\x93\xcf\xfa\xd1\x4d\x63\x9f\xed\x51\x4c\xd7\xcf\x73\x8c\xa2\x4e\x2a\xfc\x96\xd6\x51\x31\xf9\x8b\xcf\x0e\x35\x69\x2c\x7f\x1e\x5b\x37\xed\xc3\x2e\x6e\x04\x30\xe3\x38\x4e\x49\xb6\xd3\x48\x77\x1c\xf7\xd4\x16\x77\x14\x92\xe4\xb8\x00\x1e\x33\xad\xaa\x5c\x41\x23\x8b\xa7\x0b\x17\xe2\x4d\x93\x5c\x88\x95\xae\x79\x6a\xad\x58\x13\x3d\xcd\x86\x49\x8e\xf2\x2b\x81\x44\x77\x67\xd9\x9a\xa5\x62\x5e\x3d\x9d\x4f\x19\x74\x9f\x68\x26\xe2\xe7\xf9\x45\x0c\xaa\x5c\x99\xcc\xdf\x11\x8d\xdc\x5d\x9a\x3f\x97\x08\x22\x77\xd6\x50\x9e\xdb\x85\x9e\x2b\xf9\x54\xcc\x3d\x22\x0e\x47\x88\x91\xd6\x2f\x27\xc7\xeb\xb8\xe5\x09\x73\x18\x3c\xf6\x71\x29\xc5\xef\x9c\x49\xb8\x96\x29\x6f\x5f\x69\x80\xbe\x4a\x6c\x68\xa4\x2b\x4f\xb7\xce\x55\x64\x03\x79\xe5\x28\xa5\xd7\x75\x9f\xe5\xfa\x9d\x06\x04\x99\x9a\xa1\xdc\x2c\x78\xae\x0b\x35\x76\x85\x3a\x5f\x99\x99\x98\x1b\xe6\x3b\x13\x36\x0a\xd5\xeb\x8d\xd9\x06\xe9\x3a\x3b\x10\x2e\x55\x83\x69\xb6\xdb\x7c\x98\x33\x9a\x5d\x8a\x00\xbe\xd7\xdb\x14\x19\xf1\x66\x7f\xd2\xe8\x03\xef\x70\x62\xc7\x89\x55\x28\x9b\xcc\x53\x5c\x76\x03\xb3\x36\xa1\x98\x64\x4f\xf4\x38\x13\x3c\x4d\xdc\xba\x16\xcd\x5a\x24\xb3\xb3\x22\x5e\xef\x3a\x7b\x3e\x3f\x45\x7a\x4a\xff\xe8\x19\xb8\x63\x8e\xe6\x29\x6a\x7c\x9e\xf0\x39\x8e\x0b\x4f\x24\x83\xb9\x37\xc7\x79\x92\x4d\x8b\xfa\xc0\x70\x36\x33\xf8\xd3\x26\xee\x6b\x34\xd3\xfc\xa3\xb0\x7d\x84\x45\x3b\xba\xed\x5c\x81\x23\xdb\x4f\xe2\xbb\x9a\x51\xf9\x12\xf7\xd3\x44\x43\x3e\xdf\x59\x01\x9c\xa2\xfb\xb1\x7c\xce\xe3\xd6\x2c\x07\xa8\xcd\xdd\x90\x5d\x62\xd7\x65\x25\x32\xe0\xc4\x00\x9b\xae\xbd\x15\x60\x3e\xc3\x6f\x0c\x93\x9c\x15\xad\xbf\xba\x85\xc7\x33\x5f\xda\x62\x69\x2f\x1a\xbb\x6c\xb8\x79\xed\x3c\x81\x1f\x58\x28\xc0\xc0\xd3\x0a\xa2\xdf\xcb\x6e\xac\x0e\xe1\xfb\xa7\xb5\x7e\x0f\x06\xc1\x90\x1c\x01\x08\x48\x4e\xc9\xe8\x1b\x98\x58\x89\x95\xdb\xba\xde\x73\x85\xef\x99\x01\x25\xb2\x3e\x58\x2f\x73\x38\x67\x35\x2c\xb0\x07\x0c\x41\x85\xfd\xac\xd2\xfa\xdd\xb0\x00\x4c\x1d\x01\x91\xc3\xdc\x5f\xc9\xec\xd2\x97\x58\x8b\xd7\x37\x87\xc7\x1d\x22\x0b\x2b\x14\x70\x7e\x0f\xfc\x07\xbf\xfe\xb9\x87\x52\xae\xf7\x32\x6e\x03\xf8\x39\x02\xef\xc8\xf2\x13\xe1\x8e\x9c\x7b\xaf\x14\xfd\x8d\xe3\x12\x66\x20\x45\x90\x59\x10\xca\x23\x12\x4c\x9d\x91\xa6\x65\x72\x9f\x83\x5a\x76\x7c\x40\xe9\x76\x37\x8e\x4a\x15\x60\x3e\x13\x22\x05\x1a\xbb\x13\x96\x49\x09\x0c\xb8\x21\xaf\x2f\x1f\x90\xcc\x02\x11\xb6\xd5\x4d\x9e\x56\x7c\x1a\x66\x8b\x5f\x11\x60\xe3\xa2\x78\xce\xdb\xd7\xeb\x58\xac\x22\x70\xff\xe9\x69\x72\x85\x85\xe7\x33\xbd\x39\xb9\xc6\x35\xd4\x14\xbf\x8e\x42\x8f\x40\x88\x74\x57\x68\x2e\x78\x3c\x9a\xeb\xa6\xc5\x1e\xd6\xb0\x10\xb6\xa5\x52\x31\x15\xd5\xa9\x9f\xc2\x53\xa8\x25\xb2\x9f\x1a\x1b\x0f\x58\x04\xca\x07\x6d\xe0\x08\x1c\xb0\xfd\x12\x9a\x0b\x11\x0c\xc3\xd8\x17\xc2\x26\x4e\x85\xd2\x90\xe4\xe7\xb3\x93\x4b\xef\x2e\xf2\x58\x49\xfe\x48\xa7\x93\x7f\x96\xfe\xdf\xfc\xf1\xb8\x89\x14\x85\x03\xad\xd7\xec\x80\x30\xe1\xea\x4c\xcb\x22\x1a\x99\x71\xda\x35\xbb\x50\xc4\x9f\xf1\x7d\x0e\xe8\x59\xeb\xa3\x85\x15\x71\xea\xe5\xca\x54\x38\x6b\xd2\x5c\x74\x9f\xcb\x96\xa3\xa8\x29\x55\xa2\xce\xaf\x00\x1a\x3e\x61\xb7\x0b\x76\xd5\x7d\x4d\xa2\xf2\x7b\xcf\x73\x5e\xd1\xc6\x55\xbb\xc5\xf8\xa7\x0e\xb4\x16\x95\x8b\x06\x4e\x29\x11\xb2\xa3\x90\xfb\x2a\xef\x82\xff\x6d\x4a\x92\x9c\xd6\xd3\xa7\x1d\xe8\x66\x91\x0a\x2e\xe6\xb9\xcd\x7c\x1b\xd7\x83\x50\x70\x90\xa6\xd9\xde\x08\xda\xa7\x5b\x08\x62\x95\x31\x96\x4a\x73\xb9\xe9\x1e\xb8\x54\x0f\xe4\xa6\x5c\xac\x52\x2f\xdd\xea\x2b\xcd\x15\x29\x12\x22\x10\x73\xd3\x38\x69\x23\xcc\x3c\x32\xef\xb4\xd2\x83\x9d\x2d\x35\x67\xa2\xeb\x31\x1c\x52\x41\x14\x72\x8c\x32\x24\xea\xa6\xce\x35\xa8\xbf\x45\xf1\x9b\xff\x35\x70\x64\x33\xaa\xd5\x0e\x71\xd5\xd8\x46\x25\x85\x1b\x92\x4e\x46\x33\x79\xc0\x84\x4f\xc6\x24\xfd\x07\xcb\x06\xd8\xee\x8e\xf8\xf6\x9e\xd5\x72\xc2\x92\xcf\x81\xa9\x34\xf4\x67\x88\x56\x9e\xe2\xa5\x64\xde\x8e\x9d\xbb\x7a\xd5\x49\x48\xd8\x18\x77\x0c\xc2\xb6\x10\x58\xc9\x99\x52\xdc\xfb\x54\x23\xaf\x7d\xf5\x27\x9e\x49\x14\x2b\x3c\xf8\xcf\xef\x73\x3f\xb7\xb4\x77\x31\xdb\xc1\xd4\x8b\xa3\x67\x3b\x4a\x1a\xd9\x60\x19\xf9\x1a\xbd\x73\x89\xdf\x17\x87\x8c\xa8\x3e\x03\xec\xf6\x80\x87\xc1\x44\xc1\x25\xae\x19\x65\x9b\x05\xca\xf9\xe8\x61\xfc\xa9\xce\x60\x4a\x26\x0a\xfd\x6a\xc5\xc2\x9c\xef\xb9\xbd\x1d\x42\x23\x00\x76\x61\xf0\xd4\x9f\xc7\x4b\xeb\x6c\xf7\x2a\xfd\x8b\x83\xb3\x89\x5b\xfd\xb2\xd8\xc6\xa3\xa4\xfe\xf6\x65\x9f\x2c\x49\x5c\xdc\xa3\x5f\x78\x8b\x9c\x14\xf1\x12\xab\xc8\xa0\x04\xb4\x9b\x30\xf9\x5a\x5c\x9c\x7b\x43\xc3\x26\xe0\x1d\x41\xb8\xc7\xfa\xed\xb0\xaf\xec\xa9\xf2\xbd\xec\x1f\x45\xfa\xf0\x66\x97\x86\x66\xa8\x9d\x37\xcc\xfd\x1c\x49\xd7\xdd\xa2\xc4\xc6\x6b\x0c\x0b\x5d\xff\x30\x88\x46\x68\x2d\x6b\x54\xa3\x2e\x6c\x83\xfa\x51\xf8\xbf\xfc\x4b\xa3\xa6\x48\x61\x0b\x20\x7f\xfa\xd2\x47\x6a\x03\x9d\x3c\xb9\xe6\x96\x53\xcd\x79\x6c\x26\x4e\x2c\x17\xce\x64\x15\x83\xa5\xe3\x40\x1e\x89\x87\x4a\x3c\x7a\xed\x5a\x4e\xd5\x6a\x06\x6b\xb6\x26\x86\xb0\x28\x51\xca\x42\x32\xc1\xe9\x4c\x9d\xd9\x2a\x31\xee\x60\x31\x01\x82\x10\x88\x98\x3d\xb5\x60\x58\x9e\xb6\x5e\x6e\xae\xce\x2f\x4a\xf7\xfc\xbb\x3b\xfb\x7a\x70\xc7\xa1\x72\x9f\xb1\xcd\xd1\x32\x0d\x1a\xe9\x95\xf6\x11\x1e\xc9\x28\xe9\x12\x50\xef\x79\x49\x6e\x85\xf5\x8a\x5c\xb8\x33\x90\x65\xd9\xc0\x16\xc8\xf3\x33\xa5\xf5\x37\xba\x13\x59\xae\x16\x0e\x9a\xed\x52\x11\x45\xfa\x2a\x48\x9d\x7b\x0e\xda\xb3\x17\x06\x47\xf7\x05\x66\x69\xd3\x3c\xcb\x7c\xd7\xc5\x48\x33\x0e\xc4\xe9\x5a\x56\x54\x1f\x09\xb5\x60\x42\x22\x55\xca\x44\xf9\x81\xac\x26\xf7\xdb\x43\x30\xfa\x57\x39\x3a\x3e\xf2\xfd\xfa\xea\xab\x61\x13\x03\xe7\x4f\x4f\xb5\x46\xe9\x52\xeb\x2f\xcb\x0b\x2d\x8c\x7d\x2c\x07\xcd\x79\xb3\x5e\x1c\x90\x2f\x48\x24\x3a\x14\x46\xe8\x79\x9d\xe4\xbc\x1c\xaa\x4f\xe8\x3e\xdf\x87\x7b\x75\xa7\x22\x7f\x35\xbd\x84\xe6\x4f\xd4\x07\xd7\x70\xb2\x44\xcf\xb1\x3b\x3e\x8b\x63\xeb\x13\xe1\xa6\x11\x5b\x45\xe5\x51\xc6\xa7\xef\xef\x7f\x38\x7b\xf1\x52\xa8\x4c\x69\xaf\x8e\x56\x01\xba\x73\x5f\xd4\xc8\x10\x04\x12\xea\xfd\x38\x4f\x6b\x95\xa6\xd5\xea\xae\xfe\x66\xd8\xbc\x42\x00\xa2\xc9\x4f\x90\x6f\x9f\x6e\x8a\xde\xa5\x68\xa1\xab\x66\x3e\xc0\x8b\x9c\xfd\xfa\x99\x83\x81\x78\xb8\x20\xae\x05\x40\x88\x8c\x1f\x28\xae\x2b\xf3\xc3\x8a\x3c\x94\x31\x9f\x71\x02\x30\x4c\xd2\x4b\x56\xf4\xa1\x9d\xf6\x93\x49\x3b\x52\x28\xce\xc4\xca\xdf\xd7\xfd\xab\xe1\x93\xaa\xb9\x0b\xbb\x90\x58\x49\xa4\xfb\xe6\xda\x95\xd0\x7a\x3d\x90\xde\x54\x80\x3c\x03\x12\xe3\x4e\x0b\xa6\x57\x21\x3f\xc9\x53\x35\x9c\xde\x46\x61\x00\x50\xdf\x94\x2b\x50\xed\x05\x1d\x0b\xf5\xd3\xd2\x9f\x5c\xeb\x58\x06\x36\xd3\x73\x85\x9e\x68\xf8\x39\x51\xeb\x26\x35\xb8\x47\x02\x77\x3c\x21\x00\x7b\xfb\x80\x57\x42\x48\x4d\x0e\x02\xe0\xec\x8b\x80\x66\x8f\x5f\xb9\x9c\x7a\xba\x26\x9e\xf5\x40\x4d\xd5\x8a\x0a\x5c\x63\xdf\x55\xf5\xf6\x5d\xcc\xfb\x94\x81\xf9\x36\xdf\x8e\xe8\x86\xc3\xe9\x20\x02\xef\x34\x94\x6e\x03\x50\x37\x0d\xaa\x60\xa3\xfc\x6d\x58\x43\x39\xab\x47\xc9\xed\x8d\x64\xf4\xfb\x29\x92\x88\x51\x13\x80\x05\xe0\x3c\xbc\x4f\x17\xa9\xda\x63\x76\xa7\x77\xf1\xec\x67\x48\xb3\x2a\xf7\x24\xe2\x15\xaf\x52\xd5\x5e\xdd\x31\x75\xda\x63\x61\xe9\x67\x4d\x22\xda\x4b\xb0\x83\x4f\xae\x1f\xc4\xeb\xf5\x4f\xeb\x55\x3d\x4d\x0f\xdb\x98\xfb\xa5\x4f\xae\x3c\x59\x64\xe1\x57\xa9\xfc\x22\x56\x90\x01\xb0\x1b\x3e\x03\x9d\x0e\xdb\xbf\xa2\xf3\x86\x9a\x9d\xe0\x3a\x46\x64\x22\x4a\xc9\x24\xae\x55\x2a\x34\xec\x07\x52\x91\xb3\x3f\x3f\x1f\x47\x64\x34\x06\x72\x0b\x06\xb8\xcb\x1e\x4a\x06\x99\x46\xcc\xfb\xe1\x43\xa8\x26\x80\x37\xd9\xfc\x43\x27\xeb\xe8\x8c\xac\x54\xd9\xc0\x3b\xd4\xe3\xf5\xaf\xb6\x96\x8a\xba\x7d\x6f\x84\xfe\xcd\xe8\xd7\x32\xc9\xc5\x90\xd8\xf3\x51\x20\x00\x54\xac\x51\x6e\xc6\x0d\x70\x04\xc3\x07\x2f\x14\x1a\x61\x44\xd8\xd4\xb4\x7f\x6a\x07\x39\x5b\xed\xaa\x7c\xc9\x45\x61\x14\x4a\x03\x20\x3b\x7c\xa1\x3e\x89\x78\x25\xf2\xbc\x3f\xa3\xc0\x8e\x1f\x9f\x98\xd1\x25\x47\x8c\xdd\x68\x27\xcc\x1c\x9c\xdf\x5e\x89\x1b\x8a\xff\xc6\xb5\xaf\xec\x90\x07\x33\xfd\x28\xb8\xf2\x77\x6e\xfb\x0a\xde\x74\x6e\xeb\x76\x41\xca\x86\x5e\xb0\xe4\x20\x9f\x59\xff\x28\x72\x82\x99\xe8\x6d\x22\xf6\x2d\x1f\x6a\x3c\x8a\xd1\x49\xcd\xaa\xa0\x97\x08\x70\x62\x1f\xa3\x5a\x65\x6f\x58\xb8\xd7\x63\xff\x89\xc1\x0e\xdb\xe2\x81\x2c\xce\xb8\x4e\x99\x12\x00\xf6\x9d\x83\x9c\xfc\x68\x55\xec\x17\xd2\x95\xf5\x4f\xb9\x6d\x00\x43\xc1\x35\xf6\xb0\xa2\xb4\x6a\x67\x6a\xe4\xd7\xb1\x36\xc2\x6c\xcd\x13\xb5\x11\x96\x58\x19\x7f\xf3\xb4\xfa\x4a\x7e\xe8\x9d\x25\xa9\x0d\x1b\xc9\xef\x83\xcc\x62\xf5\x07\xad\xe4\x20\xec\x17\x0f\x1a\x29\xc9\x92\x0b\x62\x9c\x99\x31\x33\xc8\xe1\x8c\xe1\x02\x36\xe3\x8b\x6a\x5c\x20\x3a\x0d\x55\x05\xfc\x73\x9d\x5f\xa3\xbd\x2f\xeb\xfd\x47\x13\x24\xa3\xc5\x79\xdf\x80\xf0\xdf\xd0\xa8\xa9\x42\x31\xaa\x63\x99\xe5\x54\x18\xde\x37\x2e\xdd\x80\x5e\xd2\x7c\xfe\x44\x56\x20\xae\xfd\xcd\xc6\xfc\xa9\x86\x4b\x80\x83\xee\x29\x5f\xbf\x71\xac\x5a\x71\xbe\xd0\x6e\xa9\xda\xef\xf3\x72\x46\xb1\x68\x01\x78\xb1\x17\xab\x72\x8f\x8b\x05\x28\x42\xeb\xf5\xfe\xe9\x42\x1c\x21\x5a\xfa\x87\xa7\x8b\xaa\x4b\xef\x90\xee\x72\xf0\xb1\x12\xaa\xfc\x7a\x9f\x87\x2d\xa7\x54\xcf\x5e\x59\x2c\x74\x61\x15\x20\x06\xb1\xe7\x3b\xe8\x53\xf2\xb9\x25\x96\x54\xa0\x31\xf9\xf4\xb0\x35\x4a\x37\xd4\xa1\x1c\x3e\x7d\xfe\x30\x9c\x12\xe8\xc4\xea\x6b\x9f\x5e\x16\xeb\xbf\xfa\x4c\xee\xd1\x1b\x36\x0e\xd5\x81\x1d\xec\x36\x1c\x56\xb8\xe5\x79\x3e\x8f\x1c\x38\xcb\x2f\xab\x51\x89\x17\x0a\xbd\x41\x44\x2d\xf4\x5d\x2c\x44\xe9\xcc\x95\xbf\x41\xa1\x0c\xd5\xd3\x3d\x87\xfd\xec\x7b\x82\x89\xb4\xdd\xed\x9f\x54\x41\x0a\x22\xa5\xb4\x17\x2d\xad\x7a\x10\x97\xe1\xc4\x10\x80\x21\x72\x62\x61\x85\xf2\x3d\x55\x41\x25\x5e\x6a\xf5\x45\x18\x1e\x65\x67\xf8\x7c\x68\x99\xfc\xef\xf9\xe4\x30\x67\x28\xc6\x2b\x62\x0a\xf1\xbb\x97\x6f\x2c\xca\x7d\xc3\x7d\x75\x07\xc0\x8a\x19\xa6\xc5\x7e\x15\xcb\xd9\x3a\x45\xa4\x17\x27\x02\x8b\x11\x00\xf1\xc6\xa7\x9c\xf4\xda\x8d\xf1\x93\x8b\x68\xb0\x4a\x7c\xfe\x0c\x67\x2d\x2c\xdf\xd3\xe2\x6e\xab\xa2\x30\x0c\xfa\x4e\xb6\xc5\x1a\xcd\x36\x9c\xdd\xe8\xe7\x5d\x94\xd2\x84\xec\x2b\x93\xf5\x1e\x02\x25\xa7\x38\x7f\xde\xc9\x37\x67\xf9\xc7\x7f\x3c\x05\x0e\xfd\xd4\x6c\x14\xc6\xca\x33\x0a\x9f\x2c\xa1\x5f\xa5\x52\xe2\x5d\xda\x85\x2d\xc5\x82\xc1\x31\x00\xd3\x7f\x82\xae\x5d\x3e\xbc\x28\x4c\x6c\x5e\xeb\x7e\x5f\x8d\x7c\x39\x8f\x13\x5f\x7e\xc3\x97\xf0\xd9\x66\x0c\xd9\xec\x26\x34\x78\x70\x1d\x66\xc8\xf2\x9f\x32\x88\x10\x16\x2a\x4a\x1a\xef\xe0\xe8\x4f\x16\xe4\xcd\xf5\xfd\x53\x3b\x2e\xa1\xe0\x38\xe6\x86\x9c\xfe\x72\x25\xd9\x6d\xad\xfe\xae\x0c\x02\x52\xba\xfa\x15\xfe\xf6\x19\x78\x0e\xa7\x34\x36\xe6\x9f\x4b\xcc\x9f\x68\xaa\x24\x32\xc6\xc0\x89\x62\x11\x15\xe1\x61\x75\x5b\x64\x61\x97\xf8\x36\x4d\x68\xca\x9b\x85\x99\x68\x0b\x60\x59\xaf\x7d\xca\xe5\xbb\xcf\x66\xfa\xa5\x70\xbc\x9c\x72\x53\xaa\x30\x00\x45\x9b\x1c\x99\xe8\xd9\x76\x13\x83\x3b\x2e\xd1\x91\x93\x8a\x20\x8a\x14\x4d\xfd\x20\x33\x7c\xe9\x5d\xbe\x87\x4b\xbd\xa5\xc6\x18\x24\x36\xe9\x65\xcb\x24\xcc\x57\xf2\xab\xdd\xe2\x42\xfe\x9c\x2c\xfd\xb4\x95\xb6\x27\x00\xc9\xe6\xd4\xa5\x12\x75\x8b\x60\x30\xa3\x01\x47\xba\x2f\xf6\xa8\x53\x7c\x7f\x09\xdd\x52\xb7\x27\x10\x2a\x83\x7f\x83\xf5\x3a\xa5\x4e\x0f\xda\x9c\x52\x68\x02\x92\xf4\x4f\xa2\x6d\x76\x20\xd1\x7b\xbc\x67\xf8\xd4\x92\xe5\x57\xe5\x1e\x74\x6a\xf4\x67\x78\xbf\x5b\x40\x22\xda\xf6\xe9\x0d\x93\xce\x83\x79\xd9\x6f\xf5\x94\xd4\xb0\x97\x58\x4e\xf8\x95\xdf\xaa\x57\x06\xb4\xcd\x7e\xa6\xad\xe2\x61\xa9\x8f\x41\xc1\x64\x7d\x01\xa4\x9d\x54\x05\x4b\x3a\xcf\xd7\x1a\x07\x0e\x24\x20\xfb\xe6\x9e\xac\x99\x68\x2d\xbd\xbb\x14\xb7\xf8\xfb\x0d\xc9\x23\xe2\x44\xab\x39\x9d\xc0\x94\xd3\xed\x0e\x92\xc0\x05\x08\xab\xdc\x37\x0b\xd4\xfb\x55\xc6\xa4\xad\x01\x20\xab\xa4\x09\x20\xf8\xf2\x98\x66\x3b\xd0\xa8\x8f\xca\xbd\x0c\x78\x5e\x6c\x2d\xca\x3a\xe2\xbe\x9f\xfd\x3f\x58\xe8\x59\xc0\xed\x6b\x18\xb5\x78\xa3\x8d\x47\xb2\x5c\x9a\x11\x84\xd0\x2a\x44\xdd\xd1\x51\xe6\x12\x8e\x82\x6c\x10\x20\xf6\x5a\x2d\xea\xeb\x58\x62\x2d\xd7\x7b\x93\x36\x4c\xf0\xbe\x82\x4e\xaa\x9e\x5c\xb7\x51\x88\xd6\x84\xb2\xad\xe4\x2f\x8e\x37\xf1\x71\x8f\xee\xd5\xf7\x09\xe4\xa3\x9c\x45\x2e\x10\x60\xc5\xdc\xc4\x9d\x85\x27\x19\xbd\xeb\xc0\xa7\x0a\xf1\x75\xec\x77\x36\x63\x7b\xd5\x7e\xb2\xb0\x89\x78\xe2\x63\x70\xa0\xe7\xc5\xfa\x2f\x1f\xe0\x9e\x8c\x48\xeb\x17\xb5\xa9\x51\x6d\x0f\xa3\xec\xf4\xe7\x44\x04\x74\x1f\xe4\x59\x96\xe5\xb2\x7f\xab\xac\x61\xdc\x0c\x01\x30\x7d\xfa\xce\x46\x7f\x6f\x44\x84\xa4\x7a\xe3\xe6\x3a\xad\x97\x03\x96\x35\xd3\xdb\x1a\x25\x0b\x45\xcd\xcd\xe5\x47\xd9\x91\x1d\x08\xe2\x38\x06\xe0\x33\x83\x5e\xcb\x01\xd6\xc5\x82\xa9\x4e\xdf\x11\xb0\x9b\x9e\xeb\x12\xa6\x37\x51\x7a\x31\x98\x8f\x3a\x22\xe9\x4a\xf9\xf6\x6a\x41\xbb\x3e\xe6\xd2\xfb\x57\x77\xde\x59\x12\xdf\x56\x41\xa3\xe8\xef\x50\xb5\x9a\xfb\x1b\xd5\x99\xe8\xe7\xfb\x1d\x24\xf1\x9e\x82\x32\x03\x8c\xd4\x00\x63\x25\x32\xac\x0a\x41\x66\xd1\x3e\x0d\x01\x02\x53\xf0\xf6\x6f\x56\x80\x1b\x6b\x0f\xc1\xed\x60\x1f\xcb\xdc\xa8\xef\x64\xf6\x17\xc8\x21\xcc\xfb\xea\x8e\x31\xcd\x32\x72\xf0\xad\xcd\x64\x60\x92\xfc\x22\x17\x39\x91\x76\x2f\x2d\x02\x9d\x63\x37\x5f\x97\x5b\x8a\xff\x36\x32\xe7\x46\x9b\x93\xa0\xb6\xb4\x91\x19\x6a\x89\x95\xfa\x9b\x83\xd1\xc5\x7e\x97\x2f\x53\x4a\x06\x3a\x68\xa5\xa3\x39\x06\x37\x0f\x4d\xa5\x4f\xe7\x1d\x30\xf5\x0a\x24\x80\x94\xb7\x02\x49\x7a\xcb\x56\xc5\x22\xaa\x99\x02\x5c\x79\xd0\x4a\xa2\x9a\x72\xd2\xca\xd6\xf5\x41\xad\x21\x3e\x94\xcf\xc5\xa0\x70\x37\xb3\xdb\xcd\x37\x82\xc9\xf7\xdb\xce\x1b\xa3\x71\x9b\x4c\xa8\x49\x81\x02\xac\xdf\x70\x89\x7a\xb0\xe2\x5b\x97\x67\x2c\x9b\xdf\xe5\xe3\x4b\x99\x8c\x19\xcc\xf1\x99\x7e\xbb\x7f\x75\xcb\x5e\x28\x02\x68\x72\xe5\x37\xf0\x2c\x49\x42\xe9\x2c\x17\xa7\x9d\xa9\x57\x74\x72\x01\x92\xe5\xfb\x45\x34\x3a\x62\x50\x10\xc1\x28\x12\xec\xdd\xc3\xe3\x10\xbb\xa1\xd4\x2a\x96\x53\xcd\x7e\x0f\xce\xda\xb8\xd3\x6a\x0f\x88\xd1\x32\xa7\xda\x90\x89\x41\x30\x98\x0f\x6e\x2e\x07\x88\x41\x4d\x41\x87\x7b\xe3\xad\x8f\x18\x00\x08\xb1\x7b\xaf\xeb\xb9\x38\x06\xd5\xd5\xf3\x78\x9e\xdb\xb8\xf3\x7b\x46\xfb\xa6\x6e\xa3\xe0\xae\x8b\xf6\x64\x18\xeb\x1f\x50\xf3\x93\x12\x0d\xef\x15\xb6\x42\x2b\xf4\x31\x89\xa6\x93\xae\xc1\xc3\x8e\x72\x79\x57\xb0\xfe\x66\x21\x80\xe3\x97\x5d\x43\x14\xc9\xf3\x91\x21\x08\xf4\x2a\xa4\xe8\xfb\x12\x3e\xe5\xae\x35\xcb\x51\xa8\x87\x41\x89\x94\xd2\xd0\xef\x07\xe9\xea\x42\x0d\x4f\xe8\xb2\xac\x7a\x1b\x37\x66\x41\x30\xc6\xdb\x6c\x91\xf1\xcb\x78\x32\x46\x0a\x00\x22\x20\xf3\x44\x17\x52\x7b\xf8\x29\x9e\x1a\x31\x01\xad\x36\x33\x07\x65\xd1\x30\x79\x66\x78\xd6\xfb\xaf\x67\xcd\xad\xc9\xce\x6d\xc5\x77\xa5\x87\xe4\x82\x48\xbe\xef\xfb\x34\x54\x7c\xa4\xf5\xb2\x3c\xcb\x02\xdf\x00\x08\xb5\x13\x99\xd9\xdf\x23\xc5\xb6\x7b\x8e\xc8\x71\xcc\x7c\xe9\x42\xf2\xcd\xa8\x78\xa7\x68\x34\xbb\x51\x73\xb5\xfc\x6d\xd0\xe2\xa2\xbf\xfa\x91\x4e\x62\xbe\x9c\x36\x3a\x82\x81\x22\x05\x7b\xbe\xf1\x8a\x60\xa2\x69\x5e\x11\x2a\xe5\x4b\x90\x61\xf6\x1c\x04\xab\x71\x95\xae\xe6\x86\xfe\x15\xdd\x4c\xa6\x18\x19\xbd\x2c\x3d\x12\x16\x9f\xc4\xf8\x3b\xbb\x56\x1f\x8b\xed\x60\xda\x65\x5f\x95\x6e\x51\x3a\x1b\x6d\x5b\x0f\xdd\x0e\x8b\x72\x40\x91\x00\x80\x37\x00\x27\xe2\x5e\x48\xd4\x4d\xc6\x0f\xca\x42\xb4\xd9\x27\x12\x52\x10\x79\xac\xfc\x8c\xb1\xb7\x7d\x41\x0f\xd0\x1a\x1b\xfd\x50\xe1\x43\x60\x7e\xea\x83\xcb\x72\xe3\x46\x24\xae\xa5\x85\xe7\x91\x40\x04\xa9\x0d\x0c\xe6\x3e\xb2\x81\x96\x65\xf1\x35\x27\x98\x94\xf0\xb1\xaa\x98\x22\x64\x76\x09\x2a\xbd\x72\x9a\xba\x4e\x9b\xd2\x1f\x26\xff\xb7\xcf\xdb\xbd\xbc\x98\xb0\x98\x3e\x79\x87\xf5\xf8\xa6\x7b\x07\x44\xd6\xee\x32\x3b\x92\x1b\x7d\x08\x52\x22\x65\x18\x0c\xeb\x92\x0c\x60\xe6\x1e\xd3\x70\xb7\xa8\x29\xec\xf2\xde\x17\xc0\x5d\x64\x10\x42\x75\x7e\xe2\x75\x0b\xba\x74\xde\xf6\xd4\x43\x2e\x83\xb5\x22\x9b\x57\x70\xe9\x0e\x70\x77\x7f\x47\xe1\x48\xf4\xde\x44\x78\x37\x00\x6e\x48\x01\x8a\x84\x2a\x02\x58\x5c\xa2\xa6\xa8\xb3\xef\x9b\xbd\xe4\xd6\x1e\x0f\xe5\x5c\xdb\x66\x3f\xf8\xe6\x60\x2b\x48\xc2\x01\x72\xe1\x6f\x8d\x79\x86\xdd\x1c\x2b\xc8\x52\x60\x0e\x79\x5f\xa2\x17\xfa\x2e\x4c\x93\x62\x64\x3a\x6a\x8b\x84\xf5\x06\x24\xc3\x19\x46\xc6\x1d\x59\x0f\x4d\x3b\x8d\xe7\xa9\x09\x92\x2c\xdf\x09\x17\x3b\x24\xe2\x63\x3b\x84\x56\xb5\x59\x81\xf6\x4e\xfe\xb9\xde\x05\x86\x44\x1d\x9e\x80\xaf\x49\x1b\x14\x92\x1a\x46\xee\xfb\x3d\x80\x2f\x93\xd8\x18\x9b\x1c\x4f\xfd\x79\x01\x64\x73\x76\x03\x10\xe4\x08\x46\x16\xd8\x9d\x1d\x0b\x23\x9d\x95\x5f\xa3\xba\xd4\x5a\x64\xdc\xc8\x4b\x10\x6c\x65\x77\x26\xb0\xc6\xc3\xfe\x57\xe3\x7a\x6b\xba\xee\x40\xcf\x52\x3b\xa9\x1c\x4e\x0b\x50\x41\xb7\xcf\xc2\x07\x96\x59\x3f\xd3\x53\x08\x10\x46\x17\x56\x0e\x61\xf2\x15\x43\xac\xf1\xd3\x81\x7b\xf0\x3b\xaa\x83\x90\x25\x9b\x3f\xbd\x15\x3f\xc4\xd3\x47\x8c\xcb\x4a\x4b\x2d\x6e\x7c\xf0\xef\x30\xf6\xce\xf3\xeb\x89\xec\x2b\xda\xef\x5a\x70\xe9\x6a\x9c\xfc\x76\x40\x48\x03\x80\x09\x00\x6c\x05\xb9\x81\x2c\x7e\x62\x4f\xe9\xcc\x00\x1c\xef\x09\x82\x30\x36\x81\x00\x6c\xf9\x16\xa0\x4b\xd6\x58\x36\x83\xbc\x3a\x83\x5d\xc6\xa6\xa7\x35\x79\x72\x15\xba\x58\x64\x4f\x99\x9d\xa2\x87\x68\xa1\x8b\xf2\x8f\x3e\xe4\x26\xa9\xcb\xd4\x72\x59\xac\x86\xd1\x02\x92\xb8\xfb\xf1\xdd\x34\x30\x1d\x63\x1c\x00\x04\x81\x2c\x05\x33\x70\xcd\xf7\x5a\xaf\x0b\x11\xa7\x93\xd3\x49\x26\xda\xfa\xc0\x24\xfd\x47\x62\xf4\xe6\x40\x61\x0b\x13\x02\x3e\x70\xb8\x4f\x0a\x3f\x1f\x85\x62\x2c\xb4\xfe\x7c\x2a\xad\xca\xe5\xe8\x63\xe1\x0f\x65\xd7\xb4\xfa\x71\xa8\x46\xee\xb3\x7e\xc1\xce\x0c\x73\x5d\x03\xe3\xfb\x10\x39\x92\x4c\xf7\x3c\x26\x58\xfc\x38\x54\x07\xa6\x8f\x40\xb2\xa7\xbe\x8b\x31\xe9\x06\xa9\x80\xcd\xf4\x26\xe4\x4d\xf2\x53\xdf\xed\x45\x65\xb3\x5c\x0d\x8e\x12\xf0\x03\x7c\x93\x5a\x4d\x6e\x38\xf1\xa8\x0c\x3e\x78\x20\x5c\xfd\xdf\x9c\x49\xb1\xf5\x63\xea\x4d\x8f\x2f\x03\x17\x4b\x89\x9c\x81\xab\x8b\x20\xb9\x96\x03\x0d\xe6\x40\x50\x90\xa2\x70\x95\xf6\x22\x57\xf9\x02\xc6\x9d\x60\x92\xed\xec\xcc\x57\xf8\x92\x7a\x3d\x47\x78\x2a\x98\x84\x66\x26\xbe\x8b\x61\xe3\x1e\xaa\x07\x87\xbf\x59\x76\xa4\x73\x6d\xb6\x3c\x8b\x5e\x65\x59\x0f\x6e\xf7\xc3\xde\x7e\x4e\xfc\xa0\x01\x28\xbc\x8c\x93\x31\x08\x26\xce\x89\x7d\xc7\x70\xff\x73\x27\x68\xe7\x2b\x6d\x75\xa6\x5e\x6b\x3a\xad\x84\xa7\x9f\xbc\xef\x5b\xa4\xff\x1f\x9f\x58\xe7\x08\x5f\xe8\x44\x77\x35\xb8\x35\xf3\x49\xa4\xd1\x8b\x40\xc3\x4e\x42\xe7\x4c\xb3\xd9\xfb\x00\xd4\x59\x89\xeb\x5f\x8d\xe4\x61\xe1\x64\x2e\x44\x12\x6c\x2d\xb1\x12\x4b\x0b\x50\x06\x8d\x18\x23\x02\xc6\x35\x9f\x63\x6f\x5c\x62\x7e\x5c\x80\xa6\x1a\x74\x3b\xae\xee\xc7\x72\xd4\x64\x1a\xdc\x6e\x5f\xd2\x71\x5d\xaf\x32\x6c\x8a\xbc\x10\xec\x37\x34\xbc\xeb\xf0\x42\x13\xbe\xe5\x44\xb1\x90\xfa\xa5\x99\x13\xe1\xd3\x94\x9b\xb1\x06\x81\x64\x5a\x7f\xb2\xf9\x07\x66\x20\xdf\x70\x66\x27\xd8\x9d\x45\x3b\xe7\xe4\x07\xec\xb3\xab\x4a\x99\x2a\xe3\xcf\xf9\xbd\xcf\x77\xfa\xe8\xa7\x57\xed\xba\xa1\xe0\xef\x6a\x62\xdf\x4f\x5d\xfe\xfe\x87\xe3\x49\x4e\x9f\xe5\xda\x59\xa8\xaf\x12\xb9\x7c\x95\xfa\x1a\xcd\xb4\xa5\xd8\x56\xb1\x6e\xb5\xb8\xe5\x2b\xbc\x04\x0c\x02\x67\xee\x45\x56\x59\x14\xf3\xed\xe7\x4f\xa8\x0e\x67\xb5\xba\xd3\xea\x76\x8b\xa8\xf8\x65\xb8\x55\xb3\x43\xef\x8a\x4a\xba\x8d\x52\x28\xe0\xcc\xdf\xff\xcb\x55\xc7\xeb\x96\x04\xa1\x09\x38\x61\xfe\x28\x77\xd7\x04\xdd\x1e\xd6\x22\x62\xc8\xee\xf6\x20\xd7\x0d\x2d\x23\x6c\xca\xf9\x2b\x81\x66\xe7\xf8\xa1\x43\x09\x54\xc2\xfe\xe0\xe6\xdf\x99\x29\xff\xf2\x24\xa1\xbf\x93\x10\xd3\x47\xe4\xc6\xec\xe6\x54\x2d\xfd\x7a\xc1\x87\xf1\x81\x07\x35\xaf\xee\xe4\x5a\x95\x1b\x79\xa7\x99\xb3\x2f\x49\xe5\xbe\x0b\xa2\x7f\x22\x9f\xd2\x7d\xfd\xe5\xf3\xe6\xdf\xbd\xc8\x70\x5f\xea\xd1\x9d\x3d\xe1\x9a\xdc\x9b\xcd\xa5\xd7\x8e\xd6\x4f\xdd\x78\x7d\x5f\xed\x18\x74\xf2\x0b\x66\xed\x6d\x4f\xa9\xcd\x06\x89\x4c\x0b\xd6\x25\xcc\xdd\xe2\x8e\x9f\xc7\x77\xe3\x40\x27\xbf\x3f\x7f\xb1\x33\xea\x15\x68\x52\xd0\x7d\xeb\x67\x24\x47\xad\xa7\x3e\xb6\x68\x4e\x70\xba\xfe\xd6\x56\xf5\x9b\xca\x96\xad\xde\xdc\x1a\xf5\x39\x8c\x59\xfb\x5d\xad\xca\xec\xf1\xcf\xa7\x52\x05\xbd\xf3\x3f\x9f\x2e\x3e\x94\x44\x04\x20\x49\xe8\x46\x64\x08\x4b\x3f\xb3\x6b\xb0\x7d\x50\x39\x85\xc1\xea\x4e\x24\x8b\xb9\x6f\x5c\x50\xe6\x64\x6e\xc3\x1e\x50\x21\x7b\xd1\xed\x87\xb9\x96\x09\xdd\x6a\xac\xe6\xc6\xf7\x33\xfe\xc5\xad\x6b\x0d\xad\x6b\x3e\x38\x27\x6b\xec\x61\xc5\xb6\x65\x91\x8b\x38\xba\x1e\x14\x89\x28\x6f\xad\xbb\xa9\x41\xc7\x16\x5b\xdc\xb3\x07\xc1\x9c\x97\x70\xe5\xec\xfd\xfd\x3b\xc7\x65\xd4\x1a\x29\xb9\x6c\x5f\xd6\x6c\xc6\xbc\x29\x84\x3f\xe4\xf8\x93\x8f\x58\xee\x98\x2f\x8c\xb2\xf6\xea\xfb\xac\x29\xac\xbc\xbf\x79\x55\x94\xf9\x91\x0b\xa0\x30\xb2\x7f\xf3\xbb\x5a\xd6\x68\x52\x30\x7d\x6b\x99\xed\x8c\xa5\xca\xa9\xab\xb9\x3e\x11\x57\x6d\x3a\x65\x6d\x50\x85\xe5\x52\x12\x6b\xce\xaf\x03\xeb\x6f\xd0\xf6\xb7\xb5\x42\x35\xfc\x30\xc7\x73\xd0\x9f\x56\x90\x1d\xbf\xfa\x65\x45\x6b\x50\x75\x89\xb2\xb8\x80\xc2\xe4\xed\x2e\x8b\x34\x08\x69\x64\x83\x70\xd6\x86\x33\xed\x73\x81\xd8\xc6\x4b\x69\x48\xfa\xa4\x8c\xdb\xc1\x0d\xf9\xeb\xed\x12\x1d\x4b\xc7\xff\xab\x87\x21\x24\x11\xa6\xf5\x29\x27\x10\x4c\x95\x93\x8c\x8d\xbb\xdf\x2f\x4d\x8b\xd7\x74\xe8\xbd\xcf\x98\x2b\x67\xfa\x7d\x4b\x21\xa6\x7f\xce\xb7\xf9\x76\xe5\xb9\x02\x44\xfd\x5f\x0e\xd0\x76\x96\x9e\x3d\x2d\xe3\x85\x6b\x55\xb0\x9a\x53\x3e\x30\xb8\x11\x1a\xd2\xc8\x1f\x4f\x61\x80\xc2\x61\x68\xae\xa9\xd6\x82\x1d\xbf\x3b\xac\xcb\x0f\xc5\xaf\x29\x97\xca\xff\xac\x47\xba\x2c\xc7\xe8\xc5\x3f\xdf\x33\xc9\xd5\xb0\x7d\x93\xbb\x16\x6a\xc7\x08\x69\xd7\x9a\xdc\x97\xfa\xf1\x58\xb1\xb9\x3b\xca\x59\x0f\xfd\x73\xbc\xb4\xc2\x3b\xd9\x4b\x65\x5b\xd5\xfe\xb0\x39\xcd\xa9\xd3\x76\x2f\x15\xb3\x4d\x72\x3d\x50\x25\xf1\xfa\x6f\x1e\xb9\x05\x13\xf5\x45\x5d\x42\x65\xb1\x0b\xe5\xe8\x9a\x6f\x66\x9f\x96\x51\x4e\x06\x4f\xe4\x0b\x84\x19\x4b\x6f\x17\x43\xe7\x33\x1f\x60\x1b\x27\x4f\x5e\xb8\xa9\xe9\x7d\xcd\x44\xd3\x8f\x8a\xb0\xdf\xcf\xfe\x6f\x1c\x0d\x9b\xaf\x9d\x08\x86\x93\x8b\x58\x84\xdc\x23\xb8\xdf\xf3\x2e\x50\x48\xd6\x97\xd1\xa1\x9e\xb4\x41\x09\x5f\xda\xcb\xb9\xa7\x74\x3b\x98\x9d\xbf\x23\xc6\x90\xb7\x2c\x8f\x8c\x2f\xfe\x1f\x6c\x1b\x4c\x7a\x63\x3b\xf9\x0a\xdb\xa5\x6a\xe1\x15\x76\x01\xcf\x22\x54\xcc\x9b\xbd\xbc\x12\xa6\x5c\xc8\x4e\x1b\x76\xef\xb0\x0b\x9b\xc5\xb1\x21\xea\xe6\x0d\x16\x53\x9b\x15\xe3\xed\xa8\x3d\xa7\x1a\x4d\x2a\xf6\x8b\xd5\xff\xfd\x3e\xa5\x76\xbd\xd1\x55\x44\xed\xcf\x33\xba\xf0\x7b\x28\x87\xe0\xa3\x5b\x3f\x16\xb6\x76\xe2\x98\xed\xb0\x83\xf7\x7e\x3a\x6e\x1b\x5a\xc9\xfd\x9a\x31\x36\x9c\x8d\x0f\xce\x69\x38\x62\xc6\x5e\x15\xd9\x56\x42\x11\xaf\xed\x19\xfd\xea\x5e\xf8\x50\x9a\xa0\x2f\x67\x89\x2d\x9f\xaa\xf5\x26\xc7\x34\xf9\x72\xcc\x16\x81\xe6\x83\x04\xb3\x2f\xaa\x8a\x85\x6c\xf5\x4b\xaa\xd2\x90\xa5\x4f\xaa\x07\x1a\xbd\x87\xea\xa5\x54\xf8\xdd\x45\x19\xfb\xed\xfe\xfd\xfb\xf0\x50\xbd\x11\xe5\x19\xb5\x5f\xf6\x5c\x08\xed\x12\x2b\xb1\xea\x84\x24\xef\x6a\x08\x8a\x4c\xc4\x5e\xd2\x66\x54\x0c\xd7\x51\x17\x12\xdc\xd7\x8c\x0d\xb1\x85\xae\x52\x15\xf0\xbc\x3b\x77\x12\x9d\xa5\x73\x9f\x2c\x05\xea\xcf\xe0\xcb\x3d\x87\xc7\xfc\xbb\x23\xaf\x5e\x59\xff\x86\xde\xaa\xb5\x4b\x3c\xa7\x31\x9e\xad\xee\x04\x9d\x76\x8c\x7c\x75\xa3\xe1\xa0\x49\xb3\xa5\x6e\x3e\x13\xc5\x9f\xe8\xcc\x2e\xe3\xf4\x24\x73\x17\xe3\x8b\xed\x45\x6f\xaa\x00\xe3\xb9\x6f\x33\x2a\xf6\xcc\xe6\x48\x7d\xf9\xd5\x78\x3f\x3b\xe5\x6a\x4e\x27\x61\xca\x73\x89\x85\x4f\x0c\x1b\xb2\x11\x73\xb9\x2a\x86\x37\xff\x06\x60\xad\x83\x4e\xaa\xe1\xdb\x13\x9e\x2d\xe5\xb9\x53\xea\xbf\x4d\x86\x66\x0a\xf7\x3b\xe4\x87\x1a\xf2\x94\x8b\x1d\x56\xd2\x49\x35\xb7\xbf\x9f\x0d\xf1\x77\x56\xc0\xbc\x9c\x5e\x62\x1b\xaa\x57\xaf\xf3\x6e\xd2\x68\xed\x8f\x90\x45\xc8\xd9\x3a\xdc\x61\x5c\xf2\x7a\x09\x26\x3a\xc7\xae\xf7\xd3\x84\xa3\xf6\xa2\x82\x45\x7c\x61\xf4\xac\xc6\x17\xa7\xb4\x18\xb8\x83\x4d\xbe\x67\x79\x6c\x85\xfd\x2d\x2d\xbf\x7c\x52\xeb\x6c\x3d\x2f\x02\xde\xc4\x4c\x20\x29\xca\xb5\x6c\x9a\xe3\x7e\xd4\xdd\x81\x8e\xdb\x06\x93\xbf\x18\x03\x2e\x15\x4d\x96\xf8\xcb\x0d\x6b\xb2\x7b\x99\xd2\xd5\x00\x7b\x6a\xbd\xc5\x0d\x81\xf8\x67\xf7\x4f\x3d\x9d\x5d\xbc\xcb\x20\x6f\xb7\xf1\xf9\x36\xdf\x56\xd4\xde\xd8\x22\xcc\xc3\xaf\x81\xdb\x91\xfd\xc4\x4f\xc8\x9b\x4d\x2c\x6a\xda\x5d\xa0\xb3\x51\xb4\xd1\x6d\x45\x1f\x6b\xf3\x54\xe0\xde\xe1\xfd\x8d\x3e\x17\x15\x8d\x42\x11\x1e\x87\xb8\x4c\x03\xa8\x59\xa7\xaa\x07\x5a\x97\x5b\xe6\x33\x41\x6f\xf2\x26\x94\x15\xd9\xa6\xe7\x50\xe5\xc9\x46\x18\xd3\x11\xf7\xfb\x15\x0e\x9f\xdf\xdf\x21\xd1\xcd\x28\xc7\xb2\xa6\xd9\x5f\x52\x52\xc5\x96\x60\x9e\xc8\x03\xa8\x48\x8a\xef\xfe\x69\x12\xb9\x5a\x3c\x1e\xe2\xe3\x29\x99\xef\x8f\x33\xa7\xda\xa0\x89\x93\xaf\xda\x05\x2a\x09\xb2\x5f\x16\x96\xc8\x9c\x89\xa3\xa0\xa5\x66\x7b\xfe\xe7\x5e\x09\x9a\xcb\x16\x77\x46\x79\x4b\xe2\x7a\xa2\x66\x77\xea\x59\x08\x2a\xf7\x1b\xc7\x4f\x5d\xad\x15\x2b\x34\xd7\x39\x12\x6a\x91\x04\xef\x21\x5f\xda\x19\x9b\xc8\x93\x7f\x9c\x2a\x30\xcf\x15\x57\x4a\xb3\xe4\x14\xfa\x79\x84\x6f\x66\x14\x0b\x2a\x22\xf2\x8b\x4f\x5f\x09\xe5\x7d\x9b\x17\xf7\x52\xd0\x4b\xad\x07\x9c\xf2\x5f\x4c\x58\x2e\xbb\x7a\xf1\xdc\x24\xda\xf5\x3f\x33\x1a\xee\xd1\xf3\x66\x77\x95\x29\xd8\x34\x3c\x6b\x4c\xa1\xad\xaf\x07\xc1\x3f\xce\x19\xa3\xb1\x9a\xde\xf2\xd7\xe7\xb9\x31\x4b\x2f\xb7\x2f\xc9\x47\x21\xc8\x14\xe2\x55\x3e\xaf\x79\x1f\xa9\x71\x0d\x57\x41\x43\x49\x14\x25\xca\xec\x25\x37\xbb\xaa\x3d\xc6\x22\xfd\xed\xc9\x49\xb7\xb8\x73\xf8\xb1\xd1\xa9\x50\xca\x1b\x42\xb2\x24\xd3\x19\x13\x85\x2b\x67\xbb\x60\xd1\xec\xc6\x97\x7a\x25\xad\x34\x11\xee\x8b\x7a\xc7\x18\x34\x42\x7a\x4f\x06\x60\xcc\xd6\x30\xf1\x35\x02\x8c\x6e\xc1\x99\x8e\xf4\x83\x2b\x36\x1b\x68\xcc\xf8\x59\xf3\x01\xc1\x6f\x4a\x41\x8e\x25\xfd\xfa\x4f\x6c\x49\x3f\x75\xba\x63\xf1\x8b\x4b\x73\x17\xb3\x88\x97\x6b\xc1\x70\xab\xbf\xb7\x4a\x19\x61\x7f\xe2\xae\xd5\xfb\x42\xef\x67\xb2\x23\x36\xc5\x85\x34\x32\x63\xc4\xe3\xfb\xfb\xce\x50\xb3\xf1\x3e\x7d\x2c\xf8\x7e\x76\xa5\x7c\xb6\x0e\x93\x58\x49\x57\x2a\x94\xef\x81\xde\x98\xb1\xe1\xaf\x4d\xb9\x33\x55\x7c\xfe\xbb\x0b\x49\x5d\x0b\xa1\x35\x1e\xba\x24\x5f\x45\xdb\x10\x04\x96\x74\x97\xf7\x9e\xaf\xb4\xa4\x5b\xb8\x75\x25\x68\x16\x7c\x37\xe1\x82\xc7\x24\x2d\x55\x9e\x80\xdd\x0b\x09\x21\x44\xe7\x6f\xd9\xea\x23\xa1\x3d\xce\xd2\x58\x13\x42\xbb\x3b\x7f\x12\x7f\x35\x25\xdc\xd3\x47\x6c\x99\x17\x65\xad\x0e\x30\xcd\x76\x2e\x29\x81\x19\x46\x10\xcf\x77\x2a\x24\xc4\x72\xb3\x0a\xfe\x3c\x59\x67\x7c\x2a\x27\x24\x11\xe1\x07\x33\x57\x2d\xdf\x1c\xde\x8b\x8c\xe0\xea\xb9\x1b\xbb\x8c\xb2\xd7\x12\xf1\x13\xd9\xbf\x95\x0b\x0d\x1b\xb5\x46\x89\x3d\x81\xdf\x34\x01\x5b\xbf\xa5\xbf\x1e\x09\x5c\x27\x57\xd0\xc0\xca\x57\x63\x51\x15\x99\x68\x0b\x4c\x6f\x83\xc1\xe8\xe9\x98\xf8\xfa\x63\x55\x7c\x76\xeb\x2d\xaa\xef\x6b\x4b\xbc\x17\x06\x39\xfc\x5c\x4e\x86\x0e\x12\xfb\xbe\x3b\xc8\x8e\x31\x3a\x3f\x43\x87\xe3\x70\xca\x9f\x48\x4f\x24\x95\x89\xa6\xdc\x55\xf9\xc1\xff\xb2\xd5\x4b\x37\xc7\xbc\xcd\xfb\xdf\x59\xd3\x4f\x79\x3c\x55\xf3\x8d\x07\x11\x83\x69\x41\x77\x89\xee\x9e\x03\xee\x39\xc9\xc5\xf0\x59\x1e\x18\x92\x9b\xbd\x51\x69\xa2\x94\xa1\xa9\x10\x8a\x4d\x95\x22\x27\x1a\xf3\x67\x12\xac\x9f\xf2\x3d\xab\x6c\x16\xad\x38\x13\x9d\xe5\x04\x25\xa7\x97\x2f\x51\xce\xd7\x92\xf4\x3e\x7e\x7c\x0c\x04\x8b\xdb\x3f\x67\xf3\xf9\x7b\x36\x8f\x47\xde\x7d\x52\x47\xd6\xd6\x40\x89\x50\xff\x19\xf7\x13\xad\xd7\xc3\x93\x09\xf7\x10\x44\x3b\x31\x28\x7f\x26\x35\x58\x31\x5a\xb6\xbc\x1c\x2f\xa4\xd9\xb7\xbe\x34\xdb\x8f\x98\x23\xd9\xb7\xff\xc9\xf5\x9e\xda\xfa\x0c\x23\x08\xc2\x6a\x8c\xe9\x4b\x72\xc8\xfe\x41\x6e\xfd\xde\x20\x88\x17\x8b\x65\x9f\x7f\xc4\x17\xf5\x3b\xcb\x32\xea\xc7\x2e\xb4\x9f\x65\xc0\xda\xc5\xad\x78\x13\x0d\xde\x5b\xce\xad\xdc\x8d\x0e\x14\x64\xa8\xaf\x91\xb5\x0a\x01\xa5\x9e\x97\x22\xe5\x08\x55\xa3\x62\xf4\x10\x95\xcf\x25\x7e\x1b\x06\x10\x45\x19\x34\x13\x95\xc5\x1d\xd1\xcf\x4c\x1c\x88\x4e\x42\x77\xab\x1d\xc7\xdc\x09\xdd\x09\xda\xf7\x95\x7c\xbf\xe2\xdf\x58\x2a\x1e\xb0\x09\x71\x5f\xda\xa2\xd5\xe9\x25\x1c\x3c\x07\xa3\x4c\x30\x40\x1a\x8f\xbd\x88\xa6\x3b\x5f\x78\xa1\x1c\x1a\xef\xf5\xe1\x54\x5a\xab\x03\x5c\x2b\xc9\x61\xf6\xd6\x39\x02\x29\x69\x88\xa2\xc3\x22\x1c\xc7\xd1\x5e\x33\x2a\xaf\x6c\x66\x43\x7f\xdf\x43\xb7\x76\x82\xda\xe2\x3e\x17\x51\xc7\x36\x0a\x65\xd5\xac\x54\xe5\x27\xe1\xfc\xce\xc9\xc5\x25\x57\xa0\x3f\x1d\x83\xc4\xe2\x41\x38\x47\x1e\xea\x52\x70\x20\x62\xd8\x5a\x6e\xfb\x73\x11\xaa\xab\x12\x2b\x71\x3f\xc3\x91\x25\x4a\xc5\xc2\x97\x47\x9a\x80\x31\x15\x42\x34\xfd\x79\xc7\x42\x49\x22\x52\x86\xb0\xa5\xe7\xc1\x0f\x82\x32\xf3\x3c\xb7\x13\x0c\x44\xa7\x38\xfd\x09\xfa\xf7\x0a\x5f\x1d\x07\x81\x50\x2c\x8f\x47\xae\xe6\xea\x5f\x51\xaf\xfe\xb0\x25\xcb\xff\x2c\x03\xe1\x12\x2b\x55\xae\x70\xff\x6c\x79\xb8\xe7\x48\x1e\x17\xe5\x71\x2a\xf2\x0f\xff\x3f\xfb\x32\x1d\x51\x42\xe3\xbe\xfc\xa7\xd0\x3e\xf2\x5c\xf0\x30\xce\x17\x9d\xa6\xd3\xe8\x9e\xe4\xfb\xc9\x9b\x3e\x51\x57\x1e\x22\x3b\x28\x24\x52\x90\x37\x94\x6c\x2e\xc7\xe9\xf8\x0a\xfe\xd0\xc4\xcf\xeb\xd7\x43\x25\xe7\xb5\xcc\x71\x3f\x64\xa6\x14\x6c\x80\x76\x16\xa6\x71\x81\xa4\xd2\x18\x85\xd8\x5d\xbe\x2b\xa6\x89\x35\x9c\x40\x67\xe8\x6a\xd8\x19\x82\xb0\xf6\x48\xd1\x52\x15\xf4\xfa\x3c\x31\x0f\xba\xfb\x70\x01\xa5\x36\x56\xa6\x38\x2c\x89\xf6\xfd\x50\xc9\x55\x27\xad\x39\x75\x1b\x39\x22\xdc\xdf\x1a\x0f\x49\xed\xa6\x9e\x6b\xcf\x5b\x4d\x2b\xd9\xcd\x29\x06\x0f\xb4\x70\x7e\xe3\xf1\xc9\x7e\xe9\xdd\xbd\xf6\x86\x81\x59\x12\xf3\x83\x45\x83\x37\x8b\xc0\xae\x1c\x23\xab\x57\x48\x55\xad\xc4\x4b\x07\x08\x09\x72\x87\x6e\x1d\x72\x34\x51\x63\x7d\xe0\x35\x99\x7d\xca\xb4\x5f\xe1\x1c\xe3\x69\x2f\x4c\x96\x73\xaa\x40\x8f\x88\xbe\x7b\x52\x39\x6d\xcb\x6e\xe8\xc6\x2b\x8e\xda\xe3\x49\xef\x18\xdc\xbf\xb2\x86\x44\x0a\xa2\xe9\x95\xf8\x7c\xfd\xea\x07\xa5\xee\x42\x4b\x25\x3c\xbb\x71\x67\x18\x2e\x8c\x1f\x0a\x2c\x64\xcc\xcb\x11\x24\x07\x43\x2b\xa0\x3d\x11\x60\x0c\x83\xc0\xe0\x52\x39\xf9\x23\xeb\x3d\x82\x88\xe6\x83\x91\xdd\x39\xd4\x20\xc6\xb2\x74\xac\x75\x58\x5f\x0a\xf8\x03\xef\x00\xd8\x04\x40\x3d\x27\x49\xa4\xf5\x7a\xe9\x25\x3a\x6d\x78\x9a\x72\xce\x3a\x79\x1d\x8f\x74\x27\x98\xdf\xb3\x9d\xcd\xc0\xfb\xfc\xe4\x0b\x76\xb8\x5b\xe8\xcf\xf3\x4d\xa1\x3e\xbd\x2b\x40\x20\x76\x42\x30\x81\xef\x67\x39\xc8\x17\x3a\x51\x67\x6c\xc0\x78\x6f\xbe\x28\x25\xf9\x52\xf7\x5b\xb6\xbe\xa5\xdd\xb0\xc3\x23\xbc\xde\xcb\x7e\x6c\x8b\x90\xf9\xcb\xe8\xa7\x17\x15\x0b\x4c\x4d\x6e\x3b\x82\x2d\x45\x0a\xb3\x76\x9e\xbe\xef\xb7\xf4\xd2\xc7\xc7\x1b\x1d\x0f\x12\xb3\xbc\x6d\xb1\x30\xc2\x63\x14\x77\xbb\x2d\xd8\x83\x4d\x38\x9e\xb5\x90\xd8\x56\x84\x86\xfd\xde\xf9\x0d\x84\xe0\x53\xc4\x96\xa1\x21\xd9\x7c\x1b\xbe\x8e\xd0\x02\x8e\xb0\x77\xa3\x59\xe7\x8c\x50\x1b\x40\xdc\xa9\xd2\xd0\xc6\x25\x70\x04\x27\x08\x40\x3f\xe1\xb1\x60\xa3\xdc\x8d\x53\x4e\x3f\x1f\xb6\xea\xb1\xc8\xab\xfc\x22\x2d\xea\x4d\x45\x09\x12\x37\x07\x9e\x3b\x47\xd7\xde\x23\x66\x77\x29\x06\x80\x06\xa0\x38\xf1\xf9\xf8\x46\xad\xcd\xc0\x0d\x25\x98\x4f\xa5\x59\x54\xea\xbd\xf6\xb8\x7e\x9c\xe9\x2f\xb7\x91\x9f\x65\xd8\xae\xd9\xa2\x6d\xb4\x0d\xe3\x15\x67\xcc\x68\x7d\xac\xe9\x8a\x1b\x2f\x89\x00\x4d\x13\xb2\x72\x32\x4f\xf4\xb6\x83\x7b\xde\x4f\x69\x75\x95\x15\x67\xee\xcf\x68\x78\xfa\x89\xbc\x79\x13\xdf\xa8\x58\x79\x46\xc2\x01\xf8\x1d\x80\x70\x57\xca\xc9\x04\x70\xee\x77\x50\x7d\xd2\x30\x4d\xc4\xd5\xfa\x36\x4b\xd7\x34\x71\x62\x29\x0c\x43\x1a\xe1\xc1\xab\xcf\xfc\xd7\xc3\x42\x8c\xd0\x5b\x7d\x1e\xa1\x60\x2e\xf1\x9b\x67\x6b\xf6\x99\x03\xf2\x81\x6c\xbb\x3e\x4c\xf7\x60\x4f\xf4\x36\xfe\xdc\x45\xa0\xea\x97\x39\xe7\xba\x73\x7b\x21\xc1\xc0\x76\x26\xa6\x79\xc3\x15\x57\x59\x5f\x6f\x4c\xb3\x73\x08\x6c\xc0\xee\xd6\xad\xf4\x86\x4e\x10\xc2\x3c\x33\xc4\x3d\x39\xb4\xac\x45\x3b\xf2\xec\xbb\xef\x15\x25\xd7\xe9\x7e\x1c\x8c\x2d\x83\xd1\x79\x72\xbf\xf8\xfb\x3b\x4d\xfb\x4c\x23\xf3\x74\xfb\x4b\xc2\xed\x1f\x1c\x95\xb6\xd5\x9d\x01\xed\x02\x64\x8b\x37\x66\xa3\x4e\xe3\x41\xe7\x36\x62\xc2\x1c\x60\xa3\x16\x07\xd8\xf0\xb8\xd7\x8b\xe2\x0b\x52\xe0\xc2\x3d\xc8\x77\xda\x6e\x30\xdd\x1e\x06\x7c\xaa\xb6\x7d\xdb\x46\x25\x07\x4c\x1f\xfa\xcc\xb8\x11\x75\x7a\xfa\xe0\x4e\x09\x76\x26\xd5\x27\xce\xf7\xa5\x11\x26\xfe\x15\x8f\xbb\x76\xd6\x83\x98\x5c\xbf\xd2\x28\x07\x97\x1d\x7e\x2d\xf3\x20\x47\x9e\x0f\x71\x7c\x7f\xe7\xaf\x84\x6f\x00\x05\x3b\x60\xb0\x73\x89\x79\xb6\x3e\x9e\xda\x9b\x60\x5a\x1a\x20\x11\x9e\xf6\x23\xf9\xac\x71\xa6\x65\x56\xd8\x2b\x32\xf2\x80\x62\xb0\x7f\xd8\x95\xd7\x04\x40\xa8\xe1\x0d\xe3\xb5\xcc\xe0\x3d\x31\xfa\x0c\x18\x66\xbf\x36\x59\x5c\xe7\x87\xb9\xe4\xfc\xb3\x08\x78\xce\xde\x13\x29\xcc\x96\xe0\xf0\xde\xf4\xbf\x5e\x46\x32\xc3\xa1\xfe\x6c\xbb\xcb\x0b\x7f\x5f\x5d\x3a\x41\x93\x35\x71\x74\x10\xe4\x83\xcb\x10\x98\xe0\xf4\x36\x8f\x57\x47\x60\x1f\x27\x67\xca\x1e\x30\xe7\xb2\xfb\xf3\xd3\xbc\xe3\x53\xb7\x1b\x34\x32\x84\xfb\xc3\x94\x7e\xbc\x13\xe3\x47\x1c\x1f\xdf\x1c\x47\x28\x00\x08\x0d\xcd\x01\x56\x84\xfe\xfe\x89\xbc\x76\x46\xe8\x73\xcf\x93\xc7\x1e\x96\x02\x31\x63\x1d\xce\x0b\xd1\x86\xaf\x53\x84\x8d\x81\xe3\xbe\xe5\x90\xfb\xbd\x97\xdc\x53\x43\x21\x75\xa3\xee\xcc\x71\x73\xda\xd2\x4c\xb8\x68\xd5\x5a\x08\xde\x38\x47\x0c\xa0\x18\x8f\x25\x34\xce\x72\x6e\x68\xcd\xd3\x3e\x13\x00\x14\x06\x86\x9d\xcc\x1c\xd6\x1c\xce\x48\x6d\x74\x34\x8e\x6e\x46\x4a\xe5\xb7\xc6\xc5\xcb\x27\xd0\x3b\x7f\xef\x6d\x9f\xf0\x33\x1f\xcb\xbc\x6d\x8d\x88\x75\x67\x00\x54\x62\x90\x2b\x08\x17\x1c\x9b\x70\x78\x60\xc7\x79\x3f\x22\x61\x98\xa6\xe7\x2e\x0a\xea\xaf\xa7\xea\xc3\x21\xf6\x19\x6b\xd1\xce\x0a\xd5\xeb\x0c\xbb\xb0\xcb\x0e\x32\xfb\x28\xaa\xce\x39\x4b\xb4\xef\x3a\x64\xee\x00\xd3\xa8\x9c\x1b\xb5\x7d\x85\x13\x40\x10\x06\xa6\x2b\xce\x73\x9d\xaa\x2b\xe6\xa4\x31\x70\xa6\x5c\x7e\xf2\xb3\xa5\xf1\xbf\x19\x2a\x7a\x15\xe2\x2a\x39\xa0\xfa\x1e\x30\x4b\xc8\xce\xcd\x77\xcd\x53\x22\x27\x56\x86\xa0\xfb\x65\x96\xac\x5b\xff\xac\x39\xa1\x09\xfa\x88\x9e\x8c\x8e\xed\x14\x8f\x99\x83\xf8\xaf\xbe\xa8\x74\xd3\xd4\x4d\x7d\x93\xe8\x98\x80\x4c\x29\xf2\x0b\x4f\x78\xa4\x0e\xa6\xa5\x80\x01\x3b\xb2\xf8\xb3\xde\xad\x7c\x9e\x50\x20\x07\x18\x01\x01\x49\x0e\xf3\x7c\x9a\x78\xa9\xed\x39\x21\x7d\xf4\x11\xdd\x6a\xbc\x35\x6c\x94\x4c\x74\xe1\x0c\xf1\x4c\x02\x0c\xd0\x33\x00\xf9\x14\x84\xb3\xe7\x65\x7a\x85\xe6\x3b\x41\xc7\x04\xd3\xe3\x37\x9d\xdb\xec\x5d\x8e\xae\x3c\x32\x1e\xd8\x54\xfd\x3c\x5a\xfa\x62\xbc\x8f\xeb\x62\x35\x1d\xfc\x6a\xdd\xf9\xa7\xe9\x0b\x04\xe6\x06\x41\x1a\x11\xcf\x81\xd6\x6d\xc9\xef\x3e\x0a\x99\x1b\x14\xd8\xd7\x98\x80\xe7\x01\x82\xd0\xea\xb3\x9d\x1f\xa7\x78\x27\x80\xce\x69\x5f\x25\x7f\xa0\x45\xd7\xc0\x0d\xdf\xb1\x56\xd6\x7c\xb0\xae\x76\x8a\xfa\x66\x8a\x67\x42\x0a\x19\x9d\x02\x20\xce\x76\x7c\x23\x70\xa7\x26\x7a\x02\x80\x27\x07\xee\x1e\x80\x8e\x46\x91\xc6\x49\x87\x2f\x93\x22\xb8\x7e\x7a\x0a\x2f\xbc\x37\x8f\xb2\xb6\x7d\x93\x4e\x97\xbb\xc2\xcf\xdf\xbb\xe4\x3d\x7f\xc8\x0c\xa6\xb4\xd7\x71\x32\xc2\x63\xdf\x67\x33\xa4\xf8\x71\xe8\xf6\xd0\x00\x40\xdc\x7b\xb0\xbf\x28\xdc\x0d\x95\x99\x97\xf6\x5d\x9e\x26\x84\xa1\xc8\xf8\xe7\xf3\xa4\x9b\x85\x5c\xbc\x76\x88\x61\x4e\x7a\xeb\x1a\xf1\x73\x88\xde\xb5\x49\x22\xb2\xa1\xca\xf3\xcc\xf4\x1d\x02\xf2\x4c\x04\x95\xb4\x7c\x5f\xf2\xb0\x1f\xc0\x07\xcc\x45\x80\x7b\x25\xc8\x99\xf5\x1b\xba\x75\x26\x04\x81\xf3\x58\x96\xd8\xc6\x84\xb3\xef\xd3\xb8\xef\x16\x68\xf7\xdb\x37\xfc\x0c\x9b\xe9\xb1\xa5\x01\x41\x92\xeb\x44\x98\xb0\xa2\xc4\x22\xb5\x9e\x23\xe9\x08\x80\x09\xcb\xed\xf4\x7b\xab\xe5\x1d\x02\x80\x84\x02\xcc\x4d\x63\xf0\xde\x20\x5a\xd5\xb7\x9a\xd5\x0c\x40\xce\x31\xb6\x32\x02\x81\x88\x26\x75\x4d\x9c\x7d\xbd\xeb\xf2\xe0\x1e\xe3\x19\x01\x51\xe2\xf6\x7d\xbf\x55\x2f\x2d\x42\xd6\x09\x10\x5e\xca\x30\x37\x6a\x2f\xc4\xab\x66\xf4\x9f\x73\x7c\x89\x39\x77\x96\xa7\xd2\x4c\xd6\x4c\x10\x9b\xfb\xb4\x1f\xeb\x2f\x97\xe2\xa5\x70\xf1\x4f\x2a\xf5\x3d\x2c\x08\xf2\x18\x6a\x92\x52\x2c\xd9\xe7\x33\xec\xed\xb2\x2c\xdb\x06\xc0\xf3\x49\xd3\xec\xb5\x9b\x27\x3a\xca\xad\xc5\x30\xf7\x9e\x41\x84\xea\x13\x20\x03\xea\xbb\xe2\x77\xfa\x19\xf6\x8f\x85\x00\xa8\xd9\xe2\x95\xed\x5c\x21\x26\x6f\x81\xf6\x23\x66\x07\xf3\x0e\x00\xd3\xee\xe0\x52\xde\x5b\x85\x2d\x9b\x40\xd3\x08\x04\xa4\x28\xb8\x11\x16\x28\xc6\x42\xbd\xc5\x5d\x88\x68\x9c\x0d\x32\x89\xd8\xb5\x5d\xa4\xb9\xf3\xfb\xab\x2d\x0f\x94\x8f\xd8\xcc\x76\x99\xa7\x49\x94\xce\xd5\x9f\x15\xd3\x92\x44\x44\x9f\x8a\xe4\x6a\x98\x01\x30\x66\x1f\xec\xf1\x0e\x16\xba\x11\xbe\xdd\xe2\x6e\x3b\xaf\xdf\x79\x06\xd8\x07\x27\x63\xd9\xfa\xee\x11\x14\xf5\x4a\xf4\x82\xe1\x3a\xe6\x83\x97\x11\xc2\x4d\x75\x07\x2e\x03\x92\x34\x80\x62\xd6\x90\x22\x00\x40\x7d\xdf\xb7\x4c\x2b\xdb\x17\x08\x76\x40\x00\x00\x77\x00\x46\x1f\x30\x21\x09\x78\xd1\x80\xd3\x8a\x93\xf7\x2c\x55\xa6\x84\x0e\xf1\x4c\x2c\x69\xf5\xc4\xd4\xdf\x99\xa3\x22\x2b\xee\x84\xe5\x2e\xa6\x7d\x83\x74\xae\x7b\xbf\x2f\xca\x86\xed\x82\xa1\x96\x89\x1c\xe1\xa0\x41\xf4\x24\x69\xae\xdb\xae\x7d\x92\x37\x9c\x33\x62\xa7\x53\x86\x20\xf6\x9b\x01\x6c\x18\xe4\x5c\x10\x0c\xcf\x9d\xb1\x29\xca\xf9\x56\x4e\x34\xef\x33\x10\xce\x2a\xc4\x99\x74\x5c\xab\xe9\x4d\x68\x76\x0a\x10\xa2\xcf\x01\x3b\x01\x70\xb5\x17\x51\x5a\xa6\xb4\xed\x04\x64\x08\x40\xa2\x4e\xba\x6d\xb9\xe3\x39\xc7\x41\x7e\x1e\x43\xb8\xf9\x2d\x4b\x05\x73\xbb\xc6\x1e\x36\x0b\xdf\x43\xcb\x9b\xd9\x07\x8d\xec\xfe\x03\x91\x94\x27\xed\xa3\x44\x57\x50\xf4\xe3\x3c\xc9\xf9\x13\xf4\x11\x33\x47\xdd\x89\x9a\x96\x9b\xd8\x45\xca\xd3\x4c\x9e\xe8\x5d\xdb\x9e\x24\x93\x75\xe7\x95\xb4\xca\x81\xe6\x33\x04\x35\xa0\x9b\x1b\x20\x34\x03\xa8\x8e\x99\x68\x7d\xd6\x93\x0c\xd7\xea\xe0\x86\x0c\xdb\xf4\x65\x87\x30\x4d\xe8\x3d\x0b\x56\x93\x14\xac\xea\xe4\x60\x98\xcd\xb4\x82\x39\x98\xde\x0c\x33\xd4\x42\xd0\x64\xba\xf0\xe4\x01\x0f\x15\xb9\xb5\x76\x80\x44\x3b\xd8\x76\x41\xaf\xed\xf8\xd6\xc4\x9c\x3a\x6d\xc3\xe0\x0a\x52\xe7\x7b\x75\x99\xa0\x4b\x63\x14\x1a\x13\x1e\xe9\x5f\xe7\xa9\xfe\x6a\x19\x94\x17\x19\x75\xe3\xc4\x95\x28\x12\xac\x35\x47\xee\x83\xf0\x5c\xd3\x14\x3c\x29\x0d\x65\x4f\xab\x67\x26\x7a\xab\x17\x84\xdd\x78\xaf\x5d\x23\x84\x58\xe9\xb0\x33\x9b\xa7\x89\x94\xf8\x76\x03\xd8\x25\x80\x05\x36\x80\x24\x35\x61\xd1\xe5\x4a\xea\xca\x53\x10\xa9\x6b\x1b\x3d\x35\x3b\xcc\x71\xe4\xda\x5c\xab\x73\xae\xce\x73\x52\xc1\x0b\xc2\x45\xc5\xba\x0d\xc8\xd3\x44\xd3\x80\x0d\xa2\x9f\x52\x80\xec\x35\x79\x3a\x40\xf1\xb0\x35\xd8\x01\xe2\xe6\x40\xed\xfc\xbd\xb3\x9b\x13\x03\x97\x76\x17\x28\x34\x6c\x7b\xbf\xed\x25\xe1\x77\x6f\x56\x98\x05\x6d\xd6\x80\xc4\x9f\x78\xd3\x32\x51\x8a\xbd\xff\xf1\x69\x11\xc9\x24\x53\x9a\x3d\xcf\xe1\xa5\x70\x9c\x1f\x2b\x1b\x59\x40\xc8\x3e\xf4\x6e\xa2\xe4\x8d\xbe\x7e\x12\xa4\xfd\x00\x36\x8b\xe4\x46\x75\x88\x6f\x55\x7e\x3e\xc4\x98\x61\xee\xfb\x9e\xf7\x3d\x99\xf7\x74\x9c\xf6\x9e\xb4\x34\x41\xe8\xeb\x63\xf0\x25\x32\xc7\xba\x15\xab\x01\x5d\x5c\xd0\x71\x5d\x2f\x8c\xb7\x6f\x6f\x53\x0c\x8b\x7e\xc1\x9e\x11\x82\x3d\xf6\x5f\x5a\x49\xaa\xef\x36\x6f\x8c\x01\xba\x91\x60\x7a\xa4\x2c\x77\x35\xb5\xd6\x81\x29\x94\x0b\xf8\xfb\x66\xf8\x38\x4d\x50\x35\x27\x07\xf3\x35\x12\x80\x2d\x09\x1a\xf4\x87\xa3\x87\xcd\xaf\xf7\xca\x71\xf4\x28\x40\x76\xfe\x24\xd9\x44\xd3\xe1\xbd\x03\x88\xe4\xb1\x6d\x4a\x2d\xe5\x11\x38\xe3\xde\x14\xa2\x1b\x46\x1e\x47\x13\xcd\xba\xaa\x6d\x26\x9b\xaf\xb4\xe5\x4d\xb1\xe7\x85\x13\xc4\x34\x4d\x53\xd9\xce\xdd\xfb\xe8\x76\x20\x68\x31\x4b\xa8\xb7\x14\x4b\x08\xbd\x61\x63\xe6\xdc\x3d\xa0\xf2\x71\x58\x51\x8a\xbc\xb4\xba\x26\x70\x5a\xd6\x41\x66\xcc\x7f\xdc\xae\x83\x01\x7b\x3a\xa1\x35\xcf\x4c\x4b\x93\x14\x46\xf8\xf3\xb9\xf5\x6e\xb7\xf3\xcc\x4f\x69\xb9\x7b\xfb\x1c\xf1\xcd\x48\xb3\x34\x73\x56\x0d\x82\x37\x3a\x04\xd6\xaf\x2e\x95\xe3\x5e\x97\x5a\x0f\x2b\xc3\xdc\x84\x77\xe0\x11\x7b\x97\xd1\x34\xcd\x3f\xb8\xf5\x66\xbb\x2b\xd6\x68\xf9\x4e\x6e\xd5\x30\x76\x55\xa5\xb6\x2c\x47\x84\xe2\x08\x74\x1e\xe4\x52\xe6\x62\x29\x01\xbe\xe8\x0f\xce\x64\xe2\x8c\x58\x99\x28\x40\x08\x8a\xa2\xe0\xdc\xac\x1e\x93\xb9\x98\xbb\x2f\x75\x01\x61\xa0\x11\x9f\x0f\xb5\x12\x40\x6a\x55\x6a\x75\x7d\xbf\xef\xf1\xce\xcb\xf0\x18\xae\x1b\xf5\xd2\x5c\x9d\x59\xe8\x98\xb9\xc7\x71\x5d\xb7\xed\xc6\xbe\xbc\xfd\xdc\x61\xa2\x94\xac\x00\x21\x49\xed\x67\xa6\x5d\xc1\x71\x37\x49\x5d\x03\x80\x8e\x20\xb8\x53\xdd\x13\xe2\xe2\xf1\xcb\xd1\xdf\x1f\x04\x6e\xa6\xad\x13\xf9\xa6\x6e\xe7\x81\x31\xc4\x8e\x17\x84\xbf\x47\xec\x9b\xae\x03\xd6\x30\x6e\xf9\xba\xe0\x4e\xc4\x52\xcb\x50\x34\xbc\x3c\xeb\x62\x35\xfe\x21\x09\x2e\x9a\x7e\x4e\x0a\x0b\x5d\x7f\xcf\xf3\x09\xf3\x56\xaf\x45\xb9\xcf\xb3\x08\x84\x1d\x24\xc0\xb8\x2a\xe2\x54\xb5\x6d\x05\x40\x12\x00\xfe\xe4\xed\xbd\xec\x5f\x6a\x54\xc7\xcc\x89\x6c\xeb\xbe\x1b\x0c\xbf\x76\x44\x1c\x96\x59\x2f\x32\x48\x25\xf3\xbb\x3f\xcf\x04\x7b\x55\x7b\x96\x42\x0c\xb3\xa8\x64\x53\x02\x21\xcc\x91\xca\x34\x89\x4d\xcd\x8a\xeb\x3a\x3d\x99\x02\x71\x18\xcf\x4c\xc9\x1a\xc1\x12\x59\x85\xf1\xab\x15\x17\xb5\xcf\x00\xf5\x1d\xc0\x00\x0b\x14\x3e\x56\x49\xcf\x0c\xc1\x26\xfa\x46\x9b\xda\xbe\x8b\x5e\xcf\xe0\x16\x48\xb0\xe3\x4c\xba\x03\x6c\x66\x20\xf2\xa7\x56\x0e\x68\x8f\x07\x0e\x37\x64\xf5\x1e\x63\x90\x8a\x46\x37\x82\xd1\xe6\xf9\xbb\x69\x86\x9e\xdb\x5a\xae\x3d\x3a\x47\xc7\x22\xc4\x9e\x77\x10\x4d\xe5\x43\x25\x70\x71\x76\xaf\x1b\xe0\x1a\x46\xb4\xc9\x65\x0c\xc8\x4e\x37\x89\x2e\x40\x84\x49\x37\x02\xe4\x60\x50\xff\x78\xad\x0d\xa0\x6b\xbc\x17\x4d\x6c\xf4\x36\x27\x2c\x11\xad\xd1\xea\xcf\x0c\x33\x0c\xc3\x96\x28\x6d\x2e\x7d\xf4\xf9\x4b\x26\xaa\x01\x36\xb3\x3c\x0e\xca\xf7\xb0\x9d\x29\x31\xc0\xee\xef\x2e\x46\xbe\xcf\xfd\xb7\x1f\xc5\x71\x3a\xac\x53\xc2\x30\x7c\x54\x39\x0e\xc6\x7f\x6b\x10\x64\x00\x10\x1b\x12\x6d\xcb\x2e\x3f\x93\x5b\xbd\xa5\x83\x4d\x3c\xe9\x48\x84\x67\x93\xf1\xe6\x12\x73\xdf\x80\x53\x19\xf5\x16\x6d\xae\x71\xbf\x57\xe7\x14\x1d\xf4\x4e\xcf\x75\x5d\x0d\x45\x0e\xbd\x64\x41\xcf\x82\x1d\xba\xae\xeb\xfe\xb1\x78\xfb\x12\xd8\x6d\xa1\xab\x45\x05\x8b\x9c\xba\x37\x23\xba\x9a\xc2\x30\x34\xbe\xad\x2d\x72\x46\x4c\x82\x68\x02\x36\x9d\x99\x1f\x8a\xc0\xab\xbf\x96\xee\x0b\x55\xf8\x81\x39\xc3\xd6\xc7\xa5\x71\x5f\x54\xb5\xdf\xa7\x7e\xab\x37\x7f\x1c\xdd\xfb\x68\x9a\xa5\x6d\xe4\xd1\xb3\x42\xb7\x75\xdd\x04\xef\xb2\x18\x45\x7a\x98\xcf\x2c\xd8\x67\x1e\x99\x67\x1a\x99\x7c\xad\xa2\xd6\x08\x47\xf6\x89\x5e\xe6\x2b\x96\xde\xe2\x28\x00\xb0\x46\xc4\x81\xf0\x62\xe0\xe4\x2c\x91\x40\x64\x9b\xbd\xb6\xcc\x1c\x55\x0a\xb4\x7f\x9a\x57\xcf\x42\xf8\x10\xfe\x0e\xfa\x95\x21\x69\xb5\x7e\x96\xf8\x29\xef\x79\x06\x5d\x4d\x83\xd4\x83\x4a\xd6\x37\x41\xb7\x36\x84\x27\xe3\xdf\x6d\x2f\xd8\x51\xcc\x7c\x11\x04\x31\x1a\x79\xa7\x10\x7b\x70\xae\x6d\x7a\x00\x96\x92\x7f\xf0\x17\x19\xa5\x8b\xfd\x53\x5f\xd3\x3c\x4b\x79\x79\x80\x56\x30\x39\xff\x0b\x8a\x07\xc7\x59\x92\x84\x9e\x47\x02\x02\xc5\x89\x20\xdc\x87\x88\x46\x00\x9a\xee\xb1\x89\xf8\xca\xd7\x3e\xb2\x1c\xb9\x4f\x89\x1d\x59\x11\x7f\x54\x8d\x5f\x2f\xac\x95\xa8\xd9\xf9\xd8\xaa\x6e\x17\xee\x11\xe3\xcb\x4d\xc3\x37\x40\xe0\x1e\x8e\x94\xcc\xab\x38\x7f\xf7\x47\xde\xea\xea\xc7\x63\x03\x3c\xcf\xe7\xfb\xfe\x7d\x52\x0c\x39\x79\x1f\xed\xc6\x3e\x6f\xfb\x66\x30\xa8\x9f\x4b\xc3\x4d\x89\xb1\x62\xf9\x5d\x62\xdd\xb5\xe4\x24\x62\x54\xf7\x7d\x63\x3b\xf5\xfc\xec\xfb\xd6\xf3\xef\xbe\x78\x28\xc2\x43\xff\x8d\x61\xed\xb3\xed\xfa\x87\xee\x59\xd8\xe3\x3a\xff\x3a\x32\xae\xb6\xc1\xe5\x80\x49\x6e\x99\x04\x4d\x75\x10\xe4\xb4\xfc\xd6\x93\x3b\x02\xc0\x50\xa4\x86\x21\x9c\x21\xf8\xb6\x0d\xdb\x09\x42\x4f\xa2\xad\x33\xc4\xe9\x62\x79\xd4\x16\x2a\x0a\xd6\x0a\x94\xfb\xa1\x7f\x67\x9f\xef\xd8\xd6\x74\x94\x63\x18\x90\x5e\x2f\x35\xd3\xfd\x79\xa1\xdc\x34\x1b\x73\xb9\x9d\x7a\x58\x90\x52\x6e\x18\xef\x25\xbd\x97\xb2\x83\x4e\x92\x65\xd7\x11\x84\x5c\x0a\xa3\x54\xc2\x40\x94\x00\x04\xf4\x01\xa2\x94\xa5\x89\x7d\x1d\x2a\x20\x8d\x3c\xe0\xe4\xe1\xb2\x7e\xb1\x6b\x35\xbd\x98\x09\xa8\xf0\x43\xa3\x18\x3b\x14\x7b\xec\x33\x14\xad\x7d\x6a\x57\x61\xd0\x52\x35\xca\xfe\xbe\x51\xeb\x15\x72\x27\x42\xe4\xef\xa1\x7d\x0b\xc4\x3d\x90\xfe\x97\xb6\xad\x56\xbe\xa9\x68\xf5\x0e\x80\x0a\x9c\x7d\x55\x5a\xba\x6f\x32\x3d\x4d\xc3\xfc\xff\xbf\x7f\x33\x70\x1c\x67\xb0\x2c\xc6\x78\x61\xe7\x4f\x89\xa2\x83\x6e\x9c\x2a\x76\x72\x9a\x86\x6d\x58\xa0\x96\xf5\xe0\xbe\x44\x67\xbe\x53\x52\xe7\xbe\x0a\xc1\x50\x9c\xe0\x0c\xaa\x95\x32\x19\x05\x41\x10\xea\xa6\x89\x9f\x53\xd4\xdf\xbb\xeb\xa6\x56\x16\x7e\xa7\x7f\xfd\x42\x85\x80\xc9\x03\x86\xd9\xab\x42\x7a\x77\xbc\x60\xbf\xbd\x0a\x02\x40\x1e\x29\xe5\x76\xc9\x98\x65\xf3\xe8\x03\x2b\xd5\x1b\x27\x25\x96\x5e\xf7\xe3\x8d\x88\x35\x4c\x16\x9c\x99\x3f\x55\xfb\xfd\x3d\x58\xd0\x2c\x68\x04\xef\xef\xc7\x4e\x55\x04\xd9\xde\x4c\xad\x9c\xdf\xc7\x11\xfc\x62\xc0\x8f\x8a\x5f\x75\x41\x73\x02\x28\x70\xd5\xee\x29\xf2\xad\x1a\xe1\x2d\x86\x55\xcb\xbe\xfc\xbe\xc7\x56\x17\x67\x20\xf6\x12\x5e\xc4\xde\xe1\x59\x62\x08\xf5\xf8\x16\x9c\xf1\x21\x3d\x65\x0a\xb0\x89\x71\x76\xd2\x29\x1e\x90\x46\x49\x94\xf8\xf3\x8f\x15\xfa\xcb\x51\x8d\x5f\x3f\xcf\x93\xf0\xe3\xf9\x8f\xfd\xbc\x13\x64\x4b\x04\x76\xec\x0d\x01\x98\xac\x8d\x3e\xed\x1c\x33\xe2\xdd\x60\xb8\xf1\x0c\xb3\xe1\x20\x0e\x16\xee\x85\xfd\x20\x75\x0e\x53\x05\xc7\x99\x7a\xe7\x71\xb0\x88\xc6\x39\x30\xce\xcc\xe7\xc6\x6c\xb4\xd2\x42\xc0\x27\xae\x10\x59\xea\x2f\xe7\x6d\x68\x71\x48\x21\x42\x39\xae\xeb\x8a\x07\xa0\xb1\x76\x67\xaa\x4f\xa8\xcd\x71\x46\x30\x64\x97\x83\xe0\xa0\xef\x8f\x3a\xb5\x39\x73\x3e\x6e\x24\x1c\x8d\x0e\x89\x3c\xef\xc6\x93\x24\xcb\x69\x09\x04\x2a\x27\x16\xc4\x04\x89\x01\xb2\xc8\x5b\x75\x84\xcb\xfb\xd5\xda\x3b\xbc\x85\xad\xb6\x43\x10\x84\x55\x1f\xdf\x14\xc2\xd8\xf7\x7d\x62\x87\x69\x30\xee\xe6\x8e\x72\x8f\x1b\x22\xec\x2b\x1f\xb5\x37\x76\x25\xe5\x4b\x32\x75\x06\x92\x3e\x7e\x2f\x8c\xee\x1c\xbd\x89\xfa\x39\x18\x6f\x82\x42\x58\xed\x59\x8a\x07\x9c\x56\x77\x20\xf2\xcd\xe6\xec\x29\xb3\x91\xc9\x68\x7e\x67\xb5\xe2\xbc\xb3\xbb\xe7\xcf\xee\x75\x12\xfa\x8b\x33\x76\xf0\xaa\x7e\xa0\x14\xda\x9f\xb7\xe7\x92\xa1\x6e\x0a\xf0\x46\xbe\xf6\x2b\x18\xcd\x88\xea\x42\xd2\x20\x80\xf1\x42\x9c\x57\xf9\x96\x9d\xf3\x8d\x01\xaf\xef\x77\xe6\x43\xcc\x39\xce\xfb\x77\x12\xd1\x08\xb5\x4d\xf8\xda\xe6\x53\x2d\xfc\xf6\x54\x5f\x6f\x56\x7a\x1b\x04\xf6\x69\x91\x8f\x51\xe4\xbb\xa0\x84\x44\xbc\x93\x5f\xde\x18\xeb\x37\x62\xec\x0c\x87\x20\xb4\x71\x22\xba\x13\x74\x7c\x37\x4e\x21\xc8\x34\x00\x51\xaf\x72\x9c\xb1\xfe\x24\x2c\x02\x16\xde\xe8\x95\x41\x35\xf0\x55\xc8\xf6\x8d\xa7\x21\xf6\x61\xdb\x47\xff\xbb\xd7\xf4\xe6\x0f\x1c\xbf\x12\x24\x9f\xb3\x2e\xfa\x06\x39\xa4\x13\x90\x41\x85\x44\x1e\x8d\xa2\x72\xe2\xc5\x0e\x1a\x93\xa3\x15\xde\xd1\xd9\xc9\xe6\xef\x9c\x30\x76\x2e\xe1\x4a\x55\xfe\x5a\x3d\x0d\x86\xfb\xbe\x19\xe2\x1c\xa3\xf5\x59\x02\x9f\x01\x46\x9a\xa7\x29\x6f\x85\x72\xf0\x18\xbf\xd2\x6f\x2e\x7a\x95\x53\xd4\x0d\x08\x54\xdf\x06\x2f\x80\x44\xa2\x20\x9d\x2d\xbb\x37\xa7\x66\x1e\x3e\x74\xae\x00\x39\xcb\x2a\x94\x58\x0a\x2f\xf6\xa1\xc6\xfc\x39\x43\x70\x33\x04\x2a\x96\x59\x38\x6f\xab\x61\xec\x3e\xda\x4e\x44\xcc\x71\xf4\x36\x54\x45\x8e\xc0\x32\xe5\x86\x8c\x93\x1d\x8e\x6a\x14\x70\xb5\x11\xfc\x3e\xff\x79\x1e\x51\x1f\xa7\xe8\xd4\x25\x53\x00\xa0\x50\x05\xc1\xf0\x19\xb6\x7c\x99\xd2\x6c\xa1\xab\x2d\xf2\x0f\xa4\xa1\xd3\x6d\x83\x82\x81\x92\xcd\x7b\xb4\x2a\x87\x97\x4f\x4f\x05\xcf\x2f\x86\xa5\xbd\x43\x5b\x8e\xf1\x69\x16\xd8\xf4\xf9\x3e\xdd\x86\xbf\xe7\xea\xce\x7c\x53\xe2\x3e\x1e\x7f\x7e\x7e\x3c\xaf\x62\xfe\xab\xbd\x78\x28\x4f\x62\x65\x72\x94\x7b\x60\x9a\x8d\x1e\x41\xbe\x32\xd9\xae\x50\x1f\x31\x55\x2c\x02\xc5\xca\x87\x2c\x26\xba\x00\x18\xe9\x7b\x20\xbd\x53\xb5\xb2\xe3\x56\x92\xa9\x4b\x8f\x9b\x15\xf0\xb5\x8d\x8c\x13\x55\xb1\x53\xfa\xc8\xa3\x49\x21\x94\xc6\x67\x05\x99\xa9\x06\xb0\x5c\x17\x3b\x91\xdb\x76\x7d\xf5\x70\x06\xed\x07\xa3\x63\xdf\x7f\x3c\xf3\x5e\x4e\xc6\xdd\x48\x9f\x01\xe4\x66\xed\x66\x34\x78\xc1\x9f\x79\x36\x43\x34\xc1\xa0\xe5\x49\x9e\xd5\xa2\xe8\x88\x78\x05\xe0\xea\xb2\x5c\x54\xca\xc8\x18\xa7\x96\x88\xd3\x5b\x6c\xbb\xcf\xf3\x27\x77\x22\x27\x24\x13\xbe\x1c\x70\xa3\xe4\x56\x26\x23\xf2\x7c\x04\x79\x30\x31\xc5\x55\x72\xbf\xf5\xe8\xf9\xd1\xec\xf7\xc9\x42\x02\x22\x6f\x71\xff\xd4\x1c\x40\xd1\xca\x55\xf2\x2d\xb3\x05\x22\xa3\x7c\xf5\x7c\xbd\x13\x14\xf6\x02\x37\xaf\x1b\xee\x04\xf3\x47\x6a\x76\x1b\xc1\x71\x04\x32\x9a\xa4\xc8\x8e\x7b\xd6\x01\x6e\x10\x00\xd4\x4f\x3c\xec\xaa\xf5\xe1\xef\xd3\x74\xb1\xbb\x9e\x23\x37\xce\x10\x09\xb9\x0c\xcf\x6b\x2f\xb2\xdf\x79\xd7\x09\xf3\x18\x23\xf2\xac\x16\xe8\xdc\x8e\x32\xd5\xf0\x5d\xa1\x04\x88\x2d\x18\x58\x18\x7b\xec\xb2\x7c\xde\xce\xdf\x9a\xaa\x0b\x77\x2b\xcb\xdf\xf3\x3e\x33\x7f\x8e\x99\x9e\x92\xa6\xdd\xb5\xaa\x4e\x26\xd7\xfa\x24\x49\x96\x45\xda\x82\xf5\x87\x6f\x87\x5c\xb4\x4f\x13\x3a\x80\xcc\xf6\xfc\x8a\xcf\xf0\x2b\x34\xbf\x9e\xfd\x15\xbf\xef\x1e\x58\xb2\x1c\x91\x7b\x33\xef\x5d\xd7\xf5\x5d\x8e\x00\x43\x41\xaf\xfe\x8c\x8b\x1e\xc7\x05\x3f\xf1\x55\x7c\x0f\x68\xd8\xef\xd3\x7a\x0d\x3a\xb6\x72\x76\xfe\x83\x0b\x56\xb8\x6a\x72\x70\x35\x34\x18\xe6\x99\x61\x70\x74\x8b\xfc\x8a\xf0\x4a\x04\xb9\x7a\x40\x03\xfe\x9c\x5c\xf5\xa9\x0e\xde\xaf\xa7\x10\x5f\xec\x3b\x50\xa6\xb1\x4c\x73\x79\x8d\xcd\x1f\xac\x0a\x12\xf4\x27\x45\x29\x6a\xa9\x95\xb5\x44\x67\x05\x0a\x58\x46\xcf\xa5\x50\x11\x8b\x19\x89\x2e\xc9\xa3\x92\x7d\x46\x1c\xba\x56\xdd\x24\x86\xf4\x2c\xec\xa7\xbf\x82\x9a\xf5\x6f\x36\x20\x12\x4c\xa9\x9c\x55\x73\x8a\x9f\x77\xc2\x56\x21\x9b\xe9\xfd\xd5\xc8\xef\x8c\xf0\x26\x8c\x20\x18\xf7\xa4\x82\xc1\xb5\x1f\xb6\xcc\xff\xed\xa1\xbd\x9f\xa8\x41\x80\x6b\x32\x51\x26\x3d\xfd\x99\x56\x31\xcd\xf6\xd3\x0d\x88\xb5\x41\xe1\x6a\xf5\x69\x9d\xae\x21\x7a\xa4\x74\xb0\x78\xed\xc8\x99\x51\x57\x84\xde\x15\x7f\xeb\x26\xc1\xa2\x43\xd9\x80\xed\x19\x26\xe0\x4b\x0e\xb1\xbf\x54\x77\x9e\xec\x9a\x46\x5f\x08\xd3\xbe\x13\x4c\x99\xe3\xb2\x09\x79\x41\x20\x5a\x34\xe9\xe5\xc9\x7b\xe8\xe4\x2f\x26\x37\xf5\xa7\xfa\x2f\xa7\xfb\xf6\x11\x33\x73\x37\xb8\x68\x91\xcf\x0a\xd6\x89\x0a\x0d\xdb\x00\x7c\x08\x04\x03\x27\xca\x99\xbb\x60\xe4\xd5\x3a\x60\x01\xb2\xd6\xb5\x78\xe9\xf5\xd3\x07\x2d\xdf\x80\x83\xa3\x21\x73\x57\x93\xfb\xdd\xc7\x33\x9b\xe6\x29\xa3\xe1\xaa\xea\x61\xd0\x27\x0b\x6d\xf4\xb7\x50\x22\x15\xb6\xf4\xb5\xbf\x72\x19\x1e\x6b\x04\x4a\x0e\x5b\x68\x2b\x61\x10\x10\x75\xbe\xa6\x79\x92\x18\x28\x4f\x15\xf2\x77\x07\xb2\x3b\x63\x8f\xbf\xba\x38\x51\x7a\xb7\xd1\x3e\x5f\xcc\x4e\xc0\x22\xeb\x27\x5a\x7e\x68\xe2\x08\x55\x68\x7c\xff\xf8\x0b\x44\x78\x3b\xb8\xa6\xf7\xe1\x5f\x4d\xed\x43\x3b\x25\x18\xe1\x26\x1b\x27\x7b\x1d\xe9\xb3\xda\x05\xb2\x89\x72\x95\x0e\x9a\x9d\x45\xe9\x60\x6f\x55\xff\xba\x54\x72\x0e\x3b\x4d\x0f\x0e\x92\xd0\x6d\x0f\x83\x34\x06\xb5\xd4\x77\xdb\xd0\x73\x7b\xd9\xfb\x7c\xbe\x07\x9f\x03\xe5\x10\xef\x95\x60\xaa\xb9\x1a\x57\x5f\x51\x66\xa2\xc1\xda\x75\x0b\x2b\xf2\xf1\x02\xfb\x3f\x1a\xdf\x67\xd7\x38\x47\xc7\x30\x3d\xb1\x0f\xc2\xf3\xb0\x64\x7d\x88\x94\x57\xce\x26\x82\x01\x6b\x0f\x5b\x46\xf4\xa3\xda\x0b\xa3\xdf\x46\x4f\xcc\x34\xae\x08\x75\x45\x0c\xce\x7a\x11\x48\x78\xe7\xac\xd3\x1a\xd6\x38\x51\xee\x0d\x39\xab\x47\x2e\x12\xdd\xeb\xda\x9f\x95\x8d\x1c\xd7\xff\xa3\xea\xba\x76\xdd\xe6\x99\xed\x03\xe9\x42\xbd\x5d\xaa\xf7\xde\x75\xa7\x2e\x59\xbd\x59\xe5\xe9\x0f\x92\x2f\xd9\xf9\x8f\x01\x23\x31\xe0\x4d\x8a\xe6\x70\xca\x9a\xe1\x9a\xef\x79\x88\xe7\x81\x5a\x84\xb8\xfb\xd5\x37\x02\x69\xea\x18\xa6\x84\x14\x31\xa1\xa8\xae\xec\x8c\xb5\x13\x00\x33\xcf\xa6\x8a\x84\x21\xff\xdd\xbb\x95\xb4\x1b\x61\xc0\xf3\x1b\xd1\x7d\xaa\x79\xd8\x45\x7e\x30\xdb\xad\xcb\x5f\x72\xf0\x4b\x17\x4b\x61\x7f\xc5\x65\x85\xe6\x31\xfd\x42\xd8\xfe\x15\xab\xc5\x9d\xd8\x0a\x99\xb3\x63\x72\xd0\xca\xae\x8a\x33\x7c\xc2\x0d\xfc\xe2\xe5\x39\x26\x57\xb0\xd0\xa7\x7a\x03\x26\x00\x02\x08\xba\x85\x1d\x30\xd9\x06\x22\xd3\xb6\x0f\x41\x14\x40\xd7\xfe\x2d\x3c\x95\x6e\x61\xef\xb9\xad\xb8\x5d\xdb\xf7\xb9\xd2\xb1\xf6\x61\xd8\xf7\xc7\x07\x95\x12\xef\x13\x64\xbf\x2c\x87\x7d\x53\xb9\xd4\x7d\x76\xe3\xa0\x0f\x18\x20\x10\x74\x1b\x16\xd1\x05\x35\x51\x62\xa1\x1c\x00\x27\x7b\x02\xe6\x5a\xe2\xf9\xbd\x53\xd7\x02\x3c\x14\xf3\x0b\xfa\x09\xf6\x64\x07\x62\x66\xfe\x83\x12\x37\xf8\xa2\xa2\x5c\x58\x1a\x5e\xa2\xdf\x2f\x08\x21\x1f\xaa\xe6\xb9\xf4\xd9\x72\x3e\x23\xf3\xfa\x8b\xf8\x1e\x7d\xfa\x6b\xe8\xdd\x2e\xac\xe2\xa4\x3a\x4c\xe0\x2b\xdc\x88\x9b\xa6\x83\xc6\x18\xd4\x0f\x07\xe2\x28\x5c\x84\xfc\x05\x01\xbe\x7a\x8a\x51\xc5\x76\xa0\xdf\xf2\x68\x38\x8e\xf3\x9b\x45\xc8\xb1\xa4\xea\x98\x95\x89\x6e\xe7\x1e\xbe\xd4\x35\xaa\x0b\x9f\xf1\x0d\x88\xcf\xd6\xf5\x40\xe5\x94\x22\x00\x41\x09\x2d\x77\x5a\x1d\x6e\x23\x42\xd8\xea\x16\x75\x1f\xc8\xf7\x0f\xe9\xfc\x9e\xf5\xa6\x69\x0f\x31\x71\x20\x48\x4d\xfe\x9b\x02\xd4\x31\x4d\x2d\x74\xd0\x1d\x44\x77\x54\xec\xa1\x47\x8e\xac\x25\x88\xb3\xb2\x21\x4f\xca\xbf\xb8\xc8\x5e\x45\x87\x50\x71\x92\xbc\xcf\x7a\xe4\x3e\x00\x49\x47\xbf\x1c\xcf\x81\x20\xc8\xba\x50\x51\x9f\x88\xdc\x4a\xef\xb8\xfc\xad\xc8\xe4\xac\xce\xe1\x4d\xcf\x0f\x14\x76\x6e\xda\x6f\xe0\x0c\x9c\xd5\x06\xca\xc6\xb8\xe2\xd0\x7b\xd3\x05\x05\xac\x77\x3e\xc9\x52\x00\xcb\xed\x17\x88\xc6\x11\xce\xf6\xd9\x44\xb5\x13\xef\xb2\x69\x22\xcf\xf3\xb7\x9f\x39\xef\xd7\xc8\xff\x3a\x33\x20\x5d\x0c\xf6\xaa\x6d\xda\x97\xe1\xa7\xad\x21\x7f\xf8\x7b\x8d\x6b\x95\x2f\x10\x7c\x1b\x74\x9f\xd8\xb2\x15\xf3\x4d\x4c\x48\x39\x27\xcd\xb0\x02\x69\x50\x87\x87\x5b\x00\xbf\x29\x72\x12\x50\x65\xc9\xbf\xce\x71\x7f\x88\x28\xa4\xe7\xa1\xff\xa5\xd6\xe2\xa8\x0d\xb8\x86\xd3\xd1\xb6\x36\x04\x20\xcc\x14\x0a\xb7\x4e\x94\x32\xe8\xab\xf3\x7e\xb9\x67\xd2\xb2\xe3\xcc\x0d\x9e\x50\xd3\x53\xe7\xb2\x6d\x24\x80\x27\x50\x41\x4c\xae\xd8\xda\xc6\x4e\x58\x1f\x12\xef\x12\xe0\x2e\xee\x0b\xd3\xff\x71\x09\x2e\x4b\x69\x2f\xf1\x4b\x92\xa0\x8e\xd3\x00\xa0\xde\x41\xba\x68\x27\x8d\x9c\x19\x41\x00\xc0\xa1\xea\xf0\x22\xf2\x53\x2c\x43\x84\x2e\xc4\x26\x42\x55\x52\x3b\x47\x29\xff\xd4\xdf\x5a\x1f\x8e\x73\xbe\x85\x3b\xbe\xf6\x12\xdd\x68\x30\x41\xb9\xf7\xa4\xde\x31\x28\x61\x04\xc0\xb6\x55\x6a\x9b\xd0\xa4\xfd\xee\x8a\x2a\x10\x31\xf5\xf2\x44\x72\x88\x1a\x03\x4c\x31\x9e\xca\xbe\xe3\xa9\x2e\x81\x87\x20\x71\x49\x1d\xd9\xb7\xf9\xfe\xf3\x97\x86\x03\xcd\x89\xcf\x17\xa5\x9a\x9e\x1a\x0b\x5b\xee\x3b\x71\x3e\x55\xe3\xe5\xec\x1b\xb5\x0f\xb3\x02\x68\x80\x47\xbf\x5a\x55\xd1\xb8\xbe\x9e\xf2\x7b\x00\xa0\x1a\xc6\xf1\xe7\x5b\xa2\x0f\x18\x5c\xec\x46\x41\x30\x80\xa5\x8b\xd1\x8f\x9e\x61\x48\x29\x71\x00\x66\xae\x9b\xd8\x0c\xc2\x67\xac\x9f\x04\x40\x03\x1b\xac\x0f\xdd\xb5\xe0\xcf\xe1\x99\xec\x56\x1e\x5f\xd4\xd4\xc3\x13\x55\x5d\x45\x2d\x82\x7c\xa0\xeb\x35\x1b\xf1\x7d\xf0\xf2\x85\x02\xb3\xd0\xfc\x04\x47\x6a\x29\x1c\xfa\x53\xab\xa4\x7e\xec\xa7\x24\x80\x29\xff\x22\xaf\x0d\xe1\x23\x85\x22\xf4\x37\x95\x94\x51\x6a\xdd\xb3\x85\xac\x8e\xa0\x71\x55\xd7\x61\x18\x1c\xcb\xed\xab\x67\xe3\xe6\x11\xb5\x9b\x03\x60\xfa\x45\xf2\x05\xce\xa5\xbb\x78\x59\xb9\x3a\x23\x92\x44\xd6\x68\xf2\x45\xd9\xa8\xc8\xb2\xa8\x10\xbd\x30\x05\x1a\xcc\x23\xfc\xac\x4e\x20\x0c\xd1\x14\x2e\x83\xd5\x0b\xc5\x7c\x02\x2d\xbf\xc7\xd5\xec\x40\xc0\x7c\x47\xd3\x43\x5b\x92\x20\x8b\x84\x50\x9c\x6d\x39\xe6\x63\x36\x08\x73\x74\x69\x1b\xe8\x14\x31\x5a\x65\x0c\xfc\x27\x4f\x63\xa8\xc3\x65\xd9\x2f\xb5\xec\xe8\x42\x4b\x40\xa7\x89\x68\xec\xe3\xe8\xca\x5d\x19\x3f\x37\x70\x38\x9c\x11\x9c\xc1\xd9\x37\x46\xe9\xd4\x41\x61\xfa\x29\x68\x5d\x63\xfd\xec\x88\xbf\x36\xfd\x29\xd1\xd6\x30\xdb\xbe\xa8\x97\x12\xf2\x41\x5a\x49\x27\x51\x3a\x24\x4a\x9e\x2b\x39\x43\x41\x28\xcf\x36\x3f\x2e\xb2\x50\x0c\xc3\x78\x92\x71\x3c\xfa\xc6\x9b\x9e\xa7\xb9\x16\xd2\xd7\x79\x12\xf5\xa6\xa1\x25\x7d\x22\x64\x52\xd9\x32\x1b\x3e\xca\xba\xd9\x44\x7c\xf8\x61\x38\xe0\x68\x1c\xc7\x83\x59\xd1\x1c\xc2\x54\xff\xf4\x5a\xd6\x11\xe0\xaa\x8f\x30\x90\x8e\xcb\x02\x00\x24\x65\xf3\xb6\x6b\xfb\x10\xa0\x5b\xd5\x4b\x00\xdb\xa2\xc3\x2b\xb4\x3d\x25\x73\x8d\x14\x54\xd9\x28\xde\xc1\x00\x59\xb6\x18\x55\xbc\x51\xf6\x2e\xed\xe7\x7d\xbd\x2f\x1a\x97\xf0\x1d\x59\xdd\x4a\x1c\x2a\x98\x93\xf8\xa2\x5b\xce\x4b\x84\x63\xbb\x9e\xdb\x1a\x7a\xdf\x78\xcb\x4f\x4c\x65\xc1\x46\x72\x56\xb1\x3c\xc1\xf4\x44\xbf\xe8\xd4\x0d\xae\xfe\xb6\x70\x19\x07\xb0\x59\xd5\x38\x6e\x48\xeb\x50\x1c\xb1\x6e\xd1\xa3\xec\xc8\xd6\x4f\x7f\x21\x9e\xf3\x99\x17\x91\xf9\x16\x27\x6e\xc4\x7d\x2b\xb9\xc5\x6a\xf1\x2d\x96\x07\x58\xd7\x6d\x79\xf0\x64\x86\x57\x38\x83\x8f\x3c\xd6\xf6\xf1\x45\x4e\xb7\xa9\x24\x7f\xb8\x66\xa9\xfe\xe5\x73\x09\xa8\x3e\x80\x49\x9c\xc0\x74\x54\xa0\xa0\xa1\x2d\x6a\x12\x94\x58\x86\xfb\xb9\x0f\x96\x15\xd4\xab\x5f\x49\xdc\xb2\x75\xfe\x46\xdb\x81\xc0\xc5\x1c\x8a\x6e\x10\xa6\x5f\x3a\xed\xf7\xa9\xef\x3d\x53\xa6\x6f\x0a\x2b\x2c\x7f\x36\x22\xf1\xcb\x06\xa3\x77\x48\xbe\xda\xf7\x5c\x43\x6d\x2f\xb8\x95\xe1\x8a\xc6\x7c\x69\x3a\xf2\xfb\x5e\xa1\xf3\xc8\x85\xf9\xc3\x1d\xc4\x5d\xe3\x42\x24\x48\x8e\x62\x70\x45\xc9\x75\xca\x15\xa3\x3a\x67\x72\x7b\xf9\x60\x5e\xd6\xed\xae\xd1\xc9\x77\x7a\xc3\x67\x89\x8e\xcd\x43\x46\x8e\x5a\x64\x17\x2a\x6c\x9a\xbd\x28\xc8\x34\x5e\xc3\x17\x17\x74\xb2\x97\x6e\x4b\x3f\x07\x01\x56\x0a\x90\x27\x7d\x3b\x0d\x04\x7e\x9c\x1c\x15\x1f\x4b\xed\x8f\x71\xae\xf5\x49\x19\x6b\x43\x76\x44\xdb\x97\x27\xc1\x2a\xc9\x2a\x6f\x0d\xad\x69\x83\x10\x1b\xe3\x59\x0c\xab\xac\x27\xcd\x5a\x50\x7c\x23\x0d\x8d\xf0\x33\x1d\x3f\x54\x49\x36\x19\x78\x9f\x26\x84\xa2\x79\xd7\xb2\x16\x69\x33\x49\xf8\x53\xdf\x62\x28\xe5\x6e\x7e\xbf\x14\xf7\x50\xa2\x43\x88\x28\x58\x6c\x29\x26\xbd\x20\x51\x5a\x23\x61\x87\xed\x41\x7f\x33\xe4\x37\x0b\xcb\x4c\x0c\x68\x88\x7d\x43\xbc\xbd\xf1\xc1\x50\x7b\xa0\x22\x79\x98\x20\xcd\xee\xc6\xa8\x5f\xfe\x4c\xaa\x63\xb2\xe7\x6d\x65\x0f\x22\x35\xbb\x5f\x5c\xd8\xad\xa0\x6f\xba\x70\x06\x54\x72\x3b\xac\x39\xe1\x66\xc8\xba\x0e\x68\x08\x95\x1c\x9e\xc3\xc7\x5b\xa8\xb2\xaa\x59\x2f\x76\x17\xd5\x9b\x50\x95\x2d\x7f\xc0\x1a\x99\xfc\x8d\xcc\x9c\x9a\x0b\x5a\x6f\x38\xf2\xd8\xc4\x62\x8e\x22\xe0\x12\xd1\xcf\x13\xcb\x2c\x78\x85\x5b\xb7\xf9\xa9\xfb\x34\x98\x02\x3d\x16\x10\x33\x3a\x37\x01\x6d\x0a\x1b\x1b\x08\x39\xea\x38\xc3\x96\x80\xc0\xde\x6c\x5c\xb6\x08\x5e\xf2\xff\xda\x4c\xf6\x89\xbb\x7f\x55\x41\x39\xe4\xda\x46\x39\xe2\x0b\x82\x01\x51\x5a\x93\xbf\xa0\x56\xca\x2b\x43\xd9\x67\x47\xd6\x7e\x30\x44\x0b\x73\x97\x3d\x42\x4b\x92\x41\xa4\x39\x51\xd5\x37\xb5\x27\x07\x3e\x66\x80\xe4\x16\x20\x6b\x79\xcd\xa2\xbf\x4b\xcd\x84\x41\x70\x42\x2f\x1f\xf1\x3d\x23\xce\x3d\x5c\x8a\x06\xdc\x57\x72\xeb\x61\xf3\x77\x1f\x5d\xf2\x04\xbf\x36\x2a\x3f\x8b\x3e\x9d\x0c\x3d\x1d\xf4\x92\x45\x61\x8f\xfa\xf8\xd7\x16\xfb\xce\x2d\x4b\x00\x0d\xc3\x30\x0a\x75\xee\x64\x7e\x38\x6f\x24\x46\xa3\x80\x71\x2f\x66\x3c\xd0\x8e\x38\x87\xef\xd3\xbe\x8b\x17\xa3\xc0\x85\x42\x7f\xad\xa1\x0b\x4c\x3e\x38\x23\xd3\xf4\x43\xf8\xd8\x0e\x77\xf3\x33\x4f\xab\x7d\xe1\x36\xfc\x06\xb2\x79\x07\x32\x79\xd0\xf9\x15\x59\x5b\x1f\xd5\xa5\xb2\xda\x22\x32\xf1\xde\xb7\xdb\x9a\x3d\x0a\xb4\x50\xf8\x44\xe7\xec\x01\x50\x93\x6c\xf6\xe7\x82\x4c\x3a\x40\xcc\xcf\x2f\xa5\xe4\x40\x26\x9d\x88\xcc\x25\xad\xb0\x34\xf1\x45\xc1\x37\x94\xcd\x5f\x8f\xc9\x5f\x94\xc5\xb7\xd8\xb9\x51\xa0\x30\x8f\xd9\x1e\xcf\xd5\x87\xac\xf0\xd3\x1f\xa7\x6e\x9a\x0a\x72\x39\xce\x73\xc0\x90\xc5\xe8\x18\xec\x87\xaf\x99\xeb\x46\x94\x9e\x86\xbc\x04\x31\x60\xf2\x17\xcc\x64\x21\xb0\xe0\xa9\x78\xa6\xe1\x30\xfc\xed\xab\x36\x01\xd7\x18\x2d\x7f\x01\xdb\x05\x6c\x74\xbd\x94\xc2\xea\x1d\x3a\x13\xfb\x9c\xe3\xed\x94\xd8\x14\x7c\x82\x19\xef\xfe\x5a\xbf\x8b\x54\x7a\xdc\xfe\x08\xb7\xe9\x47\xe1\x9b\xa6\xb1\x77\x30\xc5\x68\x77\xf4\x0a\x53\x5e\xfa\xbe\x1f\xa5\xa2\x50\xf3\x00\x0a\x38\x0b\x9e\x9a\xfa\xea\xfb\x70\xff\x9a\xa5\x0c\xdd\x40\x61\xb9\x79\xd3\x40\x08\x40\xe9\x0b\xdf\x88\x6d\x6d\x56\xaa\xed\x83\xb6\x37\xdb\xae\x98\x52\xa4\xfc\xcd\xc6\xa6\xfe\x72\xa8\x9a\x42\xba\x01\x7b\xac\x6d\x00\x29\xc7\x75\x5d\xe7\xfc\x97\x1d\xa6\x14\x18\x8a\x1c\x79\xfc\xd7\x67\xc9\x45\x31\x8b\x6b\x9b\xab\xb0\x75\xf0\x93\xbb\x50\x26\xfd\xf2\x0f\xbd\x95\xda\xc3\xf5\x1b\x92\x71\x86\x4f\x1c\x91\xa3\x55\x76\x21\x08\x84\x8c\x44\xba\x74\xab\x9a\x6c\x65\x86\x4f\x99\x47\x6c\xe7\x49\x4c\xfd\x3a\x04\xeb\x10\x28\x8b\xa8\xa8\xac\x7e\x57\x9c\x92\x75\x58\xca\x61\xa1\xb3\xde\x71\x0f\xe3\xb7\x15\x7f\x73\xa9\xab\x07\xce\xb9\xca\x07\x04\x73\x58\x76\xdb\x35\x19\xe4\xb2\x3b\xe8\x6f\xa1\x6f\x75\x99\xc4\x75\x42\xfa\x19\x19\x87\x76\x78\xc6\x66\xd5\xaf\x1e\x5e\x41\x71\x9a\xf3\x59\xfe\x7a\x99\xff\x44\xce\x3a\x04\xf3\x98\x80\x22\xa6\xb3\x99\xee\x72\x9a\xeb\xe9\x3e\x24\x7c\x58\xb5\x5f\x92\x3d\x72\x67\x85\x65\x73\x8a\xf4\xcb\xbd\x12\x69\xb1\x4d\xc1\x97\x1b\x23\xeb\x87\x88\x5d\x77\x1d\xcd\xf6\x29\xee\xa1\x07\x6a\xfa\x26\xf8\xf9\xee\xbe\x4c\x83\xdf\x67\xac\x10\x66\xe4\xc3\x7c\xe1\x6a\xf2\x6d\xad\x72\xc8\x40\xd9\xfa\x94\x84\x3e\xb2\x08\x40\x7d\xee\x89\xad\xb5\x6e\x45\x8e\xb7\xc0\x26\x87\xa0\xd0\xd1\xe5\x36\x10\x6c\xdb\xf3\xec\x89\x66\x7c\xda\xe3\x68\xff\xf0\x04\x3b\xc7\x89\x57\x08\x35\x92\xf0\x9b\xe3\xa0\x89\x96\xdc\xf3\x22\xd1\x1b\x08\x40\x95\x7c\xe4\x56\x20\xec\xea\x88\x9e\xd7\xa6\xb5\x7b\xb0\x3e\x4c\xed\xaf\xe5\x86\xe4\xba\x65\x1b\xe6\xdd\x51\xc1\x63\xfb\xe6\x4b\x9a\xed\x4d\xcc\xfd\xf3\x93\xbb\x60\xd9\x38\xa7\x81\x85\xaa\x25\xb5\xd9\x9e\x00\x8d\xc8\x2f\x21\x7e\x46\x2a\xee\x16\x6d\x27\x6f\x68\x11\xc2\x2e\x77\x33\x94\x97\x77\x09\x69\xb2\x71\x1d\x1a\xfd\xcb\x9d\x5a\xb7\x6f\x08\xfd\xcd\xc5\x20\x58\x1e\x6d\x2f\x09\xbe\x0f\xcc\x98\xf2\xcb\x8e\xa9\xff\xe1\xe8\xc2\x10\x10\x03\x0d\xa0\xbb\x06\x28\xda\xa3\xeb\x15\x72\x1c\x79\x08\x7b\x28\xb6\xa7\xc9\xec\x95\x52\x4f\xa6\xd1\x07\x0d\x82\xc2\xe1\x97\xad\xae\x64\x47\xea\x12\x4e\x44\x32\x38\x5a\xce\x6c\x5c\x8a\x3d\x0a\x37\x46\x83\x1b\x62\x2a\x89\xb7\xbc\x01\xd8\x0d\x47\x70\x7f\x9a\x3f\xb8\x37\x6b\x00\x17\x08\xa9\xac\xa6\x36\x1b\xbd\xfb\x01\x7c\x64\xc8\x91\xcf\x71\x5a\xcf\x1f\x77\xb7\x4a\x01\x97\x50\x23\x22\x80\x4f\xee\x77\x44\xde\xf9\x6b\xca\x86\x0f\x83\x46\x78\x7d\xac\xaf\x4f\x3e\x20\x85\xbd\x8e\xcc\x62\xa5\xe4\x52\xd5\x6c\xfc\x2f\x46\x20\x38\xaf\x41\x5a\xb4\x0a\xee\x11\x51\xc4\x65\x3e\x95\x53\x8a\xd7\x39\x99\xe5\xd6\xa8\xda\xd6\xb3\xec\xd6\xe6\x6d\xda\x1c\x99\x2a\x59\x45\x1f\x0d\x6f\xb8\x58\x60\x45\x37\x62\xdd\x89\xe0\xae\x14\xa0\x80\xb8\xac\x16\x83\x9a\xfd\x7f\x63\x7a\x24\x4d\x12\x61\x5c\x3c\x94\x3b\x48\xc1\xb1\xa5\x48\xb5\xa2\xcd\x47\x47\x69\xbd\xb0\xde\xfa\xb4\xec\x13\x9c\xe6\x6e\x6a\xb5\xa5\xc6\x30\x1d\x1e\x23\xe9\x3e\x73\xa9\xdd\xf3\xad\x4c\x45\xde\x96\xb8\xb9\x64\x5d\xf9\xf0\xd4\xa2\xff\xe9\x57\xe5\xbc\xeb\xe4\x83\xae\xe1\xf7\x57\x52\xac\x5a\x36\x1e\x1b\x42\xc7\xa5\x01\x65\x0d\x59\x26\xd3\x88\xa4\x04\xca\xb2\x38\xa2\x9d\x7a\x8d\x96\x03\x4b\x4d\x6a\xed\x13\xe1\x83\x6c\xba\xd5\x5d\x73\x23\x1f\xbf\xac\x11\xe7\x54\x3e\xcc\xbe\x03\x27\xff\x60\x91\x11\xaf\xa7\xa4\x8d\xf1\x76\x92\x94\xef\x39\x40\x7a\xa8\xc6\x31\xfe\xb1\xba\x5a\xce\x12\xc4\x84\xdd\x96\xbc\xdb\xc0\xda\x0c\x78\xe2\xa4\xf4\x77\x7d\xc9\x2f\xfb\x81\xd0\x0d\xc9\x93\x07\x31\x04\x6b\xcf\xdb\x4e\xb5\xd8\xc6\xec\x0c\xc8\x7f\x22\xd1\x88\x9d\x25\x7f\xa0\xe8\x53\x64\x86\xad\x29\xfb\x03\xc1\xe6\x1b\xc0\xa6\x9f\x2b\x7b\x00\x52\x0f\x8d\xbc\x36\xb2\xde\x67\x60\x9c\x65\x53\x4d\x0c\xb8\x8b\x52\x36\xe3\x6f\xa6\x6e\x11\x7c\xa4\x55\x08\x97\xdf\xc2\xae\xa6\xef\x86\xfb\x3b\x69\xb2\xc4\x4c\x3f\xce\x3f\x59\x56\x94\x45\xc0\x6a\xcb\x4d\x02\x87\x3c\x68\xb8\x84\xca\x29\xc3\x51\x17\x1f\xe7\xf4\x31\xd9\x09\x26\x81\xe1\x34\xac\x32\x79\x6d\xb7\x74\x0b\xf5\xfa\x08\x4c\xfb\xfb\x3b\x31\x47\x7e\x78\x1c\x0d\xe9\xbc\x46\xe4\x88\xd1\x02\x89\xfc\xa9\xc1\x62\x3e\x93\x53\x3c\x5d\xe8\xb5\xf1\x46\x68\xeb\x7a\x6c\x11\x6c\xfa\xce\x1e\x95\xae\x8c\x05\xe2\x35\x5e\xc4\xe1\x8d\x41\x04\xa6\x86\xc0\x62\x55\x3c\xa9\xeb\x3e\x1f\x33\xe6\xc0\x52\x30\x2c\x79\xb4\x04\x7b\xde\xa1\x1f\xfa\xbf\xce\xbc\x7d\x99\x3c\xb8\x13\x0e\x9f\xef\x5f\xdd\xcf\x29\x31\xf7\xcd\x61\xda\x75\x69\x29\x0c\xc3\x70\xaf\xd3\xaf\x33\x7f\x5e\xc3\xc2\x31\x0c\xfd\xc4\x73\x8b\x75\x2b\xf4\xb5\x65\xf8\xe6\xd7\x58\x92\x50\x00\xfc\x12\xe7\xf6\x54\x1b\x2c\xca\xd7\x90\x3f\xa2\x78\xfe\xeb\x8f\xa7\x60\x90\x6c\x5c\x2b\xb0\x77\x1f\xc1\xf7\x0f\x3b\x47\xf8\x4f\xe6\x0d\x04\x1c\x7f\xd0\x52\x16\xb6\x5c\xac\x40\xa8\xac\x2b\xc8\x4f\xfc\x0d\xbf\x24\xed\x8e\x58\x57\xcc\x22\x7c\xc9\xa2\x65\x1d\xc6\x50\x6c\x6d\xd9\x78\x77\xa2\xb2\x0f\xd4\xfe\x6a\x2c\xb5\x1b\xd7\x1f\x8c\xdd\xb9\x8c\xd7\x20\x0d\xbe\xe9\x61\x95\x1f\xcd\x45\x23\xd1\x08\x1f\x17\xfa\xea\x21\x6a\xd5\x5b\xb8\x38\xa0\x79\x9f\xa0\x73\xd1\x5d\x38\xf5\x52\xb1\xd9\x87\x79\xd5\xba\x95\x38\xf3\x05\x8c\x55\xda\x9b\xf9\xf6\xa2\x36\xe5\xb8\xd7\x5b\xb9\x98\x7f\x1c\xe2\xca\xaf\x73\xcc\xa9\x1f\xe5\xbf\x7d\xce\x2b\xc4\xec\x7a\x8d\x59\xde\x50\x4c\x91\x69\x9c\x73\xfe\xa1\xb5\x1d\x3b\xe2\xcf\x83\x9e\xf8\xe4\x25\xcd\x0e\x8f\x23\x79\x22\x8f\xae\xec\xc8\xf1\xe1\xb0\x98\xfd\x92\xcf\x34\x72\x52\xc4\xfd\xe4\x1e\xe4\xcb\x17\x70\x9b\x57\x08\x9b\xe3\x47\xfa\xfc\x20\x45\x23\x48\xdc\xf8\xf4\x65\x34\xe0\xe4\x57\x8b\x1f\x9d\x32\xc7\x24\xa1\x4e\xd5\xdf\x97\xfc\xed\x5c\x75\x5d\xc7\x45\xac\x9f\x57\xf3\x11\x99\x1f\xab\x37\xf2\x12\x1d\x4f\xfe\x16\xe6\xb3\x4e\xcd\x94\x04\xdf\xf4\x80\x33\x8c\xd1\xf0\xe0\x47\x2e\x1d\xdb\x41\x4f\xfb\x2b\x56\xa9\xc5\xfb\x1e\xc4\x97\x69\x5c\x7e\xe2\xda\x96\xaf\x75\x70\xa5\x65\x4b\x87\x07\x08\x1f\x3d\xfc\x46\x51\x58\xc6\x2a\x34\x7a\x8f\x65\x19\x77\xa4\x4c\xfe\x37\x79\xe2\xa7\x91\x7f\xee\x32\x71\x5a\xfe\x9f\x5d\xa5\xd0\x3b\xe4\xff\xd3\x39\x48\x49\xd7\x3e\x6a\x93\xde\xe5\xbf\x37\x9e\x44\x14\x37\xda\x8f\xc9\x82\x38\xe9\x6f\xa1\x07\xdb\xda\xb8\x22\x87\x1f\xc0\x26\x59\x25\x79\x95\x63\x37\x99\x63\x9e\x2d\x23\x82\x66\x15\x3b\xfb\xfc\xd1\x11\xf3\xbe\xe0\x7c\x0b\x41\x50\xd4\x8f\xde\x2f\x09\x3e\xb7\x95\x00\x63\x0c\x2c\xe0\xfa\x61\xc7\x84\x03\xae\x2a\xe7\xbe\xe1\xda\x06\x68\x9e\x64\x9a\xee\x0e\x61\x58\x86\x65\x7c\xd0\x74\x9d\x64\x6a\x7b\x23\xda\xdd\x93\x30\xed\xb3\xb4\x26\xff\xf5\x25\x35\x2e\x51\xbb\x6a\x72\xfb\x5a\xf6\xf9\x72\x0f\xc3\x28\x1f\x97\x2d\x47\xe8\xef\xc1\x37\x95\x1f\x99\x4b\x65\xe9\x0d\x31\x79\x30\x53\x4f\x2a\xb5\x59\x24\x56\x71\x28\xac\xf3\x45\xf5\x21\xaa\x77\x27\xad\x76\x2e\x24\x43\x7e\x67\xbc\xb6\xdc\x39\x3c\xab\x8a\xef\xe7\x9f\xfa\xaf\x59\xfd\x18\x47\x3c\x51\x32\x47\xc4\x27\x6a\xa6\x38\xad\x0d\xde\x1d\x8a\x89\xb7\xe8\x70\xec\x07\x47\x0e\xa3\x51\x8a\x7d\xe7\xf3\xeb\xed\xd8\xf7\x3a\x04\x40\x79\xa5\xec\x3d\xf2\x0c\x39\xb6\x0c\x19\xeb\xaa\x65\x4b\xf1\x97\x43\x83\x8a\xa2\xa5\x42\x7d\xf2\x57\x67\xce\xe3\x32\xa6\x48\x2a\xb9\x41\x6e\x83\xa2\x22\x32\x69\x5c\xa3\xd4\xb2\x66\xd2\x2e\xd4\xa7\x52\x88\x15\x38\x29\xd4\xd0\x7a\x0f\x50\x84\xf4\xd7\x43\x3f\x8c\xbb\x3c\xa2\x71\xa6\xe2\x70\x66\xd2\x67\x26\xad\xf7\xa9\xf4\x7b\x04\xe1\xde\x92\xdf\x49\x63\x27\x2d\x30\xbf\x3f\x7d\x71\x5d\x99\xc7\x9f\x43\x70\x81\x93\x4c\x33\x02\xd8\x56\xbf\x2c\x6b\xc9\x36\x02\x0f\xe9\xbf\x32\x8c\xdc\x98\x44\xdb\x69\xe5\x9a\x2d\x9c\xba\xf7\xa4\x34\x5e\xa8\xc2\x65\x1c\xa2\x34\xa8\xad\xeb\xb9\x79\xa7\x24\x44\x9f\xde\x91\x80\xa6\x4a\xa2\xd4\xb9\xfe\xe5\x33\x45\x41\x59\x24\xb6\x29\x44\x0f\x98\x7b\xc8\x8f\x8e\x35\x1b\x56\x64\x33\xed\xa3\xfc\x58\xa0\x1a\xa5\x79\x4e\x95\x10\xac\x47\x17\x89\xa2\x1f\x57\x2c\x2e\x7f\x01\x09\xe8\xac\xd1\x10\x75\xe1\xb2\xac\x63\x93\xea\x9e\x58\x98\x41\x18\x10\x3e\x37\xde\x16\x03\xcf\xff\xd4\x69\x17\xee\x85\x57\xdb\xd5\x4f\xe0\x3b\x16\xfc\x27\x43\x8e\x25\x8f\xe8\xbd\xb0\xf5\xf5\x6d\x25\xef\x81\xcf\x5f\xff\xf5\xd8\x8a\x73\xdb\x0a\x80\x13\xf3\x0a\xf6\x3c\x22\xd0\x94\x1e\x9f\x77\xcd\x46\xb5\x47\x7d\xef\x04\xa6\x11\x27\x52\xa3\x32\xfd\x41\x11\x71\xa5\xfd\x7b\x7c\x5c\x56\x59\xe4\x99\xf4\xbe\xc3\x4d\xe2\xb8\xad\xe2\xc3\xf3\xae\xf9\x88\x7f\x4d\x00\x69\x80\x49\xb2\xc1\xc4\xb2\x99\x92\xe3\x63\x12\xdd\x96\xc7\x58\x84\x71\xaf\xd0\xa1\x7b\xe2\x87\x8e\x2b\xf4\x20\xa3\xf0\xd4\xd1\x8b\x6e\xb9\x4f\xab\xfe\xab\x6d\x57\x14\x5f\x45\xf7\x77\x0c\x5e\x15\xc4\x1b\x60\x22\x81\x6d\x25\xce\x7b\xcf\x85\xbe\xc4\xa3\x68\x8e\x4a\x12\x3e\x73\x88\x76\xcd\xf6\xc5\x08\xa0\x3c\x97\xa8\x6f\x2f\xcb\x45\x8e\x2d\x42\x0f\xb2\xa5\x8e\x10\xf8\x22\x81\xbb\xd7\x52\x3a\x56\xbc\xcf\x2f\xda\x4f\x6f\x38\x83\x9d\xa3\x10\x02\x8b\x4c\x43\x6b\x99\xac\xba\x79\x61\x42\xb8\x46\xa1\x35\xd4\xbd\x62\x32\xb7\x11\x78\x80\x83\x55\x2e\xf0\x01\xc1\x6d\xe4\x33\x52\x23\x5c\x13\xd6\x3e\x86\xdd\x66\xd2\xb0\x44\x79\xb6\x43\x80\x7d\x0f\x06\x6d\xd7\xb5\x8f\x15\x59\x52\xa9\x3f\x3d\x90\xd8\xe6\xb0\x49\x9f\x21\x45\xec\xab\xdd\x40\x2a\x8c\x1e\x33\xcb\xda\xb6\x92\xe7\xbd\xa7\xd2\x48\xa1\x92\x15\xb1\xd5\x0b\x46\xfb\x14\xd5\x3d\xea\x97\x69\x1d\xb5\x18\xe7\x06\x9b\x1a\x10\xd3\x4e\x44\x43\x59\x8e\x8f\xbf\x82\x71\x88\x60\x20\x00\x4c\x3e\x83\x0d\x7d\x3f\x19\xcc\x92\x94\xff\xe6\xd0\xf5\xb2\x7d\x5a\x15\x3a\xee\x8a\xda\x73\x49\xdd\xce\xf2\x7b\x7a\xa3\x69\x00\x91\x16\x6f\xe7\x1b\x36\x8d\xb1\xd4\x3a\x5c\x6e\x19\x7c\x48\xf7\x6b\x7d\xe1\x61\x2c\x44\x63\x19\x8c\x28\x46\x4f\x16\x88\xf8\xbf\x3a\x35\xd1\x3a\xb5\x2b\xa6\x85\x48\xfb\x47\x87\x54\xd1\x0d\xc2\x29\x85\x4b\xdd\x23\xbe\x3e\x51\x79\x81\xa1\xa2\xb8\x2d\xeb\xef\xa5\x28\x15\xde\xf4\x6c\x95\xa3\x15\xfd\xcd\xac\x5f\xef\xfd\x5c\xc7\x62\xc0\x26\x7f\x79\x83\x32\x85\x7d\xa6\x8b\xa4\x7f\xe3\x7e\x3e\x2a\x84\x7b\x2d\x3f\x54\x1b\x59\x7d\x12\x78\x20\x2b\xac\x96\x31\x22\xc8\xd4\x56\x55\x11\x11\x23\xe1\x09\x61\x75\xaa\xed\xb7\x82\xaa\x63\xc1\x6a\x7d\x01\xf1\x16\xde\x46\xf7\xf0\x3a\xc5\x2b\xba\x23\xee\xb9\x2b\xf9\x6e\x0a\xa5\x21\x66\xc3\xfb\xb9\x87\xf3\x51\x4d\x45\x65\x32\x35\x44\x89\xfc\x01\xc2\x35\xff\xb5\xe9\xd6\xb3\xaf\x4c\xab\x08\x3b\xfd\x98\x4a\x81\xfa\x93\x23\x62\x18\x8e\x02\x23\x80\xd2\x71\x6a\xb8\xd0\x10\xbc\xc1\x1a\xe1\xd5\x2f\x51\x22\x4f\xbc\x76\xd9\xea\x73\x64\x9b\xc6\x43\x3f\xb1\x8d\xf2\xfa\x08\xba\xaf\x3d\x05\x7b\x2d\x59\xbd\xc8\x8d\x98\x9f\x04\xd5\x07\x02\x98\xd7\x6c\x0c\xd3\x24\xe2\x38\x8e\x08\x7b\xbf\x28\xa3\x29\xae\xc0\x67\x8d\xa0\x01\x03\xce\x59\xf1\xc6\x54\x1a\xb3\x3a\x86\xf9\xe2\x8c\x37\xa2\xfb\xe6\x30\x1b\x0e\x89\x46\xa1\xe5\x07\xab\x78\x26\x98\x1b\x6c\x56\x7f\xf2\x71\xfc\x67\xb2\xda\x81\x04\x24\x51\x53\x16\x2f\x84\x2b\xb4\xb6\xa7\x47\x0d\xce\x68\x9f\xc4\xb0\x90\xbf\x44\x04\x65\xfc\x77\xab\x41\xe0\x39\xe4\x81\xaa\x3c\xe9\x1b\xdc\x22\xfe\x6b\x4f\xfd\x00\x35\x11\xfa\xab\xaf\x04\x32\xce\x83\xbe\x7d\x9b\xb4\xbe\xfa\x01\x95\x02\xd1\x24\xff\xa0\xc0\x1c\xcf\x2b\x80\xe1\x76\xaa\x4b\x7c\xf9\xf3\x4b\x1c\xf9\x96\x46\xe1\x90\x22\x65\x1c\xc1\x07\xe9\x13\xf5\x87\x48\xb6\xe4\x50\x61\xa2\x1c\xab\xa3\xaa\x29\x0c\xf5\x83\xa3\x8e\x7b\x63\x5e\x3e\xf3\xb7\xcc\x14\x96\x4d\x8e\xf5\x79\xbf\x39\xca\x93\x60\xe9\xf7\x76\x8f\xc1\x71\x41\xf4\xd4\xe0\xb0\xb8\x63\xd8\xdb\x1f\xee\x03\x9e\x55\x72\x84\xaa\xdd\xa2\x53\x11\x00\x46\x4e\x1a\x59\x42\x59\x7d\x8a\x49\x5d\xb3\x6e\x2d\xfd\xc1\xf0\x67\xf7\xca\xd7\x77\xbf\xe3\xe3\xa8\xec\x78\x1b\x63\x18\xc3\x0e\x7e\xf5\x53\xdf\xea\xb8\xa8\xcb\xc2\x61\x48\x45\xa7\x11\x52\x71\x1c\xa5\xcf\xa0\x2e\x83\xc2\x33\x90\x6d\xbe\x09\x12\x30\x6b\xc4\x7a\x6f\xf2\x1f\xbe\xc4\x74\xaa\x2b\xa9\x73\xa5\x38\x84\xae\x70\x6b\x36\x85\x30\xfd\xeb\xf7\x46\xda\x35\x9b\x32\x12\x75\x5d\x41\x33\x40\x68\x33\x63\x38\x46\xd0\x4b\xc6\x2f\x95\x64\x8f\x79\xee\x92\xae\x7f\x7c\x15\x07\x2d\xf8\xd8\x72\xf3\x08\x95\x56\x16\x81\xa4\x47\x61\x5a\x64\x84\xa1\x01\xd1\xbf\x79\xd2\x0f\xad\xa2\xeb\x0b\xaa\xfd\xfb\xb9\x95\xfe\xf4\x51\x00\x84\x81\xfa\xd1\xdc\x51\x5d\xb3\x49\x25\xaa\xf4\x73\xe2\x9c\x90\x8e\x60\xfc\x25\x47\x1a\xf4\x61\xa9\xba\x23\x93\xac\xf2\x26\xee\x20\xde\x39\x04\xa6\x11\x98\x25\xd2\x9c\x76\x8d\x36\x6c\x54\x9f\x98\xc1\xb0\x29\x23\x35\x26\xd4\x18\xb1\xf8\xd3\xb0\x97\x71\xab\x63\xf8\x46\x1c\x83\x8c\x04\x02\x7c\x57\xe9\xdc\x3e\x58\xc2\x0b\xb8\xed\x63\x21\xa7\x84\x68\xac\x05\xbb\x9b\xfb\x2b\x55\x1d\x91\x07\xb6\xf6\xe7\xc8\x21\x1f\x0e\x5d\xde\x14\xb3\x0d\xae\xea\x37\x0c\x1d\x01\xb0\x6e\x91\xa9\x46\x02\x3d\xbe\xf1\x81\x6e\xa1\xd7\xaf\xac\xd0\x33\x7f\x6c\x22\x9b\x94\xaa\xe5\x07\x94\xd8\x74\xfc\x1c\x40\xe2\x93\x73\x2a\x68\x0f\x10\x31\x2d\x44\xf5\xfb\x8d\x7d\x05\x2c\xf5\x46\x0d\x49\x3f\x1f\xba\x32\xcb\x52\x4c\xdc\x4f\x69\x2f\x5c\xe3\xac\xa0\x8d\x69\x2b\x1e\x23\xd7\x1c\xc7\x25\xb0\x41\xeb\x10\x6c\x55\x9c\x5c\xde\x28\xd0\xb8\x97\xbc\xff\x61\x35\xdc\x4d\x96\x2b\x4d\x53\xbe\x1a\x9f\xeb\xb5\x4a\xda\x8d\x18\xaf\x41\x1b\xaf\xe1\x73\x6b\x38\xb7\xb3\xd4\x3f\x57\x84\xb5\x21\x8b\x48\x17\xf2\xa5\xe9\x3a\x70\x65\xc7\x54\xe0\x55\xd7\x96\x6d\xdb\x88\x75\xdb\x32\xc9\xda\xf9\x1d\xe1\xa9\xff\x7c\x7e\xb1\x2d\xa2\x10\xfd\xca\x77\xd7\xf3\xf3\xd7\xc4\x62\x06\x93\x15\x92\x00\xe6\xf4\xb2\x78\x87\xb6\x7d\x83\xb4\x20\x9b\x2f\x29\xc3\xf9\xb4\x55\x39\x77\xbf\xed\x43\x37\xef\xfa\x52\x8a\x8a\x99\xe0\x17\x81\x06\x14\xf0\x19\x34\xac\x1a\x98\xff\xb8\x35\x0c\xae\x93\x5a\xac\xf2\xbc\xcc\x87\x0d\xe1\xca\xb7\x5a\x26\xeb\x64\x8f\xf3\x19\x92\x98\xd7\x7a\x8d\x47\x70\x7a\x89\x2b\x16\x67\x8d\xc2\x94\xb2\x17\xb0\x26\x51\x8b\xb4\x43\x9f\x4f\x14\x8b\x77\xf0\x51\xd7\x71\x75\x18\x43\x9d\x81\xff\x61\x3c\x3a\x41\xfb\x4b\x3d\x2f\xcd\x97\x37\xcc\x67\xb3\xd3\x9c\x67\x30\xeb\x35\x5e\xe1\x22\x64\xae\x58\x5c\x69\x74\x53\x7a\x5a\xe9\x7a\x03\x6b\xb2\x4e\x15\xa1\x47\xae\xee\xb1\x4a\xbf\xa1\x0c\xfe\x13\xa0\x66\xa8\x68\x23\xfb\x53\xef\xd0\x08\x59\xe8\xad\x95\xdc\x0a\xd5\xe2\xb8\xbf\xf7\x19\xf8\x52\xf6\xbe\xed\xc7\xb0\x6b\xac\x57\x4c\x29\x64\xb6\x81\x96\xfa\x4a\xae\x5d\xb2\x13\xb8\xb5\x8c\xd7\x22\xf1\x7d\x01\x18\x11\xb0\x53\xc7\x4c\x7f\xa9\xbf\xea\x3d\xda\x24\xe7\x34\xfd\x7f\x12\xad\x1c\x53\x21\x73\x89\x26\x26\x4e\xb8\x7e\x43\x1c\xb4\xc9\x5a\xc6\x4b\x98\xb2\x5b\xc8\xba\x77\x83\xed\x0a\x3f\xab\xfd\x90\xc9\x38\xe8\xf5\x4d\x02\x8c\xf1\x9a\x27\xe2\x07\x0a\xe0\x96\x57\x10\x73\xc1\x0d\x75\xea\x03\xad\x7f\xcc\xbf\xba\x7d\x57\x3e\x04\x7b\x25\x73\x33\x36\xbd\x57\xab\xd8\x97\x25\x6c\x18\x51\x53\x69\x86\xa5\x86\x12\x1b\x83\xf5\x8c\x1b\x51\x03\xb6\xd7\xca\x25\x20\x26\x08\xf8\xc2\xd5\x06\x01\x23\x05\x4b\x4e\xe1\x05\x6f\x94\xc8\xec\xfc\x3b\xf7\x3b\xb3\xc5\xa3\xff\x8c\x2b\x76\xde\x5a\xfa\x33\xc2\xb7\xb0\xa0\x4e\x3e\x11\x3b\xc4\x14\x87\x70\x59\x0c\xbd\xd1\x3a\x5a\x1b\xa8\x2c\xb2\x78\x9f\x8e\x75\xc5\x22\xff\x96\xf2\x0c\xc6\x04\x18\x2b\xc6\x26\x85\x5c\xed\xd8\xbc\xca\xd9\x17\xfd\xc4\x72\x30\xff\xb7\x76\xb1\xe9\x9b\xcb\xe4\x55\x0d\x1e\xe7\x68\xc8\x7e\xab\xc0\x28\x1a\xb6\xf3\x74\x3d\xc9\x39\x35\x0c\x94\x31\xd8\x91\x3a\x08\xf1\x54\xa5\xe6\x89\x5a\xc4\x6c\x96\xb0\x21\x60\xec\x15\x2b\x79\xec\x0f\xe1\x7a\x9c\x9f\x60\x17\xf8\xfe\xf8\x3c\xdc\x15\x8d\xeb\x99\xf4\x9e\xab\xe6\x5b\x9d\x93\x71\x1e\xc3\x74\x35\x05\xeb\x74\x6d\x98\xeb\xad\xae\x2f\x3a\x8e\x9c\xe6\x7c\x86\x22\x80\x8d\x9c\x26\x74\x1e\xcf\xab\x8b\x86\xd7\xef\xdb\x0c\xfc\xde\xf8\x23\xd5\xb4\x88\xf5\x7e\xe2\x04\x21\x72\x7d\x2e\x97\xef\xae\x9a\x1b\x62\x5c\xd6\x61\x71\x83\x50\x74\x83\x48\xba\x23\x56\x53\x6f\xf1\xf6\xe4\x79\x14\xaa\x6c\x9e\xc4\x3e\xf7\x4d\xb5\xdc\xc8\x2f\xa1\x47\x70\x18\x72\x83\x92\xb1\x4d\x22\x3d\x45\xd9\xc6\xff\x7a\xd1\x8b\x86\xef\x50\xce\x64\x85\xc1\xa8\xbd\x48\x74\x21\x11\x12\x0e\x4b\x11\x76\x9e\xe7\x78\x7d\xf0\x9b\xcb\x53\x50\x46\x19\x19\x2a\xb3\x7c\xef\x27\x7e\x7e\xe9\x6e\xf8\x3a\x8d\xaf\xec\x99\x3c\x76\x8f\xc1\x33\x98\x5c\x12\xe3\xbd\xf3\xa3\x4f\x44\xc5\xe4\xaf\xdb\x6b\x43\x03\x1e\xf0\x44\x64\xfe\x1b\x2f\xf3\xa1\xa8\xe9\x85\x79\x90\x66\x5c\xc4\x6c\x8d\xdc\xac\x6f\xb0\x14\xb8\xd2\x5b\x29\x6c\x2d\xb4\x01\xd3\x28\xa0\x39\xdf\x25\xc0\x36\x3d\x25\x4d\xde\x29\x84\xe3\x15\x88\x88\x91\x62\x49\xfc\x8f\x77\x86\x09\xe2\x2e\xf3\x25\x17\x4a\x17\x87\x1c\x1c\xd3\x80\xfc\xd2\xa6\xc0\x66\xd5\xef\x54\x37\xd3\xd5\x76\x3d\xdb\x8f\x33\xc3\xf4\xc2\xb5\x62\x21\x60\x80\x2c\xf5\x7e\x55\x61\xec\xd4\x31\x1c\x7a\xf9\x2a\x96\x7f\xf8\xc9\xfc\x55\x65\x77\x8f\x9d\xd1\xb9\x51\xed\x53\x86\x6b\x99\xef\x74\xbe\x95\x13\xb9\x02\x4b\xc8\xcd\xd9\xa7\x48\x3b\x28\x6a\x20\x69\xfe\x8a\xc6\x72\x79\x6a\xe0\x86\x61\x52\xf3\x86\x5b\x29\xd6\x7d\x59\xe6\x3c\xc6\x67\x29\xbb\x7b\x70\x8d\x17\x6a\x46\x49\x90\x16\xdf\x3f\x06\xa2\xf5\x4b\xa1\xb8\xc3\x44\xd7\xd6\x15\x98\x2e\x60\xa2\x28\x94\xa6\xcb\x40\x6b\x47\xf3\xae\xb4\x3b\x26\xc4\xfb\xcc\x87\x79\x3c\xd3\xcb\x62\x21\xeb\xa4\x4c\x56\x51\xf0\x77\x29\xed\x05\x2a\xa5\xbb\x9d\x53\xc2\x6b\x64\x1f\x0b\x67\xe9\x3f\x79\xea\xa8\x24\x5d\x37\x4c\x82\x83\xb0\xb6\x96\x71\x07\xc9\x95\x6c\xa8\x1e\x9a\xe4\xe4\x2b\x62\xb6\x8a\x2b\x37\x6b\xa9\x2e\x7f\x55\xcb\x0d\xe8\x9e\x6a\x0d\xc8\x09\x23\x23\x05\x9f\x07\xfd\xdd\xcb\xb9\x2e\xf3\xad\xcc\xb6\xd4\x11\xc5\x97\x81\x8c\x5e\x01\xf8\x8f\xf1\x53\x87\x23\x9a\x9f\x80\x96\x1d\x4a\x5e\x0b\xeb\xe4\xd9\x34\x3c\xee\x5f\x7f\x77\xd8\x5f\xe3\xbb\x86\xce\x3a\x06\xcf\x08\x21\x83\xd8\x07\x56\x1b\x70\x4d\xf2\x91\x85\x4e\xcf\x09\xfc\x46\x06\xe2\x1b\x12\x24\x9a\x92\x66\xda\x86\xe2\x51\x0e\x7b\xce\xd0\xf2\x36\x67\xf2\xe9\x41\x5a\x00\xa8\xdd\x9f\x63\x3b\x1b\xa2\xe2\xe9\xca\xb3\xfe\x7a\x45\xdd\x13\x91\x2b\x69\x9d\x4e\x41\x2d\x87\xbd\x14\x7c\x53\x70\x73\xcd\xcf\x21\x83\x09\xcd\x28\x5f\x80\x78\x59\x6c\xa6\x13\xf9\x62\x9d\x6e\xce\x64\x5b\x40\x4c\x01\x81\x22\x00\x7c\x83\x78\x86\x7f\x2b\x99\x4d\xa4\xf6\xca\x94\x66\x4d\x22\xb1\xdb\xcb\x8f\x41\x6a\x2f\x08\x83\x48\xc0\xe8\x46\xf3\x3f\xeb\xba\x28\xa6\x15\x2f\x45\x57\xf7\xcf\x15\x7f\x86\x7d\x17\xfb\xd0\x05\x65\x02\x44\x01\x0a\x04\xae\x03\x8a\x13\x5a\x50\x16\x71\x36\x59\x4d\x75\xad\x52\x50\x0e\x31\xd1\xd9\x4c\x87\x32\x71\xf8\xac\xeb\x5a\x60\xa0\x59\xf2\x63\xe9\x2f\xb5\x8e\xc7\xba\x09\x16\x62\x7b\xe0\xc0\x42\x96\xe7\x9d\xde\x37\x1e\x83\xb7\xfc\xeb\x5c\x59\xc3\x8b\xfd\xf1\xab\xc4\x41\x68\xfa\x65\x2c\xc7\x95\x31\x22\x52\x84\xfd\x5f\xf1\xf7\x3b\x4e\x46\xd1\x27\xeb\x90\x2c\xd2\x4c\xff\x9e\xc3\xbc\x5b\x4b\xad\xf8\xa7\x1a\xa5\xe3\x92\x67\x50\xc6\x40\x31\xd0\x86\x20\x93\x28\x6a\xf8\xdd\xdf\x9c\xcf\xf2\x37\x92\x50\x04\xb8\x91\x93\x82\xc7\x9d\x1e\x00\xba\xbc\x8d\x96\xb2\x97\x92\xc3\x76\x22\x4c\xc3\xa4\xd3\xfb\x34\x6e\xb1\x54\x1e\x50\x15\x8d\x98\xc7\x4d\x4a\xef\x0f\x76\xac\x69\x9c\x0b\xf9\x22\x11\x3f\x4c\x0d\x64\x1e\xb4\xae\x1b\x51\xf3\x64\x6d\x01\x77\x03\xc7\x01\x3d\x06\xd7\x28\xcc\x93\x80\xda\xb4\x0e\xf6\x33\xf1\x1b\x70\xdf\x87\x40\x5b\x60\x6d\x29\xd5\x17\xc4\x45\x6b\xa1\xad\xd4\x37\xb2\xa4\x87\x47\x4f\x15\x42\x57\x14\x87\x28\x14\x7f\xfd\x1b\x86\x66\xf8\xfb\xe5\xc2\x79\x0f\x66\xf8\x32\x02\x6f\x68\x42\xaf\x6b\x46\xd8\xdd\xee\x32\x55\x54\xe9\x9a\x8d\xfd\x3a\x04\x93\x59\xf6\x0d\xf7\x62\x86\xf2\x47\x3f\x76\x3d\x54\x0a\x77\x37\xb3\x94\x66\x2c\x5d\x1b\x1d\xd3\x31\xa0\x36\x08\xef\xcf\xaf\x67\xea\xa1\x61\x32\x96\x01\xb2\xa6\x1d\x00\x86\x87\xdb\xce\xe3\xad\xf1\x0f\x39\x3e\xae\xda\xef\x41\xe7\xa5\xaa\xd8\xaf\x0f\x08\xf6\xe0\x86\x50\x9d\x36\x77\xab\xa6\x1b\xc7\x4b\xe6\x80\xee\x2f\x4a\x1f\xa5\xc9\x3b\x3a\xd7\xa4\xb8\xc9\xb8\x02\xc7\x27\x01\x4c\x7d\xf8\xb8\x0e\xb7\xb4\x0b\x94\x09\x7f\xfd\x2b\x4e\x69\x69\x79\x1a\x3c\xb7\xfc\xc8\xfa\xde\xbd\xfa\x3e\x24\xc0\x1d\x16\x21\x35\xc9\x7d\x82\xb8\xbf\x1b\xbd\xe3\xa3\x36\xfc\x29\xfc\x63\x9c\xa5\x37\xd9\xd1\xbe\x2d\x39\x64\xec\xd6\x23\x12\xb6\xba\x01\x66\x2d\x8d\x94\x8b\xa1\x52\xbc\xbb\x86\x7d\x7f\x38\x6a\x59\xc7\x18\xa9\xca\xb5\xf1\x48\x39\xe6\x8f\x88\x03\x89\x40\xb5\x86\xd5\x3e\xb6\xab\xc9\x11\xd3\xfd\xcf\xdd\xdc\x74\xf2\x8c\x29\xb1\x15\xf1\x16\x8f\x20\xf9\xda\xaf\x33\xf5\x5f\x97\xbd\xe6\xdb\x1b\x9d\xbf\xf9\x5e\xae\x31\xd0\x48\x4b\x47\xb5\xeb\xf9\xbd\xf1\x85\x08\xba\x3b\x0a\x1c\xeb\xca\x3d\x6a\xf9\xe1\x94\x9d\x15\x7e\xf0\x40\x25\xb8\x8d\x17\xf1\xbd\x35\x9a\x19\x50\x6b\x1f\x5d\xe9\xbd\xd2\x6b\x23\xb3\xa3\x3e\xe0\x63\xf6\x09\xc8\x87\xff\x7c\x14\xd1\xf1\x32\x0e\xe4\x86\x5d\xee\xa8\xd5\x3c\xa9\x4f\x4d\xfe\x92\xcf\x47\x0d\x98\x2a\x61\xff\xd6\xae\x69\xcc\x75\xef\xe5\x62\xcf\x38\xd5\x17\x54\x34\x10\xca\x4c\x9a\xb4\xcc\xa4\x19\x6f\x44\x44\x7c\x7d\xfc\x7f\xf7\x57\x7d\xd1\xa2\x50\xb9\xff\xd4\x58\xe5\x66\xc5\xec\x33\xf2\x63\xfa\x29\x6b\x37\xa4\xff\xc3\x77\xc1\xfa\xcc\x6b\x5d\xfb\x5b\x54\xae\x8d\xa1\x34\x06\x5d\xec\x2b\x01\x5c\xcd\xbe\xe3\x4f\x2f\x58\xae\x63\x9e\xa4\x5f\xba\x99\xa7\xd6\x6c\x91\x84\x64\xa6\x58\x77\xc3\x2d\x5b\x09\xbb\xc0\xff\x50\x7e\x0b\x37\xdc\xf8\x13\xf3\x71\x23\xdb\xf5\x67\xb2\x6a\x0c\x4f\x7c\x5e\x43\xd4\xd4\x50\x6c\xd2\x73\x34\x98\x7f\x77\xd2\x99\x94\xb3\xe7\x87\x9f\xed\x87\x64\x44\x95\xb3\xe7\x9b\xd3\x99\x1e\xe8\x1b\xe1\xdf\x38\x54\x1b\xd9\xf7\x63\xb9\x9a\x1c\x32\x53\xeb\xa6\xc7\xb0\xa8\xda\xbc\x1a\xe0\x69\xcc\x9c\x44\x64\x57\x77\x27\xe5\xdf\x9e\x71\x95\xfa\xd2\x5c\xa3\x58\x03\xa5\x1b\xce\xb7\xc7\x9e\x4e\x18\xd3\x29\xcd\xf9\x54\x97\x3d\x06\xf8\xc1\x70\xf4\x5f\x46\x3b\x59\x98\xaf\x2e\x5f\xef\x20\xce\x5c\xa3\x9d\x60\xe7\x7e\x91\x0b\xb1\x5e\xe8\x75\x6e\xa8\xb1\x57\x1c\xfd\x63\x9b\x29\x3f\x40\xfc\x0f\x61\x30\xa6\x9d\xd9\x1d\x26\x1c\x38\xf7\x31\xa0\x9d\x3b\x02\xbc\x61\xff\x62\x65\x8a\x08\xfa\x10\x72\x79\x2e\x23\xce\x24\x4b\xc5\x29\xa7\x61\x41\x22\x72\xa3\xa4\x2e\x0d\xfb\xbf\xeb\x51\xb4\x36\xd4\x16\x51\x0e\x19\x70\x6e\x07\x37\xed\x95\x7c\xf0\x1a\xc1\x6c\x40\x7e\xf8\xa7\x2f\x71\x29\xf2\x58\xc6\x11\xe7\x71\x13\xf6\x59\x35\x58\xbe\x58\x03\x86\x6f\x0b\xb5\x23\x97\x9b\xa9\x7f\xe6\xe5\x28\xaa\x71\x20\x31\xc9\xc3\x4c\x0c\xc7\x91\xef\xd4\x06\x7c\x1b\xcd\x39\x6a\x98\x48\x87\x94\x68\x78\x37\xbc\x69\xed\x4f\x1c\xab\xdc\xd7\x7b\xb3\xd7\xca\xe2\xdd\x66\xb0\xc1\x72\x37\x15\x23\x69\xbd\x2b\xff\xc5\x72\x1e\xe6\x8b\x5b\xcc\xc2\xcd\xe4\xa8\x19\xa2\x1b\x0e\x9e\xa7\x5a\x33\x23\x32\x67\xf4\xd3\xcf\x7e\x97\x39\xd7\x53\x7d\x64\xef\x1a\x85\x9a\xa4\x91\x21\x22\xb9\x66\xdf\xee\xa7\x16\x86\xbb\x8a\x09\x87\xe5\xd9\xe5\x15\xa3\x85\x66\xbb\x10\x1d\x4d\xfd\x9a\x41\x53\x29\xff\x2e\x6e\xb2\x17\x44\xda\x3b\x1f\xb8\xad\x60\x34\x9a\xd9\x22\x90\x22\x14\x6c\xaa\xdb\x69\xc2\xb3\x82\xec\x31\xcf\xcf\x1d\x59\x43\x11\x53\xcc\x08\x18\x27\x6c\x95\x9b\x9e\xac\x81\xd3\xd2\xa1\x1f\x15\x3e\xff\xe1\x3a\xba\x76\x48\xe2\x7c\xdc\x61\x94\x79\x8f\x08\xb8\x17\x19\xb5\xb5\x5e\x3a\x51\x07\x66\x8c\xe4\xfd\x8f\x37\x70\xfe\xf2\x65\x3f\x98\xd3\xc2\x8e\x8d\x41\x12\x32\xee\x09\x6f\x72\xf6\x8c\x71\x5c\xaa\xd9\x0e\xf2\xfe\xf0\x15\xb0\xce\x2a\x39\xc3\x50\x2e\x17\xa9\x4c\x10\xf2\x06\x8e\xd7\x3e\xba\xb6\x6b\xcc\x02\x3b\x36\x99\xd8\x17\xc9\xfe\xd4\xbe\xb3\x99\xb2\x6d\xca\x2c\xfe\x92\x8f\xb7\x59\x72\x4e\x94\x95\xc0\xfb\xfc\xe7\xd3\x40\xcd\x4f\x5f\x71\x83\xe1\xd8\xc4\x68\xbb\x99\xe3\x30\x69\x74\x94\x1e\xd3\xcc\x07\x76\x38\x7d\x3d\x21\x44\x11\xff\x71\x21\x33\x7b\xc0\x35\xc5\xd2\x34\x33\x67\xc0\x52\x7d\xc7\x6d\x61\x1a\x1c\x1b\xee\xbb\xd3\x5d\x91\xc0\xd6\x3f\x31\x48\x93\x34\x54\xc0\xb2\xd1\x0a\x47\x03\x9c\x87\x98\xa9\x6e\xdc\x0c\x73\x3a\xeb\x97\xbe\x52\x2b\x99\xcb\xfc\x89\x2b\x98\xdd\x57\xbf\x2a\xa3\x94\xbc\xdc\xa0\x03\xdb\x79\x54\x84\x0a\x8f\x78\xfd\xeb\x59\xac\x0b\x09\x2c\x19\xef\xfd\xf0\x49\xd8\x24\x38\xf0\xf4\xac\x2b\xf6\x51\x94\x5a\x1d\x0b\x5e\x2e\x63\xaf\x18\x87\xfd\xeb\x77\x7f\xb0\xc5\x1d\x04\xdd\x8c\x8e\x18\xa0\x5a\x7c\xf8\x55\x02\x87\x6f\x0b\xc5\xe9\x1c\xf1\x1f\xef\x5a\xeb\xf1\x5f\x47\x13\x9c\xab\x99\x29\xb3\x7c\x08\x87\x91\x7a\x08\x18\x30\x5b\xc0\x4a\x85\xfb\xb7\x7f\xce\xec\x7c\x70\xcb\x98\x79\x41\x4d\x8a\xac\x92\xe0\x4f\x31\xf7\x42\xd3\x7b\xad\xd0\xa8\x7f\x71\x5d\x83\xe5\x5c\xe3\x23\x07\x4e\xf7\xe8\xdc\xa9\x39\x2d\x2a\xdc\x1a\xa4\x8b\xcc\x8a\x86\x9c\xb0\xff\xc8\x8b\xa0\x38\xe5\xca\x34\x9e\xc4\x8b\x53\x0b\x67\x28\xc1\x5c\xa7\x16\x68\xc9\xbf\x1e\xda\x51\xd3\x80\xfa\xfc\xf0\xca\x0d\xc5\x12\x39\x61\x01\xb7\x95\x81\xe2\xbd\x3f\xba\xe9\x5d\xb4\x25\xfc\x15\x13\x37\xa4\x32\xe1\x30\x15\xdb\x8c\xba\x7f\x4c\x56\x63\xd8\x73\x0d\xd4\xe4\x27\x16\x50\x6f\xd1\xf3\x17\x47\xe1\xf3\x2e\x24\xe5\x34\x8f\x33\x8d\x11\x13\xf3\x7f\x31\xdd\x77\xd5\x6e\x49\x08\x19\xb0\x73\xd5\xe4\x0b\x60\x98\xd4\x31\x0d\xa6\x29\x77\x65\x04\x4c\xa4\xfc\xf8\x7e\x38\x77\x45\xaa\x4b\xcb\x4c\xd6\xad\x5a\xdf\x7b\xa5\xcb\xa4\x60\x18\x3a\x82\x39\xec\x66\x8f\x0b\x7c\xf7\x8f\x07\xb3\x68\x33\x93\x0a\x06\x5c\x64\xf8\x74\x05\x1b\xc8\xcf\xcd\xac\x4b\x68\xa7\x53\x22\x5e\x22\x76\x47\x68\x8b\x3f\xb9\x1d\xde\xea\xf8\xc1\x11\x6c\x0f\x31\x9b\x28\xf7\x33\x43\xd1\x0c\x9e\x73\x22\xe3\xe4\x94\xbf\x38\x28\xe7\x18\x88\x62\x89\x8c\x4f\xde\x10\xf4\xf8\xe5\x2b\x39\x8c\xec\x8c\xcc\xd1\xfd\xc4\x51\x96\x67\x98\x9b\x32\x73\x8a\x1a\x17\xd0\xe5\x23\xe0\xed\x72\xfc\xd3\x09\xc8\xff\x7b\xfe\xca\x87\x19\x01\x17\x86\x30\x0c\xc7\x68\x4d\x6c\xec\x54\x10\x96\xe9\xa0\x02\xb7\x0c\xe7\x87\xbf\x80\x63\xd0\x5f\xbe\xc5\x10\x2c\x2e\x0b\x78\x2a\xde\x2d\x63\x92\x9b\x4b\xdf\xb9\xbf\x3f\xff\x7e\x7e\xf0\xdf\x77\x53\xf6\x9a\x39\x7d\x41\x46\x25\xe8\x3d\x3f\xc9\xcd\xd7\x72\x40\x7a\x63\xc1\x49\xfa\x37\xb9\xca\xe4\x46\x83\x05\xac\x08\x91\x3e\x1f\xa6\xb8\x65\x36\x64\xc0\x2e\xff\x9e\xdf\x19\xa7\x91\x07\x2d\x66\x63\x30\x8f\xa9\x4d\x8a\xee\x11\x17\x0a\x98\x78\xd0\x54\xd5\x20\xc2\x84\xfd\x27\xdb\xfa\xa0\xf6\x0a\xf5\xc9\xdf\xa7\x80\xe0\x37\x21\x15\x90\x22\xf2\xb5\xf2\xb9\x6c\x61\x20\x97\xff\xa7\x2b\x21\x96\x05\xf5\x49\x91\x28\x58\xcc\xbf\x65\xba\x05\x9e\xd2\xc9\x5f\xdc\xb3\x59\xaa\x91\xdb\x42\xea\xf9\xbf\x73\x3b\x05\xc7\x7e\xcd\x61\xd9\x46\x05\xb1\x35\xc5\x07\x2a\x1b\xd1\x1a\x57\xf8\xff\x63\x69\xf1\x0b\x92\x77\xc9\x2f\x25\x6d\x8b\xd3\x0e\x0f\x25\x0c\x1d\xe4\xcb\x44\x85\x31\x32\x16\x99\xf7\x8d\xf2\x73\x2f\x89\x3d\x85\xd3\xff\x3c\x0a\xd5\x7b\xf5\x04\xed\x14\x3e\xee\x50\x68\x23\x85\xe6\x76\x9c\xab\x2e\x3e\x3a\x16\x24\x3f\xc8\xcf\x55\x6b\xc2\xf4\x73\x1e\x51\x9d\x05\x65\x79\xf2\x4c\x0e\xa9\xd3\x23\xcb\x96\x26\xe2\xe7\xe0\x1a\x23\xe2\xbd\x7d\xe8\xb6\xac\x16\xb2\x20\x0c\x4d\xfb\xca\x4a\x5e\x5c\x9e\x8e\xcb\xf8\x15\x22\xa4\xbe\x28\x08\x9d\x92\xfe\xc8\xcb\x08\x7b\x5a\xc3\x98\x78\x51\x79\xb4\x40\x4f\x5f\xfa\x82\x4c\xfe\x2e\x07\x4a\x13\x9d\xc8\x00\xd7\x6e\xd5\x92\xac\x78\xdb\x5d\x25\x43\xb8\x7c\xe5\x9a\x4f\xcd\x30\x0a\x53\xfc\xbc\xc4\xe7\x2a\xb5\x9f\xfe\xc5\x1c\x83\xb2\xec\xab\x04\x20\x46\xf6\x61\x18\x1e\xd1\x94\x21\x66\x87\x5d\x9e\x69\xab\xaa\xf5\x49\x10\x2b\x1c\x1c\xc9\xff\xc4\x03\xd4\x44\xc6\x09\x18\x1e\x8b\x27\x46\xe7\xf0\xff\xb8\x2c\x51\xcd\xf5\xb2\x64\x65\x6a\xfe\xb3\xb3\x49\x9b\xae\xc4\xbc\xa6\x48\x3a\x35\x94\xe4\x24\x85\x6d\xa5\x9b\xc1\x33\x98\x15\x36\xc9\x2c\x9a\x58\x71\x63\x78\x0b\x66\x80\x3a\x3b\x09\x46\x0d\x18\x05\x01\x7e\x27\xfc\xe4\xb0\x8d\xd6\xb4\x7a\x08\xf8\xcc\x91\x9f\x66\x5f\x73\x39\x63\xd0\x1a\xb2\xed\xc8\xd6\xa7\x98\xda\x5e\x95\xc8\xa0\x78\x28\xb9\xc5\x92\x5d\xec\x8f\xdf\xa5\x78\x9f\x7a\xd8\x49\x96\x96\xa7\xad\xa3\xb9\xe6\x7f\xce\xe8\xc2\x6a\x0b\x1a\x96\xcb\x85\x27\x86\x7e\xd2\x09\x3b\x6c\x6e\x12\xd6\xc3\x19\xb1\x4d\x21\x1a\xea\x66\x27\xd7\x8d\xd5\xd3\xd5\xc9\xca\x1b\x87\xb0\x97\x14\x05\x1c\xb5\xf1\xc1\xb6\x07\x74\x7a\x37\x3f\x1a\x6c\xf0\xf5\xe3\x2b\xee\x7e\x7c\x59\xcb\x69\xde\x0a\x03\x71\xa3\x4c\x4f\x1e\x55\xfa\xce\x0f\x43\xb1\x9a\x82\x75\xc4\xe3\x52\xd3\xd5\xbb\xd1\xfc\xe0\xbf\x7d\xfc\x50\xe5\xb1\x7d\x04\x52\x66\x08\xfb\x86\xad\x19\x26\xdd\x6c\xf3\xb3\xfc\x13\x1b\x09\x87\xe7\x1d\x06\x15\x0e\xff\xef\x9c\xc9\xa3\xf3\x2e\xec\x57\xef\x4a\x36\x7b\x5c\xac\x8e\x09\x54\xc0\x48\x55\x54\x7e\x85\xd6\xb9\x2a\x48\x93\xd0\xf4\xed\x5c\xca\x3a\x9b\xea\x7e\x80\xc0\x44\x91\x16\x11\xc1\xdc\x20\x92\x13\x99\x2e\x71\xb6\xe6\xfb\x52\x32\x33\xd0\x8f\x3f\xcd\x3e\xbd\x41\x38\xf9\xc8\xb7\xaa\xd0\xaf\x15\x03\x6e\xe3\x39\x67\x1d\x15\x3c\xa6\x1f\xd0\xd6\xbd\xef\x80\xc2\xbd\x48\xf5\x32\x98\xc8\x20\x23\x18\x12\x36\x13\x14\xa7\x3b\xc9\xb6\x52\x44\xb8\xd1\xc8\x25\xdc\xb4\xff\x64\x9e\xb1\x8c\xf3\x05\x9c\x9b\xdc\xcd\xe1\xcb\x4a\x70\x43\x4e\x0e\x61\x23\x08\x72\x47\x42\x2a\xb9\x80\x7d\x57\x92\x58\xd7\x12\x7b\x69\xea\x60\x78\x8b\x1a\xc4\x25\x67\x22\x52\x52\x50\xf8\xe8\x16\xf7\xca\x8b\x8e\x37\xb4\xff\x74\x06\x4f\xcf\xd4\x47\xc6\x48\xd1\x0b\xcb\x2d\x5b\xe7\xc1\x4b\xb7\xdc\x84\x51\x06\x87\x09\xeb\x93\xc0\xb2\xd2\x4a\xfa\x1d\xea\x2d\x8d\x9c\xeb\xb3\x34\x87\x38\x9f\x9d\x66\xcd\xdd\x97\xbb\x13\x27\x61\xff\xdf\xbe\x38\xe4\xca\xda\x7c\x53\xbe\x7b\x88\x45\x01\xea\x83\x32\x51\xdf\x91\xaa\x2e\xa1\xc2\x37\x58\x88\x93\x63\x70\x49\xea\x5a\x8c\x9b\x9a\xe8\xba\xb2\x87\x70\x18\x1a\x67\x5e\xfb\x9c\x9a\x3f\x86\xa0\x35\xad\xca\xfd\xd3\x07\xf2\xc2\x7e\xb5\xbb\x32\xc5\xb5\x0c\xa3\x10\xfe\x65\xe1\xb9\xd7\xcd\x64\xb7\x37\x4d\x70\x8a\xbb\xc4\xeb\x2a\xc9\xed\xf3\x1e\x0c\xe1\x44\x4a\xc5\x1e\x41\xd2\x86\xef\xde\x70\x7c\x08\xbd\xc4\x05\x06\x87\xe4\x80\x5e\x81\x6f\xc0\x04\xca\xcf\x92\x71\x81\xb7\xd3\xae\xe7\xc4\x3d\x1d\xf7\x07\xae\x32\xee\xab\x17\x63\x38\x24\x16\x69\x43\xb4\xfd\xfb\x02\x05\x87\x12\x8b\xcf\xe5\xbe\xfd\x72\xc9\x1b\x25\xbf\xd6\xbc\xae\xb3\xa4\x71\x5f\xcb\xb4\x42\xee\xae\x6c\x7b\x2e\x55\xcd\x6e\xa0\x40\x58\x7e\xee\x1d\x73\x4e\x83\x3c\xd1\x16\xd3\xc9\x6c\xc4\xe7\x03\x0b\x29\xa7\x75\x9a\xf1\xad\x27\x2c\x95\x44\x1b\x2c\x9f\x42\x6a\xe6\x21\xd8\x4c\x30\x1f\x89\x73\xdd\x1a\xfe\xf6\xe8\x4b\x6d\x3f\x69\xdb\xbd\xcb\x3a\x69\x1b\x55\x18\xb8\x4e\x28\xbd\x42\xf3\xfa\x0f\x2f\x23\x53\xa4\x72\xd4\xf1\xde\xeb\x24\xdf\x7a\xe8\x39\x6f\x39\xb7\x65\x25\x77\x80\xd8\x1a\xca\xe0\x39\xb2\x49\x12\xee\x97\x9e\x70\x3d\xd9\x3f\x5f\x34\x23\xd1\xaa\x86\xa5\xcf\x34\x5e\x4e\x64\x55\xe5\xab\x18\xe1\x37\x71\x66\x9c\x12\x5b\xf9\x29\x9c\xee\x27\xe7\xd5\x33\x56\xc7\x7b\x4f\xc2\x14\x34\x4c\xdb\x03\x55\x2e\xc7\x41\xee\xc0\x52\xca\xcb\x95\xf0\xdc\x94\xe3\x94\xc9\xae\xd5\xb8\xec\x20\x75\xe4\xd9\x88\xcc\xdc\x23\x4a\xba\x11\x5c\x99\x3d\x06\x25\x94\x9c\x92\xce\xd9\xa8\xac\xb8\x9f\x1f\x5b\xcf\xef\x1f\x79\xff\x65\x28\xda\xc9\x1f\x34\x7b\xdc\x14\x06\x93\xe6\xd3\xfc\x28\xaf\x45\xdd\xdf\x0f\x55\xf8\x69\x0c\xdc\xc8\x79\xa1\xb9\x1a\x22\x98\x91\x9f\x86\x94\x5b\x3a\x58\x99\x8d\x10\x48\xcb\x4f\x4c\x66\x79\xdc\x14\xd3\x89\xa0\xd6\x7a\x56\x7a\x6c\xcc\x11\x31\x41\xdd\xf5\x82\x94\x63\x3a\xe6\x15\x98\x8f\xf1\x18\xdc\x63\x22\xf3\x97\x2f\x2b\x93\x17\xaa\x66\x99\x47\xec\xed\x4d\xc1\x28\xfd\x1a\x93\x6d\xcb\xf7\x70\xba\x79\x57\xd6\xeb\x23\xfc\xc4\xae\x46\xc3\x5e\x4e\x12\xdd\x7b\x53\xde\x64\x00\xc3\x51\x01\xce\xb2\xda\x97\xbc\x13\x55\x78\x3d\xc5\x0d\x66\x80\x67\x8e\x0f\x6d\xa6\xdf\x55\xfd\x22\xd1\x93\xf5\x75\x80\xa3\x78\xfb\x51\x66\x20\xd5\x2b\xb7\x11\x02\xf1\x7f\x9f\x53\x28\x18\x43\x4f\x2a\xc7\x97\x23\x08\x19\xc1\x6a\x4f\x61\x7f\x3d\x3f\xd8\x65\xa9\xad\xb5\xd0\xe0\x42\x40\xa5\x7c\x13\xba\x4b\xa8\x23\x18\xad\x68\x44\xac\x8b\xd6\x00\xc7\xfb\xfd\x08\x76\xe7\xdf\xe3\x82\x1d\x94\xac\x5d\xad\xc6\xc4\x3f\x3a\x3d\x66\xd9\x43\x91\x13\x02\x47\xa8\x5f\x1f\x19\x2f\x70\xd9\x50\x2e\x91\x13\x42\xa2\xd4\xb3\xec\x48\x34\xcb\x34\x8f\x01\xf5\xeb\x2a\x0b\x6d\xf0\x0d\x64\x13\x80\x3b\x93\xaf\x81\x49\x0b\x91\x4d\x2f\x48\xc4\x4f\xcf\x35\x9a\x01\xf3\x2f\x1f\x8e\xf4\x0c\xf0\xa1\xe3\x0d\x4d\xfc\xef\xec\x37\x0d\xb4\x37\xa6\xbd\x18\xdf\x4e\xe9\x56\x45\xd0\xba\xcd\x02\x97\x32\xd1\xf9\xe4\xb1\xa6\xdc\x1a\xa8\xf8\x43\xfd\x3a\x63\xb1\x05\x1a\x21\xeb\x0e\xdc\x9a\x66\xd4\x7f\xb8\xd9\xb3\xf5\xa6\xd8\x4a\xc9\x4f\x5f\x20\x86\x4d\x62\x0b\xef\x68\x4b\x9b\x37\xfe\x46\x3d\x30\x36\x5e\xe3\x85\x5e\xe3\x49\xbf\x14\x46\xa3\xa0\x56\xf0\xe5\x16\x86\x39\x00\x77\xe9\x7e\xc7\x28\x9b\xc5\xe9\x9b\x33\xc6\xf3\x53\xc3\xd8\x2b\xd6\x3b\x75\x8c\xf2\xda\x56\xd1\xa7\xaf\x48\x18\x61\x7b\x84\xeb\xa6\xed\xf4\xf1\xf8\x10\x61\xb5\x39\x4d\xc7\xc6\x97\xbd\x32\x39\x58\xa7\x3a\xfb\xb2\xc7\x4e\x4f\x54\x3d\x50\x6e\x86\x44\xa7\x91\x1f\xbf\x6c\x9a\x18\xb3\xcc\xbe\x71\x8a\xc3\xfd\x8f\x6e\x17\x1a\x01\x2f\x4e\x17\xb2\x94\x05\x46\x06\x37\x00\xf8\xd0\xb4\xc0\xa3\x2e\x45\xa8\x96\xd9\x5b\x5b\x68\xe3\x33\x13\xe6\x64\xd3\xf4\xe3\xf7\x30\x5c\x96\xb2\x9e\x66\xca\x6d\xe1\xd0\xa9\x37\x1f\xa5\x72\xa5\xbb\xd8\x71\xe6\xdf\xb9\x64\x8b\x7a\x46\x02\x40\x08\xbc\x33\x16\xc2\xc3\xf9\xca\x08\x60\x7f\x4a\x7e\x2b\x61\xb4\x5c\xab\x69\x1b\x51\x59\x20\xa6\x88\x40\x05\x06\xe6\xb3\x71\x1b\x2d\x24\x9d\x82\xa2\xd8\x1f\x38\x23\xd1\x22\x49\x3f\xed\x15\x0b\xb5\x7a\x73\x72\x6a\x05\x8d\xf2\x9f\xef\x6e\x3f\x77\xec\x7a\xb8\x36\x62\x00\xf9\x95\xb0\xc9\x21\x65\xab\x06\x12\x6f\xad\x6b\x70\x09\x60\xd3\x8f\xb8\xd0\x78\x3f\xd3\x09\x24\x19\xb6\xd3\x69\x5b\x1c\x8f\x2a\xbf\x4a\xaf\x64\x5c\x2e\x5e\xee\x9f\x71\x8a\x9d\x95\x31\x5c\xf0\xdd\x34\xd3\xbf\x7b\x84\x2d\xdf\xbc\x74\x10\xaf\x86\x9f\x6f\x10\x8e\xdd\xfb\x51\x07\x4a\xe6\xfb\xaf\xb2\x5e\xad\xc0\xbc\x7f\xe2\x29\xa6\x61\x81\xab\x76\x21\xbc\x60\x26\x67\x2f\xca\xcf\x11\xaf\xe0\x74\x8d\x25\x7c\x38\x5c\x79\xa3\x46\x5d\xc0\xb1\x7b\x03\x03\x64\x49\x6e\x8a\x7f\x5e\x19\x14\xc7\x63\x51\x05\x87\x54\x9f\xfe\x2f\x36\x05\x78\x86\xee\x40\x64\x91\x9c\xd7\x77\x5b\xc7\xf3\xbb\x73\xc7\x14\xb0\x62\x9b\xcd\xeb\xbc\x84\xa7\x3c\x9a\x91\xeb\x76\xd3\x03\x4c\xbd\x6d\xd4\x54\x9f\xd0\xfc\x29\x5b\x9e\xf0\x07\x2b\xc4\x7b\x6a\x44\x76\x8e\xf2\x52\xa5\xf7\x6c\xca\x0c\xc3\x60\xd3\xbe\xef\x64\x13\xe1\x70\x66\xeb\x1a\xec\xfc\x3a\x46\xb1\xd1\x7c\xde\x40\xd4\xd2\xd4\xd5\x3f\x2a\xb7\xcb\x97\x8d\xfc\x89\x73\xf9\xbe\x41\x76\x9e\x42\x2a\x18\x29\x02\x22\x59\xf7\x4d\xa3\x2e\x62\x5c\xd7\xc5\x43\x18\xe4\x58\xda\xe6\xd5\x8b\xce\xbd\xae\x8d\x93\xc3\x4c\x69\x71\xc3\xfb\xef\x4c\xf0\xfa\x86\xcb\x3d\x86\x37\xe8\x01\x7d\xd4\xd5\x7b\xf5\x21\x8d\xd8\x02\xc4\x5d\x88\x74\xb4\x2f\x0c\x26\xcc\x46\xf2\xa3\x18\xb5\xf7\x7a\xcd\xdd\x01\x9f\x38\x72\xc9\xda\xf5\x31\xaa\x61\xf1\xfe\x72\xd2\x83\xdc\xd8\x4b\xbf\xc6\xa0\xf7\x03\x96\x3f\x15\xba\x59\xe4\xb9\x2d\x1a\x25\xd4\xd6\x34\x82\x06\x9c\x69\xe6\xe8\x26\xdb\xc8\x15\x40\xe3\x38\xff\xe2\x68\x59\xc7\xcd\x50\x1f\x45\x68\x32\x3a\x42\x99\xcb\xed\x30\x57\x4e\x54\xfb\xc1\xf5\xe3\x38\x1e\xb7\x8b\x90\x2f\xe0\x7b\x73\x22\x2e\x05\xd8\x9d\x1e\xec\xde\xfe\xe6\xd1\x17\x7c\x4a\x7e\xb3\xe0\x4f\xa0\x7b\xd5\xc4\xda\x05\x8c\x6f\xb4\x50\xad\xbc\xa6\xbf\xd1\x29\xbe\xb0\x83\xea\x07\x08\xa2\x79\xf3\x0c\xa6\x73\x5d\x6e\x13\x3b\x5c\xfc\x7d\x4d\x3d\xcb\xfc\xc4\x72\xec\xe3\xe4\x23\x57\xb5\x26\xec\xc7\xe2\xe1\xe7\xb9\x98\xfc\x97\x5f\x12\x2e\xef\x15\x41\x3b\xca\xd0\x5d\xb2\xc6\xdb\xd9\xc6\xe6\x3d\x77\x81\xe5\xff\xc9\x0d\xb5\x7c\x0f\x48\x91\xde\xfd\x58\x8f\x7c\x29\xfc\x8f\x2c\xe3\xbf\x9c\x13\x17\x47\xfd\x38\x82\xbb\x6a\x5e\xc0\xa5\x5d\xf6\x44\xc7\x37\x8f\xef\xb1\x9b\xff\x1e\x9a\xf3\x07\xff\xe4\xf1\x9e\x80\xa3\x95\x8e\xb2\x37\x4a\x16\xd6\x23\x19\x3c\xf6\x34\xd0\x7c\x75\xd5\x81\xcc\xe6\x03\x44\x10\xea\x7e\xca\xed\x28\x62\x76\xba\xb6\xa3\x01\xf9\x46\xba\xeb\xfb\x8f\xde\x60\x8f\x6c\xb3\xd8\xb2\xf2\x6f\x8e\x7c\x12\x24\xf6\x9b\x13\x5c\x9a\xc1\x8b\x15\x7c\x4a\x5f\x3c\xb1\x47\x86\xf8\x1f\x9f\xec\xba\x52\x26\x56\x9b\xde\x8b\xfd\x5c\x13\x2c\x0e\xbd\x3d\x56\x30\x76\x59\xce\xe7\x46\xfe\xfe\x8b\x2f\x4c\x01\xf9\x70\x7a\xc3\xb4\x94\xd1\x3e\xaf\x0a\x6b\x7c\x79\x1e\xf4\x69\x48\xa0\xd9\x92\xb6\xca\xda\x0d\xd9\xfe\xeb\xe9\xac\xf2\xf6\x8c\xe9\xc9\x4d\xdf\x55\xaa\x0e\x48\x25\x5e\x01\x53\x5d\xb5\xc1\x16\xff\x13\x57\xb5\xcc\xc3\x9c\xaf\x60\xbe\x43\xae\x95\xd5\x48\x98\x98\x88\x44\x3c\xa8\xde\x9c\x52\xfe\xf8\x11\xd2\xa5\x58\xb1\xc2\x97\xf9\x5a\x8b\x85\x39\x3d\x15\x7f\x05\x8c\x5b\x66\xf6\xd6\x60\x0e\xff\xfe\xff\x58\x86\xad\x1b\xd5\x13\x8e\x84\x58\x3b\x48\x52\x44\x11\xbe\x80\x53\x87\x34\xec\x7f\xd6\x3b\x23\x3d\xd2\xcd\x2c\x8a\x7d\x23\xef\x83\xd7\x28\x57\x30\xa6\x30\xdc\x0d\x90\x73\xee\x07\x32\xb0\x7f\x5c\xe6\x6f\x42\x56\xfe\xa7\xd2\xca\xb7\x01\x92\x15\x4f\xb0\x08\xd7\x3f\x8e\x5a\xe8\xd2\xaf\x78\xee\x7f\xd6\xfa\x51\x00\x9e\xb1\x1e\x26\x6f\xb3\x75\xe5\xb7\xb3\xb4\x4e\xfe\x48\xc5\x21\x12\x95\x80\xf8\x7f\xb1\xc9\xaf\x67\xb4\x40\x01\x07\x43\x8f\x95\x3f\xf3\xee\xd8\x11\xbf\x34\xf2\x0f\x7e\xc7\x97\x94\xc3\x92\x93\xfd\x8a\xf3\xd6\x68\xfa\x1d\x69\x77\xa5\x0f\x66\xf3\xb5\xdb\x1d\x2a\xfe\x5f\x1c\x6c\x2e\x87\xd9\x77\xfe\xda\xc8\xd6\x55\xb9\xf7\x1b\x0a\xe1\x6d\xb5\x6f\xc0\x54\xd5\x05\xa1\x8d\xec\x74\x7f\x79\x14\xf9\xde\x5d\x94\xde\x2f\xad\x99\xa1\xc8\x76\xc9\x76\xa9\x9e\x8c\xa7\xd0\x0d\x01\x27\x94\xdb\x2e\xfe\x27\xee\x64\xc7\xdc\x41\xe5\x53\xab\x44\x88\xb2\x1c\x7a\xb2\x64\xb8\xfa\x76\x01\x57\x90\x6d\xa1\x72\xb6\x03\xfd\x5b\xcb\xc4\x8a\x2f\xc6\xbe\xbb\x48\xe5\x69\x2a\x0e\x88\xb5\xa0\x01\x93\x03\x74\x21\xb3\x1f\xd1\xfb\x1f\x1c\x40\x79\x12\x06\xa9\x7c\xd5\x98\xb6\x39\xef\xb5\x7a\xfe\xda\xf7\x03\xf9\xbb\xcc\x33\xec\x3f\xbc\x09\x9f\x49\x4a\x7b\xcf\x14\x39\x11\x37\x5d\x1a\x0c\x1b\xa9\xfa\x38\x86\x4d\x9d\x62\x1e\xb9\x76\x41\xfe\x3c\x4c\xb7\x0d\x7f\x71\x96\xe7\xe6\x5d\x76\xa2\x68\xb1\x60\x23\xfa\x73\x84\x33\x2d\x7f\x4a\x98\x61\x76\xd9\xd5\xe9\x24\x70\xfe\xd3\xfb\x8e\x46\x14\xfe\xa8\x58\xa0\x32\xef\x0f\x82\xa1\x86\xf7\xb1\x9a\x7b\x9f\x2c\xc1\x0c\x45\x31\x0c\x57\xc7\x10\xdc\xbf\xdc\x54\x46\x97\x3e\x54\xe4\xd3\x20\xb3\xc9\x33\x2d\xff\xfe\x6d\x6d\xb3\xd4\xc4\x47\x9b\x07\x46\x8e\xb8\x2b\x5a\xa8\x43\x14\x3c\x66\xfc\xc1\x8e\x0d\xa2\xf0\xbb\xca\xd5\xac\x1c\xab\x9c\xeb\x83\xb6\x83\x4d\x14\x4e\x61\xa2\x65\xa8\x78\xdb\x3f\xcc\xa7\x73\xd5\x79\x31\x76\x9d\xcd\xf4\x65\x9c\xbe\x25\x97\xf6\x3b\xdc\xbb\xee\xf2\x51\x53\x61\x21\xb9\xc6\xfb\xee\x34\x38\x61\x08\xc4\x75\x54\xdc\xa9\x2c\x20\xaf\xff\x83\xc5\x39\xc9\x1e\x46\x1c\x91\xd4\xc3\x7a\xc0\x03\x83\xc5\xe6\xdb\xaa\xa9\xad\x7e\xd8\xcf\x4d\x55\x8a\xfb\x35\x88\x6c\xf9\xf4\x45\x79\x60\x4b\xc1\x0f\xa9\xd1\xf1\x6b\x98\x3a\xde\x1f\x1d\x18\x35\x3a\xc3\xf7\x9d\x5b\x9e\xf2\x29\xe9\xc6\xd9\x06\xa6\xd4\x21\xc8\x85\x48\xd7\x23\x1d\xbc\x8a\xa9\x1a\xdf\x94\x75\x76\xb7\xee\x15\x75\xd8\x17\xc0\x6e\x26\xfc\xe1\xfd\xe3\xe4\x22\xff\x94\xa2\x26\x1b\xe7\x00\xda\x9c\x0f\xd3\x90\xd5\x3e\x6d\xc1\x75\xae\xc7\xa6\x43\x25\xd8\xfc\x85\xe2\x60\xe4\x79\x6f\x73\xd4\x29\x0e\x86\xd9\xe6\xb8\x8e\xce\x4e\x3d\x76\x33\xc1\xf0\xfc\x27\x07\x97\xa0\x62\x1c\xff\xd5\xa6\x03\x9c\x6a\xba\xfa\xae\xd9\xba\xcb\x6c\x53\x98\x3a\x2e\xd3\xc7\x07\x3b\xb5\x74\x11\x31\xf2\xeb\x61\x93\x52\xe6\x69\xb6\xb9\x98\x7d\xd0\xb6\x36\x76\xae\x8a\x8a\x9a\xb8\xff\xcf\xef\x81\x79\xf9\xa5\x8d\x80\x36\xef\xd7\x44\x9b\x0e\xf4\x56\xe1\xf3\x9b\xb3\xce\x1f\x91\x84\x6b\x20\xeb\x35\xf3\x30\x1c\x52\xfb\x30\xda\x4a\x45\xa7\xe2\xd9\x45\xb5\x78\xdb\xdd\x38\xa8\xb6\xe1\x3a\xec\xf8\xa3\x6b\xb9\x47\x68\x29\x84\xc1\x2b\x94\x9b\x8a\xda\x0e\x07\x38\x14\x13\x54\xbc\xf7\x5c\xfe\xb8\x43\xa2\x2c\x42\x22\xb7\xc4\xb2\xae\xf3\x7e\xe0\x58\xa5\x1c\x75\x4a\xc4\xee\x3c\xee\x19\xd9\x7e\x03\xee\x9f\xce\x67\x1b\xc5\x75\xc5\xbd\xfc\x1e\xb0\x26\xee\x6f\x4c\xcb\x3b\xf7\x9f\xbd\x7c\x8a\x71\x49\xb8\x0e\x42\x34\x5b\x4b\x79\x9d\x66\x30\x4b\x9b\x07\x37\x08\x55\x3f\xff\x30\x6d\x85\xbf\xfa\xc2\x26\xe0\x8b\x7d\x4d\xe9\x7f\x7b\x97\x34\x65\xe3\x45\x7a\x5a\xf8\x6b\x35\xae\xb5\x0d\x02\xfb\xc2\x38\x62\x14\x60\x95\xe4\xba\x9c\x76\xaf\x8c\x91\xcb\xec\x62\x2d\xa1\x02\xc3\x61\x3c\x58\x1e\xd5\xc1\xf4\xf4\xa5\xa0\xd1\xbb\xe5\x53\xbe\x1d\xc5\xe1\x7f\x38\x42\x39\xf6\x2b\x62\xb6\xeb\xb5\xb6\xe8\x46\xf8\x91\x5a\x91\xaa\x8d\xc4\xd9\xad\xf9\x5b\x27\x36\x2f\x25\xcf\x18\x04\x19\x5c\x7e\x92\x8a\x73\xbc\xc2\x6f\x0a\xb9\x07\xd2\x69\xe9\x3b\x18\x1e\xa3\x34\x03\x64\x0d\xca\x53\xbd\x4d\xab\xae\x61\xdb\xff\xc5\xba\x6b\xfd\xa2\xb4\x64\x7d\x1a\x86\xcf\x70\xd0\x4e\xf3\x3c\x0d\xb4\x4f\x80\x99\xfa\x95\xcc\xd7\x2c\x35\xb8\xe9\x07\x77\xa6\x2f\x79\x36\x9e\xcb\x3d\xb9\x80\x43\x00\xcf\x81\x79\x5d\xa5\xb3\x1e\x53\xfc\xe0\xb4\x8c\x76\xab\x39\x43\xe9\x79\xe7\x96\x02\x6c\x1e\x45\xd8\x75\x81\xd3\x25\x88\x21\xd6\x73\xda\xdb\x2a\x96\xc8\xed\x08\x47\xdc\x5d\x7d\xa8\xba\x2d\x54\x1f\x67\x99\x21\x4f\x89\x9a\x4f\x72\x3b\xad\x98\x2a\x94\xe0\xd0\x9d\xa4\x18\xdc\x66\x5f\xf9\x87\x4d\x7f\xfb\x04\x3a\x1a\x20\x15\xfa\xb5\xd0\xc0\x4b\x44\x19\xfd\x77\xce\x58\x4d\x75\x35\x57\xd7\x6c\x4a\x31\x1b\xb4\xd1\x09\x92\x8f\x41\xaa\xc1\xcb\x02\xd7\x1c\x99\xf2\x70\xaa\xde\xd9\xdf\x7b\xd6\xf3\x04\x31\xe5\x1e\x6a\xe9\x36\x30\xcf\x9d\xf0\x6c\x5e\x53\x89\x34\xd5\xfd\xce\xf5\xc3\x40\xdf\x3c\x77\x66\xc1\x2a\x67\x29\xba\x99\x21\x4d\x55\x4b\x50\x25\xcf\x6d\x2d\xc6\xe8\x8f\x4e\x6d\x91\x57\xff\x8c\xa8\xea\x50\x16\x01\x81\x28\x10\xf0\x92\x26\xfb\x27\x52\xaa\xaa\x05\x7d\xbf\x70\x48\xf8\xca\x42\xdb\x2f\x50\x35\xbd\x5d\x57\x38\x0e\xf4\x4e\x86\x97\xd8\xb4\xd2\xe8\x59\xe8\xad\x21\x8c\x1e\x7c\xde\x95\xcd\x91\xb4\xc5\x9e\x77\xcb\xbf\x24\x26\x5c\xab\xc7\xbe\xcf\xdf\x7b\xe3\x0c\xcb\x7c\xda\x40\x69\x7b\xaa\x77\x5a\xe2\xe9\xa0\xa8\x49\xda\x79\xc2\x5f\x00\x42\x32\xc2\x55\x13\xd8\xf2\xad\x9b\x71\x16\xac\xec\xbf\xd9\xde\x8a\xcc\xec\xb2\x92\x00\x49\x92\xb3\x77\xaf\xe4\x15\xcb\x0b\x5b\x2d\x6c\x2a\xf7\x18\xc6\x21\x5b\xb0\x9e\x63\xb5\xcf\x9f\x44\x1a\xc3\x29\xb2\xab\xd4\x48\x91\xf6\xa6\x91\xbf\x38\xaa\xe1\xb6\xae\x25\x26\x7b\x59\x30\x79\x08\x04\x27\x2f\xe5\xa7\x26\x6b\x9e\xe0\x14\xa9\xcb\x8e\xe1\x63\x48\x1c\xa7\xde\xf8\xd8\x69\x3a\x5c\x7d\x2e\x59\xd3\xa6\x75\x97\xaf\x72\x71\x50\xac\xf8\x33\x28\x93\xb4\xe5\xab\x45\x8b\xe2\x5e\x2b\x1e\x6b\x91\x76\x60\xc4\xf5\x46\x6b\xab\x29\x73\xd3\x89\xcc\x1c\x5a\xf4\xda\x49\xa5\x1f\x8f\xb4\x1c\xa1\x07\x89\x9b\xf4\xb8\x63\xe3\x48\xf8\x1f\x54\x77\x2f\xcb\x7b\x64\x8b\xca\xaa\x77\x71\xef\x69\xa6\x85\x24\x2a\x77\x2b\x0a\x34\xfb\x73\x8d\x8e\x79\xfa\x7f\x75\x05\xa3\xc8\x89\xa4\x33\xb1\xa9\xee\x39\x26\xce\xb4\x6c\x30\xaf\x7f\x5e\x9f\x12\x07\x2d\x1c\x2d\x90\x3b\xf7\x0a\x87\xe3\x3d\x1c\x4d\x45\x70\x7f\xee\x4d\x18\xed\x5a\x53\xb6\x95\xb4\x16\x2d\x4f\x39\xde\xf6\x21\x4a\xa6\xc8\xc6\x32\x8e\xc4\x1c\x94\x4a\xce\xf5\xe1\x54\x64\xca\x12\x9a\x9c\x3a\x8d\xbf\xcb\x71\x4e\xbc\xcd\xcd\x40\xcf\xe6\x68\xc8\xb6\xbb\x9a\xfc\x59\x4f\x73\x6b\x3e\x92\x83\x8c\xd0\x93\x84\xf9\xed\x94\x55\x03\x7c\xe9\x8c\x90\xd0\x33\xae\xc2\xf5\x10\x37\x2e\x56\x4d\xe1\x57\x84\x94\xec\xae\x8b\x59\xed\xbb\x93\xd6\x18\x3c\xe6\x66\x7f\x72\x41\x8b\xdf\xf5\x69\x43\x6e\x73\x97\x46\xe9\xf9\xef\x70\xff\x9d\xac\x53\x61\x6b\x23\x4c\x47\xeb\x04\x52\x62\x20\x69\x8f\x75\x47\x14\xc2\xf4\x35\xc1\x60\x97\xf0\xe7\xbc\x2c\xf6\xd2\x39\x04\x32\xf7\xad\xef\x18\xe5\x97\x6c\x24\xb3\xf8\x19\x42\x3d\x45\xca\x20\xe5\xed\xac\x57\x8d\xc6\xfc\x24\x50\x07\x77\x8f\x97\xab\x6f\x98\x6d\x61\xa1\xef\x25\xa9\xb5\x67\xc3\xb6\xa5\x22\x83\xfa\xdf\xba\x4a\x46\xe0\xcf\x6c\xfe\xb8\x19\x5f\x69\x0e\x15\x74\x9e\xa9\xac\x04\x7e\x94\xb3\x69\x7b\xa7\xd6\x20\xf2\x05\x46\x67\xbb\x0a\x31\x1a\xe1\xcf\xf9\x58\xb4\xd2\xd9\xbb\xb9\x9e\x98\x4e\xa7\x9d\x79\x54\x64\x6c\x8c\x5f\xb7\x24\x0b\xa8\xd3\xfc\x27\x5e\xe2\x5a\x2a\x91\x49\x80\x46\x85\xc6\x23\xc4\x61\xe0\x53\xe0\x89\xfe\x2f\x86\xc0\x70\xcc\xa9\xf1\x82\xf7\xc9\x1d\x22\xf6\x3b\x7c\x84\xd4\x66\x63\x45\x3c\x3c\x65\xb4\xb7\x3d\x02\x8c\x90\x03\x3d\x88\x1c\x99\x21\x69\x09\x35\xb5\x22\xac\x27\x72\x9e\x23\xdc\x86\x6e\x05\x26\x3c\x66\xe1\x52\x4e\x09\xbb\x24\x67\xbc\x24\x52\x39\x2a\x69\x66\x4c\x02\xcf\x16\x78\x66\x0b\x21\xc7\xd6\x9c\xbf\xbe\x09\xa3\x70\x58\x67\x2a\xb1\xe0\xf9\xd6\xa4\xf0\xe1\x77\x58\x4d\x77\x0d\x3d\xfa\x20\x21\x20\x7e\xe8\xda\x63\x64\xac\xa1\x04\x69\x39\x81\xca\xb9\xd5\xfe\x93\x6d\xa6\x2b\x7d\x61\x64\xbd\x01\xfb\x05\xf0\x25\x8b\x70\xc5\x41\x25\xe6\x42\xcc\x4f\x9a\xea\x52\xa6\xcd\x9b\xd2\xff\xb2\xf7\xd8\xe1\x64\x76\xc7\x30\x0b\xc3\xe3\xc5\xdf\xa3\xa4\x34\x3a\xd1\xb9\xfd\xd7\x70\xc3\x8e\xcc\xd3\xdc\x14\x50\x84\xde\xe9\x78\xa5\xd1\x99\x29\x5f\xdb\x02\xd6\xf2\x1a\x39\x48\x3e\xed\x78\x05\xe2\xe7\xb6\x3d\x60\x06\xc6\x2c\xb7\x53\xd8\xdc\x32\xf8\x90\xd3\xb1\xfa\x5c\xd4\xe7\xae\x43\x80\xee\x24\x23\x4b\x76\xe1\xee\xc0\xb7\x25\x55\x96\x9d\x66\x5a\x72\x3a\xdb\x60\x15\x16\x7e\x4e\xff\x25\x50\xe6\x61\x4c\x4b\xb8\xd8\xbf\x1b\x29\x0b\xb7\x64\xbb\x33\x94\x4e\xe9\xcb\xa5\x7e\xb3\x65\x7b\xc8\xb5\x90\x35\xa2\x90\x9d\xc0\x66\xfe\xa5\x18\xdb\x73\x4b\x3a\xc3\x9c\x1b\x8c\xab\x85\xfb\x68\xd9\x47\x98\x68\x31\x7a\x79\xd3\x4e\x10\xd6\x31\xd8\xd6\xc8\xe2\xa1\xe4\x93\xa7\x09\xbc\x83\xe5\x00\x45\xee\x50\xce\x63\x2d\xd3\x80\xb6\xb2\xde\x28\x9b\xd4\x12\xbe\x25\xb9\xb1\x67\x81\x58\xf8\xd1\x21\x1c\x84\xfa\x56\x8b\x78\x31\x3b\xe8\x73\xc8\xcd\x70\xb6\x09\x79\x49\x8f\x47\xaa\xba\x38\x5c\x08\x4e\xcc\x87\x27\xd9\x02\xb6\xe7\xe5\xe3\x71\x01\xdf\x17\x24\xe4\xcd\x56\x07\x93\xe1\xe0\xce\x55\xa3\x15\x89\x0a\xbe\x2d\x42\x80\xca\xaa\x1e\xd4\xb1\x68\x39\xf3\x73\x3a\xc9\x21\x59\x86\x72\x16\x68\x72\x0c\xd3\xe5\x35\xd5\xd6\x52\x77\xe4\x4c\x1f\xcc\xef\x30\x9c\xb1\xfe\x57\xae\x2a\x76\x30\x3d\x45\xed\x75\xdd\x4e\x07\x93\x3f\xf6\xe7\xcd\x17\xcc\xac\x3b\xcb\x2c\xe8\x8c\x9c\xab\xf7\xaa\x72\xf2\x2b\x0b\x28\x64\x5f\x2f\xab\x46\xea\xba\xc2\xc0\x1e\x16\x53\x3f\x8b\xb2\x02\x9b\x5c\xda\x01\x34\x61\x7d\x8e\x0c\x31\x57\xb5\x06\x53\x7b\xef\x7c\x5b\xdf\x87\xf5\x10\x4f\xe0\xf1\x95\xf8\xd7\x9c\xd1\xff\x9b\x53\x1c\x8c\xb4\x71\x5a\x85\x1a\x7a\x63\x40\x3b\x08\x2f\xa2\x1d\x1f\x47\xa2\x6a\xc9\x5a\xc6\x41\x88\xae\x69\xa5\x86\x81\x1a\xa4\x81\x7e\xc8\x74\xd5\x1f\x87\x15\x81\x10\x0d\x12\x1c\xb4\x44\xcb\xb7\x80\x3a\x1a\x98\x0d\x56\xd1\x5c\x98\xf8\xf8\xd9\x12\x6a\xae\xaf\x57\x0b\xae\x19\x05\xa1\xda\x97\xc0\xad\x3d\xe7\xa9\x57\x12\x41\x8d\x7d\x61\x7f\xb7\xfa\x36\x8e\xdd\x71\x95\xde\x81\xea\x68\x50\x1e\xf7\xe3\xfb\x3c\x29\x9b\xd3\x49\xd5\x1f\x2a\x6e\xf0\xcf\x7a\xa0\x66\xe0\xa3\x5d\xe7\x28\x4e\x47\xc4\xd2\xf5\x12\xb8\xe0\x32\x5b\x98\x6d\x70\xb4\x72\x8a\xb8\xe5\xc8\x76\x95\x72\xa4\x1e\xc3\x59\xe3\xd5\x52\x6c\x32\x43\xf8\xaa\x34\xc2\x51\x6a\xbf\xd1\xea\xa4\x29\x16\x16\xdb\x21\xce\x94\x48\xea\x36\xbb\x9a\x51\x97\xf9\x8a\x83\x35\x75\xd1\xfd\xcd\xad\x32\x8e\x7e\x43\x51\x87\x3e\x8a\x3a\xca\x69\x39\xf6\x47\x40\x41\x52\x02\xed\x0f\x98\xe1\x3b\x35\x1d\xdb\x8a\x6c\x38\xa1\x12\x6e\x90\xf4\x87\x1a\x0e\x35\x9a\xe7\xf5\x73\xa0\xe6\x1b\xe1\xcc\x25\xde\xd5\x14\x47\xe7\xbd\x70\xb3\xcb\xb2\x82\x90\xf1\x4e\x99\x7f\xdb\xa8\xcc\x27\x0e\x1e\x63\x85\xf3\x1a\x9f\xdb\x7b\x8e\xf8\x77\xa6\x19\xc6\xc5\xf8\x8d\xe0\xd9\xd7\x57\xed\xd4\x52\x85\xc9\x4b\xb7\x18\x3a\x43\xc3\x69\x25\xee\xca\x5f\x3c\x5b\x42\x75\x31\xad\xa5\xbf\x7b\xe1\x1c\xa0\x82\x80\x94\x9c\xc7\xe2\x35\xe6\x74\x88\x40\x2b\x7c\x9c\x28\x5a\x16\x18\xd7\x84\xee\x54\x0f\x26\x4b\x81\xe6\xe7\x0d\x96\x29\x91\x37\x8e\xe1\xaf\x88\x71\x3c\x06\xfc\x53\xaf\xc8\x30\x9c\x76\x8d\x2a\xa3\x3d\x5e\x63\x5c\x8f\x77\xb4\xfd\x28\xf8\x6b\xe9\x6b\x06\x5e\xb6\xc7\x59\x01\x62\x16\x81\xe5\xb8\x83\x0f\xca\xcb\xce\x2a\x12\x03\x64\x52\xd1\xab\x11\x00\x3c\x67\x08\x60\x83\xe6\xb1\x9b\xdf\x60\xe1\x54\x02\x2e\x8a\x34\xc3\xae\x97\x0a\x0c\x2e\x71\x96\x85\xa9\xdb\x1f\x03\x2f\x24\x7a\xd2\x88\xa9\x9a\x32\xf8\x26\xdc\x95\x45\x93\x56\xb5\xde\x1a\x1a\xff\x1f\x57\x6f\xb1\x2c\xbb\xb2\x73\x8d\x3e\x90\x1b\x66\x6a\x9a\x99\xd9\x3d\x97\x99\x19\x9f\xfe\xc6\x5a\x7b\x7f\xe7\x9c\xff\xc6\xec\xcd\x08\x57\x65\x65\x4a\x1a\x63\x28\x65\x89\x70\x1d\xad\xac\xb4\xee\xf6\x39\xe3\x8d\x5a\x03\x34\xaf\x89\x22\xa7\xe2\xfb\x89\x87\xfa\x30\x0a\x6d\xff\x98\x54\x1c\x91\x02\x3f\x95\x1d\x5a\x87\x60\x1d\x83\xd2\xa4\x07\x5e\x1d\x8c\xe5\x30\x17\xc1\x29\x14\x8e\xfa\xef\x79\xd5\x4c\xbd\xaf\x61\xad\x72\xf7\xc4\x19\xca\xe7\x66\x42\x07\x50\xc4\x12\xac\xbd\x98\x99\xa9\xd2\x67\x25\x1c\x4b\xaf\xbb\x7c\x52\xe2\xdf\x1f\xe7\x56\x64\x09\x1f\xe8\x49\xdf\x00\x19\xbe\x95\x49\xfd\xe1\x17\x32\x83\xcb\xbf\x61\x3d\x86\xd3\xab\xea\x94\x7d\x27\x0d\x8a\x38\x1a\x27\xa4\xdc\xd2\x0c\x22\x73\xec\xe5\x3f\xe5\x23\x0c\xc3\x8b\x40\x3c\x68\xc1\x1b\xb9\x82\xcc\xab\xbc\xd7\x64\xb6\x0b\x3a\xc8\x0d\x7c\xd7\xa6\x43\x43\xff\x4d\xfc\x0c\x1c\xa2\xa9\x4f\x45\x1d\xb0\x75\x24\x5e\x33\x99\x0f\xaa\xa0\xb8\xdc\x5c\xf0\x5b\xc1\xf5\x79\x52\x4f\x52\x94\x85\xde\x1a\x7a\xb3\xb3\x60\x4d\x30\xd5\x2b\x87\xf0\x49\x83\x96\xff\x17\x80\x18\x46\xf0\xd8\x46\x68\x46\xcf\x8c\x5b\xca\x69\x53\x5f\xfa\x39\x13\x11\x9f\x65\xa5\xfe\xa2\x2a\x9d\x4c\x76\xe9\x8d\x61\xea\x51\x40\xbc\x4f\x93\x0c\x0d\xdb\x2d\xa7\x00\x3e\x0b\xc2\x99\x52\xca\xc1\x35\xfc\xe9\x53\xfc\x7d\x08\xbe\xe9\x10\xed\x89\x58\x46\xf6\xd8\xea\xbf\x18\xa9\x30\xd8\x1f\x3e\x90\xde\xd1\x63\x21\xa5\xfe\x70\xc6\x15\x7f\x3e\x6f\x9f\xa6\xd9\x92\x13\x3d\x71\x6d\x8a\x87\x22\x4e\x12\x3a\x44\x5c\x21\x7e\x95\xe4\x39\xd1\x44\x2a\xcd\xb8\xcc\x39\x63\xaf\x3e\xcd\xa0\xbf\xbc\xc5\xfb\x5c\xaa\xe7\x53\x51\xd5\x72\xfd\x3f\xee\xcc\xb7\x86\x7a\x99\xa6\x12\x55\x63\x39\x9a\x69\x38\x76\x77\xca\x67\x55\xc4\xce\xc8\x78\xbf\x6e\x41\x75\x3d\x11\x02\xdb\xab\x41\x52\x0f\x43\x12\x34\x56\x1f\x5d\xfd\x7e\xa8\x51\x83\x3d\x57\xef\xe5\xf8\x7b\x70\xa6\xd7\x52\x69\x38\x0a\xcd\x30\x20\xe2\x4b\x1d\x46\xdf\x6a\x8b\x39\x14\xe5\xd2\xff\x63\xcb\x8c\x35\xae\xaa\x91\x36\x76\xdb\x9b\xc9\x2e\x4e\xe6\x2a\x62\xb4\xec\xf2\x15\xaf\x32\x1d\x97\x6d\xa1\x96\x64\x54\xfb\xe3\xd3\x1f\x6d\xa0\x48\x31\x83\x31\xf1\xa0\xf3\xed\x7e\xf6\x09\x2c\x45\xdd\xf2\x10\xba\xda\x31\x01\x84\xef\x18\xb8\x65\x7b\xfb\xdd\x26\x3a\x2b\x0b\xf4\x05\x37\x4e\x6c\x77\x2a\x7f\xcc\xe8\x7f\x7c\x95\x77\x71\xb3\x39\x38\x41\x27\xa4\xb5\x1e\x8b\x4a\x73\x55\x73\xf8\x7d\x03\xb1\x84\xd6\x0a\x45\xe7\x20\x78\xd9\xe0\x10\xb2\x3b\x91\x02\xdd\xf5\xf4\x0e\x3a\x1a\x6d\x85\x24\xb4\x3d\xb2\x44\x9b\xef\xa8\xf5\x83\xa7\xfe\xa2\x49\x92\x82\x4b\x81\x70\xa8\x90\x6f\xcf\x09\xff\xd7\xbe\x4c\xa1\xd6\x53\x3d\xda\xd8\x44\xc5\xbd\xc0\x2c\x37\x38\xd7\xdb\xca\xd2\xed\xfe\x10\x7e\xc9\x07\xc3\xbd\x5e\x9f\xdc\x5a\xa0\x32\x5d\x4e\x01\x62\xfe\x06\xba\x9a\x40\xc8\x8e\x7e\xbe\x54\x9e\xb0\x50\x96\x5f\xbf\x5f\x83\xb5\x40\x16\x49\xe5\xd0\x12\x3a\x2b\x80\x3f\x7f\x80\x1d\xc3\xff\xa3\x31\x58\xc6\xe4\xb2\x2e\xf0\xfe\xc4\x3f\x72\x59\x6d\xe0\x25\x54\xef\x43\xb2\x27\xdb\xc2\x6c\x08\x85\xf9\x08\xdb\x4f\x97\x66\x78\x82\xad\xde\xca\xd3\x77\x43\x9b\x90\xee\xa8\xaa\xfb\x55\x05\x36\x9f\x74\x23\x19\x69\x6f\xf4\xcb\x4d\x70\x41\x92\xe2\x57\xa8\x2c\x88\x66\x75\xcc\x7e\x2a\xb3\x02\xd5\xe4\x7f\xb9\x3e\xc7\x8c\xe7\x8d\x84\x29\x6d\x79\xac\x5a\x2c\x19\xed\xaf\x28\xfb\x92\xa6\x61\x0e\x46\x7a\x2c\xe3\x4c\x00\x0f\x50\xa1\x26\x8a\x16\x0d\x6d\x5f\x34\x58\x5d\x08\xf2\x47\x37\x35\xaa\xb9\x71\x61\xa2\xbe\x03\xc4\xe5\x51\x72\xdd\x8a\x23\x63\x39\x54\xa3\xff\xcf\x67\xab\x42\xe5\xf5\x41\xd6\x85\xeb\x10\x08\xc7\xfb\xd8\xdd\x30\x7b\x25\x92\x9c\x21\x85\x22\x53\x66\x3f\x80\xfd\xe4\xcd\x13\x93\xc8\xaa\x90\xfb\x5f\x3f\xcc\xf5\x2e\x4f\x68\x55\x2b\x18\x56\x33\x76\x2a\x6c\x87\xf3\xb7\xb0\x6c\x2d\x78\xa9\x82\xb9\xce\xb2\xb0\x77\x9e\xfd\xc7\x0d\x45\xf3\xf4\x6b\x4c\x53\x08\x27\xee\x5b\xf7\x08\x57\x0f\x6a\x76\xb8\x37\x75\x65\x47\x80\xca\x52\xb7\x92\x3c\x56\xa2\x6c\x81\x4d\x95\x12\x41\xe1\xe9\x22\xfd\x20\x8e\x79\xd8\xd1\x6c\xfa\x1a\x2f\x31\x06\xaa\xaf\xff\xe2\x5c\x2b\x8c\x4b\x68\x75\x30\xa1\x21\xb1\x7f\xb9\x84\x1c\xaa\x99\xe1\xb1\x2a\x34\xfe\x0e\x84\x16\x03\x41\x71\x6b\xc3\xec\x26\x7f\x0b\xb5\xa5\x90\x66\xe1\x27\x37\xc6\x72\x18\x60\x4e\x33\xb5\xea\x0f\x78\x99\xd4\x9c\xca\x22\xff\xc5\x6c\x86\xe9\x3d\x51\xec\xfb\x64\x6e\x37\xb5\xcd\x62\x6b\x2f\xf8\xb5\x70\x56\x01\xc3\x29\x73\x37\x1b\xa7\x68\xeb\x50\xed\x8f\x14\x48\x5f\x96\x79\x27\xf5\xcb\x6d\x4e\x36\xed\x3b\x77\x99\xff\x73\x24\x96\x89\x85\xd6\x5d\x58\xe9\x43\x72\x57\x39\xf2\x14\x8d\xba\x66\x3a\xa2\x88\xf6\xd7\xc2\x49\xe4\x01\xe8\x6c\x87\x98\x42\x6d\x71\xd8\xcf\xab\x7b\xad\x31\xd8\xcd\x0d\xdd\xd8\xe3\xff\x87\x77\x2b\xbc\xf6\x21\x89\x4b\xc8\xd1\xc8\xa6\xe1\xf8\x71\x5e\x54\x62\x84\xea\xad\xbe\x93\x7d\x01\x0f\x7a\xb8\xd2\x0f\x67\x25\xbf\x09\x19\xd2\x48\xdf\x9a\x54\x69\x29\x02\x43\x38\xd3\x7f\x75\xd6\xa3\x14\x82\x17\xae\x37\x92\x3d\xf3\xd2\xcc\x20\x40\xb1\x8d\xa9\x8e\x86\x6e\xec\xb5\x6b\x8b\x8e\xb6\x84\x84\x1c\xce\xd9\xa2\x48\xd4\x25\xde\x02\x53\x28\xee\xff\xe8\x9a\xda\x9e\xf3\x8e\x87\x24\xc7\xd2\xff\xec\x9f\xb9\x0a\x49\x85\xfd\xe1\xdb\x35\x4c\x56\x20\x4a\x64\x4b\x0b\x8f\x06\xa0\xf8\x36\x7e\x99\xde\x20\x2a\x49\xd0\x67\xf7\xc7\xe0\xc5\xfa\x41\x0d\xe9\x09\xf5\xbd\xff\x87\x03\xea\x6f\x25\x7f\x1d\x9b\x98\xed\x51\xa8\xc2\x94\xed\x79\x5a\xd9\x6c\xb6\x2d\x31\xc5\xc1\x88\xf2\x28\xc4\xbe\x71\x73\xda\xe4\x5a\xca\x12\x7b\x41\x9d\x18\x2c\xa9\xb7\xa9\xf8\x5f\x63\xfc\x07\x9f\xec\x66\x6e\x77\x35\x73\xda\xfe\x93\xea\xe7\x1d\x9d\xe7\x11\x98\x83\x87\x50\x68\x3d\xf3\xa3\x37\x56\x83\xbd\x9c\x45\x4c\x3e\x25\x4d\xd3\xf6\x11\xe6\x74\x6b\x5a\x96\x56\xfc\x7f\xdf\x51\xfa\x3b\x1d\x59\x84\xda\xc8\x79\x78\xc9\x82\xa3\x86\x91\xf3\x74\x32\x97\xb3\xd9\xd4\xf5\x89\x38\x64\x25\x5b\xac\xee\xbd\xf4\x79\xfb\xf7\xd5\xe6\x4b\x7d\xf3\x87\x91\x3d\x33\x57\xbb\xff\x10\x69\x96\x51\x4e\x7f\xc0\x57\x0e\x6b\x78\x3f\xfd\xf9\x89\xd1\x95\x8d\xd2\x9f\x37\x4d\x10\x6c\xa0\x0d\xf2\x2f\x8e\xdd\xf5\x08\xd7\xc9\x81\xbd\xe1\x95\x32\x78\xe7\x5e\xdd\x12\xb8\xec\xde\xf3\xe6\xff\x3e\x45\x65\x7c\x7d\x9f\xb2\x9d\xc6\x9d\xf2\x08\x57\x51\x95\x2c\x4e\xb2\x5d\xe2\x0c\x1f\x4b\x50\xfc\x22\xed\x03\x48\xa8\xa5\xa9\x58\x7d\x75\x4c\x01\x5b\x11\x78\xe2\xee\xfe\x7d\x77\xef\x8f\x2f\xad\x01\xe3\xb9\x96\xaa\x04\xfd\xfb\x60\x04\x32\x31\x43\xbf\xc3\x23\x1c\xad\xb0\xb7\x06\xce\x2c\xd4\xd2\x50\x7b\x9e\x32\xb7\x8d\x6c\xaf\xcb\xe2\xf8\x9b\x9d\x24\xce\x7f\x21\x71\xf7\x9b\x57\x0b\x17\x69\x92\x1c\x7e\xbe\xd2\x8e\xcb\x64\x2e\x0b\xdd\x15\x35\xbf\x9c\x49\xda\x3a\x78\xa4\x19\xd2\x1a\x87\x7d\xfb\x1b\x21\xbe\x77\x60\x73\xb6\x50\xd2\xff\xda\x07\xe7\x68\xcc\xc7\xe3\x1c\x1b\x6b\xec\xf0\x9e\xf9\xb3\x9b\xad\x60\xb5\x49\xfa\x39\xf3\x18\x8d\xb8\xc5\xa3\x6b\xe5\x61\xb1\xdf\x5a\xad\xa8\xfc\xfe\x87\x67\x38\x8c\x12\xe2\xfc\xee\xb0\xca\xa4\x38\x61\xe2\x1a\xb3\xca\x29\x7e\x9e\x76\x49\xcb\x8b\x26\x03\x4d\x25\xcd\xe4\x6e\xf8\xff\x7b\x46\x1f\xf0\x32\x7a\x79\xc5\xc0\x16\xf5\x73\x13\x91\x6b\x34\x3e\xed\xb9\xf5\x0a\xef\x55\x1b\x9b\xdb\xef\xf1\x33\xb9\x2d\x43\xe5\xc7\xde\x04\xfe\xe3\xd0\xac\xa6\x2c\xe7\x40\xe9\x9c\x68\x9b\x69\x3a\xfc\xfc\x64\xc3\x6a\x26\xad\x5f\x5f\xbd\xcc\x3b\x7f\x38\x79\x68\xeb\x35\xe7\x18\xad\xb7\x80\xff\xc9\x1d\xe8\x82\xc6\xe1\x22\xe3\xfd\xe4\x5c\xe6\x0f\x51\x71\x0c\x46\x0a\xa2\x21\x68\x7a\x93\xdd\x9d\xa6\x29\xdd\xb7\xf4\x48\x2b\xe8\x79\xe2\x26\xf2\xff\xf8\xad\xaa\xfe\xcc\x6c\x88\x96\x50\x69\xf8\xb5\xe9\xa0\x72\x0b\xb3\xce\x6f\x18\xb6\xc1\x7f\x3f\x31\x0d\xa2\x60\x6c\x98\x31\x07\xa2\x66\x61\xd8\x9e\xfd\xdf\x75\x9e\x82\x59\xd5\xcb\xdd\xe9\xc6\x94\x91\x7c\x2c\x31\x9d\xe0\xba\xa1\x84\x59\x70\x0b\x4c\xe4\xce\x59\x19\x66\xd4\x06\x86\xf5\x1c\xf3\x1f\x6a\xd3\x47\x83\xd8\x30\x89\xe6\x08\x5b\x8f\x9f\x59\xbb\x33\xcd\x60\x6c\x61\x92\x76\xc1\x4f\x50\xaa\x01\xb2\x56\x48\xed\x93\xd2\xa8\x0d\x5a\xf9\xbf\x87\x3c\xbe\x3d\xc2\xd1\x82\x23\x96\x93\x55\xde\xc8\xba\x68\x86\xb5\xe3\x60\x9a\xcd\xfe\x6d\xbc\x96\xf2\xb2\xa8\xec\x8b\xde\x2a\xb5\xc3\x1a\xb5\xfd\x5f\x8c\x50\x19\xc3\xd3\x92\x7e\x0c\xee\x71\xa7\xcf\xbd\x65\xcc\xde\x56\x95\x9e\x02\xd5\x37\x70\x6a\x72\x8c\x5a\xed\x1e\x42\xb6\xe7\xa5\xff\xd1\xd9\x6c\xff\xca\x39\x91\x5a\x6d\x3f\xbc\x77\xef\xf9\xab\x63\x24\xde\x3e\x87\xce\xac\x4b\xc9\x87\x2d\x0c\x54\x26\x18\xb5\x99\xa3\xdc\x29\xf2\xff\x38\xaa\xc3\xfb\xa1\x20\x12\xa6\x96\xea\x62\xa6\xbb\x99\xe8\x28\x0c\xe1\xd8\xde\x1d\xed\xb0\xb6\xb8\xec\xeb\xcd\x84\x87\x09\x82\xc4\xfc\xbf\xcf\xb9\xaa\x17\xf4\x6e\xbd\x30\x82\xb6\xc4\xda\x8f\xcf\x86\x8a\xcf\xb1\xae\xbf\x70\x7b\xf6\xf9\x24\x10\x1c\x63\x3a\x31\xa1\x2c\xca\x6e\x7b\xf2\xb1\xcf\x46\x0d\x10\xa0\x6c\x8b\x8b\xb4\x37\x72\x45\xb2\xeb\xf5\x3f\xfb\xcb\xaa\xc9\xc1\x6f\x81\x63\x34\x50\xd4\x66\xc5\xe9\x0f\xde\x28\xda\xa5\x8a\xd9\xf4\xe5\xf5\x45\x03\x10\xe7\xb6\x7f\xd7\x5e\x1b\xd8\xf1\xea\x69\x38\x92\x68\x42\xf0\x8e\xa6\x85\x43\x93\x74\x9c\xca\xaa\xff\xab\x07\x71\x6e\xe0\x93\x36\x66\xa9\x49\xfd\x24\x25\x73\xef\x85\x54\xf0\x58\xbb\xe0\x67\xb7\x1a\xd8\x82\x99\x17\x3a\x16\x3a\x58\xc3\x3c\xb3\x2d\x4b\xc9\xfd\x0c\xf4\xa5\xca\x25\x64\x26\xf7\x1a\xe2\xb7\x60\xad\x12\x84\x98\x64\xdc\xed\xff\x2d\x90\x73\x8c\xdf\x4a\x9c\x17\x49\x83\x75\xa9\x56\x4b\x86\xa3\x24\xf6\xda\x1f\x55\x41\x09\x6e\x5e\x9d\x59\xe5\x3f\xe6\x15\x98\xba\x16\x95\xa1\x36\x9a\x1e\x96\x66\x18\xa2\xf7\xb2\x36\xc9\x3a\x93\x16\x14\xef\x4e\x07\x3c\x8a\x6a\x5b\xbc\x56\x5b\xce\x61\x97\xe4\x4a\xb0\xc2\xc5\xea\x0e\xa8\xe2\x18\x8f\x77\xdc\xdb\x11\x56\x99\x19\x13\xd0\x9a\x54\xb6\xf9\xcf\xef\x51\x78\x03\x2a\x26\x97\xaa\x4a\x10\xf4\x50\xf7\xfb\x54\xe2\x63\xbb\xfe\x41\x4c\xa9\x8f\x5c\x35\x2f\xe5\x46\x81\xa9\x46\xd4\x54\x5f\x6b\x1c\x64\x85\x43\xef\x7c\x3d\x7f\xf7\x3e\xfd\xd8\xbc\xfc\x18\xe6\xe3\x8d\x9f\xb2\x7a\xd9\xfb\xdc\xa2\x63\xd1\xeb\xd9\xc3\x92\x32\x4f\x70\xa9\x5f\x9d\x4e\x07\xe8\xc0\x0f\x20\x0d\xa6\xdd\x69\xd7\x75\x4f\xf8\x63\x8b\x27\xf2\xe3\x82\xff\x98\x31\xf3\x86\x6e\x16\xf4\xcf\x50\xf6\xd3\x8c\x6b\xe6\x82\x9c\xb2\xd2\x23\xdc\xf1\xcb\x2e\x11\xb5\xf0\x85\x04\xba\xdd\x37\xe7\x2f\xef\xbe\x6b\x9d\x8a\x54\x97\x92\x77\x24\x92\x14\x6b\x1e\x53\x5f\xaa\x01\x31\x45\x26\x98\x9e\xb1\xa7\x86\xd9\xe4\x18\xab\x97\xfe\xd7\x6e\xb9\x3e\x68\xd7\xb0\x1b\x9c\xa0\xde\x9b\xbd\xfc\x8d\xb8\x6e\x1c\x5a\x6e\x14\xf3\xd8\x65\xbf\x4f\x41\x4c\x3f\x18\xb7\x02\x90\xd6\x82\x16\x8c\x99\xae\x96\x7d\x9c\x39\xc5\xc0\x69\xc2\x0e\x89\xfa\x96\xd2\x58\x95\x4c\x8d\xa3\x45\x81\xe3\x3f\x85\xff\xaf\x89\xbe\x7e\xfa\xba\xec\xc8\x29\x9c\xa6\x23\xd9\xe7\xae\x5c\x44\x96\x6f\xe6\xf7\xd9\x6f\x29\x97\x52\xc7\x1f\xb3\x24\x09\x6d\x79\x4b\xf4\x03\x88\x30\x4a\xef\xf4\xf1\xe7\x3e\x3f\x21\xa4\x09\x2c\x1e\x53\xd8\x2c\xf4\x96\xc8\x0e\xf0\xd3\x69\xd3\xff\xf2\xdd\x5a\x72\x3e\x29\x81\xa2\xf6\x19\x1e\x41\x09\xb3\x2d\xc0\x5f\xb8\x9c\x1a\xa5\x9f\xbc\x6c\x93\x21\x5a\x71\x73\xde\x81\xd4\xca\xd3\xec\x87\xbe\x4e\x2a\xaa\xa1\xbf\x24\x35\x09\x3e\x26\x96\x4f\x19\x67\x29\x17\x26\x22\x5a\x6a\xcc\xba\x11\x87\xc5\x73\xb5\x04\x12\xc6\xff\xe5\x14\xc1\xc8\xf7\xad\xac\x08\x4a\x8c\xed\xef\xb7\xbd\xf9\x1a\x81\x13\xba\xd2\xfe\x2a\x3a\x85\x5c\xa0\xe6\x57\xa2\xaa\x55\x2f\xa0\x8c\x83\x3d\x7d\x98\xfd\xe8\x22\x7a\x83\xdb\xc9\x72\xf3\x99\xeb\x4e\xc3\x18\x14\x65\x3c\x29\xc4\x3c\x7b\x6f\xaa\x79\xca\xee\xb0\x46\xa3\xff\x6f\x8c\xe1\xfe\xf8\x65\x17\x64\xbc\x65\xf8\x70\x8f\x13\xed\x64\x8f\x56\x21\xf6\xa5\x04\x8d\xd3\xb1\x65\x70\x11\x7b\x0a\x0a\x59\x1e\x46\x36\x44\x88\x19\x35\x27\xaf\xb4\xdf\x12\x71\x0a\x5a\xbb\x27\xbf\x79\x5c\xf6\x61\x4a\x86\x99\x5a\x09\xba\x6e\xaa\x9c\x17\x0b\xee\xfd\x71\x0a\x83\x38\xfd\x27\xdd\x7f\x04\x1b\x04\xd1\x64\x19\x0f\x70\x11\x87\x70\x88\x16\xbf\x8c\xb8\x6c\x1c\x94\xf1\x4a\x61\x59\x05\x08\xb1\xe3\xbc\x08\x29\x2f\x6d\x39\x89\x1d\x86\x91\xd8\xc4\x9d\xa1\x19\x25\x8f\xcb\x06\x14\x67\xa7\xdf\x7c\xe2\x7d\x8d\xcd\xae\x50\x9b\x61\x09\x2d\xe2\x10\x2d\xba\x40\x55\x2b\xdd\x35\x9f\xcf\x71\xf9\x35\x76\xa0\xfc\x81\x85\x20\xfd\x85\x7d\xf9\x26\x90\xb5\x63\xef\x19\xbc\xac\xc5\x5a\x02\x12\x39\x1a\xab\x28\x85\xec\x42\x58\x66\x78\xd2\xdc\x36\x10\x3e\x3b\x46\xf3\x97\xdb\xb2\x4c\xb9\xb6\xc7\x1a\x4e\x00\x54\x69\x47\x44\x56\x11\x7c\xb8\x4a\x6e\x17\xe3\xa7\xbe\xea\x3f\xc9\x87\x27\x0e\x42\x99\x9d\x3d\x53\x06\x6d\x8c\x71\x1a\x81\x6f\xe9\x71\x87\x90\xc9\xcf\x70\xfa\x08\xc7\x03\x3d\x5e\x2a\xe7\x8f\x2a\x3c\xb1\x55\xe7\xf6\x30\x03\x0a\xb2\xff\x5d\x3f\x12\x74\x5c\x76\x14\x1e\x49\x97\x25\xb3\x9b\x0c\x1a\xbb\x5e\xf8\x32\xdf\x3c\x10\x00\xdb\xc6\xb8\xce\x0f\x3e\x99\xd8\x15\x6e\x09\x35\xd5\x12\xb8\xd1\x99\xa0\xf0\xcf\xb1\xac\xd1\x71\xfe\x26\xb2\xa5\xf2\xe2\xba\x6c\x42\x2a\x68\x1a\x77\xf1\x4d\xcd\x1d\x86\xa9\xd9\x06\xec\x43\x3d\x78\x65\x35\x50\x12\x40\x0c\xd7\xc3\x7c\x70\xc5\xcd\xb7\x21\xdf\xe8\xdc\x65\x59\x41\x6b\x8b\x94\xdf\x0f\xb4\xa3\xe2\x9a\x62\x05\xe1\xe6\x25\x03\x19\x7d\x06\x15\xac\xf2\x53\xfa\xfe\xb5\x69\x90\xc5\x2e\x57\xe5\x99\x62\xed\x9b\x1b\x16\xea\x62\x7d\xad\x46\xed\xfe\x32\x26\x56\x41\x32\xa2\xb0\x10\x80\x04\xd2\x14\xce\xe8\x5c\xad\x67\x76\xfe\xb3\x57\x78\xdb\x2f\x7a\xfa\xd3\x53\xa5\xe3\x77\xae\x0e\xda\xcd\x9f\xdf\x78\x88\xc2\xf9\x14\xe7\xb6\xe5\x14\x7d\x25\xab\x92\x1c\xf1\xae\x45\xf1\xcb\x26\xd1\x92\x1c\x37\xb2\xd8\xbb\xf1\x4e\xff\x7c\x77\xd2\xd4\xa3\x4d\x03\xac\xe7\xab\x79\x37\xdd\x72\xd9\x87\x4c\xba\x9b\xec\x1f\x1e\xe0\xe8\x33\x80\xfe\x36\x18\x73\xf2\xca\x59\x07\x81\x61\x78\x25\x61\x1e\x73\x20\xac\x9d\x8c\xb9\x5b\xb7\x6c\xa3\xc0\x99\x7e\xcc\xb0\xee\xeb\x98\xb1\xff\xe4\x97\x24\x33\xa4\x18\xa9\x1c\x45\x0d\xac\x9b\x2c\x5c\x6a\xd5\x8d\xb3\xdc\x77\xcc\xc5\xba\x95\x41\xd4\x52\x70\xbd\x4c\x3a\x0e\x8b\x79\x8a\x7b\x8d\x5e\x3e\x8b\x20\xf0\xea\xe0\x4f\x7c\x21\xaa\x0b\xf1\x7c\xc2\x53\x8d\xd2\x90\xe6\x1e\x73\xa4\x94\xff\x9b\x07\x12\xb8\x76\x5d\xd7\xb5\xba\xd0\x04\x23\x44\x87\xd3\x84\xbf\xf1\x37\x1c\xf0\x48\x1b\xcd\xf4\x91\x44\xe5\x02\x84\xe5\x5d\xc6\x6a\x02\xb0\xe1\xd8\xa1\x31\x00\x68\x3d\x93\x96\xf7\xa1\xd3\x25\x66\x39\xa1\x5c\xca\xcd\xc4\xe1\x63\x87\x17\x11\xf1\xcb\x12\xc7\x0c\xda\xce\x46\xd3\x87\x0b\xf4\x78\x6b\x35\xe6\x26\xd9\x4a\x22\x28\xea\x21\xe9\x52\xd6\xfe\xcd\x2f\xb3\x2d\xfc\x95\xc9\xbb\xfe\x83\xb3\xbf\x78\xe3\x28\x8a\xe0\xc4\xdd\xda\x7a\x39\x4f\xfc\x25\x21\x6c\x5a\x4b\x71\x4a\x5d\xca\xfb\x58\x52\xee\xa9\xba\x51\x00\xbe\x8a\x57\x2a\x54\xbd\x42\xe0\x86\xfd\x8e\xef\x2d\x78\x03\xe2\x7d\x65\x27\x8b\x83\x53\xf3\x5b\xbd\xe7\x2d\xd0\x1f\x12\x46\x43\x30\x0f\x21\x08\x09\xe7\x80\x9e\x34\x3a\x31\xd8\xa4\x6d\xf5\xa1\x7b\x79\xb8\x1e\x81\xe4\x82\x13\xcc\x6b\x7f\x78\x69\x27\x24\x65\x30\xbb\xcf\xf7\xcc\x58\xf1\x87\x4b\x3d\xff\xf2\x3b\x47\xd9\xa2\x2d\x84\x49\xd2\xac\x9f\xe7\xcf\x72\x74\x5d\x68\x59\x71\x30\x06\xa7\xb1\xf1\x32\x97\xf5\x23\xdb\x72\xc2\x80\x11\x05\x92\xe4\x6d\xfb\xe6\x82\x9b\xe3\x2d\xa6\xe4\x45\x39\xb6\x12\x2d\x49\xb4\x44\x97\x03\x19\xf1\x4e\xaf\x1d\x98\xa8\x89\xb3\x31\xbc\xb0\xa1\xe2\x95\x16\x85\xd8\x35\x55\xc8\xcc\x03\xec\x38\x69\x64\x84\x33\x6d\xa4\xbb\x1e\xa3\x26\xc5\x2c\x3b\xb4\x65\xd8\xde\xfe\x37\xc7\xbd\x44\xdb\xda\x33\xfd\xdf\x33\xd9\x6f\x5f\x37\x3c\xee\x6e\x64\xdc\x0f\xb3\x7d\xbc\x5d\x35\x38\xb4\x63\x5a\x29\x70\x20\x90\x0f\x28\x7b\x0a\x9c\x8a\xaf\x44\x69\x93\x0c\x08\xd4\xd1\x9a\x40\x17\xe4\xd4\x56\x23\x35\x1d\x22\x78\xb1\xd4\xaf\xaf\xc1\x9e\xaf\x0b\xe9\xf8\xfd\x68\xf9\xee\x14\xfa\x7b\xbb\xe9\xa5\x06\xa7\xd5\x8b\xd8\xf6\xc4\x9a\xe2\x8f\x9e\xff\xe7\xbb\x79\x95\x28\xbf\xdd\x96\xbb\x5b\xb1\xfe\xe0\x22\x9b\x2e\xbf\x97\x0a\x15\x6c\xc0\x19\xe3\xfe\x83\x05\xfb\x37\xf1\xf1\xe2\x4a\x33\x36\x59\xfe\x96\x15\x67\xe8\xe2\x0a\x27\x59\xf9\x17\x40\xb4\x9e\x5d\xaa\xf0\x07\x1b\x22\xe5\x1a\xe9\xa8\x25\xa7\x02\xa2\x49\x17\x49\x47\x28\x93\x19\x8a\x24\x16\x34\xf2\x9e\x11\x0a\x0f\xd9\xeb\x26\x6b\x0d\x22\x6f\xe1\xed\xaa\x77\x04\xb1\x7d\x94\x1e\xc5\xa5\x09\x26\xe4\xd7\x92\x1c\xf1\xdf\x7c\x48\x8f\xd3\xed\x93\xbc\xe3\x5e\xd6\xfd\x9f\x58\xee\xf1\x86\x65\x48\x5d\xe0\xe5\x65\x63\x2f\xb4\x9d\x92\xbf\xcc\xa8\xa4\x81\x26\xa6\x00\x9a\xd6\x53\x5e\x38\x46\xa1\xab\xb8\xa2\x95\xb9\xe7\x0c\xb5\x37\x75\x45\xcd\x2c\x5c\x83\x48\x3f\x4d\x25\x7c\xff\x31\xb3\x54\x53\xbd\xda\x64\x57\xe9\xfa\xa6\x51\x1f\x9a\xdb\xcf\xf0\xf7\x0e\x42\x10\x96\xbc\x91\xd2\x42\x05\x48\xaf\x6b\x45\xe0\x2e\x19\x0e\xbb\x8f\x31\xa7\xdc\x7f\x48\x3c\xeb\x61\x08\xfe\x2a\x77\xcd\x30\x2a\x27\xbe\xfb\xa1\x05\x16\x51\xf3\x18\x2c\x61\x54\xa7\x7f\x85\x1b\x2f\x30\x33\x17\xa2\x90\xfe\xe1\x84\xc4\x80\x9c\x4e\xbc\x28\x66\x97\xc0\xae\x57\x20\x59\xba\x9d\xa4\x4f\x2d\xd6\x62\xab\x9e\x69\x42\x57\x7e\x89\xd4\x3d\x54\x47\xc1\x07\xb8\xfd\xc3\xc1\x5f\x26\x84\x55\x19\xe5\xcb\xe3\x77\xa3\x94\xdc\xb5\x32\xdd\x1f\x83\x21\xbd\xb3\xe9\xed\x22\x54\xf1\x4f\x91\xf7\xd6\x16\xa6\x99\x34\xea\x3f\x90\xc3\xaa\xae\x20\x06\x7f\xf4\x8c\x27\x60\x33\xac\xfc\xf9\x2f\xcb\x98\x17\xce\x2c\x59\xf7\x05\x16\x86\xb4\xfd\x3a\xbc\x53\xd5\xa7\x55\xba\x1d\x62\x7f\x40\x6e\x28\x3a\xad\x74\x5e\x07\x0e\xc3\x7d\x89\x1c\xbf\x90\xbc\x5c\xd4\xa5\xe1\xce\x48\xe7\x45\x0b\xc5\x95\x57\x85\x71\x7b\x0a\xdd\x17\x50\x05\x36\x39\x07\x62\x1a\xc2\x3e\x68\xb4\x83\xa2\x2e\x20\x7a\xaf\x94\x55\x75\x9f\xb4\x88\x49\x8b\x9e\x5b\x60\x5b\x38\xd6\x6e\xf9\xb3\xde\x3d\x32\x25\xc5\x21\x1c\x37\xa4\x40\x81\xf9\x63\x3b\xdc\x12\x78\xeb\x53\x30\x18\xc3\xe8\x6a\x10\x44\x12\x6b\x6f\x16\x11\x18\xbf\xa7\x0a\xf8\xe5\x93\x4b\x25\x9e\xe1\x2c\x1b\xda\xc8\x74\x27\x9b\x10\xba\x85\x02\xcf\x1f\x2a\x23\xd7\x52\xd9\x78\x65\x40\x87\x5e\xc8\xe8\x2b\x79\x3b\x14\x35\x0a\xa1\x93\xcb\x5f\x52\x70\xc8\x05\x5a\xa0\x39\xaa\x6a\xf5\x02\xf2\x24\x18\xc0\xa7\x09\x8d\x9e\xb9\xee\x07\x1b\x8b\x67\xdf\x7b\xf9\x12\x30\xe7\xf0\x04\x18\x85\x0b\x6d\xf7\xd6\x97\x4e\x21\x77\xbc\xde\x4c\x0d\xfd\xeb\xe7\xb2\xfe\x1a\x13\x32\x48\x7f\x7b\x15\xf3\xd2\xe4\x53\x91\x13\x32\xab\xc8\xc4\x16\x68\x3c\xe5\x61\x5e\x7a\xd2\xc9\xe8\x1b\x9c\xc8\x81\xee\xd4\x34\xd2\x84\xa7\xa4\x7f\x7d\xdd\x24\xa1\x56\x21\x9e\x28\x7b\x54\x4b\x3e\xda\x3d\x9c\x95\x3a\x3a\xae\xcc\xea\x36\x81\xa4\x63\x35\x0f\xbf\xbe\x28\x27\xd8\xda\x86\x7c\x98\x15\xac\x24\x1c\x2f\x7d\x07\x47\x63\x7d\x0b\x94\x1e\x1c\xba\x61\x4e\x5f\x4a\x5b\xee\x7a\x8e\x45\x64\x45\xdb\xff\xd1\xa4\x9e\x41\x28\x82\x67\xf6\x46\x33\xb5\x7f\x82\x00\xe2\xf5\xe8\x83\x13\x0b\xe9\xb5\xb2\xa7\x89\x8e\xa5\xa2\x16\x69\x4c\x7e\xe0\xba\x83\x51\xe5\xc7\x8f\x46\xfb\xe2\xba\x68\x26\xd2\x98\xe4\x18\xb4\x25\xd4\x26\xd8\x4b\x1b\x94\x76\xd7\x21\xc8\x3a\xb5\x6e\xdb\x3d\x98\xfa\x78\x41\x38\xc5\xf4\x48\xa9\x6b\xad\xf9\xed\xb4\x6c\x13\xfb\x32\xbd\x07\x67\x1d\x02\x4b\x45\xd3\xa9\x55\x2a\x0b\x49\x82\x75\x13\xf9\x63\x97\x0c\x16\xaa\x91\xe9\x2c\x6d\x99\x1f\xbc\xa2\xd4\x85\x35\xb5\xa6\x71\x59\x33\x90\x27\xaa\x0f\xa0\xaa\x4b\x3e\x30\xfa\x60\x67\x93\x03\x69\x3a\x8a\xf2\xdd\x29\x9d\x37\xf2\xfd\xa5\x07\xc1\xc2\x3a\xa2\xe6\xcf\xd9\xeb\xba\xff\xd8\xf2\xb4\xc1\x27\xa8\xc5\xdf\x68\x1d\xc9\x3e\x87\xcc\xcc\x2d\x1f\x6e\xaa\x70\x11\x63\xa3\xe9\xf6\xe6\xaf\x44\xf7\x59\xb4\x56\x5a\xc5\xb5\x53\xf7\xb0\xb5\xe0\x4b\x94\x65\x54\x5a\xa0\x2d\x35\x43\xe2\x74\xa2\xe0\x95\x2c\x49\xa4\xc9\xed\xe6\x9c\x96\x38\x0f\xa5\x26\xe2\x02\x6c\x3c\x9e\xf3\xa2\x69\x5b\x50\x7c\x35\x15\x9e\xcc\x1a\x74\x2d\x4f\x13\x65\x08\xf5\xe5\x33\x80\x6b\x25\x28\xbb\xa1\xec\xb4\x00\xeb\x86\x96\x97\x22\x8b\x7a\xd5\x9e\x8d\x1a\x79\x19\x90\x5f\x18\x8a\x05\x7f\x57\x0c\x2f\xee\x5e\xa2\xfe\xef\x8f\x35\x25\x9d\xe4\xe8\x29\x7d\x8e\x68\xf4\xfc\x0c\x87\xc5\x58\x11\x7e\x69\xee\x2f\x56\xea\x5a\xe4\xaf\xf9\xa6\x79\x3d\x10\x84\x51\xf1\xf3\xe8\x7c\x0d\x99\x40\xe4\xdb\x2f\xd7\x21\xc5\x4b\x15\x64\x25\x43\x5b\x85\x5e\x0b\x83\x88\x72\x3d\x05\x6c\xdb\x01\xfe\x08\x57\xd3\x5b\x07\x03\xd9\x48\x15\x8f\x35\x3a\x3e\xab\xa7\x14\xac\xa6\xd7\xce\x66\xce\xf9\xa5\x93\x81\xdf\x95\x84\xa6\xa4\x24\xcf\x08\xbd\xca\x4e\x78\x73\x68\xcc\x5c\x8a\xe2\x47\xec\x67\xe3\xcb\x95\x86\x0c\xe5\x1e\x23\xf6\x72\xfb\x39\x6c\xbf\x4a\xcf\x5c\x8a\x13\x4d\x7f\x24\xf6\x80\xc5\x11\xff\xcc\xa8\xf5\x65\x16\x2e\x9f\x94\x19\xff\xa0\xa7\xf3\xe8\x7f\xdb\x2d\x97\x39\x23\xff\xb5\xa1\xa8\x3c\xa9\x3d\xb3\xbe\xad\x58\x93\x60\x9d\x8c\xef\x74\xb1\x46\x20\xa6\xd0\xdb\xc8\xa3\xf6\xf7\x89\xf4\xcc\x54\x6e\xfd\x4b\xa2\xa3\xf6\x0d\x5e\xfd\x40\x0b\x7b\x2a\xbe\x1c\x7f\x7e\x14\x38\xd1\xbf\xa9\xfa\x60\x78\x47\x54\x74\x12\x16\xf7\x99\xd6\x1f\x94\xee\x04\x5c\x2c\xaf\x71\xc1\x08\xa9\xa3\xbd\x24\xff\x94\x1f\x65\x09\x3c\x47\x16\x2d\x80\x81\xe9\xef\x47\x9f\x74\xf6\x14\x9c\xe1\x7a\x64\x01\xc0\xef\x19\xbe\x0a\x73\xb3\xac\xa0\x80\x34\x30\x49\xf4\x9d\x30\x7f\x39\x69\xb1\xe8\x6a\x2a\xd9\x72\x67\xac\x25\x4c\x1c\xde\xea\xac\xef\x75\x71\xcf\x17\x6f\xe7\x79\x19\x52\xfd\x91\xb8\x0a\x61\x49\xd3\x49\x69\xa7\x8a\xe0\x41\x46\x70\xf9\xed\x44\x72\x60\xc1\x83\x4a\xe7\x86\x1e\xf5\x1f\x02\xec\xbc\x2b\x40\x0a\x3d\xf2\x2d\x5a\x1b\x72\xb5\x36\x89\x63\xa0\xac\xf9\x92\x5d\x2f\x46\x81\xb2\xcb\xd0\xbd\x0a\xc4\x12\xf3\xd8\x0b\x44\xd0\xa9\x2c\x4f\x04\xb6\x12\xbb\x3e\xbb\x6d\x9b\xf3\x2d\xb5\x49\xca\x82\xd5\x2c\x23\x08\xae\xe5\xef\xe4\x15\x83\x2f\xed\xae\x26\xab\xfd\x95\xa4\x67\x38\xa4\x41\xc6\xdb\xcb\x5e\x6a\x41\xd6\x39\x3f\x35\x4f\x94\x3a\xe4\xd6\x21\xec\xae\xc4\xc4\x81\xfe\xf8\xe9\xfb\x87\xdc\x2f\x1d\x26\xea\x0f\xf9\xcb\x8f\x58\x6d\xd0\xf7\x13\x2d\x9f\x62\x9d\xa3\x4c\xd8\xf1\x3f\x6b\x0b\x8c\x56\xe7\xea\x2b\x21\xa5\x26\xb2\x62\xb5\x2f\x33\x27\xd4\x03\x26\xfa\xa3\x85\x14\x45\x5e\xb3\xf1\xac\x4a\xfb\x8b\x41\x8f\x0e\x6b\x4a\x3a\x22\x1d\xcb\x1d\xc7\x52\x2c\x19\xcc\x4f\xb3\x02\xa8\xaa\xe4\xea\x5a\x13\x98\x9a\x61\xf6\x86\x55\x05\xd5\x98\xfc\x96\x57\x79\x0e\x90\x57\xda\x5e\xfe\x68\x9a\x4e\x06\x52\x07\xf1\xbe\xa8\xce\xd5\xa6\x18\x76\xef\x08\x89\x78\x5e\x5b\x9d\x4d\x3f\xdc\x04\x9b\x5c\xef\x4a\x38\xe7\x3f\x50\x66\x50\xe8\xe8\x7a\xfa\xba\xc0\x11\x6e\xe2\x35\x74\x96\x98\x49\x1a\xc4\x6f\xf4\x2e\xb8\x47\xed\xbd\xf7\xd7\x6e\x07\x5a\x5a\xe1\xf2\xf2\xe7\xc0\x6d\xce\x57\xdc\x94\xee\x36\x7a\xc8\xa6\x49\xfa\x18\x14\x17\x55\x12\x71\x06\xe5\x1d\x95\x79\x76\x77\x58\x45\xd3\xa0\xc3\xf6\xcd\x82\x26\x00\xb2\xfa\xfd\xf0\x58\xc1\xe7\x5a\x60\x18\x73\x92\x7e\x7c\x12\x8a\x4f\x38\x52\xcc\x0e\x49\x09\x62\x5d\xe2\x6d\x14\x1c\x90\xfa\xfc\xcf\x71\x1d\x73\xcf\x87\x23\xa5\xc7\x1c\x92\x2c\xb7\xc2\xa1\x81\x9f\x43\x8e\x5e\x11\x31\xa9\x68\xb2\x44\x4f\x12\x2e\xa7\xe3\x5a\xb6\x9f\xb3\xe3\x7e\xa7\xf3\xc1\xfe\x3e\x49\x24\xb6\x75\xd0\xd6\x0f\xd0\xa5\xc6\xd8\x69\xc6\x92\x32\x75\x53\x22\x32\xc2\xbc\xbe\x56\x3b\x86\x6c\x1f\x20\x69\x95\x8a\x23\xa1\xfe\xc2\x2a\xed\x18\xac\xd3\x09\x14\x23\xad\xc1\xd9\x51\x59\x5c\xc3\x12\x85\x31\xa5\x5b\x8a\x52\x18\xcb\x30\x3c\x21\xc9\x15\x10\xc9\xa3\xa4\x1f\x04\x14\xb5\x30\x72\xde\x30\x8f\xf2\xf9\x1e\x7f\x15\x4f\xb2\xc0\x39\x68\xc0\x7b\x40\xc1\x70\xc4\x10\x10\x23\xc0\xef\x8b\xed\x17\xa8\xbe\x13\x5f\x7e\xe5\x88\xae\x85\x7e\xc6\x15\xa1\x39\x79\x4a\x0c\x4d\xa6\xaf\xf7\xad\x0d\xd1\xfa\xe9\x2f\xec\x4f\x6f\x3e\x99\x5f\xfe\xf1\x5a\x6f\xc6\x57\x9e\x38\x8c\x22\x88\xd1\x06\xbf\xaa\x62\xf8\x7b\x7b\x9b\x55\x28\x86\xe1\x90\x66\x91\xd2\x38\xcc\x15\xbb\x04\x98\x40\x28\x1a\xfb\x9d\xef\xe7\x6a\x7d\xf0\x0e\x24\xf3\x2b\x6e\x4c\xfe\x02\x81\x64\x15\x13\x15\xfa\xe4\x06\x2b\xd9\x49\x22\x1d\x1b\x41\xcd\xa1\xf6\xb0\x09\xc3\x70\x8e\xa5\x0e\x86\x39\x92\x79\x19\x5f\x08\xb9\x9f\xbd\x08\x45\x5e\x2a\x11\x56\x9c\x81\x71\x82\x7e\xe4\x8c\x4c\xd9\x8f\xcf\xe0\x43\x7d\xad\x6b\x30\x9a\x01\xda\x9b\x98\xfd\xa7\x4e\x05\x88\x5f\xe0\x35\x10\x84\x9e\x32\x11\x3b\x6e\x52\x3c\x15\x2c\xa4\x4c\xb0\x44\x55\xc9\x3d\xe8\xf5\xf0\x78\x8b\x48\xa2\x56\x93\x0a\xa0\x1a\xcd\x2e\xf8\xbe\x22\xf2\x22\x25\x4d\x5b\x55\xa0\xd2\xae\xe2\x64\x5d\xf1\x29\xab\xe7\xda\x3f\x7e\x2f\x09\x96\xea\xee\x71\xa7\x7e\x28\xf8\x70\x96\xc2\xe4\x66\x5a\xc1\x0c\xf1\xb7\xef\xcb\xa3\x8f\x05\x46\x95\xd6\x3f\x79\x74\x76\x99\x7f\xed\xbc\xeb\x19\xa2\x4f\x1b\xfe\x0b\xd7\x2e\x2b\x56\x77\xc0\xcb\x25\x83\x45\x31\xf1\xb3\xd4\xb6\x90\x32\x10\x5d\x5f\x19\x0e\x6d\xc5\x45\x61\xa7\xcf\x5d\x85\xc0\x90\x36\xf1\xfd\x27\xeb\x3a\x5e\xa9\xac\x38\x1b\x7a\x3d\xb0\x15\x89\x2d\xe7\x2e\x39\xaf\x34\x8c\x91\x9b\x7e\x3e\x15\x1c\x58\x3c\x60\xa7\x86\x98\xf6\x84\x90\xe6\xc8\x16\x30\x3a\x0d\x5d\xc8\xcd\x03\xdb\x94\xaf\x6c\xad\x1b\xc3\x79\xc2\x07\x14\x5b\x36\x2e\x9c\x6c\x69\x0f\x7e\x5a\x76\xf0\x83\x8a\x7f\x52\x62\xda\xe8\xab\xa4\x62\xa8\xe3\x7d\x73\x87\xf7\x3e\x9d\x4a\xe5\x32\x1c\x45\xd2\x11\xae\x57\x98\x6d\xa1\x40\xf7\x48\x13\x58\x0d\x74\x1e\xc4\xaf\x6e\xf9\xe0\x84\x5f\x9e\xb6\x96\xfd\x27\xcd\x1a\xcb\x68\xb4\x89\x8c\x19\x79\xa2\x5f\xeb\xb7\x93\x42\x5c\x62\xbf\x94\xbf\xfe\xe9\x87\xcc\x78\xc6\x00\xed\x53\xfd\x98\x25\x47\xc6\x72\x85\x97\x33\xe4\x98\x9d\x86\x6f\xe0\x02\x2d\x2f\x20\xae\x4d\xec\xdf\x6b\x20\x56\x90\x66\xb8\xdc\xbe\x67\x7e\x2c\x1a\x2e\x9b\xa0\x5d\xf5\xf6\x9e\xbf\x23\x7d\x89\xd3\x76\x89\x2b\x50\x4e\xf5\x6e\x39\x55\x18\xc9\x87\x4d\x60\x84\x22\x76\x88\x85\xb4\xbc\x8a\xe1\xad\x16\x01\x49\x4f\xc9\x2b\x2a\x03\x8c\x64\x93\x4f\xf5\x02\xa9\x15\x35\x2b\xc7\xbd\x69\xb9\xc5\x6f\x7a\xb3\xe5\xb0\x89\xb0\x60\x02\x33\x8e\x35\x55\xb9\x47\xe6\x6f\x5d\x1a\xc7\xf1\xba\x4a\x31\x4a\x76\x26\x97\xd8\xb2\xff\xa6\x09\x95\x3e\x33\x34\xde\x4a\x3b\x6b\x24\x41\x74\xa5\xaf\x95\x67\xc6\x12\xc3\x8f\xc8\xd3\x60\x7b\x73\x08\xd4\x7d\xcf\xf0\xf1\x2c\x2a\x7b\x37\x63\xc0\x9e\xc1\x87\x80\x0a\x36\x7f\x53\x59\x35\xe0\x40\x5d\x67\x16\x19\xd8\xcc\xbd\xd1\x1f\x6e\xc7\x32\x13\x6f\x31\xde\x1a\xac\x7a\xbb\x18\x04\xd6\x9a\x72\x07\x6b\x0b\x1d\xb6\x1a\x23\x28\x7f\x74\x80\xd0\x7b\x1b\xe4\x31\x0a\xe5\x1f\x00\x28\x77\xc3\x00\x53\xed\xff\xf5\xc8\xce\x73\x29\x78\xcb\x31\x89\xdf\x54\x85\x46\xe2\x4b\x83\xc7\x6c\x56\xd7\x5d\xe9\x30\xeb\x82\x44\xdb\xbc\x8f\xdc\xe1\xce\xa8\xb2\xb4\x2d\xc9\x4b\xc6\x40\x19\x41\xeb\x57\x74\xae\xe8\xfc\xa1\xcb\xeb\x0e\xed\x11\xd6\x93\xc5\x78\x93\x1c\xe5\x4b\x32\xb4\xd0\xaa\x9b\x5c\x8f\x23\x34\x38\xd3\xc2\x5d\x1e\x8e\xc2\x73\x2a\xc3\xda\x7a\xc7\x30\x43\x63\x2c\x47\xa8\x2d\x4e\x20\x1a\x92\xf7\x04\xfb\xff\x0d\x8c\x53\x20\x6b\x84\x70\x8c\x2a\x7a\x98\x24\x7d\xd3\x82\x20\x7c\xac\xe0\xf6\xaa\x02\xaa\x6b\x5e\xd5\x1c\xd1\x78\xd4\xe6\xf6\x1b\xaf\x31\x16\x46\xfa\x39\xaf\x8f\x66\x78\x91\x0b\x62\x6d\x08\xbc\x29\xc3\xb7\xd1\xef\x74\x59\x08\x5c\x7f\xfa\xe4\x30\xd5\xc7\x05\xce\x66\x69\x20\x90\xc3\x59\x2c\x11\x92\x29\x03\x26\x25\x4f\x60\x3c\x95\xff\x1e\x1c\xb1\x15\xc3\xbd\x30\x2c\x31\xe5\x10\xbb\xfe\xcf\x46\x04\xcf\x94\x06\x2d\x76\x9d\xa1\x14\x9d\x41\xcc\x43\xad\x93\xa0\x65\x48\x3c\x7f\x14\xd3\xcf\xc9\x22\x35\x33\xaf\xb6\xf4\xc4\x33\xc7\xb6\x55\x7a\x34\x97\x51\xe8\x69\xa4\xfc\x5c\x0f\xaf\x54\x58\xbb\xa4\x12\x93\xe9\x37\x5a\xb4\x3e\x43\xcb\xef\x7b\x30\xd2\x25\x42\x36\x65\x4b\xe6\xf7\x2a\x14\xaf\x1a\xeb\x30\xc0\x88\xa4\x18\xde\xb5\x10\xc5\xf4\x24\x73\xd8\x32\xff\xdc\xdf\xd0\xea\x8c\x14\xb1\x56\x5d\x9a\x03\xfd\x71\xbf\x79\xd7\xb8\xc3\x6c\xc2\x96\x11\xd5\xbe\x4b\x37\x14\x77\xd0\x07\x15\x26\x9f\x0e\xa1\x42\xf6\xa1\x33\x04\x36\x38\x6b\x03\xaf\x76\x8e\x00\xe9\xa1\xf1\xd4\x5a\x67\x0e\x39\xa5\x82\x8b\x23\x9c\x0f\x05\xca\x82\x24\xaf\x99\xb9\x15\xfe\x70\xb6\x39\x27\x00\x0a\x1a\x9d\x57\x71\xc8\x27\x82\xb3\x47\xfc\xb7\xc5\x4f\x2d\x62\xeb\x10\x6d\x5c\x29\x0c\xd5\x26\x0c\x6e\x28\xe2\xe5\x6f\x24\x2c\x39\x3f\x5e\x03\x3f\xac\x05\x0e\x56\x80\x48\x05\x2b\x21\xb7\x9c\xa2\x53\x03\x18\x9d\xc4\xd3\x87\x97\x41\x3d\x82\x04\xb3\xf4\x2d\x27\x3f\x45\x8c\xdb\xd1\x67\x80\x18\x4d\x81\x61\xfa\x56\xd0\x1a\xc0\x98\x8a\xe2\x35\x2c\x2a\x3b\xb7\x95\x3e\xe1\xec\xa9\xda\x7f\x73\xe4\x74\xfb\xe7\xc7\xe1\x1f\x0e\x1d\x37\x3a\x04\x4b\x1a\x8b\xde\x0c\x03\xfd\xed\x9b\x13\x93\x6e\x51\x41\x7d\x82\x54\xc3\xd8\xd8\xbf\x62\x24\x25\x54\xff\x16\x77\x5e\xc2\x78\xb9\x8d\x9e\x7e\x06\x40\x6a\xb3\x52\x01\xd5\x3c\xe1\xa0\x7f\xb0\x5f\x3a\xcf\x2a\xcb\xce\xb0\xb2\x5c\xf7\x6b\x43\x4c\x38\xa7\x7f\x6a\xfd\xef\xa5\x1d\x7d\x15\xd9\x82\x6c\x9d\x8e\x3f\x08\xf1\xfe\xaa\x29\x5e\xc4\xee\x40\x24\x66\x9f\x5d\x6d\x29\xdc\xd5\x54\x0c\xaa\xd6\xb4\xf9\xb0\xae\x8a\xe3\x85\xce\xfb\xc3\x2d\x8a\x29\xd7\x5e\x22\x4c\x84\xab\xec\x4f\xa5\x4d\x49\x8c\x4f\x6e\xf7\x1e\x6a\x03\x76\x6a\xce\x09\x74\x1a\xa0\xf6\x31\xdd\x91\xa4\xe1\x27\x39\xbb\x46\xf8\xe7\x41\xbb\xfe\x7f\x76\x74\xb9\xad\x34\xfa\x38\xad\xf5\xa1\x8a\x87\xe5\x11\x73\x04\x48\x18\xfa\xd2\xbf\x99\xb1\x20\x53\x86\xe5\x0d\x9f\xa7\xf1\x9e\xfc\xcc\x94\x7d\x86\x6d\xa7\xc7\xc0\x5d\xa0\x9f\xe2\x62\x81\xeb\x06\x18\x45\xc0\x51\x0b\xaf\xc7\x75\xbe\x5e\x0b\x95\xa6\xcc\x49\x45\x8d\xb1\xd6\x82\x43\x58\xe2\x7e\x12\x67\xf1\xfb\x0f\x26\x04\x86\xe3\x5d\x94\x5e\xab\x12\x90\x71\x10\x9c\x58\x1a\x94\x42\x8d\xc5\xf2\x07\x45\xa0\x82\x9d\xfe\xdd\x6f\xde\xf5\x85\x5f\x07\x44\xb6\xec\x49\xa2\x4d\x4d\xea\xb7\x2d\xcf\x37\x7a\xc6\x75\x80\x79\xd9\x52\x61\x1e\x5a\x9d\xb0\x61\xec\xae\xa8\xb6\xca\x33\x3f\x3a\xaa\x00\x3d\x75\xfb\xef\x1c\xd4\xe1\xf3\x9d\x59\xf7\xd0\x88\xf8\x79\xee\xf8\xba\x6a\xd7\x1d\x20\xc9\x05\xf1\x87\xfb\x5c\xea\x61\x90\x1f\xd3\x1b\x52\x07\x98\xcc\x08\x06\x6b\x0f\x4f\x6d\x78\xe0\xe7\x75\xab\x8b\x56\x05\x5d\xe2\x24\x48\x6e\x54\xdb\xee\x7d\x84\xa5\x06\xd0\xe1\xd9\x31\x7f\x53\x99\xff\xeb\xf6\x9a\x66\xca\xe2\x58\x0e\xf8\xe9\xdf\x0d\x5c\x47\xf1\x4f\xc3\x95\xfe\xd3\xaf\x35\x8a\x39\xf2\xa2\x60\x2a\xeb\xb2\x6c\x3f\xc4\xce\x25\x51\x52\xb1\xc5\x4c\xc7\xb3\x37\x36\x86\xfa\x10\x87\x47\xea\x19\x08\x92\xae\x30\x45\x81\x3b\x6a\xd1\xc9\x9a\x8c\x43\x6b\xe7\xc3\x3b\xd1\xd3\x57\xe7\x2f\x26\x68\xc1\x65\x22\x96\xf9\xeb\x7b\xc2\xf0\x78\xf9\xe4\x5f\xe8\x31\xc2\xe9\x6a\x4f\x60\x67\xf6\x70\x51\x54\x55\x3c\x1b\x91\x1d\x40\xeb\x19\x69\x52\x70\xe8\x1e\x52\xfd\x0e\x44\xfb\xbf\xd9\x99\x32\x6d\x9c\xa3\xaa\xad\x62\x3a\xe3\xc5\x71\xe5\xda\x9d\xea\xd5\x15\xa6\x41\xbd\xa6\xed\x9a\x2e\x3b\x39\x5d\xd6\x24\x89\xb1\x46\xc6\xc4\x23\xe5\x9d\x54\x3e\xa3\x72\xea\x5c\x5d\x64\x53\x8e\x3a\xb4\xea\xab\x02\x52\x99\x17\xd7\x84\x9d\x2f\xe9\xed\xfd\x07\x77\xf6\xc7\x67\x38\xfe\x16\xd5\xa8\xd6\x7c\x8f\x00\xe3\xea\x2c\xd7\x95\x84\x1f\x38\x2c\xdd\x31\x84\xcb\x18\x05\x28\x9b\x65\x74\x36\x11\x19\xa7\x31\xfd\x13\x01\x2c\xdb\x1e\xd2\x8f\x58\xdc\x24\x7a\xf7\x55\xbb\x36\xb7\x03\x68\x82\x56\x4b\x50\x98\x04\x7b\x78\x9f\xd2\xb8\x4e\xcf\xfb\x38\xfd\xdf\xeb\xad\xec\x25\x95\x38\xee\x9a\x03\x23\x92\xe3\xfd\x7d\x79\xc0\x65\x7e\xe7\xd2\x68\x3c\xc9\xee\xf9\x33\x2d\x51\x08\x42\xb1\x6b\x7f\x11\x84\xa1\x26\x59\xf6\x20\xbf\x83\xaa\x42\xa6\xa9\xad\x41\x25\xa4\xe4\x30\x7a\xda\x5f\x94\x8a\x11\xaf\xfc\xdc\x50\xb0\x5c\x02\x6f\x38\xa9\x60\xf3\xf3\x45\x34\xa0\xc8\xe9\x3d\x8a\x87\x22\x0e\x17\x5c\xc5\x65\x9e\xc9\xf8\x3f\x9c\x3a\x67\x15\xc6\xb9\xad\x3f\x9a\x21\x34\xd5\xd9\x54\x17\xb6\xc4\xa1\xc9\x8f\xc3\xf0\x48\xba\xbf\xfc\x1f\xdf\x91\xaa\x02\x86\x61\xc0\xa0\xc4\xaa\xf4\x24\x84\x01\xf5\xc8\x11\xba\x04\x7f\x01\x5c\x04\xf8\x14\x90\xc6\xdf\x4b\x2d\xb6\xbe\x45\x84\x79\xed\x0a\x21\xd3\x47\xa7\x75\xce\x94\xbb\xc0\x9b\xd5\x3c\xaa\x61\x08\xc8\x2c\x99\xb4\xd1\x09\x55\x4b\x7f\x99\xae\xd1\x6e\x1b\x5b\xd0\x1e\x9f\xd1\x16\xba\xdd\xc5\x25\xd4\xa2\xb0\x9a\xb0\x55\x7f\x17\x9c\x55\x3d\x55\x83\xa2\x16\x32\xbb\x00\x71\x47\x64\x85\xa5\x21\x37\xd4\x5b\xe4\x0b\x6d\xe3\x5c\x78\x6c\xec\xe0\x5e\x41\xc9\x55\x6b\xe3\xb1\x91\xc1\xd6\xf1\x4a\xe7\xea\xe9\xc3\x7b\x66\x9f\x0d\xe7\xfe\xca\x52\x13\xee\x5a\x9f\xd0\x9d\xb2\x0b\xd9\x85\x54\x49\xb0\x53\x5f\x4f\x9b\x02\xc8\xd4\x05\x1a\x9f\x9a\xf1\xec\x45\x61\x18\x96\x17\xf6\x35\x08\xb1\x09\x33\x2a\xa0\x5c\xc9\x87\x11\xf6\x98\x77\xef\xb1\xac\x80\x14\x4e\xc6\x27\x10\xe6\x48\x57\xfb\xeb\x3a\xaf\x07\xec\xfe\x99\x07\x6b\xba\x1a\xeb\xc5\x31\x2a\x88\xd8\xc2\x89\x7f\xe2\x23\x42\x6f\xd9\x4a\x2c\xb6\x7a\xb9\x29\x2c\x6d\xe1\xa2\xf4\x7e\x3e\xd9\xbb\x51\xbd\x87\xbb\x42\xa7\xfe\x9e\xcb\x9a\xa1\x28\x10\xdf\xc9\x86\xbd\x3d\x0c\x5c\x3f\xe4\x98\xf9\xdb\x63\xd5\x7e\x4a\x76\xb9\xa7\xee\xe6\xe2\xc7\xfc\x04\x67\x20\x82\x7a\x2a\x87\xf0\xe5\xe5\x97\x4c\x8b\xae\xdf\x6a\x5c\xc2\xa3\xe6\x3e\xdd\xd6\xf0\xb7\xe7\xa5\xeb\xc0\xa4\xa5\xbf\xe2\x66\xe2\x5f\x13\xc5\x72\x87\x12\x70\x2a\x75\xcf\xa3\x12\xaf\x73\xa8\xfd\x61\x9c\xa3\xa6\x46\xeb\xc7\x86\x8c\x81\xa9\x2a\xfe\x47\x46\xd5\xd2\xdf\x19\xa4\x6e\xfa\xd4\x78\x91\x97\xeb\x60\x6d\x6f\xae\x24\x27\x11\x86\xd8\x4a\x3a\x32\x09\xbf\x43\x40\xdb\x3e\x94\x18\xff\xbc\x7f\x3a\xff\x68\x5d\xa0\xc2\x6c\x53\x03\xe1\xb7\x20\x03\xf1\xa9\x8d\x1b\x52\x04\xad\x6c\xbf\xbe\x64\x7c\xde\xd0\x82\xc1\x75\x33\x5d\xf2\xa1\x9f\x01\x47\xfd\x32\x2f\xe5\x82\x5b\x9d\x8e\xec\xad\x96\x78\xc4\xbe\xe0\x32\x6f\x7f\x23\x61\x39\x98\xe3\xb2\xef\x9d\xc6\xf2\x15\x26\x11\xb0\x0d\xdc\x2a\xa0\x4e\x00\x1b\xc1\x98\x96\x31\x86\x3a\x44\xd2\xb7\x16\x88\xe6\xba\xca\x0f\x23\xad\xd5\x1b\x0e\xbf\x25\x64\x92\x1c\x46\x61\x95\x44\x81\x2c\xd7\x72\xdb\xdf\x3b\x40\xc3\x93\xe8\xf2\x24\xcf\x6d\x11\x50\x8b\xa7\xd8\xca\x06\x01\x02\x16\xe7\xa4\xde\x55\xb6\xd4\x61\xe0\x2a\x76\x19\xa3\x9b\xfa\x9f\x0b\x31\xa0\x35\x55\x6a\x55\x2f\x3f\x38\x84\x20\xb9\x72\x54\x7d\xf1\x10\x7e\x10\x74\xe9\xdc\x83\x6d\x43\x6e\x52\x3f\x52\x09\x44\x7f\x4e\xd5\xbb\x8a\xf3\xec\x94\xee\xa9\x02\xec\x1e\xdc\x41\x15\x2c\x26\x89\xa4\x13\xa5\x70\x1b\x64\xae\xf3\xe4\x17\x66\x2b\x2d\xf4\x64\x77\x9b\x02\xe3\xb3\x82\x49\xb4\x90\x69\xed\x10\xbf\x39\xcc\xe3\x37\xe3\xa6\x3b\x3a\x18\x37\x53\xa6\x80\x47\x78\x9f\xda\xdb\x2f\xe6\x72\x18\x49\x7f\x87\x70\xf2\xea\x57\x17\x77\xf7\xd9\x67\x5d\xd5\xca\x9b\xa6\x73\xf5\x22\x74\x6f\x27\x86\x8c\x92\x28\x40\x2c\x30\x8a\x9a\x9c\xc2\xcf\xc2\xbc\x51\x74\xa1\xc4\x53\x05\xf4\xb8\xf4\xa1\x85\x3c\xf3\x0b\x0d\x37\xd2\x07\x84\xff\xe7\xf2\xd6\xe2\xf6\x94\xd7\xd3\x4e\x39\xc7\x1d\xd0\x86\x69\x0a\x08\x32\xbb\x53\x91\x22\x28\x42\xbc\x5a\x7c\xe3\xa0\xa8\x87\xf1\x28\xe8\x5f\x02\xb6\xb7\x7b\x3b\x64\xda\x7c\x2a\xe8\xb2\x84\xae\x3a\xed\xba\x02\x83\x5b\x02\xe0\xfc\x01\x02\xfa\x7b\x9c\xdb\x46\x5c\xe5\x37\xdf\x39\xe4\xf7\xb9\xe5\x0d\xb8\xe5\xca\x62\x1f\x25\x25\x82\x38\xb8\x5c\x0b\x5a\xe5\x04\x8d\x8d\xd7\xdc\x48\x05\x54\xdc\x9b\xec\x04\xa7\x59\x32\x78\xb3\xcb\x3e\x23\x3a\xe0\x7f\xb0\xfc\x8c\xbf\x1c\x28\xcb\x47\xe7\xae\xc8\xdc\xa1\x11\xc2\x7d\x55\xf8\x44\x9b\x51\x92\xbf\xb1\xe5\x27\x57\x14\x60\xcb\xda\xdb\xcf\xae\xf9\x35\x20\x78\x7d\xf3\x08\x85\xa2\x9b\x1a\xa3\xff\xa8\x0f\x80\x47\xe1\x90\x97\xb6\x9c\xdb\xcc\xdf\x1a\x12\x83\x4d\x89\x38\x22\xb2\xc2\x1a\x83\x49\xb8\xa0\x90\x57\xf0\x3f\xda\x77\xa1\xc9\x27\x0a\xf0\x36\x1b\xdc\x9b\x67\x0d\x2f\x96\x1a\x34\x4a\xcd\x42\x83\xc2\xf1\x62\xc2\x5c\xa6\x66\x9e\x87\x2e\x11\xd8\x6c\xa1\xc5\x81\x12\x9a\xec\xe4\xfd\x9a\x3b\xbf\x5d\x0e\xdb\x26\x55\x75\xa5\xd5\xf4\x9f\x13\xab\x10\x44\x67\x3a\x5a\x9d\x9b\x8b\x47\x01\x95\x08\x66\xad\xa3\xc0\x0f\x9f\x2e\x4f\xf0\x2e\x0f\x5b\x5b\x77\x86\x76\x90\x22\xc2\x10\xf7\x22\xd1\x28\xc1\x2a\x0d\xb5\xb2\x90\x44\x88\x96\x7c\x01\x61\xb1\x1f\x64\xfd\xc1\x74\x5d\xcf\x8e\x44\x3b\xf5\xa8\xec\x8e\xcf\x1d\x4e\x2c\x53\x25\x0c\x20\x6f\xc1\xa3\xfe\x99\xef\x93\x48\x2c\x7e\xfa\xbd\xaf\xce\xaf\x0e\xe4\x54\xda\x34\x3d\x99\x5e\x2e\x7a\x75\x16\xba\xe9\x4e\x0f\xfd\x06\x6b\xa4\xe0\x2c\x18\xee\xbe\xa7\x7a\xef\x90\x91\x14\x12\xf5\x8c\xc2\xdb\x12\xfd\x64\x25\x01\x3c\x6e\x7d\x79\xc8\x16\x46\xc1\x88\x4d\x06\x58\xbf\x7c\x97\x49\xa6\xb9\x2c\x7c\x80\x63\xae\x8d\xdb\x32\xc8\x42\x6f\x58\xca\x50\x62\x10\xa9\xd1\xb1\xf3\x0c\x6f\xa0\x2c\x34\xe1\x8c\x8d\xf3\xa5\x68\xf4\x91\xbf\xa4\xff\xc9\xfb\xc6\xf6\xb1\xd6\x78\x73\xe5\x50\xb7\x6d\xb4\x36\x83\x78\x70\xc2\x73\x58\xb1\x43\x99\xe0\x17\xd8\x9b\x45\xb1\xcb\x2f\x9f\xbb\x1d\x18\x3d\xbe\xba\x9b\xdf\x5c\x25\x5d\xf1\x66\x4d\x27\xb9\xff\xee\x7f\xcf\x86\x79\xfb\x45\x4f\xda\x16\xf4\x68\x1b\x6a\x6d\xed\x5d\x49\x8c\x4e\x81\x37\x09\x9c\xc7\x54\x91\x22\x0e\xbd\x65\x28\xc3\x71\x79\xa3\x3a\xa8\xf2\xfd\x9d\xb3\xa0\xa7\x15\x97\xe2\x84\x58\x0a\x4b\xb1\x13\x27\x0c\x5f\x6a\x58\x91\x85\x13\xca\x76\xb5\xe2\xcf\xc3\xf6\x34\x59\x96\xaa\xb9\xf3\xab\xa6\x2b\xf9\xd5\x53\x2d\x18\x89\x90\x41\xcd\x4b\xdd\x4a\xec\xa8\x81\xa3\xfa\x9c\x64\x92\x4d\xd3\xa2\x94\x0d\x73\xd0\x3e\x57\x8c\xab\xf6\xc8\xfc\xb5\xe0\x2f\x43\x48\xf4\xd6\x91\xef\x4a\xc9\xce\x2b\xda\xd8\x08\x35\x80\x92\x32\xb0\x73\xa3\x6d\xd2\x38\xe5\x5f\x59\x78\x0a\xd0\xd2\xe0\x05\xa2\x1d\x9c\xd5\x77\xf5\x0f\xdf\x7a\x5a\x50\xef\xbe\xc9\x20\xa9\xb9\xc2\x90\x65\xed\xbc\xfe\xd4\xaf\x98\x1f\xa6\xfb\xea\x3e\xbc\x52\xfb\x8d\x24\x7a\xcd\xd4\x9b\x97\x8d\xd0\xcc\x2b\xf8\x82\x8d\x64\x70\xc9\xc3\x68\xfe\x61\x25\x7b\x54\x42\xb8\x5b\x82\xc7\x2e\xb4\xfd\x9c\xc9\x55\xc7\xd6\x5e\x0b\xc5\x43\xa2\x1d\x20\x03\x43\x78\x9f\xfa\x0b\x2d\xc7\x1a\xb5\x70\xb6\xa9\x99\x7a\xc3\x18\xa5\xea\x29\x45\x65\xe5\x6a\xc6\x7d\xeb\x4d\xbe\x3a\xf4\x3b\xdc\x3f\x9e\xc3\xb6\x80\xeb\x4a\x1c\xc3\xbd\x48\xa1\x0d\x49\x93\xc6\x9a\x77\xaf\x63\xd7\x2d\xa4\x16\x4b\x38\x85\x54\x3e\x76\x12\x8c\x56\x70\xb0\x4a\xfc\xc3\x17\x55\xce\x5d\x7a\xa4\xa8\x0c\x4c\x10\x20\xd4\x4f\xa3\x60\x40\xc1\x09\x99\x8c\xd1\x57\xdb\x36\x6c\x1b\xff\xdc\x02\x62\xd2\x3d\xea\x82\xcb\x11\xa2\x22\x40\x53\x15\x8f\x83\x94\xfd\x9d\x63\x21\x41\x1c\x99\x4d\x01\x05\x4d\x0c\x82\x19\xa4\x89\x8b\x29\x1f\x69\xee\x14\x68\xbf\x94\xca\xb5\xe6\x92\x71\x05\x1a\x21\x74\x48\xbd\x68\xb1\x3d\x36\xd1\xad\x54\xd7\x17\x0c\x07\x00\x2d\x2d\x96\xeb\x98\x18\x0a\xa8\x80\xf7\xef\xfd\x4d\x5a\x37\xcc\x4f\x65\x2a\x96\x6a\xca\x87\xc4\x88\xe2\xea\x1c\xc5\x15\x50\x4b\x02\xcb\x4b\x91\x81\x17\xc9\xbe\x2f\xad\x71\x87\xf2\xa7\x75\x25\x56\x6b\xfa\xa3\x6a\x13\xf7\x9f\x73\xf8\x91\x4f\x70\xb8\xc6\x0b\x12\xc3\x21\x62\xf4\xae\xb3\xba\x71\x5a\xb2\x38\x00\xf0\xaf\x9a\xfc\x65\xbe\xe3\x35\x0c\xbc\x51\x92\xa5\x74\x3e\x75\x99\x21\x26\x87\x90\xda\xe8\x78\xda\x04\xae\x36\x16\x1f\x25\x67\xe5\x14\xe6\x6b\xf4\xb4\xfe\x7a\xde\xe9\x95\xa8\x15\xbc\xf6\x04\x51\x83\x04\x9f\xc7\x2d\xb7\x87\xcd\x71\xf0\xf6\x53\xf7\x0d\x1f\xdb\xc2\xaa\xfb\x1d\x5e\x1d\xcf\xd5\x76\x3f\xf5\xfd\xd3\xbf\x54\x54\x26\x38\xfa\xc1\xb1\xfe\x6c\xca\x7e\x5e\xa8\x87\x28\x2f\x8d\xce\x22\x94\x19\x99\xba\xc3\x5a\xed\x8e\xa2\xc0\xec\x31\x88\x29\xb5\xc3\x44\xd1\x95\x06\x9d\x14\x27\xc6\xd1\xef\xeb\x79\x5e\xe3\xea\xb0\xef\x93\x17\x36\x12\x7d\x91\x0a\x60\x21\x41\xa4\xc6\x28\x70\xae\x71\x77\xff\x8e\x8a\x44\x14\xba\x6c\x96\xfe\x8f\x0d\x98\xe3\x07\x1e\xa4\xf5\xed\x57\x68\x2d\x81\xea\xc5\x43\x9a\x45\xe2\x66\xec\x1a\x25\xd2\xe6\xd9\xc1\xd2\x16\x4b\x70\xc1\x27\x50\x21\xb8\x37\x4e\x6d\x4d\x34\xff\x68\x44\x30\x2a\xa2\x56\x26\x44\x53\xe1\x86\x6d\x9e\xcd\x63\x5d\xd3\x92\x6d\xea\x1a\xd0\x51\x13\x21\x5a\x44\x81\x75\xf4\xd2\x6b\x23\xf8\x71\x5b\x91\x02\xd4\x1b\x35\x77\x6d\x5d\x06\x93\x26\xc8\x7c\xfb\x25\xc2\x6b\xd3\x6a\x17\x67\xfa\xd5\x4f\x53\xe3\x53\xf7\x60\xef\x8d\x87\x34\x53\x5e\x2d\x0b\x4b\x86\xbe\x9d\xb4\x36\x8c\x28\x56\x6d\x3c\x30\x74\x04\x68\x12\xd0\xda\x7b\x9f\x3a\x7e\xaf\x8c\x7b\xae\xe5\xa1\x06\xf9\x85\x1b\x40\x99\x04\x91\x2e\xdc\xdc\xe2\xab\x23\xff\xf8\x13\x33\x6a\xa4\x0e\xad\x05\xaf\xe3\x4a\x42\xb3\xd3\x98\x3a\x2a\x62\xe6\xbf\x31\x43\x62\xef\x7c\x9b\x28\x6c\xe8\xe3\x35\x74\xb5\xba\x65\x0d\xf5\xb4\x20\x8e\x48\xf3\xa2\x88\xc2\x19\xca\xcc\x5e\x4a\x5f\x5b\x51\x1b\xc2\xd0\x38\x90\x51\x27\x70\x62\xb6\x7e\xb1\x04\xcc\x62\xcb\x96\x10\xa5\x1c\x73\x47\x40\xbb\x2c\x26\x49\xb6\x06\x04\x6a\xc5\x76\xdb\x09\xf8\xc3\x2d\x78\x2d\x97\xc3\x86\xd0\xc7\x16\x92\x0d\x03\x2a\x26\x70\x43\x5c\x2e\xc7\x3f\x3b\x03\xac\x38\x60\x1c\xf4\x16\x45\x2e\x1c\x9e\x7c\xe4\xce\x34\x2d\xcd\x69\x29\xc2\x75\x81\xde\xb2\x34\x23\x14\xc7\xb0\xc2\x80\xd7\x26\x60\x19\xa1\x2f\x41\x65\xfc\x54\x5c\x18\x93\xc0\xd1\xdf\xea\x74\x6a\xd6\x91\xd3\xec\x40\xd3\xa3\x40\x4b\xb4\x65\x77\x2a\x43\x2a\xcb\x6e\x92\x3d\xb6\x1b\xb0\x02\x57\x3f\x32\xa8\x84\x9b\xc3\xa8\xd2\x7d\x8a\xee\xae\xeb\x96\xea\xbf\xfd\xf7\x6f\x8b\x0d\xbc\x33\xde\x23\xdf\xe2\x6c\x1f\x1e\xfb\x77\x34\x8b\xe1\xbc\x60\xa4\x87\x88\x3d\x19\x0f\xaa\xea\x44\xc7\x6d\x34\x99\x6e\x79\x89\xf6\x9a\xf5\x68\xab\x3e\x1d\x4e\x6d\x2d\xc6\x0e\x4d\x18\xcc\x5d\xab\xb8\x9d\x94\xe9\xd5\x94\x5a\x69\xa0\xe3\x31\xa7\x12\x68\xf4\xfa\xf6\xb9\xfd\x7e\x24\x4e\xf9\x2e\x8a\xd4\x74\x97\xec\x99\x62\xbb\xa7\x4d\x2b\x5d\x88\x78\xba\xfb\xa7\x18\x74\x1a\xef\x81\xba\x4d\x02\x68\x72\x16\x2c\x55\x83\xb1\xd9\x5e\x45\xf8\x8b\xd2\x6f\x61\xa6\x7f\xd1\xea\xe6\x63\x42\x8d\xbd\xd2\xc8\xdf\x31\xc2\x56\xe7\x2a\xcf\x9e\x49\x97\xfd\xe6\xfd\x60\x68\x09\x87\x25\x15\x84\x39\x6a\x9a\x69\xe3\x02\xbe\x90\x40\xe4\x50\x46\x12\xcb\xba\x29\x48\x89\xbb\xf1\x1e\xce\x57\x12\xff\xe5\xc3\x0a\x63\x2c\x87\x38\x43\xed\x8e\xcb\xbd\xec\x17\x3a\xd6\x6d\x64\x3b\x3b\x1c\x11\xf3\xa5\x10\x57\x33\x50\x00\x68\x4e\xf9\x44\xee\x10\xf5\x8f\xd0\xf1\xf0\x9e\x3b\x1f\x22\x48\xad\x74\x89\x27\x6f\xfc\x8c\xf2\x1f\x08\xf8\x59\x0c\x58\x24\x3e\x60\x6d\xfa\x24\x0f\x30\x66\xbe\x49\xc2\x2b\xa1\x91\x5b\xd5\xfb\xc3\xc9\x2f\xc9\x3e\x27\x68\x54\xc2\x69\xc7\xdc\x3a\x2d\xda\x51\xda\xfb\x36\x2e\x6e\xc3\x5a\x60\x0d\xb3\xe5\xdd\x0f\x8d\xe4\xd3\xed\x6c\x7d\xf9\x42\xc7\xaf\x47\x3c\x40\xc1\xa8\xb6\x77\x31\x59\xb0\x0c\xb3\x71\x19\x26\xd5\xa4\xce\xc5\xa8\xd2\x8e\x97\xe0\x61\x20\x0b\x5a\xfa\x6f\xec\x89\xe9\x04\xbe\xf7\x85\x42\x0a\xad\xb3\x98\x20\x8d\xc2\xfa\xb8\x7f\x3c\x56\xe5\x53\x58\x5a\xe0\x3f\x58\xf6\x45\xd9\xb0\x1a\xb2\x97\x78\x29\xc0\xbc\x16\xf4\x54\xa6\xb0\x99\xbc\x07\x42\x84\xa7\x58\x1f\x55\x41\x0b\x29\x0f\x91\xd8\x81\x3e\x18\x43\xbf\x5b\x54\x52\xe0\x77\x2f\xd7\xc3\xf3\xac\x55\xc6\x37\x89\x8b\xdc\x07\x33\xa5\xe7\x9c\xc5\x93\xc9\xe0\xe0\x5c\x27\x3a\xed\xb3\xe2\x76\x20\xbf\xb7\x82\xdb\xa3\x98\x22\x08\x8e\x29\x40\x24\x35\xa9\xba\x37\x6f\x7d\xde\x4d\x12\xeb\xf1\xff\xe4\x9a\x15\x54\x88\xfd\x07\xf8\x76\x22\x87\xdc\xe4\x8c\x5a\xff\xcc\xe0\xf1\x2d\x99\x5e\xfa\xb7\xb0\xf9\x95\xfb\xd1\x2b\x96\x13\xde\xb4\x27\x58\x69\x29\x07\x1d\x41\xf8\x01\x52\xfc\x80\xfd\xae\x9b\x72\x89\x3f\x84\xc6\xb1\xe1\xad\xd3\x2d\x06\xde\x4f\x06\x7d\xdf\xce\xd9\xb0\xa9\xbb\x9f\x68\x18\x62\x7d\x3d\xaf\x98\x76\x28\x88\x14\x4c\xe5\xdf\x7b\xd1\x64\xe2\x94\x79\xfa\x4d\xbb\x08\xa7\xe9\x47\xc3\x8d\x14\x24\xd5\x50\xe7\xe2\x5e\xea\x0b\x89\xc1\xd5\x8c\xf3\x47\xaf\xa7\x9c\x54\x7d\x64\x01\xa0\xfe\xec\x9a\x68\x67\x7d\xcb\xe7\x3e\xf3\x5d\x18\xe8\x06\x65\xac\x54\x81\x3f\xa3\xb0\xe8\xe7\x34\xb1\xe4\x6f\x3d\x14\xa3\x6b\x6c\xcc\x25\x9b\xd6\xce\xb3\x2e\xf3\x5a\x2a\x23\xbf\x16\xd8\xf8\x13\x9c\x51\x9b\xbd\xcf\xe0\xac\x1b\xce\xc7\xd6\x34\x67\xc2\x66\x21\x7d\xea\x77\x4a\x94\x56\x50\xd5\xd9\x5e\x38\xc3\x9b\xfc\x2d\x28\x75\xc7\x49\xc7\xfd\xa2\x39\xf1\xe4\xab\x01\x76\x60\xf3\xc3\x8f\x97\xf5\xb8\x7e\xbf\x48\x03\xb5\xcd\x42\x81\x3a\x57\x60\x6b\x8d\x02\x49\x86\xa9\x5f\xe2\xa3\x4b\x05\xb5\xf1\xc7\x7c\x6a\xc8\x5e\x3e\x76\x38\xea\xac\xd1\x5f\x79\x2c\x63\x38\x63\xb5\xf3\x9f\xe2\xe5\x5e\x08\x21\xeb\x44\x14\xaa\xd3\x7e\xb0\xab\x16\xbf\x3d\x16\xb6\x27\x58\xfc\x8a\x35\xc5\xe4\xa0\xc8\x36\x34\x01\x83\x71\x40\x98\x19\x4d\xb6\xe3\xc7\x4f\x20\x90\x80\x69\x4e\x59\xc7\x68\x6c\xe1\x7d\xbc\x14\xa6\xb6\xab\xc1\xb1\x6b\xc2\xcf\x12\x68\xfa\xfb\xcc\x35\x21\x68\x27\x05\xd6\x57\xa2\xea\x97\x53\x0c\x6e\xf0\x74\xe2\xb8\x7b\x9b\x05\xea\x5e\x10\x6a\xad\xb6\xed\x9a\x57\x8c\x53\x47\x0e\xee\x9e\x75\xf6\xd1\x52\x0a\x9f\x73\xe0\x67\x41\xd1\x0a\x2b\x27\xc9\x53\x67\xac\x97\x29\x29\xbf\x7f\xeb\x0d\x58\x86\x6e\x59\x15\x1a\x09\x74\x48\xcb\x90\x88\x03\xa2\x6e\xd9\xde\xd9\x0c\xa2\x12\xbb\xdc\x55\xa4\x30\x26\x7e\xd3\x71\xa3\x9b\xfe\xa2\xb9\x57\x3f\x66\xd3\x53\x54\xbd\x83\x5d\xdc\x82\x57\x99\xf3\x5a\x18\x75\x1c\x4b\xfb\xcd\x8d\x2e\x40\xf9\x92\x28\xb0\x76\x75\xec\x90\xa7\x7c\x7f\xae\x55\x30\x95\xd1\x7b\x93\x32\xdd\x7f\xa2\x92\x89\x87\xc4\xc4\xb9\xb4\xdd\xda\xf9\x06\x6d\xbb\xe8\xdc\x3d\x53\xf9\x20\xc2\x70\xac\x2e\xf3\x38\x04\x98\x4d\x1c\x63\x23\x76\xc7\xd3\x87\xdf\x28\xea\x03\xee\x0b\x84\x61\x1c\xbf\x68\x6b\x6f\x5e\x69\x6f\x28\x2e\xe0\x1f\xf8\xb7\xd6\x52\xe1\x76\x05\xb7\x1e\xd6\x89\x71\xd5\x0b\xc5\x70\x5a\xe3\xc8\x8c\x52\x05\x0e\xf6\xd0\xd0\x1e\xd9\x22\xe9\x24\x06\x43\x11\x58\x43\xe7\x8a\x1d\x19\xa2\xac\x9d\x9e\xa5\x85\x4d\x55\x4a\x88\x9a\x94\x42\x29\xe2\xc2\x87\xa0\x6f\x39\x03\x14\xb7\x77\xda\x4b\x98\x69\xc3\x14\x84\x7f\xe9\xaf\x5e\xf1\x76\xd2\x38\x16\x23\xe9\x5b\x94\x89\x3e\x24\x55\x17\xfd\x19\xea\xcc\x40\xcc\x85\xe7\x09\xbf\xef\xe3\xbb\x10\x2a\x84\x27\xd4\x00\xe9\x70\x8b\xd5\x9c\xab\x0a\x55\xd9\x27\xf5\x48\x14\xff\xee\xed\x30\x68\x5b\xc8\xa5\x6f\xf7\xb6\x3f\xa1\xae\x47\xdf\xa8\x67\xe5\x74\x0c\x30\x09\xea\x64\x1b\xa4\xe0\xa9\x80\xca\x66\x64\x43\xf0\xe4\x21\xa4\x23\x71\xda\xa0\x4b\xc5\x7f\x5f\x7d\x95\x86\xbe\x4d\xcd\x1d\xe7\x20\x31\x0a\xc2\xd3\xc8\x44\x7e\x75\x71\x03\x50\x68\x8d\x75\x07\x5b\xa1\xa0\x99\x04\xe8\xd1\x81\xd7\xe3\x72\x3f\xff\xb1\x7f\xa5\x1e\x0b\x89\x8b\xd9\xaf\xc8\x93\x89\x0f\x6a\xf7\x3d\x33\x82\x53\xdb\x37\xe1\xb8\xbf\x95\x3c\xe9\xf2\x8a\x5e\xb0\x65\xc7\xa2\x32\x86\xf3\xea\x80\x20\xaf\x7a\xb1\xc1\x4a\x67\x09\x20\x3f\xef\x51\xa4\x22\x89\x73\x59\x73\xc1\xf9\x5b\xef\x2f\x30\x08\xb7\x05\x0e\xd8\x5a\xed\x4f\xe9\x27\xd8\x71\xd9\xc8\xee\x07\x63\x15\x9e\xa3\x45\xe6\x1b\x0f\x78\xd0\x2d\xd4\x46\x82\xa9\x27\x6f\xcb\x09\x48\x6f\xf2\xdd\xf8\x07\x6a\x9b\xe6\x65\x14\x77\xec\x62\x0a\x07\x22\xba\x7b\xb3\x9b\xa4\xf5\xa4\xad\x07\x9f\x83\x72\xf3\x92\xb7\xa6\xd3\x54\x59\x13\x63\xbf\x14\xcc\xaa\x24\x42\xae\xc4\xb1\x69\x81\x7a\x8b\xb5\xc0\x70\x73\x3c\x9c\x71\xd9\x42\x46\x2c\xc3\x89\x18\xce\xf2\x65\xe6\xa7\x2b\xe3\x4c\x81\xf4\x75\xd1\xca\x88\xdf\xe8\x75\x8b\x46\x05\x1e\x77\x1f\xe8\x6e\x71\xfb\x8e\xcb\xac\xe2\x68\xa8\xd6\x3f\x39\xe0\x7e\x49\xf0\xa3\xe1\x58\x2b\x77\x23\xcb\xd4\x4d\x61\xc5\xc3\x4a\xbe\x8c\x68\x30\x74\x75\xa7\x49\x5b\x58\x08\x0f\xd2\xc0\x03\x95\xcf\x40\xe0\xbb\x1f\x88\x95\x07\xaa\x2b\x14\xd9\x05\xc6\x32\xfa\x43\x6e\x51\xf6\x19\x70\x1a\xc6\x74\xb4\xef\x56\x65\x49\x25\x82\x60\x6e\x2d\xca\x08\x3c\x88\x8c\xca\xc7\xc6\x07\x1d\x3b\xd5\xa3\xd6\x9c\xc3\xf8\x2f\x76\xb3\x69\x6a\xf5\x9e\x91\x9c\x9b\xc8\x3a\x91\xbf\xcc\x94\x56\x26\xe0\x84\xc6\xcf\xf3\xf7\xcd\x7e\x9f\x8e\x7e\xf0\x1a\xfa\x4c\xf2\x70\xf5\xdf\xde\x1d\x8e\xf9\x5b\x90\xf6\x8a\x86\x69\x51\xb2\x9b\x44\xe3\xd1\x1d\x0a\xe4\x9e\xef\x3c\xf7\xb6\x18\x3d\x07\xe6\x34\x17\x1f\x01\x83\x39\xb6\x83\x42\xe6\x1e\x8d\x42\x9b\x42\x4c\x3c\x65\x0d\x9c\x2d\x58\x6d\xed\xd9\x44\x43\xb5\xc8\x9e\xd2\xe6\x92\xf8\xb5\x81\x2c\x9c\xb0\xa7\xab\x7c\xc3\x2e\x9c\x4e\xb2\x82\x54\x5f\xf5\xcc\xb8\x2c\x52\x33\x1c\x43\x86\x62\x1a\x4d\x5d\xdd\xa4\x83\x04\x4a\x7f\xb4\xd9\x6a\x2e\xe5\x41\x52\x80\xa7\x9f\x3e\x52\x4c\x38\xb9\xc2\xc4\xc7\xff\x13\xbc\x54\x16\x7e\xb4\x3d\xb8\x53\x81\x60\x40\x7a\x99\xd8\xd3\xe5\x3c\x98\xfe\x35\x99\x3c\x8d\xba\x7d\xc9\x46\x6d\x15\xcf\xcf\x34\x25\x53\x49\x83\x4c\x21\x69\xf8\x94\x69\x8e\x73\x84\x89\x7e\x4b\x0d\xb3\x71\xb2\xbf\x3b\xe1\x7a\x8e\x47\x1c\x7b\xdc\x01\x98\xbe\xae\xb2\x9b\x11\x18\x87\x15\x84\x9b\x13\x44\x8e\xf2\xbf\xaa\xbc\x16\x28\x87\x4e\x1d\x06\xae\x83\x38\xbf\x9d\x3c\xff\xfc\x6d\x27\x71\xce\x1a\xcb\x28\x8d\xbe\x7b\xa2\xd3\x7d\x76\x03\x29\xcd\x54\x53\x1f\x6f\x18\xb7\x29\xa0\x3e\x6f\x9c\x2f\xaf\x5d\xd1\x2a\x06\xff\x0c\x43\x6e\x97\xd0\xda\x50\x2b\xe5\x9d\xcc\xf7\xb2\x0f\xc9\xdf\x57\xd7\x14\x6d\x0f\x87\x68\x0f\x7d\x61\xcb\xe3\x7c\xf4\xed\xac\xa8\xec\xe9\xaa\x64\x82\x78\x3e\xb9\xfd\x85\xb1\x18\x9c\x51\x2c\xdb\x87\xe9\x36\x5b\xab\xa0\x32\x86\x0c\x88\x49\x96\xc8\x74\xa5\xd8\x25\x12\xf6\x42\x9c\xdb\x0b\x54\x28\x10\xa3\xc0\xb4\x60\xf5\x03\x0a\xd4\x07\x1b\xc1\xfb\x53\xf6\x9b\x64\xdf\x33\xb8\xc7\x9e\x34\xf6\x95\xce\x66\xde\xc9\x78\x28\x72\x5e\x29\x41\xdc\xea\xad\x03\xae\xff\xa7\xff\x4f\x7f\x9d\xdf\x8e\x1b\x0a\xac\xac\xb0\x11\x8f\x98\x56\x11\xbd\xe2\x59\x10\x8d\xa6\xde\xc2\x0c\x60\x50\x36\xe8\x26\xb3\x44\x2b\xd3\x3a\x07\x43\x51\xb5\x44\xbf\xab\x16\x78\xed\x9d\xb2\x9c\xfb\xa6\x84\xf5\x1b\xa9\xb9\x40\xc7\x35\xe9\x11\x6e\x49\xc7\x99\x5a\xd8\xd9\x4d\x1a\xf8\xf2\xd8\x10\xd7\x76\xbf\xa3\x96\x82\xc7\x80\x18\x02\x49\x8a\x98\x4d\x89\xa9\x53\x6a\xb3\x71\x59\xd6\x0c\xd9\x36\x02\xb8\x56\x02\xb8\x36\x02\x58\x7f\xe3\xb2\x58\x07\xe9\x41\x07\x99\xeb\x75\xcb\xb7\x3b\xd2\xc2\xd1\x72\x15\xf8\xc4\xf7\xd6\xf3\x5a\x93\x79\xe7\x0d\x65\xba\x91\xe8\x05\x13\x4e\xdb\xfd\xd8\xf4\xcc\x6c\x2f\xe4\x49\x96\xbb\x88\x79\x93\x3f\x76\x91\xf7\x46\xe3\xcf\x1c\xab\x9f\xba\xf4\x70\x43\x16\xa5\x7f\x63\x99\x29\x77\xf3\x9d\xd9\xee\xdc\xf1\x67\x41\xb9\xb0\x57\xac\xfc\xf2\x60\x53\x67\xae\xe9\x46\xfc\x9d\x40\x78\xad\xe3\xb3\x67\xe5\xb4\x90\xd8\x81\xdc\xef\x58\x69\x85\xbf\xe6\x1f\x8a\x4b\xd5\x84\x03\x55\x0c\x3f\x5e\x30\xa8\x9e\x73\x18\x50\xf2\x4f\x3f\xac\xf6\x8f\x4d\x6c\xda\xdf\xf1\x95\xcb\x96\x01\x5b\x0f\x06\xf9\x65\x9b\x1c\xf4\xcb\x76\xa1\x77\x66\xab\x17\xe5\xcd\x75\x91\xe6\xc2\xf6\xee\x5d\x5f\x23\x73\xae\xd0\xfe\x88\xf4\x5a\x88\x3a\xe7\xec\x58\x1b\xea\xf6\x97\x25\x00\x19\x66\xa7\x1b\x94\xd9\x98\x31\x3a\xeb\x0a\xda\xc8\xd4\xbd\xae\x62\xc4\x45\x0b\x25\x70\x5c\x1f\x80\x81\xe0\x41\x5d\x44\xbf\x0e\xd0\xb8\x7e\x48\x09\x23\x29\x26\x7d\xb7\x38\xd5\x3a\x77\xcf\x5a\xbb\x69\x5f\x8e\x0c\x9a\xe9\x16\x8c\xd1\x50\xaf\x02\xca\xad\x55\xe1\x18\x55\x5a\xb2\x4f\x23\xa0\xdd\x3d\x09\x55\x02\xd6\xf4\xa3\x00\x5b\x3e\xc9\xd1\xcd\x99\xe3\x97\x22\xc5\x94\x22\xe1\x00\xcf\xed\x46\xf8\x73\xc8\x05\xa1\x1b\x84\xe2\x12\x45\x83\x9a\x59\xe3\xcf\x18\x4d\xb1\x7b\x46\x86\x55\x40\xef\x29\x8f\x78\x75\x38\xa6\xc6\xea\x5f\x47\xe4\x77\xfa\x2b\xa5\xb6\xf1\xf4\xf1\xb9\x47\x6e\x15\xdb\x17\x37\x65\x59\xa6\x4a\xc5\x01\x01\x9e\x5e\x64\xbe\x79\x4f\x70\xe3\x1f\x0a\x30\xb9\xc6\xc1\xf2\x5f\xcc\xe1\x8f\x0f\x93\x48\x36\xfe\xd9\xae\x63\xb3\xc0\x4e\x14\x49\x14\x7d\xad\xf8\xed\x0f\xdd\x0b\x32\x53\xea\xdc\x1a\xa7\xb6\xef\x7b\x96\xcc\x96\x14\xf9\x6f\x55\x77\x6d\x49\xeb\x96\x8d\xcb\x9f\xc8\x8d\xed\xfe\x23\x50\x85\x2d\xf3\x34\xb2\xe9\x08\x7d\x55\x25\x28\xee\xb7\x26\x78\xfa\xf0\x50\xb1\x66\xdb\xe9\xf9\x18\xef\x05\x27\x3f\x94\xe7\x0f\x5b\xf9\x79\xdb\x8d\x87\x05\x6c\xf7\x49\x81\x0d\x4e\xb3\x5e\x1d\xe0\x65\xe5\x4b\xc2\x70\x3f\x41\xad\xcf\x35\x22\xe2\x0e\x9e\x60\xa1\x44\x82\xdb\xe7\x08\x53\x42\xb5\x01\xe0\xb1\x66\xe3\xb1\xa6\xe3\x07\x90\x05\x54\x80\xfd\x4b\x6d\xba\x3b\x63\x99\x19\xeb\xfd\xab\x4a\x9d\xbb\x44\xf1\x80\xd8\x0d\x47\x71\xf7\xa1\x7b\xd0\xa4\xdd\x40\x59\xa9\xfd\x51\x5c\xc7\x6f\x4a\xd0\x09\xc1\x17\x2b\x46\x89\x83\xa7\x1c\x8e\x49\xb8\x3a\xe0\x18\x1c\x1d\x53\x5f\x4b\x1d\x4d\x75\xb5\x25\x58\x8f\xe3\xb8\x0e\xcb\xef\xf1\x62\xdb\xe9\x0f\x10\x12\x23\x56\x85\x20\x14\x5d\xe8\x4a\x7c\xa0\x12\x1b\xca\xbe\xe8\xf8\x21\x29\x6a\xe7\x58\xb0\xba\x50\xd2\xa7\x5f\x40\xe7\x30\x24\x4f\x31\x96\xd1\xd8\x19\x2a\x6d\xb9\x83\x09\x53\xd6\xfc\xe5\x1c\x5b\x6c\xd5\x9e\x84\x2a\xac\x91\x6f\x9e\x77\x71\x16\x73\xdb\x8b\xe4\x2e\x74\xed\xf5\x83\x3f\xb1\x65\xd0\x06\x9f\xc3\x4e\x2d\xeb\x89\x42\x18\xaf\x0a\x5e\xe1\x82\x26\x6d\x92\xa4\x8b\xd9\x64\x1b\x48\x60\xfc\x86\x50\x85\xee\x44\x7f\x27\xb2\xc1\x24\x0d\x88\x7c\x03\xab\x30\xc4\x60\x52\x0d\xe5\x96\xfc\xe1\x2f\x20\xf3\xfd\x32\x10\xd7\xf4\xb9\x38\x75\x3a\x4b\x4f\xe4\x76\x21\x4d\xfb\xdf\xb6\x00\xc2\x20\x38\xa1\x6b\x4c\xe9\x66\x7c\xe8\xfe\xb7\x1e\x5f\x27\x44\x67\xb5\x75\x5d\x07\xab\x03\x81\x2b\x3c\x2d\xa3\xe6\xdd\x57\xab\x9b\x09\x56\x5e\x86\x4d\x5c\x11\x69\xef\x99\x5b\xe5\x64\xed\x1d\x5e\x4f\x61\xea\x34\xf7\x57\xb9\x7a\x4b\xe4\xa2\x73\x8d\x25\x67\x05\x37\xca\xc1\xe9\x64\x43\xbb\x79\x02\x52\x1c\x3b\xdb\xcd\x8a\xd2\xdc\xe4\xa2\x45\x61\x04\x59\xe2\x88\x79\xeb\x07\x6a\xb2\x56\x53\xc5\xda\x7d\x10\xfa\xd5\xb3\x68\xd9\x52\x87\x53\x50\x56\x68\xfd\x9b\xda\xf2\xd4\xe5\xd1\x19\x6b\x0c\xd8\xcd\xa4\x75\x53\xa5\x2d\x36\x38\x65\xec\x3c\x00\xe6\x95\x8d\x12\xd4\x4a\x5c\xdb\x64\x01\x6f\xc2\xf3\x33\x1d\xcf\x6a\xbd\xb6\xa7\x35\x1a\xf0\x2e\x64\x9d\xe4\x87\x04\x6d\xb6\x4f\x42\x14\x60\x06\xae\x18\x55\x1a\x3e\x30\xe3\x7c\x75\x7d\xf9\xba\xae\x6b\x31\x7e\xbf\x6b\xb2\xa7\x14\xa6\x72\x40\xd0\x05\x67\x1f\xd5\x1b\x34\x29\x38\x19\xd7\xb5\x31\xc3\xf7\xa3\x33\xbf\xe1\x04\x08\x18\x73\x63\x8a\xd7\xc3\x9a\xfc\xa5\x47\xaa\x6d\x70\x56\x23\x63\x53\xc2\x38\x79\x16\xdc\xf3\xb4\x54\x21\xf4\xf7\x6d\xae\xf9\x65\x20\x13\x7f\x20\xe8\xc9\x36\xb6\xc2\x47\xc0\xd4\xbb\xbc\x85\x6b\x73\x9c\x17\x2b\x77\xd3\x16\x70\x75\xce\x4e\x16\x9a\xee\x00\xe8\xfb\x64\x71\x22\xe5\x77\x97\x6b\x89\x94\x30\x70\xd1\xa9\x69\x8d\xaf\x14\xf8\xe1\x7a\xc0\xf8\x1e\x7d\xe9\x0e\x00\xa9\xad\x05\x6f\xd5\x10\xff\xa0\xa7\xcb\xca\x3c\x0d\x54\x56\x69\x7d\xe8\xef\x04\xb5\xef\x7d\xa9\xa5\x36\xf8\x36\xb0\xa9\xfe\x69\xd1\x34\x94\xb9\x18\x73\xcd\xd1\x72\x4c\x45\x70\x4a\x4f\x04\x7b\x41\x35\x3c\xdc\x09\x94\xdc\x16\xc0\xe6\x55\xd9\x81\xd7\x1f\x0a\x14\xd8\x0b\x9c\x53\x6a\x17\x90\x03\xb4\x54\xbc\x7e\xd4\xba\xe4\xae\xe9\x46\xbb\x93\x75\x24\x1e\xdd\x06\x84\x2c\x00\xa8\xe5\x04\xad\x09\xc4\xfb\xfe\x15\x8b\x68\xbc\x04\x91\x19\x89\xd1\x52\x11\xe7\x39\x7a\xaa\xb3\xb7\x15\xa2\x78\x0a\x14\xa3\x25\x17\x27\xfa\xf3\x0f\x00\xd4\x9b\x04\x46\x16\xb4\x88\xe3\x03\xfd\x99\x13\x89\x63\xf4\x8f\x44\xf1\xa5\xcf\xba\xd1\x4f\x69\x64\x7e\xd3\xfd\x25\x70\x5a\x55\x05\xd8\x78\x98\x6a\x7a\x18\xe0\x8f\x8d\x91\x2c\x70\x93\xa9\x6d\xa3\x11\x89\x46\x13\xed\x9c\x7f\x02\xfb\xb6\x12\x4b\x1a\x85\x3a\xb3\x5b\xe8\xf4\xe2\xca\x31\x7e\x6a\xfd\x64\x48\x7a\xfe\x46\xd2\x2b\x5a\x26\xe1\x8d\x6d\xc8\xf7\x77\x16\x59\x15\xa5\x7e\x8d\xa1\x6e\x17\x21\x75\x22\x99\x88\x38\xfd\xf9\xd1\x05\x5e\x41\x28\x14\x15\x12\xb6\x4f\x59\x5f\x0f\x26\xd6\xb3\x54\xd3\x27\xfa\x2b\x4e\x2c\xc9\x6d\xe4\x57\x9c\x43\x8e\xa2\x36\x26\x2e\x59\xd4\xce\x91\x4e\x0c\xc9\x25\x78\x17\x4c\x01\xb9\x19\x5d\x88\xfa\xb3\xc0\xa2\x94\x44\x4f\x75\xa6\xce\x63\x94\x3a\x60\x6a\x99\x21\x26\x12\xda\x6b\xcf\xd7\xf8\x12\xea\xc5\x9a\x92\xa0\x48\xcd\x6f\xc4\x2e\xf6\x66\x17\xe3\x59\xb9\xd3\xef\x67\x7a\x2b\x1d\x24\x31\xcb\x88\x1f\x68\x93\x34\x80\xfe\x40\x63\x1a\xb7\xf0\x44\x4c\x3e\x47\x79\xb0\xb2\x3b\x77\x61\xf3\x15\x50\x02\xe9\x14\x1c\x7a\xbb\xd1\xa5\x45\x67\x96\x5d\xbd\x40\x65\xb8\x58\x5b\x2a\x4d\x71\x29\x0f\x23\x82\x24\xb2\xd9\xb3\x69\x21\x1f\x8f\x9c\x53\xef\x1f\xa0\xc7\xbc\x79\x97\x99\x59\x3a\x22\x32\xdd\xd1\x9f\x26\xaa\xc6\xff\x47\xd2\x59\x2b\x49\xaf\x43\x41\xf8\x81\x1c\xc8\x0c\xe1\x18\x66\xcc\xcc\x99\x99\x99\xfd\xf4\xb7\xf6\xbf\xd1\xd6\x26\x2e\x59\x6a\x9d\xfe\x5a\x75\xc6\x1a\x6c\x09\xce\x0b\x53\x3c\xd1\x91\x3b\x47\xa3\xbe\x8c\x57\x8e\x60\xfc\x2c\xa9\xb9\xbc\x53\x4b\xf9\x5a\x8e\xf9\x60\x52\x06\x5e\x03\x2b\x0f\x4c\x65\x0f\x10\x51\x3b\xfa\x68\xea\xc5\x1a\xe3\x42\xee\x2b\x8e\x27\xfa\xef\xcd\xb7\xd2\xf8\x11\xc4\x43\x32\x94\xaa\xac\x2b\x85\x0c\xd7\x64\xc9\x53\x4d\xf5\xb8\xf0\xf8\x7d\xec\xa5\x08\x0a\xa2\x4d\x24\x18\x07\xde\xd5\x7a\x45\x61\x8f\x6b\xe0\x0c\x2a\x8d\xd1\xdd\x31\x70\x88\xdf\x2f\x4d\x53\x14\x75\x11\x19\x94\x17\xe6\x91\x29\x13\x23\x4c\x50\xad\x21\x07\x83\xa4\xfa\x00\xe2\x2d\xd0\xc5\xdc\xf7\xfd\x00\xd9\x53\x85\x75\x9c\x07\xf2\x7f\x13\xf5\x83\x57\xd1\x5d\x90\xcf\x3e\x84\xed\xa5\x8e\xf7\x28\x23\xfa\x18\x1f\xa1\xc8\xdb\xb5\x80\x15\xe6\x77\x13\xdc\xf9\xb5\x77\x7d\xd2\x45\x73\x78\xa7\x3e\xa3\x1a\x4e\xf1\xc8\xdc\xa0\xca\x9d\x1e\x91\xca\x8f\x04\x9e\xe4\x47\xe2\xce\xf4\x50\xe9\xed\x0d\x4b\xf9\x76\x9c\xfd\xc3\x21\x4a\x9f\xf4\x49\x22\xda\x42\xf5\xcb\x2d\xa6\x0d\x19\xe8\x7a\x8e\xe6\x9e\xe7\x45\xcd\x48\xeb\xe4\xce\x34\x04\xf2\xc1\x78\x29\x9c\x2c\x56\xe7\xaf\x34\xfc\x81\x01\xb5\x3c\x5b\xc4\x73\xc9\xc4\x8b\xf0\xd3\x39\xce\xec\x73\x0b\x12\x2c\xe8\x3b\x29\x1c\xbc\xab\x36\x6d\x9b\x03\x4c\xe6\xc6\xee\x0f\x3b\x79\x46\x73\xa5\xa9\xf5\xc8\x40\x04\xdf\x47\x85\x21\xbe\x4c\x11\xfa\xa1\x0f\xe7\x62\xcd\x80\x9d\x18\x37\xe8\xd3\x78\xa5\xbb\x7f\x95\x59\x67\x2b\x4d\x4e\x82\x7e\x4e\x82\x9e\x20\x10\x80\xcd\xce\xec\xbd\x1a\x95\xd8\xb8\xc6\x9e\xe5\x81\xfa\x26\x11\x07\xff\xee\xf9\x42\xfc\x38\x14\x71\x7a\x88\xe0\xe2\xe5\x63\x5e\xd9\xc3\x75\xfb\xd9\x48\x21\xc1\xb8\xc0\xb3\x4c\x0c\x9d\xbf\xd4\x9d\x3b\xac\x30\x38\x82\x84\xc9\x55\x80\xdc\x4a\xad\xab\x8a\x50\x10\xd5\xa9\x75\xb7\xcb\x0c\xf0\x63\x2c\x61\x64\xbf\x63\x9d\x7f\x98\xf9\x76\x1c\xfb\xd6\x8a\xf8\xc7\x66\x81\x22\x39\x6e\x74\xa0\x66\xb0\xb8\xdf\x89\x94\x69\xe1\xb6\x04\xc9\x57\x2f\x66\xf5\xc3\xf0\x86\x99\x78\x7b\xb3\x23\x34\xce\x81\x3f\xc5\x94\x8f\x5b\xb4\xab\x95\x00\x3d\xd7\x77\x46\x29\x69\xa5\xbf\x7f\x7e\xf4\xc7\x51\xbd\x49\x9c\x55\x4e\x43\x34\x7d\xf9\x19\x49\x5a\xc4\x4a\xed\x10\xf6\x96\x68\xeb\xaf\xfa\x08\x1e\x27\x51\x5d\xfc\xc4\x88\x52\xa5\xa4\x14\x2a\x9d\x6d\x97\x28\x6a\x7d\xdf\xa6\xed\x5f\xfb\x37\x34\x0e\x9a\x7e\x54\x58\xb7\x73\xb1\x61\x16\xa4\xd8\xc1\xd0\xc6\xdb\xac\x8d\x2e\x9a\x9a\x0b\x19\xcf\xc5\xb6\x7a\x4a\x9e\x67\x05\x04\xf7\x3d\x82\x2f\x4f\x3f\xcc\x7f\x93\x87\x00\x70\xbf\x77\xa8\xf6\x17\xf4\xd8\x52\xd3\x2c\xf2\xf0\xc6\x3b\xf1\x52\x14\x66\x54\x95\xac\x9d\x9c\x8b\x33\x57\x56\x02\x53\xfc\x9a\x78\x83\x5f\x71\x5e\x96\x41\xf8\x10\xda\xd8\x50\x87\x6b\x4f\x70\x01\x0a\xb0\xe5\xd2\x40\x5d\xdb\xb6\x9d\x25\x94\xc6\x18\x2a\xc2\x1a\xb2\x87\x3e\x53\x2c\xbd\x73\x84\xbe\xf3\xea\x8f\x61\xa2\x87\x29\xb6\x2b\xb1\x63\x98\xed\xbf\xc4\x56\x77\xdb\x66\x31\x0f\xf8\xb6\x37\x4a\x02\xc6\x4d\xee\xfd\x4c\x80\x0b\x18\xf4\xdd\x1f\x42\xa8\xde\x01\x69\x90\xd4\xc4\x5a\x00\x4a\x8c\x62\x86\xd0\xcc\x09\x5c\x16\x9e\x34\xcb\xf6\x63\xdf\x1e\x12\x0a\xcc\x85\x0a\xfa\xdd\xa5\x21\x9c\xa2\xc8\x3b\x3d\x1e\x22\x57\xcf\xcd\x92\xdc\xa3\xf2\xbe\x37\x74\xdb\x01\x86\x61\xbd\x18\x05\xdf\xfa\x6f\x8f\x91\xcb\xba\xe4\x0c\x01\x15\x8c\xf9\x6b\x3d\x63\xe3\x64\xf1\xe8\x58\x48\x2c\xd4\x36\xa7\x6a\xaf\x54\x7d\x08\xc9\x06\xbf\x8d\x50\x93\x0e\xb0\x13\x38\x3e\x73\xaf\x55\xad\x4a\x9f\x80\x95\x04\x3f\x59\x0f\xa4\xa1\xb0\x9f\x10\x4e\x2b\xb6\x70\x32\x75\xcc\x5b\x56\xe7\x5d\xc3\x26\x41\x7d\x23\x6e\xbd\x42\xaa\x69\xb1\x9c\x90\x28\xb9\xfa\x43\x56\xf9\x54\xab\x95\xa3\xb0\xeb\x1c\x5f\xf9\xdb\xa7\x70\xc6\x0b\x5a\xc6\xfc\xb4\x9f\xb5\x05\xea\x5c\xa1\xa5\x3b\x0d\x2e\xbd\xee\xa8\x4a\xb5\x67\x08\x18\x12\x18\x4c\xc1\x98\xc7\x8e\x7f\x04\x1a\xd2\xf2\xf0\x00\xca\xff\xf3\xf7\xfc\x41\xa3\xff\x70\x38\x41\x9f\xfa\x79\x00\x0c\x4d\x48\x88\xd2\x37\x72\x93\xf7\xc4\x14\xae\xcc\x10\xbf\x2d\x3d\x5e\x10\x96\xea\x78\xc6\x4d\xc1\x43\xa5\xe1\x6b\xaa\xcc\x4a\x90\x44\x6e\x60\x45\xfc\x16\xc9\xba\x52\xfd\x80\x9d\x37\x71\x84\x3d\xa1\x05\x60\x87\x49\x24\x1d\xb1\xf5\xd1\xa1\x3c\x3b\xef\xce\xa8\xa9\x7c\xc3\x56\xb9\x85\x70\x92\xa2\x28\x6c\x9c\xe7\xfd\xbc\xab\x5b\xdb\x7f\x9e\xef\xc0\x61\x16\x74\x5f\xb6\x2a\xfe\xef\x17\xb1\x27\x38\xd1\x83\x74\x43\x35\x43\xe4\xa7\x01\xb9\x1c\x34\xd5\xa9\x02\xd9\xe7\xf9\x82\x5b\x82\x47\xf2\xd4\x2b\x4d\x1e\x82\x6e\xe8\x04\x7b\x7b\x32\x68\xb9\x24\xef\x8b\xdf\x76\x26\x9e\xa0\x6d\x88\x14\xa2\x73\xcd\xd0\xf5\x87\xa4\xcb\xf9\x1b\x2c\x74\x80\xdf\x44\xcc\x40\x1a\xec\xba\x10\x55\xee\x0f\xad\x9e\xcc\x96\x19\x22\x5b\xe1\x9b\x23\x9f\x18\xf5\x34\xee\x33\x87\xab\xf9\xa5\xc1\x5d\x86\xd8\x4a\x60\x37\x43\x77\xf2\xb7\xd7\xaf\xc8\xe6\x3e\x76\xb0\xf6\xcd\xbf\x3e\xd7\x94\x5a\x09\x62\x06\x19\xc8\x4d\x4d\x51\x3a\x8f\x9b\x42\x88\x3e\x32\xa0\x82\xfe\xca\xbc\xe4\x10\xae\xcc\x42\x40\xba\xa3\x44\x4e\x1f\x62\x1b\xbe\x33\xdc\x9c\x3d\x33\x39\xf3\xbb\x77\x8a\xea\x6b\x8d\xa0\x03\x23\xc5\x77\x5a\x92\xc2\xd9\x0e\x3b\xeb\xb6\xed\x49\x0a\x1d\xb6\x9b\x1b\x5d\xfa\xf4\x6d\x9d\x58\x50\x10\xe3\x18\xec\xe6\x4e\x38\xdc\x97\x2b\x66\xfe\x86\xa6\x0c\xf9\x14\xa1\x51\x5c\xef\x17\x80\xf3\x5d\x89\x0d\xb8\x64\xe8\x44\x16\x3d\x86\xf0\xae\x3e\x27\xc5\x10\xc9\x06\x0e\xcc\x6a\xbe\x9c\x01\xe1\x12\x9c\x41\x85\x09\xf1\xae\xbd\x61\xcc\xe9\xc2\xce\x6f\x15\x43\x33\x44\xde\x17\xa3\x28\xf3\x04\xd0\x9e\x31\x26\x38\xe3\x55\xda\xc7\xbb\x24\xe5\x8e\xf2\x79\xe5\x6a\x39\x99\xd8\x50\x50\x9c\x79\x8e\x6d\x94\x10\x14\x2f\xb2\xa7\xc1\xce\x10\x34\x4d\xa0\x18\x46\xe0\x77\x06\x45\xfb\xce\xc0\x89\x75\xff\xca\x9c\x21\x48\x88\xa4\xcf\xf3\x7d\x61\x18\xfb\xd5\x9e\x51\x9b\x25\xa8\x5b\x0b\x36\xf9\xea\xa2\x99\xda\xac\xc6\x77\x12\x78\x96\xb6\xf3\x60\x3c\x73\x20\x76\x32\xba\x07\xdf\xe6\x4e\x94\x81\x05\xa3\xf1\xa3\x08\x9b\x3e\x00\xf4\x82\xf2\xba\x5f\x49\xfb\xd2\x85\x69\xb6\xc1\x80\x82\x1d\x55\x71\x66\x0d\xf6\x1f\x8f\x20\x69\xc0\x1c\xeb\x4a\xbd\x0f\xd3\xef\x67\xe8\xe6\x27\xb6\xa1\x18\xf6\x7b\x5e\xf4\x15\xc4\x7a\x21\x9f\xed\x54\x6a\xe8\x66\x24\x50\xfb\x00\x5e\xbf\x00\x00\x9c\x4a\xd7\x93\x61\x30\x06\xcf\x32\x55\x68\x7c\x35\xdd\x21\xea\xa0\xa6\xf2\x28\x21\xfe\x53\x59\x7a\x6c\xbc\x53\x76\x53\x18\xf5\xb6\xbb\xd8\xde\x37\x81\x5b\xf4\x39\x8f\x27\xc5\x48\xa4\xd1\x56\xe5\x71\x5c\xa0\x5c\x3e\xc2\xef\x7b\x5d\xbf\x3f\x6f\xa2\x0b\xda\x04\x3d\x92\x7a\x8f\x49\xde\x63\x77\x9f\x75\x84\xec\xb1\xdc\x30\xe5\xd8\x39\x8a\x46\xaa\x9a\x74\x57\x8e\xc4\x0d\x7c\xe7\x48\x51\xe3\x19\xb7\x24\xd5\x8d\x4d\xda\xf2\x8a\xc6\x3f\x6d\x1d\xec\xb3\x9c\xc5\x00\xf3\xac\x41\x0f\x70\x53\x65\x4a\x59\x55\x29\x0c\x7a\x31\xa6\x28\x18\x04\xd1\x19\x86\x74\x60\xc2\xe0\x8f\xd8\x81\xeb\x0b\xe4\xc7\x3d\x32\xcc\x9b\x62\x3b\x69\x78\x9a\x3b\x6f\x37\x4c\x81\x33\x3c\x4e\x90\x1e\xa8\x63\xf6\x63\xf2\xec\x6b\x88\x75\x78\x94\x8d\x6e\x4c\xd1\x97\xa4\x99\xbd\x44\x47\x42\xae\x8d\x6e\x9f\x42\xd2\x77\x78\x0a\x53\xe4\x20\x6b\x2b\x40\x11\x9c\xf4\xd7\x83\x82\xb6\xca\xb0\x75\xee\x1f\x9c\x01\x90\xa2\x95\xe5\x49\x8b\xc3\x0b\x88\xd8\xa8\xc1\xf1\xc7\xc1\x73\x5e\x16\xcd\xf0\x4b\x90\x10\xa2\xe9\x1f\xc0\x30\x6c\x14\x3d\x0b\xca\x32\x3b\x37\x35\xf2\x59\xd5\x26\x37\x12\x3d\xd7\x82\xc2\x38\x7e\xa1\xde\x3f\x48\xde\x9c\xb3\x3d\x14\xee\x7b\x86\xe7\x09\x15\x63\x6e\x82\x17\xe2\xa9\x98\x81\x86\x21\x90\xb5\x9c\x82\xc2\x07\x62\x18\x86\x4a\xe9\x1f\x0d\x65\x34\x60\x90\x3b\x4d\x9e\x6d\xb8\x10\x2e\x33\x44\xb1\x39\xc7\xf3\x78\x8b\xb4\x2b\x04\x3b\xf4\x99\x8c\x67\x18\x04\x30\x46\x6d\x3b\x05\xe2\x2b\xac\x4d\xd2\x26\x45\x6d\x6f\x47\x60\xc3\x79\x62\x2b\x0c\x4a\xa8\xad\xf7\x2f\xf1\x8a\xd6\xf5\xa3\xa8\x13\x8b\x41\xfd\xe3\x5d\x1e\x27\x40\x9d\xa6\x14\x4e\x50\xc4\xbc\x86\x58\x7b\x40\x9e\xd5\xfd\xe5\x67\x92\x8d\xda\x36\x7f\xe7\x2f\x8a\xce\x7b\xc7\x5d\x2c\x27\x72\x0c\xc6\x39\x38\xcf\x02\x59\x92\xc6\x3b\xe8\x45\x57\xea\xed\xfb\xbd\x1a\xe4\x7e\xa1\x9b\x9e\x71\xf2\xfe\xa0\xa3\x40\xfd\xbe\xd6\x0c\x9d\x00\x94\x22\xf6\x5c\x89\x49\x72\x33\x41\xd1\x6f\xd2\xbb\xf3\xcb\xe5\xdd\xc2\xdd\x53\x21\x16\x07\xc4\xb0\x59\x7b\x1b\xc3\x1b\x1f\xa7\x19\x0e\xc3\x0d\xe1\x14\x46\xca\x32\x9d\x53\x54\x79\xd2\x7f\xe4\x02\xe5\x58\x18\x8e\x1d\x52\x1f\xbb\xbb\x3d\x1b\x10\x2b\x52\xf4\xb1\x07\x2e\x75\x91\x66\x1d\x9a\x66\xb0\x87\x7f\xf0\x80\x30\xe7\xcf\xc9\xdf\x14\x4d\x38\x06\x38\xfe\x9e\xf5\x57\x33\xc7\xd7\x60\xdf\x82\xc2\x1c\x08\xa2\xcd\x23\x1a\xa1\xd3\x3f\x29\xc2\xf1\xfd\xe0\x3b\x70\x39\x67\xc3\xa5\xf8\xb7\x67\x9b\xb4\x65\xd1\x38\xcf\xa4\x01\x57\x0c\x62\xfe\xe3\xec\x65\x58\xf6\x2f\xf6\x63\x5d\x8f\xf9\xe3\xe8\xed\xe2\x5d\xf5\xf3\x45\x18\x9a\x8e\x0d\xaa\x1c\x5f\xcc\xc5\xc8\x44\x9d\x81\xc2\x65\xf2\x1d\x6a\x61\x23\x5b\xe7\xf0\x86\x60\xef\xfd\x82\x3a\xa8\xed\xd6\xac\xc8\xe6\x3f\x51\x09\x32\xef\x14\x92\xb0\x18\x51\x07\x14\x87\x15\x9a\x33\x63\x1a\xeb\x09\xf7\x2f\x00\x50\xb4\xf1\x21\xca\x4e\xd2\x99\x1e\x68\x69\x98\x27\x80\x99\xb2\x2c\x01\x4f\x31\x80\xce\xda\xfa\xba\x6b\xb1\x92\x04\xa8\x65\x08\x82\x84\x10\x02\x41\x5e\xea\xd9\x76\xe0\x06\x4d\x72\xef\x9b\x9f\xe2\xe4\xb9\xce\x1d\x9c\x78\x7e\x1a\x78\x92\x1b\xcd\x68\x39\xb7\x9c\xfa\xb7\x36\xfa\x57\x4d\x67\xff\x71\x37\x73\x7a\x99\xb1\x66\x28\xd9\xe8\x26\x62\xdf\x98\xc6\x3e\xdb\x51\xc8\x18\x20\x2e\x48\x5a\x16\xb9\xa9\x9f\x23\xb6\x2c\x5b\x22\xb0\xdd\x33\xdf\xac\xf0\xc5\x29\x5d\x14\x4d\x51\x80\xcd\x28\xdd\x51\xaa\x2c\xb3\xfc\xdc\x5b\x40\x3d\x3e\xe2\x8d\x70\x73\x97\x37\x26\x66\x3f\x12\x02\xe5\x56\xec\xfb\x85\x61\x1c\x3a\x05\x65\x4e\x6c\x81\x0a\xa0\xf9\xd8\xcf\xc2\x48\xe7\x99\x38\x92\xa1\xe5\xc3\x9a\x54\x17\x5b\xf7\xce\xf2\xa4\x24\x06\x22\xf1\x0c\xca\xc7\xf1\xc2\x9e\x4d\x05\x80\x2a\xc0\x4d\x70\x1f\xbc\x1d\xcf\x7e\x8e\x33\x9b\xf2\xec\xe8\x0c\x15\xff\xf8\xa0\x0f\xd1\xa0\xd1\xc8\x7c\xf2\x71\x65\xaa\x8f\x37\x28\x85\xa5\x80\xa8\xeb\xbe\x73\xf7\x4d\xfe\xcf\x4b\x26\x66\x62\x7c\xc8\x63\x64\x54\x97\x41\xcb\xde\x92\xc4\xea\x04\x0e\x4a\xef\x4b\x43\x09\x00\x24\xc0\x3c\x23\xc7\xa4\x1f\xc4\x40\x49\x0c\x1b\x5b\x2c\x59\x81\xde\xe2\xf7\x71\x9e\x0f\x69\x8f\x6e\x0f\x51\x7a\xdb\x00\xec\x91\xac\x68\x44\xdf\x7d\x3f\x67\x68\x20\xdb\xcc\x10\xf9\x06\x00\x92\x33\x34\x49\x60\x01\x1e\xb2\x78\x92\x85\x4e\x5d\x9a\xc3\x09\x5e\xc7\xb9\xe1\x5c\x8c\x7a\xe8\x38\x52\xb4\x10\x41\x58\x82\x4a\x4e\x98\x44\xed\x6b\x68\x64\x45\x71\x99\x26\xd7\x56\x61\xf8\xb1\x16\x7b\x6a\x63\x0c\xdf\xf8\x06\x40\x9f\x3b\xb1\x1c\x4e\x22\x18\xc8\xad\x51\x23\x3f\xa2\xdc\x98\x9d\x4f\x7d\x9f\x26\xbd\xcc\xc1\x9a\xf6\x35\x0c\x0a\xa8\x2c\x2f\x21\xac\x9b\x54\x7a\x0e\x6f\xb5\x95\x37\x1f\x06\xd8\xa0\x74\x00\xa6\x1b\xd0\xb4\x22\x52\xe2\xdb\xa2\x88\x5f\xce\xd0\xb3\x2c\x0b\xb9\x03\xc8\x84\xcb\xd3\x1c\xc9\x1f\x6d\xe2\x84\xb6\xfc\x60\x17\x21\x19\xa3\x31\xcb\x93\xe7\xef\x9b\x61\x78\x15\x0a\x22\x8c\xef\x1e\x59\xa4\x4a\x2c\x2d\xcf\xb0\x2c\x3d\x04\xf1\xb1\x92\x01\xa7\x4f\x9d\x37\xb9\x87\x37\xe8\x3c\x94\xca\xcf\x95\x3c\x56\x8b\xac\xbf\x3b\x66\xcf\xbf\xd1\x1d\x5a\x23\x86\x8d\x06\x8a\xf2\xe9\x27\xfd\x98\x97\x40\x83\x42\x6f\x23\x34\xe2\x8a\x51\x22\x81\x07\x01\xe6\x7e\x77\x86\x79\x48\x9c\xda\xd1\xfb\xa7\x6f\xb4\x2b\xe2\x60\x00\x26\xc0\x66\xa6\xdf\x0f\x33\x65\x81\x49\xaa\x76\xcd\x80\xbf\x32\x40\x89\xcf\xc7\x28\x9d\x17\x7a\xf1\xcc\x09\x7c\x1e\x10\xf5\x7e\xc6\xfd\x21\xcf\xa2\xd9\x18\x06\xc5\x30\x0c\x61\xde\x2f\x02\x68\x1c\xc6\x3c\x6d\xd2\x26\x15\x98\x26\x00\x74\xdb\xef\x67\x01\x51\x71\x0c\x43\xa5\x39\xa3\x59\x4c\xde\x7b\xdb\x9c\x75\xa1\x2c\xb2\xd1\x57\x7d\xb4\x30\x15\x9c\x27\x94\x23\xf2\x7a\xb6\xa7\x8b\x94\x20\x4d\xea\x4c\xd9\xf7\x28\xf8\x8a\x8c\xa4\xd6\x57\xf1\x6d\x5e\x0e\x54\x53\x7f\xfb\x14\xe6\x36\xa2\x06\x52\xbb\x79\x5c\x83\x41\xb4\xa6\x7f\xb9\x3b\x81\x68\x26\x4d\x03\x53\x6a\x8e\xb1\x2c\x4b\x3b\x67\x1b\xe1\xab\x3a\xf4\xe6\xb6\x2f\x80\xf0\x1b\xe4\x79\xc9\xa9\xae\xda\x5a\x1d\x64\xe0\x3e\xc2\x10\x44\x4e\x52\x14\xda\xaf\xc9\x7d\x2a\xf8\xab\xd1\x26\x43\x15\xd8\x49\x52\x33\x01\xa0\xf1\x3d\xc0\x70\x0f\xed\x00\xcb\x54\x7e\x40\x10\xc8\xe3\x74\xc4\xc0\x71\xb8\x24\x12\xf2\xf9\x8e\xb1\x36\x1f\xc4\x34\xc4\x0a\xdf\xe8\x44\xf1\xbc\x6a\x9e\x03\x94\x27\x8a\x44\x75\x82\x36\xf7\xfd\x37\xf5\xaa\xff\xc0\x8e\xe6\xec\x75\x66\xd6\xb3\xed\x47\xd6\x4c\x40\xca\x1c\x6d\x47\x48\xb1\xd7\xb4\x7e\x1d\x48\x02\xe7\xcf\x41\xc9\x63\x5d\x46\xe6\x45\x70\x86\x86\x18\xdb\x3a\xc4\xe9\x41\xf3\x6c\x0b\xfc\x6f\x3a\xbf\x10\xc0\x78\xf2\x78\x1d\x49\xc5\x4b\x95\xac\xfb\x1f\xa5\x5a\xc7\x19\xac\xde\x41\xd9\xa2\x2e\xda\x57\x6e\x44\x96\x0a\xc3\x79\x9a\x40\x7b\x73\x96\x63\x88\xb5\x18\xf3\xfc\x31\x5d\xcc\x53\x27\x5d\x2e\x38\x3c\x5e\xa7\x9f\xd5\x9b\xbd\x0b\x86\x15\x7c\xb9\x5d\xbe\x5a\x17\x9d\xee\xc5\x71\x9a\x9a\xbe\xdc\x04\x31\xb6\xe2\x9b\x7d\x9f\x9f\x27\x58\x36\x0b\x02\x47\xa2\x50\xb7\xdd\x10\x39\xaa\x04\xf9\xc4\x66\x50\x71\x6c\x0c\x4c\x06\xc8\xaa\xca\x20\xf4\x85\x63\x29\x1d\x76\xcb\xf8\x97\x7f\x98\xa4\x7a\xcc\xed\xca\xec\x17\x23\x8e\xfd\xc4\x54\xf6\x82\xd3\x05\x19\xe2\x24\x41\x29\x9d\x62\x20\x14\xc3\xda\xc4\xf3\x83\x20\x1e\x59\xa6\xa4\x73\xf1\xf9\xdd\xd1\x11\x9c\x94\x9d\x35\xb7\x2b\x1c\x65\xca\x65\x4c\xa8\x7e\xc9\xe7\xcf\x5f\x5a\x22\xda\x49\x0a\x79\x29\x82\xca\xf1\xee\x8d\xc3\x74\xb3\xa3\xf0\x27\x0f\x77\x3e\x04\x65\xb2\x2c\x24\x63\x5c\x03\x65\x0e\xfc\xe8\xa6\x6b\xa1\x16\xf7\xfd\x69\xdb\x40\x17\x79\x06\xa5\xc4\x1d\x2b\xcf\x92\x00\x20\xcf\x84\x85\x10\xde\xea\xe1\x24\xe5\x4e\x8e\x62\xf9\xd5\xd1\xd1\xb4\xf4\x1d\xa7\x07\x54\x9c\x56\xcb\x7d\x74\xda\xf0\x10\xc0\x02\x9a\x87\x3e\x6f\x35\x8c\x44\x18\xe2\x70\xff\xe8\x1d\xaa\xc1\xf9\x63\xc2\x96\x23\xed\xa3\xfa\x6d\xe6\xfd\x38\x44\xb1\xad\x27\x4c\x2e\xa1\x99\x97\x9f\xee\x40\xea\x60\x0a\x2e\xca\x42\x2f\xfc\x61\x27\x04\xbf\xfd\x50\x48\xc8\x69\xc6\xe7\xb9\x6f\xbe\xc6\x47\x65\xf9\xfb\xa9\xb8\xd2\x11\xfc\x4f\xe2\x4b\x15\xc7\x49\x91\x54\x03\x6a\x40\x15\x3c\x0c\x26\x00\xf0\xf9\x48\x65\x5f\xb0\x96\x07\xdd\xcf\xe4\x8f\xdb\x70\xc2\xf8\x95\x32\x84\x2a\x1d\xfd\xa0\x19\x9d\x8f\x9c\xa6\x5c\x94\xde\x40\x38\x14\xa5\xe9\xf8\x22\xbd\x5f\xd2\xe5\xc8\xfc\xce\x8d\x0f\x60\xb2\xfc\xc7\x19\xcd\x0b\x68\xfa\x7b\x95\xe1\x3c\x4c\x57\x11\x90\x04\x99\x63\x26\x30\x61\x98\x40\xf3\x31\x6e\x57\xfc\x58\x37\x4a\x7c\xd1\x21\x07\x0c\x21\xe4\x18\x92\xbb\x9e\xf0\xb1\x6f\x48\xfe\xe0\x62\x3c\x18\x22\x3f\x70\xa8\xb4\x12\x1a\x73\xae\x58\xf9\xca\xf1\xbf\x33\x0c\x71\x43\xad\x84\x97\xac\x9e\x72\xfb\x9f\x77\x7c\x4e\x1f\x65\xce\x54\x47\xb1\xac\xe6\xba\xba\x4c\xbe\x16\xa9\xfa\x61\x78\x62\xc5\x98\x33\xdf\x91\xca\xa2\x5c\xb4\x61\xd3\x3c\x35\xea\x26\x69\x70\x09\x9e\xe6\x58\xd2\xb4\x4e\x89\x19\xd3\x62\xd3\xdd\xc8\x73\x96\x35\xa1\xf5\x0b\xaf\x39\x9f\x2b\xe9\xa0\xc1\xc7\xa9\x51\x4c\x99\xa1\xfa\x38\x90\x20\x6e\x8c\x4b\xd1\xb8\xa0\x9b\x00\x62\x4e\x0f\x0b\x38\xec\xa6\xbb\x93\x7c\x8c\x38\xf7\x51\xfb\x2d\x89\xeb\xdc\x9f\xaa\xe1\x60\x65\xeb\xf3\xab\x51\x2c\x6d\xa2\xac\x08\x8b\x65\x56\xa0\xa7\x02\x6e\xd2\xe0\xbb\x51\x88\x53\x1b\xf2\xb2\x60\x5e\xb7\x23\x12\x08\x05\xf3\x0e\xf9\xa5\x37\x1f\xa4\x9e\x03\xaf\x16\x8b\x63\x08\x55\x37\x2f\xcb\x8a\xf3\x4e\x3a\xd8\x37\x6b\x0a\xfd\xa9\x94\xc9\xd3\xa3\x69\x70\x36\x3c\xc3\x40\x25\x6d\x07\xa6\x11\x51\x14\x28\xb5\xf3\xdf\xfb\x5c\xd1\x1c\x13\x0e\x0b\x95\x82\x89\x8d\x27\xe8\xe7\xfd\xc0\xd6\x7d\xe4\xa1\x98\xc4\x8f\xb7\x3c\x01\x40\x03\xf4\x4f\xe2\x3b\x10\xc8\x33\x4e\x9c\x8f\x95\x88\x09\x25\xf8\xcd\xbd\x7e\x9f\x7b\x5a\x8a\xd1\xa6\x2d\xfd\xed\x98\x2b\xa6\x89\x09\xa1\xd1\xf4\x7e\xd9\x26\x3c\xfa\x60\x0b\xab\x7b\x9e\x5c\x41\x74\x19\x94\xd2\xa8\x34\x1d\x41\xcb\xe4\xc5\xfd\xe5\x85\xc2\xa0\xe2\xf3\x84\x82\x61\x20\x7d\xcb\x7a\xcc\x2f\x06\x34\x3a\xec\x9e\xdf\x86\x4e\x65\x70\x0c\x8c\x2d\xd2\xea\x0d\x7f\xb4\xdb\xd0\x67\x97\xd2\xe2\x3e\xc0\x29\x5e\xe6\xa6\x10\x4e\x29\x66\x47\xd0\x87\xc4\x52\xf3\x84\xe1\x3d\x3d\xee\xb2\xa3\x37\x8c\x80\xca\xc2\xcb\x21\xb4\xdb\x6c\x96\x13\x5c\x32\xdf\xb1\x1a\x86\x60\xff\xb6\x3a\xfa\xfd\x51\xb2\xe6\x8b\xad\x2d\xaf\x1e\x2e\x14\xde\x6c\x35\x84\x49\x55\x3a\x7c\x26\x3b\x06\x64\xd3\x14\x49\xef\x6b\x76\x01\x05\x55\xcc\xfd\xf8\xa1\x59\x86\xeb\x42\x1e\x31\x01\x08\xcc\x85\xc1\x99\x1e\xf7\x64\x89\xaf\x1a\x24\x7b\x20\x45\x40\x37\xce\x60\x5d\xcf\x19\xaa\xb7\x67\x25\x79\x45\xe2\x89\x64\x3f\x5e\x68\x64\xb0\x91\x26\x30\xa6\x7a\x96\x96\x3e\xf4\x3d\x0d\x30\x0c\x0b\xcf\x73\x04\x14\x7e\xc3\x9f\xaa\x52\x87\x22\xe1\x61\xc6\x2c\x01\x7d\xbf\x8c\xb0\xca\x61\x5c\x2d\x46\xd4\xfc\x00\x35\x07\x4a\x91\x8f\x11\x39\xc6\xc6\xe8\x0a\x63\xf2\xce\xa7\x4e\x4c\xfb\xf8\xde\xf1\x5d\xfd\x65\x73\xbe\x8e\x5b\x3b\x50\xe1\x33\x3c\x50\x08\xd0\x2f\x56\x00\x1f\x87\x6f\x61\x32\x45\x1c\x8c\xbd\x44\x31\x38\xb9\x8d\x25\x6f\x3a\xf3\x78\x21\x52\xb7\xd9\x9a\xc1\x6d\x73\x2f\x6f\x2d\x17\xbd\xc6\xf5\x31\x85\x9a\x12\xf9\xcf\x6f\x1c\xff\x32\x6f\x66\x80\x9c\x79\x2d\x4c\x27\x73\xda\x65\xd2\x3d\x4a\x17\xb1\x08\x42\x3a\x40\xcf\x48\xb5\x4a\x68\x78\x94\xce\x16\x26\x8d\x15\x67\xc1\xa0\x29\xea\xf1\x64\x4d\x13\xc6\x79\xee\x12\x75\x77\x86\xd6\x3f\x9a\xbc\x78\xc5\xb6\xd0\xa4\xd0\xf8\x19\x50\x5a\x3e\x59\x51\x34\x27\xf1\x90\x30\xbc\xdf\x86\x79\x66\x67\x1d\xb8\xed\x29\x96\xe6\xb8\xef\xe7\x19\x60\x39\xba\x06\x51\xd6\x7e\x0d\x5e\x79\x70\x63\x2e\x82\x7e\x4e\x44\x11\x7b\xa7\x26\xcb\xca\x14\x05\xe6\xb1\x7a\x46\x81\xa5\x27\x38\x7f\x26\xd5\xae\xab\x9f\x17\x71\x77\x83\x12\xca\xfb\x71\x67\x10\xfa\x21\xc9\xfc\x78\xf9\x10\x46\xac\xb0\x39\x7e\x12\x61\x1a\x4c\x81\xee\x6b\x02\x87\x59\x24\x4a\x64\x06\x47\xee\x0e\x6f\xa3\x65\xb3\xea\xb7\x01\xec\x2d\x7f\xaa\x96\xce\x5f\x8c\xc2\xb0\xf3\x74\xc2\x13\xb7\x39\xc9\xb3\x79\x9b\x85\xce\xf0\xc4\x72\x03\xc2\xe9\xa2\x94\xbb\x7c\x25\xe8\x8a\xf9\x49\x46\xc8\x06\xc5\x88\x2d\x2b\x22\x9b\xee\x32\x1c\xba\x12\xba\x24\xc5\xf3\x9f\xdf\x20\x10\xa0\x1f\xcb\xf3\x84\x45\x04\xd0\x15\x6c\x3f\x9a\x8f\x40\xf4\x66\xea\x17\x59\x85\x5b\x80\x9b\x34\x34\x3d\x45\x01\x74\x81\x03\x9c\x39\x59\xb8\xb5\xd0\x41\x5a\xf6\x5e\xc5\xbd\xac\x2e\x1b\x9e\xb8\xf6\x93\x43\xc6\x8f\x6b\xfc\x30\xfc\x21\x4e\xf8\x90\x6a\x88\x86\xd0\x31\x2a\x22\x83\x60\x6c\x3f\xc6\x33\x4d\x37\x7d\x44\x48\x0b\x06\xcd\xfb\x04\x2f\x3a\x97\xa9\xb1\x3c\xf4\x18\xba\x6c\xce\x12\x9c\x9e\x47\xf4\x09\xff\x2e\x37\x86\xb5\x77\x1a\xea\x96\xf2\x12\x49\x79\x6a\xba\x4e\xa6\xf4\xb0\x64\x9e\x71\xa6\xfe\x1f\x30\xd1\x42\xb6\xab\x0e\xc2\x50\x1b\x24\x00\xb1\xc2\x45\x91\x9a\x5f\x8c\x21\x70\x95\xa2\xd0\xc7\xbe\x70\x97\x17\xc0\x28\xac\x49\x8b\x5a\xa9\x9a\xc0\xd1\x32\x48\x01\xaa\xc7\xcc\x31\xaf\x6b\x37\x3e\x0e\x51\xc1\x85\x2f\xdb\x5e\xfc\x93\x7e\xc8\xfb\xd2\x59\x81\xaf\x82\xbd\x30\x07\x48\xf3\x67\x37\x92\x26\xd6\x52\x0f\xd3\x19\x0a\x50\xa7\x85\xad\x08\x5a\xd6\xc9\x43\x1f\x4a\x16\x09\x86\x72\x34\xf8\x6e\x8b\xf3\x62\x79\x4f\x84\xb3\x5f\xdb\x19\x50\x6f\x95\xf1\x41\xd1\xe4\xc1\x39\x23\x9e\xc7\x69\x49\x33\x57\xbf\x81\xc2\xaf\x4c\x31\x45\x2a\x6e\x5b\x1a\x48\x41\xc8\xe8\x23\x66\x00\x23\x46\xec\xd0\x3e\x60\x9b\xb6\x24\x1f\xc5\x27\x42\x09\x1a\xa4\x74\x0e\xff\xa0\x93\x1a\xb7\x34\xa2\xd7\xe2\x56\x4a\x46\xb3\x33\x1a\x66\x84\xa9\xcf\x7e\x8e\xac\x33\xa7\x6b\xed\xb8\xbd\x43\x2c\x1a\x46\xe1\xc8\xcc\x51\xc3\x44\x40\x34\xe5\xed\xc8\x07\x94\x02\x85\x10\xc6\xf9\x4c\x94\x3f\x9a\x0a\x27\x4a\x2f\x95\xdc\xfb\x4b\xb4\xc2\xb2\xf9\xc4\x77\x5a\x90\xe4\x38\x0e\x33\x40\x71\x82\xc9\xad\xf7\xcd\xc4\xb6\x74\xf1\xf5\x0a\x20\x7e\x33\x59\xca\x94\x2e\x00\x80\x3c\x8c\x23\x44\x2d\xe4\xb6\x3e\x9e\xee\xfa\x48\x8e\x31\x38\x01\xc1\xe3\x3a\x28\xa6\x81\xbd\xf8\x1f\x6b\x97\x25\xa0\x54\xe2\xdf\x79\xe1\x2d\x48\x65\xc7\x4a\x91\x95\x0a\x46\xe1\x6f\x68\xfa\xb7\x8f\xe1\x71\x7d\xf5\x41\x3c\x84\x53\x3a\xa8\x85\x44\xc6\x97\xd6\x92\x44\xcc\xb9\xf7\x8f\xef\x28\x8a\xc2\xc5\xbd\x10\x9c\xe7\x28\x5f\xb3\xa4\xa6\x08\x00\xec\x7e\xc1\x6d\xca\x8c\xaf\x62\xaf\xd2\x2b\x5d\x04\x52\x5d\x8c\x8f\xc5\xeb\x0d\xc0\x1f\xa6\xd8\x06\x71\xfa\x0d\x4c\x67\xf4\x96\xfe\xd7\xde\x64\x1e\x9e\x1b\x5d\x94\x52\xd7\xf3\x06\x47\xe9\x6e\x8e\x85\x98\xe9\x62\x14\x89\x46\x78\xee\xed\xc7\x6d\x7d\x00\xf1\x30\x99\x07\x0e\xbe\x3a\xa9\x41\xca\x99\xe2\x6e\x01\x92\xc3\x49\x49\x66\xd8\x19\x02\xb4\x3c\x57\xc3\x68\x9e\x75\x03\xe5\x52\x88\x54\x45\xb0\x4e\xe7\x7f\x6d\xc1\x67\x79\xee\xde\x58\x57\xf8\x28\xe1\x19\x06\xa3\xa1\xef\xe9\x41\xf5\xa9\x3c\xdf\x05\xf5\xb9\x85\x8f\x67\xb3\x2c\x6b\x55\x5f\x76\xa8\x84\x7f\x1f\xc8\x6a\x5f\x26\x08\xed\xb2\x7e\xb5\x87\xd1\xa0\x92\x39\xcd\x34\x0e\xed\x08\xe4\x10\x35\x8e\x6b\x7f\x53\xe7\x50\xc0\x8c\x73\x6e\x70\x2d\x60\x22\xcc\x22\x3b\x17\xfb\x74\x41\x83\xb2\x6d\xe3\xe7\xf9\x74\x6f\x05\xff\x26\x8c\xc7\x83\x7a\x49\xe6\x2a\xfa\x51\xc1\xb5\xa5\xc9\xbd\xb7\xd6\xa3\x8d\x2f\xa5\xb7\x98\xf5\x42\xcc\x37\x3a\x94\x9d\xb8\x9e\x89\x4f\xba\x49\x08\x8f\x06\x0d\x7d\x3d\x10\x51\x7b\x3c\x8f\x93\x74\x2e\xdb\xf2\x6f\xf2\x36\xe5\x72\x7a\xeb\x6d\x2a\x14\x0e\xe4\x8f\x2f\xc5\x4f\x6c\x50\x15\x4f\x34\xcb\x6e\x66\xd3\xbe\x26\xae\xd3\x3b\x1b\x65\x60\x67\x03\x99\xe2\xf8\x12\x98\xf8\x98\xcf\x17\x22\x34\x43\xcc\x39\x81\xa2\xfa\x17\xa2\xd2\x70\xd1\x7f\x58\xca\x20\x9c\x42\x51\x17\x38\x31\x8a\xf4\x8d\x11\x39\x18\xc5\x39\x30\xba\xf8\xce\xc9\xb0\x42\x27\x46\x1a\x62\x20\x92\x92\x24\xd2\x6e\x0e\x45\x5b\xaa\x6c\x8a\xe3\x51\xc3\xaa\x41\x1f\xcb\xd2\xfc\x2f\x6b\x7d\xd8\x44\x6b\xa6\xf7\x43\x3f\x12\xfb\x91\xbe\xcd\x5b\x9c\xc1\xc0\xdb\xc7\xad\xc5\x77\x67\x88\x22\x0d\x99\xce\xec\x41\xc1\x62\x2f\x6c\xca\xa0\x94\xf2\x6c\x33\x4e\xaa\x10\xfb\x40\x21\x4a\xed\xba\x48\xd6\x0c\x6f\x79\x02\x9b\x6f\x8a\xf0\x1a\x7c\xb2\xdc\x05\x7f\x25\x7c\x10\x33\xa9\x07\x8f\x32\x9c\x6b\x76\x5b\xd3\x55\x6a\xb2\xc4\x49\x9c\x56\xec\x78\xbd\x4c\x98\x37\xbe\x14\x74\x0c\x53\xd1\xfc\x95\x4b\x60\x7c\x4c\x7c\x8a\x2a\x34\xb9\x73\xcc\xbf\xdd\x3f\x79\x77\xc0\x50\x06\xb9\xc2\xda\xee\x6e\x90\x8c\xcf\x31\x86\x50\xa4\x0f\x76\x62\x2f\xb1\x31\x09\x44\x6e\x26\x48\x0f\x6c\x0c\x09\x7c\x0b\xb4\x1d\x1a\xa3\xce\x63\x34\x8d\xad\xb4\x6f\xe0\xf7\xa1\x0f\xe7\xda\x6b\xba\x7d\x83\x6e\x6b\x02\x6f\xed\xe7\xd2\x11\x3d\xf4\x03\xee\x1a\x2c\xfb\x33\xb6\x04\x14\x69\x61\x98\xf4\x2f\xf5\xed\x6f\x88\x3f\x0b\x7b\xd0\xe0\xf0\xe4\xa0\x56\x5c\x5b\xbb\xd0\xb8\xb1\x3d\x49\x28\x15\xf1\x73\xd6\x09\xfc\x7e\x19\x64\x34\xbd\x7a\xf0\x7b\xd7\x55\xc4\xd5\x08\xd2\xef\x86\x26\x91\xfe\x83\x82\x9d\x50\x42\x3b\xd0\xf7\x68\x20\xf6\x96\x2b\x47\xe0\x21\x48\x7e\x86\x29\x32\xac\xc3\x4b\xa0\xbc\xda\x06\x0c\x3e\x00\x02\x7d\x12\xf5\x1b\xc2\x3b\xa2\xc4\x57\x79\x62\xe1\x4b\x4c\xee\x27\x0b\x07\xe2\x08\x6b\x4b\x28\xac\x55\xcb\x7f\xd6\xeb\x7b\x9d\x29\x0f\xaf\xd9\xcd\x8a\x33\x62\x14\x63\x69\x5e\x29\x1b\x09\x74\xaa\xbd\x83\x5f\x16\xe9\xcb\x71\xe0\xf6\x2d\x7a\x5a\xaf\x96\x7e\xa7\xda\xb1\x89\xee\x64\x3b\xeb\xe0\xf0\xf6\xa6\xc1\xb3\x8d\x50\xb6\xf6\xd9\x8b\x08\x2d\x9d\xd6\xa0\x02\x9b\xc4\x23\xbe\xef\x8d\x8e\xca\x9c\x26\x76\x91\x6a\x4b\xdd\x9a\x8b\x0d\x3a\xb3\x98\xad\xfc\xa1\x13\xf3\xa2\x0d\xf1\x61\x7d\xda\x1c\x81\xb1\xea\xbd\xac\x22\xa4\x05\x79\x06\x54\x6a\x59\xb4\xfb\xee\x8d\xb2\x7e\xe0\x15\x63\x29\x23\x07\x54\x09\xb5\x36\x93\x34\x75\x9f\xdf\x6c\x1a\x5b\x80\x44\x8c\x7a\xea\x60\xd4\x9f\xee\x95\xd8\x8f\x53\xa0\x12\x9e\x74\x73\x55\x60\xe2\x49\xe4\xd0\xfc\x12\xaa\x39\x12\xf4\x20\x4b\x4e\x69\xac\x3d\x8a\x24\xf3\xec\x3d\xc9\x19\xae\x22\x9d\x08\xad\xb4\xc6\x1b\xb6\xb5\xd2\xbb\xe5\x4d\x03\xe7\xfc\x9c\x73\x5e\x6f\x07\xc8\xae\xda\x7a\x2b\xa1\xdb\xfa\x20\xd1\x9e\x66\xaf\xe6\x9a\x3e\x9c\xfb\x8e\xd7\x17\x07\xf8\xd7\xa3\xe3\x63\x24\x42\xc8\x7b\x40\xe5\xd0\xee\x4e\x6d\x4b\x90\x81\x75\x95\x24\xff\xb1\x59\x41\x28\x8d\xcd\xd5\x80\xde\xc5\x96\xc2\x49\x4e\xd8\x9a\x1d\x41\xb0\xdf\x5a\x2a\x81\x3e\xf6\x8f\xe3\x75\xb2\x3d\x30\x3c\x6d\x86\x98\x7d\x9b\xd0\xd9\x48\x9d\x1c\x0e\x26\x1d\x2d\x7a\xe3\x09\x6b\xb9\xb5\xf9\x81\x95\xbc\xd5\x19\x00\xf0\x86\x3b\x9d\x9b\x55\x1e\x2b\x8c\x34\x48\x5a\x0b\x52\x2d\xbb\xe4\xde\x4c\x8b\x32\xcf\x49\xb0\xad\x8a\xd3\xcd\xca\xf3\xb7\x5c\xd3\x22\x35\x35\xbd\xd7\x9a\xdf\x69\xf2\x64\x7e\x63\x5d\x9c\x73\xe5\x6b\x29\xac\x25\x43\xe1\x0d\x85\x01\x9c\xb7\xff\xee\xe3\xa8\x7d\xdf\x0f\xfb\x38\x08\xfa\x38\xf0\xfb\x39\x09\x7b\xa2\x08\xf1\xa5\x66\x67\x4e\xa2\x4b\x33\x5b\xe5\xc7\x12\xbc\xde\xbc\xbb\xf7\x5c\x34\x9a\xbd\xd8\xe2\x26\xcd\x76\xba\x37\x2c\x9d\x90\x7b\x1a\x60\x45\x6b\xcd\xf1\xed\x19\xbb\x8b\x7f\xec\xd9\x01\x65\x36\x65\x44\x4f\x03\x64\xe7\x3d\xff\xab\xfb\xbe\xef\xbb\xbe\x1f\x07\xf1\x2a\x46\x2a\x9b\xae\x0e\x02\x49\xc7\x0a\xcd\x6b\xd2\x4c\x0a\x07\x9d\x0b\x57\x9d\x2a\xa0\xe8\x23\xe5\xaa\x4a\xbb\xf9\xaf\x4e\x32\xc5\x9b\x1d\x92\xc8\xbf\x48\x03\xef\xc1\x56\x7f\x15\x98\x06\x25\x00\x6a\xff\x50\x63\x35\xe3\xa6\x12\xa1\xc5\x79\x52\x82\x13\xeb\x12\x3d\xfc\xb6\x55\x81\x19\x7b\x7a\x24\xee\x03\x44\x1c\x28\x7e\x97\xe8\xa2\x97\xba\xd6\x93\x51\x46\x7a\x72\x96\x14\xd8\xeb\x15\x5d\x15\x3e\x98\x3b\xd9\x7a\x72\x7b\x43\x2e\xc0\x8c\xc0\x67\xbe\xa8\x3d\x4a\xc6\xad\x0c\xc9\xaf\xf9\x1b\x52\xa5\x24\xec\xa4\x38\xc7\xa4\x34\xfb\xc5\x56\x54\x9d\xa9\x73\x29\x47\xad\x10\xf1\x5c\xc2\x3b\xf6\x47\x08\xe9\x4f\xc8\x79\x1e\x57\x25\xdc\xb0\x2f\x49\x96\x23\xcb\x17\xa5\xeb\xba\xfa\x95\xed\x60\xf7\x04\xc3\x52\xa8\xf6\xe9\xd7\x73\xe2\xf0\x0e\x34\xbc\x2b\xf9\x1f\x49\xab\x69\xe6\xa1\xde\xf2\x36\xa5\x9f\xf5\xfc\xb6\x68\x90\x9b\x64\x98\xb7\xf4\x77\x4f\xf1\xef\xee\x52\xe1\x76\x72\x5c\xe8\x2e\x38\x69\xb3\xb8\xd1\xd2\x2e\x53\xa7\x52\xc5\x43\x89\xe0\xfa\xeb\xcb\x4f\x25\x33\x62\xd0\xb2\x2a\xf7\x46\x1d\x6f\xdc\x64\xb4\x7b\x62\x68\x77\x6c\x68\x42\x20\x1e\xff\xef\x4e\x32\x41\xb0\xf6\xf0\x4d\xba\xce\xc8\x2c\x8c\x09\x36\x8c\xbe\xf5\x38\xbc\x86\xf3\xab\xcf\xb7\x85\x0e\x24\xba\x4f\xa4\x31\xcc\x4b\xf2\x27\x99\x66\x5a\x9e\x6d\xd5\xd4\x7a\x53\x99\x9f\x81\x80\xcf\xf7\xaa\x04\xa0\x2e\xe4\xf0\x6f\x65\xd6\x87\x3e\x15\x85\x3e\xd5\x07\xde\x55\xc7\xc5\x83\xaf\xcf\x0e\x42\x7f\x56\xb4\x96\x7f\x40\x64\x9c\x68\x9a\xa1\x93\xbe\xb2\x02\x7d\x5a\x25\x6c\x32\x5a\x6a\x3c\xef\x0c\x83\x1e\x93\x0a\x0b\x37\x38\x1e\xba\x40\xb5\xd0\x4e\x99\x41\xed\xe7\x2a\x70\xa6\x68\xed\x15\x99\x1e\x07\x2a\xbf\xb9\x81\x45\x05\x6f\x6d\x2d\x6e\xe5\xae\xd7\x4b\x8f\xf6\x66\xaf\xde\x46\xb7\xe6\x0a\xb7\xd6\x46\xa8\xce\xab\x1e\xb2\xff\x69\x28\x1d\x32\xbe\xd6\x3b\xdb\x93\x58\x2d\xfc\xde\x9e\xff\xb5\x23\xef\xcb\xfe\xc9\xd4\xa6\x41\xa9\xbd\xc6\xbb\x51\xca\xab\xbd\xea\x43\x1b\xb4\x51\x2a\xe6\x70\x87\x8b\x0b\xa5\xb4\x3c\x95\xce\xdc\xc1\xe9\x65\x04\xab\x4f\x32\xd9\x58\x8a\xa2\x88\xb5\xa5\xca\x86\x45\x8c\xfa\xfa\xf0\x4e\xfb\x48\x58\xfd\x2b\xa4\xbf\xfb\x4f\xfd\x47\x3a\x10\x43\x3a\xc4\x4b\x32\xcc\xeb\x4c\x16\xfd\x9c\x04\x13\x66\xfd\xed\x2a\xa8\x6c\xef\x08\x89\x87\xf8\xdf\x2e\xf9\xfb\x3b\x27\xc5\x99\x52\x0c\xe4\xc4\xeb\x97\x87\xc9\xdc\xf0\x9a\x58\x0f\x56\x1f\x6b\x29\xe3\xda\x68\x20\xfe\x88\x50\x0d\xd7\xef\x46\x15\x99\x2a\x90\xc7\xbc\x00\x33\x9a\x95\x66\x39\x82\xb4\x08\x1e\x28\x70\x88\x47\xd7\xc7\xb7\x6b\x65\x27\x24\x90\x6c\x0f\x0b\xd1\x92\xaa\xa1\x5e\x63\x50\xa6\x14\xc5\xb8\x0c\x30\xcd\xb1\xdd\x46\xd3\x81\x77\xd9\xf1\xbe\xfc\xe3\x35\x34\xee\x7c\x6b\x99\x54\x9b\xc7\xac\x59\x44\xb7\x3c\xfe\x73\xeb\xae\xf7\x26\x68\xbc\x45\x83\x52\xa5\x28\x5b\x25\x0a\xf7\x17\xd3\xfa\x35\x41\x3b\x46\x3a\x7b\xcc\xa0\x21\x21\x0b\xc7\xdf\x29\xb0\xcd\xb6\xcf\x07\xa9\xfd\x69\xe5\xaa\xfc\x64\x34\xe1\x7b\xd0\x15\x5b\xde\x85\x4f\xf5\x68\xab\xf6\x6a\xab\x06\xcc\xc9\xfd\xda\x9e\xff\xb7\x1a\xd6\xa3\xb5\xa6\x8b\x30\x66\x88\x30\x9f\x4b\xe3\x73\x0b\xd6\x0b\xff\x80\x6d\xc5\xb4\xd1\xdf\x15\xd4\x34\x52\x67\x4c\x95\xf1\xf3\xa9\xe2\xa1\x42\x88\xfc\xca\x20\x38\xa5\xd7\xd4\x43\x0f\xae\x3e\x20\x67\x89\x2a\x77\x56\x68\x23\x9d\x86\x8b\x99\x11\x42\x12\xf9\xae\x0f\x44\x22\xc3\x8c\xe8\xd5\xe5\xb7\x53\x4b\x11\xdf\x09\x80\xd3\x07\x18\xaa\xcb\x46\x34\x57\x40\x34\xd7\x43\xf5\x36\x42\x90\x5c\xf4\x52\xd3\x75\xd6\x59\x71\xba\x4d\xf9\x54\xf2\x53\x8e\xa0\x3c\xb1\xc3\xed\x08\x8a\x21\xfa\x79\x45\x88\x0b\x32\x6f\x47\xac\x2f\x1a\x93\x5f\xf9\x72\x8c\x80\x62\xee\x0a\xc3\x28\x66\xac\x0d\xd0\x8e\xaa\x4f\xe2\x11\x71\x33\x56\xf0\xad\xa6\x91\xda\x4b\xff\xeb\xc0\xb9\xf9\x2d\x39\xab\x93\x7d\xbe\x5f\x9d\xf2\x37\x1f\x69\x82\xe6\x5b\x81\xa6\xf1\x81\xa5\x8b\x1f\x95\xfd\x55\x09\xdc\x9d\x6e\xa7\xab\x10\xd9\xd9\x15\x96\x82\xa5\x01\x96\xee\x0b\x9a\xef\x11\x8a\xec\x11\xaa\x77\xf1\xcf\x36\xd4\x94\x8b\x78\x16\xce\x45\x1b\xce\x45\x07\x5a\xca\xb3\xa5\xc1\x9f\x48\x86\x79\x45\xa0\xf1\xec\x08\xbd\x3c\xbd\x45\x77\x03\x5f\x19\x68\x48\x08\xbf\x56\xf8\xbd\x34\x03\xbd\xd9\x60\xd6\x08\x12\xd2\xa0\xa8\xb2\xab\x72\x7d\x86\xf9\x48\x33\x34\xad\x6e\xac\xc7\x23\xd5\x96\x06\x2a\x43\x53\xb2\x04\x6b\xd0\x55\xbf\x80\x7b\x82\x35\x41\x76\xe8\xc2\x8d\x34\x5f\xe0\x24\xf0\xd7\x85\x4c\x06\xb6\x51\xd6\xff\x3b\x91\x88\x02\xc0\x76\x1e\xf6\x64\xd1\xcd\xef\x43\x0f\xa1\x83\xc3\x85\x19\x3e\x51\xef\xa4\xc8\x1c\x8f\x3e\xc2\xa4\x6f\x9c\xdd\xd1\x19\xe4\x32\xb0\x44\x28\x6e\xdf\x29\xfd\x11\xbb\x1e\xbc\xa9\x39\xb8\x7e\xfa\xf6\x66\x17\x72\x04\x86\x43\xb1\xfe\xaf\xf1\xd4\x9c\xbd\x94\x8f\x29\x92\x8a\x77\x98\x8c\x0d\x37\x44\x73\xe5\x28\xc6\x9b\x48\xf6\x60\xc0\x8a\x63\x0a\x1d\x0d\x3e\x88\xd0\x20\xd1\xd1\xdd\x07\x77\x3e\x0c\x25\x94\x85\xbd\xeb\x35\x57\x7f\xb0\xfc\x61\x64\x73\x7a\x9c\x6e\x96\x77\x92\x49\xd0\x35\x40\xf7\xd5\x13\x4a\x6b\x0f\x48\xbd\xc1\xc1\x79\x08\xf8\x4c\x3d\x8a\x5d\x7f\x84\xd1\x22\x5d\x9c\x6f\xc1\x24\x7f\xc7\xbb\x54\x1f\x7a\x76\x3b\x42\x76\x3b\xc2\x9c\xb1\x32\x5c\x49\xa8\xd9\x83\x35\x77\x6d\x24\xf9\xd7\x78\xf5\x37\xbc\x75\x4d\x8e\x7d\x21\x4d\xc5\x39\x5d\xe0\xd4\xae\x15\x38\x17\x3a\xd0\x48\xb3\x31\xfd\xf6\x9d\x4e\xc6\x24\x56\xdc\x3e\x58\xc4\xd9\x96\x6d\x08\x01\x1d\x90\x67\x43\xd6\x73\x42\x70\x68\x30\x22\x87\x9b\x0e\x27\xf1\x59\x94\x86\x3c\x69\x10\x17\x77\xee\xb7\x1a\x33\xf9\x45\xc9\x0e\xfb\x33\xc0\x48\x26\x08\x9a\xa5\xfd\x68\xe8\xdc\x5f\x3e\x40\xfa\xb4\x04\xa8\xec\x70\x2e\xd9\x0d\xbd\x5c\xcc\x22\x4c\xe9\xa3\xdb\xaa\x59\x4c\x70\x3d\xa1\x38\xdb\x1f\x61\x21\xe8\x21\xf5\x25\xf0\xdf\x8c\xd6\x5e\x1a\x06\x16\x92\x87\xf9\x1e\x96\x26\xa3\x43\x23\x71\x94\xe3\xc3\x1c\x10\x86\x1f\x60\xc7\x54\x22\x3b\x25\xba\xcc\x33\x20\xf9\x7b\x12\xe4\x63\x82\xf6\x73\x14\xd4\x53\xfc\xb3\xbb\xe4\x97\x2c\xf3\xe2\x74\x8b\xd2\x4a\x11\xcf\x92\x26\x43\x0d\xb8\xc1\xc1\xbb\x59\x86\x08\x79\x65\x26\xb5\x64\xdd\x0c\xb7\x8f\xca\x22\x40\x07\x44\x6e\x57\x89\x6e\xea\xe0\x05\x2f\x29\xd2\x67\xa7\x02\xe4\xaa\x98\xf0\xb7\xba\x5c\xf2\xba\xf9\x81\xba\x1d\x61\x30\x07\xb6\x07\x54\xbc\xd8\x31\x51\x3b\xa3\x8f\xe4\xce\x28\x2b\x19\x54\x9a\xd8\x89\xe3\xda\x4f\xbd\xd9\xc2\x1b\x10\xf2\x89\x72\xf3\x97\xee\x28\x85\x63\x03\x0d\x47\x96\xc0\x42\x20\x3f\xad\xdc\xca\xf4\xa2\xc0\x6c\x98\xcc\x67\x9b\xf4\x3f\x74\xa2\xa9\x1f\x6f\xdd\xa0\x64\x49\xc6\x99\xcc\x2a\xa9\x65\x63\xb9\x9b\x97\xc4\x38\x31\x6c\x49\x82\x7e\x0e\x1c\xdc\x29\xd6\xe4\xcd\xc2\xef\x9d\x05\x50\x19\x9e\x32\xc2\xa8\x01\xa2\x5b\x74\x01\x56\xf8\x5f\x9b\x1b\x0a\x4a\xc5\xa9\xa6\x4e\x91\xcc\x9c\x9f\x19\x30\x49\xa0\xbe\xf0\xd4\xae\x59\x01\x9d\x37\x2c\xa2\x30\xf6\xa8\xc9\x33\x50\x3d\x27\x1f\xee\x0f\x4c\x8f\xca\x73\xce\x2b\x5c\x48\xd8\x12\x2a\x49\xad\x06\xe8\xde\x93\x68\xd4\xf9\x6f\x03\xa5\x1d\x10\x2b\x44\x77\x83\x54\x47\x61\xf2\x87\x1d\x3f\xeb\x2c\x9a\x4a\x67\xa7\x9d\xfb\xf2\x35\x4d\x16\x06\x5e\xea\xf1\x76\x7e\xa8\xdd\xf5\x10\xe3\x2d\x76\x67\x7b\x4c\x41\x39\xfa\xf6\xaf\xea\x2f\xc5\x89\x59\xb6\x0a\xdc\xc7\x12\xfc\xef\xed\x57\x27\x75\xd1\xa5\xd9\xb5\xb7\x46\xf1\x71\x6a\x9e\x28\x53\xc3\x54\x5e\xac\xd1\x12\x1b\xc8\x9e\xfa\x51\xb2\x28\xdf\xde\x73\x1a\xee\x11\xe9\x3b\x95\xe3\x31\x16\xe3\x5d\x86\x9c\xb1\x99\x3e\x1f\xee\xf3\x71\x3c\x9b\xf5\x45\x41\x6e\x6f\xcd\x11\x5b\x08\xf5\x55\x3c\xc1\xf5\xd4\xeb\x0a\xf6\x3b\xa7\x4e\xcd\xdd\x21\xf1\xd9\xaa\x9c\xff\x4c\x72\xba\x0f\x0b\xe2\x32\x2f\x87\x2b\xe4\xd1\x4e\xd0\x49\x92\x47\xdb\x03\x11\x00\x19\x2b\x7b\x38\x0f\x1f\xbe\xa2\x41\xc7\xd1\xfd\x13\x91\xa2\x45\x56\xf4\xb7\xd2\xea\x6e\x23\xe6\x12\x68\xfe\xb0\x01\x40\x8b\x59\x61\x35\x63\xcb\x50\xfa\x79\x98\xc1\x9f\x55\xb9\x80\x10\x2d\xd8\xf7\x72\x67\x71\x26\x6f\x50\x65\x15\x78\x82\x95\x9e\x47\x9b\x32\x16\xfa\xda\x4c\x8e\x33\x43\x1d\x09\x9b\xb9\x48\x8a\xb0\x1c\x56\x60\xcf\x49\xd0\xbb\xb7\x52\xdc\x3a\xc4\x64\x0f\x80\xc4\xae\x7f\xda\xd1\x22\xcb\x8c\xa4\x8f\x02\x2f\xc4\xb1\x86\x40\xdb\xf5\xb2\xe3\xad\x7d\x83\x82\xef\xcd\x98\x70\x06\x81\xec\x0c\x17\x73\xc4\x28\xdd\x7c\x31\xd6\x52\x59\xaa\x47\x29\xb7\xbe\x28\x77\x59\xa8\x04\xdd\xd2\x1f\x5d\x1a\xed\x44\x1a\xed\x98\xee\x68\x1b\x5c\x8a\xe2\xe0\x8b\xd1\x6e\x68\x6a\xda\x9d\x2f\x38\x03\x29\xf8\x1a\x47\x5a\xda\x39\xde\xe8\xbe\x26\x45\x68\x0e\xe6\x5a\x83\x10\x99\x4a\x4b\xf8\xb2\x93\x7f\x62\xe9\x8e\x50\x8c\xc8\x6f\x98\x39\x84\x3d\x39\xcb\xa3\xb1\x2e\x64\x55\x62\x14\x03\x41\xb9\x7b\x42\xd4\x8e\x3e\x5b\x2b\x2b\xe2\x4f\xd5\xda\x37\xb3\x19\x54\xe4\x3f\x59\xe9\x0f\x73\x13\xd5\x42\xfe\xed\x86\x1f\xb8\xcc\x6d\xa6\xf2\x87\x59\x50\x63\x74\xeb\xea\xea\x0e\x11\x21\x99\xd2\x2d\x09\x7a\xc1\x52\xc3\x9a\x97\x78\x30\xdf\x02\x2b\x4f\x2c\x7f\x74\x9d\x84\x30\x5f\x79\x96\x40\xed\x1f\x22\x3f\xb9\x66\xff\x15\x12\xfc\x1b\xb0\x60\xe4\x87\x42\x7a\x91\xde\x6c\x19\x43\x90\x6c\x49\x56\xe7\x0b\x9c\xaf\x8c\x3f\x65\x71\x8a\x5d\x6b\x22\x34\x93\xdc\x47\xea\x25\xba\xc8\xdb\x15\xde\x99\x8f\xfa\xf4\xd0\x38\x4b\x96\x50\x33\x0f\x51\xfa\x3b\x54\x34\xda\x33\x78\xef\xb8\xf6\x18\xf3\x01\x0f\x70\xf2\x54\xfa\xb0\xfd\xa9\xc1\x32\x6f\x0d\x44\x76\x22\x84\xeb\x3d\x9a\x59\xdf\xea\x8b\x03\x95\x1f\xf6\x60\x9d\x5d\x3f\x0f\xbf\x7d\x7c\x84\x87\x29\xee\x28\x99\x63\xe6\x86\x7d\x3b\x27\x05\xc5\xdb\xd7\x5c\x59\x2e\x81\x67\xeb\x43\xb1\x7d\xf0\x21\x11\xc1\x68\xc0\x2e\x31\xa5\x4e\x96\x7b\x18\xcd\x49\x65\x83\x4a\x65\x53\x7d\xe9\xac\x9c\xe9\x12\xbc\x1d\x37\xf9\xfc\x87\x00\xda\xf7\x53\x7d\xcb\xbf\xd8\x5d\x0b\x4a\x8d\x1b\x00\x70\x96\xc7\x55\x5e\x35\x09\xd3\x22\x58\xcb\xd7\x92\x59\x49\x06\x80\xa4\x1c\xce\x72\x84\xc8\x96\x64\x4e\x85\x7a\xf4\x63\x83\x02\x2a\x16\x40\x01\x18\xaa\xe0\xdf\x84\x18\x33\x00\x8c\x7e\x2f\xb9\xbd\x94\x2e\x69\xbd\xbf\x9e\x02\x53\x08\x28\x12\x6e\xa0\xf4\x09\xac\xeb\x27\xdd\x25\x15\x9d\x8e\xcd\xe2\x50\xc1\xb3\x80\x5a\x9f\xdf\xce\x26\xb1\xa1\x56\x68\xf6\xe3\xdb\x2f\x49\x83\x42\x0b\xa2\xa3\xd4\x44\xd5\x5f\x69\xec\x37\x8d\x7c\x82\xf6\x00\xbc\x83\x9d\x41\xa5\x26\xe3\xe3\x9a\x9a\xec\x43\x64\xe7\x8a\x40\x54\x7e\x86\xcf\xb7\x18\x83\x03\x7b\xe4\x99\x81\x46\xb7\xf4\x62\xe3\x8e\x4d\xb8\xa4\xf3\x14\x94\xa7\x78\xc1\x14\xd4\x58\x75\x15\x4a\xc8\x2f\x85\x3c\x11\x40\x56\x4f\xe5\xd7\x95\xa9\x24\x87\x36\x5d\x03\xc3\xea\xc0\x90\x0c\x04\xdc\x03\x66\xce\x0f\xac\x7e\xe6\x17\xfb\x89\x7c\xfd\x6a\x0f\xbd\xb9\x0c\x59\xee\xb0\x22\xf0\x7a\xa9\xb6\x26\x4b\x9c\xc8\x76\x81\xb1\x25\x08\xe9\xec\x75\xed\x6e\x34\xb5\x23\x8c\xf4\xc4\xf2\x50\x9d\x91\x04\x95\xd2\x2c\xe9\x2a\xe1\xd3\x1e\x18\xb5\x63\x74\xa6\x7e\x0f\xac\x3c\x3a\xd5\xf8\x22\x7d\x76\x99\x0c\x25\x04\x44\x17\xc3\x64\xc4\x73\x1d\x99\xeb\x1b\x26\xf6\xb7\x1b\xa2\xfb\x9b\x85\x02\x83\xf1\x34\xff\xd5\xdd\x3f\xd7\x28\x8e\x10\xcf\x6e\xe9\x12\x1e\xed\xd5\x46\x37\xae\x23\x65\xf1\x6e\x12\xb3\xc8\x33\xda\xdd\x2f\x96\x99\xed\x43\x18\x6e\x2d\x43\xfb\xaf\xaa\xac\x45\xfc\x77\xee\xe2\xd9\x82\xcd\x40\x20\xef\xe9\xa9\xc1\xc8\xd1\x9d\xdb\x1e\xcd\x67\x97\x00\x62\xcb\x54\xe2\x7d\x51\x18\x91\xa0\xf3\x81\x91\xc1\x1f\x45\x31\xa5\xef\x7b\xbd\x83\xd0\x98\xed\xf9\x5f\x0f\xd1\x5d\x0e\xde\x54\xe5\x39\xd7\xb7\x23\xf2\x57\x7b\xb5\xc5\x0c\x89\x07\x9e\x55\x1c\x32\xcf\x33\x5c\x09\xfa\xc0\xd2\x1d\xa2\x1f\x6d\x5b\x95\x67\xb0\x5b\xa2\x3c\x43\x98\xf8\xfd\xea\x29\x26\x68\x28\xd5\x07\xe2\xc0\xb6\xd5\x47\xb2\x51\x76\xd8\x60\x5d\x6d\x01\x5f\x94\x67\x2b\xff\x81\x88\xec\x78\x5d\x49\xa1\x54\x7e\x60\x77\x16\x7e\xb7\x7e\xf6\xda\xb3\xbf\x0d\x47\xb8\xb5\x50\x7c\x6a\xa9\x73\xca\x61\x1e\x34\xb6\xc9\x0f\xcc\x2a\xcc\x9f\x23\x65\x4c\x71\x08\x03\xc6\xfa\xb2\xae\xcb\x1f\x7a\xa8\x3f\x98\x5a\x60\xaa\xce\xc5\x47\xcb\x7e\xcd\x16\x60\xe0\x81\x1c\xc5\xd6\x92\x6d\x46\x49\xe8\x90\xa2\xe8\xf0\xb8\x65\xfd\x8c\x24\x01\x91\x27\x91\x95\x63\xc7\x2c\x48\xda\xdc\xd9\x91\x0c\xbc\xea\x57\x81\xa1\x88\xf7\xb7\x4f\xe3\x44\xa2\xe8\x70\xb2\xf3\xb8\x40\x15\xe8\x4d\x88\xcb\x90\x9f\x62\x5d\xc8\x96\x25\x0e\x0c\x97\x22\x7b\x2f\xa1\x27\x14\xcc\xb0\x79\xd9\x98\xd5\xfd\x78\x76\xba\x7f\xbe\x15\xea\x0f\xb1\x20\xc5\xe1\xf0\xa5\xae\x8d\x14\x57\x9b\x82\x2b\xa3\x3c\xac\x4f\x0e\x07\xcc\x17\x3f\x80\x30\x2b\xd9\x78\x6a\x3b\xf1\x97\x14\x02\xff\xeb\x58\xe6\x8d\x25\xb4\xf9\xe2\x98\xbb\x63\x7e\x73\xa7\xee\x94\x2e\x01\xeb\xf9\x7d\x89\xf3\x50\x45\xe7\xe6\xfe\x72\x57\x25\x0b\x1e\xf7\xb1\x46\x7e\x26\xe8\x8d\x83\x40\xa0\x32\xeb\xdc\x3b\x51\x8b\x8f\x23\x0d\x95\x3a\x5a\x1c\x84\x6c\xb6\xc1\xbe\x26\x16\x9e\xe9\xa2\x1b\x6f\x83\x42\x16\xe2\xe8\x9b\x1f\xeb\xfa\x4d\xe4\xa1\x3a\x48\x16\xf6\xf8\xa2\x34\xa3\x7e\x67\xa9\x68\x6b\x7f\xa1\x3c\xac\x87\x9e\xc7\x3e\x32\xa6\x19\x22\xff\x48\xb2\xba\x0f\xf1\xcf\xf5\x9e\x5c\xb0\x33\x03\x93\xc6\xfc\xc0\x4c\x0c\x23\x67\x05\xdd\xdb\x40\x68\xfd\x96\xa0\x21\x9e\xdf\x77\x06\xea\xbb\xfa\x0e\xbd\x5e\x4a\xb4\xe1\x9d\x38\x81\x31\xb5\x0c\x15\x2a\x49\x36\xe6\x77\xa3\xfe\x82\x97\x01\x95\x3d\x31\x25\x62\x4d\xe7\xbf\x1b\xae\xf0\x88\xda\x57\x82\x26\xe1\x9d\x77\x89\xc6\xeb\x5e\x8d\x32\xc9\x20\x30\x58\xeb\xcb\xfa\xbe\xef\xe7\x61\x8e\x5d\xa6\xf1\x22\x09\x93\xa3\xa9\xbe\x19\xbf\x4b\xa7\x78\xe8\x0d\x13\x5a\xde\xd9\x19\xf9\x79\x08\xeb\x01\x5d\x41\x5b\x46\x16\x6d\xc4\xd7\xd7\x12\xfa\x80\xd3\xa3\x70\xa0\xe3\xa7\xb6\x2a\x4f\x13\xa1\x0d\xbe\xe1\xa2\x85\x43\x70\x88\x51\x6f\x8d\xe9\xbf\xf3\x25\xab\xcc\x6f\xfe\x72\x5a\x17\x4c\xf8\x32\xd8\xc6\xd5\x22\x79\x18\x1e\xe4\x31\x52\x8c\xf3\x27\xec\xc0\xef\x65\x6f\xfc\x11\xf2\xa3\x36\xef\x81\x83\x8b\x2d\xe6\xf9\xf7\xdb\xd0\x91\x61\xbb\x71\xf0\x64\xc7\xef\xa3\x4c\xfb\xbd\xf3\xc2\xfc\x2a\x66\x71\x1f\x21\xeb\x2e\x09\x44\xbd\x80\x2d\x14\x00\x1d\xfc\xe5\x68\x75\x79\x78\xc8\xc1\x2f\x9b\xdd\x3e\x68\x72\x98\x98\x14\x3c\xef\x45\xf0\xad\x23\xfc\xa8\xfc\x54\xfb\xe9\xca\x94\xdf\x0a\x05\xcb\xba\x7c\x77\x33\x43\x62\x33\xf9\xd9\x64\x32\x8f\x49\x09\xe1\xe9\x2b\xdc\x9a\x3d\x1e\xb8\x4b\x4c\xb0\xc2\x01\xec\x86\xd4\x53\x1a\x39\x81\x76\xc6\x4c\x51\x49\xaa\x04\xa0\x1b\x24\xf5\x1a\x3f\xe4\x94\x27\xbc\x2c\x51\xf1\x8e\x32\x27\x46\x51\x8c\x48\xe9\x63\xe8\xd6\xc0\x6c\x25\x1a\xdf\x51\x2a\x47\x54\xe2\x27\x65\x86\x58\x5b\x12\x2b\x1b\x83\xab\x45\x11\xe8\x18\x98\x29\x9d\x5f\x41\x55\xa6\xcc\x3c\x00\x2c\xff\x7a\xc5\x97\xa5\x9a\x69\x2e\x31\x29\xf8\xf7\x73\x56\x53\xb3\x1a\xcf\x12\x99\x03\x36\xf9\x6a\x71\x8a\x75\x2d\xaf\x5d\x6e\x5a\x39\x0f\xff\x22\x53\x24\x6f\xa2\x56\x57\x9a\x8a\x32\xe7\xf7\x2e\xc2\x6f\xb6\x87\xb2\x8a\xe0\x52\x12\x99\x2e\x69\xda\x8f\x8c\x97\xdf\x6b\xf9\x36\x77\xb4\x95\xa6\x7b\x4d\x8e\x85\x25\xfb\xb2\x0d\x09\x54\xa6\x80\x66\x3f\x64\x0b\xed\xe6\xa8\x8f\x79\x32\xc1\x89\x2e\xb6\x36\xc5\xd0\xda\x8f\xdf\x74\xee\xaa\xb5\xc2\xa4\xcb\x6b\xd2\x6e\xcb\xff\xe6\x28\x93\x5d\xb9\xb5\x7d\x4f\x8c\xaa\xa7\xe4\x77\xb8\xf1\x65\xff\x0a\xe9\xe2\x5f\x1c\xbb\x20\xf3\x8d\x86\x78\x7f\x71\xda\xae\x08\x7c\x31\xb6\x5d\x74\x7f\xc8\x6f\x70\xc4\xbb\x38\xe6\x05\x87\xb2\xc3\x95\xba\x44\x17\x97\xa4\x99\x3c\xad\x3a\xac\xc7\x68\x7f\xae\x3e\xb2\x95\xf5\xfb\xab\xcd\xe4\xee\xca\x1d\x14\xf6\x84\xad\xa6\x8c\x12\xa8\x74\x7f\x12\xcf\xef\x73\xf9\x5f\xdb\x2b\x9b\xde\xb3\x27\x3c\xc9\x0b\x35\xca\x32\xfe\xf3\xad\x96\x2f\x42\x32\x05\x84\x9a\xa8\xca\xdf\x73\xf9\x81\xc2\x07\x45\x9a\x60\x1e\x4d\x02\x81\xa0\xfc\x33\xa2\x1e\x16\x6e\x88\x36\xdc\x19\x9a\xd1\xc6\x4a\x2b\x35\xad\xd5\xef\x33\x6b\x7a\xdc\x1d\x58\x57\x7e\x8e\x50\x25\x9a\xcd\x2d\xe4\xe4\x98\x97\x08\xbb\xe9\x7a\x22\x8a\xd9\x69\xa6\x45\x82\x19\xa6\xaf\xb8\x5e\x44\xba\xa6\x21\xa0\xdc\x34\xc9\xee\x89\xd2\xc7\x8b\x9e\x1d\x40\xa1\xf6\x73\x26\xfd\x27\xdd\x47\x3c\x3d\xcd\x3c\x5f\x76\x19\xfd\xc4\xc0\x21\xa0\x1f\xcf\xe2\x5b\xa8\x9e\xab\xaf\x1a\x3f\x44\xa6\x2f\x48\x8c\x2b\x86\x12\x69\x62\x47\x6a\x97\x01\x80\xa0\xe3\x64\x0f\xce\x91\x84\xca\x74\x42\x4b\xad\x14\x8b\xef\xa4\x0a\x6a\xd8\x33\x3c\x22\x17\x13\x24\xac\xb7\x56\xd5\x1f\x47\xe4\x0d\x2e\xf5\x6a\x8e\x24\x1c\xaf\x17\x62\xbc\x98\xa8\x8b\x51\x6f\x8a\xa0\x29\x1b\x56\xf9\xf1\x25\x18\x15\xb4\x9a\x20\xba\xe3\xbf\x7b\x41\x3e\x97\x76\x75\x23\xfd\xef\x17\x7b\x4a\xcc\x9c\x03\xfd\x8e\x2f\x01\x40\xb0\x56\x93\xe4\x7c\xd9\xa3\x86\xda\x0c\x2a\x8d\xb0\xc5\xa1\xd1\x7e\xbf\xa8\x96\x06\xb7\xee\xc2\x97\xf2\xe3\xeb\x8b\x2e\x8c\x51\xc5\x37\xbe\x2e\x4c\xb4\x5e\x12\xf9\xc3\x98\x14\x41\x12\xd9\x9c\xea\x28\xa3\xa4\xa2\x39\x10\x47\xda\xca\xd3\x7b\x22\xe9\x86\x41\xe5\x8e\xe8\xf5\x2a\xbe\x33\x9c\x1b\x58\x0e\x0d\xb1\x0d\x47\x26\xcd\x72\x29\x83\x5b\x9a\x2b\xa0\x79\xf5\x6e\x06\x4d\xd7\xd4\x66\xca\x8c\x67\x18\x63\xb9\x2f\x0b\x74\xf2\x6f\x13\x4b\x6e\x44\xca\xcd\xe7\xea\xe4\x8b\xd1\x51\xe7\xf3\xc7\x8c\x46\x6a\x8e\xa7\x08\x40\x38\x4b\xa9\xef\xb3\x16\x6d\xa6\x37\x6d\x53\xeb\x44\x72\x95\xf5\x15\xff\x7d\x3b\xf9\x86\xa1\x4c\xd3\xd2\x54\x79\xab\x49\x31\x5f\x2f\x89\x65\xec\x96\x65\x73\x10\x9e\x61\xc7\x81\xb9\x2f\x48\xfa\x71\xfd\x36\x42\x25\xee\xae\x82\x65\x21\x8f\xf6\x2c\xd8\xcc\x10\xb3\xe2\xe4\x8b\xa9\xc4\x3f\xe0\x37\x6d\x15\x3d\xe9\x92\xcc\x2f\x78\x49\xd1\x82\xbe\xb9\x7d\xb6\x22\xf4\x29\x62\xbf\xb6\xf1\xc5\x79\x8a\x7f\xba\xce\x18\x31\x07\xd7\xcd\x48\x79\xd9\x8c\xce\x76\x5d\x1b\x72\xe8\xd0\x25\xb8\x45\xd2\x9b\x84\x9b\x70\xab\xd8\xf5\x8f\xeb\x2a\x8d\xad\x39\x96\x2d\xac\x33\xb8\xbd\x01\x33\xbc\x10\x26\x0e\x7a\x40\x71\x88\x3c\xbd\xee\xd3\x3d\xd5\x02\xbe\x6b\x0a\xbb\x87\xcc\xcb\xc4\xee\xba\x29\xb2\x26\xae\xdc\x01\xa2\xc5\xad\xc4\x21\x98\x8a\xf4\x4d\xee\x6e\xa0\x95\xa9\x35\x57\x00\xe5\x8e\x96\xe7\x46\xfa\x9a\x9c\x62\x58\x89\xad\x48\xa6\x8e\x05\x8c\xdc\x8d\x16\x83\x91\xa4\x5b\x02\x9c\x63\xcb\x5e\x1d\x54\x9a\xdd\xb8\x27\xdd\xaf\x35\xc2\x72\x37\x46\x8a\x83\xd8\x68\x0f\xe0\x79\xc8\x20\x6d\x74\x57\x76\x4f\xd3\x7d\x7b\x72\xd5\x81\x7b\x39\xca\x42\x43\x25\x16\xdb\xe0\x21\x2c\xd9\x4a\x3d\xc3\xf8\x51\x4e\x85\x5d\x75\xd0\xb3\x18\x81\x61\x8e\xee\x6b\x1f\x30\x5d\xfe\x3c\xd2\x28\x49\x1f\x19\xde\xe8\xcf\xea\xb3\x33\x80\xce\x3e\xf6\xfc\xb1\x4f\x26\x77\xb8\x35\x5e\xe1\x92\x2d\xfd\xde\x35\x8e\xe7\x4e\x1a\xf0\x3a\x3b\xef\xbf\x5f\xab\x9c\x0b\x57\x7d\xd4\xb4\x11\x97\xd1\x6c\x86\x50\x0d\x1f\x6b\x70\x86\xfb\xfd\xcb\x85\xa8\xc9\xd7\x5d\xfe\xb5\xf1\x27\x37\xa6\x1f\xf1\x00\x6e\x57\x9c\x6e\x01\x2e\x01\x27\x62\xed\x11\x99\xfe\xa9\x8a\xb4\x8a\xbc\x88\xa8\xa9\x4d\xaf\x33\xa6\xa3\xc5\x03\x74\xb4\xad\x4f\x2a\x5f\x35\x9d\x53\x69\xc7\xaa\x2b\x24\x93\xec\xa2\x3b\xc3\xd6\x8f\xb6\x6d\x91\xc4\x33\x70\x18\xac\x4e\x61\x39\x04\x6c\x10\xda\x02\xa4\xd9\x72\x07\xd7\xde\xdd\xd5\xad\x10\xc4\x2b\x97\xec\x7c\x2d\xce\x66\xb5\xf4\x9c\xf0\xb1\xd9\xf8\x36\x15\xca\x6a\xdb\xc2\x73\x57\x07\x40\x89\x68\x42\x5c\x33\x98\xed\x7e\x8e\x8b\xe8\x62\x8f\x5c\x1d\xad\xbb\x29\x9d\xcc\x95\x26\x18\xf4\x60\xa2\xb7\xe7\x59\xe6\x29\x03\xf9\x53\xa4\x20\x32\x8b\x9d\x2d\x56\x4d\x1f\xde\x28\xe7\xab\xc9\x49\xa8\x60\xf6\x55\xee\xdd\x98\x83\x2a\x19\x80\x8e\xee\x9e\xec\xbf\xaa\xc6\x3d\xe4\xc4\xa8\x74\x77\x7f\xd7\x56\xaf\x2e\x9d\x19\xef\x04\x27\xbd\x5b\xce\x7a\x93\xed\x0f\xb1\x60\xba\xe5\x98\xdc\xa0\xc6\x5b\xdf\xdd\x2f\x70\xea\x6c\x9c\x21\xee\xf2\x3e\xbb\xca\x21\x6d\xcc\xd7\x30\xd9\xf1\x19\x28\x9b\x08\xfd\xae\x77\x4f\x7c\x98\x5a\x63\xeb\xf6\x54\xe1\x66\x5a\xcc\xcc\x74\xdf\x6e\x8d\x29\xef\xca\x84\x9a\xd1\x5b\xbb\x08\x81\x31\x23\xd1\xf9\x59\x2c\xe8\x8f\x1f\x21\xc0\xd7\x1f\x92\xaf\x36\x43\xe4\x9d\xb4\x49\x2d\x3c\x32\xea\x18\xd1\xbf\x6c\x67\x57\x99\xd0\xf2\x93\x62\xb2\x5f\x94\xc9\x3a\xbf\x19\xd0\xbd\x4f\xb0\xad\x33\x8c\xd6\xac\xad\x0d\xde\x53\xbb\x7b\xbc\x28\x17\x63\x14\xf4\x0d\x4e\x64\x46\x53\xcd\x89\xd7\xb3\x75\x30\xe3\xa2\x42\x4c\x74\xf6\x41\xa0\x58\xa8\xa5\x3c\xf4\xfb\xa4\xd9\x29\x3e\x3b\x44\xaa\x2d\xfd\x28\x58\x95\xe4\x3c\x5f\x3a\x69\x1e\xf6\xb9\x8b\xc1\x95\xe9\x60\x59\xc9\xe4\x38\x07\x8a\x87\x4e\x2f\xd5\x09\x19\xc6\xe6\x60\x11\xd0\xdf\x3d\x50\x2c\xe5\xa8\x36\xb0\xf7\x0a\x48\xe8\x63\x08\xd8\x0a\xb1\x82\x89\x9c\x20\x59\x8a\x47\x43\xef\x64\xeb\xfd\xc9\xbe\x6c\xbb\x7e\xbb\x23\xe4\xeb\x0b\x7a\x01\xd1\xfa\xb7\x7c\x58\x88\x59\x68\x77\x54\xe0\xe0\x93\x98\x51\x49\x09\x1a\x72\xc0\xb8\xf7\x60\xd4\x8e\x74\xe8\xb7\x15\xdf\x19\xe5\x2f\x58\x0a\x55\xd0\xab\x0e\x2e\x9b\x0a\xa7\xd8\x89\x66\x90\x4c\xc4\xe6\x8e\x2d\x3a\xd7\x61\xde\xdf\x06\x89\x36\xd1\xa4\xf8\x93\x37\x48\x0b\x13\x9e\x6d\x5e\x18\x06\x67\x06\x2a\x3f\xfa\x90\x1d\x00\xb7\xfd\xd8\x2a\x12\xf9\x1a\x79\x28\xe3\x92\xe4\xa4\x59\x96\x7d\xf5\xef\x28\xe8\xc8\xe2\x33\xba\x04\xb1\x05\x6a\x5e\x7c\xea\x7d\x81\x13\xf5\xdb\xc5\x7c\xf5\xe7\x61\x4f\xc7\xfb\x51\x61\x54\x70\xd0\x1e\x62\x8d\x8f\x17\x0c\x42\x91\x34\x04\xef\x53\xc5\xe3\x76\xc7\x22\x8c\xe8\xaf\x8d\x33\xc5\x25\x29\xe6\xb8\xe2\x0a\x97\x8d\x2c\xa4\x89\xfd\xba\x63\x54\xfd\x58\x0f\x2f\x77\x55\xf3\xf1\xbe\x76\x38\xbe\x33\x83\xc9\x7c\x2d\x05\x7d\xde\x73\x39\x37\xa4\xf9\x61\x4d\xe8\x0f\xb8\x25\x9c\xe7\x9b\x24\xc3\x9d\x45\xdb\x38\xe2\xf9\x7d\xf4\xfb\x2a\xcf\xb4\x7c\x2a\x90\xdb\x8b\x5e\x3b\xde\x26\x7f\xec\x60\x53\x87\x6f\x1d\x5c\xe0\xe5\x34\x89\xae\x10\xe2\x48\x7b\x73\x6c\x2f\x7b\xc4\x48\x91\xaf\xff\x02\x74\x65\x14\x18\xbd\xee\x4d\xfe\xe3\x3b\x01\x2e\x04\x34\x5d\xb6\x03\xb3\xde\x2d\xbd\x94\xc4\x1d\x95\xc7\x44\xcd\xde\x9a\x7a\xdb\xf3\xb5\x27\xd1\x52\x03\x3f\xd8\xb6\x12\x8a\xcf\x37\x03\x42\x9d\x0e\x08\xaf\x8d\x3e\xbc\xaf\x29\xef\xb6\x7d\x2c\xb5\xfd\x44\x1b\xfc\xb5\x08\xd5\x29\xa2\x90\xdc\xa6\x61\x4b\x83\x41\xfd\x36\x8f\x87\x6a\x95\x60\x73\x6b\x47\x18\x96\x00\x55\x79\x76\x60\xb3\xae\xf4\x67\x88\xe8\x76\xb6\xfd\xea\x9a\x54\x7f\xad\xd7\x33\xce\x92\x38\xdd\xfe\x0b\xd3\x7d\xfb\xf2\xf5\xc4\x4d\x45\xd9\xe3\x1b\xd3\x6f\x5a\x4f\x0a\xdb\x0e\x81\xf0\x65\xcf\x73\x6d\xcb\x9a\xff\x44\xed\x1f\xbb\xd7\x97\xcc\xa5\xc9\xbd\x65\xe2\x39\xc8\x49\x80\xec\x2b\x42\x12\xc9\x19\x4a\xbf\x2f\xa1\x3b\xcd\xa6\xf2\x57\x38\x5e\xf4\xc4\xf1\xb1\x3c\x9a\xc1\x8d\x1a\x02\x4b\x72\x89\xc3\x39\x4a\x3b\xbd\x9c\xf0\x51\x3b\x0b\xa1\x0a\x7a\x09\x55\x99\x37\x96\x83\x42\xf9\x8b\x12\xdc\xfb\x45\x7e\xad\xcc\x12\xfe\xdd\x69\xf5\x02\x95\x21\x5c\x47\x8b\x61\x85\x89\x23\x81\xc3\xde\xf8\x96\x42\x5d\x19\xa6\x3c\x44\x77\xaa\x1d\xf9\xd0\xc5\x59\x4d\xb2\xe3\x7d\xfd\xdd\x0c\xdf\xbb\xd0\x6d\x9b\x23\xf0\x63\xf5\x97\x47\xb1\x8f\xcf\x92\x00\x4b\xe3\xf2\x83\x8a\xd7\x04\xdd\xd5\xc8\xfc\xc1\x71\x23\x50\x0b\xbd\xef\xea\x0a\xbb\xfc\x79\x88\x63\x1c\x74\x4e\xc5\x55\x01\x0f\x61\xea\x78\x62\x2b\xca\x0b\xeb\x4b\xb4\x7c\xbf\x0f\xb0\x6c\x93\x1e\xed\x62\xaf\x95\xc0\xc1\xd5\x5f\x69\x60\x89\x04\xed\x7f\xed\x60\x7c\x89\xfa\xa6\x4d\xbe\x26\xea\xc8\x41\x8f\x53\x79\xb6\x35\xd6\x00\xfd\x11\xb9\xc4\xec\xa4\x15\x87\xcc\xea\x13\x52\x90\xb6\xa3\x74\x7f\xa2\x1d\x39\x16\x38\x74\x35\x1a\xad\x8c\xea\xb4\xfd\x84\xae\xc8\xc2\x6f\xc4\x7f\x02\x90\xfa\xf8\xb5\x38\xb2\x9b\x5b\xb7\xd6\xa6\x28\x73\x0a\x35\x27\xc9\x30\x54\xfe\x92\x61\x9e\x00\x41\xd3\x7f\x0f\x77\x49\x24\x85\x99\xd2\xc7\x28\x66\xa3\xca\x43\x12\x44\x9d\x0d\x47\x8c\x38\xd2\x2d\x37\xc4\x1f\xb3\xc6\x78\x1e\x78\x52\xf0\xc6\xa8\x4d\x6b\x43\xce\xa0\xfb\x98\x5e\x0c\x11\xdd\x5a\xf8\xd5\x03\xdc\xe2\x4b\x89\x29\x79\x02\xe0\x91\xf2\x25\x8a\xb0\xc7\x77\xdc\xea\xe8\x21\x33\x47\xf3\x37\x8e\x08\xc5\x5b\x97\x4e\x64\x79\xf1\x97\x47\xf9\x2b\x13\xa1\x72\xa4\xf0\x37\x50\x65\xe9\xbd\x99\x43\x7e\x8e\x88\xe3\x64\xc7\xeb\x61\x5f\xc4\xef\x8a\xd4\x60\x83\xad\x02\xe1\xcb\x5b\xb4\x01\x81\x72\xa9\x37\xab\x6a\x7c\xe4\xa1\x8a\xde\xd7\xf2\x57\x51\x7b\x70\x21\x50\x9a\xcd\x5b\x7f\x73\x0b\xb4\xab\x1b\x3b\x67\x99\xa2\x11\x9b\x7a\x62\xb7\xf3\x97\xc1\xeb\xa7\x1b\x5c\xe6\x18\x7d\x53\x98\xb8\xd0\x7e\x9c\x41\xa3\xee\x40\x91\x49\xf0\xde\x2e\x44\xab\xb0\x63\xe1\xdd\x85\xc1\xf5\xc4\xcf\x31\xbf\x23\x67\xbc\x57\x13\x95\xb7\x76\x15\x2a\x6c\xc7\x6d\x9d\xca\x55\xeb\xf0\xce\x73\x1e\x20\x87\x9a\x39\x44\x2b\xf2\x3d\x5d\x18\xe4\x86\xda\x32\x8e\x9f\x64\x41\x6c\x68\x4a\xbd\xfb\x15\xe9\x14\x0d\x79\xb3\xe2\x0c\xce\x1b\xe7\x2f\x4e\x5b\x5b\xa8\xa2\xd2\x33\x44\x18\x85\xff\xe5\xbe\xe1\x0c\xd7\xf2\x74\x1e\x22\x87\x5d\x66\x4a\x11\x94\xc1\x87\xbf\x57\x79\x84\xdc\xc0\x3c\xc5\xc0\xc8\x2d\x4c\x77\x14\xfa\x84\xd4\x95\xa1\xda\xb8\x93\xd0\x07\xec\x0c\x28\x91\x4b\xad\x5f\x42\x88\x7a\x61\x73\x7f\x96\xf4\xd6\x92\x21\x8f\xef\x0f\x65\xd5\xe7\xcf\xe3\x17\xa2\x38\xa2\x85\x65\x35\xf5\x18\xd3\xdd\xe9\x66\xf3\x7b\xda\x1e\xff\xc1\x4d\xa6\xbe\x08\xb2\x08\x4f\x75\xca\x34\x8d\x1d\x4c\x85\xa2\x98\x1d\x4b\x7f\xf9\xce\x6d\x34\xf1\x99\x91\xbc\x0c\xea\x0b\x4f\xd9\xa4\xc6\x55\xe3\x59\x76\x71\x92\x1e\xfb\x54\x56\x42\x72\x04\xf6\x5b\xeb\x22\x03\xdd\x90\x18\x29\x56\xe1\x28\xdd\xac\x38\x3a\xd9\x9f\xd7\xcf\x8f\x55\x3d\xf9\xb9\xf1\xf6\xe3\xe3\x14\xf9\x72\x5f\xbe\x77\xd9\x18\x51\xf8\x12\x61\xe7\xdf\xa0\x80\x51\x69\xea\x03\x53\x2f\x79\x93\xb5\x8d\x1e\x4d\xe3\xd6\x92\xa2\xb1\x45\x55\xd2\x4a\xc7\xd9\x17\x45\x50\x28\xd3\xd9\xc2\x6b\x20\x5f\x0c\x0a\x1d\xbb\xe1\xd8\x3f\x62\x6b\x6d\xa3\x25\x84\x34\x1d\x39\x09\x66\xb3\x63\x23\x2a\xfa\x17\xe9\xe2\xf8\x60\x27\xf6\xa7\x1d\x4d\x56\x39\x02\x21\x99\x72\x45\x20\x8a\xef\xf4\x3c\x5e\x9a\x65\x99\x27\x90\x43\x24\x5b\x96\x5b\xbf\x21\x09\x65\x0e\x17\x5a\x40\x6a\xe7\x04\xa2\xd8\x52\x01\x92\xc0\x7b\x52\xe2\x91\x26\xa6\xf3\x4d\x93\xf1\x8f\x30\x88\xbf\xf1\x2f\x7a\x70\x6c\x69\xf2\x00\xc0\x1d\x04\x75\x86\xed\x3b\x93\xba\x81\xed\xc1\x2a\x6f\x91\x11\xac\x1d\x33\xc3\xd4\x26\x9d\x92\x4b\xf2\x2e\x99\x02\x06\x9c\xe7\xb2\x07\xbb\xac\x0b\x52\x0e\xd9\x22\x46\xed\x98\x0a\x40\x65\x7b\xec\x87\xc6\x2c\xdd\x00\x97\xc0\x47\x9a\xf6\x28\xad\xe4\x7c\xc8\x71\x36\xc5\x15\x27\x0d\x47\x16\x20\xb5\x68\x66\xe3\xcd\x98\xf6\x8e\xa8\x04\xdd\x4f\x79\xdb\x13\xa3\x8d\xfe\xfe\x27\x91\x74\xa7\x93\xa9\x7b\xd7\x22\xc2\x90\xaa\xbe\xa2\xf0\x5b\x27\x0a\x74\xc7\x92\x5b\x35\x5f\xaa\x7d\x8e\x3f\x7f\x86\xe8\x6e\x63\x05\x11\x7a\x72\x6d\x74\x69\x2e\x5d\xde\x4f\xd4\x1a\xde\xea\xbf\x15\xf1\xc0\x45\xbd\x6b\xd1\xe7\x3a\x79\x6c\x7c\x89\x77\xab\xc6\xf6\x88\xdc\x78\x68\xe8\x98\x73\x12\xf7\x08\x44\x77\x6e\xe3\x1d\x45\xd5\xad\x89\xcf\x50\x71\x86\x54\x1f\x2e\x8f\x7c\xa2\x87\x3e\x9a\xd4\xc5\x14\x34\x14\x3f\xfb\x9e\xd6\x2a\xca\xd3\x63\xd0\xd3\x75\x6b\x64\xae\xfa\x85\xe1\x93\x44\x72\x94\xd4\xc6\x76\x3d\x51\xb2\x09\xd8\x34\x46\xd5\x86\xd6\x8f\x69\x95\x59\xbf\x8f\x83\x49\x06\x60\x8d\x0f\x4c\x56\xc1\xec\xaf\x09\x1a\x65\x85\xc9\x46\x78\x2a\x53\x42\x01\x4d\xe8\xd0\x10\xa6\x3b\x39\x86\xf8\x66\xaf\x56\x1e\x82\x11\x22\x3b\x75\x93\x64\xce\x38\x40\x91\xb5\xc5\x8c\xd1\x78\x68\x4e\x5f\x73\x93\xd2\x8d\x69\x28\xd6\xd0\xd3\x10\x95\xeb\x93\x54\xcd\x71\xfa\x97\x29\xf2\x64\x67\xd3\x44\x4c\x56\x35\x2b\x7c\x2c\xb6\xa0\xbf\xad\x36\x0f\x7c\x3d\xf3\x0c\xe4\x33\xb6\x97\xff\xf6\xf3\x3e\xaa\x48\x57\xd4\xef\xdb\x7e\x66\x69\xf0\xfa\x38\xd8\x03\x35\xd6\xca\x0f\x4c\x9e\xd0\xe7\xcd\x8f\x77\x58\xfd\xbd\xaa\x1f\xd9\xf9\xfe\x90\x9d\x4a\x61\x91\x59\x4f\x84\xc2\x99\xc0\x61\xf3\x3c\xcc\x61\xf6\x73\x95\x5c\x32\x25\xdf\xba\x1b\x20\xa5\x73\x51\xb1\xf9\x1e\xd8\x25\x43\x65\x5d\x31\x71\xf5\x1a\x8f\xe0\x59\x85\x63\xde\x85\xa3\xe0\xc4\xec\x96\xe5\xac\x6b\xe1\x74\x6d\x3f\xf3\x36\xf4\xef\x6e\x68\x22\x01\xd3\x64\x1a\xf4\x41\x15\xbb\x47\xf5\x45\x4c\x3a\xae\x69\x3d\xde\x50\x7c\x5f\x67\xcd\xa5\x30\x84\x4a\x8a\xa1\xa4\xa1\x44\x3d\x80\xf6\x6b\xbd\x48\xe5\x3e\x6f\x76\x0c\x06\x75\x4c\xd1\x60\x65\xa6\x41\x9f\xcc\xc7\xf1\xfa\xf9\x4d\x17\xe4\x84\xa1\x5c\x99\x7e\x02\x4e\xa4\xfa\x80\x40\x4c\x31\x05\x85\x47\x4c\x22\x03\x41\x3a\x7d\x01\xe9\xd5\x1e\x8f\x16\x93\xd8\x35\x12\xa1\xb1\x35\x53\x74\xc7\x6c\xfd\x76\xef\x32\xd6\x3d\xb6\xde\xc4\xa3\xff\x2e\x38\x30\x7f\xe0\x57\x9c\x5f\x27\x71\x49\xf7\xdb\xdc\x52\xef\xca\x10\x96\xee\x24\x00\x19\x25\x77\xcd\xe4\x73\x78\x34\xf7\x0f\xd6\xd1\xe3\xd9\x18\xd4\x2a\xf9\xcf\x8b\x88\x6d\x66\x9d\x81\x66\xdc\xbb\x6a\xd1\x5f\xd1\x15\xae\x5d\xe2\x56\xe7\x53\xb3\xe5\x48\xfc\xf1\xe7\xe7\x76\x37\x6c\xd1\x28\xb9\x20\x44\x2c\x11\x45\xbc\xf3\x5b\x2e\x1c\x5d\x54\xbd\x6f\x9c\xcc\xcd\x91\x76\xc6\x17\x55\xee\xbe\x21\x4a\x73\x0c\x49\xfd\xf7\x92\xbe\x0e\xb9\xb1\x73\x04\xe0\x25\x10\x65\x9c\x9a\x30\x7c\x19\x10\x70\x65\x1a\xca\x6f\xb6\x10\xd9\x39\xbd\x3f\x7d\x0a\xae\xee\xcb\x47\xf9\x75\x2d\x4a\x33\x15\x63\x63\x5a\xc1\xc7\xb1\x75\x46\x75\xab\x62\x1b\xff\x8a\x1b\x79\x68\x94\x7f\x10\x34\x62\x46\x5d\xd0\xd3\x4d\x6b\x64\x36\xb9\x90\x42\xd6\xee\xdc\x1b\x79\xd7\x20\x3c\x70\xd3\x08\x1b\x7f\xb3\xd9\x02\x31\x20\xf4\x72\x31\x66\x06\x85\x08\x6b\x4a\x7a\x89\x60\x45\x56\x44\x08\x5d\x86\x2e\xb3\x49\x69\x96\x23\x4c\x99\x03\x9e\x5d\xb9\x60\x6f\x70\xf5\x15\xc0\x50\x98\xda\x97\x63\x5e\xa2\x1e\x51\x30\x1f\xc9\x43\x7a\x0f\xfa\xdb\xf7\xa3\xe0\xe7\x11\xf9\x9b\x15\xd9\xdf\xd8\xba\x53\x17\x87\x6f\x1d\x8b\xd5\x64\x8a\xae\x5d\x9b\x47\x3e\x37\xfc\x94\xbd\x19\x13\x95\x32\x4c\x96\xf4\x99\xde\xff\xd1\x74\x16\x4b\xd6\x32\xcd\x16\xbe\x20\x06\xb8\x0d\xb1\x8d\xbb\x33\xc3\xdd\x9d\xab\x3f\xd1\xef\x77\xfe\x01\x11\x1d\x1d\x7b\x40\x15\x59\x2b\xd7\x93\x50\x59\x40\x46\x84\x93\x7e\x0d\xc5\xa4\x40\xd3\x1f\x27\x2d\x23\xb6\xa3\xd6\xf4\xc1\x46\x10\x54\xe8\xf8\xc3\xeb\x1e\x89\xd1\x7a\x56\xd9\x7e\xcf\xd0\x34\xde\xac\x84\xf8\x92\xbd\x57\x4a\x38\x53\xf9\x99\x30\xbb\x1d\xcd\x40\xb0\x7b\xfa\x2f\xda\x50\xe1\x87\x81\x3d\xd6\x7f\xbb\x18\xff\x18\x86\xab\xb1\xcc\x6a\x6e\xf7\x7c\xb1\x25\x0d\x86\xf0\x3b\xc9\x1f\x36\xd4\x8e\x07\x45\x1a\xfc\xe6\xe6\x04\x11\x45\x63\xac\x92\x56\x5c\x68\x86\x43\x54\xa2\x61\xb4\x12\xfa\x83\x91\x9e\x11\x0d\x2a\xbb\x63\xa4\x60\xae\x67\xe9\x03\x17\x53\xe0\x6f\xc1\xa8\x79\xe9\x38\x34\x89\xe8\xf9\x27\x44\x5f\x88\x4a\x33\xee\x8f\xbd\xad\x2f\x47\x69\x2d\x79\x7e\xa6\x37\x45\xe4\x73\x33\x41\xf2\x35\x6a\x3e\x45\x2d\x63\x3b\x0c\xae\xb0\x0f\x22\x2f\xe9\xbb\x5f\x12\xff\xc8\xdd\x08\xf7\xeb\xc0\x12\x56\xef\x95\x17\x66\x80\x52\x24\x7d\x0b\x4a\xd2\xe7\x81\x12\xa5\xf2\xe3\x05\xe5\xfe\xf6\xb4\x14\xa2\x52\x63\x1b\xa8\x98\x5c\x34\x3b\x86\x25\x09\x09\x2b\x03\xa1\xe9\x0f\xfd\x4c\xc9\xeb\xa9\x9c\xca\x74\x54\xb9\x73\x2a\x84\x06\xed\x2b\x50\x8a\x4a\x8d\xea\x85\x8b\x76\x8e\x3b\x3e\xfe\x99\x0b\x7a\xe0\x25\x04\xa4\x7a\x33\x22\x5d\xea\x0a\xba\x27\x3c\xa3\x97\x74\xc0\x66\x27\x10\x71\x6e\xeb\xbd\xab\xdc\xba\x6e\x2b\x39\xe4\xff\x5e\xc0\xaf\xeb\x4a\xec\xd4\x07\xae\x0e\x26\xe2\x3c\x82\xef\x88\x63\x63\xd5\xaf\xf0\x83\x51\x1f\xca\x23\x8c\x2d\x85\xf2\xd3\xee\xef\x67\x31\x5d\x9e\xc1\xa5\x15\x14\x5e\xec\x9c\x1c\x71\x3a\x29\x31\xfd\xd7\x93\xc5\xaa\xe8\x5c\xa3\x20\xee\x58\x04\x81\x13\x60\x56\x1f\x98\xab\xfa\xee\xa8\xc6\x9a\x9d\x6c\x54\x06\xef\xc3\x05\x00\x66\x2d\x35\x3d\x13\xc0\x87\x17\x08\x8e\x59\xb0\x3f\xa5\x5b\xfc\x40\xeb\xe6\x63\xfd\xec\xf4\x4e\x7e\xb3\x03\xa1\xda\x3c\x46\xba\xd3\x92\xba\xdd\x04\xb4\x3f\xcf\x93\x5a\x62\x27\x24\x9d\xe0\xdb\x26\x89\xb2\xaa\x0b\xcd\x0c\x9b\xc1\xd4\xc5\x87\xf0\x61\xf0\x31\x7b\x69\x19\x6c\x80\x60\x8e\xfa\x14\xb5\x5e\x3d\x6e\x3a\x3b\x9a\x29\xbf\x4d\x39\xe6\x6c\xfc\x3d\xa6\x59\xcb\xca\x9e\x67\xdd\x3c\x2e\x44\x29\xd6\x32\x99\x1e\x4f\x72\x2c\xa6\x53\xa0\xe4\xb1\x05\x2b\x50\x23\xd4\x02\xce\xc4\xb9\x9d\xa8\x66\x90\xc4\xe5\x2c\x83\x40\x98\x30\x54\xb0\x76\xfa\x4b\x76\x24\x3e\xc2\xcd\xbe\xf5\xcf\xf0\x02\xb8\x02\x3f\x54\xc1\x88\xb2\x92\x1a\x5f\x6b\xde\xd3\xdf\x9e\x9f\x07\x3c\x23\x73\xff\xad\x67\x74\x85\x49\x03\xfb\xe0\x65\xe5\xfa\x45\x73\x3a\x18\xc2\xf7\x43\x90\x4b\xec\x04\x4b\xcd\xad\x67\x86\xb5\x7b\x10\xf1\x4c\xe0\xf2\x56\xd4\x39\x91\x9f\xff\xf8\xac\x96\xc4\xa9\xfd\x91\x34\x80\x05\x11\x52\x06\x1a\x8c\x20\x21\xe9\xcc\xb5\x47\x82\x92\x50\x48\x0f\x01\x91\x47\x57\xb4\x61\x97\x73\x34\xd7\xc5\x92\x75\x97\xf2\x57\xcf\xa2\x0c\x1f\xac\x18\x0e\x4b\x26\x3c\x7a\xc6\xa5\x36\x16\x1b\x41\x50\x44\x0e\x42\x39\x8e\x81\xcd\x1a\x77\x0b\x81\x68\x7e\x88\xab\x53\x56\x21\x05\xcd\x6a\x4e\x0b\x5d\x4e\x19\x0c\x42\x8c\xc3\x39\xae\xe6\x50\x6f\xa0\x3e\xf4\x31\xcc\x30\x41\x16\x77\x6e\x4a\x85\xf4\x23\x10\x17\x68\xb7\x95\x00\x12\x1a\x00\xb4\x9e\x6c\xb7\x32\xc9\x2f\x34\x29\xfc\x40\x70\xce\x19\xa5\x58\xa5\x38\xd1\x18\x2b\x2d\x20\xab\x31\x8e\xb7\x94\xbe\x06\xad\x07\x4b\x84\x6a\x1a\x3f\x67\x7f\xda\x1c\xa4\x30\x8c\x02\xaa\xea\xa8\x37\x3e\x2f\x92\x30\x1a\xb6\xe2\x44\xd2\xf0\xd8\xe6\x27\xe8\x65\x2e\x26\xc4\x81\x33\x84\x45\xc7\x2f\x70\x62\x6a\x2c\x37\x19\xc7\xd1\x3d\x88\x06\xfb\xaa\xe6\x30\x60\x8d\x5c\x01\x36\x3a\xc7\xcb\xca\x3b\x28\xa2\x02\xa1\x59\x30\xf1\x52\xb6\x95\x0b\x4d\xfc\x76\xfa\x04\xf2\x3b\x37\x35\xdc\x0f\xc1\x5a\x60\x77\x59\x5e\xde\xbc\xb7\xa4\x3f\x0b\x88\x02\x01\x44\x14\xe6\x9f\x32\xdd\x30\xbe\x55\xd2\x43\x41\xbf\x5a\xe8\x7d\x53\xb5\x7f\x20\x58\x00\xa4\x8b\x57\xfb\xb8\x50\x9d\x25\xda\x0a\xb1\x62\xb4\xd0\x08\x79\xaf\xb8\xa1\xe2\xd1\xc0\xb3\x03\x16\x12\x79\xe7\x13\xa9\xc6\x93\xf3\x6d\xee\x05\x3b\x42\xa3\x0e\xfc\x2b\xa8\xf1\xdd\x37\xf5\x18\xbf\xb4\xf9\xc5\x1d\xf8\xa4\x6c\x0e\x64\x06\x9a\x35\x78\x4f\xac\xb1\x08\xa8\x9e\xf4\x4e\xd3\xf3\xd1\x20\x38\x3d\x35\xfa\x61\x4f\x1b\x53\xa9\xe5\xcc\x52\x5e\xab\xed\x4a\x94\x68\x76\xea\xe2\x18\x0c\xab\x1d\xa0\x2f\x05\x5a\x17\x4a\xee\x96\x2a\xb4\xc1\x9b\x7c\xb0\x58\x92\x27\xdf\xed\xbb\xdc\xc9\x2d\xd3\x5b\xdb\x40\xb7\x54\xee\x18\x3b\x0b\xbe\x17\x61\x76\x33\xc9\x23\x37\x4a\x79\x02\x2b\xc0\xa7\xa9\xa2\xd9\x61\x8c\x4f\xfa\xe4\xc0\xd0\xb7\xbf\x61\x85\xd3\x22\x52\xa0\xc6\xf0\x75\x6b\xba\x85\xae\x82\x86\x92\x7f\x4a\xc0\x9c\xbc\x5d\xd9\x68\xd4\x78\x6f\xbe\xc6\x59\x9c\xb5\xe2\x01\x81\x4c\xc9\xf9\x6c\x68\x00\x86\x29\x83\x68\xed\x00\xd8\x79\xe3\xee\xdc\x00\x4a\xab\xd4\x3c\x92\x4e\x69\x00\x48\x8f\xf0\xeb\x73\x7e\xd8\x8a\x94\xad\xf5\x67\x59\xe0\xf4\xce\x75\x89\xe3\x00\x2f\x78\xc9\xb2\x45\x01\x72\x40\x20\xf1\xea\x45\x0e\x3a\xb5\x59\x61\xec\xc8\xf4\x20\xd6\x9d\xa6\x07\x2d\x4e\x14\xc6\xcb\xa8\xc5\x4c\x3c\xc5\x4e\xad\x33\x26\x30\x16\x24\x51\xc9\xc2\x2d\x38\xac\xc9\xc3\x8c\x2a\x61\xce\xdd\x13\x1e\x7b\x15\x6c\x5f\x61\xd6\xfe\x9b\xb6\x18\x61\x6b\x48\x0f\x17\x8e\xe0\xeb\xae\xbc\x22\x17\x3a\x34\x3d\x9b\xd3\x57\x89\xb4\xe0\x66\xa5\xc5\x48\xa4\x20\x08\x60\x84\xc2\x0a\xa7\x4f\xc4\xd4\x52\x2f\x68\x5f\x11\x38\x4e\x0c\x91\x1e\x76\x63\x98\x9b\xd2\x96\xb9\xda\xbf\xe4\xe9\x83\xc9\xa1\x6d\xcb\x0d\x6f\x77\xa1\xd1\x03\x3e\xda\xcd\x85\xb6\x96\xea\xc2\x81\x94\x7b\xb9\x97\xcb\x61\xc3\x0b\x99\x74\x47\x32\x03\x2a\xb4\xea\xf1\xd3\xae\xc8\x4a\xa6\xa0\xb6\x5b\x63\x07\x08\xc8\xd4\xb7\x9f\x9f\x36\xaa\x4e\x68\x8c\x8c\x73\x8b\x62\xed\xc1\x7a\xfc\xa2\xd3\x73\x73\x50\xe9\x13\x10\x8b\x6e\xb1\xaa\x36\xad\x07\x32\xb7\x3e\x05\xb0\x92\xac\xa5\x26\xb6\xa1\x83\xe2\x34\xd8\x02\x4f\x90\x08\x31\xb2\x28\xaf\x08\xa6\x30\x0d\x4f\x9f\x52\xcd\x8c\xb1\x85\xab\xd3\x56\x90\x84\xcd\xde\x26\x10\x3b\x07\x36\xe6\x5e\xb5\x76\xd9\xb1\xd5\x8f\xf2\x64\x12\xf1\xcc\x0a\xff\x0c\x07\xbb\x99\x40\x3d\x8f\xcd\x83\x49\xbc\xbe\xc5\x90\x31\x69\x64\x83\xbf\xe5\x6b\xfd\x9f\x63\xfb\xc7\xf8\x65\xe9\x28\xb2\xf3\x8d\xa5\xad\x6f\x4a\x7c\x7b\x84\x5b\x68\xdf\xa2\xd8\xfe\x1a\xed\x77\xa1\x24\x71\x4c\x0e\xb2\x27\x17\xe7\xc8\xbc\x8b\xd4\x76\x2d\xd4\xff\xf4\xf4\xb7\x16\x27\xe8\xdc\x8f\x93\x59\x9c\x28\x4b\x0a\x1d\x30\x46\xe4\xc3\x1a\x63\x9e\x9d\x14\x1f\x1a\xc3\x06\xa2\xa5\x12\xcf\x36\x03\x60\x75\xa2\x59\x03\x44\xfe\xd7\x92\x0e\x94\xc3\x23\x98\xd7\xfa\xf0\xa8\x62\x0d\x21\x97\x1a\xa8\xf0\x4b\xb4\xe1\x81\xa1\x7b\x69\x49\x37\x55\x59\xb2\x0d\xc8\xb7\xc3\xaa\x5c\xbf\x6c\xc1\xfa\x95\x07\x51\x55\x88\xf2\x04\x11\xd2\x8f\x0e\x58\xed\xc8\x89\xba\x9c\xed\x3e\xb8\x54\xee\x62\xdf\x69\x9d\x1e\x49\x28\x75\x46\x32\xbf\x2d\x84\xf7\x43\xbd\x03\x8d\xb9\xbc\xf5\xc7\xfd\x4d\x50\x24\x9f\x94\x5b\xe7\x6d\x88\x65\x53\xb3\x9b\x3b\x89\x11\x7b\x45\x28\xad\x4e\xea\x1e\xb0\xd9\xa0\x04\x02\xfe\xdd\xc3\x89\xa6\xb1\x6e\x1a\x98\x5f\x5a\xd1\x65\x02\xa2\x3e\x02\x2d\x8b\xdf\xda\x69\x5b\x64\x03\x5a\x9f\x0f\xff\x62\xf8\xe0\xcc\xca\x9a\xe2\xb2\x08\x7d\xc8\x6e\x7e\x00\x63\x3d\xab\x26\x4b\xb2\x36\x10\x3b\xb3\x72\xb1\xcf\x15\x92\xeb\xd4\x7c\x96\xf6\xb4\xe3\x07\x82\x69\x39\xb6\xda\x78\x27\x4a\x3e\x9c\x57\xca\x7b\xa8\x79\xc9\x85\xc6\xca\xc2\x04\x2d\x60\xfc\x96\x05\x26\x88\x62\xb4\x1c\x6b\xfa\xe6\xa1\x3b\x73\xac\xf9\x2d\x31\x42\xb7\x82\xc4\x40\x1e\x81\xc8\xbf\xb6\x55\x61\x50\xea\x38\x8c\x2a\xad\x87\x37\x80\xf4\xf8\x55\x15\x3e\x26\x63\x29\x36\x3f\x87\x16\x9d\x3e\x2d\x65\x52\x7c\x4e\xd4\x95\xdb\xf9\xe6\x02\x51\x7f\x47\x5b\x97\x78\x42\x9d\x7f\x9e\x9d\x66\x49\x4d\x26\x62\x19\xa3\xca\x9b\x37\x37\x55\xc6\xf2\x47\x4f\xfa\xa6\xbe\x0b\x5f\x0b\x92\x30\x83\x5f\x06\xd3\x08\x59\x9c\x84\xc4\x7b\x61\xd5\xaf\x3f\xa1\x9c\xd5\x7d\xeb\xe5\xac\xc6\xcd\xa7\xcf\x6c\x23\x21\xdc\xd8\x6d\x33\x4e\xb8\x64\x51\x68\xe2\x8c\xe3\xba\x05\x29\xc6\xcf\x99\x6b\xff\x5f\x7d\xb2\xc5\x64\xee\x07\x73\xc7\xa9\xd6\xb1\x16\x42\x82\xb2\xa6\x63\x2d\xae\xe9\xb8\x2c\x2f\x21\xfe\xf6\x42\x78\x44\x67\x8e\xb1\xc1\x8a\x60\xda\x2b\x2e\x84\x58\xeb\x59\xe6\x58\xae\x26\xb4\xa7\x58\xb1\x24\xd2\x3f\x2a\x74\xa1\x74\x5c\x56\xfa\xfc\xd2\xc6\xcd\x34\x1d\xc0\x3a\x8b\xc1\x24\xc9\x13\x10\xa3\x8f\xb7\xab\x2f\x56\xa0\x92\x8a\x13\x4d\x35\x1b\xcd\xe6\x5d\x14\xcf\x2b\xf8\x9e\x53\x9e\xb3\x08\xa5\xc0\x8b\x7d\xd4\xa9\xa6\xf1\x32\x63\xaf\x21\x5b\x60\x12\x22\x97\x91\xf2\x1b\x1b\xab\x38\xad\x6e\xd5\x4e\xee\x04\xdd\xda\x71\x05\xc3\x0a\xed\x85\x16\x26\xc9\x0b\x53\x6c\xed\x8f\x6e\x9f\x94\xa3\xfc\x1d\xa8\x4c\xa2\xe0\x1e\x19\x9c\xeb\x38\x67\xb5\x57\x04\x8b\x4b\x19\x93\x31\x9e\x44\x70\xb9\x33\x98\x00\xc0\x8f\x5a\x39\xb8\xf2\x3e\x1e\x62\x3b\xc1\x77\x4b\x3f\x48\x42\x76\xf0\xb4\xd2\xda\xc3\xbf\x71\x2f\x6b\x3a\xfa\x26\xec\x1e\x99\x0b\xe1\x16\xef\x1c\xe9\xba\x12\x27\xc7\x32\x88\x46\xd4\xe3\x57\x5c\x3d\xac\xb9\xf7\xd9\x05\xc2\x3b\xaf\x6a\xb3\xa3\x59\x12\xd8\x90\xc1\x4b\xa6\x12\x69\x03\xad\xb8\x3e\x2e\xf6\x40\x68\x5f\xe8\xb9\x7e\x0a\x68\xf5\x47\x01\xf8\x20\xa0\x14\x96\xd6\xd1\x80\x76\xb5\xa5\x4e\xa6\x61\x32\x1a\xe3\x65\x66\xfc\x3b\x0b\x3d\x1b\x04\x51\x54\xcf\x82\x34\xd7\x98\xab\x74\xf1\xf4\xfb\xd3\x7f\x3f\x28\x22\x12\xe8\x55\xc0\xc3\x76\xf0\xc4\x0b\x4a\x88\x88\xbd\x77\x86\xa4\x0c\x97\xfe\xad\xd8\xe4\x4f\x10\xcb\x8a\x36\x1d\xf0\x68\xb2\x17\x82\x80\x7e\x0b\x08\xd0\x85\x8a\x1f\xab\xbe\xae\x92\xbe\x74\x84\x98\xc1\x66\xaf\xc2\x6d\xf0\x75\xdc\x55\x10\x3e\x17\xcc\x16\x0e\x2b\x9a\x1d\x68\x39\x7b\x50\xda\xbe\xfc\xdb\x69\x04\xcb\xa4\x7c\xfa\x17\x9b\x90\xa1\x88\xb7\xf9\xbb\xe7\xdf\xba\xdf\x1c\x79\xcf\xf5\x26\x75\x0f\xb6\x69\x73\x52\x9d\x10\x37\x41\x78\x79\x85\xe3\x68\xfa\x48\xf5\xeb\x54\xd1\xe9\x93\x5a\x9c\xb0\x45\x90\x3b\x29\x58\x5b\xa5\x7f\x8e\x2d\x35\xbb\x7a\xff\x49\xe7\x0a\xea\x15\xee\xb9\x4b\x66\x39\x73\xc8\xae\xa4\x92\xf0\x6a\xb4\x05\x2f\xcb\x67\x4c\xff\x9c\x5c\x63\xd3\x8c\xe0\xba\xb2\xcd\xe9\x44\x69\xd9\xfb\x9c\x8a\xce\x8e\xea\xa3\x43\xad\x46\x18\x57\x87\xcc\x31\x2e\x37\x8a\xdd\x6e\x5f\xc4\xd5\x28\x14\x11\x05\x78\xc9\xaf\xe7\xb2\xa8\x2e\x54\x5c\x10\x61\x49\x21\x3f\x13\x62\xb3\x47\x1a\xac\x2e\x6f\xac\x88\x3f\x0a\xac\xe0\x86\x75\xe9\xea\xbc\xbe\xe6\xbe\x73\x88\x3e\x68\x0c\x34\x1b\xc7\xed\x67\x75\x53\x13\x50\x66\x53\x4b\xbe\x9b\xd2\xfe\x7c\x06\x81\x7d\xd0\x8d\x6a\xce\xfc\x60\xb9\x9a\xf8\x13\x69\x02\xe1\x21\x44\xbe\x26\x9f\x44\x21\xd2\xf6\x37\xcb\x35\x53\xcb\xfc\x94\x20\x85\xee\xd5\x43\x2d\xa1\x24\x3d\x98\x93\xe7\x98\x52\xbe\x6b\xdd\xf3\x02\x5e\x7f\xb0\x0d\x10\x4a\xf5\x2c\x88\x52\x3d\x93\x0c\x3f\x85\xb6\x9b\xba\xb6\x04\xd1\xc0\x50\x7d\xd0\xeb\x18\x77\x18\x52\x95\x84\xdc\xa1\xea\x0f\x7b\x26\x3e\xf5\xf2\xea\xad\x05\x54\xc8\x16\x1c\xbf\x5b\xd1\xf1\x86\x5a\x19\x2d\x44\x0f\x64\x58\xd2\xb0\x96\xd5\x69\x1e\x95\x9f\x5d\x0b\xa2\x08\x56\x3e\x6c\x78\x39\xa8\x09\x5c\xc4\x27\x48\x64\x4d\xb7\x95\x46\x8e\x46\xba\xdf\x81\x01\x32\xcf\xd8\x55\xc2\xf4\x60\xf1\x11\x77\x80\x25\x40\xf4\x00\xa1\x50\xcb\xe2\x75\x5c\xb5\x4c\xf3\xa9\x88\x37\x50\x11\xfd\xd8\x18\xfe\x09\xba\x1d\x6b\x33\xc8\xe0\xa0\x69\x49\x1e\xa2\x0e\x5d\xcc\xf5\x7a\x0f\x19\xec\x7a\x04\x00\x58\x52\x63\x52\x38\x52\x8d\x95\x3f\x41\xb3\x1d\xae\x30\xd1\xb2\xd4\xc5\xbe\x75\xd6\x4c\x10\x4d\x08\x76\xfa\xcf\x29\x01\xef\x16\x32\x27\x92\x46\xee\x43\x2a\x3d\xd2\xac\x66\x23\x1c\x2d\x3e\x36\xf7\x6d\x1b\xef\xc5\x00\xb6\x1b\x98\x96\xd3\x79\xfd\x13\x3f\xfa\x61\x1a\xdc\xe4\x0a\xf3\xf1\xdf\x1c\xb2\x43\x8d\x81\x3b\xff\x1b\xf3\x87\xad\xef\x4a\x6b\x3a\xf3\x56\x4a\xb7\xb2\x26\x84\x28\x2a\x3d\x7c\x8e\x0b\x9d\xe4\x53\x53\x31\xe9\xb4\x0c\xe2\x28\xc1\x9c\x65\xee\xb2\x3d\x00\x8d\xb7\x3e\x86\x41\x9f\x47\x13\xea\x94\xc7\x4a\x5d\x43\x85\x1e\x24\xc7\x27\xff\xf3\x9e\xe5\xb5\xd3\x6a\x3e\x29\x5c\x4c\x55\x37\xb2\x11\x40\x75\x36\xbb\xdd\x72\x02\xe3\x39\xeb\xe0\x6f\xb6\x16\xc9\x14\xd3\xdd\xae\x3d\xbd\xff\x9e\x5f\x93\x86\xbf\x1e\xf2\x89\x72\x6b\xe3\x9a\x4f\x75\xb9\xa2\x60\xc3\xf3\x27\xfe\x36\x23\xda\x6d\xe7\x54\x7c\x12\xab\x7f\x79\x61\x57\x76\x84\x77\xec\x0e\xa2\xad\x88\x4f\x4a\x50\x7a\x36\x18\x00\x9d\xe9\x18\xc4\x0f\xa6\xc1\x0a\x92\xf8\xfb\x41\x25\x0c\x0f\x0d\x96\x55\x70\xaa\xfa\x28\xd0\xb8\xd0\xec\x56\xb4\xee\x40\xc1\xee\xdb\x75\x97\xc6\xc3\x2d\x59\xb9\x64\x08\x6f\x1e\x63\x40\x08\x2f\xb3\x93\xec\x1c\x9c\x4d\x18\x75\xb1\xf0\xf5\xb5\x10\x8c\x07\xdc\x7e\x51\x6b\x59\x71\x7a\xaf\x1d\xa8\xbb\x64\x50\xa4\xdc\x56\x62\xbf\x42\xcd\xb6\x3b\xc7\xdf\x78\x26\xe9\x88\x78\x1b\x42\x8e\xaa\x5d\x3b\xb6\xc4\x54\x3d\x36\x1b\x9a\xc3\xdf\x91\xb5\x58\x28\x59\xb7\x4b\x95\xc6\x67\xbf\x6a\x63\xa1\x24\x3e\x6b\xb2\x5c\xb3\x82\x08\x1d\xe1\xae\x95\x6f\x52\x9d\xa3\xbd\x4c\x14\x2d\xfd\xf9\x60\x35\x03\x69\x78\xb1\xc1\x6f\xb4\x5f\x05\x42\x12\x4d\x04\xc6\x29\x1e\x15\xb0\xfa\x70\x9d\xec\xf6\x7b\x0f\xb5\xc4\xcc\xf0\xde\x97\x67\xf5\xdd\xaf\x30\x23\x74\x6f\x2e\x2c\x54\x0e\x7f\x8d\xaa\xd2\x7a\x7f\x4f\x0e\x55\xd9\x33\x22\x1c\x76\x01\xc2\x6f\xf7\xba\xdf\x0c\x54\xb5\x83\xdd\x2e\xcb\x0a\x13\x89\xd3\x7f\x50\x7a\x8b\xfa\x52\x86\x4c\x26\x8a\xe3\x9c\x12\xdc\x00\x42\x89\xee\x31\x8f\x89\x5f\x37\xfa\x63\x6d\x1f\xb5\x36\x94\x7d\x1c\xaa\x5f\x38\xc6\xf0\xc8\x2a\xe7\x40\x00\xbd\x2e\x94\x44\x04\x78\x81\x88\x4a\x59\xd3\x87\x14\x1a\xfa\xd3\x51\xe3\x01\x37\x62\xae\x29\xb7\xa6\x82\xb6\xac\x06\x8c\x81\x29\x6e\x5e\x35\xcd\xed\x2f\x07\x01\x97\x3e\x09\xa3\xe2\xaa\x43\x0a\x1e\xab\x1d\xd1\xa4\xb6\xb4\x70\x41\x90\x5d\x15\xd1\x82\xed\x94\xa2\x81\xbd\x19\x46\x66\x58\xc5\x11\x7e\x3e\xfb\x9e\x71\xbf\xbc\x54\x71\xf9\xa9\x61\x25\xd1\x5f\x1c\x40\x8b\x47\x82\x96\xe0\x29\x3b\x8b\xc4\xab\x2d\x60\xa5\xd4\x60\xca\xca\xb3\x35\x8d\x10\x45\x6c\x30\xb0\xbc\x9a\x7c\x8d\x75\x6c\xb7\x70\x2e\x4e\x12\x40\x66\xdc\xbb\xc2\x1d\xbf\x0a\x99\x8d\xdf\xcc\xc4\x22\x8b\xa0\xd5\xe9\xff\x0c\xcf\xfe\x0a\x80\x8b\x7b\x80\xad\xdb\xdf\xbd\x19\xd3\xb7\xb3\x6b\xbe\x4d\x39\xe1\x19\x13\x26\x8b\x3e\xb7\xa7\x35\x10\xfd\x0c\x2d\xba\x0b\x30\xd4\x05\xa6\x97\x72\x31\xf9\x74\xc0\x33\xea\x90\x78\x65\x47\xd5\x66\x55\xf9\x5c\xc0\xa6\x5a\x19\xe3\xaf\x9d\x3e\xa1\xcc\xdd\x3f\x29\x22\xe5\x0e\x06\x98\x8b\x10\x1d\xa1\x9a\x6b\xbd\xb8\x69\x4b\xe4\x2f\xe7\x8f\xd4\x7b\x42\x4d\xa8\x39\x2c\xec\x5e\xe3\xe2\x80\xce\xd2\x77\xd8\x3c\x78\xe6\x14\xc2\x17\x18\x0f\x47\x69\xe4\xed\x13\x11\x3f\xbd\x71\xc7\xb7\x11\x3b\x39\x24\x33\x08\x13\xbd\xa8\x95\x73\xec\xeb\x40\xc8\xe2\x7a\x58\x2a\xd7\x27\x57\x96\x64\x3c\xaf\x2e\xef\x16\xa2\xb6\xe9\xb0\x54\x64\xd7\x24\x5f\xf4\xd3\x47\x51\xd2\x83\xe8\x52\x82\x4b\xef\x03\x8a\x9f\xe3\x97\xed\xd1\xa9\x62\x3b\x6f\xca\x43\x11\x89\xe2\xbf\x99\x97\xf2\x0e\x84\xc1\xa5\x93\x4a\x24\x15\x4d\x0f\x65\xfb\x02\xbb\x99\xd9\xac\x87\x06\x54\x48\xde\x6d\x0b\xf3\xcf\xf2\x20\x7a\x15\x23\x92\x67\x62\x7e\x40\x2e\x5a\x24\x8b\x13\x6d\xef\x53\xab\x63\x7f\x90\x51\x65\xb2\x82\x25\x3b\xa4\x1c\x3d\xb0\x92\xae\xc5\x71\x59\x63\x31\x06\x28\x4d\xfa\x70\xea\x94\xc0\xcb\x3a\xdd\x5f\x03\x11\x15\x0d\x14\x94\xf1\x4b\xda\xdd\x98\xf6\x30\x33\xa6\xd9\xfe\x8b\x8f\x6b\xe8\x9a\xf9\x13\x87\xf8\x03\x0d\x6f\x59\xd7\x79\xa5\xad\x58\x63\xab\x09\xff\xbd\x74\x5e\x1b\x7e\x8e\x7d\xbe\x68\x82\x7e\xc3\xfd\xd4\x4a\x5a\x31\xfa\xf6\x02\x6b\xfa\x5e\x38\xbf\x7a\x55\x62\x22\xd0\x45\x19\x6c\x12\x21\xf2\xa8\xa9\xc4\x3c\xdd\x28\xeb\x7c\x9e\xa9\xf5\x48\x1b\xb0\xbd\x41\x93\x5f\xab\xfe\xb0\xca\x4c\x77\x0b\x76\x82\xc0\x13\xfc\x25\x6e\xb9\x7a\xd7\xf2\x32\xb2\xd8\x24\x34\xbb\x18\x64\x21\x45\xd8\xa1\x8c\xc6\x28\x95\xb7\x05\x87\x82\x50\x71\x9e\xc6\x87\x02\x38\x78\x67\x5c\x56\x15\xc7\xad\x23\x43\x1e\x2f\x0d\xf7\x31\xba\x18\x31\xe7\xec\x53\x90\xd7\x82\xd0\xb9\x95\x1b\x14\xb8\x54\x68\xf4\x4c\x60\x6b\xf9\x61\xe1\xa2\x21\x09\x8a\x2b\x92\x7f\x7f\x73\x80\x53\xa4\x78\x17\xc9\x42\x37\xd4\x7d\xeb\x9b\xca\xcd\x48\xa0\xfc\xe0\x22\x62\x97\xa8\xc4\x7c\xa8\x5b\xc4\xce\xd9\x25\xd3\x71\xeb\x4b\xc3\x28\xbd\x83\xd8\xee\x42\x79\x0a\x91\xa4\xd8\xd8\xb0\x55\x6d\xb7\x53\x6a\xfe\xd3\x07\xba\x9c\x82\x93\x2c\xe2\x6c\xbd\x5a\x6c\x4d\x4c\xa0\x44\xbd\x05\x02\xe7\x5c\xb7\x9a\x9c\xaf\xb5\xdf\x4b\x97\x57\x94\x18\x36\xbd\x90\xc7\xf8\x05\xe9\x09\xb0\x60\xa4\xc5\xc5\x72\xd7\xad\xac\x72\xf5\xd5\x92\x05\x22\xc0\x64\x89\x1e\x00\x39\xe2\xb1\xc3\xb9\x58\x98\x95\x6f\xd1\x27\xc1\x66\xce\xab\x50\xe7\xd0\x2d\x38\x6b\xc1\x7b\x70\x15\xdc\x39\xcb\xfa\x75\x8f\x01\x7d\xf0\x00\x97\xba\xae\xc4\x2e\xd1\x00\xc9\xa5\x31\x8a\x4a\x77\x87\x83\xf2\x46\xab\xe5\x9d\x4b\x02\x8b\x66\xf0\x8a\x3a\x1f\x0b\xb8\x53\x82\xd2\x0a\x27\xb0\xfe\x8f\x0b\xff\xbd\xa7\x24\xc3\x48\x05\x1e\xc0\xf1\xb3\x7a\xd0\x09\xd0\x8c\xc0\x53\x52\x99\x74\xa4\x90\xd2\x94\x7a\xf7\x6c\x17\x7c\xe9\x89\xaa\x39\xaf\x5d\x91\xd3\x77\xdf\x34\x92\x33\x59\x8a\xc0\xa6\xee\x41\x7f\x05\x07\x09\x76\x92\x86\xc3\x1f\x67\xee\xca\xdc\x39\xd3\xbc\xaa\xed\xda\x1e\x77\xcb\xc1\x7a\xe5\x42\x9b\x0a\x97\x0a\x6e\x4f\x1d\x4c\xe2\x9d\x25\x12\xe0\x0a\xa7\x48\xf7\x6a\xb4\xb8\x15\x00\xe8\xf5\xce\x6f\x83\x57\x74\xc3\x81\x0c\x01\xdd\x6e\xc7\x77\x24\xeb\x9e\x99\x2e\x0f\x80\x05\x7d\xa1\xb9\xa3\x04\x29\x2e\x65\x81\xca\x40\x1b\x10\x5e\xab\xc3\x1e\x12\xa7\x24\x94\x6d\xd0\x4b\xfa\x67\xd2\xd9\xf6\x1e\x9b\xfc\x8a\x04\xa7\x5b\x6d\xa2\xf0\x16\x8a\x4a\xf2\x2d\xa5\xcb\xdd\xff\xb1\x9e\xdd\xa5\x19\x33\x18\x6d\x48\x1e\x2f\xc0\x57\x47\xbe\x10\xeb\xca\x1d\x07\x24\x8a\xbd\x10\x25\x6f\x61\x1b\xc9\x5d\x94\x9f\x2f\xe4\x28\x25\x67\x92\xa3\xbb\xaa\xc3\xfd\xd8\x9b\xb0\xd8\x46\x1f\x7b\xbf\xa6\x84\x58\x9f\x9c\x39\xff\x31\xf6\x20\xf2\xef\x0a\x97\xcb\x9a\xf6\x88\xd1\x25\xaf\x34\xff\xac\xe9\xfb\x8c\x97\xda\xf9\x9f\xe4\xa1\x94\xc1\x7b\x10\x65\x5e\xc7\xe9\x3e\x47\x16\xc0\x7f\x3a\x57\xa9\x76\x43\x8d\x80\x4e\x55\xb2\x6a\xa1\x61\xfa\x00\x06\xcb\x39\x71\xf4\xc3\x01\xa5\xad\xfc\xdc\x08\x34\xb3\xf3\x60\x9a\x98\x1c\xb8\xf0\xc2\x5a\x56\x2a\x4d\xf2\xe0\x91\x17\xe2\xbd\x6e\xf9\x0a\xfb\x86\x15\xd7\xcf\x48\xa3\xc2\x26\xfa\x29\xee\x7e\xf1\x16\x5b\xc0\x64\x61\xd1\x1d\x3d\x3e\x34\xa1\x65\x9c\x5e\xbf\x86\x33\x59\x07\x5e\x6e\xe9\x8b\xfb\x75\x6b\x69\x2f\xb5\xa8\xae\x30\xe0\xf0\x91\xac\xe9\xb8\xad\x22\xbb\xe2\x3f\xa5\xee\x5a\x61\x72\xcf\x1d\x01\xc0\xaa\x85\xb3\xc3\x57\x3a\x37\x56\xf1\xb3\x1a\xed\xd9\x37\x9a\x11\xcb\xaa\x0b\x2d\xaf\x4d\xbd\x75\x00\x28\xec\xdf\xe3\x07\x43\xe9\x6f\xc1\x37\xe3\x26\x21\x04\x9e\x5f\xbc\x74\x69\x45\x5b\xad\x82\xb2\x43\xe1\x69\xe3\x02\xd2\x22\x10\x92\x77\x20\xc1\xcf\x88\x32\x42\x95\xfb\x45\x76\xe9\xbe\x23\xe4\xe8\x06\x40\xf8\xc9\x25\x95\xab\xe7\x44\x74\x66\xc2\xec\x36\x63\x86\x51\x5d\x2f\xe8\x19\x26\x12\xc6\x41\xf4\x97\x3b\xa3\xad\xd7\x57\x3d\x69\xf7\xec\x93\x80\x95\xdb\xa0\x5d\xbe\x6e\x96\x36\x7a\xb6\xfd\x43\x1e\x10\xe6\x5e\x58\x76\x6e\xba\x1c\x3c\x13\x81\xbc\xb7\xd4\xbe\xfb\x5f\x45\x39\xce\x4d\xf1\x8e\x49\xef\x09\x58\x41\x19\x9b\x3f\xbf\x85\xd2\xf9\xc2\xa0\xda\x86\xaf\xa8\x33\xb5\x16\x4a\xd2\x70\x14\x06\xa3\x92\x5d\xd4\xbf\x9d\x8c\x4a\x1b\x8f\xc1\x91\x85\xe7\xc4\x7c\xbb\xdb\x18\xcf\x71\xea\x87\x66\x43\xb6\x20\x88\xf5\xf7\x6b\xa0\xde\x86\x86\xc1\x16\x3a\xe6\x14\xa4\xa4\x70\x03\x38\x97\xa6\x75\xe0\x15\x3d\x8d\x9a\xd3\x8c\x25\xb9\xe0\xec\x6b\x78\x28\x33\x5e\x25\x06\x9f\x16\xe3\x8d\x7c\x45\x60\x8d\x65\xa7\xe7\x7d\x08\x40\xf3\x2f\xcb\xdd\x26\x4b\xa1\xd4\xd4\x5c\x51\x86\x7e\x00\xc8\xf6\x10\xf7\xb1\x7e\x73\xa2\x2e\x44\x5b\x1b\x56\x3b\x78\x96\x35\x2f\x91\xa9\xb9\x45\x60\x72\x93\xcb\x19\x8c\x50\x0c\x77\x25\x5f\x4e\x99\xca\x3b\x68\x02\x7c\xc4\xe1\xda\xf6\x21\xd4\xb0\xd4\x33\xe3\x83\xde\x0a\x11\x71\x06\x20\x28\x75\x00\x2a\x0d\xf8\xd1\xd9\xa3\xba\xad\xd0\xc1\x3a\x76\xaf\x0f\x7d\x79\x1d\x58\x4c\xdc\x2d\x67\x29\xd7\x82\xb5\x4c\x1e\xff\x5a\xd4\x76\xad\xe7\xde\x89\x7c\xf4\xb0\x5e\x15\x24\xfa\xa4\x2e\xd5\x1d\xd8\x0f\xff\x89\x39\x38\xb9\x4a\xd0\xb1\xe8\x84\x12\x31\x47\x77\xfb\x2a\x6e\xeb\xbd\x03\x2e\xf0\xa9\x78\x7e\xcc\x1a\x1b\xb1\xcd\x69\xd5\x2e\x57\x83\xd3\xc5\x60\x73\x96\x22\xbf\x81\xbb\x3d\x1a\x00\xd6\xa1\xdb\x6d\x5c\x9b\x2a\x1a\x78\x3c\x7f\xc0\xd2\x70\x59\x81\x6b\x23\xe7\x18\x02\xbd\xda\x11\x18\x08\x1c\x25\xfe\xe9\x5b\xf1\x26\x8d\x5d\xf6\xf6\xb3\x70\x8b\x28\x82\x81\xac\xf9\xa8\xe6\x65\x3f\x1c\x03\x9a\x08\xe6\x6b\x2a\xea\xa0\x8e\xee\xba\x07\xd1\x94\xbe\x3b\x45\x3f\xb1\x36\x10\x60\x98\x8c\x4b\xe7\x5c\x62\x77\x01\x41\x55\xd9\x61\x64\xbe\x01\x48\xb6\xdd\x93\x8b\x62\x19\xd4\x7d\xb0\xc7\xe4\x64\x0b\x34\x77\x53\xe2\xf9\x18\xd1\x1f\x0d\x09\xf6\x4d\x7d\x65\x2c\x37\xf9\xa7\xc7\x52\x43\xe4\xd8\x4a\x7a\xa8\x8c\xfd\x20\x47\xc0\xb1\x9c\x28\x10\x8c\x5d\x0b\x7e\x2b\xfe\x3c\x6f\xe4\xfa\xcd\x75\xe1\xc0\x6e\xfa\x91\xf6\xc8\xde\x4b\x5d\x1a\x14\xd4\xaa\x9d\x56\x9e\x02\x02\x22\x9f\x57\xbf\x58\x7d\x7a\xe3\xb2\xee\xdb\x36\x62\xbb\x7c\x41\xd7\xc1\xfc\x1f\xeb\x86\x5b\xf0\x39\xa7\xa4\x43\x35\xf5\x8b\x2f\xd1\x55\xda\x79\x56\x91\xab\xa6\x91\x12\xac\x2c\x14\x7d\x7f\x3f\xde\x5e\x82\x1d\x1d\x93\x4f\x0f\x13\xd0\x8c\xb2\x39\xae\x33\x9c\xed\xe2\xd5\x27\xde\xb1\x38\x10\x9a\x45\xc7\x2a\x3b\x10\xfa\xca\x46\xc2\x8c\x10\x74\xc1\x4e\x6f\x4f\x44\xb6\xae\x79\x5c\xa6\x9a\x5e\x3c\x4e\xda\x2b\xa6\x0c\x81\xdd\x23\xc2\x0b\x4b\xa7\x80\xca\xfc\x3d\xb6\x7f\xf3\x78\xd1\x59\xd1\xcf\x80\x62\xb9\xbe\x3e\x09\xa2\xb6\x5e\x0d\xf4\x7e\x27\xd0\x14\x39\x96\x3f\x46\x35\xa6\x6b\x92\x6a\x09\x3f\x55\x3c\xbf\x2e\x74\x1b\xdc\x3e\xf8\xb1\x0e\xc6\x2d\xdc\x8f\x02\xad\xee\xc9\xaa\x25\xb3\x05\x49\xfa\x16\xf4\xb9\x16\x24\x17\xd9\x3a\x3e\x25\x6f\x63\xdb\x4e\xc6\x41\xbd\x42\x25\x80\x79\x06\xf9\x97\x84\xd1\xd0\x25\x3b\x0a\xd8\x55\x4d\xbc\x07\xa6\x28\x76\x55\xdd\x5c\x2a\x21\x60\xb2\xb7\xbe\xf9\xb9\x7a\xc0\xd7\x98\x59\xbd\x70\x41\xad\xfb\x92\x77\x6b\x61\x9d\xa0\x05\x14\xf5\x4e\x14\x80\xdc\xcd\x84\xa8\x39\x72\x8e\x77\x78\x2e\x4d\x77\xc6\x6f\x6b\x46\xd2\x04\x5d\x82\x19\xb4\x16\xb7\x88\x15\x08\x59\x40\x03\x99\x2a\xac\xa8\xa3\x5e\xac\xf3\x0e\x1b\x2f\x6d\x3c\x6a\x1d\x42\xd4\xe1\x26\xda\x32\xda\x2a\x4d\xda\xc8\xa1\x78\xd7\x1b\x52\x98\xf0\x82\xac\x77\xe2\xb4\x23\x27\xf3\xc0\x94\x91\x00\x30\xb9\x36\x79\x11\x65\xbf\x98\x4e\x8e\x6a\xd3\x8f\x7b\x4a\x9c\x2e\x7b\x32\x2e\x3e\x0c\xcd\x0e\xe4\xc9\x2b\x29\xc6\xe8\x17\xe7\x2a\xa1\xa6\x09\x48\xb8\xbd\xc6\x74\xb4\x4d\xc8\x27\xad\x55\xc4\xf2\x66\x68\xba\x00\x88\x7a\x85\x1d\x7c\xef\x6a\xbf\xd0\x5d\x3c\x85\x4b\xc8\x6e\x1e\x59\xee\x80\xb2\xc7\x4d\x6f\xc4\x75\x5e\x35\x1a\x10\x63\x18\x2a\x1f\xdd\x6e\xc2\xaf\x0c\xbd\x50\x92\x66\x57\xca\xfd\x0d\x86\x17\xa2\x1d\xde\x23\xf1\x72\xf5\x28\x71\x6e\xeb\x37\xff\x10\x7a\xe1\x8f\x7f\x75\x66\x4f\xe9\x39\xf3\x95\xa7\xb5\x93\x55\xab\xd7\xef\xab\x48\xc2\x98\x9b\xb2\xc6\x0f\x86\x84\x38\xb7\xab\x0b\x94\xda\xe4\x9b\xfa\x1d\x21\x60\x9a\x6b\xca\xbb\x39\x90\x9b\xa0\xb0\x65\xea\x73\x5a\x88\xa8\x38\x51\x3c\x46\x45\x84\xce\x2f\x10\x03\xd4\xfb\x08\x21\xba\xaa\x24\x40\xf7\xec\x84\x13\x75\x13\xb4\x80\x40\x4d\x86\xdd\x9b\x28\xdc\x28\x2f\xa6\xd6\xb8\x04\xa2\x29\x8c\x02\xb6\x0d\xa1\x9c\x99\x4e\xce\xe8\xc1\x01\x75\xf2\xb4\x47\xa9\x28\xa9\xa6\x2c\x8e\x94\x03\x80\x2c\xeb\xfc\xb5\xc4\x67\xcf\xe2\x47\xc8\xc6\xe8\x77\xe7\x32\x77\x90\x13\x7d\x83\x24\x99\x1f\x27\xd0\x09\x0a\x94\x4b\x31\x20\xb0\xca\x99\x8c\xa9\x10\xc4\xbd\xec\x7a\x54\x67\xd9\xd2\x21\xe8\xca\xa4\x5f\x17\x4a\x60\x89\xd0\xc9\x21\x9a\x1e\x35\x44\x56\xfb\x67\xa1\x30\xed\xf8\x95\x30\x5d\x54\x32\xee\x58\x6e\x48\x28\xf6\x82\xbc\x0b\xd0\xd5\x82\xcb\x2b\xe3\x17\x82\x6b\x65\x02\x38\x6e\x32\xb0\x8e\xc3\x7a\xa1\x1b\xc4\xb2\x21\x58\x32\x0a\xe1\xba\x8d\x5f\x5a\x28\xa6\xaf\x46\xda\x25\x10\x18\xc7\xe5\x21\xcf\xb4\x14\xc2\x5b\x72\x65\x18\x05\xb8\xcc\xa3\xb9\xd4\x79\x6a\x2d\x71\x8f\xbb\xf4\x06\x90\x5c\x8a\xcd\xcd\xa9\x74\x8b\xe7\x48\x91\x77\x72\x5d\xbb\x8c\xfe\x18\x5d\x1a\x86\x4a\x19\x54\x90\x3e\xff\xc4\x94\xaa\xa2\xfd\x24\x8f\x6f\xf4\x92\x7d\xec\x1a\xb0\x5a\xa1\xc2\xf2\xd0\xd2\x2a\x23\x6b\x24\x0f\x84\xc6\xc1\x17\xcf\xdf\x49\x79\x83\x20\x08\xa2\xb6\xc7\x59\xb3\xf1\x95\x0e\x03\xf9\xb1\x96\x8d\xb8\xeb\x17\x8a\x65\xee\x9c\x5b\x97\x30\x1c\xd6\x74\x5a\x52\x71\x4b\xc4\x54\x1f\xe8\x12\x91\x8a\xf3\x31\xb2\x5f\x1c\xfb\x4b\x2d\x42\xfd\xd5\xdf\x7c\x20\xf1\x33\xb6\xaa\xe7\x86\xe1\x42\xa7\x10\xeb\xba\x0e\x06\x00\x52\x68\xf6\x80\xd7\x89\x66\xbb\x45\x1e\xb4\xe5\x45\xb0\x17\xfd\x1c\xdf\x0f\x24\x16\x8b\x58\xa8\x90\xec\x7d\x4b\x6f\xa2\x38\xb7\x19\x59\xf7\x48\xda\x25\x50\xe1\x67\xb7\xec\xe4\x89\x56\x9e\x4d\x3f\xf5\xe7\x3e\x2d\x0e\xa8\x0c\x3b\x9d\x02\xb8\x08\x8b\x36\x06\xd1\x7e\xa3\xbe\x1b\xca\x0d\xa9\x16\x57\x1a\x00\x33\x96\xfc\x02\x9c\x30\x90\x89\xc4\x4d\x41\x01\x5a\xfe\xb1\x18\x8b\x97\xb9\x03\x90\x48\x98\x1a\x22\x0e\xa3\xf2\xc2\x9c\x52\x5f\xb8\xf5\xca\x42\x57\xbf\x5f\x64\x6e\x05\xfa\x08\x93\x01\xa7\x75\x59\xf9\x7e\xa6\x8e\x4c\x42\x9b\xe2\x3b\x20\xeb\x1e\xc5\x9a\x69\x46\xd6\xbb\x7f\xdb\x37\xbe\x0d\xa7\x09\x3a\x8b\x24\x62\xdd\xd6\x67\xff\xd7\x89\x60\xc4\xdb\x78\x64\xeb\x54\x74\xe6\x54\x3c\xb7\x85\x28\xa7\x99\xd8\x15\x93\x82\x2c\xf9\x59\x2e\x3f\xd0\x39\x54\xfe\xec\xf4\x4b\x61\xb0\x42\xdd\x00\xb7\x9c\x8f\x20\x0b\xf4\x7b\x48\x26\xcc\x48\x6b\x7a\x8b\x34\x0c\xce\x6e\x77\x5d\xe1\x0b\x33\xf0\x8c\x18\x96\x64\x77\x07\x97\x0b\x19\xeb\x37\x9c\xaa\x83\xbe\xda\x1e\xb2\x7b\x70\x0c\x11\x5a\x73\x5d\xb6\xb4\x96\x05\x9e\xb2\xaf\x3c\xe5\xe5\x4f\xc7\x29\xba\xbc\xe3\x99\x30\xd4\x63\x24\xd2\x70\xb8\xee\x18\xd1\x16\x43\x44\xf6\x6c\xfc\xca\x65\x0d\x38\x4e\x0f\x24\xe7\x9a\x70\x60\x67\x21\xf7\xd5\xe9\xef\xe4\x67\xdf\xf0\x42\xb8\x9b\x54\xef\x55\x75\x61\xdb\xe0\xdc\x20\xf0\x8a\x47\x1b\xad\xab\xee\x3c\x84\xaf\x8c\x6f\x20\x52\x99\xb9\xf3\xb5\x6b\x40\x68\x6d\x10\xa8\x04\x9b\x30\xb5\xc7\x18\xd1\x0c\xd9\x8a\xab\xc3\x45\x45\xd6\xeb\x6b\x0f\xbd\x44\x5f\xa8\x27\x9a\xc3\x5f\x93\x7a\x74\xc3\xf9\x83\xe2\xfa\x83\x92\xd2\xe5\xb5\x6e\xa0\xfd\xac\xc6\xb2\x8a\xcd\xfc\xdf\x5e\xe4\x69\xf9\x77\x10\x84\xb9\xcd\xc4\xdf\x64\x9d\xd3\x4a\x98\xc7\x96\x22\x1d\x05\x9a\xbf\xeb\xae\xe1\xec\x58\x2b\xdb\x49\xad\x3f\x70\x44\xf6\x4e\xe6\x7e\xd0\x60\x66\x7f\xd3\x9a\x1d\x48\x2d\x98\xef\xb4\x01\xa4\xd2\x4f\xc5\xef\xf8\x16\x73\x98\xd3\xf0\xbc\x50\x92\x03\x8a\x64\x47\x52\xd9\x17\x04\xe9\x00\x2e\x4d\x7d\xf5\x4e\xff\x04\x68\x35\x9c\xd5\x62\xbf\x73\xf9\x09\xac\xec\x0a\x8f\x6f\xa8\x5f\xa3\x83\x67\xdc\x96\xf3\x77\x87\xda\x41\xf0\x89\x97\x36\x37\x55\x91\x07\x42\xe2\xc0\xb7\x6b\x3c\x25\x26\x63\x30\x30\x51\x86\x11\xa5\x37\xb7\x0c\x72\xb9\x71\x98\x8e\xc3\xba\x05\x2b\x5c\x5e\xd3\xab\x04\x7d\xab\xd7\x92\xc8\xee\xd6\xf4\x34\xb2\x2b\x0c\xd0\xa0\x50\x13\x47\x4e\x8a\x9a\xf3\xe2\x5e\xd8\x0e\x0f\x84\xfc\x6b\xca\x42\xdb\x6d\x0b\x96\x1d\xc8\x5b\xea\xd3\xcf\x2d\x22\x1f\x6e\x3c\x02\x71\x39\x7b\xd5\x5a\xec\xd4\x5a\x1c\x00\xab\x0d\xa6\x41\x3a\x08\x83\x21\x09\xe0\x22\x0a\xa1\x42\x0a\xe0\x2a\xba\x75\x96\xf9\xff\x56\x02\x95\xf7\x77\x41\x94\x55\xac\xb2\x25\x77\x78\xad\x12\xe7\x2d\x2d\xc2\x5a\x98\x60\x29\x79\x0c\xc8\x1f\x67\xcb\x62\xbf\x16\x98\xbf\x65\x64\xdf\x48\x14\x35\xab\x23\xf2\x5e\xb9\x8d\x70\xfd\x0d\x49\xf8\x17\x93\x26\xdb\xd8\x88\x1b\x23\xc7\x92\xde\x06\x56\xb3\x82\x10\x8b\xcd\xa6\xbe\xa1\x67\x02\x97\xf3\xe5\xf4\x89\x57\x7e\xf1\x73\xec\xd0\x8f\x25\x47\x58\x7e\xdf\xf0\xc0\x54\x7f\xd5\xac\x4f\x00\xaa\x20\xa0\xb8\xec\x3c\xf9\xa4\x50\xaf\xe5\x3e\xb2\xc4\x62\x39\x92\x15\x73\xaf\xf8\xe2\xb9\x32\x3b\x20\x6c\xce\x42\x3c\x69\x72\xdc\x04\x55\x1a\x9f\x0b\x35\xf2\x34\x73\x8c\x0c\x75\x4d\x7a\x88\xed\x13\x1f\x91\x6a\x01\x95\xf1\xbb\x6b\x61\xdf\x7e\xd2\xcf\xf1\x83\xbf\x4b\x74\xfa\x04\x05\x3f\xca\xa5\x43\x7c\xda\x08\xa0\x4f\xfe\xfe\x23\x3a\x73\x22\x35\x03\x0a\xda\x10\x5d\x49\x73\x5d\xbe\x7d\xf5\xad\xbf\x94\x4b\xf7\xfb\x28\xe5\x75\x64\x73\xb8\x9a\xb2\xe0\x44\xed\x75\xa0\x81\xcb\x5d\x66\x95\x65\x02\x38\x8f\x3a\xd7\x0f\x0a\x7e\xe6\xbd\x84\x89\xe3\x1d\xb0\xfe\x22\x87\x21\x16\x98\x90\xed\x8d\x66\x85\x04\xff\xe3\x2e\x22\x1c\x96\x98\xe3\x6c\xc0\x19\xf5\xb1\xa0\xcf\xdf\x77\xfe\xad\x29\x6f\xa1\x08\xde\x0d\xb0\xbf\x1c\xdd\x3d\x14\x48\x9c\xd7\xf6\x62\x23\xab\xba\x93\x52\x8b\x6b\x61\x7e\x57\x1b\xe0\xc7\x6e\x4a\xd9\x52\x59\x3c\xc3\x54\x0e\xdb\x5f\xb5\xc4\xee\x1f\x10\x3f\x69\x1d\x4b\x0f\x55\xfd\x96\x74\x5c\xc2\x60\xb0\xdf\x6c\x58\xc2\x20\xc8\x00\x58\xab\x5b\x80\xea\x0a\x8b\xe9\x05\xdb\xfe\xbd\x44\x51\x1d\x1d\xbd\x7e\x86\xf8\x95\x8a\xc3\xc9\x09\xba\x23\x57\x50\xe6\xcb\x5b\x01\x98\x82\xd8\xef\xf8\x6f\x0f\x7f\x3c\x2a\x3b\x92\x1a\x9e\xfe\x18\x9e\xf0\xe9\x9f\x0e\x1b\x45\x14\x40\x94\xc5\xf4\x6c\x32\x2c\x69\xc8\x58\x11\xa5\xf3\x36\x6a\x7c\x39\xea\x04\xfe\x52\xc7\xe3\xb2\xc6\xa3\xea\x4e\x09\x64\x28\x49\x1b\x0e\x73\x86\xe7\xbe\x5b\x0a\xd1\x01\x80\x3f\x54\x34\x21\x0e\xe6\x1b\xe8\xb6\x30\x52\x36\x8d\x9f\xf3\xaf\x3e\x7c\xdf\x89\xbc\xd0\x26\x7f\x55\x37\xa3\xbf\x16\x87\x45\xdc\xa8\x76\xf3\x9b\xd1\x46\xef\x58\xa8\x3f\x01\x10\xb3\x95\xce\x81\x60\xa3\x32\x69\x01\xb8\x60\xeb\x21\x7e\x49\xbf\x5f\x03\x35\xd5\xd4\x21\x28\xad\x29\x55\x47\x3a\xc2\x07\x58\x66\x86\xf8\x36\x6c\xef\xd7\xbe\xd0\xb8\x54\xba\xc3\xab\xe8\xc5\x87\xc6\xd9\xfe\x31\x88\x40\x9f\xfd\x1e\xf2\x23\x68\xdd\x99\x1d\x56\xec\x55\xf9\xbb\x69\xc7\x55\xda\xf7\x8d\x13\x0f\x97\x40\x81\x27\xaa\x09\x3f\xbd\x1e\x3f\x3d\x0a\xe0\x01\x30\xa5\x40\x5c\x52\xf1\x65\x47\xd2\x31\x39\xb3\x10\x5e\xd2\x70\x58\x92\x70\x68\xe2\x58\x4f\xf2\x78\x75\x87\xc5\x16\xd7\xa2\xec\x62\xc6\x45\x06\x81\xc1\x0a\xb3\xe6\xc7\x87\xb6\x3c\xe8\x74\xd6\xdb\x73\xb9\x4a\xb6\x62\x0a\xb0\xaa\x8d\x0e\x56\x43\xb2\x09\x34\x25\x0a\xc9\xc1\x3e\x47\x4e\xf0\x01\x17\x1d\x80\xbf\x1d\x5f\xe3\xb6\x16\xbb\xf2\xa5\xcd\xfe\xe6\x32\x33\xbf\x9a\xaa\x2a\x59\x70\x2f\x56\xcf\xcc\x57\x70\x1d\x3d\xe1\x8d\xa1\xc2\x5a\xdb\x6d\x0b\x62\xbc\xb0\xc0\x4d\x91\x2b\xfa\x6d\x60\xaa\xc8\x1e\x1b\x6b\xce\x8e\x38\x2a\x12\xbb\xd1\x3b\x81\xf5\x0f\xe0\xe8\x74\x67\x59\x9b\xb2\x56\x38\xa5\xa7\x4b\xc1\xc7\x78\xdc\xd7\x3e\xb2\xfd\x1f\xeb\xe4\x57\x24\xf3\x01\xcd\xf6\xe4\xaf\xdb\x79\x99\xe9\xdc\x82\x7f\xa4\xb2\x91\x5b\x28\xc9\x8c\x11\xf0\x6f\xa7\xc2\x68\x8b\x25\x2f\x2f\xdc\xd6\x91\xf6\x7c\x17\x04\xc9\x36\x53\xdf\x7d\x0e\x07\x27\xde\x43\x2d\x38\xa1\xcc\x18\xdf\xc0\xdf\x3f\xfd\x33\x20\xd7\xff\x18\x7c\xaf\x32\xa4\x38\xbb\xc5\x71\xdb\x29\x42\xc8\x51\x6c\x6f\x28\x8c\x96\xb4\x1c\x66\xd5\xd5\x2c\x57\x65\x01\x89\xbb\x41\x74\x7d\x12\xfd\xf5\x07\xc5\x7b\xc6\x96\xb9\x3d\xc9\x86\xa8\x31\x32\x31\x50\x40\xc7\x4d\x06\x25\x8e\x01\x19\x50\x6a\x9e\xbc\x12\x0d\x82\x2e\x48\x1a\xe8\x3b\x8a\x24\x07\xfc\x8c\xb9\xa1\x09\xd1\x6c\xb6\xf0\x3c\xd1\xdc\x73\x84\xb2\x4f\xa6\x35\xd1\x28\xca\xcb\x7f\x33\x23\x3a\xab\xc1\xf6\x07\x95\xb5\x55\x1b\x53\xf8\x5f\x0b\xe2\xee\x9b\x1d\xe6\x44\x42\xa2\x02\x5a\xec\xe3\xf9\xf0\x11\x97\x86\x54\xdb\x4f\x2b\x72\x32\xc7\x62\x5d\xb3\x2e\x73\x3a\x3e\xf9\x35\xaa\xe3\x32\xa7\x85\xa9\x67\x7f\x7c\x2a\x2c\xd4\x54\xad\x28\xe9\x07\x8a\xbb\x6f\xe9\x93\x16\x27\x92\x79\x17\x59\x8c\xe8\x41\x5a\x5c\x23\x2d\x9c\x7e\x20\x14\x0b\x17\x2b\x9c\x62\x65\xe8\xd7\x5d\x4c\x3f\xc3\x65\x87\xba\x62\xbb\xad\x54\x5a\x56\x37\x7d\x34\xc8\x2e\xaf\x05\x53\x2b\xf2\x73\xb0\xdb\x91\xd3\x65\x4d\x47\x3c\x67\xf0\x57\x5f\xcb\x71\x59\xd3\xa6\x0f\x7e\x67\xb6\x92\x79\x38\x2e\x4b\x92\x86\xfb\xc6\x3c\xd8\x6f\x5e\xf5\x56\x71\x25\x50\x6a\xc2\xa4\x4d\xeb\x71\x0b\x0d\x23\x22\xc8\x37\x1e\xe1\xc3\x46\x52\x59\xfc\xcd\x86\xb2\x56\x17\x0d\xaa\xb8\xbb\x33\x86\x4b\x97\x27\xcf\xfe\x31\x33\x3d\x06\x97\x12\x0d\x49\x2a\x36\xbb\xc7\x10\x3b\xfe\x1c\xa7\xf6\x84\x4a\x23\x71\xb2\xcc\xf4\xd1\x65\x4d\x90\xce\x33\xe8\xf4\x25\xb8\x17\xfc\x5c\x21\xbb\x5e\xe1\xdd\x37\xf5\x5f\xaf\xe7\xe9\x22\x0c\xce\x8d\x67\xc3\x5a\x86\x21\x09\xe9\x2b\x43\xa6\xec\x80\xc8\x83\x7b\x64\x9c\x08\xc7\xbb\xab\x77\x9c\x48\xc7\x65\x6d\x7a\xe7\x97\xba\xad\x04\xd1\x55\x14\xc0\x45\xa0\xb9\xfd\xc2\xc0\x96\xd6\x9b\x22\xc0\xc8\xb2\x8b\x51\x80\x85\x4b\x1f\xc5\xad\xc7\x08\xa5\xb2\x20\xf1\xca\xaf\xd9\x06\x84\x0e\x07\xbc\xe4\xdb\x26\x8e\x7e\xdf\x4e\x94\x04\xfb\xd4\xda\x4b\x9a\x93\x57\x00\x60\xf9\x56\x9f\x3f\xb6\x2f\x86\x0d\x71\xda\x15\x29\x55\x46\xa6\x2e\x49\x4a\x1b\x87\x22\xab\xf4\x75\x79\x80\x24\xcd\x03\x18\x48\xb1\xd2\xc9\xce\x93\x85\x3f\x94\xc1\x27\x3b\x5d\x60\x84\x2e\x46\x11\x01\x9b\xd3\x4a\x8c\x0b\x63\xff\xf3\x90\xb9\x75\x71\x71\xcb\x31\xaa\xbe\xa5\xf0\xe1\x0b\xdd\x1d\x61\x7b\x74\x83\x89\x3c\x88\xa2\x60\x9f\x62\x94\xb4\xef\xe4\x2d\x64\x9f\x6f\x63\x21\xb1\x48\x71\x7e\x1b\x5a\x48\xff\xce\xc9\xf9\xe5\xcf\xc2\x46\x23\x56\x0e\x8a\x3b\x0c\x6e\x44\xb8\x5e\xff\xe7\x0d\x5d\xa9\xa3\xc4\xbe\xd4\x9e\x52\xa1\xc0\x8a\xce\x6c\x48\x60\x23\xa1\x1a\x84\x8b\x85\x62\x0b\xd3\xc6\x87\x42\xf5\x5c\x91\x5f\x79\x3f\x04\x07\x0a\x3b\xce\xb1\x31\x4f\x11\x1d\x7d\x03\x2c\x09\x94\x01\x5b\xe8\xff\x10\x73\x56\xb9\xa6\x46\x14\xce\x4b\xc6\xcc\x18\x85\x7b\x04\x5a\xb6\x11\x99\x57\xec\x00\x3a\x0e\x75\xe4\x4b\x28\xcc\xff\x3d\x95\xd6\xf7\x3b\x25\xb5\x20\x45\x8b\x43\xec\xe5\x80\x23\x83\x3f\xa8\xe1\x38\xd9\x34\xf8\x00\xa2\x4f\xb2\x42\x69\x26\xe6\x38\xd3\x40\x3a\x01\xa9\x48\x64\x0b\x8e\x2d\x20\xa8\x84\x30\x12\x10\x3c\x1e\xfc\x40\xc8\xb2\x11\x91\x4d\xa9\x74\x99\x95\x35\xee\xee\xe5\xd9\xf0\x0f\x4d\x25\x5d\x3f\x1f\x9a\xc4\x76\x30\xf8\xd6\x0b\x86\x55\x85\x7c\xd0\x57\x6b\xc4\xaf\x86\xf1\x8d\x7e\xc8\x8a\x0b\x7d\x53\xba\x94\x05\xa0\x57\xc0\x0f\xa7\x48\xad\x52\x2a\x85\x47\x87\xba\x70\xeb\x71\x69\xe3\x58\x27\xdc\xdf\x1d\x32\xb1\xc8\xb6\x35\x8f\x2b\xd3\x6b\x70\x4d\x78\x6c\xe9\x6e\x8d\x3f\x81\x2a\xcd\x17\x57\x7e\x7f\xbe\xef\x4f\x31\x6b\x59\x56\xb9\x7a\xed\x9c\x19\xe4\x10\x41\xb7\x9d\xdf\x6d\xc1\x91\x47\x2c\xdc\xe8\xd1\x0f\x19\x6b\xa0\xb9\xf1\x7a\x20\x44\x01\x5a\x6c\xc9\x8e\xe2\x14\x50\x18\x04\x57\x21\x58\x06\x13\xa4\x60\xe5\xb5\xbe\xbc\x3a\x25\x55\xaa\x51\xa3\xcd\xdb\x0d\xe8\xc6\x24\x7b\x0f\x54\x7a\xc3\xbb\x8f\x84\x5f\x89\xab\x05\x1e\x04\x5a\x0f\x78\xdf\x7e\x12\x05\x4f\x6e\x89\x9c\xba\x3d\x22\xff\x98\x42\x38\xaf\x6a\x8b\x15\x96\xd8\x66\x26\xd5\x61\xea\x90\x45\x0f\xe8\x6d\x84\x23\x4a\xa0\xd5\x41\x33\x6f\x24\x33\xf8\x88\xb5\xb9\x89\x8e\x9f\x70\xc5\xd9\xb8\xd8\xfc\x37\x5f\xdd\x83\x91\x6c\x5a\x63\x99\x35\x3c\x81\x67\xc3\x1d\x0f\x09\xf6\x1e\x2a\x53\xf2\x14\x08\x68\xb4\x42\xeb\x58\x49\xc6\xa7\x04\xe4\x75\xea\xbb\xaf\xad\xdc\xaa\xb4\x83\x01\x89\x3e\x4e\x2f\x1d\x5e\x11\xcb\xc8\x6c\x79\xc9\x9e\x35\xa1\x04\xab\x36\x63\x2a\x77\xc6\x43\x8c\xd2\x9c\x0a\xcd\xcc\x6e\x75\x5e\xdb\xc2\xec\x7c\xed\x17\x03\x51\xc5\x11\x6c\xbf\xa8\xf4\x1d\xe8\x32\xb5\x84\xe9\xb8\xcc\x1a\x4e\x01\x1d\x0f\xdb\x8d\xda\x6b\x62\x48\xdc\x8c\x31\x6e\x36\x4c\x57\x52\xcd\x09\x1f\x5b\x27\x58\xad\xba\xfc\x83\xd5\xce\x2e\xc3\xa1\xfa\xee\x9b\x87\x53\x94\xe2\x5c\xe3\xc4\xd3\xe8\xbf\xfd\x79\x3f\xce\x7e\x42\x05\x07\xa2\xa6\x83\x39\x41\x96\x6b\x31\xf6\xcc\x14\xe3\x4a\xc3\x6a\x12\xf0\xc4\x81\x54\x24\x83\x34\xbd\x37\x73\xf2\x9e\x0a\x38\x43\x1e\xeb\x55\xb1\x91\xdd\xdf\xdf\x3a\x98\x5f\x06\x60\xc5\xfd\xc3\x68\x04\x69\xe4\x24\x31\x7c\x06\x8c\xfb\x74\xbd\x57\x30\xb7\x9b\xdf\x2a\xd3\xf8\x52\x04\x75\x86\x90\x85\xe6\x3d\xe0\x8d\xc9\x9b\x91\x9f\xd1\x8b\xcf\xab\xcc\x55\x29\xa3\x6e\x30\x00\x7a\x13\x11\x74\x5a\x95\xf2\xfe\x2f\xe2\xe9\x8a\xee\xd9\xb9\x90\xbc\x87\x81\xfa\x1f\x6b\x87\x52\xaf\x9a\x2c\x22\x11\x85\x35\xc5\x72\x91\x72\xfd\x71\xf7\x0a\x1f\xdb\x11\xc9\x03\xed\x35\x13\x26\xef\xc7\x79\xdd\xea\xf6\xad\xf3\x4e\x7e\x4d\xca\xcf\xfd\xb5\x73\x4f\xea\x5f\xe7\x27\xbc\xfd\x2c\x86\xed\x4b\xec\x34\x20\x64\x73\x53\x49\x79\x7a\x37\x96\x6f\xaa\xdb\x79\xbd\x88\x70\xdf\x58\x34\x48\x87\x6d\x33\x51\xf6\xec\x34\xda\xff\x7c\x87\x0e\x68\x66\x87\xe9\x07\x5d\x44\xc1\x53\xfd\xbb\xe7\xbf\xb5\xda\x70\x85\xdd\xba\x53\x3a\x15\x4a\x1a\x0c\xb5\x7c\x26\x41\xc4\x37\x75\xd6\x3b\x3d\x54\xf0\x38\xef\x8b\x7c\x30\x1e\xaa\xda\xca\xe7\xc3\xef\xf5\x9b\x8f\x23\x59\x9e\x96\x00\xa8\xf7\x61\xf7\xfa\xaf\xee\xac\xe8\xbb\xd1\x86\x17\x04\xef\x0d\x7f\x43\x96\xd1\x23\x62\x94\x1f\xb6\x57\x18\xcf\x9e\x2c\x43\x72\x49\x89\x31\xe4\x8e\x7c\xf4\x4e\x29\x4c\xd7\xdc\xa0\x06\xb2\x6f\x1d\xa1\xbe\xe8\xf6\x4b\x12\x3a\x63\x37\xc8\xf3\x3d\xfa\xf7\xa0\xb8\xe1\xdd\x72\x1b\x0b\xf2\x75\x7b\x61\x35\x69\xc1\x98\x48\xd7\xaf\xe5\x3a\x89\xe0\xf4\x83\xe6\xa2\x17\x4c\x35\x11\x61\x73\xc8\x19\x6d\x7d\xab\x13\x30\x70\xdd\x22\x62\xf4\x45\xf0\xf3\xc1\x62\x61\xd2\x6b\x0b\x14\xc1\xf1\xa3\x1f\x9a\xeb\x7c\x70\x59\xd8\x5e\x9a\xf0\x91\x85\xfe\xd1\xfa\xbd\xca\x36\xe1\x2e\x54\xd6\xd4\xc6\xa3\xc9\xd5\xb1\xed\x70\x4b\x1c\x0e\xcb\x19\x79\xf8\xbe\xb6\x31\x21\xf3\x8c\xfd\xfb\x34\xa8\xf1\xd4\x97\xda\x2d\xd2\xb8\x2e\xa2\xc4\x1f\xac\xba\x7f\x85\xa9\x6b\x49\x1a\xd4\x80\x93\xe6\x50\xff\x2b\x18\x99\x2a\x62\x26\xb6\xfe\x97\xf7\x7e\x11\x8f\xcb\x4f\x10\xfc\xbc\x27\x6d\x85\x97\x7b\x4a\xc3\x6f\xf4\xaa\x9b\xe2\x53\xeb\xa9\xa1\x58\x6f\xef\xa0\xd4\x76\x5d\xe6\xcd\xb1\xc7\xc4\x0f\x2c\x86\x71\x1d\xfd\xca\x5e\xca\x6c\x6e\x7d\x0e\x2d\x6f\x81\xe8\xfe\x4b\x88\x66\x73\x75\xad\x7b\xde\x7e\x51\x63\x8e\x33\xd8\xcd\xf8\x68\xaa\x92\x6f\x98\x15\xd4\xb1\x5b\x89\x1c\x4d\xf3\xad\x47\xb4\xf6\x1b\xc9\xe2\x28\x4a\x34\x68\xa9\x6b\x98\xdb\xd2\x98\x09\xae\x8e\xad\xc9\x5d\x62\x09\x60\x5c\xc0\xeb\x0f\xc6\xb8\x95\xaa\x0d\x79\x83\x8d\x6c\xce\x8a\x42\xe8\xd8\x76\x9a\xc1\xd9\xea\xc1\xf7\x9c\xfd\xe8\x72\x05\x98\xd7\x7a\x39\x99\x92\x4e\xf0\x7d\xfc\x6b\x66\x9e\x50\xe9\xe6\x35\xfc\x92\x5a\x20\x03\x4a\x1f\xc1\x83\xbf\x75\x69\xca\x81\xb1\x5d\x53\x1f\x2e\xf5\xda\x0e\x75\xa4\x9b\x43\x91\xd1\x12\xb9\xf7\x84\xe6\xe6\xd4\xa7\xc6\x5f\xbc\xd8\x44\x64\xd7\x0b\xd9\x66\x39\x49\xdb\xbf\xb2\x20\xcf\x6f\xbf\xc4\xac\x40\xfa\x23\xda\x88\xa7\xb8\x08\x12\xac\x19\xf1\xde\xf1\x6d\x68\x00\x27\xda\xde\xd4\x9c\x48\x16\xa6\x9e\x18\xcf\xc8\xff\xea\xdd\x4a\x1a\xd6\xb3\xcc\x3e\x4d\x55\x7d\x45\x14\x50\x34\x6b\x3a\x28\xb2\x43\x41\x4e\x46\x92\xf4\x8a\x0e\xe2\x3a\x2f\x9e\x9f\x58\xe8\x98\xed\xa2\x06\x63\xd5\xf7\xb2\x2f\x21\xf4\xbc\x5b\xe3\x30\x74\x7b\x50\xc7\xea\xe8\x8a\x4f\xae\x88\x3d\xac\x89\x0f\x9a\xef\x99\x76\x59\xfc\x43\x01\x91\xb6\x60\x7d\xbc\x84\x97\x80\x2b\x65\xf5\xcd\x42\xfa\x8e\x11\xc6\x80\x4f\xd3\x72\x32\xe7\x0a\x37\x20\xfd\x51\x60\x71\x4e\x5f\xf2\xb8\x67\xc4\x3f\x10\x6e\x49\xd2\xab\x58\x17\x42\x56\xe2\x49\x24\xb9\xcd\xcd\xe1\x5f\xfe\x84\xc4\xd2\x0c\x46\xa5\x9e\x8f\x37\xf3\xe1\x59\x0a\x64\x40\xa6\xe5\x24\xba\x70\xea\x67\x4a\xd1\x8b\xf1\x2c\xc1\xf9\x0c\xf9\xe0\x7b\x85\x57\x3c\xa6\x5c\xa0\x2e\xdb\x0d\xde\x1f\x76\x92\x78\x73\xab\xf7\xba\xb0\x4c\xb6\x0c\xfc\xa9\xcb\xb4\x2d\x0a\x5f\xe3\x6d\xdf\x6d\x04\x70\x6d\x34\x6a\x3c\xb5\x91\x8b\xdb\xce\xf9\x3c\xa0\x97\x48\x8a\x54\xd1\x36\x40\x64\xc7\x0b\x82\xed\x77\xb4\x21\x10\xd3\x81\x90\x9e\x32\xf1\xf9\xa9\xa1\x24\x0d\x23\x78\x35\xa2\x28\xda\x21\xde\x02\x7d\x0b\x71\xaa\xed\x9c\x8a\x2c\xde\xb9\x02\x69\x7e\xbb\xea\xe8\x13\x59\xac\x8f\xff\xd6\xfe\x7b\x4a\x27\x83\xc4\x5f\xe8\xbf\x54\x9a\xe6\x6b\xa6\xd5\xd2\xd6\x6d\x7a\xf7\x5a\x2f\x28\xd6\x3b\x7a\xe9\x70\xa4\x10\xbf\x37\xf4\x6a\xc8\x57\xfa\xce\xf0\x7c\x58\xf1\xfa\xc4\x72\xd9\xf3\x78\x81\x22\x3d\xe7\x55\x68\x34\x17\x3a\xc3\xa2\xde\x10\x22\x56\xcc\x05\x31\xf3\xde\x5a\x9f\x49\x96\xa2\xe0\x9c\x3a\x1c\xa0\x51\xac\x7a\xf0\x5b\xa5\xcb\x60\xcc\xc6\xbf\x9c\x10\x49\x17\x3c\x2f\x8c\x42\x92\xe6\xbf\x6f\xbf\xb9\xf4\x5a\x5a\x1c\x95\x04\xdb\x6d\x7e\xbf\xfb\x84\x89\x1f\xcf\x62\xb9\x29\x65\x24\x95\x22\xf8\x99\xf5\xcb\x9b\x1f\x6b\x76\xf0\xc5\x85\x6e\x0f\x4e\x11\xd9\x0c\xbd\x25\x44\x18\xe0\x19\xc5\x80\xd8\xb8\xfe\xa4\x10\x74\x79\x42\x59\x89\xb6\xbb\xdc\xb8\x2a\xa5\x40\xce\x9c\x33\x74\xce\x6c\x03\xf5\x61\x76\x1d\x3d\x6c\x57\x35\x2f\x02\xba\xfe\x18\x3a\x7c\x22\xdf\xa9\xf6\xbc\xbd\x39\x51\x80\xd5\xc5\xbd\x22\xf1\x85\xb9\xac\x98\x3a\x33\xd1\x2f\x94\x0d\x26\xbf\x18\xa6\xb7\x25\x2f\xd9\x61\xe2\xdc\x96\xf2\x44\xe7\xc0\xe7\x75\x4c\x55\x9a\x14\xa8\xc4\x1b\xe2\xf9\x78\x40\x8d\x30\x18\x32\xf3\xdb\xb1\x38\xb2\x5b\xce\x67\xf3\x4e\x7e\xf5\x4e\x26\xac\xb2\xaa\x16\x0a\x28\x2a\x6b\xfa\x80\xe9\xf2\x07\x92\x7e\xb1\x4e\x7d\xaf\x2d\xca\x53\xb2\x78\xfa\x44\x71\xfd\x81\x30\x94\x68\xea\x70\x1c\x3b\xf3\xaf\xd8\x11\xe2\x48\xd1\xec\x50\xc4\xea\xb2\x53\x2a\xa3\x0a\xd2\xde\xde\x4a\x21\x79\x83\x6f\xb5\xaa\xe5\x25\x42\xb4\x6f\xb1\xf3\x63\xaf\x0d\xd9\x32\xad\x34\xdc\xe4\x6a\xa6\x77\xc7\x75\x25\xf6\x2b\xa2\x34\x65\x52\xec\xc9\xe8\x36\xd1\x7a\x59\x66\x18\xc8\x5a\x77\x5a\x1b\xbe\xa3\x3d\xf6\x8b\x97\x62\x38\x87\xb5\x40\xa6\x36\xaf\x68\xeb\xa5\x42\x6f\x04\x81\x72\x63\x98\x0e\x48\xca\x72\x8b\x49\x81\xb6\xbd\xea\x7f\xec\x9d\xfb\xda\x0f\x61\xe0\x3d\xaa\x73\xdb\x61\x12\x1b\x32\xf8\x19\x37\xba\x05\x22\xcc\x29\x23\x81\x45\x7d\x77\xcd\x32\x8a\xa4\xc7\xcd\x62\xc0\xcf\x68\x4a\x90\xe2\xef\xef\x11\x37\xb7\xee\x9e\x3e\x7b\x57\xb9\x35\xd1\xc7\xed\xc1\xde\x00\xac\xbb\xfc\x90\x39\x7c\x37\x60\xb8\xed\x8e\x26\x05\x93\xa8\x06\x3b\x04\x46\x04\x69\x10\x2f\x11\x90\xfe\xbc\x1f\xac\xed\x7b\xa5\x00\xca\xcb\xb6\x91\x36\x10\xfb\xa6\x3e\x82\x44\x34\x66\x35\xe1\x00\x68\x20\xa4\x76\x20\x04\x16\xa1\x48\x07\x81\x05\x20\xae\x1e\x6a\x72\xf0\xe0\x3a\x94\x1a\xa5\x40\x09\xe6\xe7\x2c\x66\x76\xef\x1a\xfe\x1b\x03\x95\x29\xc5\xb9\xd1\xb8\x46\x17\xc3\x24\x0d\x8c\x46\x1e\xfb\x75\x2b\xbe\xdd\xba\x6e\x29\xbc\xe2\x47\x48\x9e\x08\x1d\xd1\x71\x18\x8c\xc9\x80\x9f\xd9\x84\x83\x20\x55\x5a\x12\xc8\xd1\x20\x80\x37\x55\xaf\x80\x20\x51\xe8\x53\xe9\xd6\xd8\x4a\x9c\x5b\x48\x26\x7d\x1a\xfe\x9a\x39\x07\xe3\xbd\x92\x6d\x06\x7a\xb1\xe7\x30\xe1\x52\xa0\xe5\x30\x9c\x1b\x82\xca\x9f\xbf\x9c\xaa\x3d\x9e\xda\x21\xa6\xcb\x26\xc2\x32\xaf\x66\x0b\x31\x5e\x22\xf3\x72\xeb\x2f\xd9\x8b\x41\x8b\xea\xce\xec\xfa\xaf\xd6\xc2\xfd\x7a\xb6\xa6\xec\xb2\x62\x2c\x5e\xe6\x8d\xee\x2d\xe9\xd2\x92\xa0\xb7\xb0\x10\xfe\x84\x95\xeb\x34\x86\x24\xf4\x1f\x28\xe7\x8b\x67\xcf\x08\xa0\x50\x66\x83\xb9\x57\x56\x90\x7a\xe1\x5f\x5d\xef\xd8\xd2\xf7\xd8\x08\x84\x06\xb4\x46\x55\xda\xf9\xd4\xb8\x7b\xd7\xac\xc7\x0f\x7f\x4d\x02\xa8\xd7\x06\xad\x19\x77\x53\x86\x39\xfd\xeb\x97\xd0\xf8\xe1\x4f\x22\x01\x90\x1d\x6e\x3e\x31\x20\x21\x1e\x84\x13\xcd\x76\xfc\x17\x7d\x4f\x0f\xe4\xb2\x65\x4a\x22\xc9\xa7\x07\xa1\x52\x92\x46\x01\x81\x86\x54\xcd\x77\x20\x1a\x1f\x67\xa0\xef\xf5\xbf\x4b\x1a\x1f\xb8\xdf\x83\x29\x80\x8d\xb8\xf1\x15\x8f\xe4\xe5\x4f\x13\x08\xdc\xf5\x61\xa3\x5c\x08\xd9\xd8\x29\xb3\x6b\x6e\xf2\xcb\x23\x41\xef\x64\x7a\x8a\x25\x3f\xb2\x41\xa9\x59\x5c\x77\x10\x39\xa5\x5f\xd4\xf7\xe0\x05\xa8\xe1\x88\x2f\x39\x24\x7e\xc6\x2c\x61\xc0\x98\xb5\x4e\x24\xd6\x11\xc0\xeb\xc1\x00\x75\xf2\x7c\xab\x54\x23\x9a\x77\x20\xa7\xc7\x52\x96\x15\x7f\xb2\x5c\xbb\x12\xfc\xc7\x79\xff\x1d\xec\xd9\x64\xce\xbc\x47\x28\x88\x47\x16\xfe\xe4\xe1\xcf\xb4\x23\xd0\x42\xa3\x85\x52\xac\x32\x1a\x70\xb3\x98\x92\x33\x1b\xf1\x3f\x89\x0a\x8c\xaf\xb2\xef\xdc\x82\xce\x16\xca\x4b\x4b\x7a\xc1\xa4\x09\x82\x24\x6c\x16\x8d\x2b\xae\xcc\x5f\x32\xdb\x27\x65\x41\x3b\x06\x59\xb5\xd3\xf6\xf7\x97\xb3\x23\x0e\x8b\x88\x63\x24\x37\xf6\xad\x30\x47\x4a\x64\x7b\xd5\x1e\xc4\x97\xe7\x80\x89\x25\x27\xa2\x01\xd2\x5d\xf6\x4d\x20\xff\xd8\xb8\x16\x97\x21\x49\xea\x6a\xc0\x2b\x32\x4d\xbd\x85\x65\x6c\xf1\x3e\x33\x49\xfa\x60\x6a\x54\xd4\x1c\xd5\x94\x40\xa5\xb5\x53\x9f\x41\xc9\x44\x51\xc2\x50\xdf\x71\x20\x73\x94\x54\x9e\x86\x15\x04\x1b\xf1\x29\x55\x57\x7a\xb1\x99\x63\xb1\x99\xb3\x0a\x24\x4a\x0e\xb4\xbe\x7d\x52\xde\xfc\x4f\xa3\x69\x7a\xba\xd0\x31\xec\x17\x22\x9a\x70\xa0\xf0\xe2\x35\x37\xc9\x0e\xa8\x37\xf5\xa6\xd2\xcb\x99\x45\x0f\xbf\xd3\x73\x59\x73\xbe\x3a\x46\x11\x64\x01\x74\xe0\x3c\x1f\x32\xfe\x9e\x17\x0d\xcb\x73\x30\xf9\x7f\xc3\xe5\xea\x5a\x8d\x29\xd4\x5f\xa6\x95\x72\xc4\x54\x5c\xd7\x73\x53\x97\xfc\x9a\xeb\xf7\x79\xe8\xfd\x4d\xdc\x40\x1f\x5f\x28\x11\x9b\x1d\x1e\xd5\xb7\x5f\x6c\x2f\x92\xb4\x17\x3b\x9f\x8b\xa9\x75\x2a\xf8\x95\x32\x2b\x3b\x72\x69\x4a\x21\x9c\x21\x24\x4e\x00\xf6\x62\xeb\x40\x23\x43\x72\x62\x88\xd0\x48\xbe\x9f\xe4\x40\x69\x58\xb3\xe1\xb1\xa6\xe3\xc6\x5b\xc5\xfd\x0c\x0d\x23\xd8\x29\x6f\xc3\x85\xe5\xc1\x85\xf1\xe5\x12\x8f\x01\x8b\xf5\xef\xd5\xe6\xa1\x79\xd0\x61\xb9\x7a\x27\x7f\x3e\x6c\xd8\xe8\x9a\x8e\x3d\x19\xbe\x40\x55\xee\x48\x15\xa1\x34\xd9\xc1\xc4\x6b\x6e\x0e\x93\x3f\x23\x75\x4d\xd4\x87\x53\x60\x8a\x34\x73\xac\x3f\x5e\x8b\xab\xae\xb0\x33\x97\x6c\x66\x92\x10\x0d\xe2\x65\xf4\x79\x10\x84\x77\x46\x57\xd3\x87\x83\x4f\xca\x89\xef\x7f\xda\xc5\x47\x7d\x6b\xf4\x27\x07\xe0\xc5\x15\xac\xd6\x97\xa3\x48\x51\x17\xbc\x0d\x19\xab\xf5\x61\xcd\x4d\x55\x3a\xff\xc9\x9f\x5e\x5a\x12\x2d\x08\xfe\x29\x54\x02\xcf\xa2\x74\xf3\x30\xb7\x77\xa2\x19\x52\x52\x5f\xd8\x9c\x50\xf2\x0a\xe6\xbb\xdb\x9c\x80\xe5\xe6\x4d\x6c\x84\x37\xbc\x8a\x52\x69\x8f\xcf\x72\x02\x63\x3b\xd2\x3b\xaf\xea\x95\x1d\x08\x7d\xa2\x20\x79\x80\x01\x02\x1f\x64\x79\xfa\xf0\x4b\x57\xca\x06\x13\x40\xa5\x0c\x50\x7f\xb8\x41\x92\x6b\x4d\xae\x0e\xd5\x0b\xe1\x42\xf3\xe8\xdf\x79\xd4\x10\x26\x19\xc9\x99\x0d\x78\xf8\x03\x26\x49\xeb\xf1\x32\x61\x9d\xc5\x26\xf0\x3d\x77\x80\xd8\x3f\x83\x29\x08\x82\x8c\x85\x81\x6b\xc2\x78\x19\x94\xb9\xee\xe7\x40\x61\xcb\x91\xac\x10\x88\x6e\xff\x01\x84\xda\xad\xf7\x81\x12\x3b\x29\x21\x1c\xf3\x49\xa0\xf0\x63\xf7\xe1\x26\x8a\xcc\x80\x0e\xd9\xf5\x7f\x4c\x2f\xcc\xbe\xc0\xe2\xbf\x2f\x79\x81\xdc\xba\x81\xcc\x19\x7e\x7e\xc0\xde\x78\xd9\x38\xa2\x10\xcb\x75\xcb\x39\xcd\x4e\x0f\x07\x59\x2c\x44\x19\x9d\x57\xb4\xed\x88\x71\xe2\x7b\x66\x7c\xc9\xe3\x5f\x00\x71\x9c\xd7\x46\xae\x84\xd9\xf4\x80\x34\x83\x12\x80\x5a\xa5\xa5\x76\x2a\x4f\xaa\xcd\x9c\xf6\xca\x6a\xc5\x8d\x76\x83\xdd\x18\x29\x14\xc0\xb0\x38\xcc\x7b\x3e\x65\xfb\x8a\xbc\x01\xb2\xf9\x51\x26\x7d\xc5\x4a\x4a\xdf\x36\x52\xf2\xc1\x0a\xff\xcb\xdb\xac\x84\xb8\xd6\x63\x96\x4a\x46\x03\xc0\x10\xc3\xb4\xee\xf1\x76\x83\x6b\xea\x4b\xa1\xbf\xbf\x71\x94\x55\x5d\x3b\x3c\x49\x31\xb5\x52\xb3\x29\x5d\x9e\xc9\xa2\xbd\x23\xdd\x86\x62\x1d\x6f\x26\x6f\x63\xb9\xe1\x07\x81\xff\x34\xec\x5f\x8c\xb9\xf2\xb4\x90\xe5\xac\x8b\x7c\x40\x3c\x69\x51\x49\x2a\x5e\x70\x9d\x83\x02\x95\x79\x44\x25\xba\xe0\xe6\x02\x5d\x8f\x4c\xc2\x20\x88\x92\x03\xd5\xf8\x2f\x91\xa5\xc0\xd5\x11\x75\x78\x65\x64\xc6\x3c\xf5\xbb\x88\x05\x1f\x4d\x3e\xcd\xa0\x10\x14\x28\x42\xd6\xfc\xae\xea\x09\x0e\x29\x84\xe6\xb5\xd4\x5a\x6c\xfb\x1b\x7f\xaa\x35\x79\xae\xcf\x9e\x7b\x3f\xa5\xa1\x2b\x87\x15\x23\x6c\x53\x64\x9e\x8d\x9b\xde\xc8\x80\xc5\x1f\xf3\x6a\xd3\xb7\x50\x13\x43\xc6\xb1\xc8\x36\x9c\xfb\x97\x5c\xe6\x45\x90\x9b\xd5\x68\xf6\x9f\x68\xff\xd1\xf7\xc4\x84\xc2\x3d\x2d\x68\x5a\xa1\x75\x84\x92\x8c\x42\x23\x2c\xa3\xb0\xae\xc4\xdf\x77\x1f\x6a\xae\x16\x66\x06\x02\xe6\xaf\xbe\xe9\x2c\xeb\xf4\x7b\xd0\x95\x56\x87\xc5\xd6\xdd\x72\xb2\xe9\xb1\xc8\x9e\xa5\xc8\x9e\x10\x9b\x42\x5f\x79\x20\x39\x5f\xb2\xa3\x1b\x0c\x90\xe0\xf9\x2d\x3e\xf6\x63\x64\x25\xd4\xbc\xde\xc1\x00\xa6\xb1\xbc\x67\xf8\x42\x61\x50\xfe\x14\x9a\x0f\x67\x2a\x46\x17\x42\xac\xe3\x03\xf7\x1b\xcb\xbd\x0b\x5f\xff\xec\xd7\xee\x77\xe7\xf4\x43\x0a\x88\x04\xda\xf2\xa0\x59\x4d\x7f\xf6\xa8\x84\x01\x9b\x5d\xb3\x28\x08\xbd\x27\x06\x03\x9e\x44\x5a\xaf\xe8\x78\x14\x9d\xf8\x36\xb4\x30\x37\xc3\x87\x21\xef\xa1\xa6\x3d\x08\xed\xae\x3e\xb2\xfb\xda\x8f\x48\xcc\x89\x48\xeb\x59\x60\xe1\x10\x7a\x74\x2f\xe5\xfa\x92\x7f\xca\x6c\xc4\xce\x6b\xf7\x1b\x05\x01\x3d\x94\x90\x15\x45\x76\xc4\x19\x9c\x08\x20\x85\x44\x2b\x2b\x2a\x53\x2a\x00\x50\xb6\x95\x73\xe2\x8e\x1e\x5f\x4c\x57\x62\xe4\xcd\xe4\x98\xda\xfe\x63\x34\xff\xa9\xfe\x74\xc4\x6f\xe5\x65\x49\xc3\x13\x2b\xa5\x13\xab\xa4\x01\xef\xe9\x3c\xac\x3a\x7f\x51\x3d\xa5\xba\x13\xfa\xfc\x52\xb2\x78\xe1\x15\x13\xd9\x3a\x7e\xf0\x14\x61\x6b\x46\xf1\x2b\x0f\x3d\x79\x20\x92\xd6\x3e\xc1\xc4\xe2\xf0\xe6\x82\xdf\xf6\xf6\x5f\x9d\x21\x6b\xa3\xb2\x16\x00\x00\x55\x4b\xe5\x64\xd9\xfb\x6d\x0c\xb6\x32\x27\xa0\x7a\x4a\x99\xe3\x6c\x2c\xb7\x1c\xb8\xcc\xea\x6b\x97\x79\x88\x83\xdf\x7d\x53\xdb\x27\x3f\xdf\x99\x39\x92\x31\x19\x6b\x71\xb9\x20\x5a\x7f\xa0\xbd\x56\xa4\x3f\x34\x1b\x79\xdb\xe6\x51\xdb\x24\xda\x3f\x8f\xd8\xc5\x7e\xa5\xec\x98\xae\x74\x0f\xc8\x27\x7d\x1c\xf3\x7a\x12\x5b\x30\xa0\x4b\x8c\xc3\x0a\x8a\xda\x6d\x2b\x79\xea\xa1\x22\xf8\x42\x77\xef\x89\xa5\xfb\xd4\xac\x32\x0c\x53\x33\xc2\x20\xd8\x81\x3d\xa1\x42\x81\xa9\xdc\xbc\xba\xe3\xe7\x05\x41\x10\xa4\x70\x79\xf5\x1e\xac\x31\xf6\x01\xe4\xa8\xf2\xae\xeb\xba\xea\xc2\xbc\xb0\x32\x28\x31\xf0\xf3\xac\xaa\xb9\xbd\x08\x73\x43\xbf\xe0\xbf\xd8\x1d\x6e\xcb\xe4\x01\x73\x08\x31\xf9\x88\x7f\xc7\x36\x2a\x6b\x7a\x6e\x35\x26\x73\xa7\x51\xfb\x12\x7b\x57\x5e\xb2\x43\xff\xce\xac\x90\x47\x8f\xf9\x1d\xc6\xf8\xd9\x0c\x39\x6f\x0f\x05\x38\x72\xd7\x25\x5f\x3d\xbb\xc2\xc1\x33\x19\xcf\x92\x4b\xe0\x8a\x8f\xee\x09\x83\xc2\xf9\x03\x22\x68\x0f\x86\x6a\x41\xa4\xc1\xef\x54\xb7\x8c\x6c\xb7\xc2\xae\x71\xdf\xf8\x25\x34\x48\x5f\x2f\xbe\x16\xe6\xe4\x86\x0f\x0e\x11\xa6\xc4\x53\x40\x65\xbe\x74\xe7\x2d\x6b\x32\x42\x3f\x85\x8b\x23\x6e\x51\xed\x9e\x17\xb0\x15\xcd\xef\x49\xb1\x05\x44\xe3\xe5\x2f\xc5\xd2\x50\xbe\x54\xfd\x2f\xff\xb2\x75\x2c\xd6\xc2\x18\xc2\x6f\xbf\x94\x3a\x63\xbb\x03\x40\x43\xbd\xb8\x19\x71\x6e\x4a\x58\x7a\x1a\x0d\x8c\x16\x68\xc1\x1c\x99\x0b\x1d\x4a\xe5\x5f\x04\xec\x07\x3f\x5b\xd0\x84\x40\x71\xa7\x97\x8d\xfd\xc4\xec\x07\xf2\xef\xfe\x2f\x1b\x8b\x0d\xb8\xf0\x7c\x96\xf5\x7a\x2a\xaa\xa7\xae\x81\xa6\x9b\x20\x68\xaf\x1f\x70\xd0\xac\x9d\x99\xe3\x0c\xe7\xb2\x60\x9a\x32\x63\xdb\x61\x84\xd9\x79\x54\xb7\x9f\x81\x98\x2a\xc4\x09\x1b\x8b\x0b\xe1\xd8\x84\x9d\x49\xd2\x38\xbf\x85\x48\x47\x67\x89\xa9\x43\x73\x21\x66\x70\x48\xf0\xbc\x93\xa6\x0d\x7f\xae\xf4\x96\xae\xb1\xc4\x76\xc3\xab\x72\x41\x52\xa6\x52\x69\x6c\xf6\x99\x71\xea\x67\xf6\x38\xb6\x33\x21\x33\x70\x50\xef\xf0\xce\xb2\x35\xcd\x07\x4b\x67\xdc\x14\xc7\xfc\xe3\x7b\x91\xed\x1d\xd5\x36\x91\x43\x6c\x1a\xe6\x0d\x83\x7e\x91\x39\x93\xb1\x83\xda\x95\x41\x8b\x04\xea\x99\x61\x69\x99\x83\x72\x7e\x2c\xf5\xe1\x29\xf7\x64\x74\x04\x47\x0c\x86\xa5\x70\x50\x3e\x7b\xb0\x4b\x3e\xbd\x1e\xf7\x03\xeb\xf8\xd7\xaf\xc4\xfe\xd9\x29\xaf\x17\x0f\x5d\x0a\x0e\x04\x5b\x6e\xa2\xc3\x8e\x1f\xe8\x2e\xb1\xc5\x2a\x57\x0f\xfa\xe4\x51\xab\x5b\xc2\x59\x38\x5b\xd3\xb3\xe0\x75\xb5\x3c\x9a\x35\x75\xd8\x2f\xd0\x2c\xe1\x7c\x07\xe1\x5a\x45\xad\xbc\x2d\x43\x4b\x16\xde\x0d\x02\x3f\xd0\x1e\x92\xbe\x09\x4b\xf4\x95\xdf\x9f\xdf\xd9\x06\x84\x66\xdb\x2e\x9e\x91\xc2\x2a\xa2\x00\x36\xb4\x6c\x59\xd5\x76\xa6\x4d\xd2\x13\x61\xfe\x06\xb6\x60\x4b\x7c\x96\xcf\x57\x57\xf6\xe2\x3d\xf4\x7f\x32\x6c\x78\xfe\xb5\xcb\x6e\x5e\xe9\x4c\x48\x71\x5a\x7b\xeb\xc6\xc7\x8a\x6f\x3a\xab\x6a\x2b\x5f\x9f\x9f\x96\xd3\x09\x5b\xe7\xbe\xfd\x76\x34\xa3\x94\x15\xa8\x23\x2c\x26\xed\xfa\x6f\x3c\x92\x9b\x7a\x44\x19\x1d\x92\x83\x05\x48\x04\x32\x8c\xf6\x3b\x55\xde\xc6\xfe\xd8\xc3\x13\x13\x4a\xff\x84\xc7\x0f\x58\xbb\xfe\x29\x9a\xdb\xaf\xeb\xaa\x76\x4c\xaa\xda\xc2\xdf\x9a\x69\xe3\xb1\xa6\x4b\x23\x1c\xce\x7a\x95\x77\x40\xb6\x3c\xfa\xcc\x0e\x2c\xf7\x19\xb4\x4a\x5e\xd9\xc7\x73\x19\xc7\xf4\x31\xbf\x49\x0e\xe7\x9a\x8f\xb7\x83\x73\xff\x6f\x7f\x00\x4c\x0e\xe7\x5a\x1e\xe2\xe4\xb1\x03\x7f\x80\x1b\x4c\x8a\x62\xd2\x33\x37\xa5\x02\xc1\xcf\x0f\x2c\x3b\x37\x1c\x25\xa4\x00\x57\x11\x48\x26\x9a\x61\x6a\xd0\x78\x47\x57\x59\x9b\xe3\xb6\x64\x83\x29\xd2\xfc\x6a\xa2\x63\x7d\xc1\x76\xf8\xc4\x0e\xe0\xa2\x3a\xa7\x2f\x81\x4f\x34\x3b\x34\x17\x7e\x32\x57\x69\xe7\x55\x5d\xf5\x46\x0e\x3d\xcd\x40\xf6\x38\x75\xe7\xcd\xfa\xd7\x3c\xe0\x01\xbb\x9e\x95\xa9\xca\xe2\xea\x6d\xc5\x4c\x77\x46\x79\x2c\x69\xb3\x91\x30\xb7\x96\x7f\xf8\xa5\x5a\x9e\xba\xe5\x4f\x94\x2a\x3d\xc3\x57\x94\x58\x39\xb7\x51\x69\x63\x53\xf2\xe6\x5a\xc5\x39\x28\x5b\x0c\x91\x6e\x61\x20\xe3\xf8\x50\x0d\x38\x15\x3e\x32\x65\xd1\x95\xe2\xdc\xc4\x80\xfb\x14\x81\x59\x7f\xdd\x83\xb7\xa0\x57\xff\x44\x1e\x26\xfe\xd5\xf0\x56\xb6\x76\xfd\x51\xf9\x09\xb4\xcd\xda\xfe\xef\xbf\xef\xa7\x23\x01\x9b\x84\x7f\x03\x98\xb1\xd3\x83\x40\xd1\x53\xbe\x19\x37\xe7\x45\xb1\x3e\x99\x62\x26\x6e\x9d\x0b\xf7\x49\xe9\xdc\xf4\x4d\xf4\x9c\x8a\x3d\xae\x13\xaf\x26\x17\x84\xec\x05\x1a\xdc\x82\x99\xb5\x2e\xa8\xe1\x58\xdf\x29\x51\x82\x72\xe2\x33\xac\xc5\xc5\x12\x1a\xa5\x27\xfc\x55\x7c\x99\xfe\x12\x06\xba\x4f\x44\x9b\xe1\x4e\xa5\xf7\x26\x3e\xb7\xbc\x9b\x81\x33\xf5\xdb\x1f\x0a\xa8\x84\x66\xb1\xbf\x3a\xef\x6a\x38\xe7\x40\x0d\xa2\x2d\x2f\x47\x7f\xb7\xae\x83\xda\x4d\xeb\xa3\x47\x5d\xca\x4b\xcb\x12\xb5\xaa\xeb\xc2\x47\x7a\xc5\xaf\x07\x4e\x9a\x8d\x93\xc6\x90\x60\x4a\xd2\x6e\x8a\x5c\x23\x40\x82\x0d\x77\x31\x12\x25\x6f\xae\x0b\x98\xcd\x0a\xbf\x9e\xff\x7c\xf8\x28\x21\xb2\x40\x3b\xbc\x94\x0f\xfe\xd2\xfe\xf8\x68\x0b\xd6\x4f\xeb\x7e\xe1\x03\x80\x3a\x79\x98\x9f\x8c\xdc\x83\x3d\xfe\x13\x4c\x04\x8e\x61\x8a\x2c\xa5\x01\xfe\xe1\xec\xf7\xd0\x70\xb1\x64\x2b\xb1\x6f\xcc\x3b\x04\xc3\x90\x70\xde\xc9\x31\x82\xd0\x33\x56\xd3\x7f\xbd\xc8\xb3\x84\xb9\xed\x96\xb7\x91\x77\x92\x2d\xec\xf0\x03\xdd\xb1\x90\xbc\x03\x61\x06\x97\xcc\xaf\xfe\x6d\x9a\x01\x3f\xe5\x57\xaa\xbf\x05\x03\xc0\xf6\x46\xc2\x2f\x5e\x04\x2e\x37\xa4\x85\x19\x92\x30\x78\x7c\x9b\x1f\x08\x92\x04\xaa\xa3\x69\x38\x46\xae\xf7\x7a\x35\xd8\xe5\x00\xe6\x3b\xd9\x81\x4c\x5f\x8e\xdf\x4c\x31\xf4\xdc\x07\xc2\x1b\xe3\x6e\x31\x39\x48\xc1\x2f\x59\xbc\xa6\xeb\xca\x2c\x94\x5f\xcf\xec\x47\x53\x58\x81\x08\xe4\x53\x9c\xc8\x1d\x3e\x50\x4b\x62\x35\x6d\x41\x44\x07\x05\x5b\x82\xd4\xb2\x1a\x6d\xf0\xfb\xb3\xa9\xcc\x6e\x25\xf5\xdd\xd7\x33\xc2\xcc\xfc\x33\x46\xda\xb9\x81\xfc\x63\x46\x73\x5e\x95\xf6\xcf\x2b\xdb\xba\x84\x9d\x68\xfe\xc7\x94\x0b\xf9\x7f\x2c\x9d\xb5\xd6\xb4\xcc\x12\x85\x2f\x88\x00\xb7\x10\x67\x70\xb7\x0c\x77\x77\xae\xfe\xac\xf7\xfb\xcf\x24\x93\x0c\x2c\x56\x4f\x57\xed\x67\x37\xd5\xd5\xfe\x20\x3b\xd4\x6b\xbd\x6f\xcc\xd5\x25\x3f\xd2\xce\x35\x33\x70\x64\x43\xc7\x2f\x35\x18\x0f\x9b\xc2\x66\x76\xb9\x66\x17\x40\x4e\x12\x64\x72\x2d\xe4\x00\x36\x52\xae\x5e\xd3\x71\x49\x09\xba\x94\x38\xe1\x0f\x34\xdf\x2f\x6f\x9c\x19\xc9\xac\xc9\xd6\xcf\x11\x6f\xe3\x50\x74\x7c\x85\xdd\x43\xd5\xdd\xb7\x10\x5e\x38\x6f\x48\xa9\xdc\xc2\x38\xb6\x21\x95\x3a\x84\xe3\x07\xb1\x5f\x84\x74\x8e\x96\xc0\xda\xbc\xc6\x0b\xe6\x38\x99\xa9\x32\x10\x8c\x8a\x8d\x4c\x93\x38\xff\xd7\x7d\x38\x45\xb2\xdb\xc0\xf3\x6f\xf2\xb4\xcc\x94\xa8\x0a\x0e\x5a\x1d\x86\x98\x73\x66\x37\x59\x71\x22\x6c\xfa\x2e\x15\x7e\x01\x46\xe7\xc3\x62\x6e\xea\xbc\xf1\x6f\xbd\x6a\xdf\x98\x87\x9e\x59\xb6\xab\x22\xb8\x31\x21\x6d\xc4\xcb\xa4\xbc\x26\x47\xe9\xb7\x8b\xc1\x40\xd1\x9c\x3e\xcc\x58\x81\xa9\x33\xe1\x38\x17\x63\xff\x57\xb7\xec\x90\x84\x21\x9a\x1d\x18\x75\x5a\x20\xb3\x36\xfe\x36\xbc\x4f\x79\xc9\xe4\x71\x5e\x30\x49\xc3\x08\x95\xab\x62\x1c\x1c\xea\x32\x48\xa6\xb0\x20\x05\x13\x73\xdc\xbe\x0c\xcc\xfc\xef\x37\xfb\x13\xb3\xc6\x71\x90\xa6\xc4\xb3\x0f\xf8\x52\x1d\x7f\xbb\xc2\xd1\xc4\xa1\x4c\xa1\x51\xc3\xdc\x5e\x72\x20\x6d\xbd\xa8\xa9\xf4\xb4\xca\xdd\xb5\xd5\x83\x85\x34\x17\x48\xab\x3f\x28\x36\x55\x5a\x3a\xd3\x35\x30\x23\x22\xc2\x30\x2b\x6d\x3c\xca\xde\x82\x38\x62\x25\xd6\x59\x68\x1c\x21\xbc\xbd\xba\xff\x6f\x5f\xf5\x20\x13\xc9\x83\xa8\x4f\xac\xe7\xed\x5b\xfc\x88\xa0\x9f\x3d\xaf\x42\xf1\xbd\x52\xa5\x3a\x16\x59\x14\xc5\xe9\xd8\x0b\xf8\x1a\xd2\xfb\xb2\x1d\xf5\xae\xc5\x0e\xd5\x16\x7c\xd8\x88\x02\xd9\x63\xef\x9f\x1e\x4b\x75\xcb\xdd\x96\x0c\x02\x95\x49\xee\xa6\x6d\x11\x67\x8e\x54\x26\x00\xde\xb3\x6f\x3c\x09\x45\x97\xd7\x64\xe3\x66\x3b\xaf\x84\xde\x3a\x89\x7f\x86\xfc\x79\x83\x3f\x83\x01\x31\x2b\x3e\x26\x11\x9b\x59\x37\x31\xc6\xe2\xc5\xdd\xa7\x88\xbd\xa2\x86\xaf\x43\x1d\x56\xe8\x88\x6c\x3e\x6c\x7c\xb6\x76\xe4\x59\x74\x1c\xf8\x8f\x97\x8d\x26\xa1\x7a\xac\xa6\x62\xab\x1e\x18\xdf\x2d\x3d\x99\x2c\xd1\xc3\x74\x6e\x73\xe6\xf8\x60\xfd\x7e\x8e\x99\x6d\x4a\x75\xc5\xbb\xfe\xe9\x9e\x2f\xf3\xb3\xce\xdd\xa5\xf8\x9a\xb7\xff\xcd\x1a\x77\x5f\x17\xaa\x66\x88\x80\x3b\x9a\xf1\x14\xcc\x91\x9f\x91\x36\x04\x41\x10\x4a\xb1\x9b\x54\x39\x6a\x7c\x72\xf7\x10\x0d\x8f\x7b\x3c\x4c\x53\xd5\xae\xf9\xb3\xed\x30\x89\xe4\xf4\x2e\xf1\x62\xab\xc0\x34\xa6\xcc\xef\xe6\x0a\x4c\x03\x69\xc3\x99\x8f\x51\x75\xab\x3c\x5e\x18\x4c\x38\x4c\x68\x62\xff\x16\xfa\x25\x5b\x24\xbd\x51\xc3\xd4\xdb\xad\x5f\xa9\x5b\x00\x5a\xe3\xc7\x4c\x37\x45\x81\xe6\xd7\x67\x9e\xa2\xbf\x6e\xe2\x5f\x01\x6c\xd8\x0c\x1b\x50\xa3\x52\x44\x21\xbc\xaf\x92\x99\xa2\xd9\x21\x0a\x3c\x99\xb3\x38\xbd\xdf\x89\x3c\x03\xb2\xef\x17\xdc\xbf\x16\x1c\xac\xd1\x7e\x03\xf4\x58\x18\x56\x0b\xfb\x68\xed\xf4\xb0\x97\x34\xdb\x9c\x56\xd5\x62\xc4\x54\x22\x6c\xe9\x08\x08\xbf\x19\x62\xbf\xc3\x3b\xcf\xd6\x34\x1b\x50\x85\x44\x7a\x0f\x15\x88\x4e\xc0\xdc\x6e\xab\x06\xd0\x4b\x9e\x5a\xcd\x24\x2b\xb7\xae\xeb\x66\x3e\xba\xd3\x8e\xc2\x18\xf9\x6f\x61\xb5\xbd\xdd\xd2\x3f\xf1\x1d\x95\x31\xa1\xcb\x2e\x11\xe0\xf8\x23\x2c\xc6\x79\xa3\x0f\xe3\x80\xca\xc2\x81\x6a\x62\xee\x75\x5d\xd3\xdd\xca\x1f\xc0\xf4\x63\xa1\xe6\x58\x07\x3f\xb3\x09\x88\x51\x28\xb3\xda\x7d\x50\xbc\x1e\xbf\x8d\xea\xa2\x57\x38\x4b\x15\xa8\x87\x0a\xcf\x9f\x2c\x8d\xcc\x30\x8e\xfb\xd3\xfe\x37\xa5\xcb\x37\x8e\x71\x83\xdd\x0c\x2e\xf3\x44\x77\xf8\x0b\x0f\x5b\x18\x16\xce\xeb\x33\x63\xfa\x9a\x7f\xc7\x85\xa8\x52\x33\x27\x63\x72\x66\x33\x29\xfc\xec\xa5\xd2\x93\x91\x89\x6c\x50\xfe\xe3\xaf\x39\x52\x4b\xab\x57\x90\x17\x3a\x7e\x19\xfc\x4a\x24\x32\x45\x1b\x3a\xc0\xbc\x7d\x9b\x86\xe8\x50\x39\x5f\x17\xc8\x5d\x91\x9d\x83\x51\x73\x14\x10\xfd\xba\xc6\x12\x42\x81\xd3\x63\xc9\x9d\x29\xb0\x5c\xbb\xb8\xe1\x70\xfe\x36\xee\xdc\x5a\xf9\x61\x18\x04\x21\x49\xba\xbc\x22\xfe\x8d\xdc\xdd\xc4\xe7\x94\x6d\x77\x4c\x6f\x9c\x1f\x08\x5c\x57\xb5\xeb\xed\xab\x24\xd8\xed\x03\xfa\xfd\x34\x31\xd3\x72\x4c\xab\xba\xf3\xdd\xfb\x62\x7e\x3b\xc2\xdb\x72\x82\xc3\x88\x9d\x74\x99\x66\x59\xee\x27\x11\xc3\x0a\x74\xcf\xf3\x8f\x3b\x4d\x57\x5f\xd7\x1f\xa7\xd6\x5c\xe3\xb0\xae\x6d\xf1\xb2\x5c\x57\x27\x9b\x93\x30\x8e\x16\x4e\xb0\x95\xdb\x42\x82\x1b\x65\xe1\xdf\x64\x99\x41\x57\x80\x41\x62\x14\x68\x00\x07\x19\xb4\xaa\xed\x32\x0b\x35\xcb\xac\x7b\x0e\x14\x95\xea\x4f\x00\x72\x0f\x89\xeb\x6b\xe2\x7a\xf7\x02\x94\x15\x3c\xcf\xfd\xf8\x1c\xfd\x21\x46\x57\xd7\x91\xf4\xec\xe9\xab\x74\x2d\x3d\x45\xa7\xa7\x55\x2c\x74\x73\xe3\x9b\x66\x5e\x0d\x43\x7d\x2f\x9c\x4a\x86\x5b\xd1\xda\x85\xdf\xc4\xb0\xb7\x59\x94\x56\x27\xdb\xc3\x25\x36\x09\x04\xd6\x5a\x08\xdc\xbc\x11\xd9\x04\xe8\xc9\x99\x22\x77\xca\xe7\xef\x6c\x47\xb0\x1c\xf7\x83\x39\xf8\xc8\x02\x1d\xc4\x1b\xf9\x58\xcf\x62\x4a\x60\xc3\x38\x7a\x02\x54\x3c\x66\x07\xaa\x9b\x90\xec\x53\x45\xf6\x0d\x1b\xce\x4b\xee\x8e\x37\x30\xd6\xa9\xaa\x2a\x53\xce\x34\xb2\x53\xc7\x75\xad\xb1\x4a\xb7\xb5\x83\xb1\xd9\x5f\x98\x19\xc4\x97\x17\xc4\xe2\xaa\xbe\xcb\xb9\x5b\x40\xe0\xaa\xd7\xe3\x12\xcf\x4d\x3c\x06\x0a\x94\xe5\x41\x8a\xb8\xb7\x29\x50\xea\x39\xbf\x97\x8b\x7d\xe7\xc7\x3c\x9c\x3b\x6e\x46\x43\x1c\xc4\x2d\x1a\xbb\x72\x13\x06\x43\x92\x08\xc7\x0e\x83\x20\x58\xd7\x3b\xf3\x2b\x5f\x2a\x12\x3c\xe1\x31\x5f\xf7\x4b\xd5\x76\x9e\xcf\xdf\xae\xc7\x90\x78\xfc\x38\xbd\x1f\xd3\xb1\x99\x11\x15\xa7\x4b\xc8\x09\x90\xf9\x35\xba\x12\x51\xc8\xba\x80\x4d\xf7\xcd\x21\x6f\xcf\xe8\xb3\x4b\x7a\xe0\xa6\x3e\xd7\x94\x3b\x84\x51\x4f\xff\xf6\xc5\xa7\xac\x4c\x74\x0f\xf3\x90\x81\x2c\x94\x06\xf7\x3a\x04\x4a\x4b\x68\x95\x36\x91\xd6\xb3\xa0\xb0\xab\x56\xf6\x3a\x24\x4a\xb4\xb5\x51\xbb\xd6\x18\xd3\x4b\x66\xac\x91\x2f\xfc\x77\x2c\xdb\x4b\x55\x16\x9a\x16\x76\xdd\x0d\xd5\x06\x11\xd0\x7e\x1f\xf0\x20\x39\x6d\xb2\xfd\x56\xb3\x9e\x0d\x3e\x43\x92\x61\x1f\xfd\xd2\x92\x39\x68\x51\x7d\x69\x9b\xb1\xe9\xa5\x2e\x95\x07\x6e\xc0\xd6\x0a\x8b\xbb\x77\x2d\x47\xd9\xf7\x61\x4b\xd6\x36\x3a\xd2\xc4\x22\xee\x87\x12\x8f\xc5\xda\x4e\x7d\xd9\xa0\x0d\x5f\xc6\xf4\xfd\x39\xee\xba\x0e\x5b\xfd\xcd\x07\x4e\xca\x96\xcc\x9c\x57\xb3\x1b\xbb\xe4\xd2\xbb\x38\x4d\xc7\x61\x45\xc9\x03\x52\xc4\xab\x6e\x74\xdc\x5a\x10\xf2\xf7\xc1\x86\xd0\x39\x20\x27\xdd\xa8\x7b\x90\xe2\x0a\x67\x87\x29\x65\xca\xe0\x4e\xfe\xaf\x94\x9d\x40\x2c\xc6\xb5\xec\x17\xd5\x85\xdf\xe6\x3a\x18\x9b\xf9\x99\x11\xb6\x07\x00\xcd\x52\x29\x58\x4d\x28\x88\x4e\xb0\xd0\xef\xeb\xb9\xfa\x56\x76\xac\x8e\x0a\x80\x5d\xc3\xe0\x27\x07\x11\x34\x68\x91\x3f\x51\x6c\xfc\x50\xee\x06\x4a\x52\x11\x53\xe9\x89\x82\xd7\x3b\xe3\x73\xa3\x22\xe9\x58\x5c\x36\x59\x70\x65\xf7\x8a\x84\x02\xc2\x22\x3e\x6b\xfa\x28\x79\xea\x10\x59\xad\x1d\xe2\xb7\xed\x71\x97\x83\xd3\x79\x63\x69\xab\x36\xee\x0c\x55\x96\x3c\x7a\x68\x69\xd3\x63\x29\xab\x59\x9d\x7a\x19\xba\x09\x9d\x43\x6d\xea\x7b\xf0\xee\xaa\xfc\xf1\x0b\x0c\xc9\xfd\x18\x22\x3a\x1f\xa1\x18\xbd\xd7\x31\xd5\xfd\x1e\xdd\x15\x56\x07\x3a\xf6\x42\xc9\x70\x01\xbf\xf5\x40\x74\xa0\x5c\xfe\x6c\x86\x69\xf5\x4e\xff\x7e\xaf\xf1\xcb\x78\x9a\xd6\x7d\x5b\x93\xce\x71\x10\x8c\x8c\x85\x15\x71\x48\xca\x48\x78\xe8\x7b\x50\x85\x50\x0b\xce\x74\x4c\x46\x47\xab\x2c\xea\xcc\x39\x9d\x96\x5d\xc8\x5c\x26\xed\xcd\x7b\xc5\x14\x7a\x6c\xb6\xcf\xea\x03\xc0\x4b\xaf\xb9\x87\x4d\x1b\x1e\xad\x5a\xac\x8d\x47\xe5\xb4\xa3\xa6\x71\xf6\xa9\x6b\xa8\xa8\xf5\xdd\xd9\x49\xb9\xec\xde\x35\xee\x44\xab\x53\xe0\xde\x3b\x44\x8b\x1e\x5f\x6d\x93\x4a\x29\x67\x83\x09\xba\x5c\x4e\xff\x31\x5e\xe6\x90\xd0\x68\x1b\x27\x6f\xa9\x6d\xed\xd3\x2f\xcd\x44\xbd\xfa\x27\xce\xe9\x10\x25\x27\x4a\xc9\x73\x15\x91\x35\x07\x15\xb2\x43\x1f\xe3\x97\xb7\x61\x59\x7d\xb3\x1e\x8e\x79\xba\xab\x99\x01\x8b\xd6\xf4\xfc\x76\x5f\x10\xd9\x3b\xac\xf4\x54\xb3\x59\x86\x63\x06\xec\x14\xf9\xdf\x19\x08\x56\x84\xa8\x49\x0e\xfb\xfb\x96\x22\xca\xd0\xa4\xd5\x80\x01\x23\xf6\xfb\x0a\xab\xbe\xf7\x3a\x08\x83\x20\x38\x50\x8d\x61\x37\x9f\x1c\x8f\x91\x2c\xed\xe9\xe5\xfc\x2a\x2f\x2d\x8f\x3f\x18\x93\xd1\xe4\xc5\x97\x73\xd4\x1f\x2c\x66\x70\x14\xf6\x57\x00\xb2\x0e\xa6\x8d\x3c\x2b\xdd\xbd\x6f\xea\xba\xb6\x23\xc7\xd6\x34\x5a\x08\x81\xbf\x96\x51\x83\x51\x72\xdd\x67\x18\xcd\x8a\x98\x1a\xcb\x0e\xd7\x96\x14\x7e\x9b\xca\xf3\x25\x14\x00\x54\x60\x5e\x6a\x31\x94\x76\xaf\xde\x91\xf4\x0b\x74\x5e\x7b\x37\x0d\xb8\xa8\xa5\x87\x92\x07\x36\x8c\x90\xfa\xf1\x97\xac\x60\x24\x4c\xd0\xc8\xe6\x2e\x4c\x9f\x32\x0c\x67\x0b\x4d\x9e\xb9\x84\x9b\xaf\xeb\xbc\x12\x2e\x2a\xd4\x11\xd5\x02\xef\x3d\xab\x48\x15\xcc\x7b\x06\x30\x77\xfc\x6b\x7c\xf5\x4d\x3c\x42\xab\x9a\xb8\x14\xe7\x54\x52\x20\x03\x25\xe9\x17\xaf\x00\xaa\xa4\x42\x55\xee\x9c\xd6\x44\x18\x85\xb2\xcb\x09\x3b\x7f\xe7\xf6\x6f\x1d\xf8\xe8\xdf\x1a\x33\x39\xa1\xd1\x3d\x3f\x91\xf5\x20\x58\x52\xb5\x49\xb5\x30\x4a\x9c\x01\xfa\x9d\xfd\x79\x73\xbf\x4e\x9f\x70\x67\xce\x24\xcd\x41\x0b\xce\x89\x31\x2d\xdc\x02\xe8\xd0\x5c\xbc\xcf\x04\xa3\x60\x19\x6a\x18\x89\xf4\x3d\xb7\x83\x78\x53\xc7\x48\xa5\xda\x5f\xd5\x76\x45\xb2\x62\x54\x4d\x8d\x55\x60\x84\x2c\xbe\x5f\x33\xfa\xeb\xba\xac\xae\x00\x34\xde\x4d\x6b\x25\x5a\xa2\xd9\x2e\x70\x80\xf3\x9e\xa9\x41\x57\x81\x4c\x32\x02\x63\xdf\x3e\x08\x8f\x4e\x9f\xb4\x5c\x31\x48\x63\xb3\x09\xef\x7b\x74\x22\x72\xcd\xb5\xca\xc6\x3f\x6e\xe3\x40\xb1\x05\xe9\x0e\x2f\x21\xdc\xe4\x8f\xb5\xf4\x58\x3b\x12\x43\xb4\xb8\x7e\x01\xb6\x07\x49\x18\x4c\x09\x52\xd4\xf5\x75\xb3\xa5\xdf\xcd\xf4\x96\x4a\x1b\x42\x18\x23\x9f\x29\xbd\xdb\x86\xde\xf7\xcc\x08\x7c\x64\xe1\xec\xde\x99\xf2\xed\x18\x50\x9d\x5f\xbc\x58\x82\x77\x4e\xd5\x82\xac\x07\xd9\xe1\x64\x71\x4e\xd5\x01\xcb\x13\xd6\xab\x52\x66\x8c\x2f\x55\x80\xc5\xe9\xf9\x90\x31\x13\xd4\xf9\xc7\x6c\x35\x52\x47\x48\x88\xdc\x12\x3d\xeb\xb4\xf7\x4a\xa5\xc2\xef\x65\x52\x06\x60\x14\x0d\xdc\x64\x76\x73\x4a\x9c\x64\xb7\xda\x14\xa3\xd6\xe2\x4b\x97\x13\x7f\x90\x62\x0b\x1b\xc8\x95\x9d\xe8\x62\xb2\x03\x9a\x85\x54\x15\x7d\x4a\x70\xfd\xeb\xd5\x63\xa1\x28\xe5\x6a\x3c\xd3\xbf\x85\xd9\x4f\x48\x5a\x00\x9b\xff\xe6\x1a\xc7\x0c\xbe\xc5\x30\xce\x1f\x14\x38\x6a\xbf\xf8\x1d\x0f\x81\x6b\x6a\xee\x9b\xf0\xde\x87\xa5\xbd\xf9\x92\x89\x09\xe0\xfd\xfa\xe8\x06\x26\xe4\x4d\x9e\x3c\x12\x57\xb8\x3c\xed\xe3\xf4\xda\x28\xd8\x60\x68\xe8\x06\xf0\x75\xa2\x85\xf8\x69\x39\x5f\x51\x6e\x0d\x4c\xc7\x51\x53\xc7\x21\x5c\x32\x09\x03\x83\xc3\x30\xe6\xea\x0a\x91\xfa\xee\x78\x7e\x45\xde\xd2\xef\xd6\x09\x58\xd1\xd1\xc5\xa3\x0a\x14\x42\xbe\xaa\xef\xe5\x41\xb4\xc1\xd7\xb3\x1e\x7d\xca\x0e\x66\x7a\x67\xef\x22\x23\x69\x00\xa3\x72\x6e\x9a\xcc\x73\x65\xe1\x8f\x5e\x0d\x98\x5d\x41\xd9\xa0\x89\x30\x5c\x44\xf1\x4f\x64\xeb\x5c\xd4\xbf\xb9\x9e\x0e\xbc\x4c\xac\x32\xc7\x22\x06\x03\xc0\x6c\x77\xf2\x5f\xb5\x46\xdb\x03\x5a\x13\x7e\x0b\xbc\x50\x47\x30\x61\x7e\x7b\x26\x35\xb3\xab\x69\x1c\x71\x5e\x5b\xdf\x3a\x66\xef\x5e\x17\x05\x54\x56\x70\x81\x58\x5b\x52\x43\xe7\xca\x94\xcb\x30\x0c\x1e\xc0\x41\xe0\x44\xcc\x17\x81\xa9\xb1\x17\x4a\x52\x44\x3e\x7c\x17\x0a\x66\x31\x61\xf8\xe4\x65\xed\x26\x3a\x50\x55\xbb\xad\x7d\x31\xa1\xd6\x6b\xa7\x1d\xb6\x76\x45\xae\x42\xfe\x54\xca\xec\x21\x9d\xaf\x2e\x98\xc4\xc0\x9b\xb9\x1e\xb0\xbb\x4d\xb5\xa3\x2b\x69\x8e\x91\x60\x70\xa2\x27\xae\x41\xea\xf4\xa8\x41\xb1\xa3\xc0\x0d\x4b\xf8\xc8\x22\xb9\x7b\xa0\xcc\x9a\xbc\x98\x0e\xaf\xe8\xa2\x81\x09\x48\xb8\xe4\x0c\x49\x63\xac\xa3\x0f\x5f\x42\x7f\xe0\xf9\x53\xaf\x77\x95\xdb\x6b\xe0\xd6\x16\x84\x20\x12\xa3\x60\xfa\x06\x94\xd9\x6a\x2c\x73\xa4\xe4\x79\x96\x39\x00\xb0\x7c\x72\x4f\xa0\xac\xbc\xe6\x9d\x05\x9a\x3d\x8c\x59\xf9\x97\xba\xb4\xe1\xc5\x53\xaf\xca\x47\x56\x83\xec\x16\x4a\xe7\x40\xc5\x47\xe0\x57\x97\x40\x11\xeb\xed\xb4\xf6\xaf\xc5\xbd\x39\x6c\xbe\x3d\x74\x4b\xd3\xcb\x65\x4d\x6a\x20\xa3\x97\x1f\xe1\x16\xf0\xd7\x5f\xbc\x20\xf1\x9b\xbe\x3a\xad\x10\xf6\x11\x59\xfb\xa8\x8f\xfb\xbc\xbe\xe7\xad\xf5\x29\x50\xc9\xa0\x88\x6b\xce\x6d\x26\x34\x50\x5e\x99\x24\x14\x27\x22\xcb\xa0\x8c\x90\xc5\x75\x84\x64\xa1\xcc\x80\xfd\x5c\xa3\xd9\x43\xfe\x8f\x19\x2d\x44\x72\x2a\xa8\x71\x30\xa8\xf4\xb2\x4f\x7f\xf7\x4d\x15\xf3\x33\xec\x51\x28\x61\x30\x55\x6d\xe7\x75\xf4\x92\x91\xc8\x28\xb0\x94\x71\x3a\xcb\x4b\xf0\xbc\x22\x11\x00\xf7\x40\x74\xf2\x97\x80\x2e\xde\xd6\x35\x95\xaf\xf2\x4e\x57\x7d\x93\x67\xb7\xd3\x89\x92\x3b\x16\x76\x6f\xd7\x14\x04\x00\x2f\x3c\xd7\x96\x62\x1f\x43\xb0\xc5\x50\xb0\xda\x1d\xd3\xf0\x9c\x25\xdc\x82\x46\xc9\x4d\x1f\x83\x7e\x88\xb6\xbe\x57\x62\xb8\x44\x30\x24\x81\x80\xcf\x7e\x20\x3e\x45\xc5\x33\x7d\x53\x92\x62\x57\xe9\x65\xfd\xd4\x12\xb3\xb1\xab\xd2\x48\xec\xd2\xc4\xa1\xc8\x71\xc3\xf0\x4a\x58\xae\xc0\x8c\xb3\x73\xb4\xe0\xec\x99\x74\x45\x1b\xaa\x0c\xf8\x5e\x44\x11\xdc\xcf\xa1\x46\x5d\xd6\x0b\x31\xf5\xf2\x63\x55\x45\xdc\xf1\xea\xf7\x43\x60\xf5\x5f\x3d\x3a\x71\x6e\x73\x7d\xdf\x62\xd1\xeb\x3c\x59\x22\xe4\x88\xd6\xb1\xc0\x52\xa1\xea\x09\x8d\x39\xf4\x99\xcf\xb8\xa2\x63\xf4\x2b\x68\x11\x7f\x41\xaf\x80\x74\x43\x8c\xcb\x1a\x19\xe3\x97\x12\xfb\x26\x79\xf6\x13\x10\x94\xcb\xff\xbe\x05\x92\x3c\xe5\x84\x8a\x3e\x19\xbc\xa4\x99\xe1\xf4\x70\x97\xfc\x2d\x39\xb6\x20\x80\x22\xb9\x9c\x0d\x02\xc2\x61\x49\x8a\x13\xf5\x87\xe8\xfc\xf0\x5b\xe7\xb3\x03\x21\x9a\x2b\x11\x82\xdc\xd2\xfa\xfb\xce\x2d\x81\xc9\x43\x0c\xb4\x70\xb4\x23\x3a\x8e\x07\xb9\xba\x37\x00\x30\xb3\x20\x20\x67\x99\x7a\xd8\xc3\x9c\xaf\xf3\xf4\x39\xb6\xe5\xc6\x0a\xdf\xd4\x19\x5b\x2a\x2b\x6b\x82\x39\x81\xeb\x3e\xe2\xf0\xb7\x7a\x0d\x16\x82\x64\xbf\xb5\x24\x31\xae\x34\x15\x12\xd2\x4c\x2c\x62\xdf\x1c\xd5\x23\x2d\x78\x7f\x70\x02\xba\xc1\x70\x64\x27\xa2\x25\x54\x0d\x24\x63\xf1\x9b\x2b\xf3\xc5\x76\x7b\x56\xb9\xba\x7e\xff\x3f\x0e\x26\x06\x4a\x94\x33\x0d\xda\x0c\x38\xff\xf6\xb5\x9f\xd1\x2d\x0e\xfe\xbc\x09\x36\x55\x79\xcb\x7c\xcf\x05\x5e\x3c\x4b\x7e\x45\x0f\x46\x96\x8b\xbd\x88\xe0\x05\xbd\x81\xf9\x7e\x1f\xdb\xe3\x8b\x8d\x77\xaf\xc8\x73\x4a\x12\x06\x81\x1f\x14\xed\xe8\x43\xb6\x90\x18\x88\xc4\x19\x46\xd6\x6e\x01\x42\xe3\x27\x9a\x1d\x48\x73\x2d\xfc\x6b\x76\xc6\x8b\x26\x2f\xd8\xde\xf2\xca\xa3\x05\x94\x19\x52\xc3\xec\x3a\xf7\xee\xdb\xef\x4b\x28\x92\x72\xeb\x3e\x10\x9d\x90\xc5\xef\xe6\x2a\x3d\x50\x82\x9f\x02\xe6\x6d\x06\xa2\x5f\x0c\x97\x7c\xa9\xde\x16\xf0\x53\x15\xa5\x4e\x63\x28\x35\x42\x65\x18\x7e\xaf\xde\xe5\xd3\x29\x56\x83\x98\x09\xdf\x3e\x0e\xe1\x06\xd9\xc4\x90\x5e\x57\x98\xc5\x54\xfb\x5b\x1d\x67\xdf\x46\x11\xc8\x59\xb6\x7e\xd8\xa6\x96\x01\xf7\x4b\x12\x3e\x9b\x75\x99\x00\xa6\xcd\x25\xac\xde\x73\x76\xa4\xb7\x90\x92\xd7\xc0\x16\x35\xc6\x8f\x02\x2a\x53\xc0\x2e\xf1\x82\xb5\x1f\x11\xb3\x1e\x52\x4c\x49\xa7\x75\x65\x2f\x71\x2e\xe7\x74\x78\x65\xe1\x4c\x62\xa6\xbe\xe1\xe7\x93\x82\xe9\xa3\xe7\xd7\x88\x70\x68\x19\x3f\xf0\x3a\x74\xa8\xef\xf1\x59\x38\x9c\x2c\xed\x1e\x75\x27\xc9\x0f\xf1\xe6\x0f\x42\x3a\x6e\x2b\xb4\x07\x9a\x22\xb8\x51\x67\xeb\xb4\xea\xee\x5b\x07\x7a\xaf\x22\x5e\x63\x93\xf3\xdf\x92\x86\x03\x5d\x91\x93\x08\x19\x5c\x3b\xb7\x67\xdf\x09\xaf\x71\x92\xce\xfe\x56\xab\x54\xb3\x33\x79\x59\xd3\x07\x3d\x78\x1c\x4a\xc4\x64\x3e\x7b\x3d\x1c\x75\x8a\x0c\xcb\xdf\x7d\xf6\x2f\x08\xb1\xb7\x30\x05\x66\x15\xa1\xb6\xc3\x91\x23\xdc\x00\xb0\xa2\xdb\x22\x9e\x95\xb4\xb0\x26\x6f\xae\x24\xae\x1d\xee\xb5\xb2\x46\x41\xa4\x6e\xe8\x66\x8a\x85\x37\x00\xad\x6d\xe7\x53\xfb\x9b\x3b\x83\x1f\x29\xa2\x93\x47\x94\x19\x99\xcf\x2d\xa0\x63\x3a\x2e\xeb\x1f\x17\xed\xa3\x95\xd1\x03\x55\xcd\x6f\x7f\x69\x24\xf8\x6b\x38\x59\x04\xb5\x97\x62\xc1\xea\x12\xde\xf7\x76\xe9\xf2\x44\xba\x99\x48\x85\xfd\xc5\x21\x1c\xe2\xc0\xf6\x5f\x4f\x41\x6b\xda\x04\xe2\xc7\xea\x23\xef\x28\xd2\xba\xc3\x3b\x69\xbe\xf8\x4e\x24\x2d\xe8\x84\x17\x3a\x77\xef\x0e\x54\x56\xa4\x87\x02\xae\x67\x36\x08\xe9\xb2\x97\xea\xa4\x7a\x5e\x2e\x67\xab\xe7\xc4\x35\xd5\x45\x87\xbf\x45\xca\xf9\xae\x11\xe9\x5a\xa1\x9d\x19\x94\xc2\xe7\x66\x74\x46\xf3\x4c\xb4\xd1\x85\xc8\x6e\x5a\x89\x3f\xf3\x40\xff\xf4\x27\x93\xdd\xfe\xd0\x5a\xb4\x46\xc8\x17\x30\xe5\x6e\x78\xad\x8b\xa1\xa4\xd6\x79\x60\x02\x2f\xd1\x0a\x95\xb5\xb6\x0f\xc8\xc3\x6d\x6a\xc2\xf4\x04\xec\xa7\x53\x2a\xdd\x0f\x9b\x16\x3e\x45\x14\x78\xfc\x62\xcd\xe0\x47\x81\x46\xff\x25\x4d\x1c\x89\x84\x81\x24\x69\x88\xad\x52\x87\x74\x4a\x1f\x7d\x8d\x41\x46\x07\x01\x16\x18\x08\x04\x0f\x94\x4a\xfd\x8c\xe9\x44\x2e\xeb\x93\xe5\xde\x3d\x87\xda\xf1\xaa\xb6\xa3\x4b\xd2\xf1\x6d\xb6\xaa\xd4\x60\x09\xd7\xd8\x44\xe0\xe4\xd7\xb7\xc1\xf7\x35\x7e\x79\x25\xe0\xe8\xbc\x69\x2e\x52\x98\x11\x2a\x3d\x37\x55\x09\xcc\x86\xcf\x69\x3a\x1a\xe3\x87\xe5\x9a\x32\x4c\x1d\x4c\x2f\x13\x5e\xad\x2b\x09\xd0\x4e\x95\x10\x89\x41\x94\xd3\xcc\x47\x4c\x5a\x0f\xb5\xc4\x08\x29\x49\x17\x4e\xc0\xf6\x7c\xea\xb8\xac\xa3\x1b\x06\x42\x4b\xe9\x25\x3f\x5f\x9f\x77\x4b\x89\x1e\xb8\xf4\xec\x89\xad\xa2\x24\x0e\x13\x40\x74\xe7\xbc\xa3\xd5\x27\x59\x9e\x99\x31\xc2\xe1\xca\x45\x18\x0f\x78\x83\xe8\xe4\x45\x9a\x23\x76\xfd\xde\x5a\xf5\xcb\xe4\x37\x7f\x81\xc0\x1c\xbb\x27\x71\xe7\x70\x5a\x54\x4f\x1f\x3b\x89\xcf\xe4\x79\x06\x04\xf1\xc2\x4e\x43\xee\x40\x05\x07\xad\xfa\xb9\x3d\xad\x52\x32\xa0\x03\xef\xd2\x82\x7e\x67\xc4\x29\xc9\x18\x4b\x7c\x4d\x98\xed\x0f\xef\x6d\x39\x1c\x96\x14\x8d\x2e\xe8\x27\x36\x35\xae\x1a\xb2\x27\x02\xac\x89\x66\x94\xc9\x40\x06\x1f\x8e\x3e\xc8\xd7\x6f\x7e\x46\xaf\x18\x36\x30\x1d\xb0\x49\xe8\x0d\x28\x7c\xbe\xfd\x23\xb8\x82\x7d\x10\x3a\x6d\xfd\x7c\x8e\xb1\x05\x8e\xfa\xac\x8f\xa4\xfd\x0d\xfe\x3c\x05\x03\xaa\x9f\xf5\x84\x16\x35\x67\x40\xaa\x33\x35\x37\xb4\xf1\xa8\x95\x4a\xeb\x37\xfc\xa0\xc0\xb8\xc1\x36\xbd\xdf\x08\xbb\xce\x8f\x5a\xb2\x7e\x26\xf2\xa7\x59\xb3\x26\xb6\x88\x0f\xf5\xeb\x8e\x1c\x5b\xfa\xa7\xe1\x68\xf6\x3c\xcc\x8b\x78\xb0\x70\xb5\x7b\x1b\x68\x4b\x52\x91\xc5\xef\xbd\x36\x52\xf6\xad\x25\x66\x76\x86\xb5\x9a\xbd\x3a\x91\x15\xd9\x66\xdc\x84\x9c\x9a\xad\x2e\x74\xa6\x13\x3a\xdb\xdf\x7d\x51\x61\x5e\x0a\xec\x59\xe1\x95\x99\xa8\x7a\x25\x0c\x9c\xec\xeb\x2f\xe3\xc9\xcd\x01\xc4\x30\xaa\xfb\x75\x0f\x05\x0a\xed\x73\x0b\xbc\xf2\x41\xf3\x24\x0b\x0c\xc0\x2d\x7e\xdf\xf7\xf3\xc5\x7e\x06\x21\x46\x82\xc1\xc7\xf8\x03\xd1\x7b\xd7\xe6\x7c\x8d\x6e\x03\x5d\x9d\xe8\x6c\x84\x07\x6c\xad\xe9\xbb\x4f\x3c\x3d\x91\xf3\x1e\x69\x0f\xa3\x9a\xa9\xbb\xac\xde\x14\x4f\x15\x4b\xcc\xb0\xce\x2e\x28\x8e\x28\x79\x49\x9f\x68\xe1\xf9\xb0\xae\x4c\xb3\x19\x0e\x4d\xf6\x10\xf7\x8f\xe9\x15\x32\x45\x8e\x99\x07\x6e\xaa\xe6\x0f\xd5\x1b\x94\x46\xa8\x44\x6b\x84\x57\x38\xdb\x3f\xfc\xe4\x6b\xcc\x64\xe0\x27\x5f\x44\x17\x73\x38\x25\xd9\xc3\x0f\x8f\xe0\x0a\xb0\xaf\x8b\x72\x6c\xf0\x44\x61\xea\xce\x50\x3c\x08\x92\xd0\x7f\xbb\xfc\xd1\x2b\x19\xa6\x21\x33\x67\x32\x82\x57\xe8\x8b\xff\x70\x08\x8c\xa0\x41\x71\xfd\x55\xb4\x50\x10\xc9\xac\x4c\x0f\xcb\x68\xc0\xd3\x66\xe4\xe6\x4e\xc4\x06\x7f\x0b\x46\x32\xdd\x05\x51\x45\x5f\x5b\x6d\xcb\x8c\xfc\xb5\x84\x40\xc8\xcc\x34\x51\x54\xd5\xa8\x9e\x5b\x18\x2a\xe1\x39\xbb\x27\x3c\x26\xc7\xc6\xa9\xd4\x74\x0a\x2d\xe2\x52\x73\x63\x49\x12\x06\x87\xb6\x56\xfc\xbd\x6b\xcc\xdf\x23\x47\xf0\x91\xb6\x64\x85\xfc\xfe\x38\xa4\x8c\xac\xb1\x17\x11\x98\xa1\xbe\xd6\xc8\xd7\x2c\x93\xa8\xd5\x7c\xaf\xcd\xbd\xba\x61\x02\xe7\xe9\xc1\xa8\x42\x41\x0f\xe7\x86\x4a\x33\x96\x65\xd3\x60\xd8\x7a\xb1\x2b\x94\xc4\x0d\xc3\xb9\x9d\x2a\x10\x1d\x7f\x13\x5b\xa4\x33\x3f\x3f\xec\x60\x1a\xcc\x63\x89\xbd\x7e\xf6\xe5\xf4\x5f\xf2\x39\x33\x24\xb0\x6a\xe3\xef\x7d\xdf\x85\x5b\x70\x04\x17\xee\x4c\xfe\x19\x4d\xdd\xb3\xfe\x44\x8e\xf0\xd1\x33\xb1\x34\xdc\xc5\xf3\x4b\xa8\x53\x29\xb5\x19\x44\xf0\x24\x0c\x30\x8c\xf1\xcb\x60\xb9\x0b\xd4\xf2\x0c\x64\x07\x0a\x8c\xe9\x5b\x8f\x43\xb3\x7d\xc3\x85\x03\xdd\x13\x9e\xf9\x7b\x69\x70\x5b\x5e\x27\x5a\x65\x5f\xd4\x3d\xe8\xca\xde\x1c\x35\xbe\xdf\x83\x01\xca\x5f\x6c\xbc\x78\xd1\x2b\xa3\x97\x1d\xaf\xe2\xb5\xb2\xf5\x52\x08\x5d\x80\xf9\xb9\x3f\x54\x69\x19\x24\xd5\xd8\x07\x66\x49\x3d\xed\x70\x84\xdc\x10\x74\x7e\xd5\x0a\xd9\x57\xd4\xb4\x07\x35\x55\x52\x99\xfe\x2f\x26\x58\xef\xdd\x44\xa9\x73\x76\x77\x6a\x16\x08\xab\xae\x4f\xbe\x60\x81\x6e\x31\xc2\xac\x40\x24\x25\x04\xb3\x02\x35\x72\x65\x18\xc6\x0f\x35\x1a\x89\x5c\x82\x1e\x48\xe8\x85\xcd\xd4\x90\x60\xc3\xf5\xf7\x36\x44\xd2\x40\x13\xbf\x51\x99\xe6\x74\xc4\xd7\xdf\xd0\x12\x41\x54\xf5\xb0\xf1\xe5\x19\x2c\x41\x58\x6e\x61\x84\x14\xd6\x54\x63\xe7\xc2\x36\xb4\x48\x84\x6e\xff\xf5\xc9\x78\xf4\xd6\xa1\x27\xf5\x0e\x18\xad\x16\x41\xb1\xaf\xea\x14\x00\x27\x4c\xf2\x92\xe5\xb7\x81\x2f\x86\x18\xf5\xd2\xa3\xa4\xf6\x51\x07\x9f\xf8\x07\xff\xc7\x64\x64\x47\x23\x5e\x82\x61\x6d\xf9\x9d\xbf\x01\x6c\xf2\x6d\x22\xba\x2a\x58\x24\xcc\xbd\x5d\xcc\xfe\x72\x72\xee\xf4\xb7\xa2\x4f\xad\x25\xd6\xb5\x9d\x05\xee\xbe\x09\xfd\x27\x2e\x7b\xd9\x3b\xf1\x1e\x92\x0d\x56\x42\xed\x05\xfe\xe9\x1a\x05\x64\xfa\x16\x00\xa5\xcd\x0f\x8e\x2f\x32\x77\x59\xaa\x3d\x61\x36\x73\xc3\x28\x52\xf7\x0c\x46\x31\x2c\xb6\xd3\x67\x52\x57\xee\xe0\xb9\xea\x33\x95\x4f\xdd\xdf\xf5\xc4\xb9\xad\xab\x18\x33\xb1\xa9\x76\x65\x49\xec\x48\xd0\xb1\x9e\x91\x2e\xf5\x8f\xd7\x2c\x72\x5c\x09\xbf\x80\x08\x1a\x37\xaa\x51\x8b\x8f\x60\x47\xd7\x90\xac\xc3\xe0\xc8\x4e\xb4\x42\xa7\x1d\xca\x0c\x68\x5e\x8e\xd7\xc9\xc8\x62\xa4\x4b\xda\x92\xba\x40\x2d\x84\xd1\x39\x63\x0f\x1f\x90\xc1\x15\xa4\x93\x01\xdf\x08\xa7\x22\x71\x48\xca\x6a\x54\xf1\x42\xcd\x1a\xbd\x6b\x72\xf6\xe7\x0a\xd7\x43\x83\x81\x56\x5e\x50\x41\xf7\x4f\x04\x9a\x07\x19\x25\xa8\xc7\xbc\x89\xe5\x56\xf5\x9a\x8e\x4a\xf3\x53\x9d\xbe\xee\x53\x8c\x41\x8a\xa0\x67\xeb\x58\xea\x7a\xdc\x4c\x45\x18\xfa\x3d\xb6\x5a\x7e\x3b\x29\xbc\xdf\x0d\x64\xd6\x94\x86\xc3\xe2\x31\xa0\x06\x9b\x93\x27\x75\xc5\xa9\x69\xe8\x2b\x43\x81\x00\xf2\xc9\xe3\x0f\xa2\x23\x04\x5b\xfd\xe7\x2d\x29\x49\xe6\x10\x59\x50\x38\xbb\xff\xb1\x11\x83\xc9\x3f\x7d\xf4\xf2\xae\x9c\xbf\x3a\xff\xe6\xa1\x7b\x50\x62\x1e\xc0\x68\xfc\x0a\x7d\x52\xc4\xfc\x56\xb9\x7e\x29\xa3\x85\x9c\x7d\x8c\xad\x55\xc1\x15\x1a\x35\xd4\x06\x82\xf3\x7d\xc5\xdd\x06\x80\x3c\xae\xd3\x8a\x72\x57\x83\x0b\xd9\x49\x4b\xab\xfe\x89\xa5\x33\xf1\x01\x79\xac\x52\x33\x07\xb6\x25\x38\xb7\x6f\x49\xa0\x29\x6b\x62\xdc\xc9\x19\x39\xbd\xf3\xaa\x2e\xf1\x19\xf5\x3f\xea\x00\x7b\x10\x80\xdd\x0d\x26\xf0\x20\x10\x1d\xe1\x66\x64\x40\xc0\x28\x53\x41\xe4\xea\xbc\xfc\xdf\x87\x8b\xae\xe5\x3b\x7f\xc3\x8d\xed\xa5\x59\xc6\xf2\x11\xda\xfd\xa2\xb9\x5f\x71\x21\x9d\x67\xa2\x1f\x85\x9b\xc9\xc8\xdd\x68\x74\x21\xb1\x89\xcc\x71\x43\x89\x42\x8f\xc8\x40\x5f\x1b\x88\x37\x2e\xdc\xf8\x86\xc4\xe7\xc3\x47\x2c\x45\x77\xd3\x96\xfd\x2d\x30\xbb\xdc\x2e\xfe\x89\x45\x2a\x09\xf1\x8d\x0c\x02\x9d\x0f\xe4\x16\xee\x65\xd2\xb7\x87\x3c\x08\x76\xbf\x5b\x6f\x18\x15\x90\x4c\x6b\x72\xd1\x14\xb1\x23\xe5\xa5\x66\x7a\x5f\xe4\x93\xa3\xd5\xb3\x20\x20\x36\x92\xc3\xfb\x65\x6b\x56\xa7\x2f\x9e\xad\x89\x50\x1b\x10\x2e\x3b\x45\x1a\xc5\xc1\xec\x80\xdd\x23\x72\x5d\x7d\xfa\x6c\x26\x12\x6f\x5f\x64\xb3\x5e\x5d\x7a\xa6\x0e\xb5\x00\xe9\x32\x57\x76\x5b\xbd\x74\xae\xd6\xa2\x7c\x78\x02\x3b\xbb\x5e\xab\x0d\x22\x0a\x0b\xdb\x4b\xe3\x44\xb7\xba\x21\x6d\xc3\x18\xbd\x2c\x40\x8d\x3b\x67\xec\x0a\x13\xbc\x37\x9d\xf4\xaa\x57\x03\x43\xf7\x7c\x64\x28\xe7\xd6\xed\x17\x2d\xd1\x91\xbc\x00\x49\x59\xf1\x2d\xa6\x80\x09\xba\x90\x3f\x1c\x0a\x2d\x4e\xfc\x49\xeb\xe8\x54\x3c\x0e\xfe\x04\x9e\xa5\x7f\x88\xd4\xca\xec\x1d\xdb\x17\x5c\x23\x89\x19\x8e\x6b\x59\x59\x63\xc2\x1b\x6e\xb4\x0d\xd4\x68\x87\x59\x43\x3a\x1b\x8a\x92\x31\x25\x89\xae\x95\xbb\xf2\xa7\x3f\x0f\x38\x5a\x0a\x79\x69\x56\x2a\x49\x4d\x1c\x18\xe0\x08\x3f\xb6\xff\x63\xed\xdb\x00\x92\x71\x59\x10\xd2\x4f\xb0\xb5\x6a\x9f\x19\x2a\x41\xf0\xce\x13\xa2\x1c\x50\x92\xbe\x9b\x52\xb0\x45\x36\x0e\x1c\xab\x23\x66\xcf\x66\xeb\xf3\x38\xd1\xac\x45\xf2\xeb\xe6\xb3\xc7\x82\xd3\x00\x9c\xd4\x7f\x3a\x9b\x09\x97\x8e\x57\x9a\xec\xba\x11\x09\x7f\xc6\xf8\x95\x95\x1e\x9e\xe3\xf1\x03\xdc\x71\xd9\x56\x12\x38\xe5\x48\x8b\x2a\xe1\x8a\xa0\xb5\x04\x67\xdd\x1f\x95\xb6\xf8\x01\xbe\x44\x4f\xf8\x23\x74\xce\xab\xd5\x0c\x0f\xfc\xb4\xd3\xea\xfe\xbd\xfb\xa7\xcb\xd3\x0f\x92\x70\x38\x17\x47\x61\x35\xe5\xea\x60\x52\xa4\xde\xac\xc3\xde\x82\xae\xf4\xd1\xba\x08\xb4\x4d\xcc\x50\x83\x91\xd4\x41\xe4\x74\xcc\x48\x1a\xe8\x17\xb8\x01\x97\xaa\x4f\x4c\x8f\x7a\xeb\xb6\x1e\x39\x76\xf2\x16\xf4\x59\x6d\xdc\xbc\xf4\xda\x9c\x83\x5c\x61\x95\xe2\x42\xd3\x82\x0d\xa5\xc4\x9c\x10\x62\x4c\xc6\x24\x60\x68\x27\x1c\x66\x22\xa3\xc8\x9c\x01\x2f\xd4\x45\x74\x4e\x88\x0d\xff\xdf\xba\xe1\xe6\x2c\x68\x32\xc3\x41\x10\x04\x00\x8f\x72\x16\x38\x11\x00\x2e\x1c\x94\x15\x6e\x30\x2c\x5a\x13\x4a\x4e\x3c\x44\x94\xa6\x20\x23\x31\x3a\x5c\x09\x52\xa5\xd6\x65\xb3\x8c\x4c\xaa\x35\x78\xa1\x0a\x76\x67\x03\x6e\x7a\x35\xcc\xfb\x36\xc5\x2b\xfd\x5f\x62\x4b\x14\x17\xda\x15\x73\xf2\x66\xd8\xf0\x54\xf7\xa3\x18\x53\xf6\xd2\x3d\x02\x41\x00\xea\x70\xd5\xf5\x27\x05\xd4\xa6\xaf\x3a\x80\xc7\x27\x96\x45\x79\xc6\x69\x59\xb3\xf1\xb6\x48\x5a\x27\x2a\x4b\xe5\xe6\x7d\xb0\xd2\xe7\xe3\x50\x7d\x78\xf1\xaa\x59\x6a\x1e\xe4\xeb\xb2\xf2\x49\x11\xda\x97\xf5\xdb\x5e\x5c\x64\xbf\x19\x3d\x10\x12\x6d\x75\x7a\xfa\x68\xb2\x3b\x37\x30\x77\x28\xb0\x12\x5b\xa2\xfc\x8d\xe0\x46\x03\x1a\x8e\x7b\x92\xe7\xd6\x3e\xd8\x25\x03\x37\xa2\xbc\x30\xc5\xcb\x60\x7e\x9c\x1b\x7a\xe3\xa2\x8d\x00\x58\xad\xcb\x9a\xf6\x8e\xc6\x33\x98\xfa\xab\xc5\x38\x10\x5d\x7f\x98\x07\xd0\x06\x72\xc3\xf3\x61\xe7\xc6\x8a\xa9\x5b\xf0\xfd\xf8\xc6\x10\x24\x31\x2c\x37\x30\x90\x14\x76\x44\x89\xe5\x45\xaf\xa4\x67\x4f\x95\x82\x49\x35\x12\xba\x1b\x10\xfc\x70\x0a\xa6\x23\xb0\x55\x30\x8d\x23\x22\x93\x5e\x50\xde\x9c\x1d\x46\xac\x3b\xdd\x95\x9d\x0c\xfb\xae\x1d\xa9\x2c\xe1\x37\x57\x0a\x2e\xfe\xe0\x95\xce\x0e\xf0\xb4\xa2\x42\xcd\x44\x4f\x5c\x3f\xa9\xc1\xbb\x8b\x6b\x95\x78\x3f\x34\xee\xed\xab\xc4\xfb\xe3\xa1\x6f\x81\x00\x8b\xae\x2c\xcd\x15\x68\x79\x05\x92\xdb\x14\x87\x24\x4c\xf4\xd1\xe3\x9f\x97\xe0\x19\xd1\x7c\x29\xa5\xc9\x15\x23\x24\xd3\x79\x55\xeb\x9a\x64\x18\xb0\xeb\xcb\x6d\x7f\xa6\x1e\x3f\xb3\x96\xe8\x35\xe3\x0a\xa7\x15\xd7\x47\x12\xa4\x9b\x20\x0c\x46\xc5\xb9\x58\xfa\x5f\x9d\x34\x59\x5d\x12\x3f\x50\x8f\x0e\x65\x06\xd1\x56\xa7\x37\xd0\xf8\xfc\x4a\xbc\x73\xb9\xfa\xa7\xc2\x47\x16\x36\x73\x2a\x08\x1c\xf2\xe5\xa7\x26\x7a\xcd\x8d\xcf\xf9\x64\x8f\x02\xd3\x11\xf4\x49\xcb\xbb\xce\xa0\x54\x25\x28\x19\x4d\xdc\x3f\x5e\x0d\x87\x25\xf3\x2b\x93\xa5\xc4\x0b\xde\x07\xa5\x42\xc1\xe8\x42\x71\x15\x3d\xa2\x6c\xa0\xca\xca\x9e\x65\xc4\xeb\x1a\x0b\xec\x4d\x3a\xad\x80\x1f\x78\x26\xc1\x97\x5f\x20\x5e\x2d\x10\x50\xfc\xb6\xd3\x9f\x03\x6b\xfa\x8e\x7a\x10\x6e\x3e\xa6\x3b\x35\x18\xbf\xe3\xa0\x16\xfb\x8f\x17\x3b\x13\xfc\x55\x7d\x72\x66\x26\x32\x71\x8f\xc1\x69\x03\xe1\xa0\x2d\x1f\x9b\xfc\x95\x21\xf4\xf5\xcb\x13\x29\x18\x70\xf7\xcf\x9b\x0f\x5b\x0a\x1b\xf7\xa6\xbb\x82\xc3\x82\x97\xff\xe7\x19\x13\xed\x33\x52\xa6\xb9\x5d\xa9\xb3\x34\xb4\x8d\x47\x7d\x31\xd9\xb4\xfa\x60\x80\xac\x16\x04\xca\x49\x81\xdb\x19\xc0\xca\xda\x1c\xaa\x00\x91\xe3\x3a\x7c\xcc\xbb\x36\x1f\x97\x35\xad\x15\x7d\xba\xfc\x82\xb0\xe6\xd3\x74\xba\x0b\x0b\xe0\x21\x09\x83\x1d\xcd\x40\xf1\x96\xd0\x0f\xf9\xd8\x31\xa9\x84\xe6\x26\x0f\x53\xfe\xf6\x7e\xfd\xd3\xaf\x84\xd3\xbf\x05\xfc\x1e\x89\x57\x85\xec\x27\xef\xa3\xa0\x99\x73\xc5\xf0\x09\x8a\x03\xe0\xf5\xe2\x14\x9c\x1e\xa1\x38\xb8\x7e\xfb\xb3\xbc\x94\xb8\x77\x99\x67\x2f\x6b\xdc\x02\x82\x70\x7b\xe0\x14\x23\xc4\xb9\x32\x08\xa4\x8e\x8a\xae\x16\x9c\x89\xc4\xa7\xa8\xb8\x6d\xf8\xd1\x3d\x07\x54\x1b\x31\xdb\x36\x5b\x4f\xf8\x9a\x8e\x8b\x25\x7b\xd8\x9d\xe0\x95\x79\x7c\x18\x6c\x2b\xef\xaf\xbd\xf7\x4a\x0b\x89\xc5\xc1\x26\x15\x87\x88\xc4\xb2\x89\x3a\x33\x10\x82\x2a\xaa\x03\x49\xcf\x38\x1a\xf0\x32\x02\x49\xf9\xa3\x41\x19\x88\xff\xf8\xe9\xb4\x6f\x98\xa5\xc0\x4a\xe9\x08\x04\x70\x0c\xe9\x4b\x6c\x78\x31\xab\x03\x61\x80\xe4\x80\x58\x98\xb1\x8e\x67\x2f\x83\x21\x09\x51\xf2\x68\xcc\x2f\x2f\x74\x7e\x2e\xbd\x3e\x11\x9e\xa2\x52\x7d\x23\x3d\xc2\x17\x40\x38\x30\x02\x82\x7e\x51\xdf\xe4\x08\xd7\xb9\xc4\x4a\x91\x22\xaa\x01\x0e\x42\xa9\x38\xc6\x2f\x6c\x81\x38\x48\x7e\x82\x29\xeb\xd3\x4f\x97\x62\xf4\xcd\x19\xf7\xde\x35\x71\x45\x32\x61\xbc\x6f\xf3\x8a\xb6\xcd\x18\x51\xd9\x62\xe7\x3e\x44\x8e\x2d\xf3\x65\xf3\xbc\x54\xb8\x90\xf8\xe6\x32\xbb\x1a\xcd\xaf\x57\xa7\x34\xe9\xeb\x77\x53\x46\xc9\x83\x14\xbd\x9b\x06\x64\x4b\x2c\x5e\x96\x16\x2d\x79\xcd\x06\xcb\x0b\x40\xb4\x06\x74\x91\xd9\xc5\x46\xa7\xdc\x66\x97\x9b\x27\x11\x48\x02\x63\x18\xcd\x75\x27\xe5\xa6\xe2\xe2\xc3\xfe\x3c\x94\xd8\xf8\xc1\xcf\x59\x2a\xd5\xa5\x2e\x94\x5c\xf0\x97\x7e\x92\x9d\x08\x8b\xea\x78\xf1\x8e\x34\x8e\x1d\x89\x7f\xe6\xaf\xe2\xd3\x22\xa5\x90\x74\x86\x57\xb4\xa7\xda\x3e\x15\xa2\x3f\xd5\x2f\xad\xe1\xaa\x4e\x2b\x0a\x6d\x92\x47\x9d\xd0\x0f\xc4\x27\xb6\x68\xe0\x7b\x84\x6e\x7e\xc7\xbc\xf7\x26\x09\xa6\x8b\x83\x92\x18\x28\xc3\x58\x02\x33\xe4\x7b\xb2\x3c\x98\xce\x8a\x1d\x85\xb2\x24\xe9\xc7\xbf\xb8\x8a\x6e\xca\x94\xcb\xfa\xc6\x8b\xd3\x9d\x27\x29\x8f\x07\xa8\xee\x0b\x84\xac\xfe\xf2\x4d\x7b\x94\xbf\x3e\xa9\x78\x8d\xda\xef\x31\x4e\x9f\x36\x9f\x90\xc9\x3b\xfb\x2b\x41\x9f\x8d\xea\x94\x6f\x26\xf4\x8e\x73\xe2\x58\xad\xcc\x29\xe1\x92\xfe\x9d\xf2\xd6\xc7\x1a\xed\xa5\xae\x0b\xd5\x70\xbd\xdb\xdb\x99\x70\xfb\x43\x9a\x36\x71\x5f\xd2\x7c\xc4\x4f\xf2\x52\x07\x97\x28\xcc\x69\x51\xcb\x61\xcf\xd4\x03\x68\x15\x8c\x2a\xaf\x28\x43\x46\xdf\x9a\x95\xea\xc7\x0e\x13\xbf\x40\xbb\x26\x3d\xbf\xe2\xf0\xcc\x29\x83\x10\xb1\xbc\xaa\x9f\x3c\x31\xf5\x08\x42\xa1\xfc\x9a\x0c\x77\x47\x21\x7c\x64\x65\x3b\xf8\x0c\x80\xde\x71\x6e\xc9\x13\x57\x5a\x37\xc3\x30\x1c\xc3\xb8\xbe\xc3\x06\xdc\xbc\xa9\x90\xc1\x10\xdd\x43\x53\x87\x97\x41\xc0\xc5\x01\x95\xe5\xa2\xb3\xc2\xab\xac\x67\x8e\xbc\xaf\x31\x15\xd0\x86\x60\x75\x42\x48\x65\xce\xe6\x73\xc3\x45\x14\x30\x6e\x61\xf1\x0d\xf5\xd2\xfa\x36\xbc\xc4\x57\x65\x67\x24\xd1\xce\xc5\x37\x37\x46\xe6\x67\x7d\x0f\xbe\xa1\x57\xd5\x05\x83\x21\xaa\x26\xbc\xb3\xd7\xd6\xd6\x40\x95\x09\x8d\x5d\x71\x5d\xd3\x37\x70\x2d\x11\xb9\x74\xef\x3c\xa1\xf6\x4c\xe0\x85\xf0\x95\x1e\xe7\xa7\x4c\x95\x99\x9a\xa5\x9e\xc2\x79\x53\x86\x60\xb1\x29\xa3\x4c\x89\x6b\xc1\x7a\xeb\xfc\xbc\xba\x5b\x6a\xad\x44\x62\x8c\x00\x59\x9e\x44\xc1\xd6\x77\x02\x75\x47\x3b\xb4\x78\xf5\x23\x5b\x01\x43\x58\x24\x31\x9d\xb1\x3b\x50\xe3\xe2\x6d\xc8\xa8\xeb\xf0\x55\x4b\xb3\xdd\x56\xf2\x5c\x5a\x24\x5b\xf3\x5b\x12\x1e\x28\xc0\xbd\xc8\xcd\x81\x6b\xd4\x0a\xb5\xcc\x05\x37\x41\x8d\x2f\xff\x9b\x67\x5d\x33\x0d\xc2\xb3\x7b\x2d\x4e\x65\x30\x03\x3e\x6a\xe9\xa0\xd9\x01\x02\x00\x56\xce\xed\x2b\x34\x8e\xef\x82\xa8\xd1\xc5\x08\x5f\x13\xe6\xaa\xb5\x8c\xc0\x94\x83\x92\x86\x72\xf7\xdc\xb7\x6e\x1c\xb9\xce\x2b\x3d\x11\x0e\x4b\xf5\xcb\xdb\xf9\xd5\x3b\x6b\xf8\xf0\xbf\x41\xbb\x02\x3e\x69\x63\xb1\x01\x8f\xe3\x1d\x1d\xdd\xb5\x36\xd5\x15\x68\x0b\x44\xc5\x4e\x2d\xc9\x79\x1b\x08\xfc\x3d\x2e\x8f\x00\x09\x1a\x20\x6e\x3d\x2d\xdd\xce\x41\x0c\xaa\x52\xe2\x23\xf4\x15\x44\xa3\x33\x10\x56\x83\x0d\x06\xfc\x2d\x20\x14\x85\xa1\xed\xac\x23\x93\x09\xde\x8c\x68\x43\xfa\x6f\x19\x0c\x0a\x52\xde\x7c\x52\x91\xec\xb8\xef\x84\xe4\x48\xbe\xf2\xc3\x39\x10\x15\x37\x3f\xa7\xf6\x29\xa8\xd1\x9b\x7e\x99\x69\x76\x75\x6d\xeb\xb8\xe9\xf5\xaf\xf5\xfa\x99\x84\xef\x7c\x73\xff\x65\x37\xf7\xf7\x4d\xd2\xb6\xa6\xbd\xf2\xc3\xd7\xc4\x87\xe9\x4a\x46\x4f\x41\x8f\xff\x38\x50\xd5\xd6\xf7\x62\x99\xc1\xf0\x7c\x9c\x02\x4b\x8a\x48\x01\xcd\x99\x91\x48\xc3\xdf\x25\xf4\xb7\x22\xeb\x73\xbe\x0b\xe0\xc2\x8f\xbe\x85\xaa\x88\x13\x7f\x72\x8b\x62\x7b\xbf\x13\x50\x83\x09\xc4\x27\xa2\xcf\xa8\xaa\xf4\x1f\xd5\xcc\x49\xc1\xf5\xb9\xaf\x55\x7d\x84\xca\x1d\xaa\x7f\xfa\xf7\xb3\xae\xd6\x94\xbf\xa3\xa4\x69\x6a\x10\xf6\x69\xf1\x89\x4a\x99\x3a\x66\xec\x6e\x93\xb9\xb8\x7e\x56\x8d\x8e\x7e\x5e\x0c\x85\x31\x91\x2c\x4e\xd0\xfc\x72\x24\x38\xa8\x0b\xa4\x4c\x99\x47\x8e\x60\xb3\x7e\xa5\x35\x73\xdf\x52\x1e\x27\x92\xc5\xb9\x2e\xc7\xab\x44\x43\x94\xa7\xf4\x58\xae\x47\xbf\xfe\xb1\x0b\x1a\x04\xc8\x02\xd4\x86\xef\x01\x37\x7c\x0f\x47\xdf\xe2\x7e\x6d\xbc\xab\x18\x95\x19\xe3\x27\x3c\xba\xab\x7a\x18\x64\x42\x85\x5c\x14\x17\xaa\x72\xf5\xfc\xe3\x11\x5e\x64\xaf\x8a\x5f\xab\x49\x29\xd1\x83\x6c\xfc\xca\xec\x6a\x3b\x5e\xad\xf0\x2b\xd4\x6a\xe2\xc1\x3a\x97\x4f\xb2\x37\x25\xbe\x88\x02\x38\x0c\x92\x2d\x3e\x84\xb9\xcf\x1d\x21\x2a\x4e\x14\xed\x7d\xc1\x95\xbc\x47\xb9\x2a\x68\xc5\x69\x00\x82\x33\x63\x3d\xd7\x75\xdf\x56\x96\x9b\x57\x75\xdf\xd4\x77\xdf\x04\xec\x52\x55\x6e\x27\x50\x85\x96\xe5\x67\x4f\x5d\x30\xc9\x6a\xc2\x74\x7d\xb7\x73\x8a\x36\x5e\xd5\xb6\x67\x83\xc0\x65\x6c\x48\x1e\x2d\x69\xdc\xfc\xbf\xf4\x3f\x2c\x69\xd8\xe6\xc1\x80\x97\x3e\x8c\x33\x71\x1e\xd2\x23\x6c\x4e\x66\x8a\x5b\xbc\x8d\x78\xc6\x24\x75\x9e\x0d\xec\x58\x45\xb1\xb6\x1f\x88\x41\xf0\xaf\x96\x9a\xf9\xb1\x43\x78\x6c\xc4\x5a\x4c\x1b\x4e\x7a\x09\x45\xc6\x67\x04\xc9\x83\x9d\x1f\x9a\x0b\xd1\x95\xd9\xfd\xbb\xd6\xfa\xc5\x26\x55\x69\xc8\x72\xf5\xe9\xa0\x82\x3d\x5e\x86\x05\x57\xd7\x3f\xce\x5e\xc7\x52\x0b\xe1\x73\x9b\x89\xdb\xa5\x54\xb3\xe8\x71\xb5\x04\x2b\x98\xc8\xaf\x79\x6d\xe7\x75\x9d\x57\x81\x69\xfa\xd2\xa2\xa7\x89\x96\x80\x18\xfe\xbb\xbd\x74\xdf\xd2\x11\x6d\x37\x0e\x49\x84\xbb\xcc\x8e\x90\x48\xfe\x80\xb8\x8b\xfb\xb4\xfe\x68\x4d\x1d\xec\x24\xf1\x5d\x8b\xf3\xa5\xfd\x8d\x45\x3c\x09\x70\x0c\x17\x19\x3e\x61\xa3\xd1\x30\x4b\xef\x77\x6b\x41\x9b\x1d\xeb\xe2\x91\x13\x0c\xc9\xac\xfe\x77\xbc\xf8\xa2\xba\xc2\xd1\x42\xdb\xbf\x1e\x59\x18\x50\x99\xb2\xb5\x16\x4a\x79\x46\x76\x49\xf2\x68\x02\xe5\xa3\x46\x2b\x19\xa0\xb7\xdf\x41\x13\x97\x47\x97\x60\x39\x4f\xcc\xfa\xe7\x74\xf1\xf8\xf2\xd7\x43\x2b\xae\xd1\x0b\xa6\x62\x18\x13\xd3\x72\x5c\x55\x8f\xe4\xaa\x42\x57\xba\x7a\xf1\x1c\x83\x0d\x4f\x48\x0d\xe9\x08\xd1\xea\x03\x24\x74\xde\x7b\x61\x1f\x0d\x82\xce\x79\x6f\x79\x74\xaf\x27\xc2\xd7\x9e\x62\x84\x3e\x2e\x24\x4b\x02\x3f\x10\x1c\x2d\x19\xe8\x7c\x52\x5f\xa9\xf8\xbc\x58\x9c\xad\x46\xb8\xc0\x2e\xa1\x01\x94\x9c\x3e\xde\x1c\x16\xb7\x03\x1f\x68\x27\x27\x13\x8d\x66\x5d\xe6\xed\x5b\xc7\x8b\xb3\xe3\xe9\x1c\x7e\xc6\xe4\xcc\x78\xe0\xa6\x4a\x4b\xab\x2b\xe9\xd1\xb7\x9b\x44\xc5\x8b\x8a\x44\x38\x8c\x9c\xbe\x0d\x28\x6b\x8d\xaa\xec\xb7\x6a\x6d\xfb\xeb\x7e\x88\x51\x3e\xda\x43\xd2\x2f\x39\x80\xd1\x21\x7d\xdf\x47\x07\x90\xda\x40\xfa\x97\xff\x8b\x24\x9d\x2c\xaf\xd1\x9c\xba\x65\x4d\xc7\xf5\x01\x1b\xc3\x0b\xe1\x3d\x13\x1f\xdf\xa7\xcb\x64\xf5\x07\xc5\x7b\xc1\x0b\x95\xbb\x87\x4e\x8c\x1a\x88\x3e\x20\xcf\x24\xe7\xcf\x6d\x5c\x19\x62\xd4\x58\x51\x3e\x75\x1c\x8a\x2c\x02\xeb\xf4\x69\x81\x0c\xe6\x9b\x72\x22\x2b\xf3\xdf\x38\xe3\x1f\x69\xda\x58\x6e\xcc\x28\xa5\x2e\x43\x7e\x45\x38\x66\x59\x32\x48\xc2\x2d\xba\x1e\x60\xfc\x1a\xbc\x9b\xeb\xe1\x35\x5e\xfc\xf0\x11\x8e\x6e\x88\x8e\x1f\x08\x8f\xee\xf9\x9f\xf9\xe1\x2d\x92\x16\x40\x17\xef\xfe\xe8\x29\x67\x1d\xc2\xf0\x61\x78\x39\x6c\x77\xaf\x50\x59\xe8\x5b\x83\x44\x7f\x46\x19\xa9\x17\x25\xcb\x04\x6c\x4f\x2f\xa9\xdb\x3b\x3c\x4e\x88\x16\x45\x58\x6d\x34\x54\xd1\x92\x9e\xcd\x73\xda\x6d\x6d\x1d\x5a\x08\x7b\x39\xac\x7b\x3e\x6a\x20\x5d\x0b\xe5\x91\xf3\xfa\x83\xe1\xf5\x58\xb9\x69\x2d\xb6\x12\x87\x95\x8d\x21\x3e\x25\x21\x5b\x63\xa6\xb6\x22\x6b\x71\x4d\x44\x22\x39\xe7\xad\x01\x5a\xb8\xc1\xd0\xef\x8a\xa2\x8d\xa4\x5b\x34\xb0\x1f\x3c\x27\xd7\xf8\x18\xc9\x03\x21\xb0\xb8\x02\xc0\xaa\x9a\x8b\xf4\x8c\x26\x1d\xb7\xc4\x27\x54\x08\xae\x99\x4b\xb9\x25\xce\xed\xc4\x42\xbc\xcf\xf4\xd7\x9f\x65\x94\x00\x2c\xb1\x7d\xf5\xf0\x86\x0c\x9e\x02\xe5\x27\x99\x7c\x6b\x83\x57\x04\x25\x8b\x97\x55\xdd\xa4\x42\x43\xb4\x5c\x08\xb7\x5f\xd2\x38\x14\x9b\x34\x1c\xf0\x50\x7b\x4a\x75\x4d\xfb\x85\xd4\x5e\x91\xbd\xd3\xaf\x8a\x13\xca\x9f\xe2\xf8\xcf\xb2\x18\x5f\xfe\x19\xe1\x56\x9c\x64\xd9\xf5\x8a\x71\x86\x9f\x14\x08\x47\x88\x96\x27\x2f\x60\xaa\xd8\x22\x38\xcd\xd5\x76\x6c\x94\x57\x74\xa1\x84\x11\x7e\x5e\xb2\x23\xb4\x02\x50\x40\x45\x9f\x7c\x1e\x89\x50\x20\x3a\xbe\x0f\x74\x53\x87\xd3\xee\x8e\x20\xd9\x15\xd6\xab\x5a\x63\x31\x9d\xe9\x73\x6e\x4a\x7e\x34\xd9\x9d\xe5\x41\xf6\x9d\x5b\x6f\xe8\xe8\xee\x90\x61\x95\x4c\xa0\xe4\x81\x4a\x5d\x73\x5c\xc9\x48\x4a\x6f\x7d\xee\xab\x5a\xff\x2c\xf9\x3a\xef\xd9\xd7\x44\x13\x84\x95\xe1\x08\xe1\x23\x06\x66\x28\x27\x69\x54\x1a\x71\x60\x3a\x10\x2d\xa3\x51\x63\xfc\xf4\xee\x66\xd3\xe7\xda\x18\x17\x3b\xff\x34\x1e\xa2\x35\x77\x1e\x82\x9e\xab\x77\x6d\x83\x11\xba\xec\xfa\xdd\xe2\xfa\xc5\x75\xe3\x29\x96\x9e\x76\x1f\xab\x0a\x9d\x35\xaa\xfa\xe3\xfb\xcc\x39\xd0\x15\xdd\x49\x21\xc3\x01\xd9\x3b\xe0\x26\xa0\x1f\x74\x43\xbe\xd9\xe0\x8d\x11\x8d\x6d\xcb\xf4\x60\xc3\x8a\x78\x12\x8b\xeb\x26\x62\x0d\xd9\x23\xe1\x13\xf1\x14\x88\xa0\xda\x98\x96\x55\xd3\x55\xf7\x4d\x79\x37\x9a\xa0\x41\xbd\x34\xf4\xcf\x86\x12\xab\x0c\xa0\xc9\xa0\x40\xe6\xca\x92\x1d\x25\x68\x73\xf2\xb6\x68\x47\x19\x2c\x01\xd5\x16\x5b\xd5\x69\xc3\x30\x5d\x36\xa4\xaf\xec\xa6\x8e\x08\x60\xfb\x3e\x2c\x14\x04\xdb\x8c\x36\xee\x15\x24\x1d\xe4\x4b\x0e\x7e\x9a\xef\xda\x4f\xa6\x9a\x8b\x5e\x04\x4d\xd6\x21\x04\xc1\x0b\xfe\x13\xe4\xc3\x2e\xca\x25\xca\x03\xd1\xc9\x8a\x13\xcd\x70\x1c\x03\x57\x0d\x7f\xe9\x3f\xa0\xef\x4b\x2e\x31\xbd\x9e\xc8\x8e\x91\x64\xfb\x4b\x20\xf2\x4c\x7c\x82\x17\xaf\xaa\xe6\x4e\x46\x37\xb0\xe6\x5b\xdc\x7f\x0d\xf3\x9b\xac\x0b\xa1\x2a\xeb\x4b\x91\xa7\xfe\x29\x17\xc9\xc3\x3a\x90\x52\x0d\xd5\x9b\xbe\x96\x85\xe8\x91\x1d\x08\x69\xa2\xc1\x9d\x1e\xe7\x29\x7b\xc8\x14\x00\xde\x84\x93\x80\xde\x62\xa7\xd1\xc5\x30\x2e\x24\x66\xdc\x0b\xfc\xf7\x66\x06\x01\x5c\x73\x66\x8c\xf8\x0e\x2f\x2f\x61\x26\xc4\x1f\x9f\xca\xfc\x83\x11\xc5\x65\xcf\xbc\x5b\x39\x3e\xc4\xb2\x76\x2e\x6b\x08\x7a\xb9\xb8\x41\xc7\x46\x53\x3b\xc2\x63\x7e\xf9\x31\xbc\x67\x04\x85\xc8\x71\x65\x1b\x4c\x90\xce\x50\x58\x1d\x96\x4d\xc7\x84\x6e\xf0\xce\x7e\x75\xf6\xa3\xd9\x8f\xa8\x48\xa0\xeb\x11\x0d\x26\x3b\x0a\x2f\x4e\xaa\x41\x50\x3c\xe4\xea\x59\x95\xe9\x07\xcc\xaf\xe8\x9a\xde\x52\x9f\xf4\xed\x16\xc7\x64\x4d\xc9\x63\x44\x03\xf8\x90\x71\x04\x76\x85\x05\x95\x1f\x10\x8c\xe8\x12\xb4\xe3\x3c\x5c\x87\xfc\x4b\xf5\x5f\x3e\xae\xeb\xb9\xa1\x76\x89\x57\x15\x49\x8d\x39\x36\x99\xd1\x75\x4e\xa6\xd0\x39\x2b\xf7\xa6\x9c\x8f\x1a\x68\x35\x50\x4d\xf4\x97\x35\x7e\x4d\xf1\xb5\x14\x38\x01\x18\x95\x11\x40\xb5\x7f\xa9\x98\xd9\x1f\xa1\xe1\x80\x55\x47\xce\x9e\x49\x93\xb7\xc0\xf9\xb9\xc5\x77\x41\x96\x53\x3b\x76\x31\xab\x2a\xb8\x15\x6e\x30\x2d\x3b\x7e\x22\x38\x1d\x4c\x80\x55\x8b\x66\x4c\xee\x9a\x93\xb7\x1c\x28\xd9\xf3\x16\x30\x57\x0a\x7d\x0d\x00\x0a\x80\x1c\x19\x1c\x08\x99\x78\x38\x45\x14\x27\xea\x86\x70\xc8\x27\xc1\x87\xd3\xc0\xe5\xdd\xe0\x6c\xfe\x9a\x1b\xe8\x66\xc2\x5a\x74\x45\xb3\x67\xa4\xb2\x8e\xf2\x8a\xe2\x38\x66\x1b\xca\x50\x3c\xe1\xcb\xf7\x28\xc8\x3c\xe1\xd6\xed\x5d\xe0\xda\x79\x55\xb1\x37\xd5\xe5\x0f\x1e\x79\xcb\xa7\x05\x8e\xe0\x97\x0b\xbd\x10\xd2\x42\x01\x4d\xec\x0e\x73\xc6\x8c\x11\x74\x2e\x01\xca\x9a\x27\x3d\xc2\x2d\xd9\x91\x40\xf9\x43\xd3\x30\x81\x15\xd7\xbf\x7e\x88\xfa\x47\x58\xee\xd4\x78\xbe\x92\x47\xec\x73\xe0\xd8\x04\xfa\x40\x7e\x46\xc9\x9f\x86\x66\xc3\xa5\x13\xeb\xba\xce\x43\xfb\x24\xde\x50\x97\xd2\x71\xac\x48\x96\x1d\x03\xf0\xa5\xac\xad\x73\xe6\x7b\xf7\x16\x47\x9f\x58\x35\x1b\x54\x75\xf1\x98\x3d\x1c\x59\x09\x5e\xa6\xec\xa5\xc8\xd1\xd9\xd4\x01\xa0\xd9\x51\x87\xa5\xe5\x97\xb2\x0e\xc8\x4f\xa9\xc3\xd9\x21\xb3\x50\x21\x7d\xd9\x35\xa0\xda\xd0\x22\x0b\x11\xb0\xaa\xa2\xa8\x91\x06\x53\x40\x8b\x2d\x00\x70\x79\xca\x4b\xb6\xd1\x3e\xc1\x1a\x2e\x33\x98\xd8\xc4\xe1\x09\x56\xc7\x96\x5d\x8f\x3e\x2a\xd4\xa4\x5b\x0d\x61\x5e\x1b\x49\xaf\xe4\x82\x4c\xde\x72\xd6\x2f\xb8\xbe\xdf\x42\x81\x3b\x2a\xc8\x55\x6e\x0b\x87\x96\xc2\x1a\x16\x4a\x31\x0d\xd9\xdb\x9b\x4f\xca\x4e\x64\xd6\x6c\x0d\x93\x98\xb2\xd2\x01\x90\x05\x8c\xc0\xb1\x07\xca\x63\x83\x3b\xcd\x7b\x75\x18\x4a\x53\xb4\xe6\xde\x3b\x69\x7e\x07\x59\x7e\x47\x26\xc1\x47\xea\x29\x2d\x20\x04\x67\x36\x92\xdc\xf8\x55\x0f\x55\xc9\xcf\x06\x93\xf2\x93\x87\xca\x13\xfe\x88\x49\x09\x8d\x2e\x47\x00\x06\x3c\x12\x8a\x5a\xf8\x31\xd7\x65\xfe\x81\x88\x52\x61\x80\x1f\x14\x8e\xcb\x9a\x1d\xc8\x56\x6c\x68\x9a\x18\xd2\x9d\xcb\xed\x95\x6b\x9a\x3b\xaf\xe5\x45\xee\x48\x7c\x4d\x1f\x61\x76\xf3\x01\x7a\xa9\x73\xcc\x22\x5c\x45\x4a\x7e\x86\xd4\x54\x53\x86\x91\x6b\x38\x05\xfe\x59\x33\xfe\x9b\x73\x0e\x11\xf2\x71\x42\x01\xec\x33\xe6\x10\x07\xba\xe6\x07\x5e\x20\xa8\x98\x92\x42\x65\x43\x3e\x23\x7a\x97\x22\xc7\x96\xdf\x87\xf6\x5f\x05\xa9\xfe\xfd\xf9\xc7\x34\x82\xce\xd4\xbc\x77\x9e\xa1\x35\x0f\xa2\x2d\x17\xea\x2c\x57\x87\x8d\x32\x38\xa0\xe3\xb0\xa6\x0f\x7b\xb2\x69\xef\xfe\x64\x7a\xcc\x8c\x91\x8f\xec\xcc\xb1\xc4\x67\x78\x9f\xb4\x30\xff\xbc\xc9\x73\x9a\xec\x63\x96\x50\x25\x4f\x11\x48\x7d\xb3\x32\x11\x27\x9c\x1d\x78\x7c\x46\xad\xf6\xe9\x7e\x4e\x40\x86\xe7\x5b\x32\x8f\xf5\xbc\x70\xd0\xc0\x97\x5d\x00\x68\xcd\x50\x69\x42\xe6\xfa\xaf\x39\x64\xa6\xec\xf0\x8e\xc0\x63\x8a\x18\x5d\x8a\x0c\x03\x92\x39\x8b\x0f\x2b\x3c\xc3\xfb\xb0\x91\xf4\x7f\x3c\xa6\xb8\x95\xfc\x50\x21\xd8\xa2\xaf\x4b\x95\xbe\xf1\xc0\x3b\x7a\xa5\xc6\xf8\xe2\x59\x5c\x20\x24\xb0\x07\x48\xa4\x02\x7c\x2b\xdf\xb4\x1c\xc1\x1f\xfd\x92\x6a\x8b\x74\x35\x0e\x10\x1e\x30\x5c\xda\x97\x67\x25\x57\xcf\xbb\xc2\x95\x0b\x59\x2e\x06\x0d\x50\x60\x84\xda\x5f\xe4\x3c\x44\x69\xc9\x54\x45\x00\xf9\x71\xcb\x70\x58\x8f\x30\x41\x97\x04\xd2\x81\x5e\x62\xd0\xb9\x89\x51\x95\x74\xc1\x9c\x9f\xec\x1b\x07\xe9\x7c\x8e\x1a\x9f\x0d\x19\x52\xe7\x3e\x3a\xcf\xd4\xda\xcf\x02\xbf\x29\x41\x9c\x3e\xf9\x23\x14\x29\x1c\x97\xb4\x04\xcb\x2b\x3a\xa3\x49\x7c\x6c\x5f\xe0\x50\x81\xf7\x1f\x83\x7f\x30\x40\xe1\x90\xa3\x4b\x80\xd7\x0a\x6e\xd3\x7b\xa8\x9b\xdc\xe0\x82\x3d\x3e\x5c\x56\x71\x2f\x46\xf4\xbf\x79\xb4\x85\xc8\xbe\x85\xf0\xee\x09\xb0\xd1\x16\xc5\x15\x0d\x78\x99\xf6\x8b\x1a\x8f\x4a\x1b\xf7\x4a\x6b\xf7\x0a\x57\x0e\x73\xca\xfb\x45\xfa\xa7\x29\x93\xc7\x3e\xbf\x61\x5e\x1a\x4a\x57\x4c\x59\x7c\x69\x02\x2f\x5a\x46\xdf\xe8\x12\x8d\x0a\x3a\x4c\x8d\xc4\x2b\x63\xa7\x46\xb7\x7e\xb3\x4c\xd0\x07\x55\x77\x5e\x8d\x2e\xfe\x77\x00\x5a\x66\xb8\x73\xef\xe3\xbc\xfc\xe7\xbb\x1d\xa8\x90\x6d\xd8\xf0\xbc\x7f\x5b\x35\xe0\x22\x15\xed\xd5\x6a\xc0\xaa\x1c\xa6\xe0\x5f\x8b\xaa\xbf\x4f\x11\xb5\x54\xf4\xa7\x52\x2d\x76\x2a\x6d\xef\xd7\xbd\xb0\x44\x3d\x5e\x66\x23\x2e\x79\x53\x62\x56\xfe\x5f\x8e\x88\xa3\x85\x3f\x48\x13\x0d\x49\xf1\x55\xaa\x90\x24\x6c\x5f\x66\xff\x38\x81\xe9\xd1\xb1\xa5\x46\x4f\xd9\x66\x0b\x8d\xf7\x9d\x06\x7b\x0b\x85\x52\xbc\x1c\x82\x70\x58\x55\x64\x7d\xba\x38\x14\x1f\xd2\xb4\x95\xb2\x27\x61\x73\xba\x88\xcf\xa4\xc8\x82\xd6\x90\x39\xb7\x26\xea\x8f\x64\x92\x3d\x95\xbc\xe0\xdc\x54\xe0\x5f\x03\xde\x85\x06\xea\x3a\x49\xa5\x66\x4d\xa5\x65\x4f\x23\x13\xcd\x0a\x9a\x70\xd3\x71\x59\x89\x92\x0c\x67\xb2\xc1\x0e\xa1\xf1\x7d\xd1\x74\x7a\x1c\x70\xa8\x6f\x9f\x88\x41\x09\xc3\x61\x59\x9d\x69\x10\x93\xd0\x9f\xf8\x14\xf8\xc2\xab\xb8\xd6\x23\xbc\x40\x6b\x28\x23\xbb\xb4\x3a\x99\x21\xe5\x37\x1f\x16\x9c\xc0\x40\x8a\xb7\xc0\x30\x73\x8b\x90\xe0\xc6\x65\x8a\x17\x4b\xb8\x67\x51\x55\xcd\x54\x55\x91\x63\x4a\x29\x90\x6e\xa2\x0d\xc6\x1a\xd1\x62\xd1\x1c\xa8\xac\xf9\x6b\x34\x81\x0f\x63\xab\x23\x01\xef\xbb\x4b\x0f\xfe\x33\x5e\x74\x15\x11\x65\xd3\xfd\x3b\x14\x20\x45\xfe\x7d\xcf\xe9\xd8\xf4\x5c\xbd\xb6\x89\xef\xc3\x86\xe7\x3f\xfe\x2f\x60\x6c\xce\x5a\x80\x6b\x56\x59\x9b\xc6\xe9\x0a\x5c\x61\xe1\xb7\x0e\xa4\xf4\x42\x97\xf6\x52\x97\x46\x50\xf3\xf6\xd6\x8b\xea\x48\x41\x2b\x2c\xc2\x6f\x11\x7f\x81\xd8\xd8\xa1\xf8\xaf\xea\xcb\x9e\xf0\x3f\xc7\x13\x06\x43\x02\x54\x1e\xa8\x1b\x16\xdf\xe7\xdd\x42\x00\xe0\x02\x92\x20\xe1\x65\x9a\x7c\x8c\x6d\xb4\x11\x85\x21\x71\x8d\x67\x57\x15\xcb\xa8\x8d\x8f\x18\x1d\x73\x1b\xbc\x73\x2d\xc6\xb8\xc5\xbb\x24\xf3\xcd\x8c\x40\x87\x66\x43\x06\x1f\xf9\x7f\x8f\xdf\xf8\xbf\x21\x09\x03\xd1\xc9\xfd\xcb\xcb\x90\xb3\x0f\x8a\x30\xf8\x35\xaa\x7b\x6e\x04\x25\x04\xa2\x93\xd0\x9f\x75\x16\x7f\xe3\xab\x7c\xd7\x74\x63\xbe\x41\x84\xcd\x66\xa0\xef\x85\x57\x16\xd1\x2a\xf1\x51\x44\x22\xea\x58\x5d\x9f\x7f\xb3\x45\xd2\x40\x65\x4d\x71\xa1\x8f\xdc\x8b\x94\xe6\xe8\xda\x21\x7e\x0e\x2c\x62\xbe\x94\x27\x3d\xbf\x9a\xa4\x7d\x1a\x4e\xc2\x65\x4b\x81\xa8\x0f\x5e\xc8\xe6\xb8\x44\x9f\xf8\xce\x7d\x71\x8b\xb8\x62\x47\xf8\x2d\x92\x8c\x20\x9c\xe3\xfe\x5c\xf2\x63\xab\x3f\x8f\x3a\xd5\x98\x01\x15\x51\x98\x94\xf7\xa0\x89\x2f\x80\x97\xa5\x2e\xad\x97\x76\x25\x3b\x92\x58\xa1\xd6\xbb\xf2\x5a\x22\x18\xba\x19\x74\xba\x87\x7d\x64\xed\xa5\x5e\x95\xa3\xf3\x9e\xc1\x7d\x0e\x84\xa4\x89\x71\x6c\xc8\x1f\x4e\x92\xcb\x7b\x91\x1b\xaa\x4b\x0d\x38\xe5\x7c\x9b\x1d\x08\x2e\x9e\x3b\x51\x05\xc1\x92\x12\x67\x4c\xf4\xbf\x94\x56\x16\xea\x99\x89\x42\x9f\xe2\x59\x9d\x67\x50\xc3\x4a\x71\x0b\x56\x58\x92\x4d\x66\xfb\xb7\x07\x27\xc9\xcf\x3f\x96\xd8\x81\x97\x40\xf8\x1c\x88\x6a\x4a\xe5\xea\x15\x3e\xb2\x68\x0f\xb1\x70\x59\x89\x93\x24\x29\x1e\x50\xea\xc7\xca\xfc\x59\x26\x69\x82\x1e\x3f\xf8\x0b\xba\xec\xd3\xbf\xef\x29\xf9\x3b\x3c\x64\xb3\xf1\xb5\xa6\x81\xa8\x04\x21\x8b\xb3\x4b\xa2\x1f\x26\x6b\x62\x6c\x19\x5e\xc1\xbc\x57\x89\x08\xb0\xe7\xbe\xd7\x1f\x7c\x0e\xa5\x43\x25\x67\x16\x70\x27\x98\x1d\xf4\x43\x11\xab\x5a\x5e\x1f\x86\xad\x1b\x31\x2e\x7d\x62\xb1\x54\x4e\x14\x26\x4e\xd1\x32\xd0\x2d\xa4\x1b\x7a\x01\x0f\x34\xc3\x8b\x37\xb9\x75\x6f\xff\xfa\xf3\x52\x44\x95\x00\x24\x8e\x23\xce\x84\x92\x84\x88\x46\x2a\x50\x5c\x9b\x9f\x16\x5b\xe9\x2f\x1f\x69\x9a\x60\x7a\x42\xa5\x77\x2f\x36\x9f\xf0\x32\x24\x27\x9a\x86\xfb\x44\x61\x8d\xa8\x79\x69\xfd\xee\x6a\x06\xa2\x9e\x4c\x5f\x9d\x3a\xe8\x42\xb0\x91\xe5\x9d\x3d\x47\x36\xa9\x22\xc1\x90\xf8\xb4\xe5\xd1\xb6\xb9\x15\x4c\xf3\x73\x2e\xbb\x17\xea\x9e\xab\x29\xc3\x7e\x9e\xd8\x75\x55\x67\x97\xe8\xfb\x92\xe5\xee\x99\x2e\x00\xfd\x55\x6c\xee\x6a\xfa\x97\x23\xd6\xf4\x7d\xce\xb3\x4b\x2c\xe1\x54\x5c\x3d\x25\x67\x32\xe2\x00\xad\x3b\x0f\xff\xc3\xc1\xbc\x24\xf5\xd0\x7e\xcd\x1d\xc6\x9d\x38\x14\x31\x49\xf6\x69\x41\x4d\x4e\x2b\x78\xd0\x05\x4d\x66\x02\x63\xc2\xfb\xae\x09\xde\x39\x4f\x94\x6c\x1a\x02\x23\x8f\x72\x5a\x88\xbd\x5b\xe5\x91\xce\x9f\x17\x6f\x8e\x38\x1b\x3e\xfc\x26\x10\xb9\x02\x26\x52\x7d\x77\xc4\x68\x62\xe4\x2f\xa9\x24\x68\x01\x85\x86\xfc\xa7\xf1\xbe\x68\xf9\x02\xb3\x8a\x23\x99\xec\x52\x19\x75\x37\x46\x6a\x6d\x8c\x28\x4e\xb1\x31\xe5\xc5\x43\xf4\xa0\x38\x7e\x2a\x07\x6a\xd6\xeb\x85\x1e\x9c\xd4\xe6\xba\xd3\x92\x86\x93\xf2\xea\xa6\xce\x81\xe7\x14\x7d\xca\xfc\x63\x3a\x89\x1d\xb3\x89\xbc\x5f\x49\xe8\x69\x2e\xf9\xb9\xd0\xb1\xc1\x38\x59\x1c\x22\x92\xab\x6d\x41\xd0\xe7\x02\x03\x66\xed\x18\xe6\x0b\x9e\xa8\xf7\xfc\xe6\x5f\xcd\x23\x64\x39\xab\x98\x7c\x9f\xbc\x5f\x89\x4b\x1a\x6e\x17\x42\xb2\xd3\x80\x51\x26\xc7\x45\xc2\xfe\x9c\xd5\xd7\xdc\x7f\xa2\xdb\x35\x95\x4e\x97\x57\xe8\x60\xd2\x19\x0d\xf8\x9f\x58\xa4\xad\x59\x81\x1b\x46\x85\x86\xe9\xd5\x38\xef\x7d\x18\xa2\x34\x31\x0c\xa8\x74\x3b\x6d\x40\x4d\x01\x7d\x62\x48\xc3\x0b\x18\x9d\x83\xee\xa4\xb9\x72\xc3\xa2\x7f\x3e\x6c\x45\xda\xae\xea\x3b\xa2\xfc\x46\xa3\xf5\x65\x03\x5e\x2d\x0d\xe7\x13\x4c\x57\x51\x82\xed\xf0\x1d\x08\x33\x7e\x1e\xa8\x50\x5e\xa7\xdb\x0b\xc1\xf2\x92\xe4\x13\x1d\xbb\xea\x1e\x03\xa0\x9b\x36\xcc\x3d\xaf\x70\xab\xf2\xca\x32\xb8\x3b\xf6\x4d\xa1\x6e\x6f\x91\x8d\x83\x24\x47\xd2\x2b\xd2\x60\xf5\xc7\x32\xb5\x74\x38\xe8\x98\x26\xe6\x48\x26\x00\x76\xc7\x02\x08\xd0\xae\x3f\xa8\xef\xa8\x81\x07\x0f\x3e\x36\xff\xda\xc0\xb5\xae\x6b\xbb\x2c\x9d\x9e\x1d\xd9\x6d\x5a\xcf\xd2\x13\xd5\x31\x92\x3c\xdb\x3a\xe8\xbc\x96\x48\x56\x9c\x25\xc7\xb4\xb1\x8e\x50\x67\xe2\xec\x52\xe5\x1f\x63\xd2\xc6\xa3\x34\xd1\x9a\xd3\xc7\x92\x4d\x51\x54\x4f\x8a\x93\x76\x41\x84\x97\x56\xb8\xe4\xce\x9b\x4a\xd0\xc5\xf5\x67\x5d\xb4\xa1\xca\x1b\x9b\xcf\x33\x98\xa0\xbf\xe5\x05\x95\xd6\xd9\x3d\x59\xdb\xda\x9d\xb6\x1c\x24\x08\x6f\xb0\xb4\x56\x6b\xa6\x50\x6b\x23\xcb\x57\x9f\xa4\x11\x2a\x51\xb6\xc7\x16\x41\x45\x5f\xd7\x71\x79\x9c\x06\x76\xf7\x6d\xff\xb8\xb8\xd7\x8c\x7d\x9e\xb6\xfb\x27\x91\x34\x00\x0f\x28\x94\xe1\xb4\x21\x5d\x15\x48\x79\x8c\x5b\x1e\x59\x7a\x3b\xdc\x3e\xc7\x12\xdb\x51\x65\xc8\x83\xab\x21\xc5\xfe\xc5\xc1\x05\xdc\x3d\x4a\x57\xae\x2b\xb1\x83\x95\xd5\x30\x5f\x26\xc7\x94\x31\x52\x09\x01\x94\x4c\x58\x98\x08\x7d\x65\x33\x30\xd9\x0e\x60\x49\x9d\x57\xec\xc8\xd6\x90\x28\x8c\x7d\xf9\xa7\x13\x93\x40\x29\x45\x43\x82\xc6\x20\x3e\x7a\x60\x59\xb2\x11\x11\x7f\xfe\xfa\xfb\x75\xfa\xce\xca\xfc\x1f\x6a\xc0\xba\x67\x0b\x62\x20\x0d\x7f\x5a\x3d\xbf\x89\x4e\x17\x47\x6c\x16\xba\x00\x7e\x37\x01\x15\x51\x40\x96\xe0\x7b\x33\xc4\xc4\x8c\xd1\xfb\xa0\x9d\x79\xc7\xb0\xdd\x55\xb3\x1a\x4b\x14\x1a\x9d\xc1\x5f\xb2\x1f\x92\xc0\xd9\xdf\xb7\x9e\x55\xe6\x2e\xaa\x15\x5a\xf2\x33\x52\xdd\x7a\xfe\xb1\x8b\x0b\x17\xde\x8f\xb0\x78\x67\xc6\xf1\x32\x1a\x3a\xd6\xbb\xe5\xc9\x9a\x60\x73\xea\xc8\x60\x1e\x03\xad\x31\xd5\x6a\xb7\x74\x0c\x58\x3b\xf7\x56\x94\x21\xa9\x36\x02\xb8\x76\x93\x54\xa8\x3b\x75\xd5\xea\x83\xaa\xad\x77\x61\x43\x4b\xd1\x45\x47\x72\x81\x1d\xd0\x19\xca\xf4\x69\xcb\x16\x63\x55\x96\x2b\x2e\x89\x8b\x3d\x22\xb5\xee\x56\x78\xcf\x7c\xc3\x94\x11\x35\xe8\x76\x50\x57\x90\xe4\xac\x46\x27\x86\x72\xfa\xca\xe0\x18\x21\xef\x80\x52\xb9\x6b\xf9\x24\xe0\x9a\x5c\x2c\x8b\xb2\x05\x39\x22\xff\x63\xbe\xc0\xce\x68\x59\x9d\xf0\x84\xb2\x60\x52\xbb\x77\x32\x8a\xa1\xd4\xf0\x35\x43\x5f\x10\xe4\xb8\xe2\xea\x44\x4b\xe3\x5e\xf0\x42\xd4\xf0\xe1\x53\x6a\x38\xa7\xaa\xcb\x44\x03\xb8\x70\x04\x43\x75\x04\xb5\xc9\x8f\x68\x1b\xda\x2f\x5b\x51\x3d\x00\x04\x1e\x52\x4d\xcb\x1d\x04\xab\xe1\xd1\x6c\x9b\x24\x3c\xb2\x80\x27\xbe\xa9\xdc\x1c\x9d\x33\x8e\x31\x49\x61\x75\xae\xce\x59\x10\x35\xba\xba\x7d\xc5\x12\x3d\x70\x08\xf9\x74\x16\xa0\xb9\x47\xef\x6a\x82\x4f\xe4\x8c\x74\x4e\x27\x0e\xbc\xd0\xc4\x44\x66\x97\x3a\x05\x1b\x21\xf2\x38\x75\x98\x2e\xca\xaa\x7e\x0d\xa9\xeb\x1e\x04\xdb\xda\x61\x70\xc2\x6c\xfc\x0a\x00\xb0\xa2\x60\x11\x46\x0f\xdd\x09\x3a\x89\xb0\x74\xfe\x44\x5c\x66\x28\x33\xe6\xd8\x50\x39\x3c\xf1\xf8\xd6\x71\x27\x4b\xd4\x42\x85\xf5\x56\xc5\x23\xc4\x5c\x2e\xee\xb1\xc2\x5c\x6e\xc5\x32\x73\xa4\xe4\x99\xb1\x6f\xac\xe8\xf4\xcf\x48\xc7\xe4\x89\xdd\x5a\xec\xc7\x7a\xd5\x9c\x86\x43\xe3\xf2\x3f\xdf\x01\x82\xb5\x16\xb6\x63\xf5\x87\x7f\xef\xa4\xe2\x91\xa9\x99\xd1\x53\x46\x45\x36\xdd\x75\x59\xd5\x06\x2b\xa7\x5e\x6c\x6c\xc7\x1a\xf9\xd6\x99\x1a\x0a\x97\x9e\x3d\x8e\xf9\x92\xa8\x88\x70\x5e\xf5\x2e\x2f\x6e\x43\x73\xdf\xcb\xc7\x34\xe0\x3d\xc6\xac\xfc\x66\xae\x30\xa7\x1c\x28\x3f\xd2\x46\x73\x9d\x07\xcb\x8f\x80\x0a\x2b\x42\xc9\x60\xa2\x89\x1b\xdf\xe1\x09\x04\x34\xf3\xbd\xf7\x34\xa2\xbd\x4a\x82\x21\x00\xb1\x48\xe9\x2f\xa6\x91\xd4\x10\x38\xd1\x17\x79\x71\x89\x43\x8e\x51\xa8\x96\x48\x43\xb1\xbd\xa6\x76\x4b\x56\x38\x5e\x7e\x66\xb6\x12\xe7\x1e\x8f\xca\x58\x4f\x81\xa9\x7d\x40\x46\x1b\x63\xf6\x5d\xb7\x4c\xea\xf5\xf2\x0b\xfe\x3c\x8a\xe3\xef\x21\x49\xaf\x1f\x72\x33\x8a\x93\x77\x45\x1a\x94\x47\x98\xc1\x30\xf0\x65\xba\x54\x2d\x56\x72\xa6\xb1\xc8\xed\xd7\xc1\x75\x7b\x9d\x31\x77\xf6\xcf\x18\x68\x20\xa2\x09\x93\x87\xd3\x2b\x9a\xcd\xb0\xb4\x98\x10\x67\xbb\x9c\xef\x61\xc0\x2f\x95\xa2\x64\x7f\x53\xb7\x32\x70\xc5\xc7\x0a\x73\xe5\x00\x70\x1f\x81\x22\xbc\x46\x72\x43\x67\x58\x17\x14\xa3\xaa\xaf\x03\xf5\xcb\x0f\x97\x92\x3e\x10\x3d\x1f\x3e\xef\xd4\x94\x2a\xf6\xa6\xe3\x70\x58\x5b\x79\x02\x57\x31\x92\xfc\x8e\x02\x70\x0a\x13\x0f\x84\x0c\x3a\x30\x0f\xd7\x95\x38\xd1\x7d\x3c\x45\xf7\x02\x44\xf8\x1e\xbf\xed\x40\x88\xf7\x62\x04\xe7\xba\x88\xb9\xc9\xe6\x35\x46\x78\x06\x4c\xda\x66\x04\x91\x53\xb9\xf3\x30\x1a\x0a\xd9\x5b\x60\x45\x54\x4c\x1a\xc7\x68\x6f\xa1\x68\x1d\x45\x47\x5e\xf8\xe4\xb9\x5c\x92\x64\x22\x31\xc4\x10\x9e\x6b\x23\xe2\x80\x13\xbf\xac\x38\x61\xf3\x31\xb0\x6f\x02\xf3\x90\x7f\xd8\xea\x92\xe1\x2f\x44\x6f\x4c\x67\x34\x1c\xbc\x10\x02\x2b\x66\x90\xa9\x35\xee\x3e\xf7\x12\xd7\x3d\xd9\xee\xe9\x35\x02\xe7\x81\xea\xc2\x1f\x3b\x67\x12\x40\x91\xbb\x0c\x1e\xc0\x14\x61\x53\xdb\x0f\xe3\xb2\xa6\xd5\x45\x02\x22\x65\x70\x0b\x44\x54\xf0\xca\xb2\x0c\xb8\x56\x94\xc4\x58\x05\xed\xa5\x16\x39\x90\x25\xcf\x63\x5b\x9a\x74\x0e\x75\x27\x40\x52\xc8\xae\x5e\x9a\x9d\x42\xf6\x49\x58\x4c\xc5\xf7\xd5\xb8\xf9\xe2\xf9\x9f\x87\x12\xb9\x0c\x7c\xa0\xb3\x24\x77\x8f\xb1\x45\x6f\x81\x68\xcf\x0f\x24\xa7\x01\x0e\x38\x8f\x02\x71\x5e\xc4\x0a\x37\xaf\x49\x21\x0e\x1e\xa1\xb7\xf4\x98\x8a\xbd\x7b\xd7\x5a\x81\x83\x00\x4e\x08\xba\x6c\x05\x93\x52\x29\x89\x6f\x36\xe1\x3d\xcf\x77\xd7\xb8\xfb\x68\xa5\x5b\x59\x87\x0d\x26\x30\x95\xab\xff\x15\xfc\xb2\xbf\x5c\xe4\x9b\x1b\xe5\x73\x68\x21\xfe\xbc\xe6\xd2\xbf\x45\x79\x29\x3e\xc3\x5a\x91\xbb\xa9\xc1\xbe\x69\x6f\xf3\xeb\xbd\x0f\xbf\x49\x84\x0c\x32\x03\x72\x3b\x79\xd8\x12\xc4\x81\xe8\x3c\xfa\x9c\x7e\x0f\xff\xfc\xc4\xef\x99\xa9\x0c\xfb\xcd\x47\x6a\x7a\x5c\x2c\x57\x2b\xbb\x3e\x18\xc9\xe0\x43\x86\x54\x53\xfe\x54\xa6\x87\xf7\x44\xe0\xd6\xfe\x3c\x4a\x4e\x3c\x3a\xbd\x21\x83\x4d\x7d\xf0\x1a\xe6\x18\xe0\x48\xb9\xf5\x48\xb6\x7c\x0d\x59\x7f\x7f\x9f\x5d\x45\x60\xb4\x0f\xe1\x36\x6d\x30\xa1\x5f\x15\x25\x3a\xb6\xff\xcc\x58\xd6\xa0\x1b\xa5\xb6\xb1\xa7\xf4\x70\x0b\x0c\xd1\x5d\x6a\x5f\xf3\x83\x62\x21\xb2\xbf\x87\xb6\xd4\x36\xfc\x7e\x3c\x70\xb0\x0b\x22\xf3\x0b\x35\x46\x24\xff\x60\x90\x8e\x97\x11\x19\xe8\xb0\xc1\x7b\x12\x60\x8d\x2d\x31\x01\x4e\x96\x19\x23\xe9\xc0\x83\xfe\xf8\x3e\xec\x17\x1f\x78\x5a\x12\xb6\xc4\x1a\x48\x7b\xd2\x1d\xcb\x22\x81\x5b\x12\x68\xa9\xad\xd8\xec\xd9\xf8\x82\x0f\x54\xf0\xfe\xc4\x1f\x20\xba\x0c\x5f\xf2\x37\x2f\xa8\xaa\xa3\x0a\x16\x7b\xcd\x2d\xdd\x8a\x33\xc9\x91\x82\x7e\xe6\x27\x8c\xff\xc7\xde\x75\xb4\xde\x8f\x73\xe7\xfd\xfb\x29\xc2\x64\x15\x0c\x71\x6f\x33\x24\x60\xdf\xeb\x5e\xaf\xbb\x4d\x36\xee\xbd\x77\x87\xf7\xbb\x87\xfb\x1b\x08\xff\xc9\x2e\xfb\x11\x78\x61\x21\x74\x2c\xe9\x41\xe7\x3c\xb2\xa4\x47\x30\x10\x5f\x09\x9f\x58\x97\x5b\x8a\x0e\xb7\x03\x00\x89\x93\x1a\x15\x01\x79\x03\xb3\x16\xeb\x02\x41\xa7\x47\xbe\x30\x63\x68\x62\x68\xa7\x99\xc1\x46\xe6\x82\xe1\x3b\x15\xbd\x02\x9d\xbb\x8b\xbc\x17\xef\x81\x36\xec\xe5\x6b\x1c\x15\xbd\x89\x90\x13\x8a\x49\x2d\xa9\x52\x59\xb1\xdb\x79\x5e\x66\x5a\x94\x88\xb1\x50\xf1\x24\x42\x33\xf9\xbc\x62\x3a\x49\x81\x42\x5f\xfb\xc7\x6b\xb0\xf5\x96\xbc\x21\x42\xb0\x98\xaf\xbe\x6c\x74\xe6\x20\xa3\x22\x8c\x76\x52\xad\x0d\x00\x43\x01\x29\xa1\xb0\xb0\x7c\xe4\xf1\xaf\xe2\x10\x1b\x80\xc8\xb5\xc1\x13\xca\x2c\x78\x00\xf0\x38\x50\x62\xdd\xf5\xb8\x93\x7d\x6f\x70\x46\x8c\xce\xc1\xe2\x20\x2c\x8e\x36\xc9\x0c\xa0\x97\xd8\xdf\xac\x43\x8c\xf9\x0f\xf1\xa6\x11\x52\x5f\x60\x02\x1f\xe1\x18\x8c\x55\x2b\x0f\x06\x3c\x74\x39\xf6\x31\xbb\x73\x2f\x8c\x01\xa1\x87\x04\x4b\x05\xca\x38\x20\xba\x70\x8f\x0a\xcb\x9c\x82\x67\x1c\x0e\x37\x70\x0a\x48\x1b\x40\xb4\xfb\x39\x1e\x64\x1c\x34\x9a\xa1\x0d\x2b\xde\x7c\xf1\xdf\x66\x6d\x13\x44\xfa\xef\x7b\x81\x32\x47\x53\x5f\xd8\x4e\xae\x86\xf8\x06\x03\x12\x47\x4f\xa0\xa0\x11\x81\x0e\x26\x22\xef\x40\xba\x13\x17\x88\xd4\x45\x75\x0f\xb2\x2f\xa1\x9b\xe3\x7a\x24\x34\x15\xd8\xf3\xf0\xe8\x63\x3a\xde\xa8\xab\xa5\x85\xe6\x08\x21\xf9\xae\xc6\x58\x24\x39\x86\x6a\x42\x40\x47\xef\xb4\xe3\x2d\xd7\x95\x6e\xb5\x18\x09\xa3\xd2\x86\x46\xee\x80\x78\xf6\xbc\xaa\x8c\x96\x1b\x60\x3f\xca\xdb\xfb\x18\x07\x0b\x7e\x52\x89\x0d\x8f\x22\x4f\x8a\x0d\xe5\xf8\xae\x6c\x8d\x3d\x78\x23\xe7\xeb\x1c\x15\x67\xc2\x30\x2e\x38\xab\x59\xbd\x29\x51\x41\xb7\x89\x26\x82\x80\x89\x89\xbc\x3c\xa3\xb0\xaf\xc6\xdb\xb4\x96\xdc\x0a\x33\x33\x38\xfa\x45\x9a\x95\x57\xd3\x1f\x10\xb9\xe4\x85\x5a\xec\xa8\xd7\x9d\xaa\xeb\xf1\x56\x6e\xd2\x17\x11\x0f\x13\x60\x0c\xc8\x02\x8b\xcd\x89\x24\xfa\x22\xdf\x0a\x15\xbb\x9d\x6c\xbd\xd9\x93\xcc\xf7\x00\x21\xa7\x39\x12\x86\x79\x0b\x6d\xb7\x9b\x6e\xfd\xf5\xa3\x4f\x36\x49\xe1\x8c\xd6\x61\x07\x04\xb5\x19\xae\x36\x0c\x60\x67\x6e\xfa\x41\xec\x99\x8f\x66\x47\xed\x99\x0c\x57\x9a\xbc\x6e\xcf\x82\x6f\x20\x5d\x5d\x86\x27\x6e\x2e\x0a\x19\xef\xc6\xa3\x62\x47\x47\x2f\x0d\x5f\x04\xb9\x2b\xfd\xe2\xad\xc4\x1a\xa0\x74\xe4\xe1\x39\xda\x94\x20\x08\x01\x05\xd1\x98\x51\xb8\x12\x54\x9e\x1a\xc2\x1b\x3c\x0c\x91\xb0\x87\x29\x82\xf0\x22\x4f\x14\x3c\xdd\x30\x80\x28\x7f\x48\x64\x80\xd2\xa0\x55\xd3\x58\xe7\x52\x76\x6d\x7f\x28\xd5\x78\xb0\x2f\x4f\x24\xc0\xe3\xaa\x63\x08\xda\x92\x0f\x75\x38\x97\x98\x0f\x8b\x72\x67\xb1\x04\x2d\x11\x4d\xd0\x09\x93\x62\x82\xc4\x80\xfb\x38\x2b\xcd\x0a\x1a\x3a\x9a\x23\xb3\x63\x0f\x6a\x47\x49\x3a\x52\x0c\x24\x50\x15\xe6\x50\xde\x14\xc7\x5b\xa1\x3f\x6e\x1d\xcd\x62\xa0\x18\xd3\xfb\x3e\x84\xfb\x62\x63\x40\x6e\x46\xbe\xdb\xf1\xd5\x13\x58\x9c\xc5\xa5\xab\xaf\x5e\x82\x7a\x05\x72\xb2\x42\x93\x62\xb7\xbb\xaf\xfa\x9e\x43\x8f\x20\x05\x9a\x27\xbe\x22\x31\x58\x87\x40\x80\x5f\xc0\x4a\x50\x2f\xb6\xc6\xc3\x35\x3a\xf2\x17\x44\xda\xd0\xc6\x9a\x62\xd3\x21\x64\xde\x42\x86\x77\x2a\xd3\xac\x94\x5a\x5a\x1d\x2d\x99\x9b\x0f\x6c\xdb\x70\x40\xd9\x2e\x15\xf0\x66\x42\x50\x91\x86\x14\xf5\xee\x3e\x9f\x11\xab\xc4\xf5\x6e\xb5\x39\x21\x80\x62\x7b\xf3\x9c\x72\x4b\x11\xf6\xb3\x47\x6c\xeb\x9f\x90\x67\x4f\xb7\xe1\x45\xd9\x50\x58\x7b\x0f\x96\xdd\xbf\x97\x2b\xd4\x26\xdb\x6a\x1d\x9e\xbe\x37\xbb\x10\x6a\x75\xa0\x82\xea\xc1\x57\x28\xd3\x06\x67\x6c\xc0\xeb\xfa\xb8\x22\x0b\xa4\x6f\xa6\x55\xde\x65\x39\x4a\x2f\x5d\x81\x0a\x58\x91\xf9\x17\x4c\x83\x07\x85\x67\x7b\xcd\xb8\x0f\xef\x39\x2e\x6a\x3e\xd7\x0a\x3b\x8c\x9d\x3a\xdc\x25\x59\xef\x00\xa6\x0b\x97\x3e\x16\x4a\xdc\xc9\xb5\xea\xec\x2d\xc9\xcd\x60\x33\xc4\x06\x60\x3b\x67\x5a\xc7\x39\xee\xbf\x3c\x20\x9f\x53\x43\xb4\x4b\x40\xed\xb2\xbd\x87\x79\xde\x4a\x7d\xef\x86\x0b\x63\x70\x7a\xa7\x9f\x66\x85\x3e\x52\x4f\x7b\x8c\x07\x2c\x35\x81\x66\x8f\xcf\x01\x0b\x8d\xb5\x3f\x2b\xab\xe4\x43\x42\xcf\xa7\xe6\x8c\x84\x5f\x62\xcb\x86\xfb\x19\x9a\xca\x7c\x4d\xd7\x68\x98\xf0\x9c\xd4\x74\x10\x66\xbc\x27\x97\x28\xda\x54\x9c\x8d\x66\x24\x8d\x4e\x18\x1c\x76\x29\xb5\x56\x36\x97\x27\x25\x0d\x73\x28\x90\xc4\x68\x78\xfb\x76\x3b\xb9\x90\xcf\x23\x5b\x62\xa4\x35\xc4\xf7\xb5\x12\x89\x5c\x42\xdc\x94\xd8\x05\x7a\xa5\x01\x4c\x83\xf4\x76\xe2\xb7\x59\xb6\xef\x58\x17\x01\xfb\xd3\xb9\x87\x0d\x00\x13\xed\xa8\x12\xbf\x27\x1b\x81\x51\x69\x61\xa0\xa8\x7e\xa7\x15\xa3\x00\xb9\xd3\xe2\xaa\x55\x42\xa9\x2a\xb0\x65\x72\xa7\x54\xcf\x57\xa1\xb7\x78\x33\xa2\xe4\x6c\x73\x49\x28\xbd\x46\x82\x0d\x45\x66\x7b\x1b\xe4\x5e\x20\xa0\xac\x4a\x93\x98\xee\x01\x44\xca\xef\x06\x03\x12\x73\x10\xb4\x05\xe7\x5b\x8f\xbf\xbe\xfe\xb1\x84\x0a\x23\x96\x71\x0a\x88\x25\xb6\x5f\xa9\xa2\x50\xa1\xc7\x18\x1c\xe7\xc2\xc0\x6e\x05\xbc\x51\x8d\x91\xad\x36\x68\x53\x46\x49\x9a\xe3\x63\xa6\xee\xe5\xdc\xac\xce\x9b\x48\xf4\x6f\xf4\xff\x62\x3c\x42\x52\x81\x57\x8d\x14\xcf\x8a\x14\xe6\xa7\xa6\xf3\x7d\x38\x86\x77\x17\xd3\x7d\xd4\x47\x74\xfe\x78\x33\x94\x1f\xc3\x9e\xf4\xf8\x20\xdb\xb4\xcd\xb3\x67\x48\xd5\xd2\x6e\x55\xea\xd2\x2b\xf5\x38\x39\xd5\x49\xee\x9f\xe7\x4c\xa3\xc8\xf6\x1b\x6b\x2e\x4c\x94\x32\xde\xe5\x4c\xa4\x39\x4c\x44\x7d\xd8\x13\xc0\xee\xde\x40\x10\x23\xfa\x4c\xec\xea\x8c\xbd\xab\x73\xac\xce\x03\xb2\xdb\x4d\x5d\x30\xab\x78\x2f\xd3\x59\xa6\x41\x61\x28\xf0\xb6\xbc\x11\xe1\xcd\x0e\x25\x56\x2b\x74\x23\xf6\x90\x68\xd1\x81\xcb\xb3\x23\x94\x28\xfc\x0c\x1c\x4c\x51\x9a\x84\x84\x08\x65\xa7\xdf\x40\x16\x1c\xd7\x39\xba\xae\xc8\x55\x89\xff\xac\x2d\xcd\xca\xbd\xfd\xf0\x06\xea\xd9\xf6\x8d\xf0\x15\x55\x88\x57\xba\x10\x68\x57\x9c\x9f\xd7\xe8\x67\xc6\x90\x21\x22\x20\x57\x09\x42\x1d\xc3\x25\x60\x19\x58\xec\x50\xaa\x8b\x5c\x25\xd9\xc7\xe1\x8a\xc0\xb1\x10\xe8\xac\x0b\xf9\xe7\xcc\xa8\x7a\x55\xc3\x46\xba\xb5\x9a\x10\x2b\x0f\x20\xf3\xc5\x03\x7a\x72\x3e\xbb\x48\xb8\xa8\xcf\x2b\x60\x42\x33\x45\x55\x1b\xde\x2c\x4e\xf7\x8e\x05\xa2\x72\x93\x6f\xa5\xed\x3b\x5f\xe3\x40\x31\xdc\xa9\x0a\xbd\xca\x98\x35\x51\xf2\xdb\x37\xd7\x9a\x08\xd7\x07\xa0\x65\xdf\xf3\x02\x18\xc6\xdd\x25\x8c\xb2\x57\x40\xd2\x40\xe3\x62\x2a\x47\xf4\xcb\x8c\x16\x13\xb2\x06\xd9\x49\xe8\xdd\x0c\xc7\xb7\x51\xe6\x5a\x03\xd2\x66\xec\x0f\xd3\x16\x6f\xc1\xb7\x9f\x8a\x03\x25\x36\xef\xe1\x2b\xad\x68\x10\x27\x7e\xc8\xec\xc6\x6d\x8c\xce\x4d\x17\xd6\x1c\xc3\x79\x9c\x6a\x2c\x44\x90\xda\x8d\xa2\xcb\xa8\xe5\x30\x87\x07\x50\xaf\x5c\xb9\xb5\x46\x74\x32\xa2\x0a\xdc\x4e\xf6\x5d\x58\x77\x3c\x2f\xf0\xa0\x8c\xbb\xb6\xe7\x85\x6e\xaa\x72\x6f\x89\xd7\x41\x77\x88\x83\x77\xfb\x68\x8f\x36\x69\x85\x48\xef\xe0\x8f\x1e\x6c\x27\x4d\x02\xb6\x28\x37\xb5\x70\x97\x2d\x21\xe2\xce\xdb\xc3\xc9\xf3\x96\xe7\x65\x3e\x17\xb7\x4a\x05\xe9\x8e\xfb\x68\xaa\x7d\x2e\x51\xa4\x5d\x50\x26\x66\x37\x1d\x3c\x17\x28\x0e\xe3\x44\x6b\xe4\x86\x10\x97\xac\xbe\x38\xf7\x75\x1c\x30\xf9\x80\xd2\x93\xa2\x0a\xd2\x2e\xf1\x05\x4d\xaa\x4d\xe8\x7d\x65\x3a\x24\x6f\xf6\x0a\xd3\x7d\xbd\xbd\xcf\x6e\x4b\x6c\x7c\xa1\xd6\x27\x3d\x9e\x38\x7a\x82\x1b\xaf\xfa\x94\xae\x09\x42\xe5\x27\x25\x3c\xba\xd3\x84\x37\x1a\xe6\xba\x20\xe1\xa3\x06\x67\xec\xc7\xe5\xa9\x42\x7c\x6e\x84\x4c\x27\x12\xd6\xb9\xb1\x57\xae\x9d\x3b\x57\xb5\x0d\x84\x9c\x48\x9a\x74\x69\x14\x65\x1d\x5f\x65\x3c\xc7\xfd\x3c\x03\x45\xaf\x7d\xf9\xb5\x6a\x43\xdf\x21\x39\xd6\x92\x72\xaa\x54\x57\x1d\xa8\x96\x1a\xe9\x32\x73\x99\x75\xda\x9f\x3b\x81\xeb\x71\x52\x90\x6d\x89\xe1\x2d\xf6\x97\x68\x87\x88\x58\xff\x5c\xfb\x8b\xc5\x66\xb9\x4e\x06\x04\xa6\x83\x64\x43\xa8\xe0\x2e\x04\x64\xa0\x97\x18\xd9\x60\x92\x1e\xd8\x2b\x4b\x7d\x2e\x75\x3a\x2b\xf0\x60\x1a\x07\xe3\x2c\x86\xf3\xc3\x1c\x09\x2d\x32\xfc\xa6\xd2\x8e\x6e\xeb\x1f\x6f\xee\x40\xb0\x18\x50\x92\x8e\xb5\x7b\xb3\x59\x8a\x48\x6c\xaa\x38\xdc\x19\x0f\x60\xc5\xef\xa6\xf8\xe3\x0a\x80\xcf\x5f\xbe\x5c\x45\x81\xe7\xa5\x95\x76\x38\x6b\xe9\x7c\x2e\xfd\xfd\x79\x34\xd2\x38\x57\xf5\x75\x6f\x53\x82\x33\xe5\xae\x35\x28\xb5\xc9\xcf\x41\x7e\x19\xcd\x86\x90\x79\x61\x06\x33\x1f\xee\xef\x91\x10\x2a\x28\x23\xad\xd5\x33\x87\xa7\x82\xc8\xcc\xb7\x2e\x7b\x18\x99\x96\xd5\xeb\xb5\x68\xfc\xc7\x5b\xd1\xa5\xa3\x41\xbe\x82\xb6\xd6\x79\xdf\x1f\xac\xae\xc7\x5e\xfd\xd2\xb3\x14\x00\x9d\x09\x4e\xd6\x20\xda\xc6\x17\xc3\xbc\xa5\xfa\x47\x6b\xb7\xc6\x76\xa9\x40\x45\xfa\x06\x33\x88\x06\xb8\x95\x5c\x88\xbc\x83\x49\x5a\x68\x72\x0c\xdc\xe9\x9f\x73\x56\x0a\x6a\x9a\xf7\x80\xcd\x4a\xed\x93\xd9\x1e\x90\x34\xa2\x3a\x61\x5f\x55\x90\x53\xd4\xd0\xa6\x16\x07\x44\xe6\xc7\xd7\x13\x03\x47\x43\xce\x3c\xc6\x5e\xda\x9b\x04\x1c\xa7\xc5\x0d\x8b\x33\xa5\x0b\x17\xad\x86\x50\x9b\x3e\x11\xd5\x25\x7b\x8d\xd9\x0b\xc8\xf2\x15\x79\xf7\x68\xbc\xf5\xb0\x78\x26\x16\xc7\x30\xef\xf9\x1b\x47\xa3\x2a\xfc\xd0\x20\x41\xe7\x14\x50\x8f\xf7\xba\x28\x40\x07\x9e\xa5\xc2\x8e\x82\x31\x7c\x7d\x63\x96\x1f\xe9\xf4\x39\xfa\x9e\x61\x18\x46\xab\x1f\x5a\xb5\x21\x19\x03\x0a\x8b\xf3\x78\x33\x00\x91\x46\x84\x71\xaf\xb3\x5c\xcf\x78\x36\x32\x5b\x49\x23\x48\x46\x48\x13\x7f\xd6\x14\xde\x1e\x4d\x90\xb8\x60\x8a\x0d\xa2\xe4\xe6\x07\xd3\x26\xf9\x7a\x22\xd2\xc4\xe9\x9f\xc3\xbb\x5d\x8c\xe7\x7b\x90\x1c\x8a\x97\x62\x02\x63\x26\x2c\x60\x56\x60\x0b\x95\x6d\x9a\x08\x17\xe4\x89\xd6\x17\x59\x0b\x0c\xd0\x4a\xbd\x2e\x21\x35\x47\x14\x5a\x52\xdc\x65\x38\xd1\x8a\x24\x3a\x02\x5a\x63\x1b\xa8\x3f\x4b\xf9\x47\x89\xbd\x4f\xf6\x1e\x97\x9b\x02\x8d\xde\x91\x21\xa0\x30\x45\xf4\x06\x44\x5e\xb0\xca\x78\x7b\x5a\x98\xfd\xa4\xe2\x17\x86\xec\x99\x0a\xd5\xe2\x6a\x09\x7b\x16\x1a\x5f\xa6\x0c\x23\xbd\xc0\x99\xe8\xa7\x3a\x04\xcc\x06\xdb\xf5\xa2\x40\x67\x38\xdd\xb1\x8e\xba\xf4\x6e\xde\xa7\xc9\xf7\x86\x88\x2e\x94\x65\xfa\x91\x1e\xd2\x17\x1b\x29\x1e\xa8\xe8\xce\x25\x93\x64\x59\x0d\x7b\xbd\x19\x1e\x9c\xa0\x73\x53\xa4\xe0\x74\x0b\x36\x0d\x32\x2f\xc0\xbc\x1e\x0d\xd5\x7d\x32\x9b\xd0\x64\xd2\x7b\x30\x36\x9a\xa9\x29\x99\x95\x31\x04\x16\x04\x09\xef\x40\x10\x32\x3b\x02\x2a\x11\xae\xb4\x10\xe5\xdb\xed\xa6\xd9\xef\x21\x7f\x31\x55\xd6\xf5\x78\x4f\xfb\xf3\xe0\x3c\xb4\x2e\xf6\xb9\xa9\x36\xb4\xa9\x36\x7d\xaf\xcc\xe7\x75\xaf\x8b\xfc\x35\x65\x51\x80\xca\xdf\x64\x8d\xc1\xba\xe5\xf6\xf6\x53\x79\xbc\xee\xac\xdf\x98\x46\x40\xfd\x45\x20\x51\x77\xe6\x3f\xb5\x38\xb2\x0c\xf3\x62\x44\x5c\x70\x86\x02\x25\xe8\x7c\x47\x13\x9a\xea\x65\x3b\xec\x0e\x14\x49\x86\x08\xd6\x1f\x32\xc3\xd4\x29\x5f\x66\x62\x5b\xe2\x3e\xea\x63\x64\x5b\x22\x1f\xee\x42\xc4\x78\x0b\x89\xf7\x31\x61\xcf\xf3\x62\x3a\x3f\xc6\x5b\xad\xa1\x4d\xf9\x06\x1e\xb6\xf9\x60\x58\xa8\xaf\x08\x79\xf7\xdd\x14\x6f\x4d\x88\xc0\x8d\x8b\xa8\x5d\x8d\xae\x3e\x99\x09\x7d\x75\x32\xf4\x2a\x62\x80\x02\xe6\x5b\x06\xd0\x39\x5e\x50\x74\x0f\xc7\x7e\x37\xf5\x56\x1f\xf5\x3f\xbf\xff\xea\x10\xe6\xa8\xf3\x3b\x8b\xc2\xb1\xa0\x7b\x6c\xc9\x30\x2c\x87\xcb\x16\xc7\xbb\x59\x10\xc0\xb4\x69\xc7\x42\xd5\xc2\xba\x3f\xcd\xb1\xde\xb8\xb7\x5e\x4a\x02\x0c\xb1\x8c\x78\x15\x79\xd0\xe1\x9f\x56\x7d\x21\x94\xdd\xe2\xc6\x17\xf4\x36\x1a\xc7\xdd\x04\x75\x8d\xf4\x25\x01\x88\xde\x30\xa3\xd0\x39\x9f\x4b\x73\x5c\xe4\x8b\xa2\x35\x89\x6f\xbd\x09\xcf\x4f\xf1\x85\x71\xd1\xc1\xb2\xed\x76\xb3\xdd\x4e\x82\xd5\x47\x82\x35\x4c\x84\x3f\x4c\xdf\xef\xfa\xd6\xc6\x7e\x3e\x82\xb7\x80\x11\x65\x76\x74\xe0\x91\xf9\xf5\xc9\x4d\xa1\xf9\x40\xb1\x8e\xe0\x2b\xa2\x3b\x1f\x44\x73\x38\x44\xaf\x42\x89\x7d\x95\xe5\xf8\xc2\xb0\x5d\xad\x27\xcd\xe1\x32\x00\xcc\x7f\x94\xd8\xe2\xbc\x8b\x08\x7b\xc0\x01\xa7\xb5\x15\x90\x9d\x89\x7d\x11\xae\x36\xf9\x51\xf0\xbb\xd6\xa4\xc7\xeb\xd8\xe7\xb7\x04\x83\x56\x93\x31\xb6\xaa\x90\x6f\x0a\x54\x6f\x6a\xd0\x80\x22\x7d\x68\x10\x3c\x4e\x5c\x7f\xa8\x43\x7d\x52\xd4\xbb\x3f\x76\x57\xe0\xe6\x8f\x74\xa4\xeb\xe1\x79\x11\x14\xe9\xbb\x4c\xc5\x11\x18\x46\xe5\x55\xfe\x08\x57\x2b\x8d\x84\x83\xba\xc7\x32\xb2\x57\xba\xaf\xf2\x67\xdf\x9e\x52\xcf\xb3\xdf\xcd\x71\x93\x7c\x92\x8d\x65\x66\x4c\xe8\xbb\x2e\x7e\xb3\xdd\xac\x67\xe2\xb7\x7f\x9d\x0f\x75\x32\x8c\xc4\x49\x2d\xd7\x7b\x7d\x24\x3e\x04\xfd\x6a\x07\xe3\x4e\x49\xf9\x59\x41\x95\x04\xc6\x55\x7d\x9d\x2b\xcf\xba\x6e\x27\xf5\xfc\x94\x7b\xdd\x8f\xc3\x5b\xdb\x76\x9a\x63\xa1\x26\x82\x99\xb0\x5b\x4f\xcc\x9b\x3f\x17\x40\x65\x1b\xda\xde\x2e\xa4\xff\x09\x57\x68\x73\xbe\x8f\x0b\xeb\x89\xaf\xf9\x55\x0d\xe9\xce\xc7\xe5\x33\xf3\x8d\x71\xfc\x77\x0c\xee\xce\xf2\xbc\x3c\x68\x71\xc1\xea\xa2\xef\x80\x56\xae\xf7\x0d\xe0\xe0\xc0\x1b\x5e\xed\xc6\x49\x03\x1f\xf9\x51\x17\x06\x66\x5e\x7c\xab\xcf\x2c\x24\xd9\x17\x5c\x47\x13\x60\xcb\xaf\xcd\x73\xf9\x77\x49\x81\x83\xa2\x0c\xbe\xeb\x79\x96\xfb\x6d\xfb\xcf\xdd\x2a\x1e\x9b\x05\x1e\x9c\xcd\x2f\x7c\x85\x14\x96\x3d\xa1\x58\xcf\x44\x0b\x12\x1a\x6f\xfe\x3a\xa2\x26\x86\xc8\x4f\x98\x28\x37\xb5\x3f\xeb\xa2\xce\x56\x07\xdf\xe9\x22\x3f\x26\x90\xfa\xbc\xe5\x2b\xd6\xcb\xed\xa4\x4e\xcc\x28\x6e\xeb\xa2\xd8\xe3\xa4\xb9\xff\x73\x83\xe2\xbc\x2c\xd7\x61\xe2\xe0\x8b\x7e\xab\xdf\xd7\x56\x85\x8d\x56\x86\xb3\x10\x11\xde\xd6\x8a\x40\x3d\xea\xae\xbc\xd0\x58\x25\x94\x10\xf9\xb0\xc4\xfe\x34\x4b\xab\xc4\x50\x65\xd2\x60\x27\xf3\xbf\xe9\xe4\x14\x78\x4b\x02\x6c\x60\x34\x06\xc5\x8a\x2b\xfd\x30\xbf\xa4\xd7\xc7\x7a\x6e\x8e\xf9\x4b\x7a\xb7\x9c\x96\x88\xcc\xaf\xe5\x38\x26\x0f\x83\x33\xfd\x4b\x31\xe3\x63\x5d\x6b\xca\xfe\x9a\xf5\x61\xf1\xaa\x60\xcf\xbf\x14\xc3\x59\x51\xb8\xce\x5f\x4d\xb0\x8c\xd8\xf3\x94\xf8\x7f\x6d\xa6\x7f\xdb\xfc\xdb\xe6\xff\xc3\xe6\x01\x15\x0b\x50\x75\x81\xb1\x89\x3d\xd2\xfe\xe4\xda\xae\x67\x58\x0a\xfe\x0a\x25\xe9\x3f\x7e\xfb\xb7\x3f\xfe\xf1\xcf\x7f\xfc\x7b\xb2\xe4\x71\x96\x2e\x7b\x9f\xfc\x67\x57\x03\x5d\xfd\x7b\x92\x17\xe3\x92\xff\x77\x3a\x0e\x5b\x3e\x6c\xbf\xff\x06\xfe\x17\x04\xc5\xd0\x6f\x7f\x4c\x71\x96\xd5\x43\xf9\x3b\xf4\x2f\xf8\x74\xfd\x91\x8e\xdd\xb8\xfc\xfe\xaf\x69\x9a\xfe\xf1\xcf\xff\x09\x00\x00\xff\xff\xaf\xa5\x1a\xb1\x3d\x07\x02\x00")
+var _cmdInternalPagesAssetsStylesContainersCss = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xac\xfd\xd7\x92\xf3\x48\x92\x28\x0c\xde\xcf\x53\xd4\x9e\x63\x6b\xb6\xff\x62\x6a\x02\x5a\x54\xdb\x5e\x80\x04\x41\x68\x80\x84\xc6\xcd\x1a\xb4\xd6\x82\x00\xda\xe6\xdd\xd7\xf0\x89\xac\xea\xee\x9a\x9e\x9e\xf3\x6f\x5a\x65\x7d\x49\x20\x84\x47\x84\x6b\xf7\x70\xfe\xdb\x7f\xe4\x7d\x9f\x37\xe9\xaf\x5b\x39\xaf\x61\x53\x9e\xe1\x52\xf6\xdd\xaf\x4b\xdf\x37\x51\x38\xfd\x35\xeb\xbb\xe5\xd7\xb9\x3c\xd3\xdf\x10\x18\xfe\x7f\xfe\xe7\x3f\x6d\xfc\xcb\x3f\x7d\xfb\x6b\xba\x0f\xfd\xb4\xfc\x5a\x7e\x6f\xf4\xef\xff\x7f\x18\x2a\x09\x97\xf0\xff\xd6\x38\xc5\xd2\x36\xbf\xc6\x7d\x92\xfe\xb5\x0d\xa7\xbc\xec\x7e\x9d\xca\xbc\x58\x7e\xfb\x0f\x24\x6d\xff\xf3\x5f\xec\x79\xc1\xd2\x84\xdd\xb7\xb7\xdf\x77\xeb\x93\x7e\x1b\x24\xea\x9b\xe4\xbf\x19\xa4\xaf\x7f\x8d\xd6\x65\xe9\xbb\xbf\x0e\x61\x92\x94\x5d\xfe\x1b\x3a\xec\xff\x4d\x9f\x65\x2a\xc3\x2e\x6f\xd2\xbf\x0e\xfd\x5c\x5e\x6f\x7e\x0b\xa3\xb9\x6f\xd6\x25\xfd\xcb\x77\xe0\xe1\xbf\x2c\xfd\xf0\x1b\xfc\xdf\x0c\x13\x87\xc3\xf7\xcf\x61\xd4\xa4\x7f\xfd\x94\xc9\x52\x7c\x3b\xe1\xbf\xfc\x84\x04\xfe\xcb\xf7\x2d\xf9\x0d\xfe\x4b\xd4\x4f\x49\x3a\x7d\xfd\xf1\x6b\xdc\x37\x4d\x38\xcc\xe9\x6f\x3f\xff\xf8\x6f\xe6\x9a\xdb\xb0\x69\x7e\x4d\xca\xb0\xe9\xf3\x1f\x53\x11\x30\xfc\xdf\xae\x34\x2a\xf3\xbf\xed\x44\xff\x0b\x9d\xfe\x38\xd7\xbf\xff\xcb\xe3\xff\xe3\x5e\x46\x61\x5c\xe7\x53\xbf\x76\xc9\xb5\xdc\x7e\xfa\xed\x7f\xc7\x48\xc2\x64\xd9\xcf\xcd\x40\x86\xfd\x97\xb9\x6f\xca\xe4\x97\xff\x8d\x85\x04\x45\xe1\x5f\x1b\x47\xff\x8f\x60\xfc\x35\xfa\xd7\xc1\xfc\x35\xca\xff\xfa\x8f\x70\x25\x49\xf2\x97\x7f\x84\xff\x1b\x0e\xfc\xa5\x49\xb3\xe5\x37\xf8\x7f\x02\xce\x52\x2e\x4d\xfa\xaf\x43\xf4\xad\xf9\x9f\x00\x95\xc2\x69\x92\xa5\x7f\xf9\xf1\x09\x86\xe1\xbf\xc4\xeb\x34\xf7\xd3\x6f\x43\x5f\x76\x4b\x3a\xfd\x71\xb7\x7e\x87\x7e\x4a\x9b\x70\x29\xb7\xf4\x2f\x7f\xe0\x3a\xe8\xb0\xfc\xe5\xef\xc9\xea\x2f\x5b\x3a\x2d\x65\x1c\x36\xbf\x86\x4d\x99\x77\xbf\xb5\x65\x92\x34\xff\x13\x2c\xfc\x35\xee\xbb\x25\xed\x96\x7f\x7d\xa1\x3f\x3a\xfc\xc9\x52\xb3\x2c\xfb\x5a\x0d\x3e\xec\x7f\x03\x6c\xd7\x4f\x6d\xd8\xfc\xa5\xdf\xd2\x29\x6b\xfa\xcf\x6f\xe1\xba\xf4\xff\xe3\xd3\xf8\x35\x6e\xfa\xf9\x7f\x7a\x26\xdf\x3b\xfd\x01\xdc\xdf\x96\x29\xec\xe6\x21\x9c\xd2\x6e\xf9\x65\x9d\x9a\xff\xd7\xb7\x06\xff\xdf\xa8\xdf\xff\x23\x2f\xb3\xff\xeb\x97\xae\xff\x75\x4a\x87\x34\x5c\x7e\x99\xe3\xa9\x6f\x9a\x5f\xe2\xf4\xdb\x41\x15\xdf\x57\x82\x10\xc3\xfe\x27\x68\xf6\x9d\xe5\x20\xf0\xb0\x7f\xc3\xb8\xeb\x34\x7f\xf0\x12\xe2\x7f\x46\x06\x3f\xf6\xf7\x97\x32\x9b\xc2\x36\xfd\x1f\x9f\xcb\x8f\x7e\x7f\xe4\x2e\x3f\x21\xa7\xbe\x7d\xf8\x07\xb2\x8d\x9a\x30\xae\xff\xf3\x3f\xe2\x22\x9c\x96\xf9\xd7\xb2\x6b\xca\x2e\xfd\x35\x6a\xfa\xb8\xfe\xeb\x3f\xe2\x63\x52\xce\x43\x13\x1e\xbf\xfd\xda\xf6\xe7\x57\xdb\x7e\xff\x7a\xfe\xc7\xee\xff\xf9\xff\xfe\xe5\x12\x0e\xbf\xfc\xd9\xd0\xff\xfe\xff\xfe\x2d\x2b\xa7\x79\xf9\x35\x2e\xca\x26\x81\xfe\xcb\x76\x7f\xfd\xdb\x81\xbf\xc0\x6c\xd3\x6e\xfd\xe3\x99\x7e\x43\xbe\xdf\xb9\xf2\x37\x36\x15\xc7\xbf\xfc\x6f\x92\x24\x7f\xfc\x2f\x8e\xe3\x9f\x0d\xe6\xe5\x68\xd2\xdf\xbe\xad\xfe\xe7\xa3\x1f\x67\x35\xec\x3f\xc9\x33\x49\xb3\x70\x6d\xbe\x53\xdc\x0f\xec\xfd\x05\xc1\x86\xfd\x17\x76\x2a\xc3\xe6\xdf\xe7\xb0\x9b\x7f\x9d\xd3\xa9\xcc\x7e\x97\x0e\xfd\xba\x5c\x30\xfe\xd6\xf5\x5d\xfa\x47\x42\xf8\x05\xfe\x13\x7c\xb9\x76\x2f\x49\xf7\xdf\x50\x18\x86\xe1\xbf\x59\xd6\x4f\x49\xf8\xc7\xd5\x25\x49\xf2\x0d\x55\x01\x98\xe7\xe6\x3f\xf2\x79\x09\x97\x32\xfe\x8f\xb8\x6f\x41\x9a\x94\x4b\x3f\x81\xef\x7d\x7e\x8d\xf2\xff\x18\xba\xfc\xff\xfa\xe5\x3b\x02\xff\xba\xff\xb2\xf4\xc3\x2f\x17\xef\xfb\x5d\x74\xfd\xd7\x9c\xa8\x29\xe7\xe5\xc7\xde\x7c\x5b\xc2\x8f\x85\xa1\xc3\xfe\xe7\x4b\x83\xff\xb2\xa4\xfb\xf2\x6b\x92\xc6\xfd\xf4\x0d\x2d\xbf\xbf\xfe\x2f\x58\xd2\x3f\xae\xf0\xd7\x7e\x5d\xd2\xe9\x42\x9f\x7f\xff\xb3\xb7\x65\xd7\x7d\x7f\xfb\xd7\xff\xfa\xd8\x7e\x2c\x26\x0c\xc3\xbf\x9f\x76\xe9\x87\x7f\x3e\xe7\x5f\xff\x4e\xaa\xff\x8e\x02\xbf\xc0\xbf\x2f\xf1\x3f\xff\x39\x64\x3f\xc7\xf8\xe5\x57\x64\xd8\xff\x76\x20\xf8\x97\xeb\xd1\xcf\x81\x2e\xcc\xc1\x87\xfd\xef\x49\xe2\xcf\x47\xfd\x26\xac\x7e\x45\xfe\x79\xf3\x69\x69\x7e\xf9\xe7\x0b\xfc\x1a\xe6\x07\x73\xfa\xc6\x73\xff\x0f\x46\xfc\x1d\xb0\x3f\x8e\xf3\x5f\xd3\xef\x7f\xbb\xa8\x7f\xad\xeb\xb4\xfc\x4b\x43\xfe\xdd\xfa\x7e\xfb\x6d\xea\xfb\xe5\xcf\x3a\xfe\xf5\x1b\x57\xf9\xc1\x0b\xe1\x7f\xd2\xf0\x8f\x5b\xf8\xaf\x76\xf9\x03\x50\xff\x6a\x97\x1f\x8a\xe7\xdf\x74\xf8\xce\x68\xfe\x59\xaf\x64\xea\x87\xa4\xff\xfc\x69\xb7\x3f\x6d\x5f\xce\x97\x62\x9b\xfc\x51\x5e\x97\x6d\x98\x7f\xa7\xe5\xff\x47\xd9\x5e\xf6\x43\xd8\x2d\x7f\xe9\x87\x30\x2e\x97\xe3\xb7\xff\xc0\xfe\xf2\x8d\xb9\xff\xe1\x73\x56\x36\x4b\x3a\xfd\x16\x36\x43\x11\xfe\xbf\x7e\x3c\xff\xff\x60\xf0\xff\xf5\x4f\x27\xfc\xe5\x7f\x4e\xf1\xff\xb4\xeb\xd7\x0e\xff\xcf\xbb\xfe\xd8\xe9\xff\x79\xc7\xaf\xcd\xfe\xc1\x67\x30\x0c\xfb\xc3\x8e\xfd\x2d\x0f\x62\x18\xe6\xf7\x77\xff\x8c\xcc\x7e\xce\xf8\xef\xff\x22\x1d\x7c\x9d\xe0\xef\xfc\xf8\x62\x2d\x7f\x00\xe4\x8b\x5b\xfd\xed\xf3\x3f\x3d\x9e\xe2\x52\xc1\xfe\x0f\xce\xe6\xbf\xee\xf7\x0f\x7c\xfa\xe7\x86\xc4\xd9\x2f\xff\x9b\x64\xd2\x1f\xff\xa3\xc2\xec\xbf\x01\x2d\x8c\x2f\x15\xe3\x4f\x67\xef\x87\xb4\xfb\x13\x8d\x33\x8a\xa2\x3f\xda\x27\x5f\x52\x36\xea\x97\xa5\x6f\xbf\x09\xbe\x3f\x9d\x29\xeb\xe3\x75\xfe\x3f\x42\xd1\x7f\xd6\xf3\xbf\xd8\x88\x7e\x0a\xbb\x3c\xfd\xcf\x7f\x82\x98\x7f\xfd\xfd\x00\xbf\xa9\x0b\xbf\xc0\xff\xaa\x34\xfb\x42\xd0\x3f\x2a\xa7\xdf\xe5\x0f\x35\xec\x7f\xd8\x9b\xdf\xfe\xa9\xf6\xf0\xfd\x9f\x1f\x4a\xe5\x77\x0d\xe2\x77\x2d\xf8\x57\x8c\xa6\x87\xfd\x5f\x87\xe9\xa7\x2d\xfc\xdd\x85\xf0\xef\xff\x7d\x93\xff\x83\x63\xf8\x17\x06\xf8\x7b\x21\xfd\xeb\x0f\xa7\xc0\x3f\x87\xf9\x42\x99\x7f\xff\x6f\x5b\xfc\xf2\xaf\x68\x16\xbf\xfe\xb4\x39\xff\x8f\x46\xfb\x07\xf0\xbf\x8f\xf6\x93\xe9\xfc\x14\x7d\x3f\x4d\xef\x2c\xcb\xfe\xfb\x89\xfe\xb4\x41\x91\xc6\xf5\xbf\x8a\xd0\x7f\x3f\x6b\x92\x24\x7f\x33\x6b\xb9\xa4\xed\x5f\xff\xa0\x60\xfe\xf7\xca\xf3\x7f\xa1\x73\xc2\x7f\xa3\x3f\x53\x69\xfb\x8d\x30\xd0\x6f\x56\x55\x51\x2e\xe9\xaf\xf3\x10\xc6\x57\x97\xcf\x14\x0e\xff\x00\xc2\xdf\x7f\xbe\x54\x89\x9f\x34\xf6\x7d\x0d\x54\xda\xfe\x9c\xe1\x07\x5e\xa0\xdf\xbc\x15\x7f\xdc\x84\xae\xff\xb6\x37\x51\xbf\xff\xf2\xf7\x03\xfe\xfb\xdf\x35\x2c\xe3\xbe\xfb\x87\x46\x7f\x3b\x23\x82\xfe\xe3\xf8\x61\x1c\xa7\xcd\x7f\xdd\xef\x07\x60\xf0\xdf\x75\xfc\xb6\xa2\x9f\x56\xf8\xff\x64\xb3\xff\x71\x94\x3f\x15\x7e\xdf\xde\x7c\x03\xed\xdf\xff\x07\x1d\xfe\x0e\xa0\x38\x8e\xff\x0b\x6e\xff\xdf\x8c\x73\x6d\xe5\x5f\xff\xef\xea\x22\xdf\x46\x2a\xca\xbc\x68\xfe\x81\x05\x7d\x7f\x75\x09\xb3\x3f\xf3\x20\x91\x29\x93\xd1\x7f\x27\xd8\xff\xf6\xe1\x77\x5c\x4d\xfa\x65\x49\x93\x7f\x62\x39\xfc\xfa\x5d\x04\x5d\x7a\xff\xd7\xa3\xcb\x2f\x80\xfd\xe9\x61\xfe\xc0\xb3\x7f\xff\xf3\xdd\xf8\x03\x9c\xdf\x59\xf2\x6f\x5f\xcc\xf9\xcb\x29\x41\x0e\xfb\x77\x1f\x17\xf9\x4f\xbc\x13\x97\x82\xfc\xe7\xe6\xd9\x4f\x5f\x05\xf9\x67\xe0\xfd\xbd\x22\xfe\xdf\xc0\xfc\xa7\xcd\xbf\x2d\xe4\x1b\x80\xdf\x80\xf8\x0e\xcf\x1f\x67\xeb\xbf\x7b\x61\xe7\xb4\x49\xe3\x25\x4d\xfe\x85\x09\xff\xdb\x1e\x7f\xb7\x79\xff\x77\x44\x20\x71\x91\xef\x2f\xf0\x7f\xfe\x39\x9d\xfc\xf5\x77\x15\xf0\x2f\x49\x39\xa5\xf1\xb7\xcd\x6f\x96\xe9\x2f\xbf\xaf\xf8\x77\x11\xff\xcf\x4e\xe8\x87\x49\xfd\xfd\x68\xbe\x3d\xfa\x17\x8f\xe3\x3b\x1c\x3f\x64\xc4\x1f\x4e\xfb\x0f\xc3\xfd\x83\x36\xf4\xad\x63\xdb\xa5\x6d\xdf\x95\xf1\xaf\x45\xd9\x2d\x7f\xfd\x7b\x83\x7e\xed\x92\x74\xfa\x07\xc7\xcb\xdf\x76\x9c\xd3\x21\x9c\xc2\xa5\x9f\xfe\xb8\x0d\x7f\xe3\xb7\xfc\x9d\x04\xbe\x01\x78\xd9\xc1\xff\xf6\x6f\xff\x71\x9d\xc1\xaf\x4d\x18\xa5\xcd\x2f\x7f\xfd\xb7\x5f\x7e\xf9\xe5\x97\x7f\x70\x6e\xfe\xdb\x7f\xfe\xdb\x7f\xac\x5d\xf9\xb7\xad\x7e\x4c\x42\x7f\xfb\xf9\xcb\xef\x1d\xbf\x13\x66\xb9\x84\x4d\x19\x7f\xeb\xf9\x5d\x9d\xfc\x35\x1e\xd6\xff\x72\xfc\x3f\x8c\x07\x7f\xfb\xf9\xd6\xb1\xec\xfe\xa1\xeb\xdf\x4d\xfa\x9f\xff\xf6\x1f\x53\xf8\xf9\xf5\x5a\xc1\xfc\xc7\xc1\xb3\xb0\x2d\x9b\xe3\xb7\x5f\xfe\xd7\xbd\x5f\xa7\x32\x9d\x7e\xd1\xd2\xcf\xff\xfa\x3e\xcd\x1f\xc5\xd6\x2f\xc3\x94\xfe\x7a\x49\xae\xef\xd3\xcd\x7d\xf3\xc3\xab\x77\xf9\x2b\xff\x76\x46\xfe\xdb\xcf\xb7\x76\x43\x98\x5f\x06\x5f\x98\xa4\xd3\x2f\x05\xf2\xa3\xd9\xa7\x9f\x92\x6f\x43\xfd\xf6\x4b\x34\xa5\x61\xfd\xeb\xf5\xe0\x5b\xf3\x6f\xf1\x8c\x5f\xa7\xfe\xf3\xa7\x00\xc6\xdf\x01\xfc\x5f\xff\xfe\xcb\xff\x6a\xfb\xae\xff\x06\xd7\xff\xfa\xe3\x6e\x5e\x47\xf7\xcb\x37\x75\xf2\xdb\xc3\x3f\xe0\xd1\x2f\xdf\xd0\xeb\xfb\xe3\xbf\x63\x26\x97\xbb\xe9\xfb\x8b\x1f\xee\xa6\x5f\xbe\x06\xf8\xa3\x16\xf3\x0b\xf6\x77\x4f\xbf\x23\xec\x3f\x3c\xbe\x18\xe6\x3f\x3c\xfc\xc1\x58\xbf\x3f\xff\xcf\x7f\xfb\x8e\x97\x3f\xe2\x60\xbf\xfc\xf5\xbf\x02\xf6\xef\x1b\xfe\x47\xb4\x74\xbf\xfc\xf5\x8f\xe3\x7e\x87\x0c\xff\x31\xea\xbc\x46\x97\x44\x0b\xcb\x4b\x03\x9a\xd3\x70\x8a\x8b\x5f\xfe\xfa\x67\x60\x20\xf0\x8f\x1e\xe9\x96\x76\xcb\xfc\xeb\x77\xf9\xf4\x3f\x6a\xfb\x1f\x3f\x59\xdb\x0f\xcf\xe7\x9f\xc1\x85\xc0\x7f\x06\xd8\x0f\xe7\xe8\xaf\x65\x37\xac\xcb\x7f\xb9\x9c\x6f\x78\xf2\x8d\xbf\xff\x82\xff\x18\xe6\x7f\x37\x7d\xde\xff\x44\x8c\xa6\x0f\x97\x6f\xdc\xe1\x7b\xd3\x1f\x22\xe5\x17\xf4\x9b\xbb\xf8\x1f\xce\x03\xfd\x7a\xfa\x8f\x72\xe9\x77\x96\xf9\x0f\x2d\xbe\x23\xd4\x0f\xd0\xff\xe1\xed\x17\x3f\xfc\xe9\x6c\xff\xfb\x06\xdf\x5d\x16\x17\x07\xff\x5f\x57\xac\xf3\xb7\x6f\x9f\xc1\xd0\xe5\x7f\x89\xc2\x39\x25\xf1\x7f\x2f\x9d\x9b\xfe\xfe\xc0\xf2\x33\xef\x59\x96\x65\x35\xd3\x2e\x1e\x76\xce\xb2\x37\xca\xbe\x3e\x96\x77\x56\x64\x59\xf6\x7e\x43\x5f\x03\xc6\xb2\xac\x6c\xba\x0d\xf7\x42\x6e\xa7\x5d\x27\x77\xf1\xd1\xf0\xaf\xd3\xdf\x75\xcb\x47\x15\x4b\x5c\xd5\x17\xcb\xa6\xda\x98\xd8\x58\xe2\xd7\x20\x76\x8d\x05\x60\xc1\x8b\x71\x5f\x4b\xf5\x99\x83\x64\xce\x5f\x6c\x29\x92\x1f\xf1\xb8\xdd\x82\xbe\xb8\x9b\xec\xf3\xf9\x78\xdc\x24\x76\xf7\x5d\xf1\xde\xf8\x36\xff\x7e\xc6\x26\xef\x3d\xf2\xc5\x96\x4d\x09\x15\xf1\x91\xed\xd7\x67\x72\x2b\xfb\x22\x2c\x11\x27\xd6\x75\x3c\xc3\xe4\x14\xc9\xbc\x69\xa3\x52\x8c\x42\xc0\x4a\x13\xc4\xd9\x01\x95\x20\xce\x0f\xc4\x3e\xde\x62\x5b\xa7\x61\x19\xe6\x2c\x61\xf7\x36\xad\x2f\x79\x56\xc1\x8c\xb8\xab\x2d\x93\xb3\xa2\x63\xea\xec\x43\xbc\x71\xed\x41\x4b\xdd\x2d\xb6\x59\xd6\x60\xb9\xd4\x2b\xa0\x44\x61\x01\x60\x9f\x5b\xf4\x61\xef\xac\xc0\x39\x4a\xf9\x32\x77\x48\x00\x39\x35\xbc\xa4\x26\x66\x45\xe9\xc1\xe2\x77\xd1\x24\x3e\xf3\xad\xe6\x45\xf6\xb8\x13\xf6\x71\x7b\xb1\xc7\x2d\x67\x67\x5e\x3f\x03\xb6\x67\x1b\x96\x65\x23\xa1\xa1\x5f\xa5\xc8\x8e\x39\xcc\x52\xc6\x5b\x32\x72\x9b\x45\xe5\xd6\xc2\x6e\x31\x8b\xca\x62\x3c\xbe\x72\xb6\x7a\x3d\xd8\xd6\x2c\x04\x89\x95\xd8\xf5\x73\xcb\x1d\x9f\x37\x15\x56\x61\x3f\xf9\x3d\x9f\x1e\x6c\xa9\xe4\x16\x3b\xe5\x4f\xd6\x8d\xfc\xe3\xfd\x91\xd9\xed\x65\xb3\x49\x4f\xf8\x35\xcb\xb0\x3e\x7b\x67\x5b\x5b\x9c\x4f\x96\xcd\x75\x56\x64\x5f\x3b\x6e\x9d\x2f\x35\xb7\x58\x3d\x57\x35\x19\xe0\x72\x93\xc5\xa2\x8b\x9b\xec\x83\xfd\xa8\xab\x73\x06\x89\x02\x57\xaa\xbd\x4a\xde\xeb\xd9\x65\x07\xad\x73\xa5\x98\x2a\xf7\x48\xe1\xef\xfe\x3b\x1e\x1e\x4f\x5f\x22\xee\x72\xdc\x10\x73\xf5\x80\xab\x8e\x85\x4f\x9d\x63\x59\xf6\xb9\x75\xef\x4f\x86\xe3\x06\xc1\x75\xc4\x6a\x15\x44\x1b\x75\x91\x0e\xe0\x3d\x54\xa0\x10\xdc\x36\xb1\xc2\x0b\x3a\x01\xd0\x26\x7e\xee\x37\x96\x7d\x59\x33\xd8\xd0\x06\x34\x44\xe2\x73\xb1\x1a\xdf\x66\xc1\x05\x21\xd9\x44\xac\x36\xb8\xec\xb3\xca\x20\x07\x3e\xd1\x4f\x9b\xbb\xf9\xf4\x4a\xb7\x56\x49\xad\x8a\x7e\xdd\xc8\xb4\x77\x38\xd1\xb8\xc1\xf1\x3d\x9f\x15\xca\x74\xca\x3e\x82\x3f\x38\x3e\x43\xe0\x34\x8a\x08\xba\x2b\xd9\x16\xd1\xaf\x1b\x05\xa1\xd8\xf4\x61\x85\x67\xf8\x92\xda\xe5\x23\xeb\x1c\xf0\xf8\x22\xea\xc2\xd5\xee\xa9\xee\xf8\xbc\xef\xaa\x4b\x61\xc4\x61\x40\x3b\xee\x00\xe0\xe3\x03\x9c\x8a\xf0\xec\x7c\x66\xde\x9f\x76\x57\x6c\x71\xfb\x2e\x69\x3b\xd6\x12\xf6\xc0\x3f\xc4\xb8\x75\xf0\x42\x19\xb2\x53\xd9\x05\x22\x62\xee\x81\x86\x71\xaf\xfb\x5c\xd4\x80\xb5\x26\x5f\x58\xe2\x65\xfe\x08\xd4\xca\xbe\x64\x85\x6b\x2a\x33\xc9\x6f\x6c\xb6\xa9\x4b\x75\xeb\x95\xfa\xc5\xbe\x82\xf3\x75\xa4\x59\xcc\xb2\x92\x0e\x20\x9f\x61\xe5\xcf\x83\x3d\x83\xb4\xc6\x3f\x2c\xbb\xe9\xb9\xef\xc9\xa5\xcd\xc3\xe7\xf3\xce\xb2\xec\x5b\xbe\xdf\xe4\xe9\x76\xbb\x7d\xce\x1b\x2f\x9f\x2c\x37\x9c\x37\x21\x3a\x59\xfe\x23\x15\xb7\xfa\xcd\x72\x77\x8b\x35\x6e\xb7\xfb\x6d\x35\x59\x81\x1d\x8b\xa7\x14\xdc\x9e\x8e\xc5\xea\x4b\xfe\x58\x22\x96\x7b\x8e\xec\xbb\x5d\x1f\xaf\x45\x7d\xec\x3a\x8b\x75\xf8\x4d\x8a\xf3\x74\xf9\xf0\x9f\x20\x7f\x4a\xd4\xfd\xa9\x1c\xcf\xfb\xeb\x7d\x3b\xd8\xdc\xf2\xcd\x07\x59\xde\xf8\xb2\x5a\xf2\xf2\xcc\x73\xaf\xf5\xf1\x87\xd8\xf2\xa2\x26\xc9\xec\x70\xe7\x86\xf2\xf6\x7e\x8b\xb3\x77\x21\xe1\x7d\xb4\xa5\xb1\x76\x10\x51\x04\xe2\x12\x17\x2f\xa2\x72\x76\x16\x1a\xb8\xe8\xb8\xb3\x47\xb9\x1d\xf2\xf6\x88\xf7\x46\x7c\x1e\xf6\xcb\xb0\xd9\xce\x61\xd7\x30\xcf\x9e\xfd\xf4\xec\x05\xe3\x9d\x70\x2f\xc3\xf3\xef\x6e\xdf\x6e\xef\xe7\xf4\x4a\x0d\xff\x8e\xf6\x63\xf6\x36\xa1\x02\x49\xd8\x1b\x53\xf6\x44\x95\x6b\x8f\x9d\x6b\x43\x15\x75\xf9\xb8\x12\x13\xde\x17\x1b\xff\x59\x46\x02\xdf\x1a\x8d\xcb\x39\x6e\x75\x3a\x95\xe0\xe4\x58\x52\xd8\xd1\x47\x22\x0a\xea\x93\xd7\x6c\xbf\xb2\x0f\xe8\x23\x7a\xfd\xeb\xf5\x69\x8f\x7b\x2d\xdd\x04\xf7\xb1\xfb\x2f\x32\x6f\xa8\xbb\x0e\x3f\xa6\x5e\x74\x3e\x2d\x57\x70\xc2\x5d\x3a\x64\x6b\x7c\x40\xb0\xe4\xf5\x26\x14\xbf\x71\xbf\x1a\xf7\x9a\x2f\x9e\xd3\x3d\x5a\xc5\x37\x5e\x74\xa5\x93\x4a\x1f\xdc\xf2\x08\x4d\x94\x58\x4a\xaa\xd7\xb7\x7e\x2f\x1f\x4d\xcd\x49\xd2\x1d\x6e\x26\x53\x5b\x44\x25\x7c\x5b\x03\x47\x99\x8f\x67\x1b\x5b\xf7\x68\xaa\x9f\x98\x84\xad\x2f\x71\xee\xef\xb3\x28\xa5\xef\x85\x60\x8d\xfa\x01\x99\x10\xdd\x71\x84\x12\xdb\xfa\xec\x8a\x78\xfd\x91\xfa\xf2\x6d\x0e\xd2\xcb\x34\x2b\xa7\x31\x20\xfb\x31\x04\xf4\xf1\x1a\xda\x7c\x6c\x04\x6d\x0c\x28\xfb\x2e\x8f\xa2\x3e\x30\xa1\xe9\xa2\x79\xd2\xb0\x9b\x8d\xa7\xc3\xe3\x7c\x23\x6d\xe9\x69\xed\x1e\x70\x07\xf9\x5a\x7a\xc6\x7b\xb5\x6d\xd3\x85\x59\xd2\x2b\x50\x03\xaf\x79\x97\xe4\x3b\xc1\x37\xcc\xdd\x83\xdb\xad\xbf\x41\x5d\x47\xfa\x06\x9c\x7c\x3e\x5a\x91\xa3\xdc\x0e\x17\xa5\x7c\x6b\xa6\xa7\x37\x07\xc3\x5e\x62\x4f\x13\x0a\x2c\xd9\xe7\x61\x22\x0d\x0e\xfe\xf5\x41\x4e\x31\x6a\xdd\xd4\xe7\x88\x62\x73\x9c\x7c\x64\xca\x81\xe6\x03\x41\x7e\x0c\x36\xd9\x38\xa1\x93\x0d\x5e\x37\x36\x6f\x27\x46\x6c\x80\x78\xed\x78\x47\xcc\xc9\x79\x41\x93\xd7\x04\xcc\x50\x07\xce\x8c\x70\xd5\xf8\xea\x6c\x7e\xdc\x9c\xf1\x8d\x38\x2b\x59\xa3\xd3\x4c\x98\x09\x22\x1b\xb6\xca\xbc\x3d\x52\x87\x3c\x80\xde\xa1\x56\xd5\xe6\x3e\xe4\xdf\xe3\xfd\x69\xca\x9a\xed\x53\xd9\xf3\xe9\xc3\x05\x3f\xfb\x47\x90\xf1\xfe\x6b\x09\x0e\x27\x37\x26\xe5\xb9\xfa\x96\x2b\x45\xf3\xd6\xcd\x96\xe0\xf3\xd0\xbe\xa0\x0a\xb6\xa8\x36\xac\x9d\x63\x1b\xce\xc1\xc0\xb7\x5d\x1a\xec\xe7\xeb\xa1\xef\x93\x90\xd3\x5c\x17\x74\x8f\x49\x17\x63\x5c\x3b\xbb\x79\x61\x0f\x18\x28\x91\xe0\x86\x23\xd2\x4e\x1e\xb9\x68\xcc\x40\xa2\x13\x15\xfa\x2b\x72\x50\xef\x68\x21\x99\x69\x4b\xbc\x7b\xf2\x92\xe0\xb9\xcb\x6c\x32\xb2\x26\x2c\x79\xb5\xa4\x36\x0d\xef\xb5\x77\x49\x14\xf3\x0c\xb2\xa2\x2c\x1f\x5a\x08\xf2\xd5\x21\xc7\x66\xeb\x64\x82\xbd\xdf\xc0\x59\x18\xd9\x40\x78\x68\xda\x90\x15\xbc\x85\x33\xfe\x68\x6c\x8e\xd6\xb7\x66\x56\xad\xa2\xa2\xd5\xe3\xe5\x43\xc5\xd4\x3e\x5b\x1c\xce\xee\x0f\x3c\xcc\xdf\x07\x5a\x92\x75\xfc\xee\x7b\x86\x97\xfa\x23\xc5\xa5\x95\x14\x02\x48\x45\xd2\x5b\x0c\x05\xe3\xbe\x09\x4f\x79\xd6\x34\xa2\xc1\x9e\x33\xed\xbb\xd2\xa0\x2e\x6b\xbd\xcb\x16\x4f\x28\xf8\x70\x06\xef\xa9\x6d\x43\x12\x8e\x2c\x2b\x51\x4f\xf5\xb8\x9f\xf1\xa4\x95\xf3\x91\x1e\xba\x9e\xb8\x71\x48\x10\xc5\xb6\xb4\x34\x0a\x0e\xad\x08\x1d\xbe\x53\x1d\xb9\x3c\x43\x65\x27\xa2\xb0\xe9\x4e\xe5\x16\x04\x5a\xb3\xa5\x73\xcb\xfb\x90\xd3\x35\x2e\xe3\x44\xde\xf4\xe8\x5c\x4f\x9f\x67\x12\xc5\x5c\x07\xdd\x5f\x4f\x24\x8d\x38\x69\x26\x5b\x7a\x45\x47\x19\x8d\xa6\xf8\x8d\xb6\x28\x96\xf1\xba\x68\x57\x47\x67\xca\x99\x63\x45\x4b\xbf\x53\xc6\x39\xdf\xd7\x23\xa4\xd8\x68\xd1\xb3\xa0\x60\x4a\x0f\x8d\xb2\xc8\x10\xe2\x4f\xfc\x28\x30\x57\xc7\x5e\x54\x26\x48\x70\x12\xef\x68\x24\xdc\x69\x92\xb4\x0a\x4d\xd5\x87\x5d\xee\x38\xd2\x49\xba\x27\x9a\x16\x31\x81\x9d\xda\x3b\x6d\x4d\x82\x53\x1e\x43\x82\x36\x7b\x62\x45\x61\x2a\x0f\x28\x99\xa5\xf3\xea\x10\xef\x69\x5d\x17\x19\x81\x81\x5b\xb5\xd4\x1a\x61\x32\x16\x44\x89\x95\x9c\x1c\xc6\x67\xe1\x46\x59\x26\x19\x3d\xe9\x26\xf9\x9c\xd8\xf6\xca\xac\x0f\x83\x54\x65\x1c\x9f\x55\x17\xa6\xd1\x40\x2b\x67\xad\xc7\x9b\xd7\x05\xa9\xeb\x50\xd4\xf6\x26\x8c\x99\xf8\xb4\xdd\xe2\x7f\xa2\xb8\x8a\x76\x7e\xcb\xb7\x78\xa1\x7c\xae\x87\x0c\xd7\x23\xcf\xb5\xe6\x8c\xd9\x87\xd8\x81\x21\x3b\x3c\x85\x86\x09\x2f\xb1\x13\x85\x44\x8c\x72\xb3\xa1\x81\x6e\xe0\x48\xa1\x73\x23\x0f\x80\x1a\x90\x02\x8a\x34\x13\x5d\xda\xc6\x79\x9f\x15\x6e\x6c\x6a\xdf\x36\xff\x11\xe6\x91\xca\x85\x1f\x35\xbb\x49\xf5\x63\x78\xc5\xc8\xcd\x87\xf9\xf5\xe3\x6f\x1c\x87\x3b\xaf\xfc\x23\x71\x4e\x9d\xc5\x83\xb6\x7d\x6e\xd9\xf1\x04\x4d\xa5\x32\x7b\xa7\x48\xd3\x9b\x8f\x7b\xb1\x7b\x3b\xe1\x13\xf4\x9f\xf3\x1e\xa8\xc2\xe2\xbf\xab\x3e\x32\xee\x1a\xfd\xca\x5a\xa8\x85\xec\x73\x38\x15\x5f\x2c\xf5\x9b\x10\x89\x25\x2e\xa0\x75\xd0\xbe\x33\x22\x7b\xbf\x06\xb9\x92\x47\x65\x1d\xde\x0b\xaf\xc1\xb1\x67\x61\x8a\x16\xce\x04\xdf\x51\x01\x85\xea\xb4\x0f\x0d\x95\x52\x53\x28\x3a\xa2\x71\x17\x74\xd3\x73\x98\xed\x76\xaf\x28\x69\xc5\x73\xa5\x1c\x57\x91\xc7\x0b\x50\xf0\x74\x10\x9b\xc9\x4d\xad\xef\x29\x2a\x11\xaa\xe5\xef\x1c\x2a\xe1\x98\x7a\x27\xd0\x23\x54\x89\xb3\x6e\x1b\xd3\x4d\x9e\xfa\x4a\x24\x47\xb9\xbe\xb3\x96\x8c\x29\xa5\xeb\x62\xda\xb5\x9c\xa0\x16\x3e\x78\x11\xe4\x66\x43\x89\x80\xf0\xd5\xfc\xd5\xb0\xdb\xf3\x01\x9a\x01\x25\x34\xe1\x6d\x1c\x85\xb4\xf2\x16\xec\x42\x7d\x8f\x96\x76\xf4\xc4\xd6\x47\x4f\xd7\x6b\x98\x38\x83\x87\xea\x1a\x4c\x65\xb6\x41\x86\x62\x60\xbc\x3b\xef\xa9\x7e\x1a\xc3\x2e\x17\xa2\x7e\xb7\xe6\x6a\x1a\xa7\xe5\x04\x0f\x64\x21\xe7\x93\x69\x76\xd4\x84\x89\x46\x3a\xec\x4e\xd6\x4c\xce\x6d\xdd\xe5\xbe\x79\xaa\x12\xf7\x0b\xcc\xb4\xf2\xb1\x5a\x5c\xfa\x26\x88\x16\x93\x9f\x84\x2e\x1c\x5b\x56\x8b\xa0\x39\x69\x66\x76\x22\xd1\x51\xe1\x50\x87\xed\x91\x78\x71\x45\xa0\x2a\x64\x3e\xa5\xfc\xec\xbb\x58\x89\x9b\x0a\x22\xba\xcb\xeb\xc4\xd0\x08\x22\xb7\xba\xba\xe3\xa2\x9b\x29\xe8\x6b\xe6\xef\xf8\xac\x18\xfe\x2a\x14\x01\x9d\xb2\xe5\x06\xbb\x14\x5f\xea\x8a\xbd\x27\x98\x52\xf1\x89\x3d\x0d\x75\xfa\xec\x3e\x70\x87\x29\xa1\xba\x1e\xcd\x86\xbc\xf0\xa6\x30\x9d\x4c\x1a\xd3\xe4\x3c\xaa\x46\xe6\xe0\xd1\x6d\x5e\xc7\x7a\x77\x4e\xd3\x19\x03\x4b\x3a\x50\x72\x65\xf8\x91\xf0\x77\xa7\x68\xdc\xb7\x43\xa1\xa3\x87\x38\x8b\x9b\x1d\xbd\xd7\x38\xd0\x1c\xcb\x47\x3f\x6d\xe5\xe7\x3d\x55\x2d\xde\x24\xba\xc7\x38\x34\xe3\x35\x0b\xba\x4c\xa9\x39\x65\x32\x95\x2e\x22\x49\x5a\x53\x34\x9e\x98\x1b\x41\x9b\x39\x21\xe3\x2b\xf1\x5e\xc5\xc6\x6a\xd3\x7b\xdb\xab\xd4\x8e\x3c\x14\x91\xb1\xce\x2c\xd2\x76\x42\xaa\x19\x85\x2f\xee\xc0\x9e\x4e\xa1\xa5\x48\x74\x2a\xe1\x8c\x02\xb9\xd0\x46\x03\x4d\x07\x04\x78\xf1\xe2\x82\x10\x9d\x16\x8a\x0a\xa4\x65\x72\x37\x06\xc1\x52\xa0\xea\xf9\x0e\x3b\x3a\x43\x6d\x8d\x7e\xd0\x8b\xb9\xe0\x0e\xba\x32\x6f\x0f\x43\xa2\x45\x59\xf3\x05\x35\x9e\xad\x18\xad\x13\x68\xb3\x05\xc5\xdf\x58\x62\xec\x01\x69\xa4\xa4\x43\xdd\x40\xc0\x53\xd0\x32\x26\x4f\x66\xc6\x64\x08\x6c\x94\x28\x45\x8e\x88\x56\x6f\x42\xd4\x3b\xae\x69\xf1\xdb\x86\x0e\xdb\xd2\x76\x29\x12\xf3\xfd\xc8\xcd\xd2\x8d\x88\x1f\x1d\x46\x06\x74\x0a\x69\x2f\x52\x16\xf4\x0f\x73\x72\x67\x02\x05\x19\x95\x21\x77\x7d\x11\x53\x73\x33\xf5\x89\x41\xa6\x3b\x5a\x45\x21\xcb\xf4\x11\xb6\x91\xe2\x51\x75\x6f\x24\x15\xec\x14\x5a\x00\x03\x51\xa7\x50\xf9\x98\x27\x68\x3e\xe3\x75\xe3\x3d\x91\x39\x6c\x87\x52\xf3\x5e\x43\x7a\x17\xc8\x69\xda\xd9\x38\x30\x12\x33\xc0\x84\x0e\xb3\x8c\x23\x08\x11\xe4\xed\x45\x48\xf7\xa2\xa1\xd4\xd8\x13\x98\xaa\x0f\xcc\x03\x99\x44\x33\x54\x57\x21\xd8\x67\x13\x38\x58\xdc\x92\x12\x3d\x5c\xcc\x24\x61\x66\xaa\x10\x21\xeb\x38\x06\xb7\xcb\xcd\x45\xc1\x14\x64\x99\x42\x39\x31\x3d\x75\x16\x6c\xa5\x33\x05\xa7\xc0\xca\xc0\x30\x7e\x34\xe7\x44\x40\xe5\x29\x0d\x9a\xa5\x11\xb6\xed\xeb\x27\x5a\x50\xbc\xc5\xde\x07\x2e\x45\x13\x02\x09\x11\x4a\xbd\x13\xec\xbe\x12\x2a\xc6\x23\x64\x85\x95\x1b\x50\xa8\x10\xa4\xbd\x00\xe5\x60\xcc\x28\x0b\x9a\x5d\x8f\x8d\x52\x0e\xf7\xd5\x5b\x7e\xd2\x5a\xc0\x41\xb4\x7e\xf7\xd3\x14\x0c\x16\x80\xf1\xac\x80\xeb\x4c\x7d\x33\x76\xf5\x2e\x75\xad\x22\xe0\x4c\xd8\x67\xed\x39\x61\x47\xa0\x92\x16\x1e\xa6\xc7\xb0\x1b\xc6\xd1\x11\x2e\xc0\xba\x29\x26\xb7\x0e\x03\x58\x09\xc1\x9b\xe1\x1a\xa7\x4f\xb3\x1b\x51\xd2\x10\x98\xba\x76\xa0\x31\x65\x1b\xc9\x76\xd3\xe7\xb8\xda\x77\x88\x3e\xbb\x9a\xa1\xc3\x88\x9e\x62\x2a\xca\x52\x08\xa7\xa8\x29\xdb\x29\x38\x6b\xcd\x8a\x7e\x19\xc4\x8b\x66\x04\xd2\xa7\xd3\x0e\x7e\x00\xcc\x58\x13\x1a\x6c\x9f\x8d\xc1\xad\x13\x82\x81\x24\x06\x34\x7b\xa9\xf8\x7e\xbd\x08\x6f\x96\x03\xac\x41\xb3\x80\xbc\x33\x03\xa1\x5e\xcf\xa5\xf8\x51\x04\xf1\x87\x65\x95\x52\x65\xd9\x3b\x5e\xb1\x5e\xd9\x18\x98\x7f\xbd\x13\x92\x47\xa3\xbd\x5f\x59\x7a\xcb\x5f\xa6\x58\x19\x15\xbb\x5e\x96\x22\x9b\xb3\x8f\xe6\xf1\x72\xde\x38\xba\x9e\x49\x82\xde\x22\x04\xeb\xb0\xd8\x15\xdf\xe2\xe3\x61\x4a\x4a\x85\x0d\xe5\x5b\x8f\xfc\xcb\x59\xe3\x05\xcd\xfa\x5a\xd2\xb8\xaf\xe6\x00\xad\xdd\x18\xd5\xe1\x23\x58\x70\xea\xbd\xc1\xd8\xbb\xd6\xe7\x36\x1a\xda\xe6\x89\x53\x48\xe3\xd2\x5a\x88\x4c\x6c\x5b\xc0\x6f\x59\xb8\x8b\x0d\x55\xc1\xb7\x3a\xbf\xdd\x2a\x71\xc3\x38\xd2\x81\x84\x17\xc3\x8b\x3d\x4f\x71\x38\xb7\x11\x1f\x08\xab\xcd\x36\x26\x4e\xaa\xa3\x55\x85\xc6\xb3\x5b\xdc\x24\xd9\xc4\xbd\xbe\xad\x89\x15\xef\x39\x2e\xb0\x24\xf7\xb1\xd9\x8c\x15\x5f\xf3\xca\xc0\xe9\xf3\xdd\x48\x02\x7e\x9f\x06\xd9\xe7\x7f\x36\xb2\x1d\xc4\x82\xe8\x30\x8a\x90\xba\x93\xda\x9c\xcf\x79\xce\x82\x19\x83\x2a\x00\x43\xac\x93\x3e\xb5\x88\xcf\x3a\x30\xe0\x41\xf7\xf8\xd6\xe5\xa5\xdd\xfd\x36\xe8\x88\x6a\x07\xcc\xae\x3b\x87\xe2\xa0\x47\xaa\xa1\xe4\x62\x09\x6f\x38\x7b\x3d\x5e\x1a\x37\x91\x29\xcc\xa4\xc6\xed\x65\xa2\xcb\x64\xd6\xd3\xc1\x9f\x00\xe8\xef\xfe\xee\x71\xd8\x11\xbf\xae\x51\x6e\xac\xad\x35\x00\xd7\xef\xc5\x07\xce\xee\x9f\x59\x86\xdd\x15\x3b\x02\x7b\xf3\x26\x07\xb6\xf8\xb7\xc3\xbb\x1f\xf6\x31\x64\x14\x04\x72\x55\xe0\x10\xa4\x0f\xa3\x3a\xa6\x13\x04\xa3\x4e\xa4\x19\x4c\x17\xbe\x45\x05\x49\xe8\xdf\xd7\x9a\xcb\xb7\x72\x61\x36\x00\xfc\x18\x24\x53\x13\x37\x00\xea\xac\x31\xa5\x3a\x49\x8c\x2a\x3b\x0c\xd9\xe3\xd6\x73\xec\x47\x15\xba\xb3\xb3\x79\xed\xa4\xb1\xad\xe8\xaa\x7a\xa5\xc6\x25\x83\x02\x49\x1e\x42\x91\xf8\x68\x76\x25\x7c\xe2\x6f\xe3\xdd\xa4\x19\xc3\x98\x81\x20\x3b\xcb\x81\x00\xa8\xe3\x7a\x84\x92\x19\x19\x99\x41\xe4\xee\xc2\xeb\xdb\x50\xd8\xd9\xd1\xa0\xb5\xe8\x79\x59\xd6\xcd\xf5\x78\xba\xf5\xe0\x1b\x95\xb7\x5f\x30\x3d\xd9\x53\xab\x28\xa8\x26\x68\x48\xb2\x3b\xf3\x06\xc8\xb8\xb9\xed\xec\xcb\x58\xf1\x80\xfb\xcc\xca\xfd\x73\xa8\x9b\x2c\x45\xd8\xae\x8d\x8c\x67\x4a\xe9\x3d\xac\x45\x9a\xfb\x7e\x2e\xca\x4b\xa5\x69\x08\x61\xde\x34\x6e\x1a\x80\x37\x6b\x22\x17\x6f\x67\xe1\x5a\xf4\xbc\x6e\xee\x14\x21\x3b\xfe\xf1\xc3\x9b\xf3\x99\xe8\x9f\xe7\x72\xbb\x8d\x21\xba\x01\x2a\x05\x20\x16\xed\x0d\xd6\x1b\xf8\x20\xfc\xd5\x8d\x96\x36\xf1\xde\xb0\xa6\xc3\x77\x51\x3e\x21\xd0\xf3\x18\xd7\xc0\xc3\xf3\x45\x2b\x77\x46\xeb\x35\x1e\x00\x72\x48\x2a\xbf\x95\x7a\x1e\xbb\x2f\x90\x04\x2b\xdf\x06\x7c\xb0\xaf\x63\xde\x14\xcb\x26\xf4\x67\xf1\x89\xa1\x29\xdf\x62\xa5\x89\x77\x18\x8a\x27\x79\x5c\xdd\x48\xc2\xe1\x64\x44\x42\xf8\xac\xcd\x9c\x7d\xc0\xcc\x16\x31\x34\x59\x7c\xf6\x24\x74\x37\xaa\x64\x96\xa8\xc4\x73\xf7\x81\xd3\xdc\x3c\x0b\xf4\x8f\x8d\xbd\xc7\xd6\x80\xae\xe9\xa6\x2f\x9d\x93\x9d\x8d\x33\x2e\xc9\xf6\x2c\x0b\x77\xf3\xa6\xbe\x55\xaa\xd0\xd9\xd8\x9b\xe5\x21\x89\xc7\x9c\xa3\xc0\x4d\xef\x78\xf3\x56\xcf\xcb\xa0\x71\x7f\x63\xda\x31\xaf\x75\xa4\xb2\xdb\x37\xbb\x93\x65\xef\x2c\xae\xbf\x61\x7d\x03\x08\x86\xd1\xb8\x7a\x6a\x67\xe3\x40\x1b\xec\xe6\xa3\x3c\x8f\x9b\x47\x65\x6e\xc4\x45\x96\xf1\xba\xcb\xe1\x13\x6c\xba\x60\xe1\x87\xec\xa2\xcb\xb8\x01\x19\x6e\x24\xd3\x01\x24\xbe\x26\x83\x60\xb1\x54\x27\xd3\xf7\x6f\xf0\xb1\x85\x8e\xa7\x42\xc1\xa7\x4e\x33\x90\xeb\x38\x01\x65\x32\xde\x84\x11\xa5\x68\x30\xa3\xd9\x74\xed\xc7\x34\x31\xab\x67\x4b\xb4\xc1\xc1\x0f\x51\x5a\x94\x86\x99\x52\xdd\x3b\xf0\x9e\x34\x4e\x77\x03\x25\xdd\x3a\x0a\xf9\xc6\x3f\x21\x64\x55\x3f\x69\x6c\x71\xad\x1d\x1f\x37\x63\x3f\x20\x4b\xd2\xbb\x19\x8d\x26\x12\xea\x0c\xfe\xbe\x07\xf1\x82\x12\x4b\xd8\xf6\x72\xf4\xb2\x72\x07\xc6\x34\x1e\xc7\x63\x3d\x99\x12\xf8\xd4\x95\x8d\xdc\x9c\xb0\x48\xdc\x46\xd3\x38\x5f\x8b\xc4\xfd\xf3\xe3\xac\xcd\x99\x31\x4c\x98\xd0\xbd\xe6\x13\x1b\xb7\x10\x90\x62\x6d\xa6\x71\xf4\x2c\xfa\xf0\x89\xcf\xf2\x9d\x84\xa8\xa8\x45\xdc\xa0\x8c\xb4\x9b\x7e\x8b\xbc\x11\x60\x98\xd1\x3e\x3f\x70\xaa\x9f\xab\x98\x32\x01\x55\xde\x32\x55\xbb\xe5\x86\x76\x7b\x86\x5a\x76\xfb\x7c\x3f\x72\xf5\xe3\x2a\xc8\x88\xa7\x34\x88\x66\xb0\x52\x57\xbf\x57\xbd\x29\x45\x10\xba\x98\x74\xc4\x24\x13\x2e\x8a\x59\x89\x03\x41\xb3\x44\x4d\x9a\x03\xa7\x75\x24\x8e\xa7\x99\x97\xd4\x89\x60\xe9\x0b\x55\xf0\xcc\x4d\xf7\xef\x40\xda\x7f\xe0\xd0\xc7\x45\x8a\xf7\xd6\x65\x59\xd6\x03\x41\x25\x62\x2f\x4c\x41\x1f\xea\xa0\x37\x1f\x8c\xb1\xa2\x54\xb2\xae\x22\x4f\x43\xf5\x98\x6b\xcd\xbe\x32\x98\xa3\x45\xde\xb6\x75\x9b\xea\x4f\x9e\x0e\x12\x47\x95\x1a\x0d\xca\x0a\x22\xb9\xa5\xe1\xfd\x77\x38\x0f\xf5\xdd\x6a\x6f\xdf\xe5\xad\x18\x18\xb1\x47\x8e\xd8\x02\x81\x80\x20\x08\xc3\xac\x87\x70\x45\x03\xe4\xed\xb5\x81\x3b\xa2\xed\xf6\xba\xaf\xf5\x6c\x14\xba\xe0\x92\xe4\x6c\x49\x36\xa2\x59\x36\x45\x90\xa7\x6d\x2b\x32\xf2\x1a\xf9\x08\xe6\x89\x5a\x69\x18\x6f\x1a\xd8\xfc\x3b\xe9\x96\x9c\x8d\x78\x58\xd5\xa2\x69\x42\x43\xc4\xb8\x4b\x8b\x31\x22\xe1\xea\x2a\x4d\x3b\xc3\x50\xd7\xab\x22\x3b\xb5\xd2\x11\x1b\x02\x55\x12\x7a\x3b\x92\x43\x1b\x3c\xdf\x10\x34\x3d\xc6\x06\x5a\x47\x0f\x30\xc3\xac\x2d\x5a\xe1\xdf\x29\xdf\xf8\xe0\xdf\x06\xbd\x0b\xca\x59\xe6\xa3\xf6\x1e\x0d\x2d\xd8\x8c\x22\x44\xc6\x19\x19\x50\x2a\x99\x98\x93\x34\xee\x32\x11\xcf\xa3\x43\x92\x62\x25\x1e\xf5\xf9\x61\x21\xff\xf9\xae\x09\xb5\xa4\x76\x68\x9a\x4e\xaa\x4b\xa2\x01\x02\x29\x1f\xb4\xd2\xfa\xc0\x55\x21\x9b\xbf\x78\x95\x42\x63\x58\x77\x4e\x09\xef\x22\x1d\x82\xd3\x06\xb7\xd3\x47\x2a\xf1\x23\x92\x6e\x65\xe5\x06\x21\xe7\xb2\x17\xb3\xa1\x06\x32\xed\xe1\xd4\xd0\x1c\x08\x17\x1f\xad\xd9\xe1\x21\xce\x04\xfe\x22\x23\xf1\x3c\x30\x1e\x2b\x28\x3c\x10\xbe\x13\xc1\x0b\xa8\x83\x6c\x02\xd4\xab\xce\x35\x39\x0b\xb5\xb3\x08\x74\x1d\x4b\x7c\x51\x9c\xf6\x0c\x1b\x67\x0c\xf3\x5e\xbb\xc1\x92\x4b\xe3\xae\xeb\xd3\xa9\xbe\x60\x09\x0c\x09\xb6\xbd\x38\x43\xe2\x36\x92\xba\xe0\x4f\x42\xdc\xe9\x1f\xc3\x99\xa0\x93\xce\x99\xd4\xab\xfe\x88\x1b\x09\x7c\xb0\x82\xf6\xaa\x91\x5c\xa7\x89\x5c\xa7\x93\x06\x8a\x3b\xf1\x84\xe3\xf2\x05\x8b\xe2\xc2\x0b\xf7\x42\xea\x64\x22\x47\x38\x17\x34\x13\x8a\xfa\x2d\x11\x94\xdb\xbc\x6d\xfe\x09\x0b\x44\x4f\xd0\x5f\x30\xf2\xaf\xd1\x71\x1b\x89\xf6\x5b\x6b\x40\x5f\x3e\x89\x48\x11\x4a\x5e\xf4\x6d\xd6\x03\xe7\x4e\x0e\xe2\xda\x0d\x5a\xc3\xcf\xad\x37\x6e\xdc\x93\xc2\x3c\xea\x40\xf4\xc6\x1a\xc8\x85\x86\x88\x3b\x27\x1f\xf3\xa4\x84\xde\x99\x3f\x01\x74\xc7\x85\xf3\x27\x6d\xf5\xe1\x7d\x5a\xc1\xe6\x01\xe0\x65\x6a\x4e\x87\xfc\xdb\x45\xe2\xd9\x3b\xf8\x78\xf5\xde\xf6\xfb\xa1\xb2\x0e\x5b\xdf\xea\x40\xb0\x10\x08\xa8\x66\x30\x63\xf6\xda\xc0\x9b\x1e\x5b\x76\x78\x26\x34\x4d\xbf\xb0\x55\xee\x6f\xc6\xef\x3c\x8f\x35\xe7\x4a\x3c\x7d\x18\x6e\x4b\xc6\x1e\x09\xf2\x66\xd9\x88\x41\x71\xfb\x87\x8e\x37\x2b\x79\xbf\xe6\x49\x6e\xd2\xd5\x7c\x14\xa2\x7f\x97\x59\x51\xe3\x5c\xa4\xa3\xe8\x5d\x6d\x5d\xa4\x89\x80\xe1\x51\xe7\x7d\x53\x8e\x38\xef\x04\xd6\xa1\x8b\x9f\xe7\x5e\x88\xa3\x59\xaf\x52\xb5\xc3\x40\x34\x48\xca\x22\xad\x1d\x59\xd7\x01\x76\x3c\xa7\x78\xc5\xcf\xf1\x18\x6c\xfe\x96\x75\xea\xed\x7d\x17\xb1\x27\x81\x79\x19\x4a\xe0\x47\xa2\x73\x39\xae\xcb\x4d\xf1\x0c\xed\x5a\x8a\x3f\x02\x7e\xdf\xea\x1f\x08\x7a\xb3\x4f\x89\x06\x16\xc1\xd3\x80\x93\x37\x84\xd9\xbe\x89\x6f\x74\x18\xfd\x5a\xb9\x1d\x84\x3f\xf1\xe5\x1e\x8d\x09\xd7\xb7\xb7\x57\x2e\x3e\x08\xdd\x1a\x60\x32\x99\xc6\x71\x9d\x93\x6d\x39\x9a\xb0\x74\xc6\xad\x3b\x8f\xa5\x7c\xe7\xb7\x4c\x7c\xff\x38\x2a\xd1\x94\x29\xf9\x98\x4b\xb1\x14\xc9\xb4\x19\x82\x78\xf6\x85\xa2\x21\x68\x12\x4d\xa0\x0f\x1d\x7b\xca\x50\xd3\x7e\xef\x1c\xa8\xa6\x49\xa3\xff\x7c\xf0\x81\x61\x41\x60\xaf\x8f\x6f\xfc\x8c\x11\x7a\x5a\x64\x5c\xb3\x1e\xf6\x55\x7b\xaf\x3e\x4f\xdf\xbc\x2f\x1d\x62\xe4\xef\x13\x62\x3b\x7c\xe1\x39\x08\x63\x8a\xb6\x7a\xc2\x4c\x96\x59\xc9\x85\x93\x1d\x91\x83\x09\xad\x78\x7a\xf5\x08\xc8\x6b\xd4\xc7\x9d\xd5\x17\x66\x61\xa8\xee\xe4\xc8\x44\x73\x30\x0b\x66\x5e\x85\x8d\x66\x00\xa7\x83\x3c\xfb\x88\xd9\x17\xcf\x12\x5b\x8b\xd9\xba\x92\x23\xa0\xa7\x6b\x4a\x9c\x8d\x6a\x56\x8c\x6a\x66\xf6\xd8\x0f\x26\x5a\x3a\x6b\xef\x7b\x9b\x4e\x72\xe6\x85\x2c\x15\x9e\xf2\x8e\x93\xd8\xd8\xf2\x8c\x5d\xc3\xde\x5a\xbc\xdd\x8b\xf3\x66\xe4\x2f\xc8\xfa\xce\x4c\x44\xf1\x65\xf3\xef\xa0\xd7\x6e\xc3\xed\xac\x1b\x7c\x23\x84\x37\x9c\x20\x14\xb6\x10\x00\x7f\xde\x02\x37\x6d\xe5\x20\xde\x4c\x98\xd1\x7d\xe5\x71\xd3\xbd\x11\xc2\x20\x5b\x52\x1c\xc7\x43\x76\x7b\xf5\xa4\x47\x6d\xce\x70\x71\x7a\xfc\x6e\xda\xa5\x6c\xdd\xb2\x3f\x8e\x6d\xf5\x4c\x36\xc6\x9d\xbe\x5f\x72\xe8\xa1\xa8\xa0\x89\x17\xcf\xed\xc6\x76\x31\x02\x3d\xed\xa6\x80\x46\x23\xe5\x98\x1b\x39\xc5\x5f\x1d\xde\xd2\x48\x3b\x92\xe4\x30\x85\xe3\x3a\x3b\xf9\x9c\x0f\x99\x8c\x9b\x23\x7b\x92\x2d\xed\x95\x4c\xf2\x9d\xb4\xee\x8f\xea\xa2\x79\x08\x8b\x17\x6b\xce\xcd\xca\xf6\x39\xdd\x69\x06\x7b\x76\x15\xd4\xa7\xf4\xf4\x76\x7e\x97\x0b\xcb\x64\xd9\xc8\x62\xa2\xeb\x94\x2b\x8c\x45\x0f\x8f\x14\xa4\xaf\x0a\xf2\x6a\x7a\xfc\x70\x1f\x29\x0b\x0d\xf6\xf3\x5d\xe8\x9b\xb0\x90\x1b\x6c\x67\xe1\xde\x3d\x9e\xc6\x1e\xf2\x1c\x37\x69\x87\xf1\x08\x0c\xa1\x2c\xde\x5e\x73\xeb\xf5\xdb\xf3\x65\xb5\xa1\xb0\xd3\x9b\x1c\xa1\x96\x8e\x06\xf3\xae\x5a\x8f\x0f\x6f\x2f\x35\xd9\x1d\x34\x25\xfb\x5b\xcf\x62\x87\xf8\x43\x04\xdc\x1f\xcd\x6c\x3d\x76\xbd\xea\x49\xb5\x93\x8e\xd7\x7d\x5c\x23\x06\xe0\x32\x9a\xac\x0c\x95\xae\x24\x84\xa7\xaa\x60\xee\xfe\x72\xc6\x98\xee\xbf\xa1\x5b\x98\x5a\x47\x5a\xcc\x61\xdb\x0e\x53\xb8\x6c\x11\xfa\xe2\xf6\x97\x68\xcd\x93\x49\xce\xcd\x91\x6b\x74\xf5\xa4\xc7\xef\xe7\xcf\x89\x72\x5c\x19\x3a\xa6\xa3\xca\xca\xc9\x97\x3e\x99\x78\x97\x4a\xe2\x21\x74\x26\xec\xe5\x1e\xb8\x8e\x64\x7a\x7b\xec\xf1\x6a\xb0\x1d\x34\x36\x32\xdd\x06\x1a\xc6\x1b\xaf\xff\x84\x7a\x3a\x81\x09\x6c\x88\x9c\x37\xe0\xc8\x33\xf2\x9c\xb1\x26\x23\x07\x5b\x2e\xfa\x7e\x83\xa8\xb5\x9b\x34\x24\xc9\xbc\xee\xac\x19\xc3\xfa\x64\x9f\x2a\x9c\xf3\x8e\xac\x1f\x99\x48\x7d\xf1\x20\x31\x4d\x66\x2f\xc3\x94\x89\xfa\x84\x34\x07\xcc\x11\x79\x07\x6d\x50\x3b\xdf\xf4\x12\xd3\x7e\x3f\xde\xbc\x4a\x32\xde\x99\x4e\x17\x16\x1b\x28\xe9\x76\xc2\xa2\x1a\xc5\x6a\x7c\x8c\x82\x36\x8a\x56\x6a\x83\xd8\x1a\x13\x15\x26\x3b\x18\x6a\xe0\xb3\x2a\xba\x8e\xd3\xd6\x86\xd9\x86\x78\x6a\x62\x65\xca\x22\xca\x0b\x29\xcf\xa4\x3c\xc2\xde\x69\xa4\xd6\x32\x6b\x6e\x11\x77\x44\xdc\x91\x71\x92\x61\xf1\xc6\x44\x99\x3e\xb4\x71\xfb\x80\x00\x40\xfd\x65\x57\x64\xf4\x84\xd7\x00\x38\x3b\x1d\x3c\xe3\x6e\xd0\x97\x64\x8c\x9e\xe7\x1c\xf1\x18\x73\xe9\x33\x73\xf4\xdc\x45\xa5\x8c\x15\xec\x5c\x67\xb2\x1d\x64\xfb\x33\xe1\xfc\xf6\x25\xa7\x0a\xe9\xb8\x03\xee\x70\xe4\xf5\x86\x55\x42\xcb\x60\x33\xb9\x4e\xd7\xef\x25\x03\x8b\xd8\xe1\x9b\xc0\x6e\x54\x28\x73\x68\x6b\xbf\xa3\x7c\xe1\x9b\xa4\x17\xa2\xd2\x11\xb7\xc3\x11\xce\x43\xdb\x12\x63\xd8\x0e\xd7\xef\x11\xce\x35\xa2\xa1\x29\x66\x69\xd3\x96\x44\x53\x4c\x09\x2c\x21\xd5\x85\x39\x58\x81\x58\x9b\x81\x14\x2b\x4b\x3c\x83\x91\x08\x27\x9f\xa4\x95\xbb\x58\xaa\x95\x58\x5e\xa8\x22\x97\x93\x1c\x72\xaf\xb0\x8a\xa3\x7e\x94\xab\xbe\xd5\x4b\x9f\x7c\x96\xda\x7b\x56\xef\xad\x44\xa6\x46\x97\x42\x78\x7a\x06\xeb\x48\xad\xd4\x78\x27\x64\xd9\x78\x9b\x5c\xae\x43\xac\xb1\xb5\xf9\x77\x9d\xb3\xbe\xa9\x0e\x1f\xb8\x76\x73\x31\xd2\xdb\x2b\x6e\x20\x63\x4f\xa5\x31\x6c\x89\x35\x6c\x3f\xf0\xf3\x85\x09\x7b\x3e\xc9\x66\xbd\x9d\xb4\xa1\x38\xa1\x90\x1c\x16\x29\x84\x94\xe2\x84\x53\x90\xec\xe5\xec\x8c\x8b\x33\x6e\xbc\x3f\xb4\x71\x27\x8d\x7e\x27\x1d\x7e\x2b\xb0\xb8\xf0\xe0\x89\x23\x10\x6b\xcf\xa7\xa8\x09\xb0\x48\x1d\x3c\xdf\xaa\xf5\xd8\x87\xa1\xf4\x5b\xa9\xea\x51\xad\xf0\x6b\xba\xb6\xc3\x53\xe1\xf7\xd9\x0d\xfd\x56\xbe\x83\xb6\xf4\xda\xa0\x0d\xf6\x31\x6a\x69\x28\x8e\x94\xc9\x45\xd6\x04\x5b\x45\x65\x25\x37\x65\x8f\xa3\x05\x65\x0d\xe2\xfe\x45\x87\xb6\x47\xa4\xde\xc2\xd5\x38\xc4\xec\xfa\xed\x40\x16\x37\x19\x43\x9a\x79\xbc\x6b\x7f\x75\xb9\x3b\x58\xe4\x17\xae\x9c\x31\x76\xe7\x7c\xdb\x82\x11\xad\xf2\x0f\xfd\x9a\xb9\xc2\x21\xa3\xc2\x51\xa3\xc2\x21\xed\xfa\x17\x45\x3f\xe8\xd9\x9f\x8c\xaa\x5c\xfb\x9f\x45\xb7\x8f\xff\x34\x36\xae\x0b\x27\x9b\x94\xca\xe8\x29\xdc\x62\x2f\xc0\xf1\x73\xa7\x3d\x7e\x8f\xdd\xe0\xf4\x84\x3d\xe8\xd4\x57\xa7\x7e\x4e\xc3\xc2\x12\x4c\x38\x8b\xec\xa1\x55\xb6\x1a\x95\xd4\xd1\x89\x07\xaa\x11\x2c\xa0\x58\x28\xfd\x61\x3b\xf1\xdf\x98\x2e\x4c\x09\xea\x76\x33\x90\xe6\x99\x1f\xcf\x7e\x54\xee\xf8\xd6\x9d\xf7\xcf\xac\x30\xc2\xc8\x40\xac\x4b\x61\x29\x55\xe0\xc1\xb3\xc0\xd3\x8b\xa7\x3e\xbf\xfd\xd6\xc9\xb7\xbf\x83\xf6\xdd\xb8\x93\xf4\x6d\x6d\xed\xae\x66\x82\x44\xbf\xba\x5d\x8d\x3b\xe9\xfe\xaa\x25\xd3\x7b\xb6\x34\x7c\xa3\xe3\x59\x70\xc6\x15\x19\xb3\xe6\xee\xa3\x9a\x15\x20\xa9\xb7\x43\xaa\x04\x0d\x1d\x49\x0e\x19\xd0\x2b\x1c\xea\x0a\x5b\x81\x6e\x27\xf5\xa5\x1f\x98\x79\xa7\x9f\xb3\x58\x95\x1c\x28\xf7\x8f\xfe\xc6\x30\x66\xcd\x26\x32\xde\x47\x4d\x2a\x63\x2a\x65\x48\xba\xef\x1a\xbe\x48\xe5\x3a\xba\x91\x46\x80\x16\x7d\x2a\xdc\xfa\x50\xb8\x7d\x68\x41\x9c\xa3\x89\xf7\x1b\xae\x0f\x0c\xce\x0a\xa8\xcc\x5f\x57\x80\x31\x04\xf6\x9e\x34\x69\xc2\x21\xa3\xac\x2f\xb6\xb7\x8b\x56\x4d\xe8\xa6\xdd\xe8\x6f\x7c\x46\xda\xd7\x8c\xb4\xb4\xd7\x32\x86\x05\x41\x7c\x33\x44\xed\xfd\x80\x33\xac\x54\xb3\x5d\x96\xcb\x3e\xaf\x04\xd6\x62\x0a\x70\xfe\x90\x09\x0f\x8b\x61\xe4\x26\xe3\x58\xda\x28\xa0\x3b\x7f\x9d\x01\xff\x8e\x6a\x02\xca\x3a\x02\xca\x9a\x67\xfe\x79\xea\x7e\x8a\xa2\xee\xc9\x80\x97\xc6\x80\x0c\x63\xd8\x8f\xce\xe5\xf0\x45\x21\x7a\x31\x1b\x2a\x76\xd9\x8e\xeb\x6a\xd6\x83\x6e\xda\x84\x64\xd5\x84\x62\x7d\x53\x9b\x1b\x83\xfb\xc4\x5c\xad\xcc\x7c\x3e\xf3\x07\x84\x78\xd1\x8e\x93\x8e\x14\xa1\xc0\x82\x13\xb6\xd0\x5f\x97\x3e\x96\x61\x18\x16\x2f\xe6\xcd\x38\x6e\x5f\xfa\xba\x2c\xa5\x18\xe0\x9f\x89\x8d\x2c\xb2\x90\xb0\x93\x73\xa1\x7d\xd6\x11\xed\xbb\x0b\xd0\xac\x0b\x9e\x71\x50\xd9\x61\xc6\x9d\x0f\x44\x4b\x3c\x0b\x65\xb2\x08\xc5\x66\x82\x1f\x20\xbf\x7d\x72\xc5\x2d\x7e\x1e\x6a\xb0\x69\xa1\xdb\x0e\x61\xd8\x0e\xa4\xdb\x0c\xd7\xdf\xa0\x2a\xc9\x00\xdd\xe7\x49\xd6\xcf\x91\xd4\xcf\x99\x42\x19\x1f\xdb\x69\x43\x7d\x53\x5b\xeb\x9d\x43\xe3\x3a\x32\xd2\x50\x39\x07\xbd\xaa\xcf\x77\xdc\x7a\x35\x6f\x8d\x0a\x3a\xcf\x5b\x98\x9b\xc0\xe0\x16\xbf\xa7\x17\x0d\xbd\xeb\xe0\xd2\x4e\xc6\xb0\x1e\x44\x7a\x25\xb1\x46\x19\xd2\x69\xa1\xd2\x6d\xa0\x52\x6c\x20\x53\x6c\xa0\x32\xcf\x9f\x84\x17\xaa\xdf\x5b\x7b\xec\x4a\x1c\x52\x4f\x75\x11\x4f\x78\x51\x4f\x78\x31\x2c\xb8\x91\xba\x04\x3b\x88\xdd\x9d\x16\x6a\xa7\xfd\xe7\xad\xc4\x37\x41\x9a\xb5\x62\x65\xb2\x98\xe9\x0c\x60\x0c\x95\xb8\xc3\x50\xce\xe1\x2c\x94\x7e\xd1\xa4\xc3\x07\xed\xad\x46\xf0\x0d\x08\xfb\x25\x5f\xe8\x0b\x97\x88\x0c\x0c\x74\x53\x9b\xf5\xa0\x56\x22\x8d\x5d\x72\xfb\xa2\x7d\xe1\x6d\x3b\xc2\x5b\x5b\x2a\xf1\xb4\x49\xcf\xda\x3a\x3b\x5a\x18\x6f\x5a\x92\x6d\xab\x09\xdd\xca\x61\xe1\x86\x25\x58\xd2\x39\x20\x7b\xc1\xb7\x9b\x30\xf4\x2f\x7b\x62\x62\x4a\xad\xc4\x43\xad\xa4\x83\x69\xf7\xc4\xe5\x4b\xdf\x1a\x13\xc5\x82\x37\xe5\x54\x4f\xf1\x54\x77\xd5\xac\x47\x55\x2d\xa6\x0c\xc0\x4f\x45\x7a\xd4\xa7\x7a\xaa\xe8\x8a\x21\xe3\xc5\x7b\xe3\x4e\x89\xfd\xa2\x0e\x7e\x50\x58\x28\xe4\x94\x96\x23\xfa\x5a\x34\xe1\x25\xff\xb5\xfe\x05\xb1\x1b\x45\xf1\x21\xfb\x43\x76\x71\xaf\x46\x2a\xfd\x90\x63\x43\xe1\x03\x3f\x26\x63\xb8\x38\x9f\x13\x4e\x21\xec\x19\x90\x94\x79\x3e\x25\x4a\x76\x77\xa4\xdc\x49\xfb\xd5\x94\x9e\x90\x73\x24\xde\x1b\x4d\xba\x37\xba\x6d\x71\x47\x6e\x74\x63\x06\x4a\x44\x85\x01\x8f\x1c\xfd\xa2\x38\x6e\x05\x27\xac\xf0\x79\x00\x7b\x61\xbf\xff\x3c\x14\xc5\x84\x19\xa9\xff\x94\x07\xee\xdd\xbf\xc9\x38\x74\xfd\x74\x02\xfd\x4e\x92\x6a\xb6\x37\x65\x2f\xc9\x8f\x0b\xcb\x0f\x16\x23\xf4\xef\x5d\xf2\x44\x76\x9b\x21\x11\x1f\xec\x5d\xac\x84\x4f\x46\x97\xdd\x4f\x03\xea\x4e\xde\x25\x9c\xbd\xdf\x49\x0d\xdd\xad\xdc\xfa\xb2\xdb\xb9\x57\x13\x4f\x50\xfc\x62\xef\x97\x0c\x2d\xfd\x5a\x62\x58\xe1\x23\x7c\xd9\xb6\x5c\x5f\x56\x69\xf4\xa1\x0d\x8e\x7c\xa9\xac\x6a\xda\x9d\x14\x36\xb6\xbc\xf5\x2c\xc4\x82\xaf\x79\x87\xbb\xdd\x49\xcc\xe3\xc1\x8a\x4a\xda\xf3\xb2\x19\xbe\x94\xdc\xf9\x9a\xe3\xfe\x6a\x86\x5d\x05\xec\x9b\xb5\xef\x5d\xdc\xb3\x2c\xf8\xb2\x9b\x44\x13\x26\xee\x16\x5a\x7d\xd4\x97\xc3\x4b\xa6\x58\xc3\x2c\x10\x87\x2f\xfb\x07\x2e\x44\x2b\x35\xfc\x84\x7d\x12\xaf\x97\x98\x97\x5f\x72\x56\x31\x1f\x6f\x5d\xec\xd8\xbb\x62\x92\xc0\xde\x55\x4b\x80\xd9\x8c\xfd\xdd\x4f\xf4\x28\x15\x2d\x68\x60\xbd\x40\x18\xf6\x7e\x0f\x0b\xf1\x8d\xb7\x39\x87\x3f\xc1\x4f\x3b\x80\xb3\x2f\xdd\x06\x7e\xbd\xd8\xbe\xe2\xcf\xf0\xa3\xd0\x15\xf6\x73\xaf\xb8\xf1\x2e\x5f\x5b\x58\x1a\x54\xce\x06\x5f\xfe\xaa\x87\x9c\xa0\xf6\x47\xbc\xd9\x1c\xeb\x12\x7a\x20\xe5\x1c\xf4\xae\xf0\x1f\x5b\x50\x20\xd2\xa0\x4b\x52\xce\xbe\x2c\xf3\xc0\xa4\x99\x03\x87\x1a\xdf\xbe\xbd\x92\x05\x9d\xbf\x69\xdc\xe3\x73\x17\xa5\x3e\x88\x1e\xd0\x0d\x7c\xf9\xcf\x78\xd6\xf3\x0f\xf9\xc1\x96\x6c\xfc\xa0\xe2\xf6\x63\x00\xf1\x77\x1e\x8c\x93\xfa\xd0\xb7\xec\xed\x2e\x5b\x63\x66\x91\xc0\xbb\xd6\x88\x7d\xad\x51\xce\xcf\x77\x1d\x99\x37\xf6\x56\x62\x41\xaa\xbe\x87\x9e\x3d\xc4\xdb\xd7\xde\x91\xb3\xc2\x7d\x30\x9b\x14\x3c\x98\x15\x59\xb2\xaa\x63\xb0\xb0\x5c\xfb\xc5\x23\x1e\x37\x3f\x78\xd8\x0f\x8b\x65\x0b\xd8\x86\x14\xcc\xcd\x6f\xbf\xaf\xf5\xc6\x3d\xf5\x88\x66\x77\xa5\x1f\x0a\x4f\xb3\xd8\xf5\xcd\x1a\xf9\xf7\xad\x63\x8d\x1a\x67\x55\x7e\x35\xf3\x60\x35\x7b\x36\xfe\x5a\x27\x9b\x2b\x08\x9e\x59\x37\x96\x2b\x61\x31\x39\xed\x8f\x52\xbf\xb8\x1f\xfb\xf3\xb8\xfb\xef\x51\x2f\xee\xaf\x3b\x2e\xbf\x9e\x45\xf1\x13\x2f\x45\xf6\x53\x05\x7d\x7f\xef\x6f\xa2\x76\x78\x47\x6c\x5f\x5b\xfb\x1d\x3e\x56\x7c\x0f\x9a\x71\xb0\xdc\x83\xcd\xc3\xca\xc6\x24\xfb\xee\x52\xa7\x13\x7e\x7f\x79\x67\x25\xa1\x19\xd8\xfc\xb6\xa7\x0a\x16\x37\x2c\x4b\xfc\x0e\x3b\xdb\x9a\x6f\x49\x63\xef\xac\x5e\xd1\x09\xf7\xf8\xb0\x7f\x5c\x57\x5b\xfb\x65\xc9\xb2\xe5\x83\x83\x60\x36\x0d\x7e\xea\x0d\xec\x8b\x18\xf6\x1d\xce\x5f\x2c\x3e\x94\x41\x1b\x3e\xa4\x9c\x3d\xbf\xce\xe2\x2e\x18\xaa\xfe\xcc\xef\xac\xc4\xaf\xe6\x6a\x55\xf9\xed\x0f\xef\xa4\x35\x95\x0a\x5a\x10\x6f\xac\xa0\x1d\x0e\xcb\xaf\x3f\xf1\xfe\xf6\x22\x80\x43\x46\x96\x1f\x65\x2f\x36\xaf\xfa\x4e\x32\x83\xbf\x3b\xa3\x4b\xd5\xab\x3e\x69\x37\xd3\xd2\xeb\xce\x5a\x8f\x5d\x85\xd9\x80\xbd\x7d\xd9\x3e\xda\x3b\x50\xf8\xf0\x62\x3b\x3d\xcc\x18\x96\x21\x8b\x56\xcd\x0a\x5f\x74\x87\xb0\xb0\xc1\x31\xea\x83\xd5\x95\xa4\xad\x83\x9e\x5d\x6f\xdb\x1f\x70\x0b\x7b\x73\xb8\x9e\xf3\xb3\xf5\x90\x94\xdf\xf1\x2a\x24\xbb\x85\x88\x5b\xf6\x76\xd3\x94\xbf\xc3\x39\xd1\x46\xcd\x41\xa3\x52\x63\xeb\xd9\x17\x67\x26\x5e\x02\xff\x2d\xce\x3d\xaa\x89\x5a\x89\x7d\x75\x4e\x98\xf5\x6f\xfd\xe5\x8a\xa3\x04\x9f\xca\x5e\xdc\x1f\x78\x0d\x67\xb6\x25\x13\xf7\x6c\xcd\xde\xee\x6f\xc9\xae\x17\x45\xc6\xfe\x9e\xae\xc7\xa8\x6d\xeb\x3b\xcb\x3d\x10\x8d\x8b\xde\x37\xf5\xbe\xfd\x4e\x2f\xcf\x69\x8b\x90\x2a\x11\x4c\xdb\xc8\xd9\x97\x0d\x2f\x13\x09\x59\xb5\x24\x5c\x63\x24\x5f\x63\xac\x35\x99\xea\xb5\x6d\xb1\x6c\xa5\xe0\xe0\x19\x4f\xe3\xd8\x72\x38\x07\x7e\xa7\x57\xc9\xce\x00\xcd\x16\xe4\xcc\x3e\xb4\x43\xaa\xc9\xb4\x1d\xc8\x6c\x60\x21\xb6\xfb\xda\xa3\xdb\x1d\x09\x20\x26\x9d\x98\x44\x11\x3e\x77\xf1\xd4\xf9\xcf\x26\x18\xbd\xfa\x6d\xae\xf7\xd7\x5c\xe5\x38\x4c\x61\xc9\x24\xe1\xa8\x1a\xac\xfa\xc6\xe8\xc5\x1a\xce\x19\x5f\xbf\xc9\xb9\xdf\x79\xca\x65\xed\xc0\xd4\x72\x20\xed\x89\xb3\x77\xb6\x34\x25\x0e\x03\x78\xbd\xb9\xe5\xab\x09\xd9\xac\x8c\xcb\x86\x6e\xd8\x9f\xb6\x9d\x12\xa2\xcc\x9a\xa4\xa9\x24\x9c\x19\xfb\x78\x34\x5b\x85\x43\x62\x9c\x66\x77\x76\x17\x7d\xde\x65\x39\xe2\xf1\x93\xe6\xee\xf7\xa7\x50\xed\x1f\x26\x36\xd8\xc4\x0c\xa4\xfe\xe6\xdf\xd9\xc7\x8e\xa7\xd9\x92\x26\x09\x61\x3a\xcd\xc2\xca\x1f\x3e\xfb\xfd\x1c\x63\x61\x48\x14\x0a\xa6\xc7\x45\x3b\x63\x14\x66\xeb\x1b\xeb\x52\xe8\x18\x2f\x86\xa6\x91\x8d\x2d\x37\xfd\xed\xbc\xfd\x2e\x37\x20\x11\x55\xab\x8a\x3a\x29\xc3\xe0\x3e\xb4\x0e\xb3\x35\xfb\x3a\xd4\x4d\xd9\x32\x90\xa6\x87\x58\x89\x68\xcd\xab\x8f\xec\xcb\x27\x2d\x6a\x09\xdd\xa7\x5e\xe3\x20\x04\xa2\xdf\x0e\x98\xad\xb9\x57\x29\xae\x1a\x96\x51\x2c\x08\xdb\x41\x8e\x5e\xf7\x96\xdd\xbe\x7c\x18\x2a\xb7\x91\xd4\xc4\x33\xd0\x87\xf9\xe0\x5c\xc9\xe2\x37\x35\x15\x26\x62\x21\x09\xb8\xd9\x63\x17\x66\x87\x1c\xf9\xc2\x31\x81\x3b\x52\xee\xd8\x36\x20\xb4\xad\xa5\x75\xe1\xcb\x65\x7d\xd9\x31\x47\x2b\x9c\x37\x48\x20\x49\x26\x7a\xdf\xdb\xbb\x8b\xc1\xd6\xef\x3e\xf2\xa7\x8e\xa1\x04\x43\x50\x5b\xa7\x9f\x25\x11\xb2\x26\xd7\x3b\x81\xe9\x45\x11\x36\x59\x3d\xf2\x7c\x1d\x2b\x1b\xc2\x36\xc4\xfd\x24\xab\x56\xab\x7c\x0c\xe0\xf8\xf9\x4d\x1f\x84\xd9\xfa\x2e\x1e\x6a\xa7\x67\x1d\x47\x81\xf7\xe3\xfd\x88\x5e\xf7\xf5\x1e\x7d\x8d\xdf\x6b\x8a\x83\x65\x18\xac\xd2\x20\xa0\xbc\x20\x64\xcd\x7b\x2d\xdd\xfd\xda\x30\x14\xf1\x85\x3d\xf7\xf9\x6a\x2f\x4c\xbf\xc3\xa3\x09\x3e\x62\x58\xe6\x41\x42\xa4\x77\xbf\xe0\x61\x1f\x6f\xdb\xe1\x8d\xec\x7c\x31\x9b\x09\x6b\x7c\x7f\xf3\xee\xbf\xfb\x78\x6a\xb9\x1c\xa7\xae\x3b\xa7\x00\x35\x25\xed\x21\xb3\x77\x5e\x99\x98\x64\xcb\xa0\x3b\x04\x94\x0f\xed\xe6\x7c\xcc\xfd\xee\xbb\xf9\x7c\xf3\xf3\x1b\x38\x77\x83\x8d\x65\x9c\x0d\x2e\xb1\xc3\x4a\x35\xc5\x52\x9c\xa5\xfc\xce\xd2\x06\x02\x35\xb0\x3e\x20\xfa\xe0\xc8\x03\x92\x8e\x9f\x99\xbb\x6f\x08\xb4\x21\x69\x03\x5f\xf6\xf1\x92\x54\x63\x02\x7f\x48\xc1\x67\x86\xa2\xa3\xa2\x88\x4a\xd4\xf9\xc9\xbe\xca\xc6\xee\x9d\xe7\xbb\x0e\x9e\x5e\x43\x1c\xef\x79\x9d\xb0\x52\x7b\x8f\x9a\x39\x3a\xaf\xd1\x71\x0a\xbf\xbd\xbf\x25\xa1\x6e\x8e\x15\xf9\xac\x0b\xb3\x15\x31\x97\xc7\xd5\xda\x0d\x61\xb0\x69\x54\x17\x52\x2f\x72\x78\xbb\x23\x82\xa2\x4c\x48\x1d\xf5\xa0\xb8\x9c\xc1\x7a\x14\xb9\x7f\xd1\x24\xab\x24\xd3\x82\x50\xd5\x40\xe3\xcf\xf8\xe5\x8d\x5a\x2a\x5c\xa6\xa1\x34\x5b\xc2\xa5\xc3\xb7\xa9\x51\x5a\xf7\xc0\x8a\xe4\xcf\x20\x97\x83\x3c\x30\x86\x86\x2f\x76\x6d\x46\x4a\x10\x69\xa1\x74\x0f\x37\x5e\x6f\xf1\x40\xd8\xe7\xe8\xfa\x7d\xee\x7d\xda\x0d\x47\xec\x97\xc8\xed\x21\xf0\xfb\xec\xd7\xd2\xdd\x0d\x4f\xd4\x3d\xf4\xf2\xf4\xf8\xb6\x95\xb4\xcb\xb6\x3c\x2e\xe5\xf0\xec\xf0\x25\x18\x83\x36\x68\xe3\x76\x92\xc2\xe9\x16\x4e\x44\x26\xdf\x85\x1d\x4e\x9e\x3b\x1e\xdc\x53\x25\xf6\x64\xc4\xc5\xb0\x49\x69\x56\x4f\x69\x26\xd9\xcd\xef\xf3\xef\xf1\xb2\x6b\x0f\xc1\x46\x3e\x51\xe0\x22\xda\xeb\x89\x7a\x0e\xff\xae\x83\x3a\x68\xc3\xef\x0a\xfb\xf5\x3b\x46\x8f\x5d\xb5\x54\xa5\x08\x69\xe9\xd2\x97\x2f\x9b\x03\x60\x0c\x0b\x30\x06\x24\x30\x63\x84\x7a\xe5\x1f\x9f\xcf\x71\xf7\x09\xdd\xac\x27\xf5\x54\x1b\xc9\xb4\x17\xcd\xb4\x07\xd9\xac\x69\x43\x3b\x33\x11\x1e\x4d\x8d\xf3\x21\x63\x61\x01\xac\x65\x19\xc6\x64\x1e\xc2\x60\x16\x89\xbd\xc8\x9c\x6c\x1b\x79\xf0\x46\x62\x58\x56\x4f\xd1\x48\x3e\x9c\x95\x55\x93\xb0\x3f\xf8\x37\x59\x33\x50\x60\x7a\x5c\x37\x24\x49\xb3\x3b\x4e\x29\xa5\x8f\x6a\x67\xbc\x69\x47\x3c\x5d\xee\x60\xd9\x12\x4b\xa3\x39\x1e\xaf\x8d\x92\x2f\x4f\xc3\x3a\xad\xe4\x4a\xad\xd4\x65\x5c\xa4\x58\x43\xb9\x4f\x6c\x57\x7a\x86\xaf\x03\x37\x68\x03\x34\x69\x02\xd4\x69\x82\x36\xea\x19\x6f\xe2\xa9\x23\xbe\x3c\x02\x49\xe7\x20\x09\x96\x60\x0b\x95\xc2\x68\x7b\xc2\x38\x34\x9d\x50\xb1\x6e\x1e\x86\xb1\x0c\xf9\xc1\x70\x5d\xff\xd2\xfd\x58\xe9\xd1\x99\xf4\x01\xd1\x88\x56\xd9\xbc\x76\xf3\xfa\x84\xef\xe3\x49\x3e\xaf\xdc\xd0\x12\xef\xe5\xc7\xae\x9a\x85\x8c\x59\xc1\xa4\x25\x9b\x50\x0e\x43\xe4\x22\x4c\x2a\x37\xa4\xdb\xea\x0a\x9d\xcd\xad\x7d\xb4\xfd\x2c\xdf\x3f\xbd\x7c\xbf\xec\xfd\xdd\x32\xf6\x0a\x32\x90\x74\xdb\x48\x08\x59\x42\xb7\x59\x42\x17\x5b\x28\xe8\x23\x32\x86\xb3\x52\xc1\xe6\x69\x1a\x83\x89\x68\xae\x42\x8f\xaf\x7d\xe1\x3e\x37\xa1\xc7\xa7\xc5\x19\x89\xdd\x76\x9a\x21\xb4\xf9\x9b\xed\x1c\xdd\x5d\x2f\x9e\x34\x85\x31\x58\x92\x45\x64\x31\x87\xcf\xbd\xce\x0c\x39\xce\xbc\xf2\x4c\x23\x13\x1e\x14\x13\x1e\x64\x9b\xd0\x2d\x76\x5c\xf6\x75\x39\x63\x54\x0b\x68\x90\x51\x24\xd3\xc3\xba\xc9\x56\xce\x53\x3c\x44\xc1\xc8\xa9\x2f\x5e\xfa\x61\xdf\xab\x2e\x13\xee\x08\xc5\x0e\x1f\x84\x4e\x33\x93\x91\xb3\xbf\xfd\x39\x2b\x85\x34\xc2\xd2\xaa\xa7\x74\xfa\x03\x6b\x04\x4d\x66\x1d\x41\xa0\xf3\xfd\xdc\x6b\xfb\xd3\xda\xb0\x43\xb8\xee\x15\xf6\x1d\x64\x73\xbc\x65\x25\x8d\xb4\x21\x5a\xcc\x7a\x97\x41\xd9\x23\x11\x82\x83\x73\x37\x94\x5a\xda\x61\x59\x31\xb5\x0c\x6f\x1c\xc9\x7e\xd9\x9e\x8f\x92\xf6\x56\x70\x2a\xd4\xe9\xc3\xb7\x22\xc7\xa3\x9c\x50\x5a\x4a\xaa\x67\x57\xe1\x0f\x54\x2d\xfb\x5e\x9e\x8b\x2b\xf6\x84\x6a\x95\x0d\x23\x4b\xe4\x2c\xd4\x1b\x9d\x2d\xe4\x68\x7f\xf8\xb3\xc3\xb9\x2b\xf6\x97\xfd\x84\xdc\x11\xd6\xe7\x71\x8e\x9e\x6f\x74\x19\xc3\x9a\x89\xa9\x48\x0f\x26\x95\x77\x56\xcf\x9b\x9c\x85\xc2\x69\x5f\x9b\x1e\x20\x07\x71\x25\xb0\xaf\xef\x48\x47\xc8\x8e\xe7\x8d\xe8\x34\x7e\x20\xbf\xa3\x06\x67\xcc\xbd\x93\x20\x11\x7f\xab\x3e\x98\x06\x67\x3b\x81\x62\x24\x4a\x57\x7d\x28\x38\x88\xe5\x39\x1a\x15\x9f\x2a\xc7\xce\x48\xf0\x70\x84\x37\xc9\xc4\xb3\xf5\xe9\x8d\xcf\x44\xb2\x22\x10\x60\xec\x2c\x3e\x7d\x6b\x0d\x67\x4f\xad\x1f\xb9\xa7\x44\x38\x42\x1a\x06\x60\x56\xef\xca\x1b\x07\x30\xb2\x93\x7e\xca\xb9\x3c\x55\x10\x8d\x21\xe7\x69\xc4\xa5\x92\x97\x10\x7d\xc1\xca\x6f\xb1\x06\x24\xbb\x7c\x1f\x85\x66\xc7\xa7\x7a\x1a\x8e\x16\xd9\x9d\x35\x90\x87\x3b\xa2\x33\x2a\xdd\xcd\x7a\x38\xe8\xb4\x71\x1a\xd7\x69\x87\xf7\xa8\x4a\xe3\xeb\x42\xa6\x99\x4a\xb6\xf3\x01\xf9\xe1\xf3\xc5\xb5\x49\xa6\x6e\xad\x15\xce\xa3\x43\x49\x65\x1e\x6f\x5f\xb2\x35\x77\x05\x8f\xaa\x30\xc1\x22\x3e\xf6\x46\x93\xe2\x5d\x35\x46\x27\xf0\x94\xe6\x40\x95\x52\xcc\x45\x34\x6e\x4d\x49\x6f\xe8\x17\xad\xbf\x45\x88\xd6\xb9\x1c\xdd\x13\x51\x4b\xa3\x9a\xd2\xd7\xc9\x99\xe8\xcd\x38\xf3\x8d\xf4\x24\x99\x62\xfd\xe9\x11\x1b\xd8\xf3\x18\xa7\xe9\x49\x79\xbe\xe2\x8d\x83\x3c\x44\x53\xc5\x2c\x57\x1c\xae\x53\xe1\xdc\xbb\xff\xae\x5f\x0b\xc5\xa1\xf1\x18\x03\x51\x91\x82\x95\xef\x74\x42\x20\x26\x9c\x64\x27\xb0\x27\xc6\xeb\x19\x7b\xf7\x5d\x7e\x8d\x90\x74\x86\x12\x1d\x00\xbd\x8f\xd5\xaa\x27\x35\x58\x3c\x8a\x26\x68\x83\xd9\x53\x68\x48\x39\x20\xcf\x69\x3e\xa8\x6a\xf1\x3b\xa4\x56\xef\x76\x19\xc2\x0a\x8e\xcd\xd5\x26\x11\xb7\xc3\x40\x96\xc5\xae\x42\x54\x46\xc3\x0a\x3f\x64\x19\xcb\xc2\x89\xe7\x76\x5d\xb3\xec\xfb\x5c\x82\xcf\x7d\x82\xa1\x7a\x74\x48\xc2\x9f\xb1\xc1\x91\x75\x3a\x00\x67\x69\x4a\x9a\xe7\x75\xe7\xf6\x39\xcb\xa7\xb2\xdb\x22\x96\xd7\x41\x18\xae\xe3\x93\xb1\x62\x9b\x7f\x7b\x66\x99\xd3\xd1\x82\xe4\xba\x60\x0d\x34\xd1\x1e\x09\x2e\xa4\x8b\x75\x9e\x87\xfd\x20\x50\x6d\xd3\xf9\xc7\x23\xfe\x29\x9b\x5f\xee\xa6\xf3\x4d\x9b\x66\x29\x42\x9a\xbb\x86\xaa\xae\xc3\xa7\xc9\xa2\x6b\x4f\x54\x2b\xfd\xa1\x9c\x65\xec\x4e\x61\x71\xaa\x77\x67\x71\x30\x3c\xda\xc1\x70\x8d\xb1\xcd\x00\x27\x9a\xf5\xe4\xde\xb3\xab\x84\x1f\x91\x48\x34\x7b\x9a\x23\x16\xd7\xb9\xd5\x70\x21\x6c\x89\x6c\xc5\x25\x85\x9b\x6f\x54\x30\x8e\xd3\x57\x58\xf3\x74\x5f\x45\xf3\x65\x6b\x08\x02\xc2\x64\x1e\x20\xa6\x14\x3c\xce\xe0\x7d\xda\x85\x81\x51\x52\x63\xa3\xc1\xf3\x56\x69\x48\x73\xf1\xf6\x2d\x5a\x0c\xe4\x46\x11\xe5\xb1\xdc\x1f\xef\x70\x72\x03\x27\x48\x1d\x84\x64\xc2\x67\x81\x67\x56\x15\x6e\x36\xa2\x99\x74\x00\x81\x74\x8e\xf8\x5d\x8d\xe7\xb3\xb5\x8f\x0c\xc4\x47\xd7\x8f\x7a\xd5\x9a\xed\x43\x31\x7e\xc8\x70\xf1\x9e\x4f\x7c\x34\x4d\x18\xc5\xb4\x74\x27\x99\x2f\x7e\x2a\xf1\xfc\x83\x09\xa7\x65\xf9\xb3\xa7\xb8\x2f\x85\x32\x4f\x98\xd9\x42\x68\x5b\x0d\x2d\x8f\xf9\x9b\x5b\xf0\xec\x3d\x9f\x8e\x88\x02\x48\x33\x95\xb8\xce\x0f\xfb\x94\xec\x2b\x43\x6d\x42\x55\xc0\x64\xa6\x5d\xc9\xf7\x85\xef\xb5\xc3\x48\x8e\x71\x96\x11\x96\x61\xdc\xee\xc7\x17\xfe\xdc\xe5\xf0\xca\x69\x61\x5c\x00\xa5\xd8\xed\x63\xaa\x5c\x68\xd6\xab\x1c\x31\xed\xa1\x9b\xfd\x58\xb6\x6d\xa8\xf9\x40\xa0\x21\x72\xbf\xc2\xf3\x02\x16\x52\x86\xf7\xe6\x62\xe9\xf9\x7e\x04\xcb\xb6\x75\x5d\xf1\xc9\x87\x50\x7d\x67\x97\x8e\xb8\x6d\x80\x6f\x1d\x24\xc5\x72\xc5\xd3\xe4\x63\x20\x49\xf5\x21\x40\xca\xcb\xe8\xfd\xe6\xa7\xde\x9c\x0b\x32\xf0\x20\x3a\xed\x3a\x14\x19\xa2\xb7\xbb\xcc\x97\xba\xa5\x2d\xdb\xe6\x7a\x10\x10\x3a\xb5\xb6\x21\xb5\x12\xa9\x15\x35\xf8\xf6\x8d\xc6\xdd\xf4\xbe\xb9\xa9\xd3\x0d\xcd\x8c\x46\xd5\xc3\xaf\xa6\x8c\x93\xba\x14\x81\x9d\xcc\x4b\xb3\x6c\xe6\x0d\x4e\x1b\x2f\x9c\x3f\x83\x65\x0d\x7a\xfc\x95\xcf\x3f\x79\x10\x77\x47\x33\x03\xc5\xba\xd4\x10\xde\xe9\x32\x0e\x4a\x79\xf3\xb2\x38\x6b\x96\xc7\x9b\x7d\x59\xc4\x43\x4b\x27\x90\x25\x3e\x1d\x1b\x02\x77\xf6\xb4\x5d\xc0\xaf\x23\x7f\xe6\x80\x42\x5d\x94\x5e\xe4\x63\x6d\xf8\x08\x47\xae\x5c\x16\x78\x5e\xb7\x4b\x8f\x2f\x9b\xc8\x47\xa6\x6d\xf5\x94\xbd\x17\xdf\xfa\xc3\x7e\xa8\x5f\x38\x7a\x10\xed\x99\x84\x10\x0d\x0d\xc3\x4b\xba\x94\xaa\x12\x8d\xb4\x03\x49\xe6\xa1\x0a\xe8\x40\x87\xb6\x89\xf4\xbc\x8e\x40\x02\xc1\xb4\x9d\x3c\x2f\xf5\x73\x9b\xba\x16\x89\x2c\xd3\xb7\xa6\x8c\x15\xcc\x40\xd4\xb7\xce\xe8\x2f\x7a\x2e\xe2\xa9\x59\xf8\x69\xf1\x71\x06\x12\xcb\xf9\xac\x3f\xcd\xdd\xbc\x3f\x7e\xb2\x76\x71\x50\x20\x00\x98\x14\x40\x21\xa6\x10\x6f\xb9\x1b\x2e\x1f\xa5\xb0\x6d\xd8\x10\xbe\x6a\x95\x9b\x21\xc9\xb3\xc8\xe4\x20\x42\x2c\x5b\xda\x48\x87\x5f\x4d\x3d\x36\xee\xc4\x70\x49\xba\xb9\xd4\xf2\x9c\x9c\xc8\xe2\x22\x30\x52\xfa\x40\xd2\xa1\xe3\x49\xa5\xdf\xee\xf7\x7a\xd0\xab\x89\xbc\x13\x80\x92\x77\x72\xcd\xb5\x3b\x49\x06\xcf\x9f\xb6\xf0\x43\xb5\x60\x06\xc4\x1b\x80\x08\x50\xf1\xfd\x8c\x4b\x26\x12\xba\x82\x10\x4d\x51\xd6\x27\xdc\xd0\xbe\x77\xfc\x92\x21\x1b\x95\x9c\xfc\xeb\x5c\x9d\x47\x51\x07\x69\x4a\x33\x28\x50\x4e\xba\x2d\x79\xe3\xf9\x41\x51\x7c\xde\x36\x46\x71\x91\x92\xd0\x4d\xab\x26\x50\x9f\x61\xe8\x42\xa3\xf5\x5e\x79\xbd\xac\x1f\x7e\x81\x1b\xc7\x0b\x80\xc2\x93\xe7\x36\xbd\xed\xa7\x0c\xbb\x39\xec\x9e\xc1\xe6\x4e\xc4\x2c\x67\x6a\x39\x53\x24\xb4\xb5\x69\x96\x31\xfc\xb8\x24\xa2\xdc\x86\x6e\x37\x9c\xc8\x07\xa3\xd0\x78\x34\xc5\xd3\x0c\x14\x54\x38\x0b\x9c\xd1\x90\x0a\x1f\x4c\x78\xec\x94\xe6\xc2\x9d\xf6\x36\x3e\xee\x5f\xb4\xc6\xbe\xb8\x02\x86\xe6\x0d\xb0\x2f\xfb\x29\x97\x3e\xa9\x63\x1b\xc5\x66\xef\x6e\x20\xe3\x43\x11\xd7\x1d\x85\x2e\x58\xbd\x5d\x1a\x1e\x28\xf2\x72\x81\x84\xd7\x97\x1a\x28\xf1\x57\xce\xd2\xbd\xb6\x61\xad\x0a\x19\x7a\x19\x26\x6b\x8c\xfb\x37\xbc\x2c\x97\x5e\x8e\xe7\xb6\xd2\xde\xd2\x07\xbb\xfd\x9c\x87\xbb\x02\x09\x64\x06\x36\xca\x80\x4b\x53\x7a\x88\xbb\x1c\x77\x12\x77\x42\xb0\x61\xfa\x6d\xd1\x35\xef\xfa\xfe\x96\x22\xea\x24\x9c\x1a\x5b\x9e\x4e\x5f\xe4\xc4\xb2\x62\x4a\x81\x9e\xee\x44\xf6\xf2\x01\x81\x8d\xec\x3a\xac\x41\xe3\x4e\x92\x8b\xa7\x58\x8a\xa5\x0c\xd1\x4a\x0b\xca\x1e\xd5\x02\x07\x7f\x31\xea\x4f\x7c\x67\xe5\x99\xd9\x16\x03\x80\x66\xfa\xbc\x09\x93\x47\x8a\x57\x46\x30\x0c\x43\x56\x08\xb4\x06\xfc\xfe\x88\x8d\x4e\xb2\x3b\x8d\x1e\xef\x8f\x21\x9c\xdc\x28\xf2\xbc\x2b\xb0\x16\xbd\xee\x05\xef\x4f\x49\x04\xd2\x6c\x0c\xfa\x90\x2f\x1e\xfe\x24\x9d\x6a\x85\x33\x72\x9c\xe2\xc7\xed\xb8\x71\x98\x56\xc3\x34\x64\xbe\xe3\x1f\x9e\xc8\x81\x04\xbd\xeb\x5d\x48\xfd\x96\x8b\x9a\x0c\x14\x9e\x61\x0e\xa6\x0a\x93\xbd\x2c\xd5\x2b\x4e\x50\x88\x19\x75\x2f\xde\x21\xca\x80\x86\x0b\x9e\xd6\x43\xfc\xd0\xc0\xe1\x0b\xff\x75\x9f\x1f\xbe\xc5\xfa\x7c\x13\xda\x0c\x88\x90\xca\x87\x0c\x19\xa1\x2e\x6f\xec\x03\x85\xe0\xf5\x50\x99\xce\x2b\xb8\x18\x62\x73\xf1\xfb\xaa\xee\x35\x8c\x78\x0e\x8f\x0c\xe1\x35\xd7\x99\x0e\x4e\xc2\xec\xd0\x93\x98\x9b\xb7\xa3\xa5\xce\x16\xe5\xcc\xb6\x20\xfa\x4c\xf1\xcc\xf4\xbc\xe2\x5b\x82\x80\xcd\xb0\x7c\xea\x96\x93\x17\xfc\xc4\xc4\x00\xe8\x1c\x8a\xe7\xde\xf9\x36\xab\x16\x35\x1e\x96\xf0\x46\x13\x8b\x1d\xef\x4f\x08\x90\xdf\xd7\xc3\xf1\x95\x48\xea\x54\xd7\x50\xd3\xa7\x74\x26\x05\x14\xd0\xb0\x6c\xeb\xf0\x08\x1e\x8e\x56\xbc\x62\x81\xe3\x4e\xd8\x17\x0a\x42\x97\xb1\x93\x0f\x38\x89\x21\x37\x5e\xa8\x77\xd1\x0a\x7d\x97\xd5\x6e\x93\x96\x65\x59\x6a\x23\x7c\x3a\x74\x79\x53\x97\x26\x03\xf4\xe1\xd2\xe5\xcf\x6f\xf3\x18\x3f\x74\xce\x07\x1b\x5c\x31\xe4\xc1\x49\xd2\xc4\x1a\x93\xb2\x49\x8f\x34\xd1\x34\x0d\xd9\x81\x60\xa9\xe5\xbc\x1c\x71\xf7\x88\x01\x64\x17\x8f\x42\xe4\xdd\x93\x47\xe8\xcd\xe8\xac\xea\xa4\xc3\xe8\x8c\xac\x7b\xfb\x1c\x90\x74\x58\x5c\x88\x09\xd7\xd6\xdc\xf1\xc1\xf1\x15\x29\xc4\x92\x95\x28\xdf\x05\xa7\x62\x28\x09\xff\xf0\x85\xe5\xa5\x6c\x6c\xc2\xba\x0d\xa4\xe3\xd2\xd5\x1d\x8d\x34\x12\x1d\xa7\xe7\x8b\xdc\xa5\xf4\xdd\x6d\x54\x2f\xed\xf8\xfe\x14\x0a\x98\x1c\x0e\xff\xf4\x9e\x86\x01\x06\xa9\xa6\x9b\x87\xfc\x31\x3b\x7b\x6c\xd6\x0d\xc2\xdc\xc0\x5e\x3d\x99\xa7\xa4\x36\xd2\x90\xf3\x9d\x84\xed\xed\xf1\x90\xc1\x17\x0d\xdd\xaf\x08\x0f\x47\x11\xa7\x69\x3c\x8e\x68\x39\xf0\xad\x53\x9a\xfb\xe8\x57\xb6\x6c\xb6\x43\x45\x1d\xb0\x68\x14\xb8\xb5\xb3\x49\x10\xa8\xc3\x50\x08\x06\xe0\xc5\x44\x78\xc1\xd3\x2b\x17\x2f\x9b\x65\x69\x96\xed\x4a\xaa\x71\xa8\xc3\x14\x5c\x88\x26\x3d\x16\xbf\xe6\x90\xb2\x2f\xfa\x89\x37\x9e\xda\x64\x12\x62\x2e\x5b\xb2\x31\x91\x0a\x4b\xfa\x4b\xbe\xae\x81\xd5\xdf\x4d\x34\x68\x89\x5e\x35\x0c\x99\x73\xc2\xb6\xe2\x17\x4b\x0c\xab\x00\xd0\xfb\x5d\xbf\x2c\x41\x58\xec\xef\xbd\x23\x8a\xf1\x71\xc5\x88\xee\x2c\x6b\xda\xef\x9b\x23\x8f\x4b\xc2\x10\x6f\x4f\xd9\x59\xd4\x12\x5b\xe9\x48\x30\x6a\x99\xb4\xe4\xce\xf6\xb5\xff\xc3\x26\xd5\xdf\x28\xbd\xbc\x87\xdd\x66\x5c\x37\xe2\x69\xd4\x87\x23\xed\x64\xbd\xdc\xcc\x37\x05\x64\x50\x0a\x31\xce\x88\xb8\x30\xbe\xf6\x01\x27\x06\xd7\xba\x3e\xaf\xee\x43\x4f\xaf\x1c\x0f\x27\x8f\xbc\xf8\xfa\x1d\x28\x67\x73\xc7\xaa\x53\x6b\x49\x13\x45\x16\x8b\x4d\xef\x34\xbf\xaa\xfc\x77\x79\xc1\x12\xdc\x6b\xd7\xa3\x0e\x7c\x1e\x7c\x13\x22\xe5\xc7\x37\xf0\xc5\x3d\xfd\x93\xc7\xf9\x09\x9b\x8e\x12\x45\x49\xa6\xb0\x88\x58\xf2\x91\xe7\x96\x61\x10\x44\xc8\x56\x8d\x36\x7c\xae\x49\xe3\x92\x65\x59\x82\x32\x72\x39\x8b\x27\xa4\x86\x91\x7e\xc2\x29\xc7\xe8\x37\xf1\x4b\x1e\xdd\x5f\x48\x4c\x26\x2b\x1a\xb4\x41\xf1\x9a\xc5\xbb\xca\x80\x80\x58\x5c\xc4\x1e\x93\x82\x16\x96\xd1\x51\x9a\x71\x8f\x56\xdc\x87\x3c\x11\xa5\xa8\xe9\x53\x69\x67\x2a\x18\xf9\x0b\xde\x1c\x0a\xd0\xa2\x11\xf9\xa8\x6c\x1b\xdc\x02\x25\x82\x05\xc3\xfa\xa3\x3a\x59\xf7\xce\xfd\x8e\xc7\xd0\x15\xd7\x78\xee\x63\xc4\xdf\x3f\xb9\x56\x45\xe8\x27\xc5\x30\x12\x7b\xd1\x38\xf7\xf0\x23\xed\xf8\x6c\xe3\x01\x17\x1c\x11\xa3\x7e\x84\x20\x48\x6a\x37\x8c\x7e\xf9\x43\xad\x07\x30\x3e\xaf\x0f\x18\x99\xf4\x21\x23\x1d\x07\x63\xae\x56\xf9\xa8\xa1\xde\x7c\x51\xfa\xe9\xe3\x7c\xdd\x43\x53\xba\x77\x54\xc1\xa5\x8c\xb1\x1d\xef\x82\x0a\x84\xb7\x6d\xef\x3c\xcd\xdf\x06\x47\x5a\xb6\x74\xd3\xaf\x98\x92\xbb\xf1\xbb\x3c\x4f\xca\x34\x81\x55\xdf\xfa\x97\xc6\xcb\x66\x0d\x41\x0c\x81\xe2\xfc\xfd\xd2\x09\xad\x05\xa3\xd0\x47\xf9\x78\xfc\xee\x9b\x55\xab\x06\xc2\x57\x4b\x24\xe5\xf2\x7e\x73\x0f\x86\xa6\xa9\xc7\xfe\x78\xb5\xe2\x50\xf4\x4b\xd2\x0b\xd4\xb4\x79\xa1\x78\xd4\xfc\x1b\x72\xeb\x41\xa2\x77\x08\xc5\x60\x3f\xce\x6d\xe4\xed\xaf\x4f\x81\xe1\x5e\xbd\x1b\xeb\xfc\xdd\x84\x97\x49\xde\xf5\x5b\xff\x78\x7c\xed\x79\x4e\x23\x6d\x0a\x65\x2f\xff\x65\x2b\xae\x72\xe5\x9f\x0a\x3b\x5b\xfa\x25\x74\xc5\x36\xb5\x1d\x3b\x4f\x64\xd4\x5e\x99\x0c\x88\x56\xbc\xab\x2a\xc7\x30\x40\xb0\x6d\xe9\x46\x46\x03\x12\x42\x99\x26\x23\xf2\x27\xe7\x51\x0a\x23\xfc\xba\xf4\x1b\x36\xbe\xff\x34\xe7\x38\x11\x8f\xb9\x5b\xb2\xbf\x69\xe5\x99\x40\xde\x4a\x3d\xb9\x74\x0d\x0a\xf7\xee\xb4\xc4\x72\xc2\x38\x8c\xc1\x7d\x86\xad\xd8\x16\x39\x9d\x16\x0b\x02\x2c\x9a\xe5\xe8\x90\x2b\xb9\x24\xb4\x1b\x9d\x76\xef\xa8\x9c\x78\x7c\x73\x88\xf6\x7f\x33\x2e\x8e\xc5\x95\x68\x3e\xb0\xe5\x19\x3a\xcf\x0a\xef\x0f\xac\x33\x30\x6d\x72\x43\x01\x7f\x72\x68\x3a\x38\x4d\x66\x74\x27\xa9\x1a\x0d\x89\x44\x2b\x19\xf6\xb3\xc4\x42\x37\xee\xc9\x7d\x3c\x0e\x64\x59\xd2\x6b\xb7\xb1\xb9\x77\x55\x07\x3f\x9f\x1d\x4c\x37\x0f\x91\xad\x3e\x3f\xfd\x23\xac\xc2\x50\x29\xc4\x40\x15\x9d\x56\x88\x5e\x44\x14\x75\x5a\x21\xf7\x3a\xee\xb0\xb0\x4d\xd5\xb2\x12\xd0\x73\xf2\x5d\xbe\xb8\xe2\x9a\x24\x0a\xe1\x5d\x85\x3f\xb6\xc0\xb4\x9b\xdb\x8c\xc9\x5b\x30\xa9\x56\x18\x51\xd9\xfd\xdd\xd3\x9f\x7b\x9e\x73\x3f\xec\x69\x8e\xdd\xa8\x81\x4a\x2f\x4f\x05\x61\x87\x59\xea\xca\x84\xef\xf2\xb7\x78\xbf\xcb\x57\xca\x83\xb6\x93\xc8\x0e\x17\x58\x6f\x7d\x62\x0d\x66\x12\x94\x71\x62\x7e\xde\x09\xc5\x1c\xe7\x4a\xdc\x14\x24\x1d\x60\x1b\x8a\x8d\xa7\x7b\xa2\x41\xce\xdf\xb8\x7e\x51\xbe\xcb\x4f\x16\xd2\xdc\x5b\x0e\xd1\xbd\xe6\xbd\x4d\x7b\x55\x34\xed\xe3\x2d\x73\xf2\xd6\x07\xea\x9b\x4f\x10\x1b\xa8\x74\x5b\xb0\xe4\x13\x0b\xb7\xd2\x9f\xa5\x72\x4e\xca\x76\x18\x3e\xeb\x80\x19\x4f\xd9\x6b\x19\x7d\x84\x51\x4f\x86\x8c\x92\xbb\xfb\xdf\x7c\x56\xcf\xfd\x9e\x3d\x24\xf2\xdd\x0d\x61\xda\x0d\x68\x32\x45\x52\xc0\x4e\x72\x57\xbc\x5c\x9e\x37\x57\x4f\x10\x4a\x42\x6e\x5f\xc0\x8b\xa2\xed\xd9\x58\x57\x18\xd4\xe6\xc9\x70\x87\xb4\x21\x42\xc1\x76\xe5\x8b\x52\x10\x1d\x09\xb7\x3c\x7e\xee\x2b\xe2\xca\x1f\xcb\x19\x37\x8b\x0c\xf6\x5d\x5f\xe0\xb5\x81\xc3\x0a\x4e\x1b\xf8\xca\xdd\x55\xc3\xd0\x6f\x6f\xc5\x37\x3f\x99\x64\x5e\x66\x07\x8c\x18\x16\xcc\x28\x27\x0d\x2e\xe8\x21\x61\x2c\xed\xb1\x45\xb1\xcb\x2c\xc3\x00\x31\x42\xdd\x10\xda\xce\xfa\xb2\x53\x7d\x9b\x16\x02\xa6\x51\xfa\x34\x9f\xf9\x17\x0f\x34\x8e\x7e\x26\xb7\x09\x9f\x1d\xa5\xcc\x8f\xc5\x19\x57\x34\x8a\x10\x65\xfc\xa0\x69\x87\x7d\x1a\x33\x9a\x5e\x99\xce\xc7\x59\x86\x12\x31\x94\x84\xd7\x11\xa6\xd8\xd0\x66\xee\xa0\xf9\xad\x54\x66\x35\x7f\x33\xdd\x46\x4a\x6d\x87\xbf\x5e\x06\xcf\x77\x0d\x0b\xd6\x30\x14\x33\xb6\x4c\x41\x2a\xdc\xea\x84\x8b\xf9\x6d\x21\x95\x7b\x3e\x3a\x0a\x9f\x67\x16\x9f\x79\x30\xa3\x99\x89\xee\x6d\x2b\xa6\x74\x57\x5a\xaf\x5e\xe5\xa4\x77\x9c\xde\xd4\xc0\x83\x6c\x0d\x38\xc8\x10\x82\x48\x6d\x27\x78\x7b\xcd\x1e\x7b\xc2\x0b\xbb\x35\x57\xa2\xc8\x95\x8b\x7e\x9a\x07\x04\xc6\xb9\xea\xaf\x1c\x66\x84\x24\x82\x33\x6a\x9e\xf7\x67\x3e\x5e\xbe\x7c\xfe\x87\xed\x9a\x6d\x32\x85\x01\x60\x0c\x91\x8f\xbe\xfd\x20\xbd\x6c\xd6\xf8\xf9\x49\x9b\xe3\x3d\xa4\xcf\xb2\xdf\x36\x2a\x76\x51\x88\xb6\x1d\xcd\xc2\x81\x6e\x61\x8a\x13\x46\x4e\x43\xa4\xa9\x3b\x1e\x93\x33\x21\x50\x54\x13\x79\x70\xdb\x99\x9e\xd2\x3b\x8a\x5a\x4f\xe8\x81\x6e\x49\x98\x2a\xd5\x6a\x22\x80\x7e\x75\x1c\x1d\xcc\x5c\x4d\x3c\xdf\xdd\x9b\xce\x8a\x17\x9e\x61\x54\x32\x53\x3a\xc7\xf5\x34\x93\x1a\xa5\x58\xf8\x73\x67\x0d\x97\xdf\xed\xc2\x79\xa3\x2a\x51\x14\x80\x9d\x74\x93\xd0\x2d\xe9\x51\xed\xac\xe6\x28\x5c\x2c\xd2\x3a\xb5\xdd\xe1\x3b\xff\x33\x96\xa0\xde\xb2\xb4\x1b\xa8\x0c\xb8\x19\x20\x3d\x11\x7e\x06\x27\x76\x8b\x01\x38\xdb\xc4\x2a\x92\x5a\x89\x9c\x70\xca\x36\x8c\xea\xb6\x69\x8a\xd2\xb0\x25\x26\x9a\xce\x46\x22\xf5\xea\x48\x6b\x4f\x7f\x7b\x3a\x8a\xdb\x0d\xe1\x8b\x4b\xc6\x7d\x5f\x36\x67\xc9\xec\x62\x31\x31\xf4\x40\x22\x6d\x44\xe2\xd9\x54\xf0\x69\x5c\x87\xd9\xed\xb0\xa9\x41\xa8\x64\x85\xd2\x4e\x3a\x61\xc8\x5f\x27\x24\x1d\xf8\x72\x0f\x96\x4d\xef\x9e\x2d\x4a\x92\xb2\x25\xbf\x3a\x48\x9a\x16\x67\x00\xcc\xa6\x65\x97\x5f\x19\xf2\x63\x9d\xfb\x00\xb0\x2f\x29\xf4\xa8\x0f\x90\xe3\xd2\xab\xc7\xba\xe3\x30\xb3\x1f\xf9\xc5\x1c\x2b\x30\x54\xb8\xba\x0b\x1e\x3c\xcc\x77\x9f\x42\x80\x62\xc8\x87\xcf\x1d\x3c\xea\xb2\x1f\x5e\x8a\x6d\x0c\xeb\xbc\xee\x35\x7b\x4a\xf1\x89\x05\xbd\xda\x28\x90\x2d\x24\xb2\x3d\x77\x74\x99\x42\x84\x21\xe9\xd8\x23\xa1\x6a\xe4\x44\x53\xec\x24\x38\x6d\xec\xdc\x29\x7b\x4c\x3e\x66\x1a\xb8\x88\x59\x9b\xfc\xd1\x97\xe2\x61\x30\xd5\xb2\xac\x77\x86\x93\xd6\x49\xb9\x7c\xaf\xb2\x13\xae\x28\x16\xc1\x8b\x5c\x8d\xe3\x10\x3a\xc8\xbe\x0a\x1f\xdc\x5f\xa8\x2d\xa1\x20\xb0\x11\x15\x45\x51\x14\xdc\xcf\x1b\xb3\x45\xa8\x54\xda\x57\x62\x5d\x7d\xd3\xcb\xe4\x15\x02\x6f\x39\xc6\xbd\x48\xce\x8a\x76\x73\xf8\x99\xdb\xd9\xe3\x33\x7d\x0b\x7b\x7c\x3f\x13\x76\xbb\x74\xa0\x04\xa2\xc2\x9e\xae\x5e\xfc\xdb\x6b\x46\x24\x71\x6e\x5d\x42\xc1\x8c\x62\x45\x54\x87\x20\xdb\x49\xe7\x9d\xd1\x9d\x4b\x33\x6b\x03\x04\xb2\x96\xd9\x28\x5c\x60\x20\x2c\xdb\xb0\x2b\xef\xec\x72\x62\xe6\xca\x3a\x3f\xc3\xc5\x69\x57\x59\xb5\x6c\x58\xeb\xa3\x3b\x43\x0c\xf2\xe0\x4d\x78\x9f\x62\xef\x97\x07\x3f\x97\x3b\x4e\x6f\xb2\x5c\xe2\x5b\x14\x45\x04\x14\x77\xca\xf9\xc2\xf4\xf3\x44\xb0\x94\xc2\x4c\xd8\x53\xa6\x13\x42\xdb\x05\x09\xa0\x4d\x0d\x3a\xed\x19\xd4\x11\xbb\x98\x39\x63\xa4\xc8\xdd\xa4\xb7\x9a\x90\x2c\xfa\xf5\xa1\x75\x2e\x44\xe2\xd9\x39\x2a\x38\xe3\x9e\x05\x7c\xfb\x8a\x27\xd1\xe2\xc2\x40\x1f\x00\x33\x99\xa7\x18\x28\x81\xaf\x3a\x59\x50\x2f\xd0\x33\xd9\xf0\x0a\x05\x69\x8d\x56\x3c\x49\x98\x33\xbd\x9c\x22\xc4\xc0\x70\x58\x19\x77\x12\x4c\xc7\xc6\x15\x93\x41\x41\x9f\x28\x63\xd9\x8f\x0a\x7b\x4c\x90\x59\x0f\x4a\x88\x2e\xeb\xe2\xb4\xdb\x4b\xc1\x8d\xe6\xfb\x7d\x8c\x4f\xcf\xdf\x42\x1a\x6b\x6f\xfe\x8c\x45\xcb\xa7\xb7\x15\x8b\x80\x69\xdd\x82\x19\xca\xa7\x8b\xcb\x96\xc0\x16\xf2\x24\x18\x53\xaa\x8f\x76\x18\xd3\x6e\x20\x5f\x82\x2d\x48\x8e\xdc\x04\x87\xed\x0a\x5b\x3b\x19\x3b\xb7\x32\xc8\xda\x33\x4f\xff\xe9\xb3\x99\x9c\xdf\x8a\xdf\xfd\x35\xd0\x07\x85\xd0\x6d\x9b\x08\xae\x2e\x2d\xa2\xa0\xa8\x02\x5a\x57\xb9\xec\x87\xfb\xc0\xb8\x24\x6d\x70\xc8\x93\x09\x23\x4b\x0e\x2d\x09\xa6\xc8\xd0\x2f\x06\x08\x98\x12\x7e\x24\x28\x95\xf4\x81\xb0\x5f\x89\x42\x74\xf6\x52\x11\xc9\xb2\xdb\x61\x1c\x71\x89\xca\x9d\x5c\xd5\x4b\xcc\xc0\x6e\x80\xbe\xa1\x48\xf6\x71\x06\x32\x6d\x23\xad\x85\xd6\x6f\x99\x7f\xc5\x74\xc0\xfb\x8d\x7b\xc1\x1a\x95\xac\xe8\x3a\x91\x6b\xa6\x61\x0c\x74\x65\x7f\xf5\x82\xf5\x0c\x38\x59\xab\x44\x8a\x9c\x0e\xc4\x53\xb9\x9e\x5c\x3b\xa5\x29\x47\x96\xc6\x5f\xc9\xed\x2b\xb7\x40\xbf\x51\x3b\x4e\x66\xab\x50\xed\xf5\x76\x56\x97\xe2\xfa\x5d\x06\x3b\xa1\xe0\xf6\x9a\x72\xd4\xc3\x11\x6f\x86\x8e\x9d\xfd\x6c\xd0\x50\x4d\xdd\x34\x34\xc1\x09\xe8\x41\x39\x64\x62\xf0\x92\xe9\xb4\xf3\x59\xe7\x68\x21\x5e\xaa\xda\xae\x26\x42\x66\xec\xdc\xc3\xc6\x40\xb5\xf7\x70\x91\x1a\xef\x33\x78\x5a\xb5\x65\x65\x19\xa6\x4d\x26\xba\x60\x09\x15\x31\x6f\xa8\x1b\xa8\x84\x3a\x51\x68\x2a\x9a\x4c\x17\xee\x85\x2f\x1c\x77\xfb\xfd\x4c\xbb\xc1\x49\x22\x8f\xee\x60\x44\xd7\xf7\xfd\x0a\x61\x48\xb9\xd7\x90\x3a\x2c\xc6\x3f\xf5\xb3\x8f\x1a\xd7\xa6\x37\x0d\x8d\x93\x74\x41\x1b\xd5\x02\x98\x50\x14\x25\x24\xcb\xae\xa5\x33\x8a\x3a\x62\x8d\x5a\x02\x8a\x33\x54\x00\xec\x44\xaa\x2a\x97\x93\xd0\xec\xea\xc2\x01\xb9\xe3\x28\xe7\x62\xa9\x6b\xae\x5a\x29\xe6\x48\x8e\x93\xf1\xc6\x07\xf9\x1e\x33\x58\x7b\xa6\xe9\x92\x26\x62\x56\xde\x6c\xbe\xf0\x5b\x3e\x9c\xb0\xe8\xb6\xbf\x20\x83\x28\x16\x2a\xf5\x36\x48\xc3\x37\x87\x7c\xee\x73\xc8\x40\x67\x5a\x13\xae\xd3\x5e\x29\xa9\x1e\x11\x71\x6c\xc8\xa1\x10\x36\x90\xfa\x61\x67\x68\x15\x35\x4f\xec\xdd\xf7\x2e\xcd\x1e\x4a\xfe\x15\x67\x12\x8e\xf9\xca\x77\x4b\x7c\xf8\x5e\x20\x7d\x2d\x1d\x59\xb2\xb6\x8e\x22\x1b\xd0\x3d\x83\x09\xbd\xaa\xba\x34\xd3\xcf\xc4\xc7\xf0\xe4\x1d\xc8\xf5\x8c\x66\xba\x50\x21\x47\xa0\x3f\xcb\x77\x2a\x66\xc6\xfb\x91\x0a\x2b\x01\xeb\xd5\x4a\xad\xbd\x74\xec\x2d\x08\xe6\xb2\x46\x34\xcb\xa9\x79\x6a\x0f\x27\x87\xc0\x80\xe7\x76\x16\x81\x87\xcf\x77\xb7\x93\x30\x7b\xdd\xcd\xde\x50\x6c\x11\x8a\x3a\x75\x15\x87\x5e\xc1\xa4\x8d\x30\x22\x59\x23\x39\x4c\xd8\x93\xc4\x4e\x5d\x0b\x27\x2d\x94\xe9\x49\x11\x8f\x47\x95\xde\x6e\x7c\x9e\xec\xd2\x8f\x7c\x06\xee\x33\x85\xd3\x18\xd6\xde\x13\xf3\x38\x8e\x0b\x76\x28\x65\x32\x77\x8a\xb2\x38\x1d\x67\x95\x45\x50\x3a\x35\x9e\xbb\x85\x9d\xc7\xf0\x4e\x32\x5e\xa1\xbb\x10\x09\x37\x78\xdd\xb8\x1c\x85\xd6\x8b\xf1\x5d\xf1\xb6\x17\x76\xde\xde\x70\xf0\xcc\x36\x5a\x00\xbb\xe8\xf0\xa6\xc7\xb9\xdc\x92\x66\x00\xb6\xb8\x93\xa1\xe7\xc8\x71\x68\x09\xf7\x39\xd1\x47\xc6\x63\x6b\x04\x26\x9b\x98\x57\xc4\xc4\x19\x2d\x9a\x3f\xf3\x16\xd8\x5b\x36\x37\xf9\xdc\x1c\x24\x72\x58\xcc\xea\x36\xd3\x08\x2e\xb5\x50\x60\x7c\x57\xcb\xb1\x6e\x2b\xda\xa1\xfc\x08\xaf\x6f\xae\xf5\xb3\x37\x30\x0a\xe7\x30\x80\x31\xf8\x18\x0f\x9e\x81\x5c\x5e\x0b\xa7\x71\xdc\xdb\xc2\x3c\x26\x0a\x14\xd0\xc2\xd2\x6e\x6a\x73\xf6\x62\x98\x60\xf3\x29\x8c\x08\xba\x49\x25\xf5\xb3\xa6\x01\x18\x5b\x8c\x81\x3e\xef\xed\x1e\x4f\x0e\x79\x05\x9a\x8f\x57\xc6\x7c\xce\x00\x9a\x69\x21\x67\xa6\x47\xff\xe4\xd1\xe3\x07\x1e\x4a\x99\xde\xc9\xa8\x05\x18\xfe\xe3\xbb\x9f\x34\xdb\x21\xee\x93\x71\x8f\xab\x12\x40\x14\x15\x4c\x0a\x12\x08\x9a\x04\xa1\xf8\x84\xc2\x5b\xa7\xa4\x71\x1e\x9d\x5b\xaf\x16\xed\xd3\x27\x74\xab\x1d\x06\xa5\xf4\x11\x8f\x0a\xa0\x1c\x8e\x9c\x11\x49\xa5\x98\xf1\xb9\x3e\xab\x0e\x0f\xe0\xd4\xcc\x40\x74\xa3\xbf\x9f\xa4\xe2\x91\x4c\x3a\x7b\x25\x06\x56\x2e\x8c\xa6\xac\xb9\xdf\x3f\xb3\x99\xfb\xce\x0f\x9f\x26\x97\x9b\x68\x36\x91\xab\x9d\x69\x5c\x4d\x8c\xd1\xfd\x93\xeb\x21\x08\xa0\xf6\x08\xde\x33\x1a\x0d\xef\x65\xd5\x22\x17\xa9\x69\x86\x69\x0a\xa5\xa4\x4a\xa2\x55\xf9\xdc\x55\x88\x39\xb4\x6e\x1a\x1d\xf5\x48\x08\x0a\x96\x34\x50\x88\xe9\x91\xed\x10\xea\x03\x12\x0c\xc3\xd8\x66\xcc\xc2\x76\x85\xd0\xa0\xcb\x01\x7f\x5d\x92\xd9\x1c\x88\x5d\xf0\xe7\x27\x19\xf7\xe7\xad\xf0\x7e\xf2\x0f\xf6\xc4\x12\x98\xe9\x49\xbd\xa2\x7b\x88\x0a\x16\x7b\x9c\x67\xd1\x48\x8d\xdd\xaa\xe8\x99\xc0\xbe\x85\xef\x33\x40\x4e\x5a\x17\xce\xb4\x88\x12\x77\x47\x26\x82\x2b\xef\x32\x6f\xf9\xc3\x92\xc3\xb0\x2e\x54\xac\xca\x0e\x9f\x31\x3c\xcc\x6a\x66\xe4\xa8\xba\x0a\x87\xe5\xc7\x96\x65\x4f\x45\x1b\x16\xed\x14\xa3\x4b\x0e\x34\x26\x91\x89\x06\x4a\x28\x21\xb4\xc1\x0c\xf7\x52\x1e\xf9\xf3\xcb\x29\x89\x5f\x77\xa2\x76\xcb\xd7\xdf\x79\x22\x38\x88\xeb\xd4\xd3\x69\x1c\x2c\xa6\xa0\x20\x49\xd2\xad\x16\x34\xbe\x9d\x31\x6c\x91\x11\x9a\x48\x32\xb9\x6e\x59\x54\xaa\x7a\x7c\x55\x0e\x5a\xa9\xe6\x76\xf1\x94\xfa\x08\x15\x09\x12\xb0\xa9\xae\xed\xa4\x16\xa6\x27\x34\x6d\xa4\xd2\x81\x8d\x2e\x34\x7b\x24\xab\x21\xe8\x13\x41\x14\x32\x84\x30\x24\xe2\x24\xf6\xc5\xaf\x5e\x79\xfe\xc5\xff\x35\xf0\x49\x27\x58\xab\x6c\xec\xa8\x90\x95\x88\x73\x27\xc0\xed\x94\xa4\x32\x9f\x0a\x6e\xd4\x1b\xf7\x58\x9a\xf6\x91\xcd\x19\xd0\xf5\x31\xa9\xc5\x88\xc4\xfc\x07\xb9\xd2\xc9\x27\x06\x2e\x5d\xc5\x4d\xf0\xac\x19\x5a\x67\x71\xcb\x1d\x93\x90\x21\x6a\x29\x88\x6e\x18\x60\xc6\x7b\x42\x70\x8f\x5d\x0d\xdd\xe6\xde\xed\x68\x2a\x5d\x77\x71\x9e\xfc\xd7\xba\x6f\xeb\x15\xfb\xb4\x6c\x44\x3d\x38\x72\xb2\xc2\xb8\x96\x0d\x9a\x92\x8f\xc1\xdd\xe7\xe8\x71\x70\xd0\x00\xeb\x13\x40\x4e\x17\xb8\x08\x13\x2b\xa8\xc4\xd5\x83\x6c\xd1\x40\xd9\xd9\x8e\x89\xf8\x72\xf7\xc7\x78\x24\xe0\x97\x96\xcf\xd4\xfe\x98\x9a\xd3\xc6\x34\x0c\x20\x07\x72\xe5\x2a\x7f\xee\x5a\x6b\x39\x47\xe1\x1d\x1c\x33\xbd\x51\xb3\x9b\x67\xcb\x60\x0b\xe2\xa7\x5f\xf6\x46\xe3\xd8\xc1\xb1\xdd\xfc\x34\xf1\x51\x11\x0f\xb1\x0c\x0d\x42\x80\xdb\x11\x91\x8f\xd9\x41\xb9\x07\x63\x58\x18\x73\x86\x0c\xb3\x45\xfa\x69\xd3\xf7\xf4\xa6\x3e\x3b\xd9\xfb\xe4\x09\xeb\x4e\x0e\xc9\xbc\x03\x6d\x3f\x99\xcc\xcb\xa0\x64\xd9\x4c\x42\xac\xdd\xda\x30\xe1\xe5\x9b\x05\x51\x0b\x8d\x69\x0e\x6a\xd8\x06\x8d\x5f\xb1\xb9\xf7\x65\x7f\x69\xc4\x18\x2a\x74\x0e\x64\xbe\x2b\x3c\xa8\x32\xe0\xd1\x95\x2b\x6e\xde\xd5\xec\x89\x4c\xd8\x8e\x64\xc2\x1e\x2f\xa2\x3f\xb7\x1c\xc8\x42\xf1\xa3\x62\x6c\xa7\x1d\xf3\x95\x6f\xd9\x9b\x93\x39\x52\x98\x49\x88\x52\x1a\xe0\x31\x4a\xa6\xea\x44\x97\xb1\x71\xfa\xf3\x1b\x40\x10\x06\x89\xe9\x4d\xf3\xfb\xf9\x66\xe9\xc5\xea\xe8\xcf\x59\x69\x6f\x3f\xef\xce\xde\x59\xee\xf3\x51\x39\x7e\x68\x32\xb8\x48\xae\xb0\x7e\xd2\x85\x68\x28\xc3\xb8\x83\x31\x7a\xf7\x94\xe4\x46\x58\x8e\xd0\x61\x36\x8a\xa1\x69\xda\xb7\x04\x7c\xe7\xc7\xa4\x7a\x85\x67\x2c\xcb\xe5\xcc\x31\xef\x66\x2e\xb1\x3c\xb9\xe7\xb8\xce\xdd\x7a\xed\xd6\x09\xbd\xad\x7b\x02\x35\x37\x49\x96\xa6\x9e\xe3\x20\xf8\x3b\xf2\xc5\xf1\x98\x17\x58\x1f\x30\x35\xa7\x02\x2c\x51\x8a\x58\xb9\x54\xd6\x37\xf7\xe5\x43\x30\xba\x7b\x31\xd8\x1e\xf4\x7a\x79\xea\xbd\xa6\x63\x03\x7d\xee\xae\x6a\x0e\xd2\xa1\x56\x2f\xfa\x29\x34\x4c\xe4\x21\x19\xa8\xf7\x93\x76\x23\x1f\xbf\x33\x58\xac\x33\xc2\xc0\xb8\x6e\x2b\xd9\x77\x9b\xe8\x62\xb2\xcb\xb6\xfe\x5c\x9c\x31\xcf\xee\x75\x27\xc1\xd9\x0d\xf6\xc0\xd1\xef\x34\xd6\x71\xf4\x86\x4e\xe2\xd0\x78\x58\xb0\x6a\xd8\x5a\x12\x59\x98\x3e\x93\xc7\xeb\x0f\x36\x7b\x7e\x57\x88\x54\x69\x8e\x96\x54\x01\xbc\x71\x2f\xd8\x48\x21\x88\xc1\xd4\x93\xdd\x77\x73\x91\xc6\xc5\x6c\x8f\xee\xa4\xe8\xac\x84\x00\xa4\xc9\x37\x90\xad\x7c\x3b\x86\x8f\x42\x34\xe1\x45\x7b\xb3\xe0\x8e\x4f\x5e\x75\xcb\x40\x8f\xb1\x0e\x88\x2a\x01\x60\xe2\x15\x6b\x74\x1c\xf9\xd9\x2f\x10\xab\x0c\xd9\x84\x62\x80\xa2\xe2\x4e\x32\x43\x9e\xb4\x1b\x3e\x95\x36\x28\x57\xec\x91\x96\x5f\xf7\xf3\x2b\x87\x4f\x2a\xa7\x2b\x0f\x1f\x5b\x70\xa8\x7d\x65\xda\x11\x5f\xb7\xdd\x70\x77\xcc\x41\x96\x02\x89\x72\xc6\x19\xd1\xcb\xe0\x39\xca\x63\xd9\xef\xee\x4a\x20\x00\x10\xaf\x84\xcb\x61\xed\xce\xd8\x26\xec\x25\x85\x37\x3a\xe6\x67\xee\xe9\x54\xcf\x14\x72\x24\x19\x7e\x87\xcd\x13\xd7\x98\x2d\x14\xb8\xcf\x8d\x01\x60\x6b\x58\xe6\x88\x31\xa9\xce\x80\x0f\xec\x6d\x16\xe0\x94\xfd\x4a\x97\x53\x77\xe7\x8d\xa6\x1d\x50\x13\xb5\x24\x7c\xc7\xd8\x36\x55\x3d\x3d\x07\x71\xf9\xc2\xbf\x72\x76\x6c\xd1\x09\xfa\xdd\x86\x84\xa7\x7d\x25\x0a\x03\x50\xd5\x35\xac\x20\x83\xfc\xaa\x69\x43\xd9\x4b\xb6\xe0\xb6\x5a\x32\xba\x6d\x17\xaf\x54\x7f\x0c\xd0\x00\xec\x1f\x97\x6f\x43\x55\x63\x27\x67\x7c\xe4\xb7\x6e\x62\x48\x5a\xe5\x6e\x58\xb4\xa0\x65\xa2\x5a\xd7\x5d\x3f\xbb\xf9\xcc\x34\x79\xbb\xae\x05\xdc\x05\xcb\xe7\x33\xfd\x83\xdd\xef\x3f\x5c\xaf\xea\xfe\x76\x91\x95\x3a\xef\xfa\xe8\xc8\xa3\x89\xe7\x5e\x99\xc8\x77\x6c\x01\x29\x00\x9b\xe1\x51\x8c\xdd\x22\xdb\x4b\xb4\x1f\xcc\x75\xbd\x4a\x47\xb0\x54\x84\x09\x19\x47\xb5\x52\x65\x0c\x8b\x85\x4a\x7c\xf2\xa6\x1b\xfb\x09\x8d\xda\x80\x4e\xc1\x00\x67\xd1\x31\x92\x81\x27\x21\xf5\x60\x3d\x86\x51\x63\xf0\x7c\xd3\x19\x4f\xc6\xcb\x60\x0f\xb4\x54\x5a\xc0\xfd\xa8\x9f\xfb\xef\xb9\xb5\x44\xd8\x6e\x5b\x2d\x74\x0f\x4a\x3f\xe6\x51\xce\xfb\xd8\x9a\x3e\x39\x04\x40\x49\x5f\x77\x65\x4e\x80\x42\x08\xda\xbb\x81\x50\x0b\x03\x44\x27\x6f\xeb\x92\x1d\xf8\x64\x36\x8b\xf2\xc2\x67\x85\x52\x08\x0d\x80\xf4\xe3\x09\xd5\x8e\x45\x0b\x96\x65\xdd\x1e\xfa\x56\xc4\xf2\x11\xa5\x4b\xb6\x18\x39\xe1\x86\xbd\x33\xb0\xbf\x3a\x25\xaa\x89\xe7\x2b\xaa\x3c\x65\x63\x9e\x60\x22\xd9\x9c\x2b\xbe\xe2\xb6\x77\xff\x41\x66\x96\x6e\xe5\xb8\x6c\xe8\x39\x8d\xf7\xf2\x9e\x76\x6c\x9e\x61\xd4\x48\xae\x23\xb6\xad\x59\x5f\xa1\x61\x04\x8f\x6a\x5a\xfa\x9d\x84\x81\x1d\xe1\x8d\x72\x91\xdd\x7e\xe6\xee\xec\x76\xd1\x60\x8b\xac\x51\x8f\xe7\x7b\x54\x25\x54\x01\x00\xfd\xc8\x40\x86\xf3\x5a\x19\x79\xb9\x74\xa4\xdd\x4d\x6e\x6a\x40\x11\xcc\x12\xb9\x48\x5e\x98\x95\x3d\xd6\xf2\xfd\xb3\xd4\xc2\x64\x4e\x23\xb1\x62\xa6\x58\x1a\x3f\xf9\xb4\x7a\x8f\x2f\xf3\xce\x94\xd4\x9a\x0e\xe5\xc7\x07\x4f\xa3\xcb\x3d\x6f\x64\x20\xe8\x66\x97\x31\x12\x9c\xc6\xe7\xeb\x4e\x8b\x31\x51\xd0\xc7\x1e\x82\x19\xac\xc6\x0b\xd6\x38\x5f\xb4\x6b\xa2\xf4\x9f\xb7\x65\xba\x0f\xd6\x36\x2f\xe7\xb7\x9c\x20\x19\xce\xf7\xf3\x04\x98\xf7\x60\x8c\x8a\xc8\x15\xa3\xfc\xcc\x93\x9c\x08\xfd\xe3\x44\xa5\x13\x90\x73\x92\x4d\x7c\x68\xfa\xe2\xd2\x9d\x74\xf4\xdc\xd5\x60\xf6\x51\xd0\xde\xe4\xe3\x8b\x8e\x55\x33\xca\x66\xd2\x29\x2e\xef\xe0\x61\x0f\x62\xde\x00\x70\xa7\x0f\x5a\xe5\xd8\x83\x06\x30\x44\xea\xd5\xc6\xb7\x01\x0a\x61\x0d\x79\xd9\xe9\xa2\xea\x90\x1b\x43\xb6\x19\xe0\xcd\x98\xb8\x7c\x48\xac\x25\x27\x44\x47\x1f\x69\x24\xb4\x41\xe9\x43\x06\xb6\x65\x1b\xe8\x12\xfc\xb6\xc6\xa6\x94\xc3\x11\x7e\x73\x91\x25\x4c\x56\xd8\x26\xec\x67\x72\xbb\x2c\x9c\x02\xe8\xd8\xe2\x69\x7c\x27\x8b\xd5\xcf\xfc\x4c\x8e\xed\x0c\x0b\x65\xd4\x9e\xee\xad\x26\xe8\x17\x66\xcd\xb2\x6c\x1a\x38\xb0\x17\x2f\x5a\x23\x62\x37\x10\x3a\x03\x0b\x1b\xc6\x73\x90\x00\x26\x53\x47\x7e\xf9\xb9\xd2\x97\x37\x67\xef\xb7\xbd\xeb\x30\x2a\xd4\x36\xa7\xbb\x11\x39\x2e\x88\x84\xd2\x1c\xa4\xb4\xe8\x7e\x54\x04\x23\x85\x01\x0a\xcb\xb0\x99\x16\x8a\xc7\x58\xfa\xa5\x78\xa8\xe5\x0b\xa2\x9e\x30\x3d\x31\x37\x56\x4b\xe5\xdf\xcf\x27\x63\x32\x8a\xa0\xdc\x3c\x22\x20\xaf\xbd\x7b\xc6\xac\x9c\x27\xb3\x2d\x4e\x0f\x68\x31\x45\xb4\xc8\x2b\x23\x39\x5d\xc6\x10\x77\xa3\x58\xa0\x11\x0c\x40\xee\x70\x93\xe3\x4e\x3b\x91\xe7\xe8\x40\x1a\x53\xc6\xde\x73\x0f\x26\x2d\x28\x1e\xe3\xec\xac\x8b\xa2\x50\x14\xfc\x88\xd7\xd9\x1c\xde\x4d\x30\x39\xe1\xb5\x17\x85\x34\x42\xdb\x42\xa5\x9d\x0b\x31\x92\x9d\xef\xd7\x9e\xbc\x32\xfa\xc9\xfe\xa1\xa6\xc0\x47\xdf\x35\x0b\x66\x22\xe5\x16\x06\x37\x1a\xd3\x8f\x42\x29\xd0\x36\x69\x83\x86\xa0\x41\x6f\x1b\x80\xea\x78\xbf\x6d\x66\xfe\x29\x0a\x23\x9d\x55\xba\xd7\x95\xc3\xb3\x98\x86\xf1\x59\xbc\x82\xbb\xc0\xaf\x13\x02\xad\x56\x1d\x18\x4f\x70\x7c\xde\x01\xfd\xe4\x0b\x3f\x84\x68\x46\x51\x92\x68\x03\x9f\x6e\xa7\x41\x56\x1f\xaf\x6f\xb2\xe3\x10\x72\x8e\xa3\x4e\x86\xd3\xef\x8e\x24\x3b\x8d\xd9\x9d\xa5\x81\x31\x84\xae\xbe\x84\x9f\x7e\x86\x27\x87\x12\x1a\x1d\x3d\x6f\x57\x7e\x1b\x9c\x28\xb1\x8c\x50\xcc\x48\xd0\x90\x0a\x3d\x99\xf2\x34\xf1\xdc\x2a\xd0\x75\x1c\xaf\xab\x0a\xf9\x3b\xd6\x66\x40\xd3\x6e\x73\x93\x8b\x47\x97\x4e\xe4\x5d\xe1\x9e\x72\xc2\x8d\x89\x42\x01\x18\xae\x33\x68\x24\x27\xcb\x89\x0d\xee\x73\x88\xb6\x1c\x97\x18\x96\x27\x70\xe2\xf9\xa9\xe1\x49\x8f\xe2\xd1\x1f\xea\x29\xd5\x46\x2f\xd1\x71\x27\x9b\x6f\xec\x7d\x8f\xbf\x72\xb7\xb8\xe0\xb9\x8f\xa6\xbe\x5b\x4a\xd3\x61\x00\xa7\x33\xe2\x50\xb1\xaa\x81\x10\x26\x25\x01\x87\x3b\x77\xfa\x53\x25\xe8\x76\x17\xda\xb9\x6a\x76\x20\x94\xc6\xf3\x01\x96\x63\x97\x5a\xdd\x6f\x32\x42\x21\x31\x06\x27\x2f\x46\x5b\x6f\x40\x22\xb7\x68\x4b\xd1\xb1\xc1\x8b\x97\xca\xb1\x64\x62\x74\x7b\x70\x3e\x1a\x80\x43\xda\xca\x77\xc6\x9b\xcc\xfc\x69\xde\x4e\x75\x97\xd4\xa0\x93\x68\x4e\xf8\x4a\xbf\x55\x8f\x14\x68\xab\x75\x4b\x1a\xc5\x45\x12\x0f\x61\x84\x37\xed\x09\x20\x69\xa5\xd2\x9f\x93\x69\x3a\x96\xc8\xb7\x19\x8c\xa1\x1f\xdc\x8d\x7e\xc7\x5a\x43\x6e\x0e\xc1\xcd\xde\x76\x32\xf8\x27\xe4\x44\xb3\xde\x6d\xff\x2d\x27\xeb\xe9\xc7\xbe\x03\x20\x5a\x39\x4f\x1a\xa8\xe7\xbd\x88\x70\x4b\x03\x40\x56\xf1\x37\x60\xc0\xeb\x79\xdd\xfe\x61\x8c\xea\x53\x3a\x87\xc1\xec\x07\x5d\x89\xb2\x0e\x39\x8f\x5b\xf7\x43\x17\xba\xe5\xcc\xfa\x32\x8c\x4a\x3c\xe1\xda\xc5\x69\x2e\x49\x31\x4c\x68\x14\xac\x6a\xc9\x30\x75\x30\x5b\x81\x56\x06\x40\xd6\x52\xce\xea\xfd\x33\x47\x5a\xa6\x77\x6f\xd2\x78\x83\xc7\x95\xe1\x58\xde\xb8\x76\x25\x20\xad\x0e\x64\x4b\xc9\xee\xdc\xf3\x8d\x0e\x5b\x78\x2e\x9e\x87\x41\xbc\xb2\xe7\x99\x80\x81\x05\x71\x62\x67\x12\x6e\x78\xf8\xa8\x7c\x8f\xc8\xc5\xfb\x67\x3b\xd3\x09\xd9\xca\x86\x4f\x83\x3a\x7c\x62\xbc\xc1\x81\xee\x29\x56\x3f\xed\x01\xee\x46\x89\xa4\x7e\x10\xab\x1a\x56\x56\x3f\xc8\x76\xb7\x8f\x98\x4f\x76\x7e\x96\xa6\x69\x26\x7b\xa7\x4a\x1b\xc6\x49\x61\x00\xd1\xc7\xd7\x64\x74\xe7\x8a\x85\x50\xa2\xd7\x4e\xa6\x93\x7a\xd1\x23\x69\x3d\x3e\xcc\x41\x32\x61\xf8\xbd\x3a\xcf\x41\xb6\xe5\x2b\x4e\x1b\x45\x00\xf0\x13\xe8\xb4\x0c\x20\x6d\x24\xbc\xd5\xf1\x35\x00\x7a\xd5\x33\x5d\x42\xf4\x3a\x4c\x0e\x0a\xf1\x60\x5b\xc4\x1d\x29\x5b\xef\x0d\x68\x16\x76\x2a\xdc\xdf\xf3\xce\x5b\x53\x7a\x36\xa5\x5f\x2b\xfa\x23\x50\xcd\xfa\x7c\x85\x55\x2a\x7a\xd9\x76\xfa\x71\xb4\x25\xa0\x48\x01\x25\xd5\xc0\x58\xb0\x14\x29\x03\x90\x9a\xa4\x47\x32\x00\x62\x12\xf0\xf0\x4e\x5a\x60\x56\xda\xea\xfd\xd3\x46\x78\xf3\xbd\x12\xaf\xf1\xdd\x1d\x20\x63\x98\xac\x2b\xcf\x08\xd1\x4c\x23\x03\xaf\xea\x1d\xf7\x54\x9c\x1d\xf8\x2c\xc7\xd2\xe6\x26\xb9\xaf\x73\xf4\xea\xe9\x72\x43\x3c\x5f\xf5\x15\x1f\x5c\xed\x18\xb6\xa4\x15\x4f\x61\x53\x2c\xd5\x2f\x1e\x0c\xcf\xd6\xa3\xb8\xbf\xa5\xb8\x27\xfd\x46\xfa\xd4\x9f\xde\xc9\x82\xb7\xd2\x25\xd3\x06\xa8\x6a\x01\x12\x80\x8a\x53\x61\x70\x72\x4d\x17\xc5\xc4\xca\x89\x00\x5c\xf1\x21\x95\x58\x7d\xcb\x71\x23\x9b\x07\x0f\x9b\x7d\xf4\x51\xf8\x83\x82\x99\xed\x9d\x9e\x4e\xb6\x62\x54\xb6\x9d\x56\x56\x1b\xb5\x53\xa7\x42\x85\x0b\x04\xa0\xbd\x9a\x8b\xd5\x0f\x2d\x3e\x74\x79\x42\xd2\xe9\x51\xb0\x2f\xe2\x4d\xbd\xfd\x29\xda\x93\x57\xfb\x7b\xde\xb2\x1b\x88\x80\x79\x73\xc5\xcb\x77\x4d\x49\x82\xc9\x34\x13\xc7\x8d\xaa\x16\x78\x74\x00\x94\x66\xdb\x81\xd5\x3a\x64\x10\x0c\x84\x10\x38\xd8\x5a\xd6\xe5\x20\xeb\x2a\x98\x10\xc9\x89\x66\x3d\x7a\x7b\xa9\x9d\x71\xb1\x7a\xc8\x68\xa8\x5d\xad\xf1\xd8\xc0\x28\xc4\x03\x27\x97\x01\xc8\x20\x46\xbf\x45\xdd\xe1\xd4\x07\x04\x00\x08\xdb\xdc\xfb\x71\x9b\x6d\x83\x68\xab\x69\xd8\xf7\x75\xd8\x9e\x5b\x4a\x7a\x6f\xdd\x82\xc1\x59\xe5\xcd\x4e\x51\xe6\x0f\xa5\xe6\x62\x89\x86\x7b\x0f\x1a\xa1\x11\xba\xe8\x2a\x80\xa2\x6b\xcc\xc7\x0a\x33\x79\x53\x90\xee\xa4\x19\xc0\x0c\x2f\x7a\x09\x60\x28\xcb\x06\x0a\xc3\xe0\x23\x97\xc2\xd7\x5d\xe0\x8b\x4d\xab\xe7\x4f\xae\x7e\x0c\x42\x24\x94\x9a\x7c\xb0\xb8\xa3\x0b\x15\xb3\x33\x0e\x4d\x5f\xce\x02\xc4\x64\xc0\x10\xad\x93\x89\x47\x77\xe3\x46\x19\x09\x00\x90\x00\x4d\x23\x99\x4b\xcd\xc7\x4b\xd0\xc4\x88\x30\xc6\x6c\xd2\x77\xaf\xcc\x1a\x22\x4f\xd4\x93\x76\xff\x58\xb3\xe6\xd4\x64\xfb\x34\xa3\xb3\xd4\x03\xfc\x4a\x7c\xf1\x3c\x8f\x64\x14\x0f\x6a\xdc\x34\x4b\x53\xdf\x33\x00\x44\x6c\x58\xfa\xee\xce\x81\xa0\x9b\x2d\x83\xe4\x28\xa2\x5e\x64\x2e\x79\xef\x30\x7f\x24\xf0\x75\x47\xab\x3e\x9a\xe7\x69\x90\xe2\xac\xdf\xaf\x04\x90\xe8\x59\x8c\x2b\x19\x32\xbe\x22\xf9\x5b\xb6\x3e\x95\xeb\x0e\x58\x56\x62\x2a\xe1\x49\x0c\x45\x6d\x19\xf0\x17\xe3\x28\x1c\xcd\x09\xbc\x23\x3c\xa9\x54\x31\x52\x72\x9e\x3b\x28\xc8\xf9\xd8\xf8\x19\xbb\x56\xd9\xf9\xba\x27\x76\x58\x47\xa9\x9b\x84\x4e\x87\xeb\xda\x31\x4e\x8b\x84\x19\x20\x70\x00\xc0\x03\x80\x1d\x72\x0e\x28\x6c\x47\xe3\xd2\xb2\x20\x6d\xf2\xb0\x18\x17\xc4\x27\x52\xf0\x43\xe4\xae\x2f\xd0\x01\xb8\x42\x06\x2f\x50\x9e\x01\x78\xf3\xd5\x87\x4b\x33\xe3\x84\x24\xae\x21\x85\xdb\x27\x66\x20\xa8\x32\x2e\x77\x2c\xb4\x82\x86\xa6\xd1\xe5\x0a\x34\x60\x1e\x52\xe6\x63\x08\x4d\x0e\x46\x24\x47\x46\x12\xc7\x6e\x11\x3a\xfb\x7e\xfe\xf4\xf3\xb6\x77\x37\xc2\x4c\xaa\x8b\x1f\x41\x35\x3c\xc8\xce\x06\xa1\xb9\x39\xd4\x06\x65\x46\x17\x80\x04\x4b\x28\x0a\x41\xda\x38\x05\xc8\x7b\x8b\x48\x66\x33\x89\x31\x68\xb3\xce\x13\xc0\x99\xa7\x0c\xc3\xa8\xd3\x0d\xad\x1a\xd0\x26\xd3\xba\x25\x2e\x74\x18\xb4\x19\x5a\x4f\x05\x95\x4e\x1f\x75\xb6\x47\x18\x0c\x58\xe7\x8e\x98\x7b\x02\xe0\x04\x04\x20\x70\x46\x85\x00\x8d\x4a\xd7\x35\x66\xeb\x3c\xe9\x43\x6e\xac\xe1\xa3\xec\x4b\x53\x6f\x9f\x67\xfd\xa1\x4b\x06\x67\x7a\x86\x0b\xbe\x64\xcc\x2d\x68\xa7\x48\x81\xe6\x1c\xb1\xf1\xf3\x10\xdd\xc0\x73\x98\x24\xce\x07\xea\xba\x6a\x2c\x2c\x27\xc0\x29\xce\x30\x52\xee\x93\x76\xcc\xdb\x4a\xa2\x69\xac\xfd\x38\xcd\x36\xcc\x41\x3e\x12\xc6\x5b\x36\xa6\x95\x4d\x9a\xc3\x9d\x9d\xf1\xc7\x23\x47\xa0\xb0\x45\x63\xf0\x7a\x93\x06\x01\x25\x86\x91\x79\x5e\x07\x98\xfb\x1b\x5b\x29\x0b\x1f\xae\xfb\xc0\x00\x5a\xed\xcd\x00\x18\x3e\x80\x81\x06\x56\x6b\x45\xc2\x40\xa6\xc5\xcb\x28\xaf\x24\x71\xca\x09\xdd\x18\x42\x16\x7a\xa3\x7c\x73\xf8\x58\xbf\xe7\xb8\x9e\x9a\xae\xdb\x8c\x6b\xaa\xad\x54\xf4\xbb\x09\x08\xbf\xdd\x26\x81\x67\x8a\xb4\x9b\xc8\x31\x00\x10\xa5\x0b\x0b\x07\x51\xd9\x82\x40\xe6\xc0\xb7\xe0\xec\xbd\x96\x68\x19\x86\xc6\xeb\x6f\xbe\x15\x2f\x40\x13\x36\x42\x65\xa5\x21\x66\x27\xfa\x3c\x1f\x41\xe4\xee\xfb\xcb\x15\xe9\x7b\xb8\x9d\x95\xe0\x90\xe5\x30\x7a\x4d\x0f\xe1\x06\x00\x23\x00\xc8\x02\x32\x03\x9a\xbd\xeb\x8e\xfb\x44\x01\x14\xed\x30\x0c\x33\x56\x01\x03\x74\xf1\x10\x18\x07\xaf\x90\x2b\xa7\xad\xdc\xfd\x4d\x46\xc6\x9b\x39\xba\x72\x19\x38\x48\x68\x8d\xa9\x95\xc0\x1f\xd1\x84\x67\xe5\x47\x7e\xc8\x89\x13\xc7\x5b\xcb\x64\xb1\xec\x07\x13\x48\xe2\xe6\x45\x67\x5d\x33\xc9\x15\x77\x04\x10\xc4\xd0\x04\x93\x82\x63\x3a\x97\x6a\x99\xb1\x28\x19\xed\x56\x7a\xc3\x8d\x07\x2e\x3f\x4a\x6c\x74\xef\x9e\x40\x66\x2a\x00\x4f\xdf\xe6\xf8\x84\xe1\x79\x85\xa0\x4c\xb8\xe2\xf9\x52\x2b\x33\x39\xe4\x4d\x94\x55\x36\x4d\xab\xd8\x8f\x6a\x64\x1e\xed\xe5\xf4\x44\x51\xc7\xd1\x53\x9e\xc7\x40\x9f\x38\xd5\x5d\x97\xf2\x67\x2f\x0a\xd4\x9e\xea\x42\x10\x6f\x89\xe7\x5c\x31\x27\x86\xb8\x6a\x58\x5c\x77\xc0\x71\xbe\x3a\x9b\x83\x48\x27\xb9\xec\x6d\xc5\x7f\xf6\xcc\x03\xd7\x2a\x7c\x45\x31\xb6\x34\x9e\x3e\x0b\x71\xd5\x1f\x79\x26\x41\x57\xec\xd8\xbd\xdd\x67\xe1\x5f\x89\xe8\x19\xc5\x2c\x0e\x04\x65\x5a\x06\x34\x26\x03\x82\x02\xe5\xb9\xa3\x34\x07\xbe\xc8\x07\x30\xce\xf8\xf2\xf1\x6f\xd4\x4b\x78\xe1\x7a\x35\x85\x68\x22\xbc\x31\xed\x1d\x7b\x0e\x82\x0c\x5b\xa0\x7e\x38\xf4\x41\xd3\x03\x99\x69\x93\xe9\x9a\xe4\x22\xcb\xba\x7f\x3a\x3c\x7d\x7a\x19\x76\x69\x03\x8c\x70\x37\x76\xca\xc0\xa8\x28\xc3\xb6\x0d\x41\x3d\xfe\x8c\xe1\xd6\x53\x9a\x72\x4f\xae\x9a\x2d\x8d\x84\x26\x7c\xd6\x75\x0d\xd4\x7d\xb7\x27\x96\x29\x44\x67\x32\xd6\x1d\x8d\x59\xeb\x69\xc7\x92\xf0\x8e\xc1\x41\x2b\xc1\x53\xaa\x59\xf4\xf9\x01\xc4\x5e\x8a\xcb\xcf\x1c\xc9\x8f\x89\xe2\x99\x10\x4a\x4c\x73\xdd\xdf\x28\x4c\x40\x18\x24\x64\x0c\x10\x18\x96\x6c\x8a\xdc\x61\x8e\x9e\xc3\x0c\x34\xd5\x20\x9b\x61\x71\x78\xd3\x56\xe3\xb1\x77\xda\x6d\x4e\x86\x65\x39\x8a\xa0\xce\xb3\x5c\xb0\x1e\x8c\xe1\x1e\x1f\x37\x78\x33\x0f\x39\x56\x4c\xa8\xba\x6b\xef\x11\xf3\x48\xc2\x49\x69\x03\x83\x52\xad\xdb\xe9\x8c\x67\x52\x90\xad\x28\xb5\x61\xf4\x46\xc3\xad\xbd\x3f\x7b\x84\xdf\x54\xa5\x48\x94\xe1\x3a\xbf\xc7\xfe\x48\xd8\x6e\xbc\x57\x8e\x13\x08\xde\xa6\xc6\xd6\x79\xd3\xe5\xd7\x1f\x6c\x3c\xc9\xee\xd2\x4c\xdb\x73\xf5\x5e\x40\x87\xa7\x12\x2f\xa3\x1e\xd7\x04\x59\x4b\xda\x29\x67\xa7\xb8\x07\x87\x80\x30\xc0\x9e\x3a\x91\x56\x66\xe5\xfd\xf0\xb2\x1b\xa3\xf6\x7b\xb9\x5c\xf5\x00\xda\x59\x54\xbc\x22\x58\xcb\xc9\x26\x37\x45\xc5\x9d\x5a\xc9\x15\xb0\x67\x8f\xef\xbc\xea\x73\x3f\x25\x41\xa8\x7d\x4e\x98\x78\xe5\x6c\x6b\xbf\xdd\x82\x4a\x84\x0c\xd9\x59\x59\x7c\x59\xe1\xab\x98\x50\xf6\x3c\x62\xe6\xdd\xda\x5e\x60\x13\x02\x11\xd3\x97\xde\xfc\x15\x33\x7d\xde\x5d\x49\xe8\xce\x38\x40\xf4\x01\x3a\x11\xab\xde\x55\x53\x3f\xee\x0c\x6b\xf0\xcc\x87\xb8\x52\xff\x1d\xb3\x74\x42\x77\x7f\x67\xf4\x5d\x52\xb9\xd7\x0c\xe9\x7c\xe8\x11\xba\xa7\xdf\xbd\xe7\xfb\xe7\xbd\xc8\x60\x9b\xab\xc1\x99\x5c\xe1\x18\x9d\x93\xce\xa4\xfb\x06\x57\x37\xdd\xb8\xbf\xee\xcd\x70\xd5\x23\x60\xd2\xe6\xb4\xc6\xc4\xa2\xfd\x58\x26\x05\xf3\x10\xa6\x76\x76\x06\x9e\x7d\xad\x1c\x68\xe5\x07\xff\x53\x77\xbe\x72\x19\xe3\x9c\xec\x1a\x2f\xc5\x39\x62\xd9\xf5\xa1\x81\x33\x8c\xd3\xf5\x87\xb6\xa8\xaf\x44\x36\x2d\xf5\xe4\x96\xb0\xcb\x98\x88\xb6\x1e\xe5\xa2\x4c\xee\xf3\x76\x53\x4a\xbf\xb3\xbf\xd7\xe9\x7a\x06\x92\x08\x01\x28\x0e\x9c\x10\x0f\x98\xc2\x4b\xad\x0a\xac\x3c\x2c\x27\x8c\xbf\x38\x23\x4e\x23\xce\x03\x15\x94\x29\x9e\x9a\xa0\x03\x44\x40\x1f\x64\xc3\x53\xc7\x3c\xc2\x6b\x85\x54\xdc\xf0\xb8\x45\x5f\x7a\xeb\x52\x31\xe6\x31\x7d\x38\x3b\xad\xad\x7e\x41\xd6\x79\x96\xf3\x28\x3c\x58\x02\x87\x94\x87\xd6\x9e\x44\xaf\x23\xb3\x25\x6e\x29\x8b\x51\xfb\x21\x1c\x19\x7d\xbe\x7e\xc6\x71\x29\xb5\x82\x0a\x2e\xdd\xe6\x25\x9d\x10\x77\x0c\x98\xcb\x38\xe6\xb3\x01\xc9\xec\xf7\x1d\x21\xcc\xad\x7c\xdd\x2a\x02\x29\xce\x57\x56\xe6\x45\xf6\xc9\x04\x90\x1b\xe9\xef\xfc\x5d\x2d\x2a\x38\xce\xa9\xae\x31\xdf\xcd\x84\x24\xca\xae\xab\x99\x3e\x62\x47\xf5\xb6\x8b\xca\x20\x72\xd3\x21\x24\xfa\x3d\xdd\x3f\x48\x77\x82\xa6\x3b\xcd\x85\x51\x03\x9e\xfa\x5c\xf5\x52\x4c\x3f\xfd\x7c\xe5\x2f\x2b\x5a\x0d\xab\x73\x98\x46\x39\x23\x8c\xee\xe6\xd0\x50\x0d\xe1\x46\xda\x0b\x7b\x65\xd8\xe3\x36\xe5\x90\x65\xdc\x95\x1a\x27\x77\xc2\x38\x6d\xd4\x90\x5f\xee\x26\x91\x91\xf4\xf9\x1b\x79\x18\x30\x38\x44\x35\x1e\x61\xfb\xc2\x5b\xe5\x24\x63\xe5\xce\xc7\x5d\xd3\xa2\x25\xe9\x3b\x97\x1f\x32\x65\x4f\x5e\x0f\x29\x40\x74\x7e\x7f\xbc\x1f\x8e\x3c\x95\x00\xab\xfe\x68\x03\x34\xad\xa9\xa7\x37\xd3\xb8\x5f\xf9\xe8\xcb\x7b\xcc\x7a\x0a\x35\x02\x43\x1a\x9e\xd7\x15\x13\x46\xf8\x18\x9a\xf3\x56\x2b\xc1\x8a\x1e\x2d\xd2\x66\x1f\xc5\xab\x08\x87\xc8\xbe\x5d\x8f\x74\x68\x8e\xd2\xf3\x1f\xe3\x8c\x72\xd9\xaf\xaf\xf8\xac\x84\xca\x36\x02\xd2\x31\x47\xe7\xae\xf2\x2e\x2d\xd6\x67\x4b\xd8\xcb\x47\xe7\x3f\x77\x2d\x77\x77\xfa\x50\xe9\x46\xb5\x78\x3a\x23\x39\x75\x5c\xcf\xb9\xa4\xd6\xab\xb0\x07\x51\x60\xf7\x3f\xf2\x91\x53\x78\xc3\x9e\xa8\x4b\xb0\x2c\xb6\x81\x1c\x1e\xd3\x49\x6d\xe3\x3c\xc8\x71\xef\x8a\xcf\x1c\xa2\x86\xc2\xdd\xc4\xc0\xe6\xa7\x0f\x58\x87\xd1\x95\x67\x6e\xac\x3b\x4f\x7b\xc3\x09\xaf\x42\xf4\x8b\xdf\x7e\xa7\xa3\x7e\xf5\xb4\x1d\x42\x50\x7c\x16\xf3\x80\x63\xfd\xf3\x31\x6d\x02\x01\xa5\x5d\x11\x7e\xd4\x9d\x34\x08\xe1\x45\xba\x19\x77\x93\x4e\x1b\xb1\xb2\x47\x48\x19\xf2\x9a\x66\xa1\xf1\x42\xff\xa0\xdb\xfa\xa3\x5e\x5b\x76\xb6\x30\xcd\x5c\x36\xcc\x11\xb4\xfe\x93\x86\x88\xe8\xf9\xee\xe4\x05\x7b\xcb\xb9\x6c\x37\x41\xfb\xb8\x6a\xbe\xcc\xb6\xc5\xc0\x4e\x56\x23\x11\xb1\x9a\x11\xda\x0c\xda\x6d\xac\xe0\xb8\xa4\x5f\x48\xf5\xc7\xf1\x94\xca\x71\x07\x47\x11\xb5\x6f\x6b\x74\x98\xd7\x47\xf9\x08\x1e\xbc\x76\x43\x6e\x69\x3b\x8a\x58\x36\xdd\xbb\x8f\x9b\xed\x34\x81\x19\x9f\xf7\x09\xa1\x83\xc9\xe0\x51\x4e\x43\xa1\x77\xe4\x96\xa1\x65\xc6\x04\x76\x5f\x6f\xe1\x57\xde\xcb\x33\x90\x46\xc6\x93\xd3\xd8\x92\xaf\x9c\x54\x7c\x48\xe2\x17\x47\xad\x21\xa8\x79\xc8\x9f\x3c\x51\x55\x4c\x68\xad\xee\x52\x99\x04\x34\xb9\x13\x1d\xd0\xc8\x2d\x50\x0f\xa5\x44\xcf\x36\x4c\xe9\x57\xfb\x3b\x7c\x68\xa0\x9e\x90\x72\x0b\x9b\x17\xbd\xcf\x98\x76\x5c\x71\xb0\x56\x88\xb3\xb6\x62\x40\x9e\x8a\xc8\x5d\x5a\x8d\x92\xe2\x5a\xe2\x80\xfc\xf3\x98\x90\x3e\xba\x5c\xef\xa5\xff\x7c\x3a\xd3\x55\x97\x25\x99\xba\x78\xce\x61\x6f\x02\x2f\xee\xd6\xb3\xd3\xd7\x1d\x79\xf5\x48\xbb\x07\xe3\x2e\x5a\x33\x47\x53\x12\xa1\xe9\xe2\x8c\x8c\xdd\x0c\xa1\xa7\xae\x24\xd3\x6b\xd2\x64\xaa\xab\x47\x85\x11\x1f\xee\xe9\x61\xec\xae\xf4\xde\xc4\xe8\xa0\x3b\xd1\x1d\x4b\x40\xb9\xce\xe3\x1d\xe6\x5b\x6a\x71\xb8\x3e\x7f\xe5\x78\xdf\x5a\xe5\xa8\x77\x3b\xa6\x8a\xeb\x2a\x1d\x1f\x31\x35\x5e\x8b\x99\x5c\xe6\xfd\xe3\xf9\x00\x60\xb9\x4a\x25\x56\xcc\xc3\x15\x6e\x0d\xe1\x3a\x63\xe2\x3d\xde\x14\x49\xe5\xce\xab\xcf\x3e\x6a\xf0\x24\x1c\xe4\x63\xc6\xad\x54\x71\xdb\xe3\x56\x63\x3f\x63\x05\xd4\x55\x07\x80\xae\x89\x4e\x3d\xf6\xb3\x4e\xc2\xa5\xfb\x04\x34\x84\x4f\xe6\xc7\xe9\x87\x39\xab\x66\x7f\x24\x33\xe4\x78\xdc\xde\xcc\xa0\xdd\x09\x7f\x16\xef\x08\x39\xa9\xd1\xc1\x29\x0d\x02\x4e\x7f\x95\xcf\x49\x1e\x1a\x61\x7b\x48\xf3\x97\x3d\xa9\xb5\x96\x9e\xe5\xfe\xf3\x8d\xbc\x81\xa4\x28\xc7\xbc\x6a\xb6\xc3\xab\x9b\xcd\xd8\x4e\xe3\x8f\xde\x6c\xf4\xa8\x94\xd7\x69\xec\xcd\x27\x53\xe1\xd7\x1d\xc5\xa3\x06\xd6\xd8\xb8\xb3\x13\x00\xf1\xdb\xdd\x3f\x75\xb7\x37\xf1\x2c\xfc\xac\x59\x87\xdb\xe3\xfd\x30\xc3\xe6\x44\x66\x61\xea\xf7\x2f\xfa\x49\x2f\xfa\xb9\x12\x97\x22\x51\xd3\xce\x1c\x9e\x8c\xbc\x09\x4f\x33\xe4\xcd\xd5\x55\x81\x73\x06\xe7\x2b\xe4\x0f\x22\x1c\x84\x3c\xf8\x7c\xc4\x79\xec\x41\x45\xdb\xe5\x95\x2b\x26\x37\x14\x3f\x32\xee\xe8\x8e\x30\x2d\xd2\x75\xc7\xc1\xca\x8d\x0e\x11\xaa\xc5\xce\xc7\x3d\xe8\xf9\xaf\x39\x24\xb2\x1e\xe4\x48\xd6\x34\xeb\x85\x4b\xaa\xd8\x60\xd4\x0d\x62\x81\x0a\x25\xe8\xe6\xed\x6f\x2c\x53\x73\x96\x15\xd9\x9b\xf4\x7e\xf0\xf6\x94\x68\xbd\x26\x8e\x9e\x6a\xe5\xb0\x24\xc8\x5e\x91\x9b\x22\xb5\xc7\xb6\x02\x17\x9a\xe5\x7a\xfc\xb9\x60\x24\x97\xce\xce\x04\x3f\x4d\x89\xeb\xb0\x8a\xde\x88\x5b\x2e\xa8\xdc\x17\x1d\xdf\xf4\x2b\xee\x1e\xbc\x97\x29\x14\x2a\x11\x07\x8f\x3e\x9b\x9b\x09\x19\xf1\xfd\xc9\xee\x2a\x78\x5f\xc5\xdb\x8b\x77\xc1\x29\xe4\xed\x13\x3c\xa8\x41\xcc\xaf\x4b\x04\xc7\x33\xb9\xc7\x84\xfb\xaa\xef\xdc\x5d\x81\x0f\xb5\xea\x51\xc2\xbb\x53\x41\x31\x6f\xea\xf1\xe4\x46\xd1\xaa\x7e\xc4\x68\x38\xb6\x7b\xbe\xdb\xa3\x48\xc0\xaa\xa1\x69\xfd\x16\x9a\xea\x60\xb1\x27\xbb\x4f\x08\x89\x54\xe4\x9a\xdd\xf9\xdb\x4a\xcd\x9d\xdc\xdc\x25\x0f\x66\x40\xaa\x60\xf7\xe2\x76\x4c\xdb\x40\x0c\x4b\xb0\x08\x1a\x8c\xc3\x30\x56\xa4\x77\xb9\xde\x54\x8d\x1d\xf2\xe4\xcb\x27\x27\x9d\xe2\xc6\xa1\x9f\x95\x4c\x84\x42\x5e\x21\x9c\xc6\xa9\xd6\x18\x09\x54\xd9\x9b\x19\x09\x27\x27\x3a\xd4\xab\xce\xde\x88\x39\x77\xe2\x11\x21\x8c\x11\x90\x5b\xdc\x03\x63\x32\xfb\xf1\x59\x41\xc0\x68\x67\x94\x6a\x71\xcf\x3f\xa2\x77\xcd\x18\x13\xba\x57\x4f\x1f\x7b\xae\x4a\x8e\x0f\x05\x79\xff\x03\x6d\x49\x97\x9c\x6e\x69\xf4\xe0\x92\xcc\x41\x4c\xec\xee\x98\x4c\xb0\x56\xaf\x53\x25\x8c\xa0\xdb\x51\xc7\xec\x3c\xa1\xf3\x52\xd9\x16\xeb\xfc\x80\x6a\x99\x32\xa2\xe1\xf1\x7a\xa4\xf0\xbb\x76\xf9\x2e\x12\x3c\x2f\x3d\x92\x67\xba\xf4\xa3\x58\x4a\x47\x22\x14\x8f\x9e\x5c\xa9\xa1\x7e\x1e\xab\x72\xa6\xaa\x78\xfb\xfd\x2e\x24\x71\xcc\x98\x56\xbb\xf0\x1c\xbf\x14\x6d\x85\x20\xa6\x20\xdb\xac\x73\x3d\xa5\xc1\x9d\xdc\xa9\x4a\x41\x33\x99\x47\x1d\xcc\x68\x84\x93\x52\xe9\x0a\xc8\x39\xe3\x0c\xc3\xc0\xd3\xab\x68\xf4\x01\xd3\xd8\xbd\x30\x96\x18\xd3\xce\xd6\x1b\xc5\xaf\x9c\x12\xee\xe6\x41\x96\xfc\x14\x65\xad\xf2\x2f\x17\xd6\x21\xc5\x4c\x8a\x60\xd8\xed\x91\x08\x31\x36\x9f\xb4\x82\xde\x76\xda\x1e\x6e\xca\xce\xe0\x90\x70\xe9\xcc\x65\xf3\xac\x3f\xee\x1d\x0f\x99\xc5\x75\x56\x7a\x1e\x64\xb7\xc1\xa2\x1b\xb4\xbd\x4a\xe7\xba\xfb\x62\x5e\xb9\xb0\xc0\xab\x6b\x9f\xae\x1e\xd2\xcf\x1a\x09\x5c\x2b\x97\x8c\x81\x14\xf7\xda\x24\x4a\x3c\xd6\x66\x26\x39\x0d\x0a\x21\xc7\xcf\xf8\xac\x78\xb3\x7c\xa6\xa7\xde\xc0\xfa\xb6\x34\xd8\x63\xa6\xa0\x8f\x97\xc9\x71\xdf\x32\xd8\xb6\x6d\x36\xb4\x21\x94\xfe\x9c\x18\x9b\xe3\x50\xc2\x1b\x71\x57\xc4\x95\x91\x24\x9c\x45\xb9\xf4\x7f\xd9\xec\xa4\x93\xa3\x1e\xef\xf3\xf7\x58\xd3\x25\x1e\x77\xf5\xfd\x40\xfd\x90\x42\x34\xbf\x3d\x44\x67\xcb\x00\x77\xbb\x62\x1c\xfc\xcc\x22\x50\xf6\xee\x8c\x52\x13\xa5\x14\x4e\x84\x40\xac\xcb\x04\xda\xe1\xe8\xb9\xc7\xfe\xc2\x17\x8f\x49\xa5\xd3\x70\x41\xa9\x70\x2f\x46\x46\xb2\x3b\xf9\x10\xe5\x6c\x29\x70\x97\xf7\xa2\x4f\x8f\xd1\xa8\x75\x9d\x0d\xff\xf3\x6c\x58\x36\x6b\xf9\xc4\x96\xb5\xc5\x57\x42\xd8\xbb\x45\xdd\x78\x45\xb7\x6e\x54\xb0\x05\x20\xdc\xb0\x5e\xf9\x16\xa9\x41\xf2\xc1\xb4\xe4\xf9\x73\x87\xea\x6d\xed\x8a\x77\xc3\x8b\x19\x94\xbe\xba\x8b\xd7\xbb\x6a\xe3\x51\x94\x20\x08\x8b\x31\x24\x77\xc9\xc6\x3b\x16\x5f\xbb\xad\x86\x20\x37\x12\x8b\x2e\xe3\xc5\x3b\xf1\x15\xcb\x32\x2a\x76\x13\x1a\x7e\xee\x91\x66\x76\xca\xe7\x1b\xf6\x1f\x6b\xc6\x2d\xdc\x09\xf7\x04\x43\x11\x2f\x23\x6d\x14\x8c\x91\xba\xa7\x14\x2a\x9f\x40\x35\x4a\x4a\x0f\x60\xf9\x8a\x31\x1b\x06\x10\x45\x19\xd4\x23\x91\x46\x2d\xd6\x4d\x54\xe4\x8b\x76\x4c\xb6\x8b\x15\x45\xdc\xce\x38\x23\x63\x9d\x47\xfc\x7a\x89\x3f\x69\x29\x67\x99\x3a\x40\x3d\x69\x0d\x17\xbb\x93\x50\x70\xeb\x8d\x22\x46\x00\x6e\xb0\x5b\x1e\x8e\x67\x36\x3f\x85\xa2\xaf\xdd\x3b\xcf\xa9\xe4\x95\x9d\xad\x15\x78\x3f\xb9\xcb\x14\x82\x04\x37\x44\xd1\xa6\x21\x8e\xe3\x48\xb7\x1e\x94\x7b\x3a\xd1\x81\xb7\x6d\x81\x53\xd9\x7e\x65\x72\xfc\x81\x55\x91\x05\x33\xb2\xfa\x2e\x55\xe5\x62\x38\x5f\x71\x72\x71\xce\x14\xc6\x1b\x3f\xbd\x44\xa3\x7e\x30\x85\x2e\xec\x10\x4c\x8f\x45\x4c\x63\x3a\xcd\x85\x08\xe5\x71\xd5\x4a\xdc\xf6\x60\xa0\xb1\x42\x31\xd1\x99\x4d\x62\x30\x24\x42\x00\x27\xd7\x1e\x0b\x05\x0e\x49\x29\x44\x17\xae\xcb\xf0\x10\x4c\x4d\xd3\xd4\x8c\x8c\x2f\xda\xf9\x7e\x95\xa0\x3b\x17\xe6\xde\x72\x0c\x10\xf2\x99\x65\x33\x35\x53\x7f\x26\xf5\xea\xac\x25\x99\x1e\x3f\xf7\x98\x83\x2d\x44\xb1\x30\x1b\xbf\x66\xc1\x96\x41\x59\x94\x17\x9f\x5d\x91\x2f\xfb\x7f\xef\x8a\x64\x80\x31\x8d\x7b\x3d\xf9\x5c\xe3\xe5\x29\x7f\x32\x51\x36\xeb\x24\x99\x84\xe7\x28\x9f\xb7\xe7\xdb\xc3\xaa\xd2\x85\x64\x1b\x66\xb0\x04\x64\x35\x21\xbf\xe7\xcf\x6e\x7b\x0a\xca\x6a\x22\x7f\xff\xaa\xa1\x92\x3d\xb5\x2b\xe5\x15\x4f\x95\x9c\xf6\xe1\xd6\x44\x34\xce\x97\x54\x12\x21\x20\xab\xcd\x36\xe5\xfd\x46\x6a\x4e\x20\xd3\xab\x74\x52\x0a\x41\xb4\x35\x10\xa4\x54\xfa\x9d\x3e\x8d\x14\x4b\xb6\x3c\xe7\x13\x6a\x6d\xa6\x8a\x4d\xe3\x70\xd7\xf5\xa5\x5c\xb6\xd2\x92\x11\xa7\x91\x41\xc2\xf9\xaa\xd0\x00\xd7\x4e\xe2\xb6\x74\x4f\xb3\x6e\x24\xab\xde\x45\x9f\x85\x73\xfb\x8b\x1e\x6f\xf4\x8b\xdc\x9c\x63\xab\x29\xe6\xaa\x8b\xdb\x9b\x24\x78\xd0\x10\xd3\x16\x43\x68\x76\x0a\xae\xaa\xa5\x78\xe8\x00\xc2\x41\x66\x93\x8d\x8d\x0f\x6f\xd8\x58\x58\xf4\xaa\xe6\x58\x24\xdd\xc2\x4c\x11\x9a\x74\xc2\x68\xda\xbb\x0a\xf4\x10\xeb\xda\x1b\x91\x91\x96\xec\x04\x4e\xb4\xa0\xb0\x35\xec\xe4\x55\x1f\xf3\x25\x6b\x50\xa8\x40\x9a\x5e\x8a\xb7\xfb\x57\xfe\xa0\xd4\x1e\x70\xa1\x04\x7b\x3b\x6c\x14\xc5\x05\x11\xab\x30\xb9\x8c\xb8\x19\x04\x65\xa0\x6f\x04\xb8\xc3\x7c\x84\xa2\x20\xc6\x3f\x54\x4e\xe6\x65\xbd\x83\x20\xf1\xcd\x52\xb2\x33\x05\x1a\x83\xd0\x34\x19\x69\x2d\xd2\x15\x02\xca\xa2\x2d\x00\xab\x00\x88\xdb\x28\x89\xa4\x5e\xcd\x9d\x44\x26\xf5\x93\x24\xec\xbd\x8a\xef\x1f\x36\xd9\x30\xea\xeb\x6c\xa7\xab\x18\xee\xc5\x2f\xe8\xfe\xbc\x6a\xd9\x4e\x27\x01\x7b\xe4\xa6\x00\x01\xdb\x30\xe1\x0d\x3c\x2f\xcd\x40\x36\x93\xb1\x3a\x21\x3d\xf2\x74\xa7\x83\x50\xe2\x17\x71\x3e\x64\xf3\x55\x58\x35\xdd\xb3\xc1\xf1\x98\xb7\xcf\x3a\x0b\xa9\x37\x0f\x5e\x72\x10\x91\x40\x55\xf8\xba\x41\xc8\x9c\x27\x4c\xda\x4c\xe3\xeb\xf1\x90\xee\xfa\xc0\x3e\xe0\xe1\x83\x23\xa6\xbb\xce\x26\x82\xb9\x94\xe2\xac\xa7\xc9\x74\x60\x15\x3e\xb7\x4a\x88\x2d\x33\x84\x83\x6e\x6b\xbd\x9a\x61\x00\x9f\x47\xa6\xa1\x41\xe9\x74\x1a\x9e\x0e\x91\x02\x0a\xd1\x67\xad\x99\xfb\x04\x11\x2b\x80\x9c\xb1\xd4\xe0\xda\xc1\x50\x08\xc5\x30\x40\xde\x98\xcf\x8c\x0c\x72\x3b\x8c\x19\x79\x63\x2d\xd5\xa5\xa1\x7b\xf1\x82\x1a\xd8\x1d\xf3\x02\xc4\x4e\x06\x5c\x67\x0a\x8f\xad\x83\xde\xed\xa1\x18\x80\x31\x00\xc1\x89\x37\xf6\x15\x36\x16\xc5\xac\x30\x46\xf1\xa5\x66\x12\x89\x7b\xdf\xa2\x8a\xdd\x93\x2f\xdb\x46\xbe\x15\x41\xb3\xa4\xb3\xb6\x92\x16\x13\x2d\x28\xf5\x0e\x17\x76\x49\x16\xd4\xb8\x4b\x18\xa8\xeb\x80\x96\xe3\x69\x24\xd7\x0d\x9c\xd3\xb6\x4b\x8b\xa3\x2c\x28\x75\xf2\x83\xe1\xea\x3b\xf4\x78\xbe\xd1\x95\xb8\x62\x60\xc2\x07\x3c\x37\x00\x82\x4d\x29\xc6\x37\x60\xa6\x6e\x03\x25\x9f\x04\x49\x2c\x2e\xe6\xab\x9e\xdb\xba\x8e\x62\x53\xa1\x28\xdc\x08\x3e\x4f\xf5\x96\x7d\xd5\xb0\x10\x43\xf8\x54\x6f\x9f\x40\x78\xcf\xd1\xe3\x49\x57\xf4\x2d\x03\x38\x0b\xad\x9b\xde\x8f\x67\x6f\x8d\xe4\x3a\x5c\xb8\x08\x54\xfd\x78\x4f\x99\x6e\x9f\x6e\x80\x51\x4c\x33\x61\xe3\xb4\xa2\x8a\xa3\x2c\xf7\x07\xa2\x59\x19\x03\x2c\x40\x6f\xe6\xa9\x74\x86\x8e\x61\xc2\x34\x51\xd8\x39\xda\xa4\xac\x85\x1b\x74\xeb\xda\xd7\x11\xc6\xc7\xee\xf0\x36\x42\x17\xfe\x60\xdf\xb8\x2f\xfd\xfb\x35\x8e\xdb\x44\x42\xd3\x78\x7a\x73\xcc\x6d\x3c\x0a\x4b\xeb\xe2\x4c\x80\x74\x00\xb4\x46\xeb\x55\xb6\xc6\x60\xc9\xcc\x82\xde\x4c\x06\x90\x41\x8b\x7c\xa4\x67\xcf\xe5\x20\x9e\x39\x2e\x70\xc1\xe6\x67\x1b\x69\xd5\x88\x6e\xf5\x3d\x3a\x96\xeb\xb6\xae\x83\x92\x01\xaa\x0b\x3c\x6a\x58\xb1\x2a\xd9\x3d\x70\x26\x18\x3d\xe1\xea\x0d\x7d\x76\x85\x11\xc4\xde\x11\x0d\x9b\xb6\x57\xbd\x18\x1f\x5f\xa9\x51\x36\x2a\xdb\xcf\xa5\xc8\xfc\x0c\xba\xb1\xe2\xf0\x78\x4d\x2f\x09\x5d\x01\x0c\x36\x40\x21\x57\xbe\x27\x5d\x7d\x6e\xda\x03\xa3\x1a\x12\x40\x21\x9a\x74\x03\x7e\xab\x50\xaa\xa1\x16\xa6\x53\x64\x88\x65\x44\x7f\xe3\xe9\xe5\xa9\x09\x00\x53\x83\x93\x89\x96\x22\x65\xce\x91\xd2\x27\x40\x51\xdb\xb1\xca\xe2\x32\xb1\xef\x39\x7b\xde\x72\xff\xc9\x59\x5b\x2c\x05\xe9\xec\x7f\xdc\x07\xf9\x7b\x2d\x23\x99\xe2\x60\x6f\xb2\x9c\xf9\x8e\x3e\x8e\x36\x19\x99\x37\xfd\x46\xe1\x5e\x90\x3f\x5c\x0a\x31\x31\x7a\x15\xa4\x3c\x5a\x0c\xe1\xed\x8c\x2a\x3a\x40\xed\xf3\xe6\x4d\xb7\xf7\x19\xed\xba\x55\xc3\xa1\x21\x9c\x3c\x55\x78\xd1\x86\x0d\xbc\x38\xb0\xaf\x0c\x85\x08\x00\xae\x8b\xfc\x00\xc9\x03\x6f\xbb\x52\xfc\x27\x88\xdc\xb7\x2c\x66\xb7\xa0\x10\xb0\x09\x69\xd1\xa7\x10\xae\x57\xc1\x00\x64\xf0\x6d\xe7\x21\x07\xdc\x17\x5e\x72\x37\x0d\x66\x88\x13\x76\x26\x8e\x9b\x92\x86\xa4\x82\x59\x2b\x97\x5c\x70\x87\x29\xa4\x00\x41\xb9\x34\xa6\x71\xa6\x7d\x32\xe6\x34\x6e\x13\x06\x40\x6e\x20\xc8\x4e\x4d\x41\xc5\xa1\x94\xd4\x84\x9f\xda\xd6\xdf\xa1\x52\x7a\x8d\x71\x3c\xe5\x1d\xe8\xad\xb7\x75\x96\x87\x79\xa9\x87\xa4\xee\xba\x84\xd8\xb2\x51\x80\x51\x22\x90\x29\x10\xe7\x7f\x56\xe1\xe3\x82\x0d\x7d\x7a\x57\xed\xe7\x24\xd9\x37\x51\x50\xbf\x6a\xaa\xb2\x36\xb6\x4d\x48\x03\xb7\x66\xa0\x1e\xfb\x55\x0c\x24\xfd\xe0\x29\xaf\xa8\x3a\x67\xcf\xe1\xb6\xe9\x0c\x75\xfa\x88\x46\x64\xdc\xa0\x6d\x0b\x33\x02\x08\xa2\x98\x64\x41\x9f\x5c\xab\xea\xca\x7b\xd4\x28\x66\x22\x9c\xe7\xe8\xa5\x73\xed\xbd\x52\x58\x74\x4b\xc8\x51\x32\x40\x74\x1d\xa0\xe6\x80\x9e\xea\xd7\x92\x25\x58\x86\x2d\x14\x46\x76\xf3\x24\x99\xa7\xce\x2f\x19\xa6\x09\xfa\x00\xef\x94\x8e\x6c\xc4\x13\x79\xf7\xe2\xef\xf9\x45\x85\x93\x24\x4e\xe2\xbd\xb1\x96\xf2\xf1\x84\xc0\x5f\xcc\xce\x7c\x12\x1b\xd1\x12\x40\x81\x0d\x9a\xbd\x49\x6f\x97\x67\x16\x13\x20\x03\x08\xc6\x00\x1c\xef\xa7\x69\x7f\xa3\x85\xb6\x65\x98\xc4\xeb\x03\xbc\x56\x68\x63\x58\xf0\x75\x67\x7b\x0f\xd0\x54\x02\x14\xd0\x53\xc0\x3c\x13\x10\x4c\xae\x9b\xea\x25\x9c\x6d\x18\x19\x61\x54\x87\x9e\x64\x66\xd1\x67\x71\xd5\x85\xa1\x5c\xb0\xaa\xfa\xfe\x69\xc8\x83\x72\x79\xc7\x41\x2a\xd2\xff\xca\x75\x7f\xde\xde\x9e\x80\x21\x8e\xef\x27\x21\x76\xeb\x49\xdd\x92\xbc\x96\x57\xf0\xcc\x20\xc0\xb6\x44\x18\xb3\x7f\x80\x1f\x98\x5d\xba\x3d\x87\x31\xda\x30\xa0\x73\xda\x4b\xc9\x58\x38\x6f\x6b\x66\x45\x37\xa4\x91\x35\x0f\x2c\x8b\x95\xc0\xde\x3b\x41\x53\x21\x61\x28\x9d\x00\x20\x4a\x37\x74\xc5\x50\xbb\xc2\x3a\x0c\x80\x1b\x07\xce\x0e\x80\x96\x84\xa1\xda\x4e\xfa\x17\x95\x40\xa8\xbe\xbb\xca\x53\x78\xac\x2e\x61\xae\xdb\x2a\xed\x0e\x77\x04\xfc\x4f\x5c\x72\x6f\x97\x31\x83\x28\xcd\xf1\xd9\x29\x81\xdd\xb6\xe9\x1d\x10\xcf\xa1\x6f\xb7\xc0\x00\x40\xdc\x3a\xb0\xdd\x09\xd4\x09\x94\xe9\x29\x6d\x9b\x3c\x8e\x10\x45\xe0\xd1\xd5\x1e\x77\xd2\x80\x8b\x96\x16\x32\xde\xa3\xde\x38\x46\x74\xeb\xc3\x47\xf5\xc6\x21\xd9\x50\xe5\x69\xa2\xba\x16\x02\x59\x2a\x82\x52\x9a\x5f\x77\xb9\xdf\x3e\xc0\x03\xd4\x81\x81\x73\xc1\xf0\x89\xf6\x6a\xb2\xb1\x47\x08\x62\xa6\xa1\x28\x90\x95\x0a\x26\xcf\x23\x51\xcf\xc9\xe1\xf6\xcb\x6f\xc8\xf7\xeb\xdb\xa5\x0b\x83\x01\x71\xa6\x63\x41\x4c\x8b\x12\x0d\x55\x7a\x06\x25\x03\x00\x6f\xa6\x58\x77\xaf\x33\x9b\xa7\x8d\x01\x10\x13\x80\x3a\x49\x84\x39\x57\x06\x2e\xab\x53\x4d\x2b\x0a\x40\xfb\x10\x99\x29\x06\x31\x90\x26\xb5\x75\x94\xbe\xdc\xe3\x70\xaf\x24\x96\x14\x63\x60\xec\xf4\x3c\xaf\x51\x0f\x2d\x84\x96\x11\x60\x6e\x42\x51\x27\x6c\xcd\xd8\xbd\xa2\xf4\xeb\x1c\xef\x62\xc6\xed\xc5\xae\xd4\xa3\x39\x61\xd8\xea\xdc\x2c\x76\xf9\xb2\xa5\x9e\x52\x30\x7b\x3b\x91\x78\x2e\xe2\xfb\x59\xc4\x68\x92\x92\xcf\x29\xcf\xf7\x5b\x33\xcf\xf3\xba\x02\x70\xbb\x91\x24\x7d\x6c\xef\x1d\x1e\xe4\xc6\xa4\xa8\x73\x4b\x19\x88\xe8\x62\x20\x03\xe2\xb5\xa0\x67\xc2\xf7\x1b\x6f\x42\x80\xd1\x2c\xf1\x48\x37\x2e\x17\xe3\x87\x40\x7a\x21\xb5\x81\x69\x03\x80\x6a\x36\x70\x28\x8f\xb5\x44\xe6\x55\x20\x49\x88\x01\xb8\x28\x38\x21\xe2\x2b\xc6\x4c\x3c\xc4\x4d\x08\x49\x94\xf6\x53\x09\xdb\xb4\x4d\x24\xb9\xfd\xf5\x95\x5b\xee\x2b\xbc\x58\x4f\x56\x91\x25\x71\x98\x4c\xe5\xb7\x2b\xa6\x05\x0e\x89\x1e\x11\xca\x65\x3f\x01\x60\x4c\x1e\xd8\xa2\x0d\xcc\x64\x2d\xbc\xda\xd9\x59\xb7\xa7\x7e\x66\x29\xa0\x59\x4e\x46\xd2\xe5\xd1\x41\x30\xec\x16\xf0\xc1\x04\xcb\x90\xf5\x6e\x8a\x09\x27\xd1\x7e\x50\x19\xe0\xb8\x01\x94\x77\xc5\x10\x18\x00\xb0\xe7\x79\xe6\xdb\x4c\xb7\x99\x01\x1b\xc0\x00\xb8\xbe\x45\x63\xf0\x00\x15\xe0\xe0\x29\x1a\xcc\xb8\xa0\xf8\x39\x49\xe5\x5b\x82\xfb\x68\xc2\xe6\xa4\xbc\x21\xea\x57\xcc\x51\x91\x15\x67\x44\x32\x07\xd1\x5e\x7e\x32\x55\x9d\xd7\xe5\x45\x4d\xb7\x7e\x5f\xc9\x58\x06\x71\x8c\x81\x75\x38\xfe\x5e\xd6\x4d\xe3\xe3\x07\x33\xa5\xd8\x46\x26\x14\x86\x6d\x27\x05\xe8\xc0\xcf\x38\xdf\xef\x6f\x1b\x65\x11\x84\xfd\x2a\xed\x70\xda\x26\x20\xec\x65\x80\x52\xc9\xb0\x94\xe3\x03\xd3\xac\x04\x40\x58\x97\x01\x7a\x04\xe0\x68\x0e\xac\x30\xdf\xd2\xba\x61\x0c\x85\x01\x1c\xb6\x93\x75\xcd\x6c\xd7\xfe\x7c\x70\x9e\xed\x83\xd5\x6b\x68\xc2\x9f\x9a\x2b\x07\x6a\x12\x5e\x1f\x2d\xab\x27\x0f\xd4\xb2\xf3\x43\x45\x52\x6e\xa4\x07\x63\x6d\x4e\x90\xec\xbe\xe3\x13\xef\x77\x21\x35\x85\xed\x0e\xbf\x4d\x27\xb6\xf2\xe4\x49\x52\x59\xac\xb7\x4d\xb3\xe3\x54\xda\xee\x47\xdc\x28\x1f\x38\x9b\x18\x50\x01\xb2\x3e\x01\x44\x52\x80\x68\xa9\x91\xd4\x27\x3d\x4e\x51\xad\xf2\x4f\x86\xa2\xeb\xae\x68\x21\xaa\x0e\xdc\x5b\x4e\x6b\x92\x82\x94\xad\xec\xf7\xd3\x3b\x29\x99\x0c\x8c\x0f\x8a\xea\x2b\xc1\xaf\x53\x5d\xb8\x3d\xc1\x93\x51\xe4\xc6\xdc\x00\x14\x6e\x60\xdd\x04\xbd\xb2\xa2\x53\x13\x33\x62\xb7\x0c\xe3\x2a\x1c\xf4\xec\xd4\x79\x64\x1c\x12\x21\xe0\x08\x73\x71\xef\xd8\x77\xf5\x2b\x97\x41\xb9\xe3\x57\x05\xa5\xeb\xae\xbe\xbf\x54\x1c\xbe\xf5\xc2\x6d\x49\x12\x70\x23\x34\x98\xde\xcd\xee\xca\x24\xab\x66\x88\x5e\x9f\x57\xc9\x1f\x08\x5b\xc8\xab\xa2\xe9\xed\x0d\x15\xe8\x7a\x02\xa6\x8d\x01\x0d\x2c\xc0\xe0\xc4\x88\x84\x87\x23\xa9\xcb\x93\x60\xa0\xaa\xb2\xe0\x5d\xb3\x82\x0c\x85\x8e\xd5\x31\xaf\x62\x42\xae\x9d\x08\xae\x1f\xcc\x2a\xd2\xae\x40\x1e\x47\x92\x04\xb4\x1f\x5e\xa2\x00\xda\x2a\x7c\xb7\x81\xe2\x22\x8b\xbf\x01\xc8\xc9\x80\xda\x7a\x5b\x6b\xd5\x3b\x02\x8e\xcb\x27\xc4\x18\x96\xb5\x9d\xd6\x1c\x3f\x37\x77\x52\xa8\x19\xae\x17\x1f\x47\x6f\x68\xdd\x50\x61\x82\x3c\x7e\xd4\x69\x11\xf1\x38\x55\xae\xa0\x1f\x73\x28\x1c\xe7\x45\xca\x8a\xe7\x0c\x43\xb3\x7a\x3b\x12\xf2\x4a\x1e\x17\x83\xb4\x58\x60\xd1\x50\x66\x94\x1f\xf1\xa1\xca\x37\x56\xbc\xee\x4b\x9e\xe7\xb4\x6d\xf1\xb4\x25\xc3\xb8\x75\xb8\xa9\x09\x42\x57\x7d\x7a\x4f\xc2\x33\xa4\x5d\x90\x0a\x90\xf9\xc1\xd8\x8e\xe3\x06\xd1\xfa\xea\x2c\x82\xa2\xe1\x17\xd8\x52\x4c\xb0\x86\xee\x45\x2a\x71\xf9\x5a\xa7\x95\x32\x40\x3b\x60\x54\x07\x15\xc5\xa6\x26\xe6\xd2\x53\xb9\x72\x00\x6f\x5b\x0d\x0f\x25\x31\xa2\xe2\x64\x7f\x3a\x86\x2b\x88\x88\x91\xa0\xfb\xd8\x7a\x50\x7f\xd5\x5e\xf9\x7c\x3a\x18\x40\xdb\x73\xc7\xe9\x58\xd3\x99\x73\x03\x0c\x94\x45\xd6\x5b\x6a\x08\x17\x43\x29\xe7\x24\x20\xdd\x30\xb2\x28\x1c\x49\xda\x51\xad\x77\x7c\x95\xc9\x2a\x4e\x82\xde\x0f\x14\xc3\xc6\x71\x1c\x8b\x66\x6a\x1f\x9f\x76\x03\x82\x16\xd1\x98\x7a\x4a\x91\x04\x91\x2b\x32\xa4\xf6\xd9\x01\x22\x1b\xfa\x05\x26\xf0\x43\xab\x2a\x0c\x25\x65\x1d\xa4\xc6\xf4\xad\xda\xb5\xdf\x23\x37\x3b\x30\xa7\x89\x6a\x48\x9c\x40\x30\x6f\xda\xd7\xce\x69\xb7\x27\x75\x89\x96\xb3\xb3\xf6\x01\x5d\x8d\x24\x4d\x52\x7b\xd1\x18\xf0\x80\x7b\xdf\xfc\xca\x4b\xe5\xb8\xfb\xe5\xa5\x5a\x28\xea\xc4\xdc\x0f\x1a\xd2\x67\x11\x8e\xe3\x74\xe9\xad\x27\xdd\x1e\x91\x46\xca\x67\x7c\xaa\x86\xb1\xa9\x2a\xb1\xa6\x19\x24\xe4\x1f\x5f\x7f\x82\x4c\x4a\xaf\xe8\x21\x78\xc1\x97\x9e\x49\x45\x29\xb6\x50\xa1\x0f\x61\x04\x41\x30\x53\xbd\xb8\x54\xea\x20\xce\x36\x57\x39\xc3\xf8\x1a\xc6\xf3\xc4\x82\x01\xa9\x51\x89\xc5\xf1\xbc\xae\x43\x5b\x37\x45\x23\x66\x59\x89\xbb\xe6\xe8\xd4\x4c\x46\xd4\x39\x0c\xcb\xb2\xae\x27\xf2\x7a\x5a\xb7\x8d\x89\x95\x82\x16\x18\x06\x27\xb6\x3d\xd5\x0e\xff\x73\xd6\x71\x55\x01\x00\x0f\xc0\x3f\x13\xdd\x15\xa2\x9c\xfd\xb2\xd1\x1f\x3c\xc4\xac\x6f\x4b\xc7\xb2\x55\x5d\xf7\x0f\x42\x61\x1b\x9a\x63\xde\x16\xd2\x0f\xb2\xf2\xaf\x1c\x1e\xf9\x38\x98\x0d\x8b\xa4\x86\x22\x48\xe6\x70\xcd\x83\xd6\x9e\xac\x24\x38\x70\xc2\xef\x04\x12\x38\xde\x96\x65\x23\xe2\x2e\x6e\x03\x73\xfc\x2d\xf7\x85\x0d\xc4\xc0\x38\x4a\x6c\x57\xb5\x75\x01\x40\x12\x00\x7a\x7b\x5a\x5b\xd1\xdd\xd5\xb0\x8a\xa8\x1d\x5a\x97\x6d\x33\xa8\xe7\xd2\x62\x51\x50\xa4\x9d\x48\x41\xa5\xfc\xdc\xbc\x69\xc2\xe8\xa3\xdc\xd2\x84\x41\x10\x93\x88\x57\xc5\x17\x82\x0c\x2a\xdf\x6f\x6c\x55\xd3\xfc\x38\x76\x57\x26\x40\x14\x44\x13\x55\xd0\x86\x3f\x87\x66\x6e\x7c\xe5\x8a\x8b\x1a\xdf\x33\xfa\x06\x18\x1f\xf1\x95\x67\xa4\xe2\xee\x3b\x00\xab\xe8\x19\x4d\x62\x79\x0e\x7c\xdc\xfc\x53\xc0\xc1\x86\x52\xc9\x06\x90\x89\x62\xa0\x6f\xb2\xb2\x87\x3b\xd4\xb7\xb9\x3e\xad\xb6\x08\x61\x88\x70\x70\x42\x26\x5c\x5d\x6f\x7b\xbf\x03\xd7\x69\x4c\xc7\x1a\xec\x4f\x4b\x43\xd8\x96\xb5\x0c\x9c\xc8\x1f\x15\x43\xc5\xc9\x39\x4e\x80\x6a\x08\xd6\xc4\x87\xd1\x43\x1b\x59\xc7\xba\xc0\x40\x54\xb2\x62\x20\x03\xbd\xfa\xad\xd6\x5a\x0f\xda\xda\xbd\x93\xd8\x4a\xae\x53\x4c\x63\xe1\x12\x2e\xde\x44\x51\x7d\xdf\xaf\xb1\xd2\x64\x12\xaf\x4f\x2f\x3c\x56\x0d\xb0\xbe\x8b\xcf\x87\xf0\x5c\x64\xa3\x0a\x04\xd0\xdb\xa3\x8d\xa0\xd7\x6d\xfb\xf2\x47\x71\x9c\xce\x54\x09\x66\x18\x1e\xac\x7c\x3e\x94\xf7\xd0\x98\x2b\xc5\x17\x5b\xa1\x70\x9d\x37\xf9\x76\xdd\x85\x91\x3e\x74\xec\x4a\xd7\x7d\xe1\x3a\x7d\xbe\xe7\x88\x7b\xf9\x9c\x4a\xa9\xa7\x68\x71\xb5\xf3\x3a\x5a\x3b\x6f\x19\x77\x77\x1d\xc7\xd1\x60\xe8\xa3\x17\x34\xe8\x68\xb0\x31\x8e\xe3\x38\xdf\x4a\xbc\xbd\x30\xe4\x34\xe1\xc5\x24\xfc\x59\x4e\x9c\x93\x12\x1d\x4d\xa1\x28\x12\x5d\x97\x06\xda\x43\x2a\x86\x34\x01\x19\xf7\xd4\x0b\x44\xe0\x56\x2f\x53\xf7\x84\x32\xe0\x99\x8c\xa2\xab\xcf\xa1\x71\x2f\xf8\xca\x2c\xd5\x4f\xf5\x7c\x7e\x3e\xed\xe3\x53\xd7\x73\x53\xcb\x83\x6b\x06\x4e\xe3\x38\x31\xda\xa6\x11\x0c\x75\x4c\x36\xd1\x60\xbb\x6e\x5b\x4c\x24\x34\x7a\x5a\x49\x2c\x21\x0a\x6d\x23\x39\x4f\x47\x24\x3d\xc4\x41\x00\x60\x09\xb1\x0f\xf4\x14\x7d\x3b\xa3\xb1\x98\x81\xd6\xc9\x6d\x8a\xd4\x56\x25\x5f\xfb\xe1\xbc\xba\xe5\x02\x8f\x79\x1b\xe8\x16\x0a\x27\xd5\xea\x56\xa0\xbb\xbc\x65\x29\xe3\x68\x1a\x43\xb0\x44\xbc\x3c\x30\xb2\xb1\x18\x66\xa7\xbc\xb3\xe9\x84\xeb\x5e\xf7\x0b\x82\x20\xa3\x96\x37\x02\xb2\x7a\xfb\x58\x47\x16\xd0\x84\x7c\xe9\x5f\x78\x98\xcc\xd6\x25\x5f\x93\x2c\x4d\x9e\x72\xcf\x98\xfe\x68\x3f\x7e\xdc\x89\xe2\xae\xa4\xa8\xfd\x13\x03\x5f\xb1\xaf\x5b\x0d\x7d\x48\x42\x00\x4e\xb6\xe8\x0d\x79\xca\xcb\xfa\xa4\x19\x74\xee\x12\x3d\xd0\x22\xca\x96\xb5\x57\xcd\xb4\x19\xab\xe9\xce\xae\x65\xbb\x09\xe7\x80\x3c\x8b\x55\x43\x57\x80\xa1\x2e\x0a\x15\xd4\x3d\xdf\xbf\xee\x8f\x3c\xd4\xc5\x8b\x86\x1a\xb8\xae\xf7\xec\xba\xc7\x4e\x50\xf8\xe8\xf2\xda\x89\xf0\x0f\xeb\xa4\x10\x46\xdf\xe7\x9a\x1b\x63\x63\x41\xb2\xb3\x40\xda\x63\xce\xae\xba\xe5\xe7\x79\x22\x1b\x71\xe3\xb7\x6d\xed\x9e\x8f\x2e\x67\x15\x81\xd5\xbf\x68\x58\xe3\xd7\x4d\xe7\xc9\x8e\x66\x3a\x54\x7f\xde\x3f\x29\x57\x59\xe0\xb0\xc1\x28\x37\x54\x0c\x27\x3a\xf0\x33\x52\x7e\xe8\xf1\x19\x02\x60\x28\x52\x4d\x61\x76\xef\xbf\x9a\x9a\x6e\x05\xa1\xc3\xe1\xc6\xee\xa3\x64\x36\x5d\x62\x0d\x14\x05\x69\x04\xc2\xe1\xc9\xaf\xd8\xe7\x23\xb2\x34\x1d\xe6\x28\x0a\x24\xc7\x5d\x4d\x75\x6f\x9a\x09\x27\x49\x87\x4c\x6e\xc6\x8e\xc9\x71\x29\x33\x8c\xc7\x9c\x9c\x73\xd1\x32\x76\x9c\xa6\xc7\xc7\x0f\xb8\x84\x09\x13\x09\x01\x61\x0c\x20\xd0\xf9\x90\x52\x14\x6f\xe4\x65\x13\x3e\x6e\x64\x3e\x27\xf7\x87\xf9\xa5\xbb\x96\xe3\x9d\x1a\x81\xca\xf0\x24\x8c\xd0\x7d\xbe\x45\x1e\x45\x90\x1a\x5f\x39\x0a\x05\x17\xaa\x51\x74\xe7\x09\x9b\xf7\x80\xdb\x21\x2c\x7b\xf4\xcd\x43\xc0\xce\x1e\xf7\x5e\xa4\x65\x36\xf2\x49\x84\x8b\xfb\x01\xb0\xc0\x59\x47\xa9\x25\xdb\x2a\x93\xe3\xd8\x4f\x7f\x3b\xfe\x6a\xa0\x28\x4a\x21\x69\x84\x3c\x85\xed\xb9\x4b\x04\xe9\xb7\xc3\x58\xd2\xa3\x5d\xd7\x74\x4d\x03\xb5\xa8\x7a\xe7\x2e\xda\xd3\x99\xe0\x3a\xf7\x52\x30\x8a\xe0\x04\xbb\x57\xcd\x84\x4a\x09\x06\xf8\x81\xfe\x7e\xa3\xfb\x18\x76\xe7\xe6\x38\x89\x99\x06\xaf\xf1\xf7\x7a\xa1\x57\x01\x5a\x9f\xa2\xb6\x32\x97\x1e\xed\x53\xb0\x1e\x6e\xc9\x00\x80\x7f\x12\xc2\x69\xe3\x21\x4d\xa7\xc1\x03\x66\xa2\xd7\x76\x82\xcd\x9d\xee\x45\x2b\x16\x69\x88\x2c\xd8\xd3\xf3\xaa\x43\xff\xfa\xd0\xa0\x9e\xe1\x90\x39\x5f\xbc\x95\xa8\x10\xb4\x3e\xa8\x4a\xd9\x5f\xec\xc7\xff\xd2\x01\x79\x15\x3d\xaa\x9c\xe4\x04\x90\xa3\xaa\xd5\x11\xf8\x43\x35\x82\x53\x0c\xca\x86\xbe\x7b\x5d\x87\x2c\x0e\x4a\x31\xc8\x5d\xb8\x63\x5b\x8b\xa6\xb1\x21\x54\xc3\x43\xb0\x07\x56\xba\xc9\x04\xa0\x63\x63\x6f\xa5\x5d\xfc\x30\xe4\x55\xbb\xe1\xdb\x17\x2b\x74\x87\xad\x1a\x5f\xf5\x3c\x77\xcc\x8b\xa6\x6f\xe5\xe7\x6d\x3f\x9d\x43\xb0\x21\x0f\x06\x80\xd1\x5c\xc9\xdd\xca\x10\x23\xda\x0c\x8a\x1b\xf6\x20\xed\x3f\xd8\x87\x66\xb6\xdc\xba\x0a\x9b\x21\xaa\x60\xdb\x63\x67\xb3\x1f\x1a\xd2\x38\x9b\x89\xd2\xf7\x6d\xa5\x56\x52\x69\x18\xf0\x8c\x1d\x21\x34\xd5\x2f\x9b\xb7\x26\xc5\x3e\x61\x20\xc2\x76\x1c\x47\xfc\x00\x12\x69\x36\xaa\xe4\x03\x6d\x8a\x52\x8c\xc2\xdb\x0c\xf8\x1f\xf2\xe4\xd5\xb1\xc9\xa8\x9d\x3d\xa1\x60\x30\x5a\x28\x74\xdd\x13\x8d\xe3\x34\x23\x25\xe0\xab\x9c\x98\x63\x23\x83\xf5\x0c\x0d\x3d\x54\x5b\x38\xdc\xaf\x5c\x7b\xfb\x69\x22\x8b\x65\x63\x18\x66\x56\x9f\x57\xc2\x30\x91\xe7\x79\xd8\xc6\x24\xfe\xb0\xbd\x37\x98\x63\x4f\x06\xa2\xef\xd9\xa0\x3d\x90\x23\x2e\xee\xd2\x5b\xa7\x18\xdc\x43\xcf\x99\xd2\xed\x4f\xf7\x86\xbd\x0c\x0c\x27\x46\x40\xb4\x76\x2b\xc4\x0f\x33\x2e\x4e\x8f\x65\xab\xc5\x5d\x79\x4c\xd0\x68\xd4\x5f\xb1\x5a\x71\xda\xe8\xcd\xf5\x26\xe7\xd8\x31\xfd\xce\x19\x1b\xb8\x97\x97\x2a\x05\x77\xfb\xe9\x3a\x78\xa0\xbf\x05\xe6\x84\x5e\xd6\xdd\x1f\xde\x21\xd1\x06\xb8\x81\x01\xe3\x0e\xd9\xf7\xe2\x21\xdb\xfb\x03\x01\x6e\xd7\x6d\x14\x8f\x4d\x19\xfa\xf4\xce\x38\x24\x21\x62\x1d\xd1\xa5\xc9\xc6\x4a\xf8\xf2\xa9\xde\x1f\xb4\xf4\x30\x30\x84\x6f\x20\xde\xc8\xb3\x4d\x50\x02\x2c\xda\xf0\xd7\xd3\x18\xaa\x07\x64\x6c\x14\x07\x41\xa4\xb1\x43\xba\xed\xb7\xcf\x76\x18\x03\x90\x6a\x80\x81\xdd\xd2\xb6\x87\x8a\x8f\x69\x08\xcc\x4f\xa3\x53\x7a\xd5\x40\x17\x21\xdd\xd6\x27\xc9\x20\x3c\xdd\xb0\xdd\xd7\xbd\xa6\xc7\x55\x1b\xeb\x88\xa1\x6c\x4a\xdb\xf0\xe5\x67\x0c\x19\x83\x94\x51\x70\x88\xad\x95\xab\x6e\x07\xdd\x6b\x54\x06\x97\x68\x4b\xa6\x3b\x9d\x3d\x32\xcc\xd8\xb8\x98\x2b\x54\xf9\x65\x76\x24\xe8\xcf\xf3\xa4\xb0\x7d\x08\x97\x5b\x01\x3c\x0a\x18\x49\x96\x24\x4f\x33\x90\x7d\x76\x78\x49\x5f\xbc\xe8\x5e\x8c\x61\xdb\x43\x8c\xfa\x30\x9e\x02\x88\x25\x82\x21\xd3\x79\x73\xa7\xe4\x9d\x05\xec\x55\x93\x34\xa3\x69\x85\x10\x0b\xe1\x4e\xb3\x6a\xf4\xdc\x27\x06\x9c\x14\x06\x8b\x45\x1a\x4c\xeb\x62\x18\x9b\x07\x37\x23\x16\x71\x1c\xb9\xf6\x65\x9e\x41\x4c\x91\x70\x7d\xca\xc9\x36\x47\xd4\x0a\x38\x9a\x90\x79\xdd\x7e\xac\x47\xd4\x87\x31\xdc\x75\xe9\x2d\x80\xeb\x6b\x0c\x05\xc3\xa3\xe8\x2b\xc7\x76\x32\xe1\xc5\x12\x9f\x2c\x54\x93\xc9\xba\x32\x82\x01\xe3\xf5\x63\x30\x4b\xfb\x29\xef\xae\x0a\x6e\x2f\x04\x49\x3a\x9b\x34\x6d\x83\xaf\x67\xa6\xee\xb2\x6d\x3c\x0d\x6f\xcb\xd4\x8d\x7a\x25\xd8\xf9\x61\xbf\xbd\xff\xdc\x8e\x7c\xfa\x99\x7b\xc1\x2a\x37\x6c\xa1\x32\x98\x63\x11\xcd\x82\x3f\x7e\x76\x7d\x77\x81\x42\xf0\x62\xa2\x98\x18\x8c\x14\xac\x2c\xc6\xba\x00\x28\xe9\xf5\x81\x3a\xbb\x6c\x64\xdb\x29\xa5\xb7\x2e\xb1\x27\x2d\xa0\x4b\x13\x1a\x3b\xac\x22\xbb\xc4\xcb\xc3\x9b\x80\x08\xed\x99\xe6\x78\xaa\x1a\xc0\x74\x1c\x64\x87\x4e\xcb\xf1\xd4\x8f\xdd\x6b\x97\x8e\x8e\xbc\x7e\xd4\xcc\xbb\xdb\x29\x77\x42\x5d\x0a\xa0\x93\xb6\xea\xc1\x78\x0a\xde\xf4\xbc\xbe\x27\x55\x30\x48\x79\x94\x27\x35\xcf\x5b\x2c\x5a\x00\x38\xda\x34\x13\x95\x22\x34\x86\xb1\xc1\xa2\xe4\x14\x9b\x96\xbf\x5d\xbc\x13\xda\x19\x3c\x7e\x16\x3d\x6a\x14\xdc\x42\xa5\x58\x96\x0d\x20\xf3\x47\x2a\x3f\x0a\xee\x4b\x1e\xdd\x78\xcd\x7a\xec\x34\x83\x31\xd0\x43\xdc\xf8\x8a\x03\x30\x5c\x3a\x4a\xb6\xa6\x96\x80\xa5\x84\xa7\xee\xf7\x47\x0c\x33\x9d\xc0\x4d\xcb\x8a\xda\xfe\xc4\x4b\xf5\x66\x41\x28\x0a\x31\x94\x26\x29\xb2\xed\xec\x57\x61\x1d\x0c\x80\xea\x86\x06\x6d\xb9\xb0\xde\x36\x8e\x07\xbd\xe9\x19\x74\xa2\x14\x16\xe3\x73\x7f\x3b\xb6\x3c\xfd\x8a\x77\xed\x4c\x16\x21\x58\x96\x56\x02\x99\x59\x61\xaa\x1a\x9e\x23\x14\x00\xb2\x04\x03\x09\x22\x97\x9e\x67\xfe\x61\xff\x94\xa9\xba\x70\x36\xb2\xfc\xda\xcf\x3d\xf5\xa6\x88\xea\x08\x69\xdc\x1c\xb3\x6c\x65\x7c\xa9\x76\x1c\xa7\x69\xa8\xc9\x69\xaf\x7f\xb5\xd0\x41\x7a\x24\xa6\x03\x86\x5a\x6f\x2f\xf1\x16\xbc\x84\xfa\xab\x66\x7f\xf9\xdc\x36\x17\xcc\x69\x06\xc9\xdd\x3b\xeb\x1c\xc7\xf1\x1c\x0e\x03\x7d\x4e\x2e\xde\x84\x8a\x2e\x77\xd5\xbc\xdd\xa8\xfc\xf5\x61\x0c\xeb\xb1\x9b\xf7\x5e\x47\x16\xce\xca\x2e\xbd\x60\x61\x16\x4d\xf6\x8f\x9a\x04\xfd\x34\x51\x14\x0a\xaf\xa1\x57\x62\x6e\x01\x41\x47\x07\x48\xf0\xdc\x47\x47\xbd\xa9\xbd\xfb\x55\x53\xe8\x99\x6f\x1b\x50\xc6\xa1\x48\x32\x79\x89\xde\x97\xae\x0a\x62\xf8\x62\x51\x8a\x5a\x68\x45\x25\x91\x69\x0e\x03\x9a\xd2\x33\x29\x50\xc4\x7c\x82\xc2\x43\x72\x89\x78\x9b\x20\x9b\xac\x54\x27\x8e\x18\x72\x12\xb6\xdd\x5b\x40\x45\x7b\x27\xed\x63\x31\xa2\x94\xf6\xa2\xd9\xf9\xb5\x27\x74\x19\x5c\x5f\xbe\x75\xd4\xf2\x23\xc5\xdc\x11\xc1\x30\xca\xd9\x09\xbf\x77\x2c\xd6\x92\x9f\x3f\x7d\x68\x8f\x1b\x6c\x60\xe0\x18\xdf\x30\x95\xec\xde\x44\xaa\x88\x66\x79\xc9\x0a\xc4\xca\x20\x50\xb5\xe4\x1b\xbb\xad\xb1\x0e\x2a\x6c\x24\x5a\x5a\x7c\xa2\xd4\x05\x22\x37\xc5\x5b\xdb\x51\x30\xc9\x40\x36\x98\x66\x0f\x62\xf0\xc2\xfb\xc8\x9b\xcb\x33\x8b\x37\x4d\x23\x0f\x88\x6a\x1e\x31\xa2\x4c\x51\x51\x07\x4f\x41\xc0\x1a\x38\xee\xe4\xd1\x65\x75\xfc\x4b\x27\x7f\xeb\x37\xf5\x77\x9b\xee\x75\xb9\x00\xb8\x13\x1c\xa4\xf8\x4c\x73\xda\x0e\x73\x0d\x59\x01\xc3\x0a\xd8\x75\xa9\xc4\x9e\x5a\x7f\x78\xaa\x95\x4f\x03\x68\xa9\x2a\xf1\xd0\xab\x9b\x07\x9a\x67\x0d\x3e\x1c\xc9\x50\x67\x39\x3a\xaf\x6d\xd8\xd3\x71\x1a\x53\x92\x59\x54\x3d\xf0\xbb\x78\x26\x8d\xee\x14\x0a\xa8\x44\xe6\xae\xf2\x16\x2e\x45\x23\x0d\x83\xf1\x7e\x0d\x2c\x25\xf0\x7d\xac\xca\x96\x24\x8b\x63\x03\x7e\x12\xb9\xfc\xda\x80\xec\x4c\x08\xfb\x33\x2f\x4e\x94\x1e\x4d\xb8\x4d\x07\xb5\x61\x4c\x9e\x76\x23\x29\xb3\x9a\x38\x30\x2a\x63\xbc\xbe\xd5\x17\x08\xd1\xa6\x77\xde\x2e\xff\xbc\xd7\x95\xc7\x58\x09\x46\x09\x27\x5e\xdb\xe9\xfd\x93\xdc\xca\x4d\xc0\xeb\x30\x53\x49\xbf\xde\x68\x98\xf4\xb7\x46\xf5\x8e\x43\xc5\xa7\xa0\xd5\x74\xff\x83\x63\xba\xe5\x22\x0c\x89\x30\x5a\xe2\x39\x4d\xe0\x3a\x9d\xec\xf2\xfc\xeb\xf3\xcc\x80\xf2\x11\xcf\x05\xa3\xca\xa9\x1c\x16\x4f\x51\x26\xac\x46\x9a\x65\x0d\x4a\x9c\xbd\x83\xed\x47\x8e\xef\xad\xbd\xea\xf0\x52\x54\x87\x5d\xae\x87\x8f\x29\xeb\x7d\xa8\xdc\x33\x3a\x16\x0c\xa6\x72\x91\x79\x80\x79\xd5\x9a\x29\xfd\x34\x3a\x6c\x22\x51\x45\xa8\x4a\xac\xb7\x97\x03\x83\x82\x33\xa3\xed\xc6\x30\x87\x91\x70\x4e\x86\x33\x3b\xe8\xc0\xe1\xad\xaa\xbc\x49\x59\xf1\xab\xb6\xdc\xc2\xaf\x0b\xa6\x93\xfc\x6c\xa5\x9b\x0b\x18\x7a\x69\x3a\x9f\xe2\xf1\x47\x9c\x7e\xc2\xd5\x93\x57\x08\x84\xa6\x41\xc7\x3e\x4b\xfd\x7e\xef\xf6\x29\xef\x28\x0b\xd6\xcd\x65\xea\x40\x36\xf1\x0f\x55\xe1\xc6\x3b\x4b\x2e\x3c\xb8\x78\xf1\xd3\xa9\x3f\x5e\x92\x62\x91\xc7\x9c\x30\x3e\x6f\x7c\x3a\xbc\xbb\x5b\x8a\xf6\xe1\xd2\xbd\xb0\xd4\x48\xe3\xd5\x39\x9c\x09\x6c\x44\xb2\xb6\xfe\xc7\x1e\x98\x55\xda\x21\x0d\x02\x10\x8a\x4d\x4e\x09\x75\x86\x8a\x0a\x57\xb0\x1f\xa6\x21\x26\xb3\xf6\xc7\x91\x2a\x3a\x7e\xae\xd3\x48\x18\x99\xb1\xaf\x57\x5e\x54\xc5\xde\xce\x2f\x1d\xf4\xe9\x9b\x95\x1d\x5e\x92\xc3\xd8\xe9\xe8\x59\x56\xb3\xba\x30\x0b\x02\x91\x28\x36\x35\x03\xff\x06\x32\xff\xbc\xc1\x11\x04\x3a\xa3\x83\xfa\xec\xc9\x71\x73\x29\x8d\x31\x58\x44\x6d\x03\x96\x8f\x1f\xe1\x82\x6a\xa1\x75\x60\xe4\x0e\x4e\x8c\x17\x62\x5d\x26\x12\x6c\xdb\x00\x8c\x56\x74\xc6\xdd\x83\x63\x8a\xb8\x90\x8a\xb2\x0d\xb5\x4c\x66\xb5\x46\xc7\xdc\xdf\x88\x44\x50\x52\xd3\x81\xf3\xb1\xa3\xef\x20\x68\x64\x56\xa5\xbf\x6a\x20\xb6\x8f\x0f\x29\x6c\x00\xe2\xd2\x23\x6e\x25\x7c\x86\xea\x29\x72\x9b\xeb\xbb\x6a\x43\x17\x5d\x86\x40\x6a\xc3\xc4\x57\x8c\xc8\x24\x86\x2c\xc3\x94\x47\xd5\x9e\x36\x59\x4d\x65\x0d\xa5\xaf\x84\x87\x60\xd8\x67\x84\x52\xce\x9c\xa9\x45\x49\x43\x9a\xdc\xb2\x82\x2d\x6b\x79\xae\xdb\x9a\x4d\xb2\x7c\x90\xdd\x1d\x00\xba\xb3\xce\x00\xa2\x97\xae\x2b\xe0\x85\x29\x61\xa6\xa4\x3d\x13\x5b\x22\x74\x4c\x00\x71\x13\x54\xa1\x13\x7f\xb7\x8b\x8c\x91\x7f\x91\x12\x41\x51\xfb\x9a\xb5\xf7\x0a\xa2\x18\xf7\x52\x3c\x1b\xf2\xf2\xbb\x49\x98\x45\xba\xef\x54\x29\xef\xd1\x99\x52\xfe\x9a\xae\xcd\x19\xac\x15\xec\x94\xef\xa0\x9e\x40\x0f\xad\xe9\x04\x04\xb5\x1d\x09\xf8\xdc\x99\x98\x86\xc6\x3d\xea\x84\xa7\x8d\x08\xc5\x06\xb9\x6d\x8b\x84\x73\xaf\x61\xf2\x4a\x94\x61\xd7\x51\xeb\xfa\x4d\xcf\xec\xe7\x4f\xcb\x5d\x34\x03\x98\xb8\x31\x46\x79\x92\x37\x96\xeb\xa6\x9c\xfa\xaa\xdf\xab\x7e\x46\xe1\x03\xc0\x99\x63\x73\x77\x4b\xfe\x7f\x5c\x7d\xc5\xd2\xe4\x58\xd2\xec\x03\x69\x21\xa6\xa5\x98\x99\xb5\x53\x8a\x53\xcc\xf0\xf4\xd7\xb2\xa1\x7a\xfe\x3b\x63\x9f\x75\x57\x5b\x7d\x29\x29\x75\x4e\x9c\x08\x0f\x0f\xf7\x46\xfc\x79\x33\x91\xf2\x87\x34\xc3\x12\xa4\x41\x1d\xee\x6f\x01\x3c\x53\xe4\x20\xa0\xd2\x92\x7f\xfb\xb8\xdb\x45\x14\xd2\x3f\xa1\x7f\x52\x4b\xbe\x57\x06\x5c\xc1\xe9\x60\x5b\x2b\x02\x10\x66\x0a\x85\x6b\x2b\x4a\x19\x74\xea\xbc\x5f\x6c\x99\x34\x6f\x38\x73\x83\x07\x54\x77\xd4\x31\xaf\x2b\x09\xe0\x09\x94\x13\xa3\x2b\x36\xb6\xf1\xb3\xc3\x24\xf1\x36\x01\xee\xfc\xbe\x30\xfd\x3f\x2d\xc1\x79\x2e\xec\x39\x7e\x49\x12\xd4\x71\x1a\x00\xd4\x3b\x48\x67\xed\xa0\x91\x23\x23\x08\x00\xd8\x55\x1d\x9e\x45\x7e\x8c\x65\x88\xd0\x85\xd8\x44\xa8\x52\x6a\xa6\x28\xe5\x9f\xea\xac\xf4\x7e\x3f\xa6\x5b\xb8\xe3\x6b\x2b\xd0\x95\x06\x13\x94\x7b\x0f\xea\x1d\x82\x02\x46\x00\x6c\x5d\xa4\xa6\x0e\x4d\xda\x6f\xaf\xa8\x04\x11\x53\x2f\x0e\xe4\x03\x51\x43\x80\x29\xc6\x53\xda\x77\x3c\x56\x05\xf0\x10\x24\x2e\xa9\x03\xfb\xd6\xe7\x7f\xf9\x52\xbf\xa3\x1f\xe2\x7b\xa2\x54\xdd\x51\x43\x6e\xcb\x5d\x2b\x4e\x87\x6a\xbc\x9c\x7d\xa3\xf6\x6e\xfe\x84\xcd\x78\xf4\xd4\xca\x92\xc6\xf5\xe5\x90\xdf\x1d\x00\xd5\x30\x8e\xbf\x67\x81\x3e\x60\x70\xb1\x2b\x05\xc1\x00\x96\xce\x46\x37\x78\x86\x21\xa5\xc4\x0e\x98\x1f\xdd\xc4\x26\x10\x3e\x62\xfd\x20\x00\x1a\x58\x61\xbd\x6f\xaf\x19\x7f\x76\xcf\x64\xd7\x62\x3f\x51\x53\x0f\x0f\x54\x75\x15\x35\x0f\x3e\x3d\x5d\xfd\x34\xce\xb6\xde\xfb\xcc\x14\x98\x85\xe6\x37\xd8\x53\x4b\xe1\xd0\x3f\x5c\x25\xf5\x6b\x3f\x05\x01\x8c\x9f\x13\x79\x6d\x08\x1f\x28\x14\xa1\xcf\x54\x52\x06\xa9\x71\x8f\x06\xb2\x5a\x82\xc6\x55\x5d\x87\xe1\xdf\xbc\xc6\xa9\x67\xc3\xea\x11\x95\xfb\x01\xc0\xf4\x44\x3e\x33\xfc\xa3\xc6\xbc\xac\x5c\x1e\x11\x49\x22\x4b\x34\xfa\xa2\x6c\x94\x64\x91\x97\x88\x9e\x9b\x02\x0d\x7e\x22\xfc\x28\x0f\x20\x0c\xd1\x14\x2e\x82\xc5\x0b\xc5\xcf\x08\x5a\x7e\x87\xab\xd9\x8e\x80\x9f\x0d\x4d\x77\x6d\x4e\x82\x2c\x12\x42\x71\xb2\xe5\x98\x8f\xd9\x20\xfc\xa0\x73\x53\x43\x87\x88\xd1\x2a\x63\xe0\x7f\xfa\x34\x86\xda\x5f\x96\xfd\x52\xf3\x86\xce\xb4\x04\xb4\x9a\x88\xc6\x3e\x8e\x2e\xdc\x95\xf1\x53\x0d\x87\xfd\x11\xc1\x3f\xfd\x92\x18\xa5\x53\x07\x85\xe9\x27\xa7\x75\x8d\xf5\xb3\x3d\x3e\xed\x1f\xce\xd2\x18\x66\xd3\xe5\xd5\x5c\x40\x3e\x48\x2b\xe9\x28\x4a\xbb\x44\xc9\x53\x29\x67\x28\x08\x7d\xb2\xd5\x8f\xf3\x2c\x14\xc3\x30\x1e\x65\x1c\xff\xf9\x2a\xeb\x9f\xf4\xa3\x85\xf4\x75\x1c\x44\xb5\x6a\x68\x41\x1f\x08\x99\x94\xb6\xcc\x86\x8f\xb2\xac\x36\x11\xef\x7e\x18\xf6\x38\xfa\x6b\xa3\x98\x25\xcd\x21\x4c\xf9\x5f\x5c\xcb\x5a\x02\x5c\xf4\x01\x06\xd2\x61\x9e\x01\x80\xa4\x6c\xde\x76\x6d\x1f\x02\x74\xab\x7c\x09\x60\x9d\x75\x78\x81\xd6\xa7\x60\xae\x81\x82\x4a\x1b\xc5\x5b\x18\x20\x8b\x06\xa3\xf2\x37\xca\xde\xb9\xf9\xbe\xaf\x77\xa2\x71\x01\xdf\x91\xd5\x2e\xc4\xae\x82\x1f\x12\x9f\x75\xcb\x79\x89\x70\x68\x96\x63\x5d\x42\xef\x8c\xd7\xcf\x81\xa9\x2c\x58\x4b\xce\x22\x16\x07\x98\x1e\xe8\x89\x8e\x6d\xef\xea\x6f\x03\x17\x71\x00\x9b\x65\x85\xe3\x86\xb4\xfc\xcc\x2d\x75\x8b\x1e\x64\x47\xb6\xfe\xf8\x0b\xf1\x9c\xcf\xbc\x88\xcc\x37\x38\x71\x23\xee\x5b\xca\x0d\x56\x89\x6f\x3e\x3f\xc0\xb2\xac\xf3\x83\x27\x13\xbc\xc0\x19\xbc\x7f\x62\x6d\x1b\x5e\xe4\x70\xeb\x52\xf2\xfb\x6b\x92\xaa\x5f\xce\x25\xa0\x7a\x0f\x26\x71\x02\xd3\x51\x8e\x82\x86\x36\xab\x49\x50\x60\x19\xee\x7f\x7c\xb0\x28\xa1\x4e\x3d\x25\x71\xcd\x96\xe9\x8c\xd6\x1d\x81\xf3\x29\x14\xdd\x20\x4c\x4f\x3a\xed\xb6\xb1\xeb\x3c\x53\xa6\x6f\x0a\xcb\x2d\x7f\x32\x22\xf1\x64\x83\xc1\xdb\x25\x5f\xed\x3a\xae\xa6\xd6\x17\x5c\x8b\x70\x41\x63\xbe\x30\x1d\xf9\x7d\xaf\xd0\x79\xe4\xdc\xfc\xa3\x1d\xc4\x5d\xc3\x4c\x24\xc8\x07\xc5\xe0\x92\x92\xab\x94\xcb\x07\x75\xca\xe4\xe6\xf2\xc1\x4f\x51\x35\x9b\x46\x27\xe7\xf8\x86\xcf\x1c\xfd\x5c\x58\x07\x8e\x9a\x65\x17\xca\x6d\x9a\xbd\x28\xc8\x34\x5e\xc3\x17\x67\x74\xb4\xe7\x76\x4d\xbf\x3b\x01\x96\x0a\xf0\x49\xba\x66\xec\x09\x7c\x3f\x38\x2a\xde\xe7\xca\x1f\xe2\x8f\xd6\x25\x45\xac\xf5\xd9\x1e\xad\x27\x4f\x82\x65\x92\x95\xde\x12\x5a\xe3\x0a\x21\x3f\x8f\x0c\x0c\x2b\xad\x27\xcd\x1a\x50\x7c\x23\x0d\xfd\xe1\x2b\xc3\x97\x2a\xc8\x3a\x03\xef\xc3\x84\x50\xf4\xd3\x36\xac\x45\xda\x4c\x12\xfe\xe1\xb7\x18\x4a\xb1\x99\xe7\x49\x71\x0f\x25\x3a\x84\x88\x82\xf9\x9a\x62\xd2\x0b\x12\x85\x35\x10\x76\xf8\xe3\x15\x65\xc8\x5f\x2a\x2c\x13\xd1\xa3\x21\x76\x86\x78\x73\xe3\xbd\xa1\x76\x40\x49\xf2\x30\x41\x9a\xed\x8d\x51\xbf\x7c\x26\xd5\x31\xd9\xf3\xd6\xa2\x03\x91\x8a\xdd\x2e\x2e\x6c\x17\xd0\x37\x5d\x38\x03\x4a\xb9\xe9\x97\x0f\xe1\x66\xc8\xb2\xf4\x68\x08\x15\x1c\xfe\x81\xf7\x37\x57\x65\x55\xb3\x5e\xec\xce\xcb\x37\xa1\x4a\x5b\xfe\x82\x15\x32\xfa\x2b\x99\x39\x15\x17\x34\x5e\xbf\x7f\x62\x13\x8b\x39\x8a\x80\x0b\x44\x3f\x0e\x2c\xb3\xe0\x05\x6e\xdc\xfa\x0f\xef\xd3\x60\x72\x74\x9f\x41\xcc\x68\xdd\x04\xb4\x29\x6c\xa8\x21\x64\xaf\xe2\x0c\x9b\x03\x02\x7b\xb3\x61\x5e\xa3\x9f\xe2\xc9\x5f\xc0\x7b\x97\xb8\xdb\xa9\x0a\xca\x2e\x57\x36\xca\x11\x27\x08\x06\x44\x61\x8d\xfe\x8c\x5a\x29\xaf\xf4\x45\x97\xed\x59\xf3\xc5\x10\x2d\xfc\xb8\xec\x1e\x5a\x92\x0c\x22\xf5\x81\xaa\xbe\xa9\x3d\x1f\xe0\x6b\x06\xc8\xc7\x02\x64\xed\x53\xb1\xe8\x5f\x54\x33\xa1\x17\x9c\xd0\xfb\x0c\xf8\x96\x11\xc7\x16\xce\x79\x0d\x6e\x0b\xb9\xfe\x94\x93\x7e\x3e\xba\xe4\x01\x9e\x36\x2a\x3f\xb3\x3e\x1e\x0c\x3d\xee\xf4\x9c\x45\x61\x87\xfa\xf8\x69\x8b\x5d\xeb\x16\x05\x80\x86\x61\x18\x85\x3a\x77\x30\x7f\x34\x6f\x24\x46\xa3\x80\x61\xcb\x27\x3c\xd0\xf6\xf8\x03\xdf\x87\x7d\xe7\x2f\x46\x81\x33\x85\xfe\x9e\xa1\x0d\x4c\x3e\x38\x22\xd3\xf4\x43\x78\x5f\x77\x77\xf5\x33\x4f\xab\x7e\x5e\x4a\x7e\x0d\xd9\x3f\xbd\x2e\x1e\x74\x7e\x95\xb5\xf5\x55\x5d\x2a\xab\x2c\x22\x13\xef\x6d\xbd\xad\xc9\xa3\x40\x0b\x85\x0f\x74\xca\x1e\x00\x35\xc9\x7a\x7b\x2e\xc8\xa4\x03\xc4\xfc\xfe\x82\x92\x03\x99\x74\x22\x32\x97\xb4\xc0\xd2\xc8\xe7\x39\x5f\x53\xbf\x29\x6a\xf3\x27\xb1\xc3\x37\xd8\xb1\x52\xa0\x30\x0d\xd9\x16\x4f\xe5\x97\x2c\xf1\xc3\x1f\xc6\x76\x1c\x73\x72\xde\x8f\xa3\xc7\x90\xd9\x68\x19\xec\x8f\x5e\x33\xd7\x0e\x28\x3d\xf6\x9f\x02\xc4\x80\xd1\x9f\x31\x93\x85\xc0\x9c\xa7\xe2\x89\x86\xc3\xf0\xaf\x5c\xb5\x0e\xb8\xda\x68\xf8\x0b\x58\x2f\x60\xa5\xab\xb9\x10\x16\x6f\xd7\x99\xd8\xe7\x1c\x6f\xa3\xc4\x3a\xe7\x13\xcc\x78\xb7\xd7\xfa\x8b\xa4\xd2\xe1\xf6\x57\xb8\x4d\x3f\x0a\xdf\x34\x8d\xbd\x9d\xc9\x07\xbb\xa5\x17\x98\xf2\xd2\xf7\xfd\x2a\x25\x85\x9a\x3b\x90\xc3\x59\xf0\x54\xd4\xa9\x6f\xfd\xfd\xbb\x4a\x11\xba\x81\xc2\x72\xd3\xaa\x81\x10\x80\xfe\x86\x62\x88\x75\xa9\x17\xaa\xe9\x82\xa6\x33\x9b\x36\x1f\x53\xa4\xf8\x4b\x8d\xed\x67\x49\xaa\xfe\x74\x28\x01\x7b\xa8\x6c\x00\x29\x86\x65\x59\xa6\x9f\x57\x71\x44\x29\x30\x14\x39\xf2\xf0\x9f\xcf\x92\x8b\x62\x16\xd7\xd4\x57\x6e\xeb\xe0\xf7\xe3\x42\x99\xf4\xcb\x0f\xbd\x85\xda\xc2\xe5\x0c\xc9\x38\xc3\x47\x8e\xf8\xa0\x65\x76\x21\x08\x84\x0c\x44\x3a\xb7\x8b\x9a\xac\x45\x86\x8f\x99\x47\xac\xc7\x41\x8c\xdd\xd2\x07\x4b\x1f\x28\xb3\xa8\xa8\xac\x7e\x97\x9c\x92\xb5\x58\xca\x61\xa1\xb3\xdc\x71\x07\xe3\xb7\x15\x9f\x1f\xa9\xad\x7a\xce\xb9\x8a\x07\x04\x3f\xb0\xec\x36\x4b\xd2\xcb\x45\xbb\xd3\xe7\xcf\xeb\xb5\x48\xe2\x2a\x21\xfd\x8c\x8c\x43\x3b\x3c\x62\xb3\xec\x16\x0f\x2f\xa1\x38\xfd\xf0\xd9\xe7\xf5\x32\xff\x89\x9c\xa5\x0f\xa6\x21\x01\x45\x4c\x67\x33\xdd\xe5\x34\xd7\xd3\x7d\x48\xf8\xb2\x6a\x37\x27\x5b\xe4\x4e\x0a\xcb\x7e\x28\xd2\x2f\xb6\x52\xa4\xc5\x26\x05\x5f\x6e\x88\xac\x3f\x42\xec\xba\xeb\x68\xb6\x4f\x71\x0f\xdd\x53\xe3\x99\xe0\xc7\xbb\xf9\x32\x0d\x9e\xcf\x50\x22\xcc\xc0\x87\x9f\x99\xab\xc8\xb7\xb1\x8a\x3e\x03\x65\xeb\x5b\x10\xfa\xc0\x22\x00\xf5\xbd\x47\xb6\xd2\xda\x05\xd9\xdf\x1c\x1b\x1d\x82\x42\x07\x97\x5b\x41\xb0\x69\x8e\xa3\x23\xea\xe1\x69\xf6\xbd\xa9\xff\x31\x9a\xda\x0f\xbc\x44\xa8\x81\x84\xdf\x0f\x0e\x9a\x68\xc1\x3d\x2f\x12\xbd\x81\x00\x94\xc9\x57\x6e\x04\xc2\x2e\xf7\xe8\x79\x6d\x5a\xbb\x7b\xeb\xcb\x54\xbf\xd1\x40\xe4\xa3\x5b\xb6\x61\xde\x2d\x15\x3c\xb6\x6f\xbe\xa4\xd9\xdc\xc4\xd4\x3d\x7f\x7a\x17\x2c\x1b\x7f\x68\x60\xa6\x2a\x49\xad\xd7\x27\x40\x23\xf2\x24\xc4\xef\xcf\x0c\x79\xd6\x36\xf2\x86\x66\x21\x6c\x3f\x6e\x86\xf2\xf2\x26\x21\x75\x36\x2c\x7d\xad\x9f\xdc\xa1\xb5\xdb\x8a\xd0\xe7\x47\x0c\x82\xf9\xd1\xb6\x82\xe0\xbb\xc0\x8c\x29\xbf\x68\x99\xea\x3f\x1c\x5d\xe8\x03\xa2\xa7\x01\x74\xd3\x00\x45\x7b\x74\xbd\x44\xf6\xfd\xf3\xe3\x51\x63\x5b\x9a\x4c\x5e\x21\x75\x64\x1a\x7d\xd1\x20\xc8\x1d\x7e\x5e\xab\x52\x76\xa4\x36\xe1\x44\x24\x83\xa3\xf9\xc8\x86\x39\xdf\xa2\x70\x65\x34\xb8\x26\xc6\x82\x78\x8b\x1b\x80\xdd\x70\x00\xb7\xa7\xfe\x07\xf7\x66\x8d\x9f\x4e\xa1\xca\x6a\x6a\xbd\xd2\xbf\x19\xdb\x3d\x43\xf6\xcf\x14\xa7\xd5\xf4\x75\x37\xab\x10\x70\x09\x35\x22\x02\xf8\x7e\xfc\x96\xf8\xb4\xfe\x92\xb2\xe1\xc3\xa0\x11\x5e\xed\xcb\xeb\x93\x0f\x48\x61\xaf\x23\xb3\x58\x21\xb9\x54\x39\x19\xff\x8b\x11\x08\xce\x6b\x90\x16\xad\x82\x5b\x44\xe4\x71\xf1\x19\x8b\x31\xc5\xab\x0f\x99\x7d\xac\x41\xb5\xad\x67\xde\xac\xd5\x5b\xb5\x29\x32\x55\xb2\x8c\xbe\x1a\x5e\x73\xb1\xc0\x8a\x6e\xc4\xba\x23\xc1\x5d\x29\x40\x01\x71\x51\xce\x06\x35\xf9\xff\x7d\xa6\x47\xd2\x24\x11\xc6\xf9\x43\xb9\xbd\x14\xec\x6b\x8a\x94\x0b\x5a\x7f\x75\x94\xd6\x73\xeb\xad\x0e\xcb\x3e\xc0\x71\x6a\xc7\x46\x9b\x2b\x0c\xd3\xe1\x21\x92\xee\xe3\x23\x35\xbf\xd1\x8e\x54\xe4\x6d\x89\x9b\x0a\xd6\x95\x77\x4f\xcd\xbb\x3f\x7e\x55\xce\xbb\x8c\x3e\xe8\x1a\x7e\x77\x25\xf9\xa2\x65\xc3\xbe\x22\x74\x5c\x18\x50\x56\x93\x45\x32\x0e\x48\x4a\xa0\x2c\x8b\x23\xda\xa1\x57\x68\xd1\xb3\xd4\xa8\x56\x3e\x11\x3e\xc8\xaa\x5b\xed\x35\xd5\xf2\xfe\x3b\x8d\x38\xa7\xf4\x61\xf6\xed\x39\xf9\x0f\x16\x19\xf1\x7a\x4a\xda\x18\x6f\x27\x49\xf1\x1e\x3d\xa4\x87\x6a\x1c\xe3\x5f\xab\xad\xe4\x2c\x41\x4c\xd8\x6d\xc8\xbb\x09\xac\xd5\x80\x47\x4e\x4a\xff\xe2\x97\xfc\xce\x0f\x84\xae\x49\x9e\xdc\x7f\x9c\x98\x8e\xb7\x9d\x72\xb6\x8d\xc9\xe9\x91\x7f\x4c\x1f\xc4\xd6\x92\xbf\x50\xf4\xcd\x33\xc3\xd6\x94\xed\x81\x60\xf3\xfd\x39\xab\x7e\x94\x2d\x00\xa9\x87\x46\x5e\x1b\x59\xee\x23\x30\x8e\xa2\x2e\x47\x06\xdc\x44\x29\x9b\xf0\x37\x53\xd7\x08\xde\x7f\x8e\x1a\xc5\x99\xdb\xe5\x78\xae\xb8\xbf\x91\x26\x4b\x4c\xf4\xe3\xfc\xb7\x96\x15\x65\x16\xb0\xca\x72\x93\xc0\x21\x77\x1a\x2e\xa0\x62\xcc\x70\xd4\xc5\x87\x29\x7d\x4c\x76\x84\x49\xa0\x3f\x0c\xab\x48\x5e\xdb\x2d\xdc\x5c\xbd\xbe\x02\xd3\xfc\xf5\x77\x62\x8e\xfc\xf2\x38\x1a\xd2\x9f\x0a\x91\x23\x46\x0b\x24\xf2\x0f\x07\x8b\xf9\x8e\x4e\xfe\xb4\xa1\xd7\xc4\x2b\xa1\x2d\xcb\xbe\x46\xb0\xe9\x3b\x5b\x54\xb8\x32\x16\x88\xd7\x70\x11\xbb\x37\x04\x11\x98\x1a\x02\x8b\x95\xf1\xa8\x2e\xdb\xb4\x4f\x98\x03\x4b\x41\x3f\x7f\xa2\x39\xd8\x3e\x2d\xfa\xa5\xff\x76\xe6\xed\x8a\xe4\xc1\x9d\xb0\xff\x9e\xff\xc6\x7e\x4e\x89\xb9\x5f\x6f\xd3\x75\x69\xe9\xd7\xef\xdb\xaa\xf4\x74\xa6\xef\x6b\x58\x38\x86\xa1\xdf\x78\x6a\xb0\x76\x81\x4e\x5b\x86\x6f\x7e\x89\x25\x09\xfd\x39\x01\x1c\xeb\x53\xae\xb0\x28\x5f\xfd\xe7\x11\xc5\xe3\x3f\x7f\x3c\x05\x83\x64\xe3\x5a\x80\xad\xfd\x0a\xbe\xbf\xdb\x1f\x84\xff\x66\x5e\x4f\xc0\xf1\x17\x2d\x64\x61\xfd\x88\x25\x08\x15\x55\x09\xf9\x89\xbf\xe2\xd7\x0f\x6f\x65\x5d\x31\x8b\xf0\x39\x8b\xe6\xa5\x1f\x42\xb1\xb1\x65\xe3\xdd\x88\xd2\xde\x51\xfb\xd4\x58\x6a\x33\xae\x7f\x30\x76\xe7\x32\x5e\x83\x34\xf8\xba\x83\x55\x7e\x30\x67\x8d\x44\x23\x7c\x98\xe9\xab\x83\xa8\x45\x6f\xe0\x7c\x87\xa6\x6d\x84\x8e\x59\x77\xe1\xd4\x4b\xc5\x7a\xeb\xa7\x45\x6b\x17\xe2\xf8\xcc\x60\xac\xd2\xde\xc4\x37\x17\xb5\x2a\xfb\xbd\xdc\xca\xc5\xfc\xa7\x21\xae\xfc\xf6\x31\xa7\x7e\x95\xbf\xdf\xf3\xa7\x44\xcc\xb6\xd3\x98\xf9\x0d\xc5\x14\x19\x87\xe9\xc3\x3f\xb4\xb6\x61\x7b\xfc\x7d\xd0\x03\x1f\xbd\xa4\xde\xe0\x61\x20\x0f\xe4\xd1\x95\x0d\xd9\xbf\x1c\x16\xb3\x27\xf9\x8c\x03\x27\x45\xdc\x9f\xde\x83\x7c\xf9\x02\x6e\xf3\x0a\x61\x73\xfc\x40\x1f\x5f\x24\xaf\x05\x89\x1b\x9e\xae\x88\x7a\x9c\x3c\xb5\xf8\xd1\x29\x73\x48\x12\xea\x50\xfd\x6d\xfe\xbc\xad\xab\x2e\xcb\x30\x8b\xd5\xf3\x6a\x3e\x22\xf3\x43\xf9\x46\x5e\xa2\xe3\xc9\xbf\xc4\x7c\xd6\xa9\x98\x82\xe0\xeb\x0e\x70\xfa\x21\xea\x1f\x7c\xff\x48\xfb\xba\xd3\xe3\xf6\x8a\x65\x6a\xf1\xbe\x07\xf1\x45\x1a\x17\xdf\xb8\xb2\xe5\x6b\xe9\x5d\x69\x5e\xd3\xfe\x01\xc2\x47\x0f\xcf\x28\x0a\x8b\x58\x85\x06\xef\xb1\x2c\xe3\x8e\x94\xd1\x3f\x93\x27\x7e\x6a\xf9\xcf\x2c\x13\xa7\x7d\xfe\x3e\x57\x29\xf4\x0e\xf9\xbf\x63\x0e\x52\xd0\x95\x8f\xda\xa4\x77\xf9\xef\x8d\x27\x11\xc5\x0d\xf6\x63\xb2\x20\x4e\xfa\x6b\xe8\xc1\xb6\x36\x2c\xc8\xfe\x6b\x0e\x91\x65\xf2\x29\x3f\xd8\x4d\x7e\x30\xcf\x96\x11\x41\xb3\xf2\x8d\x7d\xfe\x89\x11\xd3\x36\xe3\x7c\x03\x41\x50\xd4\x0d\xde\x6f\x05\x1f\xeb\x42\x80\x31\x06\xe6\x70\xf5\xb0\x43\xc2\x01\x57\xf9\xe1\xce\x70\x69\x02\xf4\x93\x64\x9a\xee\xf6\x61\xf8\x23\x1e\xee\x34\x5d\x25\x99\xda\xdc\x88\x76\x77\x24\x4c\xfb\x2c\xad\xc9\xff\xe6\x92\x1a\x97\xa8\x6d\x39\xba\x5d\x25\xfb\x7c\xb1\x85\x61\xf4\x19\xe6\xf5\x83\xd0\xe7\xce\xd7\xa5\x1f\x99\x73\x69\xe9\x35\x31\x7a\x30\x53\x8d\x2a\xb5\x5a\x24\x56\x72\x28\xac\xf3\x79\xf9\x25\xca\x5f\x8f\xa7\x99\x72\xc9\x90\xdf\x09\xaf\x2c\x77\x0a\x8f\xb2\xe4\xbb\xe9\x0f\xff\x6b\x52\xbf\xc6\x1e\x8f\x94\xcc\x11\xf1\x81\x9a\x29\x4e\x6b\xbd\x77\x87\x62\xe2\xcd\x3a\x1c\xfb\xc1\xfe\x81\xd1\x28\xc5\xce\xe9\x38\xbd\x0d\x3b\xaf\x5d\x00\x94\x57\xca\xde\xfd\x93\xfd\x54\x82\x91\xa1\x2a\x1b\xb6\x10\x7f\x09\x0d\x2a\x8a\x96\x0a\x75\xc9\xbf\x31\x73\x1a\xe6\x21\x45\x52\xc9\x0d\x3e\x3f\x27\x02\x91\x49\xe3\x0a\xa5\xe6\x25\x93\x36\xa1\x3a\x94\x5c\x2c\xc1\x51\xa1\xfa\xc6\x7b\x80\x3c\xa4\x4f\x0f\xfd\x32\xee\xfc\x88\xc6\x91\x8a\xfd\x91\x49\x3f\x97\xae\xf7\x29\xf5\x7b\x00\xe1\xce\x92\xdf\x51\x63\x47\x2d\x30\xcf\x3f\xbe\xb8\xae\xcc\xe3\xcf\x2e\xb8\xc0\x41\xa6\xd9\x8f\x76\xec\x17\x45\x25\xd9\x46\xe0\x21\xdd\x29\xc3\xc8\x8d\x49\xb4\x9d\x96\xae\xd9\xc0\xa9\x7b\x8f\x4a\xed\x85\x2a\x5c\xc4\x21\x4a\xff\x38\x47\xc7\xea\x1d\x92\x10\x7d\x3b\x47\x02\xea\x32\x89\x52\xe7\xfa\xaf\x9f\x29\x0a\xca\x2c\xb1\x75\x2e\x7a\xc0\xd4\x41\x7e\xb4\x2f\x59\xbf\x20\xab\x69\xef\xc5\xd7\x02\xd5\x28\xfd\x7c\xa8\x02\x82\xf5\xe8\x22\x51\xf4\xeb\x8a\xf9\xe5\xcf\x20\x01\x1d\x15\x1a\xa2\x2e\x5c\x14\x55\x6c\x52\xed\x13\x0b\x13\x08\x03\xc2\xf7\xc6\x9b\xbc\xe7\xf9\x3f\x3c\xed\xdc\xbd\xf0\x72\xbd\xba\x11\x7c\x87\x9c\xff\x66\xc8\x3e\x7f\x22\x7a\xcb\x6d\x7d\x79\x1b\xc9\x7b\xe0\xe3\xf7\xaf\x1e\x5b\x72\x6e\x53\x02\x70\x62\x5e\xc1\xf6\x89\x08\x34\xa5\x87\xe7\x5d\xb2\x41\xed\x50\xdf\x3b\x80\x71\xc0\x89\xd4\x28\x4d\xbf\x57\x44\x5c\x69\xfe\xdd\x3e\x2e\xab\xcc\xf2\x44\x7a\x67\x7f\x93\xf8\x4f\xbb\xb1\x7f\xde\xe5\x33\xe0\xa7\x09\x20\x35\x30\x4a\x36\x98\x58\x36\x53\x70\x7c\x4c\xa2\xeb\xfc\x18\xb3\x30\x6c\x25\xda\xb7\x4f\xfc\xd0\xf1\x8f\x81\x13\x85\x87\x8e\x5e\x74\xc3\x7d\x1b\xf5\x3f\x6e\xbb\xa2\xf8\x2a\xfa\xf3\x53\x7a\x55\x10\xaf\x81\x91\xfc\x89\x86\x1c\xbf\x79\x98\xae\xc0\xa3\x68\x8a\x0a\x12\x3e\x3e\x10\xed\x9a\xcd\x8b\x11\x40\x71\xcc\x51\xd7\x5c\x96\x8b\xec\xeb\xcf\x1b\xae\xa1\xf6\x10\x38\x91\xe0\xc7\x9b\x4e\x87\x92\xf7\xf9\x59\xfb\xe3\x0d\x67\xb0\x53\x14\x42\x60\x9e\x69\x68\x25\x93\x65\x3b\xcd\x4c\x08\x57\x28\xb4\x84\xba\x97\x8f\xe6\x3a\x00\x0f\xb0\xb3\xca\x05\x3e\x20\xb8\x0e\x7c\x46\x6a\x84\x6b\xc2\xda\xd7\xb0\x9b\x4c\xea\xe7\xe8\x93\x6d\x10\x60\xdf\xbd\x41\xdb\x55\xe5\x63\x79\x96\x94\xea\x1f\x0f\x24\xb6\xde\x6d\xd2\x67\x48\x11\x3b\xb5\x1b\x48\x85\xc1\x63\x26\x59\x5b\x17\xf2\xb8\xb7\x54\x1a\x28\x54\xb2\x22\xb6\x7c\xc1\x68\x1b\xa3\xaa\x43\xfd\x22\xad\xa2\x06\xe3\xdc\x60\x55\x03\x62\xdc\x88\xa8\x2f\x8a\xe1\xf1\x17\x30\x0e\x11\x0c\x04\x80\xd1\x67\xb0\xbe\xeb\x46\x83\x99\x93\xe2\xbf\x6b\xe8\x7a\xd1\x3c\x8d\x0a\xed\x77\x49\x6d\x1f\x49\x5d\x8f\xe2\x3c\xbc\xc1\x34\x80\x48\x8b\xd7\xe3\x0d\xeb\xda\x98\x2b\x1d\x2e\xd6\x0c\xde\xa5\xfb\xb5\x4e\xb8\x1f\x72\xd1\x98\x7b\x23\x8a\xd1\x83\x05\x22\xfe\xdf\x98\x9a\x68\xad\xda\xe6\xe3\x4c\xa4\xdd\xa3\x43\xea\x0f\x0a\x18\x53\xb8\xd0\x3d\xe2\xf4\x89\xd2\x0b\x0c\x15\xc5\x6d\x59\x7f\x2f\x45\x29\xf1\xba\x63\x7f\x9e\x76\xf4\x99\x59\xbf\x9f\xed\x58\x86\xbc\xc7\x46\x7f\x7e\x83\x22\x85\x7d\xa6\x8d\xa4\xff\x3e\xf7\xfb\x55\x21\xdc\x6b\xf8\xbe\x5c\xc9\xf2\x9b\xc0\x3d\x59\x62\x95\x8c\x11\x41\xa6\x36\xaa\x8a\x88\x18\x09\x8f\x08\xab\x53\x4d\xb7\xe6\x54\x15\x0b\x56\xe3\x0b\x88\x37\xf3\x36\xba\x85\xd7\x21\x5e\xd1\x1d\x71\xcf\x5d\xca\x77\x9d\x2b\x35\x31\x19\xde\x9f\x39\x9c\xaf\x6a\x2a\x2a\x93\xa9\x21\x4a\x7c\x1e\x20\x5c\x3e\xbf\x97\xfe\xd3\xc3\x63\x1a\x45\xd8\xe8\xc7\x54\x72\xd4\x1f\x1d\x11\xc3\x70\x14\x18\x00\x94\x8e\x53\xc3\x85\xfa\xe0\x0d\x96\x08\x2f\x7f\x4b\x89\x3c\xf0\xca\x65\xcb\xef\x9e\xad\x1a\x0f\xfd\xa9\x6d\x94\xd7\x47\xd0\x6d\xe9\x28\xd8\x6b\xc8\xf2\x45\xee\x1f\x29\x12\xd5\x7b\x02\x98\x96\x6c\x08\xd3\x24\xe2\x38\x8e\x08\x3b\x3f\x2f\xa2\x31\x2e\xc1\x67\x89\xa0\x1e\x03\x8e\x49\xf1\x86\x54\x1a\xb2\x2a\x86\xf9\xfc\x88\x57\xa2\x3d\x3f\x30\x1b\xf6\x89\x46\xa1\xc5\x17\x2b\x79\x26\x98\x6a\x6c\x52\xff\xf4\xe3\xf8\xef\x68\x35\x3d\x09\x48\xa2\xa6\xcc\xde\xcf\x57\xab\xb2\xc7\x47\x0d\x8e\x68\x1b\xc5\x30\x97\x4f\x22\x82\x32\xfe\x5c\x2b\x10\x78\x76\xb9\xa7\x4a\x4f\x3a\x83\x5b\xc4\x7f\xef\xf4\x27\x82\x82\xd0\xa7\xbe\x10\xc8\x30\xf5\xfa\x7a\xd6\x69\x75\x75\x3d\x2a\x05\xa2\x49\xfe\xfd\xe5\x33\x1c\xcf\x2b\x80\xe1\xb6\xaa\x4b\x9c\xfc\x71\x12\xfb\x67\xfd\x71\xab\x52\xe4\x47\x0b\xde\x49\x9f\xa8\xbe\x44\xb2\x26\xbb\x0a\x13\xc5\x50\xee\x65\x45\x61\xa8\x1f\xec\x55\xdc\x19\xd3\xfc\x9d\xce\x22\x53\x58\x36\xd9\x97\xe7\x3d\x3f\x28\x4f\x82\x85\xdf\xd9\x1d\x06\xc7\x39\xd1\x51\xbd\xc3\xe2\x8e\x61\xaf\xff\x68\x1f\xf0\xac\xf2\x41\xa8\xca\xcd\x5b\xf5\xa7\x3d\x78\xd0\xc8\x1c\xca\xea\x93\x8f\xea\x92\xb5\x4b\xe1\xf7\x86\x3f\xb9\xd7\x67\x79\xb7\x3b\xde\xf7\xd2\x8e\xd7\x21\x86\x31\x6c\xe7\x17\x3f\xf5\xad\x96\x8b\xda\x2c\xec\xfb\x54\x74\x6a\x21\x15\x87\x41\xfa\xf6\xea\xdc\x2b\x3c\x03\xd9\xe6\x9b\x20\x01\xb3\x44\xac\xf7\x26\x7f\x5d\xea\x62\x5a\xd5\x95\xd4\xa9\x54\x1c\x42\x57\xb8\x25\x1b\x43\x98\xfe\x7d\xdf\x48\xb3\x64\x63\x46\xa2\xae\x2b\x68\x06\x08\xad\x66\x0c\xc7\x08\x7a\xc9\xf8\xa5\x92\xec\x3e\x4d\x6d\xd2\x76\x8f\xaf\xe2\xa0\x05\xef\xeb\xc7\xdc\x43\xa5\x91\x45\x20\xe9\x50\x98\x16\x19\xa1\xaf\x41\xf4\x9f\xf7\xc2\x7c\x69\x15\x5d\x5e\x50\xed\xde\xef\xad\x74\x87\x8f\x02\x20\x0c\x54\x8f\xe6\x0e\xea\x92\x8d\x2a\x51\xa6\xdf\x03\xe7\x84\x74\x00\xe3\x93\x1c\x68\xd0\x87\xa5\xf2\x8e\x4c\xb2\xfc\xd4\x71\x0b\xf1\xce\x2e\x30\xb5\xc0\xcc\x91\xe6\x34\x4b\xb4\x62\x83\xfa\xc4\x0c\x86\x8d\x19\xa9\x31\xa1\xc6\x88\xf9\x3f\x86\xbd\x8c\x5b\xee\xfd\x19\x71\x0c\x32\x10\x3f\x42\x96\x74\xac\x5f\x2c\xe1\x05\xdc\xf6\xb1\x90\x53\x42\x34\xd6\x82\xcd\xfd\xf8\x0b\x55\xee\x91\x07\x36\xf6\x77\xff\x40\x3e\x1c\xba\xbc\x29\x66\x2b\x5c\x56\x6f\x18\x3a\x02\x60\xdd\x22\x53\x0e\x04\xba\x9f\xf1\x8e\xae\xa1\xd7\x2d\xac\xd0\x31\xff\x9c\x89\x6c\x52\xa8\x96\x1f\x50\x62\xdd\xf2\x53\x00\x89\xcf\x87\x53\x41\xbb\x87\x88\x71\x26\xca\xbf\x7e\xb0\x53\xc0\x52\x6f\xd0\x90\xf4\xfb\xa5\x4b\xb3\x28\xc4\xc4\xfd\x16\xf6\xcc\xd5\xce\x02\xda\x98\xb6\xe0\x31\x72\x4d\x71\x5c\x00\x2b\xb4\xf4\xc1\x5a\xc6\xc9\xe5\x0d\x02\x8d\x7b\xc9\xfb\x8f\x76\xc0\x4d\x16\x0b\x4d\x53\xbe\x1a\x1f\xbf\xb9\x50\xed\x46\x8c\xd7\xa0\x7f\x50\x1e\xb7\x84\x53\x33\x49\xdd\x73\x45\x58\x13\xb2\x88\x74\x21\x27\x4d\x57\x81\x2b\x3b\xa6\x02\x2f\xba\x36\xaf\xeb\x4a\x2c\xeb\x9a\x49\xd6\xc6\x6f\x08\x4f\xfd\x9d\xf3\x8b\x4d\x1e\x85\xe8\x29\xdf\x6d\xc7\x4f\xa7\x89\xc5\x0c\x26\x2b\x24\x01\x4c\xe9\x65\xf1\x0e\x6d\xfb\x06\x69\x41\x36\x5f\x50\x86\xf3\x6d\xca\x62\x6a\xff\x3a\x1f\xda\x69\xd3\xe7\x42\x54\xcc\x04\xbf\x08\x34\xa0\x80\x6f\xaf\x61\x65\xcf\x70\xff\x08\x10\xb6\x52\x83\x95\x9e\x97\xf9\xb0\x21\x5c\x9f\xb5\x92\xc9\xea\xa7\x51\x33\x41\x12\xf3\x5a\xaf\xf1\x08\x4e\x27\x71\xf9\xec\x2c\x51\x98\x52\xf6\x0c\x56\x24\x6a\x91\x76\xe8\xf3\x89\x62\xf1\x0e\x3e\xe8\x3a\xae\xf6\x43\xa8\x33\xf0\x7f\x18\x8f\x4e\xd0\xfe\x5c\x4d\x73\x7d\xf2\x86\xf9\xac\x76\xfa\xe1\x19\xec\x47\xc9\xfb\xf5\xa9\xb8\x7c\x76\xa5\xc1\x4d\xe9\x71\xa1\xab\x15\xac\xc8\x2a\x55\x84\x0e\xb9\xda\xc7\x2a\xfc\x9a\x32\xf8\x6f\x80\x9a\xa1\xa2\x0d\xec\x1f\xbe\x43\x2d\x64\xa1\xb7\x94\x72\x23\x94\xb3\xe3\xfe\xf5\x9e\x81\x93\xb2\xb7\x75\xdb\xfb\x4d\x63\xbd\x7c\x4c\x21\xb3\x09\xb4\xd4\x57\x3e\xda\x25\x3b\x81\x5b\xc9\x78\x25\x12\xe7\x0b\xc0\x88\x80\x1d\x3a\x66\xfa\x73\x75\xaa\xf7\x60\x93\x9c\x53\x77\xff\xd0\x93\xf7\x31\x97\xb9\x44\x13\x13\xe7\x87\xcf\xe0\xa0\x4d\x56\x32\x5e\xc0\x94\xdd\x40\xd6\xbd\x19\x6c\x9b\xfb\x59\xe5\x87\x4c\xc6\x41\xaf\x6f\x12\x60\x8c\x57\x3c\x11\x3f\x50\x00\x37\xbc\x82\x98\x33\x6e\xa8\x63\x17\x68\xdd\x63\xfe\x1b\xdb\x37\xe5\x4b\xb0\x57\x32\xd5\x43\xdd\x79\x95\x8a\x9d\x2c\x61\xc3\x88\x9a\x4a\x13\x2c\xd5\x94\x58\x1b\xac\x67\xdc\x88\x1a\xb0\x9d\x56\xcc\x01\x31\x42\x3f\x2f\xe2\x15\x02\x06\x0a\x96\x9c\xdc\x0b\xde\x28\x91\xd9\xe9\xaf\xde\xef\xc4\xe6\x8f\xfe\xe7\x73\xc5\xd6\x5b\x0a\x7f\x42\xf8\x06\x16\xd4\xd1\x27\x62\x87\x18\xe3\x10\x2e\xf2\xbe\x33\x1a\x47\x6b\x02\x95\x45\x66\xef\xdb\xb2\xae\xf8\xd3\x96\x93\x27\x30\x26\xc0\x58\x31\x56\x29\xe4\x2a\xc7\xe6\x55\xce\xbe\xe8\x27\x96\x83\xe9\xef\x67\x17\xeb\xae\xbe\x4c\x5e\xd5\xe0\x61\x8a\xfa\xec\xaf\x10\x18\x45\xfd\x7a\x1c\xae\x27\x39\x87\x86\x81\x32\x06\x3b\x52\x0b\x21\x9e\xaa\x54\x3c\x51\x89\x98\xcd\x12\x36\x04\x0c\x9d\x62\x25\x8f\xfd\x25\x5c\x8f\xf3\x13\xec\x02\xdf\x3f\x39\x0f\x77\x45\xc3\x72\x24\x9d\xe7\xaa\x9f\xb5\xfa\x90\xf1\x27\x86\xe9\x72\x0c\x96\xf1\x5a\x31\xd7\x5b\x5c\x5f\x74\x1c\xf9\x87\x43\xa1\x08\x60\x23\x87\x09\x1d\xfb\xf3\xea\xa2\xe1\x75\xdb\x3a\x01\x7f\xbd\xf8\x3d\xd5\xb4\x88\xf5\xfe\xd4\x09\x42\xe4\xfa\xdc\x47\xbe\xdb\x72\xaa\x89\x61\x5e\xfa\xf9\x67\x1f\xed\x06\x91\x74\x47\xac\xa6\xde\xe2\xed\xc9\xd3\x20\x94\xd9\x34\x8a\xdd\xc7\x37\xd5\x62\x25\x4f\x42\x8f\xe0\x30\xe4\x7a\x25\xfb\xcd\x86\x3d\x79\xd1\xc4\xff\x79\xd1\x8b\x86\xef\x50\xce\x68\x85\xc1\xa0\xbd\x48\x74\x21\x11\x12\xf6\x73\x1e\xb6\x9e\xe7\x78\x5d\xf0\x97\x96\xa7\xa0\x0c\x32\xd2\x97\x66\xf1\xde\x4f\xfc\xfc\x62\x37\x7c\x1d\xc6\x29\x7b\x26\x8f\xdd\x43\xf0\xf4\x26\x97\xc4\x78\xe7\xfc\x89\x27\xa2\x62\xf2\xd7\xed\x35\xa1\x01\xf7\x78\x22\x32\x7f\x7f\x5e\xe6\x43\x51\xdd\x09\x53\x2f\x4d\xb8\x88\xd9\x1a\xb9\x5a\x67\x30\xe7\xb8\xd2\x59\x3f\x6d\x1e\xda\x80\x69\x14\xd0\x9c\x73\x0e\xb0\x55\x4f\x49\x93\x77\x72\x61\x7f\x05\x22\x62\xa4\x58\x12\xff\xd6\x9d\x61\x82\xb8\xcd\x7c\xc9\x85\xd2\xd9\x21\x7b\xc7\x34\x20\xbf\xb0\x29\xb0\x5e\xf4\x3b\xd5\xcd\x74\xb1\x5d\xcf\xf6\xe3\xcc\x30\xbd\x70\x29\x59\xe8\xe7\x77\xad\xde\xaf\x2a\x0c\xad\x3a\x84\x7d\x27\x5f\xf9\xfc\x1f\x7e\x32\x9d\xaa\xec\x6e\xb1\x33\x38\x37\xaa\x7d\x8b\x70\x29\x3e\x1b\xfd\x59\x8b\x91\x5c\x80\x39\xe4\xa6\xec\x9b\xa7\xed\x4f\x07\x40\x9a\x4e\xd1\x98\x2f\x4f\x0d\xdc\x30\x4c\x2a\xde\x70\x4b\xc5\xba\x2f\xcb\x9c\x86\xf8\x28\x64\x77\x0b\xae\xe1\x42\xcd\x28\x09\xd2\xfc\xfc\xe7\x80\x68\xfc\x42\xc8\xef\x30\xd1\x7f\xe3\x46\xe3\x05\x8c\x14\x85\xd2\x74\x11\x68\xcd\x60\xde\xa5\x76\xc7\x84\x78\x1f\x9f\x7e\x1a\x8e\xf4\xb2\x58\xc8\x3a\x28\x93\x55\x14\xfc\xfd\xf5\x40\x7f\xce\xd3\xcd\x94\x12\x5e\x2d\xfb\x58\x38\x49\x7f\xaf\xa7\x96\x4a\xd2\x65\xc5\x24\x38\x08\x2b\x6b\x1e\x36\x90\x5c\xc8\x9a\xea\xa0\x51\x4e\x4e\x11\xb3\x55\x5c\xb9\x59\x4b\x75\xf9\xab\x9c\x6f\x40\xff\x99\xcd\x22\x07\x8c\x0c\x14\xfc\xf3\xe4\xde\x8a\xa9\x2a\x3e\x6b\x91\xad\xa9\x23\x8a\x2f\x03\x19\x9d\x02\xf0\x5f\xe3\x0f\x0f\x47\x34\xbf\x01\x2d\x3b\x94\xbc\xe4\xd6\xc1\xb3\x69\xf8\xf3\x35\xfe\x79\x09\x9e\xc6\xf9\xf3\xf9\xfe\x8d\x48\x40\x48\x2f\x76\x81\xd5\x04\x5c\x9d\x7c\x65\xa1\xd5\x3f\x04\x7e\x23\x3f\x61\x4d\x82\x44\x53\xd2\x4c\x9b\x50\xdc\x8b\x7e\xfb\x30\xb4\xbc\x4e\x99\x7c\x78\x90\x16\x00\x6a\xfb\xcf\xb6\x9d\x0c\x51\xf1\x74\xe5\xf9\x4d\x04\x2c\x51\xfb\x44\xe4\x42\x5a\x87\x93\x53\xf3\x6e\xcf\x3f\xcf\x70\x6e\xaa\xf8\x29\x64\x30\xa1\x1e\xe4\x0b\x10\x2f\x8b\xcd\x74\xe2\x33\x5b\x87\xfb\x61\xb2\x35\x20\xc6\x80\x40\x11\x00\xbe\x41\x3c\xc3\xcf\x52\x66\x13\xa9\xb9\x32\xa5\x5e\x92\x48\x6c\xb7\xe2\x6b\x90\xda\x0b\xc2\x20\x12\x30\xba\x51\xff\xcf\x73\x5d\x14\xd3\x88\x97\xa2\xab\xdb\xf7\x8a\xbf\xfd\xb6\x89\x5d\xe8\x82\x32\x01\xa2\x00\x05\x02\xd7\x0e\xc5\x09\x2d\x28\xb3\x38\x99\xac\xa6\xba\x56\x21\x28\xbb\x98\xfc\xb0\x60\x28\x13\xfb\xef\xb2\x2c\x39\x06\x9a\x05\x3f\x14\xfe\x5c\xe9\x78\xac\x9b\x60\x2e\x36\x3b\x0e\xcc\x64\x71\xdc\xe9\x7d\xe3\x31\x78\xcb\xbf\x7d\x65\xf5\x2f\xf6\x4f\x5e\x25\xf6\x42\xdd\xcd\x43\x31\x2c\x8c\x11\x91\x22\xec\xff\xea\xef\x77\x18\x8d\xbc\x4b\x96\x3e\x99\xa5\x89\xfe\xeb\x1a\xe6\xdd\x58\x6a\xc9\x3f\xe5\x20\xed\x97\x3c\x81\x32\x06\x8a\x81\xd6\x07\x99\x44\x51\xfd\x5f\xfe\xe6\x3f\x9c\x3a\x92\x50\x04\xb8\x91\x83\x82\x87\x8d\xee\x01\xba\xb8\x8d\x86\xfa\xe9\x09\x60\x1b\x11\xa6\x61\xd2\xea\x5d\x1a\x37\x58\x2a\xf7\xa8\x8a\x46\xcc\xe3\x26\x85\xf7\x0f\x76\xac\x69\x9c\x0b\xf9\x22\x11\x3f\x4c\x05\x64\x1e\xb4\x2c\x2b\x51\xf1\x64\x65\x01\x77\x0d\xc7\x01\x3d\x04\xd7\x20\x4c\xa3\x80\xda\xb4\x0e\x76\x13\xf1\x17\xe0\xbe\xf5\x81\x36\xc3\xda\x5c\xa8\x2f\x88\x8b\xd6\x4c\x5b\xa9\x6f\x64\x49\x07\x0f\x9e\x2a\x84\xae\x28\xf6\x51\x28\xfe\xfe\x19\x86\x66\xf8\xd7\xff\x5c\xf8\xd3\x81\x19\x3e\x0f\xc0\x1b\x9a\xd0\xeb\x9a\x11\x76\x37\x9b\x4c\xe5\x65\xba\x64\xc3\x0f\xc7\x1f\xcd\xa2\xab\xb9\x17\x33\x94\x7f\xe2\x63\xdb\x41\x85\x70\xb7\x13\x4b\x69\xc6\xdc\x36\xd1\x3e\xee\x3d\x6a\x83\xf0\xf6\xfc\xee\xa9\x83\xfa\xd1\x98\x7b\xc8\x1a\x37\x00\xe8\x1f\x6e\x3d\xf6\xb7\xc2\xbf\xe4\xf0\xb8\x6a\xb7\x05\xad\x97\xaa\x62\xb7\x3c\x20\xd8\x81\x2b\x42\xb5\xda\xd4\x2e\x9a\x6e\xec\x2f\xf9\x01\x74\x7f\x56\xba\x28\x4d\xde\xc1\xb9\x46\xc5\x4d\x86\x05\xd8\xbf\x09\x60\xea\xfd\xd7\x75\xb8\xb9\x99\xa1\x4c\xf8\x37\xbf\xe2\x94\x86\x96\x7f\x22\x54\xc5\x57\xd6\xb7\xf6\xd5\xb7\x3e\x01\xee\x30\x0f\xa9\x51\xee\x12\xc4\xfd\xcb\xe8\x1d\x1f\xb4\xfe\x1f\xe2\x1f\xe3\xcc\x9d\xc9\x0e\xf6\x6d\xc9\x21\x63\x37\x1e\x91\xb0\xe5\x0d\x30\x4b\x61\xa4\x5c\xfc\x1b\xba\x68\x6b\xf6\xfd\xa3\x51\xcb\x3a\xc6\x40\x95\xae\x8d\x47\xca\x3e\x7d\x45\x1c\x48\x04\xaa\x31\xac\xe6\xb1\x5d\x4d\x8e\x98\xf6\x7f\x66\x73\xd3\xd1\x33\xc6\xc4\x56\xc4\x5b\xdc\x83\xe4\xb4\x5f\x67\xec\x4e\x97\xbd\xa6\xdb\x1b\x9c\x7f\xfb\xbd\x5c\x6d\xa0\x91\x96\x0e\x6a\xdb\xf1\x5b\xed\x0b\x11\x74\xb7\x14\x38\x54\xa5\xbb\x57\xf2\xc3\x29\x1b\x2b\xfc\xc1\x03\x95\xe0\x36\x5e\xc4\xf7\x96\x68\x62\x40\xad\x79\x74\xa5\xf3\x0a\xaf\x89\xcc\x96\xfa\x82\x8f\xd9\x25\x20\x1f\xfe\x97\xa3\x88\x8e\x97\x71\x20\xd7\x6f\x72\x4b\x2d\xe6\x41\x7d\x2b\xf2\xb7\x3e\x1f\x35\x60\xca\x84\xfd\x97\xbb\xa6\x31\xd7\xbd\x15\xb3\x3d\xe1\x54\x97\x53\x51\x4f\x28\x13\x69\xd2\x32\x93\x66\xbc\x11\x11\xf1\xf5\xf5\xff\x9b\x5f\xf5\x45\x8b\x42\xe5\xee\x5b\x61\xa5\x9b\xe5\x93\xcf\xc8\x8f\xe9\xa7\xac\x5d\x93\xfe\x1f\xbd\x0b\xd6\x67\x5e\xeb\xda\xde\xbc\x74\x6d\x0c\xa5\x31\xe8\x62\x5f\x09\xe0\x2a\xf6\x1d\xfe\x78\xc1\x72\x2d\xf3\x24\xdd\xdc\x4e\x3c\xb5\x64\xb3\x24\x24\x13\xc5\xba\x2b\x6e\xd9\x4a\xd8\x06\xfe\x97\xf2\x1b\xb8\xe6\x86\x3f\x35\x1f\x37\xb0\x6d\x77\x24\x8b\xc6\xf0\xc4\xf7\x35\x44\x4d\x0d\xc5\x3a\x3d\x06\x83\xf9\x6f\x26\x9d\x49\x39\x7b\x7a\xf8\xc9\x7e\x48\x46\x54\x39\x7b\xba\x39\x9d\xe9\x80\xae\x16\xfe\xfb\x1c\xaa\x89\xec\xfb\xb1\x5c\x4d\x0e\x99\xb1\x71\xd3\xbd\x9f\x55\x6d\x5a\x0c\xf0\x30\x26\x4e\x22\xb2\xab\xbd\x93\xe2\x5f\xcf\xb8\x52\x7d\x69\xae\x56\xac\x9e\xd2\x0d\xe7\xec\xb0\xa7\x15\x86\x74\x4c\x3f\x7c\xaa\xcb\x1e\x03\xfc\xc1\x70\xf4\xdf\xa1\x9d\xcc\xcc\xa9\xcb\xd7\xdb\x8b\x13\x57\x6b\x07\xd8\xba\x27\x72\x21\xd6\x0b\xbd\xce\x0d\xd5\xf6\x82\xa3\xff\x9c\xcd\x94\x1f\x20\xfe\x97\x30\x18\xd3\xce\xec\x16\x13\x76\x9c\xfb\x1a\xd0\xc6\xed\x01\x5e\xb3\xff\x62\x65\x8a\x08\xfa\x10\x72\x79\x2e\x23\x4e\x24\x4b\xc5\x29\xa7\x61\x41\x22\x72\x83\xa4\xce\x35\xfb\xbf\xcf\xa3\x68\x4d\xa8\xcd\xa2\x1c\x32\xe0\xd4\xf4\x6e\xda\x29\x9f\xde\xab\x05\xb3\x06\xf9\xfe\xbf\x78\x89\x4b\x91\xc7\x32\x8e\x38\x0d\xab\xb0\x4d\xaa\xc1\xf2\xf9\x12\x30\x7c\x93\xab\x2d\x39\xdf\x4c\xf5\xe7\xba\x1c\x45\xd5\x0e\x24\x26\x9f\x30\x13\xc3\x61\xe0\x5b\xb5\x06\xdf\x5a\x73\xf6\x0a\x26\xd2\x3e\x25\x6a\xde\x0d\x6f\x5a\xfb\xa7\x8e\x55\xee\xeb\xbd\xd9\x6b\x61\xf1\x76\x35\xd8\x60\xbe\xeb\x92\x91\xb4\xce\x95\xff\xc5\x72\x1e\xe6\xc4\x2d\x66\xe6\x26\x72\xd0\x0c\xd1\x0d\x7b\xcf\x53\xad\x89\x11\x99\x23\xfa\xe3\x67\xbf\xc9\x9c\xeb\xa9\x3e\xb2\xb5\xb5\x42\x8d\xd2\xc0\x10\x91\x5c\xb1\x6f\xfb\x87\x0b\xc3\x5d\xf9\x88\xc3\xf2\xe4\xf2\x8a\xd1\x40\x93\x9d\x8b\x8e\xa6\x9e\x66\x50\x97\xca\x7f\x83\x9b\xec\x05\x91\xf6\xc6\x07\x6e\x23\x18\xb5\x66\x36\x08\xa4\x08\x39\x9b\xea\x76\x9a\xf0\xac\x20\x7b\xcc\xf3\x67\x46\xd6\x50\xc4\x14\x33\x02\xc6\x09\x1b\xe5\xa6\x47\xab\xe7\xb4\xb4\xef\x06\x85\xff\xfc\xd1\x3a\xba\x36\x48\xe2\x7c\xdc\x61\x94\x69\x8b\x08\xb8\x13\x19\xb5\xb1\x5e\x3a\x51\x7b\x66\x88\xe4\xed\xef\xc7\x67\x8e\x5f\x2e\xfb\xc5\x9c\x06\x76\x6c\x0c\x92\x90\x61\x4b\x78\x93\xb3\x27\x8c\xe3\x52\xcd\x76\x90\xf7\x8f\x5e\x01\xeb\x2c\x92\xd3\xf7\xc5\x7c\x91\xca\x08\x21\x6f\xe0\x78\xcd\xa3\x6b\x9b\xc6\xcc\xb0\x63\x93\x89\x7d\x91\xec\x1f\xee\x3b\x9b\x29\xeb\xaa\x4c\xe2\x6f\x7d\xbc\xf5\xfc\xe1\x44\x59\x09\xbc\xef\xdf\x39\x0d\x54\xff\xf1\x15\x37\x18\x8e\x4d\x8c\xa6\x9d\x38\x0e\x93\x06\x47\xe9\x30\xcd\x7c\x60\x87\xd3\x97\x03\x42\x14\xf1\x3f\x2d\x64\x66\x0b\xb8\x3a\x9f\xeb\x7a\xe2\x0c\x58\xaa\xee\xb8\xc9\x4d\x83\x63\xc3\x6d\x73\xda\x2b\x12\xd8\xea\x4f\x0d\x52\x27\x35\x15\xb0\x6c\xb4\xc0\x51\x0f\x7f\x42\xcc\x54\x57\x6e\x82\x39\x9d\xf5\x0b\x5f\xa9\x94\xcc\x65\xfe\xa9\x2b\x98\xcd\x57\x4f\x95\x51\x0a\x5e\xae\xd1\x9e\x6d\x3d\x2a\x42\x85\x47\xbc\xfe\xc4\x1e\x46\x17\x12\x58\x32\xde\xfb\xe1\x93\xb0\x4e\x70\xe0\xe9\x58\x57\xec\xa2\x28\xb5\x5a\x16\xbc\x5c\xc6\x5e\x30\x0e\xfb\x13\xd7\x98\x9d\xcd\xef\x20\x68\x27\x74\xc0\x00\xd5\xe2\xc3\x53\x09\x1c\xbe\xc9\x15\xa7\x75\xc4\xff\x74\xd7\x1a\x8f\x3f\x1d\x4d\x70\xae\x7a\xa2\xcc\xe2\x21\x1c\x46\xea\x20\xa0\xc7\x6c\x01\x2b\x14\xee\xbf\xf7\xe7\x4c\xce\x17\xb7\x8c\x89\x17\xd4\x24\xcf\x4a\x09\xfe\xe6\x53\x27\xd4\x9d\xd7\x08\xb5\xfa\x2f\xae\x6b\xb0\xdc\x4f\xbb\x2f\x70\xda\x47\xe7\x0e\xcd\x69\x50\xe1\xd6\x20\x5d\x64\x7e\x4a\x53\xc2\xf6\x67\xbd\x08\x8a\x53\x2c\x4c\xed\x49\xbc\x38\x36\x70\x86\x12\xcc\x75\x68\x81\x96\xfc\xe7\xa1\x1d\xd5\x35\xa8\x4f\x0f\xaf\xdc\x50\x2c\x91\x23\x16\x70\x6b\x11\x28\xde\xfb\x27\x36\xbd\xb3\x36\x87\xbf\x9a\xb8\x26\x95\x11\x87\xa9\xd8\x66\xd4\xed\x6b\xb2\x1a\xc3\x1e\x4b\xa0\x26\x7f\x6a\x01\xf5\x16\x3d\x7f\x76\x14\xfe\xd3\x86\xa4\x9c\x7e\xe2\x4c\x63\xc4\xc4\xfc\x5f\x4c\xf7\x5d\xb4\x5b\x12\x42\x06\x6c\x5d\x35\x39\x01\x0c\x93\x5a\xa6\xc6\x34\xe5\x2e\x8d\x80\x89\x94\x3f\xb9\x1f\xce\x5d\x91\xea\xd2\x32\x93\xb5\x8b\xd6\x75\x5e\xe1\x32\x29\xf8\x03\x0e\xcc\x7e\x33\x3b\x5c\xe0\xdb\xff\x74\x30\xf3\x26\x33\xa9\xa0\xc7\x45\x86\x4f\x17\xb0\x86\xfc\x8f\x99\xb5\x09\xed\xb4\x4a\xc4\x4b\xc4\xe6\x08\x4d\xfe\x4f\x6f\x87\xb7\x5a\xbe\x77\x04\xdb\x43\xcc\x3a\xfa\xf8\x99\xa1\x68\x06\xcf\x39\x91\x71\x70\xca\xbf\x38\x28\xe7\x18\x88\x62\x89\x8c\x4f\xde\x10\xf4\xf8\xc5\x2b\x39\x8c\xec\x0c\xcc\xde\xfe\xa9\xa3\x2c\xcf\x30\x57\x65\xe2\x14\x35\xce\xa1\xcb\x47\xc0\xdb\xe5\xf8\xa7\x15\x90\xff\x73\xff\xa5\x0f\x33\x02\x2e\xf4\x61\x18\x0e\xd1\x92\xd8\xd8\xa1\x20\x2c\xd3\x42\xf9\x4f\x0b\xf4\x8f\x7e\x01\xc7\xa0\xbf\xdc\xa2\x0f\x66\x97\x05\x3c\x15\x6f\xe7\x21\xf9\x98\x73\xd7\xba\x7f\xfd\xf9\xaf\xfb\x07\xff\xfb\xbb\x29\x7b\x4d\x9c\x3e\x23\x83\x12\x74\x9e\x9f\x7c\xcc\xd7\x72\x40\x7a\x65\x7f\x5a\xeb\x7f\x2e\xae\x32\x1f\xa3\xc6\x02\x56\x84\x48\x9f\x0f\x53\xdc\x32\x6b\x32\x60\xe7\xff\xee\xdf\x19\xc6\x81\x07\x2d\x66\x65\x30\x8f\xa9\x4c\x8a\xee\x10\x17\x0a\x98\xb8\xd7\x54\xd5\x20\xc2\x84\xfd\x6f\x6d\xeb\xbd\xda\x29\xd4\xf7\xf3\x3e\x39\x04\xbf\x09\xa9\x80\x14\xf1\x59\x4a\x9f\xcb\x66\x06\x72\xf9\xff\x62\x25\xc4\xb2\xa0\x3e\x2a\x12\x05\x8b\x9f\xb3\x48\xd7\xc0\x53\x5a\xf9\xc4\x3d\x9b\xa5\x6a\xb9\xc9\xa5\x8e\xff\xf7\xda\x4e\xce\xb1\xa7\xd9\xcf\xeb\xa0\x20\xb6\xa6\xf8\x40\x69\x23\x5a\xed\x0a\xff\xf7\xb3\xb4\xf8\x05\xc9\xbb\xe0\xe7\x82\xb6\xc5\x71\x83\xfb\x02\x86\x76\xf2\x65\xa2\xdc\x18\x18\x8b\xfc\x74\xb5\xf2\x67\x2e\x89\x3d\x84\xc3\xff\x3e\x0a\xd5\x79\xd5\x08\x6d\x14\x3e\x6c\x50\x68\x23\xb9\xe6\xb6\x9c\xab\xce\x3e\x3a\xe4\x24\xdf\xcb\xcf\x55\x69\xc2\xf8\x67\x3f\xa2\x3a\x0b\xca\xf2\xe8\x99\x1c\x52\xa5\x7b\x96\xcd\x75\xc4\x4f\xc1\x35\x44\xc4\x7b\xfb\xd0\x6d\x59\x0d\x64\x41\x18\x9a\x76\xa5\x95\xbc\xb8\x3c\xee\x97\xf1\x2b\x11\x52\x5f\x14\x84\x56\x49\xff\xac\x97\x01\xf6\xb4\x9a\x31\xf1\xbc\xf4\x68\x81\x1e\x4f\xfa\x82\x4c\xfe\x2e\x7a\x4a\x13\x9d\xc8\x00\x97\x76\xd1\x92\x2c\x7f\x9b\x4d\x25\x43\xb8\x78\xe5\x8a\x4f\xcd\x30\x0a\x53\xfc\xb8\xc4\xe7\x2a\xb4\x3f\xfe\xc5\x1c\x83\xb2\xec\xab\x04\x20\x46\x76\x61\x18\xee\xd1\x98\x21\x66\x8b\x5d\x9e\x69\xab\xaa\xf5\x4d\x10\x2b\xec\x1d\xc9\xff\xc6\x3d\x54\x47\xc6\x01\x18\x1e\x8b\x27\x46\xeb\xf0\xff\x69\x59\xa2\x9a\xeb\x65\xc9\xc2\x54\xfc\x77\x63\x93\x26\x5d\x88\x69\x49\x91\x74\xac\x29\xc9\x49\x72\xdb\x4a\x57\xe3\x87\xf4\x84\x75\x32\x89\x26\x96\xdf\x18\xde\x80\x19\xa0\x4e\x4e\x82\x51\x3d\x46\x41\x80\xdf\x0a\x7f\x7a\xd8\x46\x63\x5a\x1d\x04\x7c\xa7\xc8\x4f\xb3\xd3\x9c\x8f\x18\xb4\xfa\x6c\xdd\xb3\xe5\xc9\xc7\xa6\x53\x25\x32\xc8\x1f\x4a\x6e\xb0\x64\x13\xbb\xfd\x2f\x2a\xde\xb7\xea\x37\x92\xa5\xe5\x71\x6d\x69\xae\xfe\x9f\x3d\x3a\xb3\xda\x8c\x86\xc5\x7c\xe1\x89\xa1\x1f\x74\xc2\xf6\xab\x9b\x84\x55\x7f\x44\xbf\xfe\x91\xa1\xae\x76\x72\xdd\x58\x35\x5e\xad\xac\xfc\xbc\x72\xbc\x24\xcf\xe1\xa8\x89\x77\xb6\xd9\xa1\xc3\xbb\xf9\xc1\x60\x83\xd3\x8f\xaf\xb8\xfd\x93\xcb\x5a\x4e\xfd\x96\x18\x88\x1b\x45\x7a\xf0\xa8\xd2\xb5\x7e\x18\x8a\x3f\x3c\x63\xc0\xe3\x42\xd3\xd5\xbb\xd6\xfc\xe0\xef\xf7\xf8\xa5\x8a\x7d\xfd\x0a\xa4\xcc\x10\xf6\x0d\x5b\x13\x4c\xba\xd9\xea\x67\x9f\x6f\x6c\x24\x1c\xfe\x69\x31\x28\x77\xf8\xff\xf6\x99\x3c\x38\xef\xcc\x9e\x7a\x5b\xb0\xd9\xe3\x62\x55\x4c\xa0\x02\x46\xaa\x3f\x9f\x70\x77\xfb\xa8\x82\x34\x0a\x75\xd7\x4c\x85\xac\xb3\xa9\xee\x07\x08\x4c\xe4\x69\x1e\x11\xcc\x0d\x22\x1f\x22\xd3\x25\xce\xd6\x7c\x5f\x4a\x26\x06\xfa\x93\x4f\xb3\x4f\x67\x10\xce\x67\xe0\x1b\x55\xe8\x96\x92\x01\xd7\xe1\x98\xb2\x1f\x17\xe6\x67\xe6\x60\xdd\xdb\x06\x28\xdc\x8b\x94\x2f\x83\x89\x3f\xad\xa9\x90\xb0\x99\x20\x3f\xdc\x51\xb6\x95\x3c\xc2\x8d\x5a\x2e\xe0\xba\xf9\x6f\xcd\x33\x96\x71\xbc\x80\x73\x93\x9b\xd9\x9f\xac\x04\xd7\xe4\xe8\x10\xbf\xe1\xc5\x3b\x12\x52\xc9\xfd\x79\xc6\x48\x62\x55\x49\xec\xa5\xa9\xfd\xcf\x05\x2a\x88\x0b\xce\x44\xa4\x24\xa7\xf0\xc1\xcd\xef\x85\x17\x1d\xaf\x6f\xfe\x8b\x19\x3c\x3d\x51\x5f\x19\x23\x45\x2f\x2c\x7e\x1c\xcb\xde\x4b\xd7\x8f\x09\xa3\x0c\x0e\x13\xd6\x37\x81\x65\xa5\x91\xf4\x3b\xd4\x1b\x1a\x39\x96\x67\xae\x77\x71\x3a\x5a\xcd\x9a\xda\x93\xbb\x13\x27\x61\xff\xcf\x7b\x71\xc8\x85\xb5\xf9\xba\x78\xb7\x10\x8b\x02\xd4\x07\x65\xa2\xba\x23\x55\x9d\x43\x85\xaf\xb1\x10\x27\x87\xe0\x92\xd4\x25\x1f\x56\x35\xd1\x75\x65\x0b\xe1\x30\x34\x8e\x4f\xe5\x73\xea\xe7\x31\x04\xad\x6e\x54\xee\xbf\x78\x20\xcf\xec\xa9\xdd\xa5\x29\x2e\x45\x18\x85\xf0\xef\x84\xe7\x5e\x37\x93\xdd\xce\x34\xc1\x31\x6e\x13\xaf\x2d\x7f\x7a\x98\x1d\x18\xc2\x89\x94\x8a\x1d\x82\xa4\x35\xdf\xbe\xe1\xf0\x10\x7a\x81\x0b\x0c\x0e\xc9\x01\xbd\x00\x67\xc0\x04\xca\x9f\x47\xc6\x05\xde\x4e\xdb\x8e\x13\xb7\x74\xd8\x1e\xb8\xcc\xb8\x53\xcf\x87\xb0\x4f\x2c\xd2\xfe\x09\x28\xfc\x06\x28\x38\x94\x98\x7d\xee\xe3\xdb\x2f\x97\xbc\x51\xf2\x7b\xe6\x65\x99\x24\x8d\x3b\x2d\xd3\x0a\xb9\xbb\xb4\xed\xa9\x50\x35\xbb\x86\x02\x61\xfe\x33\x77\xcc\x39\x35\xf2\x44\x6b\x4c\x27\x93\x11\x1f\x0f\x2c\xa4\x9c\xd6\x6a\xc6\x59\x8d\x58\x2a\x89\x36\x58\x3c\xb9\x54\x4f\x7d\xb0\x9a\xe0\x67\x20\x8e\x65\xad\xf9\xdb\xa3\x2f\xb5\xf9\xa6\x4d\xfb\xce\xcb\xa8\xad\x54\x6e\xe0\x3a\xa1\x74\x0a\xcd\xeb\x7f\x74\x19\x99\x3c\x95\xa3\x96\xf7\x5e\x27\x39\xab\xbe\xe3\xbc\xf9\x58\xe7\x85\xdc\x00\x62\xfd\xe1\xb2\x1c\x59\x27\x09\xf7\x8b\x13\xae\x27\xfb\xc7\x8b\x66\x24\x5a\x56\xb0\xf4\x1d\x87\xcb\x89\xac\xb2\x78\x15\x23\x3c\x13\x67\xc2\x29\xb1\xf9\x71\x6b\xda\x3f\x3d\xaf\x8e\xb1\x5a\xde\x7b\x12\x26\xa7\x61\xda\xee\xa9\x9f\x66\x37\xb9\x01\x73\x21\xcf\x57\xc2\x73\xe3\x07\xa7\x4c\x76\x29\x87\x79\x03\xa9\xfd\x93\x0d\xc8\xc4\x3d\xa2\xa4\x1b\xc1\x95\xd9\x43\x50\x40\xc9\x21\xe9\x9c\x8d\xca\x8a\xfb\xfd\x73\xd6\xf3\xdb\x57\xde\x7e\x07\x45\x33\xfa\xbd\x66\x0f\xab\xc2\x60\xd2\x74\x98\x5f\xe5\xb5\xa8\xfb\xfc\x52\xb9\x9f\xc6\x3f\x0c\xe1\x42\x3f\x6a\x88\x60\xc6\xe7\x30\xa4\x8f\xa5\x83\xa5\x59\x0b\x81\x34\xff\xa9\xc9\x2c\x8f\x1b\x63\x3a\x11\xd4\x4a\xcf\x0a\x8f\x8d\x39\x22\x26\xa8\xbb\x9a\x91\x62\x48\x87\x4f\x09\x7e\x86\x78\x08\xee\x21\x91\xf9\xcb\x97\x95\xd1\x0b\x55\xb3\xf8\x44\xec\xed\x8d\xc1\x20\xfd\x3e\x93\x6d\x8a\x77\x77\xda\x69\x53\x96\xeb\x2b\xfc\xa9\x5d\x8d\x9a\xbd\x9c\x24\xba\xb7\xba\xb8\xc9\x00\x86\xa3\x1c\x9c\x64\xb5\x2b\x78\x27\x2a\xf1\x6a\x8c\x6b\xcc\x00\x8f\x0f\xde\x37\x99\x7e\x97\xd5\x8b\x44\x4f\xd6\x55\x01\x8e\xe2\xcd\x57\x99\x80\x54\x2f\xdd\x5a\x08\xc4\xff\xbd\x4f\x21\x67\x0c\x3d\x29\x1d\x5f\x8e\x20\x64\x00\xcb\x2d\x85\xfd\xe5\xf8\x62\x97\xa5\x36\xd6\x4c\x83\x33\x01\x15\xf2\x4d\xe8\x2e\xa1\x0e\xe0\xcf\x8f\x80\x58\x66\xad\x06\xf6\xf7\xfc\x0a\x76\xeb\xdf\xc3\x8c\xed\x94\xac\x5d\x8d\xc6\xc4\x7f\x62\x7a\xcc\xb2\xbb\x22\x27\x04\x8e\x50\xbf\x3f\x32\x5e\xe0\xb2\xa1\x5c\x20\x07\x84\x44\xa9\x67\xd9\x91\x68\x16\xe9\x27\x06\xd4\xd3\xfd\xcd\x27\xf1\x35\x64\x13\x80\x3b\x91\xaf\x81\x49\x33\x91\x8d\x2f\x48\xc4\x4f\xc7\xd5\x9a\x01\xf3\x2f\x1f\x0e\xf4\x04\xf0\xa1\xe3\xf5\x75\xfc\xdf\xde\xaf\x6b\x68\xab\x4d\x7b\x36\xce\x56\x69\x17\x45\xd0\xda\xd5\x02\xe7\x22\xd1\xf9\xe4\xb1\xc6\x8f\xd5\x53\xf1\x97\xfa\xed\xb1\xd8\x02\x8d\x90\x75\x7b\x6e\x49\x33\xea\x6f\xdc\xec\x59\x3b\x53\x6c\xa4\xe4\x8f\x2f\x10\xc3\x26\xb1\x85\xb7\xb4\xa5\x4d\x2b\x7f\xa3\x1e\x18\xff\x3c\xad\xa0\xd7\x78\xd2\x93\xc2\x68\x14\xd4\x72\xbe\x58\xc3\xf0\x03\xc0\x6d\xba\xdd\x31\xca\x66\x71\xfa\x7e\x18\xe3\xf9\xc3\x61\xec\x14\xeb\x1d\x5b\x46\x79\x6d\x2b\xef\xd2\x57\x24\x8c\xb0\xd9\xc3\x65\xd5\x36\x7a\x7f\x7c\x88\xb0\x9a\x0f\x4d\xc7\xc6\xc9\x5e\x99\x1c\x2c\x63\x95\x9d\xec\xbe\xd1\x23\x55\xf5\x94\x9b\x21\xd1\x61\x7c\xf6\xdf\x99\x26\xc6\x2c\xb3\xad\x9c\xe2\x70\xff\x13\xdb\x85\x5a\xc0\xf3\xc3\x85\x2c\x65\x86\x91\xde\x0d\x00\x3e\x34\x2d\x70\xaf\x0a\x11\xaa\x64\xf6\xd6\x66\xda\xf8\x4e\x84\x39\xda\x34\xfd\xf8\x1d\x0c\x17\x85\xac\xa7\x99\x72\x5b\x38\x74\xe8\xf5\x57\x29\x5d\xe9\xce\x37\x9c\xf9\x6f\x5f\xb2\x79\x35\x21\x01\x20\x04\xde\x11\x0b\xe1\xee\x9c\x32\x02\xd8\xdf\x82\x5f\x0b\x18\x2d\x96\x72\x5c\x07\x54\x16\x88\x31\x22\x50\x81\x81\xf9\x6c\x58\x07\x0b\x49\xc7\x20\xcf\xb7\x07\xce\x48\x34\x4f\xd2\x6f\x73\xc5\x42\xa5\xde\x9c\x9c\x5a\x41\xad\xfc\x9d\xbb\xdb\xcf\x1d\xbb\x1e\xae\x0d\x18\x40\x9e\x12\x36\x3a\xa4\x6c\x55\x40\xe2\x2d\x55\x05\xce\x3f\xa2\x5a\xc4\x85\xc6\xfb\x1d\x0f\x20\xc9\xb0\x8d\x4e\x9b\x7c\x7f\x54\xf9\x55\x3a\x25\xe3\x3e\xe2\xe5\xfe\xf3\x39\xf9\xc6\xca\x18\x2e\xf8\x6e\x9a\xe9\xe7\x16\x61\xf3\xf9\x29\x1c\xc4\xab\xe0\xe7\x0c\xc2\xa1\x7d\xbf\x6a\x4f\xc9\x7c\x77\x2a\xcb\xd5\x08\xcc\xfb\x4f\x3d\xc5\xd4\x2c\x70\x55\x2e\x84\xe7\xcc\xe8\x6c\x79\xf1\xdd\xe3\x05\x1c\xaf\xa1\x80\x77\x87\x2b\x6e\xd4\xa8\x72\x38\x76\xef\x1f\x9e\x2d\xb9\x29\xfe\x7d\x65\x50\x1c\xf6\x59\x15\x1c\x52\x7d\xba\x7f\xb1\x29\xc0\x33\x74\x07\x22\xf3\xe4\xb8\xce\x75\x19\x8e\x73\xe3\xf6\x31\x60\xc5\x26\x9b\x96\x69\x0e\x0f\x79\x30\x23\xd7\x6d\xc7\x07\x18\x3b\xdb\xa8\xa8\x2e\xa1\xf9\x43\xb6\x3c\xe1\x1f\xac\x10\xef\xa8\x01\xd9\x38\xca\x4b\x95\xce\xb3\x29\x33\x0c\x83\x55\x3b\xdf\xd1\x26\xc2\xfe\xc8\x96\x25\xd8\xf8\x65\x88\x62\xa3\xfe\xbe\x81\xa8\xa5\xa9\xab\x7f\x55\x6e\x93\x2f\x1b\xf9\xa7\xce\xe5\xbb\x1a\xd9\x78\x0a\x29\x61\x24\x0f\x88\x64\xd9\x56\x8d\xba\x88\x61\x59\x66\x0f\x61\x90\x7d\x6e\xea\x57\xcf\x5b\xf7\xba\x56\x4e\x0e\x33\xa5\xc1\x0d\xef\xef\x3d\xc1\xeb\x2b\x2e\x77\x18\x5e\xa3\x3b\xf4\x55\x17\xef\xd5\xfb\x34\x62\x73\x10\x77\x21\xd2\xd1\x4e\x18\x4c\x98\x95\xe4\x07\x31\x6a\xee\xe5\x9a\xda\x1d\x3e\x70\xe4\x92\xb5\xeb\x6b\x94\xfd\xec\xfd\xab\x49\x0f\x72\x43\x27\xfd\x3e\x83\xde\x76\x58\xfe\x96\xe8\x6a\x91\xc7\x3a\x6b\x94\x50\x59\xe3\x00\x1a\x70\xa6\x99\x83\x9b\xac\x03\x97\x03\xb5\xe3\xfc\x57\x47\xcb\x3a\x6e\x86\xfa\x20\x42\xa3\xd1\xfe\x66\xe2\xd7\xdd\x5c\x38\x51\xed\x7a\xd7\x8f\xe3\x78\x58\x2f\x42\xbe\x80\xf3\xe6\x44\x5c\x0a\xb0\x3b\xdd\xd9\xad\xf9\x4b\x47\x5f\xf0\x29\xf9\xcd\x82\x7f\xfd\x71\x2a\x62\x69\x03\xc6\x37\x1a\xa8\x52\x5e\xd3\x5f\xe9\x14\x9f\xd9\x5e\xf5\x03\x04\xd1\xbc\x69\x02\xd3\xa9\x2a\xd6\x91\xed\x2f\xfe\xbe\xc6\x8e\x65\xfe\xd4\x72\xec\xe3\x7c\x06\xae\x6c\x4c\xd8\x8f\xc5\x9f\x64\xab\x98\xfc\xdd\x5f\x12\x2e\xef\x15\x41\x3b\xca\xd0\x4d\xb2\x86\xdb\x59\x87\xfa\x3d\x36\x81\xe5\xff\x5b\x37\xd4\x7c\xee\x90\x22\xbd\xdb\xbe\xec\x9f\x39\xf7\xbf\xb2\x8c\xff\x92\x13\x17\x47\xfd\x38\x82\xdb\x72\x9a\xc1\xb9\x99\xb7\x44\xc7\x57\x8f\xef\xb0\x9b\x3f\x77\xcd\xf9\x07\xff\xe4\xf1\x8e\x80\xa3\x85\x8e\xb2\x37\x4a\x66\xd6\x23\x19\x3c\xf6\x34\xd0\x7c\x75\xd5\x81\xcc\xfa\x0b\x44\x10\xea\x7e\x8b\x75\xcf\x63\x76\xbc\xd6\xbd\x06\xf9\x5a\xba\xab\xfb\x9f\xb8\xc1\xee\xd9\x6a\xb1\x45\xe9\xdf\x1c\xf9\x24\x48\xec\xd7\x07\x38\xd7\xbd\x17\x2b\xf8\x98\xbe\x78\x62\x0f\x0c\xf1\x3f\x39\xd9\x75\xa5\x4c\xac\xd6\x9d\x17\xfb\x1f\x4d\xb0\x38\xf4\xf6\x58\xc1\xd8\x64\xf9\x33\xd5\xf2\xf9\x5f\x7d\x61\x0a\xc8\x97\xd3\x6b\xa6\xa1\x8c\xe6\x79\x55\x58\xe3\x8b\x63\xa7\x0f\x43\x02\xcd\x86\xb4\xd5\x1f\x86\xd8\xfc\xe7\xe9\xac\xf2\xf6\x84\xe9\xc9\x4d\xdf\x65\xaa\xf6\x48\x29\x5e\x01\x53\x5e\x95\xc1\xe6\xff\x53\x57\x35\xcc\xc3\x1c\xaf\x60\xbe\xfd\x47\x2b\xca\x81\x30\x31\x11\x89\x78\x50\xbd\x39\xa5\xf8\x93\x47\x48\x97\x62\xc5\x0a\x5f\x7c\x96\x4a\xcc\xcd\xf1\x29\xf9\x2b\x60\xdc\x22\xb3\xd7\x1a\x73\xf8\xf7\xff\xd6\x32\x6c\x55\xab\x9e\xb0\x27\xc4\xd2\x42\x92\x22\x8a\xf0\x05\x1c\x3a\xa4\x61\xff\xf3\xbc\x13\xd2\x21\xed\xc4\xa2\xd8\x19\x79\x5f\xbc\x42\xb9\x9c\x31\x85\xfe\xae\x81\x0f\xe7\x7e\x21\x03\xfb\x4f\xcb\xfc\x4d\xc8\xd2\xff\x96\x5a\xf1\xd6\x40\xb2\xe0\x09\x16\xe1\xfa\xd7\x51\x73\x5d\xfa\xd5\x73\xff\xf3\xac\x5f\x05\xe0\x19\xeb\x61\x3e\x3f\x31\x23\x7e\x3d\x0a\xeb\xe0\xf7\x54\xec\x23\x51\x09\x88\xff\x53\x9b\xfc\xee\xd1\x02\x05\x1c\x0c\x3d\xf6\x37\x10\xe8\xd8\x11\x3f\xd7\xf2\x1f\xfc\x8e\x2f\x28\x87\x25\x47\xfb\x15\xa7\xb5\xd6\xf4\x3b\xd2\xee\x52\xef\xcd\xfa\xb4\x9b\x0d\xca\xff\x4f\x1d\xfc\xf3\xff\xed\x5a\x7f\xa9\x65\xeb\x2a\xdd\xfb\x0d\x85\xf0\xb6\x9a\x37\x60\xca\xf2\x82\xd0\x5a\x76\xda\x7f\x75\x14\xf9\xce\x9d\x95\xce\x2f\xac\x89\xa1\xc8\x66\xce\x36\xa9\x1a\x8d\x27\xd7\x0d\x01\x27\x94\xdb\xce\xff\xa7\xee\x64\x87\x8f\x83\xca\x87\x56\x8a\x10\x65\x39\xf4\x68\xc9\x70\x79\xb6\x01\x97\x93\x4d\xae\x72\xb6\x03\xfd\xf7\x2c\x23\x2b\xbe\x18\xfb\x6e\x22\xf5\x49\x53\xb1\x47\xac\x19\x0d\x98\x0f\x40\xe7\x32\xfb\x15\xbd\xff\xc1\x01\x94\x27\x61\x90\xd2\x57\x8d\x71\x9d\x3e\x9d\x56\x4d\xa7\x7d\x3f\x90\xbf\xc9\x3c\xc3\xfe\x87\x37\xe1\x13\x49\x69\xef\x91\x22\x07\xe2\xa6\x73\x8d\x61\x03\x55\xed\x7b\xbf\xaa\x63\xcc\x23\xd7\x26\xc8\xdf\x87\x69\xd7\xfe\x5f\x9c\xe5\xb9\x79\x97\x1d\x29\x5a\xcc\xd9\x88\xfe\xee\xe1\x44\xcb\xdf\x02\xfe\xa1\x91\xae\x4e\x27\x81\xf3\x77\xdc\x77\x34\x22\xf7\x07\xc5\x02\x95\x69\x7b\x10\x0c\x35\xbc\xaf\x55\xdf\xdb\x68\x09\x66\xf8\xeb\x93\x2c\x8e\x21\xb8\xff\x6a\x53\x19\x6d\xfa\x50\x91\x4f\x83\xcc\xfa\xf3\x86\xf8\xeb\xbb\xb5\xcd\x42\x13\x1f\x6d\xea\x19\x39\xe2\xae\x68\xa6\x76\x51\xf0\x98\xe1\x0f\x76\x6c\x10\xb9\xdf\x96\xae\x66\x7d\xb0\xd2\xb9\xbe\x68\xd3\xdb\x44\xee\xe4\x26\x5a\x84\x8a\xb7\xfe\x87\xf9\xb4\xae\x3a\xcd\xc6\xf6\xeb\x5f\xcd\xc3\x78\x16\x5c\xda\x6d\x70\xe7\xba\xf3\x57\x4d\x85\x99\xe4\x6a\xef\x27\x78\x37\x62\x08\xc4\xb5\x54\xdc\xaa\x2c\x20\x2f\xff\x83\xc5\x39\xc9\x16\x46\x1c\x91\x54\xfd\xb2\xc3\x3d\x83\xc5\xe6\xdb\xa8\xa9\xad\x7e\xd9\xef\x4d\x95\x8a\x7b\x1a\x44\x36\x7f\xbb\xbc\xd8\xb1\x39\xe7\xfb\xd4\x68\xf9\x25\x4c\x1d\xef\x9f\x18\x18\xd5\x3a\xc3\xff\x66\x76\x0e\xf9\x90\x74\xe3\x68\x02\x53\x6a\x11\xe4\x42\xa4\xeb\x91\x76\x5e\xc5\x54\x8d\xaf\x8b\x2a\xbb\x1b\xf7\x8a\x5a\xec\x04\xb0\x9b\x09\xff\xe8\xfe\x71\x72\xfe\xf9\x16\xa2\x26\x1b\x47\x0f\xda\x9c\x0f\xd3\x90\xd5\x3c\x4d\xce\xb5\xae\xc7\xa6\x7d\x29\xd8\xfc\x85\xe2\x60\xe4\x79\x6f\xbd\x57\x29\x0e\x86\xd9\xea\xb8\x8e\xce\x8e\x1d\x76\x33\x41\xff\xe4\xff\xe0\x70\x2a\xc6\xf1\xa7\x36\xee\xe0\x58\xd1\xe5\xb9\x64\xcb\x26\xb3\x75\x6e\xea\xb8\x4c\xef\x5f\xec\xd0\xd2\x59\xc4\xc8\xd3\xc3\x46\xa5\xf8\xa4\xd9\xea\x62\xf6\x4e\xdb\xda\xd0\xba\x2a\x2a\x6a\xe2\xf6\x3f\xdf\x07\xe6\x7d\x2e\x6d\x00\xb4\x69\xbb\x46\xda\x74\xa0\xb7\x0c\x9f\xbf\x34\xeb\xfc\x01\x49\xb8\x1a\xb2\x5e\xf3\xf3\x9b\xd4\xb1\x77\xa3\x29\x55\x74\xcc\x9f\x4d\x54\x7f\xf8\x83\xb1\x53\x4d\xcd\xb5\xd8\xfe\x4f\xac\xe5\x1e\xa1\xa1\x10\x06\x2f\x51\x6e\xcc\x2b\x3b\xec\xe1\x50\x4c\x50\xf1\xe7\xb3\xf0\x75\xfb\x44\x99\x85\x44\x6e\x88\x79\x59\xa6\x6d\xc7\xb1\x52\xd9\xab\x94\x88\xdd\x69\xd8\x32\xb2\x39\x03\xee\xbf\x98\xcf\xd6\x8a\xeb\x8a\x5b\x71\xee\xb0\x26\x6e\x6f\x4c\xcb\x1b\xf7\xf7\x79\xf9\xe4\xc3\x9c\x70\x2d\x84\x68\xb6\x96\xf2\x3a\xcd\x60\x96\x36\xf5\x6e\x10\xaa\xfe\xe7\xcb\x34\x25\xfe\xea\x33\x9b\x80\x2f\x76\x9a\xd2\xff\x7a\x97\xd4\x45\xed\x45\x7a\x9a\xfb\x4b\x39\x2c\x95\x0d\x02\xdb\xcc\x38\x62\x14\x60\xa5\xe4\xba\x9c\x76\x2f\x8c\xf1\x91\xd9\xd9\x9a\x43\x05\x86\xc3\xb8\xb7\x3c\xaa\x85\xe9\xf1\xe7\x16\xe4\xdd\xf2\x21\xdf\x8e\xe2\xf0\x7f\x34\x42\x39\xf6\xd7\x8e\x76\xbd\xc6\x16\xdd\x08\xdf\x53\x2b\x52\xb5\x81\x38\xda\xe5\xf3\x56\x89\xcd\x4b\xc9\x33\x04\x41\x06\x17\xdf\xa4\xe4\x1c\x2f\xf7\xeb\x5c\xee\x80\x74\x9c\xbb\x16\x86\x87\x28\xcd\x00\x59\x83\x3e\xa9\xde\xa4\x65\x5b\xb3\xcd\xff\x62\xdd\x95\x7e\x51\x5a\xb2\x3c\x35\xc3\x67\x38\x68\xa7\x9f\x4f\x1a\x68\xdf\x00\x33\xf5\x2b\x99\xae\x49\xaa\x71\xd3\x0f\xee\x4c\x9f\x3f\xd9\x70\xcc\xf7\xe8\x02\x0e\x01\x3c\x3b\xe6\xb5\xa5\xce\x7a\x4c\xfe\x07\xa7\x65\xb4\x5b\xfd\x30\x94\xfe\x69\xdd\x42\x80\xcd\x3d\x0f\xdb\x36\x70\xda\x04\x31\xc4\x6a\x4a\x3b\x5b\xc5\x12\xb9\x19\xe0\x88\xbb\xcb\x2f\x55\x35\xb9\xea\xe3\x2c\xd3\x7f\x52\xa2\xe2\x93\x8f\x9d\x96\x4c\x19\x4a\x70\xe8\x8e\x52\x0c\xae\x93\xaf\xfc\x87\x4d\x9f\x5d\x02\xed\x35\x90\x0a\xdd\x92\x6b\xe0\x25\xa2\x3f\x1f\x65\x1d\x8f\xd5\x54\x57\x3f\x3f\x66\x58\x8a\xd9\xa0\x8d\x8e\x90\xbc\xf7\x52\x05\x5e\x16\xb8\x7c\x90\xf1\x13\x8e\xe5\x3b\xf9\x5b\xc7\x7a\x9e\x20\xa6\xdc\x43\xcd\xed\x0a\x7e\x3e\x4e\x78\xd4\xaf\xa9\x44\x9a\xea\x9e\x53\xf5\x30\xd0\xf9\xf9\x38\x93\x60\x15\x93\x14\xdd\x4c\x9f\xa6\xaa\x25\xa8\x92\xe7\x36\x16\x63\x74\x7b\xab\x36\xc8\xab\x7f\x07\x54\x75\x28\x8b\x80\x40\x14\x08\x78\x49\x93\xfd\x03\x29\x54\xd5\x82\xce\x13\x0e\x09\x5f\x99\x69\xfb\x05\xca\xba\xb3\xab\x12\xc7\x81\xce\xc9\xf0\x02\x1b\x17\x1a\x3d\x72\xbd\x31\x84\xc1\x83\x8f\xbb\xb4\x39\x92\xb6\xd8\xe3\x6e\xf8\x97\xc4\x84\x6b\xf1\xd8\xf7\xf9\x77\x6e\x9c\x61\x99\x6f\x13\x28\x4d\x47\x75\x4e\x43\x3c\x3f\x7e\x44\xd2\x4c\x23\xfe\x02\x10\x92\x11\xae\x9a\xc0\x96\x6f\xdd\x8c\x33\x63\x45\x77\x66\x5b\x23\x32\x93\xcb\x4a\x02\x24\x49\xce\xd6\xbe\x92\x97\xcf\x2f\x6c\x35\xb0\xa9\xdc\x43\x18\x87\x6c\xce\x7a\x8e\xd5\x3c\xff\x34\xd2\x18\x4e\x91\x5d\xa5\x42\xf2\xb4\x33\x8d\xcf\x8b\xa3\x1a\x6e\xeb\x5a\x62\xb2\x97\x05\x93\xbb\x40\x70\xf2\x5c\x7c\x2b\xb2\xe2\x09\x4e\x91\xda\x6c\xef\xbf\x86\xc4\x71\xea\x8d\x0f\xad\xa6\xc3\xe5\xf7\x92\x35\x6d\x5c\x36\xf9\x2a\x66\x07\xc5\xf2\x7f\x3e\x94\x49\x9a\xe2\xd5\xa2\x59\x71\xaf\x05\x8f\xb5\x48\xdb\x31\xe2\x7a\xa3\xa5\xd1\x94\xa9\x6e\x45\x66\x0a\x2d\x7a\x69\xa5\xc2\x8f\x07\x5a\xfe\x51\x44\x71\x93\x1e\x36\x6c\x18\x08\xff\x8b\xea\xee\x65\x79\x8f\x6c\x51\x59\xf9\xce\xee\x3d\x4e\xb4\x90\x44\xc5\x66\x45\x81\x66\x7f\xaf\xc1\x31\x0f\xff\xdf\x58\xc1\x28\x72\x22\xe9\x4c\x6c\xaa\xdb\x07\x13\x27\x5a\x36\x98\xd7\x3f\xae\x6f\x81\x83\x16\x8e\xe6\xc8\xfd\xf1\x72\x87\xe3\x3d\x1c\x4d\x45\x70\x7b\xee\x55\x18\xec\x4a\x53\xd6\x85\xb4\x66\xed\x93\x72\xbc\xed\x43\x94\x4c\x91\xb5\x65\xec\x89\xd9\x2b\xa5\xfc\xd1\xfb\x43\x91\x29\x4b\xa8\x3f\xd4\x61\xfc\xfb\x38\xce\x81\x37\x1f\x33\xd0\xb3\x1f\x57\x69\xbd\xcb\xd1\x9f\xf4\xf4\x63\x4d\x7b\xb2\x93\x11\x7a\x90\x30\xbf\x1e\xb2\x6a\x80\x2f\x9d\x11\x12\x7a\xc4\x65\xb8\xec\xe2\xca\xc5\xaa\x29\xfc\x2a\xa4\x64\x73\x5d\xcc\x6a\x7e\x2c\xfc\x21\x78\xcc\xd5\xfe\x7e\x04\x2d\x7e\x97\xa7\x09\xb9\xd5\x9d\x6b\xa5\xe3\xcf\xfe\xfe\xf7\x62\xad\x0a\x5b\x2b\x61\x3a\x5a\x2b\x90\x12\x03\x49\x5b\xac\x3b\xa2\x10\xa6\xaf\x09\x06\x9b\x84\x3f\xc7\x65\xb1\x97\xce\x21\x90\xb9\xad\x5d\xcb\x28\xbf\xb5\x91\x4c\xe2\xb7\x0f\xf5\x14\x29\x82\x94\xb7\xb3\x4e\x35\x6a\xf3\x9b\x40\x2d\xdc\x3e\xde\x47\x7d\xc3\x6c\x0d\x73\x7d\x2b\x48\xad\x39\x6a\xb6\x29\x14\x19\xd4\xff\xe5\x55\x32\x02\x7f\x64\xd3\xd7\xcd\xf8\x52\x73\xa8\xa0\xf5\x4c\x65\x21\xf0\xbd\x98\x4c\xdb\x3b\xb4\x1a\x91\x2f\x30\x3a\x9a\x45\x88\xd1\x08\x7f\x8e\xc7\xa2\x95\xd6\xde\xcc\xe5\xc0\x74\x3a\x6d\xcd\xbd\x24\x63\x63\x38\xdd\x82\xcc\xa1\x56\xf3\x9f\x78\x8e\x2b\xa9\x40\x46\x01\x1a\x14\x1a\x8f\x10\x87\x81\x0f\x81\x27\xba\x7f\x31\x04\x86\x63\x0e\x8d\x17\xbc\xef\xc7\x21\x62\xbf\xc5\x07\x48\xad\x57\x56\xc4\xc3\x43\x46\x3b\xdb\x23\xc0\x08\xd9\xd1\x9d\xf8\x20\x13\x24\xcd\xa1\xa6\x96\x84\xf5\x44\xce\xb3\x87\x6b\xdf\x2e\xc0\x88\xc7\x2c\x5c\xc8\x29\x61\x17\xe4\x84\x17\x44\x2a\x47\x05\xcd\x0c\x49\xe0\xd9\x02\xcf\xac\x21\xe4\xd8\x9a\xf3\x6f\x6e\xc2\x28\x1c\xd6\x9a\x4a\x2c\x78\xbe\x35\x2a\x7c\x78\xf6\x8b\xe9\x2e\xa1\x47\xef\x24\x04\xc4\x0f\x5d\x79\x8c\x8c\xd5\x94\x20\xcd\x07\x50\x3a\xb7\xda\x7d\xb3\xd5\x74\xa5\x13\x46\x96\x1b\xb0\x5f\xe0\x37\xa5\x83\x2b\x0e\x2a\x31\x17\x62\x7e\xd3\x54\x97\x32\x6d\x5a\x95\xee\x77\xde\x63\xbb\x93\xd9\x2d\xc3\xcc\x0c\x8f\xe7\xff\x6e\x25\xa5\xd6\x89\xd6\xed\x4e\xc3\x0d\x5b\xf2\x93\x7e\x4c\x01\x45\xe8\x8d\x8e\x7f\x72\x5d\x4c\xf1\xda\x16\xb0\x14\xd7\xc0\x41\xf2\x61\xc7\x0b\x10\x3f\xb7\xed\x01\x13\x30\x64\x1f\x3b\x85\xcd\x1f\x1f\x5c\x4e\x87\xf2\x7b\x51\xdf\xbb\x0a\x01\xba\x95\x8c\x2c\xd9\x84\xbb\x05\xdf\x86\x54\x59\x76\x9c\x68\xc9\x69\x6d\x83\x55\x58\xf8\x39\xfc\x97\x40\x99\x87\x31\x2d\xe1\x62\xff\x7d\x91\xb2\x70\x4b\xb6\x3b\x41\xe9\x98\xbe\x5c\xea\xd7\x6b\xb6\x85\x5c\x03\x59\x03\x0a\xd9\x09\x6c\x7e\x4e\x8a\xb1\x3d\xb7\xa0\x33\xcc\xb9\xc1\xb8\x9c\xb9\xaf\x96\x7d\x85\x91\x16\xa3\x97\x37\xed\x04\x61\x1d\x83\x6d\x8c\x2c\xee\x0b\x3e\x79\xea\xc0\xdb\x59\x0e\x50\xe4\x16\xe5\x3c\xd6\x32\x0d\x68\x2d\xaa\x95\xb2\x49\x2d\xe1\x1b\x92\x1b\x3a\x16\x88\x85\x3f\x31\x84\x83\x50\xdf\x6a\x10\x2f\x66\x7b\x7d\x0a\xb9\x09\xce\x56\xe1\x53\xd0\xc3\x9e\xaa\x2e\x0e\xe7\x82\x13\xf3\xe1\x41\x36\x80\xed\x79\x9f\x61\xbf\x80\xf3\x05\x09\x79\xb5\xd5\xde\x64\x38\xb8\x75\xd5\x68\x41\xa2\x9c\x6f\xf2\x10\xa0\xb2\xb2\x03\x75\x2c\x9a\x8f\xcf\x31\x1e\x64\x9f\xcc\x7d\x31\x09\x34\x39\x84\xe9\xfc\x9a\x6a\x63\xa9\x1b\x72\xa4\x0f\xe6\xb7\x18\xce\x58\xff\xbb\xae\x4a\xb6\x37\x3d\x45\xed\x74\xdd\x4e\x7b\x93\xdf\xb7\xe7\xfd\xcc\x98\x59\xb5\x96\x99\xd3\x19\x39\x95\xef\x55\x7e\xc8\x53\x16\x50\xc8\xbe\x5e\x56\x8d\xd4\x65\x81\x81\x2d\xcc\xc7\x6e\x12\x65\x05\x36\xb9\xb4\x05\x68\xc2\xfa\xee\x19\x62\x2e\x6a\x05\xa6\xf6\xd6\xfa\xb6\xbe\xf5\xcb\x2e\x1e\xc0\xe3\x2b\xf1\xef\x9a\xd1\xff\xb9\xa6\xd8\x1b\x69\xed\x34\x0a\xd5\x77\x46\x8f\xb6\x10\x9e\x47\x1b\x3e\x0c\x44\xd9\xfc\xb8\x9c\x20\x44\x57\xb4\x52\xc1\x40\x05\xd2\x40\xd7\x67\xba\xea\x0f\xfd\x82\x40\x88\x06\x09\x0e\x5a\xa0\xc5\x9b\x43\x2d\x0d\x4c\x06\xab\x68\x2e\x4c\x7c\xfd\x6c\x0e\x35\xd7\xd7\xcb\x19\xd7\x8c\x9c\x50\xed\x4b\xe0\x96\x8e\xf3\xd4\x2b\x89\xa0\xda\xbe\xb0\x7f\x5f\xf5\x6d\xec\x9b\xe3\x2a\x9d\x03\x55\x51\xaf\x3c\xee\xd7\xf7\x79\x52\x36\xc7\x83\xaa\xbe\x54\x5c\xe3\xdf\x65\x47\xcd\xc0\x47\xdb\xd6\x51\x9c\x96\x88\xa5\xeb\x25\x70\xc1\x65\xd6\x30\xfb\x79\xc7\x71\x8a\xb8\x7e\x90\xf5\x2a\xe4\x48\xdd\xfb\xa3\xc2\xcb\x39\x5f\x65\x86\xf0\x55\x69\x80\xa3\xd4\x7e\xa3\xc5\x49\x53\x2c\xcc\xd7\x5d\x9c\x28\x91\xd4\x6d\x76\x31\x7f\xbe\x5d\x8a\x83\xd5\x55\xde\xfe\xdb\x5b\x65\x1c\xfd\x86\xa2\x16\x7d\x14\x75\x90\xd3\x62\xe8\xf6\x80\x82\xa4\x04\xda\x1e\x30\xc3\x37\x6a\xdc\xd7\x05\x59\x71\x42\x25\xdc\x20\xe9\x76\x35\xec\x2b\xf4\xf3\xa9\x9e\x1d\x35\xdf\x08\x67\x2e\xf1\x2e\xc7\x38\x3a\xee\x99\x9b\x5c\x96\x15\x84\x8c\x77\x8a\xcf\xd9\x44\xc5\x67\xe4\xe0\x21\x56\x38\xaf\xf6\xb9\xad\xe3\x88\xff\xf6\x34\xc3\xb8\x18\xbf\x12\x3c\xfb\xfa\xaa\x9d\x5a\xaa\x30\x7a\xe9\x1a\x43\x47\x68\x38\x8d\xc4\x5d\x9f\xf7\xa7\x5a\xaa\xce\xa6\x35\x77\x77\x27\x1c\x3d\x94\x13\x90\xf2\xe1\xb1\x78\x89\x39\x1d\x22\xd0\x12\x1f\x46\x8a\x96\x05\xc6\x35\xa1\x3b\xd5\x83\xd1\x52\xa0\xe9\x79\x83\x79\x4c\xe4\x95\x63\xf8\x2b\x62\x1c\x8f\x01\xff\xe1\x2b\x32\x0c\xa7\x5d\x83\xca\x68\x8f\x57\x1b\xd7\xe3\xed\x4d\x37\x08\x3f\xcf\x41\xcd\xc0\x8b\x66\x3f\x4a\x40\xcc\x22\xb0\x18\x36\xf0\x41\x79\xd9\x59\x44\xa2\x87\x4c\x2a\x7a\x35\x02\x80\xa7\x0c\x01\x6c\xd0\xdc\x37\xf3\x0c\x66\x4e\x25\xe0\x3c\x4f\x33\xec\x7a\xa9\xc0\xe0\x12\x67\x9e\x99\xaa\xf9\x73\xc0\x0b\x89\x9e\xd4\x62\xaa\xa6\x0c\xbe\x0a\x77\x69\xd1\xa4\x55\x2e\xb7\x86\xc6\x84\xeb\x68\x65\xa5\x7d\x2f\x9f\x33\x9e\xa8\x35\x40\xf3\x1c\x29\x72\x2c\xde\x8f\xb8\xab\x37\xa3\xd0\xf6\x87\x49\xc5\x01\x29\xf0\x43\xd9\x7e\x4c\xf0\x65\x08\x4a\x93\xee\x79\xb5\x37\xe6\xdd\x9c\x05\xa7\x50\x38\xea\xbf\xf7\x55\x33\xf5\xb6\x84\xb5\xca\x5d\x23\x67\x28\xaf\x9b\x09\x5f\x80\x22\xe6\x60\xe9\xc4\xcc\x4c\x95\x2e\x2b\xe1\x58\x7a\xdc\xf9\x95\x12\xff\x7a\x39\xb7\x22\x4b\x78\x47\x0f\xfa\x02\xc8\xf0\xa9\x4c\xea\x97\x5f\xc8\x0c\x2e\x7f\xfa\x65\xef\x0f\xaf\xaa\x53\xf6\x19\x35\x28\xe2\x68\x9c\x90\x72\x4b\x33\x88\xcc\xb1\xe7\x3f\xf4\x11\x86\xe1\x45\x20\xee\xb5\xe0\x89\x5c\x41\xe6\x55\xde\x6b\x32\xdb\x05\x1d\xe4\x02\xde\x73\xd5\xa1\xbe\x7b\x47\x7e\x02\x76\xd1\xd4\xc7\xa2\x0e\xd8\x3a\x12\xcf\x89\xcc\x7b\x55\x50\x5c\x6e\xfa\x01\x8c\x5c\x97\x27\xf5\x28\x45\x3f\xfe\x75\xe8\x4d\xce\x8c\x35\xc1\x58\x2f\x1c\xc2\x27\x0d\x5a\xfe\x1b\x80\x18\x46\xf0\xd8\x46\x68\x06\xcf\x8c\x5b\xca\x69\x53\x5f\xfa\x38\x23\x11\x1f\x65\xa5\x7e\xa2\x2a\x1d\x4d\x76\xee\x8c\x7e\xec\x50\x40\xbc\x0e\x93\x0c\x0d\xdb\x2d\xc7\x00\x3e\x0a\xc2\x19\x53\xca\xc1\x35\xfc\xee\x52\xfc\xb9\x09\xbe\xf9\x22\xbf\x31\x4d\x46\xf6\xd8\xea\xbf\x33\x52\x61\xb0\x5f\x3e\x90\x5e\xd1\x6d\x21\xa5\x7e\x73\xc6\x19\xbf\x3e\x6f\x1f\xa6\xd9\x92\x23\x3d\x72\x6d\x8a\x87\x22\x4e\x12\x3a\xf4\x33\x3d\x3f\x4b\xf2\x18\x69\x22\x95\x26\x5c\xe6\x9c\xa1\x53\xef\xa6\xd7\x1f\xde\xe2\x7d\x2e\xd5\xf3\xb1\xa8\x6a\xb9\xfe\x9f\xed\xcc\xb7\x86\x7a\x9a\xa6\x12\x55\x43\x39\x98\x69\x38\x7c\xaf\x94\xcf\xaa\x88\x9d\x90\xe1\x7a\xdc\x82\xfa\x76\x44\x08\xac\x8f\x06\x49\x1d\x0c\x49\xd0\x50\xbd\x74\xf5\xf9\xa0\x46\x0d\x76\x5c\xbd\x95\xc3\xe7\xc6\x99\x4e\x4b\xa5\x7e\x2f\x34\xc3\x80\x88\x37\x75\x18\x7d\xad\x2d\x66\x57\x94\x53\xff\xb3\x96\x7f\xc2\xd7\xaa\x91\x36\x76\xdb\x99\xc9\x26\x8e\xe6\x22\x62\xb4\xec\xf2\x15\xaf\x32\x5f\x2e\x5b\x43\x2d\xc9\xa8\xf6\xc3\xa7\x1f\xda\x40\x91\xe2\xc7\xe1\xbe\xd1\xe9\x72\x5f\xfb\x00\xe6\xa2\x6e\x79\x08\x5d\xec\x98\x00\xc2\x67\x08\xdc\xb2\xbd\xfc\xef\x2a\x3a\x0b\x0b\x74\x05\x37\x8c\xec\xf7\x50\x7e\xcb\xe8\x7f\xf6\x2a\xef\xe2\x66\xb3\x73\x82\x4e\x48\x4b\x3d\x14\x95\xe6\xaa\x66\xff\x79\x7b\x62\x0e\xad\x05\x8a\x8e\xfe\x67\xff\xe6\x10\xb2\x3b\x92\x02\xfd\xed\xe8\x0d\x74\x34\xda\x0a\x49\x68\xbd\x65\x89\x36\x9f\x41\xeb\x7a\x4f\xfd\x44\xa3\x24\x05\xa7\x02\xe1\x50\x21\x5f\x9e\x13\xfe\xef\xfa\x32\x85\x5a\x4f\xf5\x68\x65\x13\x15\xf7\x02\xb3\x5c\xe1\x5c\x6f\x2b\x4b\xb7\xbb\x5d\xf8\x24\x2f\x0c\x77\x7a\x7d\x70\x4b\x81\xca\x3f\x26\x38\x62\xfe\xe4\x7d\x46\x10\xb2\xa3\x8f\x2f\x95\x07\x2c\x94\xe5\xdb\x6d\x67\x6f\xcd\x90\x45\x52\x39\x34\x87\xce\x02\xe0\xf7\xef\x60\xc7\xf0\x3f\x35\x06\xcb\x98\x5c\xf6\x0d\xbc\x5f\xfc\x23\xe7\xc5\x06\x1e\x42\xf5\x5e\x24\xbb\x7f\x36\xd6\x7d\x28\x4c\x7b\xd8\xbe\xba\x34\xc1\x23\x6c\x75\x56\x9e\x3e\x2b\xda\x84\xf4\x97\xaa\xbe\x9f\xaa\xc0\xa6\x83\x6e\x24\x23\xed\x8c\x6e\xbe\x08\x2e\x48\x52\xfc\x0c\x95\x19\xd1\xac\x2f\xb3\x1d\xca\xa4\x40\x35\xf9\x5f\xae\xcf\x31\xc3\x71\x21\x61\x4a\x5b\x1e\xab\x16\x73\x46\xfb\x0b\xca\x3e\xa4\x69\x98\xbd\x91\xee\xf3\x30\x11\xc0\x0d\x54\xa8\x89\xa2\x45\x43\xdb\x27\x0d\x56\x27\x82\xfc\xea\xa6\x46\x35\x57\x2e\x4c\xd4\xa7\x87\xb8\x3c\x4a\xce\x4b\x71\x64\x2c\x87\x6a\xf4\xff\x7c\xb6\x2a\x54\x5e\x17\x64\xdf\x70\xe9\x03\x61\x7f\x6e\xfb\xdb\x4f\x5e\x89\x24\x47\x48\xa1\xc8\x98\xd9\x37\x60\xdf\x79\x73\xc7\x24\xb2\x28\xe4\xf6\xd7\x3e\xcc\xf5\x6f\x9e\xd0\xaa\x56\x30\xac\x66\x6c\x54\xd8\xf6\xc7\x67\x66\xd9\x5a\xf0\x52\x05\x73\x9d\x79\x66\xaf\x3c\xfb\xb3\x0d\x45\xf3\xf0\x7f\x84\x17\xc2\x89\xbb\xd6\xdd\xc3\xc5\x83\x9a\x0d\xee\xcc\xdf\xec\x30\x50\x59\xea\x5a\x92\xfb\x42\x94\x2d\xb0\xaa\x52\x22\x28\x3c\x5d\xa4\x2f\xc4\x31\x37\x3b\x98\x4d\x57\xe3\x25\xc6\x40\xf5\xf9\xdf\x39\xd7\x0a\xc3\x1c\x5a\x5f\x98\xd0\x90\xd8\x3f\x5d\x42\x0e\xd5\xcc\xf0\x58\x15\x1a\x3e\x3b\x42\x8b\x81\xa0\xb8\xb5\x61\x7e\x47\x7f\x0d\xb5\xb9\x90\x26\xe1\x23\x37\xc6\xbc\x1b\x60\x4e\x33\xb5\xea\xf7\x78\x99\xd4\x9c\xca\x22\xff\x9d\xd9\x0c\xd3\x79\xa2\xd8\x75\xc9\xd4\xae\x6a\x9b\xc5\xd6\x56\xf0\x4b\xe1\x2c\x02\x86\x53\xe6\x66\x36\x4e\xd1\xd6\xa1\xda\xed\x29\x90\x3e\x2c\xf3\x8c\xea\x9b\xdb\x9c\x6c\xda\x57\xee\x32\xf5\x9f\x82\x2c\x16\x5a\x77\x66\xa5\x17\xc9\x5d\x65\xcf\x53\x34\xfa\x36\xe3\x1e\x45\xb4\xbf\x14\x4e\x22\xf7\xc0\xd7\x76\x88\x31\xd4\x66\x87\x7d\xbd\xba\xd3\x1a\x83\x5d\xdd\xd0\x8d\x3d\xfe\x7f\xf2\x6e\x85\xd7\x5e\x24\x71\x09\x39\x1a\xd8\x34\x1c\x5e\xce\x8b\x4a\x8c\x50\xbd\xc5\x77\xb2\x37\xe0\x41\x0f\x57\xba\xfe\xa8\xe4\x27\x21\x43\x1a\xe9\x5a\x93\x2a\x2d\x45\x60\x08\x67\xfc\xaf\xce\xba\x95\x42\xf0\xc2\xe5\x42\xb2\x7b\x9a\x9b\x09\x04\x28\xb6\x31\xd5\xc1\xd0\x8d\xad\x76\x6d\xd1\xd1\xe6\x90\x90\xc3\x29\x9b\x15\x89\x3a\xc5\x4b\x60\x0a\xc5\xfd\x9f\xba\xa6\xb6\xa7\xfc\xcb\x43\x92\x63\xe9\xbf\xef\xcf\x5c\x84\xa4\xc2\x7e\xf9\x76\x0d\x93\x15\x88\x12\xd9\xdc\xc2\x83\x01\x28\xbe\x8d\x9f\xa6\xd7\x8b\x4a\x12\x74\xd9\xf5\x32\x78\xb1\xbc\x50\x43\x7a\x42\x7d\x6d\x7f\x72\x40\xfd\xa9\xe4\xf7\xcb\x26\x66\xbb\x17\xaa\x30\x66\x5b\x9e\x56\x36\x9b\xad\x73\x4c\x71\x30\xa2\xdc\x0a\xb1\xad\xdc\x94\x36\xb9\x96\xb2\xc4\x56\x50\x07\x06\x4b\xea\x65\x2a\xfe\xdb\x18\x7f\xce\x27\xbb\x99\xda\x4d\xcd\x9c\xb6\x7b\xa5\xfa\x7e\x06\xe7\xbe\x05\x66\xe7\x21\x14\x5a\x8e\x7c\xef\x8c\xc5\x60\x4f\x67\x16\x93\x57\x49\xd3\xb4\xbd\x85\x29\x5d\x9b\x96\xa5\x15\xff\x9f\x19\xa5\xdf\x9d\x24\x22\xd4\x46\xce\xcd\x4b\x16\x1c\x35\x8c\x9c\xa7\xa3\x39\x1f\xcd\xaa\x2e\x77\xc4\x21\x0b\xd9\x62\x75\xe7\xa5\xf7\xd3\x3d\x8f\x36\x9d\xea\x93\xdf\x8c\xec\x99\xb9\xfa\xfd\x93\x48\xb3\x8c\x72\xf8\x3d\xbe\x70\x58\xc3\xfb\xe9\xc7\x4f\x8c\x6f\xd9\x28\xdd\x71\xd1\x04\xc1\x06\x5a\x2f\x7f\xe2\xd8\x5d\xf6\x70\x19\x1d\xd8\xeb\x1f\x29\x83\x37\xee\xd1\x2d\x81\xcb\xae\x2d\x6f\xfe\xfd\x14\x95\xf1\xf5\x6d\xcc\x36\x1a\x77\x7e\x4e\xa1\xa2\x2a\x59\x9c\x64\xbb\xc4\x11\xde\x96\xa0\xf8\x45\xda\x05\x90\x50\x4b\x63\xb1\xf8\xea\x90\x02\xb6\x22\xf0\xc4\xf5\x15\xc4\x3f\x7b\x69\x09\x18\xcf\xb5\x54\x25\xe8\x9e\x1b\x23\x90\x91\xe9\xbb\x0d\x1e\x7e\xf4\x00\x6f\x09\x9c\x49\xa8\xa5\xbe\xf6\x3c\x65\x6a\x1b\xd9\x5e\xe6\xd9\xf1\x57\x3b\x49\x9c\xff\x8e\xc4\xcd\x6f\x1e\x2d\x9c\xa5\x51\x72\xf8\xe9\x4c\xbf\x5c\x26\x73\x59\xe8\x2e\xa8\xf9\xe6\x4c\xd2\xd6\x3f\xdf\x69\x48\x6b\x1c\xf6\xe9\x2e\x84\x78\x9f\x9e\xcd\xd9\x42\x49\xff\x5b\x1f\x9c\xa3\x31\x2f\x8f\x73\x6c\xac\xb1\xfd\x73\xe4\xf7\x66\xb6\x82\xd5\x26\xe9\xeb\x4c\x43\x34\xe0\x16\x8f\x2e\x95\x87\xc5\x7e\x6b\xb5\xa2\xf2\xf9\x9f\x3c\xc3\x61\x94\x10\xe7\x37\x87\x55\x46\xc5\x09\x13\xd7\x98\x54\x4e\xf1\xf3\xf4\x9b\xb4\xbc\x68\x32\xd0\x58\xd2\x4c\xee\x86\xff\xdf\xef\xe8\x3d\x5e\x46\x0f\xaf\x18\xd8\xac\xbe\x6e\x22\x72\x8d\xc6\xa7\x1d\xb7\x9c\xe1\xb5\x68\x43\x73\xf9\x1d\x7e\x24\x97\x65\xa8\xfc\xd0\x99\xc0\x9f\x0d\xcd\x6a\xca\x7c\xf4\x94\xce\x89\xb6\x99\xa6\xfd\xc7\x4f\x56\xac\x66\xd2\xfa\xf1\xd5\xd3\xbc\xf2\x9b\x93\xfb\xb6\x5e\x72\x8e\xd1\x3a\x0b\xf8\x1f\xec\x40\x17\x34\x0e\x17\x19\xef\x23\xe7\x32\xbf\x8b\x8a\x63\x30\x52\x10\xf5\x3f\x09\x1e\x76\x73\x9a\xa6\x74\x9f\xd2\x23\xad\xa0\xe3\x89\x8b\xc8\xff\xec\x5b\x55\xfd\x98\x59\x1f\xcd\xa1\xd2\xf0\x4b\xf3\x85\xca\x35\xcc\xbe\x7e\xc3\xb0\x0d\xfe\xf9\x88\x69\x10\x05\x43\xc3\x0c\x39\x10\x35\x33\xc3\x76\xec\xff\xde\xe7\x21\x98\x55\x3d\x5f\x5f\xdd\x18\x33\x92\x8f\x25\xe6\x2b\xb8\x6e\x28\x61\x16\xdc\x02\x23\xb9\x71\x56\x86\x19\xb5\x81\x61\x1d\xc7\xfc\x49\x6d\xba\xa8\x17\x1b\x26\xd1\x1c\x61\xed\xf0\x23\x6b\x37\xa6\xe9\x8d\x35\x4c\xd2\x6f\xf0\x11\x94\xaa\x87\xac\x05\x52\xbb\xa4\x34\x6a\x83\x56\xfe\xfd\x25\x8f\x6f\xf7\x70\xb0\xe0\x88\xe5\x64\x95\x37\xb2\x6f\x34\xc1\xda\xbe\x33\xcd\x6a\x7f\x56\x5e\x4b\x79\x59\x54\xb6\x59\x6f\x95\xda\x61\x8d\xda\xfe\xef\x8c\x50\x19\xc3\xd3\x92\xee\xc7\xd9\xdb\xe8\x63\x6b\x19\xb3\xb3\x55\xa5\xa3\x40\xf5\x09\x9c\x9a\x1c\xa2\x56\xbb\xfa\x90\xed\x78\xe9\x7f\xea\x6c\xb6\x7b\xe4\x9c\x48\xad\xb6\xeb\x9f\xab\xf3\xfc\xc5\x31\x12\x6f\x9b\x42\x67\xd2\xa5\xe4\xc5\x66\x06\x2a\x13\x8c\x5a\xcd\x41\xfe\xfe\xb0\x95\x3f\x1b\xd5\xe1\xfd\x50\x10\x09\x53\x4b\x75\x31\xd3\xdd\x4c\x74\x14\x86\x70\x6c\xef\x8a\x36\x58\x9b\x5d\xf6\xf1\x26\xc2\xc3\x04\x41\x62\xfe\xef\xef\xb9\xaa\x17\x74\x6e\x3d\x33\x82\x36\xc7\xda\x87\xcf\xfa\x8a\xcf\xb1\x6f\x77\xe2\xf6\xe4\xf3\x49\x20\x38\xc6\x78\x60\x42\x59\x94\xdf\xf5\xce\x87\x2e\x1b\x34\x40\x80\xb2\x35\x2e\xd2\xce\xc8\x15\xc9\xae\x97\x3f\xdf\x2f\xab\x26\x3b\xbf\x06\x8e\xd1\x40\x51\x9b\x15\x87\xdf\x7b\x83\x68\x97\x2a\x66\xd3\xa7\xd7\x15\x0d\x40\x1c\xeb\xf6\x9e\x5b\x6d\x60\xfb\xa3\xa7\xe1\x40\xa2\x09\xc1\x3b\x9a\x16\xf6\x4d\xf2\xe5\x54\x56\xfd\xdf\x7a\x10\xe7\x7a\x3e\x69\xe3\x9f\xf0\xcb\x2b\x29\x99\x7b\xcd\xa4\x82\xc7\xda\x09\xdf\x9b\xd5\xc0\x16\xcc\x3c\xd0\x3e\xd3\xc1\x12\xe6\x99\x6d\x59\x4a\xee\x67\xa0\x2f\x55\x2e\x21\x33\xb9\xd7\x10\x9f\x19\x6b\x95\x20\xc4\x24\xe3\x6a\xff\xbd\x41\xce\x31\x3e\x3f\xb3\x38\xf2\x67\x5e\xac\x56\x73\x86\xa3\x24\xf6\xd8\x2f\x55\x41\x09\x6e\x9e\x5f\xb3\xca\x3f\xcc\x23\x30\x75\x2d\x2a\x7d\x6d\x34\x1d\x2c\x4d\x30\x44\x6f\x65\x6d\x92\x75\x26\xcd\x28\xfe\x3d\x1c\x70\x2f\xaa\x75\xf6\x5a\x6d\x3e\xfa\x4d\x92\x2b\xc1\x0a\x67\xeb\xbb\x43\x15\xc7\x78\xbc\xe3\x5e\x8e\xb0\xc8\xcc\x90\x80\xd6\xa8\xb2\xcd\x9f\xe7\x51\x78\x03\x2a\x46\x97\xaa\x4a\x10\xf4\x50\xf7\x7d\x55\xe2\x65\xbf\xdd\x8d\x98\x52\x17\xb9\x6a\x5e\xca\x8d\x02\x53\x8d\xa8\xa9\xbe\xd6\x38\xc8\x02\x87\xde\xf1\x78\xfe\xe6\xbd\xfa\xbe\x7a\xf9\xde\x4f\xfb\x13\xdf\x65\xf5\xb0\xd7\xb1\x46\xfb\xac\xd7\x93\x87\x25\x65\x9e\xe0\x52\xb7\x38\x5f\x1d\xa0\x03\x3f\x80\x34\x98\x76\xc7\x4d\xd7\x3d\xe1\xb7\x16\x0f\xe4\xc3\x05\x7f\x96\x31\xf3\x84\x6e\x16\x74\x77\x5f\x76\xe3\x84\x6b\xe6\x8c\x1c\xb2\xd2\x21\xdc\xfe\xc9\x4e\x11\xb5\xf0\x99\x04\xbe\x9b\x6f\x4e\x6f\xfe\x7d\xcf\x65\x2c\x52\xfd\x87\x75\x13\x49\x8a\x35\xb7\xa9\xcf\x55\x8f\x98\x22\x13\x8c\xf7\xd0\x51\xfd\x64\x72\x8c\xd5\x49\xff\xbb\x6e\xb9\x2e\x68\x97\xf0\xdb\x3b\x41\xbd\x35\x5b\xf9\x19\x70\xdd\xd8\xb5\xdc\x28\xa6\xe1\x9b\x7d\x5e\x05\x31\xfd\x60\x58\x0b\x40\x5a\x0a\x5a\x30\x26\xba\x9a\xb7\x61\xe2\x14\x03\xa7\x09\x3b\x24\xea\x4b\x4a\x63\x55\x32\x35\x8e\x16\x05\x8e\x7f\x15\xfe\xbf\x25\xfa\xf8\xe9\xe3\xb2\x03\xa7\x70\x9a\x8e\x64\xaf\xbb\x70\x11\x59\x3e\x99\xdf\x65\x9f\xb9\x9c\x4b\x1d\xbf\xcd\x92\x24\xb4\xf9\x29\xd1\x17\x20\xc2\x28\xbd\xd2\xdb\x9f\xba\xfc\x80\x90\x26\xb0\x78\x4c\x61\xb3\xd0\x9b\x23\x3b\xc0\x0f\xa7\x4d\xff\xcb\x77\x6b\xc9\x79\xa5\x04\x8a\xda\xbb\xbf\x05\x25\xcc\xd6\x00\x7f\xe0\x72\x6c\x94\x6e\xf4\xb2\x55\x86\x68\xc5\xcd\x79\x07\x52\x2b\x4f\xb3\x6f\xfa\x3c\xa8\xa8\x86\xfe\x4a\x52\x93\xe0\x65\x62\xf9\x90\x71\x96\x72\x61\x22\xa2\xa5\xc6\xac\x1b\xb1\x9f\x3d\x57\x4b\x20\x61\xf8\xdf\x9c\x22\x18\xf8\xae\x95\x15\x41\x89\xb1\xed\x79\xd7\x27\x5f\x22\x70\x44\x17\xda\x5f\x44\xa7\x90\x0b\xd4\x7c\x4b\x54\xb5\xea\x19\x94\x71\xb0\xa3\x77\xb3\x1b\x5c\x44\x6f\x70\x3b\x99\x2f\x3e\x73\xdd\xb1\x1f\x82\xa2\x8c\x47\x85\x98\x26\xef\x49\x35\x4f\xd9\x1c\xd6\x68\xf4\xff\x8d\x31\xdc\x6f\x5f\x7e\x83\x8c\xb7\x0c\x1f\xee\x70\xa2\x1d\xed\xc1\x2a\xc4\xae\x94\xa0\x61\xfc\x19\x8b\x15\xb1\xa7\xa0\x90\xe5\x61\x64\x43\x84\x98\x51\x73\xf2\x42\xfb\x2d\x11\xa7\xa0\xb5\x79\xf2\x93\xc7\x65\x17\xa6\x64\x98\xa9\x95\xa0\xeb\xa6\xca\x79\xb1\xe0\x5e\x2f\xa7\x30\x88\xd3\xbd\xd2\xf5\x2b\xd8\x20\x88\x26\xcb\xb8\xff\x49\x7d\xc0\x21\x5a\x7c\x32\xe2\xb4\x71\x50\xc6\x2b\x85\x65\x15\x20\xc4\xf6\xe3\x24\xa4\xbc\xb4\xe5\x24\x76\x7e\x3e\x6b\x89\x3b\x41\x13\x4a\xee\xa7\x0d\x28\xce\x46\x3f\xf9\xc8\xfb\x1a\x9b\x9d\xa1\x36\xc1\x12\xfa\x13\x0c\x29\xbe\x81\xaa\x56\xba\x6b\xde\xaf\xe3\xf2\x4b\xec\x40\xf9\x0d\x0b\x41\xfa\x09\xbb\xf2\x49\x20\x6b\xc3\x9e\x23\x78\x58\x8b\xb5\x04\x24\x72\x34\x56\xf9\xd1\x29\x21\x2c\x33\x3c\x69\x6a\x1b\x08\x9f\x1c\xa3\xf9\x2b\xb7\x65\x99\x72\x69\xf7\x25\x1c\x01\xa8\xd2\xf6\x88\xac\x22\x78\x77\x95\xdc\x2e\x86\x57\x7d\xd4\xbf\xc1\x87\x3b\x0e\x42\x99\x9d\x3c\x53\x06\x6d\x8c\x71\x1a\x81\x6f\xe9\x61\x83\x90\xd1\xcf\x70\x7a\x0f\x87\x1d\xdd\x1f\x2a\xe7\xf7\x2a\x3c\xb0\x45\xe7\xb6\x30\x03\x0a\xb2\xfb\x9c\x1f\x12\x74\x5c\x76\x10\x6e\x49\x97\x25\xf3\x3b\x1a\x34\x76\x3e\xf0\x69\x3e\x79\x20\x00\xb6\x8d\x71\x5f\x3f\x78\x65\x62\x53\xb8\x1f\xbc\x6a\x09\xdc\xe0\x8c\x50\xf8\x7b\x2d\x4b\xb4\x1f\x9f\x91\x6c\xa9\xbc\x38\x4f\x9b\x90\x0a\x9a\xc6\x5d\x7c\x55\x7f\xf8\x66\xcd\x36\x60\x17\xea\xc1\x23\xab\x81\x92\x00\x62\xb8\xec\xe6\x8d\x2b\x6e\xbe\xf6\xf9\x4a\xe7\x3f\xf4\x46\x6b\x8b\x94\xdf\x76\xf4\x87\x3f\x51\xac\x20\x5c\xbc\x64\x20\x83\xcf\xa0\x82\x55\xbe\x4a\xd7\x3d\x36\x0d\xb2\xd8\xe9\xaa\x3c\x53\x2c\x5d\x73\xc1\x42\x5d\x2c\x8f\xd5\xa8\xdf\xbf\x32\x26\x56\x41\x32\xa2\xb0\x10\x80\x04\xd2\x14\xce\xe8\x5c\xad\x27\x76\xfa\x7d\x57\x78\xdb\xcd\x7a\xfa\xd1\x53\xe5\xcb\x6f\x5c\x1d\xb4\xab\x3f\x3d\x71\x1f\x85\xd3\x21\x4e\x6d\xcb\x29\xfa\x42\x56\x25\x39\xe0\xdf\x16\xc5\x4f\x9b\x44\x4b\x72\x58\xc9\x62\xfb\x0e\x57\xfa\xbb\x76\xd2\xd4\x83\x4d\x03\xac\xe7\xab\xf9\x77\xbc\xe4\xb2\x0b\x99\x74\x33\xd9\x5f\x1e\xe0\xe8\x13\x80\x7e\x56\x18\x73\xf2\xca\x59\x7a\xe1\x37\x37\x93\x30\xb7\xd9\x13\xd6\x46\xc6\xdc\xf5\x53\x74\x2b\x70\xa6\x1b\x32\xec\xfb\x7e\x99\xa1\x7b\xe5\x87\x24\x33\xa4\x18\xa8\x1c\x45\x0d\xec\x3b\x5a\xb8\xd4\xaa\x2b\x67\xb9\xcf\x90\x8b\x75\x2b\x83\xa8\xa5\xe0\x7a\x99\xfc\x74\x92\x78\x8a\x7b\x8c\x4e\x3e\x8a\x20\xf0\xea\xe0\x17\x5f\x88\xea\x44\x3c\x9f\xf0\x54\xa3\x34\xa4\xa9\xc3\x1c\x29\xe5\xff\xc2\x81\x04\xae\xfd\x71\xcd\xaa\x13\x4d\x30\x42\x74\x38\x4d\xf8\x2b\xfe\x86\x3d\x1e\x69\x83\x99\xde\x92\xa8\x9c\x80\x30\x3f\xf3\x50\x8d\x00\xd6\xef\x1b\x34\x04\x00\xad\x67\xd2\xfc\xdc\x74\x3a\xc7\x2c\x27\x94\x73\xb9\x9a\x38\xbc\x6f\xf0\x2c\x22\x7e\x59\xe2\xd8\xcf\x7b\x67\x30\x7d\xb8\x40\xf7\xa7\x56\x63\x6e\x94\xad\x24\x82\xa2\x0e\x92\x4e\x65\xe9\x9e\xfc\x34\xdb\xc2\x5f\x98\xfc\xdb\xbd\x70\xf6\xd7\x79\xe3\x28\x8a\xe0\xc4\xdf\xa5\xf5\x72\x9e\xf8\x2b\x09\x61\xd3\x5a\x8a\x53\xea\x54\x9e\xdb\x92\x72\x4f\xd5\x8d\x02\xf0\x55\xbc\x52\xa1\xea\x11\x02\x37\xec\x36\x7c\x6b\xc1\xdf\xec\xe8\x99\x1d\x2c\x0e\x8e\xcd\x67\xf1\xee\xa7\x40\x3f\x48\x18\xf5\xc1\xd4\x87\x20\x24\x1c\x3d\x7a\xd0\xe8\xc8\x60\xa3\xb6\xd6\xbb\xee\xe5\xe1\xb2\x07\x92\x0b\x8e\x30\xaf\xfd\xf2\xd2\xaf\x90\x94\xc1\xe4\xde\xef\x3d\x61\xc5\x2f\x97\xba\xff\xc9\xef\x1c\x65\x8d\xd6\x10\x26\x49\xb3\xbe\xef\xdf\xed\xe8\xba\xd0\xb2\x62\x6f\xf4\x4e\x63\xe3\x65\x2e\xeb\x7b\xb6\xe6\x84\x01\x23\x0a\x24\xc9\xeb\xfa\x4e\x05\x37\xc5\x6b\x4c\xc9\xb3\xb2\xaf\x25\x5a\x92\x68\x89\xce\x3b\x32\xe0\x5f\xbd\x76\x60\xa2\x26\x8e\xc6\xf0\xc2\x86\x8a\x17\x5a\x14\x62\xd7\x54\x21\x33\x0f\xb0\xfd\xa0\x91\x01\xce\xb4\x81\xfe\x76\x18\x35\x2a\x66\xf9\x45\x5b\x86\xed\xec\x7f\x30\xee\x39\x5a\x97\x8e\xe9\xfe\x7a\x27\xdb\xe5\xeb\x86\xc7\x5d\x8d\x8c\xfb\x61\xb6\x0d\x97\xab\x06\xbb\xb6\x8f\x0b\x05\xf6\x04\xf2\x02\x65\x47\x81\x63\xf1\x96\x28\x6d\x92\x01\x81\xfe\x66\xe3\x75\x41\x4e\x6d\x35\x52\xd3\x3e\x82\x67\x4b\x7d\xbb\x1a\xec\xf8\xba\x90\xf6\xcf\x87\x96\xaf\xaf\x42\xbf\xcf\x77\x7c\xa8\xde\x69\xf5\x22\xb6\x3d\xb1\xa6\xf8\xbd\xe3\xff\xbe\x36\xaf\xfe\xe4\x9b\x6c\xf9\x7b\x29\xd6\xef\x5c\x64\xd3\xf9\xf3\x50\xa1\x82\xf5\x38\x63\x5c\xbf\xb3\x60\x7b\x47\x3e\x9e\x5d\x69\xc2\x46\xcb\x5f\xb3\xe2\x08\x5d\x5c\xe1\x24\x2b\x7f\x03\x88\xd6\xb3\xf3\x87\x91\x66\x6b\xa4\x9c\x03\x1d\xb5\xe4\x58\x40\x34\xe9\x22\xe9\x00\x65\x32\x43\x91\xc4\x8c\x46\xde\x3d\x40\xe1\x2e\x7b\xdf\xd1\x5a\x82\xe8\xa7\xf3\x52\x75\x8e\x20\xb6\xb7\xd2\xa1\xb8\x34\xc2\x84\xfc\x58\x92\x23\xfe\x83\x87\x74\x38\xdd\xde\xc9\x33\x6c\xe5\x4f\xc7\x40\x65\x3c\xde\xb0\x0c\xe9\x1b\x78\x79\xd9\xd8\x33\x6d\xa7\xe4\x27\x33\x2a\xa9\xa7\x89\x31\x80\xc6\xe5\x90\x67\x8e\x51\xe8\x2a\xae\x68\x65\xea\x38\x43\xfd\x61\x01\x6a\x66\xe1\x1a\x44\xfa\x69\x2a\xe1\xdb\x87\x99\xa4\x9a\xea\xd4\x26\x3b\x4b\xd7\x37\x8d\x7a\xd7\xdc\x6e\x82\xdf\xa7\x17\x82\xb0\xe4\x8d\x94\x16\x2a\x40\x7a\x5c\x2b\x02\x37\xc9\x70\xd8\x6d\x88\x39\xe5\xfa\x25\xf1\xac\x87\x21\xf8\xa3\x5c\x35\xc3\xa8\x9c\xf8\x6c\xbb\x16\x58\x44\xcd\x63\xb0\x84\x51\x5f\xfd\x2d\xdc\x78\x86\x99\xa9\x10\x85\xf4\x97\x13\x12\x3d\x72\x38\xf1\xac\xfc\xdc\xd9\x5d\xaf\x40\xb2\x74\x3d\x48\x9f\x9a\xad\xd9\x56\x3d\xd3\x84\xce\xfc\x14\xa9\xab\xaf\xf6\x82\x0f\x70\xfb\x83\x83\x9f\x4c\x08\xab\x32\xca\xe7\xdb\xff\x0e\x52\x72\xd5\xca\x78\xbd\x0c\x86\x74\xce\xaa\xb7\xb3\x50\xc5\x1f\x45\xde\x5a\x5b\x18\x27\xd2\xa8\x7f\x47\x0e\xab\xba\x82\x18\xfc\xea\x19\x4f\xc0\x26\x58\xf9\xfd\x57\x96\x31\x4f\x9c\x99\xb3\xef\x1b\x58\x18\xd2\x76\x4b\xff\x8c\x55\x97\x56\xe9\xba\x8b\xdd\x0e\xb9\xa1\xe8\xb4\xd2\x71\xee\x38\x0c\x77\x25\xb2\x7f\x42\xf2\x74\x51\x97\x86\xbf\x46\x3a\xcd\x5a\x28\x2e\xbc\x2a\x0c\xeb\x5d\xe8\xbe\x80\xfe\x7a\x14\x0e\xc4\x34\x84\xbd\xd3\xe8\x4f\xd9\x2f\x20\x3a\xaf\x94\x55\x75\x1b\xb5\x88\x49\x8b\x8e\x9b\x61\x5b\xd8\x97\xef\xfc\xbb\xdf\x2d\x32\x25\xc5\x21\x1c\x37\xa4\x40\x81\xf9\xad\x1d\x6e\x0e\xbc\xe5\x2e\x18\x8c\x61\x74\x35\x08\x22\x89\xb5\x57\x8b\x08\x8c\xcf\x5d\x05\xfc\xfc\xca\xa5\x12\x4f\x70\x96\xf5\x6d\x64\xba\xa3\x4d\x08\xdf\x99\x02\x8f\x0f\x2a\x23\xe7\x5c\xd9\x78\x65\x40\xbb\x5e\xc8\xe8\x23\x79\x1b\x14\x35\x0a\xa1\x93\xf3\x5f\x49\xc1\x2e\x17\x68\x81\xe6\xa8\xaa\xd5\x33\xc8\x93\x60\x00\x1f\x26\x34\x78\xe6\xb2\xed\x6c\x2c\x1e\x5d\xe7\xe5\x73\xc0\x1c\xfd\x1d\x60\x14\x2e\xb4\xdf\xa7\x3e\x75\x0a\xb9\xe2\xe5\x62\x6a\xe8\x9f\x7d\x2e\xeb\x8f\x31\x22\xbd\xf4\x97\x56\x31\x2f\x8d\x3e\x15\x39\x21\xb3\x88\xcc\x8f\x90\x7b\x97\xbb\x79\xea\xc9\x57\x46\x9f\xe0\x40\x76\x74\xa3\xc6\x81\x26\x3c\x25\xfd\x6b\xaf\x9b\x24\xd4\x2a\xc4\x1d\x65\xb7\x6a\xc9\x7b\xbb\x85\x93\x52\x47\xfb\x99\x59\xdf\x55\x20\xe9\x58\xcd\xc3\xb7\x2b\xca\x11\xb6\xd6\x3e\xef\x27\x05\x2b\x09\xc7\x4b\x9f\xde\xd1\x58\xdf\x02\xa5\x1b\x87\x2e\x98\xd3\xe7\x9f\xc0\x6f\xc7\xb1\x88\xac\x68\xdb\xdf\x35\xa9\x67\x10\x8a\xe0\x99\x9d\xd1\x8c\xed\x2f\x08\x20\x5e\x87\xde\x38\x31\x93\x5e\x2b\x7b\x9a\xe8\x58\x2a\x6a\x91\xc6\xe8\x07\xae\xdb\x1b\x55\xbe\x7f\x68\xb4\x2b\xce\x93\x66\x22\x8d\x49\xf6\x5e\x9b\x43\x6d\x84\xbd\xb4\x41\x69\x77\xe9\x83\xec\xab\xd6\x6d\xbb\x05\x63\x17\xcf\x08\xa7\x98\x1e\x29\x7d\x5b\x6b\x7a\xbe\x5a\xb6\x8a\x5d\x99\x5e\xfd\x4f\x15\xd5\x52\xd1\x74\x6c\x95\xca\x42\x92\x60\x59\x45\x7e\xdf\x24\x83\x85\x6a\x64\x3c\x4a\x5b\xe6\x7b\xaf\x28\x75\x61\x49\xad\xf1\x27\x1a\x0c\xf2\x44\xf5\x02\x54\x75\xca\x3b\x46\xef\xec\x64\x72\x20\x4d\x47\x51\xbe\x39\xa5\xf3\x44\xbe\x3f\x77\x20\x58\x58\x7b\xd4\xfc\xde\xbd\xae\xfb\xb7\x2d\x8f\x2b\x7c\xfc\x26\x8f\x06\x6b\x4f\xb6\x29\x64\x26\x6e\x7e\x71\xf3\x27\x87\x86\x0d\xa6\xdb\x99\x9f\x12\xdd\x26\xd1\x5a\x68\x15\xd7\x0e\xdd\xc3\x96\x82\x2f\x51\x96\x51\x69\x81\xb6\xd4\x0c\x89\xd3\x91\x82\x17\xb2\x24\x91\x26\xb7\x9b\x63\x9c\xe3\x3c\x94\x9a\x88\x0b\xb0\x61\xbf\x8f\x93\xa6\x6d\x41\xf1\xd5\x54\xb8\x33\xab\xd7\xb5\x3c\x4d\x94\x3e\xd4\xe7\xd7\xf8\xf9\x9a\x51\x76\x43\xd9\x69\x01\xd6\x0d\x2d\xcf\x45\x16\x75\xaa\x3d\x19\x35\xf2\x30\x20\x3f\x33\x14\x0b\x7e\xce\x18\x9e\xdd\xad\x44\xfd\xcf\x6f\x35\x25\x5f\xc9\xd1\x53\xfa\x18\xd0\xe8\xfe\x18\x0e\x8b\xb1\x22\xfc\xd0\xdc\x5f\x67\xa5\xae\x45\xfe\x92\xaf\x9a\xd7\x01\x41\x18\x15\x1f\x8f\xce\x97\x90\x09\x44\xbe\x7d\x73\x1d\x52\xbc\x54\x41\x16\x32\xb4\x55\xe8\xb1\x30\x88\x28\x97\x43\xc0\xd6\x0d\xe0\xf7\x70\x31\xbd\xa5\x37\x90\x95\x54\xf1\x58\xa3\xe3\xa3\xba\x4b\xc1\x6a\x3a\xed\x68\xa6\x9c\x9f\xbf\x32\xf0\x39\x93\xd0\x94\x94\xe4\x1e\xa0\x47\xd9\x08\x6f\x0a\x8d\x89\x4b\x51\x7c\x8f\xfd\x6c\x78\xb8\xd2\x90\xa1\xdc\x63\xc4\x4e\x6e\x5f\x87\xed\x16\xe9\x9e\x4a\x71\xa4\xe9\x97\xc4\x6e\xb0\xd8\xe3\x8f\x19\xb5\xbe\xcc\xc2\xe5\x9d\x32\xc3\xef\xf4\x74\x6e\xfd\x2f\xb9\xe5\x32\x67\xe4\xbf\xd6\x50\x54\x1e\xd4\x96\x59\xef\x5a\x2c\x49\xb0\x8c\xc6\x7b\xb8\x58\x23\x10\x63\xe8\xad\xe4\x5e\xfb\xdb\x48\x7a\x66\x2a\xb7\xfe\x29\xd1\x51\xfb\x04\x8f\xbe\xa3\x85\x3d\x16\x6f\x8e\xdf\x1f\x0a\x1c\xe9\xcf\x58\xbd\x30\xbc\x21\x2a\x3a\x0a\x3f\x6e\xc4\xf2\x81\xd2\x8d\x80\x8b\xf9\x31\x4e\x18\x21\x75\xb4\x93\xe4\x8f\xf2\xa1\x2c\x81\xe7\xc8\xa2\x05\x30\x30\xfd\x7c\xe8\x83\xce\xee\x82\x33\x5c\x8f\x2c\x00\xf8\x39\xc2\x47\x61\x2e\x96\x15\x14\x90\x06\x46\x89\xbe\x12\xe6\xaf\x9c\xb4\x98\x75\x35\x95\x6c\xf9\x6b\x2c\x25\x4c\xec\xde\xe2\x2c\xcf\x79\x72\xf7\x1b\xaf\xc7\x71\x1a\x52\xfd\x92\xb8\x0a\x61\x49\xf3\x95\xd2\xaf\x2a\x82\x3b\x19\xc1\xe5\xbb\x11\xc9\x8e\x05\x37\x2a\x1d\x2b\xba\xd7\xbf\x04\xd8\x79\x16\x80\x14\x3a\xe4\x9d\xb5\x36\xe4\x6a\x6d\x14\x87\x40\x59\xf2\x39\x3b\x1f\x8c\x02\x65\x97\xa1\x3b\x15\x88\x25\xe6\xb6\x67\x88\xa0\x53\x59\x1e\x09\x6c\x21\x36\x7d\x72\xdb\x36\xe7\x5b\x6a\x95\x94\x19\xab\x59\x46\x10\x5c\xcb\xdf\xc8\x33\x06\x1f\xda\x5d\x7e\xf3\x98\xbf\xe3\xf7\x08\xfb\x34\xc8\x78\x7b\xde\x4a\x2d\xc8\xbe\xce\x47\xcd\x13\xa5\x0e\xb9\xa5\x0f\xbf\x67\x62\xe2\x40\xb7\x7f\xf4\xed\x45\xae\x87\x0e\x13\xf5\x83\xfc\x95\x1f\xb1\x5a\xaf\x6f\x07\x5a\xde\xc5\x32\x45\x99\xb0\xe1\xbf\x7b\x0b\x8c\x56\xe7\xea\x33\x21\xa5\x26\xb2\x62\xb5\x2b\x33\x27\xd4\x03\x26\xfa\xd5\x42\x8a\x22\x2f\xd9\x70\x54\xa5\xfd\xc6\xa0\x47\x87\x35\x25\xed\x91\x8e\xe5\x8e\x63\x29\x96\x0c\xe6\x87\x59\x01\x54\x55\x72\x75\xad\x09\x3f\x58\x78\x6b\x58\x55\x50\x8d\xd1\x6f\x79\x95\xe7\x00\x79\xa1\xed\xf9\x57\xd3\x7c\x65\x20\x75\x10\xef\x8d\xea\x5c\x6d\x8a\x7e\xf3\xf6\x90\x88\xa7\xa5\xd5\xd9\xf4\xc5\x4d\xb0\xc9\xf5\x6f\x09\xe7\xfc\x0b\xca\x0c\x0a\xed\xdf\x8e\x3e\x4f\x70\x80\x9b\x78\x09\x9d\x39\x66\x92\x06\xf1\x1b\xfd\x1b\x5c\x83\xf6\x5c\xdb\x63\xb7\x3d\x2d\x2d\x70\x79\xfa\x53\xe0\x36\xc7\x23\xae\xca\xf7\x32\x3a\xc8\xa6\x49\x7a\xef\x15\x17\x55\x12\x71\x02\xe5\x0d\x95\x79\xf6\x07\xbd\x69\x1a\xb4\xdb\xbe\x59\xd0\x04\x40\x56\x9f\x0f\x1e\x2b\xf8\x54\x0b\x0c\x63\x8e\xd2\x87\x4f\x42\xf1\x0e\x07\x8a\xd9\x20\x29\x41\xac\x53\xbc\x8c\x82\x03\x52\x9f\xff\x38\xae\x63\x6e\x79\xbf\xa7\xf4\x90\x43\x92\xe5\x56\x38\xd4\xf3\x53\xc8\xd1\x0b\x22\x26\x15\x4d\x96\x3f\xbe\x4b\x39\xee\xe7\xbc\x7e\x9c\x0d\xf7\xbf\x3a\x1f\x6c\xcf\xfd\x53\x04\xa9\x83\xb6\xbe\x81\x6f\x6a\x0c\x5f\xcd\x98\x53\xa6\x6e\x4a\x44\x46\x98\xc7\xd7\x6a\xc7\x90\xed\x1d\x24\xad\x52\x71\x24\xd4\x9f\x59\xa5\x1d\x82\x65\x3c\x80\x62\xa0\x35\x38\xdb\x2b\x8b\x6b\x58\xa2\x30\xc6\x74\x4d\x51\x0a\x63\x19\x86\x27\x24\xb9\x02\x22\x79\x90\xf4\x9d\x80\xa2\x16\x46\x8e\x0b\xe6\x51\x3e\xdf\xe2\xb7\xe2\x49\x16\x38\x7a\x0d\x78\x76\x28\xe8\xf7\x18\x02\x62\x04\xf8\xbc\xb1\xfd\x00\xd5\x7b\xe0\xf3\xa7\x1c\xd0\xa5\xd0\x8f\xb8\x22\x34\x27\x4f\x89\xdf\x84\xcb\x72\x5d\x5a\x1f\x2d\xaf\xfe\xc0\xfe\xf8\xe4\xa3\xf9\xe6\x2f\xaf\x75\x66\x7c\xe6\x89\xc3\x28\x82\x18\xad\xf0\xa3\x2a\x86\xbf\xb5\x97\x59\xfd\x3c\x0d\xfa\x34\x8b\x94\xc6\x61\xce\xd8\x25\xc0\x04\x42\xd1\xd8\xff\xfa\x7e\xae\xd6\x3b\xef\x40\x32\xbf\xe0\xc6\xe8\xcf\x10\x48\x56\x31\x51\xa1\x77\x6e\xb0\x92\x9d\x24\xd2\xbe\x12\xd4\x14\x6a\x37\x9b\xfc\xb0\x1b\x4b\xed\x0d\x73\x20\xf3\x32\x3e\x11\x72\x3b\x3a\x11\x8a\xbc\x54\x22\xac\x38\x03\xe3\x04\x7d\xc9\x09\x19\xb3\x0f\x9f\xc1\xbb\xfa\x58\x67\x6f\x34\x3d\xb4\x35\x31\xfb\x37\x4f\x05\x88\x1f\xe0\x31\x10\x84\x1e\x33\x11\xdb\x2f\x52\x3c\x14\x2c\xa4\x4c\xb0\x44\x55\xc9\xdd\xe9\x65\xf7\x78\x8b\x48\xa2\x56\x93\x0a\xa0\x1a\xcc\x6f\xf0\xbe\x45\xe4\x45\x4a\x9a\xb6\xaa\x40\xa5\xdf\x8a\x93\xf5\x1f\x2e\xde\x71\xed\x6f\xdf\x4b\x82\xa5\xba\x5b\xfc\x55\x5f\x14\xbc\x39\x4b\x61\x72\x33\xad\x60\x86\xf8\x4b\xf7\xe5\xd6\x87\x02\xfb\xf5\x17\xfe\xc2\xd1\xd9\x79\xfa\xfc\x64\xbb\x32\x44\x1f\x57\xfc\x13\x2e\xdf\xac\x58\xdc\x1e\x2f\xe7\x0c\x16\xc5\xc4\xcf\x52\xdb\x42\xca\x40\x74\x7d\xa5\xdf\xb5\x05\x17\x85\x1f\x56\xa8\x42\x60\x48\x9b\xf8\xf6\x91\x75\x1d\xaf\x54\x56\x9c\x0c\xbd\xee\xd9\x8a\xc4\xe6\x63\x93\x9c\x47\xea\x87\xc8\x4d\x5f\x9f\x0a\x76\x2c\xee\xb1\x43\x43\x4c\x7b\x44\x48\x73\x60\x0b\x18\x1d\xfb\x6f\xc8\x4d\x3d\xdb\x94\x8f\x6c\x2d\x2b\xc3\x79\xc2\x0b\x14\x6b\x36\xcc\x9c\x6c\x69\x37\x7e\x58\x76\xf0\x81\x8a\x7f\x7a\xf2\x83\xaf\x92\x8a\xa1\x0e\xd7\xc5\xed\xde\x73\x7f\x55\x2a\x97\xe1\x28\x92\xf6\x9f\x8e\x56\xb6\x86\x02\xdd\xfd\x70\x94\x06\x3a\x76\xe2\x53\xb7\x7c\x70\xc0\x0f\x4f\x5b\xf3\xf6\x91\x26\x8d\x65\x34\xda\x44\x86\x8c\x3c\xd0\xb7\xf5\xdb\x51\x21\x4e\xb1\x9b\xcb\x4f\x77\x77\x7d\x66\xdc\x43\x80\x76\xa9\xbe\x4f\xd2\xaf\x7f\xa6\xf0\x72\x86\xec\x93\xd3\xf0\x0d\x5c\xa0\xe5\x09\xc4\xb5\x89\xd5\xff\x76\xed\xa5\x09\x2e\xd7\xf7\x9e\x6e\x8b\x86\xcb\x26\x68\x17\xbd\xbd\xa6\x77\x4f\x1f\xe2\xb0\x5d\xe2\x0c\x94\x43\xbd\x5a\x4e\x15\x06\xf2\x66\x13\x18\xa1\x88\x0d\x62\x21\x2d\xaf\x62\x78\xad\x45\x40\xd2\x53\xf2\x8c\xca\x00\x23\xd9\xe4\x55\xbd\x40\x6a\x45\xcd\xca\x71\x6f\x9c\x2f\xf1\x1d\x9f\x6c\xde\x6d\x22\x2c\x98\xc0\x8c\x63\x4d\x55\xae\x81\xf9\x8b\x97\xc6\x71\xbc\xae\x52\x8c\x92\x1d\xc9\x29\xb6\xec\x3f\x30\xa1\xd2\x65\x86\xc6\x5b\xe9\xd7\x1a\x48\x10\x5d\xe8\x73\xe1\x99\xa1\xc4\xf0\x3d\xf2\x34\xd8\x5e\x1d\x02\x75\x9f\x23\xbc\x3d\x8b\xca\x9e\xd5\xe8\xb1\xbb\xf7\x21\xa0\x82\xcd\xcf\x58\x56\x0d\xd8\x53\xe7\x91\x45\x06\x36\x71\x4f\xf4\xcb\xed\x58\x66\xe4\x2d\xc6\x5b\x82\x45\x6f\x67\x83\xc0\x5a\x53\xfe\xc2\xda\x4c\x87\xad\xc6\x08\xca\xaf\x0e\x10\x3a\x6f\x85\x3c\x46\xa1\xfc\x1d\x00\xe5\x6f\xdf\xc3\x54\xfb\xaf\x46\x76\x9e\x4b\xc1\x53\x0e\x49\xfc\xa4\x2a\x34\x10\x6f\x1a\xdc\x66\xb3\xb8\xee\x42\x87\xd9\x37\x48\xb4\xd5\x7b\xc9\x0d\xfe\x1a\x55\x96\xb6\x25\x79\xca\x18\x28\x23\x68\xfd\x88\xce\x19\x1d\x1f\x74\x7e\xdc\xbe\xdd\xc3\x7a\xb4\x18\x6f\x94\xa3\x7c\x4e\xfa\x16\x5a\x74\x93\xeb\x70\x84\x06\x27\x5a\xb8\xca\xdd\x51\x78\x4e\x65\x58\xfb\xd7\x4b\xeb\x7f\xfd\xbe\x5f\xa7\x2d\x10\x0d\xc9\xbb\x83\xed\x5f\xc3\x38\x05\xb2\x06\x08\xc7\xa8\xa2\x83\x49\xf2\x67\xda\x09\xe1\x43\x05\xb7\x67\x15\x50\xdf\xe6\x51\xcd\x01\x8d\x07\x6d\x6a\xdf\xe1\x1c\x62\x61\xa0\xef\xe3\x7c\x69\x86\x17\xb9\x20\xd6\xfa\xc0\x1b\x33\x7c\x1d\xfc\xaf\x2e\x0b\x81\xeb\x8f\xaf\x1c\xa6\xfa\x30\xc3\xd9\x24\xf5\x04\xb2\x3b\xb3\x25\x42\x32\x65\xc0\xa4\xe4\x09\x8c\xa7\xf2\xef\x8d\x23\xb6\x62\xb8\x27\x86\x25\xa6\x1c\x62\xe7\xbf\x6b\x44\xf0\x4c\xa9\xd7\x62\xd7\xe9\x4b\xd1\xe9\xc5\x3c\xd4\xbe\x12\x34\xf7\x89\xe7\x0f\x62\xfa\x3a\x59\xa4\x66\xe6\xd9\x96\x9e\x78\xe4\x3f\xcf\x9c\x5b\x73\x19\x85\x1e\x07\xca\xcf\xf5\xf0\x4c\x85\xe5\x9b\x54\x62\x32\x7e\x06\x8b\xd6\x27\x68\xfe\xbc\x37\x46\xba\x44\xc8\xa6\x6c\xc9\x7c\x1e\x85\xe2\x55\x63\xe9\x7b\x18\x91\x14\xc3\x3b\x67\xa2\x18\xef\x64\x0a\x5b\xe6\xef\xfe\x0d\xad\x4e\x48\x11\x6b\xd5\xa9\x39\xd0\x6f\xfb\x4d\x9b\xc6\xed\x66\x13\xb6\x8c\xa8\x76\xdf\x74\x45\x71\x07\xbd\x51\x61\xf4\xe9\x10\x2a\x64\x1f\x3a\x42\x60\x85\xb3\x36\xf0\x6a\x67\x0f\x90\x0e\x1a\x0e\xad\x75\x7e\x66\xa0\x15\x5c\xec\xe1\xb4\x2b\x50\x16\x24\x79\xcd\x4c\xad\xf0\xcb\xd9\xa6\x9c\x00\x28\x68\x70\x1e\xc5\x21\xef\x08\xce\x6e\xf1\x1f\x89\x9f\x5a\xc4\x96\x3e\x5a\xb9\x52\xe8\xab\x55\xe8\xdd\x50\xc4\xcb\xcf\x40\x58\x72\xbe\x3f\x06\xbe\x5b\x33\x1c\x2c\x00\x91\x0a\x56\x42\xae\x39\x45\xa7\x06\x30\x38\x89\xa7\xf7\x0f\x83\x7a\x04\x09\x66\xe9\x53\x8e\x7e\x8a\x18\xd7\x0f\x9c\x21\x06\x53\x60\x98\xae\x15\xb4\x06\x30\xc6\xa2\x78\x0c\x8b\xca\x8e\x75\xa1\x0f\x38\xbb\xab\xf6\x1f\x8c\x9c\x6e\x7f\x0f\x87\xbf\x38\xb4\x5f\x68\x1f\xcc\x69\x2c\x7a\x13\x0c\x74\x97\x6f\x8e\x4c\xba\x46\x05\xf5\x0a\x52\x0d\x63\x43\xf7\x88\x91\x94\x50\xdd\x53\x5c\x79\x09\xe3\xe5\x3a\x78\xfa\x11\x00\xa9\xcd\x4a\x05\x54\xf3\xc4\x8f\xc3\xaa\x28\xd2\x71\x54\x59\x76\x84\x95\xe5\xba\x6f\x1b\x62\xc2\x31\xfe\xcd\xf5\xbf\xe6\x76\xf0\x55\x64\x0d\xb2\x65\xdc\x7f\x27\xc4\xf3\xa9\xc6\x78\x16\xbf\x3b\x22\x31\xdb\xe4\x6a\x73\xe1\x2e\xa6\x62\x50\xb5\xa6\x4d\xbb\x75\x56\x1c\x2f\x7c\xbd\x5f\x6e\x51\x8c\xb9\xf6\x10\x61\x22\x9c\x65\x77\x28\x6d\x4a\x62\x7c\x72\xb9\x57\x5f\x1b\xb0\x53\x73\x4e\xa0\xd3\x00\xb5\x0d\xe9\x86\x24\x0d\x3f\xca\xd9\x39\xc0\x1f\x0f\xda\xf4\x7f\xd7\xd1\xe9\xb6\xd2\xe0\xe3\xb4\xd6\x85\x2a\x1e\x96\x7b\xcc\x11\x20\x61\xe8\x73\xf7\x64\xc6\x8c\x8c\x19\x96\x37\x7c\x9e\xc6\x5b\xf2\x31\x53\xf6\xee\xd7\x8d\x1e\x02\x77\x86\x3e\x8a\x8b\x05\xae\x1b\x60\x14\x01\x47\x2d\xbc\xec\xe7\xf1\x78\x2d\x54\x9a\x32\x27\x15\x35\xc6\x5a\x33\x0e\x61\x89\xfb\x4a\x9c\xc5\x6f\x1f\x98\x10\x18\x8e\x77\x51\x7a\xa9\x4a\x40\xc6\x41\x70\x64\x69\x50\x0a\x35\x16\xcb\x6f\x14\x81\x0a\x76\x6c\xff\xe5\x75\xf8\xc2\xe7\x0b\x44\xb6\xec\x49\xa2\xfd\xeb\x75\xac\xf3\xfd\x0e\x9e\x71\xee\x60\x5e\xb6\x54\x98\x87\xd6\x57\x58\x31\x76\x53\x54\x5b\xe5\x99\x0f\x1d\x55\x80\x9e\xba\xdd\x7b\xf4\x6a\xff\xfa\xce\xa4\x7b\x68\x44\x7c\x3c\xf7\xa7\x2d\xf5\xfd\xee\x20\xc9\x05\xf1\x8b\xfb\x5c\xea\x61\x90\x1f\xd3\x2b\x52\x07\x98\xcc\x08\x06\x6b\xf7\x77\x6d\x78\xe0\xeb\x7d\x17\x17\xad\x0a\xba\xc4\x49\x90\x5c\xa9\xb6\xdd\xba\x08\x4b\x0d\xe0\x8b\x67\xfb\xf4\x8e\x65\xfe\xcf\xb6\xd7\x34\x53\x16\x87\xb2\xc7\x0f\xff\x6a\xe0\x3a\x8a\x3f\x1a\xae\x74\xaf\x7e\x2e\x51\xcc\x91\x27\x05\x53\xd9\x37\xcb\xb6\x5d\xfc\xba\x24\x4a\x2a\xb6\x98\xe9\x78\xf6\xc4\x46\x5f\xef\x62\x7f\x4b\x1d\x03\x41\xd2\x19\xa6\x28\x70\x45\x2d\x3a\x5a\xa3\xb1\x6b\xed\xb4\x7b\x07\x7a\xf8\xea\xf4\xc6\x04\x2d\xb8\x4c\xc4\x32\x7f\xed\x3d\xa1\xbf\xbd\x7c\xf4\x4f\x74\x1f\xe0\x74\xb1\x47\xf0\x6b\x76\x3f\xd5\xe7\x2a\x9e\x8c\xc8\x0e\xa0\xe5\x88\x34\x29\xd8\x75\x0f\xa9\x3e\x3b\xa2\xfd\xeb\x9d\x29\xd3\xc6\x31\xa8\xda\x22\xa6\x13\x5e\xec\x67\xae\x5d\xa9\x5e\x9d\x61\x1a\xd4\x4b\xda\x2e\xe9\xbc\x91\xe3\x69\x8d\x92\x18\x6b\x64\x4c\xdc\x52\xfe\x95\xca\x7b\x50\x0e\x9d\xab\x8b\x6c\xcc\x51\x87\x56\x7d\x55\x40\x2a\xf3\xe4\x9a\xf0\xeb\x4b\x7a\x7b\xfd\xce\x9d\xed\xf6\x19\x8e\xbf\x44\x35\xaa\x35\xdf\x23\xc0\xb8\x3a\xca\x65\x21\xe1\x1b\x0e\x4b\x77\x08\xe1\x32\x46\x01\xca\xfe\x39\x77\x26\x22\xe3\x34\xa6\x7f\x20\x3f\x0f\xf9\x3e\x7d\x89\xd9\x4d\xa2\x67\x5b\xb4\x73\x75\xbf\x00\x4d\xd0\x6a\x09\x0a\xa3\x60\xf7\xcf\x5d\x1a\xe7\xe1\x79\x2f\xa7\xff\xd3\xde\xca\x1e\x52\x89\xe3\x6f\xb3\x63\x44\xb2\x3f\x9f\x37\x0f\xb8\xcc\xff\xba\x34\x1a\x8f\xb2\x7b\x7c\x4c\x4b\x14\x82\x50\xfc\xb6\x9f\x08\xc2\x50\x93\x2c\x3b\x90\xdf\x40\x55\x21\xd3\xd4\xd6\xa0\x12\x52\x72\x18\x3d\xec\x37\x4a\xc5\x88\x57\x3e\x6e\x28\x58\x2e\x81\x37\x9c\x54\xb0\xf9\xf1\x20\x1a\x50\xe4\xf4\x16\xc5\x7d\x11\x87\x33\xae\xe2\x32\xcf\x64\xfc\x2f\xa7\xce\x59\x85\x71\x2e\xeb\x57\x33\x84\xa6\x3a\x99\xea\xcc\x96\x38\x34\xfa\x71\x18\xee\xc9\xf7\xaf\xfc\x1f\xdf\x90\xaa\x02\xfa\xbe\xc7\xa0\xc4\xaa\xf4\x24\x84\x01\x75\xcf\x11\xba\x04\x3f\x01\x5c\x04\xf8\x18\x90\x86\xfc\xf7\xe8\xc5\x25\x22\xcc\x63\x57\x08\x99\xde\x3a\xad\x73\xa6\xfc\x0d\xbc\x49\xcd\xa3\x1a\x86\x80\xcc\x92\x49\x1b\x1d\x51\xb5\xf4\xe7\xf1\x1c\xec\xb6\xb1\x05\xed\xf6\x19\x6d\xa6\xdb\x4d\x9c\x43\x2d\x0a\xab\x11\x5b\xf4\x67\xc6\x59\xd5\x53\x35\x28\x6a\x21\xf3\x1b\x20\xee\x80\x2c\xb0\xd4\xe7\x86\x7a\x89\x7c\xa1\xad\x9c\x0b\x0f\x8d\x1d\x5c\x0b\x28\xb9\x6a\x6d\xdc\x36\xd2\xdb\x3a\x5e\xe9\x5c\x3d\xbe\x78\xc7\x6c\x93\xe1\x5c\x6f\x59\x6a\xc2\x55\xeb\x23\xba\x51\xf6\xaf\x51\xa0\x4a\x82\xfd\x33\x6e\x6c\x0a\x20\x53\x67\x68\xb8\x6b\xc6\xb3\x67\x85\x61\x58\x5e\xd8\x96\x20\xc4\x46\xcc\xa8\x80\x72\x21\x6f\x46\xd8\x62\xde\xbd\x86\xb2\x02\x52\x38\x19\xee\x40\x98\x22\x5d\xed\xce\xf3\x38\x6f\xf0\xfb\xb7\x1f\xac\xe9\x6a\xac\x17\xc7\xa8\x20\x62\x33\x27\xfe\xe2\x23\x42\xaf\xd9\x42\xcc\xb6\x7a\xba\x29\x2c\xad\xe1\x6f\x6c\x2c\x1f\xed\xcd\xa8\x9e\xdd\x5d\xa0\x43\x7f\x8e\x79\xc9\x50\x14\x88\xaf\x64\xc5\x9e\x0e\x06\xce\x0f\xb2\x4f\xfc\xe5\xb1\x6a\x37\x26\x9b\xdc\x51\x57\x73\xf2\x43\x7e\x80\x13\x10\x41\x1d\x95\x43\xf8\xfc\xf0\x73\xa6\x45\xe7\x67\x31\x4e\xe1\x56\x73\x9f\x6e\x6b\xf8\xdd\xf2\xd2\x75\x60\xd2\xd2\x1f\x71\x35\xf1\xb7\x89\x62\xf9\x8b\x12\x70\x2a\x7d\xef\x5b\x25\x1e\x67\x57\xbb\xdd\x38\x06\x4d\x8d\x96\x97\x0d\x19\x03\x53\x55\xfc\x57\x46\xd5\xd2\x5f\x1e\xa4\x6e\x7a\xd7\x78\x91\x97\x4b\x6f\xad\x4f\xae\x24\x07\x11\x86\xd8\x42\x3a\x32\x09\x3f\x7d\xf0\x73\x4a\x4c\x8c\xbf\xe7\x4f\xa7\x0f\xad\x0b\x54\x98\xad\x6a\x20\x7c\x66\xa4\x27\x5e\xb5\x71\x43\x8a\xa0\x95\xf5\xd3\x95\x8c\xcf\x1b\x5a\xd0\xbb\x6e\xa6\x4b\x3e\xf4\x31\xe0\xa8\x9b\xa7\xb9\x9c\x71\xeb\xab\x23\x5b\xab\x25\x1e\xb1\xcd\xb8\xcc\xdb\xef\x40\x58\x0e\xe6\xb8\xec\x73\xa5\xb1\x7c\x86\x49\x04\xac\x3d\xb7\x08\xa8\x13\xc0\x46\x30\xa4\x65\x8c\xa1\x0e\x91\x74\xad\x05\xa2\xb9\xae\xf2\xfd\x40\x6b\xf5\x8a\xc3\x4f\x09\x99\x24\x87\x51\x58\x25\x51\x20\xcb\xb5\xdc\xfa\x57\x0f\xd0\xf0\x24\xba\x3c\x7e\x73\xb0\x02\x6a\xf1\x14\xfb\x9b\xef\x21\x60\x71\x4a\xea\x4d\x65\x4b\x1d\x06\xce\x62\x93\x31\xba\xa9\xff\x6e\x88\x01\xad\xa9\x52\x8b\x7a\xfa\xc1\x2e\x04\xc9\x99\xa3\xea\x83\x87\xf0\x8d\xa0\xf3\xd7\xdd\xd9\x36\xe4\x46\xf5\x25\x95\x40\xf4\xa7\x54\xbd\xaa\x38\xcf\x0e\xe9\x1a\x2b\xc0\xee\xc0\x0d\x54\xc1\x62\x94\x48\x3a\x51\x0a\xb7\x41\xa6\x3a\xff\x69\x83\x2d\xb4\xd0\x91\xdf\xcb\x14\x18\x9f\x15\x4c\xa2\x85\x4c\x6b\x83\xf8\xd5\x61\x6e\xbf\x19\x56\xdd\xd1\xc1\xb8\x19\x33\x05\xdc\xc3\xeb\xd0\x9e\x6e\x36\xe7\xdd\x48\xba\x2b\x84\x93\x47\x3f\xbf\xf1\xf7\x3a\xba\xec\x5b\xb5\xf2\xaa\xe9\x5c\x3d\x0b\xdf\xe7\x2b\x86\x8c\x92\x28\x3f\x3c\x5a\x51\x93\x43\xf8\x58\x98\x37\x88\x2e\x94\x78\xaa\x80\xee\xa7\xde\xb7\x90\x67\xbe\xa1\xe1\x46\x7a\x8f\xf0\x7f\x37\x6f\x2d\x6e\x4b\x79\x3d\xfd\x2a\xc7\xb0\x01\x5a\x3f\x8e\x01\x41\x66\x57\x2a\x52\x04\x45\x88\x67\x8b\xaf\x1c\x14\x75\x30\x1e\x05\xdd\x43\xc0\xf6\x7a\xad\xbb\x4c\x9b\x77\x05\x9d\x96\xf0\xad\x0e\xbb\xae\xc0\xe0\x92\x00\x38\xbf\x81\x80\x7e\x6f\xe7\xb2\x11\x57\xf9\x4c\x57\x0e\xf9\x5d\x6e\x79\x3d\x6e\xb9\xb2\xd8\x45\x49\x89\x20\x0e\x2e\xd7\x82\x56\x39\x41\x63\xe3\x35\x37\x50\x01\x15\x77\x3f\xff\x91\x34\x4b\x7a\x6f\x72\xd9\x7b\x40\x7b\xfc\x77\x96\x1f\xf1\x9b\x03\x65\x79\xeb\xdc\x19\x99\x1b\x34\x40\xb8\xaf\x0a\xaf\x68\x33\x4a\xf2\x57\x6c\xf9\xc8\x15\x05\xd8\xb2\xf6\x74\x93\x6b\xbe\x0d\x08\x9e\xef\x34\x40\xa1\xe8\xa6\xc6\xe0\xdf\xea\x0d\xe0\x51\xd8\xff\x1a\x86\xb9\xcd\xfc\xc5\x21\x31\xd8\x94\x88\x23\x22\x2b\xac\x21\x18\x85\x13\x0a\x79\x05\xff\xd5\xbe\x33\x4d\xde\x51\x80\xb7\x59\xef\x5e\x3c\x6b\x78\xb1\xd4\xa0\x51\x6a\x16\x1a\x14\x0e\x27\x13\xe6\x32\x35\xf1\x3c\x74\x8a\xc0\x6a\x0b\x2d\x0e\x94\xd0\x68\x27\xcf\xdb\x5c\xf9\xe5\x72\xd8\x3a\xaa\xaa\x2b\x2d\xa6\x7f\x1f\x58\x85\x20\xfa\x4f\xf7\x7a\x6a\x4e\x1e\x05\x54\x22\x98\xb4\x2f\x05\xbe\xf8\x78\x7a\x82\x77\x7a\xd8\xd2\xba\x13\xb4\x81\x14\x11\x86\xb8\x17\x89\x46\x09\x56\x69\xa8\x95\x85\x24\x42\xb4\xe4\x0b\x08\x8b\x7d\x20\xeb\x77\xa6\xeb\x7a\xb6\x27\xda\xa1\x47\xe5\x77\x7f\xdd\xfe\xc0\x32\x55\xc2\x00\xf2\x12\x3c\xea\x6f\x7f\x9f\x44\x62\xf1\xc3\xef\x7c\x75\x7a\x74\x20\xa7\xd2\xa6\xe9\xc8\xf4\x74\xd1\xf3\x6b\xa1\xab\xee\x74\xd0\xa7\xb7\x06\x0a\xce\x82\xfe\xfa\xf9\xc4\x7b\xbb\x8c\xa4\x90\xa8\x67\x14\xde\x96\xe8\x2b\x2b\x09\xe0\x71\xcb\xc3\x43\xb6\x30\x08\x46\x6c\x32\xc0\xf2\xe6\x9b\x4c\x32\xcd\x69\xe1\x3d\x1c\x73\x6d\xdc\x96\x41\x16\x7a\xfd\x5c\x86\x12\x83\x48\x8d\x8e\x1d\x47\x78\x01\x65\xa1\x09\x47\x6c\x1c\x0f\x45\xa3\xb7\xfc\x26\xdd\x47\xde\x56\xb6\x8b\xb5\xc6\x9b\x2a\x87\xba\x6c\xa3\xb5\x19\xc4\x83\x13\x9e\xc3\x8a\x0d\xca\x04\xbf\xc0\x9e\x2c\x8a\x5d\x7e\x7e\xdd\x75\xc7\xe8\xe1\xd1\xdd\xfc\xe2\x2a\xe9\x8c\x57\x6b\x3c\xc8\xed\x73\xfd\xf3\x6e\x98\xa7\x9b\xf5\xa4\x6d\x41\x8f\xb6\xa1\xd6\xd6\x9e\x85\xc4\xe8\x14\x78\x92\xc0\xb9\x4d\x15\x29\xe2\xd0\x9b\xfb\x32\x1c\xe6\x27\xaa\x83\x2a\xdf\x9e\x29\x0b\x3a\x5a\x71\x29\x4e\x88\xa5\xb0\x14\xbf\xe2\x88\xe1\x73\x0d\x2b\xb2\x70\x40\xd9\xa6\x56\xfc\xb1\xdb\x9e\x26\xcb\x52\x35\x7d\xfd\xaa\xf9\x96\xfc\xe2\xa9\x16\x8c\x44\x48\xaf\xe6\xa5\x6e\x25\x76\xd4\xc0\x51\x7d\x8c\x32\xc9\xa6\x69\x51\xca\x86\xd9\x6b\xaf\x2b\xc6\x55\xbb\x67\xfe\x52\xf0\xa7\x21\x24\x7a\xeb\xc8\x57\xa5\x64\xc7\x19\xad\x6c\x84\x1a\x40\x49\x19\xd8\xb1\xd2\x36\x69\x1c\xf2\xa7\x2c\x3c\x05\x68\x69\xf0\x04\xd1\x2f\x9c\xd5\x57\xf5\x0f\xcb\xaf\x05\xf5\xef\x3b\x1a\x24\x35\x55\x18\x32\x2f\x5f\xaf\x3b\xf4\x33\xe6\xfb\xf1\x3a\xbf\x2f\x5e\xa9\xdd\x4a\x12\x9d\x66\xea\xcd\xc3\x46\x68\xe6\x15\x7c\xc1\x46\x32\x38\xe7\x61\x34\x7d\xb0\x92\xdd\x2b\x21\xdc\x2c\xc1\x63\x67\xda\xbe\x8f\xe4\xac\x63\x6b\xab\x85\xe2\x26\xd1\x2f\x20\x03\x7d\x78\x1d\xfa\x03\xcd\xfb\x12\xb5\x70\xb6\xaa\x99\x7a\xc1\x18\xa5\xea\x29\x45\x65\xe5\x62\xc6\x5d\xeb\x8d\xbe\xfa\xe3\x9e\x75\xb7\xe7\xb0\x2d\xe0\xba\x12\xc7\x70\x0f\x52\x68\x7d\xd2\xa4\xb1\xe6\x5d\xcb\xf0\xfd\xce\xa4\x16\x4b\x38\x85\x54\x3e\x76\x10\x8c\x56\x70\xb0\x4a\xfc\x9d\x2f\xaa\x9c\x3b\x77\x48\x51\x19\x98\x20\x40\xa8\x9f\x46\x41\x8f\x82\x23\x32\x1a\x83\xaf\xb6\x6d\xd8\x36\xfe\xf1\x93\x9a\xd5\x3d\xea\x84\xcb\x01\xa2\x22\x40\x53\x15\x8f\x83\x94\xed\x99\x62\x21\x41\x1c\x99\x4d\x01\x05\x4d\x0c\x82\xe9\xa5\x91\x8b\x29\x1f\x69\xae\x14\x68\xdf\x94\xca\xb5\xe6\x94\x71\x05\x1a\x20\xb4\x4f\xbd\x68\xb6\x3d\x36\xd1\xad\x54\xd7\x67\x0c\x07\x00\x2d\x2d\xe6\x73\x1f\x19\x0a\xa8\x80\xe7\xaf\xfe\x4d\x5a\x37\xcc\x47\x65\x2a\x96\x6a\xca\x9b\xc4\x88\xe2\xfc\x3a\x8a\x2b\xa0\x96\x04\x96\xa7\x22\x03\x0f\x92\xbd\x6f\x5a\xe3\x0e\xe5\x8f\xcb\x42\x2c\xd6\xf8\xab\x6a\x13\xf7\xef\xf7\xf0\x21\xef\x60\x77\x8d\x07\x24\xfa\x5d\xc4\xe8\x4d\x67\x75\xe3\xb0\x64\xb1\x07\xe0\x4f\x35\xfa\xf3\x74\xc5\x4b\x18\x78\x83\x24\x4b\xe9\x74\xe8\x32\x43\x8c\x0e\x21\xb5\xd1\x7e\xb7\x09\x5c\xad\x2c\x3e\x48\xce\xc2\x29\xcc\xdb\xe8\x69\xfd\x76\xbc\xd3\x29\x51\x2b\x78\xed\x01\xa2\x06\x09\xde\xb7\x5b\xae\x37\x9b\xe3\xe0\xe5\xa7\xee\x13\xde\xb6\x85\x55\xd7\xd3\x3f\x3a\x9e\xab\xed\x76\xe8\xdb\xab\xbf\xa9\xa8\x8c\x70\xf4\x81\x63\xfd\x5e\x95\xed\x38\x51\x0f\x51\x1e\x1a\x9d\x44\x28\x33\x32\x75\x83\xb5\xda\x1d\x44\x81\xd9\x62\x10\x53\x6a\x87\x89\xa2\x33\x0d\xbe\x52\x9c\x18\x7b\xb7\x2d\xc7\x71\x0e\x8b\xc3\x3e\x77\x5e\xd8\x48\xf4\x46\x2a\x80\x85\x04\x91\x1a\x83\xc0\xb9\xc6\xf5\xfd\xc7\x2a\x12\x51\xe8\xb2\x99\xbb\xdf\x1a\x30\x87\x17\xdc\x49\xeb\xdd\xce\xd0\x9a\x03\xd5\x8b\x7f\x98\x98\xb8\x1a\x9b\x46\x89\xb4\x79\x7c\x61\x69\x8d\x25\xb8\xe0\x13\xa8\x10\xdc\x0b\xa7\xd6\x26\x9a\x3e\x34\x22\x18\x15\x51\x2b\x23\xa2\xa9\x70\xc3\x36\xf7\xea\xb1\xae\x69\xc9\x36\x75\xf6\xe8\xa0\x89\x10\x2d\xa2\xc0\x32\x78\xe9\xb9\x12\xfc\xb0\x2e\x48\x01\xea\x8d\x9a\xbb\xb6\x2e\x83\x49\x13\x64\xbe\xfd\x10\xe1\xb9\x6a\xb5\x8b\x33\xdd\xe2\xa7\xa9\xf1\xaa\x5b\xb0\x75\xc6\x4d\x9a\x29\xaf\x96\x85\x25\x43\xbf\x51\xba\x15\x23\x8a\x45\x1b\x76\x0c\x1d\x00\x9a\x04\xb4\xf6\xda\xc6\x2f\xbf\x55\xc6\xcf\x68\xab\xaf\x41\x7e\xe6\x7a\x50\x26\x41\xe4\x1b\xae\x6e\xf1\xd6\x91\xbf\xff\x62\x46\x8d\xd4\xa1\x35\xe3\x75\x5c\x49\x68\x76\x18\xe3\x97\x8a\x98\xe9\xaf\x98\x21\xb1\x57\xbe\x8e\x14\xd6\x77\xf1\x12\xba\x5a\xdd\xb2\x86\x7a\x58\x10\x47\xa4\x79\x51\x44\xe1\x04\x65\x66\x27\xa5\x8f\xad\xa8\x0d\x61\x68\x1c\xc8\xa8\x23\x38\x32\x6b\x37\x5b\x02\x66\xb1\x65\x4b\x88\x52\x8e\xb9\x03\xa0\x9d\x16\x93\x24\x6b\x03\x02\xb5\x62\xbb\xed\x08\xfc\x72\x0b\x5e\xcb\xe5\xb0\x21\xf4\xa1\x85\x64\xe3\xc7\xab\x02\x57\xc4\xe5\x72\xfc\xb5\x33\xc0\x8a\x03\xc6\x41\x2f\x51\xe4\xc2\xfe\xce\x07\xee\x48\xd3\xd2\x1c\xe7\x22\x5c\x66\xe8\x29\x4b\x33\x42\x71\x0c\x2b\x0c\x78\x69\x02\x96\x11\xba\x12\x54\x86\x57\xc5\x85\x21\x09\x1c\xfd\xa9\x0e\xa7\x66\x1d\x39\xcd\x76\x34\xdd\x0b\xb4\x44\x5b\x76\xa3\x32\xa4\xb2\xec\x26\xd9\x62\xbb\x01\x2b\x70\xf1\x23\x83\x4a\xb8\x29\x8c\x2a\xdd\xa7\xe8\xef\x79\x5e\x52\xfd\x97\xfe\xfe\x65\xb1\x3f\x51\x97\x2d\xf2\x2d\xce\xf6\xe1\xa1\x7b\x06\xb3\xe8\x8f\x13\x46\x3a\x88\xd8\x92\x61\xa7\xaa\xaf\xe8\xb8\x8d\x26\xd3\x2d\x2f\xd1\x5e\xb3\xec\x6d\xd5\xa5\xfd\xa1\x2d\xc5\xf0\x45\x13\x06\x73\x97\x2a\x6e\x47\x65\x7c\x34\xa5\x56\x1a\x68\xbf\xcd\xb1\x04\x1a\xbd\xbe\x7c\x6e\xbb\x6e\x89\x53\xde\x93\x22\x35\xdd\x25\x3b\xa6\x58\xaf\x71\xd5\x4a\x17\x22\xee\xef\xf5\x51\x0c\x3a\x8d\xb7\x40\x5d\x47\x01\x34\x39\x0b\x96\xaa\xde\x58\x6d\xaf\x22\x7e\x5a\xc2\x6b\x98\xe9\x6f\xb4\xb8\xf9\x90\x50\x43\xa7\x34\xf2\xbb\x0f\xb0\xf5\x75\x95\x7b\xcb\xa4\xd3\x7e\xf2\xae\x37\xb4\x84\xc3\x92\x0a\xc2\x1c\x35\xcd\xb4\x61\x06\x1f\x48\x20\x72\x28\x23\x89\x79\x59\x15\xa4\xc4\xdd\x78\x0b\xa7\x33\x89\xff\xca\x87\x15\xc6\x98\x77\x71\x82\xda\x0d\x97\x3b\xd9\x2f\x74\xec\xbb\x92\xed\xe4\x70\x44\xcc\x97\x42\x5c\x4d\x40\x01\xa0\x39\xe5\x13\xb9\x43\xd4\x1f\x42\xc7\xc3\x6b\xfa\xfa\x10\x41\x6a\xa5\x4b\xdc\x79\xe3\x67\x94\x7f\x43\xc0\xc7\x62\xc0\x22\xf1\x01\x6b\xd5\x47\xb9\x87\x31\xf3\x49\x12\x5e\x09\x8d\xdc\xaa\x9e\x0f\x4e\xbe\x49\xf6\x3a\x41\xa3\x12\x4e\x3b\xe4\xd6\x61\xd1\x8e\xd2\x5e\x97\x71\x72\x2b\xd6\x02\x4b\x98\xcd\xbf\x1e\x35\xc9\xa7\xeb\xd1\xfa\xf2\x89\x0e\x6f\x87\x78\x80\x82\x51\x6d\xe7\x62\xb2\x60\x19\x66\xe3\x32\x4c\xaa\x49\x5f\x17\xa3\x4a\x3b\x9e\x83\x9b\x81\x2c\x68\xee\xde\xa1\x23\xc6\x03\x78\x9f\x07\x0a\x29\xb4\xce\x62\x82\x34\x0a\xeb\xe5\xea\x7f\xb4\x5c\x52\x58\x9a\xe1\xdf\x59\xf6\x46\x59\xbf\x18\xb2\x97\x78\x29\xc0\x3c\x16\x74\x57\xa6\xb0\x9a\xbc\x07\x42\x84\xa7\x58\x3f\xd2\xe0\x4c\xca\x7d\x24\x7e\x41\x1f\x8c\xa1\xcf\x25\x2a\x29\xf0\xb9\xe6\xf3\xe6\x79\xd6\x2a\xe3\x8b\xc4\x45\xee\x85\x99\xd2\x73\x8e\xe2\xce\x64\xb0\x77\xce\x03\x1d\xb7\x49\x71\xbf\x20\xbf\xb5\x82\xdb\xa1\x98\x22\x08\x8e\x29\x40\x24\x35\xaa\xba\x37\xad\x5d\xfe\x1d\x25\xd6\xe3\xff\xc6\x9a\x15\x54\x88\xfd\x1b\x78\x37\x22\x87\xdc\xe4\x88\x5a\xff\xc8\xe0\xe1\x29\x99\x4e\xfa\x87\xd8\xfc\xc8\xdd\xe0\x15\xf3\x01\xaf\xda\x1d\x2c\xb4\x94\x83\x8e\x20\x7c\x00\x29\xbe\xc1\x6e\xd3\x4d\xb9\xc4\x6f\x42\xe3\xd8\xf0\xd2\xe9\x16\x03\xaf\x3b\x83\xde\x77\xe3\x6c\xd8\xd4\xdd\x57\x34\x0c\xb1\x3e\xef\x47\x4c\xbf\x28\x88\x14\x4c\xe5\x5f\x5b\xd1\x64\xe2\x98\x79\xfa\x45\xbb\x08\xa7\xe9\x7b\xc3\x0d\x14\x24\xd5\xd0\xd7\xc5\xbd\xd4\x17\x12\x83\xab\x19\xe7\x57\xaf\xa7\x9c\x54\xbd\x64\x01\xa0\xfe\xe4\x9a\xe8\xd7\x7a\xe7\xd7\xbd\xa7\xab\x30\xd0\x15\xca\x58\xa9\x02\x3f\x46\x61\xd1\xf7\x61\x62\xc9\x5f\x7c\x28\x46\xd7\xd8\x98\x4b\x56\xad\x9d\x26\x5d\xe6\xb5\x54\x46\x3e\x2d\xb0\xf2\x07\x38\xa1\x36\x7b\x1d\xc1\x51\x37\x9c\x8f\x2d\x69\xce\x84\xcd\x4c\xfa\xd4\xe7\x90\x28\xad\xa0\xaa\xa3\x3d\x71\x86\x37\xf9\x4b\x50\xea\x2f\x27\xed\xd7\x83\xe6\xc4\x9d\x2f\x06\xf8\x05\x9b\x0f\xbe\x3f\xac\xc7\x75\xdb\x49\x1a\xa8\x6d\x16\x0a\xf4\x75\x05\xb6\xd6\x28\x90\x64\x98\xfa\x21\x5e\xba\x54\x50\x1b\xbf\xcd\xbb\x86\xec\xf9\x65\xfb\xbd\xce\x1a\xfd\x91\x87\x32\x86\x33\x56\x3b\xfe\x26\x2f\x77\x42\x08\x59\x07\xa2\x50\x5f\xed\x03\xbb\x6a\xf1\xd9\x62\x61\xbd\x83\xd9\xaf\x58\x53\x4c\x76\x8a\x6c\x43\x13\x30\x18\x07\x84\x99\xc1\x64\xbf\xfc\xf0\x0a\x04\x12\x30\xcd\x21\xeb\x18\x8d\xcd\xbc\x8f\x97\xc2\xd8\x7e\x6b\x70\xf8\x36\xe1\x6b\x09\x34\xfd\xbe\xe6\x92\x10\xb4\x93\x02\xcb\x23\x51\xf5\xc3\x29\x06\xd7\x7b\x3a\xb1\x5f\x9d\xcd\x02\x75\x27\x08\xb5\x56\xdb\x76\xcd\x2b\xc6\xa1\x23\x3b\x77\x4d\x3a\x7b\x6b\x29\x85\x4f\x39\xf0\xb1\xa0\x68\x81\x95\x83\xe4\xa9\x23\xd6\xcb\x94\x94\x9f\xbf\xf8\x06\x2c\x43\xb7\xbf\x59\x0d\x02\xed\xd3\x32\x24\xe2\x80\xa8\x5b\xb6\x73\x56\x83\xa8\xc4\x6f\xee\x2a\x52\x18\x13\x9f\x71\xbf\xd0\x55\x7f\xd0\xdc\xab\x6f\xb3\xe9\x28\xaa\xde\xc0\x6f\xdc\x82\x67\x99\xf3\x5a\x18\x7d\x39\x96\xf6\x9b\x0b\x9d\x81\xf2\x21\x51\x60\xf9\xd6\xb1\x43\x1e\xf2\xf5\xba\x56\xc1\x54\x46\xe7\x8d\xca\x78\xfd\xa2\x92\x89\x87\xc4\xc8\xb9\xb4\xdd\xda\xf9\x0a\xad\x9b\xe8\x5c\x1d\x53\xf9\x20\xc2\x70\xac\x2e\xf3\x38\x04\x98\x4d\x1c\x63\x03\x76\xc5\xe3\x8b\x5f\x28\xea\x03\xee\x03\x84\x61\x1c\x3f\x68\x6b\xaf\x5e\x69\xaf\x28\x2e\xe0\x2f\xf8\x17\xd7\x52\xe1\x36\x05\xb7\x6e\xd6\x89\x71\xd5\x0b\xc5\x70\x5c\xe2\xc8\x8c\x52\x05\x0e\xb6\xd0\xd0\x6e\xd9\x22\xe9\x24\x06\x43\x11\x58\x42\xe7\x8c\x1d\x19\xa2\xac\x8d\x9e\xa4\x99\x4d\x55\x4a\x88\x9a\x94\x42\x29\xe2\xc4\xfb\xa0\x6b\x39\x03\x14\xd7\x67\xdc\x4a\x98\x69\xc3\x14\x84\x3f\xe9\xa7\x5e\xf0\x76\xd4\x38\x16\x23\xe9\x4b\x94\x89\x2e\x24\x55\x17\xfd\x18\xea\xc4\x40\xcc\x89\xe7\x09\xbf\x6d\xc3\x33\x13\x2a\x84\x27\x54\x0f\xe9\x70\x8b\xd5\x9c\xab\x0a\x55\xd9\x25\xf5\x40\x14\xff\x7c\xb7\x7d\xaf\xad\x21\x97\x3e\xdf\xa7\xfd\x08\x75\x3d\xf8\x46\x3d\x29\x87\x63\x80\x49\x50\x27\x6b\x2f\x05\x77\x05\x54\x36\x23\x1b\x82\x27\xf7\x21\x1d\x89\xe3\x0a\x9d\x2a\xfe\x79\xeb\xb3\x34\xf4\x75\x6c\xae\x38\x07\x89\x41\x10\xee\x46\x26\xf2\xf3\x1b\x37\x00\x85\xd6\xd8\x77\x67\x2b\x14\x34\x93\x00\xdd\xbf\xe0\x79\xbb\xdc\xc7\xbf\xed\x4f\xa9\xc7\x42\xe2\x62\xf6\x23\xf2\x64\xe2\x83\xda\x75\x4d\x8c\xe0\xd4\xf6\x45\x38\xee\x67\x21\x0f\xba\x3c\xa3\x07\x6c\xd9\xa1\xa8\x8c\xfe\x38\xbf\x40\x90\x57\x9d\xd8\x60\xa5\x33\x07\x90\x9f\x77\x28\x52\x91\xc4\x31\x2f\xb9\xe0\xfc\xc5\xf7\x17\x18\x84\x5b\x03\x07\x6c\xad\xf6\xa3\x74\x23\xec\xb8\x6c\x64\x77\xbd\xb1\x08\xf7\xde\x22\xd3\x85\x07\x3c\xe8\x16\x6a\x23\xc1\xd4\x9d\xb7\xe5\x08\xa4\x17\xf9\xac\xfc\x0d\xb5\x4d\xf3\x30\x8a\x3b\x7c\x63\x0a\x07\x22\xfa\xfb\x64\x17\x49\xeb\x49\x5b\xf7\x3e\x07\xe5\xe6\x29\xaf\xcd\x57\x53\x65\x4d\x8c\xfd\x52\x30\xab\x92\x08\xb9\x12\xc7\xc6\x19\xea\x2c\xd6\x02\xc3\xd5\xf1\x70\xc6\x65\x0b\x19\xb1\x0c\x27\x62\x38\xcb\x97\x99\x8f\xae\x0c\x13\x05\xd2\xe7\x49\x2b\x03\x7e\xa1\xe7\x25\x1a\x15\xb8\x5f\x5d\xa0\xbb\xc5\xe5\x3b\x2e\xb3\x88\x83\xa1\x5a\x7f\x63\xc0\xdd\x9c\xe0\x7b\xc3\xb1\x56\xee\x46\x96\xa9\x9b\xc2\x82\x87\x95\x7c\x1a\x51\x6f\xe8\xea\x46\x93\xb6\x30\x13\x1e\xa4\x81\x3b\x2a\x1f\x81\xc0\x7f\x3f\x20\x56\xee\xa8\xae\x50\xe4\x37\x30\xe6\xc1\xef\x73\x8b\xb2\x8f\x80\xd3\x30\xe6\x4b\xfb\x6e\x55\x96\x54\x22\x08\xe6\xda\xa2\x8c\xc0\x83\xc8\xa0\xbc\x6c\xbc\xd3\xb1\x53\xdd\x6a\xcd\x39\x8c\xff\x60\x17\x9b\xa6\x56\xe7\x19\xc9\xb1\x8a\xac\x13\xf9\xf3\x44\x69\x65\x02\x8e\x68\x7c\xdf\x7f\x4d\xf6\xfb\x74\xf4\x81\x97\xd0\x67\x92\x9b\xab\xff\xd2\xee\x70\xcc\xcf\x8c\xb4\x67\xd4\x8f\xb3\x92\x5d\x24\x1a\x0f\x6e\x5f\x20\xd7\x74\xe5\xb9\xb7\xc6\xe8\xd1\x33\x87\x39\xfb\x08\x18\x4c\xb1\x1d\x14\x32\x77\x6b\x14\xda\x14\x62\xe2\x29\x4b\xe0\xac\xc1\x62\x6b\xf7\x2a\x1a\xaa\x45\x76\x94\x36\x95\xc4\xa7\x0d\x64\xe1\x80\x3d\x5d\xe5\x1b\x76\xe6\x74\x92\x15\xa4\xfa\xac\x27\xc6\x65\x91\x9a\xe1\x18\x32\x14\xd3\x68\xfc\xd6\x4d\xda\x4b\xa0\xf4\xab\xcd\x16\x73\x2e\x77\x92\x02\x3c\xfd\xf0\x91\x62\xc4\xc9\x05\x26\x5e\xfe\xef\xe0\xa5\xb2\xf0\xad\x6d\xc1\x95\x0a\x04\x03\xd2\xf3\xc8\x1e\x2e\xe7\xc1\xf4\xa7\xc9\xe4\x71\xd0\xed\x53\x36\x6a\xab\xb8\x3f\xa6\x29\x99\x4a\x1a\x64\x0a\x49\xc3\x87\x4c\x73\x9c\x23\x8c\xf4\x53\x6a\x98\x8d\x93\xdd\xf5\x15\xce\x7b\xbf\xc5\xa1\xc3\x1d\x80\xe9\xea\x2a\xbb\x7e\x7c\x8b\x1f\xef\x94\x13\x44\x8e\xf2\xdf\xaa\x3c\x67\x28\x87\x8e\x1f\xde\xb2\x13\xc7\xbb\x91\xc7\xef\xff\xeb\x41\x1c\xbf\x1e\xa0\xd2\xe8\x9b\x27\x3a\xdf\xd7\x6e\x20\xa5\x19\x6b\xea\xe5\x0d\xe3\x32\x05\xd4\xe7\x8d\xe3\xe1\xb5\x33\x5a\xc4\xe0\x6f\x33\xe4\x76\x0e\xad\xf5\xe7\xc3\xed\x64\xbe\x97\xbd\x48\xfe\x3c\xba\xa6\x68\x5b\xd8\x47\x5b\xe8\x0b\x6b\x1e\xe7\x83\x6f\x67\x45\x65\x8f\x67\x25\x13\xc4\xfd\xca\xed\x27\x8c\xc5\xe0\x88\x62\xd9\xde\x4d\xb7\x59\x5b\x05\x95\x31\xa4\x47\x4c\xb2\x44\xc6\x33\xc5\x4e\x91\xb0\xe7\x9f\xb1\x28\x50\xa1\x40\x8c\x02\xe3\x8c\xd5\x37\x28\x50\x2f\x6c\x04\xcf\x47\xd9\x2e\x92\x7d\x8e\x9f\x27\x03\x69\x6c\x0b\x9d\x4d\xbc\x93\xf1\x50\xe4\x3c\x52\x82\xb8\xd5\x53\x07\x5c\xf7\xb7\xfe\x4f\x77\x1e\xef\x86\x1b\x0a\xac\x2c\xb0\x11\x0f\x98\x56\x11\x9d\xe2\x59\x10\x8d\xa6\xde\xcc\xf4\x60\x50\x36\xe8\x2a\xb3\x44\x2b\xd3\x3a\x07\x43\x51\x35\x47\x9f\xb3\x16\x78\xed\x19\xb3\x9c\x7b\xc7\x84\xf5\x1b\xa9\x39\x41\xc7\x35\xe9\x01\x6e\x49\xc7\x19\x5b\xd8\xd9\x4c\x1a\x78\xf3\xd8\x10\x97\x76\xbb\xa2\x96\x82\x87\x80\xe8\x03\x49\x8a\x98\x55\x89\xa9\x43\x6a\xb3\x61\x9e\x97\x0c\x59\x57\xe2\x47\xed\x01\xce\x95\x00\x96\xcf\x30\xcf\xd6\x4e\x7a\xd0\x4e\xe6\x7a\xdd\xf2\xed\x86\xb4\x70\x34\x9f\x05\x3e\xf2\x9d\x75\x3f\xd6\x68\x5e\x79\x43\x99\x6e\x24\x7a\xc1\x88\xd3\x76\x37\x34\x1d\x33\xd9\x33\x79\x90\xe5\x26\x62\xde\xe8\x0f\xdf\xc8\x7b\xa2\xe1\x63\x0e\xd5\x47\x9d\x3b\xb8\x21\x8b\xd2\xbf\xb0\xcc\x94\xbf\xd3\x95\xd9\xee\xf4\xe5\x8f\x82\x72\x61\xaf\x58\xf8\xf9\xc6\xc6\xaf\xb9\xa4\x2b\xf1\x97\x03\xe1\xb9\x0c\xf7\x96\x95\xe3\x4c\x62\x3b\x72\x3d\x43\xa5\x15\xfe\x92\xbf\x28\x2e\x55\x23\x0e\x54\x31\x7c\x7b\x41\xaf\x7a\xce\x6e\x40\xc9\xdf\x7a\x58\xed\x6f\x4d\xac\xda\x5f\xf6\x95\xf3\x9a\x01\x6b\x07\x06\xf9\x69\x9b\x1c\xf4\xc9\x36\xa1\x73\x26\xab\x13\xe5\xd5\x75\x91\xe6\xc4\xb6\xef\xb3\x3c\x46\xe6\x9c\xa1\xfd\x12\xe9\x39\x13\x75\xce\xd9\xb1\xd6\xd7\xed\x27\x4b\x00\x32\xcc\x0e\x37\x28\xb3\x21\x63\x74\xd6\x15\xb4\x81\xa9\x3b\x5d\xc5\x88\x93\x16\x4a\x60\x3f\x5f\x00\x03\xc1\x9d\x3a\x89\x6e\xe9\xa1\x61\x79\x7f\x5a\x7d\x3f\x13\xfb\x4b\x1c\x6b\x9d\xbb\x26\xad\x5d\xb5\x37\x47\x7a\xcd\x74\x0b\xc6\x68\xa8\x47\x01\xe5\xd6\xaa\xf0\x5f\x3f\x5e\xf6\x69\x04\xb4\xbf\x77\x42\x95\x80\x35\x7e\x7e\xf8\xd4\x41\x0e\x6e\xce\xec\x9f\x14\xf9\x59\x96\x86\x3d\x3c\xb5\x2b\xe1\x4f\x21\x17\x84\x3f\x6b\xa2\x39\x8a\x7a\x35\xb3\x86\x8f\x31\x98\xe2\xf7\x1e\x18\x56\x01\xbd\xbb\xdc\xe3\xc5\xe1\x98\x1a\xab\x3f\x5f\x22\xbf\xd2\x4f\x29\xb5\x8d\xa7\x0f\xf7\x35\x70\x8b\xd8\x3e\xb8\x29\xcb\x32\x55\x2a\x0e\x08\xf0\xf4\x2c\xf3\xcd\x73\x80\x2b\x7f\x53\x80\xc9\x35\x0e\x96\x7f\x62\x0e\xbf\x7d\x98\x44\x7e\x8e\xc7\x3f\xc7\x4f\x0b\xfc\x8a\x22\x89\xa2\x8f\x15\x3f\xdd\xae\x7b\x41\x66\x4a\x5f\xb7\xc6\xa9\xf5\x7d\xef\x39\xb3\x25\x45\xfe\x8b\xd5\x5d\x5b\xd2\xf2\x6b\xe2\xff\x22\x37\xb6\xf9\xb7\x40\x15\xb6\xcc\xd3\xc8\xaa\x23\xf4\x59\x95\xa0\xb8\x5d\x9a\xe0\xe9\xfd\x4d\xc5\x9a\x6d\xa7\xc7\x6d\x3c\x27\x9c\x7c\x50\x9e\xdf\x6d\xe5\xe3\xad\x17\x1e\x16\xb0\xdd\x25\x05\xd6\x3b\xcd\x72\x7e\x01\x2f\x2b\x1f\x12\x86\xbb\x11\x6a\x7d\xae\x11\x11\xb7\xf7\x04\x0b\x25\x12\xdc\x3e\x06\x98\x12\xaa\x15\x00\xf7\x25\x1b\xf6\x25\x1d\x5e\x80\x2c\xa0\x02\xec\x1e\x6a\xd5\xdd\x09\xcb\xcc\x58\xef\x1e\x55\xfa\xba\x73\x14\xf7\x88\xdd\x70\x14\x77\xed\xba\x07\x8d\xda\x05\x94\x95\xda\xed\xc5\xb9\x7f\xc6\x04\x1d\x11\x7c\xb6\x62\x94\xd8\x79\xca\xe1\x98\x84\xab\x03\x8e\xc1\xd1\x21\xf5\xb5\xd4\xd1\x54\x57\x9b\x83\x65\xdf\xf7\x73\xff\x79\xbf\x17\xeb\x46\xbf\x80\x90\x18\xb1\xfa\xeb\x7c\xb8\xd0\x99\xf8\x40\x25\x36\x94\x7d\xd2\xf1\x4d\x52\xd4\xc6\xb1\x60\x75\xa2\xa4\x4f\x3f\x80\xce\x61\x48\x9e\x62\x2c\xa3\xb1\x13\xf4\xe3\x1b\xc2\x84\x29\x6b\xfe\x7c\x0c\x2d\xb6\x68\x77\x42\x15\xd6\xc0\x37\xf7\x33\x3b\xb3\xb9\x6e\x45\x72\x15\xba\xf6\xf8\xc1\x2f\xb6\xf4\x5a\xef\x73\xd8\xa1\x65\x1d\x51\x08\xc3\x59\xc1\x0b\x5c\xd0\xa4\x4d\x92\x74\x31\x99\x6c\x03\x09\x8c\xdf\x10\xaa\xf0\x3d\xd0\xcf\x81\xac\x30\x49\x03\x22\xdf\xc0\x2a\x0c\x31\x98\x54\x43\xb9\x25\xbf\xf8\x03\xc8\x7c\x37\xf7\xc4\x39\xbe\x2e\x4e\x1d\xce\xdc\x11\xb9\x5d\x48\xe3\x6f\x80\xb3\x66\x84\x5e\x70\x42\xf7\xc7\xbf\x31\x5e\x74\xfb\x8b\x8f\xaf\x13\xa2\xb3\xd8\xba\xae\x83\xd5\x8e\xc0\x15\x9e\x96\x51\xf3\x6c\x8b\xf5\x9d\x08\x56\x9e\xfb\x55\x5c\x10\x69\xeb\x98\x4b\xe5\x64\xed\xe9\x1f\x4f\x61\xea\x9f\xa0\x95\x5c\x3d\x25\x72\xd2\xb9\xc6\x92\x93\x82\x1b\x65\xef\x7c\x65\x43\xbb\x78\x02\x52\x1c\x3b\xdb\xcc\x8a\xd2\xdc\xe4\xa4\x45\x61\x00\x59\x62\x8f\x79\xeb\x03\x6a\xb2\x56\x53\xc5\xf2\x7d\x21\xf4\xad\x27\xd1\xb2\xa5\x2f\x4e\x41\x59\xa1\x75\x4f\x6a\xcb\xe3\x37\x8f\x8e\x58\x63\xc0\x9f\x15\xec\x45\x95\xb6\xd8\xe0\x94\xb1\xf1\x00\x98\x57\x36\x4a\x50\x0b\x71\xae\xa3\x05\x3c\x09\xcf\x4f\x74\x3c\xa9\xf5\xd2\x1e\xd6\x60\xc0\x9b\x90\x7d\x25\x3f\x24\x68\xb3\xbd\x13\xa2\x00\x33\x70\xc1\xa8\xd2\xf0\x81\x09\xe7\xab\xf3\xcd\x7f\x7c\xfb\x62\x78\x3f\xe7\x68\x8f\x29\x4c\xe5\x80\xa0\x0b\xce\x36\xa8\x17\x68\x52\x70\x32\x2c\x4b\x63\x86\xcf\x4b\x67\x7e\xc3\x09\x10\x30\xe4\xc6\x18\x2f\xbb\x35\xfa\x73\x87\x54\x6b\xef\x2c\x46\xc6\xa6\x84\x71\xf0\x2c\xb8\xe5\x69\xa9\x42\xe8\xe7\x5d\x5d\xf3\xcd\x40\x26\x7e\x41\xd0\x93\x6d\x6c\x81\xf7\x80\xa9\x37\x79\x0d\x97\x66\x3f\x4e\x56\xfe\x8e\xeb\xcf\xed\x81\x1d\x2d\x34\xdd\x00\xd0\xf7\xc9\xe2\x40\x7e\xba\x24\x4b\x89\x94\x30\x70\xd2\xa9\x69\x0d\x8f\x14\xf8\xe1\xb2\xc3\xf8\x16\xbd\xe9\x06\x00\xa9\xad\x05\x4f\xd5\x10\x7f\x9f\x9e\x2e\x2b\xf3\x34\x50\x59\xa5\xf5\xa2\x9f\x03\xd4\xde\xe7\xa1\xe6\xda\xe0\xdb\xc0\xa6\xba\xbb\x45\xd3\x50\xe6\x62\xcc\x35\x07\xcb\x31\x15\xc1\x29\x3d\x11\xec\x04\xd5\xf0\x70\x27\x50\x72\x5b\x00\x9b\x47\x65\x7b\x5e\xbf\x29\x50\x60\x4f\x70\x4a\xa9\x4d\x40\x76\xd0\x52\xf1\xfa\x56\xeb\x92\x3b\xc7\x0b\xfd\x1e\xac\x23\xf1\xe8\xda\x23\x64\x01\x40\x2d\x27\x68\x4d\x20\x5e\xd7\xa7\x98\x45\xe3\x21\x88\xcc\x48\x8c\x96\x8a\x38\xcf\xd1\x53\x9d\xbd\xac\x10\xc5\x53\xa0\x18\x2c\xb9\x38\xd0\xcf\x8f\x0f\xa2\x37\x09\x8c\xcc\x68\x11\xc7\x3b\xfa\x31\x47\x12\xc7\xe8\x0f\x89\xe2\x73\x97\x7d\x07\x3f\xa5\x91\xe9\x49\xb7\x87\xc0\x69\x55\x15\x60\xe3\x66\xaa\xf1\x66\x80\xdf\x1a\x23\x59\xe0\x22\x53\xdb\x46\x23\x12\x8d\x46\xda\xf9\x19\x27\xff\x7e\xe6\x34\x0a\x75\x66\xb3\xd0\xf1\xc1\x95\x7d\x78\xd5\xfa\xce\x90\xf4\xf8\x0c\xa4\x57\xb4\x4c\xc2\x1b\x6b\xff\x83\x6f\x45\x56\x45\xa9\x4f\x63\xa8\xeb\x49\x48\x5f\x91\x4c\x44\x9c\x7e\xfd\xe8\x04\xcf\x20\x14\x8a\x0a\x09\xdb\xbb\xac\xcf\x1b\x13\xeb\x49\xaa\xe9\x03\xfd\x14\x07\x96\xe4\x36\xf2\x29\x8e\x3e\x47\x51\x1b\x13\xe7\x2c\x6a\xa7\x48\x27\xfa\xe4\x14\xbc\x13\xa6\x80\xdc\x8c\x4e\x44\xfd\x58\x60\x51\x4a\xa2\xa7\x3a\xe3\xd7\x63\x94\x3a\x60\xea\x1f\xb4\x47\x42\x5b\xed\xf9\x1a\x5f\x42\x9d\x58\x53\x12\x14\xa9\xf9\x85\xd8\xc5\xd6\x6c\x62\x3c\x29\x57\xfa\x7e\x4c\x6f\xa1\x83\x24\x66\x19\xf1\x05\x6d\x92\x06\xd0\x0f\x68\x8c\xc3\x1a\x1e\x88\xc9\xe7\x28\x0f\x56\xf6\xd7\x9d\xd9\x7c\x01\x94\x40\x3a\x04\x87\x5e\x2f\x74\x6e\xd1\x89\x65\x17\x2f\x50\x19\x2e\xd6\xe6\x4a\x53\x5c\xca\xc3\x88\x20\x89\x6c\xf6\x68\x5a\xc8\xc7\x23\xe7\xd0\xbb\x1b\xe8\x30\x6f\xda\x64\x66\x92\xf6\x88\x4c\x37\xf4\xa3\x89\xaa\x31\xb8\x0a\x54\x94\xb6\x7c\x22\x23\x77\x8e\x56\x73\x59\xaf\x9a\x40\xd8\x59\x91\x73\x75\x7f\x1c\x4d\x74\x3c\xfb\x41\x95\x1c\x7c\x2d\xb4\x3a\x50\x9d\x3d\xc0\x84\xdc\x91\xc7\xd0\x2f\xd6\x1a\x17\x62\x5f\x31\x2c\x33\xa5\xb7\xd8\x2a\x4b\xc2\xf1\x87\xa0\x49\x5d\x5b\x57\x12\x1e\xae\xc9\x51\xa7\x86\xec\x31\xe1\xf9\xf1\x2f\x3f\x30\x02\x26\x9b\x8c\xd3\x1e\xb4\xeb\xcd\x8a\x40\x01\xd7\x42\x39\x50\x59\xa3\xbf\xa3\xe0\x21\x8b\x22\x45\x91\x24\x79\xe1\x39\x50\x94\xf6\x91\x6b\x13\x2d\x4c\x40\x63\xc0\x07\x0d\x7f\xcc\x01\x4c\xb7\xc8\x94\x8b\x30\x0c\x7f\x06\xe4\x1a\xeb\x79\x0f\x10\x4a\x13\x29\x41\xab\xec\x2f\x30\xb3\x0f\xf1\xf7\xd2\xc7\x7b\x54\x61\x73\x4c\x8f\x58\xe6\xdd\x46\x40\x4b\x5b\xdc\x04\x7f\x7e\xdd\xdd\x9c\x4c\xd9\x1e\xde\xa9\xcf\xc9\x96\xd3\x02\xa2\xb0\xc8\x6a\xa7\x46\xb8\x0e\x13\x81\x27\xf8\x11\xbf\x73\x33\xd6\x7a\x77\x43\x3f\xfc\x77\x9c\xc3\xc3\xc3\xab\x90\x08\x09\x3c\xd9\x62\x5d\xe4\x16\xdb\x05\x2c\x64\x3d\x47\x7b\x2f\x8a\xb2\xa1\x95\x75\xf2\x67\x0a\x00\x8b\xc1\x7a\x49\x8c\x28\x57\xef\x17\x1a\x7e\x89\x01\xb9\x3c\x5b\xc2\x73\xd9\xc4\xcb\xd0\xd3\x79\xde\xcf\x27\x04\x8e\x16\xe4\x9d\x34\x0e\xda\x75\x97\x72\xed\x01\x22\x0a\xeb\x37\x8f\x45\x9c\xc9\x5c\x1b\x7a\x33\xd2\x00\xce\xf7\x49\x69\xc9\x2f\x5d\xc6\x61\xfc\x23\xd7\x34\x34\xb8\xe3\xe3\x06\x30\x6d\x50\xf9\xbb\xa8\xcd\x26\x5b\x1b\x6a\x16\xf5\x73\x16\xf5\x38\x0e\x83\xe8\xec\xcd\xc1\x6b\x90\x99\x8b\x19\xec\x59\x1d\x48\x68\xe3\x69\xf4\x97\xcf\x17\x1c\xa6\xb1\x8c\x51\x43\x02\x95\x2f\x9f\xf2\xda\x1e\xaf\x9b\xe4\xc2\xa5\x02\x61\x02\xcf\xd2\x29\x70\x4a\x1f\x7f\xee\xd0\xd2\xe2\x70\x02\x22\x56\x01\xf0\x6b\xbd\xa9\x6b\x5c\x83\x75\xaf\x31\x7f\xfd\x32\x50\xa2\x1d\x61\x64\xc5\xb1\x29\x18\x7a\xbe\x3d\xcf\xbd\x8d\x32\x95\xd8\x3c\xd2\x14\xcf\x4f\x0e\xc4\x8e\x16\x5f\x9c\x08\x95\x12\x6e\x47\x50\x42\xfd\xa2\xd7\x30\x8e\x6f\x88\x4e\xb7\x37\x3f\x62\xeb\x1c\xf8\x53\xfe\xf0\xe9\x17\xe9\x1a\x2d\x42\xce\xf5\x9d\x11\x52\x59\x29\xf1\x77\x1e\xfd\xf2\xa8\xde\xc6\xcf\xba\xa0\x00\x8a\xba\xc2\x9c\x20\x1c\x7c\x25\x77\x00\x7d\xab\x1f\xf6\x6a\x8e\xe0\xe3\x65\xba\x8f\x9d\x28\x5e\xe9\xa4\xf2\x01\x2a\x6f\xdb\x15\x92\x5c\xdf\xb7\xfd\xf6\xaf\x2b\x0d\xad\x87\x7c\x18\x1d\x32\xdd\x42\x6e\xe9\x05\x2e\x77\x70\xf8\xa6\xdb\x6c\x8c\x3e\xf2\xb1\x17\x22\x9d\xcb\x6d\x0d\xb4\xa2\xc8\x4b\x00\xea\x7b\x18\x5b\x9e\x7e\x98\x7f\x5f\x1e\x0c\x82\xf7\x7b\xc7\x7a\x7f\x01\x8f\xab\xb4\xed\xa2\x0e\x6f\xba\xe3\x2f\x49\xa2\x56\x5d\xab\xc6\xc9\xf9\x18\x7d\xe5\x15\x68\xcb\xa2\x8d\xb5\xd8\x95\x16\x55\x15\xc5\x0f\x6e\x8c\x2d\x79\xf8\xee\x04\x95\x60\x09\x6e\x85\x32\x90\xd7\xb6\x6d\x67\x05\x7c\x52\x14\x91\x21\x03\xde\xe3\x90\x2e\x97\xde\x3b\xe2\xd0\x7b\xcd\xc7\xb2\x91\xc3\x96\xbf\x2b\xbe\xa3\xa8\x1b\xbe\xf8\xd6\x74\xdb\xe6\xd0\x0f\x28\x7e\x6f\x84\x00\x69\x3f\xbb\xf7\x33\x03\x7d\x90\x46\xde\xfd\xc1\x85\xfa\x1d\xe0\x16\xfe\xd8\xe8\x17\x04\x2b\x94\xa4\x87\xd8\x2e\x70\x4c\x15\x9e\x4f\x9e\xef\xc7\xbe\x3d\x04\x10\xd9\x0b\x19\xf5\xbb\x4f\x01\x18\x49\x12\xf7\xe7\x78\xf0\x42\x3f\x37\x47\xf1\x8f\x3a\x10\x6f\xe0\x76\x23\x14\x45\x7b\x39\x89\xc4\xe6\xb7\xc7\x88\x65\x5d\x0a\x1a\x07\x4a\xda\x96\xbe\x81\xb5\x71\xaa\x7c\xfc\xc6\xcb\x4b\xfd\x5b\x90\x4d\x50\xe9\x21\x00\xe7\x43\xf8\x4d\x10\x9b\x8a\xd0\x13\xf4\x42\xfa\x5e\xeb\x46\x57\x98\x88\x55\x84\x30\x5b\x0f\xb8\x25\x51\x49\x88\xa7\x15\x5d\x38\x95\x3c\xe6\x2d\x6f\x8a\xae\x65\xb3\xa8\xb9\x61\xbf\x59\x01\xdd\x76\x58\x4e\xc8\xb4\x42\x97\xe0\x55\x3d\xf5\x7a\xe5\x48\xf4\x3a\xc7\x57\x15\xfb\x0f\x94\xf3\x82\x91\xd3\x92\x21\x39\x5b\xa4\xcf\x35\x52\xf9\xd3\xe0\x53\xeb\x8e\xe8\xe4\xf7\x8c\x41\x9a\x00\x2d\xba\xa4\xed\x63\xc7\x18\x81\x02\x8c\x22\x3e\x40\xed\xef\xef\xef\xf9\x25\x8d\xe1\xc3\x61\x38\x75\x9a\xe7\x01\xa2\x48\x46\x00\xa4\xb9\x11\x9b\xba\x67\xb6\x70\xe5\x96\x2c\x7e\xa9\xf1\x02\xd0\x8f\x89\xe5\xdc\x14\x3d\xe4\x27\x7e\x6d\x9d\x5e\x71\x02\x2f\x2c\xb4\x4c\xdf\x32\x5b\x57\xb2\x1f\xd0\xf3\xc6\x8f\xb8\xc7\x8d\x08\xdc\x21\x02\xfe\x8c\xe8\xfa\x98\x40\x91\x9f\x77\x67\x35\x64\xb1\xa1\xab\xfa\x05\x30\x82\x24\x49\x74\x9c\xe7\xfd\xbc\xeb\xdb\xd8\xa5\x20\xf4\xa0\x38\x8f\x3a\x91\xad\xcb\xbf\xf9\x22\xee\x0f\xcc\x8f\x3e\x1b\x62\x58\x32\x3f\x0d\xf0\xe5\x21\x1f\xf3\xa7\x75\x34\xcf\x17\xf4\xc5\x79\xb8\xf8\x04\x95\xcd\x03\xc0\x0d\x9c\xe0\xfe\x3d\x69\xa4\x5a\xb2\xf7\xc5\x6e\x37\x97\x4f\xf0\xdb\xe2\x1f\x80\x2a\x0c\xcb\x34\x1f\x82\xaa\x66\x31\x5a\xa8\x08\xbb\xf1\x94\x06\x0c\xc8\xf7\x01\xb2\xda\x1f\x4a\x3f\xe9\x2d\xb7\x64\xb6\xc6\x36\x4f\x3d\x51\xf2\x69\xfd\x67\x8e\x57\x5b\xa4\xc0\xbb\x8a\xd1\x15\x47\x6f\x9a\xea\x54\xb1\x37\xaf\xc4\xe5\x18\x37\x5a\xfb\xf6\x2f\x9e\xeb\x87\x5c\x71\x7c\x06\x73\xb0\xb0\x0d\x4d\xeb\x02\x6e\x8a\x01\xea\xc8\x41\x1d\xec\xaf\x3c\xc8\x0e\xe1\xca\x1d\x18\xfc\xec\x08\x5e\x50\x87\xfc\x8d\xdf\x19\x6a\xcf\x9e\x9e\xbc\xf9\xdd\x3b\x4d\x0f\x8d\x56\x30\x41\xeb\x83\xed\x94\xa2\xc4\xb3\x1b\x77\xce\xed\xba\x93\x12\x7b\x6c\x37\xb7\xa6\xc2\xf4\xdf\x26\x73\x80\x28\xc5\x50\xc8\x2f\xbc\x78\xb8\x2f\x5f\xce\xc3\x0d\xf9\xd0\xc4\x53\xc6\x56\x79\xbd\xe2\xaf\xf7\xbd\xe2\x1b\xe8\x13\xb1\x97\x38\xd4\x18\x43\xbb\xfe\x9c\x24\x8d\x67\x1b\x78\xa0\x4e\x2b\x72\x16\x80\x29\x50\x0e\x94\x36\xc0\xfb\xee\x86\xd2\xa7\x0f\x79\xd2\x2a\xc7\x76\x0c\xbf\x2f\x4a\x92\xf6\x09\x02\x7b\x4e\xdb\xe0\x99\xae\xca\x3e\xde\x15\xa1\x76\x64\xc8\x6b\xd7\x97\x53\xf1\x0d\x01\xcb\xb3\x28\xd0\x8d\x14\xa2\xf2\x85\xf7\x4f\xb4\xd3\x38\x45\xe1\x08\x8a\xe2\xd8\x9d\x03\xc9\xbe\xd3\x50\xe6\xdc\x52\x55\xd0\x38\x01\x10\xd4\x79\xbe\x2f\x04\xa1\xd2\x8f\xd3\x6b\x57\x60\xf3\x75\x20\x9b\xaf\x2f\x8a\x6e\xec\x7a\x7c\x27\x81\x67\x29\xb7\x88\xc6\xb3\x00\xe5\x4e\x45\xf6\x48\x6c\xef\x4c\x1b\x58\x70\xb4\x24\x12\x77\xa9\x03\x04\x5e\xb0\xba\xee\x57\x31\x44\xaa\xb4\xed\x6f\x34\x20\xe0\x8e\xe8\x18\xbd\x46\xbb\xc4\xc3\xf0\x27\xa2\x8f\x75\x25\xdf\x87\xee\xf7\x33\xf6\x8b\x13\xdd\x10\x14\x95\x9e\x17\x79\x05\xb9\x59\x88\x67\x3b\xb5\x06\xb8\x69\x05\x6c\x42\x10\x5a\x45\x10\x04\x31\xf2\xb3\x9e\x34\x8d\xd2\x58\x9e\xeb\x42\x1b\xea\x9f\x1d\x20\x0f\x72\xaa\x8e\x0a\xe0\x99\xda\x31\x53\xeb\x9d\xf2\x9b\x44\xc9\xf7\xbb\xcb\xdf\xfb\xc6\x31\x87\x3a\xe7\xf1\x24\x69\x85\xb0\xbe\x75\x75\x1c\x17\x58\x2d\x8c\x20\x89\xd7\x25\xfd\xce\x26\xaa\xa4\x6c\xb0\x87\x3f\xc1\x63\x13\xf7\xd8\xdd\x67\x93\xc0\x7b\xaa\xb6\x74\x35\x76\x9e\x66\x10\xba\xa1\xdc\xb5\xa7\x70\x03\xdf\x79\x4a\xd2\x06\xd6\xad\x28\x4d\xeb\x12\xae\xba\x22\xa9\x64\xac\x83\x7b\x56\xb3\x1c\xa1\x81\x33\x98\x11\x66\xeb\x74\xa5\xea\x3a\x89\x02\x2f\x4a\x97\x25\x0d\xc3\x26\x4d\x13\x1e\x84\x5b\xfc\x91\x7a\x50\x73\x81\xc5\x71\xff\x66\x57\x3e\xe8\x4e\x58\x81\xe1\xcf\xdb\x0d\x91\xe0\x19\x1f\x27\xf8\x39\x10\xcf\xee\xc7\xec\xd9\xd7\x18\xed\xb0\x24\x1f\xfd\x94\xa4\x2e\xc5\xb0\x7b\x85\x4a\x84\xc2\x18\xfd\xfe\x03\x28\xe2\xf0\x94\xb6\xcc\x01\xce\x56\x82\x65\x74\x52\x62\x00\x44\xdf\x3a\x47\xd7\xb9\x7f\x30\x1a\x04\x34\xa3\xaa\x4e\x4a\x1e\x5e\x10\x4f\xad\x06\x3c\x7e\x79\xf0\x5c\x54\x65\x3b\x48\x19\x1c\x03\x14\x25\x81\x28\x8a\x8e\x72\xe0\x00\x79\xee\x16\xb6\x41\x3c\xab\xde\x16\x56\x66\x16\x46\x54\x5a\x87\x14\x9b\xfd\x03\x17\xed\x39\xbb\x43\xe9\xbf\x67\x7c\x9e\x40\x39\x16\x36\xf8\x02\x3c\x99\xd2\xc0\x30\x44\xaa\x51\x90\x3f\x9e\x3c\x4d\xd3\xe4\x87\x92\x28\x20\xa7\x40\x1a\xbe\x3f\xd9\xb3\x0d\x17\xcc\xe5\x96\x2c\xb7\xe7\x78\x1e\x6f\xf9\xe9\x4a\xc1\x8d\x43\x3a\xe7\x69\x1a\x06\x69\xab\x71\xbd\x12\x0e\x35\xd6\x25\x28\x9b\x24\xb7\xb7\xc3\xd1\xe1\x3c\xd1\x15\x02\x2b\xe0\xdb\xec\x22\xfe\xca\xce\x25\x91\xe4\x89\xa6\x60\x23\xf1\x3e\x8f\xe1\x60\xf3\xf9\x90\x18\x4e\xe2\xf3\x1a\xa3\xdf\x03\x08\x9c\xee\x57\x3f\x13\x6c\xf2\xfd\x16\xef\x2c\x22\xc8\xbc\x77\xdc\xc5\x72\x32\x47\xa3\x9c\x87\xf1\x2c\xa8\x2a\xca\x78\x47\xbd\xec\x2b\xbd\x7b\xbf\x57\x0b\xdf\x2f\x70\x53\x33\x46\xdc\x0c\x32\x0a\xa4\x24\x3a\x33\x70\x82\x60\x25\xa3\xcf\x95\xd9\x04\x37\xe3\x24\xf5\x66\xbd\x3f\xbf\x5c\xd1\x2d\xdc\x3d\x95\x72\x79\x00\x34\x9b\x7f\x6f\x6b\x78\xd3\xe3\xb4\xe3\x61\xb8\x01\x8c\x44\x09\x55\xa5\x0a\x92\xac\x4e\xea\x97\xb9\x00\x05\x1a\xc7\x63\x07\x37\xc7\xee\x6f\xcf\x06\xca\x35\x21\x87\xe8\x03\x55\xa6\x4c\xb1\x1e\x45\xd1\xe8\xc3\x3f\x58\x84\xdb\x33\x73\xf2\x37\x49\xe1\x9e\x05\x1e\xbf\xcf\xfa\xc5\xcc\xf1\xb5\xd8\xb7\x24\x51\x0f\x00\x28\xfb\x48\x46\xe0\x0c\x4f\x12\xf7\xc2\x30\x12\x07\xae\xe0\x5c\xa8\x92\x7f\x7b\xb6\xfd\x7c\x59\x24\x2d\x72\x65\xc0\x34\x0b\x9f\x7f\x79\xf6\x32\x2c\xbb\x88\x4a\xac\x1f\xd0\xbf\x3c\x7a\xbb\x78\x5f\x67\x44\x98\xa6\xa8\xd4\x22\xab\xf1\x45\x7d\x94\xc8\xf4\x19\xd4\xb8\x5c\xbd\x63\x23\x6e\x55\xe7\x1c\xde\x18\xdc\xfb\xb0\x24\x0f\x72\xbb\x0d\x27\x71\x79\x26\xa9\xc0\x3c\x38\x85\x2c\x2e\x47\xc4\x03\xcb\xc3\x89\x7f\x83\xa4\xd6\x7a\x42\xfd\x0b\x82\x40\xb2\xf1\x31\xc2\x4e\xca\xf9\x39\x7e\x6d\xcd\xf3\xa7\xaa\x58\x55\x20\x4f\xd2\x20\x95\x7f\x9b\xeb\x6e\xe4\x5a\x11\x80\x2f\x8d\xe3\x04\x00\xe3\x30\xfc\x92\xcf\xb6\x83\x7e\xd4\x66\xf7\xbe\x85\x1f\x8c\x38\xd7\xb9\x83\xb2\x20\xfc\x44\x81\xe2\x27\x33\x52\xcd\x5f\x4e\xff\xbd\x1b\x53\xd4\x3f\x73\xf8\xf8\x9b\x3d\xbd\xf4\xd8\xd0\xa4\x6a\x75\x13\xbe\x6f\x74\xeb\x9e\xdf\x51\xc8\x69\x50\x5e\xe0\x4f\x55\x16\xb6\x79\x8e\xe8\xb2\x6c\x99\xc0\x76\xcf\x7c\xb3\x82\x88\x91\xa6\x2c\xdb\xb2\x00\xd9\xc9\x67\x47\xc8\xaa\xca\x8b\x73\xff\x82\xe4\x13\xc2\xc1\x08\xb5\x77\x75\xa3\x72\x2e\x11\x00\x58\x6d\xe5\xbe\x5f\x28\xca\x21\x53\x54\x15\xf8\x16\xe9\x20\x30\x1f\xfb\x59\x5a\x9f\x79\xc6\x8f\x6c\xf8\xf2\x71\x43\xe8\x8b\x6b\x06\x67\x75\x92\x0a\x0d\x10\x58\x0e\x14\xe3\x78\xa1\xcf\xa6\x83\x20\x59\x82\x37\xce\x31\xd8\x77\x3c\xfb\x39\xcd\x5d\x32\x70\x93\x33\xd6\xc2\x83\x41\x1e\xbc\x45\x92\x91\x66\x8a\x71\xa5\x6b\x26\x18\xb4\xd2\xd1\xc0\xa4\xeb\xc4\xb9\x13\xb3\xbf\xeb\x25\x1b\xb5\x51\x3e\xe6\x51\x22\x69\xaa\xe8\xcb\xde\x8a\xc2\x9a\x38\x06\x56\x81\x48\x01\x19\x08\x12\x20\x1a\x58\x05\xaa\x48\x00\x0d\x64\x29\x64\x6d\xa9\xe2\x44\xe6\x17\xbb\x8f\xf3\x7c\x08\x77\xf4\x7b\x80\x34\xbf\x2d\x88\x3e\x8a\x93\x8c\xc8\xbb\xef\xe7\x0c\x0c\xc4\x37\xb7\x64\xbe\x05\x41\x82\xb3\x0c\x45\x60\x41\x2c\x66\xb1\x2c\x8f\xbd\xa6\xb2\x87\x13\x7c\x3d\xef\x86\x0a\x39\xe9\x81\xe3\xf8\x20\xa5\x0c\xc6\x15\x58\xab\x19\x9d\xe9\x7d\x03\x8c\xac\x2c\x2f\xd3\xe4\xbb\x3a\x04\x3d\xce\xe2\x4e\xdf\x14\xc5\x36\xbe\x05\x01\xe6\xce\x1c\x8f\x53\x70\x1a\xf0\x1b\xc4\x2a\x8e\xa4\xb0\x66\x8f\x69\xee\xd3\xa6\x96\x39\x5a\x3f\x7d\x03\x81\x25\x50\x55\x97\x10\x37\xed\x47\x79\x8e\x60\x75\xb5\xb7\x18\x06\xc8\x22\x4d\x10\x9c\x6e\x90\xa2\x34\x99\x94\xdf\x2f\x02\x87\xd5\x0c\xfc\xec\x5b\x88\x1d\x04\x6c\xa8\x3a\xed\x91\x90\x28\x1b\xc3\x8d\x45\x82\x7c\x98\xa0\xad\xd6\xae\x4e\x9e\xbf\x6f\x9a\xe6\x75\x20\x4a\x50\xbe\x7b\x54\x99\xac\xd0\x4f\x75\xc6\x55\x15\xc0\x70\x88\x56\x34\x78\x86\xe4\x79\x13\xfb\xaf\x37\x19\x20\x64\x71\xae\xc4\xb1\x3a\x44\x23\xee\xa8\x3b\x4b\x3f\xc0\xd2\x4a\x21\xab\x05\x92\x62\x92\x14\x89\x7e\x71\x24\x2a\xcd\x6f\x82\x24\x5c\x39\x2a\x04\x18\x00\x20\x7d\xbf\x3b\x4d\x3f\x04\x46\xee\xc8\x2d\x99\x1b\xe5\xcb\x18\x38\x80\x36\x88\xce\x74\xbf\x1f\xf6\x87\x05\x6d\x42\x77\x9b\x1f\xe7\x07\xac\x7e\x8d\x33\xab\xf2\x5e\xe0\xc5\x72\x2f\x0a\x79\x10\x6f\xf6\x33\xed\x0f\x75\x96\xed\xd6\xb2\x48\x9a\xa6\x71\xfb\x7e\x61\x90\xc2\x20\x34\x30\x26\x63\xd2\x41\xdb\x06\x41\xea\xdb\xef\x67\x09\x90\x69\x0a\x01\x95\x3d\x23\x79\x4a\xdc\xfb\xb7\x3d\x9b\x52\x5b\x54\xab\xaf\xfb\x64\xa1\x6b\xa8\xc8\x48\x4f\xe6\xcd\x7c\xff\x2c\x4a\x06\xb7\x1f\x6f\xca\xc5\xa3\xe4\x6b\x22\x51\xbe\xa1\x8e\x6d\xf3\x72\x20\x86\x2e\xed\x53\x5c\xb8\xb0\x1e\x29\xdf\x2d\xe0\x5a\x14\xa0\x0c\x53\xe4\xee\x0c\xa0\xe8\xcf\x27\xb2\x95\xf6\x18\xab\xaa\x72\x0b\xb6\x15\x44\xdd\xa3\x36\xff\xfb\x82\xc0\x6f\xfe\xb1\xa8\x38\xdd\xd7\xbf\x4e\x07\x58\x58\x08\xd3\x38\x5e\x10\x24\x89\xf4\x6b\x76\x9f\x1a\xf6\x1a\x94\xfd\x9b\x1d\x3b\x09\x72\xc6\x41\x60\x7c\x0f\x70\xb8\x87\xef\x00\xa9\x64\x71\x00\x00\x58\xa4\x9f\x11\x05\x8f\xc3\x27\xe0\x98\x2f\x76\x94\x75\xf9\x28\xa5\x00\x56\x10\x93\x13\xc1\x8a\xba\x7d\x8e\x9f\xd4\x1d\x9c\x34\x19\xd2\xde\xf7\xef\xab\xd7\xc3\x07\xf2\x0c\x6f\xff\xcd\xa2\xce\x6e\x98\x38\x33\x0e\x68\x73\xb2\x1d\x31\xc9\x5e\xd3\x2a\x7a\x80\x02\x9e\x92\x87\xfc\x30\x84\x91\x7e\x61\x8c\xa6\x00\xda\x75\x0e\x79\x7a\x90\x22\xdf\xa2\x50\xfc\xcc\x2f\x00\xa2\x3c\x71\xbc\x9e\xa2\x63\x95\x4e\x34\xbd\x44\xea\xce\x8f\x9b\x15\x1c\xa4\x2b\x9b\xb2\x7b\x15\x56\xe2\xe8\x10\x54\x7c\x32\x60\x6f\xcf\x6a\x8c\xd1\x2f\x4a\x3f\xbf\x9c\x2e\xe5\xc9\x93\xaa\x16\x0c\x1a\xaf\x33\xcc\x9b\xcd\xdd\x05\xcb\x89\x44\x6e\x57\xaf\xaf\x8f\x4c\xf7\xe2\x79\x6d\x43\x5d\x7e\x06\x5b\x5b\x29\xe6\xe2\x23\x05\x82\xe3\xb2\x60\xe4\x29\x24\xe2\x7f\x37\x58\x4d\x6a\x41\x3d\xd1\x19\xac\x39\x36\x05\x6d\x1a\x54\x75\x9d\x86\xa9\x0b\x43\x3f\x54\xdc\x2d\xe3\xaf\xfe\xa1\xb3\xfa\xb1\xb7\x2b\x77\x5f\x14\x3f\xf6\x13\xfd\x79\xa9\x7e\x16\x78\x48\xb3\x0c\x21\x4d\x92\x06\x10\x14\xfd\x66\x41\x18\x45\xe9\xc8\xd2\x15\x55\xc8\x8f\x74\x27\x47\x74\x92\x6e\xde\xde\xbe\x70\x54\x1f\x2e\xa7\x63\x5d\x24\x9e\xdf\xf9\xf2\xc5\x93\x9d\x20\xe1\x97\xc4\xc9\x02\xeb\xde\x34\xfe\x6c\x6e\x12\x4b\xea\x70\x17\x43\x54\x65\xcb\x42\xd0\xd6\x35\x90\xf6\xc0\x8f\xfe\x67\x2d\xf5\xf2\xbe\x99\xef\x37\x32\x7f\x98\x2d\x29\xef\x68\x75\x56\x38\x08\x16\xb9\xb0\xe0\xc2\xfb\x6b\x12\x6b\x77\x76\x94\x8b\xd4\x24\x47\xfb\xa5\xee\xf4\x73\x00\xe5\xe9\x7c\x39\xc6\xa4\xac\x00\x06\x59\x90\xe2\x01\xe6\xad\x87\x11\x8f\x63\x0c\xea\x1f\xb3\x43\x0c\xa8\x78\x6c\xc8\xf1\x94\x7d\xd4\xc5\x76\xde\x8f\x43\x96\xbf\xcd\x84\xaa\x15\x30\xf3\xea\xd3\x1d\x70\x13\x4d\xd1\x45\x3a\xc8\x85\x3d\xec\x04\x63\x77\x18\x0b\x19\x31\xcd\xd8\x3c\xf7\xad\x68\x31\x3a\xcb\xdf\x4f\xcd\x55\x9e\x10\x32\x59\xa8\xd4\x1c\xa7\x24\x4a\x03\x92\x03\xa2\x61\x71\x34\x81\x20\x36\x1f\x1f\x35\x14\x9c\xe5\x41\xf6\x33\xfb\xe5\x6d\x18\x6e\x49\x95\x0a\x20\x5a\x47\x3d\x48\x4e\x15\x23\x67\x68\x17\x69\xb6\x00\x06\x24\x9f\xcf\xf8\xc2\x7d\x58\x51\xd5\x48\x4b\xe7\xc6\x47\x10\x51\xfd\x95\x67\xb4\x2f\x48\x51\xe2\x55\xc5\xf3\x30\x5d\x65\x44\xe0\x44\x81\xda\xa0\x0d\x41\xf8\x0f\x79\xff\xae\xd8\xb1\x6e\xa4\xfc\x22\x43\x01\xd2\xb8\x50\xa0\x70\xe1\x07\x02\xe3\xde\x80\xca\x60\x72\x3a\x58\x32\x3f\x70\x88\xb2\xe2\x06\x7d\xae\x68\xf5\xaa\xe9\x5f\x18\x86\xbc\x21\x4e\xc6\x2b\x4e\x4f\xfa\xbd\x14\x1c\xcc\x19\x22\xf4\xf9\x31\x11\x34\x6f\xb8\xae\xa9\x32\xd1\x21\xf4\x30\x8e\x4f\xb4\x1c\x0b\x5a\x1c\xc9\x3c\xf9\x71\xc3\x6d\xfb\x34\xc8\x9b\xa0\xc0\x4b\x08\x0c\xcf\x51\xa6\x75\xca\xec\x94\x92\xdb\xee\x86\x9f\xb3\x6a\x70\xa3\x5f\x78\xc3\x63\xae\xac\x03\x86\x10\x23\x47\xf9\x43\x0f\x35\xe3\x01\x82\xbc\xd1\x3e\x49\x61\x82\x69\x83\x00\x7d\x06\x68\xc4\xa1\x37\xd5\x9d\xc4\x63\xa5\x45\x88\xb8\x6f\x85\x5f\xe7\xfe\xd4\x2d\x07\x69\x5b\x5f\x5c\xad\xe6\x18\x13\xe9\x24\x68\xaa\xb2\x02\x35\x95\x50\xfb\x89\xc4\x8d\x84\xbd\xc6\x52\x97\x05\x0d\xba\x1d\x56\xc0\x58\xb0\xef\x98\x5f\x7a\xfb\x81\x9b\x39\x0a\x1a\xb9\x3c\x86\x58\xf7\x8b\xaa\xaa\xb9\xe0\xa4\xa2\x7d\x73\xa6\x38\x9c\x2a\x95\x38\x03\x8a\x02\xcf\x96\xa7\x69\xa0\xa2\xdc\xc8\xb6\x12\x92\x04\x2b\xe3\xfc\xeb\x79\xae\x64\x4e\x71\x8f\x05\x2a\xc1\x46\xc7\x13\xec\xe7\xfd\x40\xd7\x7d\xe4\x81\x94\xc0\x8e\xb7\x3a\x41\x10\x89\x90\xdf\x12\xdf\x41\x81\x38\xd3\xcc\x63\x9c\x4c\xce\x48\x21\x6c\xef\x55\x7c\xee\x69\x29\x47\x97\x72\xcc\xb7\xa3\xaf\x1f\x0f\x01\xa6\x90\xcf\xfd\xb2\x6d\x7c\xf4\xd1\x16\xd7\xf7\x3c\xf9\xc2\xaf\x7f\x42\x1a\xe4\xe7\x33\x82\x5f\xba\x28\x6f\x91\x17\x4a\x8b\x4c\xcf\x13\x88\x86\x81\x08\x1d\xe7\xb1\x45\x14\x34\xa8\xb8\x7b\xa4\x0d\x99\xaa\xe8\x18\x68\x57\xa6\xf4\x1b\x62\x8c\xdb\x32\x67\x9f\x34\xd2\x3e\xc2\x48\x5e\xe5\xa6\x18\xfa\x90\xf4\x0e\x23\x0f\x81\x7e\xec\x13\x82\xf6\xcf\x71\x57\x1d\xb5\xa1\x38\x50\x95\x41\x01\x20\xdd\xe6\xb2\x9c\xe0\x13\xc5\x8e\x36\x10\x00\x85\xb7\xd3\x51\xaf\x44\xaa\x46\x28\x7f\x5d\x75\x0d\x30\xa1\x0c\x66\xa7\xc5\x6d\xb2\x36\xa1\x33\xdb\x51\x50\xb5\x6d\x99\x08\x44\xbb\x8b\x48\xa0\xa6\xef\x27\x8c\xed\x2a\xfe\xa1\x9e\x29\x0e\xe2\xa8\x0f\x81\xe7\xe7\xb8\x27\x47\x7e\xf5\x28\xdb\x23\x25\x01\x4d\xeb\x8c\xd6\xf5\x9c\x81\x66\x7b\x56\x82\xd7\x14\x1e\xcf\xf6\xe3\x05\x46\x1a\x1d\x29\x1c\xa5\xeb\x67\xf9\x52\x87\xb9\x7f\x7e\xd0\x41\x7c\x9e\x23\x48\x62\x37\xc4\xd4\xb5\x3e\x94\x19\x0f\xd1\x76\x05\x52\xf7\x4b\x0b\xab\x1a\xa7\xf5\x62\x25\xad\x04\x92\x73\xa4\x95\xc5\x98\x10\x63\x6a\x8d\xbe\x30\x66\xef\x7c\x9a\xf8\xb4\x8f\xef\x9d\xde\xf5\xaf\x36\xe7\x9b\xf4\xeb\x46\x3a\x74\xc6\x07\x02\x80\xd4\x8b\x96\x60\x88\x41\xb7\x30\xd9\x32\x06\x8e\xbd\x42\xd2\x18\xb1\x8d\x15\x6f\x7b\xf3\x78\xc1\x4a\xb7\xb9\x86\xc5\x6d\x73\xaf\x6e\x5f\x2e\x79\xad\x8b\xb1\x85\x86\x94\x79\x46\x1a\xc7\x5f\xcd\x9b\x5b\x60\x41\xbf\x0e\x6a\x12\x05\xe5\xd3\x9f\x3d\xf9\x2c\x72\x19\xc5\x54\x84\x9c\x89\xee\x54\xc0\xf0\x68\x9d\x2b\x4c\x06\x2b\xcf\x82\x45\x91\xe4\x13\xa8\x86\x21\x8c\xf3\xdc\x65\xfa\xee\x0d\xdf\xf0\x68\x8b\xf2\x95\xbf\xa5\xa1\xc4\x96\x64\x01\x9f\xea\xc9\xcb\xb2\x3d\xf1\x87\x80\xa0\xfd\xb6\xec\x33\x3f\x9b\xc8\xff\x9e\x72\x65\x8f\xfb\x7e\x9e\x11\x5a\x20\x6b\x94\xe4\x5f\xd1\xe2\xb5\x07\xb3\xe6\xf2\x07\xbc\xc9\x32\xfa\x4e\x6d\x9e\x57\x1f\x04\xb4\x8f\x35\xb0\x4a\xf4\x73\x82\xa7\x64\x93\xdf\x75\x0d\x8b\x32\xed\x6e\xb0\x02\x8a\x7e\xdc\x69\x98\x7a\x08\xa2\x38\x5e\x3e\x86\x60\x27\x6e\x0f\x49\xc1\x6d\x8b\x2e\x7f\x08\x21\x14\xe7\x89\xac\x10\x39\x94\xf8\x3b\xb4\x8d\x8e\xcb\xea\x62\x0b\xb2\xb7\xca\xd4\x5f\xaa\x78\x51\x12\x45\xcf\xd3\x8b\x4f\xcc\xe5\x94\xc0\xe5\x5d\x16\x38\xe3\x13\x2d\x2c\x00\xa3\x7e\x3d\xa7\x62\xc5\xa9\x9a\x96\x14\x2b\x66\xa3\x72\x44\x97\x15\x56\x6d\x7f\x19\x0e\x53\x8b\x7d\x82\xe4\x79\x46\x1a\x04\x1c\xec\xc7\xea\x3c\x21\x19\x06\xa9\x1a\x72\x1f\x23\x84\x01\x6a\xb3\xcd\x8b\xa8\xe3\x2d\xc2\x6c\x0a\x98\x9e\xb2\x04\x4d\x81\x03\x39\x7b\x72\x30\x67\xa1\xa2\x4f\xd5\x07\x35\xf7\xb2\xa6\x6a\x05\xf2\xda\x4f\x1e\x91\x3e\xbe\x25\xa1\xd8\x83\x9f\xd0\xa1\x34\x00\x05\x20\x63\x52\x26\x16\x4e\xbb\x61\x8a\xe5\x86\x69\x87\xb0\xf0\x29\x69\xa4\xe8\x33\xac\xec\x7c\xba\x41\x8b\x38\xa0\xa9\xaa\x3d\x2b\xf0\x0c\x02\xbc\xcf\xf8\x77\xb9\x51\xf4\x7b\x7f\x62\xd3\xd1\x5e\x3c\xab\x4e\xc3\x34\x89\x0f\x35\x2c\x79\x60\x9d\x9f\xf0\x97\x30\x51\x42\xbe\xeb\x1e\x4c\x93\x1b\x20\x80\x72\x8d\xc9\x32\x39\xbf\x28\x8d\x63\x3a\x49\x22\x8f\x7b\x61\x3e\x2f\x80\xa3\xb0\x66\x5f\xc4\xf9\xe8\x19\x94\x2c\x83\x12\x21\x66\x4a\x1f\xf3\xba\x76\xe3\xe3\xe1\x35\x54\x86\xaa\x1b\xa4\x92\x22\xc1\xef\x4b\xe5\x25\xb6\x0a\xee\x42\x1f\xe0\xa7\x78\x76\x2b\x6b\x53\xe3\x13\xa0\x26\x4d\x82\xe4\xe9\xa0\x2b\x8c\x54\x4d\xf6\x50\x87\x96\x27\x82\xa5\x1d\x2d\xb6\xbb\xf2\xbc\x38\xc1\x93\x60\xac\xe8\x7a\x03\x12\xac\x2a\x36\x68\x86\x3a\x78\x67\xc2\xf3\x18\xa5\x18\xf6\x1a\xb6\x40\x2c\xaa\x24\x5d\x7e\xe4\x6d\xfb\x44\x4a\x14\xd3\xe6\x88\x5a\xa0\x95\xc2\x6e\xec\x1e\x90\x4b\x39\x4a\x88\x60\x13\xae\x45\x2d\x5c\x79\x47\x78\x50\x59\x83\x39\x06\xde\x1b\xe9\x57\xc9\x46\xbb\xb3\x5a\x7a\x84\x48\x66\x3f\x47\xd6\x9b\x3f\x6b\xe3\xf9\xbd\x87\x2f\x06\x4a\x62\xf0\xcc\x91\xc3\x84\x03\x14\x19\xec\x30\x03\x56\x02\x09\xe3\xd6\xf9\x4c\x64\x38\xda\x1a\x27\x2b\x2f\x99\xdd\xfb\x8b\x7f\x85\x65\x0b\x71\x71\x5a\xe0\xec\x38\x0e\x3b\x42\x30\x9c\x2e\x9c\xf7\xcd\xe5\x6f\xe5\x63\xeb\x15\x01\xfc\x66\xb3\xa4\xad\x5c\x20\x08\xaa\xc3\x38\x02\xe4\x42\x6c\xeb\x13\x98\x7e\x08\x17\x28\x8d\xe1\x00\x34\xae\x83\x66\x5b\xe8\x8b\xfd\x72\xed\xaa\x02\x49\x1d\xff\x0b\x2f\xbc\x05\xa5\xea\x58\x25\x71\x3e\x82\x55\xfe\x70\x9d\xdf\x3e\x86\xc6\xf5\x35\x07\xf9\x10\x4e\xe5\x20\x17\x02\x1e\x5f\xca\xc8\x32\xb9\xe0\xde\x5f\x7e\x47\x92\x24\x26\xef\xa5\xe0\x3d\x47\xf5\xda\x15\x39\x25\x20\x88\xde\x2f\x78\xdb\x2a\x1d\xea\xe8\xab\xf5\x5a\x97\x80\x1f\x53\x4e\x8f\x25\xe8\x2d\x90\xff\x61\xa1\x51\xfa\x11\x23\xdb\x1b\x83\xa5\x97\xbe\x37\x51\xc4\xe7\x46\x95\x95\xd2\xf5\xbc\xc5\x91\xa6\x5f\xa0\x31\x6a\xfb\x28\x49\x20\x09\x56\x04\xfb\x71\x3b\x0c\x88\x3f\x74\x1e\x80\x07\x5f\x9f\xe4\xa0\x14\x74\x79\x7f\x41\xb8\x80\xb2\x8a\xc8\xd1\x33\x06\x91\xea\x5c\x2d\xab\x7d\xd6\x0d\xac\x96\x52\x26\x6b\x9c\xf5\xba\x50\x74\x85\x90\xe5\xb9\x7b\x63\x7d\x81\xd1\xe2\x33\x8e\x46\xcb\xdc\x3f\x07\xd9\x7f\xd4\xf9\x2e\x49\xe6\x16\x98\xc0\x65\x59\xd6\xa9\x45\x76\xa8\x85\xbf\x04\xb2\xbe\x2f\x1d\xc5\x6e\xd5\xbc\xc6\x43\x1b\x40\x45\x9f\xf6\x27\x8d\xdd\x04\x2c\x00\x72\x1c\xd7\xfe\x26\xcf\xa1\x84\x68\xef\xdc\xa0\x46\x40\x65\x88\x85\x77\x2e\x0d\xa9\x92\x02\xab\xef\x37\x7d\x1e\xa6\x7b\x6b\x48\x9a\x50\x1e\x8b\x9a\x25\x9b\xeb\x44\x22\xa3\x6b\xfb\x64\xf7\xfe\x75\x1e\x63\x7c\x49\xf3\x8b\x3a\x2f\x40\x8b\xc9\xa1\xed\xf8\xf5\x4c\x7c\xd6\x4d\x42\x7c\xb4\x48\x1c\x9a\x91\x8c\xb8\xe3\x79\x9c\x84\x77\xb9\x4e\x78\x13\xb7\xad\x56\xd3\xdb\x6c\x53\xa9\x71\x60\xf1\x84\x4a\xfa\xa4\x16\x59\xf3\x78\xbb\xec\x76\x3e\xed\x6b\xe6\x7b\xbd\xb7\x91\x16\x7a\xb6\x80\x2d\x8f\x2f\x8e\xca\x8f\xfd\x88\x00\x6e\x58\x72\xc1\x09\x24\xd9\xbf\x00\xf9\x89\x17\x53\x42\x3f\x34\xcc\x69\x24\x79\x81\x27\x4a\x12\xa1\x35\xc2\x07\xad\x79\x07\x4a\x95\xe2\x9c\x0d\x2b\x70\xa2\x84\x25\x47\x32\xa1\x28\x32\xe5\x17\x40\xb2\x7d\xb4\x4d\xf3\x02\x72\x58\x0d\x80\x71\x1c\x23\x14\x59\x87\x61\x33\xa3\x9d\x5e\x86\x7a\x14\x96\x51\xc4\xf6\x2d\xcf\x68\xe0\xdd\xe3\x36\xd2\xbb\xb3\x64\x99\x02\x6c\x6f\x0e\x80\x68\x71\x17\xf6\x43\x23\xa4\xf6\x6c\x33\x46\xe8\x00\xfb\x00\x31\x42\xee\xa6\x4c\x34\x34\xef\x04\x02\x5b\x6c\x9a\xf0\x5a\x7c\xb6\xdc\x25\x7f\x65\x7c\x94\xd2\x9f\x00\x1a\x55\xa8\x30\xdc\x6f\x43\xd5\x1f\x9b\xc5\x4f\xfc\x74\xd2\x1f\xf1\x02\xb7\x6f\x6c\x29\xa9\x14\x22\x93\x59\x54\x2b\xd0\x62\x6c\x6c\x4a\x6a\x24\xbb\x0b\x34\xbc\xfd\xdf\xf2\xee\x40\x4b\x1b\xd4\x1a\xfd\x76\x77\x0b\xe7\x7c\x81\xd2\xb8\xa6\x30\xe8\x89\xbe\xf8\x46\x67\x00\xb1\xd9\xe0\xe7\x40\xc7\x18\xc7\xb6\xc8\xd8\x81\x31\xe9\x02\xda\x30\xd8\xda\x10\xa3\xb0\xff\x75\x59\x8c\xd7\xf6\xfb\x16\xd9\xd6\x0c\xda\xbe\xcc\x65\xc2\x66\x1c\x46\xdc\x35\x38\x2e\x33\x7e\x71\x20\x31\xe2\x38\xeb\x5f\x52\xec\x6f\x80\x3f\x4b\x77\x30\xa0\xf8\xe4\x80\xaf\xbc\x7e\xdd\xd2\xe0\xc6\xef\x49\x00\x1f\x19\x3b\x67\x13\xc7\xee\x97\x86\x47\x3b\x68\x86\xb0\xf7\x7d\x4d\x5e\xad\xe8\x23\x6e\x48\x96\x98\x12\x10\xed\xb8\x16\xbb\x91\xb9\x27\x03\xbe\x7f\xb9\x6a\x04\x03\x18\x2e\xce\xf8\x03\x0f\xeb\xf0\xe2\x08\xaf\x7f\x23\x1a\x1b\x40\x1c\x79\x32\x5d\x8c\xa1\x1d\xd6\xd2\xab\x3a\xd1\xf8\xc5\x27\x9f\xc9\xe3\x01\x3f\xe2\xc6\x11\x4a\x67\x35\x0a\xc9\x79\xc3\xa0\xb3\xd5\xe1\xb5\xbb\x59\xf3\x46\x94\xa4\x1d\x23\xa8\x54\x2b\x03\x4e\xbd\xf7\xb0\xcb\x21\x42\x35\x8d\xfc\xfe\x8b\x9c\xce\x6b\x7c\xc4\xa9\xf1\x5c\xbc\x3b\xd9\xce\x39\x38\xec\x7b\x53\xe0\xb3\x8d\xc0\x4f\x83\xef\x85\x85\x2f\xf5\x69\xc0\x1a\xdc\x14\x1e\x0e\xc3\x60\xf4\x74\xfa\xb4\xd1\x8b\xd0\xbf\xe4\x6d\xf8\xe8\x60\xd2\x8b\xfd\x55\x19\x2a\xb3\x2f\xca\x92\x1f\x36\xa4\xec\x11\xb4\x56\xb3\x57\x75\x98\x70\x80\xc0\x02\x2a\x23\x4f\xf6\xd0\xbf\x11\x36\x8c\x82\x72\xac\x54\xf8\x00\x6a\xa1\x31\x66\x82\x22\xef\x53\xcc\xa7\xf1\x0b\xc2\x09\xad\x9f\x26\x38\x9a\x4f\xf7\x2a\x2c\xe3\x95\x88\x82\x65\xdd\x5c\x97\xa8\x7c\xe2\x05\x30\xbf\xb8\x6e\x8f\x38\x35\xa8\x8a\x57\x59\x6b\x8f\xc0\xd9\x3c\x07\x4f\x76\xc6\xab\x4c\x65\xc2\x57\x59\xd3\x0d\xdd\xbe\xca\xbb\x15\x6d\x0b\xfd\xcc\xcf\xb9\xa0\x77\x23\x78\xd7\x5d\xf3\xab\x20\xdb\xfa\xc0\xc9\xfe\xc9\x5f\xc3\xb7\x43\xa8\x08\xbd\xa0\x2f\x0f\xf0\x2f\x8e\x4e\x88\x12\x30\xae\xee\x11\x59\x00\xbb\x3f\x7d\xbf\x38\x11\x39\x57\x45\xf0\x8c\xcb\x0a\x42\x65\x6d\xbe\x01\x9a\x5d\xea\x68\x9c\xe2\xc5\x5f\xbb\xc3\x71\x56\x6c\x94\x0a\x34\xc7\xfe\xf1\x82\x4e\x75\x07\x9a\xa7\xec\x18\x75\x6f\x1b\x38\x5b\xa5\x53\xe3\xc1\xa6\x92\xc5\x6c\x03\x61\xad\xb6\x6f\x71\xa0\x15\xef\x74\x16\x08\xf2\x96\x3f\x9d\x9b\x53\x1d\x2b\xf4\xeb\xdf\x34\x82\xd2\xa8\x3e\xb1\xb7\xd3\xa2\xcd\x73\x16\x6d\x3f\xd5\xa1\xf9\x37\x35\xa7\x79\xd3\xa2\xb4\x0d\xb5\x37\x46\xd8\x19\xea\x64\x8b\xa9\x29\xcf\x85\x26\x3a\x1a\xeb\xa8\x40\x7c\x03\x71\x04\x15\xdf\xbf\xfc\x38\x9a\x30\x0c\xe3\x3e\x8d\xa2\x3e\x8d\xc2\x7e\xce\xe2\x1e\x2f\x63\x6c\xf9\x51\xe9\x14\xaa\xb2\xf3\x55\x7d\x1c\x21\xe8\xed\xbb\x7b\xcf\xc5\xa0\xd8\x8b\x2d\x6f\xc2\xfe\x4e\xf7\x86\x7e\x26\xf8\x9e\x06\x48\x33\xbe\xf6\xf8\xf6\xb4\xdb\xa5\x12\x7b\x76\xa0\x36\xdb\x2a\x6c\x7e\x22\x78\xe7\x83\x50\x34\x7f\x7d\x42\x3f\x0c\xd3\x28\x5d\xe5\x44\x67\x3f\xab\x07\x03\xca\xb1\x02\xf3\x9a\xb5\x93\xc6\x01\xe7\xc2\xd5\xa7\x0e\x92\xd4\xf1\xe1\xea\xda\xb8\x79\xd1\x24\xe8\xf2\xcd\x0f\x45\xe6\x5f\xb8\x85\xf6\x68\x6b\x44\x0d\xa2\xc0\x0a\x04\xf5\xfe\x21\xc7\x7a\xc6\x6c\x2d\x41\xca\xf3\x24\x05\x2f\x35\x15\x6a\x90\xb6\x55\x83\x68\x77\x7a\x14\x8e\x01\x65\x0c\xd4\xc2\x2e\x33\xe5\xe0\xe3\x3b\x4f\x4e\x5a\x9f\x93\x73\x94\xc8\x5d\xaf\xe4\xaa\xb1\xc1\xde\x89\x6f\xa0\x7e\x6f\xc0\x07\x51\x2b\x0a\x69\x11\x71\x47\xc5\xba\xb5\x21\x93\xda\xdf\x2d\xd5\x5a\xc6\x4e\x9a\x77\x4c\x5a\xbb\x5f\x6c\x4d\x36\xb9\x3e\x57\x6a\xf2\x15\x7e\xbd\x56\xde\x73\x19\x21\xa6\x98\x98\x0b\x02\xae\xce\xb8\x61\x5f\xb2\xbc\x80\x17\x11\xa1\x9a\xa6\x96\xaa\xef\xe0\xf6\x38\xcd\x92\x88\xc1\xf4\xeb\x39\x71\x58\x07\xb6\xbc\xaf\x84\x8c\x62\x34\x14\xfd\x90\x6f\x75\xdb\xca\x4f\xef\x60\x4b\x06\xf5\xc7\x54\xdb\x3e\xd2\x3d\xa5\xd2\xdd\x7d\x84\xdb\x2b\x30\xa1\xbb\xa0\xec\x9b\xa7\xad\xf1\xe9\x72\x7d\xaa\x74\x2c\x56\x70\xae\xbf\x44\x7e\xaa\xe8\x11\x05\x96\x55\xbb\x37\xf2\x78\xd3\x36\xa7\xfc\x13\x45\xba\x63\x43\x32\x1c\x0e\xf8\xbf\x3c\xc9\x04\xc1\xd9\xe3\x37\xeb\x3a\x2b\x77\x50\x3a\xda\x50\xea\x36\xd3\xf8\x1a\x4e\xd1\x9c\x6f\x07\x19\x08\x64\x9f\x08\xeb\x27\xcf\xf5\x5b\x32\xed\xb4\x3c\xdb\x6a\xe8\xcd\xa6\xd3\x92\x05\x83\x8c\x78\xd5\x02\xa8\x2f\xc4\xf0\xd7\x9b\x59\x1f\xea\xd4\x34\xea\xd4\x1f\x68\xd7\x3d\x1f\x8b\xc4\x90\x1d\x84\xfe\xac\x29\xa3\x60\xc0\xc4\x3a\x91\x4f\x8e\x4c\xe6\xca\x0a\xd4\xe9\x54\x90\x4d\x1b\x1f\xeb\x79\x67\x08\xec\x51\xa5\x74\x30\x8b\xe3\x81\x0b\xac\x17\xca\xab\x72\xe0\xcb\x5c\x25\x46\x97\x5f\x77\x85\xa7\xc7\x03\x2a\xb1\xb0\xd0\xa4\xe4\x9d\xed\x8b\x39\x85\x1f\xf4\xca\x63\xbc\xf9\x6b\x7e\x93\xdb\xf0\x85\xdb\xf8\x26\x88\xc9\xeb\xc1\xaf\xf7\x02\xef\x9f\x21\xe7\x1b\xb3\x73\x03\x85\x35\x62\xf1\xfe\x11\x99\x92\x40\x64\x7f\xcb\xd4\xa5\xc0\xca\x78\xad\x77\x23\xb5\xd7\x78\xf5\x87\xb2\x28\xab\xd2\xec\xe1\x8e\x17\x1f\xf8\x50\xea\x54\x79\x73\x07\x7d\x2e\x2b\x5a\x43\x82\xce\xc7\x4a\x96\x65\xf4\x5b\xe9\x6c\x5c\xa6\x48\x68\x0e\xef\xb4\x8f\xb8\xd3\xbf\xc2\x47\xba\x7f\xab\xff\xf8\x0c\xf8\xf0\x19\xd2\xe5\x47\x0a\x9b\x89\xf2\xd7\xc4\x9d\x50\xe7\xb7\xab\x80\xea\x7b\x27\x70\x3a\xa4\x7f\xed\x92\xdf\x3f\xe7\xac\x3c\x3f\x24\x0d\x78\xe9\x2a\xf2\xbf\x3e\x7b\xd0\xa6\x66\xb4\x86\xe8\x97\xb4\xae\x8d\x02\x65\x09\x8f\xf5\x78\x15\x37\xb2\xcc\x75\xe1\x47\x2f\x06\xed\x64\xd6\xda\xe5\x88\x3e\x65\xf4\x00\x91\x87\x3f\xa6\x39\xbe\xdd\x57\xf5\x62\x1c\xce\xf7\xb8\x94\x1d\xa5\x1e\x9a\x35\x05\xab\x0f\x49\xd2\x3e\x0d\xda\xf6\xf8\xdd\x46\xdb\x83\x76\xd5\x0b\x44\xfe\x09\x5a\x0a\xf3\xc4\x46\x25\xf4\xf6\xb1\x1b\x16\x36\x9d\x80\x67\x6e\xd3\x0f\xde\x0c\x49\xb7\x64\xd0\xea\x0f\xc2\xd6\x99\xc6\xfd\xca\xb4\x7e\xcd\x90\x8e\x56\xce\x1e\xb5\x28\x40\xc8\xe3\x51\x3a\x05\xb6\xdd\xf6\xf9\x20\x8c\xdf\x5a\xb9\xea\x30\x1b\x6d\xe8\x1e\x4c\xcd\x55\x77\x81\xa9\x1f\x63\x35\x5e\x63\x35\x40\x7b\xf2\x45\x37\x08\x7f\x6f\xc3\x79\x8c\xaf\xed\xc3\xb4\x1d\xc3\x34\x73\x19\x7c\xe1\x40\x66\x19\x1e\x90\xab\xd9\x2e\x22\x5d\x51\x43\xc1\x4d\x4e\xd7\x39\x3f\x9f\x3a\x16\x6b\xb8\xcc\xaf\x34\x8c\x91\x66\x43\x3e\xd4\xe0\x9b\x03\x7c\x56\x88\x76\xe7\xa5\x31\x52\x9f\x78\xb1\x73\x5c\xc8\x92\xd0\x0f\x41\x19\xcf\x51\x2b\x79\x4d\xf5\xed\xf4\x4a\xc6\x76\x1c\xc4\xa8\x03\x1c\xea\xcb\x85\x0d\x5f\x80\x0d\x3f\x40\x7e\x52\x88\x70\x21\x07\x1f\xdb\xf7\xd6\x59\xf3\xba\x4d\xfb\xd9\x6f\x56\xe3\x8f\xb3\x76\xf8\x1d\x4e\xd2\x78\x3f\xaf\x30\x7e\x01\xf6\xed\xc9\xcd\x45\xa1\xea\xab\x5e\x9e\x15\x91\xf4\x5d\xa3\x28\x49\x8f\x8d\x05\x7e\x47\x3d\x24\xb0\x04\xbf\x69\x27\x12\xeb\x69\x24\xf7\x2a\x14\x3d\xa8\xb0\xc5\x8a\x73\x3a\x35\xe4\xfb\xd5\xab\xa4\xf9\xf8\x64\x48\xb1\x95\xc8\x27\x3d\xd0\xcf\x12\x26\x55\x7f\xd5\x02\x77\x7f\xb6\xd3\xd7\xf0\xfc\xec\x4a\x47\x43\x3f\x11\xfa\xd9\x17\xa4\xd8\x13\x04\xde\x13\xc4\xec\x52\xc9\xb5\xf4\x0f\x97\xf0\x2c\xf4\xc3\x41\x0a\xd9\x03\x96\xea\xfc\x52\xe0\x6f\x91\x0c\xf3\x0a\x03\xe3\xd9\xe1\x66\x75\x06\x8b\xe9\x47\xa1\x36\x50\x80\x10\x8b\x4e\x2c\x5e\x86\x85\xdc\x6c\x34\x1b\x38\x01\x18\x40\x52\xbb\x75\xb5\x3e\xc3\x7c\x7c\x72\xe4\x53\xdf\x68\x8f\x25\xba\xab\x0c\x64\x8e\x7c\x88\x0a\x5c\xa3\xae\x96\x22\xee\x89\x7e\x2e\x5c\xc0\x85\x59\x9f\x62\x81\xb2\x28\x5c\x17\x22\x1b\xd8\x56\x5b\xff\x66\x22\xe1\x25\x08\xb9\x45\xdc\x13\x65\x37\xbf\x0f\x35\xc4\x1e\x06\x95\x76\xfc\x24\xbd\xf7\x81\xe7\x74\x0c\x61\xfa\xf3\xa6\xf9\x9d\x9c\x51\xa1\x82\x8e\x0c\xa4\xdf\x77\xfa\x48\xf8\x6e\x46\xef\xc7\x1e\xfc\xf0\xf3\xf6\x76\x17\x73\x38\x8a\x01\xa9\xf9\x17\xf1\xd4\x9e\x83\x0f\x9f\x92\x04\x99\xee\x10\x91\x5a\x7e\x8c\x14\xda\x51\x8e\x37\x9e\xed\xd1\x80\x96\xc7\x14\x7b\x06\x74\xe0\xb1\x45\x20\xa3\xbf\x0f\xfe\x7c\x58\x5a\xac\x0a\x7b\xd7\x1b\xbe\xf9\xa0\xc5\x43\xab\xf6\xf4\x78\xdd\xac\xee\x04\x9d\x21\x6b\x84\xec\x6b\x20\x54\xce\x1e\x11\x66\x8b\x81\xe7\x21\x60\x33\xf9\x68\x6e\xc3\x08\xa3\x43\xf8\x18\xff\x05\x27\x55\x1c\xef\x4a\x7f\xa8\xd9\xef\x70\xd5\xef\x70\x7b\x46\xab\x78\x25\x80\x76\x8f\xd6\xc2\x77\xe1\xec\x2f\xe2\xd5\xef\xf6\xd6\x35\x3b\xf6\x85\xb0\x35\xef\xf4\x41\xaf\xf1\x9d\xc8\xbb\x90\x81\x82\xdb\x8d\xee\x37\x71\x3a\x69\x1b\x5f\x31\xf7\x60\x61\x6f\x5b\xb6\x21\x06\xa9\x88\x38\x5b\xa2\x99\x33\x9c\x43\xa2\x11\x3e\xfc\xcf\x70\xe2\xcc\xa2\xb5\xc4\x49\x81\x69\x79\x17\xe1\xd7\xa0\xa7\xb0\xac\xd8\x61\x7f\x06\x08\xce\x05\xc1\x70\x0c\x89\x02\xce\xfd\xe5\x23\xb8\xff\x54\x20\xa2\x7a\x9c\x4f\x74\x43\xaf\x96\xb3\x0c\x91\xe6\xe8\x7f\xf5\x3c\xc5\xb9\x1e\xd7\xbc\xed\x97\x61\xc1\xc8\xa1\xf4\x15\x18\xbe\x39\x65\xbc\x14\x04\x3a\x70\x11\x17\x7b\x5c\xd9\xb4\x09\x8c\xf8\x51\x8d\x0f\x7d\x00\x28\x76\x80\x3b\xaa\xe3\xf9\xa9\x50\x55\x91\x83\x4a\xb8\x67\x51\x31\x66\x48\x3f\x27\x51\x33\xa5\x92\xdb\x65\x52\xb6\xcc\x8b\xd7\x2d\xda\x57\x49\x78\x96\xb0\x69\x72\xc0\x2c\x0e\xda\xed\x2a\x86\x89\x2b\xb7\xc9\x25\xef\x66\xe8\xfb\xe8\x2c\x0c\x9a\x20\x5e\xb8\x75\x66\xda\x26\xf8\x82\x2f\x21\x53\x67\xa7\x83\xf0\x55\xd3\xb1\xb4\xfa\x5c\xf6\xfa\xc5\x81\xf8\x1d\x6e\xd1\x07\xfa\xe3\x63\x2d\x6e\x8a\x37\xde\x18\xc2\x85\x37\xaa\x5a\x0e\x54\x36\x7a\x62\x98\x21\xe9\x37\x5b\x06\x03\x4c\x3c\x49\x61\x4b\xbf\xae\x11\x86\x0e\x14\x94\x38\x02\x0b\x80\xc5\xe9\x14\x4e\x6e\x96\x25\xea\x42\x44\x31\xbb\x44\xc8\x50\x99\xa1\x33\xc1\xba\x01\xd9\x92\x8d\x33\x91\xd7\xca\x97\x4d\xd5\x6e\x5e\x32\xeb\x44\xd1\xe5\xc7\x1f\x8a\x3c\xcc\x2b\xd7\xec\xcd\x63\xf1\xce\x23\xa0\x8a\x4f\x15\xa6\xf5\x08\x36\x1d\xaa\x04\x57\xe8\x2f\x9a\x1b\x02\x56\x9a\x57\x4f\x9d\xa6\xd8\x05\x3f\xd3\xe0\xa4\x80\xcd\x85\x7d\xdc\x86\x15\x90\x79\x43\x13\x12\x65\x8f\x86\x38\x23\x3d\xf0\x8a\xe1\x66\x20\x6a\xd4\x9e\x73\x5e\xa1\x52\x41\x97\x58\xcb\x1a\x3d\x42\xf6\x9e\x40\x92\x2e\x7c\x5b\xe0\xd3\x81\x72\xfd\xf3\x66\xff\x98\x08\xf4\x33\x3b\x94\x9c\xb3\x6c\x6b\x93\x9d\x76\x4e\xe4\x1b\x8a\x28\x2d\xac\x32\xd3\xed\x64\xc8\xdd\x0f\x60\xeb\x2d\x77\x6f\x7b\x6c\x41\x3b\xfa\xef\x2f\xea\x2f\xe5\x89\x3a\xae\x0e\xfa\x8f\x23\xfc\x24\xe8\xea\x93\xbc\xa8\xca\xee\xbe\xb7\x41\xf2\xe9\xc7\x3e\x11\xba\x81\xc8\xa2\x5c\x93\x25\xb5\xe0\xfd\x13\x26\xd9\xa2\x89\x7d\xe0\xb5\xdc\x23\x53\xf7\x47\x4d\xc7\x54\x4e\x77\x15\xf0\xc6\x76\xfa\x69\x92\x30\x5e\xe0\xb2\xa1\x2c\xa8\xdf\xdb\xf0\xe4\x2f\x80\x84\x3a\x96\x61\xe6\x27\xe8\x4a\x56\x9c\x3f\x5e\xc3\xdd\x31\xce\x6c\x75\xc1\x33\x93\xfa\xd9\x87\x05\xf6\xe9\x97\xc3\x34\xe2\xf8\x4e\xc0\x49\x10\xc7\xb7\x07\x65\x10\x54\xd1\xaa\x87\x8a\xf8\xe1\x6b\x0a\xec\x38\xaa\x7f\x12\x42\x76\x88\x9a\x12\x7f\x9a\xfa\x1b\x3e\x57\xa0\x11\x0e\x1b\x08\x52\x72\x5e\x3a\xed\xf8\xa5\x49\xf3\x3c\xec\xe8\x77\x54\xf9\x20\x2e\x3b\x50\x18\x14\xde\xe2\x4d\xc1\xa0\xab\x3a\x18\x08\xce\xe7\x3c\xbe\x1f\xda\x41\x5e\x97\x2e\x30\x7a\x68\x12\x61\xb3\x17\x45\x13\x96\xc3\x89\xdc\x1f\xef\xcb\xbf\xb5\xf2\x36\x01\x3a\x7f\x40\x40\xee\xfa\xe7\x3b\x3a\x44\x95\x13\xd4\x51\x62\xa5\x3c\x36\x00\xf8\xed\x7a\xd5\x0b\xd6\xbe\x45\x40\xf1\xa6\x6d\x28\x07\xc0\xfc\x8c\x17\x7b\x44\x49\xd3\x7e\x51\xd6\xd1\x59\xb2\x47\x48\xbf\xb9\x48\x7f\x59\xc8\x0c\xd9\x3e\x12\x55\xfd\xf8\xb9\xd6\x77\xfc\xec\xc8\x37\xba\x34\xcd\xc3\x16\xeb\xbb\x21\x1f\xdb\xed\x42\xc1\x1b\x08\x21\x34\x38\xc2\x31\xce\xf1\xfe\xc1\x57\x65\x6c\x0f\xf6\xda\x80\x31\x3c\x55\x8e\x20\xb2\x53\x78\xa2\x9f\x1d\x26\x69\x99\xdf\x50\x7b\x88\x7b\x62\x56\x47\x6b\x5d\x88\xfa\x47\x62\x02\x80\xc2\x3f\x81\x1f\x63\x70\xfb\xaa\x9a\x2c\xe9\xc6\xf7\xcd\x5d\x1a\x91\x79\x26\xaf\xc2\x61\x6e\x93\x46\x28\xc4\x6e\x90\xc0\xcb\xde\x66\xb2\x78\xe8\x05\xb1\x46\xbf\xa9\xaf\xee\x90\x61\x82\xae\xfc\x0a\xa7\x16\xf4\x63\x39\xf3\x92\x0e\xf6\x5b\xa2\xd5\x89\x16\x8f\x69\x12\x00\x1a\x6a\xcf\x12\xe9\xfd\x83\x17\x27\xd7\xee\x52\xa9\x40\xd2\x80\x46\x23\x3f\x94\xca\x0b\xf7\xf6\x97\xb6\x04\xc5\x55\x54\x7d\xbe\xc0\xf3\x55\xb1\xa7\x2a\x4f\xb9\xfb\xda\x30\x45\x67\xf7\xf1\x09\x32\x53\xe6\xdd\x1a\xeb\xec\x47\x7f\x7a\x60\x9c\x15\x47\x68\xe8\x07\xaf\xc2\x1d\x28\x5b\xe3\x19\x82\x77\x5c\x7b\x94\x66\xc0\x07\xf4\x8a\x8f\xc2\xb0\xfd\x69\x40\x2a\xef\x0c\x78\x7e\xc2\xb8\x1f\x3c\x86\xdd\xdc\xfa\x8b\x81\x3a\x3f\xec\xd1\x3a\xfb\x61\x11\x8b\x7d\x7a\xc4\x87\x2d\xef\xc8\x0f\xf9\xdf\x50\xb1\xf3\x3e\x60\xf9\xf6\x0d\x57\x55\x4b\x14\xb8\xe6\x50\x6e\x0c\x36\x64\x32\x38\x5a\x90\x8f\x4f\x1f\x2f\x2f\x02\x94\xe2\x94\xaa\x45\x94\xaa\xad\x45\x2a\xaf\x66\xaa\x02\xdf\x8e\x9b\x42\x9e\xc1\x41\x43\x64\x6a\xb1\xfa\x95\xdd\x8d\xa0\x35\x98\x05\x82\x9c\x13\x70\x75\x50\x4f\xc2\xb4\x08\xce\x22\x3a\x2a\xab\xa8\x20\x48\x90\x1e\xe7\x78\x42\xe2\x2a\x2a\xa7\x03\x3d\xc2\xb8\x60\x09\x94\x0b\x48\x82\x10\xf0\xc3\x25\x60\x6b\x06\x41\xda\xbc\x97\xc2\x5d\x2a\x9f\x70\x5e\xa9\x27\xc1\x29\x06\x49\x02\x6a\x81\xcf\x13\x39\x97\xa4\xdc\x15\x99\x9c\x9e\xcb\x62\x40\xc9\xb3\x20\xb9\x3e\xd2\xce\x66\xa9\xa5\xd7\x48\x2e\xf1\x5f\x91\xa0\xc0\xd2\x88\x92\xa3\x32\x64\x3d\x5c\x29\x54\x9a\x46\x3e\x43\x7a\x10\x7c\x07\x37\x07\x2a\x43\xc5\xc6\xf5\x63\xb3\x0f\x9e\x9f\x2b\x0c\x90\xc5\x19\x3f\x62\x39\x46\x07\xfa\xa8\x33\x0d\x8c\x7e\x15\xa4\xd6\x9d\xda\x50\x45\x15\x1f\xb0\x3a\xe5\x0b\x22\x81\xd6\x69\xea\x58\x81\xa5\x0f\x10\xc8\x20\xe0\xf4\x64\x71\x5d\xb9\x4e\x70\x48\xdb\xb5\x10\xa4\x0f\x34\x41\x03\xa0\x7f\x40\xf4\xc9\x40\x3a\x33\xbf\xa8\x24\xf3\x3f\x84\x86\xda\x7c\x9a\xa8\x76\x48\x13\x78\xb3\xd2\xbf\x3f\xdc\x00\xde\x2e\x70\xfc\xe2\xb8\x72\xf6\xa6\x71\xb7\x86\xde\xe1\xd6\xe7\x44\x8b\x58\x9f\xe1\x0c\x51\x3e\x79\xd6\xd5\x02\xf3\x3d\x50\x72\x47\xa9\x5c\x17\x0f\xb4\x3a\x3a\xdd\x12\xe1\x3e\xbf\x6c\x9a\x14\x22\xbc\x4b\x21\x22\xe1\xb9\x8e\x28\xcc\x0d\x95\xfb\xdb\x8f\x91\xfd\xcd\x63\x81\x46\x79\x8a\x17\x7f\xa2\xe8\x71\x51\x1e\x31\x96\xdf\xca\x25\x3c\xc6\x6b\x8c\x7e\xda\x24\xda\x12\xdc\x04\xea\x10\x67\xb2\xfb\x22\x9a\xdb\xdf\x07\xb7\xfc\x46\x05\x76\xa9\xae\x9d\x45\xfe\x0b\x77\x09\x5c\xc1\xa5\x01\xb0\xe8\xa9\xa9\x45\x89\xd1\x9f\xbf\x3d\x52\xcc\x3e\x0e\xca\x5f\xba\x96\xef\x8b\x44\xf1\x0c\x99\x0f\x94\x88\x7e\x59\x14\x5d\x85\x61\xd0\x7b\x30\x85\xfe\xa6\x33\x02\xd8\xf4\x39\x68\xd3\xb5\xe7\x5c\xdf\x0e\x2f\x5e\xe3\x35\x16\x3b\xc6\x1f\x68\xd6\x31\xc0\x3e\xcf\x78\xc5\xa9\x03\xfd\xec\x00\xf5\x18\xdb\xaa\x3d\x83\xfb\xc5\xab\x33\x86\x70\x49\x6a\xa6\x14\xa7\x80\x8f\x39\xe0\x07\xba\xad\x21\x9c\x8f\xaa\xc7\x46\xeb\xea\x0a\xd8\xa2\x3d\x5b\xf5\x57\x22\xf2\x73\x46\xa9\x48\x84\x2c\x0e\xf4\xce\x63\x71\xeb\xe7\xe0\x7b\xf6\xb7\xe5\x09\xb7\x11\xcb\x4f\xa3\x74\x5e\x35\xcc\x83\xc1\xb6\xc5\x81\x3a\xa5\x2d\x79\x4a\x4e\x97\x87\x30\xa0\x6c\xa8\x9a\xa6\xca\x50\x43\xc3\xa0\x7a\x89\xea\x26\x97\x1e\x5f\x56\xb4\xbf\x20\x0a\x3e\x80\xa7\xb9\x46\xb6\xcd\x08\x01\x1c\x4a\x92\x1c\x01\xb7\xac\xcc\x48\xe0\x00\x71\xe2\x79\x35\x76\xf4\x02\x7f\xda\x3b\x3f\xb2\x81\xd7\xc3\x3a\xb2\x34\xf9\x16\xfb\x4f\x9a\x29\x24\x15\x4f\x6e\x91\x96\x88\x06\xbc\x19\x7e\x59\xea\x53\xae\x0b\xf1\x65\xf1\x03\xc5\x94\xc4\xdd\x2b\xe0\x89\x05\x3b\x6e\x5f\x36\x65\xcd\x30\x9d\xbd\xee\xaf\x73\x2b\x36\x1f\x7c\x81\xcb\xc3\xe3\x2b\xd3\x18\x49\xae\xb1\x05\x5f\x45\x78\xc8\x9c\x3c\x0e\xb4\x5f\xec\x00\x85\x59\xcb\xc7\xd3\xd8\xf1\x5f\xa5\x10\x85\xa2\xe7\xd8\x37\x9a\x51\xf6\x8b\xa1\xfe\x8e\x86\xed\xfd\xf1\xa7\xcf\x12\xb1\x41\xd8\x57\x18\x0f\xd4\x54\x61\xef\x2f\x77\xd5\xaa\x10\x70\x8c\x33\xf2\x33\x4e\x6d\x1c\x00\x46\x3a\xbd\xce\xbd\x97\x7c\xb1\x71\xa4\x80\xca\x44\xca\x03\x57\xed\x6f\xb4\xaf\x99\x83\xe5\xa6\xec\xa7\xdb\xa0\x11\xa5\x3c\x86\x36\xe3\x5c\xd2\x44\x1c\xba\x07\xe7\x71\x8f\x2d\x5a\x3b\x9a\x77\xfe\x91\x5d\xe3\x57\x94\xc7\xcd\xd0\xf3\x28\xa3\xa2\x3f\x42\xe1\xa3\xa8\xfa\x3e\xa4\x92\x1f\x3c\x85\xe0\xe6\x16\xaa\x8c\xc5\x81\xda\x28\x4a\xcc\x1a\xb2\x7f\x23\xe1\x1b\x7e\x71\x0a\xe0\xf9\x7d\xa7\x81\xbe\x6b\xee\x38\xe8\x95\xcc\x18\xde\x89\x13\x68\xdb\xc8\x11\xa1\x56\x54\x6b\xfe\x89\x1c\xfc\x7e\x80\xaa\xc7\xa7\x4c\x6e\xa8\x42\xba\xa1\x1a\x4b\xc8\x7d\xc5\x29\x02\xda\x79\x1f\x6f\x83\xee\x35\x48\x9b\x88\x22\x8b\x75\x44\xf6\x87\x03\x14\x71\x81\x5e\xb6\xf5\xc2\x19\x5d\x20\x1f\x73\xb3\xa4\xcb\x24\x79\xe0\x8d\x33\x4a\xdd\xd9\x19\x96\x02\x98\x0d\x40\x53\x43\xbe\xb4\x2a\xbb\x70\x68\xae\x15\xc0\x80\x67\x40\x62\xa0\x89\x9d\xc6\xaa\x3d\x6d\x82\xb4\xd8\x86\xc9\x0e\x06\x40\x31\x4a\xbe\x0d\x6a\x4a\xe7\x4b\xd4\x79\xd8\xfe\xea\xb4\x2e\x9a\xb0\x65\x70\xad\xeb\x0b\x17\x71\x7c\x10\xc7\x48\xd2\xde\x6f\x61\x47\x61\xaf\x06\xa3\x84\xab\x8f\xde\xbe\x07\x06\x5e\x6c\x39\xcf\x92\xb4\x21\x23\xcd\x76\xe3\x10\xa8\x5e\xd8\x27\xb9\x21\xbd\xf3\x42\x4b\x35\xbd\xf8\x8f\x90\x77\x97\x02\x26\xbd\x80\x2e\x24\x08\x76\x90\xc8\x51\xfa\xf2\xf0\x80\x87\x5d\x2e\xbb\x31\x48\x76\xd8\xa8\x12\x3d\xef\x85\xf3\x5f\x4f\x90\xc8\xe2\xd4\xfb\xe9\xca\x35\x69\x05\xa2\x65\x5d\xc4\xdd\xce\xe1\xd4\xce\x24\x97\xc8\xe6\x31\xab\x00\xec\xf3\x0a\xb7\xe1\x8e\x07\xe6\xe3\x13\xa4\x71\x20\x7a\x03\xfa\xa9\x8c\x9c\x40\x79\x63\xae\xe9\x04\x59\x81\x60\x37\x28\xfa\x35\x32\xc4\x54\x64\xfc\x4f\x9b\x6c\x47\xe8\x13\x25\x49\x5a\x26\xcd\x31\xf6\x1b\xd0\xfe\x2a\x14\xb6\x23\x64\x01\xeb\xb8\xa4\xe4\x96\xdc\x38\x0a\xab\x5a\x83\x6f\x24\x09\xd8\xd1\x10\x5d\x79\x52\x49\xd6\xb6\x4a\x3f\x20\xb8\xfc\xc5\x15\x5f\x96\x7a\xa6\xb8\xcc\x26\x21\x49\xf2\x56\xdb\x70\xda\xc0\x91\xe9\xe3\x47\x52\x5c\xbc\x72\x5d\xab\x6b\x57\xdb\xaf\x5a\xc4\xbf\x92\x29\x51\x37\xd9\x68\x6a\xe3\x37\x02\x25\xde\x65\x2c\xe6\x7b\xac\xea\x30\xa6\x64\x89\xed\x13\xb6\xfb\xa8\x58\x25\x5e\x8b\xd8\xde\xc9\x56\xd9\xfe\x35\x79\x0e\x9a\xed\xcb\x36\x64\x40\xf5\x01\x29\x96\x21\xbe\xc0\x6e\x8f\xe6\x58\x64\x3f\x16\xab\xfc\x75\x49\x9a\x32\x24\x7e\x33\xb9\xab\x31\x4a\x9b\xaa\xae\xc9\xb8\x9d\x50\x2c\x10\x3a\xbf\x0a\x67\x13\x4f\x94\x6c\xa6\x4c\x3a\xfc\xf4\x72\xa5\x52\xb9\xf8\x17\x43\x2f\xc0\x7e\x93\x21\xdd\x5f\x8c\x72\x6b\x1c\x5b\xac\x6d\x97\x7d\x09\x96\x06\x4f\xbe\xcb\x63\x5e\x30\x20\x3f\x7c\xe5\x87\xf1\xfc\xa0\x8c\xc0\xa8\x0f\xe7\xb1\xbe\x92\x6f\x8e\x6c\xed\x48\xbf\xd8\x4c\xec\xbe\xda\x01\x71\x8f\xbb\xfa\x87\xd6\x22\x9d\xea\x4f\xfc\x91\x98\x2b\x14\xdd\xa0\x6a\xfb\xe0\x37\x73\x55\x94\x7a\x92\xe7\x3c\x23\xd6\x8b\x08\x13\x74\x09\x20\x36\xa2\xf3\xf7\x5c\x31\x40\xfc\x20\x70\x1b\xcd\xa3\x8d\xc3\x00\x50\x30\x23\x12\xa0\xf1\x06\x1b\xc3\x9d\x23\x39\x65\xad\x94\xd6\x50\x46\xf3\x3e\xb3\x61\xa6\xdd\x81\x76\x15\x73\xc4\x3a\xde\x6e\x7e\xa9\x66\xc7\xbc\x24\xe8\x4d\x35\x13\x5e\xce\x5e\x3b\x2d\x0a\x44\xd3\x7d\xcd\xf5\x32\xdc\xb5\x2d\x0e\x14\xb6\x4d\x74\x4f\xf2\x79\x82\xe4\xd9\x41\x20\x36\x24\x6f\x32\x25\xe5\x3e\xd2\xe9\x69\xe7\xf9\x72\xab\x44\x92\x23\x0f\x07\x24\x9e\xc5\xb6\x58\x3f\xd7\x50\xb7\x24\x58\xa5\x2e\x40\x4e\x6b\x9a\x94\x29\x7c\x87\x1b\x9f\x06\x41\x9c\x4a\xb3\x3d\x3a\x47\x02\xa8\x3e\x13\x52\x19\x95\x5c\x8a\x93\x2e\xe8\x71\x4f\xf3\xb0\x5a\x4e\x80\xb0\xde\x46\xdd\x30\x9e\xcc\x5b\xdc\x27\x68\x38\x02\xf7\x82\x5e\x48\xb1\x72\x22\x2f\x5a\xbf\x49\x9c\x22\x5d\x48\xe7\xc7\x17\xa7\x75\xf0\x6b\x08\xb2\x3f\xfe\xe5\x0b\xc2\x5c\xc6\xd5\x8d\xd4\x5f\x13\x7b\x5a\x4a\x9f\x03\xf5\x8e\x2f\x0e\x82\xd1\x5a\x4f\x8a\x27\xb2\x47\x03\x7c\x7f\xf3\x14\xf1\x17\x03\x46\xf7\x15\x11\xe3\x13\xdd\xa6\x0f\x5d\x9a\xc4\x37\x17\x55\x5a\xa3\x8e\x6d\x7c\x53\xda\x48\xb3\x64\x2a\x43\xdb\x24\x4e\xe0\xf9\xfc\x31\x11\x5a\xfb\xc8\xf6\x80\x1f\x9f\xaf\x3a\xbd\x27\xfc\xd9\x50\xa0\xda\x61\xb3\x59\xe5\x77\x86\x0a\x0b\x2d\x80\x21\x75\xa1\xc4\xa6\x58\xee\x43\x63\x8e\xe1\x0b\x48\x51\xbf\x9b\x45\x51\x0d\xb9\xd9\x2a\x1d\x58\xd6\x58\xed\xcb\x02\x9c\xfc\xdb\xa6\x8a\x9f\x10\x6a\xcb\x5c\x9d\x7a\xd1\x26\xe2\x31\xbf\x9c\xd1\xfa\xd8\xe3\x29\x83\x60\x3c\x2b\x9f\x30\x64\x1d\xca\xfe\xdc\x94\x4b\xae\x13\xc1\xd5\x8e\x28\xff\xa5\x9d\x7c\x43\x40\x6e\x18\x9f\x8f\xf6\xd6\x93\x66\xbf\x41\x96\xaa\xe8\xad\xaa\xf6\x20\x3c\xc3\x8e\x81\xf6\xbe\xc0\x1f\xc6\xff\x71\xd5\x15\xee\xae\xa3\x65\x21\x8e\xef\x59\xb2\xb9\x25\xe7\xe5\xc9\x97\x53\x85\x31\xa0\x34\x6d\x35\x35\x99\x8a\xca\x2f\x58\x45\x52\x82\xb9\xf9\x7d\xbe\xc2\xd4\x29\xa3\xd2\xb7\x0d\xe5\x79\x4a\x25\xd3\xa4\xad\x94\x83\x9a\x76\x24\x83\x7c\x46\x66\xb7\x69\x2c\x35\xf6\xa8\x0a\xbc\x65\x22\x98\x84\x1b\xf7\xeb\xd4\x0f\x8f\xeb\xaa\xac\xad\x3d\x96\x2d\x6e\x72\xe8\x7b\x83\xf4\xf0\x02\xa8\x3c\x98\x11\xc9\xc1\xea\xf4\xfa\x4f\xf7\xd4\x0b\x28\xae\x1f\xc8\x3f\x54\x5e\xc5\x77\xdf\xff\xc0\x6b\xe6\xab\x1d\x88\x7f\x31\x27\xf3\x70\xba\x26\x42\x9b\xbb\x5b\x60\xa5\x1b\xc3\x17\x7e\xf3\x4a\xd5\xb9\x11\xa1\xa1\x7e\x50\xb4\x42\x7f\xbe\xa3\x63\x09\xc1\x77\x6b\xa4\xe0\x48\x50\x5f\x1c\x3c\xc7\x2f\x7b\x75\x40\x65\x77\xe3\x9e\x75\xd2\xd7\x8a\xab\xdd\x1a\x49\x0e\x60\x93\x3d\x82\xe6\x21\x07\x8c\xd1\x5f\xd9\xfd\xf3\xd9\xb7\xa7\xd0\x3d\xa8\x57\x93\x3c\xb6\x74\x7c\x71\x2d\x1e\x40\xb3\xad\x32\x73\x94\x1f\xd5\x8f\xf0\x93\x90\x3c\xcb\x11\xb4\xec\xd1\x7f\xdd\x03\xa2\x2a\x29\x20\xac\x8a\x08\xe1\xe1\x4d\x7e\x47\x7d\x7e\x46\xc0\xd9\xa7\x41\x38\xf6\xd9\xe4\x0f\xb7\xc1\x6b\x5c\xb6\x7d\xc4\xbb\xc1\xb0\xc2\xfb\x44\xbc\xc9\xce\xbb\x24\x7d\xb5\x1f\x10\xcc\xe8\x9f\x56\x5e\x46\xbb\x1d\x62\x3d\x7e\x9c\xc1\x1b\xee\xf7\x57\x17\x22\x36\xdf\x74\x85\xe8\x62\x4f\x61\x4d\x12\xfe\x80\xdc\xae\x79\xdd\x02\xfa\x38\x94\xc9\x4d\x80\xe7\x26\x53\x97\x9f\x3a\x09\x12\xbc\x21\x37\xb3\xc9\xe9\x8e\x92\x0f\xb0\xa3\x5c\x73\xd2\xf9\xba\xed\xbc\xda\x38\x56\x53\x23\xe8\x6c\x97\xfd\x19\x72\x24\xca\x75\x65\x02\xcb\xc1\xc3\x62\x4d\x12\x2d\x00\xd0\x05\x63\x57\x00\x0c\x57\xed\xa0\x26\xb8\xbb\xe6\x2b\x44\xe9\xca\x65\x3b\xdf\xc8\xb3\x5d\x2f\x3d\x27\x30\x2e\x9b\xde\xb6\x46\x3a\xdf\x6f\x19\xf8\xab\x07\x02\x99\x6c\x03\x5c\x3b\xd8\xdf\xfd\x1c\x17\xd9\x47\x1f\xb5\x3e\xbe\xfe\xa6\x75\x2a\x57\xd9\xe0\x60\x46\x13\xb5\x3d\xcf\x32\x4f\x39\x58\x3c\xe5\x07\x4c\xec\x72\x67\xcb\xd5\x30\x87\x37\x29\xf8\x7a\xf2\x32\x32\x9a\x43\x9d\x7b\x37\xfa\x20\x2b\x1a\xfc\xe1\x28\xd9\x2e\xd5\x0d\x16\xc0\x27\x4a\x7e\x76\x5f\xba\xb6\x66\xf5\xa9\xdc\x7a\x27\x28\xeb\xfd\x6a\x36\xdb\x7c\x7f\xf0\x05\x35\x1d\xcf\xe6\x06\x3d\xdd\xfa\xee\x7e\x41\xaf\xc9\xc7\x19\xe0\xae\x80\xd9\x75\x0e\xfe\xa6\x7c\x03\x11\x1d\x9f\x83\x55\x9b\x20\xe2\x7a\xf7\x38\x43\x37\x06\xdb\x7c\x4f\x1d\x6a\xa7\xc5\xce\x6d\xff\xed\xd6\x94\x0c\xae\x5c\x68\x68\xf3\xeb\x96\x31\x68\xcd\x70\x72\x32\x8b\x03\xfc\xf2\x47\x00\xe4\x1b\x86\xe0\xeb\xcd\x92\x79\xef\xd3\x7e\x1c\x2c\xb1\x9a\x14\x36\x45\xb6\x73\xeb\x5c\xf8\xf2\x93\x66\xb3\x22\x42\xe7\x5d\xd8\x0e\xc8\xde\x67\xe8\xd6\x59\xd6\xd7\x6e\x9c\x0d\xda\x3f\x6e\xf7\x04\x49\x21\xa7\x08\xd8\xb7\x18\x9e\x5b\x6d\x3d\x67\x41\xcf\x36\xd1\x8c\xc9\x1a\x3e\x51\x39\x03\x03\xa9\xd0\x28\x45\x1c\xf6\x59\xbb\x93\x7c\x7e\xc8\xe4\xb7\x0a\x93\x68\xd5\xb2\xf3\x7c\xa9\xac\x7d\xd8\xe7\x2e\x07\x5f\xa5\xa2\x65\x25\xb2\xe3\x1c\x48\x1e\x38\x83\x8f\x89\xab\x10\x3a\x47\x8b\x80\x48\xf7\x40\xb2\xa4\xa7\xbb\xa0\xbb\xd7\xa0\x82\x3c\x96\x80\xae\x00\x2b\xd8\xf0\x09\x66\x4b\xf9\x18\xc8\x9d\x6d\x7d\x38\xb9\x97\xeb\x36\x6f\x77\xc4\x7c\x73\x01\x2f\x88\x7f\xc3\x5b\x3d\x1c\xd8\x2e\x8d\x3b\x29\x31\x90\xc9\xec\xa4\x22\x05\x03\x3e\x20\x2c\x78\x50\x72\x87\x3b\x44\xfc\xca\xef\x8c\xf0\x17\xa4\xc4\x3a\xd8\xeb\x1e\xa6\xda\x1a\xa7\xb9\x99\x61\x11\x74\xc2\x16\x9e\x2b\x7b\xd7\x61\xdf\x62\x0b\x27\x9b\x6c\x93\xfc\xc9\x5b\x84\x83\x0a\xcf\x36\x2f\x34\x8d\xd1\x03\x59\x1c\x7d\xcc\x0e\x20\xb7\x49\x6c\x9d\xc8\x7c\x03\x3f\xa4\x75\x29\x6a\xd6\x2e\xcb\xbe\x86\x77\x12\x75\x44\xc9\x8c\x3e\xfe\xe3\xdb\x16\x25\xd3\xec\x0b\x94\xe9\x62\x97\xf2\xf5\xef\x0c\x7b\x3a\x3e\x4c\x4a\xab\x86\xa2\xef\x21\x37\xd8\x78\x41\x60\x2c\x13\x96\x10\x30\x75\x3a\x6e\x77\x2a\x43\xb0\xf9\xba\x18\x5d\x5e\x8a\x66\x8f\x2b\xa6\x71\xf9\xc8\x02\x86\xdc\xaf\x3b\x4a\x36\x8f\xf3\xf0\x6a\x57\xb7\x4c\x20\xba\xf1\xf8\xce\x34\xaa\xf2\x8d\x12\xf5\x45\xcf\x15\xdc\xf0\x29\x0e\x67\x42\x24\xd0\xaf\xa0\xa2\xd8\x14\x15\xea\x1c\xca\xc5\xe0\x20\xec\x13\x49\xd4\x9e\x69\x61\x6a\xf0\x27\x13\xdc\x78\xc1\xa6\x32\x6e\xb4\xe9\x83\xd8\x44\x17\xf8\x72\x86\x42\xd5\x30\x7e\x7c\x7a\x7b\xfc\x5e\xee\x88\x12\x32\xdf\xfc\x0a\xe8\xda\x2a\x51\x6a\xdd\xdb\x42\xe2\x3b\x01\x2a\x05\xe4\xb3\x6c\x07\xea\xbc\xdb\xe7\xd2\x32\x7f\xd4\x1e\x1b\xb1\x7b\x67\xea\xdd\x20\x34\x9e\xcc\xf8\x58\xd8\xc1\x7e\x6b\xa1\x64\xc4\x1c\x14\x9a\xcf\x00\xf3\xc6\x18\x42\xfb\xfa\xe1\xfd\x6f\x9f\x2a\xdf\x7e\xa2\x2c\xfe\x5a\x84\xfa\x94\x11\x40\xfd\x7e\xe2\x2f\x05\x0e\xba\xd8\x3e\x01\x62\xd4\x82\xcb\xad\x1d\x6e\x39\x02\x50\x17\xf9\x81\xce\xa6\xd6\x9f\x31\x6c\xba\xf9\x26\x35\x0d\xa1\x4b\xdf\xa0\xa7\xbd\x25\xf3\xba\x5d\x8a\x3f\xfb\x26\xf2\xcd\xc4\x4d\x65\xd5\x63\x3f\x4c\xd0\xe8\x09\x61\xdb\x01\x30\x7e\xd9\xf3\x5c\xbf\x55\xc3\x33\xc9\xf7\x97\xbb\x37\x97\xca\x7d\xb2\x7b\xcb\xe5\x73\x50\xb3\x08\xde\x57\x98\xc0\xb3\x33\x56\x24\x11\x37\xbd\x76\xd3\xf9\x2b\x1e\x2f\x6a\xe2\xf8\x54\x1d\xed\xe8\x46\x2c\x81\x25\xb8\xcc\xe3\x3c\xed\x3b\xbd\x9c\xc0\xe8\x9d\x03\x93\x25\xb5\xc4\xba\xca\x5b\xcb\x41\x22\xfc\x45\x0a\xfe\xfd\xc2\xd2\x57\x65\xf1\xf0\xee\x8c\x66\x01\xaa\x18\x6a\x92\xc5\x72\xe2\xcc\x53\xc0\xc3\xdd\xf8\x2f\x89\xf8\x2a\x44\x06\xb0\xe9\xd5\x3b\xcc\x50\xe5\x59\x4f\x3f\x9c\x3f\xdc\xed\xf8\xbd\x4b\xd3\x75\x39\x1c\x3b\xd6\x70\x79\x34\xf7\x60\x96\x0c\x74\x0c\xae\x38\xc8\xf4\x37\xe4\xae\x27\xb6\x04\xa5\xad\x40\x2e\xd4\xbe\xeb\x2b\xe4\xf3\xe7\x21\x8f\x69\xd4\x79\x35\x57\x47\x3c\x80\xea\xe3\x89\xae\x08\x2f\xac\x2f\xfe\xe5\xfb\x7d\x80\x54\x97\x08\x28\x1f\x7d\x9d\x0c\x8a\xae\xfe\xfa\x44\x8e\x8c\x53\xa1\xe8\x46\xe3\x8b\x37\x37\x65\xf3\x0d\xde\x24\x1e\x72\x9c\xda\xb3\xad\xa9\x01\x52\x8c\xcc\x65\x76\xa7\xac\x18\x60\xd7\x4c\x4c\x02\xc6\x8e\x50\xfd\x89\x74\xc4\x58\x62\xc0\xd5\x1a\x94\x36\xea\xd3\x26\x09\x5d\x99\xc7\x62\xc2\x33\x11\xf8\x09\xb1\x6b\xf1\x54\xbf\x70\x6e\xe3\xfb\x41\xe8\x53\x68\x38\x45\x85\x80\x4a\xca\x86\x79\x02\x71\x8a\xfa\x7d\xb8\x4f\xc0\x1f\x88\xae\x42\x94\xa4\x37\xb2\x3a\x14\x41\x36\xd9\x78\x44\xf1\xe3\xb3\x15\x96\x2c\xd1\x6b\x8a\x15\x51\xa0\x44\x6f\x8a\xb8\x94\x31\x14\x34\xb2\x8f\x9f\x8b\xc6\x93\xdb\x88\x45\x33\xc2\x1c\xbe\x52\xe8\x8a\xc7\x41\x2c\xd1\x44\xbc\x8c\x7b\x6c\xc7\x9c\x8e\x1a\x72\x7b\xb4\xa5\x71\x84\x49\xde\xb9\x4c\x3c\x2f\xca\x5f\x3d\xca\x5f\xb9\x0c\x54\x23\x89\xbd\x91\xae\x2a\xef\x4d\x1f\xea\x73\x24\x1c\xa7\x7a\x41\x0f\x85\x32\x76\xd7\x84\x01\x59\x6c\x1d\x09\x22\xef\x50\x16\x00\x56\x4b\xb3\x39\x75\x1b\xc2\x0f\x59\xf6\xa1\x51\xbc\x9a\xde\x83\x17\x0c\x7c\xf2\x79\xeb\x6f\x6e\x01\x76\x7d\x63\xe7\x3c\xd7\x0c\x7c\xd3\x4f\xf4\xf6\x7e\x35\x78\xf3\x74\x83\x4f\x1f\x63\x68\x0b\x13\x17\xbb\x8f\x37\x18\xe4\x1d\x69\x2a\x01\xbe\xb7\x0f\x50\x3a\xe4\x39\x58\x77\xa1\x50\x33\xf1\x73\xca\xef\xf0\x99\xee\xf5\x44\x16\x5f\xb7\x8e\x35\xb6\xe3\xb6\x4e\xe7\xea\x75\x78\xe7\xb9\x88\xe0\x43\xcf\x3d\xfc\x2b\xf3\x3d\x55\x5a\xc4\x86\xb8\x2a\x86\x9d\x44\x89\x6f\xc8\x87\x7c\xf7\x2b\x31\x49\x0a\x08\x66\xcd\x1b\xbc\x37\x2d\x5e\x8c\x72\xb6\x58\x47\x94\x67\x48\x50\x12\xfb\xd5\x7d\xc3\x19\xaf\xd5\xe9\x3d\x78\x01\xf9\xf4\xf4\x81\x11\x1a\x1b\x7e\x8f\xf2\x08\x85\x85\x06\x9a\x85\x12\x5b\xfc\xd9\x11\x80\x89\xc9\x2b\x47\x8c\x71\x27\x00\x06\xdc\x69\xb0\x82\x2f\xbd\x79\x71\x21\xe9\x85\xcd\x97\x1c\xe5\x6d\x14\x4b\x1d\x5f\x09\x61\xf5\xe7\x77\xc6\x2f\x78\x79\x24\x0b\xcb\x1a\xfa\x31\x7e\x76\xaf\x9b\x6d\xf1\x74\x03\x9e\xc1\x6c\xba\xb9\x70\xa2\x8c\x4f\x7d\xca\x0d\x83\x1d\x6c\x8d\x24\xe9\x1d\xfd\x48\xc5\xce\x6d\x14\xce\xcc\x70\x51\x45\x3f\x48\x97\xcd\x1a\x4c\xb7\x9e\x65\x97\x27\xe5\x71\x4f\x6d\xc5\x15\x4f\x60\xc5\xc6\x94\x69\xe0\x06\xe4\x44\x73\x4a\x4f\xfb\xf5\xae\x4d\xa2\x3f\x2f\x29\x4c\x75\x33\x93\xfc\x74\x93\xf8\xf4\x03\x8b\x9c\xc8\xf7\x3e\x9b\xc2\x1a\x5f\xc1\xec\x2c\x0d\x1a\x38\x6a\x6d\x73\xa0\xfa\xa5\x6e\xaa\xb1\x51\xa3\x6d\xdd\x46\x56\xb6\xae\xac\x2b\x46\xe5\x79\xfb\xa2\x09\x1a\x69\x7b\x5b\x7c\x0d\xc4\x8b\x02\xb1\xe7\xb6\x1c\xfb\xcb\xd8\xbe\xae\xf5\xc5\x85\xcf\x67\xe4\x14\x88\xcd\x8f\x0d\xaf\x29\x29\x31\xe5\xf1\x41\x4f\xf4\xb7\x76\x0c\x55\xe7\xf0\x1f\x20\xf8\xc3\x96\xf8\xce\x2c\xd2\xa5\x5d\x96\x79\x02\x0b\x80\x60\xab\x6a\xeb\x37\x38\x23\xed\xe1\x42\x4a\x40\xef\xbc\x48\x96\xbf\x64\x04\x67\xd0\x9e\x55\x58\x62\xc8\x9f\xf9\xa6\x88\x54\xc2\x2d\xfc\x77\xff\x8b\x19\x1d\xdb\x27\x7b\x40\x90\x3b\x70\xf2\x8c\xbf\xef\x4c\x98\x16\xba\x47\xab\xba\x25\x56\xb4\x76\xf4\x0c\x91\x9b\x72\x2a\x3e\xc1\xfb\xc4\x07\xa4\xc1\xf3\x5c\xf6\x68\x57\x4d\x41\x29\x00\x57\xfe\x41\x48\x3a\x08\xd6\x6e\xc0\x32\x14\xea\x98\x16\x78\x09\x7c\x62\x18\x8f\xf6\x55\x3c\x86\x18\x67\x5b\x5e\x31\xc2\xf2\x54\x01\xd0\xcb\x76\xb6\xde\x9c\xfe\xde\x09\x99\x21\xfb\xa9\x6e\x7b\x66\x7d\x93\xdf\x9f\x09\xf8\xb3\x53\xd9\xd4\xbd\x6b\x99\xa0\x70\xdd\x5c\x49\x2c\x36\x99\x06\xdc\xa9\xe2\xd7\xad\x48\x7e\x9f\xe3\x77\x3e\x03\x54\xb7\xb1\x82\x0c\x3c\xbf\x19\x2a\x8a\xfb\x2c\x2f\x93\x7c\xad\x60\x0d\xdf\x1a\x7f\xa0\xb2\xd9\x8d\x84\xb9\x4e\x1e\x1d\x5f\xfc\xdd\xea\xf1\x7b\x24\x7e\x3a\xb4\x54\xca\x79\x99\x7f\x44\xb2\x3f\x7f\xd3\x1d\x41\xf4\xad\x4d\xcf\x58\xf3\x7e\x4a\x29\x57\x40\x3c\xc9\x43\x1d\xed\xc7\x47\x35\x24\x96\x99\x7d\xff\x34\x3a\xc2\x53\x63\xd4\x53\xcd\xd7\xca\x7d\x5d\x84\xa0\x93\x80\x0b\x84\x30\xc6\xef\x7a\x22\x44\x1b\xb1\x9f\x14\xd1\x5b\xca\x3c\xa6\x55\x65\xc3\x3e\x8d\x26\x15\x04\xd7\xf4\x40\x55\x1d\x9c\xc3\x35\x43\x92\xbc\xb4\xd9\x04\xfb\xa8\xa4\x50\x02\x13\x32\xb4\xb8\xed\x4f\x9e\x25\xbf\xf9\x6b\x54\x87\x60\xc5\xf0\x4e\xde\x04\x51\xd0\x1e\xa8\xa9\xc6\x62\xa7\x48\x3a\xb4\x67\x68\xf8\x59\xe5\xa7\x14\x90\x1a\xc8\x69\xc9\xda\xc5\x64\x75\x7b\x9c\xe1\x65\xcb\x3c\xd1\xb9\x14\x9e\x12\x75\xc3\xfe\x64\x7a\x4a\x4a\xfc\x1a\xf3\xc0\x37\x33\x4f\x03\x21\xed\x06\x85\xb4\x9f\xf7\x51\x27\xa6\xa6\x8b\xef\x97\x99\x95\x21\xe8\xd3\x68\x8f\xf4\xd4\xa8\x18\x88\x38\x01\xe6\x2d\x8e\x77\x58\xc3\xbd\x6e\x1e\xd5\x13\x25\x78\x27\x3f\x90\x4c\xaf\x27\x4c\x62\x74\xe4\xb1\x45\x11\x17\x10\xcb\x5c\x15\x97\x4d\x99\xd8\x74\x03\xa0\x75\x3e\x22\xb7\xe2\x81\x5e\x2a\x50\x35\x35\x9d\xd6\xaf\xf5\x08\x81\x53\x7a\xf6\x5d\x7a\x1a\x86\xcf\x7e\x55\xcd\xa6\x11\x4f\xd7\x26\xd9\xb7\x65\x8a\xbb\x65\xc8\x38\x44\x11\x9f\xa8\x8f\xea\xd4\x3f\x6a\x11\xb6\xa9\xb4\xa1\xcc\x74\x43\xb0\x7d\x9d\x0d\x9f\x44\x61\x32\x2b\x87\x8a\x02\x32\xfd\x00\x7f\xb6\x77\x89\xce\x31\x6f\x7e\x0c\x16\x79\x4c\xc9\xe0\xe4\xb6\x45\x9d\x34\xe3\x05\xfd\xfc\x7e\x16\xf8\x84\x80\x42\x9b\x24\x01\xc3\x3f\xe6\x00\x03\x74\x39\x45\x65\x80\x4f\x32\x0d\x00\x26\x75\x81\xca\x6b\x3c\x01\x25\x67\xa9\x6f\x65\x42\xeb\x1a\xb6\xec\x8f\xf9\x2a\x76\xef\x32\x36\x3d\xba\xde\xf8\x63\x4a\x17\x14\xd9\x12\x28\x95\xa7\xe8\x65\x3e\xe1\xff\x6c\xf9\x7a\x5f\x05\xd0\xcf\x4e\x80\x60\x4e\xaa\x5d\x3b\x85\x1c\x96\xcc\xfd\x83\x76\xd4\x78\xb6\x16\xb9\x2a\xe1\xf3\xc2\xf2\x37\x77\xce\xc8\xb0\xee\x5d\x77\x28\x51\xf6\x85\x6b\x57\xb8\xd5\x63\x1a\xb6\x1a\xf1\x5f\xfe\xc9\xdc\xfe\x86\x2e\x06\xa9\x96\xb8\x8c\x66\xb2\x8c\x75\xe1\x97\x8b\x47\x1f\xd1\xef\x1b\x23\x0a\x7b\xa4\xbc\xf1\x45\xb4\xbb\x6f\xf1\xca\x1e\x63\xc2\x94\x5e\x22\x34\x01\x3f\xf5\x8e\x08\x7c\x71\x58\x1b\xa7\x36\x8e\x5f\x1a\x8c\xb8\xea\x13\xab\x6f\xbe\xe0\xf9\x39\xbd\x92\x39\x45\x57\x27\xf2\x49\x71\x5d\x3f\x06\x44\x39\xb6\xb6\x13\x31\x9e\x6b\xd2\xba\x5f\x97\xdb\xf8\x0b\x6e\xc4\x61\x90\xe1\x81\x53\xb0\x9d\x74\x51\x4f\xb5\x5f\x2b\x77\x89\x85\x10\xf2\xef\xce\xbd\x49\x70\x0d\xc2\x03\xb5\xad\xb0\xf1\xf7\x4f\x1e\x81\x06\xe3\xa0\x90\x53\x7a\xd0\xf0\xb8\x21\x95\x17\x8f\x56\x78\x85\x85\xd8\xa7\xa9\x2a\x9f\x7e\xfd\xfe\xf8\x43\x1f\xd0\xec\xab\x25\x7b\x83\x57\x5f\x83\x28\x02\x91\xfb\xf2\xc3\x09\x7a\x58\x43\x43\xb8\x88\xa9\x3d\xea\xef\x30\x4c\x22\x29\xc0\x8b\x37\x2f\xf3\xdf\xbd\x75\xa7\x29\x0f\x62\x93\xca\xf5\x64\xcb\xbe\xdb\xd8\x47\x31\xb7\xfc\x94\xbf\x39\x9d\x54\x2a\x44\x54\xd4\xf9\xb9\x81\x0f\x11\x8d\xc6\xd9\x17\xa3\x0a\x8d\xbf\x3a\x69\x1e\xb0\x0d\xb5\xc7\x17\x36\xc3\xb0\x42\x07\x11\xaf\x3b\x24\x41\xeb\x49\x63\xbb\xed\x83\x66\xc9\x6a\xa7\xc4\x9b\x6e\x9d\x5a\xc2\x1f\x8d\xff\x75\x63\x36\xf4\x03\x82\xdf\xbb\x7b\xe3\xf5\x27\xc8\x0e\x76\x58\xf7\x6e\x52\x22\x32\x0c\x57\x63\x1f\xbb\xb9\xbc\xe3\xc1\xe6\x2c\xec\xa3\xf7\x20\x45\xac\xaf\x5d\x1f\x8a\x75\xf8\xc9\xad\x11\x22\x8a\xc6\x5c\x64\xbd\x38\xd1\x0f\x0e\x51\xa9\x8e\xd1\x6a\x14\xf4\x66\x76\xc4\x34\xa8\x6e\xae\x99\x81\xb9\xf1\xc9\x6e\xb8\x18\xc3\x60\x0d\x07\xdd\xcf\x86\xbe\xf9\x61\x8f\x3f\xa0\x1d\xd1\x68\xc6\x13\xd9\xcb\x7e\x73\x94\xd6\xd3\x5b\xb4\xfc\x31\x26\xef\x8b\x09\xd3\xb7\xd1\xf2\x31\x6e\x19\xc7\x65\x70\x95\xbd\x11\x65\xce\x9e\xed\x94\xf9\x5b\xf9\x0e\x70\xb7\xf4\x2c\x61\x77\x7e\x79\x62\x26\x28\xc7\xf2\x3b\xa3\x24\x7d\xec\x28\x51\xaa\x22\x2f\xa8\xd7\xbb\x65\xa5\x10\x97\x3a\xdb\x40\xc5\xe8\xa1\x9f\xbd\x9f\xd3\x88\xb0\x3f\x20\x34\xfe\x4a\x3f\x4b\xf6\x3b\x2a\xa7\x3e\x06\xaa\x5e\x39\x15\x41\xbd\xfe\x16\x28\x45\x65\x66\xf5\x9b\xe7\x9c\x92\x2f\x9f\x88\xd6\x8c\xee\x78\x09\x01\x99\xd1\x0c\xc8\x37\xf3\x84\x1f\x2f\x65\xf0\xd3\x2f\xb0\x3a\x29\xf4\x6b\xb7\x5f\x9b\xc6\x2d\xcb\xba\x90\x7d\xfe\x57\x03\xfe\x37\xce\xb5\x51\x2f\xb8\xb8\x98\x84\xf3\x08\xbe\x21\xae\x83\x55\x62\x11\x84\x83\xd1\x97\x7b\x94\xd8\x2a\x15\x64\xdf\xdf\x5f\x4b\xe8\xf2\x08\x4f\xbd\xa0\xf0\x62\xe3\x94\x98\x33\x48\x99\xe9\xde\x8e\x2c\x16\xd5\xe0\x1a\x15\xf1\x86\x22\x0c\xdd\x10\xb3\xbb\xd0\xfa\x81\xe7\xa8\xce\x5a\x5f\xc5\xac\x4c\x3e\x80\x0b\x00\xfc\xb4\xd4\x78\x8f\x00\x1f\x9d\x20\x38\x7c\xc2\xed\x2e\xbd\x42\x04\xed\x8b\x4f\x8c\xe3\x6b\x7c\x95\xe7\xb3\x23\x54\x9b\x27\xc8\xf7\x47\x84\xdd\x2c\x40\xff\xe5\x3c\x99\x2d\x7d\x85\xf4\x2b\x04\x8e\x45\xa2\xac\xe6\x41\x13\xc3\x7e\x60\xea\xe4\x23\x78\x37\xf9\x84\x3d\xf5\x0f\x6c\x82\x60\x8e\x06\x14\xb5\x9c\x1d\x6e\xfd\x04\x2a\x54\x71\x55\xf7\xe9\x33\x88\xb7\x65\xd5\x8a\xba\xe5\x9f\xef\x34\xcc\x44\x29\xd5\x0a\x99\xed\x77\xba\xcf\x96\x5b\xa0\xe4\xbe\x86\x0b\x50\x23\xd4\x0c\x4e\xc4\xb1\x1e\xa8\x6e\x92\xc4\xe9\xce\xbd\x40\x58\x30\x54\xb0\x4e\x26\xfe\xbc\x43\xf6\x68\x75\x2e\xe3\xfd\x91\x84\x2b\xf0\x45\x55\x8c\x28\x2b\xb9\x09\xf4\xe6\x37\x2b\x77\x8b\x3e\x70\x0f\xcc\xf5\xdb\xcf\xe8\x02\x93\x26\xf6\xc2\xf3\xc2\x75\xb3\xee\x7e\x61\x08\xdf\x76\x41\x29\xb1\x03\x2c\x75\xaf\x9e\x18\xd6\xe9\x40\xc4\xb7\x80\xd3\x5f\x50\xf7\x40\xc4\xe0\x0e\x58\x3d\x4d\x32\xe7\x25\x69\x00\x0b\x63\xa4\x0c\x75\x18\x41\x22\xd2\x9d\x6a\x9f\x04\x65\xa1\x90\x6f\x02\x22\xf7\x6f\xd1\x46\xdf\x9c\xa3\xb9\x6f\x22\xdb\x57\xa9\xbc\xf5\x24\x29\xf0\xce\x4a\x51\x3f\x7f\x84\xdb\xf8\x70\x99\x83\x25\xe6\x0f\xa6\x76\x11\xca\x75\x4d\x6c\xd2\xb9\x4b\x08\x25\xeb\x45\x3c\xe3\x67\x84\x10\x36\x8b\x35\xce\x74\x39\x7e\x60\x10\x62\x5c\xce\xf5\x74\x97\x7a\x42\xed\xa6\xf7\x7e\x82\x09\xb2\xf8\x8d\x24\x14\xb2\x48\x20\x1e\xd0\xae\x0b\x01\xa4\x34\x00\xe8\x1d\xd9\xae\x65\x9a\x9f\x68\x5a\x04\xa1\xe0\x1e\x13\x4a\xb1\x6a\x71\xa0\x09\x56\xda\xc0\xa7\xc6\x38\xde\x56\xbb\x1a\xb4\x6f\x2c\x15\xaa\x71\x78\xdd\xed\x6e\x73\x90\xc2\x30\x0a\xa8\xaa\xbd\x5e\xf9\xbc\x48\xa3\xb8\x5f\x8b\x03\xc9\xa2\x7d\x9d\xee\xb0\x53\xb8\x84\x90\x7a\xce\x14\x66\x03\x3f\xc1\x91\xa9\xb1\xdc\x62\x5c\xd7\xf0\x21\x1a\xec\xaa\x9a\xc3\x80\x25\xf6\x04\xd8\xfc\xba\xfe\xa7\xbc\x7e\xb8\x3b\x42\xb3\x60\xea\x67\x6c\xab\x14\xba\xf4\xc3\x1b\x80\xfc\xca\x2d\x1d\x0f\x22\xb0\x16\xd8\x4d\x51\xe6\x27\xef\x6c\xf9\x97\x02\xa2\x40\xf8\x23\x34\xfd\x22\xd3\x05\xe3\x6b\x25\xdf\x14\x24\xd6\x42\x17\x58\x9a\x23\x82\x3f\x9e\xb2\x87\x57\xdb\x30\x53\x5f\x5b\x72\x54\x62\xc1\x68\xa1\x11\xf2\x4e\xf5\x22\xd5\xa7\x81\x7b\x03\x6c\x24\xf6\x8f\x3b\xd6\xcc\x3b\xe7\xdb\xdc\x0f\x37\x84\x46\x5d\x58\x2c\xa8\xe1\xd9\x56\x6d\x1f\xde\xac\x11\x93\x2f\x78\x67\x6c\x0e\x7c\x4c\xf4\xd3\xe0\x1d\xb1\x24\x12\xa0\xf9\xf2\x33\x8e\xf7\x4b\x83\xe0\x78\xd7\x3f\xf6\x78\x9b\x50\x3f\x6d\x35\x39\xaf\xb5\x76\x21\x4a\xf4\x73\x18\xd2\x10\xf6\x8b\x13\xa2\x0f\x05\xda\x27\x4a\x6e\xb6\x26\xb4\xe1\x93\xbe\xb0\x54\x92\x07\xff\xdd\x36\xe5\xab\xb4\x4c\x67\xaf\x3d\xdd\x52\xb9\x6b\x6e\x2c\xf8\x9c\x84\xf5\x9d\x48\x1e\xb9\x50\xca\x17\x58\x01\x3e\x2c\x0d\xfd\xec\xe6\x70\x67\x77\x0e\xf4\x5d\x2b\xf6\x0b\x9c\x15\xb1\x0a\x35\x66\x60\xd8\xe3\x25\x7c\x2b\xa8\x2f\xf9\xfb\x27\x6c\xe6\x6f\xea\x4a\xa3\xe6\x73\xf1\x35\xce\xe2\xac\x9d\xf4\x08\x64\xc9\xee\xeb\x40\x3d\xd0\x8f\x1f\x88\xd6\x77\x80\x9d\x56\xee\xca\x4d\xa0\xb4\x4b\xdd\x27\xe9\x8c\x06\x80\x6c\x8f\xde\x2e\xe7\xfb\xb5\xc8\xd8\xda\xb8\xe7\x19\xce\xae\xdc\x90\x39\x0e\xf0\xc3\x87\x2c\x5b\x14\x20\x7b\x04\x92\xce\x4e\xe2\xa0\x43\x9f\x54\xc6\x89\x2d\x1f\x62\xbd\x71\xbc\xd1\xe2\x40\x61\xbc\x8c\x5b\xcc\xc2\x33\xec\xd0\xbf\xe6\x08\x26\x82\x2c\xa9\x9f\x68\x0d\x77\x7b\xf4\x31\xb3\x4a\x99\x63\xf3\x85\xdb\x59\x04\x27\x50\x99\xa5\x7b\xc7\x35\x41\xd8\x1a\x32\xa2\x99\x23\xf8\xfa\x5b\x9e\xf1\x4f\xff\xc6\xf8\x4c\xd9\xa3\xc6\x7a\x78\xb1\xf2\x6c\xa6\xf2\x4f\xb3\x05\xa1\xb0\xe2\xd7\x16\xca\x6c\xed\x84\xb6\x05\x81\x93\xd4\x94\xe8\x7e\x33\xfb\xa9\x29\x1d\x85\xab\x83\x53\x19\x5f\x98\xec\xdb\xb6\x5c\xf1\x76\x13\x1a\x23\xe4\xe3\xcd\x9a\x69\x7b\xae\x4e\x1c\xc8\xb8\x87\x7b\xb8\xfc\xd7\x54\x61\xb2\x0d\xf9\x98\x50\xa1\x57\x77\x90\x7d\x8b\x4f\xc9\x14\xd4\x7a\xe9\x6c\x0f\x01\x9f\x9f\x57\xc1\xdd\xc6\xd5\x01\x0d\xb1\x79\xac\x71\xa2\xdf\x58\x87\x9f\x74\x76\xac\x2e\x2a\xbf\x02\x62\xd3\x2d\x56\xd5\x96\x7d\x43\xd6\xda\x65\x00\x56\x92\xb5\xdc\x24\x0e\xb4\x53\x9c\x0e\xdb\xe0\x01\x12\x11\x46\x16\xe5\x19\xc3\x14\xa6\xe3\xd9\x5d\x6a\x1f\x73\x68\xe1\xea\x70\x54\x24\x65\x3f\x4f\x13\x4a\x5f\x17\x36\xa7\x4e\xb3\x37\xc5\x75\xb4\x97\xf2\x7f\xc3\x8b\x56\x85\xbf\xa6\x8b\x5d\x4c\xa8\x1d\xfb\xea\xc3\x24\x5e\x5f\x52\xc4\x58\x34\xb2\xc2\xef\xfc\xb6\x81\xe8\x3a\xc1\x3e\xbc\x9f\x6c\x90\xd8\xe9\xc2\xb2\x36\xf8\xcd\x0b\xef\xd1\xfa\xeb\x4d\x4b\xad\xd8\xe8\xbf\xa6\x04\xb1\x8f\x2e\xb2\xa5\x27\xe7\x2a\xbc\x87\xd4\x4e\x2d\xd4\x7f\xc5\x53\x71\x29\x0e\xd0\xbd\x6e\xf7\x63\x73\x92\x22\xab\x74\xc8\x98\x71\x00\xeb\x8c\x75\x7c\xe5\x64\xd7\x19\x36\x94\x6c\x8d\xb8\xd7\x09\x00\xab\x03\xfd\x34\x40\x1c\xbc\x2d\xe9\x42\x39\x3c\x80\x79\x6d\xf4\xb7\x26\xd5\x10\x72\x6a\xa1\x06\x3f\x44\x1b\xed\x18\xba\x95\xb6\xfc\xe3\x99\x28\x0e\xa0\x5c\x2e\xab\x71\xdd\xbc\x86\xcb\x5b\xee\x44\x55\x21\xea\x1d\xc6\x48\x37\xb8\x60\xb5\x21\x07\xfa\xeb\xbd\xdf\xb8\x5c\x6e\x52\xf7\xd5\xbf\x46\x2c\xa3\xd4\x11\x2b\xfc\x3a\x13\xbe\xf8\xeb\x2d\x26\x5c\xde\x06\xc3\xf6\xa4\x28\x92\x8f\xea\x65\xf0\x0e\xc4\xb2\x99\xf5\x9d\xbe\x32\x23\x75\xaa\x50\xda\x5f\xf9\x7b\x83\xcd\x0a\xa5\x10\xf0\xd7\x3d\x1c\x68\x96\x18\x96\x89\x05\xa5\x1d\x9f\x16\x20\x19\x03\xd0\xb2\xf8\xa5\x1f\x8e\x4d\x36\xa0\xfd\x06\xb0\x98\xc0\x3b\x67\x55\xf6\x98\x94\x45\x14\x40\x4e\x23\x02\x8c\x7d\x2f\xba\x22\x2b\x7a\x4f\x6c\xcc\xc2\x25\x01\x57\xc8\x9e\x5b\xf3\x9f\xac\xa3\xdd\x20\x14\x2c\xdb\x75\xb4\xc6\x3f\x50\xf2\xe6\xfc\x52\xd9\x22\xdd\x4f\x4f\x34\x51\x67\x26\x6c\x01\x53\x9c\x67\x98\x20\x8a\xc1\x76\xed\xf1\x9d\xfa\xef\x91\x63\x8d\x38\x27\x08\xdd\x0a\x32\x03\xf9\x04\xa2\x88\x6d\xab\xc1\xa0\xfc\xe5\x7e\x52\xd3\x37\x6f\x02\xd9\x2e\x56\x15\x3e\xa4\x43\x29\x35\xa2\x4b\xff\x28\x52\xa5\x42\x4a\xf7\x81\x7a\x4a\x3b\x5d\x5c\x28\x19\xcf\xe0\x18\x32\x4f\x68\x93\xe8\x3b\xd9\x27\xad\xc9\x54\x2a\x13\x54\x7d\xf2\x5f\x4f\x22\x51\x5e\x7a\xfc\xf5\x0f\x67\xbe\x16\x64\x61\x02\xdf\x0f\x4c\xff\x3a\xd1\x84\xcc\xfb\x51\xd5\x2d\xa2\x50\x4e\xda\xb6\x76\xca\xa7\xc6\xad\xbb\xfb\x38\x66\x4a\x78\x89\xd7\x7e\x38\xe1\x54\x24\xa1\x49\x3e\x1c\xf7\x9d\x91\x62\x78\xdd\xa9\x0e\xfe\xc2\x27\x5b\x4c\xe1\x44\x98\xdb\x0f\xad\x4e\xf4\x08\x12\xd4\x25\x1b\x6a\xe9\x47\x1e\x9d\x1f\x42\x12\xb7\x42\xb8\x25\x77\x4a\xb0\xfe\x47\x9e\xf4\x8b\x13\x21\x96\x7a\x52\x38\x96\xab\x09\xfd\x2e\x16\x2c\x8d\x8d\x97\x8a\x3c\x28\x1b\xe6\x85\x3e\xde\xac\xf1\x3e\xba\x01\x60\x5f\x9b\xc1\x64\xd9\x17\x10\xb3\x4b\xd6\xb3\x2b\x16\xa0\xfa\xa9\x97\x65\xba\x83\x7e\xa6\x4d\x92\x8e\x33\x7c\xef\x43\x99\x3e\x31\x4a\x81\x27\x7b\x6b\x63\x4d\xe3\xe5\x87\x3d\xfb\xcf\x0c\x93\x10\x39\x0f\x54\xd0\x38\x58\xc5\xe9\x75\xab\x7d\x95\xaf\x60\xd8\x1b\xae\x62\x58\xf1\xf3\x51\x60\xd2\xbc\xb0\xa4\xd6\x79\xe9\xf6\xce\x38\x2a\xd8\x80\xca\x22\x0a\xee\x56\xc0\xa9\x4e\x72\x56\x7f\x24\xb0\x38\xd5\x21\x1d\x92\x51\x02\xe7\xeb\x03\x13\x00\xf8\x52\x0b\x07\x57\xfe\xcb\x43\xec\x57\x08\xbc\x32\x08\xd3\x88\xed\x7d\xbd\xb4\xb7\xe8\xf7\xdc\xf3\x92\x0d\x81\x05\x7b\xfb\xc7\x83\x70\x9b\x77\xf7\xdf\xa8\xee\xc1\xb1\x0c\xa2\x13\xf5\xf0\x16\x67\x07\xeb\xde\x75\x7c\x43\xe1\x99\x16\xad\xd9\xd0\x4f\x1a\x3a\x90\xc9\xcb\x96\x1a\xeb\x3d\xad\x7a\x01\x2e\x75\x40\xe4\x9c\xe8\xb1\xbc\x2a\x68\x77\x7b\x01\x04\x20\xa0\x16\xb6\xfe\xa5\x01\xfd\x6c\x4b\x83\xcc\xa2\x74\x30\x87\xd3\xfa\xf0\xcf\x24\x74\xec\x4f\x19\xb9\x9e\x04\x79\xaa\x31\x4f\xfd\x26\xa3\xf8\x8b\xff\x41\x58\xc4\x24\xd0\x69\x80\x8f\x6d\xe0\x81\x17\x94\x10\x13\x5b\xe7\xf6\x69\x19\xcd\xdd\x53\xb1\xe9\x2f\x20\x96\x15\x6d\xb9\xe0\xde\x7c\x1e\x08\x02\xba\x35\x24\x40\x0f\x2a\x44\x56\x7b\x3c\x35\x7b\xe8\x18\xb1\xc2\xd5\x59\x84\xcb\xe4\xeb\xe4\x5b\x41\xf8\x54\x30\x6b\xd4\x2f\xe8\x67\x47\xcb\xc9\x87\xb2\xf6\xe1\x9f\xaf\x4e\xb0\x4c\xc6\x67\xbf\xb5\x09\x99\xaa\x74\x59\xe2\x35\x89\xcb\x76\x71\xe4\x35\xd5\xab\xfc\xbd\xb1\x55\x9f\xd2\xea\x80\xb8\x11\xc2\x7f\x13\x2c\x83\x15\x20\x95\xf8\xd5\x24\xb7\x4b\x6b\x69\xc4\x66\x41\xf9\xca\xe1\xd2\xaa\xdd\xfd\xeb\x59\x7d\xeb\x4d\x94\x8f\x05\x34\x2a\xdc\xf7\xe6\x8f\xed\x4e\x11\xbb\x90\x6a\xca\x6b\xf1\x1a\x3e\x2c\xff\x61\xba\xfb\xe0\x1a\x87\x66\x04\xcf\x53\x1c\xce\x20\x4a\xdb\xd9\xa6\x4c\x72\x37\xd4\x18\x5c\x6a\x31\xa3\xa4\xda\x15\x8e\xf1\xb8\x41\xfa\x6e\xce\x49\x9c\x8d\x4a\x11\x71\x88\x97\xfc\x72\xcc\xb3\xe6\x41\xc5\x09\x11\xb6\x1c\xf1\x13\x21\x35\x5b\xac\xc3\xda\xfc\x24\xaa\x24\x52\x60\x05\x37\xac\x47\x57\xc7\xf9\x36\xd7\x95\x43\xf4\x4e\x63\xe0\x4f\x7f\xa4\x9b\xb4\x55\x4b\x41\x85\xcd\x6c\xe5\x6a\x4a\xe7\x0d\x18\x04\x0e\x40\x2f\xae\x39\xeb\x85\x95\x6a\xe4\x0f\xa4\x09\x85\x9b\x90\xf8\x9a\xbc\x53\x95\xc8\x5a\x71\x52\x6a\xa6\x56\xf8\x31\x45\x0a\xc3\xaf\xfb\x5a\x46\x49\xba\xb7\x46\xdf\xb5\xe4\x7c\xd3\xbf\xf7\x03\xf8\xdd\xce\x36\x40\x24\xd7\x93\x20\xc9\xf5\x44\x32\xfc\x18\x39\x5e\xe6\x39\x32\x44\x03\x7d\xf5\x42\x8f\x6b\x5e\x51\x44\x55\x32\x72\x45\x5a\xd0\xff\xe8\xf1\xf5\xfc\x18\xad\x0d\x54\xc8\x1a\xee\xe2\xa5\x1a\x78\x43\x2d\x8c\x1e\xa1\x3b\xf2\xe3\x67\xd7\x8a\x36\x4e\x83\x2a\x3a\xb5\x20\x49\x60\xf5\x6b\xf5\xe7\xa0\x2e\x70\x31\x9f\x22\xb1\x3d\x5e\x76\x16\xbb\x3a\xe9\xbd\x3b\x06\x28\x3c\xe3\x54\x29\xd3\x81\xc5\x4b\x5c\x21\x96\xfe\xe6\x20\x22\xa1\x56\xa4\x73\x3f\x6b\x85\xe6\x33\x09\x6f\xa0\x22\x16\xd9\x04\x16\x05\xc3\x49\xf4\x09\x64\x70\xd0\xb2\x65\x1f\xd1\xfa\x6f\xc2\x75\x46\x07\x99\xec\xb2\x87\x00\x58\x52\x43\x5a\xb8\x72\x8d\x95\xa2\xa0\x3b\x2e\xf7\xd3\x81\x2a\x0d\xa9\x6b\xdd\xe5\x23\x48\x16\x04\xbb\xdd\xeb\x96\x80\x7f\x09\x1f\x37\x96\x07\xee\x45\x2a\x23\xd6\xed\x66\x25\x5c\x3d\xd9\x57\xef\x69\x1b\xff\xc1\x00\xf6\xdb\x33\x2d\x67\xf0\xc6\x2b\xbd\xf4\xcd\x34\xb8\xc5\x15\xd6\x1d\x3c\x39\xe4\x44\x3a\x03\x7f\x83\x77\xc8\x6f\xb6\xbe\x2a\xbd\xf9\x5a\x97\x5a\x7a\x95\x3d\x22\x44\x51\x19\xd1\xbd\x9f\xe8\xa8\x1c\xba\x86\xc9\x87\x6d\x12\x7b\x09\xe6\x2c\x73\x95\xed\x0e\xe8\xbc\xfd\x32\x0c\x7a\xdf\xba\x50\x67\x3c\x56\x1a\x3a\x2a\x74\x20\x39\xdc\xf9\x2f\xf7\x2c\xcf\x8d\xd6\xf2\x51\xe5\x12\xaa\xba\x90\x95\x00\xaa\xa3\xd9\x9c\x96\x13\x18\xff\x67\x04\xbe\x3a\x7a\xac\x50\xcc\xf7\xf2\x9c\xf1\xf9\xeb\xfd\x35\x59\x24\x76\x50\x40\x94\x6b\x9b\xd4\x7c\x66\x28\x15\x05\x9b\x7e\x30\xf2\x97\x15\xd3\x5e\x3b\x65\xd2\x9d\xda\xdd\xc3\x0b\x9b\xba\x21\xbc\xeb\x7c\x21\xda\x8e\xf9\xb4\x04\xe5\x7b\x85\x01\xd0\x1d\xf7\x5e\x7a\x61\x1a\xac\x20\x99\xbf\x6e\x54\xc6\xf0\xc8\x64\x59\x15\xa7\xaa\x97\x02\xcd\x13\xfd\x5c\xaa\xfe\xdd\x51\xf0\xfb\x6e\x86\x47\xe3\xd1\x9a\x2e\x5c\xda\x47\x17\x8f\x31\x20\x84\x97\x9f\x83\xfc\xba\x38\x9b\x32\xda\x6c\xe3\xcb\x63\x23\x18\x0f\xfc\x86\x5c\x6a\x45\x75\x3b\xbf\xed\xa9\xab\x64\x50\xe4\x47\xdb\xd9\xce\x48\x77\x9c\xaf\x1b\xac\x3c\x93\x7e\x89\x64\xed\x23\x8e\xaa\x3d\x27\xb1\xa5\x4c\xdb\x57\x07\x9a\x22\x71\xff\xb4\x58\x24\xdb\x97\x47\x95\xe6\xeb\x3c\x5a\x63\xa3\x24\x3e\xe9\x8a\x52\xb3\x82\xf4\x1b\x34\xd1\xcb\x27\xad\x8e\xc1\x99\x47\x8a\x96\x7f\x79\xb0\xf6\x01\x69\x78\x76\xc0\x77\x70\x1e\x15\x42\x52\x5d\x02\x86\x31\x19\x54\xb0\x7a\x71\x83\xfc\x6e\xd7\x16\xe9\xa9\xf5\xc1\xbb\x40\x99\xb4\x67\x3b\xa3\x0f\x61\xf8\x53\xf1\x63\x86\x88\x8d\xa6\xd1\x46\x77\x8d\x2e\x55\x39\x13\x22\xec\x4e\x01\xc2\xcf\xf7\xf1\xde\x09\xa8\x6a\x17\xbb\x3c\x96\x15\x46\x12\xa7\x7f\x45\xe9\x25\x19\x73\x19\x31\x1f\x49\x1a\xa6\x8c\xe0\x7a\x10\x4a\x0d\x9f\xb9\x2d\xfc\xbc\x50\x91\x75\x02\xd4\x5e\x51\xf6\x76\xa9\x6e\xe6\x18\xd3\x27\xab\x9c\x03\x01\xf4\x3c\x51\x12\x11\xe0\x19\x22\x2a\x75\xc9\x6e\x52\x68\xe8\xd7\x40\xcd\x1b\x5c\x89\xa9\xa6\xbc\x9a\x0a\xdb\x5f\xef\x83\x81\x29\x6e\x5a\x74\xdd\xeb\x4e\x17\x01\xe7\x2e\x8d\xe2\xe2\xac\x23\x0a\x1e\xaa\x0d\xd1\xe5\xb6\xb4\x71\x41\x50\x3c\x0d\xd1\xc3\xf5\x90\xe3\xfe\xa7\x31\xaf\x30\xac\xea\x0a\x62\xc0\x3e\x47\xd2\xcd\x0f\x55\x9c\x41\x66\xda\x69\xfc\x5b\x07\xd0\xec\x93\xe0\xaf\x6f\xb2\xb1\x48\xb2\x38\x02\x56\xca\x0d\xa6\x2e\x3c\x5b\xd3\x08\x51\x24\x26\x03\x2b\x8b\xc5\xd7\xd8\x97\xfd\xce\x9c\x87\x93\x04\xf0\x31\xaf\x4d\xe5\x76\xb1\x42\x26\x53\x9c\x7e\x86\x4e\x08\x5a\x1d\x81\x68\xfa\xce\x5b\x00\x5c\xd2\x01\x6c\xdd\x8a\xd7\x6a\x8e\xef\xc6\x2e\xf9\x3a\xe6\x84\x6f\x8e\x98\x22\x05\xdc\x96\xd5\x40\x2c\x9a\x7a\x7c\x15\x60\x64\x08\x4c\x27\xe7\x52\xfa\x1a\x80\x6f\xd6\x11\xf1\x28\xae\xa6\x4f\x9a\xfa\x7a\x80\x43\xb5\x0a\xc6\x9f\x1b\x7d\x40\x1f\x6f\x7b\xe5\x98\x54\xbe\x30\xc0\x9c\x84\xe4\x0a\xd5\x54\x1b\xc5\x45\xdb\x12\x7f\xba\xbf\x4a\xbd\x23\xb4\x94\x9a\xa2\xc2\xe9\x74\x2e\x09\xe9\x4f\xf6\xf4\xab\x0f\x4f\x9c\x4a\x04\x02\xe3\xe3\x28\x8d\x3c\x5d\x2a\xe1\x87\x3f\x6c\xf8\x3a\x60\x07\x87\x7c\x4c\xc2\x42\x4f\x6a\xe1\x5c\xe7\xfc\xb1\x6f\xce\x9b\xa5\x72\x63\xf4\x14\x59\xc1\xf3\xea\xf4\x2f\x21\x6e\x9b\x2f\x96\x49\xec\x92\xe6\xb3\x71\x04\x28\x4a\xfa\x10\x5d\xca\x70\xe9\xbf\x40\x21\xba\x41\xd9\xee\x5f\x4d\x6a\xa7\x55\xfd\xe1\xc3\x6a\xf0\x7c\xfc\x8c\x77\x21\x0c\x2e\xdd\x4c\x26\xa9\x78\xbc\xa9\xdf\xdc\xe2\x6a\x7d\x26\x23\x32\x7f\x9e\xa7\x97\x23\x4c\xa2\xed\x43\xf4\x22\xc5\x24\xcf\x24\x7c\x8f\x9c\xb4\xf4\xeb\xa8\xb7\xd7\xa1\xd7\x49\xd0\x2b\xa8\x3a\xda\xe1\xfc\xd9\xe5\x1c\xdd\xb1\x92\xae\xa5\x61\x5e\x12\x29\x01\x28\x5d\x7e\x71\xea\x90\xc1\xd3\x3e\x3c\xb1\x81\x88\x8a\x06\x0a\xca\x14\xd3\x76\x33\xc7\x2d\xfa\x98\xe3\xe4\xfc\xd6\xc7\xd9\x7f\x9b\xe9\x95\xfa\xe4\x05\x4d\x7f\x5e\x96\x69\xa1\xed\x44\xff\x61\x70\xe2\x43\xe7\xb5\x19\xe4\xd8\x1b\x48\x16\x18\x34\x9c\xa8\x55\xf2\x82\xd1\x97\x1f\xda\xe3\xfb\xc0\xf9\xd9\x69\x32\x13\x83\x1e\xca\x60\xa3\x04\x91\x7b\x4d\xa5\xd6\xe1\xc5\x9f\x6f\xc0\x33\xb5\x11\xeb\x3d\xb6\x35\x68\x2a\xb6\x9a\x88\x55\x56\xb6\xd9\xb0\x1b\x86\xbe\x10\xcc\x49\xcb\xd5\x9b\x9e\x97\xb1\xcd\xa6\x91\xf5\x4d\x40\x16\x52\x85\x0d\xfa\xd0\x18\xa5\xf1\x8e\xe0\x52\xd0\x8f\xee\x39\xdc\x14\xc0\xc1\x1b\xe3\xb1\x9a\x34\xac\x5f\x32\xe2\xf1\xd2\xf4\xee\x9f\x7c\x8d\x35\x7d\x5e\x15\x79\x6c\x08\x9d\x5a\xa5\x41\x81\x53\x83\x06\xdf\x02\xd6\x96\xef\x67\x2e\xee\xd3\xb0\x38\x63\x45\xfc\x7d\x07\x38\x45\x4a\x57\x91\xce\x74\x43\x5d\x97\xb1\x6a\xdc\x84\x84\xaa\x08\x17\x31\x3b\xc7\x25\x16\x40\xdf\x59\xfa\xba\x9b\x6c\xb9\x5e\x7d\xea\x18\x65\x7c\x21\xf6\x7b\xa2\x3c\x85\xc8\x72\x62\xae\xbf\x66\xca\x7a\xc8\xcd\xdf\xf1\x81\x2e\xc7\xf0\x20\x8b\xe4\xb3\x9c\x2d\xb6\xa4\x16\x50\xa2\x3f\x7f\xed\x29\x37\xec\x26\xe7\x6b\x5d\x7c\xe8\xf2\x8c\x53\xd3\xa1\x67\x72\x1f\xde\x30\x3b\x00\x16\x8c\xf5\xa4\x98\xaf\xba\x55\x34\xae\x3e\x5b\xb2\x40\x04\x98\x2c\xd1\x1d\x20\x07\x3c\x71\x39\x0f\x8b\x3e\xe5\x53\x74\x69\xb8\x5a\xd3\x22\xd4\x39\x74\x09\xee\x52\xf0\x3e\x5c\x85\x57\xce\xb2\x41\xdd\x61\x40\x17\xde\xc0\xa9\xfd\xb0\x25\x99\x06\x48\x2e\x4b\x50\x54\xbe\xbe\x38\xa8\xac\xb4\x56\x5e\xb9\x2c\xb0\xe8\x07\x5e\x50\xf7\x65\x01\x6f\x4c\x51\x5a\xe5\x04\x36\x10\xb9\xe8\xaf\x3e\x25\x19\xc5\x1a\x70\x03\x6e\xf0\xa9\x7b\x83\x00\xad\x18\x3c\x64\x8d\xc9\x06\x0a\x29\x2d\xb9\xf3\x8e\x76\xc6\xe7\x8e\xa8\x9a\xe3\xdc\x54\x25\x7b\xb6\x55\x27\x39\x8b\xa5\x08\x6c\xfc\xde\xa8\x58\x70\x90\xe0\xa4\x59\xd4\xff\xea\xcc\x4d\x9d\xbe\xee\xf8\x9b\x1a\x5b\xda\xfd\x6a\x39\xd8\xa8\x3c\x68\xd5\xe0\x52\xc5\x9d\xf1\x0b\x93\xf8\xd7\x96\x08\x70\x81\x33\xe4\xfb\xe8\xb4\xb4\x16\x00\xe8\x77\xae\xb8\xc2\x0b\xba\xe2\xc0\x07\x01\xbd\xef\xf6\xeb\x61\x7d\xef\x89\x2e\x77\x80\x05\x03\xa1\xb9\xe2\x14\x29\x4e\x75\x86\xca\xf0\xe7\xcd\xa7\xd7\x51\x07\x49\x63\x1a\x29\x0e\xe8\xa7\xdd\x3d\x1a\x6c\x7b\x0d\x4d\x7e\xc6\x82\xfb\x5d\x1c\xa2\xf0\x67\x8a\x4a\xf3\x35\xa3\xcb\x2d\x10\x59\xdf\xf9\x66\x1f\xa6\x37\xdb\x88\xdc\x1f\x80\xaf\xf6\x7c\x26\x96\x85\xdb\x77\x48\x92\x3a\x21\x4e\x9f\xc2\x31\xd3\xab\x28\xdf\x40\xc8\x51\x4a\xf9\x71\x21\x3d\xcd\xe5\x44\xf6\x22\x6c\xb6\x31\x86\x2e\xa8\x29\x21\x31\x46\x77\xca\x45\xc6\xe9\x25\xfe\x59\xe0\x72\x5e\xb2\x0e\x31\xbf\xe9\x23\x4f\xa2\x3d\xbe\xaf\xf9\x50\x1b\x2f\xca\x3e\x4a\x99\xbc\x0f\x51\xd6\xb9\x1f\xde\xbd\x7f\x42\xf8\x17\xe7\x2a\xcd\x69\xa8\x01\x30\xa8\x4a\xd1\x6c\x34\xca\x6e\xc0\x64\x39\x37\x89\x45\x1c\x50\xdb\x2a\xc8\xcd\x50\xb7\xbe\x3e\x4c\x13\xa3\x0b\x17\x7e\x54\x2b\x6a\xa5\xcb\x3e\x3c\xf0\x42\xb2\xd5\x2d\x5f\x61\x6f\xbf\xe0\xc6\x11\xeb\x54\xd4\xc4\xa2\xea\x6d\x27\x6f\xb3\x05\x4c\x16\x36\xfd\xa5\x87\x9b\x26\xf4\x0f\x67\xd4\x8f\xe9\x8e\xf6\x8e\x97\x6b\xf6\xe0\x41\xdd\xda\xbf\xd9\x18\xcd\x13\x7a\x1c\xde\x7f\x23\x0b\xeb\x22\xb1\x0b\x2e\xaa\xf5\xb7\x15\x46\xef\xd8\x10\x00\xac\x5a\xf8\xb3\x07\xea\xd7\x4b\x34\xfc\xa8\x06\x67\x0a\xcc\x66\xc0\x3e\xd5\x89\x96\xe7\xaa\x5d\x06\x00\x14\xce\x6f\x90\xae\x2f\x7f\xbd\x9d\x09\xb7\x08\x21\xf4\x83\xe2\xa1\x4b\x3b\x5e\x6b\x0d\x54\x5c\x0a\xcf\x1a\x0f\x90\x67\x81\x90\xfd\x1d\x09\x45\x33\xfe\x10\x9a\xd2\xcd\x8a\x47\x77\x5f\x42\x89\x2f\x00\x84\xef\x5c\xd6\xb8\xfa\x37\xad\xf2\x03\x91\x57\x73\x82\x51\xc3\x28\xe8\x09\x26\x52\xc6\x45\x8c\x87\x3b\xe2\xb5\x33\x16\x23\x6d\xb7\xcf\x2b\x03\x0b\xb7\x42\x9b\x72\x5e\x2c\x6d\x76\xec\x6f\xf2\x71\x01\x61\xee\x81\x15\xf7\xa2\xcb\xde\xb7\x10\xc8\x7f\x4a\xfd\xbd\xfe\x42\x94\x93\xdc\x92\xae\x84\xf4\xef\x90\x15\xd4\xa1\xf9\xe5\x5b\x28\x9d\xcf\x0c\xaa\xaf\xf8\x82\xba\x63\x6b\xa3\x24\x0d\xc7\x51\x38\xa8\x9f\x93\xfa\x6b\x92\x51\x6d\x93\x21\xdc\x3f\xd1\x31\x32\xef\xe6\x35\xe6\xbd\x1f\xc6\xae\x3b\x90\x23\x08\x52\xfd\x8a\x0d\xd4\x39\x50\xdf\x3b\xc2\x97\x39\x04\x39\x2d\xbc\x10\xce\xe5\x71\xe9\x79\xd5\xc8\xe2\xe6\xb0\x12\x59\x29\x38\xe7\xec\x6f\xca\x4a\x16\x99\xc1\xc7\xd9\x7c\xe2\x40\x15\x58\x73\xde\xe8\x69\xeb\x43\xd0\xfa\x9d\x72\x97\xc5\x52\x28\x35\x36\x67\xfc\x41\x5f\x00\x64\x3b\x88\x7b\xd9\xa0\x39\x50\x0f\xa2\xed\x15\xab\x5d\xfc\xf3\x69\x1e\xe2\xa3\xe5\x36\x81\x29\x4d\xae\x7c\x60\x84\x62\xb8\x33\x7d\x73\xca\x52\x9f\x5e\x17\xe0\x3d\x89\x96\xb6\x8b\xa0\x86\xa5\xee\x09\xef\x8d\x56\x88\x89\x23\x04\x41\xf9\x0b\xa0\x72\x8f\xef\x5f\x67\xd0\xd6\x05\xda\x59\xd7\xe9\x8c\xbe\x2b\xcf\x1d\x4b\x88\xab\xe5\x6c\xf5\x9c\xb1\x96\xc9\x13\xb1\x45\x1d\xcf\xbe\xaf\x8d\xc8\x07\x1f\xeb\x34\x41\xa6\x0f\xea\xd4\xbc\x9e\x7d\x71\x51\xca\xc1\xd1\x53\xc3\x2f\x8b\x8e\x28\x91\x70\xf4\x77\x5b\xa4\x1f\x56\x0d\x78\xc0\xab\xe1\xf9\x3e\xe9\x6c\xcc\x36\x87\x5d\x7b\x5c\x0d\x8e\x27\x83\x4d\x9f\x0c\x11\x7b\xee\xf2\x69\x00\x58\xfa\xef\xe6\xe0\xfa\x58\xd1\xc0\xed\x07\x3d\x96\x45\xf3\x02\x9c\x2b\x39\x25\x10\xe8\xd7\xae\xc0\x40\xe0\x20\xf3\x77\xd7\x4a\x17\x69\x6e\x8a\xbf\x1d\x85\x57\xc4\x31\x0c\x7c\x9a\x97\x6a\x1e\xf6\xc5\x31\xa0\x89\x61\xbe\xa6\xe2\x2f\xf4\xa5\xbf\xdf\x1b\xd1\xd5\xee\x7b\x48\x41\x6a\xaf\x20\xc0\x30\x1f\x2e\x9b\x72\x99\xdd\x04\x04\xd5\x14\x97\x51\xf8\x06\x20\xd9\x76\x4b\x4f\x8a\x65\x50\xef\xc6\x6e\x8b\x53\x6c\xd0\xfa\x71\x8e\xf8\x04\x31\x6e\x1d\x09\x7f\x34\x73\x05\xcb\x2d\xfe\xee\x7e\xea\xcf\x1c\xfb\x03\x23\x3f\xec\x0b\xb9\x02\x8e\xe5\x44\x81\x60\xec\x52\xf0\x6b\xf1\xcb\x79\x63\x2f\x68\xce\x13\x07\x36\x2b\x88\xf5\x5b\xf1\x1f\xea\xd4\xa1\xb0\xd6\x9c\xac\xf2\x55\x10\x90\xf8\xbc\x12\x13\xed\xee\xcc\xd3\xbe\x2e\xc7\x4c\x9c\xf2\x01\x3d\x17\x0b\x44\xd6\x8b\xd6\xf0\x75\x0f\xd9\x80\x6a\x4a\x4c\x4e\xc9\x53\xdb\x69\xd2\x90\xb3\xa6\x91\x12\xac\x6c\x14\x7d\x44\x91\x77\xe6\x70\x43\x87\xf4\x35\xa2\x14\xb4\xe2\xcf\x94\xd4\x1f\x9c\xfd\x26\x4b\x40\x3c\x43\xb1\x23\x34\x8b\x0e\x3f\x2b\x65\xfa\xfc\xf9\x89\xc7\x08\x3a\x63\x87\xbf\xa5\x12\x5b\xd7\x3c\xae\x50\x4d\x27\xed\x07\xed\x17\xe3\x07\x81\xbd\x3d\xc6\x0b\xdb\xa0\x80\xca\x12\x6f\x27\xb8\x78\xbc\xf8\xda\xb1\x68\x42\x89\x52\x9f\xaf\x0c\x51\x6b\xa7\x85\x46\xb7\x11\x68\x86\xec\xf3\xaf\x46\x35\xc7\x73\x94\x6b\x19\x3f\x7e\xc3\x88\x27\xba\xf6\x3f\xed\x09\xd6\xc5\xb8\x99\x13\xa9\x9f\x07\xc3\xa7\x9a\x3f\x8e\x20\xff\x7a\x21\xf7\x39\x23\xf9\x8f\x78\x74\xc8\xfe\xca\xb6\x5f\x05\x07\x8d\x0a\x95\x01\xe6\xee\x15\xf1\x87\x23\x7f\xd3\x0d\x05\x9c\xaa\x26\x9e\x1d\x53\x55\xa7\xaa\x2e\x2e\x93\x11\x30\xdd\xda\xc0\x7a\x3d\x23\xe4\x6b\xcc\xfa\xf5\x42\xa8\x65\x9b\xf3\xef\x52\xd8\x07\x68\x03\x45\xbd\x11\x05\xa0\x7c\x27\x42\xd2\x5d\x25\xc7\xbf\x78\x2e\x8f\xd7\x87\x5f\x97\x0f\x49\x13\x3f\x2f\x63\x68\x29\x2e\x09\x2b\x10\xb2\x80\x7a\x32\x53\x59\xc9\x40\xfd\xc4\xe0\x5d\x36\x99\xdb\x64\xd0\xbf\x08\x51\x47\xab\xe4\x28\x68\xab\x36\x59\xa3\x44\xd2\x55\xaf\x48\x61\xc1\x33\xb2\x5c\xa9\xdb\x0e\x9c\xc2\x03\xe3\x87\x04\x80\xd1\x73\xc8\xf3\x37\xc6\x68\xb9\x39\xaa\x8f\x22\x77\x97\x38\x5d\x76\x64\x52\xbc\x18\xfa\xd9\x91\x3b\xaf\xe4\x04\xa3\x1f\x9c\xab\x84\x9a\x26\x20\xe1\xf2\x1b\xcb\xd5\x57\x21\x1f\xf5\x56\x95\xca\x8b\xa1\xe9\x02\x20\xea\x05\x76\xf1\xed\x5b\x07\x85\xe1\xe1\x19\x5c\x42\x4e\x73\x2b\xca\x17\x28\x3b\xdc\xf2\x07\xdc\xe0\x35\xb3\x01\x31\x86\xa1\xf2\xc1\xfb\x8e\xf8\xf9\xf9\xf5\xff\x69\x76\xa1\x3c\xb1\x37\xfd\x08\xfd\xe2\x1d\x92\xcc\x67\x87\xfe\xda\x3d\xef\x24\x22\xf4\xcc\xef\x7f\xe1\xcc\xbe\xda\x71\xd6\xa3\x8c\xcb\x57\xd1\xec\xce\xb8\xce\x22\x8d\x12\x6e\xfc\x34\x41\xd8\xa7\xc4\xb1\x9e\xdf\x50\xad\x2d\xbe\xa9\x9f\x01\x02\xc6\xa9\xa6\xfc\x8b\x03\xb9\x11\x8a\x5a\xa6\x3e\xc6\x99\x88\x8b\x03\xc5\x13\x54\x42\xe8\xfc\x04\x31\x40\xbb\xf6\xe8\xa7\x1a\x29\x03\x86\xef\xa4\x9c\x64\x58\xa0\x0d\x84\x5a\xda\x6f\xfe\x48\xe1\x66\x79\x32\xb5\xce\xa5\x10\x4d\x61\x14\xb0\xae\x08\xe5\x4e\x74\x7a\xc4\x37\x0e\x68\xa3\xaf\xdf\x6a\x45\xc9\x35\x65\x73\xa4\x12\x02\x64\x59\xe7\x8f\x2d\xdd\xdb\x27\xb9\x85\xcf\x10\x8b\x57\xae\x70\x3b\x39\xd2\x17\x48\x92\xf9\x7e\x00\x5f\x41\x85\x72\x39\x01\x04\x56\x3d\xd2\x21\x13\xc2\xa4\x53\x3c\x9f\xfa\xda\x8e\xbc\x0b\x86\x3a\x1a\xe7\x89\x12\x58\x2a\x7c\x95\x08\xcd\xf6\x1a\x22\xab\xed\xb5\x51\x98\x76\x83\x4a\x18\x4f\x2a\x1d\xb6\xdf\xdc\x01\x8a\x3d\x20\xef\x01\x74\x35\xe3\xca\xc2\x04\x85\xe0\xd9\x1f\x01\x1c\x56\x05\x58\x86\x7e\xf9\xe9\x94\xb2\x6c\x04\x96\x8c\x4a\x78\x5e\x13\x94\x36\x8a\x19\x8b\x99\x7d\x53\x08\x4c\x92\x72\x57\x26\x5a\x8e\xe0\x35\x3d\x3f\x18\x05\x78\xcc\xad\x7b\xd4\x71\xe8\x2d\x71\x0d\x9b\xfc\x84\x90\x52\x4a\xcd\xc5\x69\x74\x8b\xe7\x48\x91\x7f\x95\xba\xf6\x18\xe3\x36\xbf\x59\x14\xa9\x65\x58\x41\xc6\x24\x4a\x19\x55\xc5\xdb\x41\xee\xef\xf0\x1b\x81\xf8\x36\x60\xb5\x40\x85\xed\xa3\xa5\xfd\x9b\x19\x23\x77\x84\xc6\xc1\x07\xcf\x9f\x51\x7d\x7e\x53\x07\x71\xdb\xe1\xac\xd5\x04\xea\x17\x03\xf9\xa1\x56\xcc\xe4\xdb\xcd\x14\xcb\x5c\x39\xb7\xfc\x2c\x49\x7e\x03\x8c\x99\xb4\xa6\x52\x66\xf4\x74\x89\xc8\xc5\x71\x9b\x1f\x31\x49\x82\xb9\x96\xa0\xee\xec\x2e\x3e\x94\xf9\x09\x5b\xb4\x63\xc5\x70\xe1\xab\x12\xcb\xb2\xf4\x26\x00\x52\xe8\xe7\x06\xcf\x03\xfd\x6c\x36\xb9\xd3\xb6\x1f\xc3\x7e\x2c\xba\x41\x10\xca\x2c\x16\xff\x66\x82\x9d\x6d\xcd\x2e\xa2\x38\xd6\x09\x59\xb6\x58\xde\x64\x50\xe5\x27\xaf\xfc\x2a\x23\xad\xde\xab\x71\x18\xf7\x75\xd8\x1c\x50\x99\x4e\xf6\x9b\xc7\x8c\x8a\x36\x01\xd1\x6e\xa5\xde\x0b\xca\x4d\xb9\x96\x16\x1a\x00\x3f\x2c\xf9\x86\x38\x61\x22\x23\x89\x5b\x82\x0a\xb4\xfc\x6d\x33\x36\xaf\x70\x3b\x20\x93\x30\xd5\xc7\x1c\x46\xe5\x85\x35\x66\x81\x70\x19\x95\x8d\x2e\x41\x37\x2b\xdc\x02\x74\x31\xa6\x00\x6e\xeb\xb1\xca\x75\x8f\x5f\x32\x8d\x1c\x8a\xff\x02\x9f\xef\xad\xda\x13\xcd\x28\xc6\xf7\xaf\xf1\x8d\x77\xc5\x69\x82\xfe\xc4\xf2\x4f\xc0\xfb\xde\xfe\x52\x22\x18\xf0\x36\x19\xd8\x3a\x93\xdc\x1f\x2f\xff\x37\x08\x3f\x4e\xc4\xa6\x5a\x14\x64\x2b\xf7\x7c\x06\xa1\xc1\xa1\xca\xeb\x64\x6f\x06\x83\x15\xea\x85\xb8\xed\xbe\x04\x59\xa0\xef\x4d\x32\xd1\x87\xb4\xc7\xa7\xc8\xa2\xf0\xf8\x6e\x9e\x27\xbc\xd1\x07\x3c\x62\x86\x25\xd9\xcd\xc5\x95\x42\xc1\xba\x9f\xa8\x54\xd8\x55\xeb\x4d\x7e\x6f\x1c\x43\x84\xd6\x5a\xe6\x35\xab\x15\x81\xa7\x9c\x33\xcf\x78\xe5\x35\x70\x8a\x2e\xaf\x64\x22\x4c\x6d\x1f\x88\x2c\xea\xcf\x2b\x41\xf4\xd9\x94\x90\xed\x33\xbc\xe5\xbc\x84\x1c\x67\x84\xb2\x7b\x8e\x38\xb0\xb1\x90\xf7\x18\xf4\x7b\xf0\x53\x60\xfa\x11\xfc\x1d\x35\xff\xd1\x0c\x61\x5d\xe1\xdc\x24\xf0\x8a\x47\x1b\xfd\x5b\x5d\x79\x04\x9f\x1f\xbe\x81\x48\x75\xe2\x8e\xc7\xa9\x01\xa1\x75\x40\xa0\x12\x1c\xc2\xd2\x6f\x73\x40\x3f\xc8\x5a\x9c\x5f\x5c\x52\x15\xa3\x3e\xb7\xc8\x4f\x8d\x99\xba\xe3\x29\x12\x9b\xcc\xa7\x1b\xee\xe7\xce\x13\xf4\x6a\x46\x97\xe7\xb2\x82\xce\xbd\x98\xf3\x22\x35\xd3\xdf\xb3\xc8\xe3\xfc\x97\x11\x84\xb5\xfe\x9a\x9f\x33\x71\x8c\x0b\x61\xfd\xe6\x93\xbf\x14\x68\x89\xe7\x55\xc3\x9f\x7d\xa9\x1c\x37\xb3\x7f\x85\x23\xb2\x7d\x15\x4e\x84\x7a\xeb\xf3\xfb\x5a\x3f\x3b\x52\x0b\xd6\x33\xae\x00\xa9\x76\x63\x21\xee\xef\x6c\xf5\x53\x16\x1d\x27\x4a\x72\x40\x91\x6e\x48\xa6\x04\x82\x20\xef\xc0\xa9\x6b\x8f\xf1\x35\x5e\x01\x5a\x4c\x77\xb1\xd9\xf7\x98\x45\x81\x55\x3c\xe1\x0e\x4c\xed\x6d\x0c\xf0\x48\xda\x72\x7a\xaf\x48\xdf\x09\x3e\xf5\xb3\xe6\xa2\x2a\x72\x47\x48\x1c\x78\x37\x9d\xa7\xa4\x74\x08\x7b\x26\xfe\x60\x44\xe9\x4f\x2d\x83\x9c\x5e\x12\x65\x43\xbf\xac\xe1\x02\x97\xe7\xf8\xa8\x61\xd7\x1a\xb5\x2c\xb1\x9b\x3d\xde\x8d\xe2\x09\x3d\xd4\xab\xd4\xc8\x91\xbf\x09\x61\x5e\xda\x0a\xc7\xe5\x81\x88\x7f\x2c\x45\x68\xbf\xeb\x8c\x7d\x76\xe4\x29\x8d\x51\xf4\x8a\x38\x80\x1b\x9f\x40\x3c\xce\x59\xf4\x16\x3b\xf4\x16\x07\xc0\x6a\x85\x69\x90\x0e\x7f\x42\x09\xbf\x1e\x62\x04\x15\x72\x08\x57\xf1\x65\xb0\xcc\x3f\x52\x02\x95\xff\xfb\x81\x28\xbb\x58\x14\x5b\xf9\xe2\xb5\x46\x1c\x97\x3c\x0b\x4b\x61\x81\xa5\xec\x33\x20\xbf\x1f\x2d\x8b\x89\x2d\x30\xbd\xf3\xc0\x3e\xb1\x24\xe9\xf6\x97\xc8\x3b\xf5\x32\xa3\x45\xec\xd3\xe8\xb7\x26\x2d\xb6\x71\x10\x2f\x41\xf6\x39\xbb\x4c\xac\x66\x05\x21\x91\x9a\x55\x7b\xa2\x5f\x6f\xd8\x7d\x73\xfa\xc0\xab\xa0\x10\x5d\x27\x0a\x12\xd9\x15\x66\xf1\xed\x6f\x98\xea\xce\x9a\x0d\x08\x40\x13\x04\x14\x57\xdc\x3b\x1f\x55\xea\xb1\xbd\x5b\x91\x59\x2c\x47\x3e\xc5\xd4\xa9\x81\x74\x2c\xcc\x06\x08\xab\x3b\x13\x77\x96\xee\x17\xf1\xc3\x8b\x3c\xa8\x51\xc6\x89\x63\x14\xe8\xdb\x64\xbb\xd4\xde\xc9\x1e\x6b\x36\x50\x99\x3f\xdd\x8c\x6d\x15\xe5\x9f\x66\xc2\xef\xe7\x87\xf2\xa2\xe0\x4b\x79\x74\x84\x8f\x2b\x01\xfc\x74\x02\x7e\x3f\x53\x2a\x37\x3d\x0a\x3a\x10\x5d\xc9\x53\x5d\x3e\x5d\xf5\x2e\x62\xc6\x65\xdb\xb5\x97\xca\x32\xb0\x39\x5c\x8d\x9f\xf0\x40\x9d\xa5\xa7\x81\xd3\x9b\x7f\x4e\x60\x21\x9c\xc7\x5f\x2f\x08\x0b\x7e\xe2\xfd\x94\x49\x92\x0d\xb0\x7f\x2b\x87\x21\x66\x98\x50\x9c\x95\x66\x85\x14\xff\xd5\x5d\x44\xd4\xcf\x09\xc7\x39\x80\x3b\xfc\x38\x93\x87\xf8\x1e\xbf\x3d\xe5\xcf\x14\xc1\x7b\x21\xf6\x3b\xa3\x7f\x9a\x3c\xc4\x71\xae\x0f\x36\xb0\x9a\x37\xaa\xb5\xb4\x14\xd6\x7b\xb6\x21\xbe\x6f\x96\xfc\x99\x2b\x9b\x67\x98\xca\x65\xbb\xb3\x96\xd9\xed\x05\x92\x3b\xab\x13\xf9\xa6\xaa\x9f\x7e\xd3\x1c\x85\xbd\xf3\x7c\xfa\x39\x0a\xc3\x0f\x00\xeb\x75\x0b\x50\xdf\xc2\x66\x3a\xc1\x71\xc4\x87\x28\xaa\xfd\x4b\x2f\xaf\x29\xbd\xa5\xea\x72\x4a\x8a\x6e\xc8\x19\x96\xf9\xfc\x54\x00\xa6\x22\xce\x33\xfc\x35\xc3\x9f\x0c\xea\x86\x64\xa6\x6f\xdc\xa6\x2f\xfc\xc6\x0b\x61\xb3\x88\x43\x88\xb2\x99\x8e\xfd\x29\x6a\x44\x8c\x1d\x53\x06\xef\xa0\xe6\x9b\xa3\x6e\x18\xcc\x75\xf2\x43\x34\x06\xcd\x1b\x53\xc8\x54\xd3\x36\xea\xa7\x0f\x9e\x07\x5e\x29\xc4\x3b\x00\x8a\xa8\x64\x41\x1c\xcc\x37\xd0\x65\x63\xa4\x62\x99\xa2\xfb\x17\x3e\x7c\x5d\xa9\x32\xd3\x16\x7f\x56\x17\x63\x3c\x36\x87\xc5\xdc\xa0\x7d\xa7\xe7\x43\x9b\x9d\x6b\xa3\xc1\x08\x40\xcc\x5a\xba\x3b\x82\x0d\xea\xa8\x87\xe0\x8c\x2d\xbb\xf4\xa6\xdd\x76\xf6\xd4\x58\x53\xbb\xa0\xb6\x96\x5c\xed\xd9\x00\xef\x60\xf9\x31\xa5\xa7\x61\xbb\xa0\x0e\x84\xc6\xa3\xb2\x0d\x5e\x24\x3f\xd9\x75\xce\x09\xf6\x5e\x02\xba\x8f\x78\x93\x2f\x41\x1b\xee\xe4\xb2\x52\xa7\x29\xef\x45\xbb\x9e\xda\x3e\x4f\x92\xfa\xb8\x0c\x0a\x3c\x51\x8d\x3f\xf7\x72\xfc\xf0\x29\x80\x07\xc0\x8c\x02\x71\x59\xc3\xe7\x0d\xc9\x86\xf4\xf8\x44\xf0\x6f\xda\x7a\x4e\xa3\xbe\x49\x12\x23\xcd\x93\xc5\xeb\x67\x47\x5a\x8a\xf2\x9b\x30\x1e\xd2\x0b\x0c\x56\x58\x35\x3f\xdc\xb4\xed\x43\x87\xbb\x5c\xbe\xc7\x55\x8a\x9d\x50\x80\x5d\xad\x74\xb8\x98\xb2\x43\xa0\x19\x51\xc8\x2e\xf6\xba\x4a\x8a\xf7\xb8\xe4\x02\xfc\xe5\x06\x3a\xb7\xb6\xd8\x99\xcf\xed\xe7\xf7\x5d\x7e\xac\xb7\xa6\xaa\x4a\x11\xbc\x93\xfd\xe9\xb4\x08\x9e\x6b\xa4\xbc\xd9\x57\x58\xeb\x78\x6d\x41\x0c\x27\x16\x7a\x19\x72\xc6\xe2\x0a\x66\xaa\xe2\xb3\x89\xee\x6e\x88\xab\x21\x89\x17\x3f\x23\x58\x8b\x00\x47\x67\x1b\xfb\x9b\xa7\x59\xe0\x8c\x1e\x4f\x15\x1f\x92\x61\x5b\xba\xd8\x09\x44\xd6\xcd\xcf\x58\xe1\x43\x9a\xed\x48\xf1\xbb\xf1\x0a\xf3\xf5\x0a\xfe\x96\xcb\x46\x69\xa1\xf4\x63\x0e\x40\x70\xb9\x15\x46\xdb\x2c\x79\xfa\xd1\xba\x0c\xb4\x1f\x78\x20\x48\xb6\x1f\xed\xd9\xa6\xa8\x77\x93\x2d\xd2\xc3\x03\xfa\x98\xc3\x13\x06\xdb\x6b\xbc\x26\xe4\x05\x2f\x83\x6f\xd5\x07\x29\x8e\xef\xec\x7a\xed\x18\x23\xe4\x20\xb5\x17\x14\xc5\x73\x56\xf6\x93\xe6\xe9\xb6\xa7\xb1\x80\xcc\x5d\x20\xba\xdc\xa9\xf1\x04\xbd\xea\xdf\x43\xcb\x5c\xbe\xec\x40\xd4\x10\x5b\x18\x28\xa0\xc3\xaa\x80\x32\xc7\x80\x0c\x28\x37\x77\x5e\x49\x26\x41\x17\x24\x0d\x74\x5f\x8a\x24\x7b\xfc\x48\xb8\xbe\x89\xd0\xcf\x64\xe3\x79\xaa\x7b\xc7\x00\x7d\x5e\x85\xd6\x25\xb3\x28\xcf\xe0\xf9\x98\xf1\x51\xf5\x4e\xd0\x6b\xac\xa3\x39\x98\xca\x8b\x2d\x88\x7b\xcf\xe7\x37\x27\x03\x49\x2a\x68\xb3\xb7\x1f\xc0\x7b\x52\x9a\x72\xed\xdc\xad\xc4\x29\x1c\x8b\x7d\x9b\x65\x9e\xb2\xe1\xce\xcf\x41\x1b\xe6\x29\x2b\x2c\xe3\xf3\xab\x4f\x85\x99\x1a\xab\x05\x25\x83\x50\xf5\xb6\x35\xbb\xb3\xe2\x40\x3e\xfe\x49\x16\x03\xba\x93\x36\xd7\xc8\x33\xf7\xe3\x57\xb3\x70\xb1\xc0\x19\x56\x46\x41\xfd\x4d\xe8\xbb\x3f\x9d\xc8\x50\x1d\xaf\x95\x7f\xc6\x00\xe3\x4b\x83\xec\xfc\xd8\x30\xb5\x20\xa2\x8b\x5d\xae\x92\xfd\x5a\x6f\x78\xce\xe0\x8f\xb1\x94\x3f\x5d\x9f\xa6\x0b\xc5\xe3\xb3\x90\x79\x34\xcc\x73\xfa\x93\x84\x62\x6e\x4c\x9c\x16\xa3\x55\x3d\x19\x94\x9b\x28\x6d\xb3\x7a\x58\x23\xd3\x8c\x09\xf2\x49\x06\x78\x77\x90\x4c\x91\xc4\xc9\x54\x97\xea\xa4\x41\x0d\xf7\x36\xc6\xf4\xe8\xf2\xe0\xd9\x5f\xcd\x4c\x0f\xe1\xa9\xfe\x8c\xb5\xa5\x66\xf3\x19\x62\xc3\xef\xfd\xd0\xef\x48\x6d\x64\x4e\x51\x98\x2e\x3e\xed\x11\x32\x78\x06\x1d\xdf\x14\xf7\x43\xd1\x13\x3e\xe7\x23\xfc\x38\x1e\x7f\x69\x3d\x8f\x27\x61\x72\x5e\x32\x99\xf6\xdc\xf7\x69\x44\x9f\x1f\x64\xfc\xec\x10\xb9\x73\xb7\x82\x13\xd1\x70\x7d\xeb\x0d\x27\x7e\x58\x40\xd3\xb9\x62\xe6\xb5\x32\x44\x57\x71\x08\x17\xa1\xee\x75\x33\x03\xdb\x7a\x67\x49\x00\xa3\x28\x1e\x46\x01\x36\x2e\xbf\x14\xb7\xec\x03\x94\x29\x82\xcc\xab\x62\xb3\xf6\x08\x1d\xf5\x78\xc9\xb7\x4d\x12\x8b\xef\x46\x94\x04\x7b\xd7\xfa\x43\x5a\xa3\x5f\x00\x60\xf9\x54\x6f\x30\xb4\x0f\x86\xf5\x49\xf6\x2d\x32\xaa\x8c\x2d\x43\x96\xd5\x36\x89\x24\x56\xed\xea\x72\x07\x49\x9a\x07\x30\x90\x62\xe5\x83\x9d\x46\x1b\xbf\x29\x93\x4f\x37\xba\xc0\x08\x43\x8a\x63\x02\xfe\xd9\x0e\x0d\x33\xe3\xfc\x95\x43\xe6\xf6\xc9\x25\x2d\xc7\x68\xc6\x4f\x87\x25\x10\xbe\x57\x8c\x6d\xf1\x05\xa6\x4a\x2f\x49\x82\x73\x48\x71\xda\x3e\xa3\x3f\x93\x5d\xbe\x0e\x85\xcc\x22\xc5\xf1\xae\x68\xf1\x63\x5c\x84\xa1\x98\xdf\x33\x1b\x0f\x58\xd9\xab\x5e\xdf\x7b\x31\xe1\xf9\xdd\x2f\x37\xf4\xe4\x2f\x25\x75\xa5\x7e\x97\x2a\x05\x56\xf4\xc7\x81\x04\x36\x16\xaa\x5e\x38\x59\x28\xb1\x31\x7d\xb8\x29\xd4\xc8\x55\xe5\x51\xb6\x5d\x70\xa1\xe8\xcb\xb9\x0e\xe6\xab\x92\x6b\xac\x80\x2d\x83\x0a\xe0\x08\x3f\xd1\xad\x7a\xd2\xb8\xa6\x46\x54\xce\x4f\x7f\xb4\x5b\xe1\xfa\xf5\xf0\x1b\x89\x79\xa4\x2f\x40\x27\x91\x81\xbc\x29\x85\x05\xe2\x5d\xe9\x5d\xb7\x51\x72\x0b\x52\xb4\xd4\x27\x7e\x0e\xb8\x0a\x28\x42\x0d\xc7\x29\x96\xc9\x87\xd0\x6f\x06\x09\xa5\x99\x84\xe3\x2c\x13\xf9\x0a\x48\x45\xfe\x7a\x6e\x6b\x48\x50\x29\x61\xa6\x20\xb8\xdf\xf8\x8e\x90\x65\x23\x21\xab\x5a\x19\x0a\xab\xe8\xdc\xd5\x29\x93\x19\xec\xba\x46\x7a\x41\xde\x37\xa9\xe3\x62\xf0\x65\x14\x0c\xab\x09\x79\x6f\x2c\xf6\x80\x9f\x0d\x13\x98\x5d\xff\x29\x4e\xf4\xc9\xe8\x52\x11\x80\x4e\x05\x5f\x9c\x22\xf5\x4a\xad\x54\x1e\xed\xeb\xc2\xab\x7f\xf2\x0c\x89\x41\x78\xe2\x15\xfd\xba\x03\x6d\xcd\xe3\xea\xf8\x98\x5c\xf3\x9b\x75\xde\xec\x41\x14\xa8\xd2\x7a\x70\x55\xfc\xe5\x7d\xbf\x88\x59\x2b\x3f\x50\x75\xf9\xba\x13\xc8\x21\x82\xe1\xb8\xe2\x65\xc3\xb1\x4f\xcc\xdc\xe0\xd3\x37\x99\xe8\xa0\xb5\xf2\x46\x28\xc4\x21\x5a\xfc\x74\xdd\x70\x0a\x28\x4c\x82\xab\x10\xec\x03\x13\xa4\x60\xe7\xb5\x31\x3f\x06\x25\x57\x9a\x59\xa3\xcd\xf3\xed\xd1\x95\x49\xb7\x0e\xa8\x8c\x86\xf7\x6e\x19\x3f\x53\x4f\x0f\x7d\x08\xb4\x6f\xf0\xba\x82\x34\x0e\xef\xdc\x96\x38\x6d\xbd\x25\xfe\xb6\x84\xe8\x07\x84\x62\x85\x2d\xb5\x1f\x8b\xfa\x62\x5a\xff\x89\x6f\xd0\x5f\x09\x57\x92\x41\xfb\x0b\x4d\xbc\x99\x4e\xe0\x2d\xd5\xd6\x2a\xb9\x41\xca\x15\x47\xe3\x61\xd3\xef\xfb\xfa\xde\x18\xc9\x66\x3f\x5a\x65\x7f\x87\xbe\x03\x7f\x79\x48\x70\xb6\x48\x1d\xd3\xbb\x40\x40\xb3\x15\x5a\xd7\x4e\x3f\x7c\x46\x40\xfe\x57\x7b\xb6\xa5\x55\x5a\x8d\x76\x31\x20\x35\x86\xf1\xa1\xa3\x33\x66\x19\x85\x2d\x4f\xc5\xb7\x47\x94\x60\xb5\x66\xc8\x94\xaf\x79\x13\x83\x3c\x65\x42\x33\xb1\x6b\x9d\xd7\x8e\x30\xb9\x6f\xfb\x26\x40\x5c\x71\x04\xdb\xcd\x1a\x7d\x85\x86\x42\xcd\xd1\x6f\x06\x43\xc7\x29\xe0\xcb\xc3\x4e\xa3\x75\xba\x14\x11\x17\x63\x0e\xab\x03\xd3\x95\x5c\x73\xc2\xcb\xd6\x29\x56\x6b\x1e\x7f\x63\xb5\xbb\x29\x70\xf4\x1b\x04\xf1\x71\x8a\x52\xdd\x73\x18\x79\x1a\xfd\x6b\x3e\x4f\xe4\x9c\x3b\x52\x71\x20\x6e\xbe\x30\x27\x28\x4a\x2d\x25\xbe\x95\x61\x5c\x69\xda\x4d\x0a\x1e\x38\x90\x49\x64\x98\x65\xd7\x6a\x8d\xfe\x5d\x01\x47\xc4\x63\x9d\x26\x35\x8a\x27\xfe\xf6\xc1\xf4\x30\x00\x2b\x6d\x2f\x46\x23\x48\xa3\xa4\xa9\x19\x30\x60\xd2\x65\xcb\xb5\x80\xb9\xd3\x88\x8b\x42\xe3\x73\x11\xd6\x1f\x84\x2c\x74\xff\x06\x2f\x4c\x59\xcd\xfc\x88\x1f\x7c\x5a\x14\xae\xca\x18\xed\xd7\x53\xf4\x47\x22\xfc\xea\x55\xc6\x07\x62\xcc\xd3\x15\xdd\xb1\x53\x21\xfb\x37\x03\x75\x3f\x07\x5a\xb9\xd3\x2c\x16\x91\x7f\x1c\xe1\x44\x29\x32\xae\xdb\xaf\x4e\xe5\x13\x27\x26\x79\xa0\x3d\x27\xc2\xe2\x83\x24\xaf\x5b\xc3\xb9\x0c\xde\xcd\xcf\x51\x15\x3d\xb1\x9d\x3a\xd2\x78\xbf\x41\xca\x3b\xf7\x6c\x3a\x81\xcc\x8e\x3d\x42\x36\x17\x95\x96\x87\x7f\x61\xf9\xaa\x79\x5f\xbf\x93\x10\xee\x1d\x8a\x06\xf9\x62\xeb\x44\x94\x1d\x3b\x0e\xce\x5f\x79\x87\x01\xe8\xd6\x17\x33\x76\xba\x88\xc3\xbb\xfa\xeb\x9e\x7f\x7b\xb5\xe1\x0a\xa7\xf5\xc6\x6c\x2c\xd4\x2c\xec\x6b\xe5\x48\xc3\x98\x6f\xea\x4f\xe7\x76\x50\xc1\xe3\x7c\x20\xf1\xe1\xb0\x6b\x5a\xab\x1c\x37\xbf\xd5\x4f\x3e\x0c\x64\x79\xd8\xc2\x0f\x0f\x70\x3a\x43\xac\xbf\x76\xfc\x5e\x68\xc3\x0b\x82\xff\x44\x62\xff\xf9\xd0\x03\x62\x96\x2f\xb6\x55\x18\xcf\x1e\x2c\x43\x72\x69\x89\x31\xe4\x86\xbc\xf4\x46\xa9\xcc\xb7\xb9\x40\x1d\x64\x9f\x3a\x46\x03\xc9\xeb\xe6\x34\x72\x87\x6f\xaf\x4c\xd7\x10\x5c\xbd\xea\x45\x57\xcb\xad\x2c\xc8\xd7\xed\x89\xd5\xa4\x0d\x63\x12\x5d\x3f\xb6\xe7\xa6\x82\xdb\xf5\xba\x87\x9e\x30\xd5\xc4\x84\xc3\x21\x3f\xdc\xb6\x35\x08\x18\x38\x2f\x09\x31\xbb\x22\x14\x03\xb0\x98\x99\xec\x5c\x43\x55\x70\x83\x58\x44\x73\x83\x0f\x4f\x1b\xdb\xca\x9f\x06\x4e\x14\xec\x6d\xd0\x69\x6c\x13\x6d\x42\x65\x8f\x6d\x32\x58\x5c\x9d\x38\x2e\x37\x27\x51\x3f\x1f\xb1\x8f\x6f\x4b\x9b\x10\xbf\xe6\xbb\xf8\xea\x50\xe3\x6b\x0f\xb5\xd9\xa4\x79\xfe\xa6\x5a\x6e\xac\xba\xc4\xc2\x32\xf4\x34\x0b\x6b\xc0\xcd\x72\xa8\x13\x0b\x46\xa1\x8a\x84\x49\xec\x7f\xcf\x3d\x31\xe6\x71\xe5\x0e\x43\xd1\xbf\xb3\x56\x78\xb8\xbb\x34\x83\xc6\xa8\xbe\x63\x72\xe8\x1d\xd5\x17\xcb\xe5\xef\x94\xd6\x2e\xf3\xb4\xba\xce\x90\x06\xa1\xcd\x30\x9e\x6b\x9c\x9f\x87\xb2\x9a\xcb\x98\x22\xdb\x9f\x21\xba\x7b\x53\xa2\x59\x3d\xe3\xc7\x43\xe8\x66\x2d\xe1\x38\x93\x5d\xcd\x97\xa6\x2a\xe5\x82\x59\x41\x1b\xbe\x0b\x91\xa3\x59\xbe\x76\x88\xde\xbe\x03\x59\xec\x45\x89\x86\x2d\x75\xf6\x53\x5b\x9a\xbf\xf9\xe1\xc4\x1e\xbd\x39\x91\x01\xc6\xfb\xf1\x18\x18\xf3\x52\xab\xf6\x37\xbd\x1a\x3b\x9c\x1d\x47\xd0\xbe\x6e\x34\x83\xb3\xd5\x8d\x6f\x39\xfb\xd2\xe5\x02\x30\x8f\xfd\x70\xca\x6f\x0e\xf5\xb9\x83\x73\x62\xee\x48\xfd\x4e\x4b\xf4\xa6\xb5\x40\x86\x94\x31\x80\x3b\x7f\x19\xf2\x98\x03\x43\xbb\x64\x01\x5c\x1a\xb5\x13\x19\xc8\x77\x8a\x24\x46\x4f\x95\xce\x17\x7e\x40\xc8\x5d\xe3\x0f\x5e\xac\x12\xb2\x19\x85\xe2\xb0\x9c\xac\x6f\x6f\x59\x90\xc7\xbb\x9d\xd2\xa7\x40\xba\x3d\x5e\x89\xbb\x38\x09\x12\xac\x19\xe9\xda\xf0\xb5\x6f\x00\x37\x5e\x9f\xcc\x1a\x49\x16\xa6\xee\x04\xff\x90\x7f\xe3\xdd\x6a\x16\xd5\x93\xc2\xde\x4d\x55\xbd\x45\x1c\x52\x34\x6b\xb9\x28\xb2\x41\x61\x4e\xc6\xb2\xfc\x48\x2e\xe2\xb9\x0f\x9e\x1f\x58\xe4\x5a\xed\xac\x85\x43\xd5\x75\x4a\x20\x23\xf4\xb4\xd9\x43\xdf\x7f\xb7\xb0\x4e\xb4\xc1\x93\xee\x5c\x95\x3a\x58\x97\x6e\x34\xdf\x3e\xfa\x69\xff\xac\x58\x63\x7d\xc6\xba\x64\x8e\x4e\x01\x57\xcb\xea\x9d\x84\xec\x19\x62\x8c\x01\xef\xa6\xe5\x14\xce\x13\x2e\x40\xfe\x55\x81\xc5\x31\xbe\xe9\xed\x1d\x31\x7f\x43\xb8\x2d\xcb\x8f\x6a\x9f\x08\x59\x49\x07\x91\xe6\x0e\x37\x45\xbf\xf3\x13\x92\x4a\x2b\x1c\xd4\x7a\xda\x9f\x4f\x00\x4f\x72\xa8\x00\x0a\xad\xa4\xf1\x89\x53\xa2\x25\xc7\x0f\xc6\xb3\x04\x17\x30\xe4\x8d\x6f\x15\x5e\xf1\x98\x7a\x82\x86\xe2\x34\x78\xb7\x3b\x69\xea\x4f\xad\xd1\x19\xc2\x3c\x3a\x0a\xf0\x8b\x2e\xe3\x3a\xab\x7c\x8d\xb7\xdd\xf7\x67\x07\xfc\xe3\x71\xde\xb5\x99\x4b\xeb\xc6\x05\x3c\x60\x94\x48\x86\x54\xf1\xda\x43\xe4\x97\x17\x04\x27\xf8\xd2\xa6\x40\x8c\x3b\x42\xfa\xea\xc8\xe7\x87\xfe\x6b\x33\x20\x78\x35\xa0\x28\xfa\x45\xfc\x19\x7a\x67\xe2\xd0\x7e\x9c\x07\x16\xff\x7a\xc2\x4f\x00\x43\x73\x8d\x91\x2c\x96\x3b\x78\xea\xe0\x39\xe4\x83\x41\x92\x37\x0a\x1e\x2a\xcb\xf2\xe5\xa3\xd7\xf2\xfa\x5d\x8d\xef\x63\x3f\xa0\x54\x6f\xe8\x69\xc0\xb1\x4a\x88\x4f\xe4\xd7\x50\xa0\x76\x5f\xd3\x0f\x60\xd5\xef\x52\xdb\x63\x8f\xfd\x01\x8a\xec\x98\x16\xa1\xd1\x3d\xe8\x88\x8a\x7a\x45\x88\x44\xb5\x66\xc4\xca\x3b\x7b\xb9\x47\x45\x8e\xc3\xe3\x27\x15\x4b\xa3\x58\x75\xe3\x97\x46\x97\xe1\xf0\x19\x7e\x67\x42\x2c\x9f\xf0\x34\x33\x2a\x49\x5a\x7f\x71\xbf\xb9\xec\x9c\x5b\x1c\x95\x05\xc7\x6b\x44\xf1\x3a\x60\x42\xe4\x59\x2c\xb7\xe4\x0f\x49\x65\x08\x7e\x7c\xba\xf9\xc9\xf7\xe5\xb3\xf3\xc5\xf9\x9b\xe3\xa0\x88\xcf\x04\x3d\x25\x44\x98\xe0\x11\x27\x80\xd4\x78\xc1\xa8\x12\x74\x79\x40\x9f\x12\x6d\x37\xa5\xf1\x34\x4a\x85\xdc\x29\x67\xe8\x9c\x59\x7b\xea\xc5\x9c\x3a\xbe\xd9\x6f\xd5\x3c\x08\xe8\x05\x43\xe4\xf2\xa9\x72\x65\xfa\xfd\x74\xd6\x48\x01\xf6\x37\xe9\x54\x99\x2f\xac\x79\xc1\xb4\x89\x89\xc5\x48\x31\x99\xfc\x64\x98\xce\xf9\x29\x96\xc0\x3f\x94\xa4\x3c\xd0\x29\x0c\x78\x03\xd3\xd4\x26\x03\x2a\xe9\x82\x78\x3e\xe9\x51\x33\x0a\xfb\x8f\xf5\x6e\x58\x12\x3b\x2d\x17\xb0\xf9\x57\x79\x8c\xaf\x42\xd8\x65\x55\xcd\x14\xf0\x33\xb1\x7e\x81\xf1\x0c\x7a\x92\x7e\xb0\xef\x4f\xa7\x26\xce\x33\xb2\xb8\xbb\xf4\x87\xcf\x10\xa6\x1a\xff\xb4\x1f\xb0\x23\x7f\x8b\x0d\x21\xf6\x0c\xfd\xec\xaa\x54\x9d\x4e\x46\x7d\xa8\x82\x74\xd6\xa7\x52\x49\xde\xe4\x5b\xbd\x6a\x79\x99\x90\x9c\xeb\x37\x23\xe3\xb7\x11\x5b\x66\x95\x8e\x5b\x5c\xcd\x74\xde\xf0\x6b\xb6\x9e\x31\xa5\xab\xa3\xea\x8c\xe6\x77\x95\xec\xe7\xe7\x67\x4f\xd6\x86\xdb\x3a\xf0\x15\x6f\x49\x50\x3c\x14\xc3\xb9\xac\x0d\x32\xb5\x75\xc6\x6b\x27\x17\x46\x23\x08\x94\x97\xc0\x74\x48\x52\xb6\xf7\x9b\xc6\x58\xb7\xaa\x13\xd9\x2b\x0f\x74\x11\x61\xe0\x2d\xae\x73\xc7\x65\xd2\x1f\xef\x6d\xc2\xcd\xef\x0c\x11\xd6\x0f\xec\x9e\xb5\x67\xd3\x6d\xb3\x48\x3b\xdc\x2a\x7a\xfc\x88\x7f\xe4\xa9\xdf\xbf\x0f\xb8\xb5\x7e\xaf\xf1\x75\x7e\x73\x06\xa9\x31\xac\x37\xf6\x84\x60\xfd\xcd\x77\x85\xc3\x37\x13\x86\xdb\xef\xde\x64\x60\x1a\xd7\xe0\x17\x81\x11\x41\xee\xa5\x53\x02\xe4\x5f\xee\x07\xeb\xdb\x56\xa9\x80\xfa\xb0\x6d\xfc\x23\x68\xae\xda\x2d\xc8\x44\x63\xfd\xcc\xa6\x41\x13\x21\xf5\x1d\x21\xb0\x18\x45\xbe\x10\x58\x00\xd2\xe2\xa3\x16\x07\xf7\x9e\x4b\x69\x71\x06\x94\x60\x7e\x4c\xd2\xc7\xe9\x3c\x33\x78\x12\xa0\xb2\xe4\x24\x37\x1b\xef\xa7\xc5\x48\xd2\xc0\x60\xe6\x49\x50\xb7\xd2\xf3\x5d\x96\x35\x83\x17\x7c\x8f\xc8\x03\xa1\x63\x3a\x89\xc2\x21\xed\xf1\xe3\x33\xe2\x20\x48\x95\xb6\x0c\x72\x34\x08\xe0\x4d\xd5\xfd\x14\xa3\x0a\x63\x2c\xbd\x1a\xfb\xc9\x14\x46\x64\xda\x65\x91\xd8\x4c\x39\x98\x6c\x95\xe2\x30\xd0\x83\xdd\xbb\x05\x97\x02\xad\x44\xd1\xd4\x10\x54\x7e\xff\xce\x54\xfd\xf6\xb5\x2f\x62\x79\x6c\x2a\xcc\xd3\x62\xb5\x10\xe3\xa7\x0a\xaf\xb4\xc1\xfc\x79\x30\xe8\x27\x70\xcb\x2e\x7f\x61\x2d\x9c\xd8\xb1\x35\xe5\x94\xd5\x0f\x13\xe5\xcd\xef\x53\xd2\xa5\x2d\x43\x4f\x61\x23\xfc\x01\xab\xe7\x61\xf6\x69\x14\xdc\x50\xce\x17\xf7\xf6\x21\x80\x42\x9d\x4c\xe6\x5a\x58\x41\xee\x84\xbf\x70\xbd\x7d\xfd\x59\x89\x11\x08\x0d\xe8\x8d\xa6\xb6\xd3\xa1\x73\xd7\xa6\xdb\x77\x10\x89\x4d\x0a\x68\xe7\x0a\x2d\x1f\xee\xa2\x4c\x6b\xfc\x4b\x2f\xa1\x09\x22\x51\x26\x01\x90\xed\x2f\x3e\x35\xa1\xdf\xbc\xe4\x0f\x00\xc6\xc5\xf8\xbd\x3b\x20\x57\x6c\x4b\x96\x48\x3e\xdb\x09\x8d\x92\x75\x0a\x08\x75\xa4\x6a\xde\x1d\xd1\xf9\xe4\x03\x06\x7e\x27\x9e\xf2\x70\xc3\xdd\x16\x8e\x21\x6c\x26\x4d\xa0\xfa\x24\xaf\xbc\xba\xf0\xd3\xe6\x80\xcd\x72\x26\x14\x73\xa3\xac\x6f\x73\x91\x3f\x3d\x28\xe3\xab\xd0\x63\x22\x07\xb1\x03\xca\xcd\xec\x79\xbd\xc4\xa9\x3f\x85\xdf\x9d\x17\xa0\x86\x23\xde\x74\xff\xe1\xd2\xb6\xd0\x63\xcc\x52\xa7\x32\xeb\x0a\xe0\x79\x63\xbf\xbe\x40\x60\x97\x5a\x4c\xf3\x2e\xe4\x76\x58\xc6\xb2\x92\xa8\x28\xb5\x27\xc3\xbf\x3a\xef\x6f\x63\xcf\xe6\xe3\x4e\x5b\x8c\x82\x78\x6c\xe3\x77\x1e\x89\x96\x13\x83\x36\x1a\xcf\x94\x6a\x97\x71\x8f\x5b\xc5\xf8\x33\xb1\xc5\x7f\x21\x2a\x34\xdf\xca\xb9\x72\x1b\x3a\x5a\x28\x2f\x6d\xf9\x01\xd3\xe6\xa7\xe7\xdb\xcc\x3a\x57\x9c\x9f\x60\xfe\x38\x01\xa9\x08\xfa\xde\x2b\x9a\x93\xb5\xe2\xef\xcc\x8e\x39\x2c\x26\xf6\x81\x5c\xd9\xa7\xc2\x5c\x39\x55\x9c\x45\xbf\x91\x40\x99\x42\x26\x91\xdd\x98\x06\x48\x6f\xde\x56\x81\xfc\xd5\xc6\xb5\x34\xf7\x69\x5a\x57\x3d\x5e\x91\x59\xe6\xcf\x2c\xe3\x48\xd7\xf1\x91\xe5\x17\xa6\x06\x55\xcb\x51\x5d\x0d\x35\x5a\x3f\x8c\x09\x94\x2d\x14\x25\x4c\xed\x19\x7a\x32\x47\x49\xf5\x6e\x7e\x9a\xb7\x48\x40\x69\x86\xda\x49\xcd\x94\x48\xcd\xf4\xa9\x40\xa2\xfc\xe9\x30\x6d\xa3\xfa\x53\xff\x7d\x37\x9a\x1e\x4f\x74\x88\xba\x99\x88\x47\x1c\x28\xfc\x64\xc9\x2d\xf2\x0b\xd4\xab\x76\x51\xd9\xe9\x4e\x92\x8f\x5f\x3f\xd9\x96\x9c\xaf\xf6\x41\x02\x59\x00\xed\x39\x3f\x80\xcc\xdf\xfb\xa2\x61\x65\x0a\xc7\xe0\xf7\xb8\x5c\x5d\x6b\x09\x85\x06\xf3\xb8\x50\xae\x94\x49\xcb\x72\xac\xda\x9c\x9f\x53\xfd\xdc\x37\xbd\x3d\xa9\x17\x1a\xc3\x03\xa5\x52\xb3\xc1\xc3\x4f\x85\xd9\xf1\x63\x59\x7f\xb0\xe3\x3e\x99\xda\xa0\x42\xb1\x54\x58\xc5\x55\x4a\x4b\x8e\xe0\x0f\x42\xe2\x04\xe0\xcc\x8e\x01\x34\x0a\xa4\xa4\xa6\x04\x0d\xe4\xf3\xca\x2e\x94\x45\x35\x1b\xfd\x5c\xc2\x57\xde\x2e\xae\xbb\x6f\x18\xc1\xc9\x78\x07\x2e\x6c\x1f\x2e\xcc\x37\x97\x79\x0c\x98\xed\xbf\x5a\x9b\xbb\xee\x43\xbb\xed\x19\x5f\xe5\x0d\x60\xd3\x41\x97\x6c\xe8\xc8\xe8\x01\xaa\x72\x43\xaa\x18\xa5\xc9\x2f\x4c\x3c\xd6\xea\x32\xf9\x3d\x50\xe7\x48\xbd\x38\x05\x66\x48\x33\x25\xc6\xed\xb7\xb8\xe6\x09\x1b\x73\x2a\xd6\x47\x16\xe2\x5e\x3a\xcd\x2e\x0f\xc3\xe8\xfa\xd0\xd5\x4f\xeb\xe5\xce\x38\xe9\xf9\x3b\x76\xf1\x71\xd7\x9a\xdd\xc1\x01\x78\x71\x86\xcb\x6f\x56\x0b\x29\xea\x82\x77\x20\x73\xb1\x5f\xec\x87\x33\x1b\xfc\xab\xbc\x46\x69\xcb\xb4\x20\x04\x87\x50\x09\x3c\x8b\xd2\xcd\xcd\x5c\xfe\x81\x7e\x90\x92\x7a\xa3\xe6\x80\xd2\x47\xb0\x9e\xcd\xe1\x04\x2c\xb7\x2e\x62\x25\xfc\xfe\x51\xd5\x4a\xbf\x03\x96\x13\x18\xc7\x95\x7f\xd4\xe0\xf3\xd7\x3e\x3d\x50\x90\xdc\xc1\x10\x81\x77\xb2\x3c\x02\xf8\xa1\x2b\x75\x85\x09\xa0\x52\x7b\xa8\xdb\xbd\x30\xcd\xf5\x26\xd7\xfa\xea\x81\x70\xa1\xb9\x8d\xf7\xd8\x6b\x08\x93\xcd\xf4\xf8\xf4\x78\x24\x02\xa3\xac\x77\x78\x99\xb2\xee\xec\x10\xf8\x96\xbb\x40\x12\x1c\xe1\x18\x86\xe1\x87\x85\x81\x73\xc4\x78\x05\x54\xb8\xaf\xe8\x42\x51\xcb\x91\xac\x10\x4a\x5e\xf7\x02\x84\xf6\x5d\xae\x1d\x25\x36\x52\x46\x38\xe6\x95\x41\x41\x64\xb7\xfe\x22\x8a\x8f\x09\xed\x8a\x17\x88\x4c\x27\x4c\x81\xc0\xe2\xe2\x9b\x3e\x40\x6e\x5f\xc0\xc7\xed\xc5\x20\x64\x2f\xbc\x6c\x5c\x49\x48\x94\xba\xe5\xdc\x66\xa3\xfb\x9d\x2c\x66\xa2\x8c\x8f\x33\x5e\x37\xc4\x3c\xf0\xed\x63\xbe\xe9\x1d\x9c\x00\xb1\x1f\xe7\x4a\x2e\x84\xd5\x74\x80\x3c\x81\x32\x80\xda\xa5\xad\x7d\x35\x9e\xd4\x9a\x29\xeb\xd4\xc5\x4e\x1a\xfd\x02\xbf\x43\xac\x52\x00\xc3\xe2\x30\xef\x07\x94\x13\xa8\xca\x0a\x28\xd6\x4b\x59\xf4\x99\xa8\x19\x7d\x39\x48\xc9\x87\x0b\xfc\xd7\xb9\xcd\xca\x88\x67\xdf\x56\xa9\x7e\x68\x00\xe8\x13\x98\x36\x7c\xde\x69\x70\x5d\x7b\x28\x54\xfc\x3d\x47\x59\xd5\xb5\xcb\x93\x14\x53\xab\x35\x9b\xd1\xe5\x91\xce\xfa\x33\xd0\x6d\x24\xd5\xc9\x6a\xf1\x3f\x09\xb1\x20\x0c\x83\xbb\x61\x7f\x6b\xcc\x53\xc6\x99\x2c\x27\x43\xe2\x43\xe2\xce\x8a\x4a\xd6\xf0\x82\xfb\xba\x28\x50\x59\x7b\x5c\xa2\x33\x6e\xcd\xd0\x79\x2b\x24\x0c\x82\x28\xd9\x53\x4d\xf0\x10\x9f\x0c\x38\xbf\x44\x1d\x9d\x1f\xf2\xc3\xdc\xf5\x33\x4b\x05\x1f\x8f\x01\xcd\xa0\x10\x14\xaa\xc2\xa7\x11\xcf\xea\x0e\x77\x39\x82\xa6\xa5\xd4\x5b\xec\xd7\x25\x69\x32\xbd\xc9\x73\x63\xf2\xbd\xeb\x2e\x4d\x43\xdd\xed\x04\x61\x9b\xe2\xe3\x3b\xbf\xae\x2c\x03\x16\xbf\x9a\x57\x1f\xdf\x99\x1a\x19\x32\x49\x24\xb6\xe1\xbc\xdf\xe1\x32\xcd\x82\xd2\x2c\x66\xb3\x89\x92\xf3\xab\xbe\x47\x26\x12\xae\x71\x46\xb3\x0a\xad\x63\x94\x64\x54\x1a\x61\x19\x95\xf5\x64\xfe\xba\xba\x48\xf7\xf4\x9f\x06\x28\x98\xff\x34\x8d\x59\xd6\xed\xb6\xf0\x5b\xda\x5f\x2c\xb1\xaf\x96\x53\x2c\x9f\x45\xb6\x4f\x86\x6c\x29\xb1\xaa\xf4\x99\x87\xb2\xfb\xa6\x1b\xfa\x9b\xf7\x06\x8f\x77\x0e\x30\x91\x51\xd4\x48\xf7\x3b\x17\x03\x98\xc6\xf6\xef\xfe\x8d\x84\x5f\x0b\x08\x36\xf9\x68\xa2\x12\x74\x26\xa4\x3a\xd9\xf1\xa0\xb1\xbd\xab\x08\x8c\xd7\x79\x9c\x6e\x73\x8f\x20\xa2\x80\x58\xf8\x21\xb5\x93\x96\x89\xce\xa0\x46\x21\xfb\x39\x27\x49\x10\x3a\x5f\x0a\x7b\x3c\x8d\xf5\x4e\x35\xf0\x38\x3e\xf0\xb5\x6f\x61\x6e\x82\x77\xf3\x37\x1b\xa1\xdf\x08\xed\x2d\x01\xb2\x05\xba\x48\xa4\xd6\x48\x64\xf5\x24\xb0\x70\x04\xdd\x86\x9f\x71\xdd\x6f\x0e\xe9\x33\x60\xc7\xb9\x05\x8d\x8a\x80\x3e\x4a\x28\xaa\xaa\xb8\xd2\x04\x8e\x04\x90\x41\x92\xfd\x29\x2a\x4b\x2e\x00\x50\x71\xd4\x63\xe4\xf6\x0e\x9f\x2d\x4f\x66\x94\xd5\xe2\x98\xda\xf9\xd5\x68\xc1\x5d\xfd\xe2\x48\xd0\x2a\x3f\x9d\xf8\x03\x2b\xe5\x03\xab\xe4\x1e\xef\xe8\x3c\xaa\xbe\xc1\xac\xf9\x6a\x75\xa5\xbf\x19\x04\xb2\x78\xe0\x05\xfb\xb1\x07\x6e\x3c\x43\xd8\x9a\x51\x83\xca\x47\x0f\x1e\x88\xe5\xa5\x4b\x31\xa9\xd8\xfd\xa9\xe0\xd7\xad\xfd\x0b\x67\xf8\xb4\x71\x59\x0b\x00\x80\x6a\xa5\x7a\xb0\xec\xf5\x34\x26\x5b\x59\x23\x50\xdd\xa5\xc2\x71\x0e\x96\xdb\x2e\x5c\x7e\xea\x73\x53\x78\x88\x83\x7f\xb8\xe0\x4f\x87\xef\x99\x98\x3d\x1d\xd2\xa1\x96\xe6\x13\xa2\x8d\x1b\xda\x6a\x55\xfe\x95\x66\x03\xef\x38\x3c\xea\x58\x44\xfb\xcb\x11\xbf\x49\x50\xa9\x1b\x66\xa8\xdf\x1b\xe4\xd3\x2e\x49\x78\x23\x4d\x6c\x18\x30\xe4\x9f\xae\xbe\xaa\x7d\xd7\x85\x3c\x8c\x48\x15\x7e\x58\xdc\x96\xda\x46\x40\x4d\x1a\xc3\x30\x35\x23\xf4\x82\x13\x3a\x23\x2a\x14\x98\xc6\x4d\x8b\x37\xbc\xfe\x0f\x7c\xc8\xe0\xf2\xec\x7c\x58\x67\x9c\x1d\xc8\x51\xf5\xe7\xc0\xb7\x18\xc2\x34\xb3\x0a\x28\x33\xf0\x7d\x2f\x9a\xb5\x3e\x08\x73\x41\x62\xf8\xf7\xda\xed\x2f\xdb\xe2\x01\xab\x8f\x30\x65\x4f\xc4\x7d\x1d\xd4\x25\x3b\xd6\x1a\x53\xb8\xc3\xac\x03\x99\xbd\x2a\x3f\xdd\xa0\xbf\x3c\x2b\x94\xc1\x67\xc4\xdd\x1c\x5e\x87\x21\xa7\xf5\xa6\x00\x57\xf9\x7e\xd3\xb7\x9e\x3c\x61\xe7\x99\x0f\xcf\x92\x73\xe8\x49\x3f\x79\xf3\x5e\xe5\x82\x1e\x11\xf4\x1b\x43\xf5\xf0\x37\x47\x3b\xd6\x2d\xa3\x38\xad\xb0\xe9\xdc\x3b\xbc\x29\x0d\xd2\xe7\x83\x2f\x85\x35\x7a\xd1\x8d\x43\x84\x25\xf3\x3f\xa2\xc8\x43\x7f\xfd\x9f\x30\x29\x24\xaa\x5c\x12\x73\xb3\xe6\x74\xbc\x80\x2d\x68\x7e\x8d\xaa\x23\x20\x3a\xaf\xbc\x19\x96\x45\xca\xa9\x19\xbf\xf3\x97\xad\x13\xa9\x16\x86\x08\x7e\xba\xb9\x34\x18\xc7\xeb\x01\x1a\xea\xa4\xf5\xc7\xad\x92\xb1\xec\x30\x1b\x18\x2d\xd0\x82\xf9\xcd\x6c\xec\x6a\x15\x9c\x04\x1c\x84\xa2\x23\xe8\x42\xa8\x7a\xe3\xc3\x26\x41\x6a\x75\x3d\xf9\xbb\xff\xf3\x37\x13\xf9\xf3\x0f\x63\x59\xbf\xa3\xe2\x7a\xfc\x36\xd0\x78\x11\x04\xed\x77\x3d\x0e\x5a\xb5\x3b\x71\x9c\xe9\x9e\x36\x4c\x53\x56\xe2\xb8\x8c\x30\xb9\xb7\xe6\x75\x13\x90\x50\x85\x34\x62\x43\x71\x22\x1c\x9b\xb2\x13\x49\x9a\xc7\x3b\x13\xd9\xe0\xce\x09\xb5\xeb\x1e\xc4\xf4\x2e\x09\x1e\x57\xda\xb4\x91\xe8\xc9\x4f\xe9\x99\x73\xe2\x34\xbc\xa6\x14\x24\x65\xa9\x95\xce\x7e\x5e\x2b\xc9\x82\x8f\x33\x0c\xed\x44\x28\x0c\x1c\xd6\x1b\xbc\xb1\x6c\x4d\xf3\xe1\xfc\x35\x2f\x8a\x63\xfe\xaa\xef\x25\xb6\x73\x35\xc7\x42\x76\xa9\x69\x98\x27\x0a\x7f\xfd\x6f\x8b\x71\xc2\xda\x53\x40\x9b\x04\x7e\x03\xa3\xb4\xc2\x41\x39\x3f\x94\x46\x7f\x97\x5b\x3a\xb8\x82\x2b\x85\xfd\x5c\xb8\x28\xff\xb9\xb1\x53\xf9\xf5\x67\x82\xd0\xde\xff\xd2\x2b\x71\x44\x27\xe3\x8d\xe2\xa6\x4b\xc1\x85\x60\xdb\x4b\x0d\xf8\xa7\x5d\xe3\x11\x6b\xa2\x71\x75\xff\xd3\x46\x58\xbc\x12\xfe\x44\x93\x3d\xde\x33\x5e\x57\xf3\xad\xdb\xe3\x17\x13\x43\xdd\x16\x8e\xa7\x17\xce\x45\xd2\xcb\xcb\x36\xf5\x74\xe6\xbd\x30\x0c\x42\xfd\x26\xe9\x8b\xb0\xa5\x40\x15\x7f\xf9\xce\x0f\xb7\x66\xdb\x6f\x32\x21\x85\x5d\xc4\x21\x6c\xea\x9f\xf9\x47\x62\xa3\x2d\xd2\x97\x60\xfe\x02\xd6\x70\x4d\x03\x96\xcf\x17\x4f\xf1\x93\x2d\x0a\x44\xe5\x47\xb9\x3f\x37\xc5\xcb\x2b\x83\x89\x28\x4e\x6f\x2f\xc3\x7c\x59\xe9\xc9\xa6\x1f\x7e\x74\xbe\x41\x56\x8e\x07\x6c\x1f\xdb\x2a\x6e\xe8\x87\x52\x17\xa0\x8e\xb1\x84\x74\xea\xdf\xf3\xc8\xbf\xd9\xfc\x32\xde\x65\x17\x0b\x91\x18\x64\x18\x5d\x3c\x34\xde\xc1\x7e\xb5\x87\x2f\xa5\x94\xf1\x0a\x77\x10\xfe\x2c\x6a\x54\xdd\xeb\x96\x65\xd1\xbe\x4c\xa6\x39\xc2\x6f\xcf\xb4\xc9\x50\xd3\xa5\x19\xf5\x47\xbd\x28\x1b\xa0\xd8\x3e\x7d\x7c\x76\x2c\x0f\x18\xb4\x4a\x1f\x25\xc0\x73\x05\xc7\x8c\x21\xbf\x48\x0e\xe7\x9a\x97\x77\xc2\x63\xfb\x7b\x3e\x00\x26\xfb\x63\x29\x77\x69\xf4\xd9\x9e\xdf\xc1\x15\x26\x25\x29\xed\x98\x8b\xd2\x80\x50\x0c\x42\xdb\xc9\x4d\x57\x8d\x28\xc0\x53\x05\x92\x89\x27\x98\xea\x75\xde\x35\x34\xd6\xe1\xb8\x35\x5d\x61\x8a\xb4\xde\x9a\xf8\xb2\x3f\x5b\x42\x3e\x75\x42\xb8\xa8\x7e\xd8\x01\xfc\xd3\x1f\xd5\x3d\xf8\xfe\xfc\x38\x5a\x8b\xb6\x18\x8d\x12\xf9\xba\x89\x6c\x49\xe6\x4d\xab\xfd\x97\x78\xc0\x0d\x7e\x3b\xf6\x67\x5e\xc1\xd5\xeb\x82\x59\xde\xcf\x2b\x27\x6d\x7f\xb4\xac\xb5\xe5\x6f\x7e\xae\xe6\xbb\x6e\xf9\x9f\x33\x8d\x6f\x06\xaa\x9a\xa8\xc7\x3a\xa8\x6d\x62\xc9\xfe\x54\x6b\x38\x07\x7d\x66\x53\xa2\x5b\x18\xf8\x70\x7c\xa4\x85\x9c\x06\xef\x1f\x75\x36\xd4\xe2\x58\xa5\x90\x7b\x55\x81\x59\xc4\xef\x8d\xb7\xa0\x5f\x8b\x12\x0f\x13\x7f\x61\x78\x0b\x5b\x7b\xc1\xa0\x8a\x02\xed\xb0\x4e\x20\xfe\xcd\x9f\x8e\x05\x6c\x14\xfe\x7a\x80\x09\x3b\x7c\x08\x94\x7c\xf5\xc7\xb7\x9c\x66\xd5\x7e\x15\x8a\x19\xb9\x65\x2a\xbc\x3b\xa3\x73\x2b\xb0\xd0\x63\x2c\xb6\xa4\x4e\xfd\x9a\x9c\x11\xb2\x13\x68\x70\x0d\x27\xd6\x3e\xa1\x86\x63\x03\xb7\x44\x09\xca\x4d\x8e\xa8\x96\x66\x5b\x68\xd4\x8e\x08\x16\xe9\x61\xba\x53\xe8\xe9\x2e\x95\x1c\x86\x3b\xd4\xce\x1f\xf9\xdc\xf6\x2f\x06\xfe\x68\xef\x76\x53\x40\x25\x34\xb3\xf3\xd6\xf9\xb7\x86\x73\x0e\xd4\x21\xda\xf6\x73\x54\xbc\x0c\x03\xd4\x2f\xda\x18\x7c\xea\x54\x1f\x5a\x91\xa9\x45\x5b\x66\x3e\x36\x2a\x7e\xd9\x71\xd2\x6a\xdc\x2c\x81\x04\x4b\x96\x37\x4b\xe2\x1a\x01\x12\x1c\xf8\x9b\x20\x71\xfa\xe4\x86\x80\x39\xac\x20\x76\xfc\x1b\xc0\x7b\x09\x91\x05\xfa\xc5\x4b\x65\xe7\x4f\xfd\x57\x1f\xfd\x26\x16\xf5\xaf\x18\xdd\x00\x68\x90\xbb\xf5\x2a\xc8\xd5\x3b\xc3\x5f\x01\x13\x81\x13\x98\x22\x4b\xb9\x87\x45\x9c\x7d\x6f\x1a\x2e\xe6\xcf\xcf\x31\x8a\x79\xfa\xb0\xef\x53\xce\x3f\x38\x46\x10\x3a\xc6\x6e\xba\xb7\x93\x78\x96\xb0\xd6\xcd\xf6\x57\xf2\x4a\x3f\x33\xdb\x8b\xa0\x37\x14\x3f\x1e\x2a\xd3\x7b\x64\x7e\x76\x4f\xd3\xf4\xf8\xa1\x3c\x72\xfd\xce\x18\x00\xb6\x17\x12\xbd\xc9\x2c\x70\xb9\x29\xcf\xcc\x4f\x79\xff\x0e\x1c\xbe\x27\x48\x12\xa8\xf6\xa6\xe1\x18\xa5\xde\xea\xe5\xa7\x31\x07\x4c\x57\xba\x01\x1f\x63\xde\xc5\x89\x62\xe8\xa9\x0b\x85\x27\xc1\xbd\x62\x74\x91\x82\x9f\x3f\xc9\x92\x2d\x0b\x33\x53\x41\x3d\xb1\x2f\x4d\x61\x05\x22\x90\x77\x71\x20\x57\x74\x43\x2d\x89\xd5\xb4\x0d\x11\x5f\x28\x5c\x53\xa4\x56\xb4\x78\x85\x1f\xd1\xa1\x3e\x4e\x2b\xff\x70\xfa\x23\xc6\xac\xfc\x35\x07\xda\xbd\x80\xfc\x65\x06\xeb\xc7\x48\xfd\xe5\xca\x8e\x21\x63\x07\x9a\xff\x6a\xca\x99\x0c\x7a\xd9\xa5\x1e\xfb\x79\x12\xae\x2e\xf9\x81\x76\xcf\x89\x81\x63\x07\xda\x95\xcc\x64\x7c\x6c\x8c\x9a\xc9\xe3\x9a\x4d\x00\x39\x49\x90\xc9\xe5\xc7\x9a\x30\x33\xae\xfe\x4d\x14\x66\x04\x5d\x4a\x9c\xf0\x4b\x34\x9f\x37\x6f\xdc\x09\xf9\xd8\xa3\x63\x1c\x3f\xae\x4e\x24\xba\x81\xca\x6e\xd1\x4f\x83\x3e\x82\x67\xce\xef\x33\x2a\xb7\x31\x8e\x6d\x48\xb5\x8e\xe0\xe4\x46\x9c\x07\x21\xdd\xbd\x25\xb0\x36\xaf\xf1\x82\xd9\x0f\x66\xac\x4c\x04\xa3\x12\xf3\xa3\x4b\x5c\xa0\x7c\x7f\xfd\x18\x76\xed\x79\xfe\x49\xef\x96\x19\x53\x4d\xc5\x41\xfb\x8b\xfd\xc8\xf6\x4e\xf3\x29\x0e\x84\xcd\x9e\xb9\xc2\x4f\xc0\xfc\x06\xb0\x98\x5b\x06\x6f\xfe\x85\x57\xfd\xfa\x8b\xf4\xc4\xb2\xdf\x2a\x86\x1b\x0b\xd2\x07\xbc\x4c\xcb\x73\x74\xd5\x6e\x3d\x19\x0c\x14\xad\xf1\xc5\xcc\x05\x18\xbf\x16\x9c\xe4\x62\x12\x28\x75\xcb\xfe\xbc\x66\xd0\xcf\x8e\x51\x87\x0d\x32\x4b\x13\xac\xfd\x73\x97\xa7\x4c\xee\xc7\x09\xff\xf0\x36\x2a\xd7\xc4\x24\xdc\xb5\xb9\x97\x2c\x61\x46\x8a\x5f\x3f\x6a\x9b\x7b\x66\xfa\xeb\xef\x6c\x77\xc2\x9a\xfb\x4e\x5a\x12\xcf\xde\xe0\x43\x7d\xf9\xcb\x13\xf6\x26\x89\x64\x0a\x8d\x1b\xe6\xf2\xd3\x1d\x69\xeb\x59\xcb\xa4\xbb\x55\xaf\x6f\x5b\xdd\x58\x44\x73\xa1\xb4\x04\xbd\xea\x50\xa5\x6d\x30\xdf\x06\x66\x44\x44\xe8\xa7\x1f\x67\x58\xf6\x67\xc4\x15\x2b\xb1\xfe\x44\xe6\x1e\xc1\xeb\x63\x04\x7f\xcd\x55\xf7\x32\x91\xde\x88\x76\x27\x46\xde\x3e\x85\x42\x84\xdd\xe4\xfb\x15\x8a\x6f\x95\x26\xd5\x89\xc8\xa2\x28\x4e\x27\x7e\xc8\xd7\x90\xd1\x95\xed\x60\x7c\x5b\x6c\xd7\x1c\x21\xf8\xf9\x3a\xc9\x3e\x7b\x29\x46\x22\xd5\x2d\x77\xd9\x32\x08\x54\x16\xb9\x59\x8e\x4d\x1c\x39\x52\x59\x00\x78\x4d\x81\x79\xa7\x14\x5d\x9e\xa3\x83\x5b\xed\xb4\x10\x46\xeb\xa6\xc1\x11\xf1\xc7\x05\x2a\x26\x03\x62\x76\xb2\x8f\x22\x36\xb1\x5e\x6a\x0e\xc5\x83\x7b\x77\x91\xf8\x45\x0d\x9f\xbb\xd6\x2f\xd0\x1e\x3b\x7c\xd4\x04\x6c\xed\xca\x93\xe8\xba\xf0\x2f\x5f\x36\x9b\x94\xea\xb0\x9a\x4a\xec\xba\x67\x02\xaf\xf4\xe5\xdf\x9c\x81\xe5\x5e\xd6\xc4\xf1\xe1\xf2\x2a\xae\xf5\x59\xd5\xea\x4c\x36\xe3\x35\xfc\x40\xe6\x27\x83\xbb\x4a\xf1\xb1\xae\xe0\xfd\x49\x1c\x9c\x27\xaa\x7d\x10\x01\x77\x75\xf3\x2e\x98\x3d\x3f\x62\xbd\x0f\xc3\x30\x92\x12\x2f\xad\x72\xd4\x7c\xe5\xef\x4d\x34\x3c\xee\xf3\x30\x4d\x55\x9b\x1e\x4c\x3f\x2c\x4b\x72\x3b\x8f\x78\xb0\x45\x60\x1a\x4b\xe6\x37\x6b\x01\xc6\x9e\x74\xe0\x4f\x80\x51\x75\xab\xde\x7e\x14\x8e\x38\x4c\xe8\x62\xf7\x14\xc6\x29\xdb\x24\xbd\x52\xfd\xd8\x39\x6d\x50\x69\x6b\x08\xda\xc3\xcb\x8c\x17\x45\x81\xd6\xdb\x7d\x7c\xd5\x78\xbc\x34\x38\x43\xd8\x74\x18\x36\xa4\x06\xb5\x88\x23\x78\x5b\x24\xeb\x87\xe1\x89\x02\x4f\xe6\x2c\x4e\x6f\x57\x2a\x4f\x80\x1c\x04\x05\xf7\x97\x04\x07\x6b\xb6\x6f\x0f\xdd\x36\x86\xd5\xc2\x36\xd8\x3f\x8d\xb7\x92\xfe\xd1\x9b\xab\x16\x23\xc6\x12\x61\x4b\x57\x40\xf8\xd5\x14\xbb\x0d\xde\x7e\xe3\x49\x6c\x48\x15\x12\xe9\xdf\x3f\xdd\xb3\x90\xb9\xbc\x56\x0b\xa1\x87\x3c\xf4\x9a\x49\x17\x6e\x59\x96\xd5\xba\x0d\xb7\x1d\x84\x21\x0e\x9e\xc2\x6e\x3b\xa7\xa5\x15\xf1\x19\xd4\x21\xa5\xcb\x6f\x2a\xc0\xc9\x4b\xd8\x8c\xfb\xc4\x2f\xc6\x01\x95\x8d\x03\xd5\xc8\x5c\xcb\xb2\x64\x9b\x9d\xdf\x80\x15\x24\x42\xcd\xb1\xee\x0f\x05\x03\x12\x14\xfa\xd8\xed\xd6\xff\x2c\x2c\x2e\xb3\x3a\x7f\x1a\xd4\x99\x0a\x75\x50\xe1\x07\xa3\xad\x93\x1f\x8c\xe3\x7e\x67\xff\xaf\x25\xfa\x24\x09\xfe\xeb\x45\x70\x1f\x5f\xf4\x7e\x8d\xf2\xc8\x11\xfa\x99\xf3\xbb\x8f\x39\xbe\xcd\x5f\x76\x21\xda\x4f\x60\xfb\xc7\x17\x99\x48\x41\x71\xe6\xca\x48\x07\xe6\x07\x0a\xfd\xf2\xaf\x29\xd6\x4a\xbb\x53\x91\x07\xda\x95\x0f\xfc\x48\x24\x32\xc6\x2b\xda\xc3\xbc\x73\xfd\x18\x31\x54\xce\xd7\x05\x72\x55\xe4\xd7\xc5\xa8\x29\x0e\x89\x6e\x59\x12\x09\xa1\xc0\xf1\xb6\xe5\xaf\x25\xb0\x5c\x3b\x7b\x51\x7f\x28\x2b\x77\xac\xad\x7c\x33\x0c\x82\x90\xe4\x6f\x9a\x85\x7f\x62\x6f\xb3\xf0\x29\x63\xdb\x0d\x33\x1a\x57\x01\x81\xf3\xac\x36\xa3\x7d\xd4\x14\xbb\x02\xc0\xb8\xee\x26\x61\x5a\x8e\x69\x35\x6f\xba\xba\x40\xcc\x2f\x57\x78\x5a\x4e\x70\x19\xf1\x2b\x9d\x96\x55\x96\xdb\x41\x24\xb0\x0a\x5d\xd3\xa4\x70\x87\xe5\x19\xcb\xa2\x70\x5a\xcd\x35\x2e\xeb\x39\x36\x2f\xcb\x75\x75\xb0\x39\x09\xe3\x68\xe1\x86\x6b\xb9\xce\x24\xb8\x52\x36\xfe\x8e\xb6\x15\x7e\x0b\x30\x4c\xcd\x02\x0d\xe1\xf0\x03\x2d\x5a\x3b\x4f\x42\xcd\x32\xcb\x96\x03\x45\xa5\x05\x23\x80\x5c\x7d\xea\x05\xba\xb8\x5c\x9d\x00\x7d\x0a\x9e\xe7\x14\x3e\x47\x15\xc4\xfc\xd6\x75\x2c\xdd\x5b\xf6\xa8\xdf\x96\x1e\xe3\xc3\xd7\x2b\x16\xba\xb8\xe1\xc9\x3e\x7e\x0d\x43\x5d\x27\x1c\xea\x07\xb7\xe3\xe5\x1b\xbd\x23\xc3\x5e\x56\xf1\x1b\x8a\x77\xfa\x53\x6c\x52\x08\xac\xf5\x08\xb8\x78\x33\x76\x08\xd0\x97\x3f\xaa\xfc\x55\xdf\x60\x63\xbf\x04\xcb\x71\x0a\xcc\xfd\x3c\x1f\x0c\x10\x6f\xe4\x7d\x39\x8a\x31\x85\x4d\x73\xef\x08\x50\xf5\x99\x0d\xa8\x2e\x42\x72\x0e\x0d\xd9\x56\xac\x3f\x4e\xf9\xbb\x3f\xa1\xb9\xfc\xdc\xa8\x2d\xf9\xa3\x93\x5f\x6d\x58\x96\x1a\xab\x0c\x47\xdf\x19\x87\x55\xa2\x8f\x49\xbc\x79\x41\xcc\x9e\x16\x78\x9c\xb7\x86\x04\xae\xf9\x1d\x2e\xf1\xdc\xc8\x63\xa0\x40\xd9\x3e\xa4\x8a\x5b\x9b\x01\xa5\x91\xf3\x5b\x39\x3b\x57\xbe\x4f\xfd\xb1\xe1\x56\xdc\x27\x61\xd2\xa2\x89\x27\x37\x3f\x82\x5d\x2a\xec\x1b\x0c\x82\x60\x5d\x6f\x8c\x52\x3e\x54\x2c\xf8\xc2\x6d\x3d\xde\x9b\x69\xed\x34\x1d\xca\x66\x24\x90\xb8\x2b\x9c\xd1\x0d\xd9\xd0\x4c\x88\x86\xd3\x25\xe4\x86\xc8\xf4\x98\xdf\x12\x51\xc9\xba\x80\x2d\xef\xc9\x21\x7f\xfb\xd0\xc7\x37\xed\x80\x8b\x7a\x3d\x4b\xfe\x22\x8c\x76\x04\x57\x20\xde\x65\x65\xa1\x5b\x94\x47\x0c\x64\xa3\x34\xb8\xd5\x11\x50\xda\x42\xab\xb6\xa9\xb4\x1c\x05\x85\x9d\xb5\xba\xd5\x11\x51\xa2\xad\x83\x3a\xb5\xce\x58\x7e\x3a\x61\x8d\x7c\xe2\xca\x3e\xaf\x0f\x55\xd9\x68\x56\x38\xf5\xb7\xaf\x56\x88\x80\xb6\x6b\x87\x7b\xc9\x6d\xd3\x55\x59\xac\x7a\x32\xf9\x0f\x92\xf6\xdb\x10\x94\xb6\xcc\x41\xb3\x16\x48\xeb\x84\x8d\x3f\xdf\x29\x1e\xb8\x00\x47\x2f\xec\x1f\x14\x9b\xa3\xec\x73\xb3\x25\xeb\x98\x5f\xd2\xc2\x62\x4e\x41\x89\xdb\x66\x1d\xb7\x3e\x1d\xd0\x81\x4f\x73\x7c\x7f\x15\x77\x5d\x47\xad\xf1\xe4\x3d\x27\x7d\xe6\x8f\x35\x2d\xd6\x77\xf8\xa6\xa7\xf1\x4d\xb2\x1f\xcf\x11\x25\x77\x48\x15\xcf\xba\x31\x70\x7b\x46\x48\xe5\x85\x4d\xe1\xeb\x82\x9c\x74\xa1\xde\x4e\x8a\x0b\xfc\xd9\x2d\xe9\xa3\xf6\xde\x18\x28\xa5\xec\x86\x62\x31\x2c\xe5\x4f\x8b\x0e\x7e\x9a\x73\x67\x1c\x46\xb1\x62\x6c\x0b\x01\x9a\xa5\x32\xb0\x1a\x51\x10\x1d\x61\xa1\xdb\x96\x63\x09\xec\xcf\xbe\xb8\x1a\x00\x7e\x1b\x06\x3f\x38\x88\xa0\x41\x9b\x54\x44\xb1\x09\x22\xf9\xdb\x53\x92\x86\x58\x6a\x47\x14\xbc\xf1\x35\x5f\x2f\x2e\xd2\x2f\x8b\xcb\x16\x0b\x2e\xec\x56\x91\x50\x48\xd8\xc4\x6b\x8f\x2f\x25\x8f\x5f\x44\xd6\x6a\x97\x50\xd6\x2d\xf9\xe6\xe0\x78\xfc\x74\x29\xb4\xc6\x9b\xa0\xca\x96\x07\x1f\x2d\x1d\x7a\x28\x65\xed\x53\x67\xfe\x07\x5d\x85\xaf\x4b\xad\x3f\x18\xd8\x5b\xd4\x5f\xfe\x02\x43\x72\x37\x44\x88\xc1\xc7\x28\x46\x6f\x75\x42\x7d\x95\xdb\xf0\x84\xc5\x85\xf6\xad\x50\x3f\xb8\x80\x5f\x46\x28\xba\x50\x2e\xbf\x0e\xc3\xb4\x3f\x22\xa9\xf2\x98\xca\x87\xa7\x69\x23\x70\x74\xe9\x18\x7a\xc1\xfc\xb0\xb0\x2a\xf6\x69\x19\x0b\x37\x7d\xf5\x9a\xf0\xe3\x64\x65\x43\x3a\xb8\x7a\x65\x53\x47\xce\x19\xb4\xec\x41\xd6\x3c\xea\x4f\xde\xa9\x96\xd0\x61\x93\x73\x54\x2f\x00\x9e\x46\xcd\xdd\x6c\xd6\xf0\x68\xd5\x62\x3f\x8a\xdf\xe1\xc4\x4d\xe3\x6e\xe3\xb7\xa1\xe2\x36\xf0\x26\x37\xe3\x3e\xd7\xa6\x73\x3f\x1f\x04\x81\x7b\xae\x08\x2d\x3a\x7c\x71\x2c\x2a\xa3\xdc\xf5\x27\xbc\x3d\x1f\xc1\x6d\x3e\xcc\x2e\xa1\xf1\x3a\x8c\xfe\x5c\x3b\xfa\x6b\x9c\xba\x85\xfa\xb5\x22\x4e\x59\x1f\xa7\x07\x4a\xc9\x53\x15\x93\x35\xf7\x73\x5a\xa2\xf7\xe1\xcd\xdb\xe8\xd7\x67\x34\xa2\x21\xcf\x36\xed\x63\xc2\xa2\x3d\xde\xca\x16\x08\x22\x7b\x45\x95\x91\xe9\x0e\xcb\x70\x4c\x8f\x1d\x22\xaf\x1c\xa1\x60\xc7\x88\x96\xe6\x70\xb0\xad\x19\xa2\xf6\x4d\x56\xf5\x18\x30\x60\xca\x5b\xd8\xf5\xb5\xd5\x61\x14\x86\xe1\x8e\xea\x0c\xbb\x06\xe4\xb0\x0f\x64\xe9\x8c\x0f\x17\x54\x79\x69\xfb\xfc\xce\x58\x8c\x2e\xcf\x81\x9c\xa3\x41\x6f\x33\xbd\xab\xb2\x4a\x01\xc8\x06\x98\x35\xf2\xa4\x7e\xaf\x6d\xd5\x96\xa5\x1d\x38\xb6\xa6\xd1\x42\x08\x83\xa5\x8c\x1b\x8c\x92\xeb\xee\x83\xd1\xac\x88\x69\x89\xec\x72\x6d\x49\xfd\xfc\x05\xee\x37\xa5\x00\xa0\x02\xf3\x52\x4f\xa0\xec\xfb\x18\x5f\x92\x7e\x80\xaf\xdf\x5e\x4d\x03\xce\x5a\xe9\xa3\xe4\x8e\xf5\x03\xa4\xbd\xfc\x29\xab\x18\x09\x13\x34\xb2\x7a\x33\xd3\x65\x0c\xc3\x39\x42\x93\x7f\x3c\xc2\xcb\x7f\x73\x9a\x84\x87\x0a\x75\x4c\xb5\xc0\x73\x4d\x1a\x52\x85\xd3\xf6\x01\x98\x2b\x51\x9a\x40\x7b\x52\x9f\xd0\xab\x26\x29\xc5\x29\x93\x54\xc8\x44\xc9\x9f\xd7\x08\x40\x95\x54\xa4\xc9\x5f\xb7\xb5\x10\x46\xa5\x9c\x72\xc4\x0e\xe5\x58\xff\xc2\x81\xf7\xee\xa9\x31\x8b\x13\x1a\xc3\x0f\x52\xd9\x08\xc3\x39\xd3\x9a\x4c\x8f\xe2\xd4\xed\x21\xe5\xe8\x8e\x8b\x53\xbe\xc6\x88\xbb\xd3\x47\xd2\x5d\xb4\xe0\xdc\x04\xd3\xa3\x35\xfc\x19\xc3\xe1\xdd\x47\x30\x0b\x96\xa1\xfa\x81\xc8\x9e\x63\xdd\x89\x27\x73\xcd\x4c\xaa\x83\xdf\xac\x20\xf2\x29\x06\xcd\xd2\x59\x15\x46\xc8\xe2\xfd\x7f\xec\xbd\xc9\x8a\xec\xd0\xb6\xb5\xd7\xff\x9f\xc2\x5c\xb7\x8c\xc0\xaa\xab\x73\xb0\x41\x11\xaa\x42\x75\xa8\x96\x70\x47\x75\xa8\xae\x4b\x73\xdf\xdd\xac\x3c\xc6\x9c\xeb\xde\xdf\xbf\x01\x9b\xcd\xde\x64\x86\x22\x95\x92\xd6\x9a\x73\x8e\xf1\x8d\xcf\xaf\xf7\x66\x90\x6b\x24\x40\x3f\xf7\x64\xb5\x02\x2f\xf0\x74\x15\xde\x90\x7d\xef\x89\xc1\x96\xbe\x4c\x73\x02\xf7\x3d\x3d\x18\xed\xed\x36\xae\xdf\x79\x27\xf5\xbf\x45\xb8\xef\xad\x11\xb1\x63\xac\xd4\x57\xf4\x79\x2f\x6f\x58\xac\x61\xb6\x21\x0b\x84\x34\xf9\x6d\x2e\xdc\xd7\x37\x14\x03\x3c\x3f\x3e\x3e\xb1\xfa\x71\xe0\x83\x19\x5a\x55\x1d\xe7\xab\xf0\x9a\x91\x5d\x12\x69\xc1\x28\xa3\xe7\x53\xa5\x75\xea\xc0\x7d\xae\x11\x03\xba\x85\xd1\x39\x53\xe5\x59\x09\xa8\xdc\x9f\x68\xb2\x04\x77\x1f\xca\x09\x9b\x37\xba\x21\xe9\x7c\x1f\xca\x0d\x95\x07\xa0\x5d\x01\xb2\x44\x26\x87\xf3\x1d\xcc\x04\x46\x8a\xd9\xc1\x9e\xad\xc2\xaa\x10\x0b\xb0\x53\x62\x47\x9d\x75\x6f\xa9\x50\xf8\xb5\x88\x0b\x1f\x0e\xc3\xee\x3d\x98\xcd\x98\x50\x3b\xdd\xcc\x5f\x86\x53\x2b\xe0\x0d\x1d\xf8\x8d\x16\x6b\xd4\xc0\x8e\x74\xc7\x27\xf3\xd5\xe1\x69\xc0\x94\xe1\xa3\xf8\xc7\x1f\xab\xc7\xc2\x71\xc6\xd1\x78\xae\xbd\x73\xb3\x1d\xb0\x24\x87\x16\xef\xce\xb4\x37\xd7\x79\x16\xc7\xd9\x60\x53\x60\xab\xed\xe4\x35\x3c\x02\xcf\x89\xb9\x2e\xc2\x7d\x6e\x96\x76\x67\x53\x2a\xc6\x90\xfb\x69\xc3\x13\x1a\xb0\x3b\x06\xb9\x1a\x20\x9b\xe2\xbb\xed\x6e\x1d\xfa\x0b\x8a\x74\x4d\x07\xdf\x76\x38\x51\x1f\x2d\xe3\x4b\xc6\xa9\xa0\x61\xdb\x2a\x66\xdb\x84\x43\xa6\x51\xa8\xb3\x39\xce\x9c\x1d\x21\x54\xef\x95\xcc\x8e\xd0\x9d\xda\xd5\xda\x21\x2b\xdc\x9a\xa8\x57\xa1\x5c\xc8\x66\xf5\x3e\x5c\x84\x35\xf8\x6a\xd4\xc3\x47\x59\xe1\x54\x6f\xbe\xab\xc8\x49\x1a\xc4\xa9\x6f\x27\x89\xc7\xb1\xb4\xc8\x4b\x2f\x3b\xe2\x5b\x22\x69\xa7\x89\x28\x9a\x87\xd1\x47\x7c\x55\x99\xa8\x3f\x63\x35\x6c\x64\x11\x5b\x45\x46\x84\x1c\x01\xc1\xe9\x6a\x67\x9f\x72\x0e\x97\x0b\xb6\x06\xf2\x14\x78\xa1\x0a\x51\xca\x7c\xd6\x54\xfa\x8d\x8e\xa6\xbd\x81\x44\xb9\xad\x6d\xb3\x75\x8e\x83\x81\x4a\xcb\x3f\x60\xa2\x2e\x98\xae\x71\x64\xc6\xe1\x38\x8e\xf4\x51\xdf\xb7\x43\xee\x09\xe1\xc4\x58\x73\x25\x06\x1a\xf4\x33\x57\x08\x8b\x0b\x82\x2b\x2b\x2a\x27\xd6\xa1\xb2\x5c\xbf\xda\x13\x51\x6a\x35\x37\xda\xf6\xd5\x8e\xd0\x51\xe8\x8f\xca\x98\x2d\xa2\xf3\x25\xe0\x32\xc2\x27\x77\x5c\x70\x73\x9a\x6a\xc3\x96\xd2\x18\x61\x7e\x67\x87\x57\x54\xc1\xcc\xee\x32\x9d\xf2\x0d\x7d\x27\x28\xd0\x2d\x0d\xe5\xe6\x42\x52\x6b\x70\x23\x30\x6b\x3f\x58\x68\x80\xe2\x77\xbc\x07\xb4\xd1\x57\xe1\x43\x4e\x81\xd7\xf1\xfc\xae\x57\xab\xfa\x5e\x2b\xe8\xd4\x26\x8c\xa2\x62\x23\xe7\xda\x1f\x2c\xbf\xca\xbe\xc8\xb0\x82\xe7\x5f\xdc\x06\xc1\xc5\x95\xb9\x02\x63\x65\x15\x6f\x4f\xc8\xe8\x12\xdc\xcc\x83\x39\x4e\x77\x93\x89\x5b\x66\xfd\x4b\x43\xbe\x35\x92\x8c\xbe\x4a\xf6\xd0\xa7\x2a\xa0\x3c\xd2\xeb\x61\x6e\x6f\xeb\x7d\x67\xa8\x79\xb7\xc8\x29\x0d\xf7\x3b\xfd\x25\x06\xd6\xbb\xd9\x16\x2c\x3e\x7f\x80\xfb\x05\x8b\xee\xe4\xd6\x59\x85\xfa\x6e\xa1\xb5\xf6\x7a\xbf\x8e\xf3\xbd\x9f\x5a\x9b\x40\xa5\x0c\x8b\xa4\x66\x9f\x66\xcc\x42\xc5\x91\x4a\x42\xbe\x63\xb2\x0c\xcb\xc0\xc2\x0e\xf2\x4a\x95\x11\xfa\x5e\x47\x6f\xb6\x88\xf7\xe1\x7a\x0b\x93\xec\x12\xf9\xd9\x04\x52\xb8\xe9\xa3\x83\x7e\xad\x98\xed\x41\x8b\x23\x31\x47\xa8\xa0\x75\xd5\xbb\x71\x4f\xa5\x0c\x5c\xc8\x24\x9b\x66\x05\xbc\x1f\xa1\x08\xc1\xab\x2f\xda\xd9\x4d\x21\x07\xff\xd5\x35\x95\x2f\xb3\x46\x57\x3d\x93\x7f\x2d\xbb\x1d\xc6\x67\x24\xac\xee\xaa\x29\x18\x04\x1f\x64\xa6\x4d\xf9\xda\x07\x70\x4d\xe0\x70\xb9\xda\xa6\xe1\xda\x53\xb0\xf8\x3f\x25\x33\x3d\x02\xf9\x60\xda\x7c\x1f\xb1\xe1\x50\xc0\x39\x20\x90\xa3\xe7\x8b\x57\x5e\xf2\x5c\xfb\x2b\x68\xb1\x29\xf5\xa2\xba\x2a\x89\x5b\x5e\xb3\xf2\x93\x5e\xd3\x2f\x0a\xc4\xf7\xbb\xeb\x6e\x89\xc8\x14\x94\xb3\xd7\x37\x2b\xd8\x6b\x2a\x1d\xe1\x82\x2b\x1d\xb9\x02\x6f\x62\x3b\x06\x1a\x73\x58\x37\xc2\x55\xd3\xe7\xa5\x2a\xe2\x4a\x96\x9f\x0f\x86\xaa\x7f\x7a\x74\x6a\x5f\xc6\xea\x3c\xc5\xbc\xd5\x79\xba\xc0\xe8\x1e\xaf\x22\xe1\xc5\x04\xaa\x2b\xfc\xcc\xae\x4d\x3d\xce\x11\x6d\xa3\x9d\x61\x8b\x02\x37\xbd\x02\xb3\x3f\xaa\x9f\xe6\xd0\xe8\x9f\x84\x5a\x17\xc9\xfd\x5e\x3e\xc5\x38\xfc\xe7\x99\x10\xc9\x55\x76\x24\x6f\xe3\xce\x8d\x7f\x23\x9a\x6c\xce\x94\xdd\xc5\xfb\x95\x53\x50\x1e\x1f\xf6\x82\x40\x40\xe6\x9d\xef\xb8\xd7\x85\xfb\x43\x9e\x3a\x9f\x6e\x18\xf5\x3b\x62\xc1\xcf\x2c\xad\x3d\xcf\xcc\x12\xb8\x2c\x20\x60\x8b\xc4\x1b\xaa\x79\xf3\xf0\xbb\x6a\x0d\x08\x4e\x2d\x04\xca\x5e\x5c\xd5\xad\x41\xc6\x57\x59\x72\x6d\xcb\x74\x12\xb9\x67\xea\xdc\x57\x2a\x4a\x6b\x40\xdf\xc2\xbb\x79\xa8\xcd\x5b\xaa\xd9\x9f\x28\xfa\xf5\xcc\x05\x4d\xbc\x0b\x53\xa1\x11\xcd\x24\xc2\xd7\x9d\xe1\x7a\xa8\xf9\xf7\x07\x8d\x61\xc7\xef\xb6\x74\xc7\xb4\x98\xa9\xa0\xb8\xcf\x3f\x63\x69\xde\xc4\xfa\x1d\xd5\x77\x55\xdd\xff\xef\x79\x30\x09\x58\x62\xec\xa1\xd3\x46\xc8\xfe\xf3\xb5\xef\xe1\x29\x76\xde\xb8\x08\x5f\xa6\x74\xa7\xf1\x1c\x73\x32\xbf\xa6\xec\x08\x2f\x82\x2e\xa6\xef\x24\xc2\x07\x72\xfb\xe6\xfd\x3c\xaf\x96\x9c\xbe\x64\x73\x8b\xfc\x5b\x89\x03\xd0\xfa\xcc\xeb\xde\x43\xbe\x42\x6c\x60\xd2\xdb\x30\xd2\x7a\xf1\x31\x96\x04\x3d\x3c\xec\x77\x4c\xfc\x6d\x36\xc6\x8d\xc7\x37\x5c\x9f\xf2\xcc\xe3\x39\x92\x1a\xd2\x8f\x5b\xf5\xf7\xbd\x2e\x9f\x27\x66\x68\xc6\xa9\x40\xc8\x76\xf0\x22\xcf\xdf\x51\xb8\xb0\x84\x5e\x39\xca\x7f\x39\x84\xbd\x09\x52\xf2\xa4\x6a\x99\xe0\x47\x55\x94\x2a\x89\x90\xc4\x08\x94\x0e\x44\x89\x36\xd9\xb0\x8b\x65\x27\xa6\xc2\xb3\xf6\x5d\xb0\x20\x5f\xaa\x4b\x8e\x23\x48\x23\xa6\xfe\xcc\xb6\xbd\x2e\xbd\x08\x65\xaf\x57\x75\xbd\x7e\x95\x0c\x39\x4f\x1c\xf3\xe9\xa8\xcb\x14\x34\x2c\x0e\x00\x4b\xda\x2b\xd6\x5a\x58\xc1\x6b\x70\x8d\x1b\xfd\x03\xda\xef\x02\x71\x88\x07\xaa\x7d\xa8\xe8\xe5\x62\xf9\x10\x37\x5a\x53\xb4\xd2\xdb\x79\x83\x08\x24\x8b\xe4\x62\x33\xf1\x0c\x2f\x1b\x14\x42\xef\x5d\xaf\xc2\x84\x4d\x4b\xf9\x8e\xd7\x91\x4d\xbd\xb7\xc7\x22\xd1\x78\xaa\xd7\xb0\xd9\x69\xbe\x8b\x16\xaf\x13\x80\x71\x1b\x59\x7d\x4d\x11\x9c\xb0\xf9\xea\x2c\xe8\xdd\x34\xb0\x7b\x2b\xe2\xd1\xff\x32\xfe\x01\x23\x7a\xb6\xa4\x07\x11\x31\xde\xf5\x58\xef\x6d\x23\xdc\xc6\x4e\xdb\xeb\x5d\xce\x52\xf5\x1a\xe9\xc3\x1a\x1e\xe4\x22\xa3\x40\xa2\x06\xf3\x5a\xab\x6e\xab\x40\x80\x20\x78\x9f\xf5\xf1\x03\x90\x93\x00\xfa\x94\x48\xdd\x90\xd8\x16\x2c\x10\x5c\xb2\x75\x1e\x8d\x4a\x92\x5b\x83\x3b\x96\xd2\xbb\xee\xce\xb9\xb4\x7a\x41\x64\x4e\xe4\xe4\xf2\x89\x37\x20\xad\x06\xf2\x07\x70\xed\x74\x5e\xa8\x88\x76\x16\x32\x66\x68\x5e\xa7\x80\xf7\x40\xa4\x0d\xf6\x45\x6b\x6f\xa5\x6c\xc7\x94\xe3\xdd\x1e\x1a\x0d\x7f\x7e\x6f\x59\x84\xb5\x9b\x79\xc1\xe5\x21\xdc\xf7\x09\xa4\xe3\x58\x33\x52\x89\xb0\xde\x24\x42\x22\x6f\xb8\xfe\x63\x0a\x5a\xc3\x22\x50\x9f\x97\xde\xf3\xb6\x22\xcd\x2b\xba\xd2\xe6\x4d\xae\x54\x5c\xc3\x76\x70\xe0\x63\x73\xaf\x50\x69\x85\x7a\x20\x90\x7a\xfa\x85\x11\x5d\x76\x13\x9d\x56\xf7\xc3\x79\x7f\xd5\x7d\x78\xff\xca\x83\x0d\x3e\x93\x94\xf1\xcd\x4f\x64\x2b\x85\xb5\x47\x58\x0a\xae\x93\xd3\x39\xcd\x35\xf1\x9f\x2e\x84\xdf\x5f\x2d\xf1\x7b\xe6\xeb\x8f\x7e\xa5\xb2\xd3\x6e\x5a\x8d\x57\x18\x7d\x43\xa6\xdc\x74\xb7\x75\x70\x8c\x54\xdb\x17\x4a\x91\x05\x5e\xe2\xb2\x56\xb7\x3e\xbd\x39\xbf\x8a\x32\x5d\x81\xf8\xe8\x8c\xca\xb6\xdd\xa2\x05\x57\x1e\xfa\x2e\x3f\x59\x23\x0c\xf0\x34\xed\x13\x03\x69\x39\x65\x60\x71\x12\x10\xb3\xd4\x60\x8d\xd2\x86\xcf\xcf\xa0\xc3\x8d\x82\x73\x02\x86\xfc\x0b\x49\xa4\x76\x24\x74\x2a\x93\xf5\xc1\x72\xce\xf6\x8d\x7f\x23\xc0\xe1\x76\x68\x36\x3a\xcd\x5a\x95\x7e\x44\xfc\xfe\x7d\x29\xdf\xce\x8e\x67\x41\xcf\xa3\x7f\xb2\x52\x20\xf1\x71\xd1\x1c\x2c\x37\x43\x5c\xba\x4e\xa6\x14\xb8\x85\x1c\x93\xa4\x37\xfa\x87\xc8\x34\xa5\x1b\x1a\x94\x9d\x06\xb2\x9c\x67\x1a\x62\xed\x32\xa6\x62\x03\xd8\xf0\xf8\x90\x4b\xaa\xae\x92\x38\x21\xa1\xd9\xdc\xf6\x5f\x2d\x9f\xd8\xce\xcb\xd6\x0d\x03\x63\xa5\xe4\x90\xaf\xa7\xcd\x9a\xa9\xc0\x37\x52\xba\xd6\xf8\xab\xe2\x34\x89\x52\x50\x78\x66\xbc\xad\x55\x3b\x5d\xec\x00\xf5\x1b\xcc\xef\x90\xe0\x21\xb7\x13\xed\x2c\x4f\x32\xec\x5b\xdd\xa7\x56\x7e\x52\xf9\xce\x6e\xc8\x37\xfb\xe6\x8a\x9d\x31\x18\x26\xd5\xd5\xfb\x46\xe2\x53\x79\x1c\x21\x41\x3c\x88\xdd\x90\x1b\x18\x74\x06\xab\xeb\x74\xb5\x52\x49\xa1\x06\x3e\x0b\x0b\xf9\xec\xe1\x5b\x89\xfb\x48\xe2\x2b\xca\xac\x3f\x64\xfb\x95\x81\xc8\x04\x0f\x0f\xe4\x23\xfe\x2a\x52\x35\x64\x57\x84\x5e\x26\x9e\x32\x26\x87\x18\x7c\xd0\x7b\x30\x5f\xdd\x40\xd8\x2b\x06\x3f\x94\xf5\x5f\x20\x47\x1c\x47\xf7\xbb\xbd\x04\x47\xf8\x6e\x94\xce\x5a\x1f\x10\x85\x25\xbc\x99\xc7\x7a\x68\xd6\x5b\xd0\xc7\x55\x08\xa8\xfc\x58\x57\x60\x31\x63\x0a\x25\x3a\x57\xbd\x3b\x60\x2c\x2e\x94\xda\xfb\xf1\x9d\x82\x92\xc6\xeb\xd7\x7a\x3f\x61\xd5\xf9\x5e\x8b\xe7\xc7\xc4\xc0\x9a\x35\x6a\x62\x8d\x79\x48\x3b\xaf\x20\x1f\x06\xac\xe1\x78\x7a\x5d\xdc\x8d\xb9\xa8\x70\xd4\x6b\xed\x6b\x53\x5c\xd2\xf9\xe7\x3e\x16\x5a\xf6\xac\x29\xe2\x56\xee\x65\xfd\xd6\x72\xc7\x66\x6c\x19\x49\x13\xb1\xab\x57\x79\xe0\x23\x1b\xb3\xe9\x7a\xaf\x93\x8a\xf2\x92\xff\x1d\x15\x5e\x19\xa9\xb2\x55\x02\xdf\x4e\x9f\xf6\x30\xae\xcc\xec\x60\x82\x60\x9a\x0f\xf0\x59\x09\xf5\x75\x0a\xbc\xf2\x20\xe3\x20\x0b\x1c\xf4\x9e\xbc\xb6\x6d\xc7\xe3\xf5\x18\x94\x18\x0a\x06\x1f\x91\x17\xc2\xae\x4d\x9d\xf1\x15\xbe\x74\x6c\xb9\xe3\xa3\x11\x6c\xa8\x35\x27\xf7\x3a\xf0\xec\x40\x8f\x6b\xa8\x5d\x9c\x6a\x26\xce\x34\xbb\x43\x34\x94\x2f\x6a\x44\xf5\xd7\x84\x93\x98\x92\x15\xec\x8e\xe7\xae\x87\xea\xca\x30\x9a\x40\xcb\x73\x51\xe7\x87\x6b\x15\x00\xfe\x1e\x79\xe8\x64\x2a\x7e\x53\xdd\x4e\xf9\x09\xa5\x68\xf5\xe8\x8c\xa6\xeb\x43\xee\xc0\xd7\xcd\xa1\x57\x36\x89\x0e\x61\xbf\x95\x78\x0d\x1e\x32\x44\x4b\xe8\x7b\x1c\x8c\xfd\x85\x77\x1c\x65\xce\x14\x27\x81\xd6\xc5\xbb\x9b\xec\xd2\x4b\x19\x65\x11\x33\xe3\x52\x8a\x57\xd8\x83\x7f\x48\x04\x0e\x11\x30\x07\x9f\x45\x0b\x87\xb1\xd4\x4a\xf5\x00\x88\x85\x92\x5f\xff\x1e\x1b\x91\xe8\x00\xe3\x97\x4e\x56\x41\x54\xf1\xfb\xab\xd6\x45\x4a\x7f\x6a\x4a\xa0\x64\x6e\x18\x18\xa6\xfc\xa9\xae\x93\x1b\x2a\xe5\xda\xa0\xc3\x6a\xbe\x5f\x51\x22\xfd\x1a\x85\x15\x49\xe9\x77\x12\x71\x1c\xf8\x9b\x36\x97\xfc\xb9\x6a\x1c\xf8\xc8\x21\xba\x25\x35\x5d\x62\x1f\xb0\x0f\x01\xbe\xde\x56\xc4\x50\x8e\x79\x6a\x23\x9b\xd3\x54\x62\x66\xf3\x3e\x16\xe7\x68\xba\x01\x1e\x87\x8b\x60\x72\x05\xdf\xec\x13\x29\xcc\x48\x96\x4d\x83\x7b\x55\xd3\xb7\xc4\x69\xd2\x30\xec\xd3\x2e\x81\x31\x6f\x11\x6b\xac\x31\x1f\x2f\x68\x50\x16\xce\x22\xe9\x75\x7c\xbe\x87\xdd\x3e\xf1\x63\x8f\x88\xf0\x52\x7f\xde\xda\xb6\x0d\x40\x6c\xfa\x07\x69\x0f\xde\x1e\x0e\xcd\x35\x7f\xc4\x37\xe5\xe1\x7b\x6c\x69\xa4\x43\x66\x87\x50\x25\x52\xf2\xe5\x30\xc1\x95\x08\xc8\x30\xfa\x27\x45\xe5\xc6\x57\x8b\xdd\x97\x6d\xc4\x37\x86\x67\xde\x36\xed\xeb\x19\x0e\xea\x03\x66\xf0\xf8\xdc\x2c\xbc\x4c\xb7\x1d\xce\xb2\x27\xea\x2e\x72\xa4\x37\x68\xa8\x7e\x2e\x02\x52\xc0\xbd\x71\x93\x79\xab\xf4\x6e\xba\xdd\x8a\x5b\xcb\xd6\xcd\x60\x6c\x0e\x67\xfb\x7a\x31\x85\x65\xd0\xcc\xef\xbb\x11\x96\xd4\xb2\xf6\x9b\x92\x7f\x14\x9b\x1d\x95\x42\xb7\x25\x33\xac\x7e\xc5\x14\x4c\xaa\xff\xdd\x13\x2f\xf7\x5e\x44\xc0\xb2\x71\x86\xdf\x84\x10\xe5\xf1\xc8\x07\x2a\xb0\x35\x41\x99\x25\x8c\x25\x94\x60\x96\xb0\x46\xcf\x1c\xc7\x79\x81\xc6\x62\xa1\x43\xb1\x1d\x8d\xdc\xa8\x99\x18\x12\x6a\x38\xde\x5a\x07\x58\xe2\x6b\xe2\xd3\x2b\xc3\x98\xf4\xe4\xfc\xe9\x6a\xca\x0f\xcb\x16\x35\x9e\x2c\x45\x25\x84\xc8\x2c\x82\x92\x82\x8a\xf9\x7d\x33\x01\x64\x7e\x86\xf8\xf2\x2f\x4e\xc6\xa5\xd7\x36\x3b\xa8\xa7\xcf\x69\x95\x08\x8b\x6d\x59\x25\x10\x3c\x10\x92\x1b\x4f\x9f\x05\xbe\x09\xcc\xa8\xa6\x16\xa7\xb5\x87\xd9\xf8\xd8\xdb\x78\xb0\x27\xa3\x1b\x16\x73\x63\x82\xa8\x8b\x67\xff\x74\xf0\x2f\x5b\x06\xaa\x29\xfd\x49\x22\x9c\xd3\x21\xbe\x4f\x46\x8f\x8d\x7e\x97\x2c\x30\x85\xcf\x73\x3d\x0a\xef\xf3\xa4\xf4\x8f\x38\xad\x45\x0b\xbc\x62\xf4\x8f\x28\x90\xfa\x80\xc1\xba\xc6\x40\xa9\xbe\xf8\x50\xf1\xe5\x3b\xdb\x13\xb9\xb3\x28\xd4\x96\x32\x7f\xe3\x8f\x53\xa4\xe6\xea\x8c\xbc\x9b\xbe\x76\x9b\x4a\x4d\xb1\xc2\xfb\xac\x8f\x4c\x36\x34\xe0\xfb\x41\xff\x78\x16\x23\x2e\x32\xd5\xa6\x28\xa8\x15\xf3\x9b\x97\x6b\x24\x53\xf5\xe1\x35\x8b\xee\x67\xca\xcb\x11\x8a\x25\x8d\xb2\xd7\xa2\xcd\x5f\xf1\x39\xa0\xab\xc0\xdf\xd2\x1d\x2f\xf1\x61\x45\x52\x03\x19\xa7\xed\xb6\x53\x3a\xef\xd9\x82\xb5\xa4\xc6\x57\x73\xa1\xb7\xf7\xc8\x25\x3b\xac\x73\x04\x69\xe7\xe0\x3b\x24\x19\x90\xd3\x59\x94\xbd\x4a\xe6\x6a\xfa\xd3\x9b\x5f\x06\x3c\xbb\xc7\xc5\xc2\xbe\x56\x1c\x48\xce\xb6\x57\x08\x9b\x1b\x1d\xc6\xb8\xcb\xdd\xb1\xe5\x94\x60\xda\xa3\xfc\x3e\xaa\xdd\x56\x6d\x42\x70\x58\xee\xb7\x60\x96\xdf\xb4\xa4\x99\x88\x28\xf2\xb9\xbe\x6a\xf1\xac\xb4\x70\x3f\x27\x94\x5a\x03\x30\x0f\xba\x1c\xac\xa1\xe6\xe0\x4a\x4d\xbe\x6b\x1a\x7e\xcb\x88\x2f\xc0\x7c\x7c\x79\x9d\x68\x0b\xfe\x52\x81\xda\x92\x91\xe4\x37\x26\x0b\xca\xfb\xdb\x7e\x5e\x21\x47\xc8\x1f\xbd\x77\xb3\xa6\x18\x9f\x2a\x03\x08\xce\x0b\xa7\xc6\x0e\x0e\xfb\x27\xd7\x07\x45\xcc\x4e\xc0\x20\x2d\xc2\x89\x1e\x3d\xe2\x55\xa9\x82\x23\xfc\xd4\x40\xeb\xa8\xb7\xe7\x29\xce\xd2\x41\xf4\x76\xec\x56\x98\x39\x1a\x9a\xcb\x76\x52\x58\xd5\x47\x2c\xec\x81\xf7\xe9\x0d\xf8\xa2\xfd\xaf\x25\xd8\xa7\x67\x49\xb0\x29\x6b\x62\xd4\xc8\x29\x3d\x00\x7d\xd7\x14\xed\x61\xfb\x61\x36\xb8\x85\x21\xd4\x01\x21\x2b\xbe\x2f\xda\xc2\xc9\xc9\x90\x40\x30\xa6\x82\xc9\xe5\x7e\x78\x9f\x87\x14\x1d\xcb\xb3\xc1\xe9\x06\x1e\x83\x22\x92\xb7\xe0\xdb\x4e\x9a\xf3\xe4\x07\xd6\xb8\x26\xfe\x30\xa4\x19\xf7\xef\x13\x0f\x0f\x2c\x32\xb1\x31\xfa\x31\xa2\xd0\x62\x32\xd4\x56\x06\xe6\xf6\xd3\xbb\xbf\x03\x0a\x0c\x29\x23\x29\x3c\x7f\x75\xd1\x9e\x02\xb7\xca\xf5\xe4\xed\x44\xa8\xd2\x08\xff\x93\x61\xa8\xf1\xa0\xcc\x22\xdd\x54\x7a\xd6\x80\x87\xe1\xe6\x73\xea\x3f\x4e\x85\x24\xd3\x1a\x1c\x3c\xc1\xbe\xa1\x72\x33\x23\xbb\x4e\xf2\xfe\x66\xd5\x3d\xa7\x90\x57\x28\x07\xe7\xfd\xaa\x5e\x3a\x7b\xf0\xaf\x8a\x0a\xb4\x0e\x7b\xa7\xbb\xc8\xe2\x24\x9c\x6e\x80\xe9\xe1\x38\xfa\xf0\x7c\xb9\x50\x3c\x3d\xf1\x95\xb6\xea\xd4\x72\x55\xa0\xf9\x58\x93\x3a\xb2\x53\xeb\x85\x7d\xd4\x16\xe3\xa1\x03\xdc\x7c\xab\x19\xf4\x43\x73\xe0\xa1\x30\x76\x7c\xa9\x7e\xf4\xd7\x30\x7a\x37\xf5\x71\xe3\xcc\xb8\x6f\x49\x08\xee\x9d\x0c\x7a\xd9\xaa\xbe\x01\xb2\xd0\xbb\x62\xac\x9d\x76\xd2\x62\x1d\xcb\x72\x98\x96\x15\xcf\xe2\x72\x94\x62\x73\xf9\x21\x91\xc0\x7a\x8b\x1f\x69\xee\x6d\x10\x8a\xf2\x11\xf8\x17\xfb\xc1\xa4\x5a\x7e\x9d\xd1\xf7\x40\x2b\x2c\x36\x83\x7e\x2e\x4a\xab\x8f\x79\xc3\x09\x97\x8e\xe9\xbf\x41\xfa\xa3\xed\x05\xc7\xe9\x88\x91\x44\xc7\xca\x1c\xf9\xd1\xaf\x0b\xee\x2d\x85\x3e\x34\x2b\x91\xa4\x5f\xe4\x1b\x70\x8f\x5e\x5f\xef\xf3\xfa\x9e\x06\x14\xf7\xd3\x84\xd1\x5e\x4c\xcc\x65\x7d\x8d\x48\x01\xc3\x67\x16\x53\x45\x87\xd3\xec\xf9\x2b\x84\xaf\xf8\x8a\x7c\xdb\x6a\xa8\xd1\xfd\xbe\xaa\x7d\xdb\xf1\xb4\xc6\xb2\xe3\xe4\xd3\xcb\x42\x13\x1f\x1e\xd4\xbf\x75\x36\x15\x0e\x9d\x2c\x35\xd9\x71\x42\x1a\x7d\x8c\xfe\x29\x4a\x3d\xd8\xfb\xed\x03\x39\xfd\xb4\xcc\x34\xb4\xcb\xa1\x16\x96\xc2\x11\x22\x73\x01\x8f\xba\xd7\x2b\x75\xfe\x81\x3c\x89\x1d\xc8\x4b\x68\xec\x5b\xab\x38\x1e\xfa\x68\xbb\xd5\xfc\xcd\xfe\xd9\x62\x07\x2c\xd2\x6e\x9f\x6c\xe5\xa5\x29\x47\x83\xd2\x22\x73\xa7\x0d\x71\xe7\x6c\xa9\xf7\xd6\x41\xe1\x75\x6c\x06\x1a\x8a\x25\x36\x26\x27\x3d\x88\xeb\x6f\x27\xf4\x07\x4f\x65\x1b\x9b\x2e\x73\x57\x75\xd5\xbf\x5f\x83\x3b\xe1\xd7\xfc\x25\xcd\x43\xaf\xcc\xd1\xcf\x94\x97\x92\x1f\x78\x92\xbf\x02\x29\x36\x07\x8c\xea\xe3\x3e\xf6\x39\xd6\x0e\xba\x91\x4a\x19\x3a\xe3\xe0\x03\x77\x30\xfd\x2d\x44\x40\x3f\xe7\x6f\xf8\x62\x4f\x78\x3c\xa2\x40\x2f\x01\xf1\xf8\xdb\x02\xba\x2c\x52\xd8\x18\x2b\x58\x50\xd0\x97\xc4\xe9\x81\x47\xa8\xc2\x14\x64\x2c\xc2\xbb\x23\xc6\xca\xc4\x3a\xbe\x2f\x4e\xa6\xd5\x0a\x3e\x70\x85\x38\xd3\x8e\x34\xdd\x0a\xe5\xbd\x2f\xc3\x2b\x2d\x78\xb0\xc5\x8a\x83\xac\x8a\x39\xb8\x23\x6a\xb8\xaa\xf3\x30\x9c\x29\xbb\xc9\x1a\xc2\x30\x84\x34\xa4\x0a\x24\xfa\xb0\x36\x3c\xe5\x06\x5d\x1e\x35\x4d\xca\xd5\x0f\xd3\x9c\xf6\xa7\x45\xb3\x3a\x55\x5a\xea\x7b\x5c\x3b\x2b\xb9\x9e\x37\xae\x77\x37\x59\xfe\xa6\x8a\x87\xf9\xaa\x28\x3d\x5a\x44\xd6\x69\x7e\x96\x9b\x14\x5f\xcf\x88\x6f\x18\x8d\xd7\x3a\x3b\x3c\x2c\xdd\xec\x0b\x9c\xd9\x0c\x5c\x8a\x35\x55\x7c\x7a\x78\x61\x21\x8d\x24\x5d\xc9\x75\x2a\x0f\x6e\xe2\xee\xdd\xe3\xbc\x30\x44\x53\x67\x3e\x6f\x27\x70\xfb\x49\xeb\x41\x5e\x0e\x60\x66\xd9\x1a\xcf\x11\xea\xa7\x12\x23\x5f\x74\xbc\x6e\xec\xe0\x2f\x94\x01\xed\xb0\x7d\x12\xf9\xd0\x4c\xe4\xba\x3d\x7d\x00\xd3\x04\x91\x19\x04\x4c\x0b\x2b\xa6\x44\xf2\xa4\x97\x60\x4e\xa3\xe4\x5c\xa2\xd1\xc8\xf9\x83\x81\x2d\x10\x65\x43\xb8\x56\x08\xed\x4d\x85\x26\x3b\xe1\xbc\x39\xda\x9c\x58\x35\xba\x23\xdb\x29\xf1\x1c\x2b\x56\x5a\xc2\x67\x2c\x15\x52\xfc\xa0\x33\x9b\x6e\xf0\x6e\x85\xb9\x9a\x8a\xae\x38\x3f\xd2\x8f\x6c\x8e\x77\xad\x44\xeb\xa6\xbd\xef\xb6\x8c\x5d\xb0\x1f\x7a\x26\x04\xb2\xd8\xd2\xd2\x1c\x81\x95\x67\x28\x3e\x4d\xc0\x0f\x88\xf5\xde\xe5\xaf\x9b\xe2\x39\xd1\xbc\x19\xe5\x97\x29\x46\x40\x27\xe3\xac\x56\x15\xcd\x71\x70\xd3\x16\xcb\x7a\x0d\x2d\xb9\xa7\x35\xd5\x6a\xc6\x11\x0c\x33\xa9\xf7\x34\xcc\xfe\x7c\xc0\x9d\xb2\x8f\x17\xfb\xa7\x93\xa6\xcb\x43\xe2\x3b\xe6\xd2\x91\xd4\xa0\xea\x72\x77\x3b\x96\x1c\x6f\x89\xb7\x0f\x47\x7f\x80\x72\x24\xf8\x8d\x89\x20\xbc\xb1\x27\xdb\x35\xd1\xfd\x9d\xe4\x98\x0d\xdf\x5e\xe0\x1a\x8a\xdd\x59\x79\xd5\x39\x9c\x29\x05\x25\x65\xa9\xf3\xc3\xab\x00\x44\xef\x95\xe6\x8b\x11\x0f\x74\xed\x94\x12\x87\xc3\x03\x27\x55\x7c\x0b\xd3\x8e\x29\xca\xef\x28\x63\x6e\xf3\xb3\xe0\xd6\x64\x93\x12\xfa\xc0\x7b\xec\x3f\xd9\x01\x93\xe5\x84\x40\xf9\x67\xd9\xbd\x11\xe0\xf9\xb6\xaa\x13\x4e\x3e\x62\x1b\xd5\xef\x9f\x6d\x63\xa6\x2f\xd8\x2f\x36\x26\xfc\x29\xdb\x78\x4f\x4d\x6c\x78\x5f\xc6\x5b\xeb\x28\x1b\xaf\xf9\xc8\xe4\x0f\x90\xd5\xf4\xc9\x62\xa0\x51\x04\x79\x09\x58\xb7\x24\xa8\x71\x2e\xba\x23\xd8\x2f\xf8\xf0\x40\xcd\x18\x6b\x8f\x91\x70\xbf\xd3\x91\x1a\x4b\xc3\xeb\xa8\xd7\x27\xf3\x95\x94\x0f\x0a\xd1\xe5\x84\x21\x19\x2d\xbc\x57\x0e\xb2\xd2\x3a\x43\x4a\x48\x7c\xbf\x1b\xb2\xcf\x9a\x3a\x03\xa6\xe4\x4a\xd1\x87\xc3\xcb\x29\x6b\xdc\x4d\xbb\x39\x08\x1f\x05\x2a\x0d\x10\x3b\x21\x9e\x12\xfe\x60\xcf\xab\x8f\x4b\xe1\x77\xd2\x9b\x29\x3f\x6b\x3b\x83\xf5\x2b\x7e\xeb\xcf\x04\x3f\x97\xc4\xab\x42\xfa\x91\xd7\x5e\xd0\xcc\xb1\xe4\xf8\x18\x27\x21\xf8\xb8\x49\x06\x4d\xb6\x40\xec\x1c\xaf\xfe\x58\x6e\x42\x9d\xab\xcc\xbf\x0e\xab\x5f\x7c\x8a\x72\x5a\x68\x17\x43\xcc\x3e\x52\x04\x66\xb6\x12\x50\x6a\xb8\x10\xf4\x91\xde\x4b\xf7\x61\xdb\x37\x54\x2e\xd4\xf8\xfd\xbe\xaa\x81\x04\xca\x0a\x4b\x76\x89\x33\x26\x4b\x73\x7b\x08\xf4\xab\xdc\x9f\xfa\x5c\x4b\x2d\xa0\x26\x9b\x18\x54\x12\xa1\x62\xeb\x4b\x55\xa9\x81\x51\x4c\x5e\x6e\x58\xb2\x47\x61\x47\x16\x21\x4c\xcb\x0f\x0b\xcb\x50\x04\xf6\x4f\xfb\xf7\x44\x5f\x0c\x5c\x2a\x0d\x85\x41\xb6\x21\x3d\xf1\x17\x9d\xcc\x72\xc3\x38\x28\xde\x90\x17\xca\x59\xdb\xb5\x16\x20\xca\x06\xa7\xb7\x9f\xf9\x64\xb9\xce\x8f\x85\xdb\xc6\xc2\x05\x66\x99\x46\xb2\x05\x37\x84\xbd\xe1\x10\xf2\x81\xba\x3f\xde\x82\x79\x2c\x88\x42\x64\xa8\xb2\x43\xfd\x40\xca\xb7\xfe\x09\x6a\x28\xf2\xe3\x8f\x60\xca\xfa\xf0\xd1\xa5\x08\xbf\x33\xce\x39\x57\x4d\x9c\xb1\x54\xe8\xcf\x13\xf8\x50\x16\xa3\xc7\x65\xeb\x35\xb6\x01\xb6\x2d\xa9\x27\x9b\xfb\xa1\xa2\xb9\xc4\xff\x0e\xb3\xa9\xf0\xec\xb8\x75\x46\x93\x9e\x76\x35\x41\xae\x10\x2d\xba\x27\x0b\xc9\x96\x98\xdf\x2f\x56\xb4\xe4\x39\xed\x2c\xd7\x87\xf1\x0a\xd2\x45\x6e\x15\x7f\x3a\xe3\xfc\x56\xf9\x77\xc5\x02\x4d\x11\x1c\xa7\x39\xce\xa0\x9c\x0c\x60\x30\x81\x1a\x4a\xfc\x79\xfe\xc7\x9e\x4a\xd5\x61\x0e\x9c\x9e\xc8\x9b\xbd\xe2\x95\x0a\xf2\x72\xbb\xc9\x86\x36\xb6\x15\x8b\x3e\xe6\xa7\xe4\x93\x3c\x61\xb0\x64\x44\x67\xbc\x65\xea\x36\x11\x42\xb0\xea\x17\x56\x77\x94\xbb\x15\x06\x5f\x9a\xc7\xed\x00\x74\xf9\x22\x8b\x85\x9e\x4b\x68\xc6\xbb\xcf\x5a\x77\x90\x50\x36\xdf\x18\x89\x43\x52\xe2\x45\x11\x86\x7c\x0e\x96\x8b\xb2\x69\xbe\xe2\x48\x1a\xc7\x6d\x0f\xee\xab\xf0\x64\x4c\xb9\xa8\x4e\x32\xdf\x9d\x71\x90\xb2\xa8\x43\xaa\x36\xc7\xe8\x12\x3c\x6f\xea\xad\xf8\xb4\x71\xc9\x6b\xcc\x7a\xf6\x51\x72\xd5\xd9\x80\x0d\xee\xde\x1e\x31\x7e\x2d\x4c\xa3\x3c\x23\xa5\x37\x6f\x3b\x8a\xd4\xd2\x1c\xe2\x77\xdc\xde\x43\x56\x7b\xc4\x4f\xbb\x99\xe3\xc0\x35\x52\x6f\xd6\x7a\xa4\x40\x8e\xdc\xb0\x88\xeb\x94\x64\x3d\xb9\xd3\x87\xda\x39\x54\x6e\x0e\x93\x5a\x74\x6b\xaa\x6e\x50\xad\x10\x4c\x71\x84\x29\xd6\x7b\xd6\xa8\x94\x9f\x57\x37\xf0\x13\xb2\x6a\xd2\xf5\xc9\x37\xd7\x1c\x52\x04\x13\x8b\xa3\xfc\xc8\x03\x57\xf5\x30\x12\xc8\xb7\xc9\xbd\xcf\x30\x40\xb7\xb4\xa8\x3b\x8f\x83\xf0\x33\xca\x2c\x79\x78\x17\xd6\xc9\x71\xdc\x9b\xe3\x1c\xcf\x7e\xf9\xef\x71\x51\x11\x83\xa3\x9a\x8b\x65\x36\x37\x45\xa0\x03\xc8\x0d\x1c\x7c\x54\x78\xf5\xe5\x9a\x3d\xef\x69\x5c\x09\xd5\x01\x5c\xee\x08\x56\x9a\xa3\x79\x9d\x68\x1e\xfa\x9c\x93\x5b\xfc\x8f\xb9\x59\x7d\xe9\x6e\xea\x29\xd3\x3d\x94\x58\xfb\xe0\x7f\x27\x41\x67\x7b\x75\x76\x9e\xa1\x97\xe5\x81\xc2\x01\xae\xc6\xbc\xbd\x56\xd6\xf2\x43\x4a\x13\xe9\x9b\xfc\x38\x86\xa7\x7b\xd7\x54\xe8\xb0\xad\x7d\x05\xda\x35\xc0\x07\xc6\x97\x7a\x94\xed\x32\x53\xa4\x6a\x9a\xb8\xca\xdb\x1d\x52\x8c\x88\x4c\x19\xe7\x0a\x52\xf3\xe7\x53\xe7\xc7\xd9\x59\x12\x6b\xa6\x62\xa3\x87\xe8\x62\xa7\xf2\x57\x75\xc6\x48\xb3\xd5\x20\xc6\xe3\x43\xd7\x02\x81\xbd\xb0\xd8\xb4\xfb\x66\xc3\x8d\x03\x78\x06\xaa\x2a\xb8\xd5\xc2\xac\x81\x76\x77\xaa\xb1\x74\xce\x4e\x49\xb8\x10\x9f\x74\x43\x27\x83\x8e\x5e\xcb\xd5\x22\x13\x9c\x18\x10\x40\xc0\x75\xd6\xfc\x86\x4e\xb8\x56\xb7\x26\x99\x14\xe5\xe0\x4b\x2d\x6c\x3c\xdd\x60\x08\x22\x8a\xb1\xbe\x85\x9f\xed\x39\x30\x0e\xb0\xa0\xa0\xf5\x30\x6b\x35\x27\x70\x45\xa7\x24\x81\xdc\x5c\xe7\xa9\x1b\x5b\xa6\xf3\x4a\x0b\xd8\x28\xe5\x27\xab\xc7\x5b\x6f\xac\xee\x21\xc1\x49\x3b\x7c\x3e\xae\x23\xf1\x07\x6f\xdb\xdd\xdb\xba\x63\x2d\xaa\x23\xb0\x16\x8c\x8b\x8d\x5a\xd0\xe3\xd2\x51\xe4\xbd\x1d\x2e\x05\x53\x2c\x44\x9d\x7a\x52\x38\x8d\x8d\x19\x4c\xa9\x44\x5b\xe0\x29\x98\xc6\xa6\x30\xaa\xfa\x0b\x0a\x79\x8b\x4f\x29\x0a\xc7\x7e\xd3\x86\x8e\x07\x74\x31\xc2\x05\x6b\x9f\xa9\x33\x18\x44\xb9\xb3\x41\xc5\xd2\xed\x3c\x63\xfa\x4d\xf3\xa5\x17\x8c\xbe\xa8\x38\xd9\x3e\xd4\x57\xce\xf4\xee\xf0\x49\x4d\xb3\xa9\xaa\xaf\x4e\x9a\x6e\x7b\x5b\xb7\x97\x4a\xe4\x0a\x32\x74\x41\xff\xf8\xf3\x0c\xd2\x32\x27\xad\xf2\x21\xe7\xd8\x03\x26\x6d\x7c\x17\xf4\x08\xec\x03\x55\xd0\x27\x7f\x71\x9d\xe1\x7a\x24\x03\x17\x0c\x95\x40\x9a\x3d\x62\xa1\x46\xde\x53\xe0\x2d\x79\xda\x66\x7c\xe3\xa3\xb9\x17\x3e\x13\x53\x52\x3b\x79\x65\x16\xf3\x6a\xbd\x46\xc0\x0d\xce\x17\xaf\x90\xdd\xc3\xb2\xd4\x3f\xcc\x6f\x8c\xf3\x77\x9b\x79\x5a\xd9\x86\xb8\xdc\xe0\xfa\xa3\x3f\x1f\xeb\xa8\x4d\xf9\xd9\x0a\x96\x65\x3a\x61\x1d\x26\x8f\x2a\x95\xa1\xe1\xfa\xe6\x34\xb9\xe3\xdd\x8e\xaa\xd1\xb0\xd7\x4d\xe0\x28\x21\xd2\xf9\x0e\x9b\x4f\x86\xf9\x1b\x73\xc0\x8c\x29\xf3\xd8\xe6\x2f\xd6\xa7\xb0\xc6\xf7\x33\x15\xdb\x8e\xa5\x51\xa6\xcb\xd1\x2c\xb1\x08\xe3\x2a\x2d\x91\xe9\xe1\xa7\xbd\xbe\x39\x0b\x43\x74\x0e\x6b\xdd\x73\xc1\x0b\xb9\x06\xbd\x67\xbd\x3f\x75\xb4\xaa\x04\x93\x1a\x3d\x88\xc9\x76\x54\x97\x40\x4c\x24\x97\xf3\xfc\xc0\x01\x67\xf2\xc3\x63\xbc\xf8\x3a\x4a\x7e\x2e\x07\xa5\xc0\x37\xfa\xe7\x95\x66\x53\x7d\xa3\xd9\x0a\x9e\x5c\x2d\x07\x1e\xae\x32\x79\xa7\x5b\x53\xe2\x81\xb0\x26\xf0\xe3\x25\xda\x84\xb1\xcd\x6c\x01\xf0\xd6\xf0\xd6\x13\x1c\xc9\xbd\x94\xa3\x44\x66\x92\x85\x10\x34\x35\xe6\x7d\x9e\xd7\x65\x7e\xbd\xc7\x59\x05\xa0\xc1\x75\x11\x88\x43\x55\xdf\x2b\x85\x2b\xac\x2c\x5f\x6b\xe2\xc0\x71\x5a\x51\xa6\xe3\x39\x8d\x9d\xd7\xa0\xaf\x07\xd8\xf3\x0e\xf7\x45\xe4\xde\x92\xfa\x05\x64\x37\x7a\xc0\x4f\x55\x67\x7e\x47\x16\x1e\x4a\x72\x51\x16\xb0\x3d\x6a\x0e\x66\x42\x5a\xfc\x17\x73\x8d\x41\x6a\xdc\x2f\xb4\x12\x25\xf3\xfa\x7a\x20\x7f\xf8\x4f\x4b\xcd\x7d\x5e\x5d\xb0\x2d\xd4\x9c\x0f\x0b\x49\xbb\x31\x43\x47\x7b\x88\xc8\xdd\x37\x03\xd2\x68\xb6\x34\x9b\xbf\xef\xb5\x3e\x91\xc9\x94\x1a\x36\x1d\x6d\xd2\xa9\x70\x4b\x16\x41\xfe\xae\xaa\xcf\xfb\x3b\xf7\x85\x16\xa0\xfb\x32\x52\xa7\xc3\xa8\x66\xde\x92\x6a\x01\x97\x28\x95\x1d\xe3\x5c\x8f\x60\x46\x2c\x70\xbf\xb6\xb0\xd8\x61\x60\x25\x28\x42\xc1\xdb\x4b\xe7\x29\x6d\xe1\x72\x92\x88\x44\x39\xd3\x68\x0b\xb1\xe4\x75\x98\x33\x39\x57\xed\xf5\xd6\xd0\xa0\x76\x1c\x9d\x95\x38\x1e\x1a\x38\x17\xd1\x20\xa0\x11\x9a\xa7\xe4\x40\xf4\xc6\x8f\x9b\x5a\xaf\x99\x73\xd6\x6c\x5e\x0e\x19\xda\x7e\x17\x8f\xea\xbf\xe2\xc5\x01\x8e\x74\xab\x91\xe5\x8f\x91\x45\x00\xcf\xa0\x35\xe7\x4a\xb1\x87\xdf\x82\xe6\xf1\x18\xc9\x7a\x8d\x55\x52\x48\xaf\x9f\x8d\xa5\x0e\x97\x2d\xe0\x62\x1c\xb8\x19\x54\xba\x64\x74\x78\xf3\xa6\xe5\x47\xef\xfa\x43\xde\xf5\xb1\x69\xd9\x8e\xaa\x87\x72\x59\xe2\x33\x5b\xde\x64\x46\xa0\x86\x2b\x24\x86\xb4\x05\x78\xf9\x40\x12\x3e\xae\xad\xb0\xf6\x06\xc5\x66\xbc\x3b\x5d\xba\xdb\x52\xc1\xfd\x1d\x22\x8c\xdd\x0e\x2c\x8d\x7d\xc0\x38\xd7\xe2\x8e\xcd\x06\xf5\x96\xf2\xc7\x8d\xc4\xd1\xfa\x09\x07\xdc\xc4\x2c\x84\xd3\xc3\xc3\x9b\xdd\xe4\x34\xf0\x85\xac\xf4\x60\xe2\xe1\xa8\xcb\x20\xf1\x8f\xcc\xf7\x86\x67\x33\xf4\x02\x12\x2b\xd0\x3f\x2b\x2c\xad\x2a\xa5\x4b\x5f\x4e\x1a\x17\x0f\x26\x14\xd1\x20\xb4\xdb\xda\x67\xac\x39\x2c\xd3\xcf\xac\xd5\xf5\xa7\xf9\x60\x46\x71\x01\x6d\xf7\x4d\x77\x70\xb8\x49\xcf\xf3\xb0\x3e\xa2\xfe\x10\xfd\xc9\xfe\xee\x24\x9d\x2e\x8e\xde\x1c\x1a\x40\x69\x99\x2f\xf8\x07\x00\x65\x20\xf8\xd8\xf3\xd8\x22\x06\x82\x44\xf7\x86\x0f\x5c\x6e\x2e\x36\x36\x2a\x28\x7c\xa0\x2c\x95\x6c\x50\x6d\x1c\x29\x66\x54\x44\x5e\x5c\x55\x14\x88\x2f\x0c\xd5\x59\xa0\x95\x24\x3c\x53\x8e\x65\x05\x64\x84\x76\xe4\x43\x9b\xc0\xc0\x33\xe2\x8c\x3a\x75\xd9\x11\x92\x84\x65\xc9\x30\x8d\xd6\xf8\xbc\xc1\xd1\x6d\xf0\x4e\xa6\x07\x47\x7f\xf0\xdd\x43\xd9\xba\x01\xbc\x92\xc2\xa5\xbb\xde\x63\x3e\x64\x0d\xe6\xcb\x4d\xb4\x7a\xbd\xab\xec\x55\x80\xa2\x9b\xe1\x66\xe8\xb7\xb9\x85\xd2\xc2\xef\x0a\xa6\xda\x3d\x4c\x69\x3d\x2f\x5e\x9c\xff\x6a\xd9\x29\x71\x5a\x9b\x27\x29\xd1\x62\x28\xab\x0e\xbb\x32\x9c\x92\xfd\x77\xed\xdf\xba\xb2\x36\x2d\x40\xdd\x0c\xd5\x5d\x0f\x37\xb0\xa6\x46\xb2\xd0\xbe\xbd\xce\x70\x5b\xa2\x58\xb4\x9a\x98\xa9\xcd\x4a\xfb\x80\x1c\xe2\xe0\x55\x11\xa6\x36\x63\x73\x7e\x0c\x54\x2c\xd9\xfb\xa9\x41\x5a\xb0\xa0\xc8\xe7\x08\xc3\x85\x66\x6b\xdc\xff\x5e\x64\x46\xcf\xd1\x06\x00\x7e\x14\x11\x95\x10\x5c\x96\x63\x9e\xec\xe1\xa0\x93\x96\x78\x05\x0a\xf5\xfe\x8d\x85\x0c\xa6\x76\x3b\x11\x90\x6d\xaa\xdf\xde\x28\xe3\x14\x64\x89\xf5\xad\x07\x27\x62\xf0\x0c\x2c\x5f\xf1\xe0\x59\x0b\x3a\x63\x38\x9d\xdf\x2f\xd5\x89\x4b\x3c\xc0\x8b\x89\x72\xda\x29\x89\x00\xd0\x2d\xe8\xc8\x40\xbb\x0a\x75\x4e\xda\x89\xd6\x6e\xf1\x75\x26\x4f\x19\xc5\x8c\x37\x44\x11\x28\x59\x8c\x27\x7b\x8c\x60\xc9\x77\xba\x68\x5a\xc5\xd8\x83\x47\xf2\x85\x2d\xc0\x8b\x9d\x17\x08\x55\xac\x31\x92\x7d\x57\xdf\xc8\x28\x8e\xf0\xc0\x29\x23\x78\xdc\x78\xc5\x58\x05\x62\xa0\x92\xdd\xf9\x2c\x14\x11\xd0\x6c\xf4\xa0\x66\x68\x48\xd6\x59\x31\x2c\x3d\x82\x6a\x56\x2b\x22\x62\x53\x7d\xcc\x4c\xc9\x0b\x87\x6f\x63\xb9\x08\xf0\x54\xde\x81\xad\x3b\x5d\x4a\x94\x32\x05\xb2\x1e\xa5\xe6\xb7\x1d\x71\x4f\x4b\x77\xb5\xaf\xb3\x5a\x7d\x2c\xf9\xd8\xcf\xd1\xd3\x44\x13\x46\x95\x6e\x0b\xd0\x2d\x82\x46\x24\xa3\x59\x5c\xea\x49\x68\xd8\x30\x2d\x65\xc1\x8c\x4a\x6f\xce\x57\x72\x1d\x0b\xe7\x10\x3b\x58\xe3\x11\x56\x73\xc6\xce\x6f\x01\x6c\x7b\x41\x31\xb6\x68\xda\xd5\x7a\xb7\x93\xe3\x44\x43\x24\x5d\xf5\xda\x97\x25\x3e\x6a\x4c\x09\xf6\xf7\xa9\xbd\xe1\x33\xbe\xd2\x42\x4a\x42\xb2\xbb\xa1\x3f\x9f\xbd\xf0\x05\x7b\x46\x83\x37\x7a\x3c\xfa\x5a\xa6\x8b\x1a\x56\xc8\xd3\x44\x54\xfd\xc2\x97\x21\xbb\x34\xba\x83\xec\x5a\x8a\xa9\x23\x56\x56\x4d\x47\x5d\x17\xe5\x5e\x58\x8a\x85\xf5\xc2\xd0\x9f\x2f\x12\x5b\x85\x8f\x0c\x06\x03\x73\x47\x1a\xaf\x38\xc5\x9a\x83\xbb\x84\x2b\xce\x11\x31\xac\xd6\xc4\xac\x0e\x0b\x41\xe8\x32\xe0\x85\x35\x43\x43\xf9\xe8\xf7\xdc\x2c\x1c\x86\xeb\x94\x35\xce\x19\xa6\x6d\xec\x89\x37\x7e\x18\xcf\xca\x8b\x87\xea\x1d\xde\x18\x1e\xcf\x5d\x00\xc3\x07\x0a\x16\xe4\xed\x9b\x17\x53\x98\xf9\xa2\x9d\xe6\x3b\x9e\x92\x24\x01\xcf\x1a\x79\xb3\x60\x43\xdf\x16\xef\xd8\x74\x5b\x2a\xdd\x7a\xfa\xd5\x1e\x02\x95\xa5\xe2\xe5\xdf\x64\x59\xfe\xce\xb8\x77\x7c\x6b\x3c\xc5\xf5\xf3\xe3\x3e\x83\x75\x60\x4c\x69\x3d\x09\x76\x55\x1f\xe5\xa0\x79\x54\x87\x12\xe6\xc7\xb4\xa6\xa7\xa5\x01\xbe\xa5\x1b\x46\x9b\xb8\x7f\x26\xdb\xbe\xcb\x2e\x36\xf8\x90\x3b\x90\x34\xa4\xd7\xc4\x0e\xfc\xd0\xa4\x10\x9b\x51\x2b\xf0\xcf\x9d\x1a\x14\x74\x8c\xa9\xd1\x93\x2b\x3a\xdd\x94\x19\x53\x60\x7f\x2a\xf3\x20\xa9\xf0\xf8\x8e\xbc\x53\xda\x1e\xf2\x7a\x7d\x33\x59\xc3\xf0\xc3\x21\x0d\x36\x32\x7e\x95\x2d\x5c\xe6\x93\x6d\xdd\xbd\x87\x48\x80\x6d\x47\xba\xa0\x14\x6d\x77\xb9\xd5\x10\xe9\xb0\x0d\xf8\x82\xae\xaf\xa7\x4a\x3f\xec\xeb\xa1\x4a\x1a\x6a\x5a\x4c\x43\xe9\x86\x21\xf3\x9d\xf9\x61\x38\x19\xbc\xab\x51\x95\xd9\x0b\xce\x8e\xf0\x18\x00\x8d\x4f\x5f\x4e\xb1\x8f\xe7\x84\xde\x7a\xdc\x47\x37\x99\xc4\x50\x47\x98\x70\xf9\x82\xe1\x90\x2d\xe0\x6f\x94\x05\x73\x97\x3d\x89\xfe\xc9\x7a\x60\x5e\xc5\xbf\x05\x59\x96\x34\xd3\x67\xc4\x60\x86\xc7\x3e\x98\x42\x63\xcf\xef\x3b\x79\x7b\xb8\x81\x97\x1d\xf3\x0b\xc1\x53\xe3\xf3\xcb\x9f\x9a\x81\x07\x88\x60\x52\x0a\x2a\xd7\x27\x11\xd3\xef\x43\x69\x24\x64\x55\x21\x98\xb7\x0f\xee\x84\x66\xfb\x12\x9d\x39\x5d\x0c\x75\xdf\x44\x2f\x55\x21\x41\xef\x83\x95\x6d\x2f\x16\xec\x06\xa5\xe0\xb2\xc6\x53\x2e\x73\xcc\xc1\x9d\x36\x9c\x6e\x79\x0b\x1a\x4b\x85\x3d\x3a\x08\x87\xe0\x37\xed\x6f\x18\x1d\xbb\x24\x43\xe5\x3b\xee\x04\x68\xc0\xc7\xfe\x43\xb2\xd0\xe1\x9e\xf0\x68\x7e\x7e\x27\xd4\x8c\x94\x35\xe9\x8a\xf6\x1d\xb1\xd2\xda\x8a\x23\x8c\xa2\xe8\xf5\x63\x0c\xc5\x15\x9e\x6c\x0d\xfd\xd4\x15\x4e\xfd\xbb\x0a\x6f\xd0\x17\x22\xee\x44\x97\x1f\xb4\xe7\x2d\x8f\x15\xde\x14\x3f\x1d\xf8\x81\xd1\x16\x0e\x69\x62\xb3\x99\x23\x61\xf4\xb0\x7d\x08\x48\xfa\xbb\x12\xd0\x68\x59\x31\x5f\x01\x5b\xd3\x20\x46\x15\xc7\x3b\x3e\x98\x0a\x76\x58\xce\xf0\x73\x3d\x25\x0b\x5f\xd7\x46\x12\x03\xec\x41\xd9\x1e\xc6\x60\x0d\x4d\xbb\x43\x07\x00\xd0\xb1\xab\xaf\xd8\xed\xaa\x42\xda\xb6\x19\x4b\xd3\xad\x83\x9e\xe4\xf5\xd5\xdf\xe6\x7d\xb6\xd6\x9b\xdd\x89\x72\x34\x98\xf2\xe0\x89\x6f\xb7\xa5\x05\x7c\x80\xfe\x0d\xb6\x35\x5f\x66\x03\xf9\x98\x55\x50\x58\x5e\x21\xeb\x90\x7c\x15\x3a\x9a\x6e\xf2\x0b\xc9\xa5\x27\x3d\x3a\x5c\xeb\x6a\x6c\xa2\xfc\x97\xaa\x28\x6a\xa8\xa1\x0c\x54\x13\x13\x04\x1d\xae\x72\xd3\x75\xb8\x0e\xa8\x46\xca\x1c\x21\xfe\xa2\x60\x87\xcb\x6d\x49\x8f\x4b\xef\x15\x66\xd0\xad\x1f\x65\x1e\x0b\xcd\xce\xf4\x84\x0d\xee\xb4\x57\x37\x3c\xdf\xcf\xc4\xc0\x2b\x2e\xc8\x65\xf6\x15\x36\x2d\x41\x35\x22\x90\x22\x16\xf9\x2e\x77\x36\x28\x2b\x95\x5a\xa3\xd5\x0d\x62\xf2\x92\x36\x88\xce\x51\x0c\x8d\x5c\x58\xee\x7f\xa4\xfd\xbb\x8f\x86\xc0\x59\x86\xd5\x9c\x13\x50\x42\x37\xba\x78\xb6\x54\x42\xb7\xc4\x55\x6a\x48\xf0\xf7\xb4\xa7\xdf\xfd\x53\x5e\x4c\x09\x72\x3e\x68\xf9\xca\x02\xe5\x0a\x3e\xd4\xa0\x04\x46\x93\x61\x20\x53\x38\x66\x98\x89\xef\x33\x5d\xe6\x2f\x84\x2a\x14\x0e\xfa\x20\x41\x3f\xcd\xe9\x86\x2d\xf9\x82\x27\xb1\x21\x9d\x99\x5c\x1f\x99\xa6\x39\xe3\x5c\x1c\xf4\x8a\x45\xc7\xf0\x80\xa0\xb6\x0d\x76\x13\x7b\x1b\x45\xb4\x0c\x95\x6c\x0f\x00\x93\xcf\x30\x32\x8d\x64\x60\x50\x9a\xf1\xcf\x98\xbd\x31\x21\xeb\x07\x1c\x22\x1e\x63\x0c\x48\xa8\xf9\x7d\xe0\x03\x86\x15\x53\x52\x98\xb4\xcb\x46\x4c\x6f\x12\x6c\x5b\xb2\x73\xd3\xfe\xa5\x20\xd5\x1f\x50\x3f\x26\x21\xb2\x27\xe6\xb9\xf2\x1c\xab\xb9\x08\x6b\x39\x48\x63\x39\x3a\x6a\x14\xfe\x86\x6c\x9b\x35\x3c\xc4\x95\x0e\x6b\x03\x96\x69\x80\xd1\xe2\xc3\x6f\x6a\x5b\xe2\xd5\xdd\x57\x92\x9b\xa0\x36\xb9\x76\xf3\x75\x99\x05\x52\xca\x43\x08\x33\xcf\xa8\x0c\xd4\x8e\xa6\x1b\x19\xed\x61\xad\x3d\xba\x97\x51\x88\xe1\x7a\x96\xcc\x13\x2d\x2f\x6c\x2c\xf4\xa4\x07\x04\x5b\x23\x52\x98\x88\x39\xff\xc1\x21\x53\x65\x45\x57\x0c\xed\x13\xcc\x68\x12\xac\xeb\xb0\xd4\x9e\x3c\x54\xe1\x39\xde\x43\x8d\xb8\x05\xfb\x31\xc5\x01\x8c\xeb\x00\xae\xf1\xdb\x61\x0a\xcf\xb8\xd0\x15\x3f\x12\xa3\xbf\xc9\x34\xca\x31\x1a\x5a\x7d\x2c\x54\x21\xbe\x96\x4f\x56\x0e\xd1\x87\xbd\x69\xb5\xc6\x9a\x8a\x84\x28\x17\xea\x0e\xed\xc9\xd2\xe2\x5d\x8d\xab\xf2\x2e\x26\xba\x98\x0c\x16\x62\xe0\x10\xff\x3e\xa1\x7d\x51\x85\x25\x33\x25\x05\x65\xdb\x29\xa3\x41\xd5\x03\xed\x25\x85\x35\xb0\x1b\x1b\x6c\x66\x12\x4c\x29\x1d\xe8\xdb\x8b\xd7\xe5\x8d\xe8\x3c\x98\x52\x7d\x11\x43\x6a\x9c\x4b\xe7\xb9\x4a\xfb\x58\xf0\x33\xc4\x98\xdd\x02\xeb\x66\x2c\x05\xfd\x94\x14\x70\x71\x84\x7b\x38\x00\x88\xb5\xf0\xc6\x05\xde\xbb\x0c\x1e\x0c\xb6\xde\xd8\xd6\xc4\xd0\x6d\xf9\xa7\xe9\x5e\xcc\x49\x2f\x68\xfe\xda\x1e\xc0\x3d\x74\x23\x4c\x07\xd7\xd1\x12\x60\xc0\xac\xb2\xba\x02\x6a\xd4\x79\x7e\x80\xa6\x4a\x02\xb0\x3c\xc0\x88\xd5\x2a\xf5\xb7\x55\xde\x45\x37\x26\xbc\x97\x27\x60\x4d\x19\xdc\xd7\xf5\xe9\xc6\xe9\xc7\xe8\x8a\x29\x8b\x37\x4b\x91\x79\xcd\xe9\x0b\x5b\xe0\x61\xce\x06\x89\x11\xbb\x45\x64\x57\xf8\xd2\x2e\x96\x09\x7b\xb0\xea\x8c\xb3\xd1\x44\x7f\x01\x68\xa9\xe1\x8c\xad\x47\xf2\x32\xa8\xbb\x6d\x80\xe5\x45\x0d\xd7\xfd\xb3\x6a\xa0\x79\x22\x7e\x67\xeb\x07\x97\x45\x37\xf8\x7f\x88\x2a\xf0\xca\xc3\x9a\x09\xc1\x2a\x55\x13\xbb\x52\xb7\x5e\xd5\x0a\x53\xd8\x92\x45\xda\x93\x92\x3b\xc4\x66\xe9\x81\x67\x44\x14\x4e\xfc\x46\x9b\x78\x40\x8b\xb7\x52\x06\x34\x05\xc0\x51\x60\x9f\xc0\xb5\x78\x5f\x33\xbd\xab\x2c\xa3\x85\x47\xeb\xca\xc2\xad\x85\x23\x09\x59\x74\x7e\xd0\xcd\x2a\x36\x5f\x4d\x14\x88\x17\x6d\x7e\x95\xa2\xa5\x51\x73\x38\xa8\xc7\x64\xe8\x9c\xd5\xb0\x31\xb3\x06\x06\xec\x64\xe2\x35\x91\x5c\x7f\x5f\x54\xe8\x0f\xc0\x3b\xb1\x50\x55\x01\x0c\xdf\x9c\x48\xd3\x9a\x84\x26\x9e\xe6\x2c\xe5\x00\x59\x06\x55\xd0\xc1\x48\xff\x88\x4d\xf8\x79\x9e\x68\xda\x2d\x09\xd9\xcc\xb3\x0e\x54\xa7\x04\x41\x37\xcd\xf6\xd0\x89\x71\xe0\x0d\x7c\x02\x3d\xc1\x91\x83\x7c\xe9\x03\xb6\xba\x22\xfc\x02\xdd\x3b\x47\xcb\x77\xd6\x4d\x24\x45\xc0\x0c\x6f\xc1\x41\xea\xe4\x01\xf5\xee\xa7\x01\x48\x22\xcf\x51\x54\x55\x33\x51\x55\x6c\x1b\x12\x06\x66\x7f\xe1\x82\x12\x3f\xd1\x7a\xe1\x19\x54\x5a\xe3\xf3\xd3\x04\x3e\x88\xac\x86\x86\xdc\xe7\x2c\x5c\xd0\x84\x40\xd9\x32\xa4\x8a\x5f\xf3\x17\x0a\x90\x60\x7f\x7f\x8f\x49\xff\x6b\xdf\xd5\x5c\xc7\x1e\xc8\x67\xf2\x2e\xef\xe3\x73\xdf\xb7\x35\x41\xc7\xa8\xbe\xbe\x2c\xc9\x96\xf0\x8c\x0a\x9f\xb9\xa3\xa5\x1b\x39\x40\x0f\x46\xa3\x98\x71\xb9\xab\x49\xb5\x25\xbf\x16\x26\xe1\x33\x89\x1f\x5f\xfc\x7d\x03\xf1\x4f\xf5\xf5\x1d\x48\x50\xf1\x00\x01\x3c\x54\xba\xb0\x6e\x58\x3c\xd0\x3d\x50\x10\x3c\xc1\x34\x4c\xb9\xa9\x26\x6f\x7d\x1d\x2e\x54\x6e\x48\xef\x9f\xfb\x2d\xcb\x17\xa7\xfe\x3c\xcc\x68\xb8\xd3\xe0\xed\x63\x32\xfa\x25\x5a\x25\x99\xff\x8d\x18\x02\x72\x02\x0c\x3e\xf4\xc0\xc7\xff\x79\x1f\xd0\x9c\x14\xed\xcc\x3b\xdc\x14\xdb\x5b\x3f\x0f\xfc\xcf\x4f\x75\xf6\x85\x62\x04\x5f\xb4\x63\xf6\xb1\xf6\x1c\x9c\x5f\xe5\x39\x86\x93\xf0\x0c\x2a\xf8\x2d\x06\x7e\x1f\x64\x69\x51\xb5\x12\x6d\x79\x28\xe2\xb6\xd5\xb4\xd9\x33\x5a\x34\x0b\x30\x88\x51\xae\xf7\xef\x1b\x2b\xcc\xde\xf9\x06\xe4\xde\xbd\x30\xf3\x66\x5c\xe9\xfa\x54\x34\xeb\xb1\x68\x1c\x4c\x4b\x02\x85\xad\x7f\x23\xdf\xf7\x3b\xd6\x07\xbe\x71\x6e\xd2\xa2\x8e\xc8\x16\x3e\x93\x24\x63\xd8\xdb\x76\x3e\x0e\xfd\xbc\x4a\x50\xa3\x0e\x15\x61\x20\x79\x18\xc4\xc5\xd9\x69\xe2\x0d\x91\x20\x79\x6a\x3e\xb4\x23\x5e\xb1\xd8\x0a\xb4\xd6\x91\xe7\x02\x23\xf0\xc5\x60\x93\x35\x68\x43\x6b\x2d\xf4\xb2\xe8\xed\x7b\xf7\xcf\xbd\xa3\x24\x4d\x8c\x22\x43\x7e\x48\x9a\x9e\xee\x83\x5e\x70\x5d\xfa\xc1\x43\xc6\xd7\xe9\x86\x91\xe2\xbe\x52\x25\xd0\xff\x52\x7b\x44\xb5\x9f\x84\x55\x26\xe6\x1a\x01\xc0\x25\x1a\xd5\x71\x84\x35\xa2\x10\x01\x33\x58\x92\x4d\x6e\xf9\xf3\xe0\xc4\xd9\x0e\xf6\x12\x2b\x74\x53\x18\x9f\x41\x61\xc5\x00\x2e\x1f\xd0\x4d\xae\x01\x11\x4c\x33\xb5\xd3\x34\xc3\x43\x4a\x75\x59\xa9\x37\xca\x80\x43\xdb\x3f\xe8\xe3\x37\xe9\xa3\x3f\xcf\x55\xf0\x67\xb0\xc9\x26\x88\x81\xfd\x21\x4c\x0c\x12\xf9\x9a\x38\xfc\x10\x60\x6e\x68\x19\x6e\xce\xdd\x47\x81\x09\xa8\xeb\xdc\x07\xd8\x7c\x76\x85\xcd\xc4\x7b\xea\xbf\x77\x38\xdd\xd8\x8b\xa1\x66\xb5\x38\x1e\x82\x98\x17\xaa\x9f\xda\xd8\x7a\x31\x19\x95\x9b\x24\xc3\xca\x50\x33\xd1\x4e\xe0\xfa\x3c\xf4\xeb\x6e\xf2\x97\x59\xe7\xf2\xc7\xe7\x65\xa8\x32\x86\x68\x92\xc4\xec\x01\xa7\x29\x11\x0f\x55\x28\x3f\x16\x2f\xc9\x97\xc2\x9b\x1e\xda\x34\xe1\x64\x47\x0a\xf7\x9c\xbe\x7c\xcc\xcb\x88\x1c\x6b\x1a\xe9\x51\xb9\xd5\xe3\xe6\xa1\xb5\xab\xa3\x19\x98\xba\x73\x6d\xb9\xeb\xb0\x83\xa0\x46\x9a\x35\xdf\x31\xfc\xd2\x2a\xe6\x77\xb1\xc7\x5a\x2e\xfb\x35\x97\x9c\xfb\x7d\xec\xe3\xdb\x0a\x55\xfb\xae\x18\xe3\x7b\x5d\x91\xe3\xa8\xf6\x2a\xb1\xe7\x21\xcb\xcd\x35\x1c\x10\xfe\x29\x5f\x99\xa3\xe9\x4f\x86\x81\x9c\x11\xfb\x5a\xa5\x17\x65\x97\xef\x6a\x88\xf7\xb8\x27\x21\x56\xb7\x2f\xfe\x43\xc2\x59\x41\xeb\xc1\xf7\x36\x57\x94\xb4\xa3\x40\x24\x24\xd9\x63\x05\x35\xde\x2d\xff\xc2\xc1\xd0\x89\x22\xb8\xe0\x3c\x2b\x8a\xb7\xf7\x1d\xa7\x7f\x3f\x8a\xa0\xb7\x62\x98\xa8\xb5\x99\xe5\x9e\xcd\xae\x9b\xfc\x6d\x51\xda\x3d\xe4\x49\x61\x72\x09\x0d\xb4\x7a\xaf\x98\xf1\x8b\x30\xf0\x50\x89\xf1\x1c\x09\x0c\x19\xac\xf1\x9e\x68\x79\x40\x2f\xd6\xd3\xf1\x2a\x15\x61\x73\x12\xb4\x56\x47\x98\x62\xe7\x0b\x57\x1c\x3c\xc2\x76\x8a\xed\x25\xb2\xaf\xa6\xad\x9e\xeb\xfe\xce\x2c\x8e\x33\x4c\x49\x30\x28\xb7\x6e\xea\x6f\x78\x1f\xc2\x47\x19\x3f\x5c\x23\xbd\xfa\x74\xa0\xcf\x5b\x12\x5a\xf6\x1d\x7f\x1c\x64\x5b\x50\x92\xce\x37\x11\xcb\xd4\x3a\xa7\xd8\x7d\x42\x21\xb3\xb2\x0d\xf3\x86\x77\xdc\xbd\x3e\xe3\xa7\xe2\x31\xba\x18\x55\x42\x3e\x77\xde\x03\xac\xe1\x60\x39\x30\xfa\x35\x74\x04\x63\xbe\xdf\xa1\xb0\x5e\x7b\xf9\xfc\x4e\xb0\xe8\x36\xbf\x52\x67\x8b\x23\xb0\x09\x69\x0f\x3b\x12\x2c\x16\x49\x6d\x96\xf0\x42\x30\x81\x61\xba\x15\xc9\xbb\x0f\x81\x29\xbf\x08\x85\x54\xb6\x1e\x16\xa8\x62\xa0\x36\x36\xa4\xee\x86\x8c\xc6\xc6\x57\xda\x9c\xdf\xdd\xa4\x3f\x1e\x6a\x85\xda\xaa\xea\x2b\xa6\x7c\x7a\xa3\xf6\x64\x03\x9d\x2d\x8d\xe4\x63\x42\x57\x71\xea\xd5\x90\x2b\x14\xa4\xfc\xd8\x31\x81\x3c\x0f\xa7\x1b\xc0\xc5\x21\xc9\x3b\xde\x37\xe5\xd9\xfb\x50\x33\x2c\x84\xb3\x1f\xc1\x52\x66\xa5\x65\xbc\xcf\xc8\x33\x85\xaa\x3e\xc1\x0c\x34\xce\xb0\xe4\x00\xf1\x7a\x9f\x17\x57\x49\x9b\x8d\xf7\x49\x6c\xf6\x74\x0c\x11\x67\x24\xc0\x10\xeb\x78\x9d\x7a\xf7\x1a\xbc\xf1\xf0\xf5\xe5\xef\x2f\x74\xcc\xf3\x5c\x4f\x53\xa3\xa7\x5b\x7a\x9a\xd6\x05\xf2\x78\xb6\x9e\xe6\x5f\xb5\x8d\x8f\x73\x81\xa5\xf9\x5e\xbc\xb9\x3a\xd2\x31\x66\x8f\xed\x55\x2a\xbd\xad\x8f\xeb\xa8\x97\x06\x56\xb3\xdb\x48\xfa\x32\x0c\xd3\xd2\xe2\xa0\x1d\x08\xe5\x26\x25\x29\x39\xe3\xa2\x52\x6c\x7e\x80\xd2\x45\xeb\xca\xec\xf7\xe5\xb3\x14\xa5\xd8\x67\xba\x61\xa5\xb6\x57\x57\xd6\x96\x7a\x65\x2d\x1b\xf3\x83\x13\x2e\xac\xd9\x1a\x19\xdc\x5a\xe8\xe2\xd6\x07\xa9\x47\x0a\xfc\xd5\x12\x93\xa0\xe2\xb7\x63\x3b\x3c\xc9\x42\xab\x73\xd7\x60\x5f\xdc\x6a\xc6\x3a\x0e\xcb\xf9\x91\x68\x16\x42\x3b\x1c\x49\x49\xd6\x90\x8e\x12\x66\x5c\xce\x29\xb6\x34\x39\xed\xf7\x3a\x46\xd2\xab\x61\x8a\x80\x87\x67\x43\x8a\xbc\xe3\x8d\xe6\x68\x73\x29\x4d\x01\x98\x5a\x70\x69\xfd\xb8\x27\x95\x23\xc6\xe8\x99\x98\x82\x0a\x2e\xc8\x4d\x10\x97\x31\x42\xc3\xd7\x86\x2c\xa9\x71\xf3\x15\x5b\x7e\x34\x8e\x12\x20\xca\x9f\x1a\x04\x46\xc9\x7f\x34\x6c\x74\xe2\xa5\xfb\x96\x25\x1b\x21\x05\xea\xeb\xe7\xd3\xe8\xeb\x4b\xe6\xc1\x56\x03\xd5\xdd\xaf\x20\xfa\x52\x07\xd6\xea\xf1\x8e\x75\x36\xdf\x22\x33\xd7\x05\xf8\x39\x29\x24\x0f\x7d\xba\x80\xef\x93\xa3\x06\xae\x0f\xef\x0b\x6f\xcc\x33\x42\xbf\x4d\x39\xaa\x91\xc4\xe0\xe1\x0e\x66\x32\x40\x6b\x6c\xaf\xf7\x5d\x8d\x2a\x77\xe6\xe5\x8c\x4c\xd9\x1e\xaa\x0e\x40\xe5\x4d\x0e\x9a\xbb\x1f\xca\xe2\xed\x91\x24\x8b\xb0\x6b\x5e\xee\x29\x0f\xd6\x80\x9a\x43\x43\xfb\x63\xef\x6b\x3f\x53\x2d\x57\x4b\x27\xa0\xb9\x71\x4e\x45\xe9\xe2\x12\x70\xe6\x56\x93\x56\x98\x33\x71\xd4\xf2\x41\xca\xa5\x75\x50\x43\x4b\xf0\x49\xc7\x32\xe1\xd5\xe1\x23\x92\xea\xc3\x92\x4e\xc6\xac\x4c\x47\x54\x50\xc7\x6b\x0b\xd5\xaa\x99\xd1\x35\xf5\x0c\x53\xc6\x54\xbf\x59\x61\x5d\xc1\xe2\xbd\xec\xed\x08\xc9\xd8\x23\x45\x23\x8c\x3e\x7d\x46\x7d\x1f\xd3\x23\x41\xc7\xe0\x10\x69\x98\x4e\xd8\x16\x7a\x0f\xf7\xf8\xdf\x94\x95\xd5\x81\x8c\x19\x0b\xa5\xb5\x73\xa5\x43\xa0\x85\xf5\x34\x43\x9f\x30\x6c\x3b\xa2\x72\xc7\x0b\xe3\x9c\xc8\x5c\xd4\xc8\xee\x51\x2a\x34\x63\xca\xc3\x04\xb9\xf3\xb6\x60\xa8\xb6\xa0\xfe\xb2\x2d\x5c\xba\xfa\x49\x67\x5c\xf7\x21\x81\x47\x54\xd3\x72\x3a\xc1\xfa\xf1\x78\xba\x0c\x12\x19\x5a\xd0\x15\x9d\x4c\x66\x02\x7d\x4b\x44\x48\xca\x4b\x7f\x57\xd9\x0b\xf4\xdd\xab\xfa\x16\x81\xd8\x10\xc1\x1e\xfd\x05\xb1\xef\x4b\x6f\x2a\x8a\x8f\xe5\x94\xb6\x77\x3b\xf2\xdd\xc0\x24\x44\x6e\x95\x1a\x85\xe8\x11\x7a\xdb\x75\x94\xcd\x8b\xb2\xba\x0d\x09\x84\xc3\x10\x4b\xdd\x75\x76\x90\xf6\x4f\x0e\x41\x56\xe8\x4f\x42\xef\xe2\x2b\xc5\xc6\x21\x91\x8c\x8f\x48\xca\x1c\x63\x46\xef\x57\xa0\x6c\xae\xb8\x3d\x73\xbf\xd2\x05\x6e\xe1\xc2\x7c\xaa\xe2\x16\x10\xce\x3b\x6a\x89\xdc\x9c\x4e\xc5\x32\x81\xc6\x9e\xeb\xdb\x9f\x15\xee\xde\x1e\xea\x84\x3c\xbc\x96\x9a\xf8\xbc\xdc\x72\x04\xa8\x2b\x87\xff\x78\x36\xe4\xcf\x95\xb0\x6c\xb3\xd7\xfd\xcd\xa4\xa2\x9e\xab\xb8\xde\x55\x7a\x45\x36\x9d\x79\x9a\xd5\x1f\x51\x0c\xad\xf8\xfb\xda\x56\xcf\xd7\xf6\xf0\x63\x80\x82\x32\x8a\xf8\x82\x2a\xa9\x60\x9c\xf5\x26\xcb\x4f\x43\x73\xee\xc3\x23\x34\xe8\xde\xfa\xb4\x78\xc6\x77\x6e\x0e\x19\x54\x3c\xf4\x17\x30\x31\xe1\xe2\xa1\x90\xdc\x0a\x71\xda\x1f\x58\xea\x24\x57\x74\x80\x21\xcd\xbc\xcf\x35\x09\x59\xb7\x94\x50\x04\xc2\x2c\x5a\x02\xf7\x34\x96\x18\xc2\x5b\xf4\x44\x5e\x9c\xa2\xe0\xcd\x29\x4c\x4d\x25\x81\x58\x1f\x43\xbd\xc4\x33\x1a\x4d\x1f\x33\x9d\xa9\x7d\x8d\x7a\xa5\xaf\x06\xdf\xd4\x1e\x28\x65\x8d\x3e\x7d\x8e\x53\xa6\xf5\x6a\xfa\xf8\xa0\x46\xb1\xbd\x35\xa0\xd9\xf9\xc1\x4e\x4e\xb1\xb3\x26\x4f\xfc\x62\x0b\x52\x14\x85\x9e\x54\x97\xca\xc9\x8a\xf7\x24\x12\xdf\xeb\xb1\xbd\x9b\xb5\x4a\xb9\x33\xfd\x2b\x0c\x34\x18\xd3\x84\xc1\x25\xd9\x19\x4f\x47\x54\x9a\x4c\xe4\xfd\x75\xde\x9e\x4b\x40\x9f\x44\x0a\xe3\xf5\x4e\x9c\xd2\x20\x15\x8f\xc8\xcd\xf9\x0d\xc1\x6b\x0f\xe5\xc1\xd1\xd3\x0b\x3e\xa2\xba\xa0\x18\x65\x75\x6c\xb8\x57\x3c\xa4\x14\xb7\xbe\xe8\x7a\xe8\x7e\x26\xa6\x54\xbe\x4e\x36\x0a\xba\xb9\x96\x07\x78\x16\x43\xc9\x6b\x18\x88\x64\x08\x71\xc3\x68\xbf\x81\xb3\x00\x04\xc2\xe2\x6b\xbf\x8b\xce\x01\x89\xe8\xd9\x3f\xcb\x86\x51\xf7\xc1\x09\xf6\x71\x50\xe3\x2f\x1d\xe7\x08\xe3\x39\x38\xae\x7f\x3d\x8c\xed\xca\x99\x05\x61\x97\xcb\xee\x84\x2a\xa2\x62\xb2\x24\xc1\xba\x13\xc3\xea\x38\xde\xf3\xc2\x23\x8f\xc5\x14\xc7\x03\x4d\x60\x86\x70\x1d\x0b\x15\xf9\x6f\xf1\x49\xf3\x1d\x35\x2f\x83\x78\x06\x38\x0b\xf8\xeb\x55\x1e\x32\xfa\x04\xf8\x49\xe8\x9c\x46\xc2\x07\x46\x11\xf9\x08\x83\x1c\x95\x73\x5f\x0b\x52\x77\xe5\x6f\xcb\xce\x21\x3c\x76\x4c\x13\x7c\x5e\x63\x2a\x41\x0c\xbd\xca\xf0\x06\x0d\x21\x31\xd4\x6d\x07\xe6\xd6\xe5\x41\x43\x22\x63\xbc\x41\xbe\x22\x3a\xbf\x5e\x1c\x3c\x97\x8c\xc4\x59\x39\xeb\x26\x16\xdd\xd1\x05\xcf\x13\x4b\x12\x37\x36\x73\xc6\x50\x9c\xcb\x8e\x5e\x98\x8d\x42\xb7\x71\x90\x0f\xf9\xf3\x54\xa4\x79\x93\x19\xa8\xa1\xc4\x77\x0a\x5f\xc8\x5e\xd0\xab\xcb\x7d\x45\x00\x23\x75\x41\x4a\xc0\x0f\xda\xd0\x2c\xf4\xc5\x71\x12\x4b\xd2\x3c\x06\x85\xda\x78\x8c\x5d\x92\x6d\xc8\xd7\xe6\x9e\x2b\x05\xf5\x7d\x34\xa6\xd8\xa2\x16\x4c\x46\x65\x24\x1e\x58\xa1\xf6\xfd\x5e\xb5\xf7\xb9\xd5\xd2\xa9\xcc\xdd\x82\x52\x84\xfa\xae\xfe\x04\xbf\xaf\x4f\x26\xf2\xbf\x13\xe7\x33\x64\xa2\x40\xad\x39\xb5\x77\x5e\x1c\x8a\xc7\xbd\xac\xd0\x59\x54\x7f\x5d\xb4\xfb\xf7\x69\xdd\x87\x3c\x69\x8c\xf6\x53\x03\x71\x1a\xb9\x5b\x62\xcc\x46\xd8\x2c\x7c\xec\x76\x05\x8c\xe0\xf9\x73\x8d\x4c\x4a\x7c\xc6\x2d\x31\xdd\x77\x24\x97\xf3\x6b\xbe\x08\x9a\x23\xbb\x14\x2b\x87\xec\x2a\x4d\x97\x6c\x29\xdf\xa9\xbc\xb1\x97\xec\xa8\xb7\x5b\x43\x86\x7f\xd5\xc6\x6b\x84\x6d\xc0\x3d\xe3\x54\x3d\x5d\xf3\x15\x62\x81\x5f\xdf\xb7\x0c\xe1\x70\xed\x82\x65\x58\x50\x4a\x3f\x4a\x06\x64\x6f\x5f\x23\x91\xfe\xf0\x85\x51\xeb\xc8\x55\x5a\xb4\x86\xba\xf0\x2c\xb4\xe7\xf7\x41\x22\x21\xfc\x3e\x17\x6b\xa9\x75\xf0\x7c\x78\x68\x7b\x4d\x98\xcc\x4f\x4c\x1f\xd2\xfc\x45\x20\x3a\x59\x84\xb4\xaf\xa3\x06\xef\x4a\x90\xd5\xd7\xd4\x00\xd9\x69\x6a\xf4\xb4\x8d\x76\xfa\xe5\x79\xa8\x97\x3f\xf0\x6e\x49\xc4\x14\x69\x30\xeb\x4a\x67\x24\x8b\x14\x69\x49\xb0\xa5\xd6\xe2\x6f\x4d\xfb\x1b\xbe\x90\x9c\xf7\x06\x7e\x83\xf1\xa9\x7b\x62\x70\x5d\x30\x65\xc3\xe4\x2f\xe2\x36\x97\x64\xc9\xf7\x38\xc3\x72\xf6\x1a\xaf\x20\x92\x4c\x2c\x50\xa3\x27\x31\x94\x96\x61\xa3\xed\x80\x60\xea\x64\x46\x55\xc2\x78\x68\xd6\x13\x43\x02\x5a\xcc\x62\xe1\xc6\xc8\x22\xf0\x4e\xb7\xc2\x8d\x2e\x24\x33\x70\x7f\xec\x0a\x75\xde\x2e\x8b\x7e\xb2\x87\xfa\xb0\x57\xef\x11\xcc\xcb\x62\xec\x44\x12\x5a\x4f\x7f\x99\xa2\x02\xc4\xca\x32\xb3\xf2\x87\x1a\x4b\x8d\x4c\x63\x3c\x57\xce\x2b\x61\xd3\x0c\x2a\x8d\xb5\x7f\xfc\x86\x58\xef\x0f\xf0\xb0\x11\x89\xf8\x03\xd5\xe8\x2c\x20\xe6\x8f\x32\xdb\x49\xb3\x37\x08\x8e\x24\xac\x42\xa2\xd2\x0e\xb0\x27\xb8\xca\x43\x6e\x20\xaa\xd0\x07\x5f\xaa\xf2\x10\x98\x31\x0f\x9c\x5a\x77\x23\xe9\x94\xc0\x1f\xdc\x91\x60\x0b\xb8\x3c\x28\x5b\x60\x2d\x3a\x87\xd8\x25\x09\x36\xfb\x90\x13\xf1\x4b\xf1\x2c\x46\x1b\x60\x18\x3f\xa2\x09\x9c\x68\x76\x11\x0e\x64\xe4\x09\xaf\xc7\xea\xce\xbd\x34\x07\x8c\x1d\x52\x22\x93\x18\xf3\x40\xd8\xd2\x3b\x7e\x44\xee\x96\x22\xe7\x0a\xa4\x49\x32\x50\xd6\x40\xb2\xd3\xcf\xc9\xa0\x90\xb0\xd9\x0c\x6d\xf4\x13\xad\xb7\x08\x7e\xac\x6d\x42\xe8\x80\xbf\x17\x24\x77\x75\xed\x4d\xec\x34\x08\xf3\x82\x43\x9a\xc4\x4f\xa8\x64\x31\x89\x0d\x27\xaa\xe8\x60\xb6\x93\x17\x84\x36\x64\x6d\x0f\x73\x50\xd0\xcd\x49\x3d\x52\xba\x06\xed\x45\x74\xf4\x09\x9b\x6c\xcc\xd5\xb2\x52\x73\x44\x88\x72\xff\xc6\x44\xa6\x05\x8e\x69\x22\xc8\xc0\xef\xac\x03\xcd\xf5\xcf\xad\x95\x23\x65\xfe\xf4\xa1\x51\x3a\x28\x99\x7d\xff\x57\xc5\xcb\x0d\xbd\xbe\x2a\xef\x7f\xcd\xe3\x05\x7f\xb3\xcf\x2b\x3a\xca\x22\x2d\x37\x5c\x10\xbb\xaa\x35\xf7\x90\xc7\xce\xf7\x39\xaa\xee\x44\x10\x42\x78\xfe\x66\xed\x66\x64\x15\xdf\x26\x96\x0a\x43\x2e\xa1\x8a\xea\x8c\xa3\xfe\x37\xde\x96\xbd\x14\x76\x94\x5b\xe1\xd1\x2f\x9f\x59\x7d\x37\xfd\x81\xd0\x4b\x51\x6a\xe5\x8e\xfb\xdd\xa9\x81\x4e\x4e\x61\xb1\x17\x95\x0c\x13\x64\x0e\xd8\x82\xca\xcd\x89\xa5\xc6\xa2\xdc\x2a\x93\x78\x9d\x62\xf3\xaf\x93\x2e\xf6\x10\xa3\xa7\x39\x96\x86\x79\x8b\xc0\x94\x10\xf0\xcc\x41\x3e\xd9\xf4\x89\x66\xbc\x8e\x3a\x28\xac\xad\x68\x75\x50\x88\x38\x0b\x2b\x08\x13\xdf\x7a\x74\x27\x6e\xcf\x74\xb8\xb2\xf4\x7d\xfb\x36\x7a\x43\xd9\xea\x71\x22\x75\x0b\x71\xc4\xf9\x37\x19\x03\x9d\xb8\x9f\x45\x6f\x8a\xde\xd5\x7e\xf1\x57\x6a\x0d\x71\x36\xf6\xc9\x02\x6f\x2a\x18\x46\xa0\x92\x6a\xac\x38\x5a\x29\xa6\xc8\x4c\x89\x07\x3d\x47\xca\x19\xa6\x18\x21\xcb\x22\x05\xf9\x6f\x04\x44\x55\x7f\x45\x64\x88\xb3\xb0\x5d\xb3\x44\xe7\x31\x4e\xed\x7c\x19\xcd\x7c\x08\x50\x27\x52\xf0\x71\xd5\x09\x82\x6c\xe9\x97\x39\xdc\x4b\x2e\x86\x45\xbd\xf3\xe4\x83\x2c\x31\xc8\x09\xe2\x32\x42\xfa\x70\xf0\x3e\xce\x6a\xb3\xc2\xa6\x81\x17\xd8\xec\x3a\x83\xd6\x31\x1f\x03\x2b\x07\x1a\xfa\x95\xd6\x50\xdd\x8c\x20\xda\x51\x30\x6e\x1d\xfb\x22\x60\x39\x61\xf7\x7d\x88\xf6\xc5\x21\xa0\xc2\x8a\x03\xaf\x13\x7f\x4f\x68\x0b\xb6\x90\xad\x81\x76\x49\xda\x15\x2a\xe9\x0a\x98\x9e\xed\x1e\x68\x81\xef\xb2\x23\xcc\xc0\xd6\x49\xae\x58\x02\xd7\x11\x14\x92\x17\xb4\x52\xcc\xfb\x55\x93\xd1\x1a\x1f\xc5\x1b\xa1\x1d\x64\x7b\x59\x72\xd3\x61\x74\xd1\x22\xa6\x7f\xaa\xd3\xac\x56\x7a\xf6\x3b\x5a\xba\xb0\x1e\xd4\x71\xd0\x90\x71\x3c\x26\x14\xad\x94\x62\x62\x1d\x2b\xeb\xdd\x7b\xbe\xc0\x97\xbe\xde\xad\x3e\x83\x36\xf6\xc6\x8b\x82\x7a\x7f\x62\xe2\x4f\x23\xb6\xf5\x4f\x24\xbe\x4e\xaf\x11\x65\xc5\x54\x5f\xce\x1e\x2e\x7b\x70\x2f\x57\xa4\x4f\x8e\xdd\xba\x22\x7b\x6f\x4e\x29\xd5\xda\xc0\x84\xbf\x87\x5c\x91\x5c\x1f\xdc\xb1\x81\xaf\x0b\xb4\x7c\xa0\x8c\xe7\x5a\x95\xaf\x40\xb8\xbb\xa1\x22\x25\xaa\x2a\xe2\x1b\x65\xe1\x03\xf4\xea\x6b\xce\x7b\x44\xdf\xf5\x70\xeb\xb9\x56\xd4\xe5\x9c\xcc\x15\xae\x8f\xcd\x83\x10\x1c\x8f\x3d\x16\x46\xde\xe9\xf5\xd7\x39\x5b\x5a\x58\xe1\x66\xca\x0d\xf4\xea\xdc\x69\x1d\xe7\x04\xf8\x90\xf9\x62\xce\x4c\xd9\xa9\x20\xad\xcb\xf7\x1e\x15\x45\x3b\x0b\xfc\x1b\x2d\xcd\xc1\xed\xdd\x7e\x9a\x55\x40\xaf\xd3\x1f\xf3\x81\x2b\x5d\x62\x5f\xc7\xf7\x40\xa5\xc6\xde\x9f\xf5\xa5\x16\x43\xca\xce\xa7\xee\x8e\x54\x50\x11\xcb\x46\x06\x39\x9e\x29\x62\xcd\xd6\x78\x94\x8a\xc2\xa7\xe9\x10\xc2\xe4\xc1\x6c\xbf\xcd\xe4\xd9\x6c\x46\xda\xec\x00\xab\x69\xa9\xf4\x56\xb1\x96\x27\xa3\x4d\x6b\x28\xb1\xd4\x6c\x44\x07\x64\x6b\x94\xca\x79\xe4\x4b\x82\xb5\xa6\xcc\x5f\x2b\x95\x2a\x15\x22\x4c\xa9\x53\xe2\x57\x16\x82\xb4\x9f\xed\x24\x6f\xab\x6a\xf9\xc4\x90\x21\xe7\xdb\x79\x87\x03\x41\x13\xeb\x6a\x1f\x71\x4f\x37\x8a\x60\xb2\xd2\xc4\x71\xe3\xce\x80\xc8\xb6\x70\x5b\x52\xb3\x2b\x24\xd3\xa4\x57\x95\xde\x19\xd3\x8b\xbf\xc8\x5f\xfc\x19\x53\x8b\x57\x73\x7d\x70\x76\x8d\x25\x07\x01\xb9\xd2\x26\xbd\x97\x18\xac\x68\x9f\x49\xce\xf6\x10\xa1\x15\xbe\x21\x80\xee\x59\xd2\x17\x52\x6c\x7d\xf1\x02\xeb\x63\x85\x94\x66\xa2\x90\x0c\x94\x7c\x5e\xfd\xca\x94\xa5\x86\x3c\xe6\xe0\xba\x17\x01\x77\x2b\xe4\x8f\x5a\x82\x6d\xb5\xc9\x5a\x0a\x4e\xb3\x82\x98\x70\x75\xaf\x14\xd6\xef\xbc\xa9\x14\x00\x87\xe3\x37\xe7\x53\x1f\x0d\x7a\xd7\x58\xf9\x00\x7d\xe1\xb7\x66\x8b\x7d\x38\x06\xbe\x4b\x58\xd0\xeb\x64\x8b\xc7\x9f\x91\xe2\x18\x00\x10\x75\x50\x1c\xd6\x11\x5f\x27\xf0\x92\xed\xf6\x4f\x5b\x7a\xb5\x1e\x27\xf7\x77\xd2\xfb\xf7\x39\xb3\x38\x76\x82\xc6\x9e\x4b\x0b\x67\x4c\xbe\x9a\xa9\xac\x40\xa9\xb8\x8f\x7a\x0a\xda\xbd\x1b\x0a\x13\xcc\x98\xa9\x5d\x9b\x09\xfe\x77\x8e\xbf\xf3\x40\x80\x4f\x69\x21\xec\x92\x5f\xa6\xb3\xca\xc2\xd2\x54\xd1\x6d\xe1\x31\x89\x7f\x0d\x15\x51\xab\x6c\x23\xf7\x88\x6c\xb3\xa1\x27\xbe\x46\x24\x55\xc5\x19\x3a\xb8\xb2\xb2\xa8\x0f\x26\x55\x1d\x60\xf0\x84\xc7\x75\x8e\x9e\x27\x0b\xbf\x34\x78\xd6\x96\x7d\x29\xbd\xf3\x88\x26\xee\x3b\xce\x8d\x89\x3f\xd0\x9f\xcf\x16\x0a\xef\xca\x13\xf0\xb5\x73\x73\xc8\x31\x19\x52\x7e\x29\xc6\x1c\xc3\x25\x11\x39\xd0\x10\x65\x86\x2c\xfc\x3e\xce\x71\x78\x32\x74\x2c\x14\x3e\x1b\x52\xf1\x3d\x73\xa6\x5e\xb5\x08\x10\x98\x6b\x4a\xfe\x81\x70\xb9\xc5\x87\x7a\x7a\x3e\xbb\x58\xba\x98\xef\x3b\xe4\x22\x2b\xc3\x35\x07\xdd\x6c\xc1\xf0\x8f\x05\x61\x0a\x4b\x6c\x3f\x1b\x78\x5e\x03\x34\xca\x9d\x69\xc8\xbb\x4a\x5e\x16\x4e\x83\x73\x73\x81\x2c\xf7\x2f\xc4\x2a\x81\xef\x87\x28\x4a\x7a\x4b\x14\xe7\xef\x90\x66\xa1\xc6\x23\x34\x81\xea\x97\x19\x2f\x27\x6c\x0d\xf3\x93\x32\xba\x19\x4d\x6e\xb3\x2a\xf4\x06\x66\xad\x24\x18\xa6\x2d\xd9\x42\x70\x9e\xca\x03\xa7\x36\xff\x11\x01\xff\x1d\x73\x93\x87\x06\x7c\x1c\x82\x2d\x2c\x0f\xd5\x5d\xd3\x7d\xdc\xdf\x58\xca\x30\xb3\x9b\x65\x97\x33\x0b\x30\xc9\x41\x60\xd6\x7b\xeb\x8d\xec\xe6\xd4\x2f\xf4\x3a\x25\x00\x3d\x48\xdf\x0f\x7d\x24\x17\xae\xed\x79\xe3\x9b\xa6\xde\x5b\xea\x77\xc8\x1d\x91\xf0\xdd\x82\xc1\xfd\xa4\x97\x32\xbb\xc3\x7f\x79\xb0\xdd\x67\x92\x88\x45\xbd\x99\x45\xb8\x9c\x0f\x26\xef\xa2\x33\x9c\xa2\x68\xfb\x7e\x1e\x08\x49\xab\xfe\x40\xcf\xff\xd1\x35\xe7\x5c\xe2\x58\xbf\x80\x66\xe5\x66\xc3\xe7\x82\xe5\x61\x9c\x58\x1d\x0c\xb3\x2f\x45\x7b\x0b\xde\xfb\x38\x50\xfa\x81\x3f\x4f\x86\xab\x58\xbb\x24\x17\x32\x69\x0e\x65\xf4\x3f\xcb\xa5\x45\xab\x57\x39\xc0\xdc\xf1\x83\xd7\xb6\x24\x26\xb8\xd4\xfa\xb4\x27\x53\xd7\x48\x49\xf3\x5d\x9f\x9f\x6b\x42\x70\xe5\xc9\x28\x9f\xed\x74\x89\xc7\xa3\xc2\x90\x3e\xe4\xa8\xa3\x39\xc0\x8d\x31\xa5\xfc\xdc\x18\x9d\x4d\x34\x6a\x08\x63\xaf\x5e\xbb\x70\xae\x5a\x1b\x4a\x05\x95\x36\xd9\xd2\xa8\xea\x3a\xbe\xab\x04\xe8\x10\x66\xa8\xec\x75\x50\x5f\x6b\x0e\x02\x7e\x25\xc7\x5a\x31\xee\x2f\x33\x34\x17\x01\x9a\x86\xcb\x2a\x94\x97\xdb\xfe\x31\x81\xeb\x71\x52\x81\x69\x0a\xdd\x92\x60\x89\x77\x84\x4a\x8c\xef\xb5\xbf\x5f\xc4\xac\xd4\xe9\x80\xa1\x6c\x08\x70\xe3\xe1\x5d\x4a\xd8\xc0\x2e\x09\xb6\xa1\x34\x3b\xbc\xae\x3c\x0b\x84\xcc\xed\x6c\xd0\x0c\x26\xe1\x24\x07\xdc\x45\x6b\xa4\xf4\xd8\x0c\x9a\x9f\x7e\x74\x20\x1f\x79\xee\x60\xc0\xd1\xa0\xd9\x44\xbf\x37\xe7\xc5\x50\xa9\xc3\x94\x87\x37\x93\x21\x0a\x44\xb0\xc9\xd7\x93\xa0\x00\xcc\xfc\x7f\x71\xe8\xfb\xd9\x4f\x3f\xdc\xb5\x72\xbf\x97\xc1\x7f\x1f\x9d\x36\x01\x4e\xe2\xde\xa6\x94\xe4\xaa\x5d\x6f\x70\x66\x53\x9e\x83\x06\x15\x0d\xc8\x27\x29\xad\x70\x16\xa3\x9d\x1f\x29\xe9\x87\xe4\xb4\xbd\x02\x4d\xec\x0f\xa1\xf3\xc0\xbe\x9c\x61\xe4\xda\x97\x51\xaf\x65\x13\x3c\xfe\x0a\xbc\x57\xb0\xf8\x43\xb6\xd6\xe5\xef\x2f\x51\xd7\x63\xaf\x81\xf2\x2c\x83\x60\x77\x42\xd3\x35\x8c\xb7\xf1\xcd\x71\xfc\xa7\xfe\xcb\xda\xad\x89\xfd\x53\xe2\x32\x7b\xc3\x39\xc2\x42\xc2\x4a\x2f\x54\xd1\xa1\x34\x2b\x35\x05\x01\xef\xec\x9f\xcf\x4a\xc5\x2d\xeb\x1e\xc0\xec\x20\xa0\xf3\x3d\xa4\x59\x4c\x73\xa3\xfe\xf7\x43\xdc\xb2\x46\x36\xad\x3c\x10\xba\x38\xc0\x4a\x0c\x1d\x0d\x3d\x8b\xc4\xeb\xd2\x79\x1a\x72\xdd\x96\x34\x6d\xc1\xfa\x5c\xa4\x6c\x37\x94\xd6\xf4\xa9\xac\x2d\xf9\x7b\xcc\xdf\x50\x5e\xac\x18\xdf\xe3\xc9\xd6\xa3\xf2\x99\xda\x02\xc7\xf1\x33\xd8\x47\xe3\x1a\xfa\xb0\x30\xc5\x16\x0c\x54\x8f\xc0\x24\x05\x75\xf0\x59\xa9\xaf\x51\x32\x07\xb0\x36\xe6\xc5\x91\x4d\xdf\xa3\xef\x39\x8e\xe3\xf4\xfa\x61\x35\x07\x01\xfa\x1c\x5b\xf0\x45\x2b\x84\xb1\x46\x46\x49\xbf\xb3\x3d\x1f\xcc\xf7\xf2\x95\x06\xa1\xa6\x88\x2e\xff\xf5\x14\x78\x9f\xa5\x68\x52\xb2\xe4\x06\x53\x0b\xeb\x4b\xe8\x13\x80\x57\xd0\x16\xc9\xfe\x99\x77\xbb\x84\x2c\xf6\x30\x3d\x54\x3f\x23\x24\xce\x4a\x5f\x90\xf5\x83\x5b\xa4\x6a\xb3\x54\xba\x10\x5f\x06\x63\x61\x90\xff\xcf\xaa\xf5\xba\x44\xcc\x1c\x33\x78\xc5\x08\x97\x09\xa4\x12\x80\xcb\x6a\x8f\x6d\xa8\xfd\xb5\xf2\x8f\x8a\xe0\xcf\xd7\x3d\x2e\x37\x03\x9b\xbd\xab\x80\xdc\x20\x19\xbf\x21\x59\x94\xec\x2a\xd9\x9e\x16\x05\x73\x73\x70\x19\xbe\xce\x4c\xfa\x2d\x9e\x9e\xbe\xce\x52\x17\xab\x8c\xe3\x3e\x6f\x18\x04\xf4\xd4\x11\x64\x35\xc4\x6e\x00\xcd\x10\x9a\xed\x44\xc7\x5c\x46\x37\xef\xd3\x04\x48\x10\x6c\xa9\x2e\xd3\x5f\xf4\x90\xb1\x38\x18\xe8\xc7\x74\xe7\x92\x7f\x14\x45\x8b\x7a\xa3\x19\x1e\x92\x02\xbc\x70\x06\xcd\xb6\x70\xd3\x11\xeb\x82\xac\x0b\x64\xe8\x07\x74\x3e\xe1\xe9\x64\xf4\x70\x62\x36\x53\x53\x71\x2b\x67\x4a\x2f\x18\xa6\xfc\x03\x03\xe6\xf9\x90\x49\x25\x90\x43\xaa\xdc\x5e\x37\xcd\x41\x8f\x04\x8b\xa5\xbd\x80\xbc\x4b\xff\x97\x71\x1e\x59\x17\xe7\x04\x52\xae\x4d\x73\xd8\x7b\xe5\xbe\xc0\xd3\xac\x80\x43\xd9\x0c\xa4\x89\x37\x5d\x13\xa8\x61\x7b\xbd\xf3\xfc\x7c\xd1\x70\x57\xb0\xa7\x91\xf0\x60\x91\x68\xdc\x9b\xc5\x6f\x2d\x8f\x2f\x8e\x7b\x73\x32\x18\x38\x95\x38\xa0\xf1\xe3\x29\xcb\xf4\x8a\x13\x75\x07\x8e\xa5\x80\x3d\xf4\xd0\x39\xa1\x4d\xc5\x32\x53\xdb\x02\x40\x2d\x60\xe4\x19\x07\x68\x17\x61\x26\x2f\xa5\xfe\xd7\x02\x8e\x04\x90\x59\x38\xde\x5a\x8d\x6c\x2a\xd8\x78\x38\xd6\x43\x10\x91\xb1\x62\xf4\xdd\x77\x53\xb2\x35\x11\x86\x36\x1e\xa6\x75\x35\xbe\x06\x74\x2e\xf5\xbf\x93\x63\x57\x99\x80\x54\xb8\xd8\x72\x88\x2d\xc8\x92\x61\x7b\x14\xd8\x8c\x7a\x20\x4d\xfa\x1b\xff\xd5\x11\x2a\x30\x27\x78\x8a\xa2\x89\x64\xf8\xaf\x8a\xe3\x5e\x02\xa9\xd8\x82\xe8\x01\x7f\x17\x6b\x39\x89\xf4\x6b\x51\x23\x98\xe6\xc4\x68\xbc\xdb\xa8\x3e\x12\x8a\xbc\x38\xf9\x2a\x81\x66\x1e\xc4\xee\x63\x8c\x03\x62\x58\x5b\xd2\x74\xf0\x24\xe9\x26\xa4\x6b\x3e\xa0\x08\x00\x13\x9a\x51\xea\xdc\x2f\xd0\x2a\x61\xe0\x2a\x5a\xd3\xe4\x06\x93\xbc\x6f\xc9\x71\x1c\x57\x76\xa0\x19\xd9\xcd\x4e\x3b\x01\xa9\x94\x64\x0f\x13\x15\x0c\x13\xf8\x5c\xe0\xdd\x5e\xdf\xaf\xe4\x2f\x70\xcc\x58\x1d\x0b\x7a\x7f\xd7\xb7\xb0\xa4\xe6\x8b\x24\x06\x46\xae\x98\xe1\x7e\x31\xdd\x15\x40\xff\xfb\xf3\x7a\x57\xd5\xf8\x26\x40\x62\xe1\xa4\xbb\x02\xc8\x8b\xfa\x4b\x62\x4b\x8a\x2e\xa6\x9c\x81\x84\xdc\xd6\x51\xe1\x17\x60\xd6\x83\x4c\xcc\xbf\x04\xbf\x6b\x05\xd9\x98\x49\x20\x6e\x29\x81\xac\x16\x67\x6e\xbf\x52\xb9\x19\xe0\x7f\x1e\x74\xa8\xcc\x1e\x16\x86\x8f\x93\x34\x1e\x06\x8c\x61\x71\xff\xfe\x3a\x5d\x49\x5a\x7f\xd1\x91\x9e\x4f\x16\x65\x58\x02\x0f\xbf\x3c\x42\x03\x70\xdc\xff\x05\x57\xab\x20\x9f\xd7\xf0\x5f\x9c\xe2\x57\xde\xbb\xfa\xd3\xed\xa9\xf5\x3c\x83\x54\xd2\x26\xfd\xa6\xdb\x8b\x9b\x09\xa9\xef\xba\x84\x7f\x75\xb3\x91\xcb\xe0\xfc\xba\x5f\xe6\xe4\xb8\x8f\xf0\x69\x85\xde\xef\x63\xf9\xa1\xd8\x77\x3b\x98\x77\x46\x2b\xcf\x0a\x6b\x34\x34\x82\x1e\xc1\x2a\xbe\x3c\xaf\xfb\xf4\xe2\x54\xf8\xdd\xdf\x82\xb7\xb6\xed\x34\x27\x52\x4d\x85\x33\xe5\xb4\xbe\x5c\x34\xff\x6a\x80\x2a\x0e\xb2\xf1\x1e\x62\xfc\xeb\x72\x45\x36\x17\xfc\xf1\x50\x23\x0d\xf4\xe0\x57\x23\x86\xfb\xf5\xc4\xdc\xe2\x09\x41\xe4\x38\x8e\xbb\x3b\xdb\xf7\x8b\xb0\x25\x25\x1b\xe0\x82\x3a\xf2\xe7\xf9\x60\x03\x87\x86\xfe\xf0\x6e\x37\xe1\x33\x88\x71\x10\x77\x51\x68\x15\x25\x78\xfb\xdc\xc6\xd2\x7d\x21\x0d\x3c\x85\xb6\xe2\xda\x7c\x4f\xe4\x2b\x06\x1e\x54\x75\x08\x3c\xdf\xb7\x3d\xf0\xb3\xff\xb1\x55\xfc\x17\x18\x20\xe6\xf3\x9b\x5c\x11\xf5\xf5\x3a\x91\xc4\xc8\x65\x1b\x91\x1a\x7f\x06\x0b\x51\x93\x20\xf4\x37\x4a\xd5\x9b\xd9\x9f\x75\xd1\x66\xbb\x43\xef\x6c\x51\x1e\x0b\xca\x02\xd1\x0e\x54\xfb\xed\x75\x9f\x4e\xce\x19\x61\xeb\xe2\xc4\x17\x3e\x73\xff\x2f\x81\xe2\xbc\x2c\xd7\x61\x91\xf0\x9b\xe5\x35\xf0\xcf\x56\x43\xcd\x56\x41\xf3\x08\x93\x78\x7b\xc5\x90\x1e\xf7\x56\xe0\x9f\xac\x90\x94\x2a\x86\x05\xe4\x5e\x7f\xd6\x0f\xc7\x54\x69\x43\x9c\xdc\xff\xf7\x3a\x05\xe0\xe7\x08\x89\x81\xd3\x39\x10\xc2\x91\x7d\xb9\x7f\x7b\xbd\xbf\xf6\x73\x0b\xdc\x7f\x79\xf1\xad\xa0\xa7\x32\xf7\xef\x5f\x27\x70\x45\x14\x9e\xd9\x7f\xf9\x32\xf3\x6b\x5f\x6b\xf6\xfa\xf7\xff\xfa\xbe\xc8\x5f\xf9\x3a\xff\xcb\x97\x91\x2f\x59\xba\xce\x7f\x3f\xc4\x8b\x93\x7b\x91\x91\xff\xff\xc7\xcc\xfe\xfb\x98\xff\x7d\xcc\xff\x89\x63\x1e\x48\xb9\x40\xbf\x2e\x34\x37\xb9\xc7\x5a\x0e\xbc\x1c\xcf\x37\x6d\x95\x7c\x47\x9f\xcf\xff\xf1\x1f\xff\xdb\x3f\xff\xc7\x7f\xfe\x8f\xff\x3d\x5d\x8a\x24\xcf\x96\xbd\x4f\xff\xcf\xae\x86\xba\xfa\x1f\x69\x51\x8e\x4b\xf1\x7f\x67\xe3\xb0\x15\xc3\xf6\x8f\xff\x80\xff\x2f\x04\x49\x90\xff\xf8\xe7\x94\xe4\x79\x3d\x54\xff\x40\xfe\x17\x72\xba\xfe\x99\x8d\xdd\xb8\xfc\xe3\x7f\xcd\xb2\xec\x9f\xff\xf9\xff\x0c\x00\x8d\x4c\xcb\x45\x2c\x08\x02\x00")
 
 func cmdInternalPagesAssetsStylesContainersCssBytes() ([]byte, error) {
 	return bindataRead(
@@ -246,7 +246,7 @@ func cmdInternalPagesAssetsStylesContainersCss() (*asset, error) {
 	}
 
 	info := bindataFileInfo{name: "cmd/internal/pages/assets/styles/containers.css", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xd3, 0x13, 0xf3, 0xbc, 0xc8, 0x9f, 0x4b, 0x4, 0x81, 0x1c, 0xf9, 0x9c, 0x51, 0x4d, 0xa2, 0x2f, 0x8d, 0x89, 0x5a, 0xfd, 0xd6, 0x57, 0x22, 0xa, 0xa3, 0x25, 0xa3, 0x40, 0x28, 0x64, 0xd2, 0xf}}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xc4, 0x67, 0xf2, 0xb, 0x63, 0xd5, 0x78, 0xd9, 0xa0, 0x5b, 0xf8, 0x89, 0x58, 0xf9, 0xa1, 0xa3, 0x43, 0xe5, 0x37, 0xfa, 0x28, 0x9c, 0x47, 0x57, 0xfb, 0x91, 0x38, 0xc1, 0x96, 0x91, 0x86, 0xfc}}
 	return a, nil
 }
 
@@ -15,7 +15,8 @@
 
 // Code generated by go-bindata. DO NOT EDIT.
 // sources:
-// cmd/internal/pages/assets/html/containers.html (9.64kB)
+// cmd/internal/pages/assets/html/containers.html (11.93kB)
+// cmd/internal/pages/assets/html/events.html (3.215kB)
 
 package pages
 
@@ -83,7 +84,7 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _cmdInternalPagesAssetsHtmlContainersHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x5a\x5f\x73\xe2\x38\x12\x7f\x86\x4f\xd1\xeb\xba\x87\xd9\xaa\xb1\x49\x26\xb9\x87\x9b\x23\x54\xb1\xcc\xcc\x2d\xb7\x99\x24\x15\x92\xdd\xda\x47\xd9\x6e\x6c\x4d\x84\xa5\x95\x64\x08\x97\xca\x77\xbf\x92\x64\x83\xff\x01\xf9\x57\x33\xcb\x0b\x20\xa9\xbb\x7f\xfd\x53\xb7\xd4\xb2\x3c\xfc\xc9\xf7\xfb\x00\x13\x2e\xd6\x92\x26\xa9\x86\x0f\x47\xc7\xa7\xf0\x1f\xce\x13\x86\x30\xcd\xa2\x00\xc6\x8c\xc1\xb5\xe9\x52\x70\x8d\x0a\xe5\x12\xe3\xa0\xdf\x07\x38\xa7\x11\x66\x0a\x63\xc8\xb3\x18\x25\xe8\x14\x61\x2c\x48\x94\x62\xd9\xf3\x1e\x7e\x47\xa9\x28\xcf\xe0\x43\x70\x04\xef\xcc\x00\xaf\xe8\xf2\x7e\xfe\x77\x1f\x60\xcd\x73\x58\x90\x35\x64\x5c\x43\xae\x10\x74\x4a\x15\xcc\x29\x43\xc0\xfb\x08\x85\x06\x9a\x41\xc4\x17\x82\x51\x92\x45\x08\x2b\xaa\x53\x6b\xa6\x50\x12\xf4\x01\xfe\x2c\x54\xf0\x50\x13\x9a\x01\x81\x88\x8b\x35\xf0\x79\x75\x1c\x10\x6d\xf0\x9a\x4f\xaa\xb5\xf8\x38\x18\xac\x56\xab\x80\x58\xac\x01\x97\xc9\x80\xb9\x71\x6a\x70\x3e\x9d\x7c\xbe\x98\x7d\xf6\x3f\x04\x47\x46\xe2\x36\x63\xa8\x14\x48\xfc\x2b\xa7\x12\x63\x08\xd7\x40\x84\x60\x34\x22\x21\x43\x60\x64\x05\x5c\x02\x49\x24\x62\x0c\x9a\x1b\xb4\x2b\x49\x35\xcd\x92\xf7\xa0\xf8\x5c\xaf\x88\xc4\x3e\x40\x4c\x95\x96\x34\xcc\x75\x8d\xaa\x12\x1b\x55\xb5\x01\x3c\x03\x92\x81\x37\x9e\xc1\x74\xe6\xc1\x2f\xe3\xd9\x74\xf6\xbe\x0f\xf0\xc7\xf4\xe6\xd7\xcb\xdb\x1b\xf8\x63\x7c\x7d\x3d\xbe\xb8\x99\x7e\x9e\xc1\xe5\x35\x4c\x2e\x2f\x3e\x4d\x6f\xa6\x97\x17\x33\xb8\xfc\x02\xe3\x8b\x3f\xe1\xb7\xe9\xc5\xa7\xf7\x80\x54\xa7\x28\x01\xef\x85\x34\xf8\xb9\x04\x6a\x48\x34\xf3\x06\x30\x43\xac\x01\x98\x73\x07\x48\x09\x8c\xe8\x9c\x46\xc0\x48\x96\xe4\x24\x41\x48\xf8\x12\x65\x46\xb3\x04\x04\xca\x05\x55\x66\x2a\x15\x90\x2c\xee\x03\x30\xba\xa0\x9a\x68\xdb\xd2\x72\x2a\xe8\xfb\xfe\xa8\xdf\x1f\xa6\x7a\xc1\x46\x7d\x80\x61\x8a\x24\x1e\xd9\x29\x18\x6a\xaa\x19\x8e\xa2\x71\xbc\xa4\x8a\x4b\xf0\xe1\xe1\x21\xf8\x44\x95\x60\x64\x7d\x41\x16\xf8\xf8\x38\x1c\xb8\x21\x6e\xb8\x8a\x24\x15\x1a\x94\x8c\xce\xbc\x87\x87\xe0\x9a\x73\xfd\xf8\xa8\x8c\xe5\x68\x20\xb8\x10\x28\x83\x05\xcd\x82\x6f\xca\x1b\x0d\x07\x6e\x70\x21\xf9\x93\xef\xc3\x39\xd1\xa8\xb4\x8d\x21\xca\x30\x36\xd8\x61\x41\x33\x3a\xa7\x18\xc3\x64\x36\x03\x83\xd3\x8e\x66\x34\xbb\x03\x89\xec\xcc\x53\x7a\xcd\x50\xa5\x88\xda\x83\x54\xe2\xbc\x6d\x37\xe4\x5c\x2b\x2d\x89\xf0\x4f\x83\xa3\xe0\xc8\x0f\x51\x93\xe0\x83\xc5\x11\x29\xe5\x8d\xfa\x5b\x00\x97\xc2\x50\x44\x98\x61\x67\x81\xaf\x35\x67\x95\xf8\x27\xc1\x71\x70\xdc\xb2\xf6\x1c\x8d\x11\xcf\x4c\xb6\xa0\x54\x2d\xc0\x7b\x19\xfb\x2f\x59\x92\x99\x9b\x90\x8d\x27\xfb\x26\xe8\xdb\x5f\x39\xca\xb5\x7f\x12\xfc\xb3\x00\xdc\x31\x4d\xfb\xe4\xf7\x10\xdd\xd6\xb4\xd5\xa5\xd7\x02\xcf\x3c\x8d\xf7\x7a\xf0\x8d\x2c\x89\x6b\xf5\xba\x4d\x30\x4e\x62\x94\x7b\x80\x3d\x47\x59\x85\xd7\xa6\xc2\xe1\xa0\xcc\x81\x61\xc8\xe3\x75\x61\x23\xa6\x4b\x88\x18\x51\xea\xcc\xdb\xc8\xba\x50\xf1\x55\xca\x57\x11\x51\xe8\xc1\xc6\x3d\xd2\x9c\x4e\x6f\x2b\xcc\x7c\xb5\xf0\x8f\x3f\x78\x40\xe3\x33\x8f\xf1\x84\x7b\x1b\xb1\x01\xd9\xfc\xac\xd9\x2b\x45\x46\xfd\x5e\xb5\x43\x90\x04\x7d\x03\x16\xa5\xe9\x32\xd9\x7b\x3c\x6a\x27\x69\x7a\x6c\xe4\x06\x31\x5d\x9a\x6f\xce\x4a\xf1\x50\x22\x89\x23\x99\x2f\x42\x27\xfd\xf0\x20\x49\x96\x20\xfc\x43\x10\x89\x99\x9e\x6c\xdc\xfc\x78\x06\xc1\x55\xbd\x4d\x3d\x3e\x5a\x83\x8c\x8e\x2a\xce\x36\x25\x83\x73\x9a\xdd\x3d\x3e\x7a\xa3\x8e\xae\x1b\xbc\xd7\x06\x1d\x19\x0d\x07\x8c\x16\x00\x30\x8b\x8d\xe2\xe1\x80\xb3\x2d\x29\x16\xb8\xfb\xf3\xf0\x40\xe7\x10\x4c\x95\x23\xf5\x00\x57\x50\x7c\x86\xe9\xe9\x16\x64\x10\x0c\x62\x1e\xdd\x19\xc6\x3e\xd9\x6f\xd8\xfa\xe4\xc0\xa4\xa7\x9d\xa6\x0f\x59\x69\xdb\x11\x3c\x5e\x90\xcc\x1b\x5d\xd9\xef\xa7\xda\x29\x49\xa8\x3a\x3c\xcb\xc3\xa8\xca\xfc\xeb\x62\xe4\x64\x54\xd3\x37\x1c\xa4\x27\xd5\x00\xa9\x08\x33\xaa\xb4\x9f\x48\x9e\x8b\x46\x84\xa8\x8a\x02\x1b\x1e\x4d\x84\xbd\x5a\x12\xd4\xc6\x97\x41\xd1\x36\xe2\x53\x8d\x0b\x1b\x2c\xb5\xf1\xdb\x48\x69\x04\x49\x75\x76\x76\x52\xe8\x18\x74\x73\x3d\xd3\x44\xe7\x6f\x41\xe0\x27\x49\x97\x28\xc1\xe9\x6b\x12\x98\xb3\x83\xfc\xb9\x10\x54\x56\xdc\xf2\xd7\xc0\xe7\x52\xcb\xa9\x81\x0e\x8a\x86\x4a\x90\xac\xb4\x62\xd4\xf8\x8c\x84\xc8\x2c\x77\x55\xdd\xc1\x6f\xb8\x36\xd4\x99\xe1\x23\x68\x76\xfe\x4e\x58\x6e\x57\x88\x66\xfe\xd5\x59\x73\xce\x6e\xb1\xf5\x5e\x06\x6d\xa6\xb9\x24\x09\x0e\x43\x39\x2a\x00\x19\x55\xbb\xc8\xea\x6d\xb9\xb2\xe6\x5b\x5c\xed\x46\xf5\x5c\xbe\x2a\xfa\xdb\x7c\x55\x3b\xeb\x7c\xf5\x36\x74\xf5\x86\x83\x9c\x59\x6f\x4a\x26\x8b\x86\x5d\xd1\xda\x95\xe3\xce\xab\xe9\x82\x24\x78\x38\x42\x2b\x8b\xce\xce\x50\x05\xa8\x2e\x4d\x27\x23\xa7\xda\x05\x6b\xa5\xa7\x8a\xcb\x69\x33\xfb\x92\x8b\x13\x9f\x5a\x19\xb3\x3f\xd6\x46\x99\x29\x0c\xe5\xf6\xff\x21\xdf\xae\x51\xf1\x5c\x46\xa8\xc6\x4b\x42\x99\x29\xc9\xdf\x20\x07\xa7\x8a\x33\x5b\xd6\x36\xf2\xcf\x99\x9c\x88\xbc\x6a\x6c\x67\xa0\x55\x98\xd8\x19\x3f\x40\x22\x4d\x97\xe6\x00\x50\x58\xf4\x6d\xdd\x0b\x82\x64\xc8\xdc\x6f\x6f\x34\xb9\xba\x75\xd3\xbf\xd5\x58\x2c\xde\x02\x23\x03\x27\x38\x37\x85\xf8\xc6\xf1\xfd\x26\xf7\xe5\x51\x4a\xa4\x99\xc7\x32\x46\x85\xa4\x99\x76\x8d\x6d\x63\x50\x53\x93\x67\x74\xa3\x46\x55\xd5\xb4\x91\x57\x27\xb1\xc3\x97\xaf\xe4\xfe\x8d\xdc\xf9\x4a\xee\xc1\xaa\x6a\x78\x34\xe1\x75\x87\xb6\x16\x77\xfb\x14\xf1\x57\xb9\xa4\xee\x5e\xef\xce\x98\x31\xbe\x32\x47\x16\xde\x9e\x24\x63\xa1\x61\x10\x82\xaf\x24\x4a\x69\x86\xd3\x6c\xce\x83\x8b\x7c\x61\xe5\xca\x35\xa6\x8d\xbe\x5c\x6a\x36\xff\x9d\x13\x5f\x71\xc1\xe5\xfa\xfb\x06\xbc\xb3\xb9\x27\xe6\xdd\x80\xc0\x3d\x89\xb0\x6a\x5e\x4f\x6f\x45\x59\x33\x03\xe8\xff\x70\x8f\xe1\xdd\x41\x53\xc8\xdf\x66\x54\xef\x91\x7f\x49\x54\x15\x7a\xde\x28\x51\xba\x92\xa4\xed\xf4\xc1\x1c\xd9\xe9\x6e\x21\xf9\x0a\x47\x67\x2b\x22\xde\x6a\x91\x5b\x11\xd1\xb9\x2c\xb4\x3d\xae\x58\x7d\x81\xd7\x15\xe9\x03\x9e\x37\x53\xaf\xf0\xee\x29\x67\x84\xc3\x9b\xd9\xad\x32\xa5\xd1\xee\x4a\xdc\x66\x5e\x91\x7f\x42\xd2\x05\x91\xeb\x3d\x65\x80\x19\x65\x2c\xd0\x2c\x69\x17\x02\xf5\x61\x45\x32\x5f\x2e\x51\x2e\x29\xae\xf6\x97\x07\xd5\x0a\x21\x37\x88\xfd\x84\xe4\x09\x7a\x75\x95\xe6\xd4\xbc\x29\x19\x7e\x88\x37\x57\x92\x47\xa8\xd4\xa1\x6a\xa7\xea\x8e\x28\x45\x7c\xcd\xc5\x93\x1c\xda\x51\x67\x7c\x47\x37\x6d\xc9\xf1\x14\x07\x3b\xbc\x69\x18\x38\x1d\xdd\x70\x4d\x18\x94\x71\x78\x6a\x23\xb3\xc2\x4f\x24\x72\x5f\x9b\x21\xbe\x9b\xf8\x28\x25\x52\x6f\x49\x81\xf2\xa9\x94\x51\x35\xb9\xba\x85\x73\x4e\x62\x18\x2f\x51\xee\xd1\xc7\x38\x89\xeb\x8a\x36\x0f\xab\xaa\xc8\x2c\x26\x10\xf6\xa8\x2e\x77\x2a\x13\x28\x7d\xb3\xff\x77\xe2\xeb\x56\xf9\x8b\x44\x72\x17\xf3\x55\xb6\x4b\xa7\x53\x15\x96\xc3\x76\x2a\x6d\x87\xc6\xc1\xdd\xf9\x3b\x86\x49\xb9\x51\x7f\xa7\x48\x59\x58\x73\x87\xa7\x21\x94\x83\x46\x4b\x05\x80\xe4\x2b\xe8\x3e\xf0\xec\x9d\xc2\xc6\xb0\xf6\x72\xfc\x2f\x7b\xb6\xac\xb9\x2a\x79\x22\xd1\x3e\x5b\x85\xd6\xa7\x6b\xa0\x1f\x12\x09\xd5\x3f\x7e\x6c\x0e\xaa\xd2\x2b\xd7\x11\xd7\x91\x72\xed\x3b\x2a\x3a\x35\x43\x7d\xaf\x52\xd2\xe7\x19\x5b\x7b\xa3\x5f\xb9\x86\x72\xc2\xdc\x21\xb9\x43\xb2\xcd\xe6\x73\xe0\xd2\x6c\xce\x1b\x60\x23\xce\xe2\x97\xa0\x9d\x70\x16\x3f\x15\x6e\xaf\xd7\x89\xbb\xbb\xb1\x3d\x73\x27\x5e\x35\xba\x34\xde\x37\x57\x9f\x67\x26\xe5\x05\xea\x15\x97\x77\xcf\xcc\xca\xde\xeb\xd3\xb1\x30\x5c\x6c\xf6\xcf\x49\xc4\x5e\xb3\x37\x96\x5c\x98\xe0\x6f\x27\x48\x98\x6b\xcd\x37\xf3\x15\xea\x0c\x42\x9d\xf9\x31\xce\x49\xce\x34\x94\x72\xbe\xe6\x49\xc2\xd0\x2b\x1e\x9d\x3b\x21\xc7\x73\xe6\x50\xfa\x0a\x19\x46\xf6\x08\xb0\x31\x06\x31\xd1\xa4\x10\xad\x60\x00\x22\x29\xf1\x53\xa2\x04\x17\xb9\x38\xf3\xb4\xcc\xb1\x68\xc4\x7b\x41\xb2\x18\xe3\x33\x6f\x4e\x98\xc2\x8e\x10\x73\xe1\xd5\x6d\xb8\x9c\xeb\xee\xf8\xaa\x05\x66\x44\x24\x56\xc6\xf6\xca\x48\x70\x9e\xb5\x58\xca\x59\xb7\x49\xaf\x49\xb0\xbf\xc0\x2c\xf7\x40\x72\xe3\xb1\xfb\x6d\x1d\xb3\xd5\x25\xc3\x38\x5c\xef\x65\xac\x1d\xf3\xc5\xe3\xa1\x3d\x61\xfb\x9c\x05\x39\x95\x3c\x4f\x52\x91\xeb\xf6\x2a\xb8\x59\x96\x4b\x78\xe1\x5a\xa3\x6a\x6f\xdf\x2f\x30\xfb\x59\x4a\x6e\x1f\x1f\xb7\xb6\x80\xd2\x16\xda\x11\xbb\x8d\x35\x9c\x6f\x64\xe8\x17\xf5\xc3\xb6\xcc\x2f\x94\xa1\x5a\x2b\x8d\x8b\xa7\x57\x90\xf3\x8d\x8c\xdb\xfb\x3a\x8b\xc8\xdd\x9a\x76\x2c\x53\x93\x5c\x69\xbe\xf8\x8a\x5a\xd2\xe8\xb9\x7c\x1c\x58\xac\x7a\xfb\x18\x18\xbb\xdb\x73\x13\xc7\x50\x58\x6f\xae\x58\xfb\x62\xa5\x51\x4b\x59\x27\xfc\x85\xd3\x73\x30\x1e\x7a\xcd\xc3\x66\xc7\x2d\xc8\x0f\x0b\x8d\x8e\xbb\x93\x43\xd1\xf1\xb4\xa2\x4a\x80\xa9\x9b\x6d\x59\xf3\xb1\xb9\x5e\xd0\x4c\xe4\xba\x56\xea\x56\x6f\x48\xfc\xd8\x5d\xf8\xf9\x11\xcf\x33\xed\x75\xee\xdf\x9b\xad\xbb\x4b\xce\xaa\xdf\x21\xb7\x24\x2c\xc7\xb3\xe3\xa3\x06\xe4\xdd\x0b\x4d\x27\xc2\x5a\x35\xd8\xd0\xd4\xbd\x00\xbe\x90\x43\x57\x8c\x1c\xa4\xb1\x28\x23\xfe\x9e\x4c\xd6\x4a\x2d\x67\x45\x72\xc6\x2a\x66\x42\xc6\xa3\x3b\xaf\xab\x7e\xde\xe7\xdc\xcb\x27\x61\xc7\x42\xdd\xd1\x59\xed\xaa\x74\xec\xbf\xa3\x2f\x85\x13\xfb\x2e\x53\x60\x11\xaa\x40\xa1\xbe\xcc\xcc\x39\x72\x42\x18\x0b\x49\x74\xf7\x4e\x69\x22\xf5\x15\x49\xf0\xdd\xc3\x43\xb0\xb9\x4f\x75\xf7\xdc\xef\xc1\xb4\xd5\x4e\xe3\xb6\xa9\x75\xf8\xb2\xad\xee\x02\xd9\xfe\x2c\x6f\x93\x7f\xb6\x2f\x3a\x99\x4f\x2c\xc9\xca\xdd\x96\x18\x3b\xf5\x8b\x99\x62\x50\xfd\x85\x01\xf7\x9e\xc0\x70\xe0\xde\xa2\xf9\x7f\x00\x00\x00\xff\xff\x19\x81\xf2\xde\xa8\x25\x00\x00")
+var _cmdInternalPagesAssetsHtmlContainersHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xcc\x5a\x4b\x73\xdb\x38\xf2\x3f\x4b\x9f\xa2\x87\xf5\x3f\x24\x55\x21\x15\x27\xfe\x1f\x76\x46\x66\x95\x47\x49\x76\xb4\x93\xd8\x2e\xcb\x9e\xa9\x39\x42\x64\x4b\x44\x0c\x01\x1c\x00\x94\xac\x75\xf9\xbb\x6f\x01\x20\x29\x3e\x25\xbf\x36\x5e\x5f\x4c\x11\xdd\xfd\xeb\x17\x1a\x0d\x10\xe3\x9f\x7c\x7f\x08\x30\x11\xe9\x56\xd2\x65\xa2\xe1\xc3\xfb\xa3\x63\xf8\xa7\x10\x4b\x86\x30\xe5\x51\x00\xa7\x8c\xc1\xa5\x19\x52\x70\x89\x0a\xe5\x1a\xe3\x60\x38\x04\xf8\x4a\x23\xe4\x0a\x63\xc8\x78\x8c\x12\x74\x82\x70\x9a\x92\x28\xc1\x62\xe4\x1d\xfc\x81\x52\x51\xc1\xe1\x43\xf0\x1e\xde\x18\x02\x2f\x1f\xf2\xde\xfe\x32\x04\xd8\x8a\x0c\x56\x64\x0b\x5c\x68\xc8\x14\x82\x4e\xa8\x82\x05\x65\x08\x78\x1b\x61\xaa\x81\x72\x88\xc4\x2a\x65\x94\xf0\x08\x61\x43\x75\x62\x61\x72\x21\xc1\x10\xe0\xaf\x5c\x84\x98\x6b\x42\x39\x10\x88\x44\xba\x05\xb1\xa8\xd2\x01\xd1\x46\x5f\xf3\x97\x68\x9d\xfe\x3c\x1a\x6d\x36\x9b\x80\x58\x5d\x03\x21\x97\x23\xe6\xe8\xd4\xe8\xeb\x74\xf2\xf9\x6c\xf6\xd9\xff\x10\xbc\x37\x1c\xd7\x9c\xa1\x52\x20\xf1\xef\x8c\x4a\x8c\x61\xbe\x05\x92\xa6\x8c\x46\x64\xce\x10\x18\xd9\x80\x90\x40\x96\x12\x31\x06\x2d\x8c\xb6\x1b\x49\x35\xe5\xcb\x77\xa0\xc4\x42\x6f\x88\xc4\x21\x40\x4c\x95\x96\x74\x9e\xe9\x9a\xab\x0a\xdd\xa8\xaa\x11\x08\x0e\x84\x83\x77\x3a\x83\xe9\xcc\x83\x5f\x4f\x67\xd3\xd9\xbb\x21\xc0\x9f\xd3\xab\xdf\xce\xaf\xaf\xe0\xcf\xd3\xcb\xcb\xd3\xb3\xab\xe9\xe7\x19\x9c\x5f\xc2\xe4\xfc\xec\xd3\xf4\x6a\x7a\x7e\x36\x83\xf3\x2f\x70\x7a\xf6\x17\xfc\x3e\x3d\xfb\xf4\x0e\x90\xea\x04\x25\xe0\x6d\x2a\x8d\xfe\x42\x02\x35\x4e\x34\x71\x03\x98\x21\xd6\x14\x58\x08\xa7\x90\x4a\x31\xa2\x0b\x1a\x01\x23\x7c\x99\x91\x25\xc2\x52\xac\x51\x72\xca\x97\x90\xa2\x5c\x51\x65\x42\xa9\x80\xf0\x78\x08\xc0\xe8\x8a\x6a\xa2\xed\x9b\x96\x51\xc1\xd0\xf7\xc3\xe1\x70\x9c\xe8\x15\x0b\x87\x00\xe3\x04\x49\x6c\x1e\x00\xc6\x9a\x6a\x86\x61\x74\x1a\xaf\xa9\x12\x12\x7c\xb8\xbb\x0b\x3e\x51\x95\x32\xb2\x3d\x23\x2b\xbc\xbf\x1f\x8f\x1c\x89\x23\x57\x91\xa4\xa9\x06\x25\xa3\x13\xef\xee\x2e\xb8\x14\x42\xdf\xdf\x2b\x83\x1c\x8d\x52\x91\xa6\x28\x83\x15\xe5\xc1\x77\xe5\x85\xe3\x91\x23\xce\x39\x7f\xf2\x7d\xf8\x4a\x34\x2a\x6d\x73\x88\x32\x8c\x8d\xee\xb0\xa2\x9c\x2e\x28\xc6\x30\x99\xcd\xc0\xf7\x73\x6a\x46\xf9\x0d\x48\x64\x27\x9e\xd2\x5b\x86\x2a\x41\xd4\x1e\x24\x12\x17\x6d\xdc\xb9\x10\x5a\x69\x49\x52\xff\x38\x78\x1f\xbc\xf7\xe7\xa8\x49\xf0\xc1\xea\x11\x29\xe5\x85\xc3\x9d\x02\xe7\xa9\x71\x11\x61\xc6\x3b\x2b\x7c\x2e\x9c\x15\xe2\x7f\x0c\x8e\x82\xa3\x16\xda\x63\x24\x46\x82\x9b\xd9\x82\x52\xb5\x14\xde\xeb\xb1\x7f\x91\x35\x99\xb9\x80\x94\x96\xec\x0b\xd0\xf7\xbf\x33\x94\x5b\xff\x63\xf0\xff\xc1\x51\x5f\x98\xf6\xf1\xef\x71\x74\x5b\xd2\x4e\x96\xde\xa6\x78\xe2\x69\xbc\xd5\xa3\xef\x64\x4d\xdc\x5b\xaf\x1b\x82\x09\x12\xa3\xdc\xa3\xd8\x63\x84\x55\xfc\xda\x14\x38\x1e\x15\x73\x60\x3c\x17\xf1\x36\xc7\x88\xe9\x1a\x22\x46\x94\x3a\xf1\x4a\x5e\x97\x2a\xbe\x4a\xc4\x26\x22\x0a\x3d\x28\xcd\x23\xcd\x70\x7a\x3b\x66\xe6\xab\x95\x7f\xf4\xc1\x03\x1a\x9f\x78\x4c\x2c\x85\x57\xb2\x8d\x48\xf9\x58\xc3\x2b\x58\xc2\xe1\xa0\x3a\x90\x92\x25\xfa\x46\x59\x94\x66\x08\x60\x9c\x1c\x85\xed\x49\x9a\x1c\x19\xbe\x51\x4c\xd7\xe6\xbf\x60\x05\xfb\x5c\x22\x89\x23\x99\xad\xe6\x8e\xfb\xee\x4e\x12\xbe\x44\xf8\xbf\x94\x48\xe4\x7a\x52\x9a\xf9\xf3\x09\x04\x17\xf5\x77\xea\xfe\xde\x02\x32\x1a\x56\x8c\x6d\x72\x06\x5f\x29\xbf\xb9\xbf\xf7\xc2\x8e\xa1\x2b\xbc\xd5\x46\x3b\x12\x8e\x47\x8c\xe6\x0a\x20\x8f\x8d\xe0\xf1\x48\xb0\x70\x38\xb8\xbb\xa3\x0b\x08\x4a\x16\x67\xcf\x70\x30\x4e\x8e\xc3\xb6\x87\x71\x8d\x5c\xab\xbb\xbb\x26\xbd\x17\x7e\xb6\x23\x0e\x29\x39\xb6\x72\x1d\x4e\xe1\x75\xeb\x19\xf7\xc3\x41\x4e\x95\x93\x79\x20\x18\x90\xff\xd5\x14\x0a\x82\x51\x2c\xa2\x1b\x13\x92\x4f\xf6\x3f\xec\x9c\xb6\xd3\xa1\x03\xfa\x10\x4a\x1b\x27\x15\xf1\x8a\x70\x2f\xbc\xb0\xff\xff\xab\x38\xca\x0b\x7f\xcf\xe6\x28\x39\x6a\x54\x70\x21\xe2\xfd\x18\x75\x0f\x3b\xa7\xce\xb2\x79\x54\x4d\x9f\xe7\x25\xfa\xc7\xb0\x26\x6f\x3c\x4a\x3e\x56\xb2\xdc\x21\x5e\xa2\x12\x99\x8c\x50\x9d\xae\x09\x65\xa6\x13\xb0\xe9\x43\x79\x9a\x69\x3b\xfb\x54\x45\x84\xaf\x90\xc8\x28\x29\x27\xea\x42\xc8\x95\x6f\x46\xa5\x60\xd0\x45\x38\x1c\x38\x0b\xf2\xba\x53\xb0\xa7\x8c\x44\x98\x08\x16\xa3\x3c\xf1\x66\xf6\xa5\xe9\x46\x38\x59\xe1\x3b\xa0\x2b\xb3\x5a\x0b\x09\x8c\xcc\x91\x9d\xac\x09\xcb\x70\x27\x48\x70\xab\x5a\x21\xab\x66\xe0\x9b\xb7\xa5\x53\x5a\x9a\x6b\x63\x9a\x17\xee\x8c\x2f\xa6\x51\xc5\x85\x8c\x2a\xed\x2f\xa5\xc8\x52\xaf\x2d\xc0\x0c\x36\x6a\x40\x75\xdc\x16\x80\x66\xf8\x06\xb5\x32\x57\xa3\x2f\xa6\x7d\x1b\xdb\xa7\x1a\x57\x5e\xd8\xa4\xdf\xd5\x82\x46\x19\xa8\xa6\x6d\x6f\x7e\xb9\x60\xbb\xc9\x36\xd3\x44\x67\x2f\x91\x5d\x9f\x24\x5d\xa3\x04\x27\xaf\x91\x5d\xe3\x8c\x75\xb8\xb5\xee\x3f\x57\x03\x94\x65\xb7\xfe\x6b\xe8\xe7\x8a\xa7\x13\x03\x1d\x2e\x1a\xab\x94\xf0\x02\xc5\x88\xf1\x6d\xca\x58\xdf\x55\x65\x07\xbf\xe3\xd6\xb8\xce\x90\x87\xd0\x1c\xfc\xc3\x24\xd8\xfd\x7d\xbb\xc2\xd6\xbd\xe6\x8c\xdd\xe9\x36\x78\x9a\x6a\x33\x2d\x24\x59\xe2\x78\x2e\xc3\x5c\xa1\xe1\xa0\xdf\x59\x83\x9d\xaf\x2c\x7c\xcb\x57\xfd\x5a\x3d\xd6\x5f\x15\xf9\x6d\x7f\x55\x07\xeb\xfe\xda\xcd\xa4\xc1\x78\x94\x31\x6b\x8d\x1b\x01\xc8\x5f\xf4\x65\x6b\x57\x01\x74\x56\x4d\x4d\x09\x50\x0f\x5e\x5b\x00\xfa\x53\x15\xa0\x5a\xb3\x3f\x86\x4e\xb4\x4b\xd6\xca\x48\x55\x2f\x28\x2b\x88\xcb\x13\xdf\x56\x24\x55\x16\x8f\x82\xca\x84\x70\x2e\x77\xbf\x0f\xd9\xd6\x59\x6a\x9f\x3b\x07\xa7\x4a\x30\xbb\x71\xe9\xac\xee\x93\x34\xab\xd7\xf5\x9e\x44\xab\x78\xa2\x37\x7f\x80\x44\x9a\xae\x11\x68\x81\xe8\xdb\x9d\x0d\xa4\x84\x23\x73\xcf\x5e\x38\xb9\xb8\x76\xe1\xdf\x49\xcc\x57\xb6\x14\x23\xa3\x4e\xf0\xd5\x6c\xb5\xee\xef\x2b\x04\x4f\x4a\xd9\x59\x42\xa4\x89\x63\x91\xa3\xa9\xa4\x5c\xbb\x97\x6d\x30\xa8\x89\xc9\x38\x2d\xc5\xa8\xaa\x98\xb6\xe6\xd5\x20\x76\xd8\xf2\x8d\xdc\xbe\x90\x39\xdf\xc8\x2d\x58\x51\x0d\x8b\x26\xa2\x6e\xd0\x0e\xb1\xdf\xa6\x48\x3c\xcb\x24\x75\xf3\x7c\x73\x4e\x19\x13\x1b\xb3\x29\x15\xed\x20\x19\x84\x06\x20\x04\xdf\x48\x94\x50\x8e\x53\xbe\x10\xc1\x59\xb6\xb2\x7c\x45\x8d\x69\x6b\x5f\x94\x9a\xf2\xb7\x33\xe2\x1b\xae\x84\xdc\xfe\xd8\x84\x77\x98\x7b\x72\xde\x11\x04\xee\xac\xc9\x8a\x79\xbe\x7b\x2b\xc2\x9a\x33\x80\xfe\x1b\xf7\x00\xf7\x27\x4d\xce\x7f\xcd\xa9\xde\xc3\xff\x94\xac\xca\xe5\xbc\xd0\x44\xe9\x9a\x24\x6d\xa3\x0f\xce\x91\x5e\x73\x73\xce\x67\x18\x3a\xdb\x90\xf4\xa5\x8a\xdc\x86\xa4\xf0\x30\x8b\x2b\xa8\x4f\xb0\xba\xc2\x7d\xc0\xf2\xe6\xd4\x7b\xc4\xe6\xe9\xf0\x62\x76\xad\x4c\x6b\xd4\x68\x24\x6b\x4c\x1c\x59\x3e\xff\x52\x49\x57\x44\x6e\xf7\xb4\x01\x86\xca\x20\x50\xbe\x6c\x37\x02\x75\xb2\x7c\x32\x9f\xaf\x51\xae\x29\x6e\xf6\xb7\x07\xd5\x0e\x21\x33\x1a\xfb\x4b\x92\x2d\xd1\xab\x8b\x34\xe7\x22\xbb\xfd\xc6\x6b\x58\x73\x21\x45\x84\x4a\x1d\xea\x76\xaa\xe6\xa4\x05\x8b\xaf\x45\xfa\x20\x83\x7a\xfa\x8c\x1f\x68\xa6\x6d\x39\x1e\x62\x60\x87\x35\x0d\x80\xe3\xf0\x4a\x68\xc2\xa0\xc8\xc3\xe3\x70\x38\x28\xd9\x8d\x7f\xa2\x34\xf3\xb5\x21\xf1\x5d\xe0\xa3\x84\x48\xbd\x73\x4a\x79\xee\x68\x44\x4d\x2e\xae\xe1\xab\x20\x31\x9c\xae\x51\xee\x91\x67\xce\xec\xea\x82\xca\xe3\xc8\x7a\x91\xb9\x40\xd9\xf0\x73\x53\x7b\xab\x37\xa4\xf6\x3c\x45\xf6\x02\xa6\x28\x7d\xd3\x23\x74\xda\x50\x87\x6d\x56\xbb\x2a\xcc\xaf\x12\xc9\x4d\x2c\x36\xbc\x0f\xc7\x89\x9f\x17\x64\x7b\x81\x8c\x7d\x57\x89\x14\x5a\x33\xca\x97\xfb\x6c\xdc\x51\xf5\x06\xa8\xa4\x78\x94\x6d\xed\xcc\x3e\xd8\x5c\xfc\xc0\x2c\x2f\xfa\x8c\x1f\x94\xe8\x2b\x0b\x77\x30\x43\xc6\x73\x39\x6a\xbc\xa9\x28\x20\xc5\x06\xba\xf7\x6b\x7b\x33\x09\xa0\x4f\x60\x2e\xec\x1f\x76\x6b\x5c\x33\x55\x8a\xa5\xf9\x38\xd4\x02\x69\xf9\x24\x27\xf4\xe7\x44\x42\xf5\x87\x1f\x9b\x7d\xb6\xf4\x8a\x32\xe8\x06\x12\xa1\x7d\xe7\x8a\x4e\xc9\x50\x5f\x6a\x95\xf4\x05\x67\x5b\x2f\xfc\x4d\x68\x28\x02\xe6\xf6\xf8\x1d\x9c\x6d\x6f\x3e\x46\x5d\xca\x17\xa2\xa1\x6c\x24\x58\xfc\x14\x6d\x27\x82\xc5\x0f\x55\x77\x30\xe8\xd4\xbb\xfb\x65\x3b\x72\x1f\xbd\x6a\x76\x99\xcf\x10\x8d\xe2\xd9\x91\x62\xa6\x94\x8a\x55\x2a\x14\xcd\xb7\xb9\x7d\xc9\x1a\xed\xa8\xf6\xa5\x6c\x72\x1c\x5e\x98\xac\xfb\x42\x32\xa6\xd5\x1e\x79\xb6\x45\x59\x58\xaa\x5e\x79\x07\x8a\xc6\x85\x09\x4c\x26\xf1\xd5\xca\x46\xa1\xc0\xcb\x15\x0e\xb7\xdc\x76\x2f\x2c\x39\xd8\x01\xef\x97\xa5\xac\xd7\xf1\x0f\x93\x33\x3d\xef\x92\x41\xc5\x61\xfe\x03\x51\x3b\x8d\x22\x64\x28\x89\x16\x52\xbd\x5a\xe4\xaa\x4a\xbc\x5c\xf4\x3e\x65\x7a\x0b\x93\x6d\xc4\x3a\xab\x3e\xd9\x61\xfa\x71\xa6\xb7\x7e\x64\x28\x1f\x14\xce\xfe\xa5\xa4\x2a\xb4\x98\xa9\x4f\x8a\x8b\x90\xb6\x07\x5a\x98\xde\xa6\x0c\x8b\x7b\x75\xcd\x23\xf1\x9a\xb3\x0c\xe5\x02\x8a\x4f\x68\x2f\x36\xd1\x44\xc6\xb5\xfb\x6c\xd3\xf2\x69\x8a\x72\xe1\xbb\xaf\x79\x8d\x2f\x1b\x9d\x6d\x63\xdd\x65\x1d\x4d\x95\x19\xdf\x19\xd0\x0d\x67\x5b\xc6\x1c\xf3\x71\x3d\xe3\x4e\x8f\x76\x9c\x5a\x1d\x01\x8f\x1e\xa0\x4b\xc6\x9f\xaa\xcd\x81\xa9\x7f\x86\x7a\x23\xe4\xcd\x23\x33\x69\xf0\xfc\x14\xca\x81\xf3\xcd\xef\x63\xf2\x66\xd0\x1c\x8d\xa5\x48\x4d\x37\xd5\xee\xb8\xe6\x99\xd6\xa2\x6c\x00\xe6\x9a\xc3\x5c\x73\x3f\x46\xbb\xc8\x41\xc1\xe7\x6b\xb1\x5c\x32\xf4\xf2\x8f\x76\x8e\xc9\x2d\xdc\xdc\x69\xe9\x2b\x64\x18\xd9\x85\xb6\x04\x83\x98\x68\x92\xb3\x56\x74\x00\x22\x29\xf1\x13\xa2\x52\x91\x66\xe9\x89\xa7\x65\x86\xf9\x4b\xbc\x4d\x09\x8f\x31\x3e\xf1\x16\x84\x29\x6c\xa9\x5b\xf4\x2b\xdd\xc0\x45\xf3\xd0\xdd\xb0\xd4\x3a\x9d\x88\x48\xac\xd0\x0e\x8a\x4c\x70\x96\xb5\xbc\x94\xb1\x6e\x48\xaf\xe9\x60\x7f\x85\x3c\xf3\x40\x0a\x63\xb1\x7b\xb6\x86\xd9\xd3\x16\x86\xf1\x7c\xbb\xd7\x63\xed\x26\x2a\xff\x5c\xd2\x15\xfd\xb2\x49\x7a\x78\x87\x9f\x48\x91\x2d\x93\x34\xd3\xed\xb6\xba\x9c\x4d\x85\x7a\xf3\xad\x46\xd5\xde\xce\x3e\x01\xf6\xb3\x94\xa2\xbb\x68\x15\x58\x68\x29\xfa\xc1\x1a\xc6\x37\x66\xe8\x97\xd7\x5b\x92\xbf\x50\x86\x6a\xab\x34\xae\x1e\x7e\xa2\xb2\x28\x79\xdc\x66\xca\x3b\xe0\xc4\x86\xa4\x9e\x32\x35\xc9\x94\x16\xab\x6f\xa8\x25\x8d\xd4\xcb\x16\xab\xc1\xde\xa6\xc4\xdd\x17\x34\x79\x0c\x39\x7a\xb3\x62\x0d\x1e\x54\xaa\x6c\xcb\x68\x8d\xf0\x57\x4e\xce\xc1\x7c\x18\x34\x8b\x79\xc7\x95\x89\x57\x4b\x8d\x8e\x8b\x16\x2f\xb3\x4b\x4f\xc1\x9c\x23\xd9\xe6\xea\xe7\x66\xbd\xd8\x5d\xd2\x28\x8e\x75\x6a\x37\x16\x62\x77\xc5\xc9\x8f\x4c\x23\xe1\x75\x6e\x08\xcb\xbd\x60\x17\x9f\x15\xdf\xc3\x67\x6f\x65\x9c\x1c\xbd\x6f\xa8\xdc\x5f\x68\x3a\x35\xac\x1d\x2f\x0c\xf7\x6e\x28\x9f\xe7\xc3\x6a\x8f\xba\xc7\x8d\xc5\xfe\xe3\x7f\xd2\x93\xb5\xbd\xbb\x43\x91\x82\xb1\x0a\xcc\x9c\x89\xe8\xc6\x1b\xf5\x44\xa0\xcf\xb8\xa7\x07\xa1\xa7\x50\x77\x0c\x56\x87\x2a\x03\xfb\x6f\x25\x16\xcc\x4b\x7b\x7b\x3b\xb0\x1a\xaa\x40\xa1\x3e\xe7\xe6\x5c\x75\x42\x18\x9b\x93\xe8\xe6\x8d\xd2\x44\x6a\xb3\xa5\x7f\xd3\xbe\xd9\xf6\xce\x5c\xc8\xad\x9f\x9a\xda\x57\xad\xd3\x3c\xfb\xd6\xdd\x68\xb3\x8f\xc5\xf5\xb6\xb7\x6f\x7f\xc9\xd5\x88\x25\xd9\xb8\xdb\x03\x06\xa7\x7e\x51\x21\x27\xaa\x5f\x91\x74\x37\x23\xc7\x23\x77\x6f\xf8\x3f\x03\x00\x63\xa1\xce\x78\x9a\x2e\x00\x00")
 
 func cmdInternalPagesAssetsHtmlContainersHtmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -99,7 +100,27 @@ func cmdInternalPagesAssetsHtmlContainersHtml() (*asset, error) {
 	}
 
 	info := bindataFileInfo{name: "cmd/internal/pages/assets/html/containers.html", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x3b, 0xb9, 0x8c, 0xe6, 0xbf, 0xf1, 0x9d, 0x7, 0xe7, 0xbc, 0xd5, 0xf1, 0xb1, 0xc2, 0x47, 0xe5, 0x2e, 0x76, 0xdf, 0x59, 0x12, 0x64, 0x50, 0x9d, 0x91, 0xb3, 0xaf, 0xb3, 0x1, 0x60, 0xd, 0x6c}}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x3c, 0x75, 0x85, 0xc4, 0x4d, 0x53, 0x2, 0xeb, 0x54, 0x22, 0x60, 0xeb, 0xf2, 0xa6, 0xb1, 0x49, 0xf2, 0x3e, 0x1a, 0xd6, 0xb7, 0xb8, 0x1b, 0xe2, 0xb1, 0x9c, 0xa3, 0xbe, 0x27, 0xa1, 0xd2, 0x81}}
+	return a, nil
+}
+
+var _cmdInternalPagesAssetsHtmlEventsHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xac\x56\x5d\x6f\xda\x48\x14\x7d\xae\x7f\xc5\xed\x68\x1f\x52\x29\xb6\x81\x64\xa3\xaa\x6b\x2c\xd1\x34\xdd\x65\x37\x82\x2a\xd0\xad\xfa\x38\x1e\x5f\xec\x69\x86\x19\x77\x66\x0c\x41\x88\xff\xbe\x1a\xdb\x18\x08\x84\xdd\x55\xcb\x0b\xd6\xcc\x3d\xe7\x9e\xfb\x69\x47\xaf\x7d\xdf\x03\xb8\x55\xc5\x4a\xf3\x2c\xb7\xd0\xeb\xf4\x6e\xe0\x77\xa5\x32\x81\x30\x94\x2c\x80\x81\x10\xf0\xe0\xae\x0c\x3c\xa0\x41\xbd\xc0\x34\xf0\x3c\x80\x7b\xce\x50\x1a\x4c\xa1\x94\x29\x6a\xb0\x39\xc2\xa0\xa0\x2c\xc7\xed\xcd\x25\xfc\x8d\xda\x70\x25\xa1\x17\x74\xe0\xc2\x19\x90\xe6\x8a\xbc\xf9\xcd\x03\x58\xa9\x12\xe6\x74\x05\x52\x59\x28\x0d\x82\xcd\xb9\x81\x19\x17\x08\xf8\xc4\xb0\xb0\xc0\x25\x30\x35\x2f\x04\xa7\x92\x21\x2c\xb9\xcd\x2b\x37\x0d\x49\xe0\x01\x7c\x6d\x28\x54\x62\x29\x97\x40\x81\xa9\x62\x05\x6a\xb6\x6f\x07\xd4\x3a\xbd\xee\x97\x5b\x5b\xbc\x0b\xc3\xe5\x72\x19\xd0\x4a\x6b\xa0\x74\x16\x8a\xda\xce\x84\xf7\xc3\xdb\xbb\xd1\xe4\xce\xef\x05\x1d\x87\xf8\x2c\x05\x1a\x03\x1a\xbf\x97\x5c\x63\x0a\xc9\x0a\x68\x51\x08\xce\x68\x22\x10\x04\x5d\x82\xd2\x40\x33\x8d\x98\x82\x55\x4e\xed\x52\x73\xcb\x65\x76\x09\x46\xcd\xec\x92\x6a\xf4\x00\x52\x6e\xac\xe6\x49\x69\x0f\x52\xb5\xd5\xc6\xcd\x81\x81\x92\x40\x25\x90\xc1\x04\x86\x13\x02\xef\x07\x93\xe1\xe4\xd2\x03\xf8\x32\x9c\xfe\x31\xfe\x3c\x85\x2f\x83\x87\x87\xc1\x68\x3a\xbc\x9b\xc0\xf8\x01\x6e\xc7\xa3\x0f\xc3\xe9\x70\x3c\x9a\xc0\xf8\x23\x0c\x46\x5f\xe1\xaf\xe1\xe8\xc3\x25\x20\xb7\x39\x6a\xc0\xa7\x42\x3b\xfd\x4a\x03\x77\x49\x74\x75\x03\x98\x20\x1e\x08\x98\xa9\x5a\x90\x29\x90\xf1\x19\x67\x20\xa8\xcc\x4a\x9a\x21\x64\x6a\x81\x5a\x72\x99\x41\x81\x7a\xce\x8d\x2b\xa5\x01\x2a\x53\x0f\x40\xf0\x39\xb7\xd4\x56\x27\x47\x41\x05\x9e\xef\xc7\x9e\x17\xe5\x76\x2e\x62\x0f\x20\xca\x91\xa6\x71\x55\x82\xc8\x72\x2b\x30\x66\x83\x74\xc1\x8d\xd2\xe0\xc3\xdd\x02\xa5\x35\xae\x64\xeb\x75\xf0\x81\x9b\x42\xd0\xd5\x88\xce\x71\xb3\x89\xc2\xda\xb8\x06\x1a\xa6\x79\x61\xc1\x68\xd6\x27\xeb\x75\xf0\xa0\x94\xdd\x6c\x8c\xd3\xc0\xc2\x42\x15\x05\xea\x60\xce\x65\xf0\xcd\x90\x38\x0a\x6b\xe3\x06\xf9\xda\xf7\xe1\x9e\x5a\x34\xb6\xea\x26\x2e\x30\x75\x51\xc0\x9c\x4b\x3e\xe3\x98\xc2\xed\x64\x02\xbe\xdf\x58\x0b\x2e\x1f\x41\xa3\xe8\x13\x63\x57\x02\x4d\x8e\x68\x09\xe4\x1a\x67\xc7\x7e\x13\xa5\xac\xb1\x9a\x16\xfe\x75\xd0\x09\x3a\x7e\x82\x96\x06\xbd\x4a\x07\x33\x86\xc4\xde\x4e\xc0\xb8\x70\xc9\xa2\xc2\xe5\x69\x8e\x3f\xea\xae\x22\xf1\xaf\x82\x6e\xd0\x3d\xf2\xf6\x7f\x18\x99\x92\x6e\x6e\x50\x9b\x23\xc1\x67\x33\xf6\x27\x5d\xd0\x49\x5d\x90\x36\x92\x73\x05\xfa\xf6\xbd\x44\xbd\xf2\xaf\x82\x5f\x83\xee\x4b\x65\x3a\x87\x3f\x93\xe8\x97\x99\xec\xaa\xc0\x3e\xb1\xf8\x64\xc3\x6f\x74\x41\xeb\x53\x72\xda\x81\x50\x34\x45\xfd\x93\xc8\xf6\xb2\xfa\x9c\x30\x0a\xb7\xb3\x10\x25\x2a\x5d\x35\x3e\x52\xbe\x00\x26\xa8\x31\x7d\xd2\x62\xeb\x46\xf1\x4d\xae\x96\x8c\x1a\x24\x10\x37\x3b\x2c\xa2\xcf\x8b\x49\x76\x60\xe1\x9b\xb9\xdf\xed\x11\xe0\x69\x9f\x08\x95\x29\xd2\xc2\x42\xda\x3e\x1e\xf8\xdb\x42\x62\xef\xd5\xfe\x45\x41\x33\xf4\x9d\x58\xd4\xee\xca\x4d\x71\x37\x3e\x37\xac\x79\xd7\x31\x84\x29\x5f\xb8\x7f\x25\xb6\x44\x89\x46\x9a\x32\x5d\xce\x93\x9a\x67\xbd\xd6\x54\x66\x08\xbf\x14\x54\xa3\xb4\xb7\x6d\xc0\xef\xfa\x10\x7c\x3a\x3c\x33\x9b\x4d\xe5\x5a\xf0\x78\x2f\xec\xe7\xc8\xe0\x9e\xcb\xc7\xcd\x86\xc4\x27\xae\xa6\xf8\x64\x9d\x3a\x1a\x47\xa1\xe0\x8d\x00\x94\xa9\x23\x8e\x42\x25\x9c\xd6\xfc\x3a\x3e\x4e\xea\xae\x88\xeb\x75\xd0\xf2\xd5\xc1\x92\xf8\x3d\x65\x8f\x60\x55\xb5\xf5\x5a\xcb\xda\x4b\x7e\xbd\x4b\x79\x95\x8c\x1f\x4b\xfa\x55\xfc\x91\x0b\x8b\xda\x44\x61\x7e\xb5\x9f\xe1\x3d\xd8\x4c\xe9\xb9\xcf\xa5\xe0\x12\x01\xab\x12\xf9\xb3\x0a\xd4\x90\x70\x59\x94\xb6\x6a\x89\xe6\xb6\x95\x4c\x0e\x28\xdc\xb1\x56\x82\xec\x75\x3b\xf1\x5e\xbd\x82\x05\x15\x25\xf6\xc9\x89\x44\x40\xb5\xa2\xfb\xa4\x3d\x07\x53\x26\x56\x23\x36\x9e\x05\x4d\xb0\xed\x04\x96\x23\x7b\x4c\xd4\x53\x23\xb5\x36\x39\x25\xcf\x94\xc9\x2e\xfd\x5b\x35\x5b\x34\x81\xea\x09\xd3\x06\x3e\x94\x4c\x94\x29\xc2\x01\xa8\x72\x1e\x56\xde\x6b\x21\x06\x05\xb2\x03\x27\x55\x17\x9e\x8e\x7f\x2b\x4c\x55\x4b\x7b\x1b\xfe\xd5\x4d\xa7\x43\xe2\x7b\x6a\x2c\xe4\xaa\xd4\x51\x58\x5f\x9f\x36\xee\x75\x77\xd6\x37\x95\xbd\x39\x0f\x78\x7b\x73\xdd\xe9\x10\xa8\x75\x62\x5a\x23\x53\xba\x3a\x8f\xba\xe9\x5c\xbf\x6d\xfd\x2c\x11\x1f\x0f\xcc\xa3\xb0\xa6\x7b\xa1\x6d\x4e\xb4\xca\x76\x38\x83\x6a\xd2\xa7\xab\x02\xdb\x11\xfc\xef\x95\x3c\xa4\x77\xd5\x3b\xae\xe1\xae\xa5\xaa\xfd\x75\x50\xd1\xea\xf0\x79\x05\xf7\xa6\x76\x7f\xaa\x7e\xce\x88\x4d\xf9\x1c\x5d\x20\xa7\x66\x6c\xaf\x65\x6c\x63\x46\xe2\xc6\xe2\xa7\x8a\xa8\x97\xeb\xbf\x49\x70\xdf\x9e\x2f\xfb\xdf\x7f\x3c\xfb\xe6\xda\x62\xb3\xea\x4b\x3f\x60\x39\xd5\xd6\x04\x06\xed\x58\xde\x2b\x9a\xde\x52\x21\x12\xca\x1e\x2f\x66\xa5\x64\xae\x9f\x2e\xde\xc0\xba\x81\x00\x18\x4b\xb5\xad\xe5\x7e\xa2\x19\x5e\x1c\x2f\x86\x4b\x68\x17\x69\xf5\xa9\xef\x7e\xdb\xa7\xc3\xf7\x61\xfd\x1a\x8c\xc2\xfa\x63\xf1\x9f\x01\x00\x64\x0b\x98\x0b\x8f\x0c\x00\x00")
+
+func cmdInternalPagesAssetsHtmlEventsHtmlBytes() ([]byte, error) {
+	return bindataRead(
+		_cmdInternalPagesAssetsHtmlEventsHtml,
+		"cmd/internal/pages/assets/html/events.html",
+	)
+}
+
+func cmdInternalPagesAssetsHtmlEventsHtml() (*asset, error) {
+	bytes, err := cmdInternalPagesAssetsHtmlEventsHtmlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "cmd/internal/pages/assets/html/events.html", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xa7, 0x52, 0x7c, 0xca, 0x6a, 0x93, 0xe9, 0x2d, 0x43, 0x4c, 0xd6, 0x4e, 0x1c, 0xfb, 0xb2, 0x77, 0x77, 0xe, 0x3, 0x9f, 0x88, 0x7e, 0xb4, 0xcb, 0xa5, 0xa1, 0xa3, 0x3c, 0xbb, 0xca, 0x26, 0xe3}}
 	return a, nil
 }
 
@@ -195,6 +216,7 @@ func AssetNames() []string {
 // _bindata is a table, holding each asset generator, mapped to its name.
 var _bindata = map[string]func() (*asset, error){
 	"cmd/internal/pages/assets/html/containers.html": cmdInternalPagesAssetsHtmlContainersHtml,
+	"cmd/internal/pages/assets/html/events.html":     cmdInternalPagesAssetsHtmlEventsHtml,
 }
 
 // AssetDebug is true if the assets were built with the debug flag enabled.
@@ -249,6 +271,7 @@ var _bintree = &bintree{nil, map[string]*bintree{
 				"assets": {nil, map[string]*bintree{
 					"html": {nil, map[string]*bintree{
 						"containers.html": {cmdInternalPagesAssetsHtmlContainersHtml, map[string]*bintree{}},
+						"events.html":     {cmdInternalPagesAssetsHtmlEventsHtml, map[string]*bintree{}},
 					}},
 				}},
 			}},
@@ -36,6 +36,7 @@ type elasticStorage struct {
 	machineName string
 	indexName   string
 	typeName    string
+	eventIndex  string
 	lock        sync.Mutex
 }
 
@@ -46,10 +47,19 @@ type detailSpec struct {
 	ContainerStats *info.ContainerStats `json:"container_stats,omitempty"`
 }
 
+type eventDetail struct {
+	MachineName string `json:"machine_name,omitempty"`
+	*info.Event
+}
+
+// eventTypeName is the type name of the documents of container events.
+const eventTypeName = "events"
+
 var (
 	argElasticHost   = flag.String("storage_driver_es_host", "http://localhost:9200", "ElasticSearch host:port")
 	argIndexName     = flag.String("storage_driver_es_index", "cadvisor", "ElasticSearch index name")
 	argTypeName      = flag.String("storage_driver_es_type", "stats", "ElasticSearch type name")
+	argEventIndex    = flag.String("storage_driver_es_events_index", "cadvisor_events", "ElasticSearch index name of the container events, if storage_event_types is set")
 	argEnableSniffer = flag.Bool("storage_driver_es_enable_sniffer", false, "ElasticSearch uses a sniffing process to find all nodes of your cluster by default, automatically")
 )
 
@@ -62,6 +72,7 @@ func new() (storage.StorageDriver, error) {
 		hostname,
 		*argIndexName,
 		*argTypeName,
+		*argEventIndex,
 		*argElasticHost,
 		*argEnableSniffer,
 	)
@@ -110,6 +121,20 @@ func (s *elasticStorage) AddStats(cInfo *info.ContainerInfo, stats *info.Contain
 	return nil
 }
 
+func (s *elasticStorage) AddEvent(event *info.Event) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	_, err := s.client.Index().
+		Index(s.eventIndex).
+		Type(eventTypeName).
+		BodyJson(&eventDetail{MachineName: s.machineName, Event: event}).
+		Do()
+	if err != nil {
+		return fmt.Errorf("failed to write event to ElasticSearch: %v", err)
+	}
+	return nil
+}
+
 func (s *elasticStorage) Close() error {
 	s.client = nil
 	return nil
@@ -122,6 +147,7 @@ func newStorage(
 	machineName,
 	indexName,
 	typeName,
+	eventIndex,
 	elasticHost string,
 	enableSniffer bool,
 ) (storage.StorageDriver, error) {
@@ -153,6 +179,7 @@ func newStorage(
 		machineName: machineName,
 		indexName:   indexName,
 		typeName:    typeName,
+		eventIndex:  eventIndex,
 	}
 	return ret, nil
 }
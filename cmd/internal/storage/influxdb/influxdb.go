@@ -435,6 +435,13 @@ func (s *influxdbStorage) AddStats(cInfo *info.ContainerInfo, stats *info.Contai
 	return nil
 }
 
+// QueueLength returns the number of points buffered until the next write.
+func (s *influxdbStorage) QueueLength() int {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return len(s.points)
+}
+
 func (s *influxdbStorage) Close() error {
 	s.client = nil
 	return nil
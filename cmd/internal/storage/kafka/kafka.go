@@ -34,24 +34,35 @@ import (
 
 func init() {
 	storage.RegisterStorageDriver("kafka", new)
-	kafka.Logger = log.New(os.Stderr, "[kafka]", log.LstdFlags)
+	// Log through klog, so that -log_format applies.
+	logger := klog.NewStandardLogger("INFO")
+	logger.SetFlags(logger.Flags() | log.Lmsgprefix)
+	logger.SetPrefix("[kafka] ")
+	kafka.Logger = logger
 }
 
 var (
-	brokers   = flag.String("storage_driver_kafka_broker_list", "localhost:9092", "kafka broker(s) csv")
-	topic     = flag.String("storage_driver_kafka_topic", "stats", "kafka topic")
-	certFile  = flag.String("storage_driver_kafka_ssl_cert", "", "optional certificate file for TLS client authentication")
-	keyFile   = flag.String("storage_driver_kafka_ssl_key", "", "optional key file for TLS client authentication")
-	caFile    = flag.String("storage_driver_kafka_ssl_ca", "", "optional certificate authority file for TLS client authentication")
-	verifySSL = flag.Bool("storage_driver_kafka_ssl_verify", true, "verify ssl certificate chain")
+	brokers    = flag.String("storage_driver_kafka_broker_list", "localhost:9092", "kafka broker(s) csv")
+	topic      = flag.String("storage_driver_kafka_topic", "stats", "kafka topic")
+	eventTopic = flag.String("storage_driver_kafka_events_topic", "events", "kafka topic of the container events, if storage_event_types is set")
+	certFile   = flag.String("storage_driver_kafka_ssl_cert", "", "optional certificate file for TLS client authentication")
+	keyFile    = flag.String("storage_driver_kafka_ssl_key", "", "optional key file for TLS client authentication")
+	caFile     = flag.String("storage_driver_kafka_ssl_ca", "", "optional certificate authority file for TLS client authentication")
+	verifySSL  = flag.Bool("storage_driver_kafka_ssl_verify", true, "verify ssl certificate chain")
 )
 
 type kafkaStorage struct {
 	producer    kafka.AsyncProducer
 	topic       string
+	eventTopic  string
 	machineName string
 }
 
+type eventDetail struct {
+	MachineName string `json:"machine_name,omitempty"`
+	*info.Event
+}
+
 type detailSpec struct {
 	Timestamp       time.Time            `json:"timestamp"`
 	MachineName     string               `json:"machine_name,omitempty"`
@@ -90,6 +101,18 @@ func (s *kafkaStorage) AddStats(cInfo *info.ContainerInfo, stats *info.Container
 	return err
 }
 
+func (s *kafkaStorage) AddEvent(event *info.Event) error {
+	b, err := json.Marshal(&eventDetail{MachineName: s.machineName, Event: event})
+	if err != nil {
+		return err
+	}
+	s.producer.Input() <- &kafka.ProducerMessage{
+		Topic: s.eventTopic,
+		Value: kafka.StringEncoder(b),
+	}
+	return nil
+}
+
 func (s *kafkaStorage) Close() error {
 	return s.producer.Close()
 }
@@ -151,6 +174,7 @@ func newStorage(machineName string) (storage.StorageDriver, error) {
 	ret := &kafkaStorage{
 		producer:    producer,
 		topic:       *topic,
+		eventTopic:  *eventTopic,
 		machineName: machineName,
 	}
 	return ret, nil
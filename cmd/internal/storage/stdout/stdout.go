@@ -235,6 +235,11 @@ func (driver *stdoutStorage) AddStats(cInfo *info.ContainerInfo, stats *info.Con
 	return err
 }
 
+func (driver *stdoutStorage) AddEvent(event *info.Event) error {
+	_, err := fmt.Printf("event=%s cName=%s host=%s timestamp=%d\n", event.EventType, event.ContainerName, driver.Namespace, event.Timestamp.UnixNano())
+	return err
+}
+
 func (driver *stdoutStorage) Close() error {
 	return nil
 }
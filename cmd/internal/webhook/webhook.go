@@ -0,0 +1,264 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package webhook delivers container events to HTTP endpoints.
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/google/cadvisor/events"
+	info "github.com/google/cadvisor/info/v1"
+	"github.com/google/cadvisor/metrics/selfmetrics"
+
+	"k8s.io/klog/v2"
+)
+
+const (
+	// EventTypeHeader is the header holding the type of the delivered event.
+	EventTypeHeader = "X-Cadvisor-Event"
+	// SignatureHeader is the header holding the HMAC-SHA256 of the body,
+	// as "sha256=" followed by its hex encoding, when a secret is set.
+	SignatureHeader = "X-Cadvisor-Signature-256"
+
+	maxBackoff = time.Minute
+)
+
+// Source is where events are watched from, implemented by manager.Manager.
+type Source interface {
+	WatchForEvents(request *events.Request) (*events.EventChannel, error)
+	CloseEventChannel(watchID int)
+}
+
+// Config configures the delivery of events to webhooks.
+type Config struct {
+	// URLs the events are POSTed to.
+	URLs []string
+	// EventTypes are the types of the events delivered.
+	EventTypes []info.EventType
+	// Secret signs the body of the requests if not empty.
+	Secret []byte
+	// Timeout of each request.
+	Timeout time.Duration
+	// MaxRetries is how many times a failed delivery is retried.
+	MaxRetries int
+	// Backoff before the first retry, doubled on every retry up to a minute.
+	Backoff time.Duration
+	// QueueSize is the number of events buffered for each URL, newer
+	// events are dropped while it is full.
+	QueueSize int
+}
+
+// Dispatcher POSTs the events of a Source to webhooks as JSON.
+type Dispatcher struct {
+	config  Config
+	client  *http.Client
+	source  Source
+	sinks   []*sink
+	channel *events.EventChannel
+	stop    chan struct{}
+	wg      sync.WaitGroup
+}
+
+// sink delivers the events queued for one URL.
+type sink struct {
+	url   string
+	queue chan *info.Event
+}
+
+// NewDispatcher validates the configuration and returns a dispatcher of the
+// events of source. It does not watch events until Start is called.
+func NewDispatcher(config Config, source Source) (*Dispatcher, error) {
+	if len(config.URLs) == 0 {
+		return nil, errors.New("no webhook URL")
+	}
+	if len(config.EventTypes) == 0 {
+		return nil, errors.New("no event type to deliver to webhooks")
+	}
+	if config.Timeout <= 0 {
+		return nil, fmt.Errorf("webhook timeout must be positive, got %v", config.Timeout)
+	}
+	if config.MaxRetries < 0 {
+		return nil, fmt.Errorf("webhook retries must not be negative, got %d", config.MaxRetries)
+	}
+	if config.QueueSize <= 0 {
+		return nil, fmt.Errorf("webhook queue size must be positive, got %d", config.QueueSize)
+	}
+	d := &Dispatcher{
+		config: config,
+		client: &http.Client{Timeout: config.Timeout},
+		source: source,
+		stop:   make(chan struct{}),
+	}
+	for _, rawURL := range config.URLs {
+		u, err := url.Parse(rawURL)
+		if err != nil {
+			return nil, fmt.Errorf("invalid webhook URL %q: %v", rawURL, err)
+		}
+		if u.Scheme != "http" && u.Scheme != "https" {
+			return nil, fmt.Errorf("webhook URL %q must be http or https", rawURL)
+		}
+		d.sinks = append(d.sinks, &sink{
+			url:   rawURL,
+			queue: make(chan *info.Event, config.QueueSize),
+		})
+	}
+	return d, nil
+}
+
+// Start watches the events of the source and delivers them until Stop is
+// called.
+func (d *Dispatcher) Start() error {
+	request := events.NewRequest()
+	request.ContainerName = "/"
+	request.IncludeSubcontainers = true
+	for _, eventType := range d.config.EventTypes {
+		request.EventType[eventType] = true
+	}
+	channel, err := d.source.WatchForEvents(request)
+	if err != nil {
+		return err
+	}
+	d.channel = channel
+
+	for _, s := range d.sinks {
+		d.wg.Add(1)
+		go func(s *sink) {
+			defer d.wg.Done()
+			d.deliverQueue(s)
+		}(s)
+	}
+	d.wg.Add(1)
+	go func() {
+		defer d.wg.Done()
+		// The event manager blocks while the channel is full, so events
+		// are only queued here and dropped if a webhook falls behind.
+		for event := range channel.GetChannel() {
+			for _, s := range d.sinks {
+				select {
+				case s.queue <- event:
+				default:
+					selfmetrics.WebhookDeliveries.WithLabelValues("dropped").Inc()
+					klog.Warningf("Dropping %s event of %q for webhook %s, too many pending events", event.EventType, event.ContainerName, s.url)
+				}
+			}
+		}
+	}()
+	return nil
+}
+
+// Stop stops watching events and waits for the pending deliveries to be
+// abandoned.
+func (d *Dispatcher) Stop() {
+	if d.channel != nil {
+		d.source.CloseEventChannel(d.channel.GetWatchId())
+	}
+	close(d.stop)
+	d.wg.Wait()
+}
+
+func (d *Dispatcher) deliverQueue(s *sink) {
+	for {
+		select {
+		case <-d.stop:
+			return
+		case event := <-s.queue:
+			if err := d.deliver(s.url, event); err != nil {
+				selfmetrics.WebhookDeliveries.WithLabelValues("failed").Inc()
+				klog.Warningf("Failed to deliver %s event of %q to webhook %s: %v", event.EventType, event.ContainerName, s.url, err)
+				continue
+			}
+			selfmetrics.WebhookDeliveries.WithLabelValues("delivered").Inc()
+		}
+	}
+}
+
+// deliver POSTs the event to the URL, retrying on connection errors and on
+// server errors.
+func (d *Dispatcher) deliver(url string, event *info.Event) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	backoff := d.config.Backoff
+	for attempt := 0; ; attempt++ {
+		retry, err := d.post(url, event, body)
+		if err == nil {
+			return nil
+		}
+		if !retry || attempt >= d.config.MaxRetries {
+			return err
+		}
+		klog.V(4).Infof("Retrying delivery to webhook %s in %v: %v", url, backoff, err)
+		select {
+		case <-d.stop:
+			return err
+		case <-time.After(backoff):
+		}
+		backoff = min(2*backoff, maxBackoff)
+	}
+}
+
+// post sends one request, and returns whether it can be retried if it failed.
+func (d *Dispatcher) post(url string, event *info.Event, body []byte) (bool, error) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		select {
+		case <-d.stop:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "cAdvisor")
+	req.Header.Set(EventTypeHeader, string(event.EventType))
+	if len(d.config.Secret) > 0 {
+		req.Header.Set(SignatureHeader, Sign(d.config.Secret, body))
+	}
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return true, err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64*1024))
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return false, nil
+	}
+	err = fmt.Errorf("webhook responded %s", resp.Status)
+	return resp.StatusCode >= 500 || resp.StatusCode == http.StatusTooManyRequests, err
+}
+
+// Sign returns the value of the SignatureHeader of a request with body.
+func Sign(secret, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
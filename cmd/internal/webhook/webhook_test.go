@@ -0,0 +1,170 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/cadvisor/events"
+	info "github.com/google/cadvisor/info/v1"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type eventSource struct {
+	events.EventManager
+}
+
+func (s eventSource) WatchForEvents(request *events.Request) (*events.EventChannel, error) {
+	return s.WatchEvents(request)
+}
+
+func (s eventSource) CloseEventChannel(watchID int) {
+	s.StopWatch(watchID)
+}
+
+type receivedRequest struct {
+	eventType string
+	signature string
+	event     info.Event
+	body      []byte
+}
+
+// webhookServer records the requests it receives, failing the first
+// failures of them with the given status.
+func webhookServer(t *testing.T, failures int, status int) (*httptest.Server, chan receivedRequest) {
+	received := make(chan receivedRequest, 10)
+	var lock sync.Mutex
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		fail := failures > 0
+		failures--
+		lock.Unlock()
+		if fail {
+			w.WriteHeader(status)
+			return
+		}
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		req := receivedRequest{
+			eventType: r.Header.Get(EventTypeHeader),
+			signature: r.Header.Get(SignatureHeader),
+			body:      body,
+		}
+		assert.NoError(t, json.Unmarshal(body, &req.event))
+		received <- req
+	}))
+	t.Cleanup(server.Close)
+	return server, received
+}
+
+func startDispatcher(t *testing.T, config Config) events.EventManager {
+	eventManager := events.NewEventManager(events.DefaultStoragePolicy())
+	dispatcher, err := NewDispatcher(config, eventSource{eventManager})
+	require.NoError(t, err)
+	require.NoError(t, dispatcher.Start())
+	t.Cleanup(dispatcher.Stop)
+	return eventManager
+}
+
+func testConfig(urls ...string) Config {
+	return Config{
+		URLs:       urls,
+		EventTypes: []info.EventType{info.EventOomKill, info.EventContainerDeletion},
+		Timeout:    time.Second,
+		MaxRetries: 2,
+		Backoff:    time.Millisecond,
+		QueueSize:  10,
+	}
+}
+
+func receive(t *testing.T, received chan receivedRequest) receivedRequest {
+	select {
+	case req := <-received:
+		return req
+	case <-time.After(5 * time.Second):
+		t.Fatal("webhook not called")
+		return receivedRequest{}
+	}
+}
+
+func TestDispatcherDeliversSelectedEvents(t *testing.T) {
+	server, received := webhookServer(t, 0, 0)
+	other, receivedOther := webhookServer(t, 0, 0)
+	config := testConfig(server.URL, other.URL)
+	config.Secret = []byte("secret")
+	eventManager := startDispatcher(t, config)
+
+	require.NoError(t, eventManager.AddEvent(&info.Event{ContainerName: "/a", Timestamp: time.Now(), EventType: info.EventContainerCreation}))
+	require.NoError(t, eventManager.AddEvent(&info.Event{
+		ContainerName: "/a/b",
+		Timestamp:     time.Now(),
+		EventType:     info.EventOomKill,
+		EventData:     info.EventData{OomKill: &info.OomKillEventData{Pid: 42, ProcessName: "stress"}},
+	}))
+
+	for _, ch := range []chan receivedRequest{received, receivedOther} {
+		req := receive(t, ch)
+		assert.Equal(t, "oomKill", req.eventType)
+		assert.Equal(t, "/a/b", req.event.ContainerName)
+		assert.Equal(t, 42, req.event.EventData.OomKill.Pid)
+		assert.Equal(t, Sign([]byte("secret"), req.body), req.signature)
+	}
+	select {
+	case req := <-received:
+		t.Errorf("unexpected delivery of %s event", req.eventType)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestDispatcherRetriesServerErrors(t *testing.T) {
+	server, received := webhookServer(t, 2, http.StatusServiceUnavailable)
+	eventManager := startDispatcher(t, testConfig(server.URL))
+
+	require.NoError(t, eventManager.AddEvent(&info.Event{ContainerName: "/a", Timestamp: time.Now(), EventType: info.EventContainerDeletion}))
+	req := receive(t, received)
+	assert.Equal(t, "containerDeletion", req.eventType)
+	assert.Empty(t, req.signature)
+}
+
+func TestDispatcherDoesNotRetryClientErrors(t *testing.T) {
+	server, received := webhookServer(t, 1, http.StatusBadRequest)
+	eventManager := startDispatcher(t, testConfig(server.URL))
+
+	require.NoError(t, eventManager.AddEvent(&info.Event{ContainerName: "/a", Timestamp: time.Now(), EventType: info.EventContainerDeletion}))
+	require.NoError(t, eventManager.AddEvent(&info.Event{ContainerName: "/b", Timestamp: time.Now(), EventType: info.EventContainerDeletion}))
+	// The first event was rejected, only the second one is delivered.
+	req := receive(t, received)
+	assert.Equal(t, "/b", req.event.ContainerName)
+}
+
+func TestNewDispatcherValidatesConfig(t *testing.T) {
+	source := eventSource{events.NewEventManager(events.DefaultStoragePolicy())}
+	_, err := NewDispatcher(testConfig("ftp://example.com"), source)
+	assert.Error(t, err)
+	_, err = NewDispatcher(testConfig(), source)
+	assert.Error(t, err)
+	config := testConfig("http://example.com")
+	config.EventTypes = nil
+	_, err = NewDispatcher(config, source)
+	assert.Error(t, err)
+}
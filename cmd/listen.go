@@ -0,0 +1,184 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"crypto/tls"
+	"flag"
+	"fmt"
+	"net"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/coreos/go-systemd/v22/activation"
+	"k8s.io/klog/v2"
+
+	cadvisorhttp "github.com/google/cadvisor/cmd/internal/http"
+	"github.com/google/cadvisor/cmd/internal/pages"
+	"github.com/google/cadvisor/cmd/internal/pages/static"
+)
+
+var argIP = flag.String("listen_ip", "", "Comma-separated IPs to listen on, e.g. 127.0.0.1,::1. Defaults to all IPv4 and IPv6 addresses")
+var argPort = flag.Int("port", 8080, "port to listen")
+var uiPort = flag.Int("ui_port", 0, "Port to serve the web UI on, with the API it fetches its data from, on the listen_ip addresses. port then serves everything but the web UI. 0 serves everything on port")
+
+// uiSocketName is the name of the sockets passed by systemd socket
+// activation, set by FileDescriptorName=, that serve the web UI.
+const uiSocketName = "ui"
+
+// uiPatterns are the patterns of the handlers of the web UI.
+var uiPatterns = []string{
+	"/",
+	pages.ContainersPage,
+	pages.DockerPage,
+	pages.EventsPage,
+	pages.PodmanPage,
+	pages.PodsPage,
+	static.StaticResource,
+	cadvisorhttp.LoginPath,
+	cadvisorhttp.CallbackPath,
+	cadvisorhttp.LogoutPath,
+}
+
+// uiPortPatterns are the patterns of the handlers served on the UI port
+// besides those of the web UI, which it needs.
+var uiPortPatterns = []string{"/api/", "/healthz"}
+
+// endpoint is a listener and the handler of its requests.
+type endpoint struct {
+	listener net.Listener
+	handler  http.Handler
+}
+
+// listenAddrs returns the addresses to listen on port on, one for each IP of
+// the comma-separated list, or one for all addresses if it is empty. IPv6
+// addresses may be enclosed in brackets.
+func listenAddrs(ips string, port int) []string {
+	var addrs []string
+	for _, ip := range strings.Split(ips, ",") {
+		if ip = strings.TrimSpace(ip); ip != "" {
+			addrs = append(addrs, net.JoinHostPort(strings.Trim(ip, "[]"), strconv.Itoa(port)))
+		}
+	}
+	if len(addrs) == 0 {
+		addrs = []string{net.JoinHostPort("", strconv.Itoa(port))}
+	}
+	return addrs
+}
+
+// listen returns the endpoints of the HTTP server serving mux: the sockets
+// passed by systemd socket activation if there are any, or else the listen_ip
+// addresses on port and, if set, ui_port. The sockets named ui, or the
+// addresses on ui_port, serve the web UI, and others all but the web UI, if
+// ui_port is set.
+func listen(mux *http.ServeMux) ([]endpoint, error) {
+	if *uiPort != 0 && *uiPort == *argPort {
+		return nil, fmt.Errorf("ui_port must differ from port %d", *argPort)
+	}
+	handler, uiHandler := rootHandler(mux), rootHandler(mux)
+	if *uiPort != 0 {
+		handler, uiHandler = splitUI(mux)
+	}
+
+	activated, err := activation.ListenersWithNames()
+	if err != nil {
+		return nil, fmt.Errorf("failed to use the sockets passed by systemd: %w", err)
+	}
+	var endpoints []endpoint
+	for name, listeners := range activated {
+		h := handler
+		if name == uiSocketName {
+			h = uiHandler
+		}
+		for _, l := range listeners {
+			klog.V(1).Infof("Serving on the socket %s passed by systemd", l.Addr())
+			endpoints = append(endpoints, endpoint{listener: l, handler: h})
+		}
+	}
+	if len(endpoints) > 0 {
+		return endpoints, nil
+	}
+
+	ports := []int{*argPort}
+	handlers := []http.Handler{handler}
+	if *uiPort != 0 {
+		ports = append(ports, *uiPort)
+		handlers = append(handlers, uiHandler)
+	}
+	for i, port := range ports {
+		for _, addr := range listenAddrs(*argIP, port) {
+			l, err := net.Listen("tcp", addr)
+			if err != nil {
+				for _, e := range endpoints {
+					e.listener.Close()
+				}
+				return nil, fmt.Errorf("failed to listen on %s: %w", addr, err)
+			}
+			klog.V(1).Infof("Listening on %s", l.Addr())
+			endpoints = append(endpoints, endpoint{listener: l, handler: handlers[i]})
+		}
+	}
+	return endpoints, nil
+}
+
+// splitUI returns the handler of port, serving all but the web UI, and the
+// handler of ui_port, serving the web UI and the API it needs.
+func splitUI(mux *http.ServeMux) (handler, uiHandler http.Handler) {
+	handler = rootHandler(filterPatterns(mux, func(pattern string) bool {
+		return !slices.Contains(uiPatterns, pattern)
+	}))
+	uiHandler = rootHandler(filterPatterns(mux, func(pattern string) bool {
+		return slices.Contains(uiPatterns, pattern) || slices.Contains(uiPortPatterns, pattern)
+	}))
+	return handler, uiHandler
+}
+
+// rootHandler returns the handler of the requests below url_base_prefix.
+func rootHandler(handler http.Handler) http.Handler {
+	rootMux := http.NewServeMux()
+	rootMux.Handle(*urlBasePrefix+"/", http.StripPrefix(*urlBasePrefix, traceHandler(handler)))
+	return rootMux
+}
+
+// filterPatterns returns a handler serving the requests to the handlers of
+// mux whose pattern is accepted, and not found for others.
+func filterPatterns(mux *http.ServeMux, accept func(pattern string) bool) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if h, pattern := mux.Handler(r); pattern == "" || accept(pattern) {
+			h.ServeHTTP(w, r)
+			return
+		}
+		http.NotFound(w, r)
+	})
+}
+
+// serve serves HTTP requests on all the endpoints, over TLS if tlsConfig is
+// not nil, until one of them fails.
+func serve(endpoints []endpoint, tlsConfig *tls.Config) error {
+	errs := make(chan error, len(endpoints))
+	for _, e := range endpoints {
+		go func() {
+			server := &http.Server{Handler: e.handler, TLSConfig: tlsConfig}
+			if tlsConfig != nil {
+				errs <- server.ServeTLS(e.listener, "", "")
+				return
+			}
+			errs <- server.Serve(e.listener)
+		}()
+	}
+	return <-errs
+}
@@ -0,0 +1,124 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/google/cadvisor/cmd/internal/pages"
+)
+
+func TestListenAddrs(t *testing.T) {
+	assert.Equal(t, []string{":8080"}, listenAddrs("", 8080))
+	assert.Equal(t, []string{"127.0.0.1:8080", "[::1]:8080", "[::]:8080", "[fd00::1]:8080"}, listenAddrs("127.0.0.1, ::1,[::],[fd00::1],", 8080))
+}
+
+// setListenFlags sets the listen flags for the test.
+func setListenFlags(t *testing.T, ip string, port, ui int) {
+	oldIP, oldPort, oldUIPort := *argIP, *argPort, *uiPort
+	*argIP, *argPort, *uiPort = ip, port, ui
+	t.Cleanup(func() { *argIP, *argPort, *uiPort = oldIP, oldPort, oldUIPort })
+	t.Setenv("LISTEN_PID", "")
+	t.Setenv("LISTEN_FDS", "")
+}
+
+func newListenTestMux() *http.ServeMux {
+	mux := http.NewServeMux()
+	for _, pattern := range []string{"/", pages.ContainersPage, "/api/", "/healthz", "/metrics"} {
+		mux.HandleFunc(pattern, func(w http.ResponseWriter, r *http.Request) {
+			_, _ = io.WriteString(w, pattern)
+		})
+	}
+	return mux
+}
+
+// get returns the status code and body of a GET request.
+func get(t *testing.T, url string) (int, string) {
+	resp, err := http.Get(url)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	return resp.StatusCode, string(body)
+}
+
+func serveTestEndpoints(t *testing.T, endpoints []endpoint) {
+	go func() { _ = serve(endpoints, nil) }()
+	t.Cleanup(func() {
+		for _, e := range endpoints {
+			e.listener.Close()
+		}
+	})
+}
+
+func TestListen(t *testing.T) {
+	l, err := net.Listen("tcp", "[::1]:0")
+	if err != nil {
+		t.Skipf("IPv6 is not available: %v", err)
+	}
+	l.Close()
+	setListenFlags(t, "127.0.0.1,::1", 0, 0)
+	endpoints, err := listen(newListenTestMux())
+	require.NoError(t, err)
+	require.Len(t, endpoints, 2)
+	serveTestEndpoints(t, endpoints)
+
+	for _, e := range endpoints {
+		code, body := get(t, "http://"+e.listener.Addr().String()+"/metrics")
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, "/metrics", body)
+	}
+}
+
+func TestListenUIPort(t *testing.T) {
+	setListenFlags(t, "127.0.0.1", 8080, 8080)
+	_, err := listen(newListenTestMux())
+	assert.EqualError(t, err, "ui_port must differ from port 8080")
+
+	// Find two free ports.
+	var ports []int
+	for range 2 {
+		l, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+		ports = append(ports, l.Addr().(*net.TCPAddr).Port)
+		l.Close()
+	}
+	setListenFlags(t, "127.0.0.1", ports[0], ports[1])
+	endpoints, err := listen(newListenTestMux())
+	require.NoError(t, err)
+	require.Len(t, endpoints, 2)
+	serveTestEndpoints(t, endpoints)
+
+	for path, want := range map[string][2]int{
+		"/":                  {http.StatusNotFound, http.StatusOK},
+		pages.ContainersPage: {http.StatusNotFound, http.StatusOK},
+		"/unknown":           {http.StatusNotFound, http.StatusOK},
+		"/api/v2.0/machine":  {http.StatusOK, http.StatusOK},
+		"/healthz":           {http.StatusOK, http.StatusOK},
+		"/metrics":           {http.StatusOK, http.StatusNotFound},
+	} {
+		for i, port := range ports {
+			code, _ := get(t, fmt.Sprintf("http://127.0.0.1:%d%s", port, path))
+			assert.Equal(t, want[i], code, "%s on port %d", path, port)
+		}
+	}
+}
@@ -0,0 +1,43 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/google/cadvisor/cmd/internal/logging"
+)
+
+var (
+	logFormat       = flag.String("log_format", logging.FormatKlog, "Format of the logs: klog, or text and json to write logfmt and JSON lines to stderr through log/slog. The klog flags writing to files, such as -log_dir, only apply to klog")
+	logModuleLevels = flag.String("log_module_levels", "", "Comma-separated package=N verbosities of packages that differ from -v, e.g. manager=4,container/docker=5. The paths of cAdvisor's packages are relative to github.com/google/cadvisor. Requires -log_format text or json")
+)
+
+// setUpLogging writes the logs in the format and at the levels set by the
+// flags.
+func setUpLogging() (*logging.Logger, error) {
+	v, err := strconv.Atoi(flag.Lookup("v").Value.String())
+	if err != nil {
+		return nil, fmt.Errorf("invalid -v: %v", err)
+	}
+	modules, err := logging.ParseModules(*logModuleLevels)
+	if err != nil {
+		return nil, fmt.Errorf("invalid -log_module_levels: %v", err)
+	}
+	return logging.Setup(*logFormat, os.Stderr, logging.Levels{V: v, Modules: modules})
+}
@@ -0,0 +1,267 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"maps"
+	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/google/cadvisor/cache/memory"
+	"github.com/google/cadvisor/container"
+	"github.com/google/cadvisor/manager"
+	"github.com/google/cadvisor/storage"
+
+	"k8s.io/klog/v2"
+)
+
+var reloadConfigFile = flag.String("reload_config_file", "", "File of flag=value lines, re-read on SIGHUP and on POST to /admin/reload, that change the housekeeping interval, enabled metrics, storage driver and container filter flags without a restart. The perf events config is re-read as well. Lines override the command line, removed lines restore it")
+
+var (
+	housekeepingFlags = []string{"housekeeping_interval", "max_housekeeping_interval"}
+	metricsFlags      = []string{"enable_metrics", "disable_metrics"}
+	filterFlags       = []string{"container_include_regex", "container_exclude_regex", "container_include_images", "container_exclude_images", "container_include_labels", "container_exclude_labels", "container_max_depth", "container_subtree_max_depth"}
+)
+
+// isReloadable returns whether the named flag may be changed by a reload.
+func isReloadable(name string) bool {
+	for _, flags := range [][]string{housekeepingFlags, metricsFlags, filterFlags} {
+		for _, f := range flags {
+			if f == name {
+				return true
+			}
+		}
+	}
+	return isStorageDriverFlag(name)
+}
+
+func isStorageDriverFlag(name string) bool {
+	return name == "storage_driver" || strings.HasPrefix(name, "storage_driver_") || strings.HasPrefix(name, "bq_")
+}
+
+// reloader applies the reloadable flags of a config file at runtime, and
+// re-reads the perf events configuration. Only the
+// settings whose flags changed since the previous reload are applied, so a
+// reload does not undo metrics toggled through /admin/metrics unless the
+// file changes them.
+type reloader struct {
+	lock            sync.Mutex
+	path            string
+	manager         manager.Manager
+	memoryStorage   *memory.InMemoryCache
+	includedMetrics container.MetricSet
+	// Values of the reloadable flags given on the command line.
+	commandLine map[string]string
+	// Values of the reloadable flags currently in effect.
+	applied map[string]string
+}
+
+func newReloader(path string, m manager.Manager, memoryStorage *memory.InMemoryCache, includedMetrics container.MetricSet) *reloader {
+	commandLine := map[string]string{}
+	flag.VisitAll(func(f *flag.Flag) {
+		if isReloadable(f.Name) {
+			commandLine[f.Name] = f.Value.String()
+		}
+	})
+	return &reloader{
+		path:            path,
+		manager:         m,
+		memoryStorage:   memoryStorage,
+		includedMetrics: includedMetrics,
+		commandLine:     commandLine,
+		applied:         maps.Clone(commandLine),
+	}
+}
+
+// readReloadConfig reads the flag=value lines of path. Empty lines and lines
+// starting with # are ignored, and flag names may be prefixed with dashes.
+func readReloadConfig(path string) (map[string]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	values := map[string]string{}
+	scanner := bufio.NewScanner(f)
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		name, value, ok := strings.Cut(strings.TrimLeft(line, "-"), "=")
+		if !ok {
+			return nil, fmt.Errorf("%s:%d: expected flag=value, got %q", path, n, line)
+		}
+		name = strings.TrimSpace(name)
+		if flag.Lookup(name) == nil {
+			return nil, fmt.Errorf("%s:%d: unknown flag %q", path, n, name)
+		}
+		if !isReloadable(name) {
+			return nil, fmt.Errorf("%s:%d: flag %q cannot be reloaded", path, n, name)
+		}
+		values[name] = strings.TrimSpace(value)
+	}
+	return values, scanner.Err()
+}
+
+// reload re-reads the config file and applies the settings that changed. If
+// the file is invalid, nothing is applied. Without a config file, only the
+// perf events are reloaded.
+func (r *reloader) reload() error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	overrides := map[string]string{}
+	if r.path != "" {
+		var err error
+		overrides, err = readReloadConfig(r.path)
+		if err != nil {
+			return err
+		}
+	}
+	values := maps.Clone(r.commandLine)
+	maps.Copy(values, overrides)
+	changed := func(names []string) bool {
+		for _, name := range names {
+			if values[name] != r.applied[name] {
+				return true
+			}
+		}
+		return false
+	}
+
+	// Validate everything before applying anything.
+	interval, err := time.ParseDuration(values["housekeeping_interval"])
+	if err != nil {
+		return fmt.Errorf("invalid housekeeping_interval: %v", err)
+	}
+	maxInterval, err := time.ParseDuration(values["max_housekeeping_interval"])
+	if err != nil {
+		return fmt.Errorf("invalid max_housekeeping_interval: %v", err)
+	}
+	var enabled, disabled container.MetricSet
+	if err := enabled.Set(values["enable_metrics"]); err != nil {
+		return fmt.Errorf("invalid enable_metrics: %v", err)
+	}
+	if err := disabled.Set(values["disable_metrics"]); err != nil {
+		return fmt.Errorf("invalid disable_metrics: %v", err)
+	}
+	filter, err := container.NewFilter(values["container_include_regex"], values["container_exclude_regex"], values["container_include_images"], values["container_exclude_images"], values["container_include_labels"], values["container_exclude_labels"])
+	if err != nil {
+		return err
+	}
+	maxDepth, err := strconv.Atoi(values["container_max_depth"])
+	if err != nil {
+		return fmt.Errorf("invalid container_max_depth: %v", err)
+	}
+	if err := filter.SetDepthLimits(maxDepth, values["container_subtree_max_depth"]); err != nil {
+		return err
+	}
+	var storageNames []string
+	for name := range values {
+		if isStorageDriverFlag(name) {
+			storageNames = append(storageNames, name)
+		}
+	}
+	storageChanged := changed(storageNames)
+
+	// The storage drivers read their flags when they are created, so those
+	// are set, and restored if the drivers cannot be created.
+	restoreStorageFlags := func() {
+		for _, name := range storageNames {
+			_ = flag.Set(name, r.applied[name])
+		}
+	}
+	var backendStorages []storage.StorageDriver
+	if storageChanged {
+		for _, name := range storageNames {
+			if err := flag.Set(name, values[name]); err != nil {
+				restoreStorageFlags()
+				return fmt.Errorf("invalid %s: %v", name, err)
+			}
+		}
+		backendStorages, err = newBackendStorages(values["storage_driver"])
+		if err != nil {
+			restoreStorageFlags()
+			return fmt.Errorf("failed to create storage drivers: %v", err)
+		}
+	}
+
+	if changed(housekeepingFlags) {
+		if err := r.manager.SetHousekeepingIntervals(interval, maxInterval); err != nil {
+			if storageChanged {
+				closeBackendStorages(backendStorages)
+				restoreStorageFlags()
+			}
+			return err
+		}
+		r.memoryStorage.SetMaxStats(maxStoredStats(interval))
+	}
+	if storageChanged {
+		closeBackendStorages(r.memoryStorage.SetBackend(backendStorages))
+		klog.Infof("Storage driver changed to %q", values["storage_driver"])
+	}
+	if changed(metricsFlags) {
+		target := container.AllMetrics.Difference(disabled)
+		if enabled.Len() > 0 {
+			target = enabled.Copy()
+		}
+		for kind := range container.AllMetrics.Copy() {
+			if target.Has(kind) {
+				r.includedMetrics.Enable(kind)
+			} else {
+				r.includedMetrics.Disable(kind)
+			}
+		}
+		klog.Infof("Enabled metrics changed to: %s", r.includedMetrics.String())
+	}
+	filterChanged := changed(filterFlags)
+	r.applied = values
+	if filterChanged {
+		container.SetFilter(filter)
+		if err := r.manager.ApplyContainerFilter(); err != nil {
+			return fmt.Errorf("failed to apply the container filter: %v", err)
+		}
+	}
+	// The perf events in effect are kept if their file is invalid.
+	if err := r.manager.ReloadPerfEvents(); err != nil {
+		return fmt.Errorf("failed to reload the perf events: %v", err)
+	}
+	return nil
+}
+
+// installReloadHandler reloads the configuration on SIGHUP.
+func installReloadHandler(r *reloader) {
+	c := make(chan os.Signal, 1)
+	signal.Notify(c, syscall.SIGHUP)
+	go func() {
+		for range c {
+			if err := r.reload(); err != nil {
+				klog.Errorf("Failed to reload the configuration: %v", err)
+				continue
+			}
+			klog.Infof("Reloaded the configuration")
+		}
+	}()
+}
@@ -0,0 +1,134 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/google/cadvisor/cache/memory"
+	"github.com/google/cadvisor/container"
+	info "github.com/google/cadvisor/info/v1"
+	"github.com/google/cadvisor/manager"
+)
+
+// fakeReloadManager records the changes a reload makes to the manager.
+type fakeReloadManager struct {
+	manager.Manager
+	interval, maxInterval time.Duration
+	filterApplied         int
+	perfReloaded          int
+}
+
+func (m *fakeReloadManager) SetHousekeepingIntervals(interval, maxInterval time.Duration) error {
+	m.interval, m.maxInterval = interval, maxInterval
+	return nil
+}
+
+func (m *fakeReloadManager) ApplyContainerFilter() error {
+	m.filterApplied++
+	return nil
+}
+
+func (m *fakeReloadManager) ReloadPerfEvents() error {
+	m.perfReloaded++
+	return nil
+}
+
+func writeReloadConfig(t *testing.T, path, content string) {
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+}
+
+func TestReadReloadConfig(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "reload.conf")
+	writeReloadConfig(t, path, "# Comment\n\n--housekeeping_interval=5s\n-enable_metrics = cpu,memory\ncontainer_exclude_regex=^/system.slice/.*\n")
+	values, err := readReloadConfig(path)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"housekeeping_interval":   "5s",
+		"enable_metrics":          "cpu,memory",
+		"container_exclude_regex": "^/system.slice/.*",
+	}, values)
+
+	for content, msg := range map[string]string{
+		"housekeeping_interval\n": "expected flag=value",
+		"no_such_flag=1\n":        "unknown flag",
+		"port=9090\n":             "cannot be reloaded",
+	} {
+		writeReloadConfig(t, path, content)
+		_, err := readReloadConfig(path)
+		assert.ErrorContains(t, err, msg)
+	}
+}
+
+func TestReload(t *testing.T) {
+	defer container.SetFilter(nil)
+	path := filepath.Join(t.TempDir(), "reload.conf")
+	m := &fakeReloadManager{}
+	includedMetrics := container.MetricSet{container.CpuUsageMetrics: struct{}{}}
+	r := newReloader(path, m, memory.New(time.Minute, nil), includedMetrics)
+
+	writeReloadConfig(t, path, "housekeeping_interval=5s\nmax_housekeeping_interval=2m\nenable_metrics=cpu,memory\n")
+	require.NoError(t, r.reload())
+	assert.Equal(t, 5*time.Second, m.interval)
+	assert.Equal(t, 2*time.Minute, m.maxInterval)
+	assert.Equal(t, "cpu,memory", includedMetrics.String())
+	assert.Zero(t, m.filterApplied)
+	assert.Equal(t, 1, m.perfReloaded)
+
+	// Settings that did not change are not applied again.
+	includedMetrics.Enable(container.DiskUsageMetrics)
+	writeReloadConfig(t, path, "housekeeping_interval=5s\nmax_housekeeping_interval=2m\nenable_metrics=cpu,memory\ncontainer_exclude_regex=^/system.slice/\ncontainer_max_depth=2\n")
+	require.NoError(t, r.reload())
+	assert.True(t, includedMetrics.Has(container.DiskUsageMetrics))
+	assert.Equal(t, 1, m.filterApplied)
+	assert.False(t, container.Accepts("/system.slice/docker.service", info.ContainerSpec{}))
+	assert.False(t, container.Accepts("/user.slice/user-1000.slice/session-1.scope", info.ContainerSpec{}))
+
+	// An invalid file changes nothing.
+	writeReloadConfig(t, path, "housekeeping_interval=1s\nenable_metrics=cpu,bogus\n")
+	assert.Error(t, r.reload())
+	assert.Equal(t, 5*time.Second, m.interval)
+	writeReloadConfig(t, path, "storage_driver=bogus\nhousekeeping_interval=1s\n")
+	assert.Error(t, r.reload())
+	assert.Equal(t, 5*time.Second, m.interval)
+	assert.Equal(t, "", *storageDriver)
+
+	// Removed lines restore the command line values.
+	writeReloadConfig(t, path, "")
+	require.NoError(t, r.reload())
+	assert.Equal(t, *manager.HousekeepingInterval, m.interval)
+	assert.Equal(t, container.AllMetrics.Difference(ignoreMetrics).String(), includedMetrics.String())
+	assert.Equal(t, 2, m.filterApplied)
+	assert.True(t, container.Accepts("/system.slice/docker.service", info.ContainerSpec{}))
+}
+
+func TestReloadWithoutConfigFile(t *testing.T) {
+	m := &fakeReloadManager{}
+	includedMetrics := container.MetricSet{container.CpuUsageMetrics: struct{}{}}
+	r := newReloader("", m, memory.New(time.Minute, nil), includedMetrics)
+
+	// Only the perf events are reloaded.
+	require.NoError(t, r.reload())
+	assert.Equal(t, 1, m.perfReloaded)
+	assert.Zero(t, m.interval)
+	assert.Zero(t, m.filterApplied)
+	assert.Equal(t, "cpu", includedMetrics.String())
+}
@@ -28,6 +28,9 @@ import (
 	_ "github.com/google/cadvisor/cmd/internal/storage/redis"
 	_ "github.com/google/cadvisor/cmd/internal/storage/statsd"
 	_ "github.com/google/cadvisor/cmd/internal/storage/stdout"
+	"github.com/google/cadvisor/events"
+	info "github.com/google/cadvisor/info/v1"
+	"github.com/google/cadvisor/manager"
 	"github.com/google/cadvisor/storage"
 
 	"k8s.io/klog/v2"
@@ -36,22 +39,143 @@ import (
 var (
 	storageDriver   = flag.String("storage_driver", "", fmt.Sprintf("Storage `driver` to use. Data is always cached shortly in memory, this controls where data is pushed besides the local cache. Empty means none, multiple separated by commas. Options are: <empty>, %s", strings.Join(storage.ListDrivers(), ", ")))
 	storageDuration = flag.Duration("storage_duration", 2*time.Minute, "How long to keep data stored (Default: 2min).")
+	storageTiers    = flag.String("storage_tiers", "", "Comma-separated downsampled retention tiers of the in-memory storage kept beyond storage_duration, as resolution:retention from the finest to the coarsest resolution, e.g. \"10s:10m,1m:2h,10m:24h\". Each interval keeps its latest stats, with the memory and process gauges at their peak. Empty means none")
+
+	storageCheckpointFile     = flag.String("storage_checkpoint_file", "", "File to save the in-memory storage to on shutdown and periodically, and to restore it from at startup, so that a restart does not lose the recent stats. Empty disables checkpoints")
+	storageCheckpointInterval = flag.Duration("storage_checkpoint_interval", time.Minute, "How often to save the in-memory storage to storage_checkpoint_file besides on shutdown. 0 only saves it on shutdown")
+
+	storageEventTypes = flag.String("storage_event_types", "", "Comma-separated types of the container events to also push to the storage drivers that store events (elasticsearch, kafka, stdout), e.g. \"oom,oomKill,containerCreation,containerDeletion\". Empty pushes none")
 )
 
+// eventExportQueueSize is the number of events buffered for the storage
+// drivers, newer events are dropped while it is full.
+const eventExportQueueSize = 1000
+
 // NewMemoryStorage creates a memory storage with an optional backend storage option.
 func NewMemoryStorage() (*memory.InMemoryCache, error) {
+	backendStorages, err := newBackendStorages(*storageDriver)
+	if err != nil {
+		return nil, err
+	}
+	tiers, err := memory.ParseTiers(*storageTiers)
+	if err != nil {
+		return nil, err
+	}
+	if len(tiers) > 0 && tiers[0].Resolution > *storageDuration {
+		return nil, fmt.Errorf("resolution of the finest storage tier %v exceeds the storage duration %v", tiers[0].Resolution, *storageDuration)
+	}
+	klog.V(1).Infof("Caching stats in memory for %v", *storageDuration)
+	for _, tier := range tiers {
+		klog.V(1).Infof("Caching stats in memory at %v resolution for %v", tier.Resolution, tier.Retention)
+	}
+	memoryStorage := memory.NewTiered(*storageDuration, maxStoredStats(*manager.HousekeepingInterval), tiers, backendStorages)
+	if *storageCheckpointFile != "" {
+		restored, err := memoryStorage.ReadCheckpointFile(*storageCheckpointFile)
+		if err != nil {
+			// A stale or corrupt checkpoint must not keep cAdvisor from starting.
+			klog.Warningf("Not restoring the in-memory storage: %v", err)
+		} else {
+			klog.V(1).Infof("Restored the stats of %d containers from %s", restored, *storageCheckpointFile)
+		}
+	}
+	return memoryStorage, nil
+}
+
+// maxStoredStats returns how many recent stats of a container the in-memory
+// storage keeps: those of storage_duration at one housekeeping per interval.
+func maxStoredStats(interval time.Duration) int {
+	if interval <= 0 {
+		return -1
+	}
+	return int(*storageDuration/interval) + 1
+}
+
+// startCheckpointing periodically saves memoryStorage to the checkpoint file.
+func startCheckpointing(memoryStorage *memory.InMemoryCache) {
+	if *storageCheckpointFile == "" || *storageCheckpointInterval <= 0 {
+		return
+	}
+	go func() {
+		ticker := time.NewTicker(*storageCheckpointInterval)
+		defer ticker.Stop()
+		for range ticker.C {
+			writeCheckpoint(memoryStorage)
+		}
+	}()
+}
+
+// writeCheckpoint saves memoryStorage to the checkpoint file, if any.
+func writeCheckpoint(memoryStorage *memory.InMemoryCache) {
+	if *storageCheckpointFile == "" {
+		return
+	}
+	if err := memoryStorage.WriteCheckpointFile(*storageCheckpointFile); err != nil {
+		klog.Errorf("Failed to checkpoint the in-memory storage: %v", err)
+	}
+}
+
+// startEventExport pushes the events of the storage_event_types to the
+// storage drivers of memoryStorage.
+func startEventExport(containerManager manager.Manager, memoryStorage *memory.InMemoryCache) error {
+	eventTypes := splitList(*storageEventTypes)
+	if len(eventTypes) == 0 {
+		return nil
+	}
+	request := events.NewRequest()
+	request.ContainerName = "/"
+	request.IncludeSubcontainers = true
+	for _, eventType := range eventTypes {
+		request.EventType[info.EventType(eventType)] = true
+	}
+	eventChannel, err := containerManager.WatchForEvents(request)
+	if err != nil {
+		return err
+	}
+	queue := make(chan *info.Event, eventExportQueueSize)
+	go func() {
+		// The event manager blocks while the channel is full, so slow
+		// storage drivers must not be written to from this goroutine.
+		for event := range eventChannel.GetChannel() {
+			select {
+			case queue <- event:
+			default:
+				klog.Warningf("Dropping %s event of %q for the storage drivers, too many pending events", event.EventType, event.ContainerName)
+			}
+		}
+		close(queue)
+	}()
+	go func() {
+		for event := range queue {
+			memoryStorage.AddEvent(event)
+		}
+	}()
+	klog.V(1).Infof("Pushing %s events to the storage drivers", strings.Join(eventTypes, ", "))
+	return nil
+}
+
+// newBackendStorages creates the storage drivers of the comma-separated
+// drivers list.
+func newBackendStorages(drivers string) ([]storage.StorageDriver, error) {
 	backendStorages := []storage.StorageDriver{}
-	for _, driver := range strings.Split(*storageDriver, ",") {
+	for _, driver := range strings.Split(drivers, ",") {
 		if driver == "" {
 			continue
 		}
 		storage, err := storage.New(driver)
 		if err != nil {
+			closeBackendStorages(backendStorages)
 			return nil, err
 		}
 		backendStorages = append(backendStorages, storage)
 		klog.V(1).Infof("Using backend storage type %q", driver)
 	}
-	klog.V(1).Infof("Caching stats in memory for %v", *storageDuration)
-	return memory.New(*storageDuration, backendStorages), nil
+	return backendStorages, nil
+}
+
+func closeBackendStorages(backendStorages []storage.StorageDriver) {
+	for _, backend := range backendStorages {
+		if err := backend.Close(); err != nil {
+			klog.Warningf("Failed to close backend storage: %v", err)
+		}
+	}
 }
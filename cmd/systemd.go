@@ -0,0 +1,91 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"time"
+
+	"github.com/coreos/go-systemd/v22/daemon"
+	"k8s.io/klog/v2"
+
+	"github.com/google/cadvisor/manager"
+)
+
+// notifySystemd tells systemd that cAdvisor is ready and, if the service has
+// a watchdog, keeps notifying the watchdog for as long as the housekeeping of
+// the manager makes progress, so that systemd restarts cAdvisor if it wedges.
+// It does nothing unless cAdvisor runs as a Type=notify systemd service. The
+// watchdog is notified until stop is closed.
+func notifySystemd(m manager.Manager, stop <-chan struct{}) {
+	if _, err := daemon.SdNotify(false, daemon.SdNotifyReady); err != nil {
+		klog.Warningf("Failed to notify systemd that cAdvisor is ready: %v", err)
+		return
+	}
+	interval, err := daemon.SdWatchdogEnabled(false)
+	if err != nil {
+		klog.Warningf("Failed to read the systemd watchdog interval: %v", err)
+		return
+	}
+	if interval == 0 {
+		return
+	}
+	klog.V(1).Infof("Notifying the systemd watchdog every %v", interval/2)
+	go func() {
+		w := &systemdWatchdog{manager: m}
+		ticker := time.NewTicker(interval / 2)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				w.notify()
+			case <-stop:
+				return
+			}
+		}
+	}()
+}
+
+// notifySystemdStopping tells systemd that cAdvisor is shutting down.
+func notifySystemdStopping() {
+	if _, err := daemon.SdNotify(false, daemon.SdNotifyStopping); err != nil {
+		klog.Warningf("Failed to notify systemd that cAdvisor is stopping: %v", err)
+	}
+}
+
+// systemdWatchdog notifies the systemd watchdog while the housekeeping of the
+// manager makes progress.
+type systemdWatchdog struct {
+	manager manager.Manager
+	// Whether the housekeeping was stalled when last checked.
+	stalled bool
+}
+
+// notify notifies the watchdog unless the housekeeping stalled.
+func (w *systemdWatchdog) notify() {
+	if err := w.manager.CheckHousekeeping(); err != nil {
+		if !w.stalled {
+			klog.Errorf("Not notifying the systemd watchdog: %v", err)
+		}
+		w.stalled = true
+		return
+	}
+	if w.stalled {
+		klog.Infof("Housekeeping resumed, notifying the systemd watchdog again")
+	}
+	w.stalled = false
+	if _, err := daemon.SdNotify(false, daemon.SdNotifyWatchdog); err != nil {
+		klog.Warningf("Failed to notify the systemd watchdog: %v", err)
+	}
+}
@@ -0,0 +1,117 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"errors"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/google/cadvisor/manager"
+)
+
+// fakeHousekeepingManager reports the housekeeping as stalled while err is
+// set.
+type fakeHousekeepingManager struct {
+	manager.Manager
+	err error
+}
+
+func (m *fakeHousekeepingManager) CheckHousekeeping() error {
+	return m.err
+}
+
+// listenNotify listens on a notify socket for systemd notifications, and
+// points the NOTIFY_SOCKET environment variable to it.
+func listenNotify(t *testing.T) *net.UnixConn {
+	path := filepath.Join(t.TempDir(), "notify")
+	conn, err := net.ListenUnixgram("unixgram", &net.UnixAddr{Name: path, Net: "unixgram"})
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+	t.Setenv("NOTIFY_SOCKET", path)
+	return conn
+}
+
+// readNotification returns the next notification, or "" if there is none
+// within the timeout.
+func readNotification(t *testing.T, conn *net.UnixConn, timeout time.Duration) string {
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(timeout)))
+	buf := make([]byte, 256)
+	n, err := conn.Read(buf)
+	if errors.Is(err, os.ErrDeadlineExceeded) {
+		return ""
+	}
+	require.NoError(t, err)
+	return string(buf[:n])
+}
+
+func TestNotifySystemd(t *testing.T) {
+	conn := listenNotify(t)
+	t.Setenv("WATCHDOG_USEC", "40000")
+	t.Setenv("WATCHDOG_PID", strconv.Itoa(os.Getpid()))
+
+	stop := make(chan struct{})
+	defer close(stop)
+	notifySystemd(&fakeHousekeepingManager{}, stop)
+	assert.Equal(t, "READY=1", readNotification(t, conn, time.Second))
+	assert.Equal(t, "WATCHDOG=1", readNotification(t, conn, time.Second))
+	assert.Equal(t, "WATCHDOG=1", readNotification(t, conn, time.Second))
+
+	notifySystemdStopping()
+	// Skip the watchdog notifications sent meanwhile.
+	for {
+		n := readNotification(t, conn, time.Second)
+		if n != "WATCHDOG=1" {
+			assert.Equal(t, "STOPPING=1", n)
+			break
+		}
+	}
+}
+
+func TestNotifySystemdWithoutWatchdog(t *testing.T) {
+	conn := listenNotify(t)
+	t.Setenv("WATCHDOG_USEC", "")
+
+	notifySystemd(&fakeHousekeepingManager{}, nil)
+	assert.Equal(t, "READY=1", readNotification(t, conn, time.Second))
+	assert.Empty(t, readNotification(t, conn, 100*time.Millisecond))
+}
+
+func TestSystemdWatchdog(t *testing.T) {
+	conn := listenNotify(t)
+	m := &fakeHousekeepingManager{}
+	w := &systemdWatchdog{manager: m}
+
+	w.notify()
+	assert.Equal(t, "WATCHDOG=1", readNotification(t, conn, time.Second))
+
+	m.err = errors.New("global housekeeping has not completed for 5m0s")
+	w.notify()
+	w.notify()
+	assert.True(t, w.stalled)
+	assert.Empty(t, readNotification(t, conn, 100*time.Millisecond), "the watchdog is not notified while housekeeping is stalled")
+
+	m.err = nil
+	w.notify()
+	assert.False(t, w.stalled)
+	assert.Equal(t, "WATCHDOG=1", readNotification(t, conn, time.Second))
+}
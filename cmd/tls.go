@@ -0,0 +1,114 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"crypto/tls"
+	"flag"
+	"fmt"
+	"net"
+	"os"
+	"strings"
+	"time"
+
+	"golang.org/x/crypto/acme"
+	"golang.org/x/crypto/acme/autocert"
+	"k8s.io/klog/v2"
+
+	"github.com/google/cadvisor/cmd/internal/certs"
+)
+
+var (
+	tlsCert             = flag.String("tls_cert", "", "PEM file of the certificate, followed by its intermediates, to serve HTTPS with instead of HTTP. Reloaded when it or tls_key changes")
+	tlsKey              = flag.String("tls_key", "", "PEM file of the private key of tls_cert")
+	tlsReloadInterval   = flag.Duration("tls_reload_interval", time.Minute, "How often to check whether tls_cert and tls_key changed on disk")
+	tlsSelfSigned       = flag.Bool("tls_self_signed", false, "Serve HTTPS with a self-signed certificate for the hostname and listen_ip, generated at startup, whose fingerprint is logged")
+	tlsACMEDomains      = flag.String("tls_acme_domains", "", "Comma-separated domains to get a certificate for from an ACME CA, e.g. Let's Encrypt, to serve HTTPS with. The CA must reach cAdvisor on port 443 for the TLS-ALPN-01 challenge")
+	tlsACMECacheDir     = flag.String("tls_acme_cache_dir", "/var/cache/cadvisor/acme", "Directory to keep the ACME account and certificates in across restarts")
+	tlsACMEEmail        = flag.String("tls_acme_email", "", "Contact e-mail address of the ACME account, for the notices of the CA")
+	tlsACMEDirectoryURL = flag.String("tls_acme_directory_url", autocert.DefaultACMEDirectory, "Directory URL of the ACME CA")
+)
+
+// serverTLSConfig returns the TLS config of the HTTP server set by the flags,
+// nil to serve plain HTTP. A certificate read from files is reloaded when
+// they change, until stop is closed.
+func serverTLSConfig(stop <-chan struct{}) (*tls.Config, error) {
+	sources := 0
+	for _, set := range []bool{*tlsCert != "" || *tlsKey != "", *tlsSelfSigned, *tlsACMEDomains != ""} {
+		if set {
+			sources++
+		}
+	}
+	switch {
+	case sources == 0:
+		return nil, nil
+	case sources > 1:
+		return nil, fmt.Errorf("only one of -tls_cert, -tls_self_signed and -tls_acme_domains may be set")
+	}
+
+	config := &tls.Config{MinVersion: tls.VersionTLS12}
+	switch {
+	case *tlsCert != "" || *tlsKey != "":
+		if *tlsCert == "" || *tlsKey == "" {
+			return nil, fmt.Errorf("-tls_cert and -tls_key must be set together")
+		}
+		reloader, err := certs.NewReloader(*tlsCert, *tlsKey)
+		if err != nil {
+			return nil, err
+		}
+		go reloader.Watch(*tlsReloadInterval, stop)
+		config.GetCertificate = reloader.GetCertificate
+		klog.V(1).Infof("Serving HTTPS with the certificate %q", *tlsCert)
+	case *tlsSelfSigned:
+		hosts := selfSignedHosts()
+		cert, err := certs.SelfSigned(hosts, 365*24*time.Hour)
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate a self-signed certificate: %v", err)
+		}
+		config.Certificates = []tls.Certificate{*cert}
+		klog.Infof("Serving HTTPS with a self-signed certificate for %v of SHA-256 fingerprint %s", hosts, certs.Fingerprint(cert))
+	default:
+		m := &autocert.Manager{
+			Prompt:     autocert.AcceptTOS,
+			HostPolicy: autocert.HostWhitelist(splitList(*tlsACMEDomains)...),
+			Cache:      autocert.DirCache(*tlsACMECacheDir),
+			Email:      *tlsACMEEmail,
+			Client:     &acme.Client{DirectoryURL: *tlsACMEDirectoryURL},
+		}
+		config.GetCertificate = m.GetCertificate
+		config.NextProtos = []string{acme.ALPNProto}
+		klog.V(1).Infof("Serving HTTPS with certificates of %s for %s", *tlsACMEDirectoryURL, *tlsACMEDomains)
+	}
+	return config, nil
+}
+
+// selfSignedHosts returns the hosts of the self-signed certificate: the
+// hostname, localhost and the listen_ip addresses, or the loopback ones.
+func selfSignedHosts() []string {
+	hosts := []string{"localhost"}
+	if hostname, err := os.Hostname(); err == nil && hostname != "localhost" {
+		hosts = append(hosts, hostname)
+	}
+	ips := splitList(*argIP)
+	if len(ips) == 0 {
+		ips = []string{"127.0.0.1", "::1"}
+	}
+	for _, ip := range ips {
+		if ip = strings.Trim(ip, "[]"); net.ParseIP(ip) != nil && !net.ParseIP(ip).IsUnspecified() {
+			hosts = append(hosts, ip)
+		}
+	}
+	return hosts
+}
@@ -0,0 +1,92 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"io"
+	"net"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// setTLSFlags sets the TLS flags for the test.
+func setTLSFlags(t *testing.T, cert, key string, selfSigned bool, acmeDomains string) {
+	oldCert, oldKey, oldSelfSigned, oldACMEDomains := *tlsCert, *tlsKey, *tlsSelfSigned, *tlsACMEDomains
+	*tlsCert, *tlsKey, *tlsSelfSigned, *tlsACMEDomains = cert, key, selfSigned, acmeDomains
+	t.Cleanup(func() {
+		*tlsCert, *tlsKey, *tlsSelfSigned, *tlsACMEDomains = oldCert, oldKey, oldSelfSigned, oldACMEDomains
+	})
+}
+
+func TestServerTLSConfig(t *testing.T) {
+	setTLSFlags(t, "", "", false, "")
+	config, err := serverTLSConfig(nil)
+	require.NoError(t, err)
+	assert.Nil(t, config)
+
+	for name, flags := range map[string]func(){
+		"cert without key":     func() { setTLSFlags(t, "cert.pem", "", false, "") },
+		"cert and self-signed": func() { setTLSFlags(t, "cert.pem", "key.pem", true, "") },
+		"self-signed and ACME": func() { setTLSFlags(t, "", "", true, "example.com") },
+		"missing cert and key": func() { setTLSFlags(t, t.TempDir()+"/cert.pem", t.TempDir()+"/key.pem", false, "") },
+	} {
+		flags()
+		_, err := serverTLSConfig(nil)
+		assert.Error(t, err, name)
+	}
+
+	setTLSFlags(t, "", "", false, "example.com, example.org")
+	config, err = serverTLSConfig(nil)
+	require.NoError(t, err)
+	assert.NotNil(t, config.GetCertificate)
+	assert.Contains(t, config.NextProtos, "acme-tls/1")
+	assert.Equal(t, uint16(tls.VersionTLS12), config.MinVersion)
+}
+
+func TestServeTLS(t *testing.T) {
+	setListenFlags(t, "127.0.0.1", 0, 0)
+	setTLSFlags(t, "", "", true, "")
+	config, err := serverTLSConfig(nil)
+	require.NoError(t, err)
+	require.Len(t, config.Certificates, 1)
+	leaf := config.Certificates[0].Leaf
+	assert.Contains(t, leaf.DNSNames, "localhost")
+	require.Len(t, leaf.IPAddresses, 1)
+	assert.True(t, leaf.IPAddresses[0].Equal(net.ParseIP("127.0.0.1")))
+
+	endpoints, err := listen(newListenTestMux())
+	require.NoError(t, err)
+	require.Len(t, endpoints, 1)
+	go func() { _ = serve(endpoints, config) }()
+	t.Cleanup(func() { endpoints[0].listener.Close() })
+
+	roots := x509.NewCertPool()
+	roots.AddCert(leaf)
+	client := &http.Client{Transport: &http.Transport{TLSClientConfig: &tls.Config{RootCAs: roots}}}
+	resp, err := client.Get("https://" + endpoints[0].listener.Addr().String() + "/healthz")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Equal(t, "/healthz", string(body))
+
+	code, _ := get(t, "http://"+endpoints[0].listener.Addr().String()+"/healthz")
+	assert.Equal(t, http.StatusBadRequest, code)
+}
@@ -0,0 +1,71 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+
+	"github.com/google/cadvisor/cmd/internal/otlp"
+)
+
+var (
+	otlpTracesEndpoint = flag.String("otlp_traces_endpoint", "", "URL of the OTLP/HTTP receiver to export the traces of the housekeeping and the HTTP requests to, e.g. http://localhost:4318/v1/traces. Empty disables tracing")
+	otlpTracesHeaders  = flag.String("otlp_traces_headers", "", "Comma-separated name=value headers set on the requests to otlp_traces_endpoint, e.g. for authentication")
+	otlpTracesTimeout  = flag.Duration("otlp_traces_timeout", 10*time.Second, "Timeout of each request to otlp_traces_endpoint")
+	traceSampleRatio   = flag.Float64("trace_sample_ratio", 0.01, "Fraction of the housekeeping cycles and HTTP requests traced. HTTP requests carrying a W3C traceparent header are traced as decided by the caller")
+)
+
+// startTracing exports the traces to the receiver set by the flags, if any.
+// The returned function flushes the traces not exported yet.
+func startTracing() (func(context.Context) error, error) {
+	if *otlpTracesEndpoint == "" {
+		return func(context.Context) error { return nil }, nil
+	}
+	headers := map[string]string{}
+	for _, header := range splitList(*otlpTracesHeaders) {
+		name, value, ok := strings.Cut(header, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid header %q in -otlp_traces_headers, expected name=value", header)
+		}
+		headers[strings.TrimSpace(name)] = strings.TrimSpace(value)
+	}
+	return otlp.Setup(otlp.Config{
+		Endpoint:    *otlpTracesEndpoint,
+		Headers:     headers,
+		Timeout:     *otlpTracesTimeout,
+		SampleRatio: *traceSampleRatio,
+	})
+}
+
+// traceHandler traces the requests served by handler if tracing is enabled,
+// naming the spans after the method and the matched pattern of the ServeMux.
+func traceHandler(handler http.Handler) http.Handler {
+	if *otlpTracesEndpoint == "" {
+		return handler
+	}
+	return otelhttp.NewHandler(handler, "cadvisor", otelhttp.WithSpanNameFormatter(func(_ string, r *http.Request) string {
+		if r.Pattern != "" {
+			return r.Method + " " + r.Pattern
+		}
+		return r.Method
+	}))
+}
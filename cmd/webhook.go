@@ -0,0 +1,76 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/google/cadvisor/cmd/internal/webhook"
+	info "github.com/google/cadvisor/info/v1"
+	"github.com/google/cadvisor/manager"
+)
+
+var (
+	eventWebhookURLs       = flag.String("event_webhook_urls", "", "Comma-separated URLs to POST container events to as JSON. Empty disables webhooks")
+	eventWebhookEventTypes = flag.String("event_webhook_event_types", "oom,oomKill,containerCreation,containerDeletion", "Comma-separated types of the events POSTed to event_webhook_urls")
+	eventWebhookSecretFile = flag.String("event_webhook_secret_file", "", "File holding the secret the HMAC-SHA256 signature of the requests to event_webhook_urls is computed with. Empty sends unsigned requests")
+	eventWebhookTimeout    = flag.Duration("event_webhook_timeout", 10*time.Second, "Timeout of each request to event_webhook_urls")
+	eventWebhookMaxRetries = flag.Int("event_webhook_max_retries", 5, "How many times to retry the delivery of an event to a webhook that failed or responded with a server error")
+)
+
+// startEventWebhooks delivers the events of containerManager to the webhooks
+// set by the flags, if any.
+func startEventWebhooks(containerManager manager.Manager) error {
+	if *eventWebhookURLs == "" {
+		return nil
+	}
+	config := webhook.Config{
+		URLs:       splitList(*eventWebhookURLs),
+		Timeout:    *eventWebhookTimeout,
+		MaxRetries: *eventWebhookMaxRetries,
+		Backoff:    time.Second,
+		QueueSize:  1000,
+	}
+	for _, eventType := range splitList(*eventWebhookEventTypes) {
+		config.EventTypes = append(config.EventTypes, info.EventType(eventType))
+	}
+	if *eventWebhookSecretFile != "" {
+		secret, err := os.ReadFile(*eventWebhookSecretFile)
+		if err != nil {
+			return fmt.Errorf("failed to read the webhook secret: %v", err)
+		}
+		config.Secret = []byte(strings.TrimSpace(string(secret)))
+	}
+	dispatcher, err := webhook.NewDispatcher(config, containerManager)
+	if err != nil {
+		return err
+	}
+	return dispatcher.Start()
+}
+
+// splitList splits a comma-separated flag value, ignoring empty items.
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
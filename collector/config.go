@@ -26,6 +26,9 @@ type Config struct {
 	// the endpoint to hit to scrape metrics
 	Endpoint EndpointConfig `json:"endpoint"`
 
+	// how the endpoint is reached
+	HTTPConfig
+
 	// holds information about different metrics that can be collected
 	MetricsConfig []MetricConfig `json:"metrics_config"`
 }
@@ -50,17 +53,89 @@ type MetricConfig struct {
 
 	// the regular expression that can be used to extract the metric
 	Regex string `json:"regex"`
+
+	// the JSONPath selecting the elements of a JSON response the metric is
+	// extracted from, instead of a regular expression. Eg: '$.pools[*]'
+	JSONPath string `json:"json_path"`
+
+	// the JSONPath of the value relative to each element, the element
+	// itself by default. Eg: '@.connections.active'
+	ValuePath string `json:"value_path"`
+
+	// the JSONPaths of the labels of the metric, relative to each element
+	// or to the root. Eg: {"pool": "@.name"}
+	Labels map[string]string `json:"labels"`
+
+	// the numbers that string values stand for. Eg: {"UP": 1, "DOWN": 0}
+	ValueMap map[string]float64 `json:"value_map"`
 }
 
 type Prometheus struct {
 	// the endpoint to hit to scrape metrics
 	Endpoint EndpointConfig `json:"endpoint"`
 
+	// how the endpoint is reached
+	HTTPConfig
+
 	// the frequency at which metrics should be collected
 	PollingFrequency time.Duration `json:"polling_frequency"`
 
 	// holds names of different metrics that can be collected
 	MetricsConfig []string `json:"metrics_config"`
+
+	// rules relabeling the scraped samples in order, after they are filtered
+	// by name and before they are renamed
+	MetricRelabelConfigs []RelabelConfig `json:"metric_relabel_configs"`
+
+	// new names of the metrics, by their names after relabeling
+	MetricRename map[string]string `json:"metric_rename"`
+
+	// prefix prepended to the names of all the metrics, after renaming
+	MetricPrefix string `json:"metric_prefix"`
+
+	// whether the labels of the metrics are exposed as scraped, overriding
+	// the labels of the container they conflict with, instead of prefixed
+	// with app_
+	HonorLabels bool `json:"honor_labels"`
+}
+
+type Jolokia struct {
+	// the endpoint of the Jolokia agent, e.g. http://localhost:8778/jolokia
+	Endpoint EndpointConfig `json:"endpoint"`
+
+	// how the endpoint is reached
+	HTTPConfig
+
+	// the frequency at which metrics should be collected
+	PollingFrequency time.Duration `json:"polling_frequency"`
+
+	// holds information about the MBean attributes to collect
+	MetricsConfig []JolokiaMetricConfig `json:"metrics_config"`
+}
+
+// JolokiaMetricConfig holds information about a metric read from an MBean
+// attribute
+type JolokiaMetricConfig struct {
+	// the name of the metric
+	Name string `json:"name"`
+
+	// the MBean to read, or a pattern matching several MBeans whose
+	// varying key properties become labels of the metric.
+	// Eg: 'java.lang:type=GarbageCollector,name=*'
+	MBean string `json:"mbean"`
+
+	// the attribute of the MBean. Eg: 'HeapMemoryUsage'
+	Attribute string `json:"attribute"`
+
+	// the path of the value inside a composite attribute. Eg: 'used'
+	Path string `json:"path"`
+
+	// enum type for the metric type, defaults to gauge
+	MetricType v1.MetricType `json:"metric_type"`
+
+	// metric units to display on UI and in storage (eg: MB, cores)
+	// this is only used for display.
+	Units string `json:"units"`
 }
 
 type EndpointConfig struct {
@@ -15,6 +15,7 @@
 package collector
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -42,6 +43,13 @@ type GenericCollector struct {
 	httpClient *http.Client
 }
 
+// The compiled JSONPaths of a metric.
+type jsonMetric struct {
+	path      *jsonPath
+	valuePath *jsonPath
+	labels    map[string]*jsonPath
+}
+
 type collectorInfo struct {
 	// minimum polling frequency among all metrics
 	minPollingFrequency time.Duration
@@ -49,13 +57,17 @@ type collectorInfo struct {
 	// regular expresssions for all metrics
 	regexps []*regexp.Regexp
 
+	// JSONPaths of the metrics extracted from JSON, nil for the others
+	jsonMetrics []*jsonMetric
+
 	// Limit for the number of srcaped metrics. If the count is higher,
 	// no metrics will be returned.
 	metricCountLimit int
 }
 
-// Returns a new collector using the information extracted from the configfile
-func NewCollector(collectorName string, configFile []byte, metricCountLimit int, containerHandler container.ContainerHandler, httpClient *http.Client) (*GenericCollector, error) {
+// Returns a new collector using the information extracted from the configfile, whose
+// files are read with readFile
+func NewCollector(collectorName string, configFile []byte, metricCountLimit int, containerHandler container.ContainerHandler, httpClient *http.Client, readFile func(path string) ([]byte, error)) (*GenericCollector, error) {
 	var configInJSON Config
 	err := json.Unmarshal(configFile, &configInJSON)
 	if err != nil {
@@ -64,6 +76,11 @@ func NewCollector(collectorName string, configFile []byte, metricCountLimit int,
 
 	configInJSON.Endpoint.configure(containerHandler)
 
+	httpClient, err = configInJSON.HTTPConfig.client(httpClient, readFile)
+	if err != nil {
+		return nil, fmt.Errorf("invalid HTTP config: %v", err)
+	}
+
 	// TODO : Add checks for validity of config file (eg : Accurate JSON fields)
 
 	if len(configInJSON.MetricsConfig) == 0 {
@@ -72,6 +89,7 @@ func NewCollector(collectorName string, configFile []byte, metricCountLimit int,
 
 	minPollFrequency := time.Duration(0)
 	regexprs := make([]*regexp.Regexp, len(configInJSON.MetricsConfig))
+	jsonMetrics := make([]*jsonMetric, len(configInJSON.MetricsConfig))
 
 	for ind, metricConfig := range configInJSON.MetricsConfig {
 		// Find the minimum specified polling frequency in metric config.
@@ -81,6 +99,17 @@ func NewCollector(collectorName string, configFile []byte, metricCountLimit int,
 			}
 		}
 
+		if metricConfig.JSONPath != "" {
+			if metricConfig.Regex != "" {
+				return nil, fmt.Errorf("metric %v has both a regexp and a JSONPath", metricConfig.Name)
+			}
+			jsonMetrics[ind], err = compileJSONMetric(metricConfig)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSONPath for metric %v: %v", metricConfig.Name, err)
+			}
+			continue
+		}
+
 		regexprs[ind], err = regexp.Compile(metricConfig.Regex)
 		if err != nil {
 			return nil, fmt.Errorf("invalid regexp %v for metric %v", metricConfig.Regex, metricConfig.Name)
@@ -103,6 +132,7 @@ func NewCollector(collectorName string, configFile []byte, metricCountLimit int,
 		info: &collectorInfo{
 			minPollingFrequency: minPollFrequency,
 			regexps:             regexprs,
+			jsonMetrics:         jsonMetrics,
 			metricCountLimit:    metricCountLimit,
 		},
 		httpClient: httpClient,
@@ -152,7 +182,33 @@ func (collector *GenericCollector) Collect(metrics map[string][]v1.MetricVal) (t
 
 	var errorSlice []error
 
+	// The JSON response is decoded once for all the metrics extracted from it.
+	var document any
+	var documentErr error
+	documentDecoded := false
+
 	for ind, metricConfig := range collector.configFile.MetricsConfig {
+		if jsonMetric := collector.info.jsonMetrics[ind]; jsonMetric != nil {
+			if !documentDecoded {
+				dec := json.NewDecoder(bytes.NewReader(pageContent))
+				dec.UseNumber()
+				documentErr = dec.Decode(&document)
+				documentDecoded = true
+			}
+			if documentErr != nil {
+				errorSlice = append(errorSlice, fmt.Errorf("invalid JSON for metric '%v': %v", metricConfig.Name, documentErr))
+				continue
+			}
+			values, err := jsonMetric.extract(document, metricConfig, currentTime)
+			if err != nil {
+				errorSlice = append(errorSlice, err)
+			}
+			if len(values) > 0 {
+				metrics[metricConfig.Name] = values
+			}
+			continue
+		}
+
 		matchString := collector.info.regexps[ind].FindStringSubmatch(string(pageContent))
 		if matchString != nil {
 			if metricConfig.DataType == v1.FloatType {
@@ -181,3 +237,69 @@ func (collector *GenericCollector) Collect(metrics map[string][]v1.MetricVal) (t
 	}
 	return nextCollectionTime, metrics, compileErrors(errorSlice)
 }
+
+func compileJSONMetric(config MetricConfig) (*jsonMetric, error) {
+	metric := &jsonMetric{labels: make(map[string]*jsonPath, len(config.Labels))}
+	var err error
+	if metric.path, err = compileJSONPath(config.JSONPath); err != nil {
+		return nil, err
+	}
+	valuePath := config.ValuePath
+	if valuePath == "" {
+		valuePath = "@"
+	}
+	if metric.valuePath, err = compileJSONPath(valuePath); err != nil {
+		return nil, err
+	}
+	for name, labelPath := range config.Labels {
+		if metric.labels[name], err = compileJSONPath(labelPath); err != nil {
+			return nil, err
+		}
+	}
+	return metric, nil
+}
+
+// extract returns a value of the metric for each element of the document
+// selected by its JSONPath, coerced to its data type.
+func (metric *jsonMetric) extract(document any, config MetricConfig, currentTime time.Time) ([]v1.MetricVal, error) {
+	root := jsonNode{value: document}
+	elements := metric.path.evaluate(root, root)
+	if len(elements) == 0 {
+		return nil, fmt.Errorf("no match found for JSONPath: %v for metric '%v' in config", config.JSONPath, config.Name)
+	}
+	var values []v1.MetricVal
+	var errorSlice []error
+	for _, element := range elements {
+		selected := metric.valuePath.evaluate(root, element)
+		if len(selected) != 1 {
+			errorSlice = append(errorSlice, fmt.Errorf("%d values found at %v of element %q for metric '%v'", len(selected), config.ValuePath, element.key, config.Name))
+			continue
+		}
+		number, err := jsonFloat(selected[0].value, config.ValueMap)
+		if err != nil {
+			errorSlice = append(errorSlice, fmt.Errorf("invalid value of element %q for metric '%v': %v", element.key, config.Name, err))
+			continue
+		}
+		val := v1.MetricVal{Timestamp: currentTime}
+		switch config.DataType {
+		case v1.FloatType:
+			val.FloatValue = number
+		case v1.IntType:
+			// Fractions are truncated.
+			val.IntValue = int64(number)
+		default:
+			return nil, fmt.Errorf("unexpected value of 'data_type' for metric '%v' in config ", config.Name)
+		}
+		if len(metric.labels) > 0 {
+			val.Labels = make(map[string]string, len(metric.labels))
+			for name, labelPath := range metric.labels {
+				if nodes := labelPath.evaluate(root, element); len(nodes) > 0 {
+					val.Labels[name] = jsonString(nodes[0].value)
+				}
+			}
+			val.Label = labelsToCadvisorLabel(val.Labels)
+		}
+		values = append(values, val)
+	}
+	return values, compileErrors(errorSlice)
+}
@@ -22,6 +22,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	containertest "github.com/google/cadvisor/container/testing"
 	v1 "github.com/google/cadvisor/info/v1"
@@ -45,7 +46,7 @@ func TestEmptyConfig(t *testing.T) {
 	assert.NoError(err)
 
 	containerHandler := containertest.NewMockContainerHandler("mockContainer")
-	_, err = NewCollector("tempCollector", configFile, 100, containerHandler, http.DefaultClient)
+	_, err = NewCollector("tempCollector", configFile, 100, containerHandler, http.DefaultClient, nil)
 	assert.Error(err)
 
 	assert.NoError(os.Remove("temp.json"))
@@ -76,7 +77,7 @@ func TestConfigWithErrors(t *testing.T) {
 	assert.NoError(err)
 
 	containerHandler := containertest.NewMockContainerHandler("mockContainer")
-	_, err = NewCollector("tempCollector", configFile, 100, containerHandler, http.DefaultClient)
+	_, err = NewCollector("tempCollector", configFile, 100, containerHandler, http.DefaultClient, nil)
 	assert.Error(err)
 
 	assert.NoError(os.Remove("temp.json"))
@@ -115,7 +116,7 @@ func TestConfigWithRegexErrors(t *testing.T) {
 	assert.NoError(err)
 
 	containerHandler := containertest.NewMockContainerHandler("mockContainer")
-	_, err = NewCollector("tempCollector", configFile, 100, containerHandler, http.DefaultClient)
+	_, err = NewCollector("tempCollector", configFile, 100, containerHandler, http.DefaultClient, nil)
 	assert.Error(err)
 
 	assert.NoError(os.Remove("temp.json"))
@@ -129,7 +130,7 @@ func TestConfig(t *testing.T) {
 	assert.NoError(err)
 
 	containerHandler := containertest.NewMockContainerHandler("mockContainer")
-	collector, err := NewCollector("nginx", configFile, 100, containerHandler, http.DefaultClient)
+	collector, err := NewCollector("nginx", configFile, 100, containerHandler, http.DefaultClient, nil)
 	assert.NoError(err)
 	assert.Equal(collector.name, "nginx")
 	assert.Equal(collector.configFile.Endpoint.URL, "http://localhost:8000/nginx_status")
@@ -146,7 +147,7 @@ func TestEndpointConfig(t *testing.T) {
 		"111.111.111.111",
 	)
 
-	collector, err := NewCollector("nginx", configFile, 100, containerHandler, http.DefaultClient)
+	collector, err := NewCollector("nginx", configFile, 100, containerHandler, http.DefaultClient, nil)
 	assert.NoError(err)
 	assert.Equal(collector.name, "nginx")
 	assert.Equal(collector.configFile.Endpoint.URL, "https://111.111.111.111:8000/nginx_status")
@@ -161,7 +162,7 @@ func TestMetricCollection(t *testing.T) {
 	assert.NoError(err)
 
 	containerHandler := containertest.NewMockContainerHandler("mockContainer")
-	fakeCollector, err := NewCollector("nginx", configFile, 100, containerHandler, http.DefaultClient)
+	fakeCollector, err := NewCollector("nginx", configFile, 100, containerHandler, http.DefaultClient, nil)
 	assert.NoError(err)
 
 	tempServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -197,6 +198,91 @@ func TestMetricCollectionLimit(t *testing.T) {
 	assert.NoError(err)
 
 	containerHandler := containertest.NewMockContainerHandler("mockContainer")
-	_, err = NewCollector("nginx", configFile, 1, containerHandler, http.DefaultClient)
+	_, err = NewCollector("nginx", configFile, 1, containerHandler, http.DefaultClient, nil)
 	assert.Error(err)
 }
+
+func TestJSONMetricCollection(t *testing.T) {
+	configFile, err := os.ReadFile("config/sample_config_json.json")
+	require.NoError(t, err)
+
+	containerHandler := containertest.NewMockContainerHandler("mockContainer")
+	fakeCollector, err := NewCollector("app", configFile, 100, containerHandler, http.DefaultClient, nil)
+	require.NoError(t, err)
+
+	tempServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintln(w, `{
+			"status": "UP",
+			"load": "0.75",
+			"pools": [
+				{"name": "db", "connections": {"active": 3}},
+				{"name": "cache", "connections": {"active": 7.9}}
+			],
+			"queues": {"jobs": 12, "mail": 0}
+		}`)
+	}))
+	defer tempServer.Close()
+	fakeCollector.configFile.Endpoint.URL = tempServer.URL
+
+	_, metrics, err := fakeCollector.Collect(map[string][]v1.MetricVal{})
+	require.NoError(t, err)
+
+	require.Len(t, metrics["activeConnections"], 2)
+	assert.Equal(t, int64(3), metrics["activeConnections"][0].IntValue)
+	assert.Equal(t, map[string]string{"pool": "db"}, metrics["activeConnections"][0].Labels)
+	assert.Equal(t, "pool=db", metrics["activeConnections"][0].Label)
+	// Fractions are truncated for int metrics.
+	assert.Equal(t, int64(7), metrics["activeConnections"][1].IntValue)
+	assert.Equal(t, map[string]string{"pool": "cache"}, metrics["activeConnections"][1].Labels)
+
+	require.Len(t, metrics["queueLength"], 2)
+	assert.Equal(t, int64(12), metrics["queueLength"][0].IntValue)
+	assert.Equal(t, map[string]string{"queue": "jobs"}, metrics["queueLength"][0].Labels)
+	assert.Equal(t, int64(0), metrics["queueLength"][1].IntValue)
+
+	require.Len(t, metrics["up"], 1)
+	assert.Equal(t, int64(1), metrics["up"][0].IntValue)
+	assert.Empty(t, metrics["up"][0].Labels)
+	assert.Equal(t, 0.75, metrics["load"][0].FloatValue)
+}
+
+func TestJSONMetricCollectionErrors(t *testing.T) {
+	configFile, err := os.ReadFile("config/sample_config_json.json")
+	require.NoError(t, err)
+
+	containerHandler := containertest.NewMockContainerHandler("mockContainer")
+	fakeCollector, err := NewCollector("app", configFile, 100, containerHandler, http.DefaultClient, nil)
+	require.NoError(t, err)
+
+	body := "not json"
+	tempServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, body)
+	}))
+	defer tempServer.Close()
+	fakeCollector.configFile.Endpoint.URL = tempServer.URL
+
+	_, _, err = fakeCollector.Collect(map[string][]v1.MetricVal{})
+	assert.Error(t, err)
+
+	// The valid values are still collected.
+	body = `{"status": "STARTING", "load": 1, "pools": [{"name": "db", "connections": {"active": null}}]}`
+	_, metrics, err := fakeCollector.Collect(map[string][]v1.MetricVal{})
+	assert.Error(t, err)
+	assert.Equal(t, 1.0, metrics["load"][0].FloatValue)
+	assert.NotContains(t, metrics, "up")
+	assert.NotContains(t, metrics, "activeConnections")
+}
+
+func TestJSONMetricConfigErrors(t *testing.T) {
+	containerHandler := containertest.NewMockContainerHandler("mockContainer")
+	for _, metricConfig := range []string{
+		`{"name": "a", "data_type": "int", "json_path": "$.a", "regex": "a: ([0-9]+)"}`,
+		`{"name": "a", "data_type": "int", "json_path": "a"}`,
+		`{"name": "a", "data_type": "int", "json_path": "$.a", "value_path": "b"}`,
+		`{"name": "a", "data_type": "int", "json_path": "$.a", "labels": {"l": "$["}}`,
+	} {
+		configFile := []byte(`{"endpoint": "http://localhost:8000/status", "metrics_config": [` + metricConfig + `]}`)
+		_, err := NewCollector("app", configFile, 100, containerHandler, http.DefaultClient, nil)
+		assert.Error(t, err, metricConfig)
+	}
+}
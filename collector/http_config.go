@@ -0,0 +1,203 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collector
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// HTTPConfig configures the requests of a collector to its endpoint. The
+// files are read in the container when the collector is created, their
+// paths resolved inside the root of the container.
+type HTTPConfig struct {
+	// the TLS configuration of an HTTPS endpoint
+	TLSConfig *TLSConfig `json:"tls_config,omitempty"`
+
+	// the token sent in the Authorization header, given or read from a file
+	BearerToken     string `json:"bearer_token,omitempty"`
+	BearerTokenFile string `json:"bearer_token_file,omitempty"`
+
+	// the credentials of HTTP basic authentication
+	BasicAuth *BasicAuth `json:"basic_auth,omitempty"`
+
+	// the time limit of a request, unlimited by default
+	Timeout time.Duration `json:"timeout,omitempty"`
+
+	// the URL of the proxy the requests go through, instead of the proxy
+	// of the environment of cAdvisor
+	ProxyURL string `json:"proxy_url,omitempty"`
+}
+
+// TLSConfig configures the verification of the certificate of an endpoint.
+type TLSConfig struct {
+	// the PEM encoded CAs verifying the certificate, given or read from a
+	// file. The certificate isn't verified without CAs.
+	CA     string `json:"ca,omitempty"`
+	CAFile string `json:"ca_file,omitempty"`
+
+	// the name the certificate is verified for, the host of the URL by
+	// default
+	ServerName string `json:"server_name,omitempty"`
+}
+
+type BasicAuth struct {
+	Username string `json:"username"`
+
+	// the password, given or read from a file
+	Password     string `json:"password,omitempty"`
+	PasswordFile string `json:"password_file,omitempty"`
+}
+
+// client returns the client of the requests configured by config, based on
+// httpClient. readFile reads the files of the config in the container.
+func (config *HTTPConfig) client(httpClient *http.Client, readFile func(path string) ([]byte, error)) (*http.Client, error) {
+	if *config == (HTTPConfig{}) {
+		return httpClient, nil
+	}
+	read := func(path string) (string, error) {
+		if readFile == nil {
+			return "", fmt.Errorf("files can't be read")
+		}
+		for _, element := range strings.Split(path, "/") {
+			if element == ".." {
+				return "", fmt.Errorf("invalid path %q: files outside of the container can't be read", path)
+			}
+		}
+		data, err := readFile(path)
+		if err != nil {
+			return "", fmt.Errorf("failed to read %q: %v", path, err)
+		}
+		return strings.TrimSpace(string(data)), nil
+	}
+
+	client := *httpClient
+	if config.Timeout > 0 {
+		client.Timeout = config.Timeout
+	}
+
+	if config.TLSConfig != nil || config.ProxyURL != "" {
+		var transport *http.Transport
+		switch t := client.Transport.(type) {
+		case nil:
+			transport = http.DefaultTransport.(*http.Transport).Clone()
+		case *http.Transport:
+			transport = t.Clone()
+		default:
+			return nil, fmt.Errorf("the TLS and proxy of a collector can't be configured with a %T transport", t)
+		}
+		if config.TLSConfig != nil {
+			tlsConfig, err := config.TLSConfig.configure(transport.TLSClientConfig, read)
+			if err != nil {
+				return nil, err
+			}
+			transport.TLSClientConfig = tlsConfig
+		}
+		if config.ProxyURL != "" {
+			proxyURL, err := url.Parse(config.ProxyURL)
+			if err != nil {
+				return nil, fmt.Errorf("invalid proxy URL %q: %v", config.ProxyURL, err)
+			}
+			transport.Proxy = http.ProxyURL(proxyURL)
+		}
+		client.Transport = transport
+	}
+
+	var authorization string
+	switch {
+	case config.BearerToken != "" && config.BearerTokenFile != "":
+		return nil, fmt.Errorf("at most one of bearer_token and bearer_token_file can be set")
+	case (config.BearerToken != "" || config.BearerTokenFile != "") && config.BasicAuth != nil:
+		return nil, fmt.Errorf("at most one of bearer token and basic auth can be set")
+	case config.BearerToken != "":
+		authorization = "Bearer " + config.BearerToken
+	case config.BearerTokenFile != "":
+		token, err := read(config.BearerTokenFile)
+		if err != nil {
+			return nil, err
+		}
+		authorization = "Bearer " + token
+	case config.BasicAuth != nil:
+		password := config.BasicAuth.Password
+		if config.BasicAuth.PasswordFile != "" {
+			if password != "" {
+				return nil, fmt.Errorf("at most one of password and password_file can be set")
+			}
+			var err error
+			if password, err = read(config.BasicAuth.PasswordFile); err != nil {
+				return nil, err
+			}
+		}
+		authorization = "Basic " + base64.StdEncoding.EncodeToString([]byte(config.BasicAuth.Username+":"+password))
+	}
+	if authorization != "" {
+		next := client.Transport
+		if next == nil {
+			next = http.DefaultTransport
+		}
+		client.Transport = &authorizationRoundTripper{authorization: authorization, next: next}
+	}
+	return &client, nil
+}
+
+// configure returns a copy of the TLS configuration of the transport, nil
+// if none, verifying the certificate against the CAs of config.
+func (config *TLSConfig) configure(tlsConfig *tls.Config, read func(path string) (string, error)) (*tls.Config, error) {
+	if tlsConfig == nil {
+		tlsConfig = &tls.Config{}
+	} else {
+		tlsConfig = tlsConfig.Clone()
+	}
+	ca := config.CA
+	if config.CAFile != "" {
+		if ca != "" {
+			return nil, fmt.Errorf("at most one of ca and ca_file can be set")
+		}
+		var err error
+		if ca, err = read(config.CAFile); err != nil {
+			return nil, err
+		}
+	}
+	if ca != "" {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM([]byte(ca)) {
+			return nil, fmt.Errorf("no CA certificate found")
+		}
+		tlsConfig.RootCAs = pool
+		tlsConfig.InsecureSkipVerify = false
+	}
+	if config.ServerName != "" {
+		tlsConfig.ServerName = config.ServerName
+	}
+	return tlsConfig, nil
+}
+
+// authorizationRoundTripper sets the Authorization header of requests.
+type authorizationRoundTripper struct {
+	authorization string
+	next          http.RoundTripper
+}
+
+func (rt *authorizationRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	req.Header.Set("Authorization", rt.authorization)
+	return rt.next.RoundTrip(req)
+}
@@ -0,0 +1,165 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collector
+
+import (
+	"crypto/tls"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	containertest "github.com/google/cadvisor/container/testing"
+)
+
+func fakeReadFile(files map[string]string) func(string) ([]byte, error) {
+	return func(path string) ([]byte, error) {
+		if data, ok := files[path]; ok {
+			return []byte(data), nil
+		}
+		return nil, os.ErrNotExist
+	}
+}
+
+func get(t *testing.T, client *http.Client, url string) (*http.Response, error) {
+	response, err := client.Get(url)
+	if err == nil {
+		t.Cleanup(func() { response.Body.Close() })
+	}
+	return response, err
+}
+
+func TestHTTPConfigUnchangedClient(t *testing.T) {
+	client, err := (&HTTPConfig{}).client(http.DefaultClient, nil)
+	require.NoError(t, err)
+	assert.Same(t, http.DefaultClient, client)
+}
+
+func TestHTTPConfigCA(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+	ca := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}))
+	// The client of cAdvisor doesn't verify certificates by default.
+	insecureClient := &http.Client{Transport: &http.Transport{TLSClientConfig: &tls.Config{InsecureSkipVerify: true}}}
+
+	client, err := (&HTTPConfig{TLSConfig: &TLSConfig{CAFile: "/ca.pem"}}).client(insecureClient, fakeReadFile(map[string]string{"/ca.pem": ca}))
+	require.NoError(t, err)
+	response, err := get(t, client, server.URL)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+
+	// Verified for the name of the certificate.
+	client, err = (&HTTPConfig{TLSConfig: &TLSConfig{CA: ca, ServerName: "other.example"}}).client(insecureClient, nil)
+	require.NoError(t, err)
+	_, err = get(t, client, server.URL)
+	assert.Error(t, err)
+
+	// The transport of cAdvisor is left as is.
+	response, err = get(t, insecureClient, server.URL)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+
+	_, err = (&HTTPConfig{TLSConfig: &TLSConfig{CA: "not a certificate"}}).client(insecureClient, nil)
+	assert.Error(t, err)
+	_, err = (&HTTPConfig{TLSConfig: &TLSConfig{CAFile: "/missing.pem"}}).client(insecureClient, fakeReadFile(nil))
+	assert.Error(t, err)
+}
+
+func TestHTTPConfigAuthorization(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, r.Header.Get("Authorization"))
+	}))
+	defer server.Close()
+	readFile := fakeReadFile(map[string]string{"/token": "file-token\n", "/password": "secret"})
+
+	for _, tc := range []struct {
+		config        HTTPConfig
+		authorization string
+	}{
+		{HTTPConfig{BearerToken: "token"}, "Bearer token"},
+		{HTTPConfig{BearerTokenFile: "/token"}, "Bearer file-token"},
+		{HTTPConfig{BasicAuth: &BasicAuth{Username: "user", Password: "secret"}}, "Basic dXNlcjpzZWNyZXQ="},
+		{HTTPConfig{BasicAuth: &BasicAuth{Username: "user", PasswordFile: "/password"}}, "Basic dXNlcjpzZWNyZXQ="},
+	} {
+		client, err := tc.config.client(http.DefaultClient, readFile)
+		require.NoError(t, err)
+		response, err := get(t, client, server.URL)
+		require.NoError(t, err)
+		body, err := io.ReadAll(response.Body)
+		require.NoError(t, err)
+		assert.Equal(t, tc.authorization, string(body))
+	}
+
+	for _, config := range []HTTPConfig{
+		{BearerToken: "token", BearerTokenFile: "/token"},
+		{BearerToken: "token", BasicAuth: &BasicAuth{Username: "user"}},
+		{BasicAuth: &BasicAuth{Username: "user", Password: "secret", PasswordFile: "/password"}},
+		{BearerTokenFile: "/missing"},
+		{BearerTokenFile: "../../../../token"},
+		{TLSConfig: &TLSConfig{CAFile: "/etc/../../token"}},
+	} {
+		_, err := config.client(http.DefaultClient, readFile)
+		assert.Error(t, err, "%+v", config)
+	}
+	// Files can't be read without a reader.
+	_, err := (&HTTPConfig{BearerTokenFile: "/token"}).client(http.DefaultClient, nil)
+	assert.Error(t, err)
+}
+
+func TestHTTPConfigTimeoutAndProxy(t *testing.T) {
+	proxied := make(chan string, 1)
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxied <- r.URL.String()
+	}))
+	defer proxy.Close()
+
+	client, err := (&HTTPConfig{ProxyURL: proxy.URL, Timeout: 5 * time.Second}).client(http.DefaultClient, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 5*time.Second, client.Timeout)
+	response, err := get(t, client, "http://app.invalid/metrics")
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	assert.Equal(t, "http://app.invalid/metrics", <-proxied)
+
+	_, err = (&HTTPConfig{ProxyURL: "://"}).client(http.DefaultClient, nil)
+	assert.Error(t, err)
+}
+
+func TestPrometheusWithBearerToken(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		fmt.Fprint(w, "# TYPE up gauge\nup 1\n")
+	}))
+	defer server.Close()
+
+	configFile := []byte(fmt.Sprintf(`{"endpoint": "%s", "bearer_token_file": "/token", "timeout": 5000000000}`, server.URL))
+	containerHandler := containertest.NewMockContainerHandler("mockContainer")
+	collector, err := NewPrometheusCollector("prometheus", configFile, 100, containerHandler, http.DefaultClient, fakeReadFile(map[string]string{"/token": "token"}))
+	require.NoError(t, err)
+	assert.Equal(t, 5*time.Second, collector.configFile.Timeout)
+	specs := collector.GetSpec()
+	require.Len(t, specs, 1)
+	assert.Equal(t, "up", specs[0].Name)
+}
@@ -0,0 +1,298 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collector
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/cadvisor/container"
+	v1 "github.com/google/cadvisor/info/v1"
+)
+
+// JolokiaCollector reads MBean attributes of a JVM from its Jolokia agent,
+// all of them in one bulk request.
+type JolokiaCollector struct {
+	// name of the collector
+	name string
+
+	// rate at which metrics are collected
+	pollingFrequency time.Duration
+
+	// holds information extracted from the config file for a collector
+	configFile Jolokia
+
+	// Limit for the number of collected metrics. If the count is higher,
+	// no metrics will be returned.
+	metricCountLimit int
+
+	// The Http client to use when connecting to metric endpoints
+	httpClient *http.Client
+}
+
+// A read request of the Jolokia protocol.
+type jolokiaRequest struct {
+	Type      string `json:"type"`
+	MBean     string `json:"mbean"`
+	Attribute string `json:"attribute,omitempty"`
+	Path      string `json:"path,omitempty"`
+}
+
+// The response to a jolokiaRequest.
+type jolokiaResponse struct {
+	Status int             `json:"status"`
+	Error  string          `json:"error"`
+	Value  json.RawMessage `json:"value"`
+}
+
+// Returns a new collector using the information extracted from the configfile, whose
+// files are read with readFile
+func NewJolokiaCollector(collectorName string, configFile []byte, metricCountLimit int, containerHandler container.ContainerHandler, httpClient *http.Client, readFile func(path string) ([]byte, error)) (*JolokiaCollector, error) {
+	var configInJSON Jolokia
+	err := json.Unmarshal(configFile, &configInJSON)
+	if err != nil {
+		return nil, err
+	}
+
+	configInJSON.Endpoint.configure(containerHandler)
+
+	httpClient, err = configInJSON.HTTPConfig.client(httpClient, readFile)
+	if err != nil {
+		return nil, fmt.Errorf("invalid HTTP config: %v", err)
+	}
+
+	if len(configInJSON.MetricsConfig) == 0 {
+		return nil, fmt.Errorf("no metrics provided in config")
+	}
+	if len(configInJSON.MetricsConfig) > metricCountLimit {
+		return nil, fmt.Errorf("too many metrics defined: %d limit: %d", len(configInJSON.MetricsConfig), metricCountLimit)
+	}
+	for i, metricConfig := range configInJSON.MetricsConfig {
+		if metricConfig.Name == "" || metricConfig.MBean == "" || metricConfig.Attribute == "" {
+			return nil, fmt.Errorf("metric %d requires a name, an mbean and an attribute", i)
+		}
+		if metricConfig.MetricType == "" {
+			configInJSON.MetricsConfig[i].MetricType = v1.MetricGauge
+		}
+	}
+
+	// Minimum supported polling frequency is 1s.
+	pollingFrequency := configInJSON.PollingFrequency
+	minSupportedFrequency := 1 * time.Second
+	if pollingFrequency < minSupportedFrequency {
+		pollingFrequency = minSupportedFrequency
+	}
+
+	return &JolokiaCollector{
+		name:             collectorName,
+		pollingFrequency: pollingFrequency,
+		configFile:       configInJSON,
+		metricCountLimit: metricCountLimit,
+		httpClient:       httpClient,
+	}, nil
+}
+
+// Returns name of the collector
+func (collector *JolokiaCollector) Name() string {
+	return collector.name
+}
+
+func (collector *JolokiaCollector) GetSpec() []v1.MetricSpec {
+	specs := []v1.MetricSpec{}
+	for _, metricConfig := range collector.configFile.MetricsConfig {
+		specs = append(specs, v1.MetricSpec{
+			Name:   metricConfig.Name,
+			Type:   metricConfig.MetricType,
+			Format: v1.FloatType,
+			Units:  metricConfig.Units,
+		})
+	}
+	return specs
+}
+
+// Returns collected metrics and the next collection time of the collector
+func (collector *JolokiaCollector) Collect(metrics map[string][]v1.MetricVal) (time.Time, map[string][]v1.MetricVal, error) {
+	currentTime := time.Now()
+	nextCollectionTime := currentTime.Add(collector.pollingFrequency)
+
+	requests := make([]jolokiaRequest, 0, len(collector.configFile.MetricsConfig))
+	for _, metricConfig := range collector.configFile.MetricsConfig {
+		requests = append(requests, jolokiaRequest{
+			Type:      "read",
+			MBean:     metricConfig.MBean,
+			Attribute: metricConfig.Attribute,
+			Path:      metricConfig.Path,
+		})
+	}
+	body, err := json.Marshal(requests)
+	if err != nil {
+		return nextCollectionTime, nil, err
+	}
+
+	response, err := collector.httpClient.Post(collector.configFile.Endpoint.URL, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return nextCollectionTime, nil, err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return nextCollectionTime, nil, fmt.Errorf("server returned HTTP status %s", response.Status)
+	}
+
+	var responses []jolokiaResponse
+	if err := json.NewDecoder(response.Body).Decode(&responses); err != nil {
+		return nextCollectionTime, nil, fmt.Errorf("failed to decode the Jolokia response: %v", err)
+	}
+	if len(responses) != len(requests) {
+		return nextCollectionTime, nil, fmt.Errorf("got %d Jolokia responses to %d requests", len(responses), len(requests))
+	}
+
+	var errorSlice []error
+	newMetrics := make(map[string][]v1.MetricVal)
+	count := 0
+	for i, metricConfig := range collector.configFile.MetricsConfig {
+		values, err := jolokiaValues(metricConfig, responses[i], currentTime)
+		if err != nil {
+			errorSlice = append(errorSlice, fmt.Errorf("metric %q: %v", metricConfig.Name, err))
+			continue
+		}
+		count += len(values)
+		if count > collector.metricCountLimit {
+			return nextCollectionTime, nil, fmt.Errorf("too many metrics to collect")
+		}
+		newMetrics[metricConfig.Name] = append(newMetrics[metricConfig.Name], values...)
+	}
+
+	for key, val := range newMetrics {
+		metrics[key] = append(metrics[key], val...)
+	}
+	return nextCollectionTime, metrics, compileErrors(errorSlice)
+}
+
+// jolokiaValues returns the values of the metric in response. The value of an
+// MBean pattern is keyed by the names of the MBeans matched, then by their
+// attributes.
+func jolokiaValues(metricConfig JolokiaMetricConfig, response jolokiaResponse, timestamp time.Time) ([]v1.MetricVal, error) {
+	if response.Status != http.StatusOK {
+		return nil, fmt.Errorf("Jolokia returned status %d: %s", response.Status, response.Error)
+	}
+
+	if !strings.Contains(metricConfig.MBean, "*") && !strings.Contains(metricConfig.MBean, "?") {
+		value, err := jolokiaValue(response.Value)
+		if err != nil {
+			return nil, err
+		}
+		return []v1.MetricVal{{FloatValue: value, Timestamp: timestamp}}, nil
+	}
+
+	var mbeans map[string]map[string]json.RawMessage
+	if err := json.Unmarshal(response.Value, &mbeans); err != nil {
+		return nil, fmt.Errorf("unexpected value of MBean pattern: %v", err)
+	}
+	names := make([]string, 0, len(mbeans))
+	for name := range mbeans {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	values := make([]v1.MetricVal, 0, len(mbeans))
+	for _, name := range names {
+		raw, ok := mbeans[name][metricConfig.Attribute]
+		if !ok {
+			continue
+		}
+		value, err := jolokiaValue(raw)
+		if err != nil {
+			return nil, fmt.Errorf("MBean %q: %v", name, err)
+		}
+		labels := mbeanLabels(metricConfig.MBean, name)
+		values = append(values, v1.MetricVal{
+			FloatValue: value,
+			Timestamp:  timestamp,
+			Label:      labelsToCadvisorLabel(labels),
+			Labels:     labels,
+		})
+	}
+	return values, nil
+}
+
+// jolokiaValue converts a numeric or boolean attribute value.
+func jolokiaValue(raw json.RawMessage) (float64, error) {
+	var value interface{}
+	if err := json.Unmarshal(raw, &value); err != nil {
+		return 0, err
+	}
+	switch v := value.(type) {
+	case float64:
+		return v, nil
+	case bool:
+		if v {
+			return 1, nil
+		}
+		return 0, nil
+	default:
+		return 0, fmt.Errorf("value %s is not a number, a path may be missing", raw)
+	}
+}
+
+// mbeanProperties returns the domain and the key properties of an MBean name,
+// e.g. java.lang:type=GarbageCollector,name=G1 Young Generation.
+func mbeanProperties(mbean string) (string, map[string]string) {
+	domain, list, _ := strings.Cut(mbean, ":")
+	properties := make(map[string]string)
+	for _, property := range strings.Split(list, ",") {
+		if key, value, ok := strings.Cut(property, "="); ok {
+			properties[key] = value
+		}
+	}
+	return domain, properties
+}
+
+// mbeanLabels returns the key properties of the MBean matched by pattern
+// which the pattern doesn't fix, and its domain if the pattern doesn't.
+func mbeanLabels(pattern, mbean string) map[string]string {
+	patternDomain, patternProperties := mbeanProperties(pattern)
+	domain, properties := mbeanProperties(mbean)
+	labels := make(map[string]string)
+	if patternDomain != domain {
+		labels["domain"] = domain
+	}
+	for key, value := range properties {
+		if patternProperties[key] != value {
+			labels[key] = value
+		}
+	}
+	return labels
+}
+
+// labelsToCadvisorLabel joins labels into the single label of a metric value,
+// sorted by name.
+func labelsToCadvisorLabel(labels map[string]string) string {
+	names := make([]string, 0, len(labels))
+	for name := range labels {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	pairs := make([]string, 0, len(names))
+	for _, name := range names {
+		pairs = append(pairs, name+"="+labels[name])
+	}
+	return strings.Join(pairs, "\xff")
+}
@@ -0,0 +1,131 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collector
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	containertest "github.com/google/cadvisor/container/testing"
+	v1 "github.com/google/cadvisor/info/v1"
+)
+
+func newTestJolokiaCollector(t *testing.T, metricCountLimit int) *JolokiaCollector {
+	configFile, err := os.ReadFile("config/sample_config_jolokia.json")
+	require.NoError(t, err)
+	containerHandler := containertest.NewMockContainerHandler("mockContainer")
+	collector, err := NewJolokiaCollector("jolokia", configFile, metricCountLimit, containerHandler, http.DefaultClient, nil)
+	require.NoError(t, err)
+	return collector
+}
+
+func TestJolokia(t *testing.T) {
+	assert := assert.New(t)
+	collector := newTestJolokiaCollector(t, 100)
+	assert.Equal("jolokia", collector.Name())
+	assert.Equal("http://localhost:8778/jolokia", collector.configFile.Endpoint.URL)
+
+	assert.Equal([]v1.MetricSpec{
+		{Name: "jvm_memory_heap_used_bytes", Type: v1.MetricGauge, Format: v1.FloatType, Units: "bytes"},
+		{Name: "jvm_gc_collections_total", Type: v1.MetricCumulative, Format: v1.FloatType},
+		{Name: "jvm_threads", Type: v1.MetricGauge, Format: v1.FloatType},
+	}, collector.GetSpec())
+
+	tempServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(http.MethodPost, r.Method)
+		var requests []jolokiaRequest
+		assert.NoError(json.NewDecoder(r.Body).Decode(&requests))
+		assert.Equal([]jolokiaRequest{
+			{Type: "read", MBean: "java.lang:type=Memory", Attribute: "HeapMemoryUsage", Path: "used"},
+			{Type: "read", MBean: "java.lang:type=GarbageCollector,name=*", Attribute: "CollectionCount"},
+			{Type: "read", MBean: "java.lang:type=Threading", Attribute: "ThreadCount"},
+		}, requests)
+
+		fmt.Fprintln(w, `[
+  {"request": {"type": "read"}, "value": 123456, "status": 200},
+  {"request": {"type": "read"}, "value": {
+    "java.lang:name=G1 Young Generation,type=GarbageCollector": {"CollectionCount": 12},
+    "java.lang:name=G1 Old Generation,type=GarbageCollector": {"CollectionCount": 1}
+  }, "status": 200},
+  {"request": {"type": "read"}, "error": "javax.management.InstanceNotFoundException", "status": 404}
+]`)
+	}))
+	defer tempServer.Close()
+	collector.configFile.Endpoint.URL = tempServer.URL
+
+	metrics := map[string][]v1.MetricVal{}
+	_, metrics, err := collector.Collect(metrics)
+	assert.Error(err)
+	assert.Contains(err.Error(), "InstanceNotFoundException")
+
+	heap := metrics["jvm_memory_heap_used_bytes"]
+	require.Len(t, heap, 1)
+	assert.Equal(float64(123456), heap[0].FloatValue)
+
+	gc := metrics["jvm_gc_collections_total"]
+	require.Len(t, gc, 2)
+	assert.Equal(float64(1), gc[0].FloatValue)
+	assert.Equal(map[string]string{"name": "G1 Old Generation"}, gc[0].Labels)
+	assert.Equal("name=G1 Old Generation", gc[0].Label)
+	assert.Equal(float64(12), gc[1].FloatValue)
+	assert.Equal(map[string]string{"name": "G1 Young Generation"}, gc[1].Labels)
+
+	assert.NotContains(metrics, "jvm_threads")
+}
+
+func TestJolokiaMetricCountLimit(t *testing.T) {
+	collector := newTestJolokiaCollector(t, 3)
+
+	tempServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintln(w, `[
+  {"value": 1, "status": 200},
+  {"value": {"java.lang:name=a,type=GarbageCollector": {"CollectionCount": 1}, "java.lang:name=b,type=GarbageCollector": {"CollectionCount": 2}}, "status": 200},
+  {"value": true, "status": 200}
+]`)
+	}))
+	defer tempServer.Close()
+	collector.configFile.Endpoint.URL = tempServer.URL
+
+	_, metrics, err := collector.Collect(map[string][]v1.MetricVal{})
+	assert.Error(t, err)
+	assert.Nil(t, metrics)
+}
+
+func TestJolokiaInvalidConfig(t *testing.T) {
+	containerHandler := containertest.NewMockContainerHandler("mockContainer")
+	for _, config := range []string{
+		`{"endpoint": "http://localhost:8778/jolokia"}`,
+		`{"endpoint": "http://localhost:8778/jolokia", "metrics_config": [{"name": "a", "mbean": "java.lang:type=Memory"}]}`,
+	} {
+		_, err := NewJolokiaCollector("jolokia", []byte(config), 100, containerHandler, http.DefaultClient, nil)
+		assert.Error(t, err, config)
+	}
+}
+
+func TestMBeanLabels(t *testing.T) {
+	assert.Equal(t, map[string]string{"name": "G1 Young Generation"},
+		mbeanLabels("java.lang:type=GarbageCollector,name=*", "java.lang:name=G1 Young Generation,type=GarbageCollector"))
+	assert.Equal(t, map[string]string{"type": "Memory"},
+		mbeanLabels("java.lang:*", "java.lang:type=Memory"))
+	assert.Equal(t, map[string]string{"domain": "kafka.server", "type": "BrokerTopicMetrics"},
+		mbeanLabels("*:type=*", "kafka.server:type=BrokerTopicMetrics"))
+}
@@ -0,0 +1,234 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collector
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// A JSONPath expression, among the subset supported by the generic collector:
+// the root $ or the current element @, followed by children .name or
+// ['name'], indices [n] (negative from the end), wildcards .* or [*],
+// recursive descent ..name, ..* or ..[n], and a final ~ selecting the keys of
+// the nodes instead of their values.
+type jsonPath struct {
+	// whether the path starts from the current element rather than the root
+	relative bool
+	steps    []jsonPathStep
+	// whether the path selects the keys of the nodes
+	keys bool
+}
+
+type jsonPathStep struct {
+	// the child selected, all if wildcard
+	name     string
+	index    int
+	isIndex  bool
+	wildcard bool
+	// whether the step applies to all the descendants
+	recursive bool
+}
+
+// A value selected by a path, with its key in its parent: a name or an index.
+type jsonNode struct {
+	key   string
+	value any
+}
+
+func compileJSONPath(expr string) (*jsonPath, error) {
+	path := &jsonPath{}
+	switch {
+	case strings.HasPrefix(expr, "$"):
+	case strings.HasPrefix(expr, "@"):
+		path.relative = true
+	default:
+		return nil, fmt.Errorf("JSONPath %q must start with $ or @", expr)
+	}
+	rest := expr[1:]
+	if strings.HasSuffix(rest, "~") {
+		path.keys = true
+		rest = strings.TrimSuffix(rest, "~")
+	}
+	for rest != "" {
+		var step jsonPathStep
+		if strings.HasPrefix(rest, "..") {
+			step.recursive = true
+			rest = rest[2:]
+			if !strings.HasPrefix(rest, "[") {
+				rest = "." + rest
+			}
+		}
+		switch {
+		case strings.HasPrefix(rest, "."):
+			end := strings.IndexAny(rest[1:], ".[")
+			if end < 0 {
+				end = len(rest) - 1
+			}
+			name := rest[1 : end+1]
+			rest = rest[end+1:]
+			if name == "" {
+				return nil, fmt.Errorf("JSONPath %q has an empty name", expr)
+			}
+			if name == "*" {
+				step.wildcard = true
+			} else {
+				step.name = name
+			}
+		case strings.HasPrefix(rest, "["):
+			end := strings.Index(rest, "]")
+			if end < 0 {
+				return nil, fmt.Errorf("JSONPath %q has an unclosed [", expr)
+			}
+			selector := strings.TrimSpace(rest[1:end])
+			rest = rest[end+1:]
+			switch {
+			case selector == "*":
+				step.wildcard = true
+			case len(selector) >= 2 && (selector[0] == '\'' || selector[0] == '"') && selector[len(selector)-1] == selector[0]:
+				step.name = selector[1 : len(selector)-1]
+			default:
+				index, err := strconv.Atoi(selector)
+				if err != nil {
+					return nil, fmt.Errorf("JSONPath %q has an unsupported selector [%s]", expr, selector)
+				}
+				step.index = index
+				step.isIndex = true
+			}
+		default:
+			return nil, fmt.Errorf("JSONPath %q is invalid at %q", expr, rest)
+		}
+		path.steps = append(path.steps, step)
+	}
+	return path, nil
+}
+
+// evaluate returns the nodes selected from the root document or, for a
+// relative path, from the current element.
+func (path *jsonPath) evaluate(root, current jsonNode) []jsonNode {
+	nodes := []jsonNode{root}
+	if path.relative {
+		nodes = []jsonNode{current}
+	}
+	for _, step := range path.steps {
+		var next []jsonNode
+		for _, node := range nodes {
+			if step.recursive {
+				for _, descendant := range descendants(node) {
+					next = append(next, step.children(descendant)...)
+				}
+			} else {
+				next = append(next, step.children(node)...)
+			}
+		}
+		nodes = next
+	}
+	if path.keys {
+		for i := range nodes {
+			nodes[i].value = nodes[i].key
+		}
+	}
+	return nodes
+}
+
+// children returns the children of a node selected by the step.
+func (step jsonPathStep) children(node jsonNode) []jsonNode {
+	switch value := node.value.(type) {
+	case map[string]any:
+		if step.wildcard {
+			keys := make([]string, 0, len(value))
+			for k := range value {
+				keys = append(keys, k)
+			}
+			sort.Strings(keys)
+			children := make([]jsonNode, len(keys))
+			for i, k := range keys {
+				children[i] = jsonNode{key: k, value: value[k]}
+			}
+			return children
+		}
+		if child, ok := value[step.name]; ok && !step.isIndex {
+			return []jsonNode{{key: step.name, value: child}}
+		}
+	case []any:
+		if step.wildcard {
+			children := make([]jsonNode, len(value))
+			for i, child := range value {
+				children[i] = jsonNode{key: strconv.Itoa(i), value: child}
+			}
+			return children
+		}
+		if step.isIndex {
+			index := step.index
+			if index < 0 {
+				index += len(value)
+			}
+			if index >= 0 && index < len(value) {
+				return []jsonNode{{key: strconv.Itoa(index), value: value[index]}}
+			}
+		}
+	}
+	return nil
+}
+
+// descendants returns a node and all its descendants, depth first.
+func descendants(node jsonNode) []jsonNode {
+	nodes := []jsonNode{node}
+	for _, child := range (jsonPathStep{wildcard: true}).children(node) {
+		nodes = append(nodes, descendants(child)...)
+	}
+	return nodes
+}
+
+// jsonFloat coerces a JSON value to a number: numbers as is, numeric strings
+// parsed, booleans as 0 or 1 and, if valueMap is set, strings mapped by it.
+func jsonFloat(value any, valueMap map[string]float64) (float64, error) {
+	switch v := value.(type) {
+	case json.Number:
+		return v.Float64()
+	case bool:
+		if v {
+			return 1, nil
+		}
+		return 0, nil
+	case string:
+		if f, ok := valueMap[v]; ok {
+			return f, nil
+		}
+		return strconv.ParseFloat(strings.TrimSpace(v), 64)
+	case nil:
+		return 0, fmt.Errorf("null value")
+	default:
+		return 0, fmt.Errorf("value of type %T isn't a number", v)
+	}
+}
+
+// jsonString returns a JSON value as a label value.
+func jsonString(value any) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case json.Number:
+		return v.String()
+	case nil:
+		return ""
+	default:
+		data, _ := json.Marshal(v)
+		return string(data)
+	}
+}
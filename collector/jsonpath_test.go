@@ -0,0 +1,103 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collector
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testJSONDocument = `{
+	"name": "app",
+	"pools": [
+		{"name": "db", "size": 10, "tags": ["a", "b"]},
+		{"name": "cache", "size": 4}
+	],
+	"queues": {"jobs": 3, "mail": 1},
+	"nested": {"pools": [{"name": "inner"}]}
+}`
+
+func TestJSONPathEvaluate(t *testing.T) {
+	dec := json.NewDecoder(strings.NewReader(testJSONDocument))
+	dec.UseNumber()
+	var document any
+	require.NoError(t, dec.Decode(&document))
+	root := jsonNode{value: document}
+
+	for expr, expected := range map[string][]string{
+		"$.name":           {"app"},
+		"$['name']":        {"app"},
+		"$.pools[0].name":  {"db"},
+		"$.pools[-1].name": {"cache"},
+		"$.pools[*].size":  {"10", "4"},
+		"$.pools.*.name":   {"db", "cache"},
+		"$.queues.*":       {"3", "1"},
+		"$.queues.*~":      {"jobs", "mail"},
+		"$.pools[*]~":      {"0", "1"},
+		// Keys of objects are ordered.
+		"$..name":            {"app", "inner", "db", "cache"},
+		"$..pools[0].name":   {"db", "inner"},
+		"$.pools[0].tags[1]": {"b"},
+		"$.missing":          nil,
+		"$.pools[5]":         nil,
+		"$.name.child":       nil,
+	} {
+		path, err := compileJSONPath(expr)
+		require.NoError(t, err, expr)
+		var values []string
+		for _, node := range path.evaluate(root, root) {
+			values = append(values, jsonString(node.value))
+		}
+		assert.Equal(t, expected, values, expr)
+	}
+
+	// Relative paths start from the current element.
+	path, err := compileJSONPath("@.name")
+	require.NoError(t, err)
+	element := jsonNode{key: "1", value: document.(map[string]any)["pools"].([]any)[1]}
+	nodes := path.evaluate(root, element)
+	require.Len(t, nodes, 1)
+	assert.Equal(t, "cache", nodes[0].value)
+}
+
+func TestCompileJSONPathErrors(t *testing.T) {
+	for _, expr := range []string{"", "name", "$.", "$.a[", "$[?(@.a)]", "$.a[1:2]", "$a"} {
+		_, err := compileJSONPath(expr)
+		assert.Error(t, err, expr)
+	}
+}
+
+func TestJSONFloat(t *testing.T) {
+	valueMap := map[string]float64{"UP": 1}
+	for value, expected := range map[any]float64{
+		json.Number("1.5"): 1.5,
+		" 2 ":              2,
+		true:               1,
+		false:              0,
+		"UP":               1,
+	} {
+		f, err := jsonFloat(value, valueMap)
+		require.NoError(t, err, value)
+		assert.Equal(t, expected, f, value)
+	}
+	for _, value := range []any{nil, "DOWN", map[string]any{}} {
+		_, err := jsonFloat(value, valueMap)
+		assert.Error(t, err, value)
+	}
+}
@@ -0,0 +1,212 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collector
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+)
+
+// The prefix of the labels declaring collectors without a configuration file,
+// as io.cadvisor/collector.[<name>.]<option>.
+const collectorLabelPrefix = "io.cadvisor/collector."
+
+// The options of the collectors declared by labels.
+const (
+	// The collector type, prometheus or jolokia, prometheus by default.
+	labelOptionType = "type"
+	// The whole configuration in JSON, as in a configuration file, which
+	// the other options override.
+	labelOptionConfig = "config"
+	// The URL of the endpoint, on the container's IP address.
+	labelOptionEndpoint = "endpoint"
+	// The protocol, port and path of an endpoint on the container's IP
+	// address, if the URL isn't given.
+	labelOptionProtocol = "protocol"
+	labelOptionPort     = "port"
+	labelOptionPath     = "path"
+	// The polling interval, as a duration.
+	labelOptionInterval = "interval"
+	// The comma-separated names of the metrics to collect, all by default.
+	labelOptionMetrics = "metrics"
+)
+
+// GetLabelCollectorConfigs returns the configurations of the collectors
+// declared by the labels of a container with the IP address ipAddress, by
+// collector name. The name starts with the collector type. An error lists the
+// collectors whose labels are invalid, which are left out.
+//
+// Anyone able to label a container, e.g. in its image, can declare these
+// collectors, so unlike configuration files they can't read files nor reach
+// other endpoints than the container itself.
+func GetLabelCollectorConfigs(labels map[string]string, ipAddress string) (map[string][]byte, error) {
+	options := map[string]map[string]string{}
+	for k, v := range labels {
+		key, ok := strings.CutPrefix(k, collectorLabelPrefix)
+		if !ok {
+			continue
+		}
+		name, option := "", key
+		if i := strings.LastIndex(key, "."); i >= 0 {
+			name, option = key[:i], key[i+1:]
+		}
+		if options[name] == nil {
+			options[name] = map[string]string{}
+		}
+		options[name][option] = v
+	}
+
+	names := make([]string, 0, len(options))
+	for name := range options {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	configs := map[string][]byte{}
+	var errors []error
+	for _, name := range names {
+		collectorType, config, err := labelCollectorConfig(options[name], ipAddress)
+		if err != nil {
+			errors = append(errors, fmt.Errorf("invalid labels of collector %q: %v", name, err))
+			continue
+		}
+		collectorName := collectorType + "-label"
+		if name != "" {
+			collectorName += "-" + name
+		}
+		configs[collectorName] = config
+	}
+	return configs, compileErrors(errors)
+}
+
+// labelCollectorConfig returns the type and configuration of a collector
+// from its label options.
+func labelCollectorConfig(options map[string]string, ipAddress string) (string, []byte, error) {
+	collectorType := "prometheus"
+	if t, ok := options[labelOptionType]; ok {
+		collectorType = strings.ToLower(t)
+	}
+	if collectorType != "prometheus" && collectorType != "jolokia" {
+		return "", nil, fmt.Errorf("unknown collector type %q", options[labelOptionType])
+	}
+
+	config := map[string]any{}
+	if c, ok := options[labelOptionConfig]; ok {
+		if err := json.Unmarshal([]byte(c), &config); err != nil {
+			return "", nil, fmt.Errorf("invalid config: %v", err)
+		}
+	}
+	for option, value := range options {
+		switch option {
+		case labelOptionType, labelOptionConfig, labelOptionEndpoint, labelOptionProtocol, labelOptionPort, labelOptionPath:
+		case labelOptionInterval:
+			interval, err := time.ParseDuration(value)
+			if err != nil {
+				return "", nil, fmt.Errorf("invalid interval %q: %v", value, err)
+			}
+			config["polling_frequency"] = interval
+		case labelOptionMetrics:
+			if collectorType != "prometheus" {
+				return "", nil, fmt.Errorf("the metrics of a %s collector must be given in its config", collectorType)
+			}
+			var metrics []string
+			for _, m := range strings.Split(value, ",") {
+				if m = strings.TrimSpace(m); m != "" {
+					metrics = append(metrics, m)
+				}
+			}
+			config["metrics_config"] = metrics
+		default:
+			return "", nil, fmt.Errorf("unknown option %q", option)
+		}
+	}
+
+	if endpoint, ok := options[labelOptionEndpoint]; ok {
+		config["endpoint"] = endpoint
+	} else if urlConfig := urlConfigOptions(options); len(urlConfig) > 0 {
+		config["endpoint"] = urlConfig
+	} else if _, ok := config["endpoint"]; !ok {
+		return "", nil, fmt.Errorf("no endpoint")
+	}
+	if collectorType == "jolokia" && config["metrics_config"] == nil {
+		return "", nil, fmt.Errorf("no metrics in the config of the jolokia collector")
+	}
+
+	configFile, err := json.Marshal(config)
+	if err != nil {
+		return "", nil, err
+	}
+	if err := checkLabelConfig(collectorType, configFile, ipAddress); err != nil {
+		return "", nil, err
+	}
+	return collectorType, configFile, nil
+}
+
+// checkLabelConfig refuses the configurations declared by labels which read
+// files or reach other endpoints than the container. The configuration is
+// checked as the collector decodes it, field names being case-insensitive.
+func checkLabelConfig(collectorType string, configFile []byte, ipAddress string) error {
+	var endpoint EndpointConfig
+	var httpConfig HTTPConfig
+	if collectorType == "jolokia" {
+		var config Jolokia
+		if err := json.Unmarshal(configFile, &config); err != nil {
+			return fmt.Errorf("invalid config: %v", err)
+		}
+		endpoint, httpConfig = config.Endpoint, config.HTTPConfig
+	} else {
+		var config Prometheus
+		if err := json.Unmarshal(configFile, &config); err != nil {
+			return fmt.Errorf("invalid config: %v", err)
+		}
+		endpoint, httpConfig = config.Endpoint, config.HTTPConfig
+	}
+
+	if httpConfig.BearerTokenFile != "" || (httpConfig.BasicAuth != nil && httpConfig.BasicAuth.PasswordFile != "") || (httpConfig.TLSConfig != nil && httpConfig.TLSConfig.CAFile != "") {
+		return fmt.Errorf("files can't be read by collectors declared by labels")
+	}
+	if httpConfig.ProxyURL != "" {
+		return fmt.Errorf("collectors declared by labels can't use a proxy")
+	}
+	if endpoint.URL != "" {
+		u, err := url.Parse(endpoint.URL)
+		if err != nil {
+			return fmt.Errorf("invalid endpoint %q: %v", endpoint.URL, err)
+		}
+		if ipAddress == "" || u.Hostname() != ipAddress {
+			return fmt.Errorf("endpoint %q isn't on the IP address %q of the container, give its port and path instead", endpoint.URL, ipAddress)
+		}
+	}
+	return nil
+}
+
+// urlConfigOptions returns the options of an endpoint on the container's IP
+// address, defaulted as in configuration files.
+func urlConfigOptions(options map[string]string) map[string]any {
+	urlConfig := map[string]any{}
+	for option, key := range map[string]string{
+		labelOptionProtocol: "protocol",
+		labelOptionPort:     "port",
+		labelOptionPath:     "path",
+	} {
+		if value, ok := options[option]; ok {
+			urlConfig[key] = value
+		}
+	}
+	return urlConfig
+}
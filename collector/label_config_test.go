@@ -0,0 +1,131 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collector
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	containertest "github.com/google/cadvisor/container/testing"
+)
+
+func TestGetLabelCollectorConfigs(t *testing.T) {
+	configs, err := GetLabelCollectorConfigs(map[string]string{
+		"io.cadvisor/collector.endpoint":      "http://10.0.0.2:9090/metrics",
+		"io.cadvisor/collector.interval":      "30s",
+		"io.cadvisor/collector.metrics":       "up, requests_total",
+		"io.cadvisor/collector.admin.port":    "9100",
+		"io.cadvisor/collector.admin.path":    "/stats",
+		"io.cadvisor/collector.jvm.type":      "jolokia",
+		"io.cadvisor/collector.jvm.config":    `{"endpoint": "http://10.0.0.2:8778/jolokia", "metrics_config": [{"name": "threads", "mbean": "java.lang:type=Threading", "attribute": "ThreadCount"}]}`,
+		"io.cadvisor/collector.jvm.interval":  "1m",
+		"io.cadvisor.metric.prometheus-files": "/var/cadvisor/config.json",
+		"unrelated":                           "label",
+	}, "10.0.0.2")
+	require.NoError(t, err)
+	require.Len(t, configs, 3)
+
+	var prometheus Prometheus
+	require.NoError(t, json.Unmarshal(configs["prometheus-label"], &prometheus))
+	assert.Equal(t, "http://10.0.0.2:9090/metrics", prometheus.Endpoint.URL)
+	assert.Equal(t, 30*time.Second, prometheus.PollingFrequency)
+	assert.Equal(t, []string{"up", "requests_total"}, prometheus.MetricsConfig)
+
+	var admin Prometheus
+	require.NoError(t, json.Unmarshal(configs["prometheus-label-admin"], &admin))
+	assert.Equal(t, URLConfig{Protocol: "http", Port: "9100", Path: "/stats"}, admin.Endpoint.URLConfig)
+
+	var jolokia Jolokia
+	require.NoError(t, json.Unmarshal(configs["jolokia-label-jvm"], &jolokia))
+	assert.Equal(t, "http://10.0.0.2:8778/jolokia", jolokia.Endpoint.URL)
+	assert.Equal(t, time.Minute, jolokia.PollingFrequency)
+	require.Len(t, jolokia.MetricsConfig, 1)
+	assert.Equal(t, "ThreadCount", jolokia.MetricsConfig[0].Attribute)
+
+	containerHandler := containertest.NewMockContainerHandler("mockContainer")
+	_, err = NewPrometheusCollector("prometheus-label", configs["prometheus-label"], 100, containerHandler, http.DefaultClient, nil)
+	assert.NoError(t, err)
+	_, err = NewJolokiaCollector("jolokia-label-jvm", configs["jolokia-label-jvm"], 100, containerHandler, http.DefaultClient, nil)
+	assert.NoError(t, err)
+}
+
+func TestGetLabelCollectorConfigsInvalid(t *testing.T) {
+	configs, err := GetLabelCollectorConfigs(map[string]string{
+		"io.cadvisor/collector.endpoint":      "http://10.0.0.2:9090/metrics",
+		"io.cadvisor/collector.a.interval":    "30s",
+		"io.cadvisor/collector.b.endpoint":    "http://10.0.0.2:9090/metrics",
+		"io.cadvisor/collector.b.type":        "statsd",
+		"io.cadvisor/collector.c.endpoint":    "http://10.0.0.2:9090/metrics",
+		"io.cadvisor/collector.c.interval":    "often",
+		"io.cadvisor/collector.d.endpoint":    "http://10.0.0.2:8778/jolokia",
+		"io.cadvisor/collector.d.type":        "jolokia",
+		"io.cadvisor/collector.e.endpoint":    "http://10.0.0.2:9090/metrics",
+		"io.cadvisor/collector.e.timeout":     "1s",
+		"io.cadvisor/collector.f.config":      "{",
+		"io.cadvisor/collector.g.endpoint":    "http://10.0.0.2:8778/jolokia",
+		"io.cadvisor/collector.g.type":        "jolokia",
+		"io.cadvisor/collector.g.metrics":     "threads",
+		"io.cadvisor.metric.prometheus-files": "/var/cadvisor/config.json",
+	}, "10.0.0.2")
+	assert.Error(t, err)
+	for _, name := range []string{"a", "b", "c", "d", "e", "f", "g"} {
+		assert.Contains(t, err.Error(), `"`+name+`"`)
+	}
+	// The valid collectors are still returned.
+	assert.Len(t, configs, 1)
+	assert.Contains(t, configs, "prometheus-label")
+}
+
+func TestGetLabelCollectorConfigsRestricted(t *testing.T) {
+	configs, err := GetLabelCollectorConfigs(map[string]string{
+		"io.cadvisor/collector.endpoint":   "https://10.0.0.2:9090/metrics",
+		"io.cadvisor/collector.config":     `{"bearer_token": "token", "tls_config": {"ca": "", "server_name": "app"}}`,
+		"io.cadvisor/collector.a.endpoint": "http://10.0.0.3:9090/metrics",
+		"io.cadvisor/collector.b.endpoint": "http://127.0.0.1:10250/metrics",
+		"io.cadvisor/collector.c.config":   `{"endpoint": "https://kubernetes.default.svc/metrics"}`,
+		"io.cadvisor/collector.d.port":     "9090",
+		"io.cadvisor/collector.d.config":   `{"bearer_token_file": "/var/run/secrets/kubernetes.io/serviceaccount/token"}`,
+		"io.cadvisor/collector.e.port":     "9090",
+		"io.cadvisor/collector.e.config":   `{"Bearer_Token_File": "/token"}`,
+		"io.cadvisor/collector.f.port":     "9090",
+		"io.cadvisor/collector.f.config":   `{"basic_auth": {"username": "user", "password_file": "/password"}}`,
+		"io.cadvisor/collector.g.port":     "9090",
+		"io.cadvisor/collector.g.config":   `{"tls_config": {"ca_file": "/etc/ssl/ca.pem"}}`,
+		"io.cadvisor/collector.h.port":     "9090",
+		"io.cadvisor/collector.h.config":   `{"proxy_url": "http://attacker.example.com"}`,
+		"io.cadvisor/collector.i.type":     "jolokia",
+		"io.cadvisor/collector.i.config":   `{"endpoint": {"port": "8778"}, "bearer_token_file": "/token", "metrics_config": [{"name": "threads", "mbean": "java.lang:type=Threading", "attribute": "ThreadCount"}]}`,
+	}, "10.0.0.2")
+	assert.Error(t, err)
+	for _, name := range []string{"a", "b", "c", "d", "e", "f", "g", "h", "i"} {
+		assert.Contains(t, err.Error(), `"`+name+`"`)
+	}
+	assert.Len(t, configs, 1)
+	assert.Contains(t, configs, "prometheus-label")
+
+	// Without an IP address, only the port and path declare an endpoint.
+	configs, err = GetLabelCollectorConfigs(map[string]string{
+		"io.cadvisor/collector.endpoint": "http://10.0.0.2:9090/metrics",
+		"io.cadvisor/collector.a.port":   "9090",
+	}, "")
+	assert.Error(t, err)
+	assert.Len(t, configs, 1)
+	assert.Contains(t, configs, "prometheus-label-a")
+}
@@ -52,8 +52,9 @@ type PrometheusCollector struct {
 	httpClient *http.Client
 }
 
-// Returns a new collector using the information extracted from the configfile
-func NewPrometheusCollector(collectorName string, configFile []byte, metricCountLimit int, containerHandler container.ContainerHandler, httpClient *http.Client) (*PrometheusCollector, error) {
+// Returns a new collector using the information extracted from the configfile, whose
+// files are read with readFile
+func NewPrometheusCollector(collectorName string, configFile []byte, metricCountLimit int, containerHandler container.ContainerHandler, httpClient *http.Client, readFile func(path string) ([]byte, error)) (*PrometheusCollector, error) {
 	var configInJSON Prometheus
 	err := json.Unmarshal(configFile, &configInJSON)
 	if err != nil {
@@ -62,6 +63,11 @@ func NewPrometheusCollector(collectorName string, configFile []byte, metricCount
 
 	configInJSON.Endpoint.configure(containerHandler)
 
+	httpClient, err = configInJSON.HTTPConfig.client(httpClient, readFile)
+	if err != nil {
+		return nil, fmt.Errorf("invalid HTTP config: %v", err)
+	}
+
 	minPollingFrequency := configInJSON.PollingFrequency
 
 	// Minimum supported frequency is 1s
@@ -75,6 +81,12 @@ func NewPrometheusCollector(collectorName string, configFile []byte, metricCount
 		return nil, fmt.Errorf("metric count limit must be greater than or equal to 0")
 	}
 
+	for i := range configInJSON.MetricRelabelConfigs {
+		if err := configInJSON.MetricRelabelConfigs[i].compile(); err != nil {
+			return nil, fmt.Errorf("invalid metric relabel config %d: %v", i, err)
+		}
+	}
+
 	var metricsSet map[string]bool
 	if len(configInJSON.MetricsConfig) > 0 {
 		metricsSet = make(map[string]bool, len(configInJSON.MetricsConfig))
@@ -118,6 +130,7 @@ func (collector *PrometheusCollector) GetSpec() []v1.MetricSpec {
 	dec := expfmt.NewDecoder(response.Body, expfmt.ResponseFormat(response.Header))
 
 	var specs []v1.MetricSpec
+	seen := make(map[string]struct{})
 
 	for {
 		d := rawmodel.MetricFamily{}
@@ -133,12 +146,29 @@ func (collector *PrometheusCollector) GetSpec() []v1.MetricSpec {
 			continue
 		}
 
-		spec := v1.MetricSpec{
-			Name:   name,
-			Type:   metricType(d.GetType()),
-			Format: v1.FloatType,
+		// The relabel rules may rename or drop some of the metrics of the
+		// family only, so the spec lists the names they end up with.
+		for _, m := range d.GetMetric() {
+			metric := model.Metric{model.MetricNameLabel: model.LabelValue(name)}
+			for _, l := range m.GetLabel() {
+				metric[model.LabelName(l.GetName())] = model.LabelValue(l.GetValue())
+			}
+			metric, ok := collector.transform(metric)
+			if !ok {
+				continue
+			}
+			newName := string(metric[model.MetricNameLabel])
+			if _, ok := seen[newName]; ok {
+				continue
+			}
+			seen[newName] = struct{}{}
+			spec := v1.MetricSpec{
+				Name:   newName,
+				Type:   metricType(d.GetType()),
+				Format: v1.FloatType,
+			}
+			specs = append(specs, spec)
 		}
-		specs = append(specs, spec)
 	}
 
 	if err != nil && err != io.EOF {
@@ -148,6 +178,32 @@ func (collector *PrometheusCollector) GetSpec() []v1.MetricSpec {
 	return specs
 }
 
+// transform relabels and renames a scraped metric as configured, and returns
+// false if it is dropped or its new name is invalid.
+func (collector *PrometheusCollector) transform(metric model.Metric) (model.Metric, bool) {
+	config := &collector.configFile
+	if len(config.MetricRelabelConfigs) == 0 && len(config.MetricRename) == 0 && config.MetricPrefix == "" {
+		return metric, true
+	}
+	metric, ok := relabel(metric, config.MetricRelabelConfigs)
+	if !ok {
+		return nil, false
+	}
+	name := string(metric[model.MetricNameLabel])
+	if newName, ok := config.MetricRename[name]; ok {
+		name = newName
+	}
+	name = config.MetricPrefix + name
+	if !model.IsValidMetricName(model.LabelValue(name)) {
+		return nil, false
+	}
+	if len(config.MetricRelabelConfigs) == 0 {
+		metric = metric.Clone()
+	}
+	metric[model.MetricNameLabel] = model.LabelValue(name)
+	return metric, true
+}
+
 // metricType converts Prometheus metric type to cadvisor metric type.
 // If there is no mapping then just return the name of the Prometheus metric type.
 func metricType(t rawmodel.MetricType) v1.MetricType {
@@ -255,16 +311,22 @@ func (collector *PrometheusCollector) Collect(metrics map[string][]v1.MetricVal)
 			if _, ok := collector.metricsSet[metName]; collector.metricsSet != nil && !ok {
 				continue
 			}
+			transformed, ok := collector.transform(sample.Metric)
+			if !ok {
+				continue
+			}
+			metName = string(transformed[model.MetricNameLabel])
 			// TODO Handle multiple labels nicer. Prometheus metrics can have multiple
 			// labels, cadvisor only accepts a single string for the metric label.
-			label := prometheusLabelSetToCadvisorLabel(sample.Metric)
-			labels := prometheusLabelSetToCadvisorLabels(sample.Metric)
+			label := prometheusLabelSetToCadvisorLabel(transformed)
+			labels := prometheusLabelSetToCadvisorLabels(transformed)
 
 			metric := v1.MetricVal{
-				FloatValue: float64(sample.Value),
-				Timestamp:  sample.Timestamp.Time(),
-				Label:      label,
-				Labels:     labels,
+				FloatValue:  float64(sample.Value),
+				Timestamp:   sample.Timestamp.Time(),
+				Label:       label,
+				Labels:      labels,
+				HonorLabels: collector.configFile.HonorLabels,
 			}
 			newMetrics[metName] = append(newMetrics[metName], metric)
 			if len(newMetrics) > collector.metricCountLimit {
@@ -35,7 +35,7 @@ func TestPrometheus(t *testing.T) {
 	configFile, err := os.ReadFile("config/sample_config_prometheus.json")
 	assert.NoError(err)
 	containerHandler := containertest.NewMockContainerHandler("mockContainer")
-	collector, err := NewPrometheusCollector("Prometheus", configFile, 100, containerHandler, http.DefaultClient)
+	collector, err := NewPrometheusCollector("Prometheus", configFile, 100, containerHandler, http.DefaultClient, nil)
 	assert.NoError(err)
 	assert.Equal("Prometheus", collector.name)
 	assert.Equal("http://localhost:8080/metrics", collector.configFile.Endpoint.URL)
@@ -123,7 +123,7 @@ func TestPrometheusEndpointConfig(t *testing.T) {
 		"222.222.222.222",
 	)
 
-	collector, err := NewPrometheusCollector("Prometheus", configFile, 100, containerHandler, http.DefaultClient)
+	collector, err := NewPrometheusCollector("Prometheus", configFile, 100, containerHandler, http.DefaultClient, nil)
 	assert.NoError(err)
 	assert.Equal(collector.name, "Prometheus")
 	assert.Equal(collector.configFile.Endpoint.URL, "http://222.222.222.222:8081/METRICS")
@@ -136,7 +136,7 @@ func TestPrometheusShortResponse(t *testing.T) {
 	configFile, err := os.ReadFile("config/sample_config_prometheus.json")
 	assert.NoError(err)
 	containerHandler := containertest.NewMockContainerHandler("mockContainer")
-	collector, err := NewPrometheusCollector("Prometheus", configFile, 100, containerHandler, http.DefaultClient)
+	collector, err := NewPrometheusCollector("Prometheus", configFile, 100, containerHandler, http.DefaultClient, nil)
 	assert.NoError(err)
 	assert.Equal(collector.name, "Prometheus")
 	assert.Equal(collector.configFile.Endpoint.URL, "http://localhost:8080/metrics")
@@ -160,7 +160,7 @@ func TestPrometheusMetricCountLimit(t *testing.T) {
 	configFile, err := os.ReadFile("config/sample_config_prometheus.json")
 	assert.NoError(err)
 	containerHandler := containertest.NewMockContainerHandler("mockContainer")
-	collector, err := NewPrometheusCollector("Prometheus", configFile, 10, containerHandler, http.DefaultClient)
+	collector, err := NewPrometheusCollector("Prometheus", configFile, 10, containerHandler, http.DefaultClient, nil)
 	assert.NoError(err)
 	assert.Equal(collector.name, "Prometheus")
 	assert.Equal(collector.configFile.Endpoint.URL, "http://localhost:8080/metrics")
@@ -190,7 +190,7 @@ func TestPrometheusFiltersMetrics(t *testing.T) {
 	configFile, err := os.ReadFile("config/sample_config_prometheus_filtered.json")
 	assert.NoError(err)
 	containerHandler := containertest.NewMockContainerHandler("mockContainer")
-	collector, err := NewPrometheusCollector("Prometheus", configFile, 100, containerHandler, http.DefaultClient)
+	collector, err := NewPrometheusCollector("Prometheus", configFile, 100, containerHandler, http.DefaultClient, nil)
 	assert.NoError(err)
 	assert.Equal(collector.name, "Prometheus")
 	assert.Equal(collector.configFile.Endpoint.URL, "http://localhost:8080/metrics")
@@ -230,6 +230,74 @@ func TestPrometheusFiltersMetricsCountLimit(t *testing.T) {
 	configFile, err := os.ReadFile("config/sample_config_prometheus_filtered.json")
 	assert.NoError(err)
 	containerHandler := containertest.NewMockContainerHandler("mockContainer")
-	_, err = NewPrometheusCollector("Prometheus", configFile, 1, containerHandler, http.DefaultClient)
+	_, err = NewPrometheusCollector("Prometheus", configFile, 1, containerHandler, http.DefaultClient, nil)
 	assert.Error(err)
 }
+
+func TestPrometheusRelabelsMetrics(t *testing.T) {
+	assert := assert.New(t)
+
+	// Create a prometheus collector using the config file 'sample_config_prometheus_relabel.json'
+	configFile, err := os.ReadFile("config/sample_config_prometheus_relabel.json")
+	assert.NoError(err)
+	containerHandler := containertest.NewMockContainerHandler("mockContainer")
+	collector, err := NewPrometheusCollector("Prometheus", configFile, 100, containerHandler, http.DefaultClient, nil)
+	require.NoError(t, err)
+
+	tempServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+
+		text := `# HELP go_gc_duration_seconds A summary of the GC invocation durations.
+# TYPE go_gc_duration_seconds summary
+go_gc_duration_seconds{quantile="0"} 5.8348000000000004e-05
+go_gc_duration_seconds_sum 1.7560473e+07
+go_gc_duration_seconds_count 2693
+# HELP go_goroutines Number of goroutines that currently exist.
+# TYPE go_goroutines gauge
+go_goroutines 16
+# HELP http_requests_total Number of HTTP requests.
+# TYPE http_requests_total counter
+http_requests_total{handler="/api/users",code="200"} 7
+http_requests_total{handler="/healthz",code="200"} 3
+`
+		fmt.Fprintln(w, text)
+	}))
+
+	defer tempServer.Close()
+
+	collector.configFile.Endpoint.URL = tempServer.URL
+
+	spec := collector.GetSpec()
+	specNames := make([]string, 0, len(spec))
+	for _, s := range spec {
+		specNames = append(specNames, s.Name)
+	}
+	assert.ElementsMatch([]string{"app_go_goroutines", "app_requests_total"}, specNames)
+
+	metrics := map[string][]v1.MetricVal{}
+	_, metrics, errMetric := collector.Collect(metrics)
+	assert.NoError(errMetric)
+	assert.Len(metrics, 2)
+
+	goRoutines := metrics["app_go_goroutines"]
+	assert.Equal(float64(16), goRoutines[0].FloatValue)
+	assert.True(goRoutines[0].HonorLabels)
+
+	requests := metrics["app_requests_total"]
+	require.Len(t, requests, 2)
+	assert.Equal(map[string]string{"code": "200", "endpoint": "users"}, requests[0].Labels)
+	assert.Equal("__name__=app_requests_total\xffcode=200\xffendpoint=users", requests[0].Label)
+	assert.Equal(map[string]string{"code": "200"}, requests[1].Labels)
+}
+
+func TestPrometheusInvalidRelabelConfig(t *testing.T) {
+	containerHandler := containertest.NewMockContainerHandler("mockContainer")
+	for _, config := range []string{
+		`{"endpoint": "http://localhost:8080/metrics", "metric_relabel_configs": [{"source_labels": ["a"], "regex": "("}]}`,
+		`{"endpoint": "http://localhost:8080/metrics", "metric_relabel_configs": [{"source_labels": ["a"]}]}`,
+		`{"endpoint": "http://localhost:8080/metrics", "metric_relabel_configs": [{"action": "keep"}]}`,
+		`{"endpoint": "http://localhost:8080/metrics", "metric_relabel_configs": [{"action": "unknown"}]}`,
+	} {
+		_, err := NewPrometheusCollector("Prometheus", []byte(config), 100, containerHandler, http.DefaultClient, nil)
+		assert.Error(t, err, config)
+	}
+}
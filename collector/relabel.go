@@ -0,0 +1,158 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collector
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/prometheus/common/model"
+)
+
+// RelabelAction is the action of a relabel rule, following the
+// metric_relabel_configs of Prometheus.
+type RelabelAction string
+
+const (
+	// Sets the target label to the replacement, expanded with the groups the
+	// regex matched in the source labels.
+	RelabelReplace RelabelAction = "replace"
+	// Drops the samples whose source labels don't match the regex.
+	RelabelKeep RelabelAction = "keep"
+	// Drops the samples whose source labels match the regex.
+	RelabelDrop RelabelAction = "drop"
+	// Copies the labels whose names match the regex to the labels named by
+	// the replacement.
+	RelabelLabelMap RelabelAction = "labelmap"
+	// Removes the labels whose names match the regex.
+	RelabelLabelDrop RelabelAction = "labeldrop"
+	// Removes the labels whose names don't match the regex.
+	RelabelLabelKeep RelabelAction = "labelkeep"
+)
+
+// RelabelConfig is a rule relabeling the scraped samples. The name of a sample
+// is its __name__ label.
+type RelabelConfig struct {
+	// The labels whose values, joined by the separator, are matched.
+	SourceLabels []string `json:"source_labels"`
+	// Defaults to ";".
+	Separator *string `json:"separator"`
+	// The regular expression matching the whole value. Defaults to "(.*)".
+	Regex string `json:"regex"`
+	// The label set by the replace action.
+	TargetLabel string `json:"target_label"`
+	// Defaults to "$1".
+	Replacement *string `json:"replacement"`
+	// Defaults to replace.
+	Action RelabelAction `json:"action"`
+
+	regex *regexp.Regexp
+}
+
+// compile validates the rule and sets its defaults.
+func (c *RelabelConfig) compile() error {
+	if c.Separator == nil {
+		separator := ";"
+		c.Separator = &separator
+	}
+	if c.Regex == "" {
+		c.Regex = "(.*)"
+	}
+	if c.Replacement == nil {
+		replacement := "$1"
+		c.Replacement = &replacement
+	}
+	if c.Action == "" {
+		c.Action = RelabelReplace
+	}
+	regex, err := regexp.Compile("^(?:" + c.Regex + ")$")
+	if err != nil {
+		return fmt.Errorf("invalid regex %q: %v", c.Regex, err)
+	}
+	c.regex = regex
+
+	switch c.Action {
+	case RelabelReplace:
+		if c.TargetLabel == "" {
+			return fmt.Errorf("the replace action requires a target label")
+		}
+	case RelabelKeep, RelabelDrop:
+		if len(c.SourceLabels) == 0 {
+			return fmt.Errorf("the %s action requires source labels", c.Action)
+		}
+	case RelabelLabelMap, RelabelLabelDrop, RelabelLabelKeep:
+	default:
+		return fmt.Errorf("unknown action %q", c.Action)
+	}
+	return nil
+}
+
+// relabel applies the compiled rules to metric in order and returns the
+// relabeled metric, or false if it is dropped. metric isn't modified. The
+// labeldrop and labelkeep actions never remove the name.
+func relabel(metric model.Metric, configs []RelabelConfig) (model.Metric, bool) {
+	if len(configs) == 0 {
+		return metric, true
+	}
+	metric = metric.Clone()
+	for _, c := range configs {
+		values := make([]string, 0, len(c.SourceLabels))
+		for _, label := range c.SourceLabels {
+			values = append(values, string(metric[model.LabelName(label)]))
+		}
+		value := strings.Join(values, *c.Separator)
+
+		switch c.Action {
+		case RelabelKeep:
+			if !c.regex.MatchString(value) {
+				return nil, false
+			}
+		case RelabelDrop:
+			if c.regex.MatchString(value) {
+				return nil, false
+			}
+		case RelabelReplace:
+			match := c.regex.FindStringSubmatchIndex(value)
+			if match == nil {
+				continue
+			}
+			target := model.LabelName(c.regex.ExpandString(nil, c.TargetLabel, value, match))
+			replacement := c.regex.ExpandString(nil, *c.Replacement, value, match)
+			if len(replacement) == 0 {
+				delete(metric, target)
+			} else {
+				metric[target] = model.LabelValue(replacement)
+			}
+		case RelabelLabelMap:
+			mapped := model.Metric{}
+			for name, v := range metric {
+				if c.regex.MatchString(string(name)) {
+					mapped[model.LabelName(c.regex.ReplaceAllString(string(name), *c.Replacement))] = v
+				}
+			}
+			for name, v := range mapped {
+				metric[name] = v
+			}
+		case RelabelLabelDrop, RelabelLabelKeep:
+			for name := range metric {
+				if name != model.MetricNameLabel && c.regex.MatchString(string(name)) == (c.Action == RelabelLabelDrop) {
+					delete(metric, name)
+				}
+			}
+		}
+	}
+	return metric, metric[model.MetricNameLabel] != ""
+}
@@ -0,0 +1,102 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collector
+
+import (
+	"testing"
+
+	"github.com/prometheus/common/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func compileRelabelConfigs(t *testing.T, configs ...RelabelConfig) []RelabelConfig {
+	for i := range configs {
+		require.NoError(t, configs[i].compile())
+	}
+	return configs
+}
+
+func stringPtr(s string) *string {
+	return &s
+}
+
+func TestRelabel(t *testing.T) {
+	metric := model.Metric{"__name__": "requests_total", "method": "GET", "path": "/api/users", "code": "200"}
+
+	for _, tc := range []struct {
+		name     string
+		configs  []RelabelConfig
+		expected model.Metric
+	}{
+		{
+			name:     "no rules",
+			expected: metric,
+		},
+		{
+			name:     "keep",
+			configs:  []RelabelConfig{{SourceLabels: []string{"method"}, Regex: "GET|POST", Action: RelabelKeep}},
+			expected: metric,
+		},
+		{
+			name:    "keep not matching",
+			configs: []RelabelConfig{{SourceLabels: []string{"method"}, Regex: "POST", Action: RelabelKeep}},
+		},
+		{
+			name:    "drop joined labels",
+			configs: []RelabelConfig{{SourceLabels: []string{"method", "code"}, Regex: "GET;2..", Action: RelabelDrop}},
+		},
+		{
+			name:     "replace",
+			configs:  []RelabelConfig{{SourceLabels: []string{"path"}, Regex: "/api/(.*)", TargetLabel: "resource"}},
+			expected: model.Metric{"__name__": "requests_total", "method": "GET", "path": "/api/users", "code": "200", "resource": "users"},
+		},
+		{
+			name:     "replace removes empty label",
+			configs:  []RelabelConfig{{SourceLabels: []string{"path"}, TargetLabel: "code", Replacement: stringPtr("")}},
+			expected: model.Metric{"__name__": "requests_total", "method": "GET", "path": "/api/users"},
+		},
+		{
+			name:     "rename",
+			configs:  []RelabelConfig{{SourceLabels: []string{"__name__"}, Regex: "(.*)_total", TargetLabel: "__name__", Replacement: stringPtr("${1}_count")}},
+			expected: model.Metric{"__name__": "requests_count", "method": "GET", "path": "/api/users", "code": "200"},
+		},
+		{
+			name:     "labelmap",
+			configs:  []RelabelConfig{{Regex: "(method|code)", Replacement: stringPtr("http_$1"), Action: RelabelLabelMap}},
+			expected: model.Metric{"__name__": "requests_total", "method": "GET", "path": "/api/users", "code": "200", "http_method": "GET", "http_code": "200"},
+		},
+		{
+			name:     "labeldrop",
+			configs:  []RelabelConfig{{Regex: "path|code", Action: RelabelLabelDrop}},
+			expected: model.Metric{"__name__": "requests_total", "method": "GET"},
+		},
+		{
+			name:     "labelkeep keeps the name",
+			configs:  []RelabelConfig{{Regex: "code", Action: RelabelLabelKeep}},
+			expected: model.Metric{"__name__": "requests_total", "code": "200"},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			relabeled, ok := relabel(metric, compileRelabelConfigs(t, tc.configs...))
+			assert.Equal(t, tc.expected != nil, ok)
+			if ok {
+				assert.Equal(t, tc.expected, relabeled)
+			}
+		})
+	}
+	// The metric isn't modified.
+	assert.Len(t, metric, 4)
+}
@@ -0,0 +1,367 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collector
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	v1 "github.com/google/cadvisor/info/v1"
+
+	"k8s.io/klog/v2"
+)
+
+// The largest StatsD datagram read.
+const statsdMaxPacketSize = 65535
+
+// Characters replaced by underscores in the names of StatsD metrics.
+var statsdInvalidNameChars = regexp.MustCompile(`[^a-zA-Z0-9_:]`)
+
+// StatsdConfig configures a StatsdListener.
+type StatsdConfig struct {
+	// The UDP address to listen on, empty not to.
+	UDPAddress string
+	// The path of the Unix datagram socket to listen on, empty not to. The
+	// sender of a metric is known on Linux.
+	UnixSocket string
+	// The tag naming the container of a metric, which is removed from its
+	// labels.
+	ContainerTag string
+	// Limit for the number of metrics kept per container.
+	MetricCountLimit int
+
+	// ContainerOfName returns the container of a name or alias given by the
+	// container tag.
+	ContainerOfName func(name string) (string, bool)
+	// ContainerOfPid returns the container of the process which sent a
+	// metric without the container tag.
+	ContainerOfPid func(pid int) (string, bool)
+}
+
+// StatsdListener receives StatsD metrics and keeps them by container until
+// they are collected by the StatsdCollector of the container. Counters,
+// timers and histograms are accumulated from the start of the listener,
+// gauges keep the last value and sets count the unique values received
+// between collections.
+type StatsdListener struct {
+	config StatsdConfig
+	conns  []net.PacketConn
+
+	lock       sync.Mutex
+	containers map[string]map[string]*statsdSeries
+}
+
+// A StatsD metric of a container, by name and tags.
+type statsdSeries struct {
+	name       string
+	metricType v1.MetricType
+	labels     map[string]string
+	value      float64
+	// The unique values of a set since the last collection.
+	set map[string]struct{}
+}
+
+// NewStatsdListener returns a listener configured by config.
+func NewStatsdListener(config StatsdConfig) (*StatsdListener, error) {
+	if config.UDPAddress == "" && config.UnixSocket == "" {
+		return nil, fmt.Errorf("a UDP address or a Unix socket is required")
+	}
+	if config.ContainerTag == "" {
+		config.ContainerTag = "container"
+	}
+	return &StatsdListener{
+		config:     config,
+		containers: make(map[string]map[string]*statsdSeries),
+	}, nil
+}
+
+// Start listens on the configured sockets.
+func (l *StatsdListener) Start() error {
+	if l.config.UDPAddress != "" {
+		conn, err := net.ListenPacket("udp", l.config.UDPAddress)
+		if err != nil {
+			return fmt.Errorf("failed to listen on %q: %v", l.config.UDPAddress, err)
+		}
+		l.conns = append(l.conns, conn)
+		go l.serve(conn, func(buf []byte) (int, int, error) {
+			n, _, err := conn.ReadFrom(buf)
+			return n, 0, err
+		})
+	}
+	if l.config.UnixSocket != "" {
+		conn, err := listenUnixgram(l.config.UnixSocket)
+		if err != nil {
+			l.Stop()
+			return fmt.Errorf("failed to listen on %q: %v", l.config.UnixSocket, err)
+		}
+		l.conns = append(l.conns, conn)
+		oob := make([]byte, 1024)
+		go l.serve(conn, func(buf []byte) (int, int, error) {
+			return readUnixgram(conn, buf, oob)
+		})
+	}
+	return nil
+}
+
+// Stop closes the sockets.
+func (l *StatsdListener) Stop() {
+	for _, conn := range l.conns {
+		conn.Close()
+	}
+	l.conns = nil
+}
+
+// serve handles the datagrams returned by read, with the pid of their sender
+// if known, until the socket is closed.
+func (l *StatsdListener) serve(conn net.PacketConn, read func(buf []byte) (int, int, error)) {
+	buf := make([]byte, statsdMaxPacketSize)
+	for {
+		n, pid, err := read(buf)
+		if err != nil {
+			if errors.Is(err, net.ErrClosed) {
+				return
+			}
+			klog.V(4).Infof("Failed to read StatsD metrics on %s: %v", conn.LocalAddr(), err)
+			continue
+		}
+		l.handlePacket(string(buf[:n]), pid)
+	}
+}
+
+// handlePacket adds the metrics of a datagram, one per line.
+func (l *StatsdListener) handlePacket(packet string, pid int) {
+	for _, line := range strings.Split(packet, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		if err := l.handleLine(line, pid); err != nil {
+			klog.V(4).Infof("Dropping StatsD metric %q: %v", line, err)
+		}
+	}
+}
+
+// handleLine adds a metric in the StatsD format extended with DogStatsD tags:
+// <name>:<value>|<type>[|@<sample rate>][|#<tag>[:<value>],...].
+func (l *StatsdListener) handleLine(line string, pid int) error {
+	name, rest, ok := strings.Cut(line, ":")
+	if !ok || name == "" {
+		return fmt.Errorf("no name")
+	}
+	fields := strings.Split(rest, "|")
+	if len(fields) < 2 {
+		return fmt.Errorf("no type")
+	}
+	rawValue, metricType := fields[0], fields[1]
+	sampleRate := 1.0
+	labels := map[string]string{}
+	for _, field := range fields[2:] {
+		switch {
+		case strings.HasPrefix(field, "@"):
+			rate, err := strconv.ParseFloat(field[1:], 64)
+			if err != nil || rate <= 0 || rate > 1 {
+				return fmt.Errorf("invalid sample rate %q", field[1:])
+			}
+			sampleRate = rate
+		case strings.HasPrefix(field, "#"):
+			for _, tag := range strings.Split(field[1:], ",") {
+				key, value, _ := strings.Cut(tag, ":")
+				if key != "" {
+					labels[key] = value
+				}
+			}
+		}
+	}
+
+	var containerName string
+	if tag, ok := labels[l.config.ContainerTag]; ok {
+		delete(labels, l.config.ContainerTag)
+		containerName, ok = l.config.ContainerOfName(tag)
+		if !ok {
+			return fmt.Errorf("unknown container %q", tag)
+		}
+	} else if pid > 0 {
+		containerName, ok = l.config.ContainerOfPid(pid)
+		if !ok {
+			return fmt.Errorf("no container for pid %d", pid)
+		}
+	} else {
+		return fmt.Errorf("no %q tag", l.config.ContainerTag)
+	}
+
+	name = statsdInvalidNameChars.ReplaceAllString(name, "_")
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	switch metricType {
+	case "c":
+		value, err := strconv.ParseFloat(rawValue, 64)
+		if err != nil {
+			return err
+		}
+		series, err := l.series(containerName, name, v1.MetricCumulative, labels)
+		if err != nil {
+			return err
+		}
+		series.value += value / sampleRate
+	case "g":
+		value, err := strconv.ParseFloat(rawValue, 64)
+		if err != nil {
+			return err
+		}
+		series, err := l.series(containerName, name, v1.MetricGauge, labels)
+		if err != nil {
+			return err
+		}
+		// A signed value changes the gauge rather than setting it.
+		if strings.HasPrefix(rawValue, "+") || strings.HasPrefix(rawValue, "-") {
+			series.value += value
+		} else {
+			series.value = value
+		}
+	case "ms", "h", "d":
+		value, err := strconv.ParseFloat(rawValue, 64)
+		if err != nil {
+			return err
+		}
+		count, err := l.series(containerName, name+"_count", v1.MetricCumulative, labels)
+		if err != nil {
+			return err
+		}
+		sum, err := l.series(containerName, name+"_sum", v1.MetricCumulative, labels)
+		if err != nil {
+			return err
+		}
+		count.value += 1 / sampleRate
+		sum.value += value / sampleRate
+	case "s":
+		series, err := l.series(containerName, name, v1.MetricGauge, labels)
+		if err != nil {
+			return err
+		}
+		if series.set == nil {
+			series.set = make(map[string]struct{})
+		}
+		series.set[rawValue] = struct{}{}
+	default:
+		return fmt.Errorf("unknown type %q", metricType)
+	}
+	return nil
+}
+
+// series returns the series of a container, added if new. A name keeps the
+// type it was first received with.
+func (l *StatsdListener) series(containerName, name string, metricType v1.MetricType, labels map[string]string) (*statsdSeries, error) {
+	containerSeries, ok := l.containers[containerName]
+	if !ok {
+		containerSeries = make(map[string]*statsdSeries)
+		l.containers[containerName] = containerSeries
+	}
+	key := name + "\xff" + labelsToCadvisorLabel(labels)
+	if series, ok := containerSeries[key]; ok {
+		if series.metricType != metricType {
+			return nil, fmt.Errorf("metric %q is a %s", name, series.metricType)
+		}
+		return series, nil
+	}
+	if len(containerSeries) >= l.config.MetricCountLimit {
+		return nil, fmt.Errorf("too many metrics for container %q", containerName)
+	}
+	series := &statsdSeries{
+		name:       name,
+		metricType: metricType,
+		labels:     labels,
+	}
+	containerSeries[key] = series
+	return series, nil
+}
+
+// Forget drops the metrics of a container.
+func (l *StatsdListener) Forget(containerName string) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	delete(l.containers, containerName)
+}
+
+// Collector returns the collector of the metrics of a container.
+func (l *StatsdListener) Collector(containerName string) *StatsdCollector {
+	return &StatsdCollector{listener: l, containerName: containerName}
+}
+
+// StatsdCollector collects the StatsD metrics of a container received by a
+// StatsdListener.
+type StatsdCollector struct {
+	listener      *StatsdListener
+	containerName string
+}
+
+// Returns name of the collector
+func (collector *StatsdCollector) Name() string {
+	return "statsd"
+}
+
+// GetSpec returns the specs of the metrics received so far.
+func (collector *StatsdCollector) GetSpec() []v1.MetricSpec {
+	l := collector.listener
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	types := make(map[string]v1.MetricType)
+	for _, series := range l.containers[collector.containerName] {
+		types[series.name] = series.metricType
+	}
+	specs := make([]v1.MetricSpec, 0, len(types))
+	for name, metricType := range types {
+		specs = append(specs, v1.MetricSpec{
+			Name:   name,
+			Type:   metricType,
+			Format: v1.FloatType,
+		})
+	}
+	sort.Slice(specs, func(i, j int) bool { return specs[i].Name < specs[j].Name })
+	return specs
+}
+
+// Returns collected metrics and the next collection time of the collector
+func (collector *StatsdCollector) Collect(metrics map[string][]v1.MetricVal) (time.Time, map[string][]v1.MetricVal, error) {
+	currentTime := time.Now()
+	l := collector.listener
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	for _, series := range l.containers[collector.containerName] {
+		value := series.value
+		if series.set != nil {
+			value = float64(len(series.set))
+			series.set = make(map[string]struct{})
+		}
+		labels := make(map[string]string, len(series.labels))
+		for k, v := range series.labels {
+			labels[k] = v
+		}
+		metrics[series.name] = append(metrics[series.name], v1.MetricVal{
+			FloatValue: value,
+			Timestamp:  currentTime,
+			Label:      labelsToCadvisorLabel(labels),
+			Labels:     labels,
+		})
+	}
+	// The metrics are received continuously and read on every housekeeping.
+	return currentTime, metrics, nil
+}
@@ -0,0 +1,77 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package collector
+
+import (
+	"net"
+	"os"
+
+	"golang.org/x/sys/unix"
+)
+
+// listenUnixgram listens on a Unix datagram socket passing the credentials of
+// the senders, replacing a stale socket.
+func listenUnixgram(path string) (*net.UnixConn, error) {
+	if fi, err := os.Lstat(path); err == nil && fi.Mode()&os.ModeSocket != 0 {
+		os.Remove(path)
+	}
+	conn, err := net.ListenUnixgram("unixgram", &net.UnixAddr{Name: path, Net: "unixgram"})
+	if err != nil {
+		return nil, err
+	}
+	// The socket is mounted in the containers, whose users may differ.
+	if err := os.Chmod(path, 0o666); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	raw, err := conn.SyscallConn()
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	var sockErr error
+	err = raw.Control(func(fd uintptr) {
+		sockErr = unix.SetsockoptInt(int(fd), unix.SOL_SOCKET, unix.SO_PASSCRED, 1)
+	})
+	if err == nil {
+		err = sockErr
+	}
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return conn, nil
+}
+
+// readUnixgram reads a datagram and returns its size and the pid of its
+// sender, 0 if unknown.
+func readUnixgram(conn *net.UnixConn, buf, oob []byte) (int, int, error) {
+	n, oobn, _, _, err := conn.ReadMsgUnix(buf, oob)
+	if err != nil {
+		return 0, 0, err
+	}
+	pid := 0
+	messages, err := unix.ParseSocketControlMessage(oob[:oobn])
+	if err == nil {
+		for i := range messages {
+			if cred, err := unix.ParseUnixCredentials(&messages[i]); err == nil {
+				pid = int(cred.Pid)
+			}
+		}
+	}
+	return n, pid, nil
+}
@@ -0,0 +1,154 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collector
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/google/cadvisor/info/v1"
+)
+
+func newTestStatsdListener(t *testing.T, metricCountLimit int) *StatsdListener {
+	listener, err := NewStatsdListener(StatsdConfig{
+		UDPAddress:       "127.0.0.1:0",
+		MetricCountLimit: metricCountLimit,
+		ContainerOfName: func(name string) (string, bool) {
+			if name == "app" {
+				return "/docker/app", true
+			}
+			return "", false
+		},
+		ContainerOfPid: func(pid int) (string, bool) {
+			if pid == 42 {
+				return "/docker/other", true
+			}
+			return "", false
+		},
+	})
+	require.NoError(t, err)
+	return listener
+}
+
+func collectStatsd(t *testing.T, listener *StatsdListener, containerName string) map[string][]v1.MetricVal {
+	_, metrics, err := listener.Collector(containerName).Collect(map[string][]v1.MetricVal{})
+	require.NoError(t, err)
+	return metrics
+}
+
+func TestStatsdAggregatesMetrics(t *testing.T) {
+	listener := newTestStatsdListener(t, 100)
+	listener.handlePacket("requests:1|c|#container:app\n"+
+		"requests:2|c|@0.5|#container:app\n"+
+		"queue.size:10|g|#container:app\n"+
+		"queue.size:-3|g|#container:app\n"+
+		"latency:20|ms|#container:app,route:/\n"+
+		"latency:30|ms|#container:app,route:/\n"+
+		"users:alice|s|#container:app\n"+
+		"users:bob|s|#container:app\n"+
+		"users:alice|s|#container:app", 0)
+
+	metrics := collectStatsd(t, listener, "/docker/app")
+	require.Len(t, metrics["requests"], 1)
+	assert.Equal(t, 5.0, metrics["requests"][0].FloatValue)
+	assert.Equal(t, 7.0, metrics["queue_size"][0].FloatValue)
+	assert.Equal(t, 2.0, metrics["latency_count"][0].FloatValue)
+	assert.Equal(t, 50.0, metrics["latency_sum"][0].FloatValue)
+	assert.Equal(t, map[string]string{"route": "/"}, metrics["latency_sum"][0].Labels)
+	assert.Equal(t, "route=/", metrics["latency_sum"][0].Label)
+	assert.Equal(t, 2.0, metrics["users"][0].FloatValue)
+	assert.Empty(t, metrics["requests"][0].Labels)
+
+	// Counters accumulate and sets are reset by collections.
+	listener.handlePacket("requests:1|c|#container:app", 0)
+	metrics = collectStatsd(t, listener, "/docker/app")
+	assert.Equal(t, 6.0, metrics["requests"][0].FloatValue)
+	assert.Equal(t, 0.0, metrics["users"][0].FloatValue)
+
+	specs := listener.Collector("/docker/app").GetSpec()
+	require.Len(t, specs, 5)
+	assert.Equal(t, v1.MetricSpec{Name: "latency_count", Type: v1.MetricCumulative, Format: v1.FloatType}, specs[0])
+	assert.Equal(t, v1.MetricSpec{Name: "queue_size", Type: v1.MetricGauge, Format: v1.FloatType}, specs[2])
+}
+
+func TestStatsdAttributesMetrics(t *testing.T) {
+	listener := newTestStatsdListener(t, 100)
+	// The tag takes precedence over the sender.
+	listener.handlePacket("a:1|c|#container:app", 42)
+	listener.handlePacket("b:1|c", 42)
+	// Dropped, with no known container.
+	listener.handlePacket("c:1|c", 0)
+	listener.handlePacket("d:1|c|#container:unknown", 0)
+
+	assert.Contains(t, collectStatsd(t, listener, "/docker/app"), "a")
+	other := collectStatsd(t, listener, "/docker/other")
+	assert.Contains(t, other, "b")
+	assert.Len(t, other, 1)
+
+	listener.Forget("/docker/app")
+	assert.Empty(t, collectStatsd(t, listener, "/docker/app"))
+}
+
+func TestStatsdRejectsInvalidMetrics(t *testing.T) {
+	listener := newTestStatsdListener(t, 100)
+	for _, line := range []string{
+		"nameonly",
+		":1|c|#container:app",
+		"a:1|#container:app",
+		"a:x|c|#container:app",
+		"a:1|c|@2|#container:app",
+		"a:1|q|#container:app",
+	} {
+		assert.Error(t, listener.handleLine(line, 0), line)
+	}
+	require.NoError(t, listener.handleLine("a:1|c|#container:app", 0))
+	// A name keeps its type.
+	assert.Error(t, listener.handleLine("a:1|g|#container:app", 0))
+}
+
+func TestStatsdMetricCountLimit(t *testing.T) {
+	listener := newTestStatsdListener(t, 2)
+	listener.handlePacket("a:1|c|#container:app\nb:1|c|#container:app\nc:1|c|#container:app\na:1|c|#container:app,k:v", 0)
+	metrics := collectStatsd(t, listener, "/docker/app")
+	assert.Len(t, metrics, 2)
+	assert.Len(t, metrics["a"], 1)
+	assert.NotContains(t, metrics, "c")
+}
+
+func TestStatsdListenerReceivesUDP(t *testing.T) {
+	listener := newTestStatsdListener(t, 100)
+	require.NoError(t, listener.Start())
+	defer listener.Stop()
+
+	conn, err := net.Dial("udp", listener.conns[0].LocalAddr().String())
+	require.NoError(t, err)
+	defer conn.Close()
+	_, err = conn.Write([]byte("hits:3|c|#container:app"))
+	require.NoError(t, err)
+
+	assert.Eventually(t, func() bool {
+		metrics := collectStatsd(t, listener, "/docker/app")
+		return len(metrics["hits"]) == 1 && metrics["hits"][0].FloatValue == 3
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestNewStatsdListenerRequiresSocket(t *testing.T) {
+	_, err := NewStatsdListener(StatsdConfig{})
+	assert.Error(t, err)
+}
@@ -0,0 +1,32 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !linux
+
+package collector
+
+import (
+	"net"
+)
+
+// listenUnixgram listens on a Unix datagram socket. The senders aren't known.
+func listenUnixgram(path string) (*net.UnixConn, error) {
+	return net.ListenUnixgram("unixgram", &net.UnixAddr{Name: path, Net: "unixgram"})
+}
+
+// readUnixgram reads a datagram and returns its size.
+func readUnixgram(conn *net.UnixConn, buf, oob []byte) (int, int, error) {
+	n, _, err := conn.ReadFromUnix(buf)
+	return n, 0, err
+}
@@ -0,0 +1,41 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package common
+
+import (
+	"flag"
+	"fmt"
+
+	"github.com/opencontainers/cgroups"
+)
+
+var disableCgroupV1 = flag.Bool("disable_cgroup_v1", !CgroupV1Supported, "Refuse to start on hosts using the legacy cgroup v1 hierarchy, so that fleets meant to be on the unified hierarchy only do not silently run on cgroup v1. Always true in binaries built with the cgroupv2only tag")
+
+// CheckCgroupMode returns an error if the host uses cgroup v1 while cgroup
+// v1 is disabled or not compiled in.
+func CheckCgroupMode() error {
+	if cgroups.IsCgroup2UnifiedMode() {
+		return nil
+	}
+	if !CgroupV1Supported {
+		return fmt.Errorf("the host uses cgroup v1, which this cAdvisor binary is built without (cgroupv2only build tag)")
+	}
+	if *disableCgroupV1 {
+		return fmt.Errorf("the host uses cgroup v1, which --disable_cgroup_v1 refuses")
+	}
+	return nil
+}
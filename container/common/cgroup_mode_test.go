@@ -0,0 +1,38 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package common
+
+import (
+	"testing"
+
+	"github.com/opencontainers/cgroups"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckCgroupMode(t *testing.T) {
+	if cgroups.IsCgroup2UnifiedMode() {
+		assert.NoError(t, CheckCgroupMode())
+		return
+	}
+	defer func(disable bool) { *disableCgroupV1 = disable }(*disableCgroupV1)
+
+	*disableCgroupV1 = true
+	assert.Error(t, CheckCgroupMode())
+
+	*disableCgroupV1 = false
+	assert.Equal(t, CgroupV1Supported, CheckCgroupMode() == nil)
+}
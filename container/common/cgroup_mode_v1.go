@@ -0,0 +1,31 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux && !cgroupv2only
+
+package common
+
+import (
+	"github.com/opencontainers/cgroups"
+)
+
+// CgroupV1Supported is whether the cgroup v1 code paths are compiled in,
+// which the cgroupv2only build tag leaves out.
+const CgroupV1Supported = true
+
+// IsCgroup2UnifiedMode returns whether the host uses the unified cgroup v2
+// hierarchy.
+func IsCgroup2UnifiedMode() bool {
+	return cgroups.IsCgroup2UnifiedMode()
+}
@@ -0,0 +1,28 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux && cgroupv2only
+
+package common
+
+// CgroupV1Supported is whether the cgroup v1 code paths are compiled in,
+// which the cgroupv2only build tag leaves out.
+const CgroupV1Supported = false
+
+// IsCgroup2UnifiedMode returns true: binaries built with the cgroupv2only
+// tag only run on the unified hierarchy, which CheckCgroupMode checks at
+// startup. Being a constant, the cgroup v1 branches are compiled out.
+func IsCgroup2UnifiedMode() bool {
+	return true
+}
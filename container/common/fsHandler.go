@@ -18,11 +18,13 @@
 package common
 
 import (
+	"context"
 	"fmt"
 	"sync"
 	"time"
 
 	"github.com/google/cadvisor/fs"
+	info "github.com/google/cadvisor/info/v1"
 
 	"k8s.io/klog/v2"
 )
@@ -50,6 +52,9 @@ type realFsHandler struct {
 	fsInfo     fs.FsInfo
 	// Tells the container to stop.
 	stopChan chan struct{}
+	// Cancels the scan running when the container stops.
+	ctx    context.Context
+	cancel context.CancelFunc
 }
 
 const (
@@ -61,6 +66,7 @@ const DefaultPeriod = time.Minute
 var _ FsHandler = &realFsHandler{}
 
 func NewFsHandler(period time.Duration, rootfs, extraDir string, fsInfo fs.FsInfo) FsHandler {
+	ctx, cancel := context.WithCancel(context.Background())
 	return &realFsHandler{
 		lastUpdate: time.Time{},
 		usage:      FsUsage{},
@@ -70,6 +76,8 @@ func NewFsHandler(period time.Duration, rootfs, extraDir string, fsInfo fs.FsInf
 		extraDir:   extraDir,
 		fsInfo:     fsInfo,
 		stopChan:   make(chan struct{}, 1),
+		ctx:        ctx,
+		cancel:     cancel,
 	}
 }
 
@@ -80,11 +88,11 @@ func (fh *realFsHandler) update() error {
 	)
 	// TODO(vishh): Add support for external mounts.
 	if fh.rootfs != "" {
-		rootUsage, rootErr = fh.fsInfo.GetDirUsage(fh.rootfs)
+		rootUsage, rootErr = fh.fsInfo.GetDirUsage(fh.ctx, fh.rootfs)
 	}
 
 	if fh.extraDir != "" {
-		extraUsage, extraErr = fh.fsInfo.GetDirUsage(fh.extraDir)
+		extraUsage, extraErr = fh.fsInfo.GetDirUsage(fh.ctx, fh.extraDir)
 	}
 
 	// Wait to handle errors until after all operartions are run.
@@ -117,7 +125,11 @@ func (fh *realFsHandler) trackUsage() {
 	longOp := time.Second
 	for {
 		start := time.Now()
-		if err := fh.update(); err != nil {
+		err := fh.update()
+		if fh.ctx.Err() != nil {
+			return
+		}
+		if err != nil {
 			klog.Errorf("failed to collect filesystem stats - %v", err)
 			fh.period = fh.period * 2
 			if fh.period > maxBackoffFactor*fh.minPeriod {
@@ -147,6 +159,7 @@ func (fh *realFsHandler) Start() {
 }
 
 func (fh *realFsHandler) Stop() {
+	fh.cancel()
 	close(fh.stopChan)
 }
 
@@ -155,3 +168,18 @@ func (fh *realFsHandler) Usage() FsUsage {
 	defer fh.RUnlock()
 	return fh.usage
 }
+
+// AssignFsInodes sets the inodes of the filesystem of stats from the
+// filesystem of the same device, and the inodes used by the container to the
+// inode count of its usage.
+func AssignFsInodes(fileSystems []fs.Fs, usage FsUsage, stats *info.FsStats) {
+	stats.InodesUsed = usage.InodeUsage
+	for _, fileSys := range fileSystems {
+		if fileSys.Device == stats.Device && fileSys.Inodes != nil && fileSys.InodesFree != nil {
+			stats.HasInodes = true
+			stats.InodesTotal = *fileSys.Inodes
+			stats.InodesFree = *fileSys.InodesFree
+			return
+		}
+	}
+}
@@ -0,0 +1,43 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/google/cadvisor/fs"
+	info "github.com/google/cadvisor/info/v1"
+)
+
+func TestAssignFsInodes(t *testing.T) {
+	inodes, inodesFree := uint64(1000), uint64(10)
+	fileSystems := []fs.Fs{
+		{DeviceInfo: fs.DeviceInfo{Device: "/dev/sda1"}},
+		{DeviceInfo: fs.DeviceInfo{Device: "/dev/sdb1"}, Inodes: &inodes, InodesFree: &inodesFree},
+	}
+	usage := FsUsage{InodeUsage: 42}
+
+	stats := info.FsStats{Device: "/dev/sdb1"}
+	AssignFsInodes(fileSystems, usage, &stats)
+	assert.Equal(t, info.FsStats{Device: "/dev/sdb1", HasInodes: true, InodesUsed: 42, InodesTotal: 1000, InodesFree: 10}, stats)
+
+	stats = info.FsStats{Device: "/dev/sda1"}
+	AssignFsInodes(fileSystems, usage, &stats)
+	assert.Equal(t, info.FsStats{Device: "/dev/sda1", InodesUsed: 42}, stats)
+}
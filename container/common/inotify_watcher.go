@@ -20,6 +20,8 @@ import (
 	"sync"
 
 	inotify "k8s.io/utils/inotify"
+
+	"github.com/google/cadvisor/metrics/selfmetrics"
 )
 
 // Watcher for container-related inotify events in the cgroup hierarchy.
@@ -66,6 +68,7 @@ func (iw *InotifyWatcher) AddWatch(containerName, dir string) (bool, error) {
 			cgroupsWatched = make(map[string]bool)
 		}
 		cgroupsWatched[dir] = true
+		selfmetrics.InotifyWatches.Inc()
 	}
 
 	// Record our watching of the container.
@@ -93,6 +96,7 @@ func (iw *InotifyWatcher) RemoveWatch(containerName, dir string) (bool, error) {
 			return false, nil
 		}
 		delete(cgroupsWatched, dir)
+		selfmetrics.InotifyWatches.Dec()
 	}
 
 	// Remove the record if this is the last watch.
@@ -116,6 +120,12 @@ func (iw *InotifyWatcher) Event() chan *inotify.Event {
 
 // Closes the inotify watcher.
 func (iw *InotifyWatcher) Close() error {
+	iw.lock.Lock()
+	for _, cgroupsWatched := range iw.containersWatched {
+		selfmetrics.InotifyWatches.Sub(float64(len(cgroupsWatched)))
+	}
+	iw.containersWatched = make(map[string]map[string]bool)
+	iw.lock.Unlock()
 	return iw.watcher.Close()
 }
 
@@ -16,7 +16,11 @@
 // defines an interface for container operation handlers.
 package container
 
-import info "github.com/google/cadvisor/info/v1"
+import (
+	"context"
+
+	info "github.com/google/cadvisor/info/v1"
+)
 
 // ListType describes whether listing should be just for a
 // specific container or performed recursively.
@@ -35,6 +39,16 @@ const (
 	ContainerTypeCrio
 	ContainerTypeContainerd
 	ContainerTypePodman
+	ContainerTypeKata
+	ContainerTypeGvisor
+	ContainerTypeFirecracker
+	ContainerTypeLxd
+	ContainerTypeCri
+	ContainerTypeSystemd
+	ContainerTypeNomad
+	ContainerTypeExternal
+	ContainerTypeHcs
+	ContainerTypeJail
 )
 
 // Interface for container operation handlers.
@@ -81,3 +95,9 @@ type ContainerHandler interface {
 	// Type of handler
 	Type() ContainerType
 }
+
+// StatsContextGetter is implemented by the handlers that trace the reads behind
+// GetStats, such as those of the cgroup files, as children of the span in ctx.
+type StatsContextGetter interface {
+	GetStatsContext(ctx context.Context) (*info.ContainerStats, error)
+}
@@ -23,6 +23,10 @@ import (
 	"time"
 
 	containersapi "github.com/containerd/containerd/api/services/containers/v1"
+	contentapi "github.com/containerd/containerd/api/services/content/v1"
+	eventsservice "github.com/containerd/containerd/api/services/events/v1"
+	imagesapi "github.com/containerd/containerd/api/services/images/v1"
+	snapshotsapi "github.com/containerd/containerd/api/services/snapshots/v1"
 	tasksapi "github.com/containerd/containerd/api/services/tasks/v1"
 	versionapi "github.com/containerd/containerd/api/services/version/v1"
 	tasktypes "github.com/containerd/containerd/api/types/task"
@@ -40,6 +44,10 @@ type client struct {
 	containerService containersapi.ContainersClient
 	taskService      tasksapi.TasksClient
 	versionService   versionapi.VersionClient
+	imageService     imagesapi.ImagesClient
+	contentService   contentapi.ContentClient
+	snapshotService  snapshotsapi.SnapshotsClient
+	eventService     eventsservice.EventsClient
 }
 
 type ContainerdClient interface {
@@ -106,6 +114,10 @@ func Client(address, namespace string) (ContainerdClient, error) {
 			containerService: containersapi.NewContainersClient(conn),
 			taskService:      tasksapi.NewTasksClient(conn),
 			versionService:   versionapi.NewVersionClient(conn),
+			imageService:     imagesapi.NewImagesClient(conn),
+			contentService:   contentapi.NewContentClient(conn),
+			snapshotService:  snapshotsapi.NewSnapshotsClient(conn),
+			eventService:     eventsservice.NewEventsClient(conn),
 		}
 	})
 	return ctrdClient, ctrdClientErr
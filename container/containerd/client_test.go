@@ -21,6 +21,7 @@ import (
 	"github.com/containerd/containerd/api/types/task"
 
 	"github.com/google/cadvisor/container/containerd/containers"
+	"github.com/google/cadvisor/container/containerd/namespaces"
 )
 
 type containerdClientMock struct {
@@ -28,6 +29,9 @@ type containerdClientMock struct {
 	returnErr  error
 	tasks      map[string]*task.Process
 	exitStatus uint32
+	// The namespace of each container, if set only requests in that
+	// namespace find it.
+	namespaces map[string]string
 }
 
 func (c *containerdClientMock) LoadContainer(ctx context.Context, id string) (*containers.Container, error) {
@@ -38,6 +42,11 @@ func (c *containerdClientMock) LoadContainer(ctx context.Context, id string) (*c
 	if !ok {
 		return nil, fmt.Errorf("unable to find container %q", id)
 	}
+	if ns, ok := c.namespaces[id]; ok {
+		if reqNs, _ := namespaces.Namespace(ctx); reqNs != ns {
+			return nil, fmt.Errorf("unable to find container %q in namespace %q", id, reqNs)
+		}
+	}
 	return cntr, nil
 }
 
@@ -27,6 +27,7 @@ import (
 	"k8s.io/klog/v2"
 
 	"github.com/google/cadvisor/container"
+	"github.com/google/cadvisor/container/containerd/namespaces"
 	"github.com/google/cadvisor/container/libcontainer"
 	"github.com/google/cadvisor/fs"
 	info "github.com/google/cadvisor/info/v1"
@@ -35,6 +36,7 @@ import (
 
 var ArgContainerdEndpoint = flag.String("containerd", "/run/containerd/containerd.sock", "containerd endpoint")
 var ArgContainerdNamespace = flag.String("containerd-namespace", "k8s.io", "containerd namespace")
+var ArgContainerdNamespaces = flag.String("containerd-namespaces", "", "Comma-separated list of containerd namespaces to watch, e.g. \"k8s.io,default,moby,buildkit\". Overrides --containerd-namespace if set")
 
 var containerdEnvMetadataWhiteList = flag.String("containerd_env_metadata_whitelist", "", "DEPRECATED: this flag will be removed, please use `env_metadata_whitelist`. A comma-separated list of environment variable keys matched with specified prefix that needs to be collected for containerd containers")
 
@@ -49,11 +51,19 @@ type containerdFactory struct {
 	machineInfoFactory info.MachineInfoFactory
 	client             ContainerdClient
 	version            string
+	// The containerd namespaces to look for containers in, in order.
+	namespaces []string
 	// Information about the mounted cgroup subsystems.
 	cgroupSubsystems map[string]string
 	// Information about mounted filesystems.
 	fsInfo          fs.FsInfo
 	includedMetrics container.MetricSet
+
+	// The client of the image storage, nil if the client does not support
+	// it, and the images pulled since registration, nil unless image
+	// storage metrics are enabled.
+	images     imageClient
+	imagePulls *imagePulls
 }
 
 func (f *containerdFactory) String() string {
@@ -73,8 +83,16 @@ func (f *containerdFactory) NewContainerHandler(name string, metadataEnvAllowLis
 		containerdMetadataEnvAllowList = metadataEnvAllowList
 	}
 
+	ctx, cancel := context.WithTimeout(context.Background(), connectionTimeout)
+	defer cancel()
+	namespace, err := f.containerNamespace(ctx, ContainerNameToContainerdID(name))
+	if err != nil {
+		return
+	}
+
 	return newContainerdContainerHandler(
 		client,
+		namespace,
 		name,
 		f.machineInfoFactory,
 		f.fsInfo,
@@ -104,6 +122,45 @@ func isContainerName(name string) bool {
 	return containerdCgroupRegexp.MatchString(path.Base(name))
 }
 
+// containerdNamespaces returns the containerd namespaces to watch.
+func containerdNamespaces() []string {
+	if *ArgContainerdNamespaces == "" {
+		return []string{*ArgContainerdNamespace}
+	}
+	var result []string
+	for _, ns := range strings.Split(*ArgContainerdNamespaces, ",") {
+		if ns = strings.TrimSpace(ns); ns != "" {
+			result = append(result, ns)
+		}
+	}
+	return result
+}
+
+// withNamespace returns a context for requests in the given containerd
+// namespace. Requests without one go to the namespace of the client.
+func withNamespace(ctx context.Context, namespace string) context.Context {
+	if namespace == "" {
+		return ctx
+	}
+	return namespaces.WithNamespace(ctx, namespace)
+}
+
+// containerNamespace returns the first watched namespace that holds a
+// container with the given id.
+func (f *containerdFactory) containerNamespace(ctx context.Context, id string) (string, error) {
+	watched := f.namespaces
+	if len(watched) == 0 {
+		watched = []string{""}
+	}
+	var err error
+	for _, namespace := range watched {
+		if _, err = f.client.LoadContainer(withNamespace(ctx, namespace), id); err == nil {
+			return namespace, nil
+		}
+	}
+	return "", err
+}
+
 // Containerd can handle and accept all containerd created containers
 func (f *containerdFactory) CanHandleAndAccept(name string) (bool, bool, error) {
 	// if the container is not associated with containerd, we can't handle it or accept it.
@@ -116,7 +173,7 @@ func (f *containerdFactory) CanHandleAndAccept(name string) (bool, bool, error)
 	// that the container state is not known to containerd
 	ctx, cancel := context.WithTimeout(context.Background(), connectionTimeout)
 	defer cancel()
-	_, err := f.client.LoadContainer(ctx, id)
+	_, err := f.containerNamespace(ctx, id)
 	if err != nil {
 		return false, false, fmt.Errorf("failed to load container: %v", err)
 	}
@@ -145,15 +202,21 @@ func Register(factory info.MachineInfoFactory, fsInfo fs.FsInfo, includedMetrics
 		return fmt.Errorf("failed to get cgroup subsystems: %v", err)
 	}
 
-	klog.V(1).Infof("Registering containerd factory")
+	klog.V(1).Infof("Registering containerd factory for namespaces %v", containerdNamespaces())
 	f := &containerdFactory{
 		cgroupSubsystems:   cgroupSubsystems,
 		client:             client,
 		fsInfo:             fsInfo,
 		machineInfoFactory: factory,
 		version:            containerdVersion,
+		namespaces:         containerdNamespaces(),
 		includedMetrics:    includedMetrics,
 	}
+	f.images, _ = client.(imageClient)
+	if f.images != nil && includedMetrics.Has(container.ImageStorageMetrics) {
+		f.imagePulls = newImagePulls(f.images)
+		go f.imagePulls.watch(context.Background())
+	}
 
 	container.RegisterContainerHandlerFactory(f, []watcher.ContainerWatchSource{watcher.Raw})
 	return nil
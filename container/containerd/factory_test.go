@@ -17,6 +17,7 @@
 package containerd
 
 import (
+	"context"
 	"testing"
 
 	"github.com/containerd/typeurl/v2"
@@ -75,3 +76,39 @@ func TestCanHandleAndAccept(t *testing.T) {
 		as.Equal(b2, v)
 	}
 }
+
+func TestCanHandleAndAcceptNamespaces(t *testing.T) {
+	as := assert.New(t)
+	id := "40af7cdcbe507acad47a5a62025743ad3ddc6ab93b77b21363aa1c1d641047c9"
+	client := mockcontainerdClient(map[string]*containers.Container{id: {ID: id}}, nil).(*containerdClientMock)
+	client.namespaces = map[string]string{id: "buildkit"}
+
+	f := &containerdFactory{client: client, namespaces: []string{"k8s.io", "default"}}
+	handle, accept, err := f.CanHandleAndAccept("/buildkit/" + id)
+	as.Error(err)
+	as.False(handle)
+	as.False(accept)
+
+	f.namespaces = []string{"k8s.io", "buildkit"}
+	handle, accept, err = f.CanHandleAndAccept("/buildkit/" + id)
+	as.NoError(err)
+	as.True(handle)
+	as.True(accept)
+
+	ns, err := f.containerNamespace(context.Background(), id)
+	as.NoError(err)
+	as.Equal("buildkit", ns)
+}
+
+func TestContainerdNamespaces(t *testing.T) {
+	oldNamespace, oldNamespaces := *ArgContainerdNamespace, *ArgContainerdNamespaces
+	defer func() {
+		*ArgContainerdNamespace, *ArgContainerdNamespaces = oldNamespace, oldNamespaces
+	}()
+
+	*ArgContainerdNamespace, *ArgContainerdNamespaces = "k8s.io", ""
+	assert.Equal(t, []string{"k8s.io"}, containerdNamespaces())
+
+	*ArgContainerdNamespaces = "default, moby,,buildkit"
+	assert.Equal(t, []string{"default", "moby", "buildkit"}, containerdNamespaces())
+}
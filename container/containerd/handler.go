@@ -26,16 +26,22 @@ import (
 	"time"
 
 	"github.com/containerd/errdefs"
-	"github.com/opencontainers/cgroups"
 	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"go.opentelemetry.io/otel"
 
 	"github.com/google/cadvisor/container"
 	"github.com/google/cadvisor/container/common"
 	containerlibcontainer "github.com/google/cadvisor/container/libcontainer"
 	"github.com/google/cadvisor/fs"
 	info "github.com/google/cadvisor/info/v1"
+	"github.com/google/cadvisor/utils/tracing"
+
+	"k8s.io/klog/v2"
 )
 
+// NamespaceLabel is the label holding the containerd namespace of a container.
+const NamespaceLabel = "io.containerd.namespace"
+
 type containerdContainerHandler struct {
 	machineInfoFactory info.MachineInfoFactory
 	// Absolute path to the cgroup hierarchies of this container.
@@ -53,13 +59,27 @@ type containerdContainerHandler struct {
 
 	libcontainerHandler *containerlibcontainer.Handler
 	client              ContainerdClient
+	// The containerd namespace of the container, empty for the namespace
+	// of the client.
+	namespace string
+	// Pid of the runwasi shim started by this container's task, 0 if none.
+	shimPid int
+	rootfs  string
+	// Pid of the container's task.
+	pid int
+	// The zfs dataset of the root filesystem of the container, empty if
+	// it is not stored by the zfs snapshotter.
+	zfsDataset string
 }
 
 var _ container.ContainerHandler = &containerdContainerHandler{}
 
+var tracer = otel.Tracer("github.com/google/cadvisor/container/containerd")
+
 // newContainerdContainerHandler returns a new container.ContainerHandler
 func newContainerdContainerHandler(
 	client ContainerdClient,
+	namespace string,
 	name string,
 	machineInfoFactory info.MachineInfoFactory,
 	fsInfo fs.FsInfo,
@@ -79,7 +99,7 @@ func newContainerdContainerHandler(
 
 	id := ContainerNameToContainerdID(name)
 	// We assume that if load fails then the container is not known to containerd.
-	ctx := context.Background()
+	ctx := withNamespace(context.Background(), namespace)
 	cntr, err := client.LoadContainer(ctx, id)
 	if err != nil {
 		return nil, err
@@ -137,16 +157,47 @@ func newContainerdContainerHandler(
 
 	libcontainerHandler := containerlibcontainer.NewHandler(cgroupManager, rootfs, int(taskPid), metrics)
 
+	labels := make(map[string]string, len(cntr.Labels)+3)
+	for k, v := range cntr.Labels {
+		labels[k] = v
+	}
+	if namespace != "" {
+		labels[NamespaceLabel] = namespace
+	}
+	var shim int
+	if runtime, ok := wasmRuntime(cntr.Runtime.Name); ok {
+		labels[RuntimeLabel] = cntr.Runtime.Name
+		labels[WasmRuntimeLabel] = runtime
+		if alias, ok := wasmAlias(cntr.Labels); ok {
+			containerReference.Aliases = append(containerReference.Aliases, alias)
+		}
+		taskNamespace := namespace
+		if taskNamespace == "" {
+			taskNamespace = *ArgContainerdNamespace
+		}
+		shim = shimPid(rootfs, taskNamespace, id)
+	}
+
 	handler := &containerdContainerHandler{
 		machineInfoFactory:  machineInfoFactory,
 		cgroupPaths:         cgroupPaths,
 		fsInfo:              fsInfo,
 		envs:                make(map[string]string),
-		labels:              cntr.Labels,
+		labels:              labels,
 		includedMetrics:     metrics,
 		reference:           containerReference,
 		libcontainerHandler: libcontainerHandler,
 		client:              client,
+		namespace:           namespace,
+		shimPid:             shim,
+		rootfs:              rootfs,
+		pid:                 int(taskPid),
+	}
+	if cntr.Snapshotter == zfsSnapshotter && metrics.Has(container.DiskUsageMetrics) {
+		handler.zfsDataset, err = rootDataset(rootfs, int(taskPid))
+		if err != nil {
+			klog.V(4).Infof("Unable to get the zfs dataset of container %q: %v", name, err)
+		}
 	}
 	// Add the name and bare ID as aliases of the container.
 	handler.image = cntr.Image
@@ -175,14 +226,18 @@ func (h *containerdContainerHandler) ContainerReference() (info.ContainerReferen
 }
 
 func (h *containerdContainerHandler) GetSpec() (info.ContainerSpec, error) {
-	// TODO: Since we dont collect disk usage stats for containerd, we set hasFilesystem
-	// to false. Revisit when we support disk usage stats for containerd
-	hasFilesystem := false
+	// Disk usage is only collected for the root filesystems of the zfs
+	// snapshotter, whose datasets report their own usage.
+	hasFilesystem := h.zfsDataset != ""
 	hasNet := h.includedMetrics.Has(container.NetworkUsageMetrics)
 	spec, err := common.GetSpec(h.cgroupPaths, h.machineInfoFactory, hasNet, hasFilesystem)
 	spec.Labels = h.labels
 	spec.Envs = h.envs
 	spec.Image = h.image
+	if h.shimPid != 0 {
+		spec.HasCustomMetrics = true
+		spec.CustomMetrics = shimMetricSpecs
+	}
 
 	return spec, err
 }
@@ -196,27 +251,55 @@ func (h *containerdContainerHandler) getFsStats(stats *info.ContainerStats) erro
 	if h.includedMetrics.Has(container.DiskIOMetrics) {
 		common.AssignDeviceNamesToDiskStats((*common.MachineInfoNamer)(mi), &stats.DiskIo)
 	}
+	if h.zfsDataset != "" {
+		h.addZfsStats(stats)
+	}
 	return nil
 }
 
 func (h *containerdContainerHandler) GetStats() (*info.ContainerStats, error) {
-	stats, err := h.libcontainerHandler.GetStats()
+	return h.GetStatsContext(context.Background())
+}
+
+func (h *containerdContainerHandler) GetStatsContext(ctx context.Context) (*info.ContainerStats, error) {
+	stats, err := h.libcontainerHandler.GetStatsContext(ctx)
 	if err != nil {
 		return stats, err
 	}
 
+	if h.shimPid != 0 {
+		h.addShimStats(stats)
+	}
+
 	// Get filesystem stats.
+	_, span := tracer.Start(ctx, "ReadFsStats")
 	err = h.getFsStats(stats)
+	tracing.End(span, err)
 	return stats, err
 }
 
+// addShimStats reports the usage of the runwasi shim, which runs the WASM
+// runtime outside of the container's cgroup.
+func (h *containerdContainerHandler) addShimStats(stats *info.ContainerStats) {
+	cpu, rss, err := processUsage(h.rootfs, h.shimPid)
+	if err != nil {
+		klog.V(4).Infof("Unable to get usage of shim %d of container %q: %v", h.shimPid, h.reference.Name, err)
+		return
+	}
+	if stats.CustomMetrics == nil {
+		stats.CustomMetrics = make(map[string][]info.MetricVal, len(shimMetricSpecs))
+	}
+	stats.CustomMetrics[shimCPUUsageMetric] = []info.MetricVal{{FloatValue: cpu.Seconds(), Timestamp: stats.Timestamp}}
+	stats.CustomMetrics[shimMemoryUsageMetric] = []info.MetricVal{{IntValue: int64(rss), Timestamp: stats.Timestamp}}
+}
+
 func (h *containerdContainerHandler) ListContainers(listType container.ListType) ([]info.ContainerReference, error) {
 	return []info.ContainerReference{}, nil
 }
 
 func (h *containerdContainerHandler) GetCgroupPath(resource string) (string, error) {
 	var res string
-	if !cgroups.IsCgroup2UnifiedMode() {
+	if !common.IsCgroup2UnifiedMode() {
 		res = resource
 	}
 	path, ok := h.cgroupPaths[res]
@@ -246,6 +329,7 @@ func (h *containerdContainerHandler) Start() {
 }
 
 func (h *containerdContainerHandler) Cleanup() {
+	h.libcontainerHandler.Close()
 }
 
 func (h *containerdContainerHandler) GetContainerIPAddress() string {
@@ -254,7 +338,7 @@ func (h *containerdContainerHandler) GetContainerIPAddress() string {
 }
 
 func (h *containerdContainerHandler) GetExitCode() (int, error) {
-	ctx := context.Background()
+	ctx := withNamespace(context.Background(), h.namespace)
 	exitStatus, err := h.client.TaskExitStatus(ctx, h.reference.Id)
 	if err != nil {
 		return -1, err
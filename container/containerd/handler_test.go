@@ -48,6 +48,7 @@ func TestHandler(t *testing.T) {
 	as := assert.New(t)
 	type testCase struct {
 		client               ContainerdClient
+		namespace            string
 		name                 string
 		machineInfoFactory   info.MachineInfoFactory
 		fsInfo               fs.FsInfo
@@ -60,6 +61,7 @@ func TestHandler(t *testing.T) {
 		errContains    string
 		checkReference *info.ContainerReference
 		checkEnvVars   map[string]string
+		checkLabels    map[string]string
 	}
 	testContainers := make(map[string]*containers.Container)
 	testContainer := &containers.Container{
@@ -72,6 +74,7 @@ func TestHandler(t *testing.T) {
 	for _, ts := range []testCase{
 		{
 			mockcontainerdClient(nil, nil),
+			"",
 			"/kubepods/pod068e8fa0-9213-11e7-a01f-507b9d4141fa/40af7cdcbe507acad47a5a62025743ad3ddc6ab93b77b21363aa1c1d641047c9",
 			nil,
 			nil,
@@ -83,9 +86,11 @@ func TestHandler(t *testing.T) {
 			"unable to find container \"40af7cdcbe507acad47a5a62025743ad3ddc6ab93b77b21363aa1c1d641047c9\"",
 			nil,
 			nil,
+			nil,
 		},
 		{
 			mockcontainerdClient(testContainers, nil),
+			"",
 			"/kubepods/pod068e8fa0-9213-11e7-a01f-507b9d4141fa/40af7cdcbe507acad47a5a62025743ad3ddc6ab93b77b21363aa1c1d641047c9",
 			&mockedMachineInfo{},
 			nil,
@@ -102,9 +107,11 @@ func TestHandler(t *testing.T) {
 				Namespace: k8sContainerdNamespace,
 			},
 			map[string]string{},
+			nil,
 		},
 		{
 			mockcontainerdClient(testContainers, nil),
+			"",
 			"/kubepods/pod068e8fa0-9213-11e7-a01f-507b9d4141fa/40af7cdcbe507acad47a5a62025743ad3ddc6ab93b77b21363aa1c1d641047c9",
 			&mockedMachineInfo{},
 			nil,
@@ -121,9 +128,26 @@ func TestHandler(t *testing.T) {
 				Namespace: k8sContainerdNamespace,
 			},
 			map[string]string{"TEST_REGION": "FRA", "TEST_ZONE": "A"},
+			nil,
+		},
+		{
+			mockcontainerdClient(testContainers, nil),
+			"default",
+			"/kubepods/pod068e8fa0-9213-11e7-a01f-507b9d4141fa/40af7cdcbe507acad47a5a62025743ad3ddc6ab93b77b21363aa1c1d641047c9",
+			&mockedMachineInfo{},
+			nil,
+			nil,
+			false,
+			nil,
+			nil,
+			false,
+			"",
+			nil,
+			nil,
+			map[string]string{"io.cri-containerd.kind": "sandbox", NamespaceLabel: "default"},
 		},
 	} {
-		handler, err := newContainerdContainerHandler(ts.client, ts.name, ts.machineInfoFactory, ts.fsInfo, ts.cgroupSubsystems, ts.inHostNamespace, ts.metadataEnvAllowList, ts.includedMetrics)
+		handler, err := newContainerdContainerHandler(ts.client, ts.namespace, ts.name, ts.machineInfoFactory, ts.fsInfo, ts.cgroupSubsystems, ts.inHostNamespace, ts.metadataEnvAllowList, ts.includedMetrics)
 		if ts.hasErr {
 			as.NotNil(err)
 			if ts.errContains != "" {
@@ -140,6 +164,9 @@ func TestHandler(t *testing.T) {
 			as.Nil(err)
 			as.Equal(ts.checkEnvVars, sp.Envs)
 		}
+		if ts.checkLabels != nil {
+			as.Equal(ts.checkLabels, handler.GetContainerLabels())
+		}
 	}
 }
 
@@ -0,0 +1,431 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package containerd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	eventsapi "github.com/containerd/containerd/api/events"
+	containersapi "github.com/containerd/containerd/api/services/containers/v1"
+	contentapi "github.com/containerd/containerd/api/services/content/v1"
+	eventsservice "github.com/containerd/containerd/api/services/events/v1"
+	imagesapi "github.com/containerd/containerd/api/services/images/v1"
+	snapshotsapi "github.com/containerd/containerd/api/services/snapshots/v1"
+	"github.com/containerd/errdefs/pkg/errgrpc"
+	"google.golang.org/protobuf/proto"
+	"k8s.io/klog/v2"
+
+	info "github.com/google/cadvisor/info/v1"
+)
+
+const (
+	imageCreateTopic = "/images/create"
+
+	// Pulls whose content was created longer than this before the image
+	// are not timed, as the content predates the pull, e.g. when an image
+	// that is already stored gets another name.
+	maxPullDuration = time.Hour
+
+	maxPullEventsBackoff = time.Minute
+)
+
+// Media types of the blobs referencing other blobs.
+var (
+	indexMediaTypes = map[string]bool{
+		"application/vnd.oci.image.index.v1+json":                   true,
+		"application/vnd.docker.distribution.manifest.list.v2+json": true,
+	}
+	manifestMediaTypes = map[string]bool{
+		"application/vnd.oci.image.manifest.v1+json":           true,
+		"application/vnd.docker.distribution.manifest.v2+json": true,
+	}
+)
+
+// imageClient is the part of the containerd API the image storage is
+// computed from.
+type imageClient interface {
+	ListImages(ctx context.Context) ([]*imagesapi.Image, error)
+	GetImage(ctx context.Context, name string) (*imagesapi.Image, error)
+	ListContent(ctx context.Context) ([]*contentapi.Info, error)
+	ContentInfo(ctx context.Context, digest string) (*contentapi.Info, error)
+	ReadContent(ctx context.Context, digest string) ([]byte, error)
+	ListContainers(ctx context.Context) ([]*containersapi.Container, error)
+	SnapshotUsage(ctx context.Context, snapshotter, key string) (int64, error)
+	SubscribeImageCreates(ctx context.Context) (func() (*eventsapi.ImageCreate, string, error), error)
+}
+
+var _ imageClient = &client{}
+
+func (c *client) ListImages(ctx context.Context) ([]*imagesapi.Image, error) {
+	r, err := c.imageService.List(ctx, &imagesapi.ListImagesRequest{})
+	if err != nil {
+		return nil, errgrpc.ToNative(err)
+	}
+	return r.Images, nil
+}
+
+func (c *client) GetImage(ctx context.Context, name string) (*imagesapi.Image, error) {
+	r, err := c.imageService.Get(ctx, &imagesapi.GetImageRequest{Name: name})
+	if err != nil {
+		return nil, errgrpc.ToNative(err)
+	}
+	return r.Image, nil
+}
+
+func (c *client) ListContent(ctx context.Context) ([]*contentapi.Info, error) {
+	stream, err := c.contentService.List(ctx, &contentapi.ListContentRequest{})
+	if err != nil {
+		return nil, errgrpc.ToNative(err)
+	}
+	var infos []*contentapi.Info
+	for {
+		r, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return infos, nil
+		}
+		if err != nil {
+			return nil, errgrpc.ToNative(err)
+		}
+		infos = append(infos, r.Info...)
+	}
+}
+
+func (c *client) ContentInfo(ctx context.Context, digest string) (*contentapi.Info, error) {
+	r, err := c.contentService.Info(ctx, &contentapi.InfoRequest{Digest: digest})
+	if err != nil {
+		return nil, errgrpc.ToNative(err)
+	}
+	return r.Info, nil
+}
+
+func (c *client) ReadContent(ctx context.Context, digest string) ([]byte, error) {
+	stream, err := c.contentService.Read(ctx, &contentapi.ReadContentRequest{Digest: digest})
+	if err != nil {
+		return nil, errgrpc.ToNative(err)
+	}
+	var data []byte
+	for {
+		r, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return data, nil
+		}
+		if err != nil {
+			return nil, errgrpc.ToNative(err)
+		}
+		data = append(data, r.Data...)
+	}
+}
+
+func (c *client) ListContainers(ctx context.Context) ([]*containersapi.Container, error) {
+	r, err := c.containerService.List(ctx, &containersapi.ListContainersRequest{})
+	if err != nil {
+		return nil, errgrpc.ToNative(err)
+	}
+	return r.Containers, nil
+}
+
+func (c *client) SnapshotUsage(ctx context.Context, snapshotter, key string) (int64, error) {
+	r, err := c.snapshotService.Usage(ctx, &snapshotsapi.UsageRequest{Snapshotter: snapshotter, Key: key})
+	if err != nil {
+		return 0, errgrpc.ToNative(err)
+	}
+	return r.Size, nil
+}
+
+// SubscribeImageCreates subscribes to the image creations of all namespaces
+// and returns a function receiving them along with their namespace.
+func (c *client) SubscribeImageCreates(ctx context.Context) (func() (*eventsapi.ImageCreate, string, error), error) {
+	stream, err := c.eventService.Subscribe(ctx, &eventsservice.SubscribeRequest{
+		Filters: []string{fmt.Sprintf("topic==%q", imageCreateTopic)},
+	})
+	if err != nil {
+		return nil, errgrpc.ToNative(err)
+	}
+	return func() (*eventsapi.ImageCreate, string, error) {
+		envelope, err := stream.Recv()
+		if err != nil {
+			return nil, "", errgrpc.ToNative(err)
+		}
+		event := &eventsapi.ImageCreate{}
+		if envelope.Event != nil {
+			if err := proto.Unmarshal(envelope.Event.GetValue(), event); err != nil {
+				return nil, "", err
+			}
+		}
+		return event, envelope.Namespace, nil
+	}, nil
+}
+
+// imagePulls counts the images pulled in each namespace. containerd creates
+// an image once all its content is fetched, which gives an estimate of the
+// duration of pulls of images whose content was not stored before.
+type imagePulls struct {
+	client imageClient
+
+	lock       sync.Mutex
+	pulls      map[string]uint64
+	timedPulls map[string]uint64
+	seconds    map[string]float64
+}
+
+func newImagePulls(client imageClient) *imagePulls {
+	return &imagePulls{
+		client:     client,
+		pulls:      map[string]uint64{},
+		timedPulls: map[string]uint64{},
+		seconds:    map[string]float64{},
+	}
+}
+
+// watch counts image creations until ctx is done, resubscribing with a
+// backoff when containerd is unavailable.
+func (p *imagePulls) watch(ctx context.Context) {
+	backoff := time.Second
+	for {
+		received, err := p.receive(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		if received {
+			backoff = time.Second
+		}
+		klog.V(2).Infof("containerd image events unavailable, retrying in %v: %v", backoff, err)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(backoff):
+		}
+		if backoff < maxPullEventsBackoff {
+			backoff *= 2
+		}
+	}
+}
+
+// receive counts image creations until the subscription ends, and returns
+// whether it received any.
+func (p *imagePulls) receive(ctx context.Context) (bool, error) {
+	recv, err := p.client.SubscribeImageCreates(ctx)
+	if err != nil {
+		return false, err
+	}
+	received := false
+	for {
+		event, ns, err := recv()
+		if err != nil {
+			return received, err
+		}
+		received = true
+		p.add(ns, p.duration(withNamespace(ctx, ns), event.Name))
+	}
+}
+
+// duration estimates how long pulling the named image took, or returns 0
+// if it cannot.
+func (p *imagePulls) duration(ctx context.Context, name string) time.Duration {
+	image, err := p.client.GetImage(ctx, name)
+	if err != nil || image.Target == nil || image.CreatedAt == nil {
+		klog.V(4).Infof("Unable to get created containerd image %q: %v", name, err)
+		return 0
+	}
+	// The target of an image is fetched first.
+	target, err := p.client.ContentInfo(ctx, image.Target.Digest)
+	if err != nil || target.CreatedAt == nil {
+		return 0
+	}
+	d := image.CreatedAt.AsTime().Sub(target.CreatedAt.AsTime())
+	if d < 0 || d > maxPullDuration {
+		return 0
+	}
+	return d
+}
+
+func (p *imagePulls) add(namespace string, d time.Duration) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.pulls[namespace]++
+	if d > 0 {
+		p.timedPulls[namespace]++
+		p.seconds[namespace] += d.Seconds()
+	}
+}
+
+func (p *imagePulls) addTo(storage *info.ImageStorage) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	storage.Pulls = p.pulls[storage.Namespace]
+	storage.TimedPulls = p.timedPulls[storage.Namespace]
+	storage.PullSeconds = p.seconds[storage.Namespace]
+}
+
+// ImageStorage returns the disk usage of the images and of the writable
+// layers of the containers of each watched containerd namespace.
+func (f *containerdFactory) ImageStorage() ([]info.ImageStorage, error) {
+	if f.images == nil {
+		return nil, fmt.Errorf("image storage is not supported by the containerd client")
+	}
+	var storage []info.ImageStorage
+	for _, ns := range f.namespaces {
+		ctx, cancel := context.WithTimeout(withNamespace(context.Background(), ns), imageStorageTimeout)
+		s, err := namespaceImageStorage(ctx, f.images)
+		cancel()
+		if err != nil {
+			return nil, fmt.Errorf("namespace %q: %v", ns, err)
+		}
+		s.Namespace = ns
+		if f.imagePulls != nil {
+			f.imagePulls.addTo(&s)
+		}
+		storage = append(storage, s)
+	}
+	return storage, nil
+}
+
+const imageStorageTimeout = 30 * time.Second
+
+// descriptor is the part of an OCI descriptor, index or manifest needed to
+// walk the content of an image.
+type descriptor struct {
+	MediaType string       `json:"mediaType"`
+	Digest    string       `json:"digest"`
+	Manifests []descriptor `json:"manifests"`
+	Config    *descriptor  `json:"config"`
+	Layers    []descriptor `json:"layers"`
+}
+
+// namespaceImageStorage returns the image storage of the namespace of ctx.
+// Image sizes are those of their stored, usually compressed, content.
+func namespaceImageStorage(ctx context.Context, c imageClient) (info.ImageStorage, error) {
+	storage := info.ImageStorage{
+		Runtime:   k8sContainerdNamespace,
+		Timestamp: time.Now(),
+	}
+	images, err := c.ListImages(ctx)
+	if err != nil {
+		return storage, err
+	}
+	contents, err := c.ListContent(ctx)
+	if err != nil {
+		return storage, err
+	}
+	sizes := make(map[string]uint64, len(contents))
+	for _, content := range contents {
+		sizes[content.Digest] = uint64(max(content.Size, 0))
+	}
+	ctnrs, err := c.ListContainers(ctx)
+	if err != nil {
+		return storage, err
+	}
+
+	// Images sharing a target are the same image under several names.
+	var ids []string
+	names := map[string][]string{}
+	mediaTypes := map[string]string{}
+	imageIDs := map[string]string{}
+	for _, image := range images {
+		if image.Target == nil {
+			continue
+		}
+		id := image.Target.Digest
+		if _, ok := names[id]; !ok {
+			ids = append(ids, id)
+			mediaTypes[id] = image.Target.MediaType
+		}
+		names[id] = append(names[id], image.Name)
+		imageIDs[image.Name] = id
+	}
+
+	blobs := map[string][]string{}
+	references := map[string]int{}
+	for _, id := range ids {
+		seen := map[string]bool{}
+		walkContent(ctx, c, descriptor{MediaType: mediaTypes[id], Digest: id}, sizes, seen)
+		for digest := range seen {
+			blobs[id] = append(blobs[id], digest)
+			references[digest]++
+		}
+	}
+
+	containers := map[string]int{}
+	for _, ctnr := range ctnrs {
+		if id, ok := imageIDs[ctnr.Image]; ok {
+			containers[id]++
+		}
+		if ctnr.Snapshotter == "" || ctnr.SnapshotKey == "" {
+			continue
+		}
+		size, err := c.SnapshotUsage(ctx, ctnr.Snapshotter, ctnr.SnapshotKey)
+		if err != nil {
+			klog.V(4).Infof("Unable to get the usage of snapshot %q of containerd container %q: %v", ctnr.SnapshotKey, ctnr.ID, err)
+			continue
+		}
+		storage.WritableLayersBytes += uint64(max(size, 0))
+	}
+
+	for digest := range references {
+		storage.ImagesBytes += sizes[digest]
+	}
+	for _, id := range ids {
+		usage := info.ImageUsage{
+			ID:         id,
+			RepoTags:   names[id],
+			Containers: containers[id],
+		}
+		for _, digest := range blobs[id] {
+			usage.Size += sizes[digest]
+			if references[digest] > 1 {
+				usage.SharedSize += sizes[digest]
+			}
+		}
+		storage.Images = append(storage.Images, usage)
+	}
+	return storage, nil
+}
+
+// walkContent adds the stored blobs reachable from desc to seen. Blobs of
+// platforms that were not pulled are not stored and skipped.
+func walkContent(ctx context.Context, c imageClient, desc descriptor, sizes map[string]uint64, seen map[string]bool) {
+	if _, ok := sizes[desc.Digest]; !ok || seen[desc.Digest] {
+		return
+	}
+	seen[desc.Digest] = true
+	if !indexMediaTypes[desc.MediaType] && !manifestMediaTypes[desc.MediaType] {
+		return
+	}
+	data, err := c.ReadContent(ctx, desc.Digest)
+	if err != nil {
+		klog.V(4).Infof("Unable to read containerd content %q: %v", desc.Digest, err)
+		return
+	}
+	var parsed descriptor
+	if err := json.Unmarshal(data, &parsed); err != nil {
+		klog.V(4).Infof("Unable to parse containerd content %q: %v", desc.Digest, err)
+		return
+	}
+	children := append(parsed.Manifests, parsed.Layers...)
+	if parsed.Config != nil {
+		children = append(children, *parsed.Config)
+	}
+	for _, child := range children {
+		walkContent(ctx, c, child, sizes, seen)
+	}
+}
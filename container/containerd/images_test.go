@@ -0,0 +1,165 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package containerd
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	eventsapi "github.com/containerd/containerd/api/events"
+	containersapi "github.com/containerd/containerd/api/services/containers/v1"
+	contentapi "github.com/containerd/containerd/api/services/content/v1"
+	imagesapi "github.com/containerd/containerd/api/services/images/v1"
+	"github.com/containerd/containerd/api/types"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	info "github.com/google/cadvisor/info/v1"
+)
+
+type imageClientMock struct {
+	images     []*imagesapi.Image
+	content    []*contentapi.Info
+	blobs      map[string]string
+	containers []*containersapi.Container
+	usage      map[string]int64
+}
+
+func (c *imageClientMock) ListImages(ctx context.Context) ([]*imagesapi.Image, error) {
+	return c.images, nil
+}
+
+func (c *imageClientMock) GetImage(ctx context.Context, name string) (*imagesapi.Image, error) {
+	for _, image := range c.images {
+		if image.Name == name {
+			return image, nil
+		}
+	}
+	return nil, fmt.Errorf("image %q not found", name)
+}
+
+func (c *imageClientMock) ListContent(ctx context.Context) ([]*contentapi.Info, error) {
+	return c.content, nil
+}
+
+func (c *imageClientMock) ContentInfo(ctx context.Context, digest string) (*contentapi.Info, error) {
+	for _, content := range c.content {
+		if content.Digest == digest {
+			return content, nil
+		}
+	}
+	return nil, fmt.Errorf("content %q not found", digest)
+}
+
+func (c *imageClientMock) ReadContent(ctx context.Context, digest string) ([]byte, error) {
+	blob, ok := c.blobs[digest]
+	if !ok {
+		return nil, fmt.Errorf("content %q not found", digest)
+	}
+	return []byte(blob), nil
+}
+
+func (c *imageClientMock) ListContainers(ctx context.Context) ([]*containersapi.Container, error) {
+	return c.containers, nil
+}
+
+func (c *imageClientMock) SnapshotUsage(ctx context.Context, snapshotter, key string) (int64, error) {
+	size, ok := c.usage[key]
+	if !ok {
+		return 0, fmt.Errorf("snapshot %q not found", key)
+	}
+	return size, nil
+}
+
+func (c *imageClientMock) SubscribeImageCreates(ctx context.Context) (func() (*eventsapi.ImageCreate, string, error), error) {
+	return nil, fmt.Errorf("not supported")
+}
+
+const (
+	ociIndex    = "application/vnd.oci.image.index.v1+json"
+	ociManifest = "application/vnd.oci.image.manifest.v1+json"
+)
+
+func testImageClient() *imageClientMock {
+	return &imageClientMock{
+		images: []*imagesapi.Image{
+			{Name: "docker.io/library/app:1", Target: &types.Descriptor{MediaType: ociIndex, Digest: "sha256:index"}},
+			{Name: "docker.io/library/app:latest", Target: &types.Descriptor{MediaType: ociIndex, Digest: "sha256:index"}},
+			{Name: "docker.io/library/tool:1", Target: &types.Descriptor{MediaType: ociManifest, Digest: "sha256:tool"}},
+		},
+		content: []*contentapi.Info{
+			{Digest: "sha256:index", Size: 1},
+			{Digest: "sha256:amd64", Size: 2},
+			{Digest: "sha256:config", Size: 4},
+			{Digest: "sha256:base", Size: 100},
+			{Digest: "sha256:app", Size: 50},
+			{Digest: "sha256:tool", Size: 3},
+			{Digest: "sha256:toolconfig", Size: 5},
+			{Digest: "sha256:unused", Size: 1000},
+		},
+		blobs: map[string]string{
+			// The arm64 manifest was not pulled.
+			"sha256:index": `{"manifests":[{"mediaType":"application/vnd.oci.image.manifest.v1+json","digest":"sha256:amd64"},{"mediaType":"application/vnd.oci.image.manifest.v1+json","digest":"sha256:arm64"}]}`,
+			"sha256:amd64": `{"config":{"digest":"sha256:config"},"layers":[{"digest":"sha256:base"},{"digest":"sha256:app"}]}`,
+			"sha256:tool":  `{"config":{"digest":"sha256:toolconfig"},"layers":[{"digest":"sha256:base"}]}`,
+		},
+		containers: []*containersapi.Container{
+			{ID: "a", Image: "docker.io/library/app:1", Snapshotter: "overlayfs", SnapshotKey: "a"},
+			{ID: "b", Image: "docker.io/library/app:latest", Snapshotter: "overlayfs", SnapshotKey: "b"},
+			{ID: "c", Image: "docker.io/library/gone:1", Snapshotter: "overlayfs", SnapshotKey: "missing"},
+		},
+		usage: map[string]int64{"a": 7, "b": 8},
+	}
+}
+
+func TestNamespaceImageStorage(t *testing.T) {
+	storage, err := namespaceImageStorage(context.Background(), testImageClient())
+	assert.NoError(t, err)
+	assert.Equal(t, "containerd", storage.Runtime)
+	assert.EqualValues(t, 165, storage.ImagesBytes)
+	assert.EqualValues(t, 15, storage.WritableLayersBytes)
+	assert.Equal(t, []info.ImageUsage{
+		{ID: "sha256:index", RepoTags: []string{"docker.io/library/app:1", "docker.io/library/app:latest"}, Size: 157, SharedSize: 100, Containers: 2},
+		{ID: "sha256:tool", RepoTags: []string{"docker.io/library/tool:1"}, Size: 108, SharedSize: 100},
+	}, storage.Images)
+}
+
+func TestImagePullDuration(t *testing.T) {
+	created := time.Unix(1700000000, 0)
+	client := &imageClientMock{
+		images: []*imagesapi.Image{
+			{Name: "pulled", Target: &types.Descriptor{Digest: "sha256:new"}, CreatedAt: timestamppb.New(created)},
+			{Name: "tagged", Target: &types.Descriptor{Digest: "sha256:old"}, CreatedAt: timestamppb.New(created)},
+		},
+		content: []*contentapi.Info{
+			{Digest: "sha256:new", CreatedAt: timestamppb.New(created.Add(-4 * time.Second))},
+			{Digest: "sha256:old", CreatedAt: timestamppb.New(created.Add(-48 * time.Hour))},
+		},
+	}
+	pulls := newImagePulls(client)
+	for _, name := range []string{"pulled", "tagged", "unknown"} {
+		pulls.add("k8s.io", pulls.duration(context.Background(), name))
+	}
+
+	storage := info.ImageStorage{Namespace: "k8s.io"}
+	pulls.addTo(&storage)
+	assert.EqualValues(t, 3, storage.Pulls)
+	assert.EqualValues(t, 1, storage.TimedPulls)
+	assert.InDelta(t, 4, storage.PullSeconds, 1e-9)
+}
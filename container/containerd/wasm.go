@@ -0,0 +1,142 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package containerd
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	info "github.com/google/cadvisor/info/v1"
+	"github.com/google/cadvisor/utils/kubelet"
+)
+
+var ArgContainerdStateDir = flag.String("containerd-state-dir", "/run/containerd", "containerd state directory, used to find the shim processes of WASM tasks")
+
+const (
+	// RuntimeLabel holds the containerd runtime of a container run by a
+	// runwasi shim.
+	RuntimeLabel = "io.containerd.runtime"
+	// WasmRuntimeLabel holds the WASM runtime (e.g. "spin", "wasmtime")
+	// of containers run by a runwasi shim.
+	WasmRuntimeLabel = "io.containerd.wasm.runtime"
+)
+
+// Custom metrics with the usage of the runwasi shim process, reported on the
+// container whose task started the shim.
+const (
+	shimCPUUsageMetric    = "containerd_shim_cpu_usage_seconds"
+	shimMemoryUsageMetric = "containerd_shim_memory_rss_bytes"
+)
+
+var shimMetricSpecs = []info.MetricSpec{
+	{Name: shimCPUUsageMetric, Type: info.MetricCumulative, Format: info.FloatType, Units: "seconds"},
+	{Name: shimMemoryUsageMetric, Type: info.MetricGauge, Format: info.IntType, Units: "bytes"},
+}
+
+// Runtimes implemented by runwasi shims are named io.containerd.<runtime>.v<N>.
+var wasmRuntimeRegexp = regexp.MustCompile(`^io\.containerd\.(spin|wasmtime|wasmedge|wasmer|slight|wws|lunatic)\.v[0-9]+$`)
+
+// userHz is the unit of the CPU times in /proc/<pid>/stat. It is part of
+// the kernel ABI and 100 on all architectures Kubernetes runs on.
+const userHz = 100
+
+// wasmRuntime returns the WASM runtime of a containerd runtime name.
+func wasmRuntime(runtimeName string) (string, bool) {
+	matches := wasmRuntimeRegexp.FindStringSubmatch(runtimeName)
+	if matches == nil {
+		return "", false
+	}
+	return matches[1], true
+}
+
+// wasmAlias returns the alias of a container run by a runwasi shim: the
+// namespace, pod and container names Kubernetes gave it, as WASM modules have
+// no command line to tell them apart by. It returns false for containers not
+// run by Kubernetes.
+func wasmAlias(labels map[string]string) (string, bool) {
+	namespace, pod, name := labels[kubelet.PodNamespaceLabel], labels[kubelet.PodNameLabel], labels[kubelet.ContainerNameLabel]
+	if namespace == "" || pod == "" || name == "" {
+		return "", false
+	}
+	return path.Join(namespace, pod, name), true
+}
+
+// shimPid returns the pid of the shim recorded in the task bundle of the
+// container, or 0 if the container's task did not start the shim. Shims that
+// group the containers of a pod are started by the pod sandbox.
+func shimPid(rootFs, namespace, id string) int {
+	p := filepath.Join(rootFs, *ArgContainerdStateDir, "io.containerd.runtime.v2.task", namespace, id, "shim.pid")
+	content, err := os.ReadFile(p)
+	if err != nil {
+		return 0
+	}
+	pid, err := strconv.Atoi(strings.TrimSpace(string(content)))
+	if err != nil {
+		return 0
+	}
+	return pid
+}
+
+// processUsage returns the CPU time and resident memory of a process.
+func processUsage(rootFs string, pid int) (cpu time.Duration, rss uint64, err error) {
+	procDir := path.Join(rootFs, "proc", strconv.Itoa(pid))
+	stat, err := os.ReadFile(path.Join(procDir, "stat"))
+	if err != nil {
+		return 0, 0, err
+	}
+	// The command name may contain spaces, the fields after it do not.
+	end := bytes.LastIndexByte(stat, ')')
+	if end < 0 {
+		return 0, 0, fmt.Errorf("malformed %s/stat", procDir)
+	}
+	// Fields after the command, starting at field 3 (state).
+	fields := strings.Fields(string(stat[end+1:]))
+	if len(fields) < 13 {
+		return 0, 0, fmt.Errorf("malformed %s/stat", procDir)
+	}
+	utime, err := strconv.ParseUint(fields[11], 10, 64)
+	if err != nil {
+		return 0, 0, err
+	}
+	stime, err := strconv.ParseUint(fields[12], 10, 64)
+	if err != nil {
+		return 0, 0, err
+	}
+	cpu = time.Duration(utime+stime) * time.Second / userHz
+
+	statm, err := os.ReadFile(path.Join(procDir, "statm"))
+	if err != nil {
+		return 0, 0, err
+	}
+	statmFields := strings.Fields(string(statm))
+	if len(statmFields) < 2 {
+		return 0, 0, fmt.Errorf("malformed %s/statm", procDir)
+	}
+	pages, err := strconv.ParseUint(statmFields[1], 10, 64)
+	if err != nil {
+		return 0, 0, err
+	}
+	return cpu, pages * uint64(os.Getpagesize()), nil
+}
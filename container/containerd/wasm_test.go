@@ -0,0 +1,145 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package containerd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/containerd/typeurl/v2"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/google/cadvisor/container/containerd/containers"
+)
+
+func TestWasmRuntime(t *testing.T) {
+	for _, tc := range []struct {
+		runtimeName string
+		runtime     string
+		ok          bool
+	}{
+		{"io.containerd.spin.v2", "spin", true},
+		{"io.containerd.wasmtime.v1", "wasmtime", true},
+		{"io.containerd.wasmedge.v1", "wasmedge", true},
+		{"io.containerd.runc.v2", "", false},
+		{"io.containerd.kata.v2", "", false},
+		{"", "", false},
+	} {
+		runtime, ok := wasmRuntime(tc.runtimeName)
+		assert.Equal(t, tc.ok, ok, tc.runtimeName)
+		assert.Equal(t, tc.runtime, runtime, tc.runtimeName)
+	}
+}
+
+func writeFile(t *testing.T, name, content string) {
+	t.Helper()
+	assert.NoError(t, os.MkdirAll(filepath.Dir(name), 0o755))
+	assert.NoError(t, os.WriteFile(name, []byte(content), 0o644))
+}
+
+func TestShimPid(t *testing.T) {
+	rootFs := t.TempDir()
+	taskDir := filepath.Join(rootFs, *ArgContainerdStateDir, "io.containerd.runtime.v2.task", "k8s.io")
+	writeFile(t, filepath.Join(taskDir, "sandbox", "shim.pid"), "4242\n")
+	writeFile(t, filepath.Join(taskDir, "garbage", "shim.pid"), "not a pid")
+
+	assert.Equal(t, 4242, shimPid(rootFs, "k8s.io", "sandbox"))
+	assert.Equal(t, 0, shimPid(rootFs, "k8s.io", "garbage"))
+	assert.Equal(t, 0, shimPid(rootFs, "k8s.io", "workload"))
+}
+
+func TestProcessUsage(t *testing.T) {
+	rootFs := t.TempDir()
+	writeFile(t, filepath.Join(rootFs, "proc", "4242", "stat"),
+		"4242 (containerd-shim (spin)) S 1 4242 4242 0 -1 4194560 1000 0 0 0 250 50 0 0 20 0 12 0 100 123456789 300 18446744073709551615 1 1 0 0 0 0 0 0 0 0 0 0 17 3 0 0 0 0 0\n")
+	writeFile(t, filepath.Join(rootFs, "proc", "4242", "statm"), "30000 300 100 1 0 2000 0\n")
+
+	cpu, rss, err := processUsage(rootFs, 4242)
+	assert.NoError(t, err)
+	assert.Equal(t, 3*time.Second, cpu)
+	assert.Equal(t, uint64(300*os.Getpagesize()), rss)
+
+	writeFile(t, filepath.Join(rootFs, "proc", "4343", "stat"), "4343 (short) S 1\n")
+	_, _, err = processUsage(rootFs, 4343)
+	assert.Error(t, err)
+
+	_, _, err = processUsage(rootFs, 4444)
+	assert.Error(t, err)
+}
+
+func TestHandlerWasmLabels(t *testing.T) {
+	id := "40af7cdcbe507acad47a5a62025743ad3ddc6ab93b77b21363aa1c1d641047c9"
+	testContainer := &containers.Container{
+		ID: id,
+		Labels: map[string]string{
+			"io.cri-containerd.kind":       "container",
+			"io.kubernetes.pod.namespace":  "default",
+			"io.kubernetes.pod.name":       "hello-0",
+			"io.kubernetes.container.name": "hello",
+		},
+		Image:   "ghcr.io/deislabs/containerd-wasm-shims/examples/spin-rust-hello:latest",
+		Runtime: containers.RuntimeInfo{Name: "io.containerd.spin.v2"},
+	}
+	spec := &specs.Spec{Root: &specs.Root{Path: "/test/"}, Process: &specs.Process{}}
+	testContainer.Spec, _ = typeurl.MarshalAnyToProto(spec)
+
+	handler, err := newContainerdContainerHandler(
+		mockcontainerdClient(map[string]*containers.Container{id: testContainer}, nil), "",
+		"/kubepods/pod068e8fa0-9213-11e7-a01f-507b9d4141fa/"+id,
+		&mockedMachineInfo{}, nil, nil, false, nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"io.cri-containerd.kind":       "container",
+		"io.kubernetes.pod.namespace":  "default",
+		"io.kubernetes.pod.name":       "hello-0",
+		"io.kubernetes.container.name": "hello",
+		RuntimeLabel:                   "io.containerd.spin.v2",
+		WasmRuntimeLabel:               "spin",
+	}, handler.GetContainerLabels())
+	ref, err := handler.ContainerReference()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{id, "/kubepods/pod068e8fa0-9213-11e7-a01f-507b9d4141fa/" + id, "default/hello-0/hello"}, ref.Aliases)
+
+	sp, err := handler.GetSpec()
+	assert.Nil(t, err)
+	assert.Equal(t, testContainer.Image, sp.Image)
+	assert.False(t, sp.HasCustomMetrics)
+}
+
+func TestHandlerNoWasmLabels(t *testing.T) {
+	id := "40af7cdcbe507acad47a5a62025743ad3ddc6ab93b77b21363aa1c1d641047c9"
+	testContainer := &containers.Container{
+		ID:      id,
+		Labels:  map[string]string{"io.kubernetes.pod.namespace": "default", "io.kubernetes.pod.name": "web-0", "io.kubernetes.container.name": "web"},
+		Runtime: containers.RuntimeInfo{Name: "io.containerd.runc.v2"},
+	}
+	spec := &specs.Spec{Root: &specs.Root{Path: "/test/"}, Process: &specs.Process{}}
+	testContainer.Spec, _ = typeurl.MarshalAnyToProto(spec)
+
+	name := "/kubepods/pod068e8fa0-9213-11e7-a01f-507b9d4141fa/" + id
+	handler, err := newContainerdContainerHandler(
+		mockcontainerdClient(map[string]*containers.Container{id: testContainer}, nil), "",
+		name, &mockedMachineInfo{}, nil, nil, false, nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, testContainer.Labels, handler.GetContainerLabels())
+	ref, err := handler.ContainerReference()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{id, name}, ref.Aliases)
+}
@@ -0,0 +1,79 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package containerd
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+
+	mount "github.com/moby/sys/mountinfo"
+	"k8s.io/klog/v2"
+
+	"github.com/google/cadvisor/fs"
+	info "github.com/google/cadvisor/info/v1"
+	"github.com/google/cadvisor/zfs"
+)
+
+// zfsSnapshotter is the snapshotter storing the root filesystems of
+// containers in zfs datasets.
+const zfsSnapshotter = "zfs"
+
+// rootDataset returns the zfs dataset mounted as the root filesystem of a
+// process, or an empty string if its root filesystem is not a zfs dataset.
+func rootDataset(rootFs string, pid int) (string, error) {
+	f, err := os.Open(filepath.Join(rootFs, "proc", strconv.Itoa(pid), "mountinfo"))
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	mounts, err := mount.GetMountsFromReader(f, func(m *mount.Info) (bool, bool) {
+		return m.Mountpoint != "/", false
+	})
+	if err != nil || len(mounts) == 0 {
+		return "", err
+	}
+	// The last mount on / is the one the process sees.
+	root := mounts[len(mounts)-1]
+	if root.FSType != "zfs" {
+		return "", nil
+	}
+	return root.Source, nil
+}
+
+// addZfsStats reports the usage of the dataset of the container's root
+// filesystem, read from the kernel through the root of its task.
+func (h *containerdContainerHandler) addZfsStats(stats *info.ContainerStats) {
+	usage, err := zfs.GetDatasetUsage(filepath.Join(h.rootfs, "proc", strconv.Itoa(h.pid), "root"))
+	if err != nil {
+		klog.V(4).Infof("Unable to get usage of zfs dataset %s of container %q: %v", h.zfsDataset, h.reference.Name, err)
+		return
+	}
+	stats.Filesystem = append(stats.Filesystem, info.FsStats{
+		Device:      h.zfsDataset,
+		Type:        string(fs.ZFS),
+		Limit:       usage.Referenced + usage.Available,
+		Usage:       usage.Referenced,
+		BaseUsage:   usage.Referenced,
+		Available:   usage.Available,
+		HasInodes:   true,
+		Inodes:      usage.Objects,
+		InodesFree:  usage.ObjectsFree,
+		InodesUsed:  usage.Objects,
+		InodesTotal: usage.Objects + usage.ObjectsFree,
+	})
+}
@@ -0,0 +1,48 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package containerd
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRootDataset(t *testing.T) {
+	rootFs := t.TempDir()
+	writeFile(t, filepath.Join(rootFs, "proc", "4242", "mountinfo"),
+		`1500 1400 0:25 / / rw,relatime - overlay overlay rw,lowerdir=/l,upperdir=/u,workdir=/w
+1501 1500 0:26 / / rw,relatime - zfs tank/containerd/42 rw,xattr,posixacl
+1502 1501 0:27 / /proc rw,nosuid,nodev,noexec,relatime - proc proc rw
+`)
+	writeFile(t, filepath.Join(rootFs, "proc", "4343", "mountinfo"),
+		`1600 1400 0:28 / / rw,relatime - overlay overlay rw,lowerdir=/l,upperdir=/u,workdir=/w
+1601 1600 0:26 / /data rw,relatime - zfs tank/data rw,xattr,posixacl
+`)
+
+	dataset, err := rootDataset(rootFs, 4242)
+	assert.NoError(t, err)
+	assert.Equal(t, "tank/containerd/42", dataset)
+
+	dataset, err = rootDataset(rootFs, 4343)
+	assert.NoError(t, err)
+	assert.Equal(t, "", dataset)
+
+	_, err = rootDataset(rootFs, 4444)
+	assert.Error(t, err)
+}
@@ -0,0 +1,451 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// The subset of the Kubernetes CRI runtime.v1 API cAdvisor uses, encoded by
+// hand to avoid importing k8s.io/cri-api and its dependencies.
+package cri
+
+import (
+	"fmt"
+
+	"github.com/google/cadvisor/utils/grpcwire"
+)
+
+const runtimeService = "/runtime.v1.RuntimeService/"
+
+// ContainerState mirrors runtime.v1.ContainerState.
+type ContainerState int32
+
+const (
+	ContainerCreated ContainerState = iota
+	ContainerRunning
+	ContainerExited
+	ContainerUnknown
+)
+
+// ContainerMetadata mirrors runtime.v1.ContainerMetadata.
+type ContainerMetadata struct {
+	Name    string
+	Attempt uint32
+}
+
+// Container mirrors runtime.v1.Container.
+type Container struct {
+	ID           string
+	PodSandboxID string
+	Metadata     ContainerMetadata
+	Image        string
+	ImageRef     string
+	State        ContainerState
+	CreatedAt    int64
+	Labels       map[string]string
+	Annotations  map[string]string
+}
+
+// ContainerStatus mirrors runtime.v1.ContainerStatus.
+type ContainerStatus struct {
+	ID          string
+	Metadata    ContainerMetadata
+	State       ContainerState
+	CreatedAt   int64
+	StartedAt   int64
+	FinishedAt  int64
+	ExitCode    int32
+	Image       string
+	ImageRef    string
+	Labels      map[string]string
+	Annotations map[string]string
+	LogPath     string
+}
+
+// ContainerStats mirrors runtime.v1.ContainerStats. Values the runtime
+// does not report are nil.
+type ContainerStats struct {
+	ID string
+
+	CPUTimestamp         int64
+	UsageCoreNanoSeconds *uint64
+
+	MemoryTimestamp int64
+	WorkingSetBytes *uint64
+	UsageBytes      *uint64
+	RssBytes        *uint64
+	PageFaults      *uint64
+	MajorPageFaults *uint64
+
+	WritableLayerTimestamp int64
+	WritableLayerBytes     *uint64
+	WritableLayerInodes    *uint64
+}
+
+// VersionResponse mirrors runtime.v1.VersionResponse.
+type VersionResponse struct {
+	Version           string
+	RuntimeName       string
+	RuntimeVersion    string
+	RuntimeAPIVersion string
+}
+
+// parseUInt64Value decodes a runtime.v1.UInt64Value.
+func parseUInt64Value(b []byte) (*uint64, error) {
+	fields, err := grpcwire.ParseFields(b)
+	if err != nil {
+		return nil, err
+	}
+	var v uint64
+	for _, f := range fields {
+		if f.Num == 1 {
+			v = f.Varint
+		}
+	}
+	return &v, nil
+}
+
+func (m *ContainerMetadata) Unmarshal(b []byte) error {
+	fields, err := grpcwire.ParseFields(b)
+	if err != nil {
+		return err
+	}
+	for _, f := range fields {
+		switch f.Num {
+		case 1:
+			m.Name = string(f.Bytes)
+		case 2:
+			m.Attempt = uint32(f.Varint)
+		}
+	}
+	return nil
+}
+
+// parseImageSpec returns the image of a runtime.v1.ImageSpec.
+func parseImageSpec(b []byte) (string, error) {
+	fields, err := grpcwire.ParseFields(b)
+	if err != nil {
+		return "", err
+	}
+	for _, f := range fields {
+		if f.Num == 1 {
+			return string(f.Bytes), nil
+		}
+	}
+	return "", nil
+}
+
+func (c *Container) Unmarshal(b []byte) error {
+	fields, err := grpcwire.ParseFields(b)
+	if err != nil {
+		return err
+	}
+	c.Labels = map[string]string{}
+	c.Annotations = map[string]string{}
+	for _, f := range fields {
+		switch f.Num {
+		case 1:
+			c.ID = string(f.Bytes)
+		case 2:
+			c.PodSandboxID = string(f.Bytes)
+		case 3:
+			err = c.Metadata.Unmarshal(f.Bytes)
+		case 4:
+			c.Image, err = parseImageSpec(f.Bytes)
+		case 5:
+			c.ImageRef = string(f.Bytes)
+		case 6:
+			c.State = ContainerState(f.Varint)
+		case 7:
+			c.CreatedAt = int64(f.Varint)
+		case 8:
+			err = grpcwire.ParseMapEntry(f.Bytes, c.Labels)
+		case 9:
+			err = grpcwire.ParseMapEntry(f.Bytes, c.Annotations)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *ContainerStatus) Unmarshal(b []byte) error {
+	fields, err := grpcwire.ParseFields(b)
+	if err != nil {
+		return err
+	}
+	s.Labels = map[string]string{}
+	s.Annotations = map[string]string{}
+	for _, f := range fields {
+		switch f.Num {
+		case 1:
+			s.ID = string(f.Bytes)
+		case 2:
+			err = s.Metadata.Unmarshal(f.Bytes)
+		case 3:
+			s.State = ContainerState(f.Varint)
+		case 4:
+			s.CreatedAt = int64(f.Varint)
+		case 5:
+			s.StartedAt = int64(f.Varint)
+		case 6:
+			s.FinishedAt = int64(f.Varint)
+		case 7:
+			s.ExitCode = int32(f.Varint)
+		case 8:
+			s.Image, err = parseImageSpec(f.Bytes)
+		case 9:
+			s.ImageRef = string(f.Bytes)
+		case 12:
+			err = grpcwire.ParseMapEntry(f.Bytes, s.Labels)
+		case 13:
+			err = grpcwire.ParseMapEntry(f.Bytes, s.Annotations)
+		case 15:
+			s.LogPath = string(f.Bytes)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *ContainerStats) Unmarshal(b []byte) error {
+	fields, err := grpcwire.ParseFields(b)
+	if err != nil {
+		return err
+	}
+	for _, f := range fields {
+		switch f.Num {
+		case 1:
+			err = s.unmarshalAttributes(f.Bytes)
+		case 2:
+			err = s.unmarshalCPU(f.Bytes)
+		case 3:
+			err = s.unmarshalMemory(f.Bytes)
+		case 4:
+			err = s.unmarshalWritableLayer(f.Bytes)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *ContainerStats) unmarshalAttributes(b []byte) error {
+	fields, err := grpcwire.ParseFields(b)
+	if err != nil {
+		return err
+	}
+	for _, f := range fields {
+		if f.Num == 1 {
+			s.ID = string(f.Bytes)
+		}
+	}
+	return nil
+}
+
+func (s *ContainerStats) unmarshalCPU(b []byte) error {
+	fields, err := grpcwire.ParseFields(b)
+	if err != nil {
+		return err
+	}
+	for _, f := range fields {
+		switch f.Num {
+		case 1:
+			s.CPUTimestamp = int64(f.Varint)
+		case 2:
+			s.UsageCoreNanoSeconds, err = parseUInt64Value(f.Bytes)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *ContainerStats) unmarshalMemory(b []byte) error {
+	fields, err := grpcwire.ParseFields(b)
+	if err != nil {
+		return err
+	}
+	for _, f := range fields {
+		switch f.Num {
+		case 1:
+			s.MemoryTimestamp = int64(f.Varint)
+		case 2:
+			s.WorkingSetBytes, err = parseUInt64Value(f.Bytes)
+		case 4:
+			s.UsageBytes, err = parseUInt64Value(f.Bytes)
+		case 5:
+			s.RssBytes, err = parseUInt64Value(f.Bytes)
+		case 6:
+			s.PageFaults, err = parseUInt64Value(f.Bytes)
+		case 7:
+			s.MajorPageFaults, err = parseUInt64Value(f.Bytes)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *ContainerStats) unmarshalWritableLayer(b []byte) error {
+	fields, err := grpcwire.ParseFields(b)
+	if err != nil {
+		return err
+	}
+	for _, f := range fields {
+		switch f.Num {
+		case 1:
+			s.WritableLayerTimestamp = int64(f.Varint)
+		case 3:
+			s.WritableLayerBytes, err = parseUInt64Value(f.Bytes)
+		case 4:
+			s.WritableLayerInodes, err = parseUInt64Value(f.Bytes)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type versionRequest struct{}
+
+func (r *versionRequest) Marshal() []byte {
+	// The CRI version is an informational field; runtimes ignore it.
+	return grpcwire.AppendString(nil, 1, "v1")
+}
+
+func (r *VersionResponse) Unmarshal(b []byte) error {
+	fields, err := grpcwire.ParseFields(b)
+	if err != nil {
+		return err
+	}
+	for _, f := range fields {
+		switch f.Num {
+		case 1:
+			r.Version = string(f.Bytes)
+		case 2:
+			r.RuntimeName = string(f.Bytes)
+		case 3:
+			r.RuntimeVersion = string(f.Bytes)
+		case 4:
+			r.RuntimeAPIVersion = string(f.Bytes)
+		}
+	}
+	return nil
+}
+
+// listContainersRequest lists the container with the given id, all
+// containers if empty.
+type listContainersRequest struct {
+	id string
+}
+
+func (r *listContainersRequest) Marshal() []byte {
+	// ContainerFilter.id
+	return grpcwire.AppendMessage(nil, 1, grpcwire.AppendString(nil, 1, r.id))
+}
+
+type listContainersResponse struct {
+	containers []*Container
+}
+
+func (r *listContainersResponse) Unmarshal(b []byte) error {
+	fields, err := grpcwire.ParseFields(b)
+	if err != nil {
+		return err
+	}
+	for _, f := range fields {
+		if f.Num != 1 {
+			continue
+		}
+		c := &Container{}
+		if err := c.Unmarshal(f.Bytes); err != nil {
+			return err
+		}
+		r.containers = append(r.containers, c)
+	}
+	return nil
+}
+
+type containerStatusRequest struct {
+	id      string
+	verbose bool
+}
+
+func (r *containerStatusRequest) Marshal() []byte {
+	return grpcwire.AppendBool(grpcwire.AppendString(nil, 1, r.id), 2, r.verbose)
+}
+
+type containerStatusResponse struct {
+	status *ContainerStatus
+	info   map[string]string
+}
+
+func (r *containerStatusResponse) Unmarshal(b []byte) error {
+	fields, err := grpcwire.ParseFields(b)
+	if err != nil {
+		return err
+	}
+	r.info = map[string]string{}
+	for _, f := range fields {
+		switch f.Num {
+		case 1:
+			r.status = &ContainerStatus{}
+			err = r.status.Unmarshal(f.Bytes)
+		case 2:
+			err = grpcwire.ParseMapEntry(f.Bytes, r.info)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	if r.status == nil {
+		return fmt.Errorf("container status missing from response")
+	}
+	return nil
+}
+
+type listContainerStatsRequest struct {
+	id string
+}
+
+func (r *listContainerStatsRequest) Marshal() []byte {
+	// ContainerStatsFilter.id
+	return grpcwire.AppendMessage(nil, 1, grpcwire.AppendString(nil, 1, r.id))
+}
+
+type listContainerStatsResponse struct {
+	stats []*ContainerStats
+}
+
+func (r *listContainerStatsResponse) Unmarshal(b []byte) error {
+	fields, err := grpcwire.ParseFields(b)
+	if err != nil {
+		return err
+	}
+	for _, f := range fields {
+		if f.Num != 1 {
+			continue
+		}
+		s := &ContainerStats{}
+		if err := s.Unmarshal(f.Bytes); err != nil {
+			return err
+		}
+		r.stats = append(r.stats, s)
+	}
+	return nil
+}
@@ -0,0 +1,105 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cri
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"github.com/google/cadvisor/utils/grpcwire"
+)
+
+func mapEntry(key, value string) []byte {
+	return grpcwire.AppendString(grpcwire.AppendString(nil, 1, key), 2, value)
+}
+
+func uint64Value(v uint64) []byte {
+	b := protowire.AppendTag(nil, 1, protowire.VarintType)
+	return protowire.AppendVarint(b, v)
+}
+
+func appendVarint(b []byte, num protowire.Number, v uint64) []byte {
+	b = protowire.AppendTag(b, num, protowire.VarintType)
+	return protowire.AppendVarint(b, v)
+}
+
+func TestContainerStatusResponse(t *testing.T) {
+	var status []byte
+	status = grpcwire.AppendString(status, 1, "abc")
+	status = grpcwire.AppendMessage(status, 2, appendVarint(grpcwire.AppendString(nil, 1, "web"), 2, 3))
+	status = appendVarint(status, 3, uint64(ContainerExited))
+	status = appendVarint(status, 4, 1700000000000000000)
+	exitCode := int64(-1)
+	status = appendVarint(status, 7, uint64(exitCode))
+	status = grpcwire.AppendMessage(status, 8, grpcwire.AppendString(nil, 1, "nginx:latest"))
+	status = grpcwire.AppendMessage(status, 12, mapEntry("io.kubernetes.pod.name", "web-0"))
+	// Fields cAdvisor does not know about are skipped.
+	status = protowire.AppendTag(status, 99, protowire.Fixed64Type)
+	status = protowire.AppendFixed64(status, 42)
+
+	var b []byte
+	b = grpcwire.AppendMessage(b, 1, status)
+	b = grpcwire.AppendMessage(b, 2, mapEntry("info", `{"pid":1234}`))
+
+	resp := &containerStatusResponse{}
+	assert.NoError(t, grpcwire.Codec{}.Unmarshal(b, resp))
+	assert.Equal(t, &ContainerStatus{
+		ID:          "abc",
+		Metadata:    ContainerMetadata{Name: "web", Attempt: 3},
+		State:       ContainerExited,
+		CreatedAt:   1700000000000000000,
+		ExitCode:    -1,
+		Image:       "nginx:latest",
+		Labels:      map[string]string{"io.kubernetes.pod.name": "web-0"},
+		Annotations: map[string]string{},
+	}, resp.status)
+	assert.Equal(t, 1234, pidFromInfo(resp.info))
+
+	assert.Error(t, grpcwire.Codec{}.Unmarshal(nil, &containerStatusResponse{}))
+	assert.Error(t, grpcwire.Codec{}.Unmarshal([]byte{0xff}, &containerStatusResponse{}))
+}
+
+func TestListContainerStatsResponse(t *testing.T) {
+	var stats []byte
+	stats = grpcwire.AppendMessage(stats, 1, grpcwire.AppendString(nil, 1, "abc"))
+	stats = grpcwire.AppendMessage(stats, 2, grpcwire.AppendMessage(appendVarint(nil, 1, 10), 2, uint64Value(5000)))
+	stats = grpcwire.AppendMessage(stats, 3, grpcwire.AppendMessage(grpcwire.AppendMessage(appendVarint(nil, 1, 11), 2, uint64Value(100)), 4, uint64Value(200)))
+
+	resp := &listContainerStatsResponse{}
+	assert.NoError(t, grpcwire.Codec{}.Unmarshal(grpcwire.AppendMessage(nil, 1, stats), resp))
+	assert.Len(t, resp.stats, 1)
+	s := resp.stats[0]
+	assert.Equal(t, "abc", s.ID)
+	assert.Equal(t, int64(10), s.CPUTimestamp)
+	assert.Equal(t, uint64(5000), *s.UsageCoreNanoSeconds)
+	assert.Equal(t, uint64(100), *s.WorkingSetBytes)
+	assert.Equal(t, uint64(200), *s.UsageBytes)
+	assert.Nil(t, s.RssBytes)
+}
+
+func TestRequests(t *testing.T) {
+	b, err := grpcwire.Codec{}.Marshal(&containerStatusRequest{id: "abc", verbose: true})
+	assert.NoError(t, err)
+	assert.Equal(t, appendVarint(grpcwire.AppendString(nil, 1, "abc"), 2, 1), b)
+
+	b, err = grpcwire.Codec{}.Marshal(&listContainersRequest{id: "abc"})
+	assert.NoError(t, err)
+	assert.Equal(t, grpcwire.AppendMessage(nil, 1, grpcwire.AppendString(nil, 1, "abc")), b)
+
+	_, err = grpcwire.Codec{}.Marshal(&listContainersResponse{})
+	assert.Error(t, err)
+}
@@ -0,0 +1,117 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cri
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/google/cadvisor/utils/grpcwire"
+)
+
+const maxMsgSize = 16 * 1024 * 1024 // 16MB
+
+// CRIClient talks to the RuntimeService of a CRI runtime.
+type CRIClient interface {
+	Version(ctx context.Context) (*VersionResponse, error)
+	// ListContainers returns the container with the given id, or all
+	// containers if id is empty.
+	ListContainers(ctx context.Context, id string) ([]*Container, error)
+	// ContainerStatus returns the status of a container and the
+	// runtime-specific verbose information about it.
+	ContainerStatus(ctx context.Context, id string) (*ContainerStatus, map[string]string, error)
+	// ContainerStats returns the stats the runtime reports for the container.
+	ContainerStats(ctx context.Context, id string) (*ContainerStats, error)
+}
+
+type client struct {
+	conn *grpc.ClientConn
+}
+
+// NewClient returns a CRIClient for the runtime at endpoint, e.g.
+// "unix:///run/containerd/containerd.sock". The connection is established
+// lazily.
+func NewClient(endpoint string) (CRIClient, error) {
+	conn, err := grpc.NewClient(endpoint,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultCallOptions(
+			grpc.MaxCallRecvMsgSize(maxMsgSize),
+			grpc.ForceCodec(grpcwire.Codec{}),
+		),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create CRI client for %q: %v", endpoint, err)
+	}
+	return &client{conn: conn}, nil
+}
+
+func (c *client) Version(ctx context.Context) (*VersionResponse, error) {
+	resp := &VersionResponse{}
+	if err := c.conn.Invoke(ctx, runtimeService+"Version", &versionRequest{}, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *client) ListContainers(ctx context.Context, id string) ([]*Container, error) {
+	resp := &listContainersResponse{}
+	if err := c.conn.Invoke(ctx, runtimeService+"ListContainers", &listContainersRequest{id: id}, resp); err != nil {
+		return nil, err
+	}
+	return resp.containers, nil
+}
+
+func (c *client) ContainerStatus(ctx context.Context, id string) (*ContainerStatus, map[string]string, error) {
+	resp := &containerStatusResponse{}
+	if err := c.conn.Invoke(ctx, runtimeService+"ContainerStatus", &containerStatusRequest{id: id, verbose: true}, resp); err != nil {
+		return nil, nil, err
+	}
+	return resp.status, resp.info, nil
+}
+
+func (c *client) ContainerStats(ctx context.Context, id string) (*ContainerStats, error) {
+	resp := &listContainerStatsResponse{}
+	if err := c.conn.Invoke(ctx, runtimeService+"ListContainerStats", &listContainerStatsRequest{id: id}, resp); err != nil {
+		return nil, err
+	}
+	for _, s := range resp.stats {
+		if s.ID == id {
+			return s, nil
+		}
+	}
+	return nil, fmt.Errorf("no stats for container %q", id)
+}
+
+// pidFromInfo returns the pid of the container's init process from the verbose
+// ContainerStatus information. containerd and CRI-O both report it as the
+// "pid" field of the JSON object under the "info" key; other runtimes may not
+// report it at all, in which case 0 is returned.
+func pidFromInfo(info map[string]string) int {
+	raw, ok := info["info"]
+	if !ok {
+		return 0
+	}
+	verbose := struct {
+		Pid int `json:"pid"`
+	}{}
+	if err := json.Unmarshal([]byte(raw), &verbose); err != nil {
+		return 0
+	}
+	return verbose.Pid
+}
@@ -0,0 +1,95 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cri
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+
+	"github.com/google/cadvisor/utils/grpcwire"
+)
+
+// rawCodec passes messages through as bytes, so the test server can answer
+// with hand encoded responses.
+type rawCodec struct{}
+
+func (rawCodec) Marshal(v interface{}) ([]byte, error) {
+	return *v.(*[]byte), nil
+}
+
+func (rawCodec) Unmarshal(data []byte, v interface{}) error {
+	*v.(*[]byte) = append([]byte(nil), data...)
+	return nil
+}
+
+func (rawCodec) Name() string {
+	return "proto"
+}
+
+func TestClient(t *testing.T) {
+	socket := filepath.Join(t.TempDir(), "cri.sock")
+	listener, err := net.Listen("unix", socket)
+	assert.NoError(t, err)
+
+	requests := map[string][]byte{}
+	server := grpc.NewServer(
+		grpc.ForceServerCodec(rawCodec{}),
+		grpc.UnknownServiceHandler(func(srv interface{}, stream grpc.ServerStream) error {
+			method, _ := grpc.MethodFromServerStream(stream)
+			var req []byte
+			if err := stream.RecvMsg(&req); err != nil {
+				return err
+			}
+			requests[method] = req
+			var resp []byte
+			switch method {
+			case runtimeService + "Version":
+				resp = grpcwire.AppendString(grpcwire.AppendString(nil, 2, "fake"), 3, "1.0")
+			case runtimeService + "ListContainerStats":
+				stats := grpcwire.AppendMessage(nil, 1, grpcwire.AppendString(nil, 1, "abc"))
+				resp = grpcwire.AppendMessage(nil, 1, stats)
+			default:
+				return fmt.Errorf("unexpected method %s", method)
+			}
+			return stream.SendMsg(&resp)
+		}),
+	)
+	go func() { _ = server.Serve(listener) }()
+	defer server.Stop()
+
+	c, err := NewClient("unix://" + socket)
+	assert.NoError(t, err)
+
+	version, err := c.Version(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, &VersionResponse{RuntimeName: "fake", RuntimeVersion: "1.0"}, version)
+
+	stats, err := c.ContainerStats(context.Background(), "abc")
+	assert.NoError(t, err)
+	assert.Equal(t, "abc", stats.ID)
+	assert.Equal(t, grpcwire.AppendMessage(nil, 1, grpcwire.AppendString(nil, 1, "abc")), requests[runtimeService+"ListContainerStats"])
+
+	_, err = c.ContainerStats(context.Background(), "def")
+	assert.Error(t, err)
+
+	_, err = c.ListContainers(context.Background(), "abc")
+	assert.Error(t, err)
+}
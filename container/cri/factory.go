@@ -0,0 +1,136 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package cri
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/google/cadvisor/container"
+	dockerutil "github.com/google/cadvisor/container/docker/utils"
+	"github.com/google/cadvisor/container/libcontainer"
+	info "github.com/google/cadvisor/info/v1"
+	"github.com/google/cadvisor/watcher"
+
+	"k8s.io/klog/v2"
+)
+
+var ArgCriEndpoint = flag.String("cri_endpoint", "", "CRI runtime endpoint, e.g. unix:///run/containerd/containerd.sock. If set, containers of the runtime are handled through the CRI API instead of the runtime-specific handlers")
+
+var criTimeout = flag.Duration("cri_timeout", 2*time.Second, "Timeout of CRI API requests")
+
+// CriNamespace is the namespace under which CRI aliases are unique.
+const CriNamespace = "cri"
+
+type criFactory struct {
+	machineInfoFactory info.MachineInfoFactory
+	client             CRIClient
+	runtimeName        string
+	// Information about the mounted cgroup subsystems.
+	cgroupSubsystems map[string]string
+	includedMetrics  container.MetricSet
+}
+
+func (f *criFactory) String() string {
+	return CriNamespace
+}
+
+// The factory is only registered if asked for explicitly, in which case the
+// CRI API should win over the runtime-specific factories.
+func (f *criFactory) Specialized() {}
+
+func (f *criFactory) NewContainerHandler(name string, metadataEnvAllowList []string, inHostNamespace bool) (container.ContainerHandler, error) {
+	return newCriContainerHandler(f.client, name, f.machineInfoFactory, f.cgroupSubsystems, inHostNamespace, f.includedMetrics)
+}
+
+// isContainerName returns whether the cgroup may belong to a CRI container.
+// Runtimes put the container id in the last element of the cgroup path,
+// e.g. cri-containerd-<id>.scope, crio-<id>.scope or <id>.
+func isContainerName(name string) bool {
+	// Runtime helpers, such as CRI-O's conmon, get cgroups named after the
+	// container too.
+	if strings.Contains(path.Base(name), "conmon") {
+		return false
+	}
+	return dockerutil.IsContainerName(name)
+}
+
+func (f *criFactory) CanHandleAndAccept(name string) (bool, bool, error) {
+	if !isContainerName(name) {
+		return false, false, nil
+	}
+	id := dockerutil.ContainerNameToId(name)
+
+	ctx, cancel := context.WithTimeout(context.Background(), *criTimeout)
+	defer cancel()
+	// Pod sandboxes are not containers in the CRI API and are left to other
+	// factories.
+	containers, err := f.client.ListContainers(ctx, id)
+	if err != nil {
+		return false, false, fmt.Errorf("failed to list CRI container %q: %v", id, err)
+	}
+	for _, c := range containers {
+		if c.ID == id {
+			return true, true, nil
+		}
+	}
+	return false, false, nil
+}
+
+func (f *criFactory) DebugInfo() map[string][]string {
+	return map[string][]string{
+		"CRI runtime": {fmt.Sprintf("%s at %s", f.runtimeName, *ArgCriEndpoint)},
+	}
+}
+
+// Register root container before running this function!
+func Register(factory info.MachineInfoFactory, includedMetrics container.MetricSet) error {
+	if *ArgCriEndpoint == "" {
+		return fmt.Errorf("no CRI endpoint configured")
+	}
+
+	client, err := NewClient(*ArgCriEndpoint)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), *criTimeout)
+	defer cancel()
+	version, err := client.Version(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to communicate with CRI runtime at %q: %v", *ArgCriEndpoint, err)
+	}
+
+	cgroupSubsystems, err := libcontainer.GetCgroupSubsystems(includedMetrics)
+	if err != nil {
+		return fmt.Errorf("failed to get cgroup subsystems: %v", err)
+	}
+
+	klog.V(1).Infof("Registering CRI factory for %s %s", version.RuntimeName, version.RuntimeVersion)
+	f := &criFactory{
+		machineInfoFactory: factory,
+		client:             client,
+		runtimeName:        version.RuntimeName,
+		cgroupSubsystems:   cgroupSubsystems,
+		includedMetrics:    includedMetrics,
+	}
+	container.RegisterContainerHandlerFactory(f, []watcher.ContainerWatchSource{watcher.Raw})
+	return nil
+}
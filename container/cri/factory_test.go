@@ -0,0 +1,83 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package cri
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testID = "72e5a5ff5eef3c4222a6551b992b9360a99122f77d2229783f0ee0946dfd800e"
+
+type fakeCRIClient struct {
+	containers map[string]*Container
+}
+
+func (c *fakeCRIClient) Version(ctx context.Context) (*VersionResponse, error) {
+	return &VersionResponse{RuntimeName: "fake"}, nil
+}
+
+func (c *fakeCRIClient) ListContainers(ctx context.Context, id string) ([]*Container, error) {
+	if ctr, ok := c.containers[id]; ok {
+		return []*Container{ctr}, nil
+	}
+	return nil, nil
+}
+
+func (c *fakeCRIClient) ContainerStatus(ctx context.Context, id string) (*ContainerStatus, map[string]string, error) {
+	return nil, nil, fmt.Errorf("not implemented")
+}
+
+func (c *fakeCRIClient) ContainerStats(ctx context.Context, id string) (*ContainerStats, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+func TestCanHandleAndAccept(t *testing.T) {
+	as := assert.New(t)
+	f := &criFactory{
+		client: &fakeCRIClient{containers: map[string]*Container{testID: {ID: testID}}},
+	}
+	for name, expected := range map[string]bool{
+		"/kubepods.slice/kubepods-burstable.slice/cri-containerd-" + testID + ".scope": true,
+		"/kubepods/burstable/pod1234/" + testID:                                        true,
+		"/kubepods.slice/crio-conmon-" + testID + ".scope":                             false,
+		"/kubepods.slice/crio-" + testID[1:] + "0.scope":                               false,
+		"/system.slice/kubelet.service":                                                false,
+	} {
+		handle, accept, err := f.CanHandleAndAccept(name)
+		as.NoError(err, name)
+		as.Equal(expected, handle, name)
+		as.Equal(expected, accept, name)
+	}
+}
+
+func TestToContainerStats(t *testing.T) {
+	cpu, workingSet := uint64(5000), uint64(100)
+	stats := toContainerStats(&ContainerStats{
+		ID:                   testID,
+		CPUTimestamp:         10,
+		UsageCoreNanoSeconds: &cpu,
+		WorkingSetBytes:      &workingSet,
+	})
+	assert.Equal(t, int64(10), stats.Timestamp.UnixNano())
+	assert.Equal(t, cpu, stats.Cpu.Usage.Total)
+	assert.Equal(t, workingSet, stats.Memory.WorkingSet)
+	assert.Equal(t, uint64(0), stats.Memory.Usage)
+}
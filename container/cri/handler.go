@@ -0,0 +1,230 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+// Handler for containers of CRI runtimes.
+package cri
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"k8s.io/klog/v2"
+
+	"github.com/google/cadvisor/container"
+	"github.com/google/cadvisor/container/common"
+	dockerutil "github.com/google/cadvisor/container/docker/utils"
+	containerlibcontainer "github.com/google/cadvisor/container/libcontainer"
+	info "github.com/google/cadvisor/info/v1"
+)
+
+type criContainerHandler struct {
+	client CRIClient
+
+	machineInfoFactory info.MachineInfoFactory
+
+	// Absolute path to the cgroup hierarchies of this container.
+	// (e.g.: "cpu" -> "/sys/fs/cgroup/cpu/test")
+	cgroupPaths map[string]string
+
+	labels          map[string]string
+	image           string
+	creationTime    time.Time
+	includedMetrics container.MetricSet
+	reference       info.ContainerReference
+
+	libcontainerHandler *containerlibcontainer.Handler
+}
+
+var _ container.ContainerHandler = &criContainerHandler{}
+
+// newCriContainerHandler returns a new container.ContainerHandler
+func newCriContainerHandler(
+	client CRIClient,
+	name string,
+	machineInfoFactory info.MachineInfoFactory,
+	cgroupSubsystems map[string]string,
+	inHostNamespace bool,
+	includedMetrics container.MetricSet,
+) (container.ContainerHandler, error) {
+	id := dockerutil.ContainerNameToId(name)
+
+	ctx, cancel := context.WithTimeout(context.Background(), *criTimeout)
+	defer cancel()
+	status, verboseInfo, err := client.ContainerStatus(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get status of CRI container %q: %v", id, err)
+	}
+
+	cgroupPaths := common.MakeCgroupPaths(cgroupSubsystems, name)
+	cgroupManager, err := containerlibcontainer.NewCgroupManager(name, cgroupPaths)
+	if err != nil {
+		return nil, err
+	}
+
+	rootFs := "/"
+	if !inHostNamespace {
+		rootFs = "/rootfs"
+	}
+
+	// Containers share the network of their pod sandbox, whose stats are
+	// reported by the sandbox cgroup.
+	metrics := common.RemoveNetMetrics(includedMetrics, true)
+
+	aliases := []string{id}
+	if status.Metadata.Name != "" {
+		aliases = append(aliases, status.Metadata.Name)
+	}
+
+	return &criContainerHandler{
+		client:             client,
+		machineInfoFactory: machineInfoFactory,
+		cgroupPaths:        cgroupPaths,
+		labels:             status.Labels,
+		image:              status.Image,
+		creationTime:       time.Unix(0, status.CreatedAt),
+		includedMetrics:    metrics,
+		reference: info.ContainerReference{
+			Id:        id,
+			Name:      name,
+			Aliases:   aliases,
+			Namespace: CriNamespace,
+		},
+		libcontainerHandler: containerlibcontainer.NewHandler(cgroupManager, rootFs, pidFromInfo(verboseInfo), metrics),
+	}, nil
+}
+
+func (h *criContainerHandler) ContainerReference() (info.ContainerReference, error) {
+	return h.reference, nil
+}
+
+func (h *criContainerHandler) GetSpec() (info.ContainerSpec, error) {
+	spec, err := common.GetSpec(h.cgroupPaths, h.machineInfoFactory, false, false)
+	spec.Labels = h.labels
+	spec.Image = h.image
+	if spec.CreationTime.IsZero() {
+		spec.CreationTime = h.creationTime
+	}
+	return spec, err
+}
+
+func (h *criContainerHandler) GetStats() (*info.ContainerStats, error) {
+	stats, err := h.libcontainerHandler.GetStats()
+	if err == nil {
+		return stats, nil
+	}
+	// Runtimes that do not run containers in host cgroups, e.g. VM based
+	// ones, can still report usage through the CRI API.
+	klog.V(4).Infof("Failed to get cgroup stats of CRI container %q, falling back to CRI stats: %v", h.reference.Id, err)
+	return h.criStats()
+}
+
+// criStats returns the CPU and memory stats reported by the runtime.
+func (h *criContainerHandler) criStats() (*info.ContainerStats, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), *criTimeout)
+	defer cancel()
+	criStats, err := h.client.ContainerStats(ctx, h.reference.Id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get CRI stats of container %q: %v", h.reference.Id, err)
+	}
+	return toContainerStats(criStats), nil
+}
+
+func toContainerStats(s *ContainerStats) *info.ContainerStats {
+	stats := &info.ContainerStats{
+		Timestamp: time.Unix(0, s.CPUTimestamp),
+	}
+	if s.CPUTimestamp == 0 {
+		stats.Timestamp = time.Unix(0, s.MemoryTimestamp)
+	}
+	if s.UsageCoreNanoSeconds != nil {
+		stats.Cpu.Usage.Total = *s.UsageCoreNanoSeconds
+	}
+	if s.UsageBytes != nil {
+		stats.Memory.Usage = *s.UsageBytes
+	}
+	if s.WorkingSetBytes != nil {
+		stats.Memory.WorkingSet = *s.WorkingSetBytes
+	}
+	if s.RssBytes != nil {
+		stats.Memory.RSS = *s.RssBytes
+	}
+	if s.PageFaults != nil {
+		stats.Memory.ContainerData.Pgfault = *s.PageFaults
+	}
+	if s.MajorPageFaults != nil {
+		stats.Memory.ContainerData.Pgmajfault = *s.MajorPageFaults
+	}
+	return stats
+}
+
+func (h *criContainerHandler) ListContainers(listType container.ListType) ([]info.ContainerReference, error) {
+	return []info.ContainerReference{}, nil
+}
+
+func (h *criContainerHandler) ListProcesses(listType container.ListType) ([]int, error) {
+	return h.libcontainerHandler.GetProcesses()
+}
+
+func (h *criContainerHandler) GetCgroupPath(resource string) (string, error) {
+	var res string
+	if !common.IsCgroup2UnifiedMode() {
+		res = resource
+	}
+	path, ok := h.cgroupPaths[res]
+	if !ok {
+		return "", fmt.Errorf("could not find path for resource %q for container %q", resource, h.reference.Name)
+	}
+	return path, nil
+}
+
+func (h *criContainerHandler) GetContainerLabels() map[string]string {
+	return h.labels
+}
+
+func (h *criContainerHandler) GetContainerIPAddress() string {
+	// The IP address belongs to the pod sandbox.
+	return ""
+}
+
+func (h *criContainerHandler) Exists() bool {
+	return common.CgroupExists(h.cgroupPaths)
+}
+
+func (h *criContainerHandler) Type() container.ContainerType {
+	return container.ContainerTypeCri
+}
+
+func (h *criContainerHandler) GetExitCode() (int, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), *criTimeout)
+	defer cancel()
+	status, _, err := h.client.ContainerStatus(ctx, h.reference.Id)
+	if err != nil {
+		return -1, fmt.Errorf("failed to get status of CRI container %q: %v", h.reference.Id, err)
+	}
+	if status.State != ContainerExited {
+		return -1, fmt.Errorf("container %s has not exited", h.reference.Id)
+	}
+	return int(status.ExitCode), nil
+}
+
+// Nothing to start up.
+func (h *criContainerHandler) Start() {}
+
+// Nothing to clean up.
+func (h *criContainerHandler) Cleanup() {
+	h.libcontainerHandler.Close()
+}
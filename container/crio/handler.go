@@ -18,18 +18,23 @@
 package crio
 
 import (
+	"context"
 	"fmt"
 	"path/filepath"
 	"strconv"
 	"strings"
 
 	"github.com/opencontainers/cgroups"
+	"go.opentelemetry.io/otel"
 
 	"github.com/google/cadvisor/container"
 	"github.com/google/cadvisor/container/common"
 	containerlibcontainer "github.com/google/cadvisor/container/libcontainer"
 	"github.com/google/cadvisor/fs"
 	info "github.com/google/cadvisor/info/v1"
+	"github.com/google/cadvisor/utils/tracing"
+
+	"k8s.io/klog/v2"
 )
 
 type crioContainerHandler struct {
@@ -78,6 +83,8 @@ type crioContainerHandler struct {
 
 var _ container.ContainerHandler = &crioContainerHandler{}
 
+var tracer = otel.Tracer("github.com/google/cadvisor/container/crio")
+
 // newCrioContainerHandler returns a new container.ContainerHandler
 func newCrioContainerHandler(
 	client CrioClient,
@@ -91,15 +98,6 @@ func newCrioContainerHandler(
 	metadataEnvAllowList []string,
 	includedMetrics container.MetricSet,
 ) (container.ContainerHandler, error) {
-	// Create the cgroup paths.
-	cgroupPaths := common.MakeCgroupPaths(cgroupSubsystems, name)
-
-	// Generate the equivalent cgroup manager for this container.
-	cgroupManager, err := containerlibcontainer.NewCgroupManager(name, cgroupPaths)
-	if err != nil {
-		return nil, err
-	}
-
 	rootFs := "/"
 	if !inHostNamespace {
 		rootFs = "/rootfs"
@@ -112,6 +110,24 @@ func newCrioContainerHandler(
 	if err != nil {
 		return nil, err
 	}
+
+	// The usage of a pod cgroup includes that of all containers of the pod.
+	statsCgroup := name
+	if *podStats && isSandbox(cInfo) {
+		if pod := podCgroup(name); pod != "" {
+			statsCgroup = pod
+		}
+	}
+
+	// Create the cgroup paths.
+	cgroupPaths := common.MakeCgroupPaths(cgroupSubsystems, statsCgroup)
+
+	// Generate the equivalent cgroup manager for this container.
+	cgroupManager, err := containerlibcontainer.NewCgroupManager(statsCgroup, cgroupPaths)
+	if err != nil {
+		return nil, err
+	}
+
 	if cInfo.Pid == 0 {
 		// If pid is not known yet, network related stats can not be retrieved by the
 		// libcontainer handler GetStats().  In this case, the crio handler GetStats()
@@ -156,7 +172,26 @@ func newCrioContainerHandler(
 	// infrastructure container -- does not need their stats to be
 	// reported. This stops metrics being reported multiple times for each
 	// container in a pod.
-	metrics := common.RemoveNetMetrics(includedMetrics, cInfo.Labels["io.kubernetes.container.name"] != "POD")
+	metrics := common.RemoveNetMetrics(includedMetrics, !isSandbox(cInfo))
+
+	labels := make(map[string]string, len(cInfo.Labels))
+	for k, v := range cInfo.Labels {
+		labels[k] = v
+	}
+	if patterns := podAnnotationPatterns(); len(patterns) > 0 {
+		// Sandboxes carry the annotations of their pod.
+		annotations := cInfo.Annotations
+		if sandboxID := cInfo.Annotations[sandboxIDAnnotation]; !isSandbox(cInfo) && sandboxID != "" && sandboxID != id {
+			if sandbox, err := client.ContainerInfo(sandboxID); err != nil {
+				klog.V(4).Infof("Failed to get sandbox %q of CRI-O container %q: %v", sandboxID, id, err)
+			} else {
+				annotations = sandbox.Annotations
+			}
+		}
+		for k, v := range selectAnnotations(annotations, patterns) {
+			labels[k] = v
+		}
+	}
 
 	libcontainerHandler := containerlibcontainer.NewHandler(cgroupManager, rootFs, cInfo.Pid, metrics)
 
@@ -170,7 +205,7 @@ func newCrioContainerHandler(
 		fsInfo:              fsInfo,
 		rootfsStorageDir:    rootfsStorageDir,
 		envs:                make(map[string]string),
-		labels:              cInfo.Labels,
+		labels:              labels,
 		includedMetrics:     metrics,
 		reference:           containerReference,
 		libcontainerHandler: libcontainerHandler,
@@ -214,6 +249,7 @@ func (h *crioContainerHandler) Cleanup() {
 	if h.fsHandler != nil {
 		h.fsHandler.Stop()
 	}
+	h.libcontainerHandler.Close()
 }
 
 func (h *crioContainerHandler) ContainerReference() (info.ContainerReference, error) {
@@ -279,6 +315,11 @@ func (h *crioContainerHandler) getFsStats(stats *info.ContainerStats) error {
 	fsStat.BaseUsage = usage.BaseUsageBytes
 	fsStat.Usage = usage.TotalUsageBytes
 	fsStat.Inodes = usage.InodeUsage
+	fileSystems, err := h.fsInfo.GetGlobalFsInfo()
+	if err != nil {
+		return fmt.Errorf("unable to obtain the inodes of filesystem %s: %v", device, err)
+	}
+	common.AssignFsInodes(fileSystems, usage, &fsStat)
 
 	stats.Filesystem = append(stats.Filesystem, fsStat)
 
@@ -298,14 +339,19 @@ func (h *crioContainerHandler) getLibcontainerHandler() *containerlibcontainer.H
 	}
 
 	h.pidKnown = true
+	h.libcontainerHandler.Close()
 	h.libcontainerHandler = containerlibcontainer.NewHandler(h.cgroupManager, h.rootFs, cInfo.Pid, h.includedMetrics)
 
 	return h.libcontainerHandler
 }
 
 func (h *crioContainerHandler) GetStats() (*info.ContainerStats, error) {
+	return h.GetStatsContext(context.Background())
+}
+
+func (h *crioContainerHandler) GetStatsContext(ctx context.Context) (*info.ContainerStats, error) {
 	libcontainerHandler := h.getLibcontainerHandler()
-	stats, err := libcontainerHandler.GetStats()
+	stats, err := libcontainerHandler.GetStatsContext(ctx)
 	if err != nil {
 		return stats, err
 	}
@@ -318,7 +364,9 @@ func (h *crioContainerHandler) GetStats() (*info.ContainerStats, error) {
 		return stats, nil
 	}
 	// Get filesystem stats.
+	_, span := tracer.Start(ctx, "ReadFsStats")
 	err = h.getFsStats(stats)
+	tracing.End(span, err)
 	if err != nil {
 		return stats, err
 	}
@@ -333,7 +381,7 @@ func (h *crioContainerHandler) ListContainers(listType container.ListType) ([]in
 
 func (h *crioContainerHandler) GetCgroupPath(resource string) (string, error) {
 	var res string
-	if !cgroups.IsCgroup2UnifiedMode() {
+	if !common.IsCgroup2UnifiedMode() {
 		res = resource
 	}
 	path, ok := h.cgroupPaths[res]
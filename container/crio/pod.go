@@ -0,0 +1,80 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package crio
+
+import (
+	"flag"
+	"path"
+	"strings"
+
+	"github.com/google/cadvisor/utils/kubelet"
+)
+
+var (
+	podStats       = flag.Bool("crio_pod_stats", false, "Report the stats of the whole pod cgroup for CRI-O pod sandboxes instead of those of the infra container alone")
+	podAnnotations = flag.String("crio_pod_annotations", "", "Comma-separated list of pod annotations to add as labels of CRI-O containers, e.g. \"prometheus.io/scrape,example.com/*\". A trailing * matches any annotation with the prefix")
+)
+
+const (
+	// The kubelet names the container of a pod sandbox "POD".
+	sandboxContainerName = "POD"
+	// The annotation holding the id of the sandbox of a container.
+	sandboxIDAnnotation = "io.kubernetes.cri-o.SandboxID"
+)
+
+func isSandbox(cInfo *ContainerInfo) bool {
+	return cInfo.Labels[kubelet.ContainerNameLabel] == sandboxContainerName
+}
+
+// podCgroup returns the cgroup of the pod of the sandbox container cgroup
+// name, or "" if its parent is not a pod cgroup.
+func podCgroup(name string) string {
+	parent := path.Dir(name)
+	if pod, _, ok := kubelet.PodCgroup(parent); !ok || pod != parent {
+		return ""
+	}
+	return parent
+}
+
+// selectAnnotations returns the annotations matching the patterns of
+// --crio_pod_annotations.
+func selectAnnotations(annotations map[string]string, patterns []string) map[string]string {
+	selected := map[string]string{}
+	for _, pattern := range patterns {
+		if prefix, ok := strings.CutSuffix(pattern, "*"); ok {
+			for k, v := range annotations {
+				if strings.HasPrefix(k, prefix) {
+					selected[k] = v
+				}
+			}
+		} else if v, ok := annotations[pattern]; ok {
+			selected[pattern] = v
+		}
+	}
+	return selected
+}
+
+// podAnnotationPatterns returns the patterns of --crio_pod_annotations.
+func podAnnotationPatterns() []string {
+	var patterns []string
+	for _, p := range strings.Split(*podAnnotations, ",") {
+		if p = strings.TrimSpace(p); p != "" {
+			patterns = append(patterns, p)
+		}
+	}
+	return patterns
+}
@@ -0,0 +1,92 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package crio
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/google/cadvisor/container"
+	"github.com/google/cadvisor/container/common"
+)
+
+func TestPodCgroup(t *testing.T) {
+	for name, want := range map[string]string{
+		"/kubepods/pod068e8fa0-9213-11e7-a01f-507b9d4141fa/crio-81e5c2990803":                                                               "/kubepods/pod068e8fa0-9213-11e7-a01f-507b9d4141fa",
+		"/kubepods.slice/kubepods-burstable.slice/kubepods-burstable-pod068e8fa0_9213_11e7_a01f_507b9d4141fa.slice/crio-81e5c2990803.scope": "/kubepods.slice/kubepods-burstable.slice/kubepods-burstable-pod068e8fa0_9213_11e7_a01f_507b9d4141fa.slice",
+		"/system.slice/crio-81e5c2990803.scope": "",
+	} {
+		assert.Equal(t, want, podCgroup(name), name)
+	}
+}
+
+func TestSelectAnnotations(t *testing.T) {
+	annotations := map[string]string{
+		"prometheus.io/scrape": "true",
+		"prometheus.io/port":   "9090",
+		"example.com/team":     "storage",
+		"other":                "x",
+	}
+	assert.Equal(t, map[string]string{
+		"prometheus.io/scrape": "true",
+		"example.com/team":     "storage",
+	}, selectAnnotations(annotations, []string{"prometheus.io/scrape", "example.com/*", "missing"}))
+	assert.Len(t, selectAnnotations(annotations, []string{"prometheus.io/*"}), 2)
+	assert.Empty(t, selectAnnotations(annotations, nil))
+}
+
+func TestPodStatsAndAnnotations(t *testing.T) {
+	defer func(stats bool, annotations string) {
+		*podStats, *podAnnotations = stats, annotations
+	}(*podStats, *podAnnotations)
+	*podStats, *podAnnotations = true, "example.com/*"
+
+	const (
+		pod       = "/kubepods/pod068e8fa0-9213-11e7-a01f-507b9d4141fa"
+		sandboxID = "81e5c2990803c383229c9680ce964738d5e566d97f5bd436ac34808d2ec75d5f"
+		ctnrID    = "f3a4a3e0bb91b4a6e0b1d3c4a1f7a0e9d2c5b8e7f6a5d4c3b2a1908f7e6d5c4b"
+	)
+	sandboxLabels := map[string]string{"io.kubernetes.container.name": "POD"}
+	client := mockCrioClient(Info{}, map[string]*ContainerInfo{
+		sandboxID: {
+			Name:        "sandbox",
+			Labels:      sandboxLabels,
+			Annotations: map[string]string{"example.com/team": "storage", "io.kubernetes.cri-o.SandboxID": sandboxID},
+		},
+		ctnrID: {
+			Name:        "app",
+			Labels:      map[string]string{"io.kubernetes.container.name": "app"},
+			Annotations: map[string]string{"io.kubernetes.cri-o.SandboxID": sandboxID},
+		},
+	}, nil)
+	subsystems := map[string]string{"cpu": "/sys/fs/cgroup/cpu"}
+
+	h, err := newCrioContainerHandler(client, pod+"/crio-"+sandboxID, nil, nil, "", "", subsystems, true, nil, container.AllMetrics)
+	assert.NoError(t, err)
+	sandbox := h.(*crioContainerHandler)
+	assert.Equal(t, common.MakeCgroupPaths(subsystems, pod), sandbox.cgroupPaths)
+	assert.Equal(t, "storage", sandbox.GetContainerLabels()["example.com/team"])
+	// The labels of the CRI-O client are not modified.
+	assert.NotContains(t, sandboxLabels, "example.com/team")
+
+	h, err = newCrioContainerHandler(client, pod+"/crio-"+ctnrID, nil, nil, "", "", subsystems, true, nil, container.AllMetrics)
+	assert.NoError(t, err)
+	ctnr := h.(*crioContainerHandler)
+	assert.Equal(t, common.MakeCgroupPaths(subsystems, pod+"/crio-"+ctnrID), ctnr.cgroupPaths)
+	assert.Equal(t, "storage", ctnr.GetContainerLabels()["example.com/team"])
+}
@@ -102,6 +102,7 @@ const (
 	Overlay2StorageDriver              StorageDriver = "overlay2"
 	ContainerdSnapshotterStorageDriver StorageDriver = "overlayfs"
 	ZfsStorageDriver                   StorageDriver = "zfs"
+	BtrfsStorageDriver                 StorageDriver = "btrfs"
 	VfsStorageDriver                   StorageDriver = "vfs"
 )
 
@@ -130,6 +131,13 @@ type dockerFactory struct {
 	thinPoolWatcher *devicemapper.ThinPoolWatcher
 
 	zfsWatcher *zfs.ZfsWatcher
+
+	// Rootless daemons found so far, by uid.
+	rootlessDaemons *dockerutil.RootlessDaemons[*rootlessDaemon]
+
+	// Images pulled since registration, nil unless image storage metrics
+	// are enabled.
+	imagePulls *imagePulls
 }
 
 func (f *dockerFactory) String() string {
@@ -137,11 +145,6 @@ func (f *dockerFactory) String() string {
 }
 
 func (f *dockerFactory) NewContainerHandler(name string, metadataEnvAllowList []string, inHostNamespace bool) (handler container.ContainerHandler, err error) {
-	client, err := Client()
-	if err != nil {
-		return
-	}
-
 	dockerMetadataEnvAllowList := strings.Split(*dockerEnvMetadataWhiteList, ",")
 
 	// prefer using the unified metadataEnvAllowList
@@ -149,8 +152,37 @@ func (f *dockerFactory) NewContainerHandler(name string, metadataEnvAllowList []
 		dockerMetadataEnvAllowList = metadataEnvAllowList
 	}
 
+	daemon, err := f.rootlessDaemon(name)
+	if err != nil {
+		return
+	}
+	if daemon != nil {
+		// Rootless daemons support neither devicemapper nor zfs, and do
+		// not share the containerd of the host.
+		return newContainerHandler(
+			daemon.client,
+			nil,
+			name,
+			f.machineInfoFactory,
+			f.fsInfo,
+			daemon.storageDriver,
+			daemon.storageDir,
+			f.cgroupSubsystems,
+			inHostNamespace,
+			dockerMetadataEnvAllowList,
+			daemon.dockerVersion,
+			f.includedMetrics,
+			"",
+			nil,
+			nil,
+		)
+	}
+	if f.client == nil {
+		return nil, fmt.Errorf("no docker daemon handles %q", name)
+	}
+
 	handler, err = newContainerHandler(
-		client,
+		f.client,
 		f.containerdClient,
 		name,
 		f.machineInfoFactory,
@@ -179,8 +211,21 @@ func (f *dockerFactory) CanHandleAndAccept(name string) (bool, bool, error) {
 	// Check if the container is known to docker and it is active.
 	id := dockerutil.ContainerNameToId(name)
 
+	client := f.client
+	daemon, err := f.rootlessDaemon(name)
+	if err != nil {
+		return false, true, err
+	}
+	if daemon != nil {
+		client = daemon.client
+	}
+	if client == nil {
+		// Only rootless daemons are registered.
+		return false, false, nil
+	}
+
 	// We assume that if Inspect fails then the container is not known to docker.
-	ctnr, err := f.client.ContainerInspect(context.Background(), id)
+	ctnr, err := client.ContainerInspect(context.Background(), id)
 	if err != nil || !ctnr.State.Running {
 		return false, true, fmt.Errorf("error inspecting container: %v", err)
 	}
@@ -311,12 +356,12 @@ func ensureThinLsKernelVersion(kernelVersion string) error {
 func Register(factory info.MachineInfoFactory, fsInfo fs.FsInfo, includedMetrics container.MetricSet) error {
 	client, err := Client()
 	if err != nil {
-		return fmt.Errorf("unable to communicate with docker daemon: %v", err)
+		return registerRootless(factory, fsInfo, includedMetrics, fmt.Errorf("unable to communicate with docker daemon: %v", err))
 	}
 
 	dockerInfo, err := ValidateInfo(Info, VersionString)
 	if err != nil {
-		return fmt.Errorf("failed to validate Docker info: %v", err)
+		return registerRootless(factory, fsInfo, includedMetrics, fmt.Errorf("failed to validate Docker info: %v", err))
 	}
 
 	// Version already validated above, assume no error here.
@@ -377,8 +422,39 @@ func Register(factory info.MachineInfoFactory, fsInfo fs.FsInfo, includedMetrics
 		thinPoolName:       thinPoolName,
 		thinPoolWatcher:    thinPoolWatcher,
 		zfsWatcher:         zfsWatcher,
+		rootlessDaemons:    dockerutil.NewRootlessDaemons(rootlessSocket, connectRootlessDaemon),
+	}
+
+	if includedMetrics.Has(container.ImageStorageMetrics) {
+		f.imagePulls = &imagePulls{}
+		go f.imagePulls.watch(context.Background(), client)
+	}
+
+	container.RegisterContainerHandlerFactory(f, []watcher.ContainerWatchSource{watcher.Raw})
+	return nil
+}
+
+// registerRootless registers a factory for the containers of rootless
+// daemons only, if rootless discovery is enabled, when there is no rootful
+// daemon for the reason err.
+func registerRootless(factory info.MachineInfoFactory, fsInfo fs.FsInfo, includedMetrics container.MetricSet, err error) error {
+	if *dockerutil.ArgRootlessRuntimeDir == "" {
+		return err
+	}
+
+	cgroupSubsystems, cgroupErr := libcontainer.GetCgroupSubsystems(includedMetrics)
+	if cgroupErr != nil {
+		return fmt.Errorf("failed to get cgroup subsystems: %v", cgroupErr)
 	}
 
+	klog.V(1).Infof("Registering Docker factory for rootless daemons only: %v", err)
+	f := &dockerFactory{
+		cgroupSubsystems:   cgroupSubsystems,
+		fsInfo:             fsInfo,
+		machineInfoFactory: factory,
+		includedMetrics:    includedMetrics,
+		rootlessDaemons:    dockerutil.NewRootlessDaemons(rootlessSocket, connectRootlessDaemon),
+	}
 	container.RegisterContainerHandlerFactory(f, []watcher.ContainerWatchSource{watcher.Raw})
 	return nil
 }
@@ -16,7 +16,13 @@
 
 package docker
 
-import "testing"
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	dockerutil "github.com/google/cadvisor/container/docker/utils"
+)
 
 func TestEnsureThinLsKernelVersion(t *testing.T) {
 	tests := []struct {
@@ -51,3 +57,25 @@ func TestEnsureThinLsKernelVersion(t *testing.T) {
 		}
 	}
 }
+
+func TestRootlessOnlyFactory(t *testing.T) {
+	old := *dockerutil.ArgRootlessRuntimeDir
+	defer func() { *dockerutil.ArgRootlessRuntimeDir = old }()
+	*dockerutil.ArgRootlessRuntimeDir = t.TempDir()
+
+	f := &dockerFactory{rootlessDaemons: dockerutil.NewRootlessDaemons(rootlessSocket, connectRootlessDaemon)}
+	for _, name := range []string{
+		"/system.slice/docker-72e5a5ff5eef3c4222a6551b992b9360a99122f77d2229783f0ee0946dfd800e.scope",
+		"/user.slice/user-1000.slice/user@1000.service/user.slice/docker-72e5a5ff5eef3c4222a6551b992b9360a99122f77d2229783f0ee0946dfd800e.scope",
+	} {
+		canHandle, canAccept, err := f.CanHandleAndAccept(name)
+		assert.NoError(t, err, name)
+		assert.False(t, canHandle, name)
+		assert.False(t, canAccept, name)
+		_, err = f.NewContainerHandler(name, nil, true)
+		assert.Error(t, err, name)
+	}
+	storage, err := f.ImageStorage()
+	assert.NoError(t, err)
+	assert.Empty(t, storage)
+}
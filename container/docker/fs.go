@@ -54,7 +54,7 @@ func FsStats(
 		switch storageDriver {
 		case DevicemapperStorageDriver:
 			device = poolName
-		case AufsStorageDriver, OverlayStorageDriver, Overlay2StorageDriver, VfsStorageDriver:
+		case AufsStorageDriver, OverlayStorageDriver, Overlay2StorageDriver, VfsStorageDriver, BtrfsStorageDriver:
 			deviceInfo, err := globalFsInfo.GetDirFsDevice(rootfsStorageDir)
 			if err != nil {
 				return fmt.Errorf("unable to determine device info for dir: %v: %v", rootfsStorageDir, err)
@@ -82,6 +82,7 @@ func FsStats(
 					return fmt.Errorf("unable to obtain diskstats for filesystem %s: %v", fsStat.Device, err)
 				}
 				addDiskStats(fileSystems, &fs, &fsStat)
+				common.AssignFsInodes(fileSystems, usage, &fsStat)
 				stats.Filesystem = append(stats.Filesystem, fsStat)
 				break
 			}
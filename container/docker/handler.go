@@ -27,9 +27,10 @@ import (
 	"strings"
 	"time"
 
+	dockercontainer "github.com/docker/docker/api/types/container"
 	dclient "github.com/docker/docker/client"
-	"github.com/opencontainers/cgroups"
 	"github.com/opencontainers/runtime-spec/specs-go"
+	"go.opentelemetry.io/otel"
 
 	"github.com/google/cadvisor/container"
 	"github.com/google/cadvisor/container/common"
@@ -40,6 +41,7 @@ import (
 	"github.com/google/cadvisor/devicemapper"
 	"github.com/google/cadvisor/fs"
 	info "github.com/google/cadvisor/info/v1"
+	"github.com/google/cadvisor/utils/tracing"
 	"github.com/google/cadvisor/zfs"
 )
 
@@ -48,6 +50,7 @@ const (
 	aufsRWLayer     = "diff"
 	overlayRWLayer  = "upper"
 	overlay2RWLayer = "diff"
+	btrfsRWLayer    = "subvolumes"
 
 	// Path to the directory where docker stores log files if the json logging driver is enabled.
 	pathToContainersDir = "containers"
@@ -97,10 +100,15 @@ type containerHandler struct {
 
 	// the docker client is needed to inspect the container and get the health status
 	client dclient.APIClient
+
+	restartPolicy *info.RestartPolicy
+	mounts        []info.MountSpec
 }
 
 var _ container.ContainerHandler = &containerHandler{}
 
+var tracer = otel.Tracer("github.com/google/cadvisor/container/docker")
+
 func getRwLayerID(containerID, storageDir string, sd StorageDriver, dockerVersion []int) (string, error) {
 	const (
 		// Docker version >=1.10.0 have a randomized ID for the root fs of a container.
@@ -245,6 +253,14 @@ func newContainerHandler(
 		handler.labels["restartcount"] = strconv.Itoa(ctnr.RestartCount)
 	}
 
+	if ctnr.HostConfig != nil && ctnr.HostConfig.RestartPolicy.Name != "" {
+		handler.restartPolicy = &info.RestartPolicy{
+			Name:              string(ctnr.HostConfig.RestartPolicy.Name),
+			MaximumRetryCount: ctnr.HostConfig.RestartPolicy.MaximumRetryCount,
+		}
+	}
+	handler.mounts = mountSpecs(ctnr.Mounts)
+
 	if includedMetrics.Has(container.DiskUsageMetrics) {
 		handler.fsHandler = &FsHandler{
 			FsHandler:       common.NewFsHandler(common.DefaultPeriod, rootfsStorageDir, otherStorageDir, fsInfo),
@@ -286,6 +302,9 @@ func DetermineDeviceStorage(storageDriver StorageDriver, storageDir string, rwLa
 		rootfsStorageDir = path.Join(storageDir, string(storageDriver), rwLayerID, overlay2RWLayer)
 	case VfsStorageDriver:
 		rootfsStorageDir = path.Join(storageDir)
+	case BtrfsStorageDriver:
+		// Each layer is a subvolume, snapshot of the one of its parent.
+		rootfsStorageDir = path.Join(storageDir, string(BtrfsStorageDriver), btrfsRWLayer, rwLayerID)
 	case ZfsStorageDriver:
 		var status info.DockerStatus
 		status, err = Status()
@@ -314,30 +333,58 @@ func (h *containerHandler) GetSpec() (info.ContainerSpec, error) {
 	spec.Envs = h.envs
 	spec.Image = h.image
 	spec.CreationTime = h.creationTime
+	spec.RestartPolicy = h.restartPolicy
+	spec.Mounts = h.mounts
 
 	return spec, nil
 }
 
+func mountSpecs(mounts []dockercontainer.MountPoint) []info.MountSpec {
+	if len(mounts) == 0 {
+		return nil
+	}
+	specs := make([]info.MountSpec, 0, len(mounts))
+	for _, m := range mounts {
+		specs = append(specs, info.MountSpec{
+			Type:        string(m.Type),
+			Name:        m.Name,
+			Source:      m.Source,
+			Destination: m.Destination,
+			ReadOnly:    !m.RW,
+		})
+	}
+	return specs
+}
+
 func (h *containerHandler) GetStats() (*info.ContainerStats, error) {
+	return h.GetStatsContext(context.Background())
+}
+
+func (h *containerHandler) GetStatsContext(ctx context.Context) (*info.ContainerStats, error) {
 	// TODO(vmarmol): Get from libcontainer API instead of cgroup manager when we don't have to support older Dockers.
-	stats, err := h.libcontainerHandler.GetStats()
+	stats, err := h.libcontainerHandler.GetStatsContext(ctx)
 	if err != nil {
 		return stats, err
 	}
 
 	// We assume that if Inspect fails then the container is not known to docker.
-	ctnr, err := h.client.ContainerInspect(context.Background(), h.reference.Id)
+	inspectCtx, span := tracer.Start(ctx, "InspectContainer")
+	ctnr, err := h.client.ContainerInspect(inspectCtx, h.reference.Id)
+	tracing.End(span, err)
 	if err != nil {
 		return nil, fmt.Errorf("failed to inspect container %q: %v", h.reference.Id, err)
 	}
 
 	if ctnr.State.Health != nil {
 		stats.Health.Status = ctnr.State.Health.Status
+		stats.Health.FailingStreak = ctnr.State.Health.FailingStreak
 	}
 
 	// Get filesystem stats.
+	_, span = tracer.Start(ctx, "ReadFsStats")
 	err = FsStats(stats, h.machineInfoFactory, h.metrics, h.storageDriver,
 		h.fsHandler, h.fsInfo, h.thinPoolName, h.rootfsStorageDir, h.zfsParent)
+	tracing.End(span, err)
 	if err != nil {
 		return stats, err
 	}
@@ -355,7 +402,7 @@ func (h *containerHandler) ListProcesses(container.ListType) ([]int, error) {
 
 func (h *containerHandler) GetCgroupPath(resource string) (string, error) {
 	var res string
-	if !cgroups.IsCgroup2UnifiedMode() {
+	if !common.IsCgroup2UnifiedMode() {
 		res = resource
 	}
 	cgroupPath, ok := h.cgroupPaths[res]
@@ -381,6 +428,7 @@ func (h *containerHandler) Cleanup() {
 	if h.fsHandler != nil {
 		h.fsHandler.Stop()
 	}
+	h.libcontainerHandler.Close()
 }
 
 func (h *containerHandler) Start() {
@@ -319,3 +319,14 @@ func TestGetExitCode(t *testing.T) {
 		})
 	}
 }
+
+func TestMountSpecs(t *testing.T) {
+	assert.Nil(t, mountSpecs(nil))
+	assert.Equal(t, []info.MountSpec{
+		{Type: "bind", Source: "/srv/config", Destination: "/etc/app", ReadOnly: true},
+		{Type: "volume", Name: "data", Source: "/var/lib/docker/volumes/data/_data", Destination: "/data"},
+	}, mountSpecs([]container.MountPoint{
+		{Type: "bind", Source: "/srv/config", Destination: "/etc/app", RW: false},
+		{Type: "volume", Name: "data", Source: "/var/lib/docker/volumes/data/_data", Destination: "/data", RW: true},
+	}))
+}
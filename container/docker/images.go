@@ -0,0 +1,137 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package docker
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	dockertypes "github.com/docker/docker/api/types"
+	dockerevents "github.com/docker/docker/api/types/events"
+	"github.com/docker/docker/api/types/filters"
+	dclient "github.com/docker/docker/client"
+	"k8s.io/klog/v2"
+
+	info "github.com/google/cadvisor/info/v1"
+)
+
+const maxPullEventsBackoff = time.Minute
+
+// imagePulls counts the images pulled by the Docker daemon. Docker reports a
+// pull once it completes, so pull durations are unknown.
+type imagePulls struct {
+	count atomic.Uint64
+}
+
+// watch counts pull events until ctx is done, reconnecting with a backoff
+// when the daemon is unavailable.
+func (p *imagePulls) watch(ctx context.Context, client *dclient.Client) {
+	options := dockerevents.ListOptions{
+		Filters: filters.NewArgs(
+			filters.Arg("type", string(dockerevents.ImageEventType)),
+			filters.Arg("event", string(dockerevents.ActionPull)),
+		),
+	}
+	backoff := time.Second
+	for {
+		messages, errs := client.Events(ctx, options)
+		connected := false
+		err := func() error {
+			for {
+				select {
+				case <-messages:
+					connected = true
+					p.count.Add(1)
+				case err := <-errs:
+					return err
+				}
+			}
+		}()
+		if ctx.Err() != nil {
+			return
+		}
+		if connected {
+			backoff = time.Second
+		}
+		klog.V(2).Infof("Docker image events unavailable, retrying in %v: %v", backoff, err)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(backoff):
+		}
+		if backoff < maxPullEventsBackoff {
+			backoff *= 2
+		}
+	}
+}
+
+// ImageStorage returns the disk usage of the images and of the writable
+// layers of the containers of the rootful Docker daemon, if any.
+func (f *dockerFactory) ImageStorage() ([]info.ImageStorage, error) {
+	if f.client == nil {
+		return nil, nil
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), dockerTimeout)
+	defer cancel()
+	du, err := f.client.DiskUsage(ctx, dockertypes.DiskUsageOptions{
+		Types: []dockertypes.DiskUsageObject{dockertypes.ImageObject, dockertypes.ContainerObject},
+	})
+	if err != nil {
+		return nil, err
+	}
+	storage := imageStorageFromDiskUsage(du)
+	if f.imagePulls != nil {
+		storage.Pulls = f.imagePulls.count.Load()
+	}
+	return []info.ImageStorage{storage}, nil
+}
+
+func imageStorageFromDiskUsage(du dockertypes.DiskUsage) info.ImageStorage {
+	storage := info.ImageStorage{
+		Runtime:     DockerNamespace,
+		Timestamp:   time.Now(),
+		ImagesBytes: nonNegative(du.LayersSize),
+	}
+	for _, image := range du.Images {
+		if image == nil {
+			continue
+		}
+		usage := info.ImageUsage{
+			ID:         image.ID,
+			RepoTags:   image.RepoTags,
+			Size:       nonNegative(image.Size),
+			SharedSize: nonNegative(image.SharedSize),
+			Containers: int(max(image.Containers, 0)),
+		}
+		storage.Images = append(storage.Images, usage)
+	}
+	for _, ctnr := range du.Containers {
+		if ctnr != nil {
+			storage.WritableLayersBytes += nonNegative(ctnr.SizeRw)
+		}
+	}
+	return storage
+}
+
+// nonNegative returns v, or 0 for the -1 Docker reports for unknown sizes.
+func nonNegative(v int64) uint64 {
+	if v < 0 {
+		return 0
+	}
+	return uint64(v)
+}
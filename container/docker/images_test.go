@@ -0,0 +1,48 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package docker
+
+import (
+	"testing"
+
+	dockertypes "github.com/docker/docker/api/types"
+	dockercontainer "github.com/docker/docker/api/types/container"
+	dockerimage "github.com/docker/docker/api/types/image"
+	"github.com/stretchr/testify/assert"
+
+	info "github.com/google/cadvisor/info/v1"
+)
+
+func TestImageStorageFromDiskUsage(t *testing.T) {
+	storage := imageStorageFromDiskUsage(dockertypes.DiskUsage{
+		LayersSize: 300,
+		Images: []*dockerimage.Summary{
+			{ID: "sha256:1", RepoTags: []string{"busybox:latest"}, Size: 200, SharedSize: 100, Containers: 2},
+			// Docker reports -1 for sizes and counts it did not compute.
+			{ID: "sha256:2", Size: 150, SharedSize: -1, Containers: -1},
+			nil,
+		},
+		Containers: []*dockercontainer.Summary{{SizeRw: 10}, {SizeRw: 5}, nil},
+	})
+	assert.Equal(t, "docker", storage.Runtime)
+	assert.EqualValues(t, 300, storage.ImagesBytes)
+	assert.EqualValues(t, 15, storage.WritableLayersBytes)
+	assert.Equal(t, []info.ImageUsage{
+		{ID: "sha256:1", RepoTags: []string{"busybox:latest"}, Size: 200, SharedSize: 100, Containers: 2},
+		{ID: "sha256:2", Size: 150},
+	}, storage.Images)
+}
@@ -0,0 +1,75 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package docker
+
+import (
+	"context"
+	"fmt"
+
+	dclient "github.com/docker/docker/client"
+	"k8s.io/klog/v2"
+)
+
+// rootlessSocket is the socket of a rootless daemon in its user's XDG_RUNTIME_DIR.
+const rootlessSocket = "docker.sock"
+
+// rootlessDaemon is a docker daemon running as an unprivileged user.
+type rootlessDaemon struct {
+	client        *dclient.Client
+	storageDriver StorageDriver
+	storageDir    string
+	dockerVersion []int
+}
+
+// rootlessDaemon returns the rootless daemon that owns the cgroup with the
+// given name. It returns nil if the cgroup belongs to no user or the user
+// runs no rootless daemon.
+func (f *dockerFactory) rootlessDaemon(name string) (*rootlessDaemon, error) {
+	d, _, err := f.rootlessDaemons.Get(name)
+	return d, err
+}
+
+// connectRootlessDaemon connects to the rootless daemon of the user with the
+// given uid at socket.
+func connectRootlessDaemon(uid int, socket string) (*rootlessDaemon, error) {
+	client, err := dclient.NewClientWithOpts(
+		dclient.WithHost("unix://"+socket),
+		dclient.WithAPIVersionNegotiation())
+	if err != nil {
+		return nil, fmt.Errorf("unable to create client for rootless docker daemon of uid %d: %v", uid, err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), dockerTimeout)
+	defer cancel()
+	dockerInfo, err := client.Info(ctx)
+	if err != nil {
+		_ = client.Close()
+		return nil, fmt.Errorf("unable to communicate with rootless docker daemon of uid %d: %v", uid, err)
+	}
+	dockerVersion, err := ParseVersion(dockerInfo.ServerVersion, VersionRe, 3)
+	if err != nil {
+		_ = client.Close()
+		return nil, fmt.Errorf("unable to parse version of rootless docker daemon of uid %d: %v", uid, err)
+	}
+
+	klog.V(1).Infof("Found rootless docker daemon of uid %d at %q", uid, socket)
+	return &rootlessDaemon{
+		client:        client,
+		storageDriver: StorageDriver(dockerInfo.Driver),
+		storageDir:    dockerInfo.DockerRootDir,
+		dockerVersion: dockerVersion,
+	}, nil
+}
@@ -0,0 +1,124 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"sync"
+	"time"
+)
+
+var ArgRootlessRuntimeDir = flag.String("rootless_runtime_dir", "", "Directory holding the XDG_RUNTIME_DIR of each user (usually /run/user), searched for the sockets of rootless docker and podman daemons. Rootless containers are ignored if empty")
+
+// Regexp that identifies the cgroup of a systemd user instance. Rootless
+// daemons can only manage delegated cgroups, i.e. below user@<uid>.service.
+var userServiceRegexp = regexp.MustCompile(`/user@(\d+)\.service(/|$)`)
+
+// RootlessUID returns the uid of the user whose systemd instance owns the
+// cgroup with the given name.
+func RootlessUID(name string) (int, bool) {
+	matches := userServiceRegexp.FindStringSubmatch(name)
+	if matches == nil {
+		return 0, false
+	}
+	uid, err := strconv.Atoi(matches[1])
+	if err != nil {
+		return 0, false
+	}
+	return uid, true
+}
+
+// RootlessSocket returns the path of socket, relative to the runtime
+// directory of the user with the given uid, if rootless discovery is
+// enabled and the socket exists.
+func RootlessSocket(uid int, socket string) (string, bool) {
+	if *ArgRootlessRuntimeDir == "" {
+		return "", false
+	}
+	p := filepath.Join(*ArgRootlessRuntimeDir, strconv.Itoa(uid), socket)
+	if _, err := os.Stat(p); err != nil {
+		return "", false
+	}
+	return p, true
+}
+
+// RootlessRetryInterval is how long a rootless daemon that could not be
+// reached is not asked again.
+const RootlessRetryInterval = 30 * time.Second
+
+type rootlessFailure struct {
+	err error
+	at  time.Time
+}
+
+// RootlessDaemons caches, by uid, the rootless daemons found and the ones
+// that could not be reached.
+type RootlessDaemons[T any] struct {
+	socket  string
+	connect func(uid int, socket string) (T, error)
+
+	lock   sync.Mutex
+	found  map[int]T
+	failed map[int]rootlessFailure
+}
+
+// NewRootlessDaemons returns a cache of the rootless daemons serving on
+// socket, relative to the runtime directory of their user, to which connect
+// connects.
+func NewRootlessDaemons[T any](socket string, connect func(uid int, socket string) (T, error)) *RootlessDaemons[T] {
+	return &RootlessDaemons[T]{
+		socket:  socket,
+		connect: connect,
+		found:   map[int]T{},
+		failed:  map[int]rootlessFailure{},
+	}
+}
+
+// Get returns the rootless daemon that owns the cgroup with the given name.
+// It returns false if the cgroup belongs to no user or the user runs no
+// rootless daemon.
+func (d *RootlessDaemons[T]) Get(name string) (T, bool, error) {
+	var none T
+	uid, ok := RootlessUID(name)
+	if !ok {
+		return none, false, nil
+	}
+
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	if daemon, ok := d.found[uid]; ok {
+		return daemon, true, nil
+	}
+	if f, ok := d.failed[uid]; ok && time.Since(f.at) < RootlessRetryInterval {
+		return none, false, f.err
+	}
+
+	socket, ok := RootlessSocket(uid, d.socket)
+	if !ok {
+		return none, false, nil
+	}
+	daemon, err := d.connect(uid, socket)
+	if err != nil {
+		d.failed[uid] = rootlessFailure{err: err, at: time.Now()}
+		return none, false, err
+	}
+	delete(d.failed, uid)
+	d.found[uid] = daemon
+	return daemon, true, nil
+}
@@ -0,0 +1,113 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRootlessUID(t *testing.T) {
+	for name, expected := range map[string]int{
+		"/user.slice/user-1000.slice/user@1000.service/user.slice/docker-72e5a5ff5eef3c4222a6551b992b9360a99122f77d2229783f0ee0946dfd800e.scope":           1000,
+		"/user.slice/user-1001.slice/user@1001.service/user.slice/libpod-72e5a5ff5eef3c4222a6551b992b9360a99122f77d2229783f0ee0946dfd800e.scope/container": 1001,
+		"/user.slice/user-1000.slice/user@1000.service":                                               1000,
+		"/system.slice/docker-72e5a5ff5eef3c4222a6551b992b9360a99122f77d2229783f0ee0946dfd800e.scope": -1,
+		"/user.slice/user-1000.slice/session-2.scope":                                                 -1,
+	} {
+		uid, ok := RootlessUID(name)
+		assert.Equal(t, expected >= 0, ok, name)
+		if ok {
+			assert.Equal(t, expected, uid, name)
+		}
+	}
+}
+
+func TestRootlessSocket(t *testing.T) {
+	dir := t.TempDir()
+	assert.NoError(t, os.MkdirAll(filepath.Join(dir, "1000"), 0o700))
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "1000", "docker.sock"), nil, 0o600))
+
+	old := *ArgRootlessRuntimeDir
+	defer func() { *ArgRootlessRuntimeDir = old }()
+
+	*ArgRootlessRuntimeDir = ""
+	_, ok := RootlessSocket(1000, "docker.sock")
+	assert.False(t, ok)
+
+	*ArgRootlessRuntimeDir = dir
+	socket, ok := RootlessSocket(1000, "docker.sock")
+	assert.True(t, ok)
+	assert.Equal(t, filepath.Join(dir, "1000", "docker.sock"), socket)
+
+	_, ok = RootlessSocket(1001, "docker.sock")
+	assert.False(t, ok)
+}
+
+func TestRootlessDaemons(t *testing.T) {
+	dir := t.TempDir()
+	for _, uid := range []string{"1000", "1001"} {
+		assert.NoError(t, os.MkdirAll(filepath.Join(dir, uid), 0o700))
+		assert.NoError(t, os.WriteFile(filepath.Join(dir, uid, "docker.sock"), nil, 0o600))
+	}
+	old := *ArgRootlessRuntimeDir
+	defer func() { *ArgRootlessRuntimeDir = old }()
+	*ArgRootlessRuntimeDir = dir
+
+	connects := map[int]int{}
+	daemons := NewRootlessDaemons("docker.sock", func(uid int, socket string) (string, error) {
+		connects[uid]++
+		if uid == 1001 {
+			return "", fmt.Errorf("connection refused")
+		}
+		return socket, nil
+	})
+
+	name := "/user.slice/user-1000.slice/user@1000.service/user.slice/docker-72e5a5ff5eef3c4222a6551b992b9360a99122f77d2229783f0ee0946dfd800e.scope"
+	for i := 0; i < 2; i++ {
+		daemon, ok, err := daemons.Get(name)
+		assert.NoError(t, err)
+		assert.True(t, ok)
+		assert.Equal(t, filepath.Join(dir, "1000", "docker.sock"), daemon)
+	}
+	assert.Equal(t, 1, connects[1000])
+
+	// Daemons that cannot be reached are not asked again for a while.
+	name = "/user.slice/user-1001.slice/user@1001.service/user.slice/docker-72e5a5ff5eef3c4222a6551b992b9360a99122f77d2229783f0ee0946dfd800e.scope"
+	for i := 0; i < 2; i++ {
+		_, ok, err := daemons.Get(name)
+		assert.Error(t, err)
+		assert.False(t, ok)
+	}
+	assert.Equal(t, 1, connects[1001])
+	daemons.failed[1001] = rootlessFailure{err: fmt.Errorf("connection refused"), at: time.Now().Add(-RootlessRetryInterval)}
+	_, _, err := daemons.Get(name)
+	assert.Error(t, err)
+	assert.Equal(t, 2, connects[1001])
+
+	for _, name := range []string{
+		"/user.slice/user-1002.slice/user@1002.service/user.slice/docker-72e5a5ff5eef3c4222a6551b992b9360a99122f77d2229783f0ee0946dfd800e.scope",
+		"/system.slice/docker-72e5a5ff5eef3c4222a6551b992b9360a99122f77d2229783f0ee0946dfd800e.scope",
+	} {
+		_, ok, err := daemons.Get(name)
+		assert.NoError(t, err)
+		assert.False(t, ok)
+	}
+}
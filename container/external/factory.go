@@ -0,0 +1,269 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package external
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/cadvisor/container"
+	"github.com/google/cadvisor/container/external/pluginapi"
+	"github.com/google/cadvisor/container/libcontainer"
+	"github.com/google/cadvisor/fs"
+	info "github.com/google/cadvisor/info/v1"
+	"github.com/google/cadvisor/watcher"
+
+	"k8s.io/klog/v2"
+)
+
+var (
+	ArgPluginDir     = flag.String("container_plugin_dir", "", "Directory in which out-of-tree container plugins serve their gRPC API on *.sock unix sockets. Empty disables plugins")
+	ArgPluginTimeout = flag.Duration("container_plugin_timeout", 2*time.Second, "Timeout of container plugin requests")
+)
+
+const (
+	// rescanInterval is how often the plugin directory is checked for plugins
+	// that started or stopped.
+	rescanInterval = 10 * time.Second
+	// canHandleTimeout bounds how long a plugin may take to tell whether it
+	// handles a cgroup, as the manager waits for it on every cgroup event.
+	canHandleTimeout = 500 * time.Millisecond
+	// unhandledTTL is how long a cgroup no plugin handles is remembered as
+	// such.
+	unhandledTTL = rescanInterval
+)
+
+// containerPlugin is a container plugin serving on a socket of the plugin directory.
+type containerPlugin struct {
+	name   string
+	socket string
+	client pluginapi.Client
+}
+
+type externalFactory struct {
+	machineInfoFactory info.MachineInfoFactory
+	// Information about the mounted cgroup subsystems.
+	cgroupSubsystems map[string]string
+	includedMetrics  container.MetricSet
+
+	dir      string
+	lock     sync.Mutex
+	plugins  map[string]*containerPlugin // keyed by socket
+	lastScan time.Time
+	// unhandled holds when the cgroups that no plugin handles were last
+	// asked about, keyed by name. It is reset when a plugin registers, which
+	// bumps generation.
+	unhandled  map[string]time.Time
+	generation int
+	// newClient is replaced in tests.
+	newClient func(socket string) (pluginapi.Client, error)
+}
+
+func (f *externalFactory) String() string {
+	return "external"
+}
+
+// Plugins decide for themselves which cgroups they handle, and may well
+// claim containers a built-in factory would also accept.
+func (f *externalFactory) Specialized() {}
+
+// scan connects to plugins that appeared in the plugin directory and drops
+// those whose socket is gone. Plugins that fail to identify themselves are
+// retried on the next scan. Must be called with the lock held.
+func (f *externalFactory) scan() {
+	f.lastScan = time.Now()
+	sockets, err := filepath.Glob(filepath.Join(f.dir, "*.sock"))
+	if err != nil {
+		klog.Warningf("Failed to list container plugins in %q: %v", f.dir, err)
+		return
+	}
+	found := make(map[string]bool, len(sockets))
+	for _, socket := range sockets {
+		found[socket] = true
+		if _, ok := f.plugins[socket]; ok {
+			continue
+		}
+		client, err := f.newClient(socket)
+		if err != nil {
+			klog.Warningf("Failed to create client for container plugin %q: %v", socket, err)
+			continue
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), *ArgPluginTimeout)
+		pluginInfo, err := client.GetPluginInfo(ctx)
+		cancel()
+		if err != nil {
+			klog.V(4).Infof("Container plugin %q not ready: %v", socket, err)
+			_ = client.Close()
+			continue
+		}
+		klog.Infof("Registered container plugin %s %s at %q", pluginInfo.Name, pluginInfo.Version, socket)
+		f.plugins[socket] = &containerPlugin{name: pluginInfo.Name, socket: socket, client: client}
+		// The new plugin may handle cgroups the others did not.
+		f.unhandled = map[string]time.Time{}
+		f.generation++
+	}
+	for name, asked := range f.unhandled {
+		if time.Since(asked) >= unhandledTTL {
+			delete(f.unhandled, name)
+		}
+	}
+	for socket, p := range f.plugins {
+		if !found[socket] {
+			klog.Infof("Container plugin %s at %q is gone", p.name, socket)
+			_ = p.client.Close()
+			delete(f.plugins, socket)
+		}
+	}
+}
+
+// currentPlugins returns the plugins in the order of their sockets and the
+// generation they belong to, rescanning the plugin directory if it is due.
+func (f *externalFactory) currentPlugins() ([]*containerPlugin, int) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	if time.Since(f.lastScan) >= rescanInterval {
+		f.scan()
+	}
+	plugins := make([]*containerPlugin, 0, len(f.plugins))
+	for _, p := range f.plugins {
+		plugins = append(plugins, p)
+	}
+	sort.Slice(plugins, func(i, j int) bool { return plugins[i].socket < plugins[j].socket })
+	return plugins, f.generation
+}
+
+// isUnhandled returns whether no plugin handled the cgroup when last asked,
+// less than unhandledTTL ago.
+func (f *externalFactory) isUnhandled(name string) bool {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	asked, ok := f.unhandled[name]
+	return ok && time.Since(asked) < unhandledTTL
+}
+
+// setUnhandled remembers that none of the plugins of the generation handles
+// the cgroup, unless a plugin registered since.
+func (f *externalFactory) setUnhandled(name string, generation int) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	if generation == f.generation {
+		f.unhandled[name] = time.Now()
+	}
+}
+
+// canHandleResult is the answer of a plugin to CanHandle.
+type canHandleResult struct {
+	handle, accept bool
+	err            error
+}
+
+// pluginFor returns the first plugin, in the order of their sockets, that
+// handles the cgroup, if any. The plugins are asked concurrently.
+func (f *externalFactory) pluginFor(name string) (*containerPlugin, bool) {
+	plugins, generation := f.currentPlugins()
+	if f.isUnhandled(name) {
+		return nil, false
+	}
+	timeout := canHandleTimeout
+	if *ArgPluginTimeout < timeout {
+		timeout = *ArgPluginTimeout
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	results := make([]canHandleResult, len(plugins))
+	var wg sync.WaitGroup
+	for i, p := range plugins {
+		wg.Add(1)
+		go func(i int, p *containerPlugin) {
+			defer wg.Done()
+			r := &results[i]
+			r.handle, r.accept, r.err = p.client.CanHandle(ctx, name)
+		}(i, p)
+	}
+	wg.Wait()
+
+	failed := false
+	for i, r := range results {
+		if r.err != nil {
+			klog.V(4).Infof("Container plugin %s failed to tell whether it handles %q: %v", plugins[i].name, name, r.err)
+			failed = true
+			continue
+		}
+		if r.handle {
+			return plugins[i], r.accept
+		}
+	}
+	// Plugins that failed to answer are asked again next time.
+	if !failed {
+		f.setUnhandled(name, generation)
+	}
+	return nil, false
+}
+
+func (f *externalFactory) NewContainerHandler(name string, metadataEnvAllowList []string, inHostNamespace bool) (container.ContainerHandler, error) {
+	p, _ := f.pluginFor(name)
+	if p == nil {
+		return nil, fmt.Errorf("no container plugin handles %q", name)
+	}
+	return newExternalContainerHandler(p, name, f.machineInfoFactory, f.cgroupSubsystems, inHostNamespace, f.includedMetrics)
+}
+
+func (f *externalFactory) CanHandleAndAccept(name string) (bool, bool, error) {
+	p, accept := f.pluginFor(name)
+	return p != nil, accept, nil
+}
+
+func (f *externalFactory) DebugInfo() map[string][]string {
+	plugins := []string{}
+	current, _ := f.currentPlugins()
+	for _, p := range current {
+		plugins = append(plugins, fmt.Sprintf("%s at %s", p.name, p.socket))
+	}
+	return map[string][]string{
+		"Container plugins": plugins,
+	}
+}
+
+// Register root container before running this function!
+func Register(factory info.MachineInfoFactory, fsInfo fs.FsInfo, includedMetrics container.MetricSet) error {
+	if *ArgPluginDir == "" {
+		return fmt.Errorf("no container plugin directory configured")
+	}
+
+	cgroupSubsystems, err := libcontainer.GetCgroupSubsystems(includedMetrics)
+	if err != nil {
+		return fmt.Errorf("failed to get cgroup subsystems: %v", err)
+	}
+
+	klog.V(1).Infof("Registering external container plugin factory for %q", *ArgPluginDir)
+	f := &externalFactory{
+		machineInfoFactory: factory,
+		cgroupSubsystems:   cgroupSubsystems,
+		includedMetrics:    includedMetrics,
+		dir:                *ArgPluginDir,
+		plugins:            map[string]*containerPlugin{},
+		unhandled:          map[string]time.Time{},
+		newClient:          pluginapi.NewClient,
+	}
+	container.RegisterContainerHandlerFactory(f, []watcher.ContainerWatchSource{watcher.Raw})
+	return nil
+}
@@ -0,0 +1,202 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package external
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/google/cadvisor/container/external/pluginapi"
+	info "github.com/google/cadvisor/info/v1"
+)
+
+type fakeClient struct {
+	name       string
+	containers map[string]*pluginapi.Container
+	closed     bool
+	// hang makes CanHandle wait for its context to end.
+	hang  bool
+	calls int32
+}
+
+func (c *fakeClient) GetPluginInfo(ctx context.Context) (*pluginapi.PluginInfo, error) {
+	if c.name == "" {
+		return nil, fmt.Errorf("not ready")
+	}
+	return &pluginapi.PluginInfo{Name: c.name}, nil
+}
+
+func (c *fakeClient) CanHandle(ctx context.Context, name string) (bool, bool, error) {
+	atomic.AddInt32(&c.calls, 1)
+	if c.hang {
+		<-ctx.Done()
+		return false, false, ctx.Err()
+	}
+	_, ok := c.containers[name]
+	return ok, ok, nil
+}
+
+func (c *fakeClient) GetContainer(ctx context.Context, name string) (*pluginapi.Container, error) {
+	if cont, ok := c.containers[name]; ok {
+		return cont, nil
+	}
+	return nil, fmt.Errorf("no container %q", name)
+}
+
+func (c *fakeClient) GetStats(ctx context.Context, name string) (*info.ContainerStats, error) {
+	return &info.ContainerStats{Cpu: info.CpuStats{Usage: info.CpuUsage{Total: 42}}}, nil
+}
+
+func (c *fakeClient) Close() error {
+	c.closed = true
+	return nil
+}
+
+func newTestFactory(dir string, clients map[string]*fakeClient) *externalFactory {
+	return &externalFactory{
+		dir:       dir,
+		plugins:   map[string]*containerPlugin{},
+		unhandled: map[string]time.Time{},
+		newClient: func(socket string) (pluginapi.Client, error) {
+			return clients[socket], nil
+		},
+	}
+}
+
+func TestFactory(t *testing.T) {
+	dir := t.TempDir()
+	clients := map[string]*fakeClient{
+		filepath.Join(dir, "a.sock"): {name: "a", containers: map[string]*pluginapi.Container{
+			"/myruntime/abc": {ID: "abc", Aliases: []string{"web"}, Namespace: "myruntime", Labels: map[string]string{"app": "web"}, StatsFromPlugin: true},
+		}},
+		filepath.Join(dir, "b.sock"):       {},
+		filepath.Join(dir, "ignored.json"): {name: "ignored"},
+	}
+	for socket := range clients {
+		assert.NoError(t, os.WriteFile(socket, nil, 0o600))
+	}
+	f := newTestFactory(dir, clients)
+
+	canHandle, canAccept, err := f.CanHandleAndAccept("/myruntime/abc")
+	assert.NoError(t, err)
+	assert.True(t, canHandle)
+	assert.True(t, canAccept)
+	canHandle, _, err = f.CanHandleAndAccept("/system.slice/sshd.service")
+	assert.NoError(t, err)
+	assert.False(t, canHandle)
+
+	// b is not ready and is retried on the next scan.
+	assert.True(t, clients[filepath.Join(dir, "b.sock")].closed)
+	assert.Equal(t, map[string][]string{"Container plugins": {"a at " + filepath.Join(dir, "a.sock")}}, f.DebugInfo())
+
+	handler, err := f.NewContainerHandler("/myruntime/abc", nil, true)
+	assert.NoError(t, err)
+	ref, err := handler.ContainerReference()
+	assert.NoError(t, err)
+	assert.Equal(t, info.ContainerReference{Id: "abc", Name: "/myruntime/abc", Aliases: []string{"web"}, Namespace: "myruntime"}, ref)
+	assert.Equal(t, map[string]string{"app": "web"}, handler.GetContainerLabels())
+	stats, err := handler.GetStats()
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(42), stats.Cpu.Usage.Total)
+
+	// Plugins whose socket is removed are dropped on the next scan.
+	assert.NoError(t, os.Remove(filepath.Join(dir, "a.sock")))
+	f.lastScan = time.Time{}
+	canHandle, _, err = f.CanHandleAndAccept("/myruntime/abc")
+	assert.NoError(t, err)
+	assert.False(t, canHandle)
+	assert.True(t, clients[filepath.Join(dir, "a.sock")].closed)
+}
+
+func TestPluginForCachesUnhandled(t *testing.T) {
+	dir := t.TempDir()
+	a := filepath.Join(dir, "a.sock")
+	clients := map[string]*fakeClient{
+		a: {name: "a", containers: map[string]*pluginapi.Container{"/myruntime/abc": {ID: "abc"}}},
+	}
+	assert.NoError(t, os.WriteFile(a, nil, 0o600))
+	f := newTestFactory(dir, clients)
+
+	for i := 0; i < 3; i++ {
+		canHandle, _, err := f.CanHandleAndAccept("/system.slice/sshd.service")
+		assert.NoError(t, err)
+		assert.False(t, canHandle)
+	}
+	assert.EqualValues(t, 1, atomic.LoadInt32(&clients[a].calls))
+
+	// Handled cgroups are not cached.
+	for i := 0; i < 2; i++ {
+		canHandle, _, err := f.CanHandleAndAccept("/myruntime/abc")
+		assert.NoError(t, err)
+		assert.True(t, canHandle)
+	}
+	assert.EqualValues(t, 3, atomic.LoadInt32(&clients[a].calls))
+
+	// A new plugin may handle what the others did not.
+	b := filepath.Join(dir, "b.sock")
+	clients[b] = &fakeClient{name: "b", containers: map[string]*pluginapi.Container{"/system.slice/sshd.service": {ID: "sshd"}}}
+	assert.NoError(t, os.WriteFile(b, nil, 0o600))
+	f.lastScan = time.Time{}
+	canHandle, _, err := f.CanHandleAndAccept("/system.slice/sshd.service")
+	assert.NoError(t, err)
+	assert.True(t, canHandle)
+
+	// So are expired answers.
+	f.lock.Lock()
+	f.unhandled["/other"] = time.Now().Add(-unhandledTTL)
+	f.lock.Unlock()
+	calls := atomic.LoadInt32(&clients[a].calls)
+	canHandle, _, err = f.CanHandleAndAccept("/other")
+	assert.NoError(t, err)
+	assert.False(t, canHandle)
+	assert.Equal(t, calls+1, atomic.LoadInt32(&clients[a].calls))
+}
+
+func TestPluginForAsksConcurrently(t *testing.T) {
+	dir := t.TempDir()
+	clients := map[string]*fakeClient{
+		filepath.Join(dir, "a.sock"): {name: "a", hang: true},
+		filepath.Join(dir, "b.sock"): {name: "b", hang: true},
+		filepath.Join(dir, "c.sock"): {name: "c", containers: map[string]*pluginapi.Container{"/myruntime/abc": {ID: "abc"}}},
+	}
+	for socket := range clients {
+		assert.NoError(t, os.WriteFile(socket, nil, 0o600))
+	}
+	f := newTestFactory(dir, clients)
+
+	start := time.Now()
+	p, accept := f.pluginFor("/myruntime/abc")
+	assert.Less(t, time.Since(start), 2*canHandleTimeout)
+	if assert.NotNil(t, p) {
+		assert.Equal(t, "c", p.name)
+	}
+	assert.True(t, accept)
+
+	// Plugins that failed to answer are asked again.
+	p, _ = f.pluginFor("/system.slice/sshd.service")
+	assert.Nil(t, p)
+	p, _ = f.pluginFor("/system.slice/sshd.service")
+	assert.Nil(t, p)
+	assert.EqualValues(t, 3, atomic.LoadInt32(&clients[filepath.Join(dir, "a.sock")].calls))
+}
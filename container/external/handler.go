@@ -0,0 +1,170 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+// Handler for containers of out-of-tree container plugins.
+package external
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/cadvisor/container"
+	"github.com/google/cadvisor/container/common"
+	containerlibcontainer "github.com/google/cadvisor/container/libcontainer"
+	info "github.com/google/cadvisor/info/v1"
+)
+
+type externalContainerHandler struct {
+	plugin *containerPlugin
+
+	machineInfoFactory info.MachineInfoFactory
+
+	// Absolute path to the cgroup hierarchies of this container.
+	// (e.g.: "cpu" -> "/sys/fs/cgroup/cpu/test")
+	cgroupPaths map[string]string
+
+	labels          map[string]string
+	envs            map[string]string
+	image           string
+	hasNetwork      bool
+	statsFromPlugin bool
+	reference       info.ContainerReference
+
+	libcontainerHandler *containerlibcontainer.Handler
+}
+
+var _ container.ContainerHandler = &externalContainerHandler{}
+
+// newExternalContainerHandler returns a new container.ContainerHandler
+func newExternalContainerHandler(
+	p *containerPlugin,
+	name string,
+	machineInfoFactory info.MachineInfoFactory,
+	cgroupSubsystems map[string]string,
+	inHostNamespace bool,
+	includedMetrics container.MetricSet,
+) (container.ContainerHandler, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), *ArgPluginTimeout)
+	defer cancel()
+	c, err := p.client.GetContainer(ctx, name)
+	if err != nil {
+		return nil, fmt.Errorf("container plugin %s failed to get container %q: %v", p.name, name, err)
+	}
+
+	cgroupPaths := common.MakeCgroupPaths(cgroupSubsystems, name)
+	cgroupManager, err := containerlibcontainer.NewCgroupManager(name, cgroupPaths)
+	if err != nil {
+		return nil, err
+	}
+
+	rootFs := "/"
+	if !inHostNamespace {
+		rootFs = "/rootfs"
+	}
+
+	metrics := common.RemoveNetMetrics(includedMetrics, !c.HasNetwork)
+
+	return &externalContainerHandler{
+		plugin:             p,
+		machineInfoFactory: machineInfoFactory,
+		cgroupPaths:        cgroupPaths,
+		labels:             c.Labels,
+		envs:               c.Envs,
+		image:              c.Image,
+		hasNetwork:         c.HasNetwork,
+		statsFromPlugin:    c.StatsFromPlugin,
+		reference: info.ContainerReference{
+			Id:        c.ID,
+			Name:      name,
+			Aliases:   c.Aliases,
+			Namespace: c.Namespace,
+		},
+		libcontainerHandler: containerlibcontainer.NewHandler(cgroupManager, rootFs, int(c.Pid), metrics),
+	}, nil
+}
+
+func (h *externalContainerHandler) ContainerReference() (info.ContainerReference, error) {
+	return h.reference, nil
+}
+
+func (h *externalContainerHandler) GetSpec() (info.ContainerSpec, error) {
+	spec, err := common.GetSpec(h.cgroupPaths, h.machineInfoFactory, h.hasNetwork, false)
+	spec.Labels = h.labels
+	spec.Envs = h.envs
+	spec.Image = h.image
+	return spec, err
+}
+
+func (h *externalContainerHandler) GetStats() (*info.ContainerStats, error) {
+	if !h.statsFromPlugin {
+		return h.libcontainerHandler.GetStats()
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), *ArgPluginTimeout)
+	defer cancel()
+	stats, err := h.plugin.client.GetStats(ctx, h.reference.Name)
+	if err != nil {
+		return nil, fmt.Errorf("container plugin %s failed to get stats of %q: %v", h.plugin.name, h.reference.Name, err)
+	}
+	return stats, nil
+}
+
+func (h *externalContainerHandler) ListContainers(listType container.ListType) ([]info.ContainerReference, error) {
+	return []info.ContainerReference{}, nil
+}
+
+func (h *externalContainerHandler) ListProcesses(listType container.ListType) ([]int, error) {
+	return h.libcontainerHandler.GetProcesses()
+}
+
+func (h *externalContainerHandler) GetCgroupPath(resource string) (string, error) {
+	var res string
+	if !common.IsCgroup2UnifiedMode() {
+		res = resource
+	}
+	path, ok := h.cgroupPaths[res]
+	if !ok {
+		return "", fmt.Errorf("could not find path for resource %q for container %q", resource, h.reference.Name)
+	}
+	return path, nil
+}
+
+func (h *externalContainerHandler) GetContainerLabels() map[string]string {
+	return h.labels
+}
+
+func (h *externalContainerHandler) GetContainerIPAddress() string {
+	return ""
+}
+
+func (h *externalContainerHandler) Exists() bool {
+	return common.CgroupExists(h.cgroupPaths)
+}
+
+func (h *externalContainerHandler) Type() container.ContainerType {
+	return container.ContainerTypeExternal
+}
+
+func (h *externalContainerHandler) GetExitCode() (int, error) {
+	return -1, fmt.Errorf("exit code not available for containers of plugin %s", h.plugin.name)
+}
+
+// Nothing to start up.
+func (h *externalContainerHandler) Start() {}
+
+// Nothing to clean up.
+func (h *externalContainerHandler) Cleanup() {
+	h.libcontainerHandler.Close()
+}
@@ -0,0 +1,231 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package pluginapi is the API between cAdvisor and out-of-tree container
+// plugins defined in plugin.proto, with a client for cAdvisor and a server
+// for plugins written in Go. Messages are encoded by hand to keep plugins
+// free of generated code.
+package pluginapi
+
+import "github.com/google/cadvisor/utils/grpcwire"
+
+// ServiceName is the full name of the ContainerPlugin service.
+const ServiceName = "cadvisor.container.v1alpha1.ContainerPlugin"
+
+// PluginInfo identifies a plugin.
+type PluginInfo struct {
+	Name    string
+	Version string
+}
+
+// Container is the metadata of a container handled by a plugin.
+type Container struct {
+	ID        string
+	Aliases   []string
+	Namespace string
+	Labels    map[string]string
+	Envs      map[string]string
+	Image     string
+	// A process of the container, used for network stats with HasNetwork.
+	Pid        int32
+	HasNetwork bool
+	// StatsFromPlugin is set for containers whose stats are read with
+	// GetStats instead of from their cgroup, e.g. for VM based runtimes
+	// whose cgroup only holds the VMM.
+	StatsFromPlugin bool
+}
+
+// message is implemented by all messages, which plugins and cAdvisor both
+// encode and decode.
+type message interface {
+	grpcwire.Marshaler
+	grpcwire.Unmarshaler
+}
+
+type getPluginInfoRequest struct{}
+
+func (m *getPluginInfoRequest) Marshal() []byte { return nil }
+
+func (m *getPluginInfoRequest) Unmarshal(b []byte) error {
+	_, err := grpcwire.ParseFields(b)
+	return err
+}
+
+type getPluginInfoResponse struct {
+	info PluginInfo
+}
+
+func (m *getPluginInfoResponse) Marshal() []byte {
+	b := grpcwire.AppendString(nil, 1, m.info.Name)
+	return grpcwire.AppendString(b, 2, m.info.Version)
+}
+
+func (m *getPluginInfoResponse) Unmarshal(b []byte) error {
+	fields, err := grpcwire.ParseFields(b)
+	if err != nil {
+		return err
+	}
+	for _, f := range fields {
+		switch f.Num {
+		case 1:
+			m.info.Name = string(f.Bytes)
+		case 2:
+			m.info.Version = string(f.Bytes)
+		}
+	}
+	return nil
+}
+
+// nameRequest is the request of all RPCs about a single container.
+type nameRequest struct {
+	name string
+}
+
+func (m *nameRequest) Marshal() []byte {
+	return grpcwire.AppendString(nil, 1, m.name)
+}
+
+func (m *nameRequest) Unmarshal(b []byte) error {
+	fields, err := grpcwire.ParseFields(b)
+	if err != nil {
+		return err
+	}
+	for _, f := range fields {
+		if f.Num == 1 {
+			m.name = string(f.Bytes)
+		}
+	}
+	return nil
+}
+
+type canHandleResponse struct {
+	handle bool
+	accept bool
+}
+
+func (m *canHandleResponse) Marshal() []byte {
+	b := grpcwire.AppendBool(nil, 1, m.handle)
+	return grpcwire.AppendBool(b, 2, m.accept)
+}
+
+func (m *canHandleResponse) Unmarshal(b []byte) error {
+	fields, err := grpcwire.ParseFields(b)
+	if err != nil {
+		return err
+	}
+	for _, f := range fields {
+		switch f.Num {
+		case 1:
+			m.handle = f.Varint != 0
+		case 2:
+			m.accept = f.Varint != 0
+		}
+	}
+	return nil
+}
+
+func (c *Container) Marshal() []byte {
+	var b []byte
+	b = grpcwire.AppendString(b, 1, c.ID)
+	for _, alias := range c.Aliases {
+		// Repeated strings are kept even if empty.
+		b = grpcwire.AppendMessage(b, 2, []byte(alias))
+	}
+	b = grpcwire.AppendString(b, 3, c.Namespace)
+	b = grpcwire.AppendMap(b, 4, c.Labels)
+	b = grpcwire.AppendMap(b, 5, c.Envs)
+	b = grpcwire.AppendString(b, 6, c.Image)
+	b = grpcwire.AppendVarint(b, 7, uint64(c.Pid))
+	b = grpcwire.AppendBool(b, 8, c.HasNetwork)
+	return grpcwire.AppendBool(b, 9, c.StatsFromPlugin)
+}
+
+func (c *Container) Unmarshal(b []byte) error {
+	fields, err := grpcwire.ParseFields(b)
+	if err != nil {
+		return err
+	}
+	c.Labels = map[string]string{}
+	c.Envs = map[string]string{}
+	for _, f := range fields {
+		switch f.Num {
+		case 1:
+			c.ID = string(f.Bytes)
+		case 2:
+			c.Aliases = append(c.Aliases, string(f.Bytes))
+		case 3:
+			c.Namespace = string(f.Bytes)
+		case 4:
+			err = grpcwire.ParseMapEntry(f.Bytes, c.Labels)
+		case 5:
+			err = grpcwire.ParseMapEntry(f.Bytes, c.Envs)
+		case 6:
+			c.Image = string(f.Bytes)
+		case 7:
+			c.Pid = int32(f.Varint)
+		case 8:
+			c.HasNetwork = f.Varint != 0
+		case 9:
+			c.StatsFromPlugin = f.Varint != 0
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type getContainerResponse struct {
+	container Container
+}
+
+func (m *getContainerResponse) Marshal() []byte {
+	return grpcwire.AppendMessage(nil, 1, m.container.Marshal())
+}
+
+func (m *getContainerResponse) Unmarshal(b []byte) error {
+	fields, err := grpcwire.ParseFields(b)
+	if err != nil {
+		return err
+	}
+	for _, f := range fields {
+		if f.Num == 1 {
+			if err := m.container.Unmarshal(f.Bytes); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+type getStatsResponse struct {
+	statsJSON []byte
+}
+
+func (m *getStatsResponse) Marshal() []byte {
+	return grpcwire.AppendBytes(nil, 1, m.statsJSON)
+}
+
+func (m *getStatsResponse) Unmarshal(b []byte) error {
+	fields, err := grpcwire.ParseFields(b)
+	if err != nil {
+		return err
+	}
+	for _, f := range fields {
+		if f.Num == 1 {
+			m.statsJSON = append([]byte(nil), f.Bytes...)
+		}
+	}
+	return nil
+}
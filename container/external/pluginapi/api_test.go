@@ -0,0 +1,64 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pluginapi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"github.com/google/cadvisor/utils/grpcwire"
+)
+
+func TestContainerRoundTrip(t *testing.T) {
+	c := Container{
+		ID:              "abc",
+		Aliases:         []string{"abc", "web"},
+		Namespace:       "myruntime",
+		Labels:          map[string]string{"app": "web", "tier": "frontend"},
+		Envs:            map[string]string{"REGION": "FRA"},
+		Image:           "registry.example.com/web:1.0",
+		Pid:             1234,
+		HasNetwork:      true,
+		StatsFromPlugin: true,
+	}
+	resp := &getContainerResponse{container: c}
+	b, err := grpcwire.Codec{}.Marshal(resp)
+	assert.NoError(t, err)
+
+	decoded := &getContainerResponse{}
+	assert.NoError(t, grpcwire.Codec{}.Unmarshal(b, decoded))
+	assert.Equal(t, c, decoded.container)
+
+	// Maps are encoded in key order, so messages are reproducible.
+	again, err := grpcwire.Codec{}.Marshal(resp)
+	assert.NoError(t, err)
+	assert.Equal(t, b, again)
+}
+
+func TestUnknownFieldsAreSkipped(t *testing.T) {
+	b := grpcwire.AppendBool(nil, 2, true)
+	b = protowire.AppendTag(b, 99, protowire.Fixed32Type)
+	b = protowire.AppendFixed32(b, 42)
+
+	resp := &canHandleResponse{}
+	assert.NoError(t, grpcwire.Codec{}.Unmarshal(b, resp))
+	assert.Equal(t, &canHandleResponse{accept: true}, resp)
+
+	assert.Error(t, grpcwire.Codec{}.Unmarshal([]byte{0xff}, &canHandleResponse{}))
+	_, err := grpcwire.Codec{}.Marshal("not a message")
+	assert.Error(t, err)
+}
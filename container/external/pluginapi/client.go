@@ -0,0 +1,100 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pluginapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	info "github.com/google/cadvisor/info/v1"
+	"github.com/google/cadvisor/utils/grpcwire"
+)
+
+const maxMsgSize = 16 * 1024 * 1024 // 16MB
+
+const methodPrefix = "/" + ServiceName + "/"
+
+// Client talks to a container plugin.
+type Client interface {
+	GetPluginInfo(ctx context.Context) (*PluginInfo, error)
+	CanHandle(ctx context.Context, name string) (handle bool, accept bool, err error)
+	GetContainer(ctx context.Context, name string) (*Container, error)
+	GetStats(ctx context.Context, name string) (*info.ContainerStats, error)
+	Close() error
+}
+
+type client struct {
+	conn *grpc.ClientConn
+}
+
+// NewClient returns a Client for the plugin listening on the unix socket.
+// The connection is established lazily.
+func NewClient(socket string) (Client, error) {
+	conn, err := grpc.NewClient("unix://"+socket,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultCallOptions(
+			grpc.MaxCallRecvMsgSize(maxMsgSize),
+			grpc.ForceCodec(grpcwire.Codec{}),
+		),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create plugin client for %q: %v", socket, err)
+	}
+	return &client{conn: conn}, nil
+}
+
+func (c *client) GetPluginInfo(ctx context.Context) (*PluginInfo, error) {
+	resp := &getPluginInfoResponse{}
+	if err := c.conn.Invoke(ctx, methodPrefix+"GetPluginInfo", &getPluginInfoRequest{}, resp); err != nil {
+		return nil, err
+	}
+	return &resp.info, nil
+}
+
+func (c *client) CanHandle(ctx context.Context, name string) (bool, bool, error) {
+	resp := &canHandleResponse{}
+	if err := c.conn.Invoke(ctx, methodPrefix+"CanHandle", &nameRequest{name: name}, resp); err != nil {
+		return false, false, err
+	}
+	return resp.handle, resp.accept, nil
+}
+
+func (c *client) GetContainer(ctx context.Context, name string) (*Container, error) {
+	resp := &getContainerResponse{}
+	if err := c.conn.Invoke(ctx, methodPrefix+"GetContainer", &nameRequest{name: name}, resp); err != nil {
+		return nil, err
+	}
+	return &resp.container, nil
+}
+
+func (c *client) GetStats(ctx context.Context, name string) (*info.ContainerStats, error) {
+	resp := &getStatsResponse{}
+	if err := c.conn.Invoke(ctx, methodPrefix+"GetStats", &nameRequest{name: name}, resp); err != nil {
+		return nil, err
+	}
+	stats := &info.ContainerStats{}
+	if err := json.Unmarshal(resp.statsJSON, stats); err != nil {
+		return nil, fmt.Errorf("failed to decode stats of %q: %v", name, err)
+	}
+	return stats, nil
+}
+
+func (c *client) Close() error {
+	return c.conn.Close()
+}
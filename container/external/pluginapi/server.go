@@ -0,0 +1,113 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pluginapi
+
+import (
+	"context"
+	"encoding/json"
+
+	"google.golang.org/grpc"
+
+	info "github.com/google/cadvisor/info/v1"
+	"github.com/google/cadvisor/utils/grpcwire"
+)
+
+// Server is implemented by container plugins written in Go.
+type Server interface {
+	GetPluginInfo(ctx context.Context) (*PluginInfo, error)
+	// CanHandle reports whether the plugin handles the cgroup and whether
+	// cAdvisor should collect it, see
+	// container.ContainerHandlerFactory.CanHandleAndAccept.
+	CanHandle(ctx context.Context, name string) (handle bool, accept bool, err error)
+	GetContainer(ctx context.Context, name string) (*Container, error)
+	// GetStats is only called for containers with StatsFromPlugin set.
+	GetStats(ctx context.Context, name string) (*info.ContainerStats, error)
+}
+
+// NewServer returns a grpc.Server serving the plugin. It is to be served on
+// a unix socket in cAdvisor's --container_plugin_dir.
+func NewServer(impl Server, opts ...grpc.ServerOption) *grpc.Server {
+	s := grpc.NewServer(append([]grpc.ServerOption{grpc.ForceServerCodec(grpcwire.Codec{})}, opts...)...)
+	s.RegisterService(&serviceDesc, impl)
+	return s
+}
+
+func unaryHandler(method string, newRequest func() message, call func(srv Server, ctx context.Context, req message) (message, error)) grpc.MethodHandler {
+	return func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+		req := newRequest()
+		if err := dec(req); err != nil {
+			return nil, err
+		}
+		if interceptor == nil {
+			return call(srv.(Server), ctx, req)
+		}
+		info := &grpc.UnaryServerInfo{Server: srv, FullMethod: methodPrefix + method}
+		return interceptor(ctx, req, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+			return call(srv.(Server), ctx, req.(message))
+		})
+	}
+}
+
+var serviceDesc = grpc.ServiceDesc{
+	ServiceName: ServiceName,
+	HandlerType: (*Server)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetPluginInfo",
+			Handler: unaryHandler("GetPluginInfo", func() message { return &getPluginInfoRequest{} }, func(srv Server, ctx context.Context, _ message) (message, error) {
+				info, err := srv.GetPluginInfo(ctx)
+				if err != nil {
+					return nil, err
+				}
+				return &getPluginInfoResponse{info: *info}, nil
+			}),
+		},
+		{
+			MethodName: "CanHandle",
+			Handler: unaryHandler("CanHandle", func() message { return &nameRequest{} }, func(srv Server, ctx context.Context, req message) (message, error) {
+				handle, accept, err := srv.CanHandle(ctx, req.(*nameRequest).name)
+				if err != nil {
+					return nil, err
+				}
+				return &canHandleResponse{handle: handle, accept: accept}, nil
+			}),
+		},
+		{
+			MethodName: "GetContainer",
+			Handler: unaryHandler("GetContainer", func() message { return &nameRequest{} }, func(srv Server, ctx context.Context, req message) (message, error) {
+				c, err := srv.GetContainer(ctx, req.(*nameRequest).name)
+				if err != nil {
+					return nil, err
+				}
+				return &getContainerResponse{container: *c}, nil
+			}),
+		},
+		{
+			MethodName: "GetStats",
+			Handler: unaryHandler("GetStats", func() message { return &nameRequest{} }, func(srv Server, ctx context.Context, req message) (message, error) {
+				stats, err := srv.GetStats(ctx, req.(*nameRequest).name)
+				if err != nil {
+					return nil, err
+				}
+				b, err := json.Marshal(stats)
+				if err != nil {
+					return nil, err
+				}
+				return &getStatsResponse{statsJSON: b}, nil
+			}),
+		},
+	},
+	Metadata: "plugin.proto",
+}
@@ -0,0 +1,102 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pluginapi
+
+import (
+	"context"
+	"net"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	info "github.com/google/cadvisor/info/v1"
+)
+
+type fakeServer struct{}
+
+func (fakeServer) GetPluginInfo(ctx context.Context) (*PluginInfo, error) {
+	return &PluginInfo{Name: "fake", Version: "1.0"}, nil
+}
+
+func (fakeServer) CanHandle(ctx context.Context, name string) (bool, bool, error) {
+	return name == "/fake/abc", true, nil
+}
+
+func (fakeServer) GetContainer(ctx context.Context, name string) (*Container, error) {
+	if name != "/fake/abc" {
+		return nil, status.Errorf(codes.NotFound, "no container %q", name)
+	}
+	return &Container{ID: "abc", Labels: map[string]string{"app": "web"}}, nil
+}
+
+func (fakeServer) GetStats(ctx context.Context, name string) (*info.ContainerStats, error) {
+	return &info.ContainerStats{
+		Timestamp: time.Unix(1700000000, 0).UTC(),
+		Cpu:       info.CpuStats{Usage: info.CpuUsage{Total: 5000}},
+	}, nil
+}
+
+func TestClientServer(t *testing.T) {
+	socket := filepath.Join(t.TempDir(), "fake.sock")
+	listener, err := net.Listen("unix", socket)
+	assert.NoError(t, err)
+	var lock sync.Mutex
+	var intercepted []string
+	server := NewServer(fakeServer{}, grpc.UnaryInterceptor(func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		lock.Lock()
+		intercepted = append(intercepted, info.FullMethod)
+		lock.Unlock()
+		return handler(ctx, req)
+	}))
+	go func() { _ = server.Serve(listener) }()
+	defer server.Stop()
+
+	c, err := NewClient(socket)
+	assert.NoError(t, err)
+	defer c.Close()
+	ctx := context.Background()
+
+	pluginInfo, err := c.GetPluginInfo(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, &PluginInfo{Name: "fake", Version: "1.0"}, pluginInfo)
+
+	handle, accept, err := c.CanHandle(ctx, "/fake/abc")
+	assert.NoError(t, err)
+	assert.True(t, handle)
+	assert.True(t, accept)
+
+	cont, err := c.GetContainer(ctx, "/fake/abc")
+	assert.NoError(t, err)
+	assert.Equal(t, "abc", cont.ID)
+	assert.Equal(t, map[string]string{"app": "web"}, cont.Labels)
+
+	_, err = c.GetContainer(ctx, "/fake/def")
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	stats, err := c.GetStats(ctx, "/fake/abc")
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(5000), stats.Cpu.Usage.Total)
+	assert.Equal(t, time.Unix(1700000000, 0).UTC(), stats.Timestamp)
+
+	lock.Lock()
+	defer lock.Unlock()
+	assert.Contains(t, intercepted, "/"+ServiceName+"/GetStats")
+}
@@ -15,13 +15,16 @@
 package container
 
 import (
+	"errors"
 	"fmt"
 	"sort"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/google/cadvisor/fs"
 	info "github.com/google/cadvisor/info/v1"
+	"github.com/google/cadvisor/metrics/selfmetrics"
 	"github.com/google/cadvisor/watcher"
 
 	"k8s.io/klog/v2"
@@ -41,6 +44,49 @@ type ContainerHandlerFactory interface {
 	DebugInfo() map[string][]string
 }
 
+// SpecializedFactory is implemented by factories that handle a subset of the
+// containers a general runtime factory (e.g. containerd) would also accept,
+// such as the CRI API when asked for explicitly. They are asked before any
+// other factory.
+type SpecializedFactory interface {
+	ContainerHandlerFactory
+
+	// Specialized is a marker method and is never called.
+	Specialized()
+}
+
+// FallbackFactory is implemented by factories that handle cgroups any runtime
+// factory may claim, such as systemd units or gVisor containers. They are
+// asked after all other factories except raw.
+type FallbackFactory interface {
+	ContainerHandlerFactory
+
+	// Fallback is a marker method and is never called.
+	Fallback()
+}
+
+// HandlerWrapperFactory is implemented by factories that add to the handlers
+// other factories create, such as the gVisor factory, as the runtime factory
+// of a gVisor container has its metadata and labels but only runsc knows the
+// usage of its workload. The handlers of all other factories are passed to
+// them.
+type HandlerWrapperFactory interface {
+	ContainerHandlerFactory
+	// WrapHandler returns handler, or a handler wrapping it, for the named
+	// container.
+	WrapHandler(name string, handler ContainerHandler) ContainerHandler
+}
+
+// ImageStorageFactory is implemented by factories of runtimes that store
+// container images.
+type ImageStorageFactory interface {
+	ContainerHandlerFactory
+
+	// ImageStorage returns the image storage of the runtime, one per
+	// namespace for runtimes with namespaces.
+	ImageStorage() ([]info.ImageStorage, error)
+}
+
 // MetricKind represents the kind of metrics that cAdvisor exposes.
 type MetricKind string
 
@@ -62,11 +108,16 @@ const (
 	HugetlbUsageMetrics            MetricKind = "hugetlb"
 	PerfMetrics                    MetricKind = "perf_event"
 	ReferencedMemoryMetrics        MetricKind = "referenced_memory"
+	DaxMemoryMetrics               MetricKind = "dax_memory"
 	CPUTopologyMetrics             MetricKind = "cpu_topology"
 	ResctrlMetrics                 MetricKind = "resctrl"
 	CPUSetMetrics                  MetricKind = "cpuset"
 	OOMMetrics                     MetricKind = "oom_event"
 	PressureMetrics                MetricKind = "pressure"
+	ImageStorageMetrics            MetricKind = "image_storage"
+	NetworkFsMetrics               MetricKind = "network_fs"
+	AcceleratorUsageMetrics        MetricKind = "accelerator"
+	TopProcessMetrics              MetricKind = "top_process"
 )
 
 // AllMetrics represents all kinds of metrics that cAdvisor supported.
@@ -88,11 +139,16 @@ var AllMetrics = MetricSet{
 	HugetlbUsageMetrics:            struct{}{},
 	PerfMetrics:                    struct{}{},
 	ReferencedMemoryMetrics:        struct{}{},
+	DaxMemoryMetrics:               struct{}{},
 	CPUTopologyMetrics:             struct{}{},
 	ResctrlMetrics:                 struct{}{},
 	CPUSetMetrics:                  struct{}{},
 	OOMMetrics:                     struct{}{},
 	PressureMetrics:                struct{}{},
+	ImageStorageMetrics:            struct{}{},
+	NetworkFsMetrics:               struct{}{},
+	AcceleratorUsageMetrics:        struct{}{},
+	TopProcessMetrics:              struct{}{},
 }
 
 // AllNetworkMetrics represents all network metrics that cAdvisor supports.
@@ -109,12 +165,20 @@ func (mk MetricKind) String() string {
 
 type MetricSet map[MetricKind]struct{}
 
+// metricSetLock guards MetricSet values that are shared across components and
+// toggled at runtime through Enable and Disable.
+var metricSetLock sync.RWMutex
+
 func (ms MetricSet) Has(mk MetricKind) bool {
+	metricSetLock.RLock()
+	defer metricSetLock.RUnlock()
 	_, exists := ms[mk]
 	return exists
 }
 
 func (ms MetricSet) HasAny(ms1 MetricSet) bool {
+	metricSetLock.RLock()
+	defer metricSetLock.RUnlock()
 	for m := range ms1 {
 		if _, ok := ms[m]; ok {
 			return true
@@ -127,11 +191,48 @@ func (ms MetricSet) add(mk MetricKind) {
 	ms[mk] = struct{}{}
 }
 
+// Enable adds mk to the set in place. It is safe to call while other
+// goroutines are reading the set.
+func (ms MetricSet) Enable(mk MetricKind) {
+	metricSetLock.Lock()
+	defer metricSetLock.Unlock()
+	ms[mk] = struct{}{}
+}
+
+// Disable removes mk from the set in place. It is safe to call while other
+// goroutines are reading the set.
+func (ms MetricSet) Disable(mk MetricKind) {
+	metricSetLock.Lock()
+	defer metricSetLock.Unlock()
+	delete(ms, mk)
+}
+
+// Copy returns a snapshot of the set that is not affected by later calls to
+// Enable or Disable.
+func (ms MetricSet) Copy() MetricSet {
+	metricSetLock.RLock()
+	defer metricSetLock.RUnlock()
+	result := make(MetricSet, len(ms))
+	for kind := range ms {
+		result[kind] = struct{}{}
+	}
+	return result
+}
+
+// Len returns the number of metrics in the set.
+func (ms MetricSet) Len() int {
+	metricSetLock.RLock()
+	defer metricSetLock.RUnlock()
+	return len(ms)
+}
+
 func (ms MetricSet) String() string {
+	metricSetLock.RLock()
 	values := make([]string, 0, len(ms))
 	for metric := range ms {
 		values = append(values, string(metric))
 	}
+	metricSetLock.RUnlock()
 	sort.Strings(values)
 	return strings.Join(values, ",")
 }
@@ -153,9 +254,11 @@ func (ms *MetricSet) Set(value string) error {
 }
 
 func (ms MetricSet) Difference(ms1 MetricSet) MetricSet {
+	metricSetLock.RLock()
+	defer metricSetLock.RUnlock()
 	result := MetricSet{}
 	for kind := range ms {
-		if !ms1.Has(kind) {
+		if _, ok := ms1[kind]; !ok {
 			result.add(kind)
 		}
 	}
@@ -163,9 +266,11 @@ func (ms MetricSet) Difference(ms1 MetricSet) MetricSet {
 }
 
 func (ms MetricSet) Append(ms1 MetricSet) MetricSet {
+	metricSetLock.Lock()
+	defer metricSetLock.Unlock()
 	result := ms
 	for kind := range ms1 {
-		if !ms.Has(kind) {
+		if _, ok := ms[kind]; !ok {
 			result.add(kind)
 		}
 	}
@@ -254,17 +359,51 @@ func HasFactories() bool {
 	return len(factories) != 0
 }
 
+// ImageStorage returns the image storage of all registered runtimes that
+// report it. The storage of runtimes that succeed is returned along with the
+// errors of those that fail.
+func ImageStorage() ([]info.ImageStorage, error) {
+	factoriesLock.RLock()
+	defer factoriesLock.RUnlock()
+
+	var (
+		storage []info.ImageStorage
+		errs    []error
+	)
+	for _, factory := range factories[watcher.Raw] {
+		f, ok := factory.(ImageStorageFactory)
+		if !ok {
+			continue
+		}
+		s, err := f.ImageStorage()
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %v", f, err))
+			continue
+		}
+		storage = append(storage, s...)
+	}
+	return storage, errors.Join(errs...)
+}
+
 // Create a new ContainerHandler for the specified container.
 func NewContainerHandler(name string, watchType watcher.ContainerWatchSource, metadataEnvAllowList []string, inHostNamespace bool) (ContainerHandler, bool, error) {
 	factoriesLock.RLock()
 	defer factoriesLock.RUnlock()
 
+	filter := getFilter()
+	if !filter.AcceptsName(name) {
+		klog.V(3).Infof("Container %q is excluded by the container filter, ignoring.", name)
+		return nil, false, nil
+	}
+
 	// Create the ContainerHandler with the first factory that supports it.
 	// Note that since RawContainerHandler can support a wide range of paths,
 	// it's evaluated last just to make sure if any other ContainerHandler
 	// can support it.
 	for _, factory := range GetReorderedFactoryList(watchType) {
+		start := time.Now()
 		canHandle, canAccept, err := factory.CanHandleAndAccept(name)
+		selfmetrics.FactoryMatchDuration.WithLabelValues(factory.String()).Observe(time.Since(start).Seconds())
 		if err != nil {
 			klog.V(4).Infof("Error trying to work out if we can handle %s: %v", name, err)
 		}
@@ -275,6 +414,22 @@ func NewContainerHandler(name string, watchType watcher.ContainerWatchSource, me
 			}
 			klog.V(3).Infof("Using factory %q for container %q", factory, name)
 			handle, err := factory.NewContainerHandler(name, metadataEnvAllowList, inHostNamespace)
+			if err == nil {
+				handle = wrapHandler(name, watchType, factory, handle)
+			}
+			if err != nil || !filter.filtersSpecs() {
+				return handle, canAccept, err
+			}
+			spec, err := handle.GetSpec()
+			if err != nil {
+				klog.V(4).Infof("Failed to get the spec of container %q, not filtering it: %v", name, err)
+				return handle, canAccept, nil
+			}
+			if !filter.AcceptsSpec(spec) {
+				klog.V(3).Infof("Container %q with image %q is excluded by the container filter, ignoring.", name, spec.Image)
+				handle.Cleanup()
+				return nil, false, nil
+			}
 			return handle, canAccept, err
 		}
 		klog.V(4).Infof("Factory %q was unable to handle container %q", factory, name)
@@ -283,6 +438,17 @@ func NewContainerHandler(name string, watchType watcher.ContainerWatchSource, me
 	return nil, false, fmt.Errorf("no known factory can handle creation of container")
 }
 
+// wrapHandler passes the handler factory created for the named container to
+// the other HandlerWrapperFactory factories.
+func wrapHandler(name string, watchType watcher.ContainerWatchSource, factory ContainerHandlerFactory, handle ContainerHandler) ContainerHandler {
+	for _, f := range factories[watchType] {
+		if wrapper, ok := f.(HandlerWrapperFactory); ok && f != factory {
+			handle = wrapper.WrapHandler(name, handle)
+		}
+	}
+	return handle
+}
+
 // Clear the known factories.
 func ClearContainerHandlerFactories() {
 	factoriesLock.Lock()
@@ -307,21 +473,33 @@ func DebugInfo() map[string][]string {
 	return out
 }
 
-// GetReorderedFactoryList returns the list of ContainerHandlerFactory where the
-// RawContainerHandler is always the last element.
+// GetReorderedFactoryList returns the list of ContainerHandlerFactory where
+// any SpecializedFactory comes first, followed by the general factories and
+// any FallbackFactory, and the RawContainerHandler is always the last element.
 func GetReorderedFactoryList(watchType watcher.ContainerWatchSource) []ContainerHandlerFactory {
 	ContainerHandlerFactoryList := make([]ContainerHandlerFactory, 0, len(factories))
 
 	var rawFactory ContainerHandlerFactory
+	var generalFactories, fallbackFactories []ContainerHandlerFactory
 	for _, v := range factories[watchType] {
 		if v != nil {
 			if v.String() == "raw" {
 				rawFactory = v
 				continue
 			}
-			ContainerHandlerFactoryList = append(ContainerHandlerFactoryList, v)
+			if _, ok := v.(SpecializedFactory); ok {
+				ContainerHandlerFactoryList = append(ContainerHandlerFactoryList, v)
+				continue
+			}
+			if _, ok := v.(FallbackFactory); ok {
+				fallbackFactories = append(fallbackFactories, v)
+				continue
+			}
+			generalFactories = append(generalFactories, v)
 		}
 	}
+	ContainerHandlerFactoryList = append(ContainerHandlerFactoryList, generalFactories...)
+	ContainerHandlerFactoryList = append(ContainerHandlerFactoryList, fallbackFactories...)
 
 	if rawFactory != nil {
 		ContainerHandlerFactoryList = append(ContainerHandlerFactoryList, rawFactory)
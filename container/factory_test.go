@@ -15,10 +15,13 @@
 package container_test
 
 import (
+	"errors"
+	"strings"
 	"testing"
 
 	"github.com/google/cadvisor/container"
 	containertest "github.com/google/cadvisor/container/testing"
+	info "github.com/google/cadvisor/info/v1"
 	"github.com/google/cadvisor/watcher"
 
 	"github.com/stretchr/testify/mock"
@@ -48,6 +51,41 @@ func (f *mockContainerHandlerFactory) NewContainerHandler(name string, metadataE
 	return args.Get(0).(container.ContainerHandler), args.Error(1)
 }
 
+type mockSpecializedFactory struct {
+	mockContainerHandlerFactory
+}
+
+func (f *mockSpecializedFactory) Specialized() {}
+
+type mockFallbackFactory struct {
+	mockContainerHandlerFactory
+}
+
+func (f *mockFallbackFactory) Fallback() {}
+
+type mockWrapperFactory struct {
+	mockContainerHandlerFactory
+}
+
+// wrappedHandler is a handler wrapped by mockWrapperFactory.
+type wrappedHandler struct {
+	container.ContainerHandler
+}
+
+func (f *mockWrapperFactory) WrapHandler(name string, handler container.ContainerHandler) container.ContainerHandler {
+	return &wrappedHandler{handler}
+}
+
+type mockImageStorageFactory struct {
+	mockContainerHandlerFactory
+	storage []info.ImageStorage
+	err     error
+}
+
+func (f *mockImageStorageFactory) ImageStorage() ([]info.ImageStorage, error) {
+	return f.storage, f.err
+}
+
 const testContainerName = "/test"
 
 var testMetadataEnvAllowList = []string{}
@@ -184,3 +222,141 @@ func TestRawContainerHandler_Last(t *testing.T) {
 		t.Error("Expected raw container handler to be last in the list.")
 	}
 }
+
+func TestSpecializedFactory_First(t *testing.T) {
+	container.ClearContainerHandlerFactories()
+	container.RegisterContainerHandlerFactory(&mockContainerHandlerFactory{Name: "raw"}, []watcher.ContainerWatchSource{watcher.Raw})
+	container.RegisterContainerHandlerFactory(&mockContainerHandlerFactory{Name: "containerd"}, []watcher.ContainerWatchSource{watcher.Raw})
+	container.RegisterContainerHandlerFactory(&mockSpecializedFactory{mockContainerHandlerFactory{Name: "cri"}}, []watcher.ContainerWatchSource{watcher.Raw})
+
+	list := container.GetReorderedFactoryList(watcher.Raw)
+
+	names := make([]string, 0, len(list))
+	for _, f := range list {
+		names = append(names, f.String())
+	}
+	if strings.Join(names, ",") != "cri,containerd,raw" {
+		t.Errorf("Expected specialized factories first and raw last, got %v", names)
+	}
+}
+
+func TestFallbackFactory_BeforeRaw(t *testing.T) {
+	container.ClearContainerHandlerFactories()
+	container.RegisterContainerHandlerFactory(&mockFallbackFactory{mockContainerHandlerFactory{Name: "systemd"}}, []watcher.ContainerWatchSource{watcher.Raw})
+	container.RegisterContainerHandlerFactory(&mockContainerHandlerFactory{Name: "raw"}, []watcher.ContainerWatchSource{watcher.Raw})
+	container.RegisterContainerHandlerFactory(&mockContainerHandlerFactory{Name: "docker"}, []watcher.ContainerWatchSource{watcher.Raw})
+	container.RegisterContainerHandlerFactory(&mockSpecializedFactory{mockContainerHandlerFactory{Name: "cri"}}, []watcher.ContainerWatchSource{watcher.Raw})
+
+	list := container.GetReorderedFactoryList(watcher.Raw)
+
+	names := make([]string, 0, len(list))
+	for _, f := range list {
+		names = append(names, f.String())
+	}
+	if strings.Join(names, ",") != "cri,docker,systemd,raw" {
+		t.Errorf("Expected fallback factories right before raw, got %v", names)
+	}
+}
+
+func TestHandlerWrapperFactory(t *testing.T) {
+	container.ClearContainerHandlerFactories()
+	wrapper := &mockWrapperFactory{mockContainerHandlerFactory{Name: "gvisor"}}
+	docker := &mockContainerHandlerFactory{Name: "docker", CanHandleValue: true, CanAcceptValue: true}
+	container.RegisterContainerHandlerFactory(wrapper, []watcher.ContainerWatchSource{watcher.Raw})
+	container.RegisterContainerHandlerFactory(docker, []watcher.ContainerWatchSource{watcher.Raw})
+	handler := containertest.NewMockContainerHandler(testContainerName)
+	docker.On("NewContainerHandler", testContainerName).Return(handler, nil)
+
+	cont, _, err := container.NewContainerHandler(testContainerName, watcher.Raw, testMetadataEnvAllowList, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if w, ok := cont.(*wrappedHandler); !ok || w.ContainerHandler != handler {
+		t.Errorf("Expected the docker handler to be wrapped, got %T", cont)
+	}
+
+	// The handlers of the wrapper itself are not wrapped.
+	wrapper.CanHandleValue, wrapper.CanAcceptValue = true, true
+	wrapper.On("NewContainerHandler", testContainerName).Return(handler, nil)
+	container.ClearContainerHandlerFactories()
+	container.RegisterContainerHandlerFactory(wrapper, []watcher.ContainerWatchSource{watcher.Raw})
+	cont, _, err = container.NewContainerHandler(testContainerName, watcher.Raw, testMetadataEnvAllowList, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cont != handler {
+		t.Errorf("Expected the handler of the wrapper factory, got %T", cont)
+	}
+}
+
+func TestImageStorage(t *testing.T) {
+	container.ClearContainerHandlerFactories()
+	container.RegisterContainerHandlerFactory(&mockImageStorageFactory{
+		mockContainerHandlerFactory: mockContainerHandlerFactory{Name: "docker"},
+		storage:                     []info.ImageStorage{{Runtime: "docker", ImagesBytes: 1}},
+	}, []watcher.ContainerWatchSource{watcher.Raw})
+	container.RegisterContainerHandlerFactory(&mockImageStorageFactory{
+		mockContainerHandlerFactory: mockContainerHandlerFactory{Name: "containerd"},
+		err:                         errors.New("unavailable"),
+	}, []watcher.ContainerWatchSource{watcher.Raw})
+	container.RegisterContainerHandlerFactory(&mockContainerHandlerFactory{Name: "raw"}, []watcher.ContainerWatchSource{watcher.Raw})
+
+	storage, err := container.ImageStorage()
+	if err == nil || !strings.Contains(err.Error(), "containerd: unavailable") {
+		t.Errorf("Expected the error of the containerd factory, got %v", err)
+	}
+	if len(storage) != 1 || storage[0].Runtime != "docker" {
+		t.Errorf("Expected the image storage of the docker factory, got %+v", storage)
+	}
+}
+
+func TestMetricSetEnableDisable(t *testing.T) {
+	ms := container.MetricSet{container.CpuUsageMetrics: struct{}{}}
+	snapshot := ms.Copy()
+
+	ms.Enable(container.PerfMetrics)
+	ms.Disable(container.CpuUsageMetrics)
+
+	if !ms.Has(container.PerfMetrics) || ms.Has(container.CpuUsageMetrics) {
+		t.Errorf("unexpected metric set after toggling: %s", ms)
+	}
+	if snapshot.String() != "cpu" {
+		t.Errorf("expected copy to be unaffected by toggling, got %s", snapshot)
+	}
+}
+
+func TestNewContainerHandler_Filtered(t *testing.T) {
+	container.ClearContainerHandlerFactories()
+	filter, err := container.NewFilter("", "^/excluded", "", "*:debug", "", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	container.SetFilter(filter)
+	defer container.SetFilter(nil)
+
+	allwaysYes := &mockContainerHandlerFactory{
+		Name:           "yes",
+		CanHandleValue: true,
+		CanAcceptValue: true,
+	}
+	container.RegisterContainerHandlerFactory(allwaysYes, []watcher.ContainerWatchSource{watcher.Raw})
+
+	// Excluded names are rejected before any factory is asked.
+	cont, accept, err := container.NewContainerHandler("/excluded/test", watcher.Raw, testMetadataEnvAllowList, true)
+	if err != nil || accept || cont != nil {
+		t.Errorf("expected container to be ignored, got %v, %v, %v", cont, accept, err)
+	}
+
+	// Excluded images are rejected once the handler knows the spec.
+	mockContainer, err := mockFactory.NewContainerHandler(testContainerName, testMetadataEnvAllowList, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	mockContainer.(*containertest.MockContainerHandler).On("GetSpec").Return(info.ContainerSpec{Image: "web:debug"}, nil)
+	allwaysYes.On("NewContainerHandler", testContainerName).Return(mockContainer, nil)
+
+	cont, accept, err = container.NewContainerHandler(testContainerName, watcher.Raw, testMetadataEnvAllowList, true)
+	if err != nil || accept || cont != nil {
+		t.Errorf("expected container to be ignored, got %v, %v, %v", cont, accept, err)
+	}
+}
@@ -0,0 +1,319 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package container
+
+import (
+	"flag"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	info "github.com/google/cadvisor/info/v1"
+)
+
+var (
+	includeNamesFlag  = flag.String("container_include_regex", "", "If set, only containers whose name (cgroup path) matches this regular expression are collected, besides the root container")
+	excludeNamesFlag  = flag.String("container_exclude_regex", "", "Containers whose name (cgroup path) matches this regular expression are not collected")
+	includeImagesFlag = flag.String("container_include_images", "", "Comma-separated image patterns, where * matches any characters, e.g. \"registry.example.com/*\"; if set, only runtime containers with a matching image are collected")
+	excludeImagesFlag = flag.String("container_exclude_images", "", "Comma-separated image patterns, where * matches any characters; runtime containers with a matching image are not collected")
+	includeLabelsFlag = flag.String("container_include_labels", "", "Label selector, e.g. \"app=web,tier!=batch,team\"; if set, only runtime containers matching it are collected")
+	excludeLabelsFlag = flag.String("container_exclude_labels", "", "Comma-separated label requirements, e.g. \"io.cadvisor.ignore=true\"; runtime containers matching any of them are not collected")
+	maxDepthFlag      = flag.Int("container_max_depth", 0, "Containers nested more than this many levels below the root container are not collected. If 0, the depth is not limited")
+	subtreeDepthsFlag = flag.String("container_subtree_max_depth", "", "Comma-separated depth limits below subtrees, as subtree=depth, e.g. \"/kubepods=4,/system.slice=1\". Containers nested deeper below the longest matching subtree are not collected; this overrides container_max_depth")
+)
+
+var (
+	filterLock      sync.RWMutex
+	containerFilter *Filter
+)
+
+// Filter decides which containers are collected. Containers are matched by
+// name before a handler is created for them, and runtime containers, which
+// have an image, are then matched by image and labels. Name rules apply to
+// all containers, image and label rules only to runtime containers. The root
+// container is always collected.
+type Filter struct {
+	includeNames  *regexp.Regexp
+	excludeNames  *regexp.Regexp
+	includeImages []*regexp.Regexp
+	excludeImages []*regexp.Regexp
+	includeLabels LabelSelector
+	excludeLabels []labelRequirement
+	// Depth limits, 0 if the depth is not limited outside of the subtrees.
+	maxDepth      int
+	subtreeDepths []subtreeDepth
+}
+
+// subtreeDepth limits how deep containers below root are collected.
+type subtreeDepth struct {
+	root      string
+	rootDepth int
+	maxDepth  int
+}
+
+// labelRequirement is a single term of a label selector: key, !key, key=value
+// or key!=value.
+type labelRequirement struct {
+	key    string
+	value  string
+	negate bool
+	exists bool
+}
+
+func (r labelRequirement) matches(labels map[string]string) bool {
+	value, ok := labels[r.key]
+	if r.exists {
+		return ok != r.negate
+	}
+	return (ok && value == r.value) != r.negate
+}
+
+// LabelSelector matches the labels meeting all of its requirements. The empty
+// selector matches any labels.
+type LabelSelector []labelRequirement
+
+// ParseLabelSelector parses a comma-separated label selector, e.g.
+// "app=web,tier!=batch,team".
+func ParseLabelSelector(selector string) (LabelSelector, error) {
+	return parseLabelRequirements(selector)
+}
+
+// Matches returns whether the labels meet all the requirements of s.
+func (s LabelSelector) Matches(labels map[string]string) bool {
+	for _, r := range s {
+		if !r.matches(labels) {
+			return false
+		}
+	}
+	return true
+}
+
+func parseLabelRequirements(selector string) ([]labelRequirement, error) {
+	var requirements []labelRequirement
+	for _, term := range strings.Split(selector, ",") {
+		term = strings.TrimSpace(term)
+		if term == "" {
+			continue
+		}
+		var r labelRequirement
+		switch {
+		case strings.Contains(term, "!="):
+			r.key, r.value, _ = strings.Cut(term, "!=")
+			r.negate = true
+		case strings.Contains(term, "="):
+			r.key, r.value, _ = strings.Cut(term, "=")
+		case strings.HasPrefix(term, "!"):
+			r.key = term[1:]
+			r.negate = true
+			r.exists = true
+		default:
+			r.key = term
+			r.exists = true
+		}
+		r.key = strings.TrimSpace(r.key)
+		r.value = strings.TrimSpace(r.value)
+		if r.key == "" {
+			return nil, fmt.Errorf("invalid label requirement %q", term)
+		}
+		requirements = append(requirements, r)
+	}
+	return requirements, nil
+}
+
+// parseImagePatterns compiles comma-separated image patterns, in which * matches
+// any characters, including the slashes of repository paths.
+func parseImagePatterns(patterns string) []*regexp.Regexp {
+	var images []*regexp.Regexp
+	for _, pattern := range strings.Split(patterns, ",") {
+		pattern = strings.TrimSpace(pattern)
+		if pattern == "" {
+			continue
+		}
+		expr := strings.ReplaceAll(regexp.QuoteMeta(pattern), `\*`, ".*")
+		images = append(images, regexp.MustCompile("^"+expr+"$"))
+	}
+	return images
+}
+
+func compileOptional(expr string) (*regexp.Regexp, error) {
+	if expr == "" {
+		return nil, nil
+	}
+	return regexp.Compile(expr)
+}
+
+// NewFilter returns a Filter from the given rules, each of which may be empty.
+func NewFilter(includeNames, excludeNames, includeImages, excludeImages, includeLabels, excludeLabels string) (*Filter, error) {
+	var f Filter
+	var err error
+	if f.includeNames, err = compileOptional(includeNames); err != nil {
+		return nil, fmt.Errorf("invalid container include regex: %v", err)
+	}
+	if f.excludeNames, err = compileOptional(excludeNames); err != nil {
+		return nil, fmt.Errorf("invalid container exclude regex: %v", err)
+	}
+	f.includeImages = parseImagePatterns(includeImages)
+	f.excludeImages = parseImagePatterns(excludeImages)
+	if f.includeLabels, err = parseLabelRequirements(includeLabels); err != nil {
+		return nil, err
+	}
+	if f.excludeLabels, err = parseLabelRequirements(excludeLabels); err != nil {
+		return nil, err
+	}
+	return &f, nil
+}
+
+// SetDepthLimits limits how deeply nested the collected containers may be,
+// below the root container and below the subtrees of subtreeDepths, given as
+// "/kubepods=4,/system.slice=1". It must be called before the filter is set.
+func (f *Filter) SetDepthLimits(maxDepth int, subtreeDepths string) error {
+	if maxDepth < 0 {
+		return fmt.Errorf("invalid container max depth %d", maxDepth)
+	}
+	var subtrees []subtreeDepth
+	for _, term := range strings.Split(subtreeDepths, ",") {
+		term = strings.TrimSpace(term)
+		if term == "" {
+			continue
+		}
+		root, depth, ok := strings.Cut(term, "=")
+		root = strings.TrimSpace(root)
+		if root != "/" {
+			root = strings.TrimSuffix(root, "/")
+		}
+		if !ok || !strings.HasPrefix(root, "/") {
+			return fmt.Errorf("invalid subtree depth %q, expected /subtree=depth", term)
+		}
+		d, err := strconv.Atoi(strings.TrimSpace(depth))
+		if err != nil || d < 0 {
+			return fmt.Errorf("invalid depth of subtree %q: %q", root, depth)
+		}
+		subtrees = append(subtrees, subtreeDepth{root: root, rootDepth: containerDepth(root), maxDepth: d})
+	}
+	// The longest matching subtree applies.
+	sort.SliceStable(subtrees, func(i, j int) bool {
+		return len(subtrees[i].root) > len(subtrees[j].root)
+	})
+	f.maxDepth = maxDepth
+	f.subtreeDepths = subtrees
+	return nil
+}
+
+// containerDepth returns how many levels name is nested below the root.
+func containerDepth(name string) int {
+	name = strings.Trim(name, "/")
+	if name == "" {
+		return 0
+	}
+	return strings.Count(name, "/") + 1
+}
+
+func (f *Filter) withinDepth(name string) bool {
+	depth := containerDepth(name)
+	for _, s := range f.subtreeDepths {
+		if s.root == "/" || name == s.root || strings.HasPrefix(name, s.root+"/") {
+			return depth-s.rootDepth <= s.maxDepth
+		}
+	}
+	return f.maxDepth == 0 || depth <= f.maxDepth
+}
+
+// AcceptsName returns whether the container with the given name may be collected.
+func (f *Filter) AcceptsName(name string) bool {
+	if f == nil || name == "/" {
+		return true
+	}
+	if !f.withinDepth(name) {
+		return false
+	}
+	if f.includeNames != nil && !f.includeNames.MatchString(name) {
+		return false
+	}
+	return f.excludeNames == nil || !f.excludeNames.MatchString(name)
+}
+
+// AcceptsSpec returns whether the container with the given spec may be
+// collected. Containers without an image are always accepted.
+func (f *Filter) AcceptsSpec(spec info.ContainerSpec) bool {
+	if f == nil || spec.Image == "" {
+		return true
+	}
+	if len(f.includeImages) > 0 && !matchesAnyImage(f.includeImages, spec.Image) {
+		return false
+	}
+	if matchesAnyImage(f.excludeImages, spec.Image) {
+		return false
+	}
+	if !f.includeLabels.Matches(spec.Labels) {
+		return false
+	}
+	for _, r := range f.excludeLabels {
+		if r.matches(spec.Labels) {
+			return false
+		}
+	}
+	return true
+}
+
+// filtersSpecs returns whether AcceptsSpec can reject any container.
+func (f *Filter) filtersSpecs() bool {
+	return f != nil && len(f.includeImages)+len(f.excludeImages)+len(f.includeLabels)+len(f.excludeLabels) > 0
+}
+
+func matchesAnyImage(patterns []*regexp.Regexp, image string) bool {
+	for _, pattern := range patterns {
+		if pattern.MatchString(image) {
+			return true
+		}
+	}
+	return false
+}
+
+// InitializeFilter sets up the container filter from the command line flags.
+func InitializeFilter() error {
+	f, err := NewFilter(*includeNamesFlag, *excludeNamesFlag, *includeImagesFlag, *excludeImagesFlag, *includeLabelsFlag, *excludeLabelsFlag)
+	if err != nil {
+		return err
+	}
+	if err := f.SetDepthLimits(*maxDepthFlag, *subtreeDepthsFlag); err != nil {
+		return err
+	}
+	SetFilter(f)
+	return nil
+}
+
+// SetFilter sets the filter NewContainerHandler applies to new containers. A
+// nil filter accepts all containers.
+func SetFilter(f *Filter) {
+	filterLock.Lock()
+	defer filterLock.Unlock()
+	containerFilter = f
+}
+
+// Accepts returns whether the current filter accepts the container with the
+// given name and spec.
+func Accepts(name string, spec info.ContainerSpec) bool {
+	f := getFilter()
+	return f.AcceptsName(name) && f.AcceptsSpec(spec)
+}
+
+func getFilter() *Filter {
+	filterLock.RLock()
+	defer filterLock.RUnlock()
+	return containerFilter
+}
@@ -0,0 +1,91 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package container
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	info "github.com/google/cadvisor/info/v1"
+)
+
+func TestFilterAcceptsName(t *testing.T) {
+	f, err := NewFilter("^/(system|kubepods)", `\.mount$|/cron.*\.scope$`, "", "", "", "")
+	assert.NoError(t, err)
+
+	assert.True(t, f.AcceptsName("/"))
+	assert.True(t, f.AcceptsName("/system.slice/containerd.service"))
+	assert.True(t, f.AcceptsName("/kubepods/pod1"))
+	assert.False(t, f.AcceptsName("/user.slice"))
+	assert.False(t, f.AcceptsName("/system.slice/var-lib.mount"))
+	assert.False(t, f.AcceptsName("/system.slice/cron-daily.scope"))
+
+	var none *Filter
+	assert.True(t, none.AcceptsName("/user.slice"))
+	assert.False(t, none.filtersSpecs())
+}
+
+func TestFilterDepthLimits(t *testing.T) {
+	f, err := NewFilter("", "", "", "", "", "")
+	assert.NoError(t, err)
+	assert.NoError(t, f.SetDepthLimits(2, "/kubepods=4, /kubepods/besteffort/=1,/system.slice=0"))
+
+	assert.True(t, f.AcceptsName("/user.slice/user-1000.slice"))
+	assert.False(t, f.AcceptsName("/user.slice/user-1000.slice/session-1.scope"))
+	assert.True(t, f.AcceptsName("/kubepods/burstable/pod1/abc/nested"))
+	assert.False(t, f.AcceptsName("/kubepods/burstable/pod1/abc/nested/deeper"))
+	assert.True(t, f.AcceptsName("/kubepods/besteffort/pod1"))
+	assert.False(t, f.AcceptsName("/kubepods/besteffort/pod1/abc"))
+	assert.True(t, f.AcceptsName("/system.slice"))
+	assert.False(t, f.AcceptsName("/system.slice/docker.service"))
+	// Only whole path components match a subtree.
+	assert.False(t, f.AcceptsName("/kubepods-other/a/b"))
+
+	for _, subtrees := range []string{"kubepods=1", "/kubepods", "/kubepods=-1", "/kubepods=deep"} {
+		assert.Error(t, f.SetDepthLimits(0, subtrees), subtrees)
+	}
+	assert.Error(t, f.SetDepthLimits(-1, ""))
+}
+
+func TestFilterAcceptsSpec(t *testing.T) {
+	f, err := NewFilter("", "", "registry.example.com/*, docker.io/library/*", "*:debug", "app, tier!=batch", "io.cadvisor.ignore=true")
+	assert.NoError(t, err)
+	assert.True(t, f.filtersSpecs())
+
+	spec := func(image string, labels map[string]string) info.ContainerSpec {
+		return info.ContainerSpec{Image: image, Labels: labels}
+	}
+	assert.True(t, f.AcceptsSpec(spec("", nil)), "containers without an image are not filtered")
+	assert.True(t, f.AcceptsSpec(spec("registry.example.com/web:1.0", map[string]string{"app": "web"})))
+	assert.True(t, f.AcceptsSpec(spec("docker.io/library/nginx", map[string]string{"app": "web", "tier": "front"})))
+	assert.False(t, f.AcceptsSpec(spec("quay.io/web:1.0", map[string]string{"app": "web"})), "image not included")
+	assert.False(t, f.AcceptsSpec(spec("registry.example.com/web:debug", map[string]string{"app": "web"})), "image excluded")
+	assert.False(t, f.AcceptsSpec(spec("registry.example.com/web:1.0", nil)), "required label missing")
+	assert.False(t, f.AcceptsSpec(spec("registry.example.com/web:1.0", map[string]string{"app": "web", "tier": "batch"})), "label mismatch")
+	assert.False(t, f.AcceptsSpec(spec("registry.example.com/web:1.0", map[string]string{"app": "web", "io.cadvisor.ignore": "true"})), "label excluded")
+}
+
+func TestNewFilterErrors(t *testing.T) {
+	for _, args := range [][6]string{
+		{"(", "", "", "", "", ""},
+		{"", "(", "", "", "", ""},
+		{"", "", "", "", "=value", ""},
+		{"", "", "", "", "", "!"},
+	} {
+		_, err := NewFilter(args[0], args[1], args[2], args[3], args[4], args[5])
+		assert.Error(t, err, "%q", args)
+	}
+}
@@ -0,0 +1,200 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package firecracker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// apiSocket is the name of the Firecracker API socket inside the
+	// firecracker-containerd shim directory of a VM.
+	apiSocket = "firecracker.sock"
+
+	apiTimeout = 5 * time.Second
+)
+
+// machineConfig is the subset of GET /machine-config cAdvisor uses.
+type machineConfig struct {
+	VcpuCount  int    `json:"vcpu_count"`
+	MemSizeMib uint64 `json:"mem_size_mib"`
+}
+
+// balloonStatistics is the subset of GET /balloon/statistics cAdvisor uses.
+// Memory values are in bytes.
+type balloonStatistics struct {
+	ActualMib       uint64 `json:"actual_mib"`
+	TotalMemory     uint64 `json:"total_memory"`
+	FreeMemory      uint64 `json:"free_memory"`
+	AvailableMemory uint64 `json:"available_memory"`
+}
+
+// APIClient talks to the Firecracker API socket of a microVM.
+type APIClient interface {
+	// HasVM returns whether an API socket for the VM exists.
+	HasVM(vmID string) bool
+	// VMNamespace returns the containerd namespace that owns the VM.
+	VMNamespace(vmID string) string
+	MachineConfig(vmID string) (*machineConfig, error)
+	// BalloonStatistics returns the guest memory statistics. It fails if
+	// the VM has no balloon device with statistics enabled.
+	BalloonStatistics(vmID string) (*balloonStatistics, error)
+}
+
+type apiClient struct {
+	shimBaseDir string
+
+	// The HTTP clients of the API sockets of the VMs, reused across
+	// requests to keep their connections alive.
+	lock    sync.Mutex
+	clients map[string]*http.Client
+}
+
+// NewAPIClient returns an APIClient for VMs whose shim directories are
+// shimBaseDir/<namespace>#<vm id>.
+func NewAPIClient(shimBaseDir string) APIClient {
+	return &apiClient{shimBaseDir: shimBaseDir, clients: map[string]*http.Client{}}
+}
+
+// vmDir returns the shim directory of the VM. The VM id comes from a
+// container name, so it is compared as is rather than used as a pattern.
+func (c *apiClient) vmDir(vmID string) (string, bool) {
+	entries, err := os.ReadDir(c.shimBaseDir)
+	if err != nil {
+		return "", false
+	}
+	for _, entry := range entries {
+		if _, id, found := strings.Cut(entry.Name(), "#"); found && id == vmID {
+			return filepath.Join(c.shimBaseDir, entry.Name()), true
+		}
+	}
+	return "", false
+}
+
+func (c *apiClient) HasVM(vmID string) bool {
+	dir, ok := c.vmDir(vmID)
+	if !ok {
+		return false
+	}
+	socket := filepath.Join(dir, apiSocket)
+	if pathExists(socket) {
+		return true
+	}
+	c.forget(socket)
+	return false
+}
+
+// client returns the HTTP client of the API listening on socket.
+func (c *apiClient) client(socket string) *http.Client {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	client, ok := c.clients[socket]
+	if !ok {
+		client = &http.Client{
+			Timeout: apiTimeout,
+			Transport: &http.Transport{
+				DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+					var d net.Dialer
+					return d.DialContext(ctx, "unix", socket)
+				},
+				MaxIdleConns:    1,
+				IdleConnTimeout: time.Minute,
+			},
+		}
+		c.clients[socket] = client
+	}
+	return client
+}
+
+// forget closes the connections to the API of a VM that is gone.
+func (c *apiClient) forget(socket string) {
+	c.lock.Lock()
+	client, ok := c.clients[socket]
+	delete(c.clients, socket)
+	c.lock.Unlock()
+	if ok {
+		client.CloseIdleConnections()
+	}
+}
+
+func (c *apiClient) VMNamespace(vmID string) string {
+	dir, ok := c.vmDir(vmID)
+	if !ok {
+		return ""
+	}
+	ns, _ := splitShimDir(filepath.Base(dir))
+	return ns
+}
+
+func (c *apiClient) get(vmID, path string, out interface{}) error {
+	dir, ok := c.vmDir(vmID)
+	if !ok {
+		return fmt.Errorf("no shim directory for VM %q", vmID)
+	}
+	socket := filepath.Join(dir, apiSocket)
+	if !pathExists(socket) {
+		c.forget(socket)
+		return fmt.Errorf("no firecracker API socket for VM %q", vmID)
+	}
+	resp, err := c.client(socket).Get("http://localhost" + path)
+	if err != nil {
+		return fmt.Errorf("failed to query firecracker API of VM %q: %v", vmID, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("firecracker API of VM %q returned %s for %s", vmID, resp.Status, path)
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
+
+func (c *apiClient) MachineConfig(vmID string) (*machineConfig, error) {
+	config := &machineConfig{}
+	if err := c.get(vmID, "/machine-config", config); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+func (c *apiClient) BalloonStatistics(vmID string) (*balloonStatistics, error) {
+	stats := &balloonStatistics{}
+	if err := c.get(vmID, "/balloon/statistics", stats); err != nil {
+		return nil, err
+	}
+	return stats, nil
+}
+
+// splitShimDir splits a firecracker-containerd shim directory name of the
+// form "<namespace>#<vm id>".
+func splitShimDir(dir string) (namespace, vmID string) {
+	namespace, vmID, found := strings.Cut(dir, "#")
+	if !found {
+		return "", dir
+	}
+	return namespace, vmID
+}
+
+func pathExists(p string) bool {
+	_, err := os.Stat(p)
+	return err == nil
+}
@@ -0,0 +1,82 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package firecracker
+
+import (
+	"encoding/json"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAPIClient(t *testing.T) {
+	base := t.TempDir()
+	vmDir := filepath.Join(base, "default#vm1")
+	assert.NoError(t, os.Mkdir(vmDir, 0o755))
+
+	listener, err := net.Listen("unix", filepath.Join(vmDir, apiSocket))
+	assert.NoError(t, err)
+	mux := http.NewServeMux()
+	mux.HandleFunc("/machine-config", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"vcpu_count": 2, "mem_size_mib": 1024, "smt": false})
+	})
+	var conns atomic.Int32
+	server := &http.Server{Handler: mux, ConnState: func(_ net.Conn, state http.ConnState) {
+		if state == http.StateNew {
+			conns.Add(1)
+		}
+	}}
+	go func() { _ = server.Serve(listener) }()
+	defer server.Close()
+
+	c := NewAPIClient(base)
+	assert.True(t, c.HasVM("vm1"))
+	assert.False(t, c.HasVM("vm2"))
+	assert.Equal(t, "default", c.VMNamespace("vm1"))
+
+	config, err := c.MachineConfig("vm1")
+	assert.NoError(t, err)
+	assert.Equal(t, &machineConfig{VcpuCount: 2, MemSizeMib: 1024}, config)
+
+	_, err = c.BalloonStatistics("vm1")
+	assert.Error(t, err)
+	// The requests share a connection.
+	assert.Equal(t, int32(1), conns.Load())
+
+	// The client of a VM is dropped once it is gone.
+	assert.NoError(t, os.Remove(filepath.Join(vmDir, apiSocket)))
+	_, err = c.MachineConfig("vm1")
+	assert.Error(t, err)
+	assert.Empty(t, c.(*apiClient).clients)
+}
+
+func TestAPIClientMatchesVMIDLiterally(t *testing.T) {
+	base := filepath.Join(t.TempDir(), "shim[base]")
+	for _, dir := range []string{"default#vm1", "default#vm2/x"} {
+		assert.NoError(t, os.MkdirAll(filepath.Join(base, dir, apiSocket), 0o755))
+	}
+
+	c := NewAPIClient(base)
+	assert.True(t, c.HasVM("vm1"))
+	for _, vmID := range []string{"vm*", "vm?", "vm[12]", "*"} {
+		assert.False(t, c.HasVM(vmID), vmID)
+		assert.Empty(t, c.VMNamespace(vmID), vmID)
+	}
+}
@@ -0,0 +1,91 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package firecracker
+
+import (
+	"flag"
+	"fmt"
+	"path"
+
+	"github.com/google/cadvisor/container"
+	"github.com/google/cadvisor/container/libcontainer"
+	"github.com/google/cadvisor/fs"
+	info "github.com/google/cadvisor/info/v1"
+	"github.com/google/cadvisor/watcher"
+
+	"k8s.io/klog/v2"
+)
+
+var ArgShimBaseDir = flag.String("firecracker_shim_base_dir", "/var/lib/firecracker-containerd/shim-base", "Directory holding the firecracker-containerd shim directories of the microVMs")
+var ArgCgroupParent = flag.String("firecracker_cgroup_parent", "/firecracker", "Parent cgroup the firecracker jailer places microVMs in")
+
+// FirecrackerNamespace is the namespace under which firecracker aliases are unique.
+const FirecrackerNamespace = "firecracker"
+
+type firecrackerFactory struct {
+	machineInfoFactory info.MachineInfoFactory
+	client             APIClient
+	cgroupParent       string
+	// Information about the mounted cgroup subsystems.
+	cgroupSubsystems map[string]string
+	includedMetrics  container.MetricSet
+}
+
+func (f *firecrackerFactory) String() string {
+	return FirecrackerNamespace
+}
+
+func (f *firecrackerFactory) NewContainerHandler(name string, metadataEnvAllowList []string, inHostNamespace bool) (container.ContainerHandler, error) {
+	return newFirecrackerContainerHandler(f.client, name, f.machineInfoFactory, f.cgroupSubsystems, inHostNamespace, f.includedMetrics)
+}
+
+func (f *firecrackerFactory) CanHandleAndAccept(name string) (bool, bool, error) {
+	if path.Dir(name) != f.cgroupParent {
+		return false, false, nil
+	}
+	if !f.client.HasVM(path.Base(name)) {
+		return false, false, nil
+	}
+	return true, true, nil
+}
+
+func (f *firecrackerFactory) DebugInfo() map[string][]string {
+	return map[string][]string{}
+}
+
+// Register root container before running this function!
+func Register(factory info.MachineInfoFactory, fsInfo fs.FsInfo, includedMetrics container.MetricSet) error {
+	if !pathExists(*ArgShimBaseDir) {
+		return fmt.Errorf("firecracker-containerd shim directory %q not found", *ArgShimBaseDir)
+	}
+
+	cgroupSubsystems, err := libcontainer.GetCgroupSubsystems(includedMetrics)
+	if err != nil {
+		return fmt.Errorf("failed to get cgroup subsystems: %v", err)
+	}
+
+	klog.V(1).Infof("Registering firecracker factory")
+	f := &firecrackerFactory{
+		machineInfoFactory: factory,
+		client:             NewAPIClient(*ArgShimBaseDir),
+		cgroupParent:       path.Clean("/" + *ArgCgroupParent),
+		cgroupSubsystems:   cgroupSubsystems,
+		includedMetrics:    includedMetrics,
+	}
+	container.RegisterContainerHandlerFactory(f, []watcher.ContainerWatchSource{watcher.Raw})
+	return nil
+}
@@ -0,0 +1,72 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package firecracker
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeAPIClient struct {
+	vms map[string]bool
+}
+
+func (c *fakeAPIClient) HasVM(vmID string) bool {
+	return c.vms[vmID]
+}
+
+func (c *fakeAPIClient) VMNamespace(vmID string) string {
+	return "default"
+}
+
+func (c *fakeAPIClient) MachineConfig(vmID string) (*machineConfig, error) {
+	return &machineConfig{VcpuCount: 2, MemSizeMib: 512}, nil
+}
+
+func (c *fakeAPIClient) BalloonStatistics(vmID string) (*balloonStatistics, error) {
+	return &balloonStatistics{}, nil
+}
+
+func TestCanHandleAndAccept(t *testing.T) {
+	as := assert.New(t)
+	f := &firecrackerFactory{
+		client:       &fakeAPIClient{vms: map[string]bool{"vm1": true}},
+		cgroupParent: "/firecracker",
+	}
+	for k, v := range map[string]bool{
+		"/firecracker/vm1":        true,
+		"/firecracker/vm2":        false,
+		"/firecracker/vm1/nested": false,
+		"/system.slice/vm1":       false,
+	} {
+		b1, b2, err := f.CanHandleAndAccept(k)
+		as.Nil(err)
+		as.Equal(v, b1, k)
+		as.Equal(v, b2, k)
+	}
+}
+
+func TestSplitShimDir(t *testing.T) {
+	ns, vmID := splitShimDir("default#vm1")
+	assert.Equal(t, "default", ns)
+	assert.Equal(t, "vm1", vmID)
+
+	ns, vmID = splitShimDir("vm1")
+	assert.Equal(t, "", ns)
+	assert.Equal(t, "vm1", vmID)
+}
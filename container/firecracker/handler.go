@@ -0,0 +1,234 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+// Handler for firecracker microVMs.
+package firecracker
+
+import (
+	"fmt"
+	"path"
+	"strconv"
+	"time"
+
+	"k8s.io/klog/v2"
+
+	"github.com/google/cadvisor/container"
+	"github.com/google/cadvisor/container/common"
+	containerlibcontainer "github.com/google/cadvisor/container/libcontainer"
+	info "github.com/google/cadvisor/info/v1"
+)
+
+const (
+	// Labels attached to firecracker microVM containers.
+	VMIDLabel       = "io.firecracker.vm.id"
+	NamespaceLabel  = "io.firecracker.containerd.namespace"
+	VcpuCountLabel  = "io.firecracker.vm.vcpu_count"
+	MemSizeMibLabel = "io.firecracker.vm.mem_size_mib"
+)
+
+// Custom metrics with the memory usage the guest reports to the balloon device.
+const (
+	guestMemoryTotalMetric     = "firecracker_guest_memory_total_bytes"
+	guestMemoryAvailableMetric = "firecracker_guest_memory_available_bytes"
+	guestMemoryFreeMetric      = "firecracker_guest_memory_free_bytes"
+	balloonSizeMetric          = "firecracker_balloon_size_bytes"
+)
+
+var balloonMetricSpecs = []info.MetricSpec{
+	{Name: guestMemoryTotalMetric, Type: info.MetricGauge, Format: info.IntType, Units: "bytes"},
+	{Name: guestMemoryAvailableMetric, Type: info.MetricGauge, Format: info.IntType, Units: "bytes"},
+	{Name: guestMemoryFreeMetric, Type: info.MetricGauge, Format: info.IntType, Units: "bytes"},
+	{Name: balloonSizeMetric, Type: info.MetricGauge, Format: info.IntType, Units: "bytes"},
+}
+
+type firecrackerContainerHandler struct {
+	client APIClient
+	vmID   string
+
+	machineInfoFactory info.MachineInfoFactory
+
+	// Absolute path to the cgroup hierarchies of this container.
+	// (e.g.: "cpu" -> "/sys/fs/cgroup/cpu/test")
+	cgroupPaths map[string]string
+
+	// Machine configuration of the VM, nil if the API did not return it.
+	machineConfig *machineConfig
+
+	labels          map[string]string
+	includedMetrics container.MetricSet
+	reference       info.ContainerReference
+
+	libcontainerHandler *containerlibcontainer.Handler
+}
+
+var _ container.ContainerHandler = &firecrackerContainerHandler{}
+
+// newFirecrackerContainerHandler returns a new container.ContainerHandler
+func newFirecrackerContainerHandler(
+	client APIClient,
+	name string,
+	machineInfoFactory info.MachineInfoFactory,
+	cgroupSubsystems map[string]string,
+	inHostNamespace bool,
+	includedMetrics container.MetricSet,
+) (container.ContainerHandler, error) {
+	vmID := path.Base(name)
+
+	rootFs := "/"
+	if !inHostNamespace {
+		rootFs = "/rootfs"
+	}
+
+	cgroupPaths := common.MakeCgroupPaths(cgroupSubsystems, name)
+	cgroupManager, err := containerlibcontainer.NewCgroupManager(name, cgroupPaths)
+	if err != nil {
+		return nil, err
+	}
+
+	// The jailer puts the firecracker process, and with it the vCPU threads,
+	// in the VM cgroup. Its network namespace holds the VM's tap device.
+	pid := 0
+	if pids, err := cgroupManager.GetPids(); err == nil && len(pids) > 0 {
+		pid = pids[0]
+	}
+
+	labels := map[string]string{
+		VMIDLabel:      vmID,
+		NamespaceLabel: client.VMNamespace(vmID),
+	}
+	config, err := client.MachineConfig(vmID)
+	if err != nil {
+		klog.V(4).Infof("Failed to get machine config of firecracker VM %q: %v", vmID, err)
+		config = nil
+	} else {
+		labels[VcpuCountLabel] = strconv.Itoa(config.VcpuCount)
+		labels[MemSizeMibLabel] = strconv.FormatUint(config.MemSizeMib, 10)
+	}
+
+	return &firecrackerContainerHandler{
+		client:             client,
+		vmID:               vmID,
+		machineInfoFactory: machineInfoFactory,
+		cgroupPaths:        cgroupPaths,
+		machineConfig:      config,
+		labels:             labels,
+		includedMetrics:    includedMetrics,
+		// firecracker-containerd names a VM created implicitly for a task
+		// after the task's container, so the VM id is also the alias of
+		// the owning container.
+		reference: info.ContainerReference{
+			Id:        vmID,
+			Name:      name,
+			Aliases:   []string{vmID},
+			Namespace: FirecrackerNamespace,
+		},
+		libcontainerHandler: containerlibcontainer.NewHandler(cgroupManager, rootFs, pid, includedMetrics),
+	}, nil
+}
+
+func (h *firecrackerContainerHandler) ContainerReference() (info.ContainerReference, error) {
+	return h.reference, nil
+}
+
+func (h *firecrackerContainerHandler) GetSpec() (info.ContainerSpec, error) {
+	hasNetwork := h.includedMetrics.Has(container.NetworkUsageMetrics)
+	spec, err := common.GetSpec(h.cgroupPaths, h.machineInfoFactory, hasNetwork, false)
+	spec.Labels = h.labels
+	// The guest can never use more memory than the VM was booted with.
+	if h.machineConfig != nil && h.machineConfig.MemSizeMib > 0 {
+		vmMemory := h.machineConfig.MemSizeMib << 20
+		if !spec.HasMemory || spec.Memory.Limit == 0 || spec.Memory.Limit > vmMemory {
+			spec.HasMemory = true
+			spec.Memory.Limit = vmMemory
+		}
+	}
+	spec.HasCustomMetrics = true
+	spec.CustomMetrics = balloonMetricSpecs
+	return spec, err
+}
+
+// GetStats returns the stats of the jailer cgroup of the VM. Only the guest
+// memory custom metrics come from the Firecracker API, CPU, memory and network
+// stats are those of the VMM process as seen by the host.
+func (h *firecrackerContainerHandler) GetStats() (*info.ContainerStats, error) {
+	stats, err := h.libcontainerHandler.GetStats()
+	if err != nil {
+		return stats, err
+	}
+	balloon, err := h.client.BalloonStatistics(h.vmID)
+	if err != nil {
+		// Not every VM has a balloon device.
+		klog.V(5).Infof("Failed to get balloon statistics of firecracker VM %q: %v", h.vmID, err)
+		return stats, nil
+	}
+	now := time.Now()
+	stats.CustomMetrics = map[string][]info.MetricVal{
+		guestMemoryTotalMetric:     {{Timestamp: now, IntValue: int64(balloon.TotalMemory)}},
+		guestMemoryAvailableMetric: {{Timestamp: now, IntValue: int64(balloon.AvailableMemory)}},
+		guestMemoryFreeMetric:      {{Timestamp: now, IntValue: int64(balloon.FreeMemory)}},
+		balloonSizeMetric:          {{Timestamp: now, IntValue: int64(balloon.ActualMib << 20)}},
+	}
+	return stats, nil
+}
+
+func (h *firecrackerContainerHandler) ListContainers(listType container.ListType) ([]info.ContainerReference, error) {
+	// The containers of the VM live inside the guest.
+	return []info.ContainerReference{}, nil
+}
+
+func (h *firecrackerContainerHandler) ListProcesses(listType container.ListType) ([]int, error) {
+	return h.libcontainerHandler.GetProcesses()
+}
+
+func (h *firecrackerContainerHandler) GetCgroupPath(resource string) (string, error) {
+	var res string
+	if !common.IsCgroup2UnifiedMode() {
+		res = resource
+	}
+	path, ok := h.cgroupPaths[res]
+	if !ok {
+		return "", fmt.Errorf("could not find path for resource %q for container %q", resource, h.reference.Name)
+	}
+	return path, nil
+}
+
+func (h *firecrackerContainerHandler) GetContainerLabels() map[string]string {
+	return h.labels
+}
+
+func (h *firecrackerContainerHandler) GetContainerIPAddress() string {
+	return ""
+}
+
+func (h *firecrackerContainerHandler) Exists() bool {
+	return common.CgroupExists(h.cgroupPaths)
+}
+
+func (h *firecrackerContainerHandler) Type() container.ContainerType {
+	return container.ContainerTypeFirecracker
+}
+
+func (h *firecrackerContainerHandler) GetExitCode() (int, error) {
+	return -1, fmt.Errorf("exit code not available for firecracker microVMs")
+}
+
+// Nothing to start up.
+func (h *firecrackerContainerHandler) Start() {}
+
+// Nothing to clean up.
+func (h *firecrackerContainerHandler) Cleanup() {
+	h.libcontainerHandler.Close()
+}
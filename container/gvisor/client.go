@@ -0,0 +1,167 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package gvisor
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"time"
+
+	"github.com/opencontainers/runc/types"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+)
+
+// Bundle annotations used by CRI runtimes to mark the sandbox container.
+const (
+	containerdContainerTypeAnnotation = "io.kubernetes.cri.container-type"
+	containerdSandboxIDAnnotation     = "io.kubernetes.cri.sandbox-id"
+	crioContainerTypeAnnotation       = "io.kubernetes.cri-o.ContainerType"
+	crioSandboxIDAnnotation           = "io.kubernetes.cri-o.SandboxID"
+	containerTypeSandbox              = "sandbox"
+)
+
+// runscState is the subset of "runsc state" output cAdvisor uses, plus the
+// annotations from the container's OCI bundle.
+type runscState struct {
+	ID          string            `json:"id"`
+	Pid         int               `json:"pid"`
+	Status      string            `json:"status"`
+	Bundle      string            `json:"bundle"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// IsSandbox returns whether the container is the sandbox (pause) container,
+// whose host cgroup holds the sentry and gofer processes.
+func (s *runscState) IsSandbox() bool {
+	if t, ok := s.Annotations[containerdContainerTypeAnnotation]; ok {
+		return t == containerTypeSandbox
+	}
+	if t, ok := s.Annotations[crioContainerTypeAnnotation]; ok {
+		return t == containerTypeSandbox
+	}
+	// Without CRI annotations, a container is a sandbox of its own.
+	return true
+}
+
+// SandboxID returns the id of the sandbox the container runs in.
+func (s *runscState) SandboxID() string {
+	for _, key := range []string{containerdSandboxIDAnnotation, crioSandboxIDAnnotation} {
+		if id, ok := s.Annotations[key]; ok && id != "" {
+			return id
+		}
+	}
+	return s.ID
+}
+
+// RunscClient queries container state and usage from runsc.
+type RunscClient interface {
+	// HasContainer returns whether runsc has state for the container.
+	HasContainer(id string) bool
+	State(id string) (*runscState, error)
+	// Stats returns the usage of the container as accounted by the sentry.
+	Stats(id string) (*types.Stats, error)
+}
+
+type runscClient struct {
+	binary  string
+	root    string
+	timeout time.Duration
+}
+
+// NewRunscClient returns a RunscClient that executes binary with the given
+// runsc state root, killing it if it runs for longer than timeout.
+func NewRunscClient(binary, root string, timeout time.Duration) RunscClient {
+	return &runscClient{binary: binary, root: root, timeout: timeout}
+}
+
+func (c *runscClient) HasContainer(id string) bool {
+	// runsc names its state files after the sandbox and container ids.
+	matches, err := filepath.Glob(filepath.Join(c.root, "*"+id+"*"))
+	return err == nil && len(matches) > 0
+}
+
+func (c *runscClient) run(args ...string) ([]byte, error) {
+	args = append([]string{"--root", c.root}, args...)
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+	cmd := exec.CommandContext(ctx, c.binary, args...)
+	// Do not wait for children of a killed runsc that hold on to its output.
+	cmd.WaitDelay = time.Second
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute %s %v: %v", c.binary, args, err)
+	}
+	return out, nil
+}
+
+func (c *runscClient) State(id string) (*runscState, error) {
+	out, err := c.run("state", id)
+	if err != nil {
+		return nil, err
+	}
+	state := &runscState{}
+	if err := json.Unmarshal(out, state); err != nil {
+		return nil, fmt.Errorf("failed to decode runsc state of %q: %v", id, err)
+	}
+	if state.Bundle != "" {
+		annotations, err := readBundleAnnotations(state.Bundle)
+		if err != nil {
+			return nil, err
+		}
+		state.Annotations = annotations
+	}
+	return state, nil
+}
+
+func readBundleAnnotations(bundle string) (map[string]string, error) {
+	data, err := os.ReadFile(filepath.Join(bundle, "config.json"))
+	if err != nil {
+		return nil, err
+	}
+	var spec specs.Spec
+	if err := json.Unmarshal(data, &spec); err != nil {
+		return nil, fmt.Errorf("failed to decode OCI spec of bundle %q: %v", bundle, err)
+	}
+	return spec.Annotations, nil
+}
+
+func (c *runscClient) Stats(id string) (*types.Stats, error) {
+	out, err := c.run("events", "--stats", id)
+	if err != nil {
+		return nil, err
+	}
+	return parseStatsEvent(out)
+}
+
+// parseStatsEvent decodes the output of "runsc events --stats".
+func parseStatsEvent(out []byte) (*types.Stats, error) {
+	event := struct {
+		Type string      `json:"type"`
+		Data types.Stats `json:"data"`
+	}{}
+	if err := json.Unmarshal(out, &event); err != nil {
+		return nil, fmt.Errorf("failed to decode runsc stats event: %v", err)
+	}
+	if event.Type != "stats" {
+		return nil, fmt.Errorf("unexpected runsc event type %q", event.Type)
+	}
+	return &event.Data, nil
+}
@@ -0,0 +1,70 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package gvisor
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseStatsEvent(t *testing.T) {
+	out := []byte(`{"type":"stats","id":"abc","data":{"cpu":{"usage":{"total":3000,"kernel":1000,"user":2000}},"memory":{"cache":10,"usage":{"usage":100,"max":200},"raw":{"rss":80}},"pids":{"current":3}}}`)
+	stats, err := parseStatsEvent(out)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(3000), stats.CPU.Usage.Total)
+	assert.Equal(t, uint64(100), stats.Memory.Usage.Usage)
+	assert.Equal(t, uint64(80), stats.Memory.Raw["rss"])
+	assert.Equal(t, uint64(3), stats.Pids.Current)
+
+	_, err = parseStatsEvent([]byte(`{"type":"oom","id":"abc"}`))
+	assert.Error(t, err)
+}
+
+func TestRunscStateSandbox(t *testing.T) {
+	sandbox := &runscState{ID: "s", Annotations: map[string]string{
+		containerdContainerTypeAnnotation: "sandbox",
+		containerdSandboxIDAnnotation:     "s",
+	}}
+	assert.True(t, sandbox.IsSandbox())
+	assert.Equal(t, "s", sandbox.SandboxID())
+
+	workload := &runscState{ID: "w", Annotations: map[string]string{
+		crioContainerTypeAnnotation: "container",
+		crioSandboxIDAnnotation:     "s",
+	}}
+	assert.False(t, workload.IsSandbox())
+	assert.Equal(t, "s", workload.SandboxID())
+
+	standalone := &runscState{ID: "x"}
+	assert.True(t, standalone.IsSandbox())
+	assert.Equal(t, "x", standalone.SandboxID())
+}
+
+func TestRunscClientTimeout(t *testing.T) {
+	binary := filepath.Join(t.TempDir(), "runsc")
+	assert.NoError(t, os.WriteFile(binary, []byte("#!/bin/sh\nsleep 10\n"), 0o700))
+	client := NewRunscClient(binary, t.TempDir(), 100*time.Millisecond)
+
+	start := time.Now()
+	_, err := client.State("abc")
+	assert.Error(t, err)
+	assert.Less(t, time.Since(start), 5*time.Second)
+}
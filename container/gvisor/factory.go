@@ -0,0 +1,135 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package gvisor
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path"
+	"regexp"
+	"time"
+
+	"github.com/google/cadvisor/container"
+	"github.com/google/cadvisor/container/libcontainer"
+	"github.com/google/cadvisor/fs"
+	info "github.com/google/cadvisor/info/v1"
+	"github.com/google/cadvisor/watcher"
+
+	"k8s.io/klog/v2"
+)
+
+var ArgRunscBinary = flag.String("runsc", "runsc", "Path to the runsc binary used to query gVisor sandboxes")
+var ArgRunscRoot = flag.String("runsc_root", "/run/containerd/runsc/k8s.io", "runsc state root directory of the gVisor sandboxes")
+var runscTimeout = flag.Duration("runsc_timeout", 2*time.Second, "Timeout of runsc executions")
+
+// GvisorNamespace is the namespace under which gVisor aliases are unique.
+const GvisorNamespace = "gvisor"
+
+// Regexp that identifies container ids in cgroup names such as
+// "cri-containerd-<id>.scope" or "crio-<id>".
+var gvisorCgroupRegexp = regexp.MustCompile(`([a-z0-9]{64})`)
+
+type gvisorFactory struct {
+	machineInfoFactory info.MachineInfoFactory
+	client             RunscClient
+	// Information about the mounted cgroup subsystems.
+	cgroupSubsystems map[string]string
+	// Information about mounted filesystems.
+	fsInfo          fs.FsInfo
+	includedMetrics container.MetricSet
+}
+
+var (
+	_ container.FallbackFactory       = &gvisorFactory{}
+	_ container.HandlerWrapperFactory = &gvisorFactory{}
+)
+
+func (f *gvisorFactory) String() string {
+	return GvisorNamespace
+}
+
+// gVisor containers are also known to containerd or CRI-O, whose handlers
+// have their runtime metadata and labels, so this factory only gets those not
+// claimed by a runtime factory. The handlers of the others are wrapped.
+func (f *gvisorFactory) Fallback() {}
+
+// WrapHandler adds the gVisor labels and the usage runsc accounts to the
+// handler a runtime factory created for a gVisor container.
+func (f *gvisorFactory) WrapHandler(name string, handler container.ContainerHandler) container.ContainerHandler {
+	id, ok := containerNameToID(name)
+	if !ok || !f.client.HasContainer(id) {
+		return handler
+	}
+	state, err := f.client.State(id)
+	if err != nil {
+		klog.V(4).Infof("Failed to get runsc state of container %q, not adding its runsc stats: %v", id, err)
+		return handler
+	}
+	return newRunscStatsHandler(handler, f.client, id, state, f.includedMetrics)
+}
+
+func (f *gvisorFactory) NewContainerHandler(name string, metadataEnvAllowList []string, inHostNamespace bool) (container.ContainerHandler, error) {
+	return newGvisorContainerHandler(f.client, name, f.machineInfoFactory, f.cgroupSubsystems, inHostNamespace, f.includedMetrics)
+}
+
+// containerNameToID returns the container id embedded in a cgroup name.
+func containerNameToID(name string) (string, bool) {
+	matches := gvisorCgroupRegexp.FindStringSubmatch(path.Base(name))
+	if matches == nil {
+		return "", false
+	}
+	return matches[1], true
+}
+
+func (f *gvisorFactory) CanHandleAndAccept(name string) (bool, bool, error) {
+	id, ok := containerNameToID(name)
+	if !ok {
+		return false, false, nil
+	}
+	if !f.client.HasContainer(id) {
+		return false, false, nil
+	}
+	return true, true, nil
+}
+
+func (f *gvisorFactory) DebugInfo() map[string][]string {
+	return map[string][]string{}
+}
+
+// Register root container before running this function!
+func Register(factory info.MachineInfoFactory, fsInfo fs.FsInfo, includedMetrics container.MetricSet) error {
+	if _, err := os.Stat(*ArgRunscRoot); err != nil {
+		return fmt.Errorf("runsc root %q not available: %v", *ArgRunscRoot, err)
+	}
+
+	cgroupSubsystems, err := libcontainer.GetCgroupSubsystems(includedMetrics)
+	if err != nil {
+		return fmt.Errorf("failed to get cgroup subsystems: %v", err)
+	}
+
+	klog.V(1).Infof("Registering gVisor factory")
+	f := &gvisorFactory{
+		machineInfoFactory: factory,
+		client:             NewRunscClient(*ArgRunscBinary, *ArgRunscRoot, *runscTimeout),
+		cgroupSubsystems:   cgroupSubsystems,
+		fsInfo:             fsInfo,
+		includedMetrics:    includedMetrics,
+	}
+	container.RegisterContainerHandlerFactory(f, []watcher.ContainerWatchSource{watcher.Raw})
+	return nil
+}
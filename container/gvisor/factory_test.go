@@ -0,0 +1,169 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package gvisor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/opencontainers/runc/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/google/cadvisor/container"
+	containertest "github.com/google/cadvisor/container/testing"
+	info "github.com/google/cadvisor/info/v1"
+	"github.com/google/cadvisor/watcher"
+)
+
+const testContainerID = "81e5c2990803c383229c9680ce964738d5e566d97f5bd436ac34808d2ec75d5f"
+
+type fakeRunscClient struct {
+	states map[string]*runscState
+	stats  map[string]*types.Stats
+}
+
+func (c *fakeRunscClient) HasContainer(id string) bool {
+	_, ok := c.states[id]
+	return ok
+}
+
+func (c *fakeRunscClient) State(id string) (*runscState, error) {
+	return c.states[id], nil
+}
+
+func (c *fakeRunscClient) Stats(id string) (*types.Stats, error) {
+	return c.stats[id], nil
+}
+
+func TestCanHandleAndAccept(t *testing.T) {
+	as := assert.New(t)
+	f := &gvisorFactory{
+		client: &fakeRunscClient{states: map[string]*runscState{testContainerID: {ID: testContainerID}}},
+	}
+	for k, v := range map[string]bool{
+		"/kubepods/burstable/pod068e8fa0/" + testContainerID:                                         true,
+		"/kubepods.slice/kubepods-pod068e8fa0.slice/cri-containerd-" + testContainerID + ".scope":    true,
+		"/kubepods/burstable/pod068e8fa0/990803c383229c9680ce964738d5e566d97f5bd436ac34808d2ec75d5f": false,
+		"/system.slice/docker.service":                                                               false,
+	} {
+		b1, b2, err := f.CanHandleAndAccept(k)
+		as.Nil(err)
+		as.Equal(v, b1, k)
+		as.Equal(v, b2, k)
+	}
+}
+
+// runtimeFactory stands for a runtime factory, such as containerd, that
+// claims every container it is asked about.
+type runtimeFactory struct {
+	name    string
+	handler func(name string) container.ContainerHandler
+}
+
+func (f *runtimeFactory) String() string { return f.name }
+
+func (f *runtimeFactory) DebugInfo() map[string][]string { return nil }
+
+func (f *runtimeFactory) CanHandleAndAccept(name string) (bool, bool, error) {
+	return true, true, nil
+}
+
+func (f *runtimeFactory) NewContainerHandler(name string, metadataEnvAllowList []string, inHostNamespace bool) (container.ContainerHandler, error) {
+	return f.handler(name), nil
+}
+
+func TestRuntimeHandlersGetRunscStats(t *testing.T) {
+	const sandboxID = "0123456789012345678901234567890123456789012345678901234567890123"
+	runscStats := &types.Stats{}
+	runscStats.CPU.Usage.Total = 3000
+	runscStats.Memory.Usage.Usage = 100
+	client := &fakeRunscClient{
+		states: map[string]*runscState{
+			testContainerID: {ID: testContainerID, Annotations: map[string]string{
+				containerdContainerTypeAnnotation: "container",
+				containerdSandboxIDAnnotation:     sandboxID,
+			}},
+			sandboxID: {ID: sandboxID, Annotations: map[string]string{
+				containerdContainerTypeAnnotation: containerTypeSandbox,
+			}},
+		},
+		stats: map[string]*types.Stats{testContainerID: runscStats, sandboxID: runscStats},
+	}
+	cgroupStats := func() *info.ContainerStats {
+		stats := &info.ContainerStats{}
+		stats.Cpu.Usage.Total = 500
+		stats.Memory.Usage = 10
+		return stats
+	}
+	containerd := &runtimeFactory{name: "containerd", handler: func(name string) container.ContainerHandler {
+		h := containertest.NewMockContainerHandler(name)
+		// Each call gets fresh stats, as the runsc usage is added to them.
+		h.On("GetStats").Return(cgroupStats(), nil).Once()
+		h.On("GetStats").Return(cgroupStats(), nil).Once()
+		h.On("GetSpec").Return(info.ContainerSpec{Labels: map[string]string{"io.kubernetes.pod.name": "web"}}, nil)
+		return h
+	}}
+	raw := &runtimeFactory{name: "raw", handler: func(name string) container.ContainerHandler {
+		return containertest.NewMockContainerHandler(name)
+	}}
+
+	container.ClearContainerHandlerFactories()
+	defer container.ClearContainerHandlerFactories()
+	// Registered in the order of the plugins, which is not the one they are asked in.
+	container.RegisterContainerHandlerFactory(raw, []watcher.ContainerWatchSource{watcher.Raw})
+	container.RegisterContainerHandlerFactory(&gvisorFactory{client: client, includedMetrics: container.AllMetrics}, []watcher.ContainerWatchSource{watcher.Raw})
+	container.RegisterContainerHandlerFactory(containerd, []watcher.ContainerWatchSource{watcher.Raw})
+
+	newHandler := func(id string) container.ContainerHandler {
+		name := "/kubepods.slice/kubepods-pod068e8fa0.slice/cri-containerd-" + id + ".scope"
+		handler, accept, err := container.NewContainerHandler(name, watcher.Raw, nil, true)
+		require.NoError(t, err)
+		require.True(t, accept)
+		ref, err := handler.ContainerReference()
+		require.NoError(t, err)
+		assert.Equal(t, name, ref.Name)
+		return handler
+	}
+
+	// The workload container is containerd's, with the runsc usage added.
+	workload := newHandler(testContainerID)
+	stats, err := workload.GetStats()
+	require.NoError(t, err)
+	assert.Equal(t, uint64(3500), stats.Cpu.Usage.Total)
+	assert.Equal(t, uint64(110), stats.Memory.Usage)
+	stats, err = workload.(container.StatsContextGetter).GetStatsContext(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, uint64(3500), stats.Cpu.Usage.Total)
+	spec, err := workload.GetSpec()
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"io.kubernetes.pod.name": "web",
+		SandboxIDLabel:           sandboxID,
+		RoleLabel:                roleWorkload,
+	}, spec.Labels)
+
+	// The host cgroup of the sandbox already has the sentry.
+	sandbox := newHandler(sandboxID)
+	stats, err = sandbox.GetStats()
+	require.NoError(t, err)
+	assert.Equal(t, uint64(500), stats.Cpu.Usage.Total)
+
+	// Other containers are left alone.
+	other := newHandler("90803c383229c9680ce964738d5e566d97f5bd436ac34808d2ec75d5f81e5c2a")
+	assert.IsType(t, &containertest.MockContainerHandler{}, other)
+}
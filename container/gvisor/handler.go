@@ -0,0 +1,309 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+// Handler for gVisor (runsc) containers.
+package gvisor
+
+import (
+	"context"
+	"fmt"
+	"maps"
+
+	"github.com/opencontainers/runc/types"
+	"k8s.io/klog/v2"
+
+	"github.com/google/cadvisor/container"
+	"github.com/google/cadvisor/container/common"
+	containerlibcontainer "github.com/google/cadvisor/container/libcontainer"
+	info "github.com/google/cadvisor/info/v1"
+)
+
+const (
+	// Labels attached to gVisor containers.
+	SandboxIDLabel = "dev.gvisor.sandbox.id"
+	RoleLabel      = "dev.gvisor.role"
+
+	roleSandbox  = "sandbox"
+	roleWorkload = "workload"
+)
+
+type gvisorContainerHandler struct {
+	client RunscClient
+	id     string
+	// Whether this is the sandbox container. Its host cgroup holds the
+	// sentry and gofer processes, so its cgroup stats are the real host
+	// cost of the whole sandbox.
+	sandbox bool
+
+	machineInfoFactory info.MachineInfoFactory
+
+	// Absolute path to the cgroup hierarchies of this container.
+	// (e.g.: "cpu" -> "/sys/fs/cgroup/cpu/test")
+	cgroupPaths map[string]string
+
+	labels          map[string]string
+	includedMetrics container.MetricSet
+	reference       info.ContainerReference
+
+	libcontainerHandler *containerlibcontainer.Handler
+}
+
+var _ container.ContainerHandler = &gvisorContainerHandler{}
+
+// newGvisorContainerHandler returns a new container.ContainerHandler
+func newGvisorContainerHandler(
+	client RunscClient,
+	name string,
+	machineInfoFactory info.MachineInfoFactory,
+	cgroupSubsystems map[string]string,
+	inHostNamespace bool,
+	includedMetrics container.MetricSet,
+) (container.ContainerHandler, error) {
+	id, ok := containerNameToID(name)
+	if !ok {
+		return nil, fmt.Errorf("%q is not a gVisor container cgroup", name)
+	}
+	state, err := client.State(id)
+	if err != nil {
+		return nil, err
+	}
+
+	rootFs := "/"
+	if !inHostNamespace {
+		rootFs = "/rootfs"
+	}
+
+	cgroupPaths := common.MakeCgroupPaths(cgroupSubsystems, name)
+	cgroupManager, err := containerlibcontainer.NewCgroupManager(name, cgroupPaths)
+	if err != nil {
+		return nil, err
+	}
+
+	// The pod network belongs to the sandbox; only report it there.
+	metrics := common.RemoveNetMetrics(includedMetrics, !state.IsSandbox())
+
+	return &gvisorContainerHandler{
+		client:             client,
+		id:                 id,
+		sandbox:            state.IsSandbox(),
+		machineInfoFactory: machineInfoFactory,
+		cgroupPaths:        cgroupPaths,
+		labels:             gvisorLabels(state),
+		includedMetrics:    metrics,
+		reference: info.ContainerReference{
+			Id:        id,
+			Name:      name,
+			Aliases:   []string{id},
+			Namespace: GvisorNamespace,
+		},
+		libcontainerHandler: containerlibcontainer.NewHandler(cgroupManager, rootFs, state.Pid, metrics),
+	}, nil
+}
+
+// gvisorLabels returns the labels of the container of state.
+func gvisorLabels(state *runscState) map[string]string {
+	role := roleWorkload
+	if state.IsSandbox() {
+		role = roleSandbox
+	}
+	return map[string]string{
+		SandboxIDLabel: state.SandboxID(),
+		RoleLabel:      role,
+	}
+}
+
+func (h *gvisorContainerHandler) ContainerReference() (info.ContainerReference, error) {
+	return h.reference, nil
+}
+
+func (h *gvisorContainerHandler) GetSpec() (info.ContainerSpec, error) {
+	hasNetwork := h.includedMetrics.Has(container.NetworkUsageMetrics)
+	spec, err := common.GetSpec(h.cgroupPaths, h.machineInfoFactory, hasNetwork, false)
+	spec.Labels = h.labels
+	return spec, err
+}
+
+func (h *gvisorContainerHandler) GetStats() (*info.ContainerStats, error) {
+	stats, err := h.libcontainerHandler.GetStats()
+	if err != nil || h.sandbox {
+		return stats, err
+	}
+
+	addRunscStats(h.client, h.id, stats, h.includedMetrics)
+	return stats, nil
+}
+
+// addRunscStats adds the usage runsc accounts to the workload container id to
+// stats. Workload processes run inside the sentry, so the host cgroup of a
+// workload container is (nearly) empty.
+func addRunscStats(client RunscClient, id string, stats *info.ContainerStats, includedMetrics container.MetricSet) {
+	runscStats, err := client.Stats(id)
+	if err != nil {
+		klog.V(4).Infof("Failed to get runsc stats of container %q: %v", id, err)
+		return
+	}
+	mergeRunscStats(stats, runscStats, includedMetrics)
+}
+
+// mergeRunscStats adds the CPU, memory and process usage runsc accounts to
+// the workload inside the sentry to the usage of its host cgroup, which has
+// the processes that run outside of the sentry, if any.
+func mergeRunscStats(stats *info.ContainerStats, runscStats *types.Stats, includedMetrics container.MetricSet) {
+	if includedMetrics.Has(container.CpuUsageMetrics) {
+		stats.Cpu.Usage.Total += runscStats.CPU.Usage.Total
+		stats.Cpu.Usage.User += runscStats.CPU.Usage.User
+		stats.Cpu.Usage.System += runscStats.CPU.Usage.Kernel
+		if includedMetrics.Has(container.PerCpuUsageMetrics) {
+			for i, usage := range runscStats.CPU.Usage.Percpu {
+				if i < len(stats.Cpu.Usage.PerCpu) {
+					stats.Cpu.Usage.PerCpu[i] += usage
+				} else {
+					stats.Cpu.Usage.PerCpu = append(stats.Cpu.Usage.PerCpu, usage)
+				}
+			}
+		}
+	}
+	if includedMetrics.Has(container.MemoryUsageMetrics) {
+		usage := runscStats.Memory.Usage.Usage
+		stats.Memory.Usage += usage
+		stats.Memory.Cache += runscStats.Memory.Cache
+		if usage > runscStats.Memory.Cache {
+			stats.Memory.WorkingSet += usage - runscStats.Memory.Cache
+		}
+		stats.Memory.RSS += runscStats.Memory.Raw["rss"]
+		// The peaks of the two need not have been at the same time, so the
+		// peak of their sum is only known to be at least the larger one.
+		for _, peak := range []uint64{runscStats.Memory.Usage.Max, stats.Memory.Usage} {
+			if peak > stats.Memory.MaxUsage {
+				stats.Memory.MaxUsage = peak
+			}
+		}
+	}
+	if includedMetrics.Has(container.ProcessMetrics) {
+		stats.Processes.ProcessCount += runscStats.Pids.Current
+	}
+}
+
+func (h *gvisorContainerHandler) ListContainers(listType container.ListType) ([]info.ContainerReference, error) {
+	return []info.ContainerReference{}, nil
+}
+
+func (h *gvisorContainerHandler) ListProcesses(listType container.ListType) ([]int, error) {
+	return h.libcontainerHandler.GetProcesses()
+}
+
+func (h *gvisorContainerHandler) GetCgroupPath(resource string) (string, error) {
+	var res string
+	if !common.IsCgroup2UnifiedMode() {
+		res = resource
+	}
+	path, ok := h.cgroupPaths[res]
+	if !ok {
+		return "", fmt.Errorf("could not find path for resource %q for container %q", resource, h.reference.Name)
+	}
+	return path, nil
+}
+
+func (h *gvisorContainerHandler) GetContainerLabels() map[string]string {
+	return h.labels
+}
+
+func (h *gvisorContainerHandler) GetContainerIPAddress() string {
+	return ""
+}
+
+func (h *gvisorContainerHandler) Exists() bool {
+	return common.CgroupExists(h.cgroupPaths)
+}
+
+func (h *gvisorContainerHandler) Type() container.ContainerType {
+	return container.ContainerTypeGvisor
+}
+
+func (h *gvisorContainerHandler) GetExitCode() (int, error) {
+	return -1, fmt.Errorf("exit code not available from runsc")
+}
+
+// Nothing to start up.
+func (h *gvisorContainerHandler) Start() {}
+
+// Nothing to clean up.
+func (h *gvisorContainerHandler) Cleanup() {
+	h.libcontainerHandler.Close()
+}
+
+// runscStatsHandler is the handler a runtime factory, such as containerd or
+// CRI-O, created for a gVisor container, with the gVisor labels and, for
+// workload containers, the usage runsc accounts added to its stats.
+type runscStatsHandler struct {
+	container.ContainerHandler
+
+	client          RunscClient
+	id              string
+	sandbox         bool
+	labels          map[string]string
+	includedMetrics container.MetricSet
+}
+
+var _ container.StatsContextGetter = &runscStatsHandler{}
+
+func newRunscStatsHandler(handler container.ContainerHandler, client RunscClient, id string, state *runscState, includedMetrics container.MetricSet) *runscStatsHandler {
+	return &runscStatsHandler{
+		ContainerHandler: handler,
+		client:           client,
+		id:               id,
+		sandbox:          state.IsSandbox(),
+		labels:           gvisorLabels(state),
+		includedMetrics:  includedMetrics,
+	}
+}
+
+func (h *runscStatsHandler) GetSpec() (info.ContainerSpec, error) {
+	spec, err := h.ContainerHandler.GetSpec()
+	spec.Labels = h.addLabels(spec.Labels)
+	return spec, err
+}
+
+func (h *runscStatsHandler) GetContainerLabels() map[string]string {
+	return h.addLabels(h.ContainerHandler.GetContainerLabels())
+}
+
+// addLabels returns a copy of labels with the gVisor labels.
+func (h *runscStatsHandler) addLabels(labels map[string]string) map[string]string {
+	ret := make(map[string]string, len(labels)+len(h.labels))
+	maps.Copy(ret, labels)
+	maps.Copy(ret, h.labels)
+	return ret
+}
+
+func (h *runscStatsHandler) GetStats() (*info.ContainerStats, error) {
+	return h.addStats(h.ContainerHandler.GetStats())
+}
+
+func (h *runscStatsHandler) GetStatsContext(ctx context.Context) (*info.ContainerStats, error) {
+	if getter, ok := h.ContainerHandler.(container.StatsContextGetter); ok {
+		return h.addStats(getter.GetStatsContext(ctx))
+	}
+	return h.GetStats()
+}
+
+func (h *runscStatsHandler) addStats(stats *info.ContainerStats, err error) (*info.ContainerStats, error) {
+	if err != nil || h.sandbox {
+		return stats, err
+	}
+	addRunscStats(h.client, h.id, stats, h.includedMetrics)
+	return stats, nil
+}
@@ -0,0 +1,66 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package gvisor
+
+import (
+	"testing"
+
+	"github.com/opencontainers/runc/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/google/cadvisor/container"
+	info "github.com/google/cadvisor/info/v1"
+)
+
+func TestMergeRunscStats(t *testing.T) {
+	stats := &info.ContainerStats{}
+	stats.Cpu.Usage.Total = 500
+	stats.Cpu.Usage.PerCpu = []uint64{500}
+	stats.Memory.Usage = 10
+	stats.Memory.MaxUsage = 50
+	stats.Memory.WorkingSet = 8
+	stats.Memory.RSS = 5
+	stats.Processes.ProcessCount = 1
+	runscStats := &types.Stats{}
+	runscStats.CPU.Usage.Total = 3000
+	runscStats.CPU.Usage.User = 2000
+	runscStats.CPU.Usage.Kernel = 1000
+	runscStats.CPU.Usage.Percpu = []uint64{1000, 2000}
+	runscStats.Memory.Usage.Usage = 100
+	runscStats.Memory.Usage.Max = 80
+	runscStats.Memory.Cache = 30
+	runscStats.Memory.Raw = map[string]uint64{"rss": 60}
+	runscStats.Pids.Current = 4
+
+	mergeRunscStats(stats, runscStats, container.MetricSet{
+		container.CpuUsageMetrics:    struct{}{},
+		container.PerCpuUsageMetrics: struct{}{},
+		container.MemoryUsageMetrics: struct{}{},
+	})
+
+	// The usage of the host cgroup is kept.
+	assert.Equal(t, uint64(3500), stats.Cpu.Usage.Total)
+	assert.Equal(t, uint64(1000), stats.Cpu.Usage.System)
+	assert.Equal(t, []uint64{1500, 2000}, stats.Cpu.Usage.PerCpu)
+	assert.Equal(t, uint64(110), stats.Memory.Usage)
+	assert.Equal(t, uint64(110), stats.Memory.MaxUsage)
+	assert.Equal(t, uint64(30), stats.Memory.Cache)
+	assert.Equal(t, uint64(78), stats.Memory.WorkingSet)
+	assert.Equal(t, uint64(65), stats.Memory.RSS)
+	// Process metrics are not enabled.
+	assert.Equal(t, uint64(1), stats.Processes.ProcessCount)
+}
@@ -0,0 +1,103 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hcs
+
+import (
+	"time"
+)
+
+// Client reads the compute systems of the Host Compute Service.
+type Client interface {
+	// ComputeSystems returns the containers running on the host.
+	ComputeSystems() ([]ComputeSystem, error)
+	// Properties returns the statistics and processes of a container.
+	Properties(id string) (*Properties, error)
+}
+
+// ComputeSystem describes a container, as enumerated by the HCS.
+type ComputeSystem struct {
+	ID         string `json:"Id"`
+	SystemType string `json:",omitempty"`
+	Name       string `json:",omitempty"`
+	// The program that created the container, e.g. docker or
+	// containerd-shim-runhcs-v1.exe.
+	Owner string `json:",omitempty"`
+	// The ID of the utility VM of a Hyper-V isolated container.
+	RuntimeID string `json:"RuntimeId,omitempty"`
+	Stopped   bool   `json:",omitempty"`
+	ExitType  string `json:",omitempty"`
+}
+
+// Properties are the properties of a container for the Statistics and
+// ProcessList property types.
+type Properties struct {
+	ComputeSystem
+	Statistics  *Statistics      `json:",omitempty"`
+	ProcessList []ProcessDetails `json:",omitempty"`
+}
+
+// Statistics are the counters of the job object of a process isolated
+// container, or of the utility VM of a Hyper-V isolated container.
+type Statistics struct {
+	Timestamp          time.Time
+	ContainerStartTime time.Time
+	Uptime100ns        uint64
+	Processor          *ProcessorStats `json:",omitempty"`
+	Memory             *MemoryStats    `json:",omitempty"`
+	Storage            *StorageStats   `json:",omitempty"`
+	Network            []NetworkStats  `json:",omitempty"`
+}
+
+type ProcessorStats struct {
+	TotalRuntime100ns  uint64 `json:",omitempty"`
+	RuntimeUser100ns   uint64 `json:",omitempty"`
+	RuntimeKernel100ns uint64 `json:",omitempty"`
+}
+
+type MemoryStats struct {
+	UsageCommitBytes            uint64 `json:"MemoryUsageCommitBytes,omitempty"`
+	UsageCommitPeakBytes        uint64 `json:"MemoryUsageCommitPeakBytes,omitempty"`
+	UsagePrivateWorkingSetBytes uint64 `json:"MemoryUsagePrivateWorkingSetBytes,omitempty"`
+}
+
+type StorageStats struct {
+	ReadCountNormalized  uint64 `json:",omitempty"`
+	ReadSizeBytes        uint64 `json:",omitempty"`
+	WriteCountNormalized uint64 `json:",omitempty"`
+	WriteSizeBytes       uint64 `json:",omitempty"`
+}
+
+type NetworkStats struct {
+	BytesReceived          uint64 `json:",omitempty"`
+	BytesSent              uint64 `json:",omitempty"`
+	PacketsReceived        uint64 `json:",omitempty"`
+	PacketsSent            uint64 `json:",omitempty"`
+	DroppedPacketsIncoming uint64 `json:",omitempty"`
+	DroppedPacketsOutgoing uint64 `json:",omitempty"`
+	EndpointID             string `json:"EndpointId,omitempty"`
+	InstanceID             string `json:"InstanceId,omitempty"`
+}
+
+// ProcessDetails describes a process of a container.
+type ProcessDetails struct {
+	ProcessID                    uint32    `json:"ProcessId,omitempty"`
+	ImageName                    string    `json:",omitempty"`
+	CreateTimestamp              time.Time `json:",omitempty"`
+	UserTime100ns                uint64    `json:",omitempty"`
+	KernelTime100ns              uint64    `json:",omitempty"`
+	MemoryCommitBytes            uint64    `json:",omitempty"`
+	MemoryWorkingSetPrivateBytes uint64    `json:",omitempty"`
+	MemoryWorkingSetSharedBytes  uint64    `json:",omitempty"`
+}
@@ -0,0 +1,75 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package hcs monitors the Windows containers of the Host Compute Service.
+package hcs
+
+import (
+	"path"
+	"strings"
+
+	"github.com/google/cadvisor/container"
+	info "github.com/google/cadvisor/info/v1"
+)
+
+// HcsNamespace is the namespace under which the IDs of containers are
+// unique.
+const HcsNamespace = "hcs"
+
+// The parent of the containers, which are named /hcs/<id>.
+const containersParent = "/hcs"
+
+type hcsFactory struct {
+	client             Client
+	machineInfoFactory info.MachineInfoFactory
+	// Reads the usage of the host, the root container.
+	hostStats       func() (*info.ContainerStats, error)
+	includedMetrics container.MetricSet
+}
+
+func (f *hcsFactory) String() string {
+	return HcsNamespace
+}
+
+func (f *hcsFactory) NewContainerHandler(name string, metadataEnvAllowList []string, inHostNamespace bool) (container.ContainerHandler, error) {
+	if name == "/" {
+		return newHostHandler(f.client, f.machineInfoFactory, f.hostStats, f.includedMetrics), nil
+	}
+	return newHcsContainerHandler(f.client, name, containerID(name), f.machineInfoFactory, f.includedMetrics)
+}
+
+// containerID returns the ID of a container from its name.
+func containerID(name string) string {
+	return strings.TrimPrefix(name, containersParent+"/")
+}
+
+// containerName returns the name of a container from its ID.
+func containerName(id string) string {
+	return path.Join(containersParent, id)
+}
+
+// The host and its containers are handled, the containers being the only
+// subcontainers of the host.
+func (f *hcsFactory) CanHandleAndAccept(name string) (bool, bool, error) {
+	if name == "/" {
+		return true, true, nil
+	}
+	id := containerID(name)
+	ok := id != name && id != "" && !strings.Contains(id, "/")
+	return ok, ok, nil
+}
+
+func (f *hcsFactory) DebugInfo() map[string][]string {
+	return map[string][]string{}
+}
@@ -0,0 +1,213 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hcs
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/cadvisor/container"
+	info "github.com/google/cadvisor/info/v1"
+)
+
+// Labels attached to HCS containers.
+const (
+	OwnerLabel      = "io.cadvisor.hcs.owner"
+	SystemTypeLabel = "io.cadvisor.hcs.system_type"
+	// The ID of the utility VM of a Hyper-V isolated container.
+	RuntimeIDLabel = "io.cadvisor.hcs.runtime_id"
+)
+
+type hcsContainerHandler struct {
+	client             Client
+	id                 string
+	machineInfoFactory info.MachineInfoFactory
+	includedMetrics    container.MetricSet
+	reference          info.ContainerReference
+	labels             map[string]string
+	creationTime       time.Time
+}
+
+var _ container.ContainerHandler = &hcsContainerHandler{}
+
+func newHcsContainerHandler(client Client, name, id string, machineInfoFactory info.MachineInfoFactory, includedMetrics container.MetricSet) (container.ContainerHandler, error) {
+	properties, err := client.Properties(id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the properties of container %q: %v", id, err)
+	}
+	labels := map[string]string{}
+	for label, value := range map[string]string{
+		OwnerLabel:      properties.Owner,
+		SystemTypeLabel: properties.SystemType,
+		RuntimeIDLabel:  properties.RuntimeID,
+	} {
+		if value != "" {
+			labels[label] = value
+		}
+	}
+	handler := &hcsContainerHandler{
+		client:             client,
+		id:                 id,
+		machineInfoFactory: machineInfoFactory,
+		includedMetrics:    includedMetrics,
+		reference: info.ContainerReference{
+			Id:        id,
+			Name:      name,
+			Aliases:   []string{id},
+			Namespace: HcsNamespace,
+		},
+		labels: labels,
+	}
+	if properties.Name != "" && properties.Name != id {
+		handler.reference.Aliases = append(handler.reference.Aliases, properties.Name)
+	}
+	if properties.Statistics != nil {
+		handler.creationTime = properties.Statistics.ContainerStartTime
+	}
+	return handler, nil
+}
+
+func (h *hcsContainerHandler) ContainerReference() (info.ContainerReference, error) {
+	return h.reference, nil
+}
+
+func (h *hcsContainerHandler) GetSpec() (info.ContainerSpec, error) {
+	spec := info.ContainerSpec{
+		CreationTime: h.creationTime,
+		Labels:       h.labels,
+		HasCpu:       true,
+		HasMemory:    true,
+		HasDiskIo:    h.includedMetrics.Has(container.DiskIOMetrics),
+		HasNetwork:   h.includedMetrics.Has(container.NetworkUsageMetrics),
+		HasProcesses: h.includedMetrics.Has(container.ProcessMetrics),
+	}
+	// Limits aren't read from the HCS, the machine capacity is the most a
+	// container can use.
+	if machineInfo, err := h.machineInfoFactory.GetMachineInfo(); err == nil {
+		spec.Cpu.Limit = uint64(1024 * machineInfo.NumCores)
+		spec.Memory.Limit = machineInfo.MemoryCapacity
+	}
+	return spec, nil
+}
+
+func (h *hcsContainerHandler) GetStats() (*info.ContainerStats, error) {
+	properties, err := h.client.Properties(h.id)
+	if err != nil {
+		return nil, err
+	}
+	if properties.Statistics == nil {
+		return nil, fmt.Errorf("no statistics for container %q", h.id)
+	}
+	stats := convertStatistics(properties.Statistics, h.includedMetrics)
+	if h.includedMetrics.Has(container.ProcessMetrics) {
+		stats.Processes.ProcessCount = uint64(len(properties.ProcessList))
+	}
+	return stats, nil
+}
+
+func (h *hcsContainerHandler) ListContainers(listType container.ListType) ([]info.ContainerReference, error) {
+	return nil, nil
+}
+
+func (h *hcsContainerHandler) ListProcesses(listType container.ListType) ([]int, error) {
+	properties, err := h.client.Properties(h.id)
+	if err != nil {
+		return nil, err
+	}
+	pids := make([]int, len(properties.ProcessList))
+	for i, process := range properties.ProcessList {
+		pids[i] = int(process.ProcessID)
+	}
+	return pids, nil
+}
+
+func (h *hcsContainerHandler) GetCgroupPath(resource string) (string, error) {
+	return "", fmt.Errorf("windows containers have no cgroups")
+}
+
+func (h *hcsContainerHandler) GetContainerLabels() map[string]string {
+	return h.labels
+}
+
+func (h *hcsContainerHandler) GetContainerIPAddress() string {
+	return ""
+}
+
+func (h *hcsContainerHandler) GetExitCode() (int, error) {
+	return -1, fmt.Errorf("exit codes of windows containers aren't available")
+}
+
+func (h *hcsContainerHandler) Exists() bool {
+	properties, err := h.client.Properties(h.id)
+	return err == nil && !properties.Stopped
+}
+
+func (h *hcsContainerHandler) Cleanup() {}
+
+func (h *hcsContainerHandler) Start() {}
+
+func (h *hcsContainerHandler) Type() container.ContainerType {
+	return container.ContainerTypeHcs
+}
+
+// convertStatistics returns the container stats of HCS statistics.
+func convertStatistics(statistics *Statistics, includedMetrics container.MetricSet) *info.ContainerStats {
+	stats := &info.ContainerStats{Timestamp: statistics.Timestamp}
+	if stats.Timestamp.IsZero() {
+		stats.Timestamp = time.Now()
+	}
+	if p := statistics.Processor; p != nil {
+		stats.Cpu.Usage.Total = p.TotalRuntime100ns * 100
+		stats.Cpu.Usage.User = p.RuntimeUser100ns * 100
+		stats.Cpu.Usage.System = p.RuntimeKernel100ns * 100
+	}
+	if m := statistics.Memory; m != nil {
+		// Windows commits the memory processes allocate, the working set
+		// is the part of it which is resident.
+		stats.Memory.Usage = m.UsageCommitBytes
+		stats.Memory.MaxUsage = m.UsageCommitPeakBytes
+		stats.Memory.WorkingSet = m.UsagePrivateWorkingSetBytes
+		stats.Memory.RSS = m.UsagePrivateWorkingSetBytes
+	}
+	if s := statistics.Storage; s != nil && includedMetrics.Has(container.DiskIOMetrics) {
+		stats.DiskIo.IoServiceBytes = []info.PerDiskStats{{Stats: map[string]uint64{
+			"Read":  s.ReadSizeBytes,
+			"Write": s.WriteSizeBytes,
+			"Total": s.ReadSizeBytes + s.WriteSizeBytes,
+		}}}
+		stats.DiskIo.IoServiced = []info.PerDiskStats{{Stats: map[string]uint64{
+			"Read":  s.ReadCountNormalized,
+			"Write": s.WriteCountNormalized,
+			"Total": s.ReadCountNormalized + s.WriteCountNormalized,
+		}}}
+	}
+	if includedMetrics.Has(container.NetworkUsageMetrics) {
+		for _, n := range statistics.Network {
+			stats.Network.Interfaces = append(stats.Network.Interfaces, info.InterfaceStats{
+				Name:      n.EndpointID,
+				RxBytes:   n.BytesReceived,
+				RxPackets: n.PacketsReceived,
+				RxDropped: n.DroppedPacketsIncoming,
+				TxBytes:   n.BytesSent,
+				TxPackets: n.PacketsSent,
+				TxDropped: n.DroppedPacketsOutgoing,
+			})
+		}
+		if len(stats.Network.Interfaces) > 0 {
+			stats.Network.InterfaceStats = stats.Network.Interfaces[0]
+		}
+	}
+	return stats
+}
@@ -0,0 +1,181 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hcs
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/google/cadvisor/container"
+	info "github.com/google/cadvisor/info/v1"
+)
+
+// fakeClient serves the properties of the containers of a map, by ID.
+type fakeClient struct {
+	lock       sync.Mutex
+	properties map[string]*Properties
+}
+
+func (c *fakeClient) set(properties map[string]*Properties) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.properties = properties
+}
+
+func (c *fakeClient) ComputeSystems() ([]ComputeSystem, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	var systems []ComputeSystem
+	for _, p := range c.properties {
+		systems = append(systems, p.ComputeSystem)
+	}
+	return systems, nil
+}
+
+func (c *fakeClient) Properties(id string) (*Properties, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	p, ok := c.properties[id]
+	if !ok {
+		return nil, fmt.Errorf("no compute system %q", id)
+	}
+	return p, nil
+}
+
+type fakeMachineInfoFactory struct{}
+
+func (fakeMachineInfoFactory) GetMachineInfo() (*info.MachineInfo, error) {
+	return &info.MachineInfo{NumCores: 4, MemoryCapacity: 16 << 30}, nil
+}
+
+func (fakeMachineInfoFactory) GetVersionInfo() (*info.VersionInfo, error) {
+	return &info.VersionInfo{}, nil
+}
+
+// The properties of a container as returned by HcsGetComputeSystemProperties.
+const sampleProperties = `{
+	"Id": "3f2a",
+	"SystemType": "Container",
+	"Name": "3f2a",
+	"Owner": "containerd-shim-runhcs-v1.exe",
+	"RuntimeId": "00000000-0000-0000-0000-000000000000",
+	"Statistics": {
+		"Timestamp": "2026-10-14T09:00:00Z",
+		"ContainerStartTime": "2026-10-14T08:00:00Z",
+		"Uptime100ns": 36000000000,
+		"Processor": {"TotalRuntime100ns": 30, "RuntimeUser100ns": 20, "RuntimeKernel100ns": 10},
+		"Memory": {"MemoryUsageCommitBytes": 4096, "MemoryUsageCommitPeakBytes": 8192, "MemoryUsagePrivateWorkingSetBytes": 2048},
+		"Storage": {"ReadCountNormalized": 1, "ReadSizeBytes": 512, "WriteCountNormalized": 2, "WriteSizeBytes": 1024},
+		"Network": [{"EndpointId": "e1", "BytesReceived": 10, "BytesSent": 20, "PacketsReceived": 1, "PacketsSent": 2, "DroppedPacketsIncoming": 3, "DroppedPacketsOutgoing": 4}]
+	},
+	"ProcessList": [{"ProcessId": 100, "ImageName": "cmd.exe"}, {"ProcessId": 200, "ImageName": "app.exe"}]
+}`
+
+func newFakeClient(t *testing.T) *fakeClient {
+	var properties Properties
+	require.NoError(t, json.Unmarshal([]byte(sampleProperties), &properties))
+	return &fakeClient{properties: map[string]*Properties{"3f2a": &properties}}
+}
+
+func TestCanHandleAndAccept(t *testing.T) {
+	f := &hcsFactory{}
+	for name, expected := range map[string]bool{
+		"/":                  true,
+		"/hcs/3f2a":          true,
+		"/hcs":               false,
+		"/hcs/":              false,
+		"/hcs/3f2a/child":    false,
+		"/docker/3f2a":       false,
+		"/system.slice/kube": false,
+	} {
+		canHandle, canAccept, err := f.CanHandleAndAccept(name)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, canHandle, name)
+		assert.Equal(t, expected, canAccept, name)
+	}
+}
+
+func TestHandler(t *testing.T) {
+	client := newFakeClient(t)
+	metrics := container.MetricSet{container.DiskIOMetrics: struct{}{}, container.NetworkUsageMetrics: struct{}{}, container.ProcessMetrics: struct{}{}}
+	f := &hcsFactory{client: client, machineInfoFactory: fakeMachineInfoFactory{}, includedMetrics: metrics}
+	handler, err := f.NewContainerHandler("/hcs/3f2a", nil, true)
+	require.NoError(t, err)
+
+	reference, err := handler.ContainerReference()
+	require.NoError(t, err)
+	assert.Equal(t, info.ContainerReference{Id: "3f2a", Name: "/hcs/3f2a", Aliases: []string{"3f2a"}, Namespace: HcsNamespace}, reference)
+
+	spec, err := handler.GetSpec()
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2026, 10, 14, 8, 0, 0, 0, time.UTC), spec.CreationTime)
+	assert.Equal(t, map[string]string{
+		OwnerLabel:      "containerd-shim-runhcs-v1.exe",
+		SystemTypeLabel: "Container",
+		RuntimeIDLabel:  "00000000-0000-0000-0000-000000000000",
+	}, spec.Labels)
+	assert.Equal(t, uint64(4096), spec.Cpu.Limit)
+	assert.Equal(t, uint64(16<<30), spec.Memory.Limit)
+	assert.True(t, spec.HasDiskIo)
+	assert.True(t, spec.HasNetwork)
+
+	stats, err := handler.GetStats()
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2026, 10, 14, 9, 0, 0, 0, time.UTC), stats.Timestamp)
+	assert.Equal(t, info.CpuUsage{Total: 3000, User: 2000, System: 1000}, stats.Cpu.Usage)
+	assert.Equal(t, uint64(4096), stats.Memory.Usage)
+	assert.Equal(t, uint64(8192), stats.Memory.MaxUsage)
+	assert.Equal(t, uint64(2048), stats.Memory.WorkingSet)
+	assert.Equal(t, []info.PerDiskStats{{Stats: map[string]uint64{"Read": 512, "Write": 1024, "Total": 1536}}}, stats.DiskIo.IoServiceBytes)
+	assert.Equal(t, []info.PerDiskStats{{Stats: map[string]uint64{"Read": 1, "Write": 2, "Total": 3}}}, stats.DiskIo.IoServiced)
+	require.Len(t, stats.Network.Interfaces, 1)
+	assert.Equal(t, info.InterfaceStats{Name: "e1", RxBytes: 10, TxBytes: 20, RxPackets: 1, TxPackets: 2, RxDropped: 3, TxDropped: 4}, stats.Network.Interfaces[0])
+	assert.Equal(t, uint64(2), stats.Processes.ProcessCount)
+
+	pids, err := handler.ListProcesses(container.ListSelf)
+	require.NoError(t, err)
+	assert.Equal(t, []int{100, 200}, pids)
+
+	assert.True(t, handler.Exists())
+	client.properties["3f2a"].Stopped = true
+	assert.False(t, handler.Exists())
+	client.set(nil)
+	assert.False(t, handler.Exists())
+}
+
+func TestConvertStatisticsLeavesOutMetrics(t *testing.T) {
+	client := newFakeClient(t)
+	stats := convertStatistics(client.properties["3f2a"].Statistics, container.MetricSet{})
+	assert.Equal(t, uint64(3000), stats.Cpu.Usage.Total)
+	assert.Empty(t, stats.DiskIo.IoServiceBytes)
+	assert.Empty(t, stats.Network.Interfaces)
+}
+
+func TestHostListContainers(t *testing.T) {
+	client := newFakeClient(t)
+	client.properties["stopped"] = &Properties{ComputeSystem: ComputeSystem{ID: "stopped", Stopped: true}}
+	f := &hcsFactory{client: client, machineInfoFactory: fakeMachineInfoFactory{}}
+	handler, err := f.NewContainerHandler("/", nil, true)
+	require.NoError(t, err)
+	containers, err := handler.ListContainers(container.ListSelf)
+	require.NoError(t, err)
+	assert.Equal(t, []info.ContainerReference{{Name: "/hcs/3f2a", Namespace: HcsNamespace}}, containers)
+}
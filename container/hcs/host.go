@@ -0,0 +1,113 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hcs
+
+import (
+	"fmt"
+
+	"github.com/google/cadvisor/container"
+	info "github.com/google/cadvisor/info/v1"
+)
+
+// hostHandler handles the root container, the host, whose subcontainers are
+// the containers of the HCS.
+type hostHandler struct {
+	client             Client
+	machineInfoFactory info.MachineInfoFactory
+	hostStats          func() (*info.ContainerStats, error)
+	includedMetrics    container.MetricSet
+}
+
+var _ container.ContainerHandler = &hostHandler{}
+
+func newHostHandler(client Client, machineInfoFactory info.MachineInfoFactory, hostStats func() (*info.ContainerStats, error), includedMetrics container.MetricSet) container.ContainerHandler {
+	return &hostHandler{
+		client:             client,
+		machineInfoFactory: machineInfoFactory,
+		hostStats:          hostStats,
+		includedMetrics:    includedMetrics,
+	}
+}
+
+func (h *hostHandler) ContainerReference() (info.ContainerReference, error) {
+	return info.ContainerReference{Name: "/"}, nil
+}
+
+func (h *hostHandler) GetSpec() (info.ContainerSpec, error) {
+	spec := info.ContainerSpec{
+		HasCpu:    true,
+		HasMemory: true,
+	}
+	if machineInfo, err := h.machineInfoFactory.GetMachineInfo(); err == nil {
+		spec.Cpu.Limit = uint64(1024 * machineInfo.NumCores)
+		spec.Memory.Limit = machineInfo.MemoryCapacity
+	}
+	return spec, nil
+}
+
+func (h *hostHandler) GetStats() (*info.ContainerStats, error) {
+	return h.hostStats()
+}
+
+func (h *hostHandler) ListContainers(listType container.ListType) ([]info.ContainerReference, error) {
+	systems, err := h.client.ComputeSystems()
+	if err != nil {
+		return nil, err
+	}
+	var containers []info.ContainerReference
+	for _, system := range systems {
+		if system.Stopped || system.ID == "" {
+			continue
+		}
+		containers = append(containers, info.ContainerReference{
+			Name:      containerName(system.ID),
+			Namespace: HcsNamespace,
+		})
+	}
+	return containers, nil
+}
+
+func (h *hostHandler) ListProcesses(listType container.ListType) ([]int, error) {
+	return nil, fmt.Errorf("the processes of the host aren't listed")
+}
+
+func (h *hostHandler) GetCgroupPath(resource string) (string, error) {
+	return "", fmt.Errorf("windows hosts have no cgroups")
+}
+
+func (h *hostHandler) GetContainerLabels() map[string]string {
+	return map[string]string{}
+}
+
+func (h *hostHandler) GetContainerIPAddress() string {
+	// the IP address for the host corresponds to the system ip address.
+	return "127.0.0.1"
+}
+
+func (h *hostHandler) GetExitCode() (int, error) {
+	return -1, fmt.Errorf("the host has no exit code")
+}
+
+func (h *hostHandler) Exists() bool {
+	return true
+}
+
+func (h *hostHandler) Cleanup() {}
+
+func (h *hostHandler) Start() {}
+
+func (h *hostHandler) Type() container.ContainerType {
+	return container.ContainerTypeRaw
+}
@@ -0,0 +1,87 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build windows
+
+package hcs
+
+import (
+	"time"
+
+	"github.com/google/cadvisor/container"
+	"github.com/google/cadvisor/fs"
+	info "github.com/google/cadvisor/info/v1"
+	"github.com/google/cadvisor/utils/win32"
+	"github.com/google/cadvisor/watcher"
+
+	"k8s.io/klog/v2"
+)
+
+// NewPlugin returns an implementation of container.Plugin suitable for passing to container.RegisterPlugin()
+func NewPlugin() container.Plugin {
+	return &plugin{}
+}
+
+type plugin struct{}
+
+func (p *plugin) InitializeFSContext(context *fs.Context) error {
+	return nil
+}
+
+func (p *plugin) Register(factory info.MachineInfoFactory, fsInfo fs.FsInfo, includedMetrics container.MetricSet) (watcher.ContainerWatcher, error) {
+	client, err := Register(factory, fsInfo, includedMetrics)
+	if err != nil {
+		return nil, err
+	}
+	return newHcsWatcher(client, *pollInterval), nil
+}
+
+// Register registers the factory of the host, the root container, and of
+// its HCS containers.
+func Register(factory info.MachineInfoFactory, fsInfo fs.FsInfo, includedMetrics container.MetricSet) (Client, error) {
+	client, err := NewClient()
+	if err != nil {
+		return nil, err
+	}
+
+	klog.V(1).Infof("Registering HCS factory")
+	f := &hcsFactory{
+		client:             client,
+		machineInfoFactory: factory,
+		hostStats:          readHostStats,
+		includedMetrics:    includedMetrics,
+	}
+	container.RegisterContainerHandlerFactory(f, []watcher.ContainerWatchSource{watcher.Raw})
+	return client, nil
+}
+
+// readHostStats returns the CPU and memory usage of the host.
+func readHostStats() (*info.ContainerStats, error) {
+	idle, kernel, user, err := win32.SystemTimes()
+	if err != nil {
+		return nil, err
+	}
+	memory, err := win32.GlobalMemoryStatus()
+	if err != nil {
+		return nil, err
+	}
+	stats := &info.ContainerStats{Timestamp: time.Now()}
+	// The kernel time includes the idle time.
+	stats.Cpu.Usage.User = uint64(user)
+	stats.Cpu.Usage.System = uint64(kernel - idle)
+	stats.Cpu.Usage.Total = uint64(user + kernel - idle)
+	stats.Memory.Usage = memory.TotalPhys - memory.AvailPhys
+	stats.Memory.WorkingSet = stats.Memory.Usage
+	return stats, nil
+}
@@ -0,0 +1,120 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build windows
+
+package hcs
+
+import (
+	"encoding/json"
+	"fmt"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+var (
+	vmcompute                         = windows.NewLazySystemDLL("vmcompute.dll")
+	procHcsEnumerateComputeSystems    = vmcompute.NewProc("HcsEnumerateComputeSystems")
+	procHcsOpenComputeSystem          = vmcompute.NewProc("HcsOpenComputeSystem")
+	procHcsCloseComputeSystem         = vmcompute.NewProc("HcsCloseComputeSystem")
+	procHcsGetComputeSystemProperties = vmcompute.NewProc("HcsGetComputeSystemProperties")
+)
+
+// The queries of the containers and of their properties.
+const (
+	enumerateContainersQuery    = `{"Types":["Container"]}`
+	statisticsAndProcessesQuery = `{"PropertyTypes":["Statistics","ProcessList"]}`
+)
+
+type vmcomputeClient struct{}
+
+// NewClient returns a client of the HCS of the host, through vmcompute.dll.
+func NewClient() (Client, error) {
+	if err := vmcompute.Load(); err != nil {
+		return nil, fmt.Errorf("the Host Compute Service isn't available: %v", err)
+	}
+	return vmcomputeClient{}, nil
+}
+
+func (vmcomputeClient) ComputeSystems() ([]ComputeSystem, error) {
+	query, err := windows.UTF16PtrFromString(enumerateContainersQuery)
+	if err != nil {
+		return nil, err
+	}
+	var output, result *uint16
+	r, _, _ := procHcsEnumerateComputeSystems.Call(uintptr(unsafe.Pointer(query)), uintptr(unsafe.Pointer(&output)), uintptr(unsafe.Pointer(&result)))
+	data := takeString(output)
+	if err := hcsError("HcsEnumerateComputeSystems", r, result); err != nil {
+		return nil, err
+	}
+	var systems []ComputeSystem
+	if err := json.Unmarshal([]byte(data), &systems); err != nil {
+		return nil, fmt.Errorf("failed to parse the compute systems: %v", err)
+	}
+	return systems, nil
+}
+
+func (vmcomputeClient) Properties(id string) (*Properties, error) {
+	idPtr, err := windows.UTF16PtrFromString(id)
+	if err != nil {
+		return nil, err
+	}
+	var system windows.Handle
+	var result *uint16
+	r, _, _ := procHcsOpenComputeSystem.Call(uintptr(unsafe.Pointer(idPtr)), uintptr(unsafe.Pointer(&system)), uintptr(unsafe.Pointer(&result)))
+	if err := hcsError("HcsOpenComputeSystem", r, result); err != nil {
+		return nil, err
+	}
+	defer procHcsCloseComputeSystem.Call(uintptr(system))
+
+	query, err := windows.UTF16PtrFromString(statisticsAndProcessesQuery)
+	if err != nil {
+		return nil, err
+	}
+	var output *uint16
+	result = nil
+	r, _, _ = procHcsGetComputeSystemProperties.Call(uintptr(system), uintptr(unsafe.Pointer(query)), uintptr(unsafe.Pointer(&output)), uintptr(unsafe.Pointer(&result)))
+	data := takeString(output)
+	if err := hcsError("HcsGetComputeSystemProperties", r, result); err != nil {
+		return nil, err
+	}
+	properties := &Properties{}
+	if err := json.Unmarshal([]byte(data), properties); err != nil {
+		return nil, fmt.Errorf("failed to parse the properties of %q: %v", id, err)
+	}
+	return properties, nil
+}
+
+// takeString returns a string allocated by the HCS and frees it.
+func takeString(s *uint16) string {
+	if s == nil {
+		return ""
+	}
+	defer windows.CoTaskMemFree(unsafe.Pointer(s))
+	return windows.UTF16PtrToString(s)
+}
+
+// hcsError returns the error of an HCS call from its HRESULT and the JSON
+// result describing a failure.
+func hcsError(call string, hr uintptr, result *uint16) error {
+	details := takeString(result)
+	if int32(hr) >= 0 {
+		return nil
+	}
+	if details != "" {
+		return fmt.Errorf("%s failed: %v: %s", call, windows.Errno(hr), details)
+	}
+	return fmt.Errorf("%s failed: %v", call, windows.Errno(hr))
+}
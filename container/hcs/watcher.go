@@ -0,0 +1,117 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hcs
+
+import (
+	"flag"
+	"time"
+
+	"github.com/google/cadvisor/watcher"
+
+	"k8s.io/klog/v2"
+)
+
+var pollInterval = flag.Duration("hcs_poll_interval", 5*time.Second, "Interval between enumerations of the containers of the Host Compute Service, to detect new and removed containers")
+
+// hcsWatcher detects the containers started and removed by enumerating the
+// containers of the HCS, which has no events for all of them.
+type hcsWatcher struct {
+	client   Client
+	interval time.Duration
+	stop     chan struct{}
+	done     chan struct{}
+}
+
+var _ watcher.ContainerWatcher = &hcsWatcher{}
+
+func newHcsWatcher(client Client, interval time.Duration) *hcsWatcher {
+	return &hcsWatcher{
+		client:   client,
+		interval: interval,
+	}
+}
+
+func (w *hcsWatcher) Start(events chan watcher.ContainerEvent) error {
+	known, err := w.running()
+	if err != nil {
+		return err
+	}
+	w.stop = make(chan struct{})
+	w.done = make(chan struct{})
+	go func() {
+		defer close(w.done)
+		ticker := time.NewTicker(w.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-w.stop:
+				return
+			case <-ticker.C:
+			}
+			running, err := w.running()
+			if err != nil {
+				klog.V(4).Infof("Failed to enumerate the HCS containers: %v", err)
+				continue
+			}
+			for id := range running {
+				if _, ok := known[id]; !ok && !w.send(events, watcher.ContainerAdd, id) {
+					return
+				}
+			}
+			for id := range known {
+				if _, ok := running[id]; !ok && !w.send(events, watcher.ContainerDelete, id) {
+					return
+				}
+			}
+			known = running
+		}
+	}()
+	return nil
+}
+
+func (w *hcsWatcher) Stop() error {
+	if w.stop != nil {
+		close(w.stop)
+		<-w.done
+		w.stop = nil
+	}
+	return nil
+}
+
+// send sends an event about a container, unless the watcher is stopped
+// first.
+func (w *hcsWatcher) send(events chan watcher.ContainerEvent, eventType watcher.ContainerEventType, id string) bool {
+	select {
+	case events <- watcher.ContainerEvent{EventType: eventType, Name: containerName(id), WatchSource: watcher.Raw}:
+		return true
+	case <-w.stop:
+		return false
+	}
+}
+
+// running returns the IDs of the running containers.
+func (w *hcsWatcher) running() (map[string]struct{}, error) {
+	systems, err := w.client.ComputeSystems()
+	if err != nil {
+		return nil, err
+	}
+	running := make(map[string]struct{}, len(systems))
+	for _, system := range systems {
+		if !system.Stopped && system.ID != "" {
+			running[system.ID] = struct{}{}
+		}
+	}
+	return running, nil
+}
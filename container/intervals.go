@@ -0,0 +1,150 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package container
+
+import (
+	"flag"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// intervalMetricGroups are the metric groups whose collection interval can be
+// set, as their collection is expensive compared to reading cgroup counters.
+var intervalMetricGroups = MetricSet{
+	DiskUsageMetrics:               struct{}{},
+	NetworkUsageMetrics:            struct{}{},
+	NetworkTcpUsageMetrics:         struct{}{},
+	NetworkAdvancedTcpUsageMetrics: struct{}{},
+	NetworkUdpUsageMetrics:         struct{}{},
+	ProcessMetrics:                 struct{}{},
+	ProcessSchedulerMetrics:        struct{}{},
+	ReferencedMemoryMetrics:        struct{}{},
+	DaxMemoryMetrics:               struct{}{},
+	PerfMetrics:                    struct{}{},
+	ResctrlMetrics:                 struct{}{},
+	NetworkFsMetrics:               struct{}{},
+	TopProcessMetrics:              struct{}{},
+}
+
+// metricGroupIntervals holds the collection interval of the metric groups
+// that are collected less often than every housekeeping.
+type metricGroupIntervals struct {
+	lock      sync.RWMutex
+	intervals map[MetricKind]time.Duration
+}
+
+var groupIntervals = &metricGroupIntervals{}
+
+func init() {
+	flag.Var(groupIntervals, "metric_group_intervals", "Comma-separated minimum intervals between collections of expensive metric groups, as group=interval, e.g. \"disk=2m,tcp=30s,perf_event=1m\". Between collections, the last values of a group are reported again. Groups not listed are collected on every housekeeping")
+}
+
+func (m *metricGroupIntervals) String() string {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+	groups := make([]string, 0, len(m.intervals))
+	for kind, interval := range m.intervals {
+		groups = append(groups, fmt.Sprintf("%s=%v", kind, interval))
+	}
+	sort.Strings(groups)
+	return strings.Join(groups, ",")
+}
+
+func (m *metricGroupIntervals) Set(value string) error {
+	intervals, err := ParseMetricGroupIntervals(value)
+	if err != nil {
+		return err
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.intervals = intervals
+	return nil
+}
+
+func (m *metricGroupIntervals) get(kind MetricKind) time.Duration {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+	return m.intervals[kind]
+}
+
+// ParseMetricGroupIntervals parses a comma-separated list of group=interval pairs.
+func ParseMetricGroupIntervals(value string) (map[MetricKind]time.Duration, error) {
+	intervals := map[MetricKind]time.Duration{}
+	for _, item := range strings.Split(value, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		group, durationStr, ok := strings.Cut(item, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid metric group interval %q, expected group=interval", item)
+		}
+		kind := MetricKind(strings.TrimSpace(group))
+		if !intervalMetricGroups.Has(kind) {
+			return nil, fmt.Errorf("metric group %q does not support a collection interval, supported groups are %s", kind, intervalMetricGroups)
+		}
+		interval, err := time.ParseDuration(strings.TrimSpace(durationStr))
+		if err != nil || interval < 0 {
+			return nil, fmt.Errorf("invalid interval of metric group %q: %q", kind, durationStr)
+		}
+		intervals[kind] = interval
+	}
+	return intervals, nil
+}
+
+// SetMetricGroupInterval sets how often the given metric group is collected.
+// An interval of 0 collects it on every housekeeping.
+func SetMetricGroupInterval(kind MetricKind, interval time.Duration) {
+	groupIntervals.lock.Lock()
+	defer groupIntervals.lock.Unlock()
+	if groupIntervals.intervals == nil {
+		groupIntervals.intervals = map[MetricKind]time.Duration{}
+	}
+	if interval == 0 {
+		delete(groupIntervals.intervals, kind)
+		return
+	}
+	groupIntervals.intervals[kind] = interval
+}
+
+// MetricGroupSchedule tracks when the metric groups of one container were
+// last collected, so that groups with an interval set by
+// --metric_group_intervals are only collected when due.
+//
+// Like the handlers using it, it is not safe for concurrent use.
+type MetricGroupSchedule struct {
+	last map[MetricKind]time.Time
+}
+
+// Due returns whether the given metric group should be collected at now, and
+// if so records it as collected. Groups without an interval are always due.
+func (s *MetricGroupSchedule) Due(kind MetricKind, now time.Time) bool {
+	interval := groupIntervals.get(kind)
+	if interval == 0 {
+		return true
+	}
+	last, ok := s.last[kind]
+	if ok && now.Sub(last) < interval {
+		return false
+	}
+	if s.last == nil {
+		s.last = make(map[MetricKind]time.Time)
+	}
+	s.last[kind] = now
+	return true
+}
@@ -0,0 +1,62 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package container
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseMetricGroupIntervals(t *testing.T) {
+	intervals, err := ParseMetricGroupIntervals("disk=2m, tcp=30s,perf_event=1m")
+	assert.NoError(t, err)
+	assert.Equal(t, map[MetricKind]time.Duration{
+		DiskUsageMetrics:       2 * time.Minute,
+		NetworkTcpUsageMetrics: 30 * time.Second,
+		PerfMetrics:            time.Minute,
+	}, intervals)
+
+	for _, value := range []string{"disk", "unknown=1m", "cpu=1m", "disk=x", "disk=-1s"} {
+		_, err := ParseMetricGroupIntervals(value)
+		assert.Error(t, err, value)
+	}
+}
+
+func TestMetricGroupIntervalsFlag(t *testing.T) {
+	var m metricGroupIntervals
+	assert.NoError(t, m.Set("tcp=30s,disk=2m"))
+	assert.Equal(t, "disk=2m0s,tcp=30s", m.String())
+	assert.Equal(t, 30*time.Second, m.get(NetworkTcpUsageMetrics))
+	assert.Zero(t, m.get(CpuUsageMetrics))
+	assert.Error(t, m.Set("tcp"))
+}
+
+func TestMetricGroupScheduleDue(t *testing.T) {
+	SetMetricGroupInterval(DiskUsageMetrics, time.Minute)
+	defer SetMetricGroupInterval(DiskUsageMetrics, 0)
+
+	var s MetricGroupSchedule
+	start := time.Unix(1000, 0)
+	assert.True(t, s.Due(DiskUsageMetrics, start))
+	assert.False(t, s.Due(DiskUsageMetrics, start.Add(30*time.Second)))
+	assert.True(t, s.Due(DiskUsageMetrics, start.Add(time.Minute)))
+	assert.False(t, s.Due(DiskUsageMetrics, start.Add(90*time.Second)))
+
+	// Groups without an interval are always due.
+	assert.True(t, s.Due(CpuUsageMetrics, start))
+	assert.True(t, s.Due(CpuUsageMetrics, start))
+}
@@ -0,0 +1,36 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jail
+
+// Client reads the jails of the host and their resource accounting.
+type Client interface {
+	// Jails returns the jails running on the host, dying ones excluded.
+	Jails() ([]Jail, error)
+	// Usage returns the rctl resource usage of a jail, by resource, e.g.
+	// memoryuse.
+	Usage(name string) (map[string]uint64, error)
+	// Limits returns the amounts of the rctl deny rules of a jail, by
+	// resource.
+	Limits(name string) (map[string]uint64, error)
+}
+
+// Jail describes a jail, as returned by jail_get.
+type Jail struct {
+	// The jail ID, which changes when the jail is restarted.
+	JID      int
+	Name     string
+	Path     string
+	Hostname string
+}
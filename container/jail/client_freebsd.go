@@ -0,0 +1,105 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build freebsd
+
+package jail
+
+import (
+	"unsafe"
+
+	"golang.org/x/sys/unix"
+
+	"github.com/google/cadvisor/utils/rctl"
+)
+
+// The size of the name and host.hostname parameters of jails,
+// MAXHOSTNAMELEN.
+const maxHostnameLen = 256
+
+// NewClient returns a client reading the jails with jail_get and their
+// usage with rctl. It fails if the kernel doesn't account resources.
+func NewClient() (Client, error) {
+	if !rctl.Enabled() {
+		return nil, rctl.ErrDisabled
+	}
+	return &client{}, nil
+}
+
+type client struct{}
+
+func (c *client) Jails() ([]Jail, error) {
+	var jails []Jail
+	lastJID := 0
+	for {
+		jail, err := nextJail(lastJID)
+		if err == unix.ENOENT {
+			return jails, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		jails = append(jails, *jail)
+		lastJID = jail.JID
+	}
+}
+
+// nextJail returns the jail following the JID lastJID, ENOENT if there is
+// none.
+func nextJail(lastJID int) (*Jail, error) {
+	lastjid := int32(lastJID)
+	name := make([]byte, maxHostnameLen)
+	path := make([]byte, unix.PathMax)
+	hostname := make([]byte, maxHostnameLen)
+	var iov []unix.Iovec
+	for _, param := range []struct {
+		name  string
+		value []byte
+	}{
+		{"lastjid", (*[4]byte)(unsafe.Pointer(&lastjid))[:]},
+		{"name", name},
+		{"path", path},
+		{"host.hostname", hostname},
+	} {
+		key, err := unix.ByteSliceFromString(param.name)
+		if err != nil {
+			return nil, err
+		}
+		iov = append(iov, iovec(key), iovec(param.value))
+	}
+	jid, _, errno := unix.Syscall(unix.SYS_JAIL_GET, uintptr(unsafe.Pointer(&iov[0])), uintptr(len(iov)), 0)
+	if errno != 0 {
+		return nil, errno
+	}
+	return &Jail{
+		JID:      int(jid),
+		Name:     unix.ByteSliceToString(name),
+		Path:     unix.ByteSliceToString(path),
+		Hostname: unix.ByteSliceToString(hostname),
+	}, nil
+}
+
+func iovec(b []byte) unix.Iovec {
+	iov := unix.Iovec{Base: &b[0]}
+	iov.SetLen(len(b))
+	return iov
+}
+
+func (c *client) Usage(name string) (map[string]uint64, error) {
+	return rctl.GetUsage(rctl.JailFilter(name))
+}
+
+func (c *client) Limits(name string) (map[string]uint64, error) {
+	return rctl.GetLimits(rctl.JailFilter(name))
+}
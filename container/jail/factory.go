@@ -0,0 +1,75 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package jail monitors the jails of FreeBSD hosts from their rctl resource
+// accounting.
+package jail
+
+import (
+	"path"
+	"strings"
+
+	"github.com/google/cadvisor/container"
+	info "github.com/google/cadvisor/info/v1"
+)
+
+// JailNamespace is the namespace under which the names of jails are unique.
+const JailNamespace = "jail"
+
+// The parent of the jails, which are named /jail/<name>.
+const jailsParent = "/jail"
+
+type jailFactory struct {
+	client             Client
+	machineInfoFactory info.MachineInfoFactory
+	// Reads the usage of the host, the root container.
+	hostStats       func() (*info.ContainerStats, error)
+	includedMetrics container.MetricSet
+}
+
+func (f *jailFactory) String() string {
+	return JailNamespace
+}
+
+func (f *jailFactory) NewContainerHandler(name string, metadataEnvAllowList []string, inHostNamespace bool) (container.ContainerHandler, error) {
+	if name == "/" {
+		return newHostHandler(f.client, f.machineInfoFactory, f.hostStats), nil
+	}
+	return newJailContainerHandler(f.client, name, jailName(name), f.machineInfoFactory, f.includedMetrics)
+}
+
+// jailName returns the name of the jail of a container.
+func jailName(name string) string {
+	return strings.TrimPrefix(name, jailsParent+"/")
+}
+
+// containerName returns the name of the container of a jail.
+func containerName(jail string) string {
+	return path.Join(jailsParent, jail)
+}
+
+// CanHandleAndAccept handles the root container and the jails, which have
+// no subcontainers.
+func (f *jailFactory) CanHandleAndAccept(name string) (bool, bool, error) {
+	if name == "/" {
+		return true, true, nil
+	}
+	jail := jailName(name)
+	ok := jail != name && jail != "" && !strings.Contains(jail, "/")
+	return ok, ok, nil
+}
+
+func (f *jailFactory) DebugInfo() map[string][]string {
+	return map[string][]string{}
+}
@@ -0,0 +1,195 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jail
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/google/cadvisor/container"
+	info "github.com/google/cadvisor/info/v1"
+)
+
+// Labels attached to jails.
+const (
+	PathLabel     = "io.cadvisor.jail.path"
+	HostnameLabel = "io.cadvisor.jail.hostname"
+	// The jail ID, which changes when the jail is restarted.
+	JIDLabel = "io.cadvisor.jail.jid"
+)
+
+// The period of the CPU quota of jails, whose pcpu limit is a percentage
+// of a CPU.
+const cpuPeriod = 100000
+
+type jailContainerHandler struct {
+	client             Client
+	name               string
+	machineInfoFactory info.MachineInfoFactory
+	includedMetrics    container.MetricSet
+	reference          info.ContainerReference
+	labels             map[string]string
+	creationTime       time.Time
+}
+
+var _ container.ContainerHandler = &jailContainerHandler{}
+
+func newJailContainerHandler(client Client, name, jailName string, machineInfoFactory info.MachineInfoFactory, includedMetrics container.MetricSet) (container.ContainerHandler, error) {
+	jail, err := findJail(client, jailName)
+	if err != nil {
+		return nil, err
+	}
+	usage, err := client.Usage(jailName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the resource usage of jail %q: %v", jailName, err)
+	}
+	labels := map[string]string{JIDLabel: strconv.Itoa(jail.JID)}
+	if jail.Path != "" {
+		labels[PathLabel] = jail.Path
+	}
+	if jail.Hostname != "" {
+		labels[HostnameLabel] = jail.Hostname
+	}
+	return &jailContainerHandler{
+		client:             client,
+		name:               jailName,
+		machineInfoFactory: machineInfoFactory,
+		includedMetrics:    includedMetrics,
+		reference: info.ContainerReference{
+			Id:        jailName,
+			Name:      name,
+			Aliases:   []string{jailName, strconv.Itoa(jail.JID)},
+			Namespace: JailNamespace,
+		},
+		labels: labels,
+		// The wall clock time is the number of seconds the jail has been
+		// running.
+		creationTime: time.Now().Add(-time.Duration(usage["wallclock"]) * time.Second).Truncate(time.Second),
+	}, nil
+}
+
+// findJail returns the running jail of a name.
+func findJail(client Client, name string) (*Jail, error) {
+	jails, err := client.Jails()
+	if err != nil {
+		return nil, err
+	}
+	for i := range jails {
+		if jails[i].Name == name {
+			return &jails[i], nil
+		}
+	}
+	return nil, fmt.Errorf("no jail %q", name)
+}
+
+func (h *jailContainerHandler) ContainerReference() (info.ContainerReference, error) {
+	return h.reference, nil
+}
+
+func (h *jailContainerHandler) GetSpec() (info.ContainerSpec, error) {
+	spec := info.ContainerSpec{
+		CreationTime: h.creationTime,
+		Labels:       h.labels,
+		HasCpu:       true,
+		HasMemory:    true,
+		HasProcesses: h.includedMetrics.Has(container.ProcessMetrics),
+	}
+	if machineInfo, err := h.machineInfoFactory.GetMachineInfo(); err == nil {
+		spec.Cpu.Limit = uint64(1024 * machineInfo.NumCores)
+		spec.Memory.Limit = machineInfo.MemoryCapacity
+	}
+	limits, err := h.client.Limits(h.name)
+	if err != nil {
+		return spec, fmt.Errorf("failed to get the limits of jail %q: %v", h.name, err)
+	}
+	if limit, ok := limits["memoryuse"]; ok {
+		spec.Memory.Limit = limit
+	}
+	if limit, ok := limits["swapuse"]; ok {
+		spec.Memory.SwapLimit = limit
+	}
+	if limit, ok := limits["pcpu"]; ok {
+		spec.Cpu.Quota = limit * cpuPeriod / 100
+		spec.Cpu.Period = cpuPeriod
+	}
+	if limit, ok := limits["maxproc"]; ok {
+		spec.Processes.Limit = limit
+	}
+	return spec, nil
+}
+
+func (h *jailContainerHandler) GetStats() (*info.ContainerStats, error) {
+	usage, err := h.client.Usage(h.name)
+	if err != nil {
+		return nil, err
+	}
+	return convertUsage(usage, h.includedMetrics), nil
+}
+
+// convertUsage returns the container stats of the rctl usage of a jail.
+func convertUsage(usage map[string]uint64, includedMetrics container.MetricSet) *info.ContainerStats {
+	stats := &info.ContainerStats{Timestamp: time.Now()}
+	// racct counts the CPU time in seconds, user and system time together.
+	stats.Cpu.Usage.Total = usage["cputime"] * uint64(time.Second)
+	// The resident memory of the processes of the jail.
+	stats.Memory.Usage = usage["memoryuse"]
+	stats.Memory.WorkingSet = usage["memoryuse"]
+	stats.Memory.RSS = usage["memoryuse"]
+	stats.Memory.Swap = usage["swapuse"]
+	if includedMetrics.Has(container.ProcessMetrics) {
+		stats.Processes.ProcessCount = usage["maxproc"]
+		stats.Processes.ThreadsCurrent = usage["nthr"]
+		stats.Processes.FdCount = usage["openfiles"]
+	}
+	return stats
+}
+
+func (h *jailContainerHandler) ListContainers(listType container.ListType) ([]info.ContainerReference, error) {
+	return nil, nil
+}
+
+func (h *jailContainerHandler) ListProcesses(listType container.ListType) ([]int, error) {
+	return nil, fmt.Errorf("the processes of jails aren't listed")
+}
+
+func (h *jailContainerHandler) GetCgroupPath(resource string) (string, error) {
+	return "", fmt.Errorf("jails have no cgroups")
+}
+
+func (h *jailContainerHandler) GetContainerLabels() map[string]string {
+	return h.labels
+}
+
+func (h *jailContainerHandler) GetContainerIPAddress() string {
+	return ""
+}
+
+func (h *jailContainerHandler) GetExitCode() (int, error) {
+	return -1, fmt.Errorf("jails have no exit code")
+}
+
+func (h *jailContainerHandler) Exists() bool {
+	_, err := findJail(h.client, h.name)
+	return err == nil
+}
+
+func (h *jailContainerHandler) Cleanup() {}
+
+func (h *jailContainerHandler) Start() {}
+
+func (h *jailContainerHandler) Type() container.ContainerType {
+	return container.ContainerTypeJail
+}
@@ -0,0 +1,182 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jail
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/google/cadvisor/container"
+	info "github.com/google/cadvisor/info/v1"
+)
+
+// fakeClient serves jails and their usage and limits, by name.
+type fakeClient struct {
+	lock   sync.Mutex
+	jails  []Jail
+	usage  map[string]map[string]uint64
+	limits map[string]map[string]uint64
+}
+
+func (c *fakeClient) setJails(jails []Jail) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.jails = jails
+}
+
+func (c *fakeClient) Jails() ([]Jail, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return append([]Jail(nil), c.jails...), nil
+}
+
+func (c *fakeClient) Usage(name string) (map[string]uint64, error) {
+	usage, ok := c.usage[name]
+	if !ok {
+		return nil, fmt.Errorf("no jail %q", name)
+	}
+	return usage, nil
+}
+
+func (c *fakeClient) Limits(name string) (map[string]uint64, error) {
+	return c.limits[name], nil
+}
+
+type fakeMachineInfoFactory struct{}
+
+func (fakeMachineInfoFactory) GetMachineInfo() (*info.MachineInfo, error) {
+	return &info.MachineInfo{NumCores: 4, MemoryCapacity: 16 << 30}, nil
+}
+
+func (fakeMachineInfoFactory) GetVersionInfo() (*info.VersionInfo, error) {
+	return &info.VersionInfo{}, nil
+}
+
+func newFakeClient() *fakeClient {
+	return &fakeClient{
+		jails: []Jail{{JID: 3, Name: "www", Path: "/usr/jails/www", Hostname: "www.example.org"}},
+		usage: map[string]map[string]uint64{"www": {
+			"cputime":   12,
+			"memoryuse": 1 << 20,
+			"swapuse":   4096,
+			"maxproc":   5,
+			"nthr":      9,
+			"openfiles": 40,
+			"wallclock": 3600,
+		}},
+		limits: map[string]map[string]uint64{"www": {
+			"memoryuse": 512 << 20,
+			"pcpu":      50,
+			"maxproc":   100,
+		}},
+	}
+}
+
+func TestCanHandleAndAccept(t *testing.T) {
+	f := &jailFactory{}
+	for name, expected := range map[string]bool{
+		"/":             true,
+		"/jail/www":     true,
+		"/jail/www.db":  true,
+		"/jail":         false,
+		"/jail/":        false,
+		"/jail/www/sub": false,
+		"/docker/www":   false,
+	} {
+		canHandle, canAccept, err := f.CanHandleAndAccept(name)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, canHandle, name)
+		assert.Equal(t, expected, canAccept, name)
+	}
+}
+
+func TestHandler(t *testing.T) {
+	client := newFakeClient()
+	f := &jailFactory{client: client, machineInfoFactory: fakeMachineInfoFactory{}, includedMetrics: container.MetricSet{container.ProcessMetrics: struct{}{}}}
+	handler, err := f.NewContainerHandler("/jail/www", nil, true)
+	require.NoError(t, err)
+
+	reference, err := handler.ContainerReference()
+	require.NoError(t, err)
+	assert.Equal(t, info.ContainerReference{Id: "www", Name: "/jail/www", Aliases: []string{"www", "3"}, Namespace: JailNamespace}, reference)
+
+	spec, err := handler.GetSpec()
+	require.NoError(t, err)
+	assert.WithinDuration(t, time.Now().Add(-time.Hour), spec.CreationTime, 2*time.Second)
+	assert.Equal(t, map[string]string{
+		JIDLabel:      "3",
+		PathLabel:     "/usr/jails/www",
+		HostnameLabel: "www.example.org",
+	}, spec.Labels)
+	assert.Equal(t, uint64(4096), spec.Cpu.Limit)
+	assert.Equal(t, uint64(50000), spec.Cpu.Quota)
+	assert.Equal(t, uint64(100000), spec.Cpu.Period)
+	assert.Equal(t, uint64(512<<20), spec.Memory.Limit)
+	assert.Equal(t, uint64(100), spec.Processes.Limit)
+	assert.True(t, spec.HasProcesses)
+
+	stats, err := handler.GetStats()
+	require.NoError(t, err)
+	assert.Equal(t, uint64(12*time.Second), stats.Cpu.Usage.Total)
+	assert.Equal(t, uint64(1<<20), stats.Memory.Usage)
+	assert.Equal(t, uint64(1<<20), stats.Memory.WorkingSet)
+	assert.Equal(t, uint64(4096), stats.Memory.Swap)
+	assert.Equal(t, info.ProcessStats{ProcessCount: 5, ThreadsCurrent: 9, FdCount: 40}, stats.Processes)
+
+	assert.True(t, handler.Exists())
+	client.setJails(nil)
+	assert.False(t, handler.Exists())
+}
+
+func TestHandlerWithoutLimits(t *testing.T) {
+	client := newFakeClient()
+	client.limits = nil
+	f := &jailFactory{client: client, machineInfoFactory: fakeMachineInfoFactory{}}
+	handler, err := f.NewContainerHandler("/jail/www", nil, true)
+	require.NoError(t, err)
+	spec, err := handler.GetSpec()
+	require.NoError(t, err)
+	assert.Equal(t, uint64(16<<30), spec.Memory.Limit)
+	assert.Zero(t, spec.Cpu.Quota)
+
+	stats, err := handler.GetStats()
+	require.NoError(t, err)
+	assert.Zero(t, stats.Processes.ProcessCount)
+}
+
+func TestNewHandlerOfMissingJail(t *testing.T) {
+	f := &jailFactory{client: newFakeClient(), machineInfoFactory: fakeMachineInfoFactory{}}
+	_, err := f.NewContainerHandler("/jail/db", nil, true)
+	assert.Error(t, err)
+}
+
+func TestHostListContainers(t *testing.T) {
+	client := newFakeClient()
+	client.jails = append(client.jails, Jail{JID: 4, Name: "db"})
+	f := &jailFactory{client: client, machineInfoFactory: fakeMachineInfoFactory{}}
+	handler, err := f.NewContainerHandler("/", nil, true)
+	require.NoError(t, err)
+	containers, err := handler.ListContainers(container.ListSelf)
+	require.NoError(t, err)
+	assert.Equal(t, []info.ContainerReference{
+		{Name: "/jail/www", Namespace: JailNamespace},
+		{Name: "/jail/db", Namespace: JailNamespace},
+	}, containers)
+}
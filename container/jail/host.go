@@ -0,0 +1,108 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jail
+
+import (
+	"fmt"
+
+	"github.com/google/cadvisor/container"
+	info "github.com/google/cadvisor/info/v1"
+)
+
+// hostHandler handles the root container, the host, whose subcontainers are
+// its jails.
+type hostHandler struct {
+	client             Client
+	machineInfoFactory info.MachineInfoFactory
+	hostStats          func() (*info.ContainerStats, error)
+}
+
+var _ container.ContainerHandler = &hostHandler{}
+
+func newHostHandler(client Client, machineInfoFactory info.MachineInfoFactory, hostStats func() (*info.ContainerStats, error)) container.ContainerHandler {
+	return &hostHandler{
+		client:             client,
+		machineInfoFactory: machineInfoFactory,
+		hostStats:          hostStats,
+	}
+}
+
+func (h *hostHandler) ContainerReference() (info.ContainerReference, error) {
+	return info.ContainerReference{Name: "/"}, nil
+}
+
+func (h *hostHandler) GetSpec() (info.ContainerSpec, error) {
+	spec := info.ContainerSpec{
+		HasCpu:    true,
+		HasMemory: true,
+	}
+	if machineInfo, err := h.machineInfoFactory.GetMachineInfo(); err == nil {
+		spec.Cpu.Limit = uint64(1024 * machineInfo.NumCores)
+		spec.Memory.Limit = machineInfo.MemoryCapacity
+	}
+	return spec, nil
+}
+
+func (h *hostHandler) GetStats() (*info.ContainerStats, error) {
+	return h.hostStats()
+}
+
+func (h *hostHandler) ListContainers(listType container.ListType) ([]info.ContainerReference, error) {
+	jails, err := h.client.Jails()
+	if err != nil {
+		return nil, err
+	}
+	var containers []info.ContainerReference
+	for _, jail := range jails {
+		containers = append(containers, info.ContainerReference{
+			Name:      containerName(jail.Name),
+			Namespace: JailNamespace,
+		})
+	}
+	return containers, nil
+}
+
+func (h *hostHandler) ListProcesses(listType container.ListType) ([]int, error) {
+	return nil, fmt.Errorf("the processes of the host aren't listed")
+}
+
+func (h *hostHandler) GetCgroupPath(resource string) (string, error) {
+	return "", fmt.Errorf("freebsd hosts have no cgroups")
+}
+
+func (h *hostHandler) GetContainerLabels() map[string]string {
+	return map[string]string{}
+}
+
+func (h *hostHandler) GetContainerIPAddress() string {
+	// The IP address of the host, like the raw root container.
+	return "127.0.0.1"
+}
+
+func (h *hostHandler) GetExitCode() (int, error) {
+	return -1, fmt.Errorf("the host has no exit code")
+}
+
+func (h *hostHandler) Exists() bool {
+	return true
+}
+
+func (h *hostHandler) Cleanup() {}
+
+func (h *hostHandler) Start() {}
+
+func (h *hostHandler) Type() container.ContainerType {
+	return container.ContainerTypeRaw
+}
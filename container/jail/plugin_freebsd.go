@@ -0,0 +1,127 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build freebsd
+
+package jail
+
+import (
+	"encoding/binary"
+	"fmt"
+	"time"
+	"unsafe"
+
+	"golang.org/x/sys/unix"
+
+	"github.com/google/cadvisor/container"
+	"github.com/google/cadvisor/fs"
+	info "github.com/google/cadvisor/info/v1"
+	"github.com/google/cadvisor/watcher"
+
+	"k8s.io/klog/v2"
+)
+
+// NewPlugin returns an implementation of container.Plugin suitable for passing to container.RegisterPlugin()
+func NewPlugin() container.Plugin {
+	return &plugin{}
+}
+
+type plugin struct{}
+
+func (p *plugin) InitializeFSContext(context *fs.Context) error {
+	return nil
+}
+
+func (p *plugin) Register(factory info.MachineInfoFactory, fsInfo fs.FsInfo, includedMetrics container.MetricSet) (watcher.ContainerWatcher, error) {
+	client, err := Register(factory, fsInfo, includedMetrics)
+	if err != nil {
+		return nil, err
+	}
+	return newJailWatcher(client, *pollInterval), nil
+}
+
+// Register registers the factory of the host, the root container, and of
+// its jails.
+func Register(factory info.MachineInfoFactory, fsInfo fs.FsInfo, includedMetrics container.MetricSet) (Client, error) {
+	client, err := NewClient()
+	if err != nil {
+		return nil, err
+	}
+
+	klog.V(1).Infof("Registering jail factory")
+	f := &jailFactory{
+		client:             client,
+		machineInfoFactory: factory,
+		hostStats:          readHostStats,
+		includedMetrics:    includedMetrics,
+	}
+	container.RegisterContainerHandlerFactory(f, []watcher.ContainerWatchSource{watcher.Raw})
+	return client, nil
+}
+
+// The states of kern.cp_time.
+const (
+	cpUser = iota
+	cpNice
+	cpSys
+	cpIntr
+	cpIdle
+	cpuStates
+)
+
+// readHostStats returns the CPU and memory usage of the host.
+func readHostStats() (*info.ContainerStats, error) {
+	clock, err := unix.SysctlClockinfo("kern.clockrate")
+	if err != nil {
+		return nil, err
+	}
+	// The ticks of the statistics clock spent in each state, longs.
+	raw, err := unix.SysctlRaw("kern.cp_time")
+	if err != nil {
+		return nil, err
+	}
+	longSize := int(unsafe.Sizeof(uintptr(0)))
+	if len(raw) < cpuStates*longSize {
+		return nil, fmt.Errorf("kern.cp_time has %d bytes", len(raw))
+	}
+	ticks := make([]uint64, cpuStates)
+	for i := range ticks {
+		if longSize == 8 {
+			ticks[i] = binary.NativeEndian.Uint64(raw[i*8:])
+		} else {
+			ticks[i] = uint64(binary.NativeEndian.Uint32(raw[i*4:]))
+		}
+	}
+	tick := uint64(time.Second) / uint64(clock.Stathz)
+
+	pageSize, err := unix.SysctlUint32("hw.pagesize")
+	if err != nil {
+		return nil, err
+	}
+	pages := map[string]uint32{}
+	for _, name := range []string{"v_page_count", "v_free_count", "v_inactive_count"} {
+		if pages[name], err = unix.SysctlUint32("vm.stats.vm." + name); err != nil {
+			return nil, err
+		}
+	}
+
+	stats := &info.ContainerStats{Timestamp: time.Now()}
+	stats.Cpu.Usage.User = (ticks[cpUser] + ticks[cpNice]) * tick
+	stats.Cpu.Usage.System = (ticks[cpSys] + ticks[cpIntr]) * tick
+	stats.Cpu.Usage.Total = stats.Cpu.Usage.User + stats.Cpu.Usage.System
+	stats.Memory.Usage = uint64(pages["v_page_count"]-pages["v_free_count"]) * uint64(pageSize)
+	// Inactive pages are reclaimed first under memory pressure.
+	stats.Memory.WorkingSet = stats.Memory.Usage - uint64(pages["v_inactive_count"])*uint64(pageSize)
+	return stats, nil
+}
@@ -0,0 +1,116 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jail
+
+import (
+	"flag"
+	"time"
+
+	"github.com/google/cadvisor/watcher"
+
+	"k8s.io/klog/v2"
+)
+
+var pollInterval = flag.Duration("jail_poll_interval", 5*time.Second, "Interval between listings of the jails of the host, to detect started and removed jails")
+
+// jailWatcher detects the jails started and removed by listing the jails of
+// the host, as the kernel has no events for them.
+type jailWatcher struct {
+	client   Client
+	interval time.Duration
+	stop     chan struct{}
+	done     chan struct{}
+}
+
+var _ watcher.ContainerWatcher = &jailWatcher{}
+
+func newJailWatcher(client Client, interval time.Duration) *jailWatcher {
+	return &jailWatcher{
+		client:   client,
+		interval: interval,
+	}
+}
+
+func (w *jailWatcher) Start(events chan watcher.ContainerEvent) error {
+	known, err := w.running()
+	if err != nil {
+		return err
+	}
+	w.stop = make(chan struct{})
+	w.done = make(chan struct{})
+	go func() {
+		defer close(w.done)
+		ticker := time.NewTicker(w.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-w.stop:
+				return
+			case <-ticker.C:
+			}
+			running, err := w.running()
+			if err != nil {
+				klog.V(4).Infof("Failed to list the jails: %v", err)
+				continue
+			}
+			// A jail restarted since the last listing has a new JID, and
+			// is removed before being added again.
+			for name, jid := range known {
+				if current, ok := running[name]; (!ok || current != jid) && !w.send(events, watcher.ContainerDelete, name) {
+					return
+				}
+			}
+			for name, jid := range running {
+				if previous, ok := known[name]; (!ok || previous != jid) && !w.send(events, watcher.ContainerAdd, name) {
+					return
+				}
+			}
+			known = running
+		}
+	}()
+	return nil
+}
+
+func (w *jailWatcher) Stop() error {
+	if w.stop != nil {
+		close(w.stop)
+		<-w.done
+		w.stop = nil
+	}
+	return nil
+}
+
+// send sends an event about a jail, unless the watcher is stopped first.
+func (w *jailWatcher) send(events chan watcher.ContainerEvent, eventType watcher.ContainerEventType, name string) bool {
+	select {
+	case events <- watcher.ContainerEvent{EventType: eventType, Name: containerName(name), WatchSource: watcher.Raw}:
+		return true
+	case <-w.stop:
+		return false
+	}
+}
+
+// running returns the JIDs of the running jails, by name.
+func (w *jailWatcher) running() (map[string]int, error) {
+	jails, err := w.client.Jails()
+	if err != nil {
+		return nil, err
+	}
+	running := make(map[string]int, len(jails))
+	for _, jail := range jails {
+		running[jail.Name] = jail.JID
+	}
+	return running, nil
+}
@@ -0,0 +1,63 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jail
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/google/cadvisor/watcher"
+)
+
+func TestWatcher(t *testing.T) {
+	client := &fakeClient{jails: []Jail{{JID: 1, Name: "www"}}}
+	w := newJailWatcher(client, 10*time.Millisecond)
+	events := make(chan watcher.ContainerEvent)
+	require.NoError(t, w.Start(events))
+	defer w.Stop()
+
+	next := func() watcher.ContainerEvent {
+		select {
+		case ev := <-events:
+			return ev
+		case <-time.After(5 * time.Second):
+			t.Fatal("no container event")
+			return watcher.ContainerEvent{}
+		}
+	}
+
+	client.setJails([]Jail{{JID: 1, Name: "www"}, {JID: 2, Name: "db"}})
+	assert.Equal(t, watcher.ContainerEvent{EventType: watcher.ContainerAdd, Name: "/jail/db", WatchSource: watcher.Raw}, next())
+
+	// A restarted jail is removed and added again.
+	client.setJails([]Jail{{JID: 3, Name: "www"}, {JID: 2, Name: "db"}})
+	assert.Equal(t, watcher.ContainerEvent{EventType: watcher.ContainerDelete, Name: "/jail/www", WatchSource: watcher.Raw}, next())
+	assert.Equal(t, watcher.ContainerEvent{EventType: watcher.ContainerAdd, Name: "/jail/www", WatchSource: watcher.Raw}, next())
+
+	client.setJails([]Jail{{JID: 3, Name: "www"}})
+	assert.Equal(t, watcher.ContainerEvent{EventType: watcher.ContainerDelete, Name: "/jail/db", WatchSource: watcher.Raw}, next())
+}
+
+func TestWatcherStopsWhileSending(t *testing.T) {
+	client := &fakeClient{}
+	w := newJailWatcher(client, time.Millisecond)
+	require.NoError(t, w.Start(make(chan watcher.ContainerEvent)))
+	client.setJails([]Jail{{JID: 1, Name: "www"}})
+	time.Sleep(20 * time.Millisecond)
+	assert.NoError(t, w.Stop())
+}
@@ -0,0 +1,206 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kata
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+)
+
+const (
+	// shimMonitorSocket is the name of the kata shim management socket
+	// inside the per-sandbox runtime directory.
+	shimMonitorSocket = "shim-monitor.sock"
+
+	shimMetricsURL = "http://shim/metrics"
+	shimTimeout    = 5 * time.Second
+)
+
+// guestStats is the workload usage as seen from inside the guest VM.
+type guestStats struct {
+	// Cumulative CPU time spent inside the guest, in seconds.
+	CPUUserSeconds   float64
+	CPUSystemSeconds float64
+
+	// Guest memory in bytes.
+	MemoryTotal     uint64
+	MemoryAvailable uint64
+}
+
+// ShimClient talks to the management API of a kata shim.
+type ShimClient interface {
+	// GuestStats returns the current guest usage of the given sandbox.
+	GuestStats(sandboxID string) (*guestStats, error)
+	// HasSandbox returns whether a shim for the sandbox is running.
+	HasSandbox(sandboxID string) bool
+}
+
+type shimClient struct {
+	runtimeDir string
+
+	// The HTTP clients of the sockets of the shims, reused across requests
+	// to keep their connections alive.
+	lock    sync.Mutex
+	clients map[string]*http.Client
+}
+
+// NewShimClient returns a ShimClient for shims whose sockets live in
+// runtimeDir/<sandbox id>/.
+func NewShimClient(runtimeDir string) ShimClient {
+	return &shimClient{runtimeDir: runtimeDir, clients: map[string]*http.Client{}}
+}
+
+func (c *shimClient) socketPath(sandboxID string) string {
+	return filepath.Join(c.runtimeDir, sandboxID, shimMonitorSocket)
+}
+
+func (c *shimClient) HasSandbox(sandboxID string) bool {
+	socket := c.socketPath(sandboxID)
+	if pathExists(socket) {
+		return true
+	}
+	c.forget(socket)
+	return false
+}
+
+// client returns the HTTP client of the shim listening on socket.
+func (c *shimClient) client(socket string) *http.Client {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	client, ok := c.clients[socket]
+	if !ok {
+		client = &http.Client{
+			Timeout: shimTimeout,
+			Transport: &http.Transport{
+				DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+					var d net.Dialer
+					return d.DialContext(ctx, "unix", socket)
+				},
+				MaxIdleConns:    1,
+				IdleConnTimeout: time.Minute,
+			},
+		}
+		c.clients[socket] = client
+	}
+	return client
+}
+
+// forget closes the connections to the shim of a sandbox that is gone.
+func (c *shimClient) forget(socket string) {
+	c.lock.Lock()
+	client, ok := c.clients[socket]
+	delete(c.clients, socket)
+	c.lock.Unlock()
+	if ok {
+		client.CloseIdleConnections()
+	}
+}
+
+func (c *shimClient) GuestStats(sandboxID string) (*guestStats, error) {
+	if !c.HasSandbox(sandboxID) {
+		return nil, fmt.Errorf("no kata shim for sandbox %q", sandboxID)
+	}
+	resp, err := c.client(c.socketPath(sandboxID)).Get(shimMetricsURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query kata shim of sandbox %q: %v", sandboxID, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("kata shim of sandbox %q returned %s", sandboxID, resp.Status)
+	}
+	return parseGuestStats(resp.Body, expfmt.ResponseFormat(resp.Header))
+}
+
+// parseGuestStats extracts the kata_guest_* families from the shim's
+// Prometheus output.
+func parseGuestStats(r io.Reader, format expfmt.Format) (*guestStats, error) {
+	dec := expfmt.NewDecoder(r, format)
+	stats := &guestStats{}
+	for {
+		var family dto.MetricFamily
+		if err := dec.Decode(&family); err != nil {
+			if err == io.EOF {
+				break
+			}
+			return nil, fmt.Errorf("failed to decode kata shim metrics: %v", err)
+		}
+		switch family.GetName() {
+		case "kata_guest_cpu_time":
+			for _, m := range family.GetMetric() {
+				labels := labelMap(m)
+				if cpu := labels["cpu"]; cpu != "cpu" && cpu != "total" {
+					continue
+				}
+				switch labels["item"] {
+				case "user":
+					stats.CPUUserSeconds = metricValue(m)
+				case "system":
+					stats.CPUSystemSeconds = metricValue(m)
+				}
+			}
+		case "kata_guest_meminfo":
+			for _, m := range family.GetMetric() {
+				switch normalizeItem(labelMap(m)["item"]) {
+				case "memtotal":
+					stats.MemoryTotal = uint64(metricValue(m))
+				case "memavailable":
+					stats.MemoryAvailable = uint64(metricValue(m))
+				}
+			}
+		}
+	}
+	return stats, nil
+}
+
+func labelMap(m *dto.Metric) map[string]string {
+	labels := make(map[string]string, len(m.GetLabel()))
+	for _, l := range m.GetLabel() {
+		labels[l.GetName()] = l.GetValue()
+	}
+	return labels
+}
+
+func metricValue(m *dto.Metric) float64 {
+	switch {
+	case m.Gauge != nil:
+		return m.GetGauge().GetValue()
+	case m.Counter != nil:
+		return m.GetCounter().GetValue()
+	case m.Untyped != nil:
+		return m.GetUntyped().GetValue()
+	}
+	return 0
+}
+
+// normalizeItem maps both "MemTotal" and "mem_total" style items to "memtotal".
+func normalizeItem(item string) string {
+	return strings.ToLower(strings.ReplaceAll(item, "_", ""))
+}
+
+func pathExists(p string) bool {
+	_, err := os.Stat(p)
+	return err == nil
+}
@@ -0,0 +1,96 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package kata
+
+import (
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/prometheus/common/expfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const shimMetrics = `# HELP kata_guest_cpu_time Guest CPU stat.
+# TYPE kata_guest_cpu_time gauge
+kata_guest_cpu_time{cpu="cpu",item="user"} 12.5
+kata_guest_cpu_time{cpu="cpu",item="system"} 3.25
+kata_guest_cpu_time{cpu="cpu0",item="user"} 6
+# HELP kata_guest_meminfo Statistics about memory usage in the system.
+# TYPE kata_guest_meminfo gauge
+kata_guest_meminfo{item="mem_total"} 2.147483648e+09
+kata_guest_meminfo{item="mem_available"} 1.073741824e+09
+kata_guest_meminfo{item="mem_free"} 5.36870912e+08
+`
+
+func TestParseGuestStats(t *testing.T) {
+	stats, err := parseGuestStats(strings.NewReader(shimMetrics), expfmt.NewFormat(expfmt.TypeTextPlain))
+	assert.NoError(t, err)
+	assert.Equal(t, &guestStats{
+		CPUUserSeconds:   12.5,
+		CPUSystemSeconds: 3.25,
+		MemoryTotal:      2147483648,
+		MemoryAvailable:  1073741824,
+	}, stats)
+}
+
+func TestParseGuestStatsMalformed(t *testing.T) {
+	_, err := parseGuestStats(strings.NewReader("kata_guest_meminfo{item=}"), expfmt.NewFormat(expfmt.TypeTextPlain))
+	assert.Error(t, err)
+}
+
+func TestShimClientReusesConnections(t *testing.T) {
+	runtimeDir := t.TempDir()
+	require.NoError(t, os.Mkdir(filepath.Join(runtimeDir, "sandbox"), 0o755))
+	socket := filepath.Join(runtimeDir, "sandbox", shimMonitorSocket)
+	l, err := net.Listen("unix", socket)
+	require.NoError(t, err)
+	var conns atomic.Int32
+	server := &httptest.Server{
+		Listener: l,
+		Config: &http.Server{
+			Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				io.WriteString(w, shimMetrics)
+			}),
+			ConnState: func(_ net.Conn, state http.ConnState) {
+				if state == http.StateNew {
+					conns.Add(1)
+				}
+			},
+		},
+	}
+	server.Start()
+	defer server.Close()
+
+	c := NewShimClient(runtimeDir).(*shimClient)
+	for i := 0; i < 3; i++ {
+		stats, err := c.GuestStats("sandbox")
+		require.NoError(t, err)
+		assert.Equal(t, 12.5, stats.CPUUserSeconds)
+	}
+	assert.Equal(t, int32(1), conns.Load())
+
+	// The client of a sandbox is dropped once its shim is gone.
+	require.NoError(t, os.Remove(socket))
+	_, err = c.GuestStats("sandbox")
+	assert.Error(t, err)
+	assert.Empty(t, c.clients)
+}
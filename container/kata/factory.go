@@ -0,0 +1,125 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package kata
+
+import (
+	"flag"
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/google/cadvisor/container"
+	"github.com/google/cadvisor/container/libcontainer"
+	"github.com/google/cadvisor/fs"
+	info "github.com/google/cadvisor/info/v1"
+	"github.com/google/cadvisor/watcher"
+
+	"k8s.io/klog/v2"
+)
+
+var ArgKataRuntimeDir = flag.String("kata_runtime_dir", "/run/vc/sbs", "Directory holding the per-sandbox kata shim sockets")
+
+// KataNamespace is the namespace under which kata aliases are unique.
+const KataNamespace = "kata"
+
+const (
+	// sandboxCgroupPrefix prefixes the host cgroup kata creates for each sandbox.
+	sandboxCgroupPrefix = "kata_"
+	// overheadCgroupParent holds the VMM and shim threads when kata runs
+	// with sandbox_cgroup_only=false.
+	overheadCgroupParent = "/kata_overhead"
+)
+
+var sandboxIDRegexp = regexp.MustCompile(`^[a-z0-9]{64}$`)
+
+type kataFactory struct {
+	machineInfoFactory info.MachineInfoFactory
+	client             ShimClient
+	// Information about the mounted cgroup subsystems.
+	cgroupSubsystems map[string]string
+	// Information about mounted filesystems.
+	fsInfo          fs.FsInfo
+	includedMetrics container.MetricSet
+}
+
+func (f *kataFactory) String() string {
+	return KataNamespace
+}
+
+func (f *kataFactory) NewContainerHandler(name string, metadataEnvAllowList []string, inHostNamespace bool) (container.ContainerHandler, error) {
+	return newKataContainerHandler(f.client, name, f.machineInfoFactory, f.fsInfo, f.cgroupSubsystems, inHostNamespace, f.includedMetrics)
+}
+
+// parseCgroupName returns the sandbox id of a kata sandbox or overhead cgroup
+// and whether the cgroup is the overhead one.
+func parseCgroupName(name string) (sandboxID string, overhead bool, ok bool) {
+	base := strings.TrimSuffix(path.Base(name), ".scope")
+	if path.Dir(name) == overheadCgroupParent {
+		return base, true, sandboxIDRegexp.MatchString(base)
+	}
+	if !strings.HasPrefix(base, sandboxCgroupPrefix) {
+		return "", false, false
+	}
+	sandboxID = strings.TrimPrefix(base, sandboxCgroupPrefix)
+	return sandboxID, false, sandboxIDRegexp.MatchString(sandboxID)
+}
+
+// overheadCgroupName returns the name of the overhead cgroup for a sandbox.
+func overheadCgroupName(sandboxID string) string {
+	return path.Join(overheadCgroupParent, sandboxID)
+}
+
+func (f *kataFactory) CanHandleAndAccept(name string) (bool, bool, error) {
+	sandboxID, _, ok := parseCgroupName(name)
+	if !ok {
+		return false, false, nil
+	}
+	// Only claim cgroups whose shim is still around, otherwise leave them
+	// to the raw factory.
+	if !f.client.HasSandbox(sandboxID) {
+		return false, false, nil
+	}
+	return true, true, nil
+}
+
+func (f *kataFactory) DebugInfo() map[string][]string {
+	return map[string][]string{}
+}
+
+// Register root container before running this function!
+func Register(factory info.MachineInfoFactory, fsInfo fs.FsInfo, includedMetrics container.MetricSet) error {
+	if !pathExists(*ArgKataRuntimeDir) {
+		return fmt.Errorf("kata runtime directory %q not found", *ArgKataRuntimeDir)
+	}
+
+	cgroupSubsystems, err := libcontainer.GetCgroupSubsystems(includedMetrics)
+	if err != nil {
+		return fmt.Errorf("failed to get cgroup subsystems: %v", err)
+	}
+
+	klog.V(1).Infof("Registering kata factory")
+	f := &kataFactory{
+		machineInfoFactory: factory,
+		client:             NewShimClient(*ArgKataRuntimeDir),
+		cgroupSubsystems:   cgroupSubsystems,
+		fsInfo:             fsInfo,
+		includedMetrics:    includedMetrics,
+	}
+	container.RegisterContainerHandlerFactory(f, []watcher.ContainerWatchSource{watcher.Raw})
+	return nil
+}
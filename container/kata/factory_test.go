@@ -0,0 +1,70 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//go:build linux
+
+package kata
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testSandboxID = "81e5c2990803c383229c9680ce964738d5e566d97f5bd436ac34808d2ec75d5f"
+
+type fakeShimClient struct {
+	sandboxes map[string]*guestStats
+}
+
+func (c *fakeShimClient) GuestStats(sandboxID string) (*guestStats, error) {
+	return c.sandboxes[sandboxID], nil
+}
+
+func (c *fakeShimClient) HasSandbox(sandboxID string) bool {
+	_, ok := c.sandboxes[sandboxID]
+	return ok
+}
+
+func TestCanHandleAndAccept(t *testing.T) {
+	as := assert.New(t)
+	f := &kataFactory{
+		client: &fakeShimClient{sandboxes: map[string]*guestStats{testSandboxID: {}}},
+	}
+	for k, v := range map[string]bool{
+		"/kubepods/burstable/pod068e8fa0-9213-11e7-a01f-507b9d4141fa/kata_" + testSandboxID:           true,
+		"/kubepods.slice/kubepods-pod068e8fa0.slice/kata_" + testSandboxID + ".scope":                 true,
+		"/kata_overhead/" + testSandboxID:                                                             true,
+		"/kubepods/burstable/pod068e8fa0-9213-11e7-a01f-507b9d4141fa/" + testSandboxID:                false,
+		"/kubepods/burstable/pod068e8fa0-9213-11e7-a01f-507b9d4141fa/kata_990803c383229c9680ce96473":  false,
+		"/kubepods/burstable/pod068e8fa0-9213-11e7-a01f-507b9d4141fa/kata_" + testSandboxID[1:] + "0": false,
+	} {
+		b1, b2, err := f.CanHandleAndAccept(k)
+		as.Nil(err)
+		as.Equal(v, b1, k)
+		as.Equal(v, b2, k)
+	}
+}
+
+func TestParseCgroupName(t *testing.T) {
+	id, overhead, ok := parseCgroupName("/kata_overhead/" + testSandboxID)
+	assert.True(t, ok)
+	assert.True(t, overhead)
+	assert.Equal(t, testSandboxID, id)
+
+	id, overhead, ok = parseCgroupName("/kubepods/pod1/kata_" + testSandboxID)
+	assert.True(t, ok)
+	assert.False(t, overhead)
+	assert.Equal(t, testSandboxID, id)
+	assert.Equal(t, "/kata_overhead/"+testSandboxID, overheadCgroupName(id))
+}
@@ -0,0 +1,264 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+// Handler for Kata Containers sandboxes.
+package kata
+
+import (
+	"fmt"
+	"time"
+
+	"k8s.io/klog/v2"
+
+	"github.com/google/cadvisor/container"
+	"github.com/google/cadvisor/container/common"
+	containerlibcontainer "github.com/google/cadvisor/container/libcontainer"
+	"github.com/google/cadvisor/fs"
+	info "github.com/google/cadvisor/info/v1"
+)
+
+const (
+	// Labels attached to kata sandbox and overhead containers.
+	SandboxIDLabel  = "io.katacontainers.sandbox.id"
+	CgroupKindLabel = "io.katacontainers.cgroup.kind"
+
+	cgroupKindSandbox  = "sandbox"
+	cgroupKindOverhead = "overhead"
+)
+
+// Custom metrics reported on the sandbox container. The guest metrics come
+// from the kata shim and cover what runs inside the VM; the overhead metrics
+// cover the VMM and shim threads that kata accounts outside the pod cgroup.
+const (
+	guestCPUUserMetric        = "kata_guest_cpu_user_seconds"
+	guestCPUSystemMetric      = "kata_guest_cpu_system_seconds"
+	guestMemoryUsageMetric    = "kata_guest_memory_usage_bytes"
+	guestMemoryTotalMetric    = "kata_guest_memory_total_bytes"
+	overheadCPUUsageMetric    = "kata_overhead_cpu_usage_seconds"
+	overheadMemoryUsageMetric = "kata_overhead_memory_usage_bytes"
+)
+
+var sandboxMetricSpecs = []info.MetricSpec{
+	{Name: guestCPUUserMetric, Type: info.MetricCumulative, Format: info.FloatType, Units: "seconds"},
+	{Name: guestCPUSystemMetric, Type: info.MetricCumulative, Format: info.FloatType, Units: "seconds"},
+	{Name: guestMemoryUsageMetric, Type: info.MetricGauge, Format: info.IntType, Units: "bytes"},
+	{Name: guestMemoryTotalMetric, Type: info.MetricGauge, Format: info.IntType, Units: "bytes"},
+	{Name: overheadCPUUsageMetric, Type: info.MetricCumulative, Format: info.FloatType, Units: "seconds"},
+	{Name: overheadMemoryUsageMetric, Type: info.MetricGauge, Format: info.IntType, Units: "bytes"},
+}
+
+type kataContainerHandler struct {
+	client    ShimClient
+	sandboxID string
+	overhead  bool
+
+	machineInfoFactory info.MachineInfoFactory
+
+	// Absolute path to the cgroup hierarchies of this container.
+	// (e.g.: "cpu" -> "/sys/fs/cgroup/cpu/test")
+	cgroupPaths map[string]string
+
+	labels          map[string]string
+	includedMetrics container.MetricSet
+	reference       info.ContainerReference
+
+	libcontainerHandler *containerlibcontainer.Handler
+
+	// Handler of the overhead cgroup. Only set for sandbox containers of
+	// kata runtimes configured with sandbox_cgroup_only=false.
+	overheadHandler *containerlibcontainer.Handler
+}
+
+var _ container.ContainerHandler = &kataContainerHandler{}
+
+// newKataContainerHandler returns a new container.ContainerHandler
+func newKataContainerHandler(
+	client ShimClient,
+	name string,
+	machineInfoFactory info.MachineInfoFactory,
+	fsInfo fs.FsInfo,
+	cgroupSubsystems map[string]string,
+	inHostNamespace bool,
+	includedMetrics container.MetricSet,
+) (container.ContainerHandler, error) {
+	sandboxID, overhead, ok := parseCgroupName(name)
+	if !ok {
+		return nil, fmt.Errorf("%q is not a kata sandbox cgroup", name)
+	}
+
+	rootFs := "/"
+	if !inHostNamespace {
+		rootFs = "/rootfs"
+	}
+
+	cgroupPaths := common.MakeCgroupPaths(cgroupSubsystems, name)
+	cgroupManager, err := containerlibcontainer.NewCgroupManager(name, cgroupPaths)
+	if err != nil {
+		return nil, err
+	}
+
+	// The VMM runs in the pod network namespace, so any pid of the sandbox
+	// cgroup gives us the pod network stats.
+	pid := 0
+	if pids, err := cgroupManager.GetPids(); err == nil && len(pids) > 0 {
+		pid = pids[0]
+	}
+
+	kind := cgroupKindSandbox
+	if overhead {
+		kind = cgroupKindOverhead
+	}
+	handler := &kataContainerHandler{
+		client:             client,
+		sandboxID:          sandboxID,
+		overhead:           overhead,
+		machineInfoFactory: machineInfoFactory,
+		cgroupPaths:        cgroupPaths,
+		labels: map[string]string{
+			SandboxIDLabel:  sandboxID,
+			CgroupKindLabel: kind,
+		},
+		includedMetrics: includedMetrics,
+		reference: info.ContainerReference{
+			Id:        sandboxID,
+			Name:      name,
+			Aliases:   []string{sandboxID},
+			Namespace: KataNamespace,
+		},
+		libcontainerHandler: containerlibcontainer.NewHandler(cgroupManager, rootFs, pid, includedMetrics),
+	}
+	if overhead {
+		// Aliases must be unique in the namespace; the sandbox owns the bare id.
+		handler.reference.Aliases = []string{cgroupKindOverhead + "-" + sandboxID}
+		return handler, nil
+	}
+
+	overheadName := overheadCgroupName(sandboxID)
+	overheadPaths := common.MakeCgroupPaths(cgroupSubsystems, overheadName)
+	if common.CgroupExists(overheadPaths) {
+		overheadManager, err := containerlibcontainer.NewCgroupManager(overheadName, overheadPaths)
+		if err != nil {
+			return nil, err
+		}
+		handler.overheadHandler = containerlibcontainer.NewHandler(overheadManager, rootFs, 0, container.MetricSet{
+			container.CpuUsageMetrics:    struct{}{},
+			container.MemoryUsageMetrics: struct{}{},
+		})
+	}
+	return handler, nil
+}
+
+func (h *kataContainerHandler) ContainerReference() (info.ContainerReference, error) {
+	return h.reference, nil
+}
+
+func (h *kataContainerHandler) GetSpec() (info.ContainerSpec, error) {
+	hasNetwork := !h.overhead && h.includedMetrics.Has(container.NetworkUsageMetrics)
+	spec, err := common.GetSpec(h.cgroupPaths, h.machineInfoFactory, hasNetwork, false)
+	spec.Labels = h.labels
+	if !h.overhead {
+		spec.HasCustomMetrics = true
+		spec.CustomMetrics = sandboxMetricSpecs
+	}
+	return spec, err
+}
+
+func (h *kataContainerHandler) GetStats() (*info.ContainerStats, error) {
+	stats, err := h.libcontainerHandler.GetStats()
+	if err != nil || h.overhead {
+		return stats, err
+	}
+
+	now := time.Now()
+	customMetrics := map[string][]info.MetricVal{}
+	guest, err := h.client.GuestStats(h.sandboxID)
+	if err != nil {
+		// The sandbox cgroup stats are still valid without the guest view.
+		klog.V(4).Infof("Failed to get guest stats of kata sandbox %q: %v", h.sandboxID, err)
+	} else {
+		customMetrics[guestCPUUserMetric] = []info.MetricVal{{Timestamp: now, FloatValue: guest.CPUUserSeconds}}
+		customMetrics[guestCPUSystemMetric] = []info.MetricVal{{Timestamp: now, FloatValue: guest.CPUSystemSeconds}}
+		customMetrics[guestMemoryTotalMetric] = []info.MetricVal{{Timestamp: now, IntValue: int64(guest.MemoryTotal)}}
+		if guest.MemoryTotal >= guest.MemoryAvailable {
+			customMetrics[guestMemoryUsageMetric] = []info.MetricVal{{Timestamp: now, IntValue: int64(guest.MemoryTotal - guest.MemoryAvailable)}}
+		}
+	}
+
+	if h.overheadHandler != nil {
+		overheadStats, err := h.overheadHandler.GetStats()
+		if err != nil {
+			klog.V(4).Infof("Failed to get overhead stats of kata sandbox %q: %v", h.sandboxID, err)
+		} else {
+			customMetrics[overheadCPUUsageMetric] = []info.MetricVal{{Timestamp: now, FloatValue: float64(overheadStats.Cpu.Usage.Total) / float64(time.Second)}}
+			customMetrics[overheadMemoryUsageMetric] = []info.MetricVal{{Timestamp: now, IntValue: int64(overheadStats.Memory.Usage)}}
+		}
+	}
+	if len(customMetrics) > 0 {
+		stats.CustomMetrics = customMetrics
+	}
+	return stats, nil
+}
+
+func (h *kataContainerHandler) ListContainers(listType container.ListType) ([]info.ContainerReference, error) {
+	// The workload containers live inside the guest and have no host cgroup.
+	return []info.ContainerReference{}, nil
+}
+
+func (h *kataContainerHandler) ListProcesses(listType container.ListType) ([]int, error) {
+	return h.libcontainerHandler.GetProcesses()
+}
+
+func (h *kataContainerHandler) GetCgroupPath(resource string) (string, error) {
+	var res string
+	if !common.IsCgroup2UnifiedMode() {
+		res = resource
+	}
+	path, ok := h.cgroupPaths[res]
+	if !ok {
+		return "", fmt.Errorf("could not find path for resource %q for container %q", resource, h.reference.Name)
+	}
+	return path, nil
+}
+
+func (h *kataContainerHandler) GetContainerLabels() map[string]string {
+	return h.labels
+}
+
+func (h *kataContainerHandler) GetContainerIPAddress() string {
+	return ""
+}
+
+func (h *kataContainerHandler) Exists() bool {
+	return common.CgroupExists(h.cgroupPaths)
+}
+
+func (h *kataContainerHandler) Type() container.ContainerType {
+	return container.ContainerTypeKata
+}
+
+func (h *kataContainerHandler) GetExitCode() (int, error) {
+	return -1, fmt.Errorf("exit code not available for kata sandboxes")
+}
+
+// Nothing to start up.
+func (h *kataContainerHandler) Start() {}
+
+// Cleanup closes the libcontainer managers of the sandbox cgroup and of the
+// VMM overhead cgroup.
+func (h *kataContainerHandler) Cleanup() {
+	h.libcontainerHandler.Close()
+	h.overheadHandler.Close()
+}
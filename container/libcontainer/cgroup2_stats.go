@@ -0,0 +1,491 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package libcontainer
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/opencontainers/cgroups"
+	"github.com/opencontainers/cgroups/fs2"
+	"github.com/opencontainers/cgroups/fscommon"
+	"golang.org/x/sys/unix"
+
+	"github.com/google/cadvisor/container"
+)
+
+// cgroup2Stats reads the stats of a cgroup v2 container. Unlike the stats of
+// the fs2 cgroup manager, which reads every controller, it only reads the
+// files backing the included metrics, and remembers which optional files the
+// cgroup does not have so that they are not opened again. The parsing follows
+// the fs2 package of github.com/opencontainers/cgroups, so both produce the
+// same stats. The files are read through cgroupFiles, so they are not opened
+// again every time.
+//
+// Like Handler, it is not safe for concurrent use.
+type cgroup2Stats struct {
+	dirPath         string
+	includedMetrics container.MetricSet
+	files           *cgroupFiles
+
+	// Optional files found not to exist in the cgroup.
+	unsupported map[string]bool
+}
+
+func newCgroup2Stats(dirPath string, includedMetrics container.MetricSet) *cgroup2Stats {
+	return &cgroup2Stats{
+		dirPath:         dirPath,
+		includedMetrics: includedMetrics,
+		files:           newCgroupFiles(dirPath),
+		unsupported:     make(map[string]bool),
+	}
+}
+
+// GetStats returns the stats of the cgroup. The CPU and memory stats are
+// always read, as every container reports them.
+func (s *cgroup2Stats) GetStats() (*cgroups.Stats, error) {
+	var errs []error
+	st := cgroups.NewStats()
+
+	if s.includedMetrics.Has(container.ProcessMetrics) {
+		if err := s.statPids(st); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if err := s.statMemory(st); err != nil && !os.IsNotExist(err) {
+		errs = append(errs, err)
+	}
+	if s.includedMetrics.Has(container.DiskIOMetrics) {
+		if err := s.statIo(st); err != nil && !os.IsNotExist(err) {
+			errs = append(errs, err)
+		}
+	}
+	// Note cpu.stat is available even if the controller is not enabled.
+	if err := s.statCpu(st); err != nil && !os.IsNotExist(err) {
+		errs = append(errs, err)
+	}
+	if s.includedMetrics.Has(container.PressureMetrics) {
+		var err error
+		if st.CpuStats.PSI, err = s.statPSI("cpu.pressure"); err != nil {
+			errs = append(errs, err)
+		}
+		if st.MemoryStats.PSI, err = s.statPSI("memory.pressure"); err != nil {
+			errs = append(errs, err)
+		}
+		if s.includedMetrics.Has(container.DiskIOMetrics) {
+			if st.BlkioStats.PSI, err = s.statPSI("io.pressure"); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+	if s.includedMetrics.Has(container.HugetlbUsageMetrics) {
+		if err := s.statHugeTlb(st); err != nil && !os.IsNotExist(err) {
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) > 0 {
+		return st, fmt.Errorf("error while statting cgroup v2: %+v", errs)
+	}
+	return st, nil
+}
+
+// Close closes the files of the cgroup.
+func (s *cgroup2Stats) Close() {
+	s.files.Close()
+}
+
+// readUint reads an optional single value file, reporting whether it exists.
+func (s *cgroup2Stats) readUint(file string) (uint64, bool, error) {
+	if s.unsupported[file] {
+		return 0, false, nil
+	}
+	value, err := s.files.readUint(file)
+	if err != nil {
+		if os.IsNotExist(err) {
+			s.unsupported[file] = true
+			return 0, false, nil
+		}
+		return 0, false, err
+	}
+	return value, true, nil
+}
+
+func (s *cgroup2Stats) statPids(st *cgroups.Stats) error {
+	current, ok, err := s.readUint("pids.current")
+	if err != nil {
+		return err
+	}
+	if !ok {
+		// If the controller is not enabled, count the processes (or threads if
+		// cgroup.threads is enabled) of the cgroup instead.
+		contents, err := s.files.read("cgroup.procs")
+		if errors.Is(err, unix.ENOTSUP) {
+			contents, err = s.files.read("cgroup.threads")
+		}
+		if err != nil {
+			return err
+		}
+		st.PidsStats.Current = uint64(bytes.Count(contents, []byte("\n")))
+		return nil
+	}
+
+	limit, err := s.files.readUint("pids.max")
+	if err != nil {
+		return err
+	}
+	// No limit is represented as 0.
+	if limit == math.MaxUint64 {
+		limit = 0
+	}
+	st.PidsStats.Current = current
+	st.PidsStats.Limit = limit
+	return nil
+}
+
+func (s *cgroup2Stats) statCpu(st *cgroups.Stats) error {
+	const file = "cpu.stat"
+	contents, err := s.files.read(file)
+	if err != nil {
+		return err
+	}
+
+	sc := bufio.NewScanner(bytes.NewReader(contents))
+	for sc.Scan() {
+		t, v, err := fscommon.ParseKeyValue(sc.Text())
+		if err != nil {
+			return &fscommon.ParseError{Path: s.dirPath, File: file, Err: err}
+		}
+		switch t {
+		case "usage_usec":
+			st.CpuStats.CpuUsage.TotalUsage = v * 1000
+		case "user_usec":
+			st.CpuStats.CpuUsage.UsageInUsermode = v * 1000
+		case "system_usec":
+			st.CpuStats.CpuUsage.UsageInKernelmode = v * 1000
+		case "nr_periods":
+			st.CpuStats.ThrottlingData.Periods = v
+		case "nr_throttled":
+			st.CpuStats.ThrottlingData.ThrottledPeriods = v
+		case "throttled_usec":
+			st.CpuStats.ThrottlingData.ThrottledTime = v * 1000
+		case "nr_bursts":
+			st.CpuStats.BurstData.BurstsPeriods = v
+		case "burst_usec":
+			st.CpuStats.BurstData.BurstTime = v * 1000
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return &fscommon.ParseError{Path: s.dirPath, File: file, Err: err}
+	}
+	return nil
+}
+
+func (s *cgroup2Stats) statMemory(st *cgroups.Stats) error {
+	const file = "memory.stat"
+	contents, err := s.files.read(file)
+	if err != nil {
+		return err
+	}
+
+	sc := bufio.NewScanner(bytes.NewReader(contents))
+	for sc.Scan() {
+		t, v, err := fscommon.ParseKeyValue(sc.Text())
+		if err != nil {
+			return &fscommon.ParseError{Path: s.dirPath, File: file, Err: err}
+		}
+		st.MemoryStats.Stats[t] = v
+	}
+	if err := sc.Err(); err != nil {
+		return &fscommon.ParseError{Path: s.dirPath, File: file, Err: err}
+	}
+	st.MemoryStats.Cache = st.MemoryStats.Stats["file"]
+	// cgroup v2 is always hierarchical.
+	st.MemoryStats.UseHierarchy = true
+
+	usage, ok, err := s.memoryData("memory")
+	if err != nil {
+		return err
+	}
+	if !ok {
+		if s.dirPath == fs2.UnifiedMountpoint {
+			// The root cgroup does not have memory.{current,max,peak}.
+			return rootMemoryStatsFromMeminfo(st)
+		}
+		return &os.PathError{Op: "open", Path: s.dirPath + "/memory.current", Err: os.ErrNotExist}
+	}
+	st.MemoryStats.Usage = usage
+
+	// Swap is not accounted if the memory.swap files do not exist.
+	swapOnly, _, err := s.memoryData("memory.swap")
+	if err != nil {
+		return err
+	}
+	st.MemoryStats.SwapOnlyUsage = swapOnly
+	// Report mem+swap combined like cgroup v1 does.
+	swap := swapOnly
+	swap.Usage += usage.Usage
+	if swap.Limit != math.MaxUint64 {
+		swap.Limit += usage.Limit
+	}
+	swap.MaxUsage = 0
+	st.MemoryStats.SwapUsage = swap
+	return nil
+}
+
+// memoryData reads the current, max and peak files of the given memory
+// module, reporting whether the module exists.
+func (s *cgroup2Stats) memoryData(module string) (cgroups.MemoryData, bool, error) {
+	var data cgroups.MemoryData
+	usage, ok, err := s.readUint(module + ".current")
+	if err != nil || !ok {
+		return data, ok, err
+	}
+	data.Usage = usage
+
+	data.Limit, err = s.files.readUint(module + ".max")
+	if err != nil {
+		return cgroups.MemoryData{}, false, err
+	}
+
+	// memory.peak is available since kernel 5.19, memory.swap.peak since 6.5.
+	data.MaxUsage, _, err = s.readUint(module + ".peak")
+	if err != nil {
+		return cgroups.MemoryData{}, false, err
+	}
+	return data, true, nil
+}
+
+// rootMemoryStatsFromMeminfo emulates the memory usage of the root cgroup
+// from its memory.stat and /proc/meminfo.
+func rootMemoryStatsFromMeminfo(st *cgroups.Stats) error {
+	const file = "/proc/meminfo"
+	f, err := os.Open(file)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	var swapFree, swapTotal uint64
+	fields := map[string]*uint64{
+		"SwapFree":  &swapFree,
+		"SwapTotal": &swapTotal,
+	}
+	found := 0
+	sc := bufio.NewScanner(f)
+	for sc.Scan() && found < len(fields) {
+		key, value, ok := strings.Cut(sc.Text(), ":")
+		p, known := fields[key]
+		if !ok || !known {
+			continue
+		}
+		*p, err = strconv.ParseUint(strings.TrimSpace(strings.TrimSuffix(value, " kB")), 10, 64)
+		if err != nil {
+			return &fscommon.ParseError{File: file, Err: errors.New("bad value for " + key)}
+		}
+		found++
+	}
+	if err := sc.Err(); err != nil {
+		return &fscommon.ParseError{File: file, Err: err}
+	}
+
+	// Report anon+file like usage_in_bytes of cgroup v1, and mem+swap as swap usage.
+	st.MemoryStats.Usage.Usage = st.MemoryStats.Stats["anon"] + st.MemoryStats.Stats["file"]
+	st.MemoryStats.Usage.Limit = math.MaxUint64
+	st.MemoryStats.SwapUsage.Usage = (swapTotal-swapFree)*1024 + st.MemoryStats.Usage.Usage
+	st.MemoryStats.SwapUsage.Limit = math.MaxUint64
+	return nil
+}
+
+func (s *cgroup2Stats) statIo(st *cgroups.Stats) error {
+	const file = "io.stat"
+	contents, err := s.files.read(file)
+	if err != nil {
+		return err
+	}
+
+	// See https://www.kernel.org/doc/Documentation/cgroup-v2.txt for the format.
+	var parsed cgroups.BlkioStats
+	sc := bufio.NewScanner(bytes.NewReader(contents))
+	for sc.Scan() {
+		parts := strings.Fields(sc.Text())
+		if len(parts) < 2 {
+			continue
+		}
+		majorStr, minorStr, ok := strings.Cut(parts[0], ":")
+		if !ok {
+			continue
+		}
+		major, err := strconv.ParseUint(majorStr, 10, 64)
+		if err != nil {
+			return &fscommon.ParseError{Path: s.dirPath, File: file, Err: err}
+		}
+		minor, err := strconv.ParseUint(minorStr, 10, 64)
+		if err != nil {
+			return &fscommon.ParseError{Path: s.dirPath, File: file, Err: err}
+		}
+		for _, item := range parts[1:] {
+			key, valueStr, ok := strings.Cut(item, "=")
+			if !ok {
+				continue
+			}
+			// Map to the cgroup v1 naming and layout.
+			var op string
+			var table *[]cgroups.BlkioStatEntry
+			switch key {
+			case "rbytes":
+				op, table = "Read", &parsed.IoServiceBytesRecursive
+			case "wbytes":
+				op, table = "Write", &parsed.IoServiceBytesRecursive
+			case "rios":
+				op, table = "Read", &parsed.IoServicedRecursive
+			case "wios":
+				op, table = "Write", &parsed.IoServicedRecursive
+			case "cost.usage":
+				op, table = "Count", &parsed.IoCostUsage
+			case "cost.wait":
+				op, table = "Count", &parsed.IoCostWait
+			case "cost.indebt":
+				op, table = "Count", &parsed.IoCostIndebt
+			case "cost.indelay":
+				op, table = "Count", &parsed.IoCostIndelay
+			default:
+				continue
+			}
+			value, err := strconv.ParseUint(valueStr, 10, 64)
+			if err != nil {
+				return &fscommon.ParseError{Path: s.dirPath, File: file, Err: err}
+			}
+			*table = append(*table, cgroups.BlkioStatEntry{
+				Op:    op,
+				Major: major,
+				Minor: minor,
+				Value: value,
+			})
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return &fscommon.ParseError{Path: s.dirPath, File: file, Err: err}
+	}
+	st.BlkioStats = parsed
+	return nil
+}
+
+func (s *cgroup2Stats) statPSI(file string) (*cgroups.PSIStats, error) {
+	if s.unsupported[file] {
+		return nil, nil
+	}
+	contents, err := s.files.read(file)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) || errors.Is(err, unix.ENOTSUP) {
+			// PSI is not supported by the kernel or turned off for the cgroup.
+			// Some kernels need the psi=1 kernel parameter to read it.
+			s.unsupported[file] = true
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var psi cgroups.PSIStats
+	sc := bufio.NewScanner(bytes.NewReader(contents))
+	for sc.Scan() {
+		parts := strings.Fields(sc.Text())
+		if len(parts) == 0 {
+			continue
+		}
+		var data *cgroups.PSIData
+		switch parts[0] {
+		case "some":
+			data = &psi.Some
+		case "full":
+			data = &psi.Full
+		default:
+			continue
+		}
+		if *data, err = parsePSIData(parts[1:]); err != nil {
+			return nil, &fscommon.ParseError{Path: s.dirPath, File: file, Err: err}
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return nil, &fscommon.ParseError{Path: s.dirPath, File: file, Err: err}
+	}
+	return &psi, nil
+}
+
+func parsePSIData(fields []string) (cgroups.PSIData, error) {
+	var data cgroups.PSIData
+	for _, field := range fields {
+		key, value, ok := strings.Cut(field, "=")
+		if !ok {
+			return data, fmt.Errorf("invalid psi data: %q", field)
+		}
+		var avg *float64
+		switch key {
+		case "avg10":
+			avg = &data.Avg10
+		case "avg60":
+			avg = &data.Avg60
+		case "avg300":
+			avg = &data.Avg300
+		case "total":
+			v, err := strconv.ParseUint(value, 10, 64)
+			if err != nil {
+				return data, fmt.Errorf("invalid %s PSI value: %w", key, err)
+			}
+			data.Total = v
+		}
+		if avg != nil {
+			v, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				return data, fmt.Errorf("invalid %s PSI value: %w", key, err)
+			}
+			*avg = v
+		}
+	}
+	return data, nil
+}
+
+func (s *cgroup2Stats) statHugeTlb(st *cgroups.Stats) error {
+	for _, pageSize := range cgroups.HugePageSizes() {
+		prefix := "hugetlb." + pageSize
+		// Prefer the usage including reservations, available since kernel 5.7.
+		usage, ok, err := s.readUint(prefix + ".rsvd.current")
+		if err == nil && !ok {
+			usage, ok, err = s.readUint(prefix + ".current")
+		}
+		if err != nil {
+			return err
+		}
+		if !ok {
+			continue
+		}
+		failcnt, err := s.files.readValueByKey(prefix+".events", "max")
+		if err != nil {
+			return err
+		}
+		st.HugetlbStats[pageSize] = cgroups.HugetlbStats{
+			Usage:   usage,
+			Failcnt: failcnt,
+		}
+	}
+	return nil
+}
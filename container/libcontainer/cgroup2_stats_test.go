@@ -0,0 +1,123 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package libcontainer
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/opencontainers/cgroups"
+	"github.com/opencontainers/cgroups/fs2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/google/cadvisor/container"
+)
+
+var cgroup2Files = map[string]string{
+	"cpu.stat":            "usage_usec 1000\nuser_usec 600\nsystem_usec 400\nnr_periods 10\nnr_throttled 2\nthrottled_usec 50\n",
+	"cpu.pressure":        "some avg10=1.50 avg60=0.50 avg300=0.10 total=1234\nfull avg10=0.00 avg60=0.00 avg300=0.00 total=0\n",
+	"memory.stat":         "anon 4096\nfile 8192\n",
+	"memory.current":      "12288\n",
+	"memory.max":          "max\n",
+	"memory.swap.current": "1024\n",
+	"memory.swap.max":     "4096\n",
+	"memory.pressure":     "some avg10=0.00 avg60=0.00 avg300=0.00 total=5\nfull avg10=0.00 avg60=0.00 avg300=0.00 total=1\n",
+	"io.stat":             "8:0 rbytes=100 wbytes=200 rios=3 wios=4 dbytes=0 dios=0\n",
+	"io.pressure":         "some avg10=0.00 avg60=0.00 avg300=0.00 total=7\n",
+	"pids.current":        "3\n",
+	"pids.max":            "max\n",
+	"cgroup.procs":        "1\n2\n3\n",
+}
+
+func writeCgroup2Files(t *testing.T, dir string, files map[string]string) {
+	// Allow reading the fake cgroupfs.
+	cgroups.TestMode = true
+	for name, content := range files {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644))
+	}
+}
+
+func TestCgroup2StatsMatchFs2(t *testing.T) {
+	dir := t.TempDir()
+	writeCgroup2Files(t, dir, cgroup2Files)
+
+	m, err := fs2.NewManager(&cgroups.Cgroup{Resources: &cgroups.Resources{}}, dir)
+	require.NoError(t, err)
+	expected, err := m.GetStats()
+	require.NoError(t, err)
+
+	all := container.MetricSet{
+		container.ProcessMetrics:      struct{}{},
+		container.DiskIOMetrics:       struct{}{},
+		container.PressureMetrics:     struct{}{},
+		container.HugetlbUsageMetrics: struct{}{},
+	}
+	actual, err := newCgroup2Stats(dir, all).GetStats()
+	require.NoError(t, err)
+	assert.Equal(t, expected, actual)
+}
+
+func TestCgroup2StatsSkipsExcludedMetrics(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{}
+	for name, content := range cgroup2Files {
+		files[name] = content
+	}
+	// Unreadable files of excluded metrics must not matter.
+	files["io.stat"] = "8:0 rbytes=invalid\n"
+	files["pids.max"] = "invalid\n"
+	files["cpu.pressure"] = "some avg10=invalid\n"
+	writeCgroup2Files(t, dir, files)
+
+	s := newCgroup2Stats(dir, container.MetricSet{})
+	st, err := s.GetStats()
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1000000), st.CpuStats.CpuUsage.TotalUsage)
+	assert.Equal(t, uint64(12288), st.MemoryStats.Usage.Usage)
+	assert.Empty(t, st.BlkioStats.IoServiceBytesRecursive)
+	assert.Nil(t, st.CpuStats.PSI)
+	assert.Zero(t, st.PidsStats.Current)
+}
+
+func TestCgroup2StatsCachesMissingFiles(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{}
+	for name, content := range cgroup2Files {
+		files[name] = content
+	}
+	delete(files, "memory.swap.current")
+	delete(files, "memory.swap.max")
+	delete(files, "pids.current")
+	writeCgroup2Files(t, dir, files)
+
+	s := newCgroup2Stats(dir, container.MetricSet{container.ProcessMetrics: struct{}{}})
+	st, err := s.GetStats()
+	require.NoError(t, err)
+	assert.Zero(t, st.MemoryStats.SwapOnlyUsage.Usage)
+	assert.Zero(t, st.MemoryStats.Usage.MaxUsage)
+	assert.Equal(t, uint64(3), st.PidsStats.Current, "counted from cgroup.procs")
+	assert.True(t, s.unsupported["memory.peak"])
+
+	// Files found missing are not looked at again.
+	writeCgroup2Files(t, dir, map[string]string{"memory.peak": "16384\n", "pids.current": "5\n"})
+	st, err = s.GetStats()
+	require.NoError(t, err)
+	assert.Zero(t, st.MemoryStats.Usage.MaxUsage)
+	assert.Equal(t, uint64(3), st.PidsStats.Current)
+}
@@ -0,0 +1,191 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package libcontainer
+
+import (
+	"errors"
+	"flag"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/opencontainers/cgroups/fscommon"
+	"golang.org/x/sys/unix"
+)
+
+var keepCgroupFilesOpen = flag.Bool("cgroup_keep_files_open", true, "Keep the cgroup v2 stat files of every container open between housekeeping cycles and re-read each with a single pread, instead of resolving and opening them again every cycle. Costs one file descriptor per stat file and container")
+
+// cgroupFiles reads the files of a cgroup directory. The directory is opened
+// once, files are opened relative to it without resolving the cgroup path
+// again, and, unless cgroup_keep_files_open is false, kept open so that every
+// later read of a file is a single pread from its start. Cgroup files
+// regenerate their contents when read from offset 0.
+//
+// Like cgroup2Stats, it is not safe for concurrent use.
+type cgroupFiles struct {
+	dirPath string
+	dir     *os.File
+	files   map[string]*os.File
+	// buf holds the contents of the last file read.
+	buf []byte
+}
+
+func newCgroupFiles(dirPath string) *cgroupFiles {
+	return &cgroupFiles{
+		dirPath: dirPath,
+		files:   make(map[string]*os.File),
+		buf:     make([]byte, 4096),
+	}
+}
+
+// read returns the contents of the given file of the cgroup. The returned
+// slice is only valid until the next read.
+func (c *cgroupFiles) read(name string) ([]byte, error) {
+	f, err := c.open(name)
+	if err != nil {
+		return nil, err
+	}
+	if !*keepCgroupFilesOpen {
+		defer f.Close()
+	}
+	off := 0
+	for {
+		n, err := f.ReadAt(c.buf[off:], int64(off))
+		off += n
+		if err == io.EOF {
+			return c.buf[:off], nil
+		}
+		if err != nil {
+			if errors.Is(err, unix.ENODEV) {
+				// The cgroup was removed, the name may refer to a new one by now.
+				c.Close()
+			} else {
+				c.forget(name)
+			}
+			return nil, err
+		}
+		// The buffer is full, the file may be longer.
+		c.buf = append(c.buf, make([]byte, len(c.buf))...)
+	}
+}
+
+// readString returns the contents of the given file with surrounding
+// whitespace trimmed.
+func (c *cgroupFiles) readString(name string) (string, error) {
+	contents, err := c.read(name)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(contents)), nil
+}
+
+// readUint reads a single value file, like fscommon.GetCgroupParamUint.
+func (c *cgroupFiles) readUint(name string) (uint64, error) {
+	contents, err := c.readString(name)
+	if err != nil {
+		return 0, err
+	}
+	if contents == "max" {
+		return math.MaxUint64, nil
+	}
+	value, err := fscommon.ParseUint(contents, 10, 64)
+	if err != nil {
+		return value, &fscommon.ParseError{Path: c.dirPath, File: name, Err: err}
+	}
+	return value, nil
+}
+
+// readValueByKey reads the value of the given key of a flat keyed file,
+// like fscommon.GetValueByKey.
+func (c *cgroupFiles) readValueByKey(name, key string) (uint64, error) {
+	contents, err := c.readString(name)
+	if err != nil {
+		return 0, err
+	}
+	for _, line := range strings.Split(contents, "\n") {
+		if v, ok := strings.CutPrefix(line, key+" "); ok {
+			value, err := fscommon.ParseUint(v, 10, 64)
+			if err != nil {
+				return value, &fscommon.ParseError{Path: c.dirPath, File: name, Err: err}
+			}
+			return value, nil
+		}
+	}
+	return 0, nil
+}
+
+// open returns the open file of the given name, opening the directory and
+// the file if needed.
+func (c *cgroupFiles) open(name string) (*os.File, error) {
+	if f, ok := c.files[name]; ok {
+		return f, nil
+	}
+	if c.dir == nil {
+		fd, err := openat2(unix.AT_FDCWD, c.dirPath, unix.O_PATH|unix.O_DIRECTORY|unix.O_CLOEXEC, unix.RESOLVE_NO_MAGICLINKS)
+		if err != nil {
+			return nil, &os.PathError{Op: "open", Path: c.dirPath, Err: err}
+		}
+		c.dir = os.NewFile(uintptr(fd), c.dirPath)
+	}
+	path := filepath.Join(c.dirPath, name)
+	fd, err := openat2(int(c.dir.Fd()), name, unix.O_RDONLY|unix.O_CLOEXEC, unix.RESOLVE_BENEATH|unix.RESOLVE_NO_MAGICLINKS)
+	if err != nil {
+		return nil, &os.PathError{Op: "open", Path: path, Err: err}
+	}
+	f := os.NewFile(uintptr(fd), path)
+	if *keepCgroupFilesOpen {
+		c.files[name] = f
+	}
+	return f, nil
+}
+
+// forget closes the given file, so that it is opened again on the next read.
+func (c *cgroupFiles) forget(name string) {
+	if f, ok := c.files[name]; ok {
+		f.Close()
+		delete(c.files, name)
+	}
+}
+
+// Close closes the directory and all open files.
+func (c *cgroupFiles) Close() {
+	for name := range c.files {
+		c.forget(name)
+	}
+	if c.dir != nil {
+		c.dir.Close()
+		c.dir = nil
+	}
+}
+
+// openat2 opens path relative to dirfd with the given resolve flags, falling
+// back to openat on kernels without openat2.
+func openat2(dirfd int, path string, flags uint64, resolve uint64) (int, error) {
+	for {
+		fd, err := unix.Openat2(dirfd, path, &unix.OpenHow{Flags: flags, Resolve: resolve})
+		if err == unix.EINTR || err == unix.EAGAIN {
+			continue
+		}
+		if err == unix.ENOSYS || err == unix.EPERM {
+			// openat2 is available since kernel 5.6, and may be blocked by seccomp.
+			return unix.Openat(dirfd, path, int(flags), 0)
+		}
+		return fd, err
+	}
+}
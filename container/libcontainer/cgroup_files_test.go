@@ -0,0 +1,127 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package libcontainer
+
+import (
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCgroupFilesRereadsOpenFiles(t *testing.T) {
+	dir := t.TempDir()
+	writeCgroup2Files(t, dir, map[string]string{"memory.current": "4096\n"})
+	files := newCgroupFiles(dir)
+	defer files.Close()
+
+	value, err := files.readUint("memory.current")
+	require.NoError(t, err)
+	assert.Equal(t, uint64(4096), value)
+	opened := files.files["memory.current"]
+	require.NotNil(t, opened)
+
+	// Shorter contents are not mixed with the previous ones.
+	writeCgroup2Files(t, dir, map[string]string{"memory.current": "12\n"})
+	value, err = files.readUint("memory.current")
+	require.NoError(t, err)
+	assert.Equal(t, uint64(12), value)
+	assert.Same(t, opened, files.files["memory.current"], "file opened again")
+}
+
+func TestCgroupFilesReadsLongFiles(t *testing.T) {
+	dir := t.TempDir()
+	long := strings.Repeat("pgfault 1\n", 1000)
+	writeCgroup2Files(t, dir, map[string]string{"memory.stat": long})
+	files := newCgroupFiles(dir)
+	defer files.Close()
+
+	contents, err := files.read("memory.stat")
+	require.NoError(t, err)
+	assert.Equal(t, long, string(contents))
+}
+
+func TestCgroupFilesParsing(t *testing.T) {
+	dir := t.TempDir()
+	writeCgroup2Files(t, dir, map[string]string{
+		"memory.max":          "max\n",
+		"pids.current":        "-1\n",
+		"hugetlb.2MB.events":  "max 3\n",
+		"memory.swap.current": "invalid\n",
+	})
+	files := newCgroupFiles(dir)
+	defer files.Close()
+
+	value, err := files.readUint("memory.max")
+	require.NoError(t, err)
+	assert.Equal(t, uint64(math.MaxUint64), value)
+	value, err = files.readUint("pids.current")
+	require.NoError(t, err)
+	assert.Zero(t, value)
+	value, err = files.readValueByKey("hugetlb.2MB.events", "max")
+	require.NoError(t, err)
+	assert.Equal(t, uint64(3), value)
+	_, err = files.readUint("memory.swap.current")
+	assert.Error(t, err)
+}
+
+func TestCgroupFilesMissing(t *testing.T) {
+	dir := t.TempDir()
+	files := newCgroupFiles(dir)
+	defer files.Close()
+	_, err := files.read("memory.peak")
+	assert.True(t, os.IsNotExist(err))
+
+	files = newCgroupFiles(filepath.Join(dir, "gone"))
+	_, err = files.read("memory.peak")
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestCgroupFilesClose(t *testing.T) {
+	dir := t.TempDir()
+	writeCgroup2Files(t, dir, map[string]string{"memory.current": "1\n"})
+	files := newCgroupFiles(dir)
+	_, err := files.read("memory.current")
+	require.NoError(t, err)
+
+	files.Close()
+	assert.Empty(t, files.files)
+	assert.Nil(t, files.dir)
+	// The files are opened again as needed.
+	value, err := files.readUint("memory.current")
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), value)
+	files.Close()
+}
+
+func TestCgroupFilesNotKeptOpen(t *testing.T) {
+	defer func(keep bool) { *keepCgroupFilesOpen = keep }(*keepCgroupFilesOpen)
+	*keepCgroupFilesOpen = false
+
+	dir := t.TempDir()
+	writeCgroup2Files(t, dir, map[string]string{"memory.current": "1\n"})
+	files := newCgroupFiles(dir)
+	defer files.Close()
+	value, err := files.readUint("memory.current")
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), value)
+	assert.Empty(t, files.files)
+}
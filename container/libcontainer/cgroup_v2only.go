@@ -0,0 +1,37 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux && cgroupv2only
+
+package libcontainer
+
+import (
+	"errors"
+
+	"github.com/google/cadvisor/container"
+
+	"github.com/opencontainers/cgroups"
+)
+
+// errCgroupV1 is returned for cgroup v1 hierarchies, which binaries built
+// with the cgroupv2only tag don't read.
+var errCgroupV1 = errors.New("cgroup v1 is not supported by this binary, built with the cgroupv2only tag")
+
+func getCgroupV1Subsystems(includedMetrics container.MetricSet) (map[string]string, error) {
+	return nil, errCgroupV1
+}
+
+func newCgroupV1Manager(config *cgroups.Cgroup, paths map[string]string) (cgroups.Manager, error) {
+	return nil, errCgroupV1
+}
@@ -19,24 +19,31 @@ package libcontainer
 import (
 	"bufio"
 	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"flag"
 	"fmt"
 	"io"
 	"os"
 	"path"
 	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/opencontainers/cgroups"
 	"github.com/opencontainers/cgroups/fs2"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/sys/unix"
 	"k8s.io/klog/v2"
 
 	"github.com/google/cadvisor/container"
 	"github.com/google/cadvisor/container/common"
 	info "github.com/google/cadvisor/info/v1"
+	"github.com/google/cadvisor/utils/tracing"
 )
 
 var (
@@ -47,6 +54,11 @@ var (
 	clearRefsFilePathPattern = "/proc/%d/clear_refs"
 
 	referencedRegexp = regexp.MustCompile(`Referenced:\s*([0-9]+)\s*kB`)
+	// smapsMappingRegexp matches the first line of a mapping in smaps, which
+	// ends with the path of the mapped file if any.
+	smapsMappingRegexp = regexp.MustCompile(`^[0-9a-f]+-[0-9a-f]+ \S+ \S+ \S+ \S+\s*(.*)$`)
+
+	tracer = otel.Tracer("github.com/google/cadvisor/container/libcontainer")
 )
 
 type Handler struct {
@@ -59,22 +71,67 @@ type Handler struct {
 	// pidMetricsSaved holds accumulated CPU scheduler stats for processes that no longer exist.
 	pidMetricsSaved info.CpuSchedstat
 	cycles          uint64
+	// cgroup2Stats reads the cgroup stats of the included metrics on cgroup v2, nil on cgroup v1.
+	cgroup2Stats *cgroup2Stats
+	// groupSchedule tracks when the metric groups with a collection interval were last collected.
+	groupSchedule container.MetricGroupSchedule
+	// lastStats are the stats returned by the previous GetStats, whose values are
+	// reported again for the metric groups that are not due.
+	lastStats *info.ContainerStats
+	// netns is the network namespace of the container, queried with netlink,
+	// nil until the network stats are first collected or if netlink sockets
+	// cannot be opened in it, as noted by netnsFailed.
+	netns       *netns
+	netnsFailed bool
+	// topProcessCPU holds the CPU usage of the processes at the previous
+	// collection of the top processes, to rank them by their recent usage.
+	topProcessCPU map[int]uint64
+}
+
+// due returns whether the given metric group is to be collected for stats.
+func (h *Handler) due(kind container.MetricKind, stats *info.ContainerStats) bool {
+	return h.groupSchedule.Due(kind, stats.Timestamp) || h.lastStats == nil
 }
 
 func NewHandler(cgroupManager cgroups.Manager, rootFs string, pid int, includedMetrics container.MetricSet) *Handler {
-	return &Handler{
+	h := &Handler{
 		cgroupManager:   cgroupManager,
 		rootFs:          rootFs,
 		pid:             pid,
 		includedMetrics: includedMetrics,
 		pidMetricsCache: make(map[int]*info.CpuSchedstat),
 	}
+	if m, ok := cgroupManager.(*fs2.Manager); ok {
+		h.cgroup2Stats = newCgroup2Stats(m.Path(""), includedMetrics)
+	}
+	return h
+}
+
+// Close releases the files the handler keeps open. The handler can still be
+// used, it opens them again as needed.
+func (h *Handler) Close() {
+	if h == nil {
+		return
+	}
+	if h.cgroup2Stats != nil {
+		h.cgroup2Stats.Close()
+	}
+	if h.netns != nil {
+		h.netns.release()
+		h.netns = nil
+	}
 }
 
 // Get cgroup and networking stats of the specified container
 func (h *Handler) GetStats() (*info.ContainerStats, error) {
+	return h.GetStatsContext(context.Background())
+}
+
+// GetStatsContext is GetStats tracing the reads of the cgroup and /proc files
+// as children of the span in ctx.
+func (h *Handler) GetStatsContext(ctx context.Context) (*info.ContainerStats, error) {
 	ignoreStatsError := false
-	if cgroups.IsCgroup2UnifiedMode() {
+	if common.IsCgroup2UnifiedMode() {
 		// On cgroup v2 the root cgroup stats have been introduced in recent kernel versions,
 		// so not all kernel versions have all the data. This means that stat fetching can fail
 		// due to lacking cgroup stat files, but that some data is provided.
@@ -83,7 +140,15 @@ func (h *Handler) GetStats() (*info.ContainerStats, error) {
 		}
 	}
 
-	cgroupStats, err := h.cgroupManager.GetStats()
+	var cgroupStats *cgroups.Stats
+	var err error
+	_, span := tracer.Start(ctx, "ReadCgroupStats", trace.WithAttributes(tracing.CgroupPath.String(h.cgroupManager.Path(""))))
+	if h.cgroup2Stats != nil {
+		cgroupStats, err = h.cgroup2Stats.GetStats()
+	} else {
+		cgroupStats, err = h.cgroupManager.GetStats()
+	}
+	tracing.End(span, err)
 	if err != nil {
 		if !ignoreStatsError {
 			return nil, err
@@ -91,89 +156,174 @@ func (h *Handler) GetStats() (*info.ContainerStats, error) {
 		klog.V(4).Infof("Ignoring errors when gathering stats for root cgroup since some controllers don't have stats on the root cgroup: %v", err)
 	}
 	stats := newContainerStats(cgroupStats, h.includedMetrics)
+	last := h.lastStats
 
 	if h.includedMetrics.Has(container.ProcessSchedulerMetrics) {
-		stats.Cpu.Schedstat, err = h.schedulerStatsFromProcs()
-		if err != nil {
-			klog.V(4).Infof("Unable to get Process Scheduler Stats: %v", err)
+		if h.due(container.ProcessSchedulerMetrics, stats) {
+			stats.Cpu.Schedstat, err = h.schedulerStatsFromProcs()
+			if err != nil {
+				klog.V(4).Infof("Unable to get Process Scheduler Stats: %v", err)
+			}
+		} else {
+			stats.Cpu.Schedstat = last.Cpu.Schedstat
 		}
 	}
 
 	if h.includedMetrics.Has(container.ReferencedMemoryMetrics) {
-		h.cycles++
-		pids, err := h.cgroupManager.GetPids()
-		if err != nil {
-			klog.V(4).Infof("Could not get PIDs for container %d: %v", h.pid, err)
-		} else {
-			stats.ReferencedMemory, err = referencedBytesStat(pids, h.cycles, *referencedResetInterval)
+		if h.due(container.ReferencedMemoryMetrics, stats) {
+			h.cycles++
+			_, span := tracer.Start(ctx, "ReadReferencedMemory")
+			pids, err := h.cgroupManager.GetPids()
 			if err != nil {
-				klog.V(4).Infof("Unable to get referenced bytes: %v", err)
+				klog.V(4).Infof("Could not get PIDs for container %d: %v", h.pid, err)
+			} else {
+				stats.ReferencedMemory, err = referencedBytesStat(pids, h.cycles, *referencedResetInterval)
+				if err != nil {
+					klog.V(4).Infof("Unable to get referenced bytes: %v", err)
+				}
 			}
+			tracing.End(span, err)
+		} else {
+			stats.ReferencedMemory = last.ReferencedMemory
 		}
 	}
 
-	// If we know the pid then get network stats from /proc/<pid>/net/dev
-	if h.pid > 0 {
-		if h.includedMetrics.Has(container.NetworkUsageMetrics) {
-			netStats, err := networkStatsFromProc(h.rootFs, h.pid)
+	if h.includedMetrics.Has(container.DaxMemoryMetrics) {
+		if h.due(container.DaxMemoryMetrics, stats) {
+			pids, err := h.cgroupManager.GetPids()
 			if err != nil {
-				klog.V(4).Infof("Unable to get network stats from pid %d: %v", h.pid, err)
+				klog.V(4).Infof("Could not get PIDs for container %d: %v", h.pid, err)
 			} else {
-				stats.Network.Interfaces = append(stats.Network.Interfaces, netStats...)
+				stats.DaxMemory, err = daxMappedBytes(pids)
+				if err != nil {
+					klog.V(4).Infof("Unable to get DAX mapped bytes: %v", err)
+				}
 			}
+		} else {
+			stats.DaxMemory = last.DaxMemory
 		}
-		if h.includedMetrics.Has(container.NetworkTcpUsageMetrics) {
-			t, err := tcpStatsFromProc(h.rootFs, h.pid, "net/tcp")
+	}
+
+	if h.includedMetrics.Has(container.TopProcessMetrics) {
+		if h.due(container.TopProcessMetrics, stats) {
+			pids, err := h.cgroupManager.GetAllPids()
 			if err != nil {
-				klog.V(4).Infof("Unable to get tcp stats from pid %d: %v", h.pid, err)
+				klog.V(4).Infof("Could not get PIDs for container %d: %v", h.pid, err)
 			} else {
-				stats.Network.Tcp = t
+				stats.TopProcesses, h.topProcessCPU = topProcesses(h.rootFs, pids, h.topProcessCPU, *topProcessCount)
 			}
+		} else {
+			stats.TopProcesses = last.TopProcesses
+		}
+	}
 
-			t6, err := tcpStatsFromProc(h.rootFs, h.pid, "net/tcp6")
-			if err != nil {
-				klog.V(4).Infof("Unable to get tcp6 stats from pid %d: %v", h.pid, err)
+	// If we know the pid then get network stats from its network namespace
+	if h.pid > 0 {
+		_, span := tracer.Start(ctx, "ReadPidStats")
+		if h.includedMetrics.Has(container.NetworkUsageMetrics) {
+			if h.due(container.NetworkUsageMetrics, stats) {
+				netStats, err := h.interfaceStats()
+				if err != nil {
+					klog.V(4).Infof("Unable to get network stats from pid %d: %v", h.pid, err)
+				} else {
+					stats.Network.Interfaces = append(stats.Network.Interfaces, netStats...)
+				}
 			} else {
-				stats.Network.Tcp6 = t6
+				stats.Network.Interfaces = slices.Clone(last.Network.Interfaces)
 			}
+		}
+		if h.includedMetrics.Has(container.NetworkTcpUsageMetrics) {
+			if h.due(container.NetworkTcpUsageMetrics, stats) {
+				t, err := h.tcpStats(unix.AF_INET)
+				if err != nil {
+					klog.V(4).Infof("Unable to get tcp stats from pid %d: %v", h.pid, err)
+				} else {
+					stats.Network.Tcp = t
+				}
 
+				t6, err := h.tcpStats(unix.AF_INET6)
+				if err != nil {
+					klog.V(4).Infof("Unable to get tcp6 stats from pid %d: %v", h.pid, err)
+				} else {
+					stats.Network.Tcp6 = t6
+				}
+			} else {
+				stats.Network.Tcp = last.Network.Tcp
+				stats.Network.Tcp6 = last.Network.Tcp6
+			}
 		}
 		if h.includedMetrics.Has(container.NetworkAdvancedTcpUsageMetrics) {
-			ta, err := advancedTCPStatsFromProc(h.rootFs, h.pid, "net/netstat", "net/snmp")
-			if err != nil {
-				klog.V(4).Infof("Unable to get advanced tcp stats from pid %d: %v", h.pid, err)
+			if h.due(container.NetworkAdvancedTcpUsageMetrics, stats) {
+				ta, err := advancedTCPStatsFromProc(h.rootFs, h.pid, "net/netstat", "net/snmp")
+				if err != nil {
+					klog.V(4).Infof("Unable to get advanced tcp stats from pid %d: %v", h.pid, err)
+				} else {
+					stats.Network.TcpAdvanced = ta
+				}
 			} else {
-				stats.Network.TcpAdvanced = ta
+				stats.Network.TcpAdvanced = last.Network.TcpAdvanced
 			}
 		}
-		if h.includedMetrics.Has(container.NetworkUdpUsageMetrics) {
-			u, err := udpStatsFromProc(h.rootFs, h.pid, "net/udp")
-			if err != nil {
-				klog.V(4).Infof("Unable to get udp stats from pid %d: %v", h.pid, err)
+		if h.includedMetrics.Has(container.DiskUsageMetrics) {
+			if h.due(container.DiskUsageMetrics, stats) {
+				stats.Volumes, err = volumeStatsFromProc(h.rootFs, h.pid)
+				if err != nil {
+					klog.V(4).Infof("Unable to get volume stats from pid %d: %v", h.pid, err)
+				}
+			} else {
+				stats.Volumes = last.Volumes
+			}
+		}
+		if h.includedMetrics.Has(container.NetworkFsMetrics) {
+			if h.due(container.NetworkFsMetrics, stats) {
+				stats.NetworkFs, err = networkFsStatsFromProc(h.rootFs, h.pid)
+				if err != nil {
+					klog.V(4).Infof("Unable to get network filesystem stats from pid %d: %v", h.pid, err)
+				}
 			} else {
-				stats.Network.Udp = u
+				stats.NetworkFs = last.NetworkFs
 			}
+		}
+		if h.includedMetrics.Has(container.NetworkUdpUsageMetrics) {
+			if h.due(container.NetworkUdpUsageMetrics, stats) {
+				u, err := h.udpStats(unix.AF_INET)
+				if err != nil {
+					klog.V(4).Infof("Unable to get udp stats from pid %d: %v", h.pid, err)
+				} else {
+					stats.Network.Udp = u
+				}
 
-			u6, err := udpStatsFromProc(h.rootFs, h.pid, "net/udp6")
-			if err != nil {
-				klog.V(4).Infof("Unable to get udp6 stats from pid %d: %v", h.pid, err)
+				u6, err := h.udpStats(unix.AF_INET6)
+				if err != nil {
+					klog.V(4).Infof("Unable to get udp6 stats from pid %d: %v", h.pid, err)
+				} else {
+					stats.Network.Udp6 = u6
+				}
 			} else {
-				stats.Network.Udp6 = u6
+				stats.Network.Udp = last.Network.Udp
+				stats.Network.Udp6 = last.Network.Udp6
 			}
 		}
+		span.End()
 	}
 	// some process metrics are per container ( number of processes, number of
 	// file descriptors etc.) and not required a proper container's
 	// root PID (systemd services don't have the root PID atm)
 	if h.includedMetrics.Has(container.ProcessMetrics) {
-		path, ok := common.GetControllerPath(h.cgroupManager.GetPaths(), "cpu", cgroups.IsCgroup2UnifiedMode())
-		if !ok {
-			klog.V(4).Infof("Could not find cgroups CPU for container %d", h.pid)
-		} else {
-			stats.Processes, err = processStatsFromProcs(h.rootFs, path, h.pid)
-			if err != nil {
-				klog.V(4).Infof("Unable to get Process Stats: %v", err)
+		if h.due(container.ProcessMetrics, stats) {
+			path, ok := common.GetControllerPath(h.cgroupManager.GetPaths(), "cpu", common.IsCgroup2UnifiedMode())
+			if !ok {
+				klog.V(4).Infof("Could not find cgroups CPU for container %d", h.pid)
+			} else {
+				_, span := tracer.Start(ctx, "ReadProcessStats")
+				stats.Processes, err = processStatsFromProcs(h.rootFs, path, h.pid)
+				if err != nil {
+					klog.V(4).Infof("Unable to get Process Stats: %v", err)
+				}
+				tracing.End(span, err)
 			}
+		} else {
+			stats.Processes = last.Processes
 		}
 
 		// if include processes metrics, just set threads metrics if exist, and has no relationship with cpu path
@@ -185,6 +335,7 @@ func (h *Handler) GetStats() (*info.ContainerStats, error) {
 		stats.Network.InterfaceStats = stats.Network.Interfaces[0]
 	}
 
+	h.lastStats = stats
 	return stats, nil
 }
 
@@ -429,6 +580,52 @@ func getReferencedKBytes(pids []int) (uint64, error) {
 	return referencedKBytes, nil
 }
 
+// daxMappedBytes returns the size of the mappings of device DAX character
+// devices, /dev/dax<region>.<n>, of the processes. Their pages are mapped
+// directly and not counted in the resident set size.
+func daxMappedBytes(pids []int) (uint64, error) {
+	mappedKBytes := uint64(0)
+	for _, pid := range pids {
+		smapsFilePath := fmt.Sprintf(smapsFilePathPattern, pid)
+		smaps, err := os.Open(smapsFilePath)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue // the process exited
+			}
+			return 0, err
+		}
+		kBytes, err := parseDaxMappedKBytes(smaps)
+		smaps.Close()
+		if err != nil {
+			return 0, fmt.Errorf("failed to parse %s: %v", smapsFilePath, err)
+		}
+		mappedKBytes += kBytes
+	}
+	return mappedKBytes * 1024, nil
+}
+
+func parseDaxMappedKBytes(smaps io.Reader) (uint64, error) {
+	mappedKBytes := uint64(0)
+	dax := false
+	scanner := bufio.NewScanner(smaps)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if m := smapsMappingRegexp.FindStringSubmatch(line); m != nil {
+			dax = strings.HasPrefix(m[1], "/dev/dax")
+			continue
+		}
+		if !dax || !strings.HasPrefix(line, "Size:") {
+			continue
+		}
+		var size uint64
+		if _, err := fmt.Sscanf(line, "Size: %d kB", &size); err != nil {
+			return 0, err
+		}
+		mappedKBytes += size
+	}
+	return mappedKBytes, scanner.Err()
+}
+
 func clearReferencedBytes(pids []int, cycles uint64, resetInterval uint64) error {
 	if resetInterval == 0 {
 		return nil
@@ -455,6 +652,83 @@ func clearReferencedBytes(pids []int, cycles uint64, resetInterval uint64) error
 	return nil
 }
 
+// networkNamespace returns the network namespace of the container, opened
+// the first time through its pid or, if it has exited, another process of
+// the container. It returns nil if netlink sockets cannot be opened in it.
+func (h *Handler) networkNamespace() *netns {
+	if h.netns != nil || h.netnsFailed {
+		return h.netns
+	}
+	ns, err := acquireNetns(h.rootFs, h.pid)
+	if errors.Is(err, os.ErrNotExist) {
+		pids, pidsErr := h.cgroupManager.GetPids()
+		if pidsErr != nil {
+			return nil
+		}
+		for _, pid := range pids {
+			if ns, err = acquireNetns(h.rootFs, pid); !errors.Is(err, os.ErrNotExist) {
+				break
+			}
+		}
+	}
+	switch {
+	case err == nil:
+		h.netns = ns
+	case errors.Is(err, os.ErrNotExist):
+		// Retried with the processes the container has next time.
+	default:
+		klog.V(4).Infof("Reading the network stats of pid %d from /proc: %v", h.pid, err)
+		h.netnsFailed = true
+	}
+	return h.netns
+}
+
+// interfaceStats returns the stats of the network interfaces of the
+// container, with netlink if possible and from /proc/<pid>/net/dev
+// otherwise.
+func (h *Handler) interfaceStats() ([]info.InterfaceStats, error) {
+	if ns := h.networkNamespace(); ns != nil {
+		stats, err := ns.interfaceStats()
+		if err == nil {
+			return stats, nil
+		}
+		klog.V(4).Infof("Unable to get network stats of pid %d with netlink: %v", h.pid, err)
+	}
+	return networkStatsFromProc(h.rootFs, h.pid)
+}
+
+// tcpStats returns the TCP stats of family of the container, with sock_diag
+// if possible and from /proc/<pid>/net/tcp{,6} otherwise.
+func (h *Handler) tcpStats(family uint8) (info.TcpStat, error) {
+	if ns := h.networkNamespace(); ns != nil {
+		stats, err := ns.tcpStats(family)
+		if err == nil {
+			return stats, nil
+		}
+		klog.V(4).Infof("Unable to get tcp stats of pid %d with sock_diag: %v", h.pid, err)
+	}
+	if family == unix.AF_INET6 {
+		return tcpStatsFromProc(h.rootFs, h.pid, "net/tcp6")
+	}
+	return tcpStatsFromProc(h.rootFs, h.pid, "net/tcp")
+}
+
+// udpStats returns the UDP stats of family of the container, with sock_diag
+// if possible and from /proc/<pid>/net/udp{,6} otherwise.
+func (h *Handler) udpStats(family uint8) (info.UdpStat, error) {
+	if ns := h.networkNamespace(); ns != nil {
+		stats, err := ns.udpStats(family)
+		if err == nil {
+			return stats, nil
+		}
+		klog.V(4).Infof("Unable to get udp stats of pid %d with sock_diag: %v", h.pid, err)
+	}
+	if family == unix.AF_INET6 {
+		return udpStatsFromProc(h.rootFs, h.pid, "net/udp6")
+	}
+	return udpStatsFromProc(h.rootFs, h.pid, "net/udp")
+}
+
 func networkStatsFromProc(rootFs string, pid int) ([]info.InterfaceStats, error) {
 	netStatsFile := path.Join(rootFs, "proc", strconv.Itoa(pid), "/net/dev")
 
@@ -811,7 +1085,7 @@ func setMemoryStats(s *cgroups.Stats, ret *info.ContainerStats) {
 	ret.Memory.KernelUsage = s.MemoryStats.KernelUsage.Usage
 	setPSIStats(s.MemoryStats.PSI, &ret.Memory.PSI)
 
-	if cgroups.IsCgroup2UnifiedMode() {
+	if common.IsCgroup2UnifiedMode() {
 		ret.Memory.Cache = s.MemoryStats.Stats["file"]
 		ret.Memory.RSS = s.MemoryStats.Stats["anon"]
 		ret.Memory.Swap = s.MemoryStats.SwapUsage.Usage - s.MemoryStats.Usage.Usage
@@ -837,12 +1111,12 @@ func setMemoryStats(s *cgroups.Stats, ret *info.ContainerStats) {
 	}
 
 	inactiveFileKeyName := "total_inactive_file"
-	if cgroups.IsCgroup2UnifiedMode() {
+	if common.IsCgroup2UnifiedMode() {
 		inactiveFileKeyName = "inactive_file"
 	}
 
 	activeFileKeyName := "total_active_file"
-	if cgroups.IsCgroup2UnifiedMode() {
+	if common.IsCgroup2UnifiedMode() {
 		activeFileKeyName = "active_file"
 	}
 
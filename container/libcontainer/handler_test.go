@@ -351,3 +351,12 @@ func TestProcessMaxOpenFileLimitLine(t *testing.T) {
 	assert.Equal(t, int64(1073741816), ulimit.SoftLimit)
 	assert.Equal(t, int64(1073741816), ulimit.HardLimit)
 }
+
+func TestDaxMappedBytes(t *testing.T) {
+	smapsFilePathPattern = "testdata/smaps%d"
+
+	// smaps4 has no DAX mapping and there is no smaps10.
+	stat, err := daxMappedBytes([]int{4, 20, 10})
+	assert.Nil(t, err)
+	assert.Equal(t, uint64((4194304+1048576)*1024), stat)
+}
@@ -17,15 +17,12 @@
 package libcontainer
 
 import (
-	"fmt"
-
 	"github.com/google/cadvisor/container"
+	"github.com/google/cadvisor/container/common"
 	info "github.com/google/cadvisor/info/v1"
 
 	"github.com/opencontainers/cgroups"
-	fs "github.com/opencontainers/cgroups/fs"
 	fs2 "github.com/opencontainers/cgroups/fs2"
-	"k8s.io/klog/v2"
 )
 
 // GetCgroupSubsystems returns information about the cgroup subsystems that are
@@ -39,70 +36,10 @@ import (
 // For cgroup v2, includedMetrics argument is unused, the only map key is ""
 // (empty string), and the value is the unified cgroup mount point.
 func GetCgroupSubsystems(includedMetrics container.MetricSet) (map[string]string, error) {
-	if cgroups.IsCgroup2UnifiedMode() {
+	if common.IsCgroup2UnifiedMode() {
 		return map[string]string{"": fs2.UnifiedMountpoint}, nil
 	}
-	// Get all cgroup mounts.
-	allCgroups, err := cgroups.GetCgroupMounts(true)
-	if err != nil {
-		return nil, err
-	}
-
-	return getCgroupSubsystemsHelper(allCgroups, includedMetrics)
-}
-
-func getCgroupSubsystemsHelper(allCgroups []cgroups.Mount, includedMetrics container.MetricSet) (map[string]string, error) {
-	if len(allCgroups) == 0 {
-		return nil, fmt.Errorf("failed to find cgroup mounts")
-	}
-
-	// Trim the mounts to only the subsystems we care about.
-	mountPoints := make(map[string]string, len(allCgroups))
-	for _, mount := range allCgroups {
-		for _, subsystem := range mount.Subsystems {
-			if !needSubsys(subsystem, includedMetrics) {
-				continue
-			}
-			if _, ok := mountPoints[subsystem]; ok {
-				// duplicate mount for this subsystem; use the first one we saw
-				klog.V(5).Infof("skipping %s, already using mount at %s", mount.Mountpoint, mountPoints[subsystem])
-				continue
-			}
-			mountPoints[subsystem] = mount.Mountpoint
-		}
-	}
-
-	return mountPoints, nil
-}
-
-// A map of cgroup subsystems we support listing (should be the minimal set
-// we need stats from) to a respective MetricKind.
-var supportedSubsystems = map[string]container.MetricKind{
-	"cpu":        container.CpuUsageMetrics,
-	"cpuacct":    container.CpuUsageMetrics,
-	"memory":     container.MemoryUsageMetrics,
-	"hugetlb":    container.HugetlbUsageMetrics,
-	"pids":       container.ProcessMetrics,
-	"cpuset":     container.CPUSetMetrics,
-	"blkio":      container.DiskIOMetrics,
-	"io":         container.DiskIOMetrics,
-	"devices":    "",
-	"perf_event": container.PerfMetrics,
-}
-
-// Check if this cgroup subsystem/controller is of use.
-func needSubsys(name string, metrics container.MetricSet) bool {
-	// Check if supported.
-	metric, supported := supportedSubsystems[name]
-	if !supported {
-		return false
-	}
-	// Check if needed.
-	if metrics == nil || metric == "" {
-		return true
-	}
-
-	return metrics.Has(metric)
+	return getCgroupV1Subsystems(includedMetrics)
 }
 
 func diskStatsCopy0(major, minor uint64) *info.PerDiskStats {
@@ -160,10 +97,10 @@ func NewCgroupManager(name string, paths map[string]string) (cgroups.Manager, er
 		Name:      name,
 		Resources: &cgroups.Resources{},
 	}
-	if cgroups.IsCgroup2UnifiedMode() {
+	if common.IsCgroup2UnifiedMode() {
 		path := paths[""]
 		return fs2.NewManager(config, path)
 	}
 
-	return fs.NewManager(config, paths)
+	return newCgroupV1Manager(config, paths)
 }
@@ -0,0 +1,81 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package libcontainer
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/opencontainers/cgroups"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/google/cadvisor/container"
+)
+
+// fakeCgroupManager returns empty cgroup stats.
+type fakeCgroupManager struct {
+	cgroups.Manager
+}
+
+func (m *fakeCgroupManager) GetStats() (*cgroups.Stats, error) {
+	return cgroups.NewStats(), nil
+}
+
+func (m *fakeCgroupManager) Path(string) string {
+	return ""
+}
+
+func (m *fakeCgroupManager) GetPids() ([]int, error) {
+	return nil, nil
+}
+
+func writeNetDev(t *testing.T, rootFs string, rxBytes string) {
+	dir := filepath.Join(rootFs, "proc", "1", "net")
+	require.NoError(t, os.MkdirAll(dir, 0o755))
+	content := "Inter-|   Receive |  Transmit\n face |bytes packets\n" +
+		"  eth0: " + rxBytes + " 1 0 0 0 0 0 0 2 1 0 0 0 0 0 0\n"
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "dev"), []byte(content), 0o644))
+}
+
+func TestGetStatsReportsGroupsAgainUntilDue(t *testing.T) {
+	container.SetMetricGroupInterval(container.NetworkUsageMetrics, time.Hour)
+	defer container.SetMetricGroupInterval(container.NetworkUsageMetrics, 0)
+
+	rootFs := t.TempDir()
+	writeNetDev(t, rootFs, "100")
+	h := NewHandler(&fakeCgroupManager{}, rootFs, 1, container.MetricSet{container.NetworkUsageMetrics: struct{}{}})
+
+	stats, err := h.GetStats()
+	require.NoError(t, err)
+	require.Len(t, stats.Network.Interfaces, 1)
+	assert.Equal(t, uint64(100), stats.Network.RxBytes)
+
+	// Not due yet, so the previous values are reported again.
+	writeNetDev(t, rootFs, "200")
+	stats, err = h.GetStats()
+	require.NoError(t, err)
+	require.Len(t, stats.Network.Interfaces, 1)
+	assert.Equal(t, uint64(100), stats.Network.RxBytes)
+
+	container.SetMetricGroupInterval(container.NetworkUsageMetrics, 0)
+	stats, err = h.GetStats()
+	require.NoError(t, err)
+	assert.Equal(t, uint64(200), stats.Network.RxBytes)
+}
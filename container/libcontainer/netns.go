@@ -0,0 +1,442 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package libcontainer
+
+import (
+	"bytes"
+	"encoding/binary"
+	"flag"
+	"fmt"
+	"os"
+	"path"
+	"runtime"
+	"slices"
+	"strconv"
+	"sync"
+	"syscall"
+	"time"
+
+	"golang.org/x/sys/unix"
+
+	info "github.com/google/cadvisor/info/v1"
+)
+
+var netnsStatsMaxAge = flag.Duration("network_namespace_stats_max_age", time.Second, "How long the network stats of a network namespace are reused for the other containers in it, e.g. of the same pod, instead of querying them again. 0 queries them for every container")
+
+const (
+	// sizeofInetDiagReqV2 and sizeofInetDiagMsg are the sizes of struct
+	// inet_diag_req_v2 and struct inet_diag_msg of linux/inet_diag.h.
+	sizeofInetDiagReqV2 = 56
+	sizeofInetDiagMsg   = 72
+	// inetDiagSkMeminfo is the INET_DIAG_SKMEMINFO attribute, the
+	// SK_MEMINFO_* counters of a socket.
+	inetDiagSkMeminfo = 7
+	// tcpNewSynRecv is the state of the request sockets of listening TCP
+	// sockets, which /proc/net/tcp reports as SYN_RECV.
+	tcpNewSynRecv = 12
+	// netlinkTimeout bounds how long a netlink query waits for the kernel.
+	netlinkTimeout = 5 * time.Second
+)
+
+// netnsKey identifies a network namespace by the device and inode of its
+// nsfs file.
+type netnsKey struct {
+	dev, ino uint64
+}
+
+// netns holds netlink sockets opened in a network namespace, shared by the
+// handlers of all the containers in it. The sockets keep the namespace, and
+// so its stats, reachable after the process they were opened through exits.
+type netns struct {
+	key netnsKey
+	// refs is the number of handlers using the namespace, guarded by
+	// netnsesLock.
+	refs int
+
+	lock sync.Mutex
+	// route and sockDiag are the NETLINK_ROUTE and NETLINK_SOCK_DIAG sockets.
+	route, sockDiag int
+	seq             uint32
+	interfaces      cachedStats[[]info.InterfaceStats]
+	tcp, tcp6       cachedStats[info.TcpStat]
+	udp, udp6       cachedStats[info.UdpStat]
+}
+
+var (
+	netnsesLock sync.Mutex
+	netnses     = map[netnsKey]*netns{}
+)
+
+// acquireNetns returns the network namespace of pid, opening netlink sockets
+// in it unless another handler already did. It is released with release.
+func acquireNetns(rootFs string, pid int) (*netns, error) {
+	fd, err := unix.Open(path.Join(rootFs, "proc", strconv.Itoa(pid), "ns/net"), unix.O_RDONLY|unix.O_CLOEXEC, 0)
+	if err != nil {
+		return nil, &os.PathError{Op: "open", Path: path.Join(rootFs, "proc", strconv.Itoa(pid), "ns/net"), Err: err}
+	}
+	defer unix.Close(fd)
+	key, err := netnsKeyOf(fd)
+	if err != nil {
+		return nil, err
+	}
+
+	netnsesLock.Lock()
+	defer netnsesLock.Unlock()
+	if ns, ok := netnses[key]; ok {
+		ns.refs++
+		return ns, nil
+	}
+	fds, err := socketsInNetns(fd, key, unix.NETLINK_ROUTE, unix.NETLINK_SOCK_DIAG)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open netlink sockets in the network namespace of pid %d: %v", pid, err)
+	}
+	ns := &netns{key: key, refs: 1, route: fds[0], sockDiag: fds[1]}
+	netnses[key] = ns
+	return ns, nil
+}
+
+// release closes the sockets of the namespace once no handler uses it.
+func (ns *netns) release() {
+	netnsesLock.Lock()
+	defer netnsesLock.Unlock()
+	ns.refs--
+	if ns.refs > 0 {
+		return
+	}
+	delete(netnses, ns.key)
+	unix.Close(ns.route)
+	unix.Close(ns.sockDiag)
+}
+
+func netnsKeyOf(fd int) (netnsKey, error) {
+	var st unix.Stat_t
+	if err := unix.Fstat(fd, &st); err != nil {
+		return netnsKey{}, err
+	}
+	return netnsKey{dev: st.Dev, ino: st.Ino}, nil
+}
+
+// socketsInNetns opens netlink sockets of the given protocols in the
+// network namespace of nsFd. Sockets stay in the namespace they were opened
+// in, so the thread opening them switches to it, unless it is already the
+// namespace of cAdvisor, and then back.
+func socketsInNetns(nsFd int, key netnsKey, protocols ...int) ([]int, error) {
+	if self, err := os.Stat("/proc/self/ns/net"); err == nil {
+		if st, ok := self.Sys().(*unix.Stat_t); ok && (netnsKey{dev: st.Dev, ino: st.Ino}) == key {
+			return netlinkSockets(protocols)
+		}
+	}
+
+	type result struct {
+		fds []int
+		err error
+	}
+	results := make(chan result, 1)
+	go func() {
+		runtime.LockOSThread()
+		own, err := unix.Open("/proc/thread-self/ns/net", unix.O_RDONLY|unix.O_CLOEXEC, 0)
+		if err != nil {
+			runtime.UnlockOSThread()
+			results <- result{err: err}
+			return
+		}
+		defer unix.Close(own)
+		if err := unix.Setns(nsFd, unix.CLONE_NEWNET); err != nil {
+			runtime.UnlockOSThread()
+			results <- result{err: fmt.Errorf("setns: %v", err)}
+			return
+		}
+		fds, err := netlinkSockets(protocols)
+		if restoreErr := unix.Setns(own, unix.CLONE_NEWNET); restoreErr != nil {
+			// Leave the thread locked, so that it exits with the goroutine
+			// instead of running other goroutines in the wrong namespace.
+			closeAll(fds)
+			results <- result{err: fmt.Errorf("failed to switch back to the network namespace of cAdvisor: %v", restoreErr)}
+			return
+		}
+		runtime.UnlockOSThread()
+		results <- result{fds: fds, err: err}
+	}()
+	r := <-results
+	return r.fds, r.err
+}
+
+func netlinkSockets(protocols []int) ([]int, error) {
+	fds := make([]int, 0, len(protocols))
+	for _, protocol := range protocols {
+		fd, err := unix.Socket(unix.AF_NETLINK, unix.SOCK_RAW|unix.SOCK_CLOEXEC, protocol)
+		if err != nil {
+			closeAll(fds)
+			return nil, err
+		}
+		fds = append(fds, fd)
+		timeout := unix.NsecToTimeval(netlinkTimeout.Nanoseconds())
+		if err := unix.SetsockoptTimeval(fd, unix.SOL_SOCKET, unix.SO_RCVTIMEO, &timeout); err != nil {
+			closeAll(fds)
+			return nil, err
+		}
+		if err := unix.Bind(fd, &unix.SockaddrNetlink{Family: unix.AF_NETLINK}); err != nil {
+			closeAll(fds)
+			return nil, err
+		}
+	}
+	return fds, nil
+}
+
+func closeAll(fds []int) {
+	for _, fd := range fds {
+		unix.Close(fd)
+	}
+}
+
+// cachedStats holds stats of a namespace for netnsStatsMaxAge.
+type cachedStats[T any] struct {
+	value T
+	time  time.Time
+}
+
+// get returns the cached stats, or queries them if they are older than
+// netnsStatsMaxAge.
+func (c *cachedStats[T]) get(query func() (T, error)) (T, error) {
+	now := time.Now()
+	if !c.time.IsZero() && now.Sub(c.time) < *netnsStatsMaxAge {
+		return c.value, nil
+	}
+	value, err := query()
+	if err != nil {
+		return value, err
+	}
+	c.value, c.time = value, now
+	return value, nil
+}
+
+// interfaceStats returns the stats of the network interfaces of the
+// namespace but the ignored ones, as /proc/<pid>/net/dev does.
+func (ns *netns) interfaceStats() ([]info.InterfaceStats, error) {
+	ns.lock.Lock()
+	defer ns.lock.Unlock()
+	stats, err := ns.interfaces.get(func() ([]info.InterfaceStats, error) {
+		var stats []info.InterfaceStats
+		req := make([]byte, unix.SizeofIfInfomsg)
+		err := ns.dump(ns.route, unix.RTM_GETLINK, req, func(m syscall.NetlinkMessage) error {
+			if m.Header.Type != unix.RTM_NEWLINK {
+				return nil
+			}
+			attrs, err := syscall.ParseNetlinkRouteAttr(&m)
+			if err != nil {
+				return err
+			}
+			if s, ok := parseLinkStats(attrs); ok && !isIgnoredDevice(s.Name) {
+				stats = append(stats, s)
+			}
+			return nil
+		})
+		return stats, err
+	})
+	return slices.Clone(stats), err
+}
+
+// parseLinkStats returns the stats of an interface from the attributes of
+// its RTM_NEWLINK message, counted as in /proc/net/dev.
+func parseLinkStats(attrs []syscall.NetlinkRouteAttr) (info.InterfaceStats, bool) {
+	var s info.InterfaceStats
+	var stats64 []byte
+	for _, attr := range attrs {
+		switch attr.Attr.Type {
+		case unix.IFLA_IFNAME:
+			s.Name = string(bytes.TrimRight(attr.Value, "\x00"))
+		case unix.IFLA_STATS64:
+			stats64 = attr.Value
+		}
+	}
+	// struct rtnl_link_stats64 starts with rx_packets, tx_packets, rx_bytes,
+	// tx_bytes, rx_errors, tx_errors, rx_dropped and tx_dropped, and has
+	// rx_missed_errors, also counted as dropped, at index 15.
+	if s.Name == "" || len(stats64) < 16*8 {
+		return s, false
+	}
+	counter := func(i int) uint64 { return binary.NativeEndian.Uint64(stats64[i*8:]) }
+	s.RxPackets, s.TxPackets = counter(0), counter(1)
+	s.RxBytes, s.TxBytes = counter(2), counter(3)
+	s.RxErrors, s.TxErrors = counter(4), counter(5)
+	s.RxDropped, s.TxDropped = counter(6)+counter(15), counter(7)
+	return s, true
+}
+
+// inetSocket is the part of the inet_diag_msg of a socket cAdvisor reports.
+type inetSocket struct {
+	state          uint8
+	rqueue, wqueue uint32
+	drops          uint32
+}
+
+// tcpStats returns the number of TCP sockets of family in each state.
+func (ns *netns) tcpStats(family uint8) (info.TcpStat, error) {
+	ns.lock.Lock()
+	defer ns.lock.Unlock()
+	c := &ns.tcp
+	if family == unix.AF_INET6 {
+		c = &ns.tcp6
+	}
+	return c.get(func() (info.TcpStat, error) {
+		sockets, err := ns.inetSockets(family, unix.IPPROTO_TCP, 0)
+		return tcpStatFromSockets(sockets), err
+	})
+}
+
+// udpStats returns the UDP sockets of family, with their queued bytes and
+// dropped datagrams.
+func (ns *netns) udpStats(family uint8) (info.UdpStat, error) {
+	ns.lock.Lock()
+	defer ns.lock.Unlock()
+	c := &ns.udp
+	if family == unix.AF_INET6 {
+		c = &ns.udp6
+	}
+	return c.get(func() (info.UdpStat, error) {
+		sockets, err := ns.inetSockets(family, unix.IPPROTO_UDP, 1<<(inetDiagSkMeminfo-1))
+		return udpStatFromSockets(sockets), err
+	})
+}
+
+func tcpStatFromSockets(sockets []inetSocket) info.TcpStat {
+	var s info.TcpStat
+	counters := map[uint8]*uint64{
+		unix.BPF_TCP_ESTABLISHED: &s.Established,
+		unix.BPF_TCP_SYN_SENT:    &s.SynSent,
+		unix.BPF_TCP_SYN_RECV:    &s.SynRecv,
+		tcpNewSynRecv:            &s.SynRecv,
+		unix.BPF_TCP_FIN_WAIT1:   &s.FinWait1,
+		unix.BPF_TCP_FIN_WAIT2:   &s.FinWait2,
+		unix.BPF_TCP_TIME_WAIT:   &s.TimeWait,
+		unix.BPF_TCP_CLOSE:       &s.Close,
+		unix.BPF_TCP_CLOSE_WAIT:  &s.CloseWait,
+		unix.BPF_TCP_LAST_ACK:    &s.LastAck,
+		unix.BPF_TCP_LISTEN:      &s.Listen,
+		unix.BPF_TCP_CLOSING:     &s.Closing,
+	}
+	for _, socket := range sockets {
+		if counter, ok := counters[socket.state]; ok {
+			*counter++
+		}
+	}
+	return s
+}
+
+func udpStatFromSockets(sockets []inetSocket) info.UdpStat {
+	s := info.UdpStat{Listen: uint64(len(sockets))}
+	for _, socket := range sockets {
+		s.RxQueued += uint64(socket.rqueue)
+		s.TxQueued += uint64(socket.wqueue)
+		s.Dropped += uint64(socket.drops)
+	}
+	return s
+}
+
+// inetSockets dumps the sockets of family and protocol in all states, with
+// the ext attributes of inet_diag_req_v2.
+func (ns *netns) inetSockets(family, protocol, ext uint8) ([]inetSocket, error) {
+	req := make([]byte, sizeofInetDiagReqV2)
+	req[0], req[1], req[2] = family, protocol, ext
+	binary.NativeEndian.PutUint32(req[4:], ^uint32(0))
+	var sockets []inetSocket
+	err := ns.dump(ns.sockDiag, unix.SOCK_DIAG_BY_FAMILY, req, func(m syscall.NetlinkMessage) error {
+		if m.Header.Type != unix.SOCK_DIAG_BY_FAMILY {
+			return nil
+		}
+		socket, err := parseInetDiagMsg(m.Data)
+		if err != nil {
+			return err
+		}
+		sockets = append(sockets, socket)
+		return nil
+	})
+	return sockets, err
+}
+
+func parseInetDiagMsg(data []byte) (inetSocket, error) {
+	if len(data) < sizeofInetDiagMsg {
+		return inetSocket{}, fmt.Errorf("inet_diag_msg of %d bytes", len(data))
+	}
+	socket := inetSocket{
+		state:  data[1],
+		rqueue: binary.NativeEndian.Uint32(data[56:]),
+		wqueue: binary.NativeEndian.Uint32(data[60:]),
+	}
+	for b := data[sizeofInetDiagMsg:]; len(b) >= unix.SizeofRtAttr; {
+		l := int(binary.NativeEndian.Uint16(b))
+		if l < unix.SizeofRtAttr || l > len(b) {
+			break
+		}
+		if binary.NativeEndian.Uint16(b[2:]) == inetDiagSkMeminfo && l >= unix.SizeofRtAttr+4*(unix.SK_MEMINFO_DROPS+1) {
+			socket.drops = binary.NativeEndian.Uint32(b[unix.SizeofRtAttr+4*unix.SK_MEMINFO_DROPS:])
+		}
+		l = (l + unix.RTA_ALIGNTO - 1) &^ (unix.RTA_ALIGNTO - 1)
+		if l > len(b) {
+			break
+		}
+		b = b[l:]
+	}
+	return socket, nil
+}
+
+// dump sends a dump request of type typ with the payload req on fd and
+// calls handle for each message of the reply.
+func (ns *netns) dump(fd int, typ uint16, req []byte, handle func(syscall.NetlinkMessage) error) error {
+	ns.seq++
+	seq := ns.seq
+	msg := make([]byte, unix.SizeofNlMsghdr, unix.SizeofNlMsghdr+len(req))
+	binary.NativeEndian.PutUint32(msg[0:], uint32(unix.SizeofNlMsghdr+len(req)))
+	binary.NativeEndian.PutUint16(msg[4:], typ)
+	binary.NativeEndian.PutUint16(msg[6:], unix.NLM_F_REQUEST|unix.NLM_F_DUMP)
+	binary.NativeEndian.PutUint32(msg[8:], seq)
+	msg = append(msg, req...)
+	if err := unix.Sendto(fd, msg, 0, &unix.SockaddrNetlink{Family: unix.AF_NETLINK}); err != nil {
+		return err
+	}
+
+	buf := make([]byte, 64*1024)
+	for {
+		n, _, err := unix.Recvfrom(fd, buf, 0)
+		if err != nil {
+			return err
+		}
+		msgs, err := syscall.ParseNetlinkMessage(buf[:n])
+		if err != nil {
+			return err
+		}
+		for _, m := range msgs {
+			if m.Header.Seq != seq {
+				// A reply to an earlier request that timed out.
+				continue
+			}
+			switch m.Header.Type {
+			case unix.NLMSG_DONE, unix.NLMSG_ERROR:
+				if len(m.Data) >= 4 {
+					if errno := int32(binary.NativeEndian.Uint32(m.Data)); errno < 0 {
+						return unix.Errno(-errno)
+					}
+				}
+				return nil
+			default:
+				if err := handle(m); err != nil {
+					return err
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,162 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package libcontainer
+
+import (
+	"encoding/binary"
+	"net"
+	"os"
+	"os/exec"
+	"syscall"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/sys/unix"
+
+	info "github.com/google/cadvisor/info/v1"
+)
+
+func TestParseLinkStats(t *testing.T) {
+	stats64 := make([]byte, 24*8)
+	for i := range 24 {
+		binary.NativeEndian.PutUint64(stats64[i*8:], uint64(i+1))
+	}
+	s, ok := parseLinkStats([]syscall.NetlinkRouteAttr{
+		{Attr: syscall.RtAttr{Type: unix.IFLA_IFNAME}, Value: []byte("eth0\x00")},
+		{Attr: syscall.RtAttr{Type: unix.IFLA_STATS64}, Value: stats64},
+	})
+	require.True(t, ok)
+	assert.Equal(t, info.InterfaceStats{
+		Name:      "eth0",
+		RxPackets: 1,
+		TxPackets: 2,
+		RxBytes:   3,
+		TxBytes:   4,
+		RxErrors:  5,
+		TxErrors:  6,
+		RxDropped: 7 + 16,
+		TxDropped: 8,
+	}, s)
+
+	_, ok = parseLinkStats([]syscall.NetlinkRouteAttr{{Attr: syscall.RtAttr{Type: unix.IFLA_IFNAME}, Value: []byte("eth0\x00")}})
+	assert.False(t, ok)
+}
+
+func TestParseInetDiagMsg(t *testing.T) {
+	msg := make([]byte, sizeofInetDiagMsg+unix.SizeofRtAttr+9*4)
+	msg[1] = unix.BPF_TCP_CLOSE
+	binary.NativeEndian.PutUint32(msg[56:], 100)
+	binary.NativeEndian.PutUint32(msg[60:], 200)
+	attr := msg[sizeofInetDiagMsg:]
+	binary.NativeEndian.PutUint16(attr, uint16(len(attr)))
+	binary.NativeEndian.PutUint16(attr[2:], inetDiagSkMeminfo)
+	binary.NativeEndian.PutUint32(attr[unix.SizeofRtAttr+4*unix.SK_MEMINFO_DROPS:], 3)
+
+	socket, err := parseInetDiagMsg(msg)
+	require.NoError(t, err)
+	assert.Equal(t, inetSocket{state: unix.BPF_TCP_CLOSE, rqueue: 100, wqueue: 200, drops: 3}, socket)
+
+	_, err = parseInetDiagMsg(msg[:sizeofInetDiagMsg-1])
+	assert.Error(t, err)
+}
+
+func TestStatsFromSockets(t *testing.T) {
+	sockets := []inetSocket{
+		{state: unix.BPF_TCP_LISTEN},
+		{state: unix.BPF_TCP_ESTABLISHED, rqueue: 1, wqueue: 2},
+		{state: unix.BPF_TCP_ESTABLISHED, drops: 4},
+		{state: tcpNewSynRecv},
+		{state: unix.BPF_TCP_TIME_WAIT},
+	}
+	assert.Equal(t, info.TcpStat{Established: 2, SynRecv: 1, TimeWait: 1, Listen: 1}, tcpStatFromSockets(sockets))
+	assert.Equal(t, info.UdpStat{Listen: 5, Dropped: 4, RxQueued: 1, TxQueued: 2}, udpStatFromSockets(sockets))
+}
+
+func TestNetns(t *testing.T) {
+	ns, err := acquireNetns("/", os.Getpid())
+	if err != nil {
+		t.Skipf("netlink is not available: %v", err)
+	}
+	other, err := acquireNetns("/", os.Getpid())
+	require.NoError(t, err)
+	assert.Same(t, ns, other)
+	other.release()
+
+	tcp, err := net.Listen("tcp4", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer tcp.Close()
+	udp, err := net.ListenPacket("udp4", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer udp.Close()
+
+	interfaces, err := ns.interfaceStats()
+	require.NoError(t, err)
+	fromProc, err := scanInterfaceStats("/proc/self/net/dev")
+	require.NoError(t, err)
+	var names, procNames []string
+	for _, s := range interfaces {
+		names = append(names, s.Name)
+	}
+	for _, s := range fromProc {
+		procNames = append(procNames, s.Name)
+	}
+	assert.ElementsMatch(t, procNames, names)
+
+	tcpStats, err := ns.tcpStats(unix.AF_INET)
+	require.NoError(t, err)
+	assert.NotZero(t, tcpStats.Listen)
+	udpStats, err := ns.udpStats(unix.AF_INET)
+	if err == nil {
+		assert.NotZero(t, udpStats.Listen)
+	} else {
+		t.Logf("udp_diag is not available: %v", err)
+	}
+
+	ns.release()
+	netnsesLock.Lock()
+	assert.Empty(t, netnses)
+	netnsesLock.Unlock()
+}
+
+func TestNetnsOfOtherNamespace(t *testing.T) {
+	// A process in a new network namespace, with only a loopback interface.
+	cmd := exec.Command("sleep", "60")
+	cmd.SysProcAttr = &syscall.SysProcAttr{Cloneflags: syscall.CLONE_NEWNET}
+	if err := cmd.Start(); err != nil {
+		t.Skipf("cannot create a network namespace: %v", err)
+	}
+	defer cmd.Wait()
+	defer cmd.Process.Kill()
+
+	ns, err := acquireNetns("/", cmd.Process.Pid)
+	require.NoError(t, err)
+	defer ns.release()
+	interfaces, err := ns.interfaceStats()
+	require.NoError(t, err)
+	assert.Empty(t, interfaces)
+	tcp, err := ns.tcpStats(unix.AF_INET)
+	require.NoError(t, err)
+	assert.Equal(t, info.TcpStat{}, tcp)
+
+	// The namespace is still queried after the process exits.
+	require.NoError(t, cmd.Process.Kill())
+	_ = cmd.Wait()
+	ns.interfaces = cachedStats[[]info.InterfaceStats]{}
+	_, err = ns.interfaceStats()
+	assert.NoError(t, err)
+}
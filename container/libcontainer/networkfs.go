@@ -0,0 +1,208 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package libcontainer
+
+import (
+	"bufio"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"k8s.io/klog/v2"
+
+	info "github.com/google/cadvisor/info/v1"
+)
+
+// cephDebugDir is where the CephFS kernel client reports the metrics of its
+// clients, relative to the root filesystem.
+const cephDebugDir = "sys/kernel/debug/ceph"
+
+// networkFsTypes are the filesystem types whose mounts are reported.
+var networkFsTypes = map[string]bool{
+	"nfs":  true,
+	"nfs4": true,
+	"ceph": true,
+}
+
+// cephFsidRegexp matches the fsid of the devices of the new CephFS mount
+// syntax, <name>@<fsid>.<fs name>=<path>.
+var cephFsidRegexp = regexp.MustCompile(`@([0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12})\.`)
+
+// networkFsStatsFromProc returns the statistics of the network filesystems
+// in the mount namespace of pid.
+func networkFsStatsFromProc(rootFs string, pid int) ([]info.NetworkFsStats, error) {
+	f, err := os.Open(filepath.Join(rootFs, "proc", strconv.Itoa(pid), "mountstats"))
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	stats, err := parseMountStats(f)
+	if err != nil {
+		return nil, err
+	}
+	for i := range stats {
+		if stats[i].Type == "ceph" {
+			if err := addCephStats(rootFs, &stats[i]); err != nil {
+				klog.V(4).Infof("Unable to get the metrics of CephFS mount %s: %v", stats[i].Mountpoint, err)
+			}
+		}
+	}
+	return stats, nil
+}
+
+// parseMountStats parses the network filesystem mounts of a mountstats file.
+// CephFS mounts have no statistics in it.
+func parseMountStats(r io.Reader) ([]info.NetworkFsStats, error) {
+	var (
+		stats   []info.NetworkFsStats
+		current *info.NetworkFsStats
+		inOps   bool
+	)
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 {
+			continue
+		}
+		// device <device> mounted on <mountpoint> with fstype <type> [statvers=<version>]
+		if fields[0] == "device" {
+			current, inOps = nil, false
+			if len(fields) >= 8 && networkFsTypes[fields[7]] {
+				stats = append(stats, info.NetworkFsStats{Device: fields[1], Mountpoint: fields[4], Type: fields[7]})
+				current = &stats[len(stats)-1]
+			}
+			continue
+		}
+		if current == nil {
+			continue
+		}
+		switch {
+		case fields[0] == "bytes:" && len(fields) >= 7:
+			// normal, direct and server read and written bytes, the last
+			// ones being the ones exchanged with the server.
+			current.ReadBytes, _ = strconv.ParseUint(fields[5], 10, 64)
+			current.WriteBytes, _ = strconv.ParseUint(fields[6], 10, 64)
+		case fields[0] == "per-op":
+			inOps = true
+		case inOps && len(fields) >= 9 && strings.HasSuffix(fields[0], ":"):
+			if op, ok := parseNfsOperation(fields); ok {
+				current.Operations = append(current.Operations, op)
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return stats, nil
+}
+
+// parseNfsOperation parses the statistics of an operation, as
+// <name>: <ops> <transmissions> <timeouts> <bytes sent> <bytes received>
+// <queue ms> <rtt ms> <execute ms> [<errors>]. Operations that were never
+// sent are skipped.
+func parseNfsOperation(fields []string) (info.NetworkFsOperationStats, bool) {
+	values := make([]uint64, len(fields)-1)
+	for i, field := range fields[1:] {
+		v, err := strconv.ParseUint(field, 10, 64)
+		if err != nil {
+			return info.NetworkFsOperationStats{}, false
+		}
+		values[i] = v
+	}
+	if values[0] == 0 {
+		return info.NetworkFsOperationStats{}, false
+	}
+	op := info.NetworkFsOperationStats{
+		Name:        strings.TrimSuffix(fields[0], ":"),
+		Count:       values[0],
+		Timeouts:    values[2],
+		RTT:         values[6],
+		ExecuteTime: values[7],
+	}
+	// The errors of operations are reported since Linux 5.2.
+	if len(values) > 8 {
+		op.Errors = values[8]
+	}
+	return op, true
+}
+
+// addCephStats adds the metrics of the CephFS client of a mount. The client
+// is found by the fsid of the device, or is the only client of the host.
+func addCephStats(rootFs string, stats *info.NetworkFsStats) error {
+	clients, err := filepath.Glob(filepath.Join(rootFs, cephDebugDir, "*.client*"))
+	if err != nil || len(clients) == 0 {
+		return err
+	}
+	if m := cephFsidRegexp.FindStringSubmatch(stats.Device); m != nil {
+		clients, _ = filepath.Glob(filepath.Join(rootFs, cephDebugDir, m[1]+".client*"))
+	}
+	if len(clients) != 1 {
+		klog.V(5).Infof("Found %d CephFS clients for %s, not reporting its metrics", len(clients), stats.Device)
+		return nil
+	}
+
+	latency, err := os.ReadFile(filepath.Join(clients[0], "metrics", "latency"))
+	if err != nil {
+		return err
+	}
+	// item total avg_lat(us) min_lat(us) max_lat(us) stdev(us)
+	for _, fields := range cephMetrics(string(latency), 3) {
+		count, _ := strconv.ParseUint(fields[1], 10, 64)
+		avg, _ := strconv.ParseUint(fields[2], 10, 64)
+		stats.Operations = append(stats.Operations, info.NetworkFsOperationStats{
+			Name:        fields[0],
+			Count:       count,
+			ExecuteTime: count * avg / 1000,
+		})
+	}
+
+	size, err := os.ReadFile(filepath.Join(clients[0], "metrics", "size"))
+	if err != nil {
+		return err
+	}
+	// item total avg_sz(bytes) min_sz(bytes) max_sz(bytes) total_sz(bytes)
+	for _, fields := range cephMetrics(string(size), 6) {
+		total, _ := strconv.ParseUint(fields[5], 10, 64)
+		switch fields[0] {
+		case "read":
+			stats.ReadBytes = total
+		case "write":
+			stats.WriteBytes = total
+		}
+	}
+	return nil
+}
+
+// cephMetrics returns the fields of the lines of a CephFS client metrics
+// file with at least n fields and a count, skipping its header.
+func cephMetrics(content string, n int) [][]string {
+	var lines [][]string
+	for _, line := range strings.Split(content, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < n {
+			continue
+		}
+		if _, err := strconv.ParseUint(fields[1], 10, 64); err != nil {
+			continue
+		}
+		lines = append(lines, fields)
+	}
+	return lines
+}
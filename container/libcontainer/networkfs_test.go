@@ -0,0 +1,114 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package libcontainer
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	info "github.com/google/cadvisor/info/v1"
+)
+
+func TestParseMountStats(t *testing.T) {
+	f, err := os.Open("testdata/mountstats")
+	require.NoError(t, err)
+	defer f.Close()
+
+	stats, err := parseMountStats(f)
+	require.NoError(t, err)
+	assert.Equal(t, []info.NetworkFsStats{
+		{
+			Device:     "10.0.0.1:/export",
+			Mountpoint: "/data",
+			Type:       "nfs4",
+			ReadBytes:  4096,
+			WriteBytes: 8192,
+			Operations: []info.NetworkFsOperationStats{
+				{Name: "NULL", Count: 1},
+				{Name: "READ", Count: 10, Timeouts: 1, RTT: 25, ExecuteTime: 30},
+				{Name: "WRITE", Count: 5, Errors: 2, RTT: 40, ExecuteTime: 45},
+				{Name: "GETATTR", Count: 20, RTT: 10, ExecuteTime: 12},
+			},
+		},
+		{
+			Device:     "10.0.0.3:/old",
+			Mountpoint: "/old",
+			Type:       "nfs",
+			ReadBytes:  100,
+			WriteBytes: 200,
+			Operations: []info.NetworkFsOperationStats{
+				{Name: "READ", Count: 3, RTT: 6, ExecuteTime: 7},
+			},
+		},
+		{
+			Device:     "admin@ab8f1d2e-1234-4cde-9f00-0123456789ab.cephfs=/",
+			Mountpoint: "/ceph",
+			Type:       "ceph",
+		},
+	}, stats)
+}
+
+func TestNetworkFsStatsFromProcCeph(t *testing.T) {
+	rootFs := t.TempDir()
+	content, err := os.ReadFile("testdata/mountstats")
+	require.NoError(t, err)
+	writeTestFile(t, filepath.Join(rootFs, "proc", "4242", "mountstats"), string(content))
+
+	client := filepath.Join(rootFs, cephDebugDir, "ab8f1d2e-1234-4cde-9f00-0123456789ab.client4151")
+	writeTestFile(t, filepath.Join(client, "metrics", "latency"), `item               total       avg_lat(us)     min_lat(us)     max_lat(us)     stdev(us)
+-----------------------------------------------------------------------------------
+read               4           2500            1000            4000            1290
+write              2           5000            4000            6000            1414
+metadata           10          300             100             900             250
+`)
+	writeTestFile(t, filepath.Join(client, "metrics", "size"), `item          total       avg_sz(bytes)   min_sz(bytes)   max_sz(bytes)  total_sz(bytes)
+----------------------------------------------------------------------------------------
+read          4           4096            4096            4096            16384
+write         2           1024            512             1536            2048
+`)
+	// The client of another cluster.
+	require.NoError(t, os.MkdirAll(filepath.Join(rootFs, cephDebugDir, "0e2f0a4c-0000-4000-8000-000000000000.client7"), 0o755))
+
+	stats, err := networkFsStatsFromProc(rootFs, 4242)
+	require.NoError(t, err)
+	require.Len(t, stats, 3)
+	assert.Equal(t, info.NetworkFsStats{
+		Device:     "admin@ab8f1d2e-1234-4cde-9f00-0123456789ab.cephfs=/",
+		Mountpoint: "/ceph",
+		Type:       "ceph",
+		ReadBytes:  16384,
+		WriteBytes: 2048,
+		Operations: []info.NetworkFsOperationStats{
+			{Name: "read", Count: 4, ExecuteTime: 10},
+			{Name: "write", Count: 2, ExecuteTime: 10},
+			{Name: "metadata", Count: 10, ExecuteTime: 3},
+		},
+	}, stats[2])
+
+	_, err = networkFsStatsFromProc(rootFs, 4343)
+	assert.Error(t, err)
+}
+
+func writeTestFile(t *testing.T, name, content string) {
+	t.Helper()
+	require.NoError(t, os.MkdirAll(filepath.Dir(name), 0o755))
+	require.NoError(t, os.WriteFile(name, []byte(content), 0o644))
+}
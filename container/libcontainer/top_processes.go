@@ -0,0 +1,131 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package libcontainer
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"os"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	info "github.com/google/cadvisor/info/v1"
+)
+
+var topProcessCount = flag.Int("top_processes", 5, "Number of processes of each container reported by the top_process metrics: the ones that used the most CPU since the previous collection, and the ones with the largest resident memory")
+
+// userHz is the unit of the CPU times in /proc/<pid>/stat. It is part of
+// the kernel ABI and 100 on all architectures Kubernetes runs on.
+const userHz = 100
+
+// topProcesses returns the n processes of pids that used the most CPU since
+// prevCPU, the cumulative CPU usage of the processes at the previous
+// collection, and the n with the largest resident memory, ordered by pid. It
+// also returns the CPU usage of all the processes, for the next collection.
+func topProcesses(rootFs string, pids []int, prevCPU map[int]uint64, n int) ([]info.ProcessUsage, map[int]uint64) {
+	type process struct {
+		usage     info.ProcessUsage
+		recentCPU uint64
+	}
+	processes := make([]process, 0, len(pids))
+	cpu := make(map[int]uint64, len(pids))
+	for _, pid := range pids {
+		usage, err := processUsage(rootFs, pid)
+		if err != nil {
+			// The process exited.
+			continue
+		}
+		cpu[pid] = usage.CpuUsage
+		recentCPU := usage.CpuUsage
+		if prev, ok := prevCPU[pid]; ok {
+			recentCPU -= min(prev, usage.CpuUsage)
+		}
+		processes = append(processes, process{usage: usage, recentCPU: recentCPU})
+	}
+	if n <= 0 {
+		return nil, cpu
+	}
+
+	top := map[int]info.ProcessUsage{}
+	slices.SortFunc(processes, func(a, b process) int {
+		return cmpDesc(a.recentCPU, b.recentCPU, a.usage.Pid, b.usage.Pid)
+	})
+	for _, p := range processes[:min(n, len(processes))] {
+		top[p.usage.Pid] = p.usage
+	}
+	slices.SortFunc(processes, func(a, b process) int {
+		return cmpDesc(a.usage.RSS, b.usage.RSS, a.usage.Pid, b.usage.Pid)
+	})
+	for _, p := range processes[:min(n, len(processes))] {
+		top[p.usage.Pid] = p.usage
+	}
+
+	usages := make([]info.ProcessUsage, 0, len(top))
+	for _, usage := range top {
+		usages = append(usages, usage)
+	}
+	slices.SortFunc(usages, func(a, b info.ProcessUsage) int { return a.Pid - b.Pid })
+	return usages, cpu
+}
+
+// cmpDesc orders by decreasing value, and then by pid for ties.
+func cmpDesc(a, b uint64, pidA, pidB int) int {
+	switch {
+	case a > b:
+		return -1
+	case a < b:
+		return 1
+	}
+	return pidA - pidB
+}
+
+// processUsage returns the command, CPU time and resident memory of a
+// process, from /proc/<pid>/stat.
+func processUsage(rootFs string, pid int) (info.ProcessUsage, error) {
+	statFile := path.Join(rootFs, "proc", strconv.Itoa(pid), "stat")
+	stat, err := os.ReadFile(statFile)
+	if err != nil {
+		return info.ProcessUsage{}, err
+	}
+	// The command name is in parentheses and may contain spaces, the fields
+	// after it do not.
+	start, end := bytes.IndexByte(stat, '('), bytes.LastIndexByte(stat, ')')
+	if start < 0 || end < start {
+		return info.ProcessUsage{}, fmt.Errorf("malformed %s", statFile)
+	}
+	// Fields after the command, starting at field 3 (state).
+	fields := strings.Fields(string(stat[end+1:]))
+	if len(fields) < 22 {
+		return info.ProcessUsage{}, fmt.Errorf("malformed %s", statFile)
+	}
+	var values [3]uint64
+	for i, field := range []int{11, 12, 21} { // utime, stime and rss
+		if values[i], err = strconv.ParseUint(fields[field], 10, 64); err != nil {
+			return info.ProcessUsage{}, fmt.Errorf("malformed %s: %v", statFile, err)
+		}
+	}
+	return info.ProcessUsage{
+		Pid:      pid,
+		Command:  string(stat[start+1 : end]),
+		CpuUsage: uint64(time.Duration(values[0]+values[1]) * time.Second / userHz),
+		RSS:      values[2] * uint64(os.Getpagesize()),
+	}, nil
+}
@@ -0,0 +1,91 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package libcontainer
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	info "github.com/google/cadvisor/info/v1"
+)
+
+// writeProcStat writes /proc/<pid>/stat of a process with the given CPU
+// times, in clock ticks, and resident memory, in pages.
+func writeProcStat(t *testing.T, rootFs string, pid int, comm string, utime, stime, rss uint64) {
+	dir := filepath.Join(rootFs, "proc", strconv.Itoa(pid))
+	require.NoError(t, os.MkdirAll(dir, 0o755))
+	stat := fmt.Sprintf("%d (%s) S 1 1 1 0 -1 4194560 100 0 0 0 %d %d 0 0 20 0 1 0 100 1000000 %d 18446744073709551615 0 0 0 0 0 0 0 0 0 0 0 0 17 0 0 0 0 0 0\n", pid, comm, utime, stime, rss)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "stat"), []byte(stat), 0o644))
+}
+
+func TestProcessUsage(t *testing.T) {
+	rootFs := t.TempDir()
+	writeProcStat(t, rootFs, 42, "my (worker) 2", 150, 50, 10)
+
+	usage, err := processUsage(rootFs, 42)
+	require.NoError(t, err)
+	assert.Equal(t, info.ProcessUsage{
+		Pid:      42,
+		Command:  "my (worker) 2",
+		CpuUsage: 2000000000,
+		RSS:      10 * uint64(os.Getpagesize()),
+	}, usage)
+
+	_, err = processUsage(rootFs, 43)
+	assert.Error(t, err)
+}
+
+func TestTopProcesses(t *testing.T) {
+	rootFs := t.TempDir()
+	page := uint64(os.Getpagesize())
+	writeProcStat(t, rootFs, 1, "init", 1000, 0, 1)
+	writeProcStat(t, rootFs, 2, "leaky", 10, 0, 1000)
+	writeProcStat(t, rootFs, 3, "busy", 500, 0, 10)
+	writeProcStat(t, rootFs, 4, "idle", 0, 0, 1)
+
+	// Without a previous collection, processes are ranked by their total CPU
+	// usage. Exited processes are skipped.
+	top, cpu := topProcesses(rootFs, []int{1, 2, 3, 4, 5}, nil, 1)
+	assert.Equal(t, []info.ProcessUsage{
+		{Pid: 1, Command: "init", CpuUsage: 10000000000, RSS: page},
+		{Pid: 2, Command: "leaky", CpuUsage: 100000000, RSS: 1000 * page},
+	}, top)
+	assert.Len(t, cpu, 4)
+
+	// Then by their usage since the previous collection.
+	writeProcStat(t, rootFs, 3, "busy", 600, 0, 10)
+	top, _ = topProcesses(rootFs, []int{1, 2, 3, 4}, cpu, 1)
+	require.Len(t, top, 2)
+	assert.Equal(t, 2, top[0].Pid)
+	assert.Equal(t, 3, top[1].Pid)
+
+	// One process can be in both rankings.
+	top, _ = topProcesses(rootFs, []int{2, 4}, nil, 1)
+	require.Len(t, top, 1)
+	assert.Equal(t, 2, top[0].Pid)
+
+	top, _ = topProcesses(rootFs, []int{1, 2, 3, 4}, nil, 10)
+	assert.Len(t, top, 4)
+	top, _ = topProcesses(rootFs, []int{1, 2, 3, 4}, nil, 0)
+	assert.Empty(t, top)
+}
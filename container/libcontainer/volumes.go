@@ -0,0 +1,128 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package libcontainer
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+
+	mount "github.com/moby/sys/mountinfo"
+	"golang.org/x/sys/unix"
+	"k8s.io/klog/v2"
+
+	info "github.com/google/cadvisor/info/v1"
+)
+
+// virtualFsTypes are the filesystem types of mounts that are not volumes,
+// such as the pseudo filesystems of the container and the secrets runtimes
+// mount in memory.
+var virtualFsTypes = map[string]bool{
+	"autofs":      true,
+	"binfmt_misc": true,
+	"bpf":         true,
+	"cgroup":      true,
+	"cgroup2":     true,
+	"configfs":    true,
+	"debugfs":     true,
+	"devpts":      true,
+	"devtmpfs":    true,
+	"fusectl":     true,
+	"hugetlbfs":   true,
+	"mqueue":      true,
+	"nsfs":        true,
+	"overlay":     true,
+	"proc":        true,
+	"pstore":      true,
+	"securityfs":  true,
+	"sysfs":       true,
+	"tmpfs":       true,
+	"tracefs":     true,
+}
+
+// volumeStatsFromProc returns the statistics of the filesystems of the
+// directories mounted in the mount namespace of pid, other than its root.
+// Nothing is returned for processes in the mount namespace of the host, whose
+// filesystems are those of the machine.
+func volumeStatsFromProc(rootFs string, pid int) ([]info.VolumeStats, error) {
+	procDir := filepath.Join(rootFs, "proc", strconv.Itoa(pid))
+	if sameMountNamespace(filepath.Join(rootFs, "proc", "1"), procDir) {
+		return nil, nil
+	}
+	f, err := os.Open(filepath.Join(procDir, "mountinfo"))
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	mounts, err := mount.GetMountsFromReader(f, func(m *mount.Info) (bool, bool) {
+		return m.Mountpoint == "/" || virtualFsTypes[m.FSType], false
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var volumes []info.VolumeStats
+	for _, m := range volumeMounts(mounts) {
+		// Files, such as /etc/hosts, are not volumes.
+		path := filepath.Join(procDir, "root", m.Mountpoint)
+		if fi, err := os.Stat(path); err != nil || !fi.IsDir() {
+			continue
+		}
+		var s unix.Statfs_t
+		if err := unix.Statfs(path, &s); err != nil {
+			klog.V(4).Infof("Unable to get the filesystem stats of volume %s of pid %d: %v", m.Mountpoint, pid, err)
+			continue
+		}
+		volumes = append(volumes, info.VolumeStats{
+			Mountpoint: m.Mountpoint,
+			Device:     m.Source,
+			Type:       m.FSType,
+			Capacity:   uint64(s.Frsize) * s.Blocks,
+			Available:  uint64(s.Frsize) * s.Bavail,
+			Inodes:     s.Files,
+			InodesFree: s.Ffree,
+		})
+	}
+	return volumes, nil
+}
+
+// volumeMounts returns the last of the mounts of each mountpoint, the one
+// visible in the container, in the order of the mountpoints.
+func volumeMounts(mounts []*mount.Info) []*mount.Info {
+	index := make(map[string]int, len(mounts))
+	var visible []*mount.Info
+	for _, m := range mounts {
+		if i, ok := index[m.Mountpoint]; ok {
+			visible[i] = m
+			continue
+		}
+		index[m.Mountpoint] = len(visible)
+		visible = append(visible, m)
+	}
+	return visible
+}
+
+// sameMountNamespace returns whether two processes are known to be in the same
+// mount namespace.
+func sameMountNamespace(procDir1, procDir2 string) bool {
+	ns1, err := os.Readlink(filepath.Join(procDir1, "ns", "mnt"))
+	if err != nil {
+		return false
+	}
+	ns2, err := os.Readlink(filepath.Join(procDir2, "ns", "mnt"))
+	return err == nil && ns1 == ns2
+}
@@ -0,0 +1,64 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package libcontainer
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	mount "github.com/moby/sys/mountinfo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVolumeMounts(t *testing.T) {
+	mounts := []*mount.Info{
+		{Mountpoint: "/data", Source: "/dev/sdb1"},
+		{Mountpoint: "/logs", Source: "/dev/sdc1"},
+		{Mountpoint: "/data", Source: "/dev/sdd1"},
+	}
+	assert.Equal(t, []*mount.Info{
+		{Mountpoint: "/data", Source: "/dev/sdd1"},
+		{Mountpoint: "/logs", Source: "/dev/sdc1"},
+	}, volumeMounts(mounts))
+}
+
+func TestVolumeStatsFromProc(t *testing.T) {
+	rootFs := t.TempDir()
+	writeTestFile(t, filepath.Join(rootFs, "proc", "4242", "mountinfo"),
+		`1500 1400 0:25 / / rw,relatime - overlay overlay rw,lowerdir=/l,upperdir=/u,workdir=/w
+1501 1500 0:26 / /proc rw,nosuid,nodev,noexec,relatime - proc proc rw
+1502 1500 0:27 / /dev rw,nosuid - tmpfs tmpfs rw,size=65536k,mode=755
+1503 1500 8:17 /var/lib/kubelet/pods/abc/volumes/kubernetes.io~empty-dir/cache /cache rw,relatime - ext4 /dev/sdb1 rw
+1504 1500 8:1 /var/lib/docker/containers/abc/hosts /etc/hosts rw,relatime - ext4 /dev/sda1 rw
+1505 1500 0:28 / /var/run/secrets/kubernetes.io/serviceaccount ro,relatime - tmpfs tmpfs rw,size=4000k
+`)
+	require.NoError(t, os.MkdirAll(filepath.Join(rootFs, "proc", "4242", "root", "cache"), 0o755))
+	writeTestFile(t, filepath.Join(rootFs, "proc", "4242", "root", "etc", "hosts"), "127.0.0.1 localhost\n")
+
+	volumes, err := volumeStatsFromProc(rootFs, 4242)
+	require.NoError(t, err)
+	require.Len(t, volumes, 1)
+	assert.Equal(t, "/cache", volumes[0].Mountpoint)
+	assert.Equal(t, "/dev/sdb1", volumes[0].Device)
+	assert.Equal(t, "ext4", volumes[0].Type)
+	assert.NotZero(t, volumes[0].Capacity)
+
+	_, err = volumeStatsFromProc(rootFs, 4343)
+	assert.Error(t, err)
+}
@@ -0,0 +1,103 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lxd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+const apiTimeout = 5 * time.Second
+
+// instance is the subset of an LXD instance cAdvisor uses.
+type instance struct {
+	Name    string            `json:"name"`
+	Project string            `json:"project"`
+	Type    string            `json:"type"`
+	Config  map[string]string `json:"config"`
+}
+
+// image returns a human readable description of the instance's image.
+func (i *instance) image() string {
+	if desc := i.Config["image.description"]; desc != "" {
+		return desc
+	}
+	if os := i.Config["image.os"]; os != "" {
+		if release := i.Config["image.release"]; release != "" {
+			return os + " " + release
+		}
+		return os
+	}
+	return ""
+}
+
+// Client talks to the LXD REST API.
+type Client interface {
+	// Instance returns the instance with the given name in the given project.
+	Instance(name, project string) (*instance, error)
+}
+
+type client struct {
+	httpClient *http.Client
+}
+
+// NewClient returns a Client for the LXD daemon listening on socket.
+func NewClient(socket string) Client {
+	return &client{
+		httpClient: &http.Client{
+			Timeout: apiTimeout,
+			Transport: &http.Transport{
+				DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+					var d net.Dialer
+					return d.DialContext(ctx, "unix", socket)
+				},
+			},
+		},
+	}
+}
+
+// response is the envelope of all synchronous LXD API responses.
+type response struct {
+	Type     string          `json:"type"`
+	Error    string          `json:"error"`
+	Metadata json.RawMessage `json:"metadata"`
+}
+
+func (c *client) Instance(name, project string) (*instance, error) {
+	u := "http://lxd/1.0/instances/" + url.PathEscape(name) + "?project=" + url.QueryEscape(project)
+	resp, err := c.httpClient.Get(u)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query LXD instance %q: %v", name, err)
+	}
+	defer resp.Body.Close()
+
+	r := response{}
+	if err := json.NewDecoder(resp.Body).Decode(&r); err != nil {
+		return nil, fmt.Errorf("failed to decode LXD response for instance %q: %v", name, err)
+	}
+	if resp.StatusCode != http.StatusOK || r.Type == "error" {
+		return nil, fmt.Errorf("LXD returned %s for instance %q: %s", resp.Status, name, r.Error)
+	}
+	inst := &instance{}
+	if err := json.Unmarshal(r.Metadata, inst); err != nil {
+		return nil, fmt.Errorf("failed to decode LXD instance %q: %v", name, err)
+	}
+	return inst, nil
+}
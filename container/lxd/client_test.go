@@ -0,0 +1,52 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lxd
+
+import (
+	"net"
+	"net/http"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClientInstance(t *testing.T) {
+	socket := filepath.Join(t.TempDir(), "unix.socket")
+	listener, err := net.Listen("unix", socket)
+	assert.NoError(t, err)
+	mux := http.NewServeMux()
+	mux.HandleFunc("/1.0/instances/c1", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("project") != "web" {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"type":"error","error":"Instance not found","error_code":404}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"type":"sync","status":"Success","metadata":{"name":"c1","project":"web","type":"container","config":{"image.os":"Ubuntu","image.release":"noble","volatile.base_image":"abc123"}}}`))
+	})
+	server := &http.Server{Handler: mux}
+	go func() { _ = server.Serve(listener) }()
+	defer server.Close()
+
+	c := NewClient(socket)
+	inst, err := c.Instance("c1", "web")
+	assert.NoError(t, err)
+	assert.Equal(t, "container", inst.Type)
+	assert.Equal(t, "Ubuntu noble", inst.image())
+	assert.Equal(t, "abc123", inst.Config["volatile.base_image"])
+
+	_, err = c.Instance("c1", "default")
+	assert.ErrorContains(t, err, "Instance not found")
+}
@@ -0,0 +1,147 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package lxd
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/google/cadvisor/container"
+	"github.com/google/cadvisor/container/libcontainer"
+	"github.com/google/cadvisor/fs"
+	info "github.com/google/cadvisor/info/v1"
+	"github.com/google/cadvisor/watcher"
+
+	"k8s.io/klog/v2"
+)
+
+var ArgLxdSocket = flag.String("lxd_socket", "", "LXD API socket, defaults to the first existing of /var/snap/lxd/common/lxd/unix.socket and /var/lib/lxd/unix.socket")
+
+// The LXD API socket locations of the snap and the distribution packages.
+var defaultSockets = []string{
+	"/var/snap/lxd/common/lxd/unix.socket",
+	"/var/lib/lxd/unix.socket",
+}
+
+// LxdNamespace is the namespace under which LXD aliases are unique.
+const LxdNamespace = "lxd"
+
+const (
+	// payloadCgroupPrefix prefixes the cgroup of an instance with the
+	// liblxc cgroup layout used by current LXD releases.
+	payloadCgroupPrefix = "lxc.payload."
+	// legacyCgroupParent holds the instance cgroups of older releases.
+	legacyCgroupParent = "/lxc"
+
+	defaultProject = "default"
+)
+
+type lxdFactory struct {
+	machineInfoFactory info.MachineInfoFactory
+	client             Client
+	// Information about the mounted cgroup subsystems.
+	cgroupSubsystems map[string]string
+	includedMetrics  container.MetricSet
+}
+
+func (f *lxdFactory) String() string {
+	return LxdNamespace
+}
+
+func (f *lxdFactory) NewContainerHandler(name string, metadataEnvAllowList []string, inHostNamespace bool) (container.ContainerHandler, error) {
+	return newLxdContainerHandler(f.client, name, f.machineInfoFactory, f.cgroupSubsystems, inHostNamespace, f.includedMetrics)
+}
+
+// parseCgroupName returns the liblxc container name of an LXD instance
+// cgroup, e.g. "c1" for "/lxc.payload.c1" or "/lxc/c1".
+func parseCgroupName(name string) (string, bool) {
+	if path.Dir(name) == "/" && strings.HasPrefix(path.Base(name), payloadCgroupPrefix) {
+		lxcName := strings.TrimPrefix(path.Base(name), payloadCgroupPrefix)
+		return lxcName, lxcName != ""
+	}
+	if path.Dir(name) == legacyCgroupParent {
+		return path.Base(name), true
+	}
+	return "", false
+}
+
+// splitLxcName splits a liblxc container name into the LXD project and
+// instance name. LXD prefixes instances of non-default projects with
+// "<project>_"; instance names themselves cannot contain underscores.
+func splitLxcName(lxcName string) (project, name string) {
+	project, name, found := strings.Cut(lxcName, "_")
+	if !found {
+		return defaultProject, lxcName
+	}
+	return project, name
+}
+
+func (f *lxdFactory) CanHandleAndAccept(name string) (bool, bool, error) {
+	_, ok := parseCgroupName(name)
+	return ok, ok, nil
+}
+
+func (f *lxdFactory) DebugInfo() map[string][]string {
+	return map[string][]string{}
+}
+
+func pathExists(p string) bool {
+	_, err := os.Stat(p)
+	return err == nil
+}
+
+// socketPath returns the LXD API socket to use, or "" if none is found.
+func socketPath() string {
+	if *ArgLxdSocket != "" {
+		if pathExists(*ArgLxdSocket) {
+			return *ArgLxdSocket
+		}
+		return ""
+	}
+	for _, socket := range defaultSockets {
+		if pathExists(socket) {
+			return socket
+		}
+	}
+	return ""
+}
+
+// Register root container before running this function!
+func Register(factory info.MachineInfoFactory, fsInfo fs.FsInfo, includedMetrics container.MetricSet) error {
+	socket := socketPath()
+	if socket == "" {
+		return fmt.Errorf("LXD API socket not found")
+	}
+
+	cgroupSubsystems, err := libcontainer.GetCgroupSubsystems(includedMetrics)
+	if err != nil {
+		return fmt.Errorf("failed to get cgroup subsystems: %v", err)
+	}
+
+	klog.V(1).Infof("Registering LXD factory")
+	f := &lxdFactory{
+		machineInfoFactory: factory,
+		client:             NewClient(socket),
+		cgroupSubsystems:   cgroupSubsystems,
+		includedMetrics:    includedMetrics,
+	}
+	container.RegisterContainerHandlerFactory(f, []watcher.ContainerWatchSource{watcher.Raw})
+	return nil
+}
@@ -0,0 +1,50 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package lxd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseCgroupName(t *testing.T) {
+	as := assert.New(t)
+	for name, expected := range map[string]string{
+		"/lxc.payload.c1":              "c1",
+		"/lxc.payload.web_c1":          "web_c1",
+		"/lxc/c1":                      "c1",
+		"/lxc.payload.":                "",
+		"/lxc.monitor.c1":              "",
+		"/lxc.payload.c1/system.slice": "",
+		"/system.slice/lxc.payload.c1": "",
+	} {
+		lxcName, ok := parseCgroupName(name)
+		as.Equal(expected != "", ok, name)
+		as.Equal(expected, lxcName, name)
+	}
+}
+
+func TestSplitLxcName(t *testing.T) {
+	project, name := splitLxcName("c1")
+	assert.Equal(t, "default", project)
+	assert.Equal(t, "c1", name)
+
+	project, name = splitLxcName("web_c1")
+	assert.Equal(t, "web", project)
+	assert.Equal(t, "c1", name)
+}
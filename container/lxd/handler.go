@@ -0,0 +1,191 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+// Handler for LXD instances.
+package lxd
+
+import (
+	"fmt"
+
+	"k8s.io/klog/v2"
+
+	"github.com/google/cadvisor/container"
+	"github.com/google/cadvisor/container/common"
+	containerlibcontainer "github.com/google/cadvisor/container/libcontainer"
+	info "github.com/google/cadvisor/info/v1"
+)
+
+const (
+	// Labels attached to LXD instance containers.
+	InstanceLabel         = "lxd.instance"
+	ProjectLabel          = "lxd.project"
+	InstanceTypeLabel     = "lxd.instance.type"
+	ImageLabel            = "lxd.image"
+	ImageFingerprintLabel = "lxd.image.fingerprint"
+)
+
+type lxdContainerHandler struct {
+	machineInfoFactory info.MachineInfoFactory
+
+	// Absolute path to the cgroup hierarchies of this container.
+	// (e.g.: "cpu" -> "/sys/fs/cgroup/cpu/test")
+	cgroupPaths map[string]string
+
+	labels          map[string]string
+	includedMetrics container.MetricSet
+	reference       info.ContainerReference
+
+	libcontainerHandler *containerlibcontainer.Handler
+}
+
+var _ container.ContainerHandler = &lxdContainerHandler{}
+
+// newLxdContainerHandler returns a new container.ContainerHandler
+func newLxdContainerHandler(
+	client Client,
+	name string,
+	machineInfoFactory info.MachineInfoFactory,
+	cgroupSubsystems map[string]string,
+	inHostNamespace bool,
+	includedMetrics container.MetricSet,
+) (container.ContainerHandler, error) {
+	lxcName, ok := parseCgroupName(name)
+	if !ok {
+		return nil, fmt.Errorf("invalid LXD instance cgroup %q", name)
+	}
+	project, instanceName := splitLxcName(lxcName)
+
+	rootFs := "/"
+	if !inHostNamespace {
+		rootFs = "/rootfs"
+	}
+
+	cgroupPaths := common.MakeCgroupPaths(cgroupSubsystems, name)
+	cgroupManager, err := containerlibcontainer.NewCgroupManager(name, cgroupPaths)
+	if err != nil {
+		return nil, err
+	}
+
+	// System containers run their own init, which holds the network
+	// namespace of the instance.
+	pid := 0
+	if pids, err := cgroupManager.GetPids(); err == nil && len(pids) > 0 {
+		pid = pids[0]
+	}
+
+	labels := map[string]string{
+		InstanceLabel: instanceName,
+		ProjectLabel:  project,
+	}
+	// The handler is still useful without the metadata, so an unreachable
+	// LXD daemon only costs the image labels.
+	if inst, err := client.Instance(instanceName, project); err != nil {
+		klog.V(4).Infof("Failed to get LXD instance %q in project %q: %v", instanceName, project, err)
+	} else {
+		labels[InstanceTypeLabel] = inst.Type
+		if image := inst.image(); image != "" {
+			labels[ImageLabel] = image
+		}
+		if fingerprint := inst.Config["volatile.base_image"]; fingerprint != "" {
+			labels[ImageFingerprintLabel] = fingerprint
+		}
+	}
+
+	// Instance names are only unique within a project.
+	alias := instanceName
+	if project != defaultProject {
+		alias = project + "/" + instanceName
+	}
+
+	return &lxdContainerHandler{
+		machineInfoFactory: machineInfoFactory,
+		cgroupPaths:        cgroupPaths,
+		labels:             labels,
+		includedMetrics:    includedMetrics,
+		reference: info.ContainerReference{
+			Id:        lxcName,
+			Name:      name,
+			Aliases:   []string{alias},
+			Namespace: LxdNamespace,
+		},
+		libcontainerHandler: containerlibcontainer.NewHandler(cgroupManager, rootFs, pid, includedMetrics),
+	}, nil
+}
+
+func (h *lxdContainerHandler) ContainerReference() (info.ContainerReference, error) {
+	return h.reference, nil
+}
+
+func (h *lxdContainerHandler) GetSpec() (info.ContainerSpec, error) {
+	hasNetwork := h.includedMetrics.Has(container.NetworkUsageMetrics)
+	spec, err := common.GetSpec(h.cgroupPaths, h.machineInfoFactory, hasNetwork, false)
+	spec.Labels = h.labels
+	return spec, err
+}
+
+func (h *lxdContainerHandler) GetStats() (*info.ContainerStats, error) {
+	return h.libcontainerHandler.GetStats()
+}
+
+func (h *lxdContainerHandler) ListContainers(listType container.ListType) ([]info.ContainerReference, error) {
+	// System containers manage their own cgroup tree, e.g. for the
+	// services of their init system.
+	return common.ListContainers(h.reference.Name, h.cgroupPaths, listType)
+}
+
+func (h *lxdContainerHandler) ListProcesses(listType container.ListType) ([]int, error) {
+	return h.libcontainerHandler.GetProcesses()
+}
+
+func (h *lxdContainerHandler) GetCgroupPath(resource string) (string, error) {
+	var res string
+	if !common.IsCgroup2UnifiedMode() {
+		res = resource
+	}
+	path, ok := h.cgroupPaths[res]
+	if !ok {
+		return "", fmt.Errorf("could not find path for resource %q for container %q", resource, h.reference.Name)
+	}
+	return path, nil
+}
+
+func (h *lxdContainerHandler) GetContainerLabels() map[string]string {
+	return h.labels
+}
+
+func (h *lxdContainerHandler) GetContainerIPAddress() string {
+	return ""
+}
+
+func (h *lxdContainerHandler) Exists() bool {
+	return common.CgroupExists(h.cgroupPaths)
+}
+
+func (h *lxdContainerHandler) Type() container.ContainerType {
+	return container.ContainerTypeLxd
+}
+
+func (h *lxdContainerHandler) GetExitCode() (int, error) {
+	return -1, fmt.Errorf("exit code not available for LXD instances")
+}
+
+// Nothing to start up.
+func (h *lxdContainerHandler) Start() {}
+
+// Nothing to clean up.
+func (h *lxdContainerHandler) Cleanup() {
+	h.libcontainerHandler.Close()
+}
@@ -0,0 +1,93 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nomad
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const apiTimeout = 5 * time.Second
+
+// allocation is the subset of a Nomad allocation cAdvisor uses.
+type allocation struct {
+	ID        string `json:"ID"`
+	Name      string `json:"Name"`
+	Namespace string `json:"Namespace"`
+	NodeName  string `json:"NodeName"`
+	JobID     string `json:"JobID"`
+	TaskGroup string `json:"TaskGroup"`
+	Job       *struct {
+		Name string `json:"Name"`
+	} `json:"Job"`
+}
+
+// jobName returns the name of the allocation's job, which defaults to its id.
+func (a *allocation) jobName() string {
+	if a.Job != nil && a.Job.Name != "" {
+		return a.Job.Name
+	}
+	return a.JobID
+}
+
+// Client talks to the Nomad HTTP API.
+type Client interface {
+	// Allocation returns the allocation with the given id.
+	Allocation(id string) (*allocation, error)
+}
+
+type client struct {
+	addr       string
+	token      string
+	httpClient *http.Client
+}
+
+// NewClient returns a Client for the Nomad agent at addr, e.g.
+// "http://127.0.0.1:4646", authenticating with the ACL token if set.
+func NewClient(addr, token string) Client {
+	return &client{
+		addr:       strings.TrimSuffix(addr, "/"),
+		token:      token,
+		httpClient: &http.Client{Timeout: apiTimeout},
+	}
+}
+
+func (c *client) Allocation(id string) (*allocation, error) {
+	req, err := http.NewRequest(http.MethodGet, c.addr+"/v1/allocation/"+url.PathEscape(id), nil)
+	if err != nil {
+		return nil, err
+	}
+	if c.token != "" {
+		req.Header.Set("X-Nomad-Token", c.token)
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query Nomad allocation %q: %v", id, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("Nomad returned %s for allocation %q", resp.Status, id)
+	}
+
+	alloc := &allocation{}
+	if err := json.NewDecoder(resp.Body).Decode(alloc); err != nil {
+		return nil, fmt.Errorf("failed to decode Nomad allocation %q: %v", id, err)
+	}
+	return alloc, nil
+}
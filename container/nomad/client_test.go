@@ -0,0 +1,52 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nomad
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClientAllocation(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/allocation/8b1a6d4c-36c5-5f43-1c5a-0a4e4f2c9c11", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Nomad-Token") != "secret" {
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte("Permission denied"))
+			return
+		}
+		_, _ = w.Write([]byte(`{"ID":"8b1a6d4c-36c5-5f43-1c5a-0a4e4f2c9c11","Name":"web.frontend[0]","Namespace":"default","NodeName":"node-1","JobID":"web","TaskGroup":"frontend","Job":{"ID":"web","Name":"web-app"}}`))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	alloc, err := NewClient(server.URL+"/", "secret").Allocation("8b1a6d4c-36c5-5f43-1c5a-0a4e4f2c9c11")
+	assert.NoError(t, err)
+	assert.Equal(t, "frontend", alloc.TaskGroup)
+	assert.Equal(t, "web", alloc.JobID)
+	assert.Equal(t, "web-app", alloc.jobName())
+	assert.Equal(t, "node-1", alloc.NodeName)
+
+	_, err = NewClient(server.URL, "").Allocation("8b1a6d4c-36c5-5f43-1c5a-0a4e4f2c9c11")
+	assert.ErrorContains(t, err, "403")
+}
+
+func TestJobNameDefaultsToID(t *testing.T) {
+	alloc := &allocation{JobID: "batch"}
+	assert.Equal(t, "batch", alloc.jobName())
+}
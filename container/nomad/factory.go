@@ -0,0 +1,98 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package nomad
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"regexp"
+
+	"github.com/google/cadvisor/container"
+	"github.com/google/cadvisor/container/libcontainer"
+	"github.com/google/cadvisor/fs"
+	info "github.com/google/cadvisor/info/v1"
+	"github.com/google/cadvisor/watcher"
+
+	"k8s.io/klog/v2"
+)
+
+var ArgNomadAddr = flag.String("nomad_addr", "", "Nomad HTTP API address, e.g. http://127.0.0.1:4646. If set, Nomad tasks are labelled with their job and group. The ACL token is read from the NOMAD_TOKEN environment variable")
+
+// NomadNamespace is the namespace under which Nomad aliases are unique.
+const NomadNamespace = "nomad"
+
+// Task cgroups are named <alloc id>.<task> below the nomad cgroup parent and
+// its share and reserve partitions (<alloc id>.<task>.scope with systemd).
+// Older releases separate the two with a dash on cgroup v1.
+var taskCgroupRegexp = regexp.MustCompile(`^/nomad(?:\.slice)?(?:/(?:share|reserve)(?:\.slice)?)?/([0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12})[.-](.+?)(?:\.scope)?$`)
+
+type nomadFactory struct {
+	machineInfoFactory info.MachineInfoFactory
+	// Client of the Nomad API, nil if not configured.
+	client Client
+	// Information about the mounted cgroup subsystems.
+	cgroupSubsystems map[string]string
+	includedMetrics  container.MetricSet
+}
+
+func (f *nomadFactory) String() string {
+	return NomadNamespace
+}
+
+func (f *nomadFactory) NewContainerHandler(name string, metadataEnvAllowList []string, inHostNamespace bool) (container.ContainerHandler, error) {
+	return newNomadContainerHandler(f.client, name, f.machineInfoFactory, f.cgroupSubsystems, inHostNamespace, f.includedMetrics)
+}
+
+// parseCgroupName returns the allocation id and task name of a Nomad task
+// cgroup.
+func parseCgroupName(name string) (allocID, task string, ok bool) {
+	matches := taskCgroupRegexp.FindStringSubmatch(name)
+	if matches == nil {
+		return "", "", false
+	}
+	return matches[1], matches[2], true
+}
+
+func (f *nomadFactory) CanHandleAndAccept(name string) (bool, bool, error) {
+	_, _, ok := parseCgroupName(name)
+	return ok, ok, nil
+}
+
+func (f *nomadFactory) DebugInfo() map[string][]string {
+	return map[string][]string{}
+}
+
+// Register root container before running this function!
+func Register(factory info.MachineInfoFactory, fsInfo fs.FsInfo, includedMetrics container.MetricSet) error {
+	cgroupSubsystems, err := libcontainer.GetCgroupSubsystems(includedMetrics)
+	if err != nil {
+		return fmt.Errorf("failed to get cgroup subsystems: %v", err)
+	}
+
+	klog.V(1).Infof("Registering Nomad factory")
+	f := &nomadFactory{
+		machineInfoFactory: factory,
+		cgroupSubsystems:   cgroupSubsystems,
+		includedMetrics:    includedMetrics,
+	}
+	if *ArgNomadAddr != "" {
+		f.client = NewClient(*ArgNomadAddr, os.Getenv("NOMAD_TOKEN"))
+	}
+	container.RegisterContainerHandlerFactory(f, []watcher.ContainerWatchSource{watcher.Raw})
+	return nil
+}
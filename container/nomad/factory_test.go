@@ -0,0 +1,52 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package nomad
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseCgroupName(t *testing.T) {
+	const allocID = "8b1a6d4c-36c5-5f43-1c5a-0a4e4f2c9c11"
+	for _, tc := range []struct {
+		name    string
+		allocID string
+		task    string
+		ok      bool
+	}{
+		{"/nomad.slice/share.slice/" + allocID + ".redis.scope", allocID, "redis", true},
+		{"/nomad.slice/reserve.slice/" + allocID + ".api.v2.scope", allocID, "api.v2", true},
+		{"/nomad.slice/" + allocID + ".redis.scope", allocID, "redis", true},
+		{"/nomad/share/" + allocID + ".redis", allocID, "redis", true},
+		{"/nomad/" + allocID + "-log-shipper", allocID, "log-shipper", true},
+		{"/nomad.slice/docker-40af7cdcbe507acad47a5a62025743ad3ddc6ab93b77b21363aa1c1d641047c9.scope", "", "", false},
+		{"/nomad.slice", "", "", false},
+		{"/system.slice/" + allocID + ".redis.scope", "", "", false},
+	} {
+		allocID, task, ok := parseCgroupName(tc.name)
+		assert.Equal(t, tc.ok, ok, tc.name)
+		assert.Equal(t, tc.allocID, allocID, tc.name)
+		assert.Equal(t, tc.task, task, tc.name)
+
+		canHandle, canAccept, err := (&nomadFactory{}).CanHandleAndAccept(tc.name)
+		assert.NoError(t, err)
+		assert.Equal(t, tc.ok, canHandle, tc.name)
+		assert.Equal(t, tc.ok, canAccept, tc.name)
+	}
+}
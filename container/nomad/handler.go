@@ -0,0 +1,181 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+// Handler for Nomad tasks.
+package nomad
+
+import (
+	"fmt"
+
+	"k8s.io/klog/v2"
+
+	"github.com/google/cadvisor/container"
+	"github.com/google/cadvisor/container/common"
+	containerlibcontainer "github.com/google/cadvisor/container/libcontainer"
+	info "github.com/google/cadvisor/info/v1"
+)
+
+// Labels attached to Nomad tasks. They match the labels the Nomad docker
+// driver sets on task containers, so tasks look the same whatever their
+// driver.
+const (
+	AllocIDLabel   = "com.hashicorp.nomad.alloc_id"
+	TaskNameLabel  = "com.hashicorp.nomad.task_name"
+	TaskGroupLabel = "com.hashicorp.nomad.task_group_name"
+	JobIDLabel     = "com.hashicorp.nomad.job_id"
+	JobNameLabel   = "com.hashicorp.nomad.job_name"
+	NamespaceLabel = "com.hashicorp.nomad.namespace"
+	NodeNameLabel  = "com.hashicorp.nomad.node_name"
+)
+
+type nomadContainerHandler struct {
+	machineInfoFactory info.MachineInfoFactory
+
+	// Absolute path to the cgroup hierarchies of this container.
+	// (e.g.: "cpu" -> "/sys/fs/cgroup/cpu/test")
+	cgroupPaths map[string]string
+
+	labels    map[string]string
+	reference info.ContainerReference
+
+	libcontainerHandler *containerlibcontainer.Handler
+}
+
+var _ container.ContainerHandler = &nomadContainerHandler{}
+
+// newNomadContainerHandler returns a new container.ContainerHandler
+func newNomadContainerHandler(
+	client Client,
+	name string,
+	machineInfoFactory info.MachineInfoFactory,
+	cgroupSubsystems map[string]string,
+	inHostNamespace bool,
+	includedMetrics container.MetricSet,
+) (container.ContainerHandler, error) {
+	allocID, task, ok := parseCgroupName(name)
+	if !ok {
+		return nil, fmt.Errorf("invalid Nomad task cgroup %q", name)
+	}
+
+	rootFs := "/"
+	if !inHostNamespace {
+		rootFs = "/rootfs"
+	}
+
+	cgroupPaths := common.MakeCgroupPaths(cgroupSubsystems, name)
+	cgroupManager, err := containerlibcontainer.NewCgroupManager(name, cgroupPaths)
+	if err != nil {
+		return nil, err
+	}
+
+	labels := map[string]string{
+		AllocIDLabel:  allocID,
+		TaskNameLabel: task,
+	}
+	// The cgroup name is enough to identify the task, the API only adds
+	// the job metadata.
+	if client != nil {
+		if alloc, err := client.Allocation(allocID); err != nil {
+			klog.V(4).Infof("Failed to get Nomad allocation %q: %v", allocID, err)
+		} else {
+			labels[TaskGroupLabel] = alloc.TaskGroup
+			labels[JobIDLabel] = alloc.JobID
+			labels[JobNameLabel] = alloc.jobName()
+			labels[NamespaceLabel] = alloc.Namespace
+			labels[NodeNameLabel] = alloc.NodeName
+		}
+	}
+
+	// Tasks of the exec and java drivers get their own network namespace
+	// only in bridge mode, which the cgroup does not tell. Like raw
+	// containers, report no network.
+	metrics := common.RemoveNetMetrics(includedMetrics, true)
+
+	return &nomadContainerHandler{
+		machineInfoFactory: machineInfoFactory,
+		cgroupPaths:        cgroupPaths,
+		labels:             labels,
+		reference: info.ContainerReference{
+			Id:        allocID + "/" + task,
+			Name:      name,
+			Aliases:   []string{allocID + "/" + task},
+			Namespace: NomadNamespace,
+		},
+		libcontainerHandler: containerlibcontainer.NewHandler(cgroupManager, rootFs, 0, metrics),
+	}, nil
+}
+
+func (h *nomadContainerHandler) ContainerReference() (info.ContainerReference, error) {
+	return h.reference, nil
+}
+
+func (h *nomadContainerHandler) GetSpec() (info.ContainerSpec, error) {
+	spec, err := common.GetSpec(h.cgroupPaths, h.machineInfoFactory, false, false)
+	spec.Labels = h.labels
+	return spec, err
+}
+
+func (h *nomadContainerHandler) GetStats() (*info.ContainerStats, error) {
+	return h.libcontainerHandler.GetStats()
+}
+
+func (h *nomadContainerHandler) ListContainers(listType container.ListType) ([]info.ContainerReference, error) {
+	return []info.ContainerReference{}, nil
+}
+
+func (h *nomadContainerHandler) ListProcesses(listType container.ListType) ([]int, error) {
+	return h.libcontainerHandler.GetProcesses()
+}
+
+func (h *nomadContainerHandler) GetCgroupPath(resource string) (string, error) {
+	var res string
+	if !common.IsCgroup2UnifiedMode() {
+		res = resource
+	}
+	path, ok := h.cgroupPaths[res]
+	if !ok {
+		return "", fmt.Errorf("could not find path for resource %q for container %q", resource, h.reference.Name)
+	}
+	return path, nil
+}
+
+func (h *nomadContainerHandler) GetContainerLabels() map[string]string {
+	return h.labels
+}
+
+func (h *nomadContainerHandler) GetContainerIPAddress() string {
+	return ""
+}
+
+func (h *nomadContainerHandler) Exists() bool {
+	return common.CgroupExists(h.cgroupPaths)
+}
+
+func (h *nomadContainerHandler) Type() container.ContainerType {
+	return container.ContainerTypeNomad
+}
+
+func (h *nomadContainerHandler) GetExitCode() (int, error) {
+	return -1, fmt.Errorf("exit code not available for Nomad tasks")
+}
+
+// Nothing to start up.
+func (h *nomadContainerHandler) Start() {}
+
+// Nothing to clean up.
+func (h *nomadContainerHandler) Cleanup() {
+	h.libcontainerHandler.Close()
+}
@@ -35,8 +35,8 @@ type Connection struct {
 	Client *http.Client
 }
 
-func client(ctx *context.Context) (*Connection, error) {
-	url, err := urllib.Parse(*endpointFlag)
+func client(ctx *context.Context, endpoint string) (*Connection, error) {
+	url, err := urllib.Parse(endpoint)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,171 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package podman
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"time"
+
+	"k8s.io/klog/v2"
+
+	"github.com/google/cadvisor/watcher"
+)
+
+// eventsFilter selects the events the watcher needs.
+const eventsFilter = `{"type":["container"],"event":["start","died"]}`
+
+const maxEventsBackoff = time.Minute
+
+// event is the subset of a Podman event cAdvisor uses. Podman reports
+// events in both the Docker and its own format.
+type event struct {
+	Type   string `json:"Type"`
+	Action string `json:"Action"`
+	Status string `json:"Status"`
+	ID     string `json:"ID"`
+	Actor  struct {
+		ID string `json:"ID"`
+	} `json:"Actor"`
+}
+
+// eventsWatcher adds containers of a Podman service when they start, which
+// catches containers whose cgroup appears before they are running.
+type eventsWatcher struct {
+	endpoint string
+	cancel   context.CancelFunc
+
+	// Cgroups of the containers started while watching, by id. Only used
+	// by the goroutine watching the events.
+	cgroups map[string]string
+}
+
+var _ watcher.ContainerWatcher = &eventsWatcher{}
+
+func newEventsWatcher(endpoint string) *eventsWatcher {
+	return &eventsWatcher{
+		endpoint: endpoint,
+		cgroups:  map[string]string{},
+	}
+}
+
+func (w *eventsWatcher) Start(events chan watcher.ContainerEvent) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	w.cancel = cancel
+	go w.run(ctx, events)
+	return nil
+}
+
+func (w *eventsWatcher) Stop() error {
+	if w.cancel != nil {
+		w.cancel()
+	}
+	return nil
+}
+
+// run watches the events until stopped, reconnecting with a backoff when the
+// service is unavailable.
+func (w *eventsWatcher) run(ctx context.Context, events chan watcher.ContainerEvent) {
+	backoff := time.Second
+	for {
+		connected, err := w.watch(ctx, events)
+		if ctx.Err() != nil {
+			return
+		}
+		if connected {
+			backoff = time.Second
+		}
+		klog.V(2).Infof("Podman events of %q unavailable, retrying in %v: %v", w.endpoint, backoff, err)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(backoff):
+		}
+		if backoff < maxEventsBackoff {
+			backoff *= 2
+		}
+	}
+}
+
+// watch streams the events until the stream ends, and returns whether it
+// connected at all.
+func (w *eventsWatcher) watch(ctx context.Context, events chan watcher.ContainerEvent) (bool, error) {
+	conn, err := client(&ctx, w.endpoint)
+	if err != nil {
+		return false, err
+	}
+	u := libpodAPI + "/events?stream=true&filters=" + url.QueryEscape(eventsFilter)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return false, err
+	}
+	resp, err := conn.Client.Do(req)
+	if err := validateResponse(err, resp); err != nil {
+		return false, err
+	}
+	defer resp.Body.Close()
+
+	decoder := json.NewDecoder(resp.Body)
+	for {
+		e := event{}
+		if err := decoder.Decode(&e); err != nil {
+			return true, err
+		}
+		if containerEvent, ok := w.handle(e); ok {
+			select {
+			case events <- containerEvent:
+			case <-ctx.Done():
+				return true, ctx.Err()
+			}
+		}
+	}
+}
+
+// handle returns the container event for a Podman event, if any.
+func (w *eventsWatcher) handle(e event) (watcher.ContainerEvent, bool) {
+	if e.Type != "" && e.Type != "container" {
+		return watcher.ContainerEvent{}, false
+	}
+	action, id := e.Action, e.Actor.ID
+	if action == "" {
+		action = e.Status
+	}
+	if id == "" {
+		id = e.ID
+	}
+
+	switch action {
+	case "start":
+		ctnr, err := inspectContainerAt(w.endpoint, id)
+		if err != nil || ctnr.State.CgroupPath == "" {
+			klog.V(4).Infof("Unable to find the cgroup of started podman container %q: %v", id, err)
+			return watcher.ContainerEvent{}, false
+		}
+		w.cgroups[id] = ctnr.State.CgroupPath
+		return watcher.ContainerEvent{EventType: watcher.ContainerAdd, Name: ctnr.State.CgroupPath, WatchSource: watcher.Raw}, true
+	case "died":
+		cgroup, ok := w.cgroups[id]
+		if !ok {
+			return watcher.ContainerEvent{}, false
+		}
+		delete(w.cgroups, id)
+		return watcher.ContainerEvent{EventType: watcher.ContainerDelete, Name: cgroup, WatchSource: watcher.Raw}, true
+	}
+	return watcher.ContainerEvent{}, false
+}
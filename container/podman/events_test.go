@@ -0,0 +1,113 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package podman
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/google/cadvisor/watcher"
+)
+
+const testContainerID = "4f8e37a2c8e2bc3b3f2d1b0c29bb16e9e7d4d6c5d8b2a1f0e9d8c7b6a5f4e3d2"
+
+// serveLibpod serves the native Podman API on a unix socket and returns its
+// endpoint.
+func serveLibpod(t *testing.T, events string) string {
+	socket := filepath.Join(t.TempDir(), "podman.sock")
+	listener, err := net.Listen("unix", socket)
+	assert.NoError(t, err)
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v4.0.0/libpod/containers/"+testContainerID+"/json", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprintf(w, `{"Id":%q,"Name":"web","Pod":"p1","State":{"Running":true,"Pid":1234,"CgroupPath":"/machine.slice/libpod-%s.scope"},"Config":{"Labels":{"app":"web"}},"HostConfig":{"NetworkMode":"bridge","UsernsMode":"keep-id"}}`, testContainerID, testContainerID)
+	})
+	mux.HandleFunc("/v4.0.0/libpod/events", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "true", r.URL.Query().Get("stream"))
+		assert.Equal(t, eventsFilter, r.URL.Query().Get("filters"))
+		_, _ = w.Write([]byte(events))
+	})
+	server := &http.Server{Handler: mux}
+	go func() { _ = server.Serve(listener) }()
+	t.Cleanup(func() { server.Close() })
+	return "unix://" + socket
+}
+
+func TestEventsWatcher(t *testing.T) {
+	cgroup := "/machine.slice/libpod-" + testContainerID + ".scope"
+	endpoint := serveLibpod(t, fmt.Sprintf(`{"Type":"container","Action":"start","Actor":{"ID":%q}}
+{"Type":"container","Status":"start","ID":"unknown"}
+{"Type":"container","Status":"died","ID":%q}
+{"Type":"container","Status":"died","ID":"unknown"}
+`, testContainerID, testContainerID))
+
+	w := newEventsWatcher(endpoint)
+	events := make(chan watcher.ContainerEvent, 10)
+	connected, err := w.watch(t.Context(), events)
+	assert.True(t, connected)
+	assert.Error(t, err) // The stream ended.
+	close(events)
+
+	var got []watcher.ContainerEvent
+	for e := range events {
+		got = append(got, e)
+	}
+	assert.Equal(t, []watcher.ContainerEvent{
+		{EventType: watcher.ContainerAdd, Name: cgroup, WatchSource: watcher.Raw},
+		{EventType: watcher.ContainerDelete, Name: cgroup, WatchSource: watcher.Raw},
+	}, got)
+	assert.Empty(t, w.cgroups)
+}
+
+func TestEventsWatcherUnavailable(t *testing.T) {
+	w := newEventsWatcher("unix://" + filepath.Join(t.TempDir(), "missing.sock"))
+	connected, err := w.watch(t.Context(), make(chan watcher.ContainerEvent))
+	assert.False(t, connected)
+	assert.Error(t, err)
+}
+
+func TestInspectContainer(t *testing.T) {
+	endpoint := serveLibpod(t, "")
+	ctnr, err := inspectContainerAt(endpoint, testContainerID)
+	assert.NoError(t, err)
+	assert.Equal(t, "p1", ctnr.Pod)
+	assert.Equal(t, 1234, ctnr.State.Pid)
+	assert.Equal(t, "keep-id", ctnr.HostConfig.UsernsMode)
+	assert.Equal(t, map[string]string{"app": "web"}, ctnr.Config.Labels)
+
+	_, err = inspectContainerAt(endpoint, "unknown")
+	assert.Error(t, err)
+}
+
+func TestIDMap(t *testing.T) {
+	rootFs := t.TempDir()
+	write := func(pid, file, content string) {
+		assert.NoError(t, os.MkdirAll(filepath.Join(rootFs, "proc", pid), 0o755))
+		assert.NoError(t, os.WriteFile(filepath.Join(rootFs, "proc", pid, file), []byte(content), 0o644))
+	}
+	write("1", "uid_map", "         0          0 4294967295\n")
+	write("1234", "uid_map", "         0       1000          1\n         1     100000      65536\n")
+
+	assert.Equal(t, "", idMap(rootFs, 1, "uid_map"))
+	assert.Equal(t, "0 1000 1,1 100000 65536", idMap(rootFs, 1234, "uid_map"))
+	assert.Equal(t, "", idMap(rootFs, 1234, "gid_map"))
+}
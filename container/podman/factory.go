@@ -40,6 +40,7 @@ const (
 
 var (
 	endpointFlag = flag.String("podman", "unix:///var/run/podman/podman.sock", "podman endpoint")
+	eventsFlag   = flag.Bool("podman_events", true, "Discover podman containers from the events of the podman service in addition to cgroup changes")
 )
 
 var (
@@ -79,6 +80,14 @@ type podmanFactory struct {
 	thinPoolWatcher *devicemapper.ThinPoolWatcher
 
 	zfsWatcher *zfs.ZfsWatcher
+
+	// Whether there is a rootful service, or only rootless ones.
+	rootful bool
+	// Whether start events of the rootful service are watched.
+	eventsWatched bool
+
+	// Rootless podman services found so far, by uid.
+	rootlessServices *dockerutil.RootlessDaemons[*rootlessService]
 }
 
 func (f *podmanFactory) CanHandleAndAccept(name string) (handle bool, accept bool, err error) {
@@ -92,16 +101,38 @@ func (f *podmanFactory) CanHandleAndAccept(name string) (handle bool, accept boo
 
 	id := dockerutil.ContainerNameToId(name)
 
-	ctnr, err := InspectContainer(id)
+	endpoint := *endpointFlag
+	service, err := f.rootlessService(name)
+	if err != nil {
+		return false, true, err
+	}
+	if service != nil {
+		endpoint = service.endpoint
+	} else if !f.rootful {
+		return false, false, nil
+	}
+
+	ctnr, err := inspectContainerAt(endpoint, id)
 	if err != nil {
 		return false, true, fmt.Errorf("error inspecting container: %v", err)
 	}
-	if ctnr.ContainerJSONBase == nil || ctnr.State == nil || !ctnr.State.Running {
+	if !ctnr.State.Running {
+		// Containers whose cgroup shows up before they are running are
+		// added on their start event instead.
+		if f.watchesEvents(endpoint) {
+			return true, false, nil
+		}
 		return false, true, fmt.Errorf("container not running")
 	}
 	return true, true, nil
 }
 
+// watchesEvents returns whether containers of the service at endpoint are
+// discovered through its events.
+func (f *podmanFactory) watchesEvents(endpoint string) bool {
+	return f.eventsWatched && endpoint == *endpointFlag
+}
+
 func (f *podmanFactory) DebugInfo() map[string][]string {
 	return map[string][]string{}
 }
@@ -111,7 +142,20 @@ func (f *podmanFactory) String() string {
 }
 
 func (f *podmanFactory) NewContainerHandler(name string, metadataEnvAllowList []string, inHostNamespace bool) (handler container.ContainerHandler, err error) {
-	return newContainerHandler(name, f.machineInfoFactory, f.fsInfo,
+	service, err := f.rootlessService(name)
+	if err != nil {
+		return nil, err
+	}
+	if service != nil {
+		// Rootless podman supports neither devicemapper nor zfs.
+		return newContainerHandler(service.endpoint, name, f.machineInfoFactory, f.fsInfo,
+			service.storageDriver, service.storageDir, f.cgroupSubsystem, inHostNamespace,
+			metadataEnvAllowList, f.metrics, "", nil, nil)
+	}
+	if !f.rootful {
+		return nil, fmt.Errorf("no podman service handles %q", name)
+	}
+	return newContainerHandler(*endpointFlag, name, f.machineInfoFactory, f.fsInfo,
 		f.storageDriver, f.storageDir, f.cgroupSubsystem, inHostNamespace,
 		metadataEnvAllowList, f.metrics, f.thinPoolName, f.thinPoolWatcher, f.zfsWatcher)
 }
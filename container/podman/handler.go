@@ -26,7 +26,6 @@ import (
 	"time"
 
 	dockercontainer "github.com/docker/docker/api/types/container"
-	"github.com/opencontainers/cgroups"
 
 	"github.com/google/cadvisor/container"
 	"github.com/google/cadvisor/container/common"
@@ -37,9 +36,27 @@ import (
 	"github.com/google/cadvisor/fs"
 	info "github.com/google/cadvisor/info/v1"
 	"github.com/google/cadvisor/zfs"
+
+	"k8s.io/klog/v2"
+)
+
+// Labels attached to Podman containers in addition to their own.
+const (
+	PodIDLabel    = "io.podman.pod.id"
+	PodNameLabel  = "io.podman.pod.name"
+	PodInfraLabel = "io.podman.pod.infra"
+	// UsernsModeLabel holds the --userns mode, e.g. "keep-id" or "auto".
+	UsernsModeLabel = "io.podman.userns.mode"
+	// UIDMapLabel and GIDMapLabel hold the id mappings of containers in a
+	// user namespace as comma separated "<inside> <outside> <count>" ranges.
+	UIDMapLabel = "io.podman.userns.uid_map"
+	GIDMapLabel = "io.podman.userns.gid_map"
 )
 
 type containerHandler struct {
+	// endpoint of the podman service that owns the container
+	endpoint string
+
 	// machineInfoFactory provides info.MachineInfo
 	machineInfoFactory info.MachineInfoFactory
 
@@ -85,6 +102,7 @@ type containerHandler struct {
 }
 
 func newContainerHandler(
+	endpoint string,
 	name string,
 	machineInfoFactory info.MachineInfoFactory,
 	fsInfo fs.FsInfo,
@@ -121,27 +139,51 @@ func newContainerHandler(
 	id := dockerutil.ContainerNameToId(name)
 
 	// We assume that if Inspect fails then the container is not known to Podman.
-	ctnr, err := InspectContainer(id)
+	ctnr, err := inspectContainerAt(endpoint, id)
 	if err != nil {
 		return nil, err
 	}
 
-	// Obtain the IP address for the container.
-	var ipAddress string
-	if ctnr.NetworkSettings != nil && ctnr.HostConfig != nil {
-		c := ctnr
-		if ctnr.HostConfig.NetworkMode.IsContainer() {
-			// If the NetworkMode starts with 'container:' then we need to use the IP address of the container specified.
-			// This happens in cases such as kubernetes where the containers doesn't have an IP address itself and we need to use the pod's address
-			containerID := ctnr.HostConfig.NetworkMode.ConnectedContainer()
-			c, err = InspectContainer(containerID)
-			if err != nil {
-				return nil, fmt.Errorf("failed to inspect container %q: %v", containerID, err)
+	labels := make(map[string]string, len(ctnr.Config.Labels)+5)
+	for k, v := range ctnr.Config.Labels {
+		labels[k] = v
+	}
+
+	// Containers of a pod share the network of its infra container, which
+	// reports the network stats and holds the IP address of the pod.
+	networkMode := dockercontainer.NetworkMode(ctnr.HostConfig.NetworkMode)
+	ipAddress := ctnr.ipAddress()
+	if ctnr.Pod != "" {
+		labels[PodIDLabel] = ctnr.Pod
+		if ctnr.IsInfra {
+			labels[PodInfraLabel] = "true"
+		}
+		pod, err := inspectPodAt(endpoint, ctnr.Pod)
+		if err != nil {
+			klog.V(4).Infof("Failed to inspect pod %q of container %q: %v", ctnr.Pod, id, err)
+		} else {
+			labels[PodNameLabel] = pod.Name
+			if !ctnr.IsInfra && pod.InfraContainerID != "" {
+				networkMode = dockercontainer.NetworkMode("container:" + pod.InfraContainerID)
 			}
 		}
-		if nw, ok := c.NetworkSettings.Networks[c.HostConfig.NetworkMode.NetworkName()]; ok {
-			ipAddress = nw.IPAddress
+	}
+	if networkMode.IsContainer() {
+		// If the NetworkMode starts with 'container:' then we need to use the IP address of the container specified.
+		containerID := networkMode.ConnectedContainer()
+		c, err := inspectContainerAt(endpoint, containerID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to inspect container %q: %v", containerID, err)
 		}
+		ipAddress = c.ipAddress()
+	}
+
+	if ctnr.HostConfig.UsernsMode != "" {
+		labels[UsernsModeLabel] = ctnr.HostConfig.UsernsMode
+	}
+	if uidMap := idMap(rootFs, ctnr.State.Pid, "uid_map"); uidMap != "" {
+		labels[UIDMapLabel] = uidMap
+		labels[GIDMapLabel] = idMap(rootFs, ctnr.State.Pid, "gid_map")
 	}
 
 	layerID, err := rwLayerID(storageDriver, storageDir, id)
@@ -159,6 +201,7 @@ func newContainerHandler(
 	otherStorageDir := filepath.Join(storageDir, string(storageDriver)+"-containers", id)
 
 	handler := &containerHandler{
+		endpoint:           endpoint,
 		machineInfoFactory: machineInfoFactory,
 		cgroupPaths:        cgroupPaths,
 		storageDriver:      storageDriver,
@@ -166,9 +209,9 @@ func newContainerHandler(
 		rootfsStorageDir:   rootfsStorageDir,
 		ipAddress:          ipAddress,
 		envs:               make(map[string]string),
-		labels:             ctnr.Config.Labels,
-		image:              ctnr.Config.Image,
-		networkMode:        ctnr.HostConfig.NetworkMode,
+		labels:             labels,
+		image:              ctnr.ImageName,
+		networkMode:        networkMode,
 		fsHandler:          common.NewFsHandler(common.DefaultPeriod, rootfsStorageDir, otherStorageDir, fsInfo),
 		metrics:            metrics,
 		thinPoolName:       thinPoolName,
@@ -183,10 +226,7 @@ func newContainerHandler(
 		libcontainerHandler: containerlibcontainer.NewHandler(cgroupManager, rootFs, ctnr.State.Pid, metrics),
 	}
 
-	handler.creationTime, err = time.Parse(time.RFC3339, ctnr.Created)
-	if err != nil {
-		return nil, fmt.Errorf("failed to parse the create timestamp %q for container %q: %v", ctnr.Created, id, err)
-	}
+	handler.creationTime = ctnr.Created
 
 	if ctnr.RestartCount > 0 {
 		handler.labels["restartcount"] = strconv.Itoa(ctnr.RestartCount)
@@ -291,7 +331,7 @@ func (h *containerHandler) ListProcesses(container.ListType) ([]int, error) {
 
 func (h *containerHandler) GetCgroupPath(resource string) (string, error) {
 	var res string
-	if !cgroups.IsCgroup2UnifiedMode() {
+	if !common.IsCgroup2UnifiedMode() {
 		res = resource
 	}
 	cgroupPath, ok := h.cgroupPaths[res]
@@ -318,6 +358,7 @@ func (h *containerHandler) Cleanup() {
 	if h.fsHandler != nil {
 		h.fsHandler.Stop()
 	}
+	h.libcontainerHandler.Close()
 }
 
 func (h *containerHandler) Start() {
@@ -331,15 +372,11 @@ func (h *containerHandler) Type() container.ContainerType {
 }
 
 func (h *containerHandler) GetExitCode() (int, error) {
-	ctnr, err := InspectContainer(h.reference.Id)
+	ctnr, err := inspectContainerAt(h.endpoint, h.reference.Id)
 	if err != nil {
 		return -1, fmt.Errorf("failed to inspect container %s: %w", h.reference.Id, err)
 	}
 
-	if ctnr.State == nil {
-		return -1, fmt.Errorf("container state not available for %s", h.reference.Id)
-	}
-
 	if ctnr.State.Running {
 		return -1, fmt.Errorf("container %s is still running", h.reference.Id)
 	}
@@ -0,0 +1,130 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package podman
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// libpodAPI is the base URL of the native Podman REST API. Version 4.0.0 is
+// served by all Podman 4 and later releases.
+const libpodAPI = "http://d/v4.0.0/libpod"
+
+// libpodContainer is the subset of the native Podman container inspection
+// cAdvisor uses.
+type libpodContainer struct {
+	ID           string    `json:"Id"`
+	Created      time.Time `json:"Created"`
+	Name         string    `json:"Name"`
+	ImageName    string    `json:"ImageName"`
+	Pod          string    `json:"Pod"`
+	IsInfra      bool      `json:"IsInfra"`
+	RestartCount int       `json:"RestartCount"`
+	State        *struct {
+		Status     string `json:"Status"`
+		Running    bool   `json:"Running"`
+		Pid        int    `json:"Pid"`
+		ExitCode   int    `json:"ExitCode"`
+		CgroupPath string `json:"CgroupPath"`
+	} `json:"State"`
+	Config *struct {
+		Labels map[string]string `json:"Labels"`
+		Env    []string          `json:"Env"`
+	} `json:"Config"`
+	HostConfig *struct {
+		NetworkMode string `json:"NetworkMode"`
+		UsernsMode  string `json:"UsernsMode"`
+	} `json:"HostConfig"`
+	NetworkSettings *struct {
+		IPAddress string `json:"IPAddress"`
+		Networks  map[string]struct {
+			IPAddress string `json:"IPAddress"`
+		} `json:"Networks"`
+	} `json:"NetworkSettings"`
+	GraphDriver struct {
+		Name string            `json:"Name"`
+		Data map[string]string `json:"Data"`
+	} `json:"GraphDriver"`
+}
+
+// ipAddress returns the first IP address of the container.
+func (c *libpodContainer) ipAddress() string {
+	if c.NetworkSettings == nil {
+		return ""
+	}
+	if c.NetworkSettings.IPAddress != "" {
+		return c.NetworkSettings.IPAddress
+	}
+	for _, nw := range c.NetworkSettings.Networks {
+		if nw.IPAddress != "" {
+			return nw.IPAddress
+		}
+	}
+	return ""
+}
+
+// libpodPod is the subset of the native Podman pod inspection cAdvisor uses.
+type libpodPod struct {
+	ID               string `json:"Id"`
+	Name             string `json:"Name"`
+	InfraContainerID string `json:"InfraContainerID"`
+}
+
+func inspectContainerAt(endpoint, id string) (*libpodContainer, error) {
+	ctnr := &libpodContainer{}
+	if err := apiGetRequestAt(endpoint, fmt.Sprintf("%s/containers/%s/json", libpodAPI, id), ctnr); err != nil {
+		return nil, err
+	}
+	if ctnr.State == nil || ctnr.Config == nil || ctnr.HostConfig == nil {
+		return nil, fmt.Errorf("incomplete inspection of container %q", id)
+	}
+	return ctnr, nil
+}
+
+func inspectPodAt(endpoint, id string) (*libpodPod, error) {
+	pod := &libpodPod{}
+	err := apiGetRequestAt(endpoint, fmt.Sprintf("%s/pods/%s/json", libpodAPI, id), pod)
+	return pod, err
+}
+
+// idMap returns the uid_map or gid_map of a process as a comma separated list
+// of "<inside> <outside> <count>" ranges, or "" if the process is not in a
+// user namespace.
+func idMap(rootFs string, pid int, file string) string {
+	content, err := os.ReadFile(path.Join(rootFs, "proc", strconv.Itoa(pid), file))
+	if err != nil {
+		return ""
+	}
+	var ranges []string
+	for _, line := range strings.Split(strings.TrimSpace(string(content)), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) != 3 {
+			continue
+		}
+		// The initial user namespace maps all ids to themselves.
+		if fields[0] == "0" && fields[1] == "0" && fields[2] == "4294967295" {
+			return ""
+		}
+		ranges = append(ranges, strings.Join(fields, " "))
+	}
+	return strings.Join(ranges, ",")
+}
@@ -19,10 +19,10 @@ package podman
 import (
 	"fmt"
 
-	"github.com/opencontainers/cgroups"
 	"k8s.io/klog/v2"
 
 	"github.com/google/cadvisor/container"
+	"github.com/google/cadvisor/container/common"
 	"github.com/google/cadvisor/container/docker"
 	dockerutil "github.com/google/cadvisor/container/docker/utils"
 	"github.com/google/cadvisor/container/libcontainer"
@@ -61,7 +61,20 @@ func Register(factory info.MachineInfoFactory, fsInfo fs.FsInfo, metrics contain
 
 	validatedInfo, err := docker.ValidateInfo(GetInfo, VersionString)
 	if err != nil {
-		return nil, fmt.Errorf("failed to validate Podman info: %v", err)
+		err = fmt.Errorf("failed to validate Podman info: %v", err)
+		if *dockerutil.ArgRootlessRuntimeDir == "" {
+			return nil, err
+		}
+		klog.V(1).Infof("Registering Podman factory for rootless services only: %v", err)
+		f := &podmanFactory{
+			machineInfoFactory: factory,
+			cgroupSubsystem:    cgroupSubsystem,
+			fsInfo:             fsInfo,
+			metrics:            metrics,
+			rootlessServices:   dockerutil.NewRootlessDaemons(rootlessSocket, connectRootlessService),
+		}
+		container.RegisterContainerHandlerFactory(f, []watcher.ContainerWatchSource{watcher.Raw})
+		return nil, nil
 	}
 
 	var (
@@ -99,13 +112,19 @@ func Register(factory info.MachineInfoFactory, fsInfo fs.FsInfo, metrics contain
 		thinPoolName:       thinPoolName,
 		thinPoolWatcher:    thinPoolWatcher,
 		zfsWatcher:         zfsWatcher,
+		rootful:            true,
+		rootlessServices:   dockerutil.NewRootlessDaemons(rootlessSocket, connectRootlessService),
+		eventsWatched:      *eventsFlag,
 	}
 
 	container.RegisterContainerHandlerFactory(f, []watcher.ContainerWatchSource{watcher.Raw})
 
-	if !cgroups.IsCgroup2UnifiedMode() {
+	if !common.IsCgroup2UnifiedMode() {
 		klog.Warning("Podman rootless containers not working with cgroups v1!")
 	}
 
+	if *eventsFlag {
+		return newEventsWatcher(*endpointFlag), nil
+	}
 	return nil, nil
 }
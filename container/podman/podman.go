@@ -25,7 +25,6 @@ import (
 	"time"
 
 	dockertypes "github.com/docker/docker/api/types"
-	dockercontainer "github.com/docker/docker/api/types/container"
 	dockerimage "github.com/docker/docker/api/types/image"
 	dockersystem "github.com/docker/docker/api/types/system"
 
@@ -61,10 +60,14 @@ func validateResponse(gotError error, response *http.Response) error {
 }
 
 func apiGetRequest(url string, item interface{}) error {
+	return apiGetRequestAt(*endpointFlag, url, item)
+}
+
+func apiGetRequestAt(endpoint, url string, item interface{}) error {
 	ctx, cancel := context.WithTimeout(context.Background(), timeout)
 	defer cancel()
 
-	conn, err := client(&ctx)
+	conn, err := client(&ctx, endpoint)
 	if err != nil {
 		return err
 	}
@@ -158,8 +161,8 @@ func APIVersionString() (string, error) {
 	return version.APIVersion, nil
 }
 
-func InspectContainer(id string) (dockercontainer.InspectResponse, error) {
-	var data dockercontainer.InspectResponse
-	err := apiGetRequest(fmt.Sprintf("http://d/v1.0.0/containers/%s/json", id), &data)
-	return data, err
+func getInfoAt(endpoint string) (*dockersystem.Info, error) {
+	var info dockersystem.Info
+	err := apiGetRequestAt(endpoint, "http://d/v1.0.0/info", &info)
+	return &info, err
 }
@@ -0,0 +1,61 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package podman
+
+import (
+	"fmt"
+
+	"k8s.io/klog/v2"
+
+	"github.com/google/cadvisor/container/docker"
+)
+
+// rootlessSocket is the socket of a rootless podman service in its user's
+// XDG_RUNTIME_DIR.
+const rootlessSocket = "podman/podman.sock"
+
+// rootlessService is a podman service running as an unprivileged user.
+type rootlessService struct {
+	endpoint      string
+	storageDriver docker.StorageDriver
+	storageDir    string
+}
+
+// rootlessService returns the rootless podman service that owns the cgroup
+// with the given name. It returns nil if the cgroup belongs to no user or the
+// user runs no podman service.
+func (f *podmanFactory) rootlessService(name string) (*rootlessService, error) {
+	s, _, err := f.rootlessServices.Get(name)
+	return s, err
+}
+
+// connectRootlessService connects to the rootless podman service of the user
+// with the given uid at socket.
+func connectRootlessService(uid int, socket string) (*rootlessService, error) {
+	endpoint := "unix://" + socket
+	podmanInfo, err := getInfoAt(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("unable to communicate with rootless podman service of uid %d: %v", uid, err)
+	}
+
+	klog.V(1).Infof("Found rootless podman service of uid %d at %q", uid, socket)
+	return &rootlessService{
+		endpoint:      endpoint,
+		storageDriver: docker.StorageDriver(podmanInfo.Driver),
+		storageDir:    podmanInfo.DockerRootDir,
+	}, nil
+}
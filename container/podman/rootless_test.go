@@ -0,0 +1,77 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package podman
+
+import (
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	dockerutil "github.com/google/cadvisor/container/docker/utils"
+)
+
+func TestRootlessService(t *testing.T) {
+	dir := t.TempDir()
+	assert.NoError(t, os.MkdirAll(filepath.Join(dir, "1000", "podman"), 0o700))
+	socket := filepath.Join(dir, "1000", rootlessSocket)
+	listener, err := net.Listen("unix", socket)
+	assert.NoError(t, err)
+	requests := 0
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1.0.0/info", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		_, _ = w.Write([]byte(`{"Driver":"overlay","DockerRootDir":"/home/user/.local/share/containers/storage"}`))
+	})
+	server := &http.Server{Handler: mux}
+	go func() { _ = server.Serve(listener) }()
+	defer server.Close()
+
+	old := *dockerutil.ArgRootlessRuntimeDir
+	defer func() { *dockerutil.ArgRootlessRuntimeDir = old }()
+	*dockerutil.ArgRootlessRuntimeDir = dir
+
+	f := &podmanFactory{rootlessServices: dockerutil.NewRootlessDaemons(rootlessSocket, connectRootlessService)}
+	name := "/user.slice/user-1000.slice/user@1000.service/user.slice/libpod-72e5a5ff5eef3c4222a6551b992b9360a99122f77d2229783f0ee0946dfd800e.scope"
+	for i := 0; i < 2; i++ {
+		service, err := f.rootlessService(name)
+		assert.NoError(t, err)
+		assert.Equal(t, &rootlessService{
+			endpoint:      "unix://" + socket,
+			storageDriver: "overlay",
+			storageDir:    "/home/user/.local/share/containers/storage",
+		}, service)
+	}
+	assert.Equal(t, 1, requests)
+
+	service, err := f.rootlessService("/user.slice/user-1001.slice/user@1001.service/user.slice/libpod-72e5a5ff5eef3c4222a6551b992b9360a99122f77d2229783f0ee0946dfd800e.scope")
+	assert.NoError(t, err)
+	assert.Nil(t, service)
+
+	service, err = f.rootlessService("/machine.slice/libpod-72e5a5ff5eef3c4222a6551b992b9360a99122f77d2229783f0ee0946dfd800e.scope")
+	assert.NoError(t, err)
+	assert.Nil(t, service)
+
+	// Without a rootful service, other containers are left to other factories.
+	canHandle, canAccept, err := f.CanHandleAndAccept("/machine.slice/libpod-72e5a5ff5eef3c4222a6551b992b9360a99122f77d2229783f0ee0946dfd800e.scope")
+	assert.NoError(t, err)
+	assert.False(t, canHandle)
+	assert.False(t, canAccept)
+}
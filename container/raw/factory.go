@@ -20,6 +20,7 @@ import (
 	"flag"
 	"fmt"
 	"strings"
+	"sync/atomic"
 
 	"github.com/google/cadvisor/container"
 	"github.com/google/cadvisor/container/common"
@@ -36,6 +37,10 @@ var (
 	disableRootCgroupStats = flag.Bool("disable_root_cgroup_stats", false, "Disable collecting root Cgroup stats")
 )
 
+// registeredPrefixWhiteList is the cgroup path prefix whitelist of the
+// registered raw factory, a []string.
+var registeredPrefixWhiteList atomic.Value
+
 type rawFactory struct {
 	// Factory for machine information.
 	machineInfoFactory info.MachineInfoFactory
@@ -70,18 +75,33 @@ func (f *rawFactory) NewContainerHandler(name string, metadataEnvAllowList []str
 
 // The raw factory can handle any container. If --docker_only is set to true, non-docker containers are ignored except for "/" and those whitelisted by raw_cgroup_prefix_whitelist flag.
 func (f *rawFactory) CanHandleAndAccept(name string) (bool, bool, error) {
+	return true, accepts(f.rawPrefixWhiteList, name), nil
+}
+
+// Accepts returns whether the registered raw factory reports the cgroup
+// name. Other factories of plain cgroups, e.g. of systemd units, only accept
+// the cgroups it does.
+func Accepts(name string) bool {
+	whiteList, ok := registeredPrefixWhiteList.Load().([]string)
+	if !ok {
+		whiteList = []string{""}
+	}
+	return accepts(whiteList, name)
+}
+
+func accepts(rawPrefixWhiteList []string, name string) bool {
 	if name == "/" {
-		return true, true, nil
+		return true
 	}
-	if *DockerOnly && f.rawPrefixWhiteList[0] == "" {
-		return true, false, nil
+	if *DockerOnly && (len(rawPrefixWhiteList) == 0 || rawPrefixWhiteList[0] == "") {
+		return false
 	}
-	for _, prefix := range f.rawPrefixWhiteList {
+	for _, prefix := range rawPrefixWhiteList {
 		if strings.HasPrefix(name, prefix) {
-			return true, true, nil
+			return true
 		}
 	}
-	return true, false, nil
+	return false
 }
 
 func (f *rawFactory) DebugInfo() map[string][]string {
@@ -111,6 +131,7 @@ func Register(machineInfoFactory info.MachineInfoFactory, fsInfo fs.FsInfo, incl
 		includedMetrics:    includedMetrics,
 		rawPrefixWhiteList: rawPrefixWhiteList,
 	}
+	registeredPrefixWhiteList.Store(rawPrefixWhiteList)
 	container.RegisterContainerHandlerFactory(factory, []watch.ContainerWatchSource{watch.Raw})
 	return nil
 }
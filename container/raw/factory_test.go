@@ -0,0 +1,47 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package raw
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccepts(t *testing.T) {
+	for _, tc := range []struct {
+		dockerOnly bool
+		whiteList  []string
+		name       string
+		accepted   bool
+	}{
+		{false, []string{""}, "/system.slice/sshd.service", true},
+		{false, []string{"/kubepods"}, "/system.slice/sshd.service", false},
+		{false, []string{"/kubepods"}, "/kubepods/pod1", true},
+		{true, []string{""}, "/system.slice/sshd.service", false},
+		{true, []string{""}, "/", true},
+		{true, []string{"/system.slice/kubelet.service"}, "/system.slice/kubelet.service", true},
+		{true, []string{"/system.slice/kubelet.service"}, "/system.slice/sshd.service", false},
+	} {
+		*DockerOnly = tc.dockerOnly
+		assert.Equal(t, tc.accepted, accepts(tc.whiteList, tc.name), "%+v", tc)
+	}
+	*DockerOnly = false
+
+	// Everything is accepted until the raw factory is registered.
+	assert.True(t, Accepts("/system.slice/sshd.service"))
+}
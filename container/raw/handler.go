@@ -18,6 +18,7 @@
 package raw
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/google/cadvisor/container"
@@ -26,11 +27,14 @@ import (
 	"github.com/google/cadvisor/fs"
 	info "github.com/google/cadvisor/info/v1"
 	"github.com/google/cadvisor/machine"
+	"github.com/google/cadvisor/utils/tracing"
 
-	"github.com/opencontainers/cgroups"
+	"go.opentelemetry.io/otel"
 	"k8s.io/klog/v2"
 )
 
+var tracer = otel.Tracer("github.com/google/cadvisor/container/raw")
+
 type rawContainerHandler struct {
 	// Name of the container for this handler.
 	name               string
@@ -45,6 +49,12 @@ type rawContainerHandler struct {
 	includedMetrics container.MetricSet
 
 	libcontainerHandler *libcontainer.Handler
+
+	// Filesystems looked up by the last collection of disk metrics, which
+	// may happen less often than housekeeping, see --metric_group_intervals.
+	filesystems        []fs.Fs
+	filesystemsFetched bool
+	groupSchedule      container.MetricGroupSchedule
 }
 
 func isRootCgroup(name string) bool {
@@ -116,7 +126,9 @@ func (h *rawContainerHandler) GetRootNetworkDevices() ([]info.NetInfo, error) {
 func (h *rawContainerHandler) Start() {}
 
 // Nothing to clean up.
-func (h *rawContainerHandler) Cleanup() {}
+func (h *rawContainerHandler) Cleanup() {
+	h.libcontainerHandler.Close()
+}
 
 func (h *rawContainerHandler) GetSpec() (info.ContainerSpec, error) {
 	const hasNetwork = false
@@ -172,6 +184,8 @@ func fsToFsStats(fs *fs.Fs) info.FsStats {
 		HasInodes:       hasInodes,
 		Inodes:          inodes,
 		InodesFree:      inodesFree,
+		InodesUsed:      inodes - inodesFree,
+		InodesTotal:     inodes,
 		Available:       fs.Available,
 		ReadsCompleted:  fs.DiskStats.ReadsCompleted,
 		ReadsMerged:     fs.DiskStats.ReadsMerged,
@@ -195,8 +209,10 @@ func (h *rawContainerHandler) getFsStats(stats *info.ContainerStats) error {
 		return nil
 	}
 
-	// Get Filesystem information only for the root cgroup.
-	if isRootCgroup(h.name) {
+	if !h.groupSchedule.Due(container.DiskUsageMetrics, stats.Timestamp) && h.filesystemsFetched {
+		filesystems = h.filesystems
+	} else if isRootCgroup(h.name) {
+		// Get Filesystem information only for the root cgroup.
 		filesystems, err = h.fsInfo.GetGlobalFsInfo()
 		if err != nil {
 			return err
@@ -213,6 +229,8 @@ func (h *rawContainerHandler) getFsStats(stats *info.ContainerStats) error {
 			}
 		}
 	}
+	h.filesystems = filesystems
+	h.filesystemsFetched = true
 
 	if h.includedMetrics.Has(container.DiskUsageMetrics) {
 		for i := range filesystems {
@@ -229,16 +247,22 @@ func (h *rawContainerHandler) getFsStats(stats *info.ContainerStats) error {
 }
 
 func (h *rawContainerHandler) GetStats() (*info.ContainerStats, error) {
+	return h.GetStatsContext(context.Background())
+}
+
+func (h *rawContainerHandler) GetStatsContext(ctx context.Context) (*info.ContainerStats, error) {
 	if *disableRootCgroupStats && isRootCgroup(h.name) {
 		return nil, nil
 	}
-	stats, err := h.libcontainerHandler.GetStats()
+	stats, err := h.libcontainerHandler.GetStatsContext(ctx)
 	if err != nil {
 		return stats, err
 	}
 
 	// Get filesystem stats.
+	_, span := tracer.Start(ctx, "ReadFsStats")
 	err = h.getFsStats(stats)
+	tracing.End(span, err)
 	if err != nil {
 		return stats, err
 	}
@@ -248,7 +272,7 @@ func (h *rawContainerHandler) GetStats() (*info.ContainerStats, error) {
 
 func (h *rawContainerHandler) GetCgroupPath(resource string) (string, error) {
 	var res string
-	if !cgroups.IsCgroup2UnifiedMode() {
+	if !common.IsCgroup2UnifiedMode() {
 		res = resource
 	}
 	path, ok := h.cgroupPaths[res]
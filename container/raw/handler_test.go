@@ -18,6 +18,7 @@
 package raw
 
 import (
+	"context"
 	"reflect"
 	"testing"
 
@@ -67,6 +68,8 @@ func TestFsToFsStats(t *testing.T) {
 				HasInodes:       true,
 				Inodes:          inodes,
 				InodesFree:      inodesFree,
+				InodesUsed:      inodes - inodesFree,
+				InodesTotal:     inodes,
 				Available:       uint64(1024),
 				ReadsCompleted:  uint64(100),
 				ReadsMerged:     uint64(100),
@@ -188,6 +191,8 @@ func TestGetFsStats(t *testing.T) {
 					HasInodes:       true,
 					Inodes:          2000,
 					InodesFree:      1000,
+					InodesUsed:      1000,
+					InodesTotal:     2000,
 					ReadsCompleted:  1,
 					ReadsMerged:     2,
 					SectorsRead:     3,
@@ -265,6 +270,8 @@ func TestGetFsStats(t *testing.T) {
 					HasInodes:       true,
 					Inodes:          2000,
 					InodesFree:      1000,
+					InodesUsed:      1000,
+					InodesTotal:     2000,
 					ReadsCompleted:  1,
 					ReadsMerged:     2,
 					SectorsRead:     3,
@@ -455,6 +462,8 @@ func TestGetFsStats(t *testing.T) {
 					HasInodes:       true,
 					Inodes:          2000,
 					InodesFree:      1000,
+					InodesUsed:      1000,
+					InodesTotal:     2000,
 					ReadsCompleted:  1,
 					ReadsMerged:     2,
 					SectorsRead:     3,
@@ -477,6 +486,8 @@ func TestGetFsStats(t *testing.T) {
 					HasInodes:       true,
 					Inodes:          2000,
 					InodesFree:      1000,
+					InodesUsed:      1000,
+					InodesTotal:     2000,
 					ReadsCompleted:  10,
 					ReadsMerged:     20,
 					SectorsRead:     25,
@@ -558,7 +569,7 @@ func (f fsInfo) GetFsInfoForPath(mountSet map[string]struct{}) ([]fs.Fs, error)
 	return f.getFsInfoForPath(mountSet)
 }
 
-func (f fsInfo) GetDirUsage(_ string) (fs.UsageInfo, error) {
+func (f fsInfo) GetDirUsage(_ context.Context, _ string) (fs.UsageInfo, error) {
 	panic("unsupported")
 }
 
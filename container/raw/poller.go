@@ -0,0 +1,197 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package raw
+
+import (
+	"errors"
+	"flag"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/sys/unix"
+
+	"github.com/google/cadvisor/metrics/selfmetrics"
+	"github.com/google/cadvisor/watcher"
+
+	"k8s.io/klog/v2"
+)
+
+var pollInterval = flag.Duration("raw_cgroup_poll_interval", 5*time.Second, "Interval at which cgroup directories that cannot be watched with inotify (e.g. because fs.inotify.max_user_watches is exhausted) are rescanned for new and deleted containers")
+
+// isWatchLimitError returns whether err means the kernel refused to create
+// another inotify instance or watch, in which case polling is used instead.
+func isWatchLimitError(err error) bool {
+	return errors.Is(err, unix.ENOSPC) || errors.Is(err, unix.EMFILE) || errors.Is(err, unix.ENFILE)
+}
+
+// cgroupPoller discovers containers by periodically rescanning cgroup
+// subtrees that could not be watched with inotify.
+//
+// Implementation is thread-safe.
+type cgroupPoller struct {
+	// Map of polled cgroup directories to their container name.
+	roots map[string]string
+
+	// Map of polled cgroup directories to the containers last seen below them.
+	known map[string]map[string]bool
+
+	// Lock for all datastructure access.
+	lock sync.Mutex
+}
+
+func newCgroupPoller() *cgroupPoller {
+	return &cgroupPoller{
+		roots: make(map[string]string),
+		known: make(map[string]map[string]bool),
+	}
+}
+
+// addRoot starts polling dir, the cgroup directory of containerName. Add
+// events are published for the containers already below it. Returns whether
+// dir was already being polled.
+func (p *cgroupPoller) addRoot(events chan watcher.ContainerEvent, dir, containerName string) bool {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if _, ok := p.roots[dir]; ok {
+		return true
+	}
+	klog.V(2).Infof("Polling %q for containers every %v", dir, *pollInterval)
+	p.roots[dir] = containerName
+	p.known[dir] = map[string]bool{}
+	selfmetrics.PolledCgroupRoots.Inc()
+	p.scanLocked(events, dir)
+	return false
+}
+
+// removeRoot stops polling the cgroup directory dir. Delete events are
+// published for the containers that were seen below it. Returns whether dir
+// was being polled.
+func (p *cgroupPoller) removeRoot(events chan watcher.ContainerEvent, dir string) bool {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if _, ok := p.roots[dir]; !ok {
+		return false
+	}
+	publish(events, watcher.ContainerDelete, sortedNames(p.known[dir])...)
+	delete(p.roots, dir)
+	delete(p.known, dir)
+	selfmetrics.PolledCgroupRoots.Dec()
+	return true
+}
+
+// poll rescans every polled directory once.
+func (p *cgroupPoller) poll(events chan watcher.ContainerEvent) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	for dir := range p.roots {
+		p.scanLocked(events, dir)
+	}
+}
+
+// scanLocked lists the containers below dir and publishes events for the ones
+// that appeared or disappeared since the last scan. A vanished dir is dropped
+// from the polled set and reported as deleted along with everything below it.
+func (p *cgroupPoller) scanLocked(events chan watcher.ContainerEvent, dir string) {
+	current := map[string]bool{}
+	err := listContainers(dir, p.roots[dir], current)
+	if err != nil && os.IsNotExist(err) {
+		publish(events, watcher.ContainerDelete, append(sortedNames(p.known[dir]), p.roots[dir])...)
+		delete(p.roots, dir)
+		delete(p.known, dir)
+		selfmetrics.PolledCgroupRoots.Dec()
+		return
+	}
+	if err != nil {
+		klog.Warningf("Failed to poll cgroup directory %q: %v", dir, err)
+		return
+	}
+
+	known := p.known[dir]
+	var added, deleted []string
+	for name := range current {
+		if !known[name] {
+			added = append(added, name)
+		}
+	}
+	for name := range known {
+		if !current[name] {
+			deleted = append(deleted, name)
+		}
+	}
+	sort.Strings(added)
+	sort.Strings(deleted)
+	publish(events, watcher.ContainerAdd, added...)
+	publish(events, watcher.ContainerDelete, deleted...)
+	p.known[dir] = current
+}
+
+// listContainers records the names of all containers below dir, whose
+// container name is containerName, in names.
+func listContainers(dir, containerName string, names map[string]bool) error {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		name := path.Join(containerName, entry.Name())
+		names[name] = true
+		// .mount cgroups never have containers as sub-cgroups.
+		if strings.HasSuffix(name, ".mount") {
+			continue
+		}
+		err := listContainers(path.Join(dir, entry.Name()), name, names)
+		if err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// publish delivers one event per container name without blocking the caller.
+func publish(events chan watcher.ContainerEvent, eventType watcher.ContainerEventType, names ...string) {
+	if len(names) == 0 {
+		return
+	}
+	go func() {
+		for _, name := range names {
+			events <- watcher.ContainerEvent{
+				EventType:   eventType,
+				Name:        name,
+				WatchSource: watcher.Raw,
+			}
+		}
+	}()
+}
+
+func sortedNames(names map[string]bool) []string {
+	sorted := make([]string, 0, len(names))
+	for name := range names {
+		sorted = append(sorted, name)
+	}
+	sort.Strings(sorted)
+	return sorted
+}
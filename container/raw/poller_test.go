@@ -0,0 +1,152 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package raw
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/sys/unix"
+
+	"github.com/google/cadvisor/watcher"
+)
+
+// receive collects n events, sorted by type and name.
+func receive(t *testing.T, events chan watcher.ContainerEvent, n int) []watcher.ContainerEvent {
+	var received []watcher.ContainerEvent
+	for len(received) < n {
+		select {
+		case event := <-events:
+			received = append(received, event)
+		case <-time.After(5 * time.Second):
+			t.Fatalf("timed out after receiving %v", received)
+		}
+	}
+	sort.Slice(received, func(i, j int) bool {
+		if received[i].EventType != received[j].EventType {
+			return received[i].EventType < received[j].EventType
+		}
+		return received[i].Name < received[j].Name
+	})
+	return received
+}
+
+func rawEvent(eventType watcher.ContainerEventType, name string) watcher.ContainerEvent {
+	return watcher.ContainerEvent{EventType: eventType, Name: name, WatchSource: watcher.Raw}
+}
+
+func TestCgroupPoller(t *testing.T) {
+	root := t.TempDir()
+	dir := filepath.Join(root, "kubepods")
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "pod1", "ctr1"), 0o755))
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "var-lib.mount", "ignored"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "cgroup.procs"), nil, 0o644))
+
+	events := make(chan watcher.ContainerEvent)
+	p := newCgroupPoller()
+	assert.False(t, p.addRoot(events, dir, "/kubepods"))
+	assert.True(t, p.addRoot(events, dir, "/kubepods"))
+	assert.Equal(t, []watcher.ContainerEvent{
+		rawEvent(watcher.ContainerAdd, "/kubepods/pod1"),
+		rawEvent(watcher.ContainerAdd, "/kubepods/pod1/ctr1"),
+		rawEvent(watcher.ContainerAdd, "/kubepods/var-lib.mount"),
+	}, receive(t, events, 3))
+
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "pod2"), 0o755))
+	require.NoError(t, os.Remove(filepath.Join(dir, "pod1", "ctr1")))
+	p.poll(events)
+	assert.Equal(t, []watcher.ContainerEvent{
+		rawEvent(watcher.ContainerAdd, "/kubepods/pod2"),
+		rawEvent(watcher.ContainerDelete, "/kubepods/pod1/ctr1"),
+	}, receive(t, events, 2))
+
+	// Nothing changed, so nothing is published.
+	p.poll(events)
+	select {
+	case event := <-events:
+		t.Fatalf("unexpected event %+v", event)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	require.NoError(t, os.RemoveAll(dir))
+	p.poll(events)
+	assert.Equal(t, []watcher.ContainerEvent{
+		rawEvent(watcher.ContainerDelete, "/kubepods"),
+		rawEvent(watcher.ContainerDelete, "/kubepods/pod1"),
+		rawEvent(watcher.ContainerDelete, "/kubepods/pod2"),
+		rawEvent(watcher.ContainerDelete, "/kubepods/var-lib.mount"),
+	}, receive(t, events, 4))
+	assert.False(t, p.removeRoot(events, dir))
+}
+
+func TestCgroupPollerRemoveRoot(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "ctr"), 0o755))
+
+	events := make(chan watcher.ContainerEvent)
+	p := newCgroupPoller()
+	p.addRoot(events, dir, "/system.slice")
+	receive(t, events, 1)
+
+	assert.True(t, p.removeRoot(events, dir))
+	assert.Equal(t, []watcher.ContainerEvent{
+		rawEvent(watcher.ContainerDelete, "/system.slice/ctr"),
+	}, receive(t, events, 1))
+	assert.False(t, p.removeRoot(events, dir))
+}
+
+func TestIsWatchLimitError(t *testing.T) {
+	assert.True(t, isWatchLimitError(&os.PathError{Op: "inotify_add_watch", Path: "/sys/fs/cgroup", Err: unix.ENOSPC}))
+	assert.True(t, isWatchLimitError(unix.EMFILE))
+	assert.False(t, isWatchLimitError(&os.PathError{Op: "inotify_add_watch", Path: "/sys/fs/cgroup", Err: unix.ENOENT}))
+}
+
+func TestRawContainerWatcherPollsWithoutInotify(t *testing.T) {
+	oldInterval := *pollInterval
+	*pollInterval = 10 * time.Millisecond
+	defer func() { *pollInterval = oldInterval }()
+
+	root := t.TempDir()
+	require.NoError(t, os.Mkdir(filepath.Join(root, "existing"), 0o755))
+	w := &rawContainerWatcher{
+		cgroupPaths: map[string]string{"memory": root},
+		poller:      newCgroupPoller(),
+		stopWatcher: make(chan error),
+	}
+	events := make(chan watcher.ContainerEvent)
+	require.NoError(t, w.Start(events))
+	assert.Equal(t, []watcher.ContainerEvent{
+		rawEvent(watcher.ContainerAdd, "/existing"),
+	}, receive(t, events, 1))
+
+	require.NoError(t, os.Mkdir(filepath.Join(root, "new"), 0o755))
+	assert.Equal(t, []watcher.ContainerEvent{
+		rawEvent(watcher.ContainerAdd, "/new"),
+	}, receive(t, events, 1))
+
+	require.NoError(t, os.Remove(filepath.Join(root, "existing")))
+	assert.Equal(t, []watcher.ContainerEvent{
+		rawEvent(watcher.ContainerDelete, "/existing"),
+	}, receive(t, events, 1))
+
+	assert.NoError(t, w.Stop())
+}
@@ -23,6 +23,7 @@ import (
 	"os"
 	"path"
 	"strings"
+	"time"
 
 	inotify "k8s.io/utils/inotify"
 
@@ -38,9 +39,12 @@ type rawContainerWatcher struct {
 	// Absolute path to the root of the cgroup hierarchies
 	cgroupPaths map[string]string
 
-	// Inotify event watcher.
+	// Inotify event watcher, nil if inotify is unavailable.
 	watcher *common.InotifyWatcher
 
+	// Poller for the cgroup directories inotify could not watch.
+	poller *cgroupPoller
+
 	// Signal for watcher thread to stop.
 	stopWatcher chan error
 }
@@ -56,12 +60,14 @@ func NewRawContainerWatcher(includedMetrics container.MetricSet) (watcher.Contai
 
 	watcher, err := common.NewInotifyWatcher()
 	if err != nil {
-		return nil, err
+		klog.Warningf("Failed to create inotify watcher, polling cgroups every %v instead: %v", *pollInterval, err)
+		watcher = nil
 	}
 
 	rawWatcher := &rawContainerWatcher{
 		cgroupPaths: cgroupSubsystems,
 		watcher:     watcher,
+		poller:      newCgroupPoller(),
 		stopWatcher: make(chan error),
 	}
 
@@ -75,6 +81,9 @@ func (w *rawContainerWatcher) Start(events chan watcher.ContainerEvent) error {
 		_, err := w.watchDirectory(events, cgroupPath, "/")
 		if err != nil {
 			for _, watchedCgroupPath := range watched {
+				if w.watcher == nil {
+					continue
+				}
 				_, removeErr := w.watcher.RemoveWatch("/", watchedCgroupPath)
 				if removeErr != nil {
 					klog.Warningf("Failed to remove inotify watch for %q with error: %v", watchedCgroupPath, removeErr)
@@ -85,19 +94,34 @@ func (w *rawContainerWatcher) Start(events chan watcher.ContainerEvent) error {
 		watched = append(watched, cgroupPath)
 	}
 
+	// Without inotify these channels stay nil and only the poller runs.
+	var inotifyEvents chan *inotify.Event
+	var inotifyErrors chan error
+	if w.watcher != nil {
+		inotifyEvents = w.watcher.Event()
+		inotifyErrors = w.watcher.Error()
+	}
+
 	// Process the events received from the kernel.
 	go func() {
+		ticker := time.NewTicker(*pollInterval)
+		defer ticker.Stop()
 		for {
 			select {
-			case event := <-w.watcher.Event():
+			case event := <-inotifyEvents:
 				err := w.processEvent(event, events)
 				if err != nil {
 					klog.Warningf("Error while processing event (%+v): %v", event, err)
 				}
-			case err := <-w.watcher.Error():
+			case err := <-inotifyErrors:
 				klog.Warningf("Error while watching %q: %v", "/", err)
+			case <-ticker.C:
+				w.poller.poll(events)
 			case <-w.stopWatcher:
-				err := w.watcher.Close()
+				var err error
+				if w.watcher != nil {
+					err = w.watcher.Close()
+				}
 				if err == nil {
 					w.stopWatcher <- err
 					return
@@ -123,8 +147,16 @@ func (w *rawContainerWatcher) watchDirectory(events chan watcher.ContainerEvent,
 	if strings.HasSuffix(containerName, ".mount") {
 		return false, nil
 	}
+	if w.watcher == nil {
+		return w.poller.addRoot(events, dir, containerName), nil
+	}
 	alreadyWatching, err := w.watcher.AddWatch(containerName, dir)
 	if err != nil {
+		if isWatchLimitError(err) {
+			// Out of inotify watches, so poll this subtree instead.
+			klog.Warningf("Failed to watch directory %q, falling back to polling: %v", dir, err)
+			return w.poller.addRoot(events, dir, containerName), nil
+		}
 		return alreadyWatching, err
 	}
 
@@ -227,7 +259,7 @@ func (w *rawContainerWatcher) processEvent(event *inotify.Event, events chan wat
 		}
 
 		// Only report container deletion once.
-		if !lastWatched {
+		if !lastWatched && !w.poller.removeRoot(events, event.Name) {
 			return nil
 		}
 	default:
@@ -12,13 +12,19 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
+//go:build linux
+
 package systemd
 
 import (
+	"flag"
 	"fmt"
+	"path"
 	"strings"
 
 	"github.com/google/cadvisor/container"
+	"github.com/google/cadvisor/container/libcontainer"
+	"github.com/google/cadvisor/container/raw"
 	"github.com/google/cadvisor/fs"
 	info "github.com/google/cadvisor/info/v1"
 	"github.com/google/cadvisor/watcher"
@@ -26,14 +32,96 @@ import (
 	"k8s.io/klog/v2"
 )
 
-type systemdFactory struct{}
+var (
+	ArgSystemdUnits        = flag.Bool("systemd_units", false, "Report systemd slices, services, scopes, sockets and swaps as systemd containers labelled with their unit metadata")
+	ArgSystemdIgnoreScopes = flag.Bool("systemd_ignore_scopes", false, "Ignore transient systemd scope units, e.g. login sessions and systemd-run commands. Usage of ignored scopes is still included in their slice")
+)
+
+// Labels of systemd containers.
+const (
+	UnitLabel     = "systemd.unit"
+	UnitTypeLabel = "systemd.unit_type"
+	SliceLabel    = "systemd.slice"
+)
+
+// rootSlice is the slice of units directly below the root cgroup.
+const rootSlice = "-.slice"
+
+// Unit types that have a cgroup, excluding mounts which are ignored.
+var unitTypes = map[string]bool{
+	"slice":   true,
+	"service": true,
+	"scope":   true,
+	"socket":  true,
+	"swap":    true,
+}
+
+type systemdFactory struct {
+	machineInfoFactory info.MachineInfoFactory
+
+	// Information about the cgroup subsystems.
+	cgroupSubsystems map[string]string
+
+	// List of metrics to be included.
+	includedMetrics container.MetricSet
+}
 
 func (f *systemdFactory) String() string {
 	return "systemd"
 }
 
+// Fallback makes sure runtime factories get to handle the scopes of their
+// containers (e.g. docker-<id>.scope) first.
+func (f *systemdFactory) Fallback() {}
+
 func (f *systemdFactory) NewContainerHandler(name string, metadataEnvAllowList []string, inHostNamespace bool) (container.ContainerHandler, error) {
-	return nil, fmt.Errorf("not yet supported")
+	if f.cgroupSubsystems == nil {
+		return nil, fmt.Errorf("systemd units are not reported")
+	}
+	rootFs := "/"
+	if !inHostNamespace {
+		rootFs = "/rootfs"
+	}
+	return newSystemdContainerHandler(name, f.cgroupSubsystems, f.machineInfoFactory, rootFs, f.includedMetrics)
+}
+
+// unit describes the systemd unit of a cgroup.
+type unit struct {
+	name     string
+	unitType string
+	slice    string
+}
+
+// parseUnit returns the systemd unit whose cgroup is name, and whether name
+// is the cgroup of a systemd unit.
+func parseUnit(name string) (unit, bool) {
+	if name == "/" {
+		return unit{}, false
+	}
+	dir, base := path.Split(name)
+	i := strings.LastIndexByte(base, '.')
+	if i <= 0 {
+		return unit{}, false
+	}
+	u := unit{name: base, unitType: base[i+1:], slice: rootSlice}
+	if !unitTypes[u.unitType] {
+		return unit{}, false
+	}
+	if parent := path.Base(dir); strings.HasSuffix(parent, ".slice") {
+		u.slice = parent
+	} else if parent != "/" && !isUserInstance(parent) {
+		// Cgroups delegated by a unit, e.g. to a container runtime, are not
+		// units themselves. Only user instances manage units of their own,
+		// below their own root slice.
+		return unit{}, false
+	}
+	return u, true
+}
+
+// isUserInstance returns whether unit is a systemd user instance, e.g.
+// user@1000.service.
+func isUserInstance(unit string) bool {
+	return strings.HasPrefix(unit, "user@") && strings.HasSuffix(unit, ".service")
 }
 
 func (f *systemdFactory) CanHandleAndAccept(name string) (bool, bool, error) {
@@ -42,8 +130,21 @@ func (f *systemdFactory) CanHandleAndAccept(name string) (bool, bool, error) {
 	if strings.HasSuffix(name, ".mount") {
 		return true, false, nil
 	}
-	klog.V(5).Infof("%s not handled by systemd handler", name)
-	return false, false, nil
+	// The units the raw factory ignores, e.g. with --docker_only or outside of
+	// --raw_cgroup_prefix_whitelist, are left to it.
+	if f.cgroupSubsystems == nil || !raw.Accepts(name) {
+		klog.V(5).Infof("%s not handled by systemd handler", name)
+		return false, false, nil
+	}
+	u, ok := parseUnit(name)
+	if !ok {
+		klog.V(5).Infof("%s not handled by systemd handler", name)
+		return false, false, nil
+	}
+	if u.unitType == "scope" && *ArgSystemdIgnoreScopes {
+		return true, false, nil
+	}
+	return true, true, nil
 }
 
 func (f *systemdFactory) DebugInfo() map[string][]string {
@@ -53,7 +154,17 @@ func (f *systemdFactory) DebugInfo() map[string][]string {
 // Register registers the systemd container factory.
 func Register(machineInfoFactory info.MachineInfoFactory, fsInfo fs.FsInfo, includedMetrics container.MetricSet) error {
 	klog.V(1).Infof("Registering systemd factory")
-	factory := &systemdFactory{}
+	factory := &systemdFactory{
+		machineInfoFactory: machineInfoFactory,
+		includedMetrics:    includedMetrics,
+	}
+	if *ArgSystemdUnits {
+		cgroupSubsystems, err := libcontainer.GetCgroupSubsystems(includedMetrics)
+		if err != nil {
+			return fmt.Errorf("failed to get cgroup subsystems: %v", err)
+		}
+		factory.cgroupSubsystems = cgroupSubsystems
+	}
 	container.RegisterContainerHandlerFactory(factory, []watcher.ContainerWatchSource{watcher.Raw})
 	return nil
 }
@@ -0,0 +1,87 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package systemd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/google/cadvisor/container/raw"
+)
+
+func TestParseUnit(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		unit unit
+		ok   bool
+	}{
+		{"/system.slice", unit{"system.slice", "slice", rootSlice}, true},
+		{"/init.scope", unit{"init.scope", "scope", rootSlice}, true},
+		{"/system.slice/sshd.service", unit{"sshd.service", "service", "system.slice"}, true},
+		{"/system.slice/docker.socket", unit{"docker.socket", "socket", "system.slice"}, true},
+		{"/system.slice/dev-sda2.swap", unit{"dev-sda2.swap", "swap", "system.slice"}, true},
+		{"/user.slice/user-1000.slice/session-2.scope", unit{"session-2.scope", "scope", "user-1000.slice"}, true},
+		{"/user.slice/user-1000.slice/user@1000.service/app.slice", unit{"app.slice", "slice", rootSlice}, true},
+		{"/user.slice/user-1000.slice/user@1000.service/app.slice/app-foo.service", unit{"app-foo.service", "service", "app.slice"}, true},
+		{"/system.slice/docker.service/payload.scope", unit{}, false},
+		{"/system.slice/var-lib-docker.mount", unit{}, false},
+		{"/kubepods/burstable/pod068e8fa0", unit{}, false},
+		{"/lxc.payload.c1", unit{}, false},
+		{"/", unit{}, false},
+	} {
+		u, ok := parseUnit(tc.name)
+		assert.Equal(t, tc.ok, ok, tc.name)
+		assert.Equal(t, tc.unit, u, tc.name)
+	}
+}
+
+func TestCanHandleAndAccept(t *testing.T) {
+	f := &systemdFactory{cgroupSubsystems: map[string]string{}}
+	for _, tc := range []struct {
+		name         string
+		ignoreScopes bool
+		canHandle    bool
+		canAccept    bool
+	}{
+		{"/system.slice/sshd.service", false, true, true},
+		{"/system.slice/var-lib-docker.mount", false, true, false},
+		{"/user.slice/user-1000.slice/session-2.scope", false, true, true},
+		{"/user.slice/user-1000.slice/session-2.scope", true, true, false},
+		{"/user.slice", true, true, true},
+		{"/docker/40af7cdcbe50", false, false, false},
+	} {
+		*ArgSystemdIgnoreScopes = tc.ignoreScopes
+		canHandle, canAccept, err := f.CanHandleAndAccept(tc.name)
+		assert.NoError(t, err)
+		assert.Equal(t, tc.canHandle, canHandle, tc.name)
+		assert.Equal(t, tc.canAccept, canAccept, tc.name)
+	}
+	*ArgSystemdIgnoreScopes = false
+
+	// Units are left to the raw factory when they are not reported.
+	canHandle, _, err := (&systemdFactory{}).CanHandleAndAccept("/system.slice/sshd.service")
+	assert.NoError(t, err)
+	assert.False(t, canHandle)
+
+	// Nor are the units the raw factory ignores.
+	*raw.DockerOnly = true
+	defer func() { *raw.DockerOnly = false }()
+	canHandle, _, err = f.CanHandleAndAccept("/system.slice/sshd.service")
+	assert.NoError(t, err)
+	assert.False(t, canHandle)
+}
@@ -0,0 +1,149 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+// Handler for systemd units.
+package systemd
+
+import (
+	"fmt"
+
+	"github.com/opencontainers/cgroups"
+
+	"github.com/google/cadvisor/container"
+	"github.com/google/cadvisor/container/common"
+	containerlibcontainer "github.com/google/cadvisor/container/libcontainer"
+	info "github.com/google/cadvisor/info/v1"
+)
+
+type systemdContainerHandler struct {
+	name               string
+	machineInfoFactory info.MachineInfoFactory
+	// Absolute path to the cgroup hierarchies of this unit.
+	// (e.g.: "cpu" -> "/sys/fs/cgroup/cpu/system.slice/sshd.service")
+	cgroupPaths map[string]string
+	labels      map[string]string
+
+	cgroupManager       cgroups.Manager
+	libcontainerHandler *containerlibcontainer.Handler
+}
+
+var _ container.ContainerHandler = &systemdContainerHandler{}
+
+func newSystemdContainerHandler(name string, cgroupSubsystems map[string]string, machineInfoFactory info.MachineInfoFactory, rootFs string, includedMetrics container.MetricSet) (container.ContainerHandler, error) {
+	u, ok := parseUnit(name)
+	if !ok {
+		return nil, fmt.Errorf("%q is not the cgroup of a systemd unit", name)
+	}
+
+	cgroupPaths := common.MakeCgroupPaths(cgroupSubsystems, name)
+	cgroupManager, err := containerlibcontainer.NewCgroupManager(name, cgroupPaths)
+	if err != nil {
+		return nil, err
+	}
+
+	labels := map[string]string{
+		UnitLabel:     u.name,
+		UnitTypeLabel: u.unitType,
+		SliceLabel:    u.slice,
+	}
+
+	// Units share the network of the host, like raw containers.
+	metrics := common.RemoveNetMetrics(includedMetrics, true)
+
+	return &systemdContainerHandler{
+		name:                name,
+		machineInfoFactory:  machineInfoFactory,
+		cgroupPaths:         cgroupPaths,
+		labels:              labels,
+		cgroupManager:       cgroupManager,
+		libcontainerHandler: containerlibcontainer.NewHandler(cgroupManager, rootFs, 0, metrics),
+	}, nil
+}
+
+func (h *systemdContainerHandler) ContainerReference() (info.ContainerReference, error) {
+	return info.ContainerReference{
+		Name: h.name,
+	}, nil
+}
+
+// Nothing to start up.
+func (h *systemdContainerHandler) Start() {}
+
+// Nothing to clean up.
+func (h *systemdContainerHandler) Cleanup() {
+	h.libcontainerHandler.Close()
+}
+
+func (h *systemdContainerHandler) GetSpec() (info.ContainerSpec, error) {
+	const hasNetwork = false
+	const hasFilesystem = false
+	spec, err := common.GetSpec(h.cgroupPaths, h.machineInfoFactory, hasNetwork, hasFilesystem)
+	spec.Labels = h.labels
+	return spec, err
+}
+
+// GetStats returns the usage of the unit. The usage of a slice includes that
+// of all units in it, as cgroup accounting is hierarchical.
+func (h *systemdContainerHandler) GetStats() (*info.ContainerStats, error) {
+	return h.libcontainerHandler.GetStats()
+}
+
+func (h *systemdContainerHandler) GetCgroupPath(resource string) (string, error) {
+	var res string
+	if !common.IsCgroup2UnifiedMode() {
+		res = resource
+	}
+	path, ok := h.cgroupPaths[res]
+	if !ok {
+		return "", fmt.Errorf("could not find path for resource %q for container %q", resource, h.name)
+	}
+	return path, nil
+}
+
+func (h *systemdContainerHandler) GetContainerLabels() map[string]string {
+	return h.labels
+}
+
+func (h *systemdContainerHandler) GetContainerIPAddress() string {
+	// Units use the network of the host.
+	return "127.0.0.1"
+}
+
+func (h *systemdContainerHandler) ListContainers(listType container.ListType) ([]info.ContainerReference, error) {
+	return common.ListContainers(h.name, h.cgroupPaths, listType)
+}
+
+// ListProcesses returns the processes of the unit. Slices have no processes
+// of their own; when listed recursively, those of all units in the slice are
+// returned.
+func (h *systemdContainerHandler) ListProcesses(listType container.ListType) ([]int, error) {
+	if listType == container.ListRecursive {
+		return h.cgroupManager.GetAllPids()
+	}
+	return h.libcontainerHandler.GetProcesses()
+}
+
+func (h *systemdContainerHandler) Exists() bool {
+	return common.CgroupExists(h.cgroupPaths)
+}
+
+func (h *systemdContainerHandler) Type() container.ContainerType {
+	return container.ContainerTypeSystemd
+}
+
+func (h *systemdContainerHandler) GetExitCode() (int, error) {
+	return -1, fmt.Errorf("exit codes not applicable for systemd units")
+}
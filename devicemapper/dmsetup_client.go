@@ -34,6 +34,10 @@ type DmsetupClient interface {
 	// Status runs `dmsetup status` on the given device and returns the output
 	// or an error.
 	Status(deviceName string) ([]byte, error)
+	// TargetStatus runs `dmsetup status --target` for the given target type
+	// and returns the output, the status of every device with a target of
+	// the type prefixed by its name, or an error.
+	TargetStatus(target string) ([]byte, error)
 }
 
 // NewDmSetupClient returns a new DmsetupClient.
@@ -58,6 +62,10 @@ func (c *defaultDmsetupClient) Status(deviceName string) ([]byte, error) {
 	return c.dmsetup("status", deviceName)
 }
 
+func (c *defaultDmsetupClient) TargetStatus(target string) ([]byte, error) {
+	return c.dmsetup("status", "--target", target)
+}
+
 func (*defaultDmsetupClient) dmsetup(args ...string) ([]byte, error) {
 	klog.V(5).Infof("running dmsetup %v", strings.Join(args, " "))
 	return exec.Command("dmsetup", args...).Output()
@@ -51,6 +51,10 @@ func (c *FakeDmsetupClient) Status(deviceName string) ([]byte, error) {
 	return c.dmsetup("status")
 }
 
+func (c *FakeDmsetupClient) TargetStatus(target string) ([]byte, error) {
+	return c.dmsetup("status --target")
+}
+
 func (c *FakeDmsetupClient) AddCommand(name string, result string, err error) {
 	c.commands = append(c.commands, DmsetupCommand{name, result, err})
 }
@@ -0,0 +1,107 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package devicemapper
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ThinPoolStatus is the status the kernel reports for a thin-pool target.
+type ThinPoolStatus struct {
+	// Name of the device of the pool.
+	Name string
+	// Transaction id of the metadata of the pool.
+	TransactionID uint64
+	// Used and total metadata blocks, of 4KiB.
+	UsedMetadataBlocks  uint64
+	TotalMetadataBlocks uint64
+	// Used and total data blocks, of the data block size of the pool.
+	UsedDataBlocks  uint64
+	TotalDataBlocks uint64
+	// rw, ro or out_of_data_space, or fail if the pool failed.
+	Mode string
+	// Whether the metadata of the pool must be repaired.
+	NeedsCheck bool
+}
+
+// ThinPoolStatuses returns the status of every thin pool of the machine.
+func ThinPoolStatuses(dmsetup DmsetupClient) ([]ThinPoolStatus, error) {
+	output, err := dmsetup.TargetStatus("thin-pool")
+	if err != nil {
+		return nil, err
+	}
+	return parseThinPoolStatuses(string(output))
+}
+
+// parseThinPoolStatuses parses the output of dmsetup status --target
+// thin-pool, each line being
+//
+//	<name>: <start> <length> thin-pool <transaction id> <used metadata blocks>/<total metadata blocks>
+//	  <used data blocks>/<total data blocks> <held metadata root> ro|rw|out_of_data_space
+//	  [no_]discard_passdown [error|queue]_if_no_space needs_check|- [<metadata low watermark>]
+//
+// or <name>: <start> <length> thin-pool Fail for failed pools.
+func parseThinPoolStatuses(output string) ([]ThinPoolStatus, error) {
+	var statuses []ThinPoolStatus
+	for _, line := range strings.Split(output, "\n") {
+		fields := strings.Fields(line)
+		// "No devices found" when there is no pool.
+		if len(fields) < 5 || !strings.HasSuffix(fields[0], ":") || fields[3] != "thin-pool" {
+			continue
+		}
+		status := ThinPoolStatus{Name: strings.TrimSuffix(fields[0], ":")}
+		if fields[4] == "Fail" {
+			status.Mode = "fail"
+			statuses = append(statuses, status)
+			continue
+		}
+		if len(fields) < 9 {
+			return nil, fmt.Errorf("unexpected thin-pool status of %s: %q", status.Name, line)
+		}
+		var err error
+		if status.TransactionID, err = strconv.ParseUint(fields[4], 10, 64); err != nil {
+			return nil, fmt.Errorf("unexpected transaction id of thin-pool %s: %v", status.Name, err)
+		}
+		if status.UsedMetadataBlocks, status.TotalMetadataBlocks, err = parseUsedTotal(fields[5]); err != nil {
+			return nil, fmt.Errorf("unexpected metadata usage of thin-pool %s: %v", status.Name, err)
+		}
+		if status.UsedDataBlocks, status.TotalDataBlocks, err = parseUsedTotal(fields[6]); err != nil {
+			return nil, fmt.Errorf("unexpected data usage of thin-pool %s: %v", status.Name, err)
+		}
+		status.Mode = fields[8]
+		status.NeedsCheck = len(fields) > 11 && fields[11] == "needs_check"
+		statuses = append(statuses, status)
+	}
+	return statuses, nil
+}
+
+// parseUsedTotal parses <used>/<total>.
+func parseUsedTotal(field string) (uint64, uint64, error) {
+	used, total, ok := strings.Cut(field, "/")
+	if !ok {
+		return 0, 0, fmt.Errorf("expected <used>/<total>, got %q", field)
+	}
+	u, err := strconv.ParseUint(used, 10, 64)
+	if err != nil {
+		return 0, 0, err
+	}
+	t, err := strconv.ParseUint(total, 10, 64)
+	if err != nil {
+		return 0, 0, err
+	}
+	return u, t, nil
+}
@@ -0,0 +1,74 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package devicemapper
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/google/cadvisor/devicemapper/fake"
+)
+
+func TestThinPoolStatuses(t *testing.T) {
+	dmsetup := fake.NewFakeDmsetupClient(t, fake.DmsetupCommand{
+		Name: "status --target",
+		Result: `docker-thinpool: 0 209715200 thin-pool 12 2345/524288 163840/1638400 - rw no_discard_passdown queue_if_no_space - 1024
+vg0-pool0-tpool: 0 41943040 thin-pool 3 100/2560 327680/327680 - out_of_data_space discard_passdown error_if_no_space needs_check 512
+vg0-broken-tpool: 0 41943040 thin-pool Fail
+`,
+	})
+	statuses, err := ThinPoolStatuses(dmsetup)
+	require.NoError(t, err)
+	assert.Equal(t, []ThinPoolStatus{
+		{
+			Name:                "docker-thinpool",
+			TransactionID:       12,
+			UsedMetadataBlocks:  2345,
+			TotalMetadataBlocks: 524288,
+			UsedDataBlocks:      163840,
+			TotalDataBlocks:     1638400,
+			Mode:                "rw",
+		},
+		{
+			Name:                "vg0-pool0-tpool",
+			TransactionID:       3,
+			UsedMetadataBlocks:  100,
+			TotalMetadataBlocks: 2560,
+			UsedDataBlocks:      327680,
+			TotalDataBlocks:     327680,
+			Mode:                "out_of_data_space",
+			NeedsCheck:          true,
+		},
+		{Name: "vg0-broken-tpool", Mode: "fail"},
+	}, statuses)
+}
+
+func TestThinPoolStatusesNone(t *testing.T) {
+	dmsetup := fake.NewFakeDmsetupClient(t, fake.DmsetupCommand{Name: "status --target", Result: "No devices found\n"})
+	statuses, err := ThinPoolStatuses(dmsetup)
+	require.NoError(t, err)
+	assert.Empty(t, statuses)
+
+	dmsetup.AddCommand("status --target", "", errors.New("dmsetup not found"))
+	_, err = ThinPoolStatuses(dmsetup)
+	assert.Error(t, err)
+
+	dmsetup.AddCommand("status --target", "pool: 0 2048 thin-pool 1 10/20\n", nil)
+	_, err = ThinPoolStatuses(dmsetup)
+	assert.Error(t, err)
+}
@@ -16,11 +16,14 @@ package events
 
 import (
 	"errors"
+	"fmt"
 	"sort"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
 
+	"github.com/google/cadvisor/container"
 	info "github.com/google/cadvisor/info/v1"
 	"github.com/google/cadvisor/utils"
 
@@ -72,6 +75,65 @@ type Request struct {
 	// if IncludeSubcontainers is false, only events occurring in the specific
 	// container, and not the subcontainers, will be returned
 	IncludeSubcontainers bool
+	// more absolute container names, matched like ContainerName. Events of
+	// any of ContainerName and ContainerNames satisfy the request
+	ContainerNames []string
+	// if not empty, only events of containers whose labels match it satisfy
+	// the request
+	LabelSelector container.LabelSelector
+	// if set, up to MaxEventsReturned events following Cursor are returned,
+	// from the oldest, instead of the most recent ones. Must be left nil in
+	// calls to WatchEvents
+	Cursor *Cursor
+}
+
+// Cursor is a position in the chronological order of events, from which a
+// request for past events resumes.
+type Cursor struct {
+	// Timestamp of the last event returned.
+	Timestamp time.Time
+	// Number of events at Timestamp returned so far.
+	Count int
+}
+
+// String returns the opaque form of the cursor that ParseCursor reads.
+func (c Cursor) String() string {
+	return fmt.Sprintf("%d.%d", c.Timestamp.UnixNano(), c.Count)
+}
+
+// ParseCursor parses a cursor returned by Cursor.String. The empty string is
+// the cursor before the first event.
+func ParseCursor(s string) (*Cursor, error) {
+	if s == "" {
+		return &Cursor{}, nil
+	}
+	ts, count, ok := strings.Cut(s, ".")
+	nanos, err := strconv.ParseInt(ts, 10, 64)
+	if !ok || err != nil {
+		return nil, fmt.Errorf("invalid event cursor %q", s)
+	}
+	n, err := strconv.Atoi(count)
+	if err != nil || n < 0 {
+		return nil, fmt.Errorf("invalid event cursor %q", s)
+	}
+	return &Cursor{Timestamp: time.Unix(0, nanos), Count: n}, nil
+}
+
+// NextCursor returns the cursor following a page of events returned for the
+// request.
+func NextCursor(request *Request, page []*info.Event) Cursor {
+	var next Cursor
+	if request.Cursor != nil {
+		next = *request.Cursor
+	}
+	for _, event := range page {
+		if event.Timestamp.Equal(next.Timestamp) {
+			next.Count++
+			continue
+		}
+		next = Cursor{Timestamp: event.Timestamp, Count: 1}
+	}
+	return next
 }
 
 // EventManager is implemented by Events. It provides two ways to monitor
@@ -103,6 +165,11 @@ type events struct {
 	lastID int
 	// Event storage policy.
 	storagePolicy StoragePolicy
+	// store persisting the events, nil if they are only kept in memory.
+	store Store
+	// creation times of the containers still running according to the
+	// store, whose creation events are reported again at startup.
+	replayedCreations map[string]time.Time
 }
 
 // initialized by a call to WatchEvents(), a watch struct will then be added
@@ -156,6 +223,35 @@ func NewEventManager(storagePolicy StoragePolicy) EventManager {
 	}
 }
 
+// NewEventManagerWithStore returns an EventManager persisting the events to
+// store. The events of the store are replayed into memory, and GetEvents
+// serves the history held by the store.
+func NewEventManagerWithStore(storagePolicy StoragePolicy, store Store) (EventManager, error) {
+	e := &events{
+		eventStore:        make(map[info.EventType]*utils.TimedStore),
+		watchers:          make(map[int]*watch),
+		storagePolicy:     storagePolicy,
+		store:             store,
+		replayedCreations: make(map[string]time.Time),
+	}
+	replayed := 0
+	err := store.Replay(func(event *info.Event) {
+		e.updateEventStore(event)
+		switch event.EventType {
+		case info.EventContainerCreation:
+			e.replayedCreations[event.ContainerName] = event.Timestamp
+		case info.EventContainerDeletion:
+			delete(e.replayedCreations, event.ContainerName)
+		}
+		replayed++
+	})
+	if err != nil {
+		return nil, err
+	}
+	klog.V(2).Infof("Replayed %d stored events", replayed)
+	return e, nil
+}
+
 // returns a pointer to an initialized Request object
 func NewRequest() *Request {
 	return &Request{
@@ -181,8 +277,12 @@ func (ch *EventChannel) GetWatchId() int {
 	return ch.watchID
 }
 
-// sorts and returns up to the last MaxEventsReturned chronological elements
+// sorts and returns up to the last MaxEventsReturned chronological elements,
+// or the first ones following the cursor of the request
 func getMaxEventsReturned(request *Request, eSlice []*info.Event) []*info.Event {
+	if request.Cursor != nil {
+		return getPage(request, eSlice)
+	}
 	sort.Sort(byTimestamp(eSlice))
 	n := request.MaxEventsReturned
 	if n >= len(eSlice) || n <= 0 {
@@ -191,15 +291,65 @@ func getMaxEventsReturned(request *Request, eSlice []*info.Event) []*info.Event
 	return eSlice[len(eSlice)-n:]
 }
 
+// getPage returns up to MaxEventsReturned events following the cursor of the
+// request. The events are ordered by type and container within a timestamp
+// so that the pages of consecutive requests do not overlap.
+func getPage(request *Request, eSlice []*info.Event) []*info.Event {
+	sort.SliceStable(eSlice, func(i, j int) bool {
+		a, b := eSlice[i], eSlice[j]
+		if !a.Timestamp.Equal(b.Timestamp) {
+			return a.Timestamp.Before(b.Timestamp)
+		}
+		if a.EventType != b.EventType {
+			return a.EventType < b.EventType
+		}
+		return a.ContainerName < b.ContainerName
+	})
+	cursor := request.Cursor
+	start := sort.Search(len(eSlice), func(i int) bool {
+		return !eSlice[i].Timestamp.Before(cursor.Timestamp)
+	})
+	for skipped := 0; start < len(eSlice) && skipped < cursor.Count && eSlice[start].Timestamp.Equal(cursor.Timestamp); skipped++ {
+		start++
+	}
+	eSlice = eSlice[start:]
+	n := request.MaxEventsReturned
+	if n >= len(eSlice) || n <= 0 {
+		return eSlice
+	}
+	return eSlice[:n]
+}
+
 // If the request wants all subcontainers, this returns if the request's
 // container path is a prefix of the event container path.  Otherwise,
 // it checks that the container paths of the event and request are
 // equivalent
 func isSubcontainer(request *Request, event *info.Event) bool {
-	if request.IncludeSubcontainers {
-		return request.ContainerName == "/" || strings.HasPrefix(event.ContainerName+"/", request.ContainerName+"/")
+	return isInContainer(request.ContainerName, request.IncludeSubcontainers, event)
+}
+
+func isInContainer(name string, subcontainers bool, event *info.Event) bool {
+	if subcontainers {
+		return name == "/" || strings.HasPrefix(event.ContainerName+"/", name+"/")
+	}
+	return event.ContainerName == name
+}
+
+// returns whether the event occurred in any of the containers of the request,
+// or true if the request has none
+func isInRequestedContainers(request *Request, event *info.Event) bool {
+	if request.ContainerName == "" && len(request.ContainerNames) == 0 {
+		return true
 	}
-	return event.ContainerName == request.ContainerName
+	if request.ContainerName != "" && isSubcontainer(request, event) {
+		return true
+	}
+	for _, name := range request.ContainerNames {
+		if isInContainer(name, request.IncludeSubcontainers, event) {
+			return true
+		}
+	}
+	return false
 }
 
 // determines if an event occurs within the time set in the request object and is the right type
@@ -220,10 +370,10 @@ func checkIfEventSatisfiesRequest(request *Request, event *info.Event) bool {
 	if !request.EventType[event.EventType] {
 		return false
 	}
-	if request.ContainerName != "" {
-		return isSubcontainer(request, event)
+	if !request.LabelSelector.Matches(event.ContainerLabels) {
+		return false
 	}
-	return true
+	return isInRequestedContainers(request, event)
 }
 
 // method of Events object that screens Event objects found in the eventStore
@@ -232,6 +382,13 @@ func checkIfEventSatisfiesRequest(request *Request, event *info.Event) bool {
 // and StartTime/EndTime are specified in the request object, then only
 // up to the most recent MaxEventsReturned events in that time range are returned.
 func (e *events) GetEvents(request *Request) ([]*info.Event, error) {
+	if e.store != nil {
+		evs, err := e.store.Events(request)
+		if err == nil {
+			return evs, nil
+		}
+		klog.Warningf("Failed to read stored events, only returning the recent ones: %v", err)
+	}
 	returnEventList := []*info.Event{}
 	e.eventsLock.RLock()
 	defer e.eventsLock.RUnlock()
@@ -244,7 +401,15 @@ func (e *events) GetEvents(request *Request) ([]*info.Event, error) {
 			continue
 		}
 
-		res := evs.InTimeRange(request.StartTime, request.EndTime, request.MaxEventsReturned)
+		start, limit := request.StartTime, request.MaxEventsReturned
+		if request.Cursor != nil {
+			// Pages start from the oldest events after the cursor.
+			if request.Cursor.Timestamp.After(start) {
+				start = request.Cursor.Timestamp
+			}
+			limit = -1
+		}
+		res := evs.InTimeRange(start, request.EndTime, limit)
 		for _, in := range res {
 			e := in.(*info.Event)
 			if checkIfEventSatisfiesRequest(request, e) {
@@ -266,6 +431,9 @@ func (e *events) WatchEvents(request *Request) (*EventChannel, error) {
 		return nil, errors.New(
 			"for a call to watch, request.StartTime and request.EndTime must be uninitialized")
 	}
+	if request.Cursor != nil {
+		return nil, errors.New("for a call to watch, request.Cursor must be nil")
+	}
 	e.watcherLock.Lock()
 	defer e.watcherLock.Unlock()
 	newID := e.lastID + 1
@@ -276,8 +444,9 @@ func (e *events) WatchEvents(request *Request) (*EventChannel, error) {
 	return returnEventChannel, nil
 }
 
-// helper function to update the event manager's eventStore
-func (e *events) updateEventStore(event *info.Event) {
+// helper function to update the event manager's eventStore, returns whether
+// the event was stored.
+func (e *events) updateEventStore(event *info.Event) bool {
 	e.eventsLock.Lock()
 	defer e.eventsLock.Unlock()
 	if _, ok := e.eventStore[event.EventType]; !ok {
@@ -287,7 +456,7 @@ func (e *events) updateEventStore(event *info.Event) {
 		}
 		if maxNumEvents == 0 {
 			// Event storage is disabled for event.EventType
-			return
+			return false
 		}
 
 		maxAge := e.storagePolicy.DefaultMaxAge
@@ -298,6 +467,34 @@ func (e *events) updateEventStore(event *info.Event) {
 		e.eventStore[event.EventType] = utils.NewTimedStore(maxAge, maxNumEvents)
 	}
 	e.eventStore[event.EventType].Add(event.Timestamp, event)
+	return true
+}
+
+// persistEvent appends the event to the store, if any.
+func (e *events) persistEvent(event *info.Event) {
+	if e.store == nil {
+		return
+	}
+	if err := e.store.Append(event); err != nil {
+		klog.Warningf("Failed to store event %v: %v", event, err)
+	}
+}
+
+// replayedCreation returns whether the event is the creation of a container
+// already replayed from the store, which is reported again when cAdvisor
+// restarts.
+func (e *events) replayedCreation(event *info.Event) bool {
+	if event.EventType != info.EventContainerCreation {
+		return false
+	}
+	e.eventsLock.Lock()
+	defer e.eventsLock.Unlock()
+	created, ok := e.replayedCreations[event.ContainerName]
+	if !ok {
+		return false
+	}
+	delete(e.replayedCreations, event.ContainerName)
+	return created.Equal(event.Timestamp)
 }
 
 func (e *events) findValidWatchers(event *info.Event) []*watch {
@@ -315,7 +512,9 @@ func (e *events) findValidWatchers(event *info.Event) []*watch {
 // eventStore. It also feeds the event to a set of watch channels
 // held by the manager if it satisfies the request keys of the channels
 func (e *events) AddEvent(event *info.Event) error {
-	e.updateEventStore(event)
+	if !e.replayedCreation(event) && e.updateEventStore(event) {
+		e.persistEvent(event)
+	}
 	e.watcherLock.RLock()
 	defer e.watcherLock.RUnlock()
 	watchesToSend := e.findValidWatchers(event)
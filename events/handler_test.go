@@ -18,6 +18,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/google/cadvisor/container"
 	info "github.com/google/cadvisor/info/v1"
 
 	"github.com/stretchr/testify/assert"
@@ -196,3 +197,67 @@ func TestGetEventsForNoTypeRequested(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Len(t, receivedEvents, 0)
 }
+
+func TestGetEventsSelectsContainersAndLabels(t *testing.T) {
+	manager := NewEventManager(DefaultStoragePolicy())
+	now := time.Now()
+	for _, ev := range []*info.Event{
+		{ContainerName: "/a/web", Timestamp: now, EventType: info.EventOom, ContainerLabels: map[string]string{"app": "web"}},
+		{ContainerName: "/a/batch", Timestamp: now, EventType: info.EventOom, ContainerLabels: map[string]string{"app": "batch"}},
+		{ContainerName: "/b/web", Timestamp: now, EventType: info.EventOom, ContainerLabels: map[string]string{"app": "web"}},
+		{ContainerName: "/c/web", Timestamp: now, EventType: info.EventOom, ContainerLabels: map[string]string{"app": "web"}},
+	} {
+		assert.NoError(t, manager.AddEvent(ev))
+	}
+
+	request := NewRequest()
+	request.EventType[info.EventOom] = true
+	request.ContainerName = "/a"
+	request.ContainerNames = []string{"/b"}
+	request.IncludeSubcontainers = true
+	selector, err := container.ParseLabelSelector("app=web")
+	assert.NoError(t, err)
+	request.LabelSelector = selector
+
+	evs, err := manager.GetEvents(request)
+	assert.NoError(t, err)
+	var names []string
+	for _, ev := range evs {
+		names = append(names, ev.ContainerName)
+	}
+	assert.ElementsMatch(t, []string{"/a/web", "/b/web"}, names)
+}
+
+func TestGetEventsPages(t *testing.T) {
+	manager := NewEventManager(DefaultStoragePolicy())
+	start := time.Now()
+	// Pairs of events share a timestamp, the first page ending within one.
+	for i, name := range []string{"/a", "/b", "/c", "/d", "/e"} {
+		ts := start.Add(time.Duration(i/2) * time.Second)
+		assert.NoError(t, manager.AddEvent(makeEvent(ts, name)))
+	}
+
+	request := NewRequest()
+	request.EventType[info.EventOom] = true
+	request.MaxEventsReturned = 3
+	cursor, err := ParseCursor("")
+	assert.NoError(t, err)
+	var names []string
+	for page := 0; page < 3; page++ {
+		request.Cursor = cursor
+		evs, err := manager.GetEvents(request)
+		assert.NoError(t, err)
+		for _, ev := range evs {
+			names = append(names, ev.ContainerName)
+		}
+		next := NextCursor(request, evs)
+		cursor, err = ParseCursor(next.String())
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, []string{"/a", "/b", "/c", "/d", "/e"}, names)
+
+	_, err = ParseCursor("invalid")
+	assert.Error(t, err)
+	_, err = manager.WatchEvents(request)
+	assert.Error(t, err)
+}
@@ -0,0 +1,166 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package events
+
+import (
+	"sync"
+	"time"
+
+	info "github.com/google/cadvisor/info/v1"
+	"github.com/google/cadvisor/metrics/selfmetrics"
+
+	"k8s.io/klog/v2"
+	"k8s.io/utils/clock"
+)
+
+// RateLimitPolicy specifies how many events of a type a container may add
+// per window. Events beyond the burst of a window are collapsed into a
+// single event added when the window ends, whose Count is the number of
+// events it stands for.
+type RateLimitPolicy struct {
+	// Default limits, used if a per-event limit is not set. A window of
+	// zero disables rate limiting.
+	DefaultWindow time.Duration
+	DefaultBurst  int
+
+	// Per-event type limits.
+	PerTypeWindow map[info.EventType]time.Duration
+	PerTypeBurst  map[info.EventType]int
+}
+
+// DefaultRateLimitPolicy returns a policy which does not limit any event.
+func DefaultRateLimitPolicy() RateLimitPolicy {
+	return RateLimitPolicy{
+		DefaultBurst:  1,
+		PerTypeWindow: make(map[info.EventType]time.Duration),
+		PerTypeBurst:  make(map[info.EventType]int),
+	}
+}
+
+func (p RateLimitPolicy) window(eventType info.EventType) time.Duration {
+	if window, ok := p.PerTypeWindow[eventType]; ok {
+		return window
+	}
+	return p.DefaultWindow
+}
+
+func (p RateLimitPolicy) burst(eventType info.EventType) int {
+	if burst, ok := p.PerTypeBurst[eventType]; ok {
+		return burst
+	}
+	return p.DefaultBurst
+}
+
+// Enabled returns whether the policy limits any event type.
+func (p RateLimitPolicy) Enabled() bool {
+	if p.DefaultWindow > 0 {
+		return true
+	}
+	for _, window := range p.PerTypeWindow {
+		if window > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+type rateLimitKey struct {
+	containerName string
+	eventType     info.EventType
+}
+
+// rateLimitWindow counts the events of a container and type added since
+// the window started.
+type rateLimitWindow struct {
+	// number of events passed through.
+	added int
+	// number of events held back, and the last of them.
+	suppressed int
+	last       *info.Event
+}
+
+// rateLimitedEventManager is an EventManager limiting the rate at which
+// each container adds events of a type.
+type rateLimitedEventManager struct {
+	EventManager
+	policy RateLimitPolicy
+	clock  clock.WithDelayedExecution
+
+	// lock guarding windows.
+	lock sync.Mutex
+	// windows in progress, removed when they end.
+	windows map[rateLimitKey]*rateLimitWindow
+}
+
+// NewRateLimitedEventManager returns an EventManager adding events to m at
+// the rate allowed by policy, per container and event type.
+func NewRateLimitedEventManager(m EventManager, policy RateLimitPolicy) EventManager {
+	return newRateLimitedEventManager(m, policy, clock.RealClock{})
+}
+
+func newRateLimitedEventManager(m EventManager, policy RateLimitPolicy, clock clock.WithDelayedExecution) *rateLimitedEventManager {
+	return &rateLimitedEventManager{
+		EventManager: m,
+		policy:       policy,
+		clock:        clock,
+		windows:      make(map[rateLimitKey]*rateLimitWindow),
+	}
+}
+
+func (e *rateLimitedEventManager) AddEvent(event *info.Event) error {
+	window := e.policy.window(event.EventType)
+	if window <= 0 {
+		return e.EventManager.AddEvent(event)
+	}
+	key := rateLimitKey{containerName: event.ContainerName, eventType: event.EventType}
+
+	e.lock.Lock()
+	w, ok := e.windows[key]
+	if !ok {
+		w = &rateLimitWindow{}
+		e.windows[key] = w
+		e.clock.AfterFunc(window, func() { e.endWindow(key) })
+	}
+	if w.added < e.policy.burst(event.EventType) {
+		w.added++
+		e.lock.Unlock()
+		return e.EventManager.AddEvent(event)
+	}
+	w.suppressed++
+	w.last = event
+	e.lock.Unlock()
+
+	selfmetrics.EventsSuppressed.WithLabelValues(string(event.EventType)).Inc()
+	klog.V(4).Infof("Rate limited event %v", event)
+	return nil
+}
+
+// endWindow removes the window of key, adding the last event it held back
+// on behalf of all of them.
+func (e *rateLimitedEventManager) endWindow(key rateLimitKey) {
+	e.lock.Lock()
+	w := e.windows[key]
+	delete(e.windows, key)
+	e.lock.Unlock()
+
+	if w == nil || w.last == nil {
+		return
+	}
+	event := w.last
+	event.Count = w.suppressed
+	if err := e.EventManager.AddEvent(event); err != nil {
+		klog.Warningf("Failed to add collapsed event %v: %v", event, err)
+	}
+}
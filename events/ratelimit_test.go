@@ -0,0 +1,104 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package events
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	info "github.com/google/cadvisor/info/v1"
+
+	"github.com/stretchr/testify/assert"
+	testingclock "k8s.io/utils/clock/testing"
+)
+
+// recordingEventManager records the events added to it.
+type recordingEventManager struct {
+	EventManager
+	lock   sync.Mutex
+	events []*info.Event
+}
+
+func (r *recordingEventManager) AddEvent(event *info.Event) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.events = append(r.events, event)
+	return nil
+}
+
+func (r *recordingEventManager) added() []*info.Event {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return append([]*info.Event(nil), r.events...)
+}
+
+func TestRateLimitCollapsesEvents(t *testing.T) {
+	clock := testingclock.NewFakeClock(time.Unix(1000, 0))
+	recorder := &recordingEventManager{}
+	policy := DefaultRateLimitPolicy()
+	policy.PerTypeWindow[info.EventOom] = time.Minute
+	policy.PerTypeBurst[info.EventOom] = 2
+	e := newRateLimitedEventManager(recorder, policy, clock)
+
+	for i := 0; i < 10; i++ {
+		event := makeEvent(clock.Now().Add(time.Duration(i)*time.Second), "/a")
+		assert.NoError(t, e.AddEvent(event))
+	}
+	// Another container and a type without limit are not held back.
+	assert.NoError(t, e.AddEvent(makeEvent(clock.Now(), "/b")))
+	assert.NoError(t, e.AddEvent(&info.Event{ContainerName: "/a", EventType: info.EventOomKill}))
+
+	added := recorder.added()
+	assert.Len(t, added, 4)
+	for _, event := range added {
+		assert.Zero(t, event.Count)
+	}
+
+	clock.Step(time.Minute)
+	assert.Eventually(t, func() bool { return len(recorder.added()) == 5 }, time.Second, time.Millisecond)
+	collapsed := recorder.added()[4]
+	assert.Equal(t, "/a", collapsed.ContainerName)
+	assert.Equal(t, 8, collapsed.Count)
+	assert.Equal(t, clock.Now().Add(-time.Minute).Add(9*time.Second), collapsed.Timestamp)
+
+	// A new window starts once the previous one ended.
+	assert.NoError(t, e.AddEvent(makeEvent(clock.Now(), "/a")))
+	assert.Len(t, recorder.added(), 6)
+}
+
+func TestRateLimitWindowWithoutExcess(t *testing.T) {
+	clock := testingclock.NewFakeClock(time.Unix(1000, 0))
+	recorder := &recordingEventManager{}
+	policy := DefaultRateLimitPolicy()
+	policy.DefaultWindow = time.Minute
+	e := newRateLimitedEventManager(recorder, policy, clock)
+
+	assert.NoError(t, e.AddEvent(makeEvent(clock.Now(), "/a")))
+	clock.Step(time.Minute)
+	assert.Eventually(t, func() bool {
+		e.lock.Lock()
+		defer e.lock.Unlock()
+		return len(e.windows) == 0
+	}, time.Second, time.Millisecond)
+	assert.Len(t, recorder.added(), 1)
+}
+
+func TestRateLimitPolicyEnabled(t *testing.T) {
+	policy := DefaultRateLimitPolicy()
+	assert.False(t, policy.Enabled())
+	policy.PerTypeWindow[info.EventOom] = time.Minute
+	assert.True(t, policy.Enabled())
+}
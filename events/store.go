@@ -0,0 +1,258 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package events
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	info "github.com/google/cadvisor/info/v1"
+
+	"k8s.io/klog/v2"
+)
+
+// Store persists events beyond the lifetime of the process. The events it
+// holds are replayed into the in-memory store at startup and it serves the
+// history of GetEvents.
+type Store interface {
+	// Append persists an event.
+	Append(event *info.Event) error
+	// Events returns the stored events satisfying the request.
+	Events(request *Request) ([]*info.Event, error)
+	// Replay calls f with every stored event, in the order they were added.
+	Replay(f func(event *info.Event)) error
+	// Close releases the resources of the store.
+	Close() error
+}
+
+// FileStore is a Store keeping events in a file as one JSON record per line.
+// Events older than maxAge are dropped, and once the file holds twice
+// maxNumEvents records it is rewritten with the most recent maxNumEvents.
+type FileStore struct {
+	path         string
+	maxAge       time.Duration
+	maxNumEvents int
+	now          func() time.Time
+
+	// lock guarding the fields below.
+	lock sync.Mutex
+	// file the events are appended to.
+	file *os.File
+	// number of records held by the file.
+	records int
+	// timestamp of the oldest event held by the file.
+	oldest time.Time
+}
+
+var _ Store = &FileStore{}
+
+// OpenFileStore opens the event store at path, creating it if it does not
+// exist, and drops the events it holds beyond the retention limits. A
+// maxNumEvents of -1 means no limit on the number of events.
+func OpenFileStore(path string, maxAge time.Duration, maxNumEvents int) (*FileStore, error) {
+	return openFileStore(path, maxAge, maxNumEvents, time.Now)
+}
+
+func openFileStore(path string, maxAge time.Duration, maxNumEvents int, now func() time.Time) (*FileStore, error) {
+	if maxAge <= 0 {
+		return nil, fmt.Errorf("maximum age of stored events must be positive, got %v", maxAge)
+	}
+	if maxNumEvents == 0 || maxNumEvents < -1 {
+		return nil, fmt.Errorf("maximum number of stored events must be positive or -1, got %d", maxNumEvents)
+	}
+	s := &FileStore{
+		path:         path,
+		maxAge:       maxAge,
+		maxNumEvents: maxNumEvents,
+		now:          now,
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if err := s.compact(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Append writes the event at the end of the store. Events that are already
+// older than the retention are not stored.
+func (s *FileStore) Append(event *info.Event) error {
+	if s.expired(event) {
+		return nil
+	}
+	line, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	line = append(line, '\n')
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.file == nil {
+		return errors.New("event store is closed")
+	}
+	if _, err := s.file.Write(line); err != nil {
+		return fmt.Errorf("failed to write event to %q: %v", s.path, err)
+	}
+	s.records++
+	if s.oldest.IsZero() || event.Timestamp.Before(s.oldest) {
+		s.oldest = event.Timestamp
+	}
+	if (s.maxNumEvents > 0 && s.records >= 2*s.maxNumEvents) || s.now().Sub(s.oldest) > 2*s.maxAge {
+		return s.compact()
+	}
+	return nil
+}
+
+// Events returns up to the last request.MaxEventsReturned events of the store
+// satisfying the request.
+func (s *FileStore) Events(request *Request) ([]*info.Event, error) {
+	matching := []*info.Event{}
+	err := s.Replay(func(event *info.Event) {
+		if checkIfEventSatisfiesRequest(request, event) {
+			matching = append(matching, event)
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	return getMaxEventsReturned(request, matching), nil
+}
+
+// Replay calls f with the events of the store that are within the retention.
+func (s *FileStore) Replay(f func(event *info.Event)) error {
+	s.lock.Lock()
+	closed := s.file == nil
+	s.lock.Unlock()
+	if closed {
+		return errors.New("event store is closed")
+	}
+	// The file is only ever appended to or replaced by a rename, so it can
+	// be read without holding the lock.
+	return s.read(func(event *info.Event) {
+		if !s.expired(event) {
+			f(event)
+		}
+	})
+}
+
+// Close closes the file of the store.
+func (s *FileStore) Close() error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.file == nil {
+		return nil
+	}
+	err := s.file.Close()
+	s.file = nil
+	return err
+}
+
+func (s *FileStore) expired(event *info.Event) bool {
+	return s.now().Sub(event.Timestamp) > s.maxAge
+}
+
+// read calls f with every event of the file, skipping the records that
+// cannot be decoded, like one truncated by a crash.
+func (s *FileStore) read(f func(event *info.Event)) error {
+	file, err := os.Open(s.path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	skipped := 0
+	for scanner.Scan() {
+		event := &info.Event{}
+		if err := json.Unmarshal(scanner.Bytes(), event); err != nil {
+			skipped++
+			continue
+		}
+		f(event)
+	}
+	if skipped > 0 {
+		klog.Warningf("Skipped %d invalid records of event store %q", skipped, s.path)
+	}
+	return scanner.Err()
+}
+
+// compact rewrites the file with the events within the retention and reopens
+// it for appending. Must be called with the lock held.
+func (s *FileStore) compact() error {
+	var kept []*info.Event
+	err := s.read(func(event *info.Event) {
+		if !s.expired(event) {
+			kept = append(kept, event)
+		}
+	})
+	if err != nil {
+		return fmt.Errorf("failed to read event store %q: %v", s.path, err)
+	}
+	if s.maxNumEvents > 0 && len(kept) > s.maxNumEvents {
+		kept = kept[len(kept)-s.maxNumEvents:]
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(s.path), filepath.Base(s.path)+".tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	writer := bufio.NewWriter(tmp)
+	encoder := json.NewEncoder(writer)
+	var oldest time.Time
+	for _, event := range kept {
+		if err := encoder.Encode(event); err != nil {
+			tmp.Close()
+			return err
+		}
+		if oldest.IsZero() || event.Timestamp.Before(oldest) {
+			oldest = event.Timestamp
+		}
+	}
+	if err := writer.Flush(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp.Name(), s.path); err != nil {
+		return err
+	}
+
+	if s.file != nil {
+		s.file.Close()
+		s.file = nil
+	}
+	file, err := os.OpenFile(s.path, os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return err
+	}
+	s.file = file
+	s.records = len(kept)
+	s.oldest = oldest
+	return nil
+}
@@ -0,0 +1,161 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package events
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	info "github.com/google/cadvisor/info/v1"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestFileStore(t *testing.T, path string, maxNumEvents int, now *time.Time) *FileStore {
+	store, err := openFileStore(path, time.Hour, maxNumEvents, func() time.Time { return *now })
+	require.NoError(t, err)
+	t.Cleanup(func() { store.Close() })
+	return store
+}
+
+func allEventsRequest() *Request {
+	request := NewRequest()
+	request.EventType[info.EventOom] = true
+	request.EventType[info.EventContainerCreation] = true
+	request.EventType[info.EventContainerDeletion] = true
+	request.IncludeSubcontainers = true
+	request.ContainerName = "/"
+	request.MaxEventsReturned = -1
+	return request
+}
+
+func TestFileStoreSurvivesReopening(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "events")
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	store := newTestFileStore(t, path, 10, &now)
+	first := makeEvent(now.Add(-time.Minute), "/a")
+	second := &info.Event{
+		ContainerName: "/b",
+		Timestamp:     now,
+		EventType:     info.EventContainerDeletion,
+		EventData: info.EventData{
+			ContainerDeletion: &info.ContainerDeletionEventData{ExitCode: 137},
+		},
+	}
+	require.NoError(t, store.Append(first))
+	require.NoError(t, store.Append(second))
+	require.NoError(t, store.Close())
+
+	store = newTestFileStore(t, path, 10, &now)
+	evs, err := store.Events(allEventsRequest())
+	require.NoError(t, err)
+	require.Len(t, evs, 2)
+	assert.Equal(t, "/a", evs[0].ContainerName)
+	assert.True(t, first.Timestamp.Equal(evs[0].Timestamp))
+	assert.Equal(t, 137, evs[1].EventData.ContainerDeletion.ExitCode)
+}
+
+func TestFileStoreRetention(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "events")
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	store := newTestFileStore(t, path, 3, &now)
+
+	// Events already out of the retention are not stored.
+	require.NoError(t, store.Append(makeEvent(now.Add(-2*time.Hour), "/old")))
+	for i := 0; i < 6; i++ {
+		require.NoError(t, store.Append(makeEvent(now.Add(time.Duration(i)*time.Second), "/"+string(rune('a'+i)))))
+	}
+	// Appending twice the limit compacted the store to the last events.
+	evs, err := store.Events(allEventsRequest())
+	require.NoError(t, err)
+	var names []string
+	for _, ev := range evs {
+		names = append(names, ev.ContainerName)
+	}
+	assert.Equal(t, []string{"/d", "/e", "/f"}, names)
+
+	// Events aging out are no longer returned.
+	now = now.Add(time.Hour + 5*time.Second)
+	evs, err = store.Events(allEventsRequest())
+	require.NoError(t, err)
+	require.Len(t, evs, 1)
+	assert.Equal(t, "/f", evs[0].ContainerName)
+}
+
+func TestFileStoreSkipsInvalidRecords(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "events")
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	store := newTestFileStore(t, path, 10, &now)
+	require.NoError(t, store.Append(makeEvent(now, "/a")))
+	require.NoError(t, store.Close())
+
+	// A record truncated by a crash.
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0)
+	require.NoError(t, err)
+	_, err = file.WriteString("{\"container_name\":\"/b\",\"time")
+	require.NoError(t, err)
+	require.NoError(t, file.Close())
+
+	store = newTestFileStore(t, path, 10, &now)
+	require.NoError(t, store.Append(makeEvent(now, "/c")))
+	evs, err := store.Events(allEventsRequest())
+	require.NoError(t, err)
+	require.Len(t, evs, 2)
+	assert.Equal(t, "/a", evs[0].ContainerName)
+	assert.Equal(t, "/c", evs[1].ContainerName)
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, 2, strings.Count(string(content), "\n"))
+}
+
+func TestEventManagerWithStoreReplaysEvents(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "events")
+	now := time.Now()
+	store := newTestFileStore(t, path, 100, &now)
+	manager, err := NewEventManagerWithStore(DefaultStoragePolicy(), store)
+	require.NoError(t, err)
+
+	created := now.Add(-10 * time.Minute)
+	creation := func(name string) *info.Event {
+		return &info.Event{ContainerName: name, Timestamp: created, EventType: info.EventContainerCreation}
+	}
+	require.NoError(t, manager.AddEvent(creation("/running")))
+	require.NoError(t, manager.AddEvent(creation("/gone")))
+	require.NoError(t, manager.AddEvent(&info.Event{ContainerName: "/gone", Timestamp: now, EventType: info.EventContainerDeletion}))
+	require.NoError(t, manager.AddEvent(makeEvent(now, "/running")))
+	require.NoError(t, store.Close())
+
+	// After a restart, the creation of the running container is reported
+	// again and must not be recorded twice.
+	store = newTestFileStore(t, path, 100, &now)
+	manager, err = NewEventManagerWithStore(DefaultStoragePolicy(), store)
+	require.NoError(t, err)
+	require.NoError(t, manager.AddEvent(creation("/running")))
+
+	evs, err := manager.GetEvents(allEventsRequest())
+	require.NoError(t, err)
+	assert.Len(t, evs, 4)
+
+	// The replayed events are also served from memory.
+	inMemory := manager.(*events)
+	inMemory.store = nil
+	evs, err = manager.GetEvents(allEventsRequest())
+	require.NoError(t, err)
+	assert.Len(t, evs, 4)
+}
@@ -17,6 +17,7 @@
 package btrfs
 
 import (
+	"os"
 	"strings"
 
 	"github.com/google/cadvisor/fs"
@@ -56,14 +57,48 @@ func (p *btrfsPlugin) GetStats(device string, partition fs.PartitionInfo) (*fs.F
 		return nil, err
 	}
 
-	return &fs.FsStats{
+	stats := &fs.FsStats{
 		Capacity:   capacity,
 		Free:       free,
 		Available:  avail,
 		Inodes:     &inodes,
 		InodesFree: &inodesFree,
 		Type:       fs.VFS,
-	}, nil
+	}
+	fsid, err := filesystemID(partition.Mountpoint)
+	if err == nil {
+		stats.Allocation, err = allocation(fsid)
+	}
+	if err != nil {
+		klog.V(4).Infof("Failed to get the allocation of btrfs filesystem at %q: %v", partition.Mountpoint, err)
+	}
+	return stats, nil
+}
+
+// GetDirUsage returns the usage of a subvolume, such as the writable layer of
+// a container of the btrfs storage driver of Docker or Podman, from its
+// qgroup. Qgroups do not account inodes.
+func (p *btrfsPlugin) GetDirUsage(dir string, partition fs.PartitionInfo) (fs.UsageInfo, error) {
+	if ok, err := isSubvolume(dir); err != nil || !ok {
+		return fs.UsageInfo{}, fs.ErrFallbackToVFS
+	}
+	id, err := subvolumeID(dir)
+	if err != nil {
+		return fs.UsageInfo{}, err
+	}
+	fsid, err := filesystemID(dir)
+	if err != nil {
+		return fs.UsageInfo{}, err
+	}
+	exclusive, err := qgroupUsage(fsid, id)
+	if os.IsNotExist(err) {
+		// Quotas are not enabled.
+		return fs.UsageInfo{}, fs.ErrFallbackToVFS
+	}
+	if err != nil {
+		return fs.UsageInfo{}, err
+	}
+	return fs.UsageInfo{Bytes: exclusive}, nil
 }
 
 // ProcessMount handles Btrfs mount processing.
@@ -0,0 +1,143 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package btrfs
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+	"unsafe"
+
+	"golang.org/x/sys/unix"
+
+	"github.com/google/cadvisor/fs"
+)
+
+// Constants of linux/btrfs.h and linux/btrfs_tree.h.
+const (
+	// _IOWR(BTRFS_IOCTL_MAGIC, 18, struct btrfs_ioctl_ino_lookup_args)
+	iocInoLookup = 0xd0009412
+	// _IOR(BTRFS_IOCTL_MAGIC, 31, struct btrfs_ioctl_fs_info_args)
+	iocFsInfo = 0x8400941f
+	// The inode number of the root directory of every subvolume.
+	firstFreeObjectID = 256
+)
+
+// sysfsDir is where btrfs reports its filesystems, changed by tests.
+var sysfsDir = "/sys/fs/btrfs"
+
+// inoLookupArgs is struct btrfs_ioctl_ino_lookup_args.
+type inoLookupArgs struct {
+	treeID   uint64
+	objectID uint64
+	name     [4080]byte
+}
+
+// fsInfoArgs is struct btrfs_ioctl_fs_info_args.
+type fsInfoArgs struct {
+	maxID          uint64
+	numDevices     uint64
+	fsid           [16]byte
+	nodeSize       uint32
+	sectorSize     uint32
+	cloneAlignment uint32
+	csumType       uint16
+	csumSize       uint16
+	flags          uint64
+	generation     uint64
+	metadataUUID   [16]byte
+	reserved       [944]byte
+}
+
+func ioctl(dir string, request uintptr, arg unsafe.Pointer) error {
+	f, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	if _, _, errno := unix.Syscall(unix.SYS_IOCTL, f.Fd(), request, uintptr(arg)); errno != 0 {
+		return errno
+	}
+	return nil
+}
+
+// isSubvolume returns whether dir is the root of a subvolume.
+func isSubvolume(dir string) (bool, error) {
+	var stat syscall.Stat_t
+	if err := syscall.Stat(dir, &stat); err != nil {
+		return false, err
+	}
+	return stat.Ino == firstFreeObjectID && stat.Mode&syscall.S_IFMT == syscall.S_IFDIR, nil
+}
+
+// subvolumeID returns the id of the subvolume dir is in, which is the id of
+// its level 0 qgroup.
+func subvolumeID(dir string) (uint64, error) {
+	args := inoLookupArgs{objectID: firstFreeObjectID}
+	if err := ioctl(dir, iocInoLookup, unsafe.Pointer(&args)); err != nil {
+		return 0, fmt.Errorf("failed to look up the subvolume of %q: %v", dir, err)
+	}
+	return args.treeID, nil
+}
+
+// filesystemID returns the UUID of the filesystem dir is on, which names its
+// directory in sysfs.
+func filesystemID(dir string) (string, error) {
+	var args fsInfoArgs
+	if err := ioctl(dir, iocFsInfo, unsafe.Pointer(&args)); err != nil {
+		return "", fmt.Errorf("failed to get the filesystem of %q: %v", dir, err)
+	}
+	u := args.fsid
+	return fmt.Sprintf("%x-%x-%x-%x-%x", u[0:4], u[4:6], u[6:8], u[8:10], u[10:16]), nil
+}
+
+func readUint(file string) (uint64, error) {
+	value, err := os.ReadFile(file)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseUint(strings.TrimSpace(string(value)), 10, 64)
+}
+
+// qgroupUsage returns the bytes exclusive to a subvolume, that is not shared
+// with the snapshots it was taken from or of it, from its qgroup in sysfs,
+// which is only there on kernels 5.9 and later with quotas enabled.
+func qgroupUsage(fsid string, subvolume uint64) (uint64, error) {
+	return readUint(filepath.Join(sysfsDir, fsid, "qgroups", fmt.Sprintf("0_%d", subvolume), "exclusive"))
+}
+
+// allocation returns how much of the space allocated to data, metadata and
+// system chunks is used.
+func allocation(fsid string) ([]fs.Allocation, error) {
+	var allocations []fs.Allocation
+	for _, kind := range []string{"data", "metadata", "system"} {
+		dir := filepath.Join(sysfsDir, fsid, "allocation", kind)
+		total, err := readUint(filepath.Join(dir, "total_bytes"))
+		if err != nil {
+			return nil, err
+		}
+		used, err := readUint(filepath.Join(dir, "bytes_used"))
+		if err != nil {
+			return nil, err
+		}
+		allocations = append(allocations, fs.Allocation{Type: kind, Total: total, Used: used})
+	}
+	return allocations, nil
+}
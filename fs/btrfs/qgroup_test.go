@@ -0,0 +1,89 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package btrfs
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"unsafe"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/google/cadvisor/fs"
+)
+
+const testFsid = "5c1e6a21-50b1-4e4b-a5b4-0e7a6f1b2c3d"
+
+func writeFile(t *testing.T, file, content string) {
+	require.NoError(t, os.MkdirAll(filepath.Dir(file), 0755))
+	require.NoError(t, os.WriteFile(file, []byte(content), 0644))
+}
+
+func TestIoctlArgsSizes(t *testing.T) {
+	// The sizes the kernel expects.
+	assert.Equal(t, uintptr(4096), unsafe.Sizeof(inoLookupArgs{}))
+	assert.Equal(t, uintptr(1024), unsafe.Sizeof(fsInfoArgs{}))
+}
+
+func TestQgroupUsage(t *testing.T) {
+	oldSysfsDir := sysfsDir
+	defer func() { sysfsDir = oldSysfsDir }()
+	sysfsDir = t.TempDir()
+
+	_, err := qgroupUsage(testFsid, 258)
+	assert.True(t, os.IsNotExist(err))
+
+	writeFile(t, filepath.Join(sysfsDir, testFsid, "qgroups/0_258/exclusive"), "1048576\n")
+	writeFile(t, filepath.Join(sysfsDir, testFsid, "qgroups/0_258/referenced"), "73400320\n")
+	usage, err := qgroupUsage(testFsid, 258)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(1048576), usage)
+}
+
+func TestAllocation(t *testing.T) {
+	oldSysfsDir := sysfsDir
+	defer func() { sysfsDir = oldSysfsDir }()
+	sysfsDir = t.TempDir()
+
+	for kind, values := range map[string][2]string{
+		"data":     {"10737418240", "8589934592"},
+		"metadata": {"1073741824", "268435456"},
+		"system":   {"8388608", "16384"},
+	} {
+		writeFile(t, filepath.Join(sysfsDir, testFsid, "allocation", kind, "total_bytes"), values[0]+"\n")
+		writeFile(t, filepath.Join(sysfsDir, testFsid, "allocation", kind, "bytes_used"), values[1]+"\n")
+	}
+	allocations, err := allocation(testFsid)
+	assert.NoError(t, err)
+	assert.Equal(t, []fs.Allocation{
+		{Type: "data", Total: 10737418240, Used: 8589934592},
+		{Type: "metadata", Total: 1073741824, Used: 268435456},
+		{Type: "system", Total: 8388608, Used: 16384},
+	}, allocations)
+
+	require.NoError(t, os.Remove(filepath.Join(sysfsDir, testFsid, "allocation/system/bytes_used")))
+	_, err = allocation(testFsid)
+	assert.Error(t, err)
+}
+
+func TestGetDirUsageOfNonSubvolume(t *testing.T) {
+	// Directories created by tests are not subvolumes, even on btrfs.
+	_, err := NewPlugin().(fs.FsDirUsagePlugin).GetDirUsage(t.TempDir(), fs.PartitionInfo{})
+	assert.ErrorIs(t, err, fs.ErrFallbackToVFS)
+}
@@ -19,6 +19,7 @@ package fs
 
 import (
 	"bufio"
+	"context"
 	"errors"
 	"fmt"
 	"os"
@@ -48,33 +49,16 @@ const (
 const (
 	// The block size in bytes.
 	statBlockSize uint64 = 512
-	// The maximum number of `disk usage` tasks that can be running at once.
-	maxConcurrentOps = 20
 )
 
-// A pool for restricting the number of consecutive `du` and `find` tasks running.
-var pool = make(chan struct{}, maxConcurrentOps)
-
-func init() {
-	for i := 0; i < maxConcurrentOps; i++ {
-		releaseToken()
-	}
-}
-
-func claimToken() {
-	<-pool
-}
-
-func releaseToken() {
-	pool <- struct{}{}
-}
-
 type partition struct {
 	mountpoint string
 	major      uint
 	minor      uint
 	fsType     string
 	blockSize  uint
+	// Whether the filesystem is mounted with project quotas.
+	projectQuota bool
 }
 
 type RealFsInfo struct {
@@ -205,10 +189,11 @@ func processMounts(mounts []*mount.Info, excludedMountpointPrefixes []string) ma
 		}
 
 		partitions[processedMnt.Source] = partition{
-			fsType:     processedMnt.FSType,
-			mountpoint: processedMnt.Mountpoint,
-			major:      uint(processedMnt.Major),
-			minor:      uint(processedMnt.Minor),
+			fsType:       processedMnt.FSType,
+			mountpoint:   processedMnt.Mountpoint,
+			major:        uint(processedMnt.Major),
+			minor:        uint(processedMnt.Minor),
+			projectQuota: projectQuotaEnabled(processedMnt.VFSOptions),
 		}
 	}
 
@@ -471,6 +456,8 @@ func (i *RealFsInfo) GetFsInfoForPath(mountSet map[string]struct{}) ([]Fs, error
 			fs.Inodes = stats.Inodes
 			fs.InodesFree = stats.InodesFree
 			fs.Type = stats.Type
+			fs.ProjectQuota = partition.projectQuota
+			fs.Allocation = stats.Allocation
 
 			// Store in cache if plugin supports caching
 			if cacheKey != "" {
@@ -656,73 +643,46 @@ func (i *RealFsInfo) GetDirFsDevice(dir string) (*DeviceInfo, error) {
 	return nil, fmt.Errorf("with major: %d, minor: %d: %w", major, minor, ErrDeviceNotInPartitionsMap)
 }
 
+// GetDirUsage returns the usage of dir by walking it.
 func GetDirUsage(dir string) (UsageInfo, error) {
-	var usage UsageInfo
+	return scanDirUsage(context.Background(), dir)
+}
 
-	if dir == "" {
-		return usage, fmt.Errorf("invalid directory")
+// GetDirUsage returns the usage of dir from its project quota when the
+// filesystem it is on is mounted with project quotas and dir has a project of
+// its own, from the plugin of the filesystem if it can tell, and by walking
+// it otherwise, until ctx is done.
+func (i *RealFsInfo) GetDirUsage(ctx context.Context, dir string) (UsageInfo, error) {
+	usage, err := i.getDirQuotaUsage(dir)
+	if errors.Is(err, ErrNoProjectQuota) {
+		usage, err = i.getPluginDirUsage(dir)
 	}
-
-	rootInfo, err := os.Stat(dir)
-	if err != nil {
-		return usage, fmt.Errorf("could not stat %q to get inode usage: %v", dir, err)
+	if err == nil {
+		return usage, nil
+	}
+	if !errors.Is(err, ErrNoProjectQuota) && !errors.Is(err, ErrFallbackToVFS) {
+		klog.V(4).Infof("Failed to get the usage of %q without walking it: %v", dir, err)
 	}
+	return scanDirUsage(ctx, dir)
+}
 
-	rootStat, ok := rootInfo.Sys().(*syscall.Stat_t)
+// getPluginDirUsage returns the usage of dir from the plugin of its
+// filesystem, or ErrFallbackToVFS if the plugin cannot tell it.
+func (i *RealFsInfo) getPluginDirUsage(dir string) (UsageInfo, error) {
+	mnt, found := i.mountInfoFromDir(dir)
+	if !found {
+		return UsageInfo{}, ErrFallbackToVFS
+	}
+	plugin, ok := GetPluginForFsType(mnt.FSType).(FsDirUsagePlugin)
 	if !ok {
-		return usage, fmt.Errorf("unsupported fileinfo for getting inode usage of %q", dir)
+		return UsageInfo{}, ErrFallbackToVFS
 	}
-
-	rootDevID := rootStat.Dev
-
-	// dedupedInode stores inodes that could be duplicates (nlink > 1)
-	dedupedInodes := make(map[uint64]struct{})
-
-	err = filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
-		if os.IsNotExist(err) {
-			// expected if files appear/vanish
-			return nil
-		}
-		if err != nil {
-			return fmt.Errorf("unable to count inodes for part of dir %s: %s", dir, err)
-		}
-
-		// according to the docs, Sys can be nil
-		if info.Sys() == nil {
-			return fmt.Errorf("fileinfo Sys is nil")
-		}
-
-		s, ok := info.Sys().(*syscall.Stat_t)
-		if !ok {
-			return fmt.Errorf("unsupported fileinfo; could not convert to stat_t")
-		}
-
-		if s.Dev != rootDevID {
-			// don't descend into directories on other devices
-			return filepath.SkipDir
-		}
-		if s.Nlink > 1 {
-			if _, ok := dedupedInodes[s.Ino]; !ok {
-				// Dedupe things that could be hardlinks
-				dedupedInodes[s.Ino] = struct{}{}
-
-				usage.Bytes += uint64(s.Blocks) * statBlockSize
-				usage.Inodes++
-			}
-		} else {
-			usage.Bytes += uint64(s.Blocks) * statBlockSize
-			usage.Inodes++
-		}
-		return nil
+	return plugin.GetDirUsage(dir, PartitionInfo{
+		Mountpoint: mnt.Mountpoint,
+		Major:      uint(mnt.Major),
+		Minor:      uint(mnt.Minor),
+		FsType:     mnt.FSType,
 	})
-
-	return usage, err
-}
-
-func (i *RealFsInfo) GetDirUsage(dir string) (UsageInfo, error) {
-	claimToken()
-	defer releaseToken()
-	return GetDirUsage(dir)
 }
 
 // Devicemapper thin provisioning is detailed at
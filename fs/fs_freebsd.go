@@ -0,0 +1,135 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build freebsd
+
+package fs
+
+import (
+	"context"
+	"fmt"
+
+	"golang.org/x/sys/unix"
+)
+
+// freebsdFsInfo reads the local filesystems of FreeBSD hosts, e.g. UFS
+// partitions and ZFS datasets.
+type freebsdFsInfo struct{}
+
+func NewFsInfo(context Context) (FsInfo, error) {
+	return &freebsdFsInfo{}, nil
+}
+
+// localMounts returns the mounted local filesystems.
+func localMounts() ([]unix.Statfs_t, error) {
+	n, err := unix.Getfsstat(nil, unix.MNT_NOWAIT)
+	if err != nil {
+		return nil, err
+	}
+	// Leave room for filesystems mounted in between.
+	buf := make([]unix.Statfs_t, n+8)
+	n, err = unix.Getfsstat(buf, unix.MNT_NOWAIT)
+	if err != nil {
+		return nil, err
+	}
+	var mounts []unix.Statfs_t
+	for _, mount := range buf[:n] {
+		if mount.Flags&unix.MNT_LOCAL != 0 {
+			mounts = append(mounts, mount)
+		}
+	}
+	return mounts, nil
+}
+
+func mountFs(mount *unix.Statfs_t) Fs {
+	fs := Fs{
+		DeviceInfo: DeviceInfo{Device: unix.ByteSliceToString(mount.Mntfromname[:])},
+		Type:       VFS,
+		Capacity:   mount.Blocks * mount.Bsize,
+		Free:       mount.Bfree * mount.Bsize,
+	}
+	if mount.Bavail > 0 {
+		fs.Available = uint64(mount.Bavail) * mount.Bsize
+	}
+	if unix.ByteSliceToString(mount.Fstypename[:]) == "zfs" {
+		fs.Type = ZFS
+	}
+	inodes := mount.Files
+	fs.Inodes = &inodes
+	if mount.Ffree > 0 {
+		inodesFree := uint64(mount.Ffree)
+		fs.InodesFree = &inodesFree
+	}
+	return fs
+}
+
+func (i *freebsdFsInfo) GetGlobalFsInfo() ([]Fs, error) {
+	return i.GetFsInfoForPath(nil)
+}
+
+// GetFsInfoForPath returns the filesystems mounted on the mount points of
+// mountSet, all of them if nil.
+func (i *freebsdFsInfo) GetFsInfoForPath(mountSet map[string]struct{}) ([]Fs, error) {
+	mounts, err := localMounts()
+	if err != nil {
+		return nil, err
+	}
+	var filesystems []Fs
+	for i := range mounts {
+		if _, ok := mountSet[unix.ByteSliceToString(mounts[i].Mntonname[:])]; mountSet != nil && !ok {
+			continue
+		}
+		filesystems = append(filesystems, mountFs(&mounts[i]))
+	}
+	return filesystems, nil
+}
+
+// GetDirUsage returns the usage of dir by walking it, until ctx is done.
+func (i *freebsdFsInfo) GetDirUsage(ctx context.Context, dir string) (UsageInfo, error) {
+	return walkDirUsage(ctx, dir)
+}
+
+func (i *freebsdFsInfo) GetDeviceInfoByFsUUID(uuid string) (*DeviceInfo, error) {
+	return nil, ErrNoSuchDevice
+}
+
+// GetDirFsDevice returns the device of the filesystem dir is on.
+func (i *freebsdFsInfo) GetDirFsDevice(dir string) (*DeviceInfo, error) {
+	var mount unix.Statfs_t
+	if err := unix.Statfs(dir, &mount); err != nil {
+		return nil, err
+	}
+	return &DeviceInfo{Device: unix.ByteSliceToString(mount.Mntfromname[:])}, nil
+}
+
+func (i *freebsdFsInfo) GetDeviceForLabel(label string) (string, error) {
+	return "", fmt.Errorf("no device with label %q", label)
+}
+
+func (i *freebsdFsInfo) GetLabelsForDevice(device string) ([]string, error) {
+	return []string{}, nil
+}
+
+func (i *freebsdFsInfo) GetMountpointForDevice(device string) (string, error) {
+	mounts, err := localMounts()
+	if err != nil {
+		return "", err
+	}
+	for _, mount := range mounts {
+		if unix.ByteSliceToString(mount.Mntfromname[:]) == device {
+			return unix.ByteSliceToString(mount.Mntonname[:]), nil
+		}
+	}
+	return "", fmt.Errorf("no mount point for device %q", device)
+}
@@ -17,6 +17,7 @@
 package fs
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"os"
@@ -215,7 +216,7 @@ func TestDirDiskUsage(t *testing.T) {
 	fi, err := f.Stat()
 	as.NoError(err)
 	expectedSize := uint64(fi.Size())
-	usage, err := fsInfo.GetDirUsage(dir)
+	usage, err := fsInfo.GetDirUsage(context.Background(), dir)
 	as.NoError(err)
 	as.True(expectedSize <= usage.Bytes, "expected dir size to be at-least %d; got size: %d", expectedSize, usage.Bytes)
 }
@@ -231,7 +232,7 @@ func TestDirInodeUsage(t *testing.T) {
 		_, err := os.MkdirTemp(dir, "")
 		require.NoError(t, err)
 	}
-	usage, err := fsInfo.GetDirUsage(dir)
+	usage, err := fsInfo.GetDirUsage(context.Background(), dir)
 	as.NoError(err)
 	// We should get numFiles+1 inodes, since we get 1 inode for each file, plus 1 for the directory
 	as.True(uint64(numFiles+1) == usage.Inodes, "expected inodes in dir to be %d; got inodes: %d", numFiles+1, usage.Inodes)
@@ -277,6 +278,10 @@ func (*testDmsetup) Status(deviceName string) ([]byte, error) {
 	return nil, nil
 }
 
+func (*testDmsetup) TargetStatus(target string) ([]byte, error) {
+	return nil, nil
+}
+
 func (t *testDmsetup) Table(poolName string) ([]byte, error) {
 	return t.data, t.err
 }
@@ -0,0 +1,124 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build windows
+
+package fs
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"golang.org/x/sys/windows"
+)
+
+// windowsFsInfo reads the fixed drives of Windows hosts. Drives are devices
+// named by their root path, e.g. C:\.
+type windowsFsInfo struct{}
+
+func NewFsInfo(context Context) (FsInfo, error) {
+	return &windowsFsInfo{}, nil
+}
+
+// fixedDrives returns the root paths of the fixed drives.
+func fixedDrives() ([]string, error) {
+	buf := make([]uint16, 256)
+	n, err := windows.GetLogicalDriveStrings(uint32(len(buf)), &buf[0])
+	if err != nil {
+		return nil, err
+	}
+	var drives []string
+	for _, drive := range strings.Split(windows.UTF16ToString(buf[:n]), "\x00") {
+		if drive == "" {
+			continue
+		}
+		root, err := windows.UTF16PtrFromString(drive)
+		if err != nil {
+			continue
+		}
+		if windows.GetDriveType(root) == windows.DRIVE_FIXED {
+			drives = append(drives, drive)
+		}
+	}
+	return drives, nil
+}
+
+func driveFs(drive string) (Fs, error) {
+	root, err := windows.UTF16PtrFromString(drive)
+	if err != nil {
+		return Fs{}, err
+	}
+	fs := Fs{DeviceInfo: DeviceInfo{Device: drive}, Type: VFS}
+	if err := windows.GetDiskFreeSpaceEx(root, &fs.Available, &fs.Capacity, &fs.Free); err != nil {
+		return Fs{}, fmt.Errorf("failed to get the space of %s: %v", drive, err)
+	}
+	return fs, nil
+}
+
+func (i *windowsFsInfo) GetGlobalFsInfo() ([]Fs, error) {
+	return i.GetFsInfoForPath(nil)
+}
+
+// GetFsInfoForPath returns the drives of mountSet, all of them if nil.
+func (i *windowsFsInfo) GetFsInfoForPath(mountSet map[string]struct{}) ([]Fs, error) {
+	drives, err := fixedDrives()
+	if err != nil {
+		return nil, err
+	}
+	var filesystems []Fs
+	for _, drive := range drives {
+		if _, ok := mountSet[drive]; mountSet != nil && !ok {
+			continue
+		}
+		fs, err := driveFs(drive)
+		if err != nil {
+			return nil, err
+		}
+		filesystems = append(filesystems, fs)
+	}
+	return filesystems, nil
+}
+
+// GetDirUsage returns the usage of dir by walking it, until ctx is done.
+func (i *windowsFsInfo) GetDirUsage(ctx context.Context, dir string) (UsageInfo, error) {
+	return walkDirUsage(ctx, dir)
+}
+
+func (i *windowsFsInfo) GetDeviceInfoByFsUUID(uuid string) (*DeviceInfo, error) {
+	return nil, ErrNoSuchDevice
+}
+
+// GetDirFsDevice returns the drive of dir.
+func (i *windowsFsInfo) GetDirFsDevice(dir string) (*DeviceInfo, error) {
+	volume := filepath.VolumeName(dir)
+	if volume == "" {
+		return nil, fmt.Errorf("%q isn't on a drive", dir)
+	}
+	return &DeviceInfo{Device: volume + `\`}, nil
+}
+
+func (i *windowsFsInfo) GetDeviceForLabel(label string) (string, error) {
+	return "", fmt.Errorf("no device with label %q", label)
+}
+
+func (i *windowsFsInfo) GetLabelsForDevice(device string) ([]string, error) {
+	return []string{}, nil
+}
+
+// GetMountpointForDevice returns the root path of a drive, being its device.
+func (i *windowsFsInfo) GetMountpointForDevice(device string) (string, error) {
+	return device, nil
+}
@@ -0,0 +1,231 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package fs
+
+import (
+	"fmt"
+	"runtime"
+	"sync/atomic"
+	"syscall"
+	"unsafe"
+
+	"golang.org/x/sys/unix"
+)
+
+// An io_uring ring used to stat the entries of a directory in batches, with a
+// single system call per batch rather than one per entry.
+//
+// Only what is needed for IORING_OP_STATX is implemented, see io_uring(7).
+
+const (
+	ioringOpStatx         = 21
+	ioringEnterGetEvents  = 1 << 0
+	ioringFeatSingleMmap  = 1 << 0
+	ioringOffSqRing       = 0
+	ioringOffCqRing       = 0x8000000
+	ioringOffSqes         = 0x10000000
+	ioringSqeSize         = 64
+	ioringCqeSize         = 16
+	defaultIoUringEntries = 64
+)
+
+// ioUringParams is struct io_uring_params.
+type ioUringParams struct {
+	sqEntries    uint32
+	cqEntries    uint32
+	flags        uint32
+	sqThreadCPU  uint32
+	sqThreadIdle uint32
+	features     uint32
+	wqFd         uint32
+	resv         [3]uint32
+	sqOff        struct {
+		head, tail, ringMask, ringEntries, flags, dropped, array, resv1 uint32
+		userAddr                                                        uint64
+	}
+	cqOff struct {
+		head, tail, ringMask, ringEntries, overflow, cqes, flags, resv1 uint32
+		userAddr                                                        uint64
+	}
+}
+
+// ioUringSqe is the part of struct io_uring_sqe used by IORING_OP_STATX.
+type ioUringSqe struct {
+	opcode      uint8
+	flags       uint8
+	ioprio      uint16
+	fd          int32
+	off         uint64 // struct statx buffer
+	addr        uint64 // path name
+	len         uint32 // statx mask
+	statxFlags  uint32
+	userData    uint64
+	bufIndex    uint16
+	personality uint16
+	spliceFdIn  int32
+	addr3       uint64
+	pad         uint64
+}
+
+// ioUringCqe is struct io_uring_cqe.
+type ioUringCqe struct {
+	userData uint64
+	res      int32
+	flags    uint32
+}
+
+type ioUring struct {
+	fd      int
+	entries uint32
+	sqRing  []byte
+	cqRing  []byte
+	sqes    []byte
+
+	sqHead, sqTail, sqMask *uint32
+	sqArray                unsafe.Pointer
+	cqHead, cqTail, cqMask *uint32
+	cqes                   unsafe.Pointer
+}
+
+// newIoUring sets up a ring of the given number of entries.
+func newIoUring(entries uint32) (*ioUring, error) {
+	var p ioUringParams
+	fd, _, errno := unix.Syscall(unix.SYS_IO_URING_SETUP, uintptr(entries), uintptr(unsafe.Pointer(&p)), 0)
+	if errno != 0 {
+		return nil, fmt.Errorf("io_uring_setup: %w", errno)
+	}
+	r := &ioUring{fd: int(fd), entries: p.sqEntries}
+	sqSize := int(p.sqOff.array + p.sqEntries*4)
+	cqSize := int(p.cqOff.cqes + p.cqEntries*ioringCqeSize)
+	if p.features&ioringFeatSingleMmap != 0 {
+		sqSize = max(sqSize, cqSize)
+	}
+	var err error
+	if r.sqRing, err = unix.Mmap(r.fd, ioringOffSqRing, sqSize, unix.PROT_READ|unix.PROT_WRITE, unix.MAP_SHARED|unix.MAP_POPULATE); err != nil {
+		r.Close()
+		return nil, fmt.Errorf("mmap of the submission queue: %w", err)
+	}
+	r.cqRing = r.sqRing
+	if p.features&ioringFeatSingleMmap == 0 {
+		if r.cqRing, err = unix.Mmap(r.fd, ioringOffCqRing, cqSize, unix.PROT_READ|unix.PROT_WRITE, unix.MAP_SHARED|unix.MAP_POPULATE); err != nil {
+			r.Close()
+			return nil, fmt.Errorf("mmap of the completion queue: %w", err)
+		}
+	}
+	if r.sqes, err = unix.Mmap(r.fd, ioringOffSqes, int(p.sqEntries*ioringSqeSize), unix.PROT_READ|unix.PROT_WRITE, unix.MAP_SHARED|unix.MAP_POPULATE); err != nil {
+		r.Close()
+		return nil, fmt.Errorf("mmap of the submission queue entries: %w", err)
+	}
+	r.sqHead = (*uint32)(unsafe.Pointer(&r.sqRing[p.sqOff.head]))
+	r.sqTail = (*uint32)(unsafe.Pointer(&r.sqRing[p.sqOff.tail]))
+	r.sqMask = (*uint32)(unsafe.Pointer(&r.sqRing[p.sqOff.ringMask]))
+	r.sqArray = unsafe.Pointer(&r.sqRing[p.sqOff.array])
+	r.cqHead = (*uint32)(unsafe.Pointer(&r.cqRing[p.cqOff.head]))
+	r.cqTail = (*uint32)(unsafe.Pointer(&r.cqRing[p.cqOff.tail]))
+	r.cqMask = (*uint32)(unsafe.Pointer(&r.cqRing[p.cqOff.ringMask]))
+	r.cqes = unsafe.Pointer(&r.cqRing[p.cqOff.cqes])
+	return r, nil
+}
+
+// Close unmaps the ring and closes it.
+func (r *ioUring) Close() {
+	if r.sqes != nil {
+		_ = unix.Munmap(r.sqes)
+	}
+	if r.cqRing != nil && &r.cqRing[0] != &r.sqRing[0] {
+		_ = unix.Munmap(r.cqRing)
+	}
+	if r.sqRing != nil {
+		_ = unix.Munmap(r.sqRing)
+	}
+	_ = unix.Close(r.fd)
+}
+
+// statx stats the names relative to the directory dirfd without following
+// symlinks, in batches of the size of the ring, into stats, and returns the
+// error of each name.
+func (r *ioUring) statx(dirfd int, names []string, mask int, stats []unix.Statx_t) ([]error, error) {
+	errs := make([]error, len(names))
+	for start := 0; start < len(names); start += int(r.entries) {
+		end := min(start+int(r.entries), len(names))
+		if err := r.statxBatch(dirfd, names[start:end], mask, stats[start:end], errs[start:end]); err != nil {
+			return nil, err
+		}
+	}
+	return errs, nil
+}
+
+func (r *ioUring) statxBatch(dirfd int, names []string, mask int, stats []unix.Statx_t, errs []error) error {
+	paths := make([]*byte, len(names))
+	for i, name := range names {
+		path, err := unix.BytePtrFromString(name)
+		if err != nil {
+			errs[i] = err
+			continue
+		}
+		paths[i] = path
+	}
+
+	tail := atomic.LoadUint32(r.sqTail)
+	mask32 := atomic.LoadUint32(r.sqMask)
+	submitted := 0
+	for i, path := range paths {
+		if path == nil {
+			continue
+		}
+		index := tail & mask32
+		sqe := (*ioUringSqe)(unsafe.Pointer(&r.sqes[uintptr(index)*ioringSqeSize]))
+		*sqe = ioUringSqe{
+			opcode:     ioringOpStatx,
+			fd:         int32(dirfd),
+			off:        uint64(uintptr(unsafe.Pointer(&stats[i]))),
+			addr:       uint64(uintptr(unsafe.Pointer(path))),
+			len:        uint32(mask),
+			statxFlags: unix.AT_SYMLINK_NOFOLLOW,
+			userData:   uint64(i),
+		}
+		*(*uint32)(unsafe.Add(r.sqArray, uintptr(index)*4)) = index
+		tail++
+		submitted++
+	}
+	atomic.StoreUint32(r.sqTail, tail)
+
+	for completed := 0; completed < submitted; {
+		_, _, errno := unix.Syscall6(unix.SYS_IO_URING_ENTER, uintptr(r.fd), uintptr(submitted-completed), uintptr(submitted-completed), ioringEnterGetEvents, 0, 0)
+		if errno == unix.EINTR {
+			continue
+		}
+		if errno != 0 {
+			return fmt.Errorf("io_uring_enter: %w", errno)
+		}
+		head := atomic.LoadUint32(r.cqHead)
+		cqTail := atomic.LoadUint32(r.cqTail)
+		cqMask := atomic.LoadUint32(r.cqMask)
+		for ; head != cqTail; head++ {
+			cqe := (*ioUringCqe)(unsafe.Add(r.cqes, uintptr(head&cqMask)*ioringCqeSize))
+			if cqe.res < 0 {
+				errs[cqe.userData] = syscall.Errno(-cqe.res)
+			}
+			completed++
+		}
+		atomic.StoreUint32(r.cqHead, head)
+	}
+	// The kernel wrote to stats through the paths until the completions.
+	runtime.KeepAlive(paths)
+	runtime.KeepAlive(stats)
+	return nil
+}
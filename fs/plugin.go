@@ -75,6 +75,17 @@ type FsWatcher interface {
 	Stop()
 }
 
+// FsDirUsagePlugin is an optional interface for plugins that can tell the
+// usage of a directory without walking it, e.g. from the accounting of the
+// filesystem for the subvolume or dataset the directory is.
+type FsDirUsagePlugin interface {
+	FsPlugin
+
+	// GetDirUsage returns the usage of dir, on the filesystem mounted at
+	// partition, or ErrFallbackToVFS if dir is to be walked.
+	GetDirUsage(dir string, partition PartitionInfo) (UsageInfo, error)
+}
+
 // PartitionInfo contains information needed for stats collection.
 type PartitionInfo struct {
 	Mountpoint string
@@ -92,6 +103,9 @@ type FsStats struct {
 	Inodes     *uint64
 	InodesFree *uint64
 	Type       FsType
+	// Allocation of the space of the filesystem, for filesystems reporting
+	// it.
+	Allocation []Allocation
 }
 
 // ErrFallbackToVFS signals that a specialized plugin cannot handle
@@ -0,0 +1,131 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package fs
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"unsafe"
+
+	"golang.org/x/sys/unix"
+)
+
+// Constants of linux/fs.h and linux/quota.h.
+const (
+	// _IOR('X', 31, struct fsxattr), on the architectures using the generic
+	// ioctl numbers.
+	fsIocFsGetXattr = 0x801c581f
+	qGetQuota       = 0x800007
+	prjQuota        = 2
+	subCmdShift     = 8
+)
+
+// fsxattr is struct fsxattr of linux/fs.h.
+type fsxattr struct {
+	xflags     uint32
+	extsize    uint32
+	nextents   uint32
+	projid     uint32
+	cowextsize uint32
+	pad        [8]byte
+}
+
+// ifDqblk is struct if_dqblk of linux/quota.h.
+type ifDqblk struct {
+	bhardlimit uint64
+	bsoftlimit uint64
+	curspace   uint64
+	ihardlimit uint64
+	isoftlimit uint64
+	curinodes  uint64
+	btime      uint64
+	itime      uint64
+	valid      uint32
+}
+
+// getProjectID returns the project id of a directory, 0 if it has none.
+func getProjectID(dir string) (uint32, error) {
+	f, err := os.Open(dir)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+	var attr fsxattr
+	if _, _, errno := unix.Syscall(unix.SYS_IOCTL, f.Fd(), fsIocFsGetXattr, uintptr(unsafe.Pointer(&attr))); errno != 0 {
+		return 0, fmt.Errorf("failed to get the attributes of %q: %v", dir, errno)
+	}
+	return attr.projid, nil
+}
+
+// projectQuotaEnabled returns whether the superblock options of a mount
+// enable project quotas: prjquota for ext4 and XFS, pquota and pqnoenforce
+// for XFS.
+func projectQuotaEnabled(vfsOptions string) bool {
+	for _, option := range strings.Split(vfsOptions, ",") {
+		switch option {
+		case "prjquota", "pquota", "pqnoenforce":
+			return true
+		}
+	}
+	return false
+}
+
+// getDirQuotaUsage returns the usage of dir from its project quota, or
+// ErrNoProjectQuota if the filesystem of dir is mounted without project
+// quotas or dir has no project of its own. Runtimes assign projects to
+// container directories on such filesystems, e.g. the overlay upperdirs of
+// containers with a storage size limit, which their files inherit. Projects
+// shared with the root of the filesystem account for more than dir.
+func (i *RealFsInfo) getDirQuotaUsage(dir string) (UsageInfo, error) {
+	mnt, found := i.mountInfoFromDir(dir)
+	if !found || !projectQuotaEnabled(mnt.VFSOptions) {
+		return UsageInfo{}, ErrNoProjectQuota
+	}
+	id, err := getProjectID(dir)
+	if err != nil {
+		return UsageInfo{}, err
+	}
+	rootID, err := getProjectID(mnt.Mountpoint)
+	if err != nil {
+		return UsageInfo{}, err
+	}
+	if id == 0 || id == rootID {
+		return UsageInfo{}, ErrNoProjectQuota
+	}
+	return getProjectQuota(id, mnt.Source)
+}
+
+// getProjectQuota returns the usage accounted by the quota of a project on
+// device.
+func getProjectQuota(id uint32, device string) (UsageInfo, error) {
+	devicePtr, err := unix.BytePtrFromString(device)
+	if err != nil {
+		return UsageInfo{}, err
+	}
+	var quota ifDqblk
+	cmd := uint32(qGetQuota<<subCmdShift | prjQuota)
+	_, _, errno := unix.Syscall6(unix.SYS_QUOTACTL, uintptr(cmd), uintptr(unsafe.Pointer(devicePtr)), uintptr(id), uintptr(unsafe.Pointer(&quota)), 0, 0)
+	if errno != 0 {
+		if errno == unix.ESRCH || errno == unix.ENOENT {
+			// Project quotas are not enabled on the filesystem.
+			return UsageInfo{}, ErrNoProjectQuota
+		}
+		return UsageInfo{}, fmt.Errorf("failed to get the quota of project %d on %q: %v", id, device, errno)
+	}
+	return UsageInfo{Bytes: quota.curspace, Inodes: quota.curinodes}, nil
+}
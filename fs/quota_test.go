@@ -0,0 +1,65 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package fs
+
+import (
+	"context"
+	"testing"
+	"unsafe"
+
+	mount "github.com/moby/sys/mountinfo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQuotaStructSizes(t *testing.T) {
+	// The sizes the kernel expects.
+	assert.Equal(t, uintptr(28), unsafe.Sizeof(fsxattr{}))
+	assert.Equal(t, uintptr(72), unsafe.Sizeof(ifDqblk{}))
+}
+
+func TestProjectQuotaEnabled(t *testing.T) {
+	for options, expected := range map[string]bool{
+		"rw,attr2,inode64,logbufs=8,logbsize=32k,prjquota": true,
+		"rw,attr2,inode64,pquota":                          true,
+		"rw,pqnoenforce":                                   true,
+		"rw,attr2,inode64,usrquota,grpquota":               false,
+		"rw,errors=remount-ro":                             false,
+		"":                                                 false,
+	} {
+		assert.Equal(t, expected, projectQuotaEnabled(options), options)
+	}
+}
+
+func TestGetDirQuotaUsageWithoutProjectQuota(t *testing.T) {
+	dir := t.TempDir()
+	fsInfo := &RealFsInfo{mounts: map[string]mount.Info{
+		"/": {Mountpoint: "/", Source: "/dev/sda1", FSType: "ext4", VFSOptions: "rw,errors=remount-ro"},
+	}}
+	_, err := fsInfo.getDirQuotaUsage(dir)
+	assert.ErrorIs(t, err, ErrNoProjectQuota)
+
+	// Directories created by tests are in no project, on filesystems which
+	// may not even support projects.
+	fsInfo.mounts["/"] = mount.Info{Mountpoint: "/", Source: "/dev/sda1", FSType: "xfs", VFSOptions: "rw,prjquota"}
+	_, err = fsInfo.getDirQuotaUsage(dir)
+	assert.Error(t, err)
+
+	// The usage is still walked.
+	usage, err := fsInfo.GetDirUsage(context.Background(), dir)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(1), usage.Inodes)
+}
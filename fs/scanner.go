@@ -0,0 +1,320 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package fs
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"golang.org/x/sys/unix"
+
+	"k8s.io/klog/v2"
+)
+
+var (
+	diskUsageConcurrency = flag.Int("disk_usage_concurrency", 20, "Maximum number of directories read at once by the disk usage scans of all containers")
+	diskUsageIOPS        = flag.Int("disk_usage_iops", 0, "Maximum number of files per second stat'd by the disk usage scans of all containers. 0 is unlimited")
+	diskUsageIoUring     = flag.Bool("disk_usage_io_uring", true, "Whether to stat the files of directories in batches with io_uring, on kernels supporting it, when scanning the disk usage of containers")
+)
+
+// statxMask is the part of struct statx the disk usage scans need.
+const statxMask = unix.STATX_TYPE | unix.STATX_NLINK | unix.STATX_INO | unix.STATX_BLOCKS
+
+// The scan workers, limiting the number of directories read at once by all
+// scans. Each worker has an io_uring ring of its own, set up on first use.
+var (
+	scanWorkersOnce sync.Once
+	scanWorkers     chan *scanWorker
+	scanLimiter     *rateLimiter
+
+	// Set once setting up a ring failed, e.g. as io_uring is disabled.
+	ioUringUnsupported atomic.Bool
+)
+
+type scanWorker struct {
+	ring *ioUring
+}
+
+func initScanWorkers() {
+	scanWorkersOnce.Do(func() {
+		n := max(*diskUsageConcurrency, 1)
+		scanWorkers = make(chan *scanWorker, n)
+		for i := 0; i < n; i++ {
+			scanWorkers <- &scanWorker{}
+		}
+		if *diskUsageIOPS > 0 {
+			scanLimiter = newRateLimiter(*diskUsageIOPS)
+		}
+	})
+}
+
+// claimWorker waits for a scan worker, unless ctx is done first.
+func claimWorker(ctx context.Context) (*scanWorker, error) {
+	initScanWorkers()
+	select {
+	case w := <-scanWorkers:
+		return w, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// tryClaimWorker returns a scan worker if one is idle.
+func tryClaimWorker() *scanWorker {
+	select {
+	case w := <-scanWorkers:
+		return w
+	default:
+		return nil
+	}
+}
+
+func releaseWorker(w *scanWorker) {
+	scanWorkers <- w
+}
+
+// statx stats the names relative to dirfd into stats, with the io_uring ring
+// of the worker when possible.
+func (w *scanWorker) statx(dirfd int, names []string, stats []unix.Statx_t) []error {
+	if *diskUsageIoUring && w.ring == nil && !ioUringUnsupported.Load() {
+		ring, err := newIoUring(defaultIoUringEntries)
+		if err != nil {
+			if !ioUringUnsupported.Swap(true) {
+				klog.V(2).Infof("Not using io_uring for disk usage scans: %v", err)
+			}
+		} else {
+			w.ring = ring
+		}
+	}
+	if w.ring != nil {
+		errs, err := w.ring.statx(dirfd, names, statxMask, stats)
+		if err == nil {
+			unsupported := true
+			for i, name := range names {
+				// Kernels before 5.6 do not support statx with io_uring.
+				if errs[i] == unix.EINVAL {
+					errs[i] = unix.Statx(dirfd, name, unix.AT_SYMLINK_NOFOLLOW, statxMask, &stats[i])
+				} else {
+					unsupported = false
+				}
+			}
+			if !unsupported {
+				return errs
+			}
+			ioUringUnsupported.Store(true)
+			err = errors.New("statx is not supported")
+		}
+		klog.V(2).Infof("Not using io_uring for disk usage scans: %v", err)
+		w.ring.Close()
+		w.ring = nil
+	}
+	errs := make([]error, len(names))
+	for i, name := range names {
+		errs[i] = unix.Statx(dirfd, name, unix.AT_SYMLINK_NOFOLLOW, statxMask, &stats[i])
+	}
+	return errs
+}
+
+// scan is the state of the scan of the usage of a directory.
+type scan struct {
+	ctx context.Context
+	dev uint64
+
+	wg    sync.WaitGroup
+	mu    sync.Mutex
+	usage UsageInfo
+	// Inodes that could be counted several times, as having several links.
+	dedupedInodes map[uint64]struct{}
+	err           error
+}
+
+// scanDirUsage returns the usage of dir, walking it concurrently with the
+// scan workers it can claim. It does not descend into other filesystems.
+func scanDirUsage(ctx context.Context, dir string) (UsageInfo, error) {
+	if dir == "" {
+		return UsageInfo{}, fmt.Errorf("invalid directory")
+	}
+	var root unix.Statx_t
+	if err := unix.Statx(unix.AT_FDCWD, dir, 0, statxMask, &root); err != nil {
+		return UsageInfo{}, fmt.Errorf("could not stat %q to get inode usage: %v", dir, err)
+	}
+	w, err := claimWorker(ctx)
+	if err != nil {
+		return UsageInfo{}, err
+	}
+	s := &scan{
+		ctx:           ctx,
+		dev:           unix.Mkdev(root.Dev_major, root.Dev_minor),
+		dedupedInodes: make(map[uint64]struct{}),
+	}
+	s.add(&root)
+	s.walk(w, dir)
+	releaseWorker(w)
+	s.wg.Wait()
+	return s.usage, s.err
+}
+
+// walk adds the usage of dir and its subdirectories, handing subdirectories
+// to idle workers and walking them itself otherwise.
+func (s *scan) walk(w *scanWorker, dir string) {
+	if err := s.ctx.Err(); err != nil {
+		s.fail(err)
+		return
+	}
+	for _, subdir := range s.readDir(w, dir) {
+		if idle := tryClaimWorker(); idle != nil {
+			s.wg.Add(1)
+			go func() {
+				defer s.wg.Done()
+				defer releaseWorker(idle)
+				s.walk(idle, subdir)
+			}()
+			continue
+		}
+		s.walk(w, subdir)
+	}
+}
+
+// readDir adds the usage of the entries of dir and returns its
+// subdirectories on the same filesystem. The directory is closed before
+// walking the subdirectories, so that deep trees don't hold a file
+// descriptor per level.
+func (s *scan) readDir(w *scanWorker, dir string) []string {
+	f, err := os.Open(dir)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			s.fail(fmt.Errorf("unable to count inodes for part of dir %s: %s", dir, err))
+		}
+		return nil
+	}
+	defer f.Close()
+	var subdirs []string
+	for {
+		names, err := f.Readdirnames(defaultIoUringEntries)
+		if len(names) > 0 {
+			subdirs = append(subdirs, s.statEntries(w, int(f.Fd()), dir, names)...)
+		}
+		if err != nil {
+			if err != io.EOF {
+				s.fail(fmt.Errorf("unable to count inodes for part of dir %s: %s", dir, err))
+			}
+			return subdirs
+		}
+		if s.ctx.Err() != nil {
+			return nil
+		}
+	}
+}
+
+// statEntries adds the usage of the names of dir and returns those of
+// subdirectories on the same filesystem.
+func (s *scan) statEntries(w *scanWorker, dirfd int, dir string, names []string) []string {
+	if scanLimiter != nil {
+		if err := scanLimiter.wait(s.ctx, len(names)); err != nil {
+			s.fail(err)
+			return nil
+		}
+	}
+	stats := make([]unix.Statx_t, len(names))
+	errs := w.statx(dirfd, names, stats)
+	var subdirs []string
+	for i := range names {
+		if errs[i] == unix.ENOENT {
+			// Expected if files appear and vanish.
+			continue
+		}
+		if errs[i] != nil {
+			s.fail(fmt.Errorf("unable to count inodes for part of dir %s: %s", dir, errs[i]))
+			continue
+		}
+		stat := &stats[i]
+		if unix.Mkdev(stat.Dev_major, stat.Dev_minor) != s.dev {
+			// Don't count or descend into other filesystems.
+			continue
+		}
+		s.add(stat)
+		if stat.Mode&unix.S_IFMT == unix.S_IFDIR {
+			subdirs = append(subdirs, filepath.Join(dir, names[i]))
+		}
+	}
+	return subdirs
+}
+
+func (s *scan) add(stat *unix.Statx_t) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if stat.Nlink > 1 {
+		if _, ok := s.dedupedInodes[stat.Ino]; ok {
+			return
+		}
+		s.dedupedInodes[stat.Ino] = struct{}{}
+	}
+	s.usage.Bytes += stat.Blocks * statBlockSize
+	s.usage.Inodes++
+}
+
+// fail records the first error of the scan.
+func (s *scan) fail(err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.err == nil {
+		s.err = err
+	}
+}
+
+// rateLimiter is a token bucket of a burst of a second of its rate.
+type rateLimiter struct {
+	mu     sync.Mutex
+	rate   float64
+	tokens float64
+	last   time.Time
+}
+
+func newRateLimiter(perSecond int) *rateLimiter {
+	return &rateLimiter{rate: float64(perSecond), tokens: float64(perSecond), last: time.Now()}
+}
+
+// wait takes n tokens, waiting for those the bucket is short of unless ctx
+// is done first.
+func (l *rateLimiter) wait(ctx context.Context, n int) error {
+	l.mu.Lock()
+	now := time.Now()
+	l.tokens = min(l.tokens+now.Sub(l.last).Seconds()*l.rate, l.rate)
+	l.last = now
+	l.tokens -= float64(n)
+	delay := time.Duration(-l.tokens / l.rate * float64(time.Second))
+	l.mu.Unlock()
+	if delay <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,116 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package fs
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"syscall"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/sys/unix"
+)
+
+func TestScanDirUsage(t *testing.T) {
+	dir := t.TempDir()
+	for i := 0; i < 5; i++ {
+		sub := filepath.Join(dir, fmt.Sprintf("dir%d", i), "nested")
+		require.NoError(t, os.MkdirAll(sub, 0755))
+		for j := 0; j < 100; j++ {
+			require.NoError(t, os.WriteFile(filepath.Join(sub, fmt.Sprintf("file%d", j)), make([]byte, 4096*j), 0644))
+		}
+	}
+	// Hard links are counted once, and symlinks not followed.
+	require.NoError(t, os.Link(filepath.Join(dir, "dir0", "nested", "file99"), filepath.Join(dir, "link")))
+	require.NoError(t, os.Symlink("/usr", filepath.Join(dir, "symlink")))
+
+	var expected UsageInfo
+	inodes := map[uint64]struct{}{}
+	require.NoError(t, filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		require.NoError(t, err)
+		s := info.Sys().(*syscall.Stat_t)
+		if _, ok := inodes[s.Ino]; !ok {
+			inodes[s.Ino] = struct{}{}
+			expected.Bytes += uint64(s.Blocks) * statBlockSize
+			expected.Inodes++
+		}
+		return nil
+	}))
+
+	usage, err := scanDirUsage(context.Background(), dir)
+	require.NoError(t, err)
+	assert.Equal(t, expected, usage)
+}
+
+func TestScanDirUsageCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := scanDirUsage(ctx, t.TempDir())
+	assert.ErrorIs(t, err, context.Canceled)
+
+	_, err = scanDirUsage(context.Background(), filepath.Join(t.TempDir(), "missing"))
+	assert.Error(t, err)
+}
+
+func TestIoUringStatx(t *testing.T) {
+	ring, err := newIoUring(4)
+	if err != nil {
+		t.Skipf("io_uring is not available: %v", err)
+	}
+	defer ring.Close()
+
+	dir := t.TempDir()
+	names := []string{"missing"}
+	for i := 0; i < 10; i++ {
+		name := fmt.Sprintf("file%d", i)
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), make([]byte, 1000*i), 0644))
+		names = append(names, name)
+	}
+	d, err := os.Open(dir)
+	require.NoError(t, err)
+	defer d.Close()
+
+	stats := make([]unix.Statx_t, len(names))
+	errs, err := ring.statx(int(d.Fd()), names, statxMask, stats)
+	require.NoError(t, err)
+	if errs[1] == unix.EINVAL {
+		t.Skip("statx is not supported by io_uring")
+	}
+	assert.Equal(t, unix.ENOENT, errs[0])
+	for i, name := range names[1:] {
+		require.NoError(t, errs[i+1])
+		info, err := os.Lstat(filepath.Join(dir, name))
+		require.NoError(t, err)
+		s := info.Sys().(*syscall.Stat_t)
+		assert.Equal(t, s.Ino, stats[i+1].Ino, name)
+		assert.Equal(t, uint64(s.Blocks), stats[i+1].Blocks, name)
+	}
+}
+
+func TestRateLimiter(t *testing.T) {
+	l := newRateLimiter(100)
+	// The burst is a second of the rate.
+	require.NoError(t, l.wait(context.Background(), 100))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.ErrorIs(t, l.wait(ctx, 50), context.Canceled)
+}
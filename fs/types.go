@@ -15,6 +15,7 @@
 package fs
 
 import (
+	"context"
 	"errors"
 )
 
@@ -71,6 +72,20 @@ type Fs struct {
 	Inodes     *uint64
 	InodesFree *uint64
 	DiskStats  DiskStats
+	// Whether the filesystem is mounted with project quotas, which the
+	// usage of directories with a project of their own is read from.
+	ProjectQuota bool
+	// Allocation of the space of the filesystem, for filesystems reporting
+	// it.
+	Allocation []Allocation
+}
+
+// Allocation is how much of the space a filesystem allocated to a kind of
+// data is used, e.g. to the metadata chunks of btrfs.
+type Allocation struct {
+	Type  string
+	Total uint64
+	Used  uint64
 }
 
 type DiskStats struct {
@@ -102,6 +117,9 @@ var (
 
 	// ErrDeviceNotInPartitionsMap is the error resulting if a device could not be found in the partitions map.
 	ErrDeviceNotInPartitionsMap = errors.New("could not find device in cached partitions map")
+
+	// ErrNoProjectQuota is the error indicating the usage of a directory is not accounted by a project quota.
+	ErrNoProjectQuota = errors.New("no project quota")
 )
 
 type FsInfo interface {
@@ -111,8 +129,9 @@ type FsInfo interface {
 	// Returns capacity and free space, in bytes, of the set of mounts passed.
 	GetFsInfoForPath(mountSet map[string]struct{}) ([]Fs, error)
 
-	// GetDirUsage returns a usage information for 'dir'.
-	GetDirUsage(dir string) (UsageInfo, error)
+	// GetDirUsage returns a usage information for 'dir', giving up when ctx
+	// is done.
+	GetDirUsage(ctx context.Context, dir string) (UsageInfo, error)
 
 	// GetDeviceInfoByFsUUID returns the information of the device with the
 	// specified filesystem uuid. If no such device exists, this function will
@@ -0,0 +1,50 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build windows || freebsd
+
+package fs
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"path/filepath"
+)
+
+// walkDirUsage returns the usage of dir by walking it, until ctx is done.
+func walkDirUsage(ctx context.Context, dir string) (UsageInfo, error) {
+	if dir == "" {
+		return UsageInfo{}, fmt.Errorf("invalid directory")
+	}
+	var usage UsageInfo
+	err := filepath.WalkDir(dir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+		usage.Inodes++
+		if !entry.IsDir() {
+			usage.Bytes += uint64(info.Size())
+		}
+		return nil
+	})
+	return usage, err
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/google/cadvisor/fs"
 
 	mount "github.com/moby/sys/mountinfo"
+	"k8s.io/klog/v2"
 )
 
 type zfsPlugin struct{}
@@ -52,7 +53,11 @@ func (p *zfsPlugin) GetStats(device string, partition fs.PartitionInfo) (*fs.FsS
 
 	capacity, free, avail, err := GetZfsStats(device)
 	if err != nil {
-		return nil, err
+		// The zfs command may be missing, e.g. in a container image without
+		// it. The kernel still reports the usage of the dataset mounted at
+		// the mountpoint.
+		klog.V(4).Infof("unable to get the stats of zfs dataset %s, falling back to statfs: %v", device, err)
+		return nil, fs.ErrFallbackToVFS
 	}
 
 	return &fs.FsStats{
@@ -0,0 +1,39 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package zfs
+
+import (
+	"path/filepath"
+
+	"github.com/google/cadvisor/fs"
+	"github.com/google/cadvisor/zfs"
+)
+
+// GetDirUsage returns the usage of a dataset, such as the root filesystem of
+// a container of the zfs storage driver of Docker or snapshotter of
+// containerd, from the space and objects the kernel reports it references.
+// Directories within a dataset are walked.
+func (p *zfsPlugin) GetDirUsage(dir string, partition fs.PartitionInfo) (fs.UsageInfo, error) {
+	if filepath.Clean(dir) != filepath.Clean(partition.Mountpoint) {
+		return fs.UsageInfo{}, fs.ErrFallbackToVFS
+	}
+	usage, err := zfs.GetDatasetUsage(dir)
+	if err != nil {
+		return fs.UsageInfo{}, err
+	}
+	return fs.UsageInfo{Bytes: usage.Referenced, Inodes: usage.Objects}, nil
+}
@@ -0,0 +1,31 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package zfs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/google/cadvisor/fs"
+)
+
+func TestGetDirUsageWithinDataset(t *testing.T) {
+	p := &zfsPlugin{}
+	_, err := p.GetDirUsage("/tank/docker/volumes/data", fs.PartitionInfo{Mountpoint: "/tank/docker", FsType: "zfs"})
+	assert.ErrorIs(t, err, fs.ErrFallbackToVFS)
+}
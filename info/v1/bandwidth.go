@@ -0,0 +1,42 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import "time"
+
+// UncoreTraffic is the traffic counted by the uncore PMUs of the machine.
+type UncoreTraffic struct {
+	// Time at which the counters were read.
+	Timestamp time.Time `json:"timestamp"`
+
+	// Traffic of the memory channels.
+	Memory []TrafficCounter `json:"memory,omitempty"`
+	// Traffic of the links between sockets, e.g. Intel UPI or AMD Infinity
+	// Fabric.
+	Interconnect []TrafficCounter `json:"interconnect,omitempty"`
+}
+
+// TrafficCounter is the traffic of a memory channel or of an interconnect
+// link of a socket, in one direction.
+type TrafficCounter struct {
+	Socket int `json:"socket"`
+	// Channel or link number within the socket.
+	Channel string `json:"channel"`
+	// Direction of the traffic: read, write or all for memory channels
+	// counting both together, transmit or receive for links.
+	Direction string `json:"direction"`
+	// Bytes transferred since cAdvisor started counting.
+	Bytes uint64 `json:"bytes"`
+}
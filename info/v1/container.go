@@ -77,6 +77,31 @@ type ContainerSpec struct {
 
 	// Image name used for this container.
 	Image string `json:"image,omitempty"`
+
+	// Policy by which the runtime restarts the container, if known.
+	RestartPolicy *RestartPolicy `json:"restart_policy,omitempty"`
+
+	// Volumes and bind mounts of the container.
+	Mounts []MountSpec `json:"mounts,omitempty"`
+}
+
+type RestartPolicy struct {
+	// Name of the policy, e.g. "no", "always", "on-failure" or "unless-stopped".
+	Name string `json:"name"`
+	// Number of restarts after which the runtime gives up, 0 if unlimited.
+	MaximumRetryCount int `json:"maximum_retry_count,omitempty"`
+}
+
+type MountSpec struct {
+	// Type of the mount, e.g. "bind", "volume" or "tmpfs".
+	Type string `json:"type"`
+	// Name of the volume, for volume mounts.
+	Name string `json:"name,omitempty"`
+	// Path of the mount source on the host.
+	Source string `json:"source,omitempty"`
+	// Path of the mount inside the container.
+	Destination string `json:"destination"`
+	ReadOnly    bool   `json:"read_only"`
 }
 
 // Container reference contains enough information to uniquely identify a container
@@ -807,6 +832,13 @@ type FsStats struct {
 	// Number of available Inodes
 	InodesFree uint64 `json:"inodes_free"`
 
+	// Number of inodes used by the container on the filesystem, e.g. by its
+	// writable layer.
+	InodesUsed uint64 `json:"inodes_used,omitempty"`
+
+	// Number of inodes of the filesystem.
+	InodesTotal uint64 `json:"inodes_total,omitempty"`
+
 	// Number of reads completed
 	// This is the total number of reads completed successfully.
 	ReadsCompleted uint64 `json:"reads_completed"`
@@ -862,6 +894,76 @@ type FsStats struct {
 	WeightedIoTime uint64 `json:"weighted_io_time"`
 }
 
+// VolumeStats are the statistics of the filesystem of a volume mounted in a
+// container.
+type VolumeStats struct {
+	// The mountpoint of the volume, in the mount namespace of the container.
+	Mountpoint string `json:"mountpoint"`
+
+	// The device of the filesystem of the volume.
+	Device string `json:"device"`
+
+	// The type of the filesystem of the volume.
+	Type string `json:"type"`
+
+	// Number of bytes of the filesystem.
+	Capacity uint64 `json:"capacity"`
+
+	// Number of bytes available to non-root users on the filesystem.
+	Available uint64 `json:"available"`
+
+	// Number of inodes of the filesystem.
+	Inodes uint64 `json:"inodes"`
+
+	// Number of inodes free on the filesystem.
+	InodesFree uint64 `json:"inodes_free"`
+}
+
+// NetworkFsStats are the statistics of a mount of a network filesystem.
+type NetworkFsStats struct {
+	// The device of the mount, e.g. "server:/export" for NFS.
+	Device string `json:"device"`
+
+	// The mountpoint, in the mount namespace of the container.
+	Mountpoint string `json:"mountpoint"`
+
+	// The filesystem type, e.g. "nfs4" or "ceph".
+	Type string `json:"type"`
+
+	// Number of bytes read from the server.
+	ReadBytes uint64 `json:"read_bytes"`
+
+	// Number of bytes written to the server.
+	WriteBytes uint64 `json:"write_bytes"`
+
+	// Statistics of the operations sent to the server, per operation.
+	Operations []NetworkFsOperationStats `json:"operations,omitempty"`
+}
+
+// NetworkFsOperationStats are the statistics of an operation of a network
+// filesystem.
+type NetworkFsOperationStats struct {
+	// The operation, e.g. "READ" or "GETATTR" for NFS, "read", "write" or
+	// "metadata" for CephFS.
+	Name string `json:"name"`
+
+	// Number of operations.
+	Count uint64 `json:"count"`
+
+	// Number of operations that failed.
+	Errors uint64 `json:"errors"`
+
+	// Number of operations that timed out, and were retransmitted.
+	Timeouts uint64 `json:"timeouts"`
+
+	// Number of milliseconds spent waiting for the replies of the server.
+	RTT uint64 `json:"rtt"`
+
+	// Number of milliseconds spent doing the operations, from their
+	// submission to their completion.
+	ExecuteTime uint64 `json:"execute_time"`
+}
+
 type AcceleratorStats struct {
 	// Make of the accelerator (nvidia, amd, google etc.)
 	Make string `json:"make"`
@@ -883,6 +985,18 @@ type AcceleratorStats struct {
 	// Percent of time over the past sample period during which
 	// the accelerator was actively processing.
 	DutyCycle uint64 `json:"duty_cycle"`
+
+	// Id and profile of the MIG GPU instance (e.g. 1g.5gb), for the stats of
+	// an instance rather than of a whole GPU.
+	GPUInstance        string `json:"gpu_instance,omitempty"`
+	GPUInstanceProfile string `json:"gpu_instance_profile,omitempty"`
+
+	// Percent of the warps the streaming multiprocessors can hold that were
+	// resident, over the past sample period.
+	SMOccupancy uint64 `json:"sm_occupancy,omitempty"`
+
+	// The last XID error the driver reported for the accelerator, 0 if none.
+	XIDError uint64 `json:"xid_error,omitempty"`
 }
 
 // PerfStat represents value of a single monitored perf event.
@@ -903,6 +1017,16 @@ type PerfValue struct {
 	// See: https://lwn.net/Articles/324756/
 	ScalingRatio float64 `json:"scaling_ratio"`
 
+	// Time the event was enabled and running for, in nanoseconds.
+	TimeEnabled uint64 `json:"time_enabled,omitempty"`
+	TimeRunning uint64 `json:"time_running,omitempty"`
+
+	// Unreliable is set when the event was measured for too short a part of
+	// the time it was enabled, its scaling ratio being under the minimum of
+	// the perf events configuration, or not at all, for its value to be
+	// trusted.
+	Unreliable bool `json:"unreliable,omitempty"`
+
 	// Value represents value of perf event retrieved from OS. It is
 	// normalized against ScalingRatio and takes multiplexing into
 	// consideration.
@@ -916,6 +1040,12 @@ type PerfValue struct {
 // See: https://01.org/cache-monitoring-technology
 // See: https://www.kernel.org/doc/Documentation/x86/intel_rdt_ui.txt
 type MemoryBandwidthStats struct {
+	// Id of the L3 cache domain, as in the mon_data/mon_L3_<id> directory.
+	ID int `json:"id"`
+
+	// Socket of the L3 cache domain, -1 if unknown.
+	Socket int `json:"socket"`
+
 	// The 'mbm_total_bytes'.
 	TotalBytes uint64 `json:"mbm_total_bytes,omitempty"`
 
@@ -927,13 +1057,19 @@ type MemoryBandwidthStats struct {
 // See: https://01.org/cache-monitoring-technology
 // See: https://www.kernel.org/doc/Documentation/x86/intel_rdt_ui.txt
 type CacheStats struct {
+	// Id of the L3 cache domain, as in the mon_data/mon_L3_<id> directory.
+	ID int `json:"id"`
+
+	// Socket of the L3 cache domain, -1 if unknown.
+	Socket int `json:"socket"`
+
 	// The 'llc_occupancy'.
 	LLCOccupancy uint64 `json:"llc_occupancy,omitempty"`
 }
 
 // ResctrlStats corresponds to statistics from Resource Control.
 type ResctrlStats struct {
-	// Each NUMA Node statistics corresponds to one element in the array.
+	// Each L3 cache domain statistics corresponds to one element in the array.
 	MemoryBandwidth []MemoryBandwidthStats `json:"memory_bandwidth,omitempty"`
 	Cache           []CacheStats           `json:"cache,omitempty"`
 }
@@ -975,9 +1111,26 @@ type ProcessStats struct {
 	Ulimits []UlimitSpec `json:"ulimits,omitempty"`
 }
 
+// ProcessUsage is the resource usage of a process of a container.
+type ProcessUsage struct {
+	Pid int `json:"pid"`
+
+	// The command name of the process, as in /proc/<pid>/comm.
+	Command string `json:"command"`
+
+	// Cumulative CPU time consumed by the process, in nanoseconds.
+	CpuUsage uint64 `json:"cpu_usage"`
+
+	// Resident memory of the process, in bytes.
+	RSS uint64 `json:"rss"`
+}
+
 type Health struct {
 	// Health status of the container
 	Status string `json:"status"`
+
+	// Number of consecutive failed health checks
+	FailingStreak int `json:"failing_streak,omitempty"`
 }
 
 type ContainerStats struct {
@@ -991,6 +1144,12 @@ type ContainerStats struct {
 	// Filesystem statistics
 	Filesystem []FsStats `json:"filesystem,omitempty"`
 
+	// Statistics of the filesystems of the volumes mounted in the container
+	Volumes []VolumeStats `json:"volumes,omitempty"`
+
+	// Statistics of the network filesystems mounted in the container
+	NetworkFs []NetworkFsStats `json:"network_fs,omitempty"`
+
 	// Task load stats
 	TaskStats LoadStats `json:"task_stats,omitempty"`
 
@@ -1000,6 +1159,9 @@ type ContainerStats struct {
 	// ProcessStats for Containers
 	Processes ProcessStats `json:"processes,omitempty"`
 
+	// The processes using the most CPU and memory in the container, ordered by pid
+	TopProcesses []ProcessUsage `json:"top_processes,omitempty"`
+
 	// Custom metrics from all collectors
 	CustomMetrics map[string][]MetricVal `json:"custom_metrics,omitempty"`
 
@@ -1013,6 +1175,9 @@ type ContainerStats struct {
 	// Referenced memory
 	ReferencedMemory uint64 `json:"referenced_memory,omitempty"`
 
+	// Size of the device DAX (persistent memory) mappings of the processes
+	DaxMemory uint64 `json:"dax_memory,omitempty"`
+
 	// Resource Control (resctrl) statistics
 	Resctrl ResctrlStats `json:"resctrl,omitempty"`
 
@@ -1032,6 +1197,14 @@ func timeEq(t1, t2 time.Time, tolerance time.Duration) bool {
 	return diff <= tolerance
 }
 
+// ContainerStatsUpdate is a sample of the stats of a container, as streamed
+// when housekeeping collects it.
+type ContainerStatsUpdate struct {
+	// Absolute name of the container.
+	Name  string          `json:"name"`
+	Stats *ContainerStats `json:"stats"`
+}
+
 const (
 	// 10ms, i.e. 0.01s
 	timePrecision time.Duration = 10 * time.Millisecond
@@ -1067,9 +1240,18 @@ func (a *ContainerStats) StatsEq(b *ContainerStats) bool {
 	if !reflect.DeepEqual(a.Processes, b.Processes) {
 		return false
 	}
+	if !reflect.DeepEqual(a.TopProcesses, b.TopProcesses) {
+		return false
+	}
 	if !reflect.DeepEqual(a.Filesystem, b.Filesystem) {
 		return false
 	}
+	if !reflect.DeepEqual(a.Volumes, b.Volumes) {
+		return false
+	}
+	if !reflect.DeepEqual(a.NetworkFs, b.NetworkFs) {
+		return false
+	}
 	if !reflect.DeepEqual(a.TaskStats, b.TaskStats) {
 		return false
 	}
@@ -1098,6 +1280,13 @@ type Event struct {
 	// the original event object and all of its extraneous data, ex. an
 	// OomInstance
 	EventData EventData `json:"event_data,omitempty"`
+
+	// the labels of the container when the event occurred
+	ContainerLabels map[string]string `json:"container_labels,omitempty"`
+
+	// the number of events of the container and type that rate limiting
+	// collapsed into this one, unset for events reported on their own
+	Count int `json:"count,omitempty"`
 }
 
 // EventType is an enumerated type which lists the categories under which
@@ -1109,6 +1298,14 @@ const (
 	EventOomKill           EventType = "oomKill"
 	EventContainerCreation EventType = "containerCreation"
 	EventContainerDeletion EventType = "containerDeletion"
+	EventHealthStatus      EventType = "healthStatus"
+	EventLoadShedding      EventType = "loadShedding"
+	EventCpuThrottling     EventType = "cpuThrottling"
+	EventMemoryPressure    EventType = "memoryPressure"
+	EventProcess           EventType = "process"
+	EventMachineChanged    EventType = "machineChanged"
+	EventInodeUsage        EventType = "inodeUsage"
+	EventAcceleratorXID    EventType = "acceleratorXid"
 )
 
 // Extra information about an event. Only one type will be set.
@@ -1118,6 +1315,24 @@ type EventData struct {
 
 	// Information about a container deletion event.
 	ContainerDeletion *ContainerDeletionEventData `json:"container_deletion,omitempty"`
+
+	// Information about a change of the health status of a container.
+	HealthStatus *HealthStatusEventData `json:"health_status,omitempty"`
+
+	// Information about a change of the load shedding level of cAdvisor.
+	LoadShedding *LoadSheddingEventData `json:"load_shedding,omitempty"`
+
+	// Information about a resource usage of a container crossing a threshold.
+	Threshold *ThresholdEventData `json:"threshold,omitempty"`
+
+	// Information about a process started or ended in a container.
+	Process *ProcessEventData `json:"process,omitempty"`
+
+	// Information about a change of the capacity of the machine.
+	MachineChanged *MachineChangedEventData `json:"machine_changed,omitempty"`
+
+	// Information about an XID error of an accelerator of a container.
+	AcceleratorXID *AcceleratorXIDEventData `json:"accelerator_xid,omitempty"`
 }
 
 // Information related to an OOM kill instance
@@ -1135,3 +1350,105 @@ type ContainerDeletionEventData struct {
 	// A value of -1 indicates the exit code was not available or not applicable.
 	ExitCode int `json:"exit_code"`
 }
+
+// Information related to a change of the health status of a container
+type HealthStatusEventData struct {
+	// The health status before the change, empty if unknown.
+	PreviousStatus string `json:"previous_status"`
+
+	// The new health status.
+	Status string `json:"status"`
+
+	// Number of consecutive failed health checks.
+	FailingStreak int `json:"failing_streak,omitempty"`
+}
+
+// Information related to a resource usage of a container going above, or
+// back below, a threshold for a sustained window
+type ThresholdEventData struct {
+	// Whether the usage went above the threshold, false when it went back
+	// below it.
+	Exceeded bool `json:"exceeded"`
+
+	// The usage over the last housekeeping interval, in the unit of the
+	// threshold.
+	Value float64 `json:"value"`
+
+	// The threshold the usage is compared with.
+	Threshold float64 `json:"threshold"`
+
+	// How long the usage had been on this side of the threshold when the
+	// event was reported.
+	Duration time.Duration `json:"duration"`
+
+	// The device of the filesystem, or the mountpoint of the volume, whose
+	// usage crossed the threshold, for inodeUsage events.
+	Filesystem string `json:"filesystem,omitempty"`
+}
+
+// Information related to a process of a container that was forked, executed a
+// new program or exited
+type ProcessEventData struct {
+	// What happened to the process: fork, exec or exit.
+	Action string `json:"action"`
+
+	// Process id, of the new child for forks.
+	Pid int `json:"pid"`
+
+	// Process id of the parent, when known.
+	ParentPid int `json:"parent_pid,omitempty"`
+
+	// The name of the program of the process.
+	Command string `json:"command,omitempty"`
+
+	// The exit status of the process, for exits.
+	ExitCode int `json:"exit_code,omitempty"`
+
+	// The signal that killed the process, for exits.
+	Signal int `json:"signal,omitempty"`
+}
+
+// Information related to the driver of an accelerator used by a container
+// reporting an XID error, e.g. 79 when the GPU fell off the bus
+type AcceleratorXIDEventData struct {
+	// ID of the accelerator.
+	ID string `json:"id"`
+
+	// Id of the MIG GPU instance, for errors of an instance.
+	GPUInstance string `json:"gpu_instance,omitempty"`
+
+	// The XID error.
+	XID uint64 `json:"xid"`
+}
+
+// Information related to CPUs, memory or disks of the machine being added or
+// removed, e.g. when a virtual machine is resized live
+type MachineChangedEventData struct {
+	// The number of online CPUs before and after the change.
+	PreviousNumCores int `json:"previous_num_cores"`
+	NumCores         int `json:"num_cores"`
+
+	// The memory capacity before and after the change, in bytes.
+	PreviousMemoryCapacity uint64 `json:"previous_memory_capacity"`
+	MemoryCapacity         uint64 `json:"memory_capacity"`
+
+	// The names of the disks attached and detached.
+	AddedDisks   []string `json:"added_disks,omitempty"`
+	RemovedDisks []string `json:"removed_disks,omitempty"`
+}
+
+// Information related to a change of the load shedding level of cAdvisor,
+// which trades detail for resources when cAdvisor exceeds its own budgets.
+type LoadSheddingEventData struct {
+	// The load shedding level before the change, 0 meaning no shedding.
+	PreviousLevel int `json:"previous_level"`
+
+	// The new load shedding level.
+	Level int `json:"level"`
+
+	// CPU used by cAdvisor since the previous check, in cores.
+	CPUCores float64 `json:"cpu_cores"`
+
+	// Resident memory of cAdvisor, in bytes.
+	MemoryBytes uint64 `json:"memory_bytes"`
+}
@@ -0,0 +1,53 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import "time"
+
+// ImageStorage describes the image storage of a container runtime.
+type ImageStorage struct {
+	// Name of the runtime, e.g. docker or containerd.
+	Runtime string `json:"runtime"`
+	// Namespace of the runtime the images belong to, if any.
+	Namespace string `json:"namespace,omitempty"`
+	// Time at which the storage was inspected.
+	Timestamp time.Time `json:"timestamp"`
+
+	// Stored images.
+	Images []ImageUsage `json:"images,omitempty"`
+	// Bytes used by all images, counting shared layers once.
+	ImagesBytes uint64 `json:"images_bytes"`
+	// Bytes used by the writable layers of all containers.
+	WritableLayersBytes uint64 `json:"writable_layers_bytes"`
+
+	// Number of images pulled since cAdvisor started.
+	Pulls uint64 `json:"pulls"`
+	// Number of pulls whose duration is known, and their total duration.
+	// Runtimes that do not report pull durations leave these zero.
+	TimedPulls  uint64  `json:"timed_pulls,omitempty"`
+	PullSeconds float64 `json:"pull_seconds,omitempty"`
+}
+
+// ImageUsage describes the disk usage of an image.
+type ImageUsage struct {
+	ID       string   `json:"id"`
+	RepoTags []string `json:"repo_tags,omitempty"`
+	// Bytes used by the image, including layers shared with other images.
+	Size uint64 `json:"size"`
+	// Bytes used by layers shared with other images.
+	SharedSize uint64 `json:"shared_size"`
+	// Number of containers using the image.
+	Containers int `json:"containers"`
+}
@@ -35,6 +35,26 @@ type FsInfo struct {
 
 	// HasInodes when true, indicates that Inodes info will be available.
 	HasInodes bool `json:"has_inodes"`
+
+	// Whether the filesystem is mounted with project quotas, which the disk
+	// usage of containers is read from when their runtime assigns them a
+	// project.
+	ProjectQuota bool `json:"project_quota,omitempty"`
+
+	// How much of the space the filesystem allocated to each kind of data is
+	// used, e.g. to the data, metadata and system chunks of btrfs.
+	Allocation []FsAllocation `json:"allocation,omitempty"`
+}
+
+type FsAllocation struct {
+	// Kind of data the space is allocated to.
+	Type string `json:"type"`
+
+	// Bytes allocated.
+	Total uint64 `json:"total"`
+
+	// Bytes of the allocation used.
+	Used uint64 `json:"used"`
 }
 
 type Node struct {
@@ -45,16 +65,33 @@ type Node struct {
 	Cores     []Core          `json:"cores"`
 	Caches    []Cache         `json:"caches"`
 	Distances []uint64        `json:"distances"`
+	// Memory performance of the node for its nearest CPUs, if the firmware
+	// reports it.
+	MemoryPerformance *NodeMemoryPerformance `json:"memory_performance,omitempty"`
+}
+
+// NodeMemoryPerformance is the theoretical performance of the memory of a
+// NUMA node, from the ACPI Heterogeneous Memory Attribute Table (HMAT).
+type NodeMemoryPerformance struct {
+	// Read and write bandwidth in MB/s.
+	ReadBandwidth  uint64 `json:"read_bandwidth"`
+	WriteBandwidth uint64 `json:"write_bandwidth"`
+	// Read and write latency in nanoseconds.
+	ReadLatency  uint64 `json:"read_latency"`
+	WriteLatency uint64 `json:"write_latency"`
 }
 
 type Core struct {
-	Id           int     `json:"core_id"`
+	Id int `json:"core_id"`
+	// Ids of the threads of the core, which are SMT siblings.
 	Threads      []int   `json:"thread_ids"`
 	Caches       []Cache `json:"caches"`
 	UncoreCaches []Cache `json:"uncore_caches"`
 	SocketID     int     `json:"socket_id"`
 	BookID       string  `json:"book_id,omitempty"`
 	DrawerID     string  `json:"drawer_id,omitempty"`
+	// Type of the core on hybrid CPUs: performance or efficiency.
+	CoreType string `json:"core_type,omitempty"`
 }
 
 type Cache struct {
@@ -66,6 +103,8 @@ type Cache struct {
 	Type string `json:"type"`
 	// Level (distance from cpus) in a multi-level cache hierarchy.
 	Level int `json:"level"`
+	// Threads sharing the cache, as a list such as 0-3,8-11.
+	SharedCPUs string `json:"shared_cpus,omitempty"`
 }
 
 func (n *Node) FindCore(id int) (bool, int) {
@@ -123,6 +162,9 @@ type HugePagesInfo struct {
 
 	// number of huge pages
 	NumPages uint64 `json:"num_pages"`
+
+	// number of huge pages not allocated, as of the last machine info update
+	FreePages uint64 `json:"free_pages,omitempty"`
 }
 
 type DiskInfo struct {
@@ -140,6 +182,20 @@ type DiskInfo struct {
 
 	// I/O Scheduler - one of "none", "noop", "cfq", "deadline"
 	Scheduler string `json:"scheduler"`
+
+	// Model and serial number of the disk, when reported.
+	Model  string `json:"model,omitempty"`
+	Serial string `json:"serial,omitempty"`
+
+	// Whether the disk is rotational, as opposed to solid state.
+	Rotational bool `json:"rotational"`
+
+	// SMART overall health assessment, "passed" or "failed", empty if
+	// unknown.
+	Health string `json:"health,omitempty"`
+
+	// Temperature in degrees Celsius, 0 if unknown.
+	Temperature int64 `json:"temperature,omitempty"`
 }
 
 type NetInfo struct {
@@ -154,6 +210,81 @@ type NetInfo struct {
 
 	// Maximum Transmission Unit
 	Mtu int64 `json:"mtu"`
+
+	// Duplex of the link: full, half or unknown
+	Duplex string `json:"duplex,omitempty"`
+
+	// Driver of the device, empty for virtual devices
+	Driver string `json:"driver,omitempty"`
+
+	// Bond or bridge the device is a port of, and its kind: bond or bridge
+	Master     string `json:"master,omitempty"`
+	MasterType string `json:"master_type,omitempty"`
+}
+
+type PCIDevice struct {
+	// PCI address, e.g. 0000:3b:00.0
+	Address string `json:"address"`
+
+	// Vendor id, e.g. 0x8086
+	VendorID string `json:"vendor_id"`
+
+	// Device id, e.g. 0x1572
+	DeviceID string `json:"device_id"`
+
+	// Class code, e.g. 0x020000 for an ethernet controller
+	Class string `json:"class"`
+
+	// NUMA node the device is attached to, -1 if unknown
+	NumaNode int `json:"numa_node"`
+
+	// IOMMU group of the device, empty if the IOMMU is disabled
+	IOMMUGroup string `json:"iommu_group,omitempty"`
+
+	// Driver bound to the device, empty if none
+	Driver string `json:"driver,omitempty"`
+
+	// Number of SR-IOV virtual functions the device supports and has enabled
+	SRIOVTotalVFs int `json:"sriov_total_vfs,omitempty"`
+	SRIOVNumVFs   int `json:"sriov_num_vfs,omitempty"`
+
+	// Address of the physical function of an SR-IOV virtual function
+	PhysicalFunction string `json:"physical_function,omitempty"`
+}
+
+type ThinPoolInfo struct {
+	// Name of the device of the thin pool, e.g. docker-thinpool
+	Name string `json:"name"`
+
+	// Transaction id of the metadata of the pool
+	TransactionID uint64 `json:"transaction_id"`
+
+	// Used and total data blocks, of the data block size of the pool
+	DataUsed  uint64 `json:"data_used"`
+	DataTotal uint64 `json:"data_total"`
+
+	// Used and total metadata blocks, of 4KiB
+	MetadataUsed  uint64 `json:"metadata_used"`
+	MetadataTotal uint64 `json:"metadata_total"`
+
+	// rw, ro, out_of_data_space or fail
+	Mode string `json:"mode"`
+
+	// Whether the metadata of the pool must be repaired
+	NeedsCheck bool `json:"needs_check,omitempty"`
+}
+
+type VolumeGroupInfo struct {
+	// Name of the LVM volume group
+	Name string `json:"name"`
+
+	// Size and free space of the volume group, in bytes
+	Size uint64 `json:"size"`
+	Free uint64 `json:"free"`
+
+	// Number of logical and physical volumes of the volume group
+	LogicalVolumes  uint64 `json:"logical_volumes"`
+	PhysicalVolumes uint64 `json:"physical_volumes"`
 }
 
 type CloudProvider string
@@ -162,6 +293,7 @@ const (
 	GCE             CloudProvider = "GCE"
 	AWS             CloudProvider = "AWS"
 	Azure           CloudProvider = "Azure"
+	OpenStack       CloudProvider = "OpenStack"
 	UnknownProvider CloudProvider = "Unknown"
 )
 
@@ -246,6 +378,18 @@ type MachineInfo struct {
 
 	// ID of cloud instance (e.g. instance-1) given to it by the cloud provider.
 	InstanceID InstanceID `json:"instance_id"`
+
+	// Availability zone of the cloud instance (e.g. us-central1-a).
+	Zone string `json:"zone,omitempty"`
+
+	// PCI devices on this machine, including SR-IOV virtual functions.
+	PCIDevices []PCIDevice `json:"pci_devices,omitempty"`
+
+	// Devicemapper thin pools on this machine.
+	ThinPools []ThinPoolInfo `json:"thin_pools,omitempty"`
+
+	// LVM volume groups on this machine, when vgs is configured.
+	VolumeGroups []VolumeGroupInfo `json:"volume_groups,omitempty"`
 }
 
 func (m *MachineInfo) Clone() *MachineInfo {
@@ -287,6 +431,10 @@ func (m *MachineInfo) Clone() *MachineInfo {
 		CloudProvider:    m.CloudProvider,
 		InstanceType:     m.InstanceType,
 		InstanceID:       m.InstanceID,
+		Zone:             m.Zone,
+		PCIDevices:       m.PCIDevices,
+		ThinPools:        m.ThinPools,
+		VolumeGroups:     m.VolumeGroups,
 	}
 	return &copy
 }
@@ -308,6 +456,51 @@ type NVMInfo struct {
 
 	// Average power budget in watts for NVM devices configured in BIOS.
 	AvgPowerBudget uint `json:"avg_power_budget"`
+
+	// Persistent memory regions, as the kernel libnvdimm subsystem reports
+	// them.
+	Regions []NVMRegion `json:"regions,omitempty"`
+}
+
+// NVMRegion is a persistent memory region, the capacity of a set of
+// interleaved NVDIMMs.
+type NVMRegion struct {
+	// Name of the region, e.g. region0.
+	Name string `json:"name"`
+
+	// Type of the region: pmem or blk.
+	Type string `json:"type"`
+
+	// Size and size not allocated to namespaces yet, in bytes.
+	Size          uint64 `json:"size"`
+	AvailableSize uint64 `json:"available_size"`
+
+	// NUMA node the region is attached to, -1 if unknown.
+	NumaNode int `json:"numa_node"`
+
+	// NVDIMMs of the interleave set of the region, e.g. nmem0.
+	Dimms []string `json:"dimms,omitempty"`
+
+	// Namespaces the region is divided into.
+	Namespaces []NVMNamespace `json:"namespaces,omitempty"`
+}
+
+// NVMNamespace is a namespace of a persistent memory region.
+type NVMNamespace struct {
+	// Name of the namespace, e.g. namespace0.0.
+	Name string `json:"name"`
+
+	// Mode of the namespace, as ndctl names it: fsdax, devdax, sector or raw.
+	Mode string `json:"mode"`
+
+	// Size in bytes.
+	Size uint64 `json:"size"`
+
+	// UUID of the namespace, empty for raw namespaces.
+	UUID string `json:"uuid,omitempty"`
+
+	// Block device of the namespace, e.g. pmem0, empty in devdax mode.
+	BlockDevice string `json:"block_device,omitempty"`
 }
 
 type VersionInfo struct {
@@ -327,6 +520,18 @@ type VersionInfo struct {
 	CadvisorVersion string `json:"cadvisor_version"`
 	// cAdvisor git revision.
 	CadvisorRevision string `json:"cadvisor_revision"`
+
+	// Parameters the kernel was booted with.
+	KernelCmdline string `json:"kernel_cmdline,omitempty"`
+
+	// Linux security modules active, e.g. selinux or apparmor.
+	SecurityModules []string `json:"security_modules,omitempty"`
+
+	// SELinux mode: "enforcing", "permissive" or "disabled".
+	SELinuxMode string `json:"selinux_mode,omitempty"`
+
+	// Values of the sysctls selected with --sysctls, by name.
+	Sysctls map[string]string `json:"sysctls,omitempty"`
 }
 
 type MachineInfoFactory interface {
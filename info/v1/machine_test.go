@@ -91,5 +91,29 @@ func getFakeMachineInfo() MachineInfo {
 		CloudProvider: "fake-provider",
 		InstanceType:  "fake-instance-type",
 		InstanceID:    "fake-instance-id",
+		Zone:          "fake-zone",
+		PCIDevices: []PCIDevice{{
+			Address:  "0000:3b:00.0",
+			VendorID: "0x8086",
+			DeviceID: "0x1572",
+			Class:    "0x020000",
+			NumaNode: 0,
+		}},
+		ThinPools: []ThinPoolInfo{{
+			Name:          "docker-thinpool",
+			TransactionID: 12,
+			DataUsed:      163840,
+			DataTotal:     1638400,
+			MetadataUsed:  2345,
+			MetadataTotal: 524288,
+			Mode:          "rw",
+		}},
+		VolumeGroups: []VolumeGroupInfo{{
+			Name:            "vg0",
+			Size:            107369988096,
+			Free:            21474836480,
+			LogicalVolumes:  3,
+			PhysicalVolumes: 1,
+		}},
 	}
 }
@@ -60,6 +60,10 @@ type MetricValBasic struct {
 	// The value of the metric at this point.
 	IntValue   int64   `json:"int_value,omitempty"`
 	FloatValue float64 `json:"float_value,omitempty"`
+
+	// Whether the labels take precedence over the labels of the container
+	// when exposed, instead of being prefixed.
+	HonorLabels bool `json:"honor_labels,omitempty"`
 }
 
 // An exported metric.
@@ -74,4 +78,8 @@ type MetricVal struct {
 	// The value of the metric at this point.
 	IntValue   int64   `json:"int_value,omitempty"`
 	FloatValue float64 `json:"float_value,omitempty"`
+
+	// Whether the labels take precedence over the labels of the container
+	// when exposed, instead of being prefixed.
+	HonorLabels bool `json:"honor_labels,omitempty"`
 }
@@ -103,6 +103,12 @@ type ContainerSpec struct {
 
 	// Image name used for this container.
 	Image string `json:"image,omitempty"`
+
+	// Policy by which the runtime restarts the container, if known.
+	RestartPolicy *v1.RestartPolicy `json:"restart_policy,omitempty"`
+
+	// Volumes and bind mounts of the container.
+	Mounts []v1.MountSpec `json:"mounts,omitempty"`
 }
 
 type DeprecatedContainerStats struct {
@@ -184,6 +190,8 @@ type ContainerStats struct {
 	ReferencedMemory uint64 `json:"referenced_memory,omitempty"`
 	// Resource Control (resctrl) statistics
 	Resctrl v1.ResctrlStats `json:"resctrl,omitempty"`
+	// Memory bandwidth of the L3 cache domains in bytes per second (instantaneous)
+	ResctrlInst *ResctrlInstStats `json:"resctrl_inst,omitempty"`
 }
 
 type Percentiles struct {
@@ -349,6 +357,28 @@ type CpuInstUsage struct {
 	System uint64 `json:"system"`
 }
 
+// Instantaneous resctrl stats
+type ResctrlInstStats struct {
+	MemoryBandwidth []MemoryBandwidthInstStats `json:"memory_bandwidth,omitempty"`
+}
+
+// Memory bandwidth of one L3 cache domain.
+type MemoryBandwidthInstStats struct {
+	// Id of the L3 cache domain.
+	ID int `json:"id"`
+
+	// Socket of the L3 cache domain, -1 if unknown.
+	Socket int `json:"socket"`
+
+	// Total memory bandwidth.
+	// Unit: bytes per second
+	TotalBytes uint64 `json:"mbm_total_bytes"`
+
+	// Bandwidth to the memory local to the domain.
+	// Unit: bytes per second
+	LocalBytes uint64 `json:"mbm_local_bytes"`
+}
+
 // Filesystem usage statistics.
 type FilesystemStats struct {
 	// Total Number of bytes consumed by container.
@@ -360,3 +390,11 @@ type FilesystemStats struct {
 	// and does not include inodes used in mounted directories.
 	InodeUsage *uint64 `json:"containter_inode_usage,omitempty"`
 }
+
+// A page of past events, returned by the v2.1 events API when paginating.
+type EventPage struct {
+	// Events of the page, from the oldest.
+	Events []*v1.Event `json:"events"`
+	// Cursor to request the next page with, empty after the last page.
+	NextCursor string `json:"next_cursor,omitempty"`
+}
@@ -99,7 +99,7 @@ func MachineStatsFromV1(cont *v1.ContainerInfo) []MachineStats {
 
 func ContainerStatsFromV1(containerName string, spec *v1.ContainerSpec, stats []*v1.ContainerStats) []*ContainerStats {
 	newStats := make([]*ContainerStats, 0, len(stats))
-	var last *v1.ContainerStats
+	var last, lastResctrl *v1.ContainerStats
 	for _, val := range stats {
 		stat := &ContainerStats{
 			Timestamp:        val.Timestamp,
@@ -162,6 +162,15 @@ func ContainerStatsFromV1(containerName string, spec *v1.ContainerSpec, stats []
 		if len(val.Resctrl.MemoryBandwidth) > 0 || len(val.Resctrl.Cache) > 0 {
 			stat.Resctrl = val.Resctrl
 		}
+		if len(val.Resctrl.MemoryBandwidth) > 0 {
+			resctrlInst, err := InstResctrlStats(lastResctrl, val)
+			if err != nil {
+				klog.Warningf("Could not get instant resctrl stats: %v", err)
+			} else {
+				stat.ResctrlInst = resctrlInst
+			}
+			lastResctrl = val
+		}
 		// TODO(rjnagal): Handle load stats.
 		newStats = append(newStats, stat)
 	}
@@ -281,6 +290,51 @@ func InstCpuStats(last, cur *v1.ContainerStats) (*CpuInstStats, error) {
 	}, nil
 }
 
+// InstResctrlStats returns the memory bandwidth of each L3 cache domain
+// between two stats of a container.
+func InstResctrlStats(last, cur *v1.ContainerStats) (*ResctrlInstStats, error) {
+	if last == nil {
+		return nil, nil
+	}
+	if !cur.Timestamp.After(last.Timestamp) {
+		return nil, fmt.Errorf("container stats move backwards in time")
+	}
+	if len(last.Resctrl.MemoryBandwidth) != len(cur.Resctrl.MemoryBandwidth) {
+		return nil, fmt.Errorf("different number of L3 cache domains")
+	}
+	timeDeltaNs := uint64(cur.Timestamp.Sub(last.Timestamp).Nanoseconds())
+	convertToRate := func(lastValue, curValue uint64) (uint64, error) {
+		if curValue < lastValue {
+			return 0, fmt.Errorf("cumulative stats decrease")
+		}
+		return uint64(float64(curValue-lastValue) / float64(timeDeltaNs) * 1e9), nil
+	}
+	inst := &ResctrlInstStats{
+		MemoryBandwidth: make([]MemoryBandwidthInstStats, len(cur.Resctrl.MemoryBandwidth)),
+	}
+	for i, curDomain := range cur.Resctrl.MemoryBandwidth {
+		lastDomain := last.Resctrl.MemoryBandwidth[i]
+		if lastDomain.ID != curDomain.ID {
+			return nil, fmt.Errorf("different L3 cache domains")
+		}
+		total, err := convertToRate(lastDomain.TotalBytes, curDomain.TotalBytes)
+		if err != nil {
+			return nil, err
+		}
+		local, err := convertToRate(lastDomain.LocalBytes, curDomain.LocalBytes)
+		if err != nil {
+			return nil, err
+		}
+		inst.MemoryBandwidth[i] = MemoryBandwidthInstStats{
+			ID:         curDomain.ID,
+			Socket:     curDomain.Socket,
+			TotalBytes: total,
+			LocalBytes: local,
+		}
+	}
+	return inst, nil
+}
+
 // Get V2 container spec from v1 container info.
 func ContainerSpecFromV1(specV1 *v1.ContainerSpec, aliases []string, namespace string) ContainerSpec {
 	specV2 := ContainerSpec{
@@ -296,6 +350,8 @@ func ContainerSpecFromV1(specV1 *v1.ContainerSpec, aliases []string, namespace s
 		Image:            specV1.Image,
 		Labels:           specV1.Labels,
 		Envs:             specV1.Envs,
+		RestartPolicy:    specV1.RestartPolicy,
+		Mounts:           specV1.Mounts,
 	}
 	if specV1.HasCpu {
 		specV2.Cpu.Limit = specV1.Cpu.Limit
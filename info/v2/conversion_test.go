@@ -239,19 +239,27 @@ func TestContainerStatsFromV1(t *testing.T) {
 		Resctrl: v1.ResctrlStats{
 			MemoryBandwidth: []v1.MemoryBandwidthStats{
 				{
+					ID:         0,
+					Socket:     0,
 					TotalBytes: 72312331,
 					LocalBytes: 1233311,
 				},
 				{
+					ID:         1,
+					Socket:     1,
 					TotalBytes: 32312331,
 					LocalBytes: 2233311,
 				},
 			},
 			Cache: []v1.CacheStats{
 				{
+					ID:           0,
+					Socket:       0,
 					LLCOccupancy: 123123441,
 				},
 				{
+					ID:           1,
+					Socket:       1,
 					LLCOccupancy: 123313111,
 				},
 			},
@@ -443,3 +451,69 @@ func TestInstCpuStats(t *testing.T) {
 		assert.Equal(t, c.want, got)
 	}
 }
+
+func TestInstResctrlStats(t *testing.T) {
+	resctrlStats := func(seconds int64, domains ...v1.MemoryBandwidthStats) *v1.ContainerStats {
+		return &v1.ContainerStats{
+			Timestamp: time.Unix(100+seconds, 0),
+			Resctrl:   v1.ResctrlStats{MemoryBandwidth: domains},
+		}
+	}
+	tests := []struct {
+		last *v1.ContainerStats
+		cur  *v1.ContainerStats
+		want *ResctrlInstStats
+	}{
+		// Last is missing
+		{
+			nil,
+			resctrlStats(0),
+			nil,
+		},
+		// Zero time delta
+		{
+			resctrlStats(0),
+			resctrlStats(0),
+			nil,
+		},
+		// Different domains
+		{
+			resctrlStats(0, v1.MemoryBandwidthStats{ID: 0}),
+			resctrlStats(1, v1.MemoryBandwidthStats{ID: 1}),
+			nil,
+		},
+		// Stat numbers decrease
+		{
+			resctrlStats(0, v1.MemoryBandwidthStats{TotalBytes: 2000, LocalBytes: 1000}),
+			resctrlStats(1, v1.MemoryBandwidthStats{TotalBytes: 1000, LocalBytes: 1000}),
+			nil,
+		},
+		// Two seconds elapsed
+		{
+			resctrlStats(0,
+				v1.MemoryBandwidthStats{ID: 0, Socket: 0, TotalBytes: 1000, LocalBytes: 500},
+				v1.MemoryBandwidthStats{ID: 1, Socket: 1, TotalBytes: 3000, LocalBytes: 1000},
+			),
+			resctrlStats(2,
+				v1.MemoryBandwidthStats{ID: 0, Socket: 0, TotalBytes: 5000, LocalBytes: 2500},
+				v1.MemoryBandwidthStats{ID: 1, Socket: 1, TotalBytes: 3000, LocalBytes: 1000},
+			),
+			&ResctrlInstStats{
+				MemoryBandwidth: []MemoryBandwidthInstStats{
+					{ID: 0, Socket: 0, TotalBytes: 2000, LocalBytes: 1000},
+					{ID: 1, Socket: 1, TotalBytes: 0, LocalBytes: 0},
+				},
+			},
+		},
+	}
+	for _, c := range tests {
+		got, err := InstResctrlStats(c.last, c.cur)
+		if err != nil {
+			if c.want == nil {
+				continue
+			}
+			t.Errorf("Unexpected error: %v", err)
+		}
+		assert.Equal(t, c.want, got)
+	}
+}
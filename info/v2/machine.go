@@ -37,6 +37,18 @@ type Attributes struct {
 	// cAdvisor version.
 	CadvisorVersion string `json:"cadvisor_version"`
 
+	// Parameters the kernel was booted with.
+	KernelCmdline string `json:"kernel_cmdline,omitempty"`
+
+	// Linux security modules active, e.g. selinux or apparmor.
+	SecurityModules []string `json:"security_modules,omitempty"`
+
+	// SELinux mode: "enforcing", "permissive" or "disabled".
+	SELinuxMode string `json:"selinux_mode,omitempty"`
+
+	// Values of the sysctls selected with --sysctls, by name.
+	Sysctls map[string]string `json:"sysctls,omitempty"`
+
 	// The number of cores in this machine.
 	NumCores int `json:"num_cores"`
 
@@ -73,6 +85,9 @@ type Attributes struct {
 
 	// Type of cloud instance (e.g. GCE standard) the machine is.
 	InstanceType v1.InstanceType `json:"instance_type"`
+
+	// Availability zone of the cloud instance (e.g. us-central1-a).
+	Zone string `json:"zone,omitempty"`
 }
 
 func GetAttributes(mi *v1.MachineInfo, vi *v1.VersionInfo) Attributes {
@@ -82,6 +97,10 @@ func GetAttributes(mi *v1.MachineInfo, vi *v1.VersionInfo) Attributes {
 		DockerVersion:      vi.DockerVersion,
 		DockerAPIVersion:   vi.DockerAPIVersion,
 		CadvisorVersion:    vi.CadvisorVersion,
+		KernelCmdline:      vi.KernelCmdline,
+		SecurityModules:    vi.SecurityModules,
+		SELinuxMode:        vi.SELinuxMode,
+		Sysctls:            vi.Sysctls,
 		NumCores:           mi.NumCores,
 		CpuFrequency:       mi.CpuFrequency,
 		MemoryCapacity:     mi.MemoryCapacity,
@@ -94,6 +113,7 @@ func GetAttributes(mi *v1.MachineInfo, vi *v1.VersionInfo) Attributes {
 		Topology:           mi.Topology,
 		CloudProvider:      mi.CloudProvider,
 		InstanceType:       mi.InstanceType,
+		Zone:               mi.Zone,
 	}
 }
 
@@ -0,0 +1,119 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package machine
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/cadvisor/devicemapper"
+	info "github.com/google/cadvisor/info/v1"
+
+	"k8s.io/klog/v2"
+)
+
+var vgsPath = flag.String("vgs_path", "", "Path of the LVM vgs command to run for the size and free space of the LVM volume groups of the machine. Empty disables LVM volume groups")
+
+// vgsTimeout bounds how long vgs may take, as it scans the physical volumes.
+const vgsTimeout = 10 * time.Second
+
+// getThinPools returns the devicemapper thin pools of the machine, if it has
+// devicemapper devices and dmsetup is installed.
+func getThinPools(sysBlockDir string, dmsetup devicemapper.DmsetupClient) []info.ThinPoolInfo {
+	if devices, _ := filepath.Glob(filepath.Join(sysBlockDir, "dm-*")); len(devices) == 0 {
+		return nil
+	}
+	statuses, err := devicemapper.ThinPoolStatuses(dmsetup)
+	if err != nil {
+		klog.V(4).Infof("Could not get the status of the thin pools: %v", err)
+		return nil
+	}
+	var pools []info.ThinPoolInfo
+	for _, status := range statuses {
+		pools = append(pools, info.ThinPoolInfo{
+			Name:          status.Name,
+			TransactionID: status.TransactionID,
+			DataUsed:      status.UsedDataBlocks,
+			DataTotal:     status.TotalDataBlocks,
+			MetadataUsed:  status.UsedMetadataBlocks,
+			MetadataTotal: status.TotalMetadataBlocks,
+			Mode:          status.Mode,
+			NeedsCheck:    status.NeedsCheck,
+		})
+	}
+	return pools
+}
+
+// getVolumeGroups returns the LVM volume groups of the machine when vgs_path
+// is set.
+func getVolumeGroups() []info.VolumeGroupInfo {
+	if *vgsPath == "" {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), vgsTimeout)
+	defer cancel()
+	out, err := exec.CommandContext(ctx, *vgsPath, "--noheadings", "--units", "b", "--nosuffix", "--separator", ",",
+		"-o", "vg_name,vg_size,vg_free,lv_count,pv_count").Output()
+	if err != nil {
+		klog.V(4).Infof("Could not get LVM volume groups: %v", err)
+		return nil
+	}
+	volumeGroups, err := parseVolumeGroups(string(out))
+	if err != nil {
+		klog.V(4).Infof("Could not parse LVM volume groups: %v", err)
+		return nil
+	}
+	return volumeGroups
+}
+
+// parseVolumeGroups parses the output of vgs, a line of
+// <name>,<size>,<free>,<logical volumes>,<physical volumes> per volume group.
+func parseVolumeGroups(output string) ([]info.VolumeGroupInfo, error) {
+	var volumeGroups []info.VolumeGroupInfo
+	for _, line := range strings.Split(output, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		fields := strings.Split(line, ",")
+		if len(fields) != 5 {
+			return nil, fmt.Errorf("unexpected volume group %q", line)
+		}
+		var values [4]uint64
+		for i, field := range fields[1:] {
+			value, err := strconv.ParseUint(strings.TrimSpace(field), 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("unexpected volume group %q: %v", line, err)
+			}
+			values[i] = value
+		}
+		volumeGroups = append(volumeGroups, info.VolumeGroupInfo{
+			Name:            strings.TrimSpace(fields[0]),
+			Size:            values[0],
+			Free:            values[1],
+			LogicalVolumes:  values[2],
+			PhysicalVolumes: values[3],
+		})
+	}
+	return volumeGroups, nil
+}
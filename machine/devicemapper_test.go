@@ -0,0 +1,64 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package machine
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/google/cadvisor/devicemapper/fake"
+	info "github.com/google/cadvisor/info/v1"
+)
+
+func TestGetThinPools(t *testing.T) {
+	sysBlockDir := t.TempDir()
+	// Without devicemapper devices dmsetup is not run.
+	assert.Empty(t, getThinPools(sysBlockDir, fake.NewFakeDmsetupClient(t)))
+
+	require.NoError(t, os.Mkdir(filepath.Join(sysBlockDir, "dm-0"), 0755))
+	dmsetup := fake.NewFakeDmsetupClient(t, fake.DmsetupCommand{
+		Name:   "status --target",
+		Result: "docker-thinpool: 0 209715200 thin-pool 12 2345/524288 163840/1638400 - rw no_discard_passdown queue_if_no_space - 1024\n",
+	})
+	assert.Equal(t, []info.ThinPoolInfo{{
+		Name:          "docker-thinpool",
+		TransactionID: 12,
+		DataUsed:      163840,
+		DataTotal:     1638400,
+		MetadataUsed:  2345,
+		MetadataTotal: 524288,
+		Mode:          "rw",
+	}}, getThinPools(sysBlockDir, dmsetup))
+}
+
+func TestParseVolumeGroups(t *testing.T) {
+	volumeGroups, err := parseVolumeGroups("  vg0,107369988096,21474836480,3,1\n  vg1,53687091200,0,1,2\n")
+	require.NoError(t, err)
+	assert.Equal(t, []info.VolumeGroupInfo{
+		{Name: "vg0", Size: 107369988096, Free: 21474836480, LogicalVolumes: 3, PhysicalVolumes: 1},
+		{Name: "vg1", Size: 53687091200, Free: 0, LogicalVolumes: 1, PhysicalVolumes: 2},
+	}, volumeGroups)
+
+	_, err = parseVolumeGroups("vg0,107369988096\n")
+	assert.Error(t, err)
+	_, err = parseVolumeGroups("vg0,10G,0,1,1\n")
+	assert.Error(t, err)
+}
@@ -25,6 +25,7 @@ import (
 
 	"golang.org/x/sys/unix"
 
+	"github.com/google/cadvisor/devicemapper"
 	"github.com/google/cadvisor/fs"
 	info "github.com/google/cadvisor/info/v1"
 	"github.com/google/cadvisor/nvm"
@@ -89,6 +90,10 @@ func Info(sysFs sysfs.SysFs, fsInfo fs.FsInfo, inHostNamespace bool) (*info.Mach
 	if err != nil {
 		return nil, err
 	}
+	nvmInfo.Regions, err = nvm.GetRegions()
+	if err != nil {
+		klog.Errorf("Failed to get persistent memory regions: %v", err)
+	}
 
 	hugePagesInfo, err := sysinfo.GetHugePagesInfo(sysFs, hugepagesDirectory)
 	if err != nil {
@@ -104,12 +109,21 @@ func Info(sysFs sysfs.SysFs, fsInfo fs.FsInfo, inHostNamespace bool) (*info.Mach
 	if err != nil {
 		klog.Errorf("Failed to get disk map: %v", err)
 	}
+	addSmartInfo(diskMap, "/dev")
 
 	netDevices, err := sysinfo.GetNetworkDevices(sysFs)
 	if err != nil {
 		klog.Errorf("Failed to get network devices: %v", err)
 	}
 
+	pciDevices, err := sysinfo.GetPCIDevices(sysFs)
+	if err != nil {
+		klog.Errorf("Failed to get PCI devices: %v", err)
+	}
+
+	thinPools := getThinPools("/sys/block", devicemapper.NewDmsetupClient())
+	volumeGroups := getVolumeGroups()
+
 	topology, numCores, err := GetTopology(sysFs)
 	if err != nil {
 		klog.Errorf("Failed to get topology information: %v", err)
@@ -124,6 +138,7 @@ func Info(sysFs sysfs.SysFs, fsInfo fs.FsInfo, inHostNamespace bool) (*info.Mach
 	cloudProvider := realCloudInfo.GetCloudProvider()
 	instanceType := realCloudInfo.GetInstanceType()
 	instanceID := realCloudInfo.GetInstanceID()
+	zone := realCloudInfo.GetZone()
 
 	machineInfo := &info.MachineInfo{
 		Timestamp:        time.Now(),
@@ -148,6 +163,10 @@ func Info(sysFs sysfs.SysFs, fsInfo fs.FsInfo, inHostNamespace bool) (*info.Mach
 		CloudProvider:    cloudProvider,
 		InstanceType:     instanceType,
 		InstanceID:       instanceID,
+		Zone:             zone,
+		PCIDevices:       pciDevices,
+		ThinPools:        thinPools,
+		VolumeGroups:     volumeGroups,
 	}
 
 	for i := range filesystems {
@@ -156,7 +175,11 @@ func Info(sysFs sysfs.SysFs, fsInfo fs.FsInfo, inHostNamespace bool) (*info.Mach
 		if fs.Inodes != nil {
 			inodes = *fs.Inodes
 		}
-		machineInfo.Filesystems = append(machineInfo.Filesystems, info.FsInfo{Device: fs.Device, DeviceMajor: uint64(fs.Major), DeviceMinor: uint64(fs.Minor), Type: fs.Type.String(), Capacity: fs.Capacity, Inodes: inodes, HasInodes: fs.Inodes != nil})
+		var allocation []info.FsAllocation
+		for _, a := range fs.Allocation {
+			allocation = append(allocation, info.FsAllocation{Type: a.Type, Total: a.Total, Used: a.Used})
+		}
+		machineInfo.Filesystems = append(machineInfo.Filesystems, info.FsInfo{Device: fs.Device, DeviceMajor: uint64(fs.Major), DeviceMinor: uint64(fs.Minor), Type: fs.Type.String(), Capacity: fs.Capacity, Inodes: inodes, HasInodes: fs.Inodes != nil, ProjectQuota: fs.ProjectQuota, Allocation: allocation})
 	}
 
 	return machineInfo, nil
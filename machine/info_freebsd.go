@@ -0,0 +1,113 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build freebsd
+
+package machine
+
+import (
+	"runtime"
+	"strings"
+	"time"
+
+	"golang.org/x/sys/unix"
+
+	"github.com/google/cadvisor/fs"
+	info "github.com/google/cadvisor/info/v1"
+	"github.com/google/cadvisor/utils/cloudinfo"
+	"github.com/google/cadvisor/utils/sysfs"
+
+	"k8s.io/klog/v2"
+)
+
+// Info returns the machine information of a FreeBSD host, read from
+// sysctls. sysFs and inHostNamespace don't apply.
+func Info(sysFs sysfs.SysFs, fsInfo fs.FsInfo, inHostNamespace bool) (*info.MachineInfo, error) {
+	memoryCapacity, err := unix.SysctlUint64("hw.physmem")
+	if err != nil {
+		return nil, err
+	}
+	numCores := runtime.NumCPU()
+	if ncpu, err := unix.SysctlUint32("hw.ncpu"); err == nil {
+		numCores = int(ncpu)
+	}
+
+	// The frequency of the first CPU in MHz, if cpufreq is loaded.
+	var clockSpeed uint64
+	if mhz, err := unix.SysctlUint32("dev.cpu.0.freq"); err == nil {
+		clockSpeed = uint64(mhz) * 1000
+	}
+	swapCapacity, err := unix.SysctlUint64("vm.swap_total")
+	if err != nil {
+		klog.Errorf("Failed to get the swap capacity: %v", err)
+	}
+	machineID, _ := unix.Sysctl("kern.hostuuid")
+
+	filesystems, err := fsInfo.GetGlobalFsInfo()
+	if err != nil {
+		klog.Errorf("Failed to get global filesystem information: %v", err)
+	}
+
+	realCloudInfo := cloudinfo.NewRealCloudInfo()
+	machineInfo := &info.MachineInfo{
+		Timestamp:      time.Now(),
+		CPUVendorID:    cpuVendorID(),
+		NumCores:       numCores,
+		CpuFrequency:   clockSpeed,
+		MemoryCapacity: memoryCapacity,
+		SwapCapacity:   swapCapacity,
+		MachineID:      machineID,
+		SystemUUID:     machineID,
+		CloudProvider:  realCloudInfo.GetCloudProvider(),
+		InstanceType:   realCloudInfo.GetInstanceType(),
+		InstanceID:     realCloudInfo.GetInstanceID(),
+		Zone:           realCloudInfo.GetZone(),
+	}
+	for _, fs := range filesystems {
+		machineInfo.Filesystems = append(machineInfo.Filesystems, info.FsInfo{Device: fs.Device, Type: fs.Type.String(), Capacity: fs.Capacity})
+	}
+	return machineInfo, nil
+}
+
+// cpuVendorID returns the vendor ID of the CPUs, as in /proc/cpuinfo on
+// Linux, from their model name, hw.model.
+func cpuVendorID() string {
+	model, err := unix.Sysctl("hw.model")
+	if err != nil {
+		return ""
+	}
+	switch {
+	case strings.Contains(model, "Intel"):
+		return "GenuineIntel"
+	case strings.Contains(model, "AMD"):
+		return "AuthenticAMD"
+	}
+	return ""
+}
+
+func ContainerOsVersion() string {
+	os, err := getOperatingSystem()
+	if err != nil {
+		os = "Unknown"
+	}
+	return os
+}
+
+func KernelVersion() string {
+	release, err := unix.Sysctl("kern.osrelease")
+	if err != nil {
+		return "Unknown"
+	}
+	return release
+}
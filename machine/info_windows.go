@@ -0,0 +1,120 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build windows
+
+package machine
+
+import (
+	"fmt"
+	"runtime"
+	"time"
+
+	"golang.org/x/sys/windows"
+	"golang.org/x/sys/windows/registry"
+
+	"github.com/google/cadvisor/fs"
+	info "github.com/google/cadvisor/info/v1"
+	"github.com/google/cadvisor/utils/cloudinfo"
+	"github.com/google/cadvisor/utils/sysfs"
+	"github.com/google/cadvisor/utils/win32"
+
+	"k8s.io/klog/v2"
+)
+
+// Info returns the machine information of a Windows host, read from Win32
+// and the registry. sysFs and inHostNamespace don't apply.
+func Info(sysFs sysfs.SysFs, fsInfo fs.FsInfo, inHostNamespace bool) (*info.MachineInfo, error) {
+	memory, err := win32.GlobalMemoryStatus()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the memory status: %v", err)
+	}
+
+	vendorID, clockSpeed, err := getProcessorInfo()
+	if err != nil {
+		klog.Errorf("Failed to get processor information: %v", err)
+	}
+
+	filesystems, err := fsInfo.GetGlobalFsInfo()
+	if err != nil {
+		klog.Errorf("Failed to get global filesystem information: %v", err)
+	}
+
+	realCloudInfo := cloudinfo.NewRealCloudInfo()
+	machineInfo := &info.MachineInfo{
+		Timestamp:      time.Now(),
+		CPUVendorID:    vendorID,
+		NumCores:       runtime.NumCPU(),
+		CpuFrequency:   clockSpeed,
+		MemoryCapacity: memory.TotalPhys,
+		// The page file backs the memory committed beyond the physical
+		// memory.
+		SwapCapacity:  memory.TotalPageFile - memory.TotalPhys,
+		MachineID:     getMachineGUID(),
+		CloudProvider: realCloudInfo.GetCloudProvider(),
+		InstanceType:  realCloudInfo.GetInstanceType(),
+		InstanceID:    realCloudInfo.GetInstanceID(),
+		Zone:          realCloudInfo.GetZone(),
+	}
+	for _, fs := range filesystems {
+		machineInfo.Filesystems = append(machineInfo.Filesystems, info.FsInfo{Device: fs.Device, Type: fs.Type.String(), Capacity: fs.Capacity})
+	}
+	return machineInfo, nil
+}
+
+// getProcessorInfo returns the vendor and the frequency in kHz of the first
+// processor.
+func getProcessorInfo() (string, uint64, error) {
+	k, err := registry.OpenKey(registry.LOCAL_MACHINE, `HARDWARE\DESCRIPTION\System\CentralProcessor\0`, registry.QUERY_VALUE)
+	if err != nil {
+		return "", 0, err
+	}
+	defer k.Close()
+	vendorID, _, err := k.GetStringValue("VendorIdentifier")
+	if err != nil {
+		return "", 0, err
+	}
+	mhz, _, err := k.GetIntegerValue("~MHz")
+	if err != nil {
+		return vendorID, 0, err
+	}
+	return vendorID, mhz * 1000, nil
+}
+
+// getMachineGUID returns the ID Windows generates at installation.
+func getMachineGUID() string {
+	k, err := registry.OpenKey(registry.LOCAL_MACHINE, `SOFTWARE\Microsoft\Cryptography`, registry.QUERY_VALUE|registry.WOW64_64KEY)
+	if err != nil {
+		return ""
+	}
+	defer k.Close()
+	guid, _, err := k.GetStringValue("MachineGuid")
+	if err != nil {
+		return ""
+	}
+	return guid
+}
+
+func ContainerOsVersion() string {
+	os, err := getOperatingSystem()
+	if err != nil {
+		os = "Unknown"
+	}
+	return os
+}
+
+func KernelVersion() string {
+	version := windows.RtlGetVersion()
+	return fmt.Sprintf("%d.%d.%d", version.MajorVersion, version.MinorVersion, version.BuildNumber)
+}
@@ -0,0 +1,90 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package machine
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+var sysctls = flag.String("sysctls", "vm.swappiness,vm.overcommit_memory,vm.overcommit_ratio", "Comma-separated list of sysctls to report in version info, e.g. vm.swappiness. Sysctls of network namespaces are those of the namespace of cAdvisor")
+
+// Directories the kernel settings are read from, changed by tests.
+var (
+	procDir     = "/proc"
+	securityDir = "/sys/kernel/security"
+	selinuxDir  = "/sys/fs/selinux"
+)
+
+// KernelCmdline returns the parameters the kernel was booted with.
+func KernelCmdline() string {
+	cmdline, err := os.ReadFile(filepath.Join(procDir, "cmdline"))
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(cmdline))
+}
+
+// SecurityModules returns the Linux security modules active, in the order
+// the kernel calls them, or nil if securityfs is not mounted.
+func SecurityModules() []string {
+	lsm, err := os.ReadFile(filepath.Join(securityDir, "lsm"))
+	if err != nil {
+		return nil
+	}
+	var modules []string
+	for _, module := range strings.Split(strings.TrimSpace(string(lsm)), ",") {
+		if module != "" {
+			modules = append(modules, module)
+		}
+	}
+	return modules
+}
+
+// SELinuxMode returns whether SELinux is "enforcing" or "permissive", and
+// "disabled" if selinuxfs is not mounted.
+func SELinuxMode() string {
+	enforce, err := os.ReadFile(filepath.Join(selinuxDir, "enforce"))
+	if err != nil {
+		return "disabled"
+	}
+	if strings.TrimSpace(string(enforce)) == "1" {
+		return "enforcing"
+	}
+	return "permissive"
+}
+
+// Sysctls returns the values of the sysctls of the sysctls flag, leaving out
+// those that do not exist.
+func Sysctls() map[string]string {
+	values := map[string]string{}
+	for _, name := range strings.Split(*sysctls, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		value, err := os.ReadFile(filepath.Join(procDir, "sys", strings.ReplaceAll(name, ".", "/")))
+		if err != nil {
+			continue
+		}
+		// Multi-valued sysctls separate their values with tabs.
+		values[name] = strings.Join(strings.Fields(string(value)), " ")
+	}
+	return values
+}
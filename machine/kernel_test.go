@@ -0,0 +1,68 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package machine
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeFile(t *testing.T, file, content string) {
+	require.NoError(t, os.MkdirAll(filepath.Dir(file), 0755))
+	require.NoError(t, os.WriteFile(file, []byte(content), 0644))
+}
+
+func TestKernelSettings(t *testing.T) {
+	dir := t.TempDir()
+	oldProcDir, oldSecurityDir, oldSelinuxDir, oldSysctls := procDir, securityDir, selinuxDir, *sysctls
+	defer func() {
+		procDir, securityDir, selinuxDir, *sysctls = oldProcDir, oldSecurityDir, oldSelinuxDir, oldSysctls
+	}()
+	procDir = filepath.Join(dir, "proc")
+	securityDir = filepath.Join(dir, "security")
+	selinuxDir = filepath.Join(dir, "selinux")
+
+	// Nothing can be read.
+	assert.Empty(t, KernelCmdline())
+	assert.Nil(t, SecurityModules())
+	assert.Equal(t, "disabled", SELinuxMode())
+	assert.Empty(t, Sysctls())
+
+	writeFile(t, filepath.Join(procDir, "cmdline"), "BOOT_IMAGE=/vmlinuz root=/dev/sda1 ro transparent_hugepage=never\n")
+	writeFile(t, filepath.Join(securityDir, "lsm"), "lockdown,capability,yama,selinux,bpf")
+	writeFile(t, filepath.Join(selinuxDir, "enforce"), "0")
+	writeFile(t, filepath.Join(procDir, "sys/vm/swappiness"), "60\n")
+	writeFile(t, filepath.Join(procDir, "sys/vm/overcommit_memory"), "1\n")
+	writeFile(t, filepath.Join(procDir, "sys/net/ipv4/ip_local_port_range"), "32768\t60999\n")
+	*sysctls = "vm.swappiness, vm.overcommit_memory,net.ipv4.ip_local_port_range,vm.missing"
+
+	assert.Equal(t, "BOOT_IMAGE=/vmlinuz root=/dev/sda1 ro transparent_hugepage=never", KernelCmdline())
+	assert.Equal(t, []string{"lockdown", "capability", "yama", "selinux", "bpf"}, SecurityModules())
+	assert.Equal(t, "permissive", SELinuxMode())
+	assert.Equal(t, map[string]string{
+		"vm.swappiness":                "60",
+		"vm.overcommit_memory":         "1",
+		"net.ipv4.ip_local_port_range": "32768 60999",
+	}, Sysctls())
+
+	writeFile(t, filepath.Join(selinuxDir, "enforce"), "1")
+	assert.Equal(t, "enforcing", SELinuxMode())
+}
@@ -0,0 +1,39 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build windows || freebsd
+
+package machine
+
+// KernelCmdline returns nothing: the kernel command line is only read on
+// Linux.
+func KernelCmdline() string {
+	return ""
+}
+
+// SecurityModules returns nothing: Linux security modules only exist on
+// Linux.
+func SecurityModules() []string {
+	return nil
+}
+
+// SELinuxMode returns "disabled": SELinux only exists on Linux.
+func SELinuxMode() string {
+	return "disabled"
+}
+
+// Sysctls returns nothing: the sysctls are only read on Linux.
+func Sysctls() map[string]string {
+	return nil
+}
@@ -0,0 +1,110 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package machine
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"time"
+
+	info "github.com/google/cadvisor/info/v1"
+
+	"k8s.io/klog/v2"
+)
+
+var smartctlPath = flag.String("smartctl_path", "", "Path of smartctl (smartmontools 7.0 or later) to run for the SMART health and temperature of the disks of the machine. Empty disables SMART, temperatures are then only read from the hwmon sensors of the disks")
+
+// smartctlTimeout bounds how long smartctl may take per disk, as it talks to
+// the disk itself.
+const smartctlTimeout = 10 * time.Second
+
+// smartctlOutput is the part of the JSON output of smartctl used.
+type smartctlOutput struct {
+	ModelName    string `json:"model_name"`
+	SerialNumber string `json:"serial_number"`
+	SmartStatus  *struct {
+		Passed bool `json:"passed"`
+	} `json:"smart_status"`
+	Temperature struct {
+		Current int64 `json:"current"`
+	} `json:"temperature"`
+}
+
+// addSmartInfo fills in the SMART health of the disks of diskMap, and their
+// model, serial number and temperature where sysfs did not report them, when
+// smartctl_path is set.
+func addSmartInfo(diskMap map[string]info.DiskInfo, devDir string) {
+	if *smartctlPath == "" {
+		return
+	}
+	for id, disk := range diskMap {
+		out, err := runSmartctl(*smartctlPath, filepath.Join(devDir, disk.Name))
+		if err != nil {
+			klog.V(4).Infof("Could not get SMART information of disk %q: %v", disk.Name, err)
+			continue
+		}
+		if err := applySmartctlOutput(&disk, out); err != nil {
+			klog.V(4).Infof("Could not parse SMART information of disk %q: %v", disk.Name, err)
+			continue
+		}
+		diskMap[id] = disk
+	}
+}
+
+// runSmartctl returns the JSON output of smartctl for the health and the
+// attributes of a device.
+func runSmartctl(smartctl, device string) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), smartctlTimeout)
+	defer cancel()
+	out, err := exec.CommandContext(ctx, smartctl, "--json", "--health", "--attributes", device).Output()
+	// The exit status of smartctl is a bit mask which also flags failing
+	// disks, whose output is still valid.
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) && len(out) > 0 {
+		return out, nil
+	}
+	return out, err
+}
+
+// applySmartctlOutput fills in disk from the JSON output of smartctl.
+func applySmartctlOutput(disk *info.DiskInfo, out []byte) error {
+	var smart smartctlOutput
+	if err := json.Unmarshal(out, &smart); err != nil {
+		return fmt.Errorf("failed to parse smartctl output: %v", err)
+	}
+	if smart.SmartStatus != nil {
+		disk.Health = "failed"
+		if smart.SmartStatus.Passed {
+			disk.Health = "passed"
+		}
+	}
+	if disk.Model == "" {
+		disk.Model = smart.ModelName
+	}
+	if disk.Serial == "" {
+		disk.Serial = smart.SerialNumber
+	}
+	if disk.Temperature == 0 {
+		disk.Temperature = smart.Temperature.Current
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package machine
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	info "github.com/google/cadvisor/info/v1"
+)
+
+func TestApplySmartctlOutput(t *testing.T) {
+	disk := info.DiskInfo{Name: "sda", Model: "QEMU HARDDISK"}
+	out := []byte(`{
+  "model_name": "Samsung SSD 860 EVO 500GB",
+  "serial_number": "S3Z1NB0K123456",
+  "smart_status": {"passed": true},
+  "temperature": {"current": 31}
+}`)
+	assert.NoError(t, applySmartctlOutput(&disk, out))
+	assert.Equal(t, info.DiskInfo{
+		Name:        "sda",
+		Model:       "QEMU HARDDISK",
+		Serial:      "S3Z1NB0K123456",
+		Health:      "passed",
+		Temperature: 31,
+	}, disk)
+
+	disk = info.DiskInfo{Name: "sdb", Temperature: 40}
+	assert.NoError(t, applySmartctlOutput(&disk, []byte(`{"smart_status": {"passed": false}, "temperature": {"current": 55}}`)))
+	assert.Equal(t, "failed", disk.Health)
+	// The hwmon sensor is preferred.
+	assert.Equal(t, int64(40), disk.Temperature)
+
+	// Disks without SMART support have no health.
+	disk = info.DiskInfo{Name: "vda"}
+	assert.NoError(t, applySmartctlOutput(&disk, []byte(`{"model_name": ""}`)))
+	assert.Empty(t, disk.Health)
+
+	assert.Error(t, applySmartctlOutput(&disk, []byte("smartctl: not found")))
+}
@@ -12,11 +12,12 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-//go:build linux
+//go:build linux || windows || freebsd
 
 package manager
 
 import (
+	"context"
 	"flag"
 	"fmt"
 	"math"
@@ -35,13 +36,19 @@ import (
 	"github.com/google/cadvisor/cache/memory"
 	"github.com/google/cadvisor/collector"
 	"github.com/google/cadvisor/container"
+	"github.com/google/cadvisor/events"
 	info "github.com/google/cadvisor/info/v1"
 	v2 "github.com/google/cadvisor/info/v2"
+	"github.com/google/cadvisor/metrics/selfmetrics"
 	"github.com/google/cadvisor/stats"
 	"github.com/google/cadvisor/summary"
 	"github.com/google/cadvisor/utils/cpuload"
+	"github.com/google/cadvisor/utils/tracing"
+	"github.com/google/cadvisor/watcher"
 
 	"github.com/docker/go-units"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
 
 	"k8s.io/klog/v2"
 	"k8s.io/utils/clock"
@@ -50,6 +57,9 @@ import (
 // Housekeeping interval.
 var enableLoadReader = flag.Bool("enable_load_reader", false, "Whether to enable cpu load reader")
 var HousekeepingInterval = flag.Duration("housekeeping_interval", 1*time.Second, "Interval between container housekeepings")
+var tracer = otel.Tracer("github.com/google/cadvisor/manager")
+
+var housekeepingMetricsPerContainer = flag.Bool("housekeeping_metrics_per_container", false, "Whether to export a histogram of the housekeeping duration of every container, besides the one of all containers. This adds a dozen series per container")
 
 // TODO: replace regular expressions with something simpler, such as strings.Split().
 // cgroup type chosen to fetch the cgroup path of a process.
@@ -78,6 +88,28 @@ func (t *atomicTime) Time() time.Time {
 	return time.Unix(0, t.Load())
 }
 
+// housekeepingIntervals are the base and maximum housekeeping intervals,
+// shared by all containers and changed when the configuration is reloaded.
+type housekeepingIntervals struct {
+	base atomic.Int64
+	max  atomic.Int64
+}
+
+func newHousekeepingIntervals(base, max time.Duration) *housekeepingIntervals {
+	i := &housekeepingIntervals{}
+	i.set(base, max)
+	return i
+}
+
+func (i *housekeepingIntervals) get() (base, max time.Duration) {
+	return time.Duration(i.base.Load()), time.Duration(i.max.Load())
+}
+
+func (i *housekeepingIntervals) set(base, max time.Duration) {
+	i.base.Store(int64(base))
+	i.max.Store(int64(max))
+}
+
 type containerData struct {
 	oomEvents                uint64
 	handler                  container.ContainerHandler
@@ -89,7 +121,7 @@ type containerData struct {
 	loadAvg                  float64 // smoothed load average seen so far.
 	loadDAvg                 float64 // smoothed load.d average seen so far.
 	housekeepingInterval     time.Duration
-	maxHousekeepingInterval  time.Duration
+	intervals                *housekeepingIntervals
 	allowDynamicHousekeeping bool
 	infoLastUpdatedTime      atomicTime // Unix nano
 	statsLastUpdatedTime     atomicTime // Unix nano
@@ -114,10 +146,61 @@ type containerData struct {
 	collectorManager collector.CollectorManager
 
 	// perfCollector updates stats for perf_event cgroup controller.
+	// perfLock guards it, as it is replaced when the perf events are
+	// reloaded.
+	perfLock      sync.Mutex
 	perfCollector stats.Collector
 
 	// resctrlCollector updates stats for resctrl controller.
 	resctrlCollector stats.Collector
+
+	// acceleratorCollector updates the stats of the accelerators of the
+	// container.
+	acceleratorCollector stats.Collector
+	// The last XID error of each accelerator seen in the stats.
+	acceleratorXIDs map[string]uint64
+
+	// eventHandler receives the health status changes of the container, may be nil.
+	eventHandler events.EventManager
+	// Health status seen in the last stats, nil before the first stats.
+	healthStatus *string
+
+	// Bounds of the housekeeping interval when housekeeping is adaptive,
+	// nil otherwise.
+	adaptiveBounds *housekeepingBounds
+
+	// Runs the housekeeping of the container on a shared worker pool, nil
+	// if the container has its own housekeeping goroutine.
+	scheduler *housekeepingScheduler
+
+	// Tracks when the perf and resctrl metric groups were last collected.
+	groupSchedule container.MetricGroupSchedule
+
+	// Stretches the housekeeping interval while cAdvisor sheds load, nil if
+	// cAdvisor has no resource budget.
+	guardrails *guardrails
+
+	// When the running housekeeping started in Unix nano, 0 while none is
+	// running. Watched by the housekeeping watchdog.
+	housekeepingSince atomicTime
+	// Where the container was discovered from, to create it again when the
+	// watchdog restarts its collection.
+	watchSource watcher.ContainerWatchSource
+
+	// Records the OOM events of the container from its memory.events
+	// counters, nil unless on cgroup v2.
+	oomTracker       *oomTracker
+	memoryEventsLock sync.Mutex
+	// The counters seen last, nil before the first check.
+	memoryEvents *memoryEvents
+
+	// Reports the CPU throttling and memory pressure of the container
+	// crossing thresholds, nil if no threshold is set.
+	thresholds *thresholdTracker
+
+	// Returns the labels of the Kubernetes pod of the container to add to
+	// its spec, nil unless the kubelet is queried.
+	podLabels func(containerName string) map[string]string
 }
 
 // jitter returns a time.Duration between duration and duration + maxFactor * duration,
@@ -132,6 +215,10 @@ func jitter(duration time.Duration, maxFactor float64) time.Duration {
 }
 
 func (cd *containerData) Start() error {
+	if cd.scheduler != nil {
+		cd.scheduler.add(cd)
+		return nil
+	}
 	go cd.housekeeping()
 	return nil
 }
@@ -141,15 +228,61 @@ func (cd *containerData) Stop() error {
 	if err != nil {
 		return err
 	}
+	if *housekeepingMetricsPerContainer {
+		selfmetrics.ContainerHousekeepingDuration.DeleteLabelValues(cd.info.Name)
+	}
+	cd.halt()
+	return nil
+}
+
+// halt stops the housekeeping of the container, but keeps its stats. A
+// housekeeping that is running finishes in the background, and the stats it
+// collects are dropped.
+func (cd *containerData) halt() {
 	// Use sync.Once to ensure the channel is only closed once, preventing
 	// panic from concurrent calls to Stop() when multiple goroutines try
 	// to destroy the same container simultaneously.
 	cd.stopOnce.Do(func() {
 		close(cd.stop)
+		if cd.scheduler != nil {
+			cd.scheduler.remove(cd)
+		}
 	})
+	cd.perfLock.Lock()
 	cd.perfCollector.Destroy()
+	cd.perfLock.Unlock()
 	cd.resctrlCollector.Destroy()
-	return nil
+	cd.acceleratorCollector.Destroy()
+}
+
+// setPerfCollector replaces the perf collector of the container, destroying
+// the previous one.
+func (cd *containerData) setPerfCollector(collector stats.Collector) {
+	cd.perfLock.Lock()
+	previous := cd.perfCollector
+	cd.perfCollector = collector
+	cd.perfLock.Unlock()
+	previous.Destroy()
+}
+
+// stopped returns whether the container was stopped.
+func (cd *containerData) stopped() bool {
+	select {
+	case <-cd.stop:
+		return true
+	default:
+		return false
+	}
+}
+
+// housekeepingBlocked returns for how long the running housekeeping has
+// been running at now, 0 if none is.
+func (cd *containerData) housekeepingBlocked(now time.Time) time.Duration {
+	since := cd.housekeepingSince.Load()
+	if since == 0 {
+		return 0
+	}
+	return now.Sub(time.Unix(0, since))
 }
 
 func (cd *containerData) allowErrorLogging() bool {
@@ -169,6 +302,9 @@ func (cd *containerData) OnDemandHousekeeping(maxAge time.Duration) {
 	if timeSinceStatsLastUpdate > maxAge {
 		housekeepingFinishedChan := make(chan struct{})
 		cd.onDemandChan <- housekeepingFinishedChan
+		if cd.scheduler != nil {
+			cd.scheduler.expedite(cd)
+		}
 		select {
 		case <-cd.stop:
 		case <-housekeepingFinishedChan:
@@ -248,8 +384,12 @@ func (cd *containerData) getCgroupPath(cgroups string) string {
 }
 
 // Returns contents of a file inside the container root.
-// Takes in a path relative to container root.
+// Takes in a path relative to container root, which it can't escape: the
+// files are read as root on behalf of the container.
 func (cd *containerData) ReadFile(filepath string, inHostNamespace bool) ([]byte, error) {
+	if err := validateContainerPath(filepath); err != nil {
+		return nil, err
+	}
 	pids, err := cd.getContainerPids(inHostNamespace)
 	if err != nil {
 		return nil, err
@@ -260,9 +400,9 @@ func (cd *containerData) ReadFile(filepath string, inHostNamespace bool) ([]byte
 		rootfs = "/rootfs"
 	}
 	for _, pid := range pids {
-		filePath := path.Join(rootfs, "/proc", pid, "/root", filepath)
-		klog.V(3).Infof("Trying path %q", filePath)
-		data, err := os.ReadFile(filePath)
+		root := path.Join(rootfs, "/proc", pid, "/root")
+		klog.V(3).Infof("Trying path %q in %q", filepath, root)
+		data, err := readFileInRoot(root, filepath)
 		if err == nil {
 			return data, err
 		}
@@ -271,6 +411,17 @@ func (cd *containerData) ReadFile(filepath string, inHostNamespace bool) ([]byte
 	return nil, fmt.Errorf("file %q does not exist", filepath)
 }
 
+// validateContainerPath refuses the paths of files in containers with ".."
+// elements, which only serve to try to escape the container root.
+func validateContainerPath(filepath string) error {
+	for _, element := range strings.Split(filepath, "/") {
+		if element == ".." {
+			return fmt.Errorf("invalid path %q: \"..\" is not allowed", filepath)
+		}
+	}
+	return nil
+}
+
 // Return output for ps command in host /proc with specified format
 func (cd *containerData) getPsOutput(inHostNamespace bool, format string) ([]byte, error) {
 	args := []string{}
@@ -440,7 +591,7 @@ func (cd *containerData) parsePsLine(line, cadvisorContainer string, inHostNames
 	return &info, nil
 }
 
-func newContainerData(containerName string, memoryCache *memory.InMemoryCache, handler container.ContainerHandler, logUsage bool, collectorManager collector.CollectorManager, maxHousekeepingInterval time.Duration, allowDynamicHousekeeping bool, clock clock.Clock) (*containerData, error) {
+func newContainerData(containerName string, memoryCache *memory.InMemoryCache, handler container.ContainerHandler, logUsage bool, collectorManager collector.CollectorManager, intervals *housekeepingIntervals, allowDynamicHousekeeping bool, clock clock.Clock) (*containerData, error) {
 	if memoryCache == nil {
 		return nil, fmt.Errorf("nil memory storage")
 	}
@@ -455,8 +606,7 @@ func newContainerData(containerName string, memoryCache *memory.InMemoryCache, h
 	cont := &containerData{
 		handler:                  handler,
 		memoryCache:              memoryCache,
-		housekeepingInterval:     *HousekeepingInterval,
-		maxHousekeepingInterval:  maxHousekeepingInterval,
+		intervals:                intervals,
 		allowDynamicHousekeeping: allowDynamicHousekeeping,
 		logUsage:                 logUsage,
 		loadAvg:                  -1.0, // negative value indicates uninitialized.
@@ -467,8 +617,10 @@ func newContainerData(containerName string, memoryCache *memory.InMemoryCache, h
 		clock:                    clock,
 		perfCollector:            &stats.NoopCollector{},
 		resctrlCollector:         &stats.NoopCollector{},
+		acceleratorCollector:     &stats.NoopCollector{},
 	}
 	cont.info.ContainerReference = ref
+	cont.housekeepingInterval, _ = intervals.get()
 
 	cont.loadDecay = math.Exp(float64(-cont.housekeepingInterval.Seconds() / 10))
 
@@ -495,38 +647,63 @@ func newContainerData(containerName string, memoryCache *memory.InMemoryCache, h
 	return cont, nil
 }
 
+// setAdaptiveBounds makes the housekeeping of the container adaptive within
+// the given bounds.
+func (cd *containerData) setAdaptiveBounds(b housekeepingBounds) {
+	cd.adaptiveBounds = &b
+	cd.housekeepingInterval = min(max(cd.housekeepingInterval, b.min), b.max)
+}
+
 // Determine when the next housekeeping should occur.
 func (cd *containerData) nextHousekeepingInterval() time.Duration {
-	if cd.allowDynamicHousekeeping {
+	if cd.allowDynamicHousekeeping && cd.adaptiveBounds != nil {
 		var empty time.Time
-		stats, err := cd.memoryCache.RecentStats(cd.info.Name, empty, empty, 2)
-		if err != nil {
-			if cd.allowErrorLogging() {
-				klog.V(4).Infof("Failed to get RecentStats(%q) while determining the next housekeeping: %v", cd.info.Name, err)
+		var buf [adaptiveHousekeepingSamples]*info.ContainerStats
+		err := cd.memoryCache.ViewRecentStats(buf[:0], cd.info.Name, empty, empty, adaptiveHousekeepingSamples, func(stats []*info.ContainerStats) {
+			if len(stats) >= 2 {
+				variation, changed := statsVariation(stats)
+				cd.housekeepingInterval = adaptInterval(cd.housekeepingInterval, variation, changed, *cd.adaptiveBounds)
 			}
-		} else if len(stats) == 2 {
+		})
+		if err != nil && cd.allowErrorLogging() {
+			klog.V(4).Infof("Failed to get RecentStats(%q) while determining the next housekeeping: %v", cd.info.Name, err)
+		}
+	} else if cd.allowDynamicHousekeeping {
+		var empty time.Time
+		var buf [2]*info.ContainerStats
+		err := cd.memoryCache.ViewRecentStats(buf[:0], cd.info.Name, empty, empty, 2, func(stats []*info.ContainerStats) {
+			if len(stats) != 2 {
+				return
+			}
+			base, maxInterval := cd.intervals.get()
 			// TODO(vishnuk): Use no processes as a signal.
 			// Raise the interval if usage hasn't changed in the last housekeeping.
-			if stats[0].StatsEq(stats[1]) && (cd.housekeepingInterval < cd.maxHousekeepingInterval) {
+			if stats[0].StatsEq(stats[1]) && (cd.housekeepingInterval < maxInterval) {
 				cd.housekeepingInterval *= 2
-				if cd.housekeepingInterval > cd.maxHousekeepingInterval {
-					cd.housekeepingInterval = cd.maxHousekeepingInterval
+				if cd.housekeepingInterval > maxInterval {
+					cd.housekeepingInterval = maxInterval
 				}
-			} else if cd.housekeepingInterval != *HousekeepingInterval {
+			} else if cd.housekeepingInterval != base {
 				// Lower interval back to the baseline.
-				cd.housekeepingInterval = *HousekeepingInterval
+				cd.housekeepingInterval = base
 			}
+		})
+		if err != nil && cd.allowErrorLogging() {
+			klog.V(4).Infof("Failed to get RecentStats(%q) while determining the next housekeeping: %v", cd.info.Name, err)
 		}
+	} else {
+		// The interval is fixed, but may have been reloaded.
+		cd.housekeepingInterval, _ = cd.intervals.get()
 	}
 
-	return jitter(cd.housekeepingInterval, 1.0)
+	return jitter(cd.housekeepingInterval*cd.guardrails.stretch(), 1.0)
 }
 
-// TODO(vmarmol): Implement stats collecting as a custom collector.
-func (cd *containerData) housekeeping() {
+// startHousekeeping starts what housekeeping needs running in the
+// background, which must be stopped with stopHousekeeping.
+func (cd *containerData) startHousekeeping() {
 	// Start any background goroutines - must be cleaned up in cd.handler.Cleanup().
 	cd.handler.Start()
-	defer cd.handler.Cleanup()
 
 	// Initialize cpuload reader - must be cleaned up in cd.loadReader.Stop()
 	if cd.loadReader != nil {
@@ -534,14 +711,32 @@ func (cd *containerData) housekeeping() {
 		if err != nil {
 			klog.Warningf("Could not start cpu load stat collector for %q: %s", cd.info.Name, err)
 		}
-		defer cd.loadReader.Stop()
 	}
+}
 
-	// Long housekeeping is either 100ms or half of the housekeeping interval.
+func (cd *containerData) stopHousekeeping() {
+	if cd.loadReader != nil {
+		cd.loadReader.Stop()
+	}
+	cd.handler.Cleanup()
+}
+
+// longHousekeeping returns how long housekeeping may take before it is
+// logged: either 100ms or half of the housekeeping interval.
+func longHousekeeping() time.Duration {
 	longHousekeeping := 100 * time.Millisecond
 	if *HousekeepingInterval/2 < longHousekeeping {
 		longHousekeeping = *HousekeepingInterval / 2
 	}
+	return longHousekeeping
+}
+
+// TODO(vmarmol): Implement stats collecting as a custom collector.
+func (cd *containerData) housekeeping() {
+	cd.startHousekeeping()
+	defer cd.stopHousekeeping()
+
+	longHousekeeping := longHousekeeping()
 
 	// Housekeep every second.
 	klog.V(3).Infof("Start housekeeping for container %q\n", cd.info.Name)
@@ -558,34 +753,39 @@ func (cd *containerData) housekeeping() {
 			default:
 			}
 		}
-		// Log usage if asked to do so.
-		if cd.logUsage {
-			const numSamples = 60
-			var empty time.Time
-			stats, err := cd.memoryCache.RecentStats(cd.info.Name, empty, empty, numSamples)
-			if err != nil {
-				if cd.allowErrorLogging() {
-					klog.Warningf("[%s] Failed to get recent stats for logging usage: %v", cd.info.Name, err)
-				}
-			} else if len(stats) < numSamples {
-				// Ignore, not enough stats yet.
-			} else {
-				usageCPUNs := uint64(0)
-				for i := range stats {
-					if i > 0 {
-						usageCPUNs += stats[i].Cpu.Usage.Total - stats[i-1].Cpu.Usage.Total
-					}
-				}
-				usageMemory := stats[numSamples-1].Memory.Usage
+		cd.logRecentUsage()
+		houseKeepingTimer.Reset(cd.nextHousekeepingInterval())
+	}
+}
 
-				instantUsageInCores := float64(stats[numSamples-1].Cpu.Usage.Total-stats[numSamples-2].Cpu.Usage.Total) / float64(stats[numSamples-1].Timestamp.Sub(stats[numSamples-2].Timestamp).Nanoseconds())
-				usageInCores := float64(usageCPUNs) / float64(stats[numSamples-1].Timestamp.Sub(stats[0].Timestamp).Nanoseconds())
-				usageInHuman := units.HumanSize(float64(usageMemory))
-				// Don't set verbosity since this is already protected by the logUsage flag.
-				klog.Infof("[%s] %.3f cores (average: %.3f cores), %s of memory", cd.info.Name, instantUsageInCores, usageInCores, usageInHuman)
+// logRecentUsage logs the usage of the container if asked to do so.
+func (cd *containerData) logRecentUsage() {
+	if !cd.logUsage {
+		return
+	}
+	const numSamples = 60
+	var empty time.Time
+	stats, err := cd.memoryCache.RecentStats(cd.info.Name, empty, empty, numSamples)
+	if err != nil {
+		if cd.allowErrorLogging() {
+			klog.Warningf("[%s] Failed to get recent stats for logging usage: %v", cd.info.Name, err)
+		}
+	} else if len(stats) < numSamples {
+		// Ignore, not enough stats yet.
+	} else {
+		usageCPUNs := uint64(0)
+		for i := range stats {
+			if i > 0 {
+				usageCPUNs += stats[i].Cpu.Usage.Total - stats[i-1].Cpu.Usage.Total
 			}
 		}
-		houseKeepingTimer.Reset(cd.nextHousekeepingInterval())
+		usageMemory := stats[numSamples-1].Memory.Usage
+
+		instantUsageInCores := float64(stats[numSamples-1].Cpu.Usage.Total-stats[numSamples-2].Cpu.Usage.Total) / float64(stats[numSamples-1].Timestamp.Sub(stats[numSamples-2].Timestamp).Nanoseconds())
+		usageInCores := float64(usageCPUNs) / float64(stats[numSamples-1].Timestamp.Sub(stats[0].Timestamp).Nanoseconds())
+		usageInHuman := units.HumanSize(float64(usageMemory))
+		// Don't set verbosity since this is already protected by the logUsage flag.
+		klog.Infof("[%s] %.3f cores (average: %.3f cores), %s of memory", cd.info.Name, instantUsageInCores, usageInCores, usageInHuman)
 	}
 }
 
@@ -599,21 +799,36 @@ func (cd *containerData) housekeepingTick(timer <-chan time.Time, longHousekeepi
 		defer close(finishedChan)
 	case <-timer:
 	}
+	cd.housekeepOnce(longHousekeeping)
+	return true
+}
+
+// housekeepOnce updates the stats of the container and notifies the calls to
+// OnDemandHousekeeping waiting for it.
+func (cd *containerData) housekeepOnce(longHousekeeping time.Duration) {
 	start := cd.clock.Now()
-	err := cd.updateStats()
+	cd.housekeepingSince.Store(start.UnixNano())
+	defer cd.housekeepingSince.Store(0)
+	ctx, span := tracer.Start(context.Background(), "Housekeeping", trace.WithAttributes(tracing.ContainerName.String(cd.info.Name)))
+	err := cd.updateStats(ctx)
 	if err != nil {
 		if cd.allowErrorLogging() {
 			klog.Warningf("Failed to update stats for container \"%s\": %s", cd.info.Name, err)
 		}
 	}
+	cd.checkMemoryEvents()
+	tracing.End(span, err)
 	// Log if housekeeping took too long.
 	duration := cd.clock.Since(start)
+	selfmetrics.HousekeepingDuration.Observe(duration.Seconds())
+	if *housekeepingMetricsPerContainer {
+		selfmetrics.ContainerHousekeepingDuration.WithLabelValues(cd.info.Name).Observe(duration.Seconds())
+	}
 	if duration >= longHousekeeping {
 		klog.V(3).Infof("[%s] Housekeeping took %s", cd.info.Name, duration)
 	}
 	cd.notifyOnDemand()
 	cd.statsLastUpdatedTime.Store(cd.clock.Now().UnixNano())
-	return true
 }
 
 func (cd *containerData) updateSpec() error {
@@ -637,9 +852,20 @@ func (cd *containerData) updateSpec() error {
 	cd.lock.Lock()
 	defer cd.lock.Unlock()
 	cd.info.Spec = spec
+	cd.addPodLabels()
 	return nil
 }
 
+// addPodLabels adds the labels of the Kubernetes pod of the container to its
+// spec. The labels set by the container runtime take precedence. It must be
+// called with the lock held.
+func (cd *containerData) addPodLabels() {
+	if cd.podLabels == nil {
+		return
+	}
+	cd.info.Spec.Labels = mergeLabels(cd.info.Spec.Labels, cd.podLabels(cd.info.Name))
+}
+
 // Calculate new smoothed load average using the new sample of runnable threads.
 // The decay used ensures that the load will stabilize on a new constant value within
 // 10 seconds.
@@ -659,8 +885,41 @@ func (cd *containerData) updateLoadD(newLoad uint64) {
 	}
 }
 
-func (cd *containerData) updateStats() error {
-	stats, statsErr := cd.handler.GetStats()
+// groupDue returns whether the given metric group is to be collected at now.
+// If it is not, a copy of the latest stats, which hold its last collected
+// values, is returned.
+func (cd *containerData) groupDue(kind container.MetricKind, now time.Time) (last info.ContainerStats, due bool) {
+	if cd.groupSchedule.Due(kind, now) {
+		return last, true
+	}
+	var empty time.Time
+	var buf [1]*info.ContainerStats
+	due = true
+	_ = cd.memoryCache.ViewRecentStats(buf[:0], cd.info.Name, empty, empty, 1, func(stats []*info.ContainerStats) {
+		if len(stats) > 0 {
+			last, due = *stats[0], false
+		}
+	})
+	return last, due
+}
+
+// getStats returns the stats of the handler, tracing the reads of the handlers
+// implementing container.StatsContextGetter.
+func (cd *containerData) getStats(ctx context.Context) (*info.ContainerStats, error) {
+	ctx, span := tracer.Start(ctx, "GetStats", trace.WithAttributes(tracing.Handler.String(fmt.Sprintf("%T", cd.handler))))
+	var stats *info.ContainerStats
+	var err error
+	if getter, ok := cd.handler.(container.StatsContextGetter); ok {
+		stats, err = getter.GetStatsContext(ctx)
+	} else {
+		stats, err = cd.handler.GetStats()
+	}
+	tracing.End(span, err)
+	return stats, err
+}
+
+func (cd *containerData) updateStats(ctx context.Context) error {
+	stats, statsErr := cd.getStats(ctx)
 	if statsErr != nil {
 		// Ignore errors if the container is dead.
 		if !cd.handler.Exists() {
@@ -677,7 +936,9 @@ func (cd *containerData) updateStats() error {
 		// TODO(vmarmol): Cache this path.
 		path, err := cd.handler.GetCgroupPath("cpu")
 		if err == nil {
+			_, span := tracer.Start(ctx, "GetCpuLoad")
 			loadStats, err := cd.loadReader.GetCpuLoad(cd.info.Name, path)
+			tracing.End(span, err)
 			if err != nil {
 				return fmt.Errorf("failed to get load stat for %q - path %q, error %s", cd.info.Name, path, err)
 			}
@@ -701,11 +962,16 @@ func (cd *containerData) updateStats() error {
 
 	stats.OOMEvents = atomic.LoadUint64(&cd.oomEvents)
 
+	cd.updateHealthStatus(stats)
+	cd.checkThresholds(stats)
+
 	var customStatsErr error
 	cm := cd.collectorManager.(*collector.GenericCollectorManager)
 	if len(cm.Collectors) > 0 {
 		if cm.NextCollectionTime.Before(cd.clock.Now()) {
+			_, span := tracer.Start(ctx, "UpdateCustomStats")
 			customStats, err := cd.updateCustomStats()
+			tracing.End(span, err)
 			if customStats != nil {
 				stats.CustomMetrics = customStats
 			}
@@ -715,9 +981,31 @@ func (cd *containerData) updateStats() error {
 		}
 	}
 
-	perfStatsErr := cd.perfCollector.UpdateStats(stats)
+	var perfStatsErr error
+	if last, due := cd.groupDue(container.PerfMetrics, stats.Timestamp); due {
+		_, span := tracer.Start(ctx, "UpdatePerfStats")
+		cd.perfLock.Lock()
+		perfStatsErr = cd.perfCollector.UpdateStats(stats)
+		cd.perfLock.Unlock()
+		tracing.End(span, perfStatsErr)
+	} else {
+		stats.PerfStats = last.PerfStats
+		stats.PerfUncoreStats = last.PerfUncoreStats
+	}
+
+	var resctrlStatsErr error
+	if last, due := cd.groupDue(container.ResctrlMetrics, stats.Timestamp); due {
+		_, span := tracer.Start(ctx, "UpdateResctrlStats")
+		resctrlStatsErr = cd.resctrlCollector.UpdateStats(stats)
+		tracing.End(span, resctrlStatsErr)
+	} else {
+		stats.Resctrl = last.Resctrl
+	}
 
-	resctrlStatsErr := cd.resctrlCollector.UpdateStats(stats)
+	_, span := tracer.Start(ctx, "UpdateAcceleratorStats")
+	acceleratorStatsErr := cd.acceleratorCollector.UpdateStats(stats)
+	tracing.End(span, acceleratorStatsErr)
+	cd.updateAcceleratorXIDs(stats)
 
 	ref, err := cd.handler.ContainerReference()
 	if err != nil {
@@ -728,10 +1016,20 @@ func (cd *containerData) updateStats() error {
 		return err
 	}
 
+	// The storage drivers get the labels of the spec, with those of the pod
+	// if they were not known yet when the spec was read.
+	cd.lock.Lock()
+	cd.addPodLabels()
 	cInfo := info.ContainerInfo{
 		ContainerReference: ref,
+		Spec:               cd.info.Spec,
 	}
+	cd.lock.Unlock()
 
+	if cd.stopped() {
+		// The collection was restarted meanwhile, or the container destroyed.
+		return nil
+	}
 	err = cd.memoryCache.AddStats(&cInfo, stats)
 	if err != nil {
 		return err
@@ -747,9 +1045,71 @@ func (cd *containerData) updateStats() error {
 		klog.Errorf("error occurred while collecting resctrl stats for container %s: %s", cInfo.Name, resctrlStatsErr)
 		return resctrlStatsErr
 	}
+	if acceleratorStatsErr != nil {
+		klog.Errorf("error occurred while collecting accelerator stats for container %s: %s", cInfo.Name, acceleratorStatsErr)
+		return acceleratorStatsErr
+	}
 	return customStatsErr
 }
 
+// updateHealthStatus emits an event when the health status of the container
+// changes between two stats.
+func (cd *containerData) updateHealthStatus(stats *info.ContainerStats) {
+	status := stats.Health.Status
+	previous := cd.healthStatus
+	cd.healthStatus = &status
+	if previous == nil || *previous == status || cd.eventHandler == nil {
+		return
+	}
+	err := cd.eventHandler.AddEvent(&info.Event{
+		ContainerName: cd.info.Name,
+		Timestamp:     stats.Timestamp,
+		EventType:     info.EventHealthStatus,
+		EventData: info.EventData{
+			HealthStatus: &info.HealthStatusEventData{
+				PreviousStatus: *previous,
+				Status:         status,
+				FailingStreak:  stats.Health.FailingStreak,
+			},
+		},
+	})
+	if err != nil {
+		klog.Errorf("Failed to add health status event for %q: %v", cd.info.Name, err)
+	}
+}
+
+// updateAcceleratorXIDs emits an event when the last XID error of an
+// accelerator of the container changes between two stats. The error an
+// accelerator already had in the first stats it is seen in is not reported,
+// as it may predate the container.
+func (cd *containerData) updateAcceleratorXIDs(stats *info.ContainerStats) {
+	previous := cd.acceleratorXIDs
+	cd.acceleratorXIDs = make(map[string]uint64, len(stats.Accelerators))
+	for _, accelerator := range stats.Accelerators {
+		id := accelerator.ID + "/" + accelerator.GPUInstance
+		cd.acceleratorXIDs[id] = accelerator.XIDError
+		xid, ok := previous[id]
+		if !ok || xid == accelerator.XIDError || accelerator.XIDError == 0 || cd.eventHandler == nil {
+			continue
+		}
+		err := cd.eventHandler.AddEvent(&info.Event{
+			ContainerName: cd.info.Name,
+			Timestamp:     stats.Timestamp,
+			EventType:     info.EventAcceleratorXID,
+			EventData: info.EventData{
+				AcceleratorXID: &info.AcceleratorXIDEventData{
+					ID:          accelerator.ID,
+					GPUInstance: accelerator.GPUInstance,
+					XID:         accelerator.XIDError,
+				},
+			},
+		})
+		if err != nil {
+			klog.Errorf("Failed to add accelerator XID event for %q: %v", cd.info.Name, err)
+		}
+	}
+}
+
 func (cd *containerData) updateCustomStats() (map[string][]info.MetricVal, error) {
 	_, customStats, customStatsErr := cd.collectorManager.Collect()
 	if customStatsErr != nil {
@@ -19,7 +19,10 @@
 package manager
 
 import (
+	"context"
 	"fmt"
+	"os"
+	"path/filepath"
 	"reflect"
 	"sync"
 	"testing"
@@ -29,12 +32,18 @@ import (
 	"github.com/google/cadvisor/collector"
 	"github.com/google/cadvisor/container"
 	containertest "github.com/google/cadvisor/container/testing"
+	"github.com/google/cadvisor/events"
 	info "github.com/google/cadvisor/info/v1"
 	itest "github.com/google/cadvisor/info/v1/test"
 	v2 "github.com/google/cadvisor/info/v2"
+	"github.com/google/cadvisor/utils/kubelet"
+	"github.com/google/cadvisor/utils/tracing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
 
 	clock "k8s.io/utils/clock/testing"
 )
@@ -53,7 +62,7 @@ func setupContainerData(t *testing.T, spec info.ContainerSpec) (*containerData,
 	)
 	memoryCache := memory.New(60, nil)
 	fakeClock := clock.NewFakeClock(time.Now())
-	ret, err := newContainerData(containerName, memoryCache, mockHandler, false, &collector.GenericCollectorManager{}, 60*time.Second, true, fakeClock)
+	ret, err := newContainerData(containerName, memoryCache, mockHandler, false, &collector.GenericCollectorManager{}, newHousekeepingIntervals(*HousekeepingInterval, 60*time.Second), true, fakeClock)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -143,7 +152,7 @@ func TestUpdateStats(t *testing.T) {
 		nil,
 	)
 
-	err := cd.updateStats()
+	err := cd.updateStats(context.Background())
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -152,6 +161,77 @@ func TestUpdateStats(t *testing.T) {
 	mockHandler.AssertExpectations(t)
 }
 
+func TestHousekeepingSpans(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	defer func(old trace.Tracer) { tracer = old }(tracer)
+	tracer = sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)).Tracer("test")
+
+	cd, mockHandler, _, _ := newTestContainerData(t)
+	mockHandler.On("GetStats").Return(itest.GenerateRandomStats(1, 4, time.Second)[0], nil)
+	cd.housekeepOnce(testLongHousekeeping)
+
+	spans := map[string]sdktrace.ReadOnlySpan{}
+	for _, span := range recorder.Ended() {
+		spans[span.Name()] = span
+	}
+	require.Contains(t, spans, "Housekeeping")
+	require.Contains(t, spans, "GetStats")
+	housekeeping, getStats := spans["Housekeeping"], spans["GetStats"]
+	assert.False(t, housekeeping.Parent().IsValid())
+	assert.Contains(t, housekeeping.Attributes(), tracing.ContainerName.String(containerName))
+	assert.Equal(t, housekeeping.SpanContext().SpanID(), getStats.Parent().SpanID())
+	assert.Contains(t, getStats.Attributes(), tracing.Handler.String("*testing.MockContainerHandler"))
+}
+
+func TestUpdateHealthStatus(t *testing.T) {
+	cd, _, _, _ := newTestContainerData(t)
+	eventManager := events.NewEventManager(events.DefaultStoragePolicy())
+	cd.eventHandler = eventManager
+
+	now := time.Now()
+	for i, status := range []string{"starting", "starting", "healthy", "unhealthy"} {
+		cd.updateHealthStatus(&info.ContainerStats{
+			Timestamp: now.Add(time.Duration(i) * time.Second),
+			Health:    info.Health{Status: status, FailingStreak: i},
+		})
+	}
+
+	request := events.NewRequest()
+	request.EventType[info.EventHealthStatus] = true
+	evs, err := eventManager.GetEvents(request)
+	require.NoError(t, err)
+	require.Len(t, evs, 2)
+	assert.Equal(t, containerName, evs[0].ContainerName)
+	assert.Equal(t, &info.HealthStatusEventData{PreviousStatus: "starting", Status: "healthy", FailingStreak: 2}, evs[0].EventData.HealthStatus)
+	assert.Equal(t, &info.HealthStatusEventData{PreviousStatus: "healthy", Status: "unhealthy", FailingStreak: 3}, evs[1].EventData.HealthStatus)
+}
+
+func TestUpdateAcceleratorXIDs(t *testing.T) {
+	cd, _, _, _ := newTestContainerData(t)
+	eventManager := events.NewEventManager(events.DefaultStoragePolicy())
+	cd.eventHandler = eventManager
+
+	now := time.Now()
+	// The error of the first stats is not reported, nor the same one again.
+	for i, xid := range []uint64{13, 13, 79, 0} {
+		cd.updateAcceleratorXIDs(&info.ContainerStats{
+			Timestamp: now.Add(time.Duration(i) * time.Second),
+			Accelerators: []info.AcceleratorStats{
+				{ID: "GPU-a3b50e4f", XIDError: xid},
+				{ID: "GPU-5d5ba0d6", GPUInstance: "1", XIDError: 31 * uint64(i/3)},
+			},
+		})
+	}
+
+	request := events.NewRequest()
+	request.EventType[info.EventAcceleratorXID] = true
+	evs, err := eventManager.GetEvents(request)
+	require.NoError(t, err)
+	require.Len(t, evs, 2)
+	assert.Equal(t, &info.AcceleratorXIDEventData{ID: "GPU-a3b50e4f", XID: 79}, evs[0].EventData.AcceleratorXID)
+	assert.Equal(t, &info.AcceleratorXIDEventData{ID: "GPU-5d5ba0d6", GPUInstance: "1", XID: 31}, evs[1].EventData.AcceleratorXID)
+}
+
 func TestUpdateSpec(t *testing.T) {
 	spec := itest.GenerateRandomContainerSpec(4)
 	cd, mockHandler, _, _ := newTestContainerData(t)
@@ -168,6 +248,27 @@ func TestUpdateSpec(t *testing.T) {
 	mockHandler.AssertExpectations(t)
 }
 
+func TestPodLabels(t *testing.T) {
+	spec := itest.GenerateRandomContainerSpec(4)
+	spec.Labels = map[string]string{"app": "web", kubelet.PodNameLabel: "from-runtime"}
+	cd, mockHandler, _, _ := setupContainerData(t, spec)
+	var podLabels map[string]string
+	cd.podLabels = func(name string) map[string]string {
+		assert.Equal(t, containerName, name)
+		return podLabels
+	}
+	require.NoError(t, cd.updateSpec())
+	assert.Equal(t, spec.Labels, cd.info.Spec.Labels)
+
+	// Labels of pods listed after the spec was read are added by the
+	// housekeeping, without overriding those of the runtime.
+	podLabels = map[string]string{kubelet.PodNameLabel: "web-0", kubelet.PodNamespaceLabel: "shop"}
+	mockHandler.On("GetStats").Return(itest.GenerateRandomStats(1, 4, time.Second)[0], nil)
+	require.NoError(t, cd.updateStats(context.Background()))
+	assert.Equal(t, map[string]string{"app": "web", kubelet.PodNameLabel: "from-runtime", kubelet.PodNamespaceLabel: "shop"}, cd.info.Spec.Labels)
+	assert.Len(t, spec.Labels, 2, "the labels of the handler are not modified")
+}
+
 func TestGetInfo(t *testing.T) {
 	spec := itest.GenerateRandomContainerSpec(4)
 	subcontainers := []info.ContainerReference{
@@ -538,3 +639,40 @@ func TestGetCgroupPath(t *testing.T) {
 		})
 	}
 }
+
+func TestReadFileInRoot(t *testing.T) {
+	dir := t.TempDir()
+	root := filepath.Join(dir, "root")
+	require.NoError(t, os.MkdirAll(filepath.Join(root, "etc"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(root, "etc", "token"), []byte("container"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(root, "token"), []byte("container root"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "token"), []byte("host"), 0o600))
+	// Symlinks in the container resolve in its root, not in the host.
+	require.NoError(t, os.Symlink("/etc/token", filepath.Join(root, "absolute")))
+	require.NoError(t, os.Symlink("../../../token", filepath.Join(root, "etc", "relative")))
+	require.NoError(t, os.Symlink(dir, filepath.Join(root, "host")))
+
+	for _, readFile := range []func(root, path string) ([]byte, error){readFileInRoot, readFileSecureJoin} {
+		testReadFileInRoot(t, root, readFile)
+	}
+
+	assert.NoError(t, validateContainerPath("/var/run/secrets/token"))
+	assert.Error(t, validateContainerPath("../../var/lib/kubelet/pki/kubelet.key"))
+	assert.Error(t, validateContainerPath("/etc/../../token"))
+}
+
+func testReadFileInRoot(t *testing.T, root string, readFile func(root, path string) ([]byte, error)) {
+	for path, want := range map[string]string{
+		"/etc/token":    "container",
+		"etc/token":     "container",
+		"/absolute":     "container",
+		"/etc/relative": "container root",
+		"../token":      "container root",
+	} {
+		data, err := readFile(root, path)
+		require.NoError(t, err, path)
+		assert.Equal(t, want, string(data), path)
+	}
+	_, err := readFile(root, "/host/token")
+	assert.Error(t, err)
+}
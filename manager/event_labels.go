@@ -0,0 +1,47 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux || windows || freebsd
+
+package manager
+
+import (
+	"github.com/google/cadvisor/events"
+	info "github.com/google/cadvisor/info/v1"
+)
+
+// labelingEventManager records the labels of the container of the events it
+// adds, so that events can be selected by label once the container is gone.
+type labelingEventManager struct {
+	events.EventManager
+	labels func(containerName string) map[string]string
+}
+
+func (e labelingEventManager) AddEvent(event *info.Event) error {
+	if event.ContainerLabels == nil {
+		event.ContainerLabels = e.labels(event.ContainerName)
+	}
+	return e.EventManager.AddEvent(event)
+}
+
+// containerLabels returns the labels of a container, nil if it is unknown.
+func (m *manager) containerLabels(containerName string) map[string]string {
+	cont, ok := m.containers.Load(namespacedContainerName{Name: containerName})
+	if !ok || cont == nil {
+		return nil
+	}
+	cont.lock.Lock()
+	defer cont.lock.Unlock()
+	return cont.info.Spec.Labels
+}
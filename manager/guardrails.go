@@ -0,0 +1,225 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux || windows || freebsd
+
+package manager
+
+import (
+	"flag"
+	"sort"
+	"sync/atomic"
+	"time"
+
+	"k8s.io/klog/v2"
+
+	"github.com/google/cadvisor/cache/memory"
+	"github.com/google/cadvisor/container"
+	"github.com/google/cadvisor/events"
+	info "github.com/google/cadvisor/info/v1"
+)
+
+var (
+	selfCPUBudget      = flag.Float64("self_cpu_budget", 0, "CPU cAdvisor may use, in cores, before it sheds load. If 0, cAdvisor's CPU usage is not limited")
+	selfMemoryBudget   = flag.Uint64("self_memory_budget", 0, "Resident memory cAdvisor may use, in bytes, before it sheds load. If 0, cAdvisor's memory usage is not limited")
+	selfBudgetInterval = flag.Duration("self_budget_check_interval", 10*time.Second, "Interval between checks of cAdvisor's own CPU and memory usage against its budgets")
+	selfShedMetrics    = container.MetricSet{}
+	defaultShedMetrics = "advtcp,tcp,udp,sched,referenced_memory,process,disk"
+)
+
+func init() {
+	if err := selfShedMetrics.Set(defaultShedMetrics); err != nil {
+		panic(err)
+	}
+	flag.Var(&selfShedMetrics, "self_shed_metrics", "Comma-separated list of optional metrics paused while cAdvisor is well over its budgets")
+}
+
+const (
+	// Shedding levels. Each level includes the measures of the previous ones.
+	shedNone = iota
+	// Housekeeping intervals are doubled.
+	shedStretch
+	// Housekeeping intervals are quadrupled and the optional metrics are
+	// paused.
+	shedPause
+	// The in-memory cache keeps only the stats needed by dynamic housekeeping.
+	shedTrim
+	maxShedLevel = shedTrim
+
+	// Usage must drop below this fraction of the budgets to lower the level,
+	// so that the level does not flap around a budget.
+	shedRecoveryRatio = 0.8
+)
+
+// selfUsage is the resource usage of the cAdvisor process.
+type selfUsage struct {
+	// Cumulative user and system CPU time.
+	cpu time.Duration
+	// Resident set size in bytes.
+	rss uint64
+}
+
+// guardrails keeps cAdvisor within its CPU and memory budgets by shedding
+// load while it exceeds them.
+type guardrails struct {
+	cpuBudget    float64
+	memoryBudget uint64
+	// Optional metrics paused at shedPause.
+	shedMetrics     container.MetricSet
+	includedMetrics container.MetricSet
+	memoryCache     *memory.InMemoryCache
+	eventHandler    events.EventManager
+	readUsage       func() (selfUsage, error)
+
+	// Read by the housekeeping of every container.
+	level atomic.Int32
+
+	// Only accessed by check.
+	last      selfUsage
+	lastCheck time.Time
+	// Metrics paused by shedding, enabled again on recovery.
+	paused []container.MetricKind
+}
+
+func newGuardrails(cpuBudget float64, memoryBudget uint64, shedMetrics, includedMetrics container.MetricSet, memoryCache *memory.InMemoryCache, eventHandler events.EventManager) *guardrails {
+	return &guardrails{
+		cpuBudget:       cpuBudget,
+		memoryBudget:    memoryBudget,
+		shedMetrics:     shedMetrics,
+		includedMetrics: includedMetrics,
+		memoryCache:     memoryCache,
+		eventHandler:    eventHandler,
+		readUsage:       readSelfUsage,
+	}
+}
+
+// stretch returns the factor housekeeping intervals are multiplied with.
+// It is safe to call on a nil guardrails.
+func (g *guardrails) stretch() time.Duration {
+	if g == nil {
+		return 1
+	}
+	switch g.level.Load() {
+	case shedNone:
+		return 1
+	case shedStretch:
+		return 2
+	default:
+		return 4
+	}
+}
+
+// check compares the usage since the previous check against the budgets and
+// moves one shedding level up or down accordingly.
+func (g *guardrails) check(now time.Time) {
+	usage, err := g.readUsage()
+	if err != nil {
+		klog.Warningf("Failed to read cAdvisor's own resource usage: %v", err)
+		return
+	}
+	last, lastCheck := g.last, g.lastCheck
+	g.last, g.lastCheck = usage, now
+	if lastCheck.IsZero() || !now.After(lastCheck) {
+		return
+	}
+	cores := float64(usage.cpu-last.cpu) / float64(now.Sub(lastCheck))
+
+	over := (g.cpuBudget > 0 && cores > g.cpuBudget) ||
+		(g.memoryBudget > 0 && usage.rss > g.memoryBudget)
+	under := (g.cpuBudget <= 0 || cores < g.cpuBudget*shedRecoveryRatio) &&
+		(g.memoryBudget <= 0 || float64(usage.rss) < float64(g.memoryBudget)*shedRecoveryRatio)
+
+	previous := int(g.level.Load())
+	level := previous
+	switch {
+	case over && level < maxShedLevel:
+		level++
+	case under && level > shedNone:
+		level--
+	}
+	g.apply(previous, level)
+	if level == previous {
+		return
+	}
+
+	if level > previous {
+		klog.Warningf("cAdvisor uses %.2f cores and %d bytes of memory, raising load shedding to level %d", cores, usage.rss, level)
+	} else {
+		klog.Infof("cAdvisor uses %.2f cores and %d bytes of memory, lowering load shedding to level %d", cores, usage.rss, level)
+	}
+	if g.eventHandler == nil {
+		return
+	}
+	err = g.eventHandler.AddEvent(&info.Event{
+		ContainerName: "/",
+		Timestamp:     now,
+		EventType:     info.EventLoadShedding,
+		EventData: info.EventData{
+			LoadShedding: &info.LoadSheddingEventData{
+				PreviousLevel: previous,
+				Level:         level,
+				CPUCores:      cores,
+				MemoryBytes:   usage.rss,
+			},
+		},
+	})
+	if err != nil {
+		klog.Errorf("Failed to add load shedding event: %v", err)
+	}
+}
+
+// apply takes the measures of level, undoing those of previous that no
+// longer apply.
+func (g *guardrails) apply(previous, level int) {
+	if level >= shedPause && previous < shedPause {
+		shed := g.shedMetrics.Copy()
+		kinds := make([]string, 0, len(shed))
+		for kind := range shed {
+			kinds = append(kinds, string(kind))
+		}
+		sort.Strings(kinds)
+		for _, kind := range kinds {
+			if g.includedMetrics.Has(container.MetricKind(kind)) {
+				g.includedMetrics.Disable(container.MetricKind(kind))
+				g.paused = append(g.paused, container.MetricKind(kind))
+			}
+		}
+	}
+	if level < shedPause && previous >= shedPause {
+		for _, kind := range g.paused {
+			g.includedMetrics.Enable(kind)
+		}
+		g.paused = nil
+	}
+	// Trimming is repeated on every check since the cache fills up again.
+	if level >= shedTrim && g.memoryCache != nil {
+		g.memoryCache.TrimStats(adaptiveHousekeepingSamples)
+	}
+	g.level.Store(int32(level))
+}
+
+// watchSelfUsage checks cAdvisor's usage against its budgets until quit.
+func (m *manager) watchSelfUsage(quit chan error) {
+	ticker := time.NewTicker(*selfBudgetInterval)
+	for {
+		select {
+		case t := <-ticker.C:
+			m.guardrails.check(t)
+		case <-quit:
+			ticker.Stop()
+			quit <- nil
+			return
+		}
+	}
+}
@@ -0,0 +1,43 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build freebsd
+
+package manager
+
+import (
+	"os"
+	"time"
+
+	"golang.org/x/sys/unix"
+
+	"github.com/google/cadvisor/utils/rctl"
+)
+
+func readSelfUsage() (selfUsage, error) {
+	var rusage unix.Rusage
+	if err := unix.Getrusage(unix.RUSAGE_SELF, &rusage); err != nil {
+		return selfUsage{}, err
+	}
+	// The resident memory is accounted by racct, the one of getrusage is
+	// the peak.
+	usage, err := rctl.GetUsage(rctl.ProcessFilter(os.Getpid()))
+	if err != nil {
+		return selfUsage{}, err
+	}
+	return selfUsage{
+		cpu: time.Duration(rusage.Utime.Nano() + rusage.Stime.Nano()),
+		rss: usage["memoryuse"],
+	}, nil
+}
@@ -0,0 +1,60 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package manager
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/sys/unix"
+)
+
+var errNoResidentMemory = fmt.Errorf("no resident memory in /proc/self/statm")
+
+func readSelfUsage() (selfUsage, error) {
+	var rusage unix.Rusage
+	if err := unix.Getrusage(unix.RUSAGE_SELF, &rusage); err != nil {
+		return selfUsage{}, err
+	}
+	usage := selfUsage{
+		cpu: time.Duration(rusage.Utime.Nano() + rusage.Stime.Nano()),
+	}
+
+	f, err := os.Open("/proc/self/statm")
+	if err != nil {
+		return selfUsage{}, err
+	}
+	defer f.Close()
+	scanner := bufio.NewScanner(f)
+	if !scanner.Scan() {
+		return selfUsage{}, errNoResidentMemory
+	}
+	fields := strings.Fields(scanner.Text())
+	if len(fields) < 2 {
+		return selfUsage{}, errNoResidentMemory
+	}
+	pages, err := strconv.ParseUint(fields[1], 10, 64)
+	if err != nil {
+		return selfUsage{}, err
+	}
+	usage.rss = pages * uint64(os.Getpagesize())
+	return usage, nil
+}
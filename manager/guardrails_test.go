@@ -0,0 +1,112 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package manager
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/google/cadvisor/cache/memory"
+	"github.com/google/cadvisor/container"
+	"github.com/google/cadvisor/events"
+	info "github.com/google/cadvisor/info/v1"
+)
+
+func TestReadSelfUsage(t *testing.T) {
+	usage, err := readSelfUsage()
+	require.NoError(t, err)
+	assert.NotZero(t, usage.rss)
+}
+
+func TestGuardrailsStretchNil(t *testing.T) {
+	var g *guardrails
+	assert.Equal(t, time.Duration(1), g.stretch())
+}
+
+func TestGuardrails(t *testing.T) {
+	included := container.MetricSet{container.CpuUsageMetrics: struct{}{}, container.ProcessMetrics: struct{}{}}
+	shed := container.MetricSet{container.ProcessMetrics: struct{}{}, container.DiskUsageMetrics: struct{}{}}
+	eventHandler := events.NewEventManager(events.DefaultStoragePolicy())
+	memoryCache := memory.New(time.Hour, nil)
+	start := time.Unix(1000, 0)
+	for i := 0; i < 20; i++ {
+		require.NoError(t, memoryCache.AddStats(&info.ContainerInfo{ContainerReference: info.ContainerReference{Name: "/"}}, &info.ContainerStats{Timestamp: start.Add(time.Duration(i) * time.Second)}))
+	}
+
+	g := newGuardrails(0.5, 1000, shed, included, memoryCache, eventHandler)
+	var usage selfUsage
+	g.readUsage = func() (selfUsage, error) { return usage, nil }
+
+	now := start
+	// step advances the clock by 10s during which cAdvisor used the given
+	// cores, and checks the budgets.
+	step := func(cores float64, rss uint64) int {
+		now = now.Add(10 * time.Second)
+		usage.cpu += time.Duration(cores * float64(10*time.Second))
+		usage.rss = rss
+		g.check(now)
+		return int(g.level.Load())
+	}
+
+	// The first check only records the usage.
+	assert.Equal(t, shedNone, step(2, 100))
+	assert.Equal(t, shedNone, step(0.1, 100))
+
+	assert.Equal(t, shedStretch, step(1, 100))
+	assert.Equal(t, time.Duration(2), g.stretch())
+	assert.True(t, included.Has(container.ProcessMetrics))
+
+	assert.Equal(t, shedPause, step(0.1, 2000))
+	assert.Equal(t, time.Duration(4), g.stretch())
+	assert.False(t, included.Has(container.ProcessMetrics))
+	assert.False(t, included.Has(container.DiskUsageMetrics))
+	assert.True(t, included.Has(container.CpuUsageMetrics))
+
+	assert.Equal(t, shedTrim, step(1, 100))
+	stats, err := memoryCache.RecentStats("/", time.Time{}, time.Time{}, -1)
+	require.NoError(t, err)
+	assert.Len(t, stats, adaptiveHousekeepingSamples)
+	assert.Equal(t, shedTrim, step(1, 100))
+
+	// Between the recovery threshold and the budget the level is kept.
+	assert.Equal(t, shedTrim, step(0.45, 900))
+	assert.Equal(t, shedPause, step(0.1, 100))
+	assert.False(t, included.Has(container.ProcessMetrics))
+	assert.Equal(t, shedStretch, step(0.1, 100))
+	assert.True(t, included.Has(container.ProcessMetrics))
+	// Metrics that were not enabled before shedding stay disabled.
+	assert.False(t, included.Has(container.DiskUsageMetrics))
+	assert.Equal(t, shedNone, step(0.1, 100))
+	assert.Equal(t, time.Duration(1), g.stretch())
+
+	request := events.NewRequest()
+	request.EventType[info.EventLoadShedding] = true
+	request.ContainerName = "/"
+	request.MaxEventsReturned = -1
+	evs, err := eventHandler.GetEvents(request)
+	require.NoError(t, err)
+	levels := [][2]int{}
+	for _, ev := range evs {
+		levels = append(levels, [2]int{ev.EventData.LoadShedding.PreviousLevel, ev.EventData.LoadShedding.Level})
+	}
+	assert.Equal(t, [][2]int{{0, 1}, {1, 2}, {2, 3}, {3, 2}, {2, 1}, {1, 0}}, levels)
+	assert.InDelta(t, 1.0, evs[0].EventData.LoadShedding.CPUCores, 1e-9)
+	assert.Equal(t, uint64(2000), evs[1].EventData.LoadShedding.MemoryBytes)
+}
@@ -0,0 +1,40 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build windows
+
+package manager
+
+import (
+	"time"
+
+	"golang.org/x/sys/windows"
+
+	"github.com/google/cadvisor/utils/win32"
+)
+
+func readSelfUsage() (selfUsage, error) {
+	var creation, exit, kernel, user windows.Filetime
+	if err := windows.GetProcessTimes(windows.CurrentProcess(), &creation, &exit, &kernel, &user); err != nil {
+		return selfUsage{}, err
+	}
+	workingSet, err := win32.ProcessWorkingSet()
+	if err != nil {
+		return selfUsage{}, err
+	}
+	return selfUsage{
+		cpu: time.Duration(kernel.Nanoseconds() + user.Nanoseconds()),
+		rss: workingSet,
+	}, nil
+}
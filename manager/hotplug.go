@@ -0,0 +1,120 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux || windows || freebsd
+
+package manager
+
+import (
+	"sort"
+	"time"
+
+	info "github.com/google/cadvisor/info/v1"
+	"github.com/google/cadvisor/machine"
+
+	"k8s.io/klog/v2"
+)
+
+// hotplugSettleTime is how long to wait after a hotplug event before
+// updating machine info, as they come in bursts, e.g. one per memory block
+// onlined.
+const hotplugSettleTime = 2 * time.Second
+
+func (m *manager) updateMachineInfo(quit chan error) {
+	ticker := time.NewTicker(*updateMachineInfoInterval)
+	hotplug, stop := watchForHotplug()
+	var settled <-chan time.Time
+	for {
+		select {
+		case <-ticker.C:
+			m.refreshMachineInfo()
+		case <-hotplug:
+			if settled == nil {
+				settled = time.After(hotplugSettleTime)
+			}
+		case <-settled:
+			settled = nil
+			m.refreshMachineInfo()
+		case <-quit:
+			ticker.Stop()
+			stop()
+			quit <- nil
+			return
+		}
+	}
+}
+
+// refreshMachineInfo updates machine info, and reports a machineChanged event
+// of the root container if the CPUs, memory or disks of the machine changed.
+func (m *manager) refreshMachineInfo() {
+	machineInfo, err := machine.Info(m.sysFs, m.fsInfo, m.inHostNamespace)
+	if err != nil {
+		klog.Errorf("Could not get machine info: %v", err)
+		return
+	}
+	m.machineMu.Lock()
+	previous := m.machineInfo
+	m.machineInfo = *machineInfo
+	m.machineMu.Unlock()
+	klog.V(5).Infof("Update machine info: %+v", *machineInfo)
+
+	change := machineChange(&previous, machineInfo)
+	if change == nil {
+		return
+	}
+	klog.Infof("Machine changed: %d cores and %d bytes of memory, from %d cores and %d bytes, disks added %v, removed %v",
+		change.NumCores, change.MemoryCapacity, change.PreviousNumCores, change.PreviousMemoryCapacity, change.AddedDisks, change.RemovedDisks)
+	if m.eventHandler == nil {
+		return
+	}
+	err = m.eventHandler.AddEvent(&info.Event{
+		ContainerName: "/",
+		Timestamp:     machineInfo.Timestamp,
+		EventType:     info.EventMachineChanged,
+		EventData:     info.EventData{MachineChanged: change},
+	})
+	if err != nil {
+		klog.Errorf("Failed to add machine changed event: %v", err)
+	}
+}
+
+// machineChange returns how the CPUs, memory and disks of the machine changed
+// between two machine infos, and nil if they did not.
+func machineChange(previous, current *info.MachineInfo) *info.MachineChangedEventData {
+	change := &info.MachineChangedEventData{
+		PreviousNumCores:       previous.NumCores,
+		NumCores:               current.NumCores,
+		PreviousMemoryCapacity: previous.MemoryCapacity,
+		MemoryCapacity:         current.MemoryCapacity,
+		AddedDisks:             diskNamesMissing(current.DiskMap, previous.DiskMap),
+		RemovedDisks:           diskNamesMissing(previous.DiskMap, current.DiskMap),
+	}
+	if change.NumCores == change.PreviousNumCores && change.MemoryCapacity == change.PreviousMemoryCapacity &&
+		len(change.AddedDisks) == 0 && len(change.RemovedDisks) == 0 {
+		return nil
+	}
+	return change
+}
+
+// diskNamesMissing returns the sorted names of the disks of a missing from b.
+func diskNamesMissing(a, b map[string]info.DiskInfo) []string {
+	var names []string
+	for id, disk := range a {
+		if _, ok := b[id]; !ok {
+			names = append(names, disk.Name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,76 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package manager
+
+import (
+	"flag"
+
+	"github.com/google/cadvisor/utils/uevent"
+
+	"k8s.io/klog/v2"
+)
+
+var watchMachineHotplug = flag.Bool("watch_machine_hotplug", true, "Whether to update machine info as soon as the kernel reports CPUs, memory or disks being added or removed, rather than only every update_machine_info_interval")
+
+// isHotplugEvent returns whether a uevent may change the capacity of the
+// machine.
+func isHotplugEvent(ev *uevent.Event) bool {
+	switch ev.Subsystem {
+	case "cpu", "memory":
+		switch ev.Action {
+		case "add", "remove", "online", "offline":
+			return true
+		}
+	case "block":
+		if ev.Env["DEVTYPE"] != "disk" {
+			return false
+		}
+		// Resized disks report a change.
+		switch ev.Action {
+		case "add", "remove", "change":
+			return true
+		}
+	}
+	return false
+}
+
+// watchForHotplug notifies of the uevents that may change the capacity of
+// the machine, and returns a function to stop watching them. The channel is
+// nil if watch_machine_hotplug is disabled or uevents are not available.
+func watchForHotplug() (<-chan struct{}, func()) {
+	if !*watchMachineHotplug {
+		return nil, func() {}
+	}
+	watcher, err := uevent.New()
+	if err != nil {
+		klog.Warningf("Could not watch hotplug events, updating machine info every %v only: %v", *updateMachineInfoInterval, err)
+		return nil, func() {}
+	}
+	events := make(chan *uevent.Event, 64)
+	hotplug := make(chan struct{}, 64)
+	go watcher.Stream(events)
+	go func() {
+		for ev := range events {
+			if isHotplugEvent(ev) {
+				klog.V(4).Infof("Hotplug event %s of %s", ev.Action, ev.DevPath)
+				hotplug <- struct{}{}
+			}
+		}
+	}()
+	klog.V(2).Infof("Started watching for hotplug events")
+	return hotplug, func() { _ = watcher.Close() }
+}
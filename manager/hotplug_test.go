@@ -0,0 +1,81 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package manager
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	info "github.com/google/cadvisor/info/v1"
+	"github.com/google/cadvisor/utils/uevent"
+)
+
+func TestIsHotplugEvent(t *testing.T) {
+	for _, tc := range []struct {
+		ev       uevent.Event
+		expected bool
+	}{
+		{uevent.Event{Action: "online", Subsystem: "cpu"}, true},
+		{uevent.Event{Action: "offline", Subsystem: "memory"}, true},
+		{uevent.Event{Action: "add", Subsystem: "block", Env: map[string]string{"DEVTYPE": "disk"}}, true},
+		{uevent.Event{Action: "change", Subsystem: "block", Env: map[string]string{"DEVTYPE": "disk"}}, true},
+		{uevent.Event{Action: "add", Subsystem: "block", Env: map[string]string{"DEVTYPE": "partition"}}, false},
+		{uevent.Event{Action: "change", Subsystem: "cpu"}, false},
+		{uevent.Event{Action: "add", Subsystem: "net"}, false},
+	} {
+		assert.Equal(t, tc.expected, isHotplugEvent(&tc.ev), "%+v", tc.ev)
+	}
+}
+
+func TestMachineChange(t *testing.T) {
+	previous := &info.MachineInfo{
+		NumCores:       4,
+		MemoryCapacity: 8 << 30,
+		DiskMap: map[string]info.DiskInfo{
+			"8:0":  {Name: "sda"},
+			"8:16": {Name: "sdb"},
+		},
+	}
+	assert.Nil(t, machineChange(previous, previous))
+
+	current := &info.MachineInfo{
+		NumCores:       8,
+		MemoryCapacity: 16 << 30,
+		DiskMap: map[string]info.DiskInfo{
+			"8:0":    {Name: "sda"},
+			"252:0":  {Name: "vda"},
+			"252:16": {Name: "vdb"},
+		},
+	}
+	assert.Equal(t, &info.MachineChangedEventData{
+		PreviousNumCores:       4,
+		NumCores:               8,
+		PreviousMemoryCapacity: 8 << 30,
+		MemoryCapacity:         16 << 30,
+		AddedDisks:             []string{"vda", "vdb"},
+		RemovedDisks:           []string{"sdb"},
+	}, machineChange(previous, current))
+
+	// Only a disk detached.
+	detached := &info.MachineInfo{NumCores: 4, MemoryCapacity: 8 << 30, DiskMap: map[string]info.DiskInfo{"8:0": {Name: "sda"}}}
+	change := machineChange(previous, detached)
+	if assert.NotNil(t, change) {
+		assert.Empty(t, change.AddedDisks)
+		assert.Equal(t, []string{"sdb"}, change.RemovedDisks)
+	}
+}
@@ -0,0 +1,194 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux || windows || freebsd
+
+package manager
+
+import (
+	"flag"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	info "github.com/google/cadvisor/info/v1"
+
+	"k8s.io/klog/v2"
+)
+
+var adaptiveHousekeeping = flag.Bool("adaptive_housekeeping", false, "Whether to adapt the housekeeping interval of each container to the variance of its recent stats, instead of only backing off while they do not change. Requires allow_dynamic_housekeeping")
+var minHousekeepingInterval = flag.Duration("min_housekeeping_interval", 0, "Smallest interval adaptive housekeeping may use for hot containers, defaults to housekeeping_interval")
+var housekeepingQoSIntervals = flag.String("housekeeping_qos_intervals", "", "Comma-separated housekeeping interval bounds of adaptive housekeeping per Kubernetes QoS class, as class=min:max, e.g. \"guaranteed=1s:10s,besteffort=10s:2m\"")
+
+// Container labels that override the housekeeping interval bounds of a
+// container.
+const (
+	minHousekeepingIntervalLabel = "io.cadvisor.housekeeping.min_interval"
+	maxHousekeepingIntervalLabel = "io.cadvisor.housekeeping.max_interval"
+)
+
+const (
+	// Number of recent stats the variance of a container is computed over.
+	adaptiveHousekeepingSamples = 8
+	// Coefficients of variation above which the interval is shortened and
+	// below which it is lengthened.
+	highStatsVariation = 0.25
+	lowStatsVariation  = 0.05
+)
+
+// housekeepingBounds are the smallest and largest housekeeping intervals of a
+// container.
+type housekeepingBounds struct {
+	min, max time.Duration
+}
+
+// parseQoSIntervals parses the value of --housekeeping_qos_intervals.
+func parseQoSIntervals(value string) (map[string]housekeepingBounds, error) {
+	result := map[string]housekeepingBounds{}
+	for _, entry := range strings.Split(value, ",") {
+		if entry = strings.TrimSpace(entry); entry == "" {
+			continue
+		}
+		class, bounds, ok := strings.Cut(entry, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid QoS housekeeping intervals %q, expected class=min:max", entry)
+		}
+		minValue, maxValue, ok := strings.Cut(bounds, ":")
+		if !ok {
+			return nil, fmt.Errorf("invalid QoS housekeeping intervals %q, expected class=min:max", entry)
+		}
+		b, err := parseBounds(minValue, maxValue)
+		if err != nil {
+			return nil, fmt.Errorf("invalid QoS housekeeping intervals %q: %v", entry, err)
+		}
+		result[strings.ToLower(strings.TrimSpace(class))] = b
+	}
+	return result, nil
+}
+
+func parseBounds(minValue, maxValue string) (housekeepingBounds, error) {
+	var (
+		b   housekeepingBounds
+		err error
+	)
+	if b.min, err = time.ParseDuration(strings.TrimSpace(minValue)); err != nil {
+		return b, err
+	}
+	if b.max, err = time.ParseDuration(strings.TrimSpace(maxValue)); err != nil {
+		return b, err
+	}
+	if b.min <= 0 || b.max < b.min {
+		return b, fmt.Errorf("bounds %v:%v must be positive and ordered", b.min, b.max)
+	}
+	return b, nil
+}
+
+// qosClass returns the Kubernetes QoS class of the container from its cgroup,
+// or "" for containers outside of Kubernetes pods.
+func qosClass(name string) string {
+	if !strings.Contains(name, "kubepods") {
+		return ""
+	}
+	switch {
+	case strings.Contains(name, "besteffort"):
+		return "besteffort"
+	case strings.Contains(name, "burstable"):
+		return "burstable"
+	default:
+		return "guaranteed"
+	}
+}
+
+// adaptiveHousekeepingBounds returns the housekeeping interval bounds of the
+// named container, from its labels, then its QoS class, then the flags.
+func adaptiveHousekeepingBounds(name string, labels map[string]string, qosIntervals map[string]housekeepingBounds, baseInterval, maxInterval time.Duration) housekeepingBounds {
+	b := housekeepingBounds{min: *minHousekeepingInterval, max: maxInterval}
+	if b.min <= 0 {
+		b.min = baseInterval
+	}
+	if qos, ok := qosIntervals[qosClass(name)]; ok {
+		b = qos
+	}
+	minLabel, hasMin := labels[minHousekeepingIntervalLabel]
+	maxLabel, hasMax := labels[maxHousekeepingIntervalLabel]
+	if hasMin || hasMax {
+		if !hasMin {
+			minLabel = b.min.String()
+		}
+		if !hasMax {
+			maxLabel = b.max.String()
+		}
+		if labelBounds, err := parseBounds(minLabel, maxLabel); err != nil {
+			klog.Warningf("Ignoring housekeeping interval labels of %q: %v", name, err)
+		} else {
+			b = labelBounds
+		}
+	}
+	if b.max < b.min {
+		b.max = b.min
+	}
+	return b
+}
+
+// statsVariation returns the largest coefficient of variation of the CPU
+// usage rate and memory usage of stats, ordered oldest first, and whether
+// there were any changes at all.
+func statsVariation(stats []*info.ContainerStats) (float64, bool) {
+	changed := false
+	var cpuRates, memory []float64
+	for i := 1; i < len(stats); i++ {
+		if !stats[i].StatsEq(stats[i-1]) {
+			changed = true
+		}
+		elapsed := stats[i].Timestamp.Sub(stats[i-1].Timestamp).Seconds()
+		if elapsed > 0 && stats[i].Cpu.Usage.Total >= stats[i-1].Cpu.Usage.Total {
+			cpuRates = append(cpuRates, float64(stats[i].Cpu.Usage.Total-stats[i-1].Cpu.Usage.Total)/elapsed)
+		}
+		memory = append(memory, float64(stats[i].Memory.WorkingSet))
+	}
+	return math.Max(coefficientOfVariation(cpuRates), coefficientOfVariation(memory)), changed
+}
+
+func coefficientOfVariation(values []float64) float64 {
+	if len(values) < 2 {
+		return 0
+	}
+	var sum float64
+	for _, v := range values {
+		sum += v
+	}
+	mean := sum / float64(len(values))
+	if mean == 0 {
+		return 0
+	}
+	var squares float64
+	for _, v := range values {
+		squares += (v - mean) * (v - mean)
+	}
+	return math.Sqrt(squares/float64(len(values))) / mean
+}
+
+// adaptInterval returns the interval following current for a container whose
+// recent stats vary by variation: idle and steady containers are sampled less
+// often, and hot ones more often.
+func adaptInterval(current time.Duration, variation float64, changed bool, b housekeepingBounds) time.Duration {
+	switch {
+	case !changed || variation < lowStatsVariation:
+		current *= 2
+	case variation > highStatsVariation:
+		current /= 2
+	}
+	return min(max(current, b.min), b.max)
+}
@@ -0,0 +1,119 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package manager
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	info "github.com/google/cadvisor/info/v1"
+)
+
+func TestParseQoSIntervals(t *testing.T) {
+	intervals, err := parseQoSIntervals("Guaranteed=1s:10s, besteffort=10s:2m,")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]housekeepingBounds{
+		"guaranteed": {min: time.Second, max: 10 * time.Second},
+		"besteffort": {min: 10 * time.Second, max: 2 * time.Minute},
+	}, intervals)
+
+	for _, invalid := range []string{"burstable", "burstable=1s", "burstable=1s:x", "burstable=10s:1s", "burstable=0s:1s"} {
+		_, err := parseQoSIntervals(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
+
+func TestQoSClass(t *testing.T) {
+	for name, class := range map[string]string{
+		"/kubepods/besteffort/pod1/abc": "besteffort",
+		"/kubepods.slice/kubepods-burstable.slice/kubepods-burstable-pod1.slice/cri-containerd-abc.scope": "burstable",
+		"/kubepods/pod1/abc":           "guaranteed",
+		"/system.slice/docker.service": "",
+	} {
+		assert.Equal(t, class, qosClass(name), name)
+	}
+}
+
+func TestAdaptiveHousekeepingBounds(t *testing.T) {
+	qos := map[string]housekeepingBounds{"besteffort": {min: 10 * time.Second, max: 2 * time.Minute}}
+
+	assert.Equal(t, housekeepingBounds{min: *HousekeepingInterval, max: time.Minute},
+		adaptiveHousekeepingBounds("/system.slice/a.service", nil, qos, *HousekeepingInterval, time.Minute))
+	assert.Equal(t, qos["besteffort"],
+		adaptiveHousekeepingBounds("/kubepods/besteffort/pod1/abc", nil, qos, *HousekeepingInterval, time.Minute))
+	assert.Equal(t, housekeepingBounds{min: 10 * time.Second, max: 5 * time.Minute},
+		adaptiveHousekeepingBounds("/kubepods/besteffort/pod1/abc", map[string]string{maxHousekeepingIntervalLabel: "5m"}, qos, *HousekeepingInterval, time.Minute))
+	// Invalid labels are ignored.
+	assert.Equal(t, qos["besteffort"],
+		adaptiveHousekeepingBounds("/kubepods/besteffort/pod1/abc", map[string]string{minHousekeepingIntervalLabel: "soon"}, qos, *HousekeepingInterval, time.Minute))
+}
+
+func testStats(cpuPerSecond []uint64, workingSet []uint64) []*info.ContainerStats {
+	start := time.Unix(1700000000, 0)
+	var stats []*info.ContainerStats
+	var cpu uint64
+	for i := range cpuPerSecond {
+		cpu += cpuPerSecond[i]
+		s := &info.ContainerStats{Timestamp: start.Add(time.Duration(i) * time.Second)}
+		s.Cpu.Usage.Total = cpu
+		s.Memory.WorkingSet = workingSet[i]
+		stats = append(stats, s)
+	}
+	return stats
+}
+
+func TestAdaptInterval(t *testing.T) {
+	b := housekeepingBounds{min: time.Second, max: 8 * time.Second}
+
+	idle := testStats([]uint64{0, 0, 0, 0}, []uint64{100, 100, 100, 100})
+	variation, changed := statsVariation(idle)
+	assert.False(t, changed)
+	assert.Equal(t, 4*time.Second, adaptInterval(2*time.Second, variation, changed, b))
+	assert.Equal(t, 8*time.Second, adaptInterval(8*time.Second, variation, changed, b))
+
+	steady := testStats([]uint64{100, 100, 101, 100}, []uint64{100, 100, 100, 101})
+	variation, changed = statsVariation(steady)
+	assert.True(t, changed)
+	assert.Equal(t, 4*time.Second, adaptInterval(2*time.Second, variation, changed, b))
+
+	hot := testStats([]uint64{100, 10, 300, 50}, []uint64{100, 400, 100, 900})
+	variation, changed = statsVariation(hot)
+	assert.Equal(t, time.Second, adaptInterval(2*time.Second, variation, changed, b))
+	assert.Equal(t, time.Second, adaptInterval(time.Second, variation, changed, b))
+
+	moderate := testStats([]uint64{100, 110, 90, 100}, []uint64{100, 100, 100, 100})
+	variation, changed = statsVariation(moderate)
+	assert.Equal(t, 2*time.Second, adaptInterval(2*time.Second, variation, changed, b))
+}
+
+func TestAdaptiveNextHousekeepingInterval(t *testing.T) {
+	cd, _, memoryCache, _ := newTestContainerData(t)
+	cd.setAdaptiveBounds(housekeepingBounds{min: 2 * time.Second, max: 4 * time.Second})
+	assert.Equal(t, 2*time.Second, cd.housekeepingInterval)
+
+	// The cache of test containers only keeps 60ns of stats.
+	for i, s := range testStats([]uint64{0, 0, 0}, []uint64{1, 1, 1}) {
+		s.Timestamp = time.Unix(0, int64(10*i))
+		assert.NoError(t, memoryCache.AddStats(&info.ContainerInfo{ContainerReference: cd.info.ContainerReference}, s))
+	}
+	cd.nextHousekeepingInterval()
+	assert.Equal(t, 4*time.Second, cd.housekeepingInterval)
+	cd.nextHousekeepingInterval()
+	assert.Equal(t, 4*time.Second, cd.housekeepingInterval)
+}
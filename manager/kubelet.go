@@ -0,0 +1,91 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux || windows || freebsd
+
+package manager
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"k8s.io/klog/v2"
+	"k8s.io/utils/clock"
+
+	"github.com/google/cadvisor/utils/kubelet"
+)
+
+var (
+	kubeletURL                = flag.String("kubelet_url", "", "URL of the kubelet API whose pods endpoint labels the containers of Kubernetes pods with their pod name, namespace and UID and their container name, e.g. https://127.0.0.1:10250. Empty disables it")
+	kubeletTokenFile          = flag.String("kubelet_token_file", "/var/run/secrets/kubernetes.io/serviceaccount/token", "File holding the bearer token to authenticate to the kubelet with, re-read for every request. No token is sent if the file does not exist")
+	kubeletCAFile             = flag.String("kubelet_ca_file", "", "File holding the CA certificates to verify the serving certificate of the kubelet with, instead of the system ones")
+	kubeletInsecureSkipVerify = flag.Bool("kubelet_insecure_skip_tls_verify", false, "Do not verify the serving certificate of the kubelet, which kubelets sign themselves by default")
+	kubeletPodAnnotations     = flag.String("kubelet_pod_annotations", "", "Comma-separated list of pod annotations to add as labels of the containers of the pods, e.g. \"prometheus.io/scrape,example.com/*\". A trailing * matches any annotation with the prefix")
+	kubeletRefreshInterval    = flag.Duration("kubelet_refresh_interval", time.Minute, "How often to list the pods of the kubelet again to pick up changed annotations. New pods are listed as soon as their containers are detected")
+)
+
+// newKubeletEnricher returns the enricher of the containers of the pods of
+// the kubelet, nil if disabled.
+func newKubeletEnricher() (*kubelet.Enricher, error) {
+	if *kubeletURL == "" {
+		return nil, nil
+	}
+	tlsConfig := &tls.Config{InsecureSkipVerify: *kubeletInsecureSkipVerify}
+	if *kubeletCAFile != "" {
+		pem, err := os.ReadFile(*kubeletCAFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read the kubelet CA file: %v", err)
+		}
+		tlsConfig.RootCAs = x509.NewCertPool()
+		if !tlsConfig.RootCAs.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates in the kubelet CA file %q", *kubeletCAFile)
+		}
+	}
+	var patterns []string
+	for _, p := range strings.Split(*kubeletPodAnnotations, ",") {
+		if p = strings.TrimSpace(p); p != "" {
+			patterns = append(patterns, p)
+		}
+	}
+	klog.V(1).Infof("Labelling the containers of pods with the pods of the kubelet at %s", *kubeletURL)
+	client := kubelet.NewClient(*kubeletURL, *kubeletTokenFile, tlsConfig)
+	return kubelet.NewEnricher(client, patterns, *kubeletRefreshInterval, clock.RealClock{}), nil
+}
+
+// mergeLabels returns labels with the extra labels it does not have yet,
+// labels itself if there are none.
+func mergeLabels(labels, extra map[string]string) map[string]string {
+	var merged map[string]string
+	for k, v := range extra {
+		if _, ok := labels[k]; ok {
+			continue
+		}
+		if merged == nil {
+			merged = make(map[string]string, len(labels)+len(extra))
+			for k, v := range labels {
+				merged[k] = v
+			}
+		}
+		merged[k] = v
+	}
+	if merged == nil {
+		return labels
+	}
+	return merged
+}
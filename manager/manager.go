@@ -12,7 +12,7 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-//go:build linux
+//go:build linux || windows || freebsd
 
 // Manager of cAdvisor-monitored containers.
 package manager
@@ -29,10 +29,10 @@ import (
 	"sync/atomic"
 	"time"
 
+	"github.com/google/cadvisor/accelerators"
 	"github.com/google/cadvisor/cache/memory"
 	"github.com/google/cadvisor/collector"
 	"github.com/google/cadvisor/container"
-	"github.com/google/cadvisor/container/raw"
 	"github.com/google/cadvisor/events"
 	"github.com/google/cadvisor/fs"
 	info "github.com/google/cadvisor/info/v1"
@@ -41,23 +41,26 @@ import (
 	"github.com/google/cadvisor/nvm"
 	"github.com/google/cadvisor/perf"
 	"github.com/google/cadvisor/resctrl"
-	"github.com/google/cadvisor/stats"
-	"github.com/google/cadvisor/utils/oomparser"
+	"github.com/google/cadvisor/utils/kubelet"
 	"github.com/google/cadvisor/utils/sysfs"
 	"github.com/google/cadvisor/version"
 	"github.com/google/cadvisor/watcher"
 
-	"github.com/opencontainers/cgroups"
-
 	"k8s.io/klog/v2"
 	"k8s.io/utils/clock"
 )
 
 var globalHousekeepingInterval = flag.Duration("global_housekeeping_interval", 1*time.Minute, "Interval between global housekeepings")
 var updateMachineInfoInterval = flag.Duration("update_machine_info_interval", 5*time.Minute, "Interval between machine info updates.")
+var imageStorageInterval = flag.Duration("image_storage_interval", time.Minute, "Interval between inspections of the image storage of container runtimes, if image_storage metrics are enabled")
 var logCadvisorUsage = flag.Bool("log_cadvisor_usage", false, "Whether to log the usage of the cAdvisor container")
 var eventStorageAgeLimit = flag.String("event_storage_age_limit", "default=24h", "Max length of time for which to store events (per type). Value is a comma separated list of key values, where the keys are event types (e.g.: creation, oom) or \"default\" and the value is a duration. Default is applied to all non-specified event types")
 var eventStorageEventLimit = flag.String("event_storage_event_limit", "default=100000", "Max number of events to store (per type). Value is a comma separated list of key values, where the keys are event types (e.g.: creation, oom) or \"default\" and the value is an integer. Default is applied to all non-specified event types")
+var eventStoreFile = flag.String("event_store_file", "", "Path of a file in which to persist events, so that they are kept across restarts. Empty keeps events in memory only")
+var eventStoreAgeLimit = flag.Duration("event_store_age_limit", 7*24*time.Hour, "Max length of time for which to keep events in the event_store_file")
+var eventStoreEventLimit = flag.Int("event_store_event_limit", 100000, "Max number of events to keep in the event_store_file, -1 for no limit")
+var eventRateLimitWindow = flag.String("event_rate_limit_window", "default=0s", "Window over which to limit the number of events of a type each container adds (per type). Value is a comma separated list of key values, where the keys are event types (e.g.: oom, oomKill) or \"default\" and the value is a duration, 0 for no limit. Events beyond event_rate_limit_burst in a window are collapsed into one event added when it ends")
+var eventRateLimitBurst = flag.String("event_rate_limit_burst", "default=1", "Max number of events of a type each container adds per event_rate_limit_window (per type). Value is a comma separated list of key values, where the keys are event types (e.g.: oom, oomKill) or \"default\" and the value is an integer")
 var applicationMetricsCountLimit = flag.Int("application_metrics_count_limit", 100, "Max number of application metrics to store (per container)")
 
 // The namespace under which aliases are unique.
@@ -116,6 +119,13 @@ type Manager interface {
 	// Get version information about different components we depend on.
 	GetVersionInfo() (*info.VersionInfo, error)
 
+	// Get the image storage of the container runtimes, as last inspected.
+	GetImageStorage() ([]info.ImageStorage, error)
+
+	// Get the memory and interconnect traffic of the machine counted by its
+	// uncore PMUs, or nil if it is not measured.
+	GetUncoreTraffic() (*info.UncoreTraffic, error)
+
 	// GetFsInfoByFsUUID returns the information of the device having the
 	// specified filesystem uuid. If no such device with the UUID exists, this
 	// function will return the fs.ErrNoSuchDevice error.
@@ -134,6 +144,11 @@ type Manager interface {
 	// Get events streamed through passedChannel that fit the request.
 	WatchForEvents(request *events.Request) (*events.EventChannel, error)
 
+	// Get the stats of a container, and of its subcontainers if requested,
+	// streamed as housekeeping collects them. The returned function ends the
+	// watch.
+	WatchStats(containerName string, subcontainers bool) (<-chan *info.ContainerStatsUpdate, func(), error)
+
 	// Get past events that have been detected and that fit the request.
 	GetPastEvents(request *events.Request) ([]*info.Event, error)
 
@@ -145,6 +160,22 @@ type Manager interface {
 	AllPodmanContainers(c *info.ContainerInfoRequest) (map[string]info.ContainerInfo, error)
 
 	PodmanContainer(containerName string, query *info.ContainerInfoRequest) (info.ContainerInfo, error)
+
+	// SetHousekeepingIntervals changes the base and maximum housekeeping
+	// intervals of all containers.
+	SetHousekeepingIntervals(interval, maxInterval time.Duration) error
+
+	// ApplyContainerFilter stops collecting the containers the current
+	// container filter rejects and starts collecting those it now accepts.
+	ApplyContainerFilter() error
+
+	// ReloadPerfEvents re-reads the perf events configuration file and, if
+	// the events changed, measures the new events of every container.
+	ReloadPerfEvents() error
+
+	// CheckHousekeeping returns an error if the global housekeeping, which
+	// detects new containers, stopped making progress.
+	CheckHousekeeping() error
 }
 
 // Housekeeping configuration for the manager
@@ -160,15 +191,9 @@ func New(memoryCache *memory.InMemoryCache, sysfs sysfs.SysFs, HousekeepingConfi
 	}
 
 	// Detect the container we are running on.
-	selfContainer := "/"
-	var err error
-	// Avoid using GetOwnCgroupPath on cgroup v2 as it is not supported by libcontainer
-	if !cgroups.IsCgroup2UnifiedMode() {
-		selfContainer, err = cgroups.GetOwnCgroup("cpu")
-		if err != nil {
-			return nil, err
-		}
-		klog.V(2).Infof("cAdvisor running in container: %q", selfContainer)
+	selfContainer, err := getSelfContainer()
+	if err != nil {
+		return nil, err
 	}
 
 	context := fs.Context{}
@@ -200,7 +225,7 @@ func New(memoryCache *memory.InMemoryCache, sysfs sysfs.SysFs, HousekeepingConfi
 		cadvisorContainer:                     selfContainer,
 		inHostNamespace:                       inHostNamespace,
 		startupTime:                           time.Now(),
-		maxHousekeepingInterval:               *HousekeepingConfig.Interval,
+		intervals:                             newHousekeepingIntervals(*HousekeepingInterval, *HousekeepingConfig.Interval),
 		allowDynamicHousekeeping:              *HousekeepingConfig.AllowDynamic,
 		includedMetrics:                       includedMetricsSet,
 		containerWatchers:                     []watcher.ContainerWatcher{},
@@ -227,16 +252,116 @@ func New(memoryCache *memory.InMemoryCache, sysfs sysfs.SysFs, HousekeepingConfi
 		klog.V(4).Infof("Cannot gather resctrl metrics: %v", err)
 	}
 
+	newManager.acceleratorManager = &accelerators.NoopManager{}
+	if includedMetricsSet.Has(container.AcceleratorUsageMetrics) {
+		newManager.acceleratorManager = accelerators.NewManager()
+	}
+
 	versionInfo, err := getVersionInfo()
 	if err != nil {
 		return nil, err
 	}
 	klog.V(1).Infof("Version: %+v", *versionInfo)
 
-	newManager.eventHandler = events.NewEventManager(parseEventsStoragePolicy())
+	if newManager.allowDynamicHousekeeping && *adaptiveHousekeeping {
+		qosIntervals, err := parseQoSIntervals(*housekeepingQoSIntervals)
+		if err != nil {
+			return nil, err
+		}
+		newManager.housekeepingQoSIntervals = qosIntervals
+	}
+
+	if *housekeepingWorkers > 0 {
+		newManager.housekeepingScheduler = newHousekeepingScheduler(*housekeepingWorkers, clock.RealClock{})
+	}
+
+	eventHandler, eventStore := newEventHandler()
+	if policy := parseEventsRateLimitPolicy(); policy.Enabled() {
+		eventHandler = events.NewRateLimitedEventManager(eventHandler, policy)
+	}
+	newManager.eventHandler = labelingEventManager{EventManager: eventHandler, labels: newManager.containerLabels}
+	newManager.eventStore = eventStore
+
+	if *selfCPUBudget > 0 || *selfMemoryBudget > 0 {
+		newManager.guardrails = newGuardrails(*selfCPUBudget, *selfMemoryBudget, selfShedMetrics, includedMetricsSet, memoryCache, newManager.eventHandler)
+	}
+	if *profileDir != "" {
+		if *profileMaxCaptures < 1 {
+			return nil, fmt.Errorf("profile_max_captures must be at least 1, got %d", *profileMaxCaptures)
+		}
+		newManager.profiler = newSelfProfiler(*profileDir, *profileCPUThreshold, *profileDuration, *profileMinInterval, *profileMaxCaptures)
+	}
+	newManager.kubeletEnricher, err = newKubeletEnricher()
+	if err != nil {
+		return nil, err
+	}
+	newManager.trackOoms()
 	return newManager, nil
 }
 
+func (m *manager) SetHousekeepingIntervals(interval, maxInterval time.Duration) error {
+	if interval <= 0 {
+		return fmt.Errorf("housekeeping interval must be positive, got %v", interval)
+	}
+	if maxInterval < interval {
+		return fmt.Errorf("maximum housekeeping interval %v is below the housekeeping interval %v", maxInterval, interval)
+	}
+	m.intervals.set(interval, maxInterval)
+	klog.Infof("Housekeeping interval changed to %v, at most %v", interval, maxInterval)
+	return nil
+}
+
+func (m *manager) ReloadPerfEvents() error {
+	changed, err := m.perfManager.Reload()
+	if err != nil || !changed || !m.includedMetrics.Has(container.PerfMetrics) {
+		return err
+	}
+	m.containers.Range(func(name namespacedContainerName, cont *containerData) bool {
+		// Skip the aliases of containers.
+		if cont == nil || cont.info.Name != name.Name {
+			return true
+		}
+		perfCgroupPath, err := cont.handler.GetCgroupPath("perf_event")
+		if err != nil {
+			klog.Warningf("Error getting perf_event cgroup path: %q", err)
+			return true
+		}
+		collector, err := m.perfManager.GetContainerCollector(perfCgroupPath, cont.handler.GetContainerLabels())
+		if err != nil {
+			klog.Errorf("Perf event metrics will not be available for container %q: %v", cont.info.Name, err)
+		}
+		cont.setPerfCollector(collector)
+		return true
+	})
+	klog.Infof("Perf events changed, measuring them for all containers")
+	return nil
+}
+
+func (m *manager) ApplyContainerFilter() error {
+	var rejected []string
+	m.containers.Range(func(name namespacedContainerName, cont *containerData) bool {
+		if cont == nil || cont.info.Name != name.Name {
+			return true
+		}
+		cInfo, err := cont.GetInfo(false)
+		if err != nil {
+			klog.V(4).Infof("Failed to get the spec of container %q, not filtering it: %v", name.Name, err)
+			return true
+		}
+		if !container.Accepts(cInfo.Name, cInfo.Spec) {
+			rejected = append(rejected, cInfo.Name)
+		}
+		return true
+	})
+	for _, name := range rejected {
+		klog.V(3).Infof("Container %q is excluded by the container filter, removing it.", name)
+		if err := m.destroyContainer(name); err != nil {
+			klog.Errorf("Failed to destroy excluded container %q: %v", name, err)
+		}
+	}
+	return m.detectSubcontainers("/")
+}
+
 // A namespaced container name.
 type namespacedContainerName struct {
 	// The namespace of the container. Can be empty for the root namespace.
@@ -271,6 +396,11 @@ func (c *containerMap) Delete(name namespacedContainerName) {
 	c.m.Delete(name)
 }
 
+// DeleteIf removes the containerData for the given name if it is data.
+func (c *containerMap) DeleteIf(name namespacedContainerName, data *containerData) {
+	c.m.CompareAndDelete(name, data)
+}
+
 // Range calls f for each container in the map. If f returns false, iteration stops.
 func (c *containerMap) Range(f func(name namespacedContainerName, data *containerData) bool) {
 	c.m.Range(func(key, value any) bool {
@@ -285,23 +415,50 @@ type manager struct {
 	sysFs                    sysfs.SysFs
 	machineMu                sync.RWMutex // protects machineInfo
 	machineInfo              info.MachineInfo
+	imageStorageMu           sync.RWMutex // protects imageStorage
+	imageStorage             []info.ImageStorage
 	quitChannels             []chan error
 	cadvisorContainer        string
 	inHostNamespace          bool
 	eventHandler             events.EventManager
 	startupTime              time.Time
-	maxHousekeepingInterval  time.Duration
+	intervals                *housekeepingIntervals
 	allowDynamicHousekeeping bool
 	includedMetrics          container.MetricSet
 	containerWatchers        []watcher.ContainerWatcher
 	eventsChannel            chan watcher.ContainerEvent
 	collectorHTTPClient      *http.Client
-	perfManager              stats.Manager
+	perfManager              perf.Manager
 	resctrlManager           resctrl.ResControlManager
+	acceleratorManager       accelerators.Manager
 	// List of raw container cgroup path prefix whitelist.
 	rawContainerCgroupPathPrefixWhiteList []string
 	// List of container env prefix whitelist, the matched container envs would be collected into metrics as extra labels.
 	containerEnvMetadataWhiteList []string
+	// Housekeeping interval bounds per QoS class, nil unless housekeeping
+	// is adaptive.
+	housekeepingQoSIntervals map[string]housekeepingBounds
+	// Runs the housekeeping of all containers, nil if each container has
+	// its own housekeeping goroutine.
+	housekeepingScheduler *housekeepingScheduler
+	// Sheds load while cAdvisor exceeds its own CPU or memory budget, nil
+	// if no budget is set.
+	guardrails *guardrails
+	// Writes profiles of cAdvisor while it uses too much CPU, nil if
+	// disabled.
+	profiler *selfProfiler
+	// Records OOM events from the memory.events counters of containers, nil
+	// unless on cgroup v2.
+	oomTracker *oomTracker
+	// Persists the events, nil if they are only kept in memory.
+	eventStore events.Store
+	// Receives the StatsD metrics of the containers, nil if disabled.
+	statsdListener *collector.StatsdListener
+	// When the global housekeeping last completed, zero until it starts.
+	globalHousekeepingTime atomicTime
+	// Labels the containers of Kubernetes pods with the metadata of their
+	// pod, nil if the kubelet is not queried.
+	kubeletEnricher *kubelet.Enricher
 }
 
 func (m *manager) PodmanContainer(containerName string, query *info.ContainerInfoRequest) (info.ContainerInfo, error) {
@@ -319,30 +476,39 @@ func (m *manager) PodmanContainer(containerName string, query *info.ContainerInf
 
 // Start the container manager.
 func (m *manager) Start() error {
-	m.containerWatchers = container.InitializePlugins(m, m.fsInfo, m.includedMetrics)
-
-	err := raw.Register(m, m.fsInfo, m.includedMetrics, m.rawContainerCgroupPathPrefixWhiteList)
-	if err != nil {
-		klog.Errorf("Registration of the raw container factory failed: %v", err)
+	if err := container.InitializeFilter(); err != nil {
+		return err
 	}
+	m.containerWatchers = container.InitializePlugins(m, m.fsInfo, m.includedMetrics)
 
-	rawWatcher, err := raw.NewRawContainerWatcher(m.includedMetrics)
-	if err != nil {
+	if err := m.registerRawContainers(); err != nil {
 		return err
 	}
-	m.containerWatchers = append(m.containerWatchers, rawWatcher)
 
 	// Watch for OOMs.
-	err = m.watchForNewOoms()
+	err := m.watchForNewOoms()
 	if err != nil {
 		klog.Warningf("Could not configure a source for OOM detection, disabling OOM events: %v", err)
 	}
+	if err := m.watchForProcessEvents(); err != nil {
+		klog.Warningf("Could not watch process events, disabling them: %v", err)
+	}
+	if err := m.startStatsdListener(); err != nil {
+		return fmt.Errorf("failed to start the StatsD listener: %v", err)
+	}
 
 	// If there are no factories, don't start any housekeeping and serve the information we do have.
 	if !container.HasFactories() {
 		return nil
 	}
 
+	// List the pods first so that the recovered containers are labelled.
+	if m.kubeletEnricher != nil {
+		if err := m.kubeletEnricher.Refresh(); err != nil {
+			klog.Warningf("Failed to list the pods of the kubelet: %v", err)
+		}
+	}
+
 	// Create root and then recover all containers.
 	err = m.createContainer("/", watcher.Raw)
 	if err != nil {
@@ -354,6 +520,7 @@ func (m *manager) Start() error {
 		return err
 	}
 	klog.V(2).Infof("Recovery completed")
+	m.dropUntrackedStats()
 
 	// Watch for new container.
 	quitWatcher := make(chan error)
@@ -366,12 +533,37 @@ func (m *manager) Start() error {
 	// Look for new containers in the main housekeeping thread.
 	quitGlobalHousekeeping := make(chan error)
 	m.quitChannels = append(m.quitChannels, quitGlobalHousekeeping)
+	m.globalHousekeepingTime.Store(time.Now().UnixNano())
 	go m.globalHousekeeping(quitGlobalHousekeeping)
 
 	quitUpdateMachineInfo := make(chan error)
 	m.quitChannels = append(m.quitChannels, quitUpdateMachineInfo)
 	go m.updateMachineInfo(quitUpdateMachineInfo)
 
+	if m.includedMetrics.Has(container.ImageStorageMetrics) {
+		quitUpdateImageStorage := make(chan error)
+		m.quitChannels = append(m.quitChannels, quitUpdateImageStorage)
+		go m.updateImageStorage(quitUpdateImageStorage)
+	}
+
+	if *housekeepingWatchdogTimeout > 0 {
+		quitWatchdog := make(chan error)
+		m.quitChannels = append(m.quitChannels, quitWatchdog)
+		go m.watchHousekeeping(quitWatchdog)
+	}
+
+	if m.guardrails != nil {
+		quitWatchSelfUsage := make(chan error)
+		m.quitChannels = append(m.quitChannels, quitWatchSelfUsage)
+		go m.watchSelfUsage(quitWatchSelfUsage)
+	}
+
+	if m.profiler != nil {
+		quitWatchSelfProfile := make(chan error)
+		m.quitChannels = append(m.quitChannels, quitWatchSelfProfile)
+		go m.watchSelfProfile(quitWatchSelfProfile)
+	}
+
 	return nil
 }
 
@@ -389,6 +581,17 @@ func (m *manager) Stop() error {
 		}
 	}
 	m.quitChannels = make([]chan error, 0, 2)
+	if m.housekeepingScheduler != nil {
+		m.housekeepingScheduler.Stop()
+	}
+	if m.statsdListener != nil {
+		m.statsdListener.Stop()
+	}
+	if m.eventStore != nil {
+		if err := m.eventStore.Close(); err != nil {
+			klog.Warningf("Failed to close the event store: %v", err)
+		}
+	}
 	nvm.Finalize()
 	perf.Finalize()
 	return nil
@@ -399,26 +602,38 @@ func (m *manager) destroyCollectors() {
 		if container == nil {
 			return true
 		}
+		container.perfLock.Lock()
 		container.perfCollector.Destroy()
+		container.perfLock.Unlock()
 		container.resctrlCollector.Destroy()
+		container.acceleratorCollector.Destroy()
 		return true
 	})
+	if m.acceleratorManager != nil {
+		m.acceleratorManager.Destroy()
+	}
+	if m.perfManager != nil {
+		m.perfManager.Destroy()
+	}
+	if m.resctrlManager != nil {
+		m.resctrlManager.Destroy()
+	}
 }
 
-func (m *manager) updateMachineInfo(quit chan error) {
-	ticker := time.NewTicker(*updateMachineInfoInterval)
+// updateImageStorage inspects the image storage of the container runtimes,
+// which may take a while on nodes with many images, in the background.
+func (m *manager) updateImageStorage(quit chan error) {
+	ticker := time.NewTicker(*imageStorageInterval)
 	for {
+		storage, err := container.ImageStorage()
+		if err != nil {
+			klog.Warningf("Could not get image storage: %v", err)
+		}
+		m.imageStorageMu.Lock()
+		m.imageStorage = storage
+		m.imageStorageMu.Unlock()
 		select {
 		case <-ticker.C:
-			info, err := machine.Info(m.sysFs, m.fsInfo, m.inHostNamespace)
-			if err != nil {
-				klog.Errorf("Could not get machine info: %v", err)
-				break
-			}
-			m.machineMu.Lock()
-			m.machineInfo = *info
-			m.machineMu.Unlock()
-			klog.V(5).Infof("Update machine info: %+v", *info)
 		case <-quit:
 			ticker.Stop()
 			quit <- nil
@@ -445,6 +660,7 @@ func (m *manager) globalHousekeeping(quit chan error) {
 			if err != nil {
 				klog.Errorf("Failed to detect containers: %s", err)
 			}
+			m.globalHousekeepingTime.Store(time.Now().UnixNano())
 
 			// Log if housekeeping took too long.
 			duration := time.Since(start)
@@ -856,6 +1072,16 @@ func (m *manager) GetMachineInfo() (*info.MachineInfo, error) {
 	return m.machineInfo.Clone(), nil
 }
 
+func (m *manager) GetImageStorage() ([]info.ImageStorage, error) {
+	m.imageStorageMu.RLock()
+	defer m.imageStorageMu.RUnlock()
+	return m.imageStorage, nil
+}
+
+func (m *manager) GetUncoreTraffic() (*info.UncoreTraffic, error) {
+	return m.perfManager.GetUncoreTraffic()
+}
+
 func (m *manager) GetVersionInfo() (*info.VersionInfo, error) {
 	// TODO: Consider caching this and periodically updating.  The VersionInfo may change if
 	// the docker daemon is started after the cAdvisor client is created.  Caching the value
@@ -864,6 +1090,18 @@ func (m *manager) GetVersionInfo() (*info.VersionInfo, error) {
 	return getVersionInfo()
 }
 
+// dropUntrackedStats removes the cached stats of containers that are not
+// tracked, e.g. stats restored from a checkpoint for containers that went
+// away while cAdvisor was down.
+func (m *manager) dropUntrackedStats() {
+	for _, name := range m.memoryCache.ContainerNames() {
+		if !m.Exists(name) {
+			klog.V(3).Infof("Dropping cached stats of untracked container %q", name)
+			m.memoryCache.RemoveContainer(name)
+		}
+	}
+}
+
 func (m *manager) Exists(containerName string) bool {
 	_, ok := m.containers.Load(namespacedContainerName{Name: containerName})
 	return ok
@@ -899,27 +1137,52 @@ func (m *manager) registerCollectors(collectorConfigs map[string]string, cont *c
 			return fmt.Errorf("failed to read config file %q for config %q, container %q: %v", k, v, cont.info.Name, err)
 		}
 		klog.V(4).Infof("Got config from %q: %q", v, configFile)
+		if err := m.registerCollector(k, configFile, cont); err != nil {
+			return err
+		}
+	}
+	return nil
+}
 
-		if strings.HasPrefix(k, "prometheus") || strings.HasPrefix(k, "Prometheus") {
-			newCollector, err := collector.NewPrometheusCollector(k, configFile, *applicationMetricsCountLimit, cont.handler, m.collectorHTTPClient)
-			if err != nil {
-				return fmt.Errorf("failed to create collector for container %q, config %q: %v", cont.info.Name, k, err)
-			}
-			err = cont.collectorManager.RegisterCollector(newCollector)
-			if err != nil {
-				return fmt.Errorf("failed to register collector for container %q, config %q: %v", cont.info.Name, k, err)
-			}
-		} else {
-			newCollector, err := collector.NewCollector(k, configFile, *applicationMetricsCountLimit, cont.handler, m.collectorHTTPClient)
-			if err != nil {
-				return fmt.Errorf("failed to create collector for container %q, config %q: %v", cont.info.Name, k, err)
-			}
-			err = cont.collectorManager.RegisterCollector(newCollector)
-			if err != nil {
-				return fmt.Errorf("failed to register collector for container %q, config %q: %v", cont.info.Name, k, err)
-			}
+// registerLabelCollectors registers the collectors declared by the labels of
+// a container, without a configuration file.
+func (m *manager) registerLabelCollectors(labels map[string]string, cont *containerData) error {
+	collectorConfigs, err := collector.GetLabelCollectorConfigs(labels, cont.handler.GetContainerIPAddress())
+	for k, configFile := range collectorConfigs {
+		klog.V(4).Infof("Got config from the labels of %q for %q: %q", cont.info.Name, k, configFile)
+		if err := m.registerCollector(k, configFile, cont); err != nil {
+			return err
 		}
 	}
+	if err != nil {
+		return fmt.Errorf("failed to read the collector labels of container %q: %v", cont.info.Name, err)
+	}
+	return nil
+}
+
+// registerCollector registers a collector of a container, whose type is
+// given by the prefix of its name.
+func (m *manager) registerCollector(k string, configFile []byte, cont *containerData) error {
+	readFile := func(path string) ([]byte, error) {
+		return cont.ReadFile(path, m.inHostNamespace)
+	}
+	var newCollector collector.Collector
+	var err error
+	switch {
+	case strings.HasPrefix(k, "prometheus") || strings.HasPrefix(k, "Prometheus"):
+		newCollector, err = collector.NewPrometheusCollector(k, configFile, *applicationMetricsCountLimit, cont.handler, m.collectorHTTPClient, readFile)
+	case strings.HasPrefix(k, "jolokia") || strings.HasPrefix(k, "Jolokia"):
+		newCollector, err = collector.NewJolokiaCollector(k, configFile, *applicationMetricsCountLimit, cont.handler, m.collectorHTTPClient, readFile)
+	default:
+		newCollector, err = collector.NewCollector(k, configFile, *applicationMetricsCountLimit, cont.handler, m.collectorHTTPClient, readFile)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to create collector for container %q, config %q: %v", cont.info.Name, k, err)
+	}
+	err = cont.collectorManager.RegisterCollector(newCollector)
+	if err != nil {
+		return fmt.Errorf("failed to register collector for container %q, config %q: %v", cont.info.Name, k, err)
+	}
 	return nil
 }
 
@@ -933,6 +1196,33 @@ func (m *manager) createContainer(containerName string, watchSource watcher.Cont
 	if _, ok := m.containers.Load(namespacedName); ok {
 		return nil
 	}
+	return m.addContainer(containerName, watchSource, false)
+}
+
+// restartContainer replaces cont, whose housekeeping is blocked, with a new
+// containerData and handler collecting the stats of the same container. The
+// stats collected so far are kept.
+func (m *manager) restartContainer(cont *containerData) error {
+	if m.housekeepingScheduler != nil && m.housekeepingScheduler.running(cont) {
+		m.housekeepingScheduler.replaceWorker()
+	}
+	cont.halt()
+	m.containers.Delete(namespacedContainerName{Name: cont.info.Name})
+	for _, alias := range cont.info.Aliases {
+		m.containers.Delete(namespacedContainerName{
+			Namespace: cont.info.Namespace,
+			Name:      alias,
+		})
+	}
+	return m.addContainer(cont.info.Name, cont.watchSource, true)
+}
+
+// addContainer creates and starts the housekeeping of a container. Unless
+// restarted, a creation event is recorded.
+func (m *manager) addContainer(containerName string, watchSource watcher.ContainerWatchSource, restarted bool) error {
+	namespacedName := namespacedContainerName{
+		Name: containerName,
+	}
 
 	handler, accept, err := container.NewContainerHandler(containerName, watchSource, m.containerEnvMetadataWhiteList, m.inHostNamespace)
 	if err != nil {
@@ -949,17 +1239,33 @@ func (m *manager) createContainer(containerName string, watchSource watcher.Cont
 	}
 
 	logUsage := *logCadvisorUsage && containerName == m.cadvisorContainer
-	cont, err := newContainerData(containerName, m.memoryCache, handler, logUsage, collectorManager, m.maxHousekeepingInterval, m.allowDynamicHousekeeping, clock.RealClock{})
+	cont, err := newContainerData(containerName, m.memoryCache, handler, logUsage, collectorManager, m.intervals, m.allowDynamicHousekeeping, clock.RealClock{})
 	if err != nil {
 		return err
 	}
+	cont.eventHandler = m.eventHandler
+	cont.watchSource = watchSource
+	cont.oomTracker = m.oomTracker
+	cont.thresholds = newThresholdTracker(*cpuThrottlingEventThreshold, *memoryPressureEventThreshold, *inodeUsageEventThreshold, *thresholdEventWindow)
+	cont.scheduler = m.housekeepingScheduler
+	cont.guardrails = m.guardrails
+	if m.kubeletEnricher != nil {
+		cont.podLabels = m.kubeletEnricher.Labels
+		cont.lock.Lock()
+		cont.addPodLabels()
+		cont.lock.Unlock()
+	}
+	if m.housekeepingQoSIntervals != nil {
+		base, maxInterval := m.intervals.get()
+		cont.setAdaptiveBounds(adaptiveHousekeepingBounds(containerName, cont.info.Spec.Labels, m.housekeepingQoSIntervals, base, maxInterval))
+	}
 
 	if m.includedMetrics.Has(container.PerfMetrics) {
 		perfCgroupPath, err := handler.GetCgroupPath("perf_event")
 		if err != nil {
 			klog.Warningf("Error getting perf_event cgroup path: %q", err)
 		} else {
-			cont.perfCollector, err = m.perfManager.GetCollector(perfCgroupPath)
+			cont.perfCollector, err = m.perfManager.GetContainerCollector(perfCgroupPath, handler.GetContainerLabels())
 			if err != nil {
 				klog.Errorf("Perf event metrics will not be available for container %q: %v", containerName, err)
 			}
@@ -978,6 +1284,22 @@ func (m *manager) createContainer(containerName string, watchSource watcher.Cont
 		}
 	}
 
+	if m.includedMetrics.Has(container.AcceleratorUsageMetrics) {
+		// Without a devices cgroup, the GPUs are found from the labels only.
+		devicesCgroupPath, err := handler.GetCgroupPath("devices")
+		if err != nil {
+			klog.V(4).Infof("Error getting devices cgroup path: %v", err)
+		}
+		cont.acceleratorCollector, err = m.acceleratorManager.GetContainerCollector(&accelerators.Container{
+			Name:          containerName,
+			DevicesCgroup: devicesCgroupPath,
+			Labels:        handler.GetContainerLabels(),
+		})
+		if err != nil {
+			klog.V(4).Infof("accelerator metrics will not be available for container %s: %s", cont.info.Name, err)
+		}
+	}
+
 	// Add collectors
 	labels := handler.GetContainerLabels()
 	collectorConfigs := collector.GetCollectorConfigs(labels)
@@ -985,6 +1307,14 @@ func (m *manager) createContainer(containerName string, watchSource watcher.Cont
 	if err != nil {
 		klog.Warningf("Failed to register collectors for %q: %v", containerName, err)
 	}
+	if err := m.registerLabelCollectors(labels, cont); err != nil {
+		klog.Warningf("Failed to register label collectors for %q: %v", containerName, err)
+	}
+	if m.statsdListener != nil {
+		if err := cont.collectorManager.RegisterCollector(m.statsdListener.Collector(containerName)); err != nil {
+			klog.Warningf("Failed to register the StatsD collector for %q: %v", containerName, err)
+		}
+	}
 
 	// Add the container name and all its aliases. The aliases must be within the namespace of the factory.
 	m.containers.Store(namespacedName, cont)
@@ -996,6 +1326,9 @@ func (m *manager) createContainer(containerName string, watchSource watcher.Cont
 	}
 
 	klog.V(3).Infof("Added container: %q (aliases: %v, namespace: %q)", containerName, cont.info.Aliases, cont.info.Namespace)
+	if restarted {
+		return cont.Start()
+	}
 
 	contSpec, err := cont.handler.GetSpec()
 	if err != nil {
@@ -1007,10 +1340,16 @@ func (m *manager) createContainer(containerName string, watchSource watcher.Cont
 		return err
 	}
 
+	eventLabels := contSpec.Labels
+	if cont.podLabels != nil {
+		eventLabels = mergeLabels(eventLabels, cont.podLabels(containerName))
+	}
 	newEvent := &info.Event{
 		ContainerName: contRef.Name,
 		Timestamp:     contSpec.CreationTime,
 		EventType:     info.EventContainerCreation,
+		// The labels of the spec just read, rather than of the cached one.
+		ContainerLabels: eventLabels,
 	}
 	err = m.eventHandler.AddEvent(newEvent)
 	if err != nil {
@@ -1030,6 +1369,12 @@ func (m *manager) destroyContainer(containerName string) error {
 		return nil
 	}
 
+	if m.oomTracker != nil {
+		// Account for the OOM kills that may have ended the container.
+		cont.checkMemoryEvents()
+		m.oomTracker.flush(containerName)
+	}
+
 	exitCode, err := cont.handler.GetExitCode()
 	if err != nil {
 		klog.V(4).Infof("Could not retrieve exit code for container %q: %v (using -1)", containerName, err)
@@ -1041,13 +1386,18 @@ func (m *manager) destroyContainer(containerName string) error {
 		return err
 	}
 
-	// Remove the container from our records (and all its aliases).
+	if m.statsdListener != nil {
+		m.statsdListener.Forget(containerName)
+	}
+
+	// Remove the container from our records (and all its aliases, unless a
+	// newer container took them over, e.g. a restarted Kubernetes container).
 	m.containers.Delete(namespacedName)
 	for _, alias := range cont.info.Aliases {
-		m.containers.Delete(namespacedContainerName{
+		m.containers.DeleteIf(namespacedContainerName{
 			Namespace: cont.info.Namespace,
 			Name:      alias,
-		})
+		}, cont)
 	}
 	klog.V(3).Infof("Destroyed container: %q (aliases: %v, namespace: %q, exit_code: %d)", containerName, cont.info.Aliases, cont.info.Namespace, exitCode)
 
@@ -1056,10 +1406,14 @@ func (m *manager) destroyContainer(containerName string) error {
 		return err
 	}
 
+	cont.lock.Lock()
+	labels := cont.info.Spec.Labels
+	cont.lock.Unlock()
 	newEvent := &info.Event{
-		ContainerName: contRef.Name,
-		Timestamp:     time.Now(),
-		EventType:     info.EventContainerDeletion,
+		ContainerName:   contRef.Name,
+		Timestamp:       time.Now(),
+		EventType:       info.EventContainerDeletion,
+		ContainerLabels: labels,
 		EventData: info.EventData{
 			ContainerDeletion: &info.ContainerDeletionEventData{
 				ExitCode: exitCode,
@@ -1207,65 +1561,25 @@ func (m *manager) watchForNewContainers(quit chan error) error {
 	return nil
 }
 
-func (m *manager) watchForNewOoms() error {
-	klog.V(2).Infof("Started watching for new ooms in manager")
-	outStream := make(chan *oomparser.OomInstance, 10)
-	oomLog, err := oomparser.New()
+// countOomEvents counts OOM events of a container for later collection by
+// prometheus.
+func (m *manager) countOomEvents(containerName string, n uint64) {
+	request := v2.RequestOptions{
+		IdType: v2.TypeName,
+		Count:  1,
+	}
+	conts, err := m.getRequestedContainers(containerName, request)
 	if err != nil {
-		return err
+		klog.V(2).Infof("failed getting container info for %q: %v", containerName, err)
+		return
+	}
+	if len(conts) != 1 {
+		klog.V(2).Info("Expected the request to match only one container")
+		return
+	}
+	for _, cont := range conts {
+		atomic.AddUint64(&cont.oomEvents, n)
 	}
-	go oomLog.StreamOoms(outStream)
-
-	go func() {
-		for oomInstance := range outStream {
-			// Surface OOM and OOM kill events.
-			newEvent := &info.Event{
-				ContainerName: oomInstance.ContainerName,
-				Timestamp:     oomInstance.TimeOfDeath,
-				EventType:     info.EventOom,
-			}
-			err := m.eventHandler.AddEvent(newEvent)
-			if err != nil {
-				klog.Errorf("failed to add OOM event for %q: %v", oomInstance.ContainerName, err)
-			}
-			klog.V(3).Infof("Created an OOM event in container %q at %v", oomInstance.ContainerName, oomInstance.TimeOfDeath)
-
-			newEvent = &info.Event{
-				ContainerName: oomInstance.VictimContainerName,
-				Timestamp:     oomInstance.TimeOfDeath,
-				EventType:     info.EventOomKill,
-				EventData: info.EventData{
-					OomKill: &info.OomKillEventData{
-						Pid:         oomInstance.Pid,
-						ProcessName: oomInstance.ProcessName,
-					},
-				},
-			}
-			err = m.eventHandler.AddEvent(newEvent)
-			if err != nil {
-				klog.Errorf("failed to add OOM kill event for %q: %v", oomInstance.ContainerName, err)
-			}
-
-			// Count OOM events for later collection by prometheus
-			request := v2.RequestOptions{
-				IdType: v2.TypeName,
-				Count:  1,
-			}
-			conts, err := m.getRequestedContainers(oomInstance.ContainerName, request)
-			if err != nil {
-				klog.V(2).Infof("failed getting container info for %q: %v", oomInstance.ContainerName, err)
-				continue
-			}
-			if len(conts) != 1 {
-				klog.V(2).Info("Expected the request to match only one container")
-				continue
-			}
-			for _, cont := range conts {
-				atomic.AddUint64(&cont.oomEvents, 1)
-			}
-		}
-	}()
-	return nil
 }
 
 // can be called by the api which will take events returned on the channel
@@ -1273,6 +1587,15 @@ func (m *manager) WatchForEvents(request *events.Request) (*events.EventChannel,
 	return m.eventHandler.WatchEvents(request)
 }
 
+func (m *manager) WatchStats(containerName string, subcontainers bool) (<-chan *info.ContainerStatsUpdate, func(), error) {
+	cont, err := m.getContainerData(containerName)
+	if err != nil {
+		return nil, nil, err
+	}
+	updates, stop := m.memoryCache.WatchStats(cont.info.Name, subcontainers)
+	return updates, stop, nil
+}
+
 // can be called by the api which will return all events satisfying the request
 func (m *manager) GetPastEvents(request *events.Request) ([]*info.Event, error) {
 	return m.eventHandler.GetEvents(request)
@@ -1283,6 +1606,27 @@ func (m *manager) CloseEventChannel(watchID int) {
 	m.eventHandler.StopWatch(watchID)
 }
 
+// newEventHandler returns the event manager, persisting the events to the
+// event_store_file if one is set.
+func newEventHandler() (events.EventManager, events.Store) {
+	policy := parseEventsStoragePolicy()
+	if *eventStoreFile == "" {
+		return events.NewEventManager(policy), nil
+	}
+	store, err := events.OpenFileStore(*eventStoreFile, *eventStoreAgeLimit, *eventStoreEventLimit)
+	if err != nil {
+		klog.Warningf("Failed to open the event store, keeping events in memory only: %v", err)
+		return events.NewEventManager(policy), nil
+	}
+	eventHandler, err := events.NewEventManagerWithStore(policy, store)
+	if err != nil {
+		klog.Warningf("Failed to replay the stored events, keeping events in memory only: %v", err)
+		store.Close()
+		return events.NewEventManager(policy), nil
+	}
+	return eventHandler, store
+}
+
 // Parses the events StoragePolicy from the flags.
 func parseEventsStoragePolicy() events.StoragePolicy {
 	policy := events.DefaultStoragePolicy()
@@ -1330,6 +1674,51 @@ func parseEventsStoragePolicy() events.StoragePolicy {
 	return policy
 }
 
+// Parses the events RateLimitPolicy from the flags.
+func parseEventsRateLimitPolicy() events.RateLimitPolicy {
+	policy := events.DefaultRateLimitPolicy()
+
+	// Parse windows.
+	for _, part := range strings.Split(*eventRateLimitWindow, ",") {
+		key, value, ok := strings.Cut(part, "=")
+		if !ok {
+			klog.Warningf("Unknown event rate limit policy %q when parsing window", part)
+			continue
+		}
+		dur, err := time.ParseDuration(value)
+		if err != nil {
+			klog.Warningf("Unable to parse event rate limit window %q: %v", value, err)
+			continue
+		}
+		if key == "default" {
+			policy.DefaultWindow = dur
+			continue
+		}
+		policy.PerTypeWindow[info.EventType(key)] = dur
+	}
+
+	// Parse bursts.
+	for _, part := range strings.Split(*eventRateLimitBurst, ",") {
+		key, value, ok := strings.Cut(part, "=")
+		if !ok {
+			klog.Warningf("Unknown event rate limit policy %q when parsing burst", part)
+			continue
+		}
+		val, err := strconv.Atoi(value)
+		if err != nil || val < 1 {
+			klog.Warningf("Unable to parse event rate limit burst %q, it must be a positive integer", value)
+			continue
+		}
+		if key == "default" {
+			policy.DefaultBurst = val
+			continue
+		}
+		policy.PerTypeBurst[info.EventType(key)] = val
+	}
+
+	return policy
+}
+
 func (m *manager) DebugInfo() map[string][]string {
 	debugInfo := container.DebugInfo()
 
@@ -1411,6 +1800,10 @@ func getVersionInfo() (*info.VersionInfo, error) {
 		ContainerOsVersion: osVersion,
 		CadvisorVersion:    version.Info["version"],
 		CadvisorRevision:   version.Info["revision"],
+		KernelCmdline:      machine.KernelCmdline(),
+		SecurityModules:    machine.SecurityModules(),
+		SELinuxMode:        machine.SELinuxMode(),
+		Sysctls:            machine.Sysctls(),
 	}, nil
 }
 
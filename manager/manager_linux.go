@@ -0,0 +1,128 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package manager
+
+import (
+	"github.com/google/cadvisor/container/common"
+	"github.com/google/cadvisor/container/raw"
+	info "github.com/google/cadvisor/info/v1"
+	"github.com/google/cadvisor/utils/oomparser"
+
+	"github.com/opencontainers/cgroups"
+
+	"k8s.io/klog/v2"
+	"k8s.io/utils/clock"
+)
+
+// getSelfContainer returns the cgroup cAdvisor runs in.
+func getSelfContainer() (string, error) {
+	// Fail before probing the cgroup v1 hierarchy if it is disabled.
+	if err := common.CheckCgroupMode(); err != nil {
+		return "", err
+	}
+	// Avoid using GetOwnCgroupPath on cgroup v2 as it is not supported by libcontainer
+	if common.IsCgroup2UnifiedMode() {
+		return "/", nil
+	}
+	selfContainer, err := cgroups.GetOwnCgroup("cpu")
+	if err != nil {
+		return "", err
+	}
+	klog.V(2).Infof("cAdvisor running in container: %q", selfContainer)
+	return selfContainer, nil
+}
+
+// trackOoms sets up the accounting of OOMs from the memory.events counters
+// of the containers.
+func (m *manager) trackOoms() {
+	if *oomEventsFromCgroups && common.IsCgroup2UnifiedMode() {
+		// Give the kernel log records twice the longest housekeeping interval
+		// to be accounted for.
+		_, maxInterval := m.intervals.get()
+		m.oomTracker = newOomTracker(m.eventHandler, clock.RealClock{}, m.startupTime, 2*maxInterval, m.countOomEvents, m.addKernelOomEvents)
+	}
+}
+
+// registerRawContainers registers the factory and the watcher of the raw
+// cgroups, which include the root container.
+func (m *manager) registerRawContainers() error {
+	err := raw.Register(m, m.fsInfo, m.includedMetrics, m.rawContainerCgroupPathPrefixWhiteList)
+	if err != nil {
+		klog.Errorf("Registration of the raw container factory failed: %v", err)
+	}
+
+	rawWatcher, err := raw.NewRawContainerWatcher(m.includedMetrics)
+	if err != nil {
+		return err
+	}
+	m.containerWatchers = append(m.containerWatchers, rawWatcher)
+	return nil
+}
+
+func (m *manager) watchForNewOoms() error {
+	klog.V(2).Infof("Started watching for new ooms in manager")
+	outStream := make(chan *oomparser.OomInstance, 10)
+	oomLog, err := oomparser.New()
+	if err != nil {
+		return err
+	}
+	go oomLog.StreamOoms(outStream)
+
+	if m.oomTracker != nil {
+		go m.oomTracker.run(outStream)
+		return nil
+	}
+	go func() {
+		for oomInstance := range outStream {
+			m.addKernelOomEvents(oomInstance)
+		}
+	}()
+	return nil
+}
+
+// addKernelOomEvents records the OOM and OOM kill events of an OOM found in
+// the kernel log.
+func (m *manager) addKernelOomEvents(oomInstance *oomparser.OomInstance) {
+	// Surface OOM and OOM kill events.
+	newEvent := &info.Event{
+		ContainerName: oomInstance.ContainerName,
+		Timestamp:     oomInstance.TimeOfDeath,
+		EventType:     info.EventOom,
+	}
+	err := m.eventHandler.AddEvent(newEvent)
+	if err != nil {
+		klog.Errorf("failed to add OOM event for %q: %v", oomInstance.ContainerName, err)
+	}
+	klog.V(3).Infof("Created an OOM event in container %q at %v", oomInstance.ContainerName, oomInstance.TimeOfDeath)
+
+	newEvent = &info.Event{
+		ContainerName: oomInstance.VictimContainerName,
+		Timestamp:     oomInstance.TimeOfDeath,
+		EventType:     info.EventOomKill,
+		EventData: info.EventData{
+			OomKill: &info.OomKillEventData{
+				Pid:         oomInstance.Pid,
+				ProcessName: oomInstance.ProcessName,
+			},
+		},
+	}
+	err = m.eventHandler.AddEvent(newEvent)
+	if err != nil {
+		klog.Errorf("failed to add OOM kill event for %q: %v", oomInstance.ContainerName, err)
+	}
+	m.countOomEvents(oomInstance.ContainerName, 1)
+}
@@ -34,6 +34,7 @@ import (
 	info "github.com/google/cadvisor/info/v1"
 	itest "github.com/google/cadvisor/info/v1/test"
 	v2 "github.com/google/cadvisor/info/v2"
+	"github.com/google/cadvisor/perf"
 	"github.com/google/cadvisor/stats"
 	"github.com/google/cadvisor/utils/sysfs/fakesysfs"
 
@@ -69,7 +70,7 @@ func createManagerAndAddContainers(
 			spec,
 			nil,
 		).Once()
-		cont, err := newContainerData(name, memoryCache, mockHandler, false, &collector.GenericCollectorManager{}, 60*time.Second, true, clock.NewFakeClock(time.Now()))
+		cont, err := newContainerData(name, memoryCache, mockHandler, false, &collector.GenericCollectorManager{}, newHousekeepingIntervals(*HousekeepingInterval, 60*time.Second), true, clock.NewFakeClock(time.Now()))
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -131,7 +132,7 @@ func createManagerAndAddSubContainers(
 			subcontainerList[idx],
 			nil,
 		)
-		cont, err := newContainerData(name, memoryCache, mockHandler, false, &collector.GenericCollectorManager{}, 60*time.Second, true, clock.NewFakeClock(time.Now()))
+		cont, err := newContainerData(name, memoryCache, mockHandler, false, &collector.GenericCollectorManager{}, newHousekeepingIntervals(*HousekeepingInterval, 60*time.Second), true, clock.NewFakeClock(time.Now()))
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -549,10 +550,11 @@ func TestDestroyContainerWithExitCode(t *testing.T) {
 	}
 
 	cont := &containerData{
-		handler:          mockHandler,
-		memoryCache:      memoryCache,
-		perfCollector:    &stats.NoopCollector{},
-		resctrlCollector: &stats.NoopCollector{},
+		handler:              mockHandler,
+		memoryCache:          memoryCache,
+		perfCollector:        &stats.NoopCollector{},
+		resctrlCollector:     &stats.NoopCollector{},
+		acceleratorCollector: &stats.NoopCollector{},
 		info: containerInfo{
 			ContainerReference: info.ContainerReference{
 				Name: "/test",
@@ -594,10 +596,11 @@ func TestDestroyContainerExitCodeUnavailable(t *testing.T) {
 	}
 
 	cont := &containerData{
-		handler:          mockHandler,
-		memoryCache:      memoryCache,
-		perfCollector:    &stats.NoopCollector{},
-		resctrlCollector: &stats.NoopCollector{},
+		handler:              mockHandler,
+		memoryCache:          memoryCache,
+		perfCollector:        &stats.NoopCollector{},
+		resctrlCollector:     &stats.NoopCollector{},
+		acceleratorCollector: &stats.NoopCollector{},
 		info: containerInfo{
 			ContainerReference: info.ContainerReference{
 				Name: "/test",
@@ -686,10 +689,11 @@ func TestContainerDataStopConcurrent(t *testing.T) {
 				Name: "/test-concurrent",
 			},
 		},
-		memoryCache:      memoryCache,
-		stop:             make(chan struct{}),
-		perfCollector:    &stats.NoopCollector{},
-		resctrlCollector: &stats.NoopCollector{},
+		memoryCache:          memoryCache,
+		stop:                 make(chan struct{}),
+		perfCollector:        &stats.NoopCollector{},
+		resctrlCollector:     &stats.NoopCollector{},
+		acceleratorCollector: &stats.NoopCollector{},
 	}
 
 	// Launch multiple goroutines that all try to call Stop() simultaneously
@@ -741,10 +745,11 @@ func TestDestroyContainerConcurrent(t *testing.T) {
 				Name: "/test-concurrent",
 			},
 		},
-		memoryCache:      memoryCache,
-		stop:             make(chan struct{}),
-		perfCollector:    &stats.NoopCollector{},
-		resctrlCollector: &stats.NoopCollector{},
+		memoryCache:          memoryCache,
+		stop:                 make(chan struct{}),
+		perfCollector:        &stats.NoopCollector{},
+		resctrlCollector:     &stats.NoopCollector{},
+		acceleratorCollector: &stats.NoopCollector{},
 	}
 
 	// Add to manager's container map
@@ -777,3 +782,123 @@ func TestDestroyContainerConcurrent(t *testing.T) {
 	// At least one event should be recorded.
 	assert.GreaterOrEqual(t, len(mockEventHandler.events), 1, "at least one destruction event should be recorded")
 }
+
+func TestSetHousekeepingIntervals(t *testing.T) {
+	m := &manager{intervals: newHousekeepingIntervals(time.Second, time.Minute)}
+	assert.Error(t, m.SetHousekeepingIntervals(0, time.Minute))
+	assert.Error(t, m.SetHousekeepingIntervals(2*time.Minute, time.Minute))
+	assert.NoError(t, m.SetHousekeepingIntervals(5*time.Second, 2*time.Minute))
+
+	base, maxInterval := m.intervals.get()
+	assert.Equal(t, 5*time.Second, base)
+	assert.Equal(t, 2*time.Minute, maxInterval)
+
+	// Containers with a fixed interval pick up the new interval.
+	cd := &containerData{intervals: m.intervals, housekeepingInterval: time.Second}
+	next := cd.nextHousekeepingInterval()
+	assert.True(t, next >= 5*time.Second && next <= 10*time.Second, "unexpected interval %v", next)
+}
+
+func TestApplyContainerFilter(t *testing.T) {
+	defer container.SetFilter(nil)
+	memoryCache := memory.New(60*time.Second, nil)
+	m := createManagerAndAddContainers(memoryCache, nil, []string{"/", "/a", "/b"}, func(h *containertest.MockContainerHandler) {
+		h.On("GetSpec").Return(info.ContainerSpec{}, nil)
+		h.On("GetExitCode").Return(0, nil)
+		h.On("ListContainers", container.ListRecursive).Return([]info.ContainerReference{{Name: "/a"}, {Name: "/b"}}, nil)
+	}, t)
+	m.eventHandler = &mockEventHandler{}
+
+	filter, err := container.NewFilter("", "^/b$", "", "", "", "")
+	assert.NoError(t, err)
+	container.SetFilter(filter)
+	assert.NoError(t, m.ApplyContainerFilter())
+
+	assert.True(t, m.Exists("/a"))
+	assert.False(t, m.Exists("/b"))
+}
+
+// fakePerfManager hands out collectors recording the labels of their
+// container.
+type fakePerfManager struct {
+	perf.Manager
+	changed bool
+}
+
+type fakePerfCollector struct {
+	stats.NoopCollector
+	labels    map[string]string
+	destroyed bool
+}
+
+func (c *fakePerfCollector) Destroy() {
+	c.destroyed = true
+}
+
+func (m *fakePerfManager) GetContainerCollector(cgroupPath string, labels map[string]string) (stats.Collector, error) {
+	return &fakePerfCollector{labels: labels}, nil
+}
+
+func (m *fakePerfManager) Reload() (bool, error) {
+	return m.changed, nil
+}
+
+func TestReloadPerfEvents(t *testing.T) {
+	handler := containertest.NewMockContainerHandler("/a")
+	handler.On("GetCgroupPath", "perf_event").Return("/sys/fs/cgroup/a", nil)
+	handler.On("GetContainerLabels").Return(map[string]string{"tier": "database"})
+	previous := &fakePerfCollector{}
+	cont := &containerData{
+		handler:       handler,
+		perfCollector: previous,
+		info:          containerInfo{ContainerReference: info.ContainerReference{Name: "/a", Aliases: []string{"a"}}},
+	}
+	perfManager := &fakePerfManager{}
+	m := &manager{
+		perfManager:     perfManager,
+		includedMetrics: container.MetricSet{container.PerfMetrics: struct{}{}},
+	}
+	m.containers.Store(namespacedContainerName{Name: "/a"}, cont)
+	m.containers.Store(namespacedContainerName{Namespace: "docker", Name: "a"}, cont)
+
+	// Unchanged events keep the collectors.
+	assert.NoError(t, m.ReloadPerfEvents())
+	assert.Equal(t, previous, cont.perfCollector)
+
+	perfManager.changed = true
+	assert.NoError(t, m.ReloadPerfEvents())
+	assert.True(t, previous.destroyed)
+	collector, ok := cont.perfCollector.(*fakePerfCollector)
+	assert.True(t, ok)
+	assert.Equal(t, map[string]string{"tier": "database"}, collector.labels)
+	// The alias does not get a collector of its own.
+	handler.AssertNumberOfCalls(t, "GetCgroupPath", 1)
+}
+
+func TestDropUntrackedStats(t *testing.T) {
+	memoryCache := memory.New(time.Minute, nil)
+	m := createManagerAndAddContainers(memoryCache, &fakesysfs.FakeSysFs{}, []string{"/c1"}, func(*containertest.MockContainerHandler) {}, t)
+	for _, name := range []string{"/c1", "/gone"} {
+		cInfo := info.ContainerInfo{ContainerReference: info.ContainerReference{Name: name}}
+		assert.NoError(t, memoryCache.AddStats(&cInfo, &info.ContainerStats{Timestamp: time.Now()}))
+	}
+
+	m.dropUntrackedStats()
+	assert.Equal(t, []string{"/c1"}, memoryCache.ContainerNames())
+}
+
+func TestWatchStats(t *testing.T) {
+	memoryCache := memory.New(time.Minute, nil)
+	m := createManagerAndAddContainers(memoryCache, &fakesysfs.FakeSysFs{}, []string{"/c1", "/c1/c2"}, func(*containertest.MockContainerHandler) {}, t)
+
+	_, _, err := m.WatchStats("/gone", false)
+	assert.Error(t, err)
+
+	updates, stop, err := m.WatchStats("/c1", true)
+	assert.NoError(t, err)
+	defer stop()
+	stats := &info.ContainerStats{Timestamp: time.Now()}
+	cInfo := info.ContainerInfo{ContainerReference: info.ContainerReference{Name: "/c1/c2"}}
+	assert.NoError(t, memoryCache.AddStats(&cInfo, stats))
+	assert.Equal(t, &info.ContainerStatsUpdate{Name: "/c1/c2", Stats: stats}, <-updates)
+}
@@ -0,0 +1,58 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build windows || freebsd
+
+package manager
+
+import (
+	"fmt"
+	"runtime"
+)
+
+// getSelfContainer returns the root container: without cgroups, the
+// container cAdvisor runs in is not known.
+func getSelfContainer() (string, error) {
+	return "/", nil
+}
+
+// trackOoms does nothing: there are no memory.events counters without
+// cgroups.
+func (m *manager) trackOoms() {}
+
+// registerRawContainers does nothing: the root container is provided by the
+// factory of the container plugin of the OS, hcs or jail.
+func (m *manager) registerRawContainers() error {
+	return nil
+}
+
+func (m *manager) watchForNewOoms() error {
+	return fmt.Errorf("OOM events are not supported on %s", runtime.GOOS)
+}
+
+func (m *manager) watchForProcessEvents() error {
+	return fmt.Errorf("process events are not supported on %s", runtime.GOOS)
+}
+
+// containerOfPid returns no container: processes are only attributed to
+// their container from their cgroup.
+func (m *manager) containerOfPid(pid int) (string, bool) {
+	return "", false
+}
+
+// watchForHotplug returns no notifications: machine info is only updated
+// every update_machine_info_interval.
+func watchForHotplug() (<-chan struct{}, func()) {
+	return nil, func() {}
+}
@@ -0,0 +1,264 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package manager
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+
+	"k8s.io/klog/v2"
+	"k8s.io/utils/clock"
+
+	"github.com/google/cadvisor/events"
+	info "github.com/google/cadvisor/info/v1"
+	"github.com/google/cadvisor/utils/oomparser"
+)
+
+var oomEventsFromCgroups = flag.Bool("oom_events_from_cgroups", true, "On cgroup v2, detect OOMs and OOM kills from the memory.events counters of each container, which attributes them to the right container whatever the kernel log format. The kernel log only provides the killed process")
+
+// memoryEvents are the OOM counters of the memory.events file of a cgroup.
+type memoryEvents struct {
+	// Times the memory limit of the cgroup was hit and an allocation was
+	// about to fail.
+	oom uint64
+	// Processes of the cgroup killed by the OOM killer.
+	oomKill uint64
+}
+
+// readMemoryEvents reads the OOM counters of the cgroup at dir, preferring
+// memory.events.local, which only counts the events of the cgroup itself,
+// over the hierarchical memory.events of kernels before 5.2.
+func readMemoryEvents(dir string) (memoryEvents, error) {
+	contents, err := os.ReadFile(filepath.Join(dir, "memory.events.local"))
+	if os.IsNotExist(err) {
+		contents, err = os.ReadFile(filepath.Join(dir, "memory.events"))
+	}
+	if err != nil {
+		return memoryEvents{}, err
+	}
+	var ev memoryEvents
+	sc := bufio.NewScanner(bytes.NewReader(contents))
+	for sc.Scan() {
+		key, value, ok := bytes.Cut(sc.Bytes(), []byte(" "))
+		if !ok {
+			continue
+		}
+		var counter *uint64
+		switch string(key) {
+		case "oom":
+			counter = &ev.oom
+		case "oom_kill":
+			counter = &ev.oomKill
+		default:
+			continue
+		}
+		if *counter, err = strconv.ParseUint(string(value), 10, 64); err != nil {
+			return memoryEvents{}, err
+		}
+	}
+	return ev, sc.Err()
+}
+
+// oomTracker records the OOM events of containers from the changes of their
+// memory.events counters, seen on every housekeeping. The OOM kills found in
+// the kernel log are held until the counters of the container of the killed
+// process account for them, to tell which process was killed. Kernel log
+// records no container accounts for within the attribution window, e.g.
+// because the container went away first, are recorded as the kernel log
+// reports them.
+type oomTracker struct {
+	eventHandler events.EventManager
+	clock        clock.WithTicker
+	// Containers created before are not known to have had no OOMs before
+	// their first housekeeping.
+	startupTime time.Time
+	window      time.Duration
+	// count counts OOM kills in a container, for prometheus.
+	count func(containerName string, n uint64)
+	// fallback records a kernel log record no container accounted for.
+	fallback func(*oomparser.OomInstance)
+
+	lock sync.Mutex
+	// Kernel log records not accounted for yet, oldest first.
+	pending []pendingOom
+}
+
+type pendingOom struct {
+	instance *oomparser.OomInstance
+	received time.Time
+}
+
+func newOomTracker(eventHandler events.EventManager, clock clock.WithTicker, startupTime time.Time, window time.Duration, count func(string, uint64), fallback func(*oomparser.OomInstance)) *oomTracker {
+	return &oomTracker{
+		eventHandler: eventHandler,
+		clock:        clock,
+		startupTime:  startupTime,
+		window:       window,
+		count:        count,
+		fallback:     fallback,
+	}
+}
+
+// run holds the kernel log records of stream until they are accounted for.
+func (t *oomTracker) run(stream <-chan *oomparser.OomInstance) {
+	ticker := t.clock.NewTicker(max(t.window/4, time.Second))
+	defer ticker.Stop()
+	for {
+		select {
+		case instance, ok := <-stream:
+			if !ok {
+				return
+			}
+			t.addKernelRecord(instance)
+		case <-ticker.C():
+			t.expire()
+		}
+	}
+}
+
+func (t *oomTracker) addKernelRecord(instance *oomparser.OomInstance) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	t.pending = append(t.pending, pendingOom{instance: instance, received: t.clock.Now()})
+}
+
+// expire records the kernel log records older than the attribution window.
+func (t *oomTracker) expire() {
+	now := t.clock.Now()
+	t.lock.Lock()
+	var expired []*oomparser.OomInstance
+	kept := t.pending[:0]
+	for _, p := range t.pending {
+		if now.Sub(p.received) >= t.window {
+			expired = append(expired, p.instance)
+		} else {
+			kept = append(kept, p)
+		}
+	}
+	t.pending = kept
+	t.lock.Unlock()
+	for _, instance := range expired {
+		t.fallback(instance)
+	}
+}
+
+// flush records the kernel log records of the processes of a container that
+// went away before they were accounted for.
+func (t *oomTracker) flush(containerName string) {
+	for _, instance := range t.take(containerName, -1) {
+		t.fallback(instance)
+	}
+}
+
+// take removes up to n kernel log records of processes of the given
+// container, all if n is -1.
+func (t *oomTracker) take(containerName string, n int) []*oomparser.OomInstance {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	var taken []*oomparser.OomInstance
+	kept := t.pending[:0]
+	for _, p := range t.pending {
+		if p.instance.ContainerName == containerName && (n == -1 || len(taken) < n) {
+			taken = append(taken, p.instance)
+		} else {
+			kept = append(kept, p)
+		}
+	}
+	t.pending = kept
+	return taken
+}
+
+// update records the OOM events of a container between its counters prev
+// and cur. first is whether prev are unknown, on the first housekeeping.
+func (t *oomTracker) update(containerName string, creationTime time.Time, prev, cur memoryEvents, first bool) {
+	if first {
+		if creationTime.Before(t.startupTime) {
+			return
+		}
+		// The container has had no events before cAdvisor watched it.
+		prev = memoryEvents{}
+	}
+	now := t.clock.Now()
+	if cur.oom > prev.oom {
+		t.addEvent(&info.Event{
+			ContainerName: containerName,
+			Timestamp:     now,
+			EventType:     info.EventOom,
+		})
+		klog.V(3).Infof("Created an OOM event in container %q at %v", containerName, now)
+	}
+	if cur.oomKill <= prev.oomKill {
+		return
+	}
+	kills := cur.oomKill - prev.oomKill
+	records := t.take(containerName, int(kills))
+	for i := uint64(0); i < kills; i++ {
+		ev := &info.Event{
+			ContainerName: containerName,
+			Timestamp:     now,
+			EventType:     info.EventOomKill,
+			EventData: info.EventData{
+				OomKill: &info.OomKillEventData{},
+			},
+		}
+		if i < uint64(len(records)) {
+			ev.Timestamp = records[i].TimeOfDeath
+			ev.EventData.OomKill.Pid = records[i].Pid
+			ev.EventData.OomKill.ProcessName = records[i].ProcessName
+		}
+		t.addEvent(ev)
+	}
+	t.count(containerName, kills)
+}
+
+func (t *oomTracker) addEvent(ev *info.Event) {
+	if err := t.eventHandler.AddEvent(ev); err != nil {
+		klog.Errorf("failed to add %s event for %q: %v", ev.EventType, ev.ContainerName, err)
+	}
+}
+
+// checkMemoryEvents records the OOM events of the container since the last
+// check.
+func (cd *containerData) checkMemoryEvents() {
+	if cd.oomTracker == nil {
+		return
+	}
+	dir, err := cd.handler.GetCgroupPath("memory")
+	if err != nil {
+		return
+	}
+	cur, err := readMemoryEvents(dir)
+	if err != nil {
+		klog.V(4).Infof("Failed to read the memory events of container %q: %v", cd.info.Name, err)
+		return
+	}
+	cd.memoryEventsLock.Lock()
+	defer cd.memoryEventsLock.Unlock()
+	prev := cd.memoryEvents
+	if prev == nil {
+		cd.oomTracker.update(cd.info.Name, cd.info.Spec.CreationTime, memoryEvents{}, cur, true)
+	} else {
+		cd.oomTracker.update(cd.info.Name, cd.info.Spec.CreationTime, *prev, cur, false)
+	}
+	cd.memoryEvents = &cur
+}
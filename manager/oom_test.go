@@ -0,0 +1,162 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package manager
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	clock "k8s.io/utils/clock/testing"
+
+	"github.com/google/cadvisor/events"
+	info "github.com/google/cadvisor/info/v1"
+	itest "github.com/google/cadvisor/info/v1/test"
+	"github.com/google/cadvisor/utils/oomparser"
+)
+
+func TestReadMemoryEvents(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "memory.events"), []byte("low 0\nhigh 0\nmax 12\noom 3\noom_kill 2\noom_group_kill 0\n"), 0o644))
+	ev, err := readMemoryEvents(dir)
+	require.NoError(t, err)
+	assert.Equal(t, memoryEvents{oom: 3, oomKill: 2}, ev)
+
+	// The local counters are preferred.
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "memory.events.local"), []byte("low 0\nhigh 0\nmax 1\noom 1\noom_kill 1\n"), 0o644))
+	ev, err = readMemoryEvents(dir)
+	require.NoError(t, err)
+	assert.Equal(t, memoryEvents{oom: 1, oomKill: 1}, ev)
+
+	_, err = readMemoryEvents(filepath.Join(dir, "gone"))
+	assert.True(t, os.IsNotExist(err))
+}
+
+type oomTrackerTest struct {
+	tracker      *oomTracker
+	clock        *clock.FakeClock
+	eventHandler events.EventManager
+	counted      map[string]uint64
+	fallbacks    []*oomparser.OomInstance
+}
+
+func newOomTrackerTest() *oomTrackerTest {
+	test := &oomTrackerTest{
+		clock:        clock.NewFakeClock(time.Now()),
+		eventHandler: events.NewEventManager(events.DefaultStoragePolicy()),
+		counted:      map[string]uint64{},
+	}
+	test.tracker = newOomTracker(test.eventHandler, test.clock, test.clock.Now(), time.Minute,
+		func(name string, n uint64) { test.counted[name] += n },
+		func(instance *oomparser.OomInstance) { test.fallbacks = append(test.fallbacks, instance) })
+	return test
+}
+
+func (test *oomTrackerTest) events(t *testing.T, eventType info.EventType) []*info.Event {
+	request := events.NewRequest()
+	request.EventType[eventType] = true
+	request.ContainerName = "/"
+	request.IncludeSubcontainers = true
+	request.MaxEventsReturned = -1
+	evs, err := test.eventHandler.GetEvents(request)
+	require.NoError(t, err)
+	return evs
+}
+
+func TestOomTrackerAttributesKills(t *testing.T) {
+	test := newOomTrackerTest()
+	killedAt := test.clock.Now().Add(-time.Second)
+	// The kernel log reports the limit of the pod, the counters tell the
+	// kill happened in the container.
+	test.tracker.addKernelRecord(&oomparser.OomInstance{
+		Pid:                 42,
+		ProcessName:         "stress",
+		TimeOfDeath:         killedAt,
+		ContainerName:       "/kubepods/pod1/c1",
+		VictimContainerName: "/kubepods/pod1",
+	})
+	test.tracker.addKernelRecord(&oomparser.OomInstance{Pid: 7, ContainerName: "/other"})
+
+	test.tracker.update("/kubepods/pod1/c1", killedAt, memoryEvents{}, memoryEvents{oomKill: 2}, false)
+	test.tracker.update("/kubepods/pod1", killedAt, memoryEvents{oom: 4}, memoryEvents{oom: 6}, false)
+
+	kills := test.events(t, info.EventOomKill)
+	require.Len(t, kills, 2)
+	for _, ev := range kills {
+		assert.Equal(t, "/kubepods/pod1/c1", ev.ContainerName)
+	}
+	assert.ElementsMatch(t, []int{42, 0}, []int{kills[0].EventData.OomKill.Pid, kills[1].EventData.OomKill.Pid})
+	ooms := test.events(t, info.EventOom)
+	require.Len(t, ooms, 1)
+	assert.Equal(t, "/kubepods/pod1", ooms[0].ContainerName)
+	assert.Equal(t, map[string]uint64{"/kubepods/pod1/c1": 2}, test.counted)
+
+	// The record no counter accounted for is reported as the kernel log has it.
+	assert.Empty(t, test.fallbacks)
+	test.clock.Step(time.Minute)
+	test.tracker.expire()
+	require.Len(t, test.fallbacks, 1)
+	assert.Equal(t, 7, test.fallbacks[0].Pid)
+}
+
+func TestOomTrackerFirstCounters(t *testing.T) {
+	test := newOomTrackerTest()
+	old := test.clock.Now().Add(-time.Hour)
+	// The OOMs of containers created before cAdvisor started are not known
+	// to have happened since.
+	test.tracker.update("/old", old, memoryEvents{}, memoryEvents{oom: 1, oomKill: 1}, true)
+	assert.Empty(t, test.events(t, info.EventOomKill))
+
+	test.tracker.update("/new", test.clock.Now(), memoryEvents{}, memoryEvents{oom: 1, oomKill: 1}, true)
+	assert.Len(t, test.events(t, info.EventOomKill), 1)
+	assert.Len(t, test.events(t, info.EventOom), 1)
+}
+
+func TestOomTrackerFlush(t *testing.T) {
+	test := newOomTrackerTest()
+	test.tracker.addKernelRecord(&oomparser.OomInstance{Pid: 1, ContainerName: "/c1"})
+	test.tracker.addKernelRecord(&oomparser.OomInstance{Pid: 2, ContainerName: "/c2"})
+	test.tracker.flush("/c1")
+	require.Len(t, test.fallbacks, 1)
+	assert.Equal(t, 1, test.fallbacks[0].Pid)
+	require.Len(t, test.tracker.pending, 1)
+}
+
+func TestCheckMemoryEvents(t *testing.T) {
+	test := newOomTrackerTest()
+	spec := itest.GenerateRandomContainerSpec(4)
+	spec.CreationTime = test.clock.Now()
+	cd, mockHandler, _, _ := setupContainerData(t, spec)
+	cd.oomTracker = test.tracker
+	dir := t.TempDir()
+	mockHandler.On("GetCgroupPath", "memory").Return(dir, nil)
+	write := func(oomKill string) {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "memory.events.local"), []byte("oom 0\noom_kill "+oomKill+"\n"), 0o644))
+	}
+
+	write("1")
+	cd.checkMemoryEvents()
+	write("1")
+	cd.checkMemoryEvents()
+	write("3")
+	cd.checkMemoryEvents()
+	assert.Len(t, test.events(t, info.EventOomKill), 3)
+	assert.Equal(t, uint64(3), test.counted[containerName])
+}
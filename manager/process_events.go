@@ -0,0 +1,208 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package manager
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/google/cadvisor/events"
+	info "github.com/google/cadvisor/info/v1"
+	"github.com/google/cadvisor/utils/procevents"
+
+	"k8s.io/klog/v2"
+)
+
+var processEvents = flag.String("process_events", "", "Comma-separated process actions to report as process events of the containers they happen in, among exec, fork and exit. Requires CAP_NET_ADMIN. Empty disables process events")
+
+// processEventTracker turns the events of the kernel proc connector into
+// process events of the containers the processes belong to.
+type processEventTracker struct {
+	procRoot     string
+	actions      map[procevents.Action]bool
+	eventHandler events.EventManager
+	// container returns the container a cgroup belongs to, which is the
+	// closest collected container among the cgroup and its ancestors, and
+	// false if it is only the root container.
+	container func(cgroup string) (string, bool)
+	// Processes forked or executed in containers while exits are reported,
+	// whose cgroup may no longer be read from /proc at their exit.
+	processes map[int]trackedProcess
+}
+
+type trackedProcess struct {
+	container string
+	command   string
+}
+
+func parseProcessActions(value string) (map[procevents.Action]bool, error) {
+	actions := map[procevents.Action]bool{}
+	for _, action := range strings.Split(value, ",") {
+		switch a := procevents.Action(strings.TrimSpace(action)); a {
+		case "":
+		case procevents.Exec, procevents.Fork, procevents.Exit:
+			actions[a] = true
+		default:
+			return nil, fmt.Errorf("unknown process action %q", action)
+		}
+	}
+	return actions, nil
+}
+
+func (t *processEventTracker) handle(ev *procevents.Event) {
+	var process trackedProcess
+	switch ev.Action {
+	case procevents.Fork, procevents.Exec:
+		var ok bool
+		if process, ok = t.lookup(ev.Pid); !ok {
+			delete(t.processes, ev.Pid)
+			return
+		}
+		if t.actions[procevents.Exit] {
+			t.processes[ev.Pid] = process
+		}
+	case procevents.Exit:
+		var ok bool
+		if process, ok = t.processes[ev.Pid]; ok {
+			delete(t.processes, ev.Pid)
+		} else if process, ok = t.lookup(ev.Pid); !ok {
+			return
+		}
+	}
+	if !t.actions[ev.Action] {
+		return
+	}
+	err := t.eventHandler.AddEvent(&info.Event{
+		ContainerName: process.container,
+		Timestamp:     ev.Timestamp,
+		EventType:     info.EventProcess,
+		EventData: info.EventData{
+			Process: &info.ProcessEventData{
+				Action:    string(ev.Action),
+				Pid:       ev.Pid,
+				ParentPid: ev.ParentPid,
+				Command:   process.command,
+				ExitCode:  ev.ExitCode,
+				Signal:    ev.Signal,
+			},
+		},
+	})
+	if err != nil {
+		klog.Errorf("Failed to add process event for %q: %v", process.container, err)
+	}
+}
+
+// lookup returns the container and the command of a process from /proc,
+// and false if it is not in a container or already gone.
+func (t *processEventTracker) lookup(pid int) (trackedProcess, bool) {
+	dir := filepath.Join(t.procRoot, strconv.Itoa(pid))
+	cgroup, err := readProcessCgroup(filepath.Join(dir, "cgroup"))
+	if err != nil {
+		return trackedProcess{}, false
+	}
+	name, ok := t.container(cgroup)
+	if !ok {
+		return trackedProcess{}, false
+	}
+	comm, _ := os.ReadFile(filepath.Join(dir, "comm"))
+	return trackedProcess{container: name, command: strings.TrimSpace(string(comm))}, true
+}
+
+// readProcessCgroup returns the cgroup of a process from its /proc cgroup
+// file: the unified one on cgroup v2, the one of the cpu controller on v1.
+func readProcessCgroup(file string) (string, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		// hierarchy-ID:controller-list:cgroup-path
+		parts := strings.SplitN(scanner.Text(), ":", 3)
+		if len(parts) != 3 {
+			continue
+		}
+		if parts[0] == "0" && parts[1] == "" {
+			return parts[2], nil
+		}
+		for _, controller := range strings.Split(parts[1], ",") {
+			if controller == "cpu" {
+				return parts[2], nil
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return "", err
+	}
+	return "", fmt.Errorf("no cgroup found in %q", file)
+}
+
+// containerOfCgroup returns the closest container collected among a cgroup
+// and its ancestors, and false if there is none but the root container.
+func (m *manager) containerOfCgroup(cgroup string) (string, bool) {
+	for name := path.Clean(cgroup); name != "/" && name != "."; name = path.Dir(name) {
+		if cont, ok := m.containers.Load(namespacedContainerName{Name: name}); ok && cont != nil {
+			return name, true
+		}
+	}
+	return "", false
+}
+
+// watchForProcessEvents reports the process events of the process_events
+// actions.
+func (m *manager) watchForProcessEvents() error {
+	actions, err := parseProcessActions(*processEvents)
+	if err != nil || len(actions) == 0 {
+		return err
+	}
+	watcher, err := procevents.New()
+	if err != nil {
+		return err
+	}
+	tracker := &processEventTracker{
+		procRoot:     "/proc",
+		actions:      actions,
+		eventHandler: m.eventHandler,
+		container:    m.containerOfCgroup,
+		processes:    map[int]trackedProcess{},
+	}
+	outStream := make(chan *procevents.Event, 1024)
+	go watcher.Stream(outStream)
+	go func() {
+		for ev := range outStream {
+			tracker.handle(ev)
+		}
+	}()
+	klog.V(2).Infof("Started watching for process events")
+	return nil
+}
+
+// containerOfPid returns the container a process runs in.
+func (m *manager) containerOfPid(pid int) (string, bool) {
+	cgroup, err := readProcessCgroup(filepath.Join("/proc", strconv.Itoa(pid), "cgroup"))
+	if err != nil {
+		return "", false
+	}
+	return m.containerOfCgroup(cgroup)
+}
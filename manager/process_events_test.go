@@ -0,0 +1,89 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package manager
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/google/cadvisor/events"
+	info "github.com/google/cadvisor/info/v1"
+	"github.com/google/cadvisor/utils/procevents"
+)
+
+func writeProcess(t *testing.T, procRoot string, pid, cgroup, comm string) {
+	dir := filepath.Join(procRoot, pid)
+	require.NoError(t, os.MkdirAll(dir, 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "cgroup"), []byte(cgroup), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "comm"), []byte(comm+"\n"), 0o644))
+}
+
+func TestProcessEventTracker(t *testing.T) {
+	procRoot := t.TempDir()
+	writeProcess(t, procRoot, "10", "0::/kubepods/pod1/ctr/init.scope\n", "nginx")
+	writeProcess(t, procRoot, "11", "0::/system.slice/sshd.service\n", "sshd")
+	eventManager := events.NewEventManager(events.DefaultStoragePolicy())
+	actions, err := parseProcessActions("exec,exit")
+	require.NoError(t, err)
+	tracker := &processEventTracker{
+		procRoot:     procRoot,
+		actions:      actions,
+		eventHandler: eventManager,
+		container: func(cgroup string) (string, bool) {
+			if cgroup == "/kubepods/pod1/ctr/init.scope" {
+				return "/kubepods/pod1/ctr", true
+			}
+			return "", false
+		},
+		processes: map[int]trackedProcess{},
+	}
+
+	now := time.Now()
+	tracker.handle(&procevents.Event{Action: procevents.Fork, Pid: 10, ParentPid: 1, Timestamp: now})
+	tracker.handle(&procevents.Event{Action: procevents.Exec, Pid: 11, Timestamp: now})
+	// The process exits once gone from /proc.
+	require.NoError(t, os.RemoveAll(filepath.Join(procRoot, "10")))
+	tracker.handle(&procevents.Event{Action: procevents.Exit, Pid: 10, ParentPid: 1, Signal: 9, Timestamp: now.Add(time.Second)})
+	tracker.handle(&procevents.Event{Action: procevents.Exit, Pid: 12, Timestamp: now.Add(time.Second)})
+
+	request := events.NewRequest()
+	request.EventType[info.EventProcess] = true
+	evs, err := eventManager.GetEvents(request)
+	require.NoError(t, err)
+	// The fork is not reported, and sshd is not in a container.
+	require.Len(t, evs, 1)
+	assert.Equal(t, "/kubepods/pod1/ctr", evs[0].ContainerName)
+	assert.Equal(t, &info.ProcessEventData{Action: "exit", Pid: 10, ParentPid: 1, Command: "nginx", Signal: 9}, evs[0].EventData.Process)
+	assert.Empty(t, tracker.processes)
+}
+
+func TestReadProcessCgroup(t *testing.T) {
+	dir := t.TempDir()
+	v1 := filepath.Join(dir, "v1")
+	require.NoError(t, os.WriteFile(v1, []byte("12:memory:/docker/abc\n4:cpu,cpuacct:/docker/abc\n1:name=systemd:/docker/abc\n"), 0o644))
+	cgroup, err := readProcessCgroup(v1)
+	require.NoError(t, err)
+	assert.Equal(t, "/docker/abc", cgroup)
+
+	_, err = parseProcessActions("exec,spawn")
+	assert.Error(t, err)
+}
@@ -0,0 +1,179 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux || windows || freebsd
+
+package manager
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"k8s.io/klog/v2"
+
+	"github.com/google/cadvisor/utils/profiling"
+)
+
+var (
+	profileDir          = flag.String("profile_dir", "", "Directory cAdvisor writes CPU, heap and goroutine profiles of itself to while it uses more than profile_cpu_threshold cores. Empty disables these profiles")
+	profileCPUThreshold = flag.Float64("profile_cpu_threshold", 1, "CPU cAdvisor may use, in cores, before it writes profiles to profile_dir")
+	profileDuration     = flag.Duration("profile_duration", 30*time.Second, "Duration of the CPU profiles written to profile_dir")
+	profileMinInterval  = flag.Duration("profile_min_interval", 10*time.Minute, "Minimum interval between two captures of profiles to profile_dir")
+	profileMaxCaptures  = flag.Int("profile_max_captures", 10, "Number of captures kept in profile_dir, the oldest being deleted")
+)
+
+const (
+	profileCheckInterval = 10 * time.Second
+	// Layout of the names of the directories of the captures, which sort
+	// chronologically.
+	profileCaptureLayout = "20060102T150405Z"
+)
+
+// selfProfiled are the profiles written to each capture directory.
+var selfProfiled = []string{profiling.CPU, "heap", "goroutine"}
+
+// selfProfiler writes profiles of cAdvisor to a directory while it uses too
+// much CPU, to tell afterwards what it was busy with.
+type selfProfiler struct {
+	dir         string
+	threshold   float64
+	duration    time.Duration
+	minInterval time.Duration
+	maxCaptures int
+	readUsage   func() (selfUsage, error)
+
+	last        selfUsage
+	lastCheck   time.Time
+	lastCapture time.Time
+}
+
+func newSelfProfiler(dir string, threshold float64, duration, minInterval time.Duration, maxCaptures int) *selfProfiler {
+	return &selfProfiler{
+		dir:         dir,
+		threshold:   threshold,
+		duration:    duration,
+		minInterval: minInterval,
+		maxCaptures: maxCaptures,
+		readUsage:   readSelfUsage,
+	}
+}
+
+// check captures profiles if cAdvisor used more CPU than the threshold since
+// the previous check, and no capture happened within the minimum interval.
+func (p *selfProfiler) check(ctx context.Context, now time.Time) {
+	usage, err := p.readUsage()
+	if err != nil {
+		klog.Warningf("Failed to read cAdvisor's own resource usage: %v", err)
+		return
+	}
+	last, lastCheck := p.last, p.lastCheck
+	p.last, p.lastCheck = usage, now
+	if lastCheck.IsZero() || !now.After(lastCheck) {
+		return
+	}
+	cores := float64(usage.cpu-last.cpu) / float64(now.Sub(lastCheck))
+	if cores <= p.threshold || (!p.lastCapture.IsZero() && now.Sub(p.lastCapture) < p.minInterval) {
+		return
+	}
+	p.lastCapture = now
+
+	dir := filepath.Join(p.dir, now.UTC().Format(profileCaptureLayout))
+	klog.Warningf("cAdvisor uses %.2f cores, writing profiles to %s", cores, dir)
+	if err := p.capture(ctx, dir); err != nil {
+		klog.Errorf("Failed to write profiles to %s: %v", dir, err)
+	}
+	if err := p.prune(); err != nil {
+		klog.Errorf("Failed to delete old profiles from %s: %v", p.dir, err)
+	}
+	// Do not account the usage of the capture to the next check.
+	if usage, err := p.readUsage(); err == nil {
+		p.last, p.lastCheck = usage, now.Add(p.duration)
+	}
+}
+
+// capture writes the profiles to dir.
+func (p *selfProfiler) capture(ctx context.Context, dir string) error {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return err
+	}
+	for _, name := range selfProfiled {
+		if err := writeProfile(ctx, filepath.Join(dir, name+".pprof"), name, p.duration); err != nil {
+			return fmt.Errorf("%s profile: %w", name, err)
+		}
+	}
+	return nil
+}
+
+func writeProfile(ctx context.Context, path, name string, duration time.Duration) error {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o600)
+	if err != nil {
+		return err
+	}
+	if err := profiling.Capture(ctx, f, name, duration, 0); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// prune deletes the oldest captures beyond the maximum number.
+func (p *selfProfiler) prune() error {
+	entries, err := os.ReadDir(p.dir)
+	if err != nil {
+		return err
+	}
+	var captures []string
+	for _, entry := range entries {
+		if _, err := time.Parse(profileCaptureLayout, entry.Name()); entry.IsDir() && err == nil {
+			captures = append(captures, entry.Name())
+		}
+	}
+	sort.Strings(captures)
+	for len(captures) > p.maxCaptures {
+		if err := os.RemoveAll(filepath.Join(p.dir, captures[0])); err != nil {
+			return err
+		}
+		captures = captures[1:]
+	}
+	return nil
+}
+
+// watchSelfProfile checks whether to profile cAdvisor until quit. A capture
+// in progress is cut short on quit.
+func (m *manager) watchSelfProfile(quit chan error) {
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		ticker := time.NewTicker(profileCheckInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case t := <-ticker.C:
+				m.profiler.check(ctx, t)
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	<-quit
+	cancel()
+	<-done
+	quit <- nil
+}
@@ -0,0 +1,69 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package manager
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSelfProfiler(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "notes.txt"), nil, 0o600))
+	p := newSelfProfiler(dir, 0.5, 10*time.Millisecond, time.Minute, 1)
+	var usage selfUsage
+	p.readUsage = func() (selfUsage, error) { return usage, nil }
+
+	now := time.Date(2026, 10, 14, 8, 0, 0, 0, time.UTC)
+	// step advances the clock by 10s during which cAdvisor used the given
+	// cores, and returns the captures in dir.
+	step := func(cores float64) []string {
+		now = now.Add(10 * time.Second)
+		usage.cpu += time.Duration(cores * float64(10*time.Second))
+		p.check(context.Background(), now)
+		matches, err := filepath.Glob(filepath.Join(dir, "2026*"))
+		require.NoError(t, err)
+		for i := range matches {
+			matches[i] = filepath.Base(matches[i])
+		}
+		return matches
+	}
+
+	// The first check only records the usage.
+	assert.Empty(t, step(2))
+	assert.Empty(t, step(0.2))
+	assert.Equal(t, []string{"20261014T080030Z"}, step(1))
+	for _, name := range []string{"cpu.pprof", "heap.pprof", "goroutine.pprof"} {
+		info, err := os.Stat(filepath.Join(dir, "20261014T080030Z", name))
+		require.NoError(t, err)
+		assert.NotZero(t, info.Size(), name)
+	}
+
+	// No capture within the minimum interval.
+	for i := 0; i < 5; i++ {
+		assert.Equal(t, []string{"20261014T080030Z"}, step(1))
+	}
+	// The older capture is deleted, the other files are left alone.
+	assert.Equal(t, []string{"20261014T080130Z"}, step(1))
+	assert.FileExists(t, filepath.Join(dir, "notes.txt"))
+}
@@ -0,0 +1,59 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package manager
+
+import (
+	"errors"
+	"io"
+	"os"
+
+	securejoin "github.com/cyphar/filepath-securejoin"
+	"golang.org/x/sys/unix"
+)
+
+// readFileInRoot reads the file at path resolved inside root, as if root was
+// the root directory: neither "..", absolute symlinks nor symlinks to ".."
+// escape it.
+func readFileInRoot(root, path string) ([]byte, error) {
+	rootDir, err := os.Open(root)
+	if err != nil {
+		return nil, err
+	}
+	defer rootDir.Close()
+	fd, err := unix.Openat2(int(rootDir.Fd()), path, &unix.OpenHow{
+		Flags:   unix.O_RDONLY | unix.O_CLOEXEC,
+		Resolve: unix.RESOLVE_IN_ROOT | unix.RESOLVE_NO_MAGICLINKS,
+	})
+	if errors.Is(err, unix.ENOSYS) {
+		// Kernels before 5.6 lack openat2, resolve the path by hand instead.
+		return readFileSecureJoin(root, path)
+	}
+	if err != nil {
+		return nil, &os.PathError{Op: "openat2", Path: path, Err: err}
+	}
+	f := os.NewFile(uintptr(fd), path)
+	defer f.Close()
+	return io.ReadAll(f)
+}
+
+func readFileSecureJoin(root, path string) ([]byte, error) {
+	resolved, err := securejoin.SecureJoin(root, path)
+	if err != nil {
+		return nil, err
+	}
+	return os.ReadFile(resolved)
+}
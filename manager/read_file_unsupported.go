@@ -0,0 +1,33 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build windows || freebsd
+
+package manager
+
+import (
+	"os"
+
+	securejoin "github.com/cyphar/filepath-securejoin"
+)
+
+// readFileInRoot reads the file at path resolved inside root, as if root was
+// the root directory.
+func readFileInRoot(root, path string) ([]byte, error) {
+	resolved, err := securejoin.SecureJoin(root, path)
+	if err != nil {
+		return nil, err
+	}
+	return os.ReadFile(resolved)
+}
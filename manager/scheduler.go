@@ -0,0 +1,289 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux || windows || freebsd
+
+package manager
+
+import (
+	"container/heap"
+	"flag"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"k8s.io/klog/v2"
+	"k8s.io/utils/clock"
+)
+
+var housekeepingWorkers = flag.Int("housekeeping_workers", 0, "Number of workers sharing the housekeeping of all containers. If 0, each container has its own housekeeping goroutine")
+
+// scheduledContainer is a container whose housekeeping is scheduled.
+type scheduledContainer struct {
+	cd   *containerData
+	next time.Time
+	// Position in the queue, -1 while not queued.
+	index int
+	// Whether a worker is housekeeping the container, and whether the
+	// container was removed meanwhile.
+	running bool
+	removed bool
+}
+
+// scheduleQueue orders containers by their next housekeeping. It implements
+// heap.Interface.
+type scheduleQueue []*scheduledContainer
+
+func (q scheduleQueue) Len() int           { return len(q) }
+func (q scheduleQueue) Less(i, j int) bool { return q[i].next.Before(q[j].next) }
+
+func (q scheduleQueue) Swap(i, j int) {
+	q[i], q[j] = q[j], q[i]
+	q[i].index = i
+	q[j].index = j
+}
+
+func (q *scheduleQueue) Push(x any) {
+	c := x.(*scheduledContainer)
+	c.index = len(*q)
+	*q = append(*q, c)
+}
+
+func (q *scheduleQueue) Pop() any {
+	old := *q
+	c := old[len(old)-1]
+	old[len(old)-1] = nil
+	c.index = -1
+	*q = old[:len(old)-1]
+	return c
+}
+
+// housekeepingScheduler runs the housekeeping of containers on a bounded pool
+// of workers, each container at its own interval, instead of running one
+// goroutine per container.
+type housekeepingScheduler struct {
+	clock            clock.Clock
+	longHousekeeping time.Duration
+
+	lock       sync.Mutex
+	queue      scheduleQueue
+	containers map[*containerData]*scheduledContainer
+
+	// Wakes the dispatcher up when the head of the queue changes.
+	wake     chan struct{}
+	work     chan *scheduledContainer
+	stop     chan struct{}
+	stopOnce sync.Once
+	wg       sync.WaitGroup
+
+	// Number of workers to retire once they finish their housekeeping,
+	// because workers were started in place of them while they were blocked.
+	surplus atomic.Int32
+}
+
+// newHousekeepingScheduler starts a scheduler with the given number of
+// workers.
+func newHousekeepingScheduler(workers int, clock clock.Clock) *housekeepingScheduler {
+	s := &housekeepingScheduler{
+		clock:            clock,
+		longHousekeeping: longHousekeeping(),
+		containers:       map[*containerData]*scheduledContainer{},
+		wake:             make(chan struct{}, 1),
+		work:             make(chan *scheduledContainer),
+		stop:             make(chan struct{}),
+	}
+	s.wg.Add(workers + 1)
+	go s.dispatch()
+	for i := 0; i < workers; i++ {
+		go s.runWorker()
+	}
+	klog.V(1).Infof("Housekeeping containers with %d workers", workers)
+	return s
+}
+
+// Stop stops the dispatcher and the workers once they finish the
+// housekeeping they are running. It can be called several times.
+func (s *housekeepingScheduler) Stop() {
+	s.stopOnce.Do(func() { close(s.stop) })
+	s.wg.Wait()
+}
+
+func (s *housekeepingScheduler) notify() {
+	select {
+	case s.wake <- struct{}{}:
+	default:
+	}
+}
+
+// add schedules the housekeeping of cd right away.
+func (s *housekeepingScheduler) add(cd *containerData) {
+	klog.V(3).Infof("Start housekeeping for container %q\n", cd.info.Name)
+	cd.startHousekeeping()
+	s.lock.Lock()
+	c := &scheduledContainer{cd: cd, next: s.clock.Now()}
+	s.containers[cd] = c
+	heap.Push(&s.queue, c)
+	s.lock.Unlock()
+	s.notify()
+}
+
+// remove stops scheduling the housekeeping of cd. Its background work is
+// stopped now if it is queued, or once its running housekeeping finishes.
+func (s *housekeepingScheduler) remove(cd *containerData) {
+	s.lock.Lock()
+	c, ok := s.containers[cd]
+	if !ok {
+		s.lock.Unlock()
+		return
+	}
+	delete(s.containers, cd)
+	if c.running {
+		c.removed = true
+		s.lock.Unlock()
+		return
+	}
+	if c.index >= 0 {
+		heap.Remove(&s.queue, c.index)
+	}
+	s.lock.Unlock()
+	s.notify()
+	cd.stopHousekeeping()
+}
+
+// expedite moves the housekeeping of cd forward to now, for on demand
+// housekeeping. A running housekeeping is rescheduled right away when it
+// finishes instead.
+func (s *housekeepingScheduler) expedite(cd *containerData) {
+	s.lock.Lock()
+	c, ok := s.containers[cd]
+	if !ok || c.index < 0 {
+		s.lock.Unlock()
+		return
+	}
+	c.next = s.clock.Now()
+	heap.Fix(&s.queue, c.index)
+	s.lock.Unlock()
+	s.notify()
+}
+
+// dispatch hands the containers to the workers as their housekeeping is due.
+func (s *housekeepingScheduler) dispatch() {
+	defer s.wg.Done()
+	timer := s.clock.NewTimer(time.Hour)
+	defer timer.Stop()
+	for {
+		s.lock.Lock()
+		var due *scheduledContainer
+		wait := time.Hour
+		if len(s.queue) != 0 {
+			if wait = s.queue[0].next.Sub(s.clock.Now()); wait <= 0 {
+				due = heap.Pop(&s.queue).(*scheduledContainer)
+				due.running = true
+			}
+		}
+		s.lock.Unlock()
+
+		if due != nil {
+			select {
+			case s.work <- due:
+			case <-s.stop:
+				return
+			}
+			continue
+		}
+
+		if !timer.Stop() {
+			select {
+			case <-timer.C():
+			default:
+			}
+		}
+		timer.Reset(wait)
+		select {
+		case <-timer.C():
+		case <-s.wake:
+		case <-s.stop:
+			return
+		}
+	}
+}
+
+func (s *housekeepingScheduler) runWorker() {
+	defer s.wg.Done()
+	for {
+		select {
+		case c := <-s.work:
+			s.housekeep(c)
+			if s.retire() {
+				return
+			}
+		case <-s.stop:
+			return
+		}
+	}
+}
+
+// replaceWorker starts a worker in place of one that is blocked in the
+// housekeeping of a container. The pool shrinks back once the blocked worker
+// returns.
+func (s *housekeepingScheduler) replaceWorker() {
+	s.surplus.Add(1)
+	s.wg.Add(1)
+	go s.runWorker()
+}
+
+// retire returns whether the calling worker is surplus and must exit.
+func (s *housekeepingScheduler) retire() bool {
+	for {
+		n := s.surplus.Load()
+		if n <= 0 {
+			return false
+		}
+		if s.surplus.CompareAndSwap(n, n-1) {
+			return true
+		}
+	}
+}
+
+// running returns whether a worker is housekeeping cd.
+func (s *housekeepingScheduler) running(cd *containerData) bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	c, ok := s.containers[cd]
+	return ok && c.running
+}
+
+// housekeep runs the housekeeping of c and schedules the next one.
+func (s *housekeepingScheduler) housekeep(c *scheduledContainer) {
+	cd := c.cd
+	cd.housekeepOnce(s.longHousekeeping)
+	cd.logRecentUsage()
+	interval := cd.nextHousekeepingInterval()
+
+	s.lock.Lock()
+	c.running = false
+	if c.removed {
+		s.lock.Unlock()
+		cd.stopHousekeeping()
+		return
+	}
+	c.next = s.clock.Now().Add(interval)
+	if len(cd.onDemandChan) != 0 {
+		// Housekeeping was asked for while running.
+		c.next = s.clock.Now()
+	}
+	heap.Push(&s.queue, c)
+	s.lock.Unlock()
+	s.notify()
+}
@@ -0,0 +1,103 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package manager
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	itest "github.com/google/cadvisor/info/v1/test"
+)
+
+// newScheduledContainerData returns a container housekept by a scheduler on
+// the clock of the container, and the number of housekeepings it ran.
+func newScheduledContainerData(t *testing.T) (*containerData, *atomic.Int32) {
+	cd, mockHandler, _, fakeClock := newTestContainerData(t)
+	var runs atomic.Int32
+	mockHandler.On("GetStats").Return(itest.GenerateRandomStats(1, 4, time.Second)[0], nil).Run(func(mock.Arguments) {
+		runs.Add(1)
+	})
+	cd.allowDynamicHousekeeping = false
+	cd.scheduler = newHousekeepingScheduler(2, fakeClock)
+	t.Cleanup(cd.scheduler.Stop)
+	return cd, &runs
+}
+
+func TestSchedulerHousekeepsAtInterval(t *testing.T) {
+	cd, runs := newScheduledContainerData(t)
+	fakeClock := cd.clock.(interface{ Step(time.Duration) })
+
+	// The interval is static, the workers set it to the base interval.
+	interval, _ := cd.intervals.get()
+	assert.NoError(t, cd.Start())
+	assert.Eventually(t, func() bool { return runs.Load() == 1 }, time.Second, time.Millisecond)
+
+	// The next housekeeping is due within twice the interval, with jitter.
+	assert.Eventually(t, func() bool {
+		fakeClock.Step(2 * interval)
+		return runs.Load() >= 2
+	}, time.Second, 10*time.Millisecond)
+	assert.NoError(t, cd.Stop())
+}
+
+func TestSchedulerOnDemandHousekeeping(t *testing.T) {
+	cd, runs := newScheduledContainerData(t)
+	assert.NoError(t, cd.Start())
+	assert.Eventually(t, func() bool { return runs.Load() == 1 }, time.Second, time.Millisecond)
+
+	// Without on demand housekeeping, the fake clock never gets to the next
+	// one.
+	cd.OnDemandHousekeeping(-1)
+	assert.GreaterOrEqual(t, runs.Load(), int32(2))
+	assert.NoError(t, cd.Stop())
+}
+
+func TestSchedulerRemove(t *testing.T) {
+	cd, runs := newScheduledContainerData(t)
+	fakeClock := cd.clock.(interface{ Step(time.Duration) })
+	assert.NoError(t, cd.Start())
+	assert.Eventually(t, func() bool { return runs.Load() == 1 }, time.Second, time.Millisecond)
+	assert.NoError(t, cd.Stop())
+
+	fakeClock.Step(time.Hour)
+	time.Sleep(10 * time.Millisecond)
+	assert.Equal(t, int32(1), runs.Load())
+	cd.scheduler.lock.Lock()
+	defer cd.scheduler.lock.Unlock()
+	assert.Empty(t, cd.scheduler.queue)
+	assert.Empty(t, cd.scheduler.containers)
+}
+
+func TestManagerStopStopsScheduler(t *testing.T) {
+	s := newHousekeepingScheduler(2, clocktesting.NewFakeClock(time.Now()))
+	m := &manager{housekeepingScheduler: s}
+	assert.NoError(t, m.Stop())
+	select {
+	case <-s.stop:
+	default:
+		t.Fatal("the scheduler wasn't stopped")
+	}
+	// The workers and the dispatcher returned.
+	s.wg.Wait()
+	// Stopping again is harmless.
+	s.Stop()
+}
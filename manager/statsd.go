@@ -0,0 +1,69 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux || windows || freebsd
+
+package manager
+
+import (
+	"flag"
+
+	"github.com/google/cadvisor/collector"
+)
+
+var (
+	statsdUDPAddress   = flag.String("statsd_udp_address", "", "UDP address on which to receive StatsD application metrics, e.g. 127.0.0.1:8125. Empty disables it")
+	statsdUnixSocket   = flag.String("statsd_unix_socket", "", "Path of a Unix datagram socket on which to receive StatsD application metrics, attributed to the container of the sending process unless tagged. Empty disables it")
+	statsdContainerTag = flag.String("statsd_container_tag", "container", "Tag of StatsD metrics naming the container, by name or alias, they are attributed to")
+)
+
+// startStatsdListener receives the StatsD metrics of the containers, if
+// enabled.
+func (m *manager) startStatsdListener() error {
+	if *statsdUDPAddress == "" && *statsdUnixSocket == "" {
+		return nil
+	}
+	listener, err := collector.NewStatsdListener(collector.StatsdConfig{
+		UDPAddress:       *statsdUDPAddress,
+		UnixSocket:       *statsdUnixSocket,
+		ContainerTag:     *statsdContainerTag,
+		MetricCountLimit: *applicationMetricsCountLimit,
+		ContainerOfName:  m.containerOfName,
+		ContainerOfPid:   m.containerOfPid,
+	})
+	if err != nil {
+		return err
+	}
+	if err := listener.Start(); err != nil {
+		return err
+	}
+	m.statsdListener = listener
+	return nil
+}
+
+// containerOfName returns the name of the container with a name or alias.
+func (m *manager) containerOfName(name string) (string, bool) {
+	if cont, ok := m.containers.Load(namespacedContainerName{Name: name}); ok {
+		return cont.info.Name, true
+	}
+	var found string
+	m.containers.Range(func(key namespacedContainerName, cont *containerData) bool {
+		if key.Name == name {
+			found = cont.info.Name
+			return false
+		}
+		return true
+	})
+	return found, found != ""
+}
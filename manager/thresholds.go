@@ -0,0 +1,206 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux || windows || freebsd
+
+package manager
+
+import (
+	"flag"
+	"time"
+
+	info "github.com/google/cadvisor/info/v1"
+
+	"k8s.io/klog/v2"
+)
+
+var cpuThrottlingEventThreshold = flag.Float64("cpu_throttling_event_threshold", 0, "Fraction of the CFS periods of a container that are throttled above which a cpuThrottling event is reported, once sustained for threshold_event_window, e.g. 0.25. 0 disables these events")
+var memoryPressureEventThreshold = flag.Float64("memory_pressure_event_threshold", 0, "Percentage of time some tasks of a container stall on memory, from its pressure stall information, above which a memoryPressure event is reported once sustained for threshold_event_window, e.g. 10. 0 disables these events")
+var inodeUsageEventThreshold = flag.Float64("inode_usage_event_threshold", 0, "Percentage of the inodes of the filesystem of the writable layer or of a volume of a container that are used above which an inodeUsage event is reported once sustained for threshold_event_window, e.g. 90. 0 disables these events")
+var thresholdEventWindow = flag.Duration("threshold_event_window", time.Minute, "How long the usage of a container must stay above cpu_throttling_event_threshold, memory_pressure_event_threshold or inode_usage_event_threshold, or back below it, before an event is reported")
+
+// threshold reports when a usage stays above, or back below, a threshold for
+// a sustained window.
+type threshold struct {
+	limit float64
+	// Whether the usage was last reported above the limit.
+	exceeded bool
+	// When the usage crossed to the other side of the limit than reported,
+	// zero while it stays on the reported side.
+	crossed time.Time
+}
+
+// update records the usage at t, and returns whether it has been on the
+// other side of the limit than reported for the window, and for how long.
+func (t *threshold) update(value float64, now time.Time, window time.Duration) (bool, time.Duration) {
+	if (value > t.limit) == t.exceeded {
+		t.crossed = time.Time{}
+		return false, 0
+	}
+	if t.crossed.IsZero() {
+		t.crossed = now
+	}
+	sustained := now.Sub(t.crossed)
+	if sustained < window {
+		return false, 0
+	}
+	t.exceeded = !t.exceeded
+	t.crossed = time.Time{}
+	return true, sustained
+}
+
+// thresholdTracker synthesizes events when the CPU throttling, the memory
+// pressure or the inode usage of the filesystems of a container crosses a
+// threshold.
+type thresholdTracker struct {
+	window         time.Duration
+	cpuThrottling  *threshold
+	memoryPressure *threshold
+	// The inode usage threshold, 0 if disabled, and its state for each
+	// filesystem.
+	inodeUsage  float64
+	inodeUsages map[string]*threshold
+	// Stats seen last, nil before the first stats.
+	last *info.ContainerStats
+}
+
+// newThresholdTracker returns a tracker of the given thresholds, 0 disabling
+// one, or nil if all are disabled.
+func newThresholdTracker(cpuThrottling, memoryPressure, inodeUsage float64, window time.Duration) *thresholdTracker {
+	if cpuThrottling <= 0 && memoryPressure <= 0 && inodeUsage <= 0 {
+		return nil
+	}
+	t := &thresholdTracker{window: window}
+	if cpuThrottling > 0 {
+		t.cpuThrottling = &threshold{limit: cpuThrottling}
+	}
+	if memoryPressure > 0 {
+		t.memoryPressure = &threshold{limit: memoryPressure}
+	}
+	if inodeUsage > 0 {
+		t.inodeUsage = inodeUsage
+		t.inodeUsages = make(map[string]*threshold)
+	}
+	return t
+}
+
+// update compares the usage since the last stats with the thresholds, and
+// returns the events to report.
+func (t *thresholdTracker) update(name string, stats *info.ContainerStats) []*info.Event {
+	last := t.last
+	t.last = stats
+	if last == nil || !stats.Timestamp.After(last.Timestamp) {
+		return nil
+	}
+	var evs []*info.Event
+	check := func(th *threshold, eventType info.EventType, value float64, ok bool, filesystem string) {
+		if th == nil || !ok {
+			return
+		}
+		changed, sustained := th.update(value, stats.Timestamp, t.window)
+		if !changed {
+			return
+		}
+		evs = append(evs, &info.Event{
+			ContainerName: name,
+			Timestamp:     stats.Timestamp,
+			EventType:     eventType,
+			EventData: info.EventData{
+				Threshold: &info.ThresholdEventData{
+					Exceeded:   th.exceeded,
+					Value:      value,
+					Threshold:  th.limit,
+					Duration:   sustained,
+					Filesystem: filesystem,
+				},
+			},
+		})
+	}
+	throttled, ok := throttledRatio(&last.Cpu.CFS, &stats.Cpu.CFS)
+	check(t.cpuThrottling, info.EventCpuThrottling, throttled, ok, "")
+	pressure, ok := stallPercentage(&last.Memory.PSI.Some, &stats.Memory.PSI.Some, stats.Timestamp.Sub(last.Timestamp))
+	check(t.memoryPressure, info.EventMemoryPressure, pressure, ok, "")
+	if t.inodeUsages != nil {
+		usages := inodeUsages(stats)
+		for filesystem, usage := range usages {
+			th, ok := t.inodeUsages[filesystem]
+			if !ok {
+				th = &threshold{limit: t.inodeUsage}
+				t.inodeUsages[filesystem] = th
+			}
+			check(th, info.EventInodeUsage, usage, true, filesystem)
+		}
+		// Forget the filesystems that are no longer reported.
+		for filesystem := range t.inodeUsages {
+			if _, ok := usages[filesystem]; !ok {
+				delete(t.inodeUsages, filesystem)
+			}
+		}
+	}
+	return evs
+}
+
+// inodeUsages returns the percentage of the inodes used on the filesystems of
+// the container, by device for its writable layer and by mountpoint for its
+// volumes.
+func inodeUsages(stats *info.ContainerStats) map[string]float64 {
+	usages := make(map[string]float64, len(stats.Filesystem)+len(stats.Volumes))
+	for _, fs := range stats.Filesystem {
+		if fs.InodesTotal > 0 && fs.InodesFree <= fs.InodesTotal {
+			usages[fs.Device] = 100 * float64(fs.InodesTotal-fs.InodesFree) / float64(fs.InodesTotal)
+		}
+	}
+	for _, v := range stats.Volumes {
+		if v.Inodes > 0 && v.InodesFree <= v.Inodes {
+			usages[v.Mountpoint] = 100 * float64(v.Inodes-v.InodesFree) / float64(v.Inodes)
+		}
+	}
+	return usages
+}
+
+// throttledRatio returns the fraction of the CFS periods between two stats
+// that were throttled, and false if the counters were reset.
+func throttledRatio(last, cur *info.CpuCFS) (float64, bool) {
+	if cur.Periods < last.Periods || cur.ThrottledPeriods < last.ThrottledPeriods {
+		return 0, false
+	}
+	periods := cur.Periods - last.Periods
+	if periods == 0 {
+		return 0, true
+	}
+	return float64(cur.ThrottledPeriods-last.ThrottledPeriods) / float64(periods), true
+}
+
+// stallPercentage returns the percentage of the elapsed time tasks stalled
+// between two pressure stall totals, which are in microseconds.
+func stallPercentage(last, cur *info.PSIData, elapsed time.Duration) (float64, bool) {
+	if cur.Total < last.Total || elapsed <= 0 {
+		return 0, false
+	}
+	stalled := time.Duration(cur.Total-last.Total) * time.Microsecond
+	return 100 * stalled.Seconds() / elapsed.Seconds(), true
+}
+
+// checkThresholds reports the CPU throttling, memory pressure and inode usage
+// events of the container from its latest stats.
+func (cd *containerData) checkThresholds(stats *info.ContainerStats) {
+	if cd.thresholds == nil || cd.eventHandler == nil {
+		return
+	}
+	for _, ev := range cd.thresholds.update(cd.info.Name, stats) {
+		if err := cd.eventHandler.AddEvent(ev); err != nil {
+			klog.Errorf("Failed to add %s event for %q: %v", ev.EventType, cd.info.Name, err)
+		}
+	}
+}
@@ -0,0 +1,143 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package manager
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/google/cadvisor/events"
+	info "github.com/google/cadvisor/info/v1"
+)
+
+func thresholdStats(at time.Time, periods, throttled, memoryStall uint64) *info.ContainerStats {
+	stats := &info.ContainerStats{Timestamp: at}
+	stats.Cpu.CFS = info.CpuCFS{Periods: periods, ThrottledPeriods: throttled}
+	stats.Memory.PSI.Some.Total = memoryStall
+	return stats
+}
+
+func TestThresholdTrackerCPUThrottling(t *testing.T) {
+	tracker := newThresholdTracker(0.5, 0, 0, 30*time.Second)
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	var periods, throttled uint64
+	var evs []*info.Event
+	step := func(n int, ratio uint64) {
+		for i := 0; i < n; i++ {
+			periods += 100
+			throttled += ratio
+			start = start.Add(10 * time.Second)
+			evs = append(evs, tracker.update("/a", thresholdStats(start, periods, throttled, 0))...)
+		}
+	}
+
+	step(1, 0)
+	// A short burst is not reported.
+	step(2, 80)
+	step(1, 10)
+	assert.Empty(t, evs)
+
+	// Throttling sustained for the window is.
+	step(4, 80)
+	require.Len(t, evs, 1)
+	assert.Equal(t, info.EventCpuThrottling, evs[0].EventType)
+	assert.Equal(t, "/a", evs[0].ContainerName)
+	assert.Equal(t, &info.ThresholdEventData{Exceeded: true, Value: 0.8, Threshold: 0.5, Duration: 30 * time.Second}, evs[0].EventData.Threshold)
+
+	// The recovery is reported once sustained too.
+	step(3, 0)
+	require.Len(t, evs, 1)
+	step(1, 0)
+	require.Len(t, evs, 2)
+	assert.False(t, evs[1].EventData.Threshold.Exceeded)
+	assert.Equal(t, 0.0, evs[1].EventData.Threshold.Value)
+}
+
+func TestThresholdTrackerMemoryPressure(t *testing.T) {
+	tracker := newThresholdTracker(0, 10, 0, 0)
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	assert.Empty(t, tracker.update("/a", thresholdStats(start, 0, 0, 1000)))
+	// 2s of stall over 10s, in microseconds.
+	evs := tracker.update("/a", thresholdStats(start.Add(10*time.Second), 0, 0, 2001000))
+	require.Len(t, evs, 1)
+	assert.Equal(t, info.EventMemoryPressure, evs[0].EventType)
+	assert.True(t, evs[0].EventData.Threshold.Exceeded)
+	assert.InDelta(t, 20.0, evs[0].EventData.Threshold.Value, 0.001)
+
+	// Reset counters are skipped.
+	assert.Empty(t, tracker.update("/a", thresholdStats(start.Add(20*time.Second), 0, 0, 0)))
+	evs = tracker.update("/a", thresholdStats(start.Add(30*time.Second), 0, 0, 0))
+	require.Len(t, evs, 1)
+	assert.False(t, evs[0].EventData.Threshold.Exceeded)
+}
+
+func TestThresholdTrackerInodeUsage(t *testing.T) {
+	tracker := newThresholdTracker(0, 0, 90, 0)
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	inodeStats := func(at time.Time, layerFree, volumeFree uint64) *info.ContainerStats {
+		stats := &info.ContainerStats{Timestamp: at}
+		stats.Filesystem = []info.FsStats{{Device: "/dev/sda1", InodesTotal: 1000, InodesFree: layerFree}}
+		stats.Volumes = []info.VolumeStats{{Mountpoint: "/data", Inodes: 100, InodesFree: volumeFree}}
+		return stats
+	}
+
+	assert.Empty(t, tracker.update("/a", inodeStats(start, 500, 50)))
+	evs := tracker.update("/a", inodeStats(start.Add(10*time.Second), 500, 5))
+	require.Len(t, evs, 1)
+	assert.Equal(t, info.EventInodeUsage, evs[0].EventType)
+	assert.Equal(t, &info.ThresholdEventData{Exceeded: true, Value: 95, Threshold: 90, Filesystem: "/data"}, evs[0].EventData.Threshold)
+
+	evs = tracker.update("/a", inodeStats(start.Add(20*time.Second), 50, 5))
+	require.Len(t, evs, 1)
+	assert.Equal(t, "/dev/sda1", evs[0].EventData.Threshold.Filesystem)
+	assert.InDelta(t, 95.0, evs[0].EventData.Threshold.Value, 0.001)
+
+	evs = tracker.update("/a", inodeStats(start.Add(30*time.Second), 50, 90))
+	require.Len(t, evs, 1)
+	assert.Equal(t, "/data", evs[0].EventData.Threshold.Filesystem)
+	assert.False(t, evs[0].EventData.Threshold.Exceeded)
+}
+
+func TestNewThresholdTrackerDisabled(t *testing.T) {
+	assert.Nil(t, newThresholdTracker(0, 0, 0, time.Minute))
+}
+
+func TestUpdateStatsReportsThresholdEvents(t *testing.T) {
+	cd, mockHandler, _, _ := newTestContainerData(t)
+	eventManager := events.NewEventManager(events.DefaultStoragePolicy())
+	cd.eventHandler = eventManager
+	cd.thresholds = newThresholdTracker(0.5, 0, 0, 0)
+	mockHandler.On("ContainerReference").Return(info.ContainerReference{Name: containerName}, nil)
+	start := time.Now()
+	mockHandler.On("GetStats").Return(thresholdStats(start, 100, 0, 0), nil).Once()
+	mockHandler.On("GetStats").Return(thresholdStats(start.Add(time.Second), 200, 90, 0), nil).Once()
+
+	require.NoError(t, cd.updateStats(context.Background()))
+	require.NoError(t, cd.updateStats(context.Background()))
+
+	request := events.NewRequest()
+	request.EventType[info.EventCpuThrottling] = true
+	evs, err := eventManager.GetEvents(request)
+	require.NoError(t, err)
+	require.Len(t, evs, 1)
+	assert.Equal(t, containerName, evs[0].ContainerName)
+	assert.InDelta(t, 0.9, evs[0].EventData.Threshold.Value, 0.001)
+}
@@ -0,0 +1,133 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux || windows || freebsd
+
+package manager
+
+import (
+	"flag"
+	"fmt"
+	"time"
+
+	"k8s.io/klog/v2"
+	"k8s.io/utils/clock"
+
+	"github.com/google/cadvisor/metrics/selfmetrics"
+)
+
+var housekeepingWatchdogTimeout = flag.Duration("housekeeping_watchdog_timeout", 5*time.Minute, "How long the housekeeping of a container may be blocked, e.g. on a hung filesystem or runtime, before its collection is restarted. 0 disables the watchdog")
+
+// housekeepingWatchdog restarts the collection of containers whose
+// housekeeping has been blocked for longer than the timeout. Go cannot
+// interrupt a blocked system call, so the blocked housekeeping is abandoned:
+// it finishes in the background whenever it returns, and its stats are
+// dropped. A container is not restarted again while the housekeeping
+// abandoned for it is still blocked, so a container that blocks every time
+// costs at most two goroutines.
+type housekeepingWatchdog struct {
+	timeout time.Duration
+	clock   clock.Clock
+	restart func(*containerData) error
+
+	// Containers whose blocked housekeeping was abandoned, until it returns.
+	abandoned map[*containerData]struct{}
+}
+
+func newHousekeepingWatchdog(timeout time.Duration, clock clock.Clock, restart func(*containerData) error) *housekeepingWatchdog {
+	return &housekeepingWatchdog{
+		timeout:   timeout,
+		clock:     clock,
+		restart:   restart,
+		abandoned: make(map[*containerData]struct{}),
+	}
+}
+
+// check restarts the collection of the containers blocked for longer than
+// the timeout.
+func (w *housekeepingWatchdog) check(containers []*containerData) {
+	now := w.clock.Now()
+	blocked := make(map[string]bool)
+	for cd := range w.abandoned {
+		if cd.housekeepingBlocked(now) == 0 {
+			klog.V(2).Infof("Abandoned housekeeping of container %q returned", cd.info.Name)
+			delete(w.abandoned, cd)
+			continue
+		}
+		blocked[cd.info.Name] = true
+	}
+	for _, cd := range containers {
+		duration := cd.housekeepingBlocked(now)
+		if duration < w.timeout {
+			continue
+		}
+		if blocked[cd.info.Name] {
+			klog.V(2).Infof("Housekeeping of container %q has been blocked for %v, not restarting it while the previous one is still blocked", cd.info.Name, duration)
+			continue
+		}
+		klog.Warningf("Housekeeping of container %q has been blocked for %v, restarting its collection", cd.info.Name, duration)
+		w.abandoned[cd] = struct{}{}
+		selfmetrics.HousekeepingRestarts.Inc()
+		if err := w.restart(cd); err != nil {
+			klog.Errorf("Failed to restart the collection of container %q: %v", cd.info.Name, err)
+		}
+	}
+}
+
+// watchHousekeeping runs the housekeeping watchdog until quit.
+func (m *manager) watchHousekeeping(quit chan error) {
+	watchdog := newHousekeepingWatchdog(*housekeepingWatchdogTimeout, clock.RealClock{}, m.restartContainer)
+	ticker := time.NewTicker(max(*housekeepingWatchdogTimeout/4, time.Second))
+	for {
+		select {
+		case <-ticker.C:
+			var containers []*containerData
+			m.containers.Range(func(name namespacedContainerName, cont *containerData) bool {
+				// Skip the aliases.
+				if cont != nil && cont.info.Name == name.Name {
+					containers = append(containers, cont)
+				}
+				return true
+			})
+			watchdog.check(containers)
+		case <-quit:
+			ticker.Stop()
+			quit <- nil
+			return
+		}
+	}
+}
+
+// globalHousekeepingStallIntervals is how many global housekeeping intervals
+// may pass without one completing before the housekeeping is reported as
+// stalled.
+const globalHousekeepingStallIntervals = 3
+
+func (m *manager) CheckHousekeeping() error {
+	if m.globalHousekeepingTime.Load() == 0 {
+		// Housekeeping has not started, or there is none to run.
+		return nil
+	}
+	return checkGlobalHousekeeping(m.globalHousekeepingTime.Time(), time.Now(), *globalHousekeepingInterval)
+}
+
+// checkGlobalHousekeeping returns an error if the global housekeeping last
+// completed at last has failed to complete again by now within the allowed
+// number of intervals.
+func checkGlobalHousekeeping(last, now time.Time, interval time.Duration) error {
+	if since := now.Sub(last); since > globalHousekeepingStallIntervals*interval {
+		return fmt.Errorf("global housekeeping has not completed for %v", since.Truncate(time.Second))
+	}
+	return nil
+}
@@ -0,0 +1,105 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package manager
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	clock "k8s.io/utils/clock/testing"
+
+	itest "github.com/google/cadvisor/info/v1/test"
+)
+
+func TestHousekeepingWatchdog(t *testing.T) {
+	cd, mockHandler, memoryCache, fakeClock := newTestContainerData(t)
+	stats := itest.GenerateRandomStats(1, 4, time.Second)[0]
+	entered := make(chan struct{})
+	release := make(chan struct{})
+	mockHandler.On("GetStats").Run(func(mock.Arguments) {
+		close(entered)
+		<-release
+	}).Return(stats, nil)
+
+	var restarted []*containerData
+	watchdog := newHousekeepingWatchdog(time.Minute, fakeClock, func(cd *containerData) error {
+		restarted = append(restarted, cd)
+		cd.halt()
+		return nil
+	})
+
+	done := make(chan struct{})
+	go func() {
+		cd.housekeepOnce(testLongHousekeeping)
+		close(done)
+	}()
+	<-entered
+
+	// Housekeeping that is not blocked for long is left alone.
+	fakeClock.Step(30 * time.Second)
+	watchdog.check([]*containerData{cd})
+	assert.Empty(t, restarted)
+
+	fakeClock.Step(time.Minute)
+	watchdog.check([]*containerData{cd})
+	require.Len(t, restarted, 1)
+	assert.Same(t, cd, restarted[0])
+
+	// A replacement blocked as well is not restarted again while the
+	// abandoned housekeeping is still blocked.
+	replacement, _, _, _ := newTestContainerData(t)
+	replacement.housekeepingSince.Store(fakeClock.Now().Add(-2 * time.Minute).UnixNano())
+	watchdog.check([]*containerData{replacement})
+	assert.Len(t, restarted, 1)
+
+	// The stats of the abandoned housekeeping are dropped.
+	close(release)
+	<-done
+	_, err := memoryCache.RecentStats(containerName, time.Time{}, time.Time{}, -1)
+	assert.Error(t, err, "stats of the abandoned housekeeping were cached")
+	assert.Zero(t, cd.housekeepingBlocked(fakeClock.Now()))
+
+	watchdog.check([]*containerData{replacement})
+	assert.Len(t, restarted, 2)
+	assert.NotContains(t, watchdog.abandoned, cd)
+}
+
+func TestHousekeepingSchedulerReplaceWorker(t *testing.T) {
+	s := newHousekeepingScheduler(1, clock.NewFakeClock(time.Now()))
+	defer s.Stop()
+	s.replaceWorker()
+	assert.True(t, s.retire())
+	assert.False(t, s.retire())
+}
+
+func TestCheckGlobalHousekeeping(t *testing.T) {
+	last := time.Unix(1000, 0)
+	assert.NoError(t, checkGlobalHousekeeping(last, last.Add(time.Minute), time.Minute))
+	assert.NoError(t, checkGlobalHousekeeping(last, last.Add(3*time.Minute), time.Minute))
+	assert.EqualError(t, checkGlobalHousekeeping(last, last.Add(3*time.Minute+1500*time.Millisecond), time.Minute), "global housekeeping has not completed for 3m1s")
+
+	m := &manager{}
+	assert.NoError(t, m.CheckHousekeeping(), "housekeeping has not started")
+	m.globalHousekeepingTime.Store(time.Now().Add(-time.Hour).UnixNano())
+	assert.Error(t, m.CheckHousekeeping())
+	m.globalHousekeepingTime.Store(time.Now().UnixNano())
+	assert.NoError(t, m.CheckHousekeeping())
+}
@@ -17,6 +17,7 @@ package metrics
 import (
 	"fmt"
 	"regexp"
+	"slices"
 	"strconv"
 	"time"
 
@@ -33,6 +34,14 @@ import (
 // asFloat64 converts a uint64 into a float64.
 func asFloat64(v uint64) float64 { return float64(v) }
 
+// boolValue converts a bool into 1 if true and 0 otherwise.
+func boolValue(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
+
 // asMicrosecondsToSeconds converts nanoseconds into a float64 representing seconds.
 func asMicrosecondsToSeconds(v uint64) float64 {
 	return float64(v) / 1e6
@@ -56,6 +65,92 @@ func fsValues(fsStats []info.FsStats, valueFn func(*info.FsStats) float64, times
 	return values
 }
 
+// volumeValues is a helper method for assembling per-volume stats.
+func volumeValues(stats []info.VolumeStats, valueFn func(*info.VolumeStats) float64, timestamp time.Time) metricValues {
+	values := make(metricValues, 0, len(stats))
+	for i := range stats {
+		values = append(values, metricValue{
+			value:     valueFn(&stats[i]),
+			labels:    []string{stats[i].Mountpoint, stats[i].Device, stats[i].Type},
+			timestamp: timestamp,
+		})
+	}
+	return values
+}
+
+// networkFsValues is a helper method for assembling per-mount stats of network
+// filesystems.
+func networkFsValues(stats []info.NetworkFsStats, valueFn func(*info.NetworkFsStats) float64, timestamp time.Time) metricValues {
+	values := make(metricValues, 0, len(stats))
+	for i := range stats {
+		values = append(values, metricValue{
+			value:     valueFn(&stats[i]),
+			labels:    []string{stats[i].Device, stats[i].Mountpoint, stats[i].Type},
+			timestamp: timestamp,
+		})
+	}
+	return values
+}
+
+// topProcessValues is a helper method for assembling per-process stats of the
+// top processes. The processes are labelled with their rank by decreasing
+// value rather than their pid, which would make a new series for every
+// process that ever made it to the top.
+func topProcessValues(stats []info.ProcessUsage, valueFn func(*info.ProcessUsage) float64, timestamp time.Time) metricValues {
+	values := make(metricValues, 0, len(stats))
+	for i := range stats {
+		values = append(values, metricValue{
+			value:     valueFn(&stats[i]),
+			labels:    []string{"", stats[i].Command},
+			timestamp: timestamp,
+		})
+	}
+	// The processes are ordered by pid, which breaks ties.
+	slices.SortStableFunc(values, func(a, b metricValue) int {
+		switch {
+		case a.value > b.value:
+			return -1
+		case a.value < b.value:
+			return 1
+		}
+		return 0
+	})
+	for i := range values {
+		values[i].labels[0] = strconv.Itoa(i + 1)
+	}
+	return values
+}
+
+// acceleratorValues is a helper method for assembling per-accelerator stats.
+func acceleratorValues(stats []info.AcceleratorStats, valueFn func(*info.AcceleratorStats) float64, timestamp time.Time) metricValues {
+	values := make(metricValues, 0, len(stats))
+	for i := range stats {
+		values = append(values, metricValue{
+			value:     valueFn(&stats[i]),
+			labels:    []string{stats[i].Make, stats[i].Model, stats[i].ID, stats[i].GPUInstance},
+			timestamp: timestamp,
+		})
+	}
+	return values
+}
+
+// networkFsOperationValues is a helper method for assembling per-operation
+// stats of network filesystems.
+func networkFsOperationValues(stats []info.NetworkFsStats, valueFn func(*info.NetworkFsOperationStats) float64, timestamp time.Time) metricValues {
+	var values metricValues
+	for i := range stats {
+		for j := range stats[i].Operations {
+			op := &stats[i].Operations[j]
+			values = append(values, metricValue{
+				value:     valueFn(op),
+				labels:    []string{stats[i].Device, stats[i].Mountpoint, stats[i].Type, op.Name},
+				timestamp: timestamp,
+			})
+		}
+	}
+	return values
+}
+
 // ioValues is a helper method for assembling per-disk and per-filesystem stats.
 func ioValues(ioStats []info.PerDiskStats, ioType string, ioValueFn func(uint64) float64,
 	fsStats []info.FsStats, valueFn func(*info.FsStats) float64, timestamp time.Time) metricValues {
@@ -580,6 +675,16 @@ func NewPrometheusCollector(i infoProvider, f ContainerLabelsFunc, includedMetri
 						return float64(fs.Inodes)
 					}, s.Timestamp)
 				},
+			}, {
+				name:        "container_fs_inodes_used",
+				help:        "Number of Inodes used by the container on this filesystem.",
+				valueType:   prometheus.GaugeValue,
+				extraLabels: []string{"device"},
+				getValues: func(s *info.ContainerStats) metricValues {
+					return fsValues(s.Filesystem, func(fs *info.FsStats) float64 {
+						return float64(fs.InodesUsed)
+					}, s.Timestamp)
+				},
 			}, {
 				name:        "container_fs_limit_bytes",
 				help:        "Number of bytes that can be consumed by the container on this filesystem.",
@@ -600,6 +705,26 @@ func NewPrometheusCollector(i infoProvider, f ContainerLabelsFunc, includedMetri
 						return float64(fs.Usage)
 					}, s.Timestamp)
 				},
+			}, {
+				name:        "container_volume_inodes_free",
+				help:        "Number of available Inodes on the filesystem of a volume of the container.",
+				valueType:   prometheus.GaugeValue,
+				extraLabels: []string{"mountpoint", "device", "fstype"},
+				getValues: func(s *info.ContainerStats) metricValues {
+					return volumeValues(s.Volumes, func(v *info.VolumeStats) float64 {
+						return float64(v.InodesFree)
+					}, s.Timestamp)
+				},
+			}, {
+				name:        "container_volume_inodes_used",
+				help:        "Number of Inodes used on the filesystem of a volume of the container.",
+				valueType:   prometheus.GaugeValue,
+				extraLabels: []string{"mountpoint", "device", "fstype"},
+				getValues: func(s *info.ContainerStats) metricValues {
+					return volumeValues(s.Volumes, func(v *info.VolumeStats) float64 {
+						return float64(v.Inodes - v.InodesFree)
+					}, s.Timestamp)
+				},
 			},
 		}...)
 	}
@@ -1669,6 +1794,32 @@ func NewPrometheusCollector(i infoProvider, f ContainerLabelsFunc, includedMetri
 			},
 		}...)
 	}
+	if includedMetrics.Has(container.TopProcessMetrics) {
+		topProcessLabels := []string{"rank", "command"}
+		c.containerMetrics = append(c.containerMetrics, []containerMetric{
+			{
+				name:        "container_top_process_cpu_usage_seconds_total",
+				help:        "Cumulative CPU time consumed by the process of the given rank by CPU time among the processes of the container using the most CPU or memory.",
+				valueType:   prometheus.CounterValue,
+				extraLabels: topProcessLabels,
+				getValues: func(s *info.ContainerStats) metricValues {
+					return topProcessValues(s.TopProcesses, func(p *info.ProcessUsage) float64 {
+						return float64(p.CpuUsage) / float64(time.Second)
+					}, s.Timestamp)
+				},
+			}, {
+				name:        "container_top_process_memory_rss_bytes",
+				help:        "Resident memory of the process of the given rank by resident memory among the processes of the container using the most CPU or memory.",
+				valueType:   prometheus.GaugeValue,
+				extraLabels: topProcessLabels,
+				getValues: func(s *info.ContainerStats) metricValues {
+					return topProcessValues(s.TopProcesses, func(p *info.ProcessUsage) float64 {
+						return float64(p.RSS)
+					}, s.Timestamp)
+				},
+			},
+		}...)
+	}
 	if includedMetrics.Has(container.PerfMetrics) {
 		if includedMetrics.Has(container.PerCpuUsageMetrics) {
 			c.containerMetrics = append(c.containerMetrics, []containerMetric{
@@ -1689,6 +1840,15 @@ func NewPrometheusCollector(i infoProvider, f ContainerLabelsFunc, includedMetri
 					getValues: func(s *info.ContainerStats) metricValues {
 						return getPerCPUCoreScalingRatio(s)
 					},
+				},
+				{
+					name:        "container_perf_events_unreliable",
+					help:        "Whether the perf event metric was measured too short a part of the time it was enabled to be trusted, 1 if so.",
+					valueType:   prometheus.GaugeValue,
+					extraLabels: []string{"cpu", "event"},
+					getValues: func(s *info.ContainerStats) metricValues {
+						return getPerCPUCoreUnreliable(s)
+					},
 				}}...)
 		} else {
 			c.containerMetrics = append(c.containerMetrics, []containerMetric{
@@ -1709,6 +1869,15 @@ func NewPrometheusCollector(i infoProvider, f ContainerLabelsFunc, includedMetri
 					getValues: func(s *info.ContainerStats) metricValues {
 						return getMinCoreScalingRatio(s)
 					},
+				},
+				{
+					name:        "container_perf_events_unreliable",
+					help:        "Whether the perf event metric was measured too short a part of the time it was enabled to be trusted, 1 if so.",
+					valueType:   prometheus.GaugeValue,
+					extraLabels: []string{"cpu", "event"},
+					getValues: func(s *info.ContainerStats) metricValues {
+						return getAggregatedCoreUnreliable(s)
+					},
 				}}...)
 		}
 		c.containerMetrics = append(c.containerMetrics, []containerMetric{
@@ -1746,6 +1915,23 @@ func NewPrometheusCollector(i infoProvider, f ContainerLabelsFunc, includedMetri
 					return values
 				},
 			},
+			{
+				name:        "container_perf_uncore_events_unreliable",
+				help:        "Whether the perf uncore event metric was measured too short a part of the time it was enabled to be trusted, 1 if so.",
+				valueType:   prometheus.GaugeValue,
+				extraLabels: []string{"socket", "event", "pmu"},
+				getValues: func(s *info.ContainerStats) metricValues {
+					values := make(metricValues, 0, len(s.PerfUncoreStats))
+					for _, metric := range s.PerfUncoreStats {
+						values = append(values, metricValue{
+							value:     boolValue(metric.Unreliable),
+							labels:    []string{strconv.Itoa(metric.Socket), metric.Name, metric.PMU},
+							timestamp: s.Timestamp,
+						})
+					}
+					return values
+				},
+			},
 		}...)
 	}
 	if includedMetrics.Has(container.ReferencedMemoryMetrics) {
@@ -1760,6 +1946,141 @@ func NewPrometheusCollector(i infoProvider, f ContainerLabelsFunc, includedMetri
 			},
 		}...)
 	}
+	if includedMetrics.Has(container.DaxMemoryMetrics) {
+		c.containerMetrics = append(c.containerMetrics, []containerMetric{
+			{
+				name:      "container_memory_dax_mapped_bytes",
+				help:      "Size of the device DAX (persistent memory) mappings of the processes of the container.",
+				valueType: prometheus.GaugeValue,
+				getValues: func(s *info.ContainerStats) metricValues {
+					return metricValues{{value: float64(s.DaxMemory), timestamp: s.Timestamp}}
+				},
+			},
+		}...)
+	}
+	if includedMetrics.Has(container.AcceleratorUsageMetrics) {
+		acceleratorLabels := []string{"make", "model", "acc_id", "gpu_instance"}
+		c.containerMetrics = append(c.containerMetrics, []containerMetric{
+			{
+				name:        "container_accelerator_memory_total_bytes",
+				help:        "Total accelerator memory.",
+				valueType:   prometheus.GaugeValue,
+				extraLabels: acceleratorLabels,
+				getValues: func(s *info.ContainerStats) metricValues {
+					return acceleratorValues(s.Accelerators, func(a *info.AcceleratorStats) float64 {
+						return float64(a.MemoryTotal)
+					}, s.Timestamp)
+				},
+			}, {
+				name:        "container_accelerator_memory_used_bytes",
+				help:        "Total accelerator memory allocated.",
+				valueType:   prometheus.GaugeValue,
+				extraLabels: acceleratorLabels,
+				getValues: func(s *info.ContainerStats) metricValues {
+					return acceleratorValues(s.Accelerators, func(a *info.AcceleratorStats) float64 {
+						return float64(a.MemoryUsed)
+					}, s.Timestamp)
+				},
+			}, {
+				name:        "container_accelerator_duty_cycle",
+				help:        "Percent of time over the past sample period during which the accelerator was actively processing.",
+				valueType:   prometheus.GaugeValue,
+				extraLabels: acceleratorLabels,
+				getValues: func(s *info.ContainerStats) metricValues {
+					return acceleratorValues(s.Accelerators, func(a *info.AcceleratorStats) float64 {
+						return float64(a.DutyCycle)
+					}, s.Timestamp)
+				},
+			}, {
+				name:        "container_accelerator_sm_occupancy",
+				help:        "Percent of the warps the streaming multiprocessors of the accelerator can hold that were resident over the past sample period.",
+				valueType:   prometheus.GaugeValue,
+				extraLabels: acceleratorLabels,
+				getValues: func(s *info.ContainerStats) metricValues {
+					return acceleratorValues(s.Accelerators, func(a *info.AcceleratorStats) float64 {
+						return float64(a.SMOccupancy)
+					}, s.Timestamp)
+				},
+			},
+		}...)
+	}
+	if includedMetrics.Has(container.NetworkFsMetrics) {
+		mountLabels := []string{"device", "mountpoint", "fstype"}
+		operationLabels := []string{"device", "mountpoint", "fstype", "operation"}
+		c.containerMetrics = append(c.containerMetrics, []containerMetric{
+			{
+				name:        "container_network_fs_read_bytes_total",
+				help:        "Cumulative count of bytes read from the server of a network filesystem mount.",
+				valueType:   prometheus.CounterValue,
+				extraLabels: mountLabels,
+				getValues: func(s *info.ContainerStats) metricValues {
+					return networkFsValues(s.NetworkFs, func(nfs *info.NetworkFsStats) float64 {
+						return float64(nfs.ReadBytes)
+					}, s.Timestamp)
+				},
+			}, {
+				name:        "container_network_fs_write_bytes_total",
+				help:        "Cumulative count of bytes written to the server of a network filesystem mount.",
+				valueType:   prometheus.CounterValue,
+				extraLabels: mountLabels,
+				getValues: func(s *info.ContainerStats) metricValues {
+					return networkFsValues(s.NetworkFs, func(nfs *info.NetworkFsStats) float64 {
+						return float64(nfs.WriteBytes)
+					}, s.Timestamp)
+				},
+			}, {
+				name:        "container_network_fs_operations_total",
+				help:        "Cumulative count of operations sent to the server of a network filesystem mount.",
+				valueType:   prometheus.CounterValue,
+				extraLabels: operationLabels,
+				getValues: func(s *info.ContainerStats) metricValues {
+					return networkFsOperationValues(s.NetworkFs, func(op *info.NetworkFsOperationStats) float64 {
+						return float64(op.Count)
+					}, s.Timestamp)
+				},
+			}, {
+				name:        "container_network_fs_operation_errors_total",
+				help:        "Cumulative count of failed operations of a network filesystem mount.",
+				valueType:   prometheus.CounterValue,
+				extraLabels: operationLabels,
+				getValues: func(s *info.ContainerStats) metricValues {
+					return networkFsOperationValues(s.NetworkFs, func(op *info.NetworkFsOperationStats) float64 {
+						return float64(op.Errors)
+					}, s.Timestamp)
+				},
+			}, {
+				name:        "container_network_fs_operation_timeouts_total",
+				help:        "Cumulative count of timed out operations of a network filesystem mount.",
+				valueType:   prometheus.CounterValue,
+				extraLabels: operationLabels,
+				getValues: func(s *info.ContainerStats) metricValues {
+					return networkFsOperationValues(s.NetworkFs, func(op *info.NetworkFsOperationStats) float64 {
+						return float64(op.Timeouts)
+					}, s.Timestamp)
+				},
+			}, {
+				name:        "container_network_fs_operation_rtt_seconds_total",
+				help:        "Cumulative time spent waiting for the server to reply to the operations of a network filesystem mount.",
+				valueType:   prometheus.CounterValue,
+				extraLabels: operationLabels,
+				getValues: func(s *info.ContainerStats) metricValues {
+					return networkFsOperationValues(s.NetworkFs, func(op *info.NetworkFsOperationStats) float64 {
+						return float64(op.RTT) / 1000
+					}, s.Timestamp)
+				},
+			}, {
+				name:        "container_network_fs_operation_duration_seconds_total",
+				help:        "Cumulative time spent doing the operations of a network filesystem mount.",
+				valueType:   prometheus.CounterValue,
+				extraLabels: operationLabels,
+				getValues: func(s *info.ContainerStats) metricValues {
+					return networkFsOperationValues(s.NetworkFs, func(op *info.NetworkFsOperationStats) float64 {
+						return float64(op.ExecuteTime) / 1000
+					}, s.Timestamp)
+				},
+			},
+		}...)
+	}
 	if includedMetrics.Has(container.ResctrlMetrics) {
 		c.containerMetrics = append(c.containerMetrics, []containerMetric{
 			{
@@ -2054,8 +2375,19 @@ func (c *PrometheusCollector) collectContainersInfo(ch chan<- prometheus.Metric)
 					copy(clabels, labels)
 					copy(cvalues, values)
 					for label, value := range metric.Labels {
-						clabels = append(clabels, sanitizeLabelName("app_"+label))
-						cvalues = append(cvalues, value)
+						if !metric.HonorLabels {
+							clabels = append(clabels, sanitizeLabelName("app_"+label))
+							cvalues = append(cvalues, value)
+							continue
+						}
+						// The scraped label replaces the container label it conflicts with.
+						name := sanitizeLabelName(label)
+						if i := slices.Index(clabels, name); i >= 0 {
+							cvalues[i] = value
+						} else {
+							clabels = append(clabels, name)
+							cvalues = append(cvalues, value)
+						}
 					}
 					desc := prometheus.NewDesc(metricLabel, "Custom application metric.", clabels, nil)
 					ch <- prometheus.MustNewConstMetric(desc, prometheus.GaugeValue, float64(metric.FloatValue), cvalues...)
@@ -2170,6 +2502,36 @@ func getMinCoreScalingRatio(s *info.ContainerStats) metricValues {
 	return values
 }
 
+func getPerCPUCoreUnreliable(s *info.ContainerStats) metricValues {
+	values := make(metricValues, 0, len(s.PerfStats))
+	for _, metric := range s.PerfStats {
+		values = append(values, metricValue{
+			value:     boolValue(metric.Unreliable),
+			labels:    []string{strconv.Itoa(metric.Cpu), metric.Name},
+			timestamp: s.Timestamp,
+		})
+	}
+	return values
+}
+
+// getAggregatedCoreUnreliable flags the events unreliable on any CPU, whose
+// aggregated values are then unreliable too.
+func getAggregatedCoreUnreliable(s *info.ContainerStats) metricValues {
+	values := make(metricValues, 0)
+	perfEventUnreliable := make(map[string]bool)
+	for _, perfStat := range s.PerfStats {
+		perfEventUnreliable[perfStat.Name] = perfEventUnreliable[perfStat.Name] || perfStat.Unreliable
+	}
+	for perfEvent, unreliable := range perfEventUnreliable {
+		values = append(values, metricValue{
+			value:     boolValue(unreliable),
+			labels:    []string{"", perfEvent},
+			timestamp: s.Timestamp,
+		})
+	}
+	return values
+}
+
 func getContainerHealthState(s *info.ContainerStats) metricValues {
 	value := float64(0)
 	switch s.Health.Status {
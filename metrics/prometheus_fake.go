@@ -34,6 +34,46 @@ func (p testSubcontainersInfoProvider) GetVersionInfo() (*info.VersionInfo, erro
 	}, nil
 }
 
+func (p testSubcontainersInfoProvider) GetUncoreTraffic() (*info.UncoreTraffic, error) {
+	return &info.UncoreTraffic{
+		Timestamp: time.Unix(1395066363, 0),
+		Memory: []info.TrafficCounter{
+			{Socket: 0, Channel: "0", Direction: "read", Bytes: 640},
+			{Socket: 0, Channel: "0", Direction: "write", Bytes: 128},
+			{Socket: 1, Channel: "0", Direction: "read", Bytes: 320},
+		},
+		Interconnect: []info.TrafficCounter{
+			{Socket: 0, Channel: "0", Direction: "transmit", Bytes: 1024},
+			{Socket: 0, Channel: "0", Direction: "receive", Bytes: 2048},
+		},
+	}, nil
+}
+
+func (p testSubcontainersInfoProvider) GetImageStorage() ([]info.ImageStorage, error) {
+	return []info.ImageStorage{
+		{
+			Runtime:             "docker",
+			Timestamp:           time.Unix(1395066363, 0),
+			ImagesBytes:         300,
+			WritableLayersBytes: 20,
+			Pulls:               3,
+			Images: []info.ImageUsage{
+				{ID: "sha256:1", RepoTags: []string{"busybox:latest"}, Size: 200, SharedSize: 100, Containers: 2},
+				{ID: "sha256:2", Size: 200, SharedSize: 100},
+			},
+		},
+		{
+			Runtime:     "containerd",
+			Namespace:   "k8s.io",
+			Timestamp:   time.Unix(1395066363, 0),
+			ImagesBytes: 100,
+			Pulls:       2,
+			TimedPulls:  1,
+			PullSeconds: 4.5,
+		},
+	}, nil
+}
+
 func (p testSubcontainersInfoProvider) GetMachineInfo() (*info.MachineInfo, error) {
 	return &info.MachineInfo{
 		Timestamp:        time.Unix(1395066363, 0),
@@ -41,6 +81,27 @@ func (p testSubcontainersInfoProvider) GetMachineInfo() (*info.MachineInfo, erro
 		NumPhysicalCores: 1,
 		NumSockets:       1,
 		MemoryCapacity:   1024,
+		CloudProvider:    info.GCE,
+		InstanceType:     "n2-standard-4",
+		InstanceID:       "1234567890",
+		Zone:             "us-central1-a",
+		DiskMap: map[string]info.DiskInfo{
+			"8:0":   {Name: "sda", Major: 8, Minor: 0, Rotational: true, Health: "passed", Temperature: 34},
+			"8:16":  {Name: "sdb", Major: 8, Minor: 16, Rotational: true, Health: "failed"},
+			"252:0": {Name: "vda", Major: 252, Minor: 0},
+		},
+		ThinPools: []info.ThinPoolInfo{
+			{Name: "docker-thinpool", TransactionID: 12, DataUsed: 163840, DataTotal: 1638400, MetadataUsed: 131072, MetadataTotal: 524288, Mode: "rw"},
+			{Name: "vg0-broken-tpool", Mode: "fail"},
+		},
+		VolumeGroups: []info.VolumeGroupInfo{
+			{Name: "vg0", Size: 107369988096, Free: 21474836480, LogicalVolumes: 3, PhysicalVolumes: 1},
+		},
+		NetworkDevices: []info.NetInfo{
+			{Name: "eth0", Speed: 10000, Mtu: 1500, Duplex: "full", Driver: "ixgbe"},
+			// Speed is unknown.
+			{Name: "wlan0", Speed: -1, Mtu: 1500},
+		},
 		MemoryByType: map[string]*info.MemoryInfo{
 			"Non-volatile-RAM": {Capacity: 2168421613568, DimmCount: 8},
 			"Unbuffered-DDR4":  {Capacity: 412316860416, DimmCount: 12},
@@ -163,12 +224,14 @@ func (p testSubcontainersInfoProvider) GetMachineInfo() (*info.MachineInfo, erro
 				Memory: 33604804606,
 				HugePages: []info.HugePagesInfo{
 					{
-						PageSize: uint64(1048576),
-						NumPages: uint64(2),
+						PageSize:  uint64(1048576),
+						NumPages:  uint64(2),
+						FreePages: uint64(1),
 					},
 					{
-						PageSize: uint64(2048),
-						NumPages: uint64(4),
+						PageSize:  uint64(2048),
+						NumPages:  uint64(4),
+						FreePages: uint64(3),
 					},
 				},
 				Cores: []info.Core{
@@ -624,6 +687,7 @@ func (p testSubcontainersInfoProvider) GetRequestedContainersInfo(string, v2.Req
 							Device:          "sda1",
 							InodesFree:      524288,
 							Inodes:          2097152,
+							InodesUsed:      1572864,
 							Limit:           22,
 							Usage:           23,
 							ReadsCompleted:  24,
@@ -642,6 +706,7 @@ func (p testSubcontainersInfoProvider) GetRequestedContainersInfo(string, v2.Req
 							Device:          "sda2",
 							InodesFree:      262144,
 							Inodes:          2097152,
+							InodesUsed:      1835008,
 							Limit:           37,
 							Usage:           38,
 							ReadsCompleted:  39,
@@ -657,6 +722,17 @@ func (p testSubcontainersInfoProvider) GetRequestedContainersInfo(string, v2.Req
 							WeightedIoTime:  49,
 						},
 					},
+					Volumes: []info.VolumeStats{
+						{
+							Mountpoint: "/data",
+							Device:     "/dev/sdb1",
+							Type:       "ext4",
+							Capacity:   1073741824,
+							Available:  536870912,
+							Inodes:     65536,
+							InodesFree: 1024,
+						},
+					},
 					Accelerators: []info.AcceleratorStats{
 						{
 							Make:        "nvidia",
@@ -674,6 +750,17 @@ func (p testSubcontainersInfoProvider) GetRequestedContainersInfo(string, v2.Req
 							MemoryUsed:  1020304050,
 							DutyCycle:   6,
 						},
+						{
+							Make:               "nvidia",
+							Model:              "NVIDIA A100-SXM4-40GB",
+							ID:                 "GPU-5d5ba0d6-d33d-2b2c-524d-9e3d8d2b8a77",
+							GPUInstance:        "1",
+							GPUInstanceProfile: "3g.20gb",
+							MemoryTotal:        20937965568,
+							MemoryUsed:         10737418240,
+							DutyCycle:          73,
+							SMOccupancy:        41,
+						},
 					},
 					Processes: info.ProcessStats{
 						ProcessCount:   1,
@@ -727,6 +814,15 @@ func (p testSubcontainersInfoProvider) GetRequestedContainersInfo(string, v2.Req
 								Labels:     map[string]string{"test_label": "test_value"},
 							},
 						},
+						"container_custom_app_metric_4": {
+							{
+								FloatValue:  float64(4),
+								Timestamp:   time.Now(),
+								Label:       "testlabel4",
+								Labels:      map[string]string{"image": "scraped", "test_label": "test_value"},
+								HonorLabels: true,
+							},
+						},
 					},
 					PerfStats: []info.PerfStat{
 						{
@@ -758,6 +854,7 @@ func (p testSubcontainersInfoProvider) GetRequestedContainersInfo(string, v2.Req
 								ScalingRatio: 0.33333333333,
 								Value:        789,
 								Name:         "instructions_retired",
+								Unreliable:   true,
 							},
 							Cpu: 1,
 						},
@@ -783,6 +880,24 @@ func (p testSubcontainersInfoProvider) GetRequestedContainersInfo(string, v2.Req
 						},
 					},
 					ReferencedMemory: 1234,
+					DaxMemory:        4096,
+					TopProcesses: []info.ProcessUsage{
+						{Pid: 1, Command: "nginx", CpuUsage: 1500000000, RSS: 4194304},
+						{Pid: 7, Command: "nginx worker", CpuUsage: 250000000, RSS: 16777216},
+					},
+					NetworkFs: []info.NetworkFsStats{
+						{
+							Device:     "10.0.0.1:/export",
+							Mountpoint: "/data",
+							Type:       "nfs4",
+							ReadBytes:  4096,
+							WriteBytes: 8192,
+							Operations: []info.NetworkFsOperationStats{
+								{Name: "READ", Count: 10, Timeouts: 1, RTT: 250, ExecuteTime: 300},
+								{Name: "WRITE", Count: 5, Errors: 2, RTT: 400, ExecuteTime: 450},
+							},
+						},
+					},
 					Resctrl: info.ResctrlStats{
 						MemoryBandwidth: []info.MemoryBandwidthStats{
 							{
@@ -36,6 +36,18 @@ const (
 	prometheusThreadLabelName     = "thread_id"
 	prometheusPageSizeLabelName   = "page_size"
 	prometheusTargetNodeLabelName = "target_node_id"
+	prometheusRuntimeLabelName    = "runtime"
+	prometheusNamespaceLabelName  = "namespace"
+	prometheusIDLabelName         = "id"
+	prometheusImageLabelName      = "image"
+
+	prometheusCloudProviderLabelName = "cloud_provider"
+	prometheusInstanceTypeLabelName  = "instance_type"
+	prometheusInstanceIDLabelName    = "instance_id"
+	prometheusZoneLabelName          = "zone"
+	prometheusDeviceLabelName        = "device"
+	prometheusPoolLabelName          = "pool"
+	prometheusVolumeGroupLabelName   = "volume_group"
 
 	nvmMemoryMode    = "memory_mode"
 	nvmAppDirectMode = "app_direct_mode"
@@ -61,11 +73,26 @@ func (metric *machineMetric) desc(baseLabels []string) *prometheus.Desc {
 	return prometheus.NewDesc(metric.name, metric.help, append(baseLabels, metric.extraLabels...), nil)
 }
 
+// imageStorageProvider provides the image storage of container runtimes.
+type imageStorageProvider interface {
+	GetImageStorage() ([]info.ImageStorage, error)
+}
+
+// uncoreTrafficProvider provides the memory and interconnect traffic of the
+// machine.
+type uncoreTrafficProvider interface {
+	GetUncoreTraffic() (*info.UncoreTraffic, error)
+}
+
 // PrometheusMachineCollector implements prometheus.Collector.
 type PrometheusMachineCollector struct {
 	infoProvider   infoProvider
 	errors         prometheus.Gauge
 	machineMetrics []machineMetric
+	// Nil unless image storage metrics are enabled.
+	imageStorage imageStorageProvider
+	// Nil unless perf event metrics are enabled.
+	uncoreTraffic uncoreTrafficProvider
 }
 
 // NewPrometheusMachineCollector returns a new PrometheusCollector.
@@ -79,6 +106,22 @@ func NewPrometheusMachineCollector(i infoProvider, includedMetrics container.Met
 			Help:      "1 if there was an error while getting machine metrics, 0 otherwise.",
 		}),
 		machineMetrics: []machineMetric{
+			{
+				name:        "machine_cloud_info",
+				help:        "Cloud provider, instance type, instance id and availability zone of the machine, with a constant value of 1.",
+				valueType:   prometheus.GaugeValue,
+				extraLabels: []string{prometheusCloudProviderLabelName, prometheusInstanceTypeLabelName, prometheusInstanceIDLabelName, prometheusZoneLabelName},
+				condition: func(machineInfo *info.MachineInfo) bool {
+					return machineInfo.CloudProvider != "" && machineInfo.CloudProvider != info.UnknownProvider
+				},
+				getValues: func(machineInfo *info.MachineInfo) metricValues {
+					return metricValues{{
+						value:     1,
+						labels:    []string{string(machineInfo.CloudProvider), string(machineInfo.InstanceType), string(machineInfo.InstanceID), machineInfo.Zone},
+						timestamp: machineInfo.Timestamp,
+					}}
+				},
+			},
 			{
 				name:      "machine_cpu_physical_cores",
 				help:      "Number of physical CPU cores.",
@@ -119,6 +162,24 @@ func NewPrometheusMachineCollector(i infoProvider, includedMetrics container.Met
 					return metricValues{{value: float64(machineInfo.NumDrawers), timestamp: machineInfo.Timestamp}}
 				},
 			},
+			{
+				name:        "machine_disk_health",
+				help:        "SMART overall health assessment of the disk, 1 if it passed and 0 if it failed.",
+				valueType:   prometheus.GaugeValue,
+				extraLabels: []string{prometheusDeviceLabelName},
+				getValues: func(machineInfo *info.MachineInfo) metricValues {
+					return getDiskHealth(machineInfo)
+				},
+			},
+			{
+				name:        "machine_disk_temperature_celsius",
+				help:        "Temperature of the disk, in degrees Celsius.",
+				valueType:   prometheus.GaugeValue,
+				extraLabels: []string{prometheusDeviceLabelName},
+				getValues: func(machineInfo *info.MachineInfo) metricValues {
+					return getDiskTemperature(machineInfo)
+				},
+			},
 			{
 				name:      "machine_memory_bytes",
 				help:      "Amount of memory installed on the machine.",
@@ -127,6 +188,15 @@ func NewPrometheusMachineCollector(i infoProvider, includedMetrics container.Met
 					return metricValues{{value: float64(machineInfo.MemoryCapacity), timestamp: machineInfo.Timestamp}}
 				},
 			},
+			{
+				name:        "machine_network_speed_bytes",
+				help:        "Link speed of the network device, in bytes per second.",
+				valueType:   prometheus.GaugeValue,
+				extraLabels: []string{prometheusDeviceLabelName},
+				getValues: func(machineInfo *info.MachineInfo) metricValues {
+					return getNetworkSpeed(machineInfo)
+				},
+			},
 			{
 				name:      "machine_swap_bytes",
 				help:      "Amount of swap memory available on the machine.",
@@ -175,6 +245,57 @@ func NewPrometheusMachineCollector(i infoProvider, includedMetrics container.Met
 					return metricValues{{value: float64(machineInfo.NVMInfo.AvgPowerBudget), timestamp: machineInfo.Timestamp}}
 				},
 			},
+			{
+				name:        "machine_thin_pool_data_usage_ratio",
+				help:        "Ratio of the data blocks of the devicemapper thin pool in use.",
+				valueType:   prometheus.GaugeValue,
+				extraLabels: []string{prometheusPoolLabelName},
+				getValues: func(machineInfo *info.MachineInfo) metricValues {
+					return getThinPoolValues(machineInfo, func(pool info.ThinPoolInfo) (float64, bool) {
+						return float64(pool.DataUsed) / float64(pool.DataTotal), pool.DataTotal != 0
+					})
+				},
+			},
+			{
+				name:        "machine_thin_pool_metadata_usage_ratio",
+				help:        "Ratio of the metadata blocks of the devicemapper thin pool in use.",
+				valueType:   prometheus.GaugeValue,
+				extraLabels: []string{prometheusPoolLabelName},
+				getValues: func(machineInfo *info.MachineInfo) metricValues {
+					return getThinPoolValues(machineInfo, func(pool info.ThinPoolInfo) (float64, bool) {
+						return float64(pool.MetadataUsed) / float64(pool.MetadataTotal), pool.MetadataTotal != 0
+					})
+				},
+			},
+			{
+				name:        "machine_thin_pool_transaction_id",
+				help:        "Transaction id of the metadata of the devicemapper thin pool.",
+				valueType:   prometheus.GaugeValue,
+				extraLabels: []string{prometheusPoolLabelName},
+				getValues: func(machineInfo *info.MachineInfo) metricValues {
+					return getThinPoolValues(machineInfo, func(pool info.ThinPoolInfo) (float64, bool) {
+						return float64(pool.TransactionID), pool.Mode != "fail"
+					})
+				},
+			},
+			{
+				name:        "machine_lvm_volume_group_size_bytes",
+				help:        "Size of the LVM volume group, in bytes.",
+				valueType:   prometheus.GaugeValue,
+				extraLabels: []string{prometheusVolumeGroupLabelName},
+				getValues: func(machineInfo *info.MachineInfo) metricValues {
+					return getVolumeGroupValues(machineInfo, func(vg info.VolumeGroupInfo) uint64 { return vg.Size })
+				},
+			},
+			{
+				name:        "machine_lvm_volume_group_free_bytes",
+				help:        "Free space of the LVM volume group, in bytes.",
+				valueType:   prometheus.GaugeValue,
+				extraLabels: []string{prometheusVolumeGroupLabelName},
+				getValues: func(machineInfo *info.MachineInfo) metricValues {
+					return getVolumeGroupValues(machineInfo, func(vg info.VolumeGroupInfo) uint64 { return vg.Free })
+				},
+			},
 		},
 	}
 
@@ -216,6 +337,15 @@ func NewPrometheusMachineCollector(i infoProvider, includedMetrics container.Met
 					return getHugePagesCount(machineInfo)
 				},
 			},
+			{
+				name:        "machine_node_hugepages_free_count",
+				help:        "Number of hugepages of NUMA node not allocated.",
+				valueType:   prometheus.GaugeValue,
+				extraLabels: []string{prometheusNodeLabelName, prometheusPageSizeLabelName},
+				getValues: func(machineInfo *info.MachineInfo) metricValues {
+					return getHugePagesFree(machineInfo)
+				},
+			},
 			{
 				name:        "machine_node_distance",
 				help:        "Distance between NUMA node and target NUMA node.",
@@ -227,6 +357,12 @@ func NewPrometheusMachineCollector(i infoProvider, includedMetrics container.Met
 			},
 		}...)
 	}
+	if p, ok := i.(imageStorageProvider); ok && includedMetrics.Has(container.ImageStorageMetrics) {
+		c.imageStorage = p
+	}
+	if p, ok := i.(uncoreTrafficProvider); ok && includedMetrics.Has(container.PerfMetrics) {
+		c.uncoreTraffic = p
+	}
 	return c
 }
 
@@ -237,6 +373,10 @@ func (collector *PrometheusMachineCollector) Describe(ch chan<- *prometheus.Desc
 	for _, metric := range collector.machineMetrics {
 		ch <- metric.desc([]string{})
 	}
+	if collector.uncoreTraffic != nil {
+		ch <- memoryBandwidthDesc
+		ch <- interconnectTrafficDesc
+	}
 }
 
 // Collect fetches information about machine and delivers them as
@@ -280,6 +420,180 @@ func (collector *PrometheusMachineCollector) collectMachineInfo(ch chan<- promet
 		}
 
 	}
+
+	if collector.imageStorage != nil {
+		collector.collectImageStorage(ch, baseLabelsValues)
+	}
+	if collector.uncoreTraffic != nil {
+		collector.collectUncoreTraffic(ch, baseLabelsValues)
+	}
+}
+
+var (
+	imageStorageLabels = append(append([]string{}, baseLabelsNames...), prometheusRuntimeLabelName, prometheusNamespaceLabelName)
+	imageLabels        = append(append([]string{}, imageStorageLabels...), prometheusIDLabelName, prometheusImageLabelName)
+
+	imageSizeDesc           = prometheus.NewDesc("machine_image_size_bytes", "Disk usage of the image, including layers shared with other images.", imageLabels, nil)
+	imageSharedSizeDesc     = prometheus.NewDesc("machine_image_shared_size_bytes", "Disk usage of the layers of the image shared with other images.", imageLabels, nil)
+	imageContainersDesc     = prometheus.NewDesc("machine_image_containers", "Number of containers using the image.", imageLabels, nil)
+	imagesBytesDesc         = prometheus.NewDesc("machine_image_storage_bytes", "Disk usage of all images of the runtime, counting shared layers once.", imageStorageLabels, nil)
+	writableLayersBytesDesc = prometheus.NewDesc("machine_image_writable_layers_bytes", "Disk usage of the writable layers of all containers of the runtime.", imageStorageLabels, nil)
+	imagePullsDesc          = prometheus.NewDesc("machine_image_pulls_total", "Number of images pulled by the runtime since cAdvisor started.", imageStorageLabels, nil)
+	imagePullDurationDesc   = prometheus.NewDesc("machine_image_pull_duration_seconds", "Duration of the image pulls of the runtime whose duration is known.", imageStorageLabels, nil)
+)
+
+func (collector *PrometheusMachineCollector) collectImageStorage(ch chan<- prometheus.Metric, baseLabelsValues []string) {
+	storage, err := collector.imageStorage.GetImageStorage()
+	if err != nil {
+		collector.errors.Set(1)
+		klog.Warningf("Couldn't get image storage: %s", err)
+		return
+	}
+	for _, s := range storage {
+		labels := append(append([]string{}, baseLabelsValues...), s.Runtime, s.Namespace)
+		metrics := []prometheus.Metric{
+			prometheus.MustNewConstMetric(imagesBytesDesc, prometheus.GaugeValue, float64(s.ImagesBytes), labels...),
+			prometheus.MustNewConstMetric(writableLayersBytesDesc, prometheus.GaugeValue, float64(s.WritableLayersBytes), labels...),
+			prometheus.MustNewConstMetric(imagePullsDesc, prometheus.CounterValue, float64(s.Pulls), labels...),
+		}
+		if s.TimedPulls != 0 {
+			metrics = append(metrics, prometheus.MustNewConstSummary(imagePullDurationDesc, s.TimedPulls, s.PullSeconds, nil, labels...))
+		}
+		for _, image := range s.Images {
+			name := emptyLabelValue
+			if len(image.RepoTags) != 0 {
+				name = image.RepoTags[0]
+			}
+			imageLabels := append(append([]string{}, labels...), image.ID, name)
+			metrics = append(metrics,
+				prometheus.MustNewConstMetric(imageSizeDesc, prometheus.GaugeValue, float64(image.Size), imageLabels...),
+				prometheus.MustNewConstMetric(imageSharedSizeDesc, prometheus.GaugeValue, float64(image.SharedSize), imageLabels...),
+				prometheus.MustNewConstMetric(imageContainersDesc, prometheus.GaugeValue, float64(image.Containers), imageLabels...),
+			)
+		}
+		for _, metric := range metrics {
+			if s.Timestamp.IsZero() {
+				ch <- metric
+			} else {
+				ch <- prometheus.NewMetricWithTimestamp(s.Timestamp, metric)
+			}
+		}
+	}
+}
+
+var (
+	memoryBandwidthDesc     = prometheus.NewDesc("machine_memory_bandwidth_bytes_total", "Bytes transferred by the memory channel of the socket since cAdvisor started counting, by direction (read, write, or all where they are counted together).", append(append([]string{}, baseLabelsNames...), "socket", "channel", "direction"), nil)
+	interconnectTrafficDesc = prometheus.NewDesc("machine_interconnect_bytes_total", "Bytes transferred over the interconnect link of the socket to other sockets since cAdvisor started counting, by direction.", append(append([]string{}, baseLabelsNames...), "socket", "link", "direction"), nil)
+)
+
+func (collector *PrometheusMachineCollector) collectUncoreTraffic(ch chan<- prometheus.Metric, baseLabelsValues []string) {
+	traffic, err := collector.uncoreTraffic.GetUncoreTraffic()
+	if err != nil {
+		collector.errors.Set(1)
+		klog.Warningf("Couldn't get uncore traffic: %s", err)
+		return
+	}
+	if traffic == nil {
+		return
+	}
+	for _, counters := range []struct {
+		desc     *prometheus.Desc
+		counters []info.TrafficCounter
+	}{
+		{memoryBandwidthDesc, traffic.Memory},
+		{interconnectTrafficDesc, traffic.Interconnect},
+	} {
+		for _, c := range counters.counters {
+			labels := append(append([]string{}, baseLabelsValues...), strconv.Itoa(c.Socket), c.Channel, c.Direction)
+			metric := prometheus.MustNewConstMetric(counters.desc, prometheus.CounterValue, float64(c.Bytes), labels...)
+			ch <- prometheus.NewMetricWithTimestamp(traffic.Timestamp, metric)
+		}
+	}
+}
+
+// getDiskHealth returns the SMART health of the disks whose health is known.
+func getDiskHealth(machineInfo *info.MachineInfo) metricValues {
+	mValues := make(metricValues, 0, len(machineInfo.DiskMap))
+	for _, disk := range machineInfo.DiskMap {
+		if disk.Health == "" {
+			continue
+		}
+		value := 0.0
+		if disk.Health == "passed" {
+			value = 1
+		}
+		mValues = append(mValues, metricValue{
+			value:     value,
+			labels:    []string{disk.Name},
+			timestamp: machineInfo.Timestamp,
+		})
+	}
+	return mValues
+}
+
+// getThinPoolValues returns a value of each thin pool, skipping the pools
+// for which the value is unknown.
+func getThinPoolValues(machineInfo *info.MachineInfo, value func(info.ThinPoolInfo) (float64, bool)) metricValues {
+	mValues := make(metricValues, 0, len(machineInfo.ThinPools))
+	for _, pool := range machineInfo.ThinPools {
+		v, ok := value(pool)
+		if !ok {
+			continue
+		}
+		mValues = append(mValues, metricValue{
+			value:     v,
+			labels:    []string{pool.Name},
+			timestamp: machineInfo.Timestamp,
+		})
+	}
+	return mValues
+}
+
+// getVolumeGroupValues returns a value of each LVM volume group.
+func getVolumeGroupValues(machineInfo *info.MachineInfo, value func(info.VolumeGroupInfo) uint64) metricValues {
+	mValues := make(metricValues, 0, len(machineInfo.VolumeGroups))
+	for _, vg := range machineInfo.VolumeGroups {
+		mValues = append(mValues, metricValue{
+			value:     float64(value(vg)),
+			labels:    []string{vg.Name},
+			timestamp: machineInfo.Timestamp,
+		})
+	}
+	return mValues
+}
+
+// getDiskTemperature returns the temperature of the disks whose temperature
+// is known.
+func getDiskTemperature(machineInfo *info.MachineInfo) metricValues {
+	mValues := make(metricValues, 0, len(machineInfo.DiskMap))
+	for _, disk := range machineInfo.DiskMap {
+		if disk.Temperature == 0 {
+			continue
+		}
+		mValues = append(mValues, metricValue{
+			value:     float64(disk.Temperature),
+			labels:    []string{disk.Name},
+			timestamp: machineInfo.Timestamp,
+		})
+	}
+	return mValues
+}
+
+// getNetworkSpeed returns the speed of the network devices whose speed is
+// known, converted from Mbit/s.
+func getNetworkSpeed(machineInfo *info.MachineInfo) metricValues {
+	mValues := make(metricValues, 0, len(machineInfo.NetworkDevices))
+	for _, device := range machineInfo.NetworkDevices {
+		if device.Speed <= 0 {
+			continue
+		}
+		mValues = append(mValues, metricValue{
+			value:     float64(device.Speed) * 1000 * 1000 / 8,
+			labels:    []string{device.Name},
+			timestamp: machineInfo.Timestamp,
+		})
+	}
+	return mValues
 }
 
 func getMemoryByType(machineInfo *info.MachineInfo, property string) metricValues {
@@ -337,6 +651,16 @@ func getNodeMemory(machineInfo *info.MachineInfo) metricValues {
 }
 
 func getHugePagesCount(machineInfo *info.MachineInfo) metricValues {
+	return getNodeHugePages(machineInfo, func(hugePage info.HugePagesInfo) uint64 { return hugePage.NumPages })
+}
+
+func getHugePagesFree(machineInfo *info.MachineInfo) metricValues {
+	return getNodeHugePages(machineInfo, func(hugePage info.HugePagesInfo) uint64 { return hugePage.FreePages })
+}
+
+// getNodeHugePages returns a count of the hugepages of each size of each
+// NUMA node.
+func getNodeHugePages(machineInfo *info.MachineInfo, count func(info.HugePagesInfo) uint64) metricValues {
 	mValues := make(metricValues, 0)
 	for _, node := range machineInfo.Topology {
 		nodeID := strconv.Itoa(node.Id)
@@ -344,7 +668,7 @@ func getHugePagesCount(machineInfo *info.MachineInfo) metricValues {
 		for _, hugePage := range node.HugePages {
 			mValues = append(mValues,
 				metricValue{
-					value:     float64(hugePage.NumPages),
+					value:     float64(count(hugePage)),
 					labels:    []string{nodeID, strconv.FormatUint(hugePage.PageSize, 10)},
 					timestamp: machineInfo.Timestamp,
 				})
@@ -110,7 +110,7 @@ func TestPrometheusCollector_scrapeFailure(t *testing.T) {
 
 func TestNewPrometheusCollectorWithPerf(t *testing.T) {
 	c := NewPrometheusCollector(&mockInfoProvider{}, mockLabelFunc, container.MetricSet{container.PerfMetrics: struct{}{}}, now, v2.RequestOptions{})
-	assert.Len(t, c.containerMetrics, 6)
+	assert.Len(t, c.containerMetrics, 8)
 	names := []string{}
 	for _, m := range c.containerMetrics {
 		names = append(names, m.name)
@@ -119,8 +119,10 @@ func TestNewPrometheusCollectorWithPerf(t *testing.T) {
 	assert.Contains(t, names, "container_health_state")
 	assert.Contains(t, names, "container_perf_events_total")
 	assert.Contains(t, names, "container_perf_events_scaling_ratio")
+	assert.Contains(t, names, "container_perf_events_unreliable")
 	assert.Contains(t, names, "container_perf_uncore_events_total")
 	assert.Contains(t, names, "container_perf_uncore_events_scaling_ratio")
+	assert.Contains(t, names, "container_perf_uncore_events_unreliable")
 }
 
 func TestNewPrometheusCollectorWithRequestOptions(t *testing.T) {
@@ -337,6 +339,50 @@ func TestGetMinCoreScalingRatio(t *testing.T) {
 	assert.Contains(t, values, 0.3)
 }
 
+func TestGetAggregatedCoreUnreliable(t *testing.T) {
+	containerStats := &info.ContainerStats{
+		Timestamp: time.Unix(1395066367, 0),
+		PerfStats: []info.PerfStat{
+			{
+				PerfValue: info.PerfValue{
+					ScalingRatio: 1.0,
+					Value:        123,
+					Name:         "instructions"},
+				Cpu: 0,
+			},
+			{
+				PerfValue: info.PerfValue{
+					ScalingRatio: 0.5,
+					Value:        456,
+					Name:         "instructions"},
+				Cpu: 1,
+			},
+			{
+				PerfValue: info.PerfValue{
+					ScalingRatio: 0.7,
+					Value:        321,
+					Name:         "instructions_retired"},
+				Cpu: 0,
+			},
+			{
+				PerfValue: info.PerfValue{
+					ScalingRatio: 0.05,
+					Value:        789,
+					Name:         "instructions_retired",
+					Unreliable:   true},
+				Cpu: 1,
+			},
+		},
+	}
+	metricVals := getAggregatedCoreUnreliable(containerStats)
+	assert.Equal(t, 2, len(metricVals))
+	values := map[string]float64{}
+	for _, metric := range metricVals {
+		values[metric.labels[1]] = metric.value
+	}
+	assert.Equal(t, map[string]float64{"instructions": 0, "instructions_retired": 1}, values)
+}
+
 func TestGetContainerHealthState(t *testing.T) {
 	testCases := []struct {
 		name           string
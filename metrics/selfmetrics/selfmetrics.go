@@ -0,0 +1,196 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package selfmetrics holds the Prometheus metrics cAdvisor exposes about
+// itself, to tell whether it keeps up with the containers it monitors.
+package selfmetrics
+
+import (
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const namespace = "cadvisor"
+
+// Buckets of the duration histograms, from 1ms to about 16s.
+var durationBuckets = prometheus.ExponentialBuckets(0.001, 4, 8)
+
+var (
+	// HousekeepingDuration is the duration of the housekeeping of any container.
+	HousekeepingDuration = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "housekeeping_duration_seconds",
+		Help:      "Duration of the housekeeping of a container.",
+		Buckets:   durationBuckets,
+	})
+	// ContainerHousekeepingDuration is the duration of the housekeeping of
+	// each container, by container name.
+	ContainerHousekeepingDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "container_housekeeping_duration_seconds",
+		Help:      "Duration of the housekeeping of the container.",
+		Buckets:   durationBuckets,
+	}, []string{"id"})
+	// FactoryMatchDuration is how long container handler factories take to
+	// tell whether they handle a container, by factory.
+	FactoryMatchDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "factory_match_duration_seconds",
+		Help:      "Duration of asking a container handler factory whether it handles a container.",
+		Buckets:   durationBuckets,
+	}, []string{"factory"})
+	// StorageDriverErrors counts the stats storage drivers failed to store,
+	// by driver.
+	StorageDriverErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "storage_driver_errors_total",
+		Help:      "Number of stats a storage driver failed to store.",
+	}, []string{"driver"})
+	// InotifyWatches is the number of inotify watches on cgroup directories.
+	InotifyWatches = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "inotify_watches",
+		Help:      "Number of inotify watches on cgroup directories.",
+	})
+	// PolledCgroupRoots is the number of cgroup subtrees rescanned
+	// periodically since they cannot be watched with inotify.
+	PolledCgroupRoots = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "polled_cgroup_roots",
+		Help:      "Number of cgroup subtrees rescanned periodically because they cannot be watched with inotify.",
+	})
+	// HousekeepingRestarts counts the containers whose collection the
+	// housekeeping watchdog restarted because their housekeeping was blocked.
+	HousekeepingRestarts = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "housekeeping_restarts_total",
+		Help:      "Number of times the collection of a container was restarted because its housekeeping was blocked.",
+	})
+	// WebhookDeliveries counts the events delivered to webhooks, by result:
+	// delivered, failed after all retries, or dropped because too many
+	// were pending.
+	WebhookDeliveries = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "event_webhook_deliveries_total",
+		Help:      "Number of events sent to webhooks, by result.",
+	}, []string{"result"})
+
+	// EventsSuppressed counts the events held back by rate limiting, by
+	// event type.
+	EventsSuppressed = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "events_suppressed_total",
+		Help:      "Number of events collapsed by rate limiting, by event type.",
+	}, []string{"event_type"})
+)
+
+var (
+	storageDriverQueueDesc = prometheus.NewDesc(namespace+"_storage_driver_queue_length",
+		"Number of entries buffered by a storage driver and not written yet.", []string{"driver"}, nil)
+	memoryCacheContainersDesc = prometheus.NewDesc(namespace+"_memory_cache_containers",
+		"Number of containers with stats in the in-memory cache.", nil, nil)
+	memoryCacheStatsDesc = prometheus.NewDesc(namespace+"_memory_cache_stats",
+		"Number of stats samples held by the in-memory cache, including downsampled ones.", nil, nil)
+)
+
+// MemoryCache is the in-memory stats cache whose size is reported.
+type MemoryCache interface {
+	// CacheSize returns the number of containers and of stats in the cache.
+	CacheSize() (containers, stats int)
+}
+
+type queue struct {
+	driver string
+	length func() int
+}
+
+var (
+	lock        sync.Mutex
+	queues      = map[*queue]struct{}{}
+	memoryCache MemoryCache
+)
+
+// RegisterStorageDriverQueue reports the queue length of a storage driver
+// until the returned function is called.
+func RegisterStorageDriverQueue(driver string, length func() int) (unregister func()) {
+	q := &queue{driver: driver, length: length}
+	lock.Lock()
+	defer lock.Unlock()
+	queues[q] = struct{}{}
+	return func() {
+		lock.Lock()
+		defer lock.Unlock()
+		delete(queues, q)
+	}
+}
+
+// SetMemoryCache sets the in-memory cache whose size is reported, nil to
+// report none.
+func SetMemoryCache(c MemoryCache) {
+	lock.Lock()
+	defer lock.Unlock()
+	memoryCache = c
+}
+
+type collector struct {
+	metrics []prometheus.Collector
+}
+
+// NewCollector returns a collector of all self metrics.
+func NewCollector() prometheus.Collector {
+	return &collector{metrics: []prometheus.Collector{
+		HousekeepingDuration,
+		ContainerHousekeepingDuration,
+		FactoryMatchDuration,
+		StorageDriverErrors,
+		InotifyWatches,
+		PolledCgroupRoots,
+		HousekeepingRestarts,
+		WebhookDeliveries,
+		EventsSuppressed,
+	}}
+}
+
+func (c *collector) Describe(ch chan<- *prometheus.Desc) {
+	for _, m := range c.metrics {
+		m.Describe(ch)
+	}
+	ch <- storageDriverQueueDesc
+	ch <- memoryCacheContainersDesc
+	ch <- memoryCacheStatsDesc
+}
+
+func (c *collector) Collect(ch chan<- prometheus.Metric) {
+	for _, m := range c.metrics {
+		m.Collect(ch)
+	}
+
+	lock.Lock()
+	lengths := map[string]int{}
+	for q := range queues {
+		lengths[q.driver] += q.length()
+	}
+	cache := memoryCache
+	lock.Unlock()
+
+	for driver, length := range lengths {
+		ch <- prometheus.MustNewConstMetric(storageDriverQueueDesc, prometheus.GaugeValue, float64(length), driver)
+	}
+	if cache != nil {
+		containers, stats := cache.CacheSize()
+		ch <- prometheus.MustNewConstMetric(memoryCacheContainersDesc, prometheus.GaugeValue, float64(containers))
+		ch <- prometheus.MustNewConstMetric(memoryCacheStatsDesc, prometheus.GaugeValue, float64(stats))
+	}
+}
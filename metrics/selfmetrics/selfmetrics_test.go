@@ -0,0 +1,63 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package selfmetrics
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeCache struct{}
+
+func (fakeCache) CacheSize() (int, int) {
+	return 3, 120
+}
+
+func TestCollectorQueuesAndCache(t *testing.T) {
+	SetMemoryCache(fakeCache{})
+	defer SetMemoryCache(nil)
+	unregisterFirst := RegisterStorageDriverQueue("influxdb", func() int { return 4 })
+	unregisterSecond := RegisterStorageDriverQueue("influxdb", func() int { return 2 })
+	defer unregisterSecond()
+
+	expected := `
+# HELP cadvisor_memory_cache_containers Number of containers with stats in the in-memory cache.
+# TYPE cadvisor_memory_cache_containers gauge
+cadvisor_memory_cache_containers 3
+# HELP cadvisor_memory_cache_stats Number of stats samples held by the in-memory cache, including downsampled ones.
+# TYPE cadvisor_memory_cache_stats gauge
+cadvisor_memory_cache_stats 120
+# HELP cadvisor_storage_driver_queue_length Number of entries buffered by a storage driver and not written yet.
+# TYPE cadvisor_storage_driver_queue_length gauge
+cadvisor_storage_driver_queue_length{driver="influxdb"} 6
+`
+	names := []string{"cadvisor_memory_cache_containers", "cadvisor_memory_cache_stats", "cadvisor_storage_driver_queue_length"}
+	assert.NoError(t, testutil.CollectAndCompare(NewCollector(), strings.NewReader(expected), names...))
+
+	unregisterFirst()
+	expected = `
+# HELP cadvisor_storage_driver_queue_length Number of entries buffered by a storage driver and not written yet.
+# TYPE cadvisor_storage_driver_queue_length gauge
+cadvisor_storage_driver_queue_length{driver="influxdb"} 2
+`
+	assert.NoError(t, testutil.CollectAndCompare(NewCollector(), strings.NewReader(expected), "cadvisor_storage_driver_queue_length"))
+}
+
+func TestCollectorWithoutCache(t *testing.T) {
+	assert.Zero(t, testutil.CollectAndCount(NewCollector(), "cadvisor_memory_cache_stats", "cadvisor_storage_driver_queue_length"))
+}
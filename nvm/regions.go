@@ -0,0 +1,151 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nvm
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	info "github.com/google/cadvisor/info/v1"
+)
+
+// ndDevicesDir holds the devices of the libnvdimm subsystem: the regions,
+// their namespaces and the NVDIMMs.
+var ndDevicesDir = "/sys/bus/nd/devices"
+
+// namespaceModes are the names ndctl gives to the modes of namespaces, by
+// the name the kernel gives them.
+var namespaceModes = map[string]string{
+	"memory": "fsdax",
+	"dax":    "devdax",
+	"safe":   "sector",
+	"raw":    "raw",
+}
+
+// GetRegions returns the persistent memory regions and their namespaces,
+// none if the machine has no NVDIMM.
+func GetRegions() ([]info.NVMRegion, error) {
+	devices, err := os.ReadDir(ndDevicesDir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	regions := []info.NVMRegion{}
+	for _, device := range devices {
+		name := device.Name()
+		if !strings.HasPrefix(name, "region") {
+			continue
+		}
+		region, err := getRegion(name)
+		if err != nil {
+			return nil, err
+		}
+		regions = append(regions, region)
+	}
+	sort.Slice(regions, func(i, j int) bool { return regions[i].Name < regions[j].Name })
+	return regions, nil
+}
+
+func getRegion(name string) (info.NVMRegion, error) {
+	dir := filepath.Join(ndDevicesDir, name)
+	region := info.NVMRegion{
+		Name:     name,
+		Type:     strings.TrimPrefix(readAttribute(dir, "devtype"), "nd_"),
+		NumaNode: -1,
+	}
+	var err error
+	if region.Size, err = readUintAttribute(dir, "size"); err != nil {
+		return region, err
+	}
+	if region.AvailableSize, err = readUintAttribute(dir, "available_size"); err != nil {
+		return region, err
+	}
+	if node, err := strconv.Atoi(readAttribute(dir, "numa_node")); err == nil {
+		region.NumaNode = node
+	}
+
+	// mapping<n> holds the NVDIMM, offset, length and position of the n-th
+	// DIMM of the interleave set, mappings their number.
+	mappings, _ := strconv.Atoi(readAttribute(dir, "mappings"))
+	for i := 0; i < mappings; i++ {
+		dimm, _, _ := strings.Cut(readAttribute(dir, "mapping"+strconv.Itoa(i)), ",")
+		if dimm != "" {
+			region.Dimms = append(region.Dimms, dimm)
+		}
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return region, err
+	}
+	for _, entry := range entries {
+		if !strings.HasPrefix(entry.Name(), "namespace") {
+			continue
+		}
+		namespace, err := getNamespace(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return region, err
+		}
+		// Every region has an empty seed namespace to create new ones from.
+		if namespace.Size == 0 {
+			continue
+		}
+		region.Namespaces = append(region.Namespaces, namespace)
+	}
+	return region, nil
+}
+
+func getNamespace(dir string) (info.NVMNamespace, error) {
+	namespace := info.NVMNamespace{
+		Name: filepath.Base(dir),
+		UUID: readAttribute(dir, "uuid"),
+	}
+	mode := readAttribute(dir, "mode")
+	if ndctlMode, ok := namespaceModes[mode]; ok {
+		mode = ndctlMode
+	}
+	namespace.Mode = mode
+	var err error
+	if namespace.Size, err = readUintAttribute(dir, "size"); err != nil {
+		return namespace, err
+	}
+	if blocks, err := os.ReadDir(filepath.Join(dir, "block")); err == nil && len(blocks) > 0 {
+		namespace.BlockDevice = blocks[0].Name()
+	}
+	return namespace, nil
+}
+
+// readAttribute returns the trimmed content of an attribute, empty if it
+// cannot be read.
+func readAttribute(dir string, attribute string) string {
+	value, err := os.ReadFile(filepath.Join(dir, attribute))
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(value))
+}
+
+func readUintAttribute(dir string, attribute string) (uint64, error) {
+	value, err := os.ReadFile(filepath.Join(dir, attribute))
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseUint(strings.TrimSpace(string(value)), 10, 64)
+}
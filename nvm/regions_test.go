@@ -0,0 +1,113 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nvm
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	info "github.com/google/cadvisor/info/v1"
+)
+
+func writeAttributes(t *testing.T, dir string, attributes map[string]string) {
+	require.NoError(t, os.MkdirAll(dir, 0o755))
+	for name, value := range attributes {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(value+"\n"), 0o644))
+	}
+}
+
+func TestGetRegions(t *testing.T) {
+	ndDevicesDir = t.TempDir()
+	defer func() { ndDevicesDir = "/sys/bus/nd/devices" }()
+
+	region0 := filepath.Join(ndDevicesDir, "region0")
+	writeAttributes(t, region0, map[string]string{
+		"devtype":        "nd_pmem",
+		"size":           "270582939648",
+		"available_size": "0",
+		"numa_node":      "0",
+		"mappings":       "2",
+		"mapping0":       "nmem0,0,135291469824,0",
+		"mapping1":       "nmem1,0,135291469824,1",
+	})
+	writeAttributes(t, filepath.Join(region0, "namespace0.0"), map[string]string{
+		"mode": "memory",
+		"size": "266352984064",
+		"uuid": "2a0ac752-d1f4-4b01-8e1c-9bd4e4f6b3a1",
+	})
+	require.NoError(t, os.MkdirAll(filepath.Join(region0, "namespace0.0", "block", "pmem0"), 0o755))
+	// Seed namespace.
+	writeAttributes(t, filepath.Join(region0, "namespace0.1"), map[string]string{"mode": "raw", "size": "0"})
+
+	region1 := filepath.Join(ndDevicesDir, "region1")
+	writeAttributes(t, region1, map[string]string{
+		"devtype":        "nd_pmem",
+		"size":           "270582939648",
+		"available_size": "135291469824",
+		"mappings":       "0",
+	})
+	writeAttributes(t, filepath.Join(region1, "namespace1.0"), map[string]string{
+		"mode": "dax",
+		"size": "135291469824",
+		"uuid": "c1a5b9b0-7f3e-4d3c-9a43-1d2c3b4a5f60",
+	})
+	writeAttributes(t, filepath.Join(ndDevicesDir, "nmem0"), map[string]string{"devtype": "nvdimm"})
+
+	regions, err := GetRegions()
+	assert.NoError(t, err)
+	assert.Equal(t, []info.NVMRegion{
+		{
+			Name:          "region0",
+			Type:          "pmem",
+			Size:          270582939648,
+			AvailableSize: 0,
+			NumaNode:      0,
+			Dimms:         []string{"nmem0", "nmem1"},
+			Namespaces: []info.NVMNamespace{{
+				Name:        "namespace0.0",
+				Mode:        "fsdax",
+				Size:        266352984064,
+				UUID:        "2a0ac752-d1f4-4b01-8e1c-9bd4e4f6b3a1",
+				BlockDevice: "pmem0",
+			}},
+		},
+		{
+			Name:          "region1",
+			Type:          "pmem",
+			Size:          270582939648,
+			AvailableSize: 135291469824,
+			NumaNode:      -1,
+			Namespaces: []info.NVMNamespace{{
+				Name: "namespace1.0",
+				Mode: "devdax",
+				Size: 135291469824,
+				UUID: "c1a5b9b0-7f3e-4d3c-9a43-1d2c3b4a5f60",
+			}},
+		},
+	}, regions)
+}
+
+func TestGetRegionsWithoutNVDIMMs(t *testing.T) {
+	ndDevicesDir = filepath.Join(t.TempDir(), "missing")
+	defer func() { ndDevicesDir = "/sys/bus/nd/devices" }()
+
+	regions, err := GetRegions()
+	assert.NoError(t, err)
+	assert.Empty(t, regions)
+}
@@ -0,0 +1,335 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Memory bandwidth and interconnect traffic counted by the uncore PMUs.
+package perf
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"k8s.io/klog/v2"
+
+	info "github.com/google/cadvisor/info/v1"
+)
+
+const (
+	eventSourceDevicesPath = "/sys/bus/event_source/devices"
+
+	memoryTraffic       = "memory"
+	interconnectTraffic = "interconnect"
+)
+
+// bandwidthEventSpec describes an uncore event counting traffic.
+type bandwidthEventSpec struct {
+	// Channel or link counted by the event, if the PMU name does not tell.
+	channel string
+	// Direction of the traffic: read, write or all for memory, transmit or
+	// receive for interconnects.
+	direction string
+	// Name of the sysfs event alias, preferred to terms when the kernel has
+	// it.
+	alias string
+	// Terms of the event, e.g. "event=0x04,umask=0x03".
+	terms         string
+	bytesPerCount float64
+}
+
+// bandwidthPMU matches the PMUs whose events count traffic. The submatch of
+// name, if any, is the channel or link of the PMU.
+type bandwidthPMU struct {
+	name    *regexp.Regexp
+	traffic string
+	events  []bandwidthEventSpec
+}
+
+var bandwidthPMUs = []bandwidthPMU{
+	{
+		// Intel integrated memory controllers, one PMU per channel. Each CAS
+		// command transfers a cache line.
+		name:    regexp.MustCompile(`^uncore_imc_(\d+)$`),
+		traffic: memoryTraffic,
+		events: []bandwidthEventSpec{
+			{direction: "read", alias: "cas_count_read", terms: "event=0x04,umask=0x03", bytesPerCount: 64},
+			{direction: "write", alias: "cas_count_write", terms: "event=0x04,umask=0x0c", bytesPerCount: 64},
+		},
+	},
+	{
+		// Intel UPI links, counting data flits. A cache line takes 9 flits.
+		name:    regexp.MustCompile(`^uncore_upi_(\d+)$`),
+		traffic: interconnectTraffic,
+		events: []bandwidthEventSpec{
+			{direction: "transmit", terms: "event=0x02,umask=0x0f", bytesPerCount: 64.0 / 9},
+			{direction: "receive", terms: "event=0x03,umask=0x0f", bytesPerCount: 64.0 / 9},
+		},
+	},
+	{
+		// AMD Zen 2 and Zen 3 data fabric, counting 64 byte beats of the DRAM
+		// channels, reads and writes together.
+		name:    regexp.MustCompile(`^amd_df$`),
+		traffic: memoryTraffic,
+		events:  amdDataFabricEvents("all", 64, 0x38, 0x07, 0x47, 0x87, 0xc7, 0x107, 0x147, 0x187, 0x1c7),
+	},
+	{
+		// AMD Zen 2 and Zen 3 data fabric, counting 32 byte beats sent to the
+		// other socket over the Infinity Fabric links.
+		name:    regexp.MustCompile(`^amd_df$`),
+		traffic: interconnectTraffic,
+		events:  amdDataFabricEvents("transmit", 32, 0x02, 0x7c7, 0x807, 0x847, 0x887),
+	},
+}
+
+// amdDataFabricEvents returns the events of the data fabric channels or links
+// counted by the given event codes, numbered in order.
+func amdDataFabricEvents(direction string, bytesPerCount float64, umask uint64, codes ...uint64) []bandwidthEventSpec {
+	events := make([]bandwidthEventSpec, len(codes))
+	for i, code := range codes {
+		events[i] = bandwidthEventSpec{
+			channel:       strconv.Itoa(i),
+			direction:     direction,
+			terms:         fmt.Sprintf("event=%#x,umask=%#x", code, umask),
+			bytesPerCount: bytesPerCount,
+		}
+	}
+	return events
+}
+
+// bandwidthEvent is an uncore event counting traffic, encoded for the PMU.
+type bandwidthEvent struct {
+	name    string
+	pmu     string
+	pmuType uint32
+	// CPUs the PMU counts on, one per socket or die.
+	cpus          []int
+	config        [3]uint64
+	traffic       string
+	channel       string
+	direction     string
+	bytesPerCount float64
+}
+
+// findBandwidthEvents returns the events counting traffic of the PMUs in
+// devicesPath. PMUs that cannot be read are skipped.
+func findBandwidthEvents(devicesPath string) ([]bandwidthEvent, error) {
+	entries, err := os.ReadDir(devicesPath)
+	if err != nil {
+		return nil, err
+	}
+	var events []bandwidthEvent
+	for _, entry := range entries {
+		for _, p := range bandwidthPMUs {
+			match := p.name.FindStringSubmatch(entry.Name())
+			if match == nil {
+				continue
+			}
+			pmuEvents, err := readBandwidthEvents(filepath.Join(devicesPath, entry.Name()), match, p)
+			if err != nil {
+				klog.Warningf("Traffic of PMU %q will not be measured: %v", entry.Name(), err)
+				continue
+			}
+			events = append(events, pmuEvents...)
+		}
+	}
+	return events, nil
+}
+
+func readBandwidthEvents(path string, match []string, p bandwidthPMU) ([]bandwidthEvent, error) {
+	buf, err := os.ReadFile(filepath.Join(path, "type"))
+	if err != nil {
+		return nil, err
+	}
+	pmuType, err := strconv.ParseUint(strings.TrimSpace(string(buf)), 0, 32)
+	if err != nil {
+		return nil, err
+	}
+	buf, err = os.ReadFile(filepath.Join(path, "cpumask"))
+	if err != nil {
+		return nil, err
+	}
+	cpus, err := parseCPUList(strings.TrimSpace(string(buf)))
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]bandwidthEvent, 0, len(p.events))
+	for _, spec := range p.events {
+		event := bandwidthEvent{
+			pmu:           match[0],
+			pmuType:       uint32(pmuType),
+			cpus:          cpus,
+			traffic:       p.traffic,
+			channel:       spec.channel,
+			direction:     spec.direction,
+			bytesPerCount: spec.bytesPerCount,
+		}
+		if event.channel == "" && len(match) > 1 {
+			event.channel = match[1]
+		}
+		terms := spec.terms
+		event.name = fmt.Sprintf("%s/%s/", match[0], terms)
+		if spec.alias != "" {
+			if buf, err := os.ReadFile(filepath.Join(path, "events", spec.alias)); err == nil {
+				terms = strings.TrimSpace(string(buf))
+				event.name = fmt.Sprintf("%s/%s", match[0], spec.alias)
+				if bytesPerCount, ok := readAliasScale(filepath.Join(path, "events", spec.alias)); ok {
+					event.bytesPerCount = bytesPerCount
+				}
+			}
+		}
+		event.config, err = encodeTerms(filepath.Join(path, "format"), terms)
+		if err != nil {
+			return nil, fmt.Errorf("unable to encode %q: %w", event.name, err)
+		}
+		events = append(events, event)
+	}
+	return events, nil
+}
+
+var unitBytes = map[string]float64{
+	"B":   1,
+	"KB":  1e3,
+	"MB":  1e6,
+	"GB":  1e9,
+	"KiB": 1 << 10,
+	"MiB": 1 << 20,
+	"GiB": 1 << 30,
+}
+
+// readAliasScale returns the bytes counted by each count of an event alias,
+// from the scale and unit files the kernel has for some aliases.
+func readAliasScale(aliasPath string) (float64, bool) {
+	scale, err := os.ReadFile(aliasPath + ".scale")
+	if err != nil {
+		return 0, false
+	}
+	unit, err := os.ReadFile(aliasPath + ".unit")
+	if err != nil {
+		return 0, false
+	}
+	value, err := strconv.ParseFloat(strings.TrimSpace(string(scale)), 64)
+	if err != nil {
+		return 0, false
+	}
+	bytes, ok := unitBytes[strings.TrimSpace(string(unit))]
+	if !ok {
+		return 0, false
+	}
+	return value * bytes, true
+}
+
+var configFields = map[string]int{"config": 0, "config1": 1, "config2": 2}
+
+// encodeTerms sets the bits of config, config1 and config2 the terms are
+// mapped to by the format files of the PMU, e.g. "config:0-7,32-35".
+func encodeTerms(formatPath, terms string) ([3]uint64, error) {
+	var config [3]uint64
+	for _, term := range strings.Split(terms, ",") {
+		name, value, ok := strings.Cut(strings.TrimSpace(term), "=")
+		if name == "" {
+			continue
+		}
+		v := uint64(1)
+		if ok {
+			var err error
+			v, err = strconv.ParseUint(value, 0, 64)
+			if err != nil {
+				return config, fmt.Errorf("invalid value of term %q: %w", name, err)
+			}
+		}
+		buf, err := os.ReadFile(filepath.Join(formatPath, name))
+		if err != nil {
+			return config, fmt.Errorf("unknown term %q: %w", name, err)
+		}
+		field, bits, ok := strings.Cut(strings.TrimSpace(string(buf)), ":")
+		if !ok {
+			return config, fmt.Errorf("invalid format of term %q: %q", name, buf)
+		}
+		i, ok := configFields[field]
+		if !ok {
+			return config, fmt.Errorf("invalid format of term %q: %q", name, buf)
+		}
+		for _, r := range strings.Split(bits, ",") {
+			low, high, err := parseRange(r)
+			if err != nil || high > 63 {
+				return config, fmt.Errorf("invalid format of term %q: %q", name, buf)
+			}
+			width := high - low + 1
+			config[i] |= (v & (1<<width - 1)) << low
+			v >>= width
+		}
+		if v != 0 {
+			return config, fmt.Errorf("value of term %q does not fit its format %q", name, buf)
+		}
+	}
+	return config, nil
+}
+
+// parseRange parses a range like "0-7", or a single number.
+func parseRange(s string) (uint64, uint64, error) {
+	first, last, isRange := strings.Cut(s, "-")
+	low, err := strconv.ParseUint(first, 10, 32)
+	if err != nil {
+		return 0, 0, err
+	}
+	high := low
+	if isRange {
+		high, err = strconv.ParseUint(last, 10, 32)
+		if err != nil {
+			return 0, 0, err
+		}
+	}
+	if high < low {
+		return 0, 0, fmt.Errorf("invalid range %q", s)
+	}
+	return low, high, nil
+}
+
+// parseCPUList parses a list of CPUs like "0-1,28".
+func parseCPUList(list string) ([]int, error) {
+	var cpus []int
+	for _, r := range strings.Split(list, ",") {
+		low, high, err := parseRange(r)
+		if err != nil {
+			return nil, fmt.Errorf("invalid CPU list %q", list)
+		}
+		for cpu := low; cpu <= high; cpu++ {
+			cpus = append(cpus, int(cpu))
+		}
+	}
+	return cpus, nil
+}
+
+// sortTrafficCounters sorts counters by socket, channel number and direction.
+func sortTrafficCounters(counters []info.TrafficCounter) {
+	sort.Slice(counters, func(i, j int) bool {
+		a, b := counters[i], counters[j]
+		if a.Socket != b.Socket {
+			return a.Socket < b.Socket
+		}
+		if a.Channel != b.Channel {
+			x, errA := strconv.Atoi(a.Channel)
+			y, errB := strconv.Atoi(b.Channel)
+			if errA == nil && errB == nil {
+				return x < y
+			}
+			return a.Channel < b.Channel
+		}
+		return a.Direction < b.Direction
+	})
+}
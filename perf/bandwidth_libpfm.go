@@ -0,0 +1,189 @@
+//go:build libpfm && cgo
+
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Collector of the memory and interconnect traffic.
+package perf
+
+import (
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"golang.org/x/sys/unix"
+	"k8s.io/klog/v2"
+
+	info "github.com/google/cadvisor/info/v1"
+)
+
+// bandwidthGroup holds the events of a PMU counted on a CPU.
+type bandwidthGroup struct {
+	cpu    int
+	events []bandwidthEvent
+	group  group
+	leader readerCloser
+	files  []readerCloser
+}
+
+// bandwidthCollector counts the traffic of the memory channels and of the
+// interconnect links of the machine.
+type bandwidthCollector struct {
+	lock        sync.Mutex
+	groups      []*bandwidthGroup
+	cpuToSocket map[int]int
+
+	// Handle for mocking purposes.
+	perfEventOpen func(attr *unix.PerfEventAttr, pid int, cpu int, groupFd int, flags int) (fd int, err error)
+	ioctlSetInt   func(fd int, req uint, value int) error
+}
+
+func newBandwidthCollector(devicesPath string, cpuToSocket map[int]int) (*bandwidthCollector, error) {
+	events, err := findBandwidthEvents(devicesPath)
+	if err != nil {
+		return nil, err
+	}
+	c := &bandwidthCollector{
+		cpuToSocket:   cpuToSocket,
+		perfEventOpen: unix.PerfEventOpen,
+		ioctlSetInt:   unix.IoctlSetInt,
+	}
+	if err := c.setup(events); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// setup counts the events of each PMU as a group on every CPU of the PMU.
+func (c *bandwidthCollector) setup(events []bandwidthEvent) error {
+	var pmus []string
+	pmuEvents := map[string][]bandwidthEvent{}
+	for _, event := range events {
+		if _, ok := pmuEvents[event.pmu]; !ok {
+			pmus = append(pmus, event.pmu)
+		}
+		pmuEvents[event.pmu] = append(pmuEvents[event.pmu], event)
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	for _, pmu := range pmus {
+		for _, cpu := range pmuEvents[pmu][0].cpus {
+			g, err := c.openGroup(cpu, pmuEvents[pmu])
+			if err != nil {
+				klog.Warningf("Traffic of PMU %q will not be measured on CPU %d: %v", pmu, cpu, err)
+				continue
+			}
+			c.groups = append(c.groups, g)
+		}
+	}
+	if len(c.groups) == 0 {
+		return fmt.Errorf("no PMU counting memory or interconnect traffic could be set up")
+	}
+	return nil
+}
+
+func (c *bandwidthCollector) openGroup(cpu int, events []bandwidthEvent) (*bandwidthGroup, error) {
+	g := &bandwidthGroup{cpu: cpu, events: events}
+	groupFd := groupLeaderFileDescriptor
+	for _, event := range events {
+		attr := &unix.PerfEventAttr{
+			Type:   event.pmuType,
+			Config: event.config[0],
+			Ext1:   event.config[1],
+			Ext2:   event.config[2],
+		}
+		setAttributes(attr, groupFd == groupLeaderFileDescriptor)
+		fd, err := c.perfEventOpen(attr, uncorePID, cpu, groupFd, 0)
+		if err != nil {
+			closeBandwidthGroup(g)
+			return nil, fmt.Errorf("setting up perf event %q failed: %w", event.name, err)
+		}
+		file := os.NewFile(uintptr(fd), event.name)
+		g.files = append(g.files, file)
+		g.group.names = append(g.group.names, event.name)
+		if groupFd == groupLeaderFileDescriptor {
+			groupFd = fd
+			g.leader = file
+			g.group.leaderName = event.name
+		}
+	}
+	for _, req := range []uint{unix.PERF_EVENT_IOC_RESET, unix.PERF_EVENT_IOC_ENABLE} {
+		if err := c.ioctlSetInt(groupFd, req, 0); err != nil {
+			closeBandwidthGroup(g)
+			return nil, err
+		}
+	}
+	return g, nil
+}
+
+func closeBandwidthGroup(g *bandwidthGroup) {
+	for _, file := range g.files {
+		if err := file.Close(); err != nil {
+			klog.Warningf("Unable to close perf event file descriptor for %q: %v", g.group.leaderName, err)
+		}
+	}
+}
+
+// Traffic returns the traffic counted since the collector was set up, summed
+// by socket, channel and direction.
+func (c *bandwidthCollector) Traffic() *info.UncoreTraffic {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	type key struct {
+		traffic, channel, direction string
+		socket                      int
+	}
+	counters := map[key]uint64{}
+	for _, g := range c.groups {
+		values, err := getPerfValues(g.leader, g.group, 0)
+		if err != nil {
+			klog.Warningf("Unable to read from perf_event_file (event: %q, CPU: %d): %q", g.group.leaderName, g.cpu, err.Error())
+			continue
+		}
+		socket, ok := c.cpuToSocket[g.cpu]
+		if !ok {
+			// Socket is unknown.
+			socket = -1
+		}
+		for i, value := range values {
+			event := g.events[i]
+			counters[key{event.traffic, event.channel, event.direction, socket}] += uint64(float64(value.Value) * event.bytesPerCount)
+		}
+	}
+
+	traffic := &info.UncoreTraffic{Timestamp: time.Now()}
+	for k, bytes := range counters {
+		counter := info.TrafficCounter{Socket: k.socket, Channel: k.channel, Direction: k.direction, Bytes: bytes}
+		if k.traffic == memoryTraffic {
+			traffic.Memory = append(traffic.Memory, counter)
+		} else {
+			traffic.Interconnect = append(traffic.Interconnect, counter)
+		}
+	}
+	sortTrafficCounters(traffic.Memory)
+	sortTrafficCounters(traffic.Interconnect)
+	return traffic
+}
+
+func (c *bandwidthCollector) Destroy() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	for _, g := range c.groups {
+		closeBandwidthGroup(g)
+	}
+	c.groups = nil
+}
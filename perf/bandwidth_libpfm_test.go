@@ -0,0 +1,102 @@
+//go:build libpfm && cgo
+
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package perf
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/sys/unix"
+
+	info "github.com/google/cadvisor/info/v1"
+)
+
+func TestBandwidthCollectorSetup(t *testing.T) {
+	events := []bandwidthEvent{
+		{name: "uncore_imc_0/cas_count_read", pmu: "uncore_imc_0", pmuType: 14, cpus: []int{0, 28}, config: [3]uint64{0x0f04}},
+		{name: "uncore_imc_0/cas_count_write", pmu: "uncore_imc_0", pmuType: 14, cpus: []int{0, 28}, config: [3]uint64{0x3004}},
+		{name: "uncore_upi_0/event=0x02,umask=0x0f/", pmu: "uncore_upi_0", pmuType: 20, cpus: []int{0}, config: [3]uint64{0x0f02}},
+	}
+	var leaders []int
+	collector := &bandwidthCollector{}
+	collector.perfEventOpen = func(attr *unix.PerfEventAttr, pid int, cpu int, groupFd int, flags int) (fd int, err error) {
+		if attr.Type == 20 {
+			return -1, unix.ENOENT
+		}
+		if groupFd == groupLeaderFileDescriptor {
+			leaders = append(leaders, cpu)
+		}
+		return int(attr.Config), nil
+	}
+	collector.ioctlSetInt = func(fd int, req uint, value int) error {
+		return nil
+	}
+
+	// The UPI link that cannot be opened is skipped.
+	assert.NoError(t, collector.setup(events))
+	assert.Equal(t, []int{0, 28}, leaders)
+	assert.Len(t, collector.groups, 2)
+	assert.Equal(t, []string{"uncore_imc_0/cas_count_read", "uncore_imc_0/cas_count_write"}, collector.groups[1].group.names)
+	assert.Equal(t, 28, collector.groups[1].cpu)
+
+	collector = &bandwidthCollector{perfEventOpen: collector.perfEventOpen, ioctlSetInt: collector.ioctlSetInt}
+	assert.Error(t, collector.setup(events[2:]))
+}
+
+func TestBandwidthCollectorTraffic(t *testing.T) {
+	groupBuffer := func(values ...uint64) buffer {
+		b := buffer{bytes.NewBuffer([]byte{})}
+		err := binary.Write(b, binary.LittleEndian, GroupReadFormat{Nr: uint64(len(values)), TimeEnabled: 100, TimeRunning: 100})
+		assert.NoError(t, err)
+		for i, value := range values {
+			err = binary.Write(b, binary.LittleEndian, Values{Value: value, ID: uint64(i)})
+			assert.NoError(t, err)
+		}
+		return b
+	}
+	imc := []bandwidthEvent{
+		{traffic: memoryTraffic, channel: "0", direction: "read", bytesPerCount: 64},
+		{traffic: memoryTraffic, channel: "0", direction: "write", bytesPerCount: 64},
+	}
+	df := []bandwidthEvent{
+		{traffic: interconnectTraffic, channel: "0", direction: "transmit", bytesPerCount: 32},
+	}
+	collector := &bandwidthCollector{
+		cpuToSocket: map[int]int{0: 0, 1: 0, 28: 1},
+		groups: []*bandwidthGroup{
+			{cpu: 0, events: imc, group: group{names: []string{"read", "write"}, leaderName: "read"}, leader: groupBuffer(10, 2)},
+			{cpu: 28, events: imc, group: group{names: []string{"read", "write"}, leaderName: "read"}, leader: groupBuffer(5, 1)},
+			// Dies of the same socket are summed.
+			{cpu: 0, events: df, group: group{names: []string{"transmit"}, leaderName: "transmit"}, leader: groupBuffer(3)},
+			{cpu: 1, events: df, group: group{names: []string{"transmit"}, leaderName: "transmit"}, leader: groupBuffer(4)},
+		},
+	}
+
+	traffic := collector.Traffic()
+	assert.Equal(t, []info.TrafficCounter{
+		{Socket: 0, Channel: "0", Direction: "read", Bytes: 640},
+		{Socket: 0, Channel: "0", Direction: "write", Bytes: 128},
+		{Socket: 1, Channel: "0", Direction: "read", Bytes: 320},
+		{Socket: 1, Channel: "0", Direction: "write", Bytes: 64},
+	}, traffic.Memory)
+	assert.Equal(t, []info.TrafficCounter{
+		{Socket: 0, Channel: "0", Direction: "transmit", Bytes: 224},
+	}, traffic.Interconnect)
+	assert.False(t, traffic.Timestamp.IsZero())
+}
@@ -0,0 +1,155 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package perf
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	info "github.com/google/cadvisor/info/v1"
+)
+
+// mockBandwidthDevices writes the sysfs files of PMUs to a temporary
+// directory, mapping the file paths to their content.
+func mockBandwidthDevices(t *testing.T, files map[string]string) string {
+	path := t.TempDir()
+	for name, content := range files {
+		file := filepath.Join(path, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(file), 0o755))
+		require.NoError(t, os.WriteFile(file, []byte(content), 0o644))
+	}
+	return path
+}
+
+func TestFindBandwidthEvents(t *testing.T) {
+	path := mockBandwidthDevices(t, map[string]string{
+		"cpu/type":                                 "4",
+		"uncore_imc_0/type":                        "14",
+		"uncore_imc_0/cpumask":                     "0,28\n",
+		"uncore_imc_0/format/event":                "config:0-7",
+		"uncore_imc_0/format/umask":                "config:8-15",
+		"uncore_imc_0/events/cas_count_read":       "event=0x04,umask=0x0f",
+		"uncore_imc_0/events/cas_count_read.scale": "6.103515625e-5",
+		"uncore_imc_0/events/cas_count_read.unit":  "MiB",
+		"uncore_imc_0/events/cas_count_write":      "event=0x04,umask=0x30",
+		"uncore_upi_1/type":                        "20",
+		"uncore_upi_1/cpumask":                     "0",
+		"uncore_upi_1/format/event":                "config:0-7",
+		"uncore_upi_1/format/umask":                "config:8-15",
+		"uncore_upi_2/type":                        "21",
+		"uncore_upi_2/cpumask":                     "0",
+		"amd_df/type":                              "11",
+		"amd_df/cpumask":                           "0-1",
+		"amd_df/format/event":                      "config:0-7,32-35,59-60",
+		"amd_df/format/umask":                      "config:8-15",
+	})
+
+	events, err := findBandwidthEvents(path)
+	require.NoError(t, err)
+	// The UPI link without format files is skipped.
+	require.Len(t, events, 16)
+
+	assert.Equal(t, bandwidthEvent{
+		name:          "amd_df/event=0x107,umask=0x38/",
+		pmu:           "amd_df",
+		pmuType:       11,
+		cpus:          []int{0, 1},
+		config:        [3]uint64{0x1_0000_3807},
+		traffic:       memoryTraffic,
+		channel:       "4",
+		direction:     "all",
+		bytesPerCount: 64,
+	}, events[4])
+	assert.Equal(t, "amd_df/event=0x887,umask=0x2/", events[11].name)
+	assert.Equal(t, interconnectTraffic, events[11].traffic)
+	assert.Equal(t, "3", events[11].channel)
+	assert.Equal(t, [3]uint64{0x8_0000_0287}, events[11].config)
+
+	// The sysfs aliases of the kernel are preferred.
+	assert.Equal(t, bandwidthEvent{
+		name:          "uncore_imc_0/cas_count_read",
+		pmu:           "uncore_imc_0",
+		pmuType:       14,
+		cpus:          []int{0, 28},
+		config:        [3]uint64{0x0f04},
+		traffic:       memoryTraffic,
+		channel:       "0",
+		direction:     "read",
+		bytesPerCount: 64,
+	}, events[12])
+	assert.Equal(t, [3]uint64{0x3004}, events[13].config)
+	assert.Equal(t, "write", events[13].direction)
+
+	assert.Equal(t, bandwidthEvent{
+		name:          "uncore_upi_1/event=0x02,umask=0x0f/",
+		pmu:           "uncore_upi_1",
+		pmuType:       20,
+		cpus:          []int{0},
+		config:        [3]uint64{0x0f02},
+		traffic:       interconnectTraffic,
+		channel:       "1",
+		direction:     "transmit",
+		bytesPerCount: 64.0 / 9,
+	}, events[14])
+}
+
+func TestEncodeTerms(t *testing.T) {
+	path := mockBandwidthDevices(t, map[string]string{
+		"event":  "config:0-7,32-35",
+		"umask":  "config:8-15",
+		"edge":   "config:18",
+		"filter": "config1:0-9",
+	})
+
+	config, err := encodeTerms(path, "event=0x1c7,umask=0x38,edge,filter=10")
+	assert.NoError(t, err)
+	assert.Equal(t, [3]uint64{0x1_0004_38c7, 10}, config)
+
+	_, err = encodeTerms(path, "umask=0x100")
+	assert.ErrorContains(t, err, "does not fit")
+	_, err = encodeTerms(path, "inv=1")
+	assert.ErrorContains(t, err, "unknown term")
+	_, err = encodeTerms(path, "event=x")
+	assert.ErrorContains(t, err, "invalid value")
+}
+
+func TestParseCPUList(t *testing.T) {
+	cpus, err := parseCPUList("0-2,28")
+	assert.NoError(t, err)
+	assert.Equal(t, []int{0, 1, 2, 28}, cpus)
+
+	_, err = parseCPUList("2-1")
+	assert.Error(t, err)
+}
+
+func TestSortTrafficCounters(t *testing.T) {
+	counters := []info.TrafficCounter{
+		{Socket: 1, Channel: "0", Direction: "read"},
+		{Socket: 0, Channel: "10", Direction: "read"},
+		{Socket: 0, Channel: "2", Direction: "write"},
+		{Socket: 0, Channel: "2", Direction: "read"},
+	}
+	sortTrafficCounters(counters)
+	assert.Equal(t, []info.TrafficCounter{
+		{Socket: 0, Channel: "2", Direction: "read"},
+		{Socket: 0, Channel: "2", Direction: "write"},
+		{Socket: 0, Channel: "10", Direction: "read"},
+		{Socket: 1, Channel: "0", Direction: "read"},
+	}, counters)
+}
@@ -99,7 +99,7 @@ func (c *collector) UpdateStats(stats *info.ContainerStats) error {
 
 	for _, group := range c.cpuFiles {
 		for cpu, file := range group.cpuFiles[group.leaderName] {
-			stat, err := readGroupPerfStat(file, group, cpu, c.cgroupPath)
+			stat, err := readGroupPerfStat(file, group, cpu, c.cgroupPath, c.events.minScalingRatio())
 			if err != nil {
 				klog.Warningf("Unable to read from perf_event_file (event: %q, CPU: %d) for %q: %q", group.leaderName, cpu, c.cgroupPath, err.Error())
 				continue
@@ -112,8 +112,8 @@ func (c *collector) UpdateStats(stats *info.ContainerStats) error {
 	return nil
 }
 
-func readGroupPerfStat(file readerCloser, group group, cpu int, cgroupPath string) ([]info.PerfStat, error) {
-	values, err := getPerfValues(file, group)
+func readGroupPerfStat(file readerCloser, group group, cpu int, cgroupPath string, minScalingRatio float64) ([]info.PerfStat, error) {
+	values, err := getPerfValues(file, group, minScalingRatio)
 	if err != nil {
 		return nil, err
 	}
@@ -130,7 +130,10 @@ func readGroupPerfStat(file readerCloser, group group, cpu int, cgroupPath strin
 	return perfStats, nil
 }
 
-func getPerfValues(file readerCloser, group group) ([]info.PerfValue, error) {
+// getPerfValues reads the values of the events of the group, flagging them
+// unreliable if they were measured less than minScalingRatio of the time they
+// were enabled.
+func getPerfValues(file readerCloser, group group, minScalingRatio float64) ([]info.PerfValue, error) {
 	// 24 bytes of GroupReadFormat struct.
 	// 16 bytes of Values struct for each element in group.
 	// See https://man7.org/linux/man-pages/man2/perf_event_open.2.html section "Reading results" with PERF_FORMAT_GROUP specified.
@@ -156,12 +159,17 @@ func getPerfValues(file readerCloser, group group) ([]info.PerfValue, error) {
 	if perfData.TimeRunning != 0 && perfData.TimeEnabled != 0 {
 		scalingRatio = float64(perfData.TimeRunning) / float64(perfData.TimeEnabled)
 	}
+	// An event enabled but never measured has no value to scale.
+	unreliable := scalingRatio < minScalingRatio || (perfData.TimeEnabled != 0 && perfData.TimeRunning == 0)
 
 	perfValues := make([]info.PerfValue, perfData.Nr)
 	if scalingRatio != float64(0) {
 		for i, name := range group.names {
 			perfValues[i] = info.PerfValue{
 				ScalingRatio: scalingRatio,
+				TimeEnabled:  perfData.TimeEnabled,
+				TimeRunning:  perfData.TimeRunning,
+				Unreliable:   unreliable,
 				Value:        uint64(float64(values[i].Value) / scalingRatio),
 				Name:         name,
 			}
@@ -170,6 +178,9 @@ func getPerfValues(file readerCloser, group group) ([]info.PerfValue, error) {
 		for i, name := range group.names {
 			perfValues[i] = info.PerfValue{
 				ScalingRatio: scalingRatio,
+				TimeEnabled:  perfData.TimeEnabled,
+				TimeRunning:  perfData.TimeRunning,
+				Unreliable:   unreliable,
 				Value:        values[i].Value,
 				Name:         name,
 			}
@@ -119,6 +119,8 @@ func TestCollector_UpdateStats(t *testing.T) {
 	assert.Contains(t, stats.PerfStats, info.PerfStat{
 		PerfValue: info.PerfValue{
 			ScalingRatio: 0.3333333333333333,
+			TimeEnabled:  3,
+			TimeRunning:  1,
 			Value:        999999999,
 			Name:         "cycles",
 		},
@@ -127,6 +129,8 @@ func TestCollector_UpdateStats(t *testing.T) {
 	assert.Contains(t, stats.PerfStats, info.PerfStat{
 		PerfValue: info.PerfValue{
 			ScalingRatio: 1,
+			TimeEnabled:  100,
+			TimeRunning:  100,
 			Value:        123456789,
 			Name:         "instructions",
 		},
@@ -135,6 +139,8 @@ func TestCollector_UpdateStats(t *testing.T) {
 	assert.Contains(t, stats.PerfStats, info.PerfStat{
 		PerfValue: info.PerfValue{
 			ScalingRatio: 1.0,
+			TimeEnabled:  100,
+			TimeRunning:  100,
 			Value:        123456,
 			Name:         "cache-misses",
 		},
@@ -143,6 +149,8 @@ func TestCollector_UpdateStats(t *testing.T) {
 	assert.Contains(t, stats.PerfStats, info.PerfStat{
 		PerfValue: info.PerfValue{
 			ScalingRatio: 1.0,
+			TimeEnabled:  100,
+			TimeRunning:  100,
 			Value:        654321,
 			Name:         "cache-references",
 		},
@@ -277,6 +285,7 @@ var readGroupPerfStatCases = []struct {
 		perfStat: []info.PerfStat{{
 			PerfValue: info.PerfValue{
 				ScalingRatio: 1,
+				TimeRunning:  1,
 				Value:        5,
 				Name:         "some metric",
 			},
@@ -300,6 +309,8 @@ var readGroupPerfStatCases = []struct {
 		perfStat: []info.PerfStat{{
 			PerfValue: info.PerfValue{
 				ScalingRatio: 0.5,
+				TimeEnabled:  4,
+				TimeRunning:  2,
 				Value:        8,
 				Name:         "some metric",
 			},
@@ -323,6 +334,8 @@ var readGroupPerfStatCases = []struct {
 		perfStat: []info.PerfStat{{
 			PerfValue: info.PerfValue{
 				ScalingRatio: 1.0,
+				TimeEnabled:  1,
+				Unreliable:   true,
 				Value:        4,
 				Name:         "some metric",
 			},
@@ -346,6 +359,7 @@ var readGroupPerfStatCases = []struct {
 		perfStat: []info.PerfStat{{
 			PerfValue: info.PerfValue{
 				ScalingRatio: 1.0,
+				TimeRunning:  1,
 				Value:        4,
 				Name:         "some metric",
 			},
@@ -392,6 +406,7 @@ var readGroupPerfStatCases = []struct {
 		perfStat: []info.PerfStat{{
 			PerfValue: info.PerfValue{
 				ScalingRatio: 1.0,
+				TimeRunning:  3,
 				Value:        0,
 				Name:         "some metric",
 			},
@@ -399,6 +414,32 @@ var readGroupPerfStatCases = []struct {
 		}},
 		err: nil,
 	},
+	{
+		test: "scaling - 0.05, under the minimum",
+		file: GroupReadFormat{
+			TimeEnabled: 20,
+			TimeRunning: 1,
+			Nr:          1,
+		},
+		valuesFile: Values{
+			Value: 4,
+			ID:    0,
+		},
+		name: "some metric",
+		cpu:  5,
+		perfStat: []info.PerfStat{{
+			PerfValue: info.PerfValue{
+				ScalingRatio: 0.05,
+				TimeEnabled:  20,
+				TimeRunning:  1,
+				Unreliable:   true,
+				Value:        80,
+				Name:         "some metric",
+			},
+			Cpu: 5,
+		}},
+		err: nil,
+	},
 }
 
 func TestReadPerfStat(t *testing.T) {
@@ -413,7 +454,7 @@ func TestReadPerfStat(t *testing.T) {
 				cpuFiles:   nil,
 				names:      []string{test.name},
 				leaderName: test.name,
-			}, test.cpu, "/")
+			}, test.cpu, "/", defaultMinScalingRatio)
 			assert.Equal(tt, test.perfStat, stat)
 			assert.Equal(tt, test.err, err)
 		})
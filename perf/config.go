@@ -30,6 +30,64 @@ type PerfEvents struct {
 
 	// Uncore perf events to be measured.
 	Uncore Events `json:"uncore,omitempty"`
+
+	// Core perf events to be measured instead of Core for the containers
+	// matching the labels of the set. The first matching set applies.
+	Containers []ContainerEvents `json:"containers,omitempty"`
+
+	// Bandwidth enables measuring the memory bandwidth and the interconnect
+	// traffic of the machine with the uncore PMUs it has.
+	Bandwidth bool `json:"bandwidth,omitempty"`
+
+	// MinScalingRatio is the scaling ratio under which the values of the
+	// events, scaled up as they were multiplexed, are flagged unreliable.
+	// Defaults to defaultMinScalingRatio.
+	MinScalingRatio float64 `json:"min_scaling_ratio,omitempty"`
+}
+
+// defaultMinScalingRatio flags the events measured less than a tenth of the
+// time they were enabled.
+const defaultMinScalingRatio = 0.1
+
+// minScalingRatio returns the scaling ratio under which values are flagged
+// unreliable.
+func (e PerfEvents) minScalingRatio() float64 {
+	if e.MinScalingRatio == 0 {
+		return defaultMinScalingRatio
+	}
+	return e.MinScalingRatio
+}
+
+// ContainerEvents are the core perf events measured for the containers
+// matching labels.
+type ContainerEvents struct {
+	// Labels the containers must all have, with these values.
+	Labels map[string]string `json:"labels"`
+
+	// Core perf events to be measured for the matching containers.
+	Core Events `json:"core"`
+}
+
+// ForContainer returns the events to be measured for a container with the
+// given labels.
+func (e PerfEvents) ForContainer(labels map[string]string) PerfEvents {
+	events := PerfEvents{Core: e.Core, Uncore: e.Uncore, MinScalingRatio: e.MinScalingRatio}
+	for _, set := range e.Containers {
+		if matchLabels(set.Labels, labels) {
+			events.Core = set.Core
+			break
+		}
+	}
+	return events
+}
+
+func matchLabels(selector, labels map[string]string) bool {
+	for name, value := range selector {
+		if v, ok := labels[name]; !ok || v != value {
+			return false
+		}
+	}
+	return true
 }
 
 type Events struct {
@@ -80,6 +138,25 @@ func (c *Config) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
+// readConfig reads the perf events configuration file.
+func readConfig(configFile string) (PerfEvents, error) {
+	file, err := os.Open(configFile)
+	if err != nil {
+		return PerfEvents{}, fmt.Errorf("unable to read configuration file %q: %w", configFile, err)
+	}
+	defer file.Close()
+
+	config, err := parseConfig(file)
+	if err != nil {
+		return PerfEvents{}, fmt.Errorf("unable to parse configuration file %q: %w", configFile, err)
+	}
+
+	if len(config.Core.Events) == 0 && len(config.Uncore.Events) == 0 && len(config.Containers) == 0 && !config.Bandwidth {
+		return PerfEvents{}, fmt.Errorf("there is no events in config file %q", configFile)
+	}
+	return config, nil
+}
+
 func parseConfig(file *os.File) (events PerfEvents, err error) {
 	decoder := json.NewDecoder(file)
 	err = decoder.Decode(&events)
@@ -49,3 +49,47 @@ func TestConfigParsing(t *testing.T) {
 	assert.Equal(t, Event("cas_count_write"), events.Uncore.CustomEvents[0].Name)
 
 }
+
+func TestConfigForContainer(t *testing.T) {
+	events, err := readConfig("testing/perf-containers.json")
+	assert.Nil(t, err)
+	assert.Len(t, events.Containers, 2)
+
+	// The first matching set applies, and the uncore events are kept.
+	database := events.ForContainer(map[string]string{"io.kubernetes.pod.namespace": "batch", "tier": "database"})
+	assert.Len(t, database.Core.Events, 2)
+	assert.Equal(t, Event("cache-misses"), database.Core.Events[1].events[0])
+	assert.Equal(t, events.Uncore, database.Uncore)
+	assert.Empty(t, database.Containers)
+
+	batch := events.ForContainer(map[string]string{"io.kubernetes.pod.namespace": "batch", "tier": "web"})
+	assert.Len(t, batch.Core.Events, 1)
+	assert.Equal(t, Event("instructions"), batch.Core.Events[0].events[0])
+
+	// Containers matching no set measure the default core events.
+	for _, labels := range []map[string]string{nil, {"tier": "database"}} {
+		other := events.ForContainer(labels)
+		assert.Equal(t, events.Core, other.Core)
+		assert.Equal(t, events.Uncore, other.Uncore)
+	}
+}
+
+func TestMinScalingRatio(t *testing.T) {
+	assert.Equal(t, defaultMinScalingRatio, PerfEvents{}.minScalingRatio())
+	assert.Equal(t, 0.25, PerfEvents{MinScalingRatio: 0.25}.minScalingRatio())
+
+	events := PerfEvents{MinScalingRatio: 0.25, Containers: []ContainerEvents{{Core: Events{Events: []Group{{events: []Event{"instructions"}}}}}}}
+	assert.Equal(t, 0.25, events.ForContainer(nil).MinScalingRatio)
+}
+
+func TestReadConfig(t *testing.T) {
+	_, err := readConfig("testing/perf-no-events.json")
+	assert.ErrorContains(t, err, "there is no events")
+
+	_, err = readConfig("testing/missing.json")
+	assert.ErrorContains(t, err, "unable to read configuration file")
+
+	events, err := readConfig("testing/perf.json")
+	assert.Nil(t, err)
+	assert.Len(t, events.Core.Events, 2)
+}
@@ -0,0 +1,56 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Manager of perf events for containers.
+package perf
+
+import (
+	info "github.com/google/cadvisor/info/v1"
+	"github.com/google/cadvisor/stats"
+)
+
+// Manager is a stats.Manager whose events can be scoped to containers by
+// their labels, and be reloaded from the configuration file at runtime.
+type Manager interface {
+	stats.Manager
+
+	// GetContainerCollector returns a collector of the events of the
+	// container of cgroupPath with the given labels.
+	GetContainerCollector(cgroupPath string, labels map[string]string) (stats.Collector, error)
+
+	// Reload re-reads the configuration file, and returns whether the events
+	// changed. The events of existing collectors do not change.
+	Reload() (bool, error)
+
+	// GetUncoreTraffic returns the memory and interconnect traffic of the
+	// machine, or nil if it is not measured.
+	GetUncoreTraffic() (*info.UncoreTraffic, error)
+}
+
+// noopManager is the Manager used when perf events are not measured.
+type noopManager struct {
+	stats.NoopManager
+}
+
+func (m *noopManager) GetContainerCollector(string, map[string]string) (stats.Collector, error) {
+	return &stats.NoopCollector{}, nil
+}
+
+func (m *noopManager) Reload() (bool, error) {
+	return false, nil
+}
+
+func (m *noopManager) GetUncoreTraffic() (*info.UncoreTraffic, error) {
+	return nil, nil
+}
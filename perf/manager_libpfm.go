@@ -18,8 +18,10 @@
 package perf
 
 import (
-	"fmt"
-	"os"
+	"reflect"
+	"sync"
+
+	"k8s.io/klog/v2"
 
 	info "github.com/google/cadvisor/info/v1"
 	"github.com/google/cadvisor/stats"
@@ -27,29 +29,24 @@ import (
 )
 
 type manager struct {
-	events      PerfEvents
+	configFile  string
 	onlineCPUs  []int
 	cpuToSocket map[int]int
-	stats.NoopDestroy
+
+	lock   sync.RWMutex
+	events PerfEvents
+	// Nil unless the traffic of the machine is measured.
+	bandwidth *bandwidthCollector
 }
 
-func NewManager(configFile string, topology []info.Node) (stats.Manager, error) {
+func NewManager(configFile string, topology []info.Node) (Manager, error) {
 	if configFile == "" {
-		return &stats.NoopManager{}, nil
+		return &noopManager{}, nil
 	}
 
-	file, err := os.Open(configFile)
+	config, err := readConfig(configFile)
 	if err != nil {
-		return nil, fmt.Errorf("unable to read configuration file %q: %w", configFile, err)
-	}
-
-	config, err := parseConfig(file)
-	if err != nil {
-		return nil, fmt.Errorf("unable to parse configuration file %q: %w", configFile, err)
-	}
-
-	if len(config.Core.Events) == 0 && len(config.Uncore.Events) == 0 {
-		return nil, fmt.Errorf("there is no events in config file %q", configFile)
+		return nil, err
 	}
 
 	onlineCPUs := sysinfo.GetOnlineCPUs(topology)
@@ -60,11 +57,38 @@ func NewManager(configFile string, topology []info.Node) (stats.Manager, error)
 		cpuToSocket[cpu] = sysinfo.GetSocketFromCPU(topology, cpu)
 	}
 
-	return &manager{events: config, onlineCPUs: onlineCPUs, cpuToSocket: cpuToSocket}, nil
+	m := &manager{configFile: configFile, events: config, onlineCPUs: onlineCPUs, cpuToSocket: cpuToSocket}
+	m.setupBandwidth()
+	return m, nil
+}
+
+// setupBandwidth starts or stops measuring the traffic of the machine as the
+// events require.
+func (m *manager) setupBandwidth() {
+	if m.events.Bandwidth == (m.bandwidth != nil) {
+		return
+	}
+	if m.bandwidth != nil {
+		m.bandwidth.Destroy()
+		m.bandwidth = nil
+		return
+	}
+	var err error
+	m.bandwidth, err = newBandwidthCollector(eventSourceDevicesPath, m.cpuToSocket)
+	if err != nil {
+		klog.Errorf("Memory bandwidth and interconnect traffic will not be measured: %v", err)
+	}
 }
 
 func (m *manager) GetCollector(cgroupPath string) (stats.Collector, error) {
-	collector := newCollector(cgroupPath, m.events, m.onlineCPUs, m.cpuToSocket)
+	return m.GetContainerCollector(cgroupPath, nil)
+}
+
+func (m *manager) GetContainerCollector(cgroupPath string, labels map[string]string) (stats.Collector, error) {
+	m.lock.RLock()
+	events := m.events.ForContainer(labels)
+	m.lock.RUnlock()
+	collector := newCollector(cgroupPath, events, m.onlineCPUs, m.cpuToSocket)
 	err := collector.setup()
 	if err != nil {
 		collector.Destroy()
@@ -72,3 +96,36 @@ func (m *manager) GetCollector(cgroupPath string) (stats.Collector, error) {
 	}
 	return collector, nil
 }
+
+func (m *manager) Reload() (bool, error) {
+	config, err := readConfig(m.configFile)
+	if err != nil {
+		return false, err
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	previous := m.events
+	m.events = config
+	m.setupBandwidth()
+	// Collectors of containers do not measure the traffic of the machine.
+	previous.Bandwidth = config.Bandwidth
+	return !reflect.DeepEqual(config, previous), nil
+}
+
+func (m *manager) GetUncoreTraffic() (*info.UncoreTraffic, error) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+	if m.bandwidth == nil {
+		return nil, nil
+	}
+	return m.bandwidth.Traffic(), nil
+}
+
+func (m *manager) Destroy() {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if m.bandwidth != nil {
+		m.bandwidth.Destroy()
+		m.bandwidth = nil
+	}
+}
@@ -18,12 +18,13 @@
 package perf
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 
 	info "github.com/google/cadvisor/info/v1"
-	"github.com/google/cadvisor/stats"
 )
 
 func TestEmptyConfigPassed(t *testing.T) {
@@ -37,7 +38,7 @@ func TestNoConfigFilePassed(t *testing.T) {
 	manager, err := NewManager("", []info.Node{})
 
 	assert.Nil(t, err)
-	_, ok := manager.(*stats.NoopManager)
+	_, ok := manager.(*noopManager)
 	assert.True(t, ok)
 }
 
@@ -62,3 +63,30 @@ func TestNewManager(t *testing.T) {
 	_, ok := managerInstance.(*manager)
 	assert.True(t, ok)
 }
+
+func TestReload(t *testing.T) {
+	configFile := filepath.Join(t.TempDir(), "perf.json")
+	writeConfig := func(content string) {
+		assert.Nil(t, os.WriteFile(configFile, []byte(content), 0o644))
+	}
+	writeConfig(`{"core": {"events": ["cycles"]}}`)
+	managerInstance, err := NewManager(configFile, []info.Node{})
+	assert.Nil(t, err)
+
+	changed, err := managerInstance.Reload()
+	assert.Nil(t, err)
+	assert.False(t, changed)
+
+	writeConfig(`{"core": {"events": ["instructions"]}}`)
+	changed, err = managerInstance.Reload()
+	assert.Nil(t, err)
+	assert.True(t, changed)
+	assert.Equal(t, Event("instructions"), managerInstance.(*manager).events.Core.Events[0].events[0])
+
+	// The events in effect are kept if the file is invalid.
+	writeConfig(`{"core": {"events": []}}`)
+	changed, err = managerInstance.Reload()
+	assert.NotNil(t, err)
+	assert.False(t, changed)
+	assert.Equal(t, Event("instructions"), managerInstance.(*manager).events.Core.Events[0].events[0])
+}
@@ -19,12 +19,11 @@ package perf
 
 import (
 	info "github.com/google/cadvisor/info/v1"
-	"github.com/google/cadvisor/stats"
 
 	"k8s.io/klog/v2"
 )
 
-func NewManager(configFile string, topology []info.Node) (stats.Manager, error) {
+func NewManager(configFile string, topology []info.Node) (Manager, error) {
 	klog.V(1).Info("cAdvisor is build without cgo and/or libpfm support. Perf event counters are not available.")
-	return &stats.NoopManager{}, nil
+	return &noopManager{}, nil
 }
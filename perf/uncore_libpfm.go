@@ -129,6 +129,7 @@ type uncoreCollector struct {
 	events             []Group
 	eventToCustomEvent map[Event]*CustomEvent
 	cpuToSocket        map[int]int
+	minScalingRatio    float64
 
 	// Handle for mocking purposes.
 	perfEventOpen func(attr *unix.PerfEventAttr, pid int, cpu int, groupFd int, flags int) (fd int, err error)
@@ -143,9 +144,10 @@ func NewUncoreCollector(cgroupPath string, events PerfEvents, cpuToSocket map[in
 	}
 
 	collector := &uncoreCollector{
-		cpuToSocket:   cpuToSocket,
-		perfEventOpen: unix.PerfEventOpen,
-		ioctlSetInt:   unix.IoctlSetInt,
+		cpuToSocket:     cpuToSocket,
+		minScalingRatio: events.minScalingRatio(),
+		perfEventOpen:   unix.PerfEventOpen,
+		ioctlSetInt:     unix.IoctlSetInt,
 	}
 
 	err := collector.setup(events, systemDevicesPath)
@@ -343,7 +345,7 @@ func (c *uncoreCollector) UpdateStats(stats *info.ContainerStats) error {
 	for _, groupPMUs := range c.cpuFiles {
 		for pmu, group := range groupPMUs {
 			for cpu, file := range group.cpuFiles[group.leaderName] {
-				stat, err := readPerfUncoreStat(file, group, cpu, pmu, c.cpuToSocket)
+				stat, err := readPerfUncoreStat(file, group, cpu, pmu, c.cpuToSocket, c.minScalingRatio)
 				if err != nil {
 					klog.Warningf("Unable to read from perf_event_file (event: %q, CPU: %d) for %q: %q", group.leaderName, cpu, pmu, err.Error())
 					continue
@@ -492,8 +494,8 @@ func (c *uncoreCollector) deleteGroup(groupIndex int) {
 	delete(c.cpuFiles, groupIndex)
 }
 
-func readPerfUncoreStat(file readerCloser, group group, cpu int, pmu string, cpuToSocket map[int]int) ([]info.PerfUncoreStat, error) {
-	values, err := getPerfValues(file, group)
+func readPerfUncoreStat(file readerCloser, group group, cpu int, pmu string, cpuToSocket map[int]int, minScalingRatio float64) ([]info.PerfUncoreStat, error) {
+	values, err := getPerfValues(file, group, minScalingRatio)
 	if err != nil {
 		return nil, err
 	}
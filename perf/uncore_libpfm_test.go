@@ -275,6 +275,7 @@ func TestReadPerfUncoreStat(t *testing.T) {
 	expectedStat := []v1.PerfUncoreStat{{
 		PerfValue: v1.PerfValue{
 			ScalingRatio: 1,
+			TimeRunning:  1,
 			Value:        4,
 			Name:         "foo",
 		},
@@ -296,7 +297,7 @@ func TestReadPerfUncoreStat(t *testing.T) {
 		cpuFiles:   nil,
 		names:      []string{"foo"},
 		leaderName: "foo",
-	}, 1, "bar", cpuToSocket)
+	}, 1, "bar", cpuToSocket, defaultMinScalingRatio)
 	assert.NoError(t, err)
 	assert.Equal(t, expectedStat, stat)
 }
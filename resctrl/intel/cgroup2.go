@@ -0,0 +1,248 @@
+//go:build linux
+
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Monitoring groups following the threads of cgroup v2 containers.
+package intel
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/opencontainers/runc/libcontainer/intelrdt"
+	"golang.org/x/sys/unix"
+	"k8s.io/klog/v2"
+)
+
+const (
+	cgroupThreadsFileName = "cgroup.threads"
+
+	// Time after which the monitoring groups left by a previous run that no
+	// container claimed are removed.
+	reconcileDelay = time.Minute
+)
+
+// syncMonitoringGroup makes the monitoring group of the container hold the
+// threads of its cgroup and of the descendants, and only those, and returns
+// its path. The group is named after the container, so that it is adopted
+// again after a restart.
+func syncMonitoringGroup(containerName string) (string, error) {
+	if containerName == rootContainer {
+		return rootResctrl, nil
+	}
+
+	threads, err := getCgroupThreads(filepath.Join(pidsPath, containerName))
+	if err != nil {
+		return "", fmt.Errorf("couldn't obtain %q container threads: %w", containerName, err)
+	}
+	if len(threads) == 0 {
+		return "", fmt.Errorf("couldn't obtain %q container threads: there is no threads in cgroup", containerName)
+	}
+
+	controlGroupPath, controlGroupTasks, err := findControlGroup(threads)
+	if err != nil {
+		return "", fmt.Errorf("%q %q: %q", noControlGroupFoundError, containerName, err)
+	}
+
+	monGroupPath := filepath.Join(controlGroupPath, monGroupsDirName, monitoringGroupName(containerName))
+	err = os.Mkdir(monGroupPath, os.ModePerm)
+	if err != nil && !errors.Is(err, fs.ErrExist) {
+		return "", fmt.Errorf("couldn't create monitoring group directory for %q container: %w", containerName, err)
+	}
+	tasks, err := readTasksFile(filepath.Join(monGroupPath, tasksFileName))
+	if err != nil {
+		return "", err
+	}
+
+	for _, thread := range threads {
+		if _, ok := tasks[thread]; ok {
+			delete(tasks, thread)
+			continue
+		}
+		// Threads of other control groups cannot join the monitoring group.
+		if _, ok := controlGroupTasks[thread]; !ok {
+			klog.V(4).Infof("Thread %s of %q container is not in control group %q", thread, containerName, controlGroupPath)
+			continue
+		}
+		err = writeTask(monGroupPath, thread)
+		if err != nil {
+			return "", fmt.Errorf("coudn't assign threads to %q container monitoring group: %w", containerName, err)
+		}
+	}
+	// The threads that left the cgroup go back to the default monitoring
+	// group of the control group.
+	for thread := range tasks {
+		err = writeTask(controlGroupPath, thread)
+		if err != nil {
+			return "", fmt.Errorf("couldn't remove threads from %q container monitoring group: %w", containerName, err)
+		}
+	}
+
+	return monGroupPath, nil
+}
+
+// writeTask moves the thread to the group, ignoring threads that exited.
+func writeTask(group, thread string) error {
+	tid, err := strconv.Atoi(thread)
+	if err != nil {
+		return fmt.Errorf("couldn't parse thread %q: %v", thread, err)
+	}
+	err = intelrdt.WriteIntelRdtTasks(group, tid)
+	if errors.Is(err, unix.ESRCH) {
+		return nil
+	}
+	return err
+}
+
+// getCgroupThreads returns the sorted threads of the cgroup and of its
+// descendants.
+func getCgroupThreads(cgroupPath string) ([]string, error) {
+	var threads []string
+	err := filepath.WalkDir(cgroupPath, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			// Descendants removed meanwhile have no threads.
+			if path != cgroupPath && errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+		if !d.IsDir() {
+			return nil
+		}
+		file, err := os.Open(filepath.Join(path, cgroupThreadsFileName))
+		if err != nil {
+			if path != cgroupPath && errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+		defer file.Close()
+		scanner := bufio.NewScanner(file)
+		for scanner.Scan() {
+			if thread := scanner.Text(); thread != "" {
+				threads = append(threads, thread)
+			}
+		}
+		return scanner.Err()
+	})
+	sort.Strings(threads)
+	return threads, err
+}
+
+// findControlGroup returns the path and the tasks of the control group of
+// the first of the threads found in one.
+func findControlGroup(threads []string) (string, map[string]struct{}, error) {
+	groups := []string{rootResctrl}
+	files, err := os.ReadDir(rootResctrl)
+	if err != nil {
+		return "", nil, fmt.Errorf("couldn't obtain groups paths: %w", err)
+	}
+	for _, file := range files {
+		if _, ok := groupDirectories[file.Name()]; !ok && file.IsDir() {
+			groups = append(groups, filepath.Join(rootResctrl, file.Name()))
+		}
+	}
+
+	tasks := make([]map[string]struct{}, len(groups))
+	for i, group := range groups {
+		tasks[i], err = readTasksFile(filepath.Join(group, tasksFileName))
+		if err != nil {
+			return "", nil, err
+		}
+	}
+	for _, thread := range threads {
+		for i, group := range groups {
+			if _, ok := tasks[i][thread]; ok {
+				return group, tasks[i], nil
+			}
+		}
+	}
+	return "", nil, errors.New("none of the threads is in a control group")
+}
+
+// reconciler removes the monitoring groups left by a previous run of
+// cAdvisor, unless containers claim them.
+type reconciler struct {
+	mu    sync.Mutex
+	stale map[string]struct{}
+	timer *time.Timer
+}
+
+// newReconciler removes the empty monitoring groups of cAdvisor right away,
+// and the other ones after reconcileDelay if no container claimed them.
+func newReconciler() (*reconciler, error) {
+	var groups []string
+	for _, pattern := range []string{
+		filepath.Join(rootResctrl, monGroupsDirName, monGroupPrefix+"-*"),
+		filepath.Join(rootResctrl, "*", monGroupsDirName, monGroupPrefix+"-*"),
+	} {
+		matches, err := filepath.Glob(pattern)
+		if err != nil {
+			return nil, err
+		}
+		groups = append(groups, matches...)
+	}
+
+	r := &reconciler{stale: make(map[string]struct{})}
+	for _, group := range groups {
+		tasks, err := readTasksFile(filepath.Join(group, tasksFileName))
+		if err != nil {
+			klog.Warningf("Unable to read left monitoring group %q: %v", group, err)
+			continue
+		}
+		if len(tasks) == 0 {
+			removeMonitoringGroup(group)
+			continue
+		}
+		r.stale[group] = struct{}{}
+	}
+	r.timer = time.AfterFunc(reconcileDelay, r.reconcile)
+	return r, nil
+}
+
+// claim keeps the monitoring group of a container.
+func (r *reconciler) claim(group string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.stale, group)
+}
+
+func (r *reconciler) reconcile() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for group := range r.stale {
+		removeMonitoringGroup(group)
+		delete(r.stale, group)
+	}
+}
+
+func (r *reconciler) stop() {
+	r.timer.Stop()
+}
+
+func removeMonitoringGroup(group string) {
+	klog.V(4).Infof("Removing monitoring group %q left by a previous run", group)
+	if err := os.RemoveAll(group); err != nil {
+		klog.Warningf("Unable to remove monitoring group %q: %v", group, err)
+	}
+}
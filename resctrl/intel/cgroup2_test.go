@@ -0,0 +1,164 @@
+//go:build linux
+
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Tests of the monitoring groups of cgroup v2 containers.
+package intel
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	info "github.com/google/cadvisor/info/v1"
+)
+
+// writeFiles writes the files under root, mapping their paths to their
+// content.
+func writeFiles(t *testing.T, root string, files map[string]string) {
+	for name, content := range files {
+		path := filepath.Join(root, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), os.ModePerm))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+	}
+}
+
+func readFile(t *testing.T, path string) string {
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	return string(content)
+}
+
+// mockCgroupV2 mocks a resctrl filesystem with a control group "ctrl", and
+// the cgroups of containers.
+func mockCgroupV2(t *testing.T) {
+	previousRoot, previousPids := rootResctrl, pidsPath
+	rootResctrl, pidsPath = t.TempDir(), t.TempDir()
+	cgroupV2 = true
+	t.Cleanup(func() {
+		rootResctrl, pidsPath = previousRoot, previousPids
+		cgroupV2 = false
+	})
+	writeFiles(t, rootResctrl, map[string]string{
+		tasksFileName:                        "1\n2\n3\n",
+		filepath.Join(infoDirName, "x"):      "",
+		filepath.Join("ctrl", tasksFileName): "4\n5\n6\n",
+	})
+	require.NoError(t, os.Mkdir(filepath.Join(rootResctrl, monGroupsDirName), os.ModePerm))
+	writeFiles(t, pidsPath, map[string]string{
+		filepath.Join("container", cgroupThreadsFileName):          "4\n",
+		filepath.Join("container", "child", cgroupThreadsFileName): "5\n",
+		filepath.Join("mixed", cgroupThreadsFileName):              "1\n4\n",
+		filepath.Join("empty", cgroupThreadsFileName):              "",
+	})
+}
+
+func TestGetCgroupThreads(t *testing.T) {
+	mockCgroupV2(t)
+
+	threads, err := getCgroupThreads(filepath.Join(pidsPath, "container"))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"4", "5"}, threads)
+
+	_, err = getCgroupThreads(filepath.Join(pidsPath, "missing"))
+	assert.Error(t, err)
+}
+
+func TestSyncMonitoringGroup(t *testing.T) {
+	mockCgroupV2(t)
+	monGroupPath := filepath.Join(rootResctrl, "ctrl", monGroupsDirName, "cadvisor-container")
+	// The group left by a previous run is adopted, and thread 6 left the
+	// container.
+	writeFiles(t, monGroupPath, map[string]string{tasksFileName: "4\n6\n"})
+
+	path, err := syncMonitoringGroup("/container")
+	assert.NoError(t, err)
+	assert.Equal(t, monGroupPath, path)
+	// The fake tasks files keep the last thread written.
+	assert.Equal(t, "5", readFile(t, filepath.Join(monGroupPath, tasksFileName)))
+	assert.Equal(t, "6", readFile(t, filepath.Join(rootResctrl, "ctrl", tasksFileName)))
+
+	// Threads of other control groups are skipped. The kernel creates the
+	// tasks files of new groups.
+	writeFiles(t, rootResctrl, map[string]string{filepath.Join(monGroupsDirName, "cadvisor-mixed", tasksFileName): ""})
+	path, err = syncMonitoringGroup("/mixed")
+	assert.NoError(t, err)
+	assert.Equal(t, filepath.Join(rootResctrl, monGroupsDirName, "cadvisor-mixed"), path)
+	assert.Equal(t, "1", readFile(t, filepath.Join(path, tasksFileName)))
+
+	path, err = syncMonitoringGroup(rootContainer)
+	assert.NoError(t, err)
+	assert.Equal(t, rootResctrl, path)
+
+	_, err = syncMonitoringGroup("/empty")
+	assert.ErrorContains(t, err, "there is no threads in cgroup")
+}
+
+func TestCollectorCgroupV2(t *testing.T) {
+	mockCgroupV2(t)
+	enabledCMT, enabledMBM = true, true
+	previousPath := filepath.Join(rootResctrl, monGroupsDirName, "cadvisor-container")
+	writeFiles(t, previousPath, map[string]string{tasksFileName: ""})
+	// The kernel creates the tasks files of new groups.
+	monGroupPath := filepath.Join(rootResctrl, "ctrl", monGroupsDirName, "cadvisor-container")
+	writeFiles(t, monGroupPath, map[string]string{tasksFileName: ""})
+
+	collector := newCollector("/container", nil, 0, 2, "", true)
+	collector.resctrlPath = previousPath
+	assert.NoError(t, collector.setup())
+	// The container moved to the "ctrl" control group.
+	assert.Equal(t, monGroupPath, collector.resctrlPath)
+	assert.True(t, collector.running)
+	assert.NoDirExists(t, previousPath)
+
+	mockResctrlMonData(monGroupPath)
+	var stats info.ContainerStats
+	assert.NoError(t, collector.UpdateStats(&stats))
+	assert.Len(t, stats.Resctrl.Cache, 2)
+
+	// Setting up a container without threads is retried at every update.
+	collector = newCollector("/empty", nil, 0, 2, "", true)
+	assert.NoError(t, collector.setup())
+	assert.False(t, collector.running)
+	assert.Error(t, collector.UpdateStats(&stats))
+}
+
+func TestReconciler(t *testing.T) {
+	mockCgroupV2(t)
+	groups := filepath.Join(rootResctrl, monGroupsDirName)
+	writeFiles(t, rootResctrl, map[string]string{
+		filepath.Join(monGroupsDirName, "cadvisor-empty", tasksFileName):         "",
+		filepath.Join(monGroupsDirName, "cadvisor-gone", tasksFileName):          "2\n",
+		filepath.Join(monGroupsDirName, "cadvisor-live", tasksFileName):          "3\n",
+		filepath.Join(monGroupsDirName, "other", tasksFileName):                  "",
+		filepath.Join("ctrl", monGroupsDirName, "cadvisor-moved", tasksFileName): "5\n",
+	})
+
+	r, err := newReconciler()
+	require.NoError(t, err)
+	defer r.stop()
+	assert.NoDirExists(t, filepath.Join(groups, "cadvisor-empty"))
+	assert.Len(t, r.stale, 3)
+
+	r.claim(filepath.Join(groups, "cadvisor-live"))
+	r.reconcile()
+	assert.NoDirExists(t, filepath.Join(groups, "cadvisor-gone"))
+	assert.NoDirExists(t, filepath.Join(rootResctrl, "ctrl", monGroupsDirName, "cadvisor-moved"))
+	assert.DirExists(t, filepath.Join(groups, "cadvisor-live"))
+	assert.DirExists(t, filepath.Join(groups, "other"))
+}
@@ -43,6 +43,8 @@ type collector struct {
 	vendorID          string
 	mu                sync.Mutex
 	inHostNamespace   bool
+	// Nil unless the monitoring groups follow cgroup v2 containers.
+	reconciler *reconciler
 }
 
 func newCollector(id string, getContainerPids func() ([]string, error), interval time.Duration, numberOfNUMANodes int, vendorID string, inHostNamespace bool) *collector {
@@ -51,6 +53,17 @@ func newCollector(id string, getContainerPids func() ([]string, error), interval
 }
 
 func (c *collector) setup() error {
+	if cgroupV2 {
+		// The monitoring group is synced with the threads of the cgroup at
+		// every update instead.
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		if err := c.syncMonitoringGroup(); err != nil {
+			klog.V(4).Infof("Failed to setup container %q resctrl collector: %s \n Trying again in next updates.", c.id, err)
+		}
+		return nil
+	}
+
 	var err error
 	c.resctrlPath, err = prepareMonitoringGroup(c.id, c.getContainerPids, c.inHostNamespace)
 
@@ -113,13 +126,40 @@ func (c *collector) checkMonitoringGroup() error {
 	return nil
 }
 
+// syncMonitoringGroup syncs the monitoring group of a cgroup v2 container,
+// removing the previous one if the container moved between control groups.
+func (c *collector) syncMonitoringGroup() error {
+	newPath, err := syncMonitoringGroup(c.id)
+	if err != nil {
+		c.running = false
+		return err
+	}
+	if c.resctrlPath != "" && newPath != c.resctrlPath {
+		err = c.clear()
+		if err != nil {
+			return fmt.Errorf("couldn't clear previous monitoring group: %w", err)
+		}
+	}
+	c.resctrlPath = newPath
+	if c.reconciler != nil {
+		c.reconciler.claim(newPath)
+	}
+	c.running = true
+	return nil
+}
+
 func (c *collector) UpdateStats(stats *info.ContainerStats) error {
 	c.mu.Lock()
 	defer c.mu.Unlock()
+	if cgroupV2 && !c.destroyed {
+		if err := c.syncMonitoringGroup(); err != nil {
+			return err
+		}
+	}
 	if c.running {
 		stats.Resctrl = info.ResctrlStats{}
 
-		resctrlStats, err := getIntelRDTStatsFrom(c.resctrlPath, c.vendorID)
+		resctrlStats, domains, err := getIntelRDTStatsFrom(c.resctrlPath, c.vendorID)
 		if err != nil {
 			return err
 		}
@@ -127,17 +167,23 @@ func (c *collector) UpdateStats(stats *info.ContainerStats) error {
 		stats.Resctrl.MemoryBandwidth = make([]info.MemoryBandwidthStats, 0, c.numberOfNUMANodes)
 		stats.Resctrl.Cache = make([]info.CacheStats, 0, c.numberOfNUMANodes)
 
-		for _, numaNodeStats := range *resctrlStats.MBMStats {
+		for i, numaNodeStats := range *resctrlStats.MBMStats {
 			stats.Resctrl.MemoryBandwidth = append(stats.Resctrl.MemoryBandwidth,
 				info.MemoryBandwidthStats{
+					ID:         domains[i],
+					Socket:     domainSocket(domains[i]),
 					TotalBytes: numaNodeStats.MBMTotalBytes,
 					LocalBytes: numaNodeStats.MBMLocalBytes,
 				})
 		}
 
-		for _, numaNodeStats := range *resctrlStats.CMTStats {
+		for i, numaNodeStats := range *resctrlStats.CMTStats {
 			stats.Resctrl.Cache = append(stats.Resctrl.Cache,
-				info.CacheStats{LLCOccupancy: numaNodeStats.LLCOccupancy})
+				info.CacheStats{
+					ID:           domains[i],
+					Socket:       domainSocket(domains[i]),
+					LLCOccupancy: numaNodeStats.LLCOccupancy,
+				})
 		}
 	}
 
@@ -65,6 +65,8 @@ func TestUpdateStats(t *testing.T) {
 
 	mockResctrlMonData(collector.resctrlPath)
 	enabledCMT, enabledMBM = true, true
+	domainSockets = map[int]int{0: 0}
+	defer func() { domainSockets = map[int]int{} }()
 
 	stats := info.ContainerStats{}
 
@@ -72,15 +74,19 @@ func TestUpdateStats(t *testing.T) {
 	err = collector.UpdateStats(&stats)
 	assert.NoError(t, err)
 	assert.Equal(t, stats.Resctrl.Cache, []info.CacheStats{
-		{LLCOccupancy: 1111},
-		{LLCOccupancy: 3333},
+		{ID: 0, Socket: 0, LLCOccupancy: 1111},
+		{ID: 1, Socket: -1, LLCOccupancy: 3333},
 	})
 	assert.Equal(t, stats.Resctrl.MemoryBandwidth, []info.MemoryBandwidthStats{
 		{
+			ID:         0,
+			Socket:     0,
 			TotalBytes: 3333,
 			LocalBytes: 2222,
 		},
 		{
+			ID:         1,
+			Socket:     -1,
 			TotalBytes: 3333,
 			LocalBytes: 1111,
 		},
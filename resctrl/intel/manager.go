@@ -29,14 +29,16 @@ import (
 )
 
 type manager struct {
-	stats.NoopDestroy
 	interval        time.Duration
 	vendorID        string
 	inHostNamespace bool
+	// Nil unless the monitoring groups follow cgroup v2 containers.
+	reconciler *reconciler
 }
 
 func (m *manager) GetCollector(containerName string, getContainerPids func() ([]string, error), numberOfNUMANodes int) (stats.Collector, error) {
 	collector := newCollector(containerName, getContainerPids, m.interval, numberOfNUMANodes, m.vendorID, m.inHostNamespace)
+	collector.reconciler = m.reconciler
 	err := collector.setup()
 	if err != nil {
 		return &stats.NoopCollector{}, err
@@ -62,7 +64,20 @@ func NewManager(interval time.Duration, setup func() error, vendorID string, inH
 		klog.Warning("--docker_only should be set when collecting Resctrl metrics! See the runtime docs.")
 	}
 
-	return &manager{interval: interval, vendorID: vendorID, inHostNamespace: inHostNamespace}, nil
+	m := &manager{interval: interval, vendorID: vendorID, inHostNamespace: inHostNamespace}
+	if cgroupV2 {
+		m.reconciler, err = newReconciler()
+		if err != nil {
+			klog.Warningf("Unable to find the monitoring groups left by a previous run: %v", err)
+		}
+	}
+	return m, nil
+}
+
+func (m *manager) Destroy() {
+	if m.reconciler != nil {
+		m.reconciler.stop()
+	}
 }
 
 type NoopManager struct {
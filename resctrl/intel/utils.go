@@ -27,9 +27,13 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/google/cadvisor/container/common"
+
 	"github.com/opencontainers/cgroups"
 	"github.com/opencontainers/cgroups/fs2"
 	"github.com/opencontainers/runc/libcontainer/intelrdt"
+
+	"k8s.io/klog/v2"
 )
 
 const (
@@ -56,16 +60,21 @@ const (
 	minContainerNameLen      = 2 // "/<container_name>" e.g. "/a"
 	unavailable              = "Unavailable"
 	monGroupPrefix           = "cadvisor"
+	monDataL3Prefix          = "mon_L3_"
 )
 
 var (
 	rootResctrl          = ""
 	pidsPath             = ""
 	processPath          = "/proc"
+	cpuPath              = "/sys/devices/system/cpu"
+	cgroupV2             = false
 	enabledMBM           = false
 	enabledCMT           = false
 	isResctrlInitialized = false
-	groupDirectories     = map[string]struct{}{
+	// L3 cache domain id to socket.
+	domainSockets    = map[int]int{}
+	groupDirectories = map[string]struct{}{
 		cpusFileName:     {},
 		cpusListFileName: {},
 		infoDirName:      {},
@@ -85,7 +94,8 @@ func Setup() error {
 		return fmt.Errorf("unable to initialize resctrl: %v", err)
 	}
 
-	if cgroups.IsCgroup2UnifiedMode() {
+	cgroupV2 = common.IsCgroup2UnifiedMode()
+	if cgroupV2 {
 		pidsPath = fs2.UnifiedMountpoint
 	} else {
 		pidsPath = filepath.Join(fs2.UnifiedMountpoint, cpuCgroup)
@@ -94,6 +104,11 @@ func Setup() error {
 	enabledMBM = intelrdt.IsMBMEnabled()
 	enabledCMT = intelrdt.IsCMTEnabled()
 
+	domainSockets, err = getDomainSockets()
+	if err != nil {
+		klog.Warningf("Unable to map the L3 cache domains to sockets: %v", err)
+	}
+
 	isResctrlInitialized = true
 
 	return nil
@@ -131,16 +146,7 @@ func prepareMonitoringGroup(containerName string, getContainerPids func() ([]str
 
 	// Prepare new one if not exists.
 	if monGroupPath == "" {
-		// Remove leading prefix.
-		// e.g. /my/container -> my/container
-		if len(containerName) >= minContainerNameLen && containerName[0] == containerPrefix {
-			containerName = containerName[1:]
-		}
-
-		// Add own prefix and use `-` instead `/`.
-		// e.g. my/container -> cadvisor-my-container
-		properContainerName := fmt.Sprintf("%s-%s", monGroupPrefix, strings.Replace(containerName, "/", "-", -1))
-		monGroupPath = filepath.Join(controlGroupPath, monitoringGroupDir, properContainerName)
+		monGroupPath = filepath.Join(controlGroupPath, monitoringGroupDir, monitoringGroupName(containerName))
 
 		err = os.MkdirAll(monGroupPath, os.ModePerm)
 		if err != nil {
@@ -174,6 +180,20 @@ func prepareMonitoringGroup(containerName string, getContainerPids func() ([]str
 	return monGroupPath, nil
 }
 
+// monitoringGroupName returns the name of the monitoring group cAdvisor
+// creates for the container.
+func monitoringGroupName(containerName string) string {
+	// Remove leading prefix.
+	// e.g. /my/container -> my/container
+	if len(containerName) >= minContainerNameLen && containerName[0] == containerPrefix {
+		containerName = containerName[1:]
+	}
+
+	// Add own prefix and use `-` instead `/`.
+	// e.g. my/container -> cadvisor-my-container
+	return fmt.Sprintf("%s-%s", monGroupPrefix, strings.Replace(containerName, "/", "-", -1))
+}
+
 func getPids(containerName string) ([]int, error) {
 	if len(containerName) == 0 {
 		// No container name passed.
@@ -323,37 +343,45 @@ func readStatFrom(path string, vendorID string) (uint64, error) {
 	return stat, nil
 }
 
-func getIntelRDTStatsFrom(path string, vendorID string) (intelrdt.Stats, error) {
+// getIntelRDTStatsFrom returns the stats of the monitoring group at path, and
+// the ids of the L3 cache domains they were read from.
+func getIntelRDTStatsFrom(path string, vendorID string) (intelrdt.Stats, []int, error) {
 	stats := intelrdt.Stats{}
 
 	statsDirectories, err := filepath.Glob(filepath.Join(path, monDataDirName, "*"))
 	if err != nil {
-		return stats, err
+		return stats, nil, err
 	}
 
 	if len(statsDirectories) == 0 {
-		return stats, fmt.Errorf("there is no mon_data stats directories: %q", path)
+		return stats, nil, fmt.Errorf("there is no mon_data stats directories: %q", path)
 	}
 
 	var cmtStats []intelrdt.CMTNumaNodeStats
 	var mbmStats []intelrdt.MBMNumaNodeStats
+	domains := make([]int, 0, len(statsDirectories))
 
 	for _, dir := range statsDirectories {
+		domain, err := strconv.Atoi(strings.TrimPrefix(filepath.Base(dir), monDataL3Prefix))
+		if err != nil {
+			return stats, nil, fmt.Errorf("unable to parse the L3 cache domain of %q: %v", dir, err)
+		}
+		domains = append(domains, domain)
 		if enabledCMT {
 			llcOccupancy, err := readStatFrom(filepath.Join(dir, llcOccupancyFileName), vendorID)
 			if err != nil {
-				return stats, err
+				return stats, nil, err
 			}
 			cmtStats = append(cmtStats, intelrdt.CMTNumaNodeStats{LLCOccupancy: llcOccupancy})
 		}
 		if enabledMBM {
 			mbmTotalBytes, err := readStatFrom(filepath.Join(dir, mbmTotalBytesFileName), vendorID)
 			if err != nil {
-				return stats, err
+				return stats, nil, err
 			}
 			mbmLocalBytes, err := readStatFrom(filepath.Join(dir, mbmLocalBytesFileName), vendorID)
 			if err != nil {
-				return stats, err
+				return stats, nil, err
 			}
 			mbmStats = append(mbmStats, intelrdt.MBMNumaNodeStats{
 				MBMTotalBytes: mbmTotalBytes,
@@ -365,5 +393,50 @@ func getIntelRDTStatsFrom(path string, vendorID string) (intelrdt.Stats, error)
 	stats.CMTStats = &cmtStats
 	stats.MBMStats = &mbmStats
 
-	return stats, nil
+	return stats, domains, nil
+}
+
+// getDomainSockets maps the ids of the L3 caches, which are the resctrl
+// monitoring domains, to the sockets of the CPUs sharing them.
+func getDomainSockets() (map[int]int, error) {
+	sockets := map[int]int{}
+	caches, err := filepath.Glob(filepath.Join(cpuPath, "cpu*", "cache", "index*"))
+	if err != nil {
+		return sockets, err
+	}
+	for _, cache := range caches {
+		level, err := readIntFrom(filepath.Join(cache, "level"))
+		if err != nil || level != 3 {
+			continue
+		}
+		id, err := readIntFrom(filepath.Join(cache, "id"))
+		if err != nil {
+			return sockets, err
+		}
+		if _, ok := sockets[id]; ok {
+			continue
+		}
+		socket, err := readIntFrom(filepath.Join(cache, "..", "..", "topology", "physical_package_id"))
+		if err != nil {
+			return sockets, err
+		}
+		sockets[id] = socket
+	}
+	return sockets, nil
+}
+
+func readIntFrom(path string) (int, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.Atoi(string(bytes.TrimSpace(content)))
+}
+
+// domainSocket returns the socket of the L3 cache domain, -1 if unknown.
+func domainSocket(domain int) int {
+	if socket, ok := domainSockets[domain]; ok {
+		return socket
+	}
+	return -1
 }
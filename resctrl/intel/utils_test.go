@@ -31,6 +31,7 @@ import (
 	"github.com/opencontainers/runc/libcontainer/intelrdt"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func init() {
@@ -674,13 +675,47 @@ func TestGetStats(t *testing.T) {
 	for _, test := range testCases {
 		containerPath, _ := prepareMonitoringGroup(test.container, mockGetContainerPids, true)
 		mockResctrlMonData(containerPath)
-		actual, err := getIntelRDTStatsFrom(containerPath, "")
+		actual, domains, err := getIntelRDTStatsFrom(containerPath, "")
 		checkError(t, err, test.err)
 		assert.Equal(t, test.expected.CMTStats, actual.CMTStats)
 		assert.Equal(t, test.expected.MBMStats, actual.MBMStats)
+		assert.Equal(t, []int{0, 1}, domains)
 	}
 }
 
+func TestGetDomainSockets(t *testing.T) {
+	path, err := os.MkdirTemp("", "cpu")
+	require.NoError(t, err)
+	defer os.RemoveAll(path)
+	cpuPath = path
+	defer func() { cpuPath = "/sys/devices/system/cpu" }()
+
+	// Two sockets with an L3 cache each, and a L2 cache sharing its id.
+	for cpu, socket := range []string{"0", "0", "1", "1"} {
+		cpuDir := filepath.Join(path, fmt.Sprintf("cpu%d", cpu))
+		files := map[string]string{
+			"topology/physical_package_id": socket,
+			"cache/index2/level":           "2",
+			"cache/index2/id":              "7",
+			"cache/index3/level":           "3",
+			"cache/index3/id":              socket,
+		}
+		for name, value := range files {
+			require.NoError(t, os.MkdirAll(filepath.Dir(filepath.Join(cpuDir, name)), os.ModePerm))
+			require.NoError(t, os.WriteFile(filepath.Join(cpuDir, name), []byte(value+"\n"), 0o644))
+		}
+	}
+
+	sockets, err := getDomainSockets()
+	require.NoError(t, err)
+	assert.Equal(t, map[int]int{0: 0, 1: 1}, sockets)
+
+	domainSockets = sockets
+	defer func() { domainSockets = map[int]int{} }()
+	assert.Equal(t, 1, domainSocket(1))
+	assert.Equal(t, -1, domainSocket(2))
+}
+
 func TestReadTasksFile(t *testing.T) {
 	var testCases = []struct {
 		tasksFile string
@@ -19,6 +19,7 @@ import (
 	"sort"
 
 	info "github.com/google/cadvisor/info/v1"
+	"github.com/google/cadvisor/metrics/selfmetrics"
 )
 
 type StorageDriver interface {
@@ -38,6 +39,21 @@ func RegisterStorageDriver(name string, f StorageDriverFunc) {
 	registeredPlugins[name] = f
 }
 
+// QueueLengther is implemented by storage drivers that buffer stats before
+// writing them.
+type QueueLengther interface {
+	// QueueLength returns the number of entries, stats or the points
+	// derived from them, buffered but not written yet.
+	QueueLength() int
+}
+
+// EventStorer is implemented by storage drivers that can also store container
+// events.
+type EventStorer interface {
+	// AddEvent stores a container event.
+	AddEvent(event *info.Event) error
+}
+
 func New(name string) (StorageDriver, error) {
 	if name == "" {
 		return nil, nil
@@ -46,7 +62,61 @@ func New(name string) (StorageDriver, error) {
 	if !ok {
 		return nil, fmt.Errorf("unknown backend storage driver: %s", name)
 	}
-	return f()
+	driver, err := f()
+	if err != nil {
+		return nil, err
+	}
+	instrumented := newInstrumentedDriver(name, driver)
+	if events, ok := driver.(EventStorer); ok {
+		return &instrumentedEventDriver{instrumentedDriver: instrumented, events: events}, nil
+	}
+	return instrumented, nil
+}
+
+// instrumentedDriver reports the errors and the queue length of a storage
+// driver in the self metrics.
+type instrumentedDriver struct {
+	StorageDriver
+	name       string
+	unregister func()
+}
+
+func newInstrumentedDriver(name string, driver StorageDriver) *instrumentedDriver {
+	d := &instrumentedDriver{StorageDriver: driver, name: name}
+	if q, ok := driver.(QueueLengther); ok {
+		d.unregister = selfmetrics.RegisterStorageDriverQueue(name, q.QueueLength)
+	}
+	return d
+}
+
+func (d *instrumentedDriver) AddStats(cInfo *info.ContainerInfo, stats *info.ContainerStats) error {
+	err := d.StorageDriver.AddStats(cInfo, stats)
+	if err != nil {
+		selfmetrics.StorageDriverErrors.WithLabelValues(d.name).Inc()
+	}
+	return err
+}
+
+// instrumentedEventDriver is an instrumentedDriver of a driver that also
+// stores events.
+type instrumentedEventDriver struct {
+	*instrumentedDriver
+	events EventStorer
+}
+
+func (d *instrumentedEventDriver) AddEvent(event *info.Event) error {
+	err := d.events.AddEvent(event)
+	if err != nil {
+		selfmetrics.StorageDriverErrors.WithLabelValues(d.name).Inc()
+	}
+	return err
+}
+
+func (d *instrumentedDriver) Close() error {
+	if d.unregister != nil {
+		d.unregister()
+	}
+	return d.StorageDriver.Close()
 }
 
 func ListDrivers() []string {
@@ -0,0 +1,105 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	info "github.com/google/cadvisor/info/v1"
+	"github.com/google/cadvisor/metrics/selfmetrics"
+)
+
+// queueDriver fails every other AddStats and reports a fixed queue length.
+type queueDriver struct {
+	calls  int
+	closed bool
+}
+
+func (d *queueDriver) AddStats(*info.ContainerInfo, *info.ContainerStats) error {
+	d.calls++
+	if d.calls%2 == 0 {
+		return errors.New("write failed")
+	}
+	return nil
+}
+
+func (d *queueDriver) QueueLength() int {
+	return 7
+}
+
+func (d *queueDriver) Close() error {
+	d.closed = true
+	return nil
+}
+
+func TestNewInstrumentsDriver(t *testing.T) {
+	inner := &queueDriver{}
+	RegisterStorageDriver("test_queue", func() (StorageDriver, error) { return inner, nil })
+	defer delete(registeredPlugins, "test_queue")
+
+	driver, err := New("test_queue")
+	require.NoError(t, err)
+	for i := 0; i < 4; i++ {
+		_ = driver.AddStats(&info.ContainerInfo{}, &info.ContainerStats{})
+	}
+	assert.Equal(t, 4, inner.calls)
+	assert.Equal(t, 2.0, testutil.ToFloat64(selfmetrics.StorageDriverErrors.WithLabelValues("test_queue")))
+	assert.Equal(t, 1, testutil.CollectAndCount(selfmetrics.NewCollector(), "cadvisor_storage_driver_queue_length"))
+
+	require.NoError(t, driver.Close())
+	assert.True(t, inner.closed)
+	assert.Zero(t, testutil.CollectAndCount(selfmetrics.NewCollector(), "cadvisor_storage_driver_queue_length"))
+}
+
+type eventDriver struct {
+	queueDriver
+	events []*info.Event
+}
+
+func (d *eventDriver) AddEvent(event *info.Event) error {
+	d.events = append(d.events, event)
+	return nil
+}
+
+func TestNewKeepsEventStorers(t *testing.T) {
+	RegisterStorageDriver("test_events", func() (StorageDriver, error) { return &eventDriver{}, nil })
+	RegisterStorageDriver("test_no_events", func() (StorageDriver, error) { return &queueDriver{}, nil })
+	defer delete(registeredPlugins, "test_events")
+	defer delete(registeredPlugins, "test_no_events")
+
+	driver, err := New("test_events")
+	require.NoError(t, err)
+	storer, ok := driver.(EventStorer)
+	require.True(t, ok)
+	assert.NoError(t, storer.AddEvent(&info.Event{ContainerName: "/a"}))
+
+	driver, err = New("test_no_events")
+	require.NoError(t, err)
+	_, ok = driver.(EventStorer)
+	assert.False(t, ok)
+}
+
+func TestNewUnknownDriver(t *testing.T) {
+	_, err := New("no_such_driver")
+	assert.Error(t, err)
+	driver, err := New("")
+	assert.NoError(t, err)
+	assert.Nil(t, driver)
+}
@@ -58,13 +58,15 @@ func fileContainsAmazonIdentifier(filename string) bool {
 }
 
 func getAwsMetadata(name string) string {
-	cfg, err := config.LoadDefaultConfig(context.TODO())
+	ctx, cancel := context.WithTimeout(context.Background(), cloudinfo.MetadataTimeout)
+	defer cancel()
+	cfg, err := config.LoadDefaultConfig(ctx)
 	if err != nil {
 		return info.UnknownInstance
 	}
 
 	client := imds.NewFromConfig(cfg)
-	data, err := client.GetMetadata(context.TODO(), &imds.GetMetadataInput{
+	data, err := client.GetMetadata(ctx, &imds.GetMetadataInput{
 		Path: name,
 	})
 	if err != nil {
@@ -86,3 +88,11 @@ func (provider) GetInstanceType() info.InstanceType {
 func (provider) GetInstanceID() info.InstanceID {
 	return info.InstanceID(getAwsMetadata("instance-id"))
 }
+
+func (provider) GetZone() string {
+	zone := getAwsMetadata("placement/availability-zone")
+	if zone == info.UnknownInstance {
+		return ""
+	}
+	return zone
+}
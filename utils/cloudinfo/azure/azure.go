@@ -15,6 +15,8 @@
 package cloudinfo
 
 import (
+	"encoding/json"
+	"net/http"
 	"os"
 	"strings"
 
@@ -26,6 +28,8 @@ const (
 	sysVendorFileName    = "/sys/class/dmi/id/sys_vendor"
 	biosUUIDFileName     = "/sys/class/dmi/id/product_uuid"
 	microsoftCorporation = "Microsoft Corporation"
+
+	computeMetadataURL = "http://169.254.169.254/metadata/instance/compute?api-version=2021-02-01"
 )
 
 func init() {
@@ -45,8 +49,45 @@ func (provider) IsActiveProvider() bool {
 }
 
 // TODO: Implement method.
+// computeMetadata is the part of the compute metadata of the Azure instance
+// metadata service the cloud info is read from.
+type computeMetadata struct {
+	VMSize   string `json:"vmSize"`
+	Location string `json:"location"`
+	Zone     string `json:"zone"`
+}
+
+func getComputeMetadata() (*computeMetadata, error) {
+	body, err := cloudinfo.GetMetadata(computeMetadataURL, http.Header{"Metadata": {"true"}})
+	if err != nil {
+		return nil, err
+	}
+	var compute computeMetadata
+	if err := json.Unmarshal([]byte(body), &compute); err != nil {
+		return nil, err
+	}
+	return &compute, nil
+}
+
 func (provider) GetInstanceType() info.InstanceType {
-	return info.UnknownInstance
+	compute, err := getComputeMetadata()
+	if err != nil || compute.VMSize == "" {
+		return info.UnknownInstance
+	}
+	return info.InstanceType(compute.VMSize)
+}
+
+// GetZone returns the zone as Kubernetes labels it, <location>-<zone>, or
+// the location in regions without availability zones.
+func (provider) GetZone() string {
+	compute, err := getComputeMetadata()
+	if err != nil {
+		return ""
+	}
+	if compute.Zone == "" {
+		return compute.Location
+	}
+	return compute.Location + "-" + compute.Zone
 }
 
 func (provider) GetInstanceID() info.InstanceID {
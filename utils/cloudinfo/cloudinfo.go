@@ -17,18 +17,28 @@
 package cloudinfo
 
 import (
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
 	"k8s.io/klog/v2"
 
 	info "github.com/google/cadvisor/info/v1"
 )
 
+// MetadataTimeout bounds the requests to the metadata services, which only
+// answer on the instances of their cloud.
+const MetadataTimeout = 2 * time.Second
+
 type CloudInfo interface {
 	GetCloudProvider() info.CloudProvider
 	GetInstanceType() info.InstanceType
 	GetInstanceID() info.InstanceID
+	GetZone() string
 }
 
-// CloudProvider is an abstraction for providing cloud-specific information.
 type CloudProvider interface {
 	// IsActiveProvider determines whether this is the cloud provider operating
 	// this instance.
@@ -39,11 +49,14 @@ type CloudProvider interface {
 	// GetInstanceType gets the ID of the instance this process is running on.
 	// The behavior is undefined if this is not the active provider.
 	GetInstanceID() info.InstanceID
+	// GetZone gets the availability zone of the instance this process is
+	// running on, empty if unknown.
+	// The behavior is undefined if this is not the active provider.
+	GetZone() string
 }
 
 var providers = map[info.CloudProvider]CloudProvider{}
 
-// RegisterCloudProvider registers the given cloud provider
 func RegisterCloudProvider(name info.CloudProvider, provider CloudProvider) {
 	if _, alreadyRegistered := providers[name]; alreadyRegistered {
 		klog.Warningf("Duplicate registration of CloudProvider %s", name)
@@ -55,16 +68,42 @@ type realCloudInfo struct {
 	cloudProvider info.CloudProvider
 	instanceType  info.InstanceType
 	instanceID    info.InstanceID
+	zone          string
 }
 
+var (
+	// lock guarding detected.
+	lock sync.Mutex
+	// cloud info fully read from the metadata service of the provider, which
+	// does not change for the lifetime of the instance.
+	detected *realCloudInfo
+)
+
+// NewRealCloudInfo returns the cloud info of the instance. The metadata of
+// the active provider is read again on every call until all of it could be
+// read, so that metadata services unreachable at startup are retried when
+// the machine info is refreshed.
 func NewRealCloudInfo() CloudInfo {
+	lock.Lock()
+	defer lock.Unlock()
+	if detected != nil {
+		return detected
+	}
+
 	for name, provider := range providers {
 		if provider.IsActiveProvider() {
-			return &realCloudInfo{
+			cloudInfo := &realCloudInfo{
 				cloudProvider: name,
 				instanceType:  provider.GetInstanceType(),
 				instanceID:    provider.GetInstanceID(),
+				zone:          provider.GetZone(),
+			}
+			if cloudInfo.complete() {
+				detected = cloudInfo
+			} else {
+				klog.V(2).Infof("Incomplete metadata from cloud provider %s, will retry: %+v", name, *cloudInfo)
 			}
+			return cloudInfo
 		}
 	}
 
@@ -76,6 +115,12 @@ func NewRealCloudInfo() CloudInfo {
 	}
 }
 
+func (i *realCloudInfo) complete() bool {
+	return i.instanceType != "" && i.instanceType != info.UnknownInstance &&
+		i.instanceID != "" && i.instanceID != info.UnNamedInstance && string(i.instanceID) != info.UnknownInstance &&
+		i.zone != ""
+}
+
 func (i *realCloudInfo) GetCloudProvider() info.CloudProvider {
 	return i.cloudProvider
 }
@@ -87,3 +132,33 @@ func (i *realCloudInfo) GetInstanceType() info.InstanceType {
 func (i *realCloudInfo) GetInstanceID() info.InstanceID {
 	return i.instanceID
 }
+
+func (i *realCloudInfo) GetZone() string {
+	return i.zone
+}
+
+// GetMetadata returns the body of the response to a GET request of url with
+// the given header, for providers whose metadata service is a plain HTTP one.
+func GetMetadata(url string, header http.Header) (string, error) {
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return "", err
+	}
+	for name, values := range header {
+		req.Header[name] = values
+	}
+	client := http.Client{Timeout: MetadataTimeout}
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("metadata request %s got status %s", url, resp.Status)
+	}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+	return string(body), nil
+}
@@ -0,0 +1,89 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudinfo
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	info "github.com/google/cadvisor/info/v1"
+)
+
+type fakeProvider struct {
+	calls int
+	zone  string
+}
+
+func (p *fakeProvider) IsActiveProvider() bool {
+	return true
+}
+
+func (p *fakeProvider) GetInstanceType() info.InstanceType {
+	p.calls++
+	return "fake-type"
+}
+
+func (p *fakeProvider) GetInstanceID() info.InstanceID {
+	return "fake-id"
+}
+
+func (p *fakeProvider) GetZone() string {
+	return p.zone
+}
+
+func TestNewRealCloudInfoRetriesIncompleteMetadata(t *testing.T) {
+	provider := &fakeProvider{}
+	providers = map[info.CloudProvider]CloudProvider{"Fake": provider}
+	defer func() {
+		providers = map[info.CloudProvider]CloudProvider{}
+		detected = nil
+	}()
+
+	cloudInfo := NewRealCloudInfo()
+	assert.Equal(t, info.CloudProvider("Fake"), cloudInfo.GetCloudProvider())
+	assert.Equal(t, "", cloudInfo.GetZone())
+
+	provider.zone = "fake-zone"
+	cloudInfo = NewRealCloudInfo()
+	assert.Equal(t, "fake-zone", cloudInfo.GetZone())
+	assert.Equal(t, 2, provider.calls)
+
+	// Complete metadata is not read again.
+	cloudInfo = NewRealCloudInfo()
+	assert.Equal(t, info.InstanceType("fake-type"), cloudInfo.GetInstanceType())
+	assert.Equal(t, info.InstanceID("fake-id"), cloudInfo.GetInstanceID())
+	assert.Equal(t, 2, provider.calls)
+}
+
+func TestGetMetadata(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Metadata") != "true" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.Write([]byte("m5.large"))
+	}))
+	defer server.Close()
+
+	body, err := GetMetadata(server.URL, http.Header{"Metadata": {"true"}})
+	assert.NoError(t, err)
+	assert.Equal(t, "m5.large", body)
+
+	_, err = GetMetadata(server.URL, nil)
+	assert.Error(t, err)
+}
@@ -17,6 +17,7 @@ package gce
 import (
 	"context"
 	"os"
+	"path"
 	"strings"
 
 	info "github.com/google/cadvisor/info/v1"
@@ -48,8 +49,14 @@ func (provider) IsActiveProvider() bool {
 	return strings.Contains(string(data), google)
 }
 
+func getGceMetadata(suffix string) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), cloudinfo.MetadataTimeout)
+	defer cancel()
+	return metadata.GetWithContext(ctx, suffix)
+}
+
 func (provider) GetInstanceType() info.InstanceType {
-	machineType, err := metadata.GetWithContext(context.TODO(), "instance/machine-type")
+	machineType, err := getGceMetadata("instance/machine-type")
 	if err != nil {
 		return info.UnknownInstance
 	}
@@ -59,9 +66,18 @@ func (provider) GetInstanceType() info.InstanceType {
 }
 
 func (provider) GetInstanceID() info.InstanceID {
-	instanceID, err := metadata.GetWithContext(context.TODO(), "instance/id")
+	instanceID, err := getGceMetadata("instance/id")
 	if err != nil {
 		return info.UnknownInstance
 	}
 	return info.InstanceID(info.InstanceType(instanceID))
 }
+
+func (provider) GetZone() string {
+	zone, err := getGceMetadata("instance/zone")
+	if err != nil {
+		return ""
+	}
+	// The zone is given as projects/<project number>/zones/<zone>.
+	return path.Base(zone)
+}
@@ -0,0 +1,97 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openstack
+
+import (
+	"encoding/json"
+	"os"
+	"strings"
+
+	info "github.com/google/cadvisor/info/v1"
+	"github.com/google/cadvisor/utils/cloudinfo"
+)
+
+const (
+	sysVendorFileName   = "/sys/class/dmi/id/sys_vendor"
+	productNameFileName = "/sys/class/dmi/id/product_name"
+	openStack           = "OpenStack"
+
+	metadataURL = "http://169.254.169.254/openstack/latest/meta_data.json"
+	// Nova also serves the EC2 compatible metadata, the only one holding
+	// the flavor of the instance.
+	instanceTypeURL = "http://169.254.169.254/latest/meta-data/instance-type"
+)
+
+func init() {
+	cloudinfo.RegisterCloudProvider(info.OpenStack, &provider{})
+}
+
+type provider struct{}
+
+var _ cloudinfo.CloudProvider = provider{}
+
+func (provider) IsActiveProvider() bool {
+	return fileContainsOpenStack(sysVendorFileName) || fileContainsOpenStack(productNameFileName)
+}
+
+func fileContainsOpenStack(filename string) bool {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return false
+	}
+	return strings.Contains(string(data), openStack)
+}
+
+// metadata is the part of the OpenStack metadata the cloud info is read from.
+type metadata struct {
+	UUID             string `json:"uuid"`
+	AvailabilityZone string `json:"availability_zone"`
+}
+
+func getMetadata() (*metadata, error) {
+	body, err := cloudinfo.GetMetadata(metadataURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	var m metadata
+	if err := json.Unmarshal([]byte(body), &m); err != nil {
+		return nil, err
+	}
+	return &m, nil
+}
+
+func (provider) GetInstanceType() info.InstanceType {
+	instanceType, err := cloudinfo.GetMetadata(instanceTypeURL, nil)
+	if err != nil || instanceType == "" {
+		return info.UnknownInstance
+	}
+	return info.InstanceType(strings.TrimSpace(instanceType))
+}
+
+func (provider) GetInstanceID() info.InstanceID {
+	m, err := getMetadata()
+	if err != nil || m.UUID == "" {
+		return info.UnNamedInstance
+	}
+	return info.InstanceID(m.UUID)
+}
+
+func (provider) GetZone() string {
+	m, err := getMetadata()
+	if err != nil {
+		return ""
+	}
+	return m.AvailabilityZone
+}
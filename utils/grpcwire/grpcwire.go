@@ -0,0 +1,168 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package grpcwire encodes by hand the protobuf messages of the gRPC APIs
+// cAdvisor speaks, CRI and its plugin APIs, to keep them and the plugins
+// written in Go free of generated code.
+package grpcwire
+
+import (
+	"fmt"
+	"sort"
+
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// Marshaler is a message encoded by hand.
+type Marshaler interface {
+	Marshal() []byte
+}
+
+// Unmarshaler is a message decoded by hand.
+type Unmarshaler interface {
+	Unmarshal(b []byte) error
+}
+
+// Codec is a grpc encoding.Codec for the messages encoded by hand, to force
+// on the clients and servers of the APIs.
+type Codec struct{}
+
+func (Codec) Marshal(v interface{}) ([]byte, error) {
+	m, ok := v.(Marshaler)
+	if !ok {
+		return nil, fmt.Errorf("cannot marshal %T", v)
+	}
+	return m.Marshal(), nil
+}
+
+func (Codec) Unmarshal(data []byte, v interface{}) error {
+	m, ok := v.(Unmarshaler)
+	if !ok {
+		return fmt.Errorf("cannot unmarshal into %T", v)
+	}
+	return m.Unmarshal(data)
+}
+
+func (Codec) Name() string {
+	return "proto"
+}
+
+// Field is a single decoded field of a protobuf message. Only varint and
+// length-delimited fields are kept, the APIs use no others.
+type Field struct {
+	Num    protowire.Number
+	Varint uint64
+	Bytes  []byte
+}
+
+// ParseFields decodes the fields of a message.
+func ParseFields(b []byte) ([]Field, error) {
+	var fields []Field
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return nil, protowire.ParseError(n)
+		}
+		b = b[n:]
+		f := Field{Num: num}
+		switch typ {
+		case protowire.VarintType:
+			f.Varint, n = protowire.ConsumeVarint(b)
+		case protowire.BytesType:
+			f.Bytes, n = protowire.ConsumeBytes(b)
+		default:
+			n = protowire.ConsumeFieldValue(num, typ, b)
+		}
+		if n < 0 {
+			return nil, protowire.ParseError(n)
+		}
+		b = b[n:]
+		fields = append(fields, f)
+	}
+	return fields, nil
+}
+
+// AppendString appends a string field, unless empty.
+func AppendString(b []byte, num protowire.Number, s string) []byte {
+	if s == "" {
+		return b
+	}
+	b = protowire.AppendTag(b, num, protowire.BytesType)
+	return protowire.AppendString(b, s)
+}
+
+// AppendBytes appends a bytes field, unless empty.
+func AppendBytes(b []byte, num protowire.Number, v []byte) []byte {
+	if len(v) == 0 {
+		return b
+	}
+	b = protowire.AppendTag(b, num, protowire.BytesType)
+	return protowire.AppendBytes(b, v)
+}
+
+// AppendMessage appends an encoded message field, even if empty.
+func AppendMessage(b []byte, num protowire.Number, m []byte) []byte {
+	b = protowire.AppendTag(b, num, protowire.BytesType)
+	return protowire.AppendBytes(b, m)
+}
+
+// AppendBool appends a bool field, unless false.
+func AppendBool(b []byte, num protowire.Number, v bool) []byte {
+	if !v {
+		return b
+	}
+	b = protowire.AppendTag(b, num, protowire.VarintType)
+	return protowire.AppendVarint(b, 1)
+}
+
+// AppendVarint appends an integer field, unless zero.
+func AppendVarint(b []byte, num protowire.Number, v uint64) []byte {
+	if v == 0 {
+		return b
+	}
+	b = protowire.AppendTag(b, num, protowire.VarintType)
+	return protowire.AppendVarint(b, v)
+}
+
+// AppendMap appends a map<string, string> field, sorted by key.
+func AppendMap(b []byte, num protowire.Number, m map[string]string) []byte {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		b = AppendMessage(b, num, AppendString(AppendString(nil, 1, k), 2, m[k]))
+	}
+	return b
+}
+
+// ParseMapEntry decodes an entry of a map<string, string> field into m.
+func ParseMapEntry(b []byte, m map[string]string) error {
+	fields, err := ParseFields(b)
+	if err != nil {
+		return err
+	}
+	var key, value string
+	for _, f := range fields {
+		switch f.Num {
+		case 1:
+			key = string(f.Bytes)
+		case 2:
+			value = string(f.Bytes)
+		}
+	}
+	m[key] = value
+	return nil
+}
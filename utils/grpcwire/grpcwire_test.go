@@ -0,0 +1,105 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpcwire
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+type message struct {
+	name   string
+	count  uint64
+	labels map[string]string
+}
+
+func (m *message) Marshal() []byte {
+	var b []byte
+	b = AppendString(b, 1, m.name)
+	b = AppendVarint(b, 2, m.count)
+	return AppendMap(b, 3, m.labels)
+}
+
+func (m *message) Unmarshal(b []byte) error {
+	fields, err := ParseFields(b)
+	if err != nil {
+		return err
+	}
+	m.labels = map[string]string{}
+	for _, f := range fields {
+		switch f.Num {
+		case 1:
+			m.name = string(f.Bytes)
+		case 2:
+			m.count = f.Varint
+		case 3:
+			if err := ParseMapEntry(f.Bytes, m.labels); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func TestCodecRoundTrip(t *testing.T) {
+	in := &message{name: "web", count: 3, labels: map[string]string{"b": "2", "a": "1"}}
+	b, err := Codec{}.Marshal(in)
+	require.NoError(t, err)
+
+	out := &message{}
+	require.NoError(t, Codec{}.Unmarshal(b, out))
+	assert.Equal(t, in, out)
+
+	_, err = Codec{}.Marshal("not a message")
+	assert.Error(t, err)
+	assert.Error(t, Codec{}.Unmarshal(b, new(string)))
+	assert.Error(t, Codec{}.Unmarshal([]byte{0xff}, out))
+}
+
+func TestAppendMapIsSorted(t *testing.T) {
+	m := map[string]string{"b": "2", "a": "1", "c": "3"}
+	want := AppendMap(nil, 1, m)
+	for i := 0; i < 10; i++ {
+		assert.Equal(t, want, AppendMap(nil, 1, m))
+	}
+}
+
+func TestParseFieldsSkipsUnknownTypes(t *testing.T) {
+	var b []byte
+	b = protowire.AppendTag(b, 7, protowire.Fixed32Type)
+	b = protowire.AppendFixed32(b, 42)
+	b = protowire.AppendTag(b, 8, protowire.Fixed64Type)
+	b = protowire.AppendFixed64(b, 42)
+	b = AppendString(b, 1, "web")
+	b = AppendBool(b, 2, true)
+
+	fields, err := ParseFields(b)
+	require.NoError(t, err)
+	require.Len(t, fields, 4)
+	assert.Equal(t, Field{Num: 1, Bytes: []byte("web")}, fields[2])
+	assert.Equal(t, Field{Num: 2, Varint: 1}, fields[3])
+}
+
+func TestAppendSkipsZeroValues(t *testing.T) {
+	assert.Empty(t, AppendString(nil, 1, ""))
+	assert.Empty(t, AppendBytes(nil, 1, nil))
+	assert.Empty(t, AppendBool(nil, 1, false))
+	assert.Empty(t, AppendVarint(nil, 1, 0))
+	assert.Empty(t, AppendMap(nil, 1, nil))
+	assert.NotEmpty(t, AppendMessage(nil, 1, nil))
+}
@@ -0,0 +1,43 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubelet
+
+import (
+	"regexp"
+	"strings"
+)
+
+// Pod cgroups are named after the pod UID, e.g. pod<uid> with cgroupfs or
+// kubepods-burstable-pod<uid with underscores>.slice with systemd; static
+// pods are named after a hash instead.
+var podCgroupRegexp = regexp.MustCompile(`^(?:kubepods-(?:[a-z]+-)?)?pod([0-9a-f]{8}[-_][0-9a-f]{4}[-_][0-9a-f]{4}[-_][0-9a-f]{4}[-_][0-9a-f]{12}|[0-9a-f]{32})(?:\.slice)?$`)
+
+// PodCgroup returns the name of the pod cgroup that the cgroup with the given
+// name is or is below, and the UID of the pod. Pod cgroups are below the
+// kubepods cgroup of the kubelet.
+func PodCgroup(name string) (podCgroup, podUID string, ok bool) {
+	elements := strings.Split(name, "/")
+	kubepods := false
+	for i, element := range elements {
+		if !kubepods {
+			kubepods = strings.HasPrefix(element, "kubepods")
+			continue
+		}
+		if matches := podCgroupRegexp.FindStringSubmatch(element); matches != nil {
+			return strings.Join(elements[:i+1], "/"), strings.ReplaceAll(matches[1], "_", "-"), true
+		}
+	}
+	return "", "", false
+}
@@ -0,0 +1,42 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubelet
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPodCgroup(t *testing.T) {
+	for name, want := range map[string][2]string{
+		"/kubepods/pod" + podUID:                                                 {"/kubepods/pod" + podUID, podUID},
+		"/kubepods/burstable/pod" + podUID + "/" + mainID:                        {"/kubepods/burstable/pod" + podUID, podUID},
+		"/kubepods.slice/kubepods-pod3f0e2b4c_1d2a_4c5b_9e8f_7a6b5c4d3e2f.slice": {"/kubepods.slice/kubepods-pod3f0e2b4c_1d2a_4c5b_9e8f_7a6b5c4d3e2f.slice", podUID},
+		"/kubepods.slice/kubepods-besteffort.slice/kubepods-besteffort-pod3f0e2b4c_1d2a_4c5b_9e8f_7a6b5c4d3e2f.slice/crio-" + mainID + ".scope": {
+			"/kubepods.slice/kubepods-besteffort.slice/kubepods-besteffort-pod3f0e2b4c_1d2a_4c5b_9e8f_7a6b5c4d3e2f.slice", podUID,
+		},
+		"/kubepods/burstable/pod0123456789abcdef0123456789abcdef": {"/kubepods/burstable/pod0123456789abcdef0123456789abcdef", "0123456789abcdef0123456789abcdef"},
+	} {
+		podCgroup, uid, ok := PodCgroup(name)
+		if assert.True(t, ok, name) {
+			assert.Equal(t, want, [2]string{podCgroup, uid}, name)
+		}
+	}
+	for _, name := range []string{"/", "/kubepods", "/kubepods/burstable", "/pod" + podUID, "/system.slice/pod" + podUID + ".service", "/kubepods/burstable/xpod" + podUID} {
+		_, _, ok := PodCgroup(name)
+		assert.False(t, ok, name)
+	}
+}
@@ -0,0 +1,127 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package kubelet labels the containers of Kubernetes pods with the metadata
+// of their pods, as listed by the pods endpoint of the local kubelet.
+package kubelet
+
+import (
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+)
+
+const apiTimeout = 5 * time.Second
+
+// Pod is the subset of a Kubernetes pod cAdvisor uses.
+type Pod struct {
+	Metadata struct {
+		Name        string            `json:"name"`
+		Namespace   string            `json:"namespace"`
+		UID         string            `json:"uid"`
+		Annotations map[string]string `json:"annotations"`
+	} `json:"metadata"`
+	Status struct {
+		InitContainerStatuses      []ContainerStatus `json:"initContainerStatuses"`
+		ContainerStatuses          []ContainerStatus `json:"containerStatuses"`
+		EphemeralContainerStatuses []ContainerStatus `json:"ephemeralContainerStatuses"`
+	} `json:"status"`
+}
+
+// ContainerStatus is the subset of the status of a container of a pod
+// cAdvisor uses.
+type ContainerStatus struct {
+	Name string `json:"name"`
+	// The id of the container prefixed with its runtime, e.g.
+	// containerd://<id>.
+	ContainerID string `json:"containerID"`
+}
+
+// Client talks to the kubelet API.
+type Client interface {
+	// Pods returns the pods bound to the node of the kubelet.
+	Pods() ([]Pod, error)
+}
+
+type client struct {
+	url        string
+	tokenFile  string
+	httpClient *http.Client
+}
+
+// NewClient returns a Client for the kubelet at url, e.g.
+// "https://127.0.0.1:10250". Requests authenticate with the bearer token in
+// tokenFile, re-read for every request since tokens are rotated, unless the
+// file does not exist.
+func NewClient(url, tokenFile string, tlsConfig *tls.Config) Client {
+	return &client{
+		url:       strings.TrimSuffix(url, "/"),
+		tokenFile: tokenFile,
+		httpClient: &http.Client{
+			Timeout:   apiTimeout,
+			Transport: &http.Transport{TLSClientConfig: tlsConfig},
+		},
+	}
+}
+
+// token returns the bearer token to authenticate with, "" if there is none.
+func (c *client) token() (string, error) {
+	if c.tokenFile == "" {
+		return "", nil
+	}
+	token, err := os.ReadFile(c.tokenFile)
+	if errors.Is(err, fs.ErrNotExist) {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to read the kubelet token: %v", err)
+	}
+	return strings.TrimSpace(string(token)), nil
+}
+
+func (c *client) Pods() ([]Pod, error) {
+	req, err := http.NewRequest(http.MethodGet, c.url+"/pods", nil)
+	if err != nil {
+		return nil, err
+	}
+	token, err := c.token()
+	if err != nil {
+		return nil, err
+	}
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query the kubelet pods: %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("kubelet returned %s for the pods", resp.Status)
+	}
+
+	var pods struct {
+		Items []Pod `json:"items"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&pods); err != nil {
+		return nil, fmt.Errorf("failed to decode the kubelet pods: %v", err)
+	}
+	return pods.Items, nil
+}
@@ -0,0 +1,59 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubelet
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const podsJSON = `{"kind":"PodList","items":[{"metadata":{"name":"web-0","namespace":"shop","uid":"3f0e2b4c-1d2a-4c5b-9e8f-7a6b5c4d3e2f","annotations":{"prometheus.io/scrape":"true","example.com/team":"checkout","kubectl.kubernetes.io/last-applied-configuration":"{}"}},"status":{"initContainerStatuses":[{"name":"migrate","containerID":"containerd://1111111111111111111111111111111111111111111111111111111111111111"}],"containerStatuses":[{"name":"nginx","containerID":"containerd://2222222222222222222222222222222222222222222222222222222222222222"},{"name":"pending"}]}}]}`
+
+func TestClientPods(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/pods", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte(podsJSON))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(tokenFile, []byte("secret\n"), 0o600))
+	pods, err := NewClient(server.URL+"/", tokenFile, nil).Pods()
+	require.NoError(t, err)
+	require.Len(t, pods, 1)
+	assert.Equal(t, "web-0", pods[0].Metadata.Name)
+	assert.Equal(t, "shop", pods[0].Metadata.Namespace)
+	assert.Equal(t, "3f0e2b4c-1d2a-4c5b-9e8f-7a6b5c4d3e2f", pods[0].Metadata.UID)
+	assert.Equal(t, "checkout", pods[0].Metadata.Annotations["example.com/team"])
+	assert.Equal(t, []ContainerStatus{
+		{Name: "nginx", ContainerID: "containerd://2222222222222222222222222222222222222222222222222222222222222222"},
+		{Name: "pending"},
+	}, pods[0].Status.ContainerStatuses)
+
+	// A missing token file authenticates with no token.
+	_, err = NewClient(server.URL, filepath.Join(t.TempDir(), "missing"), nil).Pods()
+	assert.ErrorContains(t, err, "401")
+}
@@ -0,0 +1,192 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubelet
+
+import (
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"k8s.io/klog/v2"
+	"k8s.io/utils/clock"
+)
+
+// The labels the container runtimes of Kubernetes set on containers, which
+// the enricher sets on the containers lacking them.
+const (
+	PodNameLabel       = "io.kubernetes.pod.name"
+	PodNamespaceLabel  = "io.kubernetes.pod.namespace"
+	PodUIDLabel        = "io.kubernetes.pod.uid"
+	ContainerNameLabel = "io.kubernetes.container.name"
+)
+
+// The pods are listed again at most this often for containers of unknown pods.
+const minRefreshInterval = 10 * time.Second
+
+// Container cgroups below pod cgroups are named after the container id, e.g.
+// <id> with cgroupfs or cri-containerd-<id>.scope with systemd. Conmon
+// cgroups of CRI-O containers get only the pod labels.
+var containerCgroupRegexp = regexp.MustCompile(`^(?:(?:(?:cri-containerd|docker|crio)-)?([0-9a-f]{64})(?:\.scope)?|crio-conmon-[0-9a-f]{64}\.scope)$`)
+
+// parseCgroupName returns the UID of the pod of a pod or container cgroup,
+// and the id of the container of a container cgroup.
+func parseCgroupName(name string) (podUID, containerID string, ok bool) {
+	podCgroup, podUID, ok := PodCgroup(name)
+	if !ok {
+		return "", "", false
+	}
+	rest := strings.TrimPrefix(name, podCgroup)
+	if rest == "" {
+		return podUID, "", true
+	}
+	matches := containerCgroupRegexp.FindStringSubmatch(strings.TrimPrefix(rest, "/"))
+	if matches == nil {
+		return "", "", false
+	}
+	return podUID, matches[1], true
+}
+
+// pod is the metadata of a pod the enricher labels its containers with.
+type pod struct {
+	labels map[string]string
+	// Names of the containers of the pod by id.
+	containers map[string]string
+}
+
+// Enricher labels the containers of the pods of the kubelet with the name,
+// namespace and UID of their pod, the name of the container and the selected
+// annotations of the pod. The pods are listed in the background: when they
+// are older than the refresh interval, or a container of an unknown pod is
+// labelled.
+type Enricher struct {
+	client             Client
+	annotationPatterns []string
+	refreshInterval    time.Duration
+	clock              clock.Clock
+
+	lock sync.Mutex
+	// Pods by UID.
+	pods        map[string]*pod
+	lastRefresh time.Time
+	refreshing  bool
+}
+
+// NewEnricher returns an Enricher of the pods listed by client, which adds
+// the pod annotations matching annotationPatterns, a trailing * of which
+// matches any annotation with the prefix.
+func NewEnricher(client Client, annotationPatterns []string, refreshInterval time.Duration, clock clock.Clock) *Enricher {
+	return &Enricher{
+		client:             client,
+		annotationPatterns: annotationPatterns,
+		refreshInterval:    refreshInterval,
+		clock:              clock,
+	}
+}
+
+// Refresh lists the pods of the kubelet.
+func (e *Enricher) Refresh() error {
+	pods, err := e.client.Pods()
+	now := e.clock.Now()
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	e.lastRefresh = now
+	if err != nil {
+		return err
+	}
+	e.pods = make(map[string]*pod, len(pods))
+	for i := range pods {
+		e.pods[pods[i].Metadata.UID] = e.newPod(&pods[i])
+	}
+	return nil
+}
+
+func (e *Enricher) newPod(p *Pod) *pod {
+	labels := selectAnnotations(p.Metadata.Annotations, e.annotationPatterns)
+	labels[PodNameLabel] = p.Metadata.Name
+	labels[PodNamespaceLabel] = p.Metadata.Namespace
+	labels[PodUIDLabel] = p.Metadata.UID
+	containers := map[string]string{}
+	for _, statuses := range [][]ContainerStatus{p.Status.InitContainerStatuses, p.Status.ContainerStatuses, p.Status.EphemeralContainerStatuses} {
+		for _, status := range statuses {
+			// Strip the runtime, e.g. containerd://.
+			if _, id, ok := strings.Cut(status.ContainerID, "://"); ok {
+				containers[id] = status.Name
+			}
+		}
+	}
+	return &pod{labels: labels, containers: containers}
+}
+
+// Labels returns the labels of the pod and of the container of a cgroup,
+// nil if it is not the cgroup of a known pod or of one of its containers.
+func (e *Enricher) Labels(containerName string) map[string]string {
+	podUID, containerID, ok := parseCgroupName(containerName)
+	if !ok {
+		return nil
+	}
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	p, known := e.pods[podUID]
+	since := e.clock.Since(e.lastRefresh)
+	if since >= e.refreshInterval || (!known && since >= minRefreshInterval) {
+		e.refreshInBackground()
+	}
+	if !known {
+		return nil
+	}
+	labels := make(map[string]string, len(p.labels)+1)
+	for k, v := range p.labels {
+		labels[k] = v
+	}
+	if name, ok := p.containers[containerID]; ok && containerID != "" {
+		labels[ContainerNameLabel] = name
+	}
+	return labels
+}
+
+// refreshInBackground lists the pods unless they are being listed. It must
+// be called with the lock held.
+func (e *Enricher) refreshInBackground() {
+	if e.refreshing {
+		return
+	}
+	e.refreshing = true
+	go func() {
+		if err := e.Refresh(); err != nil {
+			klog.Warningf("Failed to list the pods of the kubelet: %v", err)
+		}
+		e.lock.Lock()
+		e.refreshing = false
+		e.lock.Unlock()
+	}()
+}
+
+// selectAnnotations returns the annotations matching the patterns.
+func selectAnnotations(annotations map[string]string, patterns []string) map[string]string {
+	selected := map[string]string{}
+	for _, pattern := range patterns {
+		if prefix, ok := strings.CutSuffix(pattern, "*"); ok {
+			for k, v := range annotations {
+				if strings.HasPrefix(k, prefix) {
+					selected[k] = v
+				}
+			}
+		} else if v, ok := annotations[pattern]; ok {
+			selected[pattern] = v
+		}
+	}
+	return selected
+}
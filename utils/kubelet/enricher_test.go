@@ -0,0 +1,152 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubelet
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	clock "k8s.io/utils/clock/testing"
+)
+
+const (
+	podUID = "3f0e2b4c-1d2a-4c5b-9e8f-7a6b5c4d3e2f"
+	initID = "1111111111111111111111111111111111111111111111111111111111111111"
+	mainID = "2222222222222222222222222222222222222222222222222222222222222222"
+)
+
+// fakeClient lists the pods, counting the calls.
+type fakeClient struct {
+	lock  sync.Mutex
+	pods  []Pod
+	err   error
+	calls int
+}
+
+func (c *fakeClient) Pods() ([]Pod, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.calls++
+	return c.pods, c.err
+}
+
+func (c *fakeClient) callCount() int {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.calls
+}
+
+func newTestPod() Pod {
+	var p Pod
+	p.Metadata.Name = "web-0"
+	p.Metadata.Namespace = "shop"
+	p.Metadata.UID = podUID
+	p.Metadata.Annotations = map[string]string{
+		"prometheus.io/scrape": "true",
+		"example.com/team":     "checkout",
+		"example.com/owner":    "alice",
+		"unrelated":            "x",
+	}
+	p.Status.InitContainerStatuses = []ContainerStatus{{Name: "migrate", ContainerID: "containerd://" + initID}}
+	p.Status.ContainerStatuses = []ContainerStatus{{Name: "nginx", ContainerID: "containerd://" + mainID}, {Name: "pending"}}
+	return p
+}
+
+func TestParseCgroupName(t *testing.T) {
+	for name, want := range map[string][2]string{
+		"/kubepods/burstable/pod" + podUID:                                       {podUID, ""},
+		"/kubepods/pod" + podUID + "/" + mainID:                                  {podUID, mainID},
+		"/kubepods/besteffort/pod" + podUID + "/" + mainID:                       {podUID, mainID},
+		"/kubepods.slice/kubepods-pod3f0e2b4c_1d2a_4c5b_9e8f_7a6b5c4d3e2f.slice": {podUID, ""},
+		"/kubepods.slice/kubepods-burstable.slice/kubepods-burstable-pod3f0e2b4c_1d2a_4c5b_9e8f_7a6b5c4d3e2f.slice/cri-containerd-" + mainID + ".scope": {podUID, mainID},
+		"/kubepods.slice/kubepods-besteffort.slice/kubepods-besteffort-pod3f0e2b4c_1d2a_4c5b_9e8f_7a6b5c4d3e2f.slice/crio-" + mainID + ".scope":         {podUID, mainID},
+		"/kubepods.slice/kubepods-besteffort.slice/kubepods-besteffort-pod3f0e2b4c_1d2a_4c5b_9e8f_7a6b5c4d3e2f.slice/crio-conmon-" + mainID + ".scope":  {podUID, ""},
+		"/kubepods/burstable/pod0123456789abcdef0123456789abcdef/" + mainID:                                                                             {"0123456789abcdef0123456789abcdef", mainID},
+	} {
+		uid, id, ok := parseCgroupName(name)
+		if assert.True(t, ok, name) {
+			assert.Equal(t, want, [2]string{uid, id}, name)
+		}
+	}
+	for _, name := range []string{"/", "/kubepods", "/kubepods/burstable", "/system.slice/docker-" + mainID + ".scope", "/kubepods/burstable/pod" + podUID + "/" + mainID + "/nested"} {
+		_, _, ok := parseCgroupName(name)
+		assert.False(t, ok, name)
+	}
+}
+
+func TestEnricherLabels(t *testing.T) {
+	client := &fakeClient{pods: []Pod{newTestPod()}}
+	e := NewEnricher(client, []string{"prometheus.io/scrape", "example.com/*", "missing"}, time.Hour, clock.NewFakeClock(time.Now()))
+	require.NoError(t, e.Refresh())
+
+	podLabels := map[string]string{
+		PodNameLabel:           "web-0",
+		PodNamespaceLabel:      "shop",
+		PodUIDLabel:            podUID,
+		"prometheus.io/scrape": "true",
+		"example.com/team":     "checkout",
+		"example.com/owner":    "alice",
+	}
+	assert.Equal(t, podLabels, e.Labels("/kubepods/burstable/pod"+podUID))
+	withContainer := func(name string) map[string]string {
+		labels := map[string]string{ContainerNameLabel: name}
+		for k, v := range podLabels {
+			labels[k] = v
+		}
+		return labels
+	}
+	assert.Equal(t, withContainer("nginx"), e.Labels("/kubepods/burstable/pod"+podUID+"/"+mainID))
+	assert.Equal(t, withContainer("migrate"), e.Labels("/kubepods/burstable/pod"+podUID+"/"+initID))
+	// A container not yet in the pod status gets only the pod labels.
+	assert.Equal(t, podLabels, e.Labels("/kubepods/burstable/pod"+podUID+"/"+"3333333333333333333333333333333333333333333333333333333333333333"))
+	assert.Nil(t, e.Labels("/system.slice/docker.service"))
+	assert.Equal(t, 1, client.callCount())
+}
+
+func TestEnricherRefresh(t *testing.T) {
+	fakeClock := clock.NewFakeClock(time.Now())
+	client := &fakeClient{err: errors.New("connection refused")}
+	e := NewEnricher(client, nil, time.Minute, fakeClock)
+	assert.EqualError(t, e.Refresh(), "connection refused")
+
+	// Containers of unknown pods list the pods again, at most every
+	// minRefreshInterval.
+	name := "/kubepods/pod" + podUID + "/" + mainID
+	assert.Nil(t, e.Labels(name))
+	assert.Equal(t, 1, client.callCount())
+
+	client.lock.Lock()
+	client.pods, client.err = []Pod{newTestPod()}, nil
+	client.lock.Unlock()
+	fakeClock.Step(minRefreshInterval)
+	assert.Nil(t, e.Labels(name))
+	assert.Eventually(t, func() bool { return e.Labels(name) != nil }, time.Second, time.Millisecond)
+	assert.Equal(t, "nginx", e.Labels(name)[ContainerNameLabel])
+	assert.Equal(t, 2, client.callCount())
+
+	// Known pods are listed again once the refresh interval passed. Removed
+	// pods are forgotten.
+	client.lock.Lock()
+	client.pods = nil
+	client.lock.Unlock()
+	fakeClock.Step(time.Minute)
+	assert.NotNil(t, e.Labels(name))
+	assert.Eventually(t, func() bool { return e.Labels(name) == nil }, time.Second, time.Millisecond)
+	assert.Equal(t, 3, client.callCount())
+}
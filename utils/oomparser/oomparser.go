@@ -30,7 +30,8 @@ import (
 var (
 	legacyContainerRegexp = regexp.MustCompile(`Task in (.*) killed as a result of limit of (.*)`)
 	// Starting in 5.0 linux kernels, the OOM message changed
-	containerRegexp = regexp.MustCompile(`oom-kill:constraint=(.*),nodemask=(.*),cpuset=(.*),mems_allowed=(.*),oom_memcg=(.*),task_memcg=(.*),task=(.*),pid=(.*),uid=(.*)`)
+	// OOMs of the whole system report global_oom instead of oom_memcg.
+	containerRegexp = regexp.MustCompile(`oom-kill:constraint=(.*),nodemask=(.*),cpuset=(.*),mems_allowed=(.*),(?:oom_memcg=(.*)|global_oom),task_memcg=(.*),task=(.*),pid=(.*),uid=(.*)`)
 	lastLineRegexp  = regexp.MustCompile(`Killed process ([0-9]+) \((.+)\)`)
 	firstLineRegexp = regexp.MustCompile(`invoked oom-killer:`)
 )
@@ -80,6 +81,9 @@ func getContainerName(line string, currentOomInstance *OomInstance) (bool, error
 	}
 	currentOomInstance.ContainerName = parsedLine[6]
 	currentOomInstance.VictimContainerName = parsedLine[5]
+	if currentOomInstance.VictimContainerName == "" {
+		currentOomInstance.VictimContainerName = "/"
+	}
 	currentOomInstance.Constraint = parsedLine[1]
 	pid, err := strconv.Atoi(parsedLine[8])
 	if err != nil {
@@ -97,6 +97,19 @@ func TestGetContainerName(t *testing.T) {
 	}
 }
 
+func TestGetContainerNameGlobalOom(t *testing.T) {
+	const line = "oom-kill:constraint=CONSTRAINT_NONE,nodemask=(null),cpuset=/,mems_allowed=0,global_oom,task_memcg=/system.slice/stress.service,task=stress,pid=4242,uid=0"
+	currentOomInstance := new(OomInstance)
+	finished, err := getContainerName(line, currentOomInstance)
+	assert.NoError(t, err)
+	assert.True(t, finished)
+	assert.Equal(t, "/system.slice/stress.service", currentOomInstance.ContainerName)
+	assert.Equal(t, "/", currentOomInstance.VictimContainerName)
+	assert.Equal(t, 4242, currentOomInstance.Pid)
+	assert.Equal(t, "stress", currentOomInstance.ProcessName)
+	assert.Equal(t, "CONSTRAINT_NONE", currentOomInstance.Constraint)
+}
+
 func TestGetProcessNamePid(t *testing.T) {
 	currentOomInstance := new(OomInstance)
 	couldParseLine, err := getProcessNamePid(startLine, currentOomInstance)
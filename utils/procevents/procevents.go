@@ -0,0 +1,215 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+// Package procevents reports the process exec, fork and exit events of the
+// kernel proc connector.
+package procevents
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"os"
+	"sync/atomic"
+	"time"
+
+	"golang.org/x/sys/unix"
+	"k8s.io/klog/v2"
+)
+
+// Action is what happened to a process.
+type Action string
+
+const (
+	Exec Action = "exec"
+	Fork Action = "fork"
+	Exit Action = "exit"
+)
+
+// Event is an event of a process, threads left aside.
+type Event struct {
+	Action Action
+	// Process the event is about, the new child for forks.
+	Pid int
+	// Parent of the process for forks, and for exits on kernels reporting
+	// it. 0 otherwise.
+	ParentPid int
+	// Exit status and fatal signal of the process for exits.
+	ExitCode int
+	Signal   int
+	// When the event was received.
+	Timestamp time.Time
+}
+
+// Constants of linux/cn_proc.h and linux/connector.h.
+const (
+	cnIdxProc          = 1
+	cnValProc          = 1
+	procCnMcastListen  = 1
+	procCnMcastIgnore  = 2
+	procEventFork      = 0x00000001
+	procEventExec      = 0x00000002
+	procEventExit      = 0x80000000
+	cnMsgLen           = 20
+	procEventHeaderLen = 16
+)
+
+// Watcher receives the events of the proc connector.
+type Watcher struct {
+	fd     int
+	closed atomic.Bool
+}
+
+// New subscribes to the proc connector, which requires CAP_NET_ADMIN.
+func New() (*Watcher, error) {
+	fd, err := unix.Socket(unix.AF_NETLINK, unix.SOCK_DGRAM|unix.SOCK_CLOEXEC, unix.NETLINK_CONNECTOR)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open the proc connector: %v", err)
+	}
+	addr := &unix.SockaddrNetlink{Family: unix.AF_NETLINK, Groups: cnIdxProc, Pid: uint32(os.Getpid())}
+	if err := unix.Bind(fd, addr); err != nil {
+		unix.Close(fd)
+		return nil, fmt.Errorf("failed to bind to the proc connector: %v", err)
+	}
+	// Wake up regularly to notice Close.
+	timeout := unix.NsecToTimeval(time.Second.Nanoseconds())
+	if err := unix.SetsockoptTimeval(fd, unix.SOL_SOCKET, unix.SO_RCVTIMEO, &timeout); err != nil {
+		unix.Close(fd)
+		return nil, err
+	}
+	w := &Watcher{fd: fd}
+	if err := w.send(procCnMcastListen); err != nil {
+		unix.Close(fd)
+		return nil, fmt.Errorf("failed to subscribe to the proc connector: %v", err)
+	}
+	return w, nil
+}
+
+// send sends an operation to the proc connector.
+func (w *Watcher) send(op uint32) error {
+	msg := make([]byte, unix.NLMSG_HDRLEN+cnMsgLen+4)
+	order := binary.NativeEndian
+	order.PutUint32(msg[0:], uint32(len(msg)))
+	order.PutUint16(msg[4:], unix.NLMSG_DONE)
+	order.PutUint32(msg[12:], uint32(os.Getpid()))
+	cn := msg[unix.NLMSG_HDRLEN:]
+	order.PutUint32(cn[0:], cnIdxProc)
+	order.PutUint32(cn[4:], cnValProc)
+	order.PutUint16(cn[16:], 4)
+	order.PutUint32(cn[cnMsgLen:], op)
+	return unix.Sendto(w.fd, msg, 0, &unix.SockaddrNetlink{Family: unix.AF_NETLINK})
+}
+
+// Stream sends the events received to out until Close is called.
+func (w *Watcher) Stream(out chan<- *Event) {
+	buf := make([]byte, os.Getpagesize()*4)
+	for !w.closed.Load() {
+		n, _, err := unix.Recvfrom(w.fd, buf, 0)
+		if err != nil {
+			switch {
+			case errors.Is(err, unix.EAGAIN), errors.Is(err, unix.EINTR):
+			case errors.Is(err, unix.ENOBUFS):
+				klog.Warningf("Lost process events, the proc connector receive buffer overflowed")
+			case w.closed.Load():
+			default:
+				klog.Errorf("Failed to receive process events, stopping: %v", err)
+				return
+			}
+			continue
+		}
+		for _, ev := range parseMessages(buf[:n], time.Now()) {
+			out <- ev
+		}
+	}
+}
+
+// Close unsubscribes from the proc connector and stops Stream.
+func (w *Watcher) Close() error {
+	if w.closed.Swap(true) {
+		return nil
+	}
+	_ = w.send(procCnMcastIgnore)
+	return unix.Close(w.fd)
+}
+
+// parseMessages returns the process events of the netlink messages in buf.
+func parseMessages(buf []byte, now time.Time) []*Event {
+	var evs []*Event
+	order := binary.NativeEndian
+	for len(buf) >= unix.NLMSG_HDRLEN {
+		msgLen := int(order.Uint32(buf[0:]))
+		if msgLen < unix.NLMSG_HDRLEN || msgLen > len(buf) {
+			break
+		}
+		if ev := parseProcEvent(buf[unix.NLMSG_HDRLEN:msgLen], now); ev != nil {
+			evs = append(evs, ev)
+		}
+		// Messages are aligned to 4 bytes.
+		next := (msgLen + unix.NLMSG_ALIGNTO - 1) &^ (unix.NLMSG_ALIGNTO - 1)
+		if next > len(buf) {
+			break
+		}
+		buf = buf[next:]
+	}
+	return evs
+}
+
+// parseProcEvent parses a cn_msg holding a proc_event, and returns nil for
+// other events and the events of threads.
+func parseProcEvent(data []byte, now time.Time) *Event {
+	order := binary.NativeEndian
+	if len(data) < cnMsgLen+procEventHeaderLen || order.Uint32(data[0:]) != cnIdxProc || order.Uint32(data[4:]) != cnValProc {
+		return nil
+	}
+	event := data[cnMsgLen:]
+	what := order.Uint32(event[0:])
+	fields := event[procEventHeaderLen:]
+	field := func(i int) int {
+		if len(fields) < 4*(i+1) {
+			return 0
+		}
+		return int(int32(order.Uint32(fields[4*i:])))
+	}
+	switch what {
+	case procEventFork:
+		// parent_pid, parent_tgid, child_pid, child_tgid
+		if len(fields) < 16 || field(2) != field(3) {
+			return nil
+		}
+		return &Event{Action: Fork, Pid: field(3), ParentPid: field(1), Timestamp: now}
+	case procEventExec:
+		// process_pid, process_tgid
+		if len(fields) < 8 || field(0) != field(1) {
+			return nil
+		}
+		return &Event{Action: Exec, Pid: field(1), Timestamp: now}
+	case procEventExit:
+		// process_pid, process_tgid, exit_code, exit_signal, parent_pid,
+		// parent_tgid
+		if len(fields) < 16 || field(0) != field(1) {
+			return nil
+		}
+		status := unix.WaitStatus(field(2))
+		ev := &Event{Action: Exit, Pid: field(1), ParentPid: field(5), Timestamp: now}
+		if status.Signaled() {
+			ev.Signal = int(status.Signal())
+		} else {
+			ev.ExitCode = status.ExitStatus()
+		}
+		return ev
+	}
+	return nil
+}
@@ -0,0 +1,71 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package procevents
+
+import (
+	"encoding/binary"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/sys/unix"
+)
+
+// message returns a netlink message holding a proc_event.
+func message(what uint32, fields ...uint32) []byte {
+	order := binary.NativeEndian
+	msg := make([]byte, unix.NLMSG_HDRLEN+cnMsgLen+procEventHeaderLen+4*len(fields))
+	order.PutUint32(msg[0:], uint32(len(msg)))
+	cn := msg[unix.NLMSG_HDRLEN:]
+	order.PutUint32(cn[0:], cnIdxProc)
+	order.PutUint32(cn[4:], cnValProc)
+	order.PutUint16(cn[16:], uint16(procEventHeaderLen+4*len(fields)))
+	event := cn[cnMsgLen:]
+	order.PutUint32(event[0:], what)
+	for i, f := range fields {
+		order.PutUint32(event[procEventHeaderLen+4*i:], f)
+	}
+	return msg
+}
+
+func TestParseMessages(t *testing.T) {
+	now := time.Now()
+	var buf []byte
+	buf = append(buf, message(procEventFork, 10, 10, 11, 11)...)
+	// A new thread is left aside.
+	buf = append(buf, message(procEventFork, 10, 10, 12, 10)...)
+	buf = append(buf, message(procEventExec, 11, 11)...)
+	// Killed by SIGKILL.
+	buf = append(buf, message(procEventExit, 11, 11, 9, 17, 10, 10)...)
+	// Exited with status 3, on a kernel not reporting the parent.
+	buf = append(buf, message(procEventExit, 13, 13, 3<<8, 17)...)
+	// Other events are ignored.
+	buf = append(buf, message(0x00000004, 11, 11, 0, 0)...)
+
+	assert.Equal(t, []*Event{
+		{Action: Fork, Pid: 11, ParentPid: 10, Timestamp: now},
+		{Action: Exec, Pid: 11, Timestamp: now},
+		{Action: Exit, Pid: 11, ParentPid: 10, Signal: 9, Timestamp: now},
+		{Action: Exit, Pid: 13, ExitCode: 3, Timestamp: now},
+	}, parseMessages(buf, now))
+}
+
+func TestParseMessagesTruncated(t *testing.T) {
+	msg := message(procEventExec, 11, 11)
+	assert.Empty(t, parseMessages(msg[:len(msg)-2], time.Now()))
+	assert.Empty(t, parseMessages(msg[:unix.NLMSG_HDRLEN-1], time.Now()))
+}
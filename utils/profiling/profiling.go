@@ -0,0 +1,82 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package profiling captures profiles of the cAdvisor process, for the admin
+// endpoints and for the profiles written while cAdvisor uses too much CPU.
+package profiling
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"runtime"
+	"runtime/pprof"
+	"runtime/trace"
+	"time"
+)
+
+const (
+	// CPU profiles the CPU usage for a duration.
+	CPU = "cpu"
+	// Trace records an execution trace for a duration.
+	Trace = "trace"
+)
+
+// ErrActive is returned when a CPU profile or an execution trace is already
+// being captured, since the runtime captures only one of each at a time.
+var ErrActive = errors.New("a profile of this kind is already being captured")
+
+// Snapshots are the profiles captured at once, as named by runtime/pprof.
+var Snapshots = []string{"allocs", "block", "goroutine", "heap", "mutex", "threadcreate"}
+
+// Capture writes the profile name to w. CPU and Trace last for d, or until
+// ctx is done; the other profiles are snapshots written in the format given by
+// debug, 0 being the gzipped protocol buffer read by "go tool pprof".
+func Capture(ctx context.Context, w io.Writer, name string, d time.Duration, debug int) error {
+	switch name {
+	case CPU:
+		if err := pprof.StartCPUProfile(w); err != nil {
+			return ErrActive
+		}
+		wait(ctx, d)
+		pprof.StopCPUProfile()
+		return nil
+	case Trace:
+		if err := trace.Start(w); err != nil {
+			return ErrActive
+		}
+		wait(ctx, d)
+		trace.Stop()
+		return nil
+	case "heap":
+		// Report the live objects as of the last completed garbage collection,
+		// like net/http/pprof does with gc=1.
+		runtime.GC()
+	}
+	profile := pprof.Lookup(name)
+	if profile == nil {
+		return fmt.Errorf("unknown profile %q", name)
+	}
+	return profile.WriteTo(w, debug)
+}
+
+func wait(ctx context.Context, d time.Duration) {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+	case <-ctx.Done():
+	}
+}
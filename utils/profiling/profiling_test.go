@@ -0,0 +1,61 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package profiling
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCaptureCPU(t *testing.T) {
+	var first, second bytes.Buffer
+	done := make(chan error)
+	go func() { done <- Capture(context.Background(), &first, CPU, 200*time.Millisecond, 0) }()
+	// Wait for the first capture to start.
+	time.Sleep(50 * time.Millisecond)
+	assert.Equal(t, ErrActive, Capture(context.Background(), &second, CPU, time.Millisecond, 0))
+	require.NoError(t, <-done)
+	assert.NotZero(t, first.Len())
+	assert.Zero(t, second.Len())
+}
+
+func TestCaptureCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	var buf bytes.Buffer
+	start := time.Now()
+	require.NoError(t, Capture(ctx, &buf, Trace, time.Hour, 0))
+	assert.Less(t, time.Since(start), time.Minute)
+	assert.NotZero(t, buf.Len())
+}
+
+func TestCaptureSnapshot(t *testing.T) {
+	for _, name := range Snapshots {
+		var buf bytes.Buffer
+		require.NoError(t, Capture(context.Background(), &buf, name, 0, 0), name)
+		assert.NotZero(t, buf.Len(), name)
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, Capture(context.Background(), &buf, "goroutine", 0, 1))
+	assert.Contains(t, buf.String(), "TestCaptureSnapshot")
+
+	assert.EqualError(t, Capture(context.Background(), &buf, "bogus", 0, 0), `unknown profile "bogus"`)
+}
@@ -0,0 +1,88 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package rctl reads the resource accounting (racct) and the limits of the
+// FreeBSD rctl framework, of processes, users, login classes and jails.
+package rctl
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// JailFilter returns the rctl filter of a jail, e.g. "jail:www".
+func JailFilter(name string) string {
+	return "jail:" + name
+}
+
+// ProcessFilter returns the rctl filter of a process.
+func ProcessFilter(pid int) string {
+	return "process:" + strconv.Itoa(pid)
+}
+
+// ParseUsage parses the resource usage returned by rctl_get_racct, e.g.
+// "cputime=3,memoryuse=1048576,...", by resource.
+func ParseUsage(usage string) (map[string]uint64, error) {
+	resources := map[string]uint64{}
+	for _, field := range strings.Split(strings.TrimRight(usage, "\x00"), ",") {
+		if field == "" {
+			continue
+		}
+		resource, value, ok := strings.Cut(field, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid resource usage %q", field)
+		}
+		amount, err := strconv.ParseUint(value, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid amount of resource %q: %v", resource, err)
+		}
+		resources[resource] = amount
+	}
+	return resources, nil
+}
+
+// ParseLimits parses the rules returned by rctl_get_limits, e.g.
+// "jail:www:memoryuse:deny=1073741824,jail:www:pcpu:deny=50", into the
+// amounts of the deny rules by resource, the lowest one if several apply.
+// Rules of other actions, e.g. log or devctl, don't actually limit usage.
+func ParseLimits(rules string) (map[string]uint64, error) {
+	limits := map[string]uint64{}
+	for _, rule := range strings.Split(strings.TrimRight(rules, "\x00"), ",") {
+		if rule == "" {
+			continue
+		}
+		// subject:subject-id:resource:action=amount[/per]
+		fields := strings.SplitN(rule, ":", 4)
+		if len(fields) != 4 {
+			return nil, fmt.Errorf("invalid rule %q", rule)
+		}
+		action, amount, ok := strings.Cut(fields[3], "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid rule %q", rule)
+		}
+		if action != "deny" {
+			continue
+		}
+		amount, _, _ = strings.Cut(amount, "/")
+		limit, err := strconv.ParseUint(amount, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid amount of rule %q: %v", rule, err)
+		}
+		if current, ok := limits[fields[2]]; !ok || limit < current {
+			limits[fields[2]] = limit
+		}
+	}
+	return limits, nil
+}
@@ -0,0 +1,77 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build freebsd
+
+package rctl
+
+import (
+	"errors"
+	"fmt"
+	"unsafe"
+
+	"golang.org/x/sys/unix"
+)
+
+// ErrDisabled is returned when the kernel doesn't account resources, i.e.
+// the kern.racct.enable tunable is not set.
+var ErrDisabled = errors.New("resource accounting is disabled, set kern.racct.enable=1 in /boot/loader.conf")
+
+// Enabled returns whether the kernel accounts resources.
+func Enabled() bool {
+	enabled, err := unix.SysctlUint32("kern.racct.enable")
+	return err == nil && enabled == 1
+}
+
+// GetUsage returns the resource usage of the subject of filter.
+func GetUsage(filter string) (map[string]uint64, error) {
+	out, err := call(unix.SYS_RCTL_GET_RACCT, filter)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUsage(out)
+}
+
+// GetLimits returns the deny limits applying to the subject of filter.
+func GetLimits(filter string) (map[string]uint64, error) {
+	out, err := call(unix.SYS_RCTL_GET_LIMITS, filter)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLimits(out)
+}
+
+// call makes an rctl system call, growing the output buffer until it holds
+// the output.
+func call(trap uintptr, filter string) (string, error) {
+	in, err := unix.ByteSliceFromString(filter)
+	if err != nil {
+		return "", err
+	}
+	for size := 4096; size <= 1<<20; size *= 4 {
+		out := make([]byte, size)
+		_, _, errno := unix.Syscall6(trap, uintptr(unsafe.Pointer(&in[0])), uintptr(len(in)), uintptr(unsafe.Pointer(&out[0])), uintptr(len(out)), 0, 0)
+		switch errno {
+		case 0:
+			return unix.ByteSliceToString(out), nil
+		case unix.ERANGE:
+			continue
+		case unix.ENOSYS:
+			return "", ErrDisabled
+		default:
+			return "", fmt.Errorf("rctl of %q: %v", filter, errno)
+		}
+	}
+	return "", fmt.Errorf("rctl of %q: output too large", filter)
+}
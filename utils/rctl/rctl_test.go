@@ -0,0 +1,46 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rctl
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseUsage(t *testing.T) {
+	usage, err := ParseUsage("cputime=12,datasize=4096,memoryuse=1048576,maxproc=3,pcpu=0,\x00")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]uint64{"cputime": 12, "datasize": 4096, "memoryuse": 1048576, "maxproc": 3, "pcpu": 0}, usage)
+
+	_, err = ParseUsage("cputime")
+	assert.Error(t, err)
+	_, err = ParseUsage("cputime=x")
+	assert.Error(t, err)
+}
+
+func TestParseLimits(t *testing.T) {
+	limits, err := ParseLimits("jail:www:memoryuse:deny=1073741824,jail:www:memoryuse:deny=536870912/jail,jail:www:pcpu:deny=50,jail:www:maxproc:log=100,user:1001:openfiles:deny=64/user")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]uint64{"memoryuse": 536870912, "pcpu": 50, "openfiles": 64}, limits)
+
+	limits, err = ParseLimits("")
+	require.NoError(t, err)
+	assert.Empty(t, limits)
+
+	_, err = ParseLimits("jail:www:memoryuse")
+	assert.Error(t, err)
+}
@@ -73,6 +73,8 @@ type FakeSysFs struct {
 	drawerIDs   map[string]string
 	drawerIDErr map[string]error
 
+	coreTypes map[string]string
+
 	memTotal string
 	memErr   error
 
@@ -81,11 +83,25 @@ type FakeSysFs struct {
 
 	hugePagesNr    map[string]string
 	hugePagesNrErr error
+	hugePagesFree  map[string]string
 
 	distances    map[string]string
 	distancesErr error
 
+	nodeAccess map[string]map[string]string
+
 	onlineCPUs map[string]interface{}
+
+	netDuplex     string
+	netDriver     string
+	netMaster     string
+	netMasterKind string
+
+	blockAttributes  map[string]string
+	blockTemperature int64
+
+	pciAttributes map[string]map[string]string
+	pciLinks      map[string]map[string]string
 }
 
 func (fs *FakeSysFs) GetNodesPaths() ([]string, error) {
@@ -125,6 +141,15 @@ func (fs *FakeSysFs) GetHugePagesNr(hugepagesDirectory string, hugePageName stri
 	return fs.hugePagesNr[hugePageFile], fs.hugePagesNrErr
 }
 
+func (fs *FakeSysFs) GetHugePagesFree(hugepagesDirectory string, hugePageName string) (string, error) {
+	hugePageFile := fmt.Sprintf("%s%s/%s", hugepagesDirectory, hugePageName, sysfs.HugePagesFreeFile)
+	free, ok := fs.hugePagesFree[hugePageFile]
+	if !ok {
+		return "", os.ErrNotExist
+	}
+	return free, nil
+}
+
 func (fs *FakeSysFs) GetBlockDevices() ([]os.FileInfo, error) {
 	fs.info.EntryName = "sda"
 	return []os.FileInfo{&fs.info}, nil
@@ -146,6 +171,29 @@ func (fs *FakeSysFs) IsBlockDeviceHidden(name string) (bool, error) {
 	return false, nil
 }
 
+func (fs *FakeSysFs) GetBlockDeviceAttribute(name, attribute string) (string, error) {
+	value, ok := fs.blockAttributes[attribute]
+	if !ok {
+		return "", os.ErrNotExist
+	}
+	return value, nil
+}
+
+func (fs *FakeSysFs) GetBlockDeviceTemperature(name string) (int64, error) {
+	if fs.blockTemperature == 0 {
+		return 0, os.ErrNotExist
+	}
+	return fs.blockTemperature, nil
+}
+
+// SetBlockDeviceAttributes sets the attributes of the block device by their
+// path relative to its directory, and its temperature in millidegrees
+// Celsius, 0 for none.
+func (fs *FakeSysFs) SetBlockDeviceAttributes(attributes map[string]string, temperature int64) {
+	fs.blockAttributes = attributes
+	fs.blockTemperature = temperature
+}
+
 func (fs *FakeSysFs) GetNetworkDevices() ([]os.FileInfo, error) {
 	return []os.FileInfo{&fs.info}, nil
 }
@@ -166,6 +214,30 @@ func (fs *FakeSysFs) GetNetworkStatValue(name string, stat string) (uint64, erro
 	return 1024, nil
 }
 
+func (fs *FakeSysFs) GetNetworkDuplex(name string) (string, error) {
+	if fs.netDuplex == "" {
+		return "", fmt.Errorf("duplex of %s: %w", name, os.ErrNotExist)
+	}
+	return fs.netDuplex + "\n", nil
+}
+
+func (fs *FakeSysFs) GetNetworkDriver(name string) (string, error) {
+	return fs.netDriver, nil
+}
+
+func (fs *FakeSysFs) GetNetworkMaster(name string) (string, string, error) {
+	return fs.netMaster, fs.netMasterKind, nil
+}
+
+// SetNetworkAttributes sets the duplex, driver and master of the network
+// devices.
+func (fs *FakeSysFs) SetNetworkAttributes(duplex, driver, master, masterKind string) {
+	fs.netDuplex = duplex
+	fs.netDriver = driver
+	fs.netMaster = master
+	fs.netMasterKind = masterKind
+}
+
 func (fs *FakeSysFs) GetCaches(id int) ([]os.FileInfo, error) {
 	fs.info.EntryName = "index0"
 	return []os.FileInfo{&fs.info}, nil
@@ -204,6 +276,14 @@ func (fs *FakeSysFs) SetBookIDs(bookIDs map[string]string, bookIDErrors map[stri
 	fs.bookIDErr = bookIDErrors
 }
 
+func (fs *FakeSysFs) GetCoreType(cpuPath string) (string, error) {
+	return fs.coreTypes[cpuPath], nil
+}
+
+func (fs *FakeSysFs) SetCoreTypes(coreTypes map[string]string) {
+	fs.coreTypes = coreTypes
+}
+
 func (fs *FakeSysFs) SetDrawerIDs(drawerIDs map[string]string, drawerIDErrors map[string]error) {
 	fs.drawerIDs = drawerIDs
 	fs.drawerIDErr = drawerIDErrors
@@ -224,6 +304,10 @@ func (fs *FakeSysFs) SetHugePagesNr(hugePagesNr map[string]string, err error) {
 	fs.hugePagesNrErr = err
 }
 
+func (fs *FakeSysFs) SetHugePagesFree(hugePagesFree map[string]string) {
+	fs.hugePagesFree = hugePagesFree
+}
+
 func (fs *FakeSysFs) SetEntryName(name string) {
 	fs.info.EntryName = name
 }
@@ -253,6 +337,21 @@ func (fs *FakeSysFs) SetDistances(nodeDir string, distances string, err error) {
 	fs.distancesErr = err
 }
 
+func (fs *FakeSysFs) GetNodeAccessAttribute(nodeDir string, attribute string) (string, error) {
+	value, ok := fs.nodeAccess[nodeDir][attribute]
+	if !ok {
+		return "", fmt.Errorf("access attribute %s of %s: %w", attribute, nodeDir, os.ErrNotExist)
+	}
+	return value, nil
+}
+
+func (fs *FakeSysFs) SetNodeAccessAttributes(nodeDir string, attributes map[string]string) {
+	if fs.nodeAccess == nil {
+		fs.nodeAccess = map[string]map[string]string{}
+	}
+	fs.nodeAccess[nodeDir] = attributes
+}
+
 func (fs *FakeSysFs) IsCPUOnline(dir string) bool {
 	if fs.onlineCPUs == nil {
 		return true
@@ -264,3 +363,34 @@ func (fs *FakeSysFs) IsCPUOnline(dir string) bool {
 func (fs *FakeSysFs) SetOnlineCPUs(online map[string]interface{}) {
 	fs.onlineCPUs = online
 }
+
+func (fs *FakeSysFs) GetPCIDevices() ([]string, error) {
+	addresses := make([]string, 0, len(fs.pciAttributes))
+	for address := range fs.pciAttributes {
+		addresses = append(addresses, address)
+	}
+	return addresses, nil
+}
+
+func (fs *FakeSysFs) GetPCIDeviceAttribute(address string, attribute string) (string, error) {
+	value, ok := fs.pciAttributes[address][attribute]
+	if !ok {
+		return "", fmt.Errorf("attribute %s of PCI device %s: %w", attribute, address, os.ErrNotExist)
+	}
+	return value, nil
+}
+
+func (fs *FakeSysFs) GetPCIDeviceLink(address string, link string) (string, error) {
+	target, ok := fs.pciLinks[address][link]
+	if !ok {
+		return "", fmt.Errorf("link %s of PCI device %s: %w", link, address, os.ErrNotExist)
+	}
+	return target, nil
+}
+
+// SetPCIDevices sets the attributes and the link targets of the PCI devices,
+// by address.
+func (fs *FakeSysFs) SetPCIDevices(attributes map[string]map[string]string, links map[string]map[string]string) {
+	fs.pciAttributes = attributes
+	fs.pciLinks = links
+}
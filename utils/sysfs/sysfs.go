@@ -32,7 +32,9 @@ const (
 	blockDir     = "/sys/block"
 	cacheDir     = "/sys/devices/system/cpu/cpu"
 	netDir       = "/sys/class/net"
+	pciDir       = "/sys/bus/pci/devices"
 	dmiDir       = "/sys/class/dmi"
+	devicesDir   = "/sys/devices"
 	ppcDevTree   = "/proc/device-tree"
 	s390xDevTree = "/etc" // s390/s390x changes
 
@@ -40,6 +42,10 @@ const (
 
 	distanceFile = "distance"
 
+	// accessDir holds the memory performance of a NUMA node for the nearest
+	// initiators, as reported by the ACPI HMAT.
+	accessDir = "access0/initiators"
+
 	sysFsCPUTopology = "topology"
 
 	// CPUPhysicalPackageID is a physical package id of cpu#. Typically corresponds to a physical socket number,
@@ -67,6 +73,8 @@ const (
 
 	//HugePagesNrFile name of nr_hugepages file in sysfs
 	HugePagesNrFile = "nr_hugepages"
+	//HugePagesFreeFile name of free_hugepages file in sysfs
+	HugePagesFreeFile = "free_hugepages"
 )
 
 var (
@@ -84,6 +92,8 @@ type CacheInfo struct {
 	Level int
 	// number of cpus that can access this cache.
 	Cpus int
+	// list of the cpus that can access this cache, e.g. 0-3,8-11.
+	SharedCPUs string
 }
 
 // Abstracts the lowest level calls to sysfs.
@@ -100,12 +110,17 @@ type SysFs interface {
 	GetBookID(cpuPath string) (string, error)
 	// Get drawer id for specified CPU
 	GetDrawerID(cpuPath string) (string, error)
+	// Get the type of the core of specified CPU on hybrid CPUs, performance
+	// or efficiency, and empty otherwise
+	GetCoreType(cpuPath string) (string, error)
 	// Get total memory for specified NUMA node
 	GetMemInfo(nodeDir string) (string, error)
 	// Get hugepages from specified directory
 	GetHugePagesInfo(hugePagesDirectory string) ([]os.FileInfo, error)
 	// Get hugepage_nr from specified directory
 	GetHugePagesNr(hugePagesDirectory string, hugePageName string) (string, error)
+	// Get free_hugepages from specified directory
+	GetHugePagesFree(hugePagesDirectory string, hugePageName string) (string, error)
 	// Get directory information for available block devices.
 	GetBlockDevices() ([]os.FileInfo, error)
 	// Get Size of a given block device.
@@ -117,12 +132,25 @@ type SysFs interface {
 	// Is the device "hidden" (meaning will not have a device handle)
 	// This is the case with native nvme multipathing.
 	IsBlockDeviceHidden(string) (bool, error)
+	// Get an attribute of a block device relative to its directory, e.g.
+	// queue/rotational or device/model, with white space trimmed.
+	GetBlockDeviceAttribute(name, attribute string) (string, error)
+	// Get the temperature of a block device in millidegrees Celsius, from the
+	// hwmon sensor of its driver.
+	GetBlockDeviceTemperature(name string) (int64, error)
 
 	GetNetworkDevices() ([]os.FileInfo, error)
 	GetNetworkAddress(string) (string, error)
 	GetNetworkMtu(string) (string, error)
 	GetNetworkSpeed(string) (string, error)
 	GetNetworkStatValue(dev string, stat string) (uint64, error)
+	// Get the duplex of the link of a network device: full, half or unknown.
+	GetNetworkDuplex(string) (string, error)
+	// Get the name of the driver of a network device, empty for virtual ones.
+	GetNetworkDriver(string) (string, error)
+	// Get the name of the bond or bridge a network device is a port of, and
+	// the kind of this master: bond, bridge, or empty for other kinds.
+	GetNetworkMaster(string) (string, string, error)
 
 	// Get directory information for available caches accessible to given cpu.
 	GetCaches(id int) ([]os.FileInfo, error)
@@ -131,9 +159,21 @@ type SysFs interface {
 
 	GetSystemUUID() (string, error)
 
+	// Get the addresses of the PCI devices, e.g. 0000:00:1f.0.
+	GetPCIDevices() ([]string, error)
+	// Get an attribute of a PCI device, e.g. vendor or numa_node.
+	GetPCIDeviceAttribute(address string, attribute string) (string, error)
+	// Get the name of the target of a link of a PCI device, e.g. the group
+	// of its iommu_group link or the address of its physfn one.
+	GetPCIDeviceLink(address string, link string) (string, error)
+
 	// GetDistances returns distance array
 	GetDistances(string) (string, error)
 
+	// GetNodeAccessAttribute returns a memory performance attribute of the
+	// specified NUMA node for its nearest initiators, e.g. read_bandwidth.
+	GetNodeAccessAttribute(nodePath string, attribute string) (string, error)
+
 	// IsCPUOnline determines if CPU status from kernel hotplug machanism standpoint.
 	// See: https://www.kernel.org/doc/html/latest/core-api/cpu_hotplug.html
 	IsCPUOnline(dir string) bool
@@ -195,6 +235,33 @@ func (fs *realSysFs) GetDrawerID(cpuPath string) (string, error) {
 	return strings.TrimSpace(string(drawerID)), nil
 }
 
+// coreTypePMUs are the perf PMUs of the cores of hybrid CPUs, whose cpus
+// file lists the CPUs of each type of core.
+var coreTypePMUs = map[string]string{
+	"cpu_core": "performance",
+	"cpu_atom": "efficiency",
+}
+
+func (fs *realSysFs) GetCoreType(cpuPath string) (string, error) {
+	cpuID, err := getCPUID(cpuPath)
+	if err != nil {
+		return "", err
+	}
+	for pmu, coreType := range coreTypePMUs {
+		// The cpus file is a list of CPUs in the format of the online one.
+		found, err := isCPUOnline(path.Join(devicesDir, pmu, "cpus"), cpuID)
+		if os.IsNotExist(err) {
+			continue
+		} else if err != nil {
+			return "", err
+		}
+		if found {
+			return coreType, nil
+		}
+	}
+	return "", nil
+}
+
 func (fs *realSysFs) GetMemInfo(nodePath string) (string, error) {
 	meminfoPath := fmt.Sprintf("%s/%s", nodePath, meminfoFile)
 	meminfo, err := os.ReadFile(meminfoPath)
@@ -213,6 +280,14 @@ func (fs *realSysFs) GetDistances(nodePath string) (string, error) {
 	return strings.TrimSpace(string(distance)), err
 }
 
+func (fs *realSysFs) GetNodeAccessAttribute(nodePath string, attribute string) (string, error) {
+	value, err := os.ReadFile(path.Join(nodePath, accessDir, attribute))
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(value)), nil
+}
+
 func (fs *realSysFs) GetHugePagesInfo(hugePagesDirectory string) ([]os.FileInfo, error) {
 	dirs, err := os.ReadDir(hugePagesDirectory)
 	if err != nil {
@@ -230,6 +305,14 @@ func (fs *realSysFs) GetHugePagesNr(hugepagesDirectory string, hugePageName stri
 	return strings.TrimSpace(string(hugePageFile)), err
 }
 
+func (fs *realSysFs) GetHugePagesFree(hugepagesDirectory string, hugePageName string) (string, error) {
+	hugePageFile, err := os.ReadFile(fmt.Sprintf("%s%s/%s", hugepagesDirectory, hugePageName, HugePagesFreeFile))
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(hugePageFile)), err
+}
+
 func (fs *realSysFs) GetBlockDevices() ([]os.FileInfo, error) {
 	dirs, err := os.ReadDir(blockDir)
 	if err != nil {
@@ -272,6 +355,35 @@ func (fs *realSysFs) GetBlockDeviceScheduler(name string) (string, error) {
 	return string(sched), nil
 }
 
+func (fs *realSysFs) GetBlockDeviceAttribute(name, attribute string) (string, error) {
+	value, err := os.ReadFile(path.Join(blockDir, name, attribute))
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(value)), nil
+}
+
+func (fs *realSysFs) GetBlockDeviceTemperature(name string) (int64, error) {
+	// NVMe controllers have their hwmon devices right below them, the
+	// drivetemp driver of SATA disks one level deeper.
+	var inputs []string
+	for _, pattern := range []string{"device/hwmon*/temp1_input", "device/hwmon/hwmon*/temp1_input"} {
+		matches, err := filepath.Glob(path.Join(blockDir, name, pattern))
+		if err != nil {
+			return 0, err
+		}
+		inputs = append(inputs, matches...)
+	}
+	if len(inputs) == 0 {
+		return 0, os.ErrNotExist
+	}
+	value, err := os.ReadFile(inputs[0])
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseInt(strings.TrimSpace(string(value)), 10, 64)
+}
+
 func (fs *realSysFs) GetBlockDeviceSize(name string) (string, error) {
 	size, err := os.ReadFile(path.Join(blockDir, name, "/size"))
 	if err != nil {
@@ -330,6 +442,40 @@ func (fs *realSysFs) GetNetworkSpeed(name string) (string, error) {
 	return string(speed), nil
 }
 
+func (fs *realSysFs) GetNetworkDuplex(name string) (string, error) {
+	duplex, err := os.ReadFile(path.Join(netDir, name, "/duplex"))
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(duplex)), nil
+}
+
+func (fs *realSysFs) GetNetworkDriver(name string) (string, error) {
+	driver, err := os.Readlink(path.Join(netDir, name, "/device/driver"))
+	if os.IsNotExist(err) {
+		return "", nil
+	} else if err != nil {
+		return "", err
+	}
+	return path.Base(driver), nil
+}
+
+func (fs *realSysFs) GetNetworkMaster(name string) (string, string, error) {
+	master, err := os.Readlink(path.Join(netDir, name, "/master"))
+	if os.IsNotExist(err) {
+		return "", "", nil
+	} else if err != nil {
+		return "", "", err
+	}
+	kind := ""
+	if _, err := os.Stat(path.Join(netDir, name, "/bonding_slave")); err == nil {
+		kind = "bond"
+	} else if _, err := os.Stat(path.Join(netDir, name, "/brport")); err == nil {
+		kind = "bridge"
+	}
+	return path.Base(master), kind, nil
+}
+
 func (fs *realSysFs) GetNetworkStatValue(dev string, stat string) (uint64, error) {
 	statPath := path.Join(netDir, dev, "/statistics", stat)
 	out, err := os.ReadFile(statPath)
@@ -436,12 +582,19 @@ func (fs *realSysFs) GetCacheInfo(cpu int, name string) (CacheInfo, error) {
 	if err != nil {
 		return CacheInfo{}, err
 	}
+	// shared_cpu_list is missing on some platforms, the cpu count is enough
+	// to place the cache in the topology.
+	var sharedCPUs string
+	if out, err = os.ReadFile(path.Join(cachePath, "/shared_cpu_list")); err == nil {
+		sharedCPUs = strings.TrimSpace(string(out))
+	}
 	return CacheInfo{
-		Id:    id,
-		Size:  size,
-		Level: level,
-		Type:  cacheType,
-		Cpus:  cpuCount,
+		Id:         id,
+		Size:       size,
+		Level:      level,
+		Type:       cacheType,
+		Cpus:       cpuCount,
+		SharedCPUs: sharedCPUs,
 	}, nil
 }
 
@@ -459,6 +612,34 @@ func (fs *realSysFs) GetSystemUUID() (string, error) {
 	}
 }
 
+func (fs *realSysFs) GetPCIDevices() ([]string, error) {
+	dirs, err := os.ReadDir(pciDir)
+	if err != nil {
+		return nil, err
+	}
+	addresses := make([]string, 0, len(dirs))
+	for _, dir := range dirs {
+		addresses = append(addresses, dir.Name())
+	}
+	return addresses, nil
+}
+
+func (fs *realSysFs) GetPCIDeviceAttribute(address string, attribute string) (string, error) {
+	value, err := os.ReadFile(path.Join(pciDir, address, attribute))
+	if err != nil {
+		return "", err
+	}
+	return string(value), nil
+}
+
+func (fs *realSysFs) GetPCIDeviceLink(address string, link string) (string, error) {
+	target, err := os.Readlink(path.Join(pciDir, address, link))
+	if err != nil {
+		return "", err
+	}
+	return path.Base(target), nil
+}
+
 func (fs *realSysFs) IsCPUOnline(cpuPath string) bool {
 	cpuOnlinePath, err := filepath.Abs(fs.cpuPath + "/online")
 	if err != nil {
@@ -19,6 +19,7 @@ import (
 	"os"
 	"regexp"
 	"runtime"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -99,6 +100,15 @@ func GetBlockDeviceInfo(sysfs sysfs.SysFs) (map[string]info.DiskInfo, error) {
 				diskInfo.Scheduler = string(matches[1])
 			}
 		}
+		// Virtual disks have no model, and only some drivers report the
+		// serial number.
+		diskInfo.Model, _ = sysfs.GetBlockDeviceAttribute(name, "device/model")
+		diskInfo.Serial, _ = sysfs.GetBlockDeviceAttribute(name, "device/serial")
+		rotational, _ := sysfs.GetBlockDeviceAttribute(name, "queue/rotational")
+		diskInfo.Rotational = rotational == "1"
+		if temperature, err := sysfs.GetBlockDeviceTemperature(name); err == nil {
+			diskInfo.Temperature = temperature / 1000
+		}
 		device := fmt.Sprintf("%d:%d", diskInfo.Major, diskInfo.Minor)
 		diskMap[device] = diskInfo
 	}
@@ -154,11 +164,72 @@ func GetNetworkDevices(sysfs sysfs.SysFs) ([]info.NetInfo, error) {
 			}
 			netInfo.Speed = s
 		}
+		// The duplex cannot be read while the link is down.
+		if duplex, err := sysfs.GetNetworkDuplex(name); err == nil {
+			netInfo.Duplex = strings.TrimSpace(duplex)
+		}
+		netInfo.Driver, err = sysfs.GetNetworkDriver(name)
+		if err != nil {
+			klog.V(4).Infof("Cannot read the driver of network device %s: %v", name, err)
+		}
+		netInfo.Master, netInfo.MasterType, err = sysfs.GetNetworkMaster(name)
+		if err != nil {
+			klog.V(4).Infof("Cannot read the master of network device %s: %v", name, err)
+		}
 		netDevices = append(netDevices, netInfo)
 	}
 	return netDevices, nil
 }
 
+// GetPCIDevices returns the PCI devices present on the system, ordered by
+// address. Devices removed while they are read are skipped.
+func GetPCIDevices(sysFs sysfs.SysFs) ([]info.PCIDevice, error) {
+	addresses, err := sysFs.GetPCIDevices()
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(addresses)
+	devices := make([]info.PCIDevice, 0, len(addresses))
+	for _, address := range addresses {
+		device, err := getPCIDevice(sysFs, address)
+		if err != nil {
+			klog.V(4).Infof("Skipping PCI device %s: %v", address, err)
+			continue
+		}
+		devices = append(devices, device)
+	}
+	return devices, nil
+}
+
+func getPCIDevice(sysFs sysfs.SysFs, address string) (info.PCIDevice, error) {
+	device := info.PCIDevice{Address: address, NumaNode: -1}
+	for attribute, value := range map[string]*string{"vendor": &device.VendorID, "device": &device.DeviceID, "class": &device.Class} {
+		v, err := sysFs.GetPCIDeviceAttribute(address, attribute)
+		if err != nil {
+			return device, err
+		}
+		*value = strings.TrimSpace(v)
+	}
+	// The optional attributes are only present on some kernels and devices.
+	for attribute, value := range map[string]*int{"numa_node": &device.NumaNode, "sriov_totalvfs": &device.SRIOVTotalVFs, "sriov_numvfs": &device.SRIOVNumVFs} {
+		v, err := sysFs.GetPCIDeviceAttribute(address, attribute)
+		if err != nil {
+			continue
+		}
+		n, err := strconv.Atoi(strings.TrimSpace(v))
+		if err != nil {
+			return device, fmt.Errorf("could not parse %s from %q: %v", attribute, v, err)
+		}
+		*value = n
+	}
+	for link, value := range map[string]*string{"iommu_group": &device.IOMMUGroup, "driver": &device.Driver, "physfn": &device.PhysicalFunction} {
+		if target, err := sysFs.GetPCIDeviceLink(address, link); err == nil {
+			*value = target
+		}
+	}
+	return device, nil
+}
+
 // GetHugePagesInfo returns information about pre-allocated huge pages
 // hugepagesDirectory should be top directory of hugepages
 // Such as: /sys/kernel/mm/hugepages/
@@ -192,9 +263,19 @@ func GetHugePagesInfo(sysFs sysfs.SysFs, hugepagesDirectory string) ([]info.Huge
 			return hugePagesInfo, fmt.Errorf("could not parse file nr_hugepage for %s, contents %q", st.Name(), string(val))
 		}
 
+		// free_hugepages is counted alongside nr_hugepages, only missing
+		// where sysfs is incomplete.
+		var freePages uint64
+		if val, err := sysFs.GetHugePagesFree(hugepagesDirectory, st.Name()); err == nil {
+			if n, err := fmt.Sscanf(val, "%d", &freePages); err != nil || n != 1 {
+				return hugePagesInfo, fmt.Errorf("could not parse file free_hugepages for %s, contents %q", st.Name(), val)
+			}
+		}
+
 		hugePagesInfo = append(hugePagesInfo, info.HugePagesInfo{
-			NumPages: numPages,
-			PageSize: pageSize,
+			NumPages:  numPages,
+			PageSize:  pageSize,
+			FreePages: freePages,
 		})
 	}
 	return hugePagesInfo, nil
@@ -259,6 +340,8 @@ func GetNodesInfo(sysFs sysfs.SysFs) ([]info.Node, int, error) {
 			return nil, 0, err
 		}
 
+		node.MemoryPerformance = getMemoryPerformance(sysFs, nodeDir)
+
 		nodes = append(nodes, node)
 	}
 	return nodes, allLogicalCoresCount, err
@@ -348,10 +431,11 @@ func addCacheInfo(sysFs sysfs.SysFs, node *info.Node) error {
 
 		for _, cache := range caches {
 			c := info.Cache{
-				Id:    cache.Id,
-				Size:  cache.Size,
-				Level: cache.Level,
-				Type:  cache.Type,
+				Id:         cache.Id,
+				Size:       cache.Size,
+				Level:      cache.Level,
+				Type:       cache.Type,
+				SharedCPUs: cache.SharedCPUs,
 			}
 			if cache.Level > cacheLevel2 {
 				if cache.Cpus == numThreadsPerNode {
@@ -428,6 +512,30 @@ func getDistances(sysFs sysfs.SysFs, nodeDir string) ([]uint64, error) {
 	return distances, nil
 }
 
+// getMemoryPerformance returns the memory performance of a NUMA node, nil if
+// the firmware does not report it.
+func getMemoryPerformance(sysFs sysfs.SysFs, nodeDir string) *info.NodeMemoryPerformance {
+	performance := &info.NodeMemoryPerformance{}
+	attributes := map[string]*uint64{
+		"read_bandwidth":  &performance.ReadBandwidth,
+		"write_bandwidth": &performance.WriteBandwidth,
+		"read_latency":    &performance.ReadLatency,
+		"write_latency":   &performance.WriteLatency,
+	}
+	for attribute, value := range attributes {
+		raw, err := sysFs.GetNodeAccessAttribute(nodeDir, attribute)
+		if err != nil {
+			return nil
+		}
+		*value, err = strconv.ParseUint(raw, 10, 64)
+		if err != nil {
+			klog.Warningf("Cannot parse %s of %s: %v", attribute, nodeDir, err)
+			return nil
+		}
+	}
+	return performance
+}
+
 // getCoresInfo returns information about physical cores
 func getCoresInfo(sysFs sysfs.SysFs, cpuDirs []string) ([]info.Core, error) {
 	cores := make([]info.Core, 0, len(cpuDirs))
@@ -505,6 +613,13 @@ func getCoresInfo(sysFs sysfs.SysFs, cpuDirs []string) ([]info.Core, error) {
 		desiredCore.BookID = bookID
 		desiredCore.DrawerID = drawerID
 
+		coreType, err := sysFs.GetCoreType(cpuDir)
+		if err != nil {
+			klog.V(4).Infof("Cannot read the core type of %s: %v", cpuDir, err)
+		} else if coreType != "" {
+			desiredCore.CoreType = coreType
+		}
+
 		if len(desiredCore.Threads) == 0 {
 			desiredCore.Threads = []int{cpuID}
 		} else {
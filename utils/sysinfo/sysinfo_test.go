@@ -47,6 +47,34 @@ func TestGetHugePagesInfo(t *testing.T) {
 	assert.Equal(t, 2, len(hugePagesInfo))
 }
 
+func TestGetHugePagesInfoFreePages(t *testing.T) {
+	fakeSys := fakesysfs.FakeSysFs{}
+	fakeSys.SetHugePages([]os.FileInfo{
+		&fakesysfs.FileInfo{EntryName: "hugepages-2048kB"},
+		&fakesysfs.FileInfo{EntryName: "hugepages-1048576kB"},
+	}, nil)
+	fakeSys.SetHugePagesNr(map[string]string{
+		"/fakeSysfs/devices/system/node/node1/hugepages/hugepages-2048kB/nr_hugepages":    "512",
+		"/fakeSysfs/devices/system/node/node1/hugepages/hugepages-1048576kB/nr_hugepages": "4",
+	}, nil)
+	fakeSys.SetHugePagesFree(map[string]string{
+		"/fakeSysfs/devices/system/node/node1/hugepages/hugepages-2048kB/free_hugepages": "100",
+	})
+
+	hugePagesInfo, err := GetHugePagesInfo(&fakeSys, "/fakeSysfs/devices/system/node/node1/hugepages/")
+	assert.NoError(t, err)
+	assert.Equal(t, []info.HugePagesInfo{
+		{PageSize: 2048, NumPages: 512, FreePages: 100},
+		{PageSize: 1048576, NumPages: 4},
+	}, hugePagesInfo)
+
+	fakeSys.SetHugePagesFree(map[string]string{
+		"/fakeSysfs/devices/system/node/node1/hugepages/hugepages-2048kB/free_hugepages": "many",
+	})
+	_, err = GetHugePagesInfo(&fakeSys, "/fakeSysfs/devices/system/node/node1/hugepages/")
+	assert.Error(t, err)
+}
+
 func TestGetHugePagesInfoWithHugePagesDirectory(t *testing.T) {
 	fakeSys := fakesysfs.FakeSysFs{}
 	hugePagesInfo, err := GetHugePagesInfo(&fakeSys, "/fakeSysfs/devices/system/node/node0/hugepages/")
@@ -1360,6 +1388,31 @@ func TestGetBlockDeviceInfo(t *testing.T) {
 	}
 }
 
+func TestGetBlockDeviceInfoAttributes(t *testing.T) {
+	fakeSys := fakesysfs.FakeSysFs{}
+	fakeSys.SetBlockDeviceAttributes(map[string]string{
+		"device/model":     "Samsung SSD 980 PRO 1TB",
+		"device/serial":    "S5GXNF0R123456",
+		"queue/rotational": "0",
+	}, 38850)
+
+	disks, err := GetBlockDeviceInfo(&fakeSys)
+	assert.NoError(t, err)
+	disk := disks["8:0"]
+	assert.Equal(t, "Samsung SSD 980 PRO 1TB", disk.Model)
+	assert.Equal(t, "S5GXNF0R123456", disk.Serial)
+	assert.False(t, disk.Rotational)
+	assert.Equal(t, int64(38), disk.Temperature)
+
+	fakeSys.SetBlockDeviceAttributes(map[string]string{"queue/rotational": "1"}, 0)
+	disks, err = GetBlockDeviceInfo(&fakeSys)
+	assert.NoError(t, err)
+	disk = disks["8:0"]
+	assert.Empty(t, disk.Model)
+	assert.True(t, disk.Rotational)
+	assert.Zero(t, disk.Temperature)
+}
+
 func TestGetNetworkDevices(t *testing.T) {
 	fakeSys := fakesysfs.FakeSysFs{}
 	fakeSys.SetEntryName("eth0")
@@ -1685,3 +1738,107 @@ func TestGetDistancesMissingDistances(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Len(t, distances, 0)
 }
+
+func TestGetPCIDevices(t *testing.T) {
+	fakeSys := fakesysfs.FakeSysFs{}
+	fakeSys.SetPCIDevices(map[string]map[string]string{
+		"0000:3b:02.0": {"vendor": "0x8086\n", "device": "0x154c\n", "class": "0x020000\n", "numa_node": "0\n"},
+		"0000:3b:00.0": {"vendor": "0x8086\n", "device": "0x1572\n", "class": "0x020000\n", "numa_node": "0\n", "sriov_totalvfs": "64\n", "sriov_numvfs": "1\n"},
+		"0000:00:1f.0": {"vendor": "0x8086\n", "device": "0xa1c1\n", "class": "0x060100\n"},
+		// Removed while it is read.
+		"0000:00:1f.4": {"vendor": "0x8086\n"},
+	}, map[string]map[string]string{
+		"0000:3b:00.0": {"iommu_group": "42", "driver": "i40e"},
+		"0000:3b:02.0": {"iommu_group": "87", "driver": "vfio-pci", "physfn": "0000:3b:00.0"},
+	})
+
+	devices, err := GetPCIDevices(&fakeSys)
+	assert.NoError(t, err)
+	assert.Equal(t, []info.PCIDevice{
+		{Address: "0000:00:1f.0", VendorID: "0x8086", DeviceID: "0xa1c1", Class: "0x060100", NumaNode: -1},
+		{Address: "0000:3b:00.0", VendorID: "0x8086", DeviceID: "0x1572", Class: "0x020000", NumaNode: 0, IOMMUGroup: "42", Driver: "i40e", SRIOVTotalVFs: 64, SRIOVNumVFs: 1},
+		{Address: "0000:3b:02.0", VendorID: "0x8086", DeviceID: "0x154c", Class: "0x020000", NumaNode: 0, IOMMUGroup: "87", Driver: "vfio-pci", PhysicalFunction: "0000:3b:00.0"},
+	}, devices)
+}
+
+func TestGetNodesInfoWithCoreTypes(t *testing.T) {
+	fakeSys := &fakesysfs.FakeSysFs{}
+	fakeSys.SetCacheInfo(sysfs.CacheInfo{
+		Size:       48 * 1024,
+		Type:       "Data",
+		Level:      1,
+		Cpus:       1,
+		SharedCPUs: "0",
+	})
+	fakeSys.SetNodesPaths([]string{"/fakeSysfs/devices/system/node/node0"}, nil)
+	fakeSys.SetCPUsPaths(map[string][]string{
+		"/fakeSysfs/devices/system/node/node0": {
+			"/fakeSysfs/devices/system/node/node0/cpu0",
+			"/fakeSysfs/devices/system/node/node0/cpu1",
+		},
+	}, nil)
+	fakeSys.SetCoreThreads(map[string]string{
+		"/fakeSysfs/devices/system/node/node0/cpu0": "0",
+		"/fakeSysfs/devices/system/node/node0/cpu1": "8",
+	}, nil)
+	fakeSys.SetPhysicalPackageIDs(map[string]string{
+		"/fakeSysfs/devices/system/node/node0/cpu0": "0",
+		"/fakeSysfs/devices/system/node/node0/cpu1": "0",
+	}, nil)
+	fakeSys.SetCoreTypes(map[string]string{
+		"/fakeSysfs/devices/system/node/node0/cpu0": "performance",
+		"/fakeSysfs/devices/system/node/node0/cpu1": "efficiency",
+	})
+	fakeSys.SetMemory("MemTotal:       32817192 kB", nil)
+	fakeSys.SetDistances("/fakeSysfs/devices/system/node/node0", "10", nil)
+
+	nodes, cores, err := GetNodesInfo(fakeSys)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, cores)
+	assert.Len(t, nodes, 1)
+	assert.Len(t, nodes[0].Cores, 2)
+	assert.Equal(t, "performance", nodes[0].Cores[0].CoreType)
+	assert.Equal(t, "efficiency", nodes[0].Cores[1].CoreType)
+	assert.Equal(t, []info.Cache{{Size: 48 * 1024, Type: "Data", Level: 1, SharedCPUs: "0"}}, nodes[0].Cores[0].Caches)
+}
+
+func TestGetMemoryPerformance(t *testing.T) {
+	fakeSys := &fakesysfs.FakeSysFs{}
+	fakeSys.SetNodeAccessAttributes("/fakeSysfs/devices/system/node/node0", map[string]string{
+		"read_bandwidth":  "262144",
+		"write_bandwidth": "131072",
+		"read_latency":    "90",
+		"write_latency":   "110",
+	})
+	fakeSys.SetNodeAccessAttributes("/fakeSysfs/devices/system/node/node1", map[string]string{
+		"read_bandwidth": "65536",
+	})
+
+	assert.Equal(t, &info.NodeMemoryPerformance{
+		ReadBandwidth:  262144,
+		WriteBandwidth: 131072,
+		ReadLatency:    90,
+		WriteLatency:   110,
+	}, getMemoryPerformance(fakeSys, "/fakeSysfs/devices/system/node/node0"))
+	assert.Nil(t, getMemoryPerformance(fakeSys, "/fakeSysfs/devices/system/node/node1"))
+	assert.Nil(t, getMemoryPerformance(fakeSys, "/fakeSysfs/devices/system/node/node2"))
+}
+
+func TestGetNetworkDevicesAttributes(t *testing.T) {
+	fakeSys := fakesysfs.FakeSysFs{}
+	fakeSys.SetEntryName("eth0")
+	fakeSys.SetNetworkAttributes("full", "ixgbe", "bond0", "bond")
+
+	devs, err := GetNetworkDevices(&fakeSys)
+	assert.NoError(t, err)
+	assert.Equal(t, []info.NetInfo{{
+		Name:       "eth0",
+		MacAddress: "42:01:02:03:04:f4",
+		Speed:      1000,
+		Mtu:        1024,
+		Duplex:     "full",
+		Driver:     "ixgbe",
+		Master:     "bond0",
+		MasterType: "bond",
+	}}, devs)
+}
@@ -19,146 +19,242 @@ import (
 	"time"
 )
 
-type timedStoreDataSlice []timedStoreData
-
-func (t timedStoreDataSlice) Less(i, j int) bool {
-	return t[i].timestamp.Before(t[j].timestamp)
-}
+// A time-based buffer for ContainerStats.
+// Holds information for a specific time period and/or a max number of items.
+type TimedStore = TimedRing[interface{}]
 
-func (t timedStoreDataSlice) Len() int {
-	return len(t)
+// Returns a new thread-compatible TimedStore.
+// A maxItems value of -1 means no limit.
+func NewTimedStore(age time.Duration, maxItems int) *TimedStore {
+	return NewTimedRing[interface{}](age, maxItems)
 }
 
-func (t timedStoreDataSlice) Swap(i, j int) {
-	t[i], t[j] = t[j], t[i]
-}
+// minRingCapacity is the capacity a TimedRing starts with on its first Add.
+const minRingCapacity = 8
 
-// A time-based buffer for ContainerStats.
+// A time-based ring buffer of items of type T.
 // Holds information for a specific time period and/or a max number of items.
-type TimedStore struct {
-	buffer   timedStoreDataSlice
+// Items are copied into the slots of the ring and evicted slots are reused, so
+// once the ring has grown to its working size adding items does not allocate.
+type TimedRing[T any] struct {
+	// Slots of the ring, the oldest item is at buffer[head].
+	buffer []timedRingData[T]
+	head   int
+	size   int
+
 	age      time.Duration
 	maxItems int
+	// Whether all maxItems slots are allocated up front.
+	fixed bool
 }
 
-type timedStoreData struct {
+type timedRingData[T any] struct {
 	timestamp time.Time
-	data      interface{}
+	data      T
 }
 
-// Returns a new thread-compatible TimedStore.
+// Returns a new thread-compatible TimedRing.
 // A maxItems value of -1 means no limit.
-func NewTimedStore(age time.Duration, maxItems int) *TimedStore {
-	return &TimedStore{
-		buffer:   make(timedStoreDataSlice, 0),
+func NewTimedRing[T any](age time.Duration, maxItems int) *TimedRing[T] {
+	return &TimedRing[T]{
+		age:      age,
+		maxItems: maxItems,
+	}
+}
+
+// Returns a new thread-compatible TimedRing with all its maxItems slots
+// allocated up front, so that it never grows. maxItems must be positive.
+func NewFixedTimedRing[T any](age time.Duration, maxItems int) *TimedRing[T] {
+	return &TimedRing[T]{
+		buffer:   make([]timedRingData[T], maxItems),
 		age:      age,
 		maxItems: maxItems,
+		fixed:    true,
+	}
+}
+
+// slot returns the slot of the i-th oldest item.
+func (s *TimedRing[T]) slot(i int) *timedRingData[T] {
+	return &s.buffer[(s.head+i)%len(s.buffer)]
+}
+
+// grow makes room for at least one more item. Bounded rings never grow past
+// maxItems slots.
+func (s *TimedRing[T]) grow() {
+	capacity := max(2*len(s.buffer), minRingCapacity)
+	if s.maxItems >= 0 {
+		capacity = min(capacity, s.maxItems)
+	}
+	s.resize(capacity)
+}
+
+// resize moves the items to a buffer of the given capacity, which must hold
+// them all.
+func (s *TimedRing[T]) resize(capacity int) {
+	buffer := make([]timedRingData[T], capacity)
+	for i := 0; i < s.size; i++ {
+		buffer[i] = *s.slot(i)
+	}
+	s.buffer = buffer
+	s.head = 0
+}
+
+// evict drops the n oldest items.
+func (s *TimedRing[T]) evict(n int) {
+	for i := 0; i < n; i++ {
+		// Clear the slot so the evicted item can be garbage collected.
+		*s.slot(i) = timedRingData[T]{}
 	}
+	s.head = (s.head + n) % len(s.buffer)
+	s.size -= n
 }
 
 // Adds an element to the start of the buffer (removing one from the end if necessary).
-func (s *TimedStore) Add(timestamp time.Time, item interface{}) {
-	data := timedStoreData{
-		timestamp: timestamp,
-		data:      item,
+func (s *TimedRing[T]) Add(timestamp time.Time, item T) {
+	if s.maxItems == 0 {
+		return
+	}
+	if s.size == len(s.buffer) {
+		if s.size != s.maxItems {
+			s.grow()
+		} else if timestamp.Before(s.slot(0).timestamp) {
+			// The ring is full of later items, this one would be evicted right away.
+			return
+		} else {
+			// The item takes the slot of the oldest one.
+			s.evict(1)
+		}
 	}
+
 	// Common case: data is added in order.
-	if len(s.buffer) == 0 || !timestamp.Before(s.buffer[len(s.buffer)-1].timestamp) {
-		s.buffer = append(s.buffer, data)
-	} else {
+	index := s.size
+	if s.size > 0 && timestamp.Before(s.slot(s.size-1).timestamp) {
 		// Data is out of order; insert it in the correct position.
-		index := sort.Search(len(s.buffer), func(index int) bool {
-			return s.buffer[index].timestamp.After(timestamp)
+		index = sort.Search(s.size, func(i int) bool {
+			return s.slot(i).timestamp.After(timestamp)
 		})
-		s.buffer = append(s.buffer, timedStoreData{}) // Make room to shift the elements
-		copy(s.buffer[index+1:], s.buffer[index:])    // Shift the elements over
-		s.buffer[index] = data
+		// Shift the later elements over.
+		for i := s.size; i > index; i-- {
+			*s.slot(i) = *s.slot(i - 1)
+		}
+	}
+	*s.slot(index) = timedRingData[T]{
+		timestamp: timestamp,
+		data:      item,
 	}
+	s.size++
 
 	// Remove any elements before eviction time.
 	// TODO(rjnagal): This is assuming that the added entry has timestamp close to now.
 	evictTime := timestamp.Add(-s.age)
-	index := sort.Search(len(s.buffer), func(index int) bool {
-		return s.buffer[index].timestamp.After(evictTime)
+	index = sort.Search(s.size, func(i int) bool {
+		return s.slot(i).timestamp.After(evictTime)
 	})
-	if index < len(s.buffer) {
-		s.buffer = s.buffer[index:]
+	if index < s.size {
+		s.evict(index)
 	}
+}
 
-	// Remove any elements if over our max size.
-	if s.maxItems >= 0 && len(s.buffer) > s.maxItems {
-		startIndex := len(s.buffer) - s.maxItems
-		s.buffer = s.buffer[startIndex:]
+// Removes all but the latest maxItems elements.
+func (s *TimedRing[T]) KeepLatest(maxItems int) {
+	if s.size > maxItems {
+		s.evict(s.size - max(maxItems, 0))
+	}
+}
+
+// Changes the max number of items, dropping the oldest items over it. A
+// maxItems value of -1 means no limit. A ring created by NewFixedTimedRing
+// allocates its new slots up front, unless it is no longer limited.
+func (s *TimedRing[T]) SetMaxItems(maxItems int) {
+	s.maxItems = maxItems
+	if maxItems < 0 {
+		s.fixed = false
+		return
+	}
+	s.KeepLatest(maxItems)
+	if s.fixed || len(s.buffer) > maxItems {
+		s.resize(maxItems)
 	}
 }
 
 // Returns up to maxResult elements in the specified time period (inclusive).
 // Results are from first to last. maxResults of -1 means no limit.
-func (s *TimedStore) InTimeRange(start, end time.Time, maxResults int) []interface{} {
-	// No stats, return empty.
-	if len(s.buffer) == 0 {
-		return []interface{}{}
-	}
-
-	var startIndex int
-	if start.IsZero() {
-		// None specified, start at the beginning.
-		startIndex = len(s.buffer) - 1
-	} else {
-		// Start is the index before the elements smaller than it. We do this by
-		// finding the first element smaller than start and taking the index
-		// before that element
-		startIndex = sort.Search(len(s.buffer), func(index int) bool {
-			// buffer[index] < start
-			return s.getData(index).timestamp.Before(start)
-		}) - 1
-		// Check if start is after all the data we have.
-		if startIndex < 0 {
-			return []interface{}{}
-		}
+func (s *TimedRing[T]) InTimeRange(start, end time.Time, maxResults int) []T {
+	first, last := s.timeRange(start, end, maxResults)
+	return s.appendRange(make([]T, 0, last-first), first, last)
+}
+
+// Appends up to maxResult elements in the specified time period (inclusive)
+// to dst and returns the extended slice, from first to last. maxResults of -1
+// means no limit. Unlike InTimeRange, this does not allocate if dst has room.
+func (s *TimedRing[T]) AppendInTimeRange(dst []T, start, end time.Time, maxResults int) []T {
+	first, last := s.timeRange(start, end, maxResults)
+	return s.appendRange(dst, first, last)
+}
+
+// Like AppendInTimeRange, but appends pointers to the elements in the ring
+// rather than copies of them. They must not be modified, and are only valid
+// until the ring is next changed.
+func (s *TimedRing[T]) AppendRefsInTimeRange(dst []*T, start, end time.Time, maxResults int) []*T {
+	first, last := s.timeRange(start, end, maxResults)
+	for i := first; i < last; i++ {
+		dst = append(dst, &s.slot(i).data)
 	}
+	return dst
+}
+
+// Returns how many elements InTimeRange would return.
+func (s *TimedRing[T]) CountInTimeRange(start, end time.Time, maxResults int) int {
+	first, last := s.timeRange(start, end, maxResults)
+	return last - first
+}
 
-	var endIndex int
-	if end.IsZero() {
-		// None specified, end with the latest stats.
-		endIndex = 0
-	} else {
-		// End is the first index smaller than or equal to it (so, not larger).
-		endIndex = sort.Search(len(s.buffer), func(index int) bool {
-			// buffer[index] <= t -> !(buffer[index] > t)
-			return !s.getData(index).timestamp.After(end)
+// timeRange returns the half-open range of the indices, from oldest, of the
+// at most maxResults latest items in the specified time period (inclusive).
+func (s *TimedRing[T]) timeRange(start, end time.Time, maxResults int) (int, int) {
+	first := 0
+	if !start.IsZero() {
+		first = sort.Search(s.size, func(i int) bool {
+			return !s.slot(i).timestamp.Before(start)
+		})
+	}
+	last := s.size
+	if !end.IsZero() {
+		last = sort.Search(s.size, func(i int) bool {
+			return s.slot(i).timestamp.After(end)
 		})
-		// Check if end is before all the data we have.
-		if endIndex == len(s.buffer) {
-			return []interface{}{}
-		}
+	}
+	if last < first {
+		return first, first
 	}
 
 	// Trim to maxResults size.
-	numResults := startIndex - endIndex + 1
-	if maxResults != -1 && numResults > maxResults {
-		startIndex -= numResults - maxResults
-		numResults = maxResults
+	if maxResults != -1 && last-first > maxResults {
+		first = last - maxResults
 	}
+	return first, last
+}
 
-	// Return in sorted timestamp order so from the "back" to "front".
-	result := make([]interface{}, numResults)
-	for i := 0; i < numResults; i++ {
-		result[i] = s.Get(startIndex - i)
+func (s *TimedRing[T]) appendRange(dst []T, first, last int) []T {
+	for i := first; i < last; i++ {
+		dst = append(dst, s.slot(i).data)
 	}
-	return result
+	return dst
 }
 
 // Gets the element at the specified index. Note that elements are output in LIFO order.
-func (s *TimedStore) Get(index int) interface{} {
-	return s.getData(index).data
+func (s *TimedRing[T]) Get(index int) T {
+	return s.slot(s.size - index - 1).data
 }
 
-// Gets the data at the specified index. Note that elements are output in LIFO order.
-func (s *TimedStore) getData(index int) timedStoreData {
-	return s.buffer[len(s.buffer)-index-1]
+// Returns the timestamp of the oldest element, if any.
+func (s *TimedRing[T]) OldestTimestamp() (time.Time, bool) {
+	if s.size == 0 {
+		return time.Time{}, false
+	}
+	return s.slot(0).timestamp, true
 }
 
-func (s *TimedStore) Size() int {
-	return len(s.buffer)
+func (s *TimedRing[T]) Size() int {
+	return s.size
 }
@@ -219,3 +219,110 @@ func TestLimitedSize(t *testing.T) {
 	expectSize(t, sb, 5)
 	expectAllElements(t, sb, []int{6, 7, 8, 9, 10})
 }
+
+func TestOutOfOrderAfterWrapAround(t *testing.T) {
+	sb := NewTimedStore(time.Hour, 5)
+
+	// Wrap the ring around a few times.
+	for i := 0; i < 12; i += 2 {
+		sb.Add(createTime(i), i)
+	}
+	expectAllElements(t, sb, []int{2, 4, 6, 8, 10})
+
+	sb.Add(createTime(7), 7)
+	expectAllElements(t, sb, []int{4, 6, 7, 8, 10})
+	sb.Add(createTime(3), 3)
+	expectAllElements(t, sb, []int{4, 6, 7, 8, 10})
+	sb.Add(createTime(5), 5)
+	expectAllElements(t, sb, []int{5, 6, 7, 8, 10})
+}
+
+func TestTimedRingReusesSlots(t *testing.T) {
+	sb := NewTimedRing[int](5*time.Second, -1)
+	for i := 0; i < 100; i++ {
+		sb.Add(createTime(i), i)
+	}
+	assert.Equal(t, 5, sb.Size())
+	assert.Equal(t, []int{95, 96, 97, 98, 99}, sb.InTimeRange(time.Time{}, time.Time{}, -1))
+
+	i := 100
+	allocs := testing.AllocsPerRun(100, func() {
+		sb.Add(createTime(i), i)
+		i++
+	})
+	assert.Zero(t, allocs)
+}
+
+func TestAppendInTimeRange(t *testing.T) {
+	sb := NewTimedRing[int](time.Hour, -1)
+	for i := 1; i <= 4; i++ {
+		sb.Add(createTime(i), i)
+	}
+
+	var empty time.Time
+	buf := make([]int, 0, 4)
+	assert.Equal(t, []int{3, 4}, sb.AppendInTimeRange(buf, empty, empty, 2))
+	assert.Equal(t, []int{0, 2, 3}, sb.AppendInTimeRange([]int{0}, createTime(2), createTime(3), -1))
+	assert.Empty(t, sb.AppendInTimeRange(buf, createTime(5), empty, -1))
+
+	allocs := testing.AllocsPerRun(100, func() {
+		sb.AppendInTimeRange(buf[:0], empty, empty, 4)
+	})
+	assert.Zero(t, allocs)
+}
+
+func TestKeepLatest(t *testing.T) {
+	sb := NewTimedStore(time.Hour, 5)
+	for i := 0; i < 7; i++ {
+		sb.Add(createTime(i), i)
+	}
+	sb.KeepLatest(2)
+	expectAllElements(t, sb, []int{5, 6})
+	sb.KeepLatest(3)
+	expectAllElements(t, sb, []int{5, 6})
+	sb.Add(createTime(7), 7)
+	expectAllElements(t, sb, []int{5, 6, 7})
+	sb.KeepLatest(0)
+	expectSize(t, sb, 0)
+}
+
+func TestFixedTimedRing(t *testing.T) {
+	sb := NewFixedTimedRing[int](time.Hour, 3)
+	i := 0
+	allocs := testing.AllocsPerRun(4, func() {
+		sb.Add(createTime(i), i)
+		i++
+	})
+	assert.Zero(t, allocs)
+	assert.Equal(t, []int{2, 3, 4}, sb.InTimeRange(time.Time{}, time.Time{}, -1))
+
+	// Items older than a full ring are dropped.
+	sb.Add(createTime(1), 1)
+	assert.Equal(t, []int{2, 3, 4}, sb.InTimeRange(time.Time{}, time.Time{}, -1))
+	sb.Add(createTime(3), 3)
+	assert.Equal(t, []int{3, 3, 4}, sb.InTimeRange(time.Time{}, time.Time{}, -1))
+
+	sb.SetMaxItems(2)
+	assert.Equal(t, []int{3, 4}, sb.InTimeRange(time.Time{}, time.Time{}, -1))
+	sb.SetMaxItems(4)
+	assert.Len(t, sb.buffer, 4)
+	assert.Equal(t, []int{3, 4}, sb.InTimeRange(time.Time{}, time.Time{}, -1))
+}
+
+func TestAppendRefsInTimeRange(t *testing.T) {
+	sb := NewFixedTimedRing[int](time.Hour, 4)
+	_, ok := sb.OldestTimestamp()
+	assert.False(t, ok)
+	for i := 1; i <= 6; i++ {
+		sb.Add(createTime(i), i)
+	}
+
+	var empty time.Time
+	refs := sb.AppendRefsInTimeRange(nil, empty, empty, 2)
+	assert.Len(t, refs, 2)
+	assert.Equal(t, 5, *refs[0])
+	assert.Same(t, &sb.slot(sb.size-1).data, refs[1])
+	oldest, ok := sb.OldestTimestamp()
+	assert.True(t, ok)
+	assert.Equal(t, createTime(3), oldest)
+}
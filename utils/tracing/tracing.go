@@ -0,0 +1,40 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package tracing holds the helpers shared by the packages tracing the
+// housekeeping of cAdvisor with OpenTelemetry. The spans are recorded with the
+// global tracer provider, which does nothing unless cmd sets up an exporter.
+package tracing
+
+import (
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Attribute keys of the spans of cAdvisor.
+const (
+	ContainerName = attribute.Key("cadvisor.container.name")
+	Handler       = attribute.Key("cadvisor.handler")
+	CgroupPath    = attribute.Key("cadvisor.cgroup.path")
+)
+
+// End ends span, marking it as failed with err if err is not nil.
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
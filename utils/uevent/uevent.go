@@ -0,0 +1,130 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+// Package uevent reports the device events the kernel sends to udev, such as
+// CPUs, memory blocks and disks being added, removed, onlined or offlined.
+package uevent
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"golang.org/x/sys/unix"
+	"k8s.io/klog/v2"
+)
+
+// Event is a kernel uevent.
+type Event struct {
+	// What happened to the device: add, remove, change, online, offline...
+	Action string
+	// Path of the device below /sys.
+	DevPath string
+	// Subsystem of the device, e.g. cpu, memory or block.
+	Subsystem string
+	// All the variables of the event, including ACTION, DEVPATH and
+	// SUBSYSTEM.
+	Env map[string]string
+}
+
+// The multicast group the kernel sends uevents to, as opposed to the ones
+// udev sends after processing them.
+const kernelGroup = 1
+
+// Watcher receives the kernel uevents.
+type Watcher struct {
+	fd     int
+	closed atomic.Bool
+}
+
+// New subscribes to the kernel uevents.
+func New() (*Watcher, error) {
+	fd, err := unix.Socket(unix.AF_NETLINK, unix.SOCK_DGRAM|unix.SOCK_CLOEXEC, unix.NETLINK_KOBJECT_UEVENT)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open the uevent socket: %v", err)
+	}
+	addr := &unix.SockaddrNetlink{Family: unix.AF_NETLINK, Groups: kernelGroup}
+	if err := unix.Bind(fd, addr); err != nil {
+		unix.Close(fd)
+		return nil, fmt.Errorf("failed to bind to the uevent socket: %v", err)
+	}
+	// Wake up regularly to notice Close.
+	timeout := unix.NsecToTimeval(time.Second.Nanoseconds())
+	if err := unix.SetsockoptTimeval(fd, unix.SOL_SOCKET, unix.SO_RCVTIMEO, &timeout); err != nil {
+		unix.Close(fd)
+		return nil, err
+	}
+	return &Watcher{fd: fd}, nil
+}
+
+// Stream sends the events received to out until Close is called.
+func (w *Watcher) Stream(out chan<- *Event) {
+	buf := make([]byte, os.Getpagesize()*2)
+	for !w.closed.Load() {
+		n, _, err := unix.Recvfrom(w.fd, buf, 0)
+		if err != nil {
+			switch {
+			case errors.Is(err, unix.EAGAIN), errors.Is(err, unix.EINTR):
+			case errors.Is(err, unix.ENOBUFS):
+				klog.Warningf("Lost uevents, the uevent socket receive buffer overflowed")
+			case w.closed.Load():
+			default:
+				klog.Errorf("Failed to receive uevents, stopping: %v", err)
+				return
+			}
+			continue
+		}
+		if ev := parseMessage(buf[:n]); ev != nil {
+			out <- ev
+		}
+	}
+}
+
+// Close stops Stream.
+func (w *Watcher) Close() error {
+	if w.closed.Swap(true) {
+		return nil
+	}
+	return unix.Close(w.fd)
+}
+
+// parseMessage parses a kernel uevent, "action@devpath" followed by
+// KEY=VALUE variables, all NUL terminated. It returns nil for malformed
+// messages.
+func parseMessage(msg []byte) *Event {
+	fields := bytes.Split(bytes.TrimRight(msg, "\x00"), []byte{0})
+	if len(fields) < 2 || !bytes.Contains(fields[0], []byte("@")) {
+		return nil
+	}
+	ev := &Event{Env: make(map[string]string, len(fields)-1)}
+	for _, field := range fields[1:] {
+		key, value, ok := strings.Cut(string(field), "=")
+		if ok {
+			ev.Env[key] = value
+		}
+	}
+	ev.Action = ev.Env["ACTION"]
+	ev.DevPath = ev.Env["DEVPATH"]
+	ev.Subsystem = ev.Env["SUBSYSTEM"]
+	if ev.Action == "" || ev.DevPath == "" {
+		return nil
+	}
+	return ev
+}
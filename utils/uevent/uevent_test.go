@@ -0,0 +1,54 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package uevent
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func message(fields ...string) []byte {
+	return []byte(strings.Join(fields, "\x00") + "\x00")
+}
+
+func TestParseMessage(t *testing.T) {
+	ev := parseMessage(message("online@/devices/system/cpu/cpu3", "ACTION=online", "DEVPATH=/devices/system/cpu/cpu3", "SUBSYSTEM=cpu", "SEQNUM=4711"))
+	assert.Equal(t, &Event{
+		Action:    "online",
+		DevPath:   "/devices/system/cpu/cpu3",
+		Subsystem: "cpu",
+		Env: map[string]string{
+			"ACTION":    "online",
+			"DEVPATH":   "/devices/system/cpu/cpu3",
+			"SUBSYSTEM": "cpu",
+			"SEQNUM":    "4711",
+		},
+	}, ev)
+
+	ev = parseMessage(message("add@/devices/virtual/block/vdb", "ACTION=add", "DEVPATH=/devices/virtual/block/vdb", "SUBSYSTEM=block", "DEVTYPE=disk", "MAJOR=252", "MINOR=16"))
+	if assert.NotNil(t, ev) {
+		assert.Equal(t, "block", ev.Subsystem)
+		assert.Equal(t, "disk", ev.Env["DEVTYPE"])
+	}
+
+	// Messages of udev, or without the mandatory variables, are ignored.
+	assert.Nil(t, parseMessage(message("libudev", "ACTION=add")))
+	assert.Nil(t, parseMessage(message("add@/devices/system/memory/memory32", "SUBSYSTEM=memory")))
+	assert.Nil(t, parseMessage(nil))
+}
@@ -0,0 +1,96 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build windows
+
+// Package win32 reads the system resources of Windows hosts.
+package win32
+
+import (
+	"time"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+var (
+	kernel32                 = windows.NewLazySystemDLL("kernel32.dll")
+	procGlobalMemoryStatusEx = kernel32.NewProc("GlobalMemoryStatusEx")
+	procGetSystemTimes       = kernel32.NewProc("GetSystemTimes")
+	// The psapi functions are exported by kernel32 since Windows 7.
+	procGetProcessMemoryInfo = kernel32.NewProc("K32GetProcessMemoryInfo")
+)
+
+// MemoryStatus is the MEMORYSTATUSEX of the physical and virtual memory of
+// the host, in bytes.
+type MemoryStatus struct {
+	Length               uint32
+	MemoryLoad           uint32
+	TotalPhys            uint64
+	AvailPhys            uint64
+	TotalPageFile        uint64
+	AvailPageFile        uint64
+	TotalVirtual         uint64
+	AvailVirtual         uint64
+	AvailExtendedVirtual uint64
+}
+
+// GlobalMemoryStatus returns the memory status of the host.
+func GlobalMemoryStatus() (*MemoryStatus, error) {
+	status := &MemoryStatus{}
+	status.Length = uint32(unsafe.Sizeof(*status))
+	if r, _, err := procGlobalMemoryStatusEx.Call(uintptr(unsafe.Pointer(status))); r == 0 {
+		return nil, err
+	}
+	return status, nil
+}
+
+// SystemTimes returns the time all the processors of the host spent idle, in
+// kernel mode, idle time included, and in user mode since boot.
+func SystemTimes() (idle, kernel, user time.Duration, err error) {
+	var idleTime, kernelTime, userTime windows.Filetime
+	if r, _, err := procGetSystemTimes.Call(uintptr(unsafe.Pointer(&idleTime)), uintptr(unsafe.Pointer(&kernelTime)), uintptr(unsafe.Pointer(&userTime))); r == 0 {
+		return 0, 0, 0, err
+	}
+	return filetimeDuration(idleTime), filetimeDuration(kernelTime), filetimeDuration(userTime), nil
+}
+
+// processMemoryCounters is the PROCESS_MEMORY_COUNTERS of a process.
+type processMemoryCounters struct {
+	CB                         uint32
+	PageFaultCount             uint32
+	PeakWorkingSetSize         uintptr
+	WorkingSetSize             uintptr
+	QuotaPeakPagedPoolUsage    uintptr
+	QuotaPagedPoolUsage        uintptr
+	QuotaPeakNonPagedPoolUsage uintptr
+	QuotaNonPagedPoolUsage     uintptr
+	PagefileUsage              uintptr
+	PeakPagefileUsage          uintptr
+}
+
+// ProcessWorkingSet returns the working set of the current process in bytes.
+func ProcessWorkingSet() (uint64, error) {
+	counters := &processMemoryCounters{}
+	counters.CB = uint32(unsafe.Sizeof(*counters))
+	if r, _, err := procGetProcessMemoryInfo.Call(uintptr(windows.CurrentProcess()), uintptr(unsafe.Pointer(counters)), uintptr(counters.CB)); r == 0 {
+		return 0, err
+	}
+	return uint64(counters.WorkingSetSize), nil
+}
+
+// filetimeDuration returns a duration counted in a FILETIME, in 100ns units.
+func filetimeDuration(ft windows.Filetime) time.Duration {
+	return time.Duration(uint64(ft.HighDateTime)<<32|uint64(ft.LowDateTime)) * 100
+}
@@ -21,17 +21,18 @@ package validate
 
 import (
 	"fmt"
-	"log"
 	"net/http"
 	"os"
 	"path"
 	"strings"
 
+	"github.com/google/cadvisor/container/common"
 	"github.com/google/cadvisor/container/docker"
 	"github.com/google/cadvisor/manager"
 	"github.com/google/cadvisor/utils"
 
 	"github.com/opencontainers/cgroups"
+	"k8s.io/klog/v2"
 )
 
 const (
@@ -49,7 +50,7 @@ func getMajorMinor(version string) (int, int, error) {
 	var ign string
 	n, err := fmt.Sscanf(version, VersionFormat, &major, &minor, &ign)
 	if n != 3 || err != nil {
-		log.Printf("Failed to parse version for %s", version)
+		klog.Warningf("Failed to parse version for %s", version)
 		return -1, -1, err
 	}
 	return major, minor, nil
@@ -158,7 +159,7 @@ func validateMemoryAccounting(availableCgroups map[string]int) string {
 		return "\tHierarchical memory accounting status unknown: memory cgroup not enabled.\n"
 	}
 	var enabled int
-	if cgroups.IsCgroup2UnifiedMode() {
+	if common.IsCgroup2UnifiedMode() {
 		enabled = 1
 	} else {
 		mnt, err := cgroups.FindCgroupMountpoint("/", "memory")
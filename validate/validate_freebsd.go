@@ -0,0 +1,89 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build freebsd
+
+// Handler for /validate content.
+// Validates cadvisor dependencies - FreeBSD version, resource accounting.
+
+package validate
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/google/cadvisor/manager"
+	"github.com/google/cadvisor/utils/rctl"
+)
+
+const (
+	ValidatePage = "/validate/"
+	Supported    = "[Supported, but not recommended]"
+	Unsupported  = "[Unsupported]"
+	Recommended  = "[Supported and recommended]"
+	Unknown      = "[Unknown]"
+	OutputFormat = "%s: %s\n\t%s\n\n"
+)
+
+// validateFreeBSDVersion checks the release of FreeBSD: rctl came with
+// FreeBSD 9, racct is compiled into the GENERIC kernel since FreeBSD 13.
+func validateFreeBSDVersion(version string) (string, string) {
+	desc := fmt.Sprintf("FreeBSD version is %s. Versions >= 9.0 are supported. 13.0+ are recommended.\n", version)
+	var major, minor int
+	if n, err := fmt.Sscanf(version, "%d.%d", &major, &minor); n != 2 || err != nil {
+		return Unknown, desc
+	}
+	if major < 9 {
+		return Unsupported, desc
+	}
+	if major < 13 {
+		return Supported, desc
+	}
+	return Recommended, desc
+}
+
+func validateRacct() (string, string) {
+	if !rctl.Enabled() {
+		return Unsupported, "Resource accounting is disabled: jails can't be monitored. Set kern.racct.enable=1 in /boot/loader.conf and reboot.\n"
+	}
+	return Recommended, "Resource accounting is enabled.\n"
+}
+
+func HandleRequest(w http.ResponseWriter, containerManager manager.Manager) error {
+	// Get cAdvisor version Info.
+	versionInfo, err := containerManager.GetVersionInfo()
+	if err != nil {
+		return err
+	}
+
+	out := fmt.Sprintf("cAdvisor version: %s\n\n", versionInfo.CadvisorVersion)
+
+	out += fmt.Sprintf("OS version: %s\n\n", versionInfo.ContainerOsVersion)
+
+	freebsdValidation, desc := validateFreeBSDVersion(versionInfo.KernelVersion)
+	out += fmt.Sprintf(OutputFormat, "FreeBSD version", freebsdValidation, desc)
+
+	racctValidation, desc := validateRacct()
+	out += fmt.Sprintf(OutputFormat, "Resource accounting", racctValidation, desc)
+
+	// Output debug info.
+	debugInfo := containerManager.DebugInfo()
+	for category, lines := range debugInfo {
+		out += fmt.Sprintf(OutputFormat, category, "", strings.Join(lines, "\n\t"))
+	}
+
+	_, err = w.Write([]byte(out))
+	return err
+}
@@ -0,0 +1,79 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build windows
+
+// Handler for /validate content.
+// Validates cadvisor dependencies - Windows version.
+
+package validate
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/google/cadvisor/manager"
+)
+
+const (
+	ValidatePage = "/validate/"
+	Supported    = "[Supported, but not recommended]"
+	Unsupported  = "[Unsupported]"
+	Recommended  = "[Supported and recommended]"
+	Unknown      = "[Unknown]"
+	OutputFormat = "%s: %s\n\t%s\n\n"
+)
+
+// validateWindowsVersion checks the build of Windows: the Host Compute
+// Service came with Windows Server 2016, its statistics matured with
+// Windows Server 2019.
+func validateWindowsVersion(version string) (string, string) {
+	desc := fmt.Sprintf("Windows version is %s. Builds >= 14393 (Windows Server 2016) are supported. 17763+ (Windows Server 2019) are recommended.\n", version)
+	var major, minor, build int
+	if n, err := fmt.Sscanf(version, "%d.%d.%d", &major, &minor, &build); n != 3 || err != nil {
+		return Unknown, desc
+	}
+	if major < 10 || build < 14393 {
+		return Unsupported, desc
+	}
+	if build < 17763 {
+		return Supported, desc
+	}
+	return Recommended, desc
+}
+
+func HandleRequest(w http.ResponseWriter, containerManager manager.Manager) error {
+	// Get cAdvisor version Info.
+	versionInfo, err := containerManager.GetVersionInfo()
+	if err != nil {
+		return err
+	}
+
+	out := fmt.Sprintf("cAdvisor version: %s\n\n", versionInfo.CadvisorVersion)
+
+	out += fmt.Sprintf("OS version: %s\n\n", versionInfo.ContainerOsVersion)
+
+	windowsValidation, desc := validateWindowsVersion(versionInfo.KernelVersion)
+	out += fmt.Sprintf(OutputFormat, "Windows version", windowsValidation, desc)
+
+	// Output debug info.
+	debugInfo := containerManager.DebugInfo()
+	for category, lines := range debugInfo {
+		out += fmt.Sprintf(OutputFormat, category, "", strings.Join(lines, "\n\t"))
+	}
+
+	_, err = w.Write([]byte(out))
+	return err
+}
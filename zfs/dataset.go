@@ -0,0 +1,94 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package zfs
+
+import (
+	"fmt"
+	"strings"
+
+	mount "github.com/moby/sys/mountinfo"
+	"golang.org/x/sys/unix"
+	"k8s.io/klog/v2"
+)
+
+// zfsSuperMagic is the filesystem type the kernel reports for zfs datasets.
+const zfsSuperMagic = 0x2fc12fc1
+
+// DatasetUsage is the usage of a mounted dataset as reported by the kernel
+// for its mountpoint.
+type DatasetUsage struct {
+	// Referenced is the space referenced by the dataset, including the data
+	// it shares with its origin snapshot.
+	Referenced uint64
+	// Available is the space available to the dataset.
+	Available uint64
+	// Objects is the number of objects in the dataset.
+	Objects uint64
+	// ObjectsFree is an estimate of the number of objects that can still be
+	// created in the dataset.
+	ObjectsFree uint64
+}
+
+// GetDatasetUsage returns the usage of the dataset mounted at mountpoint. It
+// is read with statfs(2) and needs neither the zfs command nor /dev/zfs.
+func GetDatasetUsage(mountpoint string) (DatasetUsage, error) {
+	var s unix.Statfs_t
+	if err := unix.Statfs(mountpoint, &s); err != nil {
+		return DatasetUsage{}, err
+	}
+	if uint64(s.Type) != zfsSuperMagic {
+		return DatasetUsage{}, fmt.Errorf("%s is not on a zfs dataset", mountpoint)
+	}
+	return DatasetUsage{
+		Referenced:  uint64(s.Frsize) * (s.Blocks - s.Bfree),
+		Available:   uint64(s.Frsize) * s.Bavail,
+		Objects:     s.Files - s.Ffree,
+		ObjectsFree: s.Ffree,
+	}, nil
+}
+
+// mountedChildren returns the mountpoints of the mounted descendants of the
+// filesystem, by dataset name.
+func mountedChildren(filesystem string, mounts []*mount.Info) map[string]string {
+	children := make(map[string]string)
+	for _, m := range mounts {
+		if m.FSType == "zfs" && strings.HasPrefix(m.Source, filesystem+"/") {
+			children[m.Source] = m.Mountpoint
+		}
+	}
+	return children
+}
+
+// childrenReferenced returns the space referenced by the mounted descendants
+// of the filesystem, for when the zfs command is not available.
+func childrenReferenced(filesystem string) (map[string]uint64, error) {
+	mounts, err := mount.GetMounts(mount.FSTypeFilter("zfs"))
+	if err != nil {
+		return nil, err
+	}
+	usage := make(map[string]uint64)
+	for dataset, mountpoint := range mountedChildren(filesystem, mounts) {
+		du, err := GetDatasetUsage(mountpoint)
+		if err != nil {
+			// The dataset may have been unmounted since the mounts were read.
+			klog.V(4).Infof("unable to get the usage of zfs dataset %s: %v", dataset, err)
+			continue
+		}
+		usage[dataset] = du.Referenced
+	}
+	return usage, nil
+}
@@ -0,0 +1,48 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package zfs
+
+import (
+	"testing"
+
+	mount "github.com/moby/sys/mountinfo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMountedChildren(t *testing.T) {
+	mounts := []*mount.Info{
+		{Source: "tank", Mountpoint: "/tank", FSType: "zfs"},
+		{Source: "tank/docker", Mountpoint: "/var/lib/docker", FSType: "zfs"},
+		{Source: "tank/docker/abc", Mountpoint: "/var/lib/docker/zfs/graph/abc", FSType: "zfs"},
+		{Source: "tank/docker/abc/data", Mountpoint: "/data", FSType: "zfs"},
+		{Source: "tank/dockerfoo", Mountpoint: "/dockerfoo", FSType: "zfs"},
+		{Source: "tank/docker/def", Mountpoint: "/mnt", FSType: "ext4"},
+	}
+	assert.Equal(t, map[string]string{
+		"tank/docker/abc":      "/var/lib/docker/zfs/graph/abc",
+		"tank/docker/abc/data": "/data",
+	}, mountedChildren("tank/docker", mounts))
+}
+
+func TestGetDatasetUsageNotZfs(t *testing.T) {
+	dir := t.TempDir()
+	if _, err := GetDatasetUsage(dir); err == nil {
+		t.Skipf("%s is on a zfs dataset", dir)
+	}
+	_, err := GetDatasetUsage(dir + "/missing")
+	assert.Error(t, err)
+}
@@ -12,6 +12,8 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
+//go:build linux
+
 package zfs
 
 import (
@@ -101,24 +103,38 @@ func (w *ZfsWatcher) GetUsage(filesystem string) (uint64, error) {
 	return v, nil
 }
 
-// Refresh performs a zfs get
+// Refresh performs a zfs get, falling back to the usage the kernel reports
+// for the mounted children of the filesystem when the zfs command fails.
 func (w *ZfsWatcher) Refresh() error {
-	parent, err := zfs.GetDataset(w.filesystem)
+	newCache, err := childrenUsed(w.filesystem)
+	if err != nil {
+		klog.V(4).Infof("unable to get the usage of zfs filesystem %s with the zfs command, reading it from its mounts: %v", w.filesystem, err)
+		newCache, err = childrenReferenced(w.filesystem)
+		if err != nil {
+			klog.Errorf("encountered error getting the mounts of zfs filesystem: %s: %v", w.filesystem, err)
+			return err
+		}
+	}
+
+	w.cache.Store(newCache)
+	return nil
+}
+
+// childrenUsed returns the space used by the children of the filesystem, as
+// reported by the zfs command.
+func childrenUsed(filesystem string) (map[string]uint64, error) {
+	parent, err := zfs.GetDataset(filesystem)
 	if err != nil {
-		klog.Errorf("encountered error getting zfs filesystem: %s: %v", w.filesystem, err)
-		return err
+		return nil, err
 	}
 	children, err := parent.Children(0)
 	if err != nil {
-		klog.Errorf("encountered error getting children of zfs filesystem: %s: %v", w.filesystem, err)
-		return err
+		return nil, err
 	}
 
-	newCache := make(map[string]uint64)
+	usage := make(map[string]uint64)
 	for _, ds := range children {
-		newCache[ds.Name] = ds.Used
+		usage[ds.Name] = ds.Used
 	}
-
-	w.cache.Store(newCache)
-	return nil
+	return usage, nil
 }